@@ -2,12 +2,10 @@ package auth
 
 import (
 	"context"
-	"strconv"
 
 	"ecommerce-be/common/constants"
 	commonError "ecommerce-be/common/error"
-
-	"github.com/gin-gonic/gin"
+	"ecommerce-be/common/reqctx"
 )
 
 /********************************************************************
@@ -20,72 +18,14 @@ func HasRequiredRoleLevel(userRoleLevel, requiredRoleLevel uint) bool {
 	return userRoleLevel <= requiredRoleLevel
 }
 
-// getValueFromContext is a helper that extracts a value from context
-// Works with both *gin.Context and standard context.Context
-func getValueFromContext(ctx context.Context, key string) (any, bool) {
-	// Try Gin context first (uses internal map storage)
-	if ginCtx, ok := ctx.(*gin.Context); ok {
-		return ginCtx.Get(key)
-	}
-
-	// Fallback to standard context.Context
-	val := ctx.Value(key)
-	if val != nil {
-		return val, true
-	}
-
-	return nil, false
-}
-
-// getUintFromContext extracts a uint value from context
-// Handles both uint and string types (string for scheduler job context)
-func getUintFromContext(ctx context.Context, key string) (uint, bool) {
-	val, exists := getValueFromContext(ctx, key)
-	if !exists {
-		return 0, false
-	}
-
-	switch v := val.(type) {
-	case uint:
-		return v, true
-	case string:
-		// Handle string values (from scheduler jobs)
-		if parsed, err := strconv.ParseUint(v, 10, 32); err == nil {
-			return uint(parsed), true
-		}
-	case int:
-		return uint(v), true
-	case int64:
-		return uint(v), true
-	case uint64:
-		return uint(v), true
-	}
-
-	return 0, false
-}
-
-// getStringFromContext extracts a string value from context
-func getStringFromContext(ctx context.Context, key string) (string, bool) {
-	val, exists := getValueFromContext(ctx, key)
-	if !exists {
-		return "", false
-	}
-
-	if str, ok := val.(string); ok {
-		return str, true
-	}
-
-	return "", false
-}
-
 // GetUserRoleFromContext extracts user role information from context
 // Works with both *gin.Context and context.Context
 func GetUserRoleFromContext(ctx context.Context) (roleLevel uint, roleName string, exists bool) {
-	roleLevel, exists = getUintFromContext(ctx, constants.ROLE_LEVEL_KEY)
+	roleLevel, exists = reqctx.RoleLevel.Get(ctx)
 	if !exists {
 		return 0, "", false
 	}
-	roleName, exists = getStringFromContext(ctx, constants.ROLE_NAME_KEY)
+	roleName, exists = reqctx.RoleName.Get(ctx)
 	if !exists {
 		return 0, "", false
 	}
@@ -95,25 +35,39 @@ func GetUserRoleFromContext(ctx context.Context) (roleLevel uint, roleName strin
 // GetSellerIDFromContext extracts seller ID from context
 // Works with both *gin.Context and context.Context
 func GetSellerIDFromContext(ctx context.Context) (sellerID uint, exists bool) {
-	return getUintFromContext(ctx, constants.SELLER_ID_KEY)
+	return reqctx.SellerID.Get(ctx)
+}
+
+// GetDelegatedTokenIDFromContext returns the delegated token used to authenticate
+// the request, if any. Absent for regular JWT sessions.
+func GetDelegatedTokenIDFromContext(ctx context.Context) (tokenID uint, exists bool) {
+	return reqctx.DelegatedTokenID.Get(ctx)
+}
+
+// GetImpersonationFromContext returns the admin and session behind an impersonated
+// request, if any. Absent for regular sessions.
+func GetImpersonationFromContext(
+	ctx context.Context,
+) (impersonation reqctx.ImpersonationInfo, exists bool) {
+	return reqctx.Impersonation.Get(ctx)
 }
 
 // GetUserRoleLevelFromContext extracts user role level from context
 // Works with both *gin.Context and context.Context
 func GetUserRoleLevelFromContext(ctx context.Context) (roleLevel uint, exists bool) {
-	return getUintFromContext(ctx, constants.ROLE_LEVEL_KEY)
+	return reqctx.RoleLevel.Get(ctx)
 }
 
 // GetUserIDFromContext extracts user ID from context
 // Works with both *gin.Context and context.Context
 func GetUserIDFromContext(ctx context.Context) (userID uint, exists bool) {
-	return getUintFromContext(ctx, constants.USER_ID_KEY)
+	return reqctx.UserID.Get(ctx)
 }
 
 // GetCorrelationIDFromContext extracts correlation ID from context
 // Works with both *gin.Context and context.Context
 func GetCorrelationIDFromContext(ctx context.Context) (correlationID string, exists bool) {
-	return getStringFromContext(ctx, constants.CORRELATION_ID_KEY)
+	return reqctx.CorrelationID.Get(ctx)
 }
 
 // ValidateUserHasSellerRoleOrHigherAndReturnAuthData validates that:
@@ -7,6 +7,9 @@ import (
 	"ecommerce-be/common"
 	"ecommerce-be/common/cache"
 	"ecommerce-be/common/constants"
+	"ecommerce-be/common/db"
+	"ecommerce-be/common/log"
+	"ecommerce-be/common/reqctx"
 
 	"github.com/gin-gonic/gin"
 )
@@ -43,6 +46,12 @@ func AuthMiddleware(secret string) gin.HandlerFunc {
 		// Parse and validate the token
 		tokenString := parts[1]
 
+		// Delegated access tokens are opaque grants resolved against the database
+		if IsDelegatedToken(tokenString) {
+			authenticateDelegatedToken(c, tokenString)
+			return
+		}
+
 		// Check if token is blacklisted
 		if cache.IsTokenBlacklisted(tokenString) {
 			common.ErrorWithCode(
@@ -124,14 +133,175 @@ func AuthMiddleware(secret string) gin.HandlerFunc {
 			return
 		}
 
+		// Reject tokens issued before the user's sessions were revoked (e.g. email change)
+		if claims.IssuedAt != nil && cache.AreUserSessionsRevoked(*claims.UserID, claims.IssuedAt.Time) {
+			common.ErrorWithCode(
+				c,
+				http.StatusUnauthorized,
+				constants.TOKEN_REVOKED_MSG,
+				constants.TOKEN_REVOKED_CODE,
+			)
+			c.Abort()
+			return
+		}
+
 		// Set user info in context (dereference pointers)
-		c.Set(constants.USER_ID_KEY, *claims.UserID)
-		c.Set(constants.EMAIL_KEY, *claims.Email)
-		c.Set(constants.ROLE_ID_KEY, *claims.RoleID)
-		c.Set(constants.ROLE_NAME_KEY, *claims.RoleName)
-		c.Set(constants.ROLE_LEVEL_KEY, *claims.RoleLevel)
-		if claims.SellerID != nil {
-			c.Set(constants.SELLER_ID_KEY, *claims.SellerID)
+		identity := reqctx.Identity{
+			UserID:    *claims.UserID,
+			Email:     *claims.Email,
+			RoleID:    *claims.RoleID,
+			RoleName:  *claims.RoleName,
+			RoleLevel: *claims.RoleLevel,
+			SellerID:  claims.SellerID,
+		}
+
+		// Impersonation tokens are bound to a session that is checked on every request
+		if claims.ImpersonationID != nil {
+			authenticateImpersonation(c, claims, identity)
+			return
 		}
+
+		reqctx.SetIdentity(c, identity)
+	}
+}
+
+// authenticateImpersonation validates the session behind an impersonation token,
+// blocks account-security endpoints and sets the impersonated seller's identity in
+// context. Every request with a live session is written to the session's audit trail.
+func authenticateImpersonation(c *gin.Context, claims *Claims, identity reqctx.Identity) {
+	if claims.ImpersonatorID == nil {
+		common.ErrorWithCode(
+			c,
+			http.StatusUnauthorized,
+			constants.IMPERSONATION_INVALID_MSG,
+			constants.IMPERSONATION_INVALID_CODE,
+		)
+		c.Abort()
+		return
+	}
+
+	database := db.GetDB()
+
+	result, err := ResolveImpersonationSession(
+		database,
+		*claims.ImpersonationID,
+		*claims.ImpersonatorID,
+		identity.UserID,
+	)
+	if err != nil {
+		common.ErrorWithCode(
+			c,
+			http.StatusUnauthorized,
+			constants.IMPERSONATION_INVALID_MSG,
+			constants.IMPERSONATION_INVALID_CODE,
+		)
+		c.Abort()
+		return
+	}
+
+	// Mark the response as impersonated before anything is written
+	reqctx.Impersonation.Set(c, reqctx.ImpersonationInfo{
+		SessionID:   result.SessionID,
+		AdminUserID: result.AdminUserID,
+		AdminEmail:  result.AdminEmail,
+	})
+	c.Header(constants.IMPERSONATED_BY_HEADER, result.AdminEmail)
+
+	method := c.Request.Method
+	path := c.Request.URL.Path
+	allowed := ImpersonationAllows(path)
+
+	action := constants.IMPERSONATION_AUDIT_ACTION_REQUEST
+	if !allowed {
+		action = constants.IMPERSONATION_AUDIT_ACTION_DENIED
+	}
+	if len(path) > 255 {
+		path = path[:255]
+	}
+	if auditErr := RecordImpersonationAccess(
+		database,
+		result,
+		action,
+		method,
+		path,
+		c.ClientIP(),
+	); auditErr != nil {
+		log.ErrorWithContext(c, "Failed to record impersonation access", auditErr)
+	}
+
+	if !allowed {
+		common.ErrorWithCode(
+			c,
+			http.StatusForbidden,
+			constants.IMPERSONATION_NOT_ALLOWED_MSG,
+			constants.IMPERSONATION_NOT_ALLOWED_CODE,
+		)
+		c.Abort()
+		return
+	}
+
+	reqctx.SetIdentity(c, identity)
+}
+
+// authenticateDelegatedToken validates a delegated access token, enforces its scopes
+// against the current request and sets the issuing seller's identity in context.
+// Every attempt with a resolvable token is written to the token's audit trail.
+func authenticateDelegatedToken(c *gin.Context, tokenString string) {
+	database := db.GetDB()
+
+	result, err := ResolveDelegatedToken(database, tokenString)
+	if err != nil {
+		common.ErrorWithCode(
+			c,
+			http.StatusUnauthorized,
+			constants.DELEGATED_TOKEN_INVALID_MSG,
+			constants.DELEGATED_TOKEN_INVALID_CODE,
+		)
+		c.Abort()
+		return
+	}
+
+	method := c.Request.Method
+	path := c.Request.URL.Path
+	allowed := DelegatedScopeAllows(result.Scopes, method, path)
+
+	action := constants.DELEGATED_AUDIT_ACTION_USED
+	if !allowed {
+		action = constants.DELEGATED_AUDIT_ACTION_DENIED
 	}
+	if len(path) > 255 {
+		path = path[:255]
+	}
+	if auditErr := RecordDelegatedTokenAccess(
+		database,
+		result,
+		action,
+		method,
+		path,
+		c.ClientIP(),
+	); auditErr != nil {
+		log.ErrorWithContext(c, "Failed to record delegated token access", auditErr)
+	}
+
+	if !allowed {
+		common.ErrorWithCode(
+			c,
+			http.StatusForbidden,
+			constants.DELEGATED_TOKEN_SCOPE_DENIED_MSG,
+			constants.DELEGATED_TOKEN_SCOPE_DENIED_CODE,
+		)
+		c.Abort()
+		return
+	}
+
+	// The token acts on behalf of the seller that issued it
+	reqctx.SetIdentity(c, reqctx.Identity{
+		UserID:    result.SellerID,
+		Email:     result.Email,
+		RoleID:    result.RoleID,
+		RoleName:  result.RoleName,
+		RoleLevel: result.RoleLevel,
+		SellerID:  &result.SellerID,
+	})
+	reqctx.DelegatedTokenID.Set(c, result.TokenID)
 }
@@ -0,0 +1,198 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"strings"
+
+	"ecommerce-be/common/constants"
+	"ecommerce-be/common/db"
+
+	"gorm.io/gorm"
+)
+
+/********************************************************************
+*		Delegated access tokens (USED IN MIDDLEWARE)				*
+*		Opaque, seller-minted tokens with scoped, expiring grants	*
+*********************************************************************/
+
+// delegatedTokenRandomBytes is the entropy of a generated token (256 bits)
+const delegatedTokenRandomBytes = 32
+
+// delegatedTokenDisplayLength is how many leading characters are kept for display
+const delegatedTokenDisplayLength = 12
+
+// delegatedScopeRule describes which requests a scope grants access to
+type delegatedScopeRule struct {
+	methods      []string
+	pathPrefixes []string
+}
+
+var (
+	readMethods  = []string{http.MethodGet, http.MethodHead}
+	writeMethods = []string{
+		http.MethodGet,
+		http.MethodHead,
+		http.MethodPost,
+		http.MethodPut,
+		http.MethodPatch,
+		http.MethodDelete,
+	}
+)
+
+// delegatedScopeRules maps every supported scope to the API surface it unlocks.
+// Anything not listed here (including token management itself) is never reachable
+// with a delegated token.
+var delegatedScopeRules = map[string]delegatedScopeRule{
+	constants.DELEGATED_SCOPE_CATALOG_READ: {
+		methods:      readMethods,
+		pathPrefixes: []string{constants.APIBaseProduct},
+	},
+	constants.DELEGATED_SCOPE_CATALOG_WRITE: {
+		methods:      writeMethods,
+		pathPrefixes: []string{constants.APIBaseProduct},
+	},
+	constants.DELEGATED_SCOPE_INVENTORY_READ: {
+		methods:      readMethods,
+		pathPrefixes: []string{constants.APIBaseInventory},
+	},
+	constants.DELEGATED_SCOPE_INVENTORY_WRITE: {
+		methods:      writeMethods,
+		pathPrefixes: []string{constants.APIBaseInventory},
+	},
+	constants.DELEGATED_SCOPE_ORDERS_READ: {
+		methods:      readMethods,
+		pathPrefixes: []string{constants.APIBaseOrder},
+	},
+	constants.DELEGATED_SCOPE_REPORTS_READ: {
+		methods:      readMethods,
+		pathPrefixes: []string{constants.APIBaseReport},
+	},
+}
+
+// IsValidDelegatedScope reports whether a scope is supported
+func IsValidDelegatedScope(scope string) bool {
+	_, ok := delegatedScopeRules[scope]
+	return ok
+}
+
+// DelegatedScopeAllows reports whether any of the granted scopes covers the request
+func DelegatedScopeAllows(scopes []string, method, path string) bool {
+	for _, scope := range scopes {
+		rule, ok := delegatedScopeRules[scope]
+		if !ok || !containsString(rule.methods, method) {
+			continue
+		}
+		for _, prefix := range rule.pathPrefixes {
+			if path == prefix || strings.HasPrefix(path, prefix+"/") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// IsDelegatedToken reports whether a bearer token is a delegated access token
+func IsDelegatedToken(token string) bool {
+	return strings.HasPrefix(token, constants.DELEGATED_TOKEN_PREFIX)
+}
+
+// GenerateDelegatedToken creates a new raw token and returns it together with
+// its storage hash and a short display prefix. Only the hash should be persisted.
+func GenerateDelegatedToken() (token, hash, displayPrefix string, err error) {
+	buf := make([]byte, delegatedTokenRandomBytes)
+	if _, err = rand.Read(buf); err != nil {
+		return "", "", "", err
+	}
+	token = constants.DELEGATED_TOKEN_PREFIX + base64.RawURLEncoding.EncodeToString(buf)
+	return token, HashDelegatedToken(token), token[:delegatedTokenDisplayLength], nil
+}
+
+// HashDelegatedToken returns the hex SHA-256 digest used to look a token up
+func HashDelegatedToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// DelegatedTokenResult contains the grant and the identity of the issuing seller
+type DelegatedTokenResult struct {
+	TokenID   uint           `gorm:"column:token_id"`
+	SellerID  uint           `gorm:"column:seller_id"`
+	Email     string         `gorm:"column:email"`
+	RoleID    uint           `gorm:"column:role_id"`
+	RoleName  string         `gorm:"column:role_name"`
+	RoleLevel uint           `gorm:"column:role_level"`
+	Scopes    db.StringArray `gorm:"column:scopes"`
+}
+
+// ResolveDelegatedToken looks up an active (not expired, not revoked) delegated
+// token issued by an active seller. It is intentionally not cached so that
+// revocation takes effect immediately.
+func ResolveDelegatedToken(database *gorm.DB, token string) (*DelegatedTokenResult, error) {
+	var result DelegatedTokenResult
+	query := `
+		SELECT
+			t.id as token_id,
+			t.seller_id as seller_id,
+			t.scopes as scopes,
+			u.email as email,
+			r.id as role_id,
+			r.name as role_name,
+			r.level as role_level
+		FROM delegated_access_token t
+		JOIN "user" u ON u.id = t.seller_id
+		JOIN role r ON r.id = u.role_id
+		WHERE t.token_hash = ?
+			AND t.revoked_at IS NULL
+			AND t.expires_at > NOW()
+			AND u.is_active = TRUE
+		LIMIT 1
+	`
+
+	if err := database.Raw(query, HashDelegatedToken(token)).Scan(&result).Error; err != nil {
+		return nil, err
+	}
+	if result.TokenID == 0 {
+		return nil, errors.New(constants.DELEGATED_TOKEN_INVALID_MSG)
+	}
+	return &result, nil
+}
+
+// RecordDelegatedTokenAccess writes an audit entry for a request made with a
+// delegated token and, for allowed requests, refreshes last_used_at.
+func RecordDelegatedTokenAccess(
+	database *gorm.DB,
+	result *DelegatedTokenResult,
+	action, method, path, ipAddress string,
+) error {
+	return database.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec(
+			`INSERT INTO delegated_access_token_audit
+				(token_id, seller_id, action, method, path, ip_address, created_at)
+			VALUES (?, ?, ?, ?, ?, ?, NOW())`,
+			result.TokenID, result.SellerID, action, method, path, ipAddress,
+		).Error; err != nil {
+			return err
+		}
+		if action != constants.DELEGATED_AUDIT_ACTION_USED {
+			return nil
+		}
+		return tx.Exec(
+			`UPDATE delegated_access_token SET last_used_at = NOW() WHERE id = ?`,
+			result.TokenID,
+		).Error
+	})
+}
+
+func containsString(values []string, target string) bool {
+	for _, v := range values {
+		if v == target {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,124 @@
+package auth
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"ecommerce-be/common/constants"
+
+	"github.com/golang-jwt/jwt/v5"
+	"gorm.io/gorm"
+)
+
+/********************************************************************
+*		Admin impersonation of sellers (USED IN MIDDLEWARE)			*
+*		Short-lived JWTs bound to an auditable session				*
+*********************************************************************/
+
+// impersonationDeniedPrefixes are account-security endpoints an impersonating admin
+// must never reach: refreshing or logging out the seller's sessions, changing their
+// email or password and minting delegated tokens on their behalf.
+var impersonationDeniedPrefixes = []string{
+	constants.APIBaseUser + "/auth",
+	constants.APIBaseUser + "/password",
+	constants.APIBaseUser + "/seller/delegated-tokens",
+}
+
+// ImpersonationAllows reports whether an impersonation token may be used for a path
+func ImpersonationAllows(path string) bool {
+	for _, prefix := range impersonationDeniedPrefixes {
+		if path == prefix || strings.HasPrefix(path, prefix+"/") {
+			return false
+		}
+	}
+	return true
+}
+
+// GenerateImpersonationToken issues a JWT carrying the seller's identity for an
+// impersonation session. Unlike GenerateToken it expires with the session.
+func GenerateImpersonationToken(
+	sellerInfo TokenUserInfo,
+	sessionID uint,
+	adminUserID uint,
+	expiresAt time.Time,
+	secret string,
+) (string, error) {
+	claims := Claims{
+		UserID:          &sellerInfo.UserID,
+		Email:           &sellerInfo.Email,
+		RoleID:          &sellerInfo.RoleID,
+		RoleName:        &sellerInfo.RoleName,
+		RoleLevel:       &sellerInfo.RoleLevel,
+		SellerID:        sellerInfo.SellerID,
+		ImpersonationID: &sessionID,
+		ImpersonatorID:  &adminUserID,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+		},
+	}
+
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(secret))
+}
+
+// ImpersonationSessionResult contains an active session and the impersonating admin
+type ImpersonationSessionResult struct {
+	SessionID   uint   `gorm:"column:session_id"`
+	AdminUserID uint   `gorm:"column:admin_user_id"`
+	AdminEmail  string `gorm:"column:admin_email"`
+	SellerID    uint   `gorm:"column:seller_id"`
+}
+
+// ResolveImpersonationSession looks up an active (not expired, not ended) session
+// that matches the token's admin and seller. Like delegated tokens it is not
+// cached, so ending a session takes effect immediately.
+func ResolveImpersonationSession(
+	database *gorm.DB,
+	sessionID uint,
+	adminUserID uint,
+	sellerID uint,
+) (*ImpersonationSessionResult, error) {
+	var result ImpersonationSessionResult
+	query := `
+		SELECT
+			s.id as session_id,
+			s.admin_user_id as admin_user_id,
+			a.email as admin_email,
+			s.seller_id as seller_id
+		FROM impersonation_session s
+		JOIN "user" a ON a.id = s.admin_user_id
+		WHERE s.id = ?
+			AND s.admin_user_id = ?
+			AND s.seller_id = ?
+			AND s.ended_at IS NULL
+			AND s.expires_at > NOW()
+			AND a.is_active = TRUE
+		LIMIT 1
+	`
+
+	if err := database.Raw(query, sessionID, adminUserID, sellerID).Scan(&result).Error; err != nil {
+		return nil, err
+	}
+	if result.SessionID == 0 {
+		return nil, errors.New(constants.IMPERSONATION_INVALID_MSG)
+	}
+	return &result, nil
+}
+
+// RecordImpersonationAccess writes an audit entry for a request made during an
+// impersonation session
+func RecordImpersonationAccess(
+	database *gorm.DB,
+	result *ImpersonationSessionResult,
+	action, method, path, ipAddress string,
+) error {
+	return database.Exec(
+		`INSERT INTO impersonation_audit
+			(session_id, admin_user_id, seller_id, action, actor_user_id,
+				method, path, ip_address, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, NOW())`,
+		result.SessionID, result.AdminUserID, result.SellerID, action, result.AdminUserID,
+		method, path, ipAddress,
+	).Error
+}
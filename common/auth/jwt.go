@@ -19,6 +19,10 @@ type Claims struct {
 	RoleName  *string `json:"role_name"`           // Required - pointer to detect missing field
 	RoleLevel *uint   `json:"role_level"`          // Required - pointer to detect missing field
 	SellerID  *uint   `json:"seller_id,omitempty"` // Optional - only for seller-related users
+
+	// Optional - only set on tokens issued to an admin acting as a seller
+	ImpersonationID *uint `json:"impersonation_id,omitempty"`
+	ImpersonatorID  *uint `json:"impersonator_id,omitempty"`
 	jwt.RegisteredClaims
 }
 
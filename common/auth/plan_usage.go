@@ -0,0 +1,31 @@
+package auth
+
+import (
+	"ecommerce-be/common/constants"
+
+	"gorm.io/gorm"
+)
+
+// planUsageQueries count what a seller's catalog holds of each resource a plan limits
+var planUsageQueries = map[string]string{
+	constants.PLAN_LIMIT_PRODUCTS: `SELECT COUNT(*) FROM product WHERE seller_id = ?`,
+	constants.PLAN_LIMIT_VARIANTS: `
+		SELECT COUNT(*)
+		FROM product_variant pv
+		JOIN product p ON p.id = pv.product_id
+		WHERE p.seller_id = ?`,
+}
+
+// CountPlanUsage returns how many of the plan-limited resource the seller has. Resources
+// plans do not limit count as zero.
+func CountPlanUsage(database *gorm.DB, sellerID uint, resource string) (int64, error) {
+	query, ok := planUsageQueries[resource]
+	if !ok {
+		return 0, nil
+	}
+	var count int64
+	if err := database.Raw(query, sellerID).Scan(&count).Error; err != nil {
+		return 0, err
+	}
+	return count, nil
+}
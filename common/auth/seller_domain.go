@@ -0,0 +1,63 @@
+package auth
+
+import (
+	"net"
+	"strconv"
+	"strings"
+
+	"ecommerce-be/common/cache"
+	"ecommerce-be/common/constants"
+
+	"gorm.io/gorm"
+)
+
+/********************************************************************
+*		Custom storefront domains (USED IN MIDDLEWARE)				*
+*		Resolves the seller of a public request from its Host		*
+*********************************************************************/
+
+// NormalizeHost reduces a Host header or domain to the form stored in seller_domain:
+// lowercased, without port and without a trailing dot. Returns "" for an empty host.
+func NormalizeHost(host string) string {
+	host = strings.TrimSpace(host)
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	host = strings.TrimSuffix(host, ".")
+	return strings.ToLower(host)
+}
+
+// ResolveSellerIDByHostCached returns the seller mapped to the request host, or 0
+// if the host is not a seller's storefront domain. Results are cached in Redis;
+// unmapped hosts only briefly, so a newly added domain starts resolving quickly
+// even if the explicit invalidation is missed.
+func ResolveSellerIDByHostCached(database *gorm.DB, host string) (uint, error) {
+	domain := NormalizeHost(host)
+	if domain == "" {
+		return 0, nil
+	}
+	cacheKey := constants.SELLER_DOMAIN_CACHE_KEY + domain
+
+	if cached, err := cache.Get(cacheKey); err == nil {
+		if sellerID, parseErr := strconv.ParseUint(cached, 10, 64); parseErr == nil {
+			return uint(sellerID), nil
+		}
+	}
+
+	var sellerID uint
+	err := database.Raw(
+		`SELECT seller_id FROM seller_domain WHERE domain = ? LIMIT 1`,
+		domain,
+	).Scan(&sellerID).Error
+	if err != nil {
+		return 0, err
+	}
+
+	expiration := constants.SELLER_CACHE_EXPIRATION
+	if sellerID == 0 {
+		expiration = constants.SELLER_CACHE_SHORT_EXPIRATION
+	}
+	cache.Set(cacheKey, strconv.FormatUint(uint64(sellerID), 10), expiration)
+
+	return sellerID, nil
+}
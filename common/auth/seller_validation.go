@@ -8,6 +8,8 @@ import (
 
 	"ecommerce-be/common/cache"
 	"ecommerce-be/common/constants"
+	"ecommerce-be/common/db"
+	"ecommerce-be/common/reqctx"
 
 	"gorm.io/gorm"
 )
@@ -26,6 +28,11 @@ type SellerValidationResult struct {
 	PlanID              uint       `json:"planId"`
 	PlanName            string     `json:"planName"`
 	ValidationTimestamp time.Time  `json:"validationTimestamp"`
+
+	// Catalog limits (nil = unlimited) and features of the seller's plan
+	PlanMaxProducts *int       `json:"planMaxProducts"`
+	PlanMaxVariants *int       `json:"planMaxVariants"`
+	PlanFeatures    db.JSONMap `json:"planFeatures"`
 }
 
 // IsSubscriptionActive checks if the subscription is currently active
@@ -60,6 +67,24 @@ func (svr *SellerValidationResult) ValidateForAccess() error {
 	return nil
 }
 
+// PlanLimit returns the plan's limit on a catalog resource, nil when it is unlimited
+func (svr *SellerValidationResult) PlanLimit(resource string) *int {
+	switch resource {
+	case constants.PLAN_LIMIT_PRODUCTS:
+		return svr.PlanMaxProducts
+	case constants.PLAN_LIMIT_VARIANTS:
+		return svr.PlanMaxVariants
+	default:
+		return nil
+	}
+}
+
+// HasPlanFeature reports whether the seller's plan includes the feature
+func (svr *SellerValidationResult) HasPlanFeature(feature string) bool {
+	included, _ := svr.PlanFeatures[feature].(bool)
+	return included
+}
+
 func ValidateSellerCompleteCached(db *gorm.DB, sellerID uint) (*SellerValidationResult, error) {
 	cacheKey := fmt.Sprintf("%s%d", constants.SELLER_COMPLETE_CACHE_KEY, sellerID)
 
@@ -79,14 +104,21 @@ func ValidateSellerCompleteCached(db *gorm.DB, sellerID uint) (*SellerValidation
 			u.id as seller_id,
 			u.is_active as is_active,
 			COALESCE(s.status, 'unpaid') as subscription_status,
-			s.end_date as subscription_end_date,
+			CASE WHEN LOWER(s.status) = 'past_due'
+				THEN GREATEST(s.end_date, s.grace_ends_at)
+				ELSE s.end_date
+			END as subscription_end_date,
 			COALESCE(p.id, 0) as plan_id,
 			COALESCE(p.name, '') as plan_name,
+			p.max_products as plan_max_products,
+			p.max_variants as plan_max_variants,
+			COALESCE(p.features, '{}'::jsonb) as plan_features,
 			NOW() as validation_timestamp
 		FROM "user" u
 		LEFT JOIN subscription s ON u.id = s.seller_id 
 			AND LOWER(s.status) IN ('active', 'trialing', 'past_due')
-			AND (s.end_date IS NULL OR s.end_date > NOW())
+			AND (s.end_date IS NULL OR s.end_date > NOW()
+				OR (LOWER(s.status) = 'past_due' AND s.grace_ends_at > NOW()))
 		LEFT JOIN plan p ON s.plan_id = p.id
 		WHERE u.id = ? AND u.role_id = (SELECT id FROM role WHERE UPPER(name) = 'SELLER' LIMIT 1)
 	`
@@ -144,3 +176,7 @@ func ValidateSellerDetailsOptimized(db *gorm.DB, sellerID uint) error {
 func GetSellerValidationData(db *gorm.DB, sellerID uint) (*SellerValidationResult, error) {
 	return ValidateSellerCompleteCached(db, sellerID)
 }
+
+// SellerValidation is the validated seller data the seller and customer auth
+// middleware store on the request
+var SellerValidation = reqctx.NewKey[*SellerValidationResult]("seller_validation_data")
@@ -22,6 +22,19 @@ func InvalidateSellerDetailsCache(sellerID uint) error {
 	return Del(cacheKey)
 }
 
+// InvalidateSellerCompleteCache invalidates the validation data the seller middleware
+// caches, so a changed subscription or plan applies on the seller's next request
+func InvalidateSellerCompleteCache(sellerID uint) error {
+	cacheKey := fmt.Sprintf("%s%d", constants.SELLER_COMPLETE_CACHE_KEY, sellerID)
+	return Del(cacheKey)
+}
+
+// InvalidateSellerDomainCache invalidates the seller a custom storefront domain
+// resolves to, so an added or removed mapping applies on the next public request
+func InvalidateSellerDomainCache(domain string) error {
+	return Del(constants.SELLER_DOMAIN_CACHE_KEY + domain)
+}
+
 // InvalidateAllSellerCache invalidates both subscription and details cache for a seller
 func InvalidateAllSellerCache(sellerID uint) error {
 	if err := InvalidateSellerSubscriptionCache(sellerID); err != nil {
@@ -0,0 +1,88 @@
+package cache
+
+import (
+	"container/list"
+	"sync"
+	"time"
+
+	"ecommerce-be/common/constants"
+)
+
+/****************************************************
+*			In-process LRU (first cache level)		*
+*****************************************************/
+
+type localEntry struct {
+	key       string
+	value     []byte
+	expiresAt time.Time
+}
+
+// localLRU is a size-bounded in-process cache of encoded values. It sits in front
+// of Redis for keys that are read far more often than they change.
+type localLRU struct {
+	mu       sync.Mutex
+	capacity int
+	order    *list.List // front = most recently used
+	entries  map[string]*list.Element
+}
+
+func newLocalLRU(capacity int) *localLRU {
+	return &localLRU{
+		capacity: capacity,
+		order:    list.New(),
+		entries:  make(map[string]*list.Element),
+	}
+}
+
+var local = newLocalLRU(constants.LOCAL_CACHE_MAX_ENTRIES)
+
+func (c *localLRU) get(key string) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	entry := elem.Value.(*localEntry)
+	if time.Now().After(entry.expiresAt) {
+		c.order.Remove(elem)
+		delete(c.entries, key)
+		return nil, false
+	}
+	c.order.MoveToFront(elem)
+	return entry.value, true
+}
+
+func (c *localLRU) set(key string, value []byte, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	expiresAt := time.Now().Add(ttl)
+	if elem, ok := c.entries[key]; ok {
+		entry := elem.Value.(*localEntry)
+		entry.value, entry.expiresAt = value, expiresAt
+		c.order.MoveToFront(elem)
+		return
+	}
+	c.entries[key] = c.order.PushFront(&localEntry{key: key, value: value, expiresAt: expiresAt})
+	for c.order.Len() > c.capacity {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*localEntry).key)
+	}
+}
+
+func (c *localLRU) clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.order.Init()
+	c.entries = make(map[string]*list.Element)
+}
+
+// ClearLocal drops every entry of the in-process cache level. Entries expire on
+// their own; this is for tests and for callers that cannot wait for the local TTL.
+func ClearLocal() {
+	local.clear()
+}
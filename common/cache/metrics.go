@@ -0,0 +1,73 @@
+package cache
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// NamespaceStats is the hit/miss count of one read-through cache namespace since start-up
+type NamespaceStats struct {
+	Namespace string  `json:"namespace"`
+	Hits      int64   `json:"hits"`
+	LocalHits int64   `json:"localHits"` // hits served by the in-process level, included in Hits
+	Misses    int64   `json:"misses"`
+	HitRatio  float64 `json:"hitRatio"`
+}
+
+type counters struct {
+	hits      atomic.Int64
+	localHits atomic.Int64
+	misses    atomic.Int64
+}
+
+var namespaceCounters sync.Map // namespace -> *counters
+
+func countersFor(namespace string) *counters {
+	if c, ok := namespaceCounters.Load(namespace); ok {
+		return c.(*counters)
+	}
+	c, _ := namespaceCounters.LoadOrStore(namespace, &counters{})
+	return c.(*counters)
+}
+
+func recordHit(namespace string) {
+	countersFor(namespace).hits.Add(1)
+}
+
+func recordLocalHit(namespace string) {
+	c := countersFor(namespace)
+	c.hits.Add(1)
+	c.localHits.Add(1)
+}
+
+func recordMiss(namespace string) {
+	countersFor(namespace).misses.Add(1)
+}
+
+// Stats returns the counters of every namespace starting with prefix, sorted by
+// namespace. An empty prefix returns all namespaces.
+func Stats(prefix string) []NamespaceStats {
+	stats := make([]NamespaceStats, 0)
+	namespaceCounters.Range(func(key, value any) bool {
+		namespace := key.(string)
+		if !strings.HasPrefix(namespace, prefix) {
+			return true
+		}
+		c := value.(*counters)
+		s := NamespaceStats{
+			Namespace: namespace,
+			Hits:      c.hits.Load(),
+			LocalHits: c.localHits.Load(),
+			Misses:    c.misses.Load(),
+		}
+		if total := s.Hits + s.Misses; total > 0 {
+			s.HitRatio = float64(s.Hits) / float64(total)
+		}
+		stats = append(stats, s)
+		return true
+	})
+	sort.Slice(stats, func(i, j int) bool { return stats[i].Namespace < stats[j].Namespace })
+	return stats
+}
@@ -0,0 +1,216 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/rand/v2"
+	"strconv"
+	"strings"
+	"time"
+
+	"ecommerce-be/common/constants"
+	"ecommerce-be/common/db"
+
+	"golang.org/x/sync/singleflight"
+)
+
+/****************************************************
+*			Read-through cache with versioned keys	*
+*****************************************************/
+
+// loadGroup collapses concurrent loads of the same key into one source call
+var loadGroup singleflight.Group
+
+// LoadOption tunes a single GetOrLoad call
+type LoadOption func(*loadOptions)
+
+type loadOptions struct {
+	localTTL time.Duration
+	hit      *bool
+}
+
+// WithLocal also keeps the value in the in-process LRU for ttl, in front of Redis.
+// Use it for hot keys whose values may be served up to ttl stale on other
+// instances, or whose keys are versioned.
+func WithLocal(ttl time.Duration) LoadOption {
+	return func(o *loadOptions) {
+		o.localTTL = ttl
+	}
+}
+
+// ReportHit sets *hit to whether the value was served from the cache. A caller that
+// waited on a load shared with others gets false, as nothing was cached for it.
+func ReportHit(hit *bool) LoadOption {
+	return func(o *loadOptions) {
+		o.hit = hit
+	}
+}
+
+// GetOrLoad returns the cached value of key, calling load and caching its result on
+// a miss. Values are stored as JSON. Concurrent misses of the same key in this
+// process share one load call, and the TTL is jittered so keys written together
+// do not expire together. Redis being down is not an error: the value is loaded
+// from the source and the lookup counted as a miss of namespace.
+//
+// The shared load gets ctx without its cancellation, bounded by CACHE_LOAD_TIMEOUT,
+// so a caller that gives up does not fail the load for the others waiting on it. A
+// caller whose ctx ends stops waiting and gets its error. The load also runs outside
+// the caller's transaction, so it never caches rows that may yet be rolled back.
+func GetOrLoad[T any](
+	ctx context.Context,
+	namespace, key string,
+	ttl time.Duration,
+	load func(ctx context.Context) (T, error),
+	opts ...LoadOption,
+) (T, error) {
+	var options loadOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+	if options.hit != nil {
+		*options.hit = false
+	}
+
+	if options.localTTL > 0 {
+		if cached, ok := local.get(key); ok {
+			var value T
+			if err := json.Unmarshal(cached, &value); err == nil {
+				recordLocalHit(namespace)
+				options.reportHit()
+				return value, nil
+			}
+		}
+	}
+	if cached, err := Get(key); err == nil && cached != "" {
+		var value T
+		if err := json.Unmarshal([]byte(cached), &value); err == nil {
+			if options.localTTL > 0 {
+				local.set(key, []byte(cached), options.localTTL)
+			}
+			recordHit(namespace)
+			options.reportHit()
+			return value, nil
+		}
+	}
+	recordMiss(namespace)
+
+	loaded := loadGroup.DoChan(key, func() (any, error) {
+		loadCtx, cancel := context.WithTimeout(
+			db.WithoutTransaction(context.WithoutCancel(ctx)),
+			constants.CACHE_LOAD_TIMEOUT,
+		)
+		defer cancel()
+
+		value, err := load(loadCtx)
+		if err != nil {
+			return nil, err
+		}
+		bytes, err := json.Marshal(value)
+		if err != nil {
+			// Not cacheable, but still a valid result for this caller
+			return value, nil
+		}
+		_ = Set(key, string(bytes), JitterTTL(ttl))
+		if options.localTTL > 0 {
+			local.set(key, bytes, options.localTTL)
+		}
+		return bytes, nil
+	})
+
+	var result any
+	select {
+	case res := <-loaded:
+		if res.Err != nil {
+			var zero T
+			return zero, res.Err
+		}
+		result = res.Val
+	case <-ctx.Done():
+		var zero T
+		return zero, ctx.Err()
+	}
+
+	bytes, ok := result.([]byte)
+	if !ok {
+		value, _ := result.(T)
+		return value, nil
+	}
+	// Every caller decodes its own copy so callers never share a mutable value
+	var value T
+	err := json.Unmarshal(bytes, &value)
+	return value, err
+}
+
+func (o *loadOptions) reportHit() {
+	if o.hit != nil {
+		*o.hit = true
+	}
+}
+
+// JitterTTL spreads ttl randomly by up to CACHE_TTL_JITTER_FRACTION either way
+func JitterTTL(ttl time.Duration) time.Duration {
+	spread := int64(float64(ttl) * constants.CACHE_TTL_JITTER_FRACTION)
+	if spread <= 0 {
+		return ttl
+	}
+	return ttl + time.Duration(rand.Int64N(2*spread+1)-spread)
+}
+
+// NamespaceVersion returns the current version of a namespace, 0 when it has never
+// been bumped or Redis is unavailable. The version is kept in process for
+// CACHE_VERSION_LOCAL_TTL so hot lookups do not read it from Redis every time.
+func NamespaceVersion(namespace string) int64 {
+	versionKey := constants.CACHE_VERSION_KEY_PREFIX + namespace
+	if cached, ok := local.get(versionKey); ok {
+		if version, err := strconv.ParseInt(string(cached), 10, 64); err == nil {
+			return version
+		}
+	}
+
+	var version int64
+	if value, err := Get(versionKey); err == nil {
+		if _, err := fmt.Sscan(value, &version); err != nil {
+			version = 0
+		}
+	}
+	rememberNamespaceVersion(versionKey, version)
+	return version
+}
+
+// BumpNamespaceVersion moves a namespace to a new version. Keys built for the old
+// version are never read again and expire with their TTL. This process sees the new
+// version at once, other instances within CACHE_VERSION_LOCAL_TTL.
+func BumpNamespaceVersion(namespace string) error {
+	client, err := GetRedisClient()
+	if err != nil {
+		return err
+	}
+	versionKey := constants.CACHE_VERSION_KEY_PREFIX + namespace
+	version, err := client.Incr(ctx, versionKey).Result()
+	if err != nil {
+		return err
+	}
+	rememberNamespaceVersion(versionKey, version)
+	return nil
+}
+
+// rememberNamespaceVersion keeps a namespace version in the in-process level
+func rememberNamespaceVersion(versionKey string, version int64) {
+	local.set(
+		versionKey,
+		[]byte(strconv.FormatInt(version, 10)),
+		constants.CACHE_VERSION_LOCAL_TTL,
+	)
+}
+
+// VersionedKey builds the key of parts under the current version of namespace,
+// e.g. product:category_tree:v3:seller:7
+func VersionedKey(namespace string, parts ...any) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s:v%d", namespace, NamespaceVersion(namespace))
+	for _, part := range parts {
+		fmt.Fprintf(&b, ":%v", part)
+	}
+	return b.String()
+}
@@ -2,7 +2,11 @@ package cache
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"errors"
+	"fmt"
+	"os"
 	"time"
 
 	"ecommerce-be/common/config"
@@ -12,27 +16,114 @@ import (
 )
 
 var (
-	redisClient *redis.Client
+	redisClient redis.UniversalClient
 	ctx         = context.Background()
 )
 
 // ConnectRedis initializes the Redis client using the provided configuration.
+// Standalone, sentinel and cluster topologies are served through the same
+// redis.UniversalClient, so callers don't care which one is configured.
 func ConnectRedis(cfg *config.Config) error {
-	redisClient = redis.NewClient(&redis.Options{
-		Addr:     cfg.Redis.Addr,
-		Password: cfg.Redis.Password,
-		DB:       cfg.Redis.DB,
-	})
-
+	client, err := NewRedisClient(cfg.Redis)
+	if err != nil {
+		return err
+	}
+	redisClient = client
 	return nil
 }
 
-func SetRedisClient(client *redis.Client) {
+// NewRedisClient builds a client for the topology selected by cfg.Mode.
+// Sentinel clients follow the master across failovers and cluster clients
+// follow MOVED/ASK redirects, both retrying up to cfg.MaxRetries times.
+func NewRedisClient(cfg config.RedisConfig) (redis.UniversalClient, error) {
+	opts, err := NewRedisOptions(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	switch cfg.Mode {
+	case config.REDIS_MODE_CLUSTER:
+		return redis.NewClusterClient(opts.Cluster()), nil
+	case config.REDIS_MODE_SENTINEL:
+		failover := opts.Failover()
+		if cfg.ReadFromReplicas {
+			// Reads go to the closest of master and replicas, writes to the master
+			failover.RouteByLatency = true
+			return redis.NewFailoverClusterClient(failover), nil
+		}
+		return redis.NewFailoverClient(failover), nil
+	default:
+		return redis.NewClient(opts.Simple()), nil
+	}
+}
+
+// NewRedisOptions maps the Redis configuration onto go-redis universal options
+func NewRedisOptions(cfg config.RedisConfig) (*redis.UniversalOptions, error) {
+	opts := &redis.UniversalOptions{
+		Addrs:            cfg.NodeAddrs(),
+		Username:         cfg.Username,
+		Password:         cfg.Password,
+		DB:               cfg.DB,
+		SentinelPassword: cfg.SentinelPassword,
+		PoolSize:         cfg.PoolSize,
+		MinIdleConns:     cfg.MinIdleConns,
+		PoolTimeout:      cfg.PoolTimeout,
+		DialTimeout:      cfg.DialTimeout,
+		ReadTimeout:      cfg.ReadTimeout,
+		WriteTimeout:     cfg.WriteTimeout,
+		MaxRetries:       cfg.MaxRetries,
+	}
+
+	if cfg.Mode == config.REDIS_MODE_SENTINEL {
+		opts.MasterName = cfg.MasterName
+	}
+	if cfg.Mode == config.REDIS_MODE_CLUSTER && cfg.ReadFromReplicas {
+		opts.ReadOnly = true
+		opts.RouteByLatency = true
+	}
+
+	if cfg.TLSEnabled {
+		tlsConfig, err := newRedisTLSConfig(cfg)
+		if err != nil {
+			return nil, err
+		}
+		opts.TLSConfig = tlsConfig
+	}
+	return opts, nil
+}
+
+// newRedisTLSConfig trusts the system roots plus the optional CA bundle
+func newRedisTLSConfig(cfg config.RedisConfig) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		ServerName:         cfg.TLSServerName,
+		InsecureSkipVerify: cfg.TLSInsecureSkipVerify,
+	}
+	if cfg.TLSCAFile == "" {
+		return tlsConfig, nil
+	}
+
+	pem, err := os.ReadFile(cfg.TLSCAFile)
+	if err != nil {
+		return nil, fmt.Errorf("read REDIS_TLS_CA_FILE: %w", err)
+	}
+	pool, err := x509.SystemCertPool()
+	if err != nil || pool == nil {
+		pool = x509.NewCertPool()
+	}
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, errors.New("REDIS_TLS_CA_FILE contains no valid certificates")
+	}
+	tlsConfig.RootCAs = pool
+	return tlsConfig, nil
+}
+
+func SetRedisClient(client redis.UniversalClient) {
 	redisClient = client
 }
 
 // GetRedisClient returns the Redis client instance
-func GetRedisClient() (*redis.Client, error) {
+func GetRedisClient() (redis.UniversalClient, error) {
 	if redisClient == nil {
 		return nil, errors.New(constants.REDIS_NOT_INITIALIZED_MSG)
 	}
@@ -63,6 +154,24 @@ func Del(key string) error {
 	return redisClient.Del(ctx, key).Err()
 }
 
+// IncrementCounter increments a counter that expires window after its first increment
+// and returns the new count
+func IncrementCounter(key string, window time.Duration) (int64, error) {
+	if redisClient == nil {
+		return 0, errors.New(constants.REDIS_NOT_INITIALIZED_MSG)
+	}
+	count, err := redisClient.Incr(ctx, key).Result()
+	if err != nil {
+		return 0, err
+	}
+	if count == 1 {
+		if err := redisClient.Expire(ctx, key, window).Err(); err != nil {
+			return count, err
+		}
+	}
+	return count, nil
+}
+
 // BlacklistToken stores a token in Redis with an expiration time
 func BlacklistToken(token string, expiration time.Duration) error {
 	client, err := GetRedisClient()
@@ -88,6 +197,35 @@ func IsTokenBlacklisted(token string) bool {
 	return result == "blacklisted"
 }
 
+// RevokeUserSessions invalidates every token issued to a user until now. The marker
+// lives as long as the longest-lived token it has to outlast.
+func RevokeUserSessions(userID uint, expiration time.Duration) error {
+	client, err := GetRedisClient()
+	if err != nil {
+		return err
+	}
+
+	key := fmt.Sprintf("%s%d", constants.USER_SESSIONS_REVOKED_KEY_PREFIX, userID)
+	return client.Set(ctx, key, time.Now().Unix(), expiration).Err()
+}
+
+// AreUserSessionsRevoked checks if a token issued at issuedAt was revoked by a later
+// RevokeUserSessions call. Tokens issued in the same second stay valid.
+func AreUserSessionsRevoked(userID uint, issuedAt time.Time) bool {
+	client, err := GetRedisClient()
+	if err != nil {
+		return false
+	}
+
+	key := fmt.Sprintf("%s%d", constants.USER_SESSIONS_REVOKED_KEY_PREFIX, userID)
+	revokedAt, err := client.Get(ctx, key).Int64()
+	if err != nil {
+		return false
+	}
+
+	return issuedAt.Unix() < revokedAt
+}
+
 // CloseRedis closes the Redis connection gracefully
 func CloseRedis() {
 	if redisClient != nil {
@@ -0,0 +1,125 @@
+// Package calendar renders iCalendar (RFC 5545) invites shared by every module that
+// needs to put an appointment, such as a delivery slot, in a customer's calendar.
+package calendar
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"ecommerce-be/common/constants"
+)
+
+const (
+	icsTimeLayout = "20060102T150405Z"
+	// icsMaxLineOctets is the RFC 5545 content line limit before folding
+	icsMaxLineOctets = 75
+)
+
+// Method is the iTIP method of an invite.
+type Method string
+
+const (
+	METHOD_REQUEST Method = "REQUEST"
+	METHOD_CANCEL  Method = "CANCEL"
+)
+
+// Event is a single calendar appointment. UID must stay stable for the lifetime of the
+// appointment and Sequence must grow on every change, so calendar clients replace the
+// invite they already hold instead of adding a second one.
+type Event struct {
+	UID         string    `json:"uid"`
+	Sequence    int       `json:"sequence"`
+	Start       time.Time `json:"start"`
+	End         time.Time `json:"end"`
+	Summary     string    `json:"summary"`
+	Description string    `json:"description,omitempty"`
+	Location    string    `json:"location,omitempty"`
+	Cancelled   bool      `json:"cancelled,omitempty"`
+}
+
+// Method returns CANCEL for cancelled events and REQUEST otherwise.
+func (e Event) Method() Method {
+	if e.Cancelled {
+		return METHOD_CANCEL
+	}
+	return METHOD_REQUEST
+}
+
+// ContentType returns the MIME type of the rendered invite, including its method.
+func (e Event) ContentType() string {
+	return "text/calendar; charset=UTF-8; method=" + string(e.Method())
+}
+
+// Render builds the iCalendar document for event, stamped at now. Times are written
+// in UTC so no VTIMEZONE block is needed.
+func Render(event Event, now time.Time) []byte {
+	status := "CONFIRMED"
+	if event.Cancelled {
+		status = "CANCELLED"
+	}
+
+	var b strings.Builder
+	writeLine(&b, "BEGIN:VCALENDAR")
+	writeLine(&b, "VERSION:2.0")
+	writeLine(&b, "PRODID:"+constants.CALENDAR_PRODUCT_ID)
+	writeLine(&b, "CALSCALE:GREGORIAN")
+	writeLine(&b, "METHOD:"+string(event.Method()))
+	writeLine(&b, "BEGIN:VEVENT")
+	writeLine(&b, "UID:"+escapeText(event.UID))
+	writeLine(&b, "SEQUENCE:"+strconv.Itoa(event.Sequence))
+	writeLine(&b, "DTSTAMP:"+formatTime(now))
+	writeLine(&b, "DTSTART:"+formatTime(event.Start))
+	writeLine(&b, "DTEND:"+formatTime(event.End))
+	writeLine(&b, "SUMMARY:"+escapeText(event.Summary))
+	if event.Description != "" {
+		writeLine(&b, "DESCRIPTION:"+escapeText(event.Description))
+	}
+	if event.Location != "" {
+		writeLine(&b, "LOCATION:"+escapeText(event.Location))
+	}
+	writeLine(&b, "STATUS:"+status)
+	writeLine(&b, "TRANSP:TRANSPARENT")
+	writeLine(&b, "END:VEVENT")
+	writeLine(&b, "END:VCALENDAR")
+	return []byte(b.String())
+}
+
+func formatTime(t time.Time) string {
+	return t.UTC().Format(icsTimeLayout)
+}
+
+// escapeText escapes TEXT values per RFC 5545 section 3.3.11
+func escapeText(v string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+		"\r", "",
+	).Replace(v)
+}
+
+// writeLine writes a CRLF-terminated content line, folding it at 75 octets without
+// splitting a UTF-8 sequence. Continuation lines start with a single space.
+func writeLine(b *strings.Builder, line string) {
+	limit := icsMaxLineOctets
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !isRuneStart(line[cut]) {
+			cut--
+		}
+		b.WriteString(line[:cut])
+		b.WriteString("\r\n ")
+		line = line[cut:]
+		// the leading space counts towards the next line's length
+		limit = icsMaxLineOctets - 1
+	}
+	b.WriteString(line)
+	b.WriteString("\r\n")
+}
+
+func isRuneStart(c byte) bool {
+	return c&0xC0 != 0x80
+}
@@ -9,13 +9,24 @@ import (
 type AuthConfig struct {
 	JWTSecret      string
 	JWTExpiryHours int
+
+	// EmailChangeTokenTTLHours is how long both email-change confirmation links stay valid.
+	EmailChangeTokenTTLHours int
+	// EmailChangeConfirmURL is the client page that posts the confirmation token back;
+	// the token is appended as the "token" query parameter.
+	EmailChangeConfirmURL string
 }
 
 // loadAuthConfig loads auth configuration from environment variables.
 func loadAuthConfig() AuthConfig {
 	return AuthConfig{
-		JWTSecret:      os.Getenv("JWT_SECRET"),
-		JWTExpiryHours: getEnvAsIntOrDefault("JWT_EXPIRY_HOURS", 24),
+		JWTSecret:                os.Getenv("JWT_SECRET"),
+		JWTExpiryHours:           getEnvAsIntOrDefault("JWT_EXPIRY_HOURS", 24),
+		EmailChangeTokenTTLHours: getEnvAsIntOrDefault("EMAIL_CHANGE_TOKEN_TTL_HOURS", 24),
+		EmailChangeConfirmURL: getEnvOrDefault(
+			"EMAIL_CHANGE_CONFIRM_URL",
+			"http://localhost:3000/account/email-change/confirm",
+		),
 	}
 }
 
@@ -23,3 +34,8 @@ func loadAuthConfig() AuthConfig {
 func (a *AuthConfig) TokenExpiry() time.Duration {
 	return time.Duration(a.JWTExpiryHours) * time.Hour
 }
+
+// EmailChangeTokenExpiry returns how long email-change confirmation links stay valid.
+func (a *AuthConfig) EmailChangeTokenExpiry() time.Duration {
+	return time.Duration(a.EmailChangeTokenTTLHours) * time.Hour
+}
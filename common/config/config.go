@@ -5,14 +5,27 @@ import "sync"
 // Config holds all application configuration grouped by concern.
 // This is the main struct that embeds all sub-configs.
 type Config struct {
-	Server    ServerConfig
-	Database  DatabaseConfig
-	Redis     RedisConfig
-	Auth      AuthConfig
-	App       AppConfig
-	Log       LogConfig
-	Scheduler SchedulerConfig
-	Messaging MessagingConfig
+	Server        ServerConfig
+	Database      DatabaseConfig
+	Redis         RedisConfig
+	Auth          AuthConfig
+	App           AppConfig
+	Log           LogConfig
+	Scheduler     SchedulerConfig
+	Messaging     MessagingConfig
+	Fulfillment   FulfillmentConfig
+	Notification  NotificationConfig
+	DataMigration DataMigrationConfig
+	I18n          I18nConfig
+	Media         MediaConfig
+	Screening     ScreeningConfig
+	Health        HealthConfig
+	Order         OrderConfig
+	Payment       PaymentConfig
+	SellerHealth  SellerHealthConfig
+	Subscription  SubscriptionConfig
+	HTTPCache     HTTPCacheConfig
+	RequestLimits RequestLimitsConfig
 }
 
 var (
@@ -0,0 +1,22 @@
+package config
+
+// DataMigrationConfig holds defaults for background data migrations.
+// Each run may override batch size and chunk delay through the admin API.
+type DataMigrationConfig struct {
+	// DefaultBatchSize is the number of rows a chunk processes when a run does not set one.
+	DefaultBatchSize int
+	// DefaultChunkDelayMs is the pause between chunks, throttling load on the database.
+	DefaultChunkDelayMs int
+	// StallTimeoutMinutes is how long a running migration may go without progress
+	// before the recovery job re-schedules its next chunk (e.g. after a Redis flush).
+	StallTimeoutMinutes int
+}
+
+// loadDataMigrationConfig loads data migration configuration from environment variables.
+func loadDataMigrationConfig() DataMigrationConfig {
+	return DataMigrationConfig{
+		DefaultBatchSize:    getEnvAsIntOrDefault("DATA_MIGRATION_BATCH_SIZE", 500),
+		DefaultChunkDelayMs: getEnvAsIntOrDefault("DATA_MIGRATION_CHUNK_DELAY_MS", 1000),
+		StallTimeoutMinutes: getEnvAsIntOrDefault("DATA_MIGRATION_STALL_TIMEOUT_MINUTES", 10),
+	}
+}
@@ -3,6 +3,7 @@ package config
 import (
 	"fmt"
 	"os"
+	"strings"
 )
 
 // DatabaseConfig holds PostgreSQL database configuration.
@@ -14,11 +15,23 @@ type DatabaseConfig struct {
 	Name     string
 	SSLMode  string
 
-	// Connection pool settings
+	// ReadReplicas are host or host:port addresses of read replicas of the
+	// primary, sharing its credentials and database name
+	ReadReplicas []string
+
+	// AutoMigrate applies pending SQL migrations from MigrationsDir at startup
+	AutoMigrate   bool
+	MigrationsDir string
+
+	// Connection pool settings, applied to the primary and each replica
 	MaxOpenConns           int
 	MaxIdleConns           int
 	ConnMaxLifetimeMinutes int
 	ConnMaxIdleTimeMinutes int
+
+	// StatementTimeoutMs is Postgres' statement_timeout for every session, so a runaway
+	// query is cancelled server-side even if its request is gone; 0 disables it
+	StatementTimeoutMs int
 }
 
 // loadDatabaseConfig loads database configuration from environment variables.
@@ -30,27 +43,71 @@ func loadDatabaseConfig() DatabaseConfig {
 		Password:               os.Getenv("DB_PASSWORD"),
 		Name:                   os.Getenv("DB_NAME"),
 		SSLMode:                getEnvOrDefault("DB_SSLMODE", "disable"),
+		ReadReplicas:           splitDatabaseHosts(os.Getenv("DB_READ_REPLICAS")),
+		AutoMigrate:            strings.ToLower(os.Getenv("DB_AUTO_MIGRATE")) == "true",
+		MigrationsDir:          getEnvOrDefault("DB_MIGRATIONS_DIR", "migrations"),
 		MaxOpenConns:           getEnvAsIntOrDefault("DB_MAX_OPEN_CONNS", 25),
 		MaxIdleConns:           getEnvAsIntOrDefault("DB_MAX_IDLE_CONNS", 10),
 		ConnMaxLifetimeMinutes: getEnvAsIntOrDefault("DB_CONN_MAX_LIFETIME_MINUTES", 30),
 		ConnMaxIdleTimeMinutes: getEnvAsIntOrDefault("DB_CONN_MAX_IDLE_TIME_MINUTES", 5),
+		StatementTimeoutMs:     getEnvAsIntOrDefault("DB_STATEMENT_TIMEOUT_MS", 60000),
+	}
+}
+
+// splitDatabaseHosts parses a comma separated host list
+func splitDatabaseHosts(raw string) []string {
+	var hosts []string
+	for _, host := range strings.Split(raw, ",") {
+		if host = strings.TrimSpace(host); host != "" {
+			hosts = append(hosts, host)
+		}
 	}
+	return hosts
 }
 
 // DSN returns the PostgreSQL connection string.
 func (d *DatabaseConfig) DSN() string {
-	return fmt.Sprintf(
+	return d.dsnFor(d.Host, d.Port)
+}
+
+// ReplicaDSNs returns the connection strings of the read replicas.
+// A replica without a port uses the primary's port.
+func (d *DatabaseConfig) ReplicaDSNs() []string {
+	dsns := make([]string, 0, len(d.ReadReplicas))
+	for _, replica := range d.ReadReplicas {
+		host, port, found := strings.Cut(replica, ":")
+		if !found {
+			port = d.Port
+		}
+		dsns = append(dsns, d.dsnFor(host, port))
+	}
+	return dsns
+}
+
+func (d *DatabaseConfig) dsnFor(host, port string) string {
+	dsn := fmt.Sprintf(
 		"host=%s user=%s password=%s dbname=%s port=%s sslmode=%s TimeZone=UTC",
-		d.Host,
+		host,
 		d.User,
 		d.Password,
 		d.Name,
-		d.Port,
+		port,
 		d.SSLMode,
 	)
+	if d.StatementTimeoutMs > 0 {
+		// Unknown DSN keys are sent to Postgres as session parameters
+		dsn += fmt.Sprintf(" statement_timeout=%d", d.StatementTimeoutMs)
+	}
+	return dsn
 }
 
 // LogSafeString returns a connection string safe for logging (no password).
 func (d *DatabaseConfig) LogSafeString() string {
-	return fmt.Sprintf("host=%s dbname=%s port=%s", d.Host, d.Name, d.Port)
+	return fmt.Sprintf(
+		"host=%s dbname=%s port=%s replicas=%d",
+		d.Host,
+		d.Name,
+		d.Port,
+		len(d.ReadReplicas),
+	)
 }
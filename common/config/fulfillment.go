@@ -0,0 +1,20 @@
+package config
+
+import "os"
+
+// FulfillmentConfig holds shipment tracking configuration.
+type FulfillmentConfig struct {
+	// CarrierWebhookSecret signs inbound carrier tracking webhooks (HMAC-SHA256).
+	CarrierWebhookSecret string
+	// TrackingPollIntervalMinutes controls how often active shipments are polled
+	// against carriers that do not push webhooks.
+	TrackingPollIntervalMinutes int
+}
+
+// loadFulfillmentConfig loads fulfillment configuration from environment variables.
+func loadFulfillmentConfig() FulfillmentConfig {
+	return FulfillmentConfig{
+		CarrierWebhookSecret:        os.Getenv("CARRIER_WEBHOOK_SECRET"),
+		TrackingPollIntervalMinutes: getEnvAsIntOrDefault("TRACKING_POLL_INTERVAL_MINUTES", 30),
+	}
+}
@@ -0,0 +1,18 @@
+package config
+
+// HealthConfig holds liveness/readiness probe configuration.
+type HealthConfig struct {
+	// CheckTimeoutMs bounds each dependency check so a hung dependency cannot stall
+	// the readiness probe past the Kubernetes probe timeout.
+	CheckTimeoutMs int
+	// MigrationsDir holds the NNN_*.sql files compared with the schema_migration table.
+	MigrationsDir string
+}
+
+// loadHealthConfig loads health check configuration from environment variables.
+func loadHealthConfig() HealthConfig {
+	return HealthConfig{
+		CheckTimeoutMs: getEnvAsIntOrDefault("HEALTH_CHECK_TIMEOUT_MS", 2000),
+		MigrationsDir:  getEnvOrDefault("HEALTH_MIGRATIONS_DIR", "migrations"),
+	}
+}
@@ -0,0 +1,31 @@
+package config
+
+import "errors"
+
+// HTTPCacheConfig holds the browser cache lifetimes of conditional public read routes.
+// A max-age of 0 lets clients keep the response but revalidate it on every use.
+type HTTPCacheConfig struct {
+	// ProductMaxAgeSec covers product detail, which carries stock and prices
+	ProductMaxAgeSec int
+	// CategoryMaxAgeSec covers the category list and tree
+	CategoryMaxAgeSec int
+	// StorefrontMaxAgeSec covers the public storefront settings
+	StorefrontMaxAgeSec int
+}
+
+// loadHTTPCacheConfig loads HTTP cache configuration from environment variables.
+func loadHTTPCacheConfig() HTTPCacheConfig {
+	return HTTPCacheConfig{
+		ProductMaxAgeSec:    getEnvAsIntOrDefault("HTTP_CACHE_PRODUCT_MAX_AGE_SEC", 0),
+		CategoryMaxAgeSec:   getEnvAsIntOrDefault("HTTP_CACHE_CATEGORY_MAX_AGE_SEC", 300),
+		StorefrontMaxAgeSec: getEnvAsIntOrDefault("HTTP_CACHE_STOREFRONT_MAX_AGE_SEC", 60),
+	}
+}
+
+// validate rejects negative cache lifetimes.
+func (c HTTPCacheConfig) validate() error {
+	if c.ProductMaxAgeSec < 0 || c.CategoryMaxAgeSec < 0 || c.StorefrontMaxAgeSec < 0 {
+		return errors.New("HTTP_CACHE_*_MAX_AGE_SEC must not be negative")
+	}
+	return nil
+}
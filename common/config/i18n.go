@@ -0,0 +1,17 @@
+package config
+
+// I18nConfig holds localization settings for API response messages.
+type I18nConfig struct {
+	// LocalesDir is the directory holding one <language>.json translation file per language.
+	LocalesDir string
+	// DefaultLanguage is used when Accept-Language is absent or names no supported language.
+	DefaultLanguage string
+}
+
+// loadI18nConfig loads localization configuration from environment variables.
+func loadI18nConfig() I18nConfig {
+	return I18nConfig{
+		LocalesDir:      getEnvOrDefault("I18N_LOCALES_DIR", "locales"),
+		DefaultLanguage: getEnvOrDefault("I18N_DEFAULT_LANGUAGE", "en"),
+	}
+}
@@ -15,14 +15,27 @@ func Load() (*Config, error) {
 
 	once.Do(func() {
 		cfg := &Config{
-			Server:    loadServerConfig(),
-			Database:  loadDatabaseConfig(),
-			Redis:     loadRedisConfig(),
-			Auth:      loadAuthConfig(),
-			App:       loadAppConfig(),
-			Log:       loadLogConfig(),
-			Scheduler: loadSchedulerConfig(),
-			Messaging: loadMessagingConfig(),
+			Server:        loadServerConfig(),
+			Database:      loadDatabaseConfig(),
+			Redis:         loadRedisConfig(),
+			Auth:          loadAuthConfig(),
+			App:           loadAppConfig(),
+			Log:           loadLogConfig(),
+			Scheduler:     loadSchedulerConfig(),
+			Messaging:     loadMessagingConfig(),
+			Fulfillment:   loadFulfillmentConfig(),
+			Notification:  loadNotificationConfig(),
+			DataMigration: loadDataMigrationConfig(),
+			I18n:          loadI18nConfig(),
+			Media:         loadMediaConfig(),
+			Screening:     loadScreeningConfig(),
+			Health:        loadHealthConfig(),
+			Order:         loadOrderConfig(),
+			Payment:       loadPaymentConfig(),
+			SellerHealth:  loadSellerHealthConfig(),
+			Subscription:  loadSubscriptionConfig(),
+			HTTPCache:     loadHTTPCacheConfig(),
+			RequestLimits: loadRequestLimitsConfig(),
 		}
 
 		if err := cfg.Validate(); err != nil {
@@ -56,9 +69,16 @@ func (c *Config) Validate() error {
 		return errors.New("DB_PORT is required")
 	}
 
+	if err := c.Server.validate(); err != nil {
+		return err
+	}
+	if c.Database.StatementTimeoutMs < 0 {
+		return errors.New("DB_STATEMENT_TIMEOUT_MS must not be negative")
+	}
+
 	// Redis validation
-	if c.Redis.Host == "" {
-		return errors.New("REDIS_ADDR is required")
+	if err := c.Redis.validate(); err != nil {
+		return err
 	}
 
 	// Auth validation
@@ -66,6 +86,28 @@ func (c *Config) Validate() error {
 		return errors.New("JWT_SECRET is required")
 	}
 
+	if err := c.Scheduler.validate(); err != nil {
+		return err
+	}
+	if err := c.Order.validate(); err != nil {
+		return err
+	}
+	if err := c.Payment.validate(); err != nil {
+		return err
+	}
+	if err := c.SellerHealth.validate(); err != nil {
+		return err
+	}
+	if err := c.Subscription.validate(); err != nil {
+		return err
+	}
+	if err := c.HTTPCache.validate(); err != nil {
+		return err
+	}
+	if err := c.RequestLimits.validate(); err != nil {
+		return err
+	}
+
 	// Messaging validation
 	if c.Messaging.Enabled {
 		switch c.Messaging.QueueType {
@@ -0,0 +1,32 @@
+package config
+
+import "strings"
+
+// MediaConfig holds settings for serving user-facing media such as avatars and logos,
+// and the files of digital products.
+type MediaConfig struct {
+	// CDNBaseURL serves PUBLIC files from the CDN instead of presigned storage URLs.
+	// Empty disables CDN resolution.
+	CDNBaseURL string
+
+	// DownloadSecret signs digital product download links; falls back to the JWT
+	// secret when unset. Rotating it invalidates links already sent to customers.
+	DownloadSecret string
+	// DownloadLinkTTLHours is how long a download link stays valid once issued
+	DownloadLinkTTLHours int
+	// DownloadBaseURL is the externally reachable API origin used in download links
+	DownloadBaseURL string
+}
+
+// loadMediaConfig loads media configuration from environment variables.
+func loadMediaConfig() MediaConfig {
+	return MediaConfig{
+		CDNBaseURL:           strings.TrimRight(getEnvOrDefault("MEDIA_CDN_BASE_URL", ""), "/"),
+		DownloadSecret:       getEnvOrDefault("MEDIA_DOWNLOAD_SECRET", ""),
+		DownloadLinkTTLHours: getEnvAsIntOrDefault("MEDIA_DOWNLOAD_LINK_TTL_HOURS", 72),
+		DownloadBaseURL: strings.TrimRight(
+			getEnvOrDefault("MEDIA_DOWNLOAD_BASE_URL", "http://localhost:8080"),
+			"/",
+		),
+	}
+}
@@ -0,0 +1,63 @@
+package config
+
+import "strings"
+
+// NotificationConfig holds notification channel provider configuration.
+// Each provider defaults to "log", which writes the rendered message to the
+// application log instead of delivering it (useful for local development).
+type NotificationConfig struct {
+	// EmailProvider values: smtp, ses, log
+	EmailProvider string
+	EmailFrom     string
+	SMTPHost      string
+	SMTPPort      int
+	SMTPUsername  string
+	SMTPPassword  string
+	// SESRegion selects the SES SMTP endpoint; SMTPUsername/SMTPPassword hold the
+	// SES SMTP credentials.
+	SESRegion string
+	// SPFInclude is the provider domain sellers' SPF records must include for their
+	// own sending domains (e.g. amazonses.com); empty accepts any SPF policy.
+	SPFInclude string
+
+	// SMSProvider values: twilio, log
+	SMSProvider      string
+	SMSFrom          string
+	TwilioAccountSID string
+	TwilioAuthToken  string
+
+	// PushProvider values: fcm, log
+	PushProvider string
+	FCMProjectID string
+	// FCMCredentialsJSON is the Firebase service account key (JSON document).
+	FCMCredentialsJSON string
+
+	// UnsubscribeSecret signs one-click unsubscribe links; falls back to the JWT
+	// secret when unset. Rotating it invalidates links in emails already sent.
+	UnsubscribeSecret string
+	// PublicBaseURL is the externally reachable API origin used in unsubscribe links.
+	PublicBaseURL string
+}
+
+// loadNotificationConfig loads notification configuration from environment variables.
+func loadNotificationConfig() NotificationConfig {
+	return NotificationConfig{
+		EmailProvider:      strings.ToLower(getEnvOrDefault("NOTIFICATION_EMAIL_PROVIDER", "log")),
+		EmailFrom:          getEnvOrDefault("NOTIFICATION_EMAIL_FROM", "no-reply@localhost"),
+		SMTPHost:           getEnvOrDefault("SMTP_HOST", ""),
+		SMTPPort:           getEnvAsIntOrDefault("SMTP_PORT", 587),
+		SMTPUsername:       getEnvOrDefault("SMTP_USERNAME", ""),
+		SMTPPassword:       getEnvOrDefault("SMTP_PASSWORD", ""),
+		SESRegion:          getEnvOrDefault("SES_REGION", "us-east-1"),
+		SPFInclude:         getEnvOrDefault("NOTIFICATION_SPF_INCLUDE", ""),
+		SMSProvider:        strings.ToLower(getEnvOrDefault("NOTIFICATION_SMS_PROVIDER", "log")),
+		SMSFrom:            getEnvOrDefault("NOTIFICATION_SMS_FROM", ""),
+		TwilioAccountSID:   getEnvOrDefault("TWILIO_ACCOUNT_SID", ""),
+		TwilioAuthToken:    getEnvOrDefault("TWILIO_AUTH_TOKEN", ""),
+		PushProvider:       strings.ToLower(getEnvOrDefault("NOTIFICATION_PUSH_PROVIDER", "log")),
+		FCMProjectID:       getEnvOrDefault("FCM_PROJECT_ID", ""),
+		FCMCredentialsJSON: getEnvOrDefault("FCM_CREDENTIALS_JSON", ""),
+		UnsubscribeSecret:  getEnvOrDefault("NOTIFICATION_UNSUBSCRIBE_SECRET", ""),
+		PublicBaseURL:      getEnvOrDefault("NOTIFICATION_PUBLIC_BASE_URL", "http://localhost:8080"),
+	}
+}
@@ -0,0 +1,54 @@
+package config
+
+import "errors"
+
+// OrderConfig holds checkout payment-plan configuration.
+type OrderConfig struct {
+	// DepositPercent is the share of the order total collected at placement for
+	// deposit orders; the remainder is the balance
+	DepositPercent int
+	// BalanceDueDays is how long after placement the balance of a deposit order
+	// collected before fulfillment falls due. Unpaid balances then cancel the order.
+	BalanceDueDays int
+	// BalanceReminderHours is how long before the due date the customer is reminded
+	BalanceReminderHours int
+
+	// GuestAccessSecret signs guest order-access tokens; falls back to the JWT secret
+	// when unset. Rotating it invalidates the tokens guests already hold.
+	GuestAccessSecret string
+	// GuestClaimTokenTTLHours is how long a guest order claim link stays valid
+	GuestClaimTokenTTLHours int
+	// GuestClaimConfirmURL is the client page that posts the claim token back; the
+	// token is appended as the "token" query parameter.
+	GuestClaimConfirmURL string
+}
+
+// loadOrderConfig loads order configuration from environment variables.
+func loadOrderConfig() OrderConfig {
+	return OrderConfig{
+		DepositPercent:       getEnvAsIntOrDefault("ORDER_DEPOSIT_PERCENT", 20),
+		BalanceDueDays:       getEnvAsIntOrDefault("ORDER_BALANCE_DUE_DAYS", 7),
+		BalanceReminderHours: getEnvAsIntOrDefault("ORDER_BALANCE_REMINDER_HOURS", 48),
+		GuestAccessSecret:    getEnvOrDefault("ORDER_GUEST_ACCESS_SECRET", ""),
+		GuestClaimTokenTTLHours: getEnvAsIntOrDefault(
+			"ORDER_GUEST_CLAIM_TOKEN_TTL_HOURS",
+			24,
+		),
+		GuestClaimConfirmURL: getEnvOrDefault(
+			"ORDER_GUEST_CLAIM_CONFIRM_URL",
+			"http://localhost:3000/account/orders/claim",
+		),
+	}
+}
+
+// validate rejects deposit settings that would leave nothing to collect up front or
+// as a balance.
+func (c OrderConfig) validate() error {
+	if c.DepositPercent <= 0 || c.DepositPercent >= 100 {
+		return errors.New("ORDER_DEPOSIT_PERCENT must be between 1 and 99")
+	}
+	if c.BalanceDueDays <= 0 {
+		return errors.New("ORDER_BALANCE_DUE_DAYS must be positive")
+	}
+	return nil
+}
@@ -0,0 +1,60 @@
+package config
+
+import "errors"
+
+// PaymentConfig holds payment monitoring and expiry configuration.
+type PaymentConfig struct {
+	// DeclineAlertThresholdPercent is the decline rate of a seller's payments on one
+	// gateway above which the seller and admins are alerted
+	DeclineAlertThresholdPercent int
+	// DeclineAlertWindowMinutes is the window the decline rate is measured over; the
+	// check runs once per window
+	DeclineAlertWindowMinutes int
+	// DeclineAlertMinAttempts is the number of attempts in the window below which no
+	// alert is raised, so a couple of declines do not count as a spike
+	DeclineAlertMinAttempts int
+
+	// PendingPaymentExpiryMinutes is how long a customer has to complete a payment
+	// started for an order; the order is cancelled when the payment lapses
+	PendingPaymentExpiryMinutes int
+
+	// PlatformCommissionBasisPoints is the commission the platform keeps on order lines no
+	// commission rule covers, in hundredths of a percent
+	PlatformCommissionBasisPoints int
+}
+
+// loadPaymentConfig loads payment configuration from environment variables.
+func loadPaymentConfig() PaymentConfig {
+	return PaymentConfig{
+		DeclineAlertThresholdPercent: getEnvAsIntOrDefault(
+			"PAYMENT_DECLINE_ALERT_THRESHOLD_PERCENT", 30),
+		DeclineAlertWindowMinutes: getEnvAsIntOrDefault("PAYMENT_DECLINE_ALERT_WINDOW_MINUTES", 60),
+		DeclineAlertMinAttempts:   getEnvAsIntOrDefault("PAYMENT_DECLINE_ALERT_MIN_ATTEMPTS", 20),
+		PendingPaymentExpiryMinutes: getEnvAsIntOrDefault(
+			"PAYMENT_PENDING_EXPIRY_MINUTES", 30),
+		PlatformCommissionBasisPoints: getEnvAsIntOrDefault(
+			"PAYMENT_PLATFORM_COMMISSION_BPS", 1000),
+	}
+}
+
+// validate rejects decline alert settings that would alert on every payment or never run,
+// a payment window that would expire payments as soon as they start, and a commission
+// outside 0-100%.
+func (c PaymentConfig) validate() error {
+	if c.DeclineAlertThresholdPercent <= 0 || c.DeclineAlertThresholdPercent > 100 {
+		return errors.New("PAYMENT_DECLINE_ALERT_THRESHOLD_PERCENT must be between 1 and 100")
+	}
+	if c.DeclineAlertWindowMinutes <= 0 {
+		return errors.New("PAYMENT_DECLINE_ALERT_WINDOW_MINUTES must be positive")
+	}
+	if c.DeclineAlertMinAttempts <= 0 {
+		return errors.New("PAYMENT_DECLINE_ALERT_MIN_ATTEMPTS must be positive")
+	}
+	if c.PendingPaymentExpiryMinutes <= 0 {
+		return errors.New("PAYMENT_PENDING_EXPIRY_MINUTES must be positive")
+	}
+	if c.PlatformCommissionBasisPoints < 0 || c.PlatformCommissionBasisPoints > 10000 {
+		return errors.New("PAYMENT_PLATFORM_COMMISSION_BPS must be between 0 and 10000")
+	}
+	return nil
+}
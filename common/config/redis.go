@@ -1,28 +1,140 @@
 package config
 
-import "os"
+import (
+	"errors"
+	"os"
+	"strings"
+	"time"
+)
+
+// Redis topologies selected by REDIS_MODE
+const (
+	REDIS_MODE_STANDALONE = "standalone"
+	REDIS_MODE_SENTINEL   = "sentinel"
+	REDIS_MODE_CLUSTER    = "cluster"
+)
 
 // RedisConfig holds Redis configuration.
 type RedisConfig struct {
 	Host     string
 	Port     string
+	Username string
 	Password string
 	DB       int
 	Addr     string
+
+	// Mode is standalone (default), sentinel or cluster
+	Mode string
+	// Addrs are the sentinel addresses in sentinel mode and the seed nodes in
+	// cluster mode (REDIS_ADDRS); see NodeAddrs
+	Addrs []string
+	// MasterName is the master set monitored by the sentinels
+	MasterName       string
+	SentinelPassword string
+	// ReadFromReplicas routes read-only commands to replicas (sentinel and cluster)
+	ReadFromReplicas bool
+
+	// Connection pool; zero values keep the client defaults
+	PoolSize     int
+	MinIdleConns int
+	PoolTimeout  time.Duration
+	DialTimeout  time.Duration
+	ReadTimeout  time.Duration
+	WriteTimeout time.Duration
+	// MaxRetries is how often a command is retried on network errors and failover
+	MaxRetries int
+
+	TLSEnabled            bool
+	TLSCAFile             string
+	TLSServerName         string
+	TLSInsecureSkipVerify bool
 }
 
 // loadRedisConfig loads Redis configuration from environment variables.
 func loadRedisConfig() RedisConfig {
+	addr := os.Getenv("REDIS_HOST") + ":" + getEnvOrDefault("REDIS_PORT", "6379")
 	return RedisConfig{
 		Host:     os.Getenv("REDIS_HOST"),
+		Username: os.Getenv("REDIS_USERNAME"),
 		Password: os.Getenv("REDIS_PASSWORD"),
 		Port:     getEnvOrDefault("REDIS_PORT", "6379"),
 		DB:       getEnvAsIntOrDefault("REDIS_DB", 0),
-		Addr:     os.Getenv("REDIS_HOST") + ":" + getEnvOrDefault("REDIS_PORT", "6379"),
+		Addr:     addr,
+
+		Mode:             strings.ToLower(getEnvOrDefault("REDIS_MODE", REDIS_MODE_STANDALONE)),
+		Addrs:            splitRedisAddrs(os.Getenv("REDIS_ADDRS")),
+		MasterName:       os.Getenv("REDIS_SENTINEL_MASTER"),
+		SentinelPassword: os.Getenv("REDIS_SENTINEL_PASSWORD"),
+		ReadFromReplicas: strings.ToLower(os.Getenv("REDIS_READ_FROM_REPLICAS")) == "true",
+
+		PoolSize:     getEnvAsIntOrDefault("REDIS_POOL_SIZE", 0),
+		MinIdleConns: getEnvAsIntOrDefault("REDIS_MIN_IDLE_CONNS", 0),
+		PoolTimeout:  time.Duration(getEnvAsIntOrDefault("REDIS_POOL_TIMEOUT_MS", 0)) * time.Millisecond,
+		DialTimeout:  time.Duration(getEnvAsIntOrDefault("REDIS_DIAL_TIMEOUT_MS", 0)) * time.Millisecond,
+		ReadTimeout:  time.Duration(getEnvAsIntOrDefault("REDIS_READ_TIMEOUT_MS", 0)) * time.Millisecond,
+		WriteTimeout: time.Duration(getEnvAsIntOrDefault("REDIS_WRITE_TIMEOUT_MS", 0)) * time.Millisecond,
+		MaxRetries:   getEnvAsIntOrDefault("REDIS_MAX_RETRIES", 3),
+
+		TLSEnabled:            strings.ToLower(os.Getenv("REDIS_TLS_ENABLED")) == "true",
+		TLSCAFile:             os.Getenv("REDIS_TLS_CA_FILE"),
+		TLSServerName:         os.Getenv("REDIS_TLS_SERVER_NAME"),
+		TLSInsecureSkipVerify: strings.ToLower(os.Getenv("REDIS_TLS_INSECURE_SKIP_VERIFY")) == "true",
 	}
 }
 
+// splitRedisAddrs parses a comma separated address list
+func splitRedisAddrs(raw string) []string {
+	var addrs []string
+	for _, part := range strings.Split(raw, ",") {
+		if part = strings.TrimSpace(part); part != "" {
+			addrs = append(addrs, part)
+		}
+	}
+	return addrs
+}
+
+// NodeAddrs returns the configured address list, or REDIS_HOST:REDIS_PORT when none is set
+func (r *RedisConfig) NodeAddrs() []string {
+	if len(r.Addrs) > 0 {
+		return r.Addrs
+	}
+	return []string{r.Addr}
+}
+
 // HasPassword returns true if a password is configured.
 func (r *RedisConfig) HasPassword() bool {
 	return r.Password != ""
 }
+
+// validate checks that the selected topology has what it needs to connect
+func (r RedisConfig) validate() error {
+	switch r.Mode {
+	case REDIS_MODE_STANDALONE:
+		if r.Host == "" {
+			return errors.New("REDIS_HOST is required")
+		}
+	case REDIS_MODE_SENTINEL:
+		if r.MasterName == "" {
+			return errors.New("REDIS_SENTINEL_MASTER is required in sentinel mode")
+		}
+		if len(r.Addrs) == 0 && r.Host == "" {
+			return errors.New("REDIS_ADDRS is required in sentinel mode")
+		}
+		if r.ReadFromReplicas && r.DB != 0 {
+			return errors.New("REDIS_DB must be 0 when reading from sentinel replicas")
+		}
+	case REDIS_MODE_CLUSTER:
+		if len(r.Addrs) == 0 && r.Host == "" {
+			return errors.New("REDIS_ADDRS is required in cluster mode")
+		}
+		if r.DB != 0 {
+			return errors.New("REDIS_DB must be 0 in cluster mode")
+		}
+	default:
+		return errors.New("REDIS_MODE must be standalone, sentinel or cluster")
+	}
+	if r.PoolSize < 0 || r.MinIdleConns < 0 || r.MaxRetries < -1 {
+		return errors.New("REDIS_POOL_SIZE, REDIS_MIN_IDLE_CONNS and REDIS_MAX_RETRIES must not be negative")
+	}
+	return nil
+}
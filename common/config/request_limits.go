@@ -0,0 +1,42 @@
+package config
+
+import "errors"
+
+// RequestLimitsConfig bounds the size and shape of request bodies so a single payload
+// cannot exhaust server memory
+type RequestLimitsConfig struct {
+	// MaxBodyBytes caps every request body unless its route group raises the limit
+	MaxBodyBytes int64
+	// BulkMaxBodyBytes caps the bodies of bulk routes such as bulk variant updates
+	BulkMaxBodyBytes int64
+	// MaxJSONDepth caps the nesting of objects and arrays in a JSON body
+	MaxJSONDepth int
+	// MaxJSONArrayLength caps the number of elements of any single JSON array
+	MaxJSONArrayLength int
+}
+
+// loadRequestLimitsConfig loads request limit configuration from environment variables.
+func loadRequestLimitsConfig() RequestLimitsConfig {
+	return RequestLimitsConfig{
+		MaxBodyBytes:       int64(getEnvAsIntOrDefault("REQUEST_MAX_BODY_BYTES", 1<<20)),
+		BulkMaxBodyBytes:   int64(getEnvAsIntOrDefault("REQUEST_BULK_MAX_BODY_BYTES", 10<<20)),
+		MaxJSONDepth:       getEnvAsIntOrDefault("REQUEST_MAX_JSON_DEPTH", 32),
+		MaxJSONArrayLength: getEnvAsIntOrDefault("REQUEST_MAX_JSON_ARRAY_LENGTH", 10000),
+	}
+}
+
+// validate requires positive limits and a bulk limit no smaller than the default.
+func (c RequestLimitsConfig) validate() error {
+	if c.MaxBodyBytes <= 0 || c.BulkMaxBodyBytes <= 0 {
+		return errors.New("REQUEST_MAX_BODY_BYTES and REQUEST_BULK_MAX_BODY_BYTES must be positive")
+	}
+	if c.BulkMaxBodyBytes < c.MaxBodyBytes {
+		return errors.New("REQUEST_BULK_MAX_BODY_BYTES must not be below REQUEST_MAX_BODY_BYTES")
+	}
+	if c.MaxJSONDepth <= 0 || c.MaxJSONArrayLength <= 0 {
+		return errors.New(
+			"REQUEST_MAX_JSON_DEPTH and REQUEST_MAX_JSON_ARRAY_LENGTH must be positive",
+		)
+	}
+	return nil
+}
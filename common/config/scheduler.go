@@ -1,13 +1,39 @@
 package config
 
+import "errors"
+
 // SchedulerConfig holds background job scheduler configuration.
 type SchedulerConfig struct {
 	WorkerPoolSize int
+	// JobMaxAttempts is how often a failing job runs before it is dead-lettered,
+	// unless the job sets its own limit
+	JobMaxAttempts int
+	// RetryBaseDelaySec is the delay before the first retry; each further retry
+	// doubles it, up to RetryMaxDelaySec
+	RetryBaseDelaySec int
+	RetryMaxDelaySec  int
 }
 
 // loadSchedulerConfig loads scheduler configuration from environment variables.
 func loadSchedulerConfig() SchedulerConfig {
 	return SchedulerConfig{
-		WorkerPoolSize: getEnvAsIntOrDefault("WORKER_POOL_SIZE", 5),
+		WorkerPoolSize:    getEnvAsIntOrDefault("WORKER_POOL_SIZE", 5),
+		JobMaxAttempts:    getEnvAsIntOrDefault("SCHEDULER_JOB_MAX_ATTEMPTS", 3),
+		RetryBaseDelaySec: getEnvAsIntOrDefault("SCHEDULER_RETRY_BASE_DELAY_SEC", 30),
+		RetryMaxDelaySec:  getEnvAsIntOrDefault("SCHEDULER_RETRY_MAX_DELAY_SEC", 3600),
+	}
+}
+
+// validate requires at least one attempt and a retry delay range.
+func (c SchedulerConfig) validate() error {
+	if c.JobMaxAttempts < 1 {
+		return errors.New("SCHEDULER_JOB_MAX_ATTEMPTS must be at least 1")
+	}
+	if c.RetryBaseDelaySec <= 0 || c.RetryMaxDelaySec < c.RetryBaseDelaySec {
+		return errors.New(
+			"SCHEDULER_RETRY_BASE_DELAY_SEC must be positive and at most " +
+				"SCHEDULER_RETRY_MAX_DELAY_SEC",
+		)
 	}
+	return nil
 }
@@ -0,0 +1,32 @@
+package config
+
+import (
+	"os"
+	"strings"
+)
+
+// ScreeningConfig holds sanctions / blocked-party screening configuration.
+type ScreeningConfig struct {
+	Enabled bool
+	// Provider values: denylist (configured deny lists only), http (deny lists plus an
+	// external screening provider)
+	Provider string
+	// ProviderURL receives a POST with the subject and answers with its matches
+	ProviderURL     string
+	ProviderAPIKey  string
+	ProviderTimeout int // seconds
+	// HighValueOrderCents is the order total at or above which an order is screened
+	HighValueOrderCents int64
+}
+
+// loadScreeningConfig loads screening configuration from environment variables.
+func loadScreeningConfig() ScreeningConfig {
+	return ScreeningConfig{
+		Enabled:             strings.ToLower(getEnvOrDefault("SCREENING_ENABLED", "true")) == "true",
+		Provider:            strings.ToLower(getEnvOrDefault("SCREENING_PROVIDER", "denylist")),
+		ProviderURL:         os.Getenv("SCREENING_PROVIDER_URL"),
+		ProviderAPIKey:      os.Getenv("SCREENING_PROVIDER_API_KEY"),
+		ProviderTimeout:     getEnvAsIntOrDefault("SCREENING_PROVIDER_TIMEOUT_SECONDS", 5),
+		HighValueOrderCents: int64(getEnvAsIntOrDefault("SCREENING_HIGH_VALUE_ORDER_CENTS", 1000000)),
+	}
+}
@@ -0,0 +1,56 @@
+package config
+
+import "errors"
+
+// SellerHealthConfig holds seller health scoring configuration.
+type SellerHealthConfig struct {
+	// WindowDays is the trailing period the health metrics are measured over
+	WindowDays int
+	// ShippingSLAHours is how long after placement an order may stay unshipped
+	// before it counts as an SLA breach
+	ShippingSLAHours int
+	// WarningScore is the score below which a seller is flagged at risk and warned
+	WarningScore int
+	// DemotionScore is the score below which a seller's listings are demoted in
+	// search and catalog listings
+	DemotionScore int
+	// MinOrders is the number of orders in the window below which no score based
+	// action is taken
+	MinOrders int
+	// RunHourUTC is the hour of day (UTC) the daily aggregation runs
+	RunHourUTC int
+}
+
+// loadSellerHealthConfig loads seller health configuration from environment variables.
+func loadSellerHealthConfig() SellerHealthConfig {
+	return SellerHealthConfig{
+		WindowDays:       getEnvAsIntOrDefault("SELLER_HEALTH_WINDOW_DAYS", 30),
+		ShippingSLAHours: getEnvAsIntOrDefault("SELLER_HEALTH_SHIPPING_SLA_HOURS", 48),
+		WarningScore:     getEnvAsIntOrDefault("SELLER_HEALTH_WARNING_SCORE", 70),
+		DemotionScore:    getEnvAsIntOrDefault("SELLER_HEALTH_DEMOTION_SCORE", 50),
+		MinOrders:        getEnvAsIntOrDefault("SELLER_HEALTH_MIN_ORDERS", 10),
+		RunHourUTC:       getEnvAsIntOrDefault("SELLER_HEALTH_RUN_HOUR_UTC", 2),
+	}
+}
+
+// validate rejects thresholds that would demote sellers before warning them.
+func (c SellerHealthConfig) validate() error {
+	if c.WindowDays <= 0 {
+		return errors.New("SELLER_HEALTH_WINDOW_DAYS must be positive")
+	}
+	if c.ShippingSLAHours <= 0 {
+		return errors.New("SELLER_HEALTH_SHIPPING_SLA_HOURS must be positive")
+	}
+	if c.DemotionScore < 0 || c.WarningScore > 100 || c.DemotionScore > c.WarningScore {
+		return errors.New(
+			"SELLER_HEALTH_DEMOTION_SCORE must not exceed SELLER_HEALTH_WARNING_SCORE (0-100)",
+		)
+	}
+	if c.MinOrders < 0 {
+		return errors.New("SELLER_HEALTH_MIN_ORDERS must not be negative")
+	}
+	if c.RunHourUTC < 0 || c.RunHourUTC > 23 {
+		return errors.New("SELLER_HEALTH_RUN_HOUR_UTC must be between 0 and 23")
+	}
+	return nil
+}
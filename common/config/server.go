@@ -1,22 +1,44 @@
 package config
 
 import (
+	"errors"
 	"fmt"
 	"os"
+	"time"
 )
 
-// ServerConfig holds HTTP server configuration.
+// ServerConfig holds HTTP and gRPC server configuration.
 type ServerConfig struct {
 	Port string
 	Mode string // "debug", "release", "test"
+	// GRPCPort is the port of the internal gRPC server; empty disables it
+	GRPCPort string
+	// RequestTimeoutSec is the deadline of a request's database, Redis and outbound
+	// HTTP calls; ReportRequestTimeoutSec replaces it on report routes
+	RequestTimeoutSec       int
+	ReportRequestTimeoutSec int
 }
 
 // loadServerConfig loads server configuration from environment variables.
 func loadServerConfig() ServerConfig {
 	return ServerConfig{
-		Port: getEnvOrDefault("PORT", "8080"),
-		Mode: getEnvOrDefault("GIN_MODE", "release"),
+		Port:     getEnvOrDefault("PORT", "8080"),
+		Mode:     getEnvOrDefault("GIN_MODE", "release"),
+		GRPCPort: os.Getenv("GRPC_PORT"),
+
+		RequestTimeoutSec:       getEnvAsIntOrDefault("SERVER_REQUEST_TIMEOUT_SEC", 30),
+		ReportRequestTimeoutSec: getEnvAsIntOrDefault("SERVER_REPORT_REQUEST_TIMEOUT_SEC", 120),
+	}
+}
+
+// validate requires positive request timeouts.
+func (s ServerConfig) validate() error {
+	if s.RequestTimeoutSec <= 0 || s.ReportRequestTimeoutSec <= 0 {
+		return errors.New(
+			"SERVER_REQUEST_TIMEOUT_SEC and SERVER_REPORT_REQUEST_TIMEOUT_SEC must be positive",
+		)
 	}
+	return nil
 }
 
 // getEnvOrDefault returns the environment variable value or a default.
@@ -32,6 +54,26 @@ func (s *ServerConfig) Addr() string {
 	return fmt.Sprintf(":%s", s.Port)
 }
 
+// GRPCAddr returns the gRPC server address in ":port" format.
+func (s *ServerConfig) GRPCAddr() string {
+	return fmt.Sprintf(":%s", s.GRPCPort)
+}
+
+// GRPCEnabled returns true if a gRPC port is configured.
+func (s *ServerConfig) GRPCEnabled() bool {
+	return s.GRPCPort != ""
+}
+
+// RequestTimeout returns the default request deadline.
+func (s *ServerConfig) RequestTimeout() time.Duration {
+	return time.Duration(s.RequestTimeoutSec) * time.Second
+}
+
+// ReportRequestTimeout returns the request deadline of report routes.
+func (s *ServerConfig) ReportRequestTimeout() time.Duration {
+	return time.Duration(s.ReportRequestTimeoutSec) * time.Second
+}
+
 // IsProduction returns true if running in release mode.
 func (s *ServerConfig) IsProduction() bool {
 	return s.Mode == "release"
@@ -0,0 +1,31 @@
+package config
+
+import (
+	"errors"
+	"os"
+)
+
+// SubscriptionConfig holds seller subscription billing configuration.
+type SubscriptionConfig struct {
+	// PaymentWebhookSecret signs inbound subscription payment webhooks (HMAC-SHA256)
+	PaymentWebhookSecret string
+	// GraceDays is how long a seller whose renewal payment failed keeps using the
+	// platform while the payment is retried
+	GraceDays int
+}
+
+// loadSubscriptionConfig loads subscription configuration from environment variables.
+func loadSubscriptionConfig() SubscriptionConfig {
+	return SubscriptionConfig{
+		PaymentWebhookSecret: os.Getenv("SUBSCRIPTION_PAYMENT_WEBHOOK_SECRET"),
+		GraceDays:            getEnvAsIntOrDefault("SUBSCRIPTION_GRACE_DAYS", 3),
+	}
+}
+
+// validate rejects a negative grace period.
+func (c SubscriptionConfig) validate() error {
+	if c.GraceDays < 0 {
+		return errors.New("SUBSCRIPTION_GRACE_DAYS must not be negative")
+	}
+	return nil
+}
@@ -18,6 +18,9 @@ const (
 	// Payment Service Base Path (Future)
 	APIBasePayment = "/api/payment"
 
+	// Checkout Base Path (served by the payment module)
+	APIBaseCheckout = "/api/checkout"
+
 	// Notification Service Base Path (Future)
 	APIBaseNotification = "/api/notification"
 
@@ -28,4 +31,40 @@ const (
 
 	// File Service Base Path
 	APIBaseFile = "/api/file"
+
+	// Fulfillment Service Base Path
+	APIBaseFulfillment = "/api/fulfillment"
+
+	// Background data migration admin base path
+	APIBaseDataMigration = "/api/data-migration"
+
+	// Compliance admin base path (sanctions screening review queue, deny lists)
+	APIBaseCompliance = "/api/compliance"
+
+	// Encryption admin base path (per-tenant data key rotation)
+	APIBaseEncryption = "/api/encryption"
+
+	// Feature flag admin base path (rollouts and per-seller targeting)
+	APIBaseFeatureFlags = "/api/feature-flags"
+
+	// Background job admin base path (queue monitoring, failed jobs, recurring jobs)
+	APIBaseJobs = "/api/jobs"
+
+	// Public storefront configuration, resolved per seller from X-Seller-ID
+	APIBaseStorefront = "/api/storefront"
+
+	// Signed-in customer's own resources across modules
+	APIBaseMe = "/api/me"
+
+	// Generated OpenAPI 3 document describing every registered route
+	APIOpenAPISpec = "/api/openapi.json"
+
+	// Catalog of machine-readable error codes; problem+json types point here
+	APIErrorCatalog = "/api/errors"
+)
+
+// Kubernetes probe paths, served outside /api so they bypass API gateways
+const (
+	APIHealthLiveness  = "/healthz"
+	APIHealthReadiness = "/readyz"
 )
@@ -20,15 +20,6 @@ const (
 	INVALID_AUTH_FORMAT_CODE = "INVALID_AUTH_FORMAT"
 	TOKEN_REQUIRED_CODE      = "TOKEN_REQUIRED"
 
-	// Context keys
-	USER_ID_KEY        = "user_id"
-	EMAIL_KEY          = "email"
-	ROLE_ID_KEY        = "role_id"
-	ROLE_NAME_KEY      = "role_name"
-	ROLE_LEVEL_KEY     = "role_level"
-	SELLER_ID_KEY      = "seller_id"
-	CORRELATION_ID_KEY = "correlation_id"
-
 	// Header keys
 	SELLER_ID_HEADER      = "X-Seller-ID"
 	CORRELATION_ID_HEADER = "X-Correlation-ID"
@@ -11,7 +11,32 @@ const (
 	SELLER_CACHE_EXPIRATION       = time.Minute * 15   // 15 minutes
 	SELLER_CACHE_SHORT_EXPIRATION = time.Minute * 2    // 2 minutes for failed validations
 
+	// Custom storefront domain -> seller ID; unmapped hosts are cached as 0
+	// with SELLER_CACHE_SHORT_EXPIRATION
+	// Key format: seller_domain:{host}
+	SELLER_DOMAIN_CACHE_KEY = "seller_domain:"
+
 	// Inventory Reservation cache keys
 	// Key format: reservation:expiry:{referenceId}
 	RESERVATION_EXPIRY_KEY_PREFIX = "reservation:expiry:"
+
+	// Per-user session revocation marker; JWTs issued before it are rejected
+	// Key format: user_sessions_revoked_at:{userId}
+	USER_SESSIONS_REVOKED_KEY_PREFIX = "user_sessions_revoked_at:"
+
+	// Namespace version counters for read-through caches
+	// Key format: cache:version:{namespace}
+	CACHE_VERSION_KEY_PREFIX = "cache:version:"
+
+	// Read-through cache stampede protection
+	CACHE_TTL_JITTER_FRACTION = 0.1  // TTLs are spread by up to ±10% so keys don't expire together
+	LOCAL_CACHE_MAX_ENTRIES   = 1000 // capacity of the in-process LRU level
+
+	// CACHE_VERSION_LOCAL_TTL is how long a namespace version is kept in process, and so
+	// how long other instances may read a namespace at its previous version
+	CACHE_VERSION_LOCAL_TTL = 2 * time.Second
+
+	// CACHE_LOAD_TIMEOUT bounds a shared load, which no longer follows the cancellation
+	// of the caller that started it
+	CACHE_LOAD_TIMEOUT = 30 * time.Second
 )
@@ -0,0 +1,16 @@
+package constants
+
+const (
+	// CALENDAR_PRODUCT_ID is the PRODID written into every generated iCalendar file
+	CALENDAR_PRODUCT_ID = "-//ecommerce-be//Delivery Slots//EN"
+
+	// CALENDAR_ATTACHMENT_FILENAME names the invite attached to delivery slot emails
+	CALENDAR_ATTACHMENT_FILENAME = "delivery.ics"
+
+	// CALENDAR_EVENT_DATA_KEY carries a calendar.Event in a notification payload. The
+	// email channel renders it as an iCalendar attachment.
+	CALENDAR_EVENT_DATA_KEY = "CalendarEvent"
+
+	// DELIVERY_SLOT_DATA_KEY carries the human readable slot for notification templates
+	DELIVERY_SLOT_DATA_KEY = "DeliverySlot"
+)
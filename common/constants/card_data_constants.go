@@ -0,0 +1,11 @@
+package constants
+
+// Card data (PCI scope) constants
+const (
+	// CARD_DATA_SCAN_MAX_BYTES caps how much of a request body is scanned for card data
+	CARD_DATA_SCAN_MAX_BYTES = 1 << 20
+
+	CARD_DATA_NOT_ACCEPTED_MSG = "Raw card data is not accepted; " +
+		"send only the token issued by the payment gateway's hosted fields"
+	CARD_DATA_NOT_ACCEPTED_CODE = "CARD_DATA_NOT_ACCEPTED"
+)
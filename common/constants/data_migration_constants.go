@@ -0,0 +1,52 @@
+package constants
+
+const (
+	// DATA_MIGRATION_CHUNK_COMMAND is the scheduler command that runs one chunk of a migration.
+	DATA_MIGRATION_CHUNK_COMMAND = "data_migration_chunk"
+
+	// DATA_MIGRATION_RECOVERY_JOB_NAME identifies the stalled-run recovery job in cron logs.
+	DATA_MIGRATION_RECOVERY_JOB_NAME = "data_migration_recovery"
+
+	// DATA_MIGRATION_MAX_BATCH_SIZE caps the rows a single chunk may process.
+	DATA_MIGRATION_MAX_BATCH_SIZE = 10000
+
+	// DATA_MIGRATION_MAX_CHUNK_DELAY_MS caps the pause between chunks (1 hour).
+	DATA_MIGRATION_MAX_CHUNK_DELAY_MS = 3600000
+
+	// DATA_MIGRATION_MAX_CHUNK_RETRIES is how many consecutive chunk failures are retried
+	// (with exponential backoff) before the run is marked failed.
+	DATA_MIGRATION_MAX_CHUNK_RETRIES = 3
+)
+
+const (
+	DATA_MIGRATIONS_LISTED_MSG          = "Data migrations fetched successfully"
+	DATA_MIGRATION_STARTED_MSG          = "Data migration started successfully"
+	DATA_MIGRATION_RUNS_LISTED_MSG      = "Data migration runs fetched successfully"
+	DATA_MIGRATION_RUN_FETCHED_MSG      = "Data migration run fetched successfully"
+	DATA_MIGRATION_PAUSED_MSG           = "Data migration paused successfully"
+	DATA_MIGRATION_RESUMED_MSG          = "Data migration resumed successfully"
+	DATA_MIGRATION_THROTTLE_UPDATED_MSG = "Data migration throttle updated successfully"
+)
+
+const (
+	FAILED_TO_LIST_DATA_MIGRATIONS_MSG           = "Failed to fetch data migrations"
+	FAILED_TO_START_DATA_MIGRATION_MSG           = "Failed to start data migration"
+	FAILED_TO_LIST_DATA_MIGRATION_RUNS_MSG       = "Failed to fetch data migration runs"
+	FAILED_TO_FETCH_DATA_MIGRATION_RUN_MSG       = "Failed to fetch data migration run"
+	FAILED_TO_PAUSE_DATA_MIGRATION_MSG           = "Failed to pause data migration"
+	FAILED_TO_RESUME_DATA_MIGRATION_MSG          = "Failed to resume data migration"
+	FAILED_TO_UPDATE_DATA_MIGRATION_THROTTLE_MSG = "Failed to update data migration throttle"
+)
+
+const (
+	DATA_MIGRATION_NOT_FOUND_CODE          = "DATA_MIGRATION_NOT_FOUND"
+	DATA_MIGRATION_NOT_FOUND_MSG           = "Data migration is not registered"
+	DATA_MIGRATION_RUN_NOT_FOUND_CODE      = "DATA_MIGRATION_RUN_NOT_FOUND"
+	DATA_MIGRATION_RUN_NOT_FOUND_MSG       = "Data migration run not found"
+	DATA_MIGRATION_ALREADY_ACTIVE_CODE     = "DATA_MIGRATION_ALREADY_ACTIVE"
+	DATA_MIGRATION_ALREADY_ACTIVE_MSG      = "Data migration already has a running or paused run"
+	DATA_MIGRATION_INVALID_TRANSITION_CODE = "DATA_MIGRATION_INVALID_TRANSITION"
+	DATA_MIGRATION_INVALID_TRANSITION_MSG  = "Data migration run cannot move to the requested state"
+	DATA_MIGRATION_UNAVAILABLE_CODE        = "DATA_MIGRATION_UNAVAILABLE"
+	DATA_MIGRATION_UNAVAILABLE_MSG         = "Background scheduler is not available"
+)
@@ -0,0 +1,9 @@
+package constants
+
+// Read replica routing
+const (
+	// READ_CONSISTENCY_HEADER lets a client opt out of replica reads on routes that use
+	// them, e.g. right after it wrote data it needs to read back
+	READ_CONSISTENCY_HEADER = "X-Read-Consistency"
+	READ_CONSISTENCY_STRONG = "strong"
+)
@@ -0,0 +1,34 @@
+package constants
+
+// Delegated access token constants
+const (
+	// DELEGATED_TOKEN_PREFIX marks bearer tokens that must be resolved as delegated
+	// grants instead of being parsed as JWTs.
+	DELEGATED_TOKEN_PREFIX = "dlg_"
+
+	// Delegated token messages
+	DELEGATED_TOKEN_INVALID_MSG      = "Delegated token is invalid, expired or revoked"
+	DELEGATED_TOKEN_SCOPE_DENIED_MSG = "Delegated token does not grant access to this resource"
+
+	// Delegated token error codes
+	DELEGATED_TOKEN_INVALID_CODE      = "DELEGATED_TOKEN_INVALID"
+	DELEGATED_TOKEN_SCOPE_DENIED_CODE = "DELEGATED_TOKEN_SCOPE_DENIED"
+)
+
+// Delegated token scopes
+const (
+	DELEGATED_SCOPE_CATALOG_READ    = "catalog:read"
+	DELEGATED_SCOPE_CATALOG_WRITE   = "catalog:write"
+	DELEGATED_SCOPE_INVENTORY_READ  = "inventory:read"
+	DELEGATED_SCOPE_INVENTORY_WRITE = "inventory:write"
+	DELEGATED_SCOPE_ORDERS_READ     = "orders:read"
+	DELEGATED_SCOPE_REPORTS_READ    = "reports:read"
+)
+
+// Delegated token audit actions
+const (
+	DELEGATED_AUDIT_ACTION_CREATED = "created"
+	DELEGATED_AUDIT_ACTION_USED    = "used"
+	DELEGATED_AUDIT_ACTION_DENIED  = "denied"
+	DELEGATED_AUDIT_ACTION_REVOKED = "revoked"
+)
@@ -0,0 +1,28 @@
+package constants
+
+const (
+	// TENANT_CIPHERTEXT_PREFIX marks values sealed with a tenant data key:
+	// tdk:v{version}:{base64(nonce|ciphertext)}
+	TENANT_CIPHERTEXT_PREFIX = "tdk"
+
+	// TENANT_DATA_KEY_SIZE is the AES-256 data key length in bytes
+	TENANT_DATA_KEY_SIZE = 32
+)
+
+const (
+	TENANT_KEYS_LISTED_MSG          = "Tenant data keys fetched successfully"
+	TENANT_KEY_ROTATED_MSG          = "Tenant data key rotated successfully"
+	FAILED_TO_LIST_TENANT_KEYS_MSG  = "Failed to fetch tenant data keys"
+	FAILED_TO_ROTATE_TENANT_KEY_MSG = "Failed to rotate tenant data key"
+)
+
+const (
+	TENANT_KEY_NOT_FOUND_CODE      = "TENANT_KEY_NOT_FOUND"
+	TENANT_KEY_NOT_FOUND_MSG       = "No data key has been provisioned for this seller"
+	TENANT_KEY_UNWRAP_FAILED_CODE  = "TENANT_KEY_UNWRAP_FAILED"
+	TENANT_KEY_UNWRAP_FAILED_MSG   = "Tenant data key could not be unwrapped with the master key"
+	TENANT_CIPHERTEXT_INVALID_CODE = "TENANT_CIPHERTEXT_INVALID"
+	TENANT_CIPHERTEXT_INVALID_MSG  = "Value is not a valid tenant-encrypted ciphertext"
+	TENANT_DECRYPTION_FAILED_CODE  = "TENANT_DECRYPTION_FAILED"
+	TENANT_DECRYPTION_FAILED_MSG   = "Value could not be decrypted with this seller's key"
+)
@@ -27,8 +27,27 @@ const (
 	USER_DATA_MISSING_MSG      = "User data is missing in the context"
 	CORRELATION_ID_MISSING_MSG = "Correlation ID is missing in the context"
 	FILE_NOT_ACCESSIBLE_MSG    = "File is not accessible for display"
+	ERROR_CATALOG_FETCHED_MSG  = "Error codes fetched successfully"
+	ERROR_CODE_NOT_FOUND_MSG   = "Error code not found"
 )
 
 const (
-	REQUEST_FIELD_NAME = "request"
+	REQUEST_FIELD_NAME       = "request"
+	ERROR_CATALOG_FIELD_NAME = "errors"
+)
+
+// Rules reported for binding failures that do not come from a validator tag
+const (
+	VALIDATION_RULE_TYPE          = "type"
+	VALIDATION_RULE_JSON          = "json"
+	VALIDATION_RULE_UNKNOWN_FIELD = "unknown"
+	VALIDATION_RULE_INVALID       = "invalid"
+	// VALIDATION_RULE_MAX_DEPTH and VALIDATION_RULE_MAX_ARRAY_LENGTH report a JSON body
+	// nested too deeply or carrying an oversized array
+	VALIDATION_RULE_MAX_DEPTH        = "maxDepth"
+	VALIDATION_RULE_MAX_ARRAY_LENGTH = "maxArrayLength"
+	// Cross-field rules of the validator package, named after their validator tags
+	VALIDATION_RULE_REQUIRED_WITH = "required_with"
+	VALIDATION_RULE_EXCLUDED_WITH = "excluded_with"
+	VALIDATION_RULE_LTE_FIELD     = "ltefield"
 )
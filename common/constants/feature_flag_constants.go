@@ -0,0 +1,41 @@
+package constants
+
+const (
+	// FEATURE_FLAG_CACHE_NAMESPACE versions the cached flag set; writes bump it
+	FEATURE_FLAG_CACHE_NAMESPACE = "feature_flags"
+	// FEATURE_FLAG_CACHE_TTL is how long the flag set stays in Redis, in seconds
+	FEATURE_FLAG_CACHE_TTL = 600
+	// FEATURE_FLAG_LOCAL_CACHE_TTL is how long an instance keeps the flag set in memory,
+	// in seconds, in front of Redis
+	FEATURE_FLAG_LOCAL_CACHE_TTL = 15
+	// FEATURE_FLAG_KEY_PARAM is the path parameter naming a flag
+	FEATURE_FLAG_KEY_PARAM = "key"
+	// FEATURE_FLAG_FIELD_NAME and FEATURE_FLAGS_FIELD_NAME are the response keys of
+	// one flag and of the flag list
+	FEATURE_FLAG_FIELD_NAME  = "flag"
+	FEATURE_FLAGS_FIELD_NAME = "flags"
+	// FEATURE_FLAG_EVALUATION_FIELD_NAME is the response key of an evaluation
+	FEATURE_FLAG_EVALUATION_FIELD_NAME = "evaluation"
+)
+
+const (
+	FEATURE_FLAGS_LISTED_MSG            = "Feature flags fetched successfully"
+	FEATURE_FLAG_FETCHED_MSG            = "Feature flag fetched successfully"
+	FEATURE_FLAG_CREATED_MSG            = "Feature flag created successfully"
+	FEATURE_FLAG_UPDATED_MSG            = "Feature flag updated successfully"
+	FEATURE_FLAG_DELETED_MSG            = "Feature flag deleted successfully"
+	FEATURE_FLAG_EVALUATED_MSG          = "Feature flag evaluated successfully"
+	FAILED_TO_LIST_FEATURE_FLAGS_MSG    = "Failed to fetch feature flags"
+	FAILED_TO_FETCH_FEATURE_FLAG_MSG    = "Failed to fetch feature flag"
+	FAILED_TO_CREATE_FEATURE_FLAG_MSG   = "Failed to create feature flag"
+	FAILED_TO_UPDATE_FEATURE_FLAG_MSG   = "Failed to update feature flag"
+	FAILED_TO_DELETE_FEATURE_FLAG_MSG   = "Failed to delete feature flag"
+	FAILED_TO_EVALUATE_FEATURE_FLAG_MSG = "Failed to evaluate feature flag"
+)
+
+const (
+	FEATURE_FLAG_NOT_FOUND_CODE = "FEATURE_FLAG_NOT_FOUND"
+	FEATURE_FLAG_NOT_FOUND_MSG  = "Feature flag not found"
+	FEATURE_FLAG_EXISTS_CODE    = "FEATURE_FLAG_EXISTS"
+	FEATURE_FLAG_EXISTS_MSG     = "A feature flag with this key already exists"
+)
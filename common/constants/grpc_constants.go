@@ -0,0 +1,17 @@
+package constants
+
+// gRPC metadata keys (lower-case forms of the REST headers)
+const (
+	GRPC_AUTHORIZATION_METADATA  = "authorization"
+	GRPC_CORRELATION_ID_METADATA = "x-correlation-id"
+	GRPC_SELLER_ID_METADATA      = "x-seller-id"
+
+	// GRPC_ERROR_CODE_METADATA is the trailer carrying the application error code
+	GRPC_ERROR_CODE_METADATA = "x-error-code"
+)
+
+// gRPC messages
+const (
+	GRPC_DELEGATED_TOKEN_UNSUPPORTED_MSG = "Delegated access tokens are not accepted by the gRPC API"
+	GRPC_INTERNAL_ERROR_MSG              = "Internal server error"
+)
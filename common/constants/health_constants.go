@@ -0,0 +1,17 @@
+package constants
+
+const (
+	// HEALTH_STATUS_UP means every dependency answered
+	HEALTH_STATUS_UP = "up"
+	// HEALTH_STATUS_DEGRADED means only optional dependencies are down; traffic is still served
+	HEALTH_STATUS_DEGRADED = "degraded"
+	// HEALTH_STATUS_DOWN means a required dependency is down; the pod must not receive traffic
+	HEALTH_STATUS_DOWN = "down"
+)
+
+const (
+	HEALTH_CHECK_POSTGRES   = "postgres"
+	HEALTH_CHECK_REDIS      = "redis"
+	HEALTH_CHECK_MIGRATIONS = "migrations"
+	HEALTH_CHECK_MAIL       = "mail"
+)
@@ -0,0 +1,13 @@
+package constants
+
+// Conditional request headers
+const (
+	ETAG_HEADER          = "ETag"
+	IF_NONE_MATCH_HEADER = "If-None-Match"
+	CACHE_CONTROL_HEADER = "Cache-Control"
+	VARY_HEADER          = "Vary"
+)
+
+// CONDITIONAL_GET_VARY lists the request headers a conditional read's body depends
+// on: the content locale, the signed-in user and the seller
+const CONDITIONAL_GET_VARY = "Accept-Language, Authorization, " + SELLER_ID_HEADER
@@ -0,0 +1,7 @@
+package constants
+
+// Localization constants
+const (
+	ACCEPT_LANGUAGE_HEADER  = "Accept-Language"
+	CONTENT_LANGUAGE_HEADER = "Content-Language"
+)
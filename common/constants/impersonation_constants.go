@@ -0,0 +1,23 @@
+package constants
+
+// Impersonation constants
+const (
+	// IMPERSONATED_BY_HEADER is set on every response served to an impersonation token
+	IMPERSONATED_BY_HEADER = "X-Impersonated-By"
+
+	// Impersonation messages
+	IMPERSONATION_INVALID_MSG     = "Impersonation session is invalid, expired or ended"
+	IMPERSONATION_NOT_ALLOWED_MSG = "This action is not available while impersonating a seller"
+
+	// Impersonation error codes
+	IMPERSONATION_INVALID_CODE     = "IMPERSONATION_INVALID"
+	IMPERSONATION_NOT_ALLOWED_CODE = "IMPERSONATION_NOT_ALLOWED"
+)
+
+// Impersonation audit actions
+const (
+	IMPERSONATION_AUDIT_ACTION_STARTED = "started"
+	IMPERSONATION_AUDIT_ACTION_REQUEST = "request"
+	IMPERSONATION_AUDIT_ACTION_DENIED  = "denied"
+	IMPERSONATION_AUDIT_ACTION_ENDED   = "ended"
+)
@@ -0,0 +1,16 @@
+package constants
+
+// Inventory costing methods a seller can value stock with. They live here because the
+// seller settings in the user module store the choice the inventory module applies.
+const (
+	// INVENTORY_COSTING_FIFO consumes the oldest restock lots first
+	INVENTORY_COSTING_FIFO = "FIFO"
+
+	// INVENTORY_COSTING_AVERAGE values every unit at the moving-average cost of stock on hand
+	INVENTORY_COSTING_AVERAGE = "AVERAGE"
+)
+
+// IsValidInventoryCostingMethod reports whether method is a supported costing method
+func IsValidInventoryCostingMethod(method string) bool {
+	return method == INVENTORY_COSTING_FIFO || method == INVENTORY_COSTING_AVERAGE
+}
@@ -16,4 +16,18 @@ const (
 	// ROUTING_KEY_FILE_IMAGE_PROCESS_REQUESTED is used by complete-upload to trigger async
 	// variant generation for eligible file purposes (PRODUCT_IMAGE, USER_AVATAR, raster SELLER_LOGO).
 	ROUTING_KEY_FILE_IMAGE_PROCESS_REQUESTED = "file.image.process.requested"
+
+	// Fulfillment module routing keys
+	// ROUTING_KEY_SHIPMENT_STATUS_CHANGED is published on the events exchange whenever a
+	// carrier tracking update moves a shipment to a new status (consumed by notification).
+	ROUTING_KEY_SHIPMENT_STATUS_CHANGED = "fulfillment.shipment.status.changed"
+
+	// Product module routing keys
+	// ROUTING_KEY_PRODUCT_CHANGED is published on the events exchange by any module that
+	// changes product data outside the product repositories (consumed by the product cache).
+	ROUTING_KEY_PRODUCT_CHANGED = "product.product.changed"
+
+	// QUEUE_PRODUCT_CACHE_INVALIDATION is bound to product.# on the events exchange and
+	// evicts cached product detail and variant previews.
+	QUEUE_PRODUCT_CACHE_INVALIDATION = "product.cache.invalidation"
 )
@@ -0,0 +1,40 @@
+package constants
+
+// Notification event names passed to notifier.Notifier.Notify. They double as the
+// notification module's template keys, so renaming one orphans admin templates.
+const (
+	NOTIFY_EVENT_ORDER_PLACED            = "order.placed"
+	NOTIFY_EVENT_ORDER_CANCELLED         = "order.cancelled"
+	NOTIFY_EVENT_DELIVERY_RESCHEDULED    = "order.delivery_rescheduled"
+	NOTIFY_EVENT_ORDER_BALANCE_DUE       = "order.balance_due"
+	NOTIFY_EVENT_ORDER_MESSAGE_RECEIVED  = "order.message_received"
+	NOTIFY_EVENT_GUEST_ORDER_CLAIM       = "order.guest_claim_confirm"
+	NOTIFY_EVENT_ORDER_DOWNLOADS_READY   = "order.downloads_ready"
+	NOTIFY_EVENT_PAYMENT_RECEIVED        = "payment.received"
+	NOTIFY_EVENT_PAYMENT_FAILED          = "payment.failed"
+	NOTIFY_EVENT_PAYMENT_DECLINE_SPIKE   = "payment.decline_spike"
+	NOTIFY_EVENT_SHIPMENT_STATUS_CHANGED = "shipment.status_changed"
+	NOTIFY_EVENT_SHIPMENT_DELIVERED      = "shipment.delivered"
+	NOTIFY_EVENT_INVENTORY_LOW_STOCK     = "inventory.low_stock"
+	NOTIFY_EVENT_EMAIL_CHANGE_CONFIRM    = "account.email_change_confirm"
+	NOTIFY_EVENT_EMAIL_CHANGED           = "account.email_changed"
+	NOTIFY_EVENT_SELLER_HEALTH_WARNING   = "seller.health_warning"
+	NOTIFY_EVENT_SELLER_LISTING_DEMOTED  = "seller.listing_demoted"
+)
+
+// NOTIFY_EMAIL_TO_DATA_KEY is the reserved payload key that sends a notification by
+// email only, to the given address instead of the user's stored one. Account
+// security events use it to reach an address that is not (or no longer) on file.
+const NOTIFY_EMAIL_TO_DATA_KEY = "_emailTo"
+
+// REFUND_AMOUNT_DATA_KEY carries the formatted amount refunded with a cancellation
+// for the order.cancelled templates. It is absent when nothing was refunded.
+const REFUND_AMOUNT_DATA_KEY = "RefundAmount"
+
+// DOWNLOADS_DATA_KEY and LICENSE_KEYS_DATA_KEY carry the delivered download links
+// ({ProductName, Name, URL}) and license keys ({ProductName, Key}) for the
+// order.downloads_ready templates.
+const (
+	DOWNLOADS_DATA_KEY    = "Downloads"
+	LICENSE_KEYS_DATA_KEY = "LicenseKeys"
+)
@@ -0,0 +1,16 @@
+package constants
+
+// Request body limit errors
+const (
+	REQUEST_BODY_TOO_LARGE_CODE = "REQUEST_BODY_TOO_LARGE"
+	REQUEST_BODY_TOO_LARGE_MSG  = "Request body is too large"
+)
+
+// Request deadline errors
+const (
+	REQUEST_TIMEOUT_CODE = "REQUEST_TIMEOUT"
+	REQUEST_TIMEOUT_MSG  = "Request took too long to complete"
+)
+
+// REQUEST_LOG_BODY_MAX_BYTES caps how much of a request body extended logging keeps
+const REQUEST_LOG_BODY_MAX_BYTES = 64 << 10
@@ -0,0 +1,57 @@
+package constants
+
+const (
+	// DEAD_LETTER_JOB_FIELD_NAME is the response key of a requeued job
+	DEAD_LETTER_JOB_FIELD_NAME = "job"
+	// PURGED_JOBS_FIELD_NAME is the response key of a purged job count
+	PURGED_JOBS_FIELD_NAME = "purged"
+	// DEAD_LETTER_JOB_ID_PARAM is the path parameter naming a dead-lettered job
+	DEAD_LETTER_JOB_ID_PARAM = "jobId"
+	// CRON_JOB_NAME_PARAM is the path parameter naming a recurring job
+	CRON_JOB_NAME_PARAM = "name"
+	// CRON_JOB_FIELD_NAME is the response key of a recurring job's status
+	CRON_JOB_FIELD_NAME = "job"
+	// CRON_JOBS_FIELD_NAME is the response key of the recurring job list
+	CRON_JOBS_FIELD_NAME = "jobs"
+	// JOB_SUMMARY_FIELD_NAME, JOB_RUNS_FIELD_NAME and JOBS_FIELD_NAME are the response
+	// keys of the job monitoring endpoints
+	JOB_SUMMARY_FIELD_NAME = "summary"
+	JOB_RUNS_FIELD_NAME    = "runs"
+	JOBS_FIELD_NAME        = "jobs"
+)
+
+const (
+	DEAD_LETTER_JOBS_LISTED_MSG       = "Failed jobs fetched successfully"
+	DEAD_LETTER_JOB_REQUEUED_MSG      = "Failed job requeued successfully"
+	DEAD_LETTER_JOBS_PURGED_MSG       = "Failed jobs purged successfully"
+	FAILED_TO_LIST_DEAD_LETTERS_MSG   = "Failed to fetch failed jobs"
+	FAILED_TO_REQUEUE_DEAD_LETTER_MSG = "Failed to requeue failed job"
+	FAILED_TO_PURGE_DEAD_LETTERS_MSG  = "Failed to purge failed jobs"
+	CRON_JOBS_LISTED_MSG              = "Recurring jobs fetched successfully"
+	CRON_JOB_FETCHED_MSG              = "Recurring job fetched successfully"
+	FAILED_TO_LIST_CRON_JOBS_MSG      = "Failed to fetch recurring jobs"
+	FAILED_TO_FETCH_CRON_JOB_MSG      = "Failed to fetch recurring job"
+	JOB_SUMMARY_FETCHED_MSG           = "Job summary fetched successfully"
+	QUEUED_JOBS_LISTED_MSG            = "Queued jobs fetched successfully"
+	RUNNING_JOBS_LISTED_MSG           = "Running jobs fetched successfully"
+	STALE_RUNNING_JOBS_PURGED_MSG     = "Stale running jobs purged successfully"
+	JOB_RUNS_LISTED_MSG               = "Job runs fetched successfully"
+	FAILED_TO_FETCH_JOB_SUMMARY_MSG   = "Failed to fetch job summary"
+	FAILED_TO_LIST_QUEUED_JOBS_MSG    = "Failed to fetch queued jobs"
+	FAILED_TO_LIST_RUNNING_JOBS_MSG   = "Failed to fetch running jobs"
+	FAILED_TO_PURGE_RUNNING_JOBS_MSG  = "Failed to purge stale running jobs"
+	FAILED_TO_LIST_JOB_RUNS_MSG       = "Failed to fetch job runs"
+)
+
+const (
+	DEAD_LETTER_JOB_NOT_FOUND_CODE  = "DEAD_LETTER_JOB_NOT_FOUND"
+	DEAD_LETTER_JOB_NOT_FOUND_MSG   = "Failed job not found"
+	JOB_QUEUE_UNAVAILABLE_CODE      = "JOB_QUEUE_UNAVAILABLE"
+	JOB_QUEUE_UNAVAILABLE_MSG       = "Background job queue is not available"
+	INVALID_JOB_PRIORITY_CODE       = "INVALID_JOB_PRIORITY"
+	INVALID_JOB_PRIORITY_MSG        = "Job priority must be high, normal or low"
+	CRON_JOB_NOT_FOUND_CODE         = "CRON_JOB_NOT_FOUND"
+	CRON_JOB_NOT_FOUND_MSG          = "Recurring job not found"
+	CRON_SCHEDULER_UNAVAILABLE_CODE = "CRON_SCHEDULER_UNAVAILABLE"
+	CRON_SCHEDULER_UNAVAILABLE_MSG  = "Recurring job scheduler is not running"
+)
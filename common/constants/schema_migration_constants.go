@@ -0,0 +1,14 @@
+package constants
+
+const (
+	// SCHEMA_MIGRATIONS_TABLE records which SQL migrations have been applied.
+	SCHEMA_MIGRATIONS_TABLE = "schema_migrations"
+
+	// SCHEMA_MIGRATION_LOCK_KEY is the Postgres advisory lock held while migrating, so
+	// instances starting together apply each migration once.
+	SCHEMA_MIGRATION_LOCK_KEY = 7264011
+
+	// SCHEMA_MIGRATION_DOWN_DIR holds rollback scripts, relative to the migrations
+	// directory, named like the migration they undo.
+	SCHEMA_MIGRATION_DOWN_DIR = "down"
+)
@@ -0,0 +1,41 @@
+package constants
+
+const (
+	// SCREENING_PROVIDER_DENYLIST screens against the configured deny lists only
+	SCREENING_PROVIDER_DENYLIST = "denylist"
+
+	// SCREENING_PROVIDER_HTTP additionally calls the external screening provider
+	SCREENING_PROVIDER_HTTP = "http"
+
+	// SCREENING_PROVIDER_MAX_RESPONSE_BYTES caps the external provider response read
+	SCREENING_PROVIDER_MAX_RESPONSE_BYTES = 1 << 20
+)
+
+const (
+	SCREENING_DENY_LIST_LISTED_MSG  = "Deny list entries fetched successfully"
+	SCREENING_DENY_LIST_ADDED_MSG   = "Deny list entry added successfully"
+	SCREENING_DENY_LIST_REMOVED_MSG = "Deny list entry removed successfully"
+	SCREENING_CASES_LISTED_MSG      = "Screening cases fetched successfully"
+	SCREENING_CASE_FETCHED_MSG      = "Screening case fetched successfully"
+	SCREENING_CASE_RESOLVED_MSG     = "Screening case resolved successfully"
+)
+
+const (
+	FAILED_TO_LIST_DENY_LIST_MSG         = "Failed to fetch deny list entries"
+	FAILED_TO_ADD_DENY_LIST_ENTRY_MSG    = "Failed to add deny list entry"
+	FAILED_TO_REMOVE_DENY_LIST_ENTRY_MSG = "Failed to remove deny list entry"
+	FAILED_TO_LIST_SCREENING_CASES_MSG   = "Failed to fetch screening cases"
+	FAILED_TO_FETCH_SCREENING_CASE_MSG   = "Failed to fetch screening case"
+	FAILED_TO_RESOLVE_SCREENING_CASE_MSG = "Failed to resolve screening case"
+)
+
+const (
+	SCREENING_CASE_NOT_FOUND_CODE        = "SCREENING_CASE_NOT_FOUND"
+	SCREENING_CASE_NOT_FOUND_MSG         = "Screening case not found"
+	SCREENING_CASE_ALREADY_RESOLVED_CODE = "SCREENING_CASE_ALREADY_RESOLVED"
+	SCREENING_CASE_ALREADY_RESOLVED_MSG  = "Screening case has already been resolved"
+	DENY_LIST_ENTRY_NOT_FOUND_CODE       = "DENY_LIST_ENTRY_NOT_FOUND"
+	DENY_LIST_ENTRY_NOT_FOUND_MSG        = "Deny list entry not found"
+	DENY_LIST_ENTRY_INVALID_CODE         = "DENY_LIST_ENTRY_INVALID"
+	DENY_LIST_ENTRY_INVALID_MSG          = "Deny list entry has no matchable characters"
+)
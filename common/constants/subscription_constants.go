@@ -0,0 +1,19 @@
+package constants
+
+// Plan limits and features enforced on sellers
+const (
+	// Catalog resources a plan limits
+	PLAN_LIMIT_PRODUCTS = "products"
+	PLAN_LIMIT_VARIANTS = "variants"
+
+	// Features a plan can include
+	PLAN_FEATURE_RELATED_PRODUCTS_API = "related_products_api"
+
+	// PLAN_LIMIT_REACHED_MSG is formatted with the limit and the resource
+	PLAN_LIMIT_REACHED_MSG       = "Plan limit reached: your plan allows up to %d %s"
+	PLAN_FEATURE_UNAVAILABLE_MSG = "Your plan does not include this feature"
+	PLAN_USAGE_CHECK_FAILED_MSG  = "Failed to check plan usage"
+
+	PLAN_LIMIT_REACHED_CODE       = "PLAN_LIMIT_REACHED"
+	PLAN_FEATURE_UNAVAILABLE_CODE = "PLAN_FEATURE_UNAVAILABLE"
+)
@@ -0,0 +1,21 @@
+package cron
+
+import (
+	"ecommerce-be/common"
+
+	"github.com/gin-gonic/gin"
+)
+
+// NewContainer registers the recurring job status routes. Jobs themselves are
+// registered by their owning modules with RegisterJob and its helpers.
+func NewContainer(router *gin.Engine) *common.Container {
+	c := &common.Container{}
+
+	c.RegisterModule(NewModule(NewHandler(DefaultScheduler)))
+
+	for _, module := range c.Modules {
+		module.RegisterRoutes(router)
+	}
+
+	return c
+}
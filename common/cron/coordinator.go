@@ -0,0 +1,195 @@
+package cron
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
+)
+
+const (
+	// lockKeyPrefix + job name is the Redis key of a job's lock
+	lockKeyPrefix = "cron_lock:"
+	// runStatusKey maps a job name to the RunStatus JSON of its latest run
+	runStatusKey = "cron_job_runs"
+)
+
+// extendLockScript sets a lock's TTL only while the caller's token still holds it
+var extendLockScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("PEXPIRE", KEYS[1], ARGV[2])
+end
+return 0`)
+
+// releaseLockScript deletes a lock only while the caller's token still holds it
+var releaseLockScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0`)
+
+// Coordinator elects the instance that runs each occurrence of a job and keeps the
+// status of every job's latest run, wherever it ran
+type Coordinator interface {
+	// Acquire takes the job's lock for ttl. acquired is false while another run,
+	// on this instance or another one, holds it.
+	Acquire(
+		ctx context.Context,
+		job string,
+		ttl time.Duration,
+	) (token string, acquired bool, err error)
+	// Extend sets the TTL of a lock the token still holds
+	Extend(ctx context.Context, job, token string, ttl time.Duration) error
+	// Release frees a lock the token still holds
+	Release(ctx context.Context, job, token string) error
+	// SaveRun records a job's latest run
+	SaveRun(ctx context.Context, run RunStatus) error
+	// LastRuns returns the latest run of every job that has run, by job name
+	LastRuns(ctx context.Context) (map[string]RunStatus, error)
+}
+
+// RedisCoordinator coordinates jobs across instances sharing a Redis
+type RedisCoordinator struct {
+	rdb redis.UniversalClient
+}
+
+// NewRedisCoordinator creates a Coordinator on rdb
+func NewRedisCoordinator(rdb redis.UniversalClient) *RedisCoordinator {
+	return &RedisCoordinator{rdb: rdb}
+}
+
+func (r *RedisCoordinator) Acquire(
+	ctx context.Context,
+	job string,
+	ttl time.Duration,
+) (string, bool, error) {
+	token := uuid.NewString()
+	acquired, err := r.rdb.SetNX(ctx, lockKeyPrefix+job, token, ttl).Result()
+	if err != nil {
+		return "", false, fmt.Errorf("failed to acquire lock of cron job %s: %w", job, err)
+	}
+	return token, acquired, nil
+}
+
+func (r *RedisCoordinator) Extend(
+	ctx context.Context,
+	job, token string,
+	ttl time.Duration,
+) error {
+	key := []string{lockKeyPrefix + job}
+	if err := extendLockScript.Run(ctx, r.rdb, key, token, ttl.Milliseconds()).Err(); err != nil {
+		return fmt.Errorf("failed to extend lock of cron job %s: %w", job, err)
+	}
+	return nil
+}
+
+func (r *RedisCoordinator) Release(ctx context.Context, job, token string) error {
+	key := []string{lockKeyPrefix + job}
+	if err := releaseLockScript.Run(ctx, r.rdb, key, token).Err(); err != nil {
+		return fmt.Errorf("failed to release lock of cron job %s: %w", job, err)
+	}
+	return nil
+}
+
+func (r *RedisCoordinator) SaveRun(ctx context.Context, run RunStatus) error {
+	data, err := json.Marshal(run)
+	if err != nil {
+		return fmt.Errorf("failed to marshal run of cron job %s: %w", run.Job, err)
+	}
+	if err := r.rdb.HSet(ctx, runStatusKey, run.Job, data).Err(); err != nil {
+		return fmt.Errorf("failed to save run of cron job %s: %w", run.Job, err)
+	}
+	return nil
+}
+
+func (r *RedisCoordinator) LastRuns(ctx context.Context) (map[string]RunStatus, error) {
+	values, err := r.rdb.HGetAll(ctx, runStatusKey).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load cron job runs: %w", err)
+	}
+	runs := make(map[string]RunStatus, len(values))
+	for job, data := range values {
+		var run RunStatus
+		if err := json.Unmarshal([]byte(data), &run); err == nil {
+			runs[job] = run
+		}
+	}
+	return runs, nil
+}
+
+// LocalCoordinator coordinates jobs within one process. It is used when Redis is not
+// available, where each instance runs every job itself.
+type LocalCoordinator struct {
+	mu    sync.Mutex
+	locks map[string]localLock
+	runs  map[string]RunStatus
+}
+
+type localLock struct {
+	token     string
+	expiresAt time.Time
+}
+
+// NewLocalCoordinator creates an in-memory Coordinator
+func NewLocalCoordinator() *LocalCoordinator {
+	return &LocalCoordinator{locks: map[string]localLock{}, runs: map[string]RunStatus{}}
+}
+
+func (l *LocalCoordinator) Acquire(
+	_ context.Context,
+	job string,
+	ttl time.Duration,
+) (string, bool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if lock, held := l.locks[job]; held && time.Now().Before(lock.expiresAt) {
+		return "", false, nil
+	}
+	token := uuid.NewString()
+	l.locks[job] = localLock{token: token, expiresAt: time.Now().Add(ttl)}
+	return token, true, nil
+}
+
+func (l *LocalCoordinator) Extend(_ context.Context, job, token string, ttl time.Duration) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if lock, held := l.locks[job]; held && lock.token == token {
+		l.locks[job] = localLock{token: token, expiresAt: time.Now().Add(ttl)}
+	}
+	return nil
+}
+
+func (l *LocalCoordinator) Release(_ context.Context, job, token string) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if lock, held := l.locks[job]; held && lock.token == token {
+		delete(l.locks, job)
+	}
+	return nil
+}
+
+func (l *LocalCoordinator) SaveRun(_ context.Context, run RunStatus) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.runs[run.Job] = run
+	return nil
+}
+
+func (l *LocalCoordinator) LastRuns(_ context.Context) (map[string]RunStatus, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	runs := make(map[string]RunStatus, len(l.runs))
+	for job, run := range l.runs {
+		runs[job] = run
+	}
+	return runs, nil
+}
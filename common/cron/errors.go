@@ -0,0 +1,26 @@
+package cron
+
+import (
+	"net/http"
+
+	"ecommerce-be/common/constants"
+	commonError "ecommerce-be/common/error"
+)
+
+var (
+	ErrJobNotFound = &commonError.AppError{
+		Code:       constants.CRON_JOB_NOT_FOUND_CODE,
+		Message:    constants.CRON_JOB_NOT_FOUND_MSG,
+		StatusCode: http.StatusNotFound,
+	}
+
+	ErrSchedulerUnavailable = &commonError.AppError{
+		Code:       constants.CRON_SCHEDULER_UNAVAILABLE_CODE,
+		Message:    constants.CRON_SCHEDULER_UNAVAILABLE_MSG,
+		StatusCode: http.StatusServiceUnavailable,
+	}
+)
+
+func init() {
+	commonError.Register(ErrJobNotFound, ErrSchedulerUnavailable)
+}
@@ -0,0 +1,75 @@
+package cron
+
+import (
+	"net/http"
+
+	"ecommerce-be/common/constants"
+	"ecommerce-be/common/handler"
+	"ecommerce-be/common/log"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Handler handles the recurring job status API
+type Handler struct {
+	*handler.BaseHandler
+	scheduler *Scheduler
+}
+
+// NewHandler creates a new instance of Handler. scheduler is nil when the cron
+// scheduler was not initialized, in which case every call fails with
+// ErrSchedulerUnavailable.
+func NewHandler(scheduler *Scheduler) *Handler {
+	return &Handler{
+		BaseHandler: handler.NewBaseHandler(),
+		scheduler:   scheduler,
+	}
+}
+
+// ListJobs handles listing recurring jobs with their last and next runs
+// GET /api/jobs/cron
+func (h *Handler) ListJobs(c *gin.Context) {
+	if h.scheduler == nil {
+		h.HandleError(c, ErrSchedulerUnavailable, constants.FAILED_TO_LIST_CRON_JOBS_MSG)
+		return
+	}
+
+	response, err := h.scheduler.Jobs(c)
+	if err != nil {
+		log.ErrorWithContext(c, "listCronJobs: failed", err)
+		h.HandleError(c, err, constants.FAILED_TO_LIST_CRON_JOBS_MSG)
+		return
+	}
+
+	h.SuccessWithData(
+		c,
+		http.StatusOK,
+		constants.CRON_JOBS_LISTED_MSG,
+		constants.CRON_JOBS_FIELD_NAME,
+		response,
+	)
+}
+
+// GetJob handles fetching a recurring job's last and next runs
+// GET /api/jobs/cron/:name
+func (h *Handler) GetJob(c *gin.Context) {
+	if h.scheduler == nil {
+		h.HandleError(c, ErrSchedulerUnavailable, constants.FAILED_TO_FETCH_CRON_JOB_MSG)
+		return
+	}
+
+	response, err := h.scheduler.Job(c, c.Param(constants.CRON_JOB_NAME_PARAM))
+	if err != nil {
+		log.ErrorWithContext(c, "getCronJob: failed", err)
+		h.HandleError(c, err, constants.FAILED_TO_FETCH_CRON_JOB_MSG)
+		return
+	}
+
+	h.SuccessWithData(
+		c,
+		http.StatusOK,
+		constants.CRON_JOB_FETCHED_MSG,
+		constants.CRON_JOB_FIELD_NAME,
+		response,
+	)
+}
@@ -0,0 +1,40 @@
+package cron
+
+import (
+	"net/http"
+
+	"ecommerce-be/common/constants"
+	"ecommerce-be/common/middleware"
+	"ecommerce-be/common/openapi"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Module registers the recurring job status routes
+type Module struct {
+	handler *Handler
+}
+
+// NewModule creates a new instance of Module
+func NewModule(handler *Handler) *Module {
+	return &Module{handler: handler}
+}
+
+// RegisterRoutes registers recurring job status routes (admin only)
+func (m *Module) RegisterRoutes(router *gin.Engine) {
+	jobRoutes := openapi.NewGroup(router.Group(constants.APIBaseJobs), "Background Jobs")
+	jobRoutes.Use(middleware.AdminAuth())
+	{
+		// GET /api/jobs/cron - Recurring jobs with their last and next runs
+		jobRoutes.GET("/cron", m.handler.ListJobs).
+			Summary("List recurring jobs").
+			ReturnsField(http.StatusOK, "jobs", []JobStatus{})
+
+		// GET /api/jobs/cron/:name - A recurring job's last and next runs
+		jobRoutes.GET("/cron/:name", m.handler.GetJob).
+			Summary("Get a recurring job").
+			Description("The last run is the latest on any instance; the next run is when "+
+				"this instance next competes for the job's lock.").
+			ReturnsField(http.StatusOK, "job", JobStatus{})
+	}
+}
@@ -1,28 +1,78 @@
 package cron
 
 import (
+	"context"
 	"fmt"
 	"runtime/debug"
+	"sync"
 	"time"
 
+	"ecommerce-be/common/cache"
+	"ecommerce-be/common/lifecycle"
 	"ecommerce-be/common/log"
 
 	robfigCron "github.com/robfig/cron/v3"
 )
 
-// Scheduler manages all recurring background jobs in the system
+const (
+	// lockRenewTTL is the lifetime of a running job's lock. The lock is renewed every
+	// lockRenewInterval, so it only lapses when the instance running the job dies.
+	lockRenewTTL      = 30 * time.Second
+	lockRenewInterval = 10 * time.Second
+)
+
+// parser reads the 6-field expressions (with seconds) and descriptors such as @every
+var parser = robfigCron.NewParser(
+	robfigCron.Second | robfigCron.Minute | robfigCron.Hour |
+		robfigCron.Dom | robfigCron.Month | robfigCron.Dow | robfigCron.Descriptor,
+)
+
+// Scheduler manages all recurring background jobs in the system.
+//
+// Every instance schedules every job, and each occurrence runs on the instance that
+// takes the job's lock first. The lock is held while the job runs and, afterwards,
+// for most of the time until its next occurrence, so instances whose clocks or
+// interval timers are slightly apart skip it instead of running it again.
 type Scheduler struct {
-	cron *robfigCron.Cron
+	cron        *robfigCron.Cron
+	coordinator Coordinator
+	instance    string
+
+	mu   sync.RWMutex
+	jobs map[string]*job
+}
+
+// job is a registered recurring job
+type job struct {
+	name     string
+	spec     string
+	schedule robfigCron.Schedule
+	entryID  robfigCron.EntryID
+	cmd      func()
 }
 
 var DefaultScheduler *Scheduler
 
-// Init initializes the global cron scheduler
+// Init initializes the global cron scheduler. Jobs are coordinated through Redis when
+// it is connected; otherwise each instance runs every job itself.
 func Init() {
 	if DefaultScheduler != nil {
 		return
 	}
 
+	var coordinator Coordinator
+	if redisClient, err := cache.GetRedisClient(); err == nil {
+		coordinator = NewRedisCoordinator(redisClient)
+	} else {
+		log.Warn("Cron jobs are not coordinated across instances: Redis is not connected")
+		coordinator = NewLocalCoordinator()
+	}
+
+	DefaultScheduler = NewScheduler(coordinator)
+}
+
+// NewScheduler creates a Scheduler whose jobs are coordinated by coordinator
+func NewScheduler(coordinator Coordinator) *Scheduler {
 	logger := robfigCron.PrintfLogger(panicLogger{})
 
 	c := robfigCron.New(
@@ -32,8 +82,11 @@ func Init() {
 		),
 	)
 
-	DefaultScheduler = &Scheduler{
-		cron: c,
+	return &Scheduler{
+		cron:        c,
+		coordinator: coordinator,
+		instance:    lifecycle.Instance(),
+		jobs:        map[string]*job{},
 	}
 }
 
@@ -43,25 +96,34 @@ func Init() {
 //	seconds(0-59), minutes(0-59), hours(0-23), day of month(1-31), month(1-12), day of week(0-6)
 //	Example: "0 0 * * * *" (runs at minute 0 of every hour)
 //
-// name: unique identifier used for logging job execution and tracking
+// name: unique identifier used for logging job execution, its lock and its status
 func RegisterJob(schedule string, name string, cmd func()) error {
 	if DefaultScheduler == nil {
 		return fmt.Errorf("cron scheduler not initialized")
 	}
+	return DefaultScheduler.RegisterJob(schedule, name, cmd)
+}
 
-	wrappedCmd := func() {
-		log.Info(fmt.Sprintf("[CRON] Starting job: %s", name))
-		start := time.Now()
-		cmd()
-		log.Info(fmt.Sprintf("[CRON] Completed job: %s (took %v)", name, time.Since(start)))
-	}
-
-	_, err := DefaultScheduler.cron.AddFunc(schedule, wrappedCmd)
+// RegisterJob adds a new recurring job to the scheduler
+func (s *Scheduler) RegisterJob(spec string, name string, cmd func()) error {
+	schedule, err := parser.Parse(spec)
 	if err != nil {
 		return fmt.Errorf("failed to register cron job %s: %w", name, err)
 	}
 
-	log.Info(fmt.Sprintf("Registered cron job: %s (Schedule: %s)", name, schedule))
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Instances share a job's lock and status by name, so names must be unique
+	if _, exists := s.jobs[name]; exists {
+		return fmt.Errorf("failed to register cron job %s: name already registered", name)
+	}
+
+	j := &job{name: name, spec: spec, schedule: schedule, cmd: cmd}
+	j.entryID = s.cron.Schedule(schedule, robfigCron.FuncJob(func() { s.run(j) }))
+	s.jobs[name] = j
+
+	log.Info(fmt.Sprintf("Registered cron job: %s (Schedule: %s)", name, spec))
 	return nil
 }
 
@@ -96,24 +158,143 @@ func RegisterDailyJob(hour int, minute int, tz string, name string, cmd func())
 	return RegisterJob(schedule, name, cmd)
 }
 
+// run runs an occurrence of j when this instance takes its lock, recording the run
+func (s *Scheduler) run(j *job) {
+	ctx := context.Background()
+
+	token, acquired, err := s.coordinator.Acquire(ctx, j.name, lockRenewTTL)
+	if err != nil {
+		// Without the lock every instance runs the job, as they did before locking
+		log.Error(fmt.Sprintf("[CRON] Running job %s without its lock", j.name), err)
+	} else if !acquired {
+		log.Debug(fmt.Sprintf("[CRON] Skipping job %s: running or already run elsewhere", j.name))
+		return
+	}
+	locked := err == nil
+
+	log.Info(fmt.Sprintf("[CRON] Starting job: %s", j.name))
+	run := RunStatus{
+		Job:       j.name,
+		Instance:  s.instance,
+		Result:    RunResultRunning,
+		StartedAt: time.Now().UTC(),
+	}
+	s.saveRun(ctx, run)
+
+	stopRenewing := func() {}
+	if locked {
+		stopRenewing = s.renewLock(j.name, token)
+	}
+
+	defer func() {
+		recovered := recover()
+		stopRenewing()
+
+		finishedAt := time.Now().UTC()
+		run.FinishedAt = &finishedAt
+		run.DurationMs = finishedAt.Sub(run.StartedAt).Milliseconds()
+		run.Result = RunResultSucceeded
+		if recovered != nil {
+			run.Result = RunResultFailed
+			run.Error = fmt.Sprint(recovered)
+		}
+		s.saveRun(ctx, run)
+		if locked {
+			s.holdLock(ctx, j, token)
+		}
+
+		if recovered != nil {
+			// Let the Recover chain log the panic with its stack
+			panic(recovered)
+		}
+		log.Info(fmt.Sprintf(
+			"[CRON] Completed job: %s (took %v)", j.name, finishedAt.Sub(run.StartedAt),
+		))
+	}()
+
+	j.cmd()
+}
+
+// renewLock keeps a running job's lock alive until the returned stop is called
+func (s *Scheduler) renewLock(name, token string) (stop func()) {
+	done := make(chan struct{})
+	stopped := make(chan struct{})
+
+	go func() {
+		defer close(stopped)
+		ticker := time.NewTicker(lockRenewInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				err := s.coordinator.Extend(context.Background(), name, token, lockRenewTTL)
+				if err != nil {
+					log.Error(fmt.Sprintf("[CRON] Failed to renew lock of job %s", name), err)
+				}
+			}
+		}
+	}()
+
+	return func() {
+		close(done)
+		<-stopped
+	}
+}
+
+// holdLock keeps a finished job's lock for nine tenths of the time until its next
+// occurrence, so the other instances skip this occurrence but not the next one
+func (s *Scheduler) holdLock(ctx context.Context, j *job, token string) {
+	hold := time.Until(j.schedule.Next(time.Now())) * 9 / 10
+
+	var err error
+	if hold > 0 {
+		err = s.coordinator.Extend(ctx, j.name, token, hold)
+	} else {
+		err = s.coordinator.Release(ctx, j.name, token)
+	}
+	if err != nil {
+		log.Error(fmt.Sprintf("[CRON] Failed to update lock of job %s", j.name), err)
+	}
+}
+
+// saveRun records a run; a failure only costs the status endpoints an update
+func (s *Scheduler) saveRun(ctx context.Context, run RunStatus) {
+	if err := s.coordinator.SaveRun(ctx, run); err != nil {
+		log.Error(fmt.Sprintf("[CRON] Failed to record run of job %s", run.Job), err)
+	}
+}
+
 // Start begins execution of all registered jobs in background goroutines
 func Start() {
 	if DefaultScheduler != nil {
 		log.Info("Starting cron scheduler")
-		DefaultScheduler.cron.Start()
+		DefaultScheduler.Start()
 	}
 }
 
+// Start begins execution of the scheduler's jobs
+func (s *Scheduler) Start() {
+	s.cron.Start()
+}
+
 // Stop gracefully stops the scheduler, waiting for running jobs to complete
 func Stop() {
 	if DefaultScheduler != nil {
 		log.Info("Stopping cron scheduler (waiting for running jobs...)")
-		ctx := DefaultScheduler.cron.Stop()
-		<-ctx.Done()
+		DefaultScheduler.Stop()
 		log.Info("Cron scheduler stopped cleanly")
 	}
 }
 
+// Stop stops scheduling jobs and waits for running ones to complete
+func (s *Scheduler) Stop() {
+	ctx := s.cron.Stop()
+	<-ctx.Done()
+}
+
 // panicLogger implements the robfig/cron.Logger interface to route panics to our logger
 type panicLogger struct{}
 
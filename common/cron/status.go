@@ -0,0 +1,89 @@
+package cron
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+)
+
+// RunResult is the outcome of a job's run
+type RunResult string
+
+const (
+	RunResultRunning   RunResult = "running"
+	RunResultSucceeded RunResult = "succeeded"
+	RunResultFailed    RunResult = "failed"
+)
+
+// RunStatus describes a job's latest run, on whichever instance it ran
+type RunStatus struct {
+	Job        string     `json:"job"`
+	Instance   string     `json:"instance"`
+	Result     RunResult  `json:"result"`
+	Error      string     `json:"error,omitempty"`
+	StartedAt  time.Time  `json:"startedAt"`
+	FinishedAt *time.Time `json:"finishedAt,omitempty"`
+	DurationMs int64      `json:"durationMs"`
+}
+
+// JobStatus describes a registered job. NextRunAt is this instance's next attempt;
+// the occurrence runs on whichever instance takes the job's lock.
+type JobStatus struct {
+	Name      string     `json:"name"`
+	Schedule  string     `json:"schedule"`
+	NextRunAt time.Time  `json:"nextRunAt"`
+	LastRun   *RunStatus `json:"lastRun"`
+}
+
+// Jobs returns the status of every registered job, by name
+func (s *Scheduler) Jobs(ctx context.Context) ([]JobStatus, error) {
+	runs, err := s.coordinator.LastRuns(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	statuses := make([]JobStatus, 0, len(s.jobs))
+	for _, j := range s.jobs {
+		statuses = append(statuses, s.status(j, runs))
+	}
+	slices.SortFunc(statuses, func(a, b JobStatus) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return statuses, nil
+}
+
+// Job returns the status of the named job, or ErrJobNotFound
+func (s *Scheduler) Job(ctx context.Context, name string) (*JobStatus, error) {
+	s.mu.RLock()
+	j, ok := s.jobs[name]
+	s.mu.RUnlock()
+	if !ok {
+		return nil, ErrJobNotFound
+	}
+
+	runs, err := s.coordinator.LastRuns(ctx)
+	if err != nil {
+		return nil, err
+	}
+	status := s.status(j, runs)
+	return &status, nil
+}
+
+// status combines a job's schedule with its latest run
+func (s *Scheduler) status(j *job, runs map[string]RunStatus) JobStatus {
+	// The entry has no next time until the scheduler starts
+	nextRunAt := s.cron.Entry(j.entryID).Next
+	if nextRunAt.IsZero() {
+		nextRunAt = j.schedule.Next(time.Now())
+	}
+
+	status := JobStatus{Name: j.name, Schedule: j.spec, NextRunAt: nextRunAt.UTC()}
+	if run, ok := runs[j.name]; ok {
+		status.LastRun = &run
+	}
+	return status
+}
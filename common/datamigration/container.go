@@ -0,0 +1,68 @@
+package datamigration
+
+import (
+	"sync"
+	"time"
+
+	"ecommerce-be/common"
+	"ecommerce-be/common/cache"
+	"ecommerce-be/common/config"
+	"ecommerce-be/common/constants"
+	"ecommerce-be/common/cron"
+	"ecommerce-be/common/scheduler"
+
+	"github.com/gin-gonic/gin"
+)
+
+// recoveryInterval is how often stalled runs are looked for
+const recoveryInterval = time.Minute
+
+var (
+	instance Service
+	once     sync.Once
+)
+
+// GetService returns the singleton data migration service
+func GetService() Service {
+	once.Do(func() {
+		// Without Redis, chunks cannot be scheduled; runs can still be inspected
+		var sched *scheduler.Scheduler
+		if redisClient, err := cache.GetRedisClient(); err == nil {
+			sched = scheduler.New(redisClient)
+		}
+		instance = NewService(NewRunRepository(), sched, dataMigrationConfig())
+	})
+	return instance
+}
+
+// NewContainer registers the chunk scheduler command, the stalled-run recovery job
+// and the admin routes. Migrations themselves are registered by their owning
+// modules with Register.
+func NewContainer(router *gin.Engine) *common.Container {
+	c := &common.Container{}
+	service := GetService()
+
+	c.RegisterModule(NewModule(NewHandler(service)))
+
+	scheduler.Register(constants.DATA_MIGRATION_CHUNK_COMMAND, service.ExecuteChunk)
+	cron.RegisterIntervalJob(
+		recoveryInterval,
+		constants.DATA_MIGRATION_RECOVERY_JOB_NAME,
+		service.RecoverStalled,
+	)
+
+	for _, module := range c.Modules {
+		module.RegisterRoutes(router)
+	}
+
+	return c
+}
+
+// dataMigrationConfig returns the loaded data migration config, or the defaults
+// when configuration has not been loaded.
+func dataMigrationConfig() config.DataMigrationConfig {
+	if cfg := config.Get(); cfg != nil {
+		return cfg.DataMigration
+	}
+	return config.DataMigrationConfig{StallTimeoutMinutes: 10}
+}
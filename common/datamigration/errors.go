@@ -0,0 +1,50 @@
+package datamigration
+
+import (
+	"net/http"
+
+	"ecommerce-be/common/constants"
+	commonError "ecommerce-be/common/error"
+)
+
+var (
+	ErrMigrationNotFound = &commonError.AppError{
+		Code:       constants.DATA_MIGRATION_NOT_FOUND_CODE,
+		Message:    constants.DATA_MIGRATION_NOT_FOUND_MSG,
+		StatusCode: http.StatusNotFound,
+	}
+
+	ErrRunNotFound = &commonError.AppError{
+		Code:       constants.DATA_MIGRATION_RUN_NOT_FOUND_CODE,
+		Message:    constants.DATA_MIGRATION_RUN_NOT_FOUND_MSG,
+		StatusCode: http.StatusNotFound,
+	}
+
+	ErrMigrationAlreadyActive = &commonError.AppError{
+		Code:       constants.DATA_MIGRATION_ALREADY_ACTIVE_CODE,
+		Message:    constants.DATA_MIGRATION_ALREADY_ACTIVE_MSG,
+		StatusCode: http.StatusConflict,
+	}
+
+	ErrInvalidRunTransition = &commonError.AppError{
+		Code:       constants.DATA_MIGRATION_INVALID_TRANSITION_CODE,
+		Message:    constants.DATA_MIGRATION_INVALID_TRANSITION_MSG,
+		StatusCode: http.StatusConflict,
+	}
+
+	ErrSchedulerUnavailable = &commonError.AppError{
+		Code:       constants.DATA_MIGRATION_UNAVAILABLE_CODE,
+		Message:    constants.DATA_MIGRATION_UNAVAILABLE_MSG,
+		StatusCode: http.StatusServiceUnavailable,
+	}
+)
+
+func init() {
+	commonError.Register(
+		ErrMigrationNotFound,
+		ErrRunNotFound,
+		ErrMigrationAlreadyActive,
+		ErrInvalidRunTransition,
+		ErrSchedulerUnavailable,
+	)
+}
@@ -0,0 +1,172 @@
+package datamigration
+
+import (
+	"net/http"
+
+	"ecommerce-be/common/auth"
+	"ecommerce-be/common/constants"
+	errs "ecommerce-be/common/error"
+	"ecommerce-be/common/handler"
+	"ecommerce-be/common/log"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Handler handles the data migration admin API
+type Handler struct {
+	*handler.BaseHandler
+	service Service
+}
+
+// NewHandler creates a new instance of Handler
+func NewHandler(service Service) *Handler {
+	return &Handler{
+		BaseHandler: handler.NewBaseHandler(),
+		service:     service,
+	}
+}
+
+// ListMigrations handles listing registered migrations with their latest run
+// GET /api/data-migration
+func (h *Handler) ListMigrations(c *gin.Context) {
+	response, err := h.service.ListMigrations(c)
+	if err != nil {
+		log.ErrorWithContext(c, "listDataMigrations: failed", err)
+		h.HandleError(c, err, constants.FAILED_TO_LIST_DATA_MIGRATIONS_MSG)
+		return
+	}
+
+	h.SuccessWithData(c, http.StatusOK, constants.DATA_MIGRATIONS_LISTED_MSG, "migrations", response)
+}
+
+// StartRun handles starting a registered migration
+// POST /api/data-migration/runs
+func (h *Handler) StartRun(c *gin.Context) {
+	userID, exists := auth.GetUserIDFromContext(c)
+	if !exists {
+		h.HandleError(c, errs.UnauthorizedError, constants.AUTHENTICATION_REQUIRED_MSG)
+		return
+	}
+
+	var req StartRunRequest
+	if err := h.BindJSON(c, &req); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	response, err := h.service.StartRun(c, userID, req)
+	if err != nil {
+		log.ErrorWithContext(c, "startDataMigration: failed", err)
+		h.HandleError(c, err, constants.FAILED_TO_START_DATA_MIGRATION_MSG)
+		return
+	}
+
+	h.SuccessWithData(c, http.StatusCreated, constants.DATA_MIGRATION_STARTED_MSG, "run", response)
+}
+
+// ListRuns handles listing runs
+// GET /api/data-migration/runs
+func (h *Handler) ListRuns(c *gin.Context) {
+	var params ListRunsQueryParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	response, err := h.service.ListRuns(c, params)
+	if err != nil {
+		log.ErrorWithContext(c, "listDataMigrationRuns: failed", err)
+		h.HandleError(c, err, constants.FAILED_TO_LIST_DATA_MIGRATION_RUNS_MSG)
+		return
+	}
+
+	h.SuccessWithData(c, http.StatusOK, constants.DATA_MIGRATION_RUNS_LISTED_MSG, "runs", response)
+}
+
+// GetRun handles fetching a run's progress
+// GET /api/data-migration/runs/:id
+func (h *Handler) GetRun(c *gin.Context) {
+	runID, err := h.ParseUintParam(c, "id")
+	if err != nil {
+		h.HandleError(c, err, constants.FAILED_TO_FETCH_DATA_MIGRATION_RUN_MSG)
+		return
+	}
+
+	response, err := h.service.GetRun(c, runID)
+	if err != nil {
+		log.ErrorWithContext(c, "getDataMigrationRun: failed", err)
+		h.HandleError(c, err, constants.FAILED_TO_FETCH_DATA_MIGRATION_RUN_MSG)
+		return
+	}
+
+	h.SuccessWithData(c, http.StatusOK, constants.DATA_MIGRATION_RUN_FETCHED_MSG, "run", response)
+}
+
+// PauseRun handles pausing a running migration
+// POST /api/data-migration/runs/:id/pause
+func (h *Handler) PauseRun(c *gin.Context) {
+	runID, err := h.ParseUintParam(c, "id")
+	if err != nil {
+		h.HandleError(c, err, constants.FAILED_TO_PAUSE_DATA_MIGRATION_MSG)
+		return
+	}
+
+	response, err := h.service.PauseRun(c, runID)
+	if err != nil {
+		log.ErrorWithContext(c, "pauseDataMigration: failed", err)
+		h.HandleError(c, err, constants.FAILED_TO_PAUSE_DATA_MIGRATION_MSG)
+		return
+	}
+
+	h.SuccessWithData(c, http.StatusOK, constants.DATA_MIGRATION_PAUSED_MSG, "run", response)
+}
+
+// ResumeRun handles resuming a paused or failed migration
+// POST /api/data-migration/runs/:id/resume
+func (h *Handler) ResumeRun(c *gin.Context) {
+	runID, err := h.ParseUintParam(c, "id")
+	if err != nil {
+		h.HandleError(c, err, constants.FAILED_TO_RESUME_DATA_MIGRATION_MSG)
+		return
+	}
+
+	response, err := h.service.ResumeRun(c, runID)
+	if err != nil {
+		log.ErrorWithContext(c, "resumeDataMigration: failed", err)
+		h.HandleError(c, err, constants.FAILED_TO_RESUME_DATA_MIGRATION_MSG)
+		return
+	}
+
+	h.SuccessWithData(c, http.StatusOK, constants.DATA_MIGRATION_RESUMED_MSG, "run", response)
+}
+
+// UpdateThrottle handles changing a run's batch size and chunk delay
+// PATCH /api/data-migration/runs/:id/throttle
+func (h *Handler) UpdateThrottle(c *gin.Context) {
+	runID, err := h.ParseUintParam(c, "id")
+	if err != nil {
+		h.HandleError(c, err, constants.FAILED_TO_UPDATE_DATA_MIGRATION_THROTTLE_MSG)
+		return
+	}
+
+	var req UpdateThrottleRequest
+	if err := h.BindJSON(c, &req); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	response, err := h.service.UpdateThrottle(c, runID, req)
+	if err != nil {
+		log.ErrorWithContext(c, "updateDataMigrationThrottle: failed", err)
+		h.HandleError(c, err, constants.FAILED_TO_UPDATE_DATA_MIGRATION_THROTTLE_MSG)
+		return
+	}
+
+	h.SuccessWithData(
+		c,
+		http.StatusOK,
+		constants.DATA_MIGRATION_THROTTLE_UPDATED_MSG,
+		"run",
+		response,
+	)
+}
@@ -0,0 +1,93 @@
+package datamigration
+
+import (
+	"context"
+	"strconv"
+)
+
+// Migration is a long-running data change (backfill, denormalization,
+// re-encryption) that is applied in small chunks while the application serves
+// traffic. Modules register their migrations at startup with Register.
+type Migration interface {
+	// Name uniquely identifies the migration; it is used in the admin API.
+	Name() string
+	Description() string
+
+	// RunChunk processes at most batchSize items after checkpoint and returns the
+	// new checkpoint. It runs inside the transaction that advances the run, so the
+	// work and the checkpoint commit together; ctx carries that transaction and
+	// repositories must use db.DB(ctx). An empty checkpoint means "from the start".
+	RunChunk(ctx context.Context, checkpoint string, batchSize int) (ChunkResult, error)
+}
+
+// Estimator is optionally implemented by migrations that can estimate the number
+// of items left to process, which lets the admin API report a percentage.
+type Estimator interface {
+	EstimateTotal(ctx context.Context) (int64, error)
+}
+
+// ChunkResult reports the outcome of one chunk.
+type ChunkResult struct {
+	Checkpoint string
+	Processed  int
+	Done       bool
+}
+
+// IDBatchFunc processes up to limit rows with primary key greater than afterID, in
+// ascending ID order, and returns the last ID it processed (0 when none).
+type IDBatchFunc func(
+	ctx context.Context,
+	afterID uint,
+	limit int,
+) (lastID uint, processed int, err error)
+
+// NewIDBatchMigration builds a Migration that walks a table by primary key. The
+// checkpoint is the last processed ID; the migration completes when a chunk
+// processes fewer rows than requested.
+func NewIDBatchMigration(name, description string, fn IDBatchFunc) Migration {
+	return &idBatchMigration{name: name, description: description, fn: fn}
+}
+
+type idBatchMigration struct {
+	name        string
+	description string
+	fn          IDBatchFunc
+}
+
+func (m *idBatchMigration) Name() string {
+	return m.name
+}
+
+func (m *idBatchMigration) Description() string {
+	return m.description
+}
+
+func (m *idBatchMigration) RunChunk(
+	ctx context.Context,
+	checkpoint string,
+	batchSize int,
+) (ChunkResult, error) {
+	afterID := uint(0)
+	if checkpoint != "" {
+		parsed, err := strconv.ParseUint(checkpoint, 10, 64)
+		if err != nil {
+			return ChunkResult{}, err
+		}
+		afterID = uint(parsed)
+	}
+
+	lastID, processed, err := m.fn(ctx, afterID, batchSize)
+	if err != nil {
+		return ChunkResult{}, err
+	}
+
+	next := checkpoint
+	if processed > 0 {
+		next = strconv.FormatUint(uint64(lastID), 10)
+	}
+	return ChunkResult{
+		Checkpoint: next,
+		Processed:  processed,
+		Done:       processed < batchSize,
+	}, nil
+}
@@ -0,0 +1,89 @@
+package datamigration
+
+import "time"
+
+// ========================================
+// REQUEST MODELS
+// ========================================
+
+// StartRunRequest - Starts a registered migration. Omitted throttle settings fall
+// back to DATA_MIGRATION_BATCH_SIZE / DATA_MIGRATION_CHUNK_DELAY_MS.
+type StartRunRequest struct {
+	Name         string `json:"name"         binding:"required,max=150"`
+	BatchSize    int    `json:"batchSize"    binding:"omitempty,min=1,max=10000"`
+	ChunkDelayMs *int   `json:"chunkDelayMs" binding:"omitempty,min=0,max=3600000"`
+}
+
+// UpdateThrottleRequest - Changes how fast an unfinished run proceeds
+type UpdateThrottleRequest struct {
+	BatchSize    *int `json:"batchSize"    binding:"omitempty,min=1,max=10000"`
+	ChunkDelayMs *int `json:"chunkDelayMs" binding:"omitempty,min=0,max=3600000"`
+}
+
+// ListRunsQueryParams - Optional status filter for the run list
+type ListRunsQueryParams struct {
+	Status string `form:"status" binding:"omitempty,oneof=running paused completed failed"`
+}
+
+// ========================================
+// RESPONSE MODELS
+// ========================================
+
+// RunResponse describes a run's progress
+type RunResponse struct {
+	ID              uint      `json:"id"`
+	Name            string    `json:"name"`
+	Status          RunStatus `json:"status"`
+	Checkpoint      string    `json:"checkpoint"`
+	BatchSize       int       `json:"batchSize"`
+	ChunkDelayMs    int       `json:"chunkDelayMs"`
+	ProcessedCount  int64     `json:"processedCount"`
+	ChunkCount      int64     `json:"chunkCount"`
+	TotalEstimate   *int64    `json:"totalEstimate"`
+	ProgressPercent *float64  `json:"progressPercent"`
+	FailureCount    int       `json:"failureCount"`
+	LastError       *string   `json:"lastError"`
+	StartedByUserID *uint     `json:"startedByUserId"`
+	HeartbeatAt     string    `json:"heartbeatAt"`
+	PausedAt        *string   `json:"pausedAt"`
+	CompletedAt     *string   `json:"completedAt"`
+	CreatedAt       string    `json:"createdAt"`
+}
+
+// MigrationResponse describes a registered migration and its most recent run
+type MigrationResponse struct {
+	Name        string       `json:"name"`
+	Description string       `json:"description"`
+	LatestRun   *RunResponse `json:"latestRun"`
+}
+
+// BuildRunResponse maps a run entity to its API response
+func BuildRunResponse(run *DataMigrationRun) RunResponse {
+	return RunResponse{
+		ID:              run.ID,
+		Name:            run.Name,
+		Status:          run.Status,
+		Checkpoint:      run.Checkpoint,
+		BatchSize:       run.BatchSize,
+		ChunkDelayMs:    run.ChunkDelayMs,
+		ProcessedCount:  run.ProcessedCount,
+		ChunkCount:      run.ChunkCount,
+		TotalEstimate:   run.TotalEstimate,
+		ProgressPercent: ProgressPercent(run),
+		FailureCount:    run.FailureCount,
+		LastError:       run.LastError,
+		StartedByUserID: run.StartedByUserID,
+		HeartbeatAt:     run.HeartbeatAt.UTC().Format(time.RFC3339),
+		PausedAt:        formatOptionalTime(run.PausedAt),
+		CompletedAt:     formatOptionalTime(run.CompletedAt),
+		CreatedAt:       run.CreatedAt.UTC().Format(time.RFC3339),
+	}
+}
+
+func formatOptionalTime(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	formatted := t.UTC().Format(time.RFC3339)
+	return &formatted
+}
@@ -0,0 +1,49 @@
+package datamigration
+
+import (
+	"sort"
+	"sync"
+
+	"ecommerce-be/common/log"
+)
+
+var (
+	mu       sync.RWMutex
+	registry = map[string]Migration{}
+)
+
+// Register makes a migration available to the runner and the admin API.
+func Register(m Migration) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	if _, exists := registry[m.Name()]; exists {
+		log.Warn("datamigration: migration already registered, skipping: " + m.Name())
+		return
+	}
+	registry[m.Name()] = m
+}
+
+// Get returns the registered migration with the given name.
+func Get(name string) (Migration, bool) {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	m, ok := registry[name]
+	return m, ok
+}
+
+// List returns all registered migrations ordered by name.
+func List() []Migration {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	migrations := make([]Migration, 0, len(registry))
+	for _, m := range registry {
+		migrations = append(migrations, m)
+	}
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Name() < migrations[j].Name()
+	})
+	return migrations
+}
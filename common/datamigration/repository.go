@@ -0,0 +1,144 @@
+package datamigration
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"ecommerce-be/common/db"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// RunRepository defines database operations for data migration runs
+type RunRepository interface {
+	Create(ctx context.Context, run *DataMigrationRun) error
+	Save(ctx context.Context, run *DataMigrationRun) error
+
+	FindByID(ctx context.Context, id uint) (*DataMigrationRun, error)
+	// FindByIDForUpdate locks the run row until the surrounding transaction ends,
+	// serializing chunk execution and admin state changes across instances.
+	FindByIDForUpdate(ctx context.Context, id uint) (*DataMigrationRun, error)
+	FindActiveByName(ctx context.Context, name string) (*DataMigrationRun, error)
+	FindLatestByNames(ctx context.Context, names []string) (map[string]DataMigrationRun, error)
+	FindAll(ctx context.Context, status *RunStatus) ([]DataMigrationRun, error)
+
+	// FindStalled returns running runs whose next chunk is overdue by more than
+	// stallTimeout, i.e. heartbeat_at + chunk_delay_ms + stallTimeout < now.
+	FindStalled(
+		ctx context.Context,
+		now time.Time,
+		stallTimeout time.Duration,
+	) ([]DataMigrationRun, error)
+}
+
+// RunRepositoryImpl implements RunRepository
+type RunRepositoryImpl struct{}
+
+// NewRunRepository creates a new instance of RunRepository
+func NewRunRepository() RunRepository {
+	return &RunRepositoryImpl{}
+}
+
+// Create persists a new run
+func (r *RunRepositoryImpl) Create(ctx context.Context, run *DataMigrationRun) error {
+	return db.DB(ctx).Create(run).Error
+}
+
+// Save writes every column of the run
+func (r *RunRepositoryImpl) Save(ctx context.Context, run *DataMigrationRun) error {
+	return db.DB(ctx).Save(run).Error
+}
+
+// FindByID returns the run or nil when it does not exist
+func (r *RunRepositoryImpl) FindByID(ctx context.Context, id uint) (*DataMigrationRun, error) {
+	return findRun(db.DB(ctx).Where("id = ?", id))
+}
+
+// FindByIDForUpdate returns the run with a row lock, or nil when it does not exist
+func (r *RunRepositoryImpl) FindByIDForUpdate(
+	ctx context.Context,
+	id uint,
+) (*DataMigrationRun, error) {
+	return findRun(db.DB(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", id))
+}
+
+// FindActiveByName returns the migration's running or paused run, if any
+func (r *RunRepositoryImpl) FindActiveByName(
+	ctx context.Context,
+	name string,
+) (*DataMigrationRun, error) {
+	return findRun(db.DB(ctx).
+		Where("name = ? AND status IN ?", name, []RunStatus{RUN_STATUS_RUNNING, RUN_STATUS_PAUSED}))
+}
+
+// FindLatestByNames returns the most recent run of each named migration
+func (r *RunRepositoryImpl) FindLatestByNames(
+	ctx context.Context,
+	names []string,
+) (map[string]DataMigrationRun, error) {
+	latest := make(map[string]DataMigrationRun, len(names))
+	if len(names) == 0 {
+		return latest, nil
+	}
+
+	var runs []DataMigrationRun
+	err := db.DB(ctx).
+		Raw(`SELECT DISTINCT ON (name) * FROM data_migration_run
+			WHERE name IN ? ORDER BY name, id DESC`, names).
+		Scan(&runs).Error
+	if err != nil {
+		return nil, err
+	}
+	for _, run := range runs {
+		latest[run.Name] = run
+	}
+	return latest, nil
+}
+
+// FindAll returns runs newest first, optionally filtered by status
+func (r *RunRepositoryImpl) FindAll(
+	ctx context.Context,
+	status *RunStatus,
+) ([]DataMigrationRun, error) {
+	query := db.DB(ctx).Model(&DataMigrationRun{})
+	if status != nil {
+		query = query.Where("status = ?", *status)
+	}
+
+	var runs []DataMigrationRun
+	if err := query.Order("id DESC").Find(&runs).Error; err != nil {
+		return nil, err
+	}
+	return runs, nil
+}
+
+// FindStalled returns running runs that have made no progress for too long
+func (r *RunRepositoryImpl) FindStalled(
+	ctx context.Context,
+	now time.Time,
+	stallTimeout time.Duration,
+) ([]DataMigrationRun, error) {
+	var runs []DataMigrationRun
+	err := db.DB(ctx).
+		Where("status = ?", RUN_STATUS_RUNNING).
+		Where("heartbeat_at + chunk_delay_ms * INTERVAL '1 millisecond' < ?",
+			now.Add(-stallTimeout)).
+		Find(&runs).Error
+	if err != nil {
+		return nil, err
+	}
+	return runs, nil
+}
+
+func findRun(query *gorm.DB) (*DataMigrationRun, error) {
+	var run DataMigrationRun
+	if err := query.First(&run).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &run, nil
+}
@@ -0,0 +1,72 @@
+package datamigration
+
+import (
+	"net/http"
+
+	"ecommerce-be/common/constants"
+	"ecommerce-be/common/middleware"
+	"ecommerce-be/common/openapi"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Module registers the data migration admin routes
+type Module struct {
+	handler *Handler
+}
+
+// NewModule creates a new instance of Module
+func NewModule(handler *Handler) *Module {
+	return &Module{handler: handler}
+}
+
+// RegisterRoutes registers data migration routes (admin only)
+func (m *Module) RegisterRoutes(router *gin.Engine) {
+	migrationRoutes := openapi.NewGroup(
+		router.Group(constants.APIBaseDataMigration),
+		"Data Migrations",
+	)
+	migrationRoutes.Use(middleware.AdminAuth())
+	{
+		// GET /api/data-migration - Registered migrations with their latest run
+		migrationRoutes.GET("", m.handler.ListMigrations).
+			Summary("List registered migrations").
+			ReturnsField(http.StatusOK, "migrations", []MigrationResponse{})
+
+		// POST /api/data-migration/runs - Start a migration
+		// Request: StartRunRequest
+		migrationRoutes.POST("/runs", m.handler.StartRun).
+			Summary("Start a migration run").
+			Body(StartRunRequest{}).
+			ReturnsField(http.StatusCreated, "run", RunResponse{})
+
+		// GET /api/data-migration/runs - Runs, newest first
+		// Query params: ?status=running
+		migrationRoutes.GET("/runs", m.handler.ListRuns).
+			Summary("List migration runs").
+			Query(ListRunsQueryParams{}).
+			ReturnsField(http.StatusOK, "runs", []RunResponse{})
+
+		// GET /api/data-migration/runs/:id - Run progress
+		migrationRoutes.GET("/runs/:id", m.handler.GetRun).
+			Summary("Get run progress").
+			ReturnsField(http.StatusOK, "run", RunResponse{})
+
+		// POST /api/data-migration/runs/:id/pause - Pause after the in-flight chunk
+		migrationRoutes.POST("/runs/:id/pause", m.handler.PauseRun).
+			Summary("Pause a run").
+			ReturnsField(http.StatusOK, "run", RunResponse{})
+
+		// POST /api/data-migration/runs/:id/resume - Resume from the last checkpoint
+		migrationRoutes.POST("/runs/:id/resume", m.handler.ResumeRun).
+			Summary("Resume a run").
+			ReturnsField(http.StatusOK, "run", RunResponse{})
+
+		// PATCH /api/data-migration/runs/:id/throttle - Change batch size / chunk delay
+		// Request: UpdateThrottleRequest
+		migrationRoutes.PATCH("/runs/:id/throttle", m.handler.UpdateThrottle).
+			Summary("Change a run's throttle").
+			Body(UpdateThrottleRequest{}).
+			ReturnsField(http.StatusOK, "run", RunResponse{})
+	}
+}
@@ -0,0 +1,48 @@
+package datamigration
+
+import (
+	"time"
+
+	"ecommerce-be/common/db"
+)
+
+// RunStatus is the lifecycle state of a data migration run.
+type RunStatus string
+
+const (
+	RUN_STATUS_RUNNING   RunStatus = "running"
+	RUN_STATUS_PAUSED    RunStatus = "paused"
+	RUN_STATUS_COMPLETED RunStatus = "completed"
+	RUN_STATUS_FAILED    RunStatus = "failed"
+)
+
+// IsActive reports whether the run still holds its migration's single active slot.
+func (s RunStatus) IsActive() bool {
+	return s == RUN_STATUS_RUNNING || s == RUN_STATUS_PAUSED
+}
+
+// DataMigrationRun tracks one execution of a registered migration. Checkpoint is
+// committed with every chunk, so a run resumes exactly where it stopped after a
+// pause, a failure or a restart.
+type DataMigrationRun struct {
+	db.BaseEntity
+	Name            string     `json:"name"            gorm:"column:name;size:150;not null"`
+	Status          RunStatus  `json:"status"          gorm:"column:status;size:20;not null"`
+	Checkpoint      string     `json:"checkpoint"      gorm:"column:checkpoint;not null;default:''"`
+	BatchSize       int        `json:"batchSize"       gorm:"column:batch_size;not null"`
+	ChunkDelayMs    int        `json:"chunkDelayMs"    gorm:"column:chunk_delay_ms;not null"`
+	ProcessedCount  int64      `json:"processedCount"  gorm:"column:processed_count;not null;default:0"`
+	ChunkCount      int64      `json:"chunkCount"      gorm:"column:chunk_count;not null;default:0"`
+	TotalEstimate   *int64     `json:"totalEstimate"   gorm:"column:total_estimate"`
+	FailureCount    int        `json:"failureCount"    gorm:"column:failure_count;not null;default:0"`
+	LastError       *string    `json:"lastError"       gorm:"column:last_error"`
+	ChainToken      string     `json:"-"               gorm:"column:chain_token;size:64;not null"`
+	StartedByUserID *uint      `json:"startedByUserId" gorm:"column:started_by_user_id"`
+	HeartbeatAt     time.Time  `json:"heartbeatAt"     gorm:"column:heartbeat_at;not null"`
+	PausedAt        *time.Time `json:"pausedAt"        gorm:"column:paused_at"`
+	CompletedAt     *time.Time `json:"completedAt"     gorm:"column:completed_at"`
+}
+
+func (DataMigrationRun) TableName() string {
+	return "data_migration_run"
+}
@@ -0,0 +1,479 @@
+package datamigration
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"ecommerce-be/common/config"
+	"ecommerce-be/common/constants"
+	"ecommerce-be/common/db"
+	"ecommerce-be/common/log"
+	"ecommerce-be/common/scheduler"
+
+	"github.com/google/uuid"
+)
+
+// Service starts, throttles, pauses and resumes background data migrations and
+// executes their chunks from the scheduler.
+//
+// Each run is a chain of scheduler jobs: a chunk commits its work together with
+// the new checkpoint, then schedules the next chunk after the run's chunk delay.
+// Only the job carrying the run's current chain token may advance it, so pausing
+// (which clears the token) stops the chain and a recovered chain never runs twice.
+type Service interface {
+	ListMigrations(ctx context.Context) ([]MigrationResponse, error)
+	StartRun(ctx context.Context, userID uint, req StartRunRequest) (*RunResponse, error)
+	ListRuns(ctx context.Context, params ListRunsQueryParams) ([]RunResponse, error)
+	GetRun(ctx context.Context, runID uint) (*RunResponse, error)
+	PauseRun(ctx context.Context, runID uint) (*RunResponse, error)
+	ResumeRun(ctx context.Context, runID uint) (*RunResponse, error)
+	UpdateThrottle(ctx context.Context, runID uint, req UpdateThrottleRequest) (*RunResponse, error)
+
+	// ExecuteChunk is the scheduler handler for DATA_MIGRATION_CHUNK_COMMAND.
+	ExecuteChunk(ctx context.Context, payload json.RawMessage) error
+	// RecoverStalled re-schedules running runs whose chunk job was lost. Runs as a
+	// recurring cron job.
+	RecoverStalled()
+}
+
+// chunkPayload is the scheduler payload of a chunk job
+type chunkPayload struct {
+	RunID uint   `json:"runId"`
+	Token string `json:"token"`
+}
+
+// ServiceImpl implements Service
+type ServiceImpl struct {
+	runRepo   RunRepository
+	scheduler *scheduler.Scheduler
+	cfg       config.DataMigrationConfig
+}
+
+// NewService creates a new instance of Service. sched may be nil when Redis is
+// unavailable, in which case runs cannot be started or resumed.
+func NewService(
+	runRepo RunRepository,
+	sched *scheduler.Scheduler,
+	cfg config.DataMigrationConfig,
+) Service {
+	return &ServiceImpl{
+		runRepo:   runRepo,
+		scheduler: sched,
+		cfg:       cfg,
+	}
+}
+
+// ListMigrations returns every registered migration with its latest run
+func (s *ServiceImpl) ListMigrations(ctx context.Context) ([]MigrationResponse, error) {
+	migrations := List()
+	names := make([]string, 0, len(migrations))
+	for _, m := range migrations {
+		names = append(names, m.Name())
+	}
+
+	latest, err := s.runRepo.FindLatestByNames(ctx, names)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]MigrationResponse, 0, len(migrations))
+	for _, m := range migrations {
+		response := MigrationResponse{Name: m.Name(), Description: m.Description()}
+		if run, ok := latest[m.Name()]; ok {
+			runResponse := BuildRunResponse(&run)
+			response.LatestRun = &runResponse
+		}
+		responses = append(responses, response)
+	}
+	return responses, nil
+}
+
+// StartRun creates a run for a registered migration and schedules its first chunk
+func (s *ServiceImpl) StartRun(
+	ctx context.Context,
+	userID uint,
+	req StartRunRequest,
+) (*RunResponse, error) {
+	if s.scheduler == nil {
+		return nil, ErrSchedulerUnavailable
+	}
+	migration, ok := Get(req.Name)
+	if !ok {
+		return nil, ErrMigrationNotFound
+	}
+
+	var totalEstimate *int64
+	if estimator, ok := migration.(Estimator); ok {
+		total, err := estimator.EstimateTotal(ctx)
+		if err != nil {
+			return nil, err
+		}
+		totalEstimate = &total
+	}
+
+	run, err := db.WithTransactionResult(
+		ctx,
+		func(txCtx context.Context) (*DataMigrationRun, error) {
+			active, err := s.runRepo.FindActiveByName(txCtx, migration.Name())
+			if err != nil {
+				return nil, err
+			}
+			if active != nil {
+				return nil, ErrMigrationAlreadyActive
+			}
+
+			run := &DataMigrationRun{
+				Name:            migration.Name(),
+				Status:          RUN_STATUS_RUNNING,
+				BatchSize:       ResolveBatchSize(req.BatchSize, s.cfg.DefaultBatchSize),
+				ChunkDelayMs:    ResolveChunkDelayMs(req.ChunkDelayMs, s.cfg.DefaultChunkDelayMs),
+				TotalEstimate:   totalEstimate,
+				ChainToken:      uuid.NewString(),
+				StartedByUserID: &userID,
+				HeartbeatAt:     time.Now().UTC(),
+			}
+			if err := s.runRepo.Create(txCtx, run); err != nil {
+				return nil, err
+			}
+			return run, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	s.scheduleChunk(run, 0)
+
+	response := BuildRunResponse(run)
+	return &response, nil
+}
+
+// ListRuns returns runs newest first
+func (s *ServiceImpl) ListRuns(
+	ctx context.Context,
+	params ListRunsQueryParams,
+) ([]RunResponse, error) {
+	var status *RunStatus
+	if params.Status != "" {
+		filter := RunStatus(params.Status)
+		status = &filter
+	}
+
+	runs, err := s.runRepo.FindAll(ctx, status)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]RunResponse, 0, len(runs))
+	for i := range runs {
+		responses = append(responses, BuildRunResponse(&runs[i]))
+	}
+	return responses, nil
+}
+
+// GetRun returns a single run's progress
+func (s *ServiceImpl) GetRun(ctx context.Context, runID uint) (*RunResponse, error) {
+	run, err := s.runRepo.FindByID(ctx, runID)
+	if err != nil {
+		return nil, err
+	}
+	if run == nil {
+		return nil, ErrRunNotFound
+	}
+
+	response := BuildRunResponse(run)
+	return &response, nil
+}
+
+// PauseRun stops a running migration after its in-flight chunk, if any, commits
+func (s *ServiceImpl) PauseRun(ctx context.Context, runID uint) (*RunResponse, error) {
+	run, err := s.updateLocked(ctx, runID, func(_ context.Context, run *DataMigrationRun) error {
+		if run.Status != RUN_STATUS_RUNNING {
+			return ErrInvalidRunTransition
+		}
+		now := time.Now().UTC()
+		run.Status = RUN_STATUS_PAUSED
+		run.PausedAt = &now
+		run.ChainToken = ""
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := BuildRunResponse(run)
+	return &response, nil
+}
+
+// ResumeRun continues a paused or failed run from its last checkpoint
+func (s *ServiceImpl) ResumeRun(ctx context.Context, runID uint) (*RunResponse, error) {
+	if s.scheduler == nil {
+		return nil, ErrSchedulerUnavailable
+	}
+
+	run, err := s.updateLocked(ctx, runID, func(txCtx context.Context, run *DataMigrationRun) error {
+		if run.Status != RUN_STATUS_PAUSED && run.Status != RUN_STATUS_FAILED {
+			return ErrInvalidRunTransition
+		}
+		if run.Status == RUN_STATUS_FAILED {
+			// A failed run gave up its active slot; another run may have taken it
+			active, err := s.runRepo.FindActiveByName(txCtx, run.Name)
+			if err != nil {
+				return err
+			}
+			if active != nil {
+				return ErrMigrationAlreadyActive
+			}
+		}
+		run.Status = RUN_STATUS_RUNNING
+		run.PausedAt = nil
+		run.FailureCount = 0
+		run.LastError = nil
+		run.ChainToken = uuid.NewString()
+		run.HeartbeatAt = time.Now().UTC()
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.scheduleChunk(run, 0)
+
+	response := BuildRunResponse(run)
+	return &response, nil
+}
+
+// UpdateThrottle changes the batch size and/or chunk delay of an unfinished run.
+// The new settings apply from the next chunk.
+func (s *ServiceImpl) UpdateThrottle(
+	ctx context.Context,
+	runID uint,
+	req UpdateThrottleRequest,
+) (*RunResponse, error) {
+	run, err := s.updateLocked(ctx, runID, func(_ context.Context, run *DataMigrationRun) error {
+		if !run.Status.IsActive() {
+			return ErrInvalidRunTransition
+		}
+		if req.BatchSize != nil {
+			run.BatchSize = ResolveBatchSize(*req.BatchSize, s.cfg.DefaultBatchSize)
+		}
+		if req.ChunkDelayMs != nil {
+			run.ChunkDelayMs = ResolveChunkDelayMs(req.ChunkDelayMs, s.cfg.DefaultChunkDelayMs)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := BuildRunResponse(run)
+	return &response, nil
+}
+
+// ExecuteChunk runs one chunk of a migration and schedules the next one. The chunk's
+// work and the run's new checkpoint commit in the same transaction, so a crash
+// between chunks never skips or repeats items.
+func (s *ServiceImpl) ExecuteChunk(ctx context.Context, payload json.RawMessage) error {
+	var p chunkPayload
+	if err := json.Unmarshal(payload, &p); err != nil {
+		return fmt.Errorf("invalid data migration chunk payload: %w", err)
+	}
+
+	var chunkErr error
+	run, err := db.WithTransactionResult(
+		ctx,
+		func(txCtx context.Context) (*DataMigrationRun, error) {
+			run, err := s.runRepo.FindByIDForUpdate(txCtx, p.RunID)
+			if err != nil {
+				return nil, err
+			}
+			if !isCurrentChunk(run, p.Token) {
+				// Paused, finished or superseded by a recovered chain
+				return nil, nil
+			}
+
+			migration, ok := Get(run.Name)
+			if !ok {
+				chunkErr = fmt.Errorf("data migration %q is not registered", run.Name)
+				return nil, chunkErr
+			}
+
+			result, err := migration.RunChunk(txCtx, run.Checkpoint, run.BatchSize)
+			if err != nil {
+				chunkErr = err
+				return nil, err
+			}
+
+			ApplyChunkResult(run, result, time.Now().UTC())
+			if run.Status == RUN_STATUS_RUNNING {
+				run.ChainToken = uuid.NewString()
+			}
+			if err := s.runRepo.Save(txCtx, run); err != nil {
+				return nil, err
+			}
+			return run, nil
+		},
+	)
+	if chunkErr != nil {
+		return s.recordChunkFailure(ctx, p, chunkErr)
+	}
+	if err != nil {
+		// Left for RecoverStalled; the checkpoint did not move
+		return err
+	}
+	if run == nil {
+		return nil
+	}
+
+	if run.Status == RUN_STATUS_COMPLETED {
+		log.InfoWithContext(ctx, fmt.Sprintf(
+			"Data migration %s (run %d) completed: %d items in %d chunks",
+			run.Name, run.ID, run.ProcessedCount, run.ChunkCount,
+		))
+		return nil
+	}
+
+	s.scheduleChunk(run, ChunkDelay(run))
+	return nil
+}
+
+// RecoverStalled re-schedules running runs whose chunk job is overdue
+func (s *ServiceImpl) RecoverStalled() {
+	if s.scheduler == nil {
+		return
+	}
+
+	ctx := context.Background()
+	stallTimeout := time.Duration(s.cfg.StallTimeoutMinutes) * time.Minute
+	stalled, err := s.runRepo.FindStalled(ctx, time.Now().UTC(), stallTimeout)
+	if err != nil {
+		log.ErrorWithContext(ctx, "Cron: Failed to load stalled data migrations", err)
+		return
+	}
+
+	restart := func(_ context.Context, run *DataMigrationRun) error {
+		if run.Status != RUN_STATUS_RUNNING {
+			return ErrInvalidRunTransition
+		}
+		run.ChainToken = uuid.NewString()
+		run.HeartbeatAt = time.Now().UTC()
+		return nil
+	}
+
+	for i := range stalled {
+		run, err := s.updateLocked(ctx, stalled[i].ID, restart)
+		if err != nil {
+			log.ErrorWithContext(ctx, fmt.Sprintf(
+				"Cron: Failed to recover data migration run %d", stalled[i].ID,
+			), err)
+			continue
+		}
+
+		log.Warn(fmt.Sprintf("Cron: Recovering stalled data migration %s (run %d)",
+			run.Name, run.ID))
+		s.scheduleChunk(run, 0)
+	}
+}
+
+// recordChunkFailure stores a chunk error on the run and retries with backoff until
+// the retry budget is exhausted, after which the run is marked failed.
+func (s *ServiceImpl) recordChunkFailure(
+	ctx context.Context,
+	p chunkPayload,
+	chunkErr error,
+) error {
+	retry := false
+	run, err := s.updateLocked(ctx, p.RunID, func(_ context.Context, run *DataMigrationRun) error {
+		if !isCurrentChunk(run, p.Token) {
+			return ErrInvalidRunTransition
+		}
+		retry = ApplyChunkFailure(run, chunkErr, time.Now().UTC())
+		if retry {
+			run.ChainToken = uuid.NewString()
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if !retry {
+		log.ErrorWithContext(ctx, fmt.Sprintf(
+			"Data migration %s (run %d) failed after %d attempts",
+			run.Name, run.ID, run.FailureCount,
+		), chunkErr)
+		return chunkErr
+	}
+
+	s.scheduleChunk(run, RetryDelay(run))
+	return chunkErr
+}
+
+// updateLocked loads the run under a row lock, applies mutate and saves it
+func (s *ServiceImpl) updateLocked(
+	ctx context.Context,
+	runID uint,
+	mutate func(txCtx context.Context, run *DataMigrationRun) error,
+) (*DataMigrationRun, error) {
+	return db.WithTransactionResult(
+		ctx,
+		func(txCtx context.Context) (*DataMigrationRun, error) {
+			run, err := s.runRepo.FindByIDForUpdate(txCtx, runID)
+			if err != nil {
+				return nil, err
+			}
+			if run == nil {
+				return nil, ErrRunNotFound
+			}
+			if err := mutate(txCtx, run); err != nil {
+				return nil, err
+			}
+			if err := s.runRepo.Save(txCtx, run); err != nil {
+				return nil, err
+			}
+			return run, nil
+		},
+	)
+}
+
+// scheduleChunk enqueues the run's next chunk. The run is already committed with
+// its new chain token, so a scheduling failure only delays it until RecoverStalled.
+func (s *ServiceImpl) scheduleChunk(run *DataMigrationRun, after time.Duration) {
+	payload, err := json.Marshal(chunkPayload{RunID: run.ID, Token: run.ChainToken})
+	if err != nil {
+		log.Error("Failed to marshal data migration chunk payload", err)
+		return
+	}
+
+	ctx := runContext(run)
+	// Chunks retry failed batches themselves and resume through RecoverStalled, so the
+	// scheduler must not run a chunk again on its own
+	job := scheduler.NewJob(constants.DATA_MIGRATION_CHUNK_COMMAND, payload).WithMaxAttempts(1)
+	if _, err := s.scheduler.Schedule(ctx, job, after); err != nil {
+		log.ErrorWithContext(ctx, fmt.Sprintf(
+			"Failed to schedule chunk for data migration run %d", run.ID,
+		), err)
+	}
+}
+
+// runContext builds the job context for a run's chunks: they act on behalf of the
+// admin who started the run and share one correlation ID for log tracing.
+func runContext(run *DataMigrationRun) context.Context {
+	userID := uint(0)
+	if run.StartedByUserID != nil {
+		userID = *run.StartedByUserID
+	}
+	return scheduler.GetContextWithKeys(scheduler.ScheduledJob{
+		UserID:        userID,
+		CorrelationId: fmt.Sprintf("data-migration-%d", run.ID),
+	})
+}
+
+func isCurrentChunk(run *DataMigrationRun, token string) bool {
+	return run != nil &&
+		run.Status == RUN_STATUS_RUNNING &&
+		token != "" &&
+		run.ChainToken == token
+}
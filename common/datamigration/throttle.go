@@ -0,0 +1,117 @@
+package datamigration
+
+import (
+	"time"
+
+	"ecommerce-be/common/constants"
+)
+
+const (
+	fallbackBatchSize = 500
+	minRetryDelay     = time.Second
+	maxRetryDelay     = 10 * time.Minute
+)
+
+// ResolveBatchSize returns the requested batch size, or the configured default
+// when none was requested, capped at DATA_MIGRATION_MAX_BATCH_SIZE.
+func ResolveBatchSize(requested, configured int) int {
+	size := requested
+	if size <= 0 {
+		size = configured
+	}
+	if size <= 0 {
+		size = fallbackBatchSize
+	}
+	if size > constants.DATA_MIGRATION_MAX_BATCH_SIZE {
+		size = constants.DATA_MIGRATION_MAX_BATCH_SIZE
+	}
+	return size
+}
+
+// ResolveChunkDelayMs returns the requested chunk delay, or the configured default
+// when none was requested, bounded to [0, DATA_MIGRATION_MAX_CHUNK_DELAY_MS].
+func ResolveChunkDelayMs(requested *int, configured int) int {
+	delay := configured
+	if requested != nil {
+		delay = *requested
+	}
+	if delay < 0 {
+		delay = 0
+	}
+	if delay > constants.DATA_MIGRATION_MAX_CHUNK_DELAY_MS {
+		delay = constants.DATA_MIGRATION_MAX_CHUNK_DELAY_MS
+	}
+	return delay
+}
+
+// ChunkDelay converts a run's throttle setting to the delay before its next chunk.
+func ChunkDelay(run *DataMigrationRun) time.Duration {
+	return time.Duration(run.ChunkDelayMs) * time.Millisecond
+}
+
+// RetryDelay backs off exponentially from the run's chunk delay (at least one
+// second) for each consecutive failure, capped at ten minutes.
+func RetryDelay(run *DataMigrationRun) time.Duration {
+	delay := ChunkDelay(run)
+	if delay < minRetryDelay {
+		delay = minRetryDelay
+	}
+	for i := 1; i < run.FailureCount && delay < maxRetryDelay; i++ {
+		delay *= 2
+	}
+	if delay > maxRetryDelay {
+		delay = maxRetryDelay
+	}
+	return delay
+}
+
+// ApplyChunkResult advances a run after a successfully committed chunk.
+func ApplyChunkResult(run *DataMigrationRun, result ChunkResult, now time.Time) {
+	run.Checkpoint = result.Checkpoint
+	run.ProcessedCount += int64(result.Processed)
+	run.ChunkCount++
+	run.FailureCount = 0
+	run.LastError = nil
+	run.HeartbeatAt = now
+	if result.Done {
+		run.Status = RUN_STATUS_COMPLETED
+		run.CompletedAt = &now
+		run.ChainToken = ""
+	}
+}
+
+// ApplyChunkFailure records a failed chunk. The run is marked failed once the
+// consecutive failures exceed DATA_MIGRATION_MAX_CHUNK_RETRIES; it reports
+// whether the chunk should be retried.
+func ApplyChunkFailure(run *DataMigrationRun, chunkErr error, now time.Time) bool {
+	message := chunkErr.Error()
+	run.FailureCount++
+	run.LastError = &message
+	run.HeartbeatAt = now
+	if run.FailureCount > constants.DATA_MIGRATION_MAX_CHUNK_RETRIES {
+		run.Status = RUN_STATUS_FAILED
+		run.ChainToken = ""
+		return false
+	}
+	return true
+}
+
+// ProgressPercent estimates completion from the run's total estimate, or returns
+// nil when the migration does not provide one.
+func ProgressPercent(run *DataMigrationRun) *float64 {
+	var percent float64
+	switch {
+	case run.Status == RUN_STATUS_COMPLETED:
+		percent = 100
+	case run.TotalEstimate == nil:
+		return nil
+	case *run.TotalEstimate <= 0:
+		percent = 0
+	default:
+		percent = float64(run.ProcessedCount) / float64(*run.TotalEstimate) * 100
+		if percent > 99.99 {
+			percent = 99.99
+		}
+	}
+	return &percent
+}
@@ -38,6 +38,11 @@ func ConnectDB(cfg *config.Config) {
 	/* Configure connection pool for production */
 	configureConnectionPool(_db, cfg)
 
+	/* Register read replicas; reads only use them when the request opts in */
+	if err := registerReadReplicas(_db, cfg); err != nil {
+		log.Fatal("Failed to register read replicas", err)
+	}
+
 	db = _db
 	log.Info("Database connected successfully")
 }
@@ -0,0 +1,57 @@
+package db
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// VERSION_COLUMN is the optimistic lock counter of versioned tables
+const VERSION_COLUMN = "version"
+
+// VersionConflict identifies a row that changed since the caller read it
+type VersionConflict struct {
+	ID             uint  `json:"id"`
+	CurrentVersion int64 `json:"currentVersion"`
+}
+
+// UpdateVersioned writes columns to the row of model with id only while it is still at
+// expectedVersion, and bumps its version. When another writer got there first nothing
+// is written and the row's current version is returned as a conflict.
+func UpdateVersioned(
+	ctx context.Context,
+	model any,
+	id uint,
+	expectedVersion int64,
+	columns map[string]any,
+) (*VersionConflict, error) {
+	updates := make(map[string]any, len(columns)+2)
+	for column, value := range columns {
+		updates[column] = value
+	}
+	updates[VERSION_COLUMN] = gorm.Expr(VERSION_COLUMN + " + 1")
+	updates["updated_at"] = time.Now().UTC()
+
+	result := DB(ctx).Model(model).
+		Where("id = ? AND "+VERSION_COLUMN+" = ?", id, expectedVersion).
+		UpdateColumns(updates)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 1 {
+		return nil, nil
+	}
+
+	// A deleted row is reported at version 0
+	var current []int64
+	err := DB(ctx).Model(model).Where("id = ?", id).Pluck(VERSION_COLUMN, &current).Error
+	if err != nil {
+		return nil, err
+	}
+	conflict := &VersionConflict{ID: id}
+	if len(current) > 0 {
+		conflict.CurrentVersion = current[0]
+	}
+	return conflict, nil
+}
@@ -0,0 +1,68 @@
+package db
+
+import (
+	"context"
+	"time"
+
+	"ecommerce-be/common/config"
+	"ecommerce-be/common/reqctx"
+
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/plugin/dbresolver"
+)
+
+// replicasEnabled is set once read replicas are registered on the connection
+var replicasEnabled bool
+
+// registerReadReplicas routes replica-eligible reads to DB_READ_REPLICAS. Writes,
+// locking reads and everything inside a transaction always use the primary.
+func registerReadReplicas(_db *gorm.DB, cfg *config.Config) error {
+	dsns := cfg.Database.ReplicaDSNs()
+	if len(dsns) == 0 {
+		return nil
+	}
+
+	replicas := make([]gorm.Dialector, 0, len(dsns))
+	for _, dsn := range dsns {
+		replicas = append(replicas, postgres.Open(dsn))
+	}
+
+	resolver := dbresolver.Register(dbresolver.Config{
+		Replicas: replicas,
+		Policy:   dbresolver.RandomPolicy{},
+	}).
+		SetMaxOpenConns(cfg.Database.MaxOpenConns).
+		SetMaxIdleConns(cfg.Database.MaxIdleConns).
+		SetConnMaxLifetime(time.Duration(cfg.Database.ConnMaxLifetimeMinutes) * time.Minute).
+		SetConnMaxIdleTime(time.Duration(cfg.Database.ConnMaxIdleTimeMinutes) * time.Minute)
+	if err := _db.Use(resolver); err != nil {
+		return err
+	}
+
+	replicasEnabled = true
+	return nil
+}
+
+// UseReadReplica returns a copy of ctx whose reads may be served by a read replica.
+// Only use it where slightly stale data is acceptable.
+func UseReadReplica(ctx context.Context) context.Context {
+	return reqctx.ReadReplica.With(ctx, true)
+}
+
+// UsePrimary returns a copy of ctx whose reads always hit the primary, for
+// read-your-writes paths inside replica-eligible requests
+func UsePrimary(ctx context.Context) context.Context {
+	return reqctx.ReadReplica.With(ctx, false)
+}
+
+// routeReads pins statements to the primary unless ctx opted in to replicas
+func routeReads(ctx context.Context, conn *gorm.DB) *gorm.DB {
+	if !replicasEnabled {
+		return conn
+	}
+	if useReplica, _ := reqctx.ReadReplica.Get(ctx); useReplica {
+		return conn
+	}
+	return conn.Clauses(dbresolver.Write)
+}
@@ -3,6 +3,7 @@ package db
 import (
 	"database/sql/driver"
 	"fmt"
+	"strconv"
 	"strings"
 )
 
@@ -135,3 +136,55 @@ func (a *Int64Array) Scan(value any) error {
 	*a = result
 	return nil
 }
+
+// Float64Array represents a PostgreSQL double precision[] array type
+type Float64Array []float64
+
+// Value implements the driver.Valuer interface for double precision[]
+func (a Float64Array) Value() (driver.Value, error) {
+	if a == nil {
+		return "{}", nil
+	}
+	var b strings.Builder
+	b.WriteString("{")
+	for i, n := range a {
+		if i > 0 {
+			b.WriteString(",")
+		}
+		b.WriteString(strconv.FormatFloat(n, 'g', -1, 64))
+	}
+	b.WriteString("}")
+	return b.String(), nil
+}
+
+// Scan implements the sql.Scanner interface for double precision[]
+func (a *Float64Array) Scan(value any) error {
+	var str string
+	switch v := value.(type) {
+	case nil:
+	case string:
+		str = v
+	case []byte:
+		str = string(v)
+	default:
+		return fmt.Errorf("cannot scan type %T into Float64Array", value)
+	}
+
+	str = strings.Trim(str, "{}")
+	if str == "" {
+		*a = []float64{}
+		return nil
+	}
+
+	parts := strings.Split(str, ",")
+	result := make([]float64, len(parts))
+	for i, p := range parts {
+		n, err := strconv.ParseFloat(strings.TrimSpace(p), 64)
+		if err != nil {
+			return fmt.Errorf("failed to parse float64 from '%s': %w", p, err)
+		}
+		result[i] = n
+	}
+	*a = result
+	return nil
+}
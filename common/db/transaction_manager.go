@@ -2,6 +2,8 @@ package db
 
 import (
 	"context"
+	"database/sql"
+	"sync"
 
 	"gorm.io/gorm"
 )
@@ -18,11 +20,14 @@ type txKey struct{}
 //	func (r *ProductRepository) Create(ctx context.Context, product *entity.Product) error {
 //	    return db.DB(ctx).Create(product).Error
 //	}
+//
+// Outside a transaction, reads go to a read replica only when ctx opted in via
+// UseReadReplica or the ReadReplica middleware; everything else uses the primary.
 func DB(ctx context.Context) *gorm.DB {
 	if tx, ok := ctx.Value(txKey{}).(*gorm.DB); ok {
 		return tx.WithContext(ctx)
 	}
-	return GetDB().WithContext(ctx)
+	return routeReads(ctx, GetDB().WithContext(ctx))
 }
 
 // IsInTransaction checks if the context is currently in a transaction
@@ -31,9 +36,21 @@ func IsInTransaction(ctx context.Context) bool {
 	return ok
 }
 
+// WithoutTransaction returns ctx detached from any transaction it carries, so work
+// that outlives the caller, such as a shared cache load, reads committed data from the
+// pool and runs its after-commit hooks straight away
+func WithoutTransaction(ctx context.Context) context.Context {
+	if ctx.Value(txKey{}) == nil && ctx.Value(afterCommitKey{}) == nil {
+		return ctx
+	}
+	ctx = context.WithValue(ctx, txKey{}, nil)
+	return context.WithValue(ctx, afterCommitKey{}, nil)
+}
+
 // WithTransaction executes fn within a database transaction
 // The transaction is stored in context and can be retrieved via GetDBFromContext
 // Supports nested calls - if already in a transaction, reuses the existing one
+// opts, such as a read-only transaction, apply only when a new transaction starts
 //
 // Example:
 //
@@ -46,17 +63,28 @@ func IsInTransaction(ctx context.Context) bool {
 //	    }
 //	    return nil // Commit
 //	})
-func WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+func WithTransaction(
+	ctx context.Context,
+	fn func(ctx context.Context) error,
+	opts ...*sql.TxOptions,
+) error {
 	// Check if already in transaction (nested call)
 	if _, ok := ctx.Value(txKey{}).(*gorm.DB); ok {
 		return fn(ctx) // Reuse existing transaction
 	}
 
-	// Start new transaction
-	return GetDB().Transaction(func(tx *gorm.DB) error {
+	// Start new transaction; after-commit hooks run once it has committed
+	hooks := &afterCommitHooks{}
+	err := GetDB().Transaction(func(tx *gorm.DB) error {
 		txCtx := context.WithValue(ctx, txKey{}, tx)
+		txCtx = context.WithValue(txCtx, afterCommitKey{}, hooks)
 		return fn(txCtx)
-	})
+	}, opts...)
+	if err != nil {
+		return err
+	}
+	hooks.run()
+	return nil
 }
 
 // WithTransactionResult executes fn within a transaction and returns a result
@@ -82,9 +110,8 @@ func WithTransactionResult[T any](
 		return fn(ctx)
 	}
 
-	// Start new transaction
-	err := GetDB().Transaction(func(tx *gorm.DB) error {
-		txCtx := context.WithValue(ctx, txKey{}, tx)
+	// Start new transaction through WithTransaction so after-commit hooks run
+	err := WithTransaction(ctx, func(txCtx context.Context) error {
 		var fnErr error
 		result, fnErr = fn(txCtx)
 		return fnErr
@@ -92,3 +119,43 @@ func WithTransactionResult[T any](
 
 	return result, err
 }
+
+// afterCommitKey is the context key for the hooks of the current transaction
+type afterCommitKey struct{}
+
+// afterCommitHooks collects side effects to run once the outermost transaction commits
+type afterCommitHooks struct {
+	mu  sync.Mutex
+	fns []func()
+}
+
+func (h *afterCommitHooks) add(fn func()) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.fns = append(h.fns, fn)
+}
+
+func (h *afterCommitHooks) run() {
+	h.mu.Lock()
+	fns := h.fns
+	h.fns = nil
+	h.mu.Unlock()
+	for _, fn := range fns {
+		fn()
+	}
+}
+
+// AfterCommit runs fn once the transaction in ctx commits, and drops it on rollback.
+// Outside a transaction fn runs immediately. Use it for side effects other readers
+// must not see before the data, such as cache eviction or event publishing.
+//
+// Example:
+//
+//	db.AfterCommit(ctx, func() { cache.Del(key) })
+func AfterCommit(ctx context.Context, fn func()) {
+	if hooks, ok := ctx.Value(afterCommitKey{}).(*afterCommitHooks); ok {
+		hooks.add(fn)
+		return
+	}
+	fn()
+}
@@ -0,0 +1,56 @@
+package db
+
+import "context"
+
+// TxManager is the unit of work services depend on to make calls across several
+// repositories atomic. Repositories join the transaction through db.DB(ctx), so
+// neither services nor their callers ever handle a *gorm.DB.
+//
+// Example:
+//
+//	type OrderService struct {
+//	    tx        db.TxManager
+//	    orderRepo repository.OrderRepository
+//	    stockRepo repository.StockRepository
+//	}
+//
+//	err := s.tx.Do(ctx, func(ctx context.Context) error {
+//	    if err := s.orderRepo.Create(ctx, order); err != nil {
+//	        return err // Rollback
+//	    }
+//	    db.AfterCommit(ctx, func() { publishOrderCreated(order) })
+//	    return s.stockRepo.Reserve(ctx, order.Items)
+//	})
+type TxManager interface {
+	// Do runs fn in a transaction, joining the one already in ctx if there is one.
+	// The transaction commits when fn returns nil and rolls back otherwise.
+	Do(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// gormTxManager runs units of work on the primary database connection
+type gormTxManager struct{}
+
+// NewTxManager returns the TxManager backed by the application database
+func NewTxManager() TxManager {
+	return gormTxManager{}
+}
+
+// Do runs fn in a database transaction
+func (gormTxManager) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	return WithTransaction(ctx, fn)
+}
+
+// DoWithResult runs fn through tm and returns its result
+func DoWithResult[T any](
+	ctx context.Context,
+	tm TxManager,
+	fn func(ctx context.Context) (T, error),
+) (T, error) {
+	var result T
+	err := tm.Do(ctx, func(txCtx context.Context) error {
+		var fnErr error
+		result, fnErr = fn(txCtx)
+		return fnErr
+	})
+	return result, err
+}
@@ -0,0 +1,27 @@
+package deadletter
+
+import (
+	"ecommerce-be/common"
+	"ecommerce-be/common/cache"
+	"ecommerce-be/common/scheduler"
+
+	"github.com/gin-gonic/gin"
+)
+
+// NewContainer registers the dead-letter queue admin routes
+func NewContainer(router *gin.Engine) *common.Container {
+	c := &common.Container{}
+
+	// Without Redis there is no queue to inspect; the routes report it as unavailable
+	var sched *scheduler.Scheduler
+	if redisClient, err := cache.GetRedisClient(); err == nil {
+		sched = scheduler.New(redisClient)
+	}
+	c.RegisterModule(NewModule(NewHandler(NewService(sched))))
+
+	for _, module := range c.Modules {
+		module.RegisterRoutes(router)
+	}
+
+	return c
+}
@@ -0,0 +1,98 @@
+package deadletter
+
+import (
+	"net/http"
+
+	"ecommerce-be/common/constants"
+	"ecommerce-be/common/handler"
+	"ecommerce-be/common/log"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Handler handles the dead-letter queue admin API
+type Handler struct {
+	*handler.BaseHandler
+	service Service
+}
+
+// NewHandler creates a new instance of Handler
+func NewHandler(service Service) *Handler {
+	return &Handler{
+		BaseHandler: handler.NewBaseHandler(),
+		service:     service,
+	}
+}
+
+// ListDeadLetters handles listing dead-lettered jobs
+// GET /api/jobs/dead-letters
+func (h *Handler) ListDeadLetters(c *gin.Context) {
+	var params ListDeadLettersQueryParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	response, err := h.service.ListDeadLetters(c, params)
+	if err != nil {
+		log.ErrorWithContext(c, "listDeadLetters: failed", err)
+		h.HandleError(c, err, constants.FAILED_TO_LIST_DEAD_LETTERS_MSG)
+		return
+	}
+
+	h.Success(c, http.StatusOK, constants.DEAD_LETTER_JOBS_LISTED_MSG, response)
+}
+
+// RequeueDeadLetter handles putting a dead-lettered job back on its queue
+// POST /api/jobs/dead-letters/:jobId/requeue
+func (h *Handler) RequeueDeadLetter(c *gin.Context) {
+	jobID := c.Param(constants.DEAD_LETTER_JOB_ID_PARAM)
+
+	response, err := h.service.RequeueDeadLetter(c, jobID)
+	if err != nil {
+		log.ErrorWithContext(c, "requeueDeadLetter: failed", err)
+		h.HandleError(c, err, constants.FAILED_TO_REQUEUE_DEAD_LETTER_MSG)
+		return
+	}
+
+	h.SuccessWithData(
+		c,
+		http.StatusOK,
+		constants.DEAD_LETTER_JOB_REQUEUED_MSG,
+		constants.DEAD_LETTER_JOB_FIELD_NAME,
+		response,
+	)
+}
+
+// PurgeDeadLetter handles deleting one dead-lettered job
+// DELETE /api/jobs/dead-letters/:jobId
+func (h *Handler) PurgeDeadLetter(c *gin.Context) {
+	jobID := c.Param(constants.DEAD_LETTER_JOB_ID_PARAM)
+
+	if err := h.service.PurgeDeadLetter(c, jobID); err != nil {
+		log.ErrorWithContext(c, "purgeDeadLetter: failed", err)
+		h.HandleError(c, err, constants.FAILED_TO_PURGE_DEAD_LETTERS_MSG)
+		return
+	}
+
+	h.Success(c, http.StatusOK, constants.DEAD_LETTER_JOBS_PURGED_MSG, nil)
+}
+
+// PurgeAllDeadLetters handles emptying the dead-letter queue
+// DELETE /api/jobs/dead-letters
+func (h *Handler) PurgeAllDeadLetters(c *gin.Context) {
+	purged, err := h.service.PurgeAllDeadLetters(c)
+	if err != nil {
+		log.ErrorWithContext(c, "purgeAllDeadLetters: failed", err)
+		h.HandleError(c, err, constants.FAILED_TO_PURGE_DEAD_LETTERS_MSG)
+		return
+	}
+
+	h.SuccessWithData(
+		c,
+		http.StatusOK,
+		constants.DEAD_LETTER_JOBS_PURGED_MSG,
+		constants.PURGED_JOBS_FIELD_NAME,
+		purged,
+	)
+}
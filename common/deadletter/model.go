@@ -0,0 +1,84 @@
+package deadletter
+
+import (
+	"encoding/json"
+	"time"
+
+	"ecommerce-be/common"
+	"ecommerce-be/common/scheduler"
+)
+
+// ========================================
+// REQUEST MODELS
+// ========================================
+
+// ListDeadLettersQueryParams - Page of the dead-letter queue
+type ListDeadLettersQueryParams struct {
+	Page     int `form:"page"     binding:"omitempty,min=1"`
+	PageSize int `form:"pageSize" binding:"omitempty,min=1,max=100"`
+}
+
+// ========================================
+// RESPONSE MODELS
+// ========================================
+
+// DeadLetterListResponse is a page of dead-lettered jobs, most recent failure first
+type DeadLetterListResponse struct {
+	Jobs       []DeadLetterJobResponse   `json:"jobs"`
+	Pagination common.PaginationResponse `json:"pagination"`
+}
+
+// DeadLetterJobResponse describes a job that failed its last allowed attempt
+type DeadLetterJobResponse struct {
+	JobID     string             `json:"jobId"`
+	Command   string             `json:"command"`
+	Payload   json.RawMessage    `json:"payload"`
+	Priority  scheduler.Priority `json:"priority"`
+	Attempts  int                `json:"attempts"`
+	LastError string             `json:"lastError"`
+	SellerID  uint               `json:"sellerId"`
+	FailedAt  time.Time          `json:"failedAt"`
+}
+
+// RequeuedJobResponse describes a job put back on its queue
+type RequeuedJobResponse struct {
+	JobID    string             `json:"jobId"`
+	Command  string             `json:"command"`
+	Priority scheduler.Priority `json:"priority"`
+}
+
+// ========================================
+// HELPERS
+// ========================================
+
+// toDeadLetterJobResponse converts a dead-lettered job to its response
+func toDeadLetterJobResponse(job scheduler.DeadLetterJob) DeadLetterJobResponse {
+	return DeadLetterJobResponse{
+		JobID:     job.JobID.String(),
+		Command:   job.Command,
+		Payload:   job.Payload,
+		Priority:  priorityOf(job.Job),
+		Attempts:  job.Attempt,
+		LastError: job.LastError,
+		SellerID:  job.SellerID,
+		FailedAt:  job.FailedAt,
+	}
+}
+
+// priorityOf returns the job's priority, normal when unset
+func priorityOf(job *scheduler.Job) scheduler.Priority {
+	if job.Priority == "" {
+		return scheduler.PriorityNormal
+	}
+	return job.Priority
+}
+
+func normalizePage(page, pageSize int) (int, int) {
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = 20
+	}
+	return page, pageSize
+}
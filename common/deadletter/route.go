@@ -0,0 +1,50 @@
+package deadletter
+
+import (
+	"net/http"
+
+	"ecommerce-be/common/constants"
+	"ecommerce-be/common/middleware"
+	"ecommerce-be/common/openapi"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Module registers the dead-letter queue admin routes
+type Module struct {
+	handler *Handler
+}
+
+// NewModule creates a new instance of Module
+func NewModule(handler *Handler) *Module {
+	return &Module{handler: handler}
+}
+
+// RegisterRoutes registers dead-letter queue routes (admin only)
+func (m *Module) RegisterRoutes(router *gin.Engine) {
+	jobRoutes := openapi.NewGroup(router.Group(constants.APIBaseJobs), "Background Jobs")
+	jobRoutes.Use(middleware.AdminAuth())
+	{
+		// GET /api/jobs/dead-letters - Failed jobs, most recent failure first
+		// Query params: ?page=1&pageSize=20
+		jobRoutes.GET("/dead-letters", m.handler.ListDeadLetters).
+			Summary("List dead-lettered jobs").
+			Query(ListDeadLettersQueryParams{}).
+			Returns(http.StatusOK, DeadLetterListResponse{})
+
+		// POST /api/jobs/dead-letters/:jobId/requeue - Run a failed job again
+		jobRoutes.POST("/dead-letters/:jobId/requeue", m.handler.RequeueDeadLetter).
+			Summary("Requeue a dead-lettered job").
+			Description("The job runs as soon as a worker is free, with a fresh attempt budget.").
+			ReturnsField(http.StatusOK, "job", RequeuedJobResponse{})
+
+		// DELETE /api/jobs/dead-letters/:jobId - Drop a failed job
+		jobRoutes.DELETE("/dead-letters/:jobId", m.handler.PurgeDeadLetter).
+			Summary("Delete a dead-lettered job")
+
+		// DELETE /api/jobs/dead-letters - Empty the dead-letter queue
+		jobRoutes.DELETE("/dead-letters", m.handler.PurgeAllDeadLetters).
+			Summary("Purge the dead-letter queue").
+			ReturnsField(http.StatusOK, "purged", int64(0))
+	}
+}
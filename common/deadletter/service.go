@@ -0,0 +1,100 @@
+package deadletter
+
+import (
+	"context"
+
+	"ecommerce-be/common"
+	"ecommerce-be/common/scheduler"
+
+	"github.com/google/uuid"
+)
+
+// Service inspects and recovers jobs the scheduler gave up on
+type Service interface {
+	ListDeadLetters(
+		ctx context.Context,
+		params ListDeadLettersQueryParams,
+	) (*DeadLetterListResponse, error)
+	RequeueDeadLetter(ctx context.Context, jobID string) (*RequeuedJobResponse, error)
+	PurgeDeadLetter(ctx context.Context, jobID string) error
+	PurgeAllDeadLetters(ctx context.Context) (int64, error)
+}
+
+// ServiceImpl implements Service on the scheduler's Redis queues
+type ServiceImpl struct {
+	scheduler *scheduler.Scheduler
+}
+
+// NewService creates a new instance of Service. sched is nil when Redis is not
+// available, in which case every call fails with ErrQueueUnavailable.
+func NewService(sched *scheduler.Scheduler) *ServiceImpl {
+	return &ServiceImpl{scheduler: sched}
+}
+
+// ListDeadLetters returns a page of the dead-letter queue
+func (s *ServiceImpl) ListDeadLetters(
+	ctx context.Context,
+	params ListDeadLettersQueryParams,
+) (*DeadLetterListResponse, error) {
+	if s.scheduler == nil {
+		return nil, scheduler.ErrQueueUnavailable
+	}
+	params.Page, params.PageSize = normalizePage(params.Page, params.PageSize)
+
+	jobs, total, err := s.scheduler.ListDeadLetters(ctx, params.Page, params.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	responses := make([]DeadLetterJobResponse, 0, len(jobs))
+	for _, job := range jobs {
+		responses = append(responses, toDeadLetterJobResponse(job))
+	}
+	return &DeadLetterListResponse{
+		Jobs:       responses,
+		Pagination: common.NewPaginationResponse(params.Page, params.PageSize, total),
+	}, nil
+}
+
+// RequeueDeadLetter queues a dead-lettered job to run now with a fresh attempt budget
+func (s *ServiceImpl) RequeueDeadLetter(
+	ctx context.Context,
+	jobID string,
+) (*RequeuedJobResponse, error) {
+	if s.scheduler == nil {
+		return nil, scheduler.ErrQueueUnavailable
+	}
+	if _, err := uuid.Parse(jobID); err != nil {
+		return nil, scheduler.ErrDeadLetterNotFound
+	}
+
+	job, err := s.scheduler.RequeueDeadLetter(ctx, jobID)
+	if err != nil {
+		return nil, err
+	}
+	return &RequeuedJobResponse{
+		JobID:    job.JobID.String(),
+		Command:  job.Command,
+		Priority: priorityOf(job.Job),
+	}, nil
+}
+
+// PurgeDeadLetter deletes one dead-lettered job
+func (s *ServiceImpl) PurgeDeadLetter(ctx context.Context, jobID string) error {
+	if s.scheduler == nil {
+		return scheduler.ErrQueueUnavailable
+	}
+	if _, err := uuid.Parse(jobID); err != nil {
+		return scheduler.ErrDeadLetterNotFound
+	}
+
+	_, err := s.scheduler.PurgeDeadLetters(ctx, jobID)
+	return err
+}
+
+// PurgeAllDeadLetters empties the dead-letter queue and returns how many jobs it held
+func (s *ServiceImpl) PurgeAllDeadLetters(ctx context.Context) (int64, error) {
+	if s.scheduler == nil {
+		return 0, scheduler.ErrQueueUnavailable
+	}
+	return s.scheduler.PurgeDeadLetters(ctx, "")
+}
@@ -0,0 +1,46 @@
+package encryption
+
+import (
+	"os"
+	"sync"
+
+	"ecommerce-be/common"
+	"ecommerce-be/common/config"
+
+	"github.com/gin-gonic/gin"
+)
+
+var (
+	instance Service
+	once     sync.Once
+)
+
+// GetService returns the singleton tenant encryption service
+func GetService() Service {
+	once.Do(func() {
+		instance = NewService(NewKeyRepository(), masterKey())
+	})
+	return instance
+}
+
+// NewContainer registers the tenant key admin routes
+func NewContainer(router *gin.Engine) *common.Container {
+	c := &common.Container{}
+
+	c.RegisterModule(NewModule(NewHandler(GetService())))
+
+	for _, module := range c.Modules {
+		module.RegisterRoutes(router)
+	}
+
+	return c
+}
+
+// masterKey returns the system key that wraps every tenant data key. Uses the config
+// singleton when loaded; falls back to the ENCRYPTION_KEY env var otherwise.
+func masterKey() string {
+	if cfg := config.Get(); cfg != nil && cfg.App.EncryptionKey != "" {
+		return cfg.App.EncryptionKey
+	}
+	return os.Getenv("ENCRYPTION_KEY")
+}
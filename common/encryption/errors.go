@@ -0,0 +1,43 @@
+package encryption
+
+import (
+	"net/http"
+
+	"ecommerce-be/common/constants"
+	commonError "ecommerce-be/common/error"
+)
+
+var (
+	ErrKeyNotFound = &commonError.AppError{
+		Code:       constants.TENANT_KEY_NOT_FOUND_CODE,
+		Message:    constants.TENANT_KEY_NOT_FOUND_MSG,
+		StatusCode: http.StatusNotFound,
+	}
+
+	ErrKeyUnwrapFailed = &commonError.AppError{
+		Code:       constants.TENANT_KEY_UNWRAP_FAILED_CODE,
+		Message:    constants.TENANT_KEY_UNWRAP_FAILED_MSG,
+		StatusCode: http.StatusInternalServerError,
+	}
+
+	ErrInvalidCiphertext = &commonError.AppError{
+		Code:       constants.TENANT_CIPHERTEXT_INVALID_CODE,
+		Message:    constants.TENANT_CIPHERTEXT_INVALID_MSG,
+		StatusCode: http.StatusBadRequest,
+	}
+
+	ErrDecryptionFailed = &commonError.AppError{
+		Code:       constants.TENANT_DECRYPTION_FAILED_CODE,
+		Message:    constants.TENANT_DECRYPTION_FAILED_MSG,
+		StatusCode: http.StatusForbidden,
+	}
+)
+
+func init() {
+	commonError.Register(
+		ErrKeyNotFound,
+		ErrKeyUnwrapFailed,
+		ErrInvalidCiphertext,
+		ErrDecryptionFailed,
+	)
+}
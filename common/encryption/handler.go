@@ -0,0 +1,63 @@
+package encryption
+
+import (
+	"net/http"
+
+	"ecommerce-be/common/constants"
+	"ecommerce-be/common/handler"
+	"ecommerce-be/common/log"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Handler handles the tenant key admin API
+type Handler struct {
+	*handler.BaseHandler
+	service Service
+}
+
+// NewHandler creates a new instance of Handler
+func NewHandler(service Service) *Handler {
+	return &Handler{
+		BaseHandler: handler.NewBaseHandler(),
+		service:     service,
+	}
+}
+
+// ListKeys handles listing a seller's key versions
+// GET /api/encryption/sellers/:sellerId/keys
+func (h *Handler) ListKeys(c *gin.Context) {
+	sellerID, err := h.ParseUintParam(c, "sellerId")
+	if err != nil {
+		h.HandleError(c, err, constants.FAILED_TO_LIST_TENANT_KEYS_MSG)
+		return
+	}
+
+	response, err := h.service.ListKeys(c, sellerID)
+	if err != nil {
+		log.ErrorWithContext(c, "listTenantKeys: failed", err)
+		h.HandleError(c, err, constants.FAILED_TO_LIST_TENANT_KEYS_MSG)
+		return
+	}
+
+	h.SuccessWithData(c, http.StatusOK, constants.TENANT_KEYS_LISTED_MSG, "keys", response)
+}
+
+// RotateKey handles rotating a seller's data key
+// POST /api/encryption/sellers/:sellerId/keys/rotate
+func (h *Handler) RotateKey(c *gin.Context) {
+	sellerID, err := h.ParseUintParam(c, "sellerId")
+	if err != nil {
+		h.HandleError(c, err, constants.FAILED_TO_ROTATE_TENANT_KEY_MSG)
+		return
+	}
+
+	response, err := h.service.RotateKey(c, sellerID)
+	if err != nil {
+		log.ErrorWithContext(c, "rotateTenantKey: failed", err)
+		h.HandleError(c, err, constants.FAILED_TO_ROTATE_TENANT_KEY_MSG)
+		return
+	}
+
+	h.SuccessWithData(c, http.StatusOK, constants.TENANT_KEY_ROTATED_MSG, "key", response)
+}
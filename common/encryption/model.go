@@ -0,0 +1,61 @@
+package encryption
+
+import (
+	"time"
+
+	"ecommerce-be/common/db"
+)
+
+// KeyStatus is the lifecycle state of a tenant data key.
+type KeyStatus string
+
+const (
+	// KEY_STATUS_ACTIVE keys encrypt new values; a seller has at most one
+	KEY_STATUS_ACTIVE KeyStatus = "active"
+	// KEY_STATUS_RETIRED keys only decrypt values written before a rotation
+	KEY_STATUS_RETIRED KeyStatus = "retired"
+)
+
+// TenantDataKey is one version of a seller's data encryption key. The key itself is
+// stored wrapped (encrypted) with the system master key and never leaves the process
+// in plaintext.
+type TenantDataKey struct {
+	db.BaseEntity
+	SellerID   uint       `json:"sellerId"   gorm:"column:seller_id;not null"`
+	Version    int        `json:"version"    gorm:"column:version;not null"`
+	WrappedKey string     `json:"-"          gorm:"column:wrapped_key;not null"`
+	Status     KeyStatus  `json:"status"     gorm:"column:status;size:20;not null"`
+	RetiredAt  *time.Time `json:"retiredAt"  gorm:"column:retired_at"`
+}
+
+func (TenantDataKey) TableName() string {
+	return "tenant_data_key"
+}
+
+// ========================================
+// RESPONSE MODELS
+// ========================================
+
+// KeyResponse describes a key version without any key material
+type KeyResponse struct {
+	SellerID  uint      `json:"sellerId"`
+	Version   int       `json:"version"`
+	Status    KeyStatus `json:"status"`
+	CreatedAt string    `json:"createdAt"`
+	RetiredAt *string   `json:"retiredAt"`
+}
+
+// BuildKeyResponse maps a key entity to its API response
+func BuildKeyResponse(key *TenantDataKey) KeyResponse {
+	resp := KeyResponse{
+		SellerID:  key.SellerID,
+		Version:   key.Version,
+		Status:    key.Status,
+		CreatedAt: key.CreatedAt.UTC().Format(time.RFC3339),
+	}
+	if key.RetiredAt != nil {
+		retiredAt := key.RetiredAt.UTC().Format(time.RFC3339)
+		resp.RetiredAt = &retiredAt
+	}
+	return resp
+}
@@ -0,0 +1,100 @@
+package encryption
+
+import (
+	"context"
+	"errors"
+
+	"ecommerce-be/common/db"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// KeyRepository defines database operations for tenant data keys
+type KeyRepository interface {
+	Create(ctx context.Context, key *TenantDataKey) error
+	Save(ctx context.Context, key *TenantDataKey) error
+	// FindActive returns the seller's active key, or nil when none is provisioned
+	FindActive(ctx context.Context, sellerID uint) (*TenantDataKey, error)
+	// FindActiveForUpdate locks the active key so concurrent rotations serialize
+	FindActiveForUpdate(ctx context.Context, sellerID uint) (*TenantDataKey, error)
+	// FindByVersion returns one of the seller's key versions, or nil when it does not exist
+	FindByVersion(ctx context.Context, sellerID uint, version int) (*TenantDataKey, error)
+	FindAllBySellerID(ctx context.Context, sellerID uint) ([]TenantDataKey, error)
+	MaxVersion(ctx context.Context, sellerID uint) (int, error)
+}
+
+// KeyRepositoryImpl implements KeyRepository
+type KeyRepositoryImpl struct{}
+
+// NewKeyRepository creates a new instance of KeyRepository
+func NewKeyRepository() KeyRepository {
+	return &KeyRepositoryImpl{}
+}
+
+// Create persists a new key version
+func (r *KeyRepositoryImpl) Create(ctx context.Context, key *TenantDataKey) error {
+	return db.DB(ctx).Create(key).Error
+}
+
+// Save writes every column of the key
+func (r *KeyRepositoryImpl) Save(ctx context.Context, key *TenantDataKey) error {
+	return db.DB(ctx).Save(key).Error
+}
+
+// FindActive returns the seller's active key
+func (r *KeyRepositoryImpl) FindActive(ctx context.Context, sellerID uint) (*TenantDataKey, error) {
+	return findKey(db.DB(ctx).Where("seller_id = ? AND status = ?", sellerID, KEY_STATUS_ACTIVE))
+}
+
+// FindActiveForUpdate returns the seller's active key with a row lock
+func (r *KeyRepositoryImpl) FindActiveForUpdate(
+	ctx context.Context,
+	sellerID uint,
+) (*TenantDataKey, error) {
+	return findKey(db.DB(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("seller_id = ? AND status = ?", sellerID, KEY_STATUS_ACTIVE))
+}
+
+// FindByVersion returns a specific key version of the seller
+func (r *KeyRepositoryImpl) FindByVersion(
+	ctx context.Context,
+	sellerID uint,
+	version int,
+) (*TenantDataKey, error) {
+	return findKey(db.DB(ctx).Where("seller_id = ? AND version = ?", sellerID, version))
+}
+
+// FindAllBySellerID returns every key version of the seller, newest first
+func (r *KeyRepositoryImpl) FindAllBySellerID(
+	ctx context.Context,
+	sellerID uint,
+) ([]TenantDataKey, error) {
+	var keys []TenantDataKey
+	err := db.DB(ctx).Where("seller_id = ?", sellerID).Order("version DESC").Find(&keys).Error
+	return keys, err
+}
+
+// MaxVersion returns the seller's highest key version, or 0 when none exists
+func (r *KeyRepositoryImpl) MaxVersion(ctx context.Context, sellerID uint) (int, error) {
+	var version int
+	err := db.DB(ctx).
+		Model(&TenantDataKey{}).
+		Where("seller_id = ?", sellerID).
+		Select("COALESCE(MAX(version), 0)").
+		Scan(&version).Error
+	return version, err
+}
+
+func findKey(query *gorm.DB) (*TenantDataKey, error) {
+	var key TenantDataKey
+	err := query.First(&key).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &key, nil
+}
@@ -0,0 +1,40 @@
+package encryption
+
+import (
+	"net/http"
+
+	"ecommerce-be/common/constants"
+	"ecommerce-be/common/middleware"
+	"ecommerce-be/common/openapi"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Module registers the tenant key admin routes
+type Module struct {
+	handler *Handler
+}
+
+// NewModule creates a new instance of Module
+func NewModule(handler *Handler) *Module {
+	return &Module{handler: handler}
+}
+
+// RegisterRoutes registers tenant key routes (admin only)
+func (m *Module) RegisterRoutes(router *gin.Engine) {
+	encryptionRoutes := openapi.NewGroup(router.Group(constants.APIBaseEncryption), "Encryption")
+	encryptionRoutes.Use(middleware.AdminAuth())
+	{
+		// GET /api/encryption/sellers/:sellerId/keys - Key versions, newest first
+		encryptionRoutes.GET("/sellers/:sellerId/keys", m.handler.ListKeys).
+			Summary("List a seller's data key versions").
+			ReturnsField(http.StatusOK, "keys", []KeyResponse{})
+
+		// POST /api/encryption/sellers/:sellerId/keys/rotate - Retire the active key
+		encryptionRoutes.POST("/sellers/:sellerId/keys/rotate", m.handler.RotateKey).
+			Summary("Rotate a seller's data key").
+			Description("New values are encrypted with the new version; existing values "+
+				"stay readable with the retired one until re-encrypted.").
+			ReturnsField(http.StatusOK, "key", KeyResponse{})
+	}
+}
@@ -0,0 +1,284 @@
+// Package encryption provides per-tenant envelope encryption for seller data.
+//
+// Every seller gets its own random AES-256 data key. Keys are stored wrapped with
+// the system master key (ENCRYPTION_KEY) and values are sealed with AES-GCM using
+// the seller and key version as additional data, so a value encrypted for one
+// seller cannot be decrypted with another seller's key, nor moved between sellers.
+//
+// Rotation retires the active key and provisions the next version. Retired versions
+// keep decrypting existing values; Reencrypt moves a value onto the active version.
+package encryption
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"ecommerce-be/common/constants"
+	"ecommerce-be/common/db"
+	"ecommerce-be/common/helper"
+)
+
+// Service encrypts and decrypts seller data with the seller's own data key and
+// manages the key lifecycle.
+type Service interface {
+	// ProvisionKey creates the seller's first data key; a no-op when one is active
+	ProvisionKey(ctx context.Context, sellerID uint) error
+	// RotateKey retires the active key and makes a new version active
+	RotateKey(ctx context.Context, sellerID uint) (*KeyResponse, error)
+	ListKeys(ctx context.Context, sellerID uint) ([]KeyResponse, error)
+
+	Encrypt(ctx context.Context, sellerID uint, plaintext string) (string, error)
+	Decrypt(ctx context.Context, sellerID uint, ciphertext string) (string, error)
+	// Reencrypt re-seals a value under the seller's active key. Values already on the
+	// active version are returned unchanged.
+	Reencrypt(ctx context.Context, sellerID uint, ciphertext string) (string, error)
+}
+
+// ServiceImpl implements Service
+type ServiceImpl struct {
+	repo      KeyRepository
+	masterKey string
+
+	// dataKeys caches unwrapped keys by key row ID. IDs are never reused, even when
+	// the transaction that created a key rolls back, so entries never go stale.
+	dataKeys sync.Map
+}
+
+// NewService creates a new instance of Service. masterKey wraps every data key and
+// must be 32 bytes.
+func NewService(repo KeyRepository, masterKey string) Service {
+	return &ServiceImpl{repo: repo, masterKey: masterKey}
+}
+
+// ProvisionKey creates the seller's first data key
+func (s *ServiceImpl) ProvisionKey(ctx context.Context, sellerID uint) error {
+	active, err := s.repo.FindActive(ctx, sellerID)
+	if err != nil {
+		return err
+	}
+	if active != nil {
+		return nil
+	}
+	_, err = s.createKey(ctx, sellerID)
+	return err
+}
+
+// RotateKey retires the active key and provisions the next version
+func (s *ServiceImpl) RotateKey(ctx context.Context, sellerID uint) (*KeyResponse, error) {
+	return db.WithTransactionResult(ctx, func(txCtx context.Context) (*KeyResponse, error) {
+		active, err := s.repo.FindActiveForUpdate(txCtx, sellerID)
+		if err != nil {
+			return nil, err
+		}
+		if active != nil {
+			now := time.Now()
+			active.Status = KEY_STATUS_RETIRED
+			active.RetiredAt = &now
+			if err := s.repo.Save(txCtx, active); err != nil {
+				return nil, err
+			}
+		}
+
+		key, err := s.createKey(txCtx, sellerID)
+		if err != nil {
+			return nil, err
+		}
+		resp := BuildKeyResponse(key)
+		return &resp, nil
+	})
+}
+
+// ListKeys returns the seller's key versions, newest first
+func (s *ServiceImpl) ListKeys(ctx context.Context, sellerID uint) ([]KeyResponse, error) {
+	keys, err := s.repo.FindAllBySellerID(ctx, sellerID)
+	if err != nil {
+		return nil, err
+	}
+	responses := make([]KeyResponse, 0, len(keys))
+	for i := range keys {
+		responses = append(responses, BuildKeyResponse(&keys[i]))
+	}
+	return responses, nil
+}
+
+// Encrypt seals plaintext with the seller's active key
+func (s *ServiceImpl) Encrypt(
+	ctx context.Context,
+	sellerID uint,
+	plaintext string,
+) (string, error) {
+	key, err := s.repo.FindActive(ctx, sellerID)
+	if err != nil {
+		return "", err
+	}
+	if key == nil {
+		return "", ErrKeyNotFound
+	}
+	return s.seal(key, plaintext)
+}
+
+// Decrypt opens a value sealed with any of the seller's key versions
+func (s *ServiceImpl) Decrypt(
+	ctx context.Context,
+	sellerID uint,
+	ciphertext string,
+) (string, error) {
+	version, sealed, err := parseCiphertext(ciphertext)
+	if err != nil {
+		return "", err
+	}
+
+	key, err := s.repo.FindByVersion(ctx, sellerID, version)
+	if err != nil {
+		return "", err
+	}
+	if key == nil {
+		return "", ErrDecryptionFailed
+	}
+
+	gcm, err := s.cipherFor(key)
+	if err != nil {
+		return "", err
+	}
+	nonceSize := gcm.NonceSize()
+	if len(sealed) < nonceSize {
+		return "", ErrInvalidCiphertext
+	}
+	plaintext, err := gcm.Open(nil, sealed[:nonceSize], sealed[nonceSize:], additionalData(key))
+	if err != nil {
+		return "", ErrDecryptionFailed
+	}
+	return string(plaintext), nil
+}
+
+// Reencrypt re-seals a value under the seller's active key
+func (s *ServiceImpl) Reencrypt(
+	ctx context.Context,
+	sellerID uint,
+	ciphertext string,
+) (string, error) {
+	version, _, err := parseCiphertext(ciphertext)
+	if err != nil {
+		return "", err
+	}
+
+	active, err := s.repo.FindActive(ctx, sellerID)
+	if err != nil {
+		return "", err
+	}
+	if active == nil {
+		return "", ErrKeyNotFound
+	}
+	if active.Version == version {
+		return ciphertext, nil
+	}
+
+	plaintext, err := s.Decrypt(ctx, sellerID, ciphertext)
+	if err != nil {
+		return "", err
+	}
+	return s.seal(active, plaintext)
+}
+
+// createKey generates a random data key, wraps it and stores it as the next version
+func (s *ServiceImpl) createKey(ctx context.Context, sellerID uint) (*TenantDataKey, error) {
+	version, err := s.repo.MaxVersion(ctx, sellerID)
+	if err != nil {
+		return nil, err
+	}
+
+	dataKey := make([]byte, constants.TENANT_DATA_KEY_SIZE)
+	if _, err := io.ReadFull(rand.Reader, dataKey); err != nil {
+		return nil, err
+	}
+	wrapped, err := helper.Encrypt(base64.StdEncoding.EncodeToString(dataKey), s.masterKey)
+	if err != nil {
+		return nil, err
+	}
+
+	key := &TenantDataKey{
+		SellerID:   sellerID,
+		Version:    version + 1,
+		WrappedKey: wrapped,
+		Status:     KEY_STATUS_ACTIVE,
+	}
+	if err := s.repo.Create(ctx, key); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+func (s *ServiceImpl) seal(key *TenantDataKey, plaintext string) (string, error) {
+	gcm, err := s.cipherFor(key)
+	if err != nil {
+		return "", err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return "", err
+	}
+	sealed := gcm.Seal(nonce, nonce, []byte(plaintext), additionalData(key))
+	return fmt.Sprintf(
+		"%s:v%d:%s",
+		constants.TENANT_CIPHERTEXT_PREFIX,
+		key.Version,
+		base64.StdEncoding.EncodeToString(sealed),
+	), nil
+}
+
+// cipherFor returns an AES-GCM cipher for the unwrapped data key
+func (s *ServiceImpl) cipherFor(key *TenantDataKey) (cipher.AEAD, error) {
+	var dataKey []byte
+	if cached, ok := s.dataKeys.Load(key.ID); ok {
+		dataKey = cached.([]byte)
+	} else {
+		encoded, err := helper.Decrypt(key.WrappedKey, s.masterKey)
+		if err != nil {
+			return nil, ErrKeyUnwrapFailed
+		}
+		dataKey, err = base64.StdEncoding.DecodeString(encoded)
+		if err != nil || len(dataKey) != constants.TENANT_DATA_KEY_SIZE {
+			return nil, ErrKeyUnwrapFailed
+		}
+		s.dataKeys.Store(key.ID, dataKey)
+	}
+
+	block, err := aes.NewCipher(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// additionalData binds a ciphertext to its seller and key version
+func additionalData(key *TenantDataKey) []byte {
+	return []byte(fmt.Sprintf("seller:%d:v%d", key.SellerID, key.Version))
+}
+
+// parseCiphertext splits tdk:v{version}:{base64} into the version and sealed bytes
+func parseCiphertext(ciphertext string) (int, []byte, error) {
+	parts := strings.SplitN(ciphertext, ":", 3)
+	if len(parts) != 3 || parts[0] != constants.TENANT_CIPHERTEXT_PREFIX ||
+		!strings.HasPrefix(parts[1], "v") {
+		return 0, nil, ErrInvalidCiphertext
+	}
+	version, err := strconv.Atoi(strings.TrimPrefix(parts[1], "v"))
+	if err != nil || version <= 0 {
+		return 0, nil, ErrInvalidCiphertext
+	}
+	sealed, err := base64.StdEncoding.DecodeString(parts[2])
+	if err != nil {
+		return 0, nil, ErrInvalidCiphertext
+	}
+	return version, sealed, nil
+}
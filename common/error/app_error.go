@@ -7,6 +7,7 @@ type AppError struct {
 	Code       string // Error code for client identification
 	Message    string // Human-readable error message
 	StatusCode int    // HTTP status code
+	Details    any    // Optional payload returned as the errors member of the response
 }
 
 // Error implements the error interface
@@ -29,6 +30,7 @@ func (e *AppError) WithMessage(message string) *AppError {
 		Code:       e.Code,
 		Message:    message,
 		StatusCode: e.StatusCode,
+		Details:    e.Details,
 	}
 }
 
@@ -38,6 +40,18 @@ func (e *AppError) WithMessagef(format string, args ...any) *AppError {
 		Code:       e.Code,
 		Message:    fmt.Sprintf(format, args...),
 		StatusCode: e.StatusCode,
+		Details:    e.Details,
+	}
+}
+
+// WithDetails creates a new error carrying details for the client, e.g. the current
+// state of a resource a conflicting write was rejected for
+func (e *AppError) WithDetails(details any) *AppError {
+	return &AppError{
+		Code:       e.Code,
+		Message:    e.Message,
+		StatusCode: e.StatusCode,
+		Details:    details,
 	}
 }
 
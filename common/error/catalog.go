@@ -0,0 +1,112 @@
+package error
+
+import (
+	"net/http"
+	"sort"
+	"sync"
+
+	"ecommerce-be/common/constants"
+)
+
+// Generic codes for errors that carry no code of their own. They are derived from
+// the HTTP status so every error response has a machine-readable code.
+const (
+	BAD_REQUEST_CODE         = "BAD_REQUEST"
+	UNAUTHENTICATED_CODE     = "UNAUTHENTICATED"
+	FORBIDDEN_CODE           = "FORBIDDEN"
+	NOT_FOUND_CODE           = "NOT_FOUND"
+	CONFLICT_CODE            = "CONFLICT"
+	UNPROCESSABLE_CODE       = "UNPROCESSABLE_ENTITY"
+	TOO_MANY_REQUESTS_CODE   = "TOO_MANY_REQUESTS"
+	INTERNAL_ERROR_CODE      = "INTERNAL_ERROR"
+	SERVICE_UNAVAILABLE_CODE = "SERVICE_UNAVAILABLE"
+)
+
+// CatalogEntry describes one error code. Clients branch on Code; Title is the default
+// English message and Status the HTTP status the code is returned with.
+type CatalogEntry struct {
+	Code   string `json:"code"`
+	Title  string `json:"title"`
+	Status int    `json:"status"`
+}
+
+var (
+	catalogMu sync.RWMutex
+	catalog   = map[string]CatalogEntry{}
+)
+
+var statusCodes = map[int]string{
+	http.StatusBadRequest:          BAD_REQUEST_CODE,
+	http.StatusUnauthorized:        UNAUTHENTICATED_CODE,
+	http.StatusForbidden:           FORBIDDEN_CODE,
+	http.StatusNotFound:            NOT_FOUND_CODE,
+	http.StatusConflict:            CONFLICT_CODE,
+	http.StatusUnprocessableEntity: UNPROCESSABLE_CODE,
+	http.StatusTooManyRequests:     TOO_MANY_REQUESTS_CODE,
+	http.StatusInternalServerError: INTERNAL_ERROR_CODE,
+	http.StatusServiceUnavailable:  SERVICE_UNAVAILABLE_CODE,
+}
+
+func init() {
+	for status, code := range statusCodes {
+		registerEntry(CatalogEntry{Code: code, Title: http.StatusText(status), Status: status})
+	}
+}
+
+// Register adds errors to the catalog. Each module registers its errors from its error
+// package; a code keeps the first status and message it was registered with.
+func Register(errs ...*AppError) {
+	for _, err := range errs {
+		if err == nil || err.Code == "" {
+			continue
+		}
+		registerEntry(CatalogEntry{Code: err.Code, Title: err.Message, Status: err.StatusCode})
+	}
+}
+
+func registerEntry(entry CatalogEntry) {
+	catalogMu.Lock()
+	defer catalogMu.Unlock()
+	if _, exists := catalog[entry.Code]; !exists {
+		catalog[entry.Code] = entry
+	}
+}
+
+// Lookup returns the catalog entry of a code
+func Lookup(code string) (CatalogEntry, bool) {
+	catalogMu.RLock()
+	defer catalogMu.RUnlock()
+	entry, ok := catalog[code]
+	return entry, ok
+}
+
+// CatalogEntries returns every registered code, sorted by code
+func CatalogEntries() []CatalogEntry {
+	catalogMu.RLock()
+	entries := make([]CatalogEntry, 0, len(catalog))
+	for _, entry := range catalog {
+		entries = append(entries, entry)
+	}
+	catalogMu.RUnlock()
+
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Code < entries[j].Code })
+	return entries
+}
+
+// CodeForStatus returns the generic code for an HTTP status, falling back to
+// BAD_REQUEST or INTERNAL_ERROR for statuses without one of their own
+func CodeForStatus(status int) string {
+	if code, ok := statusCodes[status]; ok {
+		return code
+	}
+	if status >= http.StatusInternalServerError {
+		return INTERNAL_ERROR_CODE
+	}
+	return BAD_REQUEST_CODE
+}
+
+// ProblemType returns the RFC 7807 problem type URI of a code. It points at the
+// code's entry in the error catalog endpoint.
+func ProblemType(code string) string {
+	return constants.APIErrorCatalog + "/" + code
+}
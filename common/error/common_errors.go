@@ -103,6 +103,20 @@ var (
 		Message:    constants.FILE_NOT_ACCESSIBLE_MSG,
 		StatusCode: http.StatusUnprocessableEntity,
 	}
+
+	// ErrRequestBodyTooLarge is returned when a request body exceeds its route's limit
+	ErrRequestBodyTooLarge = &AppError{
+		Code:       constants.REQUEST_BODY_TOO_LARGE_CODE,
+		Message:    constants.REQUEST_BODY_TOO_LARGE_MSG,
+		StatusCode: http.StatusRequestEntityTooLarge,
+	}
+
+	// ErrRequestTimeout is returned when a request fails after its deadline passed
+	ErrRequestTimeout = &AppError{
+		Code:       constants.REQUEST_TIMEOUT_CODE,
+		Message:    constants.REQUEST_TIMEOUT_MSG,
+		StatusCode: http.StatusGatewayTimeout,
+	}
 )
 
 // DatabaseError returns an AppError for database failures with a caller-specific message.
@@ -113,3 +127,23 @@ func DatabaseError(message string) *AppError {
 		http.StatusInternalServerError,
 	)
 }
+
+func init() {
+	Register(
+		ErrValidation,
+		ErrInvalidID,
+		ErrNoFieldsProvided,
+		ErrInvalidRequestStruct,
+		ErrRoleDataMissing,
+		UnauthorizedError,
+		ErrSellerDataMissing,
+		ErrRequiredQueryParam,
+		ErrInvalidLimit,
+		ErrUserDataMissing,
+		ErrCorrelationIDMissing,
+		ErrFileNotAccessible,
+		ErrRequestBodyTooLarge,
+		ErrRequestTimeout,
+		DatabaseError("Database operation failed"),
+	)
+}
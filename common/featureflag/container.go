@@ -0,0 +1,35 @@
+package featureflag
+
+import (
+	"sync"
+
+	"ecommerce-be/common"
+
+	"github.com/gin-gonic/gin"
+)
+
+var (
+	serviceOnce     sync.Once
+	serviceInstance Service
+)
+
+// GetService returns the shared feature flag service used by IsEnabled
+func GetService() Service {
+	serviceOnce.Do(func() {
+		serviceInstance = NewService(NewFlagRepository())
+	})
+	return serviceInstance
+}
+
+// NewContainer registers the feature flag admin routes
+func NewContainer(router *gin.Engine) *common.Container {
+	c := &common.Container{}
+
+	c.RegisterModule(NewModule(NewHandler(GetService())))
+
+	for _, module := range c.Modules {
+		module.RegisterRoutes(router)
+	}
+
+	return c
+}
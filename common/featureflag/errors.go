@@ -0,0 +1,26 @@
+package featureflag
+
+import (
+	"net/http"
+
+	"ecommerce-be/common/constants"
+	commonError "ecommerce-be/common/error"
+)
+
+var (
+	ErrFlagNotFound = &commonError.AppError{
+		Code:       constants.FEATURE_FLAG_NOT_FOUND_CODE,
+		Message:    constants.FEATURE_FLAG_NOT_FOUND_MSG,
+		StatusCode: http.StatusNotFound,
+	}
+
+	ErrFlagExists = &commonError.AppError{
+		Code:       constants.FEATURE_FLAG_EXISTS_CODE,
+		Message:    constants.FEATURE_FLAG_EXISTS_MSG,
+		StatusCode: http.StatusConflict,
+	}
+)
+
+func init() {
+	commonError.Register(ErrFlagNotFound, ErrFlagExists)
+}
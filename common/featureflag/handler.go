@@ -0,0 +1,167 @@
+package featureflag
+
+import (
+	"net/http"
+
+	"ecommerce-be/common/auth"
+	"ecommerce-be/common/constants"
+	errs "ecommerce-be/common/error"
+	"ecommerce-be/common/handler"
+	"ecommerce-be/common/log"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Handler handles the feature flag admin API
+type Handler struct {
+	*handler.BaseHandler
+	service Service
+}
+
+// NewHandler creates a new instance of Handler
+func NewHandler(service Service) *Handler {
+	return &Handler{
+		BaseHandler: handler.NewBaseHandler(),
+		service:     service,
+	}
+}
+
+// ListFlags handles listing feature flags
+// GET /api/feature-flags
+func (h *Handler) ListFlags(c *gin.Context) {
+	flags, err := h.service.ListFlags(c)
+	if err != nil {
+		log.ErrorWithContext(c, "listFlags: failed", err)
+		h.HandleError(c, err, constants.FAILED_TO_LIST_FEATURE_FLAGS_MSG)
+		return
+	}
+
+	h.SuccessWithData(
+		c,
+		http.StatusOK,
+		constants.FEATURE_FLAGS_LISTED_MSG,
+		constants.FEATURE_FLAGS_FIELD_NAME,
+		flags,
+	)
+}
+
+// GetFlag handles fetching a feature flag
+// GET /api/feature-flags/:key
+func (h *Handler) GetFlag(c *gin.Context) {
+	flag, err := h.service.GetFlag(c, c.Param(constants.FEATURE_FLAG_KEY_PARAM))
+	if err != nil {
+		log.ErrorWithContext(c, "getFlag: failed", err)
+		h.HandleError(c, err, constants.FAILED_TO_FETCH_FEATURE_FLAG_MSG)
+		return
+	}
+
+	h.SuccessWithData(
+		c,
+		http.StatusOK,
+		constants.FEATURE_FLAG_FETCHED_MSG,
+		constants.FEATURE_FLAG_FIELD_NAME,
+		flag,
+	)
+}
+
+// CreateFlag handles creating a feature flag
+// POST /api/feature-flags
+func (h *Handler) CreateFlag(c *gin.Context) {
+	userID, exists := auth.GetUserIDFromContext(c)
+	if !exists {
+		h.HandleError(c, errs.UnauthorizedError, constants.AUTHENTICATION_REQUIRED_MSG)
+		return
+	}
+
+	var req CreateFlagRequest
+	if err := h.BindJSON(c, &req); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	flag, err := h.service.CreateFlag(c, userID, req)
+	if err != nil {
+		log.ErrorWithContext(c, "createFlag: failed", err)
+		h.HandleError(c, err, constants.FAILED_TO_CREATE_FEATURE_FLAG_MSG)
+		return
+	}
+
+	h.SuccessWithData(
+		c,
+		http.StatusCreated,
+		constants.FEATURE_FLAG_CREATED_MSG,
+		constants.FEATURE_FLAG_FIELD_NAME,
+		flag,
+	)
+}
+
+// UpdateFlag handles changing a feature flag
+// PATCH /api/feature-flags/:key
+func (h *Handler) UpdateFlag(c *gin.Context) {
+	userID, exists := auth.GetUserIDFromContext(c)
+	if !exists {
+		h.HandleError(c, errs.UnauthorizedError, constants.AUTHENTICATION_REQUIRED_MSG)
+		return
+	}
+
+	var req UpdateFlagRequest
+	if err := h.BindJSON(c, &req); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	key := c.Param(constants.FEATURE_FLAG_KEY_PARAM)
+	flag, err := h.service.UpdateFlag(c, userID, key, req)
+	if err != nil {
+		log.ErrorWithContext(c, "updateFlag: failed", err)
+		h.HandleError(c, err, constants.FAILED_TO_UPDATE_FEATURE_FLAG_MSG)
+		return
+	}
+
+	h.SuccessWithData(
+		c,
+		http.StatusOK,
+		constants.FEATURE_FLAG_UPDATED_MSG,
+		constants.FEATURE_FLAG_FIELD_NAME,
+		flag,
+	)
+}
+
+// DeleteFlag handles deleting a feature flag
+// DELETE /api/feature-flags/:key
+func (h *Handler) DeleteFlag(c *gin.Context) {
+	if err := h.service.DeleteFlag(c, c.Param(constants.FEATURE_FLAG_KEY_PARAM)); err != nil {
+		log.ErrorWithContext(c, "deleteFlag: failed", err)
+		h.HandleError(c, err, constants.FAILED_TO_DELETE_FEATURE_FLAG_MSG)
+		return
+	}
+
+	h.Success(c, http.StatusOK, constants.FEATURE_FLAG_DELETED_MSG, nil)
+}
+
+// EvaluateFlag handles checking a feature flag for a seller or user
+// GET /api/feature-flags/:key/evaluate
+func (h *Handler) EvaluateFlag(c *gin.Context) {
+	var params EvaluateFlagQueryParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	subject := Subject{SellerID: params.SellerID, UserID: params.UserID}
+	key := c.Param(constants.FEATURE_FLAG_KEY_PARAM)
+	evaluation, err := h.service.EvaluateFlag(c, key, subject)
+	if err != nil {
+		log.ErrorWithContext(c, "evaluateFlag: failed", err)
+		h.HandleError(c, err, constants.FAILED_TO_EVALUATE_FEATURE_FLAG_MSG)
+		return
+	}
+
+	h.SuccessWithData(
+		c,
+		http.StatusOK,
+		constants.FEATURE_FLAG_EVALUATED_MSG,
+		constants.FEATURE_FLAG_EVALUATION_FIELD_NAME,
+		evaluation,
+	)
+}
@@ -0,0 +1,77 @@
+package featureflag
+
+import (
+	"ecommerce-be/common/db"
+)
+
+// FeatureFlag gates a feature during its rollout. A disabled flag is off for
+// everyone. An enabled flag is on for its targeted sellers and users, and for
+// RolloutPercent of everyone else; at 100 it is a plain on switch.
+type FeatureFlag struct {
+	db.BaseEntity
+	Key             string        `json:"key"             gorm:"column:flag_key;size:100;not null"`
+	Description     string        `json:"description"     gorm:"column:description;size:500;not null"`
+	Enabled         bool          `json:"enabled"         gorm:"column:enabled;not null"`
+	RolloutPercent  int           `json:"rolloutPercent"  gorm:"column:rollout_percent;not null"`
+	SellerIDs       db.Int64Array `json:"sellerIds"       gorm:"column:seller_ids;type:bigint[]"`
+	UserIDs         db.Int64Array `json:"userIds"         gorm:"column:user_ids;type:bigint[]"`
+	UpdatedByUserID *uint         `json:"updatedByUserId" gorm:"column:updated_by_user_id"`
+}
+
+func (FeatureFlag) TableName() string {
+	return "feature_flag"
+}
+
+// ========================================
+// REQUEST MODELS
+// ========================================
+
+// CreateFlagRequest - Adds a flag, disabled unless Enabled is set
+type CreateFlagRequest struct {
+	Key            string `json:"key"            binding:"required,max=100,sku"`
+	Description    string `json:"description"    binding:"max=500"`
+	Enabled        bool   `json:"enabled"`
+	RolloutPercent int    `json:"rolloutPercent" binding:"min=0,max=100"`
+	SellerIDs      []uint `json:"sellerIds"      binding:"omitempty,max=1000,dive,min=1"`
+	UserIDs        []uint `json:"userIds"        binding:"omitempty,max=1000,dive,min=1"`
+}
+
+// UpdateFlagRequest - Changes the given fields of a flag; the target lists are
+// replaced as a whole
+type UpdateFlagRequest struct {
+	Description    *string `json:"description"    binding:"omitempty,max=500"`
+	Enabled        *bool   `json:"enabled"`
+	RolloutPercent *int    `json:"rolloutPercent" binding:"omitempty,min=0,max=100"`
+	SellerIDs      *[]uint `json:"sellerIds"      binding:"omitempty,max=1000,dive,min=1"`
+	UserIDs        *[]uint `json:"userIds"        binding:"omitempty,max=1000,dive,min=1"`
+}
+
+// EvaluateFlagQueryParams - The subject to evaluate a flag for
+type EvaluateFlagQueryParams struct {
+	SellerID uint `form:"sellerId"`
+	UserID   uint `form:"userId"`
+}
+
+// ========================================
+// RESPONSE MODELS
+// ========================================
+
+// EvaluationResponse is a flag's result for a subject and why
+type EvaluationResponse struct {
+	Key     string `json:"key"`
+	Enabled bool   `json:"enabled"`
+	Reason  Reason `json:"reason"`
+}
+
+// ========================================
+// HELPERS
+// ========================================
+
+// toInt64Array converts request IDs to the stored array
+func toInt64Array(ids []uint) db.Int64Array {
+	array := make(db.Int64Array, 0, len(ids))
+	for _, id := range ids {
+		array = append(array, int64(id))
+	}
+	return array
+}
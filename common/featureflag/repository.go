@@ -0,0 +1,80 @@
+package featureflag
+
+import (
+	"context"
+	"errors"
+
+	"ecommerce-be/common/db"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// FlagRepository defines database operations for feature flags
+type FlagRepository interface {
+	Create(ctx context.Context, flag *FeatureFlag) error
+	Save(ctx context.Context, flag *FeatureFlag) error
+	Delete(ctx context.Context, flag *FeatureFlag) error
+	// FindByKey returns the flag, or nil when it does not exist
+	FindByKey(ctx context.Context, key string) (*FeatureFlag, error)
+	// FindByKeyForUpdate locks the flag so concurrent updates serialize
+	FindByKeyForUpdate(ctx context.Context, key string) (*FeatureFlag, error)
+	FindAll(ctx context.Context) ([]FeatureFlag, error)
+}
+
+// FlagRepositoryImpl implements FlagRepository
+type FlagRepositoryImpl struct{}
+
+// NewFlagRepository creates a new instance of FlagRepository
+func NewFlagRepository() FlagRepository {
+	return &FlagRepositoryImpl{}
+}
+
+// Create persists a new flag
+func (r *FlagRepositoryImpl) Create(ctx context.Context, flag *FeatureFlag) error {
+	return db.DB(ctx).Create(flag).Error
+}
+
+// Save writes every column of the flag
+func (r *FlagRepositoryImpl) Save(ctx context.Context, flag *FeatureFlag) error {
+	return db.DB(ctx).Save(flag).Error
+}
+
+// Delete removes the flag
+func (r *FlagRepositoryImpl) Delete(ctx context.Context, flag *FeatureFlag) error {
+	return db.DB(ctx).Delete(flag).Error
+}
+
+// FindByKey returns the flag with the key
+func (r *FlagRepositoryImpl) FindByKey(ctx context.Context, key string) (*FeatureFlag, error) {
+	return findFlag(db.DB(ctx).Where("flag_key = ?", key))
+}
+
+// FindByKeyForUpdate returns the flag with the key with a row lock
+func (r *FlagRepositoryImpl) FindByKeyForUpdate(
+	ctx context.Context,
+	key string,
+) (*FeatureFlag, error) {
+	return findFlag(db.DB(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("flag_key = ?", key))
+}
+
+// FindAll returns every flag ordered by key
+func (r *FlagRepositoryImpl) FindAll(ctx context.Context) ([]FeatureFlag, error) {
+	var flags []FeatureFlag
+	err := db.DB(ctx).Order("flag_key").Find(&flags).Error
+	return flags, err
+}
+
+func findFlag(query *gorm.DB) (*FeatureFlag, error) {
+	var flag FeatureFlag
+	err := query.First(&flag).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &flag, nil
+}
@@ -0,0 +1,62 @@
+package featureflag
+
+import (
+	"net/http"
+
+	"ecommerce-be/common/constants"
+	"ecommerce-be/common/middleware"
+	"ecommerce-be/common/openapi"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Module registers the feature flag admin routes
+type Module struct {
+	handler *Handler
+}
+
+// NewModule creates a new instance of Module
+func NewModule(handler *Handler) *Module {
+	return &Module{handler: handler}
+}
+
+// RegisterRoutes registers feature flag routes (admin only)
+func (m *Module) RegisterRoutes(router *gin.Engine) {
+	flagRoutes := openapi.NewGroup(router.Group(constants.APIBaseFeatureFlags), "Feature Flags")
+	flagRoutes.Use(middleware.AdminAuth())
+	{
+		// GET /api/feature-flags - All flags, ordered by key
+		flagRoutes.GET("", m.handler.ListFlags).
+			Summary("List feature flags").
+			ReturnsField(http.StatusOK, "flags", []FeatureFlag{})
+
+		// POST /api/feature-flags - Add a flag
+		flagRoutes.POST("", m.handler.CreateFlag).
+			Summary("Create a feature flag").
+			Body(CreateFlagRequest{}).
+			ReturnsField(http.StatusCreated, "flag", FeatureFlag{})
+
+		// GET /api/feature-flags/:key - One flag
+		flagRoutes.GET("/:key", m.handler.GetFlag).
+			Summary("Get a feature flag").
+			ReturnsField(http.StatusOK, "flag", FeatureFlag{})
+
+		// PATCH /api/feature-flags/:key - Toggle, re-target or change the rollout
+		flagRoutes.PATCH("/:key", m.handler.UpdateFlag).
+			Summary("Update a feature flag").
+			Description("Only the fields sent are changed. Changes apply within seconds.").
+			Body(UpdateFlagRequest{}).
+			ReturnsField(http.StatusOK, "flag", FeatureFlag{})
+
+		// DELETE /api/feature-flags/:key - Remove a flag; checks of it then return off
+		flagRoutes.DELETE("/:key", m.handler.DeleteFlag).
+			Summary("Delete a feature flag")
+
+		// GET /api/feature-flags/:key/evaluate - Result for a seller or user, and why
+		// Query params: ?sellerId=1&userId=2
+		flagRoutes.GET("/:key/evaluate", m.handler.EvaluateFlag).
+			Summary("Evaluate a feature flag").
+			Query(EvaluateFlagQueryParams{}).
+			ReturnsField(http.StatusOK, "evaluation", EvaluationResponse{})
+	}
+}
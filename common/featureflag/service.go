@@ -0,0 +1,242 @@
+package featureflag
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"ecommerce-be/common/cache"
+	"ecommerce-be/common/constants"
+	"ecommerce-be/common/db"
+	"ecommerce-be/common/log"
+)
+
+// Service manages feature flags and evaluates them for sellers and users
+type Service interface {
+	// IsEnabled reports whether the flag is on for subject. Unknown flags, and flags
+	// that cannot be loaded, are off.
+	IsEnabled(ctx context.Context, key string, subject Subject) bool
+
+	ListFlags(ctx context.Context) ([]FeatureFlag, error)
+	GetFlag(ctx context.Context, key string) (*FeatureFlag, error)
+	CreateFlag(ctx context.Context, userID uint, req CreateFlagRequest) (*FeatureFlag, error)
+	UpdateFlag(
+		ctx context.Context,
+		userID uint,
+		key string,
+		req UpdateFlagRequest,
+	) (*FeatureFlag, error)
+	DeleteFlag(ctx context.Context, key string) error
+	EvaluateFlag(ctx context.Context, key string, subject Subject) (*EvaluationResponse, error)
+}
+
+// ServiceImpl implements Service
+type ServiceImpl struct {
+	repo FlagRepository
+}
+
+// NewService creates a new instance of Service
+func NewService(repo FlagRepository) Service {
+	return &ServiceImpl{repo: repo}
+}
+
+// IsEnabled evaluates the flag from the cached flag set
+func (s *ServiceImpl) IsEnabled(ctx context.Context, key string, subject Subject) bool {
+	flags, err := s.cachedFlags(ctx)
+	if err != nil {
+		log.ErrorWithContext(ctx, "featureflag: failed to load flags, treating "+key+" as off", err)
+		return false
+	}
+	flag, ok := flags[normalizeKey(key)]
+	if !ok {
+		return false
+	}
+	enabled, _ := Evaluate(&flag, subject)
+	return enabled
+}
+
+// ListFlags returns every flag ordered by key
+func (s *ServiceImpl) ListFlags(ctx context.Context) ([]FeatureFlag, error) {
+	flags, err := s.repo.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if flags == nil {
+		flags = []FeatureFlag{}
+	}
+	return flags, nil
+}
+
+// GetFlag returns the flag with the key
+func (s *ServiceImpl) GetFlag(ctx context.Context, key string) (*FeatureFlag, error) {
+	flag, err := s.repo.FindByKey(ctx, normalizeKey(key))
+	if err != nil {
+		return nil, err
+	}
+	if flag == nil {
+		return nil, ErrFlagNotFound
+	}
+	return flag, nil
+}
+
+// CreateFlag adds a flag
+func (s *ServiceImpl) CreateFlag(
+	ctx context.Context,
+	userID uint,
+	req CreateFlagRequest,
+) (*FeatureFlag, error) {
+	flag := &FeatureFlag{
+		Key:             normalizeKey(req.Key),
+		Description:     req.Description,
+		Enabled:         req.Enabled,
+		RolloutPercent:  req.RolloutPercent,
+		SellerIDs:       toInt64Array(req.SellerIDs),
+		UserIDs:         toInt64Array(req.UserIDs),
+		UpdatedByUserID: &userID,
+	}
+
+	err := db.WithTransaction(ctx, func(txCtx context.Context) error {
+		existing, err := s.repo.FindByKey(txCtx, flag.Key)
+		if err != nil {
+			return err
+		}
+		if existing != nil {
+			return ErrFlagExists
+		}
+		if err := s.repo.Create(txCtx, flag); err != nil {
+			return err
+		}
+		invalidateFlags(txCtx)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.InfoWithContext(ctx, "featureflag: created "+flag.Key)
+	return flag, nil
+}
+
+// UpdateFlag changes the given fields of a flag
+func (s *ServiceImpl) UpdateFlag(
+	ctx context.Context,
+	userID uint,
+	key string,
+	req UpdateFlagRequest,
+) (*FeatureFlag, error) {
+	var flag *FeatureFlag
+	err := db.WithTransaction(ctx, func(txCtx context.Context) error {
+		var err error
+		flag, err = s.repo.FindByKeyForUpdate(txCtx, normalizeKey(key))
+		if err != nil {
+			return err
+		}
+		if flag == nil {
+			return ErrFlagNotFound
+		}
+
+		if req.Description != nil {
+			flag.Description = *req.Description
+		}
+		if req.Enabled != nil {
+			flag.Enabled = *req.Enabled
+		}
+		if req.RolloutPercent != nil {
+			flag.RolloutPercent = *req.RolloutPercent
+		}
+		if req.SellerIDs != nil {
+			flag.SellerIDs = toInt64Array(*req.SellerIDs)
+		}
+		if req.UserIDs != nil {
+			flag.UserIDs = toInt64Array(*req.UserIDs)
+		}
+		flag.UpdatedByUserID = &userID
+
+		if err := s.repo.Save(txCtx, flag); err != nil {
+			return err
+		}
+		invalidateFlags(txCtx)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.InfoWithContext(ctx, "featureflag: updated "+flag.Key)
+	return flag, nil
+}
+
+// DeleteFlag removes a flag; code still checking it sees it as off
+func (s *ServiceImpl) DeleteFlag(ctx context.Context, key string) error {
+	err := db.WithTransaction(ctx, func(txCtx context.Context) error {
+		flag, err := s.repo.FindByKeyForUpdate(txCtx, normalizeKey(key))
+		if err != nil {
+			return err
+		}
+		if flag == nil {
+			return ErrFlagNotFound
+		}
+		if err := s.repo.Delete(txCtx, flag); err != nil {
+			return err
+		}
+		invalidateFlags(txCtx)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	log.InfoWithContext(ctx, "featureflag: deleted "+normalizeKey(key))
+	return nil
+}
+
+// EvaluateFlag evaluates the stored flag for subject and explains the result
+func (s *ServiceImpl) EvaluateFlag(
+	ctx context.Context,
+	key string,
+	subject Subject,
+) (*EvaluationResponse, error) {
+	flag, err := s.GetFlag(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	enabled, reason := Evaluate(flag, subject)
+	return &EvaluationResponse{Key: flag.Key, Enabled: enabled, Reason: reason}, nil
+}
+
+// cachedFlags returns every flag by key. The set is small and read on hot paths, so
+// it is cached whole, in memory and in Redis, under a version that writes bump.
+func (s *ServiceImpl) cachedFlags(ctx context.Context) (map[string]FeatureFlag, error) {
+	return cache.GetOrLoad(
+		ctx,
+		constants.FEATURE_FLAG_CACHE_NAMESPACE,
+		cache.VersionedKey(constants.FEATURE_FLAG_CACHE_NAMESPACE, "all"),
+		constants.FEATURE_FLAG_CACHE_TTL*time.Second,
+		func(ctx context.Context) (map[string]FeatureFlag, error) {
+			flags, err := s.repo.FindAll(ctx)
+			if err != nil {
+				return nil, err
+			}
+			byKey := make(map[string]FeatureFlag, len(flags))
+			for _, flag := range flags {
+				byKey[flag.Key] = flag
+			}
+			return byKey, nil
+		},
+		cache.WithLocal(constants.FEATURE_FLAG_LOCAL_CACHE_TTL*time.Second),
+	)
+}
+
+// invalidateFlags moves the cached flag set to a new version once the write commits
+func invalidateFlags(ctx context.Context) {
+	db.AfterCommit(ctx, func() {
+		if err := cache.BumpNamespaceVersion(constants.FEATURE_FLAG_CACHE_NAMESPACE); err != nil {
+			log.WarnWithContext(ctx, "featureflag: failed to invalidate cached flags: "+err.Error())
+		}
+	})
+}
+
+// normalizeKey makes flag keys case-insensitive
+func normalizeKey(key string) string {
+	return strings.ToLower(strings.TrimSpace(key))
+}
@@ -32,3 +32,17 @@ type FileLifecycleGateway interface {
 	// treat errors from this method as best-effort degradation.
 	DeleteFile(ctx context.Context, fileID string, sellerID *uint) error
 }
+
+// FileUploadGateway extends FileLifecycleGateway with the upload flow for modules
+// that let their users upload owned media (e.g. avatars, store logos). Size and
+// format policy for the purpose is enforced by the file module.
+type FileUploadGateway interface {
+	FileLifecycleGateway
+
+	// InitUpload registers the file and returns a presigned upload URL.
+	InitUpload(ctx context.Context, req UploadRequest) (*UploadTicket, error)
+
+	// CompleteUpload verifies the uploaded bytes and activates the file. Completing an
+	// already active file returns it unchanged.
+	CompleteUpload(ctx context.Context, fileID string, sellerID *uint) (*FileDisplayInfo, error)
+}
@@ -5,15 +5,51 @@ package filegateway
 type FileDisplayInfo struct {
 	FileID       string
 	Status       string
+	Purpose      string
+	MimeType     string
+	SizeBytes    int64
 	URL          string
 	ThumbnailURL *string
 }
 
+// ImageCrop is a crop rectangle in source image pixels, applied by clients or the
+// image CDN when rendering the asset.
+type ImageCrop struct {
+	X      int `json:"x"      binding:"min=0"`
+	Y      int `json:"y"      binding:"min=0"`
+	Width  int `json:"width"  binding:"required,min=1"`
+	Height int `json:"height" binding:"required,min=1"`
+}
+
+// UploadRequest starts an upload on behalf of a module that owns the resulting media.
+// SellerID scopes the file; nil uploads it as a platform file.
+type UploadRequest struct {
+	Purpose        string
+	Filename       string
+	MimeType       string
+	SizeBytes      int64
+	UploaderUserID uint
+	SellerID       *uint
+	// Public files are served through the media CDN when one is configured
+	Public bool
+}
+
+// UploadTicket tells the client where to PUT the file bytes.
+type UploadTicket struct {
+	FileID        string            `json:"fileId"`
+	UploadURL     string            `json:"uploadUrl"`
+	UploadMethod  string            `json:"uploadMethod"`
+	UploadHeaders map[string]string `json:"uploadHeaders"`
+	ExpiresAt     string            `json:"expiresAt"`
+}
+
 // FileAssetResponse is the API response shape for a resolved file reference.
 type FileAssetResponse struct {
 	FileID       string  `json:"fileId"`
 	URL          string  `json:"url"`
 	ThumbnailURL *string `json:"thumbnailUrl,omitempty"`
+	// Crop is set for images the owner cropped, e.g. avatars and store logos
+	Crop *ImageCrop `json:"crop,omitempty"`
 }
 
 // ToFileAssetResponse converts internal display info to an API response DTO.
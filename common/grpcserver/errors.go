@@ -0,0 +1,94 @@
+package grpcserver
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"ecommerce-be/common/constants"
+	commonError "ecommerce-be/common/error"
+	"ecommerce-be/common/log"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// ToStatusError converts service errors into gRPC status errors.
+// AppErrors keep their message and map their HTTP status to the closest gRPC code;
+// any other error is reported as Internal without leaking details.
+func ToStatusError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	if appErr, ok := commonError.AsAppError(err); ok {
+		return status.Error(CodeFromHTTPStatus(appErr.StatusCode), appErr.Message)
+	}
+	switch {
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, err.Error())
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	}
+	return status.Error(codes.Internal, constants.GRPC_INTERNAL_ERROR_MSG)
+}
+
+// CodeFromHTTPStatus maps an HTTP status code to the equivalent gRPC code
+func CodeFromHTTPStatus(httpStatus int) codes.Code {
+	switch httpStatus {
+	case http.StatusBadRequest, http.StatusUnprocessableEntity:
+		return codes.InvalidArgument
+	case http.StatusUnauthorized:
+		return codes.Unauthenticated
+	case http.StatusForbidden:
+		return codes.PermissionDenied
+	case http.StatusNotFound:
+		return codes.NotFound
+	case http.StatusConflict:
+		return codes.AlreadyExists
+	case http.StatusPreconditionFailed:
+		return codes.FailedPrecondition
+	case http.StatusTooManyRequests:
+		return codes.ResourceExhausted
+	case http.StatusNotImplemented:
+		return codes.Unimplemented
+	case http.StatusServiceUnavailable:
+		return codes.Unavailable
+	case http.StatusGatewayTimeout:
+		return codes.DeadlineExceeded
+	}
+	if httpStatus >= 200 && httpStatus < 300 {
+		return codes.OK
+	}
+	return codes.Internal
+}
+
+// ErrorInterceptor converts handler errors into status errors and exposes the AppError
+// code in the x-error-code trailer, matching the REST error body's code field
+func ErrorInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req any,
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		resp, err := handler(ctx, req)
+		if err == nil {
+			return resp, nil
+		}
+
+		if appErr, ok := commonError.AsAppError(err); ok && appErr.Code != "" {
+			_ = grpc.SetTrailer(
+				ctx,
+				metadata.Pairs(constants.GRPC_ERROR_CODE_METADATA, appErr.Code),
+			)
+		} else if _, isStatus := status.FromError(err); !isStatus {
+			log.ErrorWithContext(ctx, "gRPC: unexpected error in "+info.FullMethod, err)
+		}
+		return nil, ToStatusError(err)
+	}
+}
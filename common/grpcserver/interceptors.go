@@ -0,0 +1,257 @@
+package grpcserver
+
+import (
+	"context"
+	"net/http"
+	"runtime/debug"
+	"strconv"
+	"strings"
+	"time"
+
+	"ecommerce-be/common/auth"
+	"ecommerce-be/common/cache"
+	"ecommerce-be/common/config"
+	"ecommerce-be/common/constants"
+	"ecommerce-be/common/db"
+	commonError "ecommerce-be/common/error"
+	"ecommerce-be/common/log"
+	"ecommerce-be/common/reqctx"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// RecoveryInterceptor converts handler panics into Internal errors
+func RecoveryInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req any,
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (resp any, err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				log.WithContext(ctx).WithFields(logrus.Fields{
+					"method": info.FullMethod,
+					"panic":  r,
+					"stack":  string(debug.Stack()),
+				}).Error("gRPC handler panicked")
+				resp, err = nil, status.Error(codes.Internal, constants.GRPC_INTERNAL_ERROR_MSG)
+			}
+		}()
+		return handler(ctx, req)
+	}
+}
+
+// CorrelationIDInterceptor requires an x-correlation-id metadata entry on every call,
+// stores it in the context and echoes it back in the response header
+func CorrelationIDInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req any,
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		correlationID, present := metadataValue(ctx, constants.GRPC_CORRELATION_ID_METADATA)
+		if !present || correlationID == "" {
+			return nil, reject(
+				ctx,
+				commonError.NewAppError(
+					constants.CORRELATION_ID_REQUIRED_CODE,
+					constants.CORRELATION_ID_REQUIRED_MSG,
+					http.StatusBadRequest,
+				),
+			)
+		}
+
+		correlationID = strings.TrimSpace(correlationID)
+		if len(correlationID) == 0 || len(correlationID) > 100 {
+			return nil, reject(
+				ctx,
+				commonError.NewAppError(
+					constants.CORRELATION_ID_INVALID_CODE,
+					constants.CORRELATION_ID_INVALID_MSG,
+					http.StatusBadRequest,
+				),
+			)
+		}
+
+		ctx = reqctx.CorrelationID.With(ctx, correlationID)
+		_ = grpc.SetHeader(
+			ctx,
+			metadata.Pairs(constants.GRPC_CORRELATION_ID_METADATA, correlationID),
+		)
+		return handler(ctx, req)
+	}
+}
+
+// LoggerInterceptor logs every call with its method, status code and duration
+func LoggerInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req any,
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		startTime := time.Now()
+		resp, err := handler(ctx, req)
+
+		log.WithContext(ctx).WithFields(logrus.Fields{
+			"method":   info.FullMethod,
+			"code":     status.Code(err).String(),
+			"duration": time.Since(startTime).Milliseconds(),
+		}).Info("gRPC request processed")
+		return resp, err
+	}
+}
+
+// AuthInterceptor resolves the caller's identity the same way PublicAPIAuth does for REST:
+// a Bearer JWT in the authorization metadata is optional, and without one the
+// x-seller-id metadata is mandatory. Resolved sellers are validated for access.
+// Delegated access tokens are scoped to REST routes and are rejected.
+func AuthInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req any,
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		ctx, err := authenticate(ctx)
+		if err != nil {
+			return nil, reject(ctx, err)
+		}
+
+		roleLevel, hasRole := auth.GetUserRoleLevelFromContext(ctx)
+		isAdmin := hasRole && roleLevel == constants.ADMIN_ROLE_LEVEL
+
+		sellerID, hasSellerID := auth.GetSellerIDFromContext(ctx)
+		if !hasSellerID {
+			// Admins may operate across sellers without picking one
+			if _, present := metadataValue(ctx, constants.GRPC_SELLER_ID_METADATA); isAdmin &&
+				!present {
+				return handler(ctx, req)
+			}
+			sellerID, err = sellerIDFromMetadata(ctx)
+			if err != nil {
+				return nil, reject(ctx, err)
+			}
+			ctx = reqctx.SellerID.With(ctx, sellerID)
+		}
+
+		if !isAdmin {
+			sellerData, validationErr := auth.ValidateSellerCompleteCached(db.GetDB(), sellerID)
+			if validationErr == nil {
+				validationErr = sellerData.ValidateForAccess()
+			}
+			if validationErr != nil {
+				return nil, reject(ctx, commonError.NewAppError(
+					constants.INVALID_SELLER_CODE,
+					validationErr.Error(),
+					http.StatusForbidden,
+				))
+			}
+		}
+		return handler(ctx, req)
+	}
+}
+
+// authenticate parses the optional Bearer token and stores its claims in the context
+func authenticate(ctx context.Context) (context.Context, error) {
+	authHeader, present := metadataValue(ctx, constants.GRPC_AUTHORIZATION_METADATA)
+	if !present || authHeader == "" {
+		return ctx, nil
+	}
+
+	parts := strings.SplitN(authHeader, " ", 2)
+	if len(parts) != 2 || parts[0] != constants.BEARER_PREFIX {
+		return ctx, commonError.NewAppError(
+			constants.INVALID_AUTH_FORMAT_CODE,
+			constants.INVALID_AUTH_FORMAT_MSG,
+			http.StatusUnauthorized,
+		)
+	}
+
+	tokenString := parts[1]
+	if auth.IsDelegatedToken(tokenString) {
+		return ctx, commonError.NewAppError(
+			constants.TOKEN_INVALID_CODE,
+			constants.GRPC_DELEGATED_TOKEN_UNSUPPORTED_MSG,
+			http.StatusUnauthorized,
+		)
+	}
+
+	if cache.IsTokenBlacklisted(tokenString) {
+		return ctx, commonError.NewAppError(
+			constants.TOKEN_REVOKED_CODE,
+			constants.TOKEN_REVOKED_MSG,
+			http.StatusUnauthorized,
+		)
+	}
+
+	claims, err := auth.ParseToken(tokenString, config.Get().Auth.JWTSecret)
+	if err != nil || claims.UserID == nil || claims.Email == nil || *claims.Email == "" ||
+		claims.RoleID == nil || claims.RoleName == nil || *claims.RoleName == "" ||
+		claims.RoleLevel == nil {
+		return ctx, commonError.NewAppError(
+			constants.TOKEN_INVALID_CODE,
+			constants.TOKEN_INVALID_MSG,
+			http.StatusUnauthorized,
+		)
+	}
+
+	return reqctx.WithIdentity(ctx, reqctx.Identity{
+		UserID:    *claims.UserID,
+		Email:     *claims.Email,
+		RoleID:    *claims.RoleID,
+		RoleName:  *claims.RoleName,
+		RoleLevel: *claims.RoleLevel,
+		SellerID:  claims.SellerID,
+	}), nil
+}
+
+// sellerIDFromMetadata parses the mandatory x-seller-id metadata entry
+func sellerIDFromMetadata(ctx context.Context) (uint, error) {
+	raw, _ := metadataValue(ctx, constants.GRPC_SELLER_ID_METADATA)
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return 0, commonError.NewAppError(
+			constants.SELLER_ID_REQUIRED_CODE,
+			constants.SELLER_ID_REQUIRED_MSG,
+			http.StatusBadRequest,
+		)
+	}
+
+	sellerID, err := strconv.ParseUint(raw, 10, 32)
+	if err != nil || sellerID == 0 {
+		return 0, commonError.NewAppError(
+			constants.SELLER_ID_INVALID_CODE,
+			constants.SELLER_ID_INVALID_MSG,
+			http.StatusBadRequest,
+		)
+	}
+	return uint(sellerID), nil
+}
+
+// metadataValue returns the first incoming metadata value for key
+func metadataValue(ctx context.Context, key string) (string, bool) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", false
+	}
+	values := md.Get(key)
+	if len(values) == 0 {
+		return "", false
+	}
+	return values[0], true
+}
+
+// reject reports an interceptor failure with the same trailer as ErrorInterceptor
+func reject(ctx context.Context, err error) error {
+	if appErr, ok := commonError.AsAppError(err); ok && appErr.Code != "" {
+		_ = grpc.SetTrailer(ctx, metadata.Pairs(constants.GRPC_ERROR_CODE_METADATA, appErr.Code))
+	}
+	return ToStatusError(err)
+}
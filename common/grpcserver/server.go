@@ -0,0 +1,51 @@
+// Package grpcserver hosts the internal gRPC API alongside the REST router.
+// Modules register their services on the server and share their existing service layer.
+package grpcserver
+
+import (
+	"context"
+	"net"
+
+	"google.golang.org/grpc"
+)
+
+// New creates a gRPC server with the interceptor chain mirroring the REST middleware:
+// panic recovery, mandatory correlation ID, request logging, authentication and
+// AppError to status mapping.
+func New(opts ...grpc.ServerOption) *grpc.Server {
+	opts = append([]grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(
+			RecoveryInterceptor(),
+			CorrelationIDInterceptor(),
+			LoggerInterceptor(),
+			AuthInterceptor(),
+			ErrorInterceptor(),
+		),
+	}, opts...)
+	return grpc.NewServer(opts...)
+}
+
+// Serve listens on addr and serves until the server is stopped
+func Serve(server *grpc.Server, addr string) error {
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+	return server.Serve(listener)
+}
+
+// Shutdown stops accepting calls and waits for in-flight calls to finish.
+// Remaining calls are cancelled when ctx expires.
+func Shutdown(ctx context.Context, server *grpc.Server) {
+	done := make(chan struct{})
+	go func() {
+		server.GracefulStop()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-ctx.Done():
+		server.Stop()
+	}
+}
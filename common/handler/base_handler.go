@@ -1,6 +1,8 @@
 package handler
 
 import (
+	"context"
+	"errors"
 	"net/http"
 	"strconv"
 	"strings"
@@ -8,7 +10,9 @@ import (
 	"ecommerce-be/common"
 	"ecommerce-be/common/constants"
 	commonError "ecommerce-be/common/error"
+	"ecommerce-be/common/i18n"
 	"ecommerce-be/common/log"
+	commonValidator "ecommerce-be/common/validator"
 
 	"github.com/gin-gonic/gin"
 	"github.com/go-playground/validator/v10"
@@ -25,8 +29,26 @@ func NewBaseHandler() *BaseHandler {
 // HandleError centralizes error handling logic
 // It checks if the error is an AppError and responds accordingly
 func (h *BaseHandler) HandleError(c *gin.Context, err error, defaultMessage string) {
+	// Whatever the error says, a request past its deadline failed because of it
+	if errors.Is(c.Request.Context().Err(), context.DeadlineExceeded) {
+		log.WarnWithContext(c, "Request deadline exceeded: "+err.Error())
+		timeoutErr := commonError.ErrRequestTimeout
+		common.ErrorWithCode(c, timeoutErr.StatusCode, timeoutErr.Message, timeoutErr.Code)
+		return
+	}
+
 	// Check if it's our custom AppError
 	if appErr, ok := commonError.AsAppError(err); ok {
+		if appErr.Details != nil {
+			common.ErrorWithDetails(
+				c,
+				appErr.StatusCode,
+				appErr.Message,
+				appErr.Code,
+				appErr.Details,
+			)
+			return
+		}
 		common.ErrorWithCode(
 			c,
 			appErr.StatusCode,
@@ -41,91 +63,97 @@ func (h *BaseHandler) HandleError(c *gin.Context, err error, defaultMessage stri
 	common.ErrorResp(
 		c,
 		http.StatusInternalServerError,
-		defaultMessage+": "+err.Error(),
+		i18n.Localize(c, defaultMessage)+": "+err.Error(),
 	)
 }
 
 // HandleValidationError handles JSON binding validation errors
-// It extracts field-specific validation errors from Gin's validator
+// It responds with one entry per failed field, carrying the field's JSON path, the
+// failed rule and its parameter, and a message from the message catalog
 func (h *BaseHandler) HandleValidationError(c *gin.Context, err error) {
-	var validationErrors []common.ValidationError
-
-	// Check if it's a validator.ValidationErrors type
-	if validationErrs, ok := err.(validator.ValidationErrors); ok {
-		for _, fieldErr := range validationErrs {
-			// Convert field name to JSON tag name (camelCase)
-			fieldName := fieldErr.Field()
-
-			// Get custom error message based on the validation tag
-			message := getValidationErrorMessage(fieldErr)
-
-			validationErrors = append(validationErrors, common.ValidationError{
-				Field:   fieldName,
-				Message: message,
-			})
-		}
-	} else {
-		// Fallback for other binding errors (e.g., JSON syntax errors)
-		validationErrors = []common.ValidationError{
-			{
-				Field:   constants.REQUEST_FIELD_NAME,
-				Message: err.Error(),
-			},
-		}
+	// A body cut off by its route's size limit is not a validation failure
+	var maxBytesErr *http.MaxBytesError
+	if errors.As(err, &maxBytesErr) {
+		h.HandleError(c, commonError.ErrRequestBodyTooLarge, constants.REQUEST_BODY_TOO_LARGE_MSG)
+		return
 	}
-
 	common.ErrorWithValidation(
 		c,
 		http.StatusBadRequest,
 		constants.VALIDATION_FAILED_MSG,
-		validationErrors,
+		BuildValidationErrors(c, err),
 		constants.VALIDATION_ERROR_CODE,
 	)
 }
 
-// getValidationErrorMessage returns a user-friendly error message based on the validation tag
-func getValidationErrorMessage(fieldErr validator.FieldError) string {
-	field := fieldErr.Field()
-	tag := fieldErr.Tag()
+// getValidationErrorMessage returns a user-friendly error message based on the validation tag,
+// localized into the request language
+func getValidationErrorMessage(c *gin.Context, fieldErr validator.FieldError) string {
 	param := fieldErr.Param()
+	if fieldErr.Tag() == "oneof" {
+		param = strings.ReplaceAll(param, " ", ", ")
+	}
+	code, fallback := validationMessageTemplate(fieldErr)
+	return i18n.Format(c, code, fallback, map[string]string{
+		"field": fieldPath(fieldErr),
+		"param": param,
+	})
+}
+
+// validationMessageTemplate maps a validation tag to its catalog code and English template
+func validationMessageTemplate(fieldErr validator.FieldError) (string, string) {
+	isString := fieldErr.Type().String() == "string"
 
-	switch tag {
+	switch fieldErr.Tag() {
 	case "required":
-		return field + " is required"
+		return "VALIDATION_REQUIRED", "{field} is required"
 	case "min":
-		if fieldErr.Type().String() == "string" {
-			return field + " must be at least " + param + " characters long"
+		if isString {
+			return "VALIDATION_MIN_LENGTH", "{field} must be at least {param} characters long"
 		}
-		return field + " must be at least " + param
+		return "VALIDATION_MIN", "{field} must be at least {param}"
 	case "max":
-		if fieldErr.Type().String() == "string" {
-			return field + " must be at most " + param + " characters long"
+		if isString {
+			return "VALIDATION_MAX_LENGTH", "{field} must be at most {param} characters long"
 		}
-		return field + " must be at most " + param
+		return "VALIDATION_MAX", "{field} must be at most {param}"
 	case "email":
-		return field + " must be a valid email address"
+		return "VALIDATION_EMAIL", "{field} must be a valid email address"
 	case "url":
-		return field + " must be a valid URL"
+		return "VALIDATION_URL", "{field} must be a valid URL"
 	case "oneof":
-		return field + " must be one of: " + strings.ReplaceAll(param, " ", ", ")
+		return "VALIDATION_ONEOF", "{field} must be one of: {param}"
 	case "gt":
-		return field + " must be greater than " + param
+		return "VALIDATION_GT", "{field} must be greater than {param}"
 	case "gte":
-		return field + " must be greater than or equal to " + param
+		return "VALIDATION_GTE", "{field} must be greater than or equal to {param}"
 	case "lt":
-		return field + " must be less than " + param
+		return "VALIDATION_LT", "{field} must be less than {param}"
 	case "lte":
-		return field + " must be less than or equal to " + param
+		return "VALIDATION_LTE", "{field} must be less than or equal to {param}"
 	case "len":
-		return field + " must be exactly " + param + " characters long"
+		return "VALIDATION_LEN", "{field} must be exactly {param} characters long"
 	case "alphanum":
-		return field + " must contain only alphanumeric characters"
+		return "VALIDATION_ALPHANUM", "{field} must contain only alphanumeric characters"
 	case "numeric":
-		return field + " must be a number"
+		return "VALIDATION_NUMERIC", "{field} must be a number"
 	case "uuid":
-		return field + " must be a valid UUID"
+		return "VALIDATION_UUID", "{field} must be a valid UUID"
+	case commonValidator.TAG_SKU:
+		return "VALIDATION_SKU",
+			"{field} must contain only letters and digits separated by '-', '_' or '.'"
+	case commonValidator.TAG_SLUG:
+		return "VALIDATION_SLUG",
+			"{field} must contain only lower-case letters and digits separated by '-'"
+	case commonValidator.TAG_HEX_COLOR_7:
+		return "VALIDATION_HEX_COLOR", "{field} must be a hex color such as #FF5733"
+	case commonValidator.TAG_E164_PHONE:
+		return "VALIDATION_E164_PHONE",
+			"{field} must be a phone number in international format such as +919876543210"
+	case commonValidator.TAG_CURRENCY_CODE:
+		return "VALIDATION_CURRENCY_CODE", "{field} must be an ISO 4217 currency code"
 	default:
-		return field + " is invalid"
+		return "VALIDATION_INVALID", "{field} is invalid"
 	}
 }
 
@@ -140,9 +168,10 @@ func (h *BaseHandler) ParseUintParam(c *gin.Context, paramName string) (uint, er
 	return uint(id), nil
 }
 
-// BindJSON binds JSON request body and handles validation errors
+// BindJSON binds JSON request body and handles validation errors. Fields with a
+// sanitize tag are cleaned before validation.
 func (h *BaseHandler) BindJSON(c *gin.Context, obj any) error {
-	if err := c.ShouldBindJSON(obj); err != nil {
+	if err := c.ShouldBindWith(obj, commonValidator.SanitizedJSON); err != nil {
 		return err
 	}
 	return nil
@@ -0,0 +1,48 @@
+package handler
+
+import (
+	"net/http"
+	"strings"
+
+	"ecommerce-be/common"
+	"ecommerce-be/common/constants"
+	commonError "ecommerce-be/common/error"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ErrorCatalogHandler serves the error code catalog. The type URI of every
+// problem+json error response resolves to an entry here.
+type ErrorCatalogHandler struct {
+	*BaseHandler
+}
+
+// NewErrorCatalogHandler creates a new ErrorCatalogHandler instance
+func NewErrorCatalogHandler() *ErrorCatalogHandler {
+	return &ErrorCatalogHandler{BaseHandler: NewBaseHandler()}
+}
+
+// RegisterErrorCatalogRoutes serves the catalog. Codes are registered by each module's
+// error package at init, so the routes can be registered at any point.
+func RegisterErrorCatalogRoutes(router *gin.Engine) {
+	h := NewErrorCatalogHandler()
+	router.GET(constants.APIErrorCatalog, h.ListErrorCodes)
+	router.GET(constants.APIErrorCatalog+"/:code", h.GetErrorCode)
+}
+
+// ListErrorCodes returns every registered error code
+func (h *ErrorCatalogHandler) ListErrorCodes(c *gin.Context) {
+	h.SuccessWithData(c, http.StatusOK, constants.ERROR_CATALOG_FETCHED_MSG,
+		constants.ERROR_CATALOG_FIELD_NAME, commonError.CatalogEntries())
+}
+
+// GetErrorCode returns a single error code
+func (h *ErrorCatalogHandler) GetErrorCode(c *gin.Context) {
+	entry, ok := commonError.Lookup(strings.ToUpper(c.Param("code")))
+	if !ok {
+		common.ErrorWithCode(c, http.StatusNotFound, constants.ERROR_CODE_NOT_FOUND_MSG,
+			commonError.NOT_FOUND_CODE)
+		return
+	}
+	h.Success(c, http.StatusOK, constants.ERROR_CATALOG_FETCHED_MSG, entry)
+}
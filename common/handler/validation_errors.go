@@ -0,0 +1,188 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+
+	"ecommerce-be/common"
+	"ecommerce-be/common/constants"
+	"ecommerce-be/common/i18n"
+	commonValidator "ecommerce-be/common/validator"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+// unknownFieldPrefix is how encoding/json reports fields rejected by
+// DisallowUnknownFields; the error has no dedicated type
+const unknownFieldPrefix = "json: unknown field "
+
+func init() {
+	// Handlers bind through this package, so registering here guarantees the custom
+	// tags exist before the first request is validated
+	commonValidator.RegisterBindingValidators()
+}
+
+// BuildValidationErrors converts a binding or validation error into field-level
+// validation errors localized into the request language
+func BuildValidationErrors(c *gin.Context, err error) []common.ValidationError {
+	var validationErrs validator.ValidationErrors
+	if errors.As(err, &validationErrs) {
+		result := make([]common.ValidationError, 0, len(validationErrs))
+		for _, fieldErr := range validationErrs {
+			result = append(result, common.ValidationError{
+				Field:   fieldPath(fieldErr),
+				Rule:    fieldErr.Tag(),
+				Message: getValidationErrorMessage(c, fieldErr),
+				Param:   fieldErr.Param(),
+			})
+		}
+		return result
+	}
+
+	return []common.ValidationError{decodeValidationError(c, err)}
+}
+
+// decodeValidationError describes an error raised before validation ran, while the
+// request body or query was being decoded, or by a cross-field rule checked after it
+func decodeValidationError(c *gin.Context, err error) common.ValidationError {
+	var typeErr *json.UnmarshalTypeError
+	var syntaxErr *json.SyntaxError
+	var numErr *strconv.NumError
+	var limitErr *commonValidator.JSONLimitError
+	var crossFieldErr *commonValidator.CrossFieldError
+
+	switch {
+	case errors.As(err, &typeErr):
+		field := decodePath(typeErr.Field)
+		if field == "" {
+			field = constants.REQUEST_FIELD_NAME
+		}
+		param := typeErr.Type.String()
+		return common.ValidationError{
+			Field: field,
+			Rule:  constants.VALIDATION_RULE_TYPE,
+			Message: i18n.Format(c, "VALIDATION_TYPE", "{field} must be of type {param}",
+				map[string]string{"field": field, "param": param}),
+			Param: param,
+		}
+	case errors.As(err, &syntaxErr),
+		errors.Is(err, io.EOF),
+		errors.Is(err, io.ErrUnexpectedEOF):
+		return common.ValidationError{
+			Field: constants.REQUEST_FIELD_NAME,
+			Rule:  constants.VALIDATION_RULE_JSON,
+			Message: i18n.T(c, "VALIDATION_MALFORMED_JSON",
+				"Request body must be well-formed JSON"),
+		}
+	case strings.HasPrefix(err.Error(), unknownFieldPrefix):
+		field, unquoteErr := strconv.Unquote(strings.TrimPrefix(err.Error(), unknownFieldPrefix))
+		if unquoteErr != nil {
+			field = constants.REQUEST_FIELD_NAME
+		}
+		return common.ValidationError{
+			Field: field,
+			Rule:  constants.VALIDATION_RULE_UNKNOWN_FIELD,
+			Message: i18n.Format(c, "VALIDATION_UNKNOWN_FIELD", "{field} is not a recognized field",
+				map[string]string{"field": field}),
+		}
+	case errors.As(err, &numErr):
+		return common.ValidationError{
+			Field: constants.REQUEST_FIELD_NAME,
+			Rule:  constants.VALIDATION_RULE_TYPE,
+			Message: i18n.Format(c, "VALIDATION_NUMBER_VALUE", "{param} is not a valid number",
+				map[string]string{"param": numErr.Num}),
+			Param: numErr.Num,
+		}
+	case errors.As(err, &limitErr):
+		return jsonLimitValidationError(c, limitErr)
+	case errors.As(err, &crossFieldErr):
+		return crossFieldValidationError(c, crossFieldErr)
+	default:
+		return common.ValidationError{
+			Field:   constants.REQUEST_FIELD_NAME,
+			Rule:    constants.VALIDATION_RULE_INVALID,
+			Message: err.Error(),
+		}
+	}
+}
+
+// jsonLimitValidationError describes a body rejected for its nesting depth or for an
+// oversized array
+func jsonLimitValidationError(
+	c *gin.Context,
+	limitErr *commonValidator.JSONLimitError,
+) common.ValidationError {
+	field := limitErr.Field
+	if field == "" {
+		field = constants.REQUEST_FIELD_NAME
+	}
+	param := strconv.Itoa(limitErr.Limit)
+	code, fallback := "VALIDATION_MAX_ARRAY_LENGTH", "{field} must not have more than {param} elements"
+	if limitErr.Rule == constants.VALIDATION_RULE_MAX_DEPTH {
+		code, fallback = "VALIDATION_MAX_DEPTH", "{field} must not nest deeper than {param} levels"
+	}
+	return common.ValidationError{
+		Field:   field,
+		Rule:    limitErr.Rule,
+		Message: i18n.Format(c, code, fallback, map[string]string{"field": field, "param": param}),
+		Param:   param,
+	}
+}
+
+// crossFieldValidationError describes a request that broke a rule between its fields
+func crossFieldValidationError(
+	c *gin.Context,
+	crossFieldErr *commonValidator.CrossFieldError,
+) common.ValidationError {
+	var code, fallback string
+	switch crossFieldErr.Rule {
+	case constants.VALIDATION_RULE_EXCLUDED_WITH:
+		code, fallback = "VALIDATION_EXCLUDED_WITH", "{field} cannot be combined with {param}"
+	case constants.VALIDATION_RULE_LTE_FIELD:
+		code, fallback = "VALIDATION_LTE_FIELD", "{field} must be less than or equal to {param}"
+	default:
+		code, fallback = "VALIDATION_REQUIRED_WITH", "{field} is required when {param} is provided"
+	}
+	return common.ValidationError{
+		Field: crossFieldErr.Field,
+		Rule:  crossFieldErr.Rule,
+		Message: i18n.Format(c, code, fallback, map[string]string{
+			"field": crossFieldErr.Field,
+			"param": crossFieldErr.Param,
+		}),
+		Param: crossFieldErr.Param,
+	}
+}
+
+// decodePath rewrites encoding/json's dotted path (items.0.quantity) in the
+// validator's notation (items[0].quantity)
+func decodePath(path string) string {
+	if path == "" {
+		return ""
+	}
+	var b strings.Builder
+	for i, segment := range strings.Split(path, ".") {
+		if _, err := strconv.Atoi(segment); err == nil {
+			b.WriteString("[" + segment + "]")
+			continue
+		}
+		if i > 0 {
+			b.WriteByte('.')
+		}
+		b.WriteString(segment)
+	}
+	return b.String()
+}
+
+// fieldPath returns the field's path below the request struct, e.g. items[0].quantity
+func fieldPath(fieldErr validator.FieldError) string {
+	namespace := fieldErr.Namespace()
+	if _, path, found := strings.Cut(namespace, "."); found {
+		return path
+	}
+	return fieldErr.Field()
+}
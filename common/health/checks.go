@@ -0,0 +1,105 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+
+	"ecommerce-be/common/cache"
+	"ecommerce-be/common/db"
+)
+
+// migrationFilePattern matches the files run_migrations.sh applies
+var migrationFilePattern = regexp.MustCompile(`^[0-9]{3}_.+\.sql$`)
+
+// maxListedPendingMigrations caps the file names included in the check error
+const maxListedPendingMigrations = 5
+
+// PostgresCheck pings the primary database.
+func PostgresCheck(ctx context.Context) error {
+	gormDB := db.GetDB()
+	if gormDB == nil {
+		return errors.New("database not initialized")
+	}
+	sqlDB, err := gormDB.DB()
+	if err != nil {
+		return err
+	}
+	return sqlDB.PingContext(ctx)
+}
+
+// RedisCheck pings Redis.
+func RedisCheck(ctx context.Context) error {
+	client, err := cache.GetRedisClient()
+	if err != nil {
+		return err
+	}
+	return client.Ping(ctx).Err()
+}
+
+// MigrationsCheck fails while any migration file in dir has not been recorded as
+// applied in the schema_migration table maintained by run_migrations.sh.
+func MigrationsCheck(dir string) CheckFunc {
+	return func(ctx context.Context) error {
+		files, err := ListMigrationFiles(dir)
+		if err != nil {
+			return err
+		}
+
+		var applied []string
+		err = db.DB(ctx).
+			Table("schema_migration").
+			Where("status = ?", "SUCCESS").
+			Pluck("filename", &applied).Error
+		if err != nil {
+			return err
+		}
+
+		pending := PendingMigrations(files, applied)
+		if len(pending) == 0 {
+			return nil
+		}
+		listed := pending
+		if len(listed) > maxListedPendingMigrations {
+			listed = listed[:maxListedPendingMigrations]
+		}
+		return fmt.Errorf("%d pending migration(s): %s", len(pending), strings.Join(listed, ", "))
+	}
+}
+
+// ListMigrationFiles returns the migration file names in dir, sorted.
+func ListMigrationFiles(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var files []string
+	for _, entry := range entries {
+		if !entry.IsDir() && migrationFilePattern.MatchString(entry.Name()) {
+			files = append(files, entry.Name())
+		}
+	}
+	sort.Strings(files)
+	return files, nil
+}
+
+// PendingMigrations returns the files that are not in applied, keeping file order.
+func PendingMigrations(files, applied []string) []string {
+	done := make(map[string]struct{}, len(applied))
+	for _, name := range applied {
+		done[name] = struct{}{}
+	}
+
+	var pending []string
+	for _, name := range files {
+		if _, ok := done[name]; !ok {
+			pending = append(pending, name)
+		}
+	}
+	return pending
+}
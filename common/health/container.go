@@ -0,0 +1,53 @@
+package health
+
+import (
+	"os"
+	"time"
+
+	"ecommerce-be/common"
+	"ecommerce-be/common/config"
+	"ecommerce-be/common/constants"
+	"ecommerce-be/common/log"
+
+	"github.com/gin-gonic/gin"
+)
+
+// NewContainer registers the core dependency checks and the probe routes. Optional
+// dependencies are registered by their owning modules.
+func NewContainer(router *gin.Engine) *common.Container {
+	c := &common.Container{}
+	cfg := healthConfig()
+
+	Register(Check{Name: constants.HEALTH_CHECK_POSTGRES, Required: true, Run: PostgresCheck})
+	Register(Check{Name: constants.HEALTH_CHECK_REDIS, Required: true, Run: RedisCheck})
+
+	// Images built without the migrations folder cannot tell what is pending
+	if _, err := os.Stat(cfg.MigrationsDir); err == nil {
+		Register(Check{
+			Name:     constants.HEALTH_CHECK_MIGRATIONS,
+			Required: true,
+			Run:      MigrationsCheck(cfg.MigrationsDir),
+		})
+	} else {
+		log.Warn("health: migrations dir not found, pending migration check disabled: " +
+			cfg.MigrationsDir)
+	}
+
+	timeout := time.Duration(cfg.CheckTimeoutMs) * time.Millisecond
+	c.RegisterModule(NewModule(NewHandler(timeout)))
+
+	for _, module := range c.Modules {
+		module.RegisterRoutes(router)
+	}
+
+	return c
+}
+
+// healthConfig returns the loaded health config, or the defaults when configuration
+// has not been loaded.
+func healthConfig() config.HealthConfig {
+	if cfg := config.Get(); cfg != nil {
+		return cfg.Health
+	}
+	return config.HealthConfig{CheckTimeoutMs: 2000, MigrationsDir: "migrations"}
+}
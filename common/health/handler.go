@@ -0,0 +1,50 @@
+package health
+
+import (
+	"net/http"
+	"time"
+
+	"ecommerce-be/common/constants"
+	"ecommerce-be/common/log"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Handler serves the probe endpoints. Bodies are written as-is rather than in the
+// API response envelope so probes and dashboards can read them directly.
+type Handler struct {
+	checkTimeout time.Duration
+	startedAt    time.Time
+}
+
+// NewHandler creates a new instance of Handler
+func NewHandler(checkTimeout time.Duration) *Handler {
+	return &Handler{checkTimeout: checkTimeout, startedAt: time.Now()}
+}
+
+// Liveness reports that the process is serving requests. It checks no dependencies,
+// so an outage elsewhere never gets the pod restarted.
+// GET /healthz
+func (h *Handler) Liveness(c *gin.Context) {
+	c.JSON(http.StatusOK, LivenessReport{
+		Status:        constants.HEALTH_STATUS_UP,
+		UptimeSeconds: int64(time.Since(h.startedAt).Seconds()),
+	})
+}
+
+// Readiness reports per-dependency status; 503 when a required dependency is down.
+// GET /readyz
+func (h *Handler) Readiness(c *gin.Context) {
+	report := Evaluate(c.Request.Context(), Registered(), h.checkTimeout)
+
+	statusCode := http.StatusOK
+	switch report.Status {
+	case constants.HEALTH_STATUS_DOWN:
+		statusCode = http.StatusServiceUnavailable
+		log.WarnWithContext(c, "readiness: required dependency down")
+	case constants.HEALTH_STATUS_DEGRADED:
+		log.WarnWithContext(c, "readiness: optional dependency down, serving degraded")
+	}
+
+	c.JSON(statusCode, report)
+}
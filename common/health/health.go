@@ -0,0 +1,140 @@
+// Package health serves the Kubernetes liveness (/healthz) and readiness (/readyz)
+// probes.
+//
+// Readiness runs every registered dependency check concurrently. A failing required
+// check marks the pod down (HTTP 503) so it is taken out of rotation; a failing
+// optional check (mail, ...) only marks it degraded and traffic keeps flowing.
+// Modules add their own checks with Register.
+package health
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"ecommerce-be/common/constants"
+)
+
+// CheckFunc reports whether a dependency is reachable; a nil error means up.
+type CheckFunc func(ctx context.Context) error
+
+// Check is a named dependency check.
+type Check struct {
+	Name string
+	// Required checks take the pod out of rotation when they fail
+	Required bool
+	Run      CheckFunc
+}
+
+// DependencyStatus is the outcome of one check.
+type DependencyStatus struct {
+	Name      string `json:"name"`
+	Status    string `json:"status"`
+	Required  bool   `json:"required"`
+	LatencyMs int64  `json:"latencyMs"`
+	Error     string `json:"error,omitempty"`
+}
+
+// Report is the readiness probe body.
+type Report struct {
+	Status       string             `json:"status"`
+	CheckedAt    time.Time          `json:"checkedAt"`
+	Dependencies []DependencyStatus `json:"dependencies"`
+}
+
+// LivenessReport is the liveness probe body.
+type LivenessReport struct {
+	Status        string `json:"status"`
+	UptimeSeconds int64  `json:"uptimeSeconds"`
+}
+
+var (
+	mu       sync.RWMutex
+	registry = map[string]Check{}
+)
+
+// Register adds a dependency check to the readiness probe. A check registered
+// twice under the same name replaces the earlier one.
+func Register(check Check) {
+	mu.Lock()
+	defer mu.Unlock()
+	registry[check.Name] = check
+}
+
+// Registered returns the registered checks ordered by name.
+func Registered() []Check {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	checks := make([]Check, 0, len(registry))
+	for _, check := range registry {
+		checks = append(checks, check)
+	}
+	sort.Slice(checks, func(i, j int) bool { return checks[i].Name < checks[j].Name })
+	return checks
+}
+
+// Evaluate runs the checks concurrently, each bounded by timeout, and combines
+// their outcomes into a report. Dependencies keep the order of checks.
+func Evaluate(ctx context.Context, checks []Check, timeout time.Duration) Report {
+	dependencies := make([]DependencyStatus, len(checks))
+
+	var wg sync.WaitGroup
+	for i, check := range checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			dependencies[i] = runCheck(ctx, check, timeout)
+		}()
+	}
+	wg.Wait()
+
+	status := constants.HEALTH_STATUS_UP
+	for _, dependency := range dependencies {
+		if dependency.Status == constants.HEALTH_STATUS_UP {
+			continue
+		}
+		if dependency.Required {
+			status = constants.HEALTH_STATUS_DOWN
+			break
+		}
+		status = constants.HEALTH_STATUS_DEGRADED
+	}
+
+	return Report{
+		Status:       status,
+		CheckedAt:    time.Now().UTC(),
+		Dependencies: dependencies,
+	}
+}
+
+// runCheck runs one check. The check runs in its own goroutine so one that ignores
+// its context still cannot hold the probe past the timeout.
+func runCheck(ctx context.Context, check Check, timeout time.Duration) DependencyStatus {
+	checkCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	start := time.Now()
+	done := make(chan error, 1)
+	go func() { done <- check.Run(checkCtx) }()
+
+	var err error
+	select {
+	case err = <-done:
+	case <-checkCtx.Done():
+		err = checkCtx.Err()
+	}
+
+	result := DependencyStatus{
+		Name:      check.Name,
+		Status:    constants.HEALTH_STATUS_UP,
+		Required:  check.Required,
+		LatencyMs: time.Since(start).Milliseconds(),
+	}
+	if err != nil {
+		result.Status = constants.HEALTH_STATUS_DOWN
+		result.Error = err.Error()
+	}
+	return result
+}
@@ -0,0 +1,27 @@
+package health
+
+import (
+	"ecommerce-be/common/constants"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Module registers the probe routes
+type Module struct {
+	handler *Handler
+}
+
+// NewModule creates a new instance of Module
+func NewModule(handler *Handler) *Module {
+	return &Module{handler: handler}
+}
+
+// RegisterRoutes registers the probe routes (unauthenticated). They are kept out of
+// the OpenAPI document, which describes the enveloped /api responses.
+func (m *Module) RegisterRoutes(router *gin.Engine) {
+	// GET /healthz - Liveness probe
+	router.GET(constants.APIHealthLiveness, m.handler.Liveness)
+
+	// GET /readyz - Readiness probe with per-dependency status and latency
+	router.GET(constants.APIHealthReadiness, m.handler.Readiness)
+}
@@ -5,12 +5,23 @@ import (
 	"crypto/cipher"
 	"crypto/rand"
 	"encoding/base64"
+	"encoding/hex"
 	"errors"
 	"io"
 )
 
 var ErrInvalidEncryptionKey = errors.New("encryption key must be 32 bytes for AES-256")
 
+// GenerateRandomCredential returns 256 random bits, hex encoded, for credentials no
+// person types: webhook secrets and the passwords of accounts that never sign in
+func GenerateRandomCredential() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
+
 // Encrypt encrypts a plaintext string using AES-GCM and returns a base64 encoded ciphertext
 func Encrypt(plaintext string, keyString string) (string, error) {
 	key := []byte(keyString)
@@ -0,0 +1,145 @@
+package i18n
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// Catalog holds translated API messages per language, keyed by message code.
+// Codes are the repo's error codes (e.g. "ORDER_NOT_FOUND") and message constant
+// names (e.g. "ORDER_CREATED_MSG").
+type Catalog struct {
+	defaultLanguage string
+	messages        map[string]map[string]string
+
+	// codesByText maps default-language text back to its code, so responses built
+	// from the existing English constants are translated without touching callers.
+	codesByText map[string]string
+}
+
+// NewCatalog builds a catalog from language -> code -> message maps.
+// Language tags are normalized to lower case ("pt-BR" -> "pt-br").
+func NewCatalog(defaultLanguage string, messages map[string]map[string]string) *Catalog {
+	c := &Catalog{
+		defaultLanguage: NormalizeTag(defaultLanguage),
+		messages:        make(map[string]map[string]string, len(messages)),
+		codesByText:     make(map[string]string),
+	}
+	for lang, entries := range messages {
+		c.messages[NormalizeTag(lang)] = entries
+	}
+
+	// Sort codes so that texts shared by several constants resolve deterministically.
+	defaults := c.messages[c.defaultLanguage]
+	codes := make([]string, 0, len(defaults))
+	for code := range defaults {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+	for _, code := range codes {
+		if _, exists := c.codesByText[defaults[code]]; !exists {
+			c.codesByText[defaults[code]] = code
+		}
+	}
+	return c
+}
+
+// LoadDir reads every <language>.json file in dir. Each file is a flat
+// {"CODE": "message"} object. The default language file is required.
+func LoadDir(dir, defaultLanguage string) (*Catalog, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+
+	messages := make(map[string]map[string]string, len(files))
+	for _, file := range files {
+		raw, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("read %s: %w", file, err)
+		}
+		entries := map[string]string{}
+		if err := json.Unmarshal(raw, &entries); err != nil {
+			return nil, fmt.Errorf("parse %s: %w", file, err)
+		}
+		messages[strings.TrimSuffix(filepath.Base(file), ".json")] = entries
+	}
+
+	catalog := NewCatalog(defaultLanguage, messages)
+	if !catalog.Supports(catalog.defaultLanguage) {
+		return nil, fmt.Errorf("no translation file for default language %q in %s",
+			catalog.defaultLanguage, dir)
+	}
+	return catalog, nil
+}
+
+// DefaultLanguage returns the language used when negotiation finds no match.
+func (c *Catalog) DefaultLanguage() string {
+	return c.defaultLanguage
+}
+
+// Languages returns the loaded language tags in sorted order.
+func (c *Catalog) Languages() []string {
+	languages := make([]string, 0, len(c.messages))
+	for lang := range c.messages {
+		languages = append(languages, lang)
+	}
+	sort.Strings(languages)
+	return languages
+}
+
+// Supports reports whether a translation file was loaded for lang.
+func (c *Catalog) Supports(lang string) bool {
+	_, ok := c.messages[NormalizeTag(lang)]
+	return ok
+}
+
+// Lookup returns the message for code in lang, falling back to the default language.
+func (c *Catalog) Lookup(lang, code string) (string, bool) {
+	if msg, ok := c.messages[NormalizeTag(lang)][code]; ok && msg != "" {
+		return msg, true
+	}
+	msg, ok := c.messages[c.defaultLanguage][code]
+	return msg, ok && msg != ""
+}
+
+// Translate returns the message for code in lang, or fallback when the code is unknown.
+func (c *Catalog) Translate(lang, code, fallback string) string {
+	if msg, ok := c.Lookup(lang, code); ok {
+		return msg
+	}
+	return fallback
+}
+
+// Localize translates a default-language message into lang. Text that is not in
+// the catalog (e.g. formatted or ad-hoc messages) is returned unchanged.
+func (c *Catalog) Localize(lang, text string) string {
+	if NormalizeTag(lang) == c.defaultLanguage {
+		return text
+	}
+	code, ok := c.codesByText[text]
+	if !ok {
+		return text
+	}
+	return c.Translate(lang, code, text)
+}
+
+// LocalizeError translates an error message identified by its error code.
+// The code is only trusted when text is still the catalog's default message for it;
+// errors re-worded with WithMessage/WithMessagef fall back to a text lookup so the
+// caller's wording is never replaced by a generic translation.
+func (c *Catalog) LocalizeError(lang, code, text string) string {
+	if code != "" && c.messages[c.defaultLanguage][code] == text {
+		return c.Translate(lang, code, text)
+	}
+	return c.Localize(lang, text)
+}
+
+// NormalizeTag lower-cases a language tag and uses "-" as separator ("PT_BR" -> "pt-br").
+func NormalizeTag(tag string) string {
+	return strings.ReplaceAll(strings.ToLower(strings.TrimSpace(tag)), "_", "-")
+}
@@ -0,0 +1,80 @@
+package i18n
+
+import (
+	"context"
+	"strings"
+	"sync/atomic"
+
+	"ecommerce-be/common/reqctx"
+)
+
+// current is the catalog loaded at startup. Until Init succeeds every helper
+// passes messages through unchanged, which keeps tests and tools English-only.
+var current atomic.Pointer[Catalog]
+
+var passThrough = NewCatalog("en", nil)
+
+// Init loads the translation files from dir and makes them the active catalog.
+func Init(dir, defaultLanguage string) error {
+	catalog, err := LoadDir(dir, defaultLanguage)
+	if err != nil {
+		return err
+	}
+	SetCatalog(catalog)
+	return nil
+}
+
+// SetCatalog replaces the active catalog (also used by tests).
+func SetCatalog(catalog *Catalog) {
+	current.Store(catalog)
+}
+
+// Default returns the active catalog, or an empty English catalog when none is loaded.
+func Default() *Catalog {
+	if catalog := current.Load(); catalog != nil {
+		return catalog
+	}
+	return passThrough
+}
+
+// Language returns the negotiated language stored on the request context by the
+// Language middleware, or the default language outside an HTTP request.
+func Language(ctx context.Context) string {
+	if ctx != nil {
+		if lang, ok := reqctx.Language.Get(ctx); ok && lang != "" {
+			return lang
+		}
+	}
+	return Default().DefaultLanguage()
+}
+
+// PreferredLanguagesFromContext returns the Accept-Language tags stored by the Language
+// middleware, in quality order; nil outside an HTTP request or without the header.
+func PreferredLanguagesFromContext(ctx context.Context) []string {
+	tags, _ := reqctx.PreferredLanguages.Get(ctx)
+	return tags
+}
+
+// T returns the message for code in the request language, or fallback if unknown.
+func T(ctx context.Context, code, fallback string) string {
+	return Default().Translate(Language(ctx), code, fallback)
+}
+
+// Format is T with {name} placeholders replaced from params.
+func Format(ctx context.Context, code, fallback string, params map[string]string) string {
+	msg := T(ctx, code, fallback)
+	for name, value := range params {
+		msg = strings.ReplaceAll(msg, "{"+name+"}", value)
+	}
+	return msg
+}
+
+// Localize translates an English message constant into the request language.
+func Localize(ctx context.Context, text string) string {
+	return Default().Localize(Language(ctx), text)
+}
+
+// LocalizeError translates an error message by its error code into the request language.
+func LocalizeError(ctx context.Context, code, text string) string {
+	return Default().LocalizeError(Language(ctx), code, text)
+}
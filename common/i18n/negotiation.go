@@ -0,0 +1,74 @@
+package i18n
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type languageRange struct {
+	tag     string
+	quality float64
+}
+
+// Negotiate picks the best supported language for an Accept-Language header value.
+// Ranges are tried by descending quality; "es-MX" falls back to "es" when only the
+// primary language is loaded, and "*" or no match resolves to the default language.
+func (c *Catalog) Negotiate(acceptLanguage string) string {
+	for _, r := range parseAcceptLanguage(acceptLanguage) {
+		if r.tag == "*" {
+			return c.defaultLanguage
+		}
+		if c.Supports(r.tag) {
+			return r.tag
+		}
+		if primary, _, found := strings.Cut(r.tag, "-"); found && c.Supports(primary) {
+			return primary
+		}
+	}
+	return c.defaultLanguage
+}
+
+// PreferredLanguages returns the acceptable language tags of an Accept-Language header
+// in descending quality order, without negotiating against the loaded catalogs.
+// Used where content (not UI messages) may exist in languages beyond the catalog set.
+func PreferredLanguages(acceptLanguage string) []string {
+	ranges := parseAcceptLanguage(acceptLanguage)
+	tags := make([]string, 0, len(ranges))
+	for _, r := range ranges {
+		tags = append(tags, r.tag)
+	}
+	return tags
+}
+
+// parseAcceptLanguage parses "da, en-GB;q=0.8, en;q=0.7" into ranges ordered by quality.
+// Ranges with q=0 (explicitly not acceptable) or malformed weights are dropped.
+func parseAcceptLanguage(header string) []languageRange {
+	var ranges []languageRange
+	for _, part := range strings.Split(header, ",") {
+		tag, params, _ := strings.Cut(part, ";")
+		tag = NormalizeTag(tag)
+		if tag == "" {
+			continue
+		}
+
+		quality := 1.0
+		if params = strings.TrimSpace(params); params != "" {
+			value, found := strings.CutPrefix(params, "q=")
+			if !found {
+				continue
+			}
+			q, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+			if err != nil || q <= 0 || q > 1 {
+				continue
+			}
+			quality = q
+		}
+		ranges = append(ranges, languageRange{tag: tag, quality: quality})
+	}
+
+	sort.SliceStable(ranges, func(i, j int) bool {
+		return ranges[i].quality > ranges[j].quality
+	})
+	return ranges
+}
@@ -0,0 +1,132 @@
+package jobmonitor
+
+import (
+	"net/http"
+
+	"ecommerce-be/common/constants"
+	"ecommerce-be/common/handler"
+	"ecommerce-be/common/log"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Handler handles the job monitoring API
+type Handler struct {
+	*handler.BaseHandler
+	service Service
+}
+
+// NewHandler creates a new instance of Handler
+func NewHandler(service Service) *Handler {
+	return &Handler{
+		BaseHandler: handler.NewBaseHandler(),
+		service:     service,
+	}
+}
+
+// GetSummary handles fetching the background processing overview
+// GET /api/jobs/summary
+func (h *Handler) GetSummary(c *gin.Context) {
+	response, err := h.service.GetSummary(c)
+	if err != nil {
+		log.ErrorWithContext(c, "getJobSummary: failed", err)
+		h.HandleError(c, err, constants.FAILED_TO_FETCH_JOB_SUMMARY_MSG)
+		return
+	}
+
+	h.SuccessWithData(
+		c,
+		http.StatusOK,
+		constants.JOB_SUMMARY_FETCHED_MSG,
+		constants.JOB_SUMMARY_FIELD_NAME,
+		response,
+	)
+}
+
+// ListQueuedJobs handles listing a priority queue's jobs
+// GET /api/jobs/queued
+func (h *Handler) ListQueuedJobs(c *gin.Context) {
+	var params ListQueuedJobsQueryParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	response, err := h.service.ListQueuedJobs(c, params)
+	if err != nil {
+		log.ErrorWithContext(c, "listQueuedJobs: failed", err)
+		h.HandleError(c, err, constants.FAILED_TO_LIST_QUEUED_JOBS_MSG)
+		return
+	}
+
+	h.Success(c, http.StatusOK, constants.QUEUED_JOBS_LISTED_MSG, response)
+}
+
+// ListRunningJobs handles listing the jobs workers are running
+// GET /api/jobs/running
+func (h *Handler) ListRunningJobs(c *gin.Context) {
+	response, err := h.service.ListRunningJobs(c)
+	if err != nil {
+		log.ErrorWithContext(c, "listRunningJobs: failed", err)
+		h.HandleError(c, err, constants.FAILED_TO_LIST_RUNNING_JOBS_MSG)
+		return
+	}
+
+	h.SuccessWithData(
+		c,
+		http.StatusOK,
+		constants.RUNNING_JOBS_LISTED_MSG,
+		constants.JOBS_FIELD_NAME,
+		response,
+	)
+}
+
+// PurgeStaleRunningJobs handles dropping running entries left by dead instances
+// DELETE /api/jobs/running/stale
+func (h *Handler) PurgeStaleRunningJobs(c *gin.Context) {
+	var params PurgeStaleQueryParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	purged, err := h.service.PurgeStaleRunningJobs(c, params)
+	if err != nil {
+		log.ErrorWithContext(c, "purgeStaleRunningJobs: failed", err)
+		h.HandleError(c, err, constants.FAILED_TO_PURGE_RUNNING_JOBS_MSG)
+		return
+	}
+
+	h.SuccessWithData(
+		c,
+		http.StatusOK,
+		constants.STALE_RUNNING_JOBS_PURGED_MSG,
+		constants.PURGED_JOBS_FIELD_NAME,
+		purged,
+	)
+}
+
+// ListRuns handles listing recent job runs
+// GET /api/jobs/runs
+func (h *Handler) ListRuns(c *gin.Context) {
+	var params ListRunsQueryParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	response, err := h.service.ListRuns(c, params)
+	if err != nil {
+		log.ErrorWithContext(c, "listJobRuns: failed", err)
+		h.HandleError(c, err, constants.FAILED_TO_LIST_JOB_RUNS_MSG)
+		return
+	}
+
+	h.SuccessWithData(
+		c,
+		http.StatusOK,
+		constants.JOB_RUNS_LISTED_MSG,
+		constants.JOB_RUNS_FIELD_NAME,
+		response,
+	)
+}
@@ -0,0 +1,112 @@
+package jobmonitor
+
+import (
+	"encoding/json"
+	"time"
+
+	"ecommerce-be/common"
+	"ecommerce-be/common/scheduler"
+)
+
+// defaultStaleAfterMinutes is how long a job may run before a purge without
+// olderThanMinutes treats it as left by a dead instance
+const defaultStaleAfterMinutes = 60
+
+// ========================================
+// REQUEST MODELS
+// ========================================
+
+// ListQueuedJobsQueryParams - One priority queue's due or scheduled jobs
+type ListQueuedJobsQueryParams struct {
+	Priority string `form:"priority" binding:"omitempty,oneof=high normal low"`
+	State    string `form:"state"    binding:"omitempty,oneof=due scheduled"`
+	Page     int    `form:"page"     binding:"omitempty,min=1"`
+	PageSize int    `form:"pageSize" binding:"omitempty,min=1,max=100"`
+}
+
+// ListRunsQueryParams - Filters for the recent runs
+type ListRunsQueryParams struct {
+	Failed  bool   `form:"failed"`
+	Command string `form:"command" binding:"omitempty,max=100"`
+	Limit   int    `form:"limit"   binding:"omitempty,min=1,max=200"`
+}
+
+// PurgeStaleQueryParams - Age after which a running job is considered stale
+type PurgeStaleQueryParams struct {
+	OlderThanMinutes int `form:"olderThanMinutes" binding:"omitempty,min=1"`
+}
+
+// ========================================
+// RESPONSE MODELS
+// ========================================
+
+// JobSummaryResponse is an overview of background processing
+type JobSummaryResponse struct {
+	Queues       []scheduler.QueueDepth `json:"queues"`
+	Running      int                    `json:"running"`
+	DeadLettered int64                  `json:"deadLettered"`
+	// Commands summarises the recent runs window, busiest command first
+	Commands []CommandStats `json:"commands"`
+}
+
+// CommandStats summarises a command's recent runs
+type CommandStats struct {
+	Command       string     `json:"command"`
+	Runs          int        `json:"runs"`
+	Failures      int        `json:"failures"`
+	AvgDurationMs int64      `json:"avgDurationMs"`
+	MaxDurationMs int64      `json:"maxDurationMs"`
+	LastRunAt     time.Time  `json:"lastRunAt"`
+	LastFailureAt *time.Time `json:"lastFailureAt"`
+	LastError     string     `json:"lastError,omitempty"`
+}
+
+// QueuedJobListResponse is a page of a priority queue
+type QueuedJobListResponse struct {
+	Jobs       []QueuedJobResponse       `json:"jobs"`
+	Pagination common.PaginationResponse `json:"pagination"`
+}
+
+// QueuedJobResponse describes a job waiting to run
+type QueuedJobResponse struct {
+	JobID     string             `json:"jobId"`
+	Command   string             `json:"command"`
+	Payload   json.RawMessage    `json:"payload"`
+	Priority  scheduler.Priority `json:"priority"`
+	Attempts  int                `json:"attempts"`
+	LastError string             `json:"lastError,omitempty"`
+	SellerID  uint               `json:"sellerId"`
+	DueAt     time.Time          `json:"dueAt"`
+}
+
+// ========================================
+// HELPERS
+// ========================================
+
+// toQueuedJobResponse converts a queued job to its response
+func toQueuedJobResponse(job scheduler.QueuedJob) QueuedJobResponse {
+	priority := job.Priority
+	if priority == "" {
+		priority = scheduler.PriorityNormal
+	}
+	return QueuedJobResponse{
+		JobID:     job.JobID.String(),
+		Command:   job.Command,
+		Payload:   job.Payload,
+		Priority:  priority,
+		Attempts:  job.Attempt,
+		LastError: job.LastError,
+		SellerID:  job.SellerID,
+		DueAt:     job.DueAt,
+	}
+}
+
+func normalizePage(page, pageSize int) (int, int) {
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = 20
+	}
+	return page, pageSize
+}
@@ -0,0 +1,64 @@
+package jobmonitor
+
+import (
+	"net/http"
+
+	"ecommerce-be/common/constants"
+	"ecommerce-be/common/middleware"
+	"ecommerce-be/common/openapi"
+	"ecommerce-be/common/scheduler"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Module registers the job monitoring routes
+type Module struct {
+	handler *Handler
+}
+
+// NewModule creates a new instance of Module
+func NewModule(handler *Handler) *Module {
+	return &Module{handler: handler}
+}
+
+// RegisterRoutes registers job monitoring routes (admin only)
+func (m *Module) RegisterRoutes(router *gin.Engine) {
+	jobRoutes := openapi.NewGroup(router.Group(constants.APIBaseJobs), "Background Jobs")
+	jobRoutes.Use(middleware.AdminAuth())
+	{
+		// GET /api/jobs/summary - Queue depths, running and failed counts, command stats
+		jobRoutes.GET("/summary", m.handler.GetSummary).
+			Summary("Get the background job summary").
+			ReturnsField(http.StatusOK, "summary", JobSummaryResponse{})
+
+		// GET /api/jobs/queued - Jobs waiting in a priority queue, soonest first
+		// Query params: ?priority=high&state=scheduled&page=1&pageSize=20
+		jobRoutes.GET("/queued", m.handler.ListQueuedJobs).
+			Summary("List queued jobs").
+			Description("Due jobs wait for a free worker; scheduled jobs wait for their time. "+
+				"Lists the normal priority due jobs by default.").
+			Query(ListQueuedJobsQueryParams{}).
+			Returns(http.StatusOK, QueuedJobListResponse{})
+
+		// GET /api/jobs/running - Jobs workers are running, longest running first
+		jobRoutes.GET("/running", m.handler.ListRunningJobs).
+			Summary("List running jobs").
+			ReturnsField(http.StatusOK, "jobs", []scheduler.RunningJob{})
+
+		// DELETE /api/jobs/running/stale - Drop entries left by instances that died
+		// Query params: ?olderThanMinutes=60
+		jobRoutes.DELETE("/running/stale", m.handler.PurgeStaleRunningJobs).
+			Summary("Purge stale running jobs").
+			Query(PurgeStaleQueryParams{}).
+			ReturnsField(http.StatusOK, "purged", int64(0))
+
+		// GET /api/jobs/runs - Latest finished runs with durations and errors
+		// Query params: ?failed=true&command=notification.dispatch&limit=50
+		jobRoutes.GET("/runs", m.handler.ListRuns).
+			Summary("List recent job runs").
+			Description("Failed runs are kept longer than successful ones; use failed=true "+
+				"to look further back for failures.").
+			Query(ListRunsQueryParams{}).
+			ReturnsField(http.StatusOK, "runs", []scheduler.JobRun{})
+	}
+}
@@ -0,0 +1,206 @@
+package jobmonitor
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"time"
+
+	"ecommerce-be/common"
+	"ecommerce-be/common/scheduler"
+)
+
+// defaultRunsLimit is how many runs the run list returns without a limit
+const defaultRunsLimit = 50
+
+// statsWindow is how many of the latest runs the summary's command stats cover
+const statsWindow = 500
+
+// Service reports on the scheduler's queues, workers and recent runs
+type Service interface {
+	GetSummary(ctx context.Context) (*JobSummaryResponse, error)
+	ListQueuedJobs(
+		ctx context.Context,
+		params ListQueuedJobsQueryParams,
+	) (*QueuedJobListResponse, error)
+	ListRunningJobs(ctx context.Context) ([]scheduler.RunningJob, error)
+	PurgeStaleRunningJobs(ctx context.Context, params PurgeStaleQueryParams) (int64, error)
+	ListRuns(ctx context.Context, params ListRunsQueryParams) ([]scheduler.JobRun, error)
+}
+
+// ServiceImpl implements Service on the scheduler's Redis queues
+type ServiceImpl struct {
+	scheduler *scheduler.Scheduler
+}
+
+// NewService creates a new instance of Service. sched is nil when Redis is not
+// available, in which case every call fails with ErrQueueUnavailable.
+func NewService(sched *scheduler.Scheduler) *ServiceImpl {
+	return &ServiceImpl{scheduler: sched}
+}
+
+// GetSummary returns the queue depths, running and dead-lettered counts and the
+// stats of every command over the recent runs
+func (s *ServiceImpl) GetSummary(ctx context.Context) (*JobSummaryResponse, error) {
+	if s.scheduler == nil {
+		return nil, scheduler.ErrQueueUnavailable
+	}
+
+	queues, err := s.scheduler.QueueDepths(ctx)
+	if err != nil {
+		return nil, err
+	}
+	running, err := s.scheduler.RunningJobs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	deadLettered, err := s.scheduler.DeadLetterCount(ctx)
+	if err != nil {
+		return nil, err
+	}
+	runs, err := s.scheduler.RecentRuns(ctx, false, statsWindow)
+	if err != nil {
+		return nil, err
+	}
+
+	return &JobSummaryResponse{
+		Queues:       queues,
+		Running:      len(running),
+		DeadLettered: deadLettered,
+		Commands:     CommandStatsOf(runs),
+	}, nil
+}
+
+// ListQueuedJobs returns a page of one priority queue's due jobs, or its scheduled
+// ones. The queue defaults to normal priority and the state to due.
+func (s *ServiceImpl) ListQueuedJobs(
+	ctx context.Context,
+	params ListQueuedJobsQueryParams,
+) (*QueuedJobListResponse, error) {
+	if s.scheduler == nil {
+		return nil, scheduler.ErrQueueUnavailable
+	}
+	params.Page, params.PageSize = normalizePage(params.Page, params.PageSize)
+	priority := scheduler.Priority(params.Priority)
+	if priority == "" {
+		priority = scheduler.PriorityNormal
+	}
+
+	jobs, total, err := s.scheduler.ListQueuedJobs(
+		ctx,
+		priority,
+		params.State != "scheduled",
+		params.Page,
+		params.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]QueuedJobResponse, 0, len(jobs))
+	for _, job := range jobs {
+		responses = append(responses, toQueuedJobResponse(job))
+	}
+	return &QueuedJobListResponse{
+		Jobs:       responses,
+		Pagination: common.NewPaginationResponse(params.Page, params.PageSize, total),
+	}, nil
+}
+
+// ListRunningJobs returns the jobs workers are running, longest running first
+func (s *ServiceImpl) ListRunningJobs(ctx context.Context) ([]scheduler.RunningJob, error) {
+	if s.scheduler == nil {
+		return nil, scheduler.ErrQueueUnavailable
+	}
+	return s.scheduler.RunningJobs(ctx)
+}
+
+// PurgeStaleRunningJobs drops running entries left by instances that died mid-run
+func (s *ServiceImpl) PurgeStaleRunningJobs(
+	ctx context.Context,
+	params PurgeStaleQueryParams,
+) (int64, error) {
+	if s.scheduler == nil {
+		return 0, scheduler.ErrQueueUnavailable
+	}
+	olderThan := params.OlderThanMinutes
+	if olderThan <= 0 {
+		olderThan = defaultStaleAfterMinutes
+	}
+	return s.scheduler.PurgeStaleRunningJobs(ctx, time.Duration(olderThan)*time.Minute)
+}
+
+// ListRuns returns the latest runs, newest first, optionally only failed ones or
+// only one command's
+func (s *ServiceImpl) ListRuns(
+	ctx context.Context,
+	params ListRunsQueryParams,
+) ([]scheduler.JobRun, error) {
+	if s.scheduler == nil {
+		return nil, scheduler.ErrQueueUnavailable
+	}
+	limit := params.Limit
+	if limit <= 0 {
+		limit = defaultRunsLimit
+	}
+
+	// A command filter looks through the whole kept history for its runs
+	fetch := limit
+	if params.Command != "" {
+		fetch = statsWindow
+	}
+	runs, err := s.scheduler.RecentRuns(ctx, params.Failed, fetch)
+	if err != nil {
+		return nil, err
+	}
+
+	filtered := make([]scheduler.JobRun, 0, min(limit, len(runs)))
+	for _, run := range runs {
+		if params.Command != "" && run.Command != params.Command {
+			continue
+		}
+		filtered = append(filtered, run)
+		if len(filtered) == limit {
+			break
+		}
+	}
+	return filtered, nil
+}
+
+// CommandStatsOf summarises runs, newest first, by command; busiest command first
+func CommandStatsOf(runs []scheduler.JobRun) []CommandStats {
+	byCommand := map[string]*CommandStats{}
+	totals := map[string]int64{}
+	for _, run := range runs {
+		stats, ok := byCommand[run.Command]
+		if !ok {
+			// Runs are newest first, so the first one seen is the last run
+			stats = &CommandStats{Command: run.Command, LastRunAt: run.FinishedAt}
+			byCommand[run.Command] = stats
+		}
+		stats.Runs++
+		totals[run.Command] += run.DurationMs
+		stats.MaxDurationMs = max(stats.MaxDurationMs, run.DurationMs)
+		if run.Result != scheduler.RunResultSucceeded {
+			stats.Failures++
+			if stats.LastFailureAt == nil {
+				failedAt := run.FinishedAt
+				stats.LastFailureAt = &failedAt
+				stats.LastError = run.Error
+			}
+		}
+	}
+
+	result := make([]CommandStats, 0, len(byCommand))
+	for command, stats := range byCommand {
+		stats.AvgDurationMs = totals[command] / int64(stats.Runs)
+		result = append(result, *stats)
+	}
+	slices.SortFunc(result, func(a, b CommandStats) int {
+		if byRuns := cmp.Compare(b.Runs, a.Runs); byRuns != 0 {
+			return byRuns
+		}
+		return cmp.Compare(a.Command, b.Command)
+	})
+	return result
+}
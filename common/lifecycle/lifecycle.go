@@ -0,0 +1,113 @@
+// Package lifecycle coordinates the background workers of the process - the scheduler
+// worker pool and the message consumers - so shutdown can stop them, let them finish
+// or hand back their in-flight work, and wait for them before the database and Redis
+// connections they use are closed.
+package lifecycle
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+
+	"ecommerce-be/common/log"
+)
+
+// Worker is a long-running background loop. It runs until ctx is cancelled, then stops
+// taking new work, finishes or re-enqueues what it holds and returns.
+type Worker func(ctx context.Context)
+
+// Manager starts registered workers and stops them together
+type Manager struct {
+	ctx     context.Context
+	cancel  context.CancelFunc
+	wg      sync.WaitGroup
+	mu      sync.Mutex
+	running map[string]int
+}
+
+// DefaultManager runs the workers of the application
+var DefaultManager = NewManager()
+
+// NewManager creates a Manager with no workers
+func NewManager() *Manager {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Manager{ctx: ctx, cancel: cancel, running: map[string]int{}}
+}
+
+// Go runs worker in its own goroutine until Shutdown. A worker started after shutdown
+// has begun gets an already cancelled context and is expected to return at once.
+func (m *Manager) Go(name string, worker Worker) {
+	m.mu.Lock()
+	m.running[name]++
+	m.mu.Unlock()
+	m.wg.Add(1)
+
+	go func() {
+		defer func() {
+			m.mu.Lock()
+			if m.running[name]--; m.running[name] == 0 {
+				delete(m.running, name)
+			}
+			m.mu.Unlock()
+			m.wg.Done()
+		}()
+		worker(m.ctx)
+	}()
+}
+
+// Shutdown cancels every worker's context and waits for them to return. It gives up
+// when ctx ends and reports the workers still running, which may lose in-flight work.
+func (m *Manager) Shutdown(ctx context.Context) error {
+	m.cancel()
+
+	done := make(chan struct{})
+	go func() {
+		m.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("workers still running at shutdown deadline: %s", m.runningNames())
+	}
+}
+
+// runningNames lists the workers that have not returned
+func (m *Manager) runningNames() string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	names := make([]string, 0, len(m.running))
+	for name := range m.running {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return strings.Join(names, ", ")
+}
+
+// Go runs worker on the default manager, logging when it has stopped
+func Go(name string, worker Worker) {
+	DefaultManager.Go(name, func(ctx context.Context) {
+		worker(ctx)
+		log.Info("Background worker stopped: " + name)
+	})
+}
+
+// Shutdown stops the workers of the default manager
+func Shutdown(ctx context.Context) error {
+	return DefaultManager.Shutdown(ctx)
+}
+
+// Instance identifies this process, host and pid, in the status of background work
+func Instance() string {
+	host, err := os.Hostname()
+	if err != nil || host == "" {
+		host = "unknown"
+	}
+	return fmt.Sprintf("%s:%d", host, os.Getpid())
+}
@@ -9,9 +9,8 @@ import (
 	"strings"
 
 	"ecommerce-be/common/config"
-	"ecommerce-be/common/constants"
+	"ecommerce-be/common/reqctx"
 
-	"github.com/gin-gonic/gin"
 	"github.com/sirupsen/logrus"
 )
 
@@ -120,29 +119,14 @@ func GetLogger() *logrus.Logger {
 func WithContext(ctx context.Context) *logrus.Entry {
 	fields := logrus.Fields{}
 
-	// Try to get as Gin context first (for values stored via c.Set())
-	if ginCtx, ok := ctx.(*gin.Context); ok {
-		// Extract from Gin context storage
-		if correlationID, exists := ginCtx.Get(constants.CORRELATION_ID_KEY); exists {
-			fields["correlationId"] = correlationID
-		}
-		if sellerID, exists := ginCtx.Get(constants.SELLER_ID_KEY); exists {
-			fields["sellerId"] = sellerID
-		}
-		if userID, exists := ginCtx.Get(constants.USER_ID_KEY); exists {
-			fields["userId"] = userID
-		}
-	} else {
-		// Extract from standard context.Context (for background jobs, tests, etc.)
-		if correlationID := ctx.Value(constants.CORRELATION_ID_KEY); correlationID != nil {
-			fields["correlationId"] = correlationID
-		}
-		if sellerID := ctx.Value(constants.SELLER_ID_KEY); sellerID != nil {
-			fields["sellerId"] = sellerID
-		}
-		if userID := ctx.Value(constants.USER_ID_KEY); userID != nil {
-			fields["userId"] = userID
-		}
+	if correlationID, exists := reqctx.CorrelationID.Get(ctx); exists {
+		fields["correlationId"] = correlationID
+	}
+	if sellerID, exists := reqctx.SellerID.Get(ctx); exists {
+		fields["sellerId"] = sellerID
+	}
+	if userID, exists := reqctx.UserID.Get(ctx); exists {
+		fields["userId"] = userID
 	}
 
 	return GetLogger().WithFields(fields)
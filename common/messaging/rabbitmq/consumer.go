@@ -22,6 +22,8 @@ func NewConsumer(client *Client, cfg *config.MessagingConfig) *Consumer {
 }
 
 // Consume subscribes to queue and processes messages with controlled concurrency.
+// Cancelling ctx stops taking deliveries; messages already being handled finish, and
+// prefetched ones go back to the queue when the channel closes.
 func (c *Consumer) Consume(
 	ctx context.Context,
 	queue string,
@@ -57,6 +59,8 @@ func (c *Consumer) Consume(
 
 	sem := make(chan struct{}, workers)
 	var wg sync.WaitGroup
+	// Handlers keep ctx's values but not its cancellation, so shutdown lets them finish
+	handlerCtx := context.WithoutCancel(ctx)
 
 	for {
 		select {
@@ -83,7 +87,7 @@ func (c *Consumer) Consume(
 					Headers:    mapFromTable(delivery.Headers),
 				}
 
-				if err := handler(ctx, msg); err != nil {
+				if err := handler(handlerCtx, msg); err != nil {
 					if messaging.IsRetryable(err) {
 						_ = delivery.Nack(false, true)
 						return
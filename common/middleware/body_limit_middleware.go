@@ -0,0 +1,61 @@
+package middleware
+
+import (
+	"io"
+	"net/http"
+
+	"ecommerce-be/common"
+	"ecommerce-be/common/config"
+	commonError "ecommerce-be/common/error"
+
+	"github.com/gin-gonic/gin"
+)
+
+// BodySizeLimit caps request bodies at maxBytes. A Content-Length above the limit is
+// rejected with 413 before the handler runs; a body without one, or one that lies,
+// fails with *http.MaxBytesError once reading passes the limit, which
+// HandleValidationError answers with the same 413.
+//
+// The router applies it with the default limit. Bulk route groups apply it again with
+// a larger limit, which replaces the default one since nothing has read the body yet.
+func BodySizeLimit(maxBytes int64) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.Request.ContentLength > maxBytes {
+			rejectBodyTooLarge(c)
+			return
+		}
+
+		body := c.Request.Body
+		if body == nil {
+			c.Next()
+			return
+		}
+		if limited, ok := body.(*limitedBody); ok {
+			body = limited.original
+		}
+		c.Request.Body = &limitedBody{
+			ReadCloser: http.MaxBytesReader(c.Writer, body, maxBytes),
+			original:   body,
+		}
+		c.Next()
+	}
+}
+
+// BulkBodySizeLimit raises the body limit of a bulk route, such as a bulk variant
+// update or an import, to the configured bulk limit
+func BulkBodySizeLimit() gin.HandlerFunc {
+	return BodySizeLimit(config.Get().RequestLimits.BulkMaxBodyBytes)
+}
+
+// limitedBody is a size-limited request body that remembers the body it wraps, so a
+// route group can swap in its own limit
+type limitedBody struct {
+	io.ReadCloser
+	original io.ReadCloser
+}
+
+func rejectBodyTooLarge(c *gin.Context) {
+	appErr := commonError.ErrRequestBodyTooLarge
+	common.ErrorWithCode(c, appErr.StatusCode, appErr.Message, appErr.Code)
+	c.Abort()
+}
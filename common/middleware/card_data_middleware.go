@@ -0,0 +1,73 @@
+package middleware
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+
+	"ecommerce-be/common"
+	"ecommerce-be/common/constants"
+	"ecommerce-be/common/log"
+	"ecommerce-be/common/reqctx"
+	"ecommerce-be/common/validator"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RejectCardData keeps payment endpoints out of PCI scope. Clients tokenize cards with the
+// gateway's hosted fields and send only the issued token, so any query value or body that
+// looks like a card number or carries a card number/security code field is rejected.
+// Request bodies on these routes are never written to the request log.
+func RejectCardData() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		reqctx.RedactRequestBody.Set(c, true)
+
+		for key, values := range c.Request.URL.Query() {
+			for _, value := range values {
+				if validator.IsSensitiveCardField(key) || validator.ContainsCardNumber(value) {
+					rejectCardData(c, "query:"+key)
+					return
+				}
+			}
+		}
+
+		if c.Request.Body != nil {
+			scanned, err := io.ReadAll(
+				io.LimitReader(c.Request.Body, constants.CARD_DATA_SCAN_MAX_BYTES),
+			)
+			if err != nil {
+				common.ErrorWithCode(
+					c,
+					http.StatusBadRequest,
+					constants.INVALID_REQUEST_FORMAT_MSG,
+					constants.VALIDATION_ERROR_CODE,
+				)
+				c.Abort()
+				return
+			}
+			// Restore the body, including anything beyond the scan limit, for handlers
+			c.Request.Body = io.NopCloser(
+				io.MultiReader(bytes.NewReader(scanned), c.Request.Body),
+			)
+
+			if field, found := validator.FindCardData(scanned); found {
+				rejectCardData(c, field)
+				return
+			}
+		}
+
+		c.Next()
+	}
+}
+
+// rejectCardData logs only the offending field, never the value
+func rejectCardData(c *gin.Context, field string) {
+	log.WarnWithContext(c, "Rejected payment request carrying card data in field: "+field)
+	common.ErrorWithCode(
+		c,
+		http.StatusBadRequest,
+		constants.CARD_DATA_NOT_ACCEPTED_MSG,
+		constants.CARD_DATA_NOT_ACCEPTED_CODE,
+	)
+	c.Abort()
+}
@@ -0,0 +1,128 @@
+package middleware
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"ecommerce-be/common/constants"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ConditionalGET adds ETag revalidation and browser caching to a public read route.
+// The handler's response is buffered; a 200 gets a weak ETag from a hash of its body
+// and a private Cache-Control with maxAgeSeconds (0 means revalidate on every use).
+// When If-None-Match already names that ETag the body is dropped and 304 is sent.
+//
+// Hashing the body rather than a row's updated_at keeps the ETag honest for responses
+// assembled from several records, e.g. a product with its variants, stock and
+// translations. Other statuses, redirects included, pass through unchanged.
+func ConditionalGET(maxAgeSeconds int) gin.HandlerFunc {
+	cacheControl := "private, no-cache"
+	if maxAgeSeconds > 0 {
+		cacheControl = fmt.Sprintf("private, max-age=%d", maxAgeSeconds)
+	}
+
+	return func(c *gin.Context) {
+		if c.Request.Method != http.MethodGet {
+			c.Next()
+			return
+		}
+
+		writer := &bufferedResponseWriter{ResponseWriter: c.Writer, status: http.StatusOK}
+		c.Writer = writer
+		c.Next()
+		c.Writer = writer.ResponseWriter
+
+		if writer.status != http.StatusOK {
+			writer.flush()
+			return
+		}
+
+		etag := weakETag(writer.body.Bytes())
+		header := c.Writer.Header()
+		header.Set(constants.ETAG_HEADER, etag)
+		header.Set(constants.CACHE_CONTROL_HEADER, cacheControl)
+		header.Set(constants.VARY_HEADER, constants.CONDITIONAL_GET_VARY)
+
+		if etagMatches(c.GetHeader(constants.IF_NONE_MATCH_HEADER), etag) {
+			header.Del("Content-Type")
+			header.Del("Content-Length")
+			c.Writer.WriteHeader(http.StatusNotModified)
+			c.Writer.WriteHeaderNow()
+			return
+		}
+		writer.flush()
+	}
+}
+
+// weakETag is a weak validator over the response body
+func weakETag(body []byte) string {
+	sum := sha256.Sum256(body)
+	return `W/"` + hex.EncodeToString(sum[:16]) + `"`
+}
+
+// etagMatches applies the weak comparison of If-None-Match: "*" or any listed tag
+// equal to etag once W/ prefixes are ignored
+func etagMatches(ifNoneMatch, etag string) bool {
+	if ifNoneMatch == "" {
+		return false
+	}
+	opaque := strings.TrimPrefix(etag, "W/")
+	for candidate := range strings.SplitSeq(ifNoneMatch, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == opaque {
+			return true
+		}
+	}
+	return false
+}
+
+// bufferedResponseWriter holds back the status and body of a response until the
+// conditional check has run; headers go straight to the underlying writer
+type bufferedResponseWriter struct {
+	gin.ResponseWriter
+	status int
+	body   bytes.Buffer
+}
+
+func (w *bufferedResponseWriter) WriteHeader(code int) {
+	if code > 0 {
+		w.status = code
+	}
+}
+
+func (w *bufferedResponseWriter) WriteHeaderNow() {}
+
+func (w *bufferedResponseWriter) Write(data []byte) (int, error) {
+	return w.body.Write(data)
+}
+
+func (w *bufferedResponseWriter) WriteString(s string) (int, error) {
+	return w.body.WriteString(s)
+}
+
+func (w *bufferedResponseWriter) Status() int {
+	return w.status
+}
+
+func (w *bufferedResponseWriter) Size() int {
+	return w.body.Len()
+}
+
+func (w *bufferedResponseWriter) Written() bool {
+	return w.body.Len() > 0
+}
+
+// Flush is a no-op: the response is only sent once the handler has finished
+func (w *bufferedResponseWriter) Flush() {}
+
+// flush sends the held status and body to the underlying writer
+func (w *bufferedResponseWriter) flush() {
+	w.ResponseWriter.WriteHeader(w.status)
+	_, _ = w.ResponseWriter.Write(w.body.Bytes())
+}
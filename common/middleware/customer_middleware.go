@@ -86,9 +86,8 @@ func CustomerAuth() gin.HandlerFunc {
 				return
 			}
 
-			// Set seller context and complete seller data for downstream handlers
-			c.Set("validated_seller_id", sellerID)
-			c.Set("seller_validation_data", sellerData)
+			// Set complete seller data for downstream handlers
+			auth.SellerValidation.Set(c, sellerData)
 		}
 
 		c.Next()
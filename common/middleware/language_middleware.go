@@ -0,0 +1,27 @@
+package middleware
+
+import (
+	"ecommerce-be/common/constants"
+	"ecommerce-be/common/i18n"
+	"ecommerce-be/common/reqctx"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Language negotiates the response language from the Accept-Language header
+// against the loaded translation files and stores it in the request context.
+// Response helpers read it to localize messages; Content-Language echoes the choice.
+// The raw preference list is kept too, for content translated beyond the catalogs.
+func Language() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		header := c.GetHeader(constants.ACCEPT_LANGUAGE_HEADER)
+		lang := i18n.Default().Negotiate(header)
+
+		reqctx.Language.Set(c, lang)
+		reqctx.PreferredLanguages.Set(c, i18n.PreferredLanguages(header))
+		c.Writer.Header().Set(constants.CONTENT_LANGUAGE_HEADER, lang)
+		c.Writer.Header().Add("Vary", constants.ACCEPT_LANGUAGE_HEADER)
+
+		c.Next()
+	}
+}
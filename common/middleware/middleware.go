@@ -11,6 +11,7 @@ import (
 	"ecommerce-be/common/config"
 	"ecommerce-be/common/constants"
 	"ecommerce-be/common/log"
+	"ecommerce-be/common/reqctx"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
@@ -48,13 +49,18 @@ func Logger() gin.HandlerFunc {
 
 		// Capture request body if extended logging is enabled
 		if extendedLogging {
-			// Read request body
+			// Read the head of the request body; the body size limit applies later, so
+			// only a bounded prefix is buffered here
 			if c.Request.Body != nil {
-				bodyBytes, err := io.ReadAll(c.Request.Body)
+				bodyBytes, err := io.ReadAll(
+					io.LimitReader(c.Request.Body, constants.REQUEST_LOG_BODY_MAX_BYTES),
+				)
 				if err == nil {
 					requestBody = string(bodyBytes)
-					// Restore request body for handlers to read
-					c.Request.Body = io.NopCloser(bytes.NewBuffer(bodyBytes))
+					// Restore request body, including anything beyond the logged head
+					c.Request.Body = io.NopCloser(
+						io.MultiReader(bytes.NewReader(bodyBytes), c.Request.Body),
+					)
 				}
 			}
 
@@ -83,24 +89,25 @@ func Logger() gin.HandlerFunc {
 		}
 
 		// Add correlation ID if present
-		if correlationID, exists := c.Get(constants.CORRELATION_ID_KEY); exists {
+		if correlationID, exists := reqctx.CorrelationID.Get(c); exists {
 			fields["correlationId"] = correlationID
 		}
 
 		// Add seller ID if present
-		if sellerID, exists := c.Get(constants.SELLER_ID_KEY); exists {
+		if sellerID, exists := reqctx.SellerID.Get(c); exists {
 			fields["sellerId"] = sellerID
 		}
 
 		// Add user ID if present
-		if userID, exists := c.Get(constants.USER_ID_KEY); exists {
+		if userID, exists := reqctx.UserID.Get(c); exists {
 			fields["userId"] = userID
 		}
 
 		// Add request/response body if extended logging is enabled
 		if extendedLogging {
-			// Add request body (truncate if too large)
-			if requestBody != "" {
+			// Add request body (truncate if too large); payment routes never log payloads
+			redactBody, _ := reqctx.RedactRequestBody.Get(c)
+			if requestBody != "" && !redactBody {
 				if len(requestBody) > 2000 {
 					fields["requestBody"] = requestBody[:2000] + "...[truncated]"
 				} else {
@@ -119,7 +126,7 @@ func Logger() gin.HandlerFunc {
 			}
 
 			// Add query parameters
-			if c.Request.URL.RawQuery != "" {
+			if c.Request.URL.RawQuery != "" && !redactBody {
 				fields["queryParams"] = c.Request.URL.RawQuery
 			}
 		}
@@ -163,7 +170,7 @@ func CorrelationID() gin.HandlerFunc {
 		}
 
 		// Set correlation ID in context for use throughout the request
-		c.Set(constants.CORRELATION_ID_KEY, correlationID)
+		reqctx.CorrelationID.Set(c, correlationID)
 
 		// Add correlation ID to response headers for traceability
 		c.Writer.Header().Set(constants.CORRELATION_ID_HEADER, correlationID)
@@ -184,7 +191,7 @@ func GenerateCorrelationID() gin.HandlerFunc {
 		}
 
 		// Set correlation ID in context
-		c.Set(constants.CORRELATION_ID_KEY, correlationID)
+		reqctx.CorrelationID.Set(c, correlationID)
 
 		// Add correlation ID to response headers
 		c.Writer.Header().Set(constants.CORRELATION_ID_HEADER, correlationID)
@@ -10,8 +10,11 @@ import (
 	"ecommerce-be/common/config"
 	"ecommerce-be/common/constants"
 	"ecommerce-be/common/db"
+	"ecommerce-be/common/log"
+	"ecommerce-be/common/reqctx"
 
 	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
 )
 
 // PublicAPIAuth middleware for public APIs that don't require JWT token
@@ -20,7 +23,8 @@ import (
 // This middleware:
 // 1. Checks if JWT token exists (Authorization header)
 // 2. If token does NOT exist:
-//   - Seller ID in X-Seller-ID header is MANDATORY
+//   - Seller ID is MANDATORY: taken from the X-Seller-ID header or, when the header
+//     is absent, resolved from the request Host via the seller's storefront domain
 //   - Validates seller using seller validation (active, subscription, etc.)
 //   - Stores seller ID and validation data in context
 //
@@ -72,52 +76,43 @@ func PublicAPIAuth() gin.HandlerFunc {
 		}
 
 		// Token does NOT exist - this is a public API call
-		// Seller ID is MANDATORY for multi-tenant isolation
-
-		// Extract seller ID from X-Seller-ID header
-		sellerIDHeader := c.GetHeader(constants.SELLER_ID_HEADER)
-
-		// Validate that seller ID is provided and not empty/whitespace
-		// Handles: "", "  ", null header
-		if sellerIDHeader == "" || len(strings.TrimSpace(sellerIDHeader)) == 0 {
-			common.ErrorWithCode(
-				c,
-				http.StatusBadRequest,
-				constants.SELLER_ID_REQUIRED_MSG,
-				constants.SELLER_ID_REQUIRED_CODE,
-			)
-			c.Abort()
-			return
-		}
-
-		// Trim whitespace before parsing
-		sellerIDHeader = strings.TrimSpace(sellerIDHeader)
-
-		// Parse seller ID to uint
-		// Handles: "abc", "null", "-1", "1.5", etc. → returns error
-		sellerID, err := strconv.ParseUint(sellerIDHeader, 10, 32)
-		if err != nil {
-			common.ErrorWithCode(
-				c,
-				http.StatusBadRequest,
-				constants.SELLER_ID_INVALID_MSG,
-				constants.SELLER_ID_INVALID_CODE,
-			)
-			c.Abort()
-			return
-		}
-
-		// Validate seller ID is greater than 0
-		// Handles: "0" → returns error
-		if sellerID == 0 {
-			common.ErrorWithCode(
-				c,
-				http.StatusBadRequest,
-				constants.SELLER_ID_INVALID_MSG,
-				constants.SELLER_ID_INVALID_CODE,
-			)
-			c.Abort()
-			return
+		// Seller ID is MANDATORY for multi-tenant isolation. An explicit X-Seller-ID
+		// header wins; without one the seller is resolved from the storefront domain.
+		sellerIDHeader := strings.TrimSpace(c.GetHeader(constants.SELLER_ID_HEADER))
+
+		var sellerID uint64
+		if sellerIDHeader == "" {
+			// Handles: "", "  ", null header on a host that is not a seller domain
+			domainSellerID := resolveSellerFromHost(c, database)
+			if domainSellerID == 0 {
+				common.ErrorWithCode(
+					c,
+					http.StatusBadRequest,
+					constants.SELLER_ID_REQUIRED_MSG,
+					constants.SELLER_ID_REQUIRED_CODE,
+				)
+				c.Abort()
+				return
+			}
+			sellerID = uint64(domainSellerID)
+		} else {
+			// Parse seller ID to uint
+			// Handles: "abc", "null", "-1", "1.5", etc. → returns error
+			parsedID, err := strconv.ParseUint(sellerIDHeader, 10, 32)
+
+			// Validate seller ID is greater than 0
+			// Handles: "0" → returns error
+			if err != nil || parsedID == 0 {
+				common.ErrorWithCode(
+					c,
+					http.StatusBadRequest,
+					constants.SELLER_ID_INVALID_MSG,
+					constants.SELLER_ID_INVALID_CODE,
+				)
+				c.Abort()
+				return
+			}
+			sellerID = parsedID
 		}
 
 		// Validate seller using the cached validation method
@@ -161,8 +156,21 @@ func PublicAPIAuth() gin.HandlerFunc {
 		}
 
 		// Store seller ID and validation data in context for downstream handlers
-		c.Set(constants.SELLER_ID_KEY, uint(sellerID))
+		reqctx.SellerID.Set(c, uint(sellerID))
+		auth.SellerValidation.Set(c, sellerData)
 
 		c.Next()
 	}
 }
+
+// resolveSellerFromHost returns the seller whose storefront domain the request was
+// sent to, or 0 if the host is not mapped. Lookup failures are logged and treated
+// as unmapped so the caller falls back to requiring X-Seller-ID.
+func resolveSellerFromHost(c *gin.Context, database *gorm.DB) uint {
+	sellerID, err := auth.ResolveSellerIDByHostCached(database, c.Request.Host)
+	if err != nil {
+		log.WarnWithContext(c, "resolveSellerFromHost: "+err.Error())
+		return 0
+	}
+	return sellerID
+}
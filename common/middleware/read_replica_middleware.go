@@ -0,0 +1,24 @@
+package middleware
+
+import (
+	"strings"
+
+	"ecommerce-be/common/constants"
+	"ecommerce-be/common/reqctx"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ReadReplica lets the route's reads be served by a database read replica, for
+// heavy read endpoints that tolerate replication lag. Writes and transactions still
+// use the primary. A client that must see its own recent writes sends
+// X-Read-Consistency: strong to keep the request on the primary.
+func ReadReplica() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		consistency := c.GetHeader(constants.READ_CONSISTENCY_HEADER)
+		if !strings.EqualFold(consistency, constants.READ_CONSISTENCY_STRONG) {
+			reqctx.ReadReplica.Set(c, true)
+		}
+		c.Next()
+	}
+}
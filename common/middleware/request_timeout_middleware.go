@@ -0,0 +1,44 @@
+package middleware
+
+import (
+	"context"
+	"time"
+
+	"ecommerce-be/common/config"
+
+	"github.com/gin-gonic/gin"
+)
+
+// requestParentKey holds the request context a deadline was derived from
+type requestParentKey struct{}
+
+// RequestTimeout puts a deadline of timeout on the request context. Handlers pass the
+// gin context down, and with the router's ContextWithFallback it reports this deadline,
+// so GORM queries, Redis commands and outbound HTTP calls made for the request are
+// cancelled once it passes instead of holding a worker and a connection.
+//
+// The router applies it with the default timeout. A route group applies it again to
+// replace that deadline, longer or shorter, rather than nest inside it.
+func RequestTimeout(timeout time.Duration) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		parent := c.Request.Context()
+		if original, ok := parent.Value(requestParentKey{}).(context.Context); ok {
+			parent = original
+		}
+
+		ctx, cancel := context.WithTimeout(
+			context.WithValue(parent, requestParentKey{}, parent),
+			timeout,
+		)
+		defer cancel()
+
+		c.Request = c.Request.WithContext(ctx)
+		c.Next()
+	}
+}
+
+// ReportRequestTimeout gives report routes, whose aggregations scan more rows than
+// regular reads, the configured report deadline
+func ReportRequestTimeout() gin.HandlerFunc {
+	return RequestTimeout(config.Get().Server.ReportRequestTimeout())
+}
@@ -82,7 +82,7 @@ func SellerAuth() gin.HandlerFunc {
 			}
 
 			// Set complete seller data for downstream handlers
-			c.Set("seller_validation_data", sellerData)
+			auth.SellerValidation.Set(c, sellerData)
 		}
 
 		c.Next()
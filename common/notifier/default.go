@@ -0,0 +1,39 @@
+package notifier
+
+import (
+	"context"
+	"sync/atomic"
+)
+
+// defaultNotifier holds the process-wide Notifier installed by the notification
+// module at startup.
+var defaultNotifier atomic.Value
+
+// SetDefault installs the process-wide Notifier. Modules the notification module
+// depends on (and so cannot import it) reach it through Default.
+func SetDefault(n Notifier) {
+	if n != nil {
+		defaultNotifier.Store(&n)
+	}
+}
+
+// Default returns a Notifier that forwards to the one installed with SetDefault,
+// resolved on every call. Until one is installed notifications are dropped.
+func Default() Notifier {
+	return deferredNotifier{}
+}
+
+type deferredNotifier struct{}
+
+func (deferredNotifier) Notify(
+	ctx context.Context,
+	event string,
+	userID uint,
+	payload map[string]any,
+) error {
+	n, ok := defaultNotifier.Load().(*Notifier)
+	if !ok {
+		return nil
+	}
+	return (*n).Notify(ctx, event, userID, payload)
+}
@@ -0,0 +1,17 @@
+package notifier
+
+import "context"
+
+// Notifier is the cross-module entry point for user notifications.
+//
+// Implementations live in the notification module and must not be imported from
+// common beyond this interface boundary. Event names are the
+// constants.NOTIFY_EVENT_* values; payload keys are the placeholders used by the
+// event's templates (e.g. "OrderNumber").
+type Notifier interface {
+	// Notify queues delivery of event to userID on every channel the user has
+	// enabled. Delivery happens asynchronously, so a nil error only means the
+	// notification was accepted. Callers MUST treat errors as best-effort and
+	// never fail the business operation because of them.
+	Notify(ctx context.Context, event string, userID uint, payload map[string]any) error
+}
@@ -0,0 +1,294 @@
+package openapi
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"ecommerce-be/common"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	specVersion     = "3.0.3"
+	jsonContentType = "application/json"
+)
+
+// Build assembles the OpenAPI document from the engine's route table. Routes registered through
+// a Group carry their annotations; any other route is still listed with its path parameters.
+func Build(info Info, routes gin.RoutesInfo) *Document {
+	b := &builder{
+		schemas:      NewSchemaRegistry(),
+		operationIDs: map[string]int{},
+		tags:         map[string]struct{}{},
+	}
+	doc := &Document{
+		OpenAPI: specVersion,
+		Info:    info,
+		Paths:   map[string]*PathItem{},
+	}
+
+	sorted := append(gin.RoutesInfo{}, routes...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Path != sorted[j].Path {
+			return sorted[i].Path < sorted[j].Path
+		}
+		return sorted[i].Method < sorted[j].Method
+	})
+
+	for _, route := range sorted {
+		if route.Method == http.MethodHead || route.Method == http.MethodOptions {
+			continue
+		}
+		op, ok := defaultRegistry.find(route.Method, route.Path)
+		if !ok {
+			op = &Operation{
+				Method:   route.Method,
+				Path:     route.Path,
+				tags:     []string{defaultTag(route.Path)},
+				handlers: gin.HandlersChain{route.HandlerFunc},
+			}
+		}
+
+		specPath, pathParams := convertPath(route.Path)
+		item, exists := doc.Paths[specPath]
+		if !exists {
+			item = &PathItem{}
+			doc.Paths[specPath] = item
+		}
+		(*item)[strings.ToLower(route.Method)] = b.operation(op, pathParams)
+	}
+
+	doc.Components = Components{
+		Schemas:         b.schemas.Schemas(),
+		SecuritySchemes: securitySchemes(),
+	}
+	doc.Tags = b.sortedTags()
+	return doc
+}
+
+type builder struct {
+	schemas      *SchemaRegistry
+	operationIDs map[string]int
+	tags         map[string]struct{}
+}
+
+func (b *builder) operation(op *Operation, pathParams []string) *OperationSpec {
+	spec := &OperationSpec{
+		Summary:     op.summary,
+		Description: op.description,
+		OperationID: b.operationID(op),
+		Tags:        op.tags,
+		Responses:   map[string]*Response{},
+	}
+	for _, tag := range op.tags {
+		b.tags[tag] = struct{}{}
+	}
+
+	for _, name := range pathParams {
+		spec.Parameters = append(spec.Parameters, Parameter{
+			Name:     name,
+			In:       "path",
+			Required: true,
+			Schema:   &Schema{Type: "string"},
+		})
+	}
+	for _, model := range op.query {
+		spec.Parameters = append(spec.Parameters, b.queryParameters(model)...)
+	}
+	spec.Parameters = append(spec.Parameters, op.params...)
+
+	if op.body != nil {
+		spec.RequestBody = &RequestBody{
+			Required: true,
+			Content:  jsonContent(b.schemas.SchemaOf(op.body)),
+		}
+	}
+
+	status := op.status
+	if status == 0 {
+		status = http.StatusOK
+	}
+	success := &Response{Description: http.StatusText(status)}
+	switch {
+	case status == http.StatusNoContent:
+	case op.produces != "":
+		success.Content = map[string]MediaType{
+			op.produces: {Schema: &Schema{Type: "string", Format: "binary"}},
+		}
+	default:
+		success.Content = jsonContent(b.successSchema(op))
+	}
+	spec.Responses[strconv.Itoa(status)] = success
+
+	auth, secured := resolveAuth(op.handlers)
+	if secured {
+		spec.Security = auth.Security
+		if auth.Role != "" {
+			spec.Description = strings.TrimSpace(spec.Description + "\n\n" +
+				fmt.Sprintf("Requires the %s role or higher.", auth.Role))
+		}
+	}
+	b.addErrorResponses(spec, secured, len(pathParams) > 0)
+	return spec
+}
+
+// successSchema wraps the documented model in the standard success envelope
+func (b *builder) successSchema(op *Operation) *Schema {
+	envelope := b.schemas.SchemaOf(common.Response{})
+	if op.response == nil {
+		return envelope
+	}
+
+	data := b.schemas.SchemaOf(op.response)
+	if op.dataField != "" {
+		data = &Schema{
+			Type:       "object",
+			Properties: map[string]*Schema{op.dataField: data},
+		}
+	}
+	return &Schema{AllOf: []*Schema{envelope, {
+		Type:       "object",
+		Properties: map[string]*Schema{"data": data},
+	}}}
+}
+
+// addErrorResponses documents the shared error envelope for the failures every handler can emit
+func (b *builder) addErrorResponses(spec *OperationSpec, secured, hasPathParams bool) {
+	errorSchema := b.schemas.SchemaOf(common.ErrorResponse{})
+	validationSchema := &Schema{AllOf: []*Schema{errorSchema, {
+		Type: "object",
+		Properties: map[string]*Schema{
+			"errors": b.schemas.SchemaOf([]common.ValidationError{}),
+		},
+	}}}
+
+	spec.Responses[strconv.Itoa(http.StatusBadRequest)] = &Response{
+		Description: http.StatusText(http.StatusBadRequest),
+		Content:     jsonContent(validationSchema),
+	}
+	statuses := []int{http.StatusInternalServerError}
+	if secured {
+		statuses = append(statuses, http.StatusUnauthorized, http.StatusForbidden)
+	}
+	if hasPathParams {
+		statuses = append(statuses, http.StatusNotFound)
+	}
+	for _, status := range statuses {
+		spec.Responses[strconv.Itoa(status)] = &Response{
+			Description: http.StatusText(status),
+			Content:     jsonContent(errorSchema),
+		}
+	}
+}
+
+// queryParameters expands a query binding struct into one parameter per form field
+func (b *builder) queryParameters(model any) []Parameter {
+	if model == nil {
+		return nil
+	}
+	t := reflect.TypeOf(model)
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return nil
+	}
+
+	var params []Parameter
+	b.collectQueryFields(t, &params)
+	return params
+}
+
+func (b *builder) collectQueryFields(t reflect.Type, params *[]Parameter) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		fieldType := field.Type
+		for fieldType.Kind() == reflect.Pointer {
+			fieldType = fieldType.Elem()
+		}
+
+		name, _, _ := strings.Cut(field.Tag.Get("form"), ",")
+		if field.Anonymous && name == "" && fieldType.Kind() == reflect.Struct {
+			b.collectQueryFields(fieldType, params)
+			continue
+		}
+		// path-bound fields are already documented as path parameters
+		if !field.IsExported() || name == "-" || field.Tag.Get("uri") != "" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+
+		schema := b.schemas.schemaForType(field.Type)
+		applyBindingRules(schema, field.Tag.Get("binding"))
+		*params = append(*params, Parameter{
+			Name:     name,
+			In:       "query",
+			Required: isRequired(field),
+			Schema:   schema,
+		})
+	}
+}
+
+// operationID keeps handler-derived ids unique across modules
+func (b *builder) operationID(op *Operation) string {
+	base := ""
+	if len(op.handlers) > 0 {
+		base = operationName(op.handlers[len(op.handlers)-1])
+	}
+	if base == "" {
+		base = strings.ToLower(op.Method) + strings.ReplaceAll(op.Path, "/", "_")
+	}
+
+	b.operationIDs[base]++
+	if n := b.operationIDs[base]; n > 1 {
+		return base + strconv.Itoa(n)
+	}
+	return base
+}
+
+func (b *builder) sortedTags() []Tag {
+	names := make([]string, 0, len(b.tags))
+	for name := range b.tags {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	tags := make([]Tag, 0, len(names))
+	for _, name := range names {
+		tags = append(tags, Tag{Name: name})
+	}
+	return tags
+}
+
+// convertPath rewrites gin parameters (:id, *path) into OpenAPI templates ({id}, {path})
+func convertPath(ginPath string) (string, []string) {
+	segments := strings.Split(ginPath, "/")
+	var params []string
+	for i, segment := range segments {
+		if len(segment) > 1 && (segment[0] == ':' || segment[0] == '*') {
+			params = append(params, segment[1:])
+			segments[i] = "{" + segment[1:] + "}"
+		}
+	}
+	return strings.Join(segments, "/"), params
+}
+
+// defaultTag derives a tag from the module segment of /api/<module>/...
+func defaultTag(ginPath string) string {
+	segments := strings.Split(strings.Trim(ginPath, "/"), "/")
+	if len(segments) > 1 && segments[0] == "api" {
+		return segments[1]
+	}
+	return segments[0]
+}
+
+func jsonContent(schema *Schema) map[string]MediaType {
+	return map[string]MediaType{jsonContentType: {Schema: schema}}
+}
@@ -0,0 +1,190 @@
+package openapi
+
+import (
+	"net/http"
+	"path"
+	"strings"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Group wraps a gin.RouterGroup so every route registered through it is recorded for the spec.
+// Route files keep registering handlers as before; the returned Operation can be annotated
+// with request and response models.
+type Group struct {
+	*gin.RouterGroup
+	tags []string
+}
+
+// NewGroup wraps a router group, tagging its operations for the generated documentation
+func NewGroup(rg *gin.RouterGroup, tags ...string) *Group {
+	return &Group{RouterGroup: rg, tags: tags}
+}
+
+// Group creates a documented sub-group that inherits the parent's tags
+func (g *Group) Group(relativePath string, handlers ...gin.HandlerFunc) *Group {
+	return &Group{RouterGroup: g.RouterGroup.Group(relativePath, handlers...), tags: g.tags}
+}
+
+// Handle registers the route with gin and records it for the spec
+func (g *Group) Handle(method, relativePath string, handlers ...gin.HandlerFunc) *Operation {
+	g.RouterGroup.Handle(method, relativePath, handlers...)
+
+	chain := make(gin.HandlersChain, 0, len(g.RouterGroup.Handlers)+len(handlers))
+	chain = append(chain, g.RouterGroup.Handlers...)
+	chain = append(chain, handlers...)
+
+	op := &Operation{
+		Method:   method,
+		Path:     joinPaths(g.BasePath(), relativePath),
+		tags:     g.tags,
+		handlers: chain,
+	}
+	defaultRegistry.add(op)
+	return op
+}
+
+func (g *Group) GET(relativePath string, handlers ...gin.HandlerFunc) *Operation {
+	return g.Handle(http.MethodGet, relativePath, handlers...)
+}
+
+func (g *Group) POST(relativePath string, handlers ...gin.HandlerFunc) *Operation {
+	return g.Handle(http.MethodPost, relativePath, handlers...)
+}
+
+func (g *Group) PUT(relativePath string, handlers ...gin.HandlerFunc) *Operation {
+	return g.Handle(http.MethodPut, relativePath, handlers...)
+}
+
+func (g *Group) PATCH(relativePath string, handlers ...gin.HandlerFunc) *Operation {
+	return g.Handle(http.MethodPatch, relativePath, handlers...)
+}
+
+func (g *Group) DELETE(relativePath string, handlers ...gin.HandlerFunc) *Operation {
+	return g.Handle(http.MethodDelete, relativePath, handlers...)
+}
+
+// Operation holds the documentation recorded for a single registered route
+type Operation struct {
+	Method string
+	Path   string
+
+	summary     string
+	description string
+	tags        []string
+	handlers    gin.HandlersChain
+	query       []any
+	body        any
+	params      []Parameter
+	status      int
+	response    any
+	dataField   string
+	produces    string
+}
+
+// Summary sets the one-line summary shown in the documentation
+func (o *Operation) Summary(summary string) *Operation {
+	o.summary = summary
+	return o
+}
+
+// Description sets the long-form description of the operation
+func (o *Operation) Description(description string) *Operation {
+	o.description = description
+	return o
+}
+
+// Query documents the query parameters bound from the structs' form tags
+func (o *Operation) Query(models ...any) *Operation {
+	o.query = append(o.query, models...)
+	return o
+}
+
+// Body documents the JSON request body
+func (o *Operation) Body(model any) *Operation {
+	o.body = model
+	return o
+}
+
+// QueryParam documents an optional query value read directly with c.Query
+func (o *Operation) QueryParam(name, description string) *Operation {
+	o.params = append(o.params, Parameter{
+		Name:        name,
+		In:          "query",
+		Description: description,
+		Schema:      &Schema{Type: "string"},
+	})
+	return o
+}
+
+// Header documents a required request header, e.g. a webhook signature
+func (o *Operation) Header(name, description string) *Operation {
+	o.params = append(o.params, Parameter{
+		Name:        name,
+		In:          "header",
+		Description: description,
+		Required:    true,
+		Schema:      &Schema{Type: "string"},
+	})
+	return o
+}
+
+// Returns documents the success status and the model placed in the response data
+func (o *Operation) Returns(status int, model any) *Operation {
+	o.status = status
+	o.response = model
+	o.dataField = ""
+	return o
+}
+
+// ReturnsField documents responses that wrap the model under a data key (SuccessWithData)
+func (o *Operation) ReturnsField(status int, field string, model any) *Operation {
+	o.status = status
+	o.response = model
+	o.dataField = field
+	return o
+}
+
+// Produces documents a non-JSON success body, e.g. a CSV export or file download
+func (o *Operation) Produces(contentType string) *Operation {
+	o.produces = contentType
+	return o
+}
+
+// registry collects documented operations keyed by method and full path
+type registry struct {
+	mu         sync.RWMutex
+	operations map[string]*Operation
+}
+
+var defaultRegistry = &registry{operations: map[string]*Operation{}}
+
+func (r *registry) add(op *Operation) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.operations[routeKey(op.Method, op.Path)] = op
+}
+
+func (r *registry) find(method, fullPath string) (*Operation, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	op, ok := r.operations[routeKey(method, fullPath)]
+	return op, ok
+}
+
+func routeKey(method, fullPath string) string {
+	return method + " " + fullPath
+}
+
+// joinPaths mirrors gin's path joining so recorded paths match the engine's route table
+func joinPaths(absolutePath, relativePath string) string {
+	if relativePath == "" {
+		return absolutePath
+	}
+	finalPath := path.Join(absolutePath, relativePath)
+	if strings.HasSuffix(relativePath, "/") && !strings.HasSuffix(finalPath, "/") {
+		return finalPath + "/"
+	}
+	return finalPath
+}
@@ -0,0 +1,41 @@
+package openapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"sync"
+
+	"ecommerce-be/common/constants"
+	"ecommerce-be/common/log"
+
+	"github.com/gin-gonic/gin"
+)
+
+// DefaultInfo describes this API in the generated document
+var DefaultInfo = Info{
+	Title:       "Ecommerce API",
+	Version:     "1.0.0",
+	Description: "Generated from the route registrations of every module.",
+}
+
+// RegisterRoutes serves the generated spec. It must run after all modules have registered
+// their routes; the document is built once on first request from the engine's route table.
+func RegisterRoutes(router *gin.Engine) {
+	var (
+		once sync.Once
+		body []byte
+		err  error
+	)
+
+	router.GET(constants.APIOpenAPISpec, func(c *gin.Context) {
+		once.Do(func() {
+			body, err = json.Marshal(Build(DefaultInfo, router.Routes()))
+		})
+		if err != nil {
+			log.ErrorWithContext(c, "openapi: failed to build spec", err)
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+		c.Data(http.StatusOK, jsonContentType, body)
+	})
+}
@@ -0,0 +1,211 @@
+package openapi
+
+import (
+	"encoding/json"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const modulePathPrefix = "ecommerce-be/"
+
+var (
+	timeType         = reflect.TypeOf(time.Time{})
+	rawMessageType   = reflect.TypeOf(json.RawMessage{})
+	invalidNameChars = regexp.MustCompile(`[^A-Za-z0-9._-]`)
+)
+
+// SchemaRegistry converts Go types into OpenAPI schemas, collecting named structs as components
+type SchemaRegistry struct {
+	schemas map[string]*Schema
+	names   map[reflect.Type]string
+}
+
+// NewSchemaRegistry creates an empty SchemaRegistry
+func NewSchemaRegistry() *SchemaRegistry {
+	return &SchemaRegistry{
+		schemas: map[string]*Schema{},
+		names:   map[reflect.Type]string{},
+	}
+}
+
+// Schemas returns the component schemas collected so far
+func (r *SchemaRegistry) Schemas() map[string]*Schema {
+	return r.schemas
+}
+
+// SchemaOf returns the schema for the type of v; named structs are emitted as $ref components
+func (r *SchemaRegistry) SchemaOf(v any) *Schema {
+	if v == nil {
+		return &Schema{}
+	}
+	return r.schemaForType(reflect.TypeOf(v))
+}
+
+func (r *SchemaRegistry) schemaForType(t reflect.Type) *Schema {
+	nullable := false
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+		nullable = true
+	}
+
+	schema := r.baseSchema(t)
+	if nullable && schema.Ref == "" {
+		schema.Nullable = true
+	}
+	return schema
+}
+
+func (r *SchemaRegistry) baseSchema(t reflect.Type) *Schema {
+	switch t {
+	case timeType:
+		return &Schema{Type: "string", Format: "date-time"}
+	case rawMessageType:
+		return &Schema{}
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return &Schema{Type: "integer", Format: "int32"}
+	case reflect.Int64, reflect.Uint64:
+		return &Schema{Type: "integer", Format: "int64"}
+	case reflect.Float32:
+		return &Schema{Type: "number", Format: "float"}
+	case reflect.Float64:
+		return &Schema{Type: "number", Format: "double"}
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &Schema{Type: "string", Format: "byte"}
+		}
+		return &Schema{Type: "array", Items: r.schemaForType(t.Elem())}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: r.schemaForType(t.Elem())}
+	case reflect.Struct:
+		return r.structSchema(t)
+	default:
+		// interfaces and other dynamic values accept any JSON
+		return &Schema{}
+	}
+}
+
+// structSchema registers named structs once and references them, inlining anonymous ones
+func (r *SchemaRegistry) structSchema(t reflect.Type) *Schema {
+	if t.Name() == "" {
+		return r.buildObject(t)
+	}
+
+	if name, ok := r.names[t]; ok {
+		return &Schema{Ref: "#/components/schemas/" + name}
+	}
+
+	name := r.componentName(t)
+	r.names[t] = name
+	// reserve the slot first so self-referencing types terminate
+	r.schemas[name] = &Schema{}
+	*r.schemas[name] = *r.buildObject(t)
+	return &Schema{Ref: "#/components/schemas/" + name}
+}
+
+// componentName qualifies the type with its top-level module, e.g. order.OrderResponse
+func (r *SchemaRegistry) componentName(t reflect.Type) string {
+	pkg := strings.TrimPrefix(t.PkgPath(), modulePathPrefix)
+	if idx := strings.Index(pkg, "/"); idx >= 0 {
+		pkg = pkg[:idx]
+	}
+
+	base := invalidNameChars.ReplaceAllString(pkg+"."+t.Name(), "_")
+	name := base
+	for i := 2; ; i++ {
+		if _, taken := r.schemas[name]; !taken {
+			return name
+		}
+		name = base + "_" + strconv.Itoa(i)
+	}
+}
+
+func (r *SchemaRegistry) buildObject(t reflect.Type) *Schema {
+	schema := &Schema{Type: "object", Properties: map[string]*Schema{}}
+	r.collectFields(t, schema)
+	return schema
+}
+
+// collectFields walks exported fields, flattening embedded structs the way encoding/json does
+func (r *SchemaRegistry) collectFields(t reflect.Type, schema *Schema) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name, opts, skip := jsonFieldName(field)
+		if skip {
+			continue
+		}
+
+		fieldType := field.Type
+		for fieldType.Kind() == reflect.Pointer {
+			fieldType = fieldType.Elem()
+		}
+		if field.Anonymous && !hasJSONName(field) && fieldType.Kind() == reflect.Struct {
+			r.collectFields(fieldType, schema)
+			continue
+		}
+
+		prop := r.schemaForType(field.Type)
+		if strings.Contains(opts, "string") && prop.Ref == "" {
+			prop = &Schema{Type: "string", Nullable: prop.Nullable}
+		}
+		applyBindingRules(prop, field.Tag.Get("binding"))
+
+		schema.Properties[name] = prop
+		if isRequired(field) {
+			schema.Required = append(schema.Required, name)
+		}
+	}
+}
+
+// jsonFieldName resolves the JSON property name; skip is true for unexported or ignored fields
+func jsonFieldName(field reflect.StructField) (string, string, bool) {
+	if !field.IsExported() && !field.Anonymous {
+		return "", "", true
+	}
+	tag := field.Tag.Get("json")
+	if tag == "-" {
+		return "", "", true
+	}
+	name, opts, _ := strings.Cut(tag, ",")
+	if name == "" {
+		name = field.Name
+	}
+	return name, opts, false
+}
+
+func hasJSONName(field reflect.StructField) bool {
+	name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+	return name != ""
+}
+
+// isRequired reports whether the field carries a binding:"required" validation rule
+func isRequired(field reflect.StructField) bool {
+	for _, rule := range strings.Split(field.Tag.Get("binding"), ",") {
+		if rule == "required" {
+			return true
+		}
+	}
+	return false
+}
+
+// applyBindingRules surfaces oneof validations as enums on scalar properties
+func applyBindingRules(prop *Schema, binding string) {
+	if prop.Ref != "" || prop.Type == "array" {
+		return
+	}
+	for _, rule := range strings.Split(binding, ",") {
+		if values, ok := strings.CutPrefix(rule, "oneof="); ok {
+			prop.Enum = strings.Fields(values)
+		}
+	}
+}
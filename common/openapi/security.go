@@ -0,0 +1,112 @@
+package openapi
+
+import (
+	"reflect"
+	"regexp"
+	"runtime"
+	"strings"
+	"sync"
+
+	"ecommerce-be/common/constants"
+	"ecommerce-be/common/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	BearerAuthScheme = "bearerAuth"
+	SellerIDScheme   = "sellerId"
+)
+
+// closureSuffix matches the compiler-generated suffix of closures returned by constructors
+var closureSuffix = regexp.MustCompile(`(\.func\d+)+$`)
+
+// Auth is the access rule a middleware enforces on the routes it guards
+type Auth struct {
+	Security []SecurityRequirement
+	Role     string
+}
+
+var (
+	authMu          sync.RWMutex
+	authMiddlewares = map[string]Auth{
+		funcName(middleware.CustomerAuth): {
+			Security: []SecurityRequirement{{BearerAuthScheme: {}}},
+			Role:     constants.CUSTOMER_ROLE_NAME,
+		},
+		funcName(middleware.SellerAuth): {
+			Security: []SecurityRequirement{{BearerAuthScheme: {}}},
+			Role:     constants.SELLER_ROLE_NAME,
+		},
+		funcName(middleware.AdminAuth): {
+			Security: []SecurityRequirement{{BearerAuthScheme: {}}},
+			Role:     constants.ADMIN_ROLE_NAME,
+		},
+		// Public storefront APIs accept either a token or the tenant seller header
+		funcName(middleware.PublicAPIAuth): {
+			Security: []SecurityRequirement{{BearerAuthScheme: {}}, {SellerIDScheme: {}}},
+		},
+	}
+)
+
+// RegisterAuthMiddleware maps a middleware constructor to the rule its handlers enforce
+func RegisterAuthMiddleware(constructor any, auth Auth) {
+	authMu.Lock()
+	defer authMu.Unlock()
+	authMiddlewares[funcName(constructor)] = auth
+}
+
+// securitySchemes lists every scheme referenced by the registered auth middlewares
+func securitySchemes() map[string]*SecurityScheme {
+	return map[string]*SecurityScheme{
+		BearerAuthScheme: {
+			Type:         "http",
+			Scheme:       "bearer",
+			BearerFormat: "JWT",
+		},
+		SellerIDScheme: {
+			Type:        "apiKey",
+			In:          "header",
+			Name:        constants.SELLER_ID_HEADER,
+			Description: "Tenant seller for anonymous requests; optional on custom storefront domains",
+		},
+	}
+}
+
+// resolveAuth returns the rule of the innermost auth middleware in a handler chain
+func resolveAuth(handlers gin.HandlersChain) (Auth, bool) {
+	authMu.RLock()
+	defer authMu.RUnlock()
+
+	var resolved Auth
+	found := false
+	for _, h := range handlers {
+		name := closureSuffix.ReplaceAllString(funcName(h), "")
+		if auth, ok := authMiddlewares[name]; ok {
+			resolved = auth
+			found = true
+		}
+	}
+	return resolved, found
+}
+
+// funcName returns the fully qualified symbol name of a function value
+func funcName(fn any) string {
+	v := reflect.ValueOf(fn)
+	if v.Kind() != reflect.Func || v.IsNil() {
+		return ""
+	}
+	if f := runtime.FuncForPC(v.Pointer()); f != nil {
+		return f.Name()
+	}
+	return ""
+}
+
+// operationName derives an operation id from the final handler, e.g. CreateOrder
+func operationName(handler any) string {
+	name := strings.TrimSuffix(funcName(handler), "-fm")
+	if idx := strings.LastIndex(name, "."); idx >= 0 {
+		name = name[idx+1:]
+	}
+	return name
+}
@@ -0,0 +1,78 @@
+package reqctx
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Caller identity, set by the auth middleware from the access token
+var (
+	UserID    = NewKey[uint]("user_id")
+	Email     = NewKey[string]("email")
+	RoleID    = NewKey[uint]("role_id")
+	RoleName  = NewKey[string]("role_name")
+	RoleLevel = NewKey[uint]("role_level")
+	// SellerID is the seller the request acts for: the caller's own seller, the
+	// X-Seller-ID header on customer routes or the API key's seller
+	SellerID = NewKey[uint]("seller_id")
+	// DelegatedTokenID is set when the request authenticated with a delegated token
+	DelegatedTokenID = NewKey[uint]("delegated_token_id")
+	// Impersonation is set when an admin acts as a seller with an impersonation token
+	Impersonation = NewKey[ImpersonationInfo]("impersonation")
+)
+
+// Request metadata
+var (
+	CorrelationID = NewKey[string]("correlation_id")
+	// Language is the negotiated response language
+	Language = NewKey[string]("language")
+	// PreferredLanguages are the Accept-Language tags in preference order
+	PreferredLanguages = NewKey[[]string]("preferredLanguages")
+	// RedactRequestBody keeps the request body out of the request log
+	RedactRequestBody = NewKey[bool]("redactRequestBody")
+	// ReadReplica lets reads outside transactions be served by a database read replica
+	ReadReplica = NewKey[bool]("readReplica")
+)
+
+// Identity is the authenticated caller of a request
+type Identity struct {
+	UserID    uint
+	Email     string
+	RoleID    uint
+	RoleName  string
+	RoleLevel uint
+	SellerID  *uint
+}
+
+// ImpersonationInfo identifies the admin behind an impersonated request
+type ImpersonationInfo struct {
+	SessionID   uint
+	AdminUserID uint
+	AdminEmail  string
+}
+
+// SetIdentity stores the caller's identity on a gin request
+func SetIdentity(c *gin.Context, identity Identity) {
+	UserID.Set(c, identity.UserID)
+	Email.Set(c, identity.Email)
+	RoleID.Set(c, identity.RoleID)
+	RoleName.Set(c, identity.RoleName)
+	RoleLevel.Set(c, identity.RoleLevel)
+	if identity.SellerID != nil {
+		SellerID.Set(c, *identity.SellerID)
+	}
+}
+
+// WithIdentity returns a copy of ctx carrying the caller's identity
+func WithIdentity(ctx context.Context, identity Identity) context.Context {
+	ctx = UserID.With(ctx, identity.UserID)
+	ctx = Email.With(ctx, identity.Email)
+	ctx = RoleID.With(ctx, identity.RoleID)
+	ctx = RoleName.With(ctx, identity.RoleName)
+	ctx = RoleLevel.With(ctx, identity.RoleLevel)
+	if identity.SellerID != nil {
+		ctx = SellerID.With(ctx, *identity.SellerID)
+	}
+	return ctx
+}
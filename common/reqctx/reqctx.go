@@ -0,0 +1,68 @@
+// Package reqctx holds the typed keys of request metadata (caller identity,
+// correlation ID, language) and the accessors middleware and handlers use to set
+// and read them. A value can only be read back with the type it was stored with,
+// so a handler can no longer type-assert a missing or mistyped context value.
+package reqctx
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Key is a typed request metadata key. On a *gin.Context the value is kept in the
+// gin key store under the key's name; on other contexts it is a context value
+// keyed by the Key itself, which no other package can collide with.
+type Key[T any] struct {
+	name string
+}
+
+// NewKey declares a request metadata key. Names must be unique across the process.
+func NewKey[T any](name string) Key[T] {
+	return Key[T]{name: name}
+}
+
+// Name returns the key's name in the gin key store
+func (k Key[T]) Name() string {
+	return k.name
+}
+
+// Set stores value on a gin request
+func (k Key[T]) Set(c *gin.Context, value T) {
+	c.Set(k.name, value)
+}
+
+// With returns a copy of ctx carrying value. Use it for contexts that are not a
+// gin request, e.g. gRPC calls and background jobs.
+func (k Key[T]) With(ctx context.Context, value T) context.Context {
+	return context.WithValue(ctx, k, value)
+}
+
+// Get returns the value stored under k. It finds values set with With anywhere in
+// the context chain and values set with Set on the gin request the context was
+// derived from, e.g. inside a transaction started from a handler.
+func (k Key[T]) Get(ctx context.Context) (T, bool) {
+	var zero T
+	if ctx == nil {
+		return zero, false
+	}
+	if value, ok := ctx.Value(k).(T); ok {
+		return value, true
+	}
+	if c := ginContext(ctx); c != nil {
+		if raw, exists := c.Get(k.name); exists {
+			value, ok := raw.(T)
+			return value, ok
+		}
+	}
+	return zero, false
+}
+
+// ginContext returns the gin request ctx is or was derived from, nil if none
+func ginContext(ctx context.Context) *gin.Context {
+	if c, ok := ctx.(*gin.Context); ok {
+		return c
+	}
+	c, _ := ctx.Value(gin.ContextKey).(*gin.Context)
+	return c
+}
@@ -1,6 +1,12 @@
 package common
 
 import (
+	"net/http"
+
+	commonError "ecommerce-be/common/error"
+	"ecommerce-be/common/i18n"
+	"ecommerce-be/common/reqctx"
+
 	"github.com/gin-gonic/gin"
 )
 
@@ -9,20 +15,48 @@ type Response struct {
 	Success bool        `json:"success"`
 	Message string      `json:"message"`
 	Data    any `json:"data,omitempty"`
+
+	// ImpersonatedBy is set when an admin made the request as the seller
+	ImpersonatedBy *ImpersonationMarker `json:"impersonatedBy,omitempty"`
+}
+
+// ImpersonationMarker identifies the admin behind an impersonated request
+type ImpersonationMarker struct {
+	AdminUserID uint   `json:"adminUserId"`
+	AdminEmail  string `json:"adminEmail"`
+	SessionID   uint   `json:"sessionId"`
 }
 
-// ErrorResponse includes additional error details
+// ErrorResponse is an RFC 7807 problem+json document. Code is the machine-readable
+// error code clients branch on; Success, Message and Errors are kept for clients of
+// the original error format, with Message repeating Detail.
 type ErrorResponse struct {
-	Success bool        `json:"success"`
-	Message string      `json:"message"`
-	Errors  any `json:"errors,omitempty"`
-	Code    string      `json:"code,omitempty"`
+	Type     string `json:"type"`
+	Title    string `json:"title"`
+	Status   int    `json:"status"`
+	Detail   string `json:"detail,omitempty"`
+	Instance string `json:"instance,omitempty"`
+	Code     string `json:"code"`
+	Success  bool   `json:"success"`
+	Message  string `json:"message"`
+	Errors   any    `json:"errors,omitempty"`
+
+	ImpersonatedBy *ImpersonationMarker `json:"impersonatedBy,omitempty"`
 }
 
+// PROBLEM_JSON_CONTENT_TYPE is the media type of error responses
+const PROBLEM_JSON_CONTENT_TYPE = "application/problem+json"
+
 // ValidationError represents a single field error
 type ValidationError struct {
-	Field   string `json:"field"`
+	// Field is the JSON path of the offending value, e.g. items[0].quantity
+	Field string `json:"field"`
+	// Rule is the failed validator tag (required, min, ...) or type/json/unknown
+	// for payloads that could not be decoded
+	Rule    string `json:"rule,omitempty"`
 	Message string `json:"message"`
+	// Param is the rule argument, e.g. 3 for min=3
+	Param string `json:"param,omitempty"`
 }
 
 // ============================================================================
@@ -84,11 +118,13 @@ func NewPaginationResponse(page, pageSize int, total int64) PaginationResponse {
 }
 
 // SuccessResponse sends a successful API response
+// Messages are localized into the language negotiated for the request.
 func SuccessResponse(c *gin.Context, statusCode int, message string, data any) {
 	c.JSON(statusCode, Response{
-		Success: true,
-		Message: message,
-		Data:    data,
+		Success:        true,
+		Message:        i18n.Localize(c, message),
+		Data:           data,
+		ImpersonatedBy: impersonationMarker(c),
 	})
 }
 
@@ -100,27 +136,64 @@ func ErrorWithValidation(
 	errors []ValidationError,
 	code string,
 ) {
-	c.JSON(statusCode, ErrorResponse{
-		Success: false,
-		Message: message,
-		Errors:  errors,
-		Code:    code,
-	})
+	writeProblem(c, statusCode, code, i18n.LocalizeError(c, code, message), errors)
 }
 
 // ErrorWithCode sends an error response with an error code
 func ErrorWithCode(c *gin.Context, statusCode int, message string, code string) {
-	c.JSON(statusCode, ErrorResponse{
-		Success: false,
-		Message: message,
-		Code:    code,
-	})
+	writeProblem(c, statusCode, code, i18n.LocalizeError(c, code, message), nil)
 }
 
-// ErrorResponse sends a generic error response
+// ErrorWithDetails sends an error response with an error code and details for the client
+func ErrorWithDetails(c *gin.Context, statusCode int, message string, code string, details any) {
+	writeProblem(c, statusCode, code, i18n.LocalizeError(c, code, message), details)
+}
+
+// ErrorResponse sends a generic error response. The code is derived from the status.
 func ErrorResp(c *gin.Context, statusCode int, message string) {
-	c.JSON(statusCode, ErrorResponse{
+	writeProblem(c, statusCode, "", i18n.Localize(c, message), nil)
+}
+
+// writeProblem renders an error as problem+json. Errors without a code get the
+// generic code of their status; the title comes from the error catalog.
+func writeProblem(c *gin.Context, statusCode int, code string, detail string, errors any) {
+	if code == "" {
+		code = commonError.CodeForStatus(statusCode)
+	}
+	title := http.StatusText(statusCode)
+	if entry, ok := commonError.Lookup(code); ok {
+		title = entry.Title
+	}
+
+	problem := ErrorResponse{
+		Type:    commonError.ProblemType(code),
+		Title:   i18n.LocalizeError(c, code, title),
+		Status:  statusCode,
+		Detail:  detail,
+		Code:    code,
 		Success: false,
-		Message: message,
-	})
+		Message: detail,
+		Errors:  errors,
+	}
+	problem.ImpersonatedBy = impersonationMarker(c)
+	if c.Request != nil && c.Request.URL != nil {
+		problem.Instance = c.Request.URL.Path
+	}
+
+	c.Header("Content-Type", PROBLEM_JSON_CONTENT_TYPE)
+	c.JSON(statusCode, problem)
+}
+
+// impersonationMarker returns the marker for requests made with an impersonation
+// token, nil otherwise
+func impersonationMarker(c *gin.Context) *ImpersonationMarker {
+	info, ok := reqctx.Impersonation.Get(c)
+	if !ok {
+		return nil
+	}
+	return &ImpersonationMarker{
+		AdminUserID: info.AdminUserID,
+		AdminEmail:  info.AdminEmail,
+		SessionID:   info.SessionID,
+	}
 }
@@ -0,0 +1,188 @@
+package scheduler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+const (
+	// deadLetterJobsKey orders dead-lettered job IDs by failure time
+	deadLetterJobsKey = "dead_letter_jobs"
+	// deadLetterDataKey maps a dead-lettered job ID to its DeadLetterJob JSON
+	deadLetterDataKey = "dead_letter_job_data"
+)
+
+// DeadLetterJob is a job that failed its last allowed attempt, or failed permanently
+type DeadLetterJob struct {
+	ScheduledJob
+	FailedAt time.Time `json:"failedAt"`
+}
+
+// queueKey is the sorted set holding due times of jobs of a priority. Normal jobs
+// keep the original "delayed_jobs" set.
+func queueKey(priority Priority) string {
+	if priority == PriorityNormal {
+		return delayedJobsKey
+	}
+	return delayedJobsKey + ":" + string(priority)
+}
+
+// enqueueJob queues data, the JSON of job, to run after the delay and stores it under
+// the job's key so it can be cancelled
+func enqueueJob(
+	ctx context.Context,
+	pipe redis.Pipeliner,
+	job ScheduledJob,
+	data []byte,
+	after time.Duration,
+) {
+	pipe.Set(ctx, scheduledJobKeyPrefix+job.JobID.String(), data, after+time.Hour)
+	pipe.ZAdd(ctx, queueKey(job.queuePriority()), &redis.Z{
+		Score:  float64(time.Now().Add(after).Unix()),
+		Member: data,
+	})
+}
+
+// retryJob queues a failed job's next attempt after its backoff
+func retryJob(ctx context.Context, rdb redis.UniversalClient, job ScheduledJob) error {
+	data, err := json.Marshal(job)
+	if err != nil {
+		return fmt.Errorf("failed to marshal job: %w", err)
+	}
+	_, err = rdb.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		enqueueJob(ctx, pipe, job, data, retryDelay(job))
+		return nil
+	})
+	return err
+}
+
+// deadLetterJob moves a failed job to the dead-letter queue
+func deadLetterJob(ctx context.Context, rdb redis.UniversalClient, job ScheduledJob) error {
+	data, err := json.Marshal(DeadLetterJob{ScheduledJob: job, FailedAt: time.Now().UTC()})
+	if err != nil {
+		return fmt.Errorf("failed to marshal dead-letter job: %w", err)
+	}
+	jobID := job.JobID.String()
+	_, err = rdb.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSet(ctx, deadLetterDataKey, jobID, data)
+		pipe.ZAdd(ctx, deadLetterJobsKey, &redis.Z{
+			Score:  float64(time.Now().Unix()),
+			Member: jobID,
+		})
+		pipe.Del(ctx, scheduledJobKeyPrefix+jobID)
+		return nil
+	})
+	return err
+}
+
+// ListDeadLetters returns a page of dead-lettered jobs, most recent failure first,
+// and the total number of dead-lettered jobs
+func (s *Scheduler) ListDeadLetters(
+	ctx context.Context,
+	page, pageSize int,
+) ([]DeadLetterJob, int64, error) {
+	total, err := s.rdb.ZCard(ctx, deadLetterJobsKey).Result()
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count dead-letter jobs: %w", err)
+	}
+
+	start := int64((page - 1) * pageSize)
+	jobIDs, err := s.rdb.ZRevRange(ctx, deadLetterJobsKey, start, start+int64(pageSize)-1).Result()
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list dead-letter jobs: %w", err)
+	}
+	if len(jobIDs) == 0 {
+		return []DeadLetterJob{}, total, nil
+	}
+
+	values, err := s.rdb.HMGet(ctx, deadLetterDataKey, jobIDs...).Result()
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to load dead-letter jobs: %w", err)
+	}
+	jobs := make([]DeadLetterJob, 0, len(values))
+	for _, value := range values {
+		data, ok := value.(string)
+		if !ok {
+			continue
+		}
+		var job DeadLetterJob
+		if err := json.Unmarshal([]byte(data), &job); err == nil {
+			jobs = append(jobs, job)
+		}
+	}
+	return jobs, total, nil
+}
+
+// RequeueDeadLetter takes a job off the dead-letter queue and queues it to run now
+// with a fresh attempt budget
+func (s *Scheduler) RequeueDeadLetter(ctx context.Context, jobID string) (*ScheduledJob, error) {
+	data, err := s.rdb.HGet(ctx, deadLetterDataKey, jobID).Result()
+	if err == redis.Nil {
+		return nil, ErrDeadLetterNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to load dead-letter job: %w", err)
+	}
+
+	var deadLetter DeadLetterJob
+	if err := json.Unmarshal([]byte(data), &deadLetter); err != nil || deadLetter.Job == nil {
+		return nil, fmt.Errorf("dead-letter job %s is corrupt", jobID)
+	}
+
+	// Claim the job first, so concurrent requeues enqueue it only once
+	claimed, err := s.rdb.HDel(ctx, deadLetterDataKey, jobID).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to requeue dead-letter job: %w", err)
+	}
+	if claimed == 0 {
+		return nil, ErrDeadLetterNotFound
+	}
+
+	job := deadLetter.ScheduledJob
+	job.Attempt = 0
+	job.LastError = ""
+	jobData, err := json.Marshal(job)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal job: %w", err)
+	}
+	_, err = s.rdb.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		enqueueJob(ctx, pipe, job, jobData, 0)
+		pipe.ZRem(ctx, deadLetterJobsKey, jobID)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to requeue dead-letter job: %w", err)
+	}
+	return &job, nil
+}
+
+// PurgeDeadLetters deletes one dead-lettered job, or all of them when jobID is empty,
+// and returns how many were deleted
+func (s *Scheduler) PurgeDeadLetters(ctx context.Context, jobID string) (int64, error) {
+	if jobID == "" {
+		count, err := s.rdb.HLen(ctx, deadLetterDataKey).Result()
+		if err != nil {
+			return 0, fmt.Errorf("failed to count dead-letter jobs: %w", err)
+		}
+		if err := s.rdb.Del(ctx, deadLetterDataKey, deadLetterJobsKey).Err(); err != nil {
+			return 0, fmt.Errorf("failed to purge dead-letter jobs: %w", err)
+		}
+		return count, nil
+	}
+
+	deleted, err := s.rdb.HDel(ctx, deadLetterDataKey, jobID).Result()
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge dead-letter job: %w", err)
+	}
+	if deleted == 0 {
+		return 0, ErrDeadLetterNotFound
+	}
+	if err := s.rdb.ZRem(ctx, deadLetterJobsKey, jobID).Err(); err != nil {
+		return 0, fmt.Errorf("failed to purge dead-letter job: %w", err)
+	}
+	return deleted, nil
+}
@@ -7,10 +7,12 @@ import (
 	"ecommerce-be/common/log"
 )
 
+// Dispatch runs the handler registered for the job's command. A job with an unknown
+// command fails permanently, since retrying cannot find a handler either.
 func Dispatch(job ScheduledJob, ctx context.Context) error {
 	handler, ok := Get(job.Command)
 	if !ok {
-		return fmt.Errorf("unknown command: %s", job.Command)
+		return Permanent(fmt.Errorf("unknown command: %s", job.Command))
 	}
 
 	err := handler(ctx, job.Payload)
@@ -0,0 +1,61 @@
+package scheduler
+
+import (
+	"errors"
+	"net/http"
+
+	"ecommerce-be/common/constants"
+	commonErr "ecommerce-be/common/error"
+)
+
+var (
+	ErrDeadLetterNotFound = &commonErr.AppError{
+		Code:       constants.DEAD_LETTER_JOB_NOT_FOUND_CODE,
+		Message:    constants.DEAD_LETTER_JOB_NOT_FOUND_MSG,
+		StatusCode: http.StatusNotFound,
+	}
+
+	ErrQueueUnavailable = &commonErr.AppError{
+		Code:       constants.JOB_QUEUE_UNAVAILABLE_CODE,
+		Message:    constants.JOB_QUEUE_UNAVAILABLE_MSG,
+		StatusCode: http.StatusServiceUnavailable,
+	}
+
+	ErrInvalidPriority = &commonErr.AppError{
+		Code:       constants.INVALID_JOB_PRIORITY_CODE,
+		Message:    constants.INVALID_JOB_PRIORITY_MSG,
+		StatusCode: http.StatusBadRequest,
+	}
+)
+
+func init() {
+	commonErr.Register(ErrDeadLetterNotFound, ErrQueueUnavailable, ErrInvalidPriority)
+}
+
+// PermanentError marks a job failure that retrying cannot fix, such as a payload that
+// does not decode. The job is dead-lettered without further attempts.
+type PermanentError struct {
+	Err error
+}
+
+func (e *PermanentError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *PermanentError) Unwrap() error {
+	return e.Err
+}
+
+// Permanent wraps err so the failed job is not retried
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &PermanentError{Err: err}
+}
+
+// IsPermanent reports whether err was marked with Permanent
+func IsPermanent(err error) bool {
+	var permanent *PermanentError
+	return errors.As(err, &permanent)
+}
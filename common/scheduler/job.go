@@ -2,10 +2,23 @@ package scheduler
 
 import (
 	"encoding/json"
+	"fmt"
 
 	"github.com/google/uuid"
 )
 
+// Priority decides which due jobs workers pick up first
+type Priority string
+
+const (
+	PriorityHigh   Priority = "high"
+	PriorityNormal Priority = "normal"
+	PriorityLow    Priority = "low"
+)
+
+// priorities lists the priorities in the order the dispatcher drains them
+var priorities = []Priority{PriorityHigh, PriorityNormal, PriorityLow}
+
 // Job is the base job structure with command and payload.
 type Job struct {
 	// Unique identifier for this scheduled job (used for cancellation)
@@ -13,6 +26,12 @@ type Job struct {
 
 	Command string          `json:"command"`
 	Payload json.RawMessage `json:"payload"`
+
+	// Priority defaults to PriorityNormal
+	Priority Priority `json:"priority,omitempty"`
+	// MaxAttempts caps how often the job runs before it is dead-lettered; 0 uses
+	// SCHEDULER_JOB_MAX_ATTEMPTS
+	MaxAttempts int `json:"maxAttempts,omitempty"`
 }
 
 // NewJob creates a new Job with auto-generated UUID.
@@ -29,6 +48,33 @@ func NewJob(command string, payload json.RawMessage) Job {
 	}
 }
 
+// NewTypedJob creates a Job whose payload is the JSON encoding of payload, for a
+// command registered with RegisterTyped.
+//
+// Example:
+//
+//	job, err := scheduler.NewTypedJob("expire_reservation", ExpiryPayload{ReservationID: 123})
+func NewTypedJob[T any](command string, payload T) (Job, error) {
+	raw, err := json.Marshal(payload)
+	if err != nil {
+		return Job{}, fmt.Errorf("failed to marshal %s payload: %w", command, err)
+	}
+	return NewJob(command, raw), nil
+}
+
+// WithPriority returns the job with the given priority
+func (j Job) WithPriority(priority Priority) Job {
+	j.Priority = priority
+	return j
+}
+
+// WithMaxAttempts returns the job with its own attempt limit; 1 disables retries for
+// commands that recover from failures themselves
+func (j Job) WithMaxAttempts(maxAttempts int) Job {
+	j.MaxAttempts = maxAttempts
+	return j
+}
+
 // ScheduledJob extends Job with metadata for tracing, context propagation, and cancellation.
 // The JobID is used to cancel a scheduled job before it executes.
 type ScheduledJob struct {
@@ -38,4 +84,19 @@ type ScheduledJob struct {
 	UserID        uint   `json:"userId"`
 	SellerID      uint   `json:"sellerId"`
 	CorrelationId string `json:"correlationId"`
+
+	// Attempt counts the runs that already failed; LastError is the latest failure
+	Attempt   int    `json:"attempt,omitempty"`
+	LastError string `json:"lastError,omitempty"`
+}
+
+// queuePriority returns the job's priority, PriorityNormal when unset or unknown
+func (j ScheduledJob) queuePriority() Priority {
+	if j.Job != nil {
+		switch j.Priority {
+		case PriorityHigh, PriorityLow:
+			return j.Priority
+		}
+	}
+	return PriorityNormal
 }
@@ -0,0 +1,266 @@
+package scheduler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+
+	"ecommerce-be/common/log"
+
+	"github.com/go-redis/redis/v8"
+)
+
+const (
+	// runningJobsKey maps the ID of a job a worker is running to its RunningJob JSON
+	runningJobsKey = "running_jobs"
+	// recentRunsKey and recentFailuresKey list the latest JobRun JSON, newest first.
+	// Failures are kept apart so a burst of successful runs does not push them out.
+	recentRunsKey     = "job_runs"
+	recentFailuresKey = "job_failures"
+
+	recentRunsLimit     = 500
+	recentFailuresLimit = 200
+)
+
+// RunResult is the outcome of a job's run
+type RunResult string
+
+const (
+	RunResultSucceeded    RunResult = "succeeded"
+	RunResultRetrying     RunResult = "retrying"
+	RunResultDeadLettered RunResult = "dead_lettered"
+)
+
+// RunningJob is a job a worker is running. A job whose instance died mid-run stays
+// listed, with its old StartedAt, until PurgeStaleRunningJobs drops it.
+type RunningJob struct {
+	JobID     string    `json:"jobId"`
+	Command   string    `json:"command"`
+	Priority  Priority  `json:"priority"`
+	Attempt   int       `json:"attempt"`
+	Worker    string    `json:"worker"`
+	StartedAt time.Time `json:"startedAt"`
+}
+
+// JobRun is a finished run of a job
+type JobRun struct {
+	JobID      string    `json:"jobId"`
+	Command    string    `json:"command"`
+	Priority   Priority  `json:"priority"`
+	Attempt    int       `json:"attempt"`
+	Result     RunResult `json:"result"`
+	Error      string    `json:"error,omitempty"`
+	Worker     string    `json:"worker"`
+	StartedAt  time.Time `json:"startedAt"`
+	FinishedAt time.Time `json:"finishedAt"`
+	DurationMs int64     `json:"durationMs"`
+}
+
+// QueuedJob is a job waiting in a priority queue until DueAt
+type QueuedJob struct {
+	ScheduledJob
+	DueAt time.Time `json:"dueAt"`
+}
+
+// QueueDepth counts the jobs of a priority queue: Due ones wait for a free worker,
+// Scheduled ones for their time
+type QueueDepth struct {
+	Priority  Priority `json:"priority"`
+	Due       int64    `json:"due"`
+	Scheduled int64    `json:"scheduled"`
+}
+
+// markRunning lists a job as running on worker until recordRun
+func markRunning(
+	ctx context.Context,
+	rdb redis.UniversalClient,
+	job ScheduledJob,
+	worker string,
+	startedAt time.Time,
+) {
+	data, err := json.Marshal(RunningJob{
+		JobID:     job.JobID.String(),
+		Command:   job.Command,
+		Priority:  job.queuePriority(),
+		Attempt:   job.Attempt + 1,
+		Worker:    worker,
+		StartedAt: startedAt.UTC(),
+	})
+	if err != nil {
+		return
+	}
+	if err := rdb.HSet(ctx, runningJobsKey, job.JobID.String(), data).Err(); err != nil {
+		log.ErrorWithContext(ctx, "Failed to mark job "+job.Command+" as running", err)
+	}
+}
+
+// recordRun moves a job off the running list into the recent runs, and into the
+// recent failures when it failed
+func recordRun(ctx context.Context, rdb redis.UniversalClient, run JobRun) {
+	data, err := json.Marshal(run)
+	if err != nil {
+		return
+	}
+	_, err = rdb.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HDel(ctx, runningJobsKey, run.JobID)
+		pipe.LPush(ctx, recentRunsKey, data)
+		pipe.LTrim(ctx, recentRunsKey, 0, recentRunsLimit-1)
+		if run.Result != RunResultSucceeded {
+			pipe.LPush(ctx, recentFailuresKey, data)
+			pipe.LTrim(ctx, recentFailuresKey, 0, recentFailuresLimit-1)
+		}
+		return nil
+	})
+	if err != nil {
+		log.ErrorWithContext(ctx, "Failed to record run of job "+run.Command, err)
+	}
+}
+
+// QueueDepths counts the due and scheduled jobs of every priority queue
+func (s *Scheduler) QueueDepths(ctx context.Context) ([]QueueDepth, error) {
+	now := strconv.FormatInt(time.Now().Unix(), 10)
+
+	depths := make([]QueueDepth, 0, len(priorities))
+	for _, priority := range priorities {
+		key := queueKey(priority)
+		due, err := s.rdb.ZCount(ctx, key, "-inf", now).Result()
+		if err != nil {
+			return nil, fmt.Errorf("failed to count %s priority jobs: %w", priority, err)
+		}
+		scheduled, err := s.rdb.ZCount(ctx, key, "("+now, "+inf").Result()
+		if err != nil {
+			return nil, fmt.Errorf("failed to count %s priority jobs: %w", priority, err)
+		}
+		depths = append(depths, QueueDepth{Priority: priority, Due: due, Scheduled: scheduled})
+	}
+	return depths, nil
+}
+
+// ListQueuedJobs returns a page of a priority queue's due jobs, or of its scheduled
+// jobs, soonest first, and the number of such jobs
+func (s *Scheduler) ListQueuedJobs(
+	ctx context.Context,
+	priority Priority,
+	due bool,
+	page, pageSize int,
+) ([]QueuedJob, int64, error) {
+	now := strconv.FormatInt(time.Now().Unix(), 10)
+	minScore, maxScore := "-inf", now
+	if !due {
+		minScore, maxScore = "("+now, "+inf"
+	}
+
+	key := queueKey(priority)
+	total, err := s.rdb.ZCount(ctx, key, minScore, maxScore).Result()
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count queued jobs: %w", err)
+	}
+	members, err := s.rdb.ZRangeByScoreWithScores(ctx, key, &redis.ZRangeBy{
+		Min:    minScore,
+		Max:    maxScore,
+		Offset: int64((page - 1) * pageSize),
+		Count:  int64(pageSize),
+	}).Result()
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list queued jobs: %w", err)
+	}
+
+	jobs := make([]QueuedJob, 0, len(members))
+	for _, member := range members {
+		data, ok := member.Member.(string)
+		if !ok {
+			continue
+		}
+		var job ScheduledJob
+		if err := json.Unmarshal([]byte(data), &job); err != nil || job.Job == nil {
+			continue
+		}
+		jobs = append(jobs, QueuedJob{
+			ScheduledJob: job,
+			DueAt:        time.Unix(int64(member.Score), 0).UTC(),
+		})
+	}
+	return jobs, total, nil
+}
+
+// RunningJobs returns the jobs workers are running, longest running first
+func (s *Scheduler) RunningJobs(ctx context.Context) ([]RunningJob, error) {
+	values, err := s.rdb.HGetAll(ctx, runningJobsKey).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list running jobs: %w", err)
+	}
+
+	jobs := make([]RunningJob, 0, len(values))
+	for _, data := range values {
+		var job RunningJob
+		if err := json.Unmarshal([]byte(data), &job); err == nil {
+			jobs = append(jobs, job)
+		}
+	}
+	slices.SortFunc(jobs, func(a, b RunningJob) int {
+		return a.StartedAt.Compare(b.StartedAt)
+	})
+	return jobs, nil
+}
+
+// PurgeStaleRunningJobs drops running entries started before olderThan ago, left by
+// instances that died mid-run, and returns how many were dropped
+func (s *Scheduler) PurgeStaleRunningJobs(
+	ctx context.Context,
+	olderThan time.Duration,
+) (int64, error) {
+	jobs, err := s.RunningJobs(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	cutoff := time.Now().Add(-olderThan)
+	stale := make([]string, 0)
+	for _, job := range jobs {
+		if job.StartedAt.Before(cutoff) {
+			stale = append(stale, job.JobID)
+		}
+	}
+	if len(stale) == 0 {
+		return 0, nil
+	}
+	purged, err := s.rdb.HDel(ctx, runningJobsKey, stale...).Result()
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge stale running jobs: %w", err)
+	}
+	return purged, nil
+}
+
+// RecentRuns returns up to limit of the latest finished runs, newest first; only
+// failed ones, which are kept longer, when failedOnly is set
+func (s *Scheduler) RecentRuns(ctx context.Context, failedOnly bool, limit int) ([]JobRun, error) {
+	key := recentRunsKey
+	if failedOnly {
+		key = recentFailuresKey
+	}
+	values, err := s.rdb.LRange(ctx, key, 0, int64(limit)-1).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list recent job runs: %w", err)
+	}
+
+	runs := make([]JobRun, 0, len(values))
+	for _, data := range values {
+		var run JobRun
+		if err := json.Unmarshal([]byte(data), &run); err == nil {
+			runs = append(runs, run)
+		}
+	}
+	return runs, nil
+}
+
+// DeadLetterCount returns the number of dead-lettered jobs
+func (s *Scheduler) DeadLetterCount(ctx context.Context) (int64, error) {
+	count, err := s.rdb.ZCard(ctx, deadLetterJobsKey).Result()
+	if err != nil {
+		return 0, fmt.Errorf("failed to count dead-letter jobs: %w", err)
+	}
+	return count, nil
+}
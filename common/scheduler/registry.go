@@ -3,6 +3,7 @@ package scheduler
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"sync"
 
 	"ecommerce-be/common/log"
@@ -28,6 +29,25 @@ func Register(command string, handler Handler) {
 	registry[command] = handler
 }
 
+// RegisterTyped registers a handler that receives the job payload decoded into T.
+// A payload that does not decode fails the job permanently, since retrying it would
+// fail the same way.
+//
+// Example:
+//
+//	scheduler.RegisterTyped("expire_reservation", func(ctx context.Context, p ExpiryPayload) error {
+//	    return service.ExpireReservation(ctx, p.ReservationID)
+//	})
+func RegisterTyped[T any](command string, handler func(ctx context.Context, payload T) error) {
+	Register(command, func(ctx context.Context, raw json.RawMessage) error {
+		var payload T
+		if err := json.Unmarshal(raw, &payload); err != nil {
+			return Permanent(fmt.Errorf("invalid %s payload: %w", command, err))
+		}
+		return handler(ctx, payload)
+	})
+}
+
 func Get(command string) (Handler, bool) {
 	mu.RLock()
 	defer mu.RUnlock()
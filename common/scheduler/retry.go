@@ -0,0 +1,51 @@
+package scheduler
+
+import (
+	"time"
+
+	"ecommerce-be/common/config"
+)
+
+// Retry defaults used when configuration has not been loaded
+const (
+	defaultMaxAttempts    = 3
+	defaultRetryBaseDelay = 30 * time.Second
+	defaultRetryMaxDelay  = time.Hour
+)
+
+// RetryDelay returns the backoff before the given retry (1 for the first): base,
+// doubled for every earlier retry and capped at maxDelay
+func RetryDelay(retry int, base, maxDelay time.Duration) time.Duration {
+	delay := base
+	for i := 1; i < retry && delay < maxDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, maxDelay)
+}
+
+// ShouldRetry reports whether a job that just failed with err for the attempt-th time
+// runs again
+func ShouldRetry(job ScheduledJob, err error) bool {
+	return !IsPermanent(err) && job.Attempt < maxAttempts(job)
+}
+
+// maxAttempts is the job's own limit, or the configured default
+func maxAttempts(job ScheduledJob) int {
+	if job.Job != nil && job.MaxAttempts > 0 {
+		return job.MaxAttempts
+	}
+	if cfg := config.Get(); cfg != nil && cfg.Scheduler.JobMaxAttempts > 0 {
+		return cfg.Scheduler.JobMaxAttempts
+	}
+	return defaultMaxAttempts
+}
+
+// retryDelay is the backoff before the job's next attempt under the configured policy
+func retryDelay(job ScheduledJob) time.Duration {
+	base, maxDelay := defaultRetryBaseDelay, defaultRetryMaxDelay
+	if cfg := config.Get(); cfg != nil && cfg.Scheduler.RetryBaseDelaySec > 0 {
+		base = time.Duration(cfg.Scheduler.RetryBaseDelaySec) * time.Second
+		maxDelay = time.Duration(cfg.Scheduler.RetryMaxDelaySec) * time.Second
+	}
+	return RetryDelay(job.Attempt, base, maxDelay)
+}
@@ -16,11 +16,11 @@ import (
 // Jobs are stored in a Redis Sorted Set with the execution timestamp as the score.
 // Each job also has a separate key for cancellation support.
 type Scheduler struct {
-	rdb *redis.Client
+	rdb redis.UniversalClient
 }
 
 // New creates a new Scheduler instance with the provided Redis client.
-func New(rdb *redis.Client) *Scheduler {
+func New(rdb redis.UniversalClient) *Scheduler {
 	return &Scheduler{rdb: rdb}
 }
 
@@ -31,7 +31,9 @@ func New(rdb *redis.Client) *Scheduler {
 //  1. Generate unique jobId (UUID)
 //  2. Job is serialized to JSON
 //  3. Job JSON is stored in "scheduled_job:{jobId}" for cancellation support
-//  4. Job is added to Redis Sorted Set "delayed_jobs" with score = execution timestamp
+//  4. Job is added to its priority's Redis Sorted Set ("delayed_jobs" for normal
+//     priority, "delayed_jobs:high" / "delayed_jobs:low" otherwise) with score =
+//     execution timestamp
 //  5. Worker pool (StartRedisWorkerPool) picks up jobs when their execution time arrives
 //
 // Parameters:
@@ -52,6 +54,12 @@ func New(rdb *redis.Client) *Scheduler {
 //	jobId, err := scheduler.Schedule(ctx, job, 15*time.Minute)
 //	// Store jobId to cancel later if needed
 func (s *Scheduler) Schedule(ctx context.Context, job Job, after time.Duration) (string, error) {
+	switch job.Priority {
+	case "", PriorityHigh, PriorityNormal, PriorityLow:
+	default:
+		return "", ErrInvalidPriority
+	}
+
 	scheduledJob, err := s.createScheduledJob(ctx, job)
 	if err != nil {
 		return "", err
@@ -62,20 +70,11 @@ func (s *Scheduler) Schedule(ctx context.Context, job Job, after time.Duration)
 		return "", fmt.Errorf("failed to marshal job: %w", err)
 	}
 
-	executeAt := time.Now().Add(after).Unix()
-	jobKey := scheduledJobKeyPrefix + scheduledJob.JobID.String()
-
-	// Use pipeline for atomic operations
+	// Use pipeline for atomic operations: the job JSON is stored for cancellation
+	// lookup (TTL = execution time + 1 hour buffer) and added to its priority's
+	// sorted set for scheduling
 	pipe := s.rdb.Pipeline()
-
-	// Store job JSON for cancellation lookup
-	pipe.Set(ctx, jobKey, data, after+time.Hour) // TTL = execution time + 1 hour buffer
-
-	// Add to sorted set for scheduling
-	pipe.ZAdd(ctx, delayedJobsKey, &redis.Z{
-		Score:  float64(executeAt),
-		Member: data,
-	})
+	enqueueJob(ctx, pipe, *scheduledJob, data, after)
 
 	_, err = pipe.Exec(ctx)
 	if err != nil {
@@ -90,7 +89,7 @@ func (s *Scheduler) Schedule(ctx context.Context, job Job, after time.Duration)
 //
 // How it works:
 //  1. Get job JSON from "scheduled_job:{jobId}"
-//  2. Remove job from sorted set "delayed_jobs" using exact JSON match
+//  2. Remove job from its priority's sorted set using exact JSON match
 //  3. Delete the "scheduled_job:{jobId}" key
 //
 // Parameters:
@@ -117,7 +116,9 @@ func (s *Scheduler) Cancel(ctx context.Context, jobID string) error {
 	pipe := s.rdb.Pipeline()
 
 	// Remove from sorted set
-	pipe.ZRem(ctx, delayedJobsKey, jobData)
+	var scheduledJob ScheduledJob
+	_ = json.Unmarshal([]byte(jobData), &scheduledJob)
+	pipe.ZRem(ctx, queueKey(scheduledJob.queuePriority()), jobData)
 
 	// Delete the job key
 	pipe.Del(ctx, jobKey)
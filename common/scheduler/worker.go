@@ -4,14 +4,15 @@ import (
 	"context"
 	"encoding/json"
 	"strconv"
+	"sync"
 	"time"
 
 	"ecommerce-be/common/cache"
 	"ecommerce-be/common/config"
-	"ecommerce-be/common/constants"
+	"ecommerce-be/common/lifecycle"
 	"ecommerce-be/common/log"
+	"ecommerce-be/common/reqctx"
 
-	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 
 	"github.com/go-redis/redis/v8"
@@ -27,10 +28,14 @@ const (
 // StartRedisWorkerPool starts a background worker pool that processes delayed/scheduled jobs from Redis.
 //
 // How it works:
-//  1. Jobs are stored in a Redis Sorted Set ("delayed_jobs") with score = Unix timestamp when job should execute
-//  2. A dispatcher goroutine polls Redis every 500ms looking for jobs whose execution time has passed (score <= now)
+//  1. Jobs are stored in a Redis Sorted Set per priority ("delayed_jobs:high", "delayed_jobs",
+//     "delayed_jobs:low") with score = Unix timestamp when job should execute
+//  2. A dispatcher goroutine polls Redis every 500ms looking for jobs whose execution time has passed
+//     (score <= now), draining high priority jobs before normal and low ones
 //  3. Due jobs are sent to a buffered channel where worker goroutines pick them up for processing
 //  4. Multiple workers process jobs concurrently, preventing slow jobs from blocking others
+//  5. A failed job is retried with exponential backoff until it reaches its max attempts, then
+//     moved to the dead-letter queue; a job failing with a Permanent error is dead-lettered at once
 //
 // Why we need this:
 //   - Delayed execution: Schedule tasks to run at a specific future time (e.g., reservation expiry)
@@ -39,33 +44,53 @@ const (
 //   - Scalability: Multiple workers process jobs concurrently, configurable via WORKER_POOL_SIZE env
 //   - Non-blocking: Long-running jobs don't block other jobs from being processed
 //
+// Shutdown:
+//
+// When ctx is cancelled the dispatcher stops polling, jobs already running finish, and
+// due jobs still waiting for a worker go back to their queue for the next instance.
+// The pool returns once every worker has stopped.
+//
 // Configuration:
 //
-//	WORKER_POOL_SIZE=10  # Number of concurrent workers (default: 5)
+//	WORKER_POOL_SIZE=10                 # Number of concurrent workers (default: 5)
+//	SCHEDULER_JOB_MAX_ATTEMPTS=3        # Runs of a job before it is dead-lettered (default: 3)
+//	SCHEDULER_RETRY_BASE_DELAY_SEC=30   # First retry delay, doubled per retry (default: 30)
+//	SCHEDULER_RETRY_MAX_DELAY_SEC=3600  # Cap on the retry delay (default: 3600)
 //
 // Usage:
 //
-//	go scheduler.StartRedisWorkerPool() // Start in a goroutine at application startup
+//	lifecycle.Go("scheduler worker pool", scheduler.StartRedisWorkerPool) // At startup
 //
 // To schedule a job:
 //
-//	rdb.ZAdd(ctx, "delayed_jobs", &redis.Z{
-//	    Score:  float64(time.Now().Add(15*time.Minute).Unix()), // Execute 15 min from now
-//	    Member: jobJSON,
-//	})
-func StartRedisWorkerPool() {
+//	job := scheduler.NewJob("expire_reservation", payload).WithPriority(scheduler.PriorityHigh)
+//	jobID, err := scheduler.New(rdb).Schedule(ctx, job, 15*time.Minute)
+func StartRedisWorkerPool(ctx context.Context) {
 	poolSize := getPoolSize()
-	jobChannel := make(chan ScheduledJob, poolSize*2)
+	jobChannel := make(chan dueJob, poolSize*2)
 
 	// Start worker pool
+	var workers sync.WaitGroup
 	for i := 1; i <= poolSize; i++ {
-		go jobWorker(i, jobChannel)
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+			jobWorker(ctx, i, jobChannel)
+		}()
 	}
 
 	log.Info("Redis worker pool started with " + strconv.Itoa(poolSize) + " workers")
 
-	// Start dispatcher (runs in current goroutine)
-	jobDispatcher(jobChannel)
+	// Start dispatcher (runs in current goroutine until ctx is cancelled)
+	jobDispatcher(ctx, jobChannel)
+	workers.Wait()
+}
+
+// dueJob is a job taken off its queue together with its member there, so it can
+// be put back unchanged and stay cancellable
+type dueJob struct {
+	ScheduledJob
+	data string
 }
 
 // getPoolSize reads worker pool size from config, defaults to 5
@@ -83,61 +108,135 @@ func getPoolSize() int {
 	return poolSize
 }
 
-// jobWorker is a goroutine that continuously processes jobs from the channel
-func jobWorker(id int, jobs <-chan ScheduledJob) {
+// jobWorker is a goroutine that continuously processes jobs from the channel. Once
+// shutdown has begun it re-enqueues the jobs it receives instead of running them.
+func jobWorker(shutdown context.Context, id int, jobs <-chan dueJob) {
 	workerID := strconv.Itoa(id)
+	worker := lifecycle.Instance() + "/" + workerID
 	rdb, _ := cache.GetRedisClient()
 
-	for job := range jobs {
+	for due := range jobs {
+		if shutdown.Err() != nil {
+			requeueJob(rdb, due)
+			continue
+		}
+
+		// Jobs run on their own context, so a job already started is not cut short
+		job := due.ScheduledJob
+		if job.JobID == uuid.Nil {
+			// Jobs queued before job IDs existed get one to be tracked by
+			job.JobID = uuid.New()
+		}
 		ctx := GetContextWithKeys(job)
 		log.InfoWithContext(
 			ctx,
 			"Worker "+workerID+" processing job: "+job.Command+" (jobId: "+job.JobID.String()+")",
 		)
 
-		if err := Dispatch(job, ctx); err != nil {
+		startedAt := time.Now()
+		if rdb != nil {
+			markRunning(ctx, rdb, job, worker, startedAt)
+		}
+
+		err := Dispatch(job, ctx)
+		if err != nil {
 			log.ErrorWithContext(
 				ctx,
 				"Worker "+workerID+" failed to dispatch job "+job.Command+" (jobId: "+job.JobID.String()+"): "+err.Error(),
 				err,
 			)
 		}
+		if rdb == nil {
+			continue
+		}
 
-		// Clean up the job key after processing (regardless of success/failure)
-		if job.JobID != uuid.Nil && rdb != nil {
-			jobKey := scheduledJobKeyPrefix + job.JobID.String()
-			rdb.Del(ctx, jobKey)
+		run := JobRun{
+			JobID:      job.JobID.String(),
+			Command:    job.Command,
+			Priority:   job.queuePriority(),
+			Attempt:    job.Attempt + 1,
+			Result:     RunResultSucceeded,
+			Worker:     worker,
+			StartedAt:  startedAt.UTC(),
+			FinishedAt: time.Now().UTC(),
 		}
+		run.DurationMs = run.FinishedAt.Sub(run.StartedAt).Milliseconds()
+		if err != nil {
+			run.Result = handleFailedJob(ctx, rdb, job, err)
+			run.Error = err.Error()
+		} else {
+			// Clean up the job key after a successful run
+			rdb.Del(ctx, scheduledJobKeyPrefix+job.JobID.String())
+		}
+		recordRun(ctx, rdb, run)
+	}
+}
+
+// handleFailedJob schedules the next attempt of a failed job, or moves it to the
+// dead-letter queue once it is out of attempts or failed permanently, and returns
+// which it did
+func handleFailedJob(
+	ctx context.Context,
+	rdb redis.UniversalClient,
+	job ScheduledJob,
+	err error,
+) RunResult {
+	job.Attempt++
+	job.LastError = err.Error()
+	attempt := strconv.Itoa(job.Attempt)
+
+	if ShouldRetry(job, err) {
+		if retryErr := retryJob(ctx, rdb, job); retryErr != nil {
+			log.ErrorWithContext(ctx, "Failed to schedule retry of job "+job.Command, retryErr)
+		} else {
+			log.InfoWithContext(
+				ctx,
+				"Job "+job.Command+" (jobId: "+job.JobID.String()+") failed attempt "+attempt+
+					", retrying in "+retryDelay(job).String(),
+			)
+		}
+		return RunResultRetrying
 	}
+
+	if dlqErr := deadLetterJob(ctx, rdb, job); dlqErr != nil {
+		log.ErrorWithContext(ctx, "Failed to dead-letter job "+job.Command, dlqErr)
+		return RunResultDeadLettered
+	}
+	log.WarnWithContext(
+		ctx,
+		"Job "+job.Command+" (jobId: "+job.JobID.String()+") moved to dead-letter queue after "+
+			attempt+" attempt(s)",
+	)
+	return RunResultDeadLettered
 }
 
-// jobDispatcher polls Redis for due jobs and sends them to the worker channel
-func jobDispatcher(jobs chan<- ScheduledJob) {
-	ctx := context.Background()
+// jobDispatcher polls Redis for due jobs and sends them to the worker channel until
+// ctx is cancelled, then closes the channel
+func jobDispatcher(ctx context.Context, jobs chan<- dueJob) {
+	defer close(jobs)
+
 	rdb, err := cache.GetRedisClient()
 	if err != nil {
 		log.Error("Failed to start dispatcher: "+err.Error(), err)
-		close(jobs)
 		return
 	}
 
-	for {
+	for ctx.Err() == nil {
 		now := time.Now().Unix()
 
-		// Fetch jobs that are due (score <= current timestamp)
-		results, err := rdb.ZRangeByScore(ctx, delayedJobsKey, &redis.ZRangeBy{
-			Min:   "0",
-			Max:   strconv.FormatInt(now, 10),
-			Count: 10, // Fetch multiple jobs at once for efficiency
-		}).Result()
+		// Fetch jobs that are due (score <= current timestamp) from the highest
+		// priority queue that has any
+		key, results, err := fetchDueJobs(ctx, rdb, now)
 		if err != nil {
-			log.Error("Failed to fetch jobs from Redis: "+err.Error(), err)
-			time.Sleep(pollInterval)
+			if ctx.Err() == nil {
+				log.Error("Failed to fetch jobs from Redis: "+err.Error(), err)
+			}
+			waitForPoll(ctx)
 			continue
 		}
 
 		if len(results) == 0 {
-			time.Sleep(pollInterval)
+			waitForPoll(ctx)
 			continue
 		}
 
@@ -145,27 +244,75 @@ func jobDispatcher(jobs chan<- ScheduledJob) {
 		for _, jobData := range results {
 			// Atomically remove the job - only process if we successfully removed it
 			// This prevents duplicate processing in multi-instance environments
-			if rdb.ZRem(ctx, delayedJobsKey, jobData).Val() == 1 {
+			if rdb.ZRem(ctx, key, jobData).Val() == 1 {
 				var job ScheduledJob
 				if err := json.Unmarshal([]byte(jobData), &job); err != nil {
 					log.Error("Failed to unmarshal job: "+err.Error(), err)
 					continue
 				}
 
-				// Send to worker channel (non-blocking with buffered channel)
-				jobs <- job
+				// Send to worker channel, or hand it back if shutdown begins while
+				// every worker is busy
+				select {
+				case jobs <- dueJob{ScheduledJob: job, data: jobData}:
+				case <-ctx.Done():
+					requeueJob(rdb, dueJob{ScheduledJob: job, data: jobData})
+				}
 			}
 		}
 	}
 }
 
-func GetContextWithKeys(job ScheduledJob) context.Context {
-	ctx := &gin.Context{
-		Keys: map[string]any{
-			constants.USER_ID_KEY:        job.UserID,
-			constants.SELLER_ID_KEY:      job.SellerID,
-			constants.CORRELATION_ID_KEY: job.CorrelationId,
-		},
+// fetchDueJobs returns the queue key and the due jobs of the highest priority queue
+// that has any, so high priority jobs are picked up before a backlog of lower ones
+func fetchDueJobs(
+	ctx context.Context,
+	rdb redis.UniversalClient,
+	now int64,
+) (string, []string, error) {
+	for _, priority := range priorities {
+		key := queueKey(priority)
+		results, err := rdb.ZRangeByScore(ctx, key, &redis.ZRangeBy{
+			Min:   "0",
+			Max:   strconv.FormatInt(now, 10),
+			Count: 10, // Fetch multiple jobs at once for efficiency
+		}).Result()
+		if err != nil || len(results) > 0 {
+			return key, results, err
+		}
 	}
-	return ctx
+	return "", nil, nil
+}
+
+// waitForPoll sleeps for the poll interval, waking early when ctx is cancelled
+func waitForPoll(ctx context.Context) {
+	timer := time.NewTimer(pollInterval)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+	case <-ctx.Done():
+	}
+}
+
+// requeueJob puts a job that was taken but not run back into its queue, due now,
+// so another instance or the next start runs it
+func requeueJob(rdb redis.UniversalClient, due dueJob) {
+	ctx := GetContextWithKeys(due.ScheduledJob)
+	err := rdb.ZAdd(ctx, queueKey(due.queuePriority()), &redis.Z{
+		Score:  float64(time.Now().Unix()),
+		Member: due.data,
+	}).Err()
+	if err != nil {
+		log.ErrorWithContext(ctx, "Failed to re-enqueue job "+due.Command+" at shutdown", err)
+		return
+	}
+	log.InfoWithContext(ctx, "Re-enqueued job "+due.Command+" at shutdown")
+}
+
+// GetContextWithKeys rebuilds the request metadata the job was scheduled with
+func GetContextWithKeys(job ScheduledJob) context.Context {
+	ctx := reqctx.UserID.With(context.Background(), job.UserID)
+	ctx = reqctx.SellerID.With(ctx, job.SellerID)
+	return reqctx.CorrelationID.With(ctx, job.CorrelationId)
 }
@@ -0,0 +1,99 @@
+// Package schemamigration applies the numbered SQL files in migrations/ and
+// records them in schema_migrations, with checksums to detect edited files and
+// an advisory lock so concurrent starts apply each migration once.
+package schemamigration
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+
+	"ecommerce-be/common/constants"
+)
+
+// fileNamePattern matches migration files such as 049_create_product_option_rule_table.sql
+var fileNamePattern = regexp.MustCompile(`^(\d+)_(.+)\.sql$`)
+
+// Migration is one numbered SQL file and its optional rollback script
+type Migration struct {
+	Version  int64
+	Name     string
+	UpSQL    string
+	DownSQL  string
+	Checksum string
+}
+
+// HasDown reports whether the migration can be rolled back
+func (m Migration) HasDown() bool {
+	return m.DownSQL != ""
+}
+
+// Load reads the migrations in dir, ordered by version. Rollback scripts are read
+// from the down/ subdirectory, under the same file name as the migration.
+func Load(dir string) ([]Migration, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("read migrations directory: %w", err)
+	}
+
+	seen := make(map[int64]string)
+	var migrations []Migration
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		match := fileNamePattern.FindStringSubmatch(entry.Name())
+		if match == nil {
+			continue
+		}
+		version, err := strconv.ParseInt(match[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("migration %s: %w", entry.Name(), err)
+		}
+		if other, ok := seen[version]; ok {
+			return nil, fmt.Errorf(
+				"migrations %s and %s share version %d",
+				other,
+				entry.Name(),
+				version,
+			)
+		}
+		seen[version] = entry.Name()
+
+		up, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		down, err := os.ReadFile(
+			filepath.Join(dir, constants.SCHEMA_MIGRATION_DOWN_DIR, entry.Name()),
+		)
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return nil, err
+		}
+
+		migrations = append(migrations, Migration{
+			Version:  version,
+			Name:     match[2],
+			UpSQL:    string(up),
+			DownSQL:  string(down),
+			Checksum: Checksum(string(up)),
+		})
+	}
+
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+	return migrations, nil
+}
+
+// Checksum fingerprints a migration's SQL so edits to applied files are detected
+func Checksum(sql string) string {
+	sum := sha256.Sum256([]byte(sql))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,311 @@
+package schemamigration
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"time"
+
+	"ecommerce-be/common/constants"
+)
+
+// Migration states reported by Status
+const (
+	StateApplied  = "applied"
+	StatePending  = "pending"
+	StateModified = "modified" // applied, but the file changed since
+	StateMissing  = "missing"  // recorded as applied, but the file is gone
+)
+
+// Status is the state of one migration version
+type Status struct {
+	Version   int64
+	Name      string
+	State     string
+	AppliedAt *time.Time
+}
+
+// appliedRecord is a row of schema_migrations
+type appliedRecord struct {
+	Name      string
+	Checksum  string
+	AppliedAt time.Time
+}
+
+// Runner applies and rolls back migrations against a database
+type Runner struct {
+	db         *sql.DB
+	migrations []Migration
+}
+
+// NewRunner creates a runner for migrations, which must be ordered by version
+func NewRunner(db *sql.DB, migrations []Migration) *Runner {
+	return &Runner{db: db, migrations: migrations}
+}
+
+// Up applies every pending migration in version order, each in its own transaction
+// together with its schema_migrations row. It refuses to run when an applied
+// migration's file has changed; fix the file or Force to accept it.
+func (r *Runner) Up(ctx context.Context) ([]Migration, error) {
+	var applied []Migration
+	err := r.withLock(ctx, func(conn *sql.Conn) error {
+		records, err := loadApplied(ctx, conn)
+		if err != nil {
+			return err
+		}
+		for _, m := range r.migrations {
+			if record, ok := records[m.Version]; ok && record.Checksum != m.Checksum {
+				return fmt.Errorf(
+					"migration %d_%s changed after it was applied (checksum mismatch)",
+					m.Version,
+					m.Name,
+				)
+			}
+		}
+
+		for _, m := range r.migrations {
+			if _, ok := records[m.Version]; ok {
+				continue
+			}
+			err := inTx(ctx, conn, func(tx *sql.Tx) error {
+				if _, err := tx.ExecContext(ctx, m.UpSQL); err != nil {
+					return err
+				}
+				return insertRecord(ctx, tx, m)
+			})
+			if err != nil {
+				return fmt.Errorf("apply migration %d_%s: %w", m.Version, m.Name, err)
+			}
+			applied = append(applied, m)
+		}
+		return nil
+	})
+	return applied, err
+}
+
+// Down rolls back the last steps applied migrations, newest first. Every one of
+// them needs a rollback script; nothing is rolled back otherwise.
+func (r *Runner) Down(ctx context.Context, steps int) ([]Migration, error) {
+	var rolledBack []Migration
+	err := r.withLock(ctx, func(conn *sql.Conn) error {
+		records, err := loadApplied(ctx, conn)
+		if err != nil {
+			return err
+		}
+
+		var targets []Migration
+		for i := len(r.migrations) - 1; i >= 0 && len(targets) < steps; i-- {
+			m := r.migrations[i]
+			if _, ok := records[m.Version]; !ok {
+				continue
+			}
+			if !m.HasDown() {
+				return fmt.Errorf("migration %d_%s has no rollback script", m.Version, m.Name)
+			}
+			targets = append(targets, m)
+		}
+
+		for _, m := range targets {
+			err := inTx(ctx, conn, func(tx *sql.Tx) error {
+				if _, err := tx.ExecContext(ctx, m.DownSQL); err != nil {
+					return err
+				}
+				_, err := tx.ExecContext(
+					ctx,
+					"DELETE FROM "+constants.SCHEMA_MIGRATIONS_TABLE+" WHERE version = $1",
+					m.Version,
+				)
+				return err
+			})
+			if err != nil {
+				return fmt.Errorf("roll back migration %d_%s: %w", m.Version, m.Name, err)
+			}
+			rolledBack = append(rolledBack, m)
+		}
+		return nil
+	})
+	return rolledBack, err
+}
+
+// Force sets the recorded schema version without running any SQL: migrations up to
+// version are marked applied with their current checksums and later ones are
+// unmarked. Use it to adopt a database migrated by hand or to accept edited files.
+func (r *Runner) Force(ctx context.Context, version int64) error {
+	return r.withLock(ctx, func(conn *sql.Conn) error {
+		return inTx(ctx, conn, func(tx *sql.Tx) error {
+			_, err := tx.ExecContext(
+				ctx,
+				"DELETE FROM "+constants.SCHEMA_MIGRATIONS_TABLE+" WHERE version > $1",
+				version,
+			)
+			if err != nil {
+				return err
+			}
+			for _, m := range r.migrations {
+				if m.Version > version {
+					break
+				}
+				_, err := tx.ExecContext(
+					ctx,
+					"INSERT INTO "+constants.SCHEMA_MIGRATIONS_TABLE+
+						" (version, name, checksum) VALUES ($1, $2, $3)"+
+						" ON CONFLICT (version) DO UPDATE"+
+						" SET name = EXCLUDED.name, checksum = EXCLUDED.checksum",
+					m.Version,
+					m.Name,
+					m.Checksum,
+				)
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	})
+}
+
+// Status reports every migration file and every recorded version without a file
+func (r *Runner) Status(ctx context.Context) ([]Status, error) {
+	var statuses []Status
+	err := r.withLock(ctx, func(conn *sql.Conn) error {
+		records, err := loadApplied(ctx, conn)
+		if err != nil {
+			return err
+		}
+		statuses = buildStatuses(r.migrations, records)
+		return nil
+	})
+	return statuses, err
+}
+
+// buildStatuses merges migration files with schema_migrations rows, by version
+func buildStatuses(migrations []Migration, records map[int64]appliedRecord) []Status {
+	statuses := make([]Status, 0, len(migrations))
+	known := make(map[int64]bool, len(migrations))
+	for _, m := range migrations {
+		known[m.Version] = true
+		status := Status{Version: m.Version, Name: m.Name, State: StatePending}
+		if record, ok := records[m.Version]; ok {
+			appliedAt := record.AppliedAt
+			status.AppliedAt = &appliedAt
+			status.State = StateApplied
+			if record.Checksum != m.Checksum {
+				status.State = StateModified
+			}
+		}
+		statuses = append(statuses, status)
+	}
+
+	for version, record := range records {
+		if known[version] {
+			continue
+		}
+		appliedAt := record.AppliedAt
+		statuses = append(statuses, Status{
+			Version:   version,
+			Name:      record.Name,
+			State:     StateMissing,
+			AppliedAt: &appliedAt,
+		})
+	}
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].Version < statuses[j].Version
+	})
+	return statuses
+}
+
+// withLock runs fn on a dedicated connection holding the migration advisory lock.
+// Instances starting together wait here, then find the migrations already applied.
+func (r *Runner) withLock(ctx context.Context, fn func(conn *sql.Conn) error) error {
+	conn, err := r.db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	// Waiting for the lock and building indexes may take longer than the session's
+	// statement_timeout; restore it before the connection returns to the pool
+	if _, err := conn.ExecContext(ctx, "SET statement_timeout = 0"); err != nil {
+		return fmt.Errorf("disable statement timeout: %w", err)
+	}
+	defer func() {
+		_, _ = conn.ExecContext(context.Background(), "RESET statement_timeout")
+	}()
+
+	if _, err := conn.ExecContext(
+		ctx,
+		"SELECT pg_advisory_lock($1)",
+		constants.SCHEMA_MIGRATION_LOCK_KEY,
+	); err != nil {
+		return fmt.Errorf("acquire migration lock: %w", err)
+	}
+	defer func() {
+		// The lock is also released when the session ends, so a failure here is harmless
+		_, _ = conn.ExecContext(
+			context.Background(),
+			"SELECT pg_advisory_unlock($1)",
+			constants.SCHEMA_MIGRATION_LOCK_KEY,
+		)
+	}()
+
+	if _, err := conn.ExecContext(ctx, `CREATE TABLE IF NOT EXISTS `+
+		constants.SCHEMA_MIGRATIONS_TABLE+` (
+		version BIGINT PRIMARY KEY,
+		name TEXT NOT NULL,
+		checksum TEXT NOT NULL,
+		applied_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+	)`); err != nil {
+		return fmt.Errorf("create %s: %w", constants.SCHEMA_MIGRATIONS_TABLE, err)
+	}
+	return fn(conn)
+}
+
+// loadApplied returns the schema_migrations rows keyed by version
+func loadApplied(ctx context.Context, conn *sql.Conn) (map[int64]appliedRecord, error) {
+	rows, err := conn.QueryContext(
+		ctx,
+		"SELECT version, name, checksum, applied_at FROM "+constants.SCHEMA_MIGRATIONS_TABLE,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	records := make(map[int64]appliedRecord)
+	for rows.Next() {
+		var version int64
+		var record appliedRecord
+		err := rows.Scan(&version, &record.Name, &record.Checksum, &record.AppliedAt)
+		if err != nil {
+			return nil, err
+		}
+		records[version] = record
+	}
+	return records, rows.Err()
+}
+
+func insertRecord(ctx context.Context, tx *sql.Tx, m Migration) error {
+	_, err := tx.ExecContext(
+		ctx,
+		"INSERT INTO "+constants.SCHEMA_MIGRATIONS_TABLE+
+			" (version, name, checksum) VALUES ($1, $2, $3)",
+		m.Version,
+		m.Name,
+		m.Checksum,
+	)
+	return err
+}
+
+// inTx runs fn in a transaction on conn, committing when it returns nil
+func inTx(ctx context.Context, conn *sql.Conn, fn func(tx *sql.Tx) error) error {
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if err := fn(tx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
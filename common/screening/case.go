@@ -0,0 +1,69 @@
+package screening
+
+import (
+	"time"
+
+	"ecommerce-be/common/db"
+)
+
+// CaseStatus is the review state of a screening case.
+type CaseStatus string
+
+const (
+	CASE_STATUS_PENDING_REVIEW CaseStatus = "pending_review"
+	// CASE_STATUS_CLEARED means the reviewer found the match to be a false positive
+	CASE_STATUS_CLEARED CaseStatus = "cleared"
+	// CASE_STATUS_CONFIRMED means the reviewer confirmed the subject is a blocked party
+	CASE_STATUS_CONFIRMED CaseStatus = "confirmed"
+)
+
+// ScreeningCase is an entry in the compliance review queue.
+type ScreeningCase struct {
+	db.BaseEntity
+	SubjectType    SubjectType `json:"subjectType"    gorm:"column:subject_type;size:30;not null"`
+	ReferenceID    uint        `json:"referenceId"    gorm:"column:reference_id;not null"`
+	SellerID       uint        `json:"sellerId"       gorm:"column:seller_id;not null"`
+	SubjectNames   string      `json:"subjectNames"   gorm:"column:subject_names;not null"`
+	SubjectAddress string      `json:"subjectAddress" gorm:"column:subject_address"`
+	CountryCode    string      `json:"countryCode"    gorm:"column:country_code;size:2"`
+	Status         CaseStatus  `json:"status"         gorm:"column:status;size:20;not null"`
+	Matches        MatchList   `json:"matches"        gorm:"column:matches;type:jsonb;not null"`
+	// ProviderError is set when a provider failed and the case was queued to be safe
+	ProviderError    *string    `json:"providerError"    gorm:"column:provider_error"`
+	ReviewedByUserID *uint      `json:"reviewedByUserId" gorm:"column:reviewed_by_user_id"`
+	ReviewedAt       *time.Time `json:"reviewedAt"       gorm:"column:reviewed_at"`
+	ReviewNotes      string     `json:"reviewNotes"      gorm:"column:review_notes"`
+}
+
+func (ScreeningCase) TableName() string {
+	return "screening_case"
+}
+
+// AuditAction is what a screening audit log row records.
+type AuditAction string
+
+const (
+	AUDIT_ACTION_SCREENED_CLEAR          AuditAction = "screened_clear"
+	AUDIT_ACTION_SCREENED_FLAGGED        AuditAction = "screened_flagged"
+	AUDIT_ACTION_CASE_CLEARED            AuditAction = "case_cleared"
+	AUDIT_ACTION_CASE_CONFIRMED          AuditAction = "case_confirmed"
+	AUDIT_ACTION_DENY_LIST_ENTRY_ADDED   AuditAction = "deny_list_entry_added"
+	AUDIT_ACTION_DENY_LIST_ENTRY_REMOVED AuditAction = "deny_list_entry_removed"
+)
+
+// ScreeningAuditLog is an append-only record of screening decisions and list changes.
+type ScreeningAuditLog struct {
+	ID          uint        `json:"id"          gorm:"primaryKey"`
+	Action      AuditAction `json:"action"      gorm:"column:action;size:40;not null"`
+	CaseID      *uint       `json:"caseId"      gorm:"column:case_id"`
+	SubjectType SubjectType `json:"subjectType" gorm:"column:subject_type;size:30"`
+	ReferenceID *uint       `json:"referenceId" gorm:"column:reference_id"`
+	// ActorUserID is the admin who acted; nil for automated screening
+	ActorUserID *uint      `json:"actorUserId" gorm:"column:actor_user_id"`
+	Details     db.JSONMap `json:"details"     gorm:"column:details;type:jsonb;not null"`
+	CreatedAt   time.Time  `json:"createdAt"   gorm:"column:created_at;autoCreateTime"`
+}
+
+func (ScreeningAuditLog) TableName() string {
+	return "screening_audit_log"
+}
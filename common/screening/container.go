@@ -0,0 +1,69 @@
+package screening
+
+import (
+	"sync"
+	"time"
+
+	"ecommerce-be/common"
+	"ecommerce-be/common/config"
+	"ecommerce-be/common/constants"
+
+	"github.com/gin-gonic/gin"
+)
+
+var (
+	instance Service
+	once     sync.Once
+)
+
+// GetService returns the singleton screening service. Onboarding and order
+// placement depend on it as a Screener.
+func GetService() Service {
+	once.Do(func() {
+		cfg := screeningConfig()
+		denyListRepo := NewDenyListRepository()
+
+		providers := []Provider{NewDenyListProvider(denyListRepo)}
+		if cfg.Provider == constants.SCREENING_PROVIDER_HTTP && cfg.ProviderURL != "" {
+			providers = append(providers, NewHTTPProvider(
+				cfg.ProviderURL,
+				cfg.ProviderAPIKey,
+				time.Duration(cfg.ProviderTimeout)*time.Second,
+			))
+		}
+
+		instance = NewService(denyListRepo, NewCaseRepository(), providers, cfg.Enabled)
+	})
+	return instance
+}
+
+// NewContainer registers the compliance admin routes
+func NewContainer(router *gin.Engine) *common.Container {
+	c := &common.Container{}
+
+	c.RegisterModule(NewModule(NewHandler(GetService())))
+
+	for _, module := range c.Modules {
+		module.RegisterRoutes(router)
+	}
+
+	return c
+}
+
+// screeningConfig returns the loaded screening config, or the defaults when
+// configuration has not been loaded.
+func screeningConfig() config.ScreeningConfig {
+	if cfg := config.Get(); cfg != nil {
+		return cfg.Screening
+	}
+	return config.ScreeningConfig{
+		Enabled:             true,
+		Provider:            constants.SCREENING_PROVIDER_DENYLIST,
+		HighValueOrderCents: 1000000,
+	}
+}
+
+// HighValueOrderCents returns the order total at or above which orders are screened
+func HighValueOrderCents() int64 {
+	return screeningConfig().HighValueOrderCents
+}
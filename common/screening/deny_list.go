@@ -0,0 +1,184 @@
+package screening
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"ecommerce-be/common/constants"
+	"ecommerce-be/common/db"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// EntryType is the subject field a deny list entry is compared with.
+type EntryType string
+
+const (
+	ENTRY_TYPE_NAME    EntryType = "name"
+	ENTRY_TYPE_ADDRESS EntryType = "address"
+	ENTRY_TYPE_COUNTRY EntryType = "country"
+)
+
+// DenyListEntry is one blocked party, address or country on a named list (e.g. an
+// imported sanctions list or an internal block list). Entries are deactivated rather
+// than deleted so past screening decisions stay explainable.
+type DenyListEntry struct {
+	db.BaseEntity
+	ListName        string    `json:"listName"        gorm:"column:list_name;size:100;not null"`
+	EntryType       EntryType `json:"entryType"       gorm:"column:entry_type;size:20;not null"`
+	Value           string    `json:"value"           gorm:"column:value;size:500;not null"`
+	NormalizedValue string    `json:"normalizedValue" gorm:"column:normalized_value;size:500;not null"`
+	Notes           string    `json:"notes"           gorm:"column:notes"`
+	IsActive        bool      `json:"isActive"        gorm:"column:is_active;not null;default:true"`
+	CreatedByUserID *uint     `json:"createdByUserId" gorm:"column:created_by_user_id"`
+}
+
+func (DenyListEntry) TableName() string {
+	return "screening_deny_list_entry"
+}
+
+// Match is a single list hit.
+type Match struct {
+	// Provider is the screening provider that reported the match
+	Provider  string    `json:"provider"`
+	ListName  string    `json:"listName"`
+	EntryType EntryType `json:"entryType"`
+	// Listed is the value on the list, Matched the subject value it matched
+	Listed  string   `json:"listed"`
+	Matched string   `json:"matched"`
+	Score   *float64 `json:"score,omitempty"`
+}
+
+// MatchList is stored as a JSONB array on the screening case.
+type MatchList []Match
+
+// Scan implements sql.Scanner.
+func (m *MatchList) Scan(value any) error {
+	switch v := value.(type) {
+	case nil:
+		*m = MatchList{}
+		return nil
+	case []byte:
+		return json.Unmarshal(v, m)
+	case string:
+		return json.Unmarshal([]byte(v), m)
+	default:
+		return fmt.Errorf("screening.MatchList: unsupported Scan type %T", value)
+	}
+}
+
+// Value implements driver.Valuer.
+func (m MatchList) Value() (driver.Value, error) {
+	if m == nil {
+		return json.Marshal([]Match{})
+	}
+	return json.Marshal([]Match(m))
+}
+
+// Normalize folds a name or address for comparison: accents are stripped, letters are
+// lower-cased and every run of punctuation or whitespace becomes a single space.
+func Normalize(value string) string {
+	var b strings.Builder
+	space := false
+	for _, r := range norm.NFD.String(value) {
+		switch {
+		case unicode.Is(unicode.Mn, r):
+			continue
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			if space && b.Len() > 0 {
+				b.WriteByte(' ')
+			}
+			space = false
+			b.WriteRune(unicode.ToLower(r))
+		default:
+			space = true
+		}
+	}
+	return b.String()
+}
+
+// MatchDenyList compares a subject with deny list entries.
+//
+//   - name: every word of the entry appears in one of the subject's names, in any
+//     order ("Petrov Ivan" matches "IVAN PETROV TRADING LLC")
+//   - address: the entry appears in the address as a whole-word phrase
+//   - country: the entry equals the subject's country code
+func MatchDenyList(subject Subject, entries []DenyListEntry) []Match {
+	names := make([]normalizedName, 0, len(subject.Names))
+	for _, name := range subject.Names {
+		if normalized := Normalize(name); normalized != "" {
+			names = append(names, normalizedName{raw: name, words: wordSet(normalized)})
+		}
+	}
+	address := Normalize(subject.Address)
+	country := strings.ToUpper(strings.TrimSpace(subject.CountryCode))
+
+	var matches []Match
+	for _, entry := range entries {
+		listed := entry.NormalizedValue
+		if listed == "" {
+			listed = Normalize(entry.Value)
+		}
+		if listed == "" {
+			continue
+		}
+
+		matched := ""
+		switch entry.EntryType {
+		case ENTRY_TYPE_NAME:
+			for _, name := range names {
+				if containsAllWords(name.words, strings.Fields(listed)) {
+					matched = name.raw
+					break
+				}
+			}
+		case ENTRY_TYPE_ADDRESS:
+			if address != "" && strings.Contains(" "+address+" ", " "+listed+" ") {
+				matched = subject.Address
+			}
+		case ENTRY_TYPE_COUNTRY:
+			if country != "" && strings.EqualFold(listed, country) {
+				matched = country
+			}
+		}
+		if matched == "" {
+			continue
+		}
+		matches = append(matches, Match{
+			Provider:  constants.SCREENING_PROVIDER_DENYLIST,
+			ListName:  entry.ListName,
+			EntryType: entry.EntryType,
+			Listed:    entry.Value,
+			Matched:   matched,
+		})
+	}
+	return matches
+}
+
+type normalizedName struct {
+	raw   string
+	words map[string]struct{}
+}
+
+func wordSet(normalized string) map[string]struct{} {
+	words := map[string]struct{}{}
+	for _, w := range strings.Fields(normalized) {
+		words[w] = struct{}{}
+	}
+	return words
+}
+
+func containsAllWords(words map[string]struct{}, required []string) bool {
+	if len(required) == 0 {
+		return false
+	}
+	for _, w := range required {
+		if _, ok := words[w]; !ok {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,43 @@
+package screening
+
+import (
+	"net/http"
+
+	"ecommerce-be/common/constants"
+	commonError "ecommerce-be/common/error"
+)
+
+var (
+	ErrCaseNotFound = &commonError.AppError{
+		Code:       constants.SCREENING_CASE_NOT_FOUND_CODE,
+		Message:    constants.SCREENING_CASE_NOT_FOUND_MSG,
+		StatusCode: http.StatusNotFound,
+	}
+
+	ErrCaseAlreadyResolved = &commonError.AppError{
+		Code:       constants.SCREENING_CASE_ALREADY_RESOLVED_CODE,
+		Message:    constants.SCREENING_CASE_ALREADY_RESOLVED_MSG,
+		StatusCode: http.StatusConflict,
+	}
+
+	ErrDenyListEntryNotFound = &commonError.AppError{
+		Code:       constants.DENY_LIST_ENTRY_NOT_FOUND_CODE,
+		Message:    constants.DENY_LIST_ENTRY_NOT_FOUND_MSG,
+		StatusCode: http.StatusNotFound,
+	}
+
+	ErrInvalidDenyListEntry = &commonError.AppError{
+		Code:       constants.DENY_LIST_ENTRY_INVALID_CODE,
+		Message:    constants.DENY_LIST_ENTRY_INVALID_MSG,
+		StatusCode: http.StatusBadRequest,
+	}
+)
+
+func init() {
+	commonError.Register(
+		ErrCaseNotFound,
+		ErrCaseAlreadyResolved,
+		ErrDenyListEntryNotFound,
+		ErrInvalidDenyListEntry,
+	)
+}
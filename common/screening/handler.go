@@ -0,0 +1,164 @@
+package screening
+
+import (
+	"net/http"
+
+	"ecommerce-be/common/auth"
+	"ecommerce-be/common/constants"
+	errs "ecommerce-be/common/error"
+	"ecommerce-be/common/handler"
+	"ecommerce-be/common/log"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Handler handles the compliance screening admin API
+type Handler struct {
+	*handler.BaseHandler
+	service Service
+}
+
+// NewHandler creates a new instance of Handler
+func NewHandler(service Service) *Handler {
+	return &Handler{
+		BaseHandler: handler.NewBaseHandler(),
+		service:     service,
+	}
+}
+
+// ListDenyList handles listing deny list entries
+// GET /api/compliance/deny-list
+func (h *Handler) ListDenyList(c *gin.Context) {
+	var params ListDenyListQueryParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	response, err := h.service.ListDenyList(c, params)
+	if err != nil {
+		log.ErrorWithContext(c, "listDenyList: failed", err)
+		h.HandleError(c, err, constants.FAILED_TO_LIST_DENY_LIST_MSG)
+		return
+	}
+
+	h.Success(c, http.StatusOK, constants.SCREENING_DENY_LIST_LISTED_MSG, response)
+}
+
+// AddDenyListEntry handles adding a deny list entry
+// POST /api/compliance/deny-list
+func (h *Handler) AddDenyListEntry(c *gin.Context) {
+	userID, exists := auth.GetUserIDFromContext(c)
+	if !exists {
+		h.HandleError(c, errs.UnauthorizedError, constants.AUTHENTICATION_REQUIRED_MSG)
+		return
+	}
+
+	var req CreateDenyListEntryRequest
+	if err := h.BindJSON(c, &req); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	entry, err := h.service.AddDenyListEntry(c, userID, req)
+	if err != nil {
+		log.ErrorWithContext(c, "addDenyListEntry: failed", err)
+		h.HandleError(c, err, constants.FAILED_TO_ADD_DENY_LIST_ENTRY_MSG)
+		return
+	}
+
+	h.SuccessWithData(c, http.StatusCreated, constants.SCREENING_DENY_LIST_ADDED_MSG, "entry", entry)
+}
+
+// RemoveDenyListEntry handles deactivating a deny list entry
+// DELETE /api/compliance/deny-list/:id
+func (h *Handler) RemoveDenyListEntry(c *gin.Context) {
+	userID, exists := auth.GetUserIDFromContext(c)
+	if !exists {
+		h.HandleError(c, errs.UnauthorizedError, constants.AUTHENTICATION_REQUIRED_MSG)
+		return
+	}
+
+	entryID, err := h.ParseUintParam(c, "id")
+	if err != nil {
+		h.HandleError(c, err, constants.FAILED_TO_REMOVE_DENY_LIST_ENTRY_MSG)
+		return
+	}
+
+	if err := h.service.RemoveDenyListEntry(c, userID, entryID); err != nil {
+		log.ErrorWithContext(c, "removeDenyListEntry: failed", err)
+		h.HandleError(c, err, constants.FAILED_TO_REMOVE_DENY_LIST_ENTRY_MSG)
+		return
+	}
+
+	h.Success(c, http.StatusOK, constants.SCREENING_DENY_LIST_REMOVED_MSG, nil)
+}
+
+// ListCases handles listing the compliance review queue
+// GET /api/compliance/cases
+func (h *Handler) ListCases(c *gin.Context) {
+	var params ListCasesQueryParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	response, err := h.service.ListCases(c, params)
+	if err != nil {
+		log.ErrorWithContext(c, "listScreeningCases: failed", err)
+		h.HandleError(c, err, constants.FAILED_TO_LIST_SCREENING_CASES_MSG)
+		return
+	}
+
+	h.Success(c, http.StatusOK, constants.SCREENING_CASES_LISTED_MSG, response)
+}
+
+// GetCase handles fetching a case with its audit trail
+// GET /api/compliance/cases/:id
+func (h *Handler) GetCase(c *gin.Context) {
+	caseID, err := h.ParseUintParam(c, "id")
+	if err != nil {
+		h.HandleError(c, err, constants.FAILED_TO_FETCH_SCREENING_CASE_MSG)
+		return
+	}
+
+	response, err := h.service.GetCase(c, caseID)
+	if err != nil {
+		log.ErrorWithContext(c, "getScreeningCase: failed", err)
+		h.HandleError(c, err, constants.FAILED_TO_FETCH_SCREENING_CASE_MSG)
+		return
+	}
+
+	h.SuccessWithData(c, http.StatusOK, constants.SCREENING_CASE_FETCHED_MSG, "case", response)
+}
+
+// ResolveCase handles recording a reviewer's decision
+// POST /api/compliance/cases/:id/resolve
+func (h *Handler) ResolveCase(c *gin.Context) {
+	userID, exists := auth.GetUserIDFromContext(c)
+	if !exists {
+		h.HandleError(c, errs.UnauthorizedError, constants.AUTHENTICATION_REQUIRED_MSG)
+		return
+	}
+
+	caseID, err := h.ParseUintParam(c, "id")
+	if err != nil {
+		h.HandleError(c, err, constants.FAILED_TO_RESOLVE_SCREENING_CASE_MSG)
+		return
+	}
+
+	var req ResolveCaseRequest
+	if err := h.BindJSON(c, &req); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	response, err := h.service.ResolveCase(c, userID, caseID, req)
+	if err != nil {
+		log.ErrorWithContext(c, "resolveScreeningCase: failed", err)
+		h.HandleError(c, err, constants.FAILED_TO_RESOLVE_SCREENING_CASE_MSG)
+		return
+	}
+
+	h.SuccessWithData(c, http.StatusOK, constants.SCREENING_CASE_RESOLVED_MSG, "case", response)
+}
@@ -0,0 +1,116 @@
+package screening
+
+import (
+	"time"
+
+	"ecommerce-be/common"
+)
+
+// ========================================
+// REQUEST MODELS
+// ========================================
+
+// CreateDenyListEntryRequest - Adds a blocked name, address or country to a list
+type CreateDenyListEntryRequest struct {
+	ListName  string    `json:"listName"  binding:"required,max=100"`
+	EntryType EntryType `json:"entryType" binding:"required,oneof=name address country"`
+	Value     string    `json:"value"     binding:"required,max=500"`
+	Notes     string    `json:"notes"     binding:"omitempty,max=2000"`
+}
+
+// ListDenyListQueryParams - Filters for the deny list
+type ListDenyListQueryParams struct {
+	ListName        string `form:"listName"        binding:"omitempty,max=100"`
+	EntryType       string `form:"entryType"       binding:"omitempty,oneof=name address country"`
+	IncludeInactive bool   `form:"includeInactive"`
+	Page            int    `form:"page"            binding:"omitempty,min=1"`
+	PageSize        int    `form:"pageSize"        binding:"omitempty,min=1,max=100"`
+}
+
+// ListCasesQueryParams - Filters for the review queue
+type ListCasesQueryParams struct {
+	Status      string `form:"status"      binding:"omitempty,oneof=pending_review cleared confirmed"`
+	SubjectType string `form:"subjectType" binding:"omitempty,oneof=seller_onboarding order"`
+	Page        int    `form:"page"        binding:"omitempty,min=1"`
+	PageSize    int    `form:"pageSize"    binding:"omitempty,min=1,max=100"`
+}
+
+// ResolveCaseRequest - Records the reviewer's decision on a pending case
+type ResolveCaseRequest struct {
+	Status CaseStatus `json:"status" binding:"required,oneof=cleared confirmed"`
+	Notes  string     `json:"notes"  binding:"required,max=2000"`
+}
+
+// ========================================
+// RESPONSE MODELS
+// ========================================
+
+// DenyListResponse is a page of deny list entries
+type DenyListResponse struct {
+	Entries    []DenyListEntry           `json:"entries"`
+	Pagination common.PaginationResponse `json:"pagination"`
+}
+
+// CaseResponse describes a review queue entry
+type CaseResponse struct {
+	ID               uint        `json:"id"`
+	SubjectType      SubjectType `json:"subjectType"`
+	ReferenceID      uint        `json:"referenceId"`
+	SellerID         uint        `json:"sellerId"`
+	SubjectNames     string      `json:"subjectNames"`
+	SubjectAddress   string      `json:"subjectAddress"`
+	CountryCode      string      `json:"countryCode"`
+	Status           CaseStatus  `json:"status"`
+	Matches          []Match     `json:"matches"`
+	ProviderError    *string     `json:"providerError"`
+	ReviewedByUserID *uint       `json:"reviewedByUserId"`
+	ReviewedAt       *string     `json:"reviewedAt"`
+	ReviewNotes      string      `json:"reviewNotes"`
+	CreatedAt        string      `json:"createdAt"`
+	// AuditTrail is only included when a single case is fetched
+	AuditTrail []ScreeningAuditLog `json:"auditTrail,omitempty"`
+}
+
+// CaseListResponse is a page of review queue entries
+type CaseListResponse struct {
+	Cases      []CaseResponse            `json:"cases"`
+	Pagination common.PaginationResponse `json:"pagination"`
+}
+
+// BuildCaseResponse maps a case entity to its API response
+func BuildCaseResponse(screeningCase *ScreeningCase) CaseResponse {
+	resp := CaseResponse{
+		ID:               screeningCase.ID,
+		SubjectType:      screeningCase.SubjectType,
+		ReferenceID:      screeningCase.ReferenceID,
+		SellerID:         screeningCase.SellerID,
+		SubjectNames:     screeningCase.SubjectNames,
+		SubjectAddress:   screeningCase.SubjectAddress,
+		CountryCode:      screeningCase.CountryCode,
+		Status:           screeningCase.Status,
+		Matches:          screeningCase.Matches,
+		ProviderError:    screeningCase.ProviderError,
+		ReviewedByUserID: screeningCase.ReviewedByUserID,
+		ReviewNotes:      screeningCase.ReviewNotes,
+		CreatedAt:        screeningCase.CreatedAt.UTC().Format(time.RFC3339),
+	}
+	if resp.Matches == nil {
+		resp.Matches = []Match{}
+	}
+	if screeningCase.ReviewedAt != nil {
+		reviewedAt := screeningCase.ReviewedAt.UTC().Format(time.RFC3339)
+		resp.ReviewedAt = &reviewedAt
+	}
+	return resp
+}
+
+// normalizePage applies the default page and page size
+func normalizePage(page, pageSize int) (int, int) {
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = 20
+	}
+	return page, pageSize
+}
@@ -0,0 +1,121 @@
+package screening
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"ecommerce-be/common/constants"
+)
+
+// Provider checks a subject against one source of blocked parties.
+type Provider interface {
+	Name() string
+	Check(ctx context.Context, subject Subject) ([]Match, error)
+}
+
+// denyListProvider matches against the active entries of the configured deny lists
+type denyListProvider struct {
+	repo DenyListRepository
+}
+
+// NewDenyListProvider creates a Provider backed by the deny list table.
+func NewDenyListProvider(repo DenyListRepository) Provider {
+	return &denyListProvider{repo: repo}
+}
+
+func (p *denyListProvider) Name() string {
+	return constants.SCREENING_PROVIDER_DENYLIST
+}
+
+func (p *denyListProvider) Check(ctx context.Context, subject Subject) ([]Match, error) {
+	entries, err := p.repo.FindActive(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return MatchDenyList(subject, entries), nil
+}
+
+// httpProvider calls an external screening service.
+//
+// Request:  POST {url} {"subjectType","referenceId","names","address","countryCode"}
+// Response: 200 {"matches":[{"listName","entryType","listed","matched","score"}]}
+type httpProvider struct {
+	url        string
+	apiKey     string
+	httpClient *http.Client
+}
+
+// NewHTTPProvider creates a Provider for an external screening service.
+func NewHTTPProvider(url, apiKey string, timeout time.Duration) Provider {
+	return &httpProvider{
+		url:        url,
+		apiKey:     apiKey,
+		httpClient: &http.Client{Timeout: timeout},
+	}
+}
+
+func (p *httpProvider) Name() string {
+	return constants.SCREENING_PROVIDER_HTTP
+}
+
+type httpScreeningRequest struct {
+	SubjectType SubjectType `json:"subjectType"`
+	ReferenceID uint        `json:"referenceId"`
+	Names       []string    `json:"names"`
+	Address     string      `json:"address,omitempty"`
+	CountryCode string      `json:"countryCode,omitempty"`
+}
+
+type httpScreeningResponse struct {
+	Matches []Match `json:"matches"`
+}
+
+func (p *httpProvider) Check(ctx context.Context, subject Subject) ([]Match, error) {
+	body, err := json.Marshal(httpScreeningRequest{
+		SubjectType: subject.Type,
+		ReferenceID: subject.ReferenceID,
+		Names:       subject.Names,
+		Address:     subject.Address,
+		CountryCode: subject.CountryCode,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if p.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+p.apiKey)
+	}
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	raw, err := io.ReadAll(io.LimitReader(resp.Body, constants.SCREENING_PROVIDER_MAX_RESPONSE_BYTES))
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("screening provider: status %d: %s", resp.StatusCode, raw)
+	}
+
+	var parsed httpScreeningResponse
+	if err := json.Unmarshal(raw, &parsed); err != nil {
+		return nil, fmt.Errorf("screening provider: malformed response: %w", err)
+	}
+	for i := range parsed.Matches {
+		parsed.Matches[i].Provider = p.Name()
+	}
+	return parsed.Matches, nil
+}
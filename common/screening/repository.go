@@ -0,0 +1,184 @@
+package screening
+
+import (
+	"context"
+	"errors"
+
+	"ecommerce-be/common/db"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// DenyListRepository defines database operations for deny list entries
+type DenyListRepository interface {
+	Create(ctx context.Context, entry *DenyListEntry) error
+	Save(ctx context.Context, entry *DenyListEntry) error
+	FindByID(ctx context.Context, id uint) (*DenyListEntry, error)
+	FindActive(ctx context.Context) ([]DenyListEntry, error)
+	FindAll(ctx context.Context, params ListDenyListQueryParams) ([]DenyListEntry, int64, error)
+}
+
+// CaseRepository defines database operations for the review queue and its audit log
+type CaseRepository interface {
+	Create(ctx context.Context, screeningCase *ScreeningCase) error
+	Save(ctx context.Context, screeningCase *ScreeningCase) error
+	FindByID(ctx context.Context, id uint) (*ScreeningCase, error)
+	// FindByIDForUpdate locks the case so two reviewers cannot resolve it concurrently
+	FindByIDForUpdate(ctx context.Context, id uint) (*ScreeningCase, error)
+	FindAll(ctx context.Context, params ListCasesQueryParams) ([]ScreeningCase, int64, error)
+
+	CreateAuditLog(ctx context.Context, entry *ScreeningAuditLog) error
+	FindAuditLogsByCaseID(ctx context.Context, caseID uint) ([]ScreeningAuditLog, error)
+}
+
+// DenyListRepositoryImpl implements DenyListRepository
+type DenyListRepositoryImpl struct{}
+
+// NewDenyListRepository creates a new instance of DenyListRepository
+func NewDenyListRepository() DenyListRepository {
+	return &DenyListRepositoryImpl{}
+}
+
+// Create persists a new entry
+func (r *DenyListRepositoryImpl) Create(ctx context.Context, entry *DenyListEntry) error {
+	return db.DB(ctx).Create(entry).Error
+}
+
+// Save writes every column of the entry
+func (r *DenyListRepositoryImpl) Save(ctx context.Context, entry *DenyListEntry) error {
+	return db.DB(ctx).Save(entry).Error
+}
+
+// FindByID returns the entry or nil when it does not exist
+func (r *DenyListRepositoryImpl) FindByID(ctx context.Context, id uint) (*DenyListEntry, error) {
+	var entry DenyListEntry
+	err := db.DB(ctx).Where("id = ?", id).First(&entry).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &entry, nil
+}
+
+// FindActive returns every active entry across all lists
+func (r *DenyListRepositoryImpl) FindActive(ctx context.Context) ([]DenyListEntry, error) {
+	var entries []DenyListEntry
+	err := db.DB(ctx).Where("is_active = ?", true).Find(&entries).Error
+	return entries, err
+}
+
+// FindAll returns a page of entries, newest first
+func (r *DenyListRepositoryImpl) FindAll(
+	ctx context.Context,
+	params ListDenyListQueryParams,
+) ([]DenyListEntry, int64, error) {
+	query := db.DB(ctx).Model(&DenyListEntry{})
+	if params.ListName != "" {
+		query = query.Where("list_name = ?", params.ListName)
+	}
+	if params.EntryType != "" {
+		query = query.Where("entry_type = ?", params.EntryType)
+	}
+	if !params.IncludeInactive {
+		query = query.Where("is_active = ?", true)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var entries []DenyListEntry
+	err := query.Order("id DESC").
+		Offset((params.Page - 1) * params.PageSize).
+		Limit(params.PageSize).
+		Find(&entries).Error
+	return entries, total, err
+}
+
+// CaseRepositoryImpl implements CaseRepository
+type CaseRepositoryImpl struct{}
+
+// NewCaseRepository creates a new instance of CaseRepository
+func NewCaseRepository() CaseRepository {
+	return &CaseRepositoryImpl{}
+}
+
+// Create persists a new case
+func (r *CaseRepositoryImpl) Create(ctx context.Context, screeningCase *ScreeningCase) error {
+	return db.DB(ctx).Create(screeningCase).Error
+}
+
+// Save writes every column of the case
+func (r *CaseRepositoryImpl) Save(ctx context.Context, screeningCase *ScreeningCase) error {
+	return db.DB(ctx).Save(screeningCase).Error
+}
+
+// FindByID returns the case or nil when it does not exist
+func (r *CaseRepositoryImpl) FindByID(ctx context.Context, id uint) (*ScreeningCase, error) {
+	return findCase(db.DB(ctx).Where("id = ?", id))
+}
+
+// FindByIDForUpdate returns the case with a row lock, or nil when it does not exist
+func (r *CaseRepositoryImpl) FindByIDForUpdate(
+	ctx context.Context,
+	id uint,
+) (*ScreeningCase, error) {
+	return findCase(db.DB(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", id))
+}
+
+// FindAll returns a page of cases, oldest pending first so the queue is worked in order
+func (r *CaseRepositoryImpl) FindAll(
+	ctx context.Context,
+	params ListCasesQueryParams,
+) ([]ScreeningCase, int64, error) {
+	query := db.DB(ctx).Model(&ScreeningCase{})
+	if params.Status != "" {
+		query = query.Where("status = ?", params.Status)
+	}
+	if params.SubjectType != "" {
+		query = query.Where("subject_type = ?", params.SubjectType)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var cases []ScreeningCase
+	err := query.Order("id ASC").
+		Offset((params.Page - 1) * params.PageSize).
+		Limit(params.PageSize).
+		Find(&cases).Error
+	return cases, total, err
+}
+
+// CreateAuditLog appends an audit log row
+func (r *CaseRepositoryImpl) CreateAuditLog(ctx context.Context, entry *ScreeningAuditLog) error {
+	return db.DB(ctx).Create(entry).Error
+}
+
+// FindAuditLogsByCaseID returns the case's audit trail in order
+func (r *CaseRepositoryImpl) FindAuditLogsByCaseID(
+	ctx context.Context,
+	caseID uint,
+) ([]ScreeningAuditLog, error) {
+	var logs []ScreeningAuditLog
+	err := db.DB(ctx).Where("case_id = ?", caseID).Order("id ASC").Find(&logs).Error
+	return logs, err
+}
+
+func findCase(query *gorm.DB) (*ScreeningCase, error) {
+	var screeningCase ScreeningCase
+	err := query.First(&screeningCase).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &screeningCase, nil
+}
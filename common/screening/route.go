@@ -0,0 +1,65 @@
+package screening
+
+import (
+	"net/http"
+
+	"ecommerce-be/common/constants"
+	"ecommerce-be/common/middleware"
+	"ecommerce-be/common/openapi"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Module registers the compliance screening admin routes
+type Module struct {
+	handler *Handler
+}
+
+// NewModule creates a new instance of Module
+func NewModule(handler *Handler) *Module {
+	return &Module{handler: handler}
+}
+
+// RegisterRoutes registers compliance routes (admin only)
+func (m *Module) RegisterRoutes(router *gin.Engine) {
+	complianceRoutes := openapi.NewGroup(router.Group(constants.APIBaseCompliance), "Compliance")
+	complianceRoutes.Use(middleware.AdminAuth())
+	{
+		// GET /api/compliance/deny-list - Deny list entries, newest first
+		// Query params: ?listName=ofac&entryType=name&includeInactive=true
+		complianceRoutes.GET("/deny-list", m.handler.ListDenyList).
+			Summary("List deny list entries").
+			Query(ListDenyListQueryParams{}).
+			Returns(http.StatusOK, DenyListResponse{})
+
+		// POST /api/compliance/deny-list - Add a blocked name, address or country
+		// Request: CreateDenyListEntryRequest
+		complianceRoutes.POST("/deny-list", m.handler.AddDenyListEntry).
+			Summary("Add a deny list entry").
+			Body(CreateDenyListEntryRequest{}).
+			ReturnsField(http.StatusCreated, "entry", DenyListEntry{})
+
+		// DELETE /api/compliance/deny-list/:id - Deactivate an entry
+		complianceRoutes.DELETE("/deny-list/:id", m.handler.RemoveDenyListEntry).
+			Summary("Remove a deny list entry")
+
+		// GET /api/compliance/cases - Review queue, oldest first
+		// Query params: ?status=pending_review&subjectType=order
+		complianceRoutes.GET("/cases", m.handler.ListCases).
+			Summary("List screening cases").
+			Query(ListCasesQueryParams{}).
+			Returns(http.StatusOK, CaseListResponse{})
+
+		// GET /api/compliance/cases/:id - Case with its audit trail
+		complianceRoutes.GET("/cases/:id", m.handler.GetCase).
+			Summary("Get a screening case").
+			ReturnsField(http.StatusOK, "case", CaseResponse{})
+
+		// POST /api/compliance/cases/:id/resolve - Clear or confirm a pending case
+		// Request: ResolveCaseRequest
+		complianceRoutes.POST("/cases/:id/resolve", m.handler.ResolveCase).
+			Summary("Resolve a screening case").
+			Body(ResolveCaseRequest{}).
+			ReturnsField(http.StatusOK, "case", CaseResponse{})
+	}
+}
@@ -0,0 +1,56 @@
+// Package screening checks sellers and buyers against sanctions / blocked-party lists.
+// Modules call Screen at seller onboarding and high-value order placement; subjects with
+// matches land in a compliance review queue that admins resolve through the API, and
+// every screening and review decision is written to an audit log.
+package screening
+
+import "context"
+
+// SubjectType identifies the business flow that requested screening.
+type SubjectType string
+
+const (
+	SUBJECT_SELLER_ONBOARDING SubjectType = "seller_onboarding"
+	SUBJECT_ORDER             SubjectType = "order"
+)
+
+// Decision is the outcome of screening a subject.
+type Decision string
+
+const (
+	// DECISION_CLEAR means no list matched
+	DECISION_CLEAR Decision = "clear"
+	// DECISION_REVIEW means the subject was queued for compliance review, either
+	// because a list matched or because a provider could not be reached
+	DECISION_REVIEW Decision = "review"
+)
+
+// Subject is the party being screened.
+type Subject struct {
+	Type SubjectType
+	// ReferenceID is the seller user ID or order ID the screening belongs to
+	ReferenceID uint
+	SellerID    uint
+	// Names holds every name to check, e.g. business name and account holder
+	Names []string
+	// Address is a single-line postal address; empty when unknown
+	Address string
+	// CountryCode is the ISO 3166-1 alpha-2 country; empty when unknown
+	CountryCode string
+}
+
+// Result is returned to the calling flow.
+type Result struct {
+	Decision Decision
+	// CaseID is the review queue entry created for DECISION_REVIEW
+	CaseID *uint
+	// Matches lists what triggered the review
+	Matches []Match
+}
+
+// Screener is the entry point used by other modules. Screening never blocks the
+// calling flow by itself: callers decide how to react to DECISION_REVIEW and MUST
+// treat errors as best-effort.
+type Screener interface {
+	Screen(ctx context.Context, subject Subject) (*Result, error)
+}
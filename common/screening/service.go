@@ -0,0 +1,325 @@
+package screening
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"ecommerce-be/common"
+	"ecommerce-be/common/db"
+	"ecommerce-be/common/log"
+)
+
+// Service screens subjects and manages the compliance review queue and deny lists.
+//
+// A subject with any match is queued for review. When a provider cannot be reached
+// the subject is queued as well, so an outage never silently clears a blocked party.
+type Service interface {
+	Screener
+
+	ListDenyList(ctx context.Context, params ListDenyListQueryParams) (*DenyListResponse, error)
+	AddDenyListEntry(
+		ctx context.Context,
+		userID uint,
+		req CreateDenyListEntryRequest,
+	) (*DenyListEntry, error)
+	RemoveDenyListEntry(ctx context.Context, userID uint, entryID uint) error
+
+	ListCases(ctx context.Context, params ListCasesQueryParams) (*CaseListResponse, error)
+	GetCase(ctx context.Context, caseID uint) (*CaseResponse, error)
+	ResolveCase(
+		ctx context.Context,
+		userID uint,
+		caseID uint,
+		req ResolveCaseRequest,
+	) (*CaseResponse, error)
+}
+
+// ServiceImpl implements Service
+type ServiceImpl struct {
+	denyListRepo DenyListRepository
+	caseRepo     CaseRepository
+	providers    []Provider
+	enabled      bool
+}
+
+// NewService creates a new instance of Service. Providers are consulted in order and
+// their matches combined. A disabled service clears every subject without recording it.
+func NewService(
+	denyListRepo DenyListRepository,
+	caseRepo CaseRepository,
+	providers []Provider,
+	enabled bool,
+) Service {
+	return &ServiceImpl{
+		denyListRepo: denyListRepo,
+		caseRepo:     caseRepo,
+		providers:    providers,
+		enabled:      enabled,
+	}
+}
+
+// Screen runs every provider and queues the subject for review on a match or a
+// provider failure. Clear results are only written to the audit log.
+func (s *ServiceImpl) Screen(ctx context.Context, subject Subject) (*Result, error) {
+	if !s.enabled {
+		return &Result{Decision: DECISION_CLEAR}, nil
+	}
+
+	var (
+		matches        []Match
+		providerErrors []string
+	)
+	for _, provider := range s.providers {
+		found, err := provider.Check(ctx, subject)
+		if err != nil {
+			log.ErrorWithContext(ctx, "screening provider "+provider.Name()+" failed", err)
+			providerErrors = append(providerErrors, provider.Name()+": "+err.Error())
+			continue
+		}
+		matches = append(matches, found...)
+	}
+
+	referenceID := subject.ReferenceID
+	if len(matches) == 0 && len(providerErrors) == 0 {
+		err := s.caseRepo.CreateAuditLog(ctx, &ScreeningAuditLog{
+			Action:      AUDIT_ACTION_SCREENED_CLEAR,
+			SubjectType: subject.Type,
+			ReferenceID: &referenceID,
+			Details:     subjectDetails(subject),
+		})
+		return &Result{Decision: DECISION_CLEAR}, err
+	}
+
+	screeningCase := &ScreeningCase{
+		SubjectType:    subject.Type,
+		ReferenceID:    subject.ReferenceID,
+		SellerID:       subject.SellerID,
+		SubjectNames:   strings.Join(subject.Names, "; "),
+		SubjectAddress: subject.Address,
+		CountryCode:    strings.ToUpper(subject.CountryCode),
+		Status:         CASE_STATUS_PENDING_REVIEW,
+		Matches:        matches,
+	}
+	if len(providerErrors) > 0 {
+		joined := strings.Join(providerErrors, "; ")
+		screeningCase.ProviderError = &joined
+	}
+
+	err := db.WithTransaction(ctx, func(txCtx context.Context) error {
+		if err := s.caseRepo.Create(txCtx, screeningCase); err != nil {
+			return err
+		}
+		details := subjectDetails(subject)
+		details["matchCount"] = len(matches)
+		return s.caseRepo.CreateAuditLog(txCtx, &ScreeningAuditLog{
+			Action:      AUDIT_ACTION_SCREENED_FLAGGED,
+			CaseID:      &screeningCase.ID,
+			SubjectType: subject.Type,
+			ReferenceID: &referenceID,
+			Details:     details,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.WarnWithContext(ctx, "screening: subject queued for compliance review")
+	return &Result{Decision: DECISION_REVIEW, CaseID: &screeningCase.ID, Matches: matches}, nil
+}
+
+// ListDenyList returns a page of deny list entries
+func (s *ServiceImpl) ListDenyList(
+	ctx context.Context,
+	params ListDenyListQueryParams,
+) (*DenyListResponse, error) {
+	params.Page, params.PageSize = normalizePage(params.Page, params.PageSize)
+	entries, total, err := s.denyListRepo.FindAll(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	if entries == nil {
+		entries = []DenyListEntry{}
+	}
+	return &DenyListResponse{
+		Entries:    entries,
+		Pagination: common.NewPaginationResponse(params.Page, params.PageSize, total),
+	}, nil
+}
+
+// AddDenyListEntry adds an entry; it is used by the next screening
+func (s *ServiceImpl) AddDenyListEntry(
+	ctx context.Context,
+	userID uint,
+	req CreateDenyListEntryRequest,
+) (*DenyListEntry, error) {
+	value := strings.TrimSpace(req.Value)
+	normalized := Normalize(value)
+	if req.EntryType == ENTRY_TYPE_COUNTRY {
+		value = strings.ToUpper(value)
+		if len(normalized) != 2 {
+			return nil, ErrInvalidDenyListEntry
+		}
+	}
+	if normalized == "" {
+		return nil, ErrInvalidDenyListEntry
+	}
+
+	entry := &DenyListEntry{
+		ListName:        strings.TrimSpace(req.ListName),
+		EntryType:       req.EntryType,
+		Value:           value,
+		NormalizedValue: normalized,
+		Notes:           req.Notes,
+		IsActive:        true,
+		CreatedByUserID: &userID,
+	}
+
+	err := db.WithTransaction(ctx, func(txCtx context.Context) error {
+		if err := s.denyListRepo.Create(txCtx, entry); err != nil {
+			return err
+		}
+		return s.caseRepo.CreateAuditLog(txCtx, denyListAudit(
+			AUDIT_ACTION_DENY_LIST_ENTRY_ADDED,
+			userID,
+			entry,
+		))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
+
+// RemoveDenyListEntry deactivates an entry
+func (s *ServiceImpl) RemoveDenyListEntry(ctx context.Context, userID uint, entryID uint) error {
+	return db.WithTransaction(ctx, func(txCtx context.Context) error {
+		entry, err := s.denyListRepo.FindByID(txCtx, entryID)
+		if err != nil {
+			return err
+		}
+		if entry == nil || !entry.IsActive {
+			return ErrDenyListEntryNotFound
+		}
+
+		entry.IsActive = false
+		if err := s.denyListRepo.Save(txCtx, entry); err != nil {
+			return err
+		}
+		return s.caseRepo.CreateAuditLog(txCtx, denyListAudit(
+			AUDIT_ACTION_DENY_LIST_ENTRY_REMOVED,
+			userID,
+			entry,
+		))
+	})
+}
+
+// ListCases returns a page of the review queue
+func (s *ServiceImpl) ListCases(
+	ctx context.Context,
+	params ListCasesQueryParams,
+) (*CaseListResponse, error) {
+	params.Page, params.PageSize = normalizePage(params.Page, params.PageSize)
+	cases, total, err := s.caseRepo.FindAll(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]CaseResponse, 0, len(cases))
+	for i := range cases {
+		responses = append(responses, BuildCaseResponse(&cases[i]))
+	}
+	return &CaseListResponse{
+		Cases:      responses,
+		Pagination: common.NewPaginationResponse(params.Page, params.PageSize, total),
+	}, nil
+}
+
+// GetCase returns a case with its audit trail
+func (s *ServiceImpl) GetCase(ctx context.Context, caseID uint) (*CaseResponse, error) {
+	screeningCase, err := s.caseRepo.FindByID(ctx, caseID)
+	if err != nil {
+		return nil, err
+	}
+	if screeningCase == nil {
+		return nil, ErrCaseNotFound
+	}
+
+	trail, err := s.caseRepo.FindAuditLogsByCaseID(ctx, caseID)
+	if err != nil {
+		return nil, err
+	}
+	resp := BuildCaseResponse(screeningCase)
+	resp.AuditTrail = trail
+	return &resp, nil
+}
+
+// ResolveCase records the reviewer's decision. A case is resolved exactly once.
+func (s *ServiceImpl) ResolveCase(
+	ctx context.Context,
+	userID uint,
+	caseID uint,
+	req ResolveCaseRequest,
+) (*CaseResponse, error) {
+	err := db.WithTransaction(ctx, func(txCtx context.Context) error {
+		screeningCase, err := s.caseRepo.FindByIDForUpdate(txCtx, caseID)
+		if err != nil {
+			return err
+		}
+		if screeningCase == nil {
+			return ErrCaseNotFound
+		}
+		if screeningCase.Status != CASE_STATUS_PENDING_REVIEW {
+			return ErrCaseAlreadyResolved
+		}
+
+		now := time.Now()
+		screeningCase.Status = req.Status
+		screeningCase.ReviewedByUserID = &userID
+		screeningCase.ReviewedAt = &now
+		screeningCase.ReviewNotes = req.Notes
+		if err := s.caseRepo.Save(txCtx, screeningCase); err != nil {
+			return err
+		}
+
+		action := AUDIT_ACTION_CASE_CLEARED
+		if req.Status == CASE_STATUS_CONFIRMED {
+			action = AUDIT_ACTION_CASE_CONFIRMED
+		}
+		referenceID := screeningCase.ReferenceID
+		return s.caseRepo.CreateAuditLog(txCtx, &ScreeningAuditLog{
+			Action:      action,
+			CaseID:      &screeningCase.ID,
+			SubjectType: screeningCase.SubjectType,
+			ReferenceID: &referenceID,
+			ActorUserID: &userID,
+			Details:     db.JSONMap{"notes": req.Notes},
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.GetCase(ctx, caseID)
+}
+
+func subjectDetails(subject Subject) db.JSONMap {
+	return db.JSONMap{
+		"sellerId":    subject.SellerID,
+		"names":       subject.Names,
+		"address":     subject.Address,
+		"countryCode": subject.CountryCode,
+	}
+}
+
+func denyListAudit(action AuditAction, userID uint, entry *DenyListEntry) *ScreeningAuditLog {
+	return &ScreeningAuditLog{
+		Action:      action,
+		ActorUserID: &userID,
+		Details: db.JSONMap{
+			"entryId":   entry.ID,
+			"listName":  entry.ListName,
+			"entryType": entry.EntryType,
+			"value":     entry.Value,
+		},
+	}
+}
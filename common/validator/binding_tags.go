@@ -0,0 +1,130 @@
+package validator
+
+import (
+	"reflect"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/gin-gonic/gin/binding"
+	playground "github.com/go-playground/validator/v10"
+	"golang.org/x/text/currency"
+)
+
+// Custom binding tags. Request structs declare them like any built-in tag:
+//
+//	SKU       string  `json:"sku"       binding:"omitempty,sku,max=255"`
+//	ColorCode *string `json:"colorCode" binding:"omitempty,hexcolor7"`
+const (
+	TAG_SKU           = "sku"
+	TAG_SLUG          = "slug"
+	TAG_HEX_COLOR_7   = "hexcolor7"
+	TAG_E164_PHONE    = "e164phone"
+	TAG_CURRENCY_CODE = "currencycode"
+	TAG_BARCODE       = "barcode"
+)
+
+var (
+	// skuPattern allows letters and digits in groups joined by single '-', '_' or '.'
+	skuPattern = regexp.MustCompile(`^[A-Za-z0-9]+(?:[-_.][A-Za-z0-9]+)*$`)
+	// slugPattern allows lower-case letters and digits in groups joined by single '-'
+	slugPattern      = regexp.MustCompile(`^[a-z0-9]+(?:-[a-z0-9]+)*$`)
+	hexColor7Pattern = regexp.MustCompile(`^#[0-9A-Fa-f]{6}$`)
+	e164PhonePattern = regexp.MustCompile(`^\+[1-9][0-9]{1,14}$`)
+	// barcodePattern allows the GTIN-8, UPC-A (GTIN-12), EAN-13 and GTIN-14 lengths
+	barcodePattern = regexp.MustCompile(`^(?:[0-9]{8}|[0-9]{12,14})$`)
+)
+
+var registerOnce sync.Once
+
+// RegisterBindingValidators registers the custom tags on gin's validator engine and
+// makes validation errors report fields by their json (or form/uri) name. It must run
+// before the first request is bound, since the engine caches struct metadata; calls
+// after the first are no-ops.
+func RegisterBindingValidators() {
+	registerOnce.Do(func() {
+		engine, ok := binding.Validator.Engine().(*playground.Validate)
+		if !ok {
+			return
+		}
+		engine.RegisterTagNameFunc(requestFieldName)
+		for tag, valid := range map[string]func(string) bool{
+			TAG_SKU:           IsSKU,
+			TAG_SLUG:          IsSlug,
+			TAG_HEX_COLOR_7:   IsHexColor7,
+			TAG_E164_PHONE:    IsE164Phone,
+			TAG_CURRENCY_CODE: IsCurrencyCode,
+			TAG_BARCODE:       IsBarcode,
+		} {
+			_ = engine.RegisterValidation(tag, stringValidator(valid))
+		}
+	})
+}
+
+// IsSKU reports whether value is a well-formed SKU such as "NIKE-TSHIRT-BLK-M"
+func IsSKU(value string) bool {
+	return skuPattern.MatchString(value)
+}
+
+// IsSlug reports whether value is a URL slug such as "summer-sale-2025"
+func IsSlug(value string) bool {
+	return slugPattern.MatchString(value)
+}
+
+// IsHexColor7 reports whether value is a 7-character hex color such as "#FF5733"
+func IsHexColor7(value string) bool {
+	return hexColor7Pattern.MatchString(value)
+}
+
+// IsE164Phone reports whether value is an E.164 phone number such as "+919876543210"
+func IsE164Phone(value string) bool {
+	return e164PhonePattern.MatchString(value)
+}
+
+// IsCurrencyCode reports whether value is a recognized ISO 4217 code. Case is ignored
+// because services upper-case codes before storing them.
+func IsCurrencyCode(value string) bool {
+	if len(value) != 3 {
+		return false
+	}
+	_, err := currency.ParseISO(strings.ToUpper(value))
+	return err == nil
+}
+
+// IsBarcode reports whether value is an EAN/UPC/GTIN barcode such as "4006381333931"
+// with a correct GS1 check digit
+func IsBarcode(value string) bool {
+	if !barcodePattern.MatchString(value) {
+		return false
+	}
+	// Digits are weighted 3, 1, 3... from the right, skipping the check digit
+	sum := 0
+	for i := len(value) - 2; i >= 0; i-- {
+		digit := int(value[i] - '0')
+		if (len(value)-2-i)%2 == 0 {
+			digit *= 3
+		}
+		sum += digit
+	}
+	return (10-sum%10)%10 == int(value[len(value)-1]-'0')
+}
+
+// stringValidator adapts a string check to a validator func; non-string fields fail
+func stringValidator(valid func(string) bool) playground.Func {
+	return func(fl playground.FieldLevel) bool {
+		field := fl.Field()
+		return field.Kind() == reflect.String && valid(field.String())
+	}
+}
+
+// requestFieldName returns the json name of a struct field, falling back to its
+// form or uri name for query and path bindings, then to the Go name
+func requestFieldName(field reflect.StructField) string {
+	for _, tag := range []string{"json", "form", "uri"} {
+		name, _, _ := strings.Cut(field.Tag.Get(tag), ",")
+		if name != "" && name != "-" {
+			return name
+		}
+	}
+	return field.Name
+}
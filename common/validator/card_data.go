@@ -0,0 +1,158 @@
+package validator
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+	"strings"
+)
+
+// Card numbers (PANs) are 13-19 digits; major networks start with 2-6
+// (Mastercard 2-series, Amex, Visa, Mastercard, Discover/UnionPay/Maestro)
+const (
+	minCardNumberDigits = 13
+	maxCardNumberDigits = 19
+)
+
+// sensitiveCardFields are field names (lower-case, separators removed) that only ever carry
+// raw card data
+var sensitiveCardFields = map[string]struct{}{
+	"cardnumber":       {},
+	"cardno":           {},
+	"pan":              {},
+	"cvv":              {},
+	"cvv2":             {},
+	"cvc":              {},
+	"cvc2":             {},
+	"securitycode":     {},
+	"cardsecuritycode": {},
+}
+
+// ContainsCardNumber reports whether text contains a 13-19 digit sequence that starts with a
+// card network prefix and passes the Luhn check. Digit groups may be separated by single
+// spaces or hyphens, as in "4111 1111 1111 1111".
+func ContainsCardNumber(text string) bool {
+	var run, segment []byte
+	check := func() bool {
+		found := looksLikeCardNumber(run) || looksLikeCardNumber(segment)
+		run, segment = run[:0], segment[:0]
+		return found
+	}
+
+	for i := 0; i < len(text); i++ {
+		ch := text[i]
+		switch {
+		case ch >= '0' && ch <= '9':
+			run = append(run, ch)
+			segment = append(segment, ch)
+		case (ch == ' ' || ch == '-') && len(run) > 0 &&
+			i+1 < len(text) && text[i+1] >= '0' && text[i+1] <= '9':
+			// Separator inside a grouped number; the run continues
+			if looksLikeCardNumber(segment) {
+				return true
+			}
+			segment = segment[:0]
+		default:
+			if check() {
+				return true
+			}
+		}
+	}
+	return check()
+}
+
+// IsSensitiveCardField reports whether a field name is reserved for raw card data
+// (card number or security code), ignoring case and separators
+func IsSensitiveCardField(name string) bool {
+	normalized := strings.NewReplacer("_", "", "-", "", ".", "", " ", "").
+		Replace(strings.ToLower(name))
+	_, sensitive := sensitiveCardFields[normalized]
+	return sensitive
+}
+
+// FindCardData scans a request body for raw card data. JSON bodies are walked value by value
+// and the path of the first offending field is returned; other bodies are scanned as text.
+func FindCardData(body []byte) (string, bool) {
+	if len(bytes.TrimSpace(body)) == 0 {
+		return "", false
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	var payload any
+	if err := decoder.Decode(&payload); err != nil {
+		return "", ContainsCardNumber(string(body))
+	}
+	return findCardDataIn(payload, "")
+}
+
+func findCardDataIn(value any, path string) (string, bool) {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, nested := range v {
+			nestedPath := joinFieldPath(path, key)
+			if IsSensitiveCardField(key) && !isEmptyValue(nested) {
+				return nestedPath, true
+			}
+			if found, ok := findCardDataIn(nested, nestedPath); ok {
+				return found, true
+			}
+		}
+	case []any:
+		for i, nested := range v {
+			if found, ok := findCardDataIn(nested, path+"["+strconv.Itoa(i)+"]"); ok {
+				return found, true
+			}
+		}
+	case string:
+		return path, ContainsCardNumber(v)
+	case json.Number:
+		return path, ContainsCardNumber(v.String())
+	}
+	return "", false
+}
+
+func looksLikeCardNumber(digits []byte) bool {
+	if len(digits) < minCardNumberDigits || len(digits) > maxCardNumberDigits {
+		return false
+	}
+	if digits[0] < '2' || digits[0] > '6' {
+		return false
+	}
+	return passesLuhn(digits)
+}
+
+// passesLuhn validates the mod-10 checksum used by payment card numbers
+func passesLuhn(digits []byte) bool {
+	sum := 0
+	double := false
+	for i := len(digits) - 1; i >= 0; i-- {
+		d := int(digits[i] - '0')
+		if double {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		double = !double
+	}
+	return sum%10 == 0
+}
+
+func joinFieldPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+func isEmptyValue(value any) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return strings.TrimSpace(v) == ""
+	}
+	return false
+}
@@ -0,0 +1,276 @@
+package validator
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"ecommerce-be/common/constants"
+	commonError "ecommerce-be/common/error"
+)
+
+// CrossFieldError reports a rule between request fields that failed. Field is the
+// field to fix and Param the field(s) the rule relates it to, both by JSON name.
+type CrossFieldError struct {
+	Rule  string
+	Field string
+	Param string
+}
+
+func (e *CrossFieldError) Error() string {
+	return fmt.Sprintf("%s fails the %s rule with %s", e.Field, e.Rule, e.Param)
+}
+
+// RequireTogether checks that the fields are either all provided or all omitted,
+// e.g. RequireTogether(r, "minPrice", "maxPrice")
+func RequireTogether(s any, fields ...string) error {
+	v, err := requestStruct(s)
+	if err != nil {
+		return err
+	}
+
+	var provided, missing []string
+	for _, name := range fields {
+		set, err := isProvided(v, name)
+		if err != nil {
+			return err
+		}
+		if set {
+			provided = append(provided, name)
+		} else {
+			missing = append(missing, name)
+		}
+	}
+
+	if len(provided) == 0 || len(missing) == 0 {
+		return nil
+	}
+	return &CrossFieldError{
+		Rule:  constants.VALIDATION_RULE_REQUIRED_WITH,
+		Field: missing[0],
+		Param: strings.Join(provided, ", "),
+	}
+}
+
+// RequireIfPresent checks that the required fields are provided whenever field is,
+// e.g. RequireIfPresent(r, "scheduledAt", "timezone")
+func RequireIfPresent(s any, field string, required ...string) error {
+	v, err := requestStruct(s)
+	if err != nil {
+		return err
+	}
+
+	set, err := isProvided(v, field)
+	if err != nil || !set {
+		return err
+	}
+	for _, name := range required {
+		set, err := isProvided(v, name)
+		if err != nil {
+			return err
+		}
+		if !set {
+			return &CrossFieldError{
+				Rule:  constants.VALIDATION_RULE_REQUIRED_WITH,
+				Field: name,
+				Param: field,
+			}
+		}
+	}
+	return nil
+}
+
+// MutuallyExclusive checks that at most one of the fields is provided,
+// e.g. MutuallyExclusive(r, "productId", "categoryId")
+func MutuallyExclusive(s any, fields ...string) error {
+	v, err := requestStruct(s)
+	if err != nil {
+		return err
+	}
+
+	first := ""
+	for _, name := range fields {
+		set, err := isProvided(v, name)
+		if err != nil {
+			return err
+		}
+		if !set {
+			continue
+		}
+		if first != "" {
+			return &CrossFieldError{
+				Rule:  constants.VALIDATION_RULE_EXCLUDED_WITH,
+				Field: name,
+				Param: first,
+			}
+		}
+		first = name
+	}
+	return nil
+}
+
+// RequireLessOrEqual checks that the field lower is not greater than upper when both
+// are provided, e.g. RequireLessOrEqual(r, "minPrice", "maxPrice"). Both fields are
+// numbers, or both are times: time.Time or RFC 3339 strings. A string that does not
+// parse is skipped, and left to the code that parses it to report.
+func RequireLessOrEqual(s any, lower, upper string) error {
+	v, err := requestStruct(s)
+	if err != nil {
+		return err
+	}
+
+	lowerValue, err := boundValue(v, lower)
+	if err != nil {
+		return err
+	}
+	upperValue, err := boundValue(v, upper)
+	if err != nil {
+		return err
+	}
+	if lowerValue == nil || upperValue == nil {
+		return nil
+	}
+
+	var greater bool
+	switch lowerBound := lowerValue.(type) {
+	case float64:
+		upperBound, ok := upperValue.(float64)
+		if !ok {
+			return mismatchedBounds(lower, upper)
+		}
+		greater = lowerBound > upperBound
+	case time.Time:
+		upperBound, ok := upperValue.(time.Time)
+		if !ok {
+			return mismatchedBounds(lower, upper)
+		}
+		greater = lowerBound.After(upperBound)
+	}
+
+	if greater {
+		return &CrossFieldError{
+			Rule:  constants.VALIDATION_RULE_LTE_FIELD,
+			Field: lower,
+			Param: upper,
+		}
+	}
+	return nil
+}
+
+// requestStruct returns the struct s points to
+func requestStruct(s any) (reflect.Value, error) {
+	v := reflect.ValueOf(s)
+
+	// Handle pointer to struct
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return reflect.Value{},
+				commonError.ErrInvalidRequestStruct.WithMessage("request cannot be nil")
+		}
+		v = v.Elem()
+	}
+
+	// Ensure it's a struct
+	if v.Kind() != reflect.Struct {
+		return reflect.Value{}, commonError.ErrInvalidRequestStruct
+	}
+	return v, nil
+}
+
+// fieldByName finds a field by its JSON or form name, or its Go name, including the
+// fields of embedded structs
+func fieldByName(v reflect.Value, name string) (reflect.Value, bool) {
+	t := v.Type()
+	for i := 0; i < v.NumField(); i++ {
+		structField := t.Field(i)
+		field := v.Field(i)
+		if structField.Anonymous && field.Kind() == reflect.Struct {
+			if found, ok := fieldByName(field, name); ok {
+				return found, true
+			}
+			continue
+		}
+		if !structField.IsExported() {
+			continue
+		}
+
+		if structField.Name == name ||
+			tagName(structField.Tag.Get("json")) == name ||
+			tagName(structField.Tag.Get("form")) == name {
+			return field, true
+		}
+	}
+	return reflect.Value{}, false
+}
+
+// tagName returns the name part of a json or form tag
+func tagName(tag string) string {
+	name, _, _ := strings.Cut(tag, ",")
+	return name
+}
+
+// lookupField returns the named field, or an error naming it when the request has
+// no such field, which is a bug in the rule rather than in the request
+func lookupField(v reflect.Value, name string) (reflect.Value, error) {
+	field, ok := fieldByName(v, name)
+	if !ok {
+		return reflect.Value{},
+			commonError.ErrInvalidRequestStruct.WithMessagef("unknown field %s", name)
+	}
+	return field, nil
+}
+
+// isProvided reports whether the named field has a value, with the same meaning of
+// empty as RequireAtLeastOneField
+func isProvided(v reflect.Value, name string) (bool, error) {
+	field, err := lookupField(v, name)
+	if err != nil {
+		return false, err
+	}
+	return !isZeroValue(field), nil
+}
+
+// boundValue returns the named field as a float64 or a time.Time, following a
+// pointer. It returns nil for a nil pointer, an empty string or a string that is not
+// an RFC 3339 time.
+func boundValue(v reflect.Value, name string) (any, error) {
+	field, err := lookupField(v, name)
+	if err != nil {
+		return nil, err
+	}
+	if field.Kind() == reflect.Ptr {
+		if field.IsNil() {
+			return nil, nil
+		}
+		field = field.Elem()
+	}
+
+	switch field.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(field.Int()), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(field.Uint()), nil
+	case reflect.Float32, reflect.Float64:
+		return field.Float(), nil
+	case reflect.String:
+		parsed, err := time.Parse(time.RFC3339, strings.TrimSpace(field.String()))
+		if err != nil {
+			return nil, nil
+		}
+		return parsed, nil
+	}
+	if field.Type() == reflect.TypeOf(time.Time{}) && field.CanInterface() {
+		return field.Interface().(time.Time), nil
+	}
+	return nil, commonError.ErrInvalidRequestStruct.WithMessagef(
+		"field %s is neither a number nor a time", name,
+	)
+}
+
+// mismatchedBounds reports a rule comparing a number with a time
+func mismatchedBounds(lower, upper string) error {
+	return commonError.ErrInvalidRequestStruct.WithMessagef(
+		"fields %s and %s cannot be compared", lower, upper,
+	)
+}
@@ -6,7 +6,7 @@
 //
 //	type ProductOptionValueUpdateRequest struct {
 //	    DisplayName *string `json:"displayName" binding:"omitempty,min=1,max=100"`
-//	    ColorCode   *string `json:"colorCode"   binding:"omitempty,len=7"`
+//	    ColorCode   *string `json:"colorCode"   binding:"omitempty,hexcolor7"`
 //	    Position    *int    `json:"position"    binding:"omitempty"`
 //	}
 //
@@ -49,6 +49,45 @@
 //	    return validator.RequireAtLeastOneWithTag(r, "updateable", "true")
 //	}
 //
+// 4. For UPDATE requests with nested update structs or slices:
+//
+//	type VariantImagesUpdate struct {
+//	    Position *int    `json:"position"`
+//	    AltText  *string `json:"altText"`
+//	}
+//
+//	type VariantUpdateRequest struct {
+//	    Price   *float64             `json:"price"`
+//	    Images  *VariantImagesUpdate `json:"images"`
+//	    Options []*OptionUpdate      `json:"options"`
+//	}
+//
+//	func (r *VariantUpdateRequest) Validate() error {
+//	    return validator.RequireAtLeastOneNonNilPointerWithOptions(r, validator.TraversalOptions{
+//	        MaxDepth:      1,
+//	        IncludeSlices: true,
+//	    })
+//	}
+//
+// 5. For free-text fields that are stored and rendered later (names, descriptions):
+//
+//	type ProductCreateRequest struct {
+//	    Name            string `json:"name"            binding:"required,max=200" sanitize:"nfc,striphtml,collapse"`
+//	    LongDescription string `json:"longDescription" binding:"max=5000"         sanitize:"nfc,richtext,trim"`
+//	}
+//
+//	// h.BindJSON cleans tagged fields before validating; elsewhere call
+//	validator.Sanitize(&req)
+//
+// 6. For rules between fields, named by their JSON or form names:
+//
+//	func (p *GetProductsParams) Validate() error {
+//	    if err := validator.RequireLessOrEqual(p, "minPrice", "maxPrice"); err != nil {
+//	        return err
+//	    }
+//	    return validator.MutuallyExclusive(p, "categoryIds", "sellerId")
+//	}
+//
 // KEY DIFFERENCES:
 //
 // RequireAtLeastOneField():
@@ -62,6 +101,24 @@
 //   - Use for update requests where all fields are pointers
 //   - Best for distinguishing "not provided" vs "provided but empty"
 //
+// RequireAtLeastOneNonNilPointerWithOptions():
+//   - Same check, optionally looking into nested structs (MaxDepth) and slices
+//   - A nested update struct only counts when one of its own pointers is set, so
+//     {"images": {}} is rejected like an empty request
+//   - ExcludeFields skips fields by Go name, e.g. IDs that identify rather than update
+//
+// Sanitize():
+//   - Not a check: rewrites tagged string fields in place, in the listed rule order
+//   - striphtml removes all markup; richtext keeps an allow-list of formatting tags
+//   - Runs before binding validation, so whitespace-only values fail "required"
+//
+// RequireTogether() / RequireIfPresent() / MutuallyExclusive() / RequireLessOrEqual():
+//   - Check one rule between fields; "provided" means the same as in RequireAtLeastOneField
+//   - Fail with a *CrossFieldError that HandleValidationError reports on the field to fix
+//   - RequireLessOrEqual compares numbers, or times given as time.Time or RFC 3339
+//     strings, and only when both fields are set
+//   - A name the request does not have is a bug and fails with ErrInvalidRequestStruct
+//
 // RequireAtLeastOneWithTag():
 //   - Only checks fields with a specific struct tag
 //   - Useful when you want to exclude certain fields from validation
@@ -0,0 +1,119 @@
+package validator
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"ecommerce-be/common/constants"
+)
+
+// Default structural limits of JSON request bodies, used until SetJSONLimits is called
+const (
+	DEFAULT_MAX_JSON_DEPTH        = 32
+	DEFAULT_MAX_JSON_ARRAY_LENGTH = 10000
+)
+
+var (
+	maxJSONDepth       = DEFAULT_MAX_JSON_DEPTH
+	maxJSONArrayLength = DEFAULT_MAX_JSON_ARRAY_LENGTH
+)
+
+// SetJSONLimits sets the nesting depth and array length SanitizedJSON accepts. Call it
+// once at startup, before the router serves requests.
+func SetJSONLimits(maxDepth, maxArrayLength int) {
+	maxJSONDepth = maxDepth
+	maxJSONArrayLength = maxArrayLength
+}
+
+// JSONLimitError reports a JSON body that breaks a structural limit. Field is the JSON
+// path of the offending object or array, empty for the body itself.
+type JSONLimitError struct {
+	Rule  string
+	Field string
+	Limit int
+}
+
+func (e *JSONLimitError) Error() string {
+	field := e.Field
+	if field == "" {
+		field = constants.REQUEST_FIELD_NAME
+	}
+	return fmt.Sprintf("json: %s breaks the %s limit of %d", field, e.Rule, e.Limit)
+}
+
+// jsonFrame is an open object or array while scanning a body
+type jsonFrame struct {
+	array     bool
+	path      string
+	count     int
+	key       string
+	expectKey bool
+}
+
+// CheckJSONLimits scans body token by token and rejects it with a *JSONLimitError once
+// it nests deeper than the configured depth or an array grows past the configured
+// length. The scan stops before any oversized structure is materialised. Malformed JSON
+// passes, so decoding reports it as usual.
+func CheckJSONLimits(body []byte) error {
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	var stack []jsonFrame
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return nil
+		}
+		delim, isDelim := token.(json.Delim)
+		if isDelim && (delim == ']' || delim == '}') {
+			if len(stack) > 0 {
+				stack = stack[:len(stack)-1]
+			}
+			continue
+		}
+
+		path := ""
+		if len(stack) > 0 {
+			top := &stack[len(stack)-1]
+			switch {
+			case top.array:
+				if top.count >= maxJSONArrayLength {
+					return &JSONLimitError{
+						Rule:  constants.VALIDATION_RULE_MAX_ARRAY_LENGTH,
+						Field: top.path,
+						Limit: maxJSONArrayLength,
+					}
+				}
+				if isDelim {
+					path = top.path + "[" + strconv.Itoa(top.count) + "]"
+				}
+				top.count++
+			case top.expectKey:
+				top.key, _ = token.(string)
+				top.expectKey = false
+				continue
+			default:
+				path = joinJSONPath(top.path, top.key)
+				top.expectKey = true
+			}
+		}
+
+		if isDelim {
+			if len(stack) >= maxJSONDepth {
+				return &JSONLimitError{
+					Rule:  constants.VALIDATION_RULE_MAX_DEPTH,
+					Field: path,
+					Limit: maxJSONDepth,
+				}
+			}
+			stack = append(stack, jsonFrame{array: delim == '[', path: path, expectKey: delim == '{'})
+		}
+	}
+}
+
+func joinJSONPath(parent, key string) string {
+	if parent == "" {
+		return key
+	}
+	return parent + "." + key
+}
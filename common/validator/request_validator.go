@@ -70,6 +70,28 @@ func isZeroValue(v reflect.Value) bool {
 // RequireAtLeastOneNonNilPointer checks if a struct has at least one non-nil pointer field
 // Specifically for update requests where all fields are pointers
 func RequireAtLeastOneNonNilPointer(s any) error {
+	return RequireAtLeastOneNonNilPointerWithOptions(s, TraversalOptions{})
+}
+
+// TraversalOptions controls how deep RequireAtLeastOneNonNilPointerWithOptions looks
+// into a request. The zero value checks top-level pointer fields only.
+type TraversalOptions struct {
+	// MaxDepth is the number of nested struct levels inspected below the request.
+	// Within it, a pointer to a struct only counts when the nested struct itself has a
+	// non-nil pointer; beyond it, any non-nil pointer counts.
+	MaxDepth int
+
+	// IncludeSlices also inspects slice fields: slices of pointers count when any
+	// element is non-nil, and slices of structs are inspected element by element.
+	IncludeSlices bool
+
+	// ExcludeFields are Go field names skipped at every depth
+	ExcludeFields []string
+}
+
+// RequireAtLeastOneNonNilPointerWithOptions is RequireAtLeastOneNonNilPointer for
+// requests with nested update structs or slices
+func RequireAtLeastOneNonNilPointerWithOptions(s any, opts TraversalOptions) error {
 	v := reflect.ValueOf(s)
 
 	// Handle pointer to struct
@@ -85,22 +107,75 @@ func RequireAtLeastOneNonNilPointer(s any) error {
 		return commonError.ErrInvalidRequestStruct
 	}
 
-	// Check each pointer field
+	excluded := make(map[string]struct{}, len(opts.ExcludeFields))
+	for _, name := range opts.ExcludeFields {
+		excluded[name] = struct{}{}
+	}
+
+	if hasNonNilPointer(v, 0, opts, excluded) {
+		return nil
+	}
+	return commonError.ErrNoFieldsProvided
+}
+
+// hasNonNilPointer reports whether the struct v has a set pointer field, recursing into
+// nested structs and slices as allowed by opts
+func hasNonNilPointer(
+	v reflect.Value,
+	depth int,
+	opts TraversalOptions,
+	excluded map[string]struct{},
+) bool {
+	t := v.Type()
 	for i := 0; i < v.NumField(); i++ {
 		field := v.Field(i)
 
-		// Skip unexported fields
+		// Skip unexported and excluded fields
 		if !field.CanInterface() {
 			continue
 		}
+		if _, skip := excluded[t.Field(i).Name]; skip {
+			continue
+		}
 
-		// Check if field is a pointer and non-nil
-		if field.Kind() == reflect.Ptr && !field.IsNil() {
-			return nil
+		if isSetValue(field, depth, opts, excluded) {
+			return true
 		}
 	}
+	return false
+}
 
-	return commonError.ErrNoFieldsProvided
+// isSetValue reports whether a field or slice element counts as provided
+func isSetValue(
+	v reflect.Value,
+	depth int,
+	opts TraversalOptions,
+	excluded map[string]struct{},
+) bool {
+	canRecurse := depth < opts.MaxDepth
+
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return false
+		}
+		if canRecurse && v.Elem().Kind() == reflect.Struct {
+			return hasNonNilPointer(v.Elem(), depth+1, opts, excluded)
+		}
+		return true
+	case reflect.Struct:
+		return canRecurse && hasNonNilPointer(v, depth+1, opts, excluded)
+	case reflect.Slice:
+		if !opts.IncludeSlices {
+			return false
+		}
+		for i := 0; i < v.Len(); i++ {
+			if isSetValue(v.Index(i), depth, opts, excluded) {
+				return true
+			}
+		}
+	}
+	return false
 }
 
 // RequireAtLeastOneWithTag checks if a struct has at least one non-zero field with a specific tag
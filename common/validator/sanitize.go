@@ -0,0 +1,278 @@
+package validator
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+	"unicode"
+
+	commonError "ecommerce-be/common/error"
+
+	"github.com/gin-gonic/gin/binding"
+	"golang.org/x/net/html"
+	"golang.org/x/text/unicode/norm"
+)
+
+// Sanitize rules. Request structs list them in a sanitize tag, applied in order:
+//
+//	Name            string `json:"name"            sanitize:"nfc,striphtml,collapse"`
+//	LongDescription string `json:"longDescription" sanitize:"nfc,richtext,trim"`
+const (
+	SANITIZE_TAG = "sanitize"
+
+	// SANITIZE_TRIM removes leading and trailing whitespace
+	SANITIZE_TRIM = "trim"
+	// SANITIZE_COLLAPSE replaces every run of whitespace, including line breaks, with a
+	// single space and trims the result. Meant for single-line values such as names.
+	SANITIZE_COLLAPSE = "collapse"
+	// SANITIZE_NFC normalizes unicode to NFC so visually equal strings compare equal
+	SANITIZE_NFC = "nfc"
+	// SANITIZE_STRIP_HTML removes all markup, keeping only the text. Entities are kept
+	// as written, so an escaped "&lt;script&gt;" stays inert.
+	SANITIZE_STRIP_HTML = "striphtml"
+	// SANITIZE_RICH_TEXT keeps the allow-listed formatting tags and drops everything
+	// else, including all attributes except safe links
+	SANITIZE_RICH_TEXT = "richtext"
+)
+
+// richTextTags are the formatting tags kept by SANITIZE_RICH_TEXT
+var richTextTags = map[string]struct{}{
+	"p": {}, "br": {}, "b": {}, "strong": {}, "i": {}, "em": {}, "u": {},
+	"ul": {}, "ol": {}, "li": {}, "h2": {}, "h3": {}, "h4": {}, "blockquote": {}, "a": {},
+}
+
+// richTextVoidTags have no closing tag
+var richTextVoidTags = map[string]struct{}{"br": {}}
+
+// droppedContentTags are removed together with everything inside them
+var droppedContentTags = map[string]struct{}{
+	"script": {}, "style": {}, "iframe": {}, "object": {}, "embed": {}, "noscript": {},
+	"template": {}, "textarea": {}, "title": {},
+}
+
+// safeLinkSchemes are the href schemes kept on rich-text links
+var safeLinkSchemes = map[string]struct{}{"http": {}, "https": {}, "mailto": {}}
+
+// Sanitize cleans the string fields of a request in place according to their sanitize
+// tags, descending into nested structs, pointers and slices. Call it before validating
+// so length and required checks see the cleaned values; BindJSON-based handlers get
+// this through SanitizedJSON. An unknown rule panics, as unknown binding tags do.
+func Sanitize(s any) error {
+	v := reflect.ValueOf(s)
+	if v.Kind() != reflect.Ptr || v.IsNil() {
+		return commonError.ErrInvalidRequestStruct.WithMessage("request must be a non-nil pointer")
+	}
+	if v.Elem().Kind() != reflect.Struct {
+		return commonError.ErrInvalidRequestStruct
+	}
+	sanitizeValue(v.Elem(), nil)
+	return nil
+}
+
+// sanitizeValue applies rules to strings and recurses into containers. Rules come from
+// the enclosing field, so a tagged []string or *string is cleaned element by element.
+func sanitizeValue(v reflect.Value, rules []string) {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if !v.IsNil() {
+			sanitizeValue(v.Elem(), rules)
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			sanitizeValue(v.Index(i), rules)
+		}
+	case reflect.String:
+		if len(rules) > 0 && v.CanSet() {
+			v.SetString(SanitizeString(v.String(), rules...))
+		}
+	case reflect.Struct:
+		t := v.Type()
+		for i := 0; i < v.NumField(); i++ {
+			field := t.Field(i)
+			if !field.IsExported() {
+				continue
+			}
+			sanitizeValue(v.Field(i), parseSanitizeTag(field.Tag.Get(SANITIZE_TAG)))
+		}
+	}
+}
+
+func parseSanitizeTag(tag string) []string {
+	if tag == "" || tag == "-" {
+		return nil
+	}
+	rules := strings.Split(tag, ",")
+	for i := range rules {
+		rules[i] = strings.TrimSpace(rules[i])
+	}
+	return rules
+}
+
+// SanitizeString applies the given rules to value in order
+func SanitizeString(value string, rules ...string) string {
+	for _, rule := range rules {
+		switch rule {
+		case SANITIZE_TRIM:
+			value = strings.TrimSpace(value)
+		case SANITIZE_COLLAPSE:
+			value = strings.Join(strings.FieldsFunc(value, unicode.IsSpace), " ")
+		case SANITIZE_NFC:
+			value = norm.NFC.String(value)
+		case SANITIZE_STRIP_HTML:
+			value = StripHTML(value)
+		case SANITIZE_RICH_TEXT:
+			value = SanitizeRichText(value)
+		case "":
+		default:
+			panic("validator: unknown sanitize rule " + rule)
+		}
+	}
+	return value
+}
+
+// StripHTML removes all tags, comments and the content of script-like elements from
+// value, keeping the remaining text as written
+func StripHTML(value string) string {
+	if !strings.ContainsAny(value, "<>") {
+		return value
+	}
+	var out strings.Builder
+	skipDepth := 0
+	z := html.NewTokenizer(strings.NewReader(value))
+	for {
+		tt := z.Next()
+		switch tt {
+		case html.ErrorToken:
+			return out.String()
+		case html.TextToken:
+			if skipDepth == 0 {
+				out.Write(z.Raw())
+			}
+		case html.StartTagToken, html.EndTagToken:
+			name, _ := z.TagName()
+			if _, drop := droppedContentTags[string(name)]; drop {
+				skipDepth = nextSkipDepth(skipDepth, tt)
+			}
+		}
+	}
+}
+
+// SanitizeRichText keeps the allow-listed formatting tags of value and drops all other
+// markup. Attributes are removed except href on links with an http, https or mailto
+// URL. Text is re-escaped, so the result is safe to render as HTML.
+func SanitizeRichText(value string) string {
+	var out strings.Builder
+	skipDepth := 0
+	z := html.NewTokenizer(strings.NewReader(value))
+	for {
+		tt := z.Next()
+		switch tt {
+		case html.ErrorToken:
+			return out.String()
+		case html.TextToken:
+			if skipDepth == 0 {
+				out.WriteString(html.EscapeString(string(z.Text())))
+			}
+		case html.StartTagToken, html.EndTagToken, html.SelfClosingTagToken:
+			token := z.Token()
+			if _, drop := droppedContentTags[token.Data]; drop {
+				skipDepth = nextSkipDepth(skipDepth, tt)
+				continue
+			}
+			if _, allowed := richTextTags[token.Data]; !allowed || skipDepth > 0 {
+				continue
+			}
+			writeRichTextTag(&out, tt, token)
+		}
+	}
+}
+
+func nextSkipDepth(depth int, tt html.TokenType) int {
+	switch {
+	case tt == html.StartTagToken:
+		return depth + 1
+	case tt == html.EndTagToken && depth > 0:
+		return depth - 1
+	}
+	return depth
+}
+
+func writeRichTextTag(out *strings.Builder, tt html.TokenType, token html.Token) {
+	_, void := richTextVoidTags[token.Data]
+	if tt == html.EndTagToken {
+		if !void {
+			out.WriteString("</" + token.Data + ">")
+		}
+		return
+	}
+
+	out.WriteString("<" + token.Data)
+	if token.Data == "a" {
+		for _, attr := range token.Attr {
+			if attr.Key == "href" && isSafeLink(attr.Val) {
+				out.WriteString(` href="` + html.EscapeString(attr.Val) + `"`)
+				break
+			}
+		}
+	}
+	out.WriteString(">")
+	if tt == html.SelfClosingTagToken && !void {
+		out.WriteString("</" + token.Data + ">")
+	}
+}
+
+func isSafeLink(href string) bool {
+	parsed, err := url.Parse(strings.TrimSpace(href))
+	if err != nil {
+		return false
+	}
+	_, ok := safeLinkSchemes[strings.ToLower(parsed.Scheme)]
+	return ok
+}
+
+// SanitizedJSON is a gin binding that decodes a JSON body, applies sanitize tags and
+// only then validates, so binding rules such as required and max see cleaned values.
+// Bodies nested too deeply or carrying oversized arrays are rejected before decoding.
+var SanitizedJSON binding.BindingBody = sanitizedJSONBinding{}
+
+type sanitizedJSONBinding struct{}
+
+func (sanitizedJSONBinding) Name() string {
+	return "json"
+}
+
+func (b sanitizedJSONBinding) Bind(req *http.Request, obj any) error {
+	if req == nil || req.Body == nil {
+		return errors.New("invalid request")
+	}
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		return err
+	}
+	return b.BindBody(body, obj)
+}
+
+func (sanitizedJSONBinding) BindBody(body []byte, obj any) error {
+	if err := CheckJSONLimits(body); err != nil {
+		return err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	if binding.EnableDecoderUseNumber {
+		decoder.UseNumber()
+	}
+	if binding.EnableDecoderDisallowUnknownFields {
+		decoder.DisallowUnknownFields()
+	}
+	if err := decoder.Decode(obj); err != nil {
+		return err
+	}
+	if v := reflect.ValueOf(obj); v.Kind() == reflect.Ptr && !v.IsNil() {
+		sanitizeValue(v.Elem(), nil)
+	}
+	return binding.Validator.ValidateStruct(obj)
+}
@@ -1,7 +1,13 @@
 package file
 
 import (
+	"context"
+
 	"ecommerce-be/common"
+	"ecommerce-be/common/config"
+	"ecommerce-be/common/lifecycle"
+	"ecommerce-be/common/log"
+	msgFactory "ecommerce-be/common/messaging/factory"
 	"ecommerce-be/common/scheduler"
 	"ecommerce-be/file/factory/singleton"
 	"ecommerce-be/file/route"
@@ -19,6 +25,9 @@ func NewContainer(router *gin.Engine) *common.Container {
 	// Register schedulers
 	registerScheduler()
 
+	// Generate image variants for completed uploads
+	startImageVariantConsumer()
+
 	for _, m := range c.Modules {
 		m.RegisterRoutes(router)
 	}
@@ -34,6 +43,34 @@ func registerScheduler() {
 	)
 }
 
+// startImageVariantConsumer consumes file.image.process.requested commands when
+// messaging is enabled
+func startImageVariantConsumer() {
+	cfg := config.Get()
+	if cfg == nil || !cfg.Messaging.Enabled {
+		return
+	}
+
+	mf, err := msgFactory.New("")
+	if err != nil {
+		log.Error("image variant worker: messaging factory unavailable", err)
+		return
+	}
+	consumer, err := mf.Consumer()
+	if err != nil {
+		log.Error("image variant worker: consumer unavailable", err)
+		return
+	}
+
+	worker := singleton.GetInstance().GetImageVariantWorker()
+	lifecycle.Go("image variant consumer", func(ctx context.Context) {
+		err := consumer.Consume(ctx, constant.QueueFileImageProcess, worker.Handle)
+		if err != nil && ctx.Err() == nil {
+			log.Error("image variant worker: consumer stopped", err)
+		}
+	})
+}
+
 // addModules registers all file-related modules to the container.
 func addModules(c *common.Container) {
 	c.RegisterModule(route.NewFileOperationModule())
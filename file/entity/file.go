@@ -29,6 +29,8 @@ const (
 	FilePurposeUserAvatar   FilePurpose = "USER_AVATAR"   // profile / avatar images; triggers variant generation
 	FilePurposeSellerLogo   FilePurpose = "SELLER_LOGO"   // storefront logo; raster variants generated (SVG passthrough)
 	FilePurposeInvoicePDF   FilePurpose = "INVOICE_PDF"   // seller / platform invoice documents
+	FilePurposeProductVideo FilePurpose = "PRODUCT_VIDEO" // product gallery videos; no variants
+	FilePurposeProductModel FilePurpose = "PRODUCT_MODEL" // product 3D / AR models (glTF, USDZ); no variants
 )
 
 // FileStatus represents the lifecycle status of a file_object row.
@@ -121,8 +123,7 @@ func (FileObject) TableName() string {
 }
 
 // FileVariant represents a derived file (thumbnail, webp, optimised export).
-// Rows are NOT created by this feature; the table is declared here so the
-// variant-worker feature can write to it on day 1.
+// Rows are written by the ImageVariantWorker after complete-upload.
 //
 // Column alignment with data-model.md §1.2 — no metadata column.
 type FileVariant struct {
@@ -70,3 +70,14 @@ func IsBlobError(err error, sentinel *commonError.AppError) bool {
 	}
 	return appErr.Code == sentinel.Code
 }
+
+func init() {
+	commonError.Register(
+		ErrBlobNotFound,
+		ErrBlobPermissionDenied,
+		ErrBlobNetwork,
+		ErrBlobValidation,
+		ErrBlobInternal,
+		ErrBlobFactoryInit,
+	)
+}
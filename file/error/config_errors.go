@@ -59,3 +59,18 @@ var (
 		http.StatusBadRequest,
 	)
 )
+
+func init() {
+	commonError.Register(
+		ErrProviderNotFound,
+		ErrInvalidRole,
+		ErrConfigNotFound,
+		ErrUnauthorized,
+		ErrSerializationFailed,
+		ErrEncryptionFailed,
+		ErrPersistenceFailed,
+		ErrListFailed,
+		ErrInvalidCredentials,
+		ErrAdapterSchemaNotFound,
+	)
+}
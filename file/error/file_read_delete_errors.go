@@ -50,3 +50,15 @@ var (
 		http.StatusServiceUnavailable,
 	)
 )
+
+func init() {
+	commonError.Register(
+		ErrFileNotFound,
+		ErrFileNotActive,
+		ErrVariantNotFound,
+		ErrVariantNotReady,
+		ErrFileDeleteConflict,
+		ErrStoragePermissionDenied,
+		ErrStorageUnavailable,
+	)
+}
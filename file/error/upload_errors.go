@@ -109,3 +109,20 @@ var (
 		http.StatusInternalServerError,
 	)
 )
+
+func init() {
+	commonError.Register(
+		ErrFileUploadUnauthorized,
+		ErrFileUploadForbidden,
+		ErrFileUploadInvalidInput,
+		ErrFileUploadPolicyViolation,
+		ErrFileUploadStorageUnavailable,
+		ErrFileUploadNoStorageConfig,
+		ErrFileUploadNotFound,
+		ErrFileUploadConflict,
+		ErrFileUploadObjectMissing,
+		ErrFileUploadObjectMismatch,
+		ErrFileUploadExpired,
+		ErrFileUploadInternal,
+	)
+}
@@ -21,6 +21,7 @@ type ServiceFactory struct {
 	uploadExpiryScheduler service.UploadExpiryScheduler
 	uploadExpiryHandler   *service.UploadExpiryHandler
 	variantPublisher      service.VariantPublisher
+	imageVariantWorker    *service.ImageVariantWorker
 
 	once sync.Once
 }
@@ -71,6 +72,11 @@ func (f *ServiceFactory) initialize() {
 			fileUploadRepo,
 			configRepo,
 		)
+
+		f.imageVariantWorker = service.NewImageVariantWorker(
+			fileUploadRepo,
+			configRepo,
+		)
 	})
 }
 
@@ -110,6 +116,12 @@ func (f *ServiceFactory) GetVariantPublisher() service.VariantPublisher {
 	return f.variantPublisher
 }
 
+// GetImageVariantWorker returns the singleton image variant worker
+func (f *ServiceFactory) GetImageVariantWorker() *service.ImageVariantWorker {
+	f.initialize()
+	return f.imageVariantWorker
+}
+
 // GetConfigService returns the singleton config service
 func (f *ServiceFactory) GetConfigService() service.ConfigService {
 	f.initialize()
@@ -85,6 +85,10 @@ func (f *SingletonFactory) GetVariantPublisher() service.VariantPublisher {
 	return f.serviceFactory.GetVariantPublisher()
 }
 
+func (f *SingletonFactory) GetImageVariantWorker() *service.ImageVariantWorker {
+	return f.serviceFactory.GetImageVariantWorker()
+}
+
 func (f *SingletonFactory) GetConfigService() service.ConfigService {
 	return f.serviceFactory.GetConfigService()
 }
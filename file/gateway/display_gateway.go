@@ -3,6 +3,7 @@ package gateway
 import (
 	"context"
 
+	"ecommerce-be/common/config"
 	commonError "ecommerce-be/common/error"
 	"ecommerce-be/common/filegateway"
 	"ecommerce-be/common/log"
@@ -121,11 +122,25 @@ func mapFileItem(item *fileModel.FileItem) *filegateway.FileDisplayInfo {
 	return &filegateway.FileDisplayInfo{
 		FileID:       item.FileID,
 		Status:       item.Status,
-		URL:          *item.DownloadURL,
+		Purpose:      item.Purpose,
+		MimeType:     item.MimeType,
+		SizeBytes:    item.SizeBytes,
+		URL:          resolveDisplayURL(item),
 		ThumbnailURL: selectThumbnail(item),
 	}
 }
 
+// resolveDisplayURL serves PUBLIC files from the media CDN when one is configured.
+// Private files always use their presigned download URL.
+func resolveDisplayURL(item *fileModel.FileItem) string {
+	if item.Visibility == string(entity.FileVisibilityPublic) && item.ObjectKey != "" {
+		if cfg := config.Get(); cfg != nil && cfg.Media.CDNBaseURL != "" {
+			return fileUtils.BuildCDNURL(cfg.Media.CDNBaseURL, item.ObjectKey)
+		}
+	}
+	return *item.DownloadURL
+}
+
 func selectThumbnail(item *fileModel.FileItem) *string {
 	for _, code := range fileConstant.ThumbnailVariantCodes {
 		for _, v := range item.Variants {
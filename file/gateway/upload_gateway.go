@@ -0,0 +1,75 @@
+package gateway
+
+import (
+	"context"
+
+	"ecommerce-be/common/filegateway"
+	"ecommerce-be/file/entity"
+	fileModel "ecommerce-be/file/model"
+	fileService "ecommerce-be/file/service"
+)
+
+type uploadGateway struct {
+	lifecycleGateway
+	uploadService fileService.FileUploadService
+}
+
+// NewUploadGateway returns a FileUploadGateway backed by File upload, read and delete services.
+func NewUploadGateway(
+	uploadService fileService.FileUploadService,
+	readService fileService.FileReadService,
+	deleteService fileService.FileDeleteService,
+) filegateway.FileUploadGateway {
+	return &uploadGateway{
+		lifecycleGateway: lifecycleGateway{
+			displayGateway: displayGateway{readService: readService},
+			deleteService:  deleteService,
+		},
+		uploadService: uploadService,
+	}
+}
+
+func (g *uploadGateway) InitUpload(
+	ctx context.Context,
+	req filegateway.UploadRequest,
+) (*filegateway.UploadTicket, error) {
+	caller := buildPrincipal(req.SellerID)
+	caller.UserID = uint64(req.UploaderUserID)
+
+	visibility := entity.FileVisibilityPrivate
+	if req.Public {
+		visibility = entity.FileVisibilityPublic
+	}
+
+	data, err := g.uploadService.InitUpload(ctx, caller, fileModel.InitUploadRequest{
+		Purpose:    entity.FilePurpose(req.Purpose),
+		Visibility: visibility,
+		Filename:   req.Filename,
+		MimeType:   req.MimeType,
+		SizeBytes:  req.SizeBytes,
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &filegateway.UploadTicket{
+		FileID:        data.FileID,
+		UploadURL:     data.UploadURL,
+		UploadMethod:  data.UploadMethod,
+		UploadHeaders: data.UploadHeaders,
+		ExpiresAt:     data.ExpiresAt,
+	}, nil
+}
+
+func (g *uploadGateway) CompleteUpload(
+	ctx context.Context,
+	fileID string,
+	sellerID *uint,
+) (*filegateway.FileDisplayInfo, error) {
+	caller := buildPrincipal(sellerID)
+	if _, err := g.uploadService.CompleteUpload(ctx, caller, fileModel.CompleteUploadRequest{
+		FileID: fileID,
+	}); err != nil {
+		return nil, err
+	}
+	return g.GetFileInfo(ctx, fileID, sellerID)
+}
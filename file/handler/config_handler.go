@@ -149,6 +149,7 @@ func (h *ConfigHandler) ListConfigs(c *gin.Context) {
 			constant.FILE_LIST_VALIDATION_ERR_MSG,
 			[]common.ValidationError{{
 				Field:   constant.FILE_LIST_SELLER_ID_FIELD,
+				Rule:    constants.VALIDATION_RULE_INVALID,
 				Message: constant.FILE_LIST_SELLER_ID_ERR_MSG,
 			}},
 			constant.FILE_LIST_VALIDATION_ERR_CODE,
@@ -61,6 +61,7 @@ func (h *FileUploadHandler) InitUpload(c *gin.Context) {
 				[]common.ValidationError{
 					{
 						Field:   "Idempotency-Key",
+						Rule:    constants.VALIDATION_RULE_INVALID,
 						Message: "Idempotency-Key must be 8..128 characters and contain only A-Z, a-z, 0-9, '.', '_', '~', or '-'",
 					},
 				},
@@ -18,7 +18,7 @@ import "ecommerce-be/file/entity"
 //   - UploadExpiryMinutes:  optional (default 15); [5, 60] — validated by struct tag
 type InitUploadRequest struct {
 	// Purpose determines policy (max size, allowed mimes, variant generation).
-	Purpose entity.FilePurpose `json:"purpose" binding:"required,oneof=PRODUCT_IMAGE IMPORT_FILE EXPORT_FILE DOCUMENT USER_AVATAR SELLER_LOGO INVOICE_PDF"`
+	Purpose entity.FilePurpose `json:"purpose" binding:"required,oneof=PRODUCT_IMAGE IMPORT_FILE EXPORT_FILE DOCUMENT USER_AVATAR SELLER_LOGO INVOICE_PDF PRODUCT_VIDEO PRODUCT_MODEL"`
 
 	// Visibility is PRIVATE by default; in v1 it is a logical flag only (FR-008a).
 	Visibility entity.FileVisibility `json:"visibility" binding:"omitempty,oneof=PRIVATE PUBLIC INTERNAL"`
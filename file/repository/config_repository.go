@@ -7,8 +7,6 @@ import (
 	"ecommerce-be/common/db"
 	"ecommerce-be/file/entity"
 	"ecommerce-be/file/model"
-
-	"gorm.io/gorm"
 )
 
 type ConfigRepository interface {
@@ -140,7 +138,9 @@ func (r *configRepository) SaveConfig(
 	ctx context.Context,
 	config *entity.StorageConfig,
 ) error {
-	return db.DB(ctx).Transaction(func(tx *gorm.DB) error {
+	return db.WithTransaction(ctx, func(txCtx context.Context) error {
+		tx := db.DB(txCtx)
+
 		if config.IsDefault {
 			q := tx.Model(&entity.StorageConfig{}).Where("owner_type = ?", config.OwnerType)
 			if config.OwnerType == entity.OwnerTypeSeller && config.OwnerID != nil {
@@ -69,4 +69,11 @@ type FileUploadRepository interface {
 
 	// DeleteFileObject hard-deletes a file_object row by primary key.
 	DeleteFileObject(ctx context.Context, id uint64) error
+
+	// UpsertVariant inserts a file_variant row or replaces the one with the same
+	// (file_object_id, variant_code), so reprocessing a file is idempotent.
+	UpsertVariant(ctx context.Context, variant *entity.FileVariant) error
+
+	// MarkFileJobsDone transitions the PUBLISHED jobs of a file_object to DONE.
+	MarkFileJobsDone(ctx context.Context, fileObjectID uint64, command string) error
 }
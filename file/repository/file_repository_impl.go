@@ -10,6 +10,7 @@ import (
 	"ecommerce-be/file/model"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type fileUploadRepository struct{}
@@ -248,6 +249,39 @@ func (r *fileUploadRepository) DeleteFileObject(ctx context.Context, id uint64)
 	return db.DB(ctx).Delete(&entity.FileObject{}, id).Error
 }
 
+// UpsertVariant inserts or replaces the variant identified by (file_object_id, variant_code).
+func (r *fileUploadRepository) UpsertVariant(
+	ctx context.Context,
+	variant *entity.FileVariant,
+) error {
+	return db.DB(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "file_object_id"}, {Name: "variant_code"}},
+		DoUpdates: clause.AssignmentColumns([]string{
+			"mime_type", "bucket_or_container", "object_key", "size_bytes",
+			"width", "height", "status", "updated_at",
+		}),
+	}).Create(variant).Error
+}
+
+// MarkFileJobsDone transitions PUBLISHED jobs for a file_object and command to DONE.
+func (r *fileUploadRepository) MarkFileJobsDone(
+	ctx context.Context,
+	fileObjectID uint64,
+	command string,
+) error {
+	return db.DB(ctx).
+		Model(&entity.FileJob{}).
+		Where(
+			"file_object_id = ? AND command = ? AND status = ?",
+			fileObjectID, command, entity.FileJobStatusPublished,
+		).
+		Updates(map[string]any{
+			"status":     entity.FileJobStatusDone,
+			"updated_at": time.Now().UTC(),
+		}).
+		Error
+}
+
 // resolveFileSortColumn maps client sort keys to safe database column names.
 func resolveFileSortColumn(sortBy string) string {
 	switch sortBy {
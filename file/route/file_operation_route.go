@@ -1,10 +1,14 @@
 package route
 
 import (
+	"net/http"
+
 	"ecommerce-be/common/constants"
 	"ecommerce-be/common/middleware"
+	"ecommerce-be/common/openapi"
 	"ecommerce-be/file/factory/singleton"
 	"ecommerce-be/file/handler"
+	"ecommerce-be/file/model"
 
 	"github.com/gin-gonic/gin"
 )
@@ -30,15 +34,33 @@ func (m *FileOperationModule) RegisterRoutes(router *gin.Engine) {
 	// adminAuth := middleware.AdminAuth()
 
 	// Seller endpoints for generic file operations
-	fileRoutes := router.Group(constants.APIBaseFile)
+	fileRoutes := openapi.NewGroup(router.Group(constants.APIBaseFile), "Files")
 	{
-		fileRoutes.GET("", sellerAuth, m.fileHandler.GetAllFiles)
-		fileRoutes.GET("/:fileId", sellerAuth, m.fileHandler.GetFile)
-		fileRoutes.GET("/:fileId/download-url", sellerAuth, m.fileHandler.GetDownloadURL)
-		fileRoutes.DELETE("/:fileId", sellerAuth, m.fileHandler.DeleteFile)
-		fileRoutes.POST("/:fileId/variants", sellerAuth, m.fileHandler.RequestVariants)
-
-		fileRoutes.POST("/init-upload", sellerAuth, m.uploadHandler.InitUpload)
-		fileRoutes.POST("/complete-upload", sellerAuth, m.uploadHandler.CompleteUpload)
+		fileRoutes.GET("", sellerAuth, m.fileHandler.GetAllFiles).
+			Summary("List files").
+			Query(model.GetFilesParam{}).
+			Returns(http.StatusOK, model.GetFilesResponse{})
+		fileRoutes.GET("/:fileId", sellerAuth, m.fileHandler.GetFile).
+			Summary("Get a file").
+			Query(model.GetFileQuery{}).
+			Returns(http.StatusOK, model.GetFileResponse{})
+		fileRoutes.GET("/:fileId/download-url", sellerAuth, m.fileHandler.GetDownloadURL).
+			Summary("Get a signed download URL").
+			Query(model.DownloadURLQuery{}).
+			Returns(http.StatusOK, model.DownloadURLResponse{})
+		fileRoutes.DELETE("/:fileId", sellerAuth, m.fileHandler.DeleteFile).
+			Summary("Delete a file").
+			Returns(http.StatusOK, model.DeleteFileResponse{})
+		fileRoutes.POST("/:fileId/variants", sellerAuth, m.fileHandler.RequestVariants).
+			Summary("Request derived variants of a file")
+
+		fileRoutes.POST("/init-upload", sellerAuth, m.uploadHandler.InitUpload).
+			Summary("Start a direct upload").
+			Body(model.InitUploadRequest{}).
+			Returns(http.StatusCreated, model.InitUploadData{})
+		fileRoutes.POST("/complete-upload", sellerAuth, m.uploadHandler.CompleteUpload).
+			Summary("Confirm a direct upload").
+			Body(model.CompleteUploadRequest{}).
+			Returns(http.StatusOK, model.CompleteUploadData{})
 	}
 }
@@ -3,6 +3,7 @@ package route
 import (
 	"ecommerce-be/common/constants"
 	"ecommerce-be/common/middleware"
+	"ecommerce-be/common/openapi"
 	"ecommerce-be/file/handler"
 
 	"github.com/gin-gonic/gin"
@@ -24,11 +25,20 @@ func NewFileImportExportModule() *FileImportExportModule {
 func (m *FileImportExportModule) RegisterRoutes(router *gin.Engine) {
 	sellerAuth := middleware.SellerAuth()
 
-	fileRoutes := router.Group(constants.APIBaseFile)
+	fileRoutes := openapi.NewGroup(router.Group(constants.APIBaseFile), "File Import and Export")
 	{
-		fileRoutes.POST("/imports", sellerAuth, m.exportImportHandler.CreateImportJob)
-		fileRoutes.GET("/imports/:jobId", sellerAuth, m.exportImportHandler.GetImportJob)
-		fileRoutes.POST("/exports", sellerAuth, m.exportImportHandler.CreateExportJob)
-		fileRoutes.GET("/exports/:jobId", sellerAuth, m.exportImportHandler.GetExportJob)
+		fileRoutes.POST(
+			"/imports",
+			middleware.BulkBodySizeLimit(),
+			sellerAuth,
+			m.exportImportHandler.CreateImportJob,
+		).
+			Summary("Create an import job")
+		fileRoutes.GET("/imports/:jobId", sellerAuth, m.exportImportHandler.GetImportJob).
+			Summary("Get an import job")
+		fileRoutes.POST("/exports", sellerAuth, m.exportImportHandler.CreateExportJob).
+			Summary("Create an export job")
+		fileRoutes.GET("/exports/:jobId", sellerAuth, m.exportImportHandler.GetExportJob).
+			Summary("Get an export job")
 	}
 }
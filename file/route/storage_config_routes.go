@@ -1,10 +1,14 @@
 package route
 
 import (
+	"net/http"
+
 	"ecommerce-be/common/constants"
 	"ecommerce-be/common/middleware"
+	"ecommerce-be/common/openapi"
 	"ecommerce-be/file/factory/singleton"
 	"ecommerce-be/file/handler"
+	"ecommerce-be/file/model"
 
 	"github.com/gin-gonic/gin"
 )
@@ -26,14 +30,29 @@ func NewFileStorageConfigModule() *FileStorageConfigModule {
 func (m *FileStorageConfigModule) RegisterRoutes(router *gin.Engine) {
 	sellerAuth := middleware.SellerAuth()
 
-	fileRoutes := router.Group(constants.APIBaseFile)
+	fileRoutes := openapi.NewGroup(router.Group(constants.APIBaseFile), "Storage Configs")
 	{
-		fileRoutes.GET("/storage/providers", sellerAuth, m.configHandler.GetProviders)
-		fileRoutes.GET("/storage-config/schema", sellerAuth, m.configHandler.GetAdapterSchema)
-		fileRoutes.POST("/storage-config/test", sellerAuth, m.configHandler.TestConfig)
-		fileRoutes.POST("/storage-config", sellerAuth, m.configHandler.SaveConfig)
-		fileRoutes.PUT("/storage-config/:id", sellerAuth, m.configHandler.UpdateConfig)
-		fileRoutes.GET("/storage-config", sellerAuth, m.configHandler.ListConfigs)
+		fileRoutes.GET("/storage/providers", sellerAuth, m.configHandler.GetProviders).
+			Summary("List storage providers").
+			Returns(http.StatusOK, []model.ProviderResponse{})
+		fileRoutes.GET("/storage-config/schema", sellerAuth, m.configHandler.GetAdapterSchema).
+			Summary("Get the config schema of each storage adapter")
+		fileRoutes.POST("/storage-config/test", sellerAuth, m.configHandler.TestConfig).
+			Summary("Test storage credentials without saving").
+			Body(model.SaveConfigRequest{}).
+			Returns(http.StatusOK, model.TestStorageConfigResponse{})
+		fileRoutes.POST("/storage-config", sellerAuth, m.configHandler.SaveConfig).
+			Summary("Save a storage config").
+			Body(model.SaveConfigRequest{}).
+			Returns(http.StatusOK, model.ConfigResponse{})
+		fileRoutes.PUT("/storage-config/:id", sellerAuth, m.configHandler.UpdateConfig).
+			Summary("Update a storage config").
+			Body(model.UpdateStorageConfigRequest{}).
+			Returns(http.StatusOK, model.ConfigResponse{})
+		fileRoutes.GET("/storage-config", sellerAuth, m.configHandler.ListConfigs).
+			Summary("List storage configs").
+			Query(model.ListStorageConfigQueryParams{}).
+			Returns(http.StatusOK, model.ListStorageConfigsResponse{})
 	}
 
 }
@@ -119,6 +119,10 @@ func (s *fileDeleteService) deleteVariantBlobsBestEffort(
 	fileID string,
 ) {
 	for _, variant := range variants {
+		// Variants the worker failed to produce have no object
+		if variant.ObjectKey == "" {
+			continue
+		}
 		err := adapter.DeleteObject(ctx, variant.BucketOrContainer, variant.ObjectKey)
 		if err == nil || fileError.IsBlobError(err, fileError.ErrBlobNotFound) {
 			continue
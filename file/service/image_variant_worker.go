@@ -0,0 +1,269 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"image"
+	_ "image/gif" // register decoder
+	"image/jpeg"
+	"image/png"
+	"io"
+
+	"ecommerce-be/common/log"
+	"ecommerce-be/common/messaging"
+	"ecommerce-be/file/entity"
+	fileError "ecommerce-be/file/error"
+	fileMessaging "ecommerce-be/file/messaging"
+	"ecommerce-be/file/model"
+	"ecommerce-be/file/repository"
+	"ecommerce-be/file/service/blobAdapter"
+	"ecommerce-be/file/utils"
+	"ecommerce-be/file/utils/constant"
+)
+
+// errVariantSourceUnusable marks source images the worker can never process (unknown
+// format, corrupt data, too large or missing from storage). The command is
+// acknowledged and its variants recorded as FAILED instead of being redelivered.
+var errVariantSourceUnusable = errors.New("variant source image unusable")
+
+// ImageVariantWorker consumes file.image.process.requested commands published by
+// complete-upload and writes the requested variants back to the file's storage as
+// file_variant rows.
+//
+// Semantics:
+//   - Idempotent: variants are upserted by (file_object_id, variant_code), so a
+//     redelivered command overwrites the previous result.
+//   - Storage and database failures are returned as messaging.RetryableError.
+//   - Variants that cannot be produced (unsupported kind, unusable source) are
+//     recorded as FAILED; readers fall back to the original file.
+type ImageVariantWorker struct {
+	fileRepo   repository.FileUploadRepository
+	configRepo repository.ConfigRepository
+}
+
+// NewImageVariantWorker creates a worker wired to the given repositories.
+func NewImageVariantWorker(
+	fileRepo repository.FileUploadRepository,
+	configRepo repository.ConfigRepository,
+) *ImageVariantWorker {
+	return &ImageVariantWorker{
+		fileRepo:   fileRepo,
+		configRepo: configRepo,
+	}
+}
+
+// Handle processes one file.image.process.requested delivery.
+func (w *ImageVariantWorker) Handle(ctx context.Context, msg messaging.Message) error {
+	var env messaging.Envelope
+	if err := json.Unmarshal(msg.Body, &env); err != nil {
+		return fmt.Errorf("image variant worker: decode envelope: %w", err)
+	}
+	var cmd fileMessaging.ImageProcessRequested
+	if err := env.DecodePayload(&cmd); err != nil {
+		return fmt.Errorf("image variant worker: decode payload: %w", err)
+	}
+	if cmd.FileObjectID == 0 {
+		return fmt.Errorf("image variant worker: message %s has no file object ID", env.MessageID)
+	}
+
+	row, err := w.fileRepo.FindByID(ctx, cmd.FileObjectID)
+	if err != nil {
+		return messaging.RetryableError{Err: fmt.Errorf("image variant worker: FindByID: %w", err)}
+	}
+	// Deleted or not (yet) active files have nothing to process
+	if row == nil || row.Status != entity.FileStatusActive {
+		log.InfoWithContext(ctx, fmt.Sprintf(
+			"image variant worker: skipping fileObjectId=%d correlationId=%s",
+			cmd.FileObjectID, env.CorrelationID,
+		))
+		return nil
+	}
+
+	adapter, err := w.resolveAdapter(ctx, row)
+	if err != nil {
+		return messaging.RetryableError{Err: err}
+	}
+
+	src, err := loadVariantSource(ctx, adapter, row)
+	if err != nil {
+		if !errors.Is(err, errVariantSourceUnusable) {
+			return messaging.RetryableError{Err: err}
+		}
+		log.WarnWithContext(ctx, fmt.Sprintf(
+			"image variant worker: fileObjectId=%d: %v", cmd.FileObjectID, err,
+		))
+	}
+
+	for _, code := range cmd.VariantsRequested {
+		if src == nil {
+			err = w.recordFailedVariant(ctx, row, code)
+		} else {
+			err = w.processVariant(ctx, adapter, row, src, code)
+		}
+		if err != nil {
+			return messaging.RetryableError{Err: err}
+		}
+	}
+
+	if err := w.fileRepo.MarkFileJobsDone(
+		ctx,
+		cmd.FileObjectID,
+		constant.RoutingKeyFileImageProcessRequested,
+	); err != nil {
+		return messaging.RetryableError{
+			Err: fmt.Errorf("image variant worker: mark job done: %w", err),
+		}
+	}
+
+	log.InfoWithContext(ctx, fmt.Sprintf(
+		"image variant worker: processed fileObjectId=%d variants=%v correlationId=%s",
+		cmd.FileObjectID, cmd.VariantsRequested, env.CorrelationID,
+	))
+	return nil
+}
+
+// resolveAdapter builds the blob adapter of the storage config the file lives in.
+func (w *ImageVariantWorker) resolveAdapter(
+	ctx context.Context,
+	row *entity.FileObject,
+) (blobAdapter.BlobAdapter, error) {
+	cfg, err := w.configRepo.GetConfigByID(ctx, uint(row.StorageConfigID))
+	if err != nil {
+		return nil, fmt.Errorf(
+			"image variant worker: load configId=%d: %w", row.StorageConfigID, err,
+		)
+	}
+	adapter, err := blobAdapter.GetAdapterFromStoredConfig(ctx, cfg.Provider.AdapterType, cfg.ConfigData)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"image variant worker: blob adapter configId=%d: %w", row.StorageConfigID, err,
+		)
+	}
+	return adapter, nil
+}
+
+// processVariant resizes and encodes one variant, stores it next to the original and
+// records it as READY. Unsupported codes are recorded as FAILED.
+func (w *ImageVariantWorker) processVariant(
+	ctx context.Context,
+	adapter blobAdapter.BlobAdapter,
+	row *entity.FileObject,
+	src image.Image,
+	code string,
+) error {
+	spec, ok := utils.ParseVariantCode(code)
+	if !ok || !utils.VariantKindSupported(spec.Kind) {
+		log.InfoWithContext(ctx, fmt.Sprintf(
+			"image variant worker: unsupported variant %s for fileObjectId=%d", code, row.ID,
+		))
+		return w.recordFailedVariant(ctx, row, code)
+	}
+
+	bounds := src.Bounds()
+	width, height := utils.FitWithin(bounds.Dx(), bounds.Dy(), spec.MaxDimension)
+	resized := utils.ResizeImage(src, width, height)
+
+	body, mimeType, ext, err := encodeThumbnail(resized)
+	if err != nil {
+		log.WarnWithContext(ctx, "image variant worker: encode "+code+": "+err.Error())
+		return w.recordFailedVariant(ctx, row, code)
+	}
+
+	key := utils.BuildVariantObjectKey(row.ObjectKey, code, ext)
+	if _, err := adapter.PutObject(ctx, model.BlobPutObjectInput{
+		Bucket:        row.BucketOrContainer,
+		Key:           key,
+		ContentType:   mimeType,
+		ContentLength: int64(len(body)),
+		Body:          bytes.NewReader(body),
+	}); err != nil {
+		return fmt.Errorf("image variant worker: put %s: %w", code, err)
+	}
+
+	return w.fileRepo.UpsertVariant(ctx, &entity.FileVariant{
+		FileObjectID:      uint64(row.ID),
+		VariantCode:       code,
+		MimeType:          mimeType,
+		BucketOrContainer: row.BucketOrContainer,
+		ObjectKey:         key,
+		SizeBytes:         int64(len(body)),
+		Width:             &width,
+		Height:            &height,
+		Status:            constant.FileVariantStatusReady,
+	})
+}
+
+// recordFailedVariant upserts a FAILED variant row without an object.
+func (w *ImageVariantWorker) recordFailedVariant(
+	ctx context.Context,
+	row *entity.FileObject,
+	code string,
+) error {
+	return w.fileRepo.UpsertVariant(ctx, &entity.FileVariant{
+		FileObjectID:      uint64(row.ID),
+		VariantCode:       code,
+		BucketOrContainer: row.BucketOrContainer,
+		Status:            constant.FileVariantStatusFailed,
+	})
+}
+
+// loadVariantSource downloads and decodes the original image. Sources that are too
+// large or cannot be decoded return errVariantSourceUnusable.
+func loadVariantSource(
+	ctx context.Context,
+	adapter blobAdapter.BlobAdapter,
+	row *entity.FileObject,
+) (image.Image, error) {
+	stream, _, err := adapter.GetObjectStream(ctx, row.BucketOrContainer, row.ObjectKey)
+	if stream != nil {
+		defer stream.Close()
+	}
+	if err != nil {
+		if fileError.IsBlobError(err, fileError.ErrBlobNotFound) {
+			return nil, fmt.Errorf("%w: source object missing", errVariantSourceUnusable)
+		}
+		return nil, fmt.Errorf("image variant worker: get source: %w", err)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(stream, constant.MaxVariantSourceBytes+1))
+	if err != nil {
+		return nil, fmt.Errorf("image variant worker: read source: %w", err)
+	}
+	if len(data) > constant.MaxVariantSourceBytes {
+		return nil, fmt.Errorf(
+			"%w: larger than %d bytes", errVariantSourceUnusable, constant.MaxVariantSourceBytes,
+		)
+	}
+
+	// Check dimensions before decoding so a decompression bomb is never allocated
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errVariantSourceUnusable, err)
+	}
+	if config.Width*config.Height > constant.MaxVariantSourcePixels {
+		return nil, fmt.Errorf(
+			"%w: %dx%d pixels", errVariantSourceUnusable, config.Width, config.Height,
+		)
+	}
+
+	src, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errVariantSourceUnusable, err)
+	}
+	return src, nil
+}
+
+// encodeThumbnail encodes a resized image as JPEG, or as PNG when it has transparency.
+// Returns the encoded bytes, MIME type and file extension.
+func encodeThumbnail(img *image.NRGBA) ([]byte, string, string, error) {
+	var buf bytes.Buffer
+	if img.Opaque() {
+		err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: constant.VariantJPEGQuality})
+		return buf.Bytes(), "image/jpeg", "jpg", err
+	}
+	err := png.Encode(&buf, img)
+	return buf.Bytes(), "image/png", "png", err
+}
@@ -45,7 +45,7 @@ type fileUploadService struct {
 	configRepo  repository.ConfigRepository
 	scheduler   UploadExpiryScheduler
 	publisher   VariantPublisher
-	redisClient *redis.Client
+	redisClient redis.UniversalClient
 }
 
 func NewFileUploadService(
@@ -53,7 +53,7 @@ func NewFileUploadService(
 	configRepo repository.ConfigRepository,
 	scheduler UploadExpiryScheduler,
 	publisher VariantPublisher,
-	redisClient *redis.Client,
+	redisClient redis.UniversalClient,
 ) FileUploadService {
 	return &fileUploadService{
 		repo:        repo,
@@ -0,0 +1,16 @@
+package utils
+
+import (
+	"net/url"
+	"strings"
+)
+
+// BuildCDNURL joins the CDN base URL and an object key, escaping each key segment.
+// Object keys are already built from sanitised segments; escaping guards legacy keys.
+func BuildCDNURL(baseURL, objectKey string) string {
+	segments := strings.Split(strings.TrimLeft(objectKey, "/"), "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+	return strings.TrimRight(baseURL, "/") + "/" + strings.Join(segments, "/")
+}
@@ -87,6 +87,40 @@ const (
 
 	// RoutingKeyFileImageProcessRequested is the routing key for the image-variant command.
 	RoutingKeyFileImageProcessRequested = "file.image.process.requested"
+
+	// QueueFileImageProcess is bound to file.image.process.requested on ecom.commands and
+	// consumed by the ImageVariantWorker.
+	QueueFileImageProcess = "q.file.image.process"
+)
+
+// ========================================
+// IMAGE VARIANTS (variant worker)
+// ========================================
+
+const (
+	// FileVariantStatusReady marks a variant whose object has been written to storage.
+	FileVariantStatusReady = "READY"
+
+	// FileVariantStatusFailed marks a variant the worker could not produce; readers
+	// fall back to the original file.
+	FileVariantStatusFailed = "FAILED"
+
+	// VariantKindThumbnail codes (thumb_{px}) are encoded as JPEG, or PNG when the
+	// source has transparency.
+	VariantKindThumbnail = "thumb"
+
+	// VariantKindWebP codes (webp_{px}) require a WebP encoder.
+	VariantKindWebP = "webp"
+
+	// VariantJPEGQuality is the JPEG quality used for thumbnails.
+	VariantJPEGQuality = 85
+
+	// MaxVariantSourcePixels bounds the decoded size of a source image (width × height)
+	// so a small, highly compressed file cannot exhaust worker memory.
+	MaxVariantSourcePixels = 40_000_000
+
+	// MaxVariantSourceBytes bounds how much of a source object the worker reads.
+	MaxVariantSourceBytes = 10 * 1024 * 1024
 )
 
 // ========================================
@@ -0,0 +1,91 @@
+package utils
+
+import (
+	"image"
+	"image/color"
+	"strconv"
+	"strings"
+
+	"ecommerce-be/file/utils/constant"
+)
+
+// VariantSpec describes a derived image parsed from a variant code such as
+// "thumb_200" or "webp_1600".
+type VariantSpec struct {
+	// Code is the variant code as requested (e.g. "thumb_200").
+	Code string
+
+	// Kind is the prefix of the code (constant.VariantKindThumbnail or VariantKindWebP).
+	Kind string
+
+	// MaxDimension bounds the longer edge of the variant in pixels.
+	MaxDimension int
+}
+
+// ParseVariantCode splits a "{kind}_{px}" variant code. Returns false for codes that
+// are not image resizes (e.g. "poster") or whose size is not a positive integer.
+func ParseVariantCode(code string) (VariantSpec, bool) {
+	kind, size, found := strings.Cut(code, "_")
+	if !found {
+		return VariantSpec{}, false
+	}
+	maxDimension, err := strconv.Atoi(size)
+	if err != nil || maxDimension <= 0 {
+		return VariantSpec{}, false
+	}
+	return VariantSpec{Code: code, Kind: kind, MaxDimension: maxDimension}, true
+}
+
+// FitWithin scales width × height to fit a maxDimension square, keeping the aspect
+// ratio. Images are never upscaled and each edge is at least one pixel.
+func FitWithin(width, height, maxDimension int) (int, int) {
+	if width <= maxDimension && height <= maxDimension {
+		return width, height
+	}
+	if width >= height {
+		return maxDimension, max(1, height*maxDimension/width)
+	}
+	return max(1, width*maxDimension/height), maxDimension
+}
+
+// ResizeImage downscales src to width × height by averaging the source pixels that
+// fall under each destination pixel (box filter). Averaging is done on
+// alpha-premultiplied values so transparent pixels do not darken edges.
+func ResizeImage(src image.Image, width, height int) *image.NRGBA {
+	bounds := src.Bounds()
+	srcWidth, srcHeight := bounds.Dx(), bounds.Dy()
+	dst := image.NewNRGBA(image.Rect(0, 0, width, height))
+
+	for y := 0; y < height; y++ {
+		y0 := bounds.Min.Y + y*srcHeight/height
+		y1 := max(y0+1, bounds.Min.Y+(y+1)*srcHeight/height)
+		for x := 0; x < width; x++ {
+			x0 := bounds.Min.X + x*srcWidth/width
+			x1 := max(x0+1, bounds.Min.X+(x+1)*srcWidth/width)
+
+			var r, g, b, a, n uint64
+			for sy := y0; sy < y1; sy++ {
+				for sx := x0; sx < x1; sx++ {
+					pr, pg, pb, pa := src.At(sx, sy).RGBA()
+					r += uint64(pr)
+					g += uint64(pg)
+					b += uint64(pb)
+					a += uint64(pa)
+					n++
+				}
+			}
+			dst.Set(x, y, color.RGBA64{
+				R: uint16(r / n),
+				G: uint16(g / n),
+				B: uint16(b / n),
+				A: uint16(a / n),
+			})
+		}
+	}
+	return dst
+}
+
+// VariantKindSupported reports whether the worker can encode variants of a kind
+func VariantKindSupported(kind string) bool {
+	return kind == constant.VariantKindThumbnail
+}
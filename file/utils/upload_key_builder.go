@@ -2,6 +2,7 @@ package utils
 
 import (
 	"fmt"
+	"path"
 	"regexp"
 	"strings"
 	"time"
@@ -128,3 +129,12 @@ func BuildObjectKey(
 		return fmt.Sprintf("platform/%s/%s/%s/%s", purpose, yyyy, mm, baseName)
 	}
 }
+
+// BuildVariantObjectKey returns the object key of a derived variant, stored next to
+// its original: {dir}/variants/{variantCode}/{base}.{ext} where base is the original
+// file name without its extension.
+func BuildVariantObjectKey(objectKey, variantCode, ext string) string {
+	dir, name := path.Split(objectKey)
+	base := strings.TrimSuffix(name, path.Ext(name))
+	return dir + "variants/" + variantCode + "/" + base + "." + ext
+}
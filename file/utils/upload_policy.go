@@ -42,6 +42,8 @@ const (
 //   - USER_AVATAR:    2 MB, jpeg/png/webp, variants [thumb_200, webp_400]
 //   - SELLER_LOGO:    3 MB, jpeg/png/webp/svg, raster only variants [thumb_200, webp_400]; svg→HasVariants=false (applied per-call)
 //   - INVOICE_PDF:   10 MB, pdf only, no variants
+//   - PRODUCT_VIDEO: 200 MB, mp4/webm/quicktime, no variants
+//   - PRODUCT_MODEL:  50 MB, glb/gltf/usdz, no variants
 var purposePolicy = map[entity.FilePurpose]Policy{
 	entity.FilePurposeProductImage: {
 		MaxSize:      10 * mb,
@@ -84,6 +86,18 @@ var purposePolicy = map[entity.FilePurpose]Policy{
 		HasVariants:  false,
 		VariantCodes: nil,
 	},
+	entity.FilePurposeProductVideo: {
+		MaxSize:      200 * mb,
+		AllowedMimes: []string{"video/mp4", "video/webm", "video/quicktime"},
+		HasVariants:  false,
+		VariantCodes: nil,
+	},
+	entity.FilePurposeProductModel: {
+		MaxSize:      50 * mb,
+		AllowedMimes: []string{"model/gltf-binary", "model/gltf+json", "model/vnd.usdz+zip"},
+		HasVariants:  false,
+		VariantCodes: nil,
+	},
 	// EXPORT_FILE is intentionally omitted — Evaluate rejects it explicitly.
 }
 
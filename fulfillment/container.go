@@ -0,0 +1,46 @@
+package fulfillment
+
+import (
+	"ecommerce-be/common"
+	"ecommerce-be/common/cron"
+	"ecommerce-be/fulfillment/factory/singleton"
+	"ecommerce-be/fulfillment/route"
+	"ecommerce-be/fulfillment/service"
+	"ecommerce-be/fulfillment/utils/constant"
+
+	"github.com/gin-gonic/gin"
+)
+
+// NewContainer initializes dependencies dynamically
+func NewContainer(router *gin.Engine) *common.Container {
+	// Initialize Container
+	c := &common.Container{}
+
+	// Register all modules
+	addModules(c)
+
+	// Register schedulers
+	registerScheduler()
+
+	// Register routes for each module
+	for _, module := range c.Modules {
+		module.RegisterRoutes(router)
+	}
+
+	return c
+}
+
+// addModules registers all fulfillment-related modules
+func addModules(c *common.Container) {
+	c.RegisterModule(route.NewShipmentModule())
+}
+
+// registerScheduler registers recurring background jobs
+func registerScheduler() {
+	// Poll carriers without webhook support for tracking updates
+	cron.RegisterIntervalJob(
+		service.TrackingPollInterval(),
+		constant.TRACKING_POLL_JOB_NAME,
+		singleton.GetInstance().GetShipmentTrackingService().PollActiveShipments,
+	)
+}
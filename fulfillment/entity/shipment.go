@@ -57,16 +57,24 @@ func (s ShipmentStatus) IsValid() bool {
 
 type OrderShipment struct {
 	db.BaseEntity
-	OrderID     uint                   `json:"orderId"     gorm:"column:order_id;not null;index"`
-	Carrier     string                 `json:"carrier"     gorm:"column:carrier;size:50"`
-	TrackingNo  string                 `json:"trackingNo"  gorm:"column:tracking_no;size:100"`
-	Status      ShipmentStatus         `json:"status"      gorm:"column:status;size:32;default:pending;index"`
-	ShippedAt   *time.Time             `json:"shippedAt"   gorm:"column:shipped_at"`
-	DeliveredAt *time.Time             `json:"deliveredAt" gorm:"column:delivered_at"`
-	Metadata    map[string]any `json:"metadata"    gorm:"column:metadata;type:jsonb;default:'{}'"`
+	OrderID       uint           `json:"orderId"       gorm:"column:order_id;not null;index"`
+	SellerID      uint           `json:"sellerId"      gorm:"column:seller_id;not null;index"`
+	Carrier       string         `json:"carrier"       gorm:"column:carrier;size:50"`
+	TrackingNo    string         `json:"trackingNo"    gorm:"column:tracking_no;size:100"`
+	Status        ShipmentStatus `json:"status"        gorm:"column:status;size:32;default:pending;index"`
+	ShippedAt     *time.Time     `json:"shippedAt"     gorm:"column:shipped_at"`
+	DeliveredAt   *time.Time     `json:"deliveredAt"   gorm:"column:delivered_at"`
+	LastTrackedAt *time.Time     `json:"lastTrackedAt" gorm:"column:last_tracked_at"`
+	Metadata      db.JSONMap     `json:"metadata"      gorm:"column:metadata;type:jsonb;default:'{}'"`
 
 	// Relationships
-	Items []OrderShipmentItem `json:"items,omitempty" gorm:"foreignKey:ShipmentID"`
+	Items  []OrderShipmentItem     `json:"items,omitempty"  gorm:"foreignKey:ShipmentID"`
+	Events []ShipmentTrackingEvent `json:"events,omitempty" gorm:"foreignKey:ShipmentID"`
+}
+
+// IsTerminal reports whether the shipment can no longer receive tracking updates
+func (s ShipmentStatus) IsTerminal() bool {
+	return s == SHIPMENT_STATUS_DELIVERED || s == SHIPMENT_STATUS_RETURNED
 }
 
 // ============================================================================
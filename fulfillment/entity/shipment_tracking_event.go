@@ -0,0 +1,39 @@
+package entity
+
+import (
+	"time"
+
+	"ecommerce-be/common/db"
+)
+
+// ============================================================================
+// Tracking Event Source Enum
+// ============================================================================
+
+type TrackingEventSource string
+
+const (
+	TRACKING_SOURCE_WEBHOOK TrackingEventSource = "webhook"
+	TRACKING_SOURCE_POLLING TrackingEventSource = "polling"
+	TRACKING_SOURCE_SELLER  TrackingEventSource = "seller"
+)
+
+// ============================================================================
+// Shipment Tracking Event Entity
+// ============================================================================
+
+// ShipmentTrackingEvent is an immutable carrier checkpoint for a shipment.
+// Events are appended from carrier webhooks, tracking polls, or manual seller updates.
+type ShipmentTrackingEvent struct {
+	ID              uint                `json:"id"              gorm:"primaryKey"`
+	ShipmentID      uint                `json:"shipmentId"      gorm:"column:shipment_id;not null;index"`
+	Status          ShipmentStatus      `json:"status"          gorm:"column:status;size:32;not null"`
+	CarrierStatus   string              `json:"carrierStatus"   gorm:"column:carrier_status;size:100"`
+	Description     string              `json:"description"     gorm:"column:description"`
+	Location        string              `json:"location"        gorm:"column:location;size:255"`
+	Source          TrackingEventSource `json:"source"          gorm:"column:source;size:20;not null"`
+	ExternalEventID *string             `json:"externalEventId" gorm:"column:external_event_id;size:255"`
+	OccurredAt      time.Time           `json:"occurredAt"      gorm:"column:occurred_at;not null"`
+	RawPayload      db.JSONMap          `json:"-"               gorm:"column:raw_payload;type:jsonb;default:'{}'"`
+	CreatedAt       time.Time           `json:"createdAt"       gorm:"column:created_at;autoCreateTime"`
+}
@@ -0,0 +1,95 @@
+package error
+
+import (
+	"fmt"
+	"net/http"
+
+	commonError "ecommerce-be/common/error"
+)
+
+const (
+	SHIPMENT_NOT_FOUND_CODE             = "SHIPMENT_NOT_FOUND"
+	SHIPMENT_INVALID_STATUS_CODE        = "SHIPMENT_INVALID_STATUS"
+	SHIPMENT_INVALID_TRANSITION_CODE    = "SHIPMENT_INVALID_STATUS_TRANSITION"
+	SHIPMENT_TRACKING_EXISTS_CODE       = "SHIPMENT_TRACKING_NUMBER_EXISTS"
+	SHIPMENT_ORDER_NOT_SHIPPABLE_CODE   = "SHIPMENT_ORDER_NOT_SHIPPABLE"
+	SHIPMENT_INVALID_ORDER_ITEM_CODE    = "SHIPMENT_INVALID_ORDER_ITEM"
+	CARRIER_WEBHOOK_UNAUTHORIZED_CODE   = "CARRIER_WEBHOOK_UNAUTHORIZED"
+	CARRIER_WEBHOOK_NOT_CONFIGURED_CODE = "CARRIER_WEBHOOK_NOT_CONFIGURED"
+)
+
+const (
+	SHIPMENT_NOT_FOUND_MSG             = "Shipment not found"
+	SHIPMENT_INVALID_STATUS_MSG        = "Invalid shipment status"
+	SHIPMENT_INVALID_TRANSITION_MSG    = "Invalid shipment status transition from %s to %s"
+	SHIPMENT_TRACKING_EXISTS_MSG       = "A shipment with this carrier and tracking number already exists"
+	SHIPMENT_ORDER_NOT_SHIPPABLE_MSG   = "Order must be confirmed before it can be shipped"
+	SHIPMENT_INVALID_ORDER_ITEM_MSG    = "Order item %d does not belong to this order"
+	CARRIER_WEBHOOK_UNAUTHORIZED_MSG   = "Invalid carrier webhook signature"
+	CARRIER_WEBHOOK_NOT_CONFIGURED_MSG = "Carrier webhooks are not configured"
+)
+
+var (
+	ErrShipmentNotFound = &commonError.AppError{
+		Code:       SHIPMENT_NOT_FOUND_CODE,
+		Message:    SHIPMENT_NOT_FOUND_MSG,
+		StatusCode: http.StatusNotFound,
+	}
+
+	ErrInvalidShipmentStatus = &commonError.AppError{
+		Code:       SHIPMENT_INVALID_STATUS_CODE,
+		Message:    SHIPMENT_INVALID_STATUS_MSG,
+		StatusCode: http.StatusBadRequest,
+	}
+
+	ErrTrackingNumberExists = &commonError.AppError{
+		Code:       SHIPMENT_TRACKING_EXISTS_CODE,
+		Message:    SHIPMENT_TRACKING_EXISTS_MSG,
+		StatusCode: http.StatusConflict,
+	}
+
+	ErrOrderNotShippable = &commonError.AppError{
+		Code:       SHIPMENT_ORDER_NOT_SHIPPABLE_CODE,
+		Message:    SHIPMENT_ORDER_NOT_SHIPPABLE_MSG,
+		StatusCode: http.StatusConflict,
+	}
+
+	ErrCarrierWebhookUnauthorized = &commonError.AppError{
+		Code:       CARRIER_WEBHOOK_UNAUTHORIZED_CODE,
+		Message:    CARRIER_WEBHOOK_UNAUTHORIZED_MSG,
+		StatusCode: http.StatusUnauthorized,
+	}
+
+	ErrCarrierWebhookNotConfigured = &commonError.AppError{
+		Code:       CARRIER_WEBHOOK_NOT_CONFIGURED_CODE,
+		Message:    CARRIER_WEBHOOK_NOT_CONFIGURED_MSG,
+		StatusCode: http.StatusServiceUnavailable,
+	}
+)
+
+func ErrInvalidShipmentTransition(from, to string) *commonError.AppError {
+	return &commonError.AppError{
+		Code:       SHIPMENT_INVALID_TRANSITION_CODE,
+		Message:    fmt.Sprintf(SHIPMENT_INVALID_TRANSITION_MSG, from, to),
+		StatusCode: http.StatusConflict,
+	}
+}
+
+func ErrInvalidShipmentOrderItem(orderItemID uint) *commonError.AppError {
+	return &commonError.AppError{
+		Code:       SHIPMENT_INVALID_ORDER_ITEM_CODE,
+		Message:    fmt.Sprintf(SHIPMENT_INVALID_ORDER_ITEM_MSG, orderItemID),
+		StatusCode: http.StatusBadRequest,
+	}
+}
+
+func init() {
+	commonError.Register(
+		ErrShipmentNotFound,
+		ErrInvalidShipmentStatus,
+		ErrTrackingNumberExists,
+		ErrOrderNotShippable,
+		ErrCarrierWebhookUnauthorized,
+		ErrCarrierWebhookNotConfigured,
+	)
+}
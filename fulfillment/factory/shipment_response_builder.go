@@ -0,0 +1,70 @@
+package factory
+
+import (
+	"ecommerce-be/fulfillment/entity"
+	"ecommerce-be/fulfillment/model"
+	orderModel "ecommerce-be/order/model"
+)
+
+// BuildShipmentResponse maps a shipment with preloaded items/events to its API response.
+func BuildShipmentResponse(shipment *entity.OrderShipment) model.ShipmentResponse {
+	items := make([]model.ShipmentItemResponse, 0, len(shipment.Items))
+	for _, item := range shipment.Items {
+		items = append(items, model.ShipmentItemResponse{
+			OrderItemID: item.OrderItemID,
+			Quantity:    item.Quantity,
+		})
+	}
+
+	events := make([]model.TrackingEventResponse, 0, len(shipment.Events))
+	for _, event := range shipment.Events {
+		events = append(events, model.TrackingEventResponse{
+			Status:        event.Status,
+			CarrierStatus: event.CarrierStatus,
+			Description:   event.Description,
+			Location:      event.Location,
+			OccurredAt:    event.OccurredAt,
+		})
+	}
+
+	return model.ShipmentResponse{
+		ID:            shipment.ID,
+		OrderID:       shipment.OrderID,
+		Carrier:       shipment.Carrier,
+		TrackingNo:    shipment.TrackingNo,
+		Status:        shipment.Status,
+		ShippedAt:     shipment.ShippedAt,
+		DeliveredAt:   shipment.DeliveredAt,
+		LastTrackedAt: shipment.LastTrackedAt,
+		Items:         items,
+		Events:        events,
+		CreatedAt:     shipment.CreatedAt,
+	}
+}
+
+// BuildOrderTrackingResponse maps all shipments of an order into the tracking view.
+func BuildOrderTrackingResponse(
+	order *orderModel.OrderResponse,
+	shipments []entity.OrderShipment,
+) *model.OrderTrackingResponse {
+	items := make([]model.OrderTrackingItemResponse, 0, len(order.Items))
+	for _, item := range order.Items {
+		items = append(items, model.OrderTrackingItemResponse{
+			OrderItemID:       item.ID,
+			ProductName:       item.ProductName,
+			Quantity:          item.Quantity,
+			FulfilledQuantity: item.FulfilledQuantity,
+		})
+	}
+
+	out := make([]model.ShipmentResponse, 0, len(shipments))
+	for i := range shipments {
+		out = append(out, BuildShipmentResponse(&shipments[i]))
+	}
+	return &model.OrderTrackingResponse{
+		OrderID:           order.ID,
+		FulfillmentStatus: order.FulfillmentStatus,
+		Items:             items,
+		Shipments:         out,
+	}
+}
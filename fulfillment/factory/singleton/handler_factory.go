@@ -0,0 +1,47 @@
+package singleton
+
+import (
+	"sync"
+
+	"ecommerce-be/fulfillment/handler"
+)
+
+// HandlerFactory manages all handler singleton instances
+type HandlerFactory struct {
+	serviceFactory *ServiceFactory
+
+	shipmentHandler       *handler.ShipmentHandler
+	carrierWebhookHandler *handler.CarrierWebhookHandler
+
+	once sync.Once
+}
+
+// NewHandlerFactory creates a new handler factory
+func NewHandlerFactory(serviceFactory *ServiceFactory) *HandlerFactory {
+	return &HandlerFactory{serviceFactory: serviceFactory}
+}
+
+// initialize creates all handler instances (lazy loading)
+func (f *HandlerFactory) initialize() {
+	f.once.Do(func() {
+		// Get services
+		shipmentService := f.serviceFactory.GetShipmentService()
+		trackingService := f.serviceFactory.GetShipmentTrackingService()
+
+		// Initialize handlers
+		f.shipmentHandler = handler.NewShipmentHandler(shipmentService)
+		f.carrierWebhookHandler = handler.NewCarrierWebhookHandler(trackingService)
+	})
+}
+
+// GetShipmentHandler returns the singleton shipment handler
+func (f *HandlerFactory) GetShipmentHandler() *handler.ShipmentHandler {
+	f.initialize()
+	return f.shipmentHandler
+}
+
+// GetCarrierWebhookHandler returns the singleton carrier webhook handler
+func (f *HandlerFactory) GetCarrierWebhookHandler() *handler.CarrierWebhookHandler {
+	f.initialize()
+	return f.carrierWebhookHandler
+}
@@ -0,0 +1,32 @@
+package singleton
+
+import (
+	"sync"
+
+	"ecommerce-be/fulfillment/repository"
+)
+
+// RepositoryFactory manages all repository singleton instances
+type RepositoryFactory struct {
+	shipmentRepo repository.ShipmentRepository
+
+	once sync.Once
+}
+
+// NewRepositoryFactory creates a new repository factory
+func NewRepositoryFactory() *RepositoryFactory {
+	return &RepositoryFactory{}
+}
+
+// initialize creates all repository instances (lazy loading)
+func (f *RepositoryFactory) initialize() {
+	f.once.Do(func() {
+		f.shipmentRepo = repository.NewShipmentRepository()
+	})
+}
+
+// GetShipmentRepository returns the singleton shipment repository
+func (f *RepositoryFactory) GetShipmentRepository() repository.ShipmentRepository {
+	f.initialize()
+	return f.shipmentRepo
+}
@@ -0,0 +1,82 @@
+package singleton
+
+import (
+	"sync"
+
+	msgFactory "ecommerce-be/common/messaging/factory"
+	"ecommerce-be/fulfillment/service"
+	"ecommerce-be/fulfillment/service/carrier"
+	orderFactory "ecommerce-be/order/factory/singleton"
+)
+
+// ServiceFactory manages all service singleton instances
+type ServiceFactory struct {
+	repoFactory *RepositoryFactory
+
+	carrierRegistry *carrier.Registry
+	trackingService service.ShipmentTrackingService
+	shipmentService service.ShipmentService
+
+	once sync.Once
+}
+
+// NewServiceFactory creates a new service factory
+func NewServiceFactory(repoFactory *RepositoryFactory) *ServiceFactory {
+	return &ServiceFactory{
+		repoFactory: repoFactory,
+	}
+}
+
+// initialize creates all service instances (lazy loading)
+func (f *ServiceFactory) initialize() {
+	f.once.Do(func() {
+		// Get external service dependencies
+		orderSvc := orderFactory.GetInstance().GetOrderService()
+		marketplaceSvc := orderFactory.GetInstance().GetMarketplaceOrderService()
+
+		// Get repositories
+		shipmentRepo := f.repoFactory.GetShipmentRepository()
+
+		var eventPublisher service.ShipmentEventPublisher
+		mf, err := msgFactory.New("")
+		if err == nil {
+			if pub, err := mf.Publisher(); err == nil {
+				eventPublisher = service.NewShipmentEventPublisher(pub)
+			}
+		}
+
+		// Carrier polling adapters register themselves here as integrations are added.
+		f.carrierRegistry = carrier.NewRegistry()
+
+		// Initialize services
+		f.trackingService = service.NewShipmentTrackingService(
+			shipmentRepo,
+			f.carrierRegistry,
+			eventPublisher,
+		)
+		f.shipmentService = service.NewShipmentService(
+			shipmentRepo,
+			f.trackingService,
+			orderSvc,
+			marketplaceSvc,
+		)
+	})
+}
+
+// GetCarrierRegistry returns the singleton carrier tracker registry
+func (f *ServiceFactory) GetCarrierRegistry() *carrier.Registry {
+	f.initialize()
+	return f.carrierRegistry
+}
+
+// GetShipmentTrackingService returns the singleton shipment tracking service
+func (f *ServiceFactory) GetShipmentTrackingService() service.ShipmentTrackingService {
+	f.initialize()
+	return f.trackingService
+}
+
+// GetShipmentService returns the singleton shipment service
+func (f *ServiceFactory) GetShipmentService() service.ShipmentService {
+	f.initialize()
+	return f.shipmentService
+}
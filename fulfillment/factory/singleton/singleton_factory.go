@@ -0,0 +1,80 @@
+package singleton
+
+import (
+	"sync"
+
+	"ecommerce-be/fulfillment/handler"
+	"ecommerce-be/fulfillment/repository"
+	"ecommerce-be/fulfillment/service"
+	"ecommerce-be/fulfillment/service/carrier"
+)
+
+// SingletonFactory is the main facade for accessing all factories
+type SingletonFactory struct {
+	repoFactory    *RepositoryFactory
+	serviceFactory *ServiceFactory
+	handlerFactory *HandlerFactory
+}
+
+var (
+	instance *SingletonFactory
+	once     sync.Once
+)
+
+// GetInstance returns the singleton instance of SingletonFactory
+func GetInstance() *SingletonFactory {
+	once.Do(func() {
+		repoFactory := NewRepositoryFactory()
+		serviceFactory := NewServiceFactory(repoFactory)
+		handlerFactory := NewHandlerFactory(serviceFactory)
+
+		instance = &SingletonFactory{
+			repoFactory:    repoFactory,
+			serviceFactory: serviceFactory,
+			handlerFactory: handlerFactory,
+		}
+	})
+	return instance
+}
+
+// ResetInstance resets the singleton instance
+func ResetInstance() {
+	once = sync.Once{}
+	instance = nil
+}
+
+// ===============================
+// Repository Getters (Delegates)
+// ===============================
+
+func (f *SingletonFactory) GetShipmentRepository() repository.ShipmentRepository {
+	return f.repoFactory.GetShipmentRepository()
+}
+
+// ===============================
+// Service Getters (Delegates)
+// ===============================
+
+func (f *SingletonFactory) GetCarrierRegistry() *carrier.Registry {
+	return f.serviceFactory.GetCarrierRegistry()
+}
+
+func (f *SingletonFactory) GetShipmentTrackingService() service.ShipmentTrackingService {
+	return f.serviceFactory.GetShipmentTrackingService()
+}
+
+func (f *SingletonFactory) GetShipmentService() service.ShipmentService {
+	return f.serviceFactory.GetShipmentService()
+}
+
+// ===============================
+// Handler Getters (Delegates)
+// ===============================
+
+func (f *SingletonFactory) GetShipmentHandler() *handler.ShipmentHandler {
+	return f.handlerFactory.GetShipmentHandler()
+}
+
+func (f *SingletonFactory) GetCarrierWebhookHandler() *handler.CarrierWebhookHandler {
+	return f.handlerFactory.GetCarrierWebhookHandler()
+}
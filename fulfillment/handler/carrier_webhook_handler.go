@@ -0,0 +1,87 @@
+package handler
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"strings"
+
+	"ecommerce-be/common/config"
+	"ecommerce-be/common/handler"
+	"ecommerce-be/common/log"
+	fulfillmentError "ecommerce-be/fulfillment/error"
+	"ecommerce-be/fulfillment/model"
+	"ecommerce-be/fulfillment/service"
+	fulfillmentConstants "ecommerce-be/fulfillment/utils/constant"
+
+	"github.com/gin-gonic/gin"
+)
+
+// CarrierWebhookHandler receives tracking pushes from carrier integrations.
+type CarrierWebhookHandler struct {
+	*handler.BaseHandler
+	trackingService service.ShipmentTrackingService
+}
+
+func NewCarrierWebhookHandler(
+	trackingService service.ShipmentTrackingService,
+) *CarrierWebhookHandler {
+	return &CarrierWebhookHandler{
+		BaseHandler:     handler.NewBaseHandler(),
+		trackingService: trackingService,
+	}
+}
+
+func (h *CarrierWebhookHandler) ReceiveTrackingUpdate(c *gin.Context) {
+	body, err := c.GetRawData()
+	if err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	signature := c.GetHeader(fulfillmentConstants.CARRIER_SIGNATURE_HEADER)
+	if err := verifyCarrierSignature(body, signature); err != nil {
+		h.HandleError(c, err, fulfillmentConstants.FAILED_TO_PROCESS_CARRIER_WEBHOOK_MSG)
+		return
+	}
+
+	// Restore the body consumed by signature verification so it can be bound.
+	c.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+	var req model.CarrierTrackingWebhookRequest
+	if err := h.BindJSON(c, &req); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	if err := h.trackingService.IngestCarrierWebhook(c, c.Param("carrier"), req); err != nil {
+		log.ErrorWithContext(c, "receiveTrackingUpdate: failed", err)
+		h.HandleError(c, err, fulfillmentConstants.FAILED_TO_PROCESS_CARRIER_WEBHOOK_MSG)
+		return
+	}
+
+	h.Success(c, http.StatusAccepted, fulfillmentConstants.CARRIER_WEBHOOK_ACCEPTED_MSG, nil)
+}
+
+// verifyCarrierSignature fails closed when no shared secret is configured.
+func verifyCarrierSignature(body []byte, signature string) error {
+	cfg := config.Get()
+	if cfg == nil || cfg.Fulfillment.CarrierWebhookSecret == "" {
+		return fulfillmentError.ErrCarrierWebhookNotConfigured
+	}
+
+	provided, err := hex.DecodeString(strings.TrimSpace(signature))
+	if err != nil || len(provided) == 0 {
+		return fulfillmentError.ErrCarrierWebhookUnauthorized
+	}
+
+	mac := hmac.New(sha256.New, []byte(cfg.Fulfillment.CarrierWebhookSecret))
+	mac.Write(body)
+	if !hmac.Equal(provided, mac.Sum(nil)) {
+		return fulfillmentError.ErrCarrierWebhookUnauthorized
+	}
+	return nil
+}
@@ -0,0 +1,108 @@
+package handler
+
+import (
+	"net/http"
+
+	"ecommerce-be/common/auth"
+	"ecommerce-be/common/constants"
+	errs "ecommerce-be/common/error"
+	"ecommerce-be/common/handler"
+	"ecommerce-be/common/log"
+	"ecommerce-be/fulfillment/model"
+	"ecommerce-be/fulfillment/service"
+	fulfillmentConstants "ecommerce-be/fulfillment/utils/constant"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ShipmentHandler struct {
+	*handler.BaseHandler
+	shipmentService service.ShipmentService
+}
+
+func NewShipmentHandler(shipmentService service.ShipmentService) *ShipmentHandler {
+	return &ShipmentHandler{
+		BaseHandler:     handler.NewBaseHandler(),
+		shipmentService: shipmentService,
+	}
+}
+
+func (h *ShipmentHandler) CreateShipment(c *gin.Context) {
+	sellerID, exists := auth.GetSellerIDFromContext(c)
+	if !exists {
+		h.HandleError(c, errs.ErrSellerDataMissing, constants.SELLER_DATA_MISSING_MSG)
+		return
+	}
+
+	var req model.CreateShipmentRequest
+	if err := h.BindJSON(c, &req); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	resp, err := h.shipmentService.CreateShipment(c, sellerID, req)
+	if err != nil {
+		log.ErrorWithContext(c, "createShipment: failed", err)
+		h.HandleError(c, err, fulfillmentConstants.FAILED_TO_CREATE_SHIPMENT_MSG)
+		return
+	}
+
+	h.Success(c, http.StatusCreated, fulfillmentConstants.SHIPMENT_CREATED_MSG, resp)
+}
+
+func (h *ShipmentHandler) UpdateShipmentStatus(c *gin.Context) {
+	sellerID, exists := auth.GetSellerIDFromContext(c)
+	if !exists {
+		h.HandleError(c, errs.ErrSellerDataMissing, constants.SELLER_DATA_MISSING_MSG)
+		return
+	}
+
+	shipmentID, err := h.ParseUintParam(c, "id")
+	if err != nil {
+		h.HandleError(c, err, fulfillmentConstants.INVALID_SHIPMENT_ID_MSG)
+		return
+	}
+
+	var req model.UpdateShipmentStatusRequest
+	if err := h.BindJSON(c, &req); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	resp, serviceErr := h.shipmentService.UpdateShipmentStatus(c, sellerID, shipmentID, req)
+	if serviceErr != nil {
+		log.ErrorWithContext(c, "updateShipmentStatus: failed", serviceErr)
+		h.HandleError(c, serviceErr, fulfillmentConstants.FAILED_TO_UPDATE_SHIPMENT_STATUS_MSG)
+		return
+	}
+
+	h.Success(c, http.StatusOK, fulfillmentConstants.SHIPMENT_STATUS_UPDATED_MSG, resp)
+}
+
+func (h *ShipmentHandler) GetOrderTracking(c *gin.Context) {
+	userID, exists := auth.GetUserIDFromContext(c)
+	if !exists {
+		h.HandleError(c, errs.UnauthorizedError, constants.AUTHENTICATION_REQUIRED_MSG)
+		return
+	}
+	_, role, exists := auth.GetUserRoleFromContext(c)
+	if !exists {
+		h.HandleError(c, errs.ErrRoleDataMissing, constants.ROLE_DATA_MISSING_MSG)
+		return
+	}
+
+	orderID, err := h.ParseUintParam(c, "id")
+	if err != nil {
+		h.HandleError(c, err, fulfillmentConstants.INVALID_ORDER_ID_MSG)
+		return
+	}
+
+	resp, serviceErr := h.shipmentService.GetOrderTracking(c, userID, role, orderID)
+	if serviceErr != nil {
+		log.ErrorWithContext(c, "getOrderTracking: failed", serviceErr)
+		h.HandleError(c, serviceErr, fulfillmentConstants.FAILED_TO_FETCH_ORDER_TRACKING_MSG)
+		return
+	}
+
+	h.Success(c, http.StatusOK, fulfillmentConstants.ORDER_TRACKING_FETCHED_MSG, resp)
+}
@@ -0,0 +1,103 @@
+package mapper
+
+import (
+	"strings"
+
+	"ecommerce-be/common/db"
+	"ecommerce-be/fulfillment/entity"
+	"ecommerce-be/fulfillment/model"
+	"ecommerce-be/fulfillment/service/carrier"
+	orderModel "ecommerce-be/order/model"
+)
+
+// BuildShipmentEntity maps a seller create request into a pending shipment row.
+func BuildShipmentEntity(
+	sellerID uint,
+	req model.CreateShipmentRequest,
+	lines []orderModel.FulfillmentLine,
+) *entity.OrderShipment {
+	items := make([]entity.OrderShipmentItem, 0, len(lines))
+	for _, item := range lines {
+		items = append(items, entity.OrderShipmentItem{
+			OrderItemID: item.OrderItemID,
+			Quantity:    item.Quantity,
+		})
+	}
+	metadata := db.JSONMap{}
+	for k, v := range req.Metadata {
+		metadata[k] = v
+	}
+	return &entity.OrderShipment{
+		OrderID:    req.OrderID,
+		SellerID:   sellerID,
+		Carrier:    carrier.NormalizeCode(req.Carrier),
+		TrackingNo: strings.TrimSpace(req.TrackingNo),
+		Status:     entity.SHIPMENT_STATUS_PENDING,
+		Metadata:   metadata,
+		Items:      items,
+	}
+}
+
+// BuildFulfillmentLines maps requested shipment items to the order lines they fulfill.
+func BuildFulfillmentLines(items []model.CreateShipmentItemRequest) []orderModel.FulfillmentLine {
+	lines := make([]orderModel.FulfillmentLine, 0, len(items))
+	for _, item := range items {
+		lines = append(lines, orderModel.FulfillmentLine{
+			OrderItemID: item.OrderItemID,
+			Quantity:    item.Quantity,
+		})
+	}
+	return lines
+}
+
+// BuildCheckpointsFromWebhook normalizes webhook events into carrier checkpoints.
+func BuildCheckpointsFromWebhook(
+	req model.CarrierTrackingWebhookRequest,
+) []carrier.TrackingCheckpoint {
+	checkpoints := make([]carrier.TrackingCheckpoint, 0, len(req.Events))
+	for _, event := range req.Events {
+		checkpoints = append(checkpoints, carrier.TrackingCheckpoint{
+			ExternalEventID: event.EventID,
+			Status:          normalizeStatus(event.Status),
+			CarrierStatus:   event.CarrierStatus,
+			Description:     event.Description,
+			Location:        event.Location,
+			OccurredAt:      event.OccurredAt.UTC(),
+			Raw: map[string]any{
+				"status":        event.Status,
+				"carrierStatus": event.CarrierStatus,
+				"description":   event.Description,
+				"location":      event.Location,
+				"occurredAt":    event.OccurredAt,
+			},
+		})
+	}
+	return checkpoints
+}
+
+// BuildTrackingEvents maps checkpoints into immutable tracking event rows.
+func BuildTrackingEvents(
+	shipmentID uint,
+	checkpoints []carrier.TrackingCheckpoint,
+	source entity.TrackingEventSource,
+) []entity.ShipmentTrackingEvent {
+	events := make([]entity.ShipmentTrackingEvent, 0, len(checkpoints))
+	for _, cp := range checkpoints {
+		events = append(events, entity.ShipmentTrackingEvent{
+			ShipmentID:      shipmentID,
+			Status:          cp.Status,
+			CarrierStatus:   cp.CarrierStatus,
+			Description:     cp.Description,
+			Location:        cp.Location,
+			Source:          source,
+			ExternalEventID: cp.ExternalEventID,
+			OccurredAt:      cp.OccurredAt.UTC(),
+			RawPayload:      db.JSONMap(cp.Raw),
+		})
+	}
+	return events
+}
+
+func normalizeStatus(status entity.ShipmentStatus) entity.ShipmentStatus {
+	return entity.ShipmentStatus(strings.ToLower(strings.TrimSpace(status.String())))
+}
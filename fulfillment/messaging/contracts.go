@@ -0,0 +1,23 @@
+// Package messaging contains wire-contract structs for messages published by the
+// fulfillment module. These structs are serialised into the Payload field of the
+// common/messaging.Envelope.
+package messaging
+
+import "time"
+
+// ShipmentStatusChanged is published on exchange "ecom.events" with routing key
+// "fulfillment.shipment.status.changed" whenever a shipment moves to a new status.
+// The notification module consumes it to inform customers (e.g. out for delivery,
+// delivered, delivery failed).
+type ShipmentStatusChanged struct {
+	ShipmentID     uint      `json:"shipmentId"`
+	OrderID        uint      `json:"orderId"`
+	SellerID       uint      `json:"sellerId"`
+	Carrier        string    `json:"carrier"`
+	TrackingNo     string    `json:"trackingNo"`
+	PreviousStatus string    `json:"previousStatus"`
+	Status         string    `json:"status"`
+	Description    string    `json:"description,omitempty"`
+	Location       string    `json:"location,omitempty"`
+	OccurredAt     time.Time `json:"occurredAt"`
+}
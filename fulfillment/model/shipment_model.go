@@ -0,0 +1,97 @@
+package model
+
+import (
+	"time"
+
+	"ecommerce-be/fulfillment/entity"
+	orderEntity "ecommerce-be/order/entity"
+)
+
+// ============================================================================
+// Request Models
+// ============================================================================
+
+// CreateShipmentRequest is used by sellers to register a shipment for an order.
+type CreateShipmentRequest struct {
+	OrderID    uint                        `json:"orderId"    binding:"required,gt=0"`
+	Carrier    string                      `json:"carrier"    binding:"required,max=50"`
+	TrackingNo string                      `json:"trackingNo" binding:"required,max=100"`
+	Items      []CreateShipmentItemRequest `json:"items"      binding:"omitempty,dive"`
+	Metadata   map[string]any              `json:"metadata"`
+}
+
+type CreateShipmentItemRequest struct {
+	OrderItemID uint `json:"orderItemId" binding:"required,gt=0"`
+	Quantity    int  `json:"quantity"    binding:"required,gt=0"`
+}
+
+// UpdateShipmentStatusRequest records a manual seller checkpoint (e.g. self-delivery).
+type UpdateShipmentStatusRequest struct {
+	Status      entity.ShipmentStatus `json:"status"      binding:"required"`
+	Description string                `json:"description" binding:"max=1000"`
+	Location    string                `json:"location"    binding:"max=255"`
+}
+
+// CarrierTrackingWebhookRequest is the normalized carrier tracking push payload.
+type CarrierTrackingWebhookRequest struct {
+	TrackingNo string                        `json:"trackingNo" binding:"required,max=100"`
+	Events     []CarrierTrackingEventRequest `json:"events"     binding:"required,min=1,dive"`
+}
+
+type CarrierTrackingEventRequest struct {
+	EventID       *string               `json:"eventId"       binding:"omitempty,max=255"`
+	Status        entity.ShipmentStatus `json:"status"        binding:"required"`
+	CarrierStatus string                `json:"carrierStatus" binding:"max=100"`
+	Description   string                `json:"description"`
+	Location      string                `json:"location"      binding:"max=255"`
+	OccurredAt    time.Time             `json:"occurredAt"    binding:"required"`
+}
+
+// ============================================================================
+// Response Models
+// ============================================================================
+
+type ShipmentItemResponse struct {
+	OrderItemID uint `json:"orderItemId"`
+	Quantity    int  `json:"quantity"`
+}
+
+type TrackingEventResponse struct {
+	Status        entity.ShipmentStatus `json:"status"`
+	CarrierStatus string                `json:"carrierStatus,omitempty"`
+	Description   string                `json:"description,omitempty"`
+	Location      string                `json:"location,omitempty"`
+	OccurredAt    time.Time             `json:"occurredAt"`
+}
+
+type ShipmentResponse struct {
+	ID            uint                    `json:"id"`
+	OrderID       uint                    `json:"orderId"`
+	Carrier       string                  `json:"carrier"`
+	TrackingNo    string                  `json:"trackingNo"`
+	Status        entity.ShipmentStatus   `json:"status"`
+	ShippedAt     *time.Time              `json:"shippedAt"`
+	DeliveredAt   *time.Time              `json:"deliveredAt"`
+	LastTrackedAt *time.Time              `json:"lastTrackedAt"`
+	Items         []ShipmentItemResponse  `json:"items"`
+	Events        []TrackingEventResponse `json:"events"`
+	CreatedAt     time.Time               `json:"createdAt"`
+	// OrderFulfillmentStatus is the order's fulfillment status after this shipment was created
+	OrderFulfillmentStatus orderEntity.OrderFulfillmentStatus `json:"orderFulfillmentStatus,omitempty"`
+}
+
+// OrderTrackingItemResponse is how much of one order line has shipped so far.
+type OrderTrackingItemResponse struct {
+	OrderItemID       uint   `json:"orderItemId"`
+	ProductName       string `json:"productName"`
+	Quantity          int    `json:"quantity"`
+	FulfilledQuantity int    `json:"fulfilledQuantity"`
+}
+
+// OrderTrackingResponse is the customer-facing tracking view for one order.
+type OrderTrackingResponse struct {
+	OrderID           uint                               `json:"orderId"`
+	FulfillmentStatus orderEntity.OrderFulfillmentStatus `json:"fulfillmentStatus"`
+	Items             []OrderTrackingItemResponse        `json:"items"`
+	Shipments         []ShipmentResponse                 `json:"shipments"`
+}
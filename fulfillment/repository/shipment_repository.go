@@ -0,0 +1,184 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"ecommerce-be/common/db"
+	"ecommerce-be/fulfillment/entity"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ShipmentRepository handles database operations for shipments and tracking events.
+type ShipmentRepository interface {
+	CreateShipment(ctx context.Context, shipment *entity.OrderShipment) error
+	FindShipmentByID(ctx context.Context, shipmentID uint) (*entity.OrderShipment, error)
+	FindShipmentByTracking(
+		ctx context.Context,
+		carrier, trackingNo string,
+	) (*entity.OrderShipment, error)
+	FindShipmentsByOrderID(ctx context.Context, orderID uint) ([]entity.OrderShipment, error)
+	FindActiveShipmentsByCarriers(
+		ctx context.Context,
+		carriers []string,
+		trackedBefore time.Time,
+		limit int,
+	) ([]entity.OrderShipment, error)
+
+	UpdateShipmentStatus(
+		ctx context.Context,
+		shipmentID uint,
+		status entity.ShipmentStatus,
+		occurredAt time.Time,
+	) error
+	TouchLastTrackedAt(ctx context.Context, shipmentID uint, trackedAt time.Time) error
+
+	// CreateTrackingEvents inserts events, skipping duplicates by (shipment_id, external_event_id).
+	// Returns the number of rows actually inserted.
+	CreateTrackingEvents(ctx context.Context, events []entity.ShipmentTrackingEvent) (int64, error)
+}
+
+// ShipmentRepositoryImpl implements ShipmentRepository.
+type ShipmentRepositoryImpl struct{}
+
+// NewShipmentRepository creates a new ShipmentRepository.
+func NewShipmentRepository() ShipmentRepository {
+	return &ShipmentRepositoryImpl{}
+}
+
+func (r *ShipmentRepositoryImpl) CreateShipment(
+	ctx context.Context,
+	shipment *entity.OrderShipment,
+) error {
+	return db.DB(ctx).Create(shipment).Error
+}
+
+func (r *ShipmentRepositoryImpl) FindShipmentByID(
+	ctx context.Context,
+	shipmentID uint,
+) (*entity.OrderShipment, error) {
+	var shipment entity.OrderShipment
+	err := db.DB(ctx).
+		Preload("Items").
+		Where("id = ?", shipmentID).
+		First(&shipment).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &shipment, nil
+}
+
+func (r *ShipmentRepositoryImpl) FindShipmentByTracking(
+	ctx context.Context,
+	carrier, trackingNo string,
+) (*entity.OrderShipment, error) {
+	var shipment entity.OrderShipment
+	err := db.DB(ctx).
+		Where("carrier = ? AND tracking_no = ?", carrier, trackingNo).
+		First(&shipment).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &shipment, nil
+}
+
+func (r *ShipmentRepositoryImpl) FindShipmentsByOrderID(
+	ctx context.Context,
+	orderID uint,
+) ([]entity.OrderShipment, error) {
+	var shipments []entity.OrderShipment
+	if err := db.DB(ctx).
+		Preload("Items").
+		Preload("Events", func(tx *gorm.DB) *gorm.DB {
+			return tx.Order("occurred_at DESC, id DESC")
+		}).
+		Where("order_id = ?", orderID).
+		Order("created_at ASC").
+		Find(&shipments).Error; err != nil {
+		return nil, err
+	}
+	return shipments, nil
+}
+
+func (r *ShipmentRepositoryImpl) FindActiveShipmentsByCarriers(
+	ctx context.Context,
+	carriers []string,
+	trackedBefore time.Time,
+	limit int,
+) ([]entity.OrderShipment, error) {
+	if len(carriers) == 0 {
+		return nil, nil
+	}
+	var shipments []entity.OrderShipment
+	if err := db.DB(ctx).
+		Where("carrier IN ?", carriers).
+		Where("status NOT IN ?", []entity.ShipmentStatus{
+			entity.SHIPMENT_STATUS_DELIVERED,
+			entity.SHIPMENT_STATUS_RETURNED,
+		}).
+		Where("last_tracked_at IS NULL OR last_tracked_at < ?", trackedBefore.UTC()).
+		Order("last_tracked_at ASC NULLS FIRST").
+		Limit(limit).
+		Find(&shipments).Error; err != nil {
+		return nil, err
+	}
+	return shipments, nil
+}
+
+func (r *ShipmentRepositoryImpl) UpdateShipmentStatus(
+	ctx context.Context,
+	shipmentID uint,
+	status entity.ShipmentStatus,
+	occurredAt time.Time,
+) error {
+	updates := map[string]any{
+		"status":          status,
+		"last_tracked_at": time.Now().UTC(),
+		"updated_at":      time.Now().UTC(),
+	}
+	switch status {
+	case entity.SHIPMENT_STATUS_PICKED, entity.SHIPMENT_STATUS_IN_TRANSIT:
+		// shipped_at is only set on the first move out of pending.
+		updates["shipped_at"] = gorm.Expr("COALESCE(shipped_at, ?)", occurredAt.UTC())
+	case entity.SHIPMENT_STATUS_DELIVERED:
+		updates["shipped_at"] = gorm.Expr("COALESCE(shipped_at, ?)", occurredAt.UTC())
+		updates["delivered_at"] = occurredAt.UTC()
+	}
+	return db.DB(ctx).
+		Model(&entity.OrderShipment{}).
+		Where("id = ?", shipmentID).
+		Updates(updates).Error
+}
+
+func (r *ShipmentRepositoryImpl) TouchLastTrackedAt(
+	ctx context.Context,
+	shipmentID uint,
+	trackedAt time.Time,
+) error {
+	return db.DB(ctx).
+		Model(&entity.OrderShipment{}).
+		Where("id = ?", shipmentID).
+		UpdateColumn("last_tracked_at", trackedAt.UTC()).Error
+}
+
+func (r *ShipmentRepositoryImpl) CreateTrackingEvents(
+	ctx context.Context,
+	events []entity.ShipmentTrackingEvent,
+) (int64, error) {
+	if len(events) == 0 {
+		return 0, nil
+	}
+	result := db.DB(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&events)
+	return result.RowsAffected, result.Error
+}
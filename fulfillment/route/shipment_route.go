@@ -0,0 +1,74 @@
+package route
+
+import (
+	"net/http"
+
+	"ecommerce-be/common/constants"
+	"ecommerce-be/common/middleware"
+	"ecommerce-be/common/openapi"
+	"ecommerce-be/fulfillment/factory/singleton"
+	"ecommerce-be/fulfillment/handler"
+	"ecommerce-be/fulfillment/model"
+	fulfillmentConstants "ecommerce-be/fulfillment/utils/constant"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ShipmentModule implements the Module interface for shipment tracking routes.
+type ShipmentModule struct {
+	shipmentHandler       *handler.ShipmentHandler
+	carrierWebhookHandler *handler.CarrierWebhookHandler
+}
+
+// NewShipmentModule creates a new instance of ShipmentModule.
+func NewShipmentModule() *ShipmentModule {
+	f := singleton.GetInstance()
+	return &ShipmentModule{
+		shipmentHandler:       f.GetShipmentHandler(),
+		carrierWebhookHandler: f.GetCarrierWebhookHandler(),
+	}
+}
+
+// RegisterRoutes registers all shipment-related routes.
+func (m *ShipmentModule) RegisterRoutes(router *gin.Engine) {
+	sellerAuth := middleware.SellerAuth()
+	customerAuth := middleware.CustomerAuth()
+
+	shipmentRoutes := openapi.NewGroup(
+		router.Group(constants.APIBaseFulfillment+"/shipments"),
+		"Shipments",
+	)
+	{
+		shipmentRoutes.POST("", sellerAuth, m.shipmentHandler.CreateShipment).
+			Summary("Create a shipment for an order").
+			Description("An order can ship in several shipments. Each one fulfills the given "+
+				"quantities of its items, or everything still unfulfilled when items are omitted.").
+			Body(model.CreateShipmentRequest{}).
+			Returns(http.StatusCreated, model.ShipmentResponse{})
+		shipmentRoutes.PATCH("/:id/status", sellerAuth, m.shipmentHandler.UpdateShipmentStatus).
+			Summary("Update shipment status").
+			Body(model.UpdateShipmentStatusRequest{}).
+			Returns(http.StatusOK, model.ShipmentResponse{})
+	}
+
+	// Carrier webhooks are authenticated by HMAC signature instead of a user token.
+	webhookRoutes := openapi.NewGroup(
+		router.Group(constants.APIBaseFulfillment+"/webhooks"),
+		"Shipments",
+	)
+	{
+		webhookRoutes.POST("/carriers/:carrier", m.carrierWebhookHandler.ReceiveTrackingUpdate).
+			Summary("Receive a carrier tracking webhook").
+			Header(fulfillmentConstants.CARRIER_SIGNATURE_HEADER, "Hex HMAC-SHA256 of the body").
+			Body(model.CarrierTrackingWebhookRequest{}).
+			Returns(http.StatusAccepted, nil)
+	}
+
+	// Order tracking lives under the order API; access rules follow order visibility.
+	orderRoutes := openapi.NewGroup(router.Group(constants.APIBaseOrder), "Shipments")
+	{
+		orderRoutes.GET("/:id/tracking", customerAuth, m.shipmentHandler.GetOrderTracking).
+			Summary("Get shipment tracking for an order").
+			Returns(http.StatusOK, model.OrderTrackingResponse{})
+	}
+}
@@ -0,0 +1,72 @@
+// Package carrier defines the adapter contract used to pull tracking checkpoints
+// from shipping carriers that do not push webhooks.
+package carrier
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"ecommerce-be/fulfillment/entity"
+)
+
+// TrackingCheckpoint is a carrier checkpoint normalized to our shipment statuses.
+type TrackingCheckpoint struct {
+	ExternalEventID *string
+	Status          entity.ShipmentStatus
+	CarrierStatus   string
+	Description     string
+	Location        string
+	OccurredAt      time.Time
+	Raw             map[string]any
+}
+
+// Tracker pulls the latest checkpoints for a tracking number from one carrier.
+type Tracker interface {
+	// Code is the carrier identifier stored on order_shipment.carrier (e.g. "delhivery").
+	Code() string
+	Track(ctx context.Context, trackingNo string) ([]TrackingCheckpoint, error)
+}
+
+// Registry holds the polling adapters available to the tracking service.
+type Registry struct {
+	mu       sync.RWMutex
+	trackers map[string]Tracker
+}
+
+// NewRegistry creates an empty tracker registry.
+func NewRegistry() *Registry {
+	return &Registry{trackers: map[string]Tracker{}}
+}
+
+// Register adds or replaces the tracker for its carrier code.
+func (r *Registry) Register(t Tracker) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.trackers[NormalizeCode(t.Code())] = t
+}
+
+// Get returns the tracker registered for carrier code.
+func (r *Registry) Get(code string) (Tracker, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	t, ok := r.trackers[NormalizeCode(code)]
+	return t, ok
+}
+
+// Codes lists the carrier codes that support polling.
+func (r *Registry) Codes() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	codes := make([]string, 0, len(r.trackers))
+	for code := range r.trackers {
+		codes = append(codes, code)
+	}
+	return codes
+}
+
+// NormalizeCode lowercases and trims a carrier code so lookups are case-insensitive.
+func NormalizeCode(code string) string {
+	return strings.ToLower(strings.TrimSpace(code))
+}
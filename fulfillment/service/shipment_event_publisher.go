@@ -0,0 +1,56 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"ecommerce-be/common/auth"
+	"ecommerce-be/common/constants"
+	"ecommerce-be/common/log"
+	"ecommerce-be/common/messaging"
+	fulfillmentMessaging "ecommerce-be/fulfillment/messaging"
+)
+
+// ShipmentEventPublisher publishes shipment status changes to the events exchange.
+type ShipmentEventPublisher interface {
+	PublishStatusChanged(ctx context.Context, msg fulfillmentMessaging.ShipmentStatusChanged) error
+}
+
+type shipmentEventPublisher struct {
+	publisher messaging.Publisher
+}
+
+// NewShipmentEventPublisher creates a ShipmentEventPublisher backed by the given messaging.Publisher.
+func NewShipmentEventPublisher(p messaging.Publisher) ShipmentEventPublisher {
+	return &shipmentEventPublisher{publisher: p}
+}
+
+// PublishStatusChanged wraps msg in an envelope, propagates the correlation ID and
+// tenant (seller) ID, and publishes it with routing key fulfillment.shipment.status.changed.
+func (p *shipmentEventPublisher) PublishStatusChanged(
+	ctx context.Context,
+	msg fulfillmentMessaging.ShipmentStatusChanged,
+) error {
+	env, err := messaging.NewEnvelope(constants.ROUTING_KEY_SHIPMENT_STATUS_CHANGED, msg)
+	if err != nil {
+		return fmt.Errorf("shipment event publisher: marshal payload: %w", err)
+	}
+	if correlationID, ok := auth.GetCorrelationIDFromContext(ctx); ok {
+		env.CorrelationID = correlationID
+	}
+	env.TenantID = fmt.Sprintf("%d", msg.SellerID)
+
+	if err := p.publisher.Publish(
+		ctx,
+		constants.DEFAULT_EVENTS_EXCHANGE,
+		constants.ROUTING_KEY_SHIPMENT_STATUS_CHANGED,
+		env,
+	); err != nil {
+		log.WarnWithContext(ctx, "shipment event publisher: publish failed")
+		return fmt.Errorf(
+			"shipment event publisher: publish to %s exchange failed",
+			constants.DEFAULT_EVENTS_EXCHANGE,
+		)
+	}
+	return nil
+}
@@ -0,0 +1,239 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"ecommerce-be/common/constants"
+	"ecommerce-be/common/db"
+	"ecommerce-be/common/log"
+	"ecommerce-be/fulfillment/entity"
+	fulfillmentError "ecommerce-be/fulfillment/error"
+	"ecommerce-be/fulfillment/factory"
+	"ecommerce-be/fulfillment/mapper"
+	"ecommerce-be/fulfillment/model"
+	"ecommerce-be/fulfillment/repository"
+	"ecommerce-be/fulfillment/service/carrier"
+	fulfillmentUtils "ecommerce-be/fulfillment/utils"
+	orderEntity "ecommerce-be/order/entity"
+	orderModel "ecommerce-be/order/model"
+	orderService "ecommerce-be/order/service"
+)
+
+// ShipmentService defines seller shipment management and order tracking workflows.
+type ShipmentService interface {
+	CreateShipment(
+		ctx context.Context,
+		sellerID uint,
+		req model.CreateShipmentRequest,
+	) (*model.ShipmentResponse, error)
+	UpdateShipmentStatus(
+		ctx context.Context,
+		sellerID uint,
+		shipmentID uint,
+		req model.UpdateShipmentStatusRequest,
+	) (*model.ShipmentResponse, error)
+	GetOrderTracking(
+		ctx context.Context,
+		userID uint,
+		role string,
+		orderID uint,
+	) (*model.OrderTrackingResponse, error)
+}
+
+type ShipmentServiceImpl struct {
+	shipmentRepo repository.ShipmentRepository
+	trackingSvc  ShipmentTrackingService
+	orderSvc     orderService.OrderService
+	// marketplaceSvc confirms shipments of orders ingested from external marketplaces
+	marketplaceSvc orderService.MarketplaceOrderService
+}
+
+func NewShipmentService(
+	shipmentRepo repository.ShipmentRepository,
+	trackingSvc ShipmentTrackingService,
+	orderSvc orderService.OrderService,
+	marketplaceSvc orderService.MarketplaceOrderService,
+) ShipmentService {
+	return &ShipmentServiceImpl{
+		shipmentRepo:   shipmentRepo,
+		trackingSvc:    trackingSvc,
+		orderSvc:       orderSvc,
+		marketplaceSvc: marketplaceSvc,
+	}
+}
+
+// CreateShipment registers a carrier shipment for a confirmed order owned by the seller.
+// An order can ship in several shipments: each one fulfills the given quantities of its
+// line items, or everything still unfulfilled when no items are given.
+func (s *ShipmentServiceImpl) CreateShipment(
+	ctx context.Context,
+	sellerID uint,
+	req model.CreateShipmentRequest,
+) (*model.ShipmentResponse, error) {
+	order, err := s.orderSvc.GetOrderByID(ctx, sellerID, constants.SELLER_ROLE_NAME, req.OrderID)
+	if err != nil {
+		return nil, err
+	}
+	if order.Status != orderEntity.ORDER_STATUS_CONFIRMED {
+		return nil, fulfillmentError.ErrOrderNotShippable
+	}
+	if err := validateShipmentItems(order, req.Items); err != nil {
+		return nil, err
+	}
+
+	existing, err := s.shipmentRepo.FindShipmentByTracking(
+		ctx,
+		carrier.NormalizeCode(req.Carrier),
+		strings.TrimSpace(req.TrackingNo),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, fulfillmentError.ErrTrackingNumberExists
+	}
+
+	var fulfillment *orderModel.FulfillmentResult
+	shipment, err := db.WithTransactionResult(
+		ctx,
+		func(txCtx context.Context) (*entity.OrderShipment, error) {
+			var err error
+			fulfillment, err = s.orderSvc.RecordFulfillment(
+				txCtx, sellerID, req.OrderID, mapper.BuildFulfillmentLines(req.Items))
+			if err != nil {
+				return nil, err
+			}
+			shipment := mapper.BuildShipmentEntity(sellerID, req, fulfillment.Lines)
+			if err := s.shipmentRepo.CreateShipment(txCtx, shipment); err != nil {
+				return nil, err
+			}
+			return shipment, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	s.syncMarketplaceShipment(ctx, shipment)
+
+	resp := factory.BuildShipmentResponse(shipment)
+	resp.OrderFulfillmentStatus = fulfillment.FulfillmentStatus
+	return &resp, nil
+}
+
+// syncMarketplaceShipment pushes carrier and tracking details back to the marketplace the order
+// came from. The shipment is already saved, so failures are logged instead of returned.
+func (s *ShipmentServiceImpl) syncMarketplaceShipment(
+	ctx context.Context,
+	shipment *entity.OrderShipment,
+) {
+	if s.marketplaceSvc == nil {
+		return
+	}
+	req := orderModel.MarketplaceShipmentRequest{
+		OrderID:    shipment.OrderID,
+		ShipmentID: shipment.ID,
+		Carrier:    shipment.Carrier,
+		TrackingNo: shipment.TrackingNo,
+	}
+	if shipment.ShippedAt != nil {
+		req.ShippedAt = *shipment.ShippedAt
+	}
+	for _, item := range shipment.Items {
+		req.Items = append(req.Items, orderModel.MarketplaceShipmentItem{
+			OrderItemID: item.OrderItemID,
+			Quantity:    item.Quantity,
+		})
+	}
+	if err := s.marketplaceSvc.SyncShipment(ctx, req); err != nil {
+		log.ErrorWithContext(ctx, "createShipment: marketplace shipment sync failed", err)
+	}
+}
+
+// UpdateShipmentStatus records a manual checkpoint for sellers that deliver themselves
+// or whose carrier has no webhook/polling integration.
+func (s *ShipmentServiceImpl) UpdateShipmentStatus(
+	ctx context.Context,
+	sellerID uint,
+	shipmentID uint,
+	req model.UpdateShipmentStatusRequest,
+) (*model.ShipmentResponse, error) {
+	shipment, err := s.shipmentRepo.FindShipmentByID(ctx, shipmentID)
+	if err != nil {
+		return nil, err
+	}
+	if shipment == nil || shipment.SellerID != sellerID {
+		return nil, fulfillmentError.ErrShipmentNotFound
+	}
+
+	target := entity.ShipmentStatus(strings.ToLower(strings.TrimSpace(req.Status.String())))
+	if !target.IsValid() {
+		return nil, fulfillmentError.ErrInvalidShipmentStatus
+	}
+	if !fulfillmentUtils.IsValidTransition(shipment.Status, target) {
+		return nil, fulfillmentError.ErrInvalidShipmentTransition(
+			shipment.Status.String(), target.String())
+	}
+
+	checkpoint := carrier.TrackingCheckpoint{
+		Status:      target,
+		Description: req.Description,
+		Location:    req.Location,
+		OccurredAt:  time.Now().UTC(),
+	}
+	if err := s.trackingSvc.ApplyCheckpoints(
+		ctx,
+		shipment,
+		[]carrier.TrackingCheckpoint{checkpoint},
+		entity.TRACKING_SOURCE_SELLER,
+	); err != nil {
+		return nil, err
+	}
+
+	updated, err := s.shipmentRepo.FindShipmentByID(ctx, shipmentID)
+	if err != nil {
+		return nil, err
+	}
+	resp := factory.BuildShipmentResponse(updated)
+	return &resp, nil
+}
+
+// GetOrderTracking returns all shipments with their tracking timelines for an order,
+// reusing order access rules (customer owns order, seller owns order, admin sees all).
+func (s *ShipmentServiceImpl) GetOrderTracking(
+	ctx context.Context,
+	userID uint,
+	role string,
+	orderID uint,
+) (*model.OrderTrackingResponse, error) {
+	order, err := s.orderSvc.GetOrderByID(ctx, userID, role, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	shipments, err := s.shipmentRepo.FindShipmentsByOrderID(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+	return factory.BuildOrderTrackingResponse(order, shipments), nil
+}
+
+// validateShipmentItems ensures every shipment line references an item of this order
+// and does not exceed the ordered quantity.
+func validateShipmentItems(
+	order *orderModel.OrderResponse,
+	items []model.CreateShipmentItemRequest,
+) error {
+	ordered := make(map[uint]int, len(order.Items))
+	for _, item := range order.Items {
+		ordered[item.ID] = item.Quantity
+	}
+	for _, item := range items {
+		qty, ok := ordered[item.OrderItemID]
+		if !ok || item.Quantity > qty {
+			return fulfillmentError.ErrInvalidShipmentOrderItem(item.OrderItemID)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,221 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"ecommerce-be/common/config"
+	"ecommerce-be/common/db"
+	"ecommerce-be/common/log"
+	"ecommerce-be/fulfillment/entity"
+	fulfillmentError "ecommerce-be/fulfillment/error"
+	"ecommerce-be/fulfillment/mapper"
+	fulfillmentMessaging "ecommerce-be/fulfillment/messaging"
+	"ecommerce-be/fulfillment/model"
+	"ecommerce-be/fulfillment/repository"
+	"ecommerce-be/fulfillment/service/carrier"
+	fulfillmentUtils "ecommerce-be/fulfillment/utils"
+	"ecommerce-be/fulfillment/utils/constant"
+)
+
+// ShipmentTrackingService ingests carrier tracking checkpoints from webhooks and polling.
+type ShipmentTrackingService interface {
+	IngestCarrierWebhook(
+		ctx context.Context,
+		carrierCode string,
+		req model.CarrierTrackingWebhookRequest,
+	) error
+	ApplyCheckpoints(
+		ctx context.Context,
+		shipment *entity.OrderShipment,
+		checkpoints []carrier.TrackingCheckpoint,
+		source entity.TrackingEventSource,
+	) error
+	PollActiveShipments()
+}
+
+type ShipmentTrackingServiceImpl struct {
+	shipmentRepo   repository.ShipmentRepository
+	trackers       *carrier.Registry
+	eventPublisher ShipmentEventPublisher
+}
+
+func NewShipmentTrackingService(
+	shipmentRepo repository.ShipmentRepository,
+	trackers *carrier.Registry,
+	eventPublisher ShipmentEventPublisher,
+) ShipmentTrackingService {
+	return &ShipmentTrackingServiceImpl{
+		shipmentRepo:   shipmentRepo,
+		trackers:       trackers,
+		eventPublisher: eventPublisher,
+	}
+}
+
+// IngestCarrierWebhook resolves the shipment by carrier + tracking number and applies
+// the pushed checkpoints. Duplicate deliveries are idempotent via external event IDs.
+func (s *ShipmentTrackingServiceImpl) IngestCarrierWebhook(
+	ctx context.Context,
+	carrierCode string,
+	req model.CarrierTrackingWebhookRequest,
+) error {
+	checkpoints := mapper.BuildCheckpointsFromWebhook(req)
+	for _, cp := range checkpoints {
+		if !cp.Status.IsValid() {
+			return fulfillmentError.ErrInvalidShipmentStatus
+		}
+	}
+
+	shipment, err := s.shipmentRepo.FindShipmentByTracking(
+		ctx,
+		carrier.NormalizeCode(carrierCode),
+		req.TrackingNo,
+	)
+	if err != nil {
+		return err
+	}
+	if shipment == nil {
+		return fulfillmentError.ErrShipmentNotFound
+	}
+
+	return s.ApplyCheckpoints(ctx, shipment, checkpoints, entity.TRACKING_SOURCE_WEBHOOK)
+}
+
+// ApplyCheckpoints appends checkpoints to the tracking timeline and advances the
+// shipment status along valid transitions. Carriers can deliver checkpoints out of
+// order, so checkpoints that would move the status backwards are kept in the
+// timeline but do not change the shipment status.
+func (s *ShipmentTrackingServiceImpl) ApplyCheckpoints(
+	ctx context.Context,
+	shipment *entity.OrderShipment,
+	checkpoints []carrier.TrackingCheckpoint,
+	source entity.TrackingEventSource,
+) error {
+	if len(checkpoints) == 0 {
+		return s.shipmentRepo.TouchLastTrackedAt(ctx, shipment.ID, time.Now().UTC())
+	}
+	sort.SliceStable(checkpoints, func(i, j int) bool {
+		return checkpoints[i].OccurredAt.Before(checkpoints[j].OccurredAt)
+	})
+
+	prev := shipment.Status
+	next, latest := resolveNextStatus(prev, checkpoints)
+
+	err := db.WithTransaction(ctx, func(txCtx context.Context) error {
+		events := mapper.BuildTrackingEvents(shipment.ID, checkpoints, source)
+		if _, err := s.shipmentRepo.CreateTrackingEvents(txCtx, events); err != nil {
+			return err
+		}
+		if next == prev {
+			return s.shipmentRepo.TouchLastTrackedAt(txCtx, shipment.ID, time.Now().UTC())
+		}
+		return s.shipmentRepo.UpdateShipmentStatus(txCtx, shipment.ID, next, latest.OccurredAt)
+	})
+	if err != nil {
+		return err
+	}
+
+	if next != prev {
+		shipment.Status = next
+		s.publishStatusChanged(ctx, shipment, prev, latest)
+	}
+	return nil
+}
+
+// PollActiveShipments pulls checkpoints for non-terminal shipments whose carrier has
+// a registered polling adapter. Runs as a recurring cron job.
+func (s *ShipmentTrackingServiceImpl) PollActiveShipments() {
+	ctx := context.Background()
+	codes := s.trackers.Codes()
+	if len(codes) == 0 {
+		return
+	}
+
+	trackedBefore := time.Now().UTC().Add(-TrackingPollInterval())
+	shipments, err := s.shipmentRepo.FindActiveShipmentsByCarriers(
+		ctx,
+		codes,
+		trackedBefore,
+		constant.TRACKING_POLL_BATCH_SIZE,
+	)
+	if err != nil {
+		log.ErrorWithContext(ctx, "Cron: Failed to load shipments for tracking poll", err)
+		return
+	}
+
+	for i := range shipments {
+		shipment := &shipments[i]
+		tracker, ok := s.trackers.Get(shipment.Carrier)
+		if !ok {
+			continue
+		}
+		checkpoints, err := tracker.Track(ctx, shipment.TrackingNo)
+		if err != nil {
+			log.ErrorWithContext(ctx, fmt.Sprintf(
+				"Cron: Failed to poll carrier %s for shipment %d", shipment.Carrier, shipment.ID,
+			), err)
+			continue
+		}
+		if err := s.ApplyCheckpoints(ctx, shipment, checkpoints,
+			entity.TRACKING_SOURCE_POLLING); err != nil {
+			log.ErrorWithContext(ctx, fmt.Sprintf(
+				"Cron: Failed to apply tracking for shipment %d", shipment.ID,
+			), err)
+		}
+	}
+}
+
+func (s *ShipmentTrackingServiceImpl) publishStatusChanged(
+	ctx context.Context,
+	shipment *entity.OrderShipment,
+	prev entity.ShipmentStatus,
+	latest carrier.TrackingCheckpoint,
+) {
+	if s.eventPublisher == nil {
+		return
+	}
+	if err := s.eventPublisher.PublishStatusChanged(ctx, fulfillmentMessaging.ShipmentStatusChanged{
+		ShipmentID:     shipment.ID,
+		OrderID:        shipment.OrderID,
+		SellerID:       shipment.SellerID,
+		Carrier:        shipment.Carrier,
+		TrackingNo:     shipment.TrackingNo,
+		PreviousStatus: prev.String(),
+		Status:         shipment.Status.String(),
+		Description:    latest.Description,
+		Location:       latest.Location,
+		OccurredAt:     latest.OccurredAt,
+	}); err != nil {
+		// Tracking state is already committed; notification delivery is best-effort.
+		log.ErrorWithContext(ctx, "shipment tracking: failed to publish status change", err)
+	}
+}
+
+// resolveNextStatus returns the furthest reachable status and the checkpoint that
+// produced it; the last checkpoint is returned when the status does not change.
+func resolveNextStatus(
+	current entity.ShipmentStatus,
+	checkpoints []carrier.TrackingCheckpoint,
+) (entity.ShipmentStatus, carrier.TrackingCheckpoint) {
+	statuses := make([]entity.ShipmentStatus, 0, len(checkpoints))
+	for _, cp := range checkpoints {
+		statuses = append(statuses, cp.Status)
+	}
+	next, idx := fulfillmentUtils.ResolveNextStatus(current, statuses)
+	if idx < 0 {
+		return current, checkpoints[len(checkpoints)-1]
+	}
+	return next, checkpoints[idx]
+}
+
+// TrackingPollInterval returns how often active shipments are polled and how stale a
+// shipment must be before it is polled again.
+func TrackingPollInterval() time.Duration {
+	cfg := config.Get()
+	if cfg == nil || cfg.Fulfillment.TrackingPollIntervalMinutes <= 0 {
+		return 30 * time.Minute
+	}
+	return time.Duration(cfg.Fulfillment.TrackingPollIntervalMinutes) * time.Minute
+}
@@ -0,0 +1,28 @@
+package constant
+
+const (
+	SHIPMENT_CREATED_MSG         = "Shipment created successfully"
+	SHIPMENT_STATUS_UPDATED_MSG  = "Shipment status updated successfully"
+	ORDER_TRACKING_FETCHED_MSG   = "Order tracking fetched successfully"
+	CARRIER_WEBHOOK_ACCEPTED_MSG = "Carrier tracking update accepted"
+)
+
+const (
+	FAILED_TO_CREATE_SHIPMENT_MSG         = "Failed to create shipment"
+	FAILED_TO_UPDATE_SHIPMENT_STATUS_MSG  = "Failed to update shipment status"
+	FAILED_TO_FETCH_ORDER_TRACKING_MSG    = "Failed to fetch order tracking"
+	FAILED_TO_PROCESS_CARRIER_WEBHOOK_MSG = "Failed to process carrier tracking update"
+	INVALID_SHIPMENT_ID_MSG               = "Invalid shipment ID"
+	INVALID_ORDER_ID_MSG                  = "Invalid order ID"
+)
+
+const (
+	// CARRIER_SIGNATURE_HEADER carries hex(HMAC-SHA256(CARRIER_WEBHOOK_SECRET, body)).
+	CARRIER_SIGNATURE_HEADER = "X-Carrier-Signature"
+
+	// TRACKING_POLL_JOB_NAME identifies the recurring carrier polling job in cron logs.
+	TRACKING_POLL_JOB_NAME = "shipment_tracking_poll"
+
+	// TRACKING_POLL_BATCH_SIZE caps the number of shipments polled per run.
+	TRACKING_POLL_BATCH_SIZE = 100
+)
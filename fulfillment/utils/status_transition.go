@@ -0,0 +1,69 @@
+package utils
+
+import "ecommerce-be/fulfillment/entity"
+
+// ValidTransitions defines allowed shipment status transitions.
+// Carriers may skip intermediate checkpoints, so forward jumps are permitted.
+var ValidTransitions = map[entity.ShipmentStatus][]entity.ShipmentStatus{
+	entity.SHIPMENT_STATUS_PENDING: {
+		entity.SHIPMENT_STATUS_PICKED,
+		entity.SHIPMENT_STATUS_IN_TRANSIT,
+		entity.SHIPMENT_STATUS_OUT_FOR_DELIVERY,
+		entity.SHIPMENT_STATUS_DELIVERED,
+		entity.SHIPMENT_STATUS_FAILED,
+	},
+	entity.SHIPMENT_STATUS_PICKED: {
+		entity.SHIPMENT_STATUS_IN_TRANSIT,
+		entity.SHIPMENT_STATUS_OUT_FOR_DELIVERY,
+		entity.SHIPMENT_STATUS_DELIVERED,
+		entity.SHIPMENT_STATUS_FAILED,
+	},
+	entity.SHIPMENT_STATUS_IN_TRANSIT: {
+		entity.SHIPMENT_STATUS_OUT_FOR_DELIVERY,
+		entity.SHIPMENT_STATUS_DELIVERED,
+		entity.SHIPMENT_STATUS_FAILED,
+		entity.SHIPMENT_STATUS_RETURNED,
+	},
+	entity.SHIPMENT_STATUS_OUT_FOR_DELIVERY: {
+		entity.SHIPMENT_STATUS_IN_TRANSIT,
+		entity.SHIPMENT_STATUS_DELIVERED,
+		entity.SHIPMENT_STATUS_FAILED,
+	},
+	entity.SHIPMENT_STATUS_FAILED: {
+		entity.SHIPMENT_STATUS_IN_TRANSIT,
+		entity.SHIPMENT_STATUS_OUT_FOR_DELIVERY,
+		entity.SHIPMENT_STATUS_DELIVERED,
+		entity.SHIPMENT_STATUS_RETURNED,
+	},
+}
+
+// IsValidTransition checks if a shipment status transition is allowed.
+func IsValidTransition(from, to entity.ShipmentStatus) bool {
+	next, ok := ValidTransitions[from]
+	if !ok {
+		return false
+	}
+	for _, candidate := range next {
+		if candidate == to {
+			return true
+		}
+	}
+	return false
+}
+
+// ResolveNextStatus walks chronologically ordered checkpoint statuses and returns the
+// furthest status reachable from current, plus the index of the checkpoint that
+// produced it (-1 when no checkpoint moves the shipment).
+func ResolveNextStatus(
+	current entity.ShipmentStatus,
+	statuses []entity.ShipmentStatus,
+) (entity.ShipmentStatus, int) {
+	idx := -1
+	for i, status := range statuses {
+		if IsValidTransition(current, status) {
+			current = status
+			idx = i
+		}
+	}
+	return current, idx
+}
@@ -5,9 +5,12 @@ import (
 	"ecommerce-be/common/scheduler"
 	"ecommerce-be/inventory/factory/singleton"
 	routes "ecommerce-be/inventory/route"
+	"ecommerce-be/inventory/rpc"
 	"ecommerce-be/inventory/utils/constant"
+	inventoryv1 "ecommerce-be/proto/inventory/v1"
 
 	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc"
 )
 
 // NewContainer initializes dependencies dynamically
@@ -29,11 +32,21 @@ func NewContainer(router *gin.Engine) *common.Container {
 	return c
 }
 
+// RegisterGRPC registers the stock check API on the internal gRPC server
+func RegisterGRPC(server *grpc.Server) {
+	inventoryQueryService := singleton.GetInstance().GetInventoryQueryService()
+	inventoryv1.RegisterStockServiceServer(server, rpc.NewStockServer(inventoryQueryService))
+}
+
 // addModules registers all inventory-related modules
 func addModules(c *common.Container) {
 	c.RegisterModule(routes.NewLocationModule())
 	c.RegisterModule(routes.NewInventoryModule())
 	c.RegisterModule(routes.NewInventoryReservationModule())
+	c.RegisterModule(routes.NewChannelAllocationModule())
+	c.RegisterModule(routes.NewInventoryValuationModule())
+	c.RegisterModule(routes.NewStockTakeModule())
+	c.RegisterModule(routes.NewPurchaseOrderModule())
 	// TODO: Add other inventory modules here (stock transfer, etc.)
 }
 
@@ -0,0 +1,20 @@
+package entity
+
+import "ecommerce-be/common/db"
+
+// ChannelAllocationRule controls how much of a seller's pooled stock one sales channel may sell.
+// Rules without a VariantID are the seller-wide default; a variant rule replaces the default
+// for the same channel on that variant.
+type ChannelAllocationRule struct {
+	db.BaseEntity
+	SellerID  uint   `json:"sellerId"  gorm:"column:seller_id;not null;index"`
+	VariantID *uint  `json:"variantId" gorm:"column:variant_id;index"`
+	Channel   string `json:"channel"   gorm:"column:channel;size:30;not null"`
+
+	// Share (0-100) of pooled available stock held exclusively for this channel;
+	// other channels cannot sell into it
+	ReservePercent int `json:"reservePercent" gorm:"column:reserve_percent;not null;default:0"`
+
+	// Units withheld from this channel's availability (e.g. to absorb marketplace sync lag)
+	BufferQuantity int `json:"bufferQuantity" gorm:"column:buffer_quantity;not null;default:0"`
+}
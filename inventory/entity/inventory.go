@@ -13,9 +13,17 @@ type Inventory struct {
 	Quantity         int `json:"quantity"         gorm:"column:quantity;default:0"`
 	ReservedQuantity int `json:"reservedQuantity" gorm:"column:reserved_quantity;default:0;check:reserved_quantity >= 0"`
 
+	// Returned units held out of sellable stock by their disposition. They are not part
+	// of Quantity, so they never count towards available quantity.
+	DamagedQuantity     int `json:"damagedQuantity"     gorm:"column:damaged_quantity;default:0;check:damaged_quantity >= 0"`
+	QuarantinedQuantity int `json:"quarantinedQuantity" gorm:"column:quarantined_quantity;default:0;check:quarantined_quantity >= 0"`
+
 	// Minimum allowed quantity - can be negative for backorder limit (e.g., -10 means allow up to 10 backorders)
 	Threshold int `json:"threshold" gorm:"column:threshold;default:0"`
 
 	// Specific bin/shelf location within the warehouse (Optional)
 	BinLocation string `json:"binLocation" gorm:"column:bin_location"`
+
+	// Version is bumped by every update; writers compare-and-swap on it
+	Version int64 `json:"version" gorm:"column:version;not null;default:1"`
 }
@@ -200,6 +200,17 @@ type InventoryTransaction struct {
 	BeforeReservedQuantity int `json:"beforeReservedQuantity" gorm:"column:before_reserved_quantity;not null;default:0"`
 	AfterReservedQuantity  int `json:"afterReservedQuantity"  gorm:"column:after_reserved_quantity;not null;default:0"`
 
+	// ========== RETURN DISPOSITION ==========
+	// Set on RETURN transactions. Restocked units show in QuantityChange; damaged and
+	// quarantined units show in HeldQuantityChange and leave Quantity unchanged.
+	Disposition        *ReturnDisposition `json:"disposition,omitempty" gorm:"column:disposition;type:varchar(20)"`
+	HeldQuantityChange int                `json:"heldQuantityChange"    gorm:"column:held_quantity_change;not null;default:0"`
+
+	// ========== COSTING ==========
+	// Cost paid per unit, set on PURCHASE transactions. A costed purchase is a restock
+	// lot for inventory valuation.
+	UnitCostCents *int64 `json:"unitCostCents,omitempty" gorm:"column:unit_cost_cents"`
+
 	// Audit: Who performed this transaction
 	PerformedBy uint `json:"performedBy" gorm:"column:performed_by;not null;index"`
 
@@ -210,6 +221,9 @@ type InventoryTransaction struct {
 	// Reason for the transaction (required for manual adjustments)
 	Reason string `json:"reason" gorm:"column:reason;type:text"`
 
+	// ReasonCode classifies the cause of the movement for reconciliation
+	ReasonCode MovementReason `json:"reasonCode" gorm:"column:reason_code;type:varchar(30);not null;index"`
+
 	// Additional notes
 	Note *string `json:"note,omitempty" gorm:"column:note;type:text"`
 }
@@ -0,0 +1,113 @@
+package entity
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// MovementReason is the reason code recorded on every inventory transaction, so
+// sellers can reconcile stock changes by cause rather than free-text reasons.
+type MovementReason string
+
+const (
+	REASON_SALE                MovementReason = "SALE"                // Order shipped
+	REASON_CUSTOMER_RETURN     MovementReason = "CUSTOMER_RETURN"     // Returned by a customer
+	REASON_RESTOCK             MovementReason = "RESTOCK"             // Supplier delivery
+	REASON_TRANSFER            MovementReason = "TRANSFER"            // Moved between locations
+	REASON_RESERVATION         MovementReason = "RESERVATION"         // Held for an order
+	REASON_RESERVATION_RELEASE MovementReason = "RESERVATION_RELEASE" // Order hold released
+	REASON_MANUAL_CORRECTION   MovementReason = "MANUAL_CORRECTION"   // Data entry fix
+	REASON_CYCLE_COUNT         MovementReason = "CYCLE_COUNT"         // Physical count
+	REASON_DAMAGE              MovementReason = "DAMAGE"              // Damaged in storage
+	REASON_EXPIRED             MovementReason = "EXPIRED"             // Past shelf life
+	REASON_THEFT_LOSS          MovementReason = "THEFT_LOSS"          // Lost or stolen
+	REASON_FOUND               MovementReason = "FOUND"               // Recovered units
+	REASON_STOCK_TAKE          MovementReason = "STOCK_TAKE"          // Stock-take correction
+)
+
+// ValidMovementReasons returns all valid reason codes
+func ValidMovementReasons() []MovementReason {
+	return []MovementReason{
+		REASON_SALE,
+		REASON_CUSTOMER_RETURN,
+		REASON_RESTOCK,
+		REASON_TRANSFER,
+		REASON_RESERVATION,
+		REASON_RESERVATION_RELEASE,
+		REASON_MANUAL_CORRECTION,
+		REASON_CYCLE_COUNT,
+		REASON_DAMAGE,
+		REASON_EXPIRED,
+		REASON_THEFT_LOSS,
+		REASON_FOUND,
+		REASON_STOCK_TAKE,
+	}
+}
+
+// String returns the string representation
+func (r MovementReason) String() string {
+	return string(r)
+}
+
+// IsValid checks if the reason code is valid
+func (r MovementReason) IsValid() bool {
+	return slices.Contains(ValidMovementReasons(), r)
+}
+
+// ParseMovementReason converts string to MovementReason with case-insensitive matching
+func ParseMovementReason(s string) (MovementReason, error) {
+	upperStr := strings.ToUpper(strings.TrimSpace(s))
+	for _, valid := range ValidMovementReasons() {
+		if valid.String() == upperStr {
+			return valid, nil
+		}
+	}
+	return "", fmt.Errorf("invalid movement reason: %s", s)
+}
+
+// ReasonCodes returns the reason codes a transaction type may be recorded with; the
+// first one is its default
+func (tt TransactionType) ReasonCodes() []MovementReason {
+	switch tt {
+	case TXN_PURCHASE:
+		return []MovementReason{REASON_RESTOCK}
+	case TXN_RETURN:
+		return []MovementReason{REASON_CUSTOMER_RETURN}
+	case TXN_TRANSFER_IN, TXN_TRANSFER_OUT:
+		return []MovementReason{REASON_TRANSFER}
+	case TXN_OUTBOUND:
+		return []MovementReason{REASON_SALE}
+	case TXN_RESERVED:
+		return []MovementReason{REASON_RESERVATION}
+	case TXN_RELEASED:
+		return []MovementReason{REASON_RESERVATION_RELEASE}
+	case TXN_DAMAGE:
+		return []MovementReason{REASON_DAMAGE, REASON_EXPIRED, REASON_THEFT_LOSS}
+	case TXN_REFRESH:
+		return []MovementReason{REASON_CYCLE_COUNT}
+	case TXN_ADJUSTMENT:
+		return []MovementReason{
+			REASON_MANUAL_CORRECTION,
+			REASON_DAMAGE,
+			REASON_EXPIRED,
+			REASON_THEFT_LOSS,
+			REASON_FOUND,
+			REASON_STOCK_TAKE,
+		}
+	}
+	return nil
+}
+
+// DefaultReasonCode returns the reason code recorded when the caller gives none
+func (tt TransactionType) DefaultReasonCode() MovementReason {
+	if codes := tt.ReasonCodes(); len(codes) > 0 {
+		return codes[0]
+	}
+	return REASON_MANUAL_CORRECTION
+}
+
+// AllowsReasonCode reports whether the transaction type may be recorded with reason
+func (tt TransactionType) AllowsReasonCode(reason MovementReason) bool {
+	return slices.Contains(tt.ReasonCodes(), reason)
+}
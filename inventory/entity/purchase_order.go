@@ -0,0 +1,77 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+
+	"ecommerce-be/common/db"
+)
+
+// PurchaseOrderStatus is the lifecycle state of a purchase order
+type PurchaseOrderStatus string
+
+const (
+	PO_DRAFT              PurchaseOrderStatus = "DRAFT"              // Editable, not yet ordered
+	PO_SENT               PurchaseOrderStatus = "SENT"               // Ordered from the supplier
+	PO_PARTIALLY_RECEIVED PurchaseOrderStatus = "PARTIALLY_RECEIVED" // Some units delivered
+	PO_CLOSED             PurchaseOrderStatus = "CLOSED"             // Fully received or closed short
+)
+
+// ValidPurchaseOrderStatuses returns all valid purchase order statuses
+func ValidPurchaseOrderStatuses() []PurchaseOrderStatus {
+	return []PurchaseOrderStatus{PO_DRAFT, PO_SENT, PO_PARTIALLY_RECEIVED, PO_CLOSED}
+}
+
+// IsValid checks if the status is valid
+func (s PurchaseOrderStatus) IsValid() bool {
+	for _, valid := range ValidPurchaseOrderStatuses() {
+		if s == valid {
+			return true
+		}
+	}
+	return false
+}
+
+// CanReceive reports whether deliveries can be received against the order
+func (s PurchaseOrderStatus) CanReceive() bool {
+	return s == PO_SENT || s == PO_PARTIALLY_RECEIVED
+}
+
+// PurchaseOrder is an order of stock from a supplier, delivered to one location
+type PurchaseOrder struct {
+	db.BaseEntity
+	SellerID   uint                `json:"sellerId"   gorm:"column:seller_id;not null;index"`
+	SupplierID uint                `json:"supplierId" gorm:"column:supplier_id;not null;index"`
+	LocationID uint                `json:"locationId" gorm:"column:location_id;not null"`
+	Status     PurchaseOrderStatus `json:"status"     gorm:"column:status;size:20;not null;default:'DRAFT'"`
+
+	// The supplier's own order or quote number
+	SupplierReference *string    `json:"supplierReference" gorm:"column:supplier_reference;size:100"`
+	ExpectedAt        *time.Time `json:"expectedAt"        gorm:"column:expected_at"`
+	Note              *string    `json:"note"              gorm:"column:note;size:1000"`
+	CreatedBy         uint       `json:"createdBy"         gorm:"column:created_by;not null"`
+	SentAt            *time.Time `json:"sentAt"            gorm:"column:sent_at"`
+	ClosedAt          *time.Time `json:"closedAt"          gorm:"column:closed_at"`
+
+	Lines []PurchaseOrderLine `json:"lines" gorm:"foreignKey:PurchaseOrderID"`
+}
+
+// Number is the order number quoted to suppliers and used as the reference of receipts
+func (po *PurchaseOrder) Number() string {
+	return fmt.Sprintf("PO-%06d", po.ID)
+}
+
+// PurchaseOrderLine is the ordered quantity and agreed unit cost of one variant
+type PurchaseOrderLine struct {
+	db.BaseEntity
+	PurchaseOrderID  uint  `json:"purchaseOrderId"  gorm:"column:purchase_order_id;not null;index"`
+	VariantID        uint  `json:"variantId"        gorm:"column:variant_id;not null"`
+	QuantityOrdered  int   `json:"quantityOrdered"  gorm:"column:quantity_ordered;not null"`
+	QuantityReceived int   `json:"quantityReceived" gorm:"column:quantity_received;not null;default:0"`
+	UnitCostCents    int64 `json:"unitCostCents"    gorm:"column:unit_cost_cents;not null"`
+}
+
+// RemainingQuantity is the number of units still to be delivered
+func (l PurchaseOrderLine) RemainingQuantity() int {
+	return l.QuantityOrdered - l.QuantityReceived
+}
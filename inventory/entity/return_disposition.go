@@ -0,0 +1,57 @@
+package entity
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ReturnDisposition is the quality decision for units received on a RETURN. Only
+// restocked units go back into sellable quantity; damaged and quarantined units are
+// held in their own stock state on the inventory row.
+type ReturnDisposition string
+
+const (
+	DISPOSITION_RESTOCK    ReturnDisposition = "RESTOCK"    // Resellable, back to quantity
+	DISPOSITION_DAMAGED    ReturnDisposition = "DAMAGED"    // Not resellable
+	DISPOSITION_QUARANTINE ReturnDisposition = "QUARANTINE" // Held for inspection
+)
+
+// ValidReturnDispositions returns all valid disposition values
+func ValidReturnDispositions() []ReturnDisposition {
+	return []ReturnDisposition{
+		DISPOSITION_RESTOCK,
+		DISPOSITION_DAMAGED,
+		DISPOSITION_QUARANTINE,
+	}
+}
+
+// String returns the string representation
+func (d ReturnDisposition) String() string {
+	return string(d)
+}
+
+// IsValid checks if the disposition is valid
+func (d ReturnDisposition) IsValid() bool {
+	for _, valid := range ValidReturnDispositions() {
+		if d == valid {
+			return true
+		}
+	}
+	return false
+}
+
+// IsHeld reports whether units with this disposition are kept out of sellable stock
+func (d ReturnDisposition) IsHeld() bool {
+	return d == DISPOSITION_DAMAGED || d == DISPOSITION_QUARANTINE
+}
+
+// ParseReturnDisposition converts string to ReturnDisposition with case-insensitive matching
+func ParseReturnDisposition(s string) (ReturnDisposition, error) {
+	upperStr := strings.ToUpper(strings.TrimSpace(s))
+	for _, valid := range ValidReturnDispositions() {
+		if valid.String() == upperStr {
+			return valid, nil
+		}
+	}
+	return "", fmt.Errorf("invalid return disposition: %s", s)
+}
@@ -0,0 +1,53 @@
+package entity
+
+import (
+	"time"
+
+	"ecommerce-be/common/db"
+)
+
+// StockTakeStatus is the lifecycle state of a stock-take session
+type StockTakeStatus string
+
+const (
+	STOCK_TAKE_OPEN      StockTakeStatus = "OPEN"      // Counts can be submitted
+	STOCK_TAKE_APPLIED   StockTakeStatus = "APPLIED"   // Discrepancies recorded as movements
+	STOCK_TAKE_CANCELLED StockTakeStatus = "CANCELLED" // Closed without changing stock
+)
+
+// StockTake is a physical count of one location. Counted lines are compared with the
+// quantity on hand when each variant was counted; applying the session records the
+// differences as inventory movements.
+type StockTake struct {
+	db.BaseEntity
+	SellerID   uint            `json:"sellerId"   gorm:"column:seller_id;not null;index"`
+	LocationID uint            `json:"locationId" gorm:"column:location_id;not null"`
+	Status     StockTakeStatus `json:"status"     gorm:"column:status;size:20;not null;default:'OPEN'"`
+	Note       *string         `json:"note"       gorm:"column:note;size:500"`
+	StartedBy  uint            `json:"startedBy"  gorm:"column:started_by;not null"`
+	AppliedBy  *uint           `json:"appliedBy"  gorm:"column:applied_by"`
+	AppliedAt  *time.Time      `json:"appliedAt"  gorm:"column:applied_at"`
+
+	Lines []StockTakeLine `json:"lines" gorm:"foreignKey:StockTakeID"`
+}
+
+// StockTakeLine is the counted quantity of one variant in a stock-take session
+type StockTakeLine struct {
+	db.BaseEntity
+	StockTakeID uint `json:"stockTakeId" gorm:"column:stock_take_id;not null;index"`
+	VariantID   uint `json:"variantId"   gorm:"column:variant_id;not null"`
+
+	// On-hand quantity at the location when the variant was counted
+	SystemQuantity  int       `json:"systemQuantity"  gorm:"column:system_quantity;not null"`
+	CountedQuantity int       `json:"countedQuantity" gorm:"column:counted_quantity;not null"`
+	CountedBy       uint      `json:"countedBy"       gorm:"column:counted_by;not null"`
+	CountedAt       time.Time `json:"countedAt"       gorm:"column:counted_at;not null"`
+
+	// Movement that corrected the discrepancy, set when the session is applied
+	TransactionID *uint `json:"transactionId" gorm:"column:transaction_id"`
+}
+
+// Discrepancy is the counted minus the expected quantity; negative means units are missing
+func (l StockTakeLine) Discrepancy() int {
+	return l.CountedQuantity - l.SystemQuantity
+}
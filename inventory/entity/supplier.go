@@ -0,0 +1,17 @@
+package entity
+
+import "ecommerce-be/common/db"
+
+// Supplier is a vendor a seller buys stock from
+type Supplier struct {
+	db.BaseEntity
+	SellerID    uint    `json:"sellerId"    gorm:"column:seller_id;not null;index"`
+	Name        string  `json:"name"        gorm:"column:name;size:200;not null"`
+	ContactName *string `json:"contactName" gorm:"column:contact_name;size:200"`
+	Email       *string `json:"email"       gorm:"column:email;size:255"`
+	Phone       *string `json:"phone"       gorm:"column:phone;size:30"`
+	Notes       *string `json:"notes"       gorm:"column:notes;size:1000"`
+
+	// Inactive suppliers keep their purchase orders but cannot receive new ones
+	IsActive bool `json:"isActive" gorm:"column:is_active;not null;default:true"`
+}
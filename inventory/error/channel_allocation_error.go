@@ -0,0 +1,39 @@
+package error
+
+import (
+	"net/http"
+
+	commonError "ecommerce-be/common/error"
+	"ecommerce-be/inventory/utils/constant"
+)
+
+var (
+	// ErrChannelAllocationRuleNotFound is returned when a rule does not exist for the seller
+	ErrChannelAllocationRuleNotFound = &commonError.AppError{
+		Code:       constant.CHANNEL_ALLOCATION_RULE_NOT_FOUND_CODE,
+		Message:    constant.CHANNEL_ALLOCATION_RULE_NOT_FOUND_MSG,
+		StatusCode: http.StatusNotFound,
+	}
+
+	// ErrInvalidAllocationChannel is returned when a rule names an unsupported sales channel
+	ErrInvalidAllocationChannel = &commonError.AppError{
+		Code:       constant.INVALID_ALLOCATION_CHANNEL_CODE,
+		Message:    constant.INVALID_ALLOCATION_CHANNEL_MSG,
+		StatusCode: http.StatusBadRequest,
+	}
+
+	// ErrChannelReserveExceedsPool is returned when channel reserves would exceed the whole pool
+	ErrChannelReserveExceedsPool = &commonError.AppError{
+		Code:       constant.CHANNEL_RESERVE_EXCEEDS_POOL_CODE,
+		Message:    constant.CHANNEL_RESERVE_EXCEEDS_POOL_MSG,
+		StatusCode: http.StatusBadRequest,
+	}
+)
+
+func init() {
+	commonError.Register(
+		ErrChannelAllocationRuleNotFound,
+		ErrInvalidAllocationChannel,
+		ErrChannelReserveExceedsPool,
+	)
+}
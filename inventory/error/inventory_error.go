@@ -82,6 +82,30 @@ var (
 		StatusCode: http.StatusBadRequest,
 	}
 
+	ErrInvalidDisposition = &commonError.AppError{
+		Code:       constant.INVALID_DISPOSITION_CODE,
+		Message:    constant.INVALID_DISPOSITION_MSG,
+		StatusCode: http.StatusBadRequest,
+	}
+
+	ErrDispositionNotAllowed = &commonError.AppError{
+		Code:       constant.DISPOSITION_NOT_ALLOWED_CODE,
+		Message:    constant.DISPOSITION_NOT_ALLOWED_MSG,
+		StatusCode: http.StatusBadRequest,
+	}
+
+	ErrInvalidReasonCode = &commonError.AppError{
+		Code:       constant.INVALID_REASON_CODE_CODE,
+		Message:    constant.INVALID_REASON_CODE_MSG,
+		StatusCode: http.StatusBadRequest,
+	}
+
+	ErrReasonCodeNotAllowed = &commonError.AppError{
+		Code:       constant.REASON_CODE_NOT_ALLOWED_CODE,
+		Message:    constant.REASON_CODE_NOT_ALLOWED_MSG,
+		StatusCode: http.StatusBadRequest,
+	}
+
 	ErrNotManualTransaction = &commonError.AppError{
 		Code:       constant.NOT_MANUAL_TRANSACTION_CODE,
 		Message:    constant.NOT_MANUAL_TRANSACTION_MSG,
@@ -93,4 +117,56 @@ var (
 		Message:    constant.REFERENCE_REQUIRED_MSG,
 		StatusCode: http.StatusBadRequest,
 	}
+
+	ErrUnitCostNotAllowed = &commonError.AppError{
+		Code:       constant.UNIT_COST_NOT_ALLOWED_CODE,
+		Message:    constant.UNIT_COST_NOT_ALLOWED_MSG,
+		StatusCode: http.StatusBadRequest,
+	}
+
+	ErrInvalidValuationPeriod = &commonError.AppError{
+		Code:       constant.INVALID_VALUATION_PERIOD_CODE,
+		Message:    constant.INVALID_VALUATION_PERIOD_MSG,
+		StatusCode: http.StatusBadRequest,
+	}
+
+	ErrInvalidCostingMethod = &commonError.AppError{
+		Code:       constant.INVALID_COSTING_METHOD_CODE,
+		Message:    constant.INVALID_COSTING_METHOD_MSG,
+		StatusCode: http.StatusBadRequest,
+	}
+
+	// ErrInventoryVersionConflict carries the current versions as db.VersionConflict details
+	ErrInventoryVersionConflict = &commonError.AppError{
+		Code:       constant.INVENTORY_VERSION_CONFLICT_CODE,
+		Message:    constant.INVENTORY_VERSION_CONFLICT_MSG,
+		StatusCode: http.StatusConflict,
+	}
 )
+
+func init() {
+	commonError.Register(
+		ErrInventoryNotFound,
+		ErrProductInventoryNotFound,
+		ErrInsufficientStock,
+		ErrInvalidQuantity,
+		ErrNegativeStock,
+		ErrBelowThreshold,
+		ErrInsufficientReservedStock,
+		ErrVariantNotFound,
+		ErrInvalidTransactionType,
+		ErrInvalidAdjustmentType,
+		ErrDirectionRequired,
+		ErrDirectionNotAllowed,
+		ErrInvalidDisposition,
+		ErrDispositionNotAllowed,
+		ErrInvalidReasonCode,
+		ErrReasonCodeNotAllowed,
+		ErrNotManualTransaction,
+		ErrReferenceRequired,
+		ErrUnitCostNotAllowed,
+		ErrInvalidValuationPeriod,
+		ErrInvalidCostingMethod,
+		ErrInventoryVersionConflict,
+	)
+}
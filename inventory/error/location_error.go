@@ -43,3 +43,13 @@ var (
 		StatusCode: http.StatusForbidden,
 	}
 )
+
+func init() {
+	commonError.Register(
+		ErrLocationNotFound,
+		ErrDuplicateLocationName,
+		ErrInvalidLocationType,
+		ErrLocationInactive,
+		ErrUnauthorizedLocationAccess,
+	)
+}
@@ -0,0 +1,79 @@
+package error
+
+import (
+	"net/http"
+
+	commonError "ecommerce-be/common/error"
+	"ecommerce-be/inventory/utils/constant"
+)
+
+var (
+	// ErrPurchaseOrderNotFound is returned when a purchase order does not exist for the seller
+	ErrPurchaseOrderNotFound = &commonError.AppError{
+		Code:       constant.PURCHASE_ORDER_NOT_FOUND_CODE,
+		Message:    constant.PURCHASE_ORDER_NOT_FOUND_MSG,
+		StatusCode: http.StatusNotFound,
+	}
+
+	// ErrPurchaseOrderNotEditable is returned when editing an order that was already sent
+	ErrPurchaseOrderNotEditable = &commonError.AppError{
+		Code:       constant.PURCHASE_ORDER_NOT_EDITABLE_CODE,
+		Message:    constant.PURCHASE_ORDER_NOT_EDITABLE_MSG,
+		StatusCode: http.StatusConflict,
+	}
+
+	// ErrPurchaseOrderInvalidStatus is returned when the order's status does not allow the action
+	ErrPurchaseOrderInvalidStatus = &commonError.AppError{
+		Code:       constant.PURCHASE_ORDER_INVALID_STATUS_CODE,
+		Message:    constant.PURCHASE_ORDER_INVALID_STATUS_MSG,
+		StatusCode: http.StatusConflict,
+	}
+
+	// ErrPurchaseOrderNotReceivable is returned when receiving a draft or closed order
+	ErrPurchaseOrderNotReceivable = &commonError.AppError{
+		Code:       constant.PURCHASE_ORDER_NOT_RECEIVABLE_CODE,
+		Message:    constant.PURCHASE_ORDER_NOT_RECEIVABLE_MSG,
+		StatusCode: http.StatusConflict,
+	}
+
+	// ErrDuplicatePurchaseOrderLine is returned when a variant is ordered on two lines
+	ErrDuplicatePurchaseOrderLine = &commonError.AppError{
+		Code:       constant.DUPLICATE_PURCHASE_ORDER_LINE_CODE,
+		Message:    constant.DUPLICATE_PURCHASE_ORDER_LINE_MSG,
+		StatusCode: http.StatusBadRequest,
+	}
+
+	// ErrPurchaseOrderLineNotFound is returned when a receipt names a line not on the order
+	ErrPurchaseOrderLineNotFound = &commonError.AppError{
+		Code:       constant.PURCHASE_ORDER_LINE_NOT_FOUND_CODE,
+		Message:    constant.PURCHASE_ORDER_LINE_NOT_FOUND_MSG,
+		StatusCode: http.StatusBadRequest,
+	}
+
+	// ErrReceiptExceedsOrdered is returned when more units are received than are outstanding
+	ErrReceiptExceedsOrdered = &commonError.AppError{
+		Code:       constant.RECEIPT_EXCEEDS_ORDERED_CODE,
+		Message:    constant.RECEIPT_EXCEEDS_ORDERED_MSG,
+		StatusCode: http.StatusBadRequest,
+	}
+
+	// ErrPurchaseOrderReceiptFailed is returned when stock rejects a receipt; nothing is received
+	ErrPurchaseOrderReceiptFailed = &commonError.AppError{
+		Code:       constant.PURCHASE_ORDER_RECEIPT_FAILED_CODE,
+		Message:    constant.PURCHASE_ORDER_RECEIPT_FAILED_MSG,
+		StatusCode: http.StatusUnprocessableEntity,
+	}
+)
+
+func init() {
+	commonError.Register(
+		ErrPurchaseOrderNotFound,
+		ErrPurchaseOrderNotEditable,
+		ErrPurchaseOrderInvalidStatus,
+		ErrPurchaseOrderNotReceivable,
+		ErrDuplicatePurchaseOrderLine,
+		ErrPurchaseOrderLineNotFound,
+		ErrReceiptExceedsOrdered,
+		ErrPurchaseOrderReceiptFailed,
+	)
+}
@@ -0,0 +1,55 @@
+package error
+
+import (
+	"net/http"
+
+	commonError "ecommerce-be/common/error"
+	"ecommerce-be/inventory/utils/constant"
+)
+
+var (
+	// ErrStockTakeNotFound is returned when a stock take does not exist for the seller
+	ErrStockTakeNotFound = &commonError.AppError{
+		Code:       constant.STOCK_TAKE_NOT_FOUND_CODE,
+		Message:    constant.STOCK_TAKE_NOT_FOUND_MSG,
+		StatusCode: http.StatusNotFound,
+	}
+
+	// ErrStockTakeNotOpen is returned when counting, applying or cancelling a closed stock take
+	ErrStockTakeNotOpen = &commonError.AppError{
+		Code:       constant.STOCK_TAKE_NOT_OPEN_CODE,
+		Message:    constant.STOCK_TAKE_NOT_OPEN_MSG,
+		StatusCode: http.StatusConflict,
+	}
+
+	// ErrStockTakeAlreadyOpen is returned when the location already has an open stock take
+	ErrStockTakeAlreadyOpen = &commonError.AppError{
+		Code:       constant.STOCK_TAKE_ALREADY_OPEN_CODE,
+		Message:    constant.STOCK_TAKE_ALREADY_OPEN_MSG,
+		StatusCode: http.StatusConflict,
+	}
+
+	// ErrStockTakeNothingCounted is returned when applying a stock take without counts
+	ErrStockTakeNothingCounted = &commonError.AppError{
+		Code:       constant.STOCK_TAKE_NOTHING_COUNTED_CODE,
+		Message:    constant.STOCK_TAKE_NOTHING_COUNTED_MSG,
+		StatusCode: http.StatusBadRequest,
+	}
+
+	// ErrStockTakeApplyFailed is returned when any correction is rejected; none are applied
+	ErrStockTakeApplyFailed = &commonError.AppError{
+		Code:       constant.STOCK_TAKE_APPLY_FAILED_CODE,
+		Message:    constant.STOCK_TAKE_APPLY_FAILED_MSG,
+		StatusCode: http.StatusUnprocessableEntity,
+	}
+)
+
+func init() {
+	commonError.Register(
+		ErrStockTakeNotFound,
+		ErrStockTakeNotOpen,
+		ErrStockTakeAlreadyOpen,
+		ErrStockTakeNothingCounted,
+		ErrStockTakeApplyFailed,
+	)
+}
@@ -0,0 +1,47 @@
+package error
+
+import (
+	"net/http"
+
+	commonError "ecommerce-be/common/error"
+	"ecommerce-be/inventory/utils/constant"
+)
+
+var (
+	// ErrSupplierNotFound is returned when a supplier does not exist for the seller
+	ErrSupplierNotFound = &commonError.AppError{
+		Code:       constant.SUPPLIER_NOT_FOUND_CODE,
+		Message:    constant.SUPPLIER_NOT_FOUND_MSG,
+		StatusCode: http.StatusNotFound,
+	}
+
+	// ErrDuplicateSupplierName is returned when the seller already has a supplier with the name
+	ErrDuplicateSupplierName = &commonError.AppError{
+		Code:       constant.DUPLICATE_SUPPLIER_NAME_CODE,
+		Message:    constant.DUPLICATE_SUPPLIER_NAME_MSG,
+		StatusCode: http.StatusConflict,
+	}
+
+	// ErrSupplierInactive is returned when ordering from a deactivated supplier
+	ErrSupplierInactive = &commonError.AppError{
+		Code:       constant.SUPPLIER_INACTIVE_CODE,
+		Message:    constant.SUPPLIER_INACTIVE_MSG,
+		StatusCode: http.StatusBadRequest,
+	}
+
+	// ErrSupplierInUse is returned when deleting a supplier that has purchase orders
+	ErrSupplierInUse = &commonError.AppError{
+		Code:       constant.SUPPLIER_IN_USE_CODE,
+		Message:    constant.SUPPLIER_IN_USE_MSG,
+		StatusCode: http.StatusConflict,
+	}
+)
+
+func init() {
+	commonError.Register(
+		ErrSupplierNotFound,
+		ErrDuplicateSupplierName,
+		ErrSupplierInactive,
+		ErrSupplierInUse,
+	)
+}
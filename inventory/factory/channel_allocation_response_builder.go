@@ -0,0 +1,58 @@
+package factory
+
+import (
+	"ecommerce-be/inventory/entity"
+	"ecommerce-be/inventory/model"
+	"ecommerce-be/inventory/utils/helper"
+	productEntity "ecommerce-be/product/entity"
+)
+
+// BuildChannelAllocationRuleResponse converts a rule entity to its API response
+func BuildChannelAllocationRuleResponse(
+	rule entity.ChannelAllocationRule,
+) model.ChannelAllocationRuleResponse {
+	return model.ChannelAllocationRuleResponse{
+		ID:             rule.ID,
+		VariantID:      rule.VariantID,
+		Channel:        rule.Channel,
+		ReservePercent: rule.ReservePercent,
+		BufferQuantity: rule.BufferQuantity,
+	}
+}
+
+// BuildChannelAllocationRuleResponses converts rule entities to API responses
+func BuildChannelAllocationRuleResponses(
+	rules []entity.ChannelAllocationRule,
+) []model.ChannelAllocationRuleResponse {
+	responses := make([]model.ChannelAllocationRuleResponse, 0, len(rules))
+	for _, rule := range rules {
+		responses = append(responses, BuildChannelAllocationRuleResponse(rule))
+	}
+	return responses
+}
+
+// BuildVariantChannelAvailability splits a variant's pooled stock across every sales channel
+func BuildVariantChannelAvailability(
+	variantID uint,
+	pooled int,
+	rules []entity.ChannelAllocationRule,
+) model.VariantChannelAvailability {
+	allocations := helper.ResolveChannelAllocations(rules, variantID)
+	channels := productEntity.ValidSalesChannels()
+
+	result := model.VariantChannelAvailability{
+		VariantID:       variantID,
+		PooledAvailable: pooled,
+		Channels:        make([]model.ChannelAvailability, 0, len(channels)),
+	}
+	for _, channel := range channels {
+		allocation := allocations[channel.String()]
+		result.Channels = append(result.Channels, model.ChannelAvailability{
+			Channel:        channel.String(),
+			Available:      helper.ChannelAvailableQuantity(pooled, channel.String(), allocations),
+			ReservePercent: allocation.ReservePercent,
+			BufferQuantity: allocation.BufferQuantity,
+		})
+	}
+	return result
+}
@@ -63,6 +63,8 @@ func BuildInventoryResponseFromEntity(
 		inv.BinLocation,
 	)
 	response.ID = inv.ID
+	response.DamagedQuantity = inv.DamagedQuantity
+	response.QuarantinedQuantity = inv.QuarantinedQuantity
 	return response
 }
 
@@ -0,0 +1,82 @@
+package factory
+
+import (
+	"time"
+
+	"ecommerce-be/inventory/entity"
+	"ecommerce-be/inventory/model"
+)
+
+// BuildSupplierResponse converts a supplier entity to its API response
+func BuildSupplierResponse(supplier *entity.Supplier) model.SupplierResponse {
+	return model.SupplierResponse{
+		ID:          supplier.ID,
+		Name:        supplier.Name,
+		ContactName: supplier.ContactName,
+		Email:       supplier.Email,
+		Phone:       supplier.Phone,
+		Notes:       supplier.Notes,
+		IsActive:    supplier.IsActive,
+	}
+}
+
+// BuildSupplierResponses converts supplier entities to API responses
+func BuildSupplierResponses(suppliers []entity.Supplier) []model.SupplierResponse {
+	responses := make([]model.SupplierResponse, 0, len(suppliers))
+	for i := range suppliers {
+		responses = append(responses, BuildSupplierResponse(&suppliers[i]))
+	}
+	return responses
+}
+
+// BuildPurchaseOrderResponse converts a purchase order with its lines to its API response
+func BuildPurchaseOrderResponse(order *entity.PurchaseOrder) model.PurchaseOrderResponse {
+	response := model.PurchaseOrderResponse{
+		ID:                order.ID,
+		Number:            order.Number(),
+		SupplierID:        order.SupplierID,
+		LocationID:        order.LocationID,
+		Status:            order.Status,
+		SupplierReference: order.SupplierReference,
+		ExpectedAt:        formatPurchaseOrderTime(order.ExpectedAt),
+		Note:              order.Note,
+		CreatedBy:         order.CreatedBy,
+		SentAt:            formatPurchaseOrderTime(order.SentAt),
+		ClosedAt:          formatPurchaseOrderTime(order.ClosedAt),
+		CreatedAt:         order.CreatedAt.Format(time.RFC3339),
+		Lines:             make([]model.PurchaseOrderLineResponse, 0, len(order.Lines)),
+	}
+
+	for _, line := range order.Lines {
+		lineTotal := int64(line.QuantityOrdered) * line.UnitCostCents
+		response.TotalCostCents += lineTotal
+		response.Lines = append(response.Lines, model.PurchaseOrderLineResponse{
+			ID:                line.ID,
+			VariantID:         line.VariantID,
+			QuantityOrdered:   line.QuantityOrdered,
+			QuantityReceived:  line.QuantityReceived,
+			QuantityRemaining: line.RemainingQuantity(),
+			UnitCostCents:     line.UnitCostCents,
+			LineTotalCents:    lineTotal,
+		})
+	}
+	return response
+}
+
+// BuildPurchaseOrderResponses converts purchase orders to API responses
+func BuildPurchaseOrderResponses(orders []entity.PurchaseOrder) []model.PurchaseOrderResponse {
+	responses := make([]model.PurchaseOrderResponse, 0, len(orders))
+	for i := range orders {
+		responses = append(responses, BuildPurchaseOrderResponse(&orders[i]))
+	}
+	return responses
+}
+
+// formatPurchaseOrderTime formats an optional timestamp
+func formatPurchaseOrderTime(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	formatted := t.Format(time.RFC3339)
+	return &formatted
+}
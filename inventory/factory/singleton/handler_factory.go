@@ -15,6 +15,11 @@ type HandlerFactory struct {
 	inventorySummaryHandler             *handler.InventorySummaryHandler
 	inventoryReservationHandler         *handler.InventoryReservationHandler
 	scheduleInventoryReservationHandler *handler.ScheduleInventoryReservationHandler
+	channelAllocationHandler            *handler.ChannelAllocationHandler
+	inventoryValuationHandler           *handler.InventoryValuationHandler
+	stockTakeHandler                    *handler.StockTakeHandler
+	supplierHandler                     *handler.SupplierHandler
+	purchaseOrderHandler                *handler.PurchaseOrderHandler
 
 	once sync.Once
 }
@@ -45,6 +50,23 @@ func (f *HandlerFactory) initialize() {
 		f.scheduleInventoryReservationHandler = handler.NewScheduleInventoryReservationHandler(
 			f.serviceFactory.GetInventoryReservationService(),
 		)
+
+		f.channelAllocationHandler = handler.NewChannelAllocationHandler(
+			f.serviceFactory.GetChannelAllocationService(),
+		)
+
+		f.inventoryValuationHandler = handler.NewInventoryValuationHandler(
+			f.serviceFactory.GetInventoryValuationService(),
+		)
+
+		f.stockTakeHandler = handler.NewStockTakeHandler(
+			f.serviceFactory.GetStockTakeService(),
+		)
+
+		f.supplierHandler = handler.NewSupplierHandler(f.serviceFactory.GetSupplierService())
+		f.purchaseOrderHandler = handler.NewPurchaseOrderHandler(
+			f.serviceFactory.GetPurchaseOrderService(),
+		)
 	})
 }
 
@@ -75,3 +97,33 @@ func (f *HandlerFactory) GetScheduleInventoryReservationHandler() *handler.Sched
 	f.initialize()
 	return f.scheduleInventoryReservationHandler
 }
+
+// GetChannelAllocationHandler returns the singleton channel allocation handler
+func (f *HandlerFactory) GetChannelAllocationHandler() *handler.ChannelAllocationHandler {
+	f.initialize()
+	return f.channelAllocationHandler
+}
+
+// GetInventoryValuationHandler returns the singleton inventory valuation handler
+func (f *HandlerFactory) GetInventoryValuationHandler() *handler.InventoryValuationHandler {
+	f.initialize()
+	return f.inventoryValuationHandler
+}
+
+// GetStockTakeHandler returns the singleton stock-take handler
+func (f *HandlerFactory) GetStockTakeHandler() *handler.StockTakeHandler {
+	f.initialize()
+	return f.stockTakeHandler
+}
+
+// GetSupplierHandler returns the singleton supplier handler
+func (f *HandlerFactory) GetSupplierHandler() *handler.SupplierHandler {
+	f.initialize()
+	return f.supplierHandler
+}
+
+// GetPurchaseOrderHandler returns the singleton purchase order handler
+func (f *HandlerFactory) GetPurchaseOrderHandler() *handler.PurchaseOrderHandler {
+	f.initialize()
+	return f.purchaseOrderHandler
+}
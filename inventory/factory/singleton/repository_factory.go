@@ -14,6 +14,10 @@ type RepositoryFactory struct {
 	inventoryRepository            repository.InventoryRepository
 	inventoryTransactionRepository repository.InventoryTransactionRepository
 	inventoryReservationRepository repository.InventoryReservationRepository
+	channelAllocationRepository    repository.ChannelAllocationRepository
+	stockTakeRepository            repository.StockTakeRepository
+	supplierRepository             repository.SupplierRepository
+	purchaseOrderRepository        repository.PurchaseOrderRepository
 	once                           sync.Once
 }
 
@@ -30,6 +34,10 @@ func (f *RepositoryFactory) initialize() {
 		f.inventoryRepository = repository.NewInventoryRepository()
 		f.inventoryTransactionRepository = repository.NewInventoryTransactionRepository()
 		f.inventoryReservationRepository = repository.NewInventoryReservationRepository()
+		f.channelAllocationRepository = repository.NewChannelAllocationRepository()
+		f.stockTakeRepository = repository.NewStockTakeRepository()
+		f.supplierRepository = repository.NewSupplierRepository()
+		f.purchaseOrderRepository = repository.NewPurchaseOrderRepository()
 	})
 }
 
@@ -52,3 +60,23 @@ func (f *RepositoryFactory) GetInventoryReservationRepository() repository.Inven
 	f.initialize()
 	return f.inventoryReservationRepository
 }
+
+func (f *RepositoryFactory) GetChannelAllocationRepository() repository.ChannelAllocationRepository {
+	f.initialize()
+	return f.channelAllocationRepository
+}
+
+func (f *RepositoryFactory) GetStockTakeRepository() repository.StockTakeRepository {
+	f.initialize()
+	return f.stockTakeRepository
+}
+
+func (f *RepositoryFactory) GetSupplierRepository() repository.SupplierRepository {
+	f.initialize()
+	return f.supplierRepository
+}
+
+func (f *RepositoryFactory) GetPurchaseOrderRepository() repository.PurchaseOrderRepository {
+	f.initialize()
+	return f.purchaseOrderRepository
+}
@@ -7,6 +7,8 @@ import (
 	"ecommerce-be/common/scheduler"
 	"ecommerce-be/inventory/repository"
 	"ecommerce-be/inventory/service"
+	notificationFactory "ecommerce-be/notification/factory/singleton"
+	notificationGateway "ecommerce-be/notification/gateway"
 	productFactory "ecommerce-be/product/factory/singleton"
 	productService "ecommerce-be/product/service"
 	userFactory "ecommerce-be/user/factory/singleton"
@@ -24,6 +26,11 @@ type ServiceFactory struct {
 	productInventorySummaryService service.ProductInventorySummaryService
 	inventoryReservationService    service.InventoryReservationService
 	reservationSchedulerService    service.ReservationSchedulerService
+	channelAllocationService       service.ChannelAllocationService
+	inventoryValuationService      service.InventoryValuationService
+	stockTakeService               service.StockTakeService
+	supplierService                service.SupplierService
+	purchaseOrderService           service.PurchaseOrderService
 
 	once sync.Once
 }
@@ -43,10 +50,13 @@ func (f *ServiceFactory) initialize() {
 		inventoryRepository := f.repoFactory.GetInventoryRepository()
 		inventoryTransactionRepository := f.repoFactory.GetInventoryTransactionRepository()
 		inventoryReservationRepository := f.repoFactory.GetInventoryReservationRepository()
+		channelAllocationRepository := f.repoFactory.GetChannelAllocationRepository()
 		redisClient, _ := cache.GetRedisClient()
 
 		pf := productFactory.GetInstance()
 		variantQueryService := pf.GetVariantQueryService()
+		bundleService := pf.GetVariantBundleService()
+		digitalService := pf.GetDigitalDeliveryService()
 
 		userfac := userFactory.GetInstance()
 		// Initialize location service first (needed by inventory summary service)
@@ -73,6 +83,16 @@ func (f *ServiceFactory) initialize() {
 		f.inventoryQueryService = service.NewInventoryQueryServiceImpl(
 			inventoryRepository,
 			locationRepository,
+			channelAllocationRepository,
+			bundleService,
+			digitalService,
+		)
+
+		// Initialize channel allocation service (rules are applied by the query service)
+		f.channelAllocationService = service.NewChannelAllocationService(
+			channelAllocationRepository,
+			f.inventoryQueryService,
+			variantQueryService,
 		)
 
 		// Initialize transaction service (used by inventory service and for listing)
@@ -83,12 +103,40 @@ func (f *ServiceFactory) initialize() {
 			userfac.GetUserQueryService(),
 		)
 
+		f.inventoryValuationService = service.NewInventoryValuationService(
+			inventoryTransactionRepository,
+			userfac.GetSellerSettingsService(),
+		)
+
 		f.setManageInventoryService(
 			locationRepository,
 			inventoryRepository,
 			variantQueryService,
 		)
 
+		// Stock-take corrections are applied through the inventory service
+		f.stockTakeService = service.NewStockTakeService(
+			f.repoFactory.GetStockTakeRepository(),
+			locationRepository,
+			inventoryRepository,
+			f.inventoryService,
+			variantQueryService,
+		)
+
+		f.supplierService = service.NewSupplierService(
+			f.repoFactory.GetSupplierRepository(),
+			f.repoFactory.GetPurchaseOrderRepository(),
+		)
+
+		// Purchase order receipts are added to stock through the inventory service
+		f.purchaseOrderService = service.NewPurchaseOrderService(
+			f.repoFactory.GetPurchaseOrderRepository(),
+			f.repoFactory.GetSupplierRepository(),
+			locationRepository,
+			f.inventoryService,
+			variantQueryService,
+		)
+
 		f.reservationSchedulerService = service.NewReservationSchedulerService(
 			*scheduler.New(redisClient),
 		)
@@ -100,6 +148,8 @@ func (f *ServiceFactory) initialize() {
 			variantQueryService,
 			f.reservationSchedulerService,
 			f.inventoryService,
+			bundleService,
+			digitalService,
 		)
 	})
 }
@@ -146,6 +196,36 @@ func (f *ServiceFactory) GetInventoryReservationService() service.InventoryReser
 	return f.inventoryReservationService
 }
 
+// GetChannelAllocationService returns the singleton channel allocation service
+func (f *ServiceFactory) GetChannelAllocationService() service.ChannelAllocationService {
+	f.initialize()
+	return f.channelAllocationService
+}
+
+// GetInventoryValuationService returns the singleton inventory valuation service
+func (f *ServiceFactory) GetInventoryValuationService() service.InventoryValuationService {
+	f.initialize()
+	return f.inventoryValuationService
+}
+
+// GetStockTakeService returns the singleton stock-take service
+func (f *ServiceFactory) GetStockTakeService() service.StockTakeService {
+	f.initialize()
+	return f.stockTakeService
+}
+
+// GetSupplierService returns the singleton supplier service
+func (f *ServiceFactory) GetSupplierService() service.SupplierService {
+	f.initialize()
+	return f.supplierService
+}
+
+// GetPurchaseOrderService returns the singleton purchase order service
+func (f *ServiceFactory) GetPurchaseOrderService() service.PurchaseOrderService {
+	f.initialize()
+	return f.purchaseOrderService
+}
+
 func (f *ServiceFactory) GetReservationSchedulerService() service.ReservationSchedulerService {
 	f.initialize()
 	return f.reservationSchedulerService
@@ -167,6 +247,9 @@ func (f *ServiceFactory) setManageInventoryService(
 		locationRepository,
 		variantQueryService,
 		bulkHelper,
+		notificationGateway.NewNotifier(
+			notificationFactory.GetInstance().GetNotificationDispatchService(),
+		),
 	)
 	return f.inventoryService
 }
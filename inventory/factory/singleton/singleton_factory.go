@@ -67,6 +67,26 @@ func (f *SingletonFactory) GetScheduleInventoryReservationHandler() *handler.Sch
 	return f.handlerFactory.GetScheduleInventoryReservationHandler()
 }
 
+func (f *SingletonFactory) GetChannelAllocationHandler() *handler.ChannelAllocationHandler {
+	return f.handlerFactory.GetChannelAllocationHandler()
+}
+
+func (f *SingletonFactory) GetInventoryValuationHandler() *handler.InventoryValuationHandler {
+	return f.handlerFactory.GetInventoryValuationHandler()
+}
+
+func (f *SingletonFactory) GetStockTakeHandler() *handler.StockTakeHandler {
+	return f.handlerFactory.GetStockTakeHandler()
+}
+
+func (f *SingletonFactory) GetSupplierHandler() *handler.SupplierHandler {
+	return f.handlerFactory.GetSupplierHandler()
+}
+
+func (f *SingletonFactory) GetPurchaseOrderHandler() *handler.PurchaseOrderHandler {
+	return f.handlerFactory.GetPurchaseOrderHandler()
+}
+
 func (f *SingletonFactory) GetInventoryQueryService() service.InventoryQueryService {
 	return f.serviceFactory.GetInventoryQueryService()
 }
@@ -0,0 +1,54 @@
+package factory
+
+import (
+	"ecommerce-be/inventory/entity"
+	"ecommerce-be/inventory/model"
+	"ecommerce-be/inventory/utils/helper"
+)
+
+// stockTakeTimeLayout is the RFC 3339 layout used for stock-take timestamps
+const stockTakeTimeLayout = "2006-01-02T15:04:05Z07:00"
+
+// BuildStockTakeResponse converts a session to its API response; withLines includes the
+// counted lines with their discrepancies
+func BuildStockTakeResponse(stockTake *entity.StockTake, withLines bool) model.StockTakeResponse {
+	response := model.StockTakeResponse{
+		ID:         stockTake.ID,
+		LocationID: stockTake.LocationID,
+		Status:     stockTake.Status,
+		Note:       stockTake.Note,
+		StartedBy:  stockTake.StartedBy,
+		AppliedBy:  stockTake.AppliedBy,
+		CreatedAt:  stockTake.CreatedAt.Format(stockTakeTimeLayout),
+		Summary:    helper.SummarizeStockTake(stockTake.Lines),
+	}
+	if stockTake.AppliedAt != nil {
+		appliedAt := stockTake.AppliedAt.Format(stockTakeTimeLayout)
+		response.AppliedAt = &appliedAt
+	}
+
+	if withLines {
+		response.Lines = make([]model.StockTakeLineResponse, 0, len(stockTake.Lines))
+		for _, line := range stockTake.Lines {
+			response.Lines = append(response.Lines, model.StockTakeLineResponse{
+				VariantID:       line.VariantID,
+				SystemQuantity:  line.SystemQuantity,
+				CountedQuantity: line.CountedQuantity,
+				Discrepancy:     line.Discrepancy(),
+				CountedBy:       line.CountedBy,
+				CountedAt:       line.CountedAt.Format(stockTakeTimeLayout),
+				TransactionID:   line.TransactionID,
+			})
+		}
+	}
+	return response
+}
+
+// BuildStockTakeResponses converts sessions to API responses without their lines
+func BuildStockTakeResponses(stockTakes []entity.StockTake) []model.StockTakeResponse {
+	responses := make([]model.StockTakeResponse, 0, len(stockTakes))
+	for i := range stockTakes {
+		responses = append(responses, BuildStockTakeResponse(&stockTakes[i], false))
+	}
+	return responses
+}
@@ -0,0 +1,148 @@
+package handler
+
+import (
+	"net/http"
+
+	"ecommerce-be/common/auth"
+	"ecommerce-be/common/constants"
+	commonErr "ecommerce-be/common/error"
+	"ecommerce-be/common/handler"
+	"ecommerce-be/inventory/model"
+	"ecommerce-be/inventory/service"
+	invConstants "ecommerce-be/inventory/utils/constant"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ChannelAllocationHandler handles HTTP requests for channel allocation rules
+type ChannelAllocationHandler struct {
+	*handler.BaseHandler
+	allocationService service.ChannelAllocationService
+}
+
+// NewChannelAllocationHandler creates a new instance of ChannelAllocationHandler
+func NewChannelAllocationHandler(
+	allocationService service.ChannelAllocationService,
+) *ChannelAllocationHandler {
+	return &ChannelAllocationHandler{
+		BaseHandler:       handler.NewBaseHandler(),
+		allocationService: allocationService,
+	}
+}
+
+// UpsertRule creates or replaces a channel allocation rule
+func (h *ChannelAllocationHandler) UpsertRule(c *gin.Context) {
+	var req model.ChannelAllocationRuleRequest
+	if err := h.BindJSON(c, &req); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	// Get seller ID from authenticated user
+	_, sellerID, err := auth.ValidateUserHasSellerRoleOrHigherAndReturnAuthData(c)
+	if err != nil {
+		h.HandleError(c, err, constants.UNAUTHORIZED_ERROR_MSG)
+		return
+	}
+
+	rule, err := h.allocationService.UpsertRule(c, sellerID, req)
+	if err != nil {
+		h.HandleError(c, err, invConstants.FAILED_TO_SAVE_CHANNEL_ALLOCATION_RULE_MSG)
+		return
+	}
+
+	h.SuccessWithData(
+		c,
+		http.StatusOK,
+		invConstants.CHANNEL_ALLOCATION_RULE_SAVED_MSG,
+		invConstants.CHANNEL_ALLOCATION_RULE_FIELD_NAME,
+		rule,
+	)
+}
+
+// ListRules lists the seller's channel allocation rules
+func (h *ChannelAllocationHandler) ListRules(c *gin.Context) {
+	var params model.ChannelAllocationRulesParam
+	if err := c.ShouldBindQuery(&params); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	// Get seller ID from authenticated user
+	_, sellerID, err := auth.ValidateUserHasSellerRoleOrHigherAndReturnAuthData(c)
+	if err != nil {
+		h.HandleError(c, err, constants.UNAUTHORIZED_ERROR_MSG)
+		return
+	}
+
+	rules, err := h.allocationService.ListRules(c, sellerID, params.VariantID)
+	if err != nil {
+		h.HandleError(c, err, invConstants.FAILED_TO_LIST_CHANNEL_ALLOCATION_RULES_MSG)
+		return
+	}
+
+	h.SuccessWithData(
+		c,
+		http.StatusOK,
+		invConstants.CHANNEL_ALLOCATION_RULES_LISTED_MSG,
+		invConstants.CHANNEL_ALLOCATION_RULES_FIELD_NAME,
+		rules,
+	)
+}
+
+// DeleteRule deletes a channel allocation rule
+func (h *ChannelAllocationHandler) DeleteRule(c *gin.Context) {
+	ruleID, err := h.ParseUintParam(c, "ruleId")
+	if err != nil {
+		h.HandleError(c, err, "Invalid rule ID")
+		return
+	}
+
+	// Get seller ID from authenticated user
+	_, sellerID, err := auth.ValidateUserHasSellerRoleOrHigherAndReturnAuthData(c)
+	if err != nil {
+		h.HandleError(c, err, constants.UNAUTHORIZED_ERROR_MSG)
+		return
+	}
+
+	if err := h.allocationService.DeleteRule(c, sellerID, ruleID); err != nil {
+		h.HandleError(c, err, invConstants.FAILED_TO_DELETE_CHANNEL_ALLOCATION_RULE_MSG)
+		return
+	}
+
+	h.Success(c, http.StatusOK, invConstants.CHANNEL_ALLOCATION_RULE_DELETED_MSG, nil)
+}
+
+// GetChannelAvailability reports per-channel availability for variants or products
+func (h *ChannelAllocationHandler) GetChannelAvailability(c *gin.Context) {
+	var req model.ChannelAvailabilityRequest
+	if err := h.BindJSON(c, &req); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	// Validate at least one array has items
+	if len(req.VariantIDs) == 0 && len(req.ProductIDs) == 0 {
+		h.HandleError(
+			c,
+			commonErr.NewAppError("SYSTEM_ERROR", "Must provide variantIds or productIds", 400),
+			"Bad Request",
+		)
+		return
+	}
+
+	// Get seller ID from authenticated user
+	_, sellerID, err := auth.ValidateUserHasSellerRoleOrHigherAndReturnAuthData(c)
+	if err != nil {
+		h.HandleError(c, err, constants.UNAUTHORIZED_ERROR_MSG)
+		return
+	}
+
+	response, err := h.allocationService.GetChannelAvailability(c, sellerID, req)
+	if err != nil {
+		h.HandleError(c, err, invConstants.FAILED_TO_GET_CHANNEL_AVAILABILITY_MSG)
+		return
+	}
+
+	h.Success(c, http.StatusOK, invConstants.CHANNEL_AVAILABILITY_RETRIEVED_MSG, response)
+}
@@ -7,6 +7,7 @@ import (
 	"ecommerce-be/common/constants"
 	commonErr "ecommerce-be/common/error"
 	"ecommerce-be/common/handler"
+	"ecommerce-be/common/validator"
 	"ecommerce-be/inventory/model"
 	"ecommerce-be/inventory/service"
 	invConstants "ecommerce-be/inventory/utils/constant"
@@ -166,6 +167,10 @@ func (h *InventoryHandler) ListTransactions(c *gin.Context) {
 		h.HandleValidationError(c, err)
 		return
 	}
+	if err := validator.RequireLessOrEqual(&params, "createdFrom", "createdTo"); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
 
 	// Get seller ID from authenticated user
 	_, sellerID, err := auth.ValidateUserHasSellerRoleOrHigherAndReturnAuthData(c)
@@ -187,6 +192,43 @@ func (h *InventoryHandler) ListTransactions(c *gin.Context) {
 	h.Success(c, http.StatusOK, invConstants.TRANSACTIONS_RETRIEVED_MSG, response)
 }
 
+// ListVariantMovements handles a variant's stock movement history across the
+// seller's locations
+func (h *InventoryHandler) ListVariantMovements(c *gin.Context) {
+	variantID, err := h.ParseUintParam(c, "variantId")
+	if err != nil {
+		h.HandleError(c, err, "Invalid variant ID")
+		return
+	}
+
+	var params model.VariantMovementsQueryParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+	if err := validator.RequireLessOrEqual(&params, "createdFrom", "createdTo"); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	_, sellerID, err := auth.ValidateUserHasSellerRoleOrHigherAndReturnAuthData(c)
+	if err != nil {
+		h.HandleError(c, err, constants.UNAUTHORIZED_ERROR_MSG)
+		return
+	}
+
+	filter := params.ToFilter(variantID)
+	filter.SellerID = sellerID
+
+	response, err := h.transactionService.ListTransactions(c, filter)
+	if err != nil {
+		h.HandleError(c, err, invConstants.FAILED_TO_LIST_MOVEMENTS_MSG)
+		return
+	}
+
+	h.Success(c, http.StatusOK, invConstants.MOVEMENTS_RETRIEVED_MSG, response)
+}
+
 // GetInventories handles listing inventories with filters
 func (h *InventoryHandler) GetInventories(c *gin.Context) {
 	var params model.GetInventoriesParam
@@ -195,6 +237,10 @@ func (h *InventoryHandler) GetInventories(c *gin.Context) {
 		h.HandleValidationError(c, err)
 		return
 	}
+	if err := validator.RequireLessOrEqual(&params, "minQuantity", "maxQuantity"); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
 
 	// Get seller ID from authenticated user
 	sellerID, exists := auth.GetSellerIDFromContext(c)
@@ -250,3 +296,27 @@ func (h *InventoryHandler) GetTotalAvailableQuantities(c *gin.Context) {
 
 	h.Success(c, http.StatusOK, invConstants.INVENTORIES_RETRIEVED_MSG, response)
 }
+
+// CheckStock handles the batch stock check of a cart at checkout
+func (h *InventoryHandler) CheckStock(c *gin.Context) {
+	var req model.StockCheckRequest
+	if err := h.BindJSON(c, &req); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	// Extract seller ID from context (set by PublicAPIAuth middleware)
+	sellerID, exists := auth.GetSellerIDFromContext(c)
+	if !exists || sellerID == 0 {
+		h.HandleError(c, commonErr.ErrSellerDataMissing, invConstants.FAILED_TO_CHECK_STOCK_MSG)
+		return
+	}
+
+	response, err := h.inventoryQueryService.CheckStock(c, req, sellerID)
+	if err != nil {
+		h.HandleError(c, err, invConstants.FAILED_TO_CHECK_STOCK_MSG)
+		return
+	}
+
+	h.Success(c, http.StatusOK, invConstants.STOCK_CHECKED_MSG, response)
+}
@@ -0,0 +1,65 @@
+package handler
+
+import (
+	"net/http"
+
+	"ecommerce-be/common/auth"
+	"ecommerce-be/common/constants"
+	"ecommerce-be/common/handler"
+	"ecommerce-be/common/validator"
+	"ecommerce-be/inventory/model"
+	"ecommerce-be/inventory/service"
+	invConstants "ecommerce-be/inventory/utils/constant"
+
+	"github.com/gin-gonic/gin"
+)
+
+// InventoryValuationHandler handles HTTP requests for the inventory valuation report
+type InventoryValuationHandler struct {
+	*handler.BaseHandler
+	valuationService service.InventoryValuationService
+}
+
+// NewInventoryValuationHandler creates a new instance of InventoryValuationHandler
+func NewInventoryValuationHandler(
+	valuationService service.InventoryValuationService,
+) *InventoryValuationHandler {
+	return &InventoryValuationHandler{
+		BaseHandler:      handler.NewBaseHandler(),
+		valuationService: valuationService,
+	}
+}
+
+// GetValuation reports stock value and COGS of the seller for a period
+func (h *InventoryValuationHandler) GetValuation(c *gin.Context) {
+	var params model.InventoryValuationParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+	if err := validator.RequireLessOrEqual(&params, "from", "to"); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	// Get seller ID from authenticated user
+	_, sellerID, err := auth.ValidateUserHasSellerRoleOrHigherAndReturnAuthData(c)
+	if err != nil {
+		h.HandleError(c, err, constants.UNAUTHORIZED_ERROR_MSG)
+		return
+	}
+
+	valuation, err := h.valuationService.GetValuation(c, sellerID, params)
+	if err != nil {
+		h.HandleError(c, err, invConstants.FAILED_TO_GET_INVENTORY_VALUATION_MSG)
+		return
+	}
+
+	h.SuccessWithData(
+		c,
+		http.StatusOK,
+		invConstants.INVENTORY_VALUATION_RETRIEVED_MSG,
+		invConstants.INVENTORY_VALUATION_FIELD_NAME,
+		valuation,
+	)
+}
@@ -0,0 +1,254 @@
+package handler
+
+import (
+	"net/http"
+
+	"ecommerce-be/common/auth"
+	"ecommerce-be/common/constants"
+	"ecommerce-be/common/handler"
+	"ecommerce-be/common/log"
+	"ecommerce-be/inventory/model"
+	"ecommerce-be/inventory/service"
+	invConstants "ecommerce-be/inventory/utils/constant"
+
+	"github.com/gin-gonic/gin"
+)
+
+// PurchaseOrderHandler handles HTTP requests for purchase orders
+type PurchaseOrderHandler struct {
+	*handler.BaseHandler
+	purchaseOrderService service.PurchaseOrderService
+}
+
+// NewPurchaseOrderHandler creates a new instance of PurchaseOrderHandler
+func NewPurchaseOrderHandler(
+	purchaseOrderService service.PurchaseOrderService,
+) *PurchaseOrderHandler {
+	return &PurchaseOrderHandler{
+		BaseHandler:          handler.NewBaseHandler(),
+		purchaseOrderService: purchaseOrderService,
+	}
+}
+
+// CreatePurchaseOrder creates a draft purchase order
+// POST /api/inventory/purchase-orders
+func (h *PurchaseOrderHandler) CreatePurchaseOrder(c *gin.Context) {
+	var req model.CreatePurchaseOrderRequest
+	if err := h.BindJSON(c, &req); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	userID, sellerID, err := auth.ValidateUserHasSellerRoleOrHigherAndReturnAuthData(c)
+	if err != nil {
+		h.HandleError(c, err, constants.UNAUTHORIZED_ERROR_MSG)
+		return
+	}
+
+	response, err := h.purchaseOrderService.CreatePurchaseOrder(c, sellerID, userID, req)
+	if err != nil {
+		h.HandleError(c, err, invConstants.FAILED_TO_CREATE_PURCHASE_ORDER_MSG)
+		return
+	}
+
+	h.SuccessWithData(
+		c,
+		http.StatusCreated,
+		invConstants.PURCHASE_ORDER_CREATED_MSG,
+		invConstants.PURCHASE_ORDER_FIELD_NAME,
+		response,
+	)
+}
+
+// ListPurchaseOrders lists the seller's purchase orders
+// GET /api/inventory/purchase-orders
+func (h *PurchaseOrderHandler) ListPurchaseOrders(c *gin.Context) {
+	var params model.PurchaseOrdersQueryParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	_, sellerID, err := auth.ValidateUserHasSellerRoleOrHigherAndReturnAuthData(c)
+	if err != nil {
+		h.HandleError(c, err, constants.UNAUTHORIZED_ERROR_MSG)
+		return
+	}
+
+	response, err := h.purchaseOrderService.ListPurchaseOrders(c, sellerID, params)
+	if err != nil {
+		h.HandleError(c, err, invConstants.FAILED_TO_GET_PURCHASE_ORDERS_MSG)
+		return
+	}
+
+	h.SuccessWithData(
+		c,
+		http.StatusOK,
+		invConstants.PURCHASE_ORDERS_RETRIEVED_MSG,
+		invConstants.PURCHASE_ORDERS_FIELD_NAME,
+		response,
+	)
+}
+
+// GetPurchaseOrder returns a purchase order with its lines
+// GET /api/inventory/purchase-orders/:purchaseOrderId
+func (h *PurchaseOrderHandler) GetPurchaseOrder(c *gin.Context) {
+	orderID, err := h.ParseUintParam(c, "purchaseOrderId")
+	if err != nil {
+		h.HandleError(c, err, invConstants.INVALID_PURCHASE_ORDER_ID_MSG)
+		return
+	}
+
+	_, sellerID, err := auth.ValidateUserHasSellerRoleOrHigherAndReturnAuthData(c)
+	if err != nil {
+		h.HandleError(c, err, constants.UNAUTHORIZED_ERROR_MSG)
+		return
+	}
+
+	response, err := h.purchaseOrderService.GetPurchaseOrder(c, sellerID, orderID)
+	if err != nil {
+		h.HandleError(c, err, invConstants.FAILED_TO_GET_PURCHASE_ORDER_MSG)
+		return
+	}
+
+	h.SuccessWithData(
+		c,
+		http.StatusOK,
+		invConstants.PURCHASE_ORDER_RETRIEVED_MSG,
+		invConstants.PURCHASE_ORDER_FIELD_NAME,
+		response,
+	)
+}
+
+// UpdatePurchaseOrder edits a draft purchase order
+// PUT /api/inventory/purchase-orders/:purchaseOrderId
+func (h *PurchaseOrderHandler) UpdatePurchaseOrder(c *gin.Context) {
+	orderID, err := h.ParseUintParam(c, "purchaseOrderId")
+	if err != nil {
+		h.HandleError(c, err, invConstants.INVALID_PURCHASE_ORDER_ID_MSG)
+		return
+	}
+
+	var req model.UpdatePurchaseOrderRequest
+	if err := h.BindJSON(c, &req); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	_, sellerID, err := auth.ValidateUserHasSellerRoleOrHigherAndReturnAuthData(c)
+	if err != nil {
+		h.HandleError(c, err, constants.UNAUTHORIZED_ERROR_MSG)
+		return
+	}
+
+	response, err := h.purchaseOrderService.UpdatePurchaseOrder(c, sellerID, orderID, req)
+	if err != nil {
+		h.HandleError(c, err, invConstants.FAILED_TO_UPDATE_PURCHASE_ORDER_MSG)
+		return
+	}
+
+	h.SuccessWithData(
+		c,
+		http.StatusOK,
+		invConstants.PURCHASE_ORDER_UPDATED_MSG,
+		invConstants.PURCHASE_ORDER_FIELD_NAME,
+		response,
+	)
+}
+
+// SendPurchaseOrder marks a draft purchase order as sent to the supplier
+// POST /api/inventory/purchase-orders/:purchaseOrderId/send
+func (h *PurchaseOrderHandler) SendPurchaseOrder(c *gin.Context) {
+	orderID, err := h.ParseUintParam(c, "purchaseOrderId")
+	if err != nil {
+		h.HandleError(c, err, invConstants.INVALID_PURCHASE_ORDER_ID_MSG)
+		return
+	}
+
+	_, sellerID, err := auth.ValidateUserHasSellerRoleOrHigherAndReturnAuthData(c)
+	if err != nil {
+		h.HandleError(c, err, constants.UNAUTHORIZED_ERROR_MSG)
+		return
+	}
+
+	response, err := h.purchaseOrderService.SendPurchaseOrder(c, sellerID, orderID)
+	if err != nil {
+		h.HandleError(c, err, invConstants.FAILED_TO_SEND_PURCHASE_ORDER_MSG)
+		return
+	}
+
+	h.SuccessWithData(
+		c,
+		http.StatusOK,
+		invConstants.PURCHASE_ORDER_SENT_MSG,
+		invConstants.PURCHASE_ORDER_FIELD_NAME,
+		response,
+	)
+}
+
+// ReceivePurchaseOrder records a delivery against a purchase order and adds it to stock
+// POST /api/inventory/purchase-orders/:purchaseOrderId/receive
+func (h *PurchaseOrderHandler) ReceivePurchaseOrder(c *gin.Context) {
+	orderID, err := h.ParseUintParam(c, "purchaseOrderId")
+	if err != nil {
+		h.HandleError(c, err, invConstants.INVALID_PURCHASE_ORDER_ID_MSG)
+		return
+	}
+
+	var req model.ReceivePurchaseOrderRequest
+	if err := h.BindJSON(c, &req); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	userID, sellerID, err := auth.ValidateUserHasSellerRoleOrHigherAndReturnAuthData(c)
+	if err != nil {
+		h.HandleError(c, err, constants.UNAUTHORIZED_ERROR_MSG)
+		return
+	}
+
+	response, err := h.purchaseOrderService.ReceivePurchaseOrder(c, sellerID, userID, orderID, req)
+	if err != nil {
+		log.ErrorWithContext(c, "receivePurchaseOrder: failed", err)
+		h.HandleError(c, err, invConstants.FAILED_TO_RECEIVE_PURCHASE_ORDER_MSG)
+		return
+	}
+
+	h.SuccessWithData(
+		c,
+		http.StatusOK,
+		invConstants.PURCHASE_ORDER_RECEIVED_MSG,
+		invConstants.PURCHASE_ORDER_FIELD_NAME,
+		response,
+	)
+}
+
+// ClosePurchaseOrder closes a purchase order without expecting further deliveries
+// POST /api/inventory/purchase-orders/:purchaseOrderId/close
+func (h *PurchaseOrderHandler) ClosePurchaseOrder(c *gin.Context) {
+	orderID, err := h.ParseUintParam(c, "purchaseOrderId")
+	if err != nil {
+		h.HandleError(c, err, invConstants.INVALID_PURCHASE_ORDER_ID_MSG)
+		return
+	}
+
+	_, sellerID, err := auth.ValidateUserHasSellerRoleOrHigherAndReturnAuthData(c)
+	if err != nil {
+		h.HandleError(c, err, constants.UNAUTHORIZED_ERROR_MSG)
+		return
+	}
+
+	response, err := h.purchaseOrderService.ClosePurchaseOrder(c, sellerID, orderID)
+	if err != nil {
+		h.HandleError(c, err, invConstants.FAILED_TO_CLOSE_PURCHASE_ORDER_MSG)
+		return
+	}
+
+	h.SuccessWithData(
+		c,
+		http.StatusOK,
+		invConstants.PURCHASE_ORDER_CLOSED_MSG,
+		invConstants.PURCHASE_ORDER_FIELD_NAME,
+		response,
+	)
+}
@@ -0,0 +1,216 @@
+package handler
+
+import (
+	"net/http"
+
+	"ecommerce-be/common/auth"
+	"ecommerce-be/common/constants"
+	"ecommerce-be/common/handler"
+	"ecommerce-be/common/log"
+	"ecommerce-be/inventory/model"
+	"ecommerce-be/inventory/service"
+	invConstants "ecommerce-be/inventory/utils/constant"
+
+	"github.com/gin-gonic/gin"
+)
+
+// StockTakeHandler handles HTTP requests for stock-take sessions
+type StockTakeHandler struct {
+	*handler.BaseHandler
+	stockTakeService service.StockTakeService
+}
+
+// NewStockTakeHandler creates a new instance of StockTakeHandler
+func NewStockTakeHandler(stockTakeService service.StockTakeService) *StockTakeHandler {
+	return &StockTakeHandler{
+		BaseHandler:      handler.NewBaseHandler(),
+		stockTakeService: stockTakeService,
+	}
+}
+
+// StartStockTake opens a stock-take session for a location
+// POST /api/inventory/stock-takes
+func (h *StockTakeHandler) StartStockTake(c *gin.Context) {
+	var req model.StartStockTakeRequest
+	if err := h.BindJSON(c, &req); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	userID, sellerID, err := auth.ValidateUserHasSellerRoleOrHigherAndReturnAuthData(c)
+	if err != nil {
+		h.HandleError(c, err, constants.UNAUTHORIZED_ERROR_MSG)
+		return
+	}
+
+	response, err := h.stockTakeService.StartStockTake(c, sellerID, userID, req)
+	if err != nil {
+		h.HandleError(c, err, invConstants.FAILED_TO_START_STOCK_TAKE_MSG)
+		return
+	}
+
+	h.SuccessWithData(
+		c,
+		http.StatusCreated,
+		invConstants.STOCK_TAKE_STARTED_MSG,
+		invConstants.STOCK_TAKE_FIELD_NAME,
+		response,
+	)
+}
+
+// ListStockTakes lists the seller's stock-take sessions
+// GET /api/inventory/stock-takes
+func (h *StockTakeHandler) ListStockTakes(c *gin.Context) {
+	var params model.StockTakesQueryParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	_, sellerID, err := auth.ValidateUserHasSellerRoleOrHigherAndReturnAuthData(c)
+	if err != nil {
+		h.HandleError(c, err, constants.UNAUTHORIZED_ERROR_MSG)
+		return
+	}
+
+	response, err := h.stockTakeService.ListStockTakes(c, sellerID, params)
+	if err != nil {
+		h.HandleError(c, err, invConstants.FAILED_TO_LIST_STOCK_TAKES_MSG)
+		return
+	}
+
+	h.SuccessWithData(
+		c,
+		http.StatusOK,
+		invConstants.STOCK_TAKES_LISTED_MSG,
+		invConstants.STOCK_TAKES_FIELD_NAME,
+		response,
+	)
+}
+
+// GetStockTake returns a session with its counted lines and discrepancies
+// GET /api/inventory/stock-takes/:stockTakeId
+func (h *StockTakeHandler) GetStockTake(c *gin.Context) {
+	stockTakeID, err := h.ParseUintParam(c, "stockTakeId")
+	if err != nil {
+		h.HandleError(c, err, invConstants.INVALID_STOCK_TAKE_ID_MSG)
+		return
+	}
+
+	_, sellerID, err := auth.ValidateUserHasSellerRoleOrHigherAndReturnAuthData(c)
+	if err != nil {
+		h.HandleError(c, err, constants.UNAUTHORIZED_ERROR_MSG)
+		return
+	}
+
+	response, err := h.stockTakeService.GetStockTake(c, sellerID, stockTakeID)
+	if err != nil {
+		h.HandleError(c, err, invConstants.FAILED_TO_GET_STOCK_TAKE_MSG)
+		return
+	}
+
+	h.SuccessWithData(
+		c,
+		http.StatusOK,
+		invConstants.STOCK_TAKE_RETRIEVED_MSG,
+		invConstants.STOCK_TAKE_FIELD_NAME,
+		response,
+	)
+}
+
+// SubmitCounts records counted quantities for variants at the session's location
+// PUT /api/inventory/stock-takes/:stockTakeId/counts
+func (h *StockTakeHandler) SubmitCounts(c *gin.Context) {
+	stockTakeID, err := h.ParseUintParam(c, "stockTakeId")
+	if err != nil {
+		h.HandleError(c, err, invConstants.INVALID_STOCK_TAKE_ID_MSG)
+		return
+	}
+
+	var req model.SubmitStockTakeCountsRequest
+	if err := h.BindJSON(c, &req); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	userID, sellerID, err := auth.ValidateUserHasSellerRoleOrHigherAndReturnAuthData(c)
+	if err != nil {
+		h.HandleError(c, err, constants.UNAUTHORIZED_ERROR_MSG)
+		return
+	}
+
+	response, err := h.stockTakeService.SubmitCounts(c, sellerID, userID, stockTakeID, req)
+	if err != nil {
+		h.HandleError(c, err, invConstants.FAILED_TO_SUBMIT_COUNTS_MSG)
+		return
+	}
+
+	h.SuccessWithData(
+		c,
+		http.StatusOK,
+		invConstants.STOCK_TAKE_COUNTS_SUBMITTED_MSG,
+		invConstants.STOCK_TAKE_FIELD_NAME,
+		response,
+	)
+}
+
+// ApplyStockTake records the discrepancies as inventory movements
+// POST /api/inventory/stock-takes/:stockTakeId/apply
+func (h *StockTakeHandler) ApplyStockTake(c *gin.Context) {
+	stockTakeID, err := h.ParseUintParam(c, "stockTakeId")
+	if err != nil {
+		h.HandleError(c, err, invConstants.INVALID_STOCK_TAKE_ID_MSG)
+		return
+	}
+
+	userID, sellerID, err := auth.ValidateUserHasSellerRoleOrHigherAndReturnAuthData(c)
+	if err != nil {
+		h.HandleError(c, err, constants.UNAUTHORIZED_ERROR_MSG)
+		return
+	}
+
+	response, err := h.stockTakeService.ApplyStockTake(c, sellerID, userID, stockTakeID)
+	if err != nil {
+		log.ErrorWithContext(c, "applyStockTake: failed", err)
+		h.HandleError(c, err, invConstants.FAILED_TO_APPLY_STOCK_TAKE_MSG)
+		return
+	}
+
+	h.SuccessWithData(
+		c,
+		http.StatusOK,
+		invConstants.STOCK_TAKE_APPLIED_MSG,
+		invConstants.STOCK_TAKE_FIELD_NAME,
+		response,
+	)
+}
+
+// CancelStockTake closes a session without changing stock
+// POST /api/inventory/stock-takes/:stockTakeId/cancel
+func (h *StockTakeHandler) CancelStockTake(c *gin.Context) {
+	stockTakeID, err := h.ParseUintParam(c, "stockTakeId")
+	if err != nil {
+		h.HandleError(c, err, invConstants.INVALID_STOCK_TAKE_ID_MSG)
+		return
+	}
+
+	_, sellerID, err := auth.ValidateUserHasSellerRoleOrHigherAndReturnAuthData(c)
+	if err != nil {
+		h.HandleError(c, err, constants.UNAUTHORIZED_ERROR_MSG)
+		return
+	}
+
+	response, err := h.stockTakeService.CancelStockTake(c, sellerID, stockTakeID)
+	if err != nil {
+		h.HandleError(c, err, invConstants.FAILED_TO_CANCEL_STOCK_TAKE_MSG)
+		return
+	}
+
+	h.SuccessWithData(
+		c,
+		http.StatusOK,
+		invConstants.STOCK_TAKE_CANCELLED_MSG,
+		invConstants.STOCK_TAKE_FIELD_NAME,
+		response,
+	)
+}
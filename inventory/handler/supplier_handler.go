@@ -0,0 +1,177 @@
+package handler
+
+import (
+	"net/http"
+
+	"ecommerce-be/common/auth"
+	"ecommerce-be/common/constants"
+	"ecommerce-be/common/handler"
+	"ecommerce-be/inventory/model"
+	"ecommerce-be/inventory/service"
+	invConstants "ecommerce-be/inventory/utils/constant"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SupplierHandler handles HTTP requests for suppliers
+type SupplierHandler struct {
+	*handler.BaseHandler
+	supplierService service.SupplierService
+}
+
+// NewSupplierHandler creates a new instance of SupplierHandler
+func NewSupplierHandler(supplierService service.SupplierService) *SupplierHandler {
+	return &SupplierHandler{
+		BaseHandler:     handler.NewBaseHandler(),
+		supplierService: supplierService,
+	}
+}
+
+// CreateSupplier creates a supplier
+// POST /api/inventory/suppliers
+func (h *SupplierHandler) CreateSupplier(c *gin.Context) {
+	var req model.CreateSupplierRequest
+	if err := h.BindJSON(c, &req); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	_, sellerID, err := auth.ValidateUserHasSellerRoleOrHigherAndReturnAuthData(c)
+	if err != nil {
+		h.HandleError(c, err, constants.UNAUTHORIZED_ERROR_MSG)
+		return
+	}
+
+	response, err := h.supplierService.CreateSupplier(c, sellerID, req)
+	if err != nil {
+		h.HandleError(c, err, invConstants.FAILED_TO_CREATE_SUPPLIER_MSG)
+		return
+	}
+
+	h.SuccessWithData(
+		c,
+		http.StatusCreated,
+		invConstants.SUPPLIER_CREATED_MSG,
+		invConstants.SUPPLIER_FIELD_NAME,
+		response,
+	)
+}
+
+// ListSuppliers lists the seller's suppliers
+// GET /api/inventory/suppliers
+func (h *SupplierHandler) ListSuppliers(c *gin.Context) {
+	var params model.SuppliersQueryParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	_, sellerID, err := auth.ValidateUserHasSellerRoleOrHigherAndReturnAuthData(c)
+	if err != nil {
+		h.HandleError(c, err, constants.UNAUTHORIZED_ERROR_MSG)
+		return
+	}
+
+	response, err := h.supplierService.ListSuppliers(c, sellerID, params)
+	if err != nil {
+		h.HandleError(c, err, invConstants.FAILED_TO_GET_SUPPLIERS_MSG)
+		return
+	}
+
+	h.SuccessWithData(
+		c,
+		http.StatusOK,
+		invConstants.SUPPLIERS_RETRIEVED_MSG,
+		invConstants.SUPPLIERS_FIELD_NAME,
+		response,
+	)
+}
+
+// GetSupplier returns a supplier
+// GET /api/inventory/suppliers/:supplierId
+func (h *SupplierHandler) GetSupplier(c *gin.Context) {
+	supplierID, err := h.ParseUintParam(c, "supplierId")
+	if err != nil {
+		h.HandleError(c, err, invConstants.INVALID_SUPPLIER_ID_MSG)
+		return
+	}
+
+	_, sellerID, err := auth.ValidateUserHasSellerRoleOrHigherAndReturnAuthData(c)
+	if err != nil {
+		h.HandleError(c, err, constants.UNAUTHORIZED_ERROR_MSG)
+		return
+	}
+
+	response, err := h.supplierService.GetSupplier(c, sellerID, supplierID)
+	if err != nil {
+		h.HandleError(c, err, invConstants.FAILED_TO_GET_SUPPLIER_MSG)
+		return
+	}
+
+	h.SuccessWithData(
+		c,
+		http.StatusOK,
+		invConstants.SUPPLIER_RETRIEVED_MSG,
+		invConstants.SUPPLIER_FIELD_NAME,
+		response,
+	)
+}
+
+// UpdateSupplier updates a supplier
+// PUT /api/inventory/suppliers/:supplierId
+func (h *SupplierHandler) UpdateSupplier(c *gin.Context) {
+	supplierID, err := h.ParseUintParam(c, "supplierId")
+	if err != nil {
+		h.HandleError(c, err, invConstants.INVALID_SUPPLIER_ID_MSG)
+		return
+	}
+
+	var req model.UpdateSupplierRequest
+	if err := h.BindJSON(c, &req); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	_, sellerID, err := auth.ValidateUserHasSellerRoleOrHigherAndReturnAuthData(c)
+	if err != nil {
+		h.HandleError(c, err, constants.UNAUTHORIZED_ERROR_MSG)
+		return
+	}
+
+	response, err := h.supplierService.UpdateSupplier(c, sellerID, supplierID, req)
+	if err != nil {
+		h.HandleError(c, err, invConstants.FAILED_TO_UPDATE_SUPPLIER_MSG)
+		return
+	}
+
+	h.SuccessWithData(
+		c,
+		http.StatusOK,
+		invConstants.SUPPLIER_UPDATED_MSG,
+		invConstants.SUPPLIER_FIELD_NAME,
+		response,
+	)
+}
+
+// DeleteSupplier deletes a supplier without purchase orders
+// DELETE /api/inventory/suppliers/:supplierId
+func (h *SupplierHandler) DeleteSupplier(c *gin.Context) {
+	supplierID, err := h.ParseUintParam(c, "supplierId")
+	if err != nil {
+		h.HandleError(c, err, invConstants.INVALID_SUPPLIER_ID_MSG)
+		return
+	}
+
+	_, sellerID, err := auth.ValidateUserHasSellerRoleOrHigherAndReturnAuthData(c)
+	if err != nil {
+		h.HandleError(c, err, constants.UNAUTHORIZED_ERROR_MSG)
+		return
+	}
+
+	if err := h.supplierService.DeleteSupplier(c, sellerID, supplierID); err != nil {
+		h.HandleError(c, err, invConstants.FAILED_TO_DELETE_SUPPLIER_MSG)
+		return
+	}
+
+	h.Success(c, http.StatusOK, invConstants.SUPPLIER_DELETED_MSG, nil)
+}
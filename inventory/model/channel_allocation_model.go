@@ -0,0 +1,50 @@
+package model
+
+// ChannelAllocationRuleRequest creates or replaces the rule of one channel.
+// Omit variantId to set the seller default applied to every variant.
+type ChannelAllocationRuleRequest struct {
+	VariantID      *uint  `json:"variantId"      binding:"omitempty,gt=0"`
+	Channel        string `json:"channel"        binding:"required"`
+	ReservePercent int    `json:"reservePercent" binding:"gte=0,lte=100"`
+	BufferQuantity int    `json:"bufferQuantity" binding:"gte=0"`
+}
+
+// ChannelAllocationRulesParam filters listed rules to one variant's own rules
+type ChannelAllocationRulesParam struct {
+	VariantID *uint `form:"variantId" binding:"omitempty,gt=0"`
+}
+
+// ChannelAllocationRuleResponse is a stored allocation rule
+type ChannelAllocationRuleResponse struct {
+	ID             uint   `json:"id"`
+	VariantID      *uint  `json:"variantId,omitempty"`
+	Channel        string `json:"channel"`
+	ReservePercent int    `json:"reservePercent"`
+	BufferQuantity int    `json:"bufferQuantity"`
+}
+
+// ChannelAvailabilityRequest selects the variants to report per-channel availability for
+type ChannelAvailabilityRequest struct {
+	VariantIDs []uint `json:"variantIds"`
+	ProductIDs []uint `json:"productIds"`
+}
+
+// ChannelAvailability is what one channel can sell of a variant after allocation rules
+type ChannelAvailability struct {
+	Channel        string `json:"channel"`
+	Available      int    `json:"available"`
+	ReservePercent int    `json:"reservePercent"`
+	BufferQuantity int    `json:"bufferQuantity"`
+}
+
+// VariantChannelAvailability reports a variant's pooled stock and its split per channel
+type VariantChannelAvailability struct {
+	VariantID       uint                  `json:"variantId"`
+	PooledAvailable int                   `json:"pooledAvailable"`
+	Channels        []ChannelAvailability `json:"channels"`
+}
+
+// ChannelAvailabilityResponse models the per-channel availability report
+type ChannelAvailabilityResponse struct {
+	Items []VariantChannelAvailability `json:"items"`
+}
@@ -16,6 +16,14 @@ type ManageInventoryRequest struct {
 	// Optional: Only required for ADJUSTMENT type
 	Direction *entity.AdjustmentType `json:"direction" binding:"omitempty"` // Validated by validator
 
+	// Optional: Only for RETURN type. RESTOCK (default) adds the units to quantity;
+	// DAMAGED and QUARANTINE hold them in their own stock state instead.
+	Disposition *entity.ReturnDisposition `json:"disposition" binding:"omitempty"` // Validated by validator
+
+	// Optional: Only for PURCHASE. Cost paid per unit of the restock lot, used by the
+	// inventory valuation report.
+	UnitCostCents *int64 `json:"unitCostCents" binding:"omitempty,min=0"` // Validated by validator
+
 	// Optional: Update threshold (for backorder limits)
 	Threshold *int `json:"threshold" binding:"omitempty"`
 
@@ -24,8 +32,16 @@ type ManageInventoryRequest struct {
 	// Optional for: ADJUSTMENT, DAMAGE, REFRESH
 	Reference *string `json:"reference" binding:"omitempty,min=1,max=100"`
 
+	// Optional: classifies the movement; defaults to the transaction type's usual
+	// reason (e.g. SALE for OUTBOUND, MANUAL_CORRECTION for ADJUSTMENT)
+	ReasonCode *entity.MovementReason `json:"reasonCode" binding:"omitempty"` // Validated by validator
+
 	Reason string  `json:"reason" binding:"required,min=5,max=500"`
 	Note   *string `json:"note"   binding:"omitempty,max=1000"`
+
+	// Optional: reject the request with 409 unless the inventory row is still at this
+	// version. A row that does not exist yet has version 0.
+	ExpectedVersion *int64 `json:"expectedVersion" binding:"omitempty,min=0"`
 }
 
 // InventoryResponse represents inventory data in API response
@@ -39,6 +55,10 @@ type InventoryResponse struct {
 	AvailableQuantity int         `json:"availableQuantity"`     // Computed: Quantity - ReservedQuantity
 	StockStatus       StockStatus `json:"stockStatus"`           // IN_STOCK, LOW_STOCK, OUT_OF_STOCK
 	BinLocation       string      `json:"binLocation,omitempty"` // Specific bin/shelf location within warehouse
+
+	// Returned units held out of stock; never part of AvailableQuantity
+	DamagedQuantity     int `json:"damagedQuantity"`
+	QuarantinedQuantity int `json:"quarantinedQuantity"`
 }
 
 // ManageInventoryResponse represents the response after managing inventory
@@ -50,6 +70,12 @@ type ManageInventoryResponse struct {
 	AvailableQuantity int  `json:"availableQuantity"`
 	Threshold         int  `json:"threshold"`
 	TransactionID     uint `json:"transactionId"`
+	// Version of the inventory row after this operation
+	Version int64 `json:"version"`
+
+	// Returned units held out of stock after this operation
+	DamagedQuantity     int `json:"damagedQuantity"`
+	QuarantinedQuantity int `json:"quarantinedQuantity"`
 }
 
 // BulkManageInventoryRequest represents bulk inventory management request
@@ -130,6 +156,8 @@ type InventoryResponseWithPagination struct {
 type TotalAvailableQuantityRequest struct {
 	VariantIDs []uint `json:"variantIds" form:"variantIds"`
 	ProductIDs []uint `json:"productIds" form:"productIds"`
+	// Channel limits totals to what that sales channel may sell under allocation rules
+	Channel string `json:"channel" form:"channel"`
 }
 
 // VariantAvailableQuantity models the resulting stock sum
@@ -11,6 +11,8 @@ type ReservationRequest struct {
 	ReferenceId      uint              `json:"referenceId"      binding:"required"`
 	ExpiresInMinutes uint              `json:"expiresInMinutes" binding:"required,gt=0"`
 	Items            []ReservationItem `json:"items"            binding:"required,min=1,dive"`
+	// Channel applies that sales channel's allocation rules (web, pos, marketplace)
+	Channel string `json:"channel,omitempty"`
 }
 
 type Resevation struct {
@@ -0,0 +1,77 @@
+package model
+
+import (
+	"time"
+
+	"ecommerce-be/inventory/entity"
+)
+
+// InventoryValuationParams are the valuation report query parameters
+type InventoryValuationParams struct {
+	// Period start and end, RFC 3339. The end is exclusive.
+	From string `form:"from" binding:"required"`
+	To   string `form:"to"   binding:"required"`
+
+	// Method overrides the seller's costing method for this report
+	Method    string `form:"method"    binding:"omitempty,oneof=FIFO AVERAGE"`
+	VariantID *uint  `form:"variantId" binding:"omitempty,gt=0"`
+}
+
+// ValuationLedgerEntry is one stock movement of a variant read from the stock ledger
+type ValuationLedgerEntry struct {
+	VariantID      uint
+	Type           entity.TransactionType
+	QuantityChange int
+	UnitCostCents  *int64
+	CreatedAt      time.Time
+}
+
+// VariantValuation is the valuation of one variant's stock across the seller's locations.
+// Transfers between locations do not change it.
+type VariantValuation struct {
+	VariantID uint `json:"variantId"`
+
+	OpeningQuantity   int   `json:"openingQuantity"`
+	OpeningValueCents int64 `json:"openingValueCents"`
+
+	// Restock lots received in the period
+	PurchasedQuantity   int   `json:"purchasedQuantity"`
+	PurchasedValueCents int64 `json:"purchasedValueCents"`
+	// UncostedQuantity is purchased units without a unit cost; they enter at the cost
+	// of stock on hand
+	UncostedQuantity int `json:"uncostedQuantity"`
+
+	// Returns, upward adjustments and counts, valued at the cost of stock on hand
+	AdjustedInQuantity   int   `json:"adjustedInQuantity"`
+	AdjustedInValueCents int64 `json:"adjustedInValueCents"`
+
+	// Units shipped to customers and their cost of goods sold
+	SoldQuantity int   `json:"soldQuantity"`
+	COGSCents    int64 `json:"cogsCents"`
+
+	// Damage, downward adjustments and counts
+	WrittenOffQuantity   int   `json:"writtenOffQuantity"`
+	WrittenOffValueCents int64 `json:"writtenOffValueCents"`
+
+	ClosingQuantity   int   `json:"closingQuantity"`
+	ClosingValueCents int64 `json:"closingValueCents"`
+}
+
+// InventoryValuationTotals sums the variant values of a report
+type InventoryValuationTotals struct {
+	OpeningValueCents    int64 `json:"openingValueCents"`
+	PurchasedValueCents  int64 `json:"purchasedValueCents"`
+	AdjustedInValueCents int64 `json:"adjustedInValueCents"`
+	COGSCents            int64 `json:"cogsCents"`
+	WrittenOffValueCents int64 `json:"writtenOffValueCents"`
+	ClosingValueCents    int64 `json:"closingValueCents"`
+}
+
+// InventoryValuationResponse is the inventory valuation and COGS of a period
+type InventoryValuationResponse struct {
+	Method   string                   `json:"method"`
+	From     time.Time                `json:"from"`
+	To       time.Time                `json:"to"`
+	Variants []VariantValuation       `json:"variants"`
+	Totals   InventoryValuationTotals `json:"totals"`
+}
@@ -0,0 +1,84 @@
+package model
+
+import (
+	"time"
+
+	"ecommerce-be/inventory/entity"
+)
+
+// PurchaseOrderLineRequest orders a quantity of one variant at an agreed unit cost
+type PurchaseOrderLineRequest struct {
+	VariantID       uint   `json:"variantId"       binding:"required"`
+	QuantityOrdered int    `json:"quantityOrdered" binding:"required,gt=0"`
+	UnitCostCents   *int64 `json:"unitCostCents"   binding:"required,min=0"`
+}
+
+// CreatePurchaseOrderRequest creates a draft purchase order
+type CreatePurchaseOrderRequest struct {
+	SupplierID        uint                       `json:"supplierId"        binding:"required"`
+	LocationID        uint                       `json:"locationId"        binding:"required"`
+	SupplierReference *string                    `json:"supplierReference" binding:"omitempty,max=100"`
+	ExpectedAt        *time.Time                 `json:"expectedAt"        binding:"omitempty"`
+	Note              *string                    `json:"note"              binding:"omitempty,max=1000"`
+	Lines             []PurchaseOrderLineRequest `json:"lines"             binding:"required,min=1,max=200,dive"`
+}
+
+// UpdatePurchaseOrderRequest edits a draft purchase order; lines, when given, replace
+// all existing lines
+type UpdatePurchaseOrderRequest struct {
+	SupplierID        *uint                      `json:"supplierId"        binding:"omitempty,gt=0"`
+	LocationID        *uint                      `json:"locationId"        binding:"omitempty,gt=0"`
+	SupplierReference *string                    `json:"supplierReference" binding:"omitempty,max=100"`
+	ExpectedAt        *time.Time                 `json:"expectedAt"        binding:"omitempty"`
+	Note              *string                    `json:"note"              binding:"omitempty,max=1000"`
+	Lines             []PurchaseOrderLineRequest `json:"lines"             binding:"omitempty,min=1,max=200,dive"`
+}
+
+// ReceivePurchaseOrderItem is a delivered quantity of one ordered variant. UnitCostCents
+// overrides the ordered cost when the supplier invoiced a different price.
+type ReceivePurchaseOrderItem struct {
+	VariantID     uint   `json:"variantId"     binding:"required"`
+	Quantity      int    `json:"quantity"      binding:"required,gt=0"`
+	UnitCostCents *int64 `json:"unitCostCents" binding:"omitempty,min=0"`
+}
+
+// ReceivePurchaseOrderRequest records a delivery against a purchase order
+type ReceivePurchaseOrderRequest struct {
+	Items []ReceivePurchaseOrderItem `json:"items" binding:"required,min=1,max=200,dive"`
+	Note  *string                    `json:"note"  binding:"omitempty,max=1000"`
+}
+
+// PurchaseOrdersQueryParams filters the seller's purchase orders
+type PurchaseOrdersQueryParams struct {
+	Status     *entity.PurchaseOrderStatus `form:"status"     binding:"omitempty,oneof=DRAFT SENT PARTIALLY_RECEIVED CLOSED"`
+	SupplierID *uint                       `form:"supplierId" binding:"omitempty,gt=0"`
+}
+
+// PurchaseOrderLineResponse is an ordered variant and its delivery progress
+type PurchaseOrderLineResponse struct {
+	ID                uint  `json:"id"`
+	VariantID         uint  `json:"variantId"`
+	QuantityOrdered   int   `json:"quantityOrdered"`
+	QuantityReceived  int   `json:"quantityReceived"`
+	QuantityRemaining int   `json:"quantityRemaining"`
+	UnitCostCents     int64 `json:"unitCostCents"`
+	LineTotalCents    int64 `json:"lineTotalCents"` // Ordered quantity at the ordered cost
+}
+
+// PurchaseOrderResponse represents a purchase order in API responses
+type PurchaseOrderResponse struct {
+	ID                uint                        `json:"id"`
+	Number            string                      `json:"number"`
+	SupplierID        uint                        `json:"supplierId"`
+	LocationID        uint                        `json:"locationId"`
+	Status            entity.PurchaseOrderStatus  `json:"status"`
+	SupplierReference *string                     `json:"supplierReference,omitempty"`
+	ExpectedAt        *string                     `json:"expectedAt,omitempty"`
+	Note              *string                     `json:"note,omitempty"`
+	CreatedBy         uint                        `json:"createdBy"`
+	SentAt            *string                     `json:"sentAt,omitempty"`
+	ClosedAt          *string                     `json:"closedAt,omitempty"`
+	CreatedAt         string                      `json:"createdAt"`
+	TotalCostCents    int64                       `json:"totalCostCents"`
+	Lines             []PurchaseOrderLineResponse `json:"lines"`
+}
@@ -0,0 +1,46 @@
+package model
+
+// StockCheckItem is one variant quantity to check
+type StockCheckItem struct {
+	VariantID uint `json:"variantId" binding:"required"`
+	Quantity  int  `json:"quantity"  binding:"required,gt=0"`
+}
+
+// StockCheckRequest checks a whole cart in one call. Repeated variants are summed so the
+// check covers their combined quantity.
+type StockCheckRequest struct {
+	Items []StockCheckItem `json:"items" binding:"required,min=1,max=200,dive"`
+	// Channel checks against what that sales channel may sell under allocation rules
+	Channel string `json:"channel"`
+}
+
+// StockCheckLocation is the stock of a variant at one active location
+type StockCheckLocation struct {
+	LocationID   uint   `json:"locationId"`
+	LocationName string `json:"locationName"`
+	// AvailableQuantity is quantity - reserved - threshold, never below zero
+	AvailableQuantity int `json:"availableQuantity"`
+}
+
+// StockCheckResult is the availability of one requested variant
+type StockCheckResult struct {
+	VariantID         uint `json:"variantId"`
+	RequestedQuantity int  `json:"requestedQuantity"`
+	// AvailableQuantity is the free stock across the seller's active locations
+	AvailableQuantity int `json:"availableQuantity"`
+	// ReservableQuantity is what a reservation for the channel could take right now
+	ReservableQuantity int  `json:"reservableQuantity"`
+	InStock            bool `json:"inStock"`
+	// Locations lists the variant's stock by location, highest priority first. It is
+	// empty when the variant has no inventory at any active location.
+	Locations []StockCheckLocation `json:"locations"`
+	// StockUntracked marks variants of digital products: they hold no inventory and
+	// are always in stock, with the requested quantity reported as available
+	StockUntracked bool `json:"stockUntracked,omitempty"`
+}
+
+// StockCheckResponse reports every requested variant in request order
+type StockCheckResponse struct {
+	Items      []StockCheckResult `json:"items"`
+	AllInStock bool               `json:"allInStock"`
+}
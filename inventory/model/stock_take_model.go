@@ -0,0 +1,59 @@
+package model
+
+import "ecommerce-be/inventory/entity"
+
+// StartStockTakeRequest opens a stock-take session for one location
+type StartStockTakeRequest struct {
+	LocationID uint    `json:"locationId" binding:"required"`
+	Note       *string `json:"note"       binding:"omitempty,max=500"`
+}
+
+// StockTakeCountItem is the counted quantity of one variant
+type StockTakeCountItem struct {
+	VariantID       uint `json:"variantId"       binding:"required"`
+	CountedQuantity *int `json:"countedQuantity" binding:"required,min=0"`
+}
+
+// SubmitStockTakeCountsRequest records counts; recounting a variant replaces its count
+type SubmitStockTakeCountsRequest struct {
+	Items []StockTakeCountItem `json:"items" binding:"required,min=1,max=500,dive"`
+}
+
+// StockTakesQueryParams filters the seller's stock takes
+type StockTakesQueryParams struct {
+	Status     *entity.StockTakeStatus `form:"status"     binding:"omitempty,oneof=OPEN APPLIED CANCELLED"`
+	LocationID *uint                   `form:"locationId" binding:"omitempty,gt=0"`
+}
+
+// StockTakeLineResponse is a counted variant and its discrepancy
+type StockTakeLineResponse struct {
+	VariantID       uint   `json:"variantId"`
+	SystemQuantity  int    `json:"systemQuantity"`
+	CountedQuantity int    `json:"countedQuantity"`
+	Discrepancy     int    `json:"discrepancy"` // Counted minus system; negative means missing
+	CountedBy       uint   `json:"countedBy"`
+	CountedAt       string `json:"countedAt"`
+	TransactionID   *uint  `json:"transactionId,omitempty"` // Set once applied
+}
+
+// StockTakeSummary totals the discrepancies of a stock take
+type StockTakeSummary struct {
+	CountedItems     int `json:"countedItems"`
+	DiscrepancyItems int `json:"discrepancyItems"`
+	UnitsOver        int `json:"unitsOver"`  // Units found beyond the system quantity
+	UnitsShort       int `json:"unitsShort"` // Units missing against the system quantity
+}
+
+// StockTakeResponse is a stock-take session; lines are only included for a single session
+type StockTakeResponse struct {
+	ID         uint                    `json:"id"`
+	LocationID uint                    `json:"locationId"`
+	Status     entity.StockTakeStatus  `json:"status"`
+	Note       *string                 `json:"note,omitempty"`
+	StartedBy  uint                    `json:"startedBy"`
+	AppliedBy  *uint                   `json:"appliedBy,omitempty"`
+	AppliedAt  *string                 `json:"appliedAt,omitempty"`
+	CreatedAt  string                  `json:"createdAt"`
+	Summary    StockTakeSummary        `json:"summary"`
+	Lines      []StockTakeLineResponse `json:"lines,omitempty"`
+}
@@ -0,0 +1,36 @@
+package model
+
+// CreateSupplierRequest represents the request body for creating a supplier
+type CreateSupplierRequest struct {
+	Name        string  `json:"name"        binding:"required,min=2,max=200"`
+	ContactName *string `json:"contactName" binding:"omitempty,max=200"`
+	Email       *string `json:"email"       binding:"omitempty,email,max=255"`
+	Phone       *string `json:"phone"       binding:"omitempty,max=30"`
+	Notes       *string `json:"notes"       binding:"omitempty,max=1000"`
+}
+
+// UpdateSupplierRequest represents the request body for updating a supplier
+type UpdateSupplierRequest struct {
+	Name        *string `json:"name"        binding:"omitempty,min=2,max=200"`
+	ContactName *string `json:"contactName" binding:"omitempty,max=200"`
+	Email       *string `json:"email"       binding:"omitempty,email,max=255"`
+	Phone       *string `json:"phone"       binding:"omitempty,max=30"`
+	Notes       *string `json:"notes"       binding:"omitempty,max=1000"`
+	IsActive    *bool   `json:"isActive"    binding:"omitempty"`
+}
+
+// SuppliersQueryParams filters the seller's suppliers
+type SuppliersQueryParams struct {
+	IsActive *bool `form:"isActive" binding:"omitempty"`
+}
+
+// SupplierResponse represents a supplier in API responses
+type SupplierResponse struct {
+	ID          uint    `json:"id"`
+	Name        string  `json:"name"`
+	ContactName *string `json:"contactName,omitempty"`
+	Email       *string `json:"email,omitempty"`
+	Phone       *string `json:"phone,omitempty"`
+	Notes       *string `json:"notes,omitempty"`
+	IsActive    bool    `json:"isActive"`
+}
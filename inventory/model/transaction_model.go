@@ -36,6 +36,15 @@ type CreateTransactionParams struct {
 	BeforeReservedQuantity int
 	AfterReservedQuantity  int
 
+	// ========== RETURN DISPOSITION ==========
+	// Disposition of RETURN transactions; HeldQuantityChange is the number of units
+	// moved into the damaged or quarantined state
+	Disposition        *entity.ReturnDisposition
+	HeldQuantityChange int
+
+	// Cost paid per unit of a PURCHASE restock lot
+	UnitCostCents *int64
+
 	// Who performed this transaction
 	PerformedBy uint
 
@@ -43,9 +52,10 @@ type CreateTransactionParams struct {
 	Reference     *string
 	ReferenceType string
 
-	// Reason and notes
-	Reason string
-	Note   *string
+	// Reason code, reason and notes
+	ReasonCode entity.MovementReason
+	Reason     string
+	Note       *string
 }
 
 // ============================================================================
@@ -61,6 +71,7 @@ type ListTransactionsQueryParams struct {
 	Types         string `form:"types"`
 	ReferenceID   string `form:"referenceId"`
 	ReferenceType string `form:"referenceType"`
+	ReasonCodes   string `form:"reasonCodes"`
 	PerformedBy   *uint  `form:"performedBy"`
 	CreatedFrom   string `form:"createdFrom"`
 	CreatedTo     string `form:"createdTo"`
@@ -74,6 +85,7 @@ type ListTransactionsFilter struct {
 	VariantIDs   []uint
 	LocationIDs  []uint
 	Types        []entity.TransactionType
+	ReasonCodes  []entity.MovementReason
 
 	// Single value filters
 	ReferenceID   *string
@@ -116,6 +128,14 @@ func (p *ListTransactionsQueryParams) ToFilter() ListTransactionsFilter {
 		}
 	}
 
+	// Parse reason codes
+	reasonStrings := helper.ParseCommaSeparated[string](p.ReasonCodes)
+	for _, rs := range reasonStrings {
+		if reason, err := entity.ParseMovementReason(rs); err == nil {
+			filter.ReasonCodes = append(filter.ReasonCodes, reason)
+		}
+	}
+
 	// Parse dates
 	if p.CreatedFrom != "" {
 		if t, err := time.Parse(time.RFC3339, p.CreatedFrom); err == nil {
@@ -131,6 +151,37 @@ func (p *ListTransactionsQueryParams) ToFilter() ListTransactionsFilter {
 	return filter
 }
 
+// VariantMovementsQueryParams represents the filters of a variant's movement history
+type VariantMovementsQueryParams struct {
+	common.BaseListParams
+	LocationIDs   string `form:"locationIds"`
+	Types         string `form:"types"`
+	ReasonCodes   string `form:"reasonCodes"`
+	ReferenceID   string `form:"referenceId"`
+	ReferenceType string `form:"referenceType"`
+	PerformedBy   *uint  `form:"performedBy"`
+	CreatedFrom   string `form:"createdFrom"`
+	CreatedTo     string `form:"createdTo"`
+}
+
+// ToFilter converts the query params to a transaction filter for one variant
+func (p *VariantMovementsQueryParams) ToFilter(variantID uint) ListTransactionsFilter {
+	params := ListTransactionsQueryParams{
+		BaseListParams: p.BaseListParams,
+		LocationIDs:    p.LocationIDs,
+		Types:          p.Types,
+		ReasonCodes:    p.ReasonCodes,
+		ReferenceID:    p.ReferenceID,
+		ReferenceType:  p.ReferenceType,
+		PerformedBy:    p.PerformedBy,
+		CreatedFrom:    p.CreatedFrom,
+		CreatedTo:      p.CreatedTo,
+	}
+	filter := params.ToFilter()
+	filter.VariantIDs = []uint{variantID}
+	return filter
+}
+
 // ============================================================================
 // List Transactions Response
 // ============================================================================
@@ -154,13 +205,21 @@ type TransactionResponse struct {
 	BeforeReservedQuantity int `json:"beforeReservedQuantity"`
 	AfterReservedQuantity  int `json:"afterReservedQuantity"`
 
-	PerformedBy     uint    `json:"performedBy"`
-	PerformedByName string  `json:"performedByName"`
-	ReferenceID     *string `json:"referenceId,omitempty"`
-	ReferenceType   *string `json:"referenceType,omitempty"`
-	Reason          string  `json:"reason"`
-	Note            *string `json:"note,omitempty"`
-	CreatedAt       string  `json:"createdAt"`
+	// Return disposition
+	Disposition        *entity.ReturnDisposition `json:"disposition,omitempty"`
+	HeldQuantityChange int                       `json:"heldQuantityChange"`
+
+	// Cost per unit of a PURCHASE restock lot
+	UnitCostCents *int64 `json:"unitCostCents,omitempty"`
+
+	PerformedBy     uint                  `json:"performedBy"`
+	PerformedByName string                `json:"performedByName"`
+	ReferenceID     *string               `json:"referenceId,omitempty"`
+	ReferenceType   *string               `json:"referenceType,omitempty"`
+	ReasonCode      entity.MovementReason `json:"reasonCode"`
+	Reason          string                `json:"reason"`
+	Note            *string               `json:"note,omitempty"`
+	CreatedAt       string                `json:"createdAt"`
 }
 
 // ListTransactionsResponse contains the paginated list of transactions
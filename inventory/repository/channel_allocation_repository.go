@@ -0,0 +1,118 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"ecommerce-be/common/db"
+	"ecommerce-be/inventory/entity"
+	invErrors "ecommerce-be/inventory/error"
+
+	"gorm.io/gorm"
+)
+
+// ChannelAllocationRepository defines data access for channel allocation rules
+type ChannelAllocationRepository interface {
+	Save(ctx context.Context, rule *entity.ChannelAllocationRule) error
+	FindByID(ctx context.Context, id uint, sellerID uint) (*entity.ChannelAllocationRule, error)
+	// FindBySellerID returns every rule of the seller, defaults first
+	FindBySellerID(ctx context.Context, sellerID uint) ([]entity.ChannelAllocationRule, error)
+	// FindByScope returns the seller defaults when variantID is nil, else the variant's own rules
+	FindByScope(
+		ctx context.Context,
+		sellerID uint,
+		variantID *uint,
+	) ([]entity.ChannelAllocationRule, error)
+	// FindApplicable returns the seller defaults plus the rules of the given variants
+	FindApplicable(
+		ctx context.Context,
+		sellerID uint,
+		variantIDs []uint,
+	) ([]entity.ChannelAllocationRule, error)
+	Delete(ctx context.Context, id uint) error
+}
+
+// ChannelAllocationRepositoryImpl implements ChannelAllocationRepository
+type ChannelAllocationRepositoryImpl struct{}
+
+// NewChannelAllocationRepository creates a new instance of ChannelAllocationRepository
+func NewChannelAllocationRepository() ChannelAllocationRepository {
+	return &ChannelAllocationRepositoryImpl{}
+}
+
+// Save creates the rule or updates it when it already has an ID
+func (r *ChannelAllocationRepositoryImpl) Save(
+	ctx context.Context,
+	rule *entity.ChannelAllocationRule,
+) error {
+	return db.DB(ctx).Save(rule).Error
+}
+
+// FindByID finds a rule by ID, scoped to the seller
+func (r *ChannelAllocationRepositoryImpl) FindByID(
+	ctx context.Context,
+	id uint,
+	sellerID uint,
+) (*entity.ChannelAllocationRule, error) {
+	var rule entity.ChannelAllocationRule
+	result := db.DB(ctx).Where("id = ? AND seller_id = ?", id, sellerID).First(&rule)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, invErrors.ErrChannelAllocationRuleNotFound
+		}
+		return nil, result.Error
+	}
+	return &rule, nil
+}
+
+// FindBySellerID returns every rule of the seller
+func (r *ChannelAllocationRepositoryImpl) FindBySellerID(
+	ctx context.Context,
+	sellerID uint,
+) ([]entity.ChannelAllocationRule, error) {
+	var rules []entity.ChannelAllocationRule
+	err := db.DB(ctx).
+		Where("seller_id = ?", sellerID).
+		Order("variant_id NULLS FIRST, channel").
+		Find(&rules).Error
+	return rules, err
+}
+
+// FindByScope returns the rules set for exactly one scope (seller default or a variant)
+func (r *ChannelAllocationRepositoryImpl) FindByScope(
+	ctx context.Context,
+	sellerID uint,
+	variantID *uint,
+) ([]entity.ChannelAllocationRule, error) {
+	var rules []entity.ChannelAllocationRule
+	query := db.DB(ctx).Where("seller_id = ?", sellerID)
+	if variantID == nil {
+		query = query.Where("variant_id IS NULL")
+	} else {
+		query = query.Where("variant_id = ?", *variantID)
+	}
+	err := query.Order("channel").Find(&rules).Error
+	return rules, err
+}
+
+// FindApplicable returns the seller defaults plus the rules of the given variants
+func (r *ChannelAllocationRepositoryImpl) FindApplicable(
+	ctx context.Context,
+	sellerID uint,
+	variantIDs []uint,
+) ([]entity.ChannelAllocationRule, error) {
+	var rules []entity.ChannelAllocationRule
+	query := db.DB(ctx).Where("seller_id = ?", sellerID)
+	if len(variantIDs) > 0 {
+		query = query.Where("variant_id IS NULL OR variant_id IN ?", variantIDs)
+	} else {
+		query = query.Where("variant_id IS NULL")
+	}
+	err := query.Find(&rules).Error
+	return rules, err
+}
+
+// Delete removes a rule by ID
+func (r *ChannelAllocationRepositoryImpl) Delete(ctx context.Context, id uint) error {
+	return db.DB(ctx).Delete(&entity.ChannelAllocationRule{}, id).Error
+}
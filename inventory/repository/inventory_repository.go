@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"errors"
+	"time"
 
 	"ecommerce-be/common/db"
 	"ecommerce-be/inventory/entity"
@@ -114,12 +115,14 @@ func (r *InventoryRepositoryImpl) CreateBatch(
 	return db.DB(ctx).Create(inventories).Error
 }
 
-// Update updates an existing inventory record
+// Update updates an existing inventory record if it is still at the version it was read
+// at, and returns ErrInventoryVersionConflict otherwise
 func (r *InventoryRepositoryImpl) Update(ctx context.Context, inventory *entity.Inventory) error {
-	return db.DB(ctx).Save(inventory).Error
+	return r.UpdateBatch(ctx, []*entity.Inventory{inventory})
 }
 
-// UpdateBatch updates multiple inventory records in a single transaction
+// UpdateBatch updates multiple inventory records in a single transaction. Nothing is
+// written when any of them changed since it was read; the error lists every such row.
 func (r *InventoryRepositoryImpl) UpdateBatch(
 	ctx context.Context,
 	inventories []*entity.Inventory,
@@ -127,7 +130,50 @@ func (r *InventoryRepositoryImpl) UpdateBatch(
 	if len(inventories) == 0 {
 		return nil
 	}
-	return db.DB(ctx).Save(inventories).Error
+	return db.WithTransaction(ctx, func(txCtx context.Context) error {
+		var conflicts []db.VersionConflict
+		for _, inventory := range inventories {
+			conflict, err := r.updateVersioned(txCtx, inventory)
+			if err != nil {
+				return err
+			}
+			if conflict != nil {
+				conflicts = append(conflicts, *conflict)
+			}
+		}
+		if len(conflicts) > 0 {
+			return invErrors.ErrInventoryVersionConflict.WithDetails(conflicts)
+		}
+		return nil
+	})
+}
+
+// updateVersioned compare-and-swaps the stock columns of an inventory row on its
+// version, and moves the entity to the new version on success
+func (r *InventoryRepositoryImpl) updateVersioned(
+	ctx context.Context,
+	inventory *entity.Inventory,
+) (*db.VersionConflict, error) {
+	conflict, err := db.UpdateVersioned(
+		ctx,
+		&entity.Inventory{},
+		inventory.ID,
+		inventory.Version,
+		map[string]any{
+			"quantity":             inventory.Quantity,
+			"reserved_quantity":    inventory.ReservedQuantity,
+			"damaged_quantity":     inventory.DamagedQuantity,
+			"quarantined_quantity": inventory.QuarantinedQuantity,
+			"threshold":            inventory.Threshold,
+			"bin_location":         inventory.BinLocation,
+		},
+	)
+	if err != nil || conflict != nil {
+		return conflict, err
+	}
+	inventory.Version++
+	inventory.UpdatedAt = time.Now().UTC()
+	return nil, nil
 }
 
 // FindByVariantID finds all inventory records for a variant across all locations
@@ -474,7 +520,10 @@ func (r *InventoryRepositoryImpl) IncrementReservedQuantity(
 ) error {
 	return db.DB(ctx).Model(&entity.Inventory{}).
 		Where("id = ?", inventoryID).
-		Update("reserved_quantity", gorm.Expr("reserved_quantity + ?", delta)).Error
+		Updates(map[string]any{
+			"reserved_quantity": gorm.Expr("reserved_quantity + ?", delta),
+			db.VERSION_COLUMN:   gorm.Expr(db.VERSION_COLUMN + " + 1"),
+		}).Error
 }
 
 // FindWithFilters retrieves inventories with filters, pagination and sorting
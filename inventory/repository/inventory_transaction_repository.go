@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"ecommerce-be/common/db"
 	"ecommerce-be/inventory/entity"
@@ -15,6 +16,14 @@ type InventoryTransactionRepository interface {
 	FindByInventoryID(ctx context.Context, inventoryID uint) ([]entity.InventoryTransaction, error)
 	FindByReferenceID(ctx context.Context, referenceID string) ([]entity.InventoryTransaction, error)
 	FindByFilter(ctx context.Context, filter model.ListTransactionsFilter) ([]entity.InventoryTransaction, int64, error)
+	// FindValuationLedger returns the seller's stock movements before the given time,
+	// ordered by variant and then in the order they happened
+	FindValuationLedger(
+		ctx context.Context,
+		sellerID uint,
+		variantID *uint,
+		before time.Time,
+	) ([]model.ValuationLedgerEntry, error)
 }
 
 // InventoryTransactionRepositoryImpl implements the InventoryTransactionRepository interface
@@ -115,6 +124,11 @@ func (r *InventoryTransactionRepositoryImpl) FindByFilter(
 		query = query.Where("inventory_transaction.type IN ?", filter.Types)
 	}
 
+	// Filter by reason codes
+	if len(filter.ReasonCodes) > 0 {
+		query = query.Where("inventory_transaction.reason_code IN ?", filter.ReasonCodes)
+	}
+
 	// Filter by reference ID
 	if filter.ReferenceID != nil {
 		query = query.Where("inventory_transaction.reference_id = ?", *filter.ReferenceID)
@@ -161,3 +175,37 @@ func (r *InventoryTransactionRepositoryImpl) FindByFilter(
 
 	return transactions, total, nil
 }
+
+// FindValuationLedger reads the stock ledger of the seller's locations for valuation.
+// Reservation entries are skipped as they never change stock on hand.
+func (r *InventoryTransactionRepositoryImpl) FindValuationLedger(
+	ctx context.Context,
+	sellerID uint,
+	variantID *uint,
+	before time.Time,
+) ([]model.ValuationLedgerEntry, error) {
+	query := db.DB(ctx).
+		Model(&entity.InventoryTransaction{}).
+		Select("inventory.variant_id, inventory_transaction.type, "+
+			"inventory_transaction.quantity_change, inventory_transaction.unit_cost_cents, "+
+			"inventory_transaction.created_at").
+		Joins("JOIN inventory ON inventory.id = inventory_transaction.inventory_id").
+		Joins("JOIN location ON location.id = inventory.location_id").
+		Where("location.seller_id = ?", sellerID).
+		Where("inventory_transaction.created_at < ?", before).
+		Where("inventory_transaction.quantity_change <> 0").
+		Where("inventory_transaction.type NOT IN ?",
+			[]entity.TransactionType{entity.TXN_RESERVED, entity.TXN_RELEASED})
+	if variantID != nil {
+		query = query.Where("inventory.variant_id = ?", *variantID)
+	}
+
+	var entries []model.ValuationLedgerEntry
+	err := query.
+		Order("inventory.variant_id, inventory_transaction.created_at, inventory_transaction.id").
+		Scan(&entries).Error
+	if err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
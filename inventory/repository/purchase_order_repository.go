@@ -0,0 +1,158 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"ecommerce-be/common/db"
+	"ecommerce-be/inventory/entity"
+	invErrors "ecommerce-be/inventory/error"
+	"ecommerce-be/inventory/model"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// PurchaseOrderRepository defines data access for purchase orders and their lines
+type PurchaseOrderRepository interface {
+	// Create inserts the order together with its lines
+	Create(ctx context.Context, order *entity.PurchaseOrder) error
+	// Save updates the order columns; lines are saved with SaveLines or ReplaceLines
+	Save(ctx context.Context, order *entity.PurchaseOrder) error
+	SaveLines(ctx context.Context, lines []entity.PurchaseOrderLine) error
+	// ReplaceLines deletes the order's lines and inserts lines in their place
+	ReplaceLines(ctx context.Context, orderID uint, lines []entity.PurchaseOrderLine) error
+	// FindByID returns the seller's order with its lines
+	FindByID(ctx context.Context, id uint, sellerID uint) (*entity.PurchaseOrder, error)
+	// FindByIDForUpdate is FindByID holding a row lock on the order until the transaction ends
+	FindByIDForUpdate(ctx context.Context, id uint, sellerID uint) (*entity.PurchaseOrder, error)
+	// FindAll returns the seller's orders with their lines, newest first
+	FindAll(
+		ctx context.Context,
+		sellerID uint,
+		params model.PurchaseOrdersQueryParams,
+	) ([]entity.PurchaseOrder, error)
+	ExistsForSupplier(ctx context.Context, supplierID uint) (bool, error)
+}
+
+// PurchaseOrderRepositoryImpl implements PurchaseOrderRepository
+type PurchaseOrderRepositoryImpl struct{}
+
+// NewPurchaseOrderRepository creates a new instance of PurchaseOrderRepository
+func NewPurchaseOrderRepository() PurchaseOrderRepository {
+	return &PurchaseOrderRepositoryImpl{}
+}
+
+// Create inserts the order and its lines
+func (r *PurchaseOrderRepositoryImpl) Create(
+	ctx context.Context,
+	order *entity.PurchaseOrder,
+) error {
+	return db.DB(ctx).Create(order).Error
+}
+
+// Save updates the order columns
+func (r *PurchaseOrderRepositoryImpl) Save(ctx context.Context, order *entity.PurchaseOrder) error {
+	return db.DB(ctx).Omit("Lines").Save(order).Error
+}
+
+// SaveLines updates existing lines
+func (r *PurchaseOrderRepositoryImpl) SaveLines(
+	ctx context.Context,
+	lines []entity.PurchaseOrderLine,
+) error {
+	if len(lines) == 0 {
+		return nil
+	}
+	return db.DB(ctx).Save(&lines).Error
+}
+
+// ReplaceLines swaps the order's lines for new ones
+func (r *PurchaseOrderRepositoryImpl) ReplaceLines(
+	ctx context.Context,
+	orderID uint,
+	lines []entity.PurchaseOrderLine,
+) error {
+	err := db.DB(ctx).
+		Where("purchase_order_id = ?", orderID).
+		Delete(&entity.PurchaseOrderLine{}).Error
+	if err != nil {
+		return err
+	}
+	for i := range lines {
+		lines[i].PurchaseOrderID = orderID
+	}
+	return db.DB(ctx).Create(&lines).Error
+}
+
+// FindByID finds an order by ID, scoped to the seller
+func (r *PurchaseOrderRepositoryImpl) FindByID(
+	ctx context.Context,
+	id uint,
+	sellerID uint,
+) (*entity.PurchaseOrder, error) {
+	return findPurchaseOrder(db.DB(ctx), id, sellerID)
+}
+
+// FindByIDForUpdate finds an order by ID and locks it
+func (r *PurchaseOrderRepositoryImpl) FindByIDForUpdate(
+	ctx context.Context,
+	id uint,
+	sellerID uint,
+) (*entity.PurchaseOrder, error) {
+	return findPurchaseOrder(db.DB(ctx).Clauses(clause.Locking{Strength: "UPDATE"}), id, sellerID)
+}
+
+// FindAll returns the seller's orders, newest first
+func (r *PurchaseOrderRepositoryImpl) FindAll(
+	ctx context.Context,
+	sellerID uint,
+	params model.PurchaseOrdersQueryParams,
+) ([]entity.PurchaseOrder, error) {
+	query := db.DB(ctx).Where("seller_id = ?", sellerID)
+	if params.Status != nil {
+		query = query.Where("status = ?", *params.Status)
+	}
+	if params.SupplierID != nil {
+		query = query.Where("supplier_id = ?", *params.SupplierID)
+	}
+
+	var orders []entity.PurchaseOrder
+	err := query.Preload("Lines", orderLinesByID).Order("id DESC").Find(&orders).Error
+	return orders, err
+}
+
+// ExistsForSupplier reports whether any purchase order was placed with the supplier
+func (r *PurchaseOrderRepositoryImpl) ExistsForSupplier(
+	ctx context.Context,
+	supplierID uint,
+) (bool, error) {
+	var count int64
+	err := db.DB(ctx).
+		Model(&entity.PurchaseOrder{}).
+		Where("supplier_id = ?", supplierID).
+		Limit(1).
+		Count(&count).Error
+	return count > 0, err
+}
+
+// findPurchaseOrder loads a seller's order and its lines
+func findPurchaseOrder(query *gorm.DB, id uint, sellerID uint) (*entity.PurchaseOrder, error) {
+	var order entity.PurchaseOrder
+	result := query.
+		Preload("Lines", orderLinesByID).
+		Where("id = ? AND seller_id = ?", id, sellerID).
+		First(&order)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, invErrors.ErrPurchaseOrderNotFound
+		}
+		return nil, result.Error
+	}
+	return &order, nil
+}
+
+// orderLinesByID keeps preloaded lines in the order they were added
+func orderLinesByID(tx *gorm.DB) *gorm.DB {
+	return tx.Order("id")
+}
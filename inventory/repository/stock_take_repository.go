@@ -0,0 +1,132 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"ecommerce-be/common/db"
+	"ecommerce-be/inventory/entity"
+	invErrors "ecommerce-be/inventory/error"
+	"ecommerce-be/inventory/model"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// StockTakeRepository defines data access for stock-take sessions and their lines
+type StockTakeRepository interface {
+	Create(ctx context.Context, stockTake *entity.StockTake) error
+	// Save updates the session columns; lines are saved with SaveLines
+	Save(ctx context.Context, stockTake *entity.StockTake) error
+	SaveLines(ctx context.Context, lines []entity.StockTakeLine) error
+	// FindByID returns the seller's session with its lines
+	FindByID(ctx context.Context, id uint, sellerID uint) (*entity.StockTake, error)
+	// FindByIDForUpdate is FindByID holding a row lock on the session until the transaction ends
+	FindByIDForUpdate(ctx context.Context, id uint, sellerID uint) (*entity.StockTake, error)
+	// FindOpenByLocation returns the open session of a location, or nil when there is none
+	FindOpenByLocation(ctx context.Context, locationID uint) (*entity.StockTake, error)
+	// FindAll returns the seller's sessions with their lines, newest first
+	FindAll(
+		ctx context.Context,
+		sellerID uint,
+		params model.StockTakesQueryParams,
+	) ([]entity.StockTake, error)
+}
+
+// StockTakeRepositoryImpl implements StockTakeRepository
+type StockTakeRepositoryImpl struct{}
+
+// NewStockTakeRepository creates a new instance of StockTakeRepository
+func NewStockTakeRepository() StockTakeRepository {
+	return &StockTakeRepositoryImpl{}
+}
+
+// Create inserts a new session
+func (r *StockTakeRepositoryImpl) Create(ctx context.Context, stockTake *entity.StockTake) error {
+	return db.DB(ctx).Omit("Lines").Create(stockTake).Error
+}
+
+// Save updates the session columns
+func (r *StockTakeRepositoryImpl) Save(ctx context.Context, stockTake *entity.StockTake) error {
+	return db.DB(ctx).Omit("Lines").Save(stockTake).Error
+}
+
+// SaveLines creates new lines and updates existing ones
+func (r *StockTakeRepositoryImpl) SaveLines(
+	ctx context.Context,
+	lines []entity.StockTakeLine,
+) error {
+	if len(lines) == 0 {
+		return nil
+	}
+	return db.DB(ctx).Save(&lines).Error
+}
+
+// FindByID finds a session by ID, scoped to the seller
+func (r *StockTakeRepositoryImpl) FindByID(
+	ctx context.Context,
+	id uint,
+	sellerID uint,
+) (*entity.StockTake, error) {
+	return findStockTake(db.DB(ctx), id, sellerID)
+}
+
+// FindByIDForUpdate finds a session by ID and locks it
+func (r *StockTakeRepositoryImpl) FindByIDForUpdate(
+	ctx context.Context,
+	id uint,
+	sellerID uint,
+) (*entity.StockTake, error) {
+	return findStockTake(db.DB(ctx).Clauses(clause.Locking{Strength: "UPDATE"}), id, sellerID)
+}
+
+// FindOpenByLocation returns the open session of a location
+func (r *StockTakeRepositoryImpl) FindOpenByLocation(
+	ctx context.Context,
+	locationID uint,
+) (*entity.StockTake, error) {
+	var stockTakes []entity.StockTake
+	err := db.DB(ctx).
+		Where("location_id = ? AND status = ?", locationID, entity.STOCK_TAKE_OPEN).
+		Limit(1).
+		Find(&stockTakes).Error
+	if err != nil || len(stockTakes) == 0 {
+		return nil, err
+	}
+	return &stockTakes[0], nil
+}
+
+// FindAll returns the seller's sessions, newest first
+func (r *StockTakeRepositoryImpl) FindAll(
+	ctx context.Context,
+	sellerID uint,
+	params model.StockTakesQueryParams,
+) ([]entity.StockTake, error) {
+	query := db.DB(ctx).Where("seller_id = ?", sellerID)
+	if params.Status != nil {
+		query = query.Where("status = ?", *params.Status)
+	}
+	if params.LocationID != nil {
+		query = query.Where("location_id = ?", *params.LocationID)
+	}
+
+	var stockTakes []entity.StockTake
+	err := query.Preload("Lines").Order("created_at DESC, id DESC").Find(&stockTakes).Error
+	return stockTakes, err
+}
+
+// findStockTake loads a seller's session and its lines ordered by variant
+func findStockTake(query *gorm.DB, id uint, sellerID uint) (*entity.StockTake, error) {
+	var stockTake entity.StockTake
+	result := query.
+		Preload("Lines", func(tx *gorm.DB) *gorm.DB { return tx.Order("variant_id") }).
+		Where("id = ? AND seller_id = ?", id, sellerID).
+		First(&stockTake)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, invErrors.ErrStockTakeNotFound
+		}
+		return nil, result.Error
+	}
+	return &stockTake, nil
+}
@@ -0,0 +1,101 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"ecommerce-be/common/db"
+	"ecommerce-be/inventory/entity"
+	invErrors "ecommerce-be/inventory/error"
+	"ecommerce-be/inventory/model"
+
+	"gorm.io/gorm"
+)
+
+// SupplierRepository defines data access for suppliers
+type SupplierRepository interface {
+	Create(ctx context.Context, supplier *entity.Supplier) error
+	Update(ctx context.Context, supplier *entity.Supplier) error
+	FindByID(ctx context.Context, id uint, sellerID uint) (*entity.Supplier, error)
+	// FindByName finds the seller's supplier by name, ignoring case; nil when there is none
+	FindByName(ctx context.Context, sellerID uint, name string) (*entity.Supplier, error)
+	FindAll(
+		ctx context.Context,
+		sellerID uint,
+		params model.SuppliersQueryParams,
+	) ([]entity.Supplier, error)
+	Delete(ctx context.Context, id uint) error
+}
+
+// SupplierRepositoryImpl implements SupplierRepository
+type SupplierRepositoryImpl struct{}
+
+// NewSupplierRepository creates a new instance of SupplierRepository
+func NewSupplierRepository() SupplierRepository {
+	return &SupplierRepositoryImpl{}
+}
+
+// Create inserts a new supplier
+func (r *SupplierRepositoryImpl) Create(ctx context.Context, supplier *entity.Supplier) error {
+	return db.DB(ctx).Create(supplier).Error
+}
+
+// Update saves all supplier columns
+func (r *SupplierRepositoryImpl) Update(ctx context.Context, supplier *entity.Supplier) error {
+	return db.DB(ctx).Save(supplier).Error
+}
+
+// FindByID finds a supplier by ID, scoped to the seller
+func (r *SupplierRepositoryImpl) FindByID(
+	ctx context.Context,
+	id uint,
+	sellerID uint,
+) (*entity.Supplier, error) {
+	var supplier entity.Supplier
+	result := db.DB(ctx).Where("id = ? AND seller_id = ?", id, sellerID).First(&supplier)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, invErrors.ErrSupplierNotFound
+		}
+		return nil, result.Error
+	}
+	return &supplier, nil
+}
+
+// FindByName finds the seller's supplier by name, ignoring case
+func (r *SupplierRepositoryImpl) FindByName(
+	ctx context.Context,
+	sellerID uint,
+	name string,
+) (*entity.Supplier, error) {
+	var suppliers []entity.Supplier
+	err := db.DB(ctx).
+		Where("seller_id = ? AND LOWER(name) = LOWER(?)", sellerID, name).
+		Limit(1).
+		Find(&suppliers).Error
+	if err != nil || len(suppliers) == 0 {
+		return nil, err
+	}
+	return &suppliers[0], nil
+}
+
+// FindAll returns the seller's suppliers ordered by name
+func (r *SupplierRepositoryImpl) FindAll(
+	ctx context.Context,
+	sellerID uint,
+	params model.SuppliersQueryParams,
+) ([]entity.Supplier, error) {
+	query := db.DB(ctx).Where("seller_id = ?", sellerID)
+	if params.IsActive != nil {
+		query = query.Where("is_active = ?", *params.IsActive)
+	}
+
+	var suppliers []entity.Supplier
+	err := query.Order("LOWER(name)").Find(&suppliers).Error
+	return suppliers, err
+}
+
+// Delete removes a supplier by ID
+func (r *SupplierRepositoryImpl) Delete(ctx context.Context, id uint) error {
+	return db.DB(ctx).Delete(&entity.Supplier{}, id).Error
+}
@@ -0,0 +1,70 @@
+package route
+
+import (
+	"net/http"
+
+	"ecommerce-be/common/constants"
+	"ecommerce-be/common/middleware"
+	"ecommerce-be/common/openapi"
+	"ecommerce-be/inventory/factory/singleton"
+	"ecommerce-be/inventory/handler"
+	"ecommerce-be/inventory/model"
+	invConstants "ecommerce-be/inventory/utils/constant"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ChannelAllocationModule implements the Module interface for channel allocation routes
+type ChannelAllocationModule struct {
+	allocationHandler *handler.ChannelAllocationHandler
+}
+
+// NewChannelAllocationModule creates a new instance of ChannelAllocationModule
+func NewChannelAllocationModule() *ChannelAllocationModule {
+	f := singleton.GetInstance()
+
+	return &ChannelAllocationModule{
+		allocationHandler: f.GetChannelAllocationHandler(),
+	}
+}
+
+// RegisterRoutes registers channel allocation routes
+func (m *ChannelAllocationModule) RegisterRoutes(router *gin.Engine) {
+	sellerAuth := middleware.SellerAuth()
+
+	// Channel allocation routes - all protected (seller only) - /api/inventory/channel-allocation/*
+	allocationRoutes := openapi.NewGroup(
+		router.Group(constants.APIBaseInventory+"/channel-allocation"),
+		"Channel Allocation",
+	)
+	{
+		allocationRoutes.PUT("", sellerAuth, m.allocationHandler.UpsertRule).
+			Summary("Create or replace a channel allocation rule").
+			Description("Reserves a percentage of pooled stock for a sales channel and/or "+
+				"withholds a buffer quantity from it. Omit variantId for the seller default.").
+			Body(model.ChannelAllocationRuleRequest{}).
+			ReturnsField(
+				http.StatusOK,
+				invConstants.CHANNEL_ALLOCATION_RULE_FIELD_NAME,
+				model.ChannelAllocationRuleResponse{},
+			)
+		allocationRoutes.GET("", sellerAuth, m.allocationHandler.ListRules).
+			Summary("List channel allocation rules").
+			Query(model.ChannelAllocationRulesParam{}).
+			ReturnsField(
+				http.StatusOK,
+				invConstants.CHANNEL_ALLOCATION_RULES_FIELD_NAME,
+				[]model.ChannelAllocationRuleResponse{},
+			)
+		allocationRoutes.DELETE("/:ruleId", sellerAuth, m.allocationHandler.DeleteRule).
+			Summary("Delete a channel allocation rule")
+		allocationRoutes.POST(
+			"/availability",
+			sellerAuth,
+			m.allocationHandler.GetChannelAvailability,
+		).
+			Summary("Report available stock per sales channel").
+			Body(model.ChannelAvailabilityRequest{}).
+			Returns(http.StatusOK, model.ChannelAvailabilityResponse{})
+	}
+}
@@ -1,10 +1,14 @@
 package route
 
 import (
+	"net/http"
+
 	"ecommerce-be/common/constants"
 	"ecommerce-be/common/middleware"
+	"ecommerce-be/common/openapi"
 	"ecommerce-be/inventory/factory/singleton"
 	"ecommerce-be/inventory/handler"
+	"ecommerce-be/inventory/model"
 
 	"github.com/gin-gonic/gin"
 )
@@ -24,16 +28,24 @@ func NewInventoryReservationModule() *InventoryReservationModule {
 func (m *InventoryReservationModule) RegisterRoutes(router *gin.Engine) {
 	sellerAuth := middleware.SellerAuth()
 
-	reservationGroup := router.Group(constants.APIBaseInventory + "/reservation")
+	reservationGroup := openapi.NewGroup(
+		router.Group(constants.APIBaseInventory+"/reservation"),
+		"Inventory Reservations",
+	)
 	{
 		// Create a new inventory reservation
-		reservationGroup.POST("", sellerAuth, m.inventoryReservationHandler.CreateReservation)
+		reservationGroup.POST("", sellerAuth, m.inventoryReservationHandler.CreateReservation).
+			Summary("Reserve inventory for an order").
+			Body(model.ReservationRequest{}).
+			Returns(http.StatusOK, model.ReservationResponse{})
 
 		// Update reservation status (CANCELLED or COMPLETED) by reference ID
 		reservationGroup.PUT(
 			"/status",
 			sellerAuth,
 			m.inventoryReservationHandler.UpdateReservationStatus,
-		)
+		).
+			Summary("Complete or cancel a reservation").
+			Body(model.UpdateReservationStatusRequest{})
 	}
 }
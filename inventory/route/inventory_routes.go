@@ -1,10 +1,15 @@
 package route
 
 import (
+	"net/http"
+
 	"ecommerce-be/common/constants"
 	"ecommerce-be/common/middleware"
+	"ecommerce-be/common/openapi"
 	"ecommerce-be/inventory/factory/singleton"
 	"ecommerce-be/inventory/handler"
+	"ecommerce-be/inventory/model"
+	invConstants "ecommerce-be/inventory/utils/constant"
 
 	"github.com/gin-gonic/gin"
 )
@@ -26,41 +31,106 @@ func NewInventoryModule() *InventoryModule {
 // RegisterRoutes registers all inventory-related routes
 func (m *InventoryModule) RegisterRoutes(router *gin.Engine) {
 	sellerAuth := middleware.SellerAuth()
+	publicRoutesAuth := middleware.PublicAPIAuth()
 
-	// Inventory routes - all protected (seller only) - /api/inventory/*
-	inventoryRoutes := router.Group(constants.APIBaseInventory)
+	// Inventory routes - seller only except the public stock check - /api/inventory/*
+	inventoryRoutes := openapi.NewGroup(router.Group(constants.APIBaseInventory), "Inventory")
 	{
 		// List inventories with filters
-		inventoryRoutes.GET("", sellerAuth, m.inventoryHandler.GetInventories)
+		inventoryRoutes.GET("", sellerAuth, m.inventoryHandler.GetInventories).
+			Summary("List inventories").
+			Query(model.GetInventoriesParam{}).
+			ReturnsField(
+				http.StatusOK,
+				invConstants.INVENTORIES_FIELD_NAME,
+				model.InventoryResponseWithPagination{},
+			)
 
 		// Manage inventory (quantity, reserved quantity, threshold, or physical count)
-		inventoryRoutes.POST("/manage", sellerAuth, m.inventoryHandler.ManageInventory)
+		inventoryRoutes.POST("/manage", sellerAuth, m.inventoryHandler.ManageInventory).
+			Summary("Adjust inventory for a variant at a location").
+			Body(model.ManageInventoryRequest{}).
+			ReturnsField(
+				http.StatusOK,
+				invConstants.INVENTORY_FIELD_NAME,
+				model.ManageInventoryResponse{},
+			)
 
 		// Bulk manage inventory (multiple items in one request)
-		inventoryRoutes.POST("/manage/bulk", sellerAuth, m.inventoryHandler.BulkManageInventory)
+		inventoryRoutes.POST(
+			"/manage/bulk",
+			middleware.BulkBodySizeLimit(),
+			sellerAuth,
+			m.inventoryHandler.BulkManageInventory,
+		).
+			Summary("Adjust inventory in bulk").
+			Body(model.BulkManageInventoryRequest{}).
+			ReturnsField(
+				http.StatusOK,
+				invConstants.INVENTORIES_FIELD_NAME,
+				model.BulkManageInventoryResponse{},
+			)
 
 		// Get inventory by variant (across all locations)
 		inventoryRoutes.GET(
 			"/product/:variantId",
 			sellerAuth,
 			m.inventoryHandler.GetInventoryByVariant,
-		)
+		).
+			Summary("Get variant inventory across locations").
+			ReturnsField(
+				http.StatusOK,
+				invConstants.INVENTORIES_FIELD_NAME,
+				[]model.InventoryDetailResponse{},
+			)
 
 		// Get inventory by location (all variants at location)
 		inventoryRoutes.GET(
 			"/location/:locationId/inventory",
 			sellerAuth,
 			m.inventoryHandler.GetInventoryByLocation,
-		)
+		).
+			Summary("Get all inventory at a location").
+			ReturnsField(
+				http.StatusOK,
+				invConstants.INVENTORIES_FIELD_NAME,
+				[]model.InventoryResponse{},
+			)
 
 		// Get aggregated total available quantities for variants/products in bulk
 		inventoryRoutes.POST(
 			"/summary/available",
 			sellerAuth,
 			m.inventoryHandler.GetTotalAvailableQuantities,
-		)
+		).
+			Summary("Get total available quantities in bulk").
+			Body(model.TotalAvailableQuantityRequest{}).
+			Returns(http.StatusOK, model.TotalAvailableQuantityResponse{})
+
+		// Check a whole cart's stock in one call (public, seller from X-Seller-ID or token)
+		inventoryRoutes.POST("/check", publicRoutesAuth, m.inventoryHandler.CheckStock).
+			Summary("Check stock for a batch of variant quantities").
+			Description("Returns availability, reservable quantity and a per-location "+
+				"breakdown for every variant, for cart validation at checkout.").
+			Body(model.StockCheckRequest{}).
+			Returns(http.StatusOK, model.StockCheckResponse{})
 
 		// List inventory transactions with filters
-		inventoryRoutes.GET("/transaction", sellerAuth, m.inventoryHandler.ListTransactions)
+		inventoryRoutes.GET("/transaction", sellerAuth, m.inventoryHandler.ListTransactions).
+			Summary("List inventory transactions").
+			Query(model.ListTransactionsQueryParams{}).
+			Returns(http.StatusOK, model.ListTransactionsResponse{})
+
+		// Movement history of one variant (newest first) for seller reconciliation
+		inventoryRoutes.GET(
+			"/:variantId/movements",
+			sellerAuth,
+			m.inventoryHandler.ListVariantMovements,
+		).
+			Summary("List a variant's inventory movements").
+			Description("Every stock change of the variant with its reason code, actor "+
+				"and reference. Movements are immutable; corrections appear as new movements.").
+			Query(model.VariantMovementsQueryParams{}).
+			Returns(http.StatusOK, model.ListTransactionsResponse{})
 	}
 }
@@ -0,0 +1,52 @@
+package route
+
+import (
+	"net/http"
+
+	"ecommerce-be/common/constants"
+	"ecommerce-be/common/middleware"
+	"ecommerce-be/common/openapi"
+	"ecommerce-be/inventory/factory/singleton"
+	"ecommerce-be/inventory/handler"
+	"ecommerce-be/inventory/model"
+	invConstants "ecommerce-be/inventory/utils/constant"
+
+	"github.com/gin-gonic/gin"
+)
+
+// InventoryValuationModule implements the Module interface for inventory valuation routes
+type InventoryValuationModule struct {
+	valuationHandler *handler.InventoryValuationHandler
+}
+
+// NewInventoryValuationModule creates a new instance of InventoryValuationModule
+func NewInventoryValuationModule() *InventoryValuationModule {
+	f := singleton.GetInstance()
+
+	return &InventoryValuationModule{
+		valuationHandler: f.GetInventoryValuationHandler(),
+	}
+}
+
+// RegisterRoutes registers inventory valuation routes
+func (m *InventoryValuationModule) RegisterRoutes(router *gin.Engine) {
+	sellerAuth := middleware.SellerAuth()
+
+	// Inventory valuation routes - protected (seller only) - /api/inventory/valuation
+	valuationRoutes := openapi.NewGroup(
+		router.Group(constants.APIBaseInventory+"/valuation"),
+		"Inventory Valuation",
+	)
+	{
+		valuationRoutes.GET("", sellerAuth, m.valuationHandler.GetValuation).
+			Summary("Report inventory valuation and COGS for a period").
+			Description("Values stock from purchase unit costs in the stock ledger with FIFO "+
+				"or moving-average costing. method defaults to the seller's setting.").
+			Query(model.InventoryValuationParams{}).
+			ReturnsField(
+				http.StatusOK,
+				invConstants.INVENTORY_VALUATION_FIELD_NAME,
+				model.InventoryValuationResponse{},
+			)
+	}
+}
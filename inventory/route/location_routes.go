@@ -1,10 +1,15 @@
 package route
 
 import (
+	"net/http"
+
 	"ecommerce-be/common/constants"
 	"ecommerce-be/common/middleware"
+	"ecommerce-be/common/openapi"
 	"ecommerce-be/inventory/factory/singleton"
 	"ecommerce-be/inventory/handler"
+	"ecommerce-be/inventory/model"
+	invConstants "ecommerce-be/inventory/utils/constant"
 
 	"github.com/gin-gonic/gin"
 )
@@ -30,23 +35,55 @@ func (m *LocationModule) RegisterRoutes(router *gin.Engine) {
 	sellerAuth := middleware.SellerAuth()
 
 	// Location routes - all protected (seller only) - /api/inventory/location/*
-	locationRoutes := router.Group(constants.APIBaseInventory + "/location")
+	locationRoutes := openapi.NewGroup(
+		router.Group(constants.APIBaseInventory+"/location"),
+		"Locations",
+	)
 	{
-		locationRoutes.POST("", sellerAuth, m.locationHandler.CreateLocation)
-		locationRoutes.GET("", sellerAuth, m.locationHandler.GetAllLocations)
-		locationRoutes.GET("/summary", sellerAuth, m.inventorySummaryHandler.GetLocationsSummary)
-		locationRoutes.GET("/:locationId", sellerAuth, m.locationHandler.GetLocationByID)
+		locationRoutes.POST("", sellerAuth, m.locationHandler.CreateLocation).
+			Summary("Create a stock location").
+			Body(model.LocationCreateRequest{}).
+			ReturnsField(
+				http.StatusCreated,
+				invConstants.LOCATION_FIELD_NAME,
+				model.LocationResponse{},
+			)
+		locationRoutes.GET("", sellerAuth, m.locationHandler.GetAllLocations).
+			Summary("List stock locations").
+			Query(model.LocationsParam{}).
+			ReturnsField(
+				http.StatusOK,
+				invConstants.LOCATIONS_FIELD_NAME,
+				model.LocationsResponse{},
+			)
+		locationRoutes.GET("/summary", sellerAuth, m.inventorySummaryHandler.GetLocationsSummary).
+			Summary("Summarize inventory per location").
+			Query(model.LocationsParam{}).
+			Returns(http.StatusOK, model.LocationsSummaryResponse{})
+		locationRoutes.GET("/:locationId", sellerAuth, m.locationHandler.GetLocationByID).
+			Summary("Get a stock location").
+			ReturnsField(http.StatusOK, invConstants.LOCATION_FIELD_NAME, model.LocationResponse{})
 		locationRoutes.GET(
 			"/:locationId/product",
 			sellerAuth,
 			m.inventorySummaryHandler.GetProductsAtLocation,
-		)
+		).
+			Summary("List product stock at a location").
+			Query(model.ProductsAtLocationParams{}, model.ProductsAtLocationFilter{}).
+			Returns(http.StatusOK, model.ProductsAtLocationResponse{})
 		locationRoutes.GET(
 			"/:locationId/product/:productId/variant",
 			sellerAuth,
 			m.inventorySummaryHandler.GetVariantInventoryAtLocation,
-		)
-		locationRoutes.PUT("/:locationId", sellerAuth, m.locationHandler.UpdateLocation)
-		locationRoutes.DELETE("/:locationId", sellerAuth, m.locationHandler.DeleteLocation)
+		).
+			Summary("List variant stock of a product at a location").
+			Query(model.VariantInventoryFilter{}).
+			Returns(http.StatusOK, model.VariantInventoryResponse{})
+		locationRoutes.PUT("/:locationId", sellerAuth, m.locationHandler.UpdateLocation).
+			Summary("Update a stock location").
+			Body(model.LocationUpdateRequest{}).
+			ReturnsField(http.StatusOK, invConstants.LOCATION_FIELD_NAME, model.LocationResponse{})
+		locationRoutes.DELETE("/:locationId", sellerAuth, m.locationHandler.DeleteLocation).
+			Summary("Delete a stock location")
 	}
 }
@@ -0,0 +1,159 @@
+package route
+
+import (
+	"net/http"
+
+	"ecommerce-be/common/constants"
+	"ecommerce-be/common/middleware"
+	"ecommerce-be/common/openapi"
+	"ecommerce-be/inventory/factory/singleton"
+	"ecommerce-be/inventory/handler"
+	"ecommerce-be/inventory/model"
+	invConstants "ecommerce-be/inventory/utils/constant"
+
+	"github.com/gin-gonic/gin"
+)
+
+// PurchaseOrderModule implements the Module interface for supplier and purchase order routes
+type PurchaseOrderModule struct {
+	supplierHandler      *handler.SupplierHandler
+	purchaseOrderHandler *handler.PurchaseOrderHandler
+}
+
+// NewPurchaseOrderModule creates a new instance of PurchaseOrderModule
+func NewPurchaseOrderModule() *PurchaseOrderModule {
+	f := singleton.GetInstance()
+
+	return &PurchaseOrderModule{
+		supplierHandler:      f.GetSupplierHandler(),
+		purchaseOrderHandler: f.GetPurchaseOrderHandler(),
+	}
+}
+
+// RegisterRoutes registers supplier and purchase order routes
+func (m *PurchaseOrderModule) RegisterRoutes(router *gin.Engine) {
+	sellerAuth := middleware.SellerAuth()
+
+	// Supplier routes - all protected (seller only) - /api/inventory/suppliers/*
+	supplierRoutes := openapi.NewGroup(
+		router.Group(constants.APIBaseInventory+"/suppliers"),
+		"Suppliers",
+	)
+	{
+		supplierRoutes.POST("", sellerAuth, m.supplierHandler.CreateSupplier).
+			Summary("Create a supplier").
+			Body(model.CreateSupplierRequest{}).
+			ReturnsField(
+				http.StatusCreated,
+				invConstants.SUPPLIER_FIELD_NAME,
+				model.SupplierResponse{},
+			)
+		supplierRoutes.GET("", sellerAuth, m.supplierHandler.ListSuppliers).
+			Summary("List suppliers").
+			Query(model.SuppliersQueryParams{}).
+			ReturnsField(
+				http.StatusOK,
+				invConstants.SUPPLIERS_FIELD_NAME,
+				[]model.SupplierResponse{},
+			)
+		supplierRoutes.GET("/:supplierId", sellerAuth, m.supplierHandler.GetSupplier).
+			Summary("Get a supplier").
+			ReturnsField(
+				http.StatusOK,
+				invConstants.SUPPLIER_FIELD_NAME,
+				model.SupplierResponse{},
+			)
+		supplierRoutes.PUT("/:supplierId", sellerAuth, m.supplierHandler.UpdateSupplier).
+			Summary("Update a supplier").
+			Body(model.UpdateSupplierRequest{}).
+			ReturnsField(
+				http.StatusOK,
+				invConstants.SUPPLIER_FIELD_NAME,
+				model.SupplierResponse{},
+			)
+		supplierRoutes.DELETE("/:supplierId", sellerAuth, m.supplierHandler.DeleteSupplier).
+			Summary("Delete a supplier").
+			Description("Suppliers with purchase orders cannot be deleted; deactivate them instead.")
+	}
+
+	// Purchase order routes - all protected (seller only) - /api/inventory/purchase-orders/*
+	orderRoutes := openapi.NewGroup(
+		router.Group(constants.APIBaseInventory+"/purchase-orders"),
+		"Purchase Orders",
+	)
+	{
+		orderRoutes.POST("", sellerAuth, m.purchaseOrderHandler.CreatePurchaseOrder).
+			Summary("Create a draft purchase order").
+			Body(model.CreatePurchaseOrderRequest{}).
+			ReturnsField(
+				http.StatusCreated,
+				invConstants.PURCHASE_ORDER_FIELD_NAME,
+				model.PurchaseOrderResponse{},
+			)
+		orderRoutes.GET("", sellerAuth, m.purchaseOrderHandler.ListPurchaseOrders).
+			Summary("List purchase orders").
+			Query(model.PurchaseOrdersQueryParams{}).
+			ReturnsField(
+				http.StatusOK,
+				invConstants.PURCHASE_ORDERS_FIELD_NAME,
+				[]model.PurchaseOrderResponse{},
+			)
+		orderRoutes.GET("/:purchaseOrderId", sellerAuth, m.purchaseOrderHandler.GetPurchaseOrder).
+			Summary("Get a purchase order").
+			ReturnsField(
+				http.StatusOK,
+				invConstants.PURCHASE_ORDER_FIELD_NAME,
+				model.PurchaseOrderResponse{},
+			)
+		orderRoutes.PUT(
+			"/:purchaseOrderId",
+			sellerAuth,
+			m.purchaseOrderHandler.UpdatePurchaseOrder,
+		).
+			Summary("Edit a draft purchase order").
+			Description("Lines, when given, replace all existing lines.").
+			Body(model.UpdatePurchaseOrderRequest{}).
+			ReturnsField(
+				http.StatusOK,
+				invConstants.PURCHASE_ORDER_FIELD_NAME,
+				model.PurchaseOrderResponse{},
+			)
+		orderRoutes.POST(
+			"/:purchaseOrderId/send",
+			sellerAuth,
+			m.purchaseOrderHandler.SendPurchaseOrder,
+		).
+			Summary("Mark a purchase order as sent to the supplier").
+			ReturnsField(
+				http.StatusOK,
+				invConstants.PURCHASE_ORDER_FIELD_NAME,
+				model.PurchaseOrderResponse{},
+			)
+		orderRoutes.POST(
+			"/:purchaseOrderId/receive",
+			sellerAuth,
+			m.purchaseOrderHandler.ReceivePurchaseOrder,
+		).
+			Summary("Receive a delivery").
+			Description("Adds the delivered units to stock at the order's location as PURCHASE "+
+				"movements with the unit cost paid. The order closes once fully received.").
+			Body(model.ReceivePurchaseOrderRequest{}).
+			ReturnsField(
+				http.StatusOK,
+				invConstants.PURCHASE_ORDER_FIELD_NAME,
+				model.PurchaseOrderResponse{},
+			)
+		orderRoutes.POST(
+			"/:purchaseOrderId/close",
+			sellerAuth,
+			m.purchaseOrderHandler.ClosePurchaseOrder,
+		).
+			Summary("Close a purchase order").
+			Description("Stops expecting outstanding units, e.g. when the supplier ships short.").
+			ReturnsField(
+				http.StatusOK,
+				invConstants.PURCHASE_ORDER_FIELD_NAME,
+				model.PurchaseOrderResponse{},
+			)
+	}
+}
@@ -0,0 +1,96 @@
+package route
+
+import (
+	"net/http"
+
+	"ecommerce-be/common/constants"
+	"ecommerce-be/common/middleware"
+	"ecommerce-be/common/openapi"
+	"ecommerce-be/inventory/factory/singleton"
+	"ecommerce-be/inventory/handler"
+	"ecommerce-be/inventory/model"
+	invConstants "ecommerce-be/inventory/utils/constant"
+
+	"github.com/gin-gonic/gin"
+)
+
+// StockTakeModule implements the Module interface for stock-take routes
+type StockTakeModule struct {
+	stockTakeHandler *handler.StockTakeHandler
+}
+
+// NewStockTakeModule creates a new instance of StockTakeModule
+func NewStockTakeModule() *StockTakeModule {
+	f := singleton.GetInstance()
+
+	return &StockTakeModule{
+		stockTakeHandler: f.GetStockTakeHandler(),
+	}
+}
+
+// RegisterRoutes registers stock-take routes
+func (m *StockTakeModule) RegisterRoutes(router *gin.Engine) {
+	sellerAuth := middleware.SellerAuth()
+
+	// Stock-take routes - all protected (seller only) - /api/inventory/stock-takes/*
+	stockTakeRoutes := openapi.NewGroup(
+		router.Group(constants.APIBaseInventory+"/stock-takes"),
+		"Stock Takes",
+	)
+	{
+		stockTakeRoutes.POST("", sellerAuth, m.stockTakeHandler.StartStockTake).
+			Summary("Start a stock take for a location").
+			Description("Only one stock take can be open per location.").
+			Body(model.StartStockTakeRequest{}).
+			ReturnsField(
+				http.StatusCreated,
+				invConstants.STOCK_TAKE_FIELD_NAME,
+				model.StockTakeResponse{},
+			)
+		stockTakeRoutes.GET("", sellerAuth, m.stockTakeHandler.ListStockTakes).
+			Summary("List stock takes").
+			Query(model.StockTakesQueryParams{}).
+			ReturnsField(
+				http.StatusOK,
+				invConstants.STOCK_TAKES_FIELD_NAME,
+				[]model.StockTakeResponse{},
+			)
+		stockTakeRoutes.GET("/:stockTakeId", sellerAuth, m.stockTakeHandler.GetStockTake).
+			Summary("Review a stock take and its discrepancies").
+			ReturnsField(
+				http.StatusOK,
+				invConstants.STOCK_TAKE_FIELD_NAME,
+				model.StockTakeResponse{},
+			)
+		stockTakeRoutes.PUT("/:stockTakeId/counts", sellerAuth, m.stockTakeHandler.SubmitCounts).
+			Summary("Submit counted quantities").
+			Description("Each count is compared with the quantity on hand when it is submitted. "+
+				"Submitting a variant again replaces its count.").
+			Body(model.SubmitStockTakeCountsRequest{}).
+			ReturnsField(
+				http.StatusOK,
+				invConstants.STOCK_TAKE_FIELD_NAME,
+				model.StockTakeResponse{},
+			)
+		stockTakeRoutes.POST("/:stockTakeId/apply", sellerAuth, m.stockTakeHandler.ApplyStockTake).
+			Summary("Apply stock take corrections").
+			Description("Records every discrepancy as an ADJUSTMENT movement with the STOCK_TAKE "+
+				"reason. If any correction is rejected none are applied.").
+			ReturnsField(
+				http.StatusOK,
+				invConstants.STOCK_TAKE_FIELD_NAME,
+				model.StockTakeResponse{},
+			)
+		stockTakeRoutes.POST(
+			"/:stockTakeId/cancel",
+			sellerAuth,
+			m.stockTakeHandler.CancelStockTake,
+		).
+			Summary("Cancel a stock take without changing stock").
+			ReturnsField(
+				http.StatusOK,
+				invConstants.STOCK_TAKE_FIELD_NAME,
+				model.StockTakeResponse{},
+			)
+	}
+}
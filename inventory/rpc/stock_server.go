@@ -0,0 +1,99 @@
+// Package rpc exposes inventory stock checks over gRPC using the same query service as the
+// REST handlers. Caller identity is resolved by the grpcserver interceptors.
+package rpc
+
+import (
+	"context"
+	"net/http"
+
+	"ecommerce-be/common/auth"
+	"ecommerce-be/common/constants"
+	commonError "ecommerce-be/common/error"
+	invErrors "ecommerce-be/inventory/error"
+	"ecommerce-be/inventory/model"
+	"ecommerce-be/inventory/service"
+	productEntity "ecommerce-be/product/entity"
+	inventoryv1 "ecommerce-be/proto/inventory/v1"
+)
+
+// StockServer implements inventoryv1.StockServiceServer
+type StockServer struct {
+	inventoryv1.UnimplementedStockServiceServer
+
+	inventoryQueryService service.InventoryQueryService
+}
+
+// NewStockServer creates a gRPC stock server backed by the inventory query service
+func NewStockServer(inventoryQueryService service.InventoryQueryService) *StockServer {
+	return &StockServer{inventoryQueryService: inventoryQueryService}
+}
+
+// CheckStock reports whether each requested variant quantity can be sold from the seller's
+// active locations. A channel applies that channel's allocation rules to the pooled stock.
+func (s *StockServer) CheckStock(
+	ctx context.Context,
+	req *inventoryv1.CheckStockRequest,
+) (*inventoryv1.CheckStockResponse, error) {
+	sellerID, exists := auth.GetSellerIDFromContext(ctx)
+	if !exists {
+		return nil, commonError.NewAppError(
+			constants.SELLER_ID_REQUIRED_CODE,
+			constants.SELLER_ID_REQUIRED_MSG,
+			http.StatusBadRequest,
+		)
+	}
+	if req.GetChannel() != "" && !productEntity.NormalizeSalesChannel(req.GetChannel()).IsValid() {
+		return nil, invErrors.ErrInvalidAllocationChannel
+	}
+
+	// Quantities for repeated variants are summed so the check covers the combined demand
+	requested := make(map[uint]int, len(req.GetItems()))
+	variantIDs := make([]uint, 0, len(req.GetItems()))
+	for _, item := range req.GetItems() {
+		if item.GetVariantId() == 0 {
+			return nil, commonError.ErrInvalidID
+		}
+		if item.GetQuantity() <= 0 {
+			return nil, invErrors.ErrInvalidQuantity
+		}
+		variantID := uint(item.GetVariantId())
+		if _, seen := requested[variantID]; !seen {
+			variantIDs = append(variantIDs, variantID)
+		}
+		requested[variantID] += int(item.GetQuantity())
+	}
+
+	response := &inventoryv1.CheckStockResponse{
+		Items:      make([]*inventoryv1.StockAvailability, 0, len(variantIDs)),
+		AllInStock: true,
+	}
+	if len(variantIDs) == 0 {
+		return response, nil
+	}
+
+	totals, err := s.inventoryQueryService.GetTotalAvailableQuantities(
+		ctx,
+		model.TotalAvailableQuantityRequest{VariantIDs: variantIDs, Channel: req.GetChannel()},
+		sellerID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	available := make(map[uint]int, len(totals.Items))
+	for _, item := range totals.Items {
+		available[item.VariantID] = item.TotalAvailable
+	}
+
+	for _, variantID := range variantIDs {
+		inStock := available[variantID] >= requested[variantID]
+		response.Items = append(response.Items, &inventoryv1.StockAvailability{
+			VariantId: uint64(variantID),
+			Requested: int32(requested[variantID]),
+			Available: int32(available[variantID]),
+			InStock:   inStock,
+		})
+		response.AllInStock = response.AllInStock && inStock
+	}
+	return response, nil
+}
@@ -0,0 +1,237 @@
+package service
+
+import (
+	"context"
+
+	"ecommerce-be/common/db"
+	"ecommerce-be/inventory/entity"
+	invErrors "ecommerce-be/inventory/error"
+	"ecommerce-be/inventory/factory"
+	"ecommerce-be/inventory/model"
+	"ecommerce-be/inventory/repository"
+	"ecommerce-be/inventory/utils/helper"
+	productEntity "ecommerce-be/product/entity"
+	productService "ecommerce-be/product/service"
+)
+
+// ChannelAllocationService manages how pooled stock is shared between sales channels
+// and reports the resulting per-channel availability.
+type ChannelAllocationService interface {
+	// UpsertRule creates or replaces the rule of one channel for the seller default or a variant
+	UpsertRule(
+		ctx context.Context,
+		sellerID uint,
+		req model.ChannelAllocationRuleRequest,
+	) (*model.ChannelAllocationRuleResponse, error)
+
+	// ListRules lists all seller rules, or only a variant's own rules when variantID is set
+	ListRules(
+		ctx context.Context,
+		sellerID uint,
+		variantID *uint,
+	) ([]model.ChannelAllocationRuleResponse, error)
+
+	DeleteRule(ctx context.Context, sellerID uint, ruleID uint) error
+
+	// GetChannelAvailability reports pooled stock and what each channel may sell of it
+	GetChannelAvailability(
+		ctx context.Context,
+		sellerID uint,
+		req model.ChannelAvailabilityRequest,
+	) (*model.ChannelAvailabilityResponse, error)
+}
+
+type ChannelAllocationServiceImpl struct {
+	allocationRepo        repository.ChannelAllocationRepository
+	inventoryQueryService InventoryQueryService
+	variantQueryService   productService.VariantQueryService
+}
+
+// NewChannelAllocationService creates a new instance of ChannelAllocationService
+func NewChannelAllocationService(
+	allocationRepo repository.ChannelAllocationRepository,
+	inventoryQueryService InventoryQueryService,
+	variantQueryService productService.VariantQueryService,
+) *ChannelAllocationServiceImpl {
+	return &ChannelAllocationServiceImpl{
+		allocationRepo:        allocationRepo,
+		inventoryQueryService: inventoryQueryService,
+		variantQueryService:   variantQueryService,
+	}
+}
+
+// UpsertRule validates the channel and variant ownership, then saves the rule. The reserved
+// shares effective for the scope (defaults merged with the variant's rules) must not exceed 100%.
+func (s *ChannelAllocationServiceImpl) UpsertRule(
+	ctx context.Context,
+	sellerID uint,
+	req model.ChannelAllocationRuleRequest,
+) (*model.ChannelAllocationRuleResponse, error) {
+	channel := productEntity.NormalizeSalesChannel(req.Channel)
+	if !channel.IsValid() {
+		return nil, invErrors.ErrInvalidAllocationChannel
+	}
+
+	if req.VariantID != nil {
+		variants, err := s.variantQueryService.GetProductBasicInfoByVariantIDs(
+			ctx,
+			[]uint{*req.VariantID},
+			&sellerID,
+		)
+		if err != nil {
+			return nil, err
+		}
+		if len(variants) == 0 {
+			return nil, invErrors.ErrVariantNotFound
+		}
+	}
+
+	return db.WithTransactionResult(ctx,
+		func(txCtx context.Context) (*model.ChannelAllocationRuleResponse, error) {
+			rule, err := s.findOrNewRule(txCtx, sellerID, req.VariantID, channel.String())
+			if err != nil {
+				return nil, err
+			}
+			rule.ReservePercent = req.ReservePercent
+			rule.BufferQuantity = req.BufferQuantity
+
+			if err := s.validateReserveTotal(txCtx, sellerID, *rule); err != nil {
+				return nil, err
+			}
+
+			if err := s.allocationRepo.Save(txCtx, rule); err != nil {
+				return nil, err
+			}
+			response := factory.BuildChannelAllocationRuleResponse(*rule)
+			return &response, nil
+		},
+	)
+}
+
+// ListRules lists the seller's allocation rules
+func (s *ChannelAllocationServiceImpl) ListRules(
+	ctx context.Context,
+	sellerID uint,
+	variantID *uint,
+) ([]model.ChannelAllocationRuleResponse, error) {
+	var (
+		rules []entity.ChannelAllocationRule
+		err   error
+	)
+	if variantID != nil {
+		rules, err = s.allocationRepo.FindByScope(ctx, sellerID, variantID)
+	} else {
+		rules, err = s.allocationRepo.FindBySellerID(ctx, sellerID)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return factory.BuildChannelAllocationRuleResponses(rules), nil
+}
+
+// DeleteRule removes a rule; a deleted variant rule falls back to the seller default
+func (s *ChannelAllocationServiceImpl) DeleteRule(
+	ctx context.Context,
+	sellerID uint,
+	ruleID uint,
+) error {
+	rule, err := s.allocationRepo.FindByID(ctx, ruleID, sellerID)
+	if err != nil {
+		return err
+	}
+	return s.allocationRepo.Delete(ctx, rule.ID)
+}
+
+// GetChannelAvailability reports pooled available stock per variant split across channels
+func (s *ChannelAllocationServiceImpl) GetChannelAvailability(
+	ctx context.Context,
+	sellerID uint,
+	req model.ChannelAvailabilityRequest,
+) (*model.ChannelAvailabilityResponse, error) {
+	pooled, err := s.inventoryQueryService.GetTotalAvailableQuantities(
+		ctx,
+		model.TotalAvailableQuantityRequest{
+			VariantIDs: req.VariantIDs,
+			ProductIDs: req.ProductIDs,
+		},
+		sellerID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	variantIDs := make([]uint, 0, len(pooled.Items))
+	for _, item := range pooled.Items {
+		variantIDs = append(variantIDs, item.VariantID)
+	}
+	rules, err := s.allocationRepo.FindApplicable(ctx, sellerID, variantIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &model.ChannelAvailabilityResponse{
+		Items: make([]model.VariantChannelAvailability, 0, len(pooled.Items)),
+	}
+	for _, item := range pooled.Items {
+		response.Items = append(
+			response.Items,
+			factory.BuildVariantChannelAvailability(item.VariantID, item.TotalAvailable, rules),
+		)
+	}
+	return response, nil
+}
+
+// findOrNewRule returns the existing rule of the scope and channel, or an unsaved new one
+func (s *ChannelAllocationServiceImpl) findOrNewRule(
+	ctx context.Context,
+	sellerID uint,
+	variantID *uint,
+	channel string,
+) (*entity.ChannelAllocationRule, error) {
+	rules, err := s.allocationRepo.FindByScope(ctx, sellerID, variantID)
+	if err != nil {
+		return nil, err
+	}
+	for i := range rules {
+		if rules[i].Channel == channel {
+			return &rules[i], nil
+		}
+	}
+	return &entity.ChannelAllocationRule{
+		SellerID:  sellerID,
+		VariantID: variantID,
+		Channel:   channel,
+	}, nil
+}
+
+// validateReserveTotal checks the reserved shares effective for the rule's scope stay within 100%
+func (s *ChannelAllocationServiceImpl) validateReserveTotal(
+	ctx context.Context,
+	sellerID uint,
+	rule entity.ChannelAllocationRule,
+) error {
+	var (
+		rules     []entity.ChannelAllocationRule
+		variantID uint
+		err       error
+	)
+	if rule.VariantID != nil {
+		variantID = *rule.VariantID
+		rules, err = s.allocationRepo.FindApplicable(ctx, sellerID, []uint{variantID})
+	} else {
+		rules, err = s.allocationRepo.FindByScope(ctx, sellerID, nil)
+	}
+	if err != nil {
+		return err
+	}
+
+	allocations := helper.ResolveChannelAllocations(rules, variantID)
+	allocations[rule.Channel] = helper.ChannelAllocation{
+		ReservePercent: rule.ReservePercent,
+		BufferQuantity: rule.BufferQuantity,
+	}
+	if helper.TotalReservePercent(allocations) > 100 {
+		return invErrors.ErrChannelReserveExceedsPool
+	}
+	return nil
+}
@@ -4,8 +4,10 @@ import (
 	"context"
 	"fmt"
 
+	"ecommerce-be/common/db"
 	"ecommerce-be/common/helper"
 	"ecommerce-be/inventory/entity"
+	invErrors "ecommerce-be/inventory/error"
 	"ecommerce-be/inventory/model"
 	"ecommerce-be/inventory/repository"
 	productModel "ecommerce-be/product/model"
@@ -137,6 +139,31 @@ func (h *BulkInventoryHelper) GetOrPrepareInventory(
 	return newInventory, true
 }
 
+// ValidateExpectedVersions rejects the request when an item's expected version is not
+// the current version of its inventory row. A missing row is at version 0.
+func (h *BulkInventoryHelper) ValidateExpectedVersions(
+	items []model.ManageInventoryRequest,
+	existingMap map[string]*entity.Inventory,
+) error {
+	var conflicts []db.VersionConflict
+	for _, item := range items {
+		if item.ExpectedVersion == nil {
+			continue
+		}
+		var current db.VersionConflict
+		if existing, found := existingMap[BuildInventoryKey(item.VariantID, item.LocationID)]; found {
+			current = db.VersionConflict{ID: existing.ID, CurrentVersion: existing.Version}
+		}
+		if *item.ExpectedVersion != current.CurrentVersion {
+			conflicts = append(conflicts, current)
+		}
+	}
+	if len(conflicts) > 0 {
+		return invErrors.ErrInventoryVersionConflict.WithDetails(conflicts)
+	}
+	return nil
+}
+
 // BuildInventoryKey creates a composite key for inventory lookup
 func BuildInventoryKey(variantID uint, locationID uint) string {
 	return fmt.Sprintf("%d:%d", variantID, locationID)
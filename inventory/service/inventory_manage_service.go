@@ -2,13 +2,17 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"ecommerce-be/common/db"
+	commonError "ecommerce-be/common/error"
 	"ecommerce-be/common/log"
+	"ecommerce-be/common/notifier"
 	"ecommerce-be/inventory/entity"
 	"ecommerce-be/inventory/model"
 	"ecommerce-be/inventory/repository"
+	"ecommerce-be/inventory/utils/constant"
 	"ecommerce-be/inventory/utils/helper"
 	productModel "ecommerce-be/product/model"
 	"ecommerce-be/product/service"
@@ -21,6 +25,7 @@ type InventoryServiceImpl struct {
 	locationRepo        repository.LocationRepository
 	variantQueryService service.VariantQueryService
 	bulkHelper          *BulkInventoryHelper
+	notifier            notifier.Notifier
 }
 
 // NewInventoryService creates a new instance of InventoryService
@@ -30,6 +35,7 @@ func NewInventoryService(
 	locationRepo repository.LocationRepository,
 	variantQueryService service.VariantQueryService,
 	bulkHelper *BulkInventoryHelper,
+	notifier notifier.Notifier,
 ) *InventoryServiceImpl {
 	return &InventoryServiceImpl{
 		inventoryRepo:       inventoryRepo,
@@ -37,6 +43,7 @@ func NewInventoryService(
 		locationRepo:        locationRepo,
 		variantQueryService: variantQueryService,
 		bulkHelper:          bulkHelper,
+		notifier:            notifier,
 	}
 }
 
@@ -98,8 +105,14 @@ func (s *InventoryServiceImpl) buildTransactionParams(
 		PerformedBy:     userID,
 		Reference:       req.Reference,
 		ReferenceType:   referenceType,
+		ReasonCode:      txnType.DefaultReasonCode(),
 		Reason:          req.Reason,
 		Note:            req.Note,
+		UnitCostCents:   req.UnitCostCents,
+	}
+
+	if req.ReasonCode != nil {
+		params.ReasonCode = *req.ReasonCode
 	}
 
 	// Determine quantity changes based on transaction type
@@ -129,6 +142,14 @@ func (s *InventoryServiceImpl) buildTransactionParams(
 		params.AfterReservedQuantity = previousReserved // No change
 	}
 
+	// RETURN: record the disposition; held units are not part of QuantityChange
+	if disposition := helper.ReturnDispositionOf(req); disposition != "" {
+		params.Disposition = &disposition
+		if disposition.IsHeld() {
+			params.HeldQuantityChange = req.Quantity
+		}
+	}
+
 	return params
 }
 
@@ -148,6 +169,10 @@ func (s *InventoryServiceImpl) BulkManageInventory(
 	if err != nil {
 		return nil, err
 	}
+	err = s.bulkHelper.ValidateExpectedVersions(req.Items, batchData.existingInventoryMap)
+	if err != nil {
+		return nil, err
+	}
 
 	// Phase 5: Process all items and prepare bulk operations
 	collector := s.processAllBulkItems(req.Items, batchData)
@@ -155,12 +180,20 @@ func (s *InventoryServiceImpl) BulkManageInventory(
 	// Phase 6: Execute all DB operations in a single transaction
 	transactions, err := s.executeBulkDBOperations(ctx, collector, req.Items, userID)
 	if err != nil {
+		// A concurrent update won the race; the client retries with fresh versions
+		var appErr *commonError.AppError
+		if errors.As(err, &appErr) && appErr.Code == constant.INVENTORY_VERSION_CONFLICT_CODE {
+			return nil, appErr
+		}
 		return s.buildBulkResponse(collector), nil
 	}
 
 	// Phase 7: Build final response with transaction IDs
 	s.updateResultsWithTransactionIDs(collector, req.Items, transactions)
 
+	// Phase 8: Alert the seller about items that just dropped to low stock
+	s.notifyLowStock(ctx, sellerID, collector, batchData)
+
 	log.InfoWithContext(ctx, fmt.Sprintf(
 		"Bulk inventory operation completed: %d success, %d failed",
 		collector.SuccessCount, collector.FailureCount,
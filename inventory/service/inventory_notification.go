@@ -0,0 +1,55 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"ecommerce-be/common/constants"
+	"ecommerce-be/common/log"
+	"ecommerce-be/inventory/utils/helper"
+)
+
+// notifyLowStock sends the seller a best-effort alert for every inventory in the
+// operation that crossed its low-stock threshold. Failures are logged only.
+func (s *InventoryServiceImpl) notifyLowStock(
+	ctx context.Context,
+	sellerID uint,
+	collector *BulkOperationCollector,
+	batchData *bulkBatchData,
+) {
+	if s.notifier == nil {
+		return
+	}
+
+	for _, pending := range collector.PendingResults {
+		inventory := pending.Inventory
+		if !helper.CrossedLowStockThreshold(
+			pending.PreviousQuantity,
+			inventory.Quantity,
+			inventory.Threshold,
+		) {
+			continue
+		}
+
+		payload := map[string]any{
+			"VariantID":  inventory.VariantID,
+			"LocationID": inventory.LocationID,
+			"Quantity":   inventory.Quantity,
+			"Threshold":  inventory.Threshold,
+		}
+		if variant, ok := batchData.validVariants[inventory.VariantID]; ok && variant != nil {
+			payload["VariantSKU"] = variant.SKU
+		}
+		if location, ok := batchData.validLocations[inventory.LocationID]; ok && location != nil {
+			payload["LocationName"] = location.Name
+		}
+
+		err := s.notifier.Notify(ctx, constants.NOTIFY_EVENT_INVENTORY_LOW_STOCK, sellerID, payload)
+		if err != nil {
+			log.ErrorWithContext(ctx, fmt.Sprintf(
+				"inventory: failed to queue low stock notification for inventory %d",
+				inventory.ID,
+			), err)
+		}
+	}
+}
@@ -10,22 +10,34 @@ import (
 	"ecommerce-be/inventory/factory"
 	"ecommerce-be/inventory/model"
 	"ecommerce-be/inventory/repository"
+	"ecommerce-be/inventory/utils/helper"
 	"ecommerce-be/inventory/validator"
+	productEntity "ecommerce-be/product/entity"
+	productService "ecommerce-be/product/service"
 )
 
 type InventoryQueryServiceImpl struct {
-	inventoryRepo repository.InventoryRepository
-	locationRepo  repository.LocationRepository
+	inventoryRepo  repository.InventoryRepository
+	locationRepo   repository.LocationRepository
+	allocationRepo repository.ChannelAllocationRepository
+	bundleService  productService.VariantBundleService
+	digitalService productService.DigitalDeliveryService
 }
 
 // NewInventoryQueryService creates a new instance of InventoryQueryService
 func NewInventoryQueryServiceImpl(
 	inventoryRepo repository.InventoryRepository,
 	locationRepo repository.LocationRepository,
+	allocationRepo repository.ChannelAllocationRepository,
+	bundleService productService.VariantBundleService,
+	digitalService productService.DigitalDeliveryService,
 ) *InventoryQueryServiceImpl {
 	return &InventoryQueryServiceImpl{
-		inventoryRepo: inventoryRepo,
-		locationRepo:  locationRepo,
+		inventoryRepo:  inventoryRepo,
+		locationRepo:   locationRepo,
+		allocationRepo: allocationRepo,
+		bundleService:  bundleService,
+		digitalService: digitalService,
 	}
 }
 
@@ -119,7 +131,8 @@ func (s *InventoryQueryServiceImpl) GetInventories(
 }
 
 // GetTotalAvailableQuantities queries the database to aggregate the total available quantity
-// (quantity - reserved - threshold) for a batch of variants or products
+// (quantity - reserved - threshold) for a batch of variants or products.
+// When req.Channel is set, the totals are what that channel may sell under allocation rules.
 func (s *InventoryQueryServiceImpl) GetTotalAvailableQuantities(
 	ctx context.Context,
 	req model.TotalAvailableQuantityRequest,
@@ -146,6 +159,15 @@ func (s *InventoryQueryServiceImpl) GetTotalAvailableQuantities(
 		return nil, err
 	}
 
+	pooled := make(map[uint]int, len(rows))
+	for _, row := range rows {
+		pooled[row.VariantID] = row.TotalAvailable
+	}
+	available, err := s.applyChannelAllocation(ctx, sellerID, req.Channel, pooled)
+	if err != nil {
+		return nil, err
+	}
+
 	response := &model.TotalAvailableQuantityResponse{
 		Items: make([]model.VariantAvailableQuantity, len(rows)),
 	}
@@ -153,19 +175,123 @@ func (s *InventoryQueryServiceImpl) GetTotalAvailableQuantities(
 	for i, row := range rows {
 		response.Items[i] = model.VariantAvailableQuantity{
 			VariantID:      row.VariantID,
-			TotalAvailable: row.TotalAvailable,
+			TotalAvailable: available[row.VariantID],
 		}
 	}
 
 	return response, nil
 }
 
+// CheckStock loads the inventory of every requested variant at the seller's active
+// locations in one query and reports what each can sell. Bundles hold no stock: they are
+// checked through their components, which must also cover the rest of the request.
+// Variants of digital products are not stock-tracked and always in stock.
+func (s *InventoryQueryServiceImpl) CheckStock(
+	ctx context.Context,
+	req model.StockCheckRequest,
+	sellerID uint,
+) (*model.StockCheckResponse, error) {
+	if req.Channel != "" && !productEntity.NormalizeSalesChannel(req.Channel).IsValid() {
+		return nil, invErrors.ErrInvalidAllocationChannel
+	}
+	variantIDs, requested := helper.MergeStockCheckItems(req.Items)
+
+	bundles, err := s.bundleService.GetBundleComponents(ctx, variantIDs)
+	if err != nil {
+		return nil, err
+	}
+	stockIDs, demand := helper.ExpandBundleDemand(variantIDs, requested, bundles)
+
+	digital, err := s.digitalService.GetDigitalVariantIDs(ctx, stockIDs)
+	if err != nil {
+		return nil, err
+	}
+	trackedIDs := make([]uint, 0, len(stockIDs))
+	for _, variantID := range stockIDs {
+		if _, isDigital := digital[variantID]; !isDigital {
+			trackedIDs = append(trackedIDs, variantID)
+		}
+	}
+
+	locations, err := s.locationRepo.FindActiveByPriority(ctx, sellerID)
+	if err != nil {
+		return nil, err
+	}
+	locationIDs := make([]uint, len(locations))
+	locationNames := make(map[uint]string, len(locations))
+	for i, loc := range locations {
+		locationIDs[i] = loc.ID
+		locationNames[loc.ID] = loc.Name
+	}
+
+	inventoryMap := map[uint][]*entity.Inventory{}
+	if len(locationIDs) > 0 && len(trackedIDs) > 0 {
+		inventories, err := s.inventoryRepo.FindByVariantAndLocationBatch(
+			ctx,
+			trackedIDs,
+			locationIDs,
+		)
+		if err != nil {
+			return nil, err
+		}
+		inventoryMap = s.buildInventoryMapByPriority(inventories, locationIDs)
+	}
+
+	pooled := make(map[uint]int, len(trackedIDs))
+	for _, variantID := range trackedIDs {
+		pooled[variantID] = helper.PooledAvailableQuantity(inventoryMap[variantID])
+	}
+	channelLimits, err := s.applyChannelAllocation(ctx, sellerID, req.Channel, pooled)
+	if err != nil {
+		return nil, err
+	}
+
+	stock := make(map[uint]model.StockCheckResult, len(stockIDs))
+	for _, variantID := range stockIDs {
+		if _, isDigital := digital[variantID]; isDigital {
+			stock[variantID] = helper.UntrackedStockCheckResult(variantID, demand[variantID])
+			continue
+		}
+		stock[variantID] = helper.BuildStockCheckResult(
+			variantID,
+			demand[variantID],
+			inventoryMap[variantID],
+			channelLimits[variantID],
+			locationNames,
+		)
+	}
+
+	response := &model.StockCheckResponse{
+		Items:      make([]model.StockCheckResult, 0, len(variantIDs)),
+		AllInStock: true,
+	}
+	for _, variantID := range variantIDs {
+		var result model.StockCheckResult
+		if components, isBundle := bundles[variantID]; isBundle {
+			result = helper.BuildBundleStockCheckResult(
+				variantID,
+				requested[variantID],
+				components,
+				stock,
+			)
+		} else {
+			result = stock[variantID]
+			result.RequestedQuantity = requested[variantID]
+		}
+		response.Items = append(response.Items, result)
+		response.AllInStock = response.AllInStock && result.InStock
+	}
+	return response, nil
+}
+
 // GetInventoryByVariantAndLocationPriority retrieves inventory allocations for reservation items,
 // selecting inventory from locations by priority and splitting across multiple locations when needed.
+// A non-empty channel caps each variant at what the channel may sell under allocation rules.
 func (s *InventoryQueryServiceImpl) GetInventoryByVariantAndLocationPriority(
 	ctx context.Context,
 	items []model.ReservationItem,
 	sellerID uint,
+	channel string,
 ) ([]model.InventoryResponse, error) {
 	if len(items) == 0 {
 		return nil, nil
@@ -185,9 +311,80 @@ func (s *InventoryQueryServiceImpl) GetInventoryByVariantAndLocationPriority(
 
 	inventoryMap := s.buildInventoryMapByPriority(inventories, locationIDs)
 
+	if err := s.validateChannelAllocation(
+		ctx, sellerID, channel, requestedQty, inventoryMap,
+	); err != nil {
+		return nil, err
+	}
+
 	return s.allocateInventoryByPriority(variantIDs, requestedQty, inventoryMap)
 }
 
+// validateChannelAllocation rejects requests exceeding what the channel may sell of the
+// pooled stock across all active locations
+func (s *InventoryQueryServiceImpl) validateChannelAllocation(
+	ctx context.Context,
+	sellerID uint,
+	channel string,
+	requestedQty map[uint]int,
+	inventoryMap map[uint][]*entity.Inventory,
+) error {
+	if channel == "" {
+		return nil
+	}
+
+	pooled := make(map[uint]int, len(requestedQty))
+	for variantID := range requestedQty {
+		for _, inv := range inventoryMap[variantID] {
+			pooled[variantID] += inv.Quantity - inv.ReservedQuantity - inv.Threshold
+		}
+	}
+
+	available, err := s.applyChannelAllocation(ctx, sellerID, channel, pooled)
+	if err != nil {
+		return err
+	}
+	for variantID, qty := range requestedQty {
+		if qty > available[variantID] {
+			return invErrors.ErrInsufficientStock
+		}
+	}
+	return nil
+}
+
+// applyChannelAllocation converts pooled available quantities per variant into what channel
+// may sell. A blank channel returns the pooled quantities unchanged.
+func (s *InventoryQueryServiceImpl) applyChannelAllocation(
+	ctx context.Context,
+	sellerID uint,
+	channel string,
+	pooled map[uint]int,
+) (map[uint]int, error) {
+	normalized := productEntity.NormalizeSalesChannel(channel).String()
+	if normalized == "" || len(pooled) == 0 {
+		return pooled, nil
+	}
+
+	variantIDs := make([]uint, 0, len(pooled))
+	for variantID := range pooled {
+		variantIDs = append(variantIDs, variantID)
+	}
+	rules, err := s.allocationRepo.FindApplicable(ctx, sellerID, variantIDs)
+	if err != nil {
+		return nil, err
+	}
+	if len(rules) == 0 {
+		return pooled, nil
+	}
+
+	available := make(map[uint]int, len(pooled))
+	for variantID, qty := range pooled {
+		allocations := helper.ResolveChannelAllocations(rules, variantID)
+		available[variantID] = helper.ChannelAvailableQuantity(qty, normalized, allocations)
+	}
+	return available, nil
+}
+
 // extractVariantRequests extracts variant IDs and requested quantities from reservation items
 func (s *InventoryQueryServiceImpl) extractVariantRequests(
 	items []model.ReservationItem,
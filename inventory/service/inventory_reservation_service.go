@@ -7,13 +7,14 @@ import (
 	"time"
 
 	"ecommerce-be/common"
-	"ecommerce-be/common/constants"
 	"ecommerce-be/common/db"
 	"ecommerce-be/common/helper"
 	"ecommerce-be/common/log"
+	"ecommerce-be/common/reqctx"
 	"ecommerce-be/inventory/entity"
 	"ecommerce-be/inventory/model"
 	"ecommerce-be/inventory/repository"
+	invHelper "ecommerce-be/inventory/utils/helper"
 	"ecommerce-be/inventory/validator"
 	"ecommerce-be/product/mapper"
 	"ecommerce-be/product/service"
@@ -48,6 +49,8 @@ type InventoryReservationServiceImpl struct {
 	variantService         service.VariantQueryService
 	schedulerService       ReservationSchedulerService
 	inventoryManageService InventoryManageService
+	bundleService          service.VariantBundleService
+	digitalService         service.DigitalDeliveryService
 }
 
 // NewInventoryReservationService creates a new instance of InventoryReservationServiceImpl
@@ -58,6 +61,8 @@ func NewInventoryReservationService(
 	variantService service.VariantQueryService,
 	schedulerService ReservationSchedulerService,
 	inventoryManageService InventoryManageService,
+	bundleService service.VariantBundleService,
+	digitalService service.DigitalDeliveryService,
 ) *InventoryReservationServiceImpl {
 	return &InventoryReservationServiceImpl{
 		reservationRepo:        reservationRepo,
@@ -65,12 +70,16 @@ func NewInventoryReservationService(
 		variantService:         variantService,
 		schedulerService:       schedulerService,
 		inventoryManageService: inventoryManageService,
+		bundleService:          bundleService,
+		digitalService:         digitalService,
 	}
 }
 
 // CreateReservation creates inventory reservations for the requested items.
 // It validates variant ownership, checks inventory availability across locations,
 // reserves the stock, and schedules automatic expiration via Redis.
+// Bundles are reserved as their components, so confirming or fulfilling the reservation
+// moves component stock. Variants of digital products hold no stock and are skipped.
 // The reservation is created within a database transaction to ensure consistency.
 func (s *InventoryReservationServiceImpl) CreateReservation(
 	ctx context.Context,
@@ -95,16 +104,35 @@ func (s *InventoryReservationServiceImpl) CreateReservation(
 				return nil, err
 			}
 
+			bundles, err := s.bundleService.GetBundleComponents(txCtx, variantIds)
+			if err != nil {
+				return nil, err
+			}
+			req.Items = invHelper.ExpandBundleReservationItems(req.Items, bundles)
+
+			digital, err := s.digitalService.GetDigitalVariantIDs(
+				txCtx, s.extractReqVariantIds(req.Items),
+			)
+			if err != nil {
+				return nil, err
+			}
+			req.Items = invHelper.DropUntrackedReservationItems(req.Items, digital)
+
+			expiresAt := time.Now().Add(time.Duration(req.ExpiresInMinutes) * time.Minute)
+			if len(req.Items) == 0 {
+				return s.buildReservationResponse(req.ReferenceId, expiresAt, nil, nil), nil
+			}
+
 			inventories, err := s.inventoryQueryService.GetInventoryByVariantAndLocationPriority(
 				txCtx,
 				req.Items,
 				sellerId,
+				req.Channel,
 			)
 			if err != nil {
 				return nil, err
 			}
 
-			expiresAt := time.Now().Add(time.Duration(req.ExpiresInMinutes) * time.Minute)
 			reservationEntities := s.buildreservationEntities(req, inventories, expiresAt)
 
 			if err = s.reservationRepo.CreateOrSave(txCtx, reservationEntities); err != nil {
@@ -384,7 +412,7 @@ func (s *InventoryReservationServiceImpl) manageInventoryQuantity(
 	}
 
 	reason := "Inventory " + strings.ToLower(string(transactionType)) + " for reservation ID "
-	userId := ctx.Value(constants.USER_ID_KEY).(uint)
+	userId, _ := reqctx.UserID.Get(ctx)
 	var manageInventoryRequests []model.ManageInventoryRequest
 	for _, reservation := range reservationEntities {
 		inv := mapInventory[reservation.InventoryID]
@@ -50,9 +50,18 @@ type InventoryQueryService interface {
 		sellerID uint,
 	) (*model.TotalAvailableQuantityResponse, error)
 
+	// CheckStock reports availability, reservable quantity and per-location stock for a
+	// batch of variant quantities, e.g. to validate a cart at checkout
+	CheckStock(
+		ctx context.Context,
+		req model.StockCheckRequest,
+		sellerID uint,
+	) (*model.StockCheckResponse, error)
+
 	GetInventoryByVariantAndLocationPriority(
 		ctx context.Context,
 		items []model.ReservationItem,
 		sellerID uint,
+		channel string,
 	) ([]model.InventoryResponse, error)
 }
@@ -102,6 +102,11 @@ func (s *InventoryTransactionServiceImpl) buildTransactionsFromParams(
 	transactions := make([]*entity.InventoryTransaction, len(params))
 
 	for i, p := range params {
+		reasonCode := p.ReasonCode
+		if reasonCode == "" {
+			reasonCode = p.TransactionType.DefaultReasonCode()
+		}
+
 		transactions[i] = &entity.InventoryTransaction{
 			InventoryID: p.InventoryID,
 			Type:        p.TransactionType,
@@ -116,9 +121,16 @@ func (s *InventoryTransactionServiceImpl) buildTransactionsFromParams(
 			BeforeReservedQuantity: p.BeforeReservedQuantity,
 			AfterReservedQuantity:  p.AfterReservedQuantity,
 
+			// Return disposition
+			Disposition:        p.Disposition,
+			HeldQuantityChange: p.HeldQuantityChange,
+
+			UnitCostCents: p.UnitCostCents,
+
 			PerformedBy:   p.PerformedBy,
 			ReferenceID:   p.Reference,
 			ReferenceType: helper.StringPtr(p.ReferenceType),
+			ReasonCode:    reasonCode,
 			Reason:        p.Reason,
 			Note:          p.Note,
 		}
@@ -219,9 +231,16 @@ func (s *InventoryTransactionServiceImpl) buildTransactionResponse(
 		BeforeReservedQuantity: txn.BeforeReservedQuantity,
 		AfterReservedQuantity:  txn.AfterReservedQuantity,
 
+		// Return disposition
+		Disposition:        txn.Disposition,
+		HeldQuantityChange: txn.HeldQuantityChange,
+
+		UnitCostCents: txn.UnitCostCents,
+
 		PerformedBy:   txn.PerformedBy,
 		ReferenceID:   txn.ReferenceID,
 		ReferenceType: txn.ReferenceType,
+		ReasonCode:    txn.ReasonCode,
 		Reason:        txn.Reason,
 		Note:          txn.Note,
 		CreatedAt:     txn.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
@@ -0,0 +1,110 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"ecommerce-be/common/constants"
+	"ecommerce-be/common/db"
+	invErrors "ecommerce-be/inventory/error"
+	"ecommerce-be/inventory/model"
+	"ecommerce-be/inventory/repository"
+	"ecommerce-be/inventory/utils/helper"
+	userErrors "ecommerce-be/user/error"
+	userService "ecommerce-be/user/service"
+)
+
+// InventoryValuationService values the seller's stock and its cost of goods sold
+// from the stock ledger, using the seller's costing method
+type InventoryValuationService interface {
+	// GetValuation reports opening and closing stock value and COGS for a period
+	GetValuation(
+		ctx context.Context,
+		sellerID uint,
+		params model.InventoryValuationParams,
+	) (*model.InventoryValuationResponse, error)
+}
+
+// InventoryValuationServiceImpl implements InventoryValuationService
+type InventoryValuationServiceImpl struct {
+	transactionRepo       repository.InventoryTransactionRepository
+	sellerSettingsService userService.SellerSettingsService
+}
+
+// NewInventoryValuationService creates a new instance of InventoryValuationService
+func NewInventoryValuationService(
+	transactionRepo repository.InventoryTransactionRepository,
+	sellerSettingsService userService.SellerSettingsService,
+) *InventoryValuationServiceImpl {
+	return &InventoryValuationServiceImpl{
+		transactionRepo:       transactionRepo,
+		sellerSettingsService: sellerSettingsService,
+	}
+}
+
+// GetValuation replays the whole ledger up to the end of the period, so the opening
+// balance carries the cost of every earlier restock lot
+func (s *InventoryValuationServiceImpl) GetValuation(
+	ctx context.Context,
+	sellerID uint,
+	params model.InventoryValuationParams,
+) (*model.InventoryValuationResponse, error) {
+	from, err := time.Parse(time.RFC3339, params.From)
+	if err != nil {
+		return nil, invErrors.ErrInvalidValuationPeriod
+	}
+	to, err := time.Parse(time.RFC3339, params.To)
+	if err != nil {
+		return nil, invErrors.ErrInvalidValuationPeriod
+	}
+
+	method, err := s.costingMethod(ctx, sellerID, params.Method)
+	if err != nil {
+		return nil, err
+	}
+
+	// A report tolerates replica lag and the ledger scan can be long
+	entries, err := s.transactionRepo.FindValuationLedger(
+		db.UseReadReplica(ctx),
+		sellerID,
+		params.VariantID,
+		to,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	variants := helper.ValueInventoryLedger(entries, method, from, to)
+	return &model.InventoryValuationResponse{
+		Method:   method,
+		From:     from,
+		To:       to,
+		Variants: variants,
+		Totals:   helper.SumInventoryValuation(variants),
+	}, nil
+}
+
+// costingMethod returns the requested method, else the seller's, else FIFO for sellers
+// without settings
+func (s *InventoryValuationServiceImpl) costingMethod(
+	ctx context.Context,
+	sellerID uint,
+	requested string,
+) (string, error) {
+	method := requested
+	if method == "" {
+		settings, err := s.sellerSettingsService.GetBySellerID(ctx, sellerID)
+		switch {
+		case errors.Is(err, userErrors.ErrSellerSettingsNotFound):
+			return constants.INVENTORY_COSTING_FIFO, nil
+		case err != nil:
+			return "", err
+		}
+		method = settings.InventoryCostingMethod
+	}
+	if !constants.IsValidInventoryCostingMethod(method) {
+		return "", invErrors.ErrInvalidCostingMethod
+	}
+	return method, nil
+}
@@ -0,0 +1,405 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"ecommerce-be/common/db"
+	"ecommerce-be/inventory/entity"
+	invErrors "ecommerce-be/inventory/error"
+	"ecommerce-be/inventory/factory"
+	"ecommerce-be/inventory/model"
+	"ecommerce-be/inventory/repository"
+	"ecommerce-be/inventory/utils/helper"
+	productService "ecommerce-be/product/service"
+)
+
+// PurchaseOrderService tracks stock ordered from suppliers. Orders are drafted, sent to
+// the supplier and received in one or more deliveries; receiving adds the units to stock
+// at the order's location at the unit cost paid.
+type PurchaseOrderService interface {
+	CreatePurchaseOrder(
+		ctx context.Context,
+		sellerID uint,
+		userID uint,
+		req model.CreatePurchaseOrderRequest,
+	) (*model.PurchaseOrderResponse, error)
+	// UpdatePurchaseOrder edits a draft order
+	UpdatePurchaseOrder(
+		ctx context.Context,
+		sellerID uint,
+		id uint,
+		req model.UpdatePurchaseOrderRequest,
+	) (*model.PurchaseOrderResponse, error)
+	GetPurchaseOrder(
+		ctx context.Context,
+		sellerID uint,
+		id uint,
+	) (*model.PurchaseOrderResponse, error)
+	ListPurchaseOrders(
+		ctx context.Context,
+		sellerID uint,
+		params model.PurchaseOrdersQueryParams,
+	) ([]model.PurchaseOrderResponse, error)
+	// SendPurchaseOrder marks a draft as ordered from the supplier
+	SendPurchaseOrder(
+		ctx context.Context,
+		sellerID uint,
+		id uint,
+	) (*model.PurchaseOrderResponse, error)
+	// ReceivePurchaseOrder records a delivery and adds it to stock
+	ReceivePurchaseOrder(
+		ctx context.Context,
+		sellerID uint,
+		userID uint,
+		id uint,
+		req model.ReceivePurchaseOrderRequest,
+	) (*model.PurchaseOrderResponse, error)
+	// ClosePurchaseOrder stops expecting further deliveries, e.g. when a supplier ships short
+	ClosePurchaseOrder(
+		ctx context.Context,
+		sellerID uint,
+		id uint,
+	) (*model.PurchaseOrderResponse, error)
+}
+
+// PurchaseOrderServiceImpl implements PurchaseOrderService
+type PurchaseOrderServiceImpl struct {
+	purchaseOrderRepo   repository.PurchaseOrderRepository
+	supplierRepo        repository.SupplierRepository
+	locationRepo        repository.LocationRepository
+	inventoryService    InventoryManageService
+	variantQueryService productService.VariantQueryService
+}
+
+// NewPurchaseOrderService creates a new instance of PurchaseOrderService
+func NewPurchaseOrderService(
+	purchaseOrderRepo repository.PurchaseOrderRepository,
+	supplierRepo repository.SupplierRepository,
+	locationRepo repository.LocationRepository,
+	inventoryService InventoryManageService,
+	variantQueryService productService.VariantQueryService,
+) *PurchaseOrderServiceImpl {
+	return &PurchaseOrderServiceImpl{
+		purchaseOrderRepo:   purchaseOrderRepo,
+		supplierRepo:        supplierRepo,
+		locationRepo:        locationRepo,
+		inventoryService:    inventoryService,
+		variantQueryService: variantQueryService,
+	}
+}
+
+// CreatePurchaseOrder creates a draft order with an active supplier and location
+func (s *PurchaseOrderServiceImpl) CreatePurchaseOrder(
+	ctx context.Context,
+	sellerID uint,
+	userID uint,
+	req model.CreatePurchaseOrderRequest,
+) (*model.PurchaseOrderResponse, error) {
+	if err := s.validateSupplier(ctx, sellerID, req.SupplierID); err != nil {
+		return nil, err
+	}
+	if err := s.validateLocation(ctx, sellerID, req.LocationID); err != nil {
+		return nil, err
+	}
+	lines, err := s.buildLines(ctx, sellerID, req.Lines)
+	if err != nil {
+		return nil, err
+	}
+
+	order := &entity.PurchaseOrder{
+		SellerID:          sellerID,
+		SupplierID:        req.SupplierID,
+		LocationID:        req.LocationID,
+		Status:            entity.PO_DRAFT,
+		SupplierReference: req.SupplierReference,
+		ExpectedAt:        req.ExpectedAt,
+		Note:              req.Note,
+		CreatedBy:         userID,
+		Lines:             lines,
+	}
+	if err := s.purchaseOrderRepo.Create(ctx, order); err != nil {
+		return nil, err
+	}
+	response := factory.BuildPurchaseOrderResponse(order)
+	return &response, nil
+}
+
+// UpdatePurchaseOrder edits the given fields of a draft order
+func (s *PurchaseOrderServiceImpl) UpdatePurchaseOrder(
+	ctx context.Context,
+	sellerID uint,
+	id uint,
+	req model.UpdatePurchaseOrderRequest,
+) (*model.PurchaseOrderResponse, error) {
+	if req.SupplierID != nil {
+		if err := s.validateSupplier(ctx, sellerID, *req.SupplierID); err != nil {
+			return nil, err
+		}
+	}
+	if req.LocationID != nil {
+		if err := s.validateLocation(ctx, sellerID, *req.LocationID); err != nil {
+			return nil, err
+		}
+	}
+	var lines []entity.PurchaseOrderLine
+	if len(req.Lines) > 0 {
+		var err error
+		if lines, err = s.buildLines(ctx, sellerID, req.Lines); err != nil {
+			return nil, err
+		}
+	}
+
+	return db.WithTransactionResult(ctx,
+		func(txCtx context.Context) (*model.PurchaseOrderResponse, error) {
+			order, err := s.purchaseOrderRepo.FindByIDForUpdate(txCtx, id, sellerID)
+			if err != nil {
+				return nil, err
+			}
+			if order.Status != entity.PO_DRAFT {
+				return nil, invErrors.ErrPurchaseOrderNotEditable
+			}
+
+			if req.SupplierID != nil {
+				order.SupplierID = *req.SupplierID
+			}
+			if req.LocationID != nil {
+				order.LocationID = *req.LocationID
+			}
+			if req.SupplierReference != nil {
+				order.SupplierReference = req.SupplierReference
+			}
+			if req.ExpectedAt != nil {
+				order.ExpectedAt = req.ExpectedAt
+			}
+			if req.Note != nil {
+				order.Note = req.Note
+			}
+			if err := s.purchaseOrderRepo.Save(txCtx, order); err != nil {
+				return nil, err
+			}
+
+			if lines != nil {
+				if err := s.purchaseOrderRepo.ReplaceLines(txCtx, order.ID, lines); err != nil {
+					return nil, err
+				}
+				order.Lines = lines
+			}
+			response := factory.BuildPurchaseOrderResponse(order)
+			return &response, nil
+		},
+	)
+}
+
+// GetPurchaseOrder returns one of the seller's orders with its lines
+func (s *PurchaseOrderServiceImpl) GetPurchaseOrder(
+	ctx context.Context,
+	sellerID uint,
+	id uint,
+) (*model.PurchaseOrderResponse, error) {
+	order, err := s.purchaseOrderRepo.FindByID(ctx, id, sellerID)
+	if err != nil {
+		return nil, err
+	}
+	response := factory.BuildPurchaseOrderResponse(order)
+	return &response, nil
+}
+
+// ListPurchaseOrders lists the seller's orders, newest first
+func (s *PurchaseOrderServiceImpl) ListPurchaseOrders(
+	ctx context.Context,
+	sellerID uint,
+	params model.PurchaseOrdersQueryParams,
+) ([]model.PurchaseOrderResponse, error) {
+	orders, err := s.purchaseOrderRepo.FindAll(ctx, sellerID, params)
+	if err != nil {
+		return nil, err
+	}
+	return factory.BuildPurchaseOrderResponses(orders), nil
+}
+
+// SendPurchaseOrder moves a draft to SENT; deliveries can be received from then on
+func (s *PurchaseOrderServiceImpl) SendPurchaseOrder(
+	ctx context.Context,
+	sellerID uint,
+	id uint,
+) (*model.PurchaseOrderResponse, error) {
+	return db.WithTransactionResult(ctx,
+		func(txCtx context.Context) (*model.PurchaseOrderResponse, error) {
+			order, err := s.purchaseOrderRepo.FindByIDForUpdate(txCtx, id, sellerID)
+			if err != nil {
+				return nil, err
+			}
+			if order.Status != entity.PO_DRAFT {
+				return nil, invErrors.ErrPurchaseOrderInvalidStatus
+			}
+
+			now := time.Now().UTC()
+			order.Status = entity.PO_SENT
+			order.SentAt = &now
+			if err := s.purchaseOrderRepo.Save(txCtx, order); err != nil {
+				return nil, err
+			}
+			response := factory.BuildPurchaseOrderResponse(order)
+			return &response, nil
+		},
+	)
+}
+
+// ReceivePurchaseOrder adds delivered units to stock as PURCHASE movements referencing
+// the order number. The whole delivery is rejected when stock refuses any item. The order
+// closes once every line is fully received.
+func (s *PurchaseOrderServiceImpl) ReceivePurchaseOrder(
+	ctx context.Context,
+	sellerID uint,
+	userID uint,
+	id uint,
+	req model.ReceivePurchaseOrderRequest,
+) (*model.PurchaseOrderResponse, error) {
+	return db.WithTransactionResult(ctx,
+		func(txCtx context.Context) (*model.PurchaseOrderResponse, error) {
+			order, err := s.purchaseOrderRepo.FindByIDForUpdate(txCtx, id, sellerID)
+			if err != nil {
+				return nil, err
+			}
+			if !order.Status.CanReceive() {
+				return nil, invErrors.ErrPurchaseOrderNotReceivable
+			}
+
+			items, err := helper.ApplyPurchaseOrderReceipt(order, req)
+			if err != nil {
+				return nil, err
+			}
+			if err := s.receiveIntoStock(txCtx, sellerID, userID, items); err != nil {
+				return nil, err
+			}
+
+			order.Status = helper.ReceivedStatus(order.Lines)
+			if order.Status == entity.PO_CLOSED {
+				now := time.Now().UTC()
+				order.ClosedAt = &now
+			}
+			if err := s.purchaseOrderRepo.Save(txCtx, order); err != nil {
+				return nil, err
+			}
+			if err := s.purchaseOrderRepo.SaveLines(txCtx, order.Lines); err != nil {
+				return nil, err
+			}
+			response := factory.BuildPurchaseOrderResponse(order)
+			return &response, nil
+		},
+	)
+}
+
+// ClosePurchaseOrder closes an order that is not closed yet; outstanding units are no
+// longer expected
+func (s *PurchaseOrderServiceImpl) ClosePurchaseOrder(
+	ctx context.Context,
+	sellerID uint,
+	id uint,
+) (*model.PurchaseOrderResponse, error) {
+	return db.WithTransactionResult(ctx,
+		func(txCtx context.Context) (*model.PurchaseOrderResponse, error) {
+			order, err := s.purchaseOrderRepo.FindByIDForUpdate(txCtx, id, sellerID)
+			if err != nil {
+				return nil, err
+			}
+			if order.Status == entity.PO_CLOSED {
+				return nil, invErrors.ErrPurchaseOrderInvalidStatus
+			}
+
+			now := time.Now().UTC()
+			order.Status = entity.PO_CLOSED
+			order.ClosedAt = &now
+			if err := s.purchaseOrderRepo.Save(txCtx, order); err != nil {
+				return nil, err
+			}
+			response := factory.BuildPurchaseOrderResponse(order)
+			return &response, nil
+		},
+	)
+}
+
+// receiveIntoStock records the receipt movements; any rejected item fails the receipt
+// with the rejected items as details
+func (s *PurchaseOrderServiceImpl) receiveIntoStock(
+	ctx context.Context,
+	sellerID uint,
+	userID uint,
+	items []model.ManageInventoryRequest,
+) error {
+	result, err := s.inventoryService.BulkManageInventory(
+		ctx,
+		model.BulkManageInventoryRequest{Items: items},
+		sellerID,
+		userID,
+	)
+	if err != nil {
+		return err
+	}
+	if result.FailureCount == 0 {
+		return nil
+	}
+
+	failed := make([]model.BulkInventoryItemResult, 0, result.FailureCount)
+	for _, itemResult := range result.Results {
+		if !itemResult.Success {
+			failed = append(failed, itemResult)
+		}
+	}
+	return invErrors.ErrPurchaseOrderReceiptFailed.WithDetails(failed)
+}
+
+// buildLines converts requested lines after checking every variant belongs to the seller
+func (s *PurchaseOrderServiceImpl) buildLines(
+	ctx context.Context,
+	sellerID uint,
+	requests []model.PurchaseOrderLineRequest,
+) ([]entity.PurchaseOrderLine, error) {
+	lines, err := helper.BuildPurchaseOrderLines(requests)
+	if err != nil {
+		return nil, err
+	}
+
+	variantIDs := helper.PurchaseOrderLineVariantIDs(lines)
+	variants, err := s.variantQueryService.GetProductBasicInfoByVariantIDs(ctx, variantIDs, &sellerID)
+	if err != nil {
+		return nil, err
+	}
+	if len(variants) != len(variantIDs) {
+		return nil, invErrors.ErrVariantNotFound
+	}
+	return lines, nil
+}
+
+// validateSupplier checks the supplier belongs to the seller and takes new orders
+func (s *PurchaseOrderServiceImpl) validateSupplier(
+	ctx context.Context,
+	sellerID uint,
+	supplierID uint,
+) error {
+	supplier, err := s.supplierRepo.FindByID(ctx, supplierID, sellerID)
+	if err != nil {
+		return err
+	}
+	if !supplier.IsActive {
+		return invErrors.ErrSupplierInactive
+	}
+	return nil
+}
+
+// validateLocation checks the delivery location belongs to the seller and is active
+func (s *PurchaseOrderServiceImpl) validateLocation(
+	ctx context.Context,
+	sellerID uint,
+	locationID uint,
+) error {
+	location, err := s.locationRepo.FindByID(ctx, locationID, sellerID)
+	if err != nil {
+		return err
+	}
+	if !location.IsActive {
+		return invErrors.ErrLocationInactive
+	}
+	return nil
+}
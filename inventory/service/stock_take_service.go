@@ -0,0 +1,364 @@
+package service
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"ecommerce-be/common/db"
+	"ecommerce-be/inventory/entity"
+	invErrors "ecommerce-be/inventory/error"
+	"ecommerce-be/inventory/factory"
+	"ecommerce-be/inventory/model"
+	"ecommerce-be/inventory/repository"
+	"ecommerce-be/inventory/utils/helper"
+	productService "ecommerce-be/product/service"
+)
+
+// StockTakeService runs stock takes: a seller opens a session for a location, submits
+// counted quantities, reviews the discrepancies and applies them as inventory movements.
+type StockTakeService interface {
+	StartStockTake(
+		ctx context.Context,
+		sellerID uint,
+		userID uint,
+		req model.StartStockTakeRequest,
+	) (*model.StockTakeResponse, error)
+
+	ListStockTakes(
+		ctx context.Context,
+		sellerID uint,
+		params model.StockTakesQueryParams,
+	) ([]model.StockTakeResponse, error)
+
+	// GetStockTake returns a session with its counted lines and discrepancies
+	GetStockTake(ctx context.Context, sellerID uint, id uint) (*model.StockTakeResponse, error)
+
+	// SubmitCounts records counted quantities against the current on-hand quantity
+	SubmitCounts(
+		ctx context.Context,
+		sellerID uint,
+		userID uint,
+		id uint,
+		req model.SubmitStockTakeCountsRequest,
+	) (*model.StockTakeResponse, error)
+
+	// ApplyStockTake records every discrepancy as a STOCK_TAKE movement and closes the session
+	ApplyStockTake(
+		ctx context.Context,
+		sellerID uint,
+		userID uint,
+		id uint,
+	) (*model.StockTakeResponse, error)
+
+	// CancelStockTake closes a session without changing stock
+	CancelStockTake(ctx context.Context, sellerID uint, id uint) (*model.StockTakeResponse, error)
+}
+
+// StockTakeServiceImpl implements StockTakeService
+type StockTakeServiceImpl struct {
+	stockTakeRepo       repository.StockTakeRepository
+	locationRepo        repository.LocationRepository
+	inventoryRepo       repository.InventoryRepository
+	inventoryService    InventoryManageService
+	variantQueryService productService.VariantQueryService
+}
+
+// NewStockTakeService creates a new instance of StockTakeService
+func NewStockTakeService(
+	stockTakeRepo repository.StockTakeRepository,
+	locationRepo repository.LocationRepository,
+	inventoryRepo repository.InventoryRepository,
+	inventoryService InventoryManageService,
+	variantQueryService productService.VariantQueryService,
+) *StockTakeServiceImpl {
+	return &StockTakeServiceImpl{
+		stockTakeRepo:       stockTakeRepo,
+		locationRepo:        locationRepo,
+		inventoryRepo:       inventoryRepo,
+		inventoryService:    inventoryService,
+		variantQueryService: variantQueryService,
+	}
+}
+
+// StartStockTake opens a session for an active location without another open session
+func (s *StockTakeServiceImpl) StartStockTake(
+	ctx context.Context,
+	sellerID uint,
+	userID uint,
+	req model.StartStockTakeRequest,
+) (*model.StockTakeResponse, error) {
+	location, err := s.locationRepo.FindByID(ctx, req.LocationID, sellerID)
+	if err != nil {
+		return nil, err
+	}
+	if !location.IsActive {
+		return nil, invErrors.ErrLocationInactive
+	}
+
+	open, err := s.stockTakeRepo.FindOpenByLocation(ctx, location.ID)
+	if err != nil {
+		return nil, err
+	}
+	if open != nil {
+		return nil, invErrors.ErrStockTakeAlreadyOpen
+	}
+
+	stockTake := &entity.StockTake{
+		SellerID:   sellerID,
+		LocationID: location.ID,
+		Status:     entity.STOCK_TAKE_OPEN,
+		Note:       req.Note,
+		StartedBy:  userID,
+	}
+	if err := s.stockTakeRepo.Create(ctx, stockTake); err != nil {
+		return nil, err
+	}
+	response := factory.BuildStockTakeResponse(stockTake, true)
+	return &response, nil
+}
+
+// ListStockTakes lists the seller's sessions with their discrepancy totals
+func (s *StockTakeServiceImpl) ListStockTakes(
+	ctx context.Context,
+	sellerID uint,
+	params model.StockTakesQueryParams,
+) ([]model.StockTakeResponse, error) {
+	stockTakes, err := s.stockTakeRepo.FindAll(ctx, sellerID, params)
+	if err != nil {
+		return nil, err
+	}
+	return factory.BuildStockTakeResponses(stockTakes), nil
+}
+
+// GetStockTake returns a session with its lines for review
+func (s *StockTakeServiceImpl) GetStockTake(
+	ctx context.Context,
+	sellerID uint,
+	id uint,
+) (*model.StockTakeResponse, error) {
+	stockTake, err := s.stockTakeRepo.FindByID(ctx, id, sellerID)
+	if err != nil {
+		return nil, err
+	}
+	response := factory.BuildStockTakeResponse(stockTake, true)
+	return &response, nil
+}
+
+// SubmitCounts validates the variants belong to the seller, then records each count with
+// the quantity currently on hand at the location as its expected quantity
+func (s *StockTakeServiceImpl) SubmitCounts(
+	ctx context.Context,
+	sellerID uint,
+	userID uint,
+	id uint,
+	req model.SubmitStockTakeCountsRequest,
+) (*model.StockTakeResponse, error) {
+	// A variant listed twice keeps its last count
+	counts := make(map[uint]int, len(req.Items))
+	variantIDs := make([]uint, 0, len(req.Items))
+	for _, item := range req.Items {
+		if _, seen := counts[item.VariantID]; !seen {
+			variantIDs = append(variantIDs, item.VariantID)
+		}
+		counts[item.VariantID] = *item.CountedQuantity
+	}
+
+	variants, err := s.variantQueryService.GetProductBasicInfoByVariantIDs(ctx, variantIDs, &sellerID)
+	if err != nil {
+		return nil, err
+	}
+	if len(variants) != len(variantIDs) {
+		return nil, invErrors.ErrVariantNotFound
+	}
+
+	return db.WithTransactionResult(ctx,
+		func(txCtx context.Context) (*model.StockTakeResponse, error) {
+			stockTake, err := s.findOpenForUpdate(txCtx, sellerID, id)
+			if err != nil {
+				return nil, err
+			}
+
+			onHand, err := s.onHandQuantities(txCtx, variantIDs, stockTake.LocationID)
+			if err != nil {
+				return nil, err
+			}
+
+			now := time.Now().UTC()
+			changed := make([]entity.StockTakeLine, 0, len(variantIDs))
+			for _, variantID := range variantIDs {
+				line := entity.StockTakeLine{StockTakeID: stockTake.ID, VariantID: variantID}
+				index := slices.IndexFunc(stockTake.Lines, func(l entity.StockTakeLine) bool {
+					return l.VariantID == variantID
+				})
+				if index >= 0 {
+					line = stockTake.Lines[index]
+				}
+				line.SystemQuantity = onHand[variantID]
+				line.CountedQuantity = counts[variantID]
+				line.CountedBy = userID
+				line.CountedAt = now
+				changed = append(changed, line)
+			}
+
+			if err := s.stockTakeRepo.SaveLines(txCtx, changed); err != nil {
+				return nil, err
+			}
+
+			updated, err := s.stockTakeRepo.FindByID(txCtx, id, sellerID)
+			if err != nil {
+				return nil, err
+			}
+			response := factory.BuildStockTakeResponse(updated, true)
+			return &response, nil
+		},
+	)
+}
+
+// ApplyStockTake applies all corrections in one transaction: when any of them is rejected
+// (e.g. it would take stock below its threshold) nothing is applied and the session stays
+// open with the rejected variants in the error details.
+func (s *StockTakeServiceImpl) ApplyStockTake(
+	ctx context.Context,
+	sellerID uint,
+	userID uint,
+	id uint,
+) (*model.StockTakeResponse, error) {
+	return db.WithTransactionResult(ctx,
+		func(txCtx context.Context) (*model.StockTakeResponse, error) {
+			stockTake, err := s.findOpenForUpdate(txCtx, sellerID, id)
+			if err != nil {
+				return nil, err
+			}
+			if len(stockTake.Lines) == 0 {
+				return nil, invErrors.ErrStockTakeNothingCounted
+			}
+
+			if err := s.applyAdjustments(txCtx, sellerID, userID, stockTake); err != nil {
+				return nil, err
+			}
+
+			now := time.Now().UTC()
+			stockTake.Status = entity.STOCK_TAKE_APPLIED
+			stockTake.AppliedBy = &userID
+			stockTake.AppliedAt = &now
+			if err := s.stockTakeRepo.Save(txCtx, stockTake); err != nil {
+				return nil, err
+			}
+			if err := s.stockTakeRepo.SaveLines(txCtx, stockTake.Lines); err != nil {
+				return nil, err
+			}
+
+			response := factory.BuildStockTakeResponse(stockTake, true)
+			return &response, nil
+		},
+	)
+}
+
+// CancelStockTake closes an open session without changing stock
+func (s *StockTakeServiceImpl) CancelStockTake(
+	ctx context.Context,
+	sellerID uint,
+	id uint,
+) (*model.StockTakeResponse, error) {
+	return db.WithTransactionResult(ctx,
+		func(txCtx context.Context) (*model.StockTakeResponse, error) {
+			stockTake, err := s.findOpenForUpdate(txCtx, sellerID, id)
+			if err != nil {
+				return nil, err
+			}
+			stockTake.Status = entity.STOCK_TAKE_CANCELLED
+			if err := s.stockTakeRepo.Save(txCtx, stockTake); err != nil {
+				return nil, err
+			}
+			response := factory.BuildStockTakeResponse(stockTake, true)
+			return &response, nil
+		},
+	)
+}
+
+// applyAdjustments records the discrepancies as movements and links each line to its
+// movement. Lines without a discrepancy get no movement.
+func (s *StockTakeServiceImpl) applyAdjustments(
+	ctx context.Context,
+	sellerID uint,
+	userID uint,
+	stockTake *entity.StockTake,
+) error {
+	items := helper.BuildStockTakeAdjustments(stockTake)
+	if len(items) == 0 {
+		return nil
+	}
+
+	result, err := s.inventoryService.BulkManageInventory(
+		ctx,
+		model.BulkManageInventoryRequest{Items: items},
+		sellerID,
+		userID,
+	)
+	if err != nil {
+		return err
+	}
+
+	if result.FailureCount > 0 {
+		failed := make([]model.BulkInventoryItemResult, 0, result.FailureCount)
+		for _, itemResult := range result.Results {
+			if !itemResult.Success {
+				failed = append(failed, itemResult)
+			}
+		}
+		return invErrors.ErrStockTakeApplyFailed.WithDetails(failed)
+	}
+
+	transactionIDs := make(map[uint]uint, len(result.Results))
+	for _, itemResult := range result.Results {
+		if itemResult.Response != nil {
+			transactionIDs[itemResult.VariantID] = itemResult.Response.TransactionID
+		}
+	}
+	for i := range stockTake.Lines {
+		if transactionID, ok := transactionIDs[stockTake.Lines[i].VariantID]; ok {
+			stockTake.Lines[i].TransactionID = &transactionID
+		}
+	}
+	return nil
+}
+
+// findOpenForUpdate locks the seller's session and checks it still takes counts
+func (s *StockTakeServiceImpl) findOpenForUpdate(
+	ctx context.Context,
+	sellerID uint,
+	id uint,
+) (*entity.StockTake, error) {
+	stockTake, err := s.stockTakeRepo.FindByIDForUpdate(ctx, id, sellerID)
+	if err != nil {
+		return nil, err
+	}
+	if stockTake.Status != entity.STOCK_TAKE_OPEN {
+		return nil, invErrors.ErrStockTakeNotOpen
+	}
+	return stockTake, nil
+}
+
+// onHandQuantities returns the quantity of each variant at the location; variants
+// without inventory there have none
+func (s *StockTakeServiceImpl) onHandQuantities(
+	ctx context.Context,
+	variantIDs []uint,
+	locationID uint,
+) (map[uint]int, error) {
+	inventories, err := s.inventoryRepo.FindByVariantAndLocationBatch(
+		ctx,
+		variantIDs,
+		[]uint{locationID},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	quantities := make(map[uint]int, len(inventories))
+	for _, inventory := range inventories {
+		quantities[inventory.VariantID] = inventory.Quantity
+	}
+	return quantities, nil
+}
@@ -0,0 +1,183 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"ecommerce-be/inventory/entity"
+	invErrors "ecommerce-be/inventory/error"
+	"ecommerce-be/inventory/factory"
+	"ecommerce-be/inventory/model"
+	"ecommerce-be/inventory/repository"
+)
+
+// SupplierService manages the vendors a seller buys stock from
+type SupplierService interface {
+	CreateSupplier(
+		ctx context.Context,
+		sellerID uint,
+		req model.CreateSupplierRequest,
+	) (*model.SupplierResponse, error)
+	UpdateSupplier(
+		ctx context.Context,
+		sellerID uint,
+		id uint,
+		req model.UpdateSupplierRequest,
+	) (*model.SupplierResponse, error)
+	GetSupplier(ctx context.Context, sellerID uint, id uint) (*model.SupplierResponse, error)
+	ListSuppliers(
+		ctx context.Context,
+		sellerID uint,
+		params model.SuppliersQueryParams,
+	) ([]model.SupplierResponse, error)
+	// DeleteSupplier removes a supplier that was never ordered from
+	DeleteSupplier(ctx context.Context, sellerID uint, id uint) error
+}
+
+// SupplierServiceImpl implements SupplierService
+type SupplierServiceImpl struct {
+	supplierRepo      repository.SupplierRepository
+	purchaseOrderRepo repository.PurchaseOrderRepository
+}
+
+// NewSupplierService creates a new instance of SupplierService
+func NewSupplierService(
+	supplierRepo repository.SupplierRepository,
+	purchaseOrderRepo repository.PurchaseOrderRepository,
+) *SupplierServiceImpl {
+	return &SupplierServiceImpl{
+		supplierRepo:      supplierRepo,
+		purchaseOrderRepo: purchaseOrderRepo,
+	}
+}
+
+// CreateSupplier creates a supplier with a name unique for the seller
+func (s *SupplierServiceImpl) CreateSupplier(
+	ctx context.Context,
+	sellerID uint,
+	req model.CreateSupplierRequest,
+) (*model.SupplierResponse, error) {
+	name := strings.TrimSpace(req.Name)
+	if err := s.validateNameUniqueness(ctx, sellerID, name, 0); err != nil {
+		return nil, err
+	}
+
+	supplier := &entity.Supplier{
+		SellerID:    sellerID,
+		Name:        name,
+		ContactName: req.ContactName,
+		Email:       req.Email,
+		Phone:       req.Phone,
+		Notes:       req.Notes,
+		IsActive:    true,
+	}
+	if err := s.supplierRepo.Create(ctx, supplier); err != nil {
+		return nil, err
+	}
+	response := factory.BuildSupplierResponse(supplier)
+	return &response, nil
+}
+
+// UpdateSupplier updates the given supplier fields
+func (s *SupplierServiceImpl) UpdateSupplier(
+	ctx context.Context,
+	sellerID uint,
+	id uint,
+	req model.UpdateSupplierRequest,
+) (*model.SupplierResponse, error) {
+	supplier, err := s.supplierRepo.FindByID(ctx, id, sellerID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil {
+		name := strings.TrimSpace(*req.Name)
+		if err := s.validateNameUniqueness(ctx, sellerID, name, supplier.ID); err != nil {
+			return nil, err
+		}
+		supplier.Name = name
+	}
+	if req.ContactName != nil {
+		supplier.ContactName = req.ContactName
+	}
+	if req.Email != nil {
+		supplier.Email = req.Email
+	}
+	if req.Phone != nil {
+		supplier.Phone = req.Phone
+	}
+	if req.Notes != nil {
+		supplier.Notes = req.Notes
+	}
+	if req.IsActive != nil {
+		supplier.IsActive = *req.IsActive
+	}
+
+	if err := s.supplierRepo.Update(ctx, supplier); err != nil {
+		return nil, err
+	}
+	response := factory.BuildSupplierResponse(supplier)
+	return &response, nil
+}
+
+// GetSupplier returns one of the seller's suppliers
+func (s *SupplierServiceImpl) GetSupplier(
+	ctx context.Context,
+	sellerID uint,
+	id uint,
+) (*model.SupplierResponse, error) {
+	supplier, err := s.supplierRepo.FindByID(ctx, id, sellerID)
+	if err != nil {
+		return nil, err
+	}
+	response := factory.BuildSupplierResponse(supplier)
+	return &response, nil
+}
+
+// ListSuppliers lists the seller's suppliers
+func (s *SupplierServiceImpl) ListSuppliers(
+	ctx context.Context,
+	sellerID uint,
+	params model.SuppliersQueryParams,
+) ([]model.SupplierResponse, error) {
+	suppliers, err := s.supplierRepo.FindAll(ctx, sellerID, params)
+	if err != nil {
+		return nil, err
+	}
+	return factory.BuildSupplierResponses(suppliers), nil
+}
+
+// DeleteSupplier removes a supplier; suppliers with purchase orders are kept for their
+// history and can only be deactivated
+func (s *SupplierServiceImpl) DeleteSupplier(ctx context.Context, sellerID uint, id uint) error {
+	supplier, err := s.supplierRepo.FindByID(ctx, id, sellerID)
+	if err != nil {
+		return err
+	}
+
+	inUse, err := s.purchaseOrderRepo.ExistsForSupplier(ctx, supplier.ID)
+	if err != nil {
+		return err
+	}
+	if inUse {
+		return invErrors.ErrSupplierInUse
+	}
+	return s.supplierRepo.Delete(ctx, supplier.ID)
+}
+
+// validateNameUniqueness rejects a name already used by another of the seller's suppliers
+func (s *SupplierServiceImpl) validateNameUniqueness(
+	ctx context.Context,
+	sellerID uint,
+	name string,
+	excludeID uint,
+) error {
+	existing, err := s.supplierRepo.FindByName(ctx, sellerID, name)
+	if err != nil {
+		return err
+	}
+	if existing != nil && existing.ID != excludeID {
+		return invErrors.ErrDuplicateSupplierName
+	}
+	return nil
+}
@@ -0,0 +1,30 @@
+package constant
+
+// Channel allocation success messages
+const (
+	CHANNEL_ALLOCATION_RULE_SAVED_MSG   = "Channel allocation rule saved successfully"
+	CHANNEL_ALLOCATION_RULES_LISTED_MSG = "Channel allocation rules retrieved successfully"
+	CHANNEL_ALLOCATION_RULE_DELETED_MSG = "Channel allocation rule deleted successfully"
+	CHANNEL_AVAILABILITY_RETRIEVED_MSG  = "Channel availability retrieved successfully"
+)
+
+// Channel allocation error messages
+const (
+	CHANNEL_ALLOCATION_RULE_NOT_FOUND_MSG = "Channel allocation rule not found"
+	INVALID_ALLOCATION_CHANNEL_MSG        = "Invalid sales channel. Must be web, pos, or marketplace"
+	CHANNEL_RESERVE_EXCEEDS_POOL_MSG      = "Reserved percentages across channels cannot exceed 100"
+)
+
+// Channel allocation operation failure messages
+const (
+	FAILED_TO_SAVE_CHANNEL_ALLOCATION_RULE_MSG   = "Failed to save channel allocation rule"
+	FAILED_TO_LIST_CHANNEL_ALLOCATION_RULES_MSG  = "Failed to list channel allocation rules"
+	FAILED_TO_DELETE_CHANNEL_ALLOCATION_RULE_MSG = "Failed to delete channel allocation rule"
+	FAILED_TO_GET_CHANNEL_AVAILABILITY_MSG       = "Failed to get channel availability"
+)
+
+// Channel allocation field names
+const (
+	CHANNEL_ALLOCATION_RULE_FIELD_NAME  = "rule"
+	CHANNEL_ALLOCATION_RULES_FIELD_NAME = "rules"
+)
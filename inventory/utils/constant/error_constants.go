@@ -25,4 +25,60 @@ const (
 	DIRECTION_NOT_ALLOWED_CODE       = "DIRECTION_NOT_ALLOWED"
 	NOT_MANUAL_TRANSACTION_CODE      = "NOT_MANUAL_TXN"
 	REFERENCE_REQUIRED_CODE          = "REFERENCE_REQUIRED"
+	INVENTORY_VERSION_CONFLICT_CODE  = "INVENTORY_VERSION_CONFLICT"
+)
+
+// Return disposition error codes
+const (
+	INVALID_DISPOSITION_CODE     = "INVALID_DISPOSITION"
+	DISPOSITION_NOT_ALLOWED_CODE = "DISPOSITION_NOT_ALLOWED"
+)
+
+// Movement reason error codes
+const (
+	INVALID_REASON_CODE_CODE     = "INVALID_REASON_CODE"
+	REASON_CODE_NOT_ALLOWED_CODE = "REASON_CODE_NOT_ALLOWED"
+)
+
+// Inventory valuation error codes
+const (
+	UNIT_COST_NOT_ALLOWED_CODE    = "UNIT_COST_NOT_ALLOWED"
+	INVALID_VALUATION_PERIOD_CODE = "INVALID_VALUATION_PERIOD"
+	INVALID_COSTING_METHOD_CODE   = "INVALID_COSTING_METHOD"
+)
+
+// Channel allocation error codes
+const (
+	CHANNEL_ALLOCATION_RULE_NOT_FOUND_CODE = "CHANNEL_ALLOC_NOT_FOUND"
+	INVALID_ALLOCATION_CHANNEL_CODE        = "INVALID_ALLOC_CHANNEL"
+	CHANNEL_RESERVE_EXCEEDS_POOL_CODE      = "CHANNEL_RESERVE_EXCEEDS_POOL"
+)
+
+// Stock-take error codes
+const (
+	STOCK_TAKE_NOT_FOUND_CODE       = "STOCK_TAKE_NOT_FOUND"
+	STOCK_TAKE_NOT_OPEN_CODE        = "STOCK_TAKE_NOT_OPEN"
+	STOCK_TAKE_ALREADY_OPEN_CODE    = "STOCK_TAKE_ALREADY_OPEN"
+	STOCK_TAKE_NOTHING_COUNTED_CODE = "STOCK_TAKE_NOTHING_COUNTED"
+	STOCK_TAKE_APPLY_FAILED_CODE    = "STOCK_TAKE_APPLY_FAILED"
+)
+
+// Supplier error codes
+const (
+	SUPPLIER_NOT_FOUND_CODE      = "SUPPLIER_NOT_FOUND"
+	DUPLICATE_SUPPLIER_NAME_CODE = "DUPLICATE_SUPPLIER_NAME"
+	SUPPLIER_INACTIVE_CODE       = "SUPPLIER_INACTIVE"
+	SUPPLIER_IN_USE_CODE         = "SUPPLIER_IN_USE"
+)
+
+// Purchase order error codes
+const (
+	PURCHASE_ORDER_NOT_FOUND_CODE      = "PO_NOT_FOUND"
+	PURCHASE_ORDER_NOT_EDITABLE_CODE   = "PO_NOT_EDITABLE"
+	PURCHASE_ORDER_INVALID_STATUS_CODE = "PO_INVALID_STATUS"
+	PURCHASE_ORDER_NOT_RECEIVABLE_CODE = "PO_NOT_RECEIVABLE"
+	DUPLICATE_PURCHASE_ORDER_LINE_CODE = "DUPLICATE_PO_LINE"
+	PURCHASE_ORDER_LINE_NOT_FOUND_CODE = "PO_LINE_NOT_FOUND"
+	RECEIPT_EXCEEDS_ORDERED_CODE       = "RECEIPT_EXCEEDS_ORDERED"
+	PURCHASE_ORDER_RECEIPT_FAILED_CODE = "PO_RECEIPT_FAILED"
 )
@@ -8,6 +8,8 @@ const (
 	INVENTORIES_RETRIEVED_MSG         = "Inventories retrieved successfully"
 	INVENTORY_TRANSACTION_CREATED_MSG = "Inventory transaction created successfully"
 	TRANSACTIONS_RETRIEVED_MSG        = "Transactions retrieved successfully"
+	STOCK_CHECKED_MSG                 = "Stock checked successfully"
+	MOVEMENTS_RETRIEVED_MSG           = "Inventory movements retrieved successfully"
 )
 
 // Inventory error messages
@@ -26,14 +28,29 @@ const (
 	DIRECTION_NOT_ALLOWED_MSG       = "Direction is not allowed for this transaction type"
 	NOT_MANUAL_TRANSACTION_MSG      = "Transaction type not allowed for adjust API"
 	REFERENCE_REQUIRED_MSG          = "Reference ID is required for this transaction type (Order ID, PO Number, Transfer ID, etc.)"
+	INVENTORY_VERSION_CONFLICT_MSG  = "Inventory was changed by another update; reload it and retry"
+)
+
+// Return disposition messages
+const (
+	INVALID_DISPOSITION_MSG     = "Invalid disposition. Must be RESTOCK, DAMAGED or QUARANTINE"
+	DISPOSITION_NOT_ALLOWED_MSG = "Disposition is only allowed for RETURN transactions"
+)
+
+// Movement reason messages
+const (
+	INVALID_REASON_CODE_MSG     = "Invalid reason code"
+	REASON_CODE_NOT_ALLOWED_MSG = "Reason code is not allowed for this transaction type"
 )
 
 // Inventory operation failure messages
 const (
 	FAILED_TO_ADJUST_INVENTORY_MSG   = "Failed to adjust inventory"
 	FAILED_TO_GET_INVENTORY_MSG      = "Failed to get inventory"
+	FAILED_TO_CHECK_STOCK_MSG        = "Failed to check stock"
 	FAILED_TO_CREATE_TRANSACTION_MSG = "Failed to create inventory transaction"
 	FAILED_TO_LIST_TRANSACTIONS_MSG  = "Failed to list transactions"
+	FAILED_TO_LIST_MOVEMENTS_MSG     = "Failed to list inventory movements"
 )
 
 // Inventory field names
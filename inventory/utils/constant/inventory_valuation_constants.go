@@ -0,0 +1,23 @@
+package constant
+
+// Inventory valuation success messages
+const (
+	INVENTORY_VALUATION_RETRIEVED_MSG = "Inventory valuation retrieved successfully"
+)
+
+// Inventory valuation error messages
+const (
+	UNIT_COST_NOT_ALLOWED_MSG    = "Unit cost is only allowed for PURCHASE transactions"
+	INVALID_VALUATION_PERIOD_MSG = "Valuation period needs RFC 3339 from and to dates"
+	INVALID_COSTING_METHOD_MSG   = "Invalid costing method. Must be FIFO or AVERAGE"
+)
+
+// Inventory valuation operation failure messages
+const (
+	FAILED_TO_GET_INVENTORY_VALUATION_MSG = "Failed to get inventory valuation"
+)
+
+// Inventory valuation field names
+const (
+	INVENTORY_VALUATION_FIELD_NAME = "valuation"
+)
@@ -0,0 +1,71 @@
+package constant
+
+// Supplier success messages
+const (
+	SUPPLIER_CREATED_MSG    = "Supplier created successfully"
+	SUPPLIER_UPDATED_MSG    = "Supplier updated successfully"
+	SUPPLIER_DELETED_MSG    = "Supplier deleted successfully"
+	SUPPLIERS_RETRIEVED_MSG = "Suppliers retrieved successfully"
+	SUPPLIER_RETRIEVED_MSG  = "Supplier retrieved successfully"
+)
+
+// Supplier error messages
+const (
+	SUPPLIER_NOT_FOUND_MSG      = "Supplier not found"
+	DUPLICATE_SUPPLIER_NAME_MSG = "A supplier with this name already exists"
+	SUPPLIER_INACTIVE_MSG       = "Supplier is not active"
+	SUPPLIER_IN_USE_MSG         = "Supplier has purchase orders; deactivate it instead"
+	INVALID_SUPPLIER_ID_MSG     = "Invalid supplier ID"
+)
+
+// Supplier operation failure messages
+const (
+	FAILED_TO_CREATE_SUPPLIER_MSG = "Failed to create supplier"
+	FAILED_TO_UPDATE_SUPPLIER_MSG = "Failed to update supplier"
+	FAILED_TO_DELETE_SUPPLIER_MSG = "Failed to delete supplier"
+	FAILED_TO_GET_SUPPLIERS_MSG   = "Failed to get suppliers"
+	FAILED_TO_GET_SUPPLIER_MSG    = "Failed to get supplier"
+)
+
+// Purchase order success messages
+const (
+	PURCHASE_ORDER_CREATED_MSG    = "Purchase order created successfully"
+	PURCHASE_ORDER_UPDATED_MSG    = "Purchase order updated successfully"
+	PURCHASE_ORDERS_RETRIEVED_MSG = "Purchase orders retrieved successfully"
+	PURCHASE_ORDER_RETRIEVED_MSG  = "Purchase order retrieved successfully"
+	PURCHASE_ORDER_SENT_MSG       = "Purchase order marked as sent"
+	PURCHASE_ORDER_RECEIVED_MSG   = "Purchase order receipt recorded successfully"
+	PURCHASE_ORDER_CLOSED_MSG     = "Purchase order closed successfully"
+)
+
+// Purchase order error messages
+const (
+	PURCHASE_ORDER_NOT_FOUND_MSG      = "Purchase order not found"
+	PURCHASE_ORDER_NOT_EDITABLE_MSG   = "Only draft purchase orders can be edited"
+	PURCHASE_ORDER_INVALID_STATUS_MSG = "Purchase order status does not allow this action"
+	PURCHASE_ORDER_NOT_RECEIVABLE_MSG = "Only sent or partially received purchase orders can be received"
+	DUPLICATE_PURCHASE_ORDER_LINE_MSG = "A variant can only appear once on a purchase order"
+	PURCHASE_ORDER_LINE_NOT_FOUND_MSG = "Purchase order line not found"
+	RECEIPT_EXCEEDS_ORDERED_MSG       = "Received quantity exceeds the quantity still outstanding"
+	PURCHASE_ORDER_RECEIPT_FAILED_MSG = "Some received items could not be added to stock"
+	INVALID_PURCHASE_ORDER_ID_MSG     = "Invalid purchase order ID"
+)
+
+// Purchase order operation failure messages
+const (
+	FAILED_TO_CREATE_PURCHASE_ORDER_MSG  = "Failed to create purchase order"
+	FAILED_TO_UPDATE_PURCHASE_ORDER_MSG  = "Failed to update purchase order"
+	FAILED_TO_GET_PURCHASE_ORDERS_MSG    = "Failed to get purchase orders"
+	FAILED_TO_GET_PURCHASE_ORDER_MSG     = "Failed to get purchase order"
+	FAILED_TO_SEND_PURCHASE_ORDER_MSG    = "Failed to send purchase order"
+	FAILED_TO_RECEIVE_PURCHASE_ORDER_MSG = "Failed to receive purchase order"
+	FAILED_TO_CLOSE_PURCHASE_ORDER_MSG   = "Failed to close purchase order"
+)
+
+// Supplier and purchase order field names
+const (
+	SUPPLIER_FIELD_NAME        = "supplier"
+	SUPPLIERS_FIELD_NAME       = "suppliers"
+	PURCHASE_ORDER_FIELD_NAME  = "purchaseOrder"
+	PURCHASE_ORDERS_FIELD_NAME = "purchaseOrders"
+)
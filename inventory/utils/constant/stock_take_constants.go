@@ -0,0 +1,40 @@
+package constant
+
+// Stock-take success messages
+const (
+	STOCK_TAKE_STARTED_MSG          = "Stock take started successfully"
+	STOCK_TAKES_LISTED_MSG          = "Stock takes retrieved successfully"
+	STOCK_TAKE_RETRIEVED_MSG        = "Stock take retrieved successfully"
+	STOCK_TAKE_COUNTS_SUBMITTED_MSG = "Stock take counts submitted successfully"
+	STOCK_TAKE_APPLIED_MSG          = "Stock take corrections applied successfully"
+	STOCK_TAKE_CANCELLED_MSG        = "Stock take cancelled successfully"
+)
+
+// Stock-take error messages
+const (
+	STOCK_TAKE_NOT_FOUND_MSG       = "Stock take not found"
+	STOCK_TAKE_NOT_OPEN_MSG        = "Stock take is no longer open"
+	STOCK_TAKE_ALREADY_OPEN_MSG    = "A stock take is already open for this location"
+	STOCK_TAKE_NOTHING_COUNTED_MSG = "Stock take has no counted items"
+	STOCK_TAKE_APPLY_FAILED_MSG    = "Some stock take corrections could not be applied"
+	INVALID_STOCK_TAKE_ID_MSG      = "Invalid stock take ID"
+)
+
+// Stock-take operation failure messages
+const (
+	FAILED_TO_START_STOCK_TAKE_MSG  = "Failed to start stock take"
+	FAILED_TO_LIST_STOCK_TAKES_MSG  = "Failed to list stock takes"
+	FAILED_TO_GET_STOCK_TAKE_MSG    = "Failed to get stock take"
+	FAILED_TO_SUBMIT_COUNTS_MSG     = "Failed to submit stock take counts"
+	FAILED_TO_APPLY_STOCK_TAKE_MSG  = "Failed to apply stock take"
+	FAILED_TO_CANCEL_STOCK_TAKE_MSG = "Failed to cancel stock take"
+)
+
+// Stock-take field names
+const (
+	STOCK_TAKE_FIELD_NAME  = "stockTake"
+	STOCK_TAKES_FIELD_NAME = "stockTakes"
+)
+
+// STOCK_TAKE_REFERENCE_PREFIX prefixes the session ID in the reference of its movements
+const STOCK_TAKE_REFERENCE_PREFIX = "STOCK_TAKE-"
@@ -0,0 +1,156 @@
+package helper
+
+import (
+	"ecommerce-be/inventory/model"
+	productEntity "ecommerce-be/product/entity"
+)
+
+// ExpandBundleDemand replaces requested bundles with their components. Returns the
+// stocked variants in first-seen order and the total quantity each must supply,
+// counting both direct requests and what requested bundles need of it.
+func ExpandBundleDemand(
+	variantIDs []uint,
+	requested map[uint]int,
+	bundles map[uint][]productEntity.VariantBundleComponent,
+) ([]uint, map[uint]int) {
+	stockIDs := make([]uint, 0, len(variantIDs))
+	demand := make(map[uint]int, len(variantIDs))
+	add := func(variantID uint, quantity int) {
+		if _, seen := demand[variantID]; !seen {
+			stockIDs = append(stockIDs, variantID)
+		}
+		demand[variantID] += quantity
+	}
+	for _, variantID := range variantIDs {
+		components, isBundle := bundles[variantID]
+		if !isBundle {
+			add(variantID, requested[variantID])
+			continue
+		}
+		for _, component := range components {
+			add(component.ComponentVariantID, requested[variantID]*component.Quantity)
+		}
+	}
+	return stockIDs, demand
+}
+
+// ExpandBundleReservationItems replaces bundle items with their components so the
+// reservation holds (and later ships) component stock. Repeated variants are merged.
+func ExpandBundleReservationItems(
+	items []model.ReservationItem,
+	bundles map[uint][]productEntity.VariantBundleComponent,
+) []model.ReservationItem {
+	variantIDs := make([]uint, 0, len(items))
+	requested := make(map[uint]int, len(items))
+	for _, item := range items {
+		if _, seen := requested[item.VariantID]; !seen {
+			variantIDs = append(variantIDs, item.VariantID)
+		}
+		requested[item.VariantID] += int(item.ReservedQuantity)
+	}
+
+	stockIDs, demand := ExpandBundleDemand(variantIDs, requested, bundles)
+	expanded := make([]model.ReservationItem, 0, len(stockIDs))
+	for _, variantID := range stockIDs {
+		expanded = append(expanded, model.ReservationItem{
+			VariantID:        variantID,
+			ReservedQuantity: uint(demand[variantID]),
+		})
+	}
+	return expanded
+}
+
+// BuildBundleStockCheckResult derives a bundle's availability from its components'
+// results: as many bundles as the scarcest component allows, overall and per location.
+// Component results must be checked against the combined demand of the request, so a
+// bundle is in stock only if every component can also cover the rest of the cart.
+func BuildBundleStockCheckResult(
+	bundleID uint,
+	requested int,
+	components []productEntity.VariantBundleComponent,
+	componentResults map[uint]model.StockCheckResult,
+) model.StockCheckResult {
+	result := model.StockCheckResult{
+		VariantID:         bundleID,
+		RequestedQuantity: requested,
+		InStock:           true,
+		Locations:         []model.StockCheckLocation{},
+	}
+	for i, component := range components {
+		stock := componentResults[component.ComponentVariantID]
+		available := stock.AvailableQuantity / component.Quantity
+		reservable := stock.ReservableQuantity / component.Quantity
+		if i == 0 {
+			result.AvailableQuantity, result.ReservableQuantity = available, reservable
+		}
+		result.AvailableQuantity = min(result.AvailableQuantity, available)
+		result.ReservableQuantity = min(result.ReservableQuantity, reservable)
+		result.InStock = result.InStock && stock.InStock
+	}
+	result.InStock = result.InStock && requested <= result.ReservableQuantity
+
+	// Digital components ship nothing, so only stocked components decide the locations
+	stocked := make([]productEntity.VariantBundleComponent, 0, len(components))
+	for _, component := range components {
+		if !componentResults[component.ComponentVariantID].StockUntracked {
+			stocked = append(stocked, component)
+		}
+	}
+	result.StockUntracked = len(stocked) == 0
+	result.Locations = bundleLocations(stocked, componentResults)
+	return result
+}
+
+// UntrackedStockCheckResult reports a variant of a digital product, which holds no
+// inventory: always in stock, with the requested quantity available
+func UntrackedStockCheckResult(variantID uint, requested int) model.StockCheckResult {
+	return model.StockCheckResult{
+		VariantID:          variantID,
+		RequestedQuantity:  requested,
+		AvailableQuantity:  requested,
+		ReservableQuantity: requested,
+		InStock:            true,
+		Locations:          []model.StockCheckLocation{},
+		StockUntracked:     true,
+	}
+}
+
+// bundleLocations lists the locations stocking every component, in the first
+// component's priority order, with the number of bundles each can assemble
+func bundleLocations(
+	components []productEntity.VariantBundleComponent,
+	componentResults map[uint]model.StockCheckResult,
+) []model.StockCheckLocation {
+	locations := []model.StockCheckLocation{}
+	if len(components) == 0 {
+		return locations
+	}
+
+	perComponent := make([]map[uint]int, len(components))
+	for i, component := range components {
+		stock := componentResults[component.ComponentVariantID]
+		perComponent[i] = make(map[uint]int, len(stock.Locations))
+		for _, loc := range stock.Locations {
+			perComponent[i][loc.LocationID] = loc.AvailableQuantity / component.Quantity
+		}
+	}
+
+	first := componentResults[components[0].ComponentVariantID]
+	for _, loc := range first.Locations {
+		assemblable := perComponent[0][loc.LocationID]
+		stocked := true
+		for i := 1; i < len(components) && stocked; i++ {
+			available, ok := perComponent[i][loc.LocationID]
+			stocked = ok
+			assemblable = min(assemblable, available)
+		}
+		if stocked {
+			locations = append(locations, model.StockCheckLocation{
+				LocationID:        loc.LocationID,
+				LocationName:      loc.LocationName,
+				AvailableQuantity: assemblable,
+			})
+		}
+	}
+	return locations
+}
@@ -0,0 +1,69 @@
+package helper
+
+import "ecommerce-be/inventory/entity"
+
+// ChannelAllocation is the reserve and buffer a channel has for one variant
+type ChannelAllocation struct {
+	ReservePercent int
+	BufferQuantity int
+}
+
+// ResolveChannelAllocations merges the seller-default rules with the rules of variantID,
+// keyed by channel. A variant rule replaces the default rule for its channel.
+func ResolveChannelAllocations(
+	rules []entity.ChannelAllocationRule,
+	variantID uint,
+) map[string]ChannelAllocation {
+	allocations := make(map[string]ChannelAllocation)
+	for _, rule := range rules {
+		if rule.VariantID == nil {
+			allocations[rule.Channel] = toChannelAllocation(rule)
+		}
+	}
+	for _, rule := range rules {
+		if rule.VariantID != nil && *rule.VariantID == variantID {
+			allocations[rule.Channel] = toChannelAllocation(rule)
+		}
+	}
+	return allocations
+}
+
+// ChannelAvailableQuantity applies allocation rules to a variant's pooled available stock.
+// The channel cannot sell into the shares reserved for other channels and loses its own
+// buffer. Reserved shares are rounded down so they never exceed the pool.
+// Pooled stock at or below zero is returned unchanged as there is nothing to allocate.
+func ChannelAvailableQuantity(
+	pooled int,
+	channel string,
+	allocations map[string]ChannelAllocation,
+) int {
+	if pooled <= 0 || len(allocations) == 0 {
+		return pooled
+	}
+
+	available := pooled
+	for allocationChannel, allocation := range allocations {
+		if allocationChannel == channel {
+			available -= allocation.BufferQuantity
+			continue
+		}
+		available -= pooled * allocation.ReservePercent / 100
+	}
+	return max(available, 0)
+}
+
+// TotalReservePercent sums the reserved shares of all channels
+func TotalReservePercent(allocations map[string]ChannelAllocation) int {
+	total := 0
+	for _, allocation := range allocations {
+		total += allocation.ReservePercent
+	}
+	return total
+}
+
+func toChannelAllocation(rule entity.ChannelAllocationRule) ChannelAllocation {
+	return ChannelAllocation{
+		ReservePercent: rule.ReservePercent,
+		BufferQuantity: rule.BufferQuantity,
+	}
+}
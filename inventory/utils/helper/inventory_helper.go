@@ -15,9 +15,30 @@ func ValidateManageRequest(req model.ManageInventoryRequest) error {
 	if err := validator.ValidateAdjustmentRequest(req.TransactionType, req.Direction); err != nil {
 		return err
 	}
+	if err := validator.ValidateReturnDisposition(req.TransactionType, req.Disposition); err != nil {
+		return err
+	}
+	if err := validator.ValidateUnitCost(req.TransactionType, req.UnitCostCents); err != nil {
+		return err
+	}
+	if err := validator.ValidateReasonCode(req.TransactionType, req.ReasonCode); err != nil {
+		return err
+	}
 	return validator.ValidateReferenceRequired(req.TransactionType, req.Reference)
 }
 
+// ReturnDispositionOf returns the disposition of a RETURN request, RESTOCK when not
+// given. Other transaction types have none.
+func ReturnDispositionOf(req model.ManageInventoryRequest) entity.ReturnDisposition {
+	if req.TransactionType != entity.TXN_RETURN {
+		return ""
+	}
+	if req.Disposition == nil {
+		return entity.DISPOSITION_RESTOCK
+	}
+	return *req.Disposition
+}
+
 // CalculateQuantityChange calculates the quantity change based on transaction type
 func CalculateQuantityChange(
 	req model.ManageInventoryRequest,
@@ -27,7 +48,13 @@ func CalculateQuantityChange(
 	switch req.TransactionType {
 	case entity.TXN_ADJUSTMENT:
 		return calculateAdjustmentChange(req, isNewInventory)
-	case entity.TXN_PURCHASE, entity.TXN_RETURN, entity.TXN_TRANSFER_IN:
+	case entity.TXN_RETURN:
+		// Damaged and quarantined returns are held outside quantity
+		if ReturnDispositionOf(req).IsHeld() {
+			return 0, nil
+		}
+		return req.Quantity, nil
+	case entity.TXN_PURCHASE, entity.TXN_TRANSFER_IN:
 		return req.Quantity, nil
 	case entity.TXN_OUTBOUND, entity.TXN_TRANSFER_OUT, entity.TXN_DAMAGE:
 		return -req.Quantity, nil
@@ -67,6 +94,12 @@ func ApplyInventoryChanges(
 ) error {
 	txnType := req.TransactionType
 
+	// Damaged and quarantined RETURNs only move units into their held state
+	if disposition := ReturnDispositionOf(req); disposition.IsHeld() {
+		applyHeldReturn(inventory, disposition, req.Quantity)
+		return nil
+	}
+
 	// OUTBOUND (SALE) updates BOTH quantities:
 	// - Decreases reserved_quantity (release the reservation)
 	// - Decreases quantity (ship the actual stock)
@@ -88,6 +121,20 @@ func ApplyInventoryChanges(
 	return applyQuantityChange(inventory, req, quantityChange)
 }
 
+// applyHeldReturn adds returned units to the stock state of their disposition
+func applyHeldReturn(
+	inventory *entity.Inventory,
+	disposition entity.ReturnDisposition,
+	quantity int,
+) {
+	switch disposition {
+	case entity.DISPOSITION_DAMAGED:
+		inventory.DamagedQuantity += quantity
+	case entity.DISPOSITION_QUARANTINE:
+		inventory.QuarantinedQuantity += quantity
+	}
+}
+
 // applyReservedQuantityChange applies changes to reserved quantity
 func applyReservedQuantityChange(
 	inventory *entity.Inventory,
@@ -141,6 +188,10 @@ func BuildManageResponse(
 		AvailableQuantity: inventory.Quantity - inventory.ReservedQuantity,
 		Threshold:         inventory.Threshold,
 		TransactionID:     transactionID,
+		Version:           inventory.Version,
+
+		DamagedQuantity:     inventory.DamagedQuantity,
+		QuarantinedQuantity: inventory.QuarantinedQuantity,
 	}
 }
 
@@ -191,6 +242,13 @@ func CalculateOverallStockStatus(outOfStock, lowStock, total uint) model.StockSt
 	return model.StockStatusInStock
 }
 
+// CrossedLowStockThreshold reports whether a quantity change moved an inventory from
+// above its threshold to at or below it, so alerts fire once per drop rather than
+// on every change while stock stays low.
+func CrossedLowStockThreshold(previousQuantity, currentQuantity, threshold int) bool {
+	return previousQuantity > threshold && currentQuantity <= threshold
+}
+
 // ============================================================================
 // Sorting Functions
 // ============================================================================
@@ -0,0 +1,212 @@
+package helper
+
+import (
+	"time"
+
+	"ecommerce-be/common/constants"
+	"ecommerce-be/inventory/entity"
+	"ecommerce-be/inventory/model"
+)
+
+// costLot is a quantity of stock on hand and its total cost
+type costLot struct {
+	quantity   int64
+	valueCents int64
+}
+
+// costPool tracks the cost of one variant's stock on hand. FIFO keeps one lot per
+// receipt, oldest first; AVERAGE keeps a single lot holding the moving average.
+type costPool struct {
+	method string
+	lots   []costLot
+	// backordered counts units shipped beyond stock on hand. They were costed when they
+	// shipped, so the next receipts fill them before adding to stock.
+	backordered  int64
+	lastUnitCost int64
+}
+
+func (p *costPool) quantity() int64 {
+	var total int64
+	for _, lot := range p.lots {
+		total += lot.quantity
+	}
+	return total - p.backordered
+}
+
+func (p *costPool) value() int64 {
+	var total int64
+	for _, lot := range p.lots {
+		total += lot.valueCents
+	}
+	return total
+}
+
+// unitCost is the average cost of stock on hand, or the last known cost without stock
+func (p *costPool) unitCost() int64 {
+	quantity := p.quantity()
+	if quantity <= 0 {
+		return p.lastUnitCost
+	}
+	return p.value() / quantity
+}
+
+func (p *costPool) add(quantity, unitCost int64) {
+	p.lastUnitCost = unitCost
+	filled := min(quantity, p.backordered)
+	p.backordered -= filled
+	quantity -= filled
+	if quantity == 0 {
+		return
+	}
+
+	lot := costLot{quantity: quantity, valueCents: quantity * unitCost}
+	if p.method == constants.INVENTORY_COSTING_AVERAGE && len(p.lots) > 0 {
+		p.lots[0].quantity += lot.quantity
+		p.lots[0].valueCents += lot.valueCents
+		return
+	}
+	p.lots = append(p.lots, lot)
+}
+
+// remove takes quantity units out of stock, oldest lots first, and returns their cost.
+// Units beyond stock on hand are costed at the last known unit cost.
+func (p *costPool) remove(quantity int64) int64 {
+	var cost int64
+	for quantity > 0 && len(p.lots) > 0 {
+		lot := &p.lots[0]
+		if quantity >= lot.quantity {
+			cost += lot.valueCents
+			quantity -= lot.quantity
+			p.lots = p.lots[1:]
+			continue
+		}
+		part := lot.valueCents * quantity / lot.quantity
+		lot.quantity -= quantity
+		lot.valueCents -= part
+		cost += part
+		quantity = 0
+	}
+	if quantity > 0 {
+		p.backordered += quantity
+		cost += quantity * p.lastUnitCost
+	}
+	return cost
+}
+
+// ValueInventoryLedger replays stock ledger entries to value stock with the costing
+// method. Entries must be ordered by variant, then by time. Entries from to onwards are
+// ignored; those before from build the opening balance. Variants without stock or
+// movements in the period are left out.
+func ValueInventoryLedger(
+	entries []model.ValuationLedgerEntry,
+	method string,
+	from, to time.Time,
+) []model.VariantValuation {
+	valuations := make([]model.VariantValuation, 0)
+	for start := 0; start < len(entries); {
+		end := start
+		for end < len(entries) && entries[end].VariantID == entries[start].VariantID {
+			end++
+		}
+		valuation := valueVariant(entries[start:end], method, from, to)
+		if valuation != (model.VariantValuation{VariantID: valuation.VariantID}) {
+			valuations = append(valuations, valuation)
+		}
+		start = end
+	}
+	return valuations
+}
+
+func valueVariant(
+	entries []model.ValuationLedgerEntry,
+	method string,
+	from, to time.Time,
+) model.VariantValuation {
+	pool := &costPool{method: method}
+	valuation := model.VariantValuation{VariantID: entries[0].VariantID}
+	opened := false
+	open := func() {
+		valuation.OpeningQuantity = int(pool.quantity())
+		valuation.OpeningValueCents = pool.value()
+		opened = true
+	}
+
+	for _, entry := range entries {
+		if !entry.CreatedAt.Before(to) {
+			break
+		}
+		if !opened && !entry.CreatedAt.Before(from) {
+			open()
+		}
+		if !affectsValuation(entry) {
+			continue
+		}
+
+		if entry.QuantityChange > 0 {
+			quantity := int64(entry.QuantityChange)
+			unitCost := pool.unitCost()
+			if entry.Type == entity.TXN_PURCHASE && entry.UnitCostCents != nil {
+				unitCost = *entry.UnitCostCents
+			}
+			pool.add(quantity, unitCost)
+			if !opened {
+				continue
+			}
+			if entry.Type == entity.TXN_PURCHASE {
+				valuation.PurchasedQuantity += entry.QuantityChange
+				valuation.PurchasedValueCents += quantity * unitCost
+				if entry.UnitCostCents == nil {
+					valuation.UncostedQuantity += entry.QuantityChange
+				}
+			} else {
+				valuation.AdjustedInQuantity += entry.QuantityChange
+				valuation.AdjustedInValueCents += quantity * unitCost
+			}
+			continue
+		}
+
+		cost := pool.remove(int64(-entry.QuantityChange))
+		if !opened {
+			continue
+		}
+		if entry.Type == entity.TXN_OUTBOUND {
+			valuation.SoldQuantity -= entry.QuantityChange
+			valuation.COGSCents += cost
+		} else {
+			valuation.WrittenOffQuantity -= entry.QuantityChange
+			valuation.WrittenOffValueCents += cost
+		}
+	}
+
+	if !opened {
+		open()
+	}
+	valuation.ClosingQuantity = int(pool.quantity())
+	valuation.ClosingValueCents = pool.value()
+	return valuation
+}
+
+// affectsValuation reports whether a ledger entry changes the seller's stock. Transfers
+// only move stock between the seller's locations and reservations only earmark it.
+func affectsValuation(entry model.ValuationLedgerEntry) bool {
+	switch entry.Type {
+	case entity.TXN_TRANSFER_IN, entity.TXN_TRANSFER_OUT, entity.TXN_RESERVED, entity.TXN_RELEASED:
+		return false
+	default:
+		return entry.QuantityChange != 0
+	}
+}
+
+// SumInventoryValuation totals the values of a valuation report
+func SumInventoryValuation(valuations []model.VariantValuation) model.InventoryValuationTotals {
+	var totals model.InventoryValuationTotals
+	for _, v := range valuations {
+		totals.OpeningValueCents += v.OpeningValueCents
+		totals.PurchasedValueCents += v.PurchasedValueCents
+		totals.AdjustedInValueCents += v.AdjustedInValueCents
+		totals.COGSCents += v.COGSCents
+		totals.WrittenOffValueCents += v.WrittenOffValueCents
+		totals.ClosingValueCents += v.ClosingValueCents
+	}
+	return totals
+}
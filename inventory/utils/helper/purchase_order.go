@@ -0,0 +1,99 @@
+package helper
+
+import (
+	"ecommerce-be/inventory/entity"
+	invErrors "ecommerce-be/inventory/error"
+	"ecommerce-be/inventory/model"
+)
+
+// BuildPurchaseOrderLines converts requested lines to entities. A variant may only be
+// ordered on one line.
+func BuildPurchaseOrderLines(
+	requests []model.PurchaseOrderLineRequest,
+) ([]entity.PurchaseOrderLine, error) {
+	seen := make(map[uint]bool, len(requests))
+	lines := make([]entity.PurchaseOrderLine, 0, len(requests))
+	for _, req := range requests {
+		if seen[req.VariantID] {
+			return nil, invErrors.ErrDuplicatePurchaseOrderLine
+		}
+		seen[req.VariantID] = true
+
+		lines = append(lines, entity.PurchaseOrderLine{
+			VariantID:       req.VariantID,
+			QuantityOrdered: req.QuantityOrdered,
+			UnitCostCents:   *req.UnitCostCents,
+		})
+	}
+	return lines, nil
+}
+
+// PurchaseOrderLineVariantIDs returns the variants ordered on the lines
+func PurchaseOrderLineVariantIDs(lines []entity.PurchaseOrderLine) []uint {
+	variantIDs := make([]uint, 0, len(lines))
+	for _, line := range lines {
+		variantIDs = append(variantIDs, line.VariantID)
+	}
+	return variantIDs
+}
+
+// ApplyPurchaseOrderReceipt adds delivered quantities to the order's lines and returns
+// the PURCHASE movements that bring them into stock at the order's location. Each
+// movement carries the unit cost paid, so it opens a cost lot for valuation. Nothing is
+// changed when an item is not on the order or exceeds its outstanding quantity.
+func ApplyPurchaseOrderReceipt(
+	order *entity.PurchaseOrder,
+	req model.ReceivePurchaseOrderRequest,
+) ([]model.ManageInventoryRequest, error) {
+	lineIndex := make(map[uint]int, len(order.Lines))
+	for i, line := range order.Lines {
+		lineIndex[line.VariantID] = i
+	}
+
+	received := make(map[uint]int, len(req.Items))
+	for _, item := range req.Items {
+		index, ok := lineIndex[item.VariantID]
+		if !ok {
+			return nil, invErrors.ErrPurchaseOrderLineNotFound
+		}
+		received[item.VariantID] += item.Quantity
+		if received[item.VariantID] > order.Lines[index].RemainingQuantity() {
+			return nil, invErrors.ErrReceiptExceedsOrdered
+		}
+	}
+
+	reference := order.Number()
+	items := make([]model.ManageInventoryRequest, 0, len(req.Items))
+	for _, item := range req.Items {
+		line := &order.Lines[lineIndex[item.VariantID]]
+		line.QuantityReceived += item.Quantity
+
+		unitCostCents := line.UnitCostCents
+		if item.UnitCostCents != nil {
+			unitCostCents = *item.UnitCostCents
+		}
+
+		items = append(items, model.ManageInventoryRequest{
+			VariantID:       item.VariantID,
+			LocationID:      order.LocationID,
+			Quantity:        item.Quantity,
+			TransactionType: entity.TXN_PURCHASE,
+			UnitCostCents:   &unitCostCents,
+			Reference:       &reference,
+			Reason:          "Received against " + reference,
+			Note:            req.Note,
+		})
+	}
+	return items, nil
+}
+
+// ReceivedStatus is the status of an order after a delivery: CLOSED once every line is
+// fully received, PARTIALLY_RECEIVED otherwise
+func ReceivedStatus(lines []entity.PurchaseOrderLine) entity.PurchaseOrderStatus {
+	for _, line := range lines {
+		if line.RemainingQuantity() > 0 {
+			return entity.PO_PARTIALLY_RECEIVED
+		}
+	}
+	return entity.PO_CLOSED
+}
@@ -0,0 +1,74 @@
+package helper
+
+import (
+	"ecommerce-be/inventory/entity"
+	"ecommerce-be/inventory/model"
+)
+
+// MergeStockCheckItems sums the quantities of repeated variants and returns the variant
+// IDs in the order they were first requested
+func MergeStockCheckItems(items []model.StockCheckItem) ([]uint, map[uint]int) {
+	variantIDs := make([]uint, 0, len(items))
+	requested := make(map[uint]int, len(items))
+	for _, item := range items {
+		if _, seen := requested[item.VariantID]; !seen {
+			variantIDs = append(variantIDs, item.VariantID)
+		}
+		requested[item.VariantID] += item.Quantity
+	}
+	return variantIDs, requested
+}
+
+// PooledAvailableQuantity sums quantity - reserved - threshold over a variant's rows, the
+// pool channel allocation rules divide
+func PooledAvailableQuantity(inventories []*entity.Inventory) int {
+	pooled := 0
+	for _, inv := range inventories {
+		pooled += inv.Quantity - inv.ReservedQuantity - inv.Threshold
+	}
+	return pooled
+}
+
+// BuildStockCheckResult reports a variant's availability from its inventory rows at active
+// locations, ordered by priority. channelLimit is what the channel may sell of the pool; a
+// reservation is bounded by it and by the free stock of the locations it draws from.
+func BuildStockCheckResult(
+	variantID uint,
+	requested int,
+	inventories []*entity.Inventory,
+	channelLimit int,
+	locationNames map[uint]string,
+) model.StockCheckResult {
+	result := model.StockCheckResult{
+		VariantID:         variantID,
+		RequestedQuantity: requested,
+		Locations:         make([]model.StockCheckLocation, 0, len(inventories)),
+	}
+	for _, inv := range inventories {
+		available := max(inv.Quantity-inv.ReservedQuantity-inv.Threshold, 0)
+		result.AvailableQuantity += available
+		result.Locations = append(result.Locations, model.StockCheckLocation{
+			LocationID:        inv.LocationID,
+			LocationName:      locationNames[inv.LocationID],
+			AvailableQuantity: available,
+		})
+	}
+	result.ReservableQuantity = max(min(result.AvailableQuantity, channelLimit), 0)
+	result.InStock = requested <= result.ReservableQuantity
+	return result
+}
+
+// DropUntrackedReservationItems removes variants of digital products from a
+// reservation; they hold no inventory to reserve
+func DropUntrackedReservationItems(
+	items []model.ReservationItem,
+	untracked map[uint]struct{},
+) []model.ReservationItem {
+	tracked := make([]model.ReservationItem, 0, len(items))
+	for _, item := range items {
+		if _, skip := untracked[item.VariantID]; !skip {
+			tracked = append(tracked, item)
+		}
+	}
+	return tracked
+}
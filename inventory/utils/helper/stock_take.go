@@ -0,0 +1,67 @@
+package helper
+
+import (
+	"fmt"
+	"strconv"
+
+	"ecommerce-be/inventory/entity"
+	"ecommerce-be/inventory/model"
+	"ecommerce-be/inventory/utils/constant"
+)
+
+// BuildStockTakeAdjustments turns the discrepancies of a stock take into ADJUSTMENT
+// requests with the STOCK_TAKE reason. Each adjustment is relative to the quantity on
+// hand when the variant was counted, so stock that moved since counting is not undone.
+// Lines that match the system quantity need no movement and are skipped.
+func BuildStockTakeAdjustments(stockTake *entity.StockTake) []model.ManageInventoryRequest {
+	reference := constant.STOCK_TAKE_REFERENCE_PREFIX + strconv.FormatUint(uint64(stockTake.ID), 10)
+	reasonCode := entity.REASON_STOCK_TAKE
+
+	items := make([]model.ManageInventoryRequest, 0, len(stockTake.Lines))
+	for _, line := range stockTake.Lines {
+		discrepancy := line.Discrepancy()
+		if discrepancy == 0 {
+			continue
+		}
+
+		direction := entity.ADJ_ADD
+		quantity := discrepancy
+		if discrepancy < 0 {
+			direction = entity.ADJ_REMOVE
+			quantity = -discrepancy
+		}
+
+		items = append(items, model.ManageInventoryRequest{
+			VariantID:       line.VariantID,
+			LocationID:      stockTake.LocationID,
+			Quantity:        quantity,
+			TransactionType: entity.TXN_ADJUSTMENT,
+			Direction:       &direction,
+			ReasonCode:      &reasonCode,
+			Reference:       &reference,
+			Reason: fmt.Sprintf(
+				"Stock take #%d: counted %d, expected %d",
+				stockTake.ID, line.CountedQuantity, line.SystemQuantity,
+			),
+		})
+	}
+	return items
+}
+
+// SummarizeStockTake totals the counted lines and their discrepancies
+func SummarizeStockTake(lines []entity.StockTakeLine) model.StockTakeSummary {
+	summary := model.StockTakeSummary{CountedItems: len(lines)}
+	for _, line := range lines {
+		discrepancy := line.Discrepancy()
+		if discrepancy == 0 {
+			continue
+		}
+		summary.DiscrepancyItems++
+		if discrepancy > 0 {
+			summary.UnitsOver += discrepancy
+		} else {
+			summary.UnitsShort -= discrepancy
+		}
+	}
+	return summary
+}
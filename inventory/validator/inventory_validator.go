@@ -58,6 +58,51 @@ func ValidateAdjustmentRequest(
 	return nil
 }
 
+// ValidateReturnDisposition validates that a disposition is only given for RETURN
+// transactions and is a known value
+func ValidateReturnDisposition(
+	transactionType entity.TransactionType,
+	disposition *entity.ReturnDisposition,
+) error {
+	if disposition == nil {
+		return nil
+	}
+	if transactionType != entity.TXN_RETURN {
+		return invErrors.ErrDispositionNotAllowed
+	}
+	if !disposition.IsValid() {
+		return invErrors.ErrInvalidDisposition
+	}
+	return nil
+}
+
+// ValidateUnitCost validates that a unit cost is only given for PURCHASE transactions,
+// the only ones that open a restock lot
+func ValidateUnitCost(transactionType entity.TransactionType, unitCostCents *int64) error {
+	if unitCostCents != nil && transactionType != entity.TXN_PURCHASE {
+		return invErrors.ErrUnitCostNotAllowed
+	}
+	return nil
+}
+
+// ValidateReasonCode validates that a reason code is known and fits the transaction
+// type (e.g. EXPIRED for DAMAGE, but not for PURCHASE)
+func ValidateReasonCode(
+	transactionType entity.TransactionType,
+	reasonCode *entity.MovementReason,
+) error {
+	if reasonCode == nil {
+		return nil
+	}
+	if !reasonCode.IsValid() {
+		return invErrors.ErrInvalidReasonCode
+	}
+	if !transactionType.AllowsReasonCode(*reasonCode) {
+		return invErrors.ErrReasonCodeNotAllowed
+	}
+	return nil
+}
+
 // ValidateQuantityForOperation validates if the operation is allowed based on threshold
 func ValidateQuantityForOperation(
 	currentQuantity int,
@@ -12,11 +12,25 @@ import (
 	"ecommerce-be/common/cache"
 	"ecommerce-be/common/config"
 	"ecommerce-be/common/cron"
+	"ecommerce-be/common/datamigration"
 	"ecommerce-be/common/db"
+	"ecommerce-be/common/deadletter"
+	"ecommerce-be/common/encryption"
+	"ecommerce-be/common/featureflag"
+	"ecommerce-be/common/grpcserver"
+	"ecommerce-be/common/handler"
+	"ecommerce-be/common/health"
+	"ecommerce-be/common/i18n"
+	"ecommerce-be/common/jobmonitor"
+	"ecommerce-be/common/lifecycle"
 	logger "ecommerce-be/common/log"
 	"ecommerce-be/common/middleware"
+	"ecommerce-be/common/openapi"
 	"ecommerce-be/common/scheduler"
+	"ecommerce-be/common/screening"
+	commonValidator "ecommerce-be/common/validator"
 	fileModule "ecommerce-be/file"
+	"ecommerce-be/fulfillment"
 	"ecommerce-be/inventory"
 	"ecommerce-be/notification"
 	"ecommerce-be/order"
@@ -28,6 +42,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/joho/godotenv"
+	"google.golang.org/grpc"
 )
 
 func main() {
@@ -47,11 +62,32 @@ func main() {
 	/* Initialize Logger (needs config for log level) */
 	logger.InitLogger(cfg)
 
+	/* Load translation catalog (responses stay English if it fails) */
+	if err := i18n.Init(cfg.I18n.LocalesDir, cfg.I18n.DefaultLanguage); err != nil {
+		logger.Error("Failed to load translation files", err)
+	}
+
 	/* Connect Database */
 	db.ConnectDB(cfg)
 
+	/* `migrate <command>` manages the schema and exits without starting the server */
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		code := runMigrateCommand(cfg, os.Args[2:])
+		db.CloseDB()
+		os.Exit(code)
+	}
+
+	/* Apply pending schema migrations when enabled */
+	if cfg.Database.AutoMigrate {
+		if err := autoMigrate(context.Background(), cfg); err != nil {
+			logger.Fatal("Failed to apply database migrations", err)
+		}
+	}
+
 	/* Connect Redis */
-	cache.ConnectRedis(cfg)
+	if err := cache.ConnectRedis(cfg); err != nil {
+		logger.Fatal("Failed to configure Redis client", err)
+	}
 
 	/* Initialize Cron Scheduler */
 	cron.Init()
@@ -61,18 +97,27 @@ func main() {
 
 	// Use gin.New() instead of gin.Default() to disable default logging
 	router := gin.New()
+	// Handlers pass the gin context down; this lets it carry the request's deadline
+	router.ContextWithFallback = true
 	router.Use(gin.Recovery()) // Add recovery middleware
 
 	/* Apply middleware */
 	router.Use(middleware.CORS())
+	router.Use(middleware.Language())
 	router.Use(middleware.CorrelationID()) // Mandatory correlation ID middleware
 	router.Use(middleware.Logger())
+	router.Use(middleware.RequestTimeout(cfg.Server.RequestTimeout()))
+	router.Use(middleware.BodySizeLimit(cfg.RequestLimits.MaxBodyBytes))
+	commonValidator.SetJSONLimits(
+		cfg.RequestLimits.MaxJSONDepth,
+		cfg.RequestLimits.MaxJSONArrayLength,
+	)
 
 	/* Register modules */
 	registerContainer(router)
 
-	/* Start background workers (must be before router.Run which blocks) */
-	go scheduler.StartRedisWorkerPool()
+	/* Start background workers; shutdown stops them before closing connections */
+	lifecycle.Go("scheduler worker pool", scheduler.StartRedisWorkerPool)
 	cron.Start()
 
 	/* Start Server with Graceful Shutdown */
@@ -89,12 +134,26 @@ func main() {
 		}
 	}()
 
+	/* Start internal gRPC server (product reads, stock checks) when a port is configured */
+	var grpcServer *grpc.Server
+	if cfg.Server.GRPCEnabled() {
+		grpcServer = grpcserver.New()
+		registerGRPCServices(grpcServer)
+
+		go func() {
+			logger.Info("gRPC server starting on port " + cfg.Server.GRPCPort)
+			if err := grpcserver.Serve(grpcServer, cfg.Server.GRPCAddr()); err != nil {
+				logger.Fatal("Failed to start gRPC server on port "+cfg.Server.GRPCPort, err)
+			}
+		}()
+	}
+
 	// Wait for interrupt signal to gracefully shutdown the server
-	gracefulShutdown(srv)
+	gracefulShutdown(srv, grpcServer)
 }
 
 // gracefulShutdown handles OS signals and performs cleanup
-func gracefulShutdown(srv *http.Server) {
+func gracefulShutdown(srv *http.Server, grpcServer *grpc.Server) {
 	quit := make(chan os.Signal, 1)
 	// SIGINT (Ctrl+C), SIGTERM (Docker/Kubernetes stop)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
@@ -113,6 +172,19 @@ func gracefulShutdown(srv *http.Server) {
 		logger.Error("HTTP server forced to shutdown", err)
 	}
 
+	// Shutdown gRPC server (waits for in-flight calls until the same deadline)
+	if grpcServer != nil {
+		logger.Info("Shutting down gRPC server...")
+		grpcserver.Shutdown(ctx, grpcServer)
+	}
+
+	// Stop background workers and cron jobs while their connections are still open
+	logger.Info("Stopping background workers...")
+	if err := lifecycle.Shutdown(ctx); err != nil {
+		logger.Error("Background workers forced to shutdown", err)
+	}
+	cron.Stop()
+
 	// Close database connections
 	logger.Info("Closing database connections...")
 	db.CloseDB()
@@ -121,20 +193,37 @@ func gracefulShutdown(srv *http.Server) {
 	logger.Info("Closing Redis connections...")
 	cache.CloseRedis()
 
-	// Stop Cron Scheduler
-	cron.Stop()
-
 	logger.Info("Server shutdown complete")
 }
 
 func registerContainer(router *gin.Engine) {
+	_ = health.NewContainer(router)
 	_ = user.NewContainer(router)
 	_ = fileModule.NewContainer(router)
 	_ = product.NewContainer(router)
 	_ = inventory.NewContainer(router)
 	_ = order.NewContainer(router)
+	_ = fulfillment.NewContainer(router)
 	_ = payment.NewContainer(router)
 	_ = notification.NewContainer(router)
 	_ = promotion.NewContainer(router)
 	_ = report.NewContainer(router)
+	_ = datamigration.NewContainer(router)
+	_ = screening.NewContainer(router)
+	_ = encryption.NewContainer(router)
+	_ = deadletter.NewContainer(router)
+	_ = cron.NewContainer(router)
+	_ = jobmonitor.NewContainer(router)
+	_ = featureflag.NewContainer(router)
+
+	/* Serve the error code catalog that problem+json types point at */
+	handler.RegisterErrorCatalogRoutes(router)
+
+	/* Serve the OpenAPI spec generated from the routes registered above */
+	openapi.RegisterRoutes(router)
+}
+
+func registerGRPCServices(server *grpc.Server) {
+	product.RegisterGRPC(server)
+	inventory.RegisterGRPC(server)
 }
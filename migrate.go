@@ -0,0 +1,134 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"text/tabwriter"
+
+	"ecommerce-be/common/config"
+	"ecommerce-be/common/db"
+	logger "ecommerce-be/common/log"
+	"ecommerce-be/common/schemamigration"
+)
+
+const migrateUsage = `usage: ecommerce-be migrate <command>
+
+commands:
+  up                apply all pending migrations
+  down [steps]      roll back the last applied migrations (default 1)
+  status            list migrations and whether they are applied
+  force <version>   record migrations up to version as applied without running them`
+
+// newMigrationRunner loads the SQL migrations and binds them to the primary database
+func newMigrationRunner(cfg *config.Config) (*schemamigration.Runner, error) {
+	migrations, err := schemamigration.Load(cfg.Database.MigrationsDir)
+	if err != nil {
+		return nil, err
+	}
+	sqlDB, err := db.GetDB().DB()
+	if err != nil {
+		return nil, err
+	}
+	return schemamigration.NewRunner(sqlDB, migrations), nil
+}
+
+// autoMigrate applies pending migrations at startup when DB_AUTO_MIGRATE is set
+func autoMigrate(ctx context.Context, cfg *config.Config) error {
+	runner, err := newMigrationRunner(cfg)
+	if err != nil {
+		return err
+	}
+	applied, err := runner.Up(ctx)
+	for _, m := range applied {
+		logger.Info(fmt.Sprintf("Applied migration %03d_%s", m.Version, m.Name))
+	}
+	return err
+}
+
+// runMigrateCommand runs `migrate <command>` and returns the process exit code
+func runMigrateCommand(cfg *config.Config, args []string) int {
+	if len(args) == 0 {
+		fmt.Fprintln(os.Stderr, migrateUsage)
+		return 2
+	}
+
+	runner, err := newMigrationRunner(cfg)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "migrate:", err)
+		return 1
+	}
+
+	ctx := context.Background()
+	switch args[0] {
+	case "up":
+		applied, err := runner.Up(ctx)
+		for _, m := range applied {
+			fmt.Printf("applied %03d_%s\n", m.Version, m.Name)
+		}
+		if err == nil && len(applied) == 0 {
+			fmt.Println("no pending migrations")
+		}
+		return exitCode(err)
+
+	case "down":
+		steps := 1
+		if len(args) > 1 {
+			if steps, err = strconv.Atoi(args[1]); err != nil || steps < 1 {
+				fmt.Fprintln(os.Stderr, "migrate: steps must be a positive number")
+				return 2
+			}
+		}
+		rolledBack, err := runner.Down(ctx, steps)
+		for _, m := range rolledBack {
+			fmt.Printf("rolled back %03d_%s\n", m.Version, m.Name)
+		}
+		return exitCode(err)
+
+	case "status":
+		statuses, err := runner.Status(ctx)
+		if err != nil {
+			return exitCode(err)
+		}
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "VERSION\tNAME\tSTATE\tAPPLIED AT")
+		for _, s := range statuses {
+			appliedAt := "-"
+			if s.AppliedAt != nil {
+				appliedAt = s.AppliedAt.UTC().Format("2006-01-02 15:04:05")
+			}
+			fmt.Fprintf(w, "%03d\t%s\t%s\t%s\n", s.Version, s.Name, s.State, appliedAt)
+		}
+		w.Flush()
+		return 0
+
+	case "force":
+		if len(args) < 2 {
+			fmt.Fprintln(os.Stderr, migrateUsage)
+			return 2
+		}
+		version, err := strconv.ParseInt(args[1], 10, 64)
+		if err != nil || version < 0 {
+			fmt.Fprintln(os.Stderr, "migrate: version must be a non-negative number")
+			return 2
+		}
+		if err := runner.Force(ctx, version); err != nil {
+			return exitCode(err)
+		}
+		fmt.Printf("schema version forced to %03d\n", version)
+		return 0
+
+	default:
+		fmt.Fprintln(os.Stderr, migrateUsage)
+		return 2
+	}
+}
+
+func exitCode(err error) int {
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "migrate:", err)
+		return 1
+	}
+	return 0
+}
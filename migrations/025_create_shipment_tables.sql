@@ -0,0 +1,55 @@
+-- Migration: 025_create_shipment_tables.sql
+-- Description: Shipment, shipment item, and carrier tracking event tables for fulfillment module
+
+CREATE TABLE IF NOT EXISTS order_shipment (
+    id BIGSERIAL PRIMARY KEY,
+    order_id BIGINT NOT NULL REFERENCES "order"(id) ON DELETE CASCADE,
+    seller_id BIGINT NOT NULL,
+    carrier VARCHAR(50) NOT NULL,
+    tracking_no VARCHAR(100) NOT NULL,
+    status VARCHAR(32) NOT NULL DEFAULT 'pending',
+    shipped_at TIMESTAMPTZ,
+    delivered_at TIMESTAMPTZ,
+    last_tracked_at TIMESTAMPTZ,
+    metadata JSONB NOT NULL DEFAULT '{}',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT uq_order_shipment_carrier_tracking UNIQUE (carrier, tracking_no)
+);
+
+CREATE INDEX IF NOT EXISTS idx_order_shipment_order_id ON order_shipment(order_id);
+CREATE INDEX IF NOT EXISTS idx_order_shipment_seller_id ON order_shipment(seller_id);
+CREATE INDEX IF NOT EXISTS idx_order_shipment_status ON order_shipment(status);
+
+CREATE TABLE IF NOT EXISTS order_shipment_item (
+    id BIGSERIAL PRIMARY KEY,
+    shipment_id BIGINT NOT NULL REFERENCES order_shipment(id) ON DELETE CASCADE,
+    order_item_id BIGINT NOT NULL REFERENCES order_item(id) ON DELETE CASCADE,
+    quantity INTEGER NOT NULL CHECK (quantity > 0),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_order_shipment_item_shipment_id ON order_shipment_item(shipment_id);
+CREATE INDEX IF NOT EXISTS idx_order_shipment_item_order_item_id ON order_shipment_item(order_item_id);
+
+-- Immutable carrier checkpoints (webhook pushes and polling results)
+CREATE TABLE IF NOT EXISTS shipment_tracking_event (
+    id BIGSERIAL PRIMARY KEY,
+    shipment_id BIGINT NOT NULL REFERENCES order_shipment(id) ON DELETE CASCADE,
+    status VARCHAR(32) NOT NULL,
+    carrier_status VARCHAR(100),
+    description TEXT,
+    location VARCHAR(255),
+    source VARCHAR(20) NOT NULL,
+    external_event_id VARCHAR(255),
+    occurred_at TIMESTAMPTZ NOT NULL,
+    raw_payload JSONB NOT NULL DEFAULT '{}',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_shipment_tracking_event_shipment_id
+    ON shipment_tracking_event(shipment_id, occurred_at);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_shipment_tracking_event_external_id
+    ON shipment_tracking_event(shipment_id, external_event_id)
+    WHERE external_event_id IS NOT NULL;
@@ -0,0 +1,20 @@
+-- Migration: 026_create_payment_method_rule_table.sql
+-- Description: Seller-level checkout payment method rules (enablement, amount limits, domestic-only)
+
+CREATE TABLE IF NOT EXISTS payment_method_rule (
+    id BIGSERIAL PRIMARY KEY,
+    seller_id BIGINT NOT NULL REFERENCES seller_profile(user_id),
+    method VARCHAR(50) NOT NULL,            -- 'card', 'upi', 'wallet', 'bank_transfer', 'emi', 'bnpl', 'cod'
+    is_enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    min_amount_cents BIGINT,                -- NULL = no lower bound
+    max_amount_cents BIGINT,                -- NULL = no upper bound
+    domestic_only BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT uq_payment_method_rule_seller_method UNIQUE (seller_id, method),
+    CONSTRAINT chk_payment_method_rule_amounts CHECK (
+        min_amount_cents IS NULL OR max_amount_cents IS NULL OR min_amount_cents <= max_amount_cents
+    )
+);
+
+CREATE INDEX IF NOT EXISTS idx_payment_method_rule_seller_id ON payment_method_rule(seller_id);
@@ -0,0 +1,16 @@
+-- Migration: 027_create_notification_template_table.sql
+-- Description: Admin-managed notification templates per event type and channel
+
+CREATE TABLE IF NOT EXISTS notification_template (
+    id BIGSERIAL PRIMARY KEY,
+    event_type VARCHAR(100) NOT NULL,
+    channel VARCHAR(20) NOT NULL,           -- 'email', 'sms', 'push', 'in_app'
+    subject TEXT NOT NULL DEFAULT '',       -- Go text/template; unused for sms
+    body TEXT NOT NULL,                     -- Go template (html/template for email)
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT uq_notification_template_event_channel UNIQUE (event_type, channel)
+);
+
+CREATE INDEX IF NOT EXISTS idx_notification_template_event_type ON notification_template(event_type);
@@ -0,0 +1,29 @@
+-- Migration: 028_create_user_notification_tables.sql
+-- Description: Persisted in-app notifications and per-device read-state sync cursors
+
+CREATE TABLE IF NOT EXISTS user_notification (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL REFERENCES "user"(id) ON DELETE CASCADE,
+    event_type VARCHAR(100) NOT NULL,
+    title VARCHAR(255) NOT NULL DEFAULT '',
+    body TEXT NOT NULL,
+    data JSONB NOT NULL DEFAULT '{}',
+    read_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Delta sync scans a user's notifications by (updated_at, id)
+CREATE INDEX IF NOT EXISTS idx_user_notification_user_updated
+    ON user_notification(user_id, updated_at, id);
+
+CREATE TABLE IF NOT EXISTS notification_device_cursor (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL REFERENCES "user"(id) ON DELETE CASCADE,
+    device_id VARCHAR(100) NOT NULL,
+    last_synced_at TIMESTAMPTZ,
+    last_synced_id BIGINT NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT uq_notification_device_cursor_user_device UNIQUE (user_id, device_id)
+);
@@ -0,0 +1,26 @@
+-- Migration: 029_create_notification_channel_tables.sql
+-- Description: Per-user notification channel preferences and push device tokens
+
+CREATE TABLE IF NOT EXISTS notification_channel_preference (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL REFERENCES "user"(id) ON DELETE CASCADE,
+    channel VARCHAR(20) NOT NULL,
+    is_enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT uq_notification_channel_preference_user_channel UNIQUE (user_id, channel)
+);
+
+CREATE TABLE IF NOT EXISTS user_push_token (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL REFERENCES "user"(id) ON DELETE CASCADE,
+    token VARCHAR(512) NOT NULL,
+    platform VARCHAR(20) NOT NULL,
+    last_seen_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    -- A device token belongs to whoever registered it last (shared devices, re-login)
+    CONSTRAINT uq_user_push_token_token UNIQUE (token)
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_push_token_user_id ON user_push_token(user_id);
@@ -0,0 +1,39 @@
+-- Migration: 030_create_delegated_access_token_tables.sql
+-- Description: Seller-minted, scoped and expiring delegated access tokens with an audit trail
+
+CREATE TABLE IF NOT EXISTS delegated_access_token (
+    id BIGSERIAL PRIMARY KEY,
+    seller_id BIGINT NOT NULL REFERENCES "user"(id) ON DELETE CASCADE,
+    created_by_user_id BIGINT NOT NULL REFERENCES "user"(id),
+    name VARCHAR(100) NOT NULL,
+    -- Only the SHA-256 hex digest is stored; the raw token is shown once at creation
+    token_hash CHAR(64) NOT NULL,
+    token_prefix VARCHAR(16) NOT NULL,
+    scopes TEXT[] NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL,
+    last_used_at TIMESTAMPTZ,
+    revoked_at TIMESTAMPTZ,
+    revoked_by_user_id BIGINT REFERENCES "user"(id),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT uq_delegated_access_token_hash UNIQUE (token_hash)
+);
+
+CREATE INDEX IF NOT EXISTS idx_delegated_access_token_seller_id
+    ON delegated_access_token(seller_id, created_at DESC);
+
+CREATE TABLE IF NOT EXISTS delegated_access_token_audit (
+    id BIGSERIAL PRIMARY KEY,
+    token_id BIGINT NOT NULL REFERENCES delegated_access_token(id) ON DELETE CASCADE,
+    seller_id BIGINT NOT NULL REFERENCES "user"(id) ON DELETE CASCADE,
+    -- created | used | denied | revoked
+    action VARCHAR(20) NOT NULL,
+    actor_user_id BIGINT REFERENCES "user"(id),
+    method VARCHAR(10),
+    path VARCHAR(255),
+    ip_address VARCHAR(64),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_delegated_access_token_audit_token_id
+    ON delegated_access_token_audit(token_id, created_at DESC);
@@ -0,0 +1,51 @@
+-- Migration: 031_create_seller_ledger_tables.sql
+-- Description: Payment chargebacks and the per-seller money ledger used by platform revenue reports
+
+CREATE TABLE IF NOT EXISTS payment_chargeback (
+    id BIGSERIAL PRIMARY KEY,
+    chargeback_id VARCHAR(50) NOT NULL UNIQUE,
+    transaction_id BIGINT NOT NULL REFERENCES payment_transaction(id),
+    gateway_dispute_id VARCHAR(255),
+    currency VARCHAR(3) NOT NULL,
+    amount_cents BIGINT NOT NULL,
+    status VARCHAR(20) NOT NULL,  -- 'open', 'won', 'lost'
+    reason VARCHAR(100),
+    opened_at TIMESTAMPTZ NOT NULL,
+    resolved_at TIMESTAMPTZ,
+    metadata JSONB,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_payment_chargeback_transaction_id
+    ON payment_chargeback(transaction_id);
+CREATE INDEX IF NOT EXISTS idx_payment_chargeback_status
+    ON payment_chargeback(status);
+
+-- Append-only money movements per seller. Amounts are always positive; the entry
+-- type decides the direction (sale credits the seller, everything else debits).
+CREATE TABLE IF NOT EXISTS seller_ledger_entry (
+    id BIGSERIAL PRIMARY KEY,
+    seller_id BIGINT NOT NULL REFERENCES "user"(id),
+    entry_type VARCHAR(20) NOT NULL,  -- 'sale', 'commission', 'refund', 'chargeback', 'payout', 'adjustment'
+    currency VARCHAR(3) NOT NULL,
+    amount_cents BIGINT NOT NULL CHECK (amount_cents >= 0),
+    order_id BIGINT,
+    payment_transaction_id BIGINT REFERENCES payment_transaction(id),
+    refund_id BIGINT REFERENCES payment_refund(id),
+    chargeback_id BIGINT REFERENCES payment_chargeback(id),
+    description TEXT,
+    occurred_at TIMESTAMPTZ NOT NULL,
+    metadata JSONB,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_seller_ledger_entry_seller_occurred
+    ON seller_ledger_entry(seller_id, occurred_at DESC);
+CREATE INDEX IF NOT EXISTS idx_seller_ledger_entry_occurred_at
+    ON seller_ledger_entry(occurred_at);
+CREATE INDEX IF NOT EXISTS idx_seller_ledger_entry_entry_type
+    ON seller_ledger_entry(entry_type);
+
+COMMENT ON TABLE payment_chargeback IS 'Card disputes raised against payment transactions';
+COMMENT ON TABLE seller_ledger_entry IS 'Per-seller ledger of sales, commission, refunds, chargebacks and payouts';
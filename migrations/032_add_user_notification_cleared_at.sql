@@ -0,0 +1,10 @@
+-- Migration: 032_add_user_notification_cleared_at.sql
+-- Description: Soft-clear for in-app notifications so the notification center can bulk-clear
+-- while other devices still receive the clear through delta sync
+
+ALTER TABLE user_notification ADD COLUMN IF NOT EXISTS cleared_at TIMESTAMPTZ;
+
+-- The notification center lists a user's visible notifications newest first
+CREATE INDEX IF NOT EXISTS idx_user_notification_user_visible
+    ON user_notification(user_id, created_at DESC, id DESC)
+    WHERE cleared_at IS NULL;
@@ -0,0 +1,33 @@
+-- Migration: 033_create_data_migration_run_table.sql
+-- Description: Progress and checkpoint tracking for chunked background data migrations
+
+CREATE TABLE IF NOT EXISTS data_migration_run (
+    id BIGSERIAL PRIMARY KEY,
+    name VARCHAR(150) NOT NULL,
+    status VARCHAR(20) NOT NULL CHECK (status IN ('running', 'paused', 'completed', 'failed')),
+    -- Opaque position of the last processed item; chunks resume from here
+    checkpoint TEXT NOT NULL DEFAULT '',
+    batch_size INTEGER NOT NULL CHECK (batch_size > 0),
+    chunk_delay_ms INTEGER NOT NULL DEFAULT 0 CHECK (chunk_delay_ms >= 0),
+    processed_count BIGINT NOT NULL DEFAULT 0,
+    chunk_count BIGINT NOT NULL DEFAULT 0,
+    total_estimate BIGINT,
+    failure_count INTEGER NOT NULL DEFAULT 0,
+    last_error TEXT,
+    -- Only the chunk job carrying the current token may advance the run
+    chain_token VARCHAR(64) NOT NULL DEFAULT '',
+    started_by_user_id BIGINT REFERENCES "user"(id) ON DELETE SET NULL,
+    heartbeat_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    paused_at TIMESTAMPTZ,
+    completed_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- At most one unfinished run per migration
+CREATE UNIQUE INDEX IF NOT EXISTS uq_data_migration_run_active_name
+    ON data_migration_run(name)
+    WHERE status IN ('running', 'paused');
+
+CREATE INDEX IF NOT EXISTS idx_data_migration_run_status_heartbeat
+    ON data_migration_run(status, heartbeat_at);
@@ -0,0 +1,13 @@
+-- Migration: 034_create_notification_category_preference_table.sql
+-- Description: Per-user opt-in/opt-out for notification event categories
+-- (marketing, order updates, stock alerts), also written by one-click unsubscribe
+
+CREATE TABLE IF NOT EXISTS notification_category_preference (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL REFERENCES "user"(id) ON DELETE CASCADE,
+    category VARCHAR(50) NOT NULL,
+    is_enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT uq_notification_category_preference_user_category UNIQUE (user_id, category)
+);
@@ -0,0 +1,18 @@
+-- Migration: 035_create_variant_channel_price_table.sql
+-- Description: Per-sales-channel (web, pos, marketplace) price overrides for product variants.
+-- Resolution order is channel override -> product_variant.price. Orders record the channel
+-- they were priced for.
+
+CREATE TABLE IF NOT EXISTS variant_channel_price (
+    id BIGSERIAL PRIMARY KEY,
+    variant_id BIGINT NOT NULL REFERENCES product_variant(id) ON DELETE CASCADE,
+    channel VARCHAR(30) NOT NULL,
+    price DOUBLE PRECISION NOT NULL CHECK (price > 0),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT uq_variant_channel_price_variant_channel UNIQUE (variant_id, channel)
+);
+
+CREATE INDEX IF NOT EXISTS idx_variant_channel_price_channel ON variant_channel_price(channel);
+
+ALTER TABLE "order" ADD COLUMN IF NOT EXISTS sales_channel VARCHAR(30);
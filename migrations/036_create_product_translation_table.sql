@@ -0,0 +1,29 @@
+-- Migration: 036_create_product_translation_table.sql
+-- Description: Per-locale product content. product.name / short_description / long_description
+-- and product_option.display_name hold the default-locale text; these tables hold the other
+-- locales. Public reads resolve the requested locale and fall back to the default content.
+
+CREATE TABLE IF NOT EXISTS product_translation (
+    id BIGSERIAL PRIMARY KEY,
+    product_id BIGINT NOT NULL REFERENCES product(id) ON DELETE CASCADE,
+    locale VARCHAR(20) NOT NULL,
+    name VARCHAR(200),
+    short_description TEXT,
+    long_description TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT uq_product_translation_product_locale UNIQUE (product_id, locale)
+);
+
+CREATE TABLE IF NOT EXISTS product_option_translation (
+    id BIGSERIAL PRIMARY KEY,
+    option_id BIGINT NOT NULL REFERENCES product_option(id) ON DELETE CASCADE,
+    locale VARCHAR(20) NOT NULL,
+    display_name VARCHAR(100) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT uq_product_option_translation_option_locale UNIQUE (option_id, locale)
+);
+
+CREATE INDEX IF NOT EXISTS idx_product_translation_locale ON product_translation(locale);
+CREATE INDEX IF NOT EXISTS idx_product_option_translation_locale ON product_option_translation(locale);
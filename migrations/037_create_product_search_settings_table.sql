@@ -0,0 +1,20 @@
+-- Migration: 037_create_product_search_settings_table.sql
+-- Description: Per-seller storefront listing/search configuration: the default sort used when
+-- a request does not pass sortBy, and relevance weights for name, tag and description matches.
+-- Sellers without a row use the platform defaults (newest first; weights 3 / 2 / 1).
+
+CREATE TABLE IF NOT EXISTS product_search_settings (
+    id BIGSERIAL PRIMARY KEY,
+    seller_id BIGINT NOT NULL,
+    default_sort VARCHAR(20) NOT NULL DEFAULT 'newest'
+        CHECK (default_sort IN ('newest', 'price', 'popularity', 'relevance')),
+    default_sort_order VARCHAR(4) NOT NULL DEFAULT 'desc'
+        CHECK (default_sort_order IN ('asc', 'desc')),
+    name_weight DOUBLE PRECISION NOT NULL DEFAULT 3 CHECK (name_weight BETWEEN 0 AND 10),
+    tags_weight DOUBLE PRECISION NOT NULL DEFAULT 2 CHECK (tags_weight BETWEEN 0 AND 10),
+    description_weight DOUBLE PRECISION NOT NULL DEFAULT 1
+        CHECK (description_weight BETWEEN 0 AND 10),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT uq_product_search_settings_seller UNIQUE (seller_id)
+);
@@ -0,0 +1,57 @@
+-- Migration: 038_create_marketplace_tables.sql
+-- Description: Marketplace (Amazon, eBay) order ingestion. A connection holds a seller's
+-- marketplace account and webhook secret; ingested orders are linked to the internal order
+-- created for them, and shipment confirmations pushed back are tracked for retry.
+
+CREATE TABLE IF NOT EXISTS marketplace_connection (
+    id BIGSERIAL PRIMARY KEY,
+    seller_id BIGINT NOT NULL,
+    marketplace VARCHAR(30) NOT NULL CHECK (marketplace IN ('amazon', 'ebay')),
+    external_seller_id VARCHAR(100) NOT NULL,
+    webhook_secret TEXT NOT NULL,
+    credentials JSONB NOT NULL DEFAULT '{}',
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    last_order_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT uq_marketplace_connection_account UNIQUE (marketplace, external_seller_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_marketplace_connection_seller_id
+    ON marketplace_connection(seller_id);
+
+CREATE TABLE IF NOT EXISTS marketplace_order (
+    id BIGSERIAL PRIMARY KEY,
+    connection_id BIGINT NOT NULL REFERENCES marketplace_connection(id),
+    seller_id BIGINT NOT NULL,
+    marketplace VARCHAR(30) NOT NULL,
+    external_order_id VARCHAR(100) NOT NULL,
+    order_id BIGINT NOT NULL REFERENCES "order"(id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT uq_marketplace_order_external UNIQUE (marketplace, external_order_id),
+    CONSTRAINT uq_marketplace_order_order UNIQUE (order_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_marketplace_order_connection_id
+    ON marketplace_order(connection_id);
+
+CREATE TABLE IF NOT EXISTS marketplace_shipment_sync (
+    id BIGSERIAL PRIMARY KEY,
+    marketplace_order_id BIGINT NOT NULL REFERENCES marketplace_order(id) ON DELETE CASCADE,
+    shipment_id BIGINT NOT NULL REFERENCES order_shipment(id) ON DELETE CASCADE,
+    carrier VARCHAR(50),
+    tracking_no VARCHAR(100),
+    items JSONB NOT NULL DEFAULT '{}',
+    status VARCHAR(20) NOT NULL DEFAULT 'pending'
+        CHECK (status IN ('pending', 'synced', 'failed')),
+    attempts INT NOT NULL DEFAULT 0,
+    last_error TEXT,
+    synced_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT uq_marketplace_shipment_sync_shipment UNIQUE (shipment_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_marketplace_shipment_sync_status
+    ON marketplace_shipment_sync(status);
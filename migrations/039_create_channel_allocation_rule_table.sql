@@ -0,0 +1,24 @@
+-- Migration: 039_create_channel_allocation_rule_table.sql
+-- Description: Allocation rules for stock shared across sales channels (web, pos, marketplace).
+-- reserve_percent holds a share of a variant's pooled available stock for one channel;
+-- buffer_quantity withholds units from that channel. Rows without variant_id are the seller
+-- default; a variant row replaces the default for the same channel. Rules are evaluated when
+-- availability is checked and when stock is reserved for a channel.
+
+CREATE TABLE IF NOT EXISTS channel_allocation_rule (
+    id BIGSERIAL PRIMARY KEY,
+    seller_id BIGINT NOT NULL,
+    variant_id BIGINT REFERENCES product_variant(id) ON DELETE CASCADE,
+    channel VARCHAR(30) NOT NULL,
+    reserve_percent INTEGER NOT NULL DEFAULT 0 CHECK (reserve_percent BETWEEN 0 AND 100),
+    buffer_quantity INTEGER NOT NULL DEFAULT 0 CHECK (buffer_quantity >= 0),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_channel_allocation_rule_seller_default
+    ON channel_allocation_rule(seller_id, channel) WHERE variant_id IS NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS uq_channel_allocation_rule_seller_variant
+    ON channel_allocation_rule(seller_id, variant_id, channel) WHERE variant_id IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_channel_allocation_rule_variant
+    ON channel_allocation_rule(variant_id);
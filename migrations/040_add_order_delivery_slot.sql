@@ -0,0 +1,13 @@
+-- Migration: 040_add_order_delivery_slot.sql
+-- Description: Scheduled delivery slot on orders. The sequence is bumped on every reschedule
+-- so the calendar invite sent to the customer replaces the previous one.
+
+ALTER TABLE "order" ADD COLUMN IF NOT EXISTS delivery_slot_start TIMESTAMPTZ;
+ALTER TABLE "order" ADD COLUMN IF NOT EXISTS delivery_slot_end TIMESTAMPTZ;
+ALTER TABLE "order" ADD COLUMN IF NOT EXISTS delivery_slot_sequence INTEGER NOT NULL DEFAULT 0;
+
+ALTER TABLE "order" DROP CONSTRAINT IF EXISTS chk_order_delivery_slot;
+ALTER TABLE "order" ADD CONSTRAINT chk_order_delivery_slot CHECK (
+    (delivery_slot_start IS NULL AND delivery_slot_end IS NULL)
+    OR (delivery_slot_start IS NOT NULL AND delivery_slot_end > delivery_slot_start)
+);
@@ -0,0 +1,8 @@
+-- Migration: 041_add_profile_media_crop.sql
+-- Description: User avatars and store logo crops. Files live in file_object; the crop
+-- rectangle (source pixels) is kept next to the reference so clients render the same framing.
+
+ALTER TABLE "user" ADD COLUMN IF NOT EXISTS avatar_file_id VARCHAR(80);
+ALTER TABLE "user" ADD COLUMN IF NOT EXISTS avatar_crop JSONB;
+
+ALTER TABLE seller_profile ADD COLUMN IF NOT EXISTS business_logo_crop JSONB;
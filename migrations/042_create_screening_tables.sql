@@ -0,0 +1,62 @@
+-- Migration: 042_create_screening_tables.sql
+-- Description: Sanctions / blocked-party screening: deny lists, the compliance review
+-- queue and its audit log
+
+CREATE TABLE IF NOT EXISTS screening_deny_list_entry (
+    id BIGSERIAL PRIMARY KEY,
+    list_name VARCHAR(100) NOT NULL,
+    entry_type VARCHAR(20) NOT NULL CHECK (entry_type IN ('name', 'address', 'country')),
+    value VARCHAR(500) NOT NULL,
+    -- Lowercased, accent-free, punctuation-collapsed form used for matching
+    normalized_value VARCHAR(500) NOT NULL,
+    notes TEXT NOT NULL DEFAULT '',
+    -- Entries are deactivated, never deleted, so past decisions stay explainable
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_by_user_id BIGINT REFERENCES "user"(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_screening_deny_list_entry_active
+    ON screening_deny_list_entry(is_active, list_name);
+
+CREATE TABLE IF NOT EXISTS screening_case (
+    id BIGSERIAL PRIMARY KEY,
+    subject_type VARCHAR(30) NOT NULL CHECK (subject_type IN ('seller_onboarding', 'order')),
+    -- Seller user ID for onboarding, order ID for orders
+    reference_id BIGINT NOT NULL,
+    seller_id BIGINT NOT NULL,
+    subject_names TEXT NOT NULL,
+    subject_address TEXT NOT NULL DEFAULT '',
+    country_code VARCHAR(2) NOT NULL DEFAULT '',
+    status VARCHAR(20) NOT NULL CHECK (status IN ('pending_review', 'cleared', 'confirmed')),
+    matches JSONB NOT NULL DEFAULT '[]'::jsonb,
+    -- Set when a provider failed and the subject was queued to be safe
+    provider_error TEXT,
+    reviewed_by_user_id BIGINT REFERENCES "user"(id) ON DELETE SET NULL,
+    reviewed_at TIMESTAMPTZ,
+    review_notes TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_screening_case_status_id
+    ON screening_case(status, id);
+
+CREATE INDEX IF NOT EXISTS idx_screening_case_subject
+    ON screening_case(subject_type, reference_id);
+
+CREATE TABLE IF NOT EXISTS screening_audit_log (
+    id BIGSERIAL PRIMARY KEY,
+    action VARCHAR(40) NOT NULL,
+    case_id BIGINT REFERENCES screening_case(id) ON DELETE SET NULL,
+    subject_type VARCHAR(30) NOT NULL DEFAULT '',
+    reference_id BIGINT,
+    -- NULL for automated screening decisions
+    actor_user_id BIGINT REFERENCES "user"(id) ON DELETE SET NULL,
+    details JSONB NOT NULL DEFAULT '{}'::jsonb,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_screening_audit_log_case
+    ON screening_audit_log(case_id, id);
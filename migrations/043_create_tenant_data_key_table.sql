@@ -0,0 +1,20 @@
+-- Migration: 043_create_tenant_data_key_table.sql
+-- Description: Per-seller data encryption keys (envelope encryption). Keys are stored
+-- wrapped with the system master key; retired versions keep decrypting older values.
+
+CREATE TABLE IF NOT EXISTS tenant_data_key (
+    id BIGSERIAL PRIMARY KEY,
+    seller_id BIGINT NOT NULL REFERENCES "user"(id) ON DELETE CASCADE,
+    version INTEGER NOT NULL CHECK (version > 0),
+    wrapped_key TEXT NOT NULL,
+    status VARCHAR(20) NOT NULL CHECK (status IN ('active', 'retired')),
+    retired_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT uq_tenant_data_key_seller_version UNIQUE (seller_id, version)
+);
+
+-- At most one active key per seller
+CREATE UNIQUE INDEX IF NOT EXISTS uq_tenant_data_key_active_seller
+    ON tenant_data_key(seller_id)
+    WHERE status = 'active';
@@ -0,0 +1,36 @@
+-- Migration: 044_add_order_deposit_payments.sql
+-- Description: Deposit orders. The order records its payment plan and when the balance is
+-- collected; order_payment_installment tracks the deposit and balance payments, including
+-- balance due dates, reminders and expiry.
+
+ALTER TABLE "order" ADD COLUMN IF NOT EXISTS payment_plan VARCHAR(20) NOT NULL DEFAULT 'full';
+ALTER TABLE "order" ADD COLUMN IF NOT EXISTS balance_collection VARCHAR(32);
+
+ALTER TABLE "order" DROP CONSTRAINT IF EXISTS chk_order_payment_plan;
+ALTER TABLE "order" ADD CONSTRAINT chk_order_payment_plan CHECK (
+    (payment_plan = 'full' AND balance_collection IS NULL)
+    OR (payment_plan = 'deposit' AND balance_collection IN ('before_fulfillment', 'on_delivery'))
+);
+
+CREATE TABLE IF NOT EXISTS order_payment_installment (
+    id BIGSERIAL PRIMARY KEY,
+    order_id BIGINT NOT NULL REFERENCES "order"(id) ON DELETE CASCADE,
+    kind VARCHAR(20) NOT NULL CHECK (kind IN ('deposit', 'balance')),
+    amount_cents BIGINT NOT NULL CHECK (amount_cents > 0),
+    due_at TIMESTAMPTZ,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending'
+        CHECK (status IN ('pending', 'paid', 'expired')),
+    paid_at TIMESTAMPTZ,
+    transaction_id VARCHAR(255),
+    reminder_sent_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT uq_order_payment_installment_kind UNIQUE (order_id, kind)
+);
+
+CREATE INDEX IF NOT EXISTS idx_order_payment_installment_order_id
+    ON order_payment_installment(order_id);
+
+-- Balance sweeps look up pending installments by due date
+CREATE INDEX IF NOT EXISTS idx_order_payment_installment_pending_due
+    ON order_payment_installment(due_at) WHERE status = 'pending' AND due_at IS NOT NULL;
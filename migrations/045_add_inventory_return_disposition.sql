@@ -0,0 +1,20 @@
+-- Migration: 045_add_inventory_return_disposition.sql
+-- Description: Quality disposition for customer returns. Damaged and quarantined units are
+-- held on the inventory row outside quantity, so only restocked units become available.
+-- RETURN ledger entries record the disposition and the number of held units.
+
+ALTER TABLE inventory ADD COLUMN IF NOT EXISTS damaged_quantity INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE inventory ADD COLUMN IF NOT EXISTS quarantined_quantity INTEGER NOT NULL DEFAULT 0;
+
+ALTER TABLE inventory DROP CONSTRAINT IF EXISTS chk_inventory_held_quantities;
+ALTER TABLE inventory ADD CONSTRAINT chk_inventory_held_quantities
+    CHECK (damaged_quantity >= 0 AND quarantined_quantity >= 0);
+
+ALTER TABLE inventory_transaction ADD COLUMN IF NOT EXISTS disposition VARCHAR(20);
+ALTER TABLE inventory_transaction ADD COLUMN IF NOT EXISTS held_quantity_change INTEGER NOT NULL DEFAULT 0;
+
+ALTER TABLE inventory_transaction DROP CONSTRAINT IF EXISTS chk_inventory_transaction_disposition;
+ALTER TABLE inventory_transaction ADD CONSTRAINT chk_inventory_transaction_disposition CHECK (
+    disposition IS NULL
+    OR (type = 'RETURN' AND disposition IN ('RESTOCK', 'DAMAGED', 'QUARANTINE'))
+);
@@ -0,0 +1,9 @@
+-- Migration: 046_add_payment_transaction_authorization.sql
+-- Description: Card brand and authorization time of payment transactions, used by the
+-- payment analytics reports for success rates per card type and capture latency.
+
+ALTER TABLE payment_transaction ADD COLUMN IF NOT EXISTS card_brand VARCHAR(30);
+ALTER TABLE payment_transaction ADD COLUMN IF NOT EXISTS authorized_at TIMESTAMPTZ;
+
+CREATE INDEX IF NOT EXISTS idx_payment_transaction_gateway_created_at
+    ON payment_transaction(gateway_id, created_at DESC);
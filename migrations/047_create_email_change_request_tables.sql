@@ -0,0 +1,37 @@
+-- Migration: 047_create_email_change_request_tables.sql
+-- Description: Email changes confirmed from both the old and the new address, with an audit trail
+
+CREATE TABLE IF NOT EXISTS email_change_request (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL REFERENCES "user"(id) ON DELETE CASCADE,
+    old_email VARCHAR(255) NOT NULL,
+    new_email VARCHAR(255) NOT NULL,
+    -- Only the SHA-256 hex digests are stored; the raw tokens are emailed once
+    old_token_hash CHAR(64) NOT NULL,
+    new_token_hash CHAR(64) NOT NULL,
+    old_confirmed_at TIMESTAMPTZ,
+    new_confirmed_at TIMESTAMPTZ,
+    expires_at TIMESTAMPTZ NOT NULL,
+    completed_at TIMESTAMPTZ,
+    cancelled_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT uq_email_change_request_old_token_hash UNIQUE (old_token_hash),
+    CONSTRAINT uq_email_change_request_new_token_hash UNIQUE (new_token_hash)
+);
+
+CREATE INDEX IF NOT EXISTS idx_email_change_request_user_id
+    ON email_change_request(user_id, created_at DESC);
+
+CREATE TABLE IF NOT EXISTS email_change_audit (
+    id BIGSERIAL PRIMARY KEY,
+    request_id BIGINT NOT NULL REFERENCES email_change_request(id) ON DELETE CASCADE,
+    user_id BIGINT NOT NULL REFERENCES "user"(id) ON DELETE CASCADE,
+    -- requested | confirmed_old | confirmed_new | completed | cancelled
+    action VARCHAR(20) NOT NULL,
+    ip_address VARCHAR(64),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_email_change_audit_request_id
+    ON email_change_audit(request_id, created_at DESC);
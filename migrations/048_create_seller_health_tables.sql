@@ -0,0 +1,42 @@
+-- Migration: 048_create_seller_health_tables.sql
+-- Description: Daily seller health scores with risk flags, trend history and listing demotion
+
+-- Current health per seller, rewritten by the daily aggregation
+CREATE TABLE IF NOT EXISTS seller_health (
+    seller_id BIGINT PRIMARY KEY REFERENCES "user"(id) ON DELETE CASCADE,
+    score INT NOT NULL,
+    -- healthy | at_risk | demoted | insufficient_data
+    status VARCHAR(20) NOT NULL,
+    risk_flags TEXT[] NOT NULL DEFAULT '{}',
+    -- Demoted sellers' products rank below all others in search and catalog listings
+    listing_demoted BOOLEAN NOT NULL DEFAULT FALSE,
+    demoted_at TIMESTAMPTZ,
+    calculated_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_seller_health_demoted
+    ON seller_health(seller_id) WHERE listing_demoted;
+
+-- One row per seller and day, for trend history
+CREATE TABLE IF NOT EXISTS seller_health_snapshot (
+    id BIGSERIAL PRIMARY KEY,
+    seller_id BIGINT NOT NULL REFERENCES "user"(id) ON DELETE CASCADE,
+    snapshot_date DATE NOT NULL,
+    window_days INT NOT NULL,
+    order_count INT NOT NULL DEFAULT 0,
+    cancelled_count INT NOT NULL DEFAULT 0,
+    sla_tracked_count INT NOT NULL DEFAULT 0,
+    sla_breach_count INT NOT NULL DEFAULT 0,
+    transaction_count INT NOT NULL DEFAULT 0,
+    dispute_count INT NOT NULL DEFAULT 0,
+    review_count INT NOT NULL DEFAULT 0,
+    -- Average rating 1-5; NULL until the seller has reviews
+    review_score NUMERIC(3, 2),
+    score INT NOT NULL,
+    status VARCHAR(20) NOT NULL,
+    risk_flags TEXT[] NOT NULL DEFAULT '{}',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT uq_seller_health_snapshot_day UNIQUE (seller_id, snapshot_date)
+);
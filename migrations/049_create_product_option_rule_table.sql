@@ -0,0 +1,20 @@
+-- Migration: 049_create_product_option_rule_table.sql
+-- Description: Per-product option dependency rules (e.g. Storage only applies when Color=Special Edition)
+
+-- A rule restricts an option, or some of its values, to variants whose
+-- condition option is set to one of the condition values
+CREATE TABLE IF NOT EXISTS product_option_rule (
+    id BIGSERIAL PRIMARY KEY,
+    product_id BIGINT NOT NULL REFERENCES product(id) ON DELETE CASCADE,
+    option_id BIGINT NOT NULL REFERENCES product_option(id) ON DELETE CASCADE,
+    -- Restricted values of option_id; empty restricts the whole option
+    option_value_ids BIGINT[] NOT NULL DEFAULT '{}',
+    condition_option_id BIGINT NOT NULL REFERENCES product_option(id) ON DELETE CASCADE,
+    condition_value_ids BIGINT[] NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CHECK (option_id <> condition_option_id),
+    CHECK (cardinality(condition_value_ids) > 0)
+);
+
+CREATE INDEX IF NOT EXISTS idx_product_option_rule_product_id ON product_option_rule(product_id);
@@ -0,0 +1,28 @@
+-- Migration: 050_create_sponsored_placement_table.sql
+-- Description: Seller-configured sponsored products injected into search results
+
+-- A placement shows product_id at result position for searches whose text contains
+-- one of the keywords or that are filtered to category_id
+CREATE TABLE IF NOT EXISTS sponsored_placement (
+    id BIGSERIAL PRIMARY KEY,
+    seller_id BIGINT NOT NULL,
+    product_id BIGINT NOT NULL REFERENCES product(id) ON DELETE CASCADE,
+    position INT NOT NULL CHECK (position BETWEEN 1 AND 50),
+    keywords TEXT[] NOT NULL DEFAULT '{}',
+    category_id BIGINT REFERENCES category(id) ON DELETE CASCADE,
+    -- Impressions per shopper per day; 0 is uncapped
+    frequency_cap INT NOT NULL DEFAULT 0 CHECK (frequency_cap >= 0),
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    starts_at TIMESTAMPTZ,
+    ends_at TIMESTAMPTZ,
+    impression_count BIGINT NOT NULL DEFAULT 0,
+    click_count BIGINT NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CHECK (cardinality(keywords) > 0 OR category_id IS NOT NULL),
+    CHECK (ends_at IS NULL OR starts_at IS NULL OR ends_at > starts_at)
+);
+
+CREATE INDEX IF NOT EXISTS idx_sponsored_placement_seller_id ON sponsored_placement(seller_id);
+CREATE INDEX IF NOT EXISTS idx_sponsored_placement_active
+    ON sponsored_placement(seller_id) WHERE is_active;
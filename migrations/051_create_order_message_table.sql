@@ -0,0 +1,20 @@
+-- Migration: 051_create_order_message_table.sql
+-- Description: Customer and seller message thread per order
+
+CREATE TABLE IF NOT EXISTS order_message (
+    id BIGSERIAL PRIMARY KEY,
+    order_id BIGINT NOT NULL REFERENCES "order"(id) ON DELETE CASCADE,
+    sender_user_id BIGINT NOT NULL,
+    sender_role VARCHAR(32) NOT NULL,
+    -- Side of the thread that sent the message; admins post on the seller side
+    from_customer BOOLEAN NOT NULL,
+    body TEXT NOT NULL DEFAULT '',
+    attachment_file_ids TEXT[] NOT NULL DEFAULT '{}',
+    read_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CHECK (body <> '' OR cardinality(attachment_file_ids) > 0)
+);
+
+CREATE INDEX IF NOT EXISTS idx_order_message_order_id ON order_message(order_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_order_message_unread
+    ON order_message(order_id, from_customer) WHERE read_at IS NULL;
@@ -0,0 +1,14 @@
+-- Migration: 052_add_inventory_costing.sql
+-- Description: Unit cost of restock lots and the seller's inventory costing method
+
+-- Cost paid per unit on PURCHASE transactions; each costed purchase is a restock lot
+ALTER TABLE inventory_transaction
+    ADD COLUMN IF NOT EXISTS unit_cost_cents BIGINT CHECK (unit_cost_cents >= 0);
+
+CREATE INDEX IF NOT EXISTS idx_inventory_transaction_inventory_created
+    ON inventory_transaction(inventory_id, created_at);
+
+-- How the valuation report costs stock: FIFO lots or moving average
+ALTER TABLE seller_settings
+    ADD COLUMN IF NOT EXISTS inventory_costing_method VARCHAR(16) NOT NULL DEFAULT 'FIFO'
+    CHECK (inventory_costing_method IN ('FIFO', 'AVERAGE'));
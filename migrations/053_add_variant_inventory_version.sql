@@ -0,0 +1,9 @@
+-- Migration: 053_add_variant_inventory_version.sql
+-- Description: Optimistic lock versions on variants and inventory rows
+
+-- Bumped by every update; writers only update the version they read
+ALTER TABLE product_variant
+    ADD COLUMN IF NOT EXISTS version BIGINT NOT NULL DEFAULT 1;
+
+ALTER TABLE inventory
+    ADD COLUMN IF NOT EXISTS version BIGINT NOT NULL DEFAULT 1;
@@ -0,0 +1,28 @@
+-- Migration: 054_create_seller_sending_domain_table.sql
+-- Description: Per-seller email sending domains with SPF/DKIM verification state
+
+CREATE TABLE IF NOT EXISTS seller_sending_domain (
+    id BIGSERIAL PRIMARY KEY,
+    seller_id BIGINT NOT NULL REFERENCES "user"(id) ON DELETE CASCADE,
+    domain VARCHAR(253) NOT NULL,
+    from_address VARCHAR(320) NOT NULL,
+    from_name VARCHAR(100) NOT NULL DEFAULT '',
+    -- Set by an admin once the domain is registered with the email provider
+    dkim_selector VARCHAR(63) NOT NULL DEFAULT '',
+    dkim_record_value TEXT NOT NULL DEFAULT '',
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    spf_verified BOOLEAN NOT NULL DEFAULT FALSE,
+    dkim_verified BOOLEAN NOT NULL DEFAULT FALSE,
+    last_check_error TEXT NOT NULL DEFAULT '',
+    last_checked_at TIMESTAMPTZ,
+    verified_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    -- One sending domain per seller
+    CONSTRAINT uq_seller_sending_domain_seller UNIQUE (seller_id),
+    CONSTRAINT chk_seller_sending_domain_status CHECK (
+        status IN ('pending', 'verified', 'failed')
+    )
+);
+
+CREATE INDEX IF NOT EXISTS idx_seller_sending_domain_status ON seller_sending_domain(status);
@@ -0,0 +1,39 @@
+-- Migration: 055_add_inventory_movement_reason_code.sql
+-- Description: Reason codes on inventory transactions, which become an append-only
+-- movement history
+
+ALTER TABLE inventory_transaction
+    ADD COLUMN IF NOT EXISTS reason_code VARCHAR(30);
+
+-- Existing rows get the default reason of their transaction type
+UPDATE inventory_transaction SET reason_code = CASE type
+    WHEN 'PURCHASE' THEN 'RESTOCK'
+    WHEN 'RETURN' THEN 'CUSTOMER_RETURN'
+    WHEN 'TRANSFER_IN' THEN 'TRANSFER'
+    WHEN 'TRANSFER_OUT' THEN 'TRANSFER'
+    WHEN 'OUTBOUND' THEN 'SALE'
+    WHEN 'RESERVED' THEN 'RESERVATION'
+    WHEN 'RELEASED' THEN 'RESERVATION_RELEASE'
+    WHEN 'DAMAGE' THEN 'DAMAGE'
+    WHEN 'REFRESH' THEN 'CYCLE_COUNT'
+    ELSE 'MANUAL_CORRECTION'
+END
+WHERE reason_code IS NULL;
+
+ALTER TABLE inventory_transaction ALTER COLUMN reason_code SET NOT NULL;
+
+CREATE INDEX IF NOT EXISTS idx_inventory_transaction_reason_code
+    ON inventory_transaction(reason_code);
+
+-- Movements are never edited or removed; corrections are recorded as new movements
+CREATE OR REPLACE FUNCTION prevent_inventory_transaction_change()
+RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'inventory_transaction rows are immutable (id %)', OLD.id;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS trg_inventory_transaction_immutable ON inventory_transaction;
+CREATE TRIGGER trg_inventory_transaction_immutable
+    BEFORE UPDATE OR DELETE ON inventory_transaction
+    FOR EACH ROW EXECUTE FUNCTION prevent_inventory_transaction_change();
@@ -0,0 +1,37 @@
+-- Migration: 056_create_stock_take_tables.sql
+-- Description: Stock-take (cycle count) sessions. A seller opens a session for one location,
+-- submits counted quantities per variant and applies the discrepancies as inventory movements
+-- with the STOCK_TAKE reason. system_quantity is the on-hand quantity when the variant was
+-- counted, so movements recorded between counting and applying are kept.
+
+CREATE TABLE IF NOT EXISTS stock_take (
+    id BIGSERIAL PRIMARY KEY,
+    seller_id BIGINT NOT NULL,
+    location_id BIGINT NOT NULL REFERENCES location(id) ON DELETE CASCADE,
+    status VARCHAR(20) NOT NULL DEFAULT 'OPEN',
+    note VARCHAR(500),
+    started_by BIGINT NOT NULL,
+    applied_by BIGINT,
+    applied_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_stock_take_seller_status ON stock_take(seller_id, status);
+-- A location is counted by one open session at a time
+CREATE UNIQUE INDEX IF NOT EXISTS uq_stock_take_open_location
+    ON stock_take(location_id) WHERE status = 'OPEN';
+
+CREATE TABLE IF NOT EXISTS stock_take_line (
+    id BIGSERIAL PRIMARY KEY,
+    stock_take_id BIGINT NOT NULL REFERENCES stock_take(id) ON DELETE CASCADE,
+    variant_id BIGINT NOT NULL REFERENCES product_variant(id) ON DELETE CASCADE,
+    system_quantity INTEGER NOT NULL,
+    counted_quantity INTEGER NOT NULL CHECK (counted_quantity >= 0),
+    counted_by BIGINT NOT NULL,
+    counted_at TIMESTAMPTZ NOT NULL,
+    transaction_id BIGINT REFERENCES inventory_transaction(id),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT uq_stock_take_line_variant UNIQUE (stock_take_id, variant_id)
+);
@@ -0,0 +1,52 @@
+-- Migration: 057_create_supplier_and_purchase_order_tables.sql
+-- Description: Suppliers and purchase orders for tracking incoming stock. A purchase order
+-- moves DRAFT -> SENT -> PARTIALLY_RECEIVED -> CLOSED. Receiving a line records a PURCHASE
+-- movement at the order's location with the unit cost paid, which opens a cost lot for the
+-- inventory valuation report.
+
+CREATE TABLE IF NOT EXISTS supplier (
+    id BIGSERIAL PRIMARY KEY,
+    seller_id BIGINT NOT NULL,
+    name VARCHAR(200) NOT NULL,
+    contact_name VARCHAR(200),
+    email VARCHAR(255),
+    phone VARCHAR(30),
+    notes VARCHAR(1000),
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_supplier_seller_name ON supplier(seller_id, LOWER(name));
+
+CREATE TABLE IF NOT EXISTS purchase_order (
+    id BIGSERIAL PRIMARY KEY,
+    seller_id BIGINT NOT NULL,
+    supplier_id BIGINT NOT NULL REFERENCES supplier(id),
+    location_id BIGINT NOT NULL REFERENCES location(id),
+    status VARCHAR(20) NOT NULL DEFAULT 'DRAFT',
+    supplier_reference VARCHAR(100),
+    expected_at TIMESTAMPTZ,
+    note VARCHAR(1000),
+    created_by BIGINT NOT NULL,
+    sent_at TIMESTAMPTZ,
+    closed_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_purchase_order_seller_status ON purchase_order(seller_id, status);
+CREATE INDEX IF NOT EXISTS idx_purchase_order_supplier ON purchase_order(supplier_id);
+
+CREATE TABLE IF NOT EXISTS purchase_order_line (
+    id BIGSERIAL PRIMARY KEY,
+    purchase_order_id BIGINT NOT NULL REFERENCES purchase_order(id) ON DELETE CASCADE,
+    variant_id BIGINT NOT NULL REFERENCES product_variant(id),
+    quantity_ordered INTEGER NOT NULL CHECK (quantity_ordered > 0),
+    quantity_received INTEGER NOT NULL DEFAULT 0 CHECK (quantity_received >= 0),
+    unit_cost_cents BIGINT NOT NULL CHECK (unit_cost_cents >= 0),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT uq_purchase_order_line_variant UNIQUE (purchase_order_id, variant_id),
+    CONSTRAINT chk_purchase_order_line_received CHECK (quantity_received <= quantity_ordered)
+);
@@ -0,0 +1,7 @@
+-- Migration: 058_add_order_cancellation_window.sql
+-- Description: How long after placing an order a customer may still cancel it. Zero leaves
+-- cancellation open until the order is fulfilled. Sellers can cancel regardless.
+
+ALTER TABLE seller_settings
+    ADD COLUMN IF NOT EXISTS cancellation_window_minutes INTEGER NOT NULL DEFAULT 0
+    CHECK (cancellation_window_minutes >= 0);
@@ -0,0 +1,43 @@
+-- Migration: 059_add_order_fulfillment_quantities.sql
+-- Description: Track how much of each order line has shipped so an order can be fulfilled
+-- across several shipments, and the order-level fulfillment status derived from it.
+
+ALTER TABLE order_item
+    ADD COLUMN IF NOT EXISTS fulfilled_quantity INTEGER NOT NULL DEFAULT 0
+    CHECK (fulfilled_quantity >= 0 AND fulfilled_quantity <= quantity);
+
+ALTER TABLE "order"
+    ADD COLUMN IF NOT EXISTS fulfillment_status VARCHAR(20) NOT NULL DEFAULT 'UNFULFILLED'
+    CHECK (fulfillment_status IN ('UNFULFILLED', 'PARTIAL', 'FULFILLED'));
+
+-- Backfill from existing shipments. A shipment without items covered the whole order.
+UPDATE order_item oi
+SET fulfilled_quantity = LEAST(oi.quantity, shipped.quantity)
+FROM (
+    SELECT osi.order_item_id, SUM(osi.quantity) AS quantity
+    FROM order_shipment_item osi
+    GROUP BY osi.order_item_id
+) shipped
+WHERE shipped.order_item_id = oi.id;
+
+UPDATE order_item oi
+SET fulfilled_quantity = oi.quantity
+WHERE EXISTS (
+    SELECT 1
+    FROM order_shipment os
+    WHERE os.order_id = oi.order_id
+      AND NOT EXISTS (SELECT 1 FROM order_shipment_item osi WHERE osi.shipment_id = os.id)
+);
+
+UPDATE "order" o
+SET fulfillment_status = CASE
+        WHEN totals.fulfilled >= totals.ordered THEN 'FULFILLED'
+        ELSE 'PARTIAL'
+    END
+FROM (
+    SELECT order_id, SUM(quantity) AS ordered, SUM(fulfilled_quantity) AS fulfilled
+    FROM order_item
+    GROUP BY order_id
+) totals
+WHERE totals.order_id = o.id
+  AND totals.fulfilled > 0;
@@ -0,0 +1,28 @@
+-- Migration: 060_add_guest_checkout.sql
+-- Description: Guest checkout. A guest order belongs to a guest user that cannot sign in,
+-- so guest emails may repeat and may match a registered account; only registered
+-- accounts keep email unique. Claims attach guest orders to the registered account with
+-- the same email once the account holder confirms it through an emailed link.
+
+ALTER TABLE "user" ADD COLUMN IF NOT EXISTS is_guest BOOLEAN NOT NULL DEFAULT FALSE;
+
+ALTER TABLE "user" DROP CONSTRAINT IF EXISTS user_email_key;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_user_email_registered ON "user"(email) WHERE is_guest = FALSE;
+CREATE INDEX IF NOT EXISTS idx_user_guest_email ON "user"(seller_id, LOWER(email)) WHERE is_guest = TRUE;
+
+CREATE TABLE IF NOT EXISTS guest_order_claim (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL REFERENCES "user"(id) ON DELETE CASCADE,
+    email VARCHAR(255) NOT NULL,
+    -- Only the SHA-256 hex digest is stored; the raw token is emailed once
+    token_hash CHAR(64) NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL,
+    claimed_at TIMESTAMPTZ,
+    claimed_orders INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT uq_guest_order_claim_token_hash UNIQUE (token_hash)
+);
+
+CREATE INDEX IF NOT EXISTS idx_guest_order_claim_user_id
+    ON guest_order_claim(user_id, created_at DESC);
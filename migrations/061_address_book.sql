@@ -0,0 +1,32 @@
+-- Migration: 061_address_book.sql
+-- Description: Address book. Addresses get a separate default for billing next to the
+-- existing default (used for shipping); each user has at most one of each. Order
+-- address snapshots record the address book entry they were copied from, so later
+-- edits to that entry never change a placed order.
+
+ALTER TABLE "address" ADD COLUMN IF NOT EXISTS is_default_billing BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- The single default so far served both purposes
+UPDATE "address" SET is_default_billing = TRUE WHERE is_default = TRUE;
+
+-- Keep only the newest default of each kind before enforcing one per user
+UPDATE "address" a SET is_default = FALSE
+WHERE a.is_default = TRUE
+  AND EXISTS (
+      SELECT 1 FROM "address" b
+      WHERE b.user_id = a.user_id AND b.is_default = TRUE AND b.id > a.id
+  );
+UPDATE "address" a SET is_default_billing = FALSE
+WHERE a.is_default_billing = TRUE
+  AND EXISTS (
+      SELECT 1 FROM "address" b
+      WHERE b.user_id = a.user_id AND b.is_default_billing = TRUE AND b.id > a.id
+  );
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_address_default_shipping
+    ON "address"(user_id) WHERE is_default = TRUE;
+CREATE UNIQUE INDEX IF NOT EXISTS uq_address_default_billing
+    ON "address"(user_id) WHERE is_default_billing = TRUE;
+
+-- No foreign key: deleting an address book entry must not touch order history
+ALTER TABLE order_address ADD COLUMN IF NOT EXISTS source_address_id BIGINT;
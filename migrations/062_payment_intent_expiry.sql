@@ -0,0 +1,13 @@
+-- Migration: 062_payment_intent_expiry.sql
+-- Description: Payment intents started for an order. A pending payment records the order
+-- it collects for and when it lapses; a scheduler job expires lapsed payments and cancels
+-- their orders, and customers may start a fresh payment for an unpaid order.
+
+ALTER TABLE payment_transaction ADD COLUMN IF NOT EXISTS order_id BIGINT;
+ALTER TABLE payment_transaction ADD COLUMN IF NOT EXISTS expires_at TIMESTAMPTZ;
+
+CREATE INDEX IF NOT EXISTS idx_payment_transaction_order_id
+    ON payment_transaction(order_id);
+CREATE INDEX IF NOT EXISTS idx_payment_transaction_pending_expires_at
+    ON payment_transaction(expires_at)
+    WHERE status = 'pending' AND order_id IS NOT NULL;
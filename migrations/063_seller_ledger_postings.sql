@@ -0,0 +1,51 @@
+-- Migration: 063_seller_ledger_postings.sql
+-- Description: Double-entry postings on the seller ledger. Every entry names the account it
+-- debits and the account it credits, gateway fees get their own entry type, and payouts to
+-- sellers are recorded in seller_payout so accounting can reconcile them with bank transfers.
+
+CREATE TABLE IF NOT EXISTS seller_payout (
+    id BIGSERIAL PRIMARY KEY,
+    payout_id VARCHAR(50) NOT NULL UNIQUE,
+    seller_id BIGINT NOT NULL REFERENCES "user"(id),
+    currency VARCHAR(3) NOT NULL,
+    amount_cents BIGINT NOT NULL CHECK (amount_cents > 0),
+    reference VARCHAR(255),  -- bank transfer reference
+    notes TEXT,
+    paid_at TIMESTAMPTZ NOT NULL,
+    recorded_by BIGINT REFERENCES "user"(id),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_seller_payout_seller_paid_at
+    ON seller_payout(seller_id, paid_at DESC);
+
+ALTER TABLE seller_ledger_entry ADD COLUMN IF NOT EXISTS debit_account VARCHAR(30);
+ALTER TABLE seller_ledger_entry ADD COLUMN IF NOT EXISTS credit_account VARCHAR(30);
+ALTER TABLE seller_ledger_entry ADD COLUMN IF NOT EXISTS reference VARCHAR(255);
+ALTER TABLE seller_ledger_entry
+    ADD COLUMN IF NOT EXISTS payout_id BIGINT REFERENCES seller_payout(id);
+
+-- Existing entries: sales credit the seller, everything else debits them
+UPDATE seller_ledger_entry SET
+    debit_account = CASE entry_type
+        WHEN 'sale' THEN 'gateway_clearing'
+        ELSE 'seller_payable'
+    END,
+    credit_account = CASE entry_type
+        WHEN 'sale' THEN 'seller_payable'
+        WHEN 'commission' THEN 'platform_revenue'
+        WHEN 'payout' THEN 'seller_bank'
+        WHEN 'adjustment' THEN 'platform_revenue'
+        ELSE 'gateway_clearing'
+    END
+WHERE debit_account IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_seller_ledger_entry_seller_currency_occurred
+    ON seller_ledger_entry(seller_id, currency, occurred_at);
+CREATE INDEX IF NOT EXISTS idx_seller_ledger_entry_payout_id
+    ON seller_ledger_entry(payout_id);
+
+COMMENT ON TABLE seller_payout IS 'Money paid out to sellers, mirrored by payout entries on the seller ledger';
+COMMENT ON COLUMN seller_ledger_entry.debit_account IS 'Account the entry debits; seller_payable is what the platform owes the seller';
+COMMENT ON COLUMN seller_ledger_entry.credit_account IS 'Account the entry credits';
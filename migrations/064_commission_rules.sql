@@ -0,0 +1,29 @@
+-- Migration: 064_commission_rules.sql
+-- Description: Platform commission rules. A global default, per-seller and per-category
+-- overrides (and per-seller category overrides) charge either a percentage of the line or
+-- a flat amount per unit. The rule in force when an order is placed is recorded on each
+-- order line so payouts and revenue reports read the same commission.
+
+CREATE TABLE IF NOT EXISTS commission_rule (
+    id BIGSERIAL PRIMARY KEY,
+    seller_id BIGINT REFERENCES "user"(id),    -- NULL applies to every seller
+    category_id BIGINT REFERENCES category(id), -- NULL applies to every category
+    rate_type VARCHAR(20) NOT NULL,  -- 'percentage', 'flat'
+    rate_basis_points INT NOT NULL DEFAULT 0 CHECK (rate_basis_points BETWEEN 0 AND 10000),
+    flat_cents BIGINT NOT NULL DEFAULT 0 CHECK (flat_cents >= 0),
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- One rule per scope; the global default has neither seller nor category
+CREATE UNIQUE INDEX IF NOT EXISTS ux_commission_rule_scope
+    ON commission_rule (COALESCE(seller_id, 0), COALESCE(category_id, 0));
+
+ALTER TABLE order_item ADD COLUMN IF NOT EXISTS commission_cents BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE order_item
+    ADD COLUMN IF NOT EXISTS commission_rule_id BIGINT REFERENCES commission_rule(id)
+    ON DELETE SET NULL;
+
+COMMENT ON TABLE commission_rule IS 'Platform commission: global default with seller and category overrides';
+COMMENT ON COLUMN order_item.commission_cents IS 'Platform commission on the line, fixed when the order was placed';
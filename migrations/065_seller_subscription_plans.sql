@@ -0,0 +1,31 @@
+-- Migration: 065_seller_subscription_plans.sql
+-- Description: Plan limits and feature flags enforced on sellers, past-due subscriptions with
+-- a grace period, and the payment events that renew them. Existing plans stay unlimited and
+-- keep the related products API they already served.
+
+ALTER TABLE plan ADD COLUMN IF NOT EXISTS max_products INT CHECK (max_products >= 0); -- NULL = unlimited
+ALTER TABLE plan ADD COLUMN IF NOT EXISTS max_variants INT CHECK (max_variants >= 0); -- NULL = unlimited
+ALTER TABLE plan ADD COLUMN IF NOT EXISTS features JSONB NOT NULL DEFAULT '{}'::jsonb;
+
+UPDATE plan SET features = '{"related_products_api": true}'::jsonb WHERE features = '{}'::jsonb;
+
+-- A past-due subscription keeps working until grace_ends_at; end_date stays the date it is
+-- paid up to, so a late renewal does not add the grace days to the next period
+ALTER TABLE subscription ADD COLUMN IF NOT EXISTS past_due_since TIMESTAMPTZ;
+ALTER TABLE subscription ADD COLUMN IF NOT EXISTS grace_ends_at TIMESTAMPTZ;
+
+-- Renewal payment notifications, recorded once so a redelivered webhook is not applied twice
+CREATE TABLE IF NOT EXISTS subscription_payment_event (
+    id BIGSERIAL PRIMARY KEY,
+    event_id VARCHAR(255) NOT NULL,
+    subscription_id BIGINT NOT NULL REFERENCES subscription(id) ON DELETE CASCADE,
+    event_type VARCHAR(50) NOT NULL,  -- 'payment.succeeded', 'payment.failed'
+    transaction_id VARCHAR(255),
+    occurred_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS ux_subscription_payment_event_event_id
+    ON subscription_payment_event(event_id);
+CREATE INDEX IF NOT EXISTS idx_subscription_payment_event_subscription_id
+    ON subscription_payment_event(subscription_id);
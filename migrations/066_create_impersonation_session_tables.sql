@@ -0,0 +1,37 @@
+-- Migration: 066_create_impersonation_session_tables.sql
+-- Description: Admin "act as seller" impersonation sessions with an audit trail of every request
+
+CREATE TABLE IF NOT EXISTS impersonation_session (
+    id BIGSERIAL PRIMARY KEY,
+    admin_user_id BIGINT NOT NULL REFERENCES "user"(id),
+    seller_id BIGINT NOT NULL REFERENCES "user"(id) ON DELETE CASCADE,
+    reason VARCHAR(255) NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL,
+    ended_at TIMESTAMPTZ,
+    ended_by_user_id BIGINT REFERENCES "user"(id),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_impersonation_session_admin_user_id
+    ON impersonation_session(admin_user_id, created_at DESC);
+
+CREATE INDEX IF NOT EXISTS idx_impersonation_session_seller_id
+    ON impersonation_session(seller_id, created_at DESC);
+
+CREATE TABLE IF NOT EXISTS impersonation_audit (
+    id BIGSERIAL PRIMARY KEY,
+    session_id BIGINT NOT NULL REFERENCES impersonation_session(id) ON DELETE CASCADE,
+    admin_user_id BIGINT NOT NULL REFERENCES "user"(id),
+    seller_id BIGINT NOT NULL REFERENCES "user"(id) ON DELETE CASCADE,
+    -- started | request | denied | ended
+    action VARCHAR(20) NOT NULL,
+    actor_user_id BIGINT REFERENCES "user"(id),
+    method VARCHAR(10),
+    path VARCHAR(255),
+    ip_address VARCHAR(64),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_impersonation_audit_session_id
+    ON impersonation_audit(session_id, created_at DESC);
@@ -0,0 +1,22 @@
+-- Migration: 067_create_storefront_settings_table.sql
+-- Description: Per-seller storefront configuration (branding, theme colors, contact info,
+-- social links, display currency and timezone) served by the public storefront API
+
+CREATE TABLE IF NOT EXISTS storefront_settings (
+    id BIGSERIAL PRIMARY KEY,
+    seller_id BIGINT NOT NULL REFERENCES "user"(id) ON DELETE CASCADE,
+    store_name VARCHAR(100) NOT NULL,
+    logo_url VARCHAR(500),
+    primary_color VARCHAR(7),
+    secondary_color VARCHAR(7),
+    accent_color VARCHAR(7),
+    contact_email VARCHAR(255),
+    contact_phone VARCHAR(20),
+    -- Network name -> profile URL, e.g. {"instagram": "https://instagram.com/acme"}
+    social_links JSONB NOT NULL DEFAULT '{}',
+    currency_code VARCHAR(3),
+    timezone VARCHAR(64) NOT NULL DEFAULT 'UTC',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT uq_storefront_settings_seller_id UNIQUE (seller_id)
+);
@@ -0,0 +1,15 @@
+-- Migration: 068_create_seller_domain_table.sql
+-- Description: Maps custom storefront domains to sellers so public APIs can resolve
+-- the seller from the request Host instead of the X-Seller-ID header
+
+CREATE TABLE IF NOT EXISTS seller_domain (
+    id BIGSERIAL PRIMARY KEY,
+    seller_id BIGINT NOT NULL REFERENCES "user"(id) ON DELETE CASCADE,
+    -- Stored lowercased, without port or trailing dot, e.g. "shop.acme.com"
+    domain VARCHAR(253) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT uq_seller_domain_domain UNIQUE (domain)
+);
+
+CREATE INDEX IF NOT EXISTS idx_seller_domain_seller_id ON seller_domain(seller_id);
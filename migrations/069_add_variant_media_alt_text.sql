@@ -0,0 +1,5 @@
+-- Migration: 069_add_variant_media_alt_text.sql
+-- Description: Alt text on variant media for SEO and accessibility
+
+ALTER TABLE variant_media
+    ADD COLUMN IF NOT EXISTS alt_text VARCHAR(255);
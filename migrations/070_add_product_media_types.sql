@@ -0,0 +1,21 @@
+-- Migration: 070_add_product_media_types.sql
+-- Description: Video and 3D/AR assets in product media. External videos have no
+-- file-module asset; their file_id is a generated "ext_" identifier.
+
+ALTER TABLE product_media
+    ADD COLUMN IF NOT EXISTS media_type        VARCHAR(16)  NOT NULL DEFAULT 'IMAGE',
+    ADD COLUMN IF NOT EXISTS external_url      VARCHAR(2048),
+    ADD COLUMN IF NOT EXISTS thumbnail_file_id VARCHAR(80),
+    ADD COLUMN IF NOT EXISTS duration_seconds  INT;
+
+ALTER TABLE product_media
+    DROP CONSTRAINT IF EXISTS chk_product_media_type;
+ALTER TABLE product_media
+    ADD CONSTRAINT chk_product_media_type
+        CHECK (media_type IN ('IMAGE', 'VIDEO', 'MODEL_3D'));
+
+ALTER TABLE product_media
+    DROP CONSTRAINT IF EXISTS chk_product_media_duration;
+ALTER TABLE product_media
+    ADD CONSTRAINT chk_product_media_duration
+        CHECK (duration_seconds IS NULL OR duration_seconds > 0);
@@ -0,0 +1,71 @@
+-- Migration: 071_add_seo_slugs.sql
+-- Description: URL slugs and SEO metadata for products and categories, plus
+-- redirect records for slugs that changed (served as 301 by the by-slug lookups)
+
+ALTER TABLE product
+    ADD COLUMN IF NOT EXISTS slug             VARCHAR(200),
+    ADD COLUMN IF NOT EXISTS meta_title       VARCHAR(255),
+    ADD COLUMN IF NOT EXISTS meta_description VARCHAR(500),
+    ADD COLUMN IF NOT EXISTS canonical_url    VARCHAR(2048);
+
+ALTER TABLE category
+    ADD COLUMN IF NOT EXISTS slug             VARCHAR(200),
+    ADD COLUMN IF NOT EXISTS meta_title       VARCHAR(255),
+    ADD COLUMN IF NOT EXISTS meta_description VARCHAR(500),
+    ADD COLUMN IF NOT EXISTS canonical_url    VARCHAR(2048);
+
+-- Rows inserted without a slug (seeds, raw SQL) get their slugified name, suffixed
+-- with the ID so they never collide. The application always sets a slug.
+CREATE OR REPLACE FUNCTION set_default_slug()
+RETURNS TRIGGER AS $$
+BEGIN
+    IF NEW.slug IS NULL OR NEW.slug = '' THEN
+        NEW.slug := TRIM(BOTH '-' FROM REGEXP_REPLACE(LOWER(NEW.name), '[^a-z0-9]+', '-', 'g'))
+            || '-' || NEW.id;
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_trigger WHERE tgname = 'set_product_default_slug') THEN
+        CREATE TRIGGER set_product_default_slug BEFORE INSERT ON product
+        FOR EACH ROW EXECUTE FUNCTION set_default_slug();
+    END IF;
+
+    IF NOT EXISTS (SELECT 1 FROM pg_trigger WHERE tgname = 'set_category_default_slug') THEN
+        CREATE TRIGGER set_category_default_slug BEFORE INSERT ON category
+        FOR EACH ROW EXECUTE FUNCTION set_default_slug();
+    END IF;
+END $$;
+
+-- Backfill existing rows the same way
+UPDATE product
+SET slug = TRIM(BOTH '-' FROM REGEXP_REPLACE(LOWER(name), '[^a-z0-9]+', '-', 'g')) || '-' || id
+WHERE slug IS NULL OR slug = '';
+
+UPDATE category
+SET slug = TRIM(BOTH '-' FROM REGEXP_REPLACE(LOWER(name), '[^a-z0-9]+', '-', 'g')) || '-' || id
+WHERE slug IS NULL OR slug = '';
+
+-- Slugs are unique per seller; global categories (seller_id NULL) share one scope
+CREATE UNIQUE INDEX IF NOT EXISTS uq_product_seller_slug
+    ON product(seller_id, slug);
+CREATE UNIQUE INDEX IF NOT EXISTS uq_category_seller_slug
+    ON category(COALESCE(seller_id, 0), slug);
+
+CREATE TABLE IF NOT EXISTS slug_redirect (
+    id          BIGSERIAL    PRIMARY KEY,
+    entity_type VARCHAR(20)  NOT NULL CHECK (entity_type IN ('PRODUCT', 'CATEGORY')),
+    seller_id   BIGINT,
+    old_slug    VARCHAR(200) NOT NULL,
+    entity_id   BIGINT       NOT NULL,
+    created_at  TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    updated_at  TIMESTAMPTZ  NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_slug_redirect_old_slug
+    ON slug_redirect(entity_type, COALESCE(seller_id, 0), old_slug);
+CREATE INDEX IF NOT EXISTS idx_slug_redirect_entity
+    ON slug_redirect(entity_type, entity_id);
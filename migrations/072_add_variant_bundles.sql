@@ -0,0 +1,19 @@
+-- Migration: 072_add_variant_bundles.sql
+-- Description: Bundle (kit) variants composed of other variants. A bundle sells at its
+-- own price and holds no stock; availability and reservations go to its components.
+
+CREATE TABLE IF NOT EXISTS variant_bundle_component (
+    id BIGSERIAL PRIMARY KEY,
+    bundle_variant_id BIGINT NOT NULL REFERENCES product_variant(id) ON DELETE CASCADE,
+    -- Components in use cannot be deleted; the bundle has to be changed first
+    component_variant_id BIGINT NOT NULL REFERENCES product_variant(id),
+    quantity INT NOT NULL CHECK (quantity > 0),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT uq_variant_bundle_component UNIQUE (bundle_variant_id, component_variant_id),
+    CONSTRAINT chk_variant_bundle_component_not_self
+        CHECK (bundle_variant_id <> component_variant_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_variant_bundle_component_component
+    ON variant_bundle_component(component_variant_id);
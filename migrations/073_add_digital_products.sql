@@ -0,0 +1,65 @@
+-- Migration: 073_add_digital_products.sql
+-- Description: Digital (downloadable) products. Sellers attach files and license key
+-- pools to a digital product; paying for an order issues one entitlement per order
+-- item with signed download links, a download allowance and its license keys.
+
+ALTER TABLE product
+    ADD COLUMN IF NOT EXISTS product_type VARCHAR(20) NOT NULL DEFAULT 'physical',
+    -- Downloads allowed per purchase; NULL is unlimited
+    ADD COLUMN IF NOT EXISTS download_limit INT;
+
+ALTER TABLE product
+    ADD CONSTRAINT chk_product_type CHECK (product_type IN ('physical', 'digital')),
+    ADD CONSTRAINT chk_product_download_limit
+        CHECK (download_limit IS NULL OR download_limit > 0);
+
+CREATE TABLE IF NOT EXISTS digital_asset (
+    id BIGSERIAL PRIMARY KEY,
+    product_id BIGINT NOT NULL REFERENCES product(id) ON DELETE CASCADE,
+    -- file_object.file_id of a file uploaded through the file service
+    file_id VARCHAR(80) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    sort_order INT NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT uq_digital_asset_file UNIQUE (product_id, file_id)
+);
+
+CREATE TABLE IF NOT EXISTS digital_entitlement (
+    id BIGSERIAL PRIMARY KEY,
+    order_id BIGINT NOT NULL REFERENCES "order"(id) ON DELETE CASCADE,
+    order_item_id BIGINT NOT NULL REFERENCES order_item(id) ON DELETE CASCADE,
+    user_id BIGINT NOT NULL,
+    seller_id BIGINT NOT NULL,
+    product_id BIGINT NOT NULL,
+    variant_id BIGINT NOT NULL,
+    quantity INT NOT NULL CHECK (quantity > 0),
+    -- Snapshot of product.download_limit at delivery; NULL is unlimited
+    download_limit INT,
+    download_count INT NOT NULL DEFAULT 0 CHECK (download_count >= 0),
+    last_downloaded_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT uq_digital_entitlement_order_item UNIQUE (order_item_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_digital_entitlement_order
+    ON digital_entitlement(order_id);
+
+CREATE TABLE IF NOT EXISTS license_key (
+    id BIGSERIAL PRIMARY KEY,
+    product_id BIGINT NOT NULL REFERENCES product(id) ON DELETE CASCADE,
+    key_code VARCHAR(500) NOT NULL,
+    -- NULL while the key is in the pool. Issued keys are never returned to it.
+    entitlement_id BIGINT REFERENCES digital_entitlement(id) ON DELETE CASCADE,
+    assigned_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT uq_license_key_code UNIQUE (product_id, key_code)
+);
+
+CREATE INDEX IF NOT EXISTS idx_license_key_available
+    ON license_key(product_id, id) WHERE entitlement_id IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_license_key_entitlement
+    ON license_key(entitlement_id);
@@ -0,0 +1,16 @@
+-- Migration: 074_create_product_sku_settings.sql
+-- Description: Per-seller SKU patterns used to generate a product's base SKU and variant
+-- SKUs when they are omitted on create, e.g. {BRAND:3}-{CAT:2}-{SEQ:5}. next_sequence
+-- is the seller's running {SEQ} counter. Sellers without a row use the platform
+-- default patterns; the row is created on first save or first sequence use.
+
+CREATE TABLE IF NOT EXISTS product_sku_settings (
+    id BIGSERIAL PRIMARY KEY,
+    seller_id BIGINT NOT NULL,
+    product_pattern VARCHAR(100) NOT NULL,
+    variant_pattern VARCHAR(100) NOT NULL,
+    next_sequence BIGINT NOT NULL DEFAULT 1 CHECK (next_sequence > 0),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT uq_product_sku_settings_seller UNIQUE (seller_id)
+);
@@ -0,0 +1,11 @@
+-- Migration: 075_add_variant_barcodes.sql
+-- Description: EAN/UPC/GTIN barcodes on variants for POS-style scanning. The barcode
+-- keeps the digits as entered; gtin zero-pads it to GTIN-14 so a UPC-A and the EAN-13
+-- of the same item match. Barcodes are unique per seller, enforced by the service.
+
+ALTER TABLE product_variant
+    ADD COLUMN IF NOT EXISTS barcode VARCHAR(14),
+    ADD COLUMN IF NOT EXISTS gtin CHAR(14) GENERATED ALWAYS AS (LPAD(barcode, 14, '0')) STORED;
+
+CREATE INDEX IF NOT EXISTS idx_product_variant_gtin
+    ON product_variant(gtin) WHERE gtin IS NOT NULL;
@@ -0,0 +1,27 @@
+-- Migration: 077_create_product_recommendations.sql
+-- Description: Precomputed recommendations rebuilt nightly from order history.
+-- product_co_purchase holds, per product, the other products of the same seller its
+-- customers bought, ranked by how many customers bought both. user_product_recommendation
+-- holds each customer's "recommended for you" products per seller, scored from the
+-- co-purchases of what they bought and excluding what they already own.
+
+CREATE TABLE IF NOT EXISTS product_co_purchase (
+    product_id BIGINT NOT NULL REFERENCES product(id) ON DELETE CASCADE,
+    related_product_id BIGINT NOT NULL REFERENCES product(id) ON DELETE CASCADE,
+    seller_id BIGINT NOT NULL,
+    customer_count INT NOT NULL,
+    computed_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (product_id, related_product_id)
+);
+
+CREATE TABLE IF NOT EXISTS user_product_recommendation (
+    user_id BIGINT NOT NULL,
+    product_id BIGINT NOT NULL REFERENCES product(id) ON DELETE CASCADE,
+    seller_id BIGINT NOT NULL,
+    score INT NOT NULL,
+    computed_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (user_id, product_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_product_recommendation_user_seller
+    ON user_product_recommendation(user_id, seller_id, score DESC);
@@ -0,0 +1,52 @@
+-- Migration: 078_create_product_analytics_tables.sql
+-- Description: Storefront product and search events with daily rollups for the seller dashboard
+
+-- Raw events as reported by storefronts. Written on every page, so there are no
+-- foreign keys; the rollup drops events whose product is not the seller's.
+-- Pruned once rolled up.
+CREATE TABLE IF NOT EXISTS analytics_event (
+    id BIGSERIAL PRIMARY KEY,
+    seller_id BIGINT NOT NULL,
+    -- impression | view | add_to_cart | search | search_click
+    event_type VARCHAR(20) NOT NULL,
+    product_id BIGINT,
+    user_id BIGINT,
+    session_id VARCHAR(64),
+    -- Normalized (trimmed, lowercased) query of search and search_click events
+    search_query VARCHAR(200),
+    result_count INT,
+    -- 1-based position of the clicked result
+    position INT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_analytics_event_created_at ON analytics_event(created_at);
+
+-- Product engagement per UTC day, rewritten by the rollup
+CREATE TABLE IF NOT EXISTS product_analytics_daily (
+    product_id BIGINT NOT NULL REFERENCES product(id) ON DELETE CASCADE,
+    day DATE NOT NULL,
+    seller_id BIGINT NOT NULL REFERENCES "user"(id) ON DELETE CASCADE,
+    impressions INT NOT NULL DEFAULT 0,
+    views INT NOT NULL DEFAULT 0,
+    add_to_carts INT NOT NULL DEFAULT 0,
+    search_clicks INT NOT NULL DEFAULT 0,
+    PRIMARY KEY (product_id, day)
+);
+
+CREATE INDEX IF NOT EXISTS idx_product_analytics_daily_seller_day
+    ON product_analytics_daily(seller_id, day);
+
+-- Search queries per seller and UTC day, rewritten by the rollup. Sums are kept
+-- so averages stay exact across days.
+CREATE TABLE IF NOT EXISTS search_query_daily (
+    seller_id BIGINT NOT NULL REFERENCES "user"(id) ON DELETE CASCADE,
+    day DATE NOT NULL,
+    search_query VARCHAR(200) NOT NULL,
+    searches INT NOT NULL DEFAULT 0,
+    zero_result_searches INT NOT NULL DEFAULT 0,
+    result_count_sum BIGINT NOT NULL DEFAULT 0,
+    clicks INT NOT NULL DEFAULT 0,
+    click_position_sum BIGINT NOT NULL DEFAULT 0,
+    PRIMARY KEY (seller_id, day, search_query)
+);
@@ -0,0 +1,29 @@
+-- Migration: 079_create_seller_sales_rollups.sql
+-- Description: Daily seller and product sales rollups backing the seller sales dashboard
+
+-- Sales per seller and UTC day, rewritten by the rollup jobs. Orders count on the
+-- day they were placed; refunds on the day they completed.
+CREATE TABLE IF NOT EXISTS seller_sales_daily (
+    seller_id BIGINT NOT NULL REFERENCES "user"(id) ON DELETE CASCADE,
+    day DATE NOT NULL,
+    order_count INT NOT NULL DEFAULT 0,
+    revenue_cents BIGINT NOT NULL DEFAULT 0,
+    units_sold INT NOT NULL DEFAULT 0,
+    refund_count INT NOT NULL DEFAULT 0,
+    refunded_cents BIGINT NOT NULL DEFAULT 0,
+    PRIMARY KEY (seller_id, day)
+);
+
+-- Sales per product and UTC day, rewritten by the rollup jobs
+CREATE TABLE IF NOT EXISTS seller_product_sales_daily (
+    product_id BIGINT NOT NULL REFERENCES product(id) ON DELETE CASCADE,
+    day DATE NOT NULL,
+    seller_id BIGINT NOT NULL REFERENCES "user"(id) ON DELETE CASCADE,
+    order_count INT NOT NULL DEFAULT 0,
+    units_sold INT NOT NULL DEFAULT 0,
+    revenue_cents BIGINT NOT NULL DEFAULT 0,
+    PRIMARY KEY (product_id, day)
+);
+
+CREATE INDEX IF NOT EXISTS idx_seller_product_sales_daily_seller_day
+    ON seller_product_sales_daily(seller_id, day);
@@ -0,0 +1,18 @@
+-- Migration: 080_add_search_synonyms_and_stop_words.sql
+-- Description: Per-seller search query expansion. Stop words are dropped from search
+-- queries; a synonym group makes its terms interchangeable, so a search for one term
+-- also matches products mentioning another. Terms are stored lowercased.
+
+ALTER TABLE product_search_settings
+    ADD COLUMN IF NOT EXISTS stop_words TEXT[] NOT NULL DEFAULT '{}';
+
+CREATE TABLE IF NOT EXISTS search_synonym_group (
+    id BIGSERIAL PRIMARY KEY,
+    seller_id BIGINT NOT NULL REFERENCES "user"(id) ON DELETE CASCADE,
+    terms TEXT[] NOT NULL CHECK (cardinality(terms) >= 2),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_search_synonym_group_seller
+    ON search_synonym_group (seller_id);
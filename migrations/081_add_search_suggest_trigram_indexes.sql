@@ -0,0 +1,16 @@
+-- Migration: 081_add_search_suggest_trigram_indexes.sql
+-- Description: Trigram indexes behind search-as-you-type suggestions. They serve both
+-- the prefix matches (LIKE 'term%' and word prefixes) and the typo-tolerant word
+-- similarity matches on product names, brands and category names, and are kept
+-- current by Postgres on every product and category write.
+
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+CREATE INDEX IF NOT EXISTS idx_product_name_trgm
+    ON product USING GIN (lower(name) gin_trgm_ops);
+
+CREATE INDEX IF NOT EXISTS idx_product_brand_trgm
+    ON product USING GIN (lower(brand) gin_trgm_ops);
+
+CREATE INDEX IF NOT EXISTS idx_category_name_trgm
+    ON category USING GIN (lower(name) gin_trgm_ops);
@@ -0,0 +1,8 @@
+-- Migration: 082_add_search_settings_out_of_stock_display.sql
+-- Description: How a seller's storefront listing and search treat out-of-stock products
+-- when the request does not pass inStock: show them as usual, hide them, or demote them
+-- after the in-stock products.
+
+ALTER TABLE product_search_settings
+    ADD COLUMN IF NOT EXISTS out_of_stock_display VARCHAR(10) NOT NULL DEFAULT 'show'
+        CHECK (out_of_stock_display IN ('show', 'hide', 'demote'));
@@ -0,0 +1,12 @@
+-- Migration: 083_add_product_sort_indexes.sql
+-- Description: Indexes behind the discount product sort, which looks up a seller's
+-- active promotions and then probes each scope table by the product's own keys; the
+-- scope tables were only indexed by promotion.
+
+CREATE INDEX IF NOT EXISTS idx_promotion_active_seller
+    ON promotion(seller_id, promotion_type) WHERE status = 'active';
+CREATE INDEX IF NOT EXISTS idx_promotion_product_product_id ON promotion_product(product_id);
+CREATE INDEX IF NOT EXISTS idx_promotion_variant_variant_id ON promotion_product_variant(variant_id);
+CREATE INDEX IF NOT EXISTS idx_promotion_category_category_id ON promotion_category(category_id);
+CREATE INDEX IF NOT EXISTS idx_promotion_collection_collection_id
+    ON promotion_collection(collection_id);
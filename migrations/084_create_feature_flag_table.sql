@@ -0,0 +1,19 @@
+-- Migration: 084_create_feature_flag_table.sql
+-- Description: Feature flags for gradual rollouts. An enabled flag is on for its
+-- targeted sellers and users, and for rollout_percent of everyone else, bucketed by
+-- seller (or user) so a subject keeps the same result while the percentage holds.
+
+CREATE TABLE IF NOT EXISTS feature_flag (
+    id BIGSERIAL PRIMARY KEY,
+    flag_key VARCHAR(100) NOT NULL,
+    description VARCHAR(500) NOT NULL DEFAULT '',
+    enabled BOOLEAN NOT NULL DEFAULT FALSE,
+    rollout_percent SMALLINT NOT NULL DEFAULT 0
+        CHECK (rollout_percent BETWEEN 0 AND 100),
+    seller_ids BIGINT[] NOT NULL DEFAULT '{}',
+    user_ids BIGINT[] NOT NULL DEFAULT '{}',
+    updated_by_user_id BIGINT REFERENCES "user"(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT uq_feature_flag_key UNIQUE (flag_key)
+);
@@ -0,0 +1,8 @@
+-- Migration: 085_add_recommendation_computed_at_indexes.sql
+-- Description: The nightly recommendation rebuild upserts its rows and then deletes
+-- the ones an earlier rebuild left behind by computed_at.
+
+CREATE INDEX IF NOT EXISTS idx_product_co_purchase_computed_at
+    ON product_co_purchase(computed_at);
+CREATE INDEX IF NOT EXISTS idx_user_product_recommendation_computed_at
+    ON user_product_recommendation(computed_at);
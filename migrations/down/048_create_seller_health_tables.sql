@@ -0,0 +1,4 @@
+-- Rollback: 048_create_seller_health_tables.sql
+
+DROP TABLE IF EXISTS seller_health_snapshot;
+DROP TABLE IF EXISTS seller_health;
@@ -0,0 +1,3 @@
+-- Rollback: 049_create_product_option_rule_table.sql
+
+DROP TABLE IF EXISTS product_option_rule;
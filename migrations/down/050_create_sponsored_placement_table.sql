@@ -0,0 +1,3 @@
+-- Rollback: 050_create_sponsored_placement_table.sql
+
+DROP TABLE IF EXISTS sponsored_placement;
@@ -0,0 +1,3 @@
+-- Rollback: 051_create_order_message_table.sql
+
+DROP TABLE IF EXISTS order_message;
@@ -0,0 +1,5 @@
+-- Rollback: 052_add_inventory_costing.sql
+
+ALTER TABLE seller_settings DROP COLUMN IF EXISTS inventory_costing_method;
+DROP INDEX IF EXISTS idx_inventory_transaction_inventory_created;
+ALTER TABLE inventory_transaction DROP COLUMN IF EXISTS unit_cost_cents;
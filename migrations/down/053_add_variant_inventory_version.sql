@@ -0,0 +1,4 @@
+-- Rollback: 053_add_variant_inventory_version.sql
+
+ALTER TABLE inventory DROP COLUMN IF EXISTS version;
+ALTER TABLE product_variant DROP COLUMN IF EXISTS version;
@@ -0,0 +1,3 @@
+-- Rollback: 054_create_seller_sending_domain_table.sql
+
+DROP TABLE IF EXISTS seller_sending_domain;
@@ -0,0 +1,6 @@
+-- Rollback: 055_add_inventory_movement_reason_code.sql
+
+DROP TRIGGER IF EXISTS trg_inventory_transaction_immutable ON inventory_transaction;
+DROP FUNCTION IF EXISTS prevent_inventory_transaction_change();
+DROP INDEX IF EXISTS idx_inventory_transaction_reason_code;
+ALTER TABLE inventory_transaction DROP COLUMN IF EXISTS reason_code;
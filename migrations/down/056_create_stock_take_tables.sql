@@ -0,0 +1,4 @@
+-- Rollback: 056_create_stock_take_tables.sql
+
+DROP TABLE IF EXISTS stock_take_line;
+DROP TABLE IF EXISTS stock_take;
@@ -0,0 +1,5 @@
+-- Rollback: 057_create_supplier_and_purchase_order_tables.sql
+
+DROP TABLE IF EXISTS purchase_order_line;
+DROP TABLE IF EXISTS purchase_order;
+DROP TABLE IF EXISTS supplier;
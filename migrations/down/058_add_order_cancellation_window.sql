@@ -0,0 +1,3 @@
+-- Rollback: 058_add_order_cancellation_window.sql
+
+ALTER TABLE seller_settings DROP COLUMN IF EXISTS cancellation_window_minutes;
@@ -0,0 +1,4 @@
+-- Rollback: 059_add_order_fulfillment_quantities.sql
+
+ALTER TABLE "order" DROP COLUMN IF EXISTS fulfillment_status;
+ALTER TABLE order_item DROP COLUMN IF EXISTS fulfilled_quantity;
@@ -0,0 +1,10 @@
+-- Rollback: 060_add_guest_checkout.sql
+-- Restoring the unique email constraint fails while guest users share an email with
+-- another user; resolve those rows first.
+
+DROP TABLE IF EXISTS guest_order_claim;
+
+DROP INDEX IF EXISTS idx_user_guest_email;
+DROP INDEX IF EXISTS idx_user_email_registered;
+ALTER TABLE "user" ADD CONSTRAINT user_email_key UNIQUE (email);
+ALTER TABLE "user" DROP COLUMN IF EXISTS is_guest;
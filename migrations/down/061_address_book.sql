@@ -0,0 +1,8 @@
+-- Rollback: 061_address_book.sql
+
+ALTER TABLE order_address DROP COLUMN IF EXISTS source_address_id;
+
+DROP INDEX IF EXISTS uq_address_default_billing;
+DROP INDEX IF EXISTS uq_address_default_shipping;
+
+ALTER TABLE "address" DROP COLUMN IF EXISTS is_default_billing;
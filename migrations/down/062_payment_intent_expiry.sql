@@ -0,0 +1,8 @@
+-- Rollback: 062_payment_intent_expiry.sql
+-- Payments expired by the scheduler keep the 'expired' status after rollback.
+
+DROP INDEX IF EXISTS idx_payment_transaction_pending_expires_at;
+DROP INDEX IF EXISTS idx_payment_transaction_order_id;
+
+ALTER TABLE payment_transaction DROP COLUMN IF EXISTS expires_at;
+ALTER TABLE payment_transaction DROP COLUMN IF EXISTS order_id;
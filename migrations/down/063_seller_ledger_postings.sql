@@ -0,0 +1,13 @@
+-- Rollback: 063_seller_ledger_postings.sql
+-- Gateway fee entries are kept; without their postings they read as debits to the seller.
+
+DROP INDEX IF EXISTS idx_seller_ledger_entry_payout_id;
+DROP INDEX IF EXISTS idx_seller_ledger_entry_seller_currency_occurred;
+
+ALTER TABLE seller_ledger_entry DROP COLUMN IF EXISTS payout_id;
+ALTER TABLE seller_ledger_entry DROP COLUMN IF EXISTS reference;
+ALTER TABLE seller_ledger_entry DROP COLUMN IF EXISTS credit_account;
+ALTER TABLE seller_ledger_entry DROP COLUMN IF EXISTS debit_account;
+
+DROP INDEX IF EXISTS idx_seller_payout_seller_paid_at;
+DROP TABLE IF EXISTS seller_payout;
@@ -0,0 +1,7 @@
+-- Rollback: 064_commission_rules.sql
+
+ALTER TABLE order_item DROP COLUMN IF EXISTS commission_rule_id;
+ALTER TABLE order_item DROP COLUMN IF EXISTS commission_cents;
+
+DROP INDEX IF EXISTS ux_commission_rule_scope;
+DROP TABLE IF EXISTS commission_rule;
@@ -0,0 +1,12 @@
+-- Rollback: 065_seller_subscription_plans.sql
+
+DROP INDEX IF EXISTS idx_subscription_payment_event_subscription_id;
+DROP INDEX IF EXISTS ux_subscription_payment_event_event_id;
+DROP TABLE IF EXISTS subscription_payment_event;
+
+ALTER TABLE subscription DROP COLUMN IF EXISTS grace_ends_at;
+ALTER TABLE subscription DROP COLUMN IF EXISTS past_due_since;
+
+ALTER TABLE plan DROP COLUMN IF EXISTS features;
+ALTER TABLE plan DROP COLUMN IF EXISTS max_variants;
+ALTER TABLE plan DROP COLUMN IF EXISTS max_products;
@@ -0,0 +1,8 @@
+-- Rollback: 066_create_impersonation_session_tables.sql
+
+DROP INDEX IF EXISTS idx_impersonation_audit_session_id;
+DROP TABLE IF EXISTS impersonation_audit;
+
+DROP INDEX IF EXISTS idx_impersonation_session_seller_id;
+DROP INDEX IF EXISTS idx_impersonation_session_admin_user_id;
+DROP TABLE IF EXISTS impersonation_session;
@@ -0,0 +1,3 @@
+-- Rollback: 067_create_storefront_settings_table.sql
+
+DROP TABLE IF EXISTS storefront_settings;
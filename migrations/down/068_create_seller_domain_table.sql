@@ -0,0 +1,3 @@
+-- Rollback: 068_create_seller_domain_table.sql
+
+DROP TABLE IF EXISTS seller_domain;
@@ -0,0 +1,4 @@
+-- Rollback: 069_add_variant_media_alt_text.sql
+
+ALTER TABLE variant_media
+    DROP COLUMN IF EXISTS alt_text;
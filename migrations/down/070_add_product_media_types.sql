@@ -0,0 +1,11 @@
+-- Rollback: 070_add_product_media_types.sql
+
+DELETE FROM product_media WHERE media_type <> 'IMAGE';
+
+ALTER TABLE product_media
+    DROP CONSTRAINT IF EXISTS chk_product_media_duration,
+    DROP CONSTRAINT IF EXISTS chk_product_media_type,
+    DROP COLUMN IF EXISTS duration_seconds,
+    DROP COLUMN IF EXISTS thumbnail_file_id,
+    DROP COLUMN IF EXISTS external_url,
+    DROP COLUMN IF EXISTS media_type;
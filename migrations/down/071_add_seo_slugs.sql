@@ -0,0 +1,22 @@
+-- Rollback: 071_add_seo_slugs.sql
+
+DROP TABLE IF EXISTS slug_redirect;
+
+DROP TRIGGER IF EXISTS set_category_default_slug ON category;
+DROP TRIGGER IF EXISTS set_product_default_slug ON product;
+DROP FUNCTION IF EXISTS set_default_slug();
+
+DROP INDEX IF EXISTS uq_category_seller_slug;
+DROP INDEX IF EXISTS uq_product_seller_slug;
+
+ALTER TABLE category
+    DROP COLUMN IF EXISTS canonical_url,
+    DROP COLUMN IF EXISTS meta_description,
+    DROP COLUMN IF EXISTS meta_title,
+    DROP COLUMN IF EXISTS slug;
+
+ALTER TABLE product
+    DROP COLUMN IF EXISTS canonical_url,
+    DROP COLUMN IF EXISTS meta_description,
+    DROP COLUMN IF EXISTS meta_title,
+    DROP COLUMN IF EXISTS slug;
@@ -0,0 +1,3 @@
+-- Rollback: 072_add_variant_bundles.sql
+
+DROP TABLE IF EXISTS variant_bundle_component;
@@ -0,0 +1,11 @@
+-- Rollback: 073_add_digital_products.sql
+
+DROP TABLE IF EXISTS license_key;
+DROP TABLE IF EXISTS digital_entitlement;
+DROP TABLE IF EXISTS digital_asset;
+
+ALTER TABLE product
+    DROP CONSTRAINT IF EXISTS chk_product_download_limit,
+    DROP CONSTRAINT IF EXISTS chk_product_type,
+    DROP COLUMN IF EXISTS download_limit,
+    DROP COLUMN IF EXISTS product_type;
@@ -0,0 +1,3 @@
+-- Rollback: 074_create_product_sku_settings.sql
+
+DROP TABLE IF EXISTS product_sku_settings;
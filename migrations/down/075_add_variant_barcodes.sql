@@ -0,0 +1,7 @@
+-- Rollback: 075_add_variant_barcodes.sql
+
+DROP INDEX IF EXISTS idx_product_variant_gtin;
+
+ALTER TABLE product_variant
+    DROP COLUMN IF EXISTS gtin,
+    DROP COLUMN IF EXISTS barcode;
@@ -0,0 +1,4 @@
+-- Rollback: 077_create_product_recommendations.sql
+
+DROP TABLE IF EXISTS user_product_recommendation;
+DROP TABLE IF EXISTS product_co_purchase;
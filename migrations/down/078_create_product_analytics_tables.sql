@@ -0,0 +1,5 @@
+-- Rollback: 078_create_product_analytics_tables.sql
+
+DROP TABLE IF EXISTS search_query_daily;
+DROP TABLE IF EXISTS product_analytics_daily;
+DROP TABLE IF EXISTS analytics_event;
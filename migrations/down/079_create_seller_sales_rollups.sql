@@ -0,0 +1,4 @@
+-- Rollback: 079_create_seller_sales_rollups.sql
+
+DROP TABLE IF EXISTS seller_product_sales_daily;
+DROP TABLE IF EXISTS seller_sales_daily;
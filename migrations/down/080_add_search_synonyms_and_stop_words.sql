@@ -0,0 +1,5 @@
+-- Rollback: 080_add_search_synonyms_and_stop_words.sql
+
+DROP TABLE IF EXISTS search_synonym_group;
+
+ALTER TABLE product_search_settings DROP COLUMN IF EXISTS stop_words;
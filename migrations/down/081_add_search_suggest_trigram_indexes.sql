@@ -0,0 +1,6 @@
+-- Rollback: 081_add_search_suggest_trigram_indexes.sql
+-- The pg_trgm extension is left installed.
+
+DROP INDEX IF EXISTS idx_category_name_trgm;
+DROP INDEX IF EXISTS idx_product_brand_trgm;
+DROP INDEX IF EXISTS idx_product_name_trgm;
@@ -0,0 +1,3 @@
+-- Rollback: 082_add_search_settings_out_of_stock_display.sql
+
+ALTER TABLE product_search_settings DROP COLUMN IF EXISTS out_of_stock_display;
@@ -0,0 +1,7 @@
+-- Rollback: 083_add_product_sort_indexes.sql
+
+DROP INDEX IF EXISTS idx_promotion_collection_collection_id;
+DROP INDEX IF EXISTS idx_promotion_category_category_id;
+DROP INDEX IF EXISTS idx_promotion_variant_variant_id;
+DROP INDEX IF EXISTS idx_promotion_product_product_id;
+DROP INDEX IF EXISTS idx_promotion_active_seller;
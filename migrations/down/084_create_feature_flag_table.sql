@@ -0,0 +1,3 @@
+-- Rollback: 084_create_feature_flag_table.sql
+
+DROP TABLE IF EXISTS feature_flag;
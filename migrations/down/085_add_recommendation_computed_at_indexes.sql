@@ -0,0 +1,4 @@
+-- Rollback: 085_add_recommendation_computed_at_indexes.sql
+
+DROP INDEX IF EXISTS idx_user_product_recommendation_computed_at;
+DROP INDEX IF EXISTS idx_product_co_purchase_computed_at;
@@ -8,11 +8,12 @@
 -- ============================================================================
 
 -- Insert basic plans for subscription system
-INSERT INTO plan (id, name, description, price, currency, billing_cycle, is_popular, sort_order, trial_days, created_at, updated_at) VALUES
-(1, 'Free', 'Basic free plan with limited features', 0, 'USD', 'monthly', FALSE, 1, 0, NOW(), NOW()),
-(2, 'Starter', 'Starter plan for small businesses', 9.99, 'USD', 'monthly', FALSE, 2, 14, NOW(), NOW()),
-(3, 'Professional', 'Professional plan for growing businesses', 29.99, 'USD', 'monthly', TRUE, 3, 14, NOW(), NOW()),
-(4, 'Enterprise', 'Enterprise plan for large businesses', 99.99, 'USD', 'monthly', FALSE, 4, 30, NOW(), NOW())
+-- max_products/max_variants NULL = unlimited
+INSERT INTO plan (id, name, description, price, currency, billing_cycle, is_popular, sort_order, trial_days, max_products, max_variants, features, created_at, updated_at) VALUES
+(1, 'Free', 'Basic free plan with limited features', 0, 'USD', 'monthly', FALSE, 1, 0, 25, 100, '{"related_products_api": false}', NOW(), NOW()),
+(2, 'Starter', 'Starter plan for small businesses', 9.99, 'USD', 'monthly', FALSE, 2, 14, 500, 2500, '{"related_products_api": true}', NOW(), NOW()),
+(3, 'Professional', 'Professional plan for growing businesses', 29.99, 'USD', 'monthly', TRUE, 3, 14, NULL, NULL, '{"related_products_api": true}', NOW(), NOW()),
+(4, 'Enterprise', 'Enterprise plan for large businesses', 99.99, 'USD', 'monthly', FALSE, 4, 30, NULL, NULL, '{"related_products_api": true}', NOW(), NOW())
 ON CONFLICT (id) DO UPDATE SET
     name = EXCLUDED.name,
     description = EXCLUDED.description,
@@ -22,6 +23,9 @@ ON CONFLICT (id) DO UPDATE SET
     is_popular = EXCLUDED.is_popular,
     sort_order = EXCLUDED.sort_order,
     trial_days = EXCLUDED.trial_days,
+    max_products = EXCLUDED.max_products,
+    max_variants = EXCLUDED.max_variants,
+    features = EXCLUDED.features,
     updated_at = NOW();
 
 SELECT setval('plan_id_seq', (SELECT MAX(id) FROM plan));
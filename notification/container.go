@@ -2,6 +2,16 @@ package notification
 
 import (
 	"ecommerce-be/common"
+	"ecommerce-be/common/config"
+	"ecommerce-be/common/constants"
+	"ecommerce-be/common/health"
+	"ecommerce-be/common/notifier"
+	"ecommerce-be/common/scheduler"
+	"ecommerce-be/notification/factory/singleton"
+	"ecommerce-be/notification/gateway"
+	"ecommerce-be/notification/route"
+	"ecommerce-be/notification/service/channel"
+	"ecommerce-be/notification/utils/constant"
 
 	"github.com/gin-gonic/gin"
 )
@@ -14,6 +24,15 @@ func NewContainer(router *gin.Engine) *common.Container {
 	/* Register all modules (Categories, Products, Attributes, etc.) */
 	addModules(c)
 
+	/* Register schedulers */
+	registerScheduler()
+
+	/* Expose the dispatcher to modules that cannot import the notification factory */
+	notifier.SetDefault(gateway.NewNotifier(singleton.GetInstance().GetNotificationDispatchService()))
+
+	/* Register readiness checks */
+	registerHealthChecks()
+
 	/* Register routes for each module */
 	for _, module := range c.Modules {
 		module.RegisterRoutes(router)
@@ -23,6 +42,32 @@ func NewContainer(router *gin.Engine) *common.Container {
 }
 
 /* Register all modules (Categories, Products, Attributes, etc.) */
-// TODO: we have to implement notification service and this the start point for that
 func addModules(c *common.Container) {
+	c.RegisterModule(route.NewNotificationTemplateModule())
+	c.RegisterModule(route.NewNotificationSyncModule())
+	c.RegisterModule(route.NewNotificationCenterModule())
+	c.RegisterModule(route.NewNotificationPreferenceModule())
+	c.RegisterModule(route.NewSendingDomainModule())
+}
+
+func registerScheduler() {
+	f := singleton.GetInstance()
+	dispatchHandler := f.GetScheduleNotificationDispatchHandler()
+
+	scheduler.Register(
+		constant.NOTIFICATION_DISPATCH_COMMAND,
+		dispatchHandler.DispatchNotification,
+	)
+}
+
+// registerHealthChecks adds the email relay as an optional readiness dependency;
+// while it is down notifications fail but the API keeps serving.
+func registerHealthChecks() {
+	cfg := config.Get()
+	if cfg == nil {
+		return
+	}
+	if check := channel.EmailHealthCheck(cfg.Notification); check != nil {
+		health.Register(health.Check{Name: constants.HEALTH_CHECK_MAIL, Run: check})
+	}
 }
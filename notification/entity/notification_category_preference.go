@@ -0,0 +1,57 @@
+package entity
+
+import "ecommerce-be/common/db"
+
+// NotificationCategory groups event types a user can opt out of together.
+type NotificationCategory string
+
+const (
+	NOTIFICATION_CATEGORY_MARKETING     NotificationCategory = "marketing"
+	NOTIFICATION_CATEGORY_ORDER_UPDATES NotificationCategory = "order_updates"
+	NOTIFICATION_CATEGORY_STOCK_ALERTS  NotificationCategory = "stock_alerts"
+)
+
+// AllNotificationCategories returns all supported notification categories.
+func AllNotificationCategories() []NotificationCategory {
+	return []NotificationCategory{
+		NOTIFICATION_CATEGORY_MARKETING,
+		NOTIFICATION_CATEGORY_ORDER_UPDATES,
+		NOTIFICATION_CATEGORY_STOCK_ALERTS,
+	}
+}
+
+func (c NotificationCategory) IsValid() bool {
+	for _, category := range AllNotificationCategories() {
+		if c == category {
+			return true
+		}
+	}
+	return false
+}
+
+func (c NotificationCategory) String() string {
+	return string(c)
+}
+
+// Category returns the preference category an event type belongs to.
+func (e NotificationEventType) Category() NotificationCategory {
+	switch e {
+	case NOTIFICATION_EVENT_INVENTORY_LOW_STOCK:
+		return NOTIFICATION_CATEGORY_STOCK_ALERTS
+	default:
+		return NOTIFICATION_CATEGORY_ORDER_UPDATES
+	}
+}
+
+// NotificationCategoryPreference records a user's opt-in/opt-out for one category.
+// Categories without a row fall back to the built-in default.
+type NotificationCategoryPreference struct {
+	db.BaseEntity
+	UserID    uint                 `json:"userId"    gorm:"column:user_id;not null"`
+	Category  NotificationCategory `json:"category"  gorm:"column:category;size:50;not null"`
+	IsEnabled bool                 `json:"isEnabled" gorm:"column:is_enabled;not null;default:true"`
+}
+
+func (NotificationCategoryPreference) TableName() string {
+	return "notification_category_preference"
+}
@@ -0,0 +1,42 @@
+package entity
+
+import (
+	"time"
+
+	"ecommerce-be/common/db"
+)
+
+// NotificationChannelPreference records a user's opt-in/opt-out for one channel.
+// Channels without a row fall back to the built-in default.
+type NotificationChannelPreference struct {
+	db.BaseEntity
+	UserID    uint                `json:"userId"    gorm:"column:user_id;not null"`
+	Channel   NotificationChannel `json:"channel"   gorm:"column:channel;size:20;not null"`
+	IsEnabled bool                `json:"isEnabled" gorm:"column:is_enabled;not null;default:true"`
+}
+
+func (NotificationChannelPreference) TableName() string {
+	return "notification_channel_preference"
+}
+
+// PushPlatform identifies the client platform a push token was issued for.
+type PushPlatform string
+
+const (
+	PUSH_PLATFORM_ANDROID PushPlatform = "android"
+	PUSH_PLATFORM_IOS     PushPlatform = "ios"
+	PUSH_PLATFORM_WEB     PushPlatform = "web"
+)
+
+// UserPushToken is an FCM registration token for one of a user's devices.
+type UserPushToken struct {
+	db.BaseEntity
+	UserID     uint         `json:"userId"     gorm:"column:user_id;not null;index"`
+	Token      string       `json:"token"      gorm:"column:token;size:512;not null;uniqueIndex"`
+	Platform   PushPlatform `json:"platform"   gorm:"column:platform;size:20;not null"`
+	LastSeenAt time.Time    `json:"lastSeenAt" gorm:"column:last_seen_at;not null"`
+}
+
+func (UserPushToken) TableName() string {
+	return "user_push_token"
+}
@@ -0,0 +1,127 @@
+package entity
+
+import (
+	"ecommerce-be/common/constants"
+	"ecommerce-be/common/db"
+)
+
+// NotificationChannel is a delivery channel for notifications.
+type NotificationChannel string
+
+const (
+	NOTIFICATION_CHANNEL_EMAIL  NotificationChannel = "email"
+	NOTIFICATION_CHANNEL_SMS    NotificationChannel = "sms"
+	NOTIFICATION_CHANNEL_PUSH   NotificationChannel = "push"
+	NOTIFICATION_CHANNEL_IN_APP NotificationChannel = "in_app"
+)
+
+// AllNotificationChannels returns all supported notification channels.
+func AllNotificationChannels() []NotificationChannel {
+	return []NotificationChannel{
+		NOTIFICATION_CHANNEL_EMAIL,
+		NOTIFICATION_CHANNEL_SMS,
+		NOTIFICATION_CHANNEL_PUSH,
+		NOTIFICATION_CHANNEL_IN_APP,
+	}
+}
+
+func (c NotificationChannel) IsValid() bool {
+	for _, channel := range AllNotificationChannels() {
+		if c == channel {
+			return true
+		}
+	}
+	return false
+}
+
+func (c NotificationChannel) String() string {
+	return string(c)
+}
+
+// NotificationEventType identifies the business event a notification is sent for.
+type NotificationEventType string
+
+const (
+	NOTIFICATION_EVENT_ORDER_PLACED            NotificationEventType = constants.NOTIFY_EVENT_ORDER_PLACED
+	NOTIFICATION_EVENT_ORDER_CANCELLED         NotificationEventType = constants.NOTIFY_EVENT_ORDER_CANCELLED
+	NOTIFICATION_EVENT_DELIVERY_RESCHEDULED    NotificationEventType = constants.NOTIFY_EVENT_DELIVERY_RESCHEDULED
+	NOTIFICATION_EVENT_ORDER_BALANCE_DUE       NotificationEventType = constants.NOTIFY_EVENT_ORDER_BALANCE_DUE
+	NOTIFICATION_EVENT_ORDER_MESSAGE_RECEIVED  NotificationEventType = constants.NOTIFY_EVENT_ORDER_MESSAGE_RECEIVED
+	NOTIFICATION_EVENT_GUEST_ORDER_CLAIM       NotificationEventType = constants.NOTIFY_EVENT_GUEST_ORDER_CLAIM
+	NOTIFICATION_EVENT_ORDER_DOWNLOADS_READY   NotificationEventType = constants.NOTIFY_EVENT_ORDER_DOWNLOADS_READY
+	NOTIFICATION_EVENT_PAYMENT_RECEIVED        NotificationEventType = constants.NOTIFY_EVENT_PAYMENT_RECEIVED
+	NOTIFICATION_EVENT_PAYMENT_FAILED          NotificationEventType = constants.NOTIFY_EVENT_PAYMENT_FAILED
+	NOTIFICATION_EVENT_PAYMENT_DECLINE_SPIKE   NotificationEventType = constants.NOTIFY_EVENT_PAYMENT_DECLINE_SPIKE
+	NOTIFICATION_EVENT_SHIPMENT_STATUS_CHANGED NotificationEventType = constants.NOTIFY_EVENT_SHIPMENT_STATUS_CHANGED
+	NOTIFICATION_EVENT_SHIPMENT_DELIVERED      NotificationEventType = constants.NOTIFY_EVENT_SHIPMENT_DELIVERED
+	NOTIFICATION_EVENT_INVENTORY_LOW_STOCK     NotificationEventType = constants.NOTIFY_EVENT_INVENTORY_LOW_STOCK
+	NOTIFICATION_EVENT_EMAIL_CHANGE_CONFIRM    NotificationEventType = constants.NOTIFY_EVENT_EMAIL_CHANGE_CONFIRM
+	NOTIFICATION_EVENT_EMAIL_CHANGED           NotificationEventType = constants.NOTIFY_EVENT_EMAIL_CHANGED
+	NOTIFICATION_EVENT_SELLER_HEALTH_WARNING   NotificationEventType = constants.NOTIFY_EVENT_SELLER_HEALTH_WARNING
+	NOTIFICATION_EVENT_SELLER_LISTING_DEMOTED  NotificationEventType = constants.NOTIFY_EVENT_SELLER_LISTING_DEMOTED
+)
+
+// AllNotificationEventTypes returns all event types that have built-in templates.
+func AllNotificationEventTypes() []NotificationEventType {
+	return []NotificationEventType{
+		NOTIFICATION_EVENT_ORDER_PLACED,
+		NOTIFICATION_EVENT_ORDER_CANCELLED,
+		NOTIFICATION_EVENT_DELIVERY_RESCHEDULED,
+		NOTIFICATION_EVENT_ORDER_BALANCE_DUE,
+		NOTIFICATION_EVENT_ORDER_MESSAGE_RECEIVED,
+		NOTIFICATION_EVENT_GUEST_ORDER_CLAIM,
+		NOTIFICATION_EVENT_ORDER_DOWNLOADS_READY,
+		NOTIFICATION_EVENT_PAYMENT_RECEIVED,
+		NOTIFICATION_EVENT_PAYMENT_FAILED,
+		NOTIFICATION_EVENT_PAYMENT_DECLINE_SPIKE,
+		NOTIFICATION_EVENT_SHIPMENT_STATUS_CHANGED,
+		NOTIFICATION_EVENT_SHIPMENT_DELIVERED,
+		NOTIFICATION_EVENT_INVENTORY_LOW_STOCK,
+		NOTIFICATION_EVENT_EMAIL_CHANGE_CONFIRM,
+		NOTIFICATION_EVENT_EMAIL_CHANGED,
+		NOTIFICATION_EVENT_SELLER_HEALTH_WARNING,
+		NOTIFICATION_EVENT_SELLER_LISTING_DEMOTED,
+	}
+}
+
+func (e NotificationEventType) IsValid() bool {
+	for _, eventType := range AllNotificationEventTypes() {
+		if e == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// IsMandatory reports whether the event is an account security notice, or delivers
+// something the user paid for, and is sent regardless of the user's category opt-outs.
+func (e NotificationEventType) IsMandatory() bool {
+	switch e {
+	case NOTIFICATION_EVENT_EMAIL_CHANGE_CONFIRM,
+		NOTIFICATION_EVENT_EMAIL_CHANGED,
+		NOTIFICATION_EVENT_GUEST_ORDER_CLAIM,
+		NOTIFICATION_EVENT_ORDER_DOWNLOADS_READY:
+		return true
+	default:
+		return false
+	}
+}
+
+func (e NotificationEventType) String() string {
+	return string(e)
+}
+
+// NotificationTemplate is an admin-managed template overriding the built-in default
+// for one event type and channel. Subject and body use Go template syntax.
+type NotificationTemplate struct {
+	db.BaseEntity
+	EventType NotificationEventType `json:"eventType" gorm:"column:event_type;size:100;not null"`
+	Channel   NotificationChannel   `json:"channel"   gorm:"column:channel;size:20;not null"`
+	Subject   string                `json:"subject"   gorm:"column:subject;type:text;not null;default:''"`
+	Body      string                `json:"body"      gorm:"column:body;type:text;not null"`
+	IsActive  bool                  `json:"isActive"  gorm:"column:is_active;not null;default:true"`
+}
+
+func (NotificationTemplate) TableName() string {
+	return "notification_template"
+}
@@ -0,0 +1,57 @@
+package entity
+
+import (
+	"time"
+
+	"ecommerce-be/common/db"
+)
+
+// SendingDomainStatus is the verification state of a seller's sending domain.
+type SendingDomainStatus string
+
+const (
+	// SENDING_DOMAIN_STATUS_PENDING waits for an admin to configure DKIM and verify
+	SENDING_DOMAIN_STATUS_PENDING  SendingDomainStatus = "pending"
+	SENDING_DOMAIN_STATUS_VERIFIED SendingDomainStatus = "verified"
+	SENDING_DOMAIN_STATUS_FAILED   SendingDomainStatus = "failed"
+)
+
+// IsValid reports whether s is a known status
+func (s SendingDomainStatus) IsValid() bool {
+	switch s {
+	case SENDING_DOMAIN_STATUS_PENDING,
+		SENDING_DOMAIN_STATUS_VERIFIED,
+		SENDING_DOMAIN_STATUS_FAILED:
+		return true
+	}
+	return false
+}
+
+// SellerSendingDomain is the domain a seller's customer emails are sent from. Email
+// uses it only once its SPF and DKIM records were verified; until then the platform
+// address applies.
+type SellerSendingDomain struct {
+	db.BaseEntity
+	SellerID    uint   `json:"sellerId"    gorm:"column:seller_id;not null;uniqueIndex"`
+	Domain      string `json:"domain"      gorm:"column:domain;size:253;not null"`
+	FromAddress string `json:"fromAddress" gorm:"column:from_address;size:320;not null"`
+	FromName    string `json:"fromName"    gorm:"column:from_name;size:100;not null;default:''"`
+
+	// DKIMSelector and DKIMRecordValue come from the email provider when an admin
+	// registers the domain there; the seller publishes the record
+	DKIMSelector    string `json:"dkimSelector"    gorm:"column:dkim_selector;size:63;not null;default:''"`
+	DKIMRecordValue string `json:"dkimRecordValue" gorm:"column:dkim_record_value;type:text;not null;default:''"`
+
+	Status       SendingDomainStatus `json:"status"       gorm:"column:status;size:20;not null;default:pending"`
+	SPFVerified  bool                `json:"spfVerified"  gorm:"column:spf_verified;not null;default:false"`
+	DKIMVerified bool                `json:"dkimVerified" gorm:"column:dkim_verified;not null;default:false"`
+
+	// LastCheckError explains the last failed verification
+	LastCheckError string     `json:"lastCheckError" gorm:"column:last_check_error;type:text;not null;default:''"`
+	LastCheckedAt  *time.Time `json:"lastCheckedAt"  gorm:"column:last_checked_at"`
+	VerifiedAt     *time.Time `json:"verifiedAt"     gorm:"column:verified_at"`
+}
+
+func (SellerSendingDomain) TableName() string {
+	return "seller_sending_domain"
+}
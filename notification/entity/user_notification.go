@@ -0,0 +1,38 @@
+package entity
+
+import (
+	"time"
+
+	"ecommerce-be/common/db"
+)
+
+// UserNotification is an in-app notification delivered to a single user.
+// UpdatedAt moves on every read-state change and on clear so devices can pull deltas.
+// Cleared notifications are hidden from the notification center but kept for sync.
+type UserNotification struct {
+	db.BaseEntity
+	UserID    uint                  `json:"userId"    gorm:"column:user_id;not null;index"`
+	EventType NotificationEventType `json:"eventType" gorm:"column:event_type;size:100;not null"`
+	Title     string                `json:"title"     gorm:"column:title;size:255;not null;default:''"`
+	Body      string                `json:"body"      gorm:"column:body;type:text;not null"`
+	Data      db.JSONMap            `json:"data"      gorm:"column:data;type:jsonb;not null;default:'{}'"`
+	ReadAt    *time.Time            `json:"readAt"    gorm:"column:read_at"`
+	ClearedAt *time.Time            `json:"clearedAt" gorm:"column:cleared_at"`
+}
+
+func (UserNotification) TableName() string {
+	return "user_notification"
+}
+
+// NotificationDeviceCursor tracks the last change each of a user's devices has seen.
+type NotificationDeviceCursor struct {
+	db.BaseEntity
+	UserID       uint       `json:"userId"       gorm:"column:user_id;not null"`
+	DeviceID     string     `json:"deviceId"     gorm:"column:device_id;size:100;not null"`
+	LastSyncedAt *time.Time `json:"lastSyncedAt" gorm:"column:last_synced_at"`
+	LastSyncedID uint       `json:"lastSyncedId" gorm:"column:last_synced_id;not null;default:0"`
+}
+
+func (NotificationDeviceCursor) TableName() string {
+	return "notification_device_cursor"
+}
@@ -0,0 +1,20 @@
+package error
+
+import (
+	"net/http"
+
+	commonError "ecommerce-be/common/error"
+	"ecommerce-be/notification/utils/constant"
+)
+
+var ErrNotificationSelectionInvalid = &commonError.AppError{
+	Code:       constant.NOTIFICATION_SELECTION_INVALID_CODE,
+	Message:    constant.NOTIFICATION_SELECTION_INVALID_MSG,
+	StatusCode: http.StatusBadRequest,
+}
+
+func init() {
+	commonError.Register(
+		ErrNotificationSelectionInvalid,
+	)
+}
@@ -0,0 +1,43 @@
+package error
+
+import (
+	"net/http"
+
+	commonError "ecommerce-be/common/error"
+	"ecommerce-be/notification/utils/constant"
+)
+
+var (
+	ErrPushTokenNotFound = &commonError.AppError{
+		Code:       constant.PUSH_TOKEN_NOT_FOUND_CODE,
+		Message:    constant.PUSH_TOKEN_NOT_FOUND_MSG,
+		StatusCode: http.StatusNotFound,
+	}
+
+	ErrInvalidRecipient = &commonError.AppError{
+		Code:       constant.INVALID_RECIPIENT_CODE,
+		Message:    constant.INVALID_RECIPIENT_MSG,
+		StatusCode: http.StatusBadRequest,
+	}
+
+	ErrInvalidNotificationCategory = &commonError.AppError{
+		Code:       constant.INVALID_NOTIFICATION_CATEGORY_CODE,
+		Message:    constant.INVALID_NOTIFICATION_CATEGORY_MSG,
+		StatusCode: http.StatusBadRequest,
+	}
+
+	ErrInvalidUnsubscribeToken = &commonError.AppError{
+		Code:       constant.INVALID_UNSUBSCRIBE_TOKEN_CODE,
+		Message:    constant.INVALID_UNSUBSCRIBE_TOKEN_MSG,
+		StatusCode: http.StatusBadRequest,
+	}
+)
+
+func init() {
+	commonError.Register(
+		ErrPushTokenNotFound,
+		ErrInvalidRecipient,
+		ErrInvalidNotificationCategory,
+		ErrInvalidUnsubscribeToken,
+	)
+}
@@ -0,0 +1,36 @@
+package error
+
+import (
+	"net/http"
+
+	commonError "ecommerce-be/common/error"
+	"ecommerce-be/notification/utils/constant"
+)
+
+var (
+	ErrReadStateEmpty = &commonError.AppError{
+		Code:       constant.READ_STATE_EMPTY_CODE,
+		Message:    constant.READ_STATE_EMPTY_MSG,
+		StatusCode: http.StatusBadRequest,
+	}
+
+	ErrReadStateConflict = &commonError.AppError{
+		Code:       constant.READ_STATE_CONFLICT_CODE,
+		Message:    constant.READ_STATE_CONFLICT_MSG,
+		StatusCode: http.StatusBadRequest,
+	}
+
+	ErrInvalidDeviceID = &commonError.AppError{
+		Code:       constant.INVALID_DEVICE_ID_CODE,
+		Message:    constant.INVALID_DEVICE_ID_MSG,
+		StatusCode: http.StatusBadRequest,
+	}
+)
+
+func init() {
+	commonError.Register(
+		ErrReadStateEmpty,
+		ErrReadStateConflict,
+		ErrInvalidDeviceID,
+	)
+}
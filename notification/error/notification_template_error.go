@@ -0,0 +1,62 @@
+package error
+
+import (
+	"fmt"
+	"net/http"
+
+	commonError "ecommerce-be/common/error"
+	"ecommerce-be/notification/utils/constant"
+)
+
+var (
+	ErrTemplateNotFound = &commonError.AppError{
+		Code:       constant.TEMPLATE_NOT_FOUND_CODE,
+		Message:    constant.TEMPLATE_NOT_FOUND_MSG,
+		StatusCode: http.StatusNotFound,
+	}
+
+	ErrTemplateAlreadyExists = &commonError.AppError{
+		Code:       constant.TEMPLATE_ALREADY_EXISTS_CODE,
+		Message:    constant.TEMPLATE_ALREADY_EXISTS_MSG,
+		StatusCode: http.StatusConflict,
+	}
+
+	ErrInvalidNotificationChannel = &commonError.AppError{
+		Code:       constant.INVALID_NOTIFICATION_CHANNEL_CODE,
+		Message:    constant.INVALID_NOTIFICATION_CHANNEL_MSG,
+		StatusCode: http.StatusBadRequest,
+	}
+
+	ErrInvalidNotificationEventType = &commonError.AppError{
+		Code:       constant.INVALID_NOTIFICATION_EVENT_CODE,
+		Message:    constant.INVALID_NOTIFICATION_EVENT_MSG,
+		StatusCode: http.StatusBadRequest,
+	}
+)
+
+// ErrInvalidTemplate is returned when a template fails to parse.
+func ErrInvalidTemplate(reason string) *commonError.AppError {
+	return &commonError.AppError{
+		Code:       constant.INVALID_TEMPLATE_CODE,
+		Message:    fmt.Sprintf(constant.INVALID_TEMPLATE_MSG, reason),
+		StatusCode: http.StatusBadRequest,
+	}
+}
+
+// ErrTemplateRenderFailed is returned when a template cannot be executed with the given data.
+func ErrTemplateRenderFailed(reason string) *commonError.AppError {
+	return &commonError.AppError{
+		Code:       constant.TEMPLATE_RENDER_FAILED_CODE,
+		Message:    fmt.Sprintf(constant.TEMPLATE_RENDER_FAILED_MSG, reason),
+		StatusCode: http.StatusUnprocessableEntity,
+	}
+}
+
+func init() {
+	commonError.Register(
+		ErrTemplateNotFound,
+		ErrTemplateAlreadyExists,
+		ErrInvalidNotificationChannel,
+		ErrInvalidNotificationEventType,
+	)
+}
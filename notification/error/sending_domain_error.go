@@ -0,0 +1,50 @@
+package error
+
+import (
+	"net/http"
+
+	commonError "ecommerce-be/common/error"
+	"ecommerce-be/notification/utils/constant"
+)
+
+var (
+	ErrSendingDomainNotFound = &commonError.AppError{
+		Code:       constant.SENDING_DOMAIN_NOT_FOUND_CODE,
+		Message:    constant.SENDING_DOMAIN_NOT_FOUND_MSG,
+		StatusCode: http.StatusNotFound,
+	}
+
+	ErrInvalidSendingDomain = &commonError.AppError{
+		Code:       constant.INVALID_SENDING_DOMAIN_CODE,
+		Message:    constant.INVALID_SENDING_DOMAIN_MSG,
+		StatusCode: http.StatusBadRequest,
+	}
+
+	ErrSendingDomainFromMismatch = &commonError.AppError{
+		Code:       constant.SENDING_DOMAIN_FROM_MISMATCH_CODE,
+		Message:    constant.SENDING_DOMAIN_FROM_MISMATCH_MSG,
+		StatusCode: http.StatusBadRequest,
+	}
+
+	ErrSendingDomainPlanRequired = &commonError.AppError{
+		Code:       constant.SENDING_DOMAIN_PLAN_REQUIRED_CODE,
+		Message:    constant.SENDING_DOMAIN_PLAN_REQUIRED_MSG,
+		StatusCode: http.StatusForbidden,
+	}
+
+	ErrSendingDomainDKIMNotConfigured = &commonError.AppError{
+		Code:       constant.SENDING_DOMAIN_DKIM_NOT_CONFIGURED_CODE,
+		Message:    constant.SENDING_DOMAIN_DKIM_NOT_CONFIGURED_MSG,
+		StatusCode: http.StatusConflict,
+	}
+)
+
+func init() {
+	commonError.Register(
+		ErrSendingDomainNotFound,
+		ErrInvalidSendingDomain,
+		ErrSendingDomainFromMismatch,
+		ErrSendingDomainPlanRequired,
+		ErrSendingDomainDKIMNotConfigured,
+	)
+}
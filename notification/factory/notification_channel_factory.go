@@ -0,0 +1,46 @@
+package factory
+
+import (
+	"time"
+
+	"ecommerce-be/notification/entity"
+	"ecommerce-be/notification/model"
+)
+
+// BuildChannelPreferenceResponses maps resolved channel flags to API responses in
+// the stable order of AllNotificationChannels
+func BuildChannelPreferenceResponses(
+	resolved map[entity.NotificationChannel]bool,
+) []model.ChannelPreferenceResponse {
+	responses := make([]model.ChannelPreferenceResponse, 0, len(resolved))
+	for _, channel := range entity.AllNotificationChannels() {
+		responses = append(responses, model.ChannelPreferenceResponse{
+			Channel:   channel,
+			IsEnabled: resolved[channel],
+		})
+	}
+	return responses
+}
+
+// BuildCategoryPreferenceResponses maps resolved category flags to API responses in
+// the stable order of AllNotificationCategories
+func BuildCategoryPreferenceResponses(
+	resolved map[entity.NotificationCategory]bool,
+) []model.CategoryPreferenceResponse {
+	responses := make([]model.CategoryPreferenceResponse, 0, len(resolved))
+	for _, category := range entity.AllNotificationCategories() {
+		responses = append(responses, model.CategoryPreferenceResponse{
+			Category:  category,
+			IsEnabled: resolved[category],
+		})
+	}
+	return responses
+}
+
+// BuildPushTokenResponse maps a push token entity to its API response
+func BuildPushTokenResponse(token *entity.UserPushToken) model.PushTokenResponse {
+	return model.PushTokenResponse{
+		Platform:   token.Platform,
+		LastSeenAt: token.LastSeenAt.UTC().Format(time.RFC3339),
+	}
+}
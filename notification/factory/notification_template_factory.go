@@ -0,0 +1,37 @@
+package factory
+
+import (
+	"time"
+
+	"ecommerce-be/notification/entity"
+	"ecommerce-be/notification/model"
+)
+
+// BuildTemplateEntity creates a new template entity from create request
+func BuildTemplateEntity(req model.TemplateCreateRequest) *entity.NotificationTemplate {
+	isActive := true
+	if req.IsActive != nil {
+		isActive = *req.IsActive
+	}
+	return &entity.NotificationTemplate{
+		EventType: req.EventType,
+		Channel:   req.Channel,
+		Subject:   req.Subject,
+		Body:      req.Body,
+		IsActive:  isActive,
+	}
+}
+
+// BuildTemplateResponse maps a template entity to its API response
+func BuildTemplateResponse(tmpl *entity.NotificationTemplate) model.TemplateResponse {
+	return model.TemplateResponse{
+		ID:        tmpl.ID,
+		EventType: tmpl.EventType,
+		Channel:   tmpl.Channel,
+		Subject:   tmpl.Subject,
+		Body:      tmpl.Body,
+		IsActive:  tmpl.IsActive,
+		CreatedAt: tmpl.CreatedAt.Format(time.RFC3339),
+		UpdatedAt: tmpl.UpdatedAt.Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,49 @@
+package factory
+
+import (
+	"ecommerce-be/notification/entity"
+	"ecommerce-be/notification/model"
+	"ecommerce-be/notification/utils"
+)
+
+// BuildSendingDomainResponse maps a sending domain to its API response, listing the
+// DNS records the seller must publish. The DKIM record appears once an admin has
+// configured its selector.
+func BuildSendingDomainResponse(
+	domain *entity.SellerSendingDomain,
+	spfInclude string,
+) model.SendingDomainResponse {
+	spfValue := "v=spf1 ~all"
+	if spfInclude != "" {
+		spfValue = "v=spf1 include:" + spfInclude + " ~all"
+	}
+	records := []model.SendingDomainDNSRecord{{
+		Purpose:  "spf",
+		Type:     "TXT",
+		Name:     domain.Domain,
+		Value:    spfValue,
+		Verified: domain.SPFVerified,
+	}}
+	if domain.DKIMSelector != "" {
+		records = append(records, model.SendingDomainDNSRecord{
+			Purpose:  "dkim",
+			Type:     "TXT",
+			Name:     utils.DKIMRecordName(domain.DKIMSelector, domain.Domain),
+			Value:    domain.DKIMRecordValue,
+			Verified: domain.DKIMVerified,
+		})
+	}
+
+	return model.SendingDomainResponse{
+		ID:             domain.ID,
+		SellerID:       domain.SellerID,
+		Domain:         domain.Domain,
+		FromAddress:    domain.FromAddress,
+		FromName:       domain.FromName,
+		Status:         domain.Status,
+		DNSRecords:     records,
+		LastCheckError: domain.LastCheckError,
+		LastCheckedAt:  formatOptionalTime(domain.LastCheckedAt),
+		VerifiedAt:     formatOptionalTime(domain.VerifiedAt),
+	}
+}
@@ -0,0 +1,86 @@
+package singleton
+
+import (
+	"sync"
+
+	"ecommerce-be/notification/handler"
+)
+
+// HandlerFactory manages all handler singleton instances
+type HandlerFactory struct {
+	serviceFactory *ServiceFactory
+
+	templateHandler *handler.NotificationTemplateHandler
+	syncHandler     *handler.NotificationSyncHandler
+	centerHandler   *handler.NotificationCenterHandler
+	prefHandler     *handler.NotificationPreferenceHandler
+	dispatchHandler *handler.ScheduleNotificationDispatchHandler
+	domainHandler   *handler.SendingDomainHandler
+
+	once sync.Once
+}
+
+// NewHandlerFactory creates a new handler factory
+func NewHandlerFactory(serviceFactory *ServiceFactory) *HandlerFactory {
+	return &HandlerFactory{serviceFactory: serviceFactory}
+}
+
+// initialize creates all handler instances (lazy loading)
+func (f *HandlerFactory) initialize() {
+	f.once.Do(func() {
+		f.templateHandler = handler.NewNotificationTemplateHandler(
+			f.serviceFactory.GetNotificationTemplateService(),
+		)
+		f.syncHandler = handler.NewNotificationSyncHandler(
+			f.serviceFactory.GetNotificationSyncService(),
+		)
+		f.centerHandler = handler.NewNotificationCenterHandler(
+			f.serviceFactory.GetNotificationCenterService(),
+		)
+		f.prefHandler = handler.NewNotificationPreferenceHandler(
+			f.serviceFactory.GetNotificationPreferenceService(),
+		)
+		f.dispatchHandler = handler.NewScheduleNotificationDispatchHandler(
+			f.serviceFactory.GetNotificationDispatchService(),
+		)
+		f.domainHandler = handler.NewSendingDomainHandler(
+			f.serviceFactory.GetSendingDomainService(),
+		)
+	})
+}
+
+// GetNotificationTemplateHandler returns the singleton notification template handler
+func (f *HandlerFactory) GetNotificationTemplateHandler() *handler.NotificationTemplateHandler {
+	f.initialize()
+	return f.templateHandler
+}
+
+// GetNotificationSyncHandler returns the singleton notification sync handler
+func (f *HandlerFactory) GetNotificationSyncHandler() *handler.NotificationSyncHandler {
+	f.initialize()
+	return f.syncHandler
+}
+
+// GetNotificationCenterHandler returns the singleton notification center handler
+func (f *HandlerFactory) GetNotificationCenterHandler() *handler.NotificationCenterHandler {
+	f.initialize()
+	return f.centerHandler
+}
+
+// GetNotificationPreferenceHandler returns the singleton notification preference handler
+func (f *HandlerFactory) GetNotificationPreferenceHandler() *handler.NotificationPreferenceHandler {
+	f.initialize()
+	return f.prefHandler
+}
+
+// GetScheduleNotificationDispatchHandler returns the singleton notification dispatch job handler
+func (f *HandlerFactory) GetScheduleNotificationDispatchHandler() *handler.ScheduleNotificationDispatchHandler {
+	f.initialize()
+	return f.dispatchHandler
+}
+
+// GetSendingDomainHandler returns the singleton sending domain handler
+func (f *HandlerFactory) GetSendingDomainHandler() *handler.SendingDomainHandler {
+	f.initialize()
+	return f.domainHandler
+}
@@ -0,0 +1,80 @@
+package singleton
+
+import (
+	"sync"
+
+	"ecommerce-be/notification/repository"
+)
+
+// RepositoryFactory manages all repository singleton instances
+type RepositoryFactory struct {
+	templateRepo         repository.NotificationTemplateRepository
+	userNotificationRepo repository.UserNotificationRepository
+	deviceCursorRepo     repository.NotificationDeviceCursorRepository
+	channelPrefRepo      repository.NotificationChannelPreferenceRepository
+	categoryPrefRepo     repository.NotificationCategoryPreferenceRepository
+	pushTokenRepo        repository.UserPushTokenRepository
+	sendingDomainRepo    repository.SellerSendingDomainRepository
+
+	once sync.Once
+}
+
+// NewRepositoryFactory creates a new repository factory
+func NewRepositoryFactory() *RepositoryFactory {
+	return &RepositoryFactory{}
+}
+
+// initialize creates all repository instances (lazy loading)
+func (f *RepositoryFactory) initialize() {
+	f.once.Do(func() {
+		f.templateRepo = repository.NewNotificationTemplateRepository()
+		f.userNotificationRepo = repository.NewUserNotificationRepository()
+		f.deviceCursorRepo = repository.NewNotificationDeviceCursorRepository()
+		f.channelPrefRepo = repository.NewNotificationChannelPreferenceRepository()
+		f.categoryPrefRepo = repository.NewNotificationCategoryPreferenceRepository()
+		f.pushTokenRepo = repository.NewUserPushTokenRepository()
+		f.sendingDomainRepo = repository.NewSellerSendingDomainRepository()
+	})
+}
+
+// GetNotificationTemplateRepository returns the singleton notification template repository
+func (f *RepositoryFactory) GetNotificationTemplateRepository() repository.NotificationTemplateRepository {
+	f.initialize()
+	return f.templateRepo
+}
+
+// GetUserNotificationRepository returns the singleton user notification repository
+func (f *RepositoryFactory) GetUserNotificationRepository() repository.UserNotificationRepository {
+	f.initialize()
+	return f.userNotificationRepo
+}
+
+// GetNotificationDeviceCursorRepository returns the singleton device cursor repository
+func (f *RepositoryFactory) GetNotificationDeviceCursorRepository() repository.NotificationDeviceCursorRepository {
+	f.initialize()
+	return f.deviceCursorRepo
+}
+
+// GetNotificationChannelPreferenceRepository returns the singleton channel preference repository
+func (f *RepositoryFactory) GetNotificationChannelPreferenceRepository() repository.NotificationChannelPreferenceRepository {
+	f.initialize()
+	return f.channelPrefRepo
+}
+
+// GetNotificationCategoryPreferenceRepository returns the singleton category preference repository
+func (f *RepositoryFactory) GetNotificationCategoryPreferenceRepository() repository.NotificationCategoryPreferenceRepository {
+	f.initialize()
+	return f.categoryPrefRepo
+}
+
+// GetUserPushTokenRepository returns the singleton push token repository
+func (f *RepositoryFactory) GetUserPushTokenRepository() repository.UserPushTokenRepository {
+	f.initialize()
+	return f.pushTokenRepo
+}
+
+// GetSellerSendingDomainRepository returns the singleton sending domain repository
+func (f *RepositoryFactory) GetSellerSendingDomainRepository() repository.SellerSendingDomainRepository {
+	f.initialize()
+	return f.sendingDomainRepo
+}
@@ -0,0 +1,144 @@
+package singleton
+
+import (
+	"net"
+	"sync"
+
+	"ecommerce-be/common/cache"
+	"ecommerce-be/common/config"
+	"ecommerce-be/common/scheduler"
+	"ecommerce-be/notification/service"
+	"ecommerce-be/notification/service/channel"
+	"ecommerce-be/notification/utils"
+	userSingleton "ecommerce-be/user/factory/singleton"
+)
+
+// ServiceFactory manages all service singleton instances
+type ServiceFactory struct {
+	repoFactory *RepositoryFactory
+
+	templateService   service.NotificationTemplateService
+	syncService       service.NotificationSyncService
+	centerService     service.NotificationCenterService
+	preferenceService service.NotificationPreferenceService
+	dispatchService   service.NotificationDispatchService
+	domainService     service.SendingDomainService
+
+	once sync.Once
+}
+
+// NewServiceFactory creates a new service factory
+func NewServiceFactory(repoFactory *RepositoryFactory) *ServiceFactory {
+	return &ServiceFactory{
+		repoFactory: repoFactory,
+	}
+}
+
+// initialize creates all service instances (lazy loading)
+func (f *ServiceFactory) initialize() {
+	f.once.Do(func() {
+		f.templateService = service.NewNotificationTemplateService(
+			f.repoFactory.GetNotificationTemplateRepository(),
+		)
+		f.syncService = service.NewNotificationSyncService(
+			f.repoFactory.GetUserNotificationRepository(),
+			f.repoFactory.GetNotificationDeviceCursorRepository(),
+		)
+		f.centerService = service.NewNotificationCenterService(
+			f.repoFactory.GetUserNotificationRepository(),
+		)
+		unsubscribeSigner := newUnsubscribeSigner()
+		f.preferenceService = service.NewNotificationPreferenceService(
+			f.repoFactory.GetNotificationChannelPreferenceRepository(),
+			f.repoFactory.GetNotificationCategoryPreferenceRepository(),
+			f.repoFactory.GetUserPushTokenRepository(),
+			unsubscribeSigner,
+		)
+
+		f.domainService = service.NewSendingDomainService(
+			f.repoFactory.GetSellerSendingDomainRepository(),
+			net.DefaultResolver,
+			notificationConfig().SPFInclude,
+		)
+
+		senders := channel.BuildRegistry(
+			notificationConfig(),
+			f.repoFactory.GetUserNotificationRepository(),
+			f.repoFactory.GetUserPushTokenRepository(),
+			f.domainService,
+		)
+		// Without Redis, notifications are delivered inline instead of queued
+		var sched *scheduler.Scheduler
+		if redisClient, err := cache.GetRedisClient(); err == nil {
+			sched = scheduler.New(redisClient)
+		}
+		f.dispatchService = service.NewNotificationDispatchService(
+			f.templateService,
+			f.repoFactory.GetNotificationChannelPreferenceRepository(),
+			f.repoFactory.GetNotificationCategoryPreferenceRepository(),
+			f.repoFactory.GetUserPushTokenRepository(),
+			userSingleton.GetInstance().GetUserRepository(),
+			senders,
+			unsubscribeSigner,
+			sched,
+		)
+	})
+}
+
+// GetNotificationTemplateService returns the singleton notification template service
+func (f *ServiceFactory) GetNotificationTemplateService() service.NotificationTemplateService {
+	f.initialize()
+	return f.templateService
+}
+
+// GetNotificationSyncService returns the singleton notification sync service
+func (f *ServiceFactory) GetNotificationSyncService() service.NotificationSyncService {
+	f.initialize()
+	return f.syncService
+}
+
+// GetNotificationCenterService returns the singleton notification center service
+func (f *ServiceFactory) GetNotificationCenterService() service.NotificationCenterService {
+	f.initialize()
+	return f.centerService
+}
+
+// GetNotificationPreferenceService returns the singleton notification preference service
+func (f *ServiceFactory) GetNotificationPreferenceService() service.NotificationPreferenceService {
+	f.initialize()
+	return f.preferenceService
+}
+
+// GetNotificationDispatchService returns the singleton notification dispatch service
+func (f *ServiceFactory) GetNotificationDispatchService() service.NotificationDispatchService {
+	f.initialize()
+	return f.dispatchService
+}
+
+// GetSendingDomainService returns the singleton sending domain service
+func (f *ServiceFactory) GetSendingDomainService() service.SendingDomainService {
+	f.initialize()
+	return f.domainService
+}
+
+// notificationConfig returns the loaded notification config, or the zero value
+// (log senders) when configuration has not been loaded.
+func notificationConfig() config.NotificationConfig {
+	if cfg := config.Get(); cfg != nil {
+		return cfg.Notification
+	}
+	return config.NotificationConfig{}
+}
+
+// newUnsubscribeSigner signs unsubscribe links with the dedicated secret, falling
+// back to the JWT secret so links work without extra configuration.
+func newUnsubscribeSigner() *utils.UnsubscribeSigner {
+	notificationCfg := notificationConfig()
+	secret := notificationCfg.UnsubscribeSecret
+	if secret == "" {
+		if cfg := config.Get(); cfg != nil {
+			secret = cfg.Auth.JWTSecret
+		}
+	}
+	return utils.NewUnsubscribeSigner(secret, notificationCfg.PublicBaseURL)
+}
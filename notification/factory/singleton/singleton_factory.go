@@ -0,0 +1,131 @@
+package singleton
+
+import (
+	"sync"
+
+	"ecommerce-be/notification/handler"
+	"ecommerce-be/notification/repository"
+	"ecommerce-be/notification/service"
+)
+
+// SingletonFactory is the main facade for accessing all factories
+type SingletonFactory struct {
+	repoFactory    *RepositoryFactory
+	serviceFactory *ServiceFactory
+	handlerFactory *HandlerFactory
+}
+
+var (
+	instance *SingletonFactory
+	once     sync.Once
+)
+
+// GetInstance returns the singleton instance of SingletonFactory
+func GetInstance() *SingletonFactory {
+	once.Do(func() {
+		repoFactory := NewRepositoryFactory()
+		serviceFactory := NewServiceFactory(repoFactory)
+		handlerFactory := NewHandlerFactory(serviceFactory)
+
+		instance = &SingletonFactory{
+			repoFactory:    repoFactory,
+			serviceFactory: serviceFactory,
+			handlerFactory: handlerFactory,
+		}
+	})
+	return instance
+}
+
+// ResetInstance resets the singleton instance
+func ResetInstance() {
+	once = sync.Once{}
+	instance = nil
+}
+
+// ===============================
+// Repository Getters (Delegates)
+// ===============================
+
+func (f *SingletonFactory) GetNotificationTemplateRepository() repository.NotificationTemplateRepository {
+	return f.repoFactory.GetNotificationTemplateRepository()
+}
+
+func (f *SingletonFactory) GetUserNotificationRepository() repository.UserNotificationRepository {
+	return f.repoFactory.GetUserNotificationRepository()
+}
+
+func (f *SingletonFactory) GetNotificationDeviceCursorRepository() repository.NotificationDeviceCursorRepository {
+	return f.repoFactory.GetNotificationDeviceCursorRepository()
+}
+
+func (f *SingletonFactory) GetNotificationChannelPreferenceRepository() repository.NotificationChannelPreferenceRepository {
+	return f.repoFactory.GetNotificationChannelPreferenceRepository()
+}
+
+func (f *SingletonFactory) GetNotificationCategoryPreferenceRepository() repository.NotificationCategoryPreferenceRepository {
+	return f.repoFactory.GetNotificationCategoryPreferenceRepository()
+}
+
+func (f *SingletonFactory) GetUserPushTokenRepository() repository.UserPushTokenRepository {
+	return f.repoFactory.GetUserPushTokenRepository()
+}
+
+func (f *SingletonFactory) GetSellerSendingDomainRepository() repository.SellerSendingDomainRepository {
+	return f.repoFactory.GetSellerSendingDomainRepository()
+}
+
+// ===============================
+// Service Getters (Delegates)
+// ===============================
+
+func (f *SingletonFactory) GetNotificationTemplateService() service.NotificationTemplateService {
+	return f.serviceFactory.GetNotificationTemplateService()
+}
+
+func (f *SingletonFactory) GetNotificationSyncService() service.NotificationSyncService {
+	return f.serviceFactory.GetNotificationSyncService()
+}
+
+func (f *SingletonFactory) GetNotificationCenterService() service.NotificationCenterService {
+	return f.serviceFactory.GetNotificationCenterService()
+}
+
+func (f *SingletonFactory) GetNotificationPreferenceService() service.NotificationPreferenceService {
+	return f.serviceFactory.GetNotificationPreferenceService()
+}
+
+func (f *SingletonFactory) GetNotificationDispatchService() service.NotificationDispatchService {
+	return f.serviceFactory.GetNotificationDispatchService()
+}
+
+func (f *SingletonFactory) GetSendingDomainService() service.SendingDomainService {
+	return f.serviceFactory.GetSendingDomainService()
+}
+
+// ===============================
+// Handler Getters (Delegates)
+// ===============================
+
+func (f *SingletonFactory) GetNotificationTemplateHandler() *handler.NotificationTemplateHandler {
+	return f.handlerFactory.GetNotificationTemplateHandler()
+}
+
+func (f *SingletonFactory) GetNotificationSyncHandler() *handler.NotificationSyncHandler {
+	return f.handlerFactory.GetNotificationSyncHandler()
+}
+
+func (f *SingletonFactory) GetNotificationCenterHandler() *handler.NotificationCenterHandler {
+	return f.handlerFactory.GetNotificationCenterHandler()
+}
+
+func (f *SingletonFactory) GetNotificationPreferenceHandler() *handler.NotificationPreferenceHandler {
+	return f.handlerFactory.GetNotificationPreferenceHandler()
+}
+
+func (f *SingletonFactory) GetScheduleNotificationDispatchHandler() *handler.ScheduleNotificationDispatchHandler {
+	return f.handlerFactory.GetScheduleNotificationDispatchHandler()
+}
+
+func (f *SingletonFactory) GetSendingDomainHandler() *handler.SendingDomainHandler {
+	return f.handlerFactory.GetSendingDomainHandler()
+}
@@ -0,0 +1,47 @@
+package factory
+
+import (
+	"time"
+
+	"ecommerce-be/notification/entity"
+	"ecommerce-be/notification/model"
+)
+
+// BuildUserNotificationResponse maps an in-app notification entity to its API response
+func BuildUserNotificationResponse(n *entity.UserNotification) model.UserNotificationResponse {
+	data := map[string]any(n.Data)
+	if data == nil {
+		data = map[string]any{}
+	}
+
+	return model.UserNotificationResponse{
+		ID:        n.ID,
+		EventType: n.EventType,
+		Title:     n.Title,
+		Body:      n.Body,
+		Data:      data,
+		IsRead:    n.ReadAt != nil,
+		ReadAt:    formatOptionalTime(n.ReadAt),
+		IsCleared: n.ClearedAt != nil,
+		ClearedAt: formatOptionalTime(n.ClearedAt),
+		CreatedAt: n.CreatedAt.UTC().Format(time.RFC3339),
+		UpdatedAt: n.UpdatedAt.UTC().Format(time.RFC3339Nano),
+	}
+}
+
+// BuildSyncCursor formats a sync position for the client. RFC3339Nano keeps the
+// full timestamp precision so no change is skipped or repeated on the next call.
+func BuildSyncCursor(since time.Time, afterID uint) model.SyncCursor {
+	return model.SyncCursor{
+		Since:   since.UTC().Format(time.RFC3339Nano),
+		AfterID: afterID,
+	}
+}
+
+func formatOptionalTime(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	formatted := t.UTC().Format(time.RFC3339Nano)
+	return &formatted
+}
@@ -0,0 +1,27 @@
+package gateway
+
+import (
+	"context"
+
+	"ecommerce-be/common/notifier"
+	"ecommerce-be/notification/entity"
+	"ecommerce-be/notification/service"
+)
+
+type notifierGateway struct {
+	dispatchService service.NotificationDispatchService
+}
+
+// NewNotifier returns a notifier.Notifier backed by NotificationDispatchService.
+func NewNotifier(dispatchService service.NotificationDispatchService) notifier.Notifier {
+	return &notifierGateway{dispatchService: dispatchService}
+}
+
+func (g *notifierGateway) Notify(
+	ctx context.Context,
+	event string,
+	userID uint,
+	payload map[string]any,
+) error {
+	return g.dispatchService.Notify(ctx, entity.NotificationEventType(event), userID, payload)
+}
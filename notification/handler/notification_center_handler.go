@@ -0,0 +1,126 @@
+package handler
+
+import (
+	"net/http"
+
+	"ecommerce-be/common/auth"
+	"ecommerce-be/common/constants"
+	errs "ecommerce-be/common/error"
+	"ecommerce-be/common/handler"
+	"ecommerce-be/common/log"
+	"ecommerce-be/notification/model"
+	"ecommerce-be/notification/service"
+	"ecommerce-be/notification/utils/constant"
+
+	"github.com/gin-gonic/gin"
+)
+
+// NotificationCenterHandler handles in-app notification center requests
+type NotificationCenterHandler struct {
+	*handler.BaseHandler
+	centerService service.NotificationCenterService
+}
+
+// NewNotificationCenterHandler creates a new instance of NotificationCenterHandler
+func NewNotificationCenterHandler(
+	centerService service.NotificationCenterService,
+) *NotificationCenterHandler {
+	return &NotificationCenterHandler{
+		BaseHandler:   handler.NewBaseHandler(),
+		centerService: centerService,
+	}
+}
+
+// ListNotifications handles fetching a page of the user's notifications
+// GET /api/notification/inbox
+func (h *NotificationCenterHandler) ListNotifications(c *gin.Context) {
+	userID, exists := auth.GetUserIDFromContext(c)
+	if !exists {
+		h.HandleError(c, errs.UnauthorizedError, constants.AUTHENTICATION_REQUIRED_MSG)
+		return
+	}
+
+	var params model.NotificationInboxQueryParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	response, err := h.centerService.ListNotifications(c, userID, params)
+	if err != nil {
+		log.ErrorWithContext(c, "listNotifications: failed", err)
+		h.HandleError(c, err, constant.FAILED_TO_LIST_NOTIFICATIONS_MSG)
+		return
+	}
+
+	h.Success(c, http.StatusOK, constant.NOTIFICATIONS_FETCHED_MSG, response)
+}
+
+// MarkRead handles marking selected or all notifications as read
+// POST /api/notification/inbox/read
+func (h *NotificationCenterHandler) MarkRead(c *gin.Context) {
+	userID, exists := auth.GetUserIDFromContext(c)
+	if !exists {
+		h.HandleError(c, errs.UnauthorizedError, constants.AUTHENTICATION_REQUIRED_MSG)
+		return
+	}
+
+	var req model.MarkNotificationsReadRequest
+	if err := h.BindJSON(c, &req); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	response, err := h.centerService.MarkRead(c, userID, req)
+	if err != nil {
+		log.ErrorWithContext(c, "markNotificationsRead: failed", err)
+		h.HandleError(c, err, constant.FAILED_TO_MARK_NOTIFICATIONS_READ_MSG)
+		return
+	}
+
+	h.Success(c, http.StatusOK, constant.NOTIFICATIONS_MARKED_READ_MSG, response)
+}
+
+// Clear handles bulk-clearing notifications from the notification center
+// POST /api/notification/inbox/clear
+func (h *NotificationCenterHandler) Clear(c *gin.Context) {
+	userID, exists := auth.GetUserIDFromContext(c)
+	if !exists {
+		h.HandleError(c, errs.UnauthorizedError, constants.AUTHENTICATION_REQUIRED_MSG)
+		return
+	}
+
+	var req model.ClearNotificationsRequest
+	if err := h.BindJSON(c, &req); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	response, err := h.centerService.Clear(c, userID, req)
+	if err != nil {
+		log.ErrorWithContext(c, "clearNotifications: failed", err)
+		h.HandleError(c, err, constant.FAILED_TO_CLEAR_NOTIFICATIONS_MSG)
+		return
+	}
+
+	h.Success(c, http.StatusOK, constant.NOTIFICATIONS_CLEARED_MSG, response)
+}
+
+// GetUnreadCount handles fetching the unread badge count
+// GET /api/notification/inbox/unread-count
+func (h *NotificationCenterHandler) GetUnreadCount(c *gin.Context) {
+	userID, exists := auth.GetUserIDFromContext(c)
+	if !exists {
+		h.HandleError(c, errs.UnauthorizedError, constants.AUTHENTICATION_REQUIRED_MSG)
+		return
+	}
+
+	response, err := h.centerService.GetUnreadCount(c, userID)
+	if err != nil {
+		log.ErrorWithContext(c, "getUnreadNotificationCount: failed", err)
+		h.HandleError(c, err, constant.FAILED_TO_FETCH_UNREAD_COUNT_MSG)
+		return
+	}
+
+	h.Success(c, http.StatusOK, constant.UNREAD_COUNT_FETCHED_MSG, response)
+}
@@ -0,0 +1,214 @@
+package handler
+
+import (
+	"net/http"
+
+	"ecommerce-be/common/auth"
+	"ecommerce-be/common/constants"
+	errs "ecommerce-be/common/error"
+	"ecommerce-be/common/handler"
+	"ecommerce-be/common/log"
+	"ecommerce-be/notification/model"
+	"ecommerce-be/notification/service"
+	"ecommerce-be/notification/utils/constant"
+
+	"github.com/gin-gonic/gin"
+)
+
+// NotificationPreferenceHandler handles a user's channel preferences and push tokens
+type NotificationPreferenceHandler struct {
+	*handler.BaseHandler
+	preferenceService service.NotificationPreferenceService
+}
+
+// NewNotificationPreferenceHandler creates a new instance of NotificationPreferenceHandler
+func NewNotificationPreferenceHandler(
+	preferenceService service.NotificationPreferenceService,
+) *NotificationPreferenceHandler {
+	return &NotificationPreferenceHandler{
+		BaseHandler:       handler.NewBaseHandler(),
+		preferenceService: preferenceService,
+	}
+}
+
+// GetChannelPreferences handles fetching the user's channel preferences
+// GET /api/notification/preferences/channels
+func (h *NotificationPreferenceHandler) GetChannelPreferences(c *gin.Context) {
+	userID, exists := auth.GetUserIDFromContext(c)
+	if !exists {
+		h.HandleError(c, errs.UnauthorizedError, constants.AUTHENTICATION_REQUIRED_MSG)
+		return
+	}
+
+	response, err := h.preferenceService.GetChannelPreferences(c, userID)
+	if err != nil {
+		log.ErrorWithContext(c, "getChannelPreferences: failed", err)
+		h.HandleError(c, err, constant.FAILED_TO_GET_CHANNEL_PREFERENCES_MSG)
+		return
+	}
+
+	h.SuccessWithData(
+		c,
+		http.StatusOK,
+		constant.CHANNEL_PREFERENCES_FETCHED_MSG,
+		constant.CHANNEL_PREFERENCES_FIELD_NAME,
+		response,
+	)
+}
+
+// UpdateChannelPreferences handles enabling/disabling channels
+// PUT /api/notification/preferences/channels
+func (h *NotificationPreferenceHandler) UpdateChannelPreferences(c *gin.Context) {
+	userID, exists := auth.GetUserIDFromContext(c)
+	if !exists {
+		h.HandleError(c, errs.UnauthorizedError, constants.AUTHENTICATION_REQUIRED_MSG)
+		return
+	}
+
+	var req model.UpdateChannelPreferencesRequest
+	if err := h.BindJSON(c, &req); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	response, err := h.preferenceService.UpdateChannelPreferences(c, userID, req)
+	if err != nil {
+		log.ErrorWithContext(c, "updateChannelPreferences: failed", err)
+		h.HandleError(c, err, constant.FAILED_TO_UPDATE_CHANNEL_PREFERENCES_MSG)
+		return
+	}
+
+	h.SuccessWithData(
+		c,
+		http.StatusOK,
+		constant.CHANNEL_PREFERENCES_UPDATED_MSG,
+		constant.CHANNEL_PREFERENCES_FIELD_NAME,
+		response,
+	)
+}
+
+// GetCategoryPreferences handles fetching the user's category preferences
+// GET /api/notification/preferences/categories
+func (h *NotificationPreferenceHandler) GetCategoryPreferences(c *gin.Context) {
+	userID, exists := auth.GetUserIDFromContext(c)
+	if !exists {
+		h.HandleError(c, errs.UnauthorizedError, constants.AUTHENTICATION_REQUIRED_MSG)
+		return
+	}
+
+	response, err := h.preferenceService.GetCategoryPreferences(c, userID)
+	if err != nil {
+		log.ErrorWithContext(c, "getCategoryPreferences: failed", err)
+		h.HandleError(c, err, constant.FAILED_TO_GET_CATEGORY_PREFERENCES_MSG)
+		return
+	}
+
+	h.SuccessWithData(
+		c,
+		http.StatusOK,
+		constant.CATEGORY_PREFERENCES_FETCHED_MSG,
+		constant.CATEGORY_PREFERENCES_FIELD_NAME,
+		response,
+	)
+}
+
+// UpdateCategoryPreferences handles opting in/out of notification categories
+// PUT /api/notification/preferences/categories
+func (h *NotificationPreferenceHandler) UpdateCategoryPreferences(c *gin.Context) {
+	userID, exists := auth.GetUserIDFromContext(c)
+	if !exists {
+		h.HandleError(c, errs.UnauthorizedError, constants.AUTHENTICATION_REQUIRED_MSG)
+		return
+	}
+
+	var req model.UpdateCategoryPreferencesRequest
+	if err := h.BindJSON(c, &req); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	response, err := h.preferenceService.UpdateCategoryPreferences(c, userID, req)
+	if err != nil {
+		log.ErrorWithContext(c, "updateCategoryPreferences: failed", err)
+		h.HandleError(c, err, constant.FAILED_TO_UPDATE_CATEGORY_PREFERENCES_MSG)
+		return
+	}
+
+	h.SuccessWithData(
+		c,
+		http.StatusOK,
+		constant.CATEGORY_PREFERENCES_UPDATED_MSG,
+		constant.CATEGORY_PREFERENCES_FIELD_NAME,
+		response,
+	)
+}
+
+// Unsubscribe handles a one-click unsubscribe link. The signed token identifies
+// the user, so no login is required.
+// GET  /api/notification/unsubscribe?token=...  (link clicked in the email)
+// POST /api/notification/unsubscribe?token=...  (RFC 8058 List-Unsubscribe-Post)
+func (h *NotificationPreferenceHandler) Unsubscribe(c *gin.Context) {
+	var params model.UnsubscribeQueryParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	response, err := h.preferenceService.Unsubscribe(c, params.Token)
+	if err != nil {
+		log.ErrorWithContext(c, "unsubscribe: failed", err)
+		h.HandleError(c, err, constant.FAILED_TO_UNSUBSCRIBE_MSG)
+		return
+	}
+
+	h.Success(c, http.StatusOK, constant.UNSUBSCRIBED_MSG, response)
+}
+
+// RegisterPushToken handles registering a device push token
+// POST /api/notification/push-tokens
+func (h *NotificationPreferenceHandler) RegisterPushToken(c *gin.Context) {
+	userID, exists := auth.GetUserIDFromContext(c)
+	if !exists {
+		h.HandleError(c, errs.UnauthorizedError, constants.AUTHENTICATION_REQUIRED_MSG)
+		return
+	}
+
+	var req model.RegisterPushTokenRequest
+	if err := h.BindJSON(c, &req); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	response, err := h.preferenceService.RegisterPushToken(c, userID, req)
+	if err != nil {
+		log.ErrorWithContext(c, "registerPushToken: failed", err)
+		h.HandleError(c, err, constant.FAILED_TO_REGISTER_PUSH_TOKEN_MSG)
+		return
+	}
+
+	h.Success(c, http.StatusCreated, constant.PUSH_TOKEN_REGISTERED_MSG, response)
+}
+
+// RemovePushToken handles unregistering a device push token
+// DELETE /api/notification/push-tokens
+func (h *NotificationPreferenceHandler) RemovePushToken(c *gin.Context) {
+	userID, exists := auth.GetUserIDFromContext(c)
+	if !exists {
+		h.HandleError(c, errs.UnauthorizedError, constants.AUTHENTICATION_REQUIRED_MSG)
+		return
+	}
+
+	var req model.RemovePushTokenRequest
+	if err := h.BindJSON(c, &req); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	if err := h.preferenceService.RemovePushToken(c, userID, req); err != nil {
+		log.ErrorWithContext(c, "removePushToken: failed", err)
+		h.HandleError(c, err, constant.FAILED_TO_REMOVE_PUSH_TOKEN_MSG)
+		return
+	}
+
+	h.Success(c, http.StatusOK, constant.PUSH_TOKEN_REMOVED_MSG, nil)
+}
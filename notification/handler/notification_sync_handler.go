@@ -0,0 +1,82 @@
+package handler
+
+import (
+	"net/http"
+
+	"ecommerce-be/common/auth"
+	"ecommerce-be/common/constants"
+	errs "ecommerce-be/common/error"
+	"ecommerce-be/common/handler"
+	"ecommerce-be/common/log"
+	"ecommerce-be/notification/model"
+	"ecommerce-be/notification/service"
+	"ecommerce-be/notification/utils/constant"
+
+	"github.com/gin-gonic/gin"
+)
+
+// NotificationSyncHandler handles cross-device notification sync requests
+type NotificationSyncHandler struct {
+	*handler.BaseHandler
+	syncService service.NotificationSyncService
+}
+
+// NewNotificationSyncHandler creates a new instance of NotificationSyncHandler
+func NewNotificationSyncHandler(
+	syncService service.NotificationSyncService,
+) *NotificationSyncHandler {
+	return &NotificationSyncHandler{
+		BaseHandler: handler.NewBaseHandler(),
+		syncService: syncService,
+	}
+}
+
+// GetChanges handles fetching notification changes since the device's cursor
+// GET /api/notification/sync
+func (h *NotificationSyncHandler) GetChanges(c *gin.Context) {
+	userID, exists := auth.GetUserIDFromContext(c)
+	if !exists {
+		h.HandleError(c, errs.UnauthorizedError, constants.AUTHENTICATION_REQUIRED_MSG)
+		return
+	}
+
+	var params model.NotificationSyncQueryParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	response, err := h.syncService.GetChanges(c, userID, params)
+	if err != nil {
+		log.ErrorWithContext(c, "getNotificationChanges: failed", err)
+		h.HandleError(c, err, constant.FAILED_TO_SYNC_NOTIFICATIONS_MSG)
+		return
+	}
+
+	h.Success(c, http.StatusOK, constant.NOTIFICATIONS_SYNCED_MSG, response)
+}
+
+// UpdateReadState handles marking notifications read/unread from a device
+// POST /api/notification/sync/read
+func (h *NotificationSyncHandler) UpdateReadState(c *gin.Context) {
+	userID, exists := auth.GetUserIDFromContext(c)
+	if !exists {
+		h.HandleError(c, errs.UnauthorizedError, constants.AUTHENTICATION_REQUIRED_MSG)
+		return
+	}
+
+	var req model.NotificationReadStateRequest
+	if err := h.BindJSON(c, &req); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	response, err := h.syncService.UpdateReadState(c, userID, req)
+	if err != nil {
+		log.ErrorWithContext(c, "updateNotificationReadState: failed", err)
+		h.HandleError(c, err, constant.FAILED_TO_UPDATE_READ_STATE_MSG)
+		return
+	}
+
+	h.Success(c, http.StatusOK, constant.READ_STATE_UPDATED_MSG, response)
+}
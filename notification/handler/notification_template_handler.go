@@ -0,0 +1,170 @@
+package handler
+
+import (
+	"net/http"
+
+	"ecommerce-be/common/handler"
+	"ecommerce-be/common/log"
+	"ecommerce-be/notification/model"
+	"ecommerce-be/notification/service"
+	"ecommerce-be/notification/utils/constant"
+
+	"github.com/gin-gonic/gin"
+)
+
+// NotificationTemplateHandler handles admin notification template requests
+type NotificationTemplateHandler struct {
+	*handler.BaseHandler
+	templateService service.NotificationTemplateService
+}
+
+// NewNotificationTemplateHandler creates a new instance of NotificationTemplateHandler
+func NewNotificationTemplateHandler(
+	templateService service.NotificationTemplateService,
+) *NotificationTemplateHandler {
+	return &NotificationTemplateHandler{
+		BaseHandler:     handler.NewBaseHandler(),
+		templateService: templateService,
+	}
+}
+
+// ListTemplates handles listing custom templates
+// GET /api/notification/admin/template
+func (h *NotificationTemplateHandler) ListTemplates(c *gin.Context) {
+	var params model.TemplateQueryParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	response, err := h.templateService.ListTemplates(c, params)
+	if err != nil {
+		log.ErrorWithContext(c, "listTemplates: failed", err)
+		h.HandleError(c, err, constant.FAILED_TO_LIST_TEMPLATES_MSG)
+		return
+	}
+
+	h.SuccessWithData(
+		c,
+		http.StatusOK,
+		constant.TEMPLATES_LISTED_MSG,
+		constant.TEMPLATES_FIELD_NAME,
+		response,
+	)
+}
+
+// GetTemplateByID handles getting a custom template by ID
+// GET /api/notification/admin/template/:id
+func (h *NotificationTemplateHandler) GetTemplateByID(c *gin.Context) {
+	templateID, err := h.ParseUintParam(c, "id")
+	if err != nil {
+		h.HandleError(c, err, constant.INVALID_TEMPLATE_ID_MSG)
+		return
+	}
+
+	response, err := h.templateService.GetTemplateByID(c, templateID)
+	if err != nil {
+		h.HandleError(c, err, constant.FAILED_TO_GET_TEMPLATE_MSG)
+		return
+	}
+
+	h.SuccessWithData(
+		c,
+		http.StatusOK,
+		constant.TEMPLATE_RETRIEVED_MSG,
+		constant.TEMPLATE_FIELD_NAME,
+		response,
+	)
+}
+
+// CreateTemplate handles creating a custom template
+// POST /api/notification/admin/template
+func (h *NotificationTemplateHandler) CreateTemplate(c *gin.Context) {
+	var req model.TemplateCreateRequest
+	if err := h.BindJSON(c, &req); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	response, err := h.templateService.CreateTemplate(c, req)
+	if err != nil {
+		log.ErrorWithContext(c, "createTemplate: failed", err)
+		h.HandleError(c, err, constant.FAILED_TO_CREATE_TEMPLATE_MSG)
+		return
+	}
+
+	h.SuccessWithData(
+		c,
+		http.StatusCreated,
+		constant.TEMPLATE_CREATED_MSG,
+		constant.TEMPLATE_FIELD_NAME,
+		response,
+	)
+}
+
+// UpdateTemplate handles updating a custom template
+// PUT /api/notification/admin/template/:id
+func (h *NotificationTemplateHandler) UpdateTemplate(c *gin.Context) {
+	templateID, err := h.ParseUintParam(c, "id")
+	if err != nil {
+		h.HandleError(c, err, constant.INVALID_TEMPLATE_ID_MSG)
+		return
+	}
+
+	var req model.TemplateUpdateRequest
+	if err := h.BindJSON(c, &req); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	response, err := h.templateService.UpdateTemplate(c, templateID, req)
+	if err != nil {
+		log.ErrorWithContext(c, "updateTemplate: failed", err)
+		h.HandleError(c, err, constant.FAILED_TO_UPDATE_TEMPLATE_MSG)
+		return
+	}
+
+	h.SuccessWithData(
+		c,
+		http.StatusOK,
+		constant.TEMPLATE_UPDATED_MSG,
+		constant.TEMPLATE_FIELD_NAME,
+		response,
+	)
+}
+
+// DeleteTemplate handles deleting a custom template
+// DELETE /api/notification/admin/template/:id
+func (h *NotificationTemplateHandler) DeleteTemplate(c *gin.Context) {
+	templateID, err := h.ParseUintParam(c, "id")
+	if err != nil {
+		h.HandleError(c, err, constant.INVALID_TEMPLATE_ID_MSG)
+		return
+	}
+
+	if err := h.templateService.DeleteTemplate(c, templateID); err != nil {
+		log.ErrorWithContext(c, "deleteTemplate: failed", err)
+		h.HandleError(c, err, constant.FAILED_TO_DELETE_TEMPLATE_MSG)
+		return
+	}
+
+	h.Success(c, http.StatusOK, constant.TEMPLATE_DELETED_MSG, nil)
+}
+
+// PreviewTemplate handles rendering a draft or effective template with sample data
+// POST /api/notification/admin/template/preview
+func (h *NotificationTemplateHandler) PreviewTemplate(c *gin.Context) {
+	var req model.TemplatePreviewRequest
+	if err := h.BindJSON(c, &req); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	response, err := h.templateService.PreviewTemplate(c, req)
+	if err != nil {
+		h.HandleError(c, err, constant.FAILED_TO_RENDER_TEMPLATE_MSG)
+		return
+	}
+
+	h.Success(c, http.StatusOK, constant.TEMPLATE_RENDERED_MSG, response)
+}
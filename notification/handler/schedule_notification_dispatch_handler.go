@@ -0,0 +1,41 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+
+	"ecommerce-be/common/log"
+	"ecommerce-be/notification/model"
+	"ecommerce-be/notification/service"
+)
+
+type ScheduleNotificationDispatchHandler struct {
+	dispatchService service.NotificationDispatchService
+}
+
+func NewScheduleNotificationDispatchHandler(
+	dispatchService service.NotificationDispatchService,
+) *ScheduleNotificationDispatchHandler {
+	return &ScheduleNotificationDispatchHandler{
+		dispatchService: dispatchService,
+	}
+}
+
+// DispatchNotification delivers a notification queued by NotificationDispatchService.Notify
+func (h *ScheduleNotificationDispatchHandler) DispatchNotification(
+	ctx context.Context,
+	payload json.RawMessage,
+) error {
+	var dispatchPayload model.NotificationDispatchPayload
+	if err := json.Unmarshal(payload, &dispatchPayload); err != nil {
+		log.ErrorWithContext(ctx, "Failed to unmarshal notification dispatch payload", err)
+		return err
+	}
+
+	if err := h.dispatchService.Dispatch(ctx, dispatchPayload); err != nil {
+		log.ErrorWithContext(ctx, "Failed to dispatch notification", err)
+		return err
+	}
+
+	return nil
+}
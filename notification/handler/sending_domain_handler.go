@@ -0,0 +1,207 @@
+package handler
+
+import (
+	"net/http"
+
+	"ecommerce-be/common/auth"
+	"ecommerce-be/common/constants"
+	"ecommerce-be/common/handler"
+	"ecommerce-be/common/log"
+	"ecommerce-be/notification/model"
+	"ecommerce-be/notification/service"
+	"ecommerce-be/notification/utils/constant"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SendingDomainHandler handles seller sending domain requests and their admin review
+type SendingDomainHandler struct {
+	*handler.BaseHandler
+	domainService service.SendingDomainService
+}
+
+// NewSendingDomainHandler creates a new instance of SendingDomainHandler
+func NewSendingDomainHandler(domainService service.SendingDomainService) *SendingDomainHandler {
+	return &SendingDomainHandler{
+		BaseHandler:   handler.NewBaseHandler(),
+		domainService: domainService,
+	}
+}
+
+// GetSendingDomain handles fetching the seller's sending domain
+// GET /api/notification/sending-domain
+func (h *SendingDomainHandler) GetSendingDomain(c *gin.Context) {
+	_, sellerID, err := auth.ValidateUserHasSellerRoleOrHigherAndReturnAuthData(c)
+	if err != nil {
+		h.HandleError(c, err, constants.UNAUTHORIZED_ERROR_MSG)
+		return
+	}
+
+	response, err := h.domainService.GetSendingDomain(c, sellerID)
+	if err != nil {
+		h.HandleError(c, err, constant.FAILED_TO_GET_SENDING_DOMAIN_MSG)
+		return
+	}
+
+	h.SuccessWithData(
+		c,
+		http.StatusOK,
+		constant.SENDING_DOMAIN_FETCHED_MSG,
+		constant.SENDING_DOMAIN_FIELD_NAME,
+		response,
+	)
+}
+
+// SaveSendingDomain handles requesting or replacing the seller's sending domain
+// PUT /api/notification/sending-domain
+func (h *SendingDomainHandler) SaveSendingDomain(c *gin.Context) {
+	_, sellerID, err := auth.ValidateUserHasSellerRoleOrHigherAndReturnAuthData(c)
+	if err != nil {
+		h.HandleError(c, err, constants.UNAUTHORIZED_ERROR_MSG)
+		return
+	}
+
+	var req model.SaveSendingDomainRequest
+	if err := h.BindJSON(c, &req); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	response, err := h.domainService.SaveSendingDomain(c, sellerID, req)
+	if err != nil {
+		log.ErrorWithContext(c, "saveSendingDomain: failed", err)
+		h.HandleError(c, err, constant.FAILED_TO_SAVE_SENDING_DOMAIN_MSG)
+		return
+	}
+
+	h.SuccessWithData(
+		c,
+		http.StatusOK,
+		constant.SENDING_DOMAIN_SAVED_MSG,
+		constant.SENDING_DOMAIN_FIELD_NAME,
+		response,
+	)
+}
+
+// DeleteSendingDomain handles removing the seller's sending domain
+// DELETE /api/notification/sending-domain
+func (h *SendingDomainHandler) DeleteSendingDomain(c *gin.Context) {
+	_, sellerID, err := auth.ValidateUserHasSellerRoleOrHigherAndReturnAuthData(c)
+	if err != nil {
+		h.HandleError(c, err, constants.UNAUTHORIZED_ERROR_MSG)
+		return
+	}
+
+	if err := h.domainService.DeleteSendingDomain(c, sellerID); err != nil {
+		h.HandleError(c, err, constant.FAILED_TO_REMOVE_SENDING_DOMAIN_MSG)
+		return
+	}
+
+	h.Success(c, http.StatusOK, constant.SENDING_DOMAIN_REMOVED_MSG, nil)
+}
+
+// VerifySellerSendingDomain handles re-checking the DNS records of the seller's domain
+// POST /api/notification/sending-domain/verify
+func (h *SendingDomainHandler) VerifySellerSendingDomain(c *gin.Context) {
+	_, sellerID, err := auth.ValidateUserHasSellerRoleOrHigherAndReturnAuthData(c)
+	if err != nil {
+		h.HandleError(c, err, constants.UNAUTHORIZED_ERROR_MSG)
+		return
+	}
+
+	response, err := h.domainService.VerifySellerSendingDomain(c, sellerID)
+	if err != nil {
+		log.ErrorWithContext(c, "verifySellerSendingDomain: failed", err)
+		h.HandleError(c, err, constant.FAILED_TO_VERIFY_SENDING_DOMAIN_MSG)
+		return
+	}
+
+	h.SuccessWithData(
+		c,
+		http.StatusOK,
+		constant.SENDING_DOMAIN_CHECKED_MSG,
+		constant.SENDING_DOMAIN_FIELD_NAME,
+		response,
+	)
+}
+
+// ListSendingDomains handles listing all sellers' sending domains
+// GET /api/notification/admin/sending-domain
+func (h *SendingDomainHandler) ListSendingDomains(c *gin.Context) {
+	var params model.SendingDomainQueryParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	response, err := h.domainService.ListSendingDomains(c, params)
+	if err != nil {
+		log.ErrorWithContext(c, "listSendingDomains: failed", err)
+		h.HandleError(c, err, constant.FAILED_TO_LIST_SENDING_DOMAINS_MSG)
+		return
+	}
+
+	h.SuccessWithData(
+		c,
+		http.StatusOK,
+		constant.SENDING_DOMAINS_LISTED_MSG,
+		constant.SENDING_DOMAINS_FIELD_NAME,
+		response,
+	)
+}
+
+// ConfigureDKIM handles recording the provider-issued DKIM selector for a domain
+// PUT /api/notification/admin/sending-domain/:id/dkim
+func (h *SendingDomainHandler) ConfigureDKIM(c *gin.Context) {
+	domainID, err := h.ParseUintParam(c, "id")
+	if err != nil {
+		h.HandleError(c, err, constant.INVALID_SENDING_DOMAIN_ID_MSG)
+		return
+	}
+
+	var req model.ConfigureSendingDomainDKIMRequest
+	if err := h.BindJSON(c, &req); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	response, err := h.domainService.ConfigureDKIM(c, domainID, req)
+	if err != nil {
+		log.ErrorWithContext(c, "configureDKIM: failed", err)
+		h.HandleError(c, err, constant.FAILED_TO_SAVE_SENDING_DOMAIN_MSG)
+		return
+	}
+
+	h.SuccessWithData(
+		c,
+		http.StatusOK,
+		constant.SENDING_DOMAIN_SAVED_MSG,
+		constant.SENDING_DOMAIN_FIELD_NAME,
+		response,
+	)
+}
+
+// VerifySendingDomain handles checking the DNS records of a seller's domain
+// POST /api/notification/admin/sending-domain/:id/verify
+func (h *SendingDomainHandler) VerifySendingDomain(c *gin.Context) {
+	domainID, err := h.ParseUintParam(c, "id")
+	if err != nil {
+		h.HandleError(c, err, constant.INVALID_SENDING_DOMAIN_ID_MSG)
+		return
+	}
+
+	response, err := h.domainService.VerifySendingDomain(c, domainID)
+	if err != nil {
+		log.ErrorWithContext(c, "verifySendingDomain: failed", err)
+		h.HandleError(c, err, constant.FAILED_TO_VERIFY_SENDING_DOMAIN_MSG)
+		return
+	}
+
+	h.SuccessWithData(
+		c,
+		http.StatusOK,
+		constant.SENDING_DOMAIN_CHECKED_MSG,
+		constant.SENDING_DOMAIN_FIELD_NAME,
+		response,
+	)
+}
@@ -0,0 +1,50 @@
+package model
+
+import "ecommerce-be/common"
+
+// ========================================
+// REQUEST MODELS
+// ========================================
+
+// NotificationInboxQueryParams - Page of the notification center, newest first
+type NotificationInboxQueryParams struct {
+	Page       int  `form:"page"       binding:"omitempty,min=1"`
+	PageSize   int  `form:"pageSize"   binding:"omitempty,min=1,max=100"`
+	UnreadOnly bool `form:"unreadOnly"`
+}
+
+// MarkNotificationsReadRequest - Marks the given notifications, or all, as read
+type MarkNotificationsReadRequest struct {
+	IDs []uint `json:"ids" binding:"omitempty,max=500"`
+	All bool   `json:"all"`
+}
+
+// ClearNotificationsRequest - Clears the given notifications, or all of them.
+// With all=true, readOnly limits the clear to notifications already read.
+type ClearNotificationsRequest struct {
+	IDs      []uint `json:"ids"      binding:"omitempty,max=500"`
+	All      bool   `json:"all"`
+	ReadOnly bool   `json:"readOnly"`
+}
+
+// ========================================
+// RESPONSE MODELS
+// ========================================
+
+// NotificationInboxResponse is a page of the user's visible notifications
+type NotificationInboxResponse struct {
+	Notifications []UserNotificationResponse `json:"notifications"`
+	Pagination    common.PaginationResponse  `json:"pagination"`
+	UnreadCount   int64                      `json:"unreadCount"`
+}
+
+// NotificationBulkActionResponse reports the outcome of a mark-read or clear
+type NotificationBulkActionResponse struct {
+	Updated     int64 `json:"updated"`
+	UnreadCount int64 `json:"unreadCount"`
+}
+
+// UnreadCountResponse backs the notification badge
+type UnreadCountResponse struct {
+	UnreadCount int64 `json:"unreadCount"`
+}
@@ -0,0 +1,77 @@
+package model
+
+import (
+	"ecommerce-be/notification/entity"
+)
+
+// ========================================
+// REQUEST MODELS
+// ========================================
+
+// ChannelPreferenceItem - Enables or disables one delivery channel
+type ChannelPreferenceItem struct {
+	Channel   entity.NotificationChannel `json:"channel"   binding:"required"`
+	IsEnabled *bool                      `json:"isEnabled" binding:"required"`
+}
+
+// UpdateChannelPreferencesRequest - User updates one or more channel preferences
+type UpdateChannelPreferencesRequest struct {
+	Preferences []ChannelPreferenceItem `json:"preferences" binding:"required,min=1,max=10,dive"`
+}
+
+// CategoryPreferenceItem - Enables or disables one notification category
+type CategoryPreferenceItem struct {
+	Category  entity.NotificationCategory `json:"category"  binding:"required"`
+	IsEnabled *bool                       `json:"isEnabled" binding:"required"`
+}
+
+// UpdateCategoryPreferencesRequest - User updates one or more category preferences
+type UpdateCategoryPreferencesRequest struct {
+	Preferences []CategoryPreferenceItem `json:"preferences" binding:"required,min=1,max=10,dive"`
+}
+
+// UnsubscribeQueryParams - Signed token from a one-click unsubscribe link
+type UnsubscribeQueryParams struct {
+	Token string `form:"token" binding:"required,max=512"`
+}
+
+// RegisterPushTokenRequest - Device registers (or refreshes) its FCM token
+type RegisterPushTokenRequest struct {
+	Token    string              `json:"token"    binding:"required,max=512"`
+	Platform entity.PushPlatform `json:"platform" binding:"required,oneof=android ios web"`
+}
+
+// RemovePushTokenRequest - Device unregisters its FCM token (e.g. on logout)
+type RemovePushTokenRequest struct {
+	Token string `json:"token" binding:"required,max=512"`
+}
+
+// NotificationDispatchPayload is the scheduler job payload for one notification.
+type NotificationDispatchPayload struct {
+	EventType entity.NotificationEventType `json:"eventType"`
+	UserID    uint                         `json:"userId"`
+	Data      map[string]any               `json:"data"`
+}
+
+// ========================================
+// RESPONSE MODELS
+// ========================================
+
+type ChannelPreferenceResponse struct {
+	Channel   entity.NotificationChannel `json:"channel"`
+	IsEnabled bool                       `json:"isEnabled"`
+}
+
+type CategoryPreferenceResponse struct {
+	Category  entity.NotificationCategory `json:"category"`
+	IsEnabled bool                        `json:"isEnabled"`
+}
+
+type UnsubscribeResponse struct {
+	Category entity.NotificationCategory `json:"category"`
+}
+
+type PushTokenResponse struct {
+	Platform   entity.PushPlatform `json:"platform"`
+	LastSeenAt string              `json:"lastSeenAt"`
+}
@@ -0,0 +1,68 @@
+package model
+
+import (
+	"time"
+
+	"ecommerce-be/notification/entity"
+)
+
+// ========================================
+// REQUEST MODELS
+// ========================================
+
+// NotificationSyncQueryParams - Delta query for a device's notification list.
+// When Since is omitted the device's stored cursor is used; a device that has
+// never synced receives its full history page by page.
+type NotificationSyncQueryParams struct {
+	DeviceID string     `form:"deviceId" binding:"required,max=100"`
+	Since    *time.Time `form:"since"    time_format:"2006-01-02T15:04:05Z07:00"`
+	AfterID  *uint      `form:"afterId"`
+	Limit    int        `form:"limit"    binding:"omitempty,min=1,max=200"`
+}
+
+// NotificationReadStateRequest - Marks notifications read/unread from a device
+type NotificationReadStateRequest struct {
+	DeviceID string `json:"deviceId" binding:"required,max=100"`
+	Read     []uint `json:"read"     binding:"omitempty,max=500"`
+	Unread   []uint `json:"unread"   binding:"omitempty,max=500"`
+}
+
+// ========================================
+// RESPONSE MODELS
+// ========================================
+
+type UserNotificationResponse struct {
+	ID        uint                         `json:"id"`
+	EventType entity.NotificationEventType `json:"eventType"`
+	Title     string                       `json:"title"`
+	Body      string                       `json:"body"`
+	Data      map[string]any               `json:"data"`
+	IsRead    bool                         `json:"isRead"`
+	ReadAt    *string                      `json:"readAt"`
+	IsCleared bool                         `json:"isCleared"`
+	ClearedAt *string                      `json:"clearedAt"`
+	CreatedAt string                       `json:"createdAt"`
+	UpdatedAt string                       `json:"updatedAt"`
+}
+
+// SyncCursor identifies the last change a device has applied. Clients pass it
+// back as ?since=&afterId= to continue from the same position.
+type SyncCursor struct {
+	Since   string `json:"since"`
+	AfterID uint   `json:"afterId"`
+}
+
+// NotificationSyncResponse is a page of changes ordered by (updatedAt, id)
+type NotificationSyncResponse struct {
+	Changes     []UserNotificationResponse `json:"changes"`
+	NextCursor  SyncCursor                 `json:"nextCursor"`
+	HasMore     bool                       `json:"hasMore"`
+	UnreadCount int64                      `json:"unreadCount"`
+	ServerTime  string                     `json:"serverTime"`
+}
+
+// NotificationReadStateResponse reports the outcome of a read-state update
+type NotificationReadStateResponse struct {
+	Updated     int64 `json:"updated"`
+	UnreadCount int64 `json:"unreadCount"`
+}
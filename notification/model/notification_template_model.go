@@ -0,0 +1,68 @@
+package model
+
+import (
+	"ecommerce-be/notification/entity"
+)
+
+// ========================================
+// REQUEST MODELS
+// ========================================
+
+// TemplateCreateRequest - Admin creates a template overriding a built-in default
+type TemplateCreateRequest struct {
+	EventType entity.NotificationEventType `json:"eventType" binding:"required,max=100"`
+	Channel   entity.NotificationChannel   `json:"channel"   binding:"required"`
+	Subject   string                       `json:"subject"   binding:"max=500"`
+	Body      string                       `json:"body"      binding:"required"`
+	IsActive  *bool                        `json:"isActive"` // Optional, defaults to true
+}
+
+// TemplateUpdateRequest - Admin updates a template (all fields optional)
+type TemplateUpdateRequest struct {
+	Subject  *string `json:"subject"  binding:"omitempty,max=500"`
+	Body     *string `json:"body"     binding:"omitempty,min=1"`
+	IsActive *bool   `json:"isActive"`
+}
+
+// TemplateQueryParams - Filters for listing templates
+type TemplateQueryParams struct {
+	EventType *string `form:"eventType"`
+	Channel   *string `form:"channel"`
+	IsActive  *bool   `form:"isActive"`
+}
+
+// TemplatePreviewRequest renders a template with sample data. When Subject/Body are
+// provided they are rendered as a draft; otherwise the effective template (custom
+// or built-in default) for the event type and channel is used.
+type TemplatePreviewRequest struct {
+	EventType entity.NotificationEventType `json:"eventType" binding:"required,max=100"`
+	Channel   entity.NotificationChannel   `json:"channel"   binding:"required"`
+	Subject   *string                      `json:"subject"`
+	Body      *string                      `json:"body"`
+	Data      map[string]any               `json:"data"`
+}
+
+// ========================================
+// RESPONSE MODELS
+// ========================================
+
+type TemplateResponse struct {
+	ID        uint                         `json:"id"`
+	EventType entity.NotificationEventType `json:"eventType"`
+	Channel   entity.NotificationChannel   `json:"channel"`
+	Subject   string                       `json:"subject"`
+	Body      string                       `json:"body"`
+	IsActive  bool                         `json:"isActive"`
+	CreatedAt string                       `json:"createdAt"`
+	UpdatedAt string                       `json:"updatedAt"`
+}
+
+// RenderedNotification is a template rendered for delivery or preview.
+// Source is "custom", "default", or "draft".
+type RenderedNotification struct {
+	EventType entity.NotificationEventType `json:"eventType"`
+	Channel   entity.NotificationChannel   `json:"channel"`
+	Subject   string                       `json:"subject"`
+	Body      string                       `json:"body"`
+	Source    string                       `json:"source"`
+}
@@ -0,0 +1,55 @@
+package model
+
+import (
+	"ecommerce-be/notification/entity"
+)
+
+// ========================================
+// REQUEST MODELS
+// ========================================
+
+// SaveSendingDomainRequest - Seller requests (or replaces) their sending domain.
+// Replacing it resets verification until an admin configures DKIM again.
+type SaveSendingDomainRequest struct {
+	Domain      string `json:"domain"      binding:"required,max=253"`
+	FromAddress string `json:"fromAddress" binding:"required,email,max=320"`
+	FromName    string `json:"fromName"    binding:"max=100"`
+}
+
+// ConfigureSendingDomainDKIMRequest - Admin records the DKIM selector (and record
+// value, when the provider issues one) after registering the domain with the provider
+type ConfigureSendingDomainDKIMRequest struct {
+	Selector    string `json:"selector"    binding:"required,max=63"`
+	RecordValue string `json:"recordValue" binding:"max=4096"`
+}
+
+// SendingDomainQueryParams - Filters for the admin sending domain list
+type SendingDomainQueryParams struct {
+	Status *entity.SendingDomainStatus `form:"status"`
+}
+
+// ========================================
+// RESPONSE MODELS
+// ========================================
+
+// SendingDomainDNSRecord is a record the seller must publish for verification
+type SendingDomainDNSRecord struct {
+	Purpose  string `json:"purpose"`
+	Type     string `json:"type"`
+	Name     string `json:"name"`
+	Value    string `json:"value"`
+	Verified bool   `json:"verified"`
+}
+
+type SendingDomainResponse struct {
+	ID             uint                       `json:"id"`
+	SellerID       uint                       `json:"sellerId"`
+	Domain         string                     `json:"domain"`
+	FromAddress    string                     `json:"fromAddress"`
+	FromName       string                     `json:"fromName"`
+	Status         entity.SendingDomainStatus `json:"status"`
+	DNSRecords     []SendingDomainDNSRecord   `json:"dnsRecords"`
+	LastCheckError string                     `json:"lastCheckError,omitempty"`
+	LastCheckedAt  *string                    `json:"lastCheckedAt"`
+	VerifiedAt     *string                    `json:"verifiedAt"`
+}
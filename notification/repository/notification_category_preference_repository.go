@@ -0,0 +1,51 @@
+package repository
+
+import (
+	"context"
+
+	"ecommerce-be/common/db"
+	"ecommerce-be/notification/entity"
+
+	"gorm.io/gorm/clause"
+)
+
+// NotificationCategoryPreferenceRepository defines database operations for category preferences
+type NotificationCategoryPreferenceRepository interface {
+	FindByUserID(ctx context.Context, userID uint) ([]entity.NotificationCategoryPreference, error)
+	UpsertBatch(ctx context.Context, prefs []entity.NotificationCategoryPreference) error
+}
+
+// NotificationCategoryPreferenceRepositoryImpl implements the category preference repository
+type NotificationCategoryPreferenceRepositoryImpl struct{}
+
+// NewNotificationCategoryPreferenceRepository creates a new NotificationCategoryPreferenceRepository
+func NewNotificationCategoryPreferenceRepository() NotificationCategoryPreferenceRepository {
+	return &NotificationCategoryPreferenceRepositoryImpl{}
+}
+
+// FindByUserID returns the user's explicit category preferences
+func (r *NotificationCategoryPreferenceRepositoryImpl) FindByUserID(
+	ctx context.Context,
+	userID uint,
+) ([]entity.NotificationCategoryPreference, error) {
+	var prefs []entity.NotificationCategoryPreference
+	err := db.DB(ctx).Where("user_id = ?", userID).Find(&prefs).Error
+	if err != nil {
+		return nil, err
+	}
+	return prefs, nil
+}
+
+// UpsertBatch creates or updates preferences keyed by (user_id, category)
+func (r *NotificationCategoryPreferenceRepositoryImpl) UpsertBatch(
+	ctx context.Context,
+	prefs []entity.NotificationCategoryPreference,
+) error {
+	if len(prefs) == 0 {
+		return nil
+	}
+	return db.DB(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}, {Name: "category"}},
+		DoUpdates: clause.AssignmentColumns([]string{"is_enabled", "updated_at"}),
+	}).Create(&prefs).Error
+}
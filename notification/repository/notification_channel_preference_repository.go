@@ -0,0 +1,51 @@
+package repository
+
+import (
+	"context"
+
+	"ecommerce-be/common/db"
+	"ecommerce-be/notification/entity"
+
+	"gorm.io/gorm/clause"
+)
+
+// NotificationChannelPreferenceRepository defines database operations for channel preferences
+type NotificationChannelPreferenceRepository interface {
+	FindByUserID(ctx context.Context, userID uint) ([]entity.NotificationChannelPreference, error)
+	UpsertBatch(ctx context.Context, prefs []entity.NotificationChannelPreference) error
+}
+
+// NotificationChannelPreferenceRepositoryImpl implements NotificationChannelPreferenceRepository
+type NotificationChannelPreferenceRepositoryImpl struct{}
+
+// NewNotificationChannelPreferenceRepository creates a new NotificationChannelPreferenceRepository
+func NewNotificationChannelPreferenceRepository() NotificationChannelPreferenceRepository {
+	return &NotificationChannelPreferenceRepositoryImpl{}
+}
+
+// FindByUserID returns the user's explicit channel preferences
+func (r *NotificationChannelPreferenceRepositoryImpl) FindByUserID(
+	ctx context.Context,
+	userID uint,
+) ([]entity.NotificationChannelPreference, error) {
+	var prefs []entity.NotificationChannelPreference
+	err := db.DB(ctx).Where("user_id = ?", userID).Find(&prefs).Error
+	if err != nil {
+		return nil, err
+	}
+	return prefs, nil
+}
+
+// UpsertBatch creates or updates preferences keyed by (user_id, channel)
+func (r *NotificationChannelPreferenceRepositoryImpl) UpsertBatch(
+	ctx context.Context,
+	prefs []entity.NotificationChannelPreference,
+) error {
+	if len(prefs) == 0 {
+		return nil
+	}
+	return db.DB(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}, {Name: "channel"}},
+		DoUpdates: clause.AssignmentColumns([]string{"is_enabled", "updated_at"}),
+	}).Create(&prefs).Error
+}
@@ -0,0 +1,65 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"ecommerce-be/common/db"
+	"ecommerce-be/notification/entity"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// NotificationDeviceCursorRepository defines database operations for device sync cursors
+type NotificationDeviceCursorRepository interface {
+	FindByUserAndDevice(
+		ctx context.Context,
+		userID uint,
+		deviceID string,
+	) (*entity.NotificationDeviceCursor, error)
+	Upsert(ctx context.Context, cursor *entity.NotificationDeviceCursor) error
+}
+
+// NotificationDeviceCursorRepositoryImpl implements NotificationDeviceCursorRepository
+type NotificationDeviceCursorRepositoryImpl struct{}
+
+// NewNotificationDeviceCursorRepository creates a new NotificationDeviceCursorRepository
+func NewNotificationDeviceCursorRepository() NotificationDeviceCursorRepository {
+	return &NotificationDeviceCursorRepositoryImpl{}
+}
+
+// FindByUserAndDevice finds the cursor for a user's device.
+// Returns nil, nil when the device has never synced.
+func (r *NotificationDeviceCursorRepositoryImpl) FindByUserAndDevice(
+	ctx context.Context,
+	userID uint,
+	deviceID string,
+) (*entity.NotificationDeviceCursor, error) {
+	var cursor entity.NotificationDeviceCursor
+	result := db.DB(ctx).
+		Where("user_id = ? AND device_id = ?", userID, deviceID).
+		First(&cursor)
+
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, result.Error
+	}
+
+	return &cursor, nil
+}
+
+// Upsert creates or moves the cursor for (user_id, device_id)
+func (r *NotificationDeviceCursorRepositoryImpl) Upsert(
+	ctx context.Context,
+	cursor *entity.NotificationDeviceCursor,
+) error {
+	return db.DB(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "user_id"}, {Name: "device_id"}},
+		DoUpdates: clause.AssignmentColumns(
+			[]string{"last_synced_at", "last_synced_id", "updated_at"},
+		),
+	}).Create(cursor).Error
+}
@@ -0,0 +1,132 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"ecommerce-be/common/db"
+	"ecommerce-be/notification/entity"
+	notificationErrors "ecommerce-be/notification/error"
+	"ecommerce-be/notification/model"
+
+	"gorm.io/gorm"
+)
+
+// NotificationTemplateRepository defines database operations for notification templates
+type NotificationTemplateRepository interface {
+	FindAll(
+		ctx context.Context,
+		filter model.TemplateQueryParams,
+	) ([]entity.NotificationTemplate, error)
+	FindByID(ctx context.Context, id uint) (*entity.NotificationTemplate, error)
+	FindByEventAndChannel(
+		ctx context.Context,
+		eventType entity.NotificationEventType,
+		channel entity.NotificationChannel,
+	) (*entity.NotificationTemplate, error)
+
+	Create(ctx context.Context, tmpl *entity.NotificationTemplate) error
+	Update(ctx context.Context, tmpl *entity.NotificationTemplate) error
+	Delete(ctx context.Context, id uint) error
+}
+
+// NotificationTemplateRepositoryImpl implements NotificationTemplateRepository
+type NotificationTemplateRepositoryImpl struct{}
+
+// NewNotificationTemplateRepository creates a new instance of NotificationTemplateRepository
+func NewNotificationTemplateRepository() NotificationTemplateRepository {
+	return &NotificationTemplateRepositoryImpl{}
+}
+
+// FindAll finds all templates with optional filters
+func (r *NotificationTemplateRepositoryImpl) FindAll(
+	ctx context.Context,
+	filter model.TemplateQueryParams,
+) ([]entity.NotificationTemplate, error) {
+	var templates []entity.NotificationTemplate
+	query := db.DB(ctx).Model(&entity.NotificationTemplate{})
+
+	if filter.EventType != nil && strings.TrimSpace(*filter.EventType) != "" {
+		query = query.Where("event_type = ?", strings.TrimSpace(*filter.EventType))
+	}
+	if filter.Channel != nil && strings.TrimSpace(*filter.Channel) != "" {
+		query = query.Where("channel = ?", strings.ToLower(strings.TrimSpace(*filter.Channel)))
+	}
+	if filter.IsActive != nil {
+		query = query.Where("is_active = ?", *filter.IsActive)
+	}
+
+	if err := query.Order("event_type ASC, channel ASC").Find(&templates).Error; err != nil {
+		return nil, err
+	}
+	return templates, nil
+}
+
+// FindByID finds a template by ID
+func (r *NotificationTemplateRepositoryImpl) FindByID(
+	ctx context.Context,
+	id uint,
+) (*entity.NotificationTemplate, error) {
+	var tmpl entity.NotificationTemplate
+	result := db.DB(ctx).First(&tmpl, id)
+
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, notificationErrors.ErrTemplateNotFound
+		}
+		return nil, result.Error
+	}
+
+	return &tmpl, nil
+}
+
+// FindByEventAndChannel finds the template for an event type and channel.
+// Returns nil, nil when no template exists.
+func (r *NotificationTemplateRepositoryImpl) FindByEventAndChannel(
+	ctx context.Context,
+	eventType entity.NotificationEventType,
+	channel entity.NotificationChannel,
+) (*entity.NotificationTemplate, error) {
+	var tmpl entity.NotificationTemplate
+	result := db.DB(ctx).
+		Where("event_type = ? AND channel = ?", eventType, channel).
+		First(&tmpl)
+
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, result.Error
+	}
+
+	return &tmpl, nil
+}
+
+// Create creates a new template
+func (r *NotificationTemplateRepositoryImpl) Create(
+	ctx context.Context,
+	tmpl *entity.NotificationTemplate,
+) error {
+	return db.DB(ctx).Create(tmpl).Error
+}
+
+// Update updates an existing template
+func (r *NotificationTemplateRepositoryImpl) Update(
+	ctx context.Context,
+	tmpl *entity.NotificationTemplate,
+) error {
+	return db.DB(ctx).Save(tmpl).Error
+}
+
+// Delete deletes a template by ID
+func (r *NotificationTemplateRepositoryImpl) Delete(ctx context.Context, id uint) error {
+	result := db.DB(ctx).Delete(&entity.NotificationTemplate{}, id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return notificationErrors.ErrTemplateNotFound
+	}
+	return nil
+}
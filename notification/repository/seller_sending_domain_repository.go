@@ -0,0 +1,100 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"ecommerce-be/common/db"
+	"ecommerce-be/notification/entity"
+	notificationErrors "ecommerce-be/notification/error"
+
+	"gorm.io/gorm"
+)
+
+// SellerSendingDomainRepository defines database operations for seller sending domains
+type SellerSendingDomainRepository interface {
+	FindBySellerID(ctx context.Context, sellerID uint) (*entity.SellerSendingDomain, error)
+	FindByID(ctx context.Context, id uint) (*entity.SellerSendingDomain, error)
+	FindAll(
+		ctx context.Context,
+		status *entity.SendingDomainStatus,
+	) ([]entity.SellerSendingDomain, error)
+	Save(ctx context.Context, domain *entity.SellerSendingDomain) error
+	DeleteBySellerID(ctx context.Context, sellerID uint) (bool, error)
+}
+
+// SellerSendingDomainRepositoryImpl implements SellerSendingDomainRepository
+type SellerSendingDomainRepositoryImpl struct{}
+
+// NewSellerSendingDomainRepository creates a new instance of SellerSendingDomainRepository
+func NewSellerSendingDomainRepository() SellerSendingDomainRepository {
+	return &SellerSendingDomainRepositoryImpl{}
+}
+
+// FindBySellerID returns the seller's sending domain
+func (r *SellerSendingDomainRepositoryImpl) FindBySellerID(
+	ctx context.Context,
+	sellerID uint,
+) (*entity.SellerSendingDomain, error) {
+	var domain entity.SellerSendingDomain
+	err := db.DB(ctx).Where("seller_id = ?", sellerID).First(&domain).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, notificationErrors.ErrSendingDomainNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &domain, nil
+}
+
+// FindByID returns a sending domain by ID
+func (r *SellerSendingDomainRepositoryImpl) FindByID(
+	ctx context.Context,
+	id uint,
+) (*entity.SellerSendingDomain, error) {
+	var domain entity.SellerSendingDomain
+	err := db.DB(ctx).First(&domain, id).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, notificationErrors.ErrSendingDomainNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &domain, nil
+}
+
+// FindAll lists sending domains, oldest request first, optionally by status
+func (r *SellerSendingDomainRepositoryImpl) FindAll(
+	ctx context.Context,
+	status *entity.SendingDomainStatus,
+) ([]entity.SellerSendingDomain, error) {
+	query := db.DB(ctx).Model(&entity.SellerSendingDomain{})
+	if status != nil {
+		query = query.Where("status = ?", *status)
+	}
+
+	var domains []entity.SellerSendingDomain
+	if err := query.Order("created_at ASC").Find(&domains).Error; err != nil {
+		return nil, err
+	}
+	return domains, nil
+}
+
+// Save creates or updates a sending domain
+func (r *SellerSendingDomainRepositoryImpl) Save(
+	ctx context.Context,
+	domain *entity.SellerSendingDomain,
+) error {
+	return db.DB(ctx).Save(domain).Error
+}
+
+// DeleteBySellerID removes the seller's sending domain; false when there was none
+func (r *SellerSendingDomainRepositoryImpl) DeleteBySellerID(
+	ctx context.Context,
+	sellerID uint,
+) (bool, error) {
+	result := db.DB(ctx).
+		Where("seller_id = ?", sellerID).
+		Delete(&entity.SellerSendingDomain{})
+	return result.RowsAffected > 0, result.Error
+}
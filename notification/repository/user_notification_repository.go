@@ -0,0 +1,223 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"ecommerce-be/common/db"
+	"ecommerce-be/common/helper"
+	"ecommerce-be/notification/entity"
+
+	"gorm.io/gorm"
+)
+
+// UserNotificationRepository defines database operations for in-app notifications
+type UserNotificationRepository interface {
+	Create(ctx context.Context, notification *entity.UserNotification) error
+
+	// FindChangedSince returns the user's notifications changed after the
+	// (since, afterID) position, ordered by (updated_at, id).
+	FindChangedSince(
+		ctx context.Context,
+		userID uint,
+		since time.Time,
+		afterID uint,
+		limit int,
+	) ([]entity.UserNotification, error)
+	CountUnread(ctx context.Context, userID uint) (int64, error)
+
+	// MarkRead and MarkUnread only touch rows whose state actually changes, so
+	// updated_at (and therefore other devices' deltas) is not bumped needlessly.
+	MarkRead(ctx context.Context, userID uint, ids []uint, readAt time.Time) (int64, error)
+	MarkUnread(ctx context.Context, userID uint, ids []uint, now time.Time) (int64, error)
+
+	// FindVisible returns a page of the user's notifications that have not been
+	// cleared, newest first, together with the total matching count.
+	FindVisible(
+		ctx context.Context,
+		userID uint,
+		unreadOnly bool,
+		page, pageSize int,
+	) ([]entity.UserNotification, int64, error)
+	MarkAllRead(ctx context.Context, userID uint, readAt time.Time) (int64, error)
+
+	// Clear and ClearAll hide notifications from the notification center. Cleared
+	// rows are also marked read so they no longer count towards the badge.
+	Clear(ctx context.Context, userID uint, ids []uint, clearedAt time.Time) (int64, error)
+	ClearAll(ctx context.Context, userID uint, readOnly bool, clearedAt time.Time) (int64, error)
+}
+
+// UserNotificationRepositoryImpl implements UserNotificationRepository
+type UserNotificationRepositoryImpl struct{}
+
+// NewUserNotificationRepository creates a new instance of UserNotificationRepository
+func NewUserNotificationRepository() UserNotificationRepository {
+	return &UserNotificationRepositoryImpl{}
+}
+
+// Create persists a new notification for a user
+func (r *UserNotificationRepositoryImpl) Create(
+	ctx context.Context,
+	notification *entity.UserNotification,
+) error {
+	return db.DB(ctx).Create(notification).Error
+}
+
+// FindChangedSince returns a keyset page of changes after the given position
+func (r *UserNotificationRepositoryImpl) FindChangedSince(
+	ctx context.Context,
+	userID uint,
+	since time.Time,
+	afterID uint,
+	limit int,
+) ([]entity.UserNotification, error) {
+	var notifications []entity.UserNotification
+	err := db.DB(ctx).
+		Where("user_id = ?", userID).
+		Where("(updated_at > ? OR (updated_at = ? AND id > ?))", since, since, afterID).
+		Order("updated_at ASC, id ASC").
+		Limit(limit).
+		Find(&notifications).Error
+	if err != nil {
+		return nil, err
+	}
+	return notifications, nil
+}
+
+// CountUnread counts the user's unread notifications that have not been cleared
+func (r *UserNotificationRepositoryImpl) CountUnread(
+	ctx context.Context,
+	userID uint,
+) (int64, error) {
+	var count int64
+	err := db.DB(ctx).Model(&entity.UserNotification{}).
+		Where("user_id = ? AND read_at IS NULL AND cleared_at IS NULL", userID).
+		Count(&count).Error
+	return count, err
+}
+
+// MarkRead sets read_at on the user's unread notifications among ids
+func (r *UserNotificationRepositoryImpl) MarkRead(
+	ctx context.Context,
+	userID uint,
+	ids []uint,
+	readAt time.Time,
+) (int64, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	result := db.DB(ctx).Model(&entity.UserNotification{}).
+		Where("user_id = ? AND id IN ? AND read_at IS NULL", userID, ids).
+		UpdateColumns(map[string]any{
+			"read_at":    readAt,
+			"updated_at": readAt,
+		})
+	return result.RowsAffected, result.Error
+}
+
+// MarkUnread clears read_at on the user's read notifications among ids
+func (r *UserNotificationRepositoryImpl) MarkUnread(
+	ctx context.Context,
+	userID uint,
+	ids []uint,
+	now time.Time,
+) (int64, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	result := db.DB(ctx).Model(&entity.UserNotification{}).
+		Where("user_id = ? AND id IN ? AND read_at IS NOT NULL", userID, ids).
+		UpdateColumns(map[string]any{
+			"read_at":    nil,
+			"updated_at": now,
+		})
+	return result.RowsAffected, result.Error
+}
+
+// FindVisible returns an offset page of the user's uncleared notifications
+func (r *UserNotificationRepositoryImpl) FindVisible(
+	ctx context.Context,
+	userID uint,
+	unreadOnly bool,
+	page, pageSize int,
+) ([]entity.UserNotification, int64, error) {
+	filtered := func() *gorm.DB {
+		query := db.DB(ctx).Model(&entity.UserNotification{}).
+			Where("user_id = ? AND cleared_at IS NULL", userID)
+		if unreadOnly {
+			query = query.Where("read_at IS NULL")
+		}
+		return query
+	}
+
+	var total int64
+	if err := filtered().Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var notifications []entity.UserNotification
+	err := filtered().
+		Order("created_at DESC, id DESC").
+		Limit(pageSize).
+		Offset(helper.CalculateOffset(page, pageSize)).
+		Find(&notifications).Error
+	if err != nil {
+		return nil, 0, err
+	}
+	return notifications, total, nil
+}
+
+// MarkAllRead sets read_at on every unread, uncleared notification of the user
+func (r *UserNotificationRepositoryImpl) MarkAllRead(
+	ctx context.Context,
+	userID uint,
+	readAt time.Time,
+) (int64, error) {
+	result := db.DB(ctx).Model(&entity.UserNotification{}).
+		Where("user_id = ? AND read_at IS NULL AND cleared_at IS NULL", userID).
+		UpdateColumns(map[string]any{
+			"read_at":    readAt,
+			"updated_at": readAt,
+		})
+	return result.RowsAffected, result.Error
+}
+
+// Clear hides the user's uncleared notifications among ids
+func (r *UserNotificationRepositoryImpl) Clear(
+	ctx context.Context,
+	userID uint,
+	ids []uint,
+	clearedAt time.Time,
+) (int64, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	result := db.DB(ctx).Model(&entity.UserNotification{}).
+		Where("user_id = ? AND id IN ? AND cleared_at IS NULL", userID, ids).
+		UpdateColumns(clearColumns(clearedAt))
+	return result.RowsAffected, result.Error
+}
+
+// ClearAll hides all of the user's uncleared notifications, or only the read ones
+func (r *UserNotificationRepositoryImpl) ClearAll(
+	ctx context.Context,
+	userID uint,
+	readOnly bool,
+	clearedAt time.Time,
+) (int64, error) {
+	query := db.DB(ctx).Model(&entity.UserNotification{}).
+		Where("user_id = ? AND cleared_at IS NULL", userID)
+	if readOnly {
+		query = query.Where("read_at IS NOT NULL")
+	}
+	result := query.UpdateColumns(clearColumns(clearedAt))
+	return result.RowsAffected, result.Error
+}
+
+func clearColumns(clearedAt time.Time) map[string]any {
+	return map[string]any{
+		"cleared_at": clearedAt,
+		"read_at":    gorm.Expr("COALESCE(read_at, ?)", clearedAt),
+		"updated_at": clearedAt,
+	}
+}
@@ -0,0 +1,77 @@
+package repository
+
+import (
+	"context"
+
+	"ecommerce-be/common/db"
+	"ecommerce-be/notification/entity"
+
+	"gorm.io/gorm/clause"
+)
+
+// UserPushTokenRepository defines database operations for push device tokens
+type UserPushTokenRepository interface {
+	FindByUserID(ctx context.Context, userID uint) ([]entity.UserPushToken, error)
+
+	// Upsert registers a token, moving it to userID if another user held it.
+	Upsert(ctx context.Context, token *entity.UserPushToken) error
+	DeleteByUserAndToken(ctx context.Context, userID uint, token string) (bool, error)
+	DeleteByTokens(ctx context.Context, tokens []string) error
+}
+
+// UserPushTokenRepositoryImpl implements UserPushTokenRepository
+type UserPushTokenRepositoryImpl struct{}
+
+// NewUserPushTokenRepository creates a new instance of UserPushTokenRepository
+func NewUserPushTokenRepository() UserPushTokenRepository {
+	return &UserPushTokenRepositoryImpl{}
+}
+
+// FindByUserID returns all push tokens registered by a user
+func (r *UserPushTokenRepositoryImpl) FindByUserID(
+	ctx context.Context,
+	userID uint,
+) ([]entity.UserPushToken, error) {
+	var tokens []entity.UserPushToken
+	err := db.DB(ctx).
+		Where("user_id = ?", userID).
+		Order("last_seen_at DESC").
+		Find(&tokens).Error
+	if err != nil {
+		return nil, err
+	}
+	return tokens, nil
+}
+
+// Upsert creates the token or refreshes its owner, platform and last_seen_at
+func (r *UserPushTokenRepositoryImpl) Upsert(
+	ctx context.Context,
+	token *entity.UserPushToken,
+) error {
+	return db.DB(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "token"}},
+		DoUpdates: clause.AssignmentColumns(
+			[]string{"user_id", "platform", "last_seen_at", "updated_at"},
+		),
+	}).Create(token).Error
+}
+
+// DeleteByUserAndToken removes one of the user's tokens; false when it did not exist
+func (r *UserPushTokenRepositoryImpl) DeleteByUserAndToken(
+	ctx context.Context,
+	userID uint,
+	token string,
+) (bool, error) {
+	result := db.DB(ctx).
+		Where("user_id = ? AND token = ?", userID, token).
+		Delete(&entity.UserPushToken{})
+	return result.RowsAffected > 0, result.Error
+}
+
+// DeleteByTokens removes tokens the push provider reported as unregistered
+func (r *UserPushTokenRepositoryImpl) DeleteByTokens(ctx context.Context, tokens []string) error {
+	if len(tokens) == 0 {
+		return nil
+	}
+	return db.DB(ctx).Where("token IN ?", tokens).Delete(&entity.UserPushToken{}).Error
+}
@@ -0,0 +1,65 @@
+package route
+
+import (
+	"net/http"
+
+	"ecommerce-be/common/constants"
+	"ecommerce-be/common/middleware"
+	"ecommerce-be/common/openapi"
+	"ecommerce-be/notification/factory/singleton"
+	"ecommerce-be/notification/handler"
+	"ecommerce-be/notification/model"
+
+	"github.com/gin-gonic/gin"
+)
+
+// NotificationCenterModule handles in-app notification center routes
+type NotificationCenterModule struct {
+	centerHandler *handler.NotificationCenterHandler
+}
+
+// NewNotificationCenterModule creates a new instance of NotificationCenterModule
+func NewNotificationCenterModule() *NotificationCenterModule {
+	f := singleton.GetInstance()
+	return &NotificationCenterModule{
+		centerHandler: f.GetNotificationCenterHandler(),
+	}
+}
+
+// RegisterRoutes registers notification center routes (any authenticated user)
+func (m *NotificationCenterModule) RegisterRoutes(router *gin.Engine) {
+	inboxRoutes := openapi.NewGroup(
+		router.Group(constants.APIBaseNotification+"/inbox"),
+		"Notifications",
+	)
+	inboxRoutes.Use(middleware.CustomerAuth())
+	{
+		// GET /api/notification/inbox - Visible notifications, newest first
+		// Query params: ?page=1&pageSize=20&unreadOnly=true
+		// Response: NotificationInboxResponse
+		inboxRoutes.GET("", m.centerHandler.ListNotifications).
+			Summary("List inbox notifications").
+			Query(model.NotificationInboxQueryParams{}).
+			Returns(http.StatusOK, model.NotificationInboxResponse{})
+
+		// GET /api/notification/inbox/unread-count - Badge count
+		// Response: UnreadCountResponse
+		inboxRoutes.GET("/unread-count", m.centerHandler.GetUnreadCount).
+			Summary("Get the unread badge count").
+			Returns(http.StatusOK, model.UnreadCountResponse{})
+
+		// POST /api/notification/inbox/read - Mark selected or all notifications read
+		// Request: MarkNotificationsReadRequest
+		inboxRoutes.POST("/read", m.centerHandler.MarkRead).
+			Summary("Mark notifications read").
+			Body(model.MarkNotificationsReadRequest{}).
+			Returns(http.StatusOK, model.NotificationBulkActionResponse{})
+
+		// POST /api/notification/inbox/clear - Bulk-clear notifications
+		// Request: ClearNotificationsRequest
+		inboxRoutes.POST("/clear", m.centerHandler.Clear).
+			Summary("Clear notifications").
+			Body(model.ClearNotificationsRequest{}).
+			Returns(http.StatusOK, model.NotificationBulkActionResponse{})
+	}
+}
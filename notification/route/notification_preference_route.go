@@ -0,0 +1,121 @@
+package route
+
+import (
+	"net/http"
+
+	"ecommerce-be/common/constants"
+	"ecommerce-be/common/middleware"
+	"ecommerce-be/common/openapi"
+	"ecommerce-be/notification/factory/singleton"
+	"ecommerce-be/notification/handler"
+	"ecommerce-be/notification/model"
+	"ecommerce-be/notification/utils/constant"
+
+	"github.com/gin-gonic/gin"
+)
+
+// NotificationPreferenceModule handles channel/category preference, unsubscribe and
+// push token routes
+type NotificationPreferenceModule struct {
+	preferenceHandler *handler.NotificationPreferenceHandler
+}
+
+// NewNotificationPreferenceModule creates a new instance of NotificationPreferenceModule
+func NewNotificationPreferenceModule() *NotificationPreferenceModule {
+	f := singleton.GetInstance()
+	return &NotificationPreferenceModule{
+		preferenceHandler: f.GetNotificationPreferenceHandler(),
+	}
+}
+
+// RegisterRoutes registers preference and push token routes (any authenticated user)
+// and the public unsubscribe route
+func (m *NotificationPreferenceModule) RegisterRoutes(router *gin.Engine) {
+	customerAuth := middleware.CustomerAuth()
+
+	preferenceRoutes := openapi.NewGroup(
+		router.Group(constants.APIBaseNotification+"/preferences"),
+		"Notification Preferences",
+	)
+	preferenceRoutes.Use(customerAuth)
+	{
+		// GET /api/notification/preferences/channels - Effective on/off state per channel
+		preferenceRoutes.GET("/channels", m.preferenceHandler.GetChannelPreferences).
+			Summary("Get channel preferences").
+			ReturnsField(
+				http.StatusOK,
+				constant.CHANNEL_PREFERENCES_FIELD_NAME,
+				[]model.ChannelPreferenceResponse{},
+			)
+
+		// PUT /api/notification/preferences/channels - Enable/disable channels
+		// Request: UpdateChannelPreferencesRequest
+		preferenceRoutes.PUT("/channels", m.preferenceHandler.UpdateChannelPreferences).
+			Summary("Enable or disable notification channels").
+			Body(model.UpdateChannelPreferencesRequest{}).
+			ReturnsField(
+				http.StatusOK,
+				constant.CHANNEL_PREFERENCES_FIELD_NAME,
+				[]model.ChannelPreferenceResponse{},
+			)
+
+		// GET /api/notification/preferences/categories - Effective on/off state per category
+		preferenceRoutes.GET("/categories", m.preferenceHandler.GetCategoryPreferences).
+			Summary("Get category preferences").
+			ReturnsField(
+				http.StatusOK,
+				constant.CATEGORY_PREFERENCES_FIELD_NAME,
+				[]model.CategoryPreferenceResponse{},
+			)
+
+		// PUT /api/notification/preferences/categories - Opt in/out of categories
+		// Request: UpdateCategoryPreferencesRequest
+		preferenceRoutes.PUT("/categories", m.preferenceHandler.UpdateCategoryPreferences).
+			Summary("Opt in or out of notification categories").
+			Body(model.UpdateCategoryPreferencesRequest{}).
+			ReturnsField(
+				http.StatusOK,
+				constant.CATEGORY_PREFERENCES_FIELD_NAME,
+				[]model.CategoryPreferenceResponse{},
+			)
+	}
+
+	// Public: the signed token in the link authenticates the request
+	unsubscribeRoutes := openapi.NewGroup(
+		router.Group(constants.APIBaseNotification+"/unsubscribe"),
+		"Notification Preferences",
+	)
+	{
+		// GET /api/notification/unsubscribe?token=... - One-click unsubscribe link
+		unsubscribeRoutes.GET("", m.preferenceHandler.Unsubscribe).
+			Summary("One-click unsubscribe with a signed token").
+			Query(model.UnsubscribeQueryParams{}).
+			Returns(http.StatusOK, model.UnsubscribeResponse{})
+
+		// POST /api/notification/unsubscribe?token=... - RFC 8058 one-click from mail clients
+		unsubscribeRoutes.POST("", m.preferenceHandler.Unsubscribe).
+			Summary("One-click unsubscribe with a signed token").
+			Query(model.UnsubscribeQueryParams{}).
+			Returns(http.StatusOK, model.UnsubscribeResponse{})
+	}
+
+	tokenRoutes := openapi.NewGroup(
+		router.Group(constants.APIBaseNotification+"/push-tokens"),
+		"Notification Preferences",
+	)
+	tokenRoutes.Use(customerAuth)
+	{
+		// POST /api/notification/push-tokens - Register or refresh an FCM device token
+		// Request: RegisterPushTokenRequest
+		tokenRoutes.POST("", m.preferenceHandler.RegisterPushToken).
+			Summary("Register a push device token").
+			Body(model.RegisterPushTokenRequest{}).
+			Returns(http.StatusCreated, model.PushTokenResponse{})
+
+		// DELETE /api/notification/push-tokens - Unregister a device token (on logout)
+		// Request: RemovePushTokenRequest
+		tokenRoutes.DELETE("", m.preferenceHandler.RemovePushToken).
+			Summary("Remove a push device token").
+			Body(model.RemovePushTokenRequest{})
+	}
+}
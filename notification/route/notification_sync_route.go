@@ -0,0 +1,52 @@
+package route
+
+import (
+	"net/http"
+
+	"ecommerce-be/common/constants"
+	"ecommerce-be/common/middleware"
+	"ecommerce-be/common/openapi"
+	"ecommerce-be/notification/factory/singleton"
+	"ecommerce-be/notification/handler"
+	"ecommerce-be/notification/model"
+
+	"github.com/gin-gonic/gin"
+)
+
+// NotificationSyncModule handles cross-device notification sync routes
+type NotificationSyncModule struct {
+	syncHandler *handler.NotificationSyncHandler
+}
+
+// NewNotificationSyncModule creates a new instance of NotificationSyncModule
+func NewNotificationSyncModule() *NotificationSyncModule {
+	f := singleton.GetInstance()
+	return &NotificationSyncModule{
+		syncHandler: f.GetNotificationSyncHandler(),
+	}
+}
+
+// RegisterRoutes registers notification sync routes (any authenticated user)
+func (m *NotificationSyncModule) RegisterRoutes(router *gin.Engine) {
+	syncRoutes := openapi.NewGroup(
+		router.Group(constants.APIBaseNotification+"/sync"),
+		"Notifications",
+	)
+	syncRoutes.Use(middleware.CustomerAuth())
+	{
+		// GET /api/notification/sync - Changes since the device's cursor
+		// Query params: ?deviceId=desktop-1&since=2026-01-01T00:00:00Z&afterId=42&limit=50
+		// Response: NotificationSyncResponse
+		syncRoutes.GET("", m.syncHandler.GetChanges).
+			Summary("Get notification changes since a device cursor").
+			Query(model.NotificationSyncQueryParams{}).
+			Returns(http.StatusOK, model.NotificationSyncResponse{})
+
+		// POST /api/notification/sync/read - Mark notifications read/unread
+		// Request: NotificationReadStateRequest
+		syncRoutes.POST("/read", m.syncHandler.UpdateReadState).
+			Summary("Mark notifications read or unread").
+			Body(model.NotificationReadStateRequest{}).
+			Returns(http.StatusOK, model.NotificationReadStateResponse{})
+	}
+}
@@ -0,0 +1,77 @@
+package route
+
+import (
+	"net/http"
+
+	"ecommerce-be/common/constants"
+	"ecommerce-be/common/middleware"
+	"ecommerce-be/common/openapi"
+	"ecommerce-be/notification/factory/singleton"
+	"ecommerce-be/notification/handler"
+	"ecommerce-be/notification/model"
+	"ecommerce-be/notification/utils/constant"
+
+	"github.com/gin-gonic/gin"
+)
+
+// NotificationTemplateModule handles notification template routes
+type NotificationTemplateModule struct {
+	templateHandler *handler.NotificationTemplateHandler
+}
+
+// NewNotificationTemplateModule creates a new instance of NotificationTemplateModule
+func NewNotificationTemplateModule() *NotificationTemplateModule {
+	f := singleton.GetInstance()
+	return &NotificationTemplateModule{
+		templateHandler: f.GetNotificationTemplateHandler(),
+	}
+}
+
+// RegisterRoutes registers all notification template routes (admin only)
+func (m *NotificationTemplateModule) RegisterRoutes(router *gin.Engine) {
+	adminAuth := middleware.AdminAuth()
+	adminRoutes := openapi.NewGroup(
+		router.Group(constants.APIBaseNotification+"/admin/template"),
+		"Notification Templates",
+	)
+	adminRoutes.Use(adminAuth)
+	{
+		// GET /api/notification/admin/template - List custom templates
+		// Query params: ?eventType=order.placed&channel=email&isActive=true
+		adminRoutes.GET("", m.templateHandler.ListTemplates).
+			Summary("List custom notification templates").
+			Query(model.TemplateQueryParams{}).
+			ReturnsField(http.StatusOK, constant.TEMPLATES_FIELD_NAME, []model.TemplateResponse{})
+
+		// POST /api/notification/admin/template/preview - Render draft or effective template
+		// Request: TemplatePreviewRequest
+		// Response: RenderedNotification
+		adminRoutes.POST("/preview", m.templateHandler.PreviewTemplate).
+			Summary("Render a draft or effective template").
+			Body(model.TemplatePreviewRequest{}).
+			Returns(http.StatusOK, model.RenderedNotification{})
+
+		// GET /api/notification/admin/template/:id - Get custom template
+		adminRoutes.GET("/:id", m.templateHandler.GetTemplateByID).
+			Summary("Get a custom template").
+			ReturnsField(http.StatusOK, constant.TEMPLATE_FIELD_NAME, model.TemplateResponse{})
+
+		// POST /api/notification/admin/template - Override built-in default for event/channel
+		// Request: TemplateCreateRequest
+		adminRoutes.POST("", m.templateHandler.CreateTemplate).
+			Summary("Override the built-in template for an event and channel").
+			Body(model.TemplateCreateRequest{}).
+			ReturnsField(http.StatusCreated, constant.TEMPLATE_FIELD_NAME, model.TemplateResponse{})
+
+		// PUT /api/notification/admin/template/:id - Update template
+		// Request: TemplateUpdateRequest
+		adminRoutes.PUT("/:id", m.templateHandler.UpdateTemplate).
+			Summary("Update a custom template").
+			Body(model.TemplateUpdateRequest{}).
+			ReturnsField(http.StatusOK, constant.TEMPLATE_FIELD_NAME, model.TemplateResponse{})
+
+		// DELETE /api/notification/admin/template/:id - Delete template (default applies again)
+		adminRoutes.DELETE("/:id", m.templateHandler.DeleteTemplate).
+			Summary("Delete a custom template")
+	}
+}
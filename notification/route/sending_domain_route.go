@@ -0,0 +1,113 @@
+package route
+
+import (
+	"net/http"
+
+	"ecommerce-be/common/constants"
+	"ecommerce-be/common/middleware"
+	"ecommerce-be/common/openapi"
+	"ecommerce-be/notification/factory/singleton"
+	"ecommerce-be/notification/handler"
+	"ecommerce-be/notification/model"
+	"ecommerce-be/notification/utils/constant"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SendingDomainModule handles seller sending domain routes and their admin review
+type SendingDomainModule struct {
+	domainHandler *handler.SendingDomainHandler
+}
+
+// NewSendingDomainModule creates a new instance of SendingDomainModule
+func NewSendingDomainModule() *SendingDomainModule {
+	f := singleton.GetInstance()
+	return &SendingDomainModule{
+		domainHandler: f.GetSendingDomainHandler(),
+	}
+}
+
+// RegisterRoutes registers the seller sending domain routes and the admin routes
+// that configure DKIM and verify domains
+func (m *SendingDomainModule) RegisterRoutes(router *gin.Engine) {
+	sellerRoutes := openapi.NewGroup(
+		router.Group(constants.APIBaseNotification+"/sending-domain"),
+		"Notification Sending Domains",
+	)
+	sellerRoutes.Use(middleware.SellerAuth())
+	{
+		// GET /api/notification/sending-domain - Domain, status and DNS records to publish
+		sellerRoutes.GET("", m.domainHandler.GetSendingDomain).
+			Summary("Get the seller's email sending domain").
+			ReturnsField(
+				http.StatusOK,
+				constant.SENDING_DOMAIN_FIELD_NAME,
+				model.SendingDomainResponse{},
+			)
+
+		// PUT /api/notification/sending-domain - Request or replace the sending domain
+		// Request: SaveSendingDomainRequest
+		sellerRoutes.PUT("", m.domainHandler.SaveSendingDomain).
+			Summary("Request a custom email sending domain").
+			Description("Requires the Professional or Enterprise plan. An admin registers "+
+				"the domain with the email provider and sets its DKIM selector; email is "+
+				"sent from it once its SPF and DKIM records verify.").
+			Body(model.SaveSendingDomainRequest{}).
+			ReturnsField(
+				http.StatusOK,
+				constant.SENDING_DOMAIN_FIELD_NAME,
+				model.SendingDomainResponse{},
+			)
+
+		// POST /api/notification/sending-domain/verify - Re-check the DNS records
+		sellerRoutes.POST("/verify", m.domainHandler.VerifySellerSendingDomain).
+			Summary("Check the sending domain's SPF and DKIM records").
+			ReturnsField(
+				http.StatusOK,
+				constant.SENDING_DOMAIN_FIELD_NAME,
+				model.SendingDomainResponse{},
+			)
+
+		// DELETE /api/notification/sending-domain - Send from the platform address again
+		sellerRoutes.DELETE("", m.domainHandler.DeleteSendingDomain).
+			Summary("Remove the custom sending domain")
+	}
+
+	adminRoutes := openapi.NewGroup(
+		router.Group(constants.APIBaseNotification+"/admin/sending-domain"),
+		"Notification Sending Domains",
+	)
+	adminRoutes.Use(middleware.AdminAuth())
+	{
+		// GET /api/notification/admin/sending-domain - All sellers' domains
+		// Query params: ?status=pending
+		adminRoutes.GET("", m.domainHandler.ListSendingDomains).
+			Summary("List sellers' sending domains").
+			Query(model.SendingDomainQueryParams{}).
+			ReturnsField(
+				http.StatusOK,
+				constant.SENDING_DOMAINS_FIELD_NAME,
+				[]model.SendingDomainResponse{},
+			)
+
+		// PUT /api/notification/admin/sending-domain/:id/dkim - Record provider DKIM selector
+		// Request: ConfigureSendingDomainDKIMRequest
+		adminRoutes.PUT("/:id/dkim", m.domainHandler.ConfigureDKIM).
+			Summary("Set the DKIM selector issued by the email provider").
+			Body(model.ConfigureSendingDomainDKIMRequest{}).
+			ReturnsField(
+				http.StatusOK,
+				constant.SENDING_DOMAIN_FIELD_NAME,
+				model.SendingDomainResponse{},
+			)
+
+		// POST /api/notification/admin/sending-domain/:id/verify - Check DNS records
+		adminRoutes.POST("/:id/verify", m.domainHandler.VerifySendingDomain).
+			Summary("Check a sending domain's SPF and DKIM records").
+			ReturnsField(
+				http.StatusOK,
+				constant.SENDING_DOMAIN_FIELD_NAME,
+				model.SendingDomainResponse{},
+			)
+	}
+}
@@ -0,0 +1,204 @@
+package channel
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"html"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"time"
+
+	"ecommerce-be/common/log"
+	"ecommerce-be/notification/entity"
+)
+
+// sesSMTPHostFormat is the Amazon SES SMTP interface endpoint for a region.
+const sesSMTPHostFormat = "email-smtp.%s.amazonaws.com"
+
+// base64LineLength is the maximum encoded line length of attachment parts.
+const base64LineLength = 76
+
+// EmailSender delivers HTML email through an SMTP relay (SES included, via its
+// SMTP interface). smtp.SendMail upgrades to STARTTLS when the server offers it.
+type EmailSender struct {
+	addr    string
+	auth    smtp.Auth
+	from    string
+	senders SenderResolver
+}
+
+// NewSMTPEmailSender creates an email sender for a generic SMTP relay.
+// Authentication is skipped when username is empty.
+func NewSMTPEmailSender(host string, port int, username, password, from string) *EmailSender {
+	var auth smtp.Auth
+	if username != "" {
+		auth = smtp.PlainAuth("", username, password, host)
+	}
+	return &EmailSender{
+		addr: net.JoinHostPort(host, strconv.Itoa(port)),
+		auth: auth,
+		from: from,
+	}
+}
+
+// NewSESEmailSender creates an email sender for Amazon SES using SES SMTP credentials.
+func NewSESEmailSender(region, username, password, from string) *EmailSender {
+	return NewSMTPEmailSender(fmt.Sprintf(sesSMTPHostFormat, region), 587, username, password, from)
+}
+
+// WithSenderResolver makes the sender use a seller's verified sending domain for
+// messages addressed on their behalf.
+func (s *EmailSender) WithSenderResolver(resolver SenderResolver) *EmailSender {
+	s.senders = resolver
+	return s
+}
+
+func (s *EmailSender) Channel() entity.NotificationChannel {
+	return entity.NOTIFICATION_CHANNEL_EMAIL
+}
+
+// Send delivers msg to the recipient's email address
+func (s *EmailSender) Send(ctx context.Context, msg Message) error {
+	to := sanitizeHeader(msg.Recipient.Email)
+	if to == "" {
+		return ErrNoRecipientAddress
+	}
+
+	fromHeader, envelopeFrom := s.senderFor(ctx, msg.SellerID)
+	body := buildMIMEMessage(
+		fromHeader, to, msg.Subject, msg.Body, msg.UnsubscribeURL, msg.Attachments,
+	)
+	if err := smtp.SendMail(s.addr, s.auth, envelopeFrom, []string{to}, body); err != nil {
+		return fmt.Errorf("smtp send failed: %w", err)
+	}
+	return nil
+}
+
+// senderFor returns the From header and envelope sender for a message. A seller's
+// verified domain is used when available; lookup failures fall back to the platform
+// address so the email still goes out.
+func (s *EmailSender) senderFor(ctx context.Context, sellerID uint) (string, string) {
+	if s.senders == nil || sellerID == 0 {
+		return s.from, s.from
+	}
+	sender, err := s.senders.ResolveSender(ctx, sellerID)
+	if err != nil {
+		log.ErrorWithContext(ctx, fmt.Sprintf(
+			"email: sending domain lookup for seller %d failed, using platform address",
+			sellerID,
+		), err)
+		return s.from, s.from
+	}
+	if sender == nil {
+		return s.from, s.from
+	}
+	from := sender.Address
+	if sender.Name != "" {
+		from = (&mail.Address{Name: sender.Name, Address: sender.Address}).String()
+	}
+	return from, sender.Address
+}
+
+// Ping connects to the relay and waits for its greeting, confirming it accepts
+// connections without sending mail.
+func (s *EmailSender) Ping(ctx context.Context) error {
+	host, _, err := net.SplitHostPort(s.addr)
+	if err != nil {
+		return err
+	}
+
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", s.addr)
+	if err != nil {
+		return err
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+
+	client, err := smtp.NewClient(conn, host)
+	if err != nil {
+		_ = conn.Close()
+		return err
+	}
+	return client.Quit()
+}
+
+// buildMIMEMessage assembles an HTML message with an encoded subject. Messages with
+// attachments are sent as multipart/mixed with the HTML body as the first part.
+// When unsubscribeURL is set it adds RFC 8058 one-click unsubscribe headers and a
+// footer link, so custom templates cannot omit it.
+func buildMIMEMessage(
+	from, to, subject, htmlBody, unsubscribeURL string,
+	attachments []Attachment,
+) []byte {
+	var buf bytes.Buffer
+	buf.WriteString("From: " + sanitizeHeader(from) + "\r\n")
+	buf.WriteString("To: " + to + "\r\n")
+	buf.WriteString("Subject: " + mime.QEncoding.Encode("utf-8", sanitizeHeader(subject)) + "\r\n")
+	buf.WriteString("Date: " + time.Now().UTC().Format(time.RFC1123Z) + "\r\n")
+	unsubscribeURL = sanitizeHeader(unsubscribeURL)
+	if unsubscribeURL != "" {
+		buf.WriteString("List-Unsubscribe: <" + unsubscribeURL + ">\r\n")
+		buf.WriteString("List-Unsubscribe-Post: List-Unsubscribe=One-Click\r\n")
+		htmlBody += `<p style="font-size:12px;color:#888">` +
+			`<a href="` + html.EscapeString(unsubscribeURL) + `">Unsubscribe</a></p>`
+	}
+	buf.WriteString("MIME-Version: 1.0\r\n")
+
+	if len(attachments) == 0 {
+		buf.WriteString("Content-Type: text/html; charset=UTF-8\r\n")
+		buf.WriteString("Content-Transfer-Encoding: 8bit\r\n")
+		buf.WriteString("\r\n")
+		buf.WriteString(htmlBody)
+		return buf.Bytes()
+	}
+
+	mw := multipart.NewWriter(&buf)
+	buf.WriteString("Content-Type: multipart/mixed; boundary=\"" + mw.Boundary() + "\"\r\n")
+	buf.WriteString("\r\n")
+
+	htmlPart, _ := mw.CreatePart(textproto.MIMEHeader{
+		"Content-Type":              {"text/html; charset=UTF-8"},
+		"Content-Transfer-Encoding": {"8bit"},
+	})
+	_, _ = htmlPart.Write([]byte(htmlBody))
+
+	for _, attachment := range attachments {
+		filename := sanitizeHeader(attachment.Filename)
+		part, _ := mw.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {sanitizeHeader(attachment.ContentType)},
+			"Content-Transfer-Encoding": {"base64"},
+			"Content-Disposition": {
+				mime.FormatMediaType("attachment", map[string]string{"filename": filename}),
+			},
+		})
+		writeBase64Lines(part, attachment.Content)
+	}
+	_ = mw.Close()
+	return buf.Bytes()
+}
+
+// writeBase64Lines writes content base64 encoded in 76 character lines (RFC 2045)
+func writeBase64Lines(w io.Writer, content []byte) {
+	encoded := base64.StdEncoding.EncodeToString(content)
+	for len(encoded) > base64LineLength {
+		_, _ = io.WriteString(w, encoded[:base64LineLength]+"\r\n")
+		encoded = encoded[base64LineLength:]
+	}
+	_, _ = io.WriteString(w, encoded+"\r\n")
+}
+
+// sanitizeHeader strips CR/LF so rendered values cannot inject extra headers.
+func sanitizeHeader(v string) string {
+	return strings.TrimSpace(strings.NewReplacer("\r", "", "\n", "").Replace(v))
+}
@@ -0,0 +1,38 @@
+package channel
+
+import (
+	"context"
+
+	"ecommerce-be/common/db"
+	"ecommerce-be/notification/entity"
+	"ecommerce-be/notification/repository"
+)
+
+// InAppSender stores the notification in the user's in-app notification list.
+type InAppSender struct {
+	notificationRepo repository.UserNotificationRepository
+}
+
+// NewInAppSender creates an in-app sender.
+func NewInAppSender(notificationRepo repository.UserNotificationRepository) *InAppSender {
+	return &InAppSender{notificationRepo: notificationRepo}
+}
+
+func (s *InAppSender) Channel() entity.NotificationChannel {
+	return entity.NOTIFICATION_CHANNEL_IN_APP
+}
+
+// Send persists msg as a user_notification row
+func (s *InAppSender) Send(ctx context.Context, msg Message) error {
+	data := db.JSONMap{}
+	for k, v := range msg.Data {
+		data[k] = v
+	}
+	return s.notificationRepo.Create(ctx, &entity.UserNotification{
+		UserID:    msg.Recipient.UserID,
+		EventType: msg.EventType,
+		Title:     msg.Subject,
+		Body:      msg.Body,
+		Data:      data,
+	})
+}
@@ -0,0 +1,33 @@
+package channel
+
+import (
+	"context"
+	"fmt"
+
+	"ecommerce-be/common/log"
+	"ecommerce-be/notification/entity"
+)
+
+// LogSender writes messages to the application log instead of delivering them.
+// It is the default provider for external channels in development.
+type LogSender struct {
+	channel entity.NotificationChannel
+}
+
+// NewLogSender creates a log-only sender for channel.
+func NewLogSender(channel entity.NotificationChannel) *LogSender {
+	return &LogSender{channel: channel}
+}
+
+func (s *LogSender) Channel() entity.NotificationChannel {
+	return s.channel
+}
+
+// Send logs the rendered message
+func (s *LogSender) Send(ctx context.Context, msg Message) error {
+	log.InfoWithContext(ctx, fmt.Sprintf(
+		"notification [%s] %s to user %d: %s",
+		s.channel, msg.EventType, msg.Recipient.UserID, msg.Subject,
+	))
+	return nil
+}
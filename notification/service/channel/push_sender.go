@@ -0,0 +1,135 @@
+package channel
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"ecommerce-be/common/log"
+	"ecommerce-be/notification/entity"
+	"ecommerce-be/notification/repository"
+
+	fcm "google.golang.org/api/fcm/v1"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+)
+
+// ErrPushTokenUnregistered is returned by a PushClient when the device token is no
+// longer valid (app uninstalled, token rotated). The token should be discarded.
+var ErrPushTokenUnregistered = errors.New("push token is no longer registered")
+
+// PushClient sends a notification to a single device token.
+type PushClient interface {
+	Send(ctx context.Context, token, title, body string, data map[string]string) error
+}
+
+// FCMClient sends push notifications through the Firebase Cloud Messaging HTTP v1 API.
+type FCMClient struct {
+	service   *fcm.Service
+	projectID string
+}
+
+// NewFCMClient creates an FCM client authenticated with a service account key.
+func NewFCMClient(ctx context.Context, projectID, credentialsJSON string) (*FCMClient, error) {
+	svc, err := fcm.NewService(ctx, option.WithCredentialsJSON([]byte(credentialsJSON)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create fcm service: %w", err)
+	}
+	return &FCMClient{service: svc, projectID: projectID}, nil
+}
+
+// Send delivers one message; a 404 from FCM maps to ErrPushTokenUnregistered
+func (c *FCMClient) Send(
+	ctx context.Context,
+	token, title, body string,
+	data map[string]string,
+) error {
+	req := &fcm.SendMessageRequest{
+		Message: &fcm.Message{
+			Token: token,
+			Notification: &fcm.Notification{
+				Title: title,
+				Body:  body,
+			},
+			Data: data,
+		},
+	}
+
+	_, err := c.service.Projects.Messages.Send("projects/"+c.projectID, req).Context(ctx).Do()
+	if err != nil {
+		var apiErr *googleapi.Error
+		if errors.As(err, &apiErr) && apiErr.Code == http.StatusNotFound {
+			return ErrPushTokenUnregistered
+		}
+		return fmt.Errorf("fcm send failed: %w", err)
+	}
+	return nil
+}
+
+// PushSender fans a notification out to every device token of the recipient and
+// prunes tokens the provider reports as unregistered.
+type PushSender struct {
+	client    PushClient
+	tokenRepo repository.UserPushTokenRepository
+}
+
+// NewPushSender creates a push sender.
+func NewPushSender(client PushClient, tokenRepo repository.UserPushTokenRepository) *PushSender {
+	return &PushSender{client: client, tokenRepo: tokenRepo}
+}
+
+func (s *PushSender) Channel() entity.NotificationChannel {
+	return entity.NOTIFICATION_CHANNEL_PUSH
+}
+
+// Send succeeds when at least one device received the message
+func (s *PushSender) Send(ctx context.Context, msg Message) error {
+	if len(msg.Recipient.PushTokens) == 0 {
+		return ErrNoRecipientAddress
+	}
+
+	data := toPushData(msg)
+	var stale []string
+	var errs []error
+	delivered := 0
+
+	for _, token := range msg.Recipient.PushTokens {
+		err := s.client.Send(ctx, token, msg.Subject, msg.Body, data)
+		switch {
+		case err == nil:
+			delivered++
+		case errors.Is(err, ErrPushTokenUnregistered):
+			stale = append(stale, token)
+		default:
+			errs = append(errs, err)
+		}
+	}
+
+	if len(stale) > 0 {
+		if err := s.tokenRepo.DeleteByTokens(ctx, stale); err != nil {
+			log.ErrorWithContext(ctx, "push: failed to prune unregistered tokens", err)
+		}
+	}
+
+	if delivered > 0 {
+		return nil
+	}
+	if len(errs) == 0 {
+		return ErrNoRecipientAddress
+	}
+	return errors.Join(errs...)
+}
+
+// toPushData flattens the template data into FCM's string-only data payload.
+func toPushData(msg Message) map[string]string {
+	data := make(map[string]string, len(msg.Data)+1)
+	for k, v := range msg.Data {
+		if v == nil {
+			continue
+		}
+		data[k] = fmt.Sprint(v)
+	}
+	data["eventType"] = msg.EventType.String()
+	return data
+}
@@ -0,0 +1,95 @@
+package channel
+
+import (
+	"context"
+
+	"ecommerce-be/common/config"
+	"ecommerce-be/common/log"
+	"ecommerce-be/notification/entity"
+	"ecommerce-be/notification/repository"
+)
+
+// Provider names accepted by NOTIFICATION_*_PROVIDER.
+const (
+	ProviderSMTP   = "smtp"
+	ProviderSES    = "ses"
+	ProviderTwilio = "twilio"
+	ProviderFCM    = "fcm"
+)
+
+// BuildRegistry wires one sender per channel from configuration. Unknown or
+// misconfigured providers fall back to LogSender so dispatch never breaks startup.
+// senderResolver picks sellers' own sending domains for email.
+func BuildRegistry(
+	cfg config.NotificationConfig,
+	notificationRepo repository.UserNotificationRepository,
+	tokenRepo repository.UserPushTokenRepository,
+	senderResolver SenderResolver,
+) *Registry {
+	registry := NewRegistry()
+	registry.Register(NewInAppSender(notificationRepo))
+
+	emailSender := buildEmailSender(cfg)
+	if smtpSender, ok := emailSender.(*EmailSender); ok {
+		emailSender = smtpSender.WithSenderResolver(senderResolver)
+	}
+	registry.Register(emailSender)
+	registry.Register(buildSMSSender(cfg))
+	registry.Register(buildPushSender(cfg, tokenRepo))
+	return registry
+}
+
+func buildEmailSender(cfg config.NotificationConfig) Sender {
+	switch cfg.EmailProvider {
+	case ProviderSMTP:
+		if cfg.SMTPHost != "" {
+			return NewSMTPEmailSender(
+				cfg.SMTPHost,
+				cfg.SMTPPort,
+				cfg.SMTPUsername,
+				cfg.SMTPPassword,
+				cfg.EmailFrom,
+			)
+		}
+		log.Warn("notification: SMTP_HOST not set, email falls back to log sender")
+	case ProviderSES:
+		return NewSESEmailSender(cfg.SESRegion, cfg.SMTPUsername, cfg.SMTPPassword, cfg.EmailFrom)
+	}
+	return NewLogSender(entity.NOTIFICATION_CHANNEL_EMAIL)
+}
+
+// EmailHealthCheck returns a reachability check for the configured email relay, or
+// nil when email is only written to the log.
+func EmailHealthCheck(cfg config.NotificationConfig) func(ctx context.Context) error {
+	if sender, ok := buildEmailSender(cfg).(*EmailSender); ok {
+		return sender.Ping
+	}
+	return nil
+}
+
+func buildSMSSender(cfg config.NotificationConfig) Sender {
+	if cfg.SMSProvider == ProviderTwilio {
+		if cfg.TwilioAccountSID != "" && cfg.TwilioAuthToken != "" {
+			return NewSMSSender(
+				NewTwilioClient(cfg.TwilioAccountSID, cfg.TwilioAuthToken),
+				cfg.SMSFrom,
+			)
+		}
+		log.Warn("notification: Twilio credentials not set, SMS falls back to log sender")
+	}
+	return NewLogSender(entity.NOTIFICATION_CHANNEL_SMS)
+}
+
+func buildPushSender(
+	cfg config.NotificationConfig,
+	tokenRepo repository.UserPushTokenRepository,
+) Sender {
+	if cfg.PushProvider == ProviderFCM {
+		client, err := NewFCMClient(context.Background(), cfg.FCMProjectID, cfg.FCMCredentialsJSON)
+		if err == nil {
+			return NewPushSender(client, tokenRepo)
+		}
+		log.Error("notification: FCM client init failed, push falls back to log sender", err)
+	}
+	return NewLogSender(entity.NOTIFICATION_CHANNEL_PUSH)
+}
@@ -0,0 +1,91 @@
+// Package channel defines the delivery providers used by the notification
+// dispatcher: one Sender per notification channel.
+package channel
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"ecommerce-be/notification/entity"
+)
+
+// ErrNoRecipientAddress is returned when the recipient has no address for a
+// channel (no email, no phone, no push tokens). The dispatcher treats it as a skip.
+var ErrNoRecipientAddress = errors.New("recipient has no address for this channel")
+
+// Recipient carries the delivery addresses of the user being notified.
+type Recipient struct {
+	UserID     uint
+	Email      string
+	Phone      string
+	PushTokens []string
+}
+
+// Message is a notification already rendered for one channel.
+type Message struct {
+	EventType entity.NotificationEventType
+	Recipient Recipient
+	Subject   string
+	Body      string
+	Data      map[string]any
+	// UnsubscribeURL is the one-click link for the event's category; email adds it
+	// as a footer and List-Unsubscribe header.
+	UnsubscribeURL string
+	// Attachments are only delivered by channels that support files (email)
+	Attachments []Attachment
+	// SellerID is the seller the recipient shops with; email is sent from the
+	// seller's verified domain when they have one
+	SellerID uint
+}
+
+// SenderAddress is the From mailbox of an email.
+type SenderAddress struct {
+	Name    string
+	Address string
+}
+
+// SenderResolver selects a seller's own From mailbox. It returns nil when the seller
+// sends from the platform address.
+type SenderResolver interface {
+	ResolveSender(ctx context.Context, sellerID uint) (*SenderAddress, error)
+}
+
+// Attachment is a file delivered alongside a message.
+type Attachment struct {
+	Filename    string
+	ContentType string
+	Content     []byte
+}
+
+// Sender delivers rendered messages over one channel.
+type Sender interface {
+	Channel() entity.NotificationChannel
+	Send(ctx context.Context, msg Message) error
+}
+
+// Registry holds the sender configured for each channel.
+type Registry struct {
+	mu      sync.RWMutex
+	senders map[entity.NotificationChannel]Sender
+}
+
+// NewRegistry creates an empty sender registry.
+func NewRegistry() *Registry {
+	return &Registry{senders: map[entity.NotificationChannel]Sender{}}
+}
+
+// Register adds or replaces the sender for its channel.
+func (r *Registry) Register(s Sender) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.senders[s.Channel()] = s
+}
+
+// Get returns the sender registered for channel.
+func (r *Registry) Get(channel entity.NotificationChannel) (Sender, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	s, ok := r.senders[channel]
+	return s, ok
+}
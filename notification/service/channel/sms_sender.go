@@ -0,0 +1,107 @@
+package channel
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"ecommerce-be/notification/entity"
+)
+
+const twilioAPIBaseURL = "https://api.twilio.com"
+
+// SMSClient is a Twilio-style SMS API: one text message to one E.164 number.
+type SMSClient interface {
+	SendSMS(ctx context.Context, from, to, body string) error
+}
+
+// TwilioClient sends SMS through the Twilio Messages REST API.
+type TwilioClient struct {
+	accountSID string
+	authToken  string
+	baseURL    string
+	httpClient *http.Client
+}
+
+// NewTwilioClient creates a Twilio SMS client.
+func NewTwilioClient(accountSID, authToken string) *TwilioClient {
+	return &TwilioClient{
+		accountSID: accountSID,
+		authToken:  authToken,
+		baseURL:    twilioAPIBaseURL,
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// SendSMS posts a message to /2010-04-01/Accounts/{sid}/Messages.json
+func (c *TwilioClient) SendSMS(ctx context.Context, from, to, body string) error {
+	endpoint := fmt.Sprintf(
+		"%s/2010-04-01/Accounts/%s/Messages.json",
+		c.baseURL,
+		url.PathEscape(c.accountSID),
+	)
+	form := url.Values{}
+	form.Set("From", from)
+	form.Set("To", to)
+	form.Set("Body", body)
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		endpoint,
+		strings.NewReader(form.Encode()),
+	)
+	if err != nil {
+		return err
+	}
+	req.SetBasicAuth(c.accountSID, c.authToken)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("twilio request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		var apiErr struct {
+			Code    int    `json:"code"`
+			Message string `json:"message"`
+		}
+		raw, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		if json.Unmarshal(raw, &apiErr) == nil && apiErr.Message != "" {
+			return fmt.Errorf("twilio error %d: %s", apiErr.Code, apiErr.Message)
+		}
+		return fmt.Errorf("twilio returned status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// SMSSender delivers plain-text notifications to the recipient's phone.
+type SMSSender struct {
+	client SMSClient
+	from   string
+}
+
+// NewSMSSender creates an SMS sender using client and the configured sender number.
+func NewSMSSender(client SMSClient, from string) *SMSSender {
+	return &SMSSender{client: client, from: from}
+}
+
+func (s *SMSSender) Channel() entity.NotificationChannel {
+	return entity.NOTIFICATION_CHANNEL_SMS
+}
+
+// Send delivers msg.Body to the recipient's phone number
+func (s *SMSSender) Send(ctx context.Context, msg Message) error {
+	to := strings.TrimSpace(msg.Recipient.Phone)
+	if to == "" {
+		return ErrNoRecipientAddress
+	}
+	return s.client.SendSMS(ctx, s.from, to, msg.Body)
+}
@@ -0,0 +1,167 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"ecommerce-be/common"
+	notificationErrors "ecommerce-be/notification/error"
+	"ecommerce-be/notification/factory"
+	"ecommerce-be/notification/model"
+	"ecommerce-be/notification/repository"
+	"ecommerce-be/notification/utils"
+)
+
+// NotificationCenterService backs the in-app notification center: listing,
+// marking read, bulk-clearing and the unread badge count.
+type NotificationCenterService interface {
+	ListNotifications(
+		ctx context.Context,
+		userID uint,
+		params model.NotificationInboxQueryParams,
+	) (*model.NotificationInboxResponse, error)
+	MarkRead(
+		ctx context.Context,
+		userID uint,
+		req model.MarkNotificationsReadRequest,
+	) (*model.NotificationBulkActionResponse, error)
+	Clear(
+		ctx context.Context,
+		userID uint,
+		req model.ClearNotificationsRequest,
+	) (*model.NotificationBulkActionResponse, error)
+	GetUnreadCount(ctx context.Context, userID uint) (*model.UnreadCountResponse, error)
+}
+
+// NotificationCenterServiceImpl implements NotificationCenterService
+type NotificationCenterServiceImpl struct {
+	notificationRepo repository.UserNotificationRepository
+}
+
+// NewNotificationCenterService creates a new instance of NotificationCenterService
+func NewNotificationCenterService(
+	notificationRepo repository.UserNotificationRepository,
+) NotificationCenterService {
+	return &NotificationCenterServiceImpl{
+		notificationRepo: notificationRepo,
+	}
+}
+
+// ListNotifications returns a page of the user's uncleared notifications, newest first
+func (s *NotificationCenterServiceImpl) ListNotifications(
+	ctx context.Context,
+	userID uint,
+	params model.NotificationInboxQueryParams,
+) (*model.NotificationInboxResponse, error) {
+	page, pageSize := utils.NormalizeInboxPage(params.Page, params.PageSize)
+
+	notifications, total, err := s.notificationRepo.FindVisible(
+		ctx,
+		userID,
+		params.UnreadOnly,
+		page,
+		pageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	unreadCount, err := s.notificationRepo.CountUnread(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]model.UserNotificationResponse, 0, len(notifications))
+	for i := range notifications {
+		responses = append(responses, factory.BuildUserNotificationResponse(&notifications[i]))
+	}
+
+	return &model.NotificationInboxResponse{
+		Notifications: responses,
+		Pagination:    common.NewPaginationResponse(page, pageSize, total),
+		UnreadCount:   unreadCount,
+	}, nil
+}
+
+// MarkRead marks the selected notifications, or all of them, as read
+func (s *NotificationCenterServiceImpl) MarkRead(
+	ctx context.Context,
+	userID uint,
+	req model.MarkNotificationsReadRequest,
+) (*model.NotificationBulkActionResponse, error) {
+	if !utils.IsValidInboxSelection(req.IDs, req.All) {
+		return nil, notificationErrors.ErrNotificationSelectionInvalid
+	}
+
+	now := time.Now().UTC()
+	var (
+		updated int64
+		err     error
+	)
+	if req.All {
+		updated, err = s.notificationRepo.MarkAllRead(ctx, userID, now)
+	} else {
+		updated, err = s.notificationRepo.MarkRead(ctx, userID, req.IDs, now)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return s.buildBulkActionResponse(ctx, userID, updated)
+}
+
+// Clear hides the selected notifications, or all (optionally only read) ones, from
+// the notification center. The rows are kept so other devices pick up the clear
+// through delta sync.
+func (s *NotificationCenterServiceImpl) Clear(
+	ctx context.Context,
+	userID uint,
+	req model.ClearNotificationsRequest,
+) (*model.NotificationBulkActionResponse, error) {
+	if !utils.IsValidInboxSelection(req.IDs, req.All) {
+		return nil, notificationErrors.ErrNotificationSelectionInvalid
+	}
+
+	now := time.Now().UTC()
+	var (
+		updated int64
+		err     error
+	)
+	if req.All {
+		updated, err = s.notificationRepo.ClearAll(ctx, userID, req.ReadOnly, now)
+	} else {
+		updated, err = s.notificationRepo.Clear(ctx, userID, req.IDs, now)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return s.buildBulkActionResponse(ctx, userID, updated)
+}
+
+// GetUnreadCount returns the badge count for the user's notification center
+func (s *NotificationCenterServiceImpl) GetUnreadCount(
+	ctx context.Context,
+	userID uint,
+) (*model.UnreadCountResponse, error) {
+	unreadCount, err := s.notificationRepo.CountUnread(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return &model.UnreadCountResponse{UnreadCount: unreadCount}, nil
+}
+
+func (s *NotificationCenterServiceImpl) buildBulkActionResponse(
+	ctx context.Context,
+	userID uint,
+	updated int64,
+) (*model.NotificationBulkActionResponse, error) {
+	unreadCount, err := s.notificationRepo.CountUnread(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return &model.NotificationBulkActionResponse{
+		Updated:     updated,
+		UnreadCount: unreadCount,
+	}, nil
+}
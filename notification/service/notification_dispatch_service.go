@@ -0,0 +1,310 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"ecommerce-be/common/calendar"
+	"ecommerce-be/common/constants"
+	"ecommerce-be/common/log"
+	"ecommerce-be/common/scheduler"
+	"ecommerce-be/notification/entity"
+	notificationErrors "ecommerce-be/notification/error"
+	"ecommerce-be/notification/model"
+	"ecommerce-be/notification/repository"
+	"ecommerce-be/notification/service/channel"
+	"ecommerce-be/notification/utils"
+	"ecommerce-be/notification/utils/constant"
+	userRepository "ecommerce-be/user/repository"
+)
+
+// NotificationDispatchService renders and delivers notifications on every channel
+// the recipient has enabled, unless they opted out of the event's category.
+type NotificationDispatchService interface {
+	// Notify queues a notification for asynchronous delivery.
+	Notify(
+		ctx context.Context,
+		eventType entity.NotificationEventType,
+		userID uint,
+		data map[string]any,
+	) error
+
+	// Dispatch delivers a queued notification (invoked by the scheduler worker).
+	Dispatch(ctx context.Context, payload model.NotificationDispatchPayload) error
+}
+
+// NotificationDispatchServiceImpl implements NotificationDispatchService
+type NotificationDispatchServiceImpl struct {
+	templateService   NotificationTemplateService
+	preferenceRepo    repository.NotificationChannelPreferenceRepository
+	categoryPrefRepo  repository.NotificationCategoryPreferenceRepository
+	tokenRepo         repository.UserPushTokenRepository
+	userRepo          userRepository.UserRepository
+	senders           *channel.Registry
+	unsubscribeSigner *utils.UnsubscribeSigner
+	scheduler         *scheduler.Scheduler
+}
+
+// NewNotificationDispatchService creates a new instance of NotificationDispatchService.
+// When sched is nil notifications are delivered inline.
+func NewNotificationDispatchService(
+	templateService NotificationTemplateService,
+	preferenceRepo repository.NotificationChannelPreferenceRepository,
+	categoryPrefRepo repository.NotificationCategoryPreferenceRepository,
+	tokenRepo repository.UserPushTokenRepository,
+	userRepo userRepository.UserRepository,
+	senders *channel.Registry,
+	unsubscribeSigner *utils.UnsubscribeSigner,
+	sched *scheduler.Scheduler,
+) NotificationDispatchService {
+	return &NotificationDispatchServiceImpl{
+		templateService:   templateService,
+		preferenceRepo:    preferenceRepo,
+		categoryPrefRepo:  categoryPrefRepo,
+		tokenRepo:         tokenRepo,
+		userRepo:          userRepo,
+		senders:           senders,
+		unsubscribeSigner: unsubscribeSigner,
+		scheduler:         sched,
+	}
+}
+
+// Notify validates the event and hands it to the scheduler worker pool. If the job
+// cannot be queued (e.g. background context without request keys) the
+// notification is delivered inline instead of being dropped.
+func (s *NotificationDispatchServiceImpl) Notify(
+	ctx context.Context,
+	eventType entity.NotificationEventType,
+	userID uint,
+	data map[string]any,
+) error {
+	if !eventType.IsValid() {
+		return notificationErrors.ErrInvalidNotificationEventType
+	}
+	if userID == 0 {
+		return notificationErrors.ErrInvalidRecipient
+	}
+
+	payload := model.NotificationDispatchPayload{
+		EventType: eventType,
+		UserID:    userID,
+		Data:      data,
+	}
+
+	if s.scheduler != nil {
+		raw, err := json.Marshal(payload)
+		if err != nil {
+			return fmt.Errorf("failed to marshal notification payload: %w", err)
+		}
+		job := scheduler.NewJob(constant.NOTIFICATION_DISPATCH_COMMAND, json.RawMessage(raw))
+		if _, err = s.scheduler.Schedule(ctx, job, 0); err == nil {
+			return nil
+		}
+		log.ErrorWithContext(ctx, "notify: queueing failed, dispatching inline", err)
+	}
+
+	return s.Dispatch(ctx, payload)
+}
+
+// Dispatch renders the event per enabled channel and sends it. Nothing is sent when
+// the user opted out of the event's category, unless the event is mandatory. A
+// payload addressed to an explicit email goes out by email only, to that address.
+// A failure on one channel does not stop the others; all failures are returned joined.
+func (s *NotificationDispatchServiceImpl) Dispatch(
+	ctx context.Context,
+	payload model.NotificationDispatchPayload,
+) error {
+	user, err := s.userRepo.FindByID(ctx, payload.UserID)
+	if err != nil {
+		return err
+	}
+
+	category := payload.EventType.Category()
+	if !payload.EventType.IsMandatory() {
+		categoryPrefs, err := s.categoryPrefRepo.FindByUserID(ctx, payload.UserID)
+		if err != nil {
+			return err
+		}
+		if !utils.IsCategoryEnabled(categoryPrefs, category) {
+			log.InfoWithContext(ctx, fmt.Sprintf(
+				"dispatch: user %d opted out of %s, skipping %s",
+				payload.UserID, category, payload.EventType,
+			))
+			return nil
+		}
+	}
+
+	recipient := channel.Recipient{
+		UserID: user.ID,
+		Email:  user.Email,
+		Phone:  user.Phone,
+	}
+
+	payloadData, emailTo := extractEmailOverride(payload.Data)
+	var channels []entity.NotificationChannel
+	if emailTo != "" {
+		recipient = channel.Recipient{UserID: user.ID, Email: emailTo}
+		channels = []entity.NotificationChannel{entity.NOTIFICATION_CHANNEL_EMAIL}
+	} else {
+		prefs, err := s.preferenceRepo.FindByUserID(ctx, payload.UserID)
+		if err != nil {
+			return err
+		}
+		channels = utils.EnabledChannels(prefs)
+	}
+	if len(channels) == 0 {
+		return nil
+	}
+
+	if containsChannel(channels, entity.NOTIFICATION_CHANNEL_PUSH) {
+		tokens, err := s.tokenRepo.FindByUserID(ctx, user.ID)
+		if err != nil {
+			return err
+		}
+		for _, token := range tokens {
+			recipient.PushTokens = append(recipient.PushTokens, token.Token)
+		}
+	}
+
+	// Without a signing secret the message still goes out, just without the link
+	unsubscribeURL, err := s.unsubscribeSigner.URL(user.ID, category)
+	if err != nil {
+		log.ErrorWithContext(ctx, "dispatch: unsubscribe link not signed", err)
+	}
+	messageData, attachments := extractCalendarAttachments(ctx, payloadData)
+	data := buildTemplateData(messageData, user.FirstName, user.LastName)
+	data[constant.UNSUBSCRIBE_URL_DATA_KEY] = unsubscribeURL
+
+	var errs []error
+	for _, ch := range channels {
+		sender, ok := s.senders.Get(ch)
+		if !ok {
+			continue
+		}
+
+		rendered, err := s.templateService.Render(ctx, payload.EventType, ch, data)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", ch, err))
+			continue
+		}
+
+		msg := channel.Message{
+			EventType: payload.EventType,
+			Recipient: recipient,
+			Subject:   rendered.Subject,
+			Body:      rendered.Body,
+			Data:      messageData,
+			SellerID:  user.SellerID,
+		}
+		if ch == entity.NOTIFICATION_CHANNEL_EMAIL {
+			msg.UnsubscribeURL = unsubscribeURL
+			msg.Attachments = attachments
+		}
+
+		err = sender.Send(ctx, msg)
+		if errors.Is(err, channel.ErrNoRecipientAddress) {
+			continue
+		}
+		if err != nil {
+			log.ErrorWithContext(ctx, fmt.Sprintf(
+				"dispatch: %s delivery of %s to user %d failed",
+				ch, payload.EventType, payload.UserID,
+			), err)
+			errs = append(errs, fmt.Errorf("%s: %w", ch, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// extractEmailOverride removes the explicit recipient address from the payload data.
+func extractEmailOverride(data map[string]any) (map[string]any, string) {
+	raw, ok := data[constants.NOTIFY_EMAIL_TO_DATA_KEY]
+	if !ok {
+		return data, ""
+	}
+
+	rest := make(map[string]any, len(data)-1)
+	for k, v := range data {
+		if k != constants.NOTIFY_EMAIL_TO_DATA_KEY {
+			rest[k] = v
+		}
+	}
+
+	emailTo, _ := raw.(string)
+	return rest, strings.TrimSpace(emailTo)
+}
+
+// extractCalendarAttachments removes the calendar event from the payload data and
+// renders it as an iCalendar attachment. The event arrives as a calendar.Event when
+// dispatched inline and as a decoded JSON object when it went through the queue.
+// A malformed event is logged and dropped so the notification itself still goes out.
+func extractCalendarAttachments(
+	ctx context.Context,
+	data map[string]any,
+) (map[string]any, []channel.Attachment) {
+	raw, ok := data[constants.CALENDAR_EVENT_DATA_KEY]
+	if !ok {
+		return data, nil
+	}
+
+	rest := make(map[string]any, len(data)-1)
+	for k, v := range data {
+		if k != constants.CALENDAR_EVENT_DATA_KEY {
+			rest[k] = v
+		}
+	}
+
+	encoded, err := json.Marshal(raw)
+	if err != nil {
+		log.ErrorWithContext(ctx, "dispatch: invalid calendar event", err)
+		return rest, nil
+	}
+	var event calendar.Event
+	if err := json.Unmarshal(encoded, &event); err != nil {
+		log.ErrorWithContext(ctx, "dispatch: invalid calendar event", err)
+		return rest, nil
+	}
+	if event.UID == "" || !event.End.After(event.Start) {
+		log.WarnWithContext(ctx, "dispatch: calendar event without uid or valid time range")
+		return rest, nil
+	}
+
+	return rest, []channel.Attachment{{
+		Filename:    constants.CALENDAR_ATTACHMENT_FILENAME,
+		ContentType: event.ContentType(),
+		Content:     calendar.Render(event, time.Now()),
+	}}
+}
+
+// buildTemplateData adds recipient placeholders every template may use, without
+// overriding values supplied by the caller.
+func buildTemplateData(data map[string]any, firstName, lastName string) map[string]any {
+	merged := make(map[string]any, len(data)+2)
+	for k, v := range data {
+		merged[k] = v
+	}
+	if _, ok := merged["CustomerName"]; !ok {
+		merged["CustomerName"] = strings.TrimSpace(firstName + " " + lastName)
+	}
+	if _, ok := merged["FirstName"]; !ok {
+		merged["FirstName"] = firstName
+	}
+	return merged
+}
+
+func containsChannel(
+	channels []entity.NotificationChannel,
+	target entity.NotificationChannel,
+) bool {
+	for _, ch := range channels {
+		if ch == target {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,204 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"ecommerce-be/notification/entity"
+	notificationErrors "ecommerce-be/notification/error"
+	"ecommerce-be/notification/factory"
+	"ecommerce-be/notification/model"
+	"ecommerce-be/notification/repository"
+	"ecommerce-be/notification/utils"
+)
+
+// NotificationPreferenceService manages a user's delivery channels, notification
+// categories and push devices.
+type NotificationPreferenceService interface {
+	GetChannelPreferences(
+		ctx context.Context,
+		userID uint,
+	) ([]model.ChannelPreferenceResponse, error)
+	UpdateChannelPreferences(
+		ctx context.Context,
+		userID uint,
+		req model.UpdateChannelPreferencesRequest,
+	) ([]model.ChannelPreferenceResponse, error)
+
+	GetCategoryPreferences(
+		ctx context.Context,
+		userID uint,
+	) ([]model.CategoryPreferenceResponse, error)
+	UpdateCategoryPreferences(
+		ctx context.Context,
+		userID uint,
+		req model.UpdateCategoryPreferencesRequest,
+	) ([]model.CategoryPreferenceResponse, error)
+	// Unsubscribe turns off the category encoded in a signed unsubscribe token.
+	Unsubscribe(ctx context.Context, token string) (*model.UnsubscribeResponse, error)
+
+	RegisterPushToken(
+		ctx context.Context,
+		userID uint,
+		req model.RegisterPushTokenRequest,
+	) (*model.PushTokenResponse, error)
+	RemovePushToken(ctx context.Context, userID uint, req model.RemovePushTokenRequest) error
+}
+
+// NotificationPreferenceServiceImpl implements NotificationPreferenceService
+type NotificationPreferenceServiceImpl struct {
+	preferenceRepo    repository.NotificationChannelPreferenceRepository
+	categoryPrefRepo  repository.NotificationCategoryPreferenceRepository
+	tokenRepo         repository.UserPushTokenRepository
+	unsubscribeSigner *utils.UnsubscribeSigner
+}
+
+// NewNotificationPreferenceService creates a new instance of NotificationPreferenceService
+func NewNotificationPreferenceService(
+	preferenceRepo repository.NotificationChannelPreferenceRepository,
+	categoryPrefRepo repository.NotificationCategoryPreferenceRepository,
+	tokenRepo repository.UserPushTokenRepository,
+	unsubscribeSigner *utils.UnsubscribeSigner,
+) NotificationPreferenceService {
+	return &NotificationPreferenceServiceImpl{
+		preferenceRepo:    preferenceRepo,
+		categoryPrefRepo:  categoryPrefRepo,
+		tokenRepo:         tokenRepo,
+		unsubscribeSigner: unsubscribeSigner,
+	}
+}
+
+// GetChannelPreferences returns the effective on/off state of every channel
+func (s *NotificationPreferenceServiceImpl) GetChannelPreferences(
+	ctx context.Context,
+	userID uint,
+) ([]model.ChannelPreferenceResponse, error) {
+	prefs, err := s.preferenceRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return factory.BuildChannelPreferenceResponses(utils.ResolveChannelPreferences(prefs)), nil
+}
+
+// UpdateChannelPreferences stores explicit preferences for the given channels
+func (s *NotificationPreferenceServiceImpl) UpdateChannelPreferences(
+	ctx context.Context,
+	userID uint,
+	req model.UpdateChannelPreferencesRequest,
+) ([]model.ChannelPreferenceResponse, error) {
+	prefs := make([]entity.NotificationChannelPreference, 0, len(req.Preferences))
+	for _, item := range req.Preferences {
+		channel := entity.NotificationChannel(strings.ToLower(strings.TrimSpace(string(item.Channel))))
+		if !channel.IsValid() {
+			return nil, notificationErrors.ErrInvalidNotificationChannel
+		}
+		prefs = append(prefs, entity.NotificationChannelPreference{
+			UserID:    userID,
+			Channel:   channel,
+			IsEnabled: *item.IsEnabled,
+		})
+	}
+
+	if err := s.preferenceRepo.UpsertBatch(ctx, prefs); err != nil {
+		return nil, err
+	}
+	return s.GetChannelPreferences(ctx, userID)
+}
+
+// GetCategoryPreferences returns the effective on/off state of every category
+func (s *NotificationPreferenceServiceImpl) GetCategoryPreferences(
+	ctx context.Context,
+	userID uint,
+) ([]model.CategoryPreferenceResponse, error) {
+	prefs, err := s.categoryPrefRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return factory.BuildCategoryPreferenceResponses(utils.ResolveCategoryPreferences(prefs)), nil
+}
+
+// UpdateCategoryPreferences stores explicit preferences for the given categories
+func (s *NotificationPreferenceServiceImpl) UpdateCategoryPreferences(
+	ctx context.Context,
+	userID uint,
+	req model.UpdateCategoryPreferencesRequest,
+) ([]model.CategoryPreferenceResponse, error) {
+	prefs := make([]entity.NotificationCategoryPreference, 0, len(req.Preferences))
+	for _, item := range req.Preferences {
+		category := entity.NotificationCategory(
+			strings.ToLower(strings.TrimSpace(string(item.Category))),
+		)
+		if !category.IsValid() {
+			return nil, notificationErrors.ErrInvalidNotificationCategory
+		}
+		prefs = append(prefs, entity.NotificationCategoryPreference{
+			UserID:    userID,
+			Category:  category,
+			IsEnabled: *item.IsEnabled,
+		})
+	}
+
+	if err := s.categoryPrefRepo.UpsertBatch(ctx, prefs); err != nil {
+		return nil, err
+	}
+	return s.GetCategoryPreferences(ctx, userID)
+}
+
+// Unsubscribe verifies the link's signature and opts the user out of its category.
+// It is idempotent: following the same link again leaves the category off.
+func (s *NotificationPreferenceServiceImpl) Unsubscribe(
+	ctx context.Context,
+	token string,
+) (*model.UnsubscribeResponse, error) {
+	userID, category, ok := s.unsubscribeSigner.Verify(strings.TrimSpace(token))
+	if !ok {
+		return nil, notificationErrors.ErrInvalidUnsubscribeToken
+	}
+
+	err := s.categoryPrefRepo.UpsertBatch(ctx, []entity.NotificationCategoryPreference{{
+		UserID:    userID,
+		Category:  category,
+		IsEnabled: false,
+	}})
+	if err != nil {
+		return nil, err
+	}
+	return &model.UnsubscribeResponse{Category: category}, nil
+}
+
+// RegisterPushToken registers a device token for the user
+func (s *NotificationPreferenceServiceImpl) RegisterPushToken(
+	ctx context.Context,
+	userID uint,
+	req model.RegisterPushTokenRequest,
+) (*model.PushTokenResponse, error) {
+	token := &entity.UserPushToken{
+		UserID:     userID,
+		Token:      strings.TrimSpace(req.Token),
+		Platform:   req.Platform,
+		LastSeenAt: time.Now().UTC(),
+	}
+	if err := s.tokenRepo.Upsert(ctx, token); err != nil {
+		return nil, err
+	}
+
+	response := factory.BuildPushTokenResponse(token)
+	return &response, nil
+}
+
+// RemovePushToken unregisters one of the user's device tokens
+func (s *NotificationPreferenceServiceImpl) RemovePushToken(
+	ctx context.Context,
+	userID uint,
+	req model.RemovePushTokenRequest,
+) error {
+	removed, err := s.tokenRepo.DeleteByUserAndToken(ctx, userID, strings.TrimSpace(req.Token))
+	if err != nil {
+		return err
+	}
+	if !removed {
+		return notificationErrors.ErrPushTokenNotFound
+	}
+	return nil
+}
@@ -0,0 +1,164 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"ecommerce-be/common/db"
+	"ecommerce-be/notification/entity"
+	notificationErrors "ecommerce-be/notification/error"
+	"ecommerce-be/notification/factory"
+	"ecommerce-be/notification/model"
+	"ecommerce-be/notification/repository"
+	"ecommerce-be/notification/utils"
+)
+
+// NotificationSyncService keeps a user's in-app notification list consistent
+// across devices via per-device cursors and delta reads.
+type NotificationSyncService interface {
+	GetChanges(
+		ctx context.Context,
+		userID uint,
+		params model.NotificationSyncQueryParams,
+	) (*model.NotificationSyncResponse, error)
+	UpdateReadState(
+		ctx context.Context,
+		userID uint,
+		req model.NotificationReadStateRequest,
+	) (*model.NotificationReadStateResponse, error)
+}
+
+// NotificationSyncServiceImpl implements NotificationSyncService
+type NotificationSyncServiceImpl struct {
+	notificationRepo repository.UserNotificationRepository
+	cursorRepo       repository.NotificationDeviceCursorRepository
+}
+
+// NewNotificationSyncService creates a new instance of NotificationSyncService
+func NewNotificationSyncService(
+	notificationRepo repository.UserNotificationRepository,
+	cursorRepo repository.NotificationDeviceCursorRepository,
+) NotificationSyncService {
+	return &NotificationSyncServiceImpl{
+		notificationRepo: notificationRepo,
+		cursorRepo:       cursorRepo,
+	}
+}
+
+// GetChanges returns notifications created or whose read state changed after the
+// device's cursor, then advances that device's cursor to the end of the page.
+func (s *NotificationSyncServiceImpl) GetChanges(
+	ctx context.Context,
+	userID uint,
+	params model.NotificationSyncQueryParams,
+) (*model.NotificationSyncResponse, error) {
+	deviceID := strings.TrimSpace(params.DeviceID)
+	if deviceID == "" {
+		return nil, notificationErrors.ErrInvalidDeviceID
+	}
+
+	stored, err := s.cursorRepo.FindByUserAndDevice(ctx, userID, deviceID)
+	if err != nil {
+		return nil, err
+	}
+
+	since, afterID := utils.ResolveSyncCursor(params.Since, params.AfterID, stored)
+	limit := utils.NormalizeSyncLimit(params.Limit)
+	serverTime := time.Now().UTC()
+
+	// Fetch one extra row to detect whether another page follows
+	changes, err := s.notificationRepo.FindChangedSince(ctx, userID, since, afterID, limit+1)
+	if err != nil {
+		return nil, err
+	}
+	hasMore := len(changes) > limit
+	if hasMore {
+		changes = changes[:limit]
+	}
+
+	nextSince, nextAfterID := utils.AdvanceSyncCursor(since, afterID, changes)
+	if len(changes) > 0 {
+		if err := s.cursorRepo.Upsert(ctx, &entity.NotificationDeviceCursor{
+			UserID:       userID,
+			DeviceID:     deviceID,
+			LastSyncedAt: &nextSince,
+			LastSyncedID: nextAfterID,
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	unreadCount, err := s.notificationRepo.CountUnread(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]model.UserNotificationResponse, 0, len(changes))
+	for i := range changes {
+		responses = append(responses, factory.BuildUserNotificationResponse(&changes[i]))
+	}
+
+	return &model.NotificationSyncResponse{
+		Changes:     responses,
+		NextCursor:  factory.BuildSyncCursor(nextSince, nextAfterID),
+		HasMore:     hasMore,
+		UnreadCount: unreadCount,
+		ServerTime:  serverTime.Format(time.RFC3339Nano),
+	}, nil
+}
+
+// UpdateReadState marks notifications read or unread. Every changed row gets a new
+// updated_at so the change appears in the next delta of every other device.
+func (s *NotificationSyncServiceImpl) UpdateReadState(
+	ctx context.Context,
+	userID uint,
+	req model.NotificationReadStateRequest,
+) (*model.NotificationReadStateResponse, error) {
+	if strings.TrimSpace(req.DeviceID) == "" {
+		return nil, notificationErrors.ErrInvalidDeviceID
+	}
+	if len(req.Read) == 0 && len(req.Unread) == 0 {
+		return nil, notificationErrors.ErrReadStateEmpty
+	}
+
+	readSet := make(map[uint]struct{}, len(req.Read))
+	for _, id := range req.Read {
+		readSet[id] = struct{}{}
+	}
+	for _, id := range req.Unread {
+		if _, ok := readSet[id]; ok {
+			return nil, notificationErrors.ErrReadStateConflict
+		}
+	}
+
+	var updated int64
+	err := db.WithTransaction(ctx, func(txCtx context.Context) error {
+		now := time.Now().UTC()
+
+		readCount, err := s.notificationRepo.MarkRead(txCtx, userID, req.Read, now)
+		if err != nil {
+			return err
+		}
+		unreadCount, err := s.notificationRepo.MarkUnread(txCtx, userID, req.Unread, now)
+		if err != nil {
+			return err
+		}
+
+		updated = readCount + unreadCount
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	unreadCount, err := s.notificationRepo.CountUnread(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.NotificationReadStateResponse{
+		Updated:     updated,
+		UnreadCount: unreadCount,
+	}, nil
+}
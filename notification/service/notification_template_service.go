@@ -0,0 +1,273 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"ecommerce-be/notification/entity"
+	notificationErrors "ecommerce-be/notification/error"
+	"ecommerce-be/notification/factory"
+	"ecommerce-be/notification/model"
+	"ecommerce-be/notification/repository"
+	"ecommerce-be/notification/utils"
+	"ecommerce-be/notification/utils/constant"
+)
+
+// NotificationTemplateService manages admin templates and renders notifications.
+type NotificationTemplateService interface {
+	// Admin template management
+	ListTemplates(
+		ctx context.Context,
+		filter model.TemplateQueryParams,
+	) ([]model.TemplateResponse, error)
+	GetTemplateByID(ctx context.Context, id uint) (*model.TemplateResponse, error)
+	CreateTemplate(
+		ctx context.Context,
+		req model.TemplateCreateRequest,
+	) (*model.TemplateResponse, error)
+	UpdateTemplate(
+		ctx context.Context,
+		id uint,
+		req model.TemplateUpdateRequest,
+	) (*model.TemplateResponse, error)
+	DeleteTemplate(ctx context.Context, id uint) error
+	PreviewTemplate(
+		ctx context.Context,
+		req model.TemplatePreviewRequest,
+	) (*model.RenderedNotification, error)
+
+	// Render resolves the active custom template for an event and channel, falling
+	// back to the built-in default, and renders it with data.
+	Render(
+		ctx context.Context,
+		eventType entity.NotificationEventType,
+		channel entity.NotificationChannel,
+		data map[string]any,
+	) (*model.RenderedNotification, error)
+}
+
+// NotificationTemplateServiceImpl implements NotificationTemplateService
+type NotificationTemplateServiceImpl struct {
+	templateRepo repository.NotificationTemplateRepository
+}
+
+// NewNotificationTemplateService creates a new instance of NotificationTemplateService
+func NewNotificationTemplateService(
+	templateRepo repository.NotificationTemplateRepository,
+) NotificationTemplateService {
+	return &NotificationTemplateServiceImpl{
+		templateRepo: templateRepo,
+	}
+}
+
+// ListTemplates lists custom templates with optional filters
+func (s *NotificationTemplateServiceImpl) ListTemplates(
+	ctx context.Context,
+	filter model.TemplateQueryParams,
+) ([]model.TemplateResponse, error) {
+	templates, err := s.templateRepo.FindAll(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]model.TemplateResponse, 0, len(templates))
+	for i := range templates {
+		responses = append(responses, factory.BuildTemplateResponse(&templates[i]))
+	}
+	return responses, nil
+}
+
+// GetTemplateByID retrieves a custom template by ID
+func (s *NotificationTemplateServiceImpl) GetTemplateByID(
+	ctx context.Context,
+	id uint,
+) (*model.TemplateResponse, error) {
+	tmpl, err := s.templateRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	response := factory.BuildTemplateResponse(tmpl)
+	return &response, nil
+}
+
+// CreateTemplate creates a custom template for an event type and channel
+func (s *NotificationTemplateServiceImpl) CreateTemplate(
+	ctx context.Context,
+	req model.TemplateCreateRequest,
+) (*model.TemplateResponse, error) {
+	req.EventType = normalizeEventType(req.EventType)
+	req.Channel = normalizeChannel(req.Channel)
+	if err := validateEventAndChannel(req.EventType, req.Channel); err != nil {
+		return nil, err
+	}
+	if err := utils.ParseTemplate(req.Channel, req.Subject, req.Body); err != nil {
+		return nil, notificationErrors.ErrInvalidTemplate(err.Error())
+	}
+
+	existing, err := s.templateRepo.FindByEventAndChannel(ctx, req.EventType, req.Channel)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, notificationErrors.ErrTemplateAlreadyExists
+	}
+
+	tmpl := factory.BuildTemplateEntity(req)
+	if err := s.templateRepo.Create(ctx, tmpl); err != nil {
+		return nil, err
+	}
+
+	response := factory.BuildTemplateResponse(tmpl)
+	return &response, nil
+}
+
+// UpdateTemplate updates subject, body, or active state of a custom template
+func (s *NotificationTemplateServiceImpl) UpdateTemplate(
+	ctx context.Context,
+	id uint,
+	req model.TemplateUpdateRequest,
+) (*model.TemplateResponse, error) {
+	tmpl, err := s.templateRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Subject != nil {
+		tmpl.Subject = *req.Subject
+	}
+	if req.Body != nil {
+		tmpl.Body = *req.Body
+	}
+	if req.IsActive != nil {
+		tmpl.IsActive = *req.IsActive
+	}
+	if err := utils.ParseTemplate(tmpl.Channel, tmpl.Subject, tmpl.Body); err != nil {
+		return nil, notificationErrors.ErrInvalidTemplate(err.Error())
+	}
+
+	if err := s.templateRepo.Update(ctx, tmpl); err != nil {
+		return nil, err
+	}
+
+	response := factory.BuildTemplateResponse(tmpl)
+	return &response, nil
+}
+
+// DeleteTemplate removes a custom template; the built-in default applies again
+func (s *NotificationTemplateServiceImpl) DeleteTemplate(ctx context.Context, id uint) error {
+	return s.templateRepo.Delete(ctx, id)
+}
+
+// PreviewTemplate renders a draft or the effective template with sample data
+func (s *NotificationTemplateServiceImpl) PreviewTemplate(
+	ctx context.Context,
+	req model.TemplatePreviewRequest,
+) (*model.RenderedNotification, error) {
+	eventType := normalizeEventType(req.EventType)
+	channel := normalizeChannel(req.Channel)
+	if err := validateEventAndChannel(eventType, channel); err != nil {
+		return nil, err
+	}
+
+	if req.Subject == nil && req.Body == nil {
+		return s.Render(ctx, eventType, channel, req.Data)
+	}
+
+	// Draft preview: fill the missing part from the effective template
+	subject, body, _, err := s.resolveTemplate(ctx, eventType, channel)
+	if err != nil && (req.Subject == nil || req.Body == nil) {
+		return nil, err
+	}
+	if req.Subject != nil {
+		subject = *req.Subject
+	}
+	if req.Body != nil {
+		body = *req.Body
+	}
+	if err := utils.ParseTemplate(channel, subject, body); err != nil {
+		return nil, notificationErrors.ErrInvalidTemplate(err.Error())
+	}
+	return renderNotification(
+		eventType,
+		channel,
+		subject,
+		body,
+		constant.TEMPLATE_SOURCE_DRAFT,
+		req.Data,
+	)
+}
+
+// Render resolves and renders the effective template for an event and channel
+func (s *NotificationTemplateServiceImpl) Render(
+	ctx context.Context,
+	eventType entity.NotificationEventType,
+	channel entity.NotificationChannel,
+	data map[string]any,
+) (*model.RenderedNotification, error) {
+	subject, body, source, err := s.resolveTemplate(ctx, eventType, channel)
+	if err != nil {
+		return nil, err
+	}
+	return renderNotification(eventType, channel, subject, body, source, data)
+}
+
+// resolveTemplate returns the active custom template, or the built-in default
+func (s *NotificationTemplateServiceImpl) resolveTemplate(
+	ctx context.Context,
+	eventType entity.NotificationEventType,
+	channel entity.NotificationChannel,
+) (string, string, string, error) {
+	custom, err := s.templateRepo.FindByEventAndChannel(ctx, eventType, channel)
+	if err != nil {
+		return "", "", "", err
+	}
+	if custom != nil && custom.IsActive {
+		return custom.Subject, custom.Body, constant.TEMPLATE_SOURCE_CUSTOM, nil
+	}
+
+	if def, ok := utils.GetDefaultTemplate(eventType, channel); ok {
+		return def.Subject, def.Body, constant.TEMPLATE_SOURCE_DEFAULT, nil
+	}
+	return "", "", "", notificationErrors.ErrTemplateNotFound
+}
+
+func renderNotification(
+	eventType entity.NotificationEventType,
+	channel entity.NotificationChannel,
+	subject, body, source string,
+	data map[string]any,
+) (*model.RenderedNotification, error) {
+	renderedSubject, renderedBody, err := utils.RenderTemplate(channel, subject, body, data)
+	if err != nil {
+		return nil, notificationErrors.ErrTemplateRenderFailed(err.Error())
+	}
+	return &model.RenderedNotification{
+		EventType: eventType,
+		Channel:   channel,
+		Subject:   renderedSubject,
+		Body:      renderedBody,
+		Source:    source,
+	}, nil
+}
+
+func validateEventAndChannel(
+	eventType entity.NotificationEventType,
+	channel entity.NotificationChannel,
+) error {
+	if !eventType.IsValid() {
+		return notificationErrors.ErrInvalidNotificationEventType
+	}
+	if !channel.IsValid() {
+		return notificationErrors.ErrInvalidNotificationChannel
+	}
+	return nil
+}
+
+func normalizeEventType(eventType entity.NotificationEventType) entity.NotificationEventType {
+	return entity.NotificationEventType(strings.ToLower(strings.TrimSpace(eventType.String())))
+}
+
+func normalizeChannel(channel entity.NotificationChannel) entity.NotificationChannel {
+	return entity.NotificationChannel(strings.ToLower(strings.TrimSpace(channel.String())))
+}
@@ -0,0 +1,334 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"ecommerce-be/common/auth"
+	"ecommerce-be/common/db"
+	"ecommerce-be/notification/entity"
+	notificationErrors "ecommerce-be/notification/error"
+	"ecommerce-be/notification/factory"
+	"ecommerce-be/notification/model"
+	"ecommerce-be/notification/repository"
+	"ecommerce-be/notification/service/channel"
+	"ecommerce-be/notification/utils"
+	"ecommerce-be/notification/utils/constant"
+)
+
+// TXTResolver looks up DNS TXT records (satisfied by *net.Resolver)
+type TXTResolver interface {
+	LookupTXT(ctx context.Context, name string) ([]string, error)
+}
+
+// SendingDomainService manages sellers' own email sending domains. Sellers on a
+// qualifying plan request a domain; an admin registers it with the email provider
+// and records the DKIM selector; a DNS check then verifies the SPF and DKIM records.
+// Only verified domains are used to send.
+type SendingDomainService interface {
+	GetSendingDomain(ctx context.Context, sellerID uint) (*model.SendingDomainResponse, error)
+	SaveSendingDomain(
+		ctx context.Context,
+		sellerID uint,
+		req model.SaveSendingDomainRequest,
+	) (*model.SendingDomainResponse, error)
+	DeleteSendingDomain(ctx context.Context, sellerID uint) error
+	// VerifySellerSendingDomain re-runs the DNS checks for the seller's own domain
+	VerifySellerSendingDomain(
+		ctx context.Context,
+		sellerID uint,
+	) (*model.SendingDomainResponse, error)
+
+	ListSendingDomains(
+		ctx context.Context,
+		params model.SendingDomainQueryParams,
+	) ([]model.SendingDomainResponse, error)
+	ConfigureDKIM(
+		ctx context.Context,
+		id uint,
+		req model.ConfigureSendingDomainDKIMRequest,
+	) (*model.SendingDomainResponse, error)
+	VerifySendingDomain(ctx context.Context, id uint) (*model.SendingDomainResponse, error)
+
+	// ResolveSender returns the seller's verified From mailbox, or nil when email
+	// goes out from the platform address.
+	ResolveSender(ctx context.Context, sellerID uint) (*channel.SenderAddress, error)
+}
+
+// SendingDomainServiceImpl implements SendingDomainService
+type SendingDomainServiceImpl struct {
+	domainRepo repository.SellerSendingDomainRepository
+	resolver   TXTResolver
+	spfInclude string
+}
+
+// NewSendingDomainService creates a new instance of SendingDomainService. spfInclude
+// is the provider domain SPF records must include; empty accepts any SPF policy.
+func NewSendingDomainService(
+	domainRepo repository.SellerSendingDomainRepository,
+	resolver TXTResolver,
+	spfInclude string,
+) SendingDomainService {
+	return &SendingDomainServiceImpl{
+		domainRepo: domainRepo,
+		resolver:   resolver,
+		spfInclude: strings.ToLower(strings.TrimSpace(spfInclude)),
+	}
+}
+
+// GetSendingDomain returns the seller's sending domain and the DNS records to publish
+func (s *SendingDomainServiceImpl) GetSendingDomain(
+	ctx context.Context,
+	sellerID uint,
+) (*model.SendingDomainResponse, error) {
+	domain, err := s.domainRepo.FindBySellerID(ctx, sellerID)
+	if err != nil {
+		return nil, err
+	}
+	response := factory.BuildSendingDomainResponse(domain, s.spfInclude)
+	return &response, nil
+}
+
+// SaveSendingDomain requests a sending domain for the seller. Changing the domain
+// discards its DKIM configuration and verification; changing only the From mailbox
+// within the same domain keeps them.
+func (s *SendingDomainServiceImpl) SaveSendingDomain(
+	ctx context.Context,
+	sellerID uint,
+	req model.SaveSendingDomainRequest,
+) (*model.SendingDomainResponse, error) {
+	if err := s.requireEligiblePlan(ctx, sellerID); err != nil {
+		return nil, err
+	}
+
+	domainName := utils.NormalizeSendingDomain(req.Domain)
+	if !utils.IsValidSendingDomain(domainName) {
+		return nil, notificationErrors.ErrInvalidSendingDomain
+	}
+	fromAddress := strings.TrimSpace(req.FromAddress)
+	if !utils.FromAddressOnDomain(fromAddress, domainName) {
+		return nil, notificationErrors.ErrSendingDomainFromMismatch
+	}
+
+	domain, err := s.domainRepo.FindBySellerID(ctx, sellerID)
+	if errors.Is(err, notificationErrors.ErrSendingDomainNotFound) {
+		domain = &entity.SellerSendingDomain{SellerID: sellerID}
+	} else if err != nil {
+		return nil, err
+	}
+
+	if domain.Domain != domainName {
+		resetVerification(domain)
+		domain.Domain = domainName
+		domain.DKIMSelector = ""
+		domain.DKIMRecordValue = ""
+	}
+	domain.FromAddress = fromAddress
+	domain.FromName = strings.TrimSpace(req.FromName)
+
+	if err := s.domainRepo.Save(ctx, domain); err != nil {
+		return nil, err
+	}
+	response := factory.BuildSendingDomainResponse(domain, s.spfInclude)
+	return &response, nil
+}
+
+// DeleteSendingDomain removes the seller's domain; email returns to the platform address
+func (s *SendingDomainServiceImpl) DeleteSendingDomain(ctx context.Context, sellerID uint) error {
+	deleted, err := s.domainRepo.DeleteBySellerID(ctx, sellerID)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return notificationErrors.ErrSendingDomainNotFound
+	}
+	return nil
+}
+
+// VerifySellerSendingDomain re-checks the DNS records of the seller's domain
+func (s *SendingDomainServiceImpl) VerifySellerSendingDomain(
+	ctx context.Context,
+	sellerID uint,
+) (*model.SendingDomainResponse, error) {
+	domain, err := s.domainRepo.FindBySellerID(ctx, sellerID)
+	if err != nil {
+		return nil, err
+	}
+	return s.verify(ctx, domain)
+}
+
+// ListSendingDomains lists all sellers' sending domains for admin follow-up
+func (s *SendingDomainServiceImpl) ListSendingDomains(
+	ctx context.Context,
+	params model.SendingDomainQueryParams,
+) ([]model.SendingDomainResponse, error) {
+	domains, err := s.domainRepo.FindAll(ctx, params.Status)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]model.SendingDomainResponse, 0, len(domains))
+	for i := range domains {
+		response := factory.BuildSendingDomainResponse(&domains[i], s.spfInclude)
+		responses = append(responses, response)
+	}
+	return responses, nil
+}
+
+// ConfigureDKIM records the DKIM selector the provider issued for the domain. The
+// domain goes back to pending until it is verified against the new record.
+func (s *SendingDomainServiceImpl) ConfigureDKIM(
+	ctx context.Context,
+	id uint,
+	req model.ConfigureSendingDomainDKIMRequest,
+) (*model.SendingDomainResponse, error) {
+	selector := strings.ToLower(strings.TrimSpace(req.Selector))
+	if !utils.IsValidDKIMSelector(selector) {
+		return nil, notificationErrors.ErrInvalidSendingDomain.WithMessage(
+			constant.INVALID_DKIM_SELECTOR_MSG,
+		)
+	}
+
+	domain, err := s.domainRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	resetVerification(domain)
+	domain.DKIMSelector = selector
+	domain.DKIMRecordValue = strings.TrimSpace(req.RecordValue)
+
+	if err := s.domainRepo.Save(ctx, domain); err != nil {
+		return nil, err
+	}
+	response := factory.BuildSendingDomainResponse(domain, s.spfInclude)
+	return &response, nil
+}
+
+// VerifySendingDomain checks the DNS records of any seller's domain
+func (s *SendingDomainServiceImpl) VerifySendingDomain(
+	ctx context.Context,
+	id uint,
+) (*model.SendingDomainResponse, error) {
+	domain, err := s.domainRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return s.verify(ctx, domain)
+}
+
+// ResolveSender returns the verified From mailbox while the seller's plan still
+// includes custom sending domains.
+func (s *SendingDomainServiceImpl) ResolveSender(
+	ctx context.Context,
+	sellerID uint,
+) (*channel.SenderAddress, error) {
+	domain, err := s.domainRepo.FindBySellerID(ctx, sellerID)
+	if errors.Is(err, notificationErrors.ErrSendingDomainNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if domain.Status != entity.SENDING_DOMAIN_STATUS_VERIFIED {
+		return nil, nil
+	}
+
+	if err := s.requireEligiblePlan(ctx, sellerID); err != nil {
+		if errors.Is(err, notificationErrors.ErrSendingDomainPlanRequired) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &channel.SenderAddress{Name: domain.FromName, Address: domain.FromAddress}, nil
+}
+
+// verify looks up the SPF policy and DKIM key of the domain and stores the outcome.
+// A verified domain that fails a later check stops being used until it passes again.
+// DNS errors other than missing records leave the stored state untouched.
+func (s *SendingDomainServiceImpl) verify(
+	ctx context.Context,
+	domain *entity.SellerSendingDomain,
+) (*model.SendingDomainResponse, error) {
+	if domain.DKIMSelector == "" {
+		return nil, notificationErrors.ErrSendingDomainDKIMNotConfigured
+	}
+
+	spfRecords, err := s.lookupTXT(ctx, domain.Domain)
+	if err != nil {
+		return nil, err
+	}
+	dkimRecords, err := s.lookupTXT(ctx, utils.DKIMRecordName(domain.DKIMSelector, domain.Domain))
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	domain.SPFVerified = utils.HasSPFRecord(spfRecords, s.spfInclude)
+	domain.DKIMVerified = utils.HasDKIMRecord(dkimRecords)
+	domain.LastCheckedAt = &now
+
+	var failures []string
+	if !domain.SPFVerified {
+		failures = append(failures, constant.SPF_RECORD_MISSING_MSG)
+	}
+	if !domain.DKIMVerified {
+		failures = append(failures, constant.DKIM_RECORD_MISSING_MSG)
+	}
+	domain.LastCheckError = strings.Join(failures, "; ")
+
+	if len(failures) == 0 {
+		if domain.Status != entity.SENDING_DOMAIN_STATUS_VERIFIED {
+			domain.VerifiedAt = &now
+		}
+		domain.Status = entity.SENDING_DOMAIN_STATUS_VERIFIED
+	} else {
+		domain.Status = entity.SENDING_DOMAIN_STATUS_FAILED
+		domain.VerifiedAt = nil
+	}
+
+	if err := s.domainRepo.Save(ctx, domain); err != nil {
+		return nil, err
+	}
+	response := factory.BuildSendingDomainResponse(domain, s.spfInclude)
+	return &response, nil
+}
+
+// lookupTXT returns the TXT records at name; a name without records yields none
+func (s *SendingDomainServiceImpl) lookupTXT(ctx context.Context, name string) ([]string, error) {
+	records, err := s.resolver.LookupTXT(ctx, name)
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) && dnsErr.IsNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("dns lookup of %s failed: %w", name, err)
+	}
+	return records, nil
+}
+
+// requireEligiblePlan rejects sellers whose active plan does not include custom
+// sending domains
+func (s *SendingDomainServiceImpl) requireEligiblePlan(ctx context.Context, sellerID uint) error {
+	sellerData, err := auth.GetSellerValidationData(db.DB(ctx), sellerID)
+	if err != nil {
+		return err
+	}
+	if !sellerData.IsSubscriptionActive() || !utils.IsSendingDomainPlan(sellerData.PlanName) {
+		return notificationErrors.ErrSendingDomainPlanRequired
+	}
+	return nil
+}
+
+// resetVerification returns a domain to pending, e.g. after its DNS setup changed
+func resetVerification(domain *entity.SellerSendingDomain) {
+	domain.Status = entity.SENDING_DOMAIN_STATUS_PENDING
+	domain.SPFVerified = false
+	domain.DKIMVerified = false
+	domain.LastCheckError = ""
+	domain.VerifiedAt = nil
+}
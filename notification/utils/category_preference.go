@@ -0,0 +1,37 @@
+package utils
+
+import "ecommerce-be/notification/entity"
+
+// DefaultCategoryEnabled reports whether a category is on for users who have not
+// set a preference. Marketing is opt-in; service notifications are opt-out.
+func DefaultCategoryEnabled(category entity.NotificationCategory) bool {
+	return category != entity.NOTIFICATION_CATEGORY_MARKETING
+}
+
+// ResolveCategoryPreferences merges explicit preferences over the defaults and
+// returns the enabled flag for every supported category.
+func ResolveCategoryPreferences(
+	prefs []entity.NotificationCategoryPreference,
+) map[entity.NotificationCategory]bool {
+	resolved := make(
+		map[entity.NotificationCategory]bool,
+		len(entity.AllNotificationCategories()),
+	)
+	for _, category := range entity.AllNotificationCategories() {
+		resolved[category] = DefaultCategoryEnabled(category)
+	}
+	for _, pref := range prefs {
+		if pref.Category.IsValid() {
+			resolved[pref.Category] = pref.IsEnabled
+		}
+	}
+	return resolved
+}
+
+// IsCategoryEnabled reports whether notifications of category should be delivered.
+func IsCategoryEnabled(
+	prefs []entity.NotificationCategoryPreference,
+	category entity.NotificationCategory,
+) bool {
+	return ResolveCategoryPreferences(prefs)[category]
+}
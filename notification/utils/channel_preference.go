@@ -0,0 +1,39 @@
+package utils
+
+import "ecommerce-be/notification/entity"
+
+// DefaultChannelEnabled reports whether a channel is on for users who have not
+// set a preference. SMS is opt-in because every message costs money.
+func DefaultChannelEnabled(channel entity.NotificationChannel) bool {
+	return channel != entity.NOTIFICATION_CHANNEL_SMS
+}
+
+// ResolveChannelPreferences merges explicit preferences over the defaults and
+// returns the enabled flag for every supported channel.
+func ResolveChannelPreferences(
+	prefs []entity.NotificationChannelPreference,
+) map[entity.NotificationChannel]bool {
+	resolved := make(map[entity.NotificationChannel]bool, len(entity.AllNotificationChannels()))
+	for _, channel := range entity.AllNotificationChannels() {
+		resolved[channel] = DefaultChannelEnabled(channel)
+	}
+	for _, pref := range prefs {
+		if pref.Channel.IsValid() {
+			resolved[pref.Channel] = pref.IsEnabled
+		}
+	}
+	return resolved
+}
+
+// EnabledChannels returns the channels a notification should be delivered on,
+// in the stable order of AllNotificationChannels.
+func EnabledChannels(prefs []entity.NotificationChannelPreference) []entity.NotificationChannel {
+	resolved := ResolveChannelPreferences(prefs)
+	channels := make([]entity.NotificationChannel, 0, len(resolved))
+	for _, channel := range entity.AllNotificationChannels() {
+		if resolved[channel] {
+			channels = append(channels, channel)
+		}
+	}
+	return channels
+}
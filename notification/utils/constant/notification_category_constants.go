@@ -0,0 +1,26 @@
+package constant
+
+const (
+	CATEGORY_PREFERENCES_FETCHED_MSG = "Notification category preferences fetched successfully"
+	CATEGORY_PREFERENCES_UPDATED_MSG = "Notification category preferences updated successfully"
+	UNSUBSCRIBED_MSG                 = "You have been unsubscribed successfully"
+	CATEGORY_PREFERENCES_FIELD_NAME  = "preferences"
+)
+
+const (
+	FAILED_TO_GET_CATEGORY_PREFERENCES_MSG    = "Failed to get notification category preferences"
+	FAILED_TO_UPDATE_CATEGORY_PREFERENCES_MSG = "Failed to update notification category preferences"
+	FAILED_TO_UNSUBSCRIBE_MSG                 = "Failed to unsubscribe"
+)
+
+const (
+	INVALID_NOTIFICATION_CATEGORY_CODE = "INVALID_NOTIFICATION_CATEGORY"
+	INVALID_NOTIFICATION_CATEGORY_MSG  = "Invalid notification category"
+	INVALID_UNSUBSCRIBE_TOKEN_CODE     = "INVALID_UNSUBSCRIBE_TOKEN"
+	INVALID_UNSUBSCRIBE_TOKEN_MSG      = "Unsubscribe link is invalid"
+)
+
+const (
+	// UNSUBSCRIBE_URL_DATA_KEY exposes the unsubscribe link to email templates.
+	UNSUBSCRIBE_URL_DATA_KEY = "UnsubscribeURL"
+)
@@ -0,0 +1,28 @@
+package constant
+
+const (
+	NOTIFICATIONS_FETCHED_MSG     = "Notifications fetched successfully"
+	NOTIFICATIONS_MARKED_READ_MSG = "Notifications marked as read successfully"
+	NOTIFICATIONS_CLEARED_MSG     = "Notifications cleared successfully"
+	UNREAD_COUNT_FETCHED_MSG      = "Unread notification count fetched successfully"
+)
+
+const (
+	FAILED_TO_LIST_NOTIFICATIONS_MSG      = "Failed to fetch notifications"
+	FAILED_TO_MARK_NOTIFICATIONS_READ_MSG = "Failed to mark notifications as read"
+	FAILED_TO_CLEAR_NOTIFICATIONS_MSG     = "Failed to clear notifications"
+	FAILED_TO_FETCH_UNREAD_COUNT_MSG      = "Failed to fetch unread notification count"
+)
+
+const (
+	NOTIFICATION_SELECTION_INVALID_CODE = "NOTIFICATION_SELECTION_INVALID"
+	NOTIFICATION_SELECTION_INVALID_MSG  = "Provide either notification IDs or all=true, not both"
+)
+
+const (
+	// DEFAULT_INBOX_PAGE_SIZE is used when the client does not pass ?pageSize.
+	DEFAULT_INBOX_PAGE_SIZE = 20
+
+	// MAX_INBOX_PAGE_SIZE caps a single notification center page.
+	MAX_INBOX_PAGE_SIZE = 100
+)
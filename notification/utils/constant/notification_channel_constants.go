@@ -0,0 +1,28 @@
+package constant
+
+const (
+	CHANNEL_PREFERENCES_FETCHED_MSG = "Notification channel preferences fetched successfully"
+	CHANNEL_PREFERENCES_UPDATED_MSG = "Notification channel preferences updated successfully"
+	PUSH_TOKEN_REGISTERED_MSG       = "Push token registered successfully"
+	PUSH_TOKEN_REMOVED_MSG          = "Push token removed successfully"
+	CHANNEL_PREFERENCES_FIELD_NAME  = "preferences"
+)
+
+const (
+	FAILED_TO_GET_CHANNEL_PREFERENCES_MSG    = "Failed to get notification channel preferences"
+	FAILED_TO_UPDATE_CHANNEL_PREFERENCES_MSG = "Failed to update notification channel preferences"
+	FAILED_TO_REGISTER_PUSH_TOKEN_MSG        = "Failed to register push token"
+	FAILED_TO_REMOVE_PUSH_TOKEN_MSG          = "Failed to remove push token"
+)
+
+const (
+	PUSH_TOKEN_NOT_FOUND_CODE = "PUSH_TOKEN_NOT_FOUND"
+	PUSH_TOKEN_NOT_FOUND_MSG  = "Push token not found"
+	INVALID_RECIPIENT_CODE    = "INVALID_NOTIFICATION_RECIPIENT"
+	INVALID_RECIPIENT_MSG     = "Notification recipient is required"
+)
+
+const (
+	// NOTIFICATION_DISPATCH_COMMAND is the scheduler command that delivers a queued notification.
+	NOTIFICATION_DISPATCH_COMMAND = "notification.dispatch"
+)
@@ -0,0 +1,28 @@
+package constant
+
+const (
+	NOTIFICATIONS_SYNCED_MSG = "Notification changes fetched successfully"
+	READ_STATE_UPDATED_MSG   = "Notification read state updated successfully"
+)
+
+const (
+	FAILED_TO_SYNC_NOTIFICATIONS_MSG = "Failed to fetch notification changes"
+	FAILED_TO_UPDATE_READ_STATE_MSG  = "Failed to update notification read state"
+)
+
+const (
+	READ_STATE_EMPTY_CODE    = "NOTIFICATION_READ_STATE_EMPTY"
+	READ_STATE_EMPTY_MSG     = "At least one notification ID must be provided in read or unread"
+	READ_STATE_CONFLICT_CODE = "NOTIFICATION_READ_STATE_CONFLICT"
+	READ_STATE_CONFLICT_MSG  = "A notification cannot be marked both read and unread"
+	INVALID_DEVICE_ID_CODE   = "INVALID_NOTIFICATION_DEVICE_ID"
+	INVALID_DEVICE_ID_MSG    = "Device ID must not be blank"
+)
+
+const (
+	// DEFAULT_SYNC_PAGE_SIZE is used when the client does not pass ?limit.
+	DEFAULT_SYNC_PAGE_SIZE = 50
+
+	// MAX_SYNC_PAGE_SIZE caps a single delta page.
+	MAX_SYNC_PAGE_SIZE = 200
+)
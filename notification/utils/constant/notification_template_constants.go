@@ -0,0 +1,40 @@
+package constant
+
+const (
+	TEMPLATES_LISTED_MSG    = "Notification templates listed successfully"
+	TEMPLATE_RETRIEVED_MSG  = "Notification template retrieved successfully"
+	TEMPLATE_CREATED_MSG    = "Notification template created successfully"
+	TEMPLATE_UPDATED_MSG    = "Notification template updated successfully"
+	TEMPLATE_DELETED_MSG    = "Notification template deleted successfully"
+	TEMPLATE_RENDERED_MSG   = "Notification template rendered successfully"
+	TEMPLATES_FIELD_NAME    = "templates"
+	TEMPLATE_FIELD_NAME     = "template"
+	INVALID_TEMPLATE_ID_MSG = "Invalid template ID"
+	TEMPLATE_SOURCE_CUSTOM  = "custom"
+	TEMPLATE_SOURCE_DEFAULT = "default"
+	TEMPLATE_SOURCE_DRAFT   = "draft"
+)
+
+const (
+	FAILED_TO_LIST_TEMPLATES_MSG  = "Failed to list notification templates"
+	FAILED_TO_GET_TEMPLATE_MSG    = "Failed to get notification template"
+	FAILED_TO_CREATE_TEMPLATE_MSG = "Failed to create notification template"
+	FAILED_TO_UPDATE_TEMPLATE_MSG = "Failed to update notification template"
+	FAILED_TO_DELETE_TEMPLATE_MSG = "Failed to delete notification template"
+	FAILED_TO_RENDER_TEMPLATE_MSG = "Failed to render notification template"
+)
+
+const (
+	TEMPLATE_NOT_FOUND_CODE           = "NOTIFICATION_TEMPLATE_NOT_FOUND"
+	TEMPLATE_NOT_FOUND_MSG            = "Notification template not found"
+	TEMPLATE_ALREADY_EXISTS_CODE      = "NOTIFICATION_TEMPLATE_ALREADY_EXISTS"
+	TEMPLATE_ALREADY_EXISTS_MSG       = "A template already exists for this event type and channel"
+	INVALID_TEMPLATE_CODE             = "INVALID_NOTIFICATION_TEMPLATE"
+	INVALID_TEMPLATE_MSG              = "Invalid notification template: %s"
+	TEMPLATE_RENDER_FAILED_CODE       = "NOTIFICATION_TEMPLATE_RENDER_FAILED"
+	TEMPLATE_RENDER_FAILED_MSG        = "Failed to render notification template: %s"
+	INVALID_NOTIFICATION_CHANNEL_CODE = "INVALID_NOTIFICATION_CHANNEL"
+	INVALID_NOTIFICATION_CHANNEL_MSG  = "Invalid notification channel"
+	INVALID_NOTIFICATION_EVENT_CODE   = "INVALID_NOTIFICATION_EVENT_TYPE"
+	INVALID_NOTIFICATION_EVENT_MSG    = "Invalid notification event type"
+)
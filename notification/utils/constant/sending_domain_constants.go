@@ -0,0 +1,43 @@
+package constant
+
+const (
+	SENDING_DOMAIN_FETCHED_MSG    = "Sending domain fetched successfully"
+	SENDING_DOMAIN_SAVED_MSG      = "Sending domain saved successfully"
+	SENDING_DOMAIN_REMOVED_MSG    = "Sending domain removed successfully"
+	SENDING_DOMAINS_LISTED_MSG    = "Sending domains listed successfully"
+	SENDING_DOMAIN_CHECKED_MSG    = "Sending domain verification checked"
+	SENDING_DOMAIN_FIELD_NAME     = "sendingDomain"
+	SENDING_DOMAINS_FIELD_NAME    = "sendingDomains"
+	INVALID_SENDING_DOMAIN_ID_MSG = "Invalid sending domain ID"
+)
+
+const (
+	FAILED_TO_GET_SENDING_DOMAIN_MSG    = "Failed to get sending domain"
+	FAILED_TO_SAVE_SENDING_DOMAIN_MSG   = "Failed to save sending domain"
+	FAILED_TO_REMOVE_SENDING_DOMAIN_MSG = "Failed to remove sending domain"
+	FAILED_TO_LIST_SENDING_DOMAINS_MSG  = "Failed to list sending domains"
+	FAILED_TO_VERIFY_SENDING_DOMAIN_MSG = "Failed to verify sending domain"
+)
+
+const (
+	SENDING_DOMAIN_NOT_FOUND_CODE           = "SENDING_DOMAIN_NOT_FOUND"
+	SENDING_DOMAIN_NOT_FOUND_MSG            = "Sending domain not found"
+	INVALID_SENDING_DOMAIN_CODE             = "INVALID_SENDING_DOMAIN"
+	INVALID_SENDING_DOMAIN_MSG              = "Domain must be a valid DNS name such as mail.example.com"
+	INVALID_DKIM_SELECTOR_MSG               = "DKIM selector must be a valid DNS label such as s1"
+	SENDING_DOMAIN_FROM_MISMATCH_CODE       = "SENDING_DOMAIN_FROM_MISMATCH"
+	SENDING_DOMAIN_FROM_MISMATCH_MSG        = "From address must be a mailbox on the sending domain"
+	SENDING_DOMAIN_PLAN_REQUIRED_CODE       = "SENDING_DOMAIN_PLAN_REQUIRED"
+	SENDING_DOMAIN_PLAN_REQUIRED_MSG        = "Custom sending domains require the Professional or Enterprise plan"
+	SENDING_DOMAIN_DKIM_NOT_CONFIGURED_CODE = "SENDING_DOMAIN_DKIM_NOT_CONFIGURED"
+	SENDING_DOMAIN_DKIM_NOT_CONFIGURED_MSG  = "A DKIM selector must be configured before verification"
+)
+
+const (
+	// SPF_RECORD_MISSING_MSG and DKIM_RECORD_MISSING_MSG are stored as the last check error
+	SPF_RECORD_MISSING_MSG  = "SPF record missing or does not authorize the email provider"
+	DKIM_RECORD_MISSING_MSG = "DKIM public key record not found for the selector"
+)
+
+// SENDING_DOMAIN_PLAN_NAMES are the plans whose sellers may send from their own domain
+var SENDING_DOMAIN_PLAN_NAMES = []string{"Professional", "Enterprise"}
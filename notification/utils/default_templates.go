@@ -0,0 +1,371 @@
+package utils
+
+import "ecommerce-be/notification/entity"
+
+// DefaultTemplate is a built-in template used when no active custom template exists.
+type DefaultTemplate struct {
+	Subject string
+	Body    string
+}
+
+type templateKey struct {
+	eventType entity.NotificationEventType
+	channel   entity.NotificationChannel
+}
+
+var defaultTemplates = map[templateKey]DefaultTemplate{
+	// order.placed
+	{entity.NOTIFICATION_EVENT_ORDER_PLACED, entity.NOTIFICATION_CHANNEL_EMAIL}: {
+		Subject: "Order {{.OrderNumber}} confirmed",
+		Body: "<p>Hi {{.CustomerName}},</p>" +
+			"<p>Thanks for your order <strong>{{.OrderNumber}}</strong>. " +
+			"We'll let you know when it ships.</p>" +
+			"{{if .DeliverySlot}}<p>Your delivery is scheduled for " +
+			"<strong>{{.DeliverySlot}}</strong>. " +
+			"Add the attached invite to your calendar.</p>{{end}}",
+	},
+	{entity.NOTIFICATION_EVENT_ORDER_PLACED, entity.NOTIFICATION_CHANNEL_SMS}: {
+		Body: "Your order {{.OrderNumber}} has been placed. We'll notify you when it ships.",
+	},
+	{entity.NOTIFICATION_EVENT_ORDER_PLACED, entity.NOTIFICATION_CHANNEL_PUSH}: {
+		Subject: "Order placed",
+		Body:    "Order {{.OrderNumber}} has been placed.",
+	},
+	{entity.NOTIFICATION_EVENT_ORDER_PLACED, entity.NOTIFICATION_CHANNEL_IN_APP}: {
+		Subject: "Order placed",
+		Body:    "Order {{.OrderNumber}} has been placed.",
+	},
+
+	// order.cancelled
+	{entity.NOTIFICATION_EVENT_ORDER_CANCELLED, entity.NOTIFICATION_CHANNEL_EMAIL}: {
+		Subject: "Order {{.OrderNumber}} cancelled",
+		Body: "<p>Hi {{.CustomerName}},</p>" +
+			"<p>Your order <strong>{{.OrderNumber}}</strong> has been cancelled.</p>" +
+			"{{if .RefundAmount}}<p>A refund of <strong>{{.RefundAmount}}</strong> is on its " +
+			"way to your original payment method.</p>{{end}}",
+	},
+	{entity.NOTIFICATION_EVENT_ORDER_CANCELLED, entity.NOTIFICATION_CHANNEL_SMS}: {
+		Body: "Your order {{.OrderNumber}} has been cancelled." +
+			"{{if .RefundAmount}} {{.RefundAmount}} will be refunded.{{end}}",
+	},
+	{entity.NOTIFICATION_EVENT_ORDER_CANCELLED, entity.NOTIFICATION_CHANNEL_PUSH}: {
+		Subject: "Order cancelled",
+		Body:    "Order {{.OrderNumber}} has been cancelled.",
+	},
+	{entity.NOTIFICATION_EVENT_ORDER_CANCELLED, entity.NOTIFICATION_CHANNEL_IN_APP}: {
+		Subject: "Order cancelled",
+		Body:    "Order {{.OrderNumber}} has been cancelled.",
+	},
+
+	// order.delivery_rescheduled
+	{entity.NOTIFICATION_EVENT_DELIVERY_RESCHEDULED, entity.NOTIFICATION_CHANNEL_EMAIL}: {
+		Subject: "Delivery for order {{.OrderNumber}} rescheduled",
+		Body: "<p>Hi {{.CustomerName}},</p>" +
+			"<p>The delivery of order <strong>{{.OrderNumber}}</strong> is now scheduled for " +
+			"<strong>{{.DeliverySlot}}</strong>. " +
+			"The attached invite updates the one already in your calendar.</p>",
+	},
+	{entity.NOTIFICATION_EVENT_DELIVERY_RESCHEDULED, entity.NOTIFICATION_CHANNEL_SMS}: {
+		Body: "Delivery of order {{.OrderNumber}} is rescheduled to {{.DeliverySlot}}.",
+	},
+	{entity.NOTIFICATION_EVENT_DELIVERY_RESCHEDULED, entity.NOTIFICATION_CHANNEL_PUSH}: {
+		Subject: "Delivery rescheduled",
+		Body:    "Delivery of order {{.OrderNumber}} moved to {{.DeliverySlot}}.",
+	},
+	{entity.NOTIFICATION_EVENT_DELIVERY_RESCHEDULED, entity.NOTIFICATION_CHANNEL_IN_APP}: {
+		Subject: "Delivery rescheduled",
+		Body:    "Delivery of order {{.OrderNumber}} moved to {{.DeliverySlot}}.",
+	},
+
+	// order.balance_due
+	{entity.NOTIFICATION_EVENT_ORDER_BALANCE_DUE, entity.NOTIFICATION_CHANNEL_EMAIL}: {
+		Subject: "Balance due for order {{.OrderNumber}}",
+		Body: "<p>Hi {{.CustomerName}},</p>" +
+			"<p>The remaining balance of <strong>{{.Amount}}</strong> for order " +
+			"<strong>{{.OrderNumber}}</strong> is due by <strong>{{.DueDate}}</strong>. " +
+			"Orders with an unpaid balance are cancelled after the due date.</p>",
+	},
+	{entity.NOTIFICATION_EVENT_ORDER_BALANCE_DUE, entity.NOTIFICATION_CHANNEL_SMS}: {
+		Body: "Balance of {{.Amount}} for order {{.OrderNumber}} is due by {{.DueDate}}.",
+	},
+	{entity.NOTIFICATION_EVENT_ORDER_BALANCE_DUE, entity.NOTIFICATION_CHANNEL_PUSH}: {
+		Subject: "Balance due",
+		Body:    "Pay {{.Amount}} for order {{.OrderNumber}} by {{.DueDate}}.",
+	},
+	{entity.NOTIFICATION_EVENT_ORDER_BALANCE_DUE, entity.NOTIFICATION_CHANNEL_IN_APP}: {
+		Subject: "Balance due",
+		Body:    "Pay the {{.Amount}} balance for order {{.OrderNumber}} by {{.DueDate}}.",
+	},
+
+	// order.message_received
+	{entity.NOTIFICATION_EVENT_ORDER_MESSAGE_RECEIVED, entity.NOTIFICATION_CHANNEL_EMAIL}: {
+		Subject: "New message about order {{.OrderNumber}}",
+		Body: "<p>Hi {{.CustomerName}},</p>" +
+			"<p>The {{.SenderRole}} sent a message about order <strong>{{.OrderNumber}}</strong>:</p>" +
+			"<blockquote>{{.MessagePreview}}</blockquote>" +
+			"<p>Reply from the order page.</p>",
+	},
+	{entity.NOTIFICATION_EVENT_ORDER_MESSAGE_RECEIVED, entity.NOTIFICATION_CHANNEL_SMS}: {
+		Body: "New message from the {{.SenderRole}} about order {{.OrderNumber}}.",
+	},
+	{entity.NOTIFICATION_EVENT_ORDER_MESSAGE_RECEIVED, entity.NOTIFICATION_CHANNEL_PUSH}: {
+		Subject: "New order message",
+		Body:    "{{.MessagePreview}}",
+	},
+	{entity.NOTIFICATION_EVENT_ORDER_MESSAGE_RECEIVED, entity.NOTIFICATION_CHANNEL_IN_APP}: {
+		Subject: "New message about order {{.OrderNumber}}",
+		Body:    "{{.MessagePreview}}",
+	},
+
+	// order.guest_claim_confirm (sent by email to the account holder)
+	{entity.NOTIFICATION_EVENT_GUEST_ORDER_CLAIM, entity.NOTIFICATION_CHANNEL_EMAIL}: {
+		Subject: "Add your guest orders to your account",
+		Body: "<p>Hi {{.CustomerName}},</p>" +
+			"<p>{{.OrderCount}} order(s) were placed as a guest with <strong>{{.Email}}</strong>. " +
+			"<a href=\"{{.ConfirmURL}}\">Add them to your account</a> " +
+			"(link expires {{.ExpiresAt}}).</p>" +
+			"<p>If you did not request this, ignore this email.</p>",
+	},
+	{entity.NOTIFICATION_EVENT_GUEST_ORDER_CLAIM, entity.NOTIFICATION_CHANNEL_SMS}: {
+		Body: "Check your inbox to add {{.OrderCount}} guest order(s) to your account.",
+	},
+	{entity.NOTIFICATION_EVENT_GUEST_ORDER_CLAIM, entity.NOTIFICATION_CHANNEL_PUSH}: {
+		Subject: "Claim your guest orders",
+		Body:    "Confirm adding {{.OrderCount}} guest order(s) to your account.",
+	},
+	{entity.NOTIFICATION_EVENT_GUEST_ORDER_CLAIM, entity.NOTIFICATION_CHANNEL_IN_APP}: {
+		Subject: "Claim your guest orders",
+		Body:    "Confirm adding {{.OrderCount}} guest order(s) to your account.",
+	},
+
+	// order.downloads_ready
+	{entity.NOTIFICATION_EVENT_ORDER_DOWNLOADS_READY, entity.NOTIFICATION_CHANNEL_EMAIL}: {
+		Subject: "Your downloads for order {{.OrderNumber}} are ready",
+		Body: "<p>Hi {{.CustomerName}},</p>" +
+			"<p>The digital items of order <strong>{{.OrderNumber}}</strong> are ready.</p>" +
+			"{{if .Downloads}}<ul>{{range .Downloads}}" +
+			"<li>{{.ProductName}}: <a href=\"{{.URL}}\">{{.Name}}</a></li>" +
+			"{{end}}</ul>{{end}}" +
+			"{{if .LicenseKeys}}<p>License keys:</p><ul>{{range .LicenseKeys}}" +
+			"<li>{{.ProductName}}: <code>{{.Key}}</code></li>" +
+			"{{end}}</ul>{{end}}" +
+			"<p>Links expire after a while; fresh ones are always on the order page.</p>",
+	},
+	{entity.NOTIFICATION_EVENT_ORDER_DOWNLOADS_READY, entity.NOTIFICATION_CHANNEL_SMS}: {
+		Body: "Downloads for order {{.OrderNumber}} are ready. See your email or the order page.",
+	},
+	{entity.NOTIFICATION_EVENT_ORDER_DOWNLOADS_READY, entity.NOTIFICATION_CHANNEL_PUSH}: {
+		Subject: "Downloads ready",
+		Body:    "Your digital items from order {{.OrderNumber}} are ready to download.",
+	},
+	{entity.NOTIFICATION_EVENT_ORDER_DOWNLOADS_READY, entity.NOTIFICATION_CHANNEL_IN_APP}: {
+		Subject: "Downloads ready",
+		Body:    "Your digital items from order {{.OrderNumber}} are ready to download.",
+	},
+
+	// payment.received
+	{entity.NOTIFICATION_EVENT_PAYMENT_RECEIVED, entity.NOTIFICATION_CHANNEL_EMAIL}: {
+		Subject: "Payment received for order {{.OrderNumber}}",
+		Body: "<p>Hi {{.CustomerName}},</p>" +
+			"<p>We've received your payment of <strong>{{.Amount}}</strong> " +
+			"for order <strong>{{.OrderNumber}}</strong>.</p>",
+	},
+	{entity.NOTIFICATION_EVENT_PAYMENT_RECEIVED, entity.NOTIFICATION_CHANNEL_SMS}: {
+		Body: "Payment of {{.Amount}} received for order {{.OrderNumber}}.",
+	},
+	{entity.NOTIFICATION_EVENT_PAYMENT_RECEIVED, entity.NOTIFICATION_CHANNEL_PUSH}: {
+		Subject: "Payment received",
+		Body:    "Payment for order {{.OrderNumber}} was successful.",
+	},
+	{entity.NOTIFICATION_EVENT_PAYMENT_RECEIVED, entity.NOTIFICATION_CHANNEL_IN_APP}: {
+		Subject: "Payment received",
+		Body:    "Payment of {{.Amount}} for order {{.OrderNumber}} was successful.",
+	},
+
+	// payment.failed
+	{entity.NOTIFICATION_EVENT_PAYMENT_FAILED, entity.NOTIFICATION_CHANNEL_EMAIL}: {
+		Subject: "Payment failed for order {{.OrderNumber}}",
+		Body: "<p>Hi {{.CustomerName}},</p>" +
+			"<p>We couldn't process the payment for order <strong>{{.OrderNumber}}</strong>. " +
+			"Your cart has been restored so you can try again.</p>",
+	},
+	{entity.NOTIFICATION_EVENT_PAYMENT_FAILED, entity.NOTIFICATION_CHANNEL_SMS}: {
+		Body: "Payment for order {{.OrderNumber}} failed. Please try again.",
+	},
+	{entity.NOTIFICATION_EVENT_PAYMENT_FAILED, entity.NOTIFICATION_CHANNEL_PUSH}: {
+		Subject: "Payment failed",
+		Body:    "Payment for order {{.OrderNumber}} failed. Tap to try again.",
+	},
+	{entity.NOTIFICATION_EVENT_PAYMENT_FAILED, entity.NOTIFICATION_CHANNEL_IN_APP}: {
+		Subject: "Payment failed",
+		Body:    "Payment for order {{.OrderNumber}} failed. Please try again.",
+	},
+
+	// payment.decline_spike
+	{entity.NOTIFICATION_EVENT_PAYMENT_DECLINE_SPIKE, entity.NOTIFICATION_CHANNEL_EMAIL}: {
+		Subject: "Payment declines spiking on {{.GatewayName}}",
+		Body: "<p><strong>{{.Declined}}</strong> of {{.Attempts}} payments on " +
+			"{{.GatewayName}} were declined in the last {{.WindowMinutes}} minutes " +
+			"(<strong>{{.DeclineRate}}%</strong>, alert threshold {{.Threshold}}%).</p>",
+	},
+	{entity.NOTIFICATION_EVENT_PAYMENT_DECLINE_SPIKE, entity.NOTIFICATION_CHANNEL_SMS}: {
+		Body: "{{.DeclineRate}}% of payments on {{.GatewayName}} declined in the last " +
+			"{{.WindowMinutes}} minutes.",
+	},
+	{entity.NOTIFICATION_EVENT_PAYMENT_DECLINE_SPIKE, entity.NOTIFICATION_CHANNEL_PUSH}: {
+		Subject: "Payment declines spiking",
+		Body:    "{{.DeclineRate}}% of payments on {{.GatewayName}} are being declined.",
+	},
+	{entity.NOTIFICATION_EVENT_PAYMENT_DECLINE_SPIKE, entity.NOTIFICATION_CHANNEL_IN_APP}: {
+		Subject: "Payment declines spiking",
+		Body: "{{.Declined}} of {{.Attempts}} payments on {{.GatewayName}} were declined " +
+			"in the last {{.WindowMinutes}} minutes.",
+	},
+
+	// shipment.status_changed
+	{entity.NOTIFICATION_EVENT_SHIPMENT_STATUS_CHANGED, entity.NOTIFICATION_CHANNEL_EMAIL}: {
+		Subject: "Shipment update for order {{.OrderNumber}}",
+		Body: "<p>Your shipment via {{.Carrier}} ({{.TrackingNo}}) is now " +
+			"<strong>{{.Status}}</strong>.</p>",
+	},
+	{entity.NOTIFICATION_EVENT_SHIPMENT_STATUS_CHANGED, entity.NOTIFICATION_CHANNEL_SMS}: {
+		Body: "Order {{.OrderNumber}}: shipment {{.TrackingNo}} is now {{.Status}}.",
+	},
+	{entity.NOTIFICATION_EVENT_SHIPMENT_STATUS_CHANGED, entity.NOTIFICATION_CHANNEL_PUSH}: {
+		Subject: "Shipment update",
+		Body:    "Your shipment for order {{.OrderNumber}} is now {{.Status}}.",
+	},
+	{entity.NOTIFICATION_EVENT_SHIPMENT_STATUS_CHANGED, entity.NOTIFICATION_CHANNEL_IN_APP}: {
+		Subject: "Shipment update",
+		Body:    "Your shipment for order {{.OrderNumber}} is now {{.Status}}.",
+	},
+
+	// shipment.delivered
+	{entity.NOTIFICATION_EVENT_SHIPMENT_DELIVERED, entity.NOTIFICATION_CHANNEL_EMAIL}: {
+		Subject: "Order {{.OrderNumber}} delivered",
+		Body:    "<p>Your order <strong>{{.OrderNumber}}</strong> has been delivered.</p>",
+	},
+	{entity.NOTIFICATION_EVENT_SHIPMENT_DELIVERED, entity.NOTIFICATION_CHANNEL_SMS}: {
+		Body: "Your order {{.OrderNumber}} has been delivered.",
+	},
+	{entity.NOTIFICATION_EVENT_SHIPMENT_DELIVERED, entity.NOTIFICATION_CHANNEL_PUSH}: {
+		Subject: "Delivered",
+		Body:    "Your order {{.OrderNumber}} has been delivered.",
+	},
+	{entity.NOTIFICATION_EVENT_SHIPMENT_DELIVERED, entity.NOTIFICATION_CHANNEL_IN_APP}: {
+		Subject: "Delivered",
+		Body:    "Your order {{.OrderNumber}} has been delivered.",
+	},
+
+	// inventory.low_stock (sent to the seller)
+	{entity.NOTIFICATION_EVENT_INVENTORY_LOW_STOCK, entity.NOTIFICATION_CHANNEL_EMAIL}: {
+		Subject: "Low stock: {{.VariantSKU}}",
+		Body: "<p>{{.VariantSKU}} at {{.LocationName}} is down to " +
+			"<strong>{{.Quantity}}</strong> units (threshold {{.Threshold}}).</p>",
+	},
+	{entity.NOTIFICATION_EVENT_INVENTORY_LOW_STOCK, entity.NOTIFICATION_CHANNEL_SMS}: {
+		Body: "Low stock: {{.VariantSKU}} at {{.LocationName}} has {{.Quantity}} units left.",
+	},
+	{entity.NOTIFICATION_EVENT_INVENTORY_LOW_STOCK, entity.NOTIFICATION_CHANNEL_PUSH}: {
+		Subject: "Low stock",
+		Body:    "{{.VariantSKU}} has {{.Quantity}} units left at {{.LocationName}}.",
+	},
+	{entity.NOTIFICATION_EVENT_INVENTORY_LOW_STOCK, entity.NOTIFICATION_CHANNEL_IN_APP}: {
+		Subject: "Low stock",
+		Body:    "{{.VariantSKU}} has {{.Quantity}} units left at {{.LocationName}}.",
+	},
+
+	// account.email_change_confirm (sent by email to the old and the new address)
+	{entity.NOTIFICATION_EVENT_EMAIL_CHANGE_CONFIRM, entity.NOTIFICATION_CHANNEL_EMAIL}: {
+		Subject: "Confirm your email change",
+		Body: "<p>Hi {{.CustomerName}},</p>" +
+			"<p>We received a request to change your account email from " +
+			"<strong>{{.OldEmail}}</strong> to <strong>{{.NewEmail}}</strong>. " +
+			"Both addresses must confirm before the change takes effect.</p>" +
+			"<p><a href=\"{{.ConfirmURL}}\">Confirm the change</a> " +
+			"(link expires {{.ExpiresAt}}).</p>" +
+			"<p>If you did not request this, ignore this email and change your password.</p>",
+	},
+	{entity.NOTIFICATION_EVENT_EMAIL_CHANGE_CONFIRM, entity.NOTIFICATION_CHANNEL_SMS}: {
+		Body: "An email change to {{.NewEmail}} was requested on your account. " +
+			"Check your inbox to confirm.",
+	},
+	{entity.NOTIFICATION_EVENT_EMAIL_CHANGE_CONFIRM, entity.NOTIFICATION_CHANNEL_PUSH}: {
+		Subject: "Confirm email change",
+		Body:    "Confirm the change of your account email to {{.NewEmail}}.",
+	},
+	{entity.NOTIFICATION_EVENT_EMAIL_CHANGE_CONFIRM, entity.NOTIFICATION_CHANNEL_IN_APP}: {
+		Subject: "Confirm email change",
+		Body:    "Confirm the change of your account email to {{.NewEmail}}.",
+	},
+
+	// account.email_changed (sent by email to the old and the new address)
+	{entity.NOTIFICATION_EVENT_EMAIL_CHANGED, entity.NOTIFICATION_CHANNEL_EMAIL}: {
+		Subject: "Your account email was changed",
+		Body: "<p>Hi {{.CustomerName}},</p>" +
+			"<p>Your account email is now <strong>{{.NewEmail}}</strong> " +
+			"(previously {{.OldEmail}}). You have been signed out on all devices.</p>" +
+			"<p>If you did not make this change, contact support immediately.</p>",
+	},
+	{entity.NOTIFICATION_EVENT_EMAIL_CHANGED, entity.NOTIFICATION_CHANNEL_SMS}: {
+		Body: "Your account email was changed to {{.NewEmail}}.",
+	},
+	{entity.NOTIFICATION_EVENT_EMAIL_CHANGED, entity.NOTIFICATION_CHANNEL_PUSH}: {
+		Subject: "Email changed",
+		Body:    "Your account email was changed to {{.NewEmail}}.",
+	},
+	{entity.NOTIFICATION_EVENT_EMAIL_CHANGED, entity.NOTIFICATION_CHANNEL_IN_APP}: {
+		Subject: "Email changed",
+		Body:    "Your account email was changed to {{.NewEmail}}.",
+	},
+
+	// seller.health_warning (sent to the seller)
+	{entity.NOTIFICATION_EVENT_SELLER_HEALTH_WARNING, entity.NOTIFICATION_CHANNEL_EMAIL}: {
+		Subject: "Your seller health score dropped to {{.Score}}",
+		Body: "<p>Your seller health score over the last {{.WindowDays}} days is " +
+			"<strong>{{.Score}}</strong>. Flagged: {{.RiskFlags}}.</p>" +
+			"<p>Listings are demoted in search below a score of {{.DemotionScore}}.</p>",
+	},
+	{entity.NOTIFICATION_EVENT_SELLER_HEALTH_WARNING, entity.NOTIFICATION_CHANNEL_SMS}: {
+		Body: "Your seller health score dropped to {{.Score}}. " +
+			"Listings are demoted below {{.DemotionScore}}.",
+	},
+	{entity.NOTIFICATION_EVENT_SELLER_HEALTH_WARNING, entity.NOTIFICATION_CHANNEL_PUSH}: {
+		Subject: "Seller health warning",
+		Body:    "Your seller health score dropped to {{.Score}}.",
+	},
+	{entity.NOTIFICATION_EVENT_SELLER_HEALTH_WARNING, entity.NOTIFICATION_CHANNEL_IN_APP}: {
+		Subject: "Seller health warning",
+		Body:    "Your seller health score dropped to {{.Score}}. Flagged: {{.RiskFlags}}.",
+	},
+
+	// seller.listing_demoted (sent to the seller)
+	{entity.NOTIFICATION_EVENT_SELLER_LISTING_DEMOTED, entity.NOTIFICATION_CHANNEL_EMAIL}: {
+		Subject: "Your listings have been demoted",
+		Body: "<p>Your seller health score over the last {{.WindowDays}} days is " +
+			"<strong>{{.Score}}</strong>. Flagged: {{.RiskFlags}}.</p>" +
+			"<p>Your products now rank below other sellers in search and listings until " +
+			"your score recovers to {{.WarningScore}}.</p>",
+	},
+	{entity.NOTIFICATION_EVENT_SELLER_LISTING_DEMOTED, entity.NOTIFICATION_CHANNEL_SMS}: {
+		Body: "Your listings were demoted after your seller health score fell to {{.Score}}.",
+	},
+	{entity.NOTIFICATION_EVENT_SELLER_LISTING_DEMOTED, entity.NOTIFICATION_CHANNEL_PUSH}: {
+		Subject: "Listings demoted",
+		Body:    "Your seller health score fell to {{.Score}} and your listings were demoted.",
+	},
+	{entity.NOTIFICATION_EVENT_SELLER_LISTING_DEMOTED, entity.NOTIFICATION_CHANNEL_IN_APP}: {
+		Subject: "Listings demoted",
+		Body: "Your seller health score fell to {{.Score}}. Listings rank lower until it " +
+			"recovers to {{.WarningScore}}.",
+	},
+}
+
+// GetDefaultTemplate returns the built-in template for an event type and channel.
+func GetDefaultTemplate(
+	eventType entity.NotificationEventType,
+	channel entity.NotificationChannel,
+) (DefaultTemplate, bool) {
+	tmpl, ok := defaultTemplates[templateKey{eventType: eventType, channel: channel}]
+	return tmpl, ok
+}
@@ -0,0 +1,23 @@
+package utils
+
+import "ecommerce-be/notification/utils/constant"
+
+// NormalizeInboxPage applies defaults and bounds to notification center paging.
+func NormalizeInboxPage(page, pageSize int) (int, int) {
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = constant.DEFAULT_INBOX_PAGE_SIZE
+	}
+	if pageSize > constant.MAX_INBOX_PAGE_SIZE {
+		pageSize = constant.MAX_INBOX_PAGE_SIZE
+	}
+	return page, pageSize
+}
+
+// IsValidInboxSelection reports whether a bulk action targets exactly one of an
+// explicit ID list or every notification.
+func IsValidInboxSelection(ids []uint, all bool) bool {
+	return (len(ids) > 0) != all
+}
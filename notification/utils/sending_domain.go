@@ -0,0 +1,139 @@
+package utils
+
+import (
+	"net/mail"
+	"slices"
+	"strings"
+
+	"ecommerce-be/notification/utils/constant"
+)
+
+// dkimDomainKeyLabel is the DNS label DKIM public keys are published under
+const dkimDomainKeyLabel = "_domainkey"
+
+// NormalizeSendingDomain lowercases a domain and drops surrounding space and the
+// trailing root dot.
+func NormalizeSendingDomain(domain string) string {
+	return strings.TrimSuffix(strings.ToLower(strings.TrimSpace(domain)), ".")
+}
+
+// IsValidSendingDomain reports whether domain is a fully qualified DNS name made of
+// letters, digits and hyphens, with at least two labels.
+func IsValidSendingDomain(domain string) bool {
+	if domain == "" || len(domain) > 253 {
+		return false
+	}
+	labels := strings.Split(domain, ".")
+	if len(labels) < 2 {
+		return false
+	}
+	for _, label := range labels {
+		if !isValidDNSLabel(label) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsValidDKIMSelector reports whether selector is a DNS name usable under _domainkey
+func IsValidDKIMSelector(selector string) bool {
+	if selector == "" || len(selector) > 63 {
+		return false
+	}
+	for _, label := range strings.Split(selector, ".") {
+		if !isValidDNSLabel(label) {
+			return false
+		}
+	}
+	return true
+}
+
+// FromAddressOnDomain reports whether address is a plain mailbox on domain or one
+// of its subdomains.
+func FromAddressOnDomain(address, domain string) bool {
+	parsed, err := mail.ParseAddress(address)
+	if err != nil || parsed.Name != "" || parsed.Address != strings.TrimSpace(address) {
+		return false
+	}
+	at := strings.LastIndex(parsed.Address, "@")
+	host := NormalizeSendingDomain(parsed.Address[at+1:])
+	return host == domain || strings.HasSuffix(host, "."+domain)
+}
+
+// DKIMRecordName is the DNS name of the DKIM public key for selector on domain
+func DKIMRecordName(selector, domain string) string {
+	return selector + "." + dkimDomainKeyLabel + "." + domain
+}
+
+// HasSPFRecord reports whether the TXT records of a domain hold an SPF policy.
+// When include is set the policy must also authorize that provider domain.
+func HasSPFRecord(records []string, include string) bool {
+	for _, record := range records {
+		terms := strings.Fields(strings.ToLower(record))
+		if len(terms) == 0 || terms[0] != "v=spf1" {
+			continue
+		}
+		if include == "" {
+			return true
+		}
+		return slices.Contains(terms[1:], "include:"+strings.ToLower(include))
+	}
+	return false
+}
+
+// HasDKIMRecord reports whether the TXT records at a DKIM selector publish a public
+// key. A revoked key (empty p=) does not count.
+func HasDKIMRecord(records []string) bool {
+	for _, record := range records {
+		tags := parseDKIMTags(record)
+		if version, ok := tags["v"]; ok && version != "DKIM1" {
+			continue
+		}
+		if tags["p"] != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// IsSendingDomainPlan reports whether a seller on planName may use their own
+// sending domain.
+func IsSendingDomainPlan(planName string) bool {
+	for _, name := range constant.SENDING_DOMAIN_PLAN_NAMES {
+		if strings.EqualFold(name, strings.TrimSpace(planName)) {
+			return true
+		}
+	}
+	return false
+}
+
+// isValidDNSLabel reports whether label is a hostname label (RFC 1123)
+func isValidDNSLabel(label string) bool {
+	if label == "" || len(label) > 63 {
+		return false
+	}
+	if label[0] == '-' || label[len(label)-1] == '-' {
+		return false
+	}
+	for _, r := range label {
+		isAlnum := (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9')
+		if !isAlnum && r != '-' {
+			return false
+		}
+	}
+	return true
+}
+
+// parseDKIMTags splits a DKIM tag list ("v=DKIM1; k=rsa; p=...") into its tags
+func parseDKIMTags(record string) map[string]string {
+	tags := map[string]string{}
+	for _, part := range strings.Split(record, ";") {
+		name, value, ok := strings.Cut(part, "=")
+		if !ok {
+			continue
+		}
+		// Long keys are published across whitespace-separated chunks
+		tags[strings.TrimSpace(name)] = strings.Join(strings.Fields(value), "")
+	}
+	return tags
+}
@@ -0,0 +1,54 @@
+package utils
+
+import (
+	"time"
+
+	"ecommerce-be/notification/entity"
+	"ecommerce-be/notification/utils/constant"
+)
+
+// ResolveSyncCursor picks the position a delta sync starts from. An explicit
+// since/afterId from the client wins (e.g. after a local reset), otherwise the
+// device's stored cursor is used. A zero time means "from the beginning".
+func ResolveSyncCursor(
+	since *time.Time,
+	afterID *uint,
+	stored *entity.NotificationDeviceCursor,
+) (time.Time, uint) {
+	if since != nil {
+		id := uint(0)
+		if afterID != nil {
+			id = *afterID
+		}
+		return since.UTC(), id
+	}
+	if stored != nil && stored.LastSyncedAt != nil {
+		return stored.LastSyncedAt.UTC(), stored.LastSyncedID
+	}
+	return time.Time{}, 0
+}
+
+// AdvanceSyncCursor returns the cursor after applying a page of changes, which must
+// be ordered by (updated_at, id). An empty page leaves the cursor unchanged.
+func AdvanceSyncCursor(
+	since time.Time,
+	afterID uint,
+	changes []entity.UserNotification,
+) (time.Time, uint) {
+	if len(changes) == 0 {
+		return since, afterID
+	}
+	last := changes[len(changes)-1]
+	return last.UpdatedAt.UTC(), last.ID
+}
+
+// NormalizeSyncLimit clamps the requested page size.
+func NormalizeSyncLimit(limit int) int {
+	if limit <= 0 {
+		return constant.DEFAULT_SYNC_PAGE_SIZE
+	}
+	if limit > constant.MAX_SYNC_PAGE_SIZE {
+		return constant.MAX_SYNC_PAGE_SIZE
+	}
+	return limit
+}
@@ -0,0 +1,85 @@
+package utils
+
+import (
+	"bytes"
+	htmlTemplate "html/template"
+	"io"
+	"strings"
+	textTemplate "text/template"
+
+	"ecommerce-be/notification/entity"
+)
+
+// executeFunc runs a parsed template against data.
+type executeFunc func(w io.Writer, data any) error
+
+// ParseTemplate validates subject and body syntax for a channel.
+func ParseTemplate(channel entity.NotificationChannel, subject, body string) error {
+	if _, err := parseSubject(subject); err != nil {
+		return err
+	}
+	_, err := parseBody(channel, body)
+	return err
+}
+
+// RenderTemplate executes subject and body against data. Email bodies are rendered
+// with html/template so placeholder values are escaped; everything else uses
+// text/template.
+func RenderTemplate(
+	channel entity.NotificationChannel,
+	subject, body string,
+	data map[string]any,
+) (string, string, error) {
+	subjectExec, err := parseSubject(subject)
+	if err != nil {
+		return "", "", err
+	}
+	bodyExec, err := parseBody(channel, body)
+	if err != nil {
+		return "", "", err
+	}
+
+	renderedSubject, err := execute(subjectExec, data)
+	if err != nil {
+		return "", "", err
+	}
+	renderedBody, err := execute(bodyExec, data)
+	if err != nil {
+		return "", "", err
+	}
+	return renderedSubject, renderedBody, nil
+}
+
+func parseSubject(subject string) (executeFunc, error) {
+	tmpl, err := textTemplate.New("subject").Parse(subject)
+	if err != nil {
+		return nil, err
+	}
+	return tmpl.Execute, nil
+}
+
+func parseBody(channel entity.NotificationChannel, body string) (executeFunc, error) {
+	if channel == entity.NOTIFICATION_CHANNEL_EMAIL {
+		tmpl, err := htmlTemplate.New("body").Parse(body)
+		if err != nil {
+			return nil, err
+		}
+		return tmpl.Execute, nil
+	}
+	tmpl, err := textTemplate.New("body").Parse(body)
+	if err != nil {
+		return nil, err
+	}
+	return tmpl.Execute, nil
+}
+
+func execute(exec executeFunc, data map[string]any) (string, error) {
+	if data == nil {
+		data = map[string]any{}
+	}
+	var buf bytes.Buffer
+	if err := exec(&buf, data); err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(buf.String()), nil
+}
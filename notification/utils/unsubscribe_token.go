@@ -0,0 +1,80 @@
+package utils
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"ecommerce-be/common/auth"
+	"ecommerce-be/common/constants"
+	"ecommerce-be/notification/entity"
+)
+
+// unsubscribeTokenVersion prefixes the signed payload so the format can change
+// without old links verifying against the new layout.
+const unsubscribeTokenVersion = "v1"
+
+// unsubscribeTokenPurpose keeps these tokens from verifying as any other signed token
+const unsubscribeTokenPurpose = "notification-unsubscribe"
+
+// UnsubscribeSigner issues and verifies one-click unsubscribe links. A token binds
+// a user to a single category and is signed, so it cannot be altered to unsubscribe
+// someone else. Tokens do not expire: an unsubscribe link in an old email must keep
+// working.
+type UnsubscribeSigner struct {
+	signer  *auth.TokenSigner
+	baseURL string
+}
+
+// NewUnsubscribeSigner creates a signer; baseURL is the public origin of the API
+// (e.g. https://api.example.com) used to build links.
+func NewUnsubscribeSigner(secret, baseURL string) *UnsubscribeSigner {
+	return &UnsubscribeSigner{
+		signer:  auth.NewTokenSigner(secret, unsubscribeTokenPurpose),
+		baseURL: strings.TrimRight(baseURL, "/"),
+	}
+}
+
+// Sign returns the token that unsubscribes userID from category.
+func (s *UnsubscribeSigner) Sign(
+	userID uint,
+	category entity.NotificationCategory,
+) (string, error) {
+	return s.signer.Sign(fmt.Sprintf("%s:%d:%s", unsubscribeTokenVersion, userID, category))
+}
+
+// Verify checks a token's signature and returns the user and category it encodes.
+func (s *UnsubscribeSigner) Verify(token string) (uint, entity.NotificationCategory, bool) {
+	payload, ok := s.signer.Verify(token)
+	if !ok {
+		return 0, "", false
+	}
+
+	parts := strings.Split(payload, ":")
+	if len(parts) != 3 || parts[0] != unsubscribeTokenVersion {
+		return 0, "", false
+	}
+	userID, err := strconv.ParseUint(parts[1], 10, 64)
+	if err != nil || userID == 0 {
+		return 0, "", false
+	}
+	category := entity.NotificationCategory(parts[2])
+	if !category.IsValid() {
+		return 0, "", false
+	}
+	return uint(userID), category, true
+}
+
+// URL returns the one-click unsubscribe link for userID and category.
+func (s *UnsubscribeSigner) URL(
+	userID uint,
+	category entity.NotificationCategory,
+) (string, error) {
+	token, err := s.Sign(userID, category)
+	if err != nil {
+		return "", err
+	}
+	return s.baseURL + constants.APIBaseNotification + "/unsubscribe?token=" +
+		url.QueryEscape(token), nil
+}
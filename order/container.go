@@ -2,7 +2,10 @@ package order
 
 import (
 	"ecommerce-be/common"
+	"ecommerce-be/common/cron"
+	"ecommerce-be/order/factory/singleton"
 	"ecommerce-be/order/route"
+	"ecommerce-be/order/utils/constant"
 
 	"github.com/gin-gonic/gin"
 )
@@ -15,6 +18,9 @@ func NewContainer(router *gin.Engine) *common.Container {
 	/* Register all modules (Categories, Products, Attributes, etc.) */
 	addModules(c)
 
+	/* Register schedulers */
+	registerScheduler()
+
 	/* Register routes for each module */
 	for _, module := range c.Modules {
 		module.RegisterRoutes(router)
@@ -28,4 +34,30 @@ func NewContainer(router *gin.Engine) *common.Container {
 func addModules(c *common.Container) {
 	c.RegisterModule(route.NewCartModule())
 	c.RegisterModule(route.NewOrderModule())
+	c.RegisterModule(route.NewMarketplaceModule())
+	c.RegisterModule(route.NewGuestCheckoutModule())
+}
+
+/* Register recurring background jobs */
+func registerScheduler() {
+	// Retry shipment confirmations the marketplace rejected or never received
+	cron.RegisterIntervalJob(
+		constant.MARKETPLACE_SHIPMENT_SYNC_INTERVAL,
+		constant.MARKETPLACE_SHIPMENT_SYNC_JOB_NAME,
+		singleton.GetInstance().GetMarketplaceOrderService().RetryFailedShipmentSyncs,
+	)
+
+	// Remind customers of deposit balances falling due and cancel overdue ones
+	cron.RegisterIntervalJob(
+		constant.ORDER_DEPOSIT_BALANCE_INTERVAL,
+		constant.ORDER_DEPOSIT_BALANCE_JOB_NAME,
+		singleton.GetInstance().GetOrderService().ProcessDepositBalances,
+	)
+
+	// Expire payments not completed in time and cancel their orders
+	cron.RegisterIntervalJob(
+		constant.ORDER_PAYMENT_EXPIRY_INTERVAL,
+		constant.ORDER_PAYMENT_EXPIRY_JOB_NAME,
+		singleton.GetInstance().GetOrderService().ExpireLapsedPayments,
+	)
 }
@@ -0,0 +1,31 @@
+package entity
+
+import (
+	"time"
+
+	"ecommerce-be/common/db"
+)
+
+// GuestOrderClaim is a registered customer's request to take over the guest orders
+// placed with their email. The orders move to the account once the customer opens the
+// emailed link. Only the SHA-256 hash of the link's token is stored.
+type GuestOrderClaim struct {
+	db.BaseEntity
+	UserID    uint       `json:"userId"    gorm:"column:user_id;not null;index"`
+	Email     string     `json:"email"     gorm:"column:email;size:255;not null"`
+	TokenHash string     `json:"-"         gorm:"column:token_hash;size:64;uniqueIndex;not null"`
+	ExpiresAt time.Time  `json:"expiresAt" gorm:"column:expires_at;not null"`
+	ClaimedAt *time.Time `json:"claimedAt" gorm:"column:claimed_at"`
+	// ClaimedOrders is how many guest orders the confirmation moved to the account
+	ClaimedOrders int `json:"claimedOrders" gorm:"column:claimed_orders;not null;default:0"`
+}
+
+// TableName specifies the table name
+func (GuestOrderClaim) TableName() string {
+	return "guest_order_claim"
+}
+
+// IsOpen reports whether the claim link can still be confirmed
+func (c *GuestOrderClaim) IsOpen(now time.Time) bool {
+	return c.ClaimedAt == nil && now.Before(c.ExpiresAt)
+}
@@ -0,0 +1,125 @@
+package entity
+
+import (
+	"strings"
+	"time"
+
+	"ecommerce-be/common/db"
+)
+
+// ============================================================================
+// Marketplace Enum
+// ============================================================================
+
+// Marketplace identifies an external sales channel whose orders are ingested
+type Marketplace string
+
+const (
+	MARKETPLACE_AMAZON Marketplace = "amazon"
+	MARKETPLACE_EBAY   Marketplace = "ebay"
+)
+
+// ValidMarketplaces returns all supported marketplaces
+func ValidMarketplaces() []Marketplace {
+	return []Marketplace{MARKETPLACE_AMAZON, MARKETPLACE_EBAY}
+}
+
+// NormalizeMarketplace lower-cases and trims a raw marketplace value
+func NormalizeMarketplace(raw string) Marketplace {
+	return Marketplace(strings.ToLower(strings.TrimSpace(raw)))
+}
+
+// String returns the string representation
+func (m Marketplace) String() string {
+	return string(m)
+}
+
+// IsValid checks if the marketplace is supported
+func (m Marketplace) IsValid() bool {
+	switch m {
+	case MARKETPLACE_AMAZON, MARKETPLACE_EBAY:
+		return true
+	}
+	return false
+}
+
+// ============================================================================
+// Marketplace Connection Entity
+// ============================================================================
+
+// MarketplaceConnection links a seller to their account on an external marketplace.
+// WebhookSecret and the access token in Credentials are stored encrypted.
+type MarketplaceConnection struct {
+	db.BaseEntity
+	SellerID         uint        `json:"sellerId"         gorm:"column:seller_id;not null;index"`
+	Marketplace      Marketplace `json:"marketplace"      gorm:"column:marketplace;size:30;not null"`
+	ExternalSellerID string      `json:"externalSellerId" gorm:"column:external_seller_id;size:100;not null"`
+	WebhookSecret    string      `json:"-"                gorm:"column:webhook_secret;type:text;not null"`
+	Credentials      db.JSONMap  `json:"-"                gorm:"column:credentials;type:jsonb;default:'{}'"`
+	IsActive         bool        `json:"isActive"         gorm:"column:is_active;default:true"`
+	LastOrderAt      *time.Time  `json:"lastOrderAt"      gorm:"column:last_order_at"`
+}
+
+// TableName specifies the table name
+func (MarketplaceConnection) TableName() string {
+	return "marketplace_connection"
+}
+
+// ============================================================================
+// Marketplace Order Entity
+// ============================================================================
+
+// MarketplaceOrder maps an external marketplace order to the internal order created for it.
+// The (marketplace, external_order_id) pair is unique so redelivered webhooks are ignored.
+type MarketplaceOrder struct {
+	db.BaseEntity
+	ConnectionID    uint        `json:"connectionId"    gorm:"column:connection_id;not null;index"`
+	SellerID        uint        `json:"sellerId"        gorm:"column:seller_id;not null;index"`
+	Marketplace     Marketplace `json:"marketplace"     gorm:"column:marketplace;size:30;not null"`
+	ExternalOrderID string      `json:"externalOrderId" gorm:"column:external_order_id;size:100;not null"`
+	OrderID         uint        `json:"orderId"         gorm:"column:order_id;not null;uniqueIndex"`
+}
+
+// TableName specifies the table name
+func (MarketplaceOrder) TableName() string {
+	return "marketplace_order"
+}
+
+// ============================================================================
+// Marketplace Shipment Sync Entity
+// ============================================================================
+
+type ShipmentSyncStatus string
+
+const (
+	SHIPMENT_SYNC_PENDING ShipmentSyncStatus = "pending"
+	SHIPMENT_SYNC_SYNCED  ShipmentSyncStatus = "synced"
+	SHIPMENT_SYNC_FAILED  ShipmentSyncStatus = "failed"
+)
+
+// String returns the string representation
+func (s ShipmentSyncStatus) String() string {
+	return string(s)
+}
+
+// MarketplaceShipmentSync tracks pushing one shipment's confirmation back to the marketplace.
+// Failed pushes are retried by a background job until MaxAttempts is reached.
+type MarketplaceShipmentSync struct {
+	db.BaseEntity
+	MarketplaceOrderID uint               `json:"marketplaceOrderId" gorm:"column:marketplace_order_id;not null;index"`
+	ShipmentID         uint               `json:"shipmentId"         gorm:"column:shipment_id;not null;uniqueIndex"`
+	Carrier            string             `json:"carrier"            gorm:"column:carrier;size:50"`
+	TrackingNo         string             `json:"trackingNo"         gorm:"column:tracking_no;size:100"`
+	Items              db.JSONMap         `json:"items"              gorm:"column:items;type:jsonb;default:'{}'"`
+	Status             ShipmentSyncStatus `json:"status"             gorm:"column:status;size:20;default:pending;index"`
+	Attempts           int                `json:"attempts"           gorm:"column:attempts;default:0"`
+	LastError          *string            `json:"lastError"          gorm:"column:last_error;type:text"`
+	SyncedAt           *time.Time         `json:"syncedAt"           gorm:"column:synced_at"`
+
+	MarketplaceOrder *MarketplaceOrder `json:"marketplaceOrder,omitempty" gorm:"foreignKey:MarketplaceOrderID"`
+}
+
+// TableName specifies the table name
+func (MarketplaceShipmentSync) TableName() string {
+	return "marketplace_shipment_sync"
+}
@@ -94,6 +94,12 @@ func (f FulfillmentType) String() string {
 	return string(f)
 }
 
+// SupportsDeliverySlot reports whether orders of this type can be booked into a
+// delivery or pickup window
+func (f FulfillmentType) SupportsDeliverySlot() bool {
+	return f == DELIVERY || f == BOPIS
+}
+
 func (f FulfillmentType) IsValid() bool {
 	switch f {
 	case BOPIS, DIRECTSHIP, DELIVERY, TRANSFER:
@@ -102,6 +108,23 @@ func (f FulfillmentType) IsValid() bool {
 	return false
 }
 
+// ============================================================================
+// Fulfillment Status Enum
+// ============================================================================
+
+// OrderFulfillmentStatus is derived from how much of each line item has shipped
+type OrderFulfillmentStatus string
+
+const (
+	FULFILLMENT_STATUS_UNFULFILLED OrderFulfillmentStatus = "UNFULFILLED"
+	FULFILLMENT_STATUS_PARTIAL     OrderFulfillmentStatus = "PARTIAL"
+	FULFILLMENT_STATUS_FULFILLED   OrderFulfillmentStatus = "FULFILLED"
+)
+
+func (f OrderFulfillmentStatus) String() string {
+	return string(f)
+}
+
 // ============================================================================
 // Order Entity
 // ============================================================================
@@ -122,6 +145,18 @@ type Order struct {
 	Metadata        db.JSONMap      `json:"metadata"        gorm:"column:metadata;type:jsonb;default:'{}'"`
 	TransactionID   string          `json:"transactionId"   gorm:"column:transaction_id"`
 	FulfillmentType FulfillmentType `json:"fulfillmentType" gorm:"column:fulfillment_type;size:32;default:'directship'"`
+	SalesChannel    *string         `json:"salesChannel"    gorm:"column:sales_channel;size:30"`
+	// Derived from the fulfilled quantities of the line items as shipments are created
+	FulfillmentStatus OrderFulfillmentStatus `json:"fulfillmentStatus" gorm:"column:fulfillment_status;size:20;default:'UNFULFILLED'"`
+	// Scheduled delivery (or pickup) window. The sequence grows on every reschedule and
+	// versions the calendar invite sent to the customer.
+	DeliverySlotStart    *time.Time `json:"deliverySlotStart"    gorm:"column:delivery_slot_start"`
+	DeliverySlotEnd      *time.Time `json:"deliverySlotEnd"      gorm:"column:delivery_slot_end"`
+	DeliverySlotSequence int        `json:"deliverySlotSequence" gorm:"column:delivery_slot_sequence;default:0"`
+	// Deposit orders collect part of the total at placement and the balance later, as
+	// tracked by PaymentInstallments
+	PaymentPlan       PaymentPlan        `json:"paymentPlan"       gorm:"column:payment_plan;size:20;default:'full'"`
+	BalanceCollection *BalanceCollection `json:"balanceCollection" gorm:"column:balance_collection;size:32"`
 
 	// Associations for query preloading.
 	Items                  []OrderItem                 `json:"items,omitempty"                  gorm:"foreignKey:OrderID"`
@@ -129,4 +164,5 @@ type Order struct {
 	AppliedPromotions      []OrderAppliedPromotion     `json:"appliedPromotions,omitempty"      gorm:"foreignKey:OrderID"`
 	AppliedCoupons         []OrderAppliedCoupon        `json:"appliedCoupons,omitempty"         gorm:"foreignKey:OrderID"`
 	ItemAppliedPromotions  []OrderItemAppliedPromotion `json:"itemAppliedPromotions,omitempty"  gorm:"foreignKey:OrderID"`
+	PaymentInstallments    []OrderPaymentInstallment   `json:"paymentInstallments,omitempty"    gorm:"foreignKey:OrderID"`
 }
@@ -53,4 +53,8 @@ type OrderAddress struct {
 	CountryID uint             `json:"countryId" gorm:"column:country_id;not null"`
 	Latitude  *float64         `json:"latitude"  gorm:"column:latitude"`
 	Longitude *float64         `json:"longitude" gorm:"column:longitude"`
+
+	// SourceAddressID is the address book entry the snapshot was taken from. It is
+	// kept for reference only and may point at an address deleted since.
+	SourceAddressID *uint `json:"sourceAddressId,omitempty" gorm:"column:source_address_id"`
 }
@@ -20,4 +20,12 @@ type OrderItem struct {
 	UnitPriceCents int64      `json:"unitPriceCents" gorm:"column:unit_price_cents;not null"`
 	LineTotalCents int64      `json:"lineTotalCents" gorm:"column:line_total_cents;not null"`
 	Attributes     db.JSONMap `json:"attributes"     gorm:"column:attributes;type:jsonb;default:'{}'"`
+
+	// FulfilledQuantity is how many units have been assigned to shipments so far
+	FulfilledQuantity int `json:"fulfilledQuantity" gorm:"column:fulfilled_quantity;not null;default:0"`
+
+	// CommissionCents is the platform's commission on the line, fixed by the commission
+	// rule in force when the order was placed
+	CommissionCents  int64 `json:"-" gorm:"column:commission_cents;not null;default:0"`
+	CommissionRuleID *uint `json:"-" gorm:"column:commission_rule_id"`
 }
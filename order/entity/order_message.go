@@ -0,0 +1,24 @@
+package entity
+
+import (
+	"time"
+
+	"ecommerce-be/common/db"
+)
+
+// OrderMessage is one message of the conversation between an order's customer and its
+// seller. Admins replying as support post on the seller's side of the thread.
+type OrderMessage struct {
+	ID           uint   `json:"id"           gorm:"primaryKey"`
+	OrderID      uint   `json:"orderId"      gorm:"column:order_id;not null;index"`
+	SenderUserID uint   `json:"senderUserId" gorm:"column:sender_user_id;not null"`
+	SenderRole   string `json:"senderRole"   gorm:"column:sender_role;size:32;not null"`
+	// FromCustomer tells which side of the thread sent the message; unread counts are
+	// messages from the other side
+	FromCustomer      bool           `json:"fromCustomer"      gorm:"column:from_customer;not null"`
+	Body              string         `json:"body"              gorm:"column:body;not null"`
+	AttachmentFileIDs db.StringArray `json:"attachmentFileIds" gorm:"column:attachment_file_ids;type:text[]"`
+	// ReadAt is set when the other side first fetches the thread after the message
+	ReadAt    *time.Time `json:"readAt"    gorm:"column:read_at"`
+	CreatedAt time.Time  `json:"createdAt" gorm:"column:created_at;autoCreateTime"`
+}
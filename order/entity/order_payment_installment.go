@@ -0,0 +1,77 @@
+package entity
+
+import (
+	"time"
+
+	"ecommerce-be/common/db"
+)
+
+// ============================================================================
+// Payment Plan Enums
+// ============================================================================
+
+// PaymentPlan is how an order is paid: in full, or a deposit up front and the balance later.
+type PaymentPlan string
+
+const (
+	PAYMENT_PLAN_FULL    PaymentPlan = "full"
+	PAYMENT_PLAN_DEPOSIT PaymentPlan = "deposit"
+)
+
+func (p PaymentPlan) IsValid() bool {
+	return p == PAYMENT_PLAN_FULL || p == PAYMENT_PLAN_DEPOSIT
+}
+
+// BalanceCollection is when the balance of a deposit order is collected.
+type BalanceCollection string
+
+const (
+	// Balance is due a fixed time after placement; the order expires if it is not paid
+	BALANCE_BEFORE_FULFILLMENT BalanceCollection = "before_fulfillment"
+	// Balance is collected when the order is handed over, so it never expires
+	BALANCE_ON_DELIVERY BalanceCollection = "on_delivery"
+)
+
+func (b BalanceCollection) IsValid() bool {
+	return b == BALANCE_BEFORE_FULFILLMENT || b == BALANCE_ON_DELIVERY
+}
+
+type InstallmentKind string
+
+const (
+	INSTALLMENT_DEPOSIT InstallmentKind = "deposit"
+	INSTALLMENT_BALANCE InstallmentKind = "balance"
+)
+
+type InstallmentStatus string
+
+const (
+	INSTALLMENT_STATUS_PENDING InstallmentStatus = "pending"
+	INSTALLMENT_STATUS_PAID    InstallmentStatus = "paid"
+	// The due date passed unpaid and the order was cancelled
+	INSTALLMENT_STATUS_EXPIRED InstallmentStatus = "expired"
+)
+
+// ============================================================================
+// Order Payment Installment Entity
+// ============================================================================
+
+// OrderPaymentInstallment is one scheduled payment of a deposit order. Full-payment
+// orders have no installments.
+type OrderPaymentInstallment struct {
+	db.BaseEntity
+	OrderID        uint              `json:"orderId"        gorm:"column:order_id;not null;index"`
+	Kind           InstallmentKind   `json:"kind"           gorm:"column:kind;size:20;not null"`
+	AmountCents    int64             `json:"amountCents"    gorm:"column:amount_cents;not null"`
+	DueAt          *time.Time        `json:"dueAt"          gorm:"column:due_at"`
+	Status         InstallmentStatus `json:"status"         gorm:"column:status;size:20;not null;default:pending"`
+	PaidAt         *time.Time        `json:"paidAt"         gorm:"column:paid_at"`
+	TransactionID  *string           `json:"transactionId"  gorm:"column:transaction_id;size:255"`
+	ReminderSentAt *time.Time        `json:"reminderSentAt" gorm:"column:reminder_sent_at"`
+
+	Order *Order `json:"-" gorm:"foreignKey:OrderID"`
+}
+
+func (OrderPaymentInstallment) TableName() string {
+	return "order_payment_installment"
+}
@@ -31,3 +31,9 @@ func ErrPromotionServiceUnavailable(err error) *commonError.AppError {
 		StatusCode: http.StatusInternalServerError,
 	}
 }
+
+func init() {
+	commonError.Register(
+		ErrVariantNotFound,
+	)
+}
@@ -0,0 +1,47 @@
+package error
+
+import (
+	"net/http"
+
+	commonError "ecommerce-be/common/error"
+)
+
+const (
+	INVALID_ORDER_ACCESS_TOKEN_CODE = "INVALID_ORDER_ACCESS_TOKEN"
+	NO_GUEST_ORDERS_TO_CLAIM_CODE   = "NO_GUEST_ORDERS_TO_CLAIM"
+	INVALID_GUEST_CLAIM_TOKEN_CODE  = "INVALID_GUEST_CLAIM_TOKEN"
+)
+
+const (
+	INVALID_ORDER_ACCESS_TOKEN_MSG = "Order access token is invalid"
+	NO_GUEST_ORDERS_TO_CLAIM_MSG   = "No guest orders were placed with your email"
+	INVALID_GUEST_CLAIM_TOKEN_MSG  = "Claim link is invalid or has expired"
+)
+
+var (
+	ErrInvalidOrderAccessToken = &commonError.AppError{
+		Code:       INVALID_ORDER_ACCESS_TOKEN_CODE,
+		Message:    INVALID_ORDER_ACCESS_TOKEN_MSG,
+		StatusCode: http.StatusUnauthorized,
+	}
+
+	ErrNoGuestOrdersToClaim = &commonError.AppError{
+		Code:       NO_GUEST_ORDERS_TO_CLAIM_CODE,
+		Message:    NO_GUEST_ORDERS_TO_CLAIM_MSG,
+		StatusCode: http.StatusNotFound,
+	}
+
+	ErrInvalidGuestClaimToken = &commonError.AppError{
+		Code:       INVALID_GUEST_CLAIM_TOKEN_CODE,
+		Message:    INVALID_GUEST_CLAIM_TOKEN_MSG,
+		StatusCode: http.StatusBadRequest,
+	}
+)
+
+func init() {
+	commonError.Register(
+		ErrInvalidOrderAccessToken,
+		ErrNoGuestOrdersToClaim,
+		ErrInvalidGuestClaimToken,
+	)
+}
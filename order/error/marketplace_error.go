@@ -0,0 +1,98 @@
+package error
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	commonError "ecommerce-be/common/error"
+)
+
+const (
+	MARKETPLACE_UNSUPPORTED_CODE          = "MARKETPLACE_UNSUPPORTED"
+	MARKETPLACE_CONNECTION_NOT_FOUND_CODE = "MARKETPLACE_CONNECTION_NOT_FOUND"
+	MARKETPLACE_CONNECTION_EXISTS_CODE    = "MARKETPLACE_CONNECTION_EXISTS"
+	MARKETPLACE_CONNECTION_INACTIVE_CODE  = "MARKETPLACE_CONNECTION_INACTIVE"
+	MARKETPLACE_INVALID_SIGNATURE_CODE    = "MARKETPLACE_INVALID_SIGNATURE"
+	MARKETPLACE_INVALID_PAYLOAD_CODE      = "MARKETPLACE_INVALID_PAYLOAD"
+	MARKETPLACE_UNKNOWN_SKU_CODE          = "MARKETPLACE_UNKNOWN_SKU"
+	MARKETPLACE_UNKNOWN_COUNTRY_CODE      = "MARKETPLACE_UNKNOWN_COUNTRY"
+)
+
+const (
+	MARKETPLACE_UNSUPPORTED_MSG          = "Unsupported marketplace"
+	MARKETPLACE_CONNECTION_NOT_FOUND_MSG = "Marketplace connection not found"
+	MARKETPLACE_CONNECTION_EXISTS_MSG    = "This marketplace account is already connected"
+	MARKETPLACE_CONNECTION_INACTIVE_MSG  = "Marketplace connection is inactive"
+	MARKETPLACE_INVALID_SIGNATURE_MSG    = "Invalid marketplace webhook signature"
+	MARKETPLACE_INVALID_PAYLOAD_MSG      = "Marketplace webhook payload could not be parsed"
+	MARKETPLACE_UNKNOWN_SKU_MSG          = "Marketplace order references unknown SKUs: %s"
+	MARKETPLACE_UNKNOWN_COUNTRY_MSG      = "Marketplace order ships to unsupported country %s"
+)
+
+var (
+	ErrMarketplaceUnsupported = &commonError.AppError{
+		Code:       MARKETPLACE_UNSUPPORTED_CODE,
+		Message:    MARKETPLACE_UNSUPPORTED_MSG,
+		StatusCode: http.StatusBadRequest,
+	}
+
+	ErrMarketplaceConnectionNotFound = &commonError.AppError{
+		Code:       MARKETPLACE_CONNECTION_NOT_FOUND_CODE,
+		Message:    MARKETPLACE_CONNECTION_NOT_FOUND_MSG,
+		StatusCode: http.StatusNotFound,
+	}
+
+	ErrMarketplaceConnectionExists = &commonError.AppError{
+		Code:       MARKETPLACE_CONNECTION_EXISTS_CODE,
+		Message:    MARKETPLACE_CONNECTION_EXISTS_MSG,
+		StatusCode: http.StatusConflict,
+	}
+
+	ErrMarketplaceConnectionInactive = &commonError.AppError{
+		Code:       MARKETPLACE_CONNECTION_INACTIVE_CODE,
+		Message:    MARKETPLACE_CONNECTION_INACTIVE_MSG,
+		StatusCode: http.StatusConflict,
+	}
+
+	ErrMarketplaceInvalidSignature = &commonError.AppError{
+		Code:       MARKETPLACE_INVALID_SIGNATURE_CODE,
+		Message:    MARKETPLACE_INVALID_SIGNATURE_MSG,
+		StatusCode: http.StatusUnauthorized,
+	}
+
+	ErrMarketplaceInvalidPayload = &commonError.AppError{
+		Code:       MARKETPLACE_INVALID_PAYLOAD_CODE,
+		Message:    MARKETPLACE_INVALID_PAYLOAD_MSG,
+		StatusCode: http.StatusBadRequest,
+	}
+)
+
+// ErrMarketplaceUnknownSKUs is returned when order lines cannot be matched to seller variants
+func ErrMarketplaceUnknownSKUs(skus []string) *commonError.AppError {
+	return &commonError.AppError{
+		Code:       MARKETPLACE_UNKNOWN_SKU_CODE,
+		Message:    fmt.Sprintf(MARKETPLACE_UNKNOWN_SKU_MSG, strings.Join(skus, ", ")),
+		StatusCode: http.StatusUnprocessableEntity,
+	}
+}
+
+// ErrMarketplaceUnknownCountry is returned when the ship-to country is not configured
+func ErrMarketplaceUnknownCountry(code string) *commonError.AppError {
+	return &commonError.AppError{
+		Code:       MARKETPLACE_UNKNOWN_COUNTRY_CODE,
+		Message:    fmt.Sprintf(MARKETPLACE_UNKNOWN_COUNTRY_MSG, code),
+		StatusCode: http.StatusUnprocessableEntity,
+	}
+}
+
+func init() {
+	commonError.Register(
+		ErrMarketplaceUnsupported,
+		ErrMarketplaceConnectionNotFound,
+		ErrMarketplaceConnectionExists,
+		ErrMarketplaceConnectionInactive,
+		ErrMarketplaceInvalidSignature,
+		ErrMarketplaceInvalidPayload,
+	)
+}
@@ -35,6 +35,51 @@ const (
 	ORDER_INVALID_FULFILLMENT_MSG     = "Invalid fulfillment type"
 )
 
+const (
+	ORDER_INVALID_DELIVERY_SLOT_CODE     = "ORDER_INVALID_DELIVERY_SLOT"
+	ORDER_DELIVERY_SLOT_NOT_ALLOWED_CODE = "ORDER_DELIVERY_SLOT_NOT_ALLOWED"
+	ORDER_DELIVERY_SLOT_LOCKED_CODE      = "ORDER_DELIVERY_SLOT_LOCKED"
+
+	ORDER_INVALID_DELIVERY_SLOT_MSG     = "Delivery slot must start in the future and end later"
+	ORDER_DELIVERY_SLOT_NOT_ALLOWED_MSG = "Delivery slots are only for delivery and pickup orders"
+	ORDER_DELIVERY_SLOT_LOCKED_MSG      = "Only pending or confirmed orders can be rescheduled"
+)
+
+const (
+	ORDER_INVALID_PAYMENT_PLAN_CODE  = "ORDER_INVALID_PAYMENT_PLAN"
+	ORDER_DEPOSIT_NOT_AVAILABLE_CODE = "ORDER_DEPOSIT_NOT_AVAILABLE"
+	ORDER_BALANCE_UNPAID_CODE        = "ORDER_BALANCE_UNPAID"
+	ORDER_NO_BALANCE_DUE_CODE        = "ORDER_NO_BALANCE_DUE"
+
+	ORDER_INVALID_PAYMENT_PLAN_MSG  = "Invalid paymentPlan or balanceCollection"
+	ORDER_DEPOSIT_NOT_AVAILABLE_MSG = "Order total is too small to split into a deposit and a balance"
+	ORDER_BALANCE_UNPAID_MSG        = "The order balance must be paid before the order is completed"
+	ORDER_NO_BALANCE_DUE_MSG        = "Order has no outstanding balance"
+)
+
+const (
+	ORDER_CANCELLATION_WINDOW_CLOSED_CODE = "ORDER_CANCELLATION_WINDOW_CLOSED"
+
+	ORDER_CANCELLATION_WINDOW_CLOSED_MSG = "The time allowed to cancel this order has passed; " +
+		"please contact the seller"
+)
+
+const (
+	ORDER_ALREADY_FULFILLED_CODE           = "ORDER_ALREADY_FULFILLED"
+	ORDER_INVALID_FULFILLMENT_ITEM_CODE    = "ORDER_INVALID_FULFILLMENT_ITEM"
+	ORDER_FULFILLMENT_EXCEEDS_ORDERED_CODE = "ORDER_FULFILLMENT_EXCEEDS_ORDERED"
+
+	ORDER_ALREADY_FULFILLED_MSG           = "Every item of this order has already been fulfilled"
+	ORDER_INVALID_FULFILLMENT_ITEM_MSG    = "Order item %d does not belong to this order"
+	ORDER_FULFILLMENT_EXCEEDS_ORDERED_MSG = "Order item %d has only %d unit(s) left to fulfill"
+)
+
+const (
+	ORDER_NOT_AWAITING_PAYMENT_CODE = "ORDER_NOT_AWAITING_PAYMENT"
+
+	ORDER_NOT_AWAITING_PAYMENT_MSG = "Only pending orders that have not been paid can be paid again"
+)
+
 var (
 	ErrCartNotActive = &commonError.AppError{
 		Code:       ORDER_CART_NOT_ACTIVE_CODE,
@@ -95,6 +140,66 @@ var (
 		Message:    ORDER_INVALID_FULFILLMENT_MSG,
 		StatusCode: http.StatusBadRequest,
 	}
+
+	ErrInvalidDeliverySlot = &commonError.AppError{
+		Code:       ORDER_INVALID_DELIVERY_SLOT_CODE,
+		Message:    ORDER_INVALID_DELIVERY_SLOT_MSG,
+		StatusCode: http.StatusBadRequest,
+	}
+
+	ErrDeliverySlotNotAllowed = &commonError.AppError{
+		Code:       ORDER_DELIVERY_SLOT_NOT_ALLOWED_CODE,
+		Message:    ORDER_DELIVERY_SLOT_NOT_ALLOWED_MSG,
+		StatusCode: http.StatusBadRequest,
+	}
+
+	ErrDeliverySlotLocked = &commonError.AppError{
+		Code:       ORDER_DELIVERY_SLOT_LOCKED_CODE,
+		Message:    ORDER_DELIVERY_SLOT_LOCKED_MSG,
+		StatusCode: http.StatusConflict,
+	}
+
+	ErrInvalidPaymentPlan = &commonError.AppError{
+		Code:       ORDER_INVALID_PAYMENT_PLAN_CODE,
+		Message:    ORDER_INVALID_PAYMENT_PLAN_MSG,
+		StatusCode: http.StatusBadRequest,
+	}
+
+	ErrDepositNotAvailable = &commonError.AppError{
+		Code:       ORDER_DEPOSIT_NOT_AVAILABLE_CODE,
+		Message:    ORDER_DEPOSIT_NOT_AVAILABLE_MSG,
+		StatusCode: http.StatusBadRequest,
+	}
+
+	ErrOrderBalanceUnpaid = &commonError.AppError{
+		Code:       ORDER_BALANCE_UNPAID_CODE,
+		Message:    ORDER_BALANCE_UNPAID_MSG,
+		StatusCode: http.StatusConflict,
+	}
+
+	ErrNoBalanceDue = &commonError.AppError{
+		Code:       ORDER_NO_BALANCE_DUE_CODE,
+		Message:    ORDER_NO_BALANCE_DUE_MSG,
+		StatusCode: http.StatusConflict,
+	}
+
+	ErrCancellationWindowClosed = &commonError.AppError{
+		Code:       ORDER_CANCELLATION_WINDOW_CLOSED_CODE,
+		Message:    ORDER_CANCELLATION_WINDOW_CLOSED_MSG,
+		StatusCode: http.StatusConflict,
+	}
+
+	ErrOrderAlreadyFulfilled = &commonError.AppError{
+		Code:       ORDER_ALREADY_FULFILLED_CODE,
+		Message:    ORDER_ALREADY_FULFILLED_MSG,
+		StatusCode: http.StatusConflict,
+	}
+
+	ErrOrderNotAwaitingPayment = &commonError.AppError{
+		Code:       ORDER_NOT_AWAITING_PAYMENT_CODE,
+		Message:    ORDER_NOT_AWAITING_PAYMENT_MSG,
+		StatusCode: http.StatusConflict,
+	}
 )
 
 func ErrInvalidStatusTransition(from, to string) *commonError.AppError {
@@ -104,3 +209,44 @@ func ErrInvalidStatusTransition(from, to string) *commonError.AppError {
 		StatusCode: http.StatusBadRequest,
 	}
 }
+
+func ErrInvalidFulfillmentItem(orderItemID uint) *commonError.AppError {
+	return &commonError.AppError{
+		Code:       ORDER_INVALID_FULFILLMENT_ITEM_CODE,
+		Message:    fmt.Sprintf(ORDER_INVALID_FULFILLMENT_ITEM_MSG, orderItemID),
+		StatusCode: http.StatusBadRequest,
+	}
+}
+
+func ErrFulfillmentExceedsOrdered(orderItemID uint, remaining int) *commonError.AppError {
+	return &commonError.AppError{
+		Code:       ORDER_FULFILLMENT_EXCEEDS_ORDERED_CODE,
+		Message:    fmt.Sprintf(ORDER_FULFILLMENT_EXCEEDS_ORDERED_MSG, orderItemID, remaining),
+		StatusCode: http.StatusBadRequest,
+	}
+}
+
+func init() {
+	commonError.Register(
+		ErrCartNotActive,
+		ErrCartEmpty,
+		ErrCartAlreadyInCheckout,
+		ErrOrderNotFound,
+		ErrInvalidOrderStatus,
+		ErrTransactionIDRequired,
+		ErrFailureReasonRequired,
+		ErrOrderNotCancellable,
+		ErrAddressNotFound,
+		ErrInvalidFulfillmentType,
+		ErrInvalidDeliverySlot,
+		ErrDeliverySlotNotAllowed,
+		ErrDeliverySlotLocked,
+		ErrInvalidPaymentPlan,
+		ErrDepositNotAvailable,
+		ErrOrderBalanceUnpaid,
+		ErrNoBalanceDue,
+		ErrCancellationWindowClosed,
+		ErrOrderAlreadyFulfilled,
+		ErrOrderNotAwaitingPayment,
+	)
+}
@@ -0,0 +1,45 @@
+package error
+
+import (
+	"net/http"
+
+	commonError "ecommerce-be/common/error"
+)
+
+const (
+	ORDER_MESSAGE_EMPTY_CODE              = "ORDER_MESSAGE_EMPTY"
+	ORDER_MESSAGE_TOO_MANY_FILES_CODE     = "ORDER_MESSAGE_TOO_MANY_ATTACHMENTS"
+	ORDER_MESSAGE_INVALID_ATTACHMENT_CODE = "ORDER_MESSAGE_INVALID_ATTACHMENT"
+
+	ORDER_MESSAGE_EMPTY_MSG              = "A message needs a body or at least one attachment"
+	ORDER_MESSAGE_TOO_MANY_FILES_MSG     = "A message can have at most 5 attachments"
+	ORDER_MESSAGE_INVALID_ATTACHMENT_MSG = "Attachments must be PDF, JPEG or PNG files up to 25 MB"
+)
+
+var (
+	ErrOrderMessageEmpty = &commonError.AppError{
+		Code:       ORDER_MESSAGE_EMPTY_CODE,
+		Message:    ORDER_MESSAGE_EMPTY_MSG,
+		StatusCode: http.StatusBadRequest,
+	}
+
+	ErrOrderMessageTooManyAttachments = &commonError.AppError{
+		Code:       ORDER_MESSAGE_TOO_MANY_FILES_CODE,
+		Message:    ORDER_MESSAGE_TOO_MANY_FILES_MSG,
+		StatusCode: http.StatusBadRequest,
+	}
+
+	ErrInvalidOrderMessageAttachment = &commonError.AppError{
+		Code:       ORDER_MESSAGE_INVALID_ATTACHMENT_CODE,
+		Message:    ORDER_MESSAGE_INVALID_ATTACHMENT_MSG,
+		StatusCode: http.StatusBadRequest,
+	}
+)
+
+func init() {
+	commonError.Register(
+		ErrOrderMessageEmpty,
+		ErrOrderMessageTooManyAttachments,
+		ErrInvalidOrderMessageAttachment,
+	)
+}
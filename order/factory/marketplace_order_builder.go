@@ -0,0 +1,195 @@
+package factory
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"ecommerce-be/common/constants"
+	"ecommerce-be/common/db"
+	"ecommerce-be/order/entity"
+	"ecommerce-be/order/model"
+	"ecommerce-be/order/service/marketplace"
+	orderConstants "ecommerce-be/order/utils/constant"
+	productMapper "ecommerce-be/product/mapper"
+)
+
+// BuildMarketplaceOrderItems snapshots marketplace order lines, priced as sold on the
+// marketplace, against the catalogue variants matched by SKU.
+func BuildMarketplaceOrderItems(
+	orderID uint,
+	items []marketplace.ExternalOrderItem,
+	variantsBySKU map[string]productMapper.VariantBasicInfoRow,
+) []entity.OrderItem {
+	result := make([]entity.OrderItem, 0, len(items))
+	for _, item := range items {
+		variant := variantsBySKU[item.SKU]
+		productID := variant.ProductID
+		variantID := variant.VariantID
+
+		productName := variant.ProductName
+		if productName == "" {
+			productName = item.Title
+		}
+
+		result = append(result, entity.OrderItem{
+			OrderID:        orderID,
+			ProductID:      &productID,
+			VariantID:      &variantID,
+			SKU:            toPtr(item.SKU),
+			ProductName:    productName,
+			Quantity:       item.Quantity,
+			UnitPriceCents: item.UnitPriceCents,
+			LineTotalCents: item.LineTotalCents,
+			Attributes: db.JSONMap{
+				orderConstants.MARKETPLACE_LINE_ITEM_ATTRIBUTE: item.ExternalLineID,
+			},
+		})
+	}
+	return result
+}
+
+// BuildMarketplaceOrderAddresses snapshots the marketplace ship-to address. Marketplaces do not
+// share billing details, so the billing snapshot mirrors the shipping address.
+func BuildMarketplaceOrderAddresses(
+	orderID uint,
+	address marketplace.ExternalAddress,
+	countryID uint,
+) []entity.OrderAddress {
+	line := strings.TrimSpace(address.Line1)
+	if line2 := strings.TrimSpace(address.Line2); line2 != "" {
+		line = strings.TrimSpace(line + ", " + line2)
+	}
+
+	build := func(addrType entity.OrderAddressType) entity.OrderAddress {
+		return entity.OrderAddress{
+			OrderID:   orderID,
+			Type:      addrType,
+			Address:   line,
+			City:      address.City,
+			State:     address.State,
+			ZipCode:   address.PostalCode,
+			CountryID: countryID,
+		}
+	}
+	return []entity.OrderAddress{
+		build(entity.ORDER_ADDR_SHIPPING),
+		build(entity.ORDER_ADDR_BILLING),
+	}
+}
+
+// BuildMarketplaceOrderMetadata records marketplace attribution on the order metadata
+func BuildMarketplaceOrderMetadata(
+	conn *entity.MarketplaceConnection,
+	order marketplace.ExternalOrder,
+) map[string]any {
+	metadata := map[string]any{
+		"marketplace":             conn.Marketplace.String(),
+		"marketplaceConnectionId": conn.ID,
+		"marketplaceOrderId":      order.ExternalOrderID,
+	}
+	if order.Currency != "" {
+		metadata["marketplaceCurrency"] = order.Currency
+	}
+	return metadata
+}
+
+// BuildMarketplaceConnectionResponse maps a connection to its API response. webhookSecret is
+// only set right after creation.
+func BuildMarketplaceConnectionResponse(
+	conn *entity.MarketplaceConnection,
+	webhookSecret string,
+) model.MarketplaceConnectionResponse {
+	return model.MarketplaceConnectionResponse{
+		ID:               conn.ID,
+		Marketplace:      conn.Marketplace,
+		ExternalSellerID: conn.ExternalSellerID,
+		IsActive:         conn.IsActive,
+		WebhookPath: fmt.Sprintf(
+			"%s/marketplace/webhooks/%s/%d",
+			constants.APIBaseOrder,
+			conn.Marketplace,
+			conn.ID,
+		),
+		WebhookSecret: webhookSecret,
+		LastOrderAt:   conn.LastOrderAt,
+		CreatedAt:     conn.CreatedAt,
+	}
+}
+
+// BuildShipmentConfirmation maps shipped order lines to the marketplace's line item ids.
+// Lines that were not ingested from the marketplace are left out.
+func BuildShipmentConfirmation(
+	externalOrderID string,
+	req model.MarketplaceShipmentRequest,
+	orderItems []entity.OrderItem,
+) marketplace.ShipmentConfirmation {
+	lineIDs := make(map[uint]string, len(orderItems))
+	for _, item := range orderItems {
+		lineID, ok := item.Attributes[orderConstants.MARKETPLACE_LINE_ITEM_ATTRIBUTE].(string)
+		if ok {
+			lineIDs[item.ID] = lineID
+		}
+	}
+
+	confirmation := marketplace.ShipmentConfirmation{
+		ExternalOrderID: externalOrderID,
+		Carrier:         req.Carrier,
+		TrackingNo:      req.TrackingNo,
+		ShippedAt:       req.ShippedAt,
+	}
+	for _, item := range req.Items {
+		if lineID, ok := lineIDs[item.OrderItemID]; ok && lineID != "" {
+			confirmation.Items = append(confirmation.Items, marketplace.ShipmentConfirmationItem{
+				ExternalLineID: lineID,
+				Quantity:       item.Quantity,
+			})
+		}
+	}
+	return confirmation
+}
+
+// ShipmentConfirmationToJSONMap stores a confirmation on marketplace_shipment_sync.items so
+// failed pushes can be retried without reloading the shipment
+func ShipmentConfirmationToJSONMap(confirmation marketplace.ShipmentConfirmation) db.JSONMap {
+	lines := make([]any, 0, len(confirmation.Items))
+	for _, item := range confirmation.Items {
+		lines = append(lines, map[string]any{
+			"externalLineId": item.ExternalLineID,
+			"quantity":       item.Quantity,
+		})
+	}
+	return db.JSONMap{
+		"externalOrderId": confirmation.ExternalOrderID,
+		"shippedAt":       confirmation.ShippedAt.UTC().Format(time.RFC3339),
+		"lines":           lines,
+	}
+}
+
+// ShipmentConfirmationFromSync rebuilds the confirmation stored by ShipmentConfirmationToJSONMap
+func ShipmentConfirmationFromSync(
+	sync *entity.MarketplaceShipmentSync,
+) marketplace.ShipmentConfirmation {
+	confirmation := marketplace.ShipmentConfirmation{
+		Carrier:    sync.Carrier,
+		TrackingNo: sync.TrackingNo,
+	}
+	confirmation.ExternalOrderID, _ = sync.Items["externalOrderId"].(string)
+	if raw, ok := sync.Items["shippedAt"].(string); ok {
+		confirmation.ShippedAt, _ = time.Parse(time.RFC3339, raw)
+	}
+	lines, _ := sync.Items["lines"].([]any)
+	for _, raw := range lines {
+		line, ok := raw.(map[string]any)
+		if !ok {
+			continue
+		}
+		lineID, _ := line["externalLineId"].(string)
+		quantity, _ := line["quantity"].(float64)
+		confirmation.Items = append(confirmation.Items, marketplace.ShipmentConfirmationItem{
+			ExternalLineID: lineID,
+			Quantity:       int(quantity),
+		})
+	}
+	return confirmation
+}
@@ -9,6 +9,7 @@ import (
 	"ecommerce-be/order/entity"
 	"ecommerce-be/order/mapper"
 	"ecommerce-be/order/model"
+	orderUtils "ecommerce-be/order/utils"
 	userModel "ecommerce-be/user/model"
 )
 
@@ -91,6 +92,8 @@ func BuildOrderAddressesFromUserAddresses(
 			CountryID: shipping.CountryID,
 			Latitude:  shipping.Latitude,
 			Longitude: shipping.Longitude,
+
+			SourceAddressID: sourceAddressID(shipping),
 		},
 		{
 			OrderID:   orderID,
@@ -103,10 +106,21 @@ func BuildOrderAddressesFromUserAddresses(
 			CountryID: billing.CountryID,
 			Latitude:  billing.Latitude,
 			Longitude: billing.Longitude,
+
+			SourceAddressID: sourceAddressID(billing),
 		},
 	}
 }
 
+// sourceAddressID references the address book entry behind a snapshot, when it has one
+func sourceAddressID(address *userModel.AddressResponse) *uint {
+	if address.ID == 0 {
+		return nil
+	}
+	id := address.ID
+	return &id
+}
+
 // BuildOrderAppliedPromotionsFromCartSnapshot snapshots cart-level applied promotions.
 func BuildOrderAppliedPromotionsFromCartSnapshot(
 	orderID uint,
@@ -179,15 +193,25 @@ func BuildOrderResponseFromEntity(
 		TaxCents:          order.TaxCents,
 		TotalCents:        order.TotalCents,
 		FulfillmentType:   order.FulfillmentType,
+		FulfillmentStatus: order.FulfillmentStatus,
+		SalesChannel:      order.SalesChannel,
 		PlacedAt:          order.PlacedAt,
 		PaidAt:            order.PaidAt,
 		TransactionID:     order.TransactionID,
+		PaymentPlan:       order.PaymentPlan,
 		Metadata:          map[string]any(order.Metadata),
 		Customer:          customer,
 		Items:             make([]model.OrderItemResponse, 0, len(order.Items)),
 		Addresses:         make([]model.OrderAddressResponse, 0, len(order.Addresses)),
 		AppliedPromotions: make([]model.OrderPromotionResponse, 0, len(order.AppliedPromotions)),
 	}
+	if order.DeliverySlotStart != nil && order.DeliverySlotEnd != nil {
+		resp.DeliverySlot = &model.DeliverySlotResponse{
+			Start:    order.DeliverySlotStart.UTC(),
+			End:      order.DeliverySlotEnd.UTC(),
+			Sequence: order.DeliverySlotSequence,
+		}
+	}
 
 	itemPromoByItemID := map[uint][]model.ItemPromotionBreakdownResponse{}
 	for _, p := range order.ItemAppliedPromotions {
@@ -216,6 +240,7 @@ func BuildOrderResponseFromEntity(
 			ImageURL:                  item.ImageURL,
 			ImageFileID:               item.ImageFileID,
 			Quantity:                  item.Quantity,
+			FulfilledQuantity:         item.FulfilledQuantity,
 			UnitPriceCents:            item.UnitPriceCents,
 			LineTotalCents:            item.LineTotalCents,
 			Attributes:                map[string]any(item.Attributes),
@@ -234,6 +259,8 @@ func BuildOrderResponseFromEntity(
 			CountryID: addr.CountryID,
 			Latitude:  addr.Latitude,
 			Longitude: addr.Longitude,
+
+			SourceAddressID: addr.SourceAddressID,
 		})
 	}
 
@@ -249,6 +276,21 @@ func BuildOrderResponseFromEntity(
 		})
 	}
 
+	if order.PaymentPlan == entity.PAYMENT_PLAN_DEPOSIT {
+		resp.BalanceCollection = order.BalanceCollection
+		resp.BalanceDueCents = orderUtils.OutstandingBalanceCents(order.PaymentInstallments)
+		for _, installment := range order.PaymentInstallments {
+			resp.PaymentSchedule = append(resp.PaymentSchedule, model.PaymentInstallmentResponse{
+				Kind:          installment.Kind,
+				AmountCents:   installment.AmountCents,
+				Status:        installment.Status,
+				DueAt:         installment.DueAt,
+				PaidAt:        installment.PaidAt,
+				TransactionID: installment.TransactionID,
+			})
+		}
+	}
+
 	return resp
 }
 
@@ -0,0 +1,35 @@
+package factory
+
+import (
+	"ecommerce-be/common/filegateway"
+	"ecommerce-be/order/entity"
+	"ecommerce-be/order/model"
+)
+
+// BuildOrderMessageResponses converts thread messages, resolving attachments from files.
+// Attachments missing from files are left out.
+func BuildOrderMessageResponses(
+	messages []entity.OrderMessage,
+	files map[string]*filegateway.FileDisplayInfo,
+) []model.OrderMessageResponse {
+	out := make([]model.OrderMessageResponse, 0, len(messages))
+	for _, message := range messages {
+		attachments := make([]filegateway.FileAssetResponse, 0, len(message.AttachmentFileIDs))
+		for _, fileID := range message.AttachmentFileIDs {
+			if asset := filegateway.ToFileAssetResponse(files[fileID]); asset != nil {
+				attachments = append(attachments, *asset)
+			}
+		}
+		out = append(out, model.OrderMessageResponse{
+			ID:           message.ID,
+			SenderUserID: message.SenderUserID,
+			SenderRole:   message.SenderRole,
+			FromCustomer: message.FromCustomer,
+			Body:         message.Body,
+			Attachments:  attachments,
+			ReadAt:       message.ReadAt,
+			CreatedAt:    message.CreatedAt,
+		})
+	}
+	return out
+}
@@ -10,8 +10,11 @@ import (
 type HandlerFactory struct {
 	serviceFactory *ServiceFactory
 
-	cartHandler  *handler.CartHandler
-	orderHandler *handler.OrderHandler
+	cartHandler        *handler.CartHandler
+	orderHandler       *handler.OrderHandler
+	marketplaceHandler *handler.MarketplaceHandler
+	messageHandler     *handler.OrderMessageHandler
+	guestHandler       *handler.GuestCheckoutHandler
 
 	once sync.Once
 }
@@ -27,10 +30,16 @@ func (f *HandlerFactory) initialize() {
 		// Get services
 		cartService := f.serviceFactory.GetCartService()
 		orderService := f.serviceFactory.GetOrderService()
+		messageService := f.serviceFactory.GetOrderMessageService()
+		marketplaceService := f.serviceFactory.GetMarketplaceOrderService()
+		guestService := f.serviceFactory.GetGuestCheckoutService()
 
 		// Initialize handlers
 		f.cartHandler = handler.NewCartHandler(cartService)
 		f.orderHandler = handler.NewOrderHandler(orderService)
+		f.marketplaceHandler = handler.NewMarketplaceHandler(marketplaceService)
+		f.messageHandler = handler.NewOrderMessageHandler(messageService)
+		f.guestHandler = handler.NewGuestCheckoutHandler(guestService)
 	})
 }
 
@@ -45,3 +54,21 @@ func (f *HandlerFactory) GetOrderHandler() *handler.OrderHandler {
 	f.initialize()
 	return f.orderHandler
 }
+
+// GetMarketplaceHandler returns the singleton marketplace handler
+func (f *HandlerFactory) GetMarketplaceHandler() *handler.MarketplaceHandler {
+	f.initialize()
+	return f.marketplaceHandler
+}
+
+// GetOrderMessageHandler returns the singleton order message handler
+func (f *HandlerFactory) GetOrderMessageHandler() *handler.OrderMessageHandler {
+	f.initialize()
+	return f.messageHandler
+}
+
+// GetGuestCheckoutHandler returns the singleton guest checkout handler
+func (f *HandlerFactory) GetGuestCheckoutHandler() *handler.GuestCheckoutHandler {
+	f.initialize()
+	return f.guestHandler
+}
@@ -11,6 +11,10 @@ type RepositoryFactory struct {
 	cartRepo         repository.CartRepository
 	orderRepo        repository.OrderRepository
 	orderHistoryRepo repository.OrderHistoryRepository
+	marketplaceRepo  repository.MarketplaceRepository
+	orderPaymentRepo repository.OrderPaymentRepository
+	orderMessageRepo repository.OrderMessageRepository
+	guestClaimRepo   repository.GuestOrderClaimRepository
 
 	once sync.Once
 }
@@ -26,6 +30,10 @@ func (f *RepositoryFactory) initialize() {
 		f.cartRepo = repository.NewCartRepository()
 		f.orderRepo = repository.NewOrderRepository()
 		f.orderHistoryRepo = repository.NewOrderHistoryRepository()
+		f.marketplaceRepo = repository.NewMarketplaceRepository()
+		f.orderPaymentRepo = repository.NewOrderPaymentRepository()
+		f.orderMessageRepo = repository.NewOrderMessageRepository()
+		f.guestClaimRepo = repository.NewGuestOrderClaimRepository()
 	})
 }
 
@@ -46,3 +54,27 @@ func (f *RepositoryFactory) GetOrderHistoryRepository() repository.OrderHistoryR
 	f.initialize()
 	return f.orderHistoryRepo
 }
+
+// GetMarketplaceRepository returns the singleton marketplace repository
+func (f *RepositoryFactory) GetMarketplaceRepository() repository.MarketplaceRepository {
+	f.initialize()
+	return f.marketplaceRepo
+}
+
+// GetOrderPaymentRepository returns the singleton order payment repository
+func (f *RepositoryFactory) GetOrderPaymentRepository() repository.OrderPaymentRepository {
+	f.initialize()
+	return f.orderPaymentRepo
+}
+
+// GetOrderMessageRepository returns the singleton order message repository
+func (f *RepositoryFactory) GetOrderMessageRepository() repository.OrderMessageRepository {
+	f.initialize()
+	return f.orderMessageRepo
+}
+
+// GetGuestOrderClaimRepository returns the singleton guest order claim repository
+func (f *RepositoryFactory) GetGuestOrderClaimRepository() repository.GuestOrderClaimRepository {
+	f.initialize()
+	return f.guestClaimRepo
+}
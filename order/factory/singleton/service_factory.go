@@ -2,9 +2,19 @@ package singleton
 
 import (
 	"sync"
+	"time"
 
+	"ecommerce-be/common/config"
+	"ecommerce-be/common/screening"
+	fileSingleton "ecommerce-be/file/factory/singleton"
+	filegw "ecommerce-be/file/gateway"
 	inventoryFactory "ecommerce-be/inventory/factory/singleton"
+	notificationFactory "ecommerce-be/notification/factory/singleton"
+	notificationGateway "ecommerce-be/notification/gateway"
 	"ecommerce-be/order/service"
+	"ecommerce-be/order/service/marketplace"
+	orderUtils "ecommerce-be/order/utils"
+	paymentFactory "ecommerce-be/payment/factory/singleton"
 	productFactory "ecommerce-be/product/factory/singleton"
 	promotionFactory "ecommerce-be/promotion/factory/singleton"
 	userFactory "ecommerce-be/user/factory/singleton"
@@ -14,8 +24,11 @@ import (
 type ServiceFactory struct {
 	repoFactory *RepositoryFactory
 
-	cartService  service.CartService
-	orderService service.OrderService
+	cartService        service.CartService
+	orderService       service.OrderService
+	marketplaceService service.MarketplaceOrderService
+	messageService     service.OrderMessageService
+	guestService       service.GuestCheckoutService
 
 	once sync.Once
 }
@@ -38,12 +51,36 @@ func (f *ServiceFactory) initialize() {
 		userSingleton := userFactory.GetInstance()
 		userSvc := userSingleton.GetUserService()
 		addressSvc := userSingleton.GetAddressService()
+		sellerSettingsSvc := userSingleton.GetSellerSettingsService()
+		paymentRefundSvc := paymentFactory.GetInstance().GetPaymentRefundService()
+		paymentIntentSvc := paymentFactory.GetInstance().GetPaymentIntentService()
+		sellerLedgerSvc := paymentFactory.GetInstance().GetSellerLedgerService()
+		commissionSvc := paymentFactory.GetInstance().GetCommissionService()
 		userRepo := userSingleton.GetUserRepository()
+		countryRepo := userSingleton.GetCountryRepository()
+		orderNotifier := notificationGateway.NewNotifier(
+			notificationFactory.GetInstance().GetNotificationDispatchService(),
+		)
+		fileFactory := fileSingleton.GetInstance()
+		uploadFileGateway := filegw.NewUploadGateway(
+			fileFactory.GetFileUploadService(),
+			fileFactory.GetFileReadService(),
+			fileFactory.GetFileDeleteService(),
+		)
 
 		// Get repositories
 		cartRepo := f.repoFactory.GetCartRepository()
 		orderRepo := f.repoFactory.GetOrderRepository()
 		orderHistoryRepo := f.repoFactory.GetOrderHistoryRepository()
+		orderPaymentRepo := f.repoFactory.GetOrderPaymentRepository()
+		marketplaceRepo := f.repoFactory.GetMarketplaceRepository()
+		orderMessageRepo := f.repoFactory.GetOrderMessageRepository()
+		guestClaimRepo := f.repoFactory.GetGuestOrderClaimRepository()
+
+		// Marketplace adapters
+		adapters := marketplace.NewRegistry()
+		adapters.Register(marketplace.NewAmazonAdapter())
+		adapters.Register(marketplace.NewEbayAdapter())
 
 		// Initialize services
 		f.cartService = service.NewCartService(cartRepo, orderRepo, promotionSvc, inventorySvc, variantQuerySvc, userSvc)
@@ -51,13 +88,98 @@ func (f *ServiceFactory) initialize() {
 			f.cartService,
 			orderRepo,
 			orderHistoryRepo,
+			orderPaymentRepo,
+			orderMessageRepo,
 			inventoryReservationSvc,
+			productFactory.GetInstance().GetDigitalDeliveryService(),
 			addressSvc,
+			sellerSettingsSvc,
+			paymentRefundSvc,
+			paymentIntentSvc,
+			sellerLedgerSvc,
+			commissionSvc,
+			userSvc,
+			userRepo,
+			countryRepo,
+			orderNotifier,
+			screening.GetService(),
+			screening.HighValueOrderCents(),
+			depositPolicy(),
+		)
+		f.marketplaceService = service.NewMarketplaceOrderService(
+			marketplaceRepo,
+			orderRepo,
+			orderHistoryRepo,
+			f.orderService,
+			inventoryReservationSvc,
+			variantQuerySvc,
+			userSvc,
 			userRepo,
+			countryRepo,
+			adapters,
+		)
+		f.messageService = service.NewOrderMessageService(
+			orderRepo,
+			orderHistoryRepo,
+			orderMessageRepo,
+			uploadFileGateway,
+			orderNotifier,
+		)
+		f.guestService = service.NewGuestCheckoutService(
+			f.orderService,
+			f.cartService,
+			addressSvc,
+			userSvc,
+			userRepo,
+			orderRepo,
+			guestClaimRepo,
+			orderUtils.NewOrderAccessSigner(guestAccessSecret()),
+			orderNotifier,
+			guestClaimTTL(),
 		)
 	})
 }
 
+// guestAccessSecret is the key guest order access tokens are signed with, falling
+// back to the JWT secret. The tokens are purpose-scoped, so they never verify as a
+// JWT or another signed link; with neither secret set, guest checkout fails.
+func guestAccessSecret() string {
+	cfg := config.Get()
+	if cfg == nil {
+		return ""
+	}
+	if cfg.Order.GuestAccessSecret != "" {
+		return cfg.Order.GuestAccessSecret
+	}
+	return cfg.Auth.JWTSecret
+}
+
+// guestClaimTTL is how long a guest order claim link stays valid
+func guestClaimTTL() time.Duration {
+	if cfg := config.Get(); cfg != nil && cfg.Order.GuestClaimTokenTTLHours > 0 {
+		return time.Duration(cfg.Order.GuestClaimTokenTTLHours) * time.Hour
+	}
+	return 24 * time.Hour
+}
+
+// depositPolicy builds the deposit order settings from config, with the config
+// defaults when config is not loaded
+func depositPolicy() service.DepositPolicy {
+	orderConfig := config.OrderConfig{
+		DepositPercent:       20,
+		BalanceDueDays:       7,
+		BalanceReminderHours: 48,
+	}
+	if cfg := config.Get(); cfg != nil {
+		orderConfig = cfg.Order
+	}
+	return service.DepositPolicy{
+		Percent:         orderConfig.DepositPercent,
+		BalanceDueAfter: time.Duration(orderConfig.BalanceDueDays) * 24 * time.Hour,
+		ReminderLead:    time.Duration(orderConfig.BalanceReminderHours) * time.Hour,
+	}
+}
+
 // GetCartService returns the singleton cart service
 func (f *ServiceFactory) GetCartService() service.CartService {
 	f.initialize()
@@ -69,3 +191,21 @@ func (f *ServiceFactory) GetOrderService() service.OrderService {
 	f.initialize()
 	return f.orderService
 }
+
+// GetMarketplaceOrderService returns the singleton marketplace order service
+func (f *ServiceFactory) GetMarketplaceOrderService() service.MarketplaceOrderService {
+	f.initialize()
+	return f.marketplaceService
+}
+
+// GetOrderMessageService returns the singleton order message service
+func (f *ServiceFactory) GetOrderMessageService() service.OrderMessageService {
+	f.initialize()
+	return f.messageService
+}
+
+// GetGuestCheckoutService returns the singleton guest checkout service
+func (f *ServiceFactory) GetGuestCheckoutService() service.GuestCheckoutService {
+	f.initialize()
+	return f.guestService
+}
@@ -58,6 +58,10 @@ func (f *SingletonFactory) GetOrderHistoryRepository() repository.OrderHistoryRe
 	return f.repoFactory.GetOrderHistoryRepository()
 }
 
+func (f *SingletonFactory) GetMarketplaceRepository() repository.MarketplaceRepository {
+	return f.repoFactory.GetMarketplaceRepository()
+}
+
 // ===============================
 // Service Getters (Delegates)
 // ===============================
@@ -70,6 +74,18 @@ func (f *SingletonFactory) GetOrderService() service.OrderService {
 	return f.serviceFactory.GetOrderService()
 }
 
+func (f *SingletonFactory) GetMarketplaceOrderService() service.MarketplaceOrderService {
+	return f.serviceFactory.GetMarketplaceOrderService()
+}
+
+func (f *SingletonFactory) GetOrderMessageService() service.OrderMessageService {
+	return f.serviceFactory.GetOrderMessageService()
+}
+
+func (f *SingletonFactory) GetGuestCheckoutService() service.GuestCheckoutService {
+	return f.serviceFactory.GetGuestCheckoutService()
+}
+
 // ===============================
 // Handler Getters (Delegates)
 // ===============================
@@ -81,3 +97,15 @@ func (f *SingletonFactory) GetCartHandler() *handler.CartHandler {
 func (f *SingletonFactory) GetOrderHandler() *handler.OrderHandler {
 	return f.handlerFactory.GetOrderHandler()
 }
+
+func (f *SingletonFactory) GetMarketplaceHandler() *handler.MarketplaceHandler {
+	return f.handlerFactory.GetMarketplaceHandler()
+}
+
+func (f *SingletonFactory) GetOrderMessageHandler() *handler.OrderMessageHandler {
+	return f.handlerFactory.GetOrderMessageHandler()
+}
+
+func (f *SingletonFactory) GetGuestCheckoutHandler() *handler.GuestCheckoutHandler {
+	return f.handlerFactory.GetGuestCheckoutHandler()
+}
@@ -78,6 +78,7 @@ func (h *CartHandler) AddToCart(c *gin.Context) {
 }
 
 // GetUserCart API handler to fetch active user's cart
+// Optional ?channel=pos prices the cart with that sales channel's overrides
 func (h *CartHandler) GetUserCart(c *gin.Context) {
 	userID, exists := auth.GetUserIDFromContext(c)
 	if !exists {
@@ -93,7 +94,12 @@ func (h *CartHandler) GetUserCart(c *gin.Context) {
 		return
 	}
 
-	resp, err := h.cartService.GetUserCart(c, userID, sellerID)
+	resp, err := h.cartService.GetUserCart(
+		c,
+		userID,
+		sellerID,
+		c.Query(orderConstants.SALES_CHANNEL_QUERY_PARAM),
+	)
 	if err != nil {
 		log.ErrorWithContext(c, "getUserCart: failed to fetch cart", err)
 		h.HandleError(c, err, orderConstants.FAILED_TO_GET_CART_MSG)
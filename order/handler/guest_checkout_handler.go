@@ -0,0 +1,111 @@
+package handler
+
+import (
+	"net/http"
+
+	"ecommerce-be/common/auth"
+	"ecommerce-be/common/constants"
+	errs "ecommerce-be/common/error"
+	"ecommerce-be/common/handler"
+	"ecommerce-be/common/log"
+	"ecommerce-be/order/model"
+	"ecommerce-be/order/service"
+	orderConstants "ecommerce-be/order/utils/constant"
+
+	"github.com/gin-gonic/gin"
+)
+
+// GuestCheckoutHandler places orders without an account and claims them for one later.
+type GuestCheckoutHandler struct {
+	*handler.BaseHandler
+	guestCheckoutService service.GuestCheckoutService
+}
+
+func NewGuestCheckoutHandler(
+	guestCheckoutService service.GuestCheckoutService,
+) *GuestCheckoutHandler {
+	return &GuestCheckoutHandler{
+		BaseHandler:          handler.NewBaseHandler(),
+		guestCheckoutService: guestCheckoutService,
+	}
+}
+
+func (h *GuestCheckoutHandler) Checkout(c *gin.Context) {
+	sellerID, exists := auth.GetSellerIDFromContext(c)
+	if !exists {
+		h.HandleError(c, errs.ErrSellerDataMissing, constants.SELLER_DATA_MISSING_MSG)
+		return
+	}
+
+	var req model.GuestCheckoutRequest
+	if err := h.BindJSON(c, &req); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	resp, err := h.guestCheckoutService.Checkout(c, sellerID, req)
+	if err != nil {
+		log.ErrorWithContext(c, "guestCheckout: failed", err)
+		h.HandleError(c, err, orderConstants.FAILED_TO_CREATE_GUEST_ORDER_MSG)
+		return
+	}
+
+	h.Success(c, http.StatusCreated, orderConstants.GUEST_ORDER_CREATED_MSG, resp)
+}
+
+func (h *GuestCheckoutHandler) GetOrder(c *gin.Context) {
+	var req model.GuestOrderLookupRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	resp, err := h.guestCheckoutService.GetOrderByAccessToken(c, req.Token)
+	if err != nil {
+		h.HandleError(c, err, orderConstants.FAILED_TO_FETCH_GUEST_ORDER_MSG)
+		return
+	}
+
+	h.Success(c, http.StatusOK, orderConstants.GUEST_ORDER_FETCHED_MSG, resp)
+}
+
+func (h *GuestCheckoutHandler) RequestClaim(c *gin.Context) {
+	userID, exists := auth.GetUserIDFromContext(c)
+	if !exists {
+		h.HandleError(c, errs.UnauthorizedError, constants.AUTHENTICATION_REQUIRED_MSG)
+		return
+	}
+
+	resp, err := h.guestCheckoutService.RequestClaim(c, userID)
+	if err != nil {
+		h.HandleError(c, err, orderConstants.FAILED_TO_REQUEST_GUEST_ORDER_CLAIM_MSG)
+		return
+	}
+
+	h.SuccessWithData(c, http.StatusCreated, orderConstants.GUEST_ORDER_CLAIM_REQUESTED_MSG,
+		orderConstants.GUEST_ORDER_CLAIM_FIELD_NAME, resp)
+}
+
+func (h *GuestCheckoutHandler) ConfirmClaim(c *gin.Context) {
+	userID, exists := auth.GetUserIDFromContext(c)
+	if !exists {
+		h.HandleError(c, errs.UnauthorizedError, constants.AUTHENTICATION_REQUIRED_MSG)
+		return
+	}
+
+	var req model.ConfirmGuestOrderClaimRequest
+	if err := h.BindJSON(c, &req); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	resp, err := h.guestCheckoutService.ConfirmClaim(c, userID, req)
+	if err != nil {
+		log.ErrorWithContext(c, "confirmGuestOrderClaim: failed", err)
+		h.HandleError(c, err, orderConstants.FAILED_TO_CONFIRM_GUEST_ORDER_CLAIM_MSG)
+		return
+	}
+
+	h.SuccessWithData(c, http.StatusOK, orderConstants.GUEST_ORDER_CLAIM_CONFIRMED_MSG,
+		orderConstants.GUEST_ORDER_CLAIM_FIELD_NAME, resp)
+}
@@ -0,0 +1,129 @@
+package handler
+
+import (
+	"net/http"
+
+	"ecommerce-be/common/auth"
+	"ecommerce-be/common/constants"
+	errs "ecommerce-be/common/error"
+	"ecommerce-be/common/handler"
+	"ecommerce-be/common/log"
+	"ecommerce-be/order/model"
+	"ecommerce-be/order/service"
+	orderConstants "ecommerce-be/order/utils/constant"
+
+	"github.com/gin-gonic/gin"
+)
+
+// MarketplaceHandler manages marketplace connections and receives marketplace order webhooks.
+type MarketplaceHandler struct {
+	*handler.BaseHandler
+	marketplaceService service.MarketplaceOrderService
+}
+
+func NewMarketplaceHandler(marketplaceService service.MarketplaceOrderService) *MarketplaceHandler {
+	return &MarketplaceHandler{
+		BaseHandler:        handler.NewBaseHandler(),
+		marketplaceService: marketplaceService,
+	}
+}
+
+func (h *MarketplaceHandler) CreateConnection(c *gin.Context) {
+	sellerID, exists := auth.GetSellerIDFromContext(c)
+	if !exists {
+		h.HandleError(c, errs.ErrSellerDataMissing, constants.SELLER_DATA_MISSING_MSG)
+		return
+	}
+
+	var req model.CreateMarketplaceConnectionRequest
+	if err := h.BindJSON(c, &req); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	resp, err := h.marketplaceService.CreateConnection(c, sellerID, req)
+	if err != nil {
+		log.ErrorWithContext(c, "createMarketplaceConnection: failed", err)
+		h.HandleError(c, err, orderConstants.FAILED_TO_CREATE_MARKETPLACE_CONNECTION_MSG)
+		return
+	}
+
+	h.SuccessWithData(c, http.StatusCreated,
+		orderConstants.MARKETPLACE_CONNECTION_CREATED_MSG, "connection", resp)
+}
+
+func (h *MarketplaceHandler) ListConnections(c *gin.Context) {
+	sellerID, exists := auth.GetSellerIDFromContext(c)
+	if !exists {
+		h.HandleError(c, errs.ErrSellerDataMissing, constants.SELLER_DATA_MISSING_MSG)
+		return
+	}
+
+	resp, err := h.marketplaceService.ListConnections(c, sellerID)
+	if err != nil {
+		log.ErrorWithContext(c, "listMarketplaceConnections: failed", err)
+		h.HandleError(c, err, orderConstants.FAILED_TO_LIST_MARKETPLACE_CONNECTIONS_MSG)
+		return
+	}
+
+	h.SuccessWithData(c, http.StatusOK,
+		orderConstants.MARKETPLACE_CONNECTIONS_LISTED_MSG, "connections", resp)
+}
+
+func (h *MarketplaceHandler) DeactivateConnection(c *gin.Context) {
+	sellerID, exists := auth.GetSellerIDFromContext(c)
+	if !exists {
+		h.HandleError(c, errs.ErrSellerDataMissing, constants.SELLER_DATA_MISSING_MSG)
+		return
+	}
+
+	connectionID, err := h.ParseUintParam(c, "id")
+	if err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	if err := h.marketplaceService.DeactivateConnection(c, sellerID, connectionID); err != nil {
+		log.ErrorWithContext(c, "deactivateMarketplaceConnection: failed", err)
+		h.HandleError(c, err, orderConstants.FAILED_TO_DEACTIVATE_MARKETPLACE_CONNECTION_MSG)
+		return
+	}
+
+	h.Success(c, http.StatusOK, orderConstants.MARKETPLACE_CONNECTION_DEACTIVATED_MSG, nil)
+}
+
+// ReceiveWebhook ingests a marketplace order notification. The raw body is kept intact
+// because the signature covers the exact bytes sent by the marketplace.
+func (h *MarketplaceHandler) ReceiveWebhook(c *gin.Context) {
+	connectionID, err := h.ParseUintParam(c, "connectionId")
+	if err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	c.Request.Body = http.MaxBytesReader(
+		c.Writer,
+		c.Request.Body,
+		orderConstants.MARKETPLACE_WEBHOOK_MAX_BODY_BYTES,
+	)
+	body, err := c.GetRawData()
+	if err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	resp, err := h.marketplaceService.IngestWebhook(
+		c,
+		c.Param("marketplace"),
+		connectionID,
+		body,
+		c.GetHeader(orderConstants.MARKETPLACE_SIGNATURE_HEADER),
+	)
+	if err != nil {
+		log.ErrorWithContext(c, "receiveMarketplaceWebhook: failed", err)
+		h.HandleError(c, err, orderConstants.FAILED_TO_PROCESS_MARKETPLACE_WEBHOOK_MSG)
+		return
+	}
+
+	h.Success(c, http.StatusOK, orderConstants.MARKETPLACE_WEBHOOK_PROCESSED_MSG, resp)
+}
@@ -11,6 +11,7 @@ import (
 	errs "ecommerce-be/common/error"
 	"ecommerce-be/common/handler"
 	"ecommerce-be/common/log"
+	"ecommerce-be/common/validator"
 	"ecommerce-be/order/model"
 	"ecommerce-be/order/service"
 	orderConstants "ecommerce-be/order/utils/constant"
@@ -103,6 +104,10 @@ func (h *OrderHandler) ListOrders(c *gin.Context) {
 		h.HandleValidationError(c, err)
 		return
 	}
+	if err := validator.RequireLessOrEqual(&req, "fromDate", "toDate"); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
 
 	resp, err := h.orderService.ListOrders(c, userID, role, req)
 	if err != nil {
@@ -172,6 +177,142 @@ func (h *OrderHandler) CancelOrder(c *gin.Context) {
 	h.Success(c, http.StatusOK, orderConstants.ORDER_CANCELLED_MSG, resp)
 }
 
+func (h *OrderHandler) SellerCancelOrder(c *gin.Context) {
+	sellerID, exists := auth.GetSellerIDFromContext(c)
+	if !exists {
+		h.HandleError(c, errs.ErrSellerDataMissing, constants.SELLER_DATA_MISSING_MSG)
+		return
+	}
+
+	orderID, err := parseOrderIDParam(c)
+	if err != nil {
+		h.HandleValidationError(c, errs.ErrInvalidID)
+		return
+	}
+
+	var req model.CancelOrderRequest
+	if err := c.ShouldBindJSON(&req); err != nil && !errors.Is(err, io.EOF) {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	resp, serviceErr := h.orderService.SellerCancelOrder(c, sellerID, orderID, req)
+	if serviceErr != nil {
+		log.ErrorWithContext(c, "sellerCancelOrder: failed", serviceErr)
+		h.HandleError(c, serviceErr, orderConstants.FAILED_TO_CANCEL_ORDER_MSG)
+		return
+	}
+
+	h.Success(c, http.StatusOK, orderConstants.ORDER_CANCELLED_MSG, resp)
+}
+
+func (h *OrderHandler) RescheduleDeliverySlot(c *gin.Context) {
+	sellerID, exists := auth.GetSellerIDFromContext(c)
+	if !exists {
+		h.HandleError(c, errs.ErrSellerDataMissing, constants.SELLER_DATA_MISSING_MSG)
+		return
+	}
+
+	orderID, err := parseOrderIDParam(c)
+	if err != nil {
+		h.HandleValidationError(c, errs.ErrInvalidID)
+		return
+	}
+
+	var req model.DeliverySlotRequest
+	if err := h.BindJSON(c, &req); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	resp, serviceErr := h.orderService.RescheduleDeliverySlot(c, sellerID, orderID, req)
+	if serviceErr != nil {
+		log.ErrorWithContext(c, "rescheduleDeliverySlot: failed", serviceErr)
+		h.HandleError(c, serviceErr, orderConstants.FAILED_TO_RESCHEDULE_DELIVERY_SLOT_MSG)
+		return
+	}
+
+	h.Success(c, http.StatusOK, orderConstants.DELIVERY_SLOT_RESCHEDULED_MSG, resp)
+}
+
+func (h *OrderHandler) RecordBalancePayment(c *gin.Context) {
+	sellerID, exists := auth.GetSellerIDFromContext(c)
+	if !exists {
+		h.HandleError(c, errs.ErrSellerDataMissing, constants.SELLER_DATA_MISSING_MSG)
+		return
+	}
+
+	orderID, err := parseOrderIDParam(c)
+	if err != nil {
+		h.HandleValidationError(c, errs.ErrInvalidID)
+		return
+	}
+
+	var req model.RecordBalancePaymentRequest
+	if err := h.BindJSON(c, &req); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	resp, serviceErr := h.orderService.RecordBalancePayment(c, sellerID, orderID, req)
+	if serviceErr != nil {
+		log.ErrorWithContext(c, "recordBalancePayment: failed", serviceErr)
+		h.HandleError(c, serviceErr, orderConstants.FAILED_TO_RECORD_BALANCE_PAYMENT_MSG)
+		return
+	}
+
+	h.Success(c, http.StatusOK, orderConstants.BALANCE_PAYMENT_RECORDED_MSG, resp)
+}
+
+// RetryPayment starts a fresh gateway payment for the customer's unpaid order
+func (h *OrderHandler) RetryPayment(c *gin.Context) {
+	userID, exists := auth.GetUserIDFromContext(c)
+	if !exists {
+		h.HandleError(c, errs.UnauthorizedError, constants.AUTHENTICATION_REQUIRED_MSG)
+		return
+	}
+
+	orderID, err := parseOrderIDParam(c)
+	if err != nil {
+		h.HandleValidationError(c, errs.ErrInvalidID)
+		return
+	}
+
+	resp, serviceErr := h.orderService.RetryPayment(c, userID, orderID)
+	if serviceErr != nil {
+		log.ErrorWithContext(c, "retryPayment: failed", serviceErr)
+		h.HandleError(c, serviceErr, orderConstants.FAILED_TO_RETRY_PAYMENT_MSG)
+		return
+	}
+
+	h.Success(c, http.StatusCreated, orderConstants.ORDER_PAYMENT_STARTED_MSG, resp)
+}
+
+// GetOrderDownloads lists the download links and license keys of the customer's
+// paid digital items
+func (h *OrderHandler) GetOrderDownloads(c *gin.Context) {
+	userID, exists := auth.GetUserIDFromContext(c)
+	if !exists {
+		h.HandleError(c, errs.UnauthorizedError, constants.AUTHENTICATION_REQUIRED_MSG)
+		return
+	}
+
+	orderID, err := parseOrderIDParam(c)
+	if err != nil {
+		h.HandleValidationError(c, errs.ErrInvalidID)
+		return
+	}
+
+	resp, serviceErr := h.orderService.GetOrderDownloads(c, userID, orderID)
+	if serviceErr != nil {
+		log.ErrorWithContext(c, "getOrderDownloads: failed", serviceErr)
+		h.HandleError(c, serviceErr, orderConstants.FAILED_TO_FETCH_ORDER_DOWNLOADS_MSG)
+		return
+	}
+
+	h.Success(c, http.StatusOK, orderConstants.ORDER_DOWNLOADS_FETCHED_MSG, resp)
+}
+
 func parseOrderIDParam(c *gin.Context) (uint, error) {
 	orderIDRaw := c.Param("id")
 	orderID64, err := strconv.ParseUint(orderIDRaw, 10, 64)
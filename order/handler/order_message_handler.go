@@ -0,0 +1,126 @@
+package handler
+
+import (
+	"net/http"
+
+	"ecommerce-be/common/auth"
+	"ecommerce-be/common/constants"
+	errs "ecommerce-be/common/error"
+	"ecommerce-be/common/handler"
+	"ecommerce-be/common/log"
+	"ecommerce-be/order/model"
+	"ecommerce-be/order/service"
+	orderConstants "ecommerce-be/order/utils/constant"
+
+	"github.com/gin-gonic/gin"
+)
+
+// OrderMessageHandler serves the customer-seller message thread and timeline of an order.
+type OrderMessageHandler struct {
+	*handler.BaseHandler
+	messageService service.OrderMessageService
+}
+
+func NewOrderMessageHandler(messageService service.OrderMessageService) *OrderMessageHandler {
+	return &OrderMessageHandler{
+		BaseHandler:    handler.NewBaseHandler(),
+		messageService: messageService,
+	}
+}
+
+func (h *OrderMessageHandler) InitAttachmentUpload(c *gin.Context) {
+	userID, role, orderID, ok := h.orderCaller(c)
+	if !ok {
+		return
+	}
+
+	var req model.OrderMessageAttachmentRequest
+	if err := h.BindJSON(c, &req); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	ticket, err := h.messageService.InitAttachmentUpload(c, userID, role, orderID, req)
+	if err != nil {
+		log.ErrorWithContext(c, "initOrderMessageAttachment: failed", err)
+		h.HandleError(c, err, orderConstants.FAILED_TO_INIT_MESSAGE_ATTACHMENT_MSG)
+		return
+	}
+
+	h.Success(c, http.StatusCreated, orderConstants.ORDER_MESSAGE_ATTACHMENT_UPLOAD_MSG, ticket)
+}
+
+func (h *OrderMessageHandler) PostMessage(c *gin.Context) {
+	userID, role, orderID, ok := h.orderCaller(c)
+	if !ok {
+		return
+	}
+
+	var req model.PostOrderMessageRequest
+	if err := h.BindJSON(c, &req); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	resp, err := h.messageService.PostMessage(c, userID, role, orderID, req)
+	if err != nil {
+		log.ErrorWithContext(c, "postOrderMessage: failed", err)
+		h.HandleError(c, err, orderConstants.FAILED_TO_POST_ORDER_MESSAGE_MSG)
+		return
+	}
+
+	h.Success(c, http.StatusCreated, orderConstants.ORDER_MESSAGE_POSTED_MSG, resp)
+}
+
+func (h *OrderMessageHandler) GetThread(c *gin.Context) {
+	userID, role, orderID, ok := h.orderCaller(c)
+	if !ok {
+		return
+	}
+
+	resp, err := h.messageService.GetThread(c, userID, role, orderID)
+	if err != nil {
+		log.ErrorWithContext(c, "getOrderMessages: failed", err)
+		h.HandleError(c, err, orderConstants.FAILED_TO_FETCH_ORDER_MESSAGES_MSG)
+		return
+	}
+
+	h.Success(c, http.StatusOK, orderConstants.ORDER_MESSAGES_FETCHED_MSG, resp)
+}
+
+func (h *OrderMessageHandler) GetTimeline(c *gin.Context) {
+	userID, role, orderID, ok := h.orderCaller(c)
+	if !ok {
+		return
+	}
+
+	resp, err := h.messageService.GetTimeline(c, userID, role, orderID)
+	if err != nil {
+		log.ErrorWithContext(c, "getOrderTimeline: failed", err)
+		h.HandleError(c, err, orderConstants.FAILED_TO_FETCH_ORDER_TIMELINE_MSG)
+		return
+	}
+
+	h.Success(c, http.StatusOK, orderConstants.ORDER_TIMELINE_FETCHED_MSG, resp)
+}
+
+// orderCaller reads the caller and the order ID, writing the error response when one
+// is missing or invalid
+func (h *OrderMessageHandler) orderCaller(c *gin.Context) (uint, string, uint, bool) {
+	userID, exists := auth.GetUserIDFromContext(c)
+	if !exists {
+		h.HandleError(c, errs.UnauthorizedError, constants.AUTHENTICATION_REQUIRED_MSG)
+		return 0, "", 0, false
+	}
+	_, role, exists := auth.GetUserRoleFromContext(c)
+	if !exists {
+		h.HandleError(c, errs.ErrRoleDataMissing, constants.ROLE_DATA_MISSING_MSG)
+		return 0, "", 0, false
+	}
+	orderID, err := parseOrderIDParam(c)
+	if err != nil {
+		h.HandleValidationError(c, errs.ErrInvalidID)
+		return 0, "", 0, false
+	}
+	return userID, role, orderID, true
+}
@@ -19,19 +19,20 @@ func BuildOrderEntity(
 	now time.Time,
 ) *entity.Order {
 	return &entity.Order{
-		UserID:          userID,
-		SellerID:        &sellerID,
-		OrderNumber:     orderUtils.GenerateOrderNumber(sellerID),
-		Status:          status,
-		SubtotalCents:   subtotalCents,
-		TaxCents:        taxCents,
-		ShippingCents:   shippingCents,
-		DiscountCents:   discountCents,
-		TotalCents:      totalCents,
-		PlacedAt:        &now,
-		Metadata:        toJSONMap(metadata),
-		TransactionID:   "",
-		FulfillmentType: fulfillmentType,
+		UserID:            userID,
+		SellerID:          &sellerID,
+		OrderNumber:       orderUtils.GenerateOrderNumber(sellerID),
+		Status:            status,
+		SubtotalCents:     subtotalCents,
+		TaxCents:          taxCents,
+		ShippingCents:     shippingCents,
+		DiscountCents:     discountCents,
+		TotalCents:        totalCents,
+		PlacedAt:          &now,
+		Metadata:          toJSONMap(metadata),
+		TransactionID:     "",
+		FulfillmentType:   fulfillmentType,
+		FulfillmentStatus: entity.FULFILLMENT_STATUS_UNFULFILLED,
 	}
 }
 
@@ -74,6 +75,24 @@ func BuildOrderTransitionHistory(
 	}
 }
 
+// BuildSystemTransitionHistory maps a transition made by a background job, which has no
+// acting user.
+func BuildSystemTransitionHistory(
+	orderID uint,
+	fromStatus, toStatus entity.OrderStatus,
+	role string,
+	note *string,
+) *entity.OrderHistory {
+	return &entity.OrderHistory{
+		OrderID:       orderID,
+		FromStatus:    strPtr(fromStatus.String()),
+		ToStatus:      toStatus.String(),
+		ChangedByRole: &role,
+		Note:          note,
+		Metadata:      db.JSONMap{},
+	}
+}
+
 func toJSONMap(in map[string]any) db.JSONMap {
 	if in == nil {
 		return db.JSONMap{}
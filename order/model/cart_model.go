@@ -7,6 +7,8 @@ package model
 // AddCartItemRequest represents the request to add an item to cart
 type AddCartItemRequest struct {
 	Items []AddCartItemDetail `json:"items" binding:"required,min=1,dive"`
+	// SalesChannel prices the returned cart with that channel's overrides (web, pos, marketplace)
+	SalesChannel string `json:"salesChannel"`
 }
 
 // AddCartItemDetail represents one cart line in batch add-to-cart requests.
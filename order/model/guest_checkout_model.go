@@ -0,0 +1,65 @@
+package model
+
+import (
+	"time"
+
+	"ecommerce-be/order/entity"
+	userModel "ecommerce-be/user/model"
+)
+
+// ============================================================================
+// Request Models
+// ============================================================================
+
+// GuestCheckoutRequest places an order without a registered account. The items are
+// priced and reserved exactly as a customer's cart would be.
+type GuestCheckoutRequest struct {
+	Email     string `json:"email"     binding:"required,email,max=255"`
+	FirstName string `json:"firstName" binding:"required,max=100"`
+	LastName  string `json:"lastName"  binding:"required,max=100"`
+	Phone     string `json:"phone"     binding:"omitempty,e164phone"`
+
+	ShippingAddress userModel.AddressRequest `json:"shippingAddress" binding:"required"`
+	// BillingAddress defaults to the shipping address
+	BillingAddress *userModel.AddressRequest `json:"billingAddress"`
+
+	Items           []AddCartItemDetail    `json:"items"           binding:"required,min=1,dive"`
+	FulfillmentType entity.FulfillmentType `json:"fulfillmentType"`
+	// SalesChannel prices the order with that channel's overrides (web, pos, marketplace)
+	SalesChannel string               `json:"salesChannel"`
+	DeliverySlot *DeliverySlotRequest `json:"deliverySlot"`
+	Metadata     map[string]any       `json:"metadata"`
+}
+
+// GuestOrderLookupRequest looks up a guest order by its access token
+type GuestOrderLookupRequest struct {
+	Token string `form:"token" binding:"required"`
+}
+
+// ConfirmGuestOrderClaimRequest confirms a claim with the token from the emailed link
+type ConfirmGuestOrderClaimRequest struct {
+	Token string `json:"token" binding:"required"`
+}
+
+// ============================================================================
+// Response Models
+// ============================================================================
+
+// GuestCheckoutResponse is the placed order and the token that looks it up again.
+// The token is only returned here; guests keep it to check the order's status.
+type GuestCheckoutResponse struct {
+	Order       *OrderResponse `json:"order"`
+	AccessToken string         `json:"accessToken"`
+}
+
+// GuestOrderClaimResponse describes a claim waiting for its emailed link to be opened
+type GuestOrderClaimResponse struct {
+	Email           string    `json:"email"`
+	ClaimableOrders int64     `json:"claimableOrders"`
+	ExpiresAt       time.Time `json:"expiresAt"`
+}
+
+// GuestOrderClaimConfirmResponse reports the guest orders added to the account
+type GuestOrderClaimConfirmResponse struct {
+	ClaimedOrders int `json:"claimedOrders"`
+}
@@ -0,0 +1,73 @@
+package model
+
+import (
+	"time"
+
+	"ecommerce-be/order/entity"
+)
+
+// ============================================================================
+// Request Models
+// ============================================================================
+
+// CreateMarketplaceConnectionRequest connects a seller's marketplace account.
+// The access token is used to push shipment confirmations back to the marketplace.
+type CreateMarketplaceConnectionRequest struct {
+	Marketplace      entity.Marketplace `json:"marketplace"      binding:"required,oneof=amazon ebay"`
+	ExternalSellerID string             `json:"externalSellerId" binding:"required,max=100"`
+	AccessToken      string             `json:"accessToken"      binding:"required"`
+	// APIBaseURL overrides the marketplace API endpoint (regional endpoints, sandboxes)
+	APIBaseURL string `json:"apiBaseUrl" binding:"omitempty,url"`
+}
+
+// ============================================================================
+// Response Models
+// ============================================================================
+
+// MarketplaceConnectionResponse describes a marketplace connection. WebhookSecret is only
+// returned when the connection is created; it signs webhook deliveries.
+type MarketplaceConnectionResponse struct {
+	ID               uint               `json:"id"`
+	Marketplace      entity.Marketplace `json:"marketplace"`
+	ExternalSellerID string             `json:"externalSellerId"`
+	IsActive         bool               `json:"isActive"`
+	WebhookPath      string             `json:"webhookPath"`
+	WebhookSecret    string             `json:"webhookSecret,omitempty"`
+	LastOrderAt      *time.Time         `json:"lastOrderAt"`
+	CreatedAt        time.Time          `json:"createdAt"`
+}
+
+// MarketplaceIngestedOrder reports what happened to one order carried by a webhook
+type MarketplaceIngestedOrder struct {
+	ExternalOrderID string `json:"externalOrderId"`
+	OrderID         uint   `json:"orderId,omitempty"`
+	OrderNumber     string `json:"orderNumber,omitempty"`
+	// Result is one of created, duplicate, cancelled or skipped
+	Result string `json:"result"`
+}
+
+// MarketplaceWebhookResponse summarizes a processed webhook delivery
+type MarketplaceWebhookResponse struct {
+	Orders []MarketplaceIngestedOrder `json:"orders"`
+}
+
+// ============================================================================
+// Internal Models
+// ============================================================================
+
+// MarketplaceShipmentRequest describes a shipment created for an order, used to confirm the
+// shipment on the marketplace the order was ingested from
+type MarketplaceShipmentRequest struct {
+	OrderID    uint
+	ShipmentID uint
+	Carrier    string
+	TrackingNo string
+	ShippedAt  time.Time
+	Items      []MarketplaceShipmentItem
+}
+
+// MarketplaceShipmentItem is one shipped order line
+type MarketplaceShipmentItem struct {
+	OrderItemID uint
+	Quantity    int
+}
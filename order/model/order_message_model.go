@@ -0,0 +1,74 @@
+package model
+
+import (
+	"time"
+
+	"ecommerce-be/common/filegateway"
+)
+
+// ============================================================================
+// Request Models
+// ============================================================================
+
+// PostOrderMessageRequest posts to an order's thread. Attachments are uploaded first
+// through the attachment endpoint; their file IDs are completed when the message posts.
+type PostOrderMessageRequest struct {
+	Body              string   `json:"body"              binding:"max=4000"`
+	AttachmentFileIDs []string `json:"attachmentFileIds" binding:"omitempty,dive,required"`
+}
+
+// OrderMessageAttachmentRequest starts an attachment upload. The returned ticket carries
+// a presigned URL the client PUTs the bytes to before posting the message.
+type OrderMessageAttachmentRequest struct {
+	Filename  string `json:"filename"  binding:"required,max=255"`
+	MimeType  string `json:"mimeType"  binding:"required"`
+	SizeBytes int64  `json:"sizeBytes" binding:"required,min=1"`
+}
+
+// ============================================================================
+// Response Models
+// ============================================================================
+
+type OrderMessageResponse struct {
+	ID           uint   `json:"id"`
+	SenderUserID uint   `json:"senderUserId"`
+	SenderRole   string `json:"senderRole"`
+	FromCustomer bool   `json:"fromCustomer"`
+	Body         string `json:"body"`
+	// Attachments that can no longer be resolved are left out
+	Attachments []filegateway.FileAssetResponse `json:"attachments"`
+	ReadAt      *time.Time                      `json:"readAt"`
+	CreatedAt   time.Time                       `json:"createdAt"`
+}
+
+// OrderMessageThreadResponse is an order's thread. UnreadCount is the number of messages
+// from the other side that were unread before this fetch marked them read.
+type OrderMessageThreadResponse struct {
+	OrderID     uint                   `json:"orderId"`
+	OrderNumber string                 `json:"orderNumber"`
+	UnreadCount int64                  `json:"unreadCount"`
+	Messages    []OrderMessageResponse `json:"messages"`
+}
+
+// OrderTimelineStatus is a status transition on the order timeline
+type OrderTimelineStatus struct {
+	FromStatus    *string `json:"fromStatus"`
+	ToStatus      string  `json:"toStatus"`
+	ChangedByRole *string `json:"changedByRole"`
+	Note          *string `json:"note"`
+}
+
+// OrderTimelineEntry is one event of an order's history; exactly one of Status and
+// Message is set, according to Type
+type OrderTimelineEntry struct {
+	Type       string                `json:"type"`
+	OccurredAt time.Time             `json:"occurredAt"`
+	Status     *OrderTimelineStatus  `json:"status,omitempty"`
+	Message    *OrderMessageResponse `json:"message,omitempty"`
+}
+
+type OrderTimelineResponse struct {
+	OrderID     uint                 `json:"orderId"`
+	OrderNumber string               `json:"orderNumber"`
+	Entries     []OrderTimelineEntry `json:"entries"`
+}
@@ -6,6 +6,7 @@ import (
 
 	"ecommerce-be/common"
 	"ecommerce-be/order/entity"
+	paymentModel "ecommerce-be/payment/model"
 )
 
 // PaginationResponse alias for common pagination response.
@@ -21,6 +22,21 @@ type CreateOrderRequest struct {
 	FulfillmentType   entity.FulfillmentType `json:"fulfillmentType"`
 	Status            *entity.OrderStatus    `json:"status"`
 	Metadata          map[string]any         `json:"metadata"`
+	// SalesChannel prices the order with that channel's overrides (web, pos, marketplace)
+	SalesChannel string `json:"salesChannel"`
+	// DeliverySlot books delivery and pickup orders into a window; the confirmation
+	// email then carries a calendar invite for it
+	DeliverySlot *DeliverySlotRequest `json:"deliverySlot"`
+	// PaymentPlan deposit collects part of the total at placement and the balance later,
+	// either before fulfillment or on delivery (BalanceCollection). Defaults to full.
+	PaymentPlan       *entity.PaymentPlan       `json:"paymentPlan"`
+	BalanceCollection *entity.BalanceCollection `json:"balanceCollection"`
+}
+
+// DeliverySlotRequest is a delivery or pickup window. Times are RFC 3339.
+type DeliverySlotRequest struct {
+	Start time.Time `json:"start" binding:"required"`
+	End   time.Time `json:"end"   binding:"required"`
 }
 
 type UpdateOrderStatusRequest struct {
@@ -31,6 +47,12 @@ type UpdateOrderStatusRequest struct {
 	Metadata      map[string]any     `json:"metadata"`
 }
 
+// RecordBalancePaymentRequest records the balance payment of a deposit order.
+type RecordBalancePaymentRequest struct {
+	TransactionID string  `json:"transactionId" binding:"required"`
+	Note          *string `json:"note"`
+}
+
 type CancelOrderRequest struct {
 	Reason *string `json:"reason"`
 }
@@ -115,6 +137,7 @@ type OrderItemResponse struct {
 	ImageURL                  *string                          `json:"imageUrl"`
 	ImageFileID               *string                          `json:"imageFileId,omitempty"`
 	Quantity                  int                              `json:"quantity"`
+	FulfilledQuantity         int                              `json:"fulfilledQuantity"`
 	UnitPriceCents            int64                            `json:"unitPriceCents"`
 	LineTotalCents            int64                            `json:"lineTotalCents"`
 	Attributes                map[string]any                   `json:"attributes"`
@@ -131,6 +154,8 @@ type OrderAddressResponse struct {
 	CountryID uint                    `json:"countryId"`
 	Latitude  *float64                `json:"latitude"`
 	Longitude *float64                `json:"longitude"`
+
+	SourceAddressID *uint `json:"sourceAddressId,omitempty"`
 }
 
 type OrderPromotionResponse struct {
@@ -144,38 +169,67 @@ type OrderPromotionResponse struct {
 }
 
 type OrderResponse struct {
-	ID                uint                     `json:"id"`
-	OrderNumber       string                   `json:"orderNumber"`
-	Status            entity.OrderStatus       `json:"status"`
-	SubtotalCents     int64                    `json:"subtotalCents"`
-	DiscountCents     int64                    `json:"discountCents"`
-	ShippingCents     int64                    `json:"shippingCents"`
-	TaxCents          int64                    `json:"taxCents"`
-	TotalCents        int64                    `json:"totalCents"`
-	FulfillmentType   entity.FulfillmentType   `json:"fulfillmentType"`
-	PlacedAt          *time.Time               `json:"placedAt"`
-	PaidAt            *time.Time               `json:"paidAt"`
-	TransactionID     string                   `json:"transactionId"`
-	Metadata          map[string]any           `json:"metadata"`
-	Customer          *OrderCustomerResponse   `json:"customer,omitempty"`
-	Items             []OrderItemResponse      `json:"items"`
-	Addresses         []OrderAddressResponse   `json:"addresses"`
-	AppliedPromotions []OrderPromotionResponse `json:"appliedPromotions"`
+	ID                uint                          `json:"id"`
+	OrderNumber       string                        `json:"orderNumber"`
+	Status            entity.OrderStatus            `json:"status"`
+	SubtotalCents     int64                         `json:"subtotalCents"`
+	DiscountCents     int64                         `json:"discountCents"`
+	ShippingCents     int64                         `json:"shippingCents"`
+	TaxCents          int64                         `json:"taxCents"`
+	TotalCents        int64                         `json:"totalCents"`
+	FulfillmentType   entity.FulfillmentType        `json:"fulfillmentType"`
+	FulfillmentStatus entity.OrderFulfillmentStatus `json:"fulfillmentStatus"`
+	SalesChannel      *string                       `json:"salesChannel,omitempty"`
+	PlacedAt          *time.Time                    `json:"placedAt"`
+	PaidAt            *time.Time                    `json:"paidAt"`
+	TransactionID     string                        `json:"transactionId"`
+	Metadata          map[string]any                `json:"metadata"`
+	DeliverySlot      *DeliverySlotResponse         `json:"deliverySlot,omitempty"`
+	Customer          *OrderCustomerResponse        `json:"customer,omitempty"`
+	Items             []OrderItemResponse           `json:"items"`
+	Addresses         []OrderAddressResponse        `json:"addresses"`
+	AppliedPromotions []OrderPromotionResponse      `json:"appliedPromotions"`
+	// Deposit orders only: the unpaid remainder and the deposit/balance schedule
+	PaymentPlan       entity.PaymentPlan           `json:"paymentPlan"`
+	BalanceCollection *entity.BalanceCollection    `json:"balanceCollection,omitempty"`
+	BalanceDueCents   int64                        `json:"balanceDueCents"`
+	PaymentSchedule   []PaymentInstallmentResponse `json:"paymentSchedule,omitempty"`
+}
+
+// PaymentInstallmentResponse is one scheduled payment of a deposit order. Balances
+// collected on delivery have no due date.
+type PaymentInstallmentResponse struct {
+	Kind          entity.InstallmentKind   `json:"kind"`
+	AmountCents   int64                    `json:"amountCents"`
+	Status        entity.InstallmentStatus `json:"status"`
+	DueAt         *time.Time               `json:"dueAt"`
+	PaidAt        *time.Time               `json:"paidAt"`
+	TransactionID *string                  `json:"transactionId,omitempty"`
+}
+
+// DeliverySlotResponse is the scheduled window of an order. Sequence counts reschedules.
+type DeliverySlotResponse struct {
+	Start    time.Time `json:"start"`
+	End      time.Time `json:"end"`
+	Sequence int       `json:"sequence"`
 }
 
 // OrderListResponse is a lightweight order summary for list APIs.
 type OrderListResponse struct {
-	ID              uint                   `json:"id"`
-	OrderNumber     string                 `json:"orderNumber"`
-	Status          entity.OrderStatus     `json:"status"`
-	TotalCents      int64                  `json:"totalCents"`
-	SubtotalCents   int64                  `json:"subtotalCents"`
-	DiscountCents   int64                  `json:"discountCents"`
-	FulfillmentType entity.FulfillmentType `json:"fulfillmentType"`
-	PlacedAt        *time.Time             `json:"placedAt"`
-	PaidAt          *time.Time             `json:"paidAt"`
-	CreatedAt       time.Time              `json:"createdAt"`
-	Customer        *OrderCustomerResponse `json:"customer,omitempty"`
+	ID                uint                          `json:"id"`
+	OrderNumber       string                        `json:"orderNumber"`
+	Status            entity.OrderStatus            `json:"status"`
+	TotalCents        int64                         `json:"totalCents"`
+	SubtotalCents     int64                         `json:"subtotalCents"`
+	DiscountCents     int64                         `json:"discountCents"`
+	FulfillmentType   entity.FulfillmentType        `json:"fulfillmentType"`
+	FulfillmentStatus entity.OrderFulfillmentStatus `json:"fulfillmentStatus"`
+	PlacedAt          *time.Time                    `json:"placedAt"`
+	PaidAt            *time.Time                    `json:"paidAt"`
+	CreatedAt         time.Time                     `json:"createdAt"`
+	Customer          *OrderCustomerResponse        `json:"customer,omitempty"`
+	// UnreadMessages counts thread messages the caller has not read yet
+	UnreadMessages int64 `json:"unreadMessages"`
 }
 
 type UpdateStatusResponse struct {
@@ -185,9 +239,23 @@ type UpdateStatusResponse struct {
 	Status         entity.OrderStatus `json:"status"`
 	TransactionID  *string            `json:"transactionId"`
 	UpdatedAt      time.Time          `json:"updatedAt"`
+	// Refunds issued when the order was cancelled after payment
+	Refunds []paymentModel.PaymentRefundResponse `json:"refunds,omitempty"`
 }
 
 type PaginatedOrdersResponse struct {
 	Orders     []OrderListResponse `json:"orders"`
 	Pagination PaginationResponse  `json:"pagination"`
 }
+
+// FulfillmentLine assigns a quantity of one order item to a shipment
+type FulfillmentLine struct {
+	OrderItemID uint
+	Quantity    int
+}
+
+// FulfillmentResult is what a shipment fulfilled and the order's fulfillment status after it
+type FulfillmentResult struct {
+	Lines             []FulfillmentLine
+	FulfillmentStatus entity.OrderFulfillmentStatus
+}
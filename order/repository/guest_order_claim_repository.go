@@ -0,0 +1,59 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"ecommerce-be/common/db"
+	"ecommerce-be/order/entity"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type GuestOrderClaimRepository interface {
+	Create(ctx context.Context, claim *entity.GuestOrderClaim) error
+	// FindByTokenHashForUpdate locks and returns the claim the token belongs to, or nil
+	FindByTokenHashForUpdate(ctx context.Context, tokenHash string) (*entity.GuestOrderClaim, error)
+	Save(ctx context.Context, claim *entity.GuestOrderClaim) error
+}
+
+type GuestOrderClaimRepositoryImpl struct{}
+
+func NewGuestOrderClaimRepository() GuestOrderClaimRepository {
+	return &GuestOrderClaimRepositoryImpl{}
+}
+
+func (r *GuestOrderClaimRepositoryImpl) Create(
+	ctx context.Context,
+	claim *entity.GuestOrderClaim,
+) error {
+	return db.DB(ctx).Create(claim).Error
+}
+
+// FindByTokenHashForUpdate locks the claim so opening the link twice claims once
+func (r *GuestOrderClaimRepositoryImpl) FindByTokenHashForUpdate(
+	ctx context.Context,
+	tokenHash string,
+) (*entity.GuestOrderClaim, error) {
+	var claim entity.GuestOrderClaim
+	err := db.DB(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("token_hash = ?", tokenHash).
+		First(&claim).
+		Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &claim, nil
+}
+
+func (r *GuestOrderClaimRepositoryImpl) Save(
+	ctx context.Context,
+	claim *entity.GuestOrderClaim,
+) error {
+	return db.DB(ctx).Save(claim).Error
+}
@@ -0,0 +1,213 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"ecommerce-be/common/db"
+	"ecommerce-be/order/entity"
+
+	"gorm.io/gorm"
+)
+
+// MarketplaceRepository handles database operations for marketplace connections, ingested
+// marketplace orders and shipment confirmations pushed back to marketplaces.
+type MarketplaceRepository interface {
+	CreateConnection(ctx context.Context, conn *entity.MarketplaceConnection) error
+	FindConnectionByID(ctx context.Context, id uint) (*entity.MarketplaceConnection, error)
+	FindConnectionByAccount(
+		ctx context.Context,
+		marketplace entity.Marketplace,
+		externalSellerID string,
+	) (*entity.MarketplaceConnection, error)
+	FindConnectionsBySellerID(
+		ctx context.Context,
+		sellerID uint,
+	) ([]entity.MarketplaceConnection, error)
+	UpdateConnectionActive(ctx context.Context, id uint, active bool) error
+	UpdateConnectionLastOrderAt(ctx context.Context, id uint, at time.Time) error
+
+	CreateOrderLink(ctx context.Context, link *entity.MarketplaceOrder) error
+	FindOrderLinkByExternalID(
+		ctx context.Context,
+		marketplace entity.Marketplace,
+		externalOrderID string,
+	) (*entity.MarketplaceOrder, error)
+	FindOrderLinkByOrderID(ctx context.Context, orderID uint) (*entity.MarketplaceOrder, error)
+
+	CreateShipmentSync(ctx context.Context, sync *entity.MarketplaceShipmentSync) error
+	UpdateShipmentSync(ctx context.Context, sync *entity.MarketplaceShipmentSync) error
+	FindRetryableShipmentSyncs(
+		ctx context.Context,
+		maxAttempts int,
+		limit int,
+	) ([]entity.MarketplaceShipmentSync, error)
+}
+
+// MarketplaceRepositoryImpl implements MarketplaceRepository.
+type MarketplaceRepositoryImpl struct{}
+
+// NewMarketplaceRepository creates a new MarketplaceRepository.
+func NewMarketplaceRepository() MarketplaceRepository {
+	return &MarketplaceRepositoryImpl{}
+}
+
+func (r *MarketplaceRepositoryImpl) CreateConnection(
+	ctx context.Context,
+	conn *entity.MarketplaceConnection,
+) error {
+	return db.DB(ctx).Create(conn).Error
+}
+
+func (r *MarketplaceRepositoryImpl) FindConnectionByID(
+	ctx context.Context,
+	id uint,
+) (*entity.MarketplaceConnection, error) {
+	var conn entity.MarketplaceConnection
+	err := db.DB(ctx).Where("id = ?", id).First(&conn).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &conn, nil
+}
+
+func (r *MarketplaceRepositoryImpl) FindConnectionByAccount(
+	ctx context.Context,
+	marketplace entity.Marketplace,
+	externalSellerID string,
+) (*entity.MarketplaceConnection, error) {
+	var conn entity.MarketplaceConnection
+	err := db.DB(ctx).
+		Where("marketplace = ? AND external_seller_id = ?", marketplace, externalSellerID).
+		First(&conn).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &conn, nil
+}
+
+func (r *MarketplaceRepositoryImpl) FindConnectionsBySellerID(
+	ctx context.Context,
+	sellerID uint,
+) ([]entity.MarketplaceConnection, error) {
+	var rows []entity.MarketplaceConnection
+	if err := db.DB(ctx).
+		Where("seller_id = ?", sellerID).
+		Order("created_at DESC").
+		Find(&rows).Error; err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+func (r *MarketplaceRepositoryImpl) UpdateConnectionActive(
+	ctx context.Context,
+	id uint,
+	active bool,
+) error {
+	return db.DB(ctx).
+		Model(&entity.MarketplaceConnection{}).
+		Where("id = ?", id).
+		Update("is_active", active).
+		Error
+}
+
+func (r *MarketplaceRepositoryImpl) UpdateConnectionLastOrderAt(
+	ctx context.Context,
+	id uint,
+	at time.Time,
+) error {
+	return db.DB(ctx).
+		Model(&entity.MarketplaceConnection{}).
+		Where("id = ?", id).
+		Update("last_order_at", at.UTC()).
+		Error
+}
+
+func (r *MarketplaceRepositoryImpl) CreateOrderLink(
+	ctx context.Context,
+	link *entity.MarketplaceOrder,
+) error {
+	return db.DB(ctx).Create(link).Error
+}
+
+func (r *MarketplaceRepositoryImpl) FindOrderLinkByExternalID(
+	ctx context.Context,
+	marketplace entity.Marketplace,
+	externalOrderID string,
+) (*entity.MarketplaceOrder, error) {
+	var link entity.MarketplaceOrder
+	err := db.DB(ctx).
+		Where("marketplace = ? AND external_order_id = ?", marketplace, externalOrderID).
+		First(&link).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &link, nil
+}
+
+func (r *MarketplaceRepositoryImpl) FindOrderLinkByOrderID(
+	ctx context.Context,
+	orderID uint,
+) (*entity.MarketplaceOrder, error) {
+	var link entity.MarketplaceOrder
+	err := db.DB(ctx).Where("order_id = ?", orderID).First(&link).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &link, nil
+}
+
+func (r *MarketplaceRepositoryImpl) CreateShipmentSync(
+	ctx context.Context,
+	sync *entity.MarketplaceShipmentSync,
+) error {
+	return db.DB(ctx).Omit("MarketplaceOrder").Create(sync).Error
+}
+
+func (r *MarketplaceRepositoryImpl) UpdateShipmentSync(
+	ctx context.Context,
+	sync *entity.MarketplaceShipmentSync,
+) error {
+	return db.DB(ctx).
+		Model(&entity.MarketplaceShipmentSync{}).
+		Where("id = ?", sync.ID).
+		Updates(map[string]any{
+			"status":     sync.Status,
+			"attempts":   sync.Attempts,
+			"last_error": sync.LastError,
+			"synced_at":  sync.SyncedAt,
+		}).Error
+}
+
+// FindRetryableShipmentSyncs returns failed confirmations that still have attempts left,
+// oldest first
+func (r *MarketplaceRepositoryImpl) FindRetryableShipmentSyncs(
+	ctx context.Context,
+	maxAttempts int,
+	limit int,
+) ([]entity.MarketplaceShipmentSync, error) {
+	var rows []entity.MarketplaceShipmentSync
+	if err := db.DB(ctx).
+		Preload("MarketplaceOrder").
+		Where("status = ? AND attempts < ?", entity.SHIPMENT_SYNC_FAILED, maxAttempts).
+		Order("updated_at ASC").
+		Limit(limit).
+		Find(&rows).Error; err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
@@ -0,0 +1,90 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"ecommerce-be/common/db"
+	"ecommerce-be/order/entity"
+)
+
+type OrderMessageRepository interface {
+	Create(ctx context.Context, message *entity.OrderMessage) error
+	FindByOrderID(ctx context.Context, orderID uint) ([]entity.OrderMessage, error)
+	// MarkRead sets read_at on the unread messages sent by one side of the thread
+	MarkRead(ctx context.Context, orderID uint, fromCustomer bool, readAt time.Time) error
+	// CountUnreadByOrderIDs returns the unread messages sent by one side, keyed by order.
+	// Orders without unread messages are omitted.
+	CountUnreadByOrderIDs(
+		ctx context.Context,
+		orderIDs []uint,
+		fromCustomer bool,
+	) (map[uint]int64, error)
+}
+
+type OrderMessageRepositoryImpl struct{}
+
+func NewOrderMessageRepository() OrderMessageRepository {
+	return &OrderMessageRepositoryImpl{}
+}
+
+func (r *OrderMessageRepositoryImpl) Create(
+	ctx context.Context,
+	message *entity.OrderMessage,
+) error {
+	return db.DB(ctx).Create(message).Error
+}
+
+func (r *OrderMessageRepositoryImpl) FindByOrderID(
+	ctx context.Context,
+	orderID uint,
+) ([]entity.OrderMessage, error) {
+	var rows []entity.OrderMessage
+	if err := db.DB(ctx).
+		Where("order_id = ?", orderID).
+		Order("created_at ASC, id ASC").
+		Find(&rows).Error; err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+func (r *OrderMessageRepositoryImpl) MarkRead(
+	ctx context.Context,
+	orderID uint,
+	fromCustomer bool,
+	readAt time.Time,
+) error {
+	return db.DB(ctx).
+		Model(&entity.OrderMessage{}).
+		Where("order_id = ? AND from_customer = ? AND read_at IS NULL", orderID, fromCustomer).
+		Update("read_at", readAt).Error
+}
+
+func (r *OrderMessageRepositoryImpl) CountUnreadByOrderIDs(
+	ctx context.Context,
+	orderIDs []uint,
+	fromCustomer bool,
+) (map[uint]int64, error) {
+	counts := make(map[uint]int64)
+	if len(orderIDs) == 0 {
+		return counts, nil
+	}
+
+	var rows []struct {
+		OrderID uint
+		Unread  int64
+	}
+	if err := db.DB(ctx).
+		Model(&entity.OrderMessage{}).
+		Select("order_id, COUNT(*) AS unread").
+		Where("order_id IN ? AND from_customer = ? AND read_at IS NULL", orderIDs, fromCustomer).
+		Group("order_id").
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		counts[row.OrderID] = row.Unread
+	}
+	return counts, nil
+}
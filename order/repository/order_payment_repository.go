@@ -0,0 +1,96 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"ecommerce-be/common/db"
+	"ecommerce-be/order/entity"
+)
+
+// OrderPaymentRepository handles the payment schedule of deposit orders.
+type OrderPaymentRepository interface {
+	CreateInstallments(ctx context.Context, installments []entity.OrderPaymentInstallment) error
+	UpdateInstallment(ctx context.Context, installment *entity.OrderPaymentInstallment) error
+	FindBalancesDueForReminder(
+		ctx context.Context,
+		dueBefore time.Time,
+		limit int,
+	) ([]entity.OrderPaymentInstallment, error)
+	FindOverdueBalances(
+		ctx context.Context,
+		now time.Time,
+		limit int,
+	) ([]entity.OrderPaymentInstallment, error)
+}
+
+// OrderPaymentRepositoryImpl implements OrderPaymentRepository.
+type OrderPaymentRepositoryImpl struct{}
+
+// NewOrderPaymentRepository creates a new OrderPaymentRepository.
+func NewOrderPaymentRepository() OrderPaymentRepository {
+	return &OrderPaymentRepositoryImpl{}
+}
+
+func (r *OrderPaymentRepositoryImpl) CreateInstallments(
+	ctx context.Context,
+	installments []entity.OrderPaymentInstallment,
+) error {
+	if len(installments) == 0 {
+		return nil
+	}
+	return db.DB(ctx).Create(&installments).Error
+}
+
+func (r *OrderPaymentRepositoryImpl) UpdateInstallment(
+	ctx context.Context,
+	installment *entity.OrderPaymentInstallment,
+) error {
+	return db.DB(ctx).Omit("Order").Save(installment).Error
+}
+
+// FindBalancesDueForReminder returns unpaid balances of confirmed orders falling due
+// before dueBefore whose customer has not been reminded yet, soonest first
+func (r *OrderPaymentRepositoryImpl) FindBalancesDueForReminder(
+	ctx context.Context,
+	dueBefore time.Time,
+	limit int,
+) ([]entity.OrderPaymentInstallment, error) {
+	return r.findPendingBalances(ctx, limit,
+		"order_payment_installment.due_at <= ? AND "+
+			"order_payment_installment.reminder_sent_at IS NULL",
+		dueBefore.UTC())
+}
+
+// FindOverdueBalances returns unpaid balances of confirmed orders whose due date has
+// passed, oldest first
+func (r *OrderPaymentRepositoryImpl) FindOverdueBalances(
+	ctx context.Context,
+	now time.Time,
+	limit int,
+) ([]entity.OrderPaymentInstallment, error) {
+	return r.findPendingBalances(ctx, limit,
+		"order_payment_installment.due_at <= ?", now.UTC())
+}
+
+func (r *OrderPaymentRepositoryImpl) findPendingBalances(
+	ctx context.Context,
+	limit int,
+	condition string,
+	args ...any,
+) ([]entity.OrderPaymentInstallment, error) {
+	var rows []entity.OrderPaymentInstallment
+	if err := db.DB(ctx).
+		Joins(`JOIN "order" ON "order".id = order_payment_installment.order_id`).
+		Preload("Order").
+		Where("order_payment_installment.kind = ?", entity.INSTALLMENT_BALANCE).
+		Where("order_payment_installment.status = ?", entity.INSTALLMENT_STATUS_PENDING).
+		Where(`"order".status = ?`, entity.ORDER_STATUS_CONFIRMED).
+		Where(condition, args...).
+		Order("order_payment_installment.due_at ASC").
+		Limit(limit).
+		Find(&rows).Error; err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
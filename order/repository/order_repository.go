@@ -12,6 +12,7 @@ import (
 	"ecommerce-be/order/model"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // OrderRepository handles database operations for orders.
@@ -28,6 +29,8 @@ type OrderRepository interface {
 	) error
 
 	FindOrderByID(ctx context.Context, orderID uint) (*entity.Order, error)
+	// FindOrderByIDForUpdate locks the order row and loads its items
+	FindOrderByIDForUpdate(ctx context.Context, orderID uint) (*entity.Order, error)
 	FindOrdersByUserID(
 		ctx context.Context,
 		userID uint,
@@ -46,6 +49,18 @@ type OrderRepository interface {
 	UpdateOrderStatus(ctx context.Context, orderID uint, status entity.OrderStatus) error
 	UpdateOrderTransactionID(ctx context.Context, orderID uint, txnID string) error
 	UpdateOrderPaidAt(ctx context.Context, orderID uint, paidAt time.Time) error
+	UpdateDeliverySlot(ctx context.Context, orderID uint, start, end time.Time) error
+	UpdateOrderItemFulfilledQuantity(ctx context.Context, itemID uint, quantity int) error
+	UpdateOrderFulfillmentStatus(
+		ctx context.Context,
+		orderID uint,
+		status entity.OrderFulfillmentStatus,
+	) error
+
+	// CountOrdersByUserIDs counts the orders placed by any of the users
+	CountOrdersByUserIDs(ctx context.Context, userIDs []uint) (int64, error)
+	// ReassignOrders moves every order of fromUserIDs to toUserID and returns how many moved
+	ReassignOrders(ctx context.Context, fromUserIDs []uint, toUserID uint) (int64, error)
 }
 
 // OrderRepositoryImpl implements OrderRepository.
@@ -125,6 +140,7 @@ func (r *OrderRepositoryImpl) FindOrderByID(
 		Preload("AppliedPromotions").
 		Preload("AppliedCoupons").
 		Preload("ItemAppliedPromotions").
+		Preload("PaymentInstallments").
 		Where("id = ?", orderID).
 		First(&order).Error
 	if err != nil {
@@ -136,6 +152,30 @@ func (r *OrderRepositoryImpl) FindOrderByID(
 	return &order, nil
 }
 
+func (r *OrderRepositoryImpl) FindOrderByIDForUpdate(
+	ctx context.Context,
+	orderID uint,
+) (*entity.Order, error) {
+	var order entity.Order
+	err := db.DB(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ?", orderID).
+		First(&order).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if err := db.DB(ctx).
+		Where("order_id = ?", orderID).
+		Order("id ASC").
+		Find(&order.Items).Error; err != nil {
+		return nil, err
+	}
+	return &order, nil
+}
+
 func (r *OrderRepositoryImpl) FindOrdersByUserID(
 	ctx context.Context,
 	userID uint,
@@ -298,3 +338,74 @@ func (r *OrderRepositoryImpl) UpdateOrderPaidAt(
 		Update("paid_at", paidAt.UTC()).
 		Error
 }
+
+// UpdateDeliverySlot moves the order's slot and bumps its sequence so the next
+// calendar invite supersedes the previous one.
+func (r *OrderRepositoryImpl) UpdateDeliverySlot(
+	ctx context.Context,
+	orderID uint,
+	start, end time.Time,
+) error {
+	return db.DB(ctx).
+		Model(&entity.Order{}).
+		Where("id = ?", orderID).
+		Updates(map[string]any{
+			"delivery_slot_start":    start.UTC(),
+			"delivery_slot_end":      end.UTC(),
+			"delivery_slot_sequence": gorm.Expr("delivery_slot_sequence + 1"),
+		}).
+		Error
+}
+
+func (r *OrderRepositoryImpl) UpdateOrderItemFulfilledQuantity(
+	ctx context.Context,
+	itemID uint,
+	quantity int,
+) error {
+	return db.DB(ctx).
+		Model(&entity.OrderItem{}).
+		Where("id = ?", itemID).
+		Update("fulfilled_quantity", quantity).
+		Error
+}
+
+func (r *OrderRepositoryImpl) UpdateOrderFulfillmentStatus(
+	ctx context.Context,
+	orderID uint,
+	status entity.OrderFulfillmentStatus,
+) error {
+	return db.DB(ctx).
+		Model(&entity.Order{}).
+		Where("id = ?", orderID).
+		Update("fulfillment_status", status).
+		Error
+}
+
+func (r *OrderRepositoryImpl) CountOrdersByUserIDs(
+	ctx context.Context,
+	userIDs []uint,
+) (int64, error) {
+	if len(userIDs) == 0 {
+		return 0, nil
+	}
+	var count int64
+	err := db.DB(ctx).Model(&entity.Order{}).
+		Where("user_id IN ?", userIDs).
+		Count(&count).Error
+	return count, err
+}
+
+func (r *OrderRepositoryImpl) ReassignOrders(
+	ctx context.Context,
+	fromUserIDs []uint,
+	toUserID uint,
+) (int64, error) {
+	if len(fromUserIDs) == 0 {
+		return 0, nil
+	}
+	result := db.DB(ctx).
+		Model(&entity.Order{}).
+		Where("user_id IN ?", fromUserIDs).
+		Update("user_id", toUserID)
+	return result.RowsAffected, result.Error
+}
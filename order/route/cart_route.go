@@ -1,10 +1,15 @@
 package route
 
 import (
+	"net/http"
+
 	"ecommerce-be/common/constants"
 	"ecommerce-be/common/middleware"
+	"ecommerce-be/common/openapi"
 	"ecommerce-be/order/factory/singleton"
 	"ecommerce-be/order/handler"
+	"ecommerce-be/order/model"
+	orderConstants "ecommerce-be/order/utils/constant"
 
 	"github.com/gin-gonic/gin"
 )
@@ -28,12 +33,25 @@ func (m *CartModule) RegisterRoutes(router *gin.Engine) {
 	customerAuth := middleware.CustomerAuth()
 
 	// Cart routes - /api/cart/*
-	cartRoutes := router.Group(constants.APIBaseOrder + "/cart")
+	cartRoutes := openapi.NewGroup(router.Group(constants.APIBaseOrder+"/cart"), "Cart")
 	cartRoutes.Use(customerAuth)
 	{
 		// Cart operations
-		cartRoutes.GET("", m.cartHandler.GetUserCart) // Get cart with full pricing
-		cartRoutes.DELETE("/:cartId", m.cartHandler.DeleteCart)
-		cartRoutes.POST("/item", m.cartHandler.AddToCart) // Add item to cart
+		// Get cart with full pricing
+		cartRoutes.GET("", m.cartHandler.GetUserCart).
+			Summary("Get the active cart with full pricing").
+			QueryParam(
+				orderConstants.SALES_CHANNEL_QUERY_PARAM,
+				"Sales channel used for channel pricing",
+			).
+			Returns(http.StatusOK, model.CartResponse{})
+		cartRoutes.DELETE("/:cartId", m.cartHandler.DeleteCart).
+			Summary("Delete a cart").
+			Returns(http.StatusOK, model.CartResponse{})
+		// Add item to cart
+		cartRoutes.POST("/item", m.cartHandler.AddToCart).
+			Summary("Add an item to the cart").
+			Body(model.AddCartItemRequest{}).
+			Returns(http.StatusCreated, model.CartResponse{})
 	}
 }
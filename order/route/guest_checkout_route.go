@@ -0,0 +1,68 @@
+package route
+
+import (
+	"net/http"
+
+	"ecommerce-be/common/constants"
+	"ecommerce-be/common/middleware"
+	"ecommerce-be/common/openapi"
+	"ecommerce-be/order/factory/singleton"
+	"ecommerce-be/order/handler"
+	"ecommerce-be/order/model"
+	orderConstants "ecommerce-be/order/utils/constant"
+
+	"github.com/gin-gonic/gin"
+)
+
+// GuestCheckoutModule implements the Module interface for guest checkout routes.
+type GuestCheckoutModule struct {
+	guestCheckoutHandler *handler.GuestCheckoutHandler
+}
+
+// NewGuestCheckoutModule creates a new instance of GuestCheckoutModule.
+func NewGuestCheckoutModule() *GuestCheckoutModule {
+	f := singleton.GetInstance()
+	return &GuestCheckoutModule{
+		guestCheckoutHandler: f.GetGuestCheckoutHandler(),
+	}
+}
+
+// RegisterRoutes registers guest checkout, guest order lookup and guest order claim routes.
+func (m *GuestCheckoutModule) RegisterRoutes(router *gin.Engine) {
+	customerAuth := middleware.CustomerAuth()
+
+	guestRoutes := openapi.NewGroup(router.Group(constants.APIBaseOrder+"/guest"), "Guest Checkout")
+	{
+		guestRoutes.POST("/checkout", middleware.PublicAPIAuth(), m.guestCheckoutHandler.Checkout).
+			Summary("Place an order without an account").
+			Description("Creates a guest customer for the email, stores the addresses and "+
+				"places the order. The access token in the response looks the order up "+
+				"again and is only returned here.").
+			Body(model.GuestCheckoutRequest{}).
+			Returns(http.StatusCreated, model.GuestCheckoutResponse{})
+
+		// Guest orders are authenticated by their signed access token
+		guestRoutes.GET("/order", m.guestCheckoutHandler.GetOrder).
+			Summary("Look up a guest order by its access token").
+			Query(model.GuestOrderLookupRequest{}).
+			Returns(http.StatusOK, model.OrderResponse{})
+
+		guestRoutes.POST("/claims", customerAuth, m.guestCheckoutHandler.RequestClaim).
+			Summary("Request to add guest orders to your account").
+			Description("Emails a confirmation link to the account's email when guest "+
+				"orders were placed with it.").
+			ReturnsField(
+				http.StatusCreated,
+				orderConstants.GUEST_ORDER_CLAIM_FIELD_NAME,
+				model.GuestOrderClaimResponse{},
+			)
+		guestRoutes.POST("/claims/confirm", customerAuth, m.guestCheckoutHandler.ConfirmClaim).
+			Summary("Add guest orders to your account").
+			Body(model.ConfirmGuestOrderClaimRequest{}).
+			ReturnsField(
+				http.StatusOK,
+				orderConstants.GUEST_ORDER_CLAIM_FIELD_NAME,
+				model.GuestOrderClaimConfirmResponse{},
+			)
+	}
+}
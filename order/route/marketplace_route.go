@@ -0,0 +1,63 @@
+package route
+
+import (
+	"net/http"
+
+	"ecommerce-be/common/constants"
+	"ecommerce-be/common/middleware"
+	"ecommerce-be/common/openapi"
+	"ecommerce-be/order/factory/singleton"
+	"ecommerce-be/order/handler"
+	"ecommerce-be/order/model"
+	orderConstants "ecommerce-be/order/utils/constant"
+
+	"github.com/gin-gonic/gin"
+)
+
+// MarketplaceModule implements the Module interface for marketplace order ingestion routes.
+type MarketplaceModule struct {
+	marketplaceHandler *handler.MarketplaceHandler
+}
+
+// NewMarketplaceModule creates a new instance of MarketplaceModule.
+func NewMarketplaceModule() *MarketplaceModule {
+	f := singleton.GetInstance()
+	return &MarketplaceModule{
+		marketplaceHandler: f.GetMarketplaceHandler(),
+	}
+}
+
+// RegisterRoutes registers marketplace connection and webhook routes.
+func (m *MarketplaceModule) RegisterRoutes(router *gin.Engine) {
+	sellerAuth := middleware.SellerAuth()
+
+	connectionRoutes := openapi.NewGroup(
+		router.Group(constants.APIBaseOrder+"/marketplace/connections"),
+		"Marketplaces",
+	)
+	{
+		connectionRoutes.POST("", sellerAuth, m.marketplaceHandler.CreateConnection).
+			Summary("Connect a marketplace account").
+			Description("The webhook secret is only returned in this response.").
+			Body(model.CreateMarketplaceConnectionRequest{}).
+			ReturnsField(http.StatusCreated, "connection", model.MarketplaceConnectionResponse{})
+		connectionRoutes.GET("", sellerAuth, m.marketplaceHandler.ListConnections).
+			Summary("List marketplace connections").
+			ReturnsField(http.StatusOK, "connections", []model.MarketplaceConnectionResponse{})
+		connectionRoutes.DELETE("/:id", sellerAuth, m.marketplaceHandler.DeactivateConnection).
+			Summary("Deactivate a marketplace connection").
+			Returns(http.StatusOK, nil)
+	}
+
+	// Marketplace webhooks are authenticated by the connection's HMAC signature.
+	webhookRoutes := openapi.NewGroup(
+		router.Group(constants.APIBaseOrder+"/marketplace/webhooks"),
+		"Marketplaces",
+	)
+	{
+		webhookRoutes.POST("/:marketplace/:connectionId", m.marketplaceHandler.ReceiveWebhook).
+			Summary("Receive a marketplace order webhook").
+			Header(orderConstants.MARKETPLACE_SIGNATURE_HEADER, "Hex HMAC-SHA256 of the body").
+			Returns(http.StatusOK, model.MarketplaceWebhookResponse{})
+	}
+}
@@ -1,24 +1,33 @@
 package route
 
 import (
+	"net/http"
+
 	"ecommerce-be/common/constants"
+	"ecommerce-be/common/filegateway"
 	"ecommerce-be/common/middleware"
+	"ecommerce-be/common/openapi"
 	"ecommerce-be/order/factory/singleton"
 	"ecommerce-be/order/handler"
+	"ecommerce-be/order/model"
+	paymentModel "ecommerce-be/payment/model"
+	productModel "ecommerce-be/product/model"
 
 	"github.com/gin-gonic/gin"
 )
 
 // OrderModule implements the Module interface for order routes.
 type OrderModule struct {
-	orderHandler *handler.OrderHandler
+	orderHandler   *handler.OrderHandler
+	messageHandler *handler.OrderMessageHandler
 }
 
 // NewOrderModule creates a new instance of OrderModule.
 func NewOrderModule() *OrderModule {
 	f := singleton.GetInstance()
 	return &OrderModule{
-		orderHandler: f.GetOrderHandler(),
+		orderHandler:   f.GetOrderHandler(),
+		messageHandler: f.GetOrderMessageHandler(),
 	}
 }
 
@@ -26,12 +35,76 @@ func NewOrderModule() *OrderModule {
 func (m *OrderModule) RegisterRoutes(router *gin.Engine) {
 	customerAuth := middleware.CustomerAuth()
 
-	orderRoutes := router.Group(constants.APIBaseOrder)
+	orderRoutes := openapi.NewGroup(router.Group(constants.APIBaseOrder), "Orders")
 	{
-		orderRoutes.POST("", customerAuth, m.orderHandler.CreateOrder)
-		orderRoutes.GET("", customerAuth, m.orderHandler.ListOrders)
-		orderRoutes.GET("/:id", customerAuth, m.orderHandler.GetOrderByID)
-		orderRoutes.PATCH("/:id/status", customerAuth, m.orderHandler.UpdateOrderStatus)
-		orderRoutes.POST("/:id/cancel", customerAuth, m.orderHandler.CancelOrder)
+		orderRoutes.POST("", customerAuth, m.orderHandler.CreateOrder).
+			Summary("Place an order from the active cart").
+			Body(model.CreateOrderRequest{}).
+			Returns(http.StatusCreated, model.OrderResponse{})
+		orderRoutes.GET("", customerAuth, m.orderHandler.ListOrders).
+			Summary("List orders visible to the caller").
+			Query(model.ListOrdersRequest{}).
+			Returns(http.StatusOK, model.PaginatedOrdersResponse{})
+		orderRoutes.GET("/:id", customerAuth, m.orderHandler.GetOrderByID).
+			Summary("Get order details").
+			Returns(http.StatusOK, model.OrderResponse{})
+		orderRoutes.PATCH("/:id/status", customerAuth, m.orderHandler.UpdateOrderStatus).
+			Summary("Transition an order to a new status").
+			Body(model.UpdateOrderStatusRequest{}).
+			Returns(http.StatusOK, model.UpdateStatusResponse{})
+		orderRoutes.POST("/:id/cancel", customerAuth, m.orderHandler.CancelOrder).
+			Summary("Cancel an order").
+			Description("Allowed while the order is pending or confirmed and within the "+
+				"seller's cancellation window. Payments taken online are refunded.").
+			Body(model.CancelOrderRequest{}).
+			Returns(http.StatusOK, model.UpdateStatusResponse{})
+		orderRoutes.POST("/:id/seller-cancel", customerAuth, m.orderHandler.SellerCancelOrder).
+			Summary("Cancel an order as its seller").
+			Description("Allowed while the order is pending or confirmed, regardless of the "+
+				"cancellation window. Payments taken online are refunded.").
+			Body(model.CancelOrderRequest{}).
+			Returns(http.StatusOK, model.UpdateStatusResponse{})
+		orderRoutes.PUT("/:id/delivery-slot", customerAuth, m.orderHandler.RescheduleDeliverySlot).
+			Summary("Reschedule an order's delivery slot").
+			Description("Emails the customer an updated calendar invite for the new slot.").
+			Body(model.DeliverySlotRequest{}).
+			Returns(http.StatusOK, model.OrderResponse{})
+		orderRoutes.POST("/:id/balance-payment", customerAuth, m.orderHandler.RecordBalancePayment).
+			Summary("Record the balance payment of a deposit order").
+			Description("Marks the balance paid and the order paid in full.").
+			Body(model.RecordBalancePaymentRequest{}).
+			Returns(http.StatusOK, model.OrderResponse{})
+		orderRoutes.POST("/:id/retry-payment", customerAuth, m.orderHandler.RetryPayment).
+			Summary("Start a fresh payment for an unpaid order").
+			Description("Allowed while the order is pending and unpaid. Any payment still "+
+				"pending for the order is replaced; the order is cancelled if the new payment "+
+				"is not completed before it expires.").
+			Returns(http.StatusCreated, paymentModel.PaymentIntentResponse{})
+		orderRoutes.GET("/:id/downloads", customerAuth, m.orderHandler.GetOrderDownloads).
+			Summary("Get download links and license keys of an order's digital items").
+			Description("Empty until the order is paid. Links are signed afresh on every call "+
+				"and expire; each download counts against the purchase's download limit.").
+			Returns(http.StatusOK, []productModel.DigitalPurchaseResponse{})
+		orderRoutes.GET("/:id/messages", customerAuth, m.messageHandler.GetThread).
+			Summary("Get the customer-seller message thread of an order").
+			Description("Marks the other party's messages as read.").
+			Returns(http.StatusOK, model.OrderMessageThreadResponse{})
+		orderRoutes.POST("/:id/messages", customerAuth, m.messageHandler.PostMessage).
+			Summary("Post a message to an order's thread").
+			Description("Notifies the other party of the new message.").
+			Body(model.PostOrderMessageRequest{}).
+			Returns(http.StatusCreated, model.OrderMessageResponse{})
+		orderRoutes.POST(
+			"/:id/messages/attachments",
+			customerAuth,
+			m.messageHandler.InitAttachmentUpload,
+		).
+			Summary("Start uploading a message attachment").
+			Description("PUT the file to the returned URL, then post its fileId with a message.").
+			Body(model.OrderMessageAttachmentRequest{}).
+			Returns(http.StatusCreated, filegateway.UploadTicket{})
+		orderRoutes.GET("/:id/timeline", customerAuth, m.messageHandler.GetTimeline).
+			Summary("Get status changes and messages of an order in time order").
+			Returns(http.StatusOK, model.OrderTimelineResponse{})
 	}
 }
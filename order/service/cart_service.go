@@ -34,9 +34,11 @@ type CartService interface {
 		userID, sellerID uint,
 		req model.AddCartItemRequest,
 	) (*model.CartResponse, error)
+	// GetUserCart prices the cart for salesChannel (blank = base prices)
 	GetUserCart(
 		ctx context.Context,
 		userID, sellerID uint,
+		salesChannel string,
 	) (*model.CartResponse, error)
 	DeleteCart(
 		ctx context.Context,
@@ -112,6 +114,7 @@ func (s *CartServiceImpl) AddToCart(
 			sellerID,
 			variantsNeedingValidation,
 			finalQuantityByVariant,
+			req.SalesChannel,
 		); err != nil {
 			return nil, err
 		}
@@ -144,6 +147,7 @@ func (s *CartServiceImpl) AddToCart(
 			cart,
 			items,
 			currencyMap,
+			req.SalesChannel,
 		)
 	})
 }
@@ -151,6 +155,7 @@ func (s *CartServiceImpl) AddToCart(
 func (s *CartServiceImpl) GetUserCart(
 	ctx context.Context,
 	userID, sellerID uint,
+	salesChannel string,
 ) (*model.CartResponse, error) {
 	return db.WithTransactionResult(ctx, func(txCtx context.Context) (*model.CartResponse, error) {
 		currencyMap, err := s.userSvc.GetPreferredCurrency(txCtx, userID, sellerID)
@@ -175,7 +180,15 @@ func (s *CartServiceImpl) GetUserCart(
 			return s.buildEmptyCartResponse(userID, currencyMap), nil
 		}
 
-		return s.buildCartResponseWithItems(txCtx, sellerID, userID, cart, items, currencyMap)
+		return s.buildCartResponseWithItems(
+			txCtx,
+			sellerID,
+			userID,
+			cart,
+			items,
+			currencyMap,
+			salesChannel,
+		)
 	})
 }
 
@@ -405,9 +418,10 @@ func (s *CartServiceImpl) buildCartResponseWithItems(
 	cart *entity.Cart,
 	items []entity.CartItem,
 	currencyMap *userModel.CurrencyResponse,
+	salesChannel string,
 ) (*model.CartResponse, error) {
-	// Fetch variant details once for all cart items
-	variantMap, err := s.fetchVariantMap(ctx, items, sellerID)
+	// Fetch variant details once for all cart items, priced for the sales channel
+	variantMap, err := s.fetchVariantMap(ctx, items, sellerID, salesChannel)
 	if err != nil {
 		return nil, err
 	}
@@ -460,41 +474,42 @@ func (s *CartServiceImpl) getExistingOrCreateCart(
 	return cart, nil
 }
 
+// validateInventoryForFinalQuantities checks final cart quantities against what salesChannel
+// may sell; a blank channel checks against the whole stock pool.
 func (s *CartServiceImpl) validateInventoryForFinalQuantities(
 	ctx context.Context,
 	sellerID uint,
 	variantsNeedingValidation map[uint]struct{},
 	finalQuantityByVariant map[uint]int,
+	salesChannel string,
 ) error {
 	if len(variantsNeedingValidation) == 0 {
 		return nil
 	}
 
-	variantIDs := make([]uint, 0, len(variantsNeedingValidation))
-	for variantID := range variantsNeedingValidation {
-		variantIDs = append(variantIDs, variantID)
+	invReq := inventoryModel.StockCheckRequest{
+		Items:   make([]inventoryModel.StockCheckItem, 0, len(variantsNeedingValidation)),
+		Channel: salesChannel,
 	}
-
-	invReq := inventoryModel.TotalAvailableQuantityRequest{
-		VariantIDs: variantIDs,
+	for variantID := range variantsNeedingValidation {
+		invReq.Items = append(invReq.Items, inventoryModel.StockCheckItem{
+			VariantID: variantID,
+			Quantity:  finalQuantityByVariant[variantID],
+		})
 	}
-	invRes, err := s.inventorySvc.GetTotalAvailableQuantities(ctx, invReq, sellerID)
+	invRes, err := s.inventorySvc.CheckStock(ctx, invReq, sellerID)
 	if err != nil {
 		return err
 	}
 
-	availableByVariant := make(map[uint]int, len(invRes.Items))
 	for _, item := range invRes.Items {
-		availableByVariant[item.VariantID] = item.TotalAvailable
-	}
-
-	for variantID := range variantsNeedingValidation {
-		available, exists := availableByVariant[variantID]
-		if !exists {
+		// Variants without inventory at any active location are not sold by the seller;
+		// digital products hold no inventory
+		if len(item.Locations) == 0 && !item.StockUntracked {
 			return orderError.ErrVariantNotFound
 		}
-		if finalQuantityByVariant[variantID] > available {
-			return orderError.ErrInsufficientStock(available)
+		if !item.InStock {
+			return orderError.ErrInsufficientStock(item.ReservableQuantity)
 		}
 	}
 	return nil
@@ -578,6 +593,7 @@ func (s *CartServiceImpl) fetchVariantMap(
 	ctx context.Context,
 	items []entity.CartItem,
 	sellerID uint,
+	salesChannel string,
 ) (map[uint]productModel.VariantDetailResponse, error) {
 	variantMap := make(map[uint]productModel.VariantDetailResponse)
 	if len(items) == 0 {
@@ -592,6 +608,7 @@ func (s *CartServiceImpl) fetchVariantMap(
 	listReq := &productModel.ListVariantsRequest{
 		IDs:      strings.Join(ids, ","),
 		PageSize: len(items),
+		Channel:  salesChannel,
 	}
 
 	listResp, err := s.variantQuerySvc.ListVariants(ctx, listReq, &sellerID, nil, nil)
@@ -0,0 +1,355 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"ecommerce-be/common/config"
+	"ecommerce-be/common/constants"
+	"ecommerce-be/common/db"
+	"ecommerce-be/common/helper"
+	"ecommerce-be/common/log"
+	"ecommerce-be/common/notifier"
+	"ecommerce-be/order/entity"
+	orderError "ecommerce-be/order/error"
+	"ecommerce-be/order/model"
+	"ecommerce-be/order/repository"
+	orderUtils "ecommerce-be/order/utils"
+	orderConstants "ecommerce-be/order/utils/constant"
+	userModel "ecommerce-be/user/model"
+	userRepository "ecommerce-be/user/repository"
+	userService "ecommerce-be/user/service"
+)
+
+// GuestCheckoutService places orders for shoppers without an account and lets them
+// attach those orders to an account they register later.
+//
+// A guest order belongs to a guest user: a customer row flagged is_guest that has no
+// usable password and is never returned by login lookups. Guests follow their order
+// with a signed access token; a registered customer with the same email can claim the
+// orders once they confirm the email through an emailed link.
+type GuestCheckoutService interface {
+	// Checkout places the order and returns it with its access token
+	Checkout(
+		ctx context.Context,
+		sellerID uint,
+		req model.GuestCheckoutRequest,
+	) (*model.GuestCheckoutResponse, error)
+	// GetOrderByAccessToken returns the order a guest access token was issued for
+	GetOrderByAccessToken(ctx context.Context, token string) (*model.OrderResponse, error)
+
+	// RequestClaim emails the customer a link that moves the guest orders placed with
+	// their email to their account
+	RequestClaim(ctx context.Context, userID uint) (*model.GuestOrderClaimResponse, error)
+	// ConfirmClaim moves the guest orders once the customer opened the emailed link
+	ConfirmClaim(
+		ctx context.Context,
+		userID uint,
+		req model.ConfirmGuestOrderClaimRequest,
+	) (*model.GuestOrderClaimConfirmResponse, error)
+}
+
+type GuestCheckoutServiceImpl struct {
+	orderSvc   OrderService
+	cartSvc    CartService
+	addressSvc userService.AddressService
+	userSvc    userService.UserService
+	userRepo   userRepository.UserRepository
+	orderRepo  repository.OrderRepository
+	claimRepo  repository.GuestOrderClaimRepository
+	signer     *orderUtils.OrderAccessSigner
+	notifier   notifier.Notifier
+	claimTTL   time.Duration
+}
+
+func NewGuestCheckoutService(
+	orderSvc OrderService,
+	cartSvc CartService,
+	addressSvc userService.AddressService,
+	userSvc userService.UserService,
+	userRepo userRepository.UserRepository,
+	orderRepo repository.OrderRepository,
+	claimRepo repository.GuestOrderClaimRepository,
+	signer *orderUtils.OrderAccessSigner,
+	notifier notifier.Notifier,
+	claimTTL time.Duration,
+) GuestCheckoutService {
+	return &GuestCheckoutServiceImpl{
+		orderSvc:   orderSvc,
+		cartSvc:    cartSvc,
+		addressSvc: addressSvc,
+		userSvc:    userSvc,
+		userRepo:   userRepo,
+		orderRepo:  orderRepo,
+		claimRepo:  claimRepo,
+		signer:     signer,
+		notifier:   notifier,
+		claimTTL:   claimTTL,
+	}
+}
+
+// Checkout creates a guest user for the order, stores its addresses and places the
+// order through the same cart and order path as a signed-in customer. Each checkout
+// gets its own guest user, so a guest never sees another checkout's cart or addresses.
+// The steps are not one transaction: placing the order releases the cart lock outside
+// of it, and a failed checkout only leaves an unused guest user behind.
+func (s *GuestCheckoutServiceImpl) Checkout(
+	ctx context.Context,
+	sellerID uint,
+	req model.GuestCheckoutRequest,
+) (*model.GuestCheckoutResponse, error) {
+	email := strings.ToLower(strings.TrimSpace(req.Email))
+
+	// Guests cannot sign in, so the password only fills the required column
+	password, err := helper.GenerateRandomCredential()
+	if err != nil {
+		return nil, err
+	}
+	guest, _, err := s.userSvc.CreateUserWithRole(ctx, userModel.CreateUserRequest{
+		FirstName: strings.TrimSpace(req.FirstName),
+		LastName:  strings.TrimSpace(req.LastName),
+		Email:     email,
+		Password:  password,
+		Phone:     req.Phone,
+		SellerID:  sellerID,
+		IsGuest:   true,
+	}, constants.CUSTOMER_ROLE_NAME)
+	if err != nil {
+		return nil, err
+	}
+
+	shipping, err := s.addressSvc.AddAddress(ctx, guest.ID, req.ShippingAddress)
+	if err != nil {
+		return nil, err
+	}
+	billingID := shipping.ID
+	if req.BillingAddress != nil {
+		billing, err := s.addressSvc.AddAddress(ctx, guest.ID, *req.BillingAddress)
+		if err != nil {
+			return nil, err
+		}
+		billingID = billing.ID
+	}
+
+	if _, err := s.cartSvc.AddToCart(ctx, guest.ID, sellerID, model.AddCartItemRequest{
+		Items:        req.Items,
+		SalesChannel: req.SalesChannel,
+	}); err != nil {
+		return nil, err
+	}
+
+	order, err := s.orderSvc.CreateOrder(ctx, guest.ID, sellerID, model.CreateOrderRequest{
+		ShippingAddressID: shipping.ID,
+		BillingAddressID:  billingID,
+		FulfillmentType:   req.FulfillmentType,
+		Metadata:          req.Metadata,
+		SalesChannel:      req.SalesChannel,
+		DeliverySlot:      req.DeliverySlot,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	accessToken, err := s.signer.Sign(order.ID, email)
+	if err != nil {
+		return nil, err
+	}
+	return &model.GuestCheckoutResponse{
+		Order:       order,
+		AccessToken: accessToken,
+	}, nil
+}
+
+// GetOrderByAccessToken verifies the token and that the order still belongs to the
+// email it was issued for. A claimed order keeps working with its token, since the
+// claiming account has the same email.
+func (s *GuestCheckoutServiceImpl) GetOrderByAccessToken(
+	ctx context.Context,
+	token string,
+) (*model.OrderResponse, error) {
+	orderID, email, ok := s.signer.Verify(strings.TrimSpace(token))
+	if !ok {
+		return nil, orderError.ErrInvalidOrderAccessToken
+	}
+
+	order, err := s.orderRepo.FindOrderByID(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+	if order == nil {
+		return nil, orderError.ErrInvalidOrderAccessToken
+	}
+	owner, err := s.userRepo.FindByID(ctx, order.UserID)
+	if err != nil || !strings.EqualFold(strings.TrimSpace(owner.Email), email) {
+		return nil, orderError.ErrInvalidOrderAccessToken
+	}
+
+	return s.orderSvc.GetOrderByID(ctx, order.UserID, constants.CUSTOMER_ROLE_NAME, orderID)
+}
+
+// RequestClaim stores a claim for the customer's guest orders and emails the link that
+// confirms it. Opening the link proves the customer controls the email the guest
+// orders were placed with.
+func (s *GuestCheckoutServiceImpl) RequestClaim(
+	ctx context.Context,
+	userID uint,
+) (*model.GuestOrderClaimResponse, error) {
+	user, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	email := strings.ToLower(strings.TrimSpace(user.Email))
+
+	count, err := s.countGuestOrders(ctx, user.SellerID, email)
+	if err != nil {
+		return nil, err
+	}
+	if count == 0 {
+		return nil, orderError.ErrNoGuestOrdersToClaim
+	}
+
+	token, tokenHash, err := generateGuestClaimToken()
+	if err != nil {
+		return nil, err
+	}
+	claim := &entity.GuestOrderClaim{
+		UserID:    userID,
+		Email:     email,
+		TokenHash: tokenHash,
+		ExpiresAt: time.Now().UTC().Add(s.claimTTL),
+	}
+	if err := s.claimRepo.Create(ctx, claim); err != nil {
+		return nil, err
+	}
+
+	s.sendClaimConfirmation(ctx, claim, count, token)
+
+	return &model.GuestOrderClaimResponse{
+		Email:           claim.Email,
+		ClaimableOrders: count,
+		ExpiresAt:       claim.ExpiresAt,
+	}, nil
+}
+
+// ConfirmClaim moves the guest orders placed with the claim's email to the customer.
+// Only the customer who requested the claim can confirm it, and only while the
+// account still has that email.
+func (s *GuestCheckoutServiceImpl) ConfirmClaim(
+	ctx context.Context,
+	userID uint,
+	req model.ConfirmGuestOrderClaimRequest,
+) (*model.GuestOrderClaimConfirmResponse, error) {
+	tokenHash := hashGuestClaimToken(strings.TrimSpace(req.Token))
+
+	return db.WithTransactionResult(
+		ctx,
+		func(txCtx context.Context) (*model.GuestOrderClaimConfirmResponse, error) {
+			claim, err := s.claimRepo.FindByTokenHashForUpdate(txCtx, tokenHash)
+			if err != nil {
+				return nil, err
+			}
+			now := time.Now().UTC()
+			if claim == nil || claim.UserID != userID || !claim.IsOpen(now) {
+				return nil, orderError.ErrInvalidGuestClaimToken
+			}
+
+			user, err := s.userRepo.FindByID(txCtx, userID)
+			if err != nil {
+				return nil, err
+			}
+			if !strings.EqualFold(strings.TrimSpace(user.Email), claim.Email) {
+				return nil, orderError.ErrInvalidGuestClaimToken
+			}
+
+			guestIDs, err := s.userRepo.FindGuestIDsByEmail(txCtx, user.SellerID, claim.Email)
+			if err != nil {
+				return nil, err
+			}
+			moved, err := s.orderRepo.ReassignOrders(txCtx, guestIDs, userID)
+			if err != nil {
+				return nil, err
+			}
+
+			claim.ClaimedAt = &now
+			claim.ClaimedOrders = int(moved)
+			if err := s.claimRepo.Save(txCtx, claim); err != nil {
+				return nil, err
+			}
+			return &model.GuestOrderClaimConfirmResponse{ClaimedOrders: claim.ClaimedOrders}, nil
+		},
+	)
+}
+
+func (s *GuestCheckoutServiceImpl) countGuestOrders(
+	ctx context.Context,
+	sellerID uint,
+	email string,
+) (int64, error) {
+	guestIDs, err := s.userRepo.FindGuestIDsByEmail(ctx, sellerID, email)
+	if err != nil {
+		return 0, err
+	}
+	return s.orderRepo.CountOrdersByUserIDs(ctx, guestIDs)
+}
+
+// sendClaimConfirmation emails the claim link. Failures are logged; the customer can
+// request the claim again.
+func (s *GuestCheckoutServiceImpl) sendClaimConfirmation(
+	ctx context.Context,
+	claim *entity.GuestOrderClaim,
+	orderCount int64,
+	token string,
+) {
+	if s.notifier == nil {
+		return
+	}
+	err := s.notifier.Notify(ctx, constants.NOTIFY_EVENT_GUEST_ORDER_CLAIM, claim.UserID,
+		map[string]any{
+			constants.NOTIFY_EMAIL_TO_DATA_KEY: claim.Email,
+			"Email":                            claim.Email,
+			"OrderCount":                       orderCount,
+			"ConfirmURL":                       guestClaimConfirmURL(token),
+			"ExpiresAt":                        claim.ExpiresAt.UTC().Format(time.RFC1123),
+		})
+	if err != nil {
+		log.ErrorWithContext(ctx, fmt.Sprintf(
+			"guest order claim: confirmation for claim %d failed", claim.ID,
+		), err)
+	}
+}
+
+// generateGuestClaimToken creates a raw claim token and its storage hash
+func generateGuestClaimToken() (token, hash string, err error) {
+	buf := make([]byte, orderConstants.GUEST_ORDER_CLAIM_TOKEN_RANDOM_BYTES)
+	if _, err = rand.Read(buf); err != nil {
+		return "", "", err
+	}
+	token = orderConstants.GUEST_ORDER_CLAIM_TOKEN_PREFIX +
+		base64.RawURLEncoding.EncodeToString(buf)
+	return token, hashGuestClaimToken(token), nil
+}
+
+func hashGuestClaimToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func guestClaimConfirmURL(token string) string {
+	base := ""
+	if cfg := config.Get(); cfg != nil {
+		base = cfg.Order.GuestClaimConfirmURL
+	}
+	separator := "?"
+	if strings.Contains(base, "?") {
+		separator = "&"
+	}
+	return base + separator + orderConstants.GUEST_ORDER_CLAIM_TOKEN_QUERY_PARAM + "=" +
+		url.QueryEscape(token)
+}
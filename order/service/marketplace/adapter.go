@@ -0,0 +1,137 @@
+// Package marketplace defines the adapter contract used to ingest orders from external
+// marketplaces and push shipment confirmations back to them.
+package marketplace
+
+import (
+	"context"
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"ecommerce-be/order/entity"
+)
+
+// ErrMalformedPayload is returned when a webhook body cannot be parsed as marketplace orders
+var ErrMalformedPayload = errors.New("marketplace: malformed order payload")
+
+// ExternalOrder is a marketplace order normalized for ingestion. Amounts are in cents.
+type ExternalOrder struct {
+	ExternalOrderID string
+	// Cancelled is set when the marketplace reports the order as cancelled by buyer or platform
+	Cancelled       bool
+	Buyer           ExternalBuyer
+	ShippingAddress ExternalAddress
+	Currency        string
+	SubtotalCents   int64
+	ShippingCents   int64
+	TaxCents        int64
+	DiscountCents   int64
+	TotalCents      int64
+	PlacedAt        time.Time
+	Items           []ExternalOrderItem
+}
+
+// ExternalBuyer identifies the marketplace customer. Email may be a marketplace relay address.
+type ExternalBuyer struct {
+	ExternalID string
+	Email      string
+	Name       string
+	Phone      string
+}
+
+// ExternalAddress is the marketplace ship-to address
+type ExternalAddress struct {
+	Name        string
+	Line1       string
+	Line2       string
+	City        string
+	State       string
+	PostalCode  string
+	CountryCode string
+	Phone       string
+}
+
+// ExternalOrderItem is one marketplace order line matched to our catalogue by SKU
+type ExternalOrderItem struct {
+	ExternalLineID string
+	SKU            string
+	Title          string
+	Quantity       int
+	UnitPriceCents int64
+	LineTotalCents int64
+}
+
+// ShipmentConfirmation is pushed to the marketplace when the seller ships an ingested order
+type ShipmentConfirmation struct {
+	ExternalOrderID string
+	Carrier         string
+	TrackingNo      string
+	ShippedAt       time.Time
+	Items           []ShipmentConfirmationItem
+}
+
+// ShipmentConfirmationItem references the marketplace order line being shipped
+type ShipmentConfirmationItem struct {
+	ExternalLineID string
+	Quantity       int
+}
+
+// Credentials are the decrypted API credentials of a seller's marketplace connection
+type Credentials struct {
+	ExternalSellerID string
+	AccessToken      string
+	// BaseURL overrides the adapter's API endpoint (regional endpoints, sandboxes)
+	BaseURL string
+}
+
+// Adapter integrates one marketplace.
+type Adapter interface {
+	// Code is the marketplace identifier stored on marketplace_connection.marketplace
+	Code() entity.Marketplace
+	// ParseOrders extracts every order carried by a webhook body
+	ParseOrders(body []byte) ([]ExternalOrder, error)
+	// ConfirmShipment reports carrier and tracking details for shipped order lines
+	ConfirmShipment(ctx context.Context, creds Credentials, confirmation ShipmentConfirmation) error
+}
+
+// Registry holds the marketplace adapters available to the ingestion service.
+type Registry struct {
+	mu       sync.RWMutex
+	adapters map[entity.Marketplace]Adapter
+}
+
+// NewRegistry creates an empty adapter registry.
+func NewRegistry() *Registry {
+	return &Registry{adapters: map[entity.Marketplace]Adapter{}}
+}
+
+// Register adds or replaces the adapter for its marketplace.
+func (r *Registry) Register(a Adapter) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.adapters[a.Code()] = a
+}
+
+// Get returns the adapter registered for marketplace.
+func (r *Registry) Get(marketplace entity.Marketplace) (Adapter, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	a, ok := r.adapters[entity.NormalizeMarketplace(marketplace.String())]
+	return a, ok
+}
+
+// parseAmountCents converts a decimal amount such as "19.99" into cents
+func parseAmountCents(raw string) (int64, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return 0, nil
+	}
+	value, err := strconv.ParseFloat(raw, 64)
+	if err != nil {
+		return 0, ErrMalformedPayload
+	}
+	return int64(math.Round(value * 100)), nil
+}
@@ -0,0 +1,221 @@
+package marketplace
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"ecommerce-be/order/entity"
+)
+
+const amazonAPIBaseURL = "https://sellingpartnerapi-na.amazon.com"
+
+// AmazonAdapter ingests Selling Partner API order notifications and confirms shipments through
+// the Orders API shipmentConfirmation operation.
+type AmazonAdapter struct {
+	baseURL    string
+	httpClient *http.Client
+}
+
+// NewAmazonAdapter creates an Amazon adapter using the North America SP-API endpoint.
+func NewAmazonAdapter() *AmazonAdapter {
+	return &AmazonAdapter{
+		baseURL:    amazonAPIBaseURL,
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+func (a *AmazonAdapter) Code() entity.Marketplace {
+	return entity.MARKETPLACE_AMAZON
+}
+
+type amazonMoney struct {
+	CurrencyCode string `json:"CurrencyCode"`
+	Amount       string `json:"Amount"`
+}
+
+type amazonOrderItem struct {
+	OrderItemID     string       `json:"OrderItemId"`
+	SellerSKU       string       `json:"SellerSKU"`
+	Title           string       `json:"Title"`
+	QuantityOrdered int          `json:"QuantityOrdered"`
+	ItemPrice       *amazonMoney `json:"ItemPrice"`
+	ItemTax         *amazonMoney `json:"ItemTax"`
+	ShippingPrice   *amazonMoney `json:"ShippingPrice"`
+	PromotionDisc   *amazonMoney `json:"PromotionDiscount"`
+}
+
+type amazonOrder struct {
+	AmazonOrderID string       `json:"AmazonOrderId"`
+	OrderStatus   string       `json:"OrderStatus"`
+	PurchaseDate  string       `json:"PurchaseDate"`
+	OrderTotal    *amazonMoney `json:"OrderTotal"`
+	BuyerInfo     struct {
+		BuyerEmail string `json:"BuyerEmail"`
+		BuyerName  string `json:"BuyerName"`
+	} `json:"BuyerInfo"`
+	ShippingAddress struct {
+		Name          string `json:"Name"`
+		AddressLine1  string `json:"AddressLine1"`
+		AddressLine2  string `json:"AddressLine2"`
+		City          string `json:"City"`
+		StateOrRegion string `json:"StateOrRegion"`
+		PostalCode    string `json:"PostalCode"`
+		CountryCode   string `json:"CountryCode"`
+		Phone         string `json:"Phone"`
+	} `json:"ShippingAddress"`
+	OrderItems []amazonOrderItem `json:"OrderItems"`
+}
+
+// amazonNotification accepts a single order or a batch under "Orders"
+type amazonNotification struct {
+	Payload struct {
+		Orders []amazonOrder `json:"Orders"`
+	} `json:"payload"`
+	Orders []amazonOrder `json:"Orders"`
+}
+
+// ParseOrders reads the SP-API order payload, where money values are decimal strings and
+// ItemPrice is the line total for the ordered quantity
+func (a *AmazonAdapter) ParseOrders(body []byte) ([]ExternalOrder, error) {
+	var notification amazonNotification
+	if err := json.Unmarshal(body, &notification); err != nil {
+		return nil, ErrMalformedPayload
+	}
+	orders := append(notification.Payload.Orders, notification.Orders...)
+	if len(orders) == 0 {
+		var single amazonOrder
+		if err := json.Unmarshal(body, &single); err != nil || single.AmazonOrderID == "" {
+			return nil, ErrMalformedPayload
+		}
+		orders = []amazonOrder{single}
+	}
+
+	result := make([]ExternalOrder, 0, len(orders))
+	for _, order := range orders {
+		parsed, err := a.toExternalOrder(order)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, parsed)
+	}
+	return result, nil
+}
+
+func (a *AmazonAdapter) toExternalOrder(order amazonOrder) (ExternalOrder, error) {
+	if strings.TrimSpace(order.AmazonOrderID) == "" {
+		return ExternalOrder{}, ErrMalformedPayload
+	}
+	result := ExternalOrder{
+		ExternalOrderID: order.AmazonOrderID,
+		Cancelled:       strings.EqualFold(order.OrderStatus, "Canceled"),
+		Buyer: ExternalBuyer{
+			Email: order.BuyerInfo.BuyerEmail,
+			Name:  order.BuyerInfo.BuyerName,
+			Phone: order.ShippingAddress.Phone,
+		},
+		ShippingAddress: ExternalAddress{
+			Name:        order.ShippingAddress.Name,
+			Line1:       order.ShippingAddress.AddressLine1,
+			Line2:       order.ShippingAddress.AddressLine2,
+			City:        order.ShippingAddress.City,
+			State:       order.ShippingAddress.StateOrRegion,
+			PostalCode:  order.ShippingAddress.PostalCode,
+			CountryCode: order.ShippingAddress.CountryCode,
+			Phone:       order.ShippingAddress.Phone,
+		},
+		PlacedAt: parseTimestamp(order.PurchaseDate),
+	}
+	if order.OrderTotal != nil {
+		result.Currency = order.OrderTotal.CurrencyCode
+	}
+
+	for _, item := range order.OrderItems {
+		if item.QuantityOrdered <= 0 {
+			continue
+		}
+		lineTotal, err := amazonAmount(item.ItemPrice)
+		if err != nil {
+			return ExternalOrder{}, err
+		}
+		tax, err := amazonAmount(item.ItemTax)
+		if err != nil {
+			return ExternalOrder{}, err
+		}
+		shipping, err := amazonAmount(item.ShippingPrice)
+		if err != nil {
+			return ExternalOrder{}, err
+		}
+		discount, err := amazonAmount(item.PromotionDisc)
+		if err != nil {
+			return ExternalOrder{}, err
+		}
+
+		result.Items = append(result.Items, ExternalOrderItem{
+			ExternalLineID: item.OrderItemID,
+			SKU:            item.SellerSKU,
+			Title:          item.Title,
+			Quantity:       item.QuantityOrdered,
+			UnitPriceCents: lineTotal / int64(item.QuantityOrdered),
+			LineTotalCents: lineTotal,
+		})
+		result.SubtotalCents += lineTotal
+		result.TaxCents += tax
+		result.ShippingCents += shipping
+		result.DiscountCents += discount
+	}
+
+	total, err := amazonAmount(order.OrderTotal)
+	if err != nil {
+		return ExternalOrder{}, err
+	}
+	if total == 0 {
+		total = result.SubtotalCents + result.ShippingCents + result.TaxCents - result.DiscountCents
+	}
+	result.TotalCents = total
+	return result, nil
+}
+
+// ConfirmShipment posts to /orders/v0/orders/{orderId}/shipmentConfirmation
+func (a *AmazonAdapter) ConfirmShipment(
+	ctx context.Context,
+	creds Credentials,
+	confirmation ShipmentConfirmation,
+) error {
+	items := make([]map[string]any, 0, len(confirmation.Items))
+	for _, item := range confirmation.Items {
+		items = append(items, map[string]any{
+			"orderItemId": item.ExternalLineID,
+			"quantity":    item.Quantity,
+		})
+	}
+	payload := map[string]any{
+		"packageDetail": map[string]any{
+			"packageReferenceId": confirmation.TrackingNo,
+			"carrierCode":        confirmation.Carrier,
+			"trackingNumber":     confirmation.TrackingNo,
+			"shipDate":           confirmation.ShippedAt.UTC().Format(time.RFC3339),
+			"orderItems":         items,
+		},
+	}
+
+	endpoint := fmt.Sprintf(
+		"%s/orders/v0/orders/%s/shipmentConfirmation",
+		baseURLOr(creds.BaseURL, a.baseURL),
+		url.PathEscape(confirmation.ExternalOrderID),
+	)
+	return postJSON(ctx, a.httpClient, endpoint, payload, func(req *http.Request) {
+		req.Header.Set("x-amz-access-token", creds.AccessToken)
+	}, "amazon")
+}
+
+func amazonAmount(money *amazonMoney) (int64, error) {
+	if money == nil {
+		return 0, nil
+	}
+	return parseAmountCents(money.Amount)
+}
@@ -0,0 +1,63 @@
+package marketplace
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// parseTimestamp reads an RFC 3339 timestamp, falling back to now for missing values
+func parseTimestamp(raw string) time.Time {
+	if parsed, err := time.Parse(time.RFC3339, strings.TrimSpace(raw)); err == nil {
+		return parsed.UTC()
+	}
+	return time.Now().UTC()
+}
+
+func baseURLOr(override, fallback string) string {
+	if override = strings.TrimRight(strings.TrimSpace(override), "/"); override != "" {
+		return override
+	}
+	return fallback
+}
+
+// postJSON sends payload and turns non-2xx responses into errors carrying the API message
+func postJSON(
+	ctx context.Context,
+	client *http.Client,
+	endpoint string,
+	payload any,
+	authorize func(*http.Request),
+	name string,
+) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	authorize(req)
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("%s request failed: %w", name, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		raw, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		if message := strings.TrimSpace(string(raw)); message != "" {
+			return fmt.Errorf("%s returned status %d: %s", name, resp.StatusCode, message)
+		}
+		return fmt.Errorf("%s returned status %d", name, resp.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,247 @@
+package marketplace
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"ecommerce-be/order/entity"
+)
+
+const ebayAPIBaseURL = "https://api.ebay.com"
+
+// EbayAdapter ingests Fulfillment API orders and confirms shipments by creating a shipping
+// fulfillment for the shipped line items.
+type EbayAdapter struct {
+	baseURL    string
+	httpClient *http.Client
+}
+
+// NewEbayAdapter creates an eBay adapter using the production API endpoint.
+func NewEbayAdapter() *EbayAdapter {
+	return &EbayAdapter{
+		baseURL:    ebayAPIBaseURL,
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+func (a *EbayAdapter) Code() entity.Marketplace {
+	return entity.MARKETPLACE_EBAY
+}
+
+type ebayAmount struct {
+	Value    string `json:"value"`
+	Currency string `json:"currency"`
+}
+
+type ebayPhone struct {
+	PhoneNumber string `json:"phoneNumber"`
+}
+
+type ebayLineItem struct {
+	LineItemID   string      `json:"lineItemId"`
+	SKU          string      `json:"sku"`
+	Title        string      `json:"title"`
+	Quantity     int         `json:"quantity"`
+	LineItemCost *ebayAmount `json:"lineItemCost"`
+}
+
+type ebayOrder struct {
+	OrderID      string `json:"orderId"`
+	CreationDate string `json:"creationDate"`
+	CancelStatus struct {
+		CancelState string `json:"cancelState"`
+	} `json:"cancelStatus"`
+	Buyer struct {
+		Username                 string `json:"username"`
+		BuyerRegistrationAddress struct {
+			Email        string    `json:"email"`
+			FullName     string    `json:"fullName"`
+			PrimaryPhone ebayPhone `json:"primaryPhone"`
+		} `json:"buyerRegistrationAddress"`
+	} `json:"buyer"`
+	PricingSummary struct {
+		PriceSubtotal *ebayAmount `json:"priceSubtotal"`
+		DeliveryCost  *ebayAmount `json:"deliveryCost"`
+		Tax           *ebayAmount `json:"tax"`
+		PriceDiscount *ebayAmount `json:"priceDiscount"`
+		Total         *ebayAmount `json:"total"`
+	} `json:"pricingSummary"`
+	FulfillmentStartInstructions []struct {
+		ShippingStep struct {
+			ShipTo struct {
+				FullName       string `json:"fullName"`
+				ContactAddress struct {
+					AddressLine1    string `json:"addressLine1"`
+					AddressLine2    string `json:"addressLine2"`
+					City            string `json:"city"`
+					StateOrProvince string `json:"stateOrProvince"`
+					PostalCode      string `json:"postalCode"`
+					CountryCode     string `json:"countryCode"`
+				} `json:"contactAddress"`
+				PrimaryPhone ebayPhone `json:"primaryPhone"`
+				Email        string    `json:"email"`
+			} `json:"shipTo"`
+		} `json:"shippingStep"`
+	} `json:"fulfillmentStartInstructions"`
+	LineItems []ebayLineItem `json:"lineItems"`
+}
+
+// ebayNotification accepts a single order or a batch under "orders"
+type ebayNotification struct {
+	Orders []ebayOrder `json:"orders"`
+}
+
+// ParseOrders reads the Fulfillment API order payload. Amounts are decimal strings and
+// lineItemCost is the line total for the ordered quantity.
+func (a *EbayAdapter) ParseOrders(body []byte) ([]ExternalOrder, error) {
+	var notification ebayNotification
+	if err := json.Unmarshal(body, &notification); err != nil {
+		return nil, ErrMalformedPayload
+	}
+	orders := notification.Orders
+	if len(orders) == 0 {
+		var single ebayOrder
+		if err := json.Unmarshal(body, &single); err != nil || single.OrderID == "" {
+			return nil, ErrMalformedPayload
+		}
+		orders = []ebayOrder{single}
+	}
+
+	result := make([]ExternalOrder, 0, len(orders))
+	for _, order := range orders {
+		parsed, err := a.toExternalOrder(order)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, parsed)
+	}
+	return result, nil
+}
+
+func (a *EbayAdapter) toExternalOrder(order ebayOrder) (ExternalOrder, error) {
+	if strings.TrimSpace(order.OrderID) == "" {
+		return ExternalOrder{}, ErrMalformedPayload
+	}
+	buyer := order.Buyer.BuyerRegistrationAddress
+	result := ExternalOrder{
+		ExternalOrderID: order.OrderID,
+		Cancelled:       strings.EqualFold(order.CancelStatus.CancelState, "CANCELED"),
+		Buyer: ExternalBuyer{
+			ExternalID: order.Buyer.Username,
+			Email:      buyer.Email,
+			Name:       buyer.FullName,
+			Phone:      buyer.PrimaryPhone.PhoneNumber,
+		},
+		PlacedAt: parseTimestamp(order.CreationDate),
+	}
+	if len(order.FulfillmentStartInstructions) > 0 {
+		shipTo := order.FulfillmentStartInstructions[0].ShippingStep.ShipTo
+		result.ShippingAddress = ExternalAddress{
+			Name:        shipTo.FullName,
+			Line1:       shipTo.ContactAddress.AddressLine1,
+			Line2:       shipTo.ContactAddress.AddressLine2,
+			City:        shipTo.ContactAddress.City,
+			State:       shipTo.ContactAddress.StateOrProvince,
+			PostalCode:  shipTo.ContactAddress.PostalCode,
+			CountryCode: shipTo.ContactAddress.CountryCode,
+			Phone:       shipTo.PrimaryPhone.PhoneNumber,
+		}
+		if result.Buyer.Email == "" {
+			result.Buyer.Email = shipTo.Email
+		}
+	}
+
+	for _, item := range order.LineItems {
+		if item.Quantity <= 0 {
+			continue
+		}
+		lineTotal, err := ebayAmountCents(item.LineItemCost)
+		if err != nil {
+			return ExternalOrder{}, err
+		}
+		result.Items = append(result.Items, ExternalOrderItem{
+			ExternalLineID: item.LineItemID,
+			SKU:            item.SKU,
+			Title:          item.Title,
+			Quantity:       item.Quantity,
+			UnitPriceCents: lineTotal / int64(item.Quantity),
+			LineTotalCents: lineTotal,
+		})
+		result.SubtotalCents += lineTotal
+	}
+
+	pricing := order.PricingSummary
+	var err error
+	if pricing.PriceSubtotal != nil {
+		result.Currency = pricing.PriceSubtotal.Currency
+		if result.SubtotalCents, err = ebayAmountCents(pricing.PriceSubtotal); err != nil {
+			return ExternalOrder{}, err
+		}
+	}
+	if result.ShippingCents, err = ebayAmountCents(pricing.DeliveryCost); err != nil {
+		return ExternalOrder{}, err
+	}
+	if result.TaxCents, err = ebayAmountCents(pricing.Tax); err != nil {
+		return ExternalOrder{}, err
+	}
+	// eBay reports discounts as negative amounts
+	discount, err := ebayAmountCents(pricing.PriceDiscount)
+	if err != nil {
+		return ExternalOrder{}, err
+	}
+	if discount < 0 {
+		discount = -discount
+	}
+	result.DiscountCents = discount
+
+	if result.TotalCents, err = ebayAmountCents(pricing.Total); err != nil {
+		return ExternalOrder{}, err
+	}
+	if result.TotalCents == 0 {
+		result.TotalCents = result.SubtotalCents + result.ShippingCents +
+			result.TaxCents - result.DiscountCents
+	}
+	return result, nil
+}
+
+// ConfirmShipment posts to /sell/fulfillment/v1/order/{orderId}/shipping_fulfillment
+func (a *EbayAdapter) ConfirmShipment(
+	ctx context.Context,
+	creds Credentials,
+	confirmation ShipmentConfirmation,
+) error {
+	items := make([]map[string]any, 0, len(confirmation.Items))
+	for _, item := range confirmation.Items {
+		items = append(items, map[string]any{
+			"lineItemId": item.ExternalLineID,
+			"quantity":   item.Quantity,
+		})
+	}
+	payload := map[string]any{
+		"lineItems":           items,
+		"shippedDate":         confirmation.ShippedAt.UTC().Format("2006-01-02T15:04:05.000Z"),
+		"shippingCarrierCode": confirmation.Carrier,
+		"trackingNumber":      confirmation.TrackingNo,
+	}
+
+	endpoint := fmt.Sprintf(
+		"%s/sell/fulfillment/v1/order/%s/shipping_fulfillment",
+		baseURLOr(creds.BaseURL, a.baseURL),
+		url.PathEscape(confirmation.ExternalOrderID),
+	)
+	return postJSON(ctx, a.httpClient, endpoint, payload, func(req *http.Request) {
+		req.Header.Set("Authorization", "Bearer "+creds.AccessToken)
+	}, "ebay")
+}
+
+func ebayAmountCents(amount *ebayAmount) (int64, error) {
+	if amount == nil {
+		return 0, nil
+	}
+	return parseAmountCents(amount.Value)
+}
@@ -0,0 +1,764 @@
+package service
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"ecommerce-be/common/config"
+	"ecommerce-be/common/constants"
+	"ecommerce-be/common/db"
+	"ecommerce-be/common/helper"
+	"ecommerce-be/common/log"
+	inventoryEntity "ecommerce-be/inventory/entity"
+	inventoryModel "ecommerce-be/inventory/model"
+	inventoryService "ecommerce-be/inventory/service"
+	"ecommerce-be/order/entity"
+	orderError "ecommerce-be/order/error"
+	"ecommerce-be/order/factory"
+	"ecommerce-be/order/mapper"
+	"ecommerce-be/order/model"
+	"ecommerce-be/order/repository"
+	"ecommerce-be/order/service/marketplace"
+	orderUtils "ecommerce-be/order/utils"
+	orderConstants "ecommerce-be/order/utils/constant"
+	productEntity "ecommerce-be/product/entity"
+	productMapper "ecommerce-be/product/mapper"
+	productService "ecommerce-be/product/service"
+	userModel "ecommerce-be/user/model"
+	userRepository "ecommerce-be/user/repository"
+	userService "ecommerce-be/user/service"
+)
+
+const (
+	marketplaceResultCreated   = "created"
+	marketplaceResultDuplicate = "duplicate"
+	marketplaceResultCancelled = "cancelled"
+	marketplaceResultSkipped   = "skipped"
+
+	marketplaceCredentialAccessToken = "accessToken"
+	marketplaceCredentialBaseURL     = "apiBaseUrl"
+)
+
+var marketplaceEmailKeyPattern = regexp.MustCompile(`[^a-z0-9]+`)
+
+// MarketplaceOrderService ingests orders from external marketplaces and confirms their
+// shipments back on the marketplace.
+type MarketplaceOrderService interface {
+	CreateConnection(
+		ctx context.Context,
+		sellerID uint,
+		req model.CreateMarketplaceConnectionRequest,
+	) (*model.MarketplaceConnectionResponse, error)
+	ListConnections(
+		ctx context.Context,
+		sellerID uint,
+	) ([]model.MarketplaceConnectionResponse, error)
+	DeactivateConnection(ctx context.Context, sellerID, connectionID uint) error
+
+	// IngestWebhook verifies a signed webhook delivery and creates an order per new
+	// marketplace order. Redelivered orders are reported as duplicates.
+	IngestWebhook(
+		ctx context.Context,
+		marketplaceCode string,
+		connectionID uint,
+		body []byte,
+		signature string,
+	) (*model.MarketplaceWebhookResponse, error)
+
+	// SyncShipment pushes a shipment confirmation when the order came from a marketplace.
+	// Failed pushes are recorded and retried by RetryFailedShipmentSyncs.
+	SyncShipment(ctx context.Context, req model.MarketplaceShipmentRequest) error
+	RetryFailedShipmentSyncs()
+}
+
+type MarketplaceOrderServiceImpl struct {
+	marketplaceRepo     repository.MarketplaceRepository
+	orderRepo           repository.OrderRepository
+	orderHistoryRepo    repository.OrderHistoryRepository
+	orderSvc            OrderService
+	inventoryReserveSvc inventoryService.InventoryReservationService
+	variantQuerySvc     productService.VariantQueryService
+	userSvc             userService.UserService
+	userRepo            userRepository.UserRepository
+	countryRepo         userRepository.CountryRepository
+	adapters            *marketplace.Registry
+}
+
+func NewMarketplaceOrderService(
+	marketplaceRepo repository.MarketplaceRepository,
+	orderRepo repository.OrderRepository,
+	orderHistoryRepo repository.OrderHistoryRepository,
+	orderSvc OrderService,
+	inventoryReserveSvc inventoryService.InventoryReservationService,
+	variantQuerySvc productService.VariantQueryService,
+	userSvc userService.UserService,
+	userRepo userRepository.UserRepository,
+	countryRepo userRepository.CountryRepository,
+	adapters *marketplace.Registry,
+) MarketplaceOrderService {
+	return &MarketplaceOrderServiceImpl{
+		marketplaceRepo:     marketplaceRepo,
+		orderRepo:           orderRepo,
+		orderHistoryRepo:    orderHistoryRepo,
+		orderSvc:            orderSvc,
+		inventoryReserveSvc: inventoryReserveSvc,
+		variantQuerySvc:     variantQuerySvc,
+		userSvc:             userSvc,
+		userRepo:            userRepo,
+		countryRepo:         countryRepo,
+		adapters:            adapters,
+	}
+}
+
+// ============================================================================
+// Connections
+// ============================================================================
+
+// CreateConnection stores the seller's marketplace account with an encrypted access token and
+// generates the webhook secret, which is returned only in this response.
+func (s *MarketplaceOrderServiceImpl) CreateConnection(
+	ctx context.Context,
+	sellerID uint,
+	req model.CreateMarketplaceConnectionRequest,
+) (*model.MarketplaceConnectionResponse, error) {
+	code := entity.NormalizeMarketplace(req.Marketplace.String())
+	if _, ok := s.adapters.Get(code); !ok {
+		return nil, orderError.ErrMarketplaceUnsupported
+	}
+	externalSellerID := strings.TrimSpace(req.ExternalSellerID)
+
+	existing, err := s.marketplaceRepo.FindConnectionByAccount(ctx, code, externalSellerID)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, orderError.ErrMarketplaceConnectionExists
+	}
+
+	secret, err := helper.GenerateRandomCredential()
+	if err != nil {
+		return nil, err
+	}
+	key := encryptionKey()
+	encryptedSecret, err := helper.Encrypt(secret, key)
+	if err != nil {
+		return nil, err
+	}
+	encryptedToken, err := helper.Encrypt(strings.TrimSpace(req.AccessToken), key)
+	if err != nil {
+		return nil, err
+	}
+
+	conn := &entity.MarketplaceConnection{
+		SellerID:         sellerID,
+		Marketplace:      code,
+		ExternalSellerID: externalSellerID,
+		WebhookSecret:    encryptedSecret,
+		Credentials: db.JSONMap{
+			marketplaceCredentialAccessToken: encryptedToken,
+			marketplaceCredentialBaseURL:     strings.TrimSpace(req.APIBaseURL),
+		},
+		IsActive: true,
+	}
+	if err := s.marketplaceRepo.CreateConnection(ctx, conn); err != nil {
+		return nil, err
+	}
+
+	resp := factory.BuildMarketplaceConnectionResponse(conn, secret)
+	return &resp, nil
+}
+
+// ListConnections returns the seller's marketplace connections without secrets
+func (s *MarketplaceOrderServiceImpl) ListConnections(
+	ctx context.Context,
+	sellerID uint,
+) ([]model.MarketplaceConnectionResponse, error) {
+	conns, err := s.marketplaceRepo.FindConnectionsBySellerID(ctx, sellerID)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]model.MarketplaceConnectionResponse, 0, len(conns))
+	for i := range conns {
+		result = append(result, factory.BuildMarketplaceConnectionResponse(&conns[i], ""))
+	}
+	return result, nil
+}
+
+// DeactivateConnection stops accepting webhooks for a connection. Ingested orders are kept.
+func (s *MarketplaceOrderServiceImpl) DeactivateConnection(
+	ctx context.Context,
+	sellerID, connectionID uint,
+) error {
+	conn, err := s.marketplaceRepo.FindConnectionByID(ctx, connectionID)
+	if err != nil {
+		return err
+	}
+	if conn == nil || conn.SellerID != sellerID {
+		return orderError.ErrMarketplaceConnectionNotFound
+	}
+	return s.marketplaceRepo.UpdateConnectionActive(ctx, conn.ID, false)
+}
+
+// ============================================================================
+// Order Ingestion
+// ============================================================================
+
+// IngestWebhook processes every order in a webhook delivery.
+//
+// Steps:
+//  1. Resolve the adapter and the active connection addressed by the webhook URL.
+//  2. Verify the HMAC signature with the connection's webhook secret.
+//  3. Parse the marketplace payload into normalized orders.
+//  4. Create new orders, cancel known orders the marketplace reports as cancelled,
+//     and report redeliveries as duplicates.
+func (s *MarketplaceOrderServiceImpl) IngestWebhook(
+	ctx context.Context,
+	marketplaceCode string,
+	connectionID uint,
+	body []byte,
+	signature string,
+) (*model.MarketplaceWebhookResponse, error) {
+	code := entity.NormalizeMarketplace(marketplaceCode)
+	adapter, ok := s.adapters.Get(code)
+	if !ok {
+		return nil, orderError.ErrMarketplaceUnsupported
+	}
+
+	conn, err := s.marketplaceRepo.FindConnectionByID(ctx, connectionID)
+	if err != nil {
+		return nil, err
+	}
+	if conn == nil || conn.Marketplace != code {
+		return nil, orderError.ErrMarketplaceConnectionNotFound
+	}
+	if !conn.IsActive {
+		return nil, orderError.ErrMarketplaceConnectionInactive
+	}
+	if err := verifyMarketplaceSignature(conn, body, signature); err != nil {
+		return nil, err
+	}
+
+	orders, err := adapter.ParseOrders(body)
+	if err != nil {
+		log.WarnWithContext(ctx, fmt.Sprintf(
+			"ingestWebhook: unparseable %s payload for connection %d: %v",
+			code, conn.ID, err,
+		))
+		return nil, orderError.ErrMarketplaceInvalidPayload
+	}
+
+	resp := &model.MarketplaceWebhookResponse{
+		Orders: make([]model.MarketplaceIngestedOrder, 0, len(orders)),
+	}
+	created := false
+	for _, order := range orders {
+		result, err := s.ingestOrder(ctx, conn, order)
+		if err != nil {
+			return nil, err
+		}
+		created = created || result.Result == marketplaceResultCreated
+		resp.Orders = append(resp.Orders, result)
+	}
+
+	if created {
+		if err := s.marketplaceRepo.UpdateConnectionLastOrderAt(
+			ctx, conn.ID, time.Now().UTC(),
+		); err != nil {
+			log.WarnWithContext(ctx, fmt.Sprintf(
+				"ingestWebhook: failed to touch connection %d: %v", conn.ID, err,
+			))
+		}
+	}
+	return resp, nil
+}
+
+// ingestOrder creates or updates the internal order for one marketplace order
+func (s *MarketplaceOrderServiceImpl) ingestOrder(
+	ctx context.Context,
+	conn *entity.MarketplaceConnection,
+	order marketplace.ExternalOrder,
+) (model.MarketplaceIngestedOrder, error) {
+	result := model.MarketplaceIngestedOrder{ExternalOrderID: order.ExternalOrderID}
+
+	link, err := s.marketplaceRepo.FindOrderLinkByExternalID(
+		ctx, conn.Marketplace, order.ExternalOrderID,
+	)
+	if err != nil {
+		return result, err
+	}
+	if link != nil {
+		result.OrderID = link.OrderID
+		if order.Cancelled {
+			return s.cancelIngestedOrder(ctx, conn, link, result)
+		}
+		result.Result = marketplaceResultDuplicate
+		return result, nil
+	}
+
+	// Orders cancelled before we saw them, or without lines, never touch inventory.
+	if order.Cancelled || len(order.Items) == 0 {
+		result.Result = marketplaceResultSkipped
+		return result, nil
+	}
+
+	variants, err := s.resolveVariants(ctx, conn.SellerID, order.Items)
+	if err != nil {
+		return result, err
+	}
+	countryID, err := s.resolveCountry(ctx, order.ShippingAddress.CountryCode)
+	if err != nil {
+		return result, err
+	}
+
+	created, err := db.WithTransactionResult(
+		ctx,
+		func(txCtx context.Context) (*entity.Order, error) {
+			return s.createIngestedOrder(txCtx, conn, order, variants, countryID)
+		},
+	)
+	if err != nil {
+		return result, err
+	}
+
+	result.OrderID = created.ID
+	result.OrderNumber = created.OrderNumber
+	result.Result = marketplaceResultCreated
+	return result, nil
+}
+
+// createIngestedOrder writes the order graph, confirms the inventory reservation and links the
+// marketplace order. Marketplace orders are paid on the marketplace, so they start confirmed.
+func (s *MarketplaceOrderServiceImpl) createIngestedOrder(
+	txCtx context.Context,
+	conn *entity.MarketplaceConnection,
+	ext marketplace.ExternalOrder,
+	variants map[string]productMapper.VariantBasicInfoRow,
+	countryID uint,
+) (*entity.Order, error) {
+	userID, err := s.resolveBuyer(txCtx, conn, ext)
+	if err != nil {
+		return nil, err
+	}
+
+	placedAt := ext.PlacedAt.UTC()
+	order := mapper.BuildOrderEntity(
+		userID,
+		conn.SellerID,
+		entity.DIRECTSHIP,
+		entity.ORDER_STATUS_CONFIRMED,
+		factory.BuildMarketplaceOrderMetadata(conn, ext),
+		ext.SubtotalCents,
+		ext.DiscountCents,
+		ext.ShippingCents,
+		ext.TaxCents,
+		ext.TotalCents,
+		placedAt,
+	)
+	order.PaidAt = &placedAt
+	salesChannel := productEntity.SALES_CHANNEL_MARKETPLACE.String()
+	order.SalesChannel = &salesChannel
+	if err := s.orderRepo.CreateOrder(txCtx, order); err != nil {
+		return nil, err
+	}
+
+	items := factory.BuildMarketplaceOrderItems(order.ID, ext.Items, variants)
+	if err := s.orderRepo.CreateOrderItems(txCtx, items); err != nil {
+		return nil, err
+	}
+	addresses := factory.BuildMarketplaceOrderAddresses(order.ID, ext.ShippingAddress, countryID)
+	if err := s.orderRepo.CreateOrderAddresses(txCtx, addresses); err != nil {
+		return nil, err
+	}
+	if err := s.orderHistoryRepo.CreateHistoryEntry(
+		txCtx,
+		mapper.BuildOrderCreatedHistory(
+			order.ID,
+			userID,
+			constants.CUSTOMER_ROLE_NAME,
+			entity.ORDER_STATUS_CONFIRMED.String(),
+		),
+	); err != nil {
+		return nil, err
+	}
+
+	if err := s.reserveIngestedOrder(txCtx, conn.SellerID, order.ID, items); err != nil {
+		return nil, err
+	}
+
+	if err := s.marketplaceRepo.CreateOrderLink(txCtx, &entity.MarketplaceOrder{
+		ConnectionID:    conn.ID,
+		SellerID:        conn.SellerID,
+		Marketplace:     conn.Marketplace,
+		ExternalOrderID: ext.ExternalOrderID,
+		OrderID:         order.ID,
+	}); err != nil {
+		return nil, err
+	}
+	return order, nil
+}
+
+// reserveIngestedOrder decrements shared inventory through a confirmed reservation, the same
+// path a confirmed storefront order takes.
+func (s *MarketplaceOrderServiceImpl) reserveIngestedOrder(
+	txCtx context.Context,
+	sellerID, orderID uint,
+	items []entity.OrderItem,
+) error {
+	reservationItems := make([]inventoryModel.ReservationItem, 0, len(items))
+	for _, item := range items {
+		reservationItems = append(reservationItems, inventoryModel.ReservationItem{
+			VariantID:        *item.VariantID,
+			ReservedQuantity: uint(item.Quantity),
+		})
+	}
+
+	if _, err := s.inventoryReserveSvc.CreateReservation(txCtx, sellerID,
+		inventoryModel.ReservationRequest{
+			ReferenceId:      orderID,
+			ExpiresInMinutes: reservationExpiresInMinutes,
+			Items:            reservationItems,
+			Channel:          productEntity.SALES_CHANNEL_MARKETPLACE.String(),
+		}); err != nil {
+		return err
+	}
+	return s.inventoryReserveSvc.UpdateReservationStatus(
+		txCtx,
+		sellerID,
+		inventoryModel.UpdateReservationStatusRequest{
+			ReferenceId: orderID,
+			Status:      inventoryEntity.ResConfirmed,
+		},
+	)
+}
+
+// cancelIngestedOrder cancels the internal order when the marketplace reports a cancellation.
+// Orders that already moved past confirmation are left for the seller to resolve.
+func (s *MarketplaceOrderServiceImpl) cancelIngestedOrder(
+	ctx context.Context,
+	conn *entity.MarketplaceConnection,
+	link *entity.MarketplaceOrder,
+	result model.MarketplaceIngestedOrder,
+) (model.MarketplaceIngestedOrder, error) {
+	order, err := s.orderRepo.FindOrderByID(ctx, link.OrderID)
+	if err != nil {
+		return result, err
+	}
+	if order == nil {
+		return result, orderError.ErrOrderNotFound
+	}
+	result.OrderNumber = order.OrderNumber
+
+	if order.Status == entity.ORDER_STATUS_CANCELLED {
+		result.Result = marketplaceResultDuplicate
+		return result, nil
+	}
+	if !orderUtils.IsValidTransition(order.Status, entity.ORDER_STATUS_CANCELLED) {
+		log.WarnWithContext(ctx, fmt.Sprintf(
+			"ingestWebhook: %s cancelled order %s but order %d is %s",
+			conn.Marketplace, link.ExternalOrderID, order.ID, order.Status,
+		))
+		result.Result = marketplaceResultSkipped
+		return result, nil
+	}
+
+	note := fmt.Sprintf("Cancelled on %s", conn.Marketplace)
+	if _, err := s.orderSvc.UpdateOrderStatus(ctx, conn.SellerID, order.ID,
+		model.UpdateOrderStatusRequest{
+			Status: entity.ORDER_STATUS_CANCELLED,
+			Note:   &note,
+		}); err != nil {
+		return result, err
+	}
+	result.Result = marketplaceResultCancelled
+	return result, nil
+}
+
+// resolveVariants matches order lines to the seller's variants by SKU
+func (s *MarketplaceOrderServiceImpl) resolveVariants(
+	ctx context.Context,
+	sellerID uint,
+	items []marketplace.ExternalOrderItem,
+) (map[string]productMapper.VariantBasicInfoRow, error) {
+	skus := make([]string, 0, len(items))
+	for _, item := range items {
+		skus = append(skus, item.SKU)
+	}
+	rows, err := s.variantQuerySvc.GetVariantBasicInfoBySKUs(ctx, skus, &sellerID)
+	if err != nil {
+		return nil, err
+	}
+
+	variants := make(map[string]productMapper.VariantBasicInfoRow, len(rows))
+	for _, row := range rows {
+		variants[row.SKU] = row
+	}
+	var missing []string
+	for _, sku := range skus {
+		if _, ok := variants[sku]; !ok {
+			missing = append(missing, sku)
+		}
+	}
+	if len(missing) > 0 {
+		return nil, orderError.ErrMarketplaceUnknownSKUs(missing)
+	}
+	return variants, nil
+}
+
+func (s *MarketplaceOrderServiceImpl) resolveCountry(
+	ctx context.Context,
+	countryCode string,
+) (uint, error) {
+	code := strings.ToUpper(strings.TrimSpace(countryCode))
+	country, err := s.countryRepo.FindByCode(ctx, code)
+	if err != nil {
+		return 0, err
+	}
+	if country == nil {
+		return 0, orderError.ErrMarketplaceUnknownCountry(code)
+	}
+	return country.ID, nil
+}
+
+// resolveBuyer finds the customer account for the marketplace buyer, creating one on first
+// order. Buyers without a shared email get a stable non-deliverable placeholder address.
+func (s *MarketplaceOrderServiceImpl) resolveBuyer(
+	ctx context.Context,
+	conn *entity.MarketplaceConnection,
+	order marketplace.ExternalOrder,
+) (uint, error) {
+	email := strings.ToLower(strings.TrimSpace(order.Buyer.Email))
+	if email == "" {
+		key := order.Buyer.ExternalID
+		if key == "" {
+			key = order.ExternalOrderID
+		}
+		key = strings.Trim(marketplaceEmailKeyPattern.ReplaceAllString(
+			strings.ToLower(key), "-"), "-")
+		email = fmt.Sprintf("%s-%s@%s",
+			conn.Marketplace, key, orderConstants.MARKETPLACE_BUYER_EMAIL_DOMAIN)
+	}
+
+	// FindByEmail reports a missing user as an error
+	if user, err := s.userRepo.FindByEmail(ctx, email); err == nil && user != nil {
+		return user.ID, nil
+	}
+
+	password, err := helper.GenerateRandomCredential()
+	if err != nil {
+		return 0, err
+	}
+	firstName, lastName := splitBuyerName(order.Buyer.Name, order.ShippingAddress.Name)
+	user, _, err := s.userSvc.CreateUserWithRole(ctx, userModel.CreateUserRequest{
+		FirstName: firstName,
+		LastName:  lastName,
+		Email:     email,
+		Password:  password,
+		Phone:     order.Buyer.Phone,
+		SellerID:  conn.SellerID,
+	}, constants.CUSTOMER_ROLE_NAME)
+	if err != nil {
+		return 0, err
+	}
+	return user.ID, nil
+}
+
+// ============================================================================
+// Shipment Confirmation
+// ============================================================================
+
+// SyncShipment confirms a shipment on the marketplace. It is a no-op for orders that were not
+// ingested from a marketplace, and a failed push is recorded for retry rather than returned.
+func (s *MarketplaceOrderServiceImpl) SyncShipment(
+	ctx context.Context,
+	req model.MarketplaceShipmentRequest,
+) error {
+	link, err := s.marketplaceRepo.FindOrderLinkByOrderID(ctx, req.OrderID)
+	if err != nil || link == nil {
+		return err
+	}
+	order, err := s.orderRepo.FindOrderByID(ctx, req.OrderID)
+	if err != nil {
+		return err
+	}
+	if order == nil {
+		return orderError.ErrOrderNotFound
+	}
+
+	if req.ShippedAt.IsZero() {
+		req.ShippedAt = time.Now().UTC()
+	}
+	confirmation := factory.BuildShipmentConfirmation(link.ExternalOrderID, req, order.Items)
+	if len(confirmation.Items) == 0 {
+		return nil
+	}
+	sync := &entity.MarketplaceShipmentSync{
+		MarketplaceOrderID: link.ID,
+		ShipmentID:         req.ShipmentID,
+		Carrier:            req.Carrier,
+		TrackingNo:         req.TrackingNo,
+		Items:              factory.ShipmentConfirmationToJSONMap(confirmation),
+		Status:             entity.SHIPMENT_SYNC_PENDING,
+	}
+	if err := s.marketplaceRepo.CreateShipmentSync(ctx, sync); err != nil {
+		return err
+	}
+
+	return s.pushShipmentConfirmation(ctx, link, sync, confirmation)
+}
+
+// RetryFailedShipmentSyncs re-pushes failed confirmations that have attempts left.
+// Runs as a recurring cron job.
+func (s *MarketplaceOrderServiceImpl) RetryFailedShipmentSyncs() {
+	ctx := context.Background()
+	syncs, err := s.marketplaceRepo.FindRetryableShipmentSyncs(
+		ctx,
+		orderConstants.MARKETPLACE_SHIPMENT_SYNC_MAX_ATTEMPTS,
+		orderConstants.MARKETPLACE_SHIPMENT_SYNC_BATCH_SIZE,
+	)
+	if err != nil {
+		log.ErrorWithContext(ctx, "Cron: Failed to load marketplace shipment syncs", err)
+		return
+	}
+
+	for i := range syncs {
+		sync := &syncs[i]
+		if sync.MarketplaceOrder == nil {
+			continue
+		}
+		confirmation := factory.ShipmentConfirmationFromSync(sync)
+		if err := s.pushShipmentConfirmation(ctx, sync.MarketplaceOrder, sync,
+			confirmation); err != nil {
+			log.ErrorWithContext(ctx, "Cron: Failed to record marketplace shipment sync", err)
+		}
+	}
+}
+
+// pushShipmentConfirmation calls the marketplace and records the outcome on the sync row.
+// Only persistence failures are returned; marketplace errors are kept for retry.
+func (s *MarketplaceOrderServiceImpl) pushShipmentConfirmation(
+	ctx context.Context,
+	link *entity.MarketplaceOrder,
+	sync *entity.MarketplaceShipmentSync,
+	confirmation marketplace.ShipmentConfirmation,
+) error {
+	pushErr := s.confirmShipment(ctx, link, confirmation)
+
+	sync.Attempts++
+	if pushErr != nil {
+		message := pushErr.Error()
+		sync.Status = entity.SHIPMENT_SYNC_FAILED
+		sync.LastError = &message
+		log.WarnWithContext(ctx, fmt.Sprintf(
+			"syncShipment: %s confirmation for order %s failed (attempt %d): %v",
+			link.Marketplace, link.ExternalOrderID, sync.Attempts, pushErr,
+		))
+	} else {
+		now := time.Now().UTC()
+		sync.Status = entity.SHIPMENT_SYNC_SYNCED
+		sync.LastError = nil
+		sync.SyncedAt = &now
+	}
+	return s.marketplaceRepo.UpdateShipmentSync(ctx, sync)
+}
+
+func (s *MarketplaceOrderServiceImpl) confirmShipment(
+	ctx context.Context,
+	link *entity.MarketplaceOrder,
+	confirmation marketplace.ShipmentConfirmation,
+) error {
+	adapter, ok := s.adapters.Get(link.Marketplace)
+	if !ok {
+		return orderError.ErrMarketplaceUnsupported
+	}
+	conn, err := s.marketplaceRepo.FindConnectionByID(ctx, link.ConnectionID)
+	if err != nil {
+		return err
+	}
+	if conn == nil {
+		return orderError.ErrMarketplaceConnectionNotFound
+	}
+	if !conn.IsActive {
+		return orderError.ErrMarketplaceConnectionInactive
+	}
+
+	creds, err := decryptCredentials(conn)
+	if err != nil {
+		return err
+	}
+	return adapter.ConfirmShipment(ctx, creds, confirmation)
+}
+
+// ============================================================================
+// Helpers
+// ============================================================================
+
+// verifyMarketplaceSignature checks the hex HMAC-SHA256 of the raw body against the
+// connection's webhook secret
+func verifyMarketplaceSignature(
+	conn *entity.MarketplaceConnection,
+	body []byte,
+	signature string,
+) error {
+	provided, err := hex.DecodeString(strings.TrimSpace(signature))
+	if err != nil || len(provided) == 0 {
+		return orderError.ErrMarketplaceInvalidSignature
+	}
+	secret, err := helper.Decrypt(conn.WebhookSecret, encryptionKey())
+	if err != nil {
+		return err
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	if !hmac.Equal(provided, mac.Sum(nil)) {
+		return orderError.ErrMarketplaceInvalidSignature
+	}
+	return nil
+}
+
+func decryptCredentials(conn *entity.MarketplaceConnection) (marketplace.Credentials, error) {
+	creds := marketplace.Credentials{ExternalSellerID: conn.ExternalSellerID}
+	if baseURL, ok := conn.Credentials[marketplaceCredentialBaseURL].(string); ok {
+		creds.BaseURL = baseURL
+	}
+	encrypted, ok := conn.Credentials[marketplaceCredentialAccessToken].(string)
+	if !ok || encrypted == "" {
+		return creds, errors.New("marketplace connection has no access token")
+	}
+	token, err := helper.Decrypt(encrypted, encryptionKey())
+	if err != nil {
+		return creds, err
+	}
+	creds.AccessToken = token
+	return creds, nil
+}
+
+func encryptionKey() string {
+	if cfg := config.Get(); cfg != nil {
+		return cfg.App.EncryptionKey
+	}
+	return ""
+}
+
+// splitBuyerName derives first and last names, since marketplaces share a single full name
+func splitBuyerName(names ...string) (string, string) {
+	for _, name := range names {
+		parts := strings.Fields(name)
+		switch len(parts) {
+		case 0:
+			continue
+		case 1:
+			return parts[0], parts[0]
+		default:
+			return parts[0], strings.Join(parts[1:], " ")
+		}
+	}
+	return "Marketplace", "Buyer"
+}
@@ -0,0 +1,145 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"ecommerce-be/common/constants"
+	"ecommerce-be/common/db"
+	"ecommerce-be/common/log"
+	"ecommerce-be/order/entity"
+	orderError "ecommerce-be/order/error"
+	"ecommerce-be/order/model"
+	orderUtils "ecommerce-be/order/utils"
+	paymentEntity "ecommerce-be/payment/entity"
+	paymentModel "ecommerce-be/payment/model"
+	userErrors "ecommerce-be/user/error"
+)
+
+// SellerCancelOrder cancels one of the seller's pending or confirmed orders at any time,
+// releasing the reservation and refunding what the customer paid.
+func (s *OrderServiceImpl) SellerCancelOrder(
+	ctx context.Context,
+	sellerID uint,
+	orderID uint,
+	req model.CancelOrderRequest,
+) (*model.UpdateStatusResponse, error) {
+	order, err := s.orderRepo.FindOrderByID(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+	if order == nil || order.SellerID == nil || *order.SellerID != sellerID {
+		return nil, orderError.ErrOrderNotFound
+	}
+	if !isCancellableStatus(order.Status) {
+		return nil, orderError.ErrOrderNotCancellable
+	}
+	return s.cancelOrder(ctx, order, sellerID, constants.SELLER_ROLE_NAME, req)
+}
+
+// cancelOrder cancels a validated order, then refunds its payments and notifies the
+// customer. Refunds run after the cancellation commits: a refund the gateway rejects is
+// reported in the response and left for the seller, it does not undo the cancellation.
+func (s *OrderServiceImpl) cancelOrder(
+	ctx context.Context,
+	order *entity.Order,
+	actorID uint,
+	role string,
+	req model.CancelOrderRequest,
+) (*model.UpdateStatusResponse, error) {
+	prev := order.Status
+	now := time.Now().UTC()
+	err := db.WithTransaction(ctx, func(txCtx context.Context) error {
+		return s.applyCancelOrderTx(txCtx, order, actorID, role, prev, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	refunds := s.refundCancelledOrder(ctx, order, actorID, role, req.Reason)
+	s.notifyOrderCancelled(ctx, order, refunds)
+
+	return &model.UpdateStatusResponse{
+		ID:             order.ID,
+		OrderNumber:    order.OrderNumber,
+		PreviousStatus: prev,
+		Status:         entity.ORDER_STATUS_CANCELLED,
+		UpdatedAt:      now,
+		Refunds:        refunds,
+	}, nil
+}
+
+// validateCancellationWindow rejects customer cancellations once the seller's window
+// has passed. Sellers without settings allow cancellation at any time.
+func (s *OrderServiceImpl) validateCancellationWindow(
+	ctx context.Context,
+	order *entity.Order,
+	now time.Time,
+) error {
+	if order.SellerID == nil {
+		return nil
+	}
+	settings, err := s.sellerSettingsSvc.GetBySellerID(ctx, *order.SellerID)
+	switch {
+	case errors.Is(err, userErrors.ErrSellerSettingsNotFound):
+		return nil
+	case err != nil:
+		return err
+	}
+	placedAt := orderUtils.OrderPlacedAt(order)
+	if !orderUtils.CancellationWindowOpen(placedAt, settings.CancellationWindowMinutes, now) {
+		return orderError.ErrCancellationWindowClosed
+	}
+	return nil
+}
+
+// refundCancelledOrder refunds every payment the cancelled order collected through a
+// gateway. Failures are logged and never fail the cancellation.
+func (s *OrderServiceImpl) refundCancelledOrder(
+	ctx context.Context,
+	order *entity.Order,
+	actorID uint,
+	role string,
+	note *string,
+) []paymentModel.PaymentRefundResponse {
+	if order.SellerID == nil {
+		return nil
+	}
+	initiatedByType := paymentEntity.InitiatedByCustomer
+	if role == constants.SELLER_ROLE_NAME {
+		initiatedByType = paymentEntity.InitiatedBySeller
+	}
+	notes := ""
+	if note != nil {
+		notes = *note
+	}
+
+	var refunds []paymentModel.PaymentRefundResponse
+	for _, payment := range orderUtils.BuildCancellationRefunds(order) {
+		refund, err := s.paymentRefundSvc.RefundPayment(ctx, paymentModel.RefundPaymentRequest{
+			SellerID:         *order.SellerID,
+			PaymentReference: payment.PaymentReference,
+			AmountCents:      payment.AmountCents,
+			Reason:           paymentEntity.RefundReasonOrderCancelled,
+			Notes:            notes,
+			InitiatedBy:      &actorID,
+			InitiatedByType:  initiatedByType,
+			OrderID:          &order.ID,
+		})
+		if err != nil {
+			log.ErrorWithContext(ctx, "order: failed to refund cancelled order", err)
+			continue
+		}
+		if refund != nil {
+			refunds = append(refunds, *refund)
+		}
+	}
+	return refunds
+}
+
+// isCancellableStatus reports whether an order can still be cancelled: it has not been
+// fulfilled, failed or cancelled already
+func isCancellableStatus(status entity.OrderStatus) bool {
+	return status == entity.ORDER_STATUS_PENDING || status == entity.ORDER_STATUS_CONFIRMED
+}
@@ -0,0 +1,346 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"ecommerce-be/common/constants"
+	"ecommerce-be/common/db"
+	"ecommerce-be/common/log"
+	inventoryEntity "ecommerce-be/inventory/entity"
+	inventoryModel "ecommerce-be/inventory/model"
+	"ecommerce-be/order/entity"
+	orderError "ecommerce-be/order/error"
+	"ecommerce-be/order/mapper"
+	"ecommerce-be/order/model"
+	orderUtils "ecommerce-be/order/utils"
+	orderConstant "ecommerce-be/order/utils/constant"
+	productModel "ecommerce-be/product/model"
+)
+
+// DepositPolicy configures deposit orders.
+type DepositPolicy struct {
+	// Percent of the order total collected as the deposit
+	Percent int
+	// BalanceDueAfter is how long after placement a before-fulfillment balance is due
+	BalanceDueAfter time.Duration
+	// ReminderLead is how long before the due date the customer is reminded
+	ReminderLead time.Duration
+}
+
+// normalizePaymentPlan defaults to full payment. Deposit orders default to collecting
+// the balance before fulfillment.
+func normalizePaymentPlan(
+	plan *entity.PaymentPlan,
+	collection *entity.BalanceCollection,
+) (entity.PaymentPlan, *entity.BalanceCollection, error) {
+	normalized := entity.PAYMENT_PLAN_FULL
+	if plan != nil && strings.TrimSpace(string(*plan)) != "" {
+		normalized = entity.PaymentPlan(strings.ToLower(strings.TrimSpace(string(*plan))))
+	}
+	if !normalized.IsValid() {
+		return "", nil, orderError.ErrInvalidPaymentPlan
+	}
+
+	if normalized == entity.PAYMENT_PLAN_FULL {
+		if collection != nil {
+			return "", nil, orderError.ErrInvalidPaymentPlan
+		}
+		return normalized, nil, nil
+	}
+
+	balanceCollection := entity.BALANCE_BEFORE_FULFILLMENT
+	if collection != nil {
+		balanceCollection = entity.BalanceCollection(
+			strings.ToLower(strings.TrimSpace(string(*collection))))
+	}
+	if !balanceCollection.IsValid() {
+		return "", nil, orderError.ErrInvalidPaymentPlan
+	}
+	return normalized, &balanceCollection, nil
+}
+
+// createPaymentSchedule stores the deposit and balance of a new deposit order. Orders
+// placed directly as confirmed have already paid the deposit.
+func (s *OrderServiceImpl) createPaymentSchedule(
+	txCtx context.Context,
+	order *entity.Order,
+	now time.Time,
+) error {
+	if order.PaymentPlan != entity.PAYMENT_PLAN_DEPOSIT {
+		return nil
+	}
+	installments, err := orderUtils.BuildPaymentSchedule(
+		order.ID,
+		order.TotalCents,
+		s.depositPolicy.Percent,
+		*order.BalanceCollection,
+		now,
+		s.depositPolicy.BalanceDueAfter,
+	)
+	if err != nil {
+		return err
+	}
+	if order.Status == entity.ORDER_STATUS_CONFIRMED {
+		deposit := orderUtils.FindInstallment(installments, entity.INSTALLMENT_DEPOSIT)
+		markInstallmentPaid(deposit, nil, now)
+	}
+	return s.orderPaymentRepo.CreateInstallments(txCtx, installments)
+}
+
+// validateBalanceForCompletion blocks completing a deposit order with an unpaid balance.
+// A balance collected on delivery can be settled by completing with its transactionId.
+func validateBalanceForCompletion(
+	order *entity.Order,
+	req model.UpdateOrderStatusRequest,
+) error {
+	balance := pendingBalance(order)
+	if balance == nil {
+		return nil
+	}
+	if order.BalanceCollection != nil && *order.BalanceCollection == entity.BALANCE_ON_DELIVERY &&
+		req.TransactionID != nil && strings.TrimSpace(*req.TransactionID) != "" {
+		return nil
+	}
+	return orderError.ErrOrderBalanceUnpaid
+}
+
+// applyDepositStatusTx records installment payments implied by a status transition:
+// confirming pays the deposit and completing settles a balance collected on delivery.
+// It returns the installment paid, if any; the order is paid in full, which is when
+// paid_at is set, once its balance is paid.
+func (s *OrderServiceImpl) applyDepositStatusTx(
+	txCtx context.Context,
+	order *entity.Order,
+	target entity.OrderStatus,
+	now time.Time,
+	req model.UpdateOrderStatusRequest,
+) (*entity.OrderPaymentInstallment, error) {
+	var installment *entity.OrderPaymentInstallment
+	switch target {
+	case entity.ORDER_STATUS_CONFIRMED:
+		installment = orderUtils.FindInstallment(order.PaymentInstallments,
+			entity.INSTALLMENT_DEPOSIT)
+	case entity.ORDER_STATUS_COMPLETED:
+		installment = pendingBalance(order)
+	}
+	if installment == nil || installment.Status != entity.INSTALLMENT_STATUS_PENDING {
+		return nil, nil
+	}
+
+	markInstallmentPaid(installment, req.TransactionID, now)
+	if err := s.orderPaymentRepo.UpdateInstallment(txCtx, installment); err != nil {
+		return nil, err
+	}
+	return installment, nil
+}
+
+// RecordBalancePayment records the balance payment of a confirmed deposit order, which
+// completes its payment.
+func (s *OrderServiceImpl) RecordBalancePayment(
+	ctx context.Context,
+	sellerID uint,
+	orderID uint,
+	req model.RecordBalancePaymentRequest,
+) (*model.OrderResponse, error) {
+	order, err := s.orderRepo.FindOrderByID(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+	if order == nil || order.SellerID == nil || *order.SellerID != sellerID {
+		return nil, orderError.ErrOrderNotFound
+	}
+	balance := pendingBalance(order)
+	if balance == nil || order.Status != entity.ORDER_STATUS_CONFIRMED {
+		return nil, orderError.ErrNoBalanceDue
+	}
+
+	txnID := strings.TrimSpace(req.TransactionID)
+	if txnID == "" {
+		return nil, orderError.ErrTransactionIDRequired
+	}
+	now := time.Now().UTC()
+	var purchases []productModel.DigitalPurchaseResponse
+	err = db.WithTransaction(ctx, func(txCtx context.Context) error {
+		markInstallmentPaid(balance, &txnID, now)
+		if err := s.orderPaymentRepo.UpdateInstallment(txCtx, balance); err != nil {
+			return err
+		}
+		if err := s.orderRepo.UpdateOrderPaidAt(txCtx, order.ID, now); err != nil {
+			return err
+		}
+		if err := s.orderRepo.UpdateOrderTransactionID(txCtx, order.ID, txnID); err != nil {
+			return err
+		}
+		if err := s.recordSaleTx(txCtx, order, balance.AmountCents,
+			orderUtils.InstallmentCommissionCents(order, balance), txnID, now); err != nil {
+			return err
+		}
+		if err := s.orderHistoryRepo.CreateHistoryEntry(
+			txCtx,
+			mapper.BuildOrderTransitionHistory(
+				order.ID,
+				order.Status,
+				order.Status,
+				sellerID,
+				constants.SELLER_ROLE_NAME,
+				&txnID,
+				nil,
+				req.Note,
+				nil,
+			),
+		); err != nil {
+			return err
+		}
+		purchases, err = s.deliverDigitalItemsTx(txCtx, order.ID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.notifyInstallmentEvent(ctx, constants.NOTIFY_EVENT_PAYMENT_RECEIVED, order, balance)
+	s.notifyDownloadsReady(ctx, order, purchases)
+	return s.loadCreateOrderResponse(ctx, order.ID)
+}
+
+// ProcessDepositBalances cancels confirmed deposit orders whose balance is past due and
+// reminds customers of balances falling due soon. Runs as a recurring cron job.
+func (s *OrderServiceImpl) ProcessDepositBalances() {
+	ctx := context.Background()
+	now := time.Now().UTC()
+
+	// Expire first so an overdue balance is not reminded in the same run
+	overdue, err := s.orderPaymentRepo.FindOverdueBalances(
+		ctx, now, orderConstant.ORDER_DEPOSIT_BALANCE_BATCH_SIZE)
+	if err != nil {
+		log.ErrorWithContext(ctx, "Cron: Failed to load overdue order balances", err)
+		return
+	}
+	for i := range overdue {
+		if err := s.expireUnpaidBalance(ctx, &overdue[i]); err != nil {
+			log.ErrorWithContext(ctx, "Cron: Failed to expire order balance", err)
+		}
+	}
+
+	due, err := s.orderPaymentRepo.FindBalancesDueForReminder(
+		ctx, now.Add(s.depositPolicy.ReminderLead), orderConstant.ORDER_DEPOSIT_BALANCE_BATCH_SIZE)
+	if err != nil {
+		log.ErrorWithContext(ctx, "Cron: Failed to load order balances due", err)
+		return
+	}
+	for i := range due {
+		balance := &due[i]
+		if balance.Order == nil {
+			continue
+		}
+		s.notifyInstallmentEvent(ctx, constants.NOTIFY_EVENT_ORDER_BALANCE_DUE,
+			balance.Order, balance)
+		balance.ReminderSentAt = &now
+		if err := s.orderPaymentRepo.UpdateInstallment(ctx, balance); err != nil {
+			log.ErrorWithContext(ctx, "Cron: Failed to mark order balance reminded", err)
+		}
+	}
+}
+
+// expireUnpaidBalance cancels the order of an overdue balance and releases its stock.
+// The order is re-read so a balance paid since the sweep started is left alone.
+func (s *OrderServiceImpl) expireUnpaidBalance(
+	ctx context.Context,
+	overdue *entity.OrderPaymentInstallment,
+) error {
+	order, err := s.orderRepo.FindOrderByID(ctx, overdue.OrderID)
+	if err != nil {
+		return err
+	}
+	if order == nil || order.Status != entity.ORDER_STATUS_CONFIRMED {
+		return nil
+	}
+	balance := pendingBalance(order)
+	if balance == nil {
+		return nil
+	}
+
+	err = db.WithTransaction(ctx, func(txCtx context.Context) error {
+		balance.Status = entity.INSTALLMENT_STATUS_EXPIRED
+		if err := s.orderPaymentRepo.UpdateInstallment(txCtx, balance); err != nil {
+			return err
+		}
+		if err := s.orderRepo.UpdateOrderStatus(
+			txCtx, order.ID, entity.ORDER_STATUS_CANCELLED); err != nil {
+			return err
+		}
+
+		orderSellerID := uint(0)
+		if order.SellerID != nil {
+			orderSellerID = *order.SellerID
+		}
+		if err := s.inventoryReserveSvc.UpdateReservationStatus(txCtx, orderSellerID,
+			inventoryModel.UpdateReservationStatusRequest{
+				ReferenceId: order.ID,
+				Status:      inventoryEntity.ResCancelled,
+			}); err != nil {
+			return err
+		}
+
+		note := orderConstant.ORDER_BALANCE_EXPIRED_NOTE
+		return s.orderHistoryRepo.CreateHistoryEntry(
+			txCtx,
+			mapper.BuildSystemTransitionHistory(
+				order.ID,
+				order.Status,
+				entity.ORDER_STATUS_CANCELLED,
+				orderConstant.ORDER_HISTORY_SYSTEM_ROLE,
+				&note,
+			),
+		)
+	})
+	if err != nil {
+		return err
+	}
+
+	s.notifyOrderStatusEvent(ctx, constants.NOTIFY_EVENT_ORDER_CANCELLED, order,
+		entity.ORDER_STATUS_CANCELLED)
+	return nil
+}
+
+// notifyInstallmentEvent notifies the customer about one installment; Amount is the
+// installment rather than the order total.
+func (s *OrderServiceImpl) notifyInstallmentEvent(
+	ctx context.Context,
+	event string,
+	order *entity.Order,
+	installment *entity.OrderPaymentInstallment,
+) {
+	payload := buildOrderPayload(order.ID, order.OrderNumber, order.Status,
+		installment.AmountCents)
+	if installment.DueAt != nil {
+		payload[orderConstant.ORDER_BALANCE_DUE_DATE_DATA_KEY] = installment.DueAt.UTC().
+			Format(orderConstant.ORDER_BALANCE_DUE_DATE_LAYOUT)
+	}
+	s.sendOrderNotification(ctx, event, order.UserID, payload)
+}
+
+// pendingBalance returns the unpaid balance of a deposit order, or nil
+func pendingBalance(order *entity.Order) *entity.OrderPaymentInstallment {
+	balance := orderUtils.FindInstallment(order.PaymentInstallments, entity.INSTALLMENT_BALANCE)
+	if balance == nil || balance.Status != entity.INSTALLMENT_STATUS_PENDING {
+		return nil
+	}
+	return balance
+}
+
+func markInstallmentPaid(
+	installment *entity.OrderPaymentInstallment,
+	transactionID *string,
+	now time.Time,
+) {
+	paidAt := now
+	installment.Status = entity.INSTALLMENT_STATUS_PAID
+	installment.PaidAt = &paidAt
+	if transactionID != nil && strings.TrimSpace(*transactionID) != "" {
+		txnID := strings.TrimSpace(*transactionID)
+		installment.TransactionID = &txnID
+	}
+}
@@ -0,0 +1,110 @@
+package service
+
+import (
+	"context"
+
+	"ecommerce-be/common/constants"
+	"ecommerce-be/order/entity"
+	orderError "ecommerce-be/order/error"
+	productModel "ecommerce-be/product/model"
+)
+
+// GetOrderDownloads returns the digital purchases of the customer's order with fresh
+// download links. Nothing is delivered before the order is paid.
+func (s *OrderServiceImpl) GetOrderDownloads(
+	ctx context.Context,
+	userID uint,
+	orderID uint,
+) ([]productModel.DigitalPurchaseResponse, error) {
+	order, err := s.orderRepo.FindOrderByID(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+	if order == nil || order.UserID != userID {
+		return nil, orderError.ErrOrderNotFound
+	}
+	if order.PaidAt == nil {
+		return []productModel.DigitalPurchaseResponse{}, nil
+	}
+	purchases, err := s.digitalDeliverySvc.GetOrderDownloads(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+	if purchases == nil {
+		purchases = []productModel.DigitalPurchaseResponse{}
+	}
+	return purchases, nil
+}
+
+// deliverDigitalItemsTx delivers the digital items of an order once it is paid in full,
+// within the transaction that recorded the payment. The order is re-read so the
+// payment just recorded is seen. Returns only what this call delivered.
+func (s *OrderServiceImpl) deliverDigitalItemsTx(
+	txCtx context.Context,
+	orderID uint,
+) ([]productModel.DigitalPurchaseResponse, error) {
+	order, err := s.orderRepo.FindOrderByID(txCtx, orderID)
+	if err != nil || order == nil || order.SellerID == nil || order.PaidAt == nil {
+		return nil, err
+	}
+	if order.Status == entity.ORDER_STATUS_CANCELLED || order.Status == entity.ORDER_STATUS_FAILED {
+		return nil, nil
+	}
+
+	lines := make([]productModel.DigitalOrderLine, 0, len(order.Items))
+	for _, item := range order.Items {
+		if item.ProductID == nil || item.VariantID == nil {
+			continue
+		}
+		lines = append(lines, productModel.DigitalOrderLine{
+			OrderItemID: item.ID,
+			ProductID:   *item.ProductID,
+			VariantID:   *item.VariantID,
+			Quantity:    item.Quantity,
+		})
+	}
+	if len(lines) == 0 {
+		return nil, nil
+	}
+	return s.digitalDeliverySvc.DeliverOrder(txCtx, productModel.DigitalDeliveryRequest{
+		OrderID:  order.ID,
+		UserID:   order.UserID,
+		SellerID: *order.SellerID,
+		Lines:    lines,
+	})
+}
+
+// notifyDownloadsReady sends the customer the download links and license keys of the
+// digital items just delivered
+func (s *OrderServiceImpl) notifyDownloadsReady(
+	ctx context.Context,
+	order *entity.Order,
+	purchases []productModel.DigitalPurchaseResponse,
+) {
+	if len(purchases) == 0 {
+		return
+	}
+	downloads := make([]map[string]any, 0, len(purchases))
+	licenseKeys := make([]map[string]any, 0)
+	for _, purchase := range purchases {
+		for _, link := range purchase.Downloads {
+			downloads = append(downloads, map[string]any{
+				"ProductName": purchase.ProductName,
+				"Name":        link.Name,
+				"URL":         link.URL,
+			})
+		}
+		for _, key := range purchase.LicenseKeys {
+			licenseKeys = append(licenseKeys, map[string]any{
+				"ProductName": purchase.ProductName,
+				"Key":         key,
+			})
+		}
+	}
+
+	payload := buildOrderPayload(order.ID, order.OrderNumber, order.Status, order.TotalCents)
+	payload[constants.DOWNLOADS_DATA_KEY] = downloads
+	payload[constants.LICENSE_KEYS_DATA_KEY] = licenseKeys
+	s.sendOrderNotification(ctx, constants.NOTIFY_EVENT_ORDER_DOWNLOADS_READY, order.UserID,
+		payload)
+}
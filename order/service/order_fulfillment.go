@@ -0,0 +1,54 @@
+package service
+
+import (
+	"context"
+
+	"ecommerce-be/common/db"
+	orderError "ecommerce-be/order/error"
+	"ecommerce-be/order/model"
+	orderUtils "ecommerce-be/order/utils"
+)
+
+// RecordFulfillment locks the order so concurrent shipments cannot fulfill the same units
+// twice, adds the lines to the items' fulfilled quantities and re-derives the order's
+// fulfillment status from them.
+func (s *OrderServiceImpl) RecordFulfillment(
+	ctx context.Context,
+	sellerID uint,
+	orderID uint,
+	lines []model.FulfillmentLine,
+) (*model.FulfillmentResult, error) {
+	return db.WithTransactionResult(
+		ctx,
+		func(txCtx context.Context) (*model.FulfillmentResult, error) {
+			order, err := s.orderRepo.FindOrderByIDForUpdate(txCtx, orderID)
+			if err != nil {
+				return nil, err
+			}
+			if order == nil || order.SellerID == nil || *order.SellerID != sellerID {
+				return nil, orderError.ErrOrderNotFound
+			}
+
+			resolved, err := orderUtils.ResolveFulfillmentLines(order.Items, lines)
+			if err != nil {
+				return nil, err
+			}
+			for _, i := range orderUtils.ApplyFulfillmentLines(order.Items, resolved) {
+				item := order.Items[i]
+				if err := s.orderRepo.UpdateOrderItemFulfilledQuantity(
+					txCtx, item.ID, item.FulfilledQuantity); err != nil {
+					return nil, err
+				}
+			}
+
+			status := orderUtils.DeriveFulfillmentStatus(order.Items)
+			if status != order.FulfillmentStatus {
+				if err := s.orderRepo.UpdateOrderFulfillmentStatus(
+					txCtx, order.ID, status); err != nil {
+					return nil, err
+				}
+			}
+			return &model.FulfillmentResult{Lines: resolved, FulfillmentStatus: status}, nil
+		},
+	)
+}
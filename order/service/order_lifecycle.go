@@ -7,6 +7,8 @@ import (
 
 	"ecommerce-be/common/constants"
 	"ecommerce-be/common/db"
+	"ecommerce-be/common/log"
+	"ecommerce-be/common/screening"
 	inventoryEntity "ecommerce-be/inventory/entity"
 	inventoryModel "ecommerce-be/inventory/model"
 	"ecommerce-be/order/entity"
@@ -15,6 +17,9 @@ import (
 	"ecommerce-be/order/mapper"
 	"ecommerce-be/order/model"
 	orderUtils "ecommerce-be/order/utils"
+	paymentModel "ecommerce-be/payment/model"
+	productEntity "ecommerce-be/product/entity"
+	productModel "ecommerce-be/product/model"
 )
 
 const reservationExpiresInMinutes = 5
@@ -56,9 +61,48 @@ func (s *OrderServiceImpl) CreateOrder(
 	}
 
 	converted = true
+	s.screenHighValueOrder(ctx, userID, sellerID, resp)
+	s.notifyOrderResponseEvent(ctx, constants.NOTIFY_EVENT_ORDER_PLACED, userID, resp)
 	return resp, nil
 }
 
+// screenHighValueOrder checks the customer and shipping address of a high-value order
+// against the blocked-party lists. A hit only queues the order for compliance review;
+// the order itself is already placed.
+func (s *OrderServiceImpl) screenHighValueOrder(
+	ctx context.Context,
+	userID, sellerID uint,
+	resp *model.OrderResponse,
+) {
+	if resp.TotalCents < s.highValueOrderCents {
+		return
+	}
+
+	subject := screening.Subject{
+		Type:        screening.SUBJECT_ORDER,
+		ReferenceID: resp.ID,
+		SellerID:    sellerID,
+	}
+	if user, err := s.userRepo.FindByID(ctx, userID); err == nil {
+		subject.Names = append(subject.Names, user.FirstName+" "+user.LastName)
+	}
+	for _, address := range resp.Addresses {
+		if address.Type != entity.ORDER_ADDR_SHIPPING {
+			continue
+		}
+		subject.Address = strings.Join([]string{
+			address.Address, address.City, address.State, address.ZipCode,
+		}, ", ")
+		if country, err := s.countryRepo.FindByID(ctx, address.CountryID); err == nil {
+			subject.CountryCode = country.Code
+		}
+	}
+
+	if _, err := s.screener.Screen(ctx, subject); err != nil {
+		log.ErrorWithContext(ctx, "createOrder: screening failed", err)
+	}
+}
+
 // prepareCreateOrder validates request inputs and acquires a cart checkout lock.
 func (s *OrderServiceImpl) prepareCreateOrder(
 	ctx context.Context,
@@ -75,7 +119,22 @@ func (s *OrderServiceImpl) prepareCreateOrder(
 		return nil, err
 	}
 
-	cartSnapshot, err := s.cartSvc.GetUserCart(ctx, userID, sellerID)
+	paymentPlan, balanceCollection, err := normalizePaymentPlan(
+		req.PaymentPlan,
+		req.BalanceCollection,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.DeliverySlot != nil {
+		err := orderUtils.ValidateDeliverySlot(fulfillmentType, *req.DeliverySlot, time.Now())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	cartSnapshot, err := s.cartSvc.GetUserCart(ctx, userID, sellerID, req.SalesChannel)
 	if err != nil {
 		return nil, err
 	}
@@ -100,12 +159,14 @@ func (s *OrderServiceImpl) prepareCreateOrder(
 	}
 
 	return &createOrderContext{
-		fulfillmentType: fulfillmentType,
-		orderStatus:     orderStatus,
-		cartSnapshot:    cartSnapshot,
-		lockedCart:      lockedCart,
-		shippingAddress: shippingAddress,
-		billingAddress:  billingAddress,
+		fulfillmentType:   fulfillmentType,
+		orderStatus:       orderStatus,
+		paymentPlan:       paymentPlan,
+		balanceCollection: balanceCollection,
+		cartSnapshot:      cartSnapshot,
+		lockedCart:        lockedCart,
+		shippingAddress:   shippingAddress,
+		billingAddress:    billingAddress,
 	}, nil
 }
 
@@ -126,7 +187,7 @@ func (s *OrderServiceImpl) executeCreateOrderTransaction(
 			}
 
 			if err := s.handleCreateOrderReservation(txCtx, sellerID,
-				order.ID, createCtx); err != nil {
+				order.ID, req.SalesChannel, createCtx); err != nil {
 				return nil, err
 			}
 
@@ -154,6 +215,11 @@ func (s *OrderServiceImpl) persistOrderSnapshotGraph(
 	}
 
 	orderItems := factory.BuildOrderItemsFromCartSnapshot(order.ID, createCtx.cartSnapshot)
+	if err := s.applyLineCommissions(
+		txCtx, sellerID, order, orderItems, createCtx.cartSnapshot.Items,
+	); err != nil {
+		return nil, err
+	}
 	if err := s.orderRepo.CreateOrderItems(txCtx, orderItems); err != nil {
 		return nil, err
 	}
@@ -184,6 +250,10 @@ func (s *OrderServiceImpl) persistOrderSnapshotGraph(
 		return nil, err
 	}
 
+	if err := s.createPaymentSchedule(txCtx, order, now); err != nil {
+		return nil, err
+	}
+
 	if err := s.orderHistoryRepo.CreateHistoryEntry(
 		txCtx,
 		mapper.BuildOrderCreatedHistory(
@@ -210,7 +280,7 @@ func (s *OrderServiceImpl) buildCreateOrderEntity(
 	if createCtx.cartSnapshot.Summary.Shipping != nil {
 		shippingCents = *createCtx.cartSnapshot.Summary.Shipping
 	}
-	return mapper.BuildOrderEntity(
+	order := mapper.BuildOrderEntity(
 		userID,
 		sellerID,
 		createCtx.fulfillmentType,
@@ -223,13 +293,28 @@ func (s *OrderServiceImpl) buildCreateOrderEntity(
 		createCtx.cartSnapshot.Summary.Total,
 		now,
 	)
+	// Totals above were priced for this channel by the cart snapshot.
+	if channel := productEntity.NormalizeSalesChannel(req.SalesChannel); channel != "" {
+		salesChannel := channel.String()
+		order.SalesChannel = &salesChannel
+	}
+	if req.DeliverySlot != nil {
+		start, end := req.DeliverySlot.Start.UTC(), req.DeliverySlot.End.UTC()
+		order.DeliverySlotStart = &start
+		order.DeliverySlotEnd = &end
+	}
+	order.PaymentPlan = createCtx.paymentPlan
+	order.BalanceCollection = createCtx.balanceCollection
+	return order
 }
 
 // handleCreateOrderReservation creates reservation for reservable statuses and
 // confirms it immediately when order is directly created as confirmed.
+// Stock is reserved under the allocation rules of the order's sales channel.
 func (s *OrderServiceImpl) handleCreateOrderReservation(
 	txCtx context.Context,
 	sellerID, orderID uint,
+	salesChannel string,
 	createCtx *createOrderContext,
 ) error {
 	if !shouldReserveInventoryOnCreate(createCtx.orderStatus) {
@@ -241,6 +326,7 @@ func (s *OrderServiceImpl) handleCreateOrderReservation(
 			ReferenceId:      orderID,
 			ExpiresInMinutes: reservationExpiresInMinutes,
 			Items:            buildReservationItems(createCtx.cartSnapshot.Items),
+			Channel:          salesChannel,
 		}); err != nil {
 		return err
 	}
@@ -291,14 +377,33 @@ func (s *OrderServiceImpl) UpdateOrderStatus(
 
 	prev := order.Status
 	now := time.Now().UTC()
+	balance := pendingBalance(order)
+	var purchases []productModel.DigitalPurchaseResponse
 	err = db.WithTransaction(ctx, func(txCtx context.Context) error {
-		return s.applyUpdateOrderStatusTx(txCtx, order, sellerID,
-			prev, target, now, req)
+		if err := s.applyUpdateOrderStatusTx(txCtx, order, sellerID,
+			prev, target, now, req); err != nil {
+			return err
+		}
+		purchases, err = s.deliverDigitalItemsTx(txCtx, order.ID)
+		return err
 	})
 	if err != nil {
 		return nil, err
 	}
 
+	var refunds []paymentModel.PaymentRefundResponse
+	if target == entity.ORDER_STATUS_CANCELLED {
+		refunds = s.refundCancelledOrder(ctx, order, sellerID, constants.SELLER_ROLE_NAME, req.Note)
+		s.notifyOrderCancelled(ctx, order, refunds)
+	} else if event, ok := notificationEventForStatus(target); ok {
+		s.notifyOrderStatusEvent(ctx, event, order, target)
+	}
+	// A balance collected on delivery is paid when the order completes
+	if target == entity.ORDER_STATUS_COMPLETED && balance != nil {
+		s.notifyInstallmentEvent(ctx, constants.NOTIFY_EVENT_PAYMENT_RECEIVED, order, balance)
+	}
+	s.notifyDownloadsReady(ctx, order, purchases)
+
 	return &model.UpdateStatusResponse{
 		ID:             order.ID,
 		OrderNumber:    order.OrderNumber,
@@ -306,6 +411,7 @@ func (s *OrderServiceImpl) UpdateOrderStatus(
 		Status:         target,
 		TransactionID:  req.TransactionID,
 		UpdatedAt:      now,
+		Refunds:        refunds,
 	}, nil
 }
 
@@ -341,6 +447,11 @@ func (s *OrderServiceImpl) validateUpdateOrderStatusInput(
 			}
 		}
 	}
+	if target == entity.ORDER_STATUS_COMPLETED {
+		if err := validateBalanceForCompletion(order, req); err != nil {
+			return "", err
+		}
+	}
 	return target, nil
 }
 
@@ -356,11 +467,23 @@ func (s *OrderServiceImpl) applyUpdateOrderStatusTx(
 	if err := s.orderRepo.UpdateOrderStatus(txCtx, order.ID, target); err != nil {
 		return err
 	}
-	if target == entity.ORDER_STATUS_CONFIRMED {
+	// Deposit orders are paid in full only once their balance is in
+	installment, err := s.applyDepositStatusTx(txCtx, order, target, now, req)
+	if err != nil {
+		return err
+	}
+	paidInFull := installment != nil && installment.Kind == entity.INSTALLMENT_BALANCE
+	confirmedFull := target == entity.ORDER_STATUS_CONFIRMED &&
+		order.PaymentPlan != entity.PAYMENT_PLAN_DEPOSIT
+	if paidInFull || confirmedFull {
 		if err := s.orderRepo.UpdateOrderPaidAt(txCtx, order.ID, now); err != nil {
 			return err
 		}
 	}
+	if err := s.recordStatusSaleTx(txCtx, order, installment, confirmedFull, now,
+		req); err != nil {
+		return err
+	}
 	if req.TransactionID != nil && strings.TrimSpace(*req.TransactionID) != "" {
 		if err := s.orderRepo.UpdateOrderTransactionID(
 			txCtx,
@@ -436,7 +559,8 @@ func (s *OrderServiceImpl) createSellerStatusHistoryEntry(
 	)
 }
 
-// CancelOrder performs customer-initiated cancellation with reservation release.
+// CancelOrder performs customer-initiated cancellation within the seller's cancellation
+// window, releasing the reservation and refunding what was paid.
 func (s *OrderServiceImpl) CancelOrder(
 	ctx context.Context,
 	userID uint,
@@ -450,23 +574,10 @@ func (s *OrderServiceImpl) CancelOrder(
 	if err := s.validateCancelOrderInput(order, userID); err != nil {
 		return nil, err
 	}
-
-	prev := order.Status
-	now := time.Now().UTC()
-	err = db.WithTransaction(ctx, func(txCtx context.Context) error {
-		return s.applyCancelOrderTx(txCtx, order, userID, prev, req)
-	})
-	if err != nil {
+	if err := s.validateCancellationWindow(ctx, order, time.Now().UTC()); err != nil {
 		return nil, err
 	}
-
-	return &model.UpdateStatusResponse{
-		ID:             order.ID,
-		OrderNumber:    order.OrderNumber,
-		PreviousStatus: prev,
-		Status:         entity.ORDER_STATUS_CANCELLED,
-		UpdatedAt:      now,
-	}, nil
+	return s.cancelOrder(ctx, order, userID, constants.CUSTOMER_ROLE_NAME, req)
 }
 
 // validateCancelOrderInput ensures customer ownership and cancellable state.
@@ -477,18 +588,19 @@ func (s *OrderServiceImpl) validateCancelOrderInput(
 	if order == nil || order.UserID != userID {
 		return orderError.ErrOrderNotFound
 	}
-	if order.Status != entity.ORDER_STATUS_PENDING &&
-		order.Status != entity.ORDER_STATUS_CONFIRMED {
+	if !isCancellableStatus(order.Status) {
 		return orderError.ErrOrderNotCancellable
 	}
 	return nil
 }
 
 // applyCancelOrderTx applies cancellation updates, reservation release, and audit history.
+// A customer cancelling a pending order gets their cart back.
 func (s *OrderServiceImpl) applyCancelOrderTx(
 	txCtx context.Context,
 	order *entity.Order,
-	userID uint,
+	actorID uint,
+	role string,
 	prev entity.OrderStatus,
 	req model.CancelOrderRequest,
 ) error {
@@ -512,7 +624,7 @@ func (s *OrderServiceImpl) applyCancelOrderTx(
 		return err
 	}
 
-	if prev == entity.ORDER_STATUS_PENDING {
+	if prev == entity.ORDER_STATUS_PENDING && role == constants.CUSTOMER_ROLE_NAME {
 		if err := s.reactivateCartForOrder(txCtx, order.ID); err != nil {
 			return err
 		}
@@ -524,8 +636,8 @@ func (s *OrderServiceImpl) applyCancelOrderTx(
 			order.ID,
 			prev,
 			entity.ORDER_STATUS_CANCELLED,
-			userID,
-			constants.CUSTOMER_ROLE_NAME,
+			actorID,
+			role,
 			nil,
 			nil,
 			req.Reason,
@@ -534,6 +646,42 @@ func (s *OrderServiceImpl) applyCancelOrderTx(
 	)
 }
 
+// RescheduleDeliverySlot moves the delivery window of a seller's open order and sends
+// the customer an updated calendar invite for it.
+func (s *OrderServiceImpl) RescheduleDeliverySlot(
+	ctx context.Context,
+	sellerID uint,
+	orderID uint,
+	req model.DeliverySlotRequest,
+) (*model.OrderResponse, error) {
+	order, err := s.orderRepo.FindOrderByID(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+	if order == nil || order.SellerID == nil || *order.SellerID != sellerID {
+		return nil, orderError.ErrOrderNotFound
+	}
+	if order.Status != entity.ORDER_STATUS_PENDING &&
+		order.Status != entity.ORDER_STATUS_CONFIRMED {
+		return nil, orderError.ErrDeliverySlotLocked
+	}
+	if err := orderUtils.ValidateDeliverySlot(order.FulfillmentType, req, time.Now()); err != nil {
+		return nil, err
+	}
+
+	if err := s.orderRepo.UpdateDeliverySlot(ctx, order.ID, req.Start, req.End); err != nil {
+		return nil, err
+	}
+	resp, err := s.loadCreateOrderResponse(ctx, order.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.notifyOrderResponseEvent(ctx, constants.NOTIFY_EVENT_DELIVERY_RESCHEDULED,
+		order.UserID, resp)
+	return resp, nil
+}
+
 func buildReservationItems(
 	cartItems []model.CartItemWithPricingResponse,
 ) []inventoryModel.ReservationItem {
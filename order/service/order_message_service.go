@@ -0,0 +1,317 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"ecommerce-be/common/constants"
+	commonError "ecommerce-be/common/error"
+	"ecommerce-be/common/filegateway"
+	"ecommerce-be/common/log"
+	"ecommerce-be/common/notifier"
+	fileGateway "ecommerce-be/file/gateway"
+	"ecommerce-be/order/entity"
+	orderError "ecommerce-be/order/error"
+	"ecommerce-be/order/factory"
+	"ecommerce-be/order/model"
+	"ecommerce-be/order/repository"
+	orderUtils "ecommerce-be/order/utils"
+	"ecommerce-be/order/utils/constant"
+)
+
+// OrderMessageService runs the message thread between an order's customer and its
+// seller. Anyone who can see the order can read and post to its thread.
+type OrderMessageService interface {
+	// InitAttachmentUpload validates the file metadata and returns a presigned upload ticket
+	InitAttachmentUpload(
+		ctx context.Context,
+		userID uint,
+		role string,
+		orderID uint,
+		req model.OrderMessageAttachmentRequest,
+	) (*filegateway.UploadTicket, error)
+
+	// PostMessage completes the attachments, stores the message and notifies the other side
+	PostMessage(
+		ctx context.Context,
+		userID uint,
+		role string,
+		orderID uint,
+		req model.PostOrderMessageRequest,
+	) (*model.OrderMessageResponse, error)
+
+	// GetThread returns the thread and marks the other side's messages read
+	GetThread(
+		ctx context.Context,
+		userID uint,
+		role string,
+		orderID uint,
+	) (*model.OrderMessageThreadResponse, error)
+
+	// GetTimeline returns status transitions and messages in the order they happened
+	GetTimeline(
+		ctx context.Context,
+		userID uint,
+		role string,
+		orderID uint,
+	) (*model.OrderTimelineResponse, error)
+}
+
+type OrderMessageServiceImpl struct {
+	orderRepo        repository.OrderRepository
+	orderHistoryRepo repository.OrderHistoryRepository
+	messageRepo      repository.OrderMessageRepository
+	fileGateway      filegateway.FileUploadGateway
+	notifier         notifier.Notifier
+}
+
+func NewOrderMessageService(
+	orderRepo repository.OrderRepository,
+	orderHistoryRepo repository.OrderHistoryRepository,
+	messageRepo repository.OrderMessageRepository,
+	fileGateway filegateway.FileUploadGateway,
+	notifier notifier.Notifier,
+) OrderMessageService {
+	return &OrderMessageServiceImpl{
+		orderRepo:        orderRepo,
+		orderHistoryRepo: orderHistoryRepo,
+		messageRepo:      messageRepo,
+		fileGateway:      fileGateway,
+		notifier:         notifier,
+	}
+}
+
+// InitAttachmentUpload starts a private DOCUMENT upload. Attachments are platform-scoped
+// so both the customer and the seller can resolve them.
+func (s *OrderMessageServiceImpl) InitAttachmentUpload(
+	ctx context.Context,
+	userID uint,
+	role string,
+	orderID uint,
+	req model.OrderMessageAttachmentRequest,
+) (*filegateway.UploadTicket, error) {
+	if _, err := s.findAccessibleOrder(ctx, userID, role, orderID); err != nil {
+		return nil, err
+	}
+	if err := orderUtils.ValidateOrderMessageAttachment(req.MimeType, req.SizeBytes); err != nil {
+		return nil, err
+	}
+	return s.fileGateway.InitUpload(ctx, filegateway.UploadRequest{
+		Purpose:        constant.ORDER_MESSAGE_ATTACHMENT_PURPOSE,
+		Filename:       req.Filename,
+		MimeType:       req.MimeType,
+		SizeBytes:      req.SizeBytes,
+		UploaderUserID: userID,
+	})
+}
+
+func (s *OrderMessageServiceImpl) PostMessage(
+	ctx context.Context,
+	userID uint,
+	role string,
+	orderID uint,
+	req model.PostOrderMessageRequest,
+) (*model.OrderMessageResponse, error) {
+	if err := orderUtils.ValidateOrderMessage(req.Body, req.AttachmentFileIDs); err != nil {
+		return nil, err
+	}
+	order, err := s.findAccessibleOrder(ctx, userID, role, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	files := make(map[string]*filegateway.FileDisplayInfo, len(req.AttachmentFileIDs))
+	for _, fileID := range req.AttachmentFileIDs {
+		info, err := s.completeAttachment(ctx, fileID)
+		if err != nil {
+			return nil, err
+		}
+		files[fileID] = info
+	}
+
+	message := &entity.OrderMessage{
+		OrderID:           order.ID,
+		SenderUserID:      userID,
+		SenderRole:        strings.ToUpper(strings.TrimSpace(role)),
+		FromCustomer:      orderUtils.IsCustomerSide(role),
+		Body:              strings.TrimSpace(req.Body),
+		AttachmentFileIDs: req.AttachmentFileIDs,
+	}
+	if err := s.messageRepo.Create(ctx, message); err != nil {
+		return nil, err
+	}
+
+	s.notifyRecipient(ctx, order, message)
+	resp := factory.BuildOrderMessageResponses([]entity.OrderMessage{*message}, files)
+	return &resp[0], nil
+}
+
+func (s *OrderMessageServiceImpl) GetThread(
+	ctx context.Context,
+	userID uint,
+	role string,
+	orderID uint,
+) (*model.OrderMessageThreadResponse, error) {
+	order, err := s.findAccessibleOrder(ctx, userID, role, orderID)
+	if err != nil {
+		return nil, err
+	}
+	messages, err := s.messageRepo.FindByOrderID(ctx, order.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only the parties to the order read messages; admins looking in leave them unread
+	var unread int64
+	if isOrderParty(role) {
+		otherSide := !orderUtils.IsCustomerSide(role)
+		for _, message := range messages {
+			if message.FromCustomer == otherSide && message.ReadAt == nil {
+				unread++
+			}
+		}
+		if unread > 0 {
+			if err := s.messageRepo.MarkRead(ctx, order.ID, otherSide, time.Now()); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return &model.OrderMessageThreadResponse{
+		OrderID:     order.ID,
+		OrderNumber: order.OrderNumber,
+		UnreadCount: unread,
+		Messages:    s.buildMessages(ctx, messages),
+	}, nil
+}
+
+func (s *OrderMessageServiceImpl) GetTimeline(
+	ctx context.Context,
+	userID uint,
+	role string,
+	orderID uint,
+) (*model.OrderTimelineResponse, error) {
+	order, err := s.findAccessibleOrder(ctx, userID, role, orderID)
+	if err != nil {
+		return nil, err
+	}
+	history, err := s.orderHistoryRepo.FindHistoryByOrderID(ctx, order.ID)
+	if err != nil {
+		return nil, err
+	}
+	messages, err := s.messageRepo.FindByOrderID(ctx, order.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.OrderTimelineResponse{
+		OrderID:     order.ID,
+		OrderNumber: order.OrderNumber,
+		Entries:     orderUtils.MergeOrderTimeline(history, s.buildMessages(ctx, messages)),
+	}, nil
+}
+
+func (s *OrderMessageServiceImpl) findAccessibleOrder(
+	ctx context.Context,
+	userID uint,
+	role string,
+	orderID uint,
+) (*entity.Order, error) {
+	order, err := s.orderRepo.FindOrderByID(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+	if order == nil || !canAccessOrder(order, userID, role) {
+		return nil, orderError.ErrOrderNotFound
+	}
+	return order, nil
+}
+
+// completeAttachment activates an uploaded attachment and re-checks it against the
+// policy, because the upload URL only bounds what the client declared
+func (s *OrderMessageServiceImpl) completeAttachment(
+	ctx context.Context,
+	fileID string,
+) (*filegateway.FileDisplayInfo, error) {
+	info, err := s.fileGateway.CompleteUpload(ctx, fileID, nil)
+	if err != nil {
+		if fileGateway.IsFileNotFound(err) || err == commonError.ErrFileNotAccessible {
+			return nil, orderError.ErrInvalidOrderMessageAttachment
+		}
+		return nil, err
+	}
+	if info.Purpose != constant.ORDER_MESSAGE_ATTACHMENT_PURPOSE {
+		return nil, orderError.ErrInvalidOrderMessageAttachment
+	}
+	if err := orderUtils.ValidateOrderMessageAttachment(info.MimeType, info.SizeBytes); err != nil {
+		return nil, err
+	}
+	return info, nil
+}
+
+// buildMessages resolves every attachment of the thread in one lookup
+func (s *OrderMessageServiceImpl) buildMessages(
+	ctx context.Context,
+	messages []entity.OrderMessage,
+) []model.OrderMessageResponse {
+	var fileIDs []string
+	for _, message := range messages {
+		fileIDs = append(fileIDs, message.AttachmentFileIDs...)
+	}
+
+	files := map[string]*filegateway.FileDisplayInfo{}
+	if len(fileIDs) > 0 {
+		resolved, err := s.fileGateway.GetFilesWithURLs(ctx, fileIDs, nil)
+		if err != nil {
+			log.WarnWithContext(ctx, "order messages: failed to resolve attachments: "+err.Error())
+		} else {
+			files = resolved
+		}
+	}
+	return factory.BuildOrderMessageResponses(messages, files)
+}
+
+// notifyRecipient tells the other side about a new message. Customer messages go to the
+// seller; seller and support messages go to the customer. Failures are logged only.
+func (s *OrderMessageServiceImpl) notifyRecipient(
+	ctx context.Context,
+	order *entity.Order,
+	message *entity.OrderMessage,
+) {
+	if s.notifier == nil {
+		return
+	}
+	recipientID := order.UserID
+	if message.FromCustomer {
+		if order.SellerID == nil {
+			return
+		}
+		recipientID = *order.SellerID
+	}
+
+	preview := orderUtils.MessagePreview(message.Body, constant.ORDER_MESSAGE_PREVIEW_LENGTH)
+	if preview == "" {
+		preview = constant.ORDER_MESSAGE_ATTACHMENT_PREVIEW
+	}
+	payload := map[string]any{
+		"OrderID":        order.ID,
+		"OrderNumber":    order.OrderNumber,
+		"SenderRole":     orderUtils.MessageSenderLabel(message.SenderRole),
+		"MessagePreview": preview,
+	}
+	event := constants.NOTIFY_EVENT_ORDER_MESSAGE_RECEIVED
+	if err := s.notifier.Notify(ctx, event, recipientID, payload); err != nil {
+		log.ErrorWithContext(ctx, "order: failed to queue "+event+" notification", err)
+	}
+}
+
+// isOrderParty reports whether the role is the customer or the seller of the order
+func isOrderParty(role string) bool {
+	switch strings.ToUpper(strings.TrimSpace(role)) {
+	case constants.CUSTOMER_ROLE_NAME, constants.SELLER_ROLE_NAME:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,138 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"ecommerce-be/common/constants"
+	"ecommerce-be/common/log"
+	"ecommerce-be/order/entity"
+	"ecommerce-be/order/factory"
+	"ecommerce-be/order/model"
+	orderUtils "ecommerce-be/order/utils"
+	paymentEntity "ecommerce-be/payment/entity"
+	paymentModel "ecommerce-be/payment/model"
+)
+
+// notifyOrderResponseEvent sends a best-effort notification about an order to its
+// customer. A booked delivery slot is added to the template data with its calendar
+// invite. Notification failures are logged and never fail the order operation.
+func (s *OrderServiceImpl) notifyOrderResponseEvent(
+	ctx context.Context,
+	event string,
+	userID uint,
+	order *model.OrderResponse,
+) {
+	payload := buildOrderPayload(order.ID, order.OrderNumber, order.Status, order.TotalCents)
+	addDeliverySlotPayload(payload, order, false)
+	s.sendOrderNotification(ctx, event, userID, payload)
+}
+
+// notifyOrderStatusEvent notifies about a status transition. Cancelling an order with
+// a booked slot also withdraws its calendar invite. Confirming a deposit order reports
+// the deposit as the amount paid.
+func (s *OrderServiceImpl) notifyOrderStatusEvent(
+	ctx context.Context,
+	event string,
+	order *entity.Order,
+	status entity.OrderStatus,
+) {
+	amount := order.TotalCents
+	if status == entity.ORDER_STATUS_CONFIRMED {
+		deposit := orderUtils.FindInstallment(order.PaymentInstallments, entity.INSTALLMENT_DEPOSIT)
+		if deposit != nil {
+			amount = deposit.AmountCents
+		}
+	}
+	payload := buildOrderPayload(order.ID, order.OrderNumber, status, amount)
+	if status == entity.ORDER_STATUS_CANCELLED {
+		addDeliverySlotPayload(payload, factory.BuildOrderResponseFromEntity(order, nil), true)
+	}
+	s.sendOrderNotification(ctx, event, order.UserID, payload)
+}
+
+// notifyOrderCancelled tells the customer their order was cancelled, withdrawing its
+// calendar invite, and how much is being refunded. Refunds the gateway rejected are
+// left out of the amount.
+func (s *OrderServiceImpl) notifyOrderCancelled(
+	ctx context.Context,
+	order *entity.Order,
+	refunds []paymentModel.PaymentRefundResponse,
+) {
+	payload := buildOrderPayload(order.ID, order.OrderNumber, entity.ORDER_STATUS_CANCELLED,
+		order.TotalCents)
+	addDeliverySlotPayload(payload, factory.BuildOrderResponseFromEntity(order, nil), true)
+
+	var refundedCents int64
+	for _, refund := range refunds {
+		if refund.Status != paymentEntity.RefundStatusFailed {
+			refundedCents += refund.AmountCents
+		}
+	}
+	if refundedCents > 0 {
+		payload[constants.REFUND_AMOUNT_DATA_KEY] = formatCents(refundedCents)
+	}
+	s.sendOrderNotification(ctx, constants.NOTIFY_EVENT_ORDER_CANCELLED, order.UserID, payload)
+}
+
+func (s *OrderServiceImpl) sendOrderNotification(
+	ctx context.Context,
+	event string,
+	userID uint,
+	payload map[string]any,
+) {
+	if s.notifier == nil {
+		return
+	}
+	if err := s.notifier.Notify(ctx, event, userID, payload); err != nil {
+		log.ErrorWithContext(ctx, "order: failed to queue "+event+" notification", err)
+	}
+}
+
+func buildOrderPayload(
+	orderID uint,
+	orderNumber string,
+	status entity.OrderStatus,
+	totalCents int64,
+) map[string]any {
+	return map[string]any{
+		"OrderID":     orderID,
+		"OrderNumber": orderNumber,
+		"Status":      status.String(),
+		"Amount":      formatCents(totalCents),
+	}
+}
+
+// addDeliverySlotPayload adds the formatted slot for templates and the calendar event
+// the email channel attaches as an invite. Orders without a slot are left unchanged.
+func addDeliverySlotPayload(payload map[string]any, order *model.OrderResponse, cancelled bool) {
+	if order.DeliverySlot == nil {
+		return
+	}
+	payload[constants.DELIVERY_SLOT_DATA_KEY] = orderUtils.FormatDeliverySlot(
+		order.DeliverySlot.Start, order.DeliverySlot.End)
+	payload[constants.CALENDAR_EVENT_DATA_KEY] = orderUtils.BuildDeliverySlotEvent(order, cancelled)
+}
+
+// notificationEventForStatus maps seller-driven status transitions to customer
+// notifications. Confirmation is the point the payment is recorded.
+func notificationEventForStatus(target entity.OrderStatus) (string, bool) {
+	switch target {
+	case entity.ORDER_STATUS_CONFIRMED:
+		return constants.NOTIFY_EVENT_PAYMENT_RECEIVED, true
+	case entity.ORDER_STATUS_FAILED:
+		return constants.NOTIFY_EVENT_PAYMENT_FAILED, true
+	case entity.ORDER_STATUS_CANCELLED:
+		return constants.NOTIFY_EVENT_ORDER_CANCELLED, true
+	}
+	return "", false
+}
+
+func formatCents(cents int64) string {
+	sign := ""
+	if cents < 0 {
+		sign = "-"
+		cents = -cents
+	}
+	return fmt.Sprintf("%s%d.%02d", sign, cents/100, cents%100)
+}
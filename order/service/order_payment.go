@@ -0,0 +1,234 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"ecommerce-be/common/constants"
+	"ecommerce-be/common/db"
+	"ecommerce-be/common/log"
+	inventoryEntity "ecommerce-be/inventory/entity"
+	inventoryModel "ecommerce-be/inventory/model"
+	"ecommerce-be/order/entity"
+	orderError "ecommerce-be/order/error"
+	"ecommerce-be/order/mapper"
+	"ecommerce-be/order/model"
+	orderUtils "ecommerce-be/order/utils"
+	orderConstant "ecommerce-be/order/utils/constant"
+	paymentModel "ecommerce-be/payment/model"
+)
+
+// RetryPayment starts a fresh payment for an order still waiting for one. Deposit orders
+// collect their deposit; other orders their total.
+func (s *OrderServiceImpl) RetryPayment(
+	ctx context.Context,
+	userID uint,
+	orderID uint,
+) (*paymentModel.PaymentIntentResponse, error) {
+	order, err := s.orderRepo.FindOrderByID(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+	if order == nil || order.UserID != userID || order.SellerID == nil {
+		return nil, orderError.ErrOrderNotFound
+	}
+	if order.Status != entity.ORDER_STATUS_PENDING || order.PaidAt != nil {
+		return nil, orderError.ErrOrderNotAwaitingPayment
+	}
+
+	amount := order.TotalCents
+	if order.PaymentPlan == entity.PAYMENT_PLAN_DEPOSIT {
+		deposit := orderUtils.FindInstallment(order.PaymentInstallments,
+			entity.INSTALLMENT_DEPOSIT)
+		if deposit == nil || deposit.Status != entity.INSTALLMENT_STATUS_PENDING {
+			return nil, orderError.ErrOrderNotAwaitingPayment
+		}
+		amount = deposit.AmountCents
+	}
+
+	currency, err := s.userSvc.GetPreferredCurrency(ctx, userID, *order.SellerID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.paymentIntentSvc.CreatePaymentIntent(ctx, paymentModel.CreatePaymentIntentRequest{
+		SellerID:    *order.SellerID,
+		UserID:      userID,
+		OrderID:     order.ID,
+		AmountCents: amount,
+		Currency:    currency.Code,
+	})
+}
+
+// ExpireLapsedPayments expires payments customers did not complete in time and cancels
+// their orders. Runs as a recurring cron job.
+func (s *OrderServiceImpl) ExpireLapsedPayments() {
+	ctx := context.Background()
+
+	lapsed, err := s.paymentIntentSvc.FindExpiredPayments(
+		ctx, time.Now().UTC(), orderConstant.ORDER_PAYMENT_EXPIRY_BATCH_SIZE)
+	if err != nil {
+		log.ErrorWithContext(ctx, "Cron: Failed to load lapsed order payments", err)
+		return
+	}
+	for i := range lapsed {
+		if err := s.expireLapsedPayment(ctx, lapsed[i]); err != nil {
+			log.ErrorWithContext(ctx, "Cron: Failed to expire order payment", err)
+		}
+	}
+}
+
+// expireLapsedPayment expires the payment and, when its order is still waiting to be
+// paid, releases the order's reservation and cancels it. The order is locked so a
+// payment recorded since the sweep started is left alone.
+func (s *OrderServiceImpl) expireLapsedPayment(
+	ctx context.Context,
+	payment paymentModel.ExpiredPayment,
+) error {
+	var cancelled *entity.Order
+	err := db.WithTransaction(ctx, func(txCtx context.Context) error {
+		order, err := s.orderRepo.FindOrderByIDForUpdate(txCtx, payment.OrderID)
+		if err != nil {
+			return err
+		}
+		expired, err := s.paymentIntentSvc.ExpirePayment(txCtx, payment.TransactionID)
+		if err != nil || !expired {
+			return err
+		}
+		if order == nil || order.Status != entity.ORDER_STATUS_PENDING || order.PaidAt != nil {
+			return nil
+		}
+
+		if err := s.orderRepo.UpdateOrderStatus(
+			txCtx, order.ID, entity.ORDER_STATUS_CANCELLED); err != nil {
+			return err
+		}
+		if err := s.inventoryReserveSvc.UpdateReservationStatus(txCtx, payment.SellerID,
+			inventoryModel.UpdateReservationStatusRequest{
+				ReferenceId: order.ID,
+				Status:      inventoryEntity.ResCancelled,
+			}); err != nil {
+			return err
+		}
+
+		note := orderConstant.ORDER_PAYMENT_EXPIRED_NOTE
+		if err := s.orderHistoryRepo.CreateHistoryEntry(
+			txCtx,
+			mapper.BuildSystemTransitionHistory(
+				order.ID,
+				order.Status,
+				entity.ORDER_STATUS_CANCELLED,
+				orderConstant.ORDER_HISTORY_SYSTEM_ROLE,
+				&note,
+			),
+		); err != nil {
+			return err
+		}
+		cancelled = order
+		return nil
+	})
+	if err != nil || cancelled == nil {
+		return err
+	}
+
+	s.notifyOrderStatusEvent(ctx, constants.NOTIFY_EVENT_ORDER_CANCELLED, cancelled,
+		entity.ORDER_STATUS_CANCELLED)
+	return nil
+}
+
+// applyLineCommissions records the platform commission in force on each new order line,
+// charged on the lines after the order's discount. cartItems are the cart lines the
+// items were built from, in the same order; they carry each line's own promotion discount.
+func (s *OrderServiceImpl) applyLineCommissions(
+	txCtx context.Context,
+	sellerID uint,
+	order *entity.Order,
+	items []entity.OrderItem,
+	cartItems []model.CartItemWithPricingResponse,
+) error {
+	lines := make([]paymentModel.CommissionLine, 0, len(items))
+	for i, item := range items {
+		line := paymentModel.CommissionLine{
+			Quantity:       item.Quantity,
+			LineTotalCents: item.LineTotalCents,
+		}
+		if i < len(cartItems) {
+			line.DiscountCents = cartItems[i].TotalPromotionDiscount
+		}
+		if item.VariantID != nil {
+			line.VariantID = *item.VariantID
+		}
+		lines = append(lines, line)
+	}
+	commissions, err := s.commissionSvc.CalculateCommissions(
+		txCtx, sellerID, lines, order.DiscountCents,
+	)
+	if err != nil {
+		return err
+	}
+	for i := range items {
+		items[i].CommissionCents = commissions[i].CommissionCents
+		items[i].CommissionRuleID = commissions[i].RuleID
+	}
+	return nil
+}
+
+// recordStatusSaleTx posts the money a status transition collected to the seller's
+// ledger: the installment it paid on a deposit order, or the total of a full order being
+// confirmed. Orders paid before confirmation, such as marketplace orders, were collected
+// elsewhere and are not posted.
+func (s *OrderServiceImpl) recordStatusSaleTx(
+	txCtx context.Context,
+	order *entity.Order,
+	installment *entity.OrderPaymentInstallment,
+	confirmedFull bool,
+	now time.Time,
+	req model.UpdateOrderStatusRequest,
+) error {
+	reference := order.TransactionID
+	if req.TransactionID != nil && strings.TrimSpace(*req.TransactionID) != "" {
+		reference = *req.TransactionID
+	}
+	switch {
+	case installment != nil:
+		if installment.TransactionID != nil {
+			reference = *installment.TransactionID
+		}
+		return s.recordSaleTx(txCtx, order, installment.AmountCents,
+			orderUtils.InstallmentCommissionCents(order, installment), reference, now)
+	case confirmedFull && order.PaidAt == nil:
+		return s.recordSaleTx(txCtx, order, order.TotalCents,
+			orderUtils.OrderCommissionCents(order), reference, now)
+	default:
+		return nil
+	}
+}
+
+// recordSaleTx posts a payment collected for the order to its seller's ledger, in the
+// currency the customer was charged in
+func (s *OrderServiceImpl) recordSaleTx(
+	txCtx context.Context,
+	order *entity.Order,
+	amountCents int64,
+	commissionCents int64,
+	reference string,
+	paidAt time.Time,
+) error {
+	if order.SellerID == nil || amountCents <= 0 {
+		return nil
+	}
+	currency, err := s.userSvc.GetPreferredCurrency(txCtx, order.UserID, *order.SellerID)
+	if err != nil {
+		return err
+	}
+	return s.sellerLedgerSvc.RecordSale(txCtx, paymentModel.RecordSaleRequest{
+		SellerID:         *order.SellerID,
+		OrderID:          order.ID,
+		Currency:         currency.Code,
+		AmountCents:      amountCents,
+		CommissionCents:  commissionCents,
+		PaymentReference: strings.TrimSpace(reference),
+		OccurredAt:       paidAt,
+	})
+}
@@ -11,6 +11,7 @@ import (
 	orderError "ecommerce-be/order/error"
 	"ecommerce-be/order/factory"
 	"ecommerce-be/order/model"
+	orderUtils "ecommerce-be/order/utils"
 	userModel "ecommerce-be/user/model"
 	userConstant "ecommerce-be/user/utils/constant"
 )
@@ -64,20 +65,27 @@ func (s *OrderServiceImpl) ListOrders(
 		return nil, err
 	}
 
+	unread, err := s.countUnreadMessages(ctx, orders, role)
+	if err != nil {
+		return nil, err
+	}
+
 	out := make([]model.OrderListResponse, 0, len(orders))
 	includeCustomer := shouldIncludeCustomer(role)
 	for _, order := range orders {
 		row := model.OrderListResponse{
-			ID:              order.ID,
-			OrderNumber:     order.OrderNumber,
-			Status:          order.Status,
-			TotalCents:      order.TotalCents,
-			SubtotalCents:   order.SubtotalCents,
-			DiscountCents:   order.DiscountCents,
-			FulfillmentType: order.FulfillmentType,
-			PlacedAt:        order.PlacedAt,
-			PaidAt:          order.PaidAt,
-			CreatedAt:       order.CreatedAt,
+			ID:                order.ID,
+			OrderNumber:       order.OrderNumber,
+			Status:            order.Status,
+			TotalCents:        order.TotalCents,
+			SubtotalCents:     order.SubtotalCents,
+			DiscountCents:     order.DiscountCents,
+			FulfillmentType:   order.FulfillmentType,
+			FulfillmentStatus: order.FulfillmentStatus,
+			PlacedAt:          order.PlacedAt,
+			PaidAt:            order.PaidAt,
+			CreatedAt:         order.CreatedAt,
+			UnreadMessages:    unread[order.ID],
 		}
 		if includeCustomer {
 			customer, _ := s.buildOrderCustomer(ctx, order.UserID)
@@ -92,6 +100,27 @@ func (s *OrderServiceImpl) ListOrders(
 	}, nil
 }
 
+// countUnreadMessages counts, per order, the thread messages from the other side the
+// caller has not read. Admins are not a party to the thread and get no counts.
+func (s *OrderServiceImpl) countUnreadMessages(
+	ctx context.Context,
+	orders []entity.Order,
+	role string,
+) (map[uint]int64, error) {
+	if len(orders) == 0 || !isOrderParty(role) {
+		return map[uint]int64{}, nil
+	}
+	orderIDs := make([]uint, 0, len(orders))
+	for _, order := range orders {
+		orderIDs = append(orderIDs, order.ID)
+	}
+	return s.orderMessageRepo.CountUnreadByOrderIDs(
+		ctx,
+		orderIDs,
+		!orderUtils.IsCustomerSide(role),
+	)
+}
+
 func canAccessOrder(order *entity.Order, userID uint, role string) bool {
 	switch strings.ToUpper(strings.TrimSpace(role)) {
 	case constants.CUSTOMER_ROLE_NAME:
@@ -3,10 +3,16 @@ package service
 import (
 	"context"
 
+	"ecommerce-be/common/notifier"
+	"ecommerce-be/common/screening"
 	inventoryService "ecommerce-be/inventory/service"
 	"ecommerce-be/order/entity"
 	"ecommerce-be/order/model"
 	"ecommerce-be/order/repository"
+	paymentModel "ecommerce-be/payment/model"
+	paymentService "ecommerce-be/payment/service"
+	productModel "ecommerce-be/product/model"
+	productService "ecommerce-be/product/service"
 	userModel "ecommerce-be/user/model"
 	userRepository "ecommerce-be/user/repository"
 	userService "ecommerce-be/user/service"
@@ -43,41 +49,136 @@ type OrderService interface {
 		orderID uint,
 		req model.CancelOrderRequest,
 	) (*model.UpdateStatusResponse, error)
+	// SellerCancelOrder cancels one of the seller's open orders. Unlike customers,
+	// sellers are not bound by the cancellation window.
+	SellerCancelOrder(
+		ctx context.Context,
+		sellerID uint,
+		orderID uint,
+		req model.CancelOrderRequest,
+	) (*model.UpdateStatusResponse, error)
+	RescheduleDeliverySlot(
+		ctx context.Context,
+		sellerID uint,
+		orderID uint,
+		req model.DeliverySlotRequest,
+	) (*model.OrderResponse, error)
+	RecordBalancePayment(
+		ctx context.Context,
+		sellerID uint,
+		orderID uint,
+		req model.RecordBalancePaymentRequest,
+	) (*model.OrderResponse, error)
+	// RecordFulfillment assigns quantities of the seller's order items to a new shipment and
+	// updates the order's fulfillment status. No lines assigns everything left to fulfill.
+	// Call it inside the transaction that saves the shipment.
+	RecordFulfillment(
+		ctx context.Context,
+		sellerID uint,
+		orderID uint,
+		lines []model.FulfillmentLine,
+	) (*model.FulfillmentResult, error)
+	// ProcessDepositBalances reminds customers of balances falling due and cancels
+	// orders whose balance is past due. Runs as a recurring cron job.
+	ProcessDepositBalances()
+	// RetryPayment starts a fresh gateway payment for the customer's unpaid pending order,
+	// replacing any payment still pending for it
+	RetryPayment(
+		ctx context.Context,
+		userID uint,
+		orderID uint,
+	) (*paymentModel.PaymentIntentResponse, error)
+	// ExpireLapsedPayments expires order payments left pending past their window,
+	// releasing the orders' reservations and cancelling them. Runs as a recurring cron job.
+	ExpireLapsedPayments()
+	// GetOrderDownloads returns the download links, license keys and remaining downloads
+	// of the digital items of the customer's paid order
+	GetOrderDownloads(
+		ctx context.Context,
+		userID uint,
+		orderID uint,
+	) ([]productModel.DigitalPurchaseResponse, error)
 }
 
 type OrderServiceImpl struct {
 	cartSvc             CartService
 	orderRepo           repository.OrderRepository
 	orderHistoryRepo    repository.OrderHistoryRepository
+	orderPaymentRepo    repository.OrderPaymentRepository
+	orderMessageRepo    repository.OrderMessageRepository
 	inventoryReserveSvc inventoryService.InventoryReservationService
+	digitalDeliverySvc  productService.DigitalDeliveryService
 	addressSvc          userService.AddressService
+	sellerSettingsSvc   userService.SellerSettingsService
+	paymentRefundSvc    paymentService.PaymentRefundService
+	paymentIntentSvc    paymentService.PaymentIntentService
+	sellerLedgerSvc     paymentService.SellerLedgerService
+	commissionSvc       paymentService.CommissionService
+	userSvc             userService.UserService
 	userRepo            userRepository.UserRepository
+	countryRepo         userRepository.CountryRepository
+	notifier            notifier.Notifier
+	screener            screening.Screener
+	// highValueOrderCents is the order total at or above which an order is screened
+	highValueOrderCents int64
+	depositPolicy       DepositPolicy
 }
 
 // createOrderContext carries validated inputs and locked resources required to create an order.
 type createOrderContext struct {
 	fulfillmentType entity.FulfillmentType
 	orderStatus     entity.OrderStatus
-	cartSnapshot    *model.CartResponse
-	lockedCart      *entity.Cart
-	shippingAddress *userModel.AddressResponse
-	billingAddress  *userModel.AddressResponse
+	paymentPlan     entity.PaymentPlan
+	// balanceCollection is set for deposit orders only
+	balanceCollection *entity.BalanceCollection
+	cartSnapshot      *model.CartResponse
+	lockedCart        *entity.Cart
+	shippingAddress   *userModel.AddressResponse
+	billingAddress    *userModel.AddressResponse
 }
 
 func NewOrderService(
 	cartSvc CartService,
 	orderRepo repository.OrderRepository,
 	orderHistoryRepo repository.OrderHistoryRepository,
+	orderPaymentRepo repository.OrderPaymentRepository,
+	orderMessageRepo repository.OrderMessageRepository,
 	inventoryReserveSvc inventoryService.InventoryReservationService,
+	digitalDeliverySvc productService.DigitalDeliveryService,
 	addressSvc userService.AddressService,
+	sellerSettingsSvc userService.SellerSettingsService,
+	paymentRefundSvc paymentService.PaymentRefundService,
+	paymentIntentSvc paymentService.PaymentIntentService,
+	sellerLedgerSvc paymentService.SellerLedgerService,
+	commissionSvc paymentService.CommissionService,
+	userSvc userService.UserService,
 	userRepo userRepository.UserRepository,
+	countryRepo userRepository.CountryRepository,
+	notifier notifier.Notifier,
+	screener screening.Screener,
+	highValueOrderCents int64,
+	depositPolicy DepositPolicy,
 ) OrderService {
 	return &OrderServiceImpl{
 		cartSvc:             cartSvc,
 		orderRepo:           orderRepo,
 		orderHistoryRepo:    orderHistoryRepo,
+		orderPaymentRepo:    orderPaymentRepo,
+		orderMessageRepo:    orderMessageRepo,
 		inventoryReserveSvc: inventoryReserveSvc,
+		digitalDeliverySvc:  digitalDeliverySvc,
 		addressSvc:          addressSvc,
+		sellerSettingsSvc:   sellerSettingsSvc,
+		paymentRefundSvc:    paymentRefundSvc,
+		paymentIntentSvc:    paymentIntentSvc,
+		sellerLedgerSvc:     sellerLedgerSvc,
+		commissionSvc:       commissionSvc,
+		userSvc:             userSvc,
 		userRepo:            userRepo,
+		countryRepo:         countryRepo,
+		notifier:            notifier,
+		screener:            screener,
+		highValueOrderCents: highValueOrderCents,
+		depositPolicy:       depositPolicy,
 	}
 }
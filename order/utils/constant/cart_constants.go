@@ -21,3 +21,9 @@ const (
 	FAILED_TO_UPDATE_CART_RECORD_MSG = "Failed to update record"
 	FAILED_TO_DELETE_CART_RECORD_MSG = "Failed to delete record"
 )
+
+// Cart query parameters
+const (
+	// SALES_CHANNEL_QUERY_PARAM selects the channel whose prices apply (?channel=pos)
+	SALES_CHANNEL_QUERY_PARAM = "channel"
+)
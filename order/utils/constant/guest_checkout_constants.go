@@ -0,0 +1,28 @@
+package constant
+
+const (
+	GUEST_ORDER_CREATED_MSG         = "Guest order placed successfully"
+	GUEST_ORDER_FETCHED_MSG         = "Guest order fetched successfully"
+	GUEST_ORDER_CLAIM_REQUESTED_MSG = "Claim link sent to your email"
+	GUEST_ORDER_CLAIM_CONFIRMED_MSG = "Guest orders added to your account"
+)
+
+const (
+	FAILED_TO_CREATE_GUEST_ORDER_MSG        = "Failed to place guest order"
+	FAILED_TO_FETCH_GUEST_ORDER_MSG         = "Failed to fetch guest order"
+	FAILED_TO_REQUEST_GUEST_ORDER_CLAIM_MSG = "Failed to request guest order claim"
+	FAILED_TO_CONFIRM_GUEST_ORDER_CLAIM_MSG = "Failed to claim guest orders"
+)
+
+const (
+	GUEST_ORDER_CLAIM_FIELD_NAME = "claim"
+
+	// GUEST_ORDER_CLAIM_TOKEN_PREFIX marks claim tokens so leaked ones are recognisable
+	GUEST_ORDER_CLAIM_TOKEN_PREFIX = "goc_"
+
+	// GUEST_ORDER_CLAIM_TOKEN_RANDOM_BYTES is the entropy of a claim token
+	GUEST_ORDER_CLAIM_TOKEN_RANDOM_BYTES = 32
+
+	// GUEST_ORDER_CLAIM_TOKEN_QUERY_PARAM carries the claim token in the emailed link
+	GUEST_ORDER_CLAIM_TOKEN_QUERY_PARAM = "token"
+)
@@ -0,0 +1,44 @@
+package constant
+
+import "time"
+
+const (
+	MARKETPLACE_CONNECTION_CREATED_MSG     = "Marketplace connection created successfully"
+	MARKETPLACE_CONNECTIONS_LISTED_MSG     = "Marketplace connections listed successfully"
+	MARKETPLACE_CONNECTION_DEACTIVATED_MSG = "Marketplace connection deactivated successfully"
+	MARKETPLACE_WEBHOOK_PROCESSED_MSG      = "Marketplace webhook processed successfully"
+)
+
+const (
+	FAILED_TO_CREATE_MARKETPLACE_CONNECTION_MSG     = "Failed to create marketplace connection"
+	FAILED_TO_LIST_MARKETPLACE_CONNECTIONS_MSG      = "Failed to list marketplace connections"
+	FAILED_TO_DEACTIVATE_MARKETPLACE_CONNECTION_MSG = "Failed to deactivate marketplace connection"
+	FAILED_TO_PROCESS_MARKETPLACE_WEBHOOK_MSG       = "Failed to process marketplace webhook"
+)
+
+const (
+	// MARKETPLACE_SIGNATURE_HEADER carries the hex HMAC-SHA256 of the raw webhook body,
+	// keyed with the connection's webhook secret
+	MARKETPLACE_SIGNATURE_HEADER = "X-Marketplace-Signature"
+
+	// MARKETPLACE_WEBHOOK_MAX_BODY_BYTES caps webhook bodies read before signature checks
+	MARKETPLACE_WEBHOOK_MAX_BODY_BYTES = 1 << 20
+
+	// MARKETPLACE_SHIPMENT_SYNC_MAX_ATTEMPTS bounds retries of failed shipment confirmations
+	MARKETPLACE_SHIPMENT_SYNC_MAX_ATTEMPTS = 5
+
+	// MARKETPLACE_SHIPMENT_SYNC_BATCH_SIZE is the number of failed confirmations retried per run
+	MARKETPLACE_SHIPMENT_SYNC_BATCH_SIZE = 50
+
+	// MARKETPLACE_SHIPMENT_SYNC_JOB_NAME identifies the shipment confirmation retry job in cron logs
+	MARKETPLACE_SHIPMENT_SYNC_JOB_NAME = "marketplace_shipment_sync_retry"
+
+	// MARKETPLACE_SHIPMENT_SYNC_INTERVAL is how often failed confirmations are retried
+	MARKETPLACE_SHIPMENT_SYNC_INTERVAL = 10 * time.Minute
+
+	// MARKETPLACE_BUYER_EMAIL_DOMAIN is used for buyers whose marketplace hides their email
+	MARKETPLACE_BUYER_EMAIL_DOMAIN = "marketplace.invalid"
+
+	// MARKETPLACE_LINE_ITEM_ATTRIBUTE stores the external line id on order_item.attributes
+	MARKETPLACE_LINE_ITEM_ATTRIBUTE = "marketplaceLineItemId"
+)
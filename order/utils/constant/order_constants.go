@@ -1,5 +1,7 @@
 package constant
 
+import "time"
+
 const (
 	ORDER_CREATED_MSG        = "Order placed successfully"
 	ORDER_FETCHED_MSG        = "Order fetched successfully"
@@ -16,3 +18,66 @@ const (
 	FAILED_TO_CANCEL_ORDER_MSG        = "Failed to cancel order"
 )
 
+const (
+	DELIVERY_SLOT_RESCHEDULED_MSG          = "Delivery slot rescheduled successfully"
+	FAILED_TO_RESCHEDULE_DELIVERY_SLOT_MSG = "Failed to reschedule delivery slot"
+)
+
+const (
+	// DELIVERY_SLOT_CALENDAR_UID_FORMAT keeps one calendar UID per order so reschedules
+	// replace the invite the customer already accepted
+	DELIVERY_SLOT_CALENDAR_UID_FORMAT = "order-%d-delivery-slot@ecommerce-be"
+
+	DELIVERY_SLOT_CALENDAR_SUMMARY_FORMAT = "Delivery of order %s"
+	PICKUP_SLOT_CALENDAR_SUMMARY_FORMAT   = "Pickup of order %s"
+)
+
+const (
+	BALANCE_PAYMENT_RECORDED_MSG         = "Balance payment recorded successfully"
+	FAILED_TO_RECORD_BALANCE_PAYMENT_MSG = "Failed to record balance payment"
+)
+
+const (
+	ORDER_PAYMENT_STARTED_MSG   = "Payment started successfully"
+	FAILED_TO_RETRY_PAYMENT_MSG = "Failed to start payment"
+)
+
+const (
+	ORDER_DOWNLOADS_FETCHED_MSG         = "Order downloads fetched successfully"
+	FAILED_TO_FETCH_ORDER_DOWNLOADS_MSG = "Failed to fetch order downloads"
+)
+
+const (
+	// ORDER_HISTORY_SYSTEM_ROLE marks history entries written by background jobs
+	ORDER_HISTORY_SYSTEM_ROLE = "system"
+
+	// ORDER_BALANCE_EXPIRED_NOTE is the history note of orders cancelled for an unpaid balance
+	ORDER_BALANCE_EXPIRED_NOTE = "Balance not paid by its due date"
+
+	// ORDER_DEPOSIT_BALANCE_BATCH_SIZE is the number of balances reminded or expired per run
+	ORDER_DEPOSIT_BALANCE_BATCH_SIZE = 100
+
+	// ORDER_DEPOSIT_BALANCE_JOB_NAME identifies the balance reminder and expiry job in cron logs
+	ORDER_DEPOSIT_BALANCE_JOB_NAME = "order_deposit_balance_sweep"
+
+	// ORDER_DEPOSIT_BALANCE_INTERVAL is how often balances are reminded and expired
+	ORDER_DEPOSIT_BALANCE_INTERVAL = 15 * time.Minute
+
+	// ORDER_BALANCE_DUE_DATE_LAYOUT formats the balance due date in reminders
+	ORDER_BALANCE_DUE_DATE_LAYOUT = "Mon, 02 Jan 2006 15:04 MST"
+
+	// ORDER_BALANCE_DUE_DATE_DATA_KEY carries the formatted due date for templates
+	ORDER_BALANCE_DUE_DATE_DATA_KEY = "DueDate"
+
+	// ORDER_PAYMENT_EXPIRED_NOTE is the history note of orders cancelled for a lapsed payment
+	ORDER_PAYMENT_EXPIRED_NOTE = "Payment not completed in time"
+
+	// ORDER_PAYMENT_EXPIRY_BATCH_SIZE is the number of lapsed payments expired per run
+	ORDER_PAYMENT_EXPIRY_BATCH_SIZE = 100
+
+	// ORDER_PAYMENT_EXPIRY_JOB_NAME identifies the payment expiry job in cron logs
+	ORDER_PAYMENT_EXPIRY_JOB_NAME = "order_payment_expiry_sweep"
+
+	// ORDER_PAYMENT_EXPIRY_INTERVAL is how often lapsed payments are expired
+	ORDER_PAYMENT_EXPIRY_INTERVAL = 5 * time.Minute
+)
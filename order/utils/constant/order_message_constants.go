@@ -0,0 +1,46 @@
+package constant
+
+const (
+	ORDER_MESSAGE_POSTED_MSG            = "Message posted successfully"
+	ORDER_MESSAGES_FETCHED_MSG          = "Messages fetched successfully"
+	ORDER_MESSAGE_ATTACHMENT_UPLOAD_MSG = "Attachment upload initialized successfully"
+	ORDER_TIMELINE_FETCHED_MSG          = "Order timeline fetched successfully"
+)
+
+const (
+	FAILED_TO_POST_ORDER_MESSAGE_MSG      = "Failed to post message"
+	FAILED_TO_FETCH_ORDER_MESSAGES_MSG    = "Failed to fetch messages"
+	FAILED_TO_INIT_MESSAGE_ATTACHMENT_MSG = "Failed to initialize attachment upload"
+	FAILED_TO_FETCH_ORDER_TIMELINE_MSG    = "Failed to fetch order timeline"
+)
+
+const (
+	// ORDER_MESSAGE_ATTACHMENT_PURPOSE is the file purpose of message attachments. It
+	// mirrors the file module's DOCUMENT policy so callers get an order error before a
+	// presigned URL is issued.
+	ORDER_MESSAGE_ATTACHMENT_PURPOSE = "DOCUMENT"
+
+	// ORDER_MESSAGE_ATTACHMENT_MAX_SIZE_BYTES is the largest attachment accepted
+	ORDER_MESSAGE_ATTACHMENT_MAX_SIZE_BYTES int64 = 25 * 1024 * 1024
+
+	// ORDER_MESSAGE_MAX_ATTACHMENTS caps the files attached to one message
+	ORDER_MESSAGE_MAX_ATTACHMENTS = 5
+
+	// ORDER_MESSAGE_MAX_BODY_LENGTH caps the characters of one message
+	ORDER_MESSAGE_MAX_BODY_LENGTH = 4000
+
+	// ORDER_MESSAGE_PREVIEW_LENGTH is the characters of a message quoted in notifications
+	ORDER_MESSAGE_PREVIEW_LENGTH = 140
+
+	// ORDER_MESSAGE_ATTACHMENT_PREVIEW stands in for the preview of attachment-only messages
+	ORDER_MESSAGE_ATTACHMENT_PREVIEW = "Sent an attachment"
+)
+
+// ORDER_MESSAGE_ATTACHMENT_MIME_TYPES are the formats accepted as message attachments
+var ORDER_MESSAGE_ATTACHMENT_MIME_TYPES = []string{"application/pdf", "image/jpeg", "image/png"}
+
+// Timeline entry types
+const (
+	ORDER_TIMELINE_STATUS  = "STATUS"
+	ORDER_TIMELINE_MESSAGE = "MESSAGE"
+)
@@ -0,0 +1,87 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"ecommerce-be/common/calendar"
+	"ecommerce-be/order/entity"
+	orderError "ecommerce-be/order/error"
+	"ecommerce-be/order/model"
+	"ecommerce-be/order/utils/constant"
+)
+
+// ValidateDeliverySlot checks that the fulfillment type can be scheduled and that the
+// window is in the future with its end after its start.
+func ValidateDeliverySlot(
+	fulfillmentType entity.FulfillmentType,
+	slot model.DeliverySlotRequest,
+	now time.Time,
+) error {
+	if !fulfillmentType.SupportsDeliverySlot() {
+		return orderError.ErrDeliverySlotNotAllowed
+	}
+	if slot.Start.IsZero() || !slot.End.After(slot.Start) || !slot.Start.After(now) {
+		return orderError.ErrInvalidDeliverySlot
+	}
+	return nil
+}
+
+const deliverySlotDateLayout = "Mon, 02 Jan 2006 15:04"
+
+// FormatDeliverySlot renders a slot for notification templates, e.g.
+// "Mon, 02 Mar 2026 10:00-12:00 UTC". Windows spanning midnight repeat the date.
+func FormatDeliverySlot(start, end time.Time) string {
+	start, end = start.UTC(), end.UTC()
+	if start.YearDay() == end.YearDay() && start.Year() == end.Year() {
+		return start.Format(deliverySlotDateLayout) + "-" + end.Format("15:04 MST")
+	}
+	return start.Format(deliverySlotDateLayout) + " - " + end.Format(deliverySlotDateLayout+" MST")
+}
+
+// BuildDeliverySlotEvent builds the calendar invite for an order's slot. A cancelled
+// invite carries the next sequence so clients accept it over the last update.
+func BuildDeliverySlotEvent(order *model.OrderResponse, cancelled bool) calendar.Event {
+	slot := order.DeliverySlot
+	summaryFormat := constant.DELIVERY_SLOT_CALENDAR_SUMMARY_FORMAT
+	if order.FulfillmentType == entity.BOPIS {
+		summaryFormat = constant.PICKUP_SLOT_CALENDAR_SUMMARY_FORMAT
+	}
+
+	sequence := slot.Sequence
+	if cancelled {
+		sequence++
+	}
+
+	return calendar.Event{
+		UID:       fmt.Sprintf(constant.DELIVERY_SLOT_CALENDAR_UID_FORMAT, order.ID),
+		Sequence:  sequence,
+		Start:     slot.Start,
+		End:       slot.End,
+		Summary:   fmt.Sprintf(summaryFormat, order.OrderNumber),
+		Location:  shippingLocation(order.Addresses),
+		Cancelled: cancelled,
+	}
+}
+
+// shippingLocation joins the non-empty parts of the order's shipping address
+func shippingLocation(addresses []model.OrderAddressResponse) string {
+	for _, address := range addresses {
+		if address.Type != entity.ORDER_ADDR_SHIPPING {
+			continue
+		}
+		parts := make([]string, 0, 3)
+		for _, part := range []string{
+			address.Address,
+			address.City,
+			strings.TrimSpace(address.State + " " + address.ZipCode),
+		} {
+			if part = strings.TrimSpace(part); part != "" {
+				parts = append(parts, part)
+			}
+		}
+		return strings.Join(parts, ", ")
+	}
+	return ""
+}
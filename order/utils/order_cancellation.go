@@ -0,0 +1,75 @@
+package utils
+
+import (
+	"strings"
+	"time"
+
+	"ecommerce-be/order/entity"
+)
+
+// CancellationRefund is money a cancelled order collected through one payment
+type CancellationRefund struct {
+	// PaymentReference is the transaction ID the payment was recorded with
+	PaymentReference string
+	AmountCents      int64
+}
+
+// CancellationWindowOpen reports whether a customer may still cancel an order placed at
+// placedAt when the seller allows windowMinutes for it. A zero window never closes.
+func CancellationWindowOpen(placedAt time.Time, windowMinutes int, now time.Time) bool {
+	if windowMinutes <= 0 {
+		return true
+	}
+	return !now.After(placedAt.Add(time.Duration(windowMinutes) * time.Minute))
+}
+
+// OrderPlacedAt is when the order was placed, falling back to when it was created
+func OrderPlacedAt(order *entity.Order) time.Time {
+	if order.PlacedAt != nil {
+		return *order.PlacedAt
+	}
+	return order.CreatedAt
+}
+
+// BuildCancellationRefunds lists the payments to refund when an order is cancelled: the
+// paid installments of a deposit order, or the full total of a paid order. Payments
+// without a transaction ID were taken offline and are left to the seller.
+func BuildCancellationRefunds(order *entity.Order) []CancellationRefund {
+	var refunds []CancellationRefund
+	add := func(reference string, amount int64) {
+		reference = strings.TrimSpace(reference)
+		if reference == "" || amount <= 0 {
+			return
+		}
+		for i := range refunds {
+			if refunds[i].PaymentReference == reference {
+				refunds[i].AmountCents += amount
+				return
+			}
+		}
+		refunds = append(refunds, CancellationRefund{
+			PaymentReference: reference,
+			AmountCents:      amount,
+		})
+	}
+
+	if order.PaymentPlan == entity.PAYMENT_PLAN_DEPOSIT {
+		for _, installment := range order.PaymentInstallments {
+			if installment.Status != entity.INSTALLMENT_STATUS_PAID {
+				continue
+			}
+			// The deposit is recorded with the order's transaction ID on confirmation
+			reference := order.TransactionID
+			if installment.TransactionID != nil {
+				reference = *installment.TransactionID
+			}
+			add(reference, installment.AmountCents)
+		}
+		return refunds
+	}
+
+	if order.PaidAt != nil {
+		add(order.TransactionID, order.TotalCents)
+	}
+	return refunds
+}
@@ -0,0 +1,37 @@
+package utils
+
+import "ecommerce-be/order/entity"
+
+// OrderCommissionCents is the platform commission recorded on the order's lines
+func OrderCommissionCents(order *entity.Order) int64 {
+	var total int64
+	for _, item := range order.Items {
+		total += item.CommissionCents
+	}
+	return total
+}
+
+// InstallmentCommissionCents is the part of the order's commission carried by one of its
+// payments, in proportion to what the payment collects. The balance carries whatever the
+// deposit did not, so the shares always add up to the order's commission.
+func InstallmentCommissionCents(
+	order *entity.Order,
+	installment *entity.OrderPaymentInstallment,
+) int64 {
+	total := OrderCommissionCents(order)
+	if installment.Kind == entity.INSTALLMENT_BALANCE {
+		deposit := FindInstallment(order.PaymentInstallments, entity.INSTALLMENT_DEPOSIT)
+		if deposit == nil {
+			return total
+		}
+		return total - commissionShare(total, deposit.AmountCents, order.TotalCents)
+	}
+	return commissionShare(total, installment.AmountCents, order.TotalCents)
+}
+
+func commissionShare(commissionCents, amountCents, orderTotalCents int64) int64 {
+	if orderTotalCents <= 0 || amountCents >= orderTotalCents {
+		return commissionCents
+	}
+	return commissionCents * amountCents / orderTotalCents
+}
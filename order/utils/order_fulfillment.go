@@ -0,0 +1,101 @@
+package utils
+
+import (
+	"sort"
+
+	"ecommerce-be/order/entity"
+	orderError "ecommerce-be/order/error"
+	"ecommerce-be/order/model"
+)
+
+// RemainingQuantity is how many units of the item are not in a shipment yet
+func RemainingQuantity(item entity.OrderItem) int {
+	return max(item.Quantity-item.FulfilledQuantity, 0)
+}
+
+// ResolveFulfillmentLines checks the lines a shipment fulfills against what is left of
+// each order item, merging lines for the same item. No lines means everything left.
+func ResolveFulfillmentLines(
+	items []entity.OrderItem,
+	lines []model.FulfillmentLine,
+) ([]model.FulfillmentLine, error) {
+	if len(lines) == 0 {
+		return remainingLines(items)
+	}
+
+	remaining := make(map[uint]int, len(items))
+	for _, item := range items {
+		remaining[item.ID] = RemainingQuantity(item)
+	}
+
+	resolved := make([]model.FulfillmentLine, 0, len(lines))
+	index := make(map[uint]int, len(lines))
+	for _, line := range lines {
+		left, ok := remaining[line.OrderItemID]
+		if !ok || line.Quantity <= 0 {
+			return nil, orderError.ErrInvalidFulfillmentItem(line.OrderItemID)
+		}
+		i, seen := index[line.OrderItemID]
+		if !seen {
+			i = len(resolved)
+			index[line.OrderItemID] = i
+			resolved = append(resolved, model.FulfillmentLine{OrderItemID: line.OrderItemID})
+		}
+		resolved[i].Quantity += line.Quantity
+		if resolved[i].Quantity > left {
+			return nil, orderError.ErrFulfillmentExceedsOrdered(line.OrderItemID, left)
+		}
+	}
+	return resolved, nil
+}
+
+// remainingLines fulfills every unit still left, in item order
+func remainingLines(items []entity.OrderItem) ([]model.FulfillmentLine, error) {
+	var lines []model.FulfillmentLine
+	for _, item := range items {
+		if left := RemainingQuantity(item); left > 0 {
+			lines = append(lines, model.FulfillmentLine{OrderItemID: item.ID, Quantity: left})
+		}
+	}
+	if len(lines) == 0 {
+		return nil, orderError.ErrOrderAlreadyFulfilled
+	}
+	sort.Slice(lines, func(i, j int) bool { return lines[i].OrderItemID < lines[j].OrderItemID })
+	return lines, nil
+}
+
+// ApplyFulfillmentLines adds resolved lines to the items' fulfilled quantities and returns
+// the indexes of the items that changed
+func ApplyFulfillmentLines(items []entity.OrderItem, lines []model.FulfillmentLine) []int {
+	quantities := make(map[uint]int, len(lines))
+	for _, line := range lines {
+		quantities[line.OrderItemID] += line.Quantity
+	}
+
+	var changed []int
+	for i := range items {
+		if qty := quantities[items[i].ID]; qty > 0 {
+			items[i].FulfilledQuantity = min(items[i].FulfilledQuantity+qty, items[i].Quantity)
+			changed = append(changed, i)
+		}
+	}
+	return changed
+}
+
+// DeriveFulfillmentStatus is FULFILLED once every unit is in a shipment, PARTIAL once any
+// is, and UNFULFILLED before that
+func DeriveFulfillmentStatus(items []entity.OrderItem) entity.OrderFulfillmentStatus {
+	ordered, fulfilled := 0, 0
+	for _, item := range items {
+		ordered += item.Quantity
+		fulfilled += min(item.FulfilledQuantity, item.Quantity)
+	}
+	switch {
+	case fulfilled == 0:
+		return entity.FULFILLMENT_STATUS_UNFULFILLED
+	case fulfilled >= ordered:
+		return entity.FULFILLMENT_STATUS_FULFILLED
+	default:
+		return entity.FULFILLMENT_STATUS_PARTIAL
+	}
+}
@@ -0,0 +1,94 @@
+package utils
+
+import (
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"ecommerce-be/common/constants"
+	"ecommerce-be/order/entity"
+	orderError "ecommerce-be/order/error"
+	"ecommerce-be/order/model"
+	"ecommerce-be/order/utils/constant"
+)
+
+// IsCustomerSide reports whether a role posts on the customer's side of an order thread.
+// Sellers and admins replying as support share the other side.
+func IsCustomerSide(role string) bool {
+	return strings.EqualFold(strings.TrimSpace(role), constants.CUSTOMER_ROLE_NAME)
+}
+
+// MessageSenderLabel names the sender's side of the thread for notification templates
+func MessageSenderLabel(role string) string {
+	switch strings.ToUpper(strings.TrimSpace(role)) {
+	case constants.CUSTOMER_ROLE_NAME:
+		return "customer"
+	case constants.SELLER_ROLE_NAME:
+		return "seller"
+	default:
+		return "support team"
+	}
+}
+
+// ValidateOrderMessage checks that a message carries a body or attachments and that
+// the attachment count is within the limit
+func ValidateOrderMessage(body string, attachmentFileIDs []string) error {
+	if strings.TrimSpace(body) == "" && len(attachmentFileIDs) == 0 {
+		return orderError.ErrOrderMessageEmpty
+	}
+	if len(attachmentFileIDs) > constant.ORDER_MESSAGE_MAX_ATTACHMENTS {
+		return orderError.ErrOrderMessageTooManyAttachments
+	}
+	return nil
+}
+
+// ValidateOrderMessageAttachment checks attachment metadata against the upload policy
+func ValidateOrderMessageAttachment(mimeType string, sizeBytes int64) error {
+	if sizeBytes <= 0 || sizeBytes > constant.ORDER_MESSAGE_ATTACHMENT_MAX_SIZE_BYTES ||
+		!slices.Contains(constant.ORDER_MESSAGE_ATTACHMENT_MIME_TYPES, strings.ToLower(mimeType)) {
+		return orderError.ErrInvalidOrderMessageAttachment
+	}
+	return nil
+}
+
+// MessagePreview shortens a message body to at most limit characters for notifications
+func MessagePreview(body string, limit int) string {
+	body = strings.Join(strings.Fields(body), " ")
+	if utf8.RuneCountInString(body) <= limit {
+		return body
+	}
+	runes := []rune(body)
+	return strings.TrimSpace(string(runes[:limit-1])) + "…"
+}
+
+// MergeOrderTimeline interleaves status transitions and thread messages by time. Entries
+// at the same instant keep status transitions first.
+func MergeOrderTimeline(
+	history []entity.OrderHistory,
+	messages []model.OrderMessageResponse,
+) []model.OrderTimelineEntry {
+	entries := make([]model.OrderTimelineEntry, 0, len(history)+len(messages))
+	for _, h := range history {
+		entries = append(entries, model.OrderTimelineEntry{
+			Type:       constant.ORDER_TIMELINE_STATUS,
+			OccurredAt: h.CreatedAt,
+			Status: &model.OrderTimelineStatus{
+				FromStatus:    h.FromStatus,
+				ToStatus:      h.ToStatus,
+				ChangedByRole: h.ChangedByRole,
+				Note:          h.Note,
+			},
+		})
+	}
+	for i := range messages {
+		entries = append(entries, model.OrderTimelineEntry{
+			Type:       constant.ORDER_TIMELINE_MESSAGE,
+			OccurredAt: messages[i].CreatedAt,
+			Message:    &messages[i],
+		})
+	}
+	slices.SortStableFunc(entries, func(a, b model.OrderTimelineEntry) int {
+		return a.OccurredAt.Compare(b.OccurredAt)
+	})
+	return entries
+}
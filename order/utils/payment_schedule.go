@@ -0,0 +1,91 @@
+package utils
+
+import (
+	"time"
+
+	"ecommerce-be/order/entity"
+	orderError "ecommerce-be/order/error"
+)
+
+// DepositCents returns the deposit collected at placement: percent of the total,
+// rounded up, but always leaving at least one cent for the balance.
+func DepositCents(totalCents int64, percent int) int64 {
+	deposit := (totalCents*int64(percent) + 99) / 100
+	if deposit >= totalCents {
+		deposit = totalCents - 1
+	}
+	if deposit < 1 {
+		deposit = 1
+	}
+	return deposit
+}
+
+// BuildPaymentSchedule splits a deposit order into its deposit, due at placement, and
+// its balance. A balance collected before fulfillment is due balanceDueAfter from now;
+// one collected on delivery has no due date and never expires.
+func BuildPaymentSchedule(
+	orderID uint,
+	totalCents int64,
+	percent int,
+	collection entity.BalanceCollection,
+	now time.Time,
+	balanceDueAfter time.Duration,
+) ([]entity.OrderPaymentInstallment, error) {
+	if !collection.IsValid() {
+		return nil, orderError.ErrInvalidPaymentPlan
+	}
+	// A deposit and a balance each need at least one cent
+	if totalCents < 2 {
+		return nil, orderError.ErrDepositNotAvailable
+	}
+
+	deposit := DepositCents(totalCents, percent)
+	depositDue := now
+	var balanceDue *time.Time
+	if collection == entity.BALANCE_BEFORE_FULFILLMENT {
+		due := now.Add(balanceDueAfter)
+		balanceDue = &due
+	}
+
+	return []entity.OrderPaymentInstallment{
+		{
+			OrderID:     orderID,
+			Kind:        entity.INSTALLMENT_DEPOSIT,
+			AmountCents: deposit,
+			DueAt:       &depositDue,
+			Status:      entity.INSTALLMENT_STATUS_PENDING,
+		},
+		{
+			OrderID:     orderID,
+			Kind:        entity.INSTALLMENT_BALANCE,
+			AmountCents: totalCents - deposit,
+			DueAt:       balanceDue,
+			Status:      entity.INSTALLMENT_STATUS_PENDING,
+		},
+	}, nil
+}
+
+// FindInstallment returns the installment of the given kind, or nil when the order has
+// none (full-payment orders).
+func FindInstallment(
+	installments []entity.OrderPaymentInstallment,
+	kind entity.InstallmentKind,
+) *entity.OrderPaymentInstallment {
+	for i := range installments {
+		if installments[i].Kind == kind {
+			return &installments[i]
+		}
+	}
+	return nil
+}
+
+// OutstandingBalanceCents sums the installments that are still pending.
+func OutstandingBalanceCents(installments []entity.OrderPaymentInstallment) int64 {
+	var total int64
+	for _, installment := range installments {
+		if installment.Status == entity.INSTALLMENT_STATUS_PENDING {
+			total += installment.AmountCents
+		}
+	}
+	return total
+}
@@ -0,0 +1,35 @@
+package entity
+
+import "ecommerce-be/common/db"
+
+// CommissionRateType decides how a commission rule charges an order line
+type CommissionRateType string
+
+const (
+	// CommissionRatePercentage charges RateBasisPoints of the line total
+	CommissionRatePercentage CommissionRateType = "percentage"
+	// CommissionRateFlat charges FlatCents per unit, capped at the line total
+	CommissionRateFlat CommissionRateType = "flat"
+)
+
+// IsValid checks if the CommissionRateType is a known value
+func (t CommissionRateType) IsValid() bool {
+	return t == CommissionRatePercentage || t == CommissionRateFlat
+}
+
+// CommissionRule is the platform's commission for a scope. A rule without seller or
+// category is the global default; SellerID and CategoryID narrow it, and the most specific
+// active rule matching an order line applies.
+type CommissionRule struct {
+	db.BaseEntity
+	SellerID        *uint              `json:"sellerId"        gorm:"column:seller_id;index"`
+	CategoryID      *uint              `json:"categoryId"      gorm:"column:category_id;index"`
+	RateType        CommissionRateType `json:"rateType"        gorm:"column:rate_type;size:20;not null"`
+	RateBasisPoints int                `json:"rateBasisPoints" gorm:"column:rate_basis_points;not null;default:0"`
+	FlatCents       int64              `json:"flatCents"       gorm:"column:flat_cents;not null;default:0"`
+	IsActive        bool               `json:"isActive"        gorm:"column:is_active;not null"`
+}
+
+func (CommissionRule) TableName() string {
+	return "commission_rule"
+}
@@ -0,0 +1,38 @@
+package entity
+
+import (
+	"time"
+
+	"ecommerce-be/common/db"
+)
+
+// ChargebackStatus represents the state of a card dispute
+type ChargebackStatus string
+
+const (
+	ChargebackStatusOpen ChargebackStatus = "open"
+	ChargebackStatusWon  ChargebackStatus = "won"
+	ChargebackStatusLost ChargebackStatus = "lost"
+)
+
+// PaymentChargeback represents a dispute raised against a payment transaction
+type PaymentChargeback struct {
+	db.BaseEntity
+	ChargebackID     string           `json:"chargebackId"     gorm:"column:chargeback_id;size:50;not null;uniqueIndex"`
+	TransactionID    uint             `json:"transactionId"    gorm:"column:transaction_id;not null;index"`
+	GatewayDisputeID string           `json:"gatewayDisputeId" gorm:"column:gateway_dispute_id;size:255"`
+	Currency         string           `json:"currency"         gorm:"column:currency;size:3;not null"`
+	AmountCents      int64            `json:"amountCents"      gorm:"column:amount_cents;not null"`
+	Status           ChargebackStatus `json:"status"           gorm:"column:status;size:20;not null;index"`
+	Reason           string           `json:"reason"           gorm:"column:reason;size:100"`
+	OpenedAt         time.Time        `json:"openedAt"         gorm:"column:opened_at;not null"`
+	ResolvedAt       *time.Time       `json:"resolvedAt"       gorm:"column:resolved_at"`
+	Metadata         db.JSONMap       `json:"metadata"         gorm:"column:metadata;type:jsonb"`
+
+	// Relationships
+	Transaction *PaymentTransaction `json:"transaction,omitempty" gorm:"foreignKey:TransactionID"`
+}
+
+func (PaymentChargeback) TableName() string {
+	return "payment_chargeback"
+}
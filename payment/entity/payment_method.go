@@ -12,12 +12,19 @@ const (
 	PaymentMethodTypeUPI         PaymentMethodType = "upi"
 	PaymentMethodTypeWallet      PaymentMethodType = "wallet"
 	PaymentMethodTypeBankAccount PaymentMethodType = "bank_account"
+
+	// Checkout-only method types (not saved as tokens)
+	PaymentMethodTypeBankTransfer PaymentMethodType = "bank_transfer"
+	PaymentMethodTypeEMI          PaymentMethodType = "emi"
+	PaymentMethodTypeBNPL         PaymentMethodType = "bnpl"
+	PaymentMethodTypeCOD          PaymentMethodType = "cod"
 )
 
 // PaymentMethodMetadata represents additional payment method metadata
 type PaymentMethodMetadata = db.JSONMap
 
-// PaymentMethod represents a saved payment method
+// PaymentMethod represents a saved payment method. Only gateway references and display
+// details (brand, last4, expiry) are stored; raw card data never reaches the backend.
 type PaymentMethod struct {
 	db.BaseEntity
 	UserID                 uint                  `json:"userId"                 gorm:"column:user_id;not null;index"`
@@ -26,7 +33,7 @@ type PaymentMethod struct {
 	GatewayCustomerID      string                `json:"gatewayCustomerId"      gorm:"column:gateway_customer_id;size:255"`
 	GatewayPaymentMethodID string                `json:"gatewayPaymentMethodId" gorm:"column:gateway_payment_method_id;size:255;not null;index"`
 	DisplayName            string                `json:"displayName"            gorm:"column:display_name;size:200"`
-	Metadata               PaymentMethodMetadata `json:"metadata"               gorm:"column:metadata;type:jsonb"`
+	Metadata               PaymentMethodMetadata `json:"metadata"               gorm:"column:details;type:jsonb"`
 	IsDefault              bool                  `json:"isDefault"              gorm:"column:is_default;default:false"`
 	// Relationships
 	Gateway *PaymentGateway `json:"gateway,omitempty"      gorm:"foreignKey:GatewayID"`
@@ -0,0 +1,21 @@
+package entity
+
+import (
+	"ecommerce-be/common/db"
+)
+
+// PaymentMethodRule is a seller's checkout rule for one payment method type.
+// Methods without a rule fall back to platform defaults.
+type PaymentMethodRule struct {
+	db.BaseEntity
+	SellerID       uint              `json:"sellerId"       gorm:"column:seller_id;not null;uniqueIndex:uq_payment_method_rule_seller_method"`
+	Method         PaymentMethodType `json:"method"         gorm:"column:method;size:50;not null;uniqueIndex:uq_payment_method_rule_seller_method"`
+	IsEnabled      bool              `json:"isEnabled"      gorm:"column:is_enabled;not null;default:true"`
+	MinAmountCents *int64            `json:"minAmountCents" gorm:"column:min_amount_cents"`
+	MaxAmountCents *int64            `json:"maxAmountCents" gorm:"column:max_amount_cents"`
+	DomesticOnly   bool              `json:"domesticOnly"   gorm:"column:domestic_only;not null;default:false"`
+}
+
+func (PaymentMethodRule) TableName() string {
+	return "payment_method_rule"
+}
@@ -15,6 +15,9 @@ const (
 	TransactionStatusFailed            TransactionStatus = "failed"
 	TransactionStatusRefunded          TransactionStatus = "refunded"
 	TransactionStatusPartiallyRefunded TransactionStatus = "partially_refunded"
+	// TransactionStatusExpired is a pending payment that was not completed in time, or
+	// that a newer payment attempt for the same order replaced
+	TransactionStatusExpired TransactionStatus = "expired"
 )
 
 // TransactionMetadata represents additional transaction metadata
@@ -35,9 +38,16 @@ type PaymentTransaction struct {
 	FailureCode          string              `json:"failureCode"          gorm:"column:failure_code;size:100"`
 	FailureMessage       string              `json:"failureMessage"       gorm:"column:failure_message;type:text"`
 	PaymentMethodType    PaymentMethodType   `json:"paymentMethodType"    gorm:"column:payment_method_type;size:50"`
+	CardBrand            string              `json:"cardBrand"            gorm:"column:card_brand;size:30"`
+	AuthorizedAt         *time.Time          `json:"authorizedAt"         gorm:"column:authorized_at"`
 	CompletedAt          *time.Time          `json:"completedAt"          gorm:"column:completed_at"`
 	Metadata             TransactionMetadata `json:"metadata"             gorm:"column:metadata;type:jsonb"`
 
+	// OrderID and ExpiresAt are set on payments started for an order; a payment still
+	// pending at ExpiresAt is expired and its order cancelled
+	OrderID   *uint      `json:"orderId,omitempty"   gorm:"column:order_id;index"`
+	ExpiresAt *time.Time `json:"expiresAt,omitempty" gorm:"column:expires_at"`
+
 	// Relationships
 	Gateway *PaymentGateway `json:"gateway,omitempty" gorm:"foreignKey:GatewayID"`
 }
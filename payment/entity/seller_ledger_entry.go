@@ -0,0 +1,77 @@
+package entity
+
+import (
+	"time"
+
+	"ecommerce-be/common/db"
+)
+
+// LedgerEntryType classifies a money movement on a seller's ledger
+type LedgerEntryType string
+
+const (
+	LedgerEntryTypeSale       LedgerEntryType = "sale"
+	LedgerEntryTypeCommission LedgerEntryType = "commission"
+	LedgerEntryTypeRefund     LedgerEntryType = "refund"
+	LedgerEntryTypeChargeback LedgerEntryType = "chargeback"
+	LedgerEntryTypePayout     LedgerEntryType = "payout"
+	LedgerEntryTypeAdjustment LedgerEntryType = "adjustment"
+	LedgerEntryTypeGatewayFee LedgerEntryType = "gateway_fee"
+)
+
+// LedgerAccount is one side of a ledger posting. Each entry debits one account and
+// credits another; seller_payable is what the platform owes the seller.
+type LedgerAccount string
+
+const (
+	LedgerAccountSellerPayable   LedgerAccount = "seller_payable"
+	LedgerAccountGatewayClearing LedgerAccount = "gateway_clearing"
+	LedgerAccountPlatformRevenue LedgerAccount = "platform_revenue"
+	LedgerAccountGatewayFees     LedgerAccount = "gateway_fees"
+	LedgerAccountSellerBank      LedgerAccount = "seller_bank"
+)
+
+// IsValid checks if the LedgerEntryType is a known value
+func (t LedgerEntryType) IsValid() bool {
+	switch t {
+	case LedgerEntryTypeSale,
+		LedgerEntryTypeCommission,
+		LedgerEntryTypeRefund,
+		LedgerEntryTypeChargeback,
+		LedgerEntryTypePayout,
+		LedgerEntryTypeAdjustment,
+		LedgerEntryTypeGatewayFee:
+		return true
+	default:
+		return false
+	}
+}
+
+// SellerLedgerEntry is an append-only money movement for a seller. AmountCents is
+// always positive; EntryType decides the direction.
+type SellerLedgerEntry struct {
+	ID                   uint            `json:"id"                   gorm:"primaryKey"`
+	SellerID             uint            `json:"sellerId"             gorm:"column:seller_id;not null;index"`
+	EntryType            LedgerEntryType `json:"entryType"            gorm:"column:entry_type;size:20;not null;index"`
+	Currency             string          `json:"currency"             gorm:"column:currency;size:3;not null"`
+	AmountCents          int64           `json:"amountCents"          gorm:"column:amount_cents;not null"`
+	OrderID              *uint           `json:"orderId"              gorm:"column:order_id"`
+	PaymentTransactionID *uint           `json:"paymentTransactionId" gorm:"column:payment_transaction_id"`
+	RefundID             *uint           `json:"refundId"             gorm:"column:refund_id"`
+	ChargebackID         *uint           `json:"chargebackId"         gorm:"column:chargeback_id"`
+	Description          string          `json:"description"          gorm:"column:description;type:text"`
+	OccurredAt           time.Time       `json:"occurredAt"           gorm:"column:occurred_at;not null;index"`
+	Metadata             db.JSONMap      `json:"metadata"             gorm:"column:metadata;type:jsonb"`
+	CreatedAt            time.Time       `json:"createdAt"            gorm:"column:created_at;autoCreateTime"`
+
+	// DebitAccount and CreditAccount are the entry's double-entry posting. Reference is
+	// the payment or payout reference the entry reconciles against.
+	DebitAccount  LedgerAccount `json:"debitAccount"       gorm:"column:debit_account;size:30"`
+	CreditAccount LedgerAccount `json:"creditAccount"      gorm:"column:credit_account;size:30"`
+	Reference     string        `json:"reference"          gorm:"column:reference;size:255"`
+	PayoutID      *uint         `json:"payoutId,omitempty" gorm:"column:payout_id"`
+}
+
+func (SellerLedgerEntry) TableName() string {
+	return "seller_ledger_entry"
+}
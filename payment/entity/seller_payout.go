@@ -0,0 +1,25 @@
+package entity
+
+import (
+	"time"
+
+	"ecommerce-be/common/db"
+)
+
+// SellerPayout is money paid out to a seller's bank account. Each payout is mirrored by a
+// payout entry on the seller ledger.
+type SellerPayout struct {
+	db.BaseEntity
+	PayoutID    string    `json:"payoutId"    gorm:"column:payout_id;size:50;not null;uniqueIndex"`
+	SellerID    uint      `json:"sellerId"    gorm:"column:seller_id;not null;index"`
+	Currency    string    `json:"currency"    gorm:"column:currency;size:3;not null"`
+	AmountCents int64     `json:"amountCents" gorm:"column:amount_cents;not null"`
+	Reference   string    `json:"reference"   gorm:"column:reference;size:255"`
+	Notes       string    `json:"notes"       gorm:"column:notes;type:text"`
+	PaidAt      time.Time `json:"paidAt"      gorm:"column:paid_at;not null"`
+	RecordedBy  *uint     `json:"recordedBy"  gorm:"column:recorded_by"`
+}
+
+func (SellerPayout) TableName() string {
+	return "seller_payout"
+}
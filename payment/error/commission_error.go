@@ -0,0 +1,34 @@
+package error
+
+import (
+	"net/http"
+
+	"ecommerce-be/common/error"
+	"ecommerce-be/payment/utils/constant"
+)
+
+var (
+	ErrorCommissionRuleNotFound = &error.AppError{
+		Code:       constant.COMMISSION_RULE_NOT_FOUND_CODE,
+		Message:    constant.COMMISSION_RULE_NOT_FOUND_MESSAGE,
+		StatusCode: http.StatusNotFound,
+	}
+	ErrorCommissionRuleExists = &error.AppError{
+		Code:       constant.COMMISSION_RULE_EXISTS_CODE,
+		Message:    constant.COMMISSION_RULE_EXISTS_MESSAGE,
+		StatusCode: http.StatusConflict,
+	}
+	ErrorCommissionRuleInvalid = &error.AppError{
+		Code:       constant.COMMISSION_RULE_INVALID_CODE,
+		Message:    constant.COMMISSION_RULE_INVALID_MESSAGE,
+		StatusCode: http.StatusBadRequest,
+	}
+)
+
+func init() {
+	error.Register(
+		ErrorCommissionRuleNotFound,
+		ErrorCommissionRuleExists,
+		ErrorCommissionRuleInvalid,
+	)
+}
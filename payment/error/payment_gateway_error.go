@@ -26,3 +26,11 @@ var (
 		StatusCode: http.StatusNotFound,
 	}
 )
+
+func init() {
+	error.Register(
+		ErrorPaymentGatewayNotFound,
+		ErrorPaymentGatewayNotActive,
+		ErrorPaymentGatewayNotSupported,
+	)
+}
@@ -0,0 +1,18 @@
+package error
+
+import (
+	"net/http"
+
+	"ecommerce-be/common/error"
+	"ecommerce-be/payment/utils/constant"
+)
+
+var ErrorPaymentIntentFailed = &error.AppError{
+	Code:       constant.PAYMENT_INTENT_FAILED_CODE,
+	Message:    constant.PAYMENT_INTENT_FAILED_MESSAGE,
+	StatusCode: http.StatusBadGateway,
+}
+
+func init() {
+	error.Register(ErrorPaymentIntentFailed)
+}
@@ -0,0 +1,36 @@
+package error
+
+import (
+	"net/http"
+
+	"ecommerce-be/common/error"
+	"ecommerce-be/payment/utils/constant"
+)
+
+var (
+	ErrorPaymentMethodNotSupported = &error.AppError{
+		Code:       constant.PAYMENT_METHOD_NOT_SUPPORTED_CODE,
+		Message:    constant.PAYMENT_METHOD_NOT_SUPPORTED_MESSAGE,
+		StatusCode: http.StatusBadRequest,
+	}
+
+	ErrorPaymentMethodRuleInvalidLimits = &error.AppError{
+		Code:       constant.PAYMENT_METHOD_RULE_INVALID_LIMITS_CODE,
+		Message:    constant.PAYMENT_METHOD_RULE_INVALID_LIMITS_MESSAGE,
+		StatusCode: http.StatusBadRequest,
+	}
+
+	ErrorCustomerCountryUnresolved = &error.AppError{
+		Code:       constant.CUSTOMER_COUNTRY_UNRESOLVED_CODE,
+		Message:    constant.CUSTOMER_COUNTRY_UNRESOLVED_MESSAGE,
+		StatusCode: http.StatusBadRequest,
+	}
+)
+
+func init() {
+	error.Register(
+		ErrorPaymentMethodNotSupported,
+		ErrorPaymentMethodRuleInvalidLimits,
+		ErrorCustomerCountryUnresolved,
+	)
+}
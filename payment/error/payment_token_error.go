@@ -0,0 +1,44 @@
+package error
+
+import (
+	"net/http"
+
+	"ecommerce-be/common/constants"
+	"ecommerce-be/common/error"
+	"ecommerce-be/payment/utils/constant"
+)
+
+var (
+	ErrorPaymentTokenInvalid = &error.AppError{
+		Code:       constant.PAYMENT_TOKEN_INVALID_CODE,
+		Message:    constant.PAYMENT_TOKEN_INVALID_MESSAGE,
+		StatusCode: http.StatusBadRequest,
+	}
+
+	ErrorCardDataNotAccepted = &error.AppError{
+		Code:       constants.CARD_DATA_NOT_ACCEPTED_CODE,
+		Message:    constants.CARD_DATA_NOT_ACCEPTED_MSG,
+		StatusCode: http.StatusBadRequest,
+	}
+
+	ErrorPaymentGatewayNotConfigured = &error.AppError{
+		Code:       constant.PAYMENT_GATEWAY_NOT_CONFIGURED_CODE,
+		Message:    constant.PAYMENT_GATEWAY_NOT_CONFIGURED_MESSAGE,
+		StatusCode: http.StatusBadRequest,
+	}
+
+	ErrorPaymentTokenExchangeFailed = &error.AppError{
+		Code:       constant.PAYMENT_TOKEN_EXCHANGE_FAILED_CODE,
+		Message:    constant.PAYMENT_TOKEN_EXCHANGE_FAILED_MESSAGE,
+		StatusCode: http.StatusBadGateway,
+	}
+)
+
+func init() {
+	error.Register(
+		ErrorPaymentTokenInvalid,
+		ErrorCardDataNotAccepted,
+		ErrorPaymentGatewayNotConfigured,
+		ErrorPaymentTokenExchangeFailed,
+	)
+}
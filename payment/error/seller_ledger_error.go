@@ -0,0 +1,28 @@
+package error
+
+import (
+	"net/http"
+
+	"ecommerce-be/common/error"
+	"ecommerce-be/payment/utils/constant"
+)
+
+var (
+	ErrorPayoutExceedsBalance = &error.AppError{
+		Code:       constant.PAYOUT_EXCEEDS_BALANCE_CODE,
+		Message:    constant.PAYOUT_EXCEEDS_BALANCE_MESSAGE,
+		StatusCode: http.StatusConflict,
+	}
+	ErrorLedgerInvalidPeriod = &error.AppError{
+		Code:       constant.LEDGER_INVALID_PERIOD_CODE,
+		Message:    constant.LEDGER_INVALID_PERIOD_MESSAGE,
+		StatusCode: http.StatusBadRequest,
+	}
+)
+
+func init() {
+	error.Register(
+		ErrorPayoutExceedsBalance,
+		ErrorLedgerInvalidPeriod,
+	)
+}
@@ -0,0 +1,30 @@
+package factory
+
+import (
+	"ecommerce-be/payment/entity"
+	"ecommerce-be/payment/model"
+)
+
+// BuildCommissionRuleResponse maps a commission rule to the response
+func BuildCommissionRuleResponse(rule *entity.CommissionRule) *model.CommissionRuleResponse {
+	return &model.CommissionRuleResponse{
+		ID:              rule.ID,
+		SellerID:        rule.SellerID,
+		CategoryID:      rule.CategoryID,
+		RateType:        rule.RateType,
+		RateBasisPoints: rule.RateBasisPoints,
+		FlatCents:       rule.FlatCents,
+		IsActive:        rule.IsActive,
+		CreatedAt:       rule.CreatedAt,
+		UpdatedAt:       rule.UpdatedAt,
+	}
+}
+
+// BuildCommissionRuleResponses maps commission rules to responses
+func BuildCommissionRuleResponses(rules []entity.CommissionRule) []model.CommissionRuleResponse {
+	responses := make([]model.CommissionRuleResponse, 0, len(rules))
+	for i := range rules {
+		responses = append(responses, *BuildCommissionRuleResponse(&rules[i]))
+	}
+	return responses
+}
@@ -14,10 +14,12 @@ type PaymentGatewayFactory struct {
 }
 
 func NewPaymentGatewayFactory(
+	paymentGatewayRepository repository.PaymentGatewayRepository,
 	cashfreeGateway *gateway.CashfreeGateway,
 ) *PaymentGatewayFactory {
 	return &PaymentGatewayFactory{
-		cashfreeGateway: cashfreeGateway,
+		paymentGatewayRepository: paymentGatewayRepository,
+		cashfreeGateway:          cashfreeGateway,
 	}
 }
 
@@ -37,6 +39,13 @@ func (f *PaymentGatewayFactory) GetPaymentGateway(
 	return f.getGatewayByCode(gateway.Code)
 }
 
+// GetPaymentGatewayByCode returns the adapter for an already resolved gateway code
+func (f *PaymentGatewayFactory) GetPaymentGatewayByCode(
+	code string,
+) (gateway.PaymentGateway, error) {
+	return f.getGatewayByCode(code)
+}
+
 func (f *PaymentGatewayFactory) getGatewayByCode(
 	code string,
 ) (gateway.PaymentGateway, error) {
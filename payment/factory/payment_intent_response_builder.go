@@ -0,0 +1,22 @@
+package factory
+
+import (
+	"ecommerce-be/payment/entity"
+	"ecommerce-be/payment/model"
+)
+
+// BuildPaymentIntentResponse maps a payment started at a gateway to the response
+func BuildPaymentIntentResponse(
+	transaction *entity.PaymentTransaction,
+	gatewayCode string,
+) *model.PaymentIntentResponse {
+	return &model.PaymentIntentResponse{
+		TransactionID:        transaction.TransactionID,
+		GatewayCode:          gatewayCode,
+		GatewayTransactionID: transaction.GatewayTransactionID,
+		Currency:             transaction.Currency,
+		AmountCents:          transaction.AmountCents,
+		Status:               transaction.Status,
+		ExpiresAt:            transaction.ExpiresAt,
+	}
+}
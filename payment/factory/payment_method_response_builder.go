@@ -0,0 +1,79 @@
+package factory
+
+import (
+	"ecommerce-be/payment/entity"
+	"ecommerce-be/payment/model"
+	paymentUtils "ecommerce-be/payment/utils"
+)
+
+// BuildPaymentMethodAvailabilityResponse maps resolved methods to the checkout response.
+func BuildPaymentMethodAvailabilityResponse(
+	currency string,
+	amountCents int64,
+	customerCountry string,
+	methods []paymentUtils.AvailableMethod,
+) *model.PaymentMethodAvailabilityResponse {
+	resp := &model.PaymentMethodAvailabilityResponse{
+		Currency:        currency,
+		AmountCents:     amountCents,
+		CustomerCountry: customerCountry,
+		Methods:         make([]model.AvailablePaymentMethodResponse, 0, len(methods)),
+	}
+	for _, m := range methods {
+		resp.Methods = append(resp.Methods, model.AvailablePaymentMethodResponse{
+			Method:   m.Method,
+			Gateways: m.GatewayCodes,
+			Offline:  len(m.GatewayCodes) == 0,
+		})
+	}
+	return resp
+}
+
+// BuildPaymentMethodRuleResponse maps a rule entity to its API response.
+func BuildPaymentMethodRuleResponse(
+	rule *entity.PaymentMethodRule,
+) model.PaymentMethodRuleResponse {
+	return model.PaymentMethodRuleResponse{
+		Method:         rule.Method,
+		IsEnabled:      rule.IsEnabled,
+		MinAmountCents: rule.MinAmountCents,
+		MaxAmountCents: rule.MaxAmountCents,
+		DomesticOnly:   rule.DomesticOnly,
+		UpdatedAt:      rule.UpdatedAt,
+	}
+}
+
+// BuildSavedPaymentMethodResponse maps a saved payment method to its owner's view.
+func BuildSavedPaymentMethodResponse(
+	method *entity.PaymentMethod,
+	gatewayCode string,
+) *model.SavedPaymentMethodResponse {
+	return &model.SavedPaymentMethodResponse{
+		ID:          method.ID,
+		Type:        method.Type,
+		GatewayCode: gatewayCode,
+		DisplayName: method.DisplayName,
+		Brand:       metadataString(method.Metadata, "brand"),
+		Last4:       metadataString(method.Metadata, "last4"),
+		ExpiryMonth: metadataInt(method.Metadata, "expiryMonth"),
+		ExpiryYear:  metadataInt(method.Metadata, "expiryYear"),
+		IsDefault:   method.IsDefault,
+		CreatedAt:   method.CreatedAt,
+	}
+}
+
+func metadataString(metadata entity.PaymentMethodMetadata, key string) string {
+	value, _ := metadata[key].(string)
+	return value
+}
+
+// metadataInt reads ints stored in memory and float64s decoded from JSONB
+func metadataInt(metadata entity.PaymentMethodMetadata, key string) int {
+	switch value := metadata[key].(type) {
+	case int:
+		return value
+	case float64:
+		return int(value)
+	}
+	return 0
+}
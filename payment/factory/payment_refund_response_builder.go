@@ -0,0 +1,23 @@
+package factory
+
+import (
+	"ecommerce-be/payment/entity"
+	"ecommerce-be/payment/model"
+)
+
+// BuildPaymentRefundResponse maps a refund and the payment it refunds to the response
+func BuildPaymentRefundResponse(
+	refund *entity.PaymentRefund,
+	transaction *entity.PaymentTransaction,
+) *model.PaymentRefundResponse {
+	return &model.PaymentRefundResponse{
+		RefundID:        refund.RefundID,
+		TransactionID:   transaction.TransactionID,
+		GatewayRefundID: refund.GatewayRefundID,
+		Currency:        refund.Currency,
+		AmountCents:     refund.AmountCents,
+		Status:          refund.Status,
+		FailureReason:   refund.FailureReason,
+		CompletedAt:     refund.CompletedAt,
+	}
+}
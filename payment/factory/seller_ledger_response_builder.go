@@ -0,0 +1,24 @@
+package factory
+
+import (
+	"ecommerce-be/payment/entity"
+	"ecommerce-be/payment/model"
+)
+
+// BuildSellerPayoutResponse maps a recorded payout and the balance it leaves to the
+// response
+func BuildSellerPayoutResponse(
+	payout *entity.SellerPayout,
+	balanceCents int64,
+) *model.SellerPayoutResponse {
+	return &model.SellerPayoutResponse{
+		PayoutID:     payout.PayoutID,
+		SellerID:     payout.SellerID,
+		Currency:     payout.Currency,
+		AmountCents:  payout.AmountCents,
+		Reference:    payout.Reference,
+		Notes:        payout.Notes,
+		PaidAt:       payout.PaidAt,
+		BalanceCents: balanceCents,
+	}
+}
@@ -0,0 +1,66 @@
+package singleton
+
+import (
+	"sync"
+
+	"ecommerce-be/payment/handler"
+)
+
+// HandlerFactory manages all handler singleton instances
+type HandlerFactory struct {
+	serviceFactory *ServiceFactory
+
+	paymentMethodHandler *handler.PaymentMethodHandler
+	paymentTokenHandler  *handler.PaymentTokenHandler
+	sellerLedgerHandler  *handler.SellerLedgerHandler
+	commissionHandler    *handler.CommissionHandler
+
+	once sync.Once
+}
+
+// NewHandlerFactory creates a new handler factory
+func NewHandlerFactory(serviceFactory *ServiceFactory) *HandlerFactory {
+	return &HandlerFactory{serviceFactory: serviceFactory}
+}
+
+// initialize creates all handler instances (lazy loading)
+func (f *HandlerFactory) initialize() {
+	f.once.Do(func() {
+		f.paymentMethodHandler = handler.NewPaymentMethodHandler(
+			f.serviceFactory.GetPaymentMethodService(),
+		)
+		f.paymentTokenHandler = handler.NewPaymentTokenHandler(
+			f.serviceFactory.GetPaymentTokenService(),
+		)
+		f.sellerLedgerHandler = handler.NewSellerLedgerHandler(
+			f.serviceFactory.GetSellerLedgerService(),
+		)
+		f.commissionHandler = handler.NewCommissionHandler(
+			f.serviceFactory.GetCommissionService(),
+		)
+	})
+}
+
+// GetPaymentMethodHandler returns the singleton payment method handler
+func (f *HandlerFactory) GetPaymentMethodHandler() *handler.PaymentMethodHandler {
+	f.initialize()
+	return f.paymentMethodHandler
+}
+
+// GetPaymentTokenHandler returns the singleton payment token handler
+func (f *HandlerFactory) GetPaymentTokenHandler() *handler.PaymentTokenHandler {
+	f.initialize()
+	return f.paymentTokenHandler
+}
+
+// GetSellerLedgerHandler returns the singleton seller ledger handler
+func (f *HandlerFactory) GetSellerLedgerHandler() *handler.SellerLedgerHandler {
+	f.initialize()
+	return f.sellerLedgerHandler
+}
+
+// GetCommissionHandler returns the singleton commission handler
+func (f *HandlerFactory) GetCommissionHandler() *handler.CommissionHandler {
+	f.initialize()
+	return f.commissionHandler
+}
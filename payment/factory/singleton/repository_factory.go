@@ -0,0 +1,96 @@
+package singleton
+
+import (
+	"sync"
+
+	"ecommerce-be/payment/repository"
+)
+
+// RepositoryFactory manages all repository singleton instances
+type RepositoryFactory struct {
+	gatewayConfigRepo repository.PaymentGatewayConfigRepository
+	methodRuleRepo    repository.PaymentMethodRuleRepository
+	gatewayRepo       repository.PaymentGatewayRepository
+	paymentMethodRepo repository.PaymentMethodRepository
+	transactionRepo   repository.PaymentTransactionRepository
+	refundRepo        repository.PaymentRefundRepository
+	ledgerRepo        repository.SellerLedgerRepository
+	payoutRepo        repository.SellerPayoutRepository
+	commissionRepo    repository.CommissionRuleRepository
+
+	once sync.Once
+}
+
+// NewRepositoryFactory creates a new repository factory
+func NewRepositoryFactory() *RepositoryFactory {
+	return &RepositoryFactory{}
+}
+
+// initialize creates all repository instances (lazy loading)
+func (f *RepositoryFactory) initialize() {
+	f.once.Do(func() {
+		f.gatewayConfigRepo = repository.NewPaymentGatewayConfigRepository()
+		f.methodRuleRepo = repository.NewPaymentMethodRuleRepository()
+		f.gatewayRepo = repository.NewPaymentGatewayRepository()
+		f.paymentMethodRepo = repository.NewPaymentMethodRepository()
+		f.transactionRepo = repository.NewPaymentTransactionRepository()
+		f.refundRepo = repository.NewPaymentRefundRepository()
+		f.ledgerRepo = repository.NewSellerLedgerRepository()
+		f.payoutRepo = repository.NewSellerPayoutRepository()
+		f.commissionRepo = repository.NewCommissionRuleRepository()
+	})
+}
+
+// GetPaymentGatewayConfigRepository returns the singleton gateway config repository
+func (f *RepositoryFactory) GetPaymentGatewayConfigRepository() repository.PaymentGatewayConfigRepository {
+	f.initialize()
+	return f.gatewayConfigRepo
+}
+
+// GetPaymentMethodRuleRepository returns the singleton payment method rule repository
+func (f *RepositoryFactory) GetPaymentMethodRuleRepository() repository.PaymentMethodRuleRepository {
+	f.initialize()
+	return f.methodRuleRepo
+}
+
+// GetPaymentGatewayRepository returns the singleton payment gateway repository
+func (f *RepositoryFactory) GetPaymentGatewayRepository() repository.PaymentGatewayRepository {
+	f.initialize()
+	return f.gatewayRepo
+}
+
+// GetPaymentMethodRepository returns the singleton saved payment method repository
+func (f *RepositoryFactory) GetPaymentMethodRepository() repository.PaymentMethodRepository {
+	f.initialize()
+	return f.paymentMethodRepo
+}
+
+// GetPaymentTransactionRepository returns the singleton payment transaction repository
+func (f *RepositoryFactory) GetPaymentTransactionRepository() repository.PaymentTransactionRepository {
+	f.initialize()
+	return f.transactionRepo
+}
+
+// GetPaymentRefundRepository returns the singleton payment refund repository
+func (f *RepositoryFactory) GetPaymentRefundRepository() repository.PaymentRefundRepository {
+	f.initialize()
+	return f.refundRepo
+}
+
+// GetSellerLedgerRepository returns the singleton seller ledger repository
+func (f *RepositoryFactory) GetSellerLedgerRepository() repository.SellerLedgerRepository {
+	f.initialize()
+	return f.ledgerRepo
+}
+
+// GetSellerPayoutRepository returns the singleton seller payout repository
+func (f *RepositoryFactory) GetSellerPayoutRepository() repository.SellerPayoutRepository {
+	f.initialize()
+	return f.payoutRepo
+}
+
+// GetCommissionRuleRepository returns the singleton commission rule repository
+func (f *RepositoryFactory) GetCommissionRuleRepository() repository.CommissionRuleRepository {
+	f.initialize()
+	return f.commissionRepo
+}
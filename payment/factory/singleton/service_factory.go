@@ -0,0 +1,137 @@
+package singleton
+
+import (
+	"sync"
+	"time"
+
+	"ecommerce-be/common/config"
+	"ecommerce-be/payment/factory"
+	"ecommerce-be/payment/service"
+	gateway "ecommerce-be/payment/service/payment_gateway"
+	productFactory "ecommerce-be/product/factory/singleton"
+	userFactory "ecommerce-be/user/factory/singleton"
+)
+
+// ServiceFactory manages all service singleton instances
+type ServiceFactory struct {
+	repoFactory *RepositoryFactory
+
+	paymentMethodService service.PaymentMethodService
+	paymentTokenService  service.PaymentTokenService
+	paymentRefundService service.PaymentRefundService
+	paymentIntentService service.PaymentIntentService
+	sellerLedgerService  service.SellerLedgerService
+	commissionService    service.CommissionService
+
+	once sync.Once
+}
+
+// NewServiceFactory creates a new service factory
+func NewServiceFactory(repoFactory *RepositoryFactory) *ServiceFactory {
+	return &ServiceFactory{
+		repoFactory: repoFactory,
+	}
+}
+
+// initialize creates all service instances (lazy loading)
+func (f *ServiceFactory) initialize() {
+	f.once.Do(func() {
+		// Get external service dependencies
+		userSingleton := userFactory.GetInstance()
+
+		// Gateway adapters
+		gatewayFactory := factory.NewPaymentGatewayFactory(
+			f.repoFactory.GetPaymentGatewayRepository(),
+			gateway.NewCashfreeGateway(),
+		)
+
+		// Initialize services
+		f.paymentMethodService = service.NewPaymentMethodService(
+			f.repoFactory.GetPaymentGatewayConfigRepository(),
+			f.repoFactory.GetPaymentMethodRuleRepository(),
+			userSingleton.GetAddressService(),
+			userSingleton.GetCountryService(),
+			userSingleton.GetSellerSettingsService(),
+		)
+		f.paymentTokenService = service.NewPaymentTokenService(
+			f.repoFactory.GetPaymentGatewayConfigRepository(),
+			f.repoFactory.GetPaymentMethodRepository(),
+			gatewayFactory,
+		)
+		f.paymentRefundService = service.NewPaymentRefundService(
+			f.repoFactory.GetPaymentGatewayConfigRepository(),
+			f.repoFactory.GetPaymentTransactionRepository(),
+			f.repoFactory.GetPaymentRefundRepository(),
+			f.repoFactory.GetSellerLedgerRepository(),
+			gatewayFactory,
+		)
+		f.paymentIntentService = service.NewPaymentIntentService(
+			f.repoFactory.GetPaymentGatewayConfigRepository(),
+			f.repoFactory.GetPaymentTransactionRepository(),
+			gatewayFactory,
+			pendingPaymentExpiry(),
+		)
+		f.sellerLedgerService = service.NewSellerLedgerService(
+			f.repoFactory.GetSellerLedgerRepository(),
+			f.repoFactory.GetSellerPayoutRepository(),
+			f.repoFactory.GetPaymentTransactionRepository(),
+		)
+		f.commissionService = service.NewCommissionService(
+			f.repoFactory.GetCommissionRuleRepository(),
+			productFactory.GetInstance().GetVariantQueryService(),
+			platformCommissionBasisPoints(),
+		)
+	})
+}
+
+// pendingPaymentExpiry is how long a customer has to complete an order payment
+func pendingPaymentExpiry() time.Duration {
+	if cfg := config.Get(); cfg != nil && cfg.Payment.PendingPaymentExpiryMinutes > 0 {
+		return time.Duration(cfg.Payment.PendingPaymentExpiryMinutes) * time.Minute
+	}
+	return 30 * time.Minute
+}
+
+// platformCommissionBasisPoints is the platform's commission on sales no rule covers
+func platformCommissionBasisPoints() int {
+	if cfg := config.Get(); cfg != nil {
+		return cfg.Payment.PlatformCommissionBasisPoints
+	}
+	return 1000
+}
+
+// GetPaymentMethodService returns the singleton payment method service
+func (f *ServiceFactory) GetPaymentMethodService() service.PaymentMethodService {
+	f.initialize()
+	return f.paymentMethodService
+}
+
+// GetPaymentTokenService returns the singleton payment token service
+func (f *ServiceFactory) GetPaymentTokenService() service.PaymentTokenService {
+	f.initialize()
+	return f.paymentTokenService
+}
+
+// GetPaymentRefundService returns the singleton payment refund service
+func (f *ServiceFactory) GetPaymentRefundService() service.PaymentRefundService {
+	f.initialize()
+	return f.paymentRefundService
+}
+
+// GetPaymentIntentService returns the singleton payment intent service
+func (f *ServiceFactory) GetPaymentIntentService() service.PaymentIntentService {
+	f.initialize()
+	return f.paymentIntentService
+}
+
+// GetSellerLedgerService returns the singleton seller ledger service
+func (f *ServiceFactory) GetSellerLedgerService() service.SellerLedgerService {
+	f.initialize()
+	return f.sellerLedgerService
+}
+
+// GetCommissionService returns the singleton commission service
+func (f *ServiceFactory) GetCommissionService() service.CommissionService {
+	f.initialize()
+	return f.commissionService
+}
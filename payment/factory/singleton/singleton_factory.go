@@ -0,0 +1,119 @@
+package singleton
+
+import (
+	"sync"
+
+	"ecommerce-be/payment/handler"
+	"ecommerce-be/payment/repository"
+	"ecommerce-be/payment/service"
+)
+
+// SingletonFactory is the main facade for accessing all factories
+type SingletonFactory struct {
+	repoFactory    *RepositoryFactory
+	serviceFactory *ServiceFactory
+	handlerFactory *HandlerFactory
+}
+
+var (
+	instance *SingletonFactory
+	once     sync.Once
+)
+
+// GetInstance returns the singleton instance of SingletonFactory
+func GetInstance() *SingletonFactory {
+	once.Do(func() {
+		repoFactory := NewRepositoryFactory()
+		serviceFactory := NewServiceFactory(repoFactory)
+		handlerFactory := NewHandlerFactory(serviceFactory)
+
+		instance = &SingletonFactory{
+			repoFactory:    repoFactory,
+			serviceFactory: serviceFactory,
+			handlerFactory: handlerFactory,
+		}
+	})
+	return instance
+}
+
+// ResetInstance resets the singleton instance
+func ResetInstance() {
+	once = sync.Once{}
+	instance = nil
+}
+
+// ===============================
+// Repository Getters (Delegates)
+// ===============================
+
+func (f *SingletonFactory) GetPaymentGatewayConfigRepository() repository.PaymentGatewayConfigRepository {
+	return f.repoFactory.GetPaymentGatewayConfigRepository()
+}
+
+func (f *SingletonFactory) GetPaymentMethodRuleRepository() repository.PaymentMethodRuleRepository {
+	return f.repoFactory.GetPaymentMethodRuleRepository()
+}
+
+func (f *SingletonFactory) GetPaymentMethodRepository() repository.PaymentMethodRepository {
+	return f.repoFactory.GetPaymentMethodRepository()
+}
+
+func (f *SingletonFactory) GetPaymentTransactionRepository() repository.PaymentTransactionRepository {
+	return f.repoFactory.GetPaymentTransactionRepository()
+}
+
+func (f *SingletonFactory) GetPaymentRefundRepository() repository.PaymentRefundRepository {
+	return f.repoFactory.GetPaymentRefundRepository()
+}
+
+func (f *SingletonFactory) GetSellerLedgerRepository() repository.SellerLedgerRepository {
+	return f.repoFactory.GetSellerLedgerRepository()
+}
+
+// ===============================
+// Service Getters (Delegates)
+// ===============================
+
+func (f *SingletonFactory) GetPaymentMethodService() service.PaymentMethodService {
+	return f.serviceFactory.GetPaymentMethodService()
+}
+
+func (f *SingletonFactory) GetPaymentTokenService() service.PaymentTokenService {
+	return f.serviceFactory.GetPaymentTokenService()
+}
+
+func (f *SingletonFactory) GetPaymentRefundService() service.PaymentRefundService {
+	return f.serviceFactory.GetPaymentRefundService()
+}
+
+func (f *SingletonFactory) GetPaymentIntentService() service.PaymentIntentService {
+	return f.serviceFactory.GetPaymentIntentService()
+}
+
+func (f *SingletonFactory) GetSellerLedgerService() service.SellerLedgerService {
+	return f.serviceFactory.GetSellerLedgerService()
+}
+
+func (f *SingletonFactory) GetCommissionService() service.CommissionService {
+	return f.serviceFactory.GetCommissionService()
+}
+
+// ===============================
+// Handler Getters (Delegates)
+// ===============================
+
+func (f *SingletonFactory) GetPaymentMethodHandler() *handler.PaymentMethodHandler {
+	return f.handlerFactory.GetPaymentMethodHandler()
+}
+
+func (f *SingletonFactory) GetPaymentTokenHandler() *handler.PaymentTokenHandler {
+	return f.handlerFactory.GetPaymentTokenHandler()
+}
+
+func (f *SingletonFactory) GetSellerLedgerHandler() *handler.SellerLedgerHandler {
+	return f.handlerFactory.GetSellerLedgerHandler()
+}
+
+func (f *SingletonFactory) GetCommissionHandler() *handler.CommissionHandler {
+	return f.handlerFactory.GetCommissionHandler()
+}
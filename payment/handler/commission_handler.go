@@ -0,0 +1,104 @@
+package handler
+
+import (
+	"net/http"
+
+	"ecommerce-be/common/handler"
+	"ecommerce-be/common/log"
+	"ecommerce-be/payment/model"
+	"ecommerce-be/payment/service"
+	paymentConstants "ecommerce-be/payment/utils/constant"
+
+	"github.com/gin-gonic/gin"
+)
+
+type CommissionHandler struct {
+	*handler.BaseHandler
+	commissionService service.CommissionService
+}
+
+func NewCommissionHandler(commissionService service.CommissionService) *CommissionHandler {
+	return &CommissionHandler{
+		BaseHandler:       handler.NewBaseHandler(),
+		commissionService: commissionService,
+	}
+}
+
+func (h *CommissionHandler) ListCommissionRules(c *gin.Context) {
+	var filter model.CommissionRuleFilter
+	if err := c.ShouldBindQuery(&filter); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	resp, err := h.commissionService.ListRules(c, filter)
+	if err != nil {
+		log.ErrorWithContext(c, "listCommissionRules: failed", err)
+		h.HandleError(c, err, paymentConstants.FAILED_TO_FETCH_COMMISSION_RULES_MSG)
+		return
+	}
+
+	h.SuccessWithData(
+		c,
+		http.StatusOK,
+		paymentConstants.COMMISSION_RULES_FETCHED_MSG,
+		paymentConstants.COMMISSION_RULES_FIELD_NAME,
+		resp,
+	)
+}
+
+func (h *CommissionHandler) CreateCommissionRule(c *gin.Context) {
+	var req model.CommissionRuleRequest
+	if err := h.BindJSON(c, &req); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	resp, err := h.commissionService.CreateRule(c, req)
+	if err != nil {
+		log.ErrorWithContext(c, "createCommissionRule: failed", err)
+		h.HandleError(c, err, paymentConstants.FAILED_TO_SAVE_COMMISSION_RULE_MSG)
+		return
+	}
+
+	h.Success(c, http.StatusCreated, paymentConstants.COMMISSION_RULE_SAVED_MSG, resp)
+}
+
+func (h *CommissionHandler) UpdateCommissionRule(c *gin.Context) {
+	id, err := h.ParseUintParam(c, "id")
+	if err != nil {
+		h.HandleError(c, err, paymentConstants.FAILED_TO_SAVE_COMMISSION_RULE_MSG)
+		return
+	}
+
+	var req model.CommissionRuleRequest
+	if err := h.BindJSON(c, &req); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	resp, err := h.commissionService.UpdateRule(c, id, req)
+	if err != nil {
+		log.ErrorWithContext(c, "updateCommissionRule: failed", err)
+		h.HandleError(c, err, paymentConstants.FAILED_TO_SAVE_COMMISSION_RULE_MSG)
+		return
+	}
+
+	h.Success(c, http.StatusOK, paymentConstants.COMMISSION_RULE_SAVED_MSG, resp)
+}
+
+func (h *CommissionHandler) DeleteCommissionRule(c *gin.Context) {
+	id, err := h.ParseUintParam(c, "id")
+	if err != nil {
+		h.HandleError(c, err, paymentConstants.FAILED_TO_DELETE_COMMISSION_RULE_MSG)
+		return
+	}
+
+	if err := h.commissionService.DeleteRule(c, id); err != nil {
+		log.ErrorWithContext(c, "deleteCommissionRule: failed", err)
+		h.HandleError(c, err, paymentConstants.FAILED_TO_DELETE_COMMISSION_RULE_MSG)
+		return
+	}
+
+	h.Success(c, http.StatusOK, paymentConstants.COMMISSION_RULE_DELETED_MSG, nil)
+}
@@ -0,0 +1,104 @@
+package handler
+
+import (
+	"net/http"
+
+	"ecommerce-be/common/auth"
+	"ecommerce-be/common/constants"
+	errs "ecommerce-be/common/error"
+	"ecommerce-be/common/handler"
+	"ecommerce-be/common/log"
+	"ecommerce-be/payment/model"
+	"ecommerce-be/payment/service"
+	paymentConstants "ecommerce-be/payment/utils/constant"
+
+	"github.com/gin-gonic/gin"
+)
+
+type PaymentMethodHandler struct {
+	*handler.BaseHandler
+	paymentMethodService service.PaymentMethodService
+}
+
+func NewPaymentMethodHandler(
+	paymentMethodService service.PaymentMethodService,
+) *PaymentMethodHandler {
+	return &PaymentMethodHandler{
+		BaseHandler:          handler.NewBaseHandler(),
+		paymentMethodService: paymentMethodService,
+	}
+}
+
+func (h *PaymentMethodHandler) GetAvailablePaymentMethods(c *gin.Context) {
+	userID, exists := auth.GetUserIDFromContext(c)
+	if !exists {
+		h.HandleError(c, errs.UnauthorizedError, constants.AUTHENTICATION_REQUIRED_MSG)
+		return
+	}
+	sellerID, exists := auth.GetSellerIDFromContext(c)
+	if !exists {
+		h.HandleError(c, errs.ErrSellerDataMissing, constants.SELLER_DATA_MISSING_MSG)
+		return
+	}
+
+	var req model.PaymentMethodAvailabilityRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	resp, err := h.paymentMethodService.GetAvailablePaymentMethods(c, userID, sellerID, req)
+	if err != nil {
+		log.ErrorWithContext(c, "getAvailablePaymentMethods: failed", err)
+		h.HandleError(c, err, paymentConstants.FAILED_TO_FETCH_PAYMENT_METHODS_MSG)
+		return
+	}
+
+	h.Success(c, http.StatusOK, paymentConstants.PAYMENT_METHODS_FETCHED_MSG, resp)
+}
+
+func (h *PaymentMethodHandler) ListPaymentMethodRules(c *gin.Context) {
+	sellerID, exists := auth.GetSellerIDFromContext(c)
+	if !exists {
+		h.HandleError(c, errs.ErrSellerDataMissing, constants.SELLER_DATA_MISSING_MSG)
+		return
+	}
+
+	resp, err := h.paymentMethodService.ListPaymentMethodRules(c, sellerID)
+	if err != nil {
+		log.ErrorWithContext(c, "listPaymentMethodRules: failed", err)
+		h.HandleError(c, err, paymentConstants.FAILED_TO_FETCH_PAYMENT_METHOD_RULES_MSG)
+		return
+	}
+
+	h.SuccessWithData(
+		c,
+		http.StatusOK,
+		paymentConstants.PAYMENT_METHOD_RULES_FETCHED_MSG,
+		"rules",
+		resp,
+	)
+}
+
+func (h *PaymentMethodHandler) UpsertPaymentMethodRule(c *gin.Context) {
+	sellerID, exists := auth.GetSellerIDFromContext(c)
+	if !exists {
+		h.HandleError(c, errs.ErrSellerDataMissing, constants.SELLER_DATA_MISSING_MSG)
+		return
+	}
+
+	var req model.UpsertPaymentMethodRuleRequest
+	if err := h.BindJSON(c, &req); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	resp, err := h.paymentMethodService.UpsertPaymentMethodRule(c, sellerID, c.Param("method"), req)
+	if err != nil {
+		log.ErrorWithContext(c, "upsertPaymentMethodRule: failed", err)
+		h.HandleError(c, err, paymentConstants.FAILED_TO_SAVE_PAYMENT_METHOD_RULE_MSG)
+		return
+	}
+
+	h.Success(c, http.StatusOK, paymentConstants.PAYMENT_METHOD_RULE_SAVED_MSG, resp)
+}
@@ -0,0 +1,64 @@
+package handler
+
+import (
+	"net/http"
+
+	"ecommerce-be/common/auth"
+	"ecommerce-be/common/constants"
+	errs "ecommerce-be/common/error"
+	"ecommerce-be/common/handler"
+	"ecommerce-be/common/log"
+	"ecommerce-be/payment/model"
+	"ecommerce-be/payment/service"
+	paymentConstants "ecommerce-be/payment/utils/constant"
+
+	"github.com/gin-gonic/gin"
+)
+
+type PaymentTokenHandler struct {
+	*handler.BaseHandler
+	paymentTokenService service.PaymentTokenService
+}
+
+func NewPaymentTokenHandler(
+	paymentTokenService service.PaymentTokenService,
+) *PaymentTokenHandler {
+	return &PaymentTokenHandler{
+		BaseHandler:         handler.NewBaseHandler(),
+		paymentTokenService: paymentTokenService,
+	}
+}
+
+func (h *PaymentTokenHandler) ExchangeToken(c *gin.Context) {
+	userID, exists := auth.GetUserIDFromContext(c)
+	if !exists {
+		h.HandleError(c, errs.UnauthorizedError, constants.AUTHENTICATION_REQUIRED_MSG)
+		return
+	}
+	sellerID, exists := auth.GetSellerIDFromContext(c)
+	if !exists {
+		h.HandleError(c, errs.ErrSellerDataMissing, constants.SELLER_DATA_MISSING_MSG)
+		return
+	}
+
+	var req model.PaymentTokenExchangeRequest
+	if err := h.BindJSON(c, &req); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	resp, err := h.paymentTokenService.ExchangeToken(c, userID, sellerID, req)
+	if err != nil {
+		log.ErrorWithContext(c, "exchangeToken: failed", err)
+		h.HandleError(c, err, paymentConstants.FAILED_TO_EXCHANGE_PAYMENT_TOKEN_MSG)
+		return
+	}
+
+	h.SuccessWithData(
+		c,
+		http.StatusCreated,
+		paymentConstants.PAYMENT_TOKEN_EXCHANGED_MSG,
+		paymentConstants.PAYMENT_METHOD_FIELD_NAME,
+		resp,
+	)
+}
@@ -0,0 +1,148 @@
+package handler
+
+import (
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"ecommerce-be/common/auth"
+	"ecommerce-be/common/constants"
+	errs "ecommerce-be/common/error"
+	"ecommerce-be/common/handler"
+	"ecommerce-be/common/log"
+	"ecommerce-be/payment/model"
+	"ecommerce-be/payment/service"
+	paymentConstants "ecommerce-be/payment/utils/constant"
+
+	"github.com/gin-gonic/gin"
+)
+
+type SellerLedgerHandler struct {
+	*handler.BaseHandler
+	sellerLedgerService service.SellerLedgerService
+}
+
+func NewSellerLedgerHandler(sellerLedgerService service.SellerLedgerService) *SellerLedgerHandler {
+	return &SellerLedgerHandler{
+		BaseHandler:         handler.NewBaseHandler(),
+		sellerLedgerService: sellerLedgerService,
+	}
+}
+
+// GetBalance returns what the platform owes the signed-in seller
+func (h *SellerLedgerHandler) GetBalance(c *gin.Context) {
+	sellerID, exists := auth.GetSellerIDFromContext(c)
+	if !exists {
+		h.HandleError(c, errs.ErrSellerDataMissing, constants.SELLER_DATA_MISSING_MSG)
+		return
+	}
+	h.writeBalance(c, sellerID)
+}
+
+// ExportPayoutReport streams the signed-in seller's payout report as CSV
+func (h *SellerLedgerHandler) ExportPayoutReport(c *gin.Context) {
+	sellerID, exists := auth.GetSellerIDFromContext(c)
+	if !exists {
+		h.HandleError(c, errs.ErrSellerDataMissing, constants.SELLER_DATA_MISSING_MSG)
+		return
+	}
+	h.writePayoutReport(c, sellerID)
+}
+
+// GetSellerBalance returns what the platform owes a seller
+func (h *SellerLedgerHandler) GetSellerBalance(c *gin.Context) {
+	sellerID, err := h.ParseUintParam(c, "sellerId")
+	if err != nil {
+		h.HandleError(c, err, paymentConstants.FAILED_TO_FETCH_SELLER_BALANCE_MSG)
+		return
+	}
+	h.writeBalance(c, sellerID)
+}
+
+// ExportSellerPayoutReport streams a seller's payout report as CSV
+func (h *SellerLedgerHandler) ExportSellerPayoutReport(c *gin.Context) {
+	sellerID, err := h.ParseUintParam(c, "sellerId")
+	if err != nil {
+		h.HandleError(c, err, paymentConstants.FAILED_TO_EXPORT_PAYOUT_REPORT_MSG)
+		return
+	}
+	h.writePayoutReport(c, sellerID)
+}
+
+// RecordPayout records money an admin transferred to a seller
+func (h *SellerLedgerHandler) RecordPayout(c *gin.Context) {
+	adminID, exists := auth.GetUserIDFromContext(c)
+	if !exists {
+		h.HandleError(c, errs.UnauthorizedError, constants.AUTHENTICATION_REQUIRED_MSG)
+		return
+	}
+	sellerID, err := h.ParseUintParam(c, "sellerId")
+	if err != nil {
+		h.HandleError(c, err, paymentConstants.FAILED_TO_RECORD_SELLER_PAYOUT_MSG)
+		return
+	}
+
+	var req model.RecordPayoutRequest
+	if err := h.BindJSON(c, &req); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	resp, err := h.sellerLedgerService.RecordPayout(c, sellerID, adminID, req)
+	if err != nil {
+		log.ErrorWithContext(c, "recordPayout: failed", err)
+		h.HandleError(c, err, paymentConstants.FAILED_TO_RECORD_SELLER_PAYOUT_MSG)
+		return
+	}
+
+	h.SuccessWithData(
+		c,
+		http.StatusCreated,
+		paymentConstants.SELLER_PAYOUT_RECORDED_MSG,
+		paymentConstants.SELLER_PAYOUT_FIELD_NAME,
+		resp,
+	)
+}
+
+func (h *SellerLedgerHandler) writeBalance(c *gin.Context, sellerID uint) {
+	resp, err := h.sellerLedgerService.GetBalance(c, sellerID)
+	if err != nil {
+		log.ErrorWithContext(c, "getSellerBalance: failed", err)
+		h.HandleError(c, err, paymentConstants.FAILED_TO_FETCH_SELLER_BALANCE_MSG)
+		return
+	}
+	h.Success(c, http.StatusOK, paymentConstants.SELLER_BALANCE_FETCHED_MSG, resp)
+}
+
+func (h *SellerLedgerHandler) writePayoutReport(c *gin.Context, sellerID uint) {
+	var filter model.PayoutReportFilter
+	if err := c.ShouldBindQuery(&filter); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	records, err := h.sellerLedgerService.ExportPayoutReport(c, sellerID, filter)
+	if err != nil {
+		log.ErrorWithContext(c, "exportPayoutReport: failed", err)
+		h.HandleError(c, err, paymentConstants.FAILED_TO_EXPORT_PAYOUT_REPORT_MSG)
+		return
+	}
+
+	filename := fmt.Sprintf(
+		"payout_report_%d_%s_%s.csv",
+		sellerID,
+		strings.ToLower(filter.Currency),
+		time.Now().UTC().Format("20060102T150405Z"),
+	)
+
+	c.Header("Content-Type", "text/csv; charset=utf-8")
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	c.Status(http.StatusOK)
+
+	writer := csv.NewWriter(c.Writer)
+	if err := writer.WriteAll(records); err != nil {
+		log.ErrorWithContext(c, "exportPayoutReport: failed to write csv", err)
+	}
+}
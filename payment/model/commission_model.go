@@ -0,0 +1,61 @@
+package model
+
+import (
+	"time"
+
+	"ecommerce-be/payment/entity"
+)
+
+// ============================================================================
+// Request Models
+// ============================================================================
+
+// CommissionRuleRequest creates or replaces a commission rule. Leave SellerID and
+// CategoryID empty for the global default.
+type CommissionRuleRequest struct {
+	SellerID        *uint  `json:"sellerId"        binding:"omitempty,gt=0"`
+	CategoryID      *uint  `json:"categoryId"      binding:"omitempty,gt=0"`
+	RateType        string `json:"rateType"        binding:"required,oneof=percentage flat"`
+	RateBasisPoints int    `json:"rateBasisPoints" binding:"min=0,max=10000"`
+	FlatCents       int64  `json:"flatCents"       binding:"min=0"`
+	IsActive        *bool  `json:"isActive"`
+}
+
+// CommissionRuleFilter narrows the commission rule list
+type CommissionRuleFilter struct {
+	SellerID   *uint `form:"sellerId"`
+	CategoryID *uint `form:"categoryId"`
+}
+
+// CommissionLine is an order line to charge commission on. Other modules pass it to
+// CommissionService; it is not bound from HTTP requests. DiscountCents is the line's
+// own promotion discount, which is part of the order's discount.
+type CommissionLine struct {
+	VariantID      uint
+	Quantity       int
+	LineTotalCents int64
+	DiscountCents  int64
+}
+
+// ============================================================================
+// Response Models
+// ============================================================================
+
+type CommissionRuleResponse struct {
+	ID              uint                      `json:"id"`
+	SellerID        *uint                     `json:"sellerId"`
+	CategoryID      *uint                     `json:"categoryId"`
+	RateType        entity.CommissionRateType `json:"rateType"`
+	RateBasisPoints int                       `json:"rateBasisPoints"`
+	FlatCents       int64                     `json:"flatCents"`
+	IsActive        bool                      `json:"isActive"`
+	CreatedAt       time.Time                 `json:"createdAt"`
+	UpdatedAt       time.Time                 `json:"updatedAt"`
+}
+
+// LineCommission is the commission charged on one order line and the rule that set it.
+// RuleID is nil when no rule matched and the platform default applied.
+type LineCommission struct {
+	CommissionCents int64
+	RuleID          *uint
+}
@@ -41,3 +41,15 @@ type PaymentStatusResponse struct {
 	CompletedAt          time.Time `json:"completedAt"`          // When payment completed
 	GatewayResponse      any       `json:"gatewayResponse"`      // Full gateway response for debugging
 }
+
+// ExchangeTokenResponse is the reusable payment method a gateway issues in exchange for a
+// single-use hosted-fields token. Only non-sensitive display details are returned.
+type ExchangeTokenResponse struct {
+	GatewayPaymentMethodID string `json:"gatewayPaymentMethodId"` // Reusable payment method reference
+	GatewayCustomerID      string `json:"gatewayCustomerId"`      // Gateway customer owning the method
+	Brand                  string `json:"brand"`                  // Card network or wallet/bank name
+	Last4                  string `json:"last4"`                  // Last four digits for display
+	ExpiryMonth            int    `json:"expiryMonth"`            // Card expiry month (cards only)
+	ExpiryYear             int    `json:"expiryYear"`             // Card expiry year (cards only)
+	GatewayResponse        any    `json:"gatewayResponse"`        // Full gateway response
+}
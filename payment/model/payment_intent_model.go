@@ -0,0 +1,45 @@
+package model
+
+import (
+	"time"
+
+	"ecommerce-be/payment/entity"
+)
+
+// ============================================================================
+// Request Models
+// ============================================================================
+
+// CreatePaymentIntentRequest asks the seller's gateway to start collecting a payment for
+// an order. Other modules call PaymentIntentService with it; it is not bound from HTTP
+// requests.
+type CreatePaymentIntentRequest struct {
+	SellerID    uint
+	UserID      uint
+	OrderID     uint
+	AmountCents int64
+	Currency    string
+}
+
+// ============================================================================
+// Response Models
+// ============================================================================
+
+// PaymentIntentResponse is a pending payment the customer completes at the gateway
+// before ExpiresAt
+type PaymentIntentResponse struct {
+	TransactionID        string                   `json:"transactionId"`
+	GatewayCode          string                   `json:"gatewayCode"`
+	GatewayTransactionID string                   `json:"gatewayTransactionId"`
+	Currency             string                   `json:"currency"`
+	AmountCents          int64                    `json:"amountCents"`
+	Status               entity.TransactionStatus `json:"status"`
+	ExpiresAt            *time.Time               `json:"expiresAt"`
+}
+
+// ExpiredPayment is a pending order payment whose window has passed
+type ExpiredPayment struct {
+	TransactionID string
+	OrderID       uint
+	SellerID      uint
+}
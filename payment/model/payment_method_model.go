@@ -0,0 +1,53 @@
+package model
+
+import (
+	"time"
+
+	"ecommerce-be/payment/entity"
+)
+
+// ============================================================================
+// Request Models
+// ============================================================================
+
+// PaymentMethodAvailabilityRequest is bound from the checkout payment-methods query.
+// Customer country comes from AddressID, or the customer's default address when omitted.
+type PaymentMethodAvailabilityRequest struct {
+	Currency    string `form:"currency"    binding:"required,currencycode"`
+	AmountCents int64  `form:"amountCents" binding:"required,gt=0"`
+	AddressID   *uint  `form:"addressId"   binding:"omitempty,gt=0"`
+}
+
+// UpsertPaymentMethodRuleRequest sets a seller's rule for one payment method.
+type UpsertPaymentMethodRuleRequest struct {
+	IsEnabled      *bool  `json:"isEnabled"      binding:"required"`
+	MinAmountCents *int64 `json:"minAmountCents" binding:"omitempty,gte=0"`
+	MaxAmountCents *int64 `json:"maxAmountCents" binding:"omitempty,gt=0"`
+	DomesticOnly   bool   `json:"domesticOnly"`
+}
+
+// ============================================================================
+// Response Models
+// ============================================================================
+
+type AvailablePaymentMethodResponse struct {
+	Method   entity.PaymentMethodType `json:"method"`
+	Gateways []string                 `json:"gateways"`
+	Offline  bool                     `json:"offline"`
+}
+
+type PaymentMethodAvailabilityResponse struct {
+	Currency        string                           `json:"currency"`
+	AmountCents     int64                            `json:"amountCents"`
+	CustomerCountry string                           `json:"customerCountry"`
+	Methods         []AvailablePaymentMethodResponse `json:"methods"`
+}
+
+type PaymentMethodRuleResponse struct {
+	Method         entity.PaymentMethodType `json:"method"`
+	IsEnabled      bool                     `json:"isEnabled"`
+	MinAmountCents *int64                   `json:"minAmountCents"`
+	MaxAmountCents *int64                   `json:"maxAmountCents"`
+	DomesticOnly   bool                     `json:"domesticOnly"`
+	UpdatedAt      time.Time                `json:"updatedAt"`
+}
@@ -0,0 +1,45 @@
+package model
+
+import (
+	"time"
+
+	"ecommerce-be/payment/entity"
+)
+
+// ============================================================================
+// Request Models
+// ============================================================================
+
+// RefundPaymentRequest asks for money captured through a gateway to be returned to the
+// customer. Other modules refund through PaymentRefundService with it; it is not bound
+// from HTTP requests.
+type RefundPaymentRequest struct {
+	SellerID uint
+	// PaymentReference is the payment's transaction ID, either ours or the gateway's
+	PaymentReference string
+	// AmountCents is capped at what is left to refund on the payment
+	AmountCents     int64
+	Reason          entity.RefundReason
+	Notes           string
+	InitiatedBy     *uint
+	InitiatedByType entity.InitiatedByType
+	// OrderID is recorded on the refund for reconciliation
+	OrderID *uint
+}
+
+// ============================================================================
+// Response Models
+// ============================================================================
+
+// PaymentRefundResponse is the outcome of a refund. A refund the gateway rejected is
+// returned with status failed and the reason.
+type PaymentRefundResponse struct {
+	RefundID        string              `json:"refundId"`
+	TransactionID   string              `json:"transactionId"`
+	GatewayRefundID string              `json:"gatewayRefundId,omitempty"`
+	Currency        string              `json:"currency"`
+	AmountCents     int64               `json:"amountCents"`
+	Status          entity.RefundStatus `json:"status"`
+	FailureReason   string              `json:"failureReason,omitempty"`
+	CompletedAt     *time.Time          `json:"completedAt,omitempty"`
+}
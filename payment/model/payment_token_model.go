@@ -0,0 +1,38 @@
+package model
+
+import (
+	"time"
+
+	"ecommerce-be/payment/entity"
+)
+
+// ============================================================================
+// Request Models
+// ============================================================================
+
+// PaymentTokenExchangeRequest carries the single-use token issued by the gateway's hosted
+// fields. Card numbers and security codes are entered into the gateway's fields only.
+type PaymentTokenExchangeRequest struct {
+	GatewayCode string `json:"gatewayCode" binding:"required,max=50"`
+	Token       string `json:"token"       binding:"required,max=255"`
+	Type        string `json:"type"        binding:"required,oneof=card upi wallet bank_account"`
+	SetDefault  bool   `json:"setDefault"`
+}
+
+// ============================================================================
+// Response Models
+// ============================================================================
+
+// SavedPaymentMethodResponse is a saved payment method as shown to its owner
+type SavedPaymentMethodResponse struct {
+	ID          uint                     `json:"id"`
+	Type        entity.PaymentMethodType `json:"type"`
+	GatewayCode string                   `json:"gatewayCode"`
+	DisplayName string                   `json:"displayName"`
+	Brand       string                   `json:"brand,omitempty"`
+	Last4       string                   `json:"last4,omitempty"`
+	ExpiryMonth int                      `json:"expiryMonth,omitempty"`
+	ExpiryYear  int                      `json:"expiryYear,omitempty"`
+	IsDefault   bool                     `json:"isDefault"`
+	CreatedAt   time.Time                `json:"createdAt"`
+}
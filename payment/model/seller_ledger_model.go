@@ -0,0 +1,89 @@
+package model
+
+import (
+	"time"
+
+	"ecommerce-be/payment/entity"
+)
+
+// ============================================================================
+// Request Models
+// ============================================================================
+
+// RecordSaleRequest posts money collected for an order to the seller's ledger. Other
+// modules call SellerLedgerService with it; it is not bound from HTTP requests.
+type RecordSaleRequest struct {
+	SellerID    uint
+	OrderID     uint
+	Currency    string
+	AmountCents int64
+	// CommissionCents is the platform's commission on this sale
+	CommissionCents int64
+	// PaymentReference is the transaction ID the payment was recorded with, either ours
+	// or the gateway's; the gateway fee is taken from the matching payment
+	PaymentReference string
+	OccurredAt       time.Time
+}
+
+// RecordPayoutRequest records money transferred to a seller's bank account
+type RecordPayoutRequest struct {
+	Currency    string `json:"currency"    binding:"required,len=3"`
+	AmountCents int64  `json:"amountCents" binding:"required,gt=0"`
+	// Reference is the bank transfer reference
+	Reference string     `json:"reference" binding:"max=255"`
+	Notes     string     `json:"notes"     binding:"max=1000"`
+	PaidAt    *time.Time `json:"paidAt"`
+}
+
+// PayoutReportFilter selects the ledger entries of one currency for a payout report.
+// Dates are inclusive days; the report defaults to the current month.
+type PayoutReportFilter struct {
+	Currency string     `form:"currency" binding:"required,len=3"`
+	From     *time.Time `form:"from"     time_format:"2006-01-02"`
+	To       *time.Time `form:"to"       time_format:"2006-01-02"`
+}
+
+// LedgerTotal is the sum of a seller's ledger entries sharing a currency, entry type and
+// posting
+type LedgerTotal struct {
+	Currency      string                 `gorm:"column:currency"`
+	EntryType     entity.LedgerEntryType `gorm:"column:entry_type"`
+	DebitAccount  entity.LedgerAccount   `gorm:"column:debit_account"`
+	CreditAccount entity.LedgerAccount   `gorm:"column:credit_account"`
+	AmountCents   int64                  `gorm:"column:amount_cents"`
+}
+
+// ============================================================================
+// Response Models
+// ============================================================================
+
+// SellerCurrencyBalance breaks down what the platform owes a seller in one currency.
+// AvailableCents is what can still be paid out.
+type SellerCurrencyBalance struct {
+	Currency        string `json:"currency"`
+	GrossSalesCents int64  `json:"grossSalesCents"`
+	CommissionCents int64  `json:"commissionCents"`
+	GatewayFeeCents int64  `json:"gatewayFeeCents"`
+	RefundCents     int64  `json:"refundCents"`
+	ChargebackCents int64  `json:"chargebackCents"`
+	AdjustmentCents int64  `json:"adjustmentCents"`
+	PayoutCents     int64  `json:"payoutCents"`
+	AvailableCents  int64  `json:"availableCents"`
+}
+
+type SellerBalanceResponse struct {
+	SellerID uint                    `json:"sellerId"`
+	Balances []SellerCurrencyBalance `json:"balances"`
+}
+
+type SellerPayoutResponse struct {
+	PayoutID    string    `json:"payoutId"`
+	SellerID    uint      `json:"sellerId"`
+	Currency    string    `json:"currency"`
+	AmountCents int64     `json:"amountCents"`
+	Reference   string    `json:"reference"`
+	Notes       string    `json:"notes"`
+	PaidAt      time.Time `json:"paidAt"`
+	// BalanceCents is what the seller is still owed in the currency after the payout
+	BalanceCents int64 `json:"balanceCents"`
+}
@@ -0,0 +1,130 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"ecommerce-be/common/db"
+	"ecommerce-be/payment/entity"
+	"ecommerce-be/payment/model"
+
+	"gorm.io/gorm"
+)
+
+type CommissionRuleRepository interface {
+	FindAll(ctx context.Context, filter model.CommissionRuleFilter) ([]entity.CommissionRule, error)
+	FindByID(ctx context.Context, id uint) (*entity.CommissionRule, error)
+	// FindByScope returns the rule for exactly this seller and category, either of which
+	// may be nil
+	FindByScope(ctx context.Context, sellerID, categoryID *uint) (*entity.CommissionRule, error)
+	// FindApplicable returns the active rules that can cover the seller's products in any
+	// of the categories
+	FindApplicable(
+		ctx context.Context,
+		sellerID uint,
+		categoryIDs []uint,
+	) ([]entity.CommissionRule, error)
+	Save(ctx context.Context, rule *entity.CommissionRule) error
+	Delete(ctx context.Context, id uint) error
+}
+
+type CommissionRuleRepositoryImpl struct{}
+
+func NewCommissionRuleRepository() CommissionRuleRepository {
+	return &CommissionRuleRepositoryImpl{}
+}
+
+func (r *CommissionRuleRepositoryImpl) FindAll(
+	ctx context.Context,
+	filter model.CommissionRuleFilter,
+) ([]entity.CommissionRule, error) {
+	query := db.DB(ctx)
+	if filter.SellerID != nil {
+		query = query.Where("seller_id = ?", *filter.SellerID)
+	}
+	if filter.CategoryID != nil {
+		query = query.Where("category_id = ?", *filter.CategoryID)
+	}
+
+	var rules []entity.CommissionRule
+	err := query.
+		Order("seller_id ASC NULLS FIRST, category_id ASC NULLS FIRST").
+		Find(&rules).Error
+	if err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
+func (r *CommissionRuleRepositoryImpl) FindByID(
+	ctx context.Context,
+	id uint,
+) (*entity.CommissionRule, error) {
+	var rule entity.CommissionRule
+	err := db.DB(ctx).Where("id = ?", id).First(&rule).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &rule, nil
+}
+
+func (r *CommissionRuleRepositoryImpl) FindByScope(
+	ctx context.Context,
+	sellerID, categoryID *uint,
+) (*entity.CommissionRule, error) {
+	query := db.DB(ctx)
+	if sellerID != nil {
+		query = query.Where("seller_id = ?", *sellerID)
+	} else {
+		query = query.Where("seller_id IS NULL")
+	}
+	if categoryID != nil {
+		query = query.Where("category_id = ?", *categoryID)
+	} else {
+		query = query.Where("category_id IS NULL")
+	}
+
+	var rule entity.CommissionRule
+	if err := query.First(&rule).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &rule, nil
+}
+
+func (r *CommissionRuleRepositoryImpl) FindApplicable(
+	ctx context.Context,
+	sellerID uint,
+	categoryIDs []uint,
+) ([]entity.CommissionRule, error) {
+	query := db.DB(ctx).
+		Where("is_active = ?", true).
+		Where("seller_id IS NULL OR seller_id = ?", sellerID)
+	if len(categoryIDs) > 0 {
+		query = query.Where("category_id IS NULL OR category_id IN ?", categoryIDs)
+	} else {
+		query = query.Where("category_id IS NULL")
+	}
+
+	var rules []entity.CommissionRule
+	if err := query.Find(&rules).Error; err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
+func (r *CommissionRuleRepositoryImpl) Save(
+	ctx context.Context,
+	rule *entity.CommissionRule,
+) error {
+	return db.DB(ctx).Save(rule).Error
+}
+
+func (r *CommissionRuleRepositoryImpl) Delete(ctx context.Context, id uint) error {
+	return db.DB(ctx).Delete(&entity.CommissionRule{}, id).Error
+}
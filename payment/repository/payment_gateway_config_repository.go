@@ -0,0 +1,44 @@
+package repository
+
+import (
+	"context"
+
+	"ecommerce-be/common/db"
+	"ecommerce-be/payment/entity"
+)
+
+type PaymentGatewayConfigRepository interface {
+	FindActiveBySellerID(
+		ctx context.Context,
+		sellerID uint,
+		environment entity.GatewayEnvironment,
+	) ([]entity.PaymentGatewayConfig, error)
+}
+
+type PaymentGatewayConfigRepositoryImpl struct{}
+
+func NewPaymentGatewayConfigRepository() PaymentGatewayConfigRepository {
+	return &PaymentGatewayConfigRepositoryImpl{}
+}
+
+// FindActiveBySellerID returns the seller's active gateway configs whose gateway is
+// also active, ordered by seller priority (higher first).
+func (r *PaymentGatewayConfigRepositoryImpl) FindActiveBySellerID(
+	ctx context.Context,
+	sellerID uint,
+	environment entity.GatewayEnvironment,
+) ([]entity.PaymentGatewayConfig, error) {
+	var configs []entity.PaymentGatewayConfig
+	err := db.DB(ctx).
+		Joins("Gateway").
+		Where("payment_gateway_config.seller_id = ?", sellerID).
+		Where("payment_gateway_config.environment = ?", environment).
+		Where("payment_gateway_config.is_active = ?", true).
+		Where(`"Gateway".is_active = ?`, true).
+		Order("payment_gateway_config.priority DESC").
+		Find(&configs).Error
+	if err != nil {
+		return nil, err
+	}
+	return configs, nil
+}
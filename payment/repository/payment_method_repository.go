@@ -0,0 +1,70 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"ecommerce-be/common/db"
+	"ecommerce-be/payment/entity"
+
+	"gorm.io/gorm"
+)
+
+type PaymentMethodRepository interface {
+	FindByGatewayPaymentMethodID(
+		ctx context.Context,
+		userID uint,
+		gatewayID uint,
+		gatewayPaymentMethodID string,
+	) (*entity.PaymentMethod, error)
+	Save(ctx context.Context, method *entity.PaymentMethod) error
+	ClearDefault(ctx context.Context, userID uint, exceptID uint) error
+}
+
+type PaymentMethodRepositoryImpl struct{}
+
+func NewPaymentMethodRepository() PaymentMethodRepository {
+	return &PaymentMethodRepositoryImpl{}
+}
+
+// FindByGatewayPaymentMethodID returns the user's saved method for a gateway reference,
+// or nil when the method has not been saved yet.
+func (r *PaymentMethodRepositoryImpl) FindByGatewayPaymentMethodID(
+	ctx context.Context,
+	userID uint,
+	gatewayID uint,
+	gatewayPaymentMethodID string,
+) (*entity.PaymentMethod, error) {
+	var method entity.PaymentMethod
+	err := db.DB(ctx).
+		Where("user_id = ?", userID).
+		Where("gateway_id = ?", gatewayID).
+		Where("gateway_payment_method_id = ?", gatewayPaymentMethodID).
+		First(&method).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &method, nil
+}
+
+func (r *PaymentMethodRepositoryImpl) Save(
+	ctx context.Context,
+	method *entity.PaymentMethod,
+) error {
+	return db.DB(ctx).Save(method).Error
+}
+
+// ClearDefault unsets the default flag on the user's other saved methods.
+func (r *PaymentMethodRepositoryImpl) ClearDefault(
+	ctx context.Context,
+	userID uint,
+	exceptID uint,
+) error {
+	return db.DB(ctx).
+		Model(&entity.PaymentMethod{}).
+		Where("user_id = ? AND id <> ? AND is_default = ?", userID, exceptID, true).
+		Update("is_default", false).Error
+}
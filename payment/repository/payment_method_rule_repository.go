@@ -0,0 +1,53 @@
+package repository
+
+import (
+	"context"
+
+	"ecommerce-be/common/db"
+	"ecommerce-be/payment/entity"
+
+	"gorm.io/gorm/clause"
+)
+
+type PaymentMethodRuleRepository interface {
+	FindBySellerID(ctx context.Context, sellerID uint) ([]entity.PaymentMethodRule, error)
+	Upsert(ctx context.Context, rule *entity.PaymentMethodRule) error
+}
+
+type PaymentMethodRuleRepositoryImpl struct{}
+
+func NewPaymentMethodRuleRepository() PaymentMethodRuleRepository {
+	return &PaymentMethodRuleRepositoryImpl{}
+}
+
+func (r *PaymentMethodRuleRepositoryImpl) FindBySellerID(
+	ctx context.Context,
+	sellerID uint,
+) ([]entity.PaymentMethodRule, error) {
+	var rules []entity.PaymentMethodRule
+	err := db.DB(ctx).
+		Where("seller_id = ?", sellerID).
+		Order("method ASC").
+		Find(&rules).Error
+	if err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
+// Upsert creates or replaces the seller's rule for rule.Method.
+func (r *PaymentMethodRuleRepositoryImpl) Upsert(
+	ctx context.Context,
+	rule *entity.PaymentMethodRule,
+) error {
+	return db.DB(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "seller_id"}, {Name: "method"}},
+		DoUpdates: clause.AssignmentColumns([]string{
+			"is_enabled",
+			"min_amount_cents",
+			"max_amount_cents",
+			"domestic_only",
+			"updated_at",
+		}),
+	}).Create(rule).Error
+}
@@ -0,0 +1,42 @@
+package repository
+
+import (
+	"context"
+
+	"ecommerce-be/common/db"
+	"ecommerce-be/payment/entity"
+)
+
+type PaymentRefundRepository interface {
+	Save(ctx context.Context, refund *entity.PaymentRefund) error
+	FindByTransactionID(ctx context.Context, transactionID uint) ([]entity.PaymentRefund, error)
+}
+
+type PaymentRefundRepositoryImpl struct{}
+
+func NewPaymentRefundRepository() PaymentRefundRepository {
+	return &PaymentRefundRepositoryImpl{}
+}
+
+func (r *PaymentRefundRepositoryImpl) Save(
+	ctx context.Context,
+	refund *entity.PaymentRefund,
+) error {
+	return db.DB(ctx).Omit("Transaction").Save(refund).Error
+}
+
+// FindByTransactionID returns every refund issued against a payment, oldest first
+func (r *PaymentRefundRepositoryImpl) FindByTransactionID(
+	ctx context.Context,
+	transactionID uint,
+) ([]entity.PaymentRefund, error) {
+	var refunds []entity.PaymentRefund
+	err := db.DB(ctx).
+		Where("transaction_id = ?", transactionID).
+		Order("id ASC").
+		Find(&refunds).Error
+	if err != nil {
+		return nil, err
+	}
+	return refunds, nil
+}
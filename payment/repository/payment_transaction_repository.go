@@ -0,0 +1,149 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"ecommerce-be/common/db"
+	"ecommerce-be/payment/entity"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type PaymentTransactionRepository interface {
+	FindByReferenceForUpdate(
+		ctx context.Context,
+		sellerID uint,
+		reference string,
+	) (*entity.PaymentTransaction, error)
+	// FindByReference returns the seller's payment matching our transaction ID or the
+	// gateway's, or nil when the reference is not a recorded payment
+	FindByReference(
+		ctx context.Context,
+		sellerID uint,
+		reference string,
+	) (*entity.PaymentTransaction, error)
+	UpdateStatus(ctx context.Context, id uint, status entity.TransactionStatus) error
+
+	Save(ctx context.Context, transaction *entity.PaymentTransaction) error
+	// ExpirePendingByOrderID expires every pending payment of the order
+	ExpirePendingByOrderID(ctx context.Context, orderID uint) error
+	// ExpireIfPending expires the payment unless it left pending meanwhile, and reports
+	// whether it did
+	ExpireIfPending(ctx context.Context, transactionID string) (bool, error)
+	// FindExpiredPending returns pending order payments whose window passed by now,
+	// oldest first
+	FindExpiredPending(
+		ctx context.Context,
+		now time.Time,
+		limit int,
+	) ([]entity.PaymentTransaction, error)
+}
+
+type PaymentTransactionRepositoryImpl struct{}
+
+func NewPaymentTransactionRepository() PaymentTransactionRepository {
+	return &PaymentTransactionRepositoryImpl{}
+}
+
+// FindByReferenceForUpdate locks the seller's payment matching our transaction ID or the
+// gateway's, or returns nil when the reference is not a recorded payment.
+func (r *PaymentTransactionRepositoryImpl) FindByReferenceForUpdate(
+	ctx context.Context,
+	sellerID uint,
+	reference string,
+) (*entity.PaymentTransaction, error) {
+	return r.findByReference(
+		db.DB(ctx).Clauses(clause.Locking{Strength: "UPDATE"}), sellerID, reference)
+}
+
+func (r *PaymentTransactionRepositoryImpl) FindByReference(
+	ctx context.Context,
+	sellerID uint,
+	reference string,
+) (*entity.PaymentTransaction, error) {
+	return r.findByReference(db.DB(ctx), sellerID, reference)
+}
+
+func (r *PaymentTransactionRepositoryImpl) findByReference(
+	query *gorm.DB,
+	sellerID uint,
+	reference string,
+) (*entity.PaymentTransaction, error) {
+	var transaction entity.PaymentTransaction
+	err := query.
+		Where("seller_id = ?", sellerID).
+		Where("transaction_id = ? OR gateway_transaction_id = ?", reference, reference).
+		Order("id ASC").
+		First(&transaction).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &transaction, nil
+}
+
+func (r *PaymentTransactionRepositoryImpl) UpdateStatus(
+	ctx context.Context,
+	id uint,
+	status entity.TransactionStatus,
+) error {
+	return db.DB(ctx).
+		Model(&entity.PaymentTransaction{}).
+		Where("id = ?", id).
+		Update("status", status).Error
+}
+
+func (r *PaymentTransactionRepositoryImpl) Save(
+	ctx context.Context,
+	transaction *entity.PaymentTransaction,
+) error {
+	return db.DB(ctx).Omit("Gateway").Save(transaction).Error
+}
+
+func (r *PaymentTransactionRepositoryImpl) ExpirePendingByOrderID(
+	ctx context.Context,
+	orderID uint,
+) error {
+	return db.DB(ctx).
+		Model(&entity.PaymentTransaction{}).
+		Where("order_id = ? AND status = ?", orderID, entity.TransactionStatusPending).
+		Update("status", entity.TransactionStatusExpired).Error
+}
+
+func (r *PaymentTransactionRepositoryImpl) ExpireIfPending(
+	ctx context.Context,
+	transactionID string,
+) (bool, error) {
+	result := db.DB(ctx).
+		Model(&entity.PaymentTransaction{}).
+		Where("transaction_id = ? AND status = ?", transactionID, entity.TransactionStatusPending).
+		Update("status", entity.TransactionStatusExpired)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+func (r *PaymentTransactionRepositoryImpl) FindExpiredPending(
+	ctx context.Context,
+	now time.Time,
+	limit int,
+) ([]entity.PaymentTransaction, error) {
+	var transactions []entity.PaymentTransaction
+	err := db.DB(ctx).
+		Where("status = ?", entity.TransactionStatusPending).
+		Where("order_id IS NOT NULL").
+		Where("expires_at <= ?", now.UTC()).
+		Order("expires_at ASC").
+		Limit(limit).
+		Find(&transactions).Error
+	if err != nil {
+		return nil, err
+	}
+	return transactions, nil
+}
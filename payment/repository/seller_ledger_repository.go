@@ -0,0 +1,126 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"ecommerce-be/common/db"
+	"ecommerce-be/payment/entity"
+	"ecommerce-be/payment/model"
+	"ecommerce-be/payment/utils/constant"
+)
+
+type SellerLedgerRepository interface {
+	CreateEntries(ctx context.Context, entries []entity.SellerLedgerEntry) error
+	// LockSeller serialises balance-checked writes for the seller until the surrounding
+	// transaction ends
+	LockSeller(ctx context.Context, sellerID uint) error
+	// SumBySeller totals the seller's entries per currency, entry type and posting
+	SumBySeller(ctx context.Context, sellerID uint, currency string) ([]model.LedgerTotal, error)
+	// BalanceBefore is what the platform owed the seller in the currency just before the
+	// given time
+	BalanceBefore(
+		ctx context.Context,
+		sellerID uint,
+		currency string,
+		before time.Time,
+	) (int64, error)
+	// FindEntries returns the seller's entries in the currency within [from, to), oldest
+	// first
+	FindEntries(
+		ctx context.Context,
+		sellerID uint,
+		currency string,
+		from, to time.Time,
+	) ([]entity.SellerLedgerEntry, error)
+}
+
+type SellerLedgerRepositoryImpl struct{}
+
+func NewSellerLedgerRepository() SellerLedgerRepository {
+	return &SellerLedgerRepositoryImpl{}
+}
+
+// sellerPayableDelta signs an entry by its effect on what the platform owes the seller
+var sellerPayableDelta = fmt.Sprintf(`CASE
+	WHEN credit_account = '%s' THEN amount_cents
+	WHEN debit_account = '%s' THEN -amount_cents
+	ELSE 0 END`,
+	entity.LedgerAccountSellerPayable,
+	entity.LedgerAccountSellerPayable,
+)
+
+func (r *SellerLedgerRepositoryImpl) CreateEntries(
+	ctx context.Context,
+	entries []entity.SellerLedgerEntry,
+) error {
+	if len(entries) == 0 {
+		return nil
+	}
+	return db.DB(ctx).Create(&entries).Error
+}
+
+func (r *SellerLedgerRepositoryImpl) LockSeller(ctx context.Context, sellerID uint) error {
+	return db.DB(ctx).
+		Exec("SELECT pg_advisory_xact_lock(?, ?)",
+			constant.SELLER_LEDGER_LOCK_NAMESPACE, sellerID).Error
+}
+
+func (r *SellerLedgerRepositoryImpl) SumBySeller(
+	ctx context.Context,
+	sellerID uint,
+	currency string,
+) ([]model.LedgerTotal, error) {
+	query := db.DB(ctx).
+		Model(&entity.SellerLedgerEntry{}).
+		Select("currency, entry_type, debit_account, credit_account, "+
+			"SUM(amount_cents) as amount_cents").
+		Where("seller_id = ?", sellerID)
+	if currency != "" {
+		query = query.Where("currency = ?", currency)
+	}
+
+	var totals []model.LedgerTotal
+	err := query.
+		Group("currency, entry_type, debit_account, credit_account").
+		Scan(&totals).Error
+	if err != nil {
+		return nil, err
+	}
+	return totals, nil
+}
+
+func (r *SellerLedgerRepositoryImpl) BalanceBefore(
+	ctx context.Context,
+	sellerID uint,
+	currency string,
+	before time.Time,
+) (int64, error) {
+	var balance int64
+	err := db.DB(ctx).
+		Model(&entity.SellerLedgerEntry{}).
+		Select("COALESCE(SUM("+sellerPayableDelta+"), 0)").
+		Where("seller_id = ? AND currency = ?", sellerID, currency).
+		Where("occurred_at < ?", before).
+		Scan(&balance).Error
+	return balance, err
+}
+
+func (r *SellerLedgerRepositoryImpl) FindEntries(
+	ctx context.Context,
+	sellerID uint,
+	currency string,
+	from, to time.Time,
+) ([]entity.SellerLedgerEntry, error) {
+	var entries []entity.SellerLedgerEntry
+	err := db.DB(ctx).
+		Where("seller_id = ? AND currency = ?", sellerID, currency).
+		Where("occurred_at >= ? AND occurred_at < ?", from, to).
+		Order("occurred_at ASC, id ASC").
+		Find(&entries).Error
+	if err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"context"
+
+	"ecommerce-be/common/db"
+	"ecommerce-be/payment/entity"
+)
+
+type SellerPayoutRepository interface {
+	Create(ctx context.Context, payout *entity.SellerPayout) error
+}
+
+type SellerPayoutRepositoryImpl struct{}
+
+func NewSellerPayoutRepository() SellerPayoutRepository {
+	return &SellerPayoutRepositoryImpl{}
+}
+
+func (r *SellerPayoutRepositoryImpl) Create(
+	ctx context.Context,
+	payout *entity.SellerPayout,
+) error {
+	return db.DB(ctx).Create(payout).Error
+}
@@ -0,0 +1,61 @@
+package route
+
+import (
+	"net/http"
+
+	"ecommerce-be/common/constants"
+	"ecommerce-be/common/middleware"
+	"ecommerce-be/common/openapi"
+	"ecommerce-be/payment/factory/singleton"
+	"ecommerce-be/payment/handler"
+	"ecommerce-be/payment/model"
+	paymentConstants "ecommerce-be/payment/utils/constant"
+
+	"github.com/gin-gonic/gin"
+)
+
+// CommissionModule implements the Module interface for commission rule routes.
+type CommissionModule struct {
+	commissionHandler *handler.CommissionHandler
+}
+
+// NewCommissionModule creates a new instance of CommissionModule.
+func NewCommissionModule() *CommissionModule {
+	f := singleton.GetInstance()
+	return &CommissionModule{
+		commissionHandler: f.GetCommissionHandler(),
+	}
+}
+
+// RegisterRoutes registers the admin commission rule routes.
+func (m *CommissionModule) RegisterRoutes(router *gin.Engine) {
+	ruleRoutes := openapi.NewGroup(
+		router.Group(constants.APIBasePayment+"/admin/commission-rules"),
+		"Commission Rules",
+	)
+	ruleRoutes.Use(middleware.AdminAuth())
+	{
+		ruleRoutes.GET("", m.commissionHandler.ListCommissionRules).
+			Summary("List platform commission rules").
+			Query(model.CommissionRuleFilter{}).
+			ReturnsField(
+				http.StatusOK,
+				paymentConstants.COMMISSION_RULES_FIELD_NAME,
+				[]model.CommissionRuleResponse{},
+			)
+		ruleRoutes.POST("", m.commissionHandler.CreateCommissionRule).
+			Summary("Create a commission rule").
+			Description("Omit sellerId and categoryId for the global default. The most "+
+				"specific active rule applies to an order line: seller and category, then "+
+				"seller, then category, then the global default.").
+			Body(model.CommissionRuleRequest{}).
+			Returns(http.StatusCreated, model.CommissionRuleResponse{})
+		ruleRoutes.PUT("/:id", m.commissionHandler.UpdateCommissionRule).
+			Summary("Update a commission rule").
+			Body(model.CommissionRuleRequest{}).
+			Returns(http.StatusOK, model.CommissionRuleResponse{})
+		ruleRoutes.DELETE("/:id", m.commissionHandler.DeleteCommissionRule).
+			Summary("Delete a commission rule").
+			Description("Orders already placed keep the commission recorded on their lines.")
+	}
+}
@@ -0,0 +1,62 @@
+package route
+
+import (
+	"net/http"
+
+	"ecommerce-be/common/constants"
+	"ecommerce-be/common/middleware"
+	"ecommerce-be/common/openapi"
+	"ecommerce-be/payment/factory/singleton"
+	"ecommerce-be/payment/handler"
+	"ecommerce-be/payment/model"
+
+	"github.com/gin-gonic/gin"
+)
+
+// PaymentMethodModule implements the Module interface for payment method routes.
+type PaymentMethodModule struct {
+	paymentMethodHandler *handler.PaymentMethodHandler
+}
+
+// NewPaymentMethodModule creates a new instance of PaymentMethodModule.
+func NewPaymentMethodModule() *PaymentMethodModule {
+	f := singleton.GetInstance()
+	return &PaymentMethodModule{
+		paymentMethodHandler: f.GetPaymentMethodHandler(),
+	}
+}
+
+// RegisterRoutes registers checkout payment method and seller rule routes.
+func (m *PaymentMethodModule) RegisterRoutes(router *gin.Engine) {
+	customerAuth := middleware.CustomerAuth()
+	sellerAuth := middleware.SellerAuth()
+	rejectCardData := middleware.RejectCardData()
+
+	checkoutRoutes := openapi.NewGroup(router.Group(constants.APIBaseCheckout), "Payment Methods")
+	{
+		checkoutRoutes.GET(
+			"/payment-methods",
+			rejectCardData,
+			customerAuth,
+			m.paymentMethodHandler.GetAvailablePaymentMethods,
+		).
+			Summary("List payment methods available at checkout").
+			Query(model.PaymentMethodAvailabilityRequest{}).
+			Returns(http.StatusOK, model.PaymentMethodAvailabilityResponse{})
+	}
+
+	ruleRoutes := openapi.NewGroup(
+		router.Group(constants.APIBasePayment+"/method-rules"),
+		"Payment Methods",
+	)
+	ruleRoutes.Use(rejectCardData, sellerAuth)
+	{
+		ruleRoutes.GET("", m.paymentMethodHandler.ListPaymentMethodRules).
+			Summary("List seller payment method rules").
+			ReturnsField(http.StatusOK, "rules", []model.PaymentMethodRuleResponse{})
+		ruleRoutes.PUT("/:method", m.paymentMethodHandler.UpsertPaymentMethodRule).
+			Summary("Create or update a payment method rule").
+			Body(model.UpsertPaymentMethodRuleRequest{}).
+			Returns(http.StatusOK, model.PaymentMethodRuleResponse{})
+	}
+}
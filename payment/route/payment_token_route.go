@@ -0,0 +1,51 @@
+package route
+
+import (
+	"net/http"
+
+	"ecommerce-be/common/constants"
+	"ecommerce-be/common/middleware"
+	"ecommerce-be/common/openapi"
+	"ecommerce-be/payment/factory/singleton"
+	"ecommerce-be/payment/handler"
+	"ecommerce-be/payment/model"
+	paymentConstants "ecommerce-be/payment/utils/constant"
+
+	"github.com/gin-gonic/gin"
+)
+
+// PaymentTokenModule implements the Module interface for payment token routes.
+type PaymentTokenModule struct {
+	paymentTokenHandler *handler.PaymentTokenHandler
+}
+
+// NewPaymentTokenModule creates a new instance of PaymentTokenModule.
+func NewPaymentTokenModule() *PaymentTokenModule {
+	f := singleton.GetInstance()
+	return &PaymentTokenModule{
+		paymentTokenHandler: f.GetPaymentTokenHandler(),
+	}
+}
+
+// RegisterRoutes registers the hosted-fields token exchange route.
+func (m *PaymentTokenModule) RegisterRoutes(router *gin.Engine) {
+	customerAuth := middleware.CustomerAuth()
+
+	tokenRoutes := openapi.NewGroup(
+		router.Group(constants.APIBasePayment+"/tokens"),
+		"Payment Tokens",
+	)
+	tokenRoutes.Use(middleware.RejectCardData(), customerAuth)
+	{
+		tokenRoutes.POST("/exchange", m.paymentTokenHandler.ExchangeToken).
+			Summary("Exchange a hosted-fields token for a saved payment method").
+			Description("Accepts only gateway-issued tokens. Requests carrying card numbers "+
+				"or security codes are rejected with CARD_DATA_NOT_ACCEPTED.").
+			Body(model.PaymentTokenExchangeRequest{}).
+			ReturnsField(
+				http.StatusCreated,
+				paymentConstants.PAYMENT_METHOD_FIELD_NAME,
+				model.SavedPaymentMethodResponse{},
+			)
+	}
+}
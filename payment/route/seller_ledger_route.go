@@ -0,0 +1,73 @@
+package route
+
+import (
+	"net/http"
+
+	"ecommerce-be/common/constants"
+	"ecommerce-be/common/middleware"
+	"ecommerce-be/common/openapi"
+	"ecommerce-be/payment/factory/singleton"
+	"ecommerce-be/payment/handler"
+	"ecommerce-be/payment/model"
+	paymentConstants "ecommerce-be/payment/utils/constant"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SellerLedgerModule implements the Module interface for seller ledger routes.
+type SellerLedgerModule struct {
+	sellerLedgerHandler *handler.SellerLedgerHandler
+}
+
+// NewSellerLedgerModule creates a new instance of SellerLedgerModule.
+func NewSellerLedgerModule() *SellerLedgerModule {
+	f := singleton.GetInstance()
+	return &SellerLedgerModule{
+		sellerLedgerHandler: f.GetSellerLedgerHandler(),
+	}
+}
+
+// RegisterRoutes registers seller balance, payout report and admin payout routes.
+func (m *SellerLedgerModule) RegisterRoutes(router *gin.Engine) {
+	sellerRoutes := openapi.NewGroup(
+		router.Group(constants.APIBasePayment+"/ledger"),
+		"Seller Ledger",
+	)
+	sellerRoutes.Use(middleware.SellerAuth())
+	{
+		sellerRoutes.GET("/balance", m.sellerLedgerHandler.GetBalance).
+			Summary("Get the seller's ledger balance").
+			Description("Gross sales, commission, gateway fees, refunds and payouts per "+
+				"currency, and what is still available to pay out.").
+			Returns(http.StatusOK, model.SellerBalanceResponse{})
+		sellerRoutes.GET("/payout-report", m.sellerLedgerHandler.ExportPayoutReport).
+			Summary("Export the seller's payout report as CSV").
+			Query(model.PayoutReportFilter{}).
+			Produces("text/csv")
+	}
+
+	adminRoutes := openapi.NewGroup(
+		router.Group(constants.APIBasePayment+"/admin/sellers/:sellerId/ledger"),
+		"Seller Ledger",
+	)
+	adminRoutes.Use(middleware.AdminAuth())
+	{
+		adminRoutes.GET("/balance", m.sellerLedgerHandler.GetSellerBalance).
+			Summary("Get a seller's ledger balance").
+			Returns(http.StatusOK, model.SellerBalanceResponse{})
+		adminRoutes.GET("/payout-report", m.sellerLedgerHandler.ExportSellerPayoutReport).
+			Summary("Export a seller's payout report as CSV").
+			Query(model.PayoutReportFilter{}).
+			Produces("text/csv")
+		adminRoutes.POST("/payouts", m.sellerLedgerHandler.RecordPayout).
+			Summary("Record a payout to a seller").
+			Description("Rejected with PAYOUT_EXCEEDS_BALANCE when the amount is more than "+
+				"the seller's available balance in the currency.").
+			Body(model.RecordPayoutRequest{}).
+			ReturnsField(
+				http.StatusCreated,
+				paymentConstants.SELLER_PAYOUT_FIELD_NAME,
+				model.SellerPayoutResponse{},
+			)
+	}
+}
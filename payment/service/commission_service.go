@@ -0,0 +1,193 @@
+package service
+
+import (
+	"context"
+
+	"ecommerce-be/payment/entity"
+	paymenterrors "ecommerce-be/payment/error"
+	"ecommerce-be/payment/factory"
+	"ecommerce-be/payment/model"
+	"ecommerce-be/payment/repository"
+	paymentUtils "ecommerce-be/payment/utils"
+	productService "ecommerce-be/product/service"
+)
+
+// CommissionService manages the platform's commission rules and works out the commission
+// on order lines. Checkout calls it when an order is placed, and the result recorded on
+// the lines is what the seller ledger and revenue reports use.
+type CommissionService interface {
+	ListRules(
+		ctx context.Context,
+		filter model.CommissionRuleFilter,
+	) ([]model.CommissionRuleResponse, error)
+	// CreateRule adds a rule for a scope that has none yet
+	CreateRule(
+		ctx context.Context,
+		req model.CommissionRuleRequest,
+	) (*model.CommissionRuleResponse, error)
+	// UpdateRule replaces a rule; moving it onto a scope that already has one is rejected
+	UpdateRule(
+		ctx context.Context,
+		id uint,
+		req model.CommissionRuleRequest,
+	) (*model.CommissionRuleResponse, error)
+	DeleteRule(ctx context.Context, id uint) error
+	// CalculateCommissions returns the commission on each of the seller's order lines, in
+	// the order given. Lines no rule covers are charged the platform default. Commission
+	// is charged on what the lines sold for: after their own discount and their share of
+	// what orderDiscountCents leaves once the lines' own discounts are taken out.
+	CalculateCommissions(
+		ctx context.Context,
+		sellerID uint,
+		lines []model.CommissionLine,
+		orderDiscountCents int64,
+	) ([]model.LineCommission, error)
+}
+
+type CommissionServiceImpl struct {
+	ruleRepo        repository.CommissionRuleRepository
+	variantQuerySvc productService.VariantQueryService
+	// defaultBasisPoints is charged when no rule covers a line
+	defaultBasisPoints int
+}
+
+func NewCommissionService(
+	ruleRepo repository.CommissionRuleRepository,
+	variantQuerySvc productService.VariantQueryService,
+	defaultBasisPoints int,
+) CommissionService {
+	return &CommissionServiceImpl{
+		ruleRepo:           ruleRepo,
+		variantQuerySvc:    variantQuerySvc,
+		defaultBasisPoints: defaultBasisPoints,
+	}
+}
+
+func (s *CommissionServiceImpl) ListRules(
+	ctx context.Context,
+	filter model.CommissionRuleFilter,
+) ([]model.CommissionRuleResponse, error) {
+	rules, err := s.ruleRepo.FindAll(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	return factory.BuildCommissionRuleResponses(rules), nil
+}
+
+func (s *CommissionServiceImpl) CreateRule(
+	ctx context.Context,
+	req model.CommissionRuleRequest,
+) (*model.CommissionRuleResponse, error) {
+	return s.saveRule(ctx, &entity.CommissionRule{IsActive: true}, req)
+}
+
+func (s *CommissionServiceImpl) UpdateRule(
+	ctx context.Context,
+	id uint,
+	req model.CommissionRuleRequest,
+) (*model.CommissionRuleResponse, error) {
+	rule, err := s.ruleRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if rule == nil {
+		return nil, paymenterrors.ErrorCommissionRuleNotFound
+	}
+	return s.saveRule(ctx, rule, req)
+}
+
+func (s *CommissionServiceImpl) DeleteRule(ctx context.Context, id uint) error {
+	rule, err := s.ruleRepo.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if rule == nil {
+		return paymenterrors.ErrorCommissionRuleNotFound
+	}
+	return s.ruleRepo.Delete(ctx, id)
+}
+
+// saveRule applies the request to the rule, keeping one rule per seller and category
+func (s *CommissionServiceImpl) saveRule(
+	ctx context.Context,
+	rule *entity.CommissionRule,
+	req model.CommissionRuleRequest,
+) (*model.CommissionRuleResponse, error) {
+	if err := paymentUtils.ValidateCommissionRule(req); err != nil {
+		return nil, err
+	}
+	existing, err := s.ruleRepo.FindByScope(ctx, req.SellerID, req.CategoryID)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil && existing.ID != rule.ID {
+		return nil, paymenterrors.ErrorCommissionRuleExists
+	}
+
+	rule.SellerID = req.SellerID
+	rule.CategoryID = req.CategoryID
+	rule.RateType = entity.CommissionRateType(req.RateType)
+	rule.RateBasisPoints = req.RateBasisPoints
+	rule.FlatCents = req.FlatCents
+	if req.IsActive != nil {
+		rule.IsActive = *req.IsActive
+	}
+	if err := s.ruleRepo.Save(ctx, rule); err != nil {
+		return nil, err
+	}
+	return factory.BuildCommissionRuleResponse(rule), nil
+}
+
+func (s *CommissionServiceImpl) CalculateCommissions(
+	ctx context.Context,
+	sellerID uint,
+	lines []model.CommissionLine,
+	orderDiscountCents int64,
+) ([]model.LineCommission, error) {
+	variantIDs := make([]uint, 0, len(lines))
+	lineTotals := make([]int64, 0, len(lines))
+	var lineDiscountCents int64
+	for _, line := range lines {
+		variantIDs = append(variantIDs, line.VariantID)
+		lineTotals = append(lineTotals, max(line.LineTotalCents-line.DiscountCents, 0))
+		lineDiscountCents += line.DiscountCents
+	}
+	variants, err := s.variantQuerySvc.GetProductBasicInfoByVariantIDs(ctx, variantIDs, &sellerID)
+	if err != nil {
+		return nil, err
+	}
+	categoryByVariant := make(map[uint]uint, len(variants))
+	categoryIDs := make([]uint, 0, len(variants))
+	for _, variant := range variants {
+		categoryByVariant[variant.VariantID] = variant.CategoryID
+		categoryIDs = append(categoryIDs, variant.CategoryID)
+	}
+
+	rules, err := s.ruleRepo.FindApplicable(ctx, sellerID, categoryIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only the order-level part of the discount, such as a coupon, is spread
+	discounts := paymentUtils.SpreadDiscountCents(
+		lineTotals,
+		max(orderDiscountCents-lineDiscountCents, 0),
+	)
+	commissions := make([]model.LineCommission, 0, len(lines))
+	for i, line := range lines {
+		netCents := lineTotals[i] - discounts[i]
+		rule := paymentUtils.MatchCommissionRule(rules, sellerID,
+			categoryByVariant[line.VariantID])
+		if rule == nil {
+			commissions = append(commissions, model.LineCommission{
+				CommissionCents: paymentUtils.CommissionCents(netCents, s.defaultBasisPoints),
+			})
+			continue
+		}
+		commissions = append(commissions, model.LineCommission{
+			CommissionCents: paymentUtils.LineCommissionCents(*rule, line.Quantity, netCents),
+			RuleID:          &rule.ID,
+		})
+	}
+	return commissions, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"ecommerce-be/payment/entity"
+	"ecommerce-be/payment/model"
 )
 
 type CashfreeGateway struct {
@@ -51,3 +52,12 @@ func (c *CashfreeGateway) GetPaymentStatus(
 ) (string, error) {
 	return "", nil
 }
+
+func (c *CashfreeGateway) ExchangeToken(
+	ctx context.Context,
+	token string,
+	methodType entity.PaymentMethodType,
+	paymentGatewayConfig entity.PaymentGatewayConfig,
+) (*model.ExchangeTokenResponse, error) {
+	return &model.ExchangeTokenResponse{}, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"ecommerce-be/payment/entity"
+	"ecommerce-be/payment/model"
 )
 
 type RefundType string
@@ -41,4 +42,12 @@ type PaymentGateway interface {
 		transactionID string,
 		paymentGatewayConfig entity.PaymentGatewayConfig,
 	) (string, error)
+
+	// ExchangeToken swaps a single-use hosted-fields token for a reusable payment method
+	ExchangeToken(
+		ctx context.Context,
+		token string,
+		methodType entity.PaymentMethodType,
+		paymentGatewayConfig entity.PaymentGatewayConfig,
+	) (*model.ExchangeTokenResponse, error)
 }
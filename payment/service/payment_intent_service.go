@@ -0,0 +1,164 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"ecommerce-be/common/db"
+	"ecommerce-be/common/log"
+	"ecommerce-be/payment/entity"
+	paymenterrors "ecommerce-be/payment/error"
+	"ecommerce-be/payment/factory"
+	"ecommerce-be/payment/model"
+	"ecommerce-be/payment/repository"
+	paymentUtils "ecommerce-be/payment/utils"
+	"ecommerce-be/payment/utils/constant"
+)
+
+// PaymentIntentService starts order payments at the seller's gateway and expires the
+// ones customers do not complete in time. Other modules call it at checkout and when a
+// customer retries an unpaid order.
+type PaymentIntentService interface {
+	// CreatePaymentIntent starts a payment at the seller's highest-priority gateway. Any
+	// payment still pending for the order is expired, so only the newest can be completed.
+	CreatePaymentIntent(
+		ctx context.Context,
+		req model.CreatePaymentIntentRequest,
+	) (*model.PaymentIntentResponse, error)
+	// FindExpiredPayments returns pending order payments whose window passed by now
+	FindExpiredPayments(ctx context.Context, now time.Time, limit int) ([]model.ExpiredPayment, error)
+	// ExpirePayment expires a pending payment and reports whether it did; a payment
+	// completed meanwhile is left alone. Call it inside the transaction that cancels
+	// the order.
+	ExpirePayment(ctx context.Context, transactionID string) (bool, error)
+}
+
+type PaymentIntentServiceImpl struct {
+	gatewayConfigRepo repository.PaymentGatewayConfigRepository
+	transactionRepo   repository.PaymentTransactionRepository
+	gatewayFactory    *factory.PaymentGatewayFactory
+	// expiry is how long a customer has to complete a payment
+	expiry time.Duration
+}
+
+func NewPaymentIntentService(
+	gatewayConfigRepo repository.PaymentGatewayConfigRepository,
+	transactionRepo repository.PaymentTransactionRepository,
+	gatewayFactory *factory.PaymentGatewayFactory,
+	expiry time.Duration,
+) PaymentIntentService {
+	return &PaymentIntentServiceImpl{
+		gatewayConfigRepo: gatewayConfigRepo,
+		transactionRepo:   transactionRepo,
+		gatewayFactory:    gatewayFactory,
+		expiry:            expiry,
+	}
+}
+
+// CreatePaymentIntent records the payment as pending before calling the gateway, then
+// stores the gateway's reference. A payment the gateway refuses is kept as failed.
+func (s *PaymentIntentServiceImpl) CreatePaymentIntent(
+	ctx context.Context,
+	req model.CreatePaymentIntentRequest,
+) (*model.PaymentIntentResponse, error) {
+	gatewayConfig, err := s.findGatewayConfig(ctx, req.SellerID)
+	if err != nil {
+		return nil, err
+	}
+	adapter, err := s.gatewayFactory.GetPaymentGatewayByCode(gatewayConfig.Gateway.Code)
+	if err != nil {
+		return nil, err
+	}
+
+	expiresAt := time.Now().UTC().Add(s.expiry)
+	transaction := &entity.PaymentTransaction{
+		TransactionID: paymentUtils.GenerateTransactionID(),
+		UserID:        req.UserID,
+		SellerID:      req.SellerID,
+		GatewayID:     &gatewayConfig.GatewayID,
+		Currency:      strings.ToUpper(strings.TrimSpace(req.Currency)),
+		AmountCents:   req.AmountCents,
+		Status:        entity.TransactionStatusPending,
+		Metadata: entity.TransactionMetadata{
+			constant.PAYMENT_INTENT_ORDER_ID_METADATA_KEY: req.OrderID,
+		},
+		OrderID:   &req.OrderID,
+		ExpiresAt: &expiresAt,
+	}
+	err = db.WithTransaction(ctx, func(txCtx context.Context) error {
+		if err := s.transactionRepo.ExpirePendingByOrderID(txCtx, req.OrderID); err != nil {
+			return err
+		}
+		return s.transactionRepo.Save(txCtx, transaction)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	gatewayTransactionID, gatewayErr := adapter.CreatePayment(
+		ctx,
+		transaction.AmountCents,
+		transaction.Currency,
+		*gatewayConfig,
+	)
+	if gatewayErr != nil {
+		log.ErrorWithContext(ctx, "createPaymentIntent: gateway rejected the payment", gatewayErr)
+		transaction.Status = entity.TransactionStatusFailed
+		transaction.FailureMessage = gatewayErr.Error()
+	} else {
+		transaction.GatewayTransactionID = gatewayTransactionID
+	}
+	if err := s.transactionRepo.Save(ctx, transaction); err != nil {
+		return nil, err
+	}
+	if gatewayErr != nil {
+		return nil, paymenterrors.ErrorPaymentIntentFailed
+	}
+
+	return factory.BuildPaymentIntentResponse(transaction, gatewayConfig.Gateway.Code), nil
+}
+
+func (s *PaymentIntentServiceImpl) FindExpiredPayments(
+	ctx context.Context,
+	now time.Time,
+	limit int,
+) ([]model.ExpiredPayment, error) {
+	transactions, err := s.transactionRepo.FindExpiredPending(ctx, now, limit)
+	if err != nil {
+		return nil, err
+	}
+	expired := make([]model.ExpiredPayment, 0, len(transactions))
+	for _, transaction := range transactions {
+		expired = append(expired, model.ExpiredPayment{
+			TransactionID: transaction.TransactionID,
+			OrderID:       *transaction.OrderID,
+			SellerID:      transaction.SellerID,
+		})
+	}
+	return expired, nil
+}
+
+func (s *PaymentIntentServiceImpl) ExpirePayment(
+	ctx context.Context,
+	transactionID string,
+) (bool, error) {
+	return s.transactionRepo.ExpireIfPending(ctx, transactionID)
+}
+
+// findGatewayConfig resolves the seller's highest-priority active gateway
+func (s *PaymentIntentServiceImpl) findGatewayConfig(
+	ctx context.Context,
+	sellerID uint,
+) (*entity.PaymentGatewayConfig, error) {
+	configs, err := s.gatewayConfigRepo.FindActiveBySellerID(ctx, sellerID, gatewayEnvironment())
+	if err != nil {
+		return nil, err
+	}
+	for i := range configs {
+		if configs[i].Gateway != nil {
+			return &configs[i], nil
+		}
+	}
+	return nil, paymenterrors.ErrorPaymentGatewayNotConfigured
+}
@@ -0,0 +1,212 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"ecommerce-be/common/config"
+	"ecommerce-be/payment/entity"
+	paymenterrors "ecommerce-be/payment/error"
+	"ecommerce-be/payment/factory"
+	"ecommerce-be/payment/model"
+	"ecommerce-be/payment/repository"
+	paymentUtils "ecommerce-be/payment/utils"
+	userService "ecommerce-be/user/service"
+)
+
+// PaymentMethodService resolves which payment methods a customer can use at checkout
+// and manages the seller rules that feed that decision.
+type PaymentMethodService interface {
+	GetAvailablePaymentMethods(
+		ctx context.Context,
+		userID uint,
+		sellerID uint,
+		req model.PaymentMethodAvailabilityRequest,
+	) (*model.PaymentMethodAvailabilityResponse, error)
+	ListPaymentMethodRules(
+		ctx context.Context,
+		sellerID uint,
+	) ([]model.PaymentMethodRuleResponse, error)
+	UpsertPaymentMethodRule(
+		ctx context.Context,
+		sellerID uint,
+		method string,
+		req model.UpsertPaymentMethodRuleRequest,
+	) (*model.PaymentMethodRuleResponse, error)
+}
+
+type PaymentMethodServiceImpl struct {
+	gatewayConfigRepo repository.PaymentGatewayConfigRepository
+	ruleRepo          repository.PaymentMethodRuleRepository
+	addressSvc        userService.AddressService
+	countrySvc        userService.CountryService
+	sellerSettingsSvc userService.SellerSettingsService
+}
+
+func NewPaymentMethodService(
+	gatewayConfigRepo repository.PaymentGatewayConfigRepository,
+	ruleRepo repository.PaymentMethodRuleRepository,
+	addressSvc userService.AddressService,
+	countrySvc userService.CountryService,
+	sellerSettingsSvc userService.SellerSettingsService,
+) PaymentMethodService {
+	return &PaymentMethodServiceImpl{
+		gatewayConfigRepo: gatewayConfigRepo,
+		ruleRepo:          ruleRepo,
+		addressSvc:        addressSvc,
+		countrySvc:        countrySvc,
+		sellerSettingsSvc: sellerSettingsSvc,
+	}
+}
+
+// GetAvailablePaymentMethods filters the seller's gateway capabilities and method
+// rules by order currency, amount, and the customer's country.
+func (s *PaymentMethodServiceImpl) GetAvailablePaymentMethods(
+	ctx context.Context,
+	userID uint,
+	sellerID uint,
+	req model.PaymentMethodAvailabilityRequest,
+) (*model.PaymentMethodAvailabilityResponse, error) {
+	customerCountry, err := s.resolveCustomerCountry(ctx, userID, req.AddressID)
+	if err != nil {
+		return nil, err
+	}
+
+	configs, err := s.gatewayConfigRepo.FindActiveBySellerID(ctx, sellerID, gatewayEnvironment())
+	if err != nil {
+		return nil, err
+	}
+	gateways := make([]entity.PaymentGateway, 0, len(configs))
+	for _, cfg := range configs {
+		if cfg.Gateway != nil {
+			gateways = append(gateways, *cfg.Gateway)
+		}
+	}
+
+	rules, err := s.ruleRepo.FindBySellerID(ctx, sellerID)
+	if err != nil {
+		return nil, err
+	}
+
+	currency := strings.ToUpper(strings.TrimSpace(req.Currency))
+	methods := paymentUtils.ResolveAvailableMethods(paymentUtils.MethodAvailabilityInput{
+		Currency:        currency,
+		AmountCents:     req.AmountCents,
+		CustomerCountry: customerCountry,
+		SellerCountry:   s.resolveSellerCountry(ctx, sellerID),
+		Gateways:        gateways,
+		Rules:           rules,
+	})
+
+	return factory.BuildPaymentMethodAvailabilityResponse(
+		currency,
+		req.AmountCents,
+		customerCountry,
+		methods,
+	), nil
+}
+
+func (s *PaymentMethodServiceImpl) ListPaymentMethodRules(
+	ctx context.Context,
+	sellerID uint,
+) ([]model.PaymentMethodRuleResponse, error) {
+	rules, err := s.ruleRepo.FindBySellerID(ctx, sellerID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := make([]model.PaymentMethodRuleResponse, 0, len(rules))
+	for i := range rules {
+		resp = append(resp, factory.BuildPaymentMethodRuleResponse(&rules[i]))
+	}
+	return resp, nil
+}
+
+func (s *PaymentMethodServiceImpl) UpsertPaymentMethodRule(
+	ctx context.Context,
+	sellerID uint,
+	method string,
+	req model.UpsertPaymentMethodRuleRequest,
+) (*model.PaymentMethodRuleResponse, error) {
+	methodType := entity.PaymentMethodType(strings.ToLower(strings.TrimSpace(method)))
+	if !paymentUtils.IsCheckoutPaymentMethod(methodType) {
+		return nil, paymenterrors.ErrorPaymentMethodNotSupported
+	}
+	if req.MinAmountCents != nil && req.MaxAmountCents != nil &&
+		*req.MinAmountCents > *req.MaxAmountCents {
+		return nil, paymenterrors.ErrorPaymentMethodRuleInvalidLimits
+	}
+
+	rule := &entity.PaymentMethodRule{
+		SellerID:       sellerID,
+		Method:         methodType,
+		IsEnabled:      *req.IsEnabled,
+		MinAmountCents: req.MinAmountCents,
+		MaxAmountCents: req.MaxAmountCents,
+		DomesticOnly:   req.DomesticOnly,
+	}
+	if err := s.ruleRepo.Upsert(ctx, rule); err != nil {
+		return nil, err
+	}
+
+	resp := factory.BuildPaymentMethodRuleResponse(rule)
+	return &resp, nil
+}
+
+// resolveCustomerCountry returns the ISO country code of the requested address, or of
+// the customer's default address when none is given.
+func (s *PaymentMethodServiceImpl) resolveCustomerCountry(
+	ctx context.Context,
+	userID uint,
+	addressID *uint,
+) (string, error) {
+	var countryID uint
+	if addressID != nil {
+		address, err := s.addressSvc.GetAddressByID(ctx, *addressID, userID)
+		if err != nil {
+			return "", err
+		}
+		countryID = address.CountryID
+	} else {
+		addresses, err := s.addressSvc.GetAddresses(ctx, userID)
+		if err != nil {
+			return "", err
+		}
+		for _, address := range addresses {
+			if address.IsDefault {
+				countryID = address.CountryID
+				break
+			}
+		}
+	}
+	if countryID == 0 {
+		return "", paymenterrors.ErrorCustomerCountryUnresolved
+	}
+
+	country, err := s.countrySvc.GetCountryByID(ctx, countryID)
+	if err != nil {
+		return "", err
+	}
+	return strings.ToUpper(country.Code), nil
+}
+
+// resolveSellerCountry returns the seller's business country code. Sellers without
+// settings get an empty code, which makes domestic-only methods unavailable.
+func (s *PaymentMethodServiceImpl) resolveSellerCountry(ctx context.Context, sellerID uint) string {
+	settings, err := s.sellerSettingsSvc.GetBySellerID(ctx, sellerID)
+	if err != nil || settings == nil {
+		return ""
+	}
+	country, err := s.countrySvc.GetCountryByID(ctx, settings.BusinessCountryID)
+	if err != nil {
+		return ""
+	}
+	return strings.ToUpper(country.Code)
+}
+
+func gatewayEnvironment() entity.GatewayEnvironment {
+	if cfg := config.Get(); cfg != nil && cfg.App.IsProduction() {
+		return entity.EnvironmentProduction
+	}
+	return entity.EnvironmentSandbox
+}
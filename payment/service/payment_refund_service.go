@@ -0,0 +1,235 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"ecommerce-be/common/db"
+	"ecommerce-be/common/log"
+	"ecommerce-be/payment/entity"
+	"ecommerce-be/payment/factory"
+	"ecommerce-be/payment/model"
+	"ecommerce-be/payment/repository"
+	gateway "ecommerce-be/payment/service/payment_gateway"
+	paymentUtils "ecommerce-be/payment/utils"
+	"ecommerce-be/payment/utils/constant"
+)
+
+// PaymentRefundService returns captured payments to customers through the gateway that
+// took them. Other modules call it when an order is cancelled after payment.
+type PaymentRefundService interface {
+	// RefundPayment refunds up to req.AmountCents of the referenced payment. It returns
+	// nil when there is nothing to refund through a gateway: the reference is not a
+	// recorded gateway payment, or the payment was already refunded in full.
+	RefundPayment(
+		ctx context.Context,
+		req model.RefundPaymentRequest,
+	) (*model.PaymentRefundResponse, error)
+}
+
+type PaymentRefundServiceImpl struct {
+	gatewayConfigRepo repository.PaymentGatewayConfigRepository
+	transactionRepo   repository.PaymentTransactionRepository
+	refundRepo        repository.PaymentRefundRepository
+	ledgerRepo        repository.SellerLedgerRepository
+	gatewayFactory    *factory.PaymentGatewayFactory
+}
+
+func NewPaymentRefundService(
+	gatewayConfigRepo repository.PaymentGatewayConfigRepository,
+	transactionRepo repository.PaymentTransactionRepository,
+	refundRepo repository.PaymentRefundRepository,
+	ledgerRepo repository.SellerLedgerRepository,
+	gatewayFactory *factory.PaymentGatewayFactory,
+) PaymentRefundService {
+	return &PaymentRefundServiceImpl{
+		gatewayConfigRepo: gatewayConfigRepo,
+		transactionRepo:   transactionRepo,
+		refundRepo:        refundRepo,
+		ledgerRepo:        ledgerRepo,
+		gatewayFactory:    gatewayFactory,
+	}
+}
+
+// RefundPayment records the refund as pending before calling the gateway, so money in
+// flight is never refunded twice, then stores the gateway's outcome. A rejected refund
+// is kept as failed for the seller to follow up; a completed one is debited from the
+// seller's ledger.
+func (s *PaymentRefundServiceImpl) RefundPayment(
+	ctx context.Context,
+	req model.RefundPaymentRequest,
+) (*model.PaymentRefundResponse, error) {
+	reference := strings.TrimSpace(req.PaymentReference)
+	if reference == "" || req.AmountCents <= 0 {
+		return nil, nil
+	}
+
+	var transaction *entity.PaymentTransaction
+	refund, err := db.WithTransactionResult(
+		ctx,
+		func(txCtx context.Context) (*entity.PaymentRefund, error) {
+			var err error
+			transaction, err = s.transactionRepo.FindByReferenceForUpdate(
+				txCtx, req.SellerID, reference)
+			if err != nil || transaction == nil || !paymentUtils.IsRefundable(*transaction) {
+				return nil, err
+			}
+			return s.createPendingRefund(txCtx, transaction, req)
+		},
+	)
+	if err != nil || refund == nil {
+		return nil, err
+	}
+
+	gatewayRefundID, failure := s.refundAtGateway(ctx, transaction, refund)
+	now := time.Now().UTC()
+	if failure == "" {
+		refund.Status = entity.RefundStatusCompleted
+		refund.GatewayRefundID = gatewayRefundID
+		refund.CompletedAt = &now
+	} else {
+		refund.Status = entity.RefundStatusFailed
+		refund.FailureReason = failure
+	}
+
+	err = db.WithTransaction(ctx, func(txCtx context.Context) error {
+		if err := s.refundRepo.Save(txCtx, refund); err != nil {
+			return err
+		}
+		if refund.Status != entity.RefundStatusCompleted {
+			return nil
+		}
+		refunds, err := s.refundRepo.FindByTransactionID(txCtx, transaction.ID)
+		if err != nil {
+			return err
+		}
+		remaining := paymentUtils.RefundableCents(transaction.AmountCents, refunds)
+		if err := s.transactionRepo.UpdateStatus(txCtx, transaction.ID,
+			paymentUtils.RefundedTransactionStatus(remaining)); err != nil {
+			return err
+		}
+		return s.ledgerRepo.CreateEntries(txCtx, []entity.SellerLedgerEntry{
+			buildRefundLedgerEntry(refund, transaction, req.OrderID),
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return factory.BuildPaymentRefundResponse(refund, transaction), nil
+}
+
+// createPendingRefund stores a pending refund for what is left on the locked payment,
+// capped at the requested amount
+func (s *PaymentRefundServiceImpl) createPendingRefund(
+	txCtx context.Context,
+	transaction *entity.PaymentTransaction,
+	req model.RefundPaymentRequest,
+) (*entity.PaymentRefund, error) {
+	refunds, err := s.refundRepo.FindByTransactionID(txCtx, transaction.ID)
+	if err != nil {
+		return nil, err
+	}
+	amount := min(req.AmountCents, paymentUtils.RefundableCents(transaction.AmountCents, refunds))
+	if amount <= 0 {
+		return nil, nil
+	}
+
+	refund := &entity.PaymentRefund{
+		RefundID:        paymentUtils.GenerateRefundID(),
+		TransactionID:   transaction.ID,
+		Currency:        transaction.Currency,
+		AmountCents:     amount,
+		Status:          entity.RefundStatusPending,
+		Reason:          req.Reason,
+		Notes:           req.Notes,
+		InitiatedBy:     req.InitiatedBy,
+		InitiatedByType: req.InitiatedByType,
+	}
+	if req.OrderID != nil {
+		refund.Metadata = entity.RefundMetadata{
+			constant.REFUND_ORDER_ID_METADATA_KEY: *req.OrderID,
+		}
+	}
+	if err := s.refundRepo.Save(txCtx, refund); err != nil {
+		return nil, err
+	}
+	return refund, nil
+}
+
+// buildRefundLedgerEntry debits a completed refund from the seller who took the payment.
+// The platform keeps its commission on refunded sales.
+func buildRefundLedgerEntry(
+	refund *entity.PaymentRefund,
+	transaction *entity.PaymentTransaction,
+	orderID *uint,
+) entity.SellerLedgerEntry {
+	entry := paymentUtils.NewLedgerEntry(transaction.SellerID, entity.LedgerEntryTypeRefund,
+		refund.Currency, refund.AmountCents, *refund.CompletedAt)
+	entry.OrderID = orderID
+	entry.PaymentTransactionID = &transaction.ID
+	entry.RefundID = &refund.ID
+	entry.Reference = refund.RefundID
+	entry.Description = fmt.Sprintf(constant.LEDGER_REFUND_DESCRIPTION, refund.RefundID)
+	return entry
+}
+
+// refundAtGateway asks the gateway that captured the payment to refund it. It returns the
+// gateway's refund ID, or why the refund could not be made.
+func (s *PaymentRefundServiceImpl) refundAtGateway(
+	ctx context.Context,
+	transaction *entity.PaymentTransaction,
+	refund *entity.PaymentRefund,
+) (string, string) {
+	gatewayConfig, err := s.findGatewayConfig(ctx, transaction.SellerID, *transaction.GatewayID)
+	if err != nil {
+		log.ErrorWithContext(ctx, "refundPayment: gateway config lookup failed", err)
+		return "", constant.REFUND_GATEWAY_NOT_CONFIGURED_MSG
+	}
+	if gatewayConfig == nil {
+		return "", constant.REFUND_GATEWAY_NOT_CONFIGURED_MSG
+	}
+	adapter, err := s.gatewayFactory.GetPaymentGatewayByCode(gatewayConfig.Gateway.Code)
+	if err != nil {
+		return "", constant.REFUND_GATEWAY_NOT_CONFIGURED_MSG
+	}
+
+	refundType := gateway.REFUND_TYPE_PARTIAL
+	if refund.AmountCents == transaction.AmountCents {
+		refundType = gateway.REFUND_TYPE_FULL
+	}
+	gatewayRefundID, err := adapter.RefundPayment(
+		ctx,
+		refundType,
+		refund.AmountCents,
+		refund.Currency,
+		transaction.GatewayTransactionID,
+		*gatewayConfig,
+	)
+	if err != nil {
+		log.ErrorWithContext(ctx, "refundPayment: gateway refund failed", err)
+		return "", constant.REFUND_GATEWAY_REJECTED_MSG
+	}
+	return gatewayRefundID, ""
+}
+
+// findGatewayConfig resolves the seller's active config for the gateway that took the
+// payment, or nil when the seller no longer has one
+func (s *PaymentRefundServiceImpl) findGatewayConfig(
+	ctx context.Context,
+	sellerID uint,
+	gatewayID uint,
+) (*entity.PaymentGatewayConfig, error) {
+	configs, err := s.gatewayConfigRepo.FindActiveBySellerID(ctx, sellerID, gatewayEnvironment())
+	if err != nil {
+		return nil, err
+	}
+	for i := range configs {
+		if configs[i].GatewayID == gatewayID && configs[i].Gateway != nil {
+			return &configs[i], nil
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,170 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"ecommerce-be/common/db"
+	"ecommerce-be/common/log"
+	"ecommerce-be/payment/entity"
+	paymenterrors "ecommerce-be/payment/error"
+	"ecommerce-be/payment/factory"
+	"ecommerce-be/payment/model"
+	"ecommerce-be/payment/repository"
+	paymentUtils "ecommerce-be/payment/utils"
+)
+
+// PaymentTokenService saves customer payment methods from gateway-issued tokens. Cards are
+// entered into the gateway's hosted fields, so the backend only ever handles tokens.
+type PaymentTokenService interface {
+	ExchangeToken(
+		ctx context.Context,
+		userID uint,
+		sellerID uint,
+		req model.PaymentTokenExchangeRequest,
+	) (*model.SavedPaymentMethodResponse, error)
+}
+
+type PaymentTokenServiceImpl struct {
+	gatewayConfigRepo repository.PaymentGatewayConfigRepository
+	paymentMethodRepo repository.PaymentMethodRepository
+	gatewayFactory    *factory.PaymentGatewayFactory
+}
+
+func NewPaymentTokenService(
+	gatewayConfigRepo repository.PaymentGatewayConfigRepository,
+	paymentMethodRepo repository.PaymentMethodRepository,
+	gatewayFactory *factory.PaymentGatewayFactory,
+) PaymentTokenService {
+	return &PaymentTokenServiceImpl{
+		gatewayConfigRepo: gatewayConfigRepo,
+		paymentMethodRepo: paymentMethodRepo,
+		gatewayFactory:    gatewayFactory,
+	}
+}
+
+// ExchangeToken swaps a single-use hosted-fields token for a reusable payment method at the
+// seller's gateway and saves it for the customer. Exchanging a token that resolves to an
+// already saved method refreshes that method instead of duplicating it.
+func (s *PaymentTokenServiceImpl) ExchangeToken(
+	ctx context.Context,
+	userID uint,
+	sellerID uint,
+	req model.PaymentTokenExchangeRequest,
+) (*model.SavedPaymentMethodResponse, error) {
+	token := strings.TrimSpace(req.Token)
+	if err := paymentUtils.ValidatePaymentToken(token); err != nil {
+		return nil, err
+	}
+	methodType := entity.PaymentMethodType(req.Type)
+
+	gatewayConfig, err := s.findGatewayConfig(ctx, sellerID, req.GatewayCode)
+	if err != nil {
+		return nil, err
+	}
+	if !paymentUtils.GatewaySupportsMethod(*gatewayConfig.Gateway, methodType) {
+		return nil, paymenterrors.ErrorPaymentMethodNotSupported
+	}
+
+	adapter, err := s.gatewayFactory.GetPaymentGatewayByCode(gatewayConfig.Gateway.Code)
+	if err != nil {
+		return nil, err
+	}
+	exchanged, err := adapter.ExchangeToken(ctx, token, methodType, *gatewayConfig)
+	if err != nil {
+		log.ErrorWithContext(ctx, "exchangeToken: gateway exchange failed", err)
+		return nil, paymenterrors.ErrorPaymentTokenExchangeFailed
+	}
+	if exchanged == nil || strings.TrimSpace(exchanged.GatewayPaymentMethodID) == "" {
+		return nil, paymenterrors.ErrorPaymentTokenExchangeFailed
+	}
+
+	method, err := db.WithTransactionResult(
+		ctx,
+		func(txCtx context.Context) (*entity.PaymentMethod, error) {
+			return s.savePaymentMethod(
+				txCtx,
+				userID,
+				gatewayConfig.GatewayID,
+				methodType,
+				exchanged,
+				req.SetDefault,
+			)
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return factory.BuildSavedPaymentMethodResponse(method, gatewayConfig.Gateway.Code), nil
+}
+
+// findGatewayConfig resolves the seller's active config for the gateway the token came from
+func (s *PaymentTokenServiceImpl) findGatewayConfig(
+	ctx context.Context,
+	sellerID uint,
+	gatewayCode string,
+) (*entity.PaymentGatewayConfig, error) {
+	configs, err := s.gatewayConfigRepo.FindActiveBySellerID(ctx, sellerID, gatewayEnvironment())
+	if err != nil {
+		return nil, err
+	}
+	code := strings.TrimSpace(gatewayCode)
+	for i := range configs {
+		if configs[i].Gateway != nil && strings.EqualFold(configs[i].Gateway.Code, code) {
+			return &configs[i], nil
+		}
+	}
+	return nil, paymenterrors.ErrorPaymentGatewayNotConfigured
+}
+
+func (s *PaymentTokenServiceImpl) savePaymentMethod(
+	ctx context.Context,
+	userID uint,
+	gatewayID uint,
+	methodType entity.PaymentMethodType,
+	exchanged *model.ExchangeTokenResponse,
+	setDefault bool,
+) (*entity.PaymentMethod, error) {
+	method, err := s.paymentMethodRepo.FindByGatewayPaymentMethodID(
+		ctx,
+		userID,
+		gatewayID,
+		exchanged.GatewayPaymentMethodID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if method == nil {
+		method = &entity.PaymentMethod{
+			UserID:                 userID,
+			GatewayID:              gatewayID,
+			GatewayPaymentMethodID: exchanged.GatewayPaymentMethodID,
+		}
+	}
+
+	method.Type = methodType
+	method.GatewayCustomerID = exchanged.GatewayCustomerID
+	method.DisplayName = paymentUtils.BuildPaymentMethodDisplayName(
+		methodType,
+		exchanged.Brand,
+		exchanged.Last4,
+	)
+	method.Metadata = entity.PaymentMethodMetadata{
+		"brand":       exchanged.Brand,
+		"last4":       exchanged.Last4,
+		"expiryMonth": exchanged.ExpiryMonth,
+		"expiryYear":  exchanged.ExpiryYear,
+	}
+	method.IsDefault = method.IsDefault || setDefault
+
+	if err := s.paymentMethodRepo.Save(ctx, method); err != nil {
+		return nil, err
+	}
+	if setDefault {
+		if err := s.paymentMethodRepo.ClearDefault(ctx, userID, method.ID); err != nil {
+			return nil, err
+		}
+	}
+	return method, nil
+}
@@ -0,0 +1,219 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"ecommerce-be/common/db"
+	"ecommerce-be/payment/entity"
+	paymenterrors "ecommerce-be/payment/error"
+	"ecommerce-be/payment/factory"
+	"ecommerce-be/payment/model"
+	"ecommerce-be/payment/repository"
+	paymentUtils "ecommerce-be/payment/utils"
+	"ecommerce-be/payment/utils/constant"
+)
+
+// SellerLedgerService keeps each seller's ledger: what their sales earned, what the
+// platform and gateways kept, what was refunded and what was paid out. Other modules
+// post sales to it when an order is paid.
+type SellerLedgerService interface {
+	// RecordSale posts a sale with the platform commission and gateway fee it carries.
+	// Call it inside the transaction that marks the order paid; the commission is the one
+	// recorded on the order's lines when it was placed.
+	RecordSale(ctx context.Context, req model.RecordSaleRequest) error
+	// GetBalance returns what the platform owes the seller, per currency
+	GetBalance(ctx context.Context, sellerID uint) (*model.SellerBalanceResponse, error)
+	// RecordPayout records money transferred to the seller. A payout larger than the
+	// seller's available balance in the currency is rejected.
+	RecordPayout(
+		ctx context.Context,
+		sellerID uint,
+		recordedBy uint,
+		req model.RecordPayoutRequest,
+	) (*model.SellerPayoutResponse, error)
+	// ExportPayoutReport renders the seller's ledger for the period as CSV records
+	ExportPayoutReport(
+		ctx context.Context,
+		sellerID uint,
+		filter model.PayoutReportFilter,
+	) ([][]string, error)
+}
+
+type SellerLedgerServiceImpl struct {
+	ledgerRepo      repository.SellerLedgerRepository
+	payoutRepo      repository.SellerPayoutRepository
+	transactionRepo repository.PaymentTransactionRepository
+}
+
+func NewSellerLedgerService(
+	ledgerRepo repository.SellerLedgerRepository,
+	payoutRepo repository.SellerPayoutRepository,
+	transactionRepo repository.PaymentTransactionRepository,
+) SellerLedgerService {
+	return &SellerLedgerServiceImpl{
+		ledgerRepo:      ledgerRepo,
+		payoutRepo:      payoutRepo,
+		transactionRepo: transactionRepo,
+	}
+}
+
+// RecordSale credits the seller with the sale and debits the commission and, when the
+// sale was paid through a recorded gateway payment, its share of the gateway fee
+func (s *SellerLedgerServiceImpl) RecordSale(
+	ctx context.Context,
+	req model.RecordSaleRequest,
+) error {
+	if req.AmountCents <= 0 {
+		return nil
+	}
+	reference := strings.TrimSpace(req.PaymentReference)
+	var transaction *entity.PaymentTransaction
+	if reference != "" {
+		var err error
+		transaction, err = s.transactionRepo.FindByReference(ctx, req.SellerID, reference)
+		if err != nil {
+			return err
+		}
+	}
+
+	currency := strings.ToUpper(strings.TrimSpace(req.Currency))
+	occurredAt := req.OccurredAt
+	if occurredAt.IsZero() {
+		occurredAt = time.Now().UTC()
+	}
+	var feeCents int64
+	if transaction != nil {
+		currency = transaction.Currency
+		feeCents = paymentUtils.GatewayFeeShare(
+			transaction.GatewayFeeCents, transaction.AmountCents, req.AmountCents)
+	}
+
+	newEntry := func(
+		entryType entity.LedgerEntryType,
+		amount int64,
+		format string,
+	) entity.SellerLedgerEntry {
+		entry := paymentUtils.NewLedgerEntry(req.SellerID, entryType, currency, amount, occurredAt)
+		entry.OrderID = &req.OrderID
+		entry.Reference = reference
+		entry.Description = fmt.Sprintf(format, req.OrderID)
+		if transaction != nil {
+			entry.PaymentTransactionID = &transaction.ID
+		}
+		return entry
+	}
+
+	entries := []entity.SellerLedgerEntry{
+		newEntry(entity.LedgerEntryTypeSale, req.AmountCents, constant.LEDGER_SALE_DESCRIPTION),
+	}
+	if commission := min(req.CommissionCents, req.AmountCents); commission > 0 {
+		entries = append(entries, newEntry(
+			entity.LedgerEntryTypeCommission, commission, constant.LEDGER_COMMISSION_DESCRIPTION))
+	}
+	if feeCents > 0 {
+		entries = append(entries, newEntry(
+			entity.LedgerEntryTypeGatewayFee, feeCents, constant.LEDGER_GATEWAY_FEE_DESCRIPTION))
+	}
+	return s.ledgerRepo.CreateEntries(ctx, entries)
+}
+
+func (s *SellerLedgerServiceImpl) GetBalance(
+	ctx context.Context,
+	sellerID uint,
+) (*model.SellerBalanceResponse, error) {
+	totals, err := s.ledgerRepo.SumBySeller(ctx, sellerID, "")
+	if err != nil {
+		return nil, err
+	}
+	return &model.SellerBalanceResponse{
+		SellerID: sellerID,
+		Balances: paymentUtils.BuildSellerBalances(totals),
+	}, nil
+}
+
+// RecordPayout holds the seller's ledger lock while it checks the balance, so two
+// payouts recorded together cannot both spend the same money
+func (s *SellerLedgerServiceImpl) RecordPayout(
+	ctx context.Context,
+	sellerID uint,
+	recordedBy uint,
+	req model.RecordPayoutRequest,
+) (*model.SellerPayoutResponse, error) {
+	currency := strings.ToUpper(strings.TrimSpace(req.Currency))
+	paidAt := time.Now().UTC()
+	if req.PaidAt != nil {
+		paidAt = req.PaidAt.UTC()
+	}
+
+	return db.WithTransactionResult(
+		ctx,
+		func(txCtx context.Context) (*model.SellerPayoutResponse, error) {
+			if err := s.ledgerRepo.LockSeller(txCtx, sellerID); err != nil {
+				return nil, err
+			}
+			totals, err := s.ledgerRepo.SumBySeller(txCtx, sellerID, currency)
+			if err != nil {
+				return nil, err
+			}
+			var available int64
+			for _, balance := range paymentUtils.BuildSellerBalances(totals) {
+				available += balance.AvailableCents
+			}
+			if req.AmountCents > available {
+				return nil, paymenterrors.ErrorPayoutExceedsBalance
+			}
+
+			payout := &entity.SellerPayout{
+				PayoutID:    paymentUtils.GeneratePayoutID(),
+				SellerID:    sellerID,
+				Currency:    currency,
+				AmountCents: req.AmountCents,
+				Reference:   strings.TrimSpace(req.Reference),
+				Notes:       strings.TrimSpace(req.Notes),
+				PaidAt:      paidAt,
+				RecordedBy:  &recordedBy,
+			}
+			if err := s.payoutRepo.Create(txCtx, payout); err != nil {
+				return nil, err
+			}
+
+			entry := paymentUtils.NewLedgerEntry(sellerID, entity.LedgerEntryTypePayout,
+				currency, payout.AmountCents, paidAt)
+			entry.PayoutID = &payout.ID
+			entry.Reference = payout.PayoutID
+			entry.Description = fmt.Sprintf(constant.LEDGER_PAYOUT_DESCRIPTION, payout.PayoutID)
+			if err := s.ledgerRepo.CreateEntries(
+				txCtx, []entity.SellerLedgerEntry{entry}); err != nil {
+				return nil, err
+			}
+
+			return factory.BuildSellerPayoutResponse(payout, available-payout.AmountCents), nil
+		},
+	)
+}
+
+func (s *SellerLedgerServiceImpl) ExportPayoutReport(
+	ctx context.Context,
+	sellerID uint,
+	filter model.PayoutReportFilter,
+) ([][]string, error) {
+	start, end, err := paymentUtils.ResolvePayoutReportPeriod(
+		filter.From, filter.To, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+	currency := strings.ToUpper(strings.TrimSpace(filter.Currency))
+
+	opening, err := s.ledgerRepo.BalanceBefore(ctx, sellerID, currency, start)
+	if err != nil {
+		return nil, err
+	}
+	entries, err := s.ledgerRepo.FindEntries(ctx, sellerID, currency, start, end)
+	if err != nil {
+		return nil, err
+	}
+	return paymentUtils.BuildPayoutReportCSV(currency, start, opening, entries), nil
+}
@@ -0,0 +1,111 @@
+package utils
+
+import (
+	"slices"
+
+	"ecommerce-be/payment/entity"
+	paymenterrors "ecommerce-be/payment/error"
+	"ecommerce-be/payment/model"
+)
+
+// ValidateCommissionRule rejects a rule that charges nothing in the unit its rate type
+// uses, or sets the rate of the other type
+func ValidateCommissionRule(req model.CommissionRuleRequest) error {
+	switch entity.CommissionRateType(req.RateType) {
+	case entity.CommissionRatePercentage:
+		if req.FlatCents != 0 {
+			return paymenterrors.ErrorCommissionRuleInvalid
+		}
+	case entity.CommissionRateFlat:
+		if req.RateBasisPoints != 0 {
+			return paymenterrors.ErrorCommissionRuleInvalid
+		}
+	default:
+		return paymenterrors.ErrorCommissionRuleInvalid
+	}
+	return nil
+}
+
+// commissionRuleSpecificity ranks how narrowly a rule applies: seller and category,
+// seller only, category only, then the global default
+func commissionRuleSpecificity(rule entity.CommissionRule) int {
+	switch {
+	case rule.SellerID != nil && rule.CategoryID != nil:
+		return 3
+	case rule.SellerID != nil:
+		return 2
+	case rule.CategoryID != nil:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// MatchCommissionRule picks the most specific active rule covering the seller's product
+// in the category, or nil when none does
+func MatchCommissionRule(
+	rules []entity.CommissionRule,
+	sellerID uint,
+	categoryID uint,
+) *entity.CommissionRule {
+	var match *entity.CommissionRule
+	for i := range rules {
+		rule := &rules[i]
+		if !rule.IsActive ||
+			(rule.SellerID != nil && *rule.SellerID != sellerID) ||
+			(rule.CategoryID != nil && *rule.CategoryID != categoryID) {
+			continue
+		}
+		if match == nil || commissionRuleSpecificity(*rule) > commissionRuleSpecificity(*match) {
+			match = rule
+		}
+	}
+	return match
+}
+
+// LineCommissionCents is what the rule charges on a line of quantity units totalling
+// lineTotalCents. A flat commission never exceeds the line.
+func LineCommissionCents(rule entity.CommissionRule, quantity int, lineTotalCents int64) int64 {
+	if lineTotalCents <= 0 {
+		return 0
+	}
+	if rule.RateType == entity.CommissionRateFlat {
+		return min(rule.FlatCents*int64(max(quantity, 0)), lineTotalCents)
+	}
+	return CommissionCents(lineTotalCents, rule.RateBasisPoints)
+}
+
+// SpreadDiscountCents splits an order-level discount across lines in proportion to their
+// totals. The cents lost to rounding go to the lines with the largest remainders, so the
+// shares add up to the discount, and no line is discounted below zero.
+func SpreadDiscountCents(lineTotalsCents []int64, discountCents int64) []int64 {
+	shares := make([]int64, len(lineTotalsCents))
+	var total int64
+	for _, lineTotal := range lineTotalsCents {
+		total += max(lineTotal, 0)
+	}
+	if discountCents <= 0 || total <= 0 {
+		return shares
+	}
+	discountCents = min(discountCents, total)
+
+	remainders := make([]int64, len(lineTotalsCents))
+	order := make([]int, 0, len(lineTotalsCents))
+	left := discountCents
+	for i, lineTotal := range lineTotalsCents {
+		if lineTotal <= 0 {
+			continue
+		}
+		shares[i] = discountCents * lineTotal / total
+		remainders[i] = discountCents * lineTotal % total
+		left -= shares[i]
+		order = append(order, i)
+	}
+	slices.SortStableFunc(order, func(a, b int) int {
+		return int(remainders[b] - remainders[a])
+	})
+	for _, i := range order[:left] {
+		shares[i]++
+	}
+	return shares
+}
@@ -0,0 +1,13 @@
+package constant
+
+const (
+	COMMISSION_RULES_FETCHED_MSG = "Commission rules fetched successfully"
+	COMMISSION_RULE_SAVED_MSG    = "Commission rule saved successfully"
+	COMMISSION_RULE_DELETED_MSG  = "Commission rule deleted successfully"
+
+	FAILED_TO_FETCH_COMMISSION_RULES_MSG = "Failed to fetch commission rules"
+	FAILED_TO_SAVE_COMMISSION_RULE_MSG   = "Failed to save commission rule"
+	FAILED_TO_DELETE_COMMISSION_RULE_MSG = "Failed to delete commission rule"
+
+	COMMISSION_RULES_FIELD_NAME = "rules"
+)
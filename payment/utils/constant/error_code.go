@@ -5,3 +5,30 @@ const (
 	PAYMENT_GATEWAY_NOT_ACTIVE_CODE    = "PAYMENT_GATEWAY_NOT_ACTIVE"
 	PAYMENT_GATEWAY_NOT_SUPPORTED_CODE = "PAYMENT_GATEWAY_NOT_SUPPORTED"
 )
+
+const (
+	PAYMENT_METHOD_NOT_SUPPORTED_CODE       = "PAYMENT_METHOD_NOT_SUPPORTED"
+	PAYMENT_METHOD_RULE_INVALID_LIMITS_CODE = "PAYMENT_METHOD_RULE_INVALID_LIMITS"
+	CUSTOMER_COUNTRY_UNRESOLVED_CODE        = "CUSTOMER_COUNTRY_UNRESOLVED"
+)
+
+const (
+	PAYMENT_TOKEN_INVALID_CODE          = "PAYMENT_TOKEN_INVALID"
+	PAYMENT_GATEWAY_NOT_CONFIGURED_CODE = "PAYMENT_GATEWAY_NOT_CONFIGURED"
+	PAYMENT_TOKEN_EXCHANGE_FAILED_CODE  = "PAYMENT_TOKEN_EXCHANGE_FAILED"
+)
+
+const (
+	PAYMENT_INTENT_FAILED_CODE = "PAYMENT_INTENT_FAILED"
+)
+
+const (
+	PAYOUT_EXCEEDS_BALANCE_CODE = "PAYOUT_EXCEEDS_BALANCE"
+	LEDGER_INVALID_PERIOD_CODE  = "LEDGER_INVALID_PERIOD"
+)
+
+const (
+	COMMISSION_RULE_NOT_FOUND_CODE = "COMMISSION_RULE_NOT_FOUND"
+	COMMISSION_RULE_EXISTS_CODE    = "COMMISSION_RULE_EXISTS"
+	COMMISSION_RULE_INVALID_CODE   = "COMMISSION_RULE_INVALID"
+)
@@ -5,3 +5,30 @@ const (
 	PAYMENT_GATEWAY_NOT_ACTIVE_MESSAGE    = "Payment gateway not active"
 	PAYMENT_GATEWAY_NOT_SUPPORTED_MESSAGE = "Payment gateway not supported"
 )
+
+const (
+	PAYMENT_METHOD_NOT_SUPPORTED_MESSAGE       = "Payment method not supported"
+	PAYMENT_METHOD_RULE_INVALID_LIMITS_MESSAGE = "Minimum amount must not exceed maximum amount"
+	CUSTOMER_COUNTRY_UNRESOLVED_MESSAGE        = "Unable to determine customer country; provide an address or set a default address"
+)
+
+const (
+	PAYMENT_TOKEN_INVALID_MESSAGE          = "Payment token is invalid"
+	PAYMENT_GATEWAY_NOT_CONFIGURED_MESSAGE = "Payment gateway is not configured for this seller"
+	PAYMENT_TOKEN_EXCHANGE_FAILED_MESSAGE  = "Payment gateway did not return a reusable payment method"
+)
+
+const (
+	PAYMENT_INTENT_FAILED_MESSAGE = "Payment gateway could not start the payment"
+)
+
+const (
+	PAYOUT_EXCEEDS_BALANCE_MESSAGE = "Payout exceeds the seller's available balance"
+	LEDGER_INVALID_PERIOD_MESSAGE  = "The report start date must not be after its end date"
+)
+
+const (
+	COMMISSION_RULE_NOT_FOUND_MESSAGE = "Commission rule not found"
+	COMMISSION_RULE_EXISTS_MESSAGE    = "A commission rule already exists for this seller and category"
+	COMMISSION_RULE_INVALID_MESSAGE   = "Percentage rules take rateBasisPoints and flat rules take flatCents"
+)
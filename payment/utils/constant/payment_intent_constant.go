@@ -0,0 +1,9 @@
+package constant
+
+const (
+	// TRANSACTION_ID_PREFIX starts the platform transaction ID (payment_transaction.transaction_id)
+	TRANSACTION_ID_PREFIX = "TXN-"
+
+	// PAYMENT_INTENT_ORDER_ID_METADATA_KEY links a payment to the order it collects for
+	PAYMENT_INTENT_ORDER_ID_METADATA_KEY = "orderId"
+)
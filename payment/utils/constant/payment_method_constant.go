@@ -0,0 +1,17 @@
+package constant
+
+const (
+	PAYMENT_METHODS_FETCHED_MSG      = "Payment methods fetched successfully"
+	PAYMENT_METHOD_RULES_FETCHED_MSG = "Payment method rules fetched successfully"
+	PAYMENT_METHOD_RULE_SAVED_MSG    = "Payment method rule saved successfully"
+
+	FAILED_TO_FETCH_PAYMENT_METHODS_MSG      = "Failed to fetch payment methods"
+	FAILED_TO_FETCH_PAYMENT_METHOD_RULES_MSG = "Failed to fetch payment method rules"
+	FAILED_TO_SAVE_PAYMENT_METHOD_RULE_MSG   = "Failed to save payment method rule"
+)
+
+const (
+	// Platform default BNPL order limits in minor units; sellers may override via rules.
+	BNPL_DEFAULT_MIN_AMOUNT_CENTS int64 = 1000
+	BNPL_DEFAULT_MAX_AMOUNT_CENTS int64 = 200000
+)
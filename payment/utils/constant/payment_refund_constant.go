@@ -0,0 +1,12 @@
+package constant
+
+const (
+	// REFUND_ID_PREFIX starts the platform refund ID (payment_refund.refund_id)
+	REFUND_ID_PREFIX = "RFD-"
+
+	// REFUND_ORDER_ID_METADATA_KEY links a refund to the order it was issued for
+	REFUND_ORDER_ID_METADATA_KEY = "orderId"
+
+	REFUND_GATEWAY_NOT_CONFIGURED_MSG = "The payment gateway is no longer configured for the seller"
+	REFUND_GATEWAY_REJECTED_MSG       = "The payment gateway rejected the refund"
+)
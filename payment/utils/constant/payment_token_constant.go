@@ -0,0 +1,14 @@
+package constant
+
+const (
+	PAYMENT_TOKEN_EXCHANGED_MSG = "Payment token exchanged successfully"
+
+	FAILED_TO_EXCHANGE_PAYMENT_TOKEN_MSG = "Failed to exchange payment token"
+)
+
+const (
+	// PAYMENT_TOKEN_MAX_LENGTH matches payment_method.gateway_payment_method_id
+	PAYMENT_TOKEN_MAX_LENGTH = 255
+
+	PAYMENT_METHOD_FIELD_NAME = "paymentMethod"
+)
@@ -0,0 +1,31 @@
+package constant
+
+const (
+	// PAYOUT_ID_PREFIX starts the platform payout ID (seller_payout.payout_id)
+	PAYOUT_ID_PREFIX = "PO-"
+
+	// SELLER_LEDGER_LOCK_NAMESPACE keys the per-seller advisory lock held while a payout
+	// is checked against the balance and recorded
+	SELLER_LEDGER_LOCK_NAMESPACE = 7264012
+
+	// PAYOUT_REPORT_OPENING_BALANCE labels the first row of a payout report
+	PAYOUT_REPORT_OPENING_BALANCE = "opening_balance"
+
+	SELLER_BALANCE_FETCHED_MSG = "Seller balance fetched successfully"
+	SELLER_PAYOUT_RECORDED_MSG = "Seller payout recorded successfully"
+
+	FAILED_TO_FETCH_SELLER_BALANCE_MSG = "Failed to fetch seller balance"
+	FAILED_TO_RECORD_SELLER_PAYOUT_MSG = "Failed to record seller payout"
+	FAILED_TO_EXPORT_PAYOUT_REPORT_MSG = "Failed to export payout report"
+
+	SELLER_PAYOUT_FIELD_NAME = "payout"
+)
+
+// Ledger entry descriptions
+const (
+	LEDGER_SALE_DESCRIPTION        = "Sale for order #%d"
+	LEDGER_COMMISSION_DESCRIPTION  = "Platform commission for order #%d"
+	LEDGER_GATEWAY_FEE_DESCRIPTION = "Gateway fee for order #%d"
+	LEDGER_REFUND_DESCRIPTION      = "Refund %s"
+	LEDGER_PAYOUT_DESCRIPTION      = "Payout %s"
+)
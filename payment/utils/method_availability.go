@@ -0,0 +1,162 @@
+package utils
+
+import (
+	"sort"
+	"strings"
+
+	"ecommerce-be/payment/entity"
+	"ecommerce-be/payment/utils/constant"
+)
+
+// methodDisplayOrder is the order in which methods are presented at checkout.
+var methodDisplayOrder = map[entity.PaymentMethodType]int{
+	entity.PaymentMethodTypeCard:         0,
+	entity.PaymentMethodTypeUPI:          1,
+	entity.PaymentMethodTypeWallet:       2,
+	entity.PaymentMethodTypeBankTransfer: 3,
+	entity.PaymentMethodTypeEMI:          4,
+	entity.PaymentMethodTypeBNPL:         5,
+	entity.PaymentMethodTypeCOD:          6,
+}
+
+// MethodAvailabilityInput carries everything needed to decide which methods a
+// customer may use for a given checkout.
+type MethodAvailabilityInput struct {
+	Currency        string
+	AmountCents     int64
+	CustomerCountry string
+	SellerCountry   string
+	Gateways        []entity.PaymentGateway // Seller's active gateways, highest priority first
+	Rules           []entity.PaymentMethodRule
+}
+
+// AvailableMethod is a payment method usable at checkout and the gateways that can
+// process it (empty for offline methods such as COD).
+type AvailableMethod struct {
+	Method       entity.PaymentMethodType
+	GatewayCodes []string
+}
+
+// ResolveAvailableMethods filters gateway capabilities by currency and customer
+// country, then applies seller rules and platform defaults:
+//   - COD is offline, must be enabled by a seller rule, and is always domestic only
+//   - BNPL is bounded by default amount limits unless the seller overrides them
+//   - any rule can disable a method, bound its amount, or restrict it to domestic orders
+func ResolveAvailableMethods(in MethodAvailabilityInput) []AvailableMethod {
+	currency := strings.ToUpper(strings.TrimSpace(in.Currency))
+	customerCountry := strings.ToUpper(strings.TrimSpace(in.CustomerCountry))
+	sellerCountry := strings.ToUpper(strings.TrimSpace(in.SellerCountry))
+
+	candidates := make(map[entity.PaymentMethodType][]string)
+	for _, gw := range in.Gateways {
+		if !containsFold(gw.SupportedCurrencies, currency) {
+			continue
+		}
+		if len(gw.SupportedCountries) > 0 && !containsFold(gw.SupportedCountries, customerCountry) {
+			continue
+		}
+		for _, m := range gw.SupportedPaymentMethods {
+			method := entity.PaymentMethodType(strings.ToLower(strings.TrimSpace(m)))
+			if method == "" || method == entity.PaymentMethodTypeCOD {
+				continue
+			}
+			if !containsString(candidates[method], gw.Code) {
+				candidates[method] = append(candidates[method], gw.Code)
+			}
+		}
+	}
+
+	rules := make(map[entity.PaymentMethodType]entity.PaymentMethodRule, len(in.Rules))
+	for _, rule := range in.Rules {
+		rules[rule.Method] = rule
+	}
+	if rule, ok := rules[entity.PaymentMethodTypeCOD]; ok && rule.IsEnabled {
+		candidates[entity.PaymentMethodTypeCOD] = []string{}
+	}
+
+	domestic := customerCountry != "" && customerCountry == sellerCountry
+
+	available := make([]AvailableMethod, 0, len(candidates))
+	for method, gateways := range candidates {
+		rule := effectiveRule(method, rules)
+		if !rule.IsEnabled {
+			continue
+		}
+		if rule.DomesticOnly && !domestic {
+			continue
+		}
+		if rule.MinAmountCents != nil && in.AmountCents < *rule.MinAmountCents {
+			continue
+		}
+		if rule.MaxAmountCents != nil && in.AmountCents > *rule.MaxAmountCents {
+			continue
+		}
+		available = append(available, AvailableMethod{Method: method, GatewayCodes: gateways})
+	}
+
+	sort.Slice(available, func(i, j int) bool {
+		oi, iKnown := methodDisplayOrder[available[i].Method]
+		oj, jKnown := methodDisplayOrder[available[j].Method]
+		if iKnown != jKnown {
+			return iKnown
+		}
+		if oi != oj {
+			return oi < oj
+		}
+		return available[i].Method < available[j].Method
+	})
+	return available
+}
+
+// effectiveRule merges the seller rule for a method with platform defaults.
+func effectiveRule(
+	method entity.PaymentMethodType,
+	rules map[entity.PaymentMethodType]entity.PaymentMethodRule,
+) entity.PaymentMethodRule {
+	rule, ok := rules[method]
+	if !ok {
+		rule = entity.PaymentMethodRule{Method: method, IsEnabled: true}
+	}
+
+	switch method {
+	case entity.PaymentMethodTypeCOD:
+		rule.DomesticOnly = true
+	case entity.PaymentMethodTypeBNPL:
+		if rule.MinAmountCents == nil {
+			minAmount := constant.BNPL_DEFAULT_MIN_AMOUNT_CENTS
+			rule.MinAmountCents = &minAmount
+		}
+		if rule.MaxAmountCents == nil {
+			maxAmount := constant.BNPL_DEFAULT_MAX_AMOUNT_CENTS
+			rule.MaxAmountCents = &maxAmount
+		}
+	}
+	return rule
+}
+
+func containsFold(values []string, target string) bool {
+	if target == "" {
+		return false
+	}
+	for _, v := range values {
+		if strings.EqualFold(strings.TrimSpace(v), target) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsString(values []string, target string) bool {
+	for _, v := range values {
+		if v == target {
+			return true
+		}
+	}
+	return false
+}
+
+// IsCheckoutPaymentMethod reports whether method can be configured for checkout.
+func IsCheckoutPaymentMethod(method entity.PaymentMethodType) bool {
+	_, ok := methodDisplayOrder[method]
+	return ok
+}
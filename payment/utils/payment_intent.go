@@ -0,0 +1,12 @@
+package utils
+
+import (
+	"ecommerce-be/payment/utils/constant"
+
+	"github.com/google/uuid"
+)
+
+// GenerateTransactionID returns a new platform transaction ID
+func GenerateTransactionID() string {
+	return constant.TRANSACTION_ID_PREFIX + uuid.NewString()
+}
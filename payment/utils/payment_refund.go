@@ -0,0 +1,44 @@
+package utils
+
+import (
+	"ecommerce-be/payment/entity"
+	"ecommerce-be/payment/utils/constant"
+
+	"github.com/google/uuid"
+)
+
+// GenerateRefundID returns a new platform refund ID
+func GenerateRefundID() string {
+	return constant.REFUND_ID_PREFIX + uuid.NewString()
+}
+
+// IsRefundable reports whether a payment was captured through a gateway and still has
+// money on it. Cash on delivery and failed payments have nothing to send back.
+func IsRefundable(transaction entity.PaymentTransaction) bool {
+	if transaction.GatewayID == nil || transaction.GatewayTransactionID == "" {
+		return false
+	}
+	return transaction.Status == entity.TransactionStatusCompleted ||
+		transaction.Status == entity.TransactionStatusPartiallyRefunded
+}
+
+// RefundableCents is what is left to refund on a payment of amountCents. Refunds still in
+// flight count against it so the same money is never refunded twice; failed ones do not.
+func RefundableCents(amountCents int64, refunds []entity.PaymentRefund) int64 {
+	remaining := amountCents
+	for _, refund := range refunds {
+		if refund.Status != entity.RefundStatusFailed {
+			remaining -= refund.AmountCents
+		}
+	}
+	return max(remaining, 0)
+}
+
+// RefundedTransactionStatus is the status of a payment once a refund leaves remainingCents
+// on it
+func RefundedTransactionStatus(remainingCents int64) entity.TransactionStatus {
+	if remainingCents <= 0 {
+		return entity.TransactionStatusRefunded
+	}
+	return entity.TransactionStatusPartiallyRefunded
+}
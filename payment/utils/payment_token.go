@@ -0,0 +1,61 @@
+package utils
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"ecommerce-be/common/validator"
+	"ecommerce-be/payment/entity"
+	paymenterrors "ecommerce-be/payment/error"
+	"ecommerce-be/payment/utils/constant"
+)
+
+// paymentTokenPattern matches gateway token formats (e.g. "tok_1Abc", "pm.2:xyz")
+var paymentTokenPattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_.:\-]*$`)
+
+// ValidatePaymentToken rejects tokens that are malformed or look like a raw card number.
+// Gateways never issue tokens that pass the card number checks, so such a value means the
+// client sent card data instead of tokenizing it.
+func ValidatePaymentToken(token string) error {
+	if validator.ContainsCardNumber(token) {
+		return paymenterrors.ErrorCardDataNotAccepted
+	}
+	if len(token) > constant.PAYMENT_TOKEN_MAX_LENGTH || !paymentTokenPattern.MatchString(token) {
+		return paymenterrors.ErrorPaymentTokenInvalid
+	}
+	return nil
+}
+
+// GatewaySupportsMethod reports whether the gateway advertises the payment method type
+func GatewaySupportsMethod(gw entity.PaymentGateway, method entity.PaymentMethodType) bool {
+	return containsFold(gw.SupportedPaymentMethods, string(method))
+}
+
+// BuildPaymentMethodDisplayName labels a saved method for the UI, e.g. "Visa ending in 4242"
+func BuildPaymentMethodDisplayName(
+	method entity.PaymentMethodType,
+	brand string,
+	last4 string,
+) string {
+	brand = strings.TrimSpace(brand)
+	if brand == "" {
+		brand = methodDisplayLabels[method]
+	} else if method == entity.PaymentMethodTypeCard {
+		brand = strings.ToUpper(brand[:1]) + brand[1:]
+	}
+	if brand == "" {
+		brand = string(method)
+	}
+	if last4 = strings.TrimSpace(last4); last4 != "" {
+		return fmt.Sprintf("%s ending in %s", brand, last4)
+	}
+	return brand
+}
+
+var methodDisplayLabels = map[entity.PaymentMethodType]string{
+	entity.PaymentMethodTypeCard:        "Card",
+	entity.PaymentMethodTypeUPI:         "UPI",
+	entity.PaymentMethodTypeWallet:      "Wallet",
+	entity.PaymentMethodTypeBankAccount: "Bank account",
+}
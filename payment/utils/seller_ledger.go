@@ -0,0 +1,214 @@
+package utils
+
+import (
+	"sort"
+	"strconv"
+	"time"
+
+	"ecommerce-be/payment/entity"
+	paymenterrors "ecommerce-be/payment/error"
+	"ecommerce-be/payment/model"
+	"ecommerce-be/payment/utils/constant"
+
+	"github.com/google/uuid"
+)
+
+// GeneratePayoutID returns a new platform payout ID
+func GeneratePayoutID() string {
+	return constant.PAYOUT_ID_PREFIX + uuid.NewString()
+}
+
+// LedgerPosting is the account an entry of the type debits and the one it credits. Sales
+// are owed to the seller; everything else reduces what the seller is owed.
+func LedgerPosting(entryType entity.LedgerEntryType) (entity.LedgerAccount, entity.LedgerAccount) {
+	switch entryType {
+	case entity.LedgerEntryTypeSale:
+		return entity.LedgerAccountGatewayClearing, entity.LedgerAccountSellerPayable
+	case entity.LedgerEntryTypeCommission, entity.LedgerEntryTypeAdjustment:
+		return entity.LedgerAccountSellerPayable, entity.LedgerAccountPlatformRevenue
+	case entity.LedgerEntryTypeGatewayFee:
+		return entity.LedgerAccountSellerPayable, entity.LedgerAccountGatewayFees
+	case entity.LedgerEntryTypePayout:
+		return entity.LedgerAccountSellerPayable, entity.LedgerAccountSellerBank
+	default:
+		// Refunds and chargebacks send the money back through the gateway
+		return entity.LedgerAccountSellerPayable, entity.LedgerAccountGatewayClearing
+	}
+}
+
+// NewLedgerEntry builds an entry of the type with its posting filled in
+func NewLedgerEntry(
+	sellerID uint,
+	entryType entity.LedgerEntryType,
+	currency string,
+	amountCents int64,
+	occurredAt time.Time,
+) entity.SellerLedgerEntry {
+	debit, credit := LedgerPosting(entryType)
+	return entity.SellerLedgerEntry{
+		SellerID:      sellerID,
+		EntryType:     entryType,
+		Currency:      currency,
+		AmountCents:   amountCents,
+		OccurredAt:    occurredAt,
+		DebitAccount:  debit,
+		CreditAccount: credit,
+	}
+}
+
+// CommissionCents is the platform's commission on a sale of amountCents at basisPoints,
+// rounded half up to the cent
+func CommissionCents(amountCents int64, basisPoints int) int64 {
+	if amountCents <= 0 || basisPoints <= 0 {
+		return 0
+	}
+	return (amountCents*int64(basisPoints) + 5000) / 10000
+}
+
+// GatewayFeeShare is the part of a payment's gateway fee carried by a sale of saleCents,
+// so a payment covering several sales is not charged its fee more than once
+func GatewayFeeShare(feeCents, paymentCents, saleCents int64) int64 {
+	if feeCents <= 0 || paymentCents <= 0 || saleCents <= 0 {
+		return 0
+	}
+	if saleCents >= paymentCents {
+		return feeCents
+	}
+	return feeCents * saleCents / paymentCents
+}
+
+// ResolvePayoutReportPeriod turns the inclusive report days into a [start, end) window.
+// The report defaults to the current month up to today.
+func ResolvePayoutReportPeriod(from, to *time.Time, now time.Time) (time.Time, time.Time, error) {
+	now = now.UTC()
+	start := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	if from != nil {
+		start = time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC)
+	}
+	end := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	if to != nil {
+		end = time.Date(to.Year(), to.Month(), to.Day(), 0, 0, 0, 0, time.UTC)
+	}
+	if start.After(end) {
+		return time.Time{}, time.Time{}, paymenterrors.ErrorLedgerInvalidPeriod
+	}
+	return start, end.AddDate(0, 0, 1), nil
+}
+
+// SellerPayableDelta is how a posting of amountCents changes what the platform owes the
+// seller: credits to seller_payable add to it, debits take from it
+func SellerPayableDelta(debit, credit entity.LedgerAccount, amountCents int64) int64 {
+	switch {
+	case credit == entity.LedgerAccountSellerPayable:
+		return amountCents
+	case debit == entity.LedgerAccountSellerPayable:
+		return -amountCents
+	default:
+		return 0
+	}
+}
+
+// BuildSellerBalances folds a seller's ledger totals into one balance per currency,
+// sorted by currency
+func BuildSellerBalances(totals []model.LedgerTotal) []model.SellerCurrencyBalance {
+	byCurrency := make(map[string]*model.SellerCurrencyBalance)
+	currencies := make([]string, 0)
+	for _, total := range totals {
+		balance, ok := byCurrency[total.Currency]
+		if !ok {
+			balance = &model.SellerCurrencyBalance{Currency: total.Currency}
+			byCurrency[total.Currency] = balance
+			currencies = append(currencies, total.Currency)
+		}
+
+		switch total.EntryType {
+		case entity.LedgerEntryTypeSale:
+			balance.GrossSalesCents += total.AmountCents
+		case entity.LedgerEntryTypeCommission:
+			balance.CommissionCents += total.AmountCents
+		case entity.LedgerEntryTypeGatewayFee:
+			balance.GatewayFeeCents += total.AmountCents
+		case entity.LedgerEntryTypeRefund:
+			balance.RefundCents += total.AmountCents
+		case entity.LedgerEntryTypeChargeback:
+			balance.ChargebackCents += total.AmountCents
+		case entity.LedgerEntryTypeAdjustment:
+			balance.AdjustmentCents += total.AmountCents
+		case entity.LedgerEntryTypePayout:
+			balance.PayoutCents += total.AmountCents
+		}
+		balance.AvailableCents += SellerPayableDelta(
+			total.DebitAccount, total.CreditAccount, total.AmountCents)
+	}
+
+	sort.Strings(currencies)
+	balances := make([]model.SellerCurrencyBalance, 0, len(currencies))
+	for _, currency := range currencies {
+		balances = append(balances, *byCurrency[currency])
+	}
+	return balances
+}
+
+// BuildPayoutReportCSV renders a seller's ledger entries in one currency as CSV records
+// for accounting: a header, the opening balance, then one row per entry with its posting
+// and the running balance owed to the seller
+func BuildPayoutReportCSV(
+	currency string,
+	from time.Time,
+	openingBalanceCents int64,
+	entries []entity.SellerLedgerEntry,
+) [][]string {
+	records := make([][]string, 0, len(entries)+2)
+	records = append(records, []string{
+		"occurred_at",
+		"entry_type",
+		"currency",
+		"reference",
+		"order_id",
+		"description",
+		"debit_account",
+		"credit_account",
+		"debit_cents",
+		"credit_cents",
+		"balance_cents",
+	})
+	records = append(records, []string{
+		from.UTC().Format(time.RFC3339),
+		constant.PAYOUT_REPORT_OPENING_BALANCE,
+		currency,
+		"", "", "", "", "", "", "",
+		strconv.FormatInt(openingBalanceCents, 10),
+	})
+
+	balance := openingBalanceCents
+	for _, entry := range entries {
+		delta := SellerPayableDelta(entry.DebitAccount, entry.CreditAccount, entry.AmountCents)
+		balance += delta
+
+		debitCents, creditCents := "", ""
+		if delta < 0 {
+			debitCents = strconv.FormatInt(entry.AmountCents, 10)
+		} else {
+			creditCents = strconv.FormatInt(entry.AmountCents, 10)
+		}
+		orderID := ""
+		if entry.OrderID != nil {
+			orderID = strconv.FormatUint(uint64(*entry.OrderID), 10)
+		}
+
+		records = append(records, []string{
+			entry.OccurredAt.UTC().Format(time.RFC3339),
+			string(entry.EntryType),
+			entry.Currency,
+			entry.Reference,
+			orderID,
+			entry.Description,
+			string(entry.DebitAccount),
+			string(entry.CreditAccount),
+			debitCents,
+			creditCents,
+			strconv.FormatInt(balance, 10),
+		})
+	}
+	return records
+}
@@ -1,10 +1,25 @@
 package product
 
 import (
+	"context"
+	"time"
+
 	"ecommerce-be/common"
+	"ecommerce-be/common/config"
+	"ecommerce-be/common/constants"
+	"ecommerce-be/common/cron"
+	"ecommerce-be/common/lifecycle"
+	"ecommerce-be/common/log"
+	msgFactory "ecommerce-be/common/messaging/factory"
+	"ecommerce-be/product/factory/singleton"
 	"ecommerce-be/product/route"
+	"ecommerce-be/product/rpc"
+	"ecommerce-be/product/service"
+	"ecommerce-be/product/utils"
+	productv1 "ecommerce-be/proto/product/v1"
 
 	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc"
 )
 
 /* NewContainer initializes dependencies dynamically */
@@ -20,9 +35,70 @@ func NewContainer(router *gin.Engine) *common.Container {
 		module.RegisterRoutes(router)
 	}
 
+	/* Evict cached product data when other modules publish product changes */
+	startCacheInvalidationConsumer()
+
+	/* Register recurring background jobs */
+	registerScheduler()
+
 	return c
 }
 
+/* startCacheInvalidationConsumer consumes product.product.changed events when messaging is enabled */
+func startCacheInvalidationConsumer() {
+	cfg := config.Get()
+	if cfg == nil || !cfg.Messaging.Enabled {
+		return
+	}
+
+	mf, err := msgFactory.New("")
+	if err != nil {
+		log.Error("product cache invalidation: messaging factory unavailable", err)
+		return
+	}
+	consumer, err := mf.Consumer()
+	if err != nil {
+		log.Error("product cache invalidation: consumer unavailable", err)
+		return
+	}
+
+	lifecycle.Go("product cache invalidation consumer", func(ctx context.Context) {
+		err := consumer.Consume(
+			ctx,
+			constants.QUEUE_PRODUCT_CACHE_INVALIDATION,
+			service.HandleProductChanged,
+		)
+		if err != nil && ctx.Err() == nil {
+			log.Error("product cache invalidation: consumer stopped", err)
+		}
+	})
+}
+
+/*
+registerScheduler keeps seller sitemaps fresh between publish events and rebuilds
+recommendations from order history overnight
+*/
+func registerScheduler() {
+	cron.RegisterIntervalJob(
+		utils.SITEMAP_REFRESH_INTERVAL_HOURS*time.Hour,
+		utils.SITEMAP_REFRESH_JOB_NAME,
+		singleton.GetInstance().GetSitemapService().RegenerateAll,
+	)
+	cron.RegisterDailyJob(
+		utils.RECOMMENDATION_REBUILD_HOUR_UTC,
+		0,
+		"UTC",
+		utils.RECOMMENDATION_REBUILD_JOB_NAME,
+		singleton.GetInstance().GetRecommendationService().Rebuild,
+	)
+}
+
+/* RegisterGRPC registers the product read APIs on the internal gRPC server */
+func RegisterGRPC(server *grpc.Server) {
+	productQueryService := singleton.GetInstance().GetProductQueryService()
+	productv1.RegisterProductServiceServer(server, rpc.NewProductServer(productQueryService))
+}
+
 /* Register all modules (Categories, Products, Attributes, etc.) */
 // TODO: We havve to use cache for most of this APIs because this sevice is very frequently
 // use service by users so it is very important to use cache for this service and create this sevice by AI so
@@ -40,4 +116,8 @@ func addModules(c *common.Container) {
 	c.RegisterModule(route.NewWishlistModule())
 	c.RegisterModule(route.NewWishlistItemModule())
 	c.RegisterModule(route.NewCollectionModule())
+	c.RegisterModule(route.NewSponsoredPlacementModule())
+	c.RegisterModule(route.NewSitemapModule())
+	c.RegisterModule(route.NewDigitalProductModule())
+	c.RegisterModule(route.NewRecommendationModule())
 }
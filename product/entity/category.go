@@ -13,6 +13,12 @@ type Category struct {
 	IsGlobal bool  `json:"isGlobal"                       gorm:"column:is_global"`
 	SellerID *uint `json:"sellerId"                       gorm:"column:seller_id"`
 
+	// SEO: Slug is unique per seller (global categories share one scope)
+	Slug            string  `json:"slug"            gorm:"column:slug;size:200"`
+	MetaTitle       *string `json:"metaTitle"       gorm:"column:meta_title;size:255"`
+	MetaDescription *string `json:"metaDescription" gorm:"column:meta_description;size:500"`
+	CanonicalURL    *string `json:"canonicalUrl"    gorm:"column:canonical_url;size:2048"`
+
 	// Relationships - use pointers to avoid N+1 queries
 	Parent   *Category  `json:"parent,omitempty"   gorm:"foreignKey:parent_id;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT"`
 	Children []Category `json:"children,omitempty" gorm:"foreignKey:parent_id;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT"`
@@ -0,0 +1,58 @@
+package entity
+
+import (
+	"time"
+
+	"ecommerce-be/common/db"
+)
+
+// DigitalAsset is a downloadable file attached to a digital product. The file itself
+// lives in the file service and is referenced by its public file ID.
+type DigitalAsset struct {
+	db.BaseEntity
+	ProductID uint   `json:"productId" gorm:"column:product_id;not null"`
+	FileID    string `json:"fileId"    gorm:"column:file_id;size:80;not null"`
+	Name      string `json:"name"      gorm:"column:name;size:255;not null"`
+	SortOrder int    `json:"sortOrder" gorm:"column:sort_order;not null;default:0"`
+}
+
+// TableName specifies the table name
+func (DigitalAsset) TableName() string {
+	return "digital_asset"
+}
+
+// DigitalEntitlement grants the buyer of a paid order item access to the product's
+// downloads. DownloadLimit is copied from the product at delivery; nil is unlimited.
+type DigitalEntitlement struct {
+	db.BaseEntity
+	OrderID          uint       `json:"orderId"          gorm:"column:order_id;not null"`
+	OrderItemID      uint       `json:"orderItemId"      gorm:"column:order_item_id;not null"`
+	UserID           uint       `json:"userId"           gorm:"column:user_id;not null"`
+	SellerID         uint       `json:"sellerId"         gorm:"column:seller_id;not null"`
+	ProductID        uint       `json:"productId"        gorm:"column:product_id;not null"`
+	VariantID        uint       `json:"variantId"        gorm:"column:variant_id;not null"`
+	Quantity         int        `json:"quantity"         gorm:"column:quantity;not null"`
+	DownloadLimit    *int       `json:"downloadLimit"    gorm:"column:download_limit"`
+	DownloadCount    int        `json:"downloadCount"    gorm:"column:download_count;not null;default:0"`
+	LastDownloadedAt *time.Time `json:"lastDownloadedAt" gorm:"column:last_downloaded_at"`
+}
+
+// TableName specifies the table name
+func (DigitalEntitlement) TableName() string {
+	return "digital_entitlement"
+}
+
+// LicenseKey is one key of a digital product's pool. EntitlementID is nil while the
+// key is available and set once it has been issued to a purchase.
+type LicenseKey struct {
+	db.BaseEntity
+	ProductID     uint       `json:"productId"     gorm:"column:product_id;not null"`
+	KeyCode       string     `json:"keyCode"       gorm:"column:key_code;size:500;not null"`
+	EntitlementID *uint      `json:"entitlementId" gorm:"column:entitlement_id"`
+	AssignedAt    *time.Time `json:"assignedAt"    gorm:"column:assigned_at"`
+}
+
+// TableName specifies the table name
+func (LicenseKey) TableName() string {
+	return "license_key"
+}
@@ -15,6 +15,17 @@ type Product struct {
 	Tags             db.StringArray `json:"tags"                                gorm:"column:tags;type:text[]"`
 	SellerID         uint           `json:"sellerId"                            gorm:"column:seller_id"`
 
+	// SEO: Slug is unique per seller; changing it leaves a SlugRedirect behind
+	Slug            string  `json:"slug"            gorm:"column:slug;size:200"`
+	MetaTitle       *string `json:"metaTitle"       gorm:"column:meta_title;size:255"`
+	MetaDescription *string `json:"metaDescription" gorm:"column:meta_description;size:500"`
+	CanonicalURL    *string `json:"canonicalUrl"    gorm:"column:canonical_url;size:2048"`
+
+	// Digital products are delivered as downloads and license keys instead of being
+	// shipped. DownloadLimit caps downloads per purchase; nil is unlimited.
+	ProductType   string `json:"productType"   gorm:"column:product_type;size:20;default:physical"`
+	DownloadLimit *int   `json:"downloadLimit" gorm:"column:download_limit"`
+
 	// Relationships - use pointers to avoid N+1 queries
 	Category *Category `json:"category,omitempty" gorm:"foreignKey:category_id;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT"`
 }
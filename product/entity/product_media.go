@@ -6,12 +6,21 @@ import (
 
 // ProductMedia represents a product-owned association to an uploaded file-module asset.
 // Product cascades deletion to ProductMedia rows; there is no DB FK to file tables.
+//
+// MediaType is IMAGE, VIDEO or MODEL_3D. Videos may instead link an ExternalURL
+// (e.g. a hosted stream); such rows have a generated "ext_" FileID and no file asset.
+// ThumbnailFileID is an optional uploaded poster image for videos and 3D models.
 type ProductMedia struct {
 	db.BaseEntity
 	ProductID    uint   `gorm:"column:product_id;not null"`
 	FileID       string `gorm:"column:file_id;not null"`
 	IsPrimary    bool   `gorm:"column:is_primary;not null;default:false"`
 	DisplayOrder int    `gorm:"column:display_order;not null;default:0"`
+
+	MediaType       string  `gorm:"column:media_type;size:16;not null;default:IMAGE"`
+	ExternalURL     *string `gorm:"column:external_url;size:2048"`
+	ThumbnailFileID *string `gorm:"column:thumbnail_file_id;size:80"`
+	DurationSeconds *int    `gorm:"column:duration_seconds"`
 }
 
 func (ProductMedia) TableName() string {
@@ -0,0 +1,16 @@
+package entity
+
+import "ecommerce-be/common/db"
+
+// ProductOptionRule makes an option, or some of its values, available only when
+// another option of the same product is set to one of the condition values.
+// Example: Storage applies only when Color is Special Edition.
+type ProductOptionRule struct {
+	db.BaseEntity
+	ProductID uint `json:"productId" gorm:"column:product_id;not null"`
+	OptionID  uint `json:"optionId"  gorm:"column:option_id;not null"`
+	// OptionValueIDs limits the rule to these values; empty restricts the whole option
+	OptionValueIDs    db.Int64Array `json:"optionValueIds"    gorm:"column:option_value_ids;type:bigint[]"`
+	ConditionOptionID uint          `json:"conditionOptionId" gorm:"column:condition_option_id;not null"`
+	ConditionValueIDs db.Int64Array `json:"conditionValueIds" gorm:"column:condition_value_ids;type:bigint[]"`
+}
@@ -0,0 +1,32 @@
+package entity
+
+import "ecommerce-be/common/db"
+
+// ProductTranslation holds a product's content in one non-default locale.
+// Blank fields fall back to the default-locale value stored on Product.
+type ProductTranslation struct {
+	db.BaseEntity
+	ProductID        uint   `json:"productId"        gorm:"column:product_id;not null"`
+	Locale           string `json:"locale"           gorm:"column:locale;size:20;not null"`
+	Name             string `json:"name"             gorm:"column:name"`
+	ShortDescription string `json:"shortDescription" gorm:"column:short_description"`
+	LongDescription  string `json:"longDescription"  gorm:"column:long_description"`
+}
+
+// TableName specifies the table name
+func (ProductTranslation) TableName() string {
+	return "product_translation"
+}
+
+// ProductOptionTranslation holds an option's display name in one non-default locale
+type ProductOptionTranslation struct {
+	db.BaseEntity
+	OptionID    uint   `json:"optionId"    gorm:"column:option_id;not null"`
+	Locale      string `json:"locale"      gorm:"column:locale;size:20;not null"`
+	DisplayName string `json:"displayName" gorm:"column:display_name;not null"`
+}
+
+// TableName specifies the table name
+func (ProductOptionTranslation) TableName() string {
+	return "product_option_translation"
+}
@@ -12,6 +12,10 @@ type ProductVariant struct {
 	AllowPurchase bool    `json:"allowPurchase" gorm:"column:allow_purchase"`
 	IsPopular     bool    `json:"isPopular"     gorm:"column:is_popular;default:false"`
 	IsDefault     bool    `json:"isDefault"     gorm:"column:is_default;default:false"`
+	// Barcode is an EAN/UPC/GTIN code, unique per seller; nil when unset
+	Barcode *string `json:"barcode" gorm:"column:barcode;size:14"`
+	// Version is bumped by every price/stock update; writers compare-and-swap on it
+	Version int64 `json:"version" gorm:"column:version;not null;default:1"`
 
 	// Relationships - use pointers to avoid N+1 queries
 	Product *Product `json:"product,omitempty" gorm:"foreignKey:ProductID;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT"`
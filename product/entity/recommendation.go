@@ -0,0 +1,36 @@
+package entity
+
+import (
+	"time"
+)
+
+// ProductCoPurchase is another product of the same seller bought by customers of a
+// product. Rows are rebuilt nightly from order history.
+type ProductCoPurchase struct {
+	ProductID        uint `json:"productId"        gorm:"column:product_id;primaryKey"`
+	RelatedProductID uint `json:"relatedProductId" gorm:"column:related_product_id;primaryKey"`
+	SellerID         uint `json:"sellerId"         gorm:"column:seller_id;not null"`
+	// CustomerCount is how many customers bought both products
+	CustomerCount int       `json:"customerCount" gorm:"column:customer_count;not null"`
+	ComputedAt    time.Time `json:"computedAt"    gorm:"column:computed_at"`
+}
+
+// TableName specifies the table name
+func (ProductCoPurchase) TableName() string {
+	return "product_co_purchase"
+}
+
+// UserProductRecommendation is a product recommended to a customer from the
+// co-purchases of what they bought. Rows are rebuilt nightly from order history.
+type UserProductRecommendation struct {
+	UserID     uint      `json:"userId"     gorm:"column:user_id;primaryKey"`
+	ProductID  uint      `json:"productId"  gorm:"column:product_id;primaryKey"`
+	SellerID   uint      `json:"sellerId"   gorm:"column:seller_id;not null"`
+	Score      int       `json:"score"      gorm:"column:score;not null"`
+	ComputedAt time.Time `json:"computedAt" gorm:"column:computed_at"`
+}
+
+// TableName specifies the table name
+func (UserProductRecommendation) TableName() string {
+	return "user_product_recommendation"
+}
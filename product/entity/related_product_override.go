@@ -0,0 +1,29 @@
+package entity
+
+import (
+	"ecommerce-be/common/db"
+)
+
+// Related product override modes
+const (
+	// RELATED_OVERRIDE_MODE_PIN lists the related product ahead of the scored results
+	RELATED_OVERRIDE_MODE_PIN = "pin"
+	// RELATED_OVERRIDE_MODE_EXCLUDE keeps the related product out of the results
+	RELATED_OVERRIDE_MODE_EXCLUDE = "exclude"
+)
+
+// RelatedProductOverride is a seller's manual pin or exclusion of one product in the
+// related products of another
+type RelatedProductOverride struct {
+	db.BaseEntity
+	ProductID        uint   `json:"productId"        gorm:"column:product_id;not null"`
+	RelatedProductID uint   `json:"relatedProductId" gorm:"column:related_product_id;not null"`
+	Mode             string `json:"mode"             gorm:"column:mode;size:10;not null"`
+	// Position orders pins, lowest first; exclusions keep 0
+	Position int `json:"position" gorm:"column:position;not null;default:0"`
+}
+
+// TableName specifies the table name
+func (RelatedProductOverride) TableName() string {
+	return "related_product_override"
+}
@@ -0,0 +1,94 @@
+package entity
+
+import "ecommerce-be/common/db"
+
+// ListingSort is a storefront sort a seller can make the default for listing and search
+type ListingSort string
+
+const (
+	LISTING_SORT_NEWEST     ListingSort = "newest"
+	LISTING_SORT_PRICE      ListingSort = "price"
+	LISTING_SORT_POPULARITY ListingSort = "popularity"
+	// LISTING_SORT_RELEVANCE ranks search results by weighted match; listings without a
+	// search term fall back to newest
+	LISTING_SORT_RELEVANCE ListingSort = "relevance"
+)
+
+// IsValid checks if the listing sort is supported
+func (s ListingSort) IsValid() bool {
+	switch s {
+	case LISTING_SORT_NEWEST, LISTING_SORT_PRICE, LISTING_SORT_POPULARITY, LISTING_SORT_RELEVANCE:
+		return true
+	}
+	return false
+}
+
+// String returns the string representation
+func (s ListingSort) String() string {
+	return string(s)
+}
+
+// OutOfStockDisplay is how storefront listing and search treat out-of-stock products
+// when the request does not filter on stock
+type OutOfStockDisplay string
+
+const (
+	OUT_OF_STOCK_SHOW   OutOfStockDisplay = "show"
+	OUT_OF_STOCK_HIDE   OutOfStockDisplay = "hide"
+	OUT_OF_STOCK_DEMOTE OutOfStockDisplay = "demote"
+)
+
+// IsValid checks if the out-of-stock display is supported
+func (d OutOfStockDisplay) IsValid() bool {
+	switch d {
+	case OUT_OF_STOCK_SHOW, OUT_OF_STOCK_HIDE, OUT_OF_STOCK_DEMOTE:
+		return true
+	}
+	return false
+}
+
+// String returns the string representation
+func (d OutOfStockDisplay) String() string {
+	return string(d)
+}
+
+// SearchSettings is a seller's storefront listing and search configuration
+type SearchSettings struct {
+	db.BaseEntity
+	SellerID          uint        `json:"sellerId"          gorm:"column:seller_id;not null;uniqueIndex"`
+	DefaultSort       ListingSort `json:"defaultSort"       gorm:"column:default_sort;size:20;default:newest"`
+	DefaultSortOrder  string      `json:"defaultSortOrder"  gorm:"column:default_sort_order;size:4;default:desc"`
+	NameWeight        float64     `json:"nameWeight"        gorm:"column:name_weight;default:3"`
+	TagsWeight        float64     `json:"tagsWeight"        gorm:"column:tags_weight;default:2"`
+	DescriptionWeight float64     `json:"descriptionWeight" gorm:"column:description_weight;default:1"`
+	// StopWords are lowercased words dropped from search queries
+	StopWords db.StringArray `json:"stopWords" gorm:"column:stop_words;type:text[]"`
+	// OutOfStockDisplay applies when a listing or search request does not pass inStock
+	OutOfStockDisplay OutOfStockDisplay `json:"outOfStockDisplay" gorm:"column:out_of_stock_display;size:10;default:show"`
+}
+
+// TableName specifies the table name
+func (SearchSettings) TableName() string {
+	return "product_search_settings"
+}
+
+// DefaultSearchSettings returns the platform defaults used by sellers without settings
+func DefaultSearchSettings(sellerID uint) SearchSettings {
+	return SearchSettings{
+		SellerID:          sellerID,
+		DefaultSort:       LISTING_SORT_NEWEST,
+		DefaultSortOrder:  "desc",
+		NameWeight:        3,
+		TagsWeight:        2,
+		DescriptionWeight: 1,
+		OutOfStockDisplay: OUT_OF_STOCK_SHOW,
+	}
+}
+
+// SearchSynonymGroup is a set of lowercased terms a seller's search treats as
+// interchangeable
+type SearchSynonymGroup struct {
+	db.BaseEntity
+	SellerID uint           `json:"sellerId" gorm:"column:seller_id;not null;index"`
+	Terms    db.StringArray `json:"terms"    gorm:"column:terms;type:text[];not null"`
+}
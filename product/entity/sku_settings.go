@@ -0,0 +1,36 @@
+package entity
+
+import "ecommerce-be/common/db"
+
+const (
+	// DEFAULT_PRODUCT_SKU_PATTERN generates base SKUs for sellers without settings
+	DEFAULT_PRODUCT_SKU_PATTERN = "{BRAND:3}-{CAT:3}-{SEQ:5}"
+
+	// DEFAULT_VARIANT_SKU_PATTERN generates variant SKUs for sellers without settings
+	DEFAULT_VARIANT_SKU_PATTERN = "{BASE}-{OPTIONS:3}"
+)
+
+// SKUSettings is a seller's SKU generation configuration
+type SKUSettings struct {
+	db.BaseEntity
+	SellerID       uint   `json:"sellerId"       gorm:"column:seller_id;not null;uniqueIndex"`
+	ProductPattern string `json:"productPattern" gorm:"column:product_pattern;size:100;not null"`
+	VariantPattern string `json:"variantPattern" gorm:"column:variant_pattern;size:100;not null"`
+	// NextSequence is the next value of the seller's {SEQ} counter
+	NextSequence int64 `json:"nextSequence" gorm:"column:next_sequence;not null;default:1"`
+}
+
+// TableName specifies the table name
+func (SKUSettings) TableName() string {
+	return "product_sku_settings"
+}
+
+// DefaultSKUSettings returns the platform defaults used by sellers without settings
+func DefaultSKUSettings(sellerID uint) SKUSettings {
+	return SKUSettings{
+		SellerID:       sellerID,
+		ProductPattern: DEFAULT_PRODUCT_SKU_PATTERN,
+		VariantPattern: DEFAULT_VARIANT_SKU_PATTERN,
+		NextSequence:   1,
+	}
+}
@@ -0,0 +1,19 @@
+package entity
+
+import (
+	"ecommerce-be/common/db"
+)
+
+// SlugRedirect records a slug a product or category used to have, so old URLs keep
+// resolving (301) to the entity's current slug. SellerID is nil for global categories.
+type SlugRedirect struct {
+	db.BaseEntity
+	EntityType string `gorm:"column:entity_type;size:20;not null"`
+	SellerID   *uint  `gorm:"column:seller_id"`
+	OldSlug    string `gorm:"column:old_slug;size:200;not null"`
+	EntityID   uint   `gorm:"column:entity_id;not null"`
+}
+
+func (SlugRedirect) TableName() string {
+	return "slug_redirect"
+}
@@ -0,0 +1,33 @@
+package entity
+
+import (
+	"time"
+
+	"ecommerce-be/common/db"
+)
+
+// SponsoredPlacement pins a seller's product to a search result position for
+// matching queries or categories
+type SponsoredPlacement struct {
+	db.BaseEntity
+	SellerID  uint `json:"sellerId"  gorm:"column:seller_id;not null"`
+	ProductID uint `json:"productId" gorm:"column:product_id;not null"`
+	// Position is the 1-based result position the product is injected at
+	Position int `json:"position" gorm:"column:position;not null"`
+	// Keywords match when the search text contains any of them (lower-cased)
+	Keywords db.StringArray `json:"keywords" gorm:"column:keywords;type:text[]"`
+	// CategoryID matches searches filtered to this category
+	CategoryID *uint `json:"categoryId" gorm:"column:category_id"`
+	// FrequencyCap is the most impressions one shopper sees per day; 0 means uncapped
+	FrequencyCap    int        `json:"frequencyCap"    gorm:"column:frequency_cap;not null;default:0"`
+	IsActive        bool       `json:"isActive"        gorm:"column:is_active;not null;default:true"`
+	StartsAt        *time.Time `json:"startsAt"        gorm:"column:starts_at"`
+	EndsAt          *time.Time `json:"endsAt"          gorm:"column:ends_at"`
+	ImpressionCount int64      `json:"impressionCount" gorm:"column:impression_count;not null;default:0"`
+	ClickCount      int64      `json:"clickCount"      gorm:"column:click_count;not null;default:0"`
+}
+
+// TableName specifies the table name
+func (SponsoredPlacement) TableName() string {
+	return "sponsored_placement"
+}
@@ -0,0 +1,20 @@
+package entity
+
+import (
+	"ecommerce-be/common/db"
+)
+
+// VariantBundleComponent is one line of a bundle (kit) variant: Quantity units of the
+// component variant go into every unit of the bundle. A bundle sells at its own
+// ProductVariant.Price and holds no stock of its own.
+type VariantBundleComponent struct {
+	db.BaseEntity
+	BundleVariantID    uint `json:"bundleVariantId"    gorm:"column:bundle_variant_id;not null"`
+	ComponentVariantID uint `json:"componentVariantId" gorm:"column:component_variant_id;not null"`
+	Quantity           int  `json:"quantity"           gorm:"column:quantity;not null"`
+}
+
+// TableName specifies the table name
+func (VariantBundleComponent) TableName() string {
+	return "variant_bundle_component"
+}
@@ -0,0 +1,54 @@
+package entity
+
+import (
+	"strings"
+
+	"ecommerce-be/common/db"
+)
+
+// SalesChannel identifies where a purchase is made; each channel may price a variant differently.
+type SalesChannel string
+
+const (
+	SALES_CHANNEL_WEB         SalesChannel = "web"
+	SALES_CHANNEL_POS         SalesChannel = "pos"
+	SALES_CHANNEL_MARKETPLACE SalesChannel = "marketplace"
+)
+
+// ValidSalesChannels returns all supported sales channels
+func ValidSalesChannels() []SalesChannel {
+	return []SalesChannel{SALES_CHANNEL_WEB, SALES_CHANNEL_POS, SALES_CHANNEL_MARKETPLACE}
+}
+
+// NormalizeSalesChannel lower-cases and trims a raw channel value
+func NormalizeSalesChannel(raw string) SalesChannel {
+	return SalesChannel(strings.ToLower(strings.TrimSpace(raw)))
+}
+
+// IsValid checks if the sales channel is supported
+func (c SalesChannel) IsValid() bool {
+	switch c {
+	case SALES_CHANNEL_WEB, SALES_CHANNEL_POS, SALES_CHANNEL_MARKETPLACE:
+		return true
+	}
+	return false
+}
+
+// String returns the string representation
+func (c SalesChannel) String() string {
+	return string(c)
+}
+
+// VariantChannelPrice overrides a variant's base price for one sales channel.
+// Variants without an override for a channel sell at ProductVariant.Price.
+type VariantChannelPrice struct {
+	db.BaseEntity
+	VariantID uint         `json:"variantId" gorm:"column:variant_id;not null"`
+	Channel   SalesChannel `json:"channel"   gorm:"column:channel;size:30;not null"`
+	Price     float64      `json:"price"     gorm:"column:price;not null"`
+}
+
+// TableName specifies the table name
+func (VariantChannelPrice) TableName() string {
+	return "variant_channel_price"
+}
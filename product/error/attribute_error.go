@@ -38,3 +38,12 @@ var (
 		StatusCode: http.StatusBadRequest,
 	}
 )
+
+func init() {
+	commonError.Register(
+		ErrAttributeExists,
+		ErrAttributeNotFound,
+		ErrInvalidAttributeKey,
+		ErrInvalidDataType,
+	)
+}
@@ -0,0 +1,65 @@
+package error
+
+import (
+	"net/http"
+
+	commonError "ecommerce-be/common/error"
+	"ecommerce-be/product/utils"
+)
+
+// Bundle Errors
+
+var (
+	// ErrBundleNotFound is returned when a variant has no bundle components
+	ErrBundleNotFound = &commonError.AppError{
+		StatusCode: http.StatusNotFound,
+		Code:       utils.BUNDLE_NOT_FOUND_CODE,
+		Message:    utils.BUNDLE_NOT_FOUND_MSG,
+	}
+
+	// ErrBundleComponentInvalid is returned when a component is not a variant of the seller
+	ErrBundleComponentInvalid = &commonError.AppError{
+		StatusCode: http.StatusBadRequest,
+		Code:       utils.BUNDLE_COMPONENT_INVALID_CODE,
+		Message:    utils.BUNDLE_COMPONENT_INVALID_MSG,
+	}
+
+	// ErrBundleComponentDuplicate is returned when a request repeats a component variant
+	ErrBundleComponentDuplicate = &commonError.AppError{
+		StatusCode: http.StatusBadRequest,
+		Code:       utils.BUNDLE_COMPONENT_DUPLICATE_CODE,
+		Message:    utils.BUNDLE_COMPONENT_DUPLICATE_MSG,
+	}
+
+	// ErrBundleSelfReference is returned when a bundle lists itself as a component
+	ErrBundleSelfReference = &commonError.AppError{
+		StatusCode: http.StatusBadRequest,
+		Code:       utils.BUNDLE_SELF_REFERENCE_CODE,
+		Message:    utils.BUNDLE_SELF_REFERENCE_MSG,
+	}
+
+	// ErrBundleNested is returned when a bundle would contain, or become part of, another bundle
+	ErrBundleNested = &commonError.AppError{
+		StatusCode: http.StatusBadRequest,
+		Code:       utils.BUNDLE_NESTED_CODE,
+		Message:    utils.BUNDLE_NESTED_MSG,
+	}
+
+	// ErrVariantInBundle is returned when deleting a variant that a bundle still contains
+	ErrVariantInBundle = &commonError.AppError{
+		StatusCode: http.StatusConflict,
+		Code:       utils.VARIANT_IN_BUNDLE_CODE,
+		Message:    utils.VARIANT_IN_BUNDLE_MSG,
+	}
+)
+
+func init() {
+	commonError.Register(
+		ErrBundleNotFound,
+		ErrBundleComponentInvalid,
+		ErrBundleComponentDuplicate,
+		ErrBundleSelfReference,
+		ErrBundleNested,
+		ErrVariantInBundle,
+	)
+}
@@ -65,3 +65,16 @@ var (
 		StatusCode: http.StatusNotFound,
 	}
 )
+
+func init() {
+	commonError.Register(
+		ErrCategoryExists,
+		ErrCategoryNotFound,
+		ErrCategoryHasProducts,
+		ErrCategoryHasChildren,
+		ErrInvalidParentCategory,
+		ErrUnauthorizedCategoryUpdate,
+		ErrAttributeAlreadyLinked,
+		ErrAttributeNotLinked,
+	)
+}
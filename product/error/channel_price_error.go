@@ -0,0 +1,41 @@
+package error
+
+import (
+	"net/http"
+
+	commonError "ecommerce-be/common/error"
+	"ecommerce-be/product/utils"
+)
+
+// Channel Pricing Errors
+
+var (
+	// ErrInvalidSalesChannel is returned when a channel is not one of the supported sales channels
+	ErrInvalidSalesChannel = &commonError.AppError{
+		StatusCode: http.StatusBadRequest,
+		Code:       utils.INVALID_SALES_CHANNEL_CODE,
+		Message:    utils.INVALID_SALES_CHANNEL_MSG,
+	}
+
+	// ErrChannelPriceVariantInvalid is returned when an override targets a variant of another product
+	ErrChannelPriceVariantInvalid = &commonError.AppError{
+		StatusCode: http.StatusBadRequest,
+		Code:       utils.CHANNEL_PRICE_VARIANT_INVALID_CODE,
+		Message:    utils.CHANNEL_PRICE_VARIANT_INVALID_MSG,
+	}
+
+	// ErrChannelPriceDuplicate is returned when a bulk request repeats a (variant, channel) pair
+	ErrChannelPriceDuplicate = &commonError.AppError{
+		StatusCode: http.StatusBadRequest,
+		Code:       utils.CHANNEL_PRICE_DUPLICATE_CODE,
+		Message:    utils.CHANNEL_PRICE_DUPLICATE_MSG,
+	}
+)
+
+func init() {
+	commonError.Register(
+		ErrInvalidSalesChannel,
+		ErrChannelPriceVariantInvalid,
+		ErrChannelPriceDuplicate,
+	)
+}
@@ -44,3 +44,14 @@ var (
 		StatusCode: http.StatusUnprocessableEntity,
 	}
 )
+
+func init() {
+	commonError.Register(
+		ErrCollectionNotFound,
+		ErrCollectionExists,
+		ErrUnauthorizedCollectionAccess,
+		ErrProductNotInCollection,
+		ErrInvalidCollectionProduct,
+		ErrCollectionInvalidFile,
+	)
+}
@@ -0,0 +1,89 @@
+package error
+
+import (
+	"net/http"
+
+	commonError "ecommerce-be/common/error"
+	"ecommerce-be/product/utils"
+)
+
+// Digital Product Errors
+
+var (
+	// ErrProductNotDigital is returned when managing downloads of a physical product
+	ErrProductNotDigital = &commonError.AppError{
+		StatusCode: http.StatusBadRequest,
+		Code:       utils.PRODUCT_NOT_DIGITAL_CODE,
+		Message:    utils.PRODUCT_NOT_DIGITAL_MSG,
+	}
+
+	// ErrDigitalAssetNotFound is returned when a download file does not exist on the product
+	ErrDigitalAssetNotFound = &commonError.AppError{
+		StatusCode: http.StatusNotFound,
+		Code:       utils.DIGITAL_ASSET_NOT_FOUND_CODE,
+		Message:    utils.DIGITAL_ASSET_NOT_FOUND_MSG,
+	}
+
+	// ErrDigitalAssetDuplicate is returned when a file is attached to a product twice
+	ErrDigitalAssetDuplicate = &commonError.AppError{
+		StatusCode: http.StatusConflict,
+		Code:       utils.DIGITAL_ASSET_DUPLICATE_CODE,
+		Message:    utils.DIGITAL_ASSET_DUPLICATE_MSG,
+	}
+
+	// ErrDigitalAssetLimit is returned when a product already has the maximum number of files
+	ErrDigitalAssetLimit = &commonError.AppError{
+		StatusCode: http.StatusBadRequest,
+		Code:       utils.DIGITAL_ASSET_LIMIT_CODE,
+		Message:    utils.DIGITAL_ASSET_LIMIT_MSG,
+	}
+
+	// ErrLicenseKeyNotFound is returned when a license key does not exist on the product
+	ErrLicenseKeyNotFound = &commonError.AppError{
+		StatusCode: http.StatusNotFound,
+		Code:       utils.LICENSE_KEY_NOT_FOUND_CODE,
+		Message:    utils.LICENSE_KEY_NOT_FOUND_MSG,
+	}
+
+	// ErrLicenseKeyIssued is returned when deleting a key a customer already received
+	ErrLicenseKeyIssued = &commonError.AppError{
+		StatusCode: http.StatusConflict,
+		Code:       utils.LICENSE_KEY_ISSUED_CODE,
+		Message:    utils.LICENSE_KEY_ISSUED_MSG,
+	}
+
+	// ErrDownloadLinkInvalid is returned for tampered or unknown download links
+	ErrDownloadLinkInvalid = &commonError.AppError{
+		StatusCode: http.StatusForbidden,
+		Code:       utils.DOWNLOAD_LINK_INVALID_CODE,
+		Message:    utils.DOWNLOAD_LINK_INVALID_MSG,
+	}
+
+	// ErrDownloadLinkExpired is returned for download links past their expiry
+	ErrDownloadLinkExpired = &commonError.AppError{
+		StatusCode: http.StatusGone,
+		Code:       utils.DOWNLOAD_LINK_EXPIRED_CODE,
+		Message:    utils.DOWNLOAD_LINK_EXPIRED_MSG,
+	}
+
+	// ErrDownloadLimitReached is returned once a purchase has used all its downloads
+	ErrDownloadLimitReached = &commonError.AppError{
+		StatusCode: http.StatusForbidden,
+		Code:       utils.DOWNLOAD_LIMIT_REACHED_CODE,
+		Message:    utils.DOWNLOAD_LIMIT_REACHED_MSG,
+	}
+)
+
+func init() {
+	commonError.Register(
+		ErrProductNotDigital,
+		ErrDigitalAssetNotFound,
+		ErrDigitalAssetDuplicate,
+		ErrDigitalAssetLimit,
+		ErrLicenseKeyNotFound,
+		ErrLicenseKeyIssued,
+		ErrDownloadLinkInvalid,
+		ErrDownloadLinkExpired,
+		ErrDownloadLimitReached,
+	)
+}
@@ -66,3 +66,16 @@ var (
 		StatusCode: http.StatusBadRequest,
 	}
 )
+
+func init() {
+	commonError.Register(
+		ErrProductOptionNotFound,
+		ErrProductOptionNameExists,
+		ErrProductOptionInUse,
+		ErrProductOptionValueNotFound,
+		ErrProductOptionValueInUse,
+		ErrProductOptionValueExists,
+		ErrProductOptionMismatch,
+		ErrProductOptionValueMismatch,
+	)
+}
@@ -0,0 +1,57 @@
+package error
+
+import (
+	"net/http"
+
+	commonError "ecommerce-be/common/error"
+	"ecommerce-be/product/utils"
+)
+
+// Option Rule Errors
+
+var (
+	// ErrOptionRuleNotFound is returned when a rule does not exist on the product
+	ErrOptionRuleNotFound = &commonError.AppError{
+		StatusCode: http.StatusNotFound,
+		Code:       utils.OPTION_RULE_NOT_FOUND_CODE,
+		Message:    utils.OPTION_RULE_NOT_FOUND_MSG,
+	}
+
+	// ErrOptionRuleInvalid is returned when a rule makes an option depend on itself
+	ErrOptionRuleInvalid = &commonError.AppError{
+		StatusCode: http.StatusBadRequest,
+		Code:       utils.OPTION_RULE_INVALID_CODE,
+		Message:    utils.OPTION_RULE_INVALID_MSG,
+	}
+
+	// ErrOptionRuleCycle is returned when a rule would close a dependency loop between options
+	ErrOptionRuleCycle = &commonError.AppError{
+		StatusCode: http.StatusBadRequest,
+		Code:       utils.OPTION_RULE_CYCLE_CODE,
+		Message:    utils.OPTION_RULE_CYCLE_MSG,
+	}
+
+	// ErrOptionRuleVariantConflict is returned when existing variants already break a new rule
+	ErrOptionRuleVariantConflict = &commonError.AppError{
+		StatusCode: http.StatusConflict,
+		Code:       utils.OPTION_RULE_VARIANT_CONFLICT_CODE,
+		Message:    utils.OPTION_RULE_VARIANT_CONFLICT_MSG,
+	}
+
+	// ErrOptionCombinationNotAllowed is returned when a variant's options break a product rule
+	ErrOptionCombinationNotAllowed = &commonError.AppError{
+		StatusCode: http.StatusBadRequest,
+		Code:       utils.OPTION_COMBINATION_NOT_ALLOWED_CODE,
+		Message:    utils.OPTION_COMBINATION_NOT_ALLOWED_MSG,
+	}
+)
+
+func init() {
+	commonError.Register(
+		ErrOptionRuleNotFound,
+		ErrOptionRuleInvalid,
+		ErrOptionRuleCycle,
+		ErrOptionRuleVariantConflict,
+		ErrOptionCombinationNotAllowed,
+	)
+}
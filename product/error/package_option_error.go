@@ -15,3 +15,9 @@ var (
 		StatusCode: http.StatusNotFound,
 	}
 )
+
+func init() {
+	commonError.Register(
+		ErrPackageOptionNotFound,
+	)
+}
@@ -36,3 +36,12 @@ var (
 		StatusCode: http.StatusForbidden,
 	}
 )
+
+func init() {
+	commonError.Register(
+		ErrProductAttributeNotFound,
+		ErrProductAttributeExists,
+		ErrInvalidAttributeValue,
+		ErrUnauthorizedAttributeAccess,
+	)
+}
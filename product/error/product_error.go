@@ -57,4 +57,48 @@ var (
 		Message:    utils.INVALID_STRATEGY_MSG,
 		StatusCode: http.StatusBadRequest,
 	}
+
+	// ErrInvalidOptionFilter is returned when the options listing filter is malformed
+	ErrInvalidOptionFilter = &commonError.AppError{
+		Code:       utils.INVALID_OPTION_FILTER_CODE,
+		Message:    utils.INVALID_OPTION_FILTER_MSG,
+		StatusCode: http.StatusBadRequest,
+	}
+
+	// ErrInvalidProductCompare is returned when the compared product IDs are malformed
+	ErrInvalidProductCompare = &commonError.AppError{
+		Code:       utils.INVALID_PRODUCT_COMPARE_CODE,
+		Message:    utils.INVALID_PRODUCT_COMPARE_MSG,
+		StatusCode: http.StatusBadRequest,
+	}
+
+	// ErrInvalidProductFields is returned when a fields selection is malformed
+	ErrInvalidProductFields = &commonError.AppError{
+		Code:       utils.INVALID_PRODUCT_FIELDS_CODE,
+		Message:    utils.INVALID_PRODUCT_FIELDS_MSG,
+		StatusCode: http.StatusBadRequest,
+	}
+
+	// ErrInvalidProductInclude is returned when an include list is malformed
+	ErrInvalidProductInclude = &commonError.AppError{
+		Code:       utils.INVALID_PRODUCT_INCLUDE_CODE,
+		Message:    utils.INVALID_PRODUCT_INCLUDE_MSG,
+		StatusCode: http.StatusBadRequest,
+	}
 )
+
+func init() {
+	commonError.Register(
+		ErrProductExists,
+		ErrProductNotFound,
+		ErrProductSKUExists,
+		ErrInvalidCategory,
+		ErrInvalidAttributes,
+		ErrUnauthorizedProductAccess,
+		ErrInvalidStrategy,
+		ErrInvalidOptionFilter,
+		ErrInvalidProductCompare,
+		ErrInvalidProductFields,
+		ErrInvalidProductInclude,
+	)
+}
@@ -31,6 +31,13 @@ var (
 		StatusCode: http.StatusUnprocessableEntity,
 	}
 
+	// ErrProductMediaTypeMismatch is returned when a file or option does not fit the media type.
+	ErrProductMediaTypeMismatch = &commonError.AppError{
+		Code:       utils.PRODUCT_MEDIA_TYPE_MISMATCH_CODE,
+		Message:    utils.PRODUCT_MEDIA_TYPE_MISMATCH_MSG,
+		StatusCode: http.StatusBadRequest,
+	}
+
 	// ErrProductMediaCleanupFailed is a degradation sentinel used for logging only.
 	// The product-media removal itself is still reported as successful to the caller.
 	ErrProductMediaCleanupFailed = &commonError.AppError{
@@ -39,3 +46,13 @@ var (
 		StatusCode: http.StatusInternalServerError,
 	}
 )
+
+func init() {
+	commonError.Register(
+		ErrProductMediaNotFound,
+		ErrProductMediaDuplicate,
+		ErrProductMediaInvalidFile,
+		ErrProductMediaTypeMismatch,
+		ErrProductMediaCleanupFailed,
+	)
+}
@@ -0,0 +1,26 @@
+package error
+
+import (
+	"net/http"
+
+	commonError "ecommerce-be/common/error"
+	"ecommerce-be/product/utils"
+)
+
+// Related Product Curation Errors
+
+var (
+	// ErrRelatedCurationInvalid is returned when pinned or excluded related products
+	// cannot be applied to the product
+	ErrRelatedCurationInvalid = &commonError.AppError{
+		StatusCode: http.StatusBadRequest,
+		Code:       utils.RELATED_CURATION_INVALID_CODE,
+		Message:    utils.RELATED_CURATION_INVALID_MSG,
+	}
+)
+
+func init() {
+	commonError.Register(
+		ErrRelatedCurationInvalid,
+	)
+}
@@ -0,0 +1,73 @@
+package error
+
+import (
+	"net/http"
+
+	commonError "ecommerce-be/common/error"
+	"ecommerce-be/product/utils"
+)
+
+// Search Settings Errors
+
+var (
+	// ErrSearchWeightsAllZero is returned when every relevance weight would be zero
+	ErrSearchWeightsAllZero = &commonError.AppError{
+		StatusCode: http.StatusBadRequest,
+		Code:       utils.SEARCH_WEIGHTS_ALL_ZERO_CODE,
+		Message:    utils.SEARCH_WEIGHTS_ALL_ZERO_MSG,
+	}
+
+	// ErrInvalidSearchSort is returned when a search sortBy is not supported
+	ErrInvalidSearchSort = &commonError.AppError{
+		StatusCode: http.StatusBadRequest,
+		Code:       utils.INVALID_SEARCH_SORT_CODE,
+		Message:    utils.INVALID_SEARCH_SORT_MSG,
+	}
+
+	// ErrSearchSynonymGroupNotFound is returned when a synonym group does not exist for the seller
+	ErrSearchSynonymGroupNotFound = &commonError.AppError{
+		StatusCode: http.StatusNotFound,
+		Code:       utils.SEARCH_SYNONYM_GROUP_NOT_FOUND_CODE,
+		Message:    utils.SEARCH_SYNONYM_GROUP_NOT_FOUND_MSG,
+	}
+
+	// ErrInvalidSearchSynonyms is returned when a group has fewer than two distinct terms
+	ErrInvalidSearchSynonyms = &commonError.AppError{
+		StatusCode: http.StatusBadRequest,
+		Code:       utils.INVALID_SEARCH_SYNONYMS_CODE,
+		Message:    utils.INVALID_SEARCH_SYNONYMS_MSG,
+	}
+
+	// ErrSearchSynonymConflict is returned when a term is already in another of the seller's groups
+	ErrSearchSynonymConflict = &commonError.AppError{
+		StatusCode: http.StatusConflict,
+		Code:       utils.SEARCH_SYNONYM_CONFLICT_CODE,
+		Message:    utils.SEARCH_SYNONYM_CONFLICT_MSG,
+	}
+
+	// ErrSearchSynonymLimitReached is returned when the seller has the maximum number of groups
+	ErrSearchSynonymLimitReached = &commonError.AppError{
+		StatusCode: http.StatusUnprocessableEntity,
+		Code:       utils.SEARCH_SYNONYM_LIMIT_CODE,
+		Message:    utils.SEARCH_SYNONYM_LIMIT_MSG,
+	}
+
+	// ErrInvalidSuggestQuery is returned when a suggestion prefix is too short or too long
+	ErrInvalidSuggestQuery = &commonError.AppError{
+		StatusCode: http.StatusBadRequest,
+		Code:       utils.INVALID_SUGGEST_QUERY_CODE,
+		Message:    utils.INVALID_SUGGEST_QUERY_MSG,
+	}
+)
+
+func init() {
+	commonError.Register(
+		ErrSearchWeightsAllZero,
+		ErrInvalidSearchSort,
+		ErrSearchSynonymGroupNotFound,
+		ErrInvalidSearchSynonyms,
+		ErrSearchSynonymConflict,
+		ErrSearchSynonymLimitReached,
+		ErrInvalidSuggestQuery,
+	)
+}
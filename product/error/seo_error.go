@@ -0,0 +1,33 @@
+package error
+
+import (
+	"net/http"
+
+	commonError "ecommerce-be/common/error"
+	"ecommerce-be/product/utils"
+)
+
+// SEO Slug Errors
+
+var (
+	// ErrProductSlugTaken is returned when another product of the seller has the slug
+	ErrProductSlugTaken = &commonError.AppError{
+		Code:       utils.PRODUCT_SLUG_TAKEN_CODE,
+		Message:    utils.PRODUCT_SLUG_TAKEN_MSG,
+		StatusCode: http.StatusConflict,
+	}
+
+	// ErrCategorySlugTaken is returned when another category in scope has the slug
+	ErrCategorySlugTaken = &commonError.AppError{
+		Code:       utils.CATEGORY_SLUG_TAKEN_CODE,
+		Message:    utils.CATEGORY_SLUG_TAKEN_MSG,
+		StatusCode: http.StatusConflict,
+	}
+)
+
+func init() {
+	commonError.Register(
+		ErrProductSlugTaken,
+		ErrCategorySlugTaken,
+	)
+}
@@ -0,0 +1,25 @@
+package error
+
+import (
+	"net/http"
+
+	commonError "ecommerce-be/common/error"
+	"ecommerce-be/product/utils"
+)
+
+// Sitemap Errors
+
+var (
+	// ErrSitemapPageNotFound is returned for a sitemap page beyond the last one
+	ErrSitemapPageNotFound = &commonError.AppError{
+		Code:       utils.SITEMAP_PAGE_NOT_FOUND_CODE,
+		Message:    utils.SITEMAP_PAGE_NOT_FOUND_MSG,
+		StatusCode: http.StatusNotFound,
+	}
+)
+
+func init() {
+	commonError.Register(
+		ErrSitemapPageNotFound,
+	)
+}
@@ -0,0 +1,33 @@
+package error
+
+import (
+	"net/http"
+
+	commonError "ecommerce-be/common/error"
+	"ecommerce-be/product/utils"
+)
+
+// SKU Settings Errors
+
+var (
+	// ErrSKUPatternInvalid is returned when an SKU pattern cannot be parsed or used
+	ErrSKUPatternInvalid = &commonError.AppError{
+		StatusCode: http.StatusBadRequest,
+		Code:       utils.SKU_PATTERN_INVALID_CODE,
+		Message:    utils.SKU_PATTERN_INVALID_MSG,
+	}
+
+	// ErrSKUGenerationFailed is returned when every generated SKU candidate is taken
+	ErrSKUGenerationFailed = &commonError.AppError{
+		StatusCode: http.StatusConflict,
+		Code:       utils.SKU_GENERATION_FAILED_CODE,
+		Message:    utils.SKU_GENERATION_FAILED_MSG,
+	}
+)
+
+func init() {
+	commonError.Register(
+		ErrSKUPatternInvalid,
+		ErrSKUGenerationFailed,
+	)
+}
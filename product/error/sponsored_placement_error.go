@@ -0,0 +1,41 @@
+package error
+
+import (
+	"net/http"
+
+	commonError "ecommerce-be/common/error"
+	"ecommerce-be/product/utils"
+)
+
+// Sponsored Placement Errors
+
+var (
+	// ErrSponsoredPlacementNotFound is returned when a placement does not exist for the seller
+	ErrSponsoredPlacementNotFound = &commonError.AppError{
+		StatusCode: http.StatusNotFound,
+		Code:       utils.SPONSORED_PLACEMENT_NOT_FOUND_CODE,
+		Message:    utils.SPONSORED_PLACEMENT_NOT_FOUND_MSG,
+	}
+
+	// ErrSponsoredPlacementNoTarget is returned when a placement matches no query or category
+	ErrSponsoredPlacementNoTarget = &commonError.AppError{
+		StatusCode: http.StatusBadRequest,
+		Code:       utils.SPONSORED_PLACEMENT_NO_TARGET_CODE,
+		Message:    utils.SPONSORED_PLACEMENT_NO_TARGET_MSG,
+	}
+
+	// ErrSponsoredPlacementInvalidWindow is returned when a placement ends before it starts
+	ErrSponsoredPlacementInvalidWindow = &commonError.AppError{
+		StatusCode: http.StatusBadRequest,
+		Code:       utils.SPONSORED_PLACEMENT_INVALID_WINDOW_CODE,
+		Message:    utils.SPONSORED_PLACEMENT_INVALID_WINDOW_MSG,
+	}
+)
+
+func init() {
+	commonError.Register(
+		ErrSponsoredPlacementNotFound,
+		ErrSponsoredPlacementNoTarget,
+		ErrSponsoredPlacementInvalidWindow,
+	)
+}
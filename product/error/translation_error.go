@@ -0,0 +1,57 @@
+package error
+
+import (
+	"net/http"
+
+	commonError "ecommerce-be/common/error"
+	"ecommerce-be/product/utils"
+)
+
+// Product Translation Errors
+
+var (
+	// ErrInvalidLocale is returned when a locale is not a well-formed language tag
+	ErrInvalidLocale = &commonError.AppError{
+		StatusCode: http.StatusBadRequest,
+		Code:       utils.INVALID_LOCALE_CODE,
+		Message:    utils.INVALID_LOCALE_MSG,
+	}
+
+	// ErrTranslationDefaultLocale is returned when a translation targets the default locale
+	ErrTranslationDefaultLocale = &commonError.AppError{
+		StatusCode: http.StatusBadRequest,
+		Code:       utils.TRANSLATION_DEFAULT_LOCALE_CODE,
+		Message:    utils.TRANSLATION_DEFAULT_LOCALE_MSG,
+	}
+
+	// ErrTranslationOptionInvalid is returned when an option translation targets another product
+	ErrTranslationOptionInvalid = &commonError.AppError{
+		StatusCode: http.StatusBadRequest,
+		Code:       utils.TRANSLATION_OPTION_INVALID_CODE,
+		Message:    utils.TRANSLATION_OPTION_INVALID_MSG,
+	}
+
+	// ErrTranslationOptionDuplicate is returned when a request repeats an option
+	ErrTranslationOptionDuplicate = &commonError.AppError{
+		StatusCode: http.StatusBadRequest,
+		Code:       utils.TRANSLATION_OPTION_DUPLICATE_CODE,
+		Message:    utils.TRANSLATION_OPTION_DUPLICATE_MSG,
+	}
+
+	// ErrProductTranslationNotFound is returned when the product has no content for the locale
+	ErrProductTranslationNotFound = &commonError.AppError{
+		StatusCode: http.StatusNotFound,
+		Code:       utils.PRODUCT_TRANSLATION_NOT_FOUND_CODE,
+		Message:    utils.PRODUCT_TRANSLATION_NOT_FOUND_MSG,
+	}
+)
+
+func init() {
+	commonError.Register(
+		ErrInvalidLocale,
+		ErrTranslationDefaultLocale,
+		ErrTranslationOptionInvalid,
+		ErrTranslationOptionDuplicate,
+		ErrProductTranslationNotFound,
+	)
+}
@@ -100,4 +100,73 @@ var (
 		Code:       utils.INVALID_OPTION_CODE,
 		Message:    utils.INVALID_OPTION_NAME_MSG,
 	}
+
+	// ErrVariantVersionConflict is returned when a variant changed since the caller read it.
+	// It carries the current versions as db.VersionConflict details.
+	ErrVariantVersionConflict = &commonError.AppError{
+		StatusCode: http.StatusConflict,
+		Code:       utils.VARIANT_VERSION_CONFLICT_CODE,
+		Message:    utils.VARIANT_VERSION_CONFLICT_MSG,
+	}
+
+	// ErrVariantGenerateLimit is returned when a generate request covers too many combinations
+	ErrVariantGenerateLimit = &commonError.AppError{
+		StatusCode: http.StatusBadRequest,
+		Code:       utils.VARIANT_GENERATE_LIMIT_CODE,
+		Message:    utils.VARIANT_GENERATE_LIMIT_MSG,
+	}
+
+	// ErrVariantSKUPatternInvalid is returned when an SKU pattern cannot be rendered
+	ErrVariantSKUPatternInvalid = &commonError.AppError{
+		StatusCode: http.StatusBadRequest,
+		Code:       utils.VARIANT_SKU_PATTERN_INVALID_CODE,
+		Message:    utils.VARIANT_SKU_PATTERN_INVALID_MSG,
+	}
+
+	// ErrVariantGenerateSelection is returned when a generate request sets both or
+	// neither of all and combinations
+	ErrVariantGenerateSelection = &commonError.AppError{
+		StatusCode: http.StatusBadRequest,
+		Code:       utils.VARIANT_GENERATE_SELECTION_CODE,
+		Message:    utils.VARIANT_GENERATE_SELECTION_MSG,
+	}
+
+	// ErrInvalidBarcode is returned when a looked-up barcode is not a valid EAN/UPC/GTIN
+	ErrInvalidBarcode = &commonError.AppError{
+		StatusCode: http.StatusBadRequest,
+		Code:       utils.INVALID_BARCODE_CODE,
+		Message:    utils.INVALID_BARCODE_MSG,
+	}
+
+	// ErrVariantBarcodeExists is returned when another of the seller's variants has the
+	// barcode
+	ErrVariantBarcodeExists = &commonError.AppError{
+		StatusCode: http.StatusConflict,
+		Code:       utils.VARIANT_BARCODE_EXISTS_CODE,
+		Message:    utils.VARIANT_BARCODE_EXISTS_MSG,
+	}
 )
+
+func init() {
+	commonError.Register(
+		ErrVariantNotFound,
+		ErrVariantSKUExists,
+		ErrVariantCombinationExists,
+		ErrProductHasNoOptions,
+		ErrLastVariantDeleteNotAllowed,
+		ErrVariantImageLimitExceeded,
+		ErrVariantSKURequired,
+		ErrInvalidStockOperation,
+		ErrInsufficientStockForOperation,
+		ErrBulkUpdateEmptyList,
+		ErrBulkUpdateVariantNotFound,
+		ErrVariantNotFoundWithOptions,
+		ErrInvalidOptionName,
+		ErrVariantVersionConflict,
+		ErrVariantGenerateLimit,
+		ErrVariantSKUPatternInvalid,
+		ErrVariantGenerateSelection,
+		ErrInvalidBarcode,
+		ErrVariantBarcodeExists,
+	)
+}
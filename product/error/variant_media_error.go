@@ -30,4 +30,21 @@ var (
 		Message:    utils.VARIANT_MEDIA_INVALID_FILE_MSG,
 		StatusCode: http.StatusUnprocessableEntity,
 	}
+
+	// ErrVariantMediaOrderMismatch is returned when a reorder request does not list
+	// exactly the files attached to the variant.
+	ErrVariantMediaOrderMismatch = &commonError.AppError{
+		Code:       utils.VARIANT_MEDIA_ORDER_MISMATCH_CODE,
+		Message:    utils.VARIANT_MEDIA_ORDER_MISMATCH_MSG,
+		StatusCode: http.StatusBadRequest,
+	}
 )
+
+func init() {
+	commonError.Register(
+		ErrVariantMediaNotFound,
+		ErrVariantMediaDuplicate,
+		ErrVariantMediaInvalidFile,
+		ErrVariantMediaOrderMismatch,
+	)
+}
@@ -77,3 +77,17 @@ var (
 		StatusCode: http.StatusBadRequest,
 	}
 )
+
+func init() {
+	commonError.Register(
+		ErrWishlistNotFound,
+		ErrWishlistNameExists,
+		ErrMaxWishlistsReached,
+		ErrUnauthorizedWishlist,
+		ErrCannotDeleteDefault,
+		ErrWishlistItemNotFound,
+		ErrWishlistItemExists,
+		ErrUnauthorizedWishlistItem,
+		ErrSameWishlistMove,
+	)
+}
@@ -24,6 +24,10 @@ func BuildCategoryEntityFromCreateRequest(
 		IsGlobal:    isGlobal,
 		SellerID:    sellerID,
 		BaseEntity:  helper.NewBaseEntity(),
+
+		MetaTitle:       helper.TrimmedOrNil(req.MetaTitle),
+		MetaDescription: helper.TrimmedOrNil(req.MetaDescription),
+		CanonicalURL:    helper.TrimmedOrNil(req.CanonicalURL),
 	}
 }
 
@@ -35,6 +39,15 @@ func BuildCategoryEntityFromUpdateReq(
 	category.Name = req.Name
 	category.ParentID = req.ParentID
 	category.Description = req.Description
+	if req.MetaTitle != nil {
+		category.MetaTitle = helper.TrimmedOrNil(req.MetaTitle)
+	}
+	if req.MetaDescription != nil {
+		category.MetaDescription = helper.TrimmedOrNil(req.MetaDescription)
+	}
+	if req.CanonicalURL != nil {
+		category.CanonicalURL = helper.TrimmedOrNil(req.CanonicalURL)
+	}
 	category.UpdatedAt = time.Now().UTC() // Force UTC
 
 	return category
@@ -58,6 +71,11 @@ func BuildCategoryResponse(
 		SellerID:    category.SellerID,
 		CreatedAt:   helper.FormatTimestamp(category.CreatedAt.UTC()),
 		UpdatedAt:   helper.FormatTimestamp(category.UpdatedAt.UTC()),
+
+		Slug:            category.Slug,
+		MetaTitle:       category.MetaTitle,
+		MetaDescription: category.MetaDescription,
+		CanonicalURL:    category.CanonicalURL,
 	}
 }
 
@@ -84,6 +102,7 @@ func BuildCategoryHierarchyResponse(
 		Description: category.Description,
 		IsGlobal:    category.IsGlobal,
 		Children:    children,
+		Slug:        category.Slug,
 	}
 }
 
@@ -6,6 +6,7 @@ import (
 	"ecommerce-be/product/entity"
 	"ecommerce-be/product/mapper"
 	"ecommerce-be/product/model"
+	"ecommerce-be/product/utils"
 	"ecommerce-be/product/utils/helper"
 )
 
@@ -17,6 +18,10 @@ func CreateProductFromRequest(
 	req model.ProductCreateRequest,
 	sellerID uint,
 ) *entity.Product {
+	productType := req.ProductType
+	if productType == "" {
+		productType = utils.PRODUCT_TYPE_PHYSICAL
+	}
 	return &entity.Product{
 		Name:             req.Name,
 		CategoryID:       req.CategoryID,
@@ -26,6 +31,11 @@ func CreateProductFromRequest(
 		LongDescription:  req.LongDescription,
 		Tags:             req.Tags,
 		SellerID:         sellerID,
+		MetaTitle:        helper.TrimmedOrNil(req.MetaTitle),
+		MetaDescription:  helper.TrimmedOrNil(req.MetaDescription),
+		CanonicalURL:     helper.TrimmedOrNil(req.CanonicalURL),
+		ProductType:      productType,
+		DownloadLimit:    req.DownloadLimit,
 		BaseEntity:       helper.NewBaseEntity(),
 	}
 }
@@ -56,6 +66,28 @@ func CreateProductEntityFromUpdateRequest(
 	if req.Tags != nil {
 		product.Tags = *req.Tags
 	}
+	if req.MetaTitle != nil {
+		product.MetaTitle = helper.TrimmedOrNil(req.MetaTitle)
+	}
+	if req.MetaDescription != nil {
+		product.MetaDescription = helper.TrimmedOrNil(req.MetaDescription)
+	}
+	if req.CanonicalURL != nil {
+		product.CanonicalURL = helper.TrimmedOrNil(req.CanonicalURL)
+	}
+	if req.ProductType != nil {
+		product.ProductType = *req.ProductType
+	}
+	if product.ProductType == "" {
+		// Cached entries written before product types existed carry none
+		product.ProductType = utils.PRODUCT_TYPE_PHYSICAL
+	}
+	if req.DownloadLimit != nil {
+		product.DownloadLimit = req.DownloadLimit
+		if *req.DownloadLimit == 0 {
+			product.DownloadLimit = nil
+		}
+	}
 
 	product.UpdatedAt = time.Now()
 	return product
@@ -380,21 +412,31 @@ func BuildProductResponse(
 	product *entity.Product,
 	variantAgg *mapper.VariantAggregation,
 ) model.ProductResponse {
-	// Build category hierarchy using existing helper method
-	categoryInfo := BuildCategoryHierarchyInfo(product.Category, product.Category.Parent)
+	// Build category hierarchy using existing helper method; the category is not loaded
+	// when a sparse fieldset leaves it out
+	var categoryInfo model.CategoryHierarchyInfo
+	if product.Category != nil {
+		categoryInfo = *BuildCategoryHierarchyInfo(product.Category, product.Category.Parent)
+	}
 
 	// Build base product response
 	productResp := model.ProductResponse{
 		ID:               product.ID,
 		Name:             product.Name,
 		CategoryID:       product.CategoryID,
-		Category:         *categoryInfo,
+		Category:         categoryInfo,
 		Brand:            product.Brand,
 		SKU:              product.BaseSKU,
 		ShortDescription: product.ShortDescription,
 		LongDescription:  product.LongDescription,
 		Tags:             product.Tags,
 		SellerID:         product.SellerID,
+		Slug:             product.Slug,
+		MetaTitle:        product.MetaTitle,
+		MetaDescription:  product.MetaDescription,
+		CanonicalURL:     product.CanonicalURL,
+		ProductType:      product.ProductType,
+		DownloadLimit:    product.DownloadLimit,
 		CreatedAt:        helper.FormatTimestamp(product.CreatedAt),
 		UpdatedAt:        helper.FormatTimestamp(product.UpdatedAt),
 	}
@@ -506,3 +548,95 @@ func BuildRelatedProductsScoredResponse(
 		},
 	}
 }
+
+// BuildSuggestResponse groups suggestion rows by kind, keeping their order
+func BuildSuggestResponse(prefix string, rows []mapper.SuggestionRow) *model.SuggestResponse {
+	resp := &model.SuggestResponse{
+		Query:      prefix,
+		Products:   []model.ProductSuggestion{},
+		Categories: []model.CategorySuggestion{},
+		Brands:     []string{},
+	}
+	for _, row := range rows {
+		switch row.Kind {
+		case utils.SEARCH_SUGGEST_KIND_PRODUCT:
+			resp.Products = append(resp.Products, model.ProductSuggestion{
+				ID:   row.RefID,
+				Name: row.Text,
+				Slug: row.Slug,
+			})
+		case utils.SEARCH_SUGGEST_KIND_CATEGORY:
+			resp.Categories = append(resp.Categories, model.CategorySuggestion{
+				ID:   row.RefID,
+				Name: row.Text,
+				Slug: row.Slug,
+			})
+		case utils.SEARCH_SUGGEST_KIND_BRAND:
+			resp.Brands = append(resp.Brands, row.Text)
+		}
+	}
+	return resp
+}
+
+// BuildProductFacets builds the facets of a listing or search from the facet counts.
+// Every price bucket is listed, empty ones included, so they tile the price range;
+// when all variants share one price there is a single bucket.
+func BuildProductFacets(data *mapper.ProductFacetData) *model.ProductFacets {
+	facets := &model.ProductFacets{
+		Brands:       BuildBrandFilters(data.Brands),
+		Categories:   make([]model.CategoryFacet, 0, len(data.Categories)),
+		PriceBuckets: []model.PriceRangeFilter{},
+		Options:      []model.OptionFacet{},
+	}
+	for _, category := range data.Categories {
+		facets.Categories = append(facets.Categories, model.CategoryFacet{
+			ID:           category.CategoryID,
+			Name:         category.CategoryName,
+			ParentID:     category.ParentID,
+			ProductCount: category.ProductCount,
+		})
+	}
+
+	if len(data.PriceEdges) > 1 {
+		counts := make(map[int]uint, len(data.PriceBuckets))
+		for _, bucket := range data.PriceBuckets {
+			counts[bucket.Bucket] = bucket.ProductCount
+		}
+		for i := 1; i < len(data.PriceEdges); i++ {
+			facets.PriceBuckets = append(facets.PriceBuckets, model.PriceRangeFilter{
+				Min:          data.PriceEdges[i-1],
+				Max:          data.PriceEdges[i],
+				ProductCount: counts[i],
+			})
+		}
+	} else if data.PriceRange.ProductCount > 0 {
+		facets.PriceBuckets = append(facets.PriceBuckets, model.PriceRangeFilter{
+			Min:          data.PriceRange.MinPrice,
+			Max:          data.PriceRange.MaxPrice,
+			ProductCount: data.PriceRange.ProductCount,
+		})
+	}
+
+	// Rows come ordered by option name, so each option's values are contiguous
+	for _, row := range data.OptionValues {
+		last := len(facets.Options) - 1
+		if last < 0 || facets.Options[last].Name != row.OptionName {
+			facets.Options = append(facets.Options, model.OptionFacet{
+				Name:        row.OptionName,
+				DisplayName: row.OptionDisplayName,
+				Values:      []model.VariantOptionFilter{},
+			})
+			last++
+		}
+		if len(facets.Options[last].Values) >= utils.FACET_MAX_OPTION_VALUES {
+			continue
+		}
+		facets.Options[last].Values = append(facets.Options[last].Values, model.VariantOptionFilter{
+			Value:        row.OptionValue,
+			DisplayName:  row.ValueDisplayName,
+			ColorCode:    row.ColorCode,
+			ProductCount: row.ProductCount,
+		})
+	}
+	return facets
+}
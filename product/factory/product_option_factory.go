@@ -1,6 +1,9 @@
 package factory
 
 import (
+	"slices"
+
+	"ecommerce-be/common/db"
 	"ecommerce-be/product/entity"
 	"ecommerce-be/product/model"
 	"ecommerce-be/product/utils/helper"
@@ -104,3 +107,56 @@ func BuildProductOptionsDetailResponse(
 	}
 	return result
 }
+
+// CreateOptionRuleFromRequest creates a ProductOptionRule entity from a create request
+func CreateOptionRuleFromRequest(
+	productID uint,
+	req model.ProductOptionRuleCreateRequest,
+) *entity.ProductOptionRule {
+	return &entity.ProductOptionRule{
+		ProductID:         productID,
+		OptionID:          req.OptionID,
+		OptionValueIDs:    toInt64Array(req.OptionValueIDs),
+		ConditionOptionID: req.ConditionOptionID,
+		ConditionValueIDs: toInt64Array(req.ConditionValueIDs),
+	}
+}
+
+// BuildProductOptionRuleResponses builds rule responses, naming options from optionNames
+func BuildProductOptionRuleResponses(
+	rules []entity.ProductOptionRule,
+	optionNames map[uint]string,
+) []model.ProductOptionRuleResponse {
+	result := make([]model.ProductOptionRuleResponse, 0, len(rules))
+	for _, rule := range rules {
+		result = append(result, model.ProductOptionRuleResponse{
+			ID:                  rule.ID,
+			OptionID:            rule.OptionID,
+			OptionName:          optionNames[rule.OptionID],
+			OptionValueIDs:      toUintSlice(rule.OptionValueIDs),
+			ConditionOptionID:   rule.ConditionOptionID,
+			ConditionOptionName: optionNames[rule.ConditionOptionID],
+			ConditionValueIDs:   toUintSlice(rule.ConditionValueIDs),
+		})
+	}
+	return result
+}
+
+// toInt64Array converts IDs to a bigint[] column value, dropping duplicates
+func toInt64Array(ids []uint) db.Int64Array {
+	result := make(db.Int64Array, 0, len(ids))
+	for _, id := range ids {
+		if !slices.Contains(result, int64(id)) {
+			result = append(result, int64(id))
+		}
+	}
+	return result
+}
+
+func toUintSlice(ids db.Int64Array) []uint {
+	result := make([]uint, 0, len(ids))
+	for _, id := range ids {
+		result = append(result, uint(id))
+	}
+	return result
+}
@@ -0,0 +1,87 @@
+package factory
+
+import (
+	"sort"
+	"strings"
+
+	"ecommerce-be/product/entity"
+	"ecommerce-be/product/mapper"
+	"ecommerce-be/product/model"
+	"ecommerce-be/product/utils/helper"
+)
+
+// CreateProductTranslationFromRequest creates a ProductTranslation entity for one locale
+func CreateProductTranslationFromRequest(
+	productID uint,
+	locale string,
+	req model.UpsertProductTranslationRequest,
+) *entity.ProductTranslation {
+	return &entity.ProductTranslation{
+		ProductID:        productID,
+		Locale:           locale,
+		Name:             strings.TrimSpace(req.Name),
+		ShortDescription: strings.TrimSpace(req.ShortDescription),
+		LongDescription:  strings.TrimSpace(req.LongDescription),
+	}
+}
+
+// CreateOptionTranslationsFromRequest creates ProductOptionTranslation entities for one locale
+func CreateOptionTranslationsFromRequest(
+	locale string,
+	items []model.OptionTranslationItem,
+) []entity.ProductOptionTranslation {
+	translations := make([]entity.ProductOptionTranslation, 0, len(items))
+	for _, item := range items {
+		translations = append(translations, entity.ProductOptionTranslation{
+			OptionID:    item.OptionID,
+			Locale:      locale,
+			DisplayName: strings.TrimSpace(item.DisplayName),
+		})
+	}
+	return translations
+}
+
+// BuildProductTranslationResponses groups content and option names by locale, sorted by locale.
+// A locale may have option names without content, or the other way round.
+func BuildProductTranslationResponses(
+	contents []entity.ProductTranslation,
+	options []mapper.OptionTranslationRow,
+) []model.ProductTranslationResponse {
+	byLocale := make(map[string]*model.ProductTranslationResponse)
+	get := func(locale string) *model.ProductTranslationResponse {
+		resp, ok := byLocale[locale]
+		if !ok {
+			resp = &model.ProductTranslationResponse{
+				Locale:  locale,
+				Options: []model.OptionTranslationResponse{},
+			}
+			byLocale[locale] = resp
+		}
+		return resp
+	}
+
+	for _, content := range contents {
+		resp := get(content.Locale)
+		resp.Name = content.Name
+		resp.ShortDescription = content.ShortDescription
+		resp.LongDescription = content.LongDescription
+		resp.UpdatedAt = helper.FormatTimestamp(content.UpdatedAt)
+	}
+	for _, option := range options {
+		resp := get(option.Locale)
+		resp.Options = append(resp.Options, model.OptionTranslationResponse{
+			OptionID:    option.OptionID,
+			OptionName:  option.OptionName,
+			DisplayName: option.DisplayName,
+		})
+	}
+
+	responses := make([]model.ProductTranslationResponse, 0, len(byLocale))
+	for _, resp := range byLocale {
+		responses = append(responses, *resp)
+	}
+	sort.Slice(responses, func(i, j int) bool {
+		return responses[i].Locale < responses[j].Locale
+	})
+	return responses
+}
@@ -21,6 +21,16 @@ type HandlerFactory struct {
 	wishlistHandler         *handler.WishlistHandler
 	wishlistItemHandler     *handler.WishlistItemHandler
 	collectionHandler       *handler.CollectionHandler
+	channelPriceHandler     *handler.VariantChannelPriceHandler
+	translationHandler      *handler.ProductTranslationHandler
+	searchSettingsHandler   *handler.SearchSettingsHandler
+	skuSettingsHandler      *handler.SKUSettingsHandler
+	relatedCurationHandler  *handler.RelatedCurationHandler
+	sponsoredHandler        *handler.SponsoredPlacementHandler
+	sitemapHandler          *handler.SitemapHandler
+	bundleHandler           *handler.VariantBundleHandler
+	digitalHandler          *handler.DigitalProductHandler
+	recommendationHandler   *handler.RecommendationHandler
 
 	once sync.Once
 }
@@ -42,12 +52,41 @@ func (f *HandlerFactory) initialize() {
 			f.serviceFactory.GetProductQueryService(),
 			f.serviceFactory.GetProductMediaService(),
 		)
+		f.translationHandler = handler.NewProductTranslationHandler(
+			f.serviceFactory.GetProductTranslationService(),
+		)
+		f.searchSettingsHandler = handler.NewSearchSettingsHandler(
+			f.serviceFactory.GetSearchSettingsService(),
+		)
+		f.skuSettingsHandler = handler.NewSKUSettingsHandler(
+			f.serviceFactory.GetSKUGeneratorService(),
+		)
+		f.relatedCurationHandler = handler.NewRelatedCurationHandler(
+			f.serviceFactory.GetRelatedCurationService(),
+		)
+		f.sponsoredHandler = handler.NewSponsoredPlacementHandler(
+			f.serviceFactory.GetSponsoredPlacementService(),
+		)
+		f.sitemapHandler = handler.NewSitemapHandler(f.serviceFactory.GetSitemapService())
+		f.recommendationHandler = handler.NewRecommendationHandler(
+			f.serviceFactory.GetRecommendationService(),
+		)
 		f.variantHandler = handler.NewVariantHandler(
 			f.serviceFactory.GetVariantService(),
 			f.serviceFactory.GetVariantQueryService(),
 			f.serviceFactory.GetVariantBulkService(),
 			f.serviceFactory.GetVariantMediaService(),
 		)
+		f.channelPriceHandler = handler.NewVariantChannelPriceHandler(
+			f.serviceFactory.GetVariantChannelPriceService(),
+		)
+		f.bundleHandler = handler.NewVariantBundleHandler(
+			f.serviceFactory.GetVariantBundleService(),
+		)
+		f.digitalHandler = handler.NewDigitalProductHandler(
+			f.serviceFactory.GetDigitalProductService(),
+			f.serviceFactory.GetDigitalDeliveryService(),
+		)
 		f.productAttributeHandler = handler.NewProductAttributeHandler(
 			f.serviceFactory.GetProductAttributeService(),
 		)
@@ -138,3 +177,63 @@ func (f *HandlerFactory) GetCollectionHandler() *handler.CollectionHandler {
 	f.initialize()
 	return f.collectionHandler
 }
+
+// GetVariantChannelPriceHandler returns the singleton variant channel price handler
+func (f *HandlerFactory) GetVariantChannelPriceHandler() *handler.VariantChannelPriceHandler {
+	f.initialize()
+	return f.channelPriceHandler
+}
+
+// GetVariantBundleHandler returns the singleton variant bundle handler
+func (f *HandlerFactory) GetVariantBundleHandler() *handler.VariantBundleHandler {
+	f.initialize()
+	return f.bundleHandler
+}
+
+// GetDigitalProductHandler returns the singleton digital product handler
+func (f *HandlerFactory) GetDigitalProductHandler() *handler.DigitalProductHandler {
+	f.initialize()
+	return f.digitalHandler
+}
+
+// GetProductTranslationHandler returns the singleton product translation handler
+func (f *HandlerFactory) GetProductTranslationHandler() *handler.ProductTranslationHandler {
+	f.initialize()
+	return f.translationHandler
+}
+
+// GetSearchSettingsHandler returns the singleton search settings handler
+func (f *HandlerFactory) GetSearchSettingsHandler() *handler.SearchSettingsHandler {
+	f.initialize()
+	return f.searchSettingsHandler
+}
+
+// GetSKUSettingsHandler returns the singleton SKU settings handler
+func (f *HandlerFactory) GetSKUSettingsHandler() *handler.SKUSettingsHandler {
+	f.initialize()
+	return f.skuSettingsHandler
+}
+
+// GetRelatedCurationHandler returns the singleton related product curation handler
+func (f *HandlerFactory) GetRelatedCurationHandler() *handler.RelatedCurationHandler {
+	f.initialize()
+	return f.relatedCurationHandler
+}
+
+// GetSponsoredPlacementHandler returns the singleton sponsored placement handler
+func (f *HandlerFactory) GetSponsoredPlacementHandler() *handler.SponsoredPlacementHandler {
+	f.initialize()
+	return f.sponsoredHandler
+}
+
+// GetSitemapHandler returns the singleton sitemap handler
+func (f *HandlerFactory) GetSitemapHandler() *handler.SitemapHandler {
+	f.initialize()
+	return f.sitemapHandler
+}
+
+// GetRecommendationHandler returns the singleton recommendation handler
+func (f *HandlerFactory) GetRecommendationHandler() *handler.RecommendationHandler {
+	f.initialize()
+	return f.recommendationHandler
+}
@@ -15,6 +15,7 @@ type RepositoryFactory struct {
 	productRepo           repository.ProductRepository
 	variantRepo           repository.VariantRepository
 	optionRepo            repository.ProductOptionRepository
+	optionRuleRepo        repository.ProductOptionRuleRepository
 	productAttrRepo       repository.ProductAttributeRepository
 	packageOptionRepo     repository.PackageOptionRepository
 	wishlistRepo          repository.WishlistRepository
@@ -23,6 +24,17 @@ type RepositoryFactory struct {
 	collectionProductRepo repository.CollectionProductRepository
 	productMediaRepo      repository.ProductMediaRepository
 	variantMediaRepo      repository.VariantMediaRepository
+	channelPriceRepo      repository.VariantChannelPriceRepository
+	translationRepo       repository.ProductTranslationRepository
+	searchSettingsRepo    repository.SearchSettingsRepository
+	skuSettingsRepo       repository.SKUSettingsRepository
+	relatedOverrideRepo   repository.RelatedProductOverrideRepository
+	sponsoredRepo         repository.SponsoredPlacementRepository
+	slugRedirectRepo      repository.SlugRedirectRepository
+	sitemapRepo           repository.SitemapRepository
+	bundleRepo            repository.VariantBundleRepository
+	digitalRepo           repository.DigitalProductRepository
+	recommendationRepo    repository.RecommendationRepository
 
 	once sync.Once
 }
@@ -36,11 +48,16 @@ func NewRepositoryFactory() *RepositoryFactory {
 // Uses db.GetDB() to fetch current database connection dynamically
 func (f *RepositoryFactory) initialize() {
 	f.once.Do(func() {
-		f.categoryRepo = repository.NewCategoryRepository()
+		// Product detail, category tree and variant preview are served through
+		// read-through Redis caches that invalidate themselves on writes
+		f.categoryRepo = repository.NewCachedCategoryRepository(repository.NewCategoryRepository())
 		f.attributeRepo = repository.NewAttributeDefinitionRepository()
-		f.productRepo = repository.NewProductRepository()
-		f.variantRepo = repository.NewVariantRepository()
-		f.optionRepo = repository.NewProductOptionRepository()
+		f.productRepo = repository.NewCachedProductRepository(repository.NewProductRepository())
+		f.variantRepo = repository.NewCachedVariantRepository(repository.NewVariantRepository())
+		f.optionRepo = repository.NewCachedProductOptionRepository(
+			repository.NewProductOptionRepository(),
+		)
+		f.optionRuleRepo = repository.NewProductOptionRuleRepository()
 		f.productAttrRepo = repository.NewProductAttributeRepository()
 		f.packageOptionRepo = repository.NewPackageOptionRepository()
 		f.wishlistRepo = repository.NewWishlistRepository()
@@ -49,6 +66,17 @@ func (f *RepositoryFactory) initialize() {
 		f.collectionProductRepo = repository.NewCollectionProductRepository()
 		f.productMediaRepo = repository.NewProductMediaRepository()
 		f.variantMediaRepo = repository.NewVariantMediaRepository()
+		f.channelPriceRepo = repository.NewVariantChannelPriceRepository()
+		f.translationRepo = repository.NewProductTranslationRepository()
+		f.searchSettingsRepo = repository.NewSearchSettingsRepository()
+		f.skuSettingsRepo = repository.NewSKUSettingsRepository()
+		f.relatedOverrideRepo = repository.NewRelatedProductOverrideRepository()
+		f.sponsoredRepo = repository.NewSponsoredPlacementRepository()
+		f.slugRedirectRepo = repository.NewSlugRedirectRepository()
+		f.sitemapRepo = repository.NewSitemapRepository()
+		f.bundleRepo = repository.NewVariantBundleRepository()
+		f.digitalRepo = repository.NewDigitalProductRepository()
+		f.recommendationRepo = repository.NewRecommendationRepository()
 	})
 }
 
@@ -82,6 +110,12 @@ func (f *RepositoryFactory) GetProductOptionRepository() repository.ProductOptio
 	return f.optionRepo
 }
 
+// GetProductOptionRuleRepository returns the singleton option rule repository
+func (f *RepositoryFactory) GetProductOptionRuleRepository() repository.ProductOptionRuleRepository {
+	f.initialize()
+	return f.optionRuleRepo
+}
+
 // GetProductAttributeRepository returns the singleton product attribute repository
 func (f *RepositoryFactory) GetProductAttributeRepository() repository.ProductAttributeRepository {
 	f.initialize()
@@ -127,3 +161,69 @@ func (f *RepositoryFactory) GetVariantMediaRepository() repository.VariantMediaR
 	f.initialize()
 	return f.variantMediaRepo
 }
+
+// GetVariantChannelPriceRepository returns the singleton variant channel price repository
+func (f *RepositoryFactory) GetVariantChannelPriceRepository() repository.VariantChannelPriceRepository {
+	f.initialize()
+	return f.channelPriceRepo
+}
+
+// GetProductTranslationRepository returns the singleton product translation repository
+func (f *RepositoryFactory) GetProductTranslationRepository() repository.ProductTranslationRepository {
+	f.initialize()
+	return f.translationRepo
+}
+
+// GetSearchSettingsRepository returns the singleton search settings repository
+func (f *RepositoryFactory) GetSearchSettingsRepository() repository.SearchSettingsRepository {
+	f.initialize()
+	return f.searchSettingsRepo
+}
+
+// GetSKUSettingsRepository returns the singleton SKU settings repository
+func (f *RepositoryFactory) GetSKUSettingsRepository() repository.SKUSettingsRepository {
+	f.initialize()
+	return f.skuSettingsRepo
+}
+
+// GetRelatedProductOverrideRepository returns the singleton related product override repository
+func (f *RepositoryFactory) GetRelatedProductOverrideRepository() repository.RelatedProductOverrideRepository {
+	f.initialize()
+	return f.relatedOverrideRepo
+}
+
+// GetSponsoredPlacementRepository returns the singleton sponsored placement repository
+func (f *RepositoryFactory) GetSponsoredPlacementRepository() repository.SponsoredPlacementRepository {
+	f.initialize()
+	return f.sponsoredRepo
+}
+
+// GetSlugRedirectRepository returns the singleton slug redirect repository
+func (f *RepositoryFactory) GetSlugRedirectRepository() repository.SlugRedirectRepository {
+	f.initialize()
+	return f.slugRedirectRepo
+}
+
+// GetSitemapRepository returns the singleton sitemap repository
+func (f *RepositoryFactory) GetSitemapRepository() repository.SitemapRepository {
+	f.initialize()
+	return f.sitemapRepo
+}
+
+// GetVariantBundleRepository returns the singleton variant bundle repository
+func (f *RepositoryFactory) GetVariantBundleRepository() repository.VariantBundleRepository {
+	f.initialize()
+	return f.bundleRepo
+}
+
+// GetDigitalProductRepository returns the singleton digital product repository
+func (f *RepositoryFactory) GetDigitalProductRepository() repository.DigitalProductRepository {
+	f.initialize()
+	return f.digitalRepo
+}
+
+// GetRecommendationRepository returns the singleton recommendation repository
+func (f *RepositoryFactory) GetRecommendationRepository() repository.RecommendationRepository {
+	f.initialize()
+	return f.recommendationRepo
+}
@@ -2,10 +2,13 @@ package singleton
 
 import (
 	"sync"
+	"time"
 
+	"ecommerce-be/common/config"
 	fileSingleton "ecommerce-be/file/factory/singleton"
 	filegw "ecommerce-be/file/gateway"
 	"ecommerce-be/product/service"
+	"ecommerce-be/product/utils"
 )
 
 // ServiceFactory manages all service singleton instances
@@ -30,6 +33,17 @@ type ServiceFactory struct {
 	collectionProductService service.CollectionProductService
 	productMediaService      service.ProductMediaService
 	variantMediaService      service.VariantMediaService
+	channelPriceService      service.VariantChannelPriceService
+	translationService       service.ProductTranslationService
+	searchSettingsService    service.SearchSettingsService
+	skuGeneratorService      service.SKUGeneratorService
+	relatedCurationService   service.RelatedCurationService
+	sponsoredService         service.SponsoredPlacementService
+	sitemapService           service.SitemapService
+	bundleService            service.VariantBundleService
+	digitalService           service.DigitalProductService
+	deliveryService          service.DigitalDeliveryService
+	recommendationService    service.RecommendationService
 
 	once sync.Once
 }
@@ -50,12 +64,18 @@ func (f *ServiceFactory) initialize() {
 		optionRepo := f.repoFactory.GetProductOptionRepository()
 		productAttrRepo := f.repoFactory.GetProductAttributeRepository()
 		packageOptionRepo := f.repoFactory.GetPackageOptionRepository()
+		slugRedirectRepo := f.repoFactory.GetSlugRedirectRepository()
+		bundleRepo := f.repoFactory.GetVariantBundleRepository()
 
 		// Initialize validator service first (used by other services)
 		f.validatorService = service.NewProductValidatorService(productRepo)
 
 		// Initialize product option service (used by variant services)
-		f.productOptionService = service.NewProductOptionService(optionRepo, f.validatorService)
+		f.productOptionService = service.NewProductOptionService(
+			optionRepo,
+			f.repoFactory.GetProductOptionRuleRepository(),
+			f.validatorService,
+		)
 		f.optionValueService = service.NewProductOptionValueService(
 			optionRepo,
 			productRepo,
@@ -87,21 +107,65 @@ func (f *ServiceFactory) initialize() {
 			productFileGateway,
 		)
 
-		// Initialize VariantQueryService with VariantMediaService dependency
+		// Initialize VariantChannelPriceService BEFORE VariantQueryService so channel
+		// overrides can be resolved in variant responses and cart pricing.
+		f.channelPriceService = service.NewVariantChannelPriceService(
+			f.repoFactory.GetVariantChannelPriceRepository(),
+			variantRepo,
+			f.validatorService,
+		)
+
+		// Initialize VariantQueryService with media and channel price dependencies
 		f.variantQueryService = service.NewVariantQueryService(
 			variantRepo,
 			f.wishlistItemService,
 			f.productOptionService,
 			f.validatorService,
 			f.variantMediaService,
+			f.channelPriceService,
+		)
+
+		f.bundleService = service.NewVariantBundleService(
+			bundleRepo,
+			variantRepo,
+			f.validatorService,
 		)
 
-		// Initialize VariantService with VariantQueryService dependency
+		digitalRepo := f.repoFactory.GetDigitalProductRepository()
+		f.digitalService = service.NewDigitalProductService(
+			digitalRepo,
+			f.validatorService,
+			productFileGateway,
+		)
+		f.deliveryService = service.NewDigitalDeliveryService(
+			digitalRepo,
+			productRepo,
+			productFileGateway,
+			utils.NewDownloadLinkSigner(downloadSecret(), downloadLinkTTL()),
+			downloadBaseURL(),
+		)
+
+		// Initialize SKUGeneratorService BEFORE VariantService and ProductService so
+		// products and variants created without an SKU get one from the seller's pattern.
+		f.skuGeneratorService = service.NewSKUGeneratorService(
+			f.repoFactory.GetSKUSettingsRepository(),
+			categoryRepo,
+		)
+		f.relatedCurationService = service.NewRelatedCurationService(
+			f.repoFactory.GetRelatedProductOverrideRepository(),
+			productRepo,
+			f.validatorService,
+		)
+
+		// Initialize VariantService with VariantQueryService dependency; bundle components
+		// are guarded against deletion
 		f.variantService = service.NewVariantService(
 			variantRepo,
 			f.productOptionService,
 			f.validatorService,
 			f.variantQueryService,
+			bundleRepo,
+			f.skuGeneratorService,
 		)
 
 		// Initialize VariantBulkService for bulk operations
@@ -109,9 +173,16 @@ func (f *ServiceFactory) initialize() {
 			variantRepo,
 			f.productOptionService,
 			f.validatorService,
+			bundleRepo,
+			f.skuGeneratorService,
 		)
 
-		f.categoryService = service.NewCategoryService(categoryRepo, productRepo, attributeRepo)
+		f.categoryService = service.NewCategoryService(
+			categoryRepo,
+			productRepo,
+			attributeRepo,
+			slugRedirectRepo,
+		)
 		f.attributeService = service.NewAttributeDefinitionService(attributeRepo)
 		f.productAttributeService = service.NewProductAttributeService(
 			productAttrRepo,
@@ -144,7 +215,29 @@ func (f *ServiceFactory) initialize() {
 			productFileGateway,
 		)
 
-		// Initialize ProductQueryService with VariantQueryService and media service
+		// Initialize ProductTranslationService BEFORE ProductQueryService so public
+		// product reads can resolve the requested content locale.
+		f.translationService = service.NewProductTranslationService(
+			f.repoFactory.GetProductTranslationRepository(),
+			optionRepo,
+			f.validatorService,
+		)
+
+		// Initialize SearchSettingsService BEFORE ProductQueryService so listing and
+		// search apply each seller's default sort and relevance weights.
+		f.searchSettingsService = service.NewSearchSettingsService(
+			f.repoFactory.GetSearchSettingsRepository(),
+		)
+
+		// Initialize SponsoredPlacementService BEFORE ProductQueryService so search can
+		// inject sponsored results.
+		f.sponsoredService = service.NewSponsoredPlacementService(
+			f.repoFactory.GetSponsoredPlacementRepository(),
+			f.validatorService,
+		)
+
+		// Initialize ProductQueryService with VariantQueryService, media, translation,
+		// search settings and sponsored placement services
 		f.productQueryService = service.NewProductQueryService(
 			productRepo,
 			f.variantQueryService,
@@ -153,6 +246,10 @@ func (f *ServiceFactory) initialize() {
 			f.packageOptionService,
 			f.productOptionService,
 			f.productMediaService,
+			f.translationService,
+			f.searchSettingsService,
+			f.sponsoredService,
+			slugRedirectRepo,
 		)
 
 		// Initialize WishlistService (needs ProductQueryService for product details)
@@ -162,11 +259,20 @@ func (f *ServiceFactory) initialize() {
 			f.productQueryService,
 		)
 
+		f.sitemapService = service.NewSitemapService(f.repoFactory.GetSitemapRepository())
+
+		f.recommendationService = service.NewRecommendationService(
+			f.repoFactory.GetRecommendationRepository(),
+			productRepo,
+			f.productQueryService,
+		)
+
 		// Initialize ProductService with its dependencies
 		f.productService = service.NewProductService(
 			productRepo,
 			categoryRepo,
 			variantRepo,
+			slugRedirectRepo,
 			f.productQueryService,
 			f.validatorService,
 			f.variantService,
@@ -174,6 +280,7 @@ func (f *ServiceFactory) initialize() {
 			f.productOptionService,
 			f.productAttributeService,
 			f.packageOptionService,
+			f.skuGeneratorService,
 		)
 	})
 }
@@ -283,3 +390,99 @@ func (f *ServiceFactory) GetVariantMediaService() service.VariantMediaService {
 	f.initialize()
 	return f.variantMediaService
 }
+
+// GetVariantChannelPriceService returns the singleton variant channel price service
+func (f *ServiceFactory) GetVariantChannelPriceService() service.VariantChannelPriceService {
+	f.initialize()
+	return f.channelPriceService
+}
+
+// GetProductTranslationService returns the singleton product translation service
+func (f *ServiceFactory) GetProductTranslationService() service.ProductTranslationService {
+	f.initialize()
+	return f.translationService
+}
+
+// GetSearchSettingsService returns the singleton search settings service
+func (f *ServiceFactory) GetSearchSettingsService() service.SearchSettingsService {
+	f.initialize()
+	return f.searchSettingsService
+}
+
+// GetSKUGeneratorService returns the singleton SKU generator service
+func (f *ServiceFactory) GetSKUGeneratorService() service.SKUGeneratorService {
+	f.initialize()
+	return f.skuGeneratorService
+}
+
+// GetRelatedCurationService returns the singleton related product curation service
+func (f *ServiceFactory) GetRelatedCurationService() service.RelatedCurationService {
+	f.initialize()
+	return f.relatedCurationService
+}
+
+// GetSponsoredPlacementService returns the singleton sponsored placement service
+func (f *ServiceFactory) GetSponsoredPlacementService() service.SponsoredPlacementService {
+	f.initialize()
+	return f.sponsoredService
+}
+
+// GetSitemapService returns the singleton sitemap service
+func (f *ServiceFactory) GetSitemapService() service.SitemapService {
+	f.initialize()
+	return f.sitemapService
+}
+
+// GetRecommendationService returns the singleton recommendation service
+func (f *ServiceFactory) GetRecommendationService() service.RecommendationService {
+	f.initialize()
+	return f.recommendationService
+}
+
+// GetVariantBundleService returns the singleton variant bundle service
+func (f *ServiceFactory) GetVariantBundleService() service.VariantBundleService {
+	f.initialize()
+	return f.bundleService
+}
+
+// GetDigitalProductService returns the singleton digital product service
+func (f *ServiceFactory) GetDigitalProductService() service.DigitalProductService {
+	f.initialize()
+	return f.digitalService
+}
+
+// GetDigitalDeliveryService returns the singleton digital delivery service
+func (f *ServiceFactory) GetDigitalDeliveryService() service.DigitalDeliveryService {
+	f.initialize()
+	return f.deliveryService
+}
+
+// downloadSecret is the key download links are signed with, falling back to the JWT
+// secret. The tokens are purpose-scoped, so they never verify as a JWT or another
+// signed link; with neither secret set, no download link is issued.
+func downloadSecret() string {
+	cfg := config.Get()
+	if cfg == nil {
+		return ""
+	}
+	if cfg.Media.DownloadSecret != "" {
+		return cfg.Media.DownloadSecret
+	}
+	return cfg.Auth.JWTSecret
+}
+
+// downloadLinkTTL is how long a download link stays valid once issued
+func downloadLinkTTL() time.Duration {
+	if cfg := config.Get(); cfg != nil && cfg.Media.DownloadLinkTTLHours > 0 {
+		return time.Duration(cfg.Media.DownloadLinkTTLHours) * time.Hour
+	}
+	return 72 * time.Hour
+}
+
+// downloadBaseURL is the API origin download links point at
+func downloadBaseURL() string {
+	if cfg := config.Get(); cfg != nil {
+		return cfg.Media.DownloadBaseURL
+	}
+	return ""
+}
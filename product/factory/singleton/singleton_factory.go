@@ -113,6 +113,50 @@ func (f *SingletonFactory) GetVariantQueryService() service.VariantQueryService
 	return f.serviceFactory.GetVariantQueryService()
 }
 
+func (f *SingletonFactory) GetVariantChannelPriceService() service.VariantChannelPriceService {
+	return f.serviceFactory.GetVariantChannelPriceService()
+}
+
+func (f *SingletonFactory) GetVariantBundleService() service.VariantBundleService {
+	return f.serviceFactory.GetVariantBundleService()
+}
+
+func (f *SingletonFactory) GetDigitalProductService() service.DigitalProductService {
+	return f.serviceFactory.GetDigitalProductService()
+}
+
+func (f *SingletonFactory) GetDigitalDeliveryService() service.DigitalDeliveryService {
+	return f.serviceFactory.GetDigitalDeliveryService()
+}
+
+func (f *SingletonFactory) GetProductTranslationService() service.ProductTranslationService {
+	return f.serviceFactory.GetProductTranslationService()
+}
+
+func (f *SingletonFactory) GetSearchSettingsService() service.SearchSettingsService {
+	return f.serviceFactory.GetSearchSettingsService()
+}
+
+func (f *SingletonFactory) GetSKUGeneratorService() service.SKUGeneratorService {
+	return f.serviceFactory.GetSKUGeneratorService()
+}
+
+func (f *SingletonFactory) GetRelatedCurationService() service.RelatedCurationService {
+	return f.serviceFactory.GetRelatedCurationService()
+}
+
+func (f *SingletonFactory) GetSponsoredPlacementService() service.SponsoredPlacementService {
+	return f.serviceFactory.GetSponsoredPlacementService()
+}
+
+func (f *SingletonFactory) GetSitemapService() service.SitemapService {
+	return f.serviceFactory.GetSitemapService()
+}
+
+func (f *SingletonFactory) GetRecommendationService() service.RecommendationService {
+	return f.serviceFactory.GetRecommendationService()
+}
+
 func (f *SingletonFactory) GetProductAttributeService() service.ProductAttributeService {
 	return f.serviceFactory.GetProductAttributeService()
 }
@@ -177,6 +221,46 @@ func (f *SingletonFactory) GetVariantHandler() *handler.VariantHandler {
 	return f.handlerFactory.GetVariantHandler()
 }
 
+func (f *SingletonFactory) GetVariantChannelPriceHandler() *handler.VariantChannelPriceHandler {
+	return f.handlerFactory.GetVariantChannelPriceHandler()
+}
+
+func (f *SingletonFactory) GetVariantBundleHandler() *handler.VariantBundleHandler {
+	return f.handlerFactory.GetVariantBundleHandler()
+}
+
+func (f *SingletonFactory) GetDigitalProductHandler() *handler.DigitalProductHandler {
+	return f.handlerFactory.GetDigitalProductHandler()
+}
+
+func (f *SingletonFactory) GetProductTranslationHandler() *handler.ProductTranslationHandler {
+	return f.handlerFactory.GetProductTranslationHandler()
+}
+
+func (f *SingletonFactory) GetSearchSettingsHandler() *handler.SearchSettingsHandler {
+	return f.handlerFactory.GetSearchSettingsHandler()
+}
+
+func (f *SingletonFactory) GetSKUSettingsHandler() *handler.SKUSettingsHandler {
+	return f.handlerFactory.GetSKUSettingsHandler()
+}
+
+func (f *SingletonFactory) GetRelatedCurationHandler() *handler.RelatedCurationHandler {
+	return f.handlerFactory.GetRelatedCurationHandler()
+}
+
+func (f *SingletonFactory) GetSponsoredPlacementHandler() *handler.SponsoredPlacementHandler {
+	return f.handlerFactory.GetSponsoredPlacementHandler()
+}
+
+func (f *SingletonFactory) GetSitemapHandler() *handler.SitemapHandler {
+	return f.handlerFactory.GetSitemapHandler()
+}
+
+func (f *SingletonFactory) GetRecommendationHandler() *handler.RecommendationHandler {
+	return f.handlerFactory.GetRecommendationHandler()
+}
+
 func (f *SingletonFactory) GetProductAttributeHandler() *handler.ProductAttributeHandler {
 	return f.handlerFactory.GetProductAttributeHandler()
 }
@@ -1,6 +1,7 @@
 package factory
 
 import (
+	commonHelper "ecommerce-be/common/helper"
 	"ecommerce-be/product/entity"
 	"ecommerce-be/product/mapper"
 	"ecommerce-be/product/model"
@@ -18,6 +19,7 @@ func CreateVariantFromRequest(
 	return &entity.ProductVariant{
 		ProductID:     productID,
 		SKU:           req.SKU,
+		Barcode:       commonHelper.StringPtr(req.Barcode),
 		Price:         req.Price,
 		AllowPurchase: helper.GetBoolOrDefault(req.AllowPurchase, true),
 		IsPopular:     helper.GetBoolOrDefault(req.IsPopular, false),
@@ -34,6 +36,10 @@ func UpdateVariantEntity(
 		variant.SKU = *req.SKU
 	}
 
+	if req.Barcode != nil {
+		variant.Barcode = commonHelper.StringPtr(*req.Barcode)
+	}
+
 	if req.Price != nil {
 		variant.Price = *req.Price
 	}
@@ -64,6 +70,10 @@ func BulkUpdateVariantEntity(
 		variant.SKU = *updateData.SKU
 	}
 
+	if updateData.Barcode != nil {
+		variant.Barcode = commonHelper.StringPtr(*updateData.Barcode)
+	}
+
 	if updateData.Price != nil {
 		variant.Price = *updateData.Price
 	}
@@ -132,12 +142,15 @@ func BuildVariantDetailResponse(
 		ID:              variant.ID,
 		ProductID:       variant.ProductID,
 		SKU:             variant.SKU,
+		Barcode:         variant.Barcode,
 		Price:           variant.Price,
 		AllowPurchase:   variant.AllowPurchase,
 		IsDefault:       variant.IsDefault,
 		IsPopular:       variant.IsPopular,
 		SelectedOptions: selectedOptions,
+		Version:         variant.Version,
 		Media:           []model.VariantMediaResponse{},
+		Images:          []string{},
 		CreatedAt:       helper.FormatTimestamp(variant.CreatedAt),
 		UpdatedAt:       helper.FormatTimestamp(variant.UpdatedAt),
 	}
@@ -171,12 +184,14 @@ func BuildVariantResponse(
 	return &model.VariantResponse{
 		ID:              variant.ID,
 		SKU:             variant.SKU,
+		Barcode:         variant.Barcode,
 		Price:           variant.Price,
 		AllowPurchase:   variant.AllowPurchase,
 		IsDefault:       variant.IsDefault,
 		IsPopular:       variant.IsPopular,
 		SelectedOptions: selectedOptions,
 		Media:           []model.VariantMediaResponse{},
+		Images:          []string{},
 	}
 }
 
@@ -6,6 +6,7 @@ import (
 
 	"ecommerce-be/common/auth"
 	"ecommerce-be/common/constants"
+	commonError "ecommerce-be/common/error"
 	"ecommerce-be/common/handler"
 	"ecommerce-be/product/model"
 	"ecommerce-be/product/service"
@@ -170,6 +171,38 @@ func (h *CategoryHandler) GetCategoryByID(c *gin.Context) {
 	)
 }
 
+// GetCategoryBySlug handles GET /api/product/category/by-slug/:slug for the
+// request's seller. A retired slug answers 301 with the current slug in Location.
+func (h *CategoryHandler) GetCategoryBySlug(c *gin.Context) {
+	sellerID, exists := auth.GetSellerIDFromContext(c)
+	if !exists || sellerID == 0 {
+		h.HandleError(c, commonError.ErrSellerDataMissing, constants.SELLER_DATA_MISSING_MSG)
+		return
+	}
+
+	categoryResponse, currentSlug, err := h.categoryService.GetCategoryBySlug(
+		c,
+		sellerID,
+		c.Param(utils.SLUG_PARAM),
+	)
+	if err != nil {
+		h.HandleError(c, err, utils.FAILED_TO_GET_CATEGORIES_MSG)
+		return
+	}
+	if categoryResponse == nil {
+		redirectToSlug(c, h.BaseHandler, utils.CATEGORY_SLUG_PATH, currentSlug)
+		return
+	}
+
+	h.SuccessWithData(
+		c,
+		http.StatusOK,
+		utils.CATEGORIES_RETRIEVED_MSG,
+		utils.CATEGORY_FIELD_NAME,
+		categoryResponse,
+	)
+}
+
 // GetCategoriesByParent handles getting categories by parent ID
 func (h *CategoryHandler) GetCategoriesByParent(c *gin.Context) {
 	parentIDStr := c.Query("parentId")
@@ -0,0 +1,201 @@
+package handler
+
+import (
+	"net/http"
+
+	"ecommerce-be/common/auth"
+	"ecommerce-be/common/constants"
+	"ecommerce-be/common/handler"
+	"ecommerce-be/common/log"
+	"ecommerce-be/product/model"
+	"ecommerce-be/product/service"
+	"ecommerce-be/product/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// DigitalProductHandler handles download files, license key pools and downloads of
+// digital products
+type DigitalProductHandler struct {
+	*handler.BaseHandler
+	digitalService  service.DigitalProductService
+	deliveryService service.DigitalDeliveryService
+}
+
+// NewDigitalProductHandler creates a new instance of DigitalProductHandler
+func NewDigitalProductHandler(
+	digitalService service.DigitalProductService,
+	deliveryService service.DigitalDeliveryService,
+) *DigitalProductHandler {
+	return &DigitalProductHandler{
+		BaseHandler:     handler.NewBaseHandler(),
+		digitalService:  digitalService,
+		deliveryService: deliveryService,
+	}
+}
+
+// ListAssets returns the download files of a digital product
+// GET /api/product/:productId/digital/asset
+func (h *DigitalProductHandler) ListAssets(c *gin.Context) {
+	productID, sellerID, ok := h.parseSellerProduct(c)
+	if !ok {
+		return
+	}
+
+	assets, err := h.digitalService.ListAssets(c, productID, sellerID)
+	if err != nil {
+		h.HandleError(c, err, utils.FAILED_TO_GET_DIGITAL_ASSETS_MSG)
+		return
+	}
+
+	h.SuccessWithData(
+		c, http.StatusOK, utils.DIGITAL_ASSETS_RETRIEVED_MSG, utils.DIGITAL_ASSETS_FIELD_NAME, assets,
+	)
+}
+
+// AddAsset attaches an uploaded file to a digital product
+// POST /api/product/:productId/digital/asset
+func (h *DigitalProductHandler) AddAsset(c *gin.Context) {
+	var req model.AddDigitalAssetRequest
+	if err := h.BindJSON(c, &req); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	productID, sellerID, ok := h.parseSellerProduct(c)
+	if !ok {
+		return
+	}
+
+	asset, err := h.digitalService.AddAsset(c, productID, sellerID, req)
+	if err != nil {
+		log.ErrorWithContext(c, "addDigitalAsset: failed", err)
+		h.HandleError(c, err, utils.FAILED_TO_ADD_DIGITAL_ASSET_MSG)
+		return
+	}
+
+	h.SuccessWithData(
+		c, http.StatusCreated, utils.DIGITAL_ASSET_ADDED_MSG, utils.DIGITAL_ASSET_FIELD_NAME, asset,
+	)
+}
+
+// DeleteAsset detaches a download file from a digital product
+// DELETE /api/product/:productId/digital/asset/:assetId
+func (h *DigitalProductHandler) DeleteAsset(c *gin.Context) {
+	productID, sellerID, ok := h.parseSellerProduct(c)
+	if !ok {
+		return
+	}
+	assetID, err := h.ParseUintParam(c, utils.DIGITAL_ASSET_ID_PARAM)
+	if err != nil {
+		h.HandleError(c, err, "")
+		return
+	}
+
+	if err := h.digitalService.DeleteAsset(c, productID, assetID, sellerID); err != nil {
+		log.ErrorWithContext(c, "deleteDigitalAsset: failed", err)
+		h.HandleError(c, err, utils.FAILED_TO_DELETE_DIGITAL_ASSET_MSG)
+		return
+	}
+
+	h.Success(c, http.StatusOK, utils.DIGITAL_ASSET_DELETED_MSG, nil)
+}
+
+// GetLicenseKeys returns a digital product's license key pool
+// GET /api/product/:productId/digital/license-key
+func (h *DigitalProductHandler) GetLicenseKeys(c *gin.Context) {
+	productID, sellerID, ok := h.parseSellerProduct(c)
+	if !ok {
+		return
+	}
+
+	pool, err := h.digitalService.GetLicenseKeys(c, productID, sellerID)
+	if err != nil {
+		h.HandleError(c, err, utils.FAILED_TO_GET_LICENSE_KEYS_MSG)
+		return
+	}
+
+	h.SuccessWithData(
+		c, http.StatusOK, utils.LICENSE_KEYS_RETRIEVED_MSG, utils.LICENSE_KEY_POOL_FIELD_NAME, pool,
+	)
+}
+
+// AddLicenseKeys adds keys to a digital product's pool
+// POST /api/product/:productId/digital/license-key
+func (h *DigitalProductHandler) AddLicenseKeys(c *gin.Context) {
+	var req model.AddLicenseKeysRequest
+	if err := h.BindJSON(c, &req); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	productID, sellerID, ok := h.parseSellerProduct(c)
+	if !ok {
+		return
+	}
+
+	pool, err := h.digitalService.AddLicenseKeys(c, productID, sellerID, req)
+	if err != nil {
+		log.ErrorWithContext(c, "addLicenseKeys: failed", err)
+		h.HandleError(c, err, utils.FAILED_TO_ADD_LICENSE_KEYS_MSG)
+		return
+	}
+
+	h.SuccessWithData(
+		c, http.StatusCreated, utils.LICENSE_KEYS_ADDED_MSG, utils.LICENSE_KEY_POOL_FIELD_NAME, pool,
+	)
+}
+
+// DeleteLicenseKey removes a key that has not been issued yet
+// DELETE /api/product/:productId/digital/license-key/:keyId
+func (h *DigitalProductHandler) DeleteLicenseKey(c *gin.Context) {
+	productID, sellerID, ok := h.parseSellerProduct(c)
+	if !ok {
+		return
+	}
+	keyID, err := h.ParseUintParam(c, utils.LICENSE_KEY_ID_PARAM)
+	if err != nil {
+		h.HandleError(c, err, "")
+		return
+	}
+
+	if err := h.digitalService.DeleteLicenseKey(c, productID, keyID, sellerID); err != nil {
+		log.ErrorWithContext(c, "deleteLicenseKey: failed", err)
+		h.HandleError(c, err, utils.FAILED_TO_DELETE_LICENSE_KEY_MSG)
+		return
+	}
+
+	h.Success(c, http.StatusOK, utils.LICENSE_KEY_DELETED_MSG, nil)
+}
+
+// Download redirects a signed download link to the file and counts the download.
+// The token is the only credential, so the link works straight from an email.
+// GET /api/product/download/:token
+func (h *DigitalProductHandler) Download(c *gin.Context) {
+	url, err := h.deliveryService.ResolveDownload(
+		c, c.Param(utils.DIGITAL_DOWNLOAD_TOKEN_PARAM),
+	)
+	if err != nil {
+		h.HandleError(c, err, utils.FAILED_TO_DOWNLOAD_MSG)
+		return
+	}
+
+	c.Header("Location", url)
+	h.Success(c, http.StatusFound, utils.DIGITAL_DOWNLOAD_STARTED_MSG, nil)
+}
+
+// parseSellerProduct reads the product ID and the calling seller, writing the error
+// response on failure
+func (h *DigitalProductHandler) parseSellerProduct(c *gin.Context) (uint, uint, bool) {
+	productID, err := h.ParseUintParam(c, utils.PRODUCT_ID_PARAM)
+	if err != nil {
+		h.HandleError(c, err, "")
+		return 0, 0, false
+	}
+	_, sellerID, err := auth.ValidateUserHasSellerRoleOrHigherAndReturnAuthData(c)
+	if err != nil {
+		h.HandleError(c, err, constants.UNAUTHORIZED_ERROR_MSG)
+		return 0, 0, false
+	}
+	return productID, sellerID, true
+}
@@ -2,10 +2,12 @@ package handler
 
 import (
 	"net/http"
+	"net/url"
 	"strconv"
 	"strings"
 
 	"ecommerce-be/common/auth"
+	"ecommerce-be/common/cache"
 	"ecommerce-be/common/constants"
 	"ecommerce-be/common/error"
 	"ecommerce-be/common/handler"
@@ -142,6 +144,17 @@ func (h *ProductHandler) GetAllProducts(c *gin.Context) {
 		h.HandleValidationError(c, err)
 		return
 	}
+	if err := validator.RequireLessOrEqual(&params, "minPrice", "maxPrice"); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+	if !bindContentLocale(c, h.BaseHandler) {
+		return
+	}
+	fields, ok := bindProductFields(c, h.BaseHandler, utils.ParseProductFields)
+	if !ok {
+		return
+	}
 
 	// Add seller ID filter if present in context (for multi-tenant isolation)
 	// Seller ID will be present from PublicAPIAuth or Auth middleware
@@ -159,6 +172,19 @@ func (h *ProductHandler) GetAllProducts(c *gin.Context) {
 
 	// Convert params to filter model (parses comma-separated values)
 	filter := params.ToGetProductsFilter(sellerIDPtr)
+	filter.WithFacets = true
+	if params.Options != nil {
+		optionValues, err := utils.ParseOptionValueFilters(*params.Options)
+		if err != nil {
+			h.HandleError(
+				c,
+				productErrors.ErrInvalidOptionFilter.WithMessage(err.Error()),
+				utils.FAILED_TO_GET_PRODUCTS_MSG,
+			)
+			return
+		}
+		filter.OptionValues = optionValues
+	}
 
 	// Set pagination defaults
 	params.SetDefaults()
@@ -176,6 +202,11 @@ func (h *ProductHandler) GetAllProducts(c *gin.Context) {
 		return
 	}
 
+	if fields != nil {
+		h.Success(c, http.StatusOK, utils.PRODUCTS_RETRIEVED_MSG,
+			utils.ProjectProductsResponse(productsResponse, fields))
+		return
+	}
 	h.Success(c, http.StatusOK, utils.PRODUCTS_RETRIEVED_MSG, productsResponse)
 }
 
@@ -186,6 +217,13 @@ func (h *ProductHandler) GetProductByID(c *gin.Context) {
 		h.HandleError(c, err, "Invalid product ID")
 		return
 	}
+	if !bindContentLocale(c, h.BaseHandler) {
+		return
+	}
+	fields, ok := bindProductDetailFields(c, h.BaseHandler)
+	if !ok {
+		return
+	}
 
 	// Get seller ID from context if available (for multi-tenant isolation)
 	// If seller ID exists, verify product belongs to that seller
@@ -208,7 +246,119 @@ func (h *ProductHandler) GetProductByID(c *gin.Context) {
 	}
 
 	h.SuccessWithData(c, http.StatusOK, utils.PRODUCT_RETRIEVED_MSG,
-		utils.PRODUCT_FIELD_NAME, productResponse)
+		utils.PRODUCT_FIELD_NAME, sparseProduct(productResponse, fields))
+}
+
+// GetProductsBatch handles POST /api/product/batch: full product details for up to 100
+// IDs in request order, with a not-found marker for each ID that cannot be shown
+func (h *ProductHandler) GetProductsBatch(c *gin.Context) {
+	var req model.BatchProductsRequest
+	if err := h.BindJSON(c, &req); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+	if !bindContentLocale(c, h.BaseHandler) {
+		return
+	}
+
+	var sellerIDPtr *uint
+	if sellerID, exists := auth.GetSellerIDFromContext(c); exists {
+		sellerIDPtr = &sellerID
+	}
+
+	var userIDPtr *uint
+	if userID, exists := auth.GetUserIDFromContext(c); exists {
+		userIDPtr = &userID
+	}
+
+	response, err := h.productQueryService.GetProductsBatch(
+		c,
+		req.ProductIDs,
+		sellerIDPtr,
+		userIDPtr,
+	)
+	if err != nil {
+		h.HandleError(c, err, utils.FAILED_TO_GET_PRODUCTS_MSG)
+		return
+	}
+
+	h.Success(c, http.StatusOK, utils.PRODUCTS_RETRIEVED_MSG, response)
+}
+
+// CompareProducts handles GET /api/product/compare?ids=1,2,3: the products side by side
+// with the union of their attributes
+func (h *ProductHandler) CompareProducts(c *gin.Context) {
+	productIDs, err := utils.ParseCompareProductIDs(c.Query("ids"))
+	if err != nil {
+		h.HandleError(
+			c,
+			productErrors.ErrInvalidProductCompare.WithMessage(err.Error()),
+			utils.FAILED_TO_COMPARE_PRODUCTS_MSG,
+		)
+		return
+	}
+	if !bindContentLocale(c, h.BaseHandler) {
+		return
+	}
+
+	var sellerIDPtr *uint
+	if sellerID, exists := auth.GetSellerIDFromContext(c); exists {
+		sellerIDPtr = &sellerID
+	}
+
+	var userIDPtr *uint
+	if userID, exists := auth.GetUserIDFromContext(c); exists {
+		userIDPtr = &userID
+	}
+
+	response, err := h.productQueryService.CompareProducts(c, productIDs, sellerIDPtr, userIDPtr)
+	if err != nil {
+		h.HandleError(c, err, utils.FAILED_TO_COMPARE_PRODUCTS_MSG)
+		return
+	}
+
+	h.Success(c, http.StatusOK, utils.PRODUCTS_COMPARED_MSG, response)
+}
+
+// GetProductBySlug handles GET /api/product/by-slug/:slug for the request's seller.
+// A retired slug answers 301 with the current slug in the Location header.
+func (h *ProductHandler) GetProductBySlug(c *gin.Context) {
+	if !bindContentLocale(c, h.BaseHandler) {
+		return
+	}
+	fields, ok := bindProductDetailFields(c, h.BaseHandler)
+	if !ok {
+		return
+	}
+
+	sellerID, exists := auth.GetSellerIDFromContext(c)
+	if !exists || sellerID == 0 {
+		h.HandleError(c, error.ErrSellerDataMissing, "Seller context required")
+		return
+	}
+
+	var userIDPtr *uint
+	if userID, exists := auth.GetUserIDFromContext(c); exists {
+		userIDPtr = &userID
+	}
+
+	productResponse, currentSlug, err := h.productQueryService.GetProductBySlug(
+		c,
+		sellerID,
+		c.Param(utils.SLUG_PARAM),
+		userIDPtr,
+	)
+	if err != nil {
+		h.HandleError(c, err, utils.FAILED_TO_GET_PRODUCT_MSG)
+		return
+	}
+	if productResponse == nil {
+		redirectToSlug(c, h.BaseHandler, utils.PRODUCT_SLUG_PATH, currentSlug)
+		return
+	}
+
+	h.SuccessWithData(c, http.StatusOK, utils.PRODUCT_RETRIEVED_MSG,
+		utils.PRODUCT_FIELD_NAME, sparseProduct(productResponse, fields))
 }
 
 // SearchProducts handles product search
@@ -218,6 +368,13 @@ func (h *ProductHandler) SearchProducts(c *gin.Context) {
 		h.HandleError(c, error.ErrRequiredQueryParam, "Search query parameter 'q' is required")
 		return
 	}
+	if !bindContentLocale(c, h.BaseHandler) {
+		return
+	}
+	fields, ok := bindProductFields(c, h.BaseHandler, utils.ParseSearchResultFields)
+	if !ok {
+		return
+	}
 
 	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
 	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
@@ -237,6 +394,9 @@ func (h *ProductHandler) SearchProducts(c *gin.Context) {
 	if maxPrice, err := strconv.ParseFloat(c.Query("maxPrice"), 64); err == nil && maxPrice > 0 {
 		filters["maxPrice"] = maxPrice
 	}
+	if inStock, err := strconv.ParseBool(c.Query("inStock")); err == nil {
+		filters["inStock"] = inStock
+	}
 
 	// Add seller ID filter if present in context (for multi-tenant isolation)
 	if sellerID, exists := auth.GetSellerIDFromContext(c); exists {
@@ -249,15 +409,61 @@ func (h *ProductHandler) SearchProducts(c *gin.Context) {
 		userIDPtr = &userID
 	}
 
-	searchResponse, err := h.productQueryService.SearchProducts(c, query, filters, page, limit, userIDPtr)
+	// Optional explicit sort; otherwise the seller's default sort (e.g. relevance) applies
+	searchResponse, err := h.productQueryService.SearchProducts(
+		c,
+		query,
+		filters,
+		page,
+		limit,
+		c.Query("sortBy"),
+		c.Query("sortOrder"),
+		userIDPtr,
+		sponsoredViewer(c),
+	)
 	if err != nil {
 		h.HandleError(c, err, utils.FAILED_TO_SEARCH_PRODUCTS_MSG)
 		return
 	}
 
+	if fields != nil {
+		h.Success(c, http.StatusOK, utils.PRODUCTS_FOUND_MSG,
+			utils.ProjectSearchResponse(searchResponse, fields))
+		return
+	}
 	h.Success(c, http.StatusOK, utils.PRODUCTS_FOUND_MSG, searchResponse)
 }
 
+// SuggestProducts handles search-as-you-type suggestions for a prefix
+func (h *ProductHandler) SuggestProducts(c *gin.Context) {
+	query := c.Query("q")
+	if query == "" {
+		h.HandleError(c, error.ErrRequiredQueryParam, "Search query parameter 'q' is required")
+		return
+	}
+	limit, _ := strconv.Atoi(c.Query("limit"))
+
+	// Storefront requests only suggest the seller's own catalogue
+	var sellerIDPtr *uint
+	if sellerID, exists := auth.GetSellerIDFromContext(c); exists {
+		sellerIDPtr = &sellerID
+	}
+
+	suggestions, err := h.productQueryService.SuggestProducts(c, query, sellerIDPtr, limit)
+	if err != nil {
+		h.HandleError(c, err, utils.FAILED_TO_GET_SEARCH_SUGGESTIONS_MSG)
+		return
+	}
+
+	h.SuccessWithData(
+		c,
+		http.StatusOK,
+		utils.SEARCH_SUGGESTIONS_FOUND_MSG,
+		utils.SEARCH_SUGGESTIONS_FIELD_NAME,
+		suggestions,
+	)
+}
+
 // GetProductFilters handles getting available product filters
 func (h *ProductHandler) GetProductFilters(c *gin.Context) {
 	// Get seller ID from context if available (for multi-tenant isolation)
@@ -285,6 +491,9 @@ func (h *ProductHandler) GetRelatedProductsScored(c *gin.Context) {
 		h.HandleError(c, err, "Invalid product ID")
 		return
 	}
+	if !bindContentLocale(c, h.BaseHandler) {
+		return
+	}
 
 	// Parse query parameters
 	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
@@ -359,7 +568,8 @@ func (h *ProductHandler) GetRelatedProductsScored(c *gin.Context) {
 // ─── Product Media Handlers ───────────────────────────────────────────────────
 
 // AttachMedia handles POST /api/product/:productId/media
-// Attaches an already-uploaded file to the product identified by productId.
+// Attaches an already-uploaded image, video or 3D model, or an external video URL,
+// to the product identified by productId.
 // Requires seller authentication; the caller must own the product.
 func (h *ProductHandler) AttachMedia(c *gin.Context) {
 	productID, err := h.ParseUintParam(c, "productId")
@@ -464,3 +674,78 @@ func (h *ProductHandler) RemoveMedia(c *gin.Context) {
 
 	c.Status(http.StatusNoContent)
 }
+
+// GetCacheStats returns hit/miss counters of the product read-through caches
+// GET /api/product/cache/stats
+func (h *ProductHandler) GetCacheStats(c *gin.Context) {
+	h.SuccessWithData(
+		c,
+		http.StatusOK,
+		utils.CACHE_STATS_RETRIEVED_MSG,
+		utils.CACHE_STATS_FIELD_NAME,
+		cache.Stats(utils.CACHE_NAMESPACE_PREFIX),
+	)
+}
+
+// bindProductFields stores a valid ?fields selection in the request context so the
+// product read only loads what it returns. Writes the error response and returns false
+// when the value is malformed; a nil fieldset means every field.
+func bindProductFields(
+	c *gin.Context,
+	h *handler.BaseHandler,
+	parse utils.FieldSetParser,
+) (model.ProductFieldSet, bool) {
+	fields, err := parse(c.Query(utils.PRODUCT_FIELDS_QUERY_PARAM))
+	if err != nil {
+		h.HandleError(c, productErrors.ErrInvalidProductFields.WithMessage(err.Error()), "")
+		return nil, false
+	}
+	if fields != nil {
+		utils.ProductFields.Set(c, fields)
+	}
+	return fields, true
+}
+
+// bindProductDetailFields binds ?fields like bindProductFields, narrowed by ?include to
+// the associations product detail should load
+func bindProductDetailFields(
+	c *gin.Context,
+	h *handler.BaseHandler,
+) (model.ProductFieldSet, bool) {
+	includes, err := utils.ParseProductIncludes(c.Query(utils.PRODUCT_INCLUDE_QUERY_PARAM))
+	if err != nil {
+		h.HandleError(c, productErrors.ErrInvalidProductInclude.WithMessage(err.Error()), "")
+		return nil, false
+	}
+	fields, err := utils.ParseProductFields(c.Query(utils.PRODUCT_FIELDS_QUERY_PARAM))
+	if err != nil {
+		h.HandleError(c, productErrors.ErrInvalidProductFields.WithMessage(err.Error()), "")
+		return nil, false
+	}
+
+	fields = utils.ApplyProductIncludes(fields, includes)
+	if fields != nil {
+		utils.ProductFields.Set(c, fields)
+	}
+	return fields, true
+}
+
+// sparseProduct limits a product detail response to the selected fields
+func sparseProduct(product *model.ProductResponse, fields model.ProductFieldSet) any {
+	if fields == nil {
+		return product
+	}
+	return utils.ProjectProduct(product, fields)
+}
+
+// redirectToSlug answers a lookup by a retired slug with 301 to the current one,
+// keeping the query string (e.g. ?locale) so the redirected request is equivalent
+func redirectToSlug(c *gin.Context, h *handler.BaseHandler, path, slug string) {
+	location := path + url.PathEscape(slug)
+	if c.Request.URL.RawQuery != "" {
+		location += "?" + c.Request.URL.RawQuery
+	}
+	c.Header("Location", location)
+	h.SuccessWithData(c, http.StatusMovedPermanently, utils.SLUG_MOVED_MSG,
+		utils.SLUG_PARAM, slug)
+}
@@ -200,3 +200,91 @@ func (h *ProductOptionHandler) BulkUpdateOptions(c *gin.Context) {
 		"updatedCount": response.UpdatedCount,
 	})
 }
+
+/***********************************************
+ *              Option Rules                    *
+ ***********************************************/
+// ListOptionRules handles listing the option dependency rules of a product
+// GET /api/product/:productId/option-rule
+func (h *ProductOptionHandler) ListOptionRules(c *gin.Context) {
+	productID, err := h.ParseUintParam(c, "productId")
+	if err != nil {
+		h.HandleError(c, err, "Invalid product ID")
+		return
+	}
+
+	// Extract seller ID from context (set by PublicAPIAuth middleware)
+	var sellerID *uint
+	if id, exists := auth.GetSellerIDFromContext(c); exists {
+		sellerID = &id
+	}
+
+	rules, err := h.optionService.ListOptionRules(c, productID, sellerID)
+	if err != nil {
+		h.HandleError(c, err, utils.FAILED_TO_GET_OPTION_RULES_MSG)
+		return
+	}
+
+	h.SuccessWithData(c, http.StatusOK, utils.OPTION_RULES_RETRIEVED_MSG,
+		utils.OPTION_RULES_FIELD_NAME, rules)
+}
+
+// CreateOptionRule handles creating an option dependency rule
+// POST /api/product/:productId/option-rule
+func (h *ProductOptionHandler) CreateOptionRule(c *gin.Context) {
+	productID, err := h.ParseUintParam(c, "productId")
+	if err != nil {
+		h.HandleError(c, err, "Invalid product ID")
+		return
+	}
+
+	var req model.ProductOptionRuleCreateRequest
+	if err := h.BindJSON(c, &req); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	_, sellerId, err := auth.ValidateUserHasSellerRoleOrHigherAndReturnAuthData(c)
+	if err != nil {
+		h.HandleError(c, err, constants.UNAUTHORIZED_ERROR_MSG)
+		return
+	}
+
+	rule, err := h.optionService.CreateOptionRule(c, productID, sellerId, req)
+	if err != nil {
+		h.HandleError(c, err, utils.FAILED_TO_CREATE_OPTION_RULE_MSG)
+		return
+	}
+
+	h.SuccessWithData(c, http.StatusCreated, utils.OPTION_RULE_CREATED_MSG,
+		utils.OPTION_RULE_FIELD_NAME, rule)
+}
+
+// DeleteOptionRule handles deleting an option dependency rule
+// DELETE /api/product/:productId/option-rule/:ruleId
+func (h *ProductOptionHandler) DeleteOptionRule(c *gin.Context) {
+	productID, err := h.ParseUintParam(c, "productId")
+	if err != nil {
+		h.HandleError(c, err, "Invalid product ID")
+		return
+	}
+
+	ruleID, err := h.ParseUintParam(c, "ruleId")
+	if err != nil {
+		h.HandleError(c, err, "Invalid rule ID")
+		return
+	}
+
+	_, sellerId, err := auth.ValidateUserHasSellerRoleOrHigherAndReturnAuthData(c)
+	if err != nil {
+		h.HandleError(c, err, constants.UNAUTHORIZED_ERROR_MSG)
+		return
+	}
+
+	if err := h.optionService.DeleteOptionRule(c, productID, sellerId, ruleID); err != nil {
+		h.HandleError(c, err, utils.FAILED_TO_DELETE_OPTION_RULE_MSG)
+		return
+	}
+
+	h.Success(c, http.StatusOK, utils.OPTION_RULE_DELETED_MSG, nil)
+}
@@ -0,0 +1,145 @@
+package handler
+
+import (
+	"net/http"
+
+	"ecommerce-be/common"
+	"ecommerce-be/common/auth"
+	"ecommerce-be/common/constants"
+	"ecommerce-be/common/handler"
+	"ecommerce-be/common/log"
+	"ecommerce-be/product/model"
+	"ecommerce-be/product/service"
+	"ecommerce-be/product/utils"
+	"ecommerce-be/product/validator"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ProductTranslationHandler handles seller management of per-locale product content
+type ProductTranslationHandler struct {
+	*handler.BaseHandler
+	translationService service.ProductTranslationService
+}
+
+// NewProductTranslationHandler creates a new instance of ProductTranslationHandler
+func NewProductTranslationHandler(
+	translationService service.ProductTranslationService,
+) *ProductTranslationHandler {
+	return &ProductTranslationHandler{
+		BaseHandler:        handler.NewBaseHandler(),
+		translationService: translationService,
+	}
+}
+
+// GetTranslations lists every translated locale of a product
+// GET /api/product/:productId/translation
+func (h *ProductTranslationHandler) GetTranslations(c *gin.Context) {
+	productID, err := h.ParseUintParam(c, utils.PRODUCT_ID_PARAM)
+	if err != nil {
+		h.HandleError(c, err, "")
+		return
+	}
+
+	_, sellerID, err := auth.ValidateUserHasSellerRoleOrHigherAndReturnAuthData(c)
+	if err != nil {
+		h.HandleError(c, err, constants.UNAUTHORIZED_ERROR_MSG)
+		return
+	}
+
+	resp, err := h.translationService.GetProductTranslations(c, productID, sellerID)
+	if err != nil {
+		log.ErrorWithContext(c, "getProductTranslations: failed", err)
+		h.HandleError(c, err, utils.FAILED_TO_GET_PRODUCT_TRANSLATIONS_MSG)
+		return
+	}
+
+	h.Success(c, http.StatusOK, utils.PRODUCT_TRANSLATIONS_RETRIEVED_MSG, resp)
+}
+
+// UpsertTranslation replaces a product's content and option names for one locale
+// PUT /api/product/:productId/translation/:locale
+func (h *ProductTranslationHandler) UpsertTranslation(c *gin.Context) {
+	productID, err := h.ParseUintParam(c, utils.PRODUCT_ID_PARAM)
+	if err != nil {
+		h.HandleError(c, err, "")
+		return
+	}
+
+	var req model.UpsertProductTranslationRequest
+	if err := h.BindJSON(c, &req); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	_, sellerID, err := auth.ValidateUserHasSellerRoleOrHigherAndReturnAuthData(c)
+	if err != nil {
+		h.HandleError(c, err, constants.UNAUTHORIZED_ERROR_MSG)
+		return
+	}
+
+	resp, err := h.translationService.UpsertProductTranslation(
+		c,
+		productID,
+		sellerID,
+		c.Param(utils.LOCALE_PARAM),
+		req,
+	)
+	if err != nil {
+		log.ErrorWithContext(c, "upsertProductTranslation: failed", err)
+		h.HandleError(c, err, utils.FAILED_TO_SAVE_PRODUCT_TRANSLATION_MSG)
+		return
+	}
+
+	h.SuccessWithData(
+		c,
+		http.StatusOK,
+		utils.PRODUCT_TRANSLATION_SAVED_MSG,
+		utils.TRANSLATION_FIELD_NAME,
+		resp,
+	)
+}
+
+// DeleteTranslation removes one locale of a product's content
+// DELETE /api/product/:productId/translation/:locale
+func (h *ProductTranslationHandler) DeleteTranslation(c *gin.Context) {
+	productID, err := h.ParseUintParam(c, utils.PRODUCT_ID_PARAM)
+	if err != nil {
+		h.HandleError(c, err, "")
+		return
+	}
+
+	_, sellerID, err := auth.ValidateUserHasSellerRoleOrHigherAndReturnAuthData(c)
+	if err != nil {
+		h.HandleError(c, err, constants.UNAUTHORIZED_ERROR_MSG)
+		return
+	}
+
+	if err := h.translationService.DeleteProductTranslation(
+		c,
+		productID,
+		sellerID,
+		c.Param(utils.LOCALE_PARAM),
+	); err != nil {
+		log.ErrorWithContext(c, "deleteProductTranslation: failed", err)
+		h.HandleError(c, err, utils.FAILED_TO_DELETE_PRODUCT_TRANSLATION_MSG)
+		return
+	}
+
+	common.SuccessResponse(c, http.StatusOK, utils.PRODUCT_TRANSLATION_DELETED_MSG, nil)
+}
+
+// bindContentLocale stores a valid ?locale override in the request context so product
+// content resolves to it instead of Accept-Language. Writes the error response and
+// returns false when the value is malformed.
+func bindContentLocale(c *gin.Context, h *handler.BaseHandler) bool {
+	locale, err := validator.ParseContentLocale(c.Query(utils.LOCALE_QUERY_PARAM))
+	if err != nil {
+		h.HandleError(c, err, "")
+		return false
+	}
+	if locale != "" {
+		utils.ContentLocale.Set(c, locale)
+	}
+	return true
+}
@@ -0,0 +1,125 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"ecommerce-be/common/auth"
+	"ecommerce-be/common/constants"
+	commonError "ecommerce-be/common/error"
+	"ecommerce-be/common/handler"
+	"ecommerce-be/common/log"
+	"ecommerce-be/product/service"
+	"ecommerce-be/product/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RecommendationHandler handles "customers also bought" and personal recommendations
+type RecommendationHandler struct {
+	*handler.BaseHandler
+	recommendationService service.RecommendationService
+}
+
+// NewRecommendationHandler creates a new instance of RecommendationHandler
+func NewRecommendationHandler(
+	recommendationService service.RecommendationService,
+) *RecommendationHandler {
+	return &RecommendationHandler{
+		BaseHandler:           handler.NewBaseHandler(),
+		recommendationService: recommendationService,
+	}
+}
+
+// GetAlsoBought returns the products customers of the product also bought
+// GET /api/product/:productId/also-bought
+func (h *RecommendationHandler) GetAlsoBought(c *gin.Context) {
+	productID, err := h.ParseUintParam(c, "productId")
+	if err != nil {
+		h.HandleError(c, err, utils.INVALID_PRODUCT_ID_MSG)
+		return
+	}
+	if !bindContentLocale(c, h.BaseHandler) {
+		return
+	}
+	limit, ok := h.parseLimit(c)
+	if !ok {
+		return
+	}
+
+	var sellerID *uint
+	if id, exists := auth.GetSellerIDFromContext(c); exists {
+		sellerID = &id
+	}
+	var userID *uint
+	if id, exists := auth.GetUserIDFromContext(c); exists {
+		userID = &id
+	}
+
+	products, err := h.recommendationService.GetAlsoBought(c, productID, sellerID, userID, limit)
+	if err != nil {
+		log.ErrorWithContext(c, "getAlsoBought: failed", err)
+		h.HandleError(c, err, utils.FAILED_TO_GET_ALSO_BOUGHT_MSG)
+		return
+	}
+
+	h.SuccessWithData(
+		c,
+		http.StatusOK,
+		utils.ALSO_BOUGHT_RETRIEVED_MSG,
+		utils.ALSO_BOUGHT_FIELD_NAME,
+		products,
+	)
+}
+
+// GetRecommendations returns the products recommended to the signed-in customer
+// GET /api/me/recommendations
+func (h *RecommendationHandler) GetRecommendations(c *gin.Context) {
+	userID, exists := auth.GetUserIDFromContext(c)
+	if !exists || userID == 0 {
+		h.HandleError(c, nil, constants.UNAUTHORIZED_ERROR_MSG)
+		return
+	}
+	if !bindContentLocale(c, h.BaseHandler) {
+		return
+	}
+	limit, ok := h.parseLimit(c)
+	if !ok {
+		return
+	}
+
+	var sellerID *uint
+	if id, exists := auth.GetSellerIDFromContext(c); exists {
+		sellerID = &id
+	}
+
+	products, err := h.recommendationService.GetRecommendations(c, userID, sellerID, limit)
+	if err != nil {
+		log.ErrorWithContext(c, "getRecommendations: failed", err)
+		h.HandleError(c, err, utils.FAILED_TO_GET_RECOMMENDATIONS_MSG)
+		return
+	}
+
+	h.SuccessWithData(
+		c,
+		http.StatusOK,
+		utils.RECOMMENDATIONS_RETRIEVED_MSG,
+		utils.RECOMMENDATIONS_FIELD_NAME,
+		products,
+	)
+}
+
+// parseLimit reads the limit query parameter, writing the error response when it is
+// out of range
+func (h *RecommendationHandler) parseLimit(c *gin.Context) (int, bool) {
+	limit, ok := utils.ParseRecommendationLimit(
+		c.DefaultQuery("limit", strconv.Itoa(utils.RECOMMENDATION_DEFAULT_LIMIT)),
+	)
+	if !ok {
+		h.HandleError(c, commonError.ErrInvalidLimit.WithMessagef(
+			"Limit must be between 1 and %d", utils.RECOMMENDATION_MAX_LIMIT,
+		), constants.INVALID_LIMIT_MSG)
+		return 0, false
+	}
+	return limit, true
+}
@@ -0,0 +1,123 @@
+package handler
+
+import (
+	"net/http"
+
+	"ecommerce-be/common/auth"
+	"ecommerce-be/common/constants"
+	"ecommerce-be/common/handler"
+	"ecommerce-be/common/log"
+	"ecommerce-be/product/model"
+	"ecommerce-be/product/service"
+	"ecommerce-be/product/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RelatedCurationHandler handles seller pins and exclusions of related products
+type RelatedCurationHandler struct {
+	*handler.BaseHandler
+	curationService service.RelatedCurationService
+}
+
+// NewRelatedCurationHandler creates a new instance of RelatedCurationHandler
+func NewRelatedCurationHandler(
+	curationService service.RelatedCurationService,
+) *RelatedCurationHandler {
+	return &RelatedCurationHandler{
+		BaseHandler:     handler.NewBaseHandler(),
+		curationService: curationService,
+	}
+}
+
+// GetCuration returns the product's pinned and excluded related products
+// GET /api/product/:productId/related/curation
+func (h *RelatedCurationHandler) GetCuration(c *gin.Context) {
+	productID, err := h.ParseUintParam(c, "productId")
+	if err != nil {
+		h.HandleError(c, err, utils.INVALID_PRODUCT_ID_MSG)
+		return
+	}
+
+	_, sellerID, err := auth.ValidateUserHasSellerRoleOrHigherAndReturnAuthData(c)
+	if err != nil {
+		h.HandleError(c, err, constants.UNAUTHORIZED_ERROR_MSG)
+		return
+	}
+
+	resp, err := h.curationService.GetCuration(c, productID, sellerID)
+	if err != nil {
+		log.ErrorWithContext(c, "getRelatedCuration: failed", err)
+		h.HandleError(c, err, utils.FAILED_TO_GET_RELATED_CURATION_MSG)
+		return
+	}
+
+	h.SuccessWithData(
+		c,
+		http.StatusOK,
+		utils.RELATED_CURATION_RETRIEVED_MSG,
+		utils.RELATED_CURATION_FIELD_NAME,
+		resp,
+	)
+}
+
+// UpdateCuration replaces the product's pinned and excluded related products
+// PUT /api/product/:productId/related/curation
+func (h *RelatedCurationHandler) UpdateCuration(c *gin.Context) {
+	productID, err := h.ParseUintParam(c, "productId")
+	if err != nil {
+		h.HandleError(c, err, utils.INVALID_PRODUCT_ID_MSG)
+		return
+	}
+
+	_, sellerID, err := auth.ValidateUserHasSellerRoleOrHigherAndReturnAuthData(c)
+	if err != nil {
+		h.HandleError(c, err, constants.UNAUTHORIZED_ERROR_MSG)
+		return
+	}
+
+	var req model.UpdateRelatedCurationRequest
+	if err := h.BindJSON(c, &req); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	resp, err := h.curationService.UpdateCuration(c, productID, sellerID, req)
+	if err != nil {
+		log.ErrorWithContext(c, "updateRelatedCuration: failed", err)
+		h.HandleError(c, err, utils.FAILED_TO_UPDATE_RELATED_CURATION_MSG)
+		return
+	}
+
+	h.SuccessWithData(
+		c,
+		http.StatusOK,
+		utils.RELATED_CURATION_UPDATED_MSG,
+		utils.RELATED_CURATION_FIELD_NAME,
+		resp,
+	)
+}
+
+// ClearCuration removes the product's pinned and excluded related products
+// DELETE /api/product/:productId/related/curation
+func (h *RelatedCurationHandler) ClearCuration(c *gin.Context) {
+	productID, err := h.ParseUintParam(c, "productId")
+	if err != nil {
+		h.HandleError(c, err, utils.INVALID_PRODUCT_ID_MSG)
+		return
+	}
+
+	_, sellerID, err := auth.ValidateUserHasSellerRoleOrHigherAndReturnAuthData(c)
+	if err != nil {
+		h.HandleError(c, err, constants.UNAUTHORIZED_ERROR_MSG)
+		return
+	}
+
+	if err := h.curationService.ClearCuration(c, productID, sellerID); err != nil {
+		log.ErrorWithContext(c, "clearRelatedCuration: failed", err)
+		h.HandleError(c, err, utils.FAILED_TO_CLEAR_RELATED_CURATION_MSG)
+		return
+	}
+
+	h.Success(c, http.StatusOK, utils.RELATED_CURATION_CLEARED_MSG, nil)
+}
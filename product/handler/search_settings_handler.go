@@ -0,0 +1,235 @@
+package handler
+
+import (
+	"net/http"
+
+	"ecommerce-be/common/auth"
+	"ecommerce-be/common/constants"
+	"ecommerce-be/common/handler"
+	"ecommerce-be/common/log"
+	"ecommerce-be/product/model"
+	"ecommerce-be/product/service"
+	"ecommerce-be/product/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SearchSettingsHandler handles seller storefront listing and search settings
+type SearchSettingsHandler struct {
+	*handler.BaseHandler
+	searchSettingsService service.SearchSettingsService
+}
+
+// NewSearchSettingsHandler creates a new instance of SearchSettingsHandler
+func NewSearchSettingsHandler(
+	searchSettingsService service.SearchSettingsService,
+) *SearchSettingsHandler {
+	return &SearchSettingsHandler{
+		BaseHandler:           handler.NewBaseHandler(),
+		searchSettingsService: searchSettingsService,
+	}
+}
+
+// GetSearchSettings returns the seller's default sort and relevance weights
+// GET /api/product/search-settings
+func (h *SearchSettingsHandler) GetSearchSettings(c *gin.Context) {
+	_, sellerID, err := auth.ValidateUserHasSellerRoleOrHigherAndReturnAuthData(c)
+	if err != nil {
+		h.HandleError(c, err, constants.UNAUTHORIZED_ERROR_MSG)
+		return
+	}
+
+	resp, err := h.searchSettingsService.GetSettings(c, sellerID)
+	if err != nil {
+		log.ErrorWithContext(c, "getSearchSettings: failed", err)
+		h.HandleError(c, err, utils.FAILED_TO_GET_SEARCH_SETTINGS_MSG)
+		return
+	}
+
+	h.SuccessWithData(
+		c,
+		http.StatusOK,
+		utils.SEARCH_SETTINGS_RETRIEVED_MSG,
+		utils.SEARCH_SETTINGS_FIELD_NAME,
+		resp,
+	)
+}
+
+// UpdateSearchSettings changes the seller's default sort and relevance weights
+// PUT /api/product/search-settings
+func (h *SearchSettingsHandler) UpdateSearchSettings(c *gin.Context) {
+	var req model.UpdateSearchSettingsRequest
+	if err := h.BindJSON(c, &req); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	_, sellerID, err := auth.ValidateUserHasSellerRoleOrHigherAndReturnAuthData(c)
+	if err != nil {
+		h.HandleError(c, err, constants.UNAUTHORIZED_ERROR_MSG)
+		return
+	}
+
+	resp, err := h.searchSettingsService.UpdateSettings(c, sellerID, req)
+	if err != nil {
+		log.ErrorWithContext(c, "updateSearchSettings: failed", err)
+		h.HandleError(c, err, utils.FAILED_TO_UPDATE_SEARCH_SETTINGS_MSG)
+		return
+	}
+
+	h.SuccessWithData(
+		c,
+		http.StatusOK,
+		utils.SEARCH_SETTINGS_UPDATED_MSG,
+		utils.SEARCH_SETTINGS_FIELD_NAME,
+		resp,
+	)
+}
+
+// ListSynonymGroups returns the seller's search synonym groups
+// GET /api/product/search-settings/synonyms
+func (h *SearchSettingsHandler) ListSynonymGroups(c *gin.Context) {
+	_, sellerID, err := auth.ValidateUserHasSellerRoleOrHigherAndReturnAuthData(c)
+	if err != nil {
+		h.HandleError(c, err, constants.UNAUTHORIZED_ERROR_MSG)
+		return
+	}
+
+	groups, err := h.searchSettingsService.ListSynonymGroups(c, sellerID)
+	if err != nil {
+		log.ErrorWithContext(c, "listSynonymGroups: failed", err)
+		h.HandleError(c, err, utils.FAILED_TO_GET_SEARCH_SYNONYMS_MSG)
+		return
+	}
+
+	h.SuccessWithData(c, http.StatusOK, utils.SEARCH_SYNONYMS_RETRIEVED_MSG,
+		utils.SEARCH_SYNONYMS_FIELD_NAME, groups)
+}
+
+// CreateSynonymGroup adds a search synonym group
+// POST /api/product/search-settings/synonyms
+func (h *SearchSettingsHandler) CreateSynonymGroup(c *gin.Context) {
+	var req model.SearchSynonymGroupRequest
+	if err := h.BindJSON(c, &req); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	_, sellerID, err := auth.ValidateUserHasSellerRoleOrHigherAndReturnAuthData(c)
+	if err != nil {
+		h.HandleError(c, err, constants.UNAUTHORIZED_ERROR_MSG)
+		return
+	}
+
+	group, err := h.searchSettingsService.CreateSynonymGroup(c, sellerID, req)
+	if err != nil {
+		log.ErrorWithContext(c, "createSynonymGroup: failed", err)
+		h.HandleError(c, err, utils.FAILED_TO_SAVE_SEARCH_SYNONYMS_MSG)
+		return
+	}
+
+	h.SuccessWithData(c, http.StatusCreated, utils.SEARCH_SYNONYM_GROUP_CREATED_MSG,
+		utils.SEARCH_SYNONYM_GROUP_FIELD_NAME, group)
+}
+
+// UpdateSynonymGroup replaces the terms of a search synonym group
+// PUT /api/product/search-settings/synonyms/:synonymGroupId
+func (h *SearchSettingsHandler) UpdateSynonymGroup(c *gin.Context) {
+	groupID, err := h.ParseUintParam(c, "synonymGroupId")
+	if err != nil {
+		h.HandleError(c, err, "Invalid synonym group ID")
+		return
+	}
+
+	var req model.SearchSynonymGroupRequest
+	if err := h.BindJSON(c, &req); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	_, sellerID, err := auth.ValidateUserHasSellerRoleOrHigherAndReturnAuthData(c)
+	if err != nil {
+		h.HandleError(c, err, constants.UNAUTHORIZED_ERROR_MSG)
+		return
+	}
+
+	group, err := h.searchSettingsService.UpdateSynonymGroup(c, sellerID, groupID, req)
+	if err != nil {
+		log.ErrorWithContext(c, "updateSynonymGroup: failed", err)
+		h.HandleError(c, err, utils.FAILED_TO_SAVE_SEARCH_SYNONYMS_MSG)
+		return
+	}
+
+	h.SuccessWithData(c, http.StatusOK, utils.SEARCH_SYNONYM_GROUP_UPDATED_MSG,
+		utils.SEARCH_SYNONYM_GROUP_FIELD_NAME, group)
+}
+
+// DeleteSynonymGroup removes a search synonym group
+// DELETE /api/product/search-settings/synonyms/:synonymGroupId
+func (h *SearchSettingsHandler) DeleteSynonymGroup(c *gin.Context) {
+	groupID, err := h.ParseUintParam(c, "synonymGroupId")
+	if err != nil {
+		h.HandleError(c, err, "Invalid synonym group ID")
+		return
+	}
+
+	_, sellerID, err := auth.ValidateUserHasSellerRoleOrHigherAndReturnAuthData(c)
+	if err != nil {
+		h.HandleError(c, err, constants.UNAUTHORIZED_ERROR_MSG)
+		return
+	}
+
+	if err := h.searchSettingsService.DeleteSynonymGroup(c, sellerID, groupID); err != nil {
+		log.ErrorWithContext(c, "deleteSynonymGroup: failed", err)
+		h.HandleError(c, err, utils.FAILED_TO_SAVE_SEARCH_SYNONYMS_MSG)
+		return
+	}
+
+	h.Success(c, http.StatusOK, utils.SEARCH_SYNONYM_GROUP_DELETED_MSG, nil)
+}
+
+// GetStopWords returns the words the seller's search ignores
+// GET /api/product/search-settings/stop-words
+func (h *SearchSettingsHandler) GetStopWords(c *gin.Context) {
+	_, sellerID, err := auth.ValidateUserHasSellerRoleOrHigherAndReturnAuthData(c)
+	if err != nil {
+		h.HandleError(c, err, constants.UNAUTHORIZED_ERROR_MSG)
+		return
+	}
+
+	words, err := h.searchSettingsService.GetStopWords(c, sellerID)
+	if err != nil {
+		log.ErrorWithContext(c, "getStopWords: failed", err)
+		h.HandleError(c, err, utils.FAILED_TO_GET_STOP_WORDS_MSG)
+		return
+	}
+
+	h.SuccessWithData(c, http.StatusOK, utils.SEARCH_STOP_WORDS_RETRIEVED_MSG,
+		utils.SEARCH_STOP_WORDS_FIELD_NAME, words)
+}
+
+// UpdateStopWords replaces the words the seller's search ignores
+// PUT /api/product/search-settings/stop-words
+func (h *SearchSettingsHandler) UpdateStopWords(c *gin.Context) {
+	var req model.UpdateStopWordsRequest
+	if err := h.BindJSON(c, &req); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	_, sellerID, err := auth.ValidateUserHasSellerRoleOrHigherAndReturnAuthData(c)
+	if err != nil {
+		h.HandleError(c, err, constants.UNAUTHORIZED_ERROR_MSG)
+		return
+	}
+
+	words, err := h.searchSettingsService.UpdateStopWords(c, sellerID, req)
+	if err != nil {
+		log.ErrorWithContext(c, "updateStopWords: failed", err)
+		h.HandleError(c, err, utils.FAILED_TO_UPDATE_STOP_WORDS_MSG)
+		return
+	}
+
+	h.SuccessWithData(c, http.StatusOK, utils.SEARCH_STOP_WORDS_UPDATED_MSG,
+		utils.SEARCH_STOP_WORDS_FIELD_NAME, words)
+}
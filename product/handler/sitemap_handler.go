@@ -0,0 +1,72 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"ecommerce-be/common/auth"
+	"ecommerce-be/common/constants"
+	commonError "ecommerce-be/common/error"
+	"ecommerce-be/common/handler"
+	productErrors "ecommerce-be/product/error"
+	"ecommerce-be/product/service"
+	"ecommerce-be/product/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SitemapHandler serves the storefront sitemaps of sellers
+type SitemapHandler struct {
+	*handler.BaseHandler
+	sitemapService service.SitemapService
+}
+
+// NewSitemapHandler creates a new instance of SitemapHandler
+func NewSitemapHandler(sitemapService service.SitemapService) *SitemapHandler {
+	return &SitemapHandler{
+		BaseHandler:    handler.NewBaseHandler(),
+		sitemapService: sitemapService,
+	}
+}
+
+// GetSitemap handles GET /sitemap.xml for the seller resolved from the storefront
+// domain or X-Seller-ID. ?page=N returns one page of a sitemap index.
+func (h *SitemapHandler) GetSitemap(c *gin.Context) {
+	sellerID, exists := auth.GetSellerIDFromContext(c)
+	if !exists || sellerID == 0 {
+		h.HandleError(c, commonError.ErrSellerDataMissing, constants.SELLER_DATA_MISSING_MSG)
+		return
+	}
+
+	page := 0
+	if raw := c.Query(utils.SITEMAP_PAGE_PARAM); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil || parsed < 1 {
+			h.HandleError(c, productErrors.ErrSitemapPageNotFound, utils.FAILED_TO_GET_SITEMAP_MSG)
+			return
+		}
+		page = parsed
+	}
+
+	body, err := h.sitemapService.GetSitemap(c, sellerID, requestBaseURL(c), page)
+	if err != nil {
+		h.HandleError(c, err, utils.FAILED_TO_GET_SITEMAP_MSG)
+		return
+	}
+
+	c.Data(http.StatusOK, utils.SITEMAP_CONTENT_TYPE, body)
+}
+
+// requestBaseURL is the origin the request was made to, honouring the scheme set by
+// a TLS-terminating proxy, so sitemap URLs point at the domain that was crawled
+func requestBaseURL(c *gin.Context) string {
+	scheme := "http"
+	if c.Request.TLS != nil {
+		scheme = "https"
+	}
+	if proto := c.GetHeader("X-Forwarded-Proto"); proto != "" {
+		scheme = strings.TrimSpace(strings.Split(proto, ",")[0])
+	}
+	return scheme + "://" + c.Request.Host
+}
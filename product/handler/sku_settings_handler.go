@@ -0,0 +1,118 @@
+package handler
+
+import (
+	"net/http"
+
+	"ecommerce-be/common/auth"
+	"ecommerce-be/common/constants"
+	"ecommerce-be/common/handler"
+	"ecommerce-be/common/log"
+	"ecommerce-be/product/model"
+	"ecommerce-be/product/service"
+	"ecommerce-be/product/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SKUSettingsHandler handles seller SKU generation patterns
+type SKUSettingsHandler struct {
+	*handler.BaseHandler
+	skuGeneratorService service.SKUGeneratorService
+}
+
+// NewSKUSettingsHandler creates a new instance of SKUSettingsHandler
+func NewSKUSettingsHandler(
+	skuGeneratorService service.SKUGeneratorService,
+) *SKUSettingsHandler {
+	return &SKUSettingsHandler{
+		BaseHandler:         handler.NewBaseHandler(),
+		skuGeneratorService: skuGeneratorService,
+	}
+}
+
+// GetSKUSettings returns the seller's SKU patterns and next sequence number
+// GET /api/product/sku-settings
+func (h *SKUSettingsHandler) GetSKUSettings(c *gin.Context) {
+	_, sellerID, err := auth.ValidateUserHasSellerRoleOrHigherAndReturnAuthData(c)
+	if err != nil {
+		h.HandleError(c, err, constants.UNAUTHORIZED_ERROR_MSG)
+		return
+	}
+
+	resp, err := h.skuGeneratorService.GetSettings(c, sellerID)
+	if err != nil {
+		log.ErrorWithContext(c, "getSKUSettings: failed", err)
+		h.HandleError(c, err, utils.FAILED_TO_GET_SKU_SETTINGS_MSG)
+		return
+	}
+
+	h.SuccessWithData(
+		c,
+		http.StatusOK,
+		utils.SKU_SETTINGS_RETRIEVED_MSG,
+		utils.SKU_SETTINGS_FIELD_NAME,
+		resp,
+	)
+}
+
+// UpdateSKUSettings changes the seller's SKU patterns
+// PUT /api/product/sku-settings
+func (h *SKUSettingsHandler) UpdateSKUSettings(c *gin.Context) {
+	var req model.UpdateSKUSettingsRequest
+	if err := h.BindJSON(c, &req); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	_, sellerID, err := auth.ValidateUserHasSellerRoleOrHigherAndReturnAuthData(c)
+	if err != nil {
+		h.HandleError(c, err, constants.UNAUTHORIZED_ERROR_MSG)
+		return
+	}
+
+	resp, err := h.skuGeneratorService.UpdateSettings(c, sellerID, req)
+	if err != nil {
+		log.ErrorWithContext(c, "updateSKUSettings: failed", err)
+		h.HandleError(c, err, utils.FAILED_TO_UPDATE_SKU_SETTINGS_MSG)
+		return
+	}
+
+	h.SuccessWithData(
+		c,
+		http.StatusOK,
+		utils.SKU_SETTINGS_UPDATED_MSG,
+		utils.SKU_SETTINGS_FIELD_NAME,
+		resp,
+	)
+}
+
+// PreviewSKU renders the SKUs the next product and variant would get
+// POST /api/product/sku-settings/preview
+func (h *SKUSettingsHandler) PreviewSKU(c *gin.Context) {
+	var req model.SKUPreviewRequest
+	if err := h.BindJSON(c, &req); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	_, sellerID, err := auth.ValidateUserHasSellerRoleOrHigherAndReturnAuthData(c)
+	if err != nil {
+		h.HandleError(c, err, constants.UNAUTHORIZED_ERROR_MSG)
+		return
+	}
+
+	resp, err := h.skuGeneratorService.Preview(c, sellerID, req)
+	if err != nil {
+		log.ErrorWithContext(c, "previewSKU: failed", err)
+		h.HandleError(c, err, utils.FAILED_TO_PREVIEW_SKU_MSG)
+		return
+	}
+
+	h.SuccessWithData(
+		c,
+		http.StatusOK,
+		utils.SKU_PREVIEW_GENERATED_MSG,
+		utils.SKU_PREVIEW_FIELD_NAME,
+		resp,
+	)
+}
@@ -0,0 +1,172 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+
+	"ecommerce-be/common/auth"
+	"ecommerce-be/common/constants"
+	"ecommerce-be/common/handler"
+	"ecommerce-be/product/model"
+	"ecommerce-be/product/service"
+	"ecommerce-be/product/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SponsoredPlacementHandler handles sponsored search placements and their tracking
+type SponsoredPlacementHandler struct {
+	*handler.BaseHandler
+	sponsoredService service.SponsoredPlacementService
+}
+
+// NewSponsoredPlacementHandler creates a new instance of SponsoredPlacementHandler
+func NewSponsoredPlacementHandler(
+	sponsoredService service.SponsoredPlacementService,
+) *SponsoredPlacementHandler {
+	return &SponsoredPlacementHandler{
+		BaseHandler:      handler.NewBaseHandler(),
+		sponsoredService: sponsoredService,
+	}
+}
+
+// ListPlacements returns the seller's placements with impressions and clicks
+// GET /api/product/sponsored-placement
+func (h *SponsoredPlacementHandler) ListPlacements(c *gin.Context) {
+	_, sellerID, err := auth.ValidateUserHasSellerRoleOrHigherAndReturnAuthData(c)
+	if err != nil {
+		h.HandleError(c, err, constants.UNAUTHORIZED_ERROR_MSG)
+		return
+	}
+
+	placements, err := h.sponsoredService.ListPlacements(c, sellerID)
+	if err != nil {
+		h.HandleError(c, err, utils.FAILED_TO_GET_SPONSORED_PLACEMENTS_MSG)
+		return
+	}
+
+	h.SuccessWithData(c, http.StatusOK, utils.SPONSORED_PLACEMENTS_RETRIEVED_MSG,
+		utils.SPONSORED_PLACEMENTS_FIELD_NAME, placements)
+}
+
+// CreatePlacement creates a sponsored placement for one of the seller's products
+// POST /api/product/sponsored-placement
+func (h *SponsoredPlacementHandler) CreatePlacement(c *gin.Context) {
+	var req model.SponsoredPlacementRequest
+	if err := h.BindJSON(c, &req); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	_, sellerID, err := auth.ValidateUserHasSellerRoleOrHigherAndReturnAuthData(c)
+	if err != nil {
+		h.HandleError(c, err, constants.UNAUTHORIZED_ERROR_MSG)
+		return
+	}
+
+	placement, err := h.sponsoredService.CreatePlacement(c, sellerID, req)
+	if err != nil {
+		h.HandleError(c, err, utils.FAILED_TO_CREATE_SPONSORED_PLACEMENT_MSG)
+		return
+	}
+
+	h.SuccessWithData(c, http.StatusCreated, utils.SPONSORED_PLACEMENT_CREATED_MSG,
+		utils.SPONSORED_PLACEMENT_FIELD_NAME, placement)
+}
+
+// UpdatePlacement replaces a placement's settings
+// PUT /api/product/sponsored-placement/:placementId
+func (h *SponsoredPlacementHandler) UpdatePlacement(c *gin.Context) {
+	placementID, err := h.ParseUintParam(c, "placementId")
+	if err != nil {
+		h.HandleError(c, err, "Invalid placement ID")
+		return
+	}
+
+	var req model.SponsoredPlacementRequest
+	if err := h.BindJSON(c, &req); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	_, sellerID, err := auth.ValidateUserHasSellerRoleOrHigherAndReturnAuthData(c)
+	if err != nil {
+		h.HandleError(c, err, constants.UNAUTHORIZED_ERROR_MSG)
+		return
+	}
+
+	placement, err := h.sponsoredService.UpdatePlacement(c, sellerID, placementID, req)
+	if err != nil {
+		h.HandleError(c, err, utils.FAILED_TO_UPDATE_SPONSORED_PLACEMENT_MSG)
+		return
+	}
+
+	h.SuccessWithData(c, http.StatusOK, utils.SPONSORED_PLACEMENT_UPDATED_MSG,
+		utils.SPONSORED_PLACEMENT_FIELD_NAME, placement)
+}
+
+// DeletePlacement removes a placement
+// DELETE /api/product/sponsored-placement/:placementId
+func (h *SponsoredPlacementHandler) DeletePlacement(c *gin.Context) {
+	placementID, err := h.ParseUintParam(c, "placementId")
+	if err != nil {
+		h.HandleError(c, err, "Invalid placement ID")
+		return
+	}
+
+	_, sellerID, err := auth.ValidateUserHasSellerRoleOrHigherAndReturnAuthData(c)
+	if err != nil {
+		h.HandleError(c, err, constants.UNAUTHORIZED_ERROR_MSG)
+		return
+	}
+
+	if err := h.sponsoredService.DeletePlacement(c, sellerID, placementID); err != nil {
+		h.HandleError(c, err, utils.FAILED_TO_DELETE_SPONSORED_PLACEMENT_MSG)
+		return
+	}
+
+	h.Success(c, http.StatusOK, utils.SPONSORED_PLACEMENT_DELETED_MSG, nil)
+}
+
+// RecordImpression records that a sponsored search result was shown
+// POST /api/product/sponsored-placement/:placementId/impression
+func (h *SponsoredPlacementHandler) RecordImpression(c *gin.Context) {
+	placementID, err := h.ParseUintParam(c, "placementId")
+	if err != nil {
+		h.HandleError(c, err, "Invalid placement ID")
+		return
+	}
+
+	if err := h.sponsoredService.RecordImpression(c, placementID, sponsoredViewer(c)); err != nil {
+		h.HandleError(c, err, utils.FAILED_TO_RECORD_SPONSORED_EVENT_MSG)
+		return
+	}
+
+	h.Success(c, http.StatusOK, utils.SPONSORED_IMPRESSION_RECORDED_MSG, nil)
+}
+
+// RecordClick records a click on a sponsored search result
+// POST /api/product/sponsored-placement/:placementId/click
+func (h *SponsoredPlacementHandler) RecordClick(c *gin.Context) {
+	placementID, err := h.ParseUintParam(c, "placementId")
+	if err != nil {
+		h.HandleError(c, err, "Invalid placement ID")
+		return
+	}
+
+	if err := h.sponsoredService.RecordClick(c, placementID); err != nil {
+		h.HandleError(c, err, utils.FAILED_TO_RECORD_SPONSORED_EVENT_MSG)
+		return
+	}
+
+	h.Success(c, http.StatusOK, utils.SPONSORED_CLICK_RECORDED_MSG, nil)
+}
+
+// sponsoredViewer identifies the shopper for frequency caps: the user when signed in,
+// otherwise the client IP
+func sponsoredViewer(c *gin.Context) string {
+	if userID, exists := auth.GetUserIDFromContext(c); exists {
+		return fmt.Sprintf("user:%d", userID)
+	}
+	return "ip:" + c.ClientIP()
+}
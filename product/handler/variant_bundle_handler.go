@@ -0,0 +1,117 @@
+package handler
+
+import (
+	"net/http"
+
+	"ecommerce-be/common/auth"
+	"ecommerce-be/common/constants"
+	"ecommerce-be/common/handler"
+	"ecommerce-be/common/log"
+	"ecommerce-be/product/model"
+	"ecommerce-be/product/service"
+	"ecommerce-be/product/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// VariantBundleHandler handles bundle (kit) variants
+type VariantBundleHandler struct {
+	*handler.BaseHandler
+	bundleService service.VariantBundleService
+}
+
+// NewVariantBundleHandler creates a new instance of VariantBundleHandler
+func NewVariantBundleHandler(bundleService service.VariantBundleService) *VariantBundleHandler {
+	return &VariantBundleHandler{
+		BaseHandler:   handler.NewBaseHandler(),
+		bundleService: bundleService,
+	}
+}
+
+// GetBundle returns a bundle variant's components and pricing
+// GET /api/product/:productId/variant/:variantId/bundle
+func (h *VariantBundleHandler) GetBundle(c *gin.Context) {
+	productID, variantID, ok := h.parseBundleParams(c)
+	if !ok {
+		return
+	}
+
+	// Extract seller ID from context (set by PublicAPIAuth middleware)
+	sellerID, _ := auth.GetSellerIDFromContext(c)
+
+	resp, err := h.bundleService.GetBundle(c, productID, variantID, sellerID)
+	if err != nil {
+		h.HandleError(c, err, utils.FAILED_TO_GET_BUNDLE_MSG)
+		return
+	}
+
+	h.SuccessWithData(c, http.StatusOK, utils.BUNDLE_RETRIEVED_MSG, utils.BUNDLE_FIELD_NAME, resp)
+}
+
+// SetBundle replaces the components of a variant, making it a bundle
+// PUT /api/product/:productId/variant/:variantId/bundle
+func (h *VariantBundleHandler) SetBundle(c *gin.Context) {
+	productID, variantID, ok := h.parseBundleParams(c)
+	if !ok {
+		return
+	}
+
+	var req model.SetBundleComponentsRequest
+	if err := h.BindJSON(c, &req); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	_, sellerID, err := auth.ValidateUserHasSellerRoleOrHigherAndReturnAuthData(c)
+	if err != nil {
+		h.HandleError(c, err, constants.UNAUTHORIZED_ERROR_MSG)
+		return
+	}
+
+	resp, err := h.bundleService.SetBundle(c, productID, variantID, sellerID, req)
+	if err != nil {
+		log.ErrorWithContext(c, "setBundle: failed", err)
+		h.HandleError(c, err, utils.FAILED_TO_UPDATE_BUNDLE_MSG)
+		return
+	}
+
+	h.SuccessWithData(c, http.StatusOK, utils.BUNDLE_UPDATED_MSG, utils.BUNDLE_FIELD_NAME, resp)
+}
+
+// DeleteBundle turns a bundle back into a regular variant
+// DELETE /api/product/:productId/variant/:variantId/bundle
+func (h *VariantBundleHandler) DeleteBundle(c *gin.Context) {
+	productID, variantID, ok := h.parseBundleParams(c)
+	if !ok {
+		return
+	}
+
+	_, sellerID, err := auth.ValidateUserHasSellerRoleOrHigherAndReturnAuthData(c)
+	if err != nil {
+		h.HandleError(c, err, constants.UNAUTHORIZED_ERROR_MSG)
+		return
+	}
+
+	if err := h.bundleService.DeleteBundle(c, productID, variantID, sellerID); err != nil {
+		log.ErrorWithContext(c, "deleteBundle: failed", err)
+		h.HandleError(c, err, utils.FAILED_TO_DELETE_BUNDLE_MSG)
+		return
+	}
+
+	h.Success(c, http.StatusOK, utils.BUNDLE_DELETED_MSG, nil)
+}
+
+// parseBundleParams reads the product and variant IDs, writing the error response on failure
+func (h *VariantBundleHandler) parseBundleParams(c *gin.Context) (uint, uint, bool) {
+	productID, err := h.ParseUintParam(c, utils.PRODUCT_ID_PARAM)
+	if err != nil {
+		h.HandleError(c, err, "")
+		return 0, 0, false
+	}
+	variantID, err := h.ParseUintParam(c, utils.VARIANT_ID_PARAM)
+	if err != nil {
+		h.HandleError(c, err, "")
+		return 0, 0, false
+	}
+	return productID, variantID, true
+}
@@ -0,0 +1,133 @@
+package handler
+
+import (
+	"net/http"
+
+	"ecommerce-be/common"
+	"ecommerce-be/common/auth"
+	"ecommerce-be/common/constants"
+	"ecommerce-be/common/handler"
+	"ecommerce-be/common/log"
+	"ecommerce-be/product/model"
+	"ecommerce-be/product/service"
+	"ecommerce-be/product/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// VariantChannelPriceHandler handles seller management of per-channel variant prices
+type VariantChannelPriceHandler struct {
+	*handler.BaseHandler
+	channelPriceService service.VariantChannelPriceService
+}
+
+// NewVariantChannelPriceHandler creates a new instance of VariantChannelPriceHandler
+func NewVariantChannelPriceHandler(
+	channelPriceService service.VariantChannelPriceService,
+) *VariantChannelPriceHandler {
+	return &VariantChannelPriceHandler{
+		BaseHandler:         handler.NewBaseHandler(),
+		channelPriceService: channelPriceService,
+	}
+}
+
+// GetChannelPrices lists base prices and channel overrides for a product's variants
+// GET /api/product/:productId/variant/channel-price
+func (h *VariantChannelPriceHandler) GetChannelPrices(c *gin.Context) {
+	productID, err := h.ParseUintParam(c, utils.PRODUCT_ID_PARAM)
+	if err != nil {
+		h.HandleError(c, err, "")
+		return
+	}
+
+	_, sellerID, err := auth.ValidateUserHasSellerRoleOrHigherAndReturnAuthData(c)
+	if err != nil {
+		h.HandleError(c, err, constants.UNAUTHORIZED_ERROR_MSG)
+		return
+	}
+
+	resp, err := h.channelPriceService.GetProductChannelPrices(c, productID, sellerID)
+	if err != nil {
+		log.ErrorWithContext(c, "getChannelPrices: failed", err)
+		h.HandleError(c, err, utils.FAILED_TO_GET_CHANNEL_PRICES_MSG)
+		return
+	}
+
+	h.SuccessWithData(
+		c,
+		http.StatusOK,
+		utils.CHANNEL_PRICES_RETRIEVED_MSG,
+		utils.CHANNEL_PRICES_FIELD_NAME,
+		resp,
+	)
+}
+
+// UpsertChannelPrices creates or replaces channel price overrides in bulk
+// PUT /api/product/:productId/variant/channel-price
+func (h *VariantChannelPriceHandler) UpsertChannelPrices(c *gin.Context) {
+	productID, err := h.ParseUintParam(c, utils.PRODUCT_ID_PARAM)
+	if err != nil {
+		h.HandleError(c, err, "")
+		return
+	}
+
+	var req model.UpsertChannelPricesRequest
+	if err := h.BindJSON(c, &req); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	_, sellerID, err := auth.ValidateUserHasSellerRoleOrHigherAndReturnAuthData(c)
+	if err != nil {
+		h.HandleError(c, err, constants.UNAUTHORIZED_ERROR_MSG)
+		return
+	}
+
+	resp, err := h.channelPriceService.UpsertChannelPrices(c, productID, sellerID, req)
+	if err != nil {
+		log.ErrorWithContext(c, "upsertChannelPrices: failed", err)
+		h.HandleError(c, err, utils.FAILED_TO_UPDATE_CHANNEL_PRICES_MSG)
+		return
+	}
+
+	h.SuccessWithData(
+		c,
+		http.StatusOK,
+		utils.CHANNEL_PRICES_UPDATED_MSG,
+		utils.CHANNEL_PRICES_FIELD_NAME,
+		resp,
+	)
+}
+
+// DeleteChannelPrices removes channel price overrides in bulk
+// DELETE /api/product/:productId/variant/channel-price
+func (h *VariantChannelPriceHandler) DeleteChannelPrices(c *gin.Context) {
+	productID, err := h.ParseUintParam(c, utils.PRODUCT_ID_PARAM)
+	if err != nil {
+		h.HandleError(c, err, "")
+		return
+	}
+
+	var req model.DeleteChannelPricesRequest
+	if err := h.BindJSON(c, &req); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	_, sellerID, err := auth.ValidateUserHasSellerRoleOrHigherAndReturnAuthData(c)
+	if err != nil {
+		h.HandleError(c, err, constants.UNAUTHORIZED_ERROR_MSG)
+		return
+	}
+
+	deleted, err := h.channelPriceService.DeleteChannelPrices(c, productID, sellerID, req)
+	if err != nil {
+		log.ErrorWithContext(c, "deleteChannelPrices: failed", err)
+		h.HandleError(c, err, utils.FAILED_TO_DELETE_CHANNEL_PRICES_MSG)
+		return
+	}
+
+	common.SuccessResponse(c, http.StatusOK, utils.CHANNEL_PRICES_DELETED_MSG, map[string]any{
+		utils.DELETED_COUNT_FIELD_NAME: deleted,
+	})
+}
@@ -8,6 +8,7 @@ import (
 	"ecommerce-be/common/constants"
 	commonError "ecommerce-be/common/error"
 	"ecommerce-be/common/handler"
+	"ecommerce-be/common/validator"
 	"ecommerce-be/product/model"
 	"ecommerce-be/product/service"
 	"ecommerce-be/product/utils"
@@ -41,11 +42,43 @@ func NewVariantHandler(
 	}
 }
 
+/***********************************************
+ *            LookupVariantByBarcode           *
+ ***********************************************/
+// LookupVariantByBarcode finds the seller's variant by its scanned barcode
+// GET /api/product/lookup?barcode=4006381333931&channel=pos
+func (h *VariantHandler) LookupVariantByBarcode(c *gin.Context) {
+	_, sellerID, err := auth.ValidateUserHasSellerRoleOrHigherAndReturnAuthData(c)
+	if err != nil {
+		h.HandleError(c, err, constants.UNAUTHORIZED_ERROR_MSG)
+		return
+	}
+
+	variantResponse, err := h.variantQueryService.LookupVariantByBarcode(
+		c,
+		sellerID,
+		c.Query(utils.BARCODE_QUERY_PARAM),
+		c.Query(utils.SALES_CHANNEL_QUERY_PARAM),
+	)
+	if err != nil {
+		h.HandleError(c, err, utils.FAILED_TO_LOOKUP_BARCODE_MSG)
+		return
+	}
+
+	h.SuccessWithData(
+		c,
+		http.StatusOK,
+		utils.VARIANT_FOUND_BY_BARCODE_MSG,
+		utils.VARIANT_FIELD_NAME,
+		variantResponse,
+	)
+}
+
 /***********************************************
  *                GetVariantByID               *
  ***********************************************/
 // GetVariantByID handles retrieving a specific variant by ID
-// GET /api/product/:productId/variant/:variantId
+// GET /api/product/:productId/variant/:variantId?channel=pos
 func (h *VariantHandler) GetVariantByID(c *gin.Context) {
 	// Parse and validate IDs
 	productID, err := h.ParseUintParam(c, utils.PRODUCT_ID_PARAM)
@@ -76,6 +109,7 @@ func (h *VariantHandler) GetVariantByID(c *gin.Context) {
 		variantID,
 		sellerID,
 		userIDPtr,
+		c.Query(utils.SALES_CHANNEL_QUERY_PARAM),
 	)
 	if err != nil {
 		h.HandleError(c, err, utils.FAILED_TO_RETRIEVE_VARIANT_MSG)
@@ -96,7 +130,7 @@ func (h *VariantHandler) GetVariantByID(c *gin.Context) {
  *            FindVariantByOptions             *
  ***********************************************/
 // FindVariantByOptions handles finding a variant by selected options
-// GET /api/product/:productId/variant/find?color=red&size=m
+// GET /api/product/:productId/variant/find?color=red&size=m&channel=pos
 func (h *VariantHandler) FindVariantByOptions(c *gin.Context) {
 	// Parse and validate product ID
 	productID, err := h.ParseUintParam(c, utils.PRODUCT_ID_PARAM)
@@ -105,9 +139,12 @@ func (h *VariantHandler) FindVariantByOptions(c *gin.Context) {
 		return
 	}
 
-	// Parse query parameters to get selected options
+	// Parse query parameters to get selected options (?channel= is not an option)
 	queryParams := c.Request.URL.Query()
-	optionValues := helper.ParseOptionsFromQuery(queryParams, []string{})
+	optionValues := helper.ParseOptionsFromQuery(
+		queryParams,
+		[]string{utils.SALES_CHANNEL_QUERY_PARAM},
+	)
 
 	// Validate options
 	if len(optionValues) == 0 {
@@ -134,6 +171,7 @@ func (h *VariantHandler) FindVariantByOptions(c *gin.Context) {
 		optionValues,
 		sellerID,
 		userIDPtr,
+		c.Query(utils.SALES_CHANNEL_QUERY_PARAM),
 	)
 	if err != nil {
 		h.HandleError(c, err, utils.FAILED_TO_FIND_VARIANT_MSG)
@@ -328,6 +366,48 @@ func (h *VariantHandler) BulkUpdateVariants(c *gin.Context) {
 	)
 }
 
+/***********************************************
+ *            GenerateVariants                 *
+ ***********************************************/
+// GenerateVariants bulk-creates the missing variants of an option matrix
+// POST /api/product/:productId/variant/generate
+func (h *VariantHandler) GenerateVariants(c *gin.Context) {
+	productID, err := h.ParseUintParam(c, utils.PRODUCT_ID_PARAM)
+	if err != nil {
+		h.HandleError(c, err, "")
+		return
+	}
+
+	var request model.GenerateVariantsRequest
+	if err := h.BindJSON(c, &request); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	_, sellerID, err := auth.ValidateUserHasSellerRoleOrHigherAndReturnAuthData(c)
+	if err != nil {
+		h.HandleError(c, err, constants.UNAUTHORIZED_ERROR_MSG)
+		return
+	}
+
+	response, err := h.variantBulkService.GenerateVariants(c, productID, sellerID, &request)
+	if err != nil {
+		h.HandleError(c, err, utils.FAILED_TO_GENERATE_VARIANTS_MSG)
+		return
+	}
+
+	common.SuccessResponse(
+		c,
+		http.StatusCreated,
+		utils.VARIANTS_GENERATED_MSG,
+		map[string]any{
+			utils.CREATED_COUNT_FIELD_NAME: response.CreatedCount,
+			utils.SKIPPED_COUNT_FIELD_NAME: response.SkippedCount,
+			utils.VARIANTS_FIELD_NAME:      response.Variants,
+		},
+	)
+}
+
 /***********************************************
  *              ListVariants                   *
  ***********************************************/
@@ -342,6 +422,10 @@ func (h *VariantHandler) ListVariants(c *gin.Context) {
 		h.HandleValidationError(c, err)
 		return
 	}
+	if err := validator.RequireLessOrEqual(&request, "minPrice", "maxPrice"); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
 
 	// Extract seller ID from context (set by PublicAPIAuth middleware)
 	var sellerID *uint
@@ -361,6 +445,7 @@ func (h *VariantHandler) ListVariants(c *gin.Context) {
 	defaultExcludes := []string{
 		"ids", "productIds", "productId", "product_id", "sellerId", "seller_id",
 		"allowPurchase", "isDefault", "is_popular", "sku", "pageSize", "page",
+		utils.SALES_CHANNEL_QUERY_PARAM,
 	}
 	optionFilters := helper.ParseOptionsFromQuery(queryParams, defaultExcludes)
 
@@ -408,8 +493,8 @@ func (h *VariantHandler) AttachVariantMedia(c *gin.Context) {
 	}
 
 	var req model.AttachVariantMediaRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		h.HandleError(c, commonError.ErrValidation.WithMessage(err.Error()), "")
+	if err := h.BindJSON(c, &req); err != nil {
+		h.HandleValidationError(c, err)
 		return
 	}
 
@@ -430,7 +515,7 @@ func (h *VariantHandler) AttachVariantMedia(c *gin.Context) {
 }
 
 // UpdateVariantMediaMetadata handles PATCH /api/product/:productId/variant/:variantId/media/:fileId
-// Updates isPrimary and/or displayOrder for an existing variant-media link.
+// Updates isPrimary, displayOrder and/or altText for an existing variant-media link.
 func (h *VariantHandler) UpdateVariantMediaMetadata(c *gin.Context) {
 	productID, err := h.ParseUintParam(c, utils.PRODUCT_ID_PARAM)
 	if err != nil {
@@ -450,13 +535,13 @@ func (h *VariantHandler) UpdateVariantMediaMetadata(c *gin.Context) {
 	}
 
 	var req model.UpdateVariantMediaMetadataRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		h.HandleError(c, commonError.ErrValidation.WithMessage(err.Error()), "")
+	if err := h.BindJSON(c, &req); err != nil {
+		h.HandleValidationError(c, err)
 		return
 	}
-	if req.IsPrimary == nil && req.DisplayOrder == nil {
+	if req.IsPrimary == nil && req.DisplayOrder == nil && req.AltText == nil {
 		h.HandleError(c, commonError.ErrNoFieldsProvided.WithMessage(
-			"at least one of isPrimary or displayOrder must be provided"), "")
+			"at least one of isPrimary, displayOrder or altText must be provided"), "")
 		return
 	}
 
@@ -476,6 +561,42 @@ func (h *VariantHandler) UpdateVariantMediaMetadata(c *gin.Context) {
 		utils.MEDIA_FIELD_NAME, resp)
 }
 
+// ReorderVariantMedia handles PUT /api/product/:productId/variant/:variantId/media/order
+// Sets the display order of all media of a variant from the order of fileIds.
+func (h *VariantHandler) ReorderVariantMedia(c *gin.Context) {
+	productID, err := h.ParseUintParam(c, utils.PRODUCT_ID_PARAM)
+	if err != nil {
+		h.HandleError(c, err, "Invalid product ID")
+		return
+	}
+	variantID, err := h.ParseUintParam(c, utils.VARIANT_ID_PARAM)
+	if err != nil {
+		h.HandleError(c, err, "Invalid variant ID")
+		return
+	}
+
+	var req model.ReorderVariantMediaRequest
+	if err := h.BindJSON(c, &req); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	sellerID, exists := auth.GetSellerIDFromContext(c)
+	if !exists || sellerID == 0 {
+		h.HandleError(c, commonError.ErrSellerDataMissing, "Seller context required")
+		return
+	}
+
+	resp, err := h.variantMediaService.ReorderMedia(c, variantID, productID, sellerID, req)
+	if err != nil {
+		h.HandleError(c, err, utils.FAILED_TO_REORDER_VARIANT_MEDIA_MSG)
+		return
+	}
+
+	h.SuccessWithData(c, http.StatusOK, utils.VARIANT_MEDIA_REORDERED_MSG,
+		utils.MEDIA_FIELD_NAME, resp)
+}
+
 // RemoveVariantMedia handles DELETE /api/product/:productId/variant/:variantId/media/:fileId
 // Removes the variant-media link. Returns 204 No Content.
 func (h *VariantHandler) RemoveVariantMedia(c *gin.Context) {
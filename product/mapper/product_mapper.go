@@ -1,6 +1,10 @@
 package mapper
 
-import "ecommerce-be/common/db"
+import (
+	"time"
+
+	"ecommerce-be/common/db"
+)
 
 type CategoryWithProductCount struct {
 	CategoryID   uint   `json:"category_id"`
@@ -71,3 +75,69 @@ type RelatedProductScored struct {
 	RelationReason     string         `gorm:"column:relation_reason"`
 	StrategyUsed       string         `gorm:"column:strategy_used"`
 }
+
+// OptionTranslationRow is an option display-name translation joined with its option
+type OptionTranslationRow struct {
+	OptionID    uint   `gorm:"column:option_id"`
+	ProductID   uint   `gorm:"column:product_id"`
+	OptionName  string `gorm:"column:option_name"`
+	Locale      string `gorm:"column:locale"`
+	DisplayName string `gorm:"column:display_name"`
+}
+
+// SearchMatchRow reports which searchable fields of a product matched the search term
+type SearchMatchRow struct {
+	ProductID        uint `gorm:"column:product_id"`
+	NameMatch        bool `gorm:"column:name_match"`
+	TagsMatch        bool `gorm:"column:tags_match"`
+	DescriptionMatch bool `gorm:"column:description_match"`
+}
+
+// SitemapEntryRow is a product or category URL listed in a seller's sitemap
+type SitemapEntryRow struct {
+	Slug      string    `gorm:"column:slug"`
+	UpdatedAt time.Time `gorm:"column:updated_at"`
+}
+
+// PendingLicenseRow is a digital purchase still owed license keys
+type PendingLicenseRow struct {
+	EntitlementID uint `gorm:"column:entitlement_id"`
+	Missing       int  `gorm:"column:missing"`
+}
+
+// SuggestionRow is one search-as-you-type suggestion. Kind is product, brand or
+// category; RefID is the product or category ID and zero for brands.
+type SuggestionRow struct {
+	Kind  string  `gorm:"column:kind"`
+	RefID uint    `gorm:"column:ref_id"`
+	Text  string  `gorm:"column:text"`
+	Slug  string  `gorm:"column:slug"`
+	Score float64 `gorm:"column:score"`
+}
+
+// PriceBucketRow is the number of products with a variant priced in a facet bucket
+type PriceBucketRow struct {
+	Bucket       int  `gorm:"column:bucket"`
+	ProductCount uint `gorm:"column:product_count"`
+}
+
+// OptionValueFacetRow is the number of products offering an option value
+type OptionValueFacetRow struct {
+	OptionName        string `gorm:"column:option_name"`
+	OptionDisplayName string `gorm:"column:option_display_name"`
+	OptionValue       string `gorm:"column:option_value"`
+	ValueDisplayName  string `gorm:"column:value_display_name"`
+	ColorCode         string `gorm:"column:color_code"`
+	ProductCount      uint   `gorm:"column:product_count"`
+}
+
+// ProductFacetData holds the facet counts of a listing or search result set.
+// PriceEdges are the bucket edges PriceBuckets are numbered against.
+type ProductFacetData struct {
+	Brands       []BrandWithProductCount
+	Categories   []CategoryWithProductCount
+	PriceRange   PriceRangeData
+	PriceEdges   []float64
+	PriceBuckets []PriceBucketRow
+	OptionValues []OptionValueFacetRow
+}
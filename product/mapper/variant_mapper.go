@@ -60,5 +60,6 @@ type VariantBasicInfoRow struct {
 	CategoryID  uint
 	BaseSKU     string
 	SellerID    uint
+	SKU         string
 	Price       float64
 }
@@ -0,0 +1,15 @@
+// Package messaging contains wire-contract structs for messages published or consumed
+// by the product module. These structs are serialised into the Payload field of the
+// common/messaging.Envelope.
+package messaging
+
+// ProductChanged is the payload published on exchange "ecom.events" with routing key
+// "product.product.changed" when product, variant or option data changes outside the
+// product repositories. The product cache evicts the product's entries on receipt.
+type ProductChanged struct {
+	// ProductID is the product whose cached detail and variant preview are stale.
+	ProductID uint `json:"productId"`
+
+	// SellerID is the owning seller; also carried as the envelope tenant ID.
+	SellerID uint `json:"sellerId"`
+}
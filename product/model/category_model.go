@@ -2,16 +2,29 @@ package model
 
 // CategoryCreateRequest represents the request body for creating a category
 type CategoryCreateRequest struct {
-	Name        string `json:"name"        binding:"required,min=3,max=100"`
+	Name        string `json:"name"        binding:"required,min=3,max=100" sanitize:"nfc,striphtml,collapse"`
 	ParentID    *uint  `json:"parentId"`
-	Description string `json:"description" binding:"max=500"`
+	Description string `json:"description" binding:"max=500" sanitize:"nfc,striphtml,collapse"`
+
+	// SEO fields; the slug is generated from the name when omitted
+	Slug            *string `json:"slug"            binding:"omitempty,slug,max=200"`
+	MetaTitle       *string `json:"metaTitle"       binding:"omitempty,max=255" sanitize:"nfc,striphtml,collapse"`
+	MetaDescription *string `json:"metaDescription" binding:"omitempty,max=500" sanitize:"nfc,striphtml,collapse"`
+	CanonicalURL    *string `json:"canonicalUrl"    binding:"omitempty,http_url,max=2048"`
 }
 
 // CategoryUpdateRequest represents the request body for updating a category
 type CategoryUpdateRequest struct {
-	Name        string `json:"name"        binding:"required,min=3,max=100"`
+	Name        string `json:"name"        binding:"required,min=3,max=100" sanitize:"nfc,striphtml,collapse"`
 	ParentID    *uint  `json:"parentId"`
-	Description string `json:"description" binding:"max=500"`
+	Description string `json:"description" binding:"max=500" sanitize:"nfc,striphtml,collapse"`
+
+	// SEO fields; nil leaves a field unchanged and an empty meta value clears it.
+	// Changing the slug keeps the old one as a redirect.
+	Slug            *string `json:"slug"            binding:"omitempty,slug,max=200"`
+	MetaTitle       *string `json:"metaTitle"       binding:"omitempty,max=255" sanitize:"nfc,striphtml,collapse"`
+	MetaDescription *string `json:"metaDescription" binding:"omitempty,max=500" sanitize:"nfc,striphtml,collapse"`
+	CanonicalURL    *string `json:"canonicalUrl"    binding:"omitempty,http_url|len=0,max=2048"`
 }
 
 // CategoryResponse represents the category data returned in API responses
@@ -26,6 +39,11 @@ type CategoryResponse struct {
 	UpdatedAt   string             `json:"updatedAt"`
 	Children    []CategoryResponse `json:"children"`
 	Parent      *CategoryResponse  `json:"parent"`
+
+	Slug            string  `json:"slug"`
+	MetaTitle       *string `json:"metaTitle,omitempty"`
+	MetaDescription *string `json:"metaDescription,omitempty"`
+	CanonicalURL    *string `json:"canonicalUrl,omitempty"`
 }
 
 // CategoryHierarchyResponse represents the hierarchical category structure
@@ -36,6 +54,8 @@ type CategoryHierarchyResponse struct {
 	Description string                      `json:"description"`
 	IsGlobal    bool                        `json:"isGlobal"`
 	Children    []CategoryHierarchyResponse `json:"children"`
+
+	Slug string `json:"slug"`
 }
 
 // CategoriesResponse represents the response for getting all categories
@@ -86,3 +86,27 @@ type StockStatusFilter struct {
 	OutOfStock    uint `json:"outOfStock"` // Products without stock
 	TotalProducts uint `json:"totalProducts"`
 }
+
+// ProductFacets are the filter counts of the products matching a listing or search,
+// returned with the results so filter sidebars need no extra requests
+type ProductFacets struct {
+	Brands       []BrandFilter      `json:"brands"`
+	Categories   []CategoryFacet    `json:"categories"`
+	PriceBuckets []PriceRangeFilter `json:"priceBuckets"` // Min inclusive, max exclusive
+	Options      []OptionFacet      `json:"options"`
+}
+
+// CategoryFacet is the number of matching products in a category
+type CategoryFacet struct {
+	ID           uint   `json:"id"`
+	Name         string `json:"name"`
+	ParentID     *uint  `json:"parentId,omitempty"`
+	ProductCount uint   `json:"productCount"`
+}
+
+// OptionFacet lists the values of a variant option (e.g. Color) across matching products
+type OptionFacet struct {
+	Name        string                `json:"name"`
+	DisplayName string                `json:"displayName"`
+	Values      []VariantOptionFilter `json:"values"`
+}
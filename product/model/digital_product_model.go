@@ -0,0 +1,83 @@
+package model
+
+// AddDigitalAssetRequest attaches an uploaded file to a digital product
+type AddDigitalAssetRequest struct {
+	FileID    string `json:"fileId"    binding:"required,max=80"`
+	Name      string `json:"name"      binding:"required,max=255" sanitize:"nfc,striphtml,collapse"`
+	SortOrder int    `json:"sortOrder" binding:"gte=0"`
+}
+
+// DigitalAssetResponse is a download file of a digital product
+type DigitalAssetResponse struct {
+	ID        uint   `json:"id"`
+	FileID    string `json:"fileId"`
+	Name      string `json:"name"`
+	SortOrder int    `json:"sortOrder"`
+	CreatedAt string `json:"createdAt"`
+}
+
+// AddLicenseKeysRequest adds keys to a product's pool. Blank keys and keys already in
+// the pool are skipped.
+type AddLicenseKeysRequest struct {
+	Keys []string `json:"keys" binding:"required,min=1,max=1000,dive,max=500"`
+}
+
+// LicenseKeyResponse is one key of a product's pool
+type LicenseKeyResponse struct {
+	ID         uint    `json:"id"`
+	KeyCode    string  `json:"keyCode"`
+	Issued     bool    `json:"issued"`
+	AssignedAt *string `json:"assignedAt,omitempty"`
+}
+
+// LicenseKeyPoolResponse summarizes a product's license key pool. Pending is the
+// number of keys paid orders are still waiting for because the pool ran dry.
+type LicenseKeyPoolResponse struct {
+	Available int                  `json:"available"`
+	Issued    int                  `json:"issued"`
+	Pending   int                  `json:"pending"`
+	Added     *int                 `json:"added,omitempty"`
+	Keys      []LicenseKeyResponse `json:"keys"`
+}
+
+// DigitalOrderLine is one paid order item considered for digital delivery
+type DigitalOrderLine struct {
+	OrderItemID uint
+	ProductID   uint
+	VariantID   uint
+	Quantity    int
+}
+
+// DigitalDeliveryRequest asks for the digital items of a paid order to be delivered.
+// Lines of physical products are ignored.
+type DigitalDeliveryRequest struct {
+	OrderID  uint
+	UserID   uint
+	SellerID uint
+	Lines    []DigitalOrderLine
+}
+
+// DigitalDownloadLink is a signed, time-limited link to one file of a purchase
+type DigitalDownloadLink struct {
+	AssetID   uint   `json:"assetId"`
+	Name      string `json:"name"`
+	URL       string `json:"url"`
+	ExpiresAt string `json:"expiresAt"`
+}
+
+// DigitalPurchaseResponse is what the buyer of a digital order item received: fresh
+// download links, the remaining download allowance and the issued license keys.
+// PendingLicenseKeys counts keys still owed because the seller's pool ran dry.
+type DigitalPurchaseResponse struct {
+	OrderItemID        uint                  `json:"orderItemId"`
+	ProductID          uint                  `json:"productId"`
+	ProductName        string                `json:"productName"`
+	VariantID          uint                  `json:"variantId"`
+	Quantity           int                   `json:"quantity"`
+	DownloadLimit      *int                  `json:"downloadLimit,omitempty"`
+	DownloadCount      int                   `json:"downloadCount"`
+	DownloadsRemaining *int                  `json:"downloadsRemaining,omitempty"`
+	LicenseKeys        []string              `json:"licenseKeys"`
+	PendingLicenseKeys int                   `json:"pendingLicenseKeys"`
+	Downloads          []DigitalDownloadLink `json:"downloads"`
+}
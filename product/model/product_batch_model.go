@@ -0,0 +1,19 @@
+package model
+
+// BatchProductsRequest asks for the full detail of up to 100 products at once
+type BatchProductsRequest struct {
+	ProductIDs []uint `json:"productIds" binding:"required,min=1,max=100,dive,gt=0"`
+}
+
+// BatchProductResult is the outcome for one requested product ID. Product is omitted
+// and Found is false when the product does not exist or is not visible to the caller.
+type BatchProductResult struct {
+	ProductID uint             `json:"productId"`
+	Found     bool             `json:"found"`
+	Product   *ProductResponse `json:"product,omitempty"`
+}
+
+// BatchProductsResponse lists one result per requested ID, in request order
+type BatchProductsResponse struct {
+	Products []BatchProductResult `json:"products"`
+}
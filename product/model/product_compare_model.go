@@ -0,0 +1,27 @@
+package model
+
+// CompareAttributeValue is one product's value for a compared attribute. Present is
+// false when the product does not have the attribute.
+type CompareAttributeValue struct {
+	ProductID uint   `json:"productId"`
+	Value     string `json:"value"`
+	Present   bool   `json:"present"`
+}
+
+// CompareAttributeRow is one attribute across the compared products. Values has an
+// entry for every compared product, in the order of the response's products.
+type CompareAttributeRow struct {
+	Key    string                  `json:"key"`
+	Name   string                  `json:"name"`
+	Unit   string                  `json:"unit,omitempty"`
+	Values []CompareAttributeValue `json:"values"`
+	// Differs is true when the products do not all share the same value
+	Differs bool `json:"differs"`
+}
+
+// CompareProductsResponse lists the compared products in request order with the union
+// of their attributes as a matrix
+type CompareProductsResponse struct {
+	Products   []ProductResponse     `json:"products"`
+	Attributes []CompareAttributeRow `json:"attributes"`
+}
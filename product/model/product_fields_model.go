@@ -0,0 +1,34 @@
+package model
+
+// ProductFieldSet is the sparse fieldset a client asked for with ?fields=, keyed by
+// JSON field name. A nil set selects every field.
+type ProductFieldSet map[string]bool
+
+// Includes reports whether any of the named fields is selected
+func (f ProductFieldSet) Includes(names ...string) bool {
+	if f == nil {
+		return true
+	}
+	for _, name := range names {
+		if f[name] {
+			return true
+		}
+	}
+	return false
+}
+
+// SparseProductsResponse is a product listing limited to the requested fields
+type SparseProductsResponse struct {
+	Products   []map[string]any   `json:"products"`
+	Pagination PaginationResponse `json:"pagination"`
+	Facets     *ProductFacets     `json:"facets,omitempty"`
+}
+
+// SparseSearchResponse is a product search limited to the requested result fields
+type SparseSearchResponse struct {
+	Query      string             `json:"query"`
+	Results    []map[string]any   `json:"results"`
+	Pagination PaginationResponse `json:"pagination"`
+	SearchTime string             `json:"searchTime"`
+	Facets     *ProductFacets     `json:"facets,omitempty"`
+}
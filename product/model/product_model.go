@@ -9,13 +9,13 @@ import (
 // Note: Product requires at least one variant with price and images
 // Frontend handles variant generation from options - backend only saves the final variants
 type ProductCreateRequest struct {
-	Name             string   `json:"name"             binding:"required,min=3,max=200"`
+	Name             string   `json:"name"             binding:"required,min=3,max=200" sanitize:"nfc,striphtml,collapse"`
 	CategoryID       uint     `json:"categoryId"       binding:"required"`
-	Brand            string   `json:"brand"            binding:"max=100"`
-	BaseSKU          string   `json:"baseSku"          binding:"max=50"` // Optional, no validation
-	ShortDescription string   `json:"shortDescription" binding:"max=500"`
-	LongDescription  string   `json:"longDescription"  binding:"max=5000"`
-	Tags             []string `json:"tags"             binding:"max=20"`
+	Brand            string   `json:"brand"            binding:"max=100" sanitize:"nfc,striphtml,collapse"`
+	BaseSKU          string   `json:"baseSku"          binding:"omitempty,sku,max=50"` // Optional
+	ShortDescription string   `json:"shortDescription" binding:"max=500" sanitize:"nfc,striphtml,collapse"`
+	LongDescription  string   `json:"longDescription"  binding:"max=5000" sanitize:"nfc,richtext,trim"`
+	Tags             []string `json:"tags"             binding:"max=20" sanitize:"nfc,striphtml,collapse"`
 	SellerID         *uint    `json:"sellerId"` // Optional: set by backend from auth context this is required in case of admin creates product for a seller
 
 	// Options and Variants
@@ -25,35 +25,57 @@ type ProductCreateRequest struct {
 
 	// Simple product commerce fields (used when variants is empty)
 	Price         float64 `json:"price"         binding:"omitempty,gt=0"`
+	Barcode       string  `json:"barcode"       binding:"omitempty,barcode"`
 	AllowPurchase *bool   `json:"allowPurchase"`
 	IsPopular     *bool   `json:"isPopular"`
 
 	// Product attributes and package options
 	Attributes     []ProductAttributeRequest `json:"attributes"     binding:"dive"`
 	PackageOptions []PackageOptionRequest    `json:"packageOptions" binding:"dive"`
+
+	// SEO fields; the slug is generated from the name when omitted
+	Slug            *string `json:"slug"            binding:"omitempty,slug,max=200"`
+	MetaTitle       *string `json:"metaTitle"       binding:"omitempty,max=255" sanitize:"nfc,striphtml,collapse"`
+	MetaDescription *string `json:"metaDescription" binding:"omitempty,max=500" sanitize:"nfc,striphtml,collapse"`
+	CanonicalURL    *string `json:"canonicalUrl"    binding:"omitempty,http_url,max=2048"`
+
+	// Digital products are delivered as downloads instead of shipped; defaults to physical
+	ProductType   string `json:"productType"   binding:"omitempty,oneof=physical digital"`
+	DownloadLimit *int   `json:"downloadLimit" binding:"omitempty,gt=0"`
 }
 
 // ProductUpdateRequest represents the request body for updating a product
 // Note: Price, images, stock are managed at variant level
 // Uses pointers to distinguish between null (don't update) and empty (clear field)
 type ProductUpdateRequest struct {
-	Name             *string                   `json:"name"             binding:"omitempty,min=3,max=200"`
+	Name             *string                   `json:"name"             binding:"omitempty,min=3,max=200" sanitize:"nfc,striphtml,collapse"`
 	CategoryID       *uint                     `json:"categoryId"       binding:"omitempty"`
-	Brand            *string                   `json:"brand"            binding:"omitempty,max=100"`
-	ShortDescription *string                   `json:"shortDescription" binding:"omitempty,max=500"`
-	LongDescription  *string                   `json:"longDescription"  binding:"omitempty,max=5000"`
-	Tags             *[]string                 `json:"tags"             binding:"omitempty,max=20"`
+	Brand            *string                   `json:"brand"            binding:"omitempty,max=100" sanitize:"nfc,striphtml,collapse"`
+	ShortDescription *string                   `json:"shortDescription" binding:"omitempty,max=500" sanitize:"nfc,striphtml,collapse"`
+	LongDescription  *string                   `json:"longDescription"  binding:"omitempty,max=5000" sanitize:"nfc,richtext,trim"`
+	Tags             *[]string                 `json:"tags"             binding:"omitempty,max=20" sanitize:"nfc,striphtml,collapse"`
 	Attributes       []ProductAttributeRequest `json:"attributes"       binding:"omitempty,dive"`
 	PackageOptions   []PackageOptionRequest    `json:"packageOptions"   binding:"omitempty,dive"`
 	Price            *float64                  `json:"price"            binding:"omitempty,gt=0"`
 	AllowPurchase    *bool                     `json:"allowPurchase"`
 	IsPopular        *bool                     `json:"isPopular"`
+
+	// SEO fields; an empty meta value clears it. Changing the slug keeps the old one
+	// as a redirect.
+	Slug            *string `json:"slug"            binding:"omitempty,slug,max=200"`
+	MetaTitle       *string `json:"metaTitle"       binding:"omitempty,max=255" sanitize:"nfc,striphtml,collapse"`
+	MetaDescription *string `json:"metaDescription" binding:"omitempty,max=500" sanitize:"nfc,striphtml,collapse"`
+	CanonicalURL    *string `json:"canonicalUrl"    binding:"omitempty,http_url|len=0,max=2048"`
+
+	// Digital delivery; a download limit of 0 removes the limit
+	ProductType   *string `json:"productType"   binding:"omitempty,oneof=physical digital"`
+	DownloadLimit *int    `json:"downloadLimit" binding:"omitempty,gte=0"`
 }
 
 // ProductAttributeRequest represents a product attribute in requests
 type ProductAttributeRequest struct {
 	Key       string `json:"key"       binding:"required"`
-	Name      string `json:"name"      binding:"required"`
+	Name      string `json:"name"      binding:"required" sanitize:"nfc,striphtml,collapse"`
 	Value     string `json:"value"     binding:"required"`
 	Unit      string `json:"unit"`
 	SortOrder uint   `json:"sortOrder"`
@@ -71,7 +93,7 @@ type ProductAttributeResponse struct {
 
 // PackageOptionRequest represents a package option in requests
 type PackageOptionRequest struct {
-	Name        string  `json:"name"        binding:"required"`
+	Name        string  `json:"name"        binding:"required" sanitize:"nfc,striphtml,collapse"`
 	Description string  `json:"description"`
 	Price       float64 `json:"price"       binding:"required,gt=0"`
 	Quantity    int     `json:"quantity"    binding:"required,gt=0"`
@@ -102,6 +124,17 @@ type ProductResponse struct {
 	LongDescription  string                `json:"longDescription"`
 	Tags             []string              `json:"tags"`
 	SellerID         uint                  `json:"sellerId"`
+	Locale           string                `json:"locale,omitempty"` // Content locale (public reads)
+
+	// SEO metadata
+	Slug            string  `json:"slug"`
+	MetaTitle       *string `json:"metaTitle,omitempty"`
+	MetaDescription *string `json:"metaDescription,omitempty"`
+	CanonicalURL    *string `json:"canonicalUrl,omitempty"`
+
+	// Digital delivery
+	ProductType   string `json:"productType"`
+	DownloadLimit *int   `json:"downloadLimit,omitempty"`
 
 	// Variant information (from aggregated variants) for a get all products API
 	HasVariants    bool            `json:"hasVariants"`              // Configurable product with option-derived variants
@@ -127,19 +160,33 @@ type ProductResponse struct {
 
 // ProductMediaResponse is the media summary embedded in product detail and listing responses.
 // Ordered by display_order ASC, id ASC. Missing file data is omitted (resilience).
+// MediaType (IMAGE, VIDEO, MODEL_3D) tells storefronts how to render the item; for
+// external videos URL is the external link and External is true.
 type ProductMediaResponse struct {
 	FileID       string  `json:"fileId"`
 	URL          string  `json:"url"`
 	ThumbnailURL *string `json:"thumbnailUrl,omitempty"`
 	IsPrimary    bool    `json:"isPrimary"`
 	DisplayOrder int     `json:"displayOrder"`
+
+	MediaType       string `json:"mediaType"`
+	MimeType        string `json:"mimeType,omitempty"`
+	External        bool   `json:"external"`
+	DurationSeconds *int   `json:"durationSeconds,omitempty"`
 }
 
 // AttachMediaRequest is the request body for POST /api/product/:productId/media.
+// Exactly one of FileID (uploaded asset) and ExternalURL (VIDEO only) is required.
+// ThumbnailFileID is an uploaded poster image for VIDEO and MODEL_3D media.
 type AttachMediaRequest struct {
-	FileID       string `json:"fileId"       binding:"required"`
+	FileID       string `json:"fileId"       binding:"required_without=ExternalURL"`
 	IsPrimary    bool   `json:"isPrimary"`
 	DisplayOrder int    `json:"displayOrder" binding:"min=0"`
+
+	MediaType       string  `json:"mediaType"       binding:"omitempty,oneof=IMAGE VIDEO MODEL_3D"`
+	ExternalURL     *string `json:"externalUrl"     binding:"omitempty,http_url,max=2048"`
+	ThumbnailFileID *string `json:"thumbnailFileId" binding:"omitempty,max=80"`
+	DurationSeconds *int    `json:"durationSeconds" binding:"omitempty,min=1"`
 }
 
 // UpdateMediaMetadataRequest is the request body for PATCH /api/product/:productId/media/:fileId.
@@ -166,6 +213,8 @@ type CategoryHierarchyInfo struct {
 type ProductsResponse struct {
 	Products   []ProductResponse  `json:"products"`
 	Pagination PaginationResponse `json:"pagination"`
+	// Facets is set on storefront listings
+	Facets *ProductFacets `json:"facets,omitempty"`
 }
 
 // ProductStockUpdateRequest represents the request body for updating product purchase availability
@@ -180,6 +229,9 @@ type SearchResult struct {
 	ProductResponse          // Embedded - includes all product fields with variants
 	RelevanceScore  float64  `json:"relevanceScore"`
 	MatchedFields   []string `json:"matchedFields"`
+	// Sponsored marks paid placements; PlacementID is used for impression/click tracking
+	Sponsored   bool  `json:"sponsored"`
+	PlacementID *uint `json:"placementId,omitempty"`
 }
 
 // SearchResponse represents the response for product search
@@ -188,6 +240,29 @@ type SearchResponse struct {
 	Results    []SearchResult     `json:"results"`
 	Pagination PaginationResponse `json:"pagination"`
 	SearchTime string             `json:"searchTime"`
+	Facets     *ProductFacets     `json:"facets,omitempty"`
+}
+
+// SuggestResponse lists search-as-you-type suggestions for a prefix, best matches first
+type SuggestResponse struct {
+	Query      string               `json:"query"`
+	Products   []ProductSuggestion  `json:"products"`
+	Categories []CategorySuggestion `json:"categories"`
+	Brands     []string             `json:"brands"`
+}
+
+// ProductSuggestion is a product whose name matches the prefix
+type ProductSuggestion struct {
+	ID   uint   `json:"id"`
+	Name string `json:"name"`
+	Slug string `json:"slug"`
+}
+
+// CategorySuggestion is a category with products whose name matches the prefix
+type CategorySuggestion struct {
+	ID   uint   `json:"id"`
+	Name string `json:"name"`
+	Slug string `json:"slug"`
 }
 
 // RelatedProductItem represents a related product with relation reason
@@ -223,11 +298,12 @@ type RelatedProductsMeta struct {
 	StrategiesUsed  []string `json:"strategiesUsed"`  // List of strategies that found products
 	AvgScore        float64  `json:"avgScore"`        // Average relevance score
 	TotalStrategies int      `json:"totalStrategies"` // Total strategies attempted
+	CacheStatus     string   `json:"cacheStatus"`     // "hit" or "miss", for debugging
 }
 
 // PackageOptionCreateRequest represents the request body for creating a package option
 type PackageOptionCreateRequest struct {
-	Name        string  `json:"name"        binding:"required"`
+	Name        string  `json:"name"        binding:"required" sanitize:"nfc,striphtml,collapse"`
 	Description string  `json:"description"`
 	Price       float64 `json:"price"       binding:"required,gt=0"`
 	Quantity    int     `json:"quantity"    binding:"required,gt=0"`
@@ -235,7 +311,7 @@ type PackageOptionCreateRequest struct {
 
 // PackageOptionUpdateRequest represents the request body for updating a package option
 type PackageOptionUpdateRequest struct {
-	Name        string  `json:"name"        binding:"required"`
+	Name        string  `json:"name"        binding:"required" sanitize:"nfc,striphtml,collapse"`
 	Description string  `json:"description"`
 	Price       float64 `json:"price"       binding:"required,gt=0"`
 	Quantity    int     `json:"quantity"    binding:"required,gt=0"`
@@ -249,7 +325,7 @@ type PackageOptionsResponse struct {
 // BulkUpdatePackageOptionItem represents one package option in a bulk update request
 type BulkUpdatePackageOptionItem struct {
 	PackageOptionID uint    `json:"packageOptionId" binding:"required"`
-	Name            string  `json:"name"            binding:"required"`
+	Name            string  `json:"name"            binding:"required" sanitize:"nfc,striphtml,collapse"`
 	Description     string  `json:"description"`
 	Price           float64 `json:"price"           binding:"required,gt=0"`
 	Quantity        int     `json:"quantity"        binding:"required,gt=0"`
@@ -300,6 +376,8 @@ type GetProductsParams struct {
 	Brands      *string `form:"brands"`
 	IDs         *string `form:"ids"`
 	VariantIDs  *string `form:"variantIds"`
+	// Options filters on variant option values, e.g. color:black,size:m
+	Options *string `form:"options"`
 }
 
 type GetProductsFilter struct {
@@ -308,6 +386,18 @@ type GetProductsFilter struct {
 	Brands      []string
 	IDs         []uint
 	VariantIDs  []uint
+	// OptionValues keeps products with an in-stock variant matching every option
+	OptionValues []OptionValueFilter
+	// DemoteOutOfStock lists out-of-stock products after the in-stock ones
+	DemoteOutOfStock bool
+	// WithFacets adds the facet counts of the matching products to the response
+	WithFacets bool
+}
+
+// OptionValueFilter matches variants having any of Values for the option Name
+type OptionValueFilter struct {
+	Name   string
+	Values []string
 }
 
 func (p *GetProductsParams) ToGetProductsFilter(
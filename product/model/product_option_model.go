@@ -18,14 +18,14 @@ type ProductOptionUpdateRequest struct {
 type ProductOptionValueRequest struct {
 	Value       string `json:"value"       binding:"required,min=1,max=100"`
 	DisplayName string `json:"displayName" binding:"required,min=1,max=100"`
-	ColorCode   string `json:"colorCode"   binding:"omitempty,len=7"`
+	ColorCode   string `json:"colorCode"   binding:"omitempty,hexcolor7"`
 	Position    int    `json:"position"`
 }
 
 // ProductOptionValueUpdateRequest represents the request body for updating a product option value
 type ProductOptionValueUpdateRequest struct {
 	DisplayName *string `json:"displayName" binding:"omitempty,min=1,max=100"`
-	ColorCode   *string `json:"colorCode"   binding:"omitempty,len=7"`
+	ColorCode   *string `json:"colorCode"   binding:"omitempty,hexcolor7"`
 	Position    *int    `json:"position"    binding:"omitempty"`
 }
 
@@ -79,7 +79,7 @@ type ProductOptionBulkUpdateRequest struct {
 type ProductOptionValueBulkUpdateItem struct {
 	ValueID     uint   `json:"valueId"     binding:"required"`
 	DisplayName string `json:"displayName" binding:"omitempty,min=1,max=100"`
-	ColorCode   string `json:"colorCode"   binding:"omitempty,len=7"`
+	ColorCode   string `json:"colorCode"   binding:"omitempty,hexcolor7"`
 	Position    int    `json:"position"`
 }
 
@@ -88,6 +88,27 @@ type ProductOptionValueBulkUpdateRequest struct {
 	Values []ProductOptionValueBulkUpdateItem `json:"values" binding:"required,min=1,dive"`
 }
 
+// ProductOptionRuleCreateRequest represents the request body for creating an option rule.
+// The option (or only OptionValueIDs of it) is available only when the condition
+// option is set to one of ConditionValueIDs.
+type ProductOptionRuleCreateRequest struct {
+	OptionID          uint   `json:"optionId"          binding:"required"`
+	OptionValueIDs    []uint `json:"optionValueIds"    binding:"omitempty,dive,required"`
+	ConditionOptionID uint   `json:"conditionOptionId" binding:"required"`
+	ConditionValueIDs []uint `json:"conditionValueIds" binding:"required,min=1,dive,required"`
+}
+
+// ProductOptionRuleResponse represents an option rule in responses
+type ProductOptionRuleResponse struct {
+	ID                  uint   `json:"id"`
+	OptionID            uint   `json:"optionId"`
+	OptionName          string `json:"optionName"`
+	OptionValueIDs      []uint `json:"optionValueIds"`
+	ConditionOptionID   uint   `json:"conditionOptionId"`
+	ConditionOptionName string `json:"conditionOptionName"`
+	ConditionValueIDs   []uint `json:"conditionValueIds"`
+}
+
 // BulkUpdateResponse represents the response for bulk updates
 type BulkUpdateResponse struct {
 	UpdatedCount int    `json:"updatedCount"`
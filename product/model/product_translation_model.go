@@ -0,0 +1,40 @@
+package model
+
+// OptionTranslationItem sets one option's display name in the translated locale
+type OptionTranslationItem struct {
+	OptionID    uint   `json:"optionId"    binding:"required,gt=0"`
+	DisplayName string `json:"displayName" binding:"required,max=100"`
+}
+
+// UpsertProductTranslationRequest replaces a product's content for one locale.
+// Omitted fields fall back to the default-locale content on public reads.
+type UpsertProductTranslationRequest struct {
+	Name             string                  `json:"name"             binding:"max=200"`
+	ShortDescription string                  `json:"shortDescription" binding:"max=500"`
+	LongDescription  string                  `json:"longDescription"  binding:"max=5000"`
+	Options          []OptionTranslationItem `json:"options"          binding:"max=50,dive"`
+}
+
+// OptionTranslationResponse is an option display name in one locale
+type OptionTranslationResponse struct {
+	OptionID    uint   `json:"optionId"`
+	OptionName  string `json:"optionName"`
+	DisplayName string `json:"displayName"`
+}
+
+// ProductTranslationResponse is a product's content in one locale
+type ProductTranslationResponse struct {
+	Locale           string                      `json:"locale"`
+	Name             string                      `json:"name"`
+	ShortDescription string                      `json:"shortDescription"`
+	LongDescription  string                      `json:"longDescription"`
+	Options          []OptionTranslationResponse `json:"options"`
+	UpdatedAt        string                      `json:"updatedAt,omitempty"`
+}
+
+// ProductTranslationsResponse lists every translated locale of a product
+type ProductTranslationsResponse struct {
+	ProductID     uint                         `json:"productId"`
+	DefaultLocale string                       `json:"defaultLocale"`
+	Translations  []ProductTranslationResponse `json:"translations"`
+}
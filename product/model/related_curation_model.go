@@ -0,0 +1,22 @@
+package model
+
+// UpdateRelatedCurationRequest replaces a product's manual related products. Pinned
+// products lead the related products in the order given; excluded products never
+// appear. Both lists must hold other products of the same seller.
+type UpdateRelatedCurationRequest struct {
+	PinnedProductIDs   []uint `json:"pinnedProductIds"   binding:"max=20,unique,dive,gt=0"`
+	ExcludedProductIDs []uint `json:"excludedProductIds" binding:"max=100,unique,dive,gt=0"`
+}
+
+// RelatedCurationItem is one pinned or excluded related product
+type RelatedCurationItem struct {
+	ProductID uint   `json:"productId"`
+	Name      string `json:"name"`
+}
+
+// RelatedCurationResponse is a product's manual related products, pins in order
+type RelatedCurationResponse struct {
+	ProductID uint                  `json:"productId"`
+	Pinned    []RelatedCurationItem `json:"pinned"`
+	Excluded  []RelatedCurationItem `json:"excluded"`
+}
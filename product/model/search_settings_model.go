@@ -0,0 +1,60 @@
+package model
+
+// RelevanceWeights sets how much a search match in each field counts toward relevance
+type RelevanceWeights struct {
+	Name        float64 `json:"name"`
+	Tags        float64 `json:"tags"`
+	Description float64 `json:"description"`
+}
+
+// SearchRanking is the resolved ordering the product search query applies
+type SearchRanking struct {
+	SortBy    string // product sort key, or "relevance"
+	SortOrder string
+	Weights   RelevanceWeights
+	// DemoteOutOfStock lists out-of-stock products after the in-stock ones
+	DemoteOutOfStock bool
+}
+
+// UpdateSearchSettingsRequest changes a seller's storefront listing and search settings.
+// Omitted fields keep their current (or default) value.
+type UpdateSearchSettingsRequest struct {
+	DefaultSort       *string  `json:"defaultSort"       binding:"omitempty,oneof=newest price popularity relevance"`
+	DefaultSortOrder  *string  `json:"defaultSortOrder"  binding:"omitempty,oneof=asc desc"`
+	NameWeight        *float64 `json:"nameWeight"        binding:"omitempty,gte=0,lte=10"`
+	TagsWeight        *float64 `json:"tagsWeight"        binding:"omitempty,gte=0,lte=10"`
+	DescriptionWeight *float64 `json:"descriptionWeight" binding:"omitempty,gte=0,lte=10"`
+	OutOfStockDisplay *string  `json:"outOfStockDisplay" binding:"omitempty,oneof=show hide demote"`
+}
+
+// SearchSettingsResponse is a seller's effective listing and search settings
+type SearchSettingsResponse struct {
+	SellerID          uint             `json:"sellerId"`
+	DefaultSort       string           `json:"defaultSort"`
+	DefaultSortOrder  string           `json:"defaultSortOrder"`
+	RelevanceWeights  RelevanceWeights `json:"relevanceWeights"`
+	StopWords         []string         `json:"stopWords"`
+	OutOfStockDisplay string           `json:"outOfStockDisplay"` // show, hide or demote
+	IsDefault         bool             `json:"isDefault"`         // true until the seller saves settings
+	UpdatedAt         string           `json:"updatedAt,omitempty"`
+}
+
+// SearchSynonymGroupRequest creates or replaces a synonym group. Terms are compared
+// case-insensitively and may be phrases such as "cell phone".
+type SearchSynonymGroupRequest struct {
+	Terms []string `json:"terms" binding:"required,min=2,max=20,dive,required,max=50"`
+}
+
+// SearchSynonymGroupResponse is a synonym group with its normalized terms
+type SearchSynonymGroupResponse struct {
+	ID        uint     `json:"id"`
+	Terms     []string `json:"terms"`
+	CreatedAt string   `json:"createdAt"`
+	UpdatedAt string   `json:"updatedAt"`
+}
+
+// UpdateStopWordsRequest replaces the seller's stop words. Entries with several words
+// add each of them.
+type UpdateStopWordsRequest struct {
+	StopWords []string `json:"stopWords" binding:"max=200,dive,required,max=30"`
+}
@@ -0,0 +1,41 @@
+package model
+
+import "encoding/xml"
+
+// SellerSitemap is the cached list of a seller's published URLs. Paths are relative
+// so one cached copy serves every domain the seller's storefront is reached on.
+type SellerSitemap struct {
+	Entries []SitemapEntry `json:"entries"`
+}
+
+// SitemapEntry is one storefront URL with its last modification date (YYYY-MM-DD)
+type SitemapEntry struct {
+	Path    string `json:"path"`
+	LastMod string `json:"lastMod"`
+}
+
+// SitemapURLSet is a sitemap file listing storefront URLs
+type SitemapURLSet struct {
+	XMLName xml.Name     `xml:"urlset"`
+	Xmlns   string       `xml:"xmlns,attr"`
+	URLs    []SitemapURL `xml:"url"`
+}
+
+// SitemapURL is a <url> element of a sitemap file
+type SitemapURL struct {
+	Loc     string `xml:"loc"`
+	LastMod string `xml:"lastmod,omitempty"`
+}
+
+// SitemapIndex lists the pages of a sitemap that exceeds one file
+type SitemapIndex struct {
+	XMLName  xml.Name          `xml:"sitemapindex"`
+	Xmlns    string            `xml:"xmlns,attr"`
+	Sitemaps []SitemapIndexRef `xml:"sitemap"`
+}
+
+// SitemapIndexRef is a <sitemap> element of a sitemap index
+type SitemapIndexRef struct {
+	Loc     string `xml:"loc"`
+	LastMod string `xml:"lastmod,omitempty"`
+}
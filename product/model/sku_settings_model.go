@@ -0,0 +1,38 @@
+package model
+
+// UpdateSKUSettingsRequest changes a seller's SKU generation patterns. Omitted fields
+// keep their current (or default) value.
+type UpdateSKUSettingsRequest struct {
+	ProductPattern *string `json:"productPattern" binding:"omitempty,min=1,max=100"`
+	VariantPattern *string `json:"variantPattern" binding:"omitempty,min=1,max=100"`
+}
+
+// SKUSettingsResponse is a seller's effective SKU generation settings
+type SKUSettingsResponse struct {
+	SellerID       uint   `json:"sellerId"`
+	ProductPattern string `json:"productPattern"`
+	VariantPattern string `json:"variantPattern"`
+	NextSequence   int64  `json:"nextSequence"`
+	IsDefault      bool   `json:"isDefault"` // true until the seller saves settings
+	UpdatedAt      string `json:"updatedAt,omitempty"`
+}
+
+// SKUPreviewRequest renders sample SKUs without reserving a sequence number. Omitted
+// patterns fall back to the seller's saved ones.
+type SKUPreviewRequest struct {
+	ProductPattern *string  `json:"productPattern" binding:"omitempty,min=1,max=100"`
+	VariantPattern *string  `json:"variantPattern" binding:"omitempty,min=1,max=100"`
+	Name           string   `json:"name"           binding:"max=200"`
+	Brand          string   `json:"brand"          binding:"max=100"`
+	CategoryID     uint     `json:"categoryId"`
+	Options        []string `json:"options"        binding:"max=10,dive,max=100"`
+}
+
+// SKUPreviewResponse is what the next generated SKUs would look like
+type SKUPreviewResponse struct {
+	ProductPattern string `json:"productPattern"`
+	VariantPattern string `json:"variantPattern"`
+	ProductSKU     string `json:"productSku"`
+	VariantSKU     string `json:"variantSku"`
+	Sequence       int64  `json:"sequence"`
+}
@@ -0,0 +1,32 @@
+package model
+
+import "time"
+
+// SponsoredPlacementRequest creates or replaces a sponsored placement.
+// At least one of Keywords and CategoryID is required.
+type SponsoredPlacementRequest struct {
+	ProductID    uint       `json:"productId"    binding:"required"`
+	Position     int        `json:"position"     binding:"required,min=1,max=50"`
+	Keywords     []string   `json:"keywords"     binding:"omitempty,max=20,dive,required,max=100"`
+	CategoryID   *uint      `json:"categoryId"   binding:"omitempty,min=1"`
+	FrequencyCap int        `json:"frequencyCap" binding:"omitempty,min=0,max=1000"`
+	IsActive     *bool      `json:"isActive"`
+	StartsAt     *time.Time `json:"startsAt"`
+	EndsAt       *time.Time `json:"endsAt"`
+}
+
+// SponsoredPlacementResponse is a placement with its delivery statistics
+type SponsoredPlacementResponse struct {
+	ID              uint       `json:"id"`
+	ProductID       uint       `json:"productId"`
+	Position        int        `json:"position"`
+	Keywords        []string   `json:"keywords"`
+	CategoryID      *uint      `json:"categoryId,omitempty"`
+	FrequencyCap    int        `json:"frequencyCap"`
+	IsActive        bool       `json:"isActive"`
+	StartsAt        *time.Time `json:"startsAt,omitempty"`
+	EndsAt          *time.Time `json:"endsAt,omitempty"`
+	Impressions     int64      `json:"impressions"`
+	Clicks          int64      `json:"clicks"`
+	ClickThroughPct float64    `json:"clickThroughPct"`
+}
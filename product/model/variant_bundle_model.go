@@ -0,0 +1,35 @@
+package model
+
+// BundleComponentItem puts Quantity units of a variant into every unit of the bundle
+type BundleComponentItem struct {
+	VariantID uint `json:"variantId" binding:"required,gt=0"`
+	Quantity  int  `json:"quantity"  binding:"required,gt=0,max=1000"`
+}
+
+// SetBundleComponentsRequest replaces the component list of a bundle variant
+type SetBundleComponentsRequest struct {
+	Components []BundleComponentItem `json:"components" binding:"required,min=1,max=50,dive"`
+}
+
+// BundleComponentResponse is one component of a bundle, priced at its base price
+type BundleComponentResponse struct {
+	VariantID   uint    `json:"variantId"`
+	ProductID   uint    `json:"productId"`
+	ProductName string  `json:"productName"`
+	SKU         string  `json:"sku"`
+	Quantity    int     `json:"quantity"`
+	UnitPrice   float64 `json:"unitPrice"`
+	LineTotal   float64 `json:"lineTotal"`
+}
+
+// VariantBundleResponse describes a bundle variant. Price is what the bundle sells for;
+// ComponentsPrice is what its components cost bought separately and Savings the
+// difference (zero when the bundle is not cheaper).
+type VariantBundleResponse struct {
+	VariantID       uint                      `json:"variantId"`
+	SKU             string                    `json:"sku"`
+	Price           float64                   `json:"price"`
+	ComponentsPrice float64                   `json:"componentsPrice"`
+	Savings         float64                   `json:"savings"`
+	Components      []BundleComponentResponse `json:"components"`
+}
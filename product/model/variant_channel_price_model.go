@@ -0,0 +1,39 @@
+package model
+
+// ChannelPriceItem sets one variant's price for one sales channel
+type ChannelPriceItem struct {
+	VariantID uint    `json:"variantId" binding:"required,gt=0"`
+	Channel   string  `json:"channel"   binding:"required"`
+	Price     float64 `json:"price"     binding:"required,gt=0"`
+}
+
+// UpsertChannelPricesRequest creates or replaces channel price overrides in bulk
+type UpsertChannelPricesRequest struct {
+	Prices []ChannelPriceItem `json:"prices" binding:"required,min=1,max=500,dive"`
+}
+
+// ChannelPriceKey identifies one override to remove
+type ChannelPriceKey struct {
+	VariantID uint   `json:"variantId" binding:"required,gt=0"`
+	Channel   string `json:"channel"   binding:"required"`
+}
+
+// DeleteChannelPricesRequest removes channel price overrides in bulk,
+// reverting those variants to their base price on the channel
+type DeleteChannelPricesRequest struct {
+	Prices []ChannelPriceKey `json:"prices" binding:"required,min=1,max=500,dive"`
+}
+
+// ChannelPriceResponse is a single channel override
+type ChannelPriceResponse struct {
+	Channel string  `json:"channel"`
+	Price   float64 `json:"price"`
+}
+
+// VariantChannelPricesResponse lists a variant's base price and its channel overrides
+type VariantChannelPricesResponse struct {
+	VariantID     uint                   `json:"variantId"`
+	SKU           string                 `json:"sku"`
+	BasePrice     float64                `json:"basePrice"`
+	ChannelPrices []ChannelPriceResponse `json:"channelPrices"`
+}
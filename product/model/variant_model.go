@@ -25,30 +25,59 @@ type VariantDetailResponse struct {
 	ProductID       uint                    `json:"productId,omitempty"`
 	Product         ProductBasicInfo        `json:"product,omitzero"`
 	SKU             string                  `json:"sku"`
+	Barcode         *string                 `json:"barcode,omitempty"`
 	Price           float64                 `json:"price"`
 	AllowPurchase   bool                    `json:"allowPurchase"`
 	IsPopular       bool                    `json:"isPopular"`
 	IsDefault       bool                    `json:"isDefault"`
 	IsWishlisted    bool                    `json:"isWishlisted"`
 	SelectedOptions []VariantOptionResponse `json:"selectedOptions"`
+	// Version is sent back as expectedVersion to update the variant safely
+	Version int64 `json:"version"`
+	// Channel and BasePrice are set when Price was resolved for a sales channel
+	Channel   string   `json:"channel,omitempty"`
+	BasePrice *float64 `json:"basePrice,omitempty"`
 	// Media is always a JSON array (never null). Items ordered by display_order ASC, id ASC.
 	// Items whose file data cannot be resolved are silently omitted.
 	Media     []VariantMediaResponse `json:"media"`
 	CreatedAt string                 `json:"createdAt,omitempty"`
 	UpdatedAt string                 `json:"updatedAt,omitempty"`
+
+	// Images is the legacy plain URL array derived from Media, primary image first.
+	// Deprecated: use Media.
+	Images []string `json:"images"`
+}
+
+// SetMedia sets the variant's media and the legacy Images array derived from it
+func (r *VariantDetailResponse) SetMedia(media []VariantMediaResponse) {
+	r.Media = media
+	r.Images = VariantMediaImageURLs(media)
 }
 
 // VariantResponse represents simplified variant information
 type VariantResponse struct {
 	ID              uint                    `json:"id"`
 	SKU             string                  `json:"sku"`
+	Barcode         *string                 `json:"barcode,omitempty"`
 	Price           float64                 `json:"price"`
 	AllowPurchase   bool                    `json:"allowPurchase"`
 	IsPopular       bool                    `json:"isPopular"`
 	IsDefault       bool                    `json:"isDefault"`
 	IsWishlisted    bool                    `json:"isWishlisted"`
 	SelectedOptions []VariantOptionResponse `json:"selectedOptions"`
+	Channel         string                  `json:"channel,omitempty"`
+	BasePrice       *float64                `json:"basePrice,omitempty"`
 	Media           []VariantMediaResponse  `json:"media"`
+
+	// Images is the legacy plain URL array derived from Media, primary image first.
+	// Deprecated: use Media.
+	Images []string `json:"images"`
+}
+
+// SetMedia sets the variant's media and the legacy Images array derived from it
+func (r *VariantResponse) SetMedia(media []VariantMediaResponse) {
+	r.Media = media
+	r.Images = VariantMediaImageURLs(media)
 }
 
 // FindVariantByOptionsRequest represents the request to find a variant by options
@@ -85,6 +114,8 @@ type ProductOptionDetailResponse struct {
 type GetAvailableOptionsResponse struct {
 	ProductID uint                          `json:"productId"`
 	Options   []ProductOptionDetailResponse `json:"options"`
+	// Rules list option dependencies so the storefront can hide impossible combinations
+	Rules []ProductOptionRuleResponse `json:"rules"`
 }
 
 // ─── Variant media ────────────────────────────────────────────────────────────
@@ -96,16 +127,35 @@ type VariantMediaResponse struct {
 	FileID       string  `json:"fileId"`
 	URL          string  `json:"url"`
 	ThumbnailURL *string `json:"thumbnailUrl,omitempty"`
+	AltText      *string `json:"altText"`
 	IsPrimary    bool    `json:"isPrimary"`
 	DisplayOrder int     `json:"displayOrder"`
 }
 
+// VariantMediaImageURLs flattens media into the legacy images array: the primary
+// image first, then the rest in display order. Always non-nil.
+func VariantMediaImageURLs(media []VariantMediaResponse) []string {
+	urls := make([]string, 0, len(media))
+	for _, m := range media {
+		if m.IsPrimary && m.URL != "" {
+			urls = append(urls, m.URL)
+		}
+	}
+	for _, m := range media {
+		if !m.IsPrimary && m.URL != "" {
+			urls = append(urls, m.URL)
+		}
+	}
+	return urls
+}
+
 // AttachVariantMediaRequest is the request body for
 // POST /api/product/:productId/variant/:variantId/media
 type AttachVariantMediaRequest struct {
-	FileID       string `json:"fileId"       binding:"required"`
-	IsPrimary    bool   `json:"isPrimary"`
-	DisplayOrder int    `json:"displayOrder" binding:"min=0"`
+	FileID       string  `json:"fileId"       binding:"required"`
+	IsPrimary    bool    `json:"isPrimary"`
+	DisplayOrder int     `json:"displayOrder" binding:"min=0"`
+	AltText      *string `json:"altText"      binding:"omitempty,max=255"`
 }
 
 // UpdateVariantMediaMetadataRequest is the request body for
@@ -113,6 +163,15 @@ type AttachVariantMediaRequest struct {
 type UpdateVariantMediaMetadataRequest struct {
 	IsPrimary    *bool `json:"isPrimary"`
 	DisplayOrder *int  `json:"displayOrder" binding:"omitempty,min=0"`
+	// AltText replaces the alt text; an empty string clears it
+	AltText *string `json:"altText" binding:"omitempty,max=255"`
+}
+
+// ReorderVariantMediaRequest is the request body for
+// PUT /api/product/:productId/variant/:variantId/media/order.
+// FileIDs must list every file attached to the variant exactly once, in the new order.
+type ReorderVariantMediaRequest struct {
+	FileIDs []string `json:"fileIds" binding:"required,min=1,unique,dive,required"`
 }
 
 // ─── Variant option inputs ────────────────────────────────────────────────────
@@ -127,7 +186,8 @@ type VariantOptionInput struct {
 // Images are no longer accepted here — attach them after creation via
 // POST /api/product/:productId/variant/:variantId/media.
 type CreateVariantRequest struct {
-	SKU           string               `json:"sku"`
+	SKU           string               `json:"sku"           binding:"omitempty,sku,max=255"`
+	Barcode       string               `json:"barcode"       binding:"omitempty,barcode"`
 	Price         float64              `json:"price"         binding:"required,gt=0"`
 	AllowPurchase *bool                `json:"allowPurchase"`
 	IsPopular     *bool                `json:"isPopular"`
@@ -136,24 +196,32 @@ type CreateVariantRequest struct {
 }
 
 // UpdateVariantRequest represents the request to update an existing variant.
-// Images are managed separately via the variant media endpoints.
+// Images are managed separately via the variant media endpoints. An empty barcode
+// removes the variant's barcode.
 type UpdateVariantRequest struct {
-	SKU           *string  `json:"sku"`
+	SKU           *string  `json:"sku"           binding:"omitempty,sku,max=255"`
+	Barcode       *string  `json:"barcode"       binding:"omitempty,barcode"`
 	Price         *float64 `json:"price"         binding:"omitempty,gt=0"`
 	AllowPurchase *bool    `json:"allowPurchase"`
 	IsPopular     *bool    `json:"isPopular"`
 	IsDefault     *bool    `json:"isDefault"`
+	// ExpectedVersion rejects the update with 409 unless the variant is still at it
+	ExpectedVersion *int64 `json:"expectedVersion" binding:"omitempty,min=1"`
 }
 
 // BulkUpdateVariantItem represents a single variant update in bulk operation.
 // Images are managed separately via the variant media endpoints.
 type BulkUpdateVariantItem struct {
 	ID            uint     `json:"id"                      binding:"required"`
-	SKU           *string  `json:"sku,omitempty"`
+	SKU           *string  `json:"sku,omitempty"           binding:"omitempty,sku,max=255"`
+	Barcode       *string  `json:"barcode,omitempty"       binding:"omitempty,barcode"`
 	Price         *float64 `json:"price,omitempty"         binding:"omitempty,gt=0"`
 	AllowPurchase *bool    `json:"allowPurchase,omitempty"`
 	IsPopular     *bool    `json:"isPopular,omitempty"`
 	IsDefault     *bool    `json:"isDefault,omitempty"`
+	// ExpectedVersion rejects the whole bulk update with 409 unless the variant is still
+	// at it. Without it the update applies on top of the version read by the request.
+	ExpectedVersion *int64 `json:"expectedVersion,omitempty" binding:"omitempty,min=1"`
 }
 
 // BulkUpdateVariantsRequest represents the request to bulk update variants
@@ -165,8 +233,10 @@ type BulkUpdateVariantsRequest struct {
 type BulkUpdateVariantSummary struct {
 	ID            uint    `json:"id"`
 	SKU           string  `json:"sku"`
+	Barcode       *string `json:"barcode,omitempty"`
 	Price         float64 `json:"price"`
 	AllowPurchase bool    `json:"allowPurchase"`
+	Version       int64   `json:"version"`
 }
 
 // BulkUpdateVariantsResponse represents the response for bulk update
@@ -175,6 +245,30 @@ type BulkUpdateVariantsResponse struct {
 	Variants     []BulkUpdateVariantSummary `json:"variants"`
 }
 
+// GenerateVariantsRequest creates the variants of an option matrix that do not exist yet.
+// Set All to cover every combination the option rules allow, or list Combinations.
+// SKUPattern placeholders name options, e.g. "TEE-{color}-{size}", or {baseSku}.
+type GenerateVariantsRequest struct {
+	All           bool                         `json:"all"`
+	Combinations  []GenerateVariantCombination `json:"combinations"  binding:"omitempty,dive"`
+	Price         float64                      `json:"price"         binding:"required,gt=0"`
+	SKUPattern    string                       `json:"skuPattern"    binding:"required,max=255"`
+	AllowPurchase *bool                        `json:"allowPurchase"`
+}
+
+// GenerateVariantCombination is one option combination to generate
+type GenerateVariantCombination struct {
+	Options []VariantOptionInput `json:"options" binding:"required,min=1,dive"`
+}
+
+// GenerateVariantsResponse lists the variants created. Combinations that already had a
+// variant are counted as skipped.
+type GenerateVariantsResponse struct {
+	CreatedCount int                     `json:"createdCount"`
+	SkippedCount int                     `json:"skippedCount"`
+	Variants     []VariantDetailResponse `json:"variants"`
+}
+
 // ListVariantsRequest represents the request to list/filter variants
 type ListVariantsRequest struct {
 	// Filter by variant IDs (for home page recommendations)
@@ -200,6 +294,10 @@ type ListVariantsRequest struct {
 	// SKU search (partial match)
 	SKU string `form:"sku" binding:"omitempty,max=100"`
 
+	// Sales channel (web, pos, marketplace) whose price overrides replace the base price.
+	// minPrice/maxPrice and price sorting still apply to the base price.
+	Channel string `form:"channel"`
+
 	// TODO: Stock filters - integrate with inventory service when ready
 	// MinStock     *int  `form:"minStock" binding:"omitempty,gte=0"`
 	// MaxStock     *int  `form:"maxStock" binding:"omitempty,gte=0"`
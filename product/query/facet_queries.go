@@ -0,0 +1,68 @@
+package query
+
+// Facet queries for listing and search responses. Each takes the matching products as
+// a subquery of product IDs, so facet counts cover exactly the products matching the
+// request, its filters included.
+const (
+	// FACET_BRANDS_QUERY counts products per brand. Args: product IDs, limit.
+	FACET_BRANDS_QUERY = `
+		SELECT p.brand AS brand, COUNT(*) AS product_count
+		FROM product p
+		WHERE p.id IN (?) AND p.brand <> ''
+		GROUP BY p.brand
+		ORDER BY product_count DESC, p.brand
+		LIMIT ?`
+
+	// FACET_CATEGORIES_QUERY counts products per category. Args: product IDs, limit.
+	FACET_CATEGORIES_QUERY = `
+		SELECT
+			c.id AS category_id,
+			c.name AS category_name,
+			c.parent_id AS parent_id,
+			COUNT(*) AS product_count
+		FROM product p
+		INNER JOIN category c ON c.id = p.category_id
+		WHERE p.id IN (?)
+		GROUP BY c.id, c.name, c.parent_id
+		ORDER BY product_count DESC, c.name
+		LIMIT ?`
+
+	// FACET_PRICE_RANGE_QUERY returns the variant price range. Args: product IDs.
+	FACET_PRICE_RANGE_QUERY = `
+		SELECT
+			COALESCE(MIN(pv.price), 0) AS min_price,
+			COALESCE(MAX(pv.price), 0) AS max_price,
+			COUNT(DISTINCT pv.product_id) AS product_count
+		FROM product_variant pv
+		WHERE pv.product_id IN (?)`
+
+	// FACET_PRICE_BUCKETS_QUERY counts products with a variant priced in each bucket,
+	// matching the minPrice/maxPrice filters. Buckets are numbered from 1 by
+	// width_bucket. Args: bucket edges, product IDs.
+	FACET_PRICE_BUCKETS_QUERY = `
+		SELECT
+			width_bucket(pv.price, ?::float8[]) AS bucket,
+			COUNT(DISTINCT pv.product_id) AS product_count
+		FROM product_variant pv
+		WHERE pv.product_id IN (?)
+		GROUP BY bucket
+		ORDER BY bucket`
+
+	// FACET_OPTION_VALUES_QUERY counts products per option value that a variant uses.
+	// Options are per product, so values are grouped by case-insensitive option name
+	// and value. Args: product IDs.
+	FACET_OPTION_VALUES_QUERY = `
+		SELECT
+			lower(po.name) AS option_name,
+			MIN(COALESCE(NULLIF(po.display_name, ''), po.name)) AS option_display_name,
+			MIN(pov.value) AS option_value,
+			MIN(COALESCE(NULLIF(pov.display_name, ''), pov.value)) AS value_display_name,
+			COALESCE(MIN(pov.color_code), '') AS color_code,
+			COUNT(DISTINCT po.product_id) AS product_count
+		FROM product_option po
+		INNER JOIN product_option_value pov ON pov.option_id = po.id
+		INNER JOIN variant_option_value vov ON vov.option_value_id = pov.id
+		WHERE po.product_id IN (?)
+		GROUP BY lower(po.name), lower(pov.value)
+		ORDER BY option_name, product_count DESC, option_value`
+)
@@ -49,4 +49,27 @@ const (
 		WHERE pv.product_id = product.id 
 		AND pv.id IN ?
 	)`
+
+	// FILTER_OPTION_VALUES_VARIANT_SUBQUERY selects the product's purchasable variants with
+	// available inventory. The options filter appends FILTER_OPTION_VALUE_CONDITION once
+	// per option and wraps it in EXISTS, so one variant must match every option.
+	FILTER_OPTION_VALUES_VARIANT_SUBQUERY = `SELECT 1 FROM product_variant pv
+		WHERE pv.product_id = product.id
+		AND pv.allow_purchase = true
+		AND EXISTS (
+			SELECT 1 FROM inventory inv
+			WHERE inv.variant_id = pv.id
+			AND (inv.quantity - inv.reserved_quantity - inv.threshold) > 0
+		)`
+
+	// FILTER_OPTION_VALUE_CONDITION keeps variants having one of the values for an option.
+	// Args: lowercased option name, lowercased values.
+	FILTER_OPTION_VALUE_CONDITION = `EXISTS (
+		SELECT 1 FROM variant_option_value vov
+		INNER JOIN product_option po ON po.id = vov.option_id
+		INNER JOIN product_option_value pov ON pov.id = vov.option_value_id
+		WHERE vov.variant_id = pv.id
+		AND lower(po.name) = ?
+		AND lower(pov.value) IN ?
+	)`
 )
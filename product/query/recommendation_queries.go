@@ -0,0 +1,87 @@
+package query
+
+// Recommendation rebuild queries. Purchases are the distinct (customer, product) pairs of
+// orders placed since $1 that were not cancelled or failed; products are only paired
+// with other products of the same seller. A rebuild upserts its rows stamped with its
+// start time and then deletes the rows an earlier rebuild left behind, so unchanged
+// rows are updated in place instead of the whole table being rewritten.
+const (
+	// REBUILD_CO_PURCHASES_QUERY upserts, per product, the $3 products most customers
+	// also bought, when at least $2 customers bought both, stamped with $4
+	REBUILD_CO_PURCHASES_QUERY = `
+		WITH purchases AS (
+			SELECT DISTINCT o.user_id, oi.product_id, p.seller_id
+			FROM order_item oi
+			JOIN "order" o ON o.id = oi.order_id
+			JOIN product p ON p.id = oi.product_id
+			WHERE o.created_at >= $1 AND o.status NOT IN ('cancelled', 'failed')
+		)
+		INSERT INTO product_co_purchase
+			(product_id, related_product_id, seller_id, customer_count, computed_at)
+		SELECT pair.product_id, pair.related_product_id, pair.seller_id, pair.customer_count,
+			$4::timestamptz
+		FROM (
+			SELECT a.product_id, b.product_id AS related_product_id, a.seller_id,
+				COUNT(*) AS customer_count,
+				ROW_NUMBER() OVER (
+					PARTITION BY a.product_id ORDER BY COUNT(*) DESC, b.product_id DESC
+				) AS rn
+			FROM purchases a
+			JOIN purchases b ON b.user_id = a.user_id
+				AND b.seller_id = a.seller_id
+				AND b.product_id != a.product_id
+			GROUP BY a.product_id, b.product_id, a.seller_id
+			HAVING COUNT(*) >= $2
+		) pair
+		WHERE pair.rn <= $3
+		ON CONFLICT (product_id, related_product_id) DO UPDATE SET
+			seller_id = EXCLUDED.seller_id,
+			customer_count = EXCLUDED.customer_count,
+			computed_at = EXCLUDED.computed_at`
+
+	// DELETE_STALE_CO_PURCHASES_QUERY removes the co-purchases a rebuild stamped with
+	// $1 did not write
+	DELETE_STALE_CO_PURCHASES_QUERY = `DELETE FROM product_co_purchase WHERE computed_at < $1`
+
+	// REBUILD_USER_RECOMMENDATIONS_QUERY upserts, per customer and seller, the $2 products
+	// they do not own whose co-purchases with what they bought score highest, stamped
+	// with $3. Runs once the co-purchases are rebuilt.
+	REBUILD_USER_RECOMMENDATIONS_QUERY = `
+		WITH purchases AS (
+			SELECT DISTINCT o.user_id, oi.product_id
+			FROM order_item oi
+			JOIN "order" o ON o.id = oi.order_id
+			WHERE o.created_at >= $1 AND o.status NOT IN ('cancelled', 'failed')
+				AND oi.product_id IS NOT NULL
+		),
+		candidates AS (
+			SELECT pu.user_id, cp.related_product_id AS product_id, cp.seller_id,
+				SUM(cp.customer_count) AS score
+			FROM purchases pu
+			JOIN product_co_purchase cp ON cp.product_id = pu.product_id
+			WHERE NOT EXISTS (
+				SELECT 1 FROM purchases owned
+				WHERE owned.user_id = pu.user_id AND owned.product_id = cp.related_product_id
+			)
+			GROUP BY pu.user_id, cp.related_product_id, cp.seller_id
+		)
+		INSERT INTO user_product_recommendation
+			(user_id, product_id, seller_id, score, computed_at)
+		SELECT ranked.user_id, ranked.product_id, ranked.seller_id, ranked.score, $3::timestamptz
+		FROM (
+			SELECT c.*, ROW_NUMBER() OVER (
+				PARTITION BY c.user_id, c.seller_id ORDER BY c.score DESC, c.product_id DESC
+			) AS rn
+			FROM candidates c
+		) ranked
+		WHERE ranked.rn <= $2
+		ON CONFLICT (user_id, product_id) DO UPDATE SET
+			seller_id = EXCLUDED.seller_id,
+			score = EXCLUDED.score,
+			computed_at = EXCLUDED.computed_at`
+
+	// DELETE_STALE_USER_RECOMMENDATIONS_QUERY removes the recommendations a rebuild
+	// stamped with $1 did not write
+	DELETE_STALE_USER_RECOMMENDATIONS_QUERY = `
+		DELETE FROM user_product_recommendation WHERE computed_at < $1`
+)
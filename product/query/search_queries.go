@@ -0,0 +1,150 @@
+package query
+
+// Search match expressions shared by the search filter, relevance ordering and match
+// reporting. Each covers the default-locale column and every translated locale and
+// takes a text[] of ILIKE patterns, matching when any pattern does.
+const (
+	// SEARCH_NAME_MATCH_EXPR takes the patterns twice
+	SEARCH_NAME_MATCH_EXPR = `(product.name ILIKE ANY (?::text[]) OR EXISTS (
+		SELECT 1 FROM product_translation pt
+		WHERE pt.product_id = product.id AND pt.name ILIKE ANY (?::text[])
+	))`
+
+	// SEARCH_TAGS_MATCH_EXPR takes the patterns once
+	SEARCH_TAGS_MATCH_EXPR = `EXISTS (
+		SELECT 1 FROM unnest(product.tags) AS tag
+		WHERE tag ILIKE ANY (?::text[])
+	)`
+
+	// SEARCH_DESCRIPTION_MATCH_EXPR takes the patterns twice
+	SEARCH_DESCRIPTION_MATCH_EXPR = `(product.short_description ILIKE ANY (?::text[]) OR EXISTS (
+		SELECT 1 FROM product_translation pt
+		WHERE pt.product_id = product.id AND pt.short_description ILIKE ANY (?::text[])
+	))`
+
+	// SEARCH_MATCH_FILTER keeps products matching a term in any searchable field (patterns x5)
+	SEARCH_MATCH_FILTER = SEARCH_NAME_MATCH_EXPR + ` OR ` + SEARCH_TAGS_MATCH_EXPR +
+		` OR ` + SEARCH_DESCRIPTION_MATCH_EXPR
+
+	// SEARCH_RELEVANCE_ORDER ranks by weighted field matches, newest first on ties.
+	// Args: patterns x2, name weight, patterns, tags weight, patterns x2, description weight.
+	SEARCH_RELEVANCE_ORDER = `(CASE WHEN ` + SEARCH_NAME_MATCH_EXPR + ` THEN ? ELSE 0 END
+		+ CASE WHEN ` + SEARCH_TAGS_MATCH_EXPR + ` THEN ? ELSE 0 END
+		+ CASE WHEN ` + SEARCH_DESCRIPTION_MATCH_EXPR + ` THEN ? ELSE 0 END) DESC,
+		product.created_at DESC`
+
+	// SEARCH_MATCH_FLAGS_QUERY reports which fields matched for the given products (patterns x5 + ids)
+	SEARCH_MATCH_FLAGS_QUERY = `SELECT
+			product.id AS product_id,
+			` + SEARCH_NAME_MATCH_EXPR + ` AS name_match,
+			` + SEARCH_TAGS_MATCH_EXPR + ` AS tags_match,
+			` + SEARCH_DESCRIPTION_MATCH_EXPR + ` AS description_match
+		FROM product
+		WHERE product.id IN ?`
+)
+
+// Sort expressions for listing sorts that are not plain product columns
+const (
+	// DEMOTED_SELLER_ORDER ranks products of sellers demoted for poor health after
+	// all others. It is applied ahead of every listing and search sort.
+	DEMOTED_SELLER_ORDER = `EXISTS (SELECT 1 FROM seller_health sh
+		WHERE sh.seller_id = product.seller_id AND sh.listing_demoted) ASC`
+
+	// OUT_OF_STOCK_LAST_ORDER ranks products without a purchasable in-stock variant after
+	// the others, for sellers that demote out-of-stock products
+	OUT_OF_STOCK_LAST_ORDER = FILTER_OUT_OF_STOCK_SUBQUERY + ` ASC`
+
+	// SORT_BY_PRICE_EXPR orders by the cheapest variant price
+	SORT_BY_PRICE_EXPR = `(SELECT MIN(pv.price)
+		FROM product_variant pv
+		WHERE pv.product_id = product.id)`
+
+	// SORT_BY_POPULARITY_EXPR orders by units sold on orders that were not cancelled or failed
+	SORT_BY_POPULARITY_EXPR = `(SELECT COALESCE(SUM(oi.quantity), 0)
+		FROM order_item oi
+		JOIN product_variant pv ON pv.id = oi.variant_id
+		JOIN "order" o ON o.id = oi.order_id
+		WHERE pv.product_id = product.id AND o.status NOT IN ('cancelled', 'failed'))`
+
+	// SORT_BY_DISCOUNT_EXPR orders by the largest percentage off from the seller's running
+	// percentage or flash sale promotions that cover the product through any of its
+	// scopes; products without one sort as 0
+	SORT_BY_DISCOUNT_EXPR = `(SELECT COALESCE(MAX(CASE p.promotion_type
+			WHEN 'percentage_discount' THEN (p.discount_config->>'percentage')::numeric
+			ELSE (p.discount_config->>'discount_value')::numeric END), 0)
+		FROM promotion p
+		WHERE p.seller_id = product.seller_id AND p.status = 'active'
+			AND p.starts_at <= NOW() AND (p.ends_at IS NULL OR p.ends_at > NOW())
+			AND (p.promotion_type = 'percentage_discount'
+				OR (p.promotion_type = 'flash_sale'
+					AND p.discount_config->>'discount_type' = 'percentage'))
+			AND (p.applies_to = 'all_products'
+				OR (p.applies_to = 'specific_products' AND EXISTS (SELECT 1
+					FROM promotion_product pp
+					WHERE pp.promotion_id = p.id AND pp.product_id = product.id))
+				OR (p.applies_to = 'specific_variant' AND EXISTS (SELECT 1
+					FROM promotion_product_variant ppv
+					JOIN product_variant pv ON pv.id = ppv.variant_id
+					WHERE ppv.promotion_id = p.id AND pv.product_id = product.id))
+				OR (p.applies_to = 'specific_categories' AND EXISTS (SELECT 1
+					FROM promotion_category pc
+					JOIN category c ON c.id = product.category_id
+					WHERE pc.promotion_id = p.id AND (pc.category_id = c.id
+						OR (pc.include_subcategories AND pc.category_id = c.parent_id))))
+				OR (p.applies_to = 'specific_collections' AND EXISTS (SELECT 1
+					FROM promotion_collection pcol
+					JOIN collection_product cp ON cp.collection_id = pcol.collection_id
+					WHERE pcol.promotion_id = p.id AND cp.product_id = product.id))))`
+)
+
+// Search-as-you-type suggestions. A candidate matches when the prefix starts the text
+// (score 1), starts one of its words (0.8), or is a trigram match of one of its words
+// for typos (scaled word similarity, at most 0.6). The trigram cut-off is the
+// transaction's pg_trgm.word_similarity_threshold, set by SET_SUGGEST_SIMILARITY_QUERY.
+// Parameters: $1 prefix, $2 prefix pattern, $3 word prefix pattern,
+// $4 seller ID (nullable), $5 limit per kind
+const (
+	// SET_SUGGEST_SIMILARITY_QUERY sets the trigram cut-off for the current transaction
+	SET_SUGGEST_SIMILARITY_QUERY = `SELECT set_config('pg_trgm.word_similarity_threshold', ?, true)`
+
+	// SEARCH_SUGGEST_QUERY returns product name, brand and category suggestions, best first
+	SEARCH_SUGGEST_QUERY = `SELECT * FROM (
+		(SELECT 'product' AS kind, p.id AS ref_id, p.name AS text,
+			COALESCE(p.slug, '') AS slug,
+			CASE WHEN lower(p.name) LIKE $2 THEN 1.0
+				WHEN lower(p.name) LIKE $3 THEN 0.8
+				ELSE 0.6 * word_similarity($1, lower(p.name)) END AS score
+		FROM product p
+		WHERE (lower(p.name) LIKE $2 OR lower(p.name) LIKE $3 OR $1 <% lower(p.name))
+			AND ($4::bigint IS NULL OR p.seller_id = $4)
+		ORDER BY score DESC, length(p.name), p.id
+		LIMIT $5)
+		UNION ALL
+		(SELECT 'brand' AS kind, 0 AS ref_id, p.brand AS text, '' AS slug,
+			MAX(CASE WHEN lower(p.brand) LIKE $2 THEN 1.0
+				WHEN lower(p.brand) LIKE $3 THEN 0.8
+				ELSE 0.6 * word_similarity($1, lower(p.brand)) END) AS score
+		FROM product p
+		WHERE p.brand <> ''
+			AND (lower(p.brand) LIKE $2 OR lower(p.brand) LIKE $3 OR $1 <% lower(p.brand))
+			AND ($4::bigint IS NULL OR p.seller_id = $4)
+		GROUP BY p.brand
+		ORDER BY score DESC, length(p.brand), p.brand
+		LIMIT $5)
+		UNION ALL
+		(SELECT 'category' AS kind, c.id AS ref_id, c.name AS text,
+			COALESCE(c.slug, '') AS slug,
+			CASE WHEN lower(c.name) LIKE $2 THEN 1.0
+				WHEN lower(c.name) LIKE $3 THEN 0.8
+				ELSE 0.6 * word_similarity($1, lower(c.name)) END AS score
+		FROM category c
+		WHERE (lower(c.name) LIKE $2 OR lower(c.name) LIKE $3 OR $1 <% lower(c.name))
+			AND EXISTS (
+				SELECT 1 FROM product p
+				WHERE p.category_id = c.id AND ($4::bigint IS NULL OR p.seller_id = $4)
+			)
+		ORDER BY score DESC, length(c.name), c.id
+		LIMIT $5)
+	) suggestion
+	ORDER BY suggestion.score DESC, length(suggestion.text), suggestion.ref_id`
+)
@@ -0,0 +1,30 @@
+package query
+
+// Sitemap queries. A product is published once at least one of its variants can be
+// purchased; a category is listed for a seller when it holds a published product of
+// that seller.
+const (
+	SITEMAP_PUBLISHED_PRODUCT_FILTER = `EXISTS (SELECT 1 FROM product_variant pv
+		WHERE pv.product_id = p.id AND pv.allow_purchase)`
+
+	FIND_SITEMAP_PRODUCTS_QUERY = `
+		SELECT p.slug, p.updated_at
+		FROM product p
+		WHERE p.seller_id = ? AND p.slug <> '' AND ` + SITEMAP_PUBLISHED_PRODUCT_FILTER + `
+		ORDER BY p.id`
+
+	FIND_SITEMAP_CATEGORIES_QUERY = `
+		SELECT c.slug, c.updated_at
+		FROM category c
+		WHERE (c.is_global OR c.seller_id = ?) AND c.slug <> ''
+			AND EXISTS (SELECT 1 FROM product p
+				WHERE p.category_id = c.id AND p.seller_id = ?
+					AND ` + SITEMAP_PUBLISHED_PRODUCT_FILTER + `)
+		ORDER BY c.id`
+
+	FIND_SITEMAP_SELLER_IDS_QUERY = `
+		SELECT DISTINCT p.seller_id
+		FROM product p
+		WHERE ` + SITEMAP_PUBLISHED_PRODUCT_FILTER + `
+		ORDER BY p.seller_id`
+)
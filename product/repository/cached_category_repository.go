@@ -0,0 +1,117 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"ecommerce-be/common/cache"
+	"ecommerce-be/common/db"
+	"ecommerce-be/product/entity"
+	"ecommerce-be/product/utils"
+)
+
+// CachedCategoryRepository is a read-through cache around a CategoryRepository.
+// The category tree is cached per seller and per parent; any category write bumps
+// the tree namespace, and updates and deletes also drop cached product detail,
+// which embeds the category. Tree reads are hot, so each instance also keeps them
+// in-process for a few seconds.
+type CachedCategoryRepository struct {
+	CategoryRepository
+}
+
+// NewCachedCategoryRepository wraps repo with the category tree cache
+func NewCachedCategoryRepository(repo CategoryRepository) CategoryRepository {
+	return &CachedCategoryRepository{CategoryRepository: repo}
+}
+
+// FindAllHierarchical returns the categories visible to a seller (all for admin)
+func (r *CachedCategoryRepository) FindAllHierarchical(
+	ctx context.Context,
+	sellerID *uint,
+) ([]entity.Category, error) {
+	if db.IsInTransaction(ctx) {
+		return r.CategoryRepository.FindAllHierarchical(ctx, sellerID)
+	}
+	return cache.GetOrLoad(
+		ctx,
+		utils.CATEGORY_TREE_CACHE_NAMESPACE,
+		cache.VersionedKey(utils.CATEGORY_TREE_CACHE_NAMESPACE, "all", sellerScope(sellerID)),
+		utils.CATEGORY_LIST_CACHE_TTL*time.Second,
+		func(ctx context.Context) ([]entity.Category, error) {
+			return r.CategoryRepository.FindAllHierarchical(ctx, sellerID)
+		},
+		cache.WithLocal(utils.CATEGORY_TREE_LOCAL_CACHE_TTL*time.Second),
+	)
+}
+
+// FindByParentID returns the children of a category, or the roots when parentID is nil
+func (r *CachedCategoryRepository) FindByParentID(
+	ctx context.Context,
+	parentID *uint,
+	sellerID *uint,
+) ([]entity.Category, error) {
+	if db.IsInTransaction(ctx) {
+		return r.CategoryRepository.FindByParentID(ctx, parentID, sellerID)
+	}
+	var parent any = "root"
+	if parentID != nil {
+		parent = *parentID
+	}
+	return cache.GetOrLoad(
+		ctx,
+		utils.CATEGORY_TREE_CACHE_NAMESPACE,
+		cache.VersionedKey(
+			utils.CATEGORY_TREE_CACHE_NAMESPACE,
+			"parent", parent, sellerScope(sellerID),
+		),
+		utils.CATEGORY_LIST_CACHE_TTL*time.Second,
+		func(ctx context.Context) ([]entity.Category, error) {
+			return r.CategoryRepository.FindByParentID(ctx, parentID, sellerID)
+		},
+		cache.WithLocal(utils.CATEGORY_TREE_LOCAL_CACHE_TTL*time.Second),
+	)
+}
+
+// Create creates the category and invalidates the tree
+func (r *CachedCategoryRepository) Create(ctx context.Context, category *entity.Category) error {
+	if err := r.CategoryRepository.Create(ctx, category); err != nil {
+		return err
+	}
+	InvalidateCacheNamespace(ctx, utils.CATEGORY_TREE_CACHE_NAMESPACE)
+	return nil
+}
+
+// Update updates the category and invalidates the tree, product detail, related products
+// and search suggestions
+func (r *CachedCategoryRepository) Update(ctx context.Context, category *entity.Category) error {
+	if err := r.CategoryRepository.Update(ctx, category); err != nil {
+		return err
+	}
+	InvalidateCacheNamespace(ctx, utils.CATEGORY_TREE_CACHE_NAMESPACE)
+	InvalidateCacheNamespace(ctx, utils.PRODUCT_DETAIL_CACHE_NAMESPACE)
+	InvalidateCacheNamespace(ctx, utils.RELATED_PRODUCTS_CACHE_NAMESPACE)
+	InvalidateCacheNamespace(ctx, utils.SEARCH_SUGGEST_CACHE_NAMESPACE)
+	return nil
+}
+
+// Delete deletes the category and invalidates the tree, product detail, related products
+// and search suggestions
+func (r *CachedCategoryRepository) Delete(ctx context.Context, id uint) error {
+	if err := r.CategoryRepository.Delete(ctx, id); err != nil {
+		return err
+	}
+	InvalidateCacheNamespace(ctx, utils.CATEGORY_TREE_CACHE_NAMESPACE)
+	InvalidateCacheNamespace(ctx, utils.PRODUCT_DETAIL_CACHE_NAMESPACE)
+	InvalidateCacheNamespace(ctx, utils.RELATED_PRODUCTS_CACHE_NAMESPACE)
+	InvalidateCacheNamespace(ctx, utils.SEARCH_SUGGEST_CACHE_NAMESPACE)
+	return nil
+}
+
+// sellerScope is the cache key segment of a seller filter; nil is the admin view
+func sellerScope(sellerID *uint) string {
+	if sellerID == nil {
+		return "admin"
+	}
+	return fmt.Sprintf("seller:%d", *sellerID)
+}
@@ -0,0 +1,74 @@
+package repository
+
+import (
+	"context"
+
+	"ecommerce-be/product/entity"
+)
+
+// CachedProductOptionRepository invalidates cached variant previews, which list
+// option names and values, whenever an option or option value is written
+type CachedProductOptionRepository struct {
+	ProductOptionRepository
+}
+
+// NewCachedProductOptionRepository wraps repo with variant preview invalidation
+func NewCachedProductOptionRepository(repo ProductOptionRepository) ProductOptionRepository {
+	return &CachedProductOptionRepository{ProductOptionRepository: repo}
+}
+
+// UpdateOption updates the option and invalidates variant previews
+func (r *CachedProductOptionRepository) UpdateOption(
+	ctx context.Context,
+	option *entity.ProductOption,
+) error {
+	return invalidatePreviewsAfter(ctx, r.ProductOptionRepository.UpdateOption(ctx, option))
+}
+
+// DeleteOption deletes the option and invalidates variant previews
+func (r *CachedProductOptionRepository) DeleteOption(ctx context.Context, id uint) error {
+	return invalidatePreviewsAfter(ctx, r.ProductOptionRepository.DeleteOption(ctx, id))
+}
+
+// BulkUpdateOptions updates the options and invalidates variant previews
+func (r *CachedProductOptionRepository) BulkUpdateOptions(
+	ctx context.Context,
+	options []*entity.ProductOption,
+) error {
+	return invalidatePreviewsAfter(ctx, r.ProductOptionRepository.BulkUpdateOptions(ctx, options))
+}
+
+// UpdateOptionValue updates the value and invalidates variant previews
+func (r *CachedProductOptionRepository) UpdateOptionValue(
+	ctx context.Context,
+	value *entity.ProductOptionValue,
+) error {
+	return invalidatePreviewsAfter(ctx, r.ProductOptionRepository.UpdateOptionValue(ctx, value))
+}
+
+// DeleteOptionValue deletes the value and invalidates variant previews
+func (r *CachedProductOptionRepository) DeleteOptionValue(ctx context.Context, id uint) error {
+	return invalidatePreviewsAfter(ctx, r.ProductOptionRepository.DeleteOptionValue(ctx, id))
+}
+
+// BulkUpdateOptionValues updates the values and invalidates variant previews
+func (r *CachedProductOptionRepository) BulkUpdateOptionValues(
+	ctx context.Context,
+	values []*entity.ProductOptionValue,
+) error {
+	return invalidatePreviewsAfter(
+		ctx,
+		r.ProductOptionRepository.BulkUpdateOptionValues(ctx, values),
+	)
+}
+
+// DeleteOptionValuesByOptionID deletes the option's values and invalidates variant previews
+func (r *CachedProductOptionRepository) DeleteOptionValuesByOptionID(
+	ctx context.Context,
+	optionID uint,
+) error {
+	return invalidatePreviewsAfter(
+		ctx,
+		r.ProductOptionRepository.DeleteOptionValuesByOptionID(ctx, optionID),
+	)
+}
@@ -0,0 +1,107 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"ecommerce-be/common/cache"
+	"ecommerce-be/common/db"
+	"ecommerce-be/common/log"
+	"ecommerce-be/product/entity"
+	"ecommerce-be/product/utils"
+)
+
+// CachedProductRepository is a read-through cache around a ProductRepository.
+// Product detail is served from Redis; every write path evicts the product's entry
+// and the cached related products and search suggestions it may appear in.
+type CachedProductRepository struct {
+	ProductRepository
+}
+
+// NewCachedProductRepository wraps repo with the product detail cache
+func NewCachedProductRepository(repo ProductRepository) ProductRepository {
+	return &CachedProductRepository{ProductRepository: repo}
+}
+
+// FindByID returns the product with its category from the cache, loading it on a miss.
+// Reads inside a transaction bypass the cache so uncommitted rows are never cached.
+func (r *CachedProductRepository) FindByID(ctx context.Context, id uint) (*entity.Product, error) {
+	if db.IsInTransaction(ctx) {
+		return r.ProductRepository.FindByID(ctx, id)
+	}
+	return cache.GetOrLoad(
+		ctx,
+		utils.PRODUCT_DETAIL_CACHE_NAMESPACE,
+		cache.VersionedKey(utils.PRODUCT_DETAIL_CACHE_NAMESPACE, id),
+		utils.PRODUCT_DETAIL_CACHE_TTL*time.Second,
+		func(ctx context.Context) (*entity.Product, error) {
+			return r.ProductRepository.FindByID(ctx, id)
+		},
+	)
+}
+
+// Create creates the product and drops its seller's cached related products and
+// search suggestions
+func (r *CachedProductRepository) Create(ctx context.Context, product *entity.Product) error {
+	if err := r.ProductRepository.Create(ctx, product); err != nil {
+		return err
+	}
+	InvalidateRelatedProductsCache(ctx, product.SellerID)
+	InvalidateSearchSuggestCache(ctx, product.SellerID)
+	return nil
+}
+
+// Update updates the product and evicts its cached detail and its seller's related
+// products and search suggestions
+func (r *CachedProductRepository) Update(ctx context.Context, product *entity.Product) error {
+	if err := r.ProductRepository.Update(ctx, product); err != nil {
+		return err
+	}
+	InvalidateProductCache(ctx, product.ID)
+	InvalidateRelatedProductsCache(ctx, product.SellerID)
+	InvalidateSearchSuggestCache(ctx, product.SellerID)
+	return nil
+}
+
+// Delete deletes the product and evicts its cached detail. The seller is unknown here,
+// so every cached related products page and search suggestion is dropped.
+func (r *CachedProductRepository) Delete(ctx context.Context, id uint) error {
+	if err := r.ProductRepository.Delete(ctx, id); err != nil {
+		return err
+	}
+	InvalidateProductCache(ctx, id)
+	InvalidateCacheNamespace(ctx, utils.RELATED_PRODUCTS_CACHE_NAMESPACE)
+	InvalidateCacheNamespace(ctx, utils.SEARCH_SUGGEST_CACHE_NAMESPACE)
+	return nil
+}
+
+// UpdateStock updates the stock flag and evicts the product's cached detail
+func (r *CachedProductRepository) UpdateStock(ctx context.Context, id uint, inStock bool) error {
+	if err := r.ProductRepository.UpdateStock(ctx, id, inStock); err != nil {
+		return err
+	}
+	InvalidateProductCache(ctx, id)
+	return nil
+}
+
+// InvalidateProductCache evicts the cached detail of a product once the current
+// transaction commits, so concurrent reads cannot re-cache the old row. Failures are
+// logged only; the entry expires with its TTL.
+func InvalidateProductCache(ctx context.Context, productID uint) {
+	db.AfterCommit(ctx, func() {
+		key := cache.VersionedKey(utils.PRODUCT_DETAIL_CACHE_NAMESPACE, productID)
+		if err := cache.Del(key); err != nil {
+			log.WarnWithContext(ctx, "invalidateProductCache: "+err.Error())
+		}
+	})
+}
+
+// InvalidateCacheNamespace drops every key of a namespace by bumping its version once
+// the current transaction commits
+func InvalidateCacheNamespace(ctx context.Context, namespace string) {
+	db.AfterCommit(ctx, func() {
+		if err := cache.BumpNamespaceVersion(namespace); err != nil {
+			log.WarnWithContext(ctx, "invalidateCacheNamespace: "+namespace+": "+err.Error())
+		}
+	})
+}
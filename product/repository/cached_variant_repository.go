@@ -0,0 +1,161 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"ecommerce-be/common/cache"
+	"ecommerce-be/common/db"
+	"ecommerce-be/product/entity"
+	"ecommerce-be/product/mapper"
+	"ecommerce-be/product/utils"
+)
+
+// CachedVariantRepository is a read-through cache around a VariantRepository.
+// It caches the variant preview (aggregation) shown on product detail. Variant
+// writes do not all carry the product ID, so each one bumps the preview namespace.
+type CachedVariantRepository struct {
+	VariantRepository
+}
+
+// NewCachedVariantRepository wraps repo with the variant preview cache
+func NewCachedVariantRepository(repo VariantRepository) VariantRepository {
+	return &CachedVariantRepository{VariantRepository: repo}
+}
+
+// GetProductVariantAggregation returns the variant preview of a product. The
+// wishlist flag is per user, so only anonymous lookups are cached.
+func (r *CachedVariantRepository) GetProductVariantAggregation(
+	ctx context.Context,
+	productID uint,
+	userID *uint,
+) (*mapper.VariantAggregation, error) {
+	if userID != nil || db.IsInTransaction(ctx) {
+		return r.VariantRepository.GetProductVariantAggregation(ctx, productID, userID)
+	}
+	return cache.GetOrLoad(
+		ctx,
+		utils.VARIANT_PREVIEW_CACHE_NAMESPACE,
+		cache.VersionedKey(utils.VARIANT_PREVIEW_CACHE_NAMESPACE, productID),
+		utils.VARIANT_PREVIEW_CACHE_TTL*time.Second,
+		func(ctx context.Context) (*mapper.VariantAggregation, error) {
+			return r.VariantRepository.GetProductVariantAggregation(ctx, productID, nil)
+		},
+	)
+}
+
+// CreateVariant creates the variant and invalidates variant previews
+func (r *CachedVariantRepository) CreateVariant(
+	ctx context.Context,
+	variant *entity.ProductVariant,
+) error {
+	return invalidatePreviewsAfter(ctx, r.VariantRepository.CreateVariant(ctx, variant))
+}
+
+// BulkCreateVariants creates the variants and invalidates variant previews
+func (r *CachedVariantRepository) BulkCreateVariants(
+	ctx context.Context,
+	variants []*entity.ProductVariant,
+) error {
+	return invalidatePreviewsAfter(ctx, r.VariantRepository.BulkCreateVariants(ctx, variants))
+}
+
+// CreateVariantOptionValues links option values and invalidates variant previews
+func (r *CachedVariantRepository) CreateVariantOptionValues(
+	ctx context.Context,
+	variantOptionValues []entity.VariantOptionValue,
+) error {
+	return invalidatePreviewsAfter(
+		ctx,
+		r.VariantRepository.CreateVariantOptionValues(ctx, variantOptionValues),
+	)
+}
+
+// UpdateVariant updates the variant and invalidates variant previews
+func (r *CachedVariantRepository) UpdateVariant(
+	ctx context.Context,
+	variant *entity.ProductVariant,
+) error {
+	return invalidatePreviewsAfter(ctx, r.VariantRepository.UpdateVariant(ctx, variant))
+}
+
+// DeleteVariant deletes the variant and invalidates variant previews
+func (r *CachedVariantRepository) DeleteVariant(ctx context.Context, variantID uint) error {
+	return invalidatePreviewsAfter(ctx, r.VariantRepository.DeleteVariant(ctx, variantID))
+}
+
+// DeleteVariantOptionValues unlinks option values and invalidates variant previews
+func (r *CachedVariantRepository) DeleteVariantOptionValues(
+	ctx context.Context,
+	variantID uint,
+) error {
+	return invalidatePreviewsAfter(
+		ctx,
+		r.VariantRepository.DeleteVariantOptionValues(ctx, variantID),
+	)
+}
+
+// BulkUpdateVariants updates the variants and invalidates variant previews
+func (r *CachedVariantRepository) BulkUpdateVariants(
+	ctx context.Context,
+	variants []*entity.ProductVariant,
+) error {
+	return invalidatePreviewsAfter(ctx, r.VariantRepository.BulkUpdateVariants(ctx, variants))
+}
+
+// UnsetAllDefaultVariantsForProduct clears the default flag and invalidates variant previews
+func (r *CachedVariantRepository) UnsetAllDefaultVariantsForProduct(
+	ctx context.Context,
+	productID uint,
+	exceptVariantIDs ...uint,
+) error {
+	return invalidatePreviewsAfter(
+		ctx,
+		r.VariantRepository.UnsetAllDefaultVariantsForProduct(
+			ctx, productID, exceptVariantIDs...),
+	)
+}
+
+// DeleteVariantsByProductID deletes the product's variants and invalidates variant previews
+func (r *CachedVariantRepository) DeleteVariantsByProductID(
+	ctx context.Context,
+	productID uint,
+) error {
+	return invalidatePreviewsAfter(
+		ctx,
+		r.VariantRepository.DeleteVariantsByProductID(ctx, productID),
+	)
+}
+
+// DeleteVariantOptionValuesByVariantIDs unlinks option values and invalidates variant previews
+func (r *CachedVariantRepository) DeleteVariantOptionValuesByVariantIDs(
+	ctx context.Context,
+	variantIDs []uint,
+) error {
+	return invalidatePreviewsAfter(
+		ctx,
+		r.VariantRepository.DeleteVariantOptionValuesByVariantIDs(ctx, variantIDs),
+	)
+}
+
+// UpdateAllVariantsFlags updates the flags and invalidates variant previews
+func (r *CachedVariantRepository) UpdateAllVariantsFlags(
+	ctx context.Context,
+	productID uint,
+	allowPurchase *bool,
+	isPopular *bool,
+) error {
+	return invalidatePreviewsAfter(
+		ctx,
+		r.VariantRepository.UpdateAllVariantsFlags(ctx, productID, allowPurchase, isPopular),
+	)
+}
+
+// invalidatePreviewsAfter bumps the variant preview namespace when a write succeeded
+func invalidatePreviewsAfter(ctx context.Context, err error) error {
+	if err != nil {
+		return err
+	}
+	InvalidateCacheNamespace(ctx, utils.VARIANT_PREVIEW_CACHE_NAMESPACE)
+	return nil
+}
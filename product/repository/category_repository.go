@@ -19,6 +19,12 @@ type CategoryRepository interface {
 	Update(ctx context.Context, category *entity.Category) error
 	FindByID(ctx context.Context, id uint) (*entity.Category, error)
 	FindByNameAndParent(ctx context.Context, name string, parentID *uint) (*entity.Category, error)
+	// FindBySlug returns the category with the slug visible to a seller, preferring the
+	// seller's own over a global one, or nil
+	FindBySlug(ctx context.Context, sellerID uint, slug string) (*entity.Category, error)
+	// SlugExists reports whether another category in the scope uses the slug. A seller
+	// scope includes global categories so a seller slug never shadows a global one.
+	SlugExists(ctx context.Context, sellerID *uint, slug string, excludeID uint) (bool, error)
 	FindAllHierarchical(ctx context.Context, sellerID *uint) ([]entity.Category, error)
 	FindByParentID(ctx context.Context, parentID *uint, sellerID *uint) ([]entity.Category, error)
 	Delete(ctx context.Context, id uint) error
@@ -55,12 +61,19 @@ func (r *CategoryRepositoryImpl) Create(ctx context.Context, category *entity.Ca
 func (r *CategoryRepositoryImpl) Update(ctx context.Context, category *entity.Category) error {
 	// Use Updates with Select to handle pointer fields (ParentID) and force timestamp updates
 	return db.DB(ctx).Model(category).
-		Select("Name", "Description", "ParentID", "UpdatedAt").
+		Select(
+			"Name", "Description", "ParentID", "UpdatedAt",
+			"Slug", "MetaTitle", "MetaDescription", "CanonicalURL",
+		).
 		Updates(map[string]any{
-			"name":        category.Name,
-			"description": category.Description,
-			"parent_id":   category.ParentID,
-			"updated_at":  category.UpdatedAt,
+			"name":             category.Name,
+			"description":      category.Description,
+			"parent_id":        category.ParentID,
+			"updated_at":       category.UpdatedAt,
+			"slug":             category.Slug,
+			"meta_title":       category.MetaTitle,
+			"meta_description": category.MetaDescription,
+			"canonical_url":    category.CanonicalURL,
 		}).Error
 }
 
@@ -102,6 +115,46 @@ func (r *CategoryRepositoryImpl) FindByNameAndParent(
 	return &category, nil
 }
 
+// FindBySlug finds the category with the slug among global and the seller's categories
+func (r *CategoryRepositoryImpl) FindBySlug(
+	ctx context.Context,
+	sellerID uint,
+	slug string,
+) (*entity.Category, error) {
+	var category entity.Category
+	err := db.DB(ctx).
+		Where("slug = ? AND (is_global = ? OR seller_id = ?)", slug, true, sellerID).
+		Order("seller_id NULLS LAST").
+		First(&category).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &category, nil
+}
+
+// SlugExists checks whether a category other than excludeID uses the slug in scope
+func (r *CategoryRepositoryImpl) SlugExists(
+	ctx context.Context,
+	sellerID *uint,
+	slug string,
+	excludeID uint,
+) (bool, error) {
+	q := db.DB(ctx).
+		Model(&entity.Category{}).
+		Where("slug = ? AND id <> ?", slug, excludeID)
+	if sellerID == nil {
+		q = q.Where("seller_id IS NULL")
+	} else {
+		q = q.Where("(seller_id IS NULL OR seller_id = ?)", *sellerID)
+	}
+	var count int64
+	err := q.Count(&count).Error
+	return count > 0, err
+}
+
 // FindAllHierarchical finds all categories with hierarchical structure
 // Multi-tenant: Returns global categories + seller-specific categories
 // If sellerID is nil (admin), returns all categories
@@ -7,7 +7,6 @@ import (
 	"ecommerce-be/product/entity"
 	prodErrors "ecommerce-be/product/error"
 
-	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 )
 
@@ -167,7 +166,9 @@ func (r *CollectionProductRepositoryImpl) UpdatePositions(
 	collectionID uint,
 	items []CollectionProductPositionUpdate,
 ) error {
-	return db.DB(ctx).Transaction(func(tx *gorm.DB) error {
+	return db.WithTransaction(ctx, func(txCtx context.Context) error {
+		tx := db.DB(txCtx)
+
 		for _, item := range items {
 			result := tx.Model(&entity.CollectionProduct{}).
 				Where("collection_id = ? AND product_id = ?", collectionID, item.ProductID).
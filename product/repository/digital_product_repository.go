@@ -0,0 +1,340 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"ecommerce-be/common/db"
+	"ecommerce-be/product/entity"
+	"ecommerce-be/product/mapper"
+	"ecommerce-be/product/utils"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// DigitalProductRepository defines database operations for digital product files,
+// license key pools and the entitlements of paid digital purchases
+type DigitalProductRepository interface {
+	// Files
+	FindAssetsByProductIDs(ctx context.Context, productIDs []uint) ([]entity.DigitalAsset, error)
+	FindAssetByID(ctx context.Context, productID, assetID uint) (*entity.DigitalAsset, error)
+	CountAssets(ctx context.Context, productID uint) (int64, error)
+	CreateAsset(ctx context.Context, asset *entity.DigitalAsset) error
+	DeleteAsset(ctx context.Context, productID, assetID uint) (bool, error)
+
+	// License keys
+	FindLicenseKeys(ctx context.Context, productID uint) ([]entity.LicenseKey, error)
+	FindLicenseKeyByID(ctx context.Context, productID, keyID uint) (*entity.LicenseKey, error)
+	FindLicenseKeysByEntitlementIDs(
+		ctx context.Context,
+		entitlementIDs []uint,
+	) ([]entity.LicenseKey, error)
+	HasLicenseKeys(ctx context.Context, productID uint) (bool, error)
+	InsertLicenseKeys(ctx context.Context, keys []entity.LicenseKey) (int64, error)
+	DeleteLicenseKey(ctx context.Context, keyID uint) error
+	AssignLicenseKeys(ctx context.Context, productID, entitlementID uint, count int) (int64, error)
+	FindPendingLicenses(ctx context.Context, productID uint) ([]mapper.PendingLicenseRow, error)
+
+	// Entitlements
+	FindDigitalProductsByIDs(ctx context.Context, productIDs []uint) ([]entity.Product, error)
+	FindDigitalVariantIDs(ctx context.Context, variantIDs []uint) ([]uint, error)
+	FindEntitlementsByOrderID(
+		ctx context.Context,
+		orderID uint,
+	) ([]entity.DigitalEntitlement, error)
+	FindEntitlementByIDForUpdate(
+		ctx context.Context,
+		id uint,
+	) (*entity.DigitalEntitlement, error)
+	CreateEntitlements(ctx context.Context, entitlements []entity.DigitalEntitlement) error
+	RecordDownload(ctx context.Context, id uint, at time.Time) error
+}
+
+// DigitalProductRepositoryImpl implements DigitalProductRepository
+type DigitalProductRepositoryImpl struct{}
+
+// NewDigitalProductRepository creates a new DigitalProductRepository
+func NewDigitalProductRepository() DigitalProductRepository {
+	return &DigitalProductRepositoryImpl{}
+}
+
+// FindAssetsByProductIDs returns the download files of the given products in display order
+func (r *DigitalProductRepositoryImpl) FindAssetsByProductIDs(
+	ctx context.Context,
+	productIDs []uint,
+) ([]entity.DigitalAsset, error) {
+	var assets []entity.DigitalAsset
+	if len(productIDs) == 0 {
+		return assets, nil
+	}
+	err := db.DB(ctx).
+		Where("product_id IN ?", productIDs).
+		Order("product_id ASC, sort_order ASC, id ASC").
+		Find(&assets).Error
+	return assets, err
+}
+
+// FindAssetByID returns a download file of the product, or nil if there is none
+func (r *DigitalProductRepositoryImpl) FindAssetByID(
+	ctx context.Context,
+	productID, assetID uint,
+) (*entity.DigitalAsset, error) {
+	var asset entity.DigitalAsset
+	err := db.DB(ctx).
+		Where("id = ? AND product_id = ?", assetID, productID).
+		First(&asset).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &asset, nil
+}
+
+// CountAssets returns the number of download files attached to the product
+func (r *DigitalProductRepositoryImpl) CountAssets(
+	ctx context.Context,
+	productID uint,
+) (int64, error) {
+	var count int64
+	err := db.DB(ctx).Model(&entity.DigitalAsset{}).
+		Where("product_id = ?", productID).
+		Count(&count).Error
+	return count, err
+}
+
+// CreateAsset attaches a download file to a product
+func (r *DigitalProductRepositoryImpl) CreateAsset(
+	ctx context.Context,
+	asset *entity.DigitalAsset,
+) error {
+	return db.DB(ctx).Create(asset).Error
+}
+
+// DeleteAsset removes a download file from the product; false if it was not attached
+func (r *DigitalProductRepositoryImpl) DeleteAsset(
+	ctx context.Context,
+	productID, assetID uint,
+) (bool, error) {
+	result := db.DB(ctx).
+		Where("id = ? AND product_id = ?", assetID, productID).
+		Delete(&entity.DigitalAsset{})
+	return result.RowsAffected > 0, result.Error
+}
+
+// FindLicenseKeys returns the product's key pool, available keys first
+func (r *DigitalProductRepositoryImpl) FindLicenseKeys(
+	ctx context.Context,
+	productID uint,
+) ([]entity.LicenseKey, error) {
+	var keys []entity.LicenseKey
+	err := db.DB(ctx).
+		Where("product_id = ?", productID).
+		Order("entitlement_id IS NOT NULL, id ASC").
+		Find(&keys).Error
+	return keys, err
+}
+
+// FindLicenseKeyByID returns a key of the product, or nil if there is none
+func (r *DigitalProductRepositoryImpl) FindLicenseKeyByID(
+	ctx context.Context,
+	productID, keyID uint,
+) (*entity.LicenseKey, error) {
+	var key entity.LicenseKey
+	err := db.DB(ctx).
+		Where("id = ? AND product_id = ?", keyID, productID).
+		First(&key).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &key, nil
+}
+
+// FindLicenseKeysByEntitlementIDs returns the keys issued to the given purchases
+func (r *DigitalProductRepositoryImpl) FindLicenseKeysByEntitlementIDs(
+	ctx context.Context,
+	entitlementIDs []uint,
+) ([]entity.LicenseKey, error) {
+	var keys []entity.LicenseKey
+	if len(entitlementIDs) == 0 {
+		return keys, nil
+	}
+	err := db.DB(ctx).
+		Where("entitlement_id IN ?", entitlementIDs).
+		Order("entitlement_id ASC, id ASC").
+		Find(&keys).Error
+	return keys, err
+}
+
+// HasLicenseKeys reports whether the product has ever had keys in its pool, i.e.
+// whether its purchases are owed one key per unit
+func (r *DigitalProductRepositoryImpl) HasLicenseKeys(
+	ctx context.Context,
+	productID uint,
+) (bool, error) {
+	var count int64
+	err := db.DB(ctx).Model(&entity.LicenseKey{}).
+		Where("product_id = ?", productID).
+		Count(&count).Error
+	return count > 0, err
+}
+
+// InsertLicenseKeys adds keys to their pools, skipping keys a pool already holds.
+// Returns the number of keys added.
+func (r *DigitalProductRepositoryImpl) InsertLicenseKeys(
+	ctx context.Context,
+	keys []entity.LicenseKey,
+) (int64, error) {
+	if len(keys) == 0 {
+		return 0, nil
+	}
+	result := db.DB(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&keys)
+	return result.RowsAffected, result.Error
+}
+
+// DeleteLicenseKey removes a key from its pool
+func (r *DigitalProductRepositoryImpl) DeleteLicenseKey(ctx context.Context, keyID uint) error {
+	return db.DB(ctx).Delete(&entity.LicenseKey{}, keyID).Error
+}
+
+// AssignLicenseKeys issues up to count available keys of the product to the purchase,
+// oldest first. Keys locked by a concurrent assignment are skipped rather than waited
+// for. Returns the number of keys issued.
+func (r *DigitalProductRepositoryImpl) AssignLicenseKeys(
+	ctx context.Context,
+	productID, entitlementID uint,
+	count int,
+) (int64, error) {
+	if count <= 0 {
+		return 0, nil
+	}
+	result := db.DB(ctx).Exec(`
+		UPDATE license_key SET entitlement_id = ?, assigned_at = ?, updated_at = ?
+		WHERE id IN (
+			SELECT id FROM license_key
+			WHERE product_id = ? AND entitlement_id IS NULL
+			ORDER BY id
+			LIMIT ?
+			FOR UPDATE SKIP LOCKED
+		)`,
+		entitlementID, time.Now().UTC(), time.Now().UTC(), productID, count,
+	)
+	return result.RowsAffected, result.Error
+}
+
+// FindPendingLicenses returns the product's purchases that hold fewer keys than units,
+// oldest first, with the number of keys each is missing
+func (r *DigitalProductRepositoryImpl) FindPendingLicenses(
+	ctx context.Context,
+	productID uint,
+) ([]mapper.PendingLicenseRow, error) {
+	var rows []mapper.PendingLicenseRow
+	err := db.DB(ctx).Raw(`
+		SELECT e.id AS entitlement_id, e.quantity - COUNT(k.id) AS missing
+		FROM digital_entitlement e
+		LEFT JOIN license_key k ON k.entitlement_id = e.id
+		WHERE e.product_id = ?
+		GROUP BY e.id, e.quantity
+		HAVING e.quantity > COUNT(k.id)
+		ORDER BY e.id ASC`,
+		productID,
+	).Scan(&rows).Error
+	return rows, err
+}
+
+// FindDigitalProductsByIDs returns the digital products among the given IDs
+func (r *DigitalProductRepositoryImpl) FindDigitalProductsByIDs(
+	ctx context.Context,
+	productIDs []uint,
+) ([]entity.Product, error) {
+	var products []entity.Product
+	if len(productIDs) == 0 {
+		return products, nil
+	}
+	err := db.DB(ctx).
+		Where("id IN ? AND product_type = ?", productIDs, utils.PRODUCT_TYPE_DIGITAL).
+		Find(&products).Error
+	return products, err
+}
+
+// FindDigitalVariantIDs returns which of the given variants belong to digital products
+func (r *DigitalProductRepositoryImpl) FindDigitalVariantIDs(
+	ctx context.Context,
+	variantIDs []uint,
+) ([]uint, error) {
+	var ids []uint
+	if len(variantIDs) == 0 {
+		return ids, nil
+	}
+	err := db.DB(ctx).Table("product_variant pv").
+		Joins("JOIN product p ON p.id = pv.product_id").
+		Where("pv.id IN ? AND p.product_type = ?", variantIDs, utils.PRODUCT_TYPE_DIGITAL).
+		Pluck("pv.id", &ids).Error
+	return ids, err
+}
+
+// FindEntitlementsByOrderID returns the digital purchases of an order
+func (r *DigitalProductRepositoryImpl) FindEntitlementsByOrderID(
+	ctx context.Context,
+	orderID uint,
+) ([]entity.DigitalEntitlement, error) {
+	var entitlements []entity.DigitalEntitlement
+	err := db.DB(ctx).
+		Where("order_id = ?", orderID).
+		Order("id ASC").
+		Find(&entitlements).Error
+	return entitlements, err
+}
+
+// FindEntitlementByIDForUpdate loads and row-locks a purchase, or nil if there is none
+func (r *DigitalProductRepositoryImpl) FindEntitlementByIDForUpdate(
+	ctx context.Context,
+	id uint,
+) (*entity.DigitalEntitlement, error) {
+	var entitlement entity.DigitalEntitlement
+	err := db.DB(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ?", id).
+		First(&entitlement).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &entitlement, nil
+}
+
+// CreateEntitlements inserts purchases; an order item can be delivered only once
+func (r *DigitalProductRepositoryImpl) CreateEntitlements(
+	ctx context.Context,
+	entitlements []entity.DigitalEntitlement,
+) error {
+	if len(entitlements) == 0 {
+		return nil
+	}
+	return db.DB(ctx).Create(&entitlements).Error
+}
+
+// RecordDownload counts one download of the purchase
+func (r *DigitalProductRepositoryImpl) RecordDownload(
+	ctx context.Context,
+	id uint,
+	at time.Time,
+) error {
+	return db.DB(ctx).Model(&entity.DigitalEntitlement{}).
+		Where("id = ?", id).
+		Updates(map[string]any{
+			"download_count":     gorm.Expr("download_count + 1"),
+			"last_downloaded_at": at,
+			"updated_at":         at,
+		}).Error
+}
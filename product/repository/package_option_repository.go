@@ -18,6 +18,7 @@ type PackageOptionRepository interface {
 	Delete(ctx context.Context, id uint) error
 	FindByID(ctx context.Context, id uint) (*entity.PackageOption, error)
 	FindAllByProductID(ctx context.Context, productID uint) ([]entity.PackageOption, error)
+	FindAllByProductIDs(ctx context.Context, productIDs []uint) ([]entity.PackageOption, error)
 	DeleteByProductID(ctx context.Context, productID uint) error
 }
 
@@ -97,6 +98,25 @@ func (r *PackageOptionRepositoryImpl) FindAllByProductID(
 	return packageOptions, nil
 }
 
+// FindAllByProductIDs finds the package options of several products
+func (r *PackageOptionRepositoryImpl) FindAllByProductIDs(
+	ctx context.Context,
+	productIDs []uint,
+) ([]entity.PackageOption, error) {
+	if len(productIDs) == 0 {
+		return nil, nil
+	}
+	var packageOptions []entity.PackageOption
+	err := db.DB(ctx).
+		Where("product_id IN ?", productIDs).
+		Order("product_id ASC, id ASC").
+		Find(&packageOptions).Error
+	if err != nil {
+		return nil, err
+	}
+	return packageOptions, nil
+}
+
 // DeleteByProductID deletes all package options for a given product
 func (r *PackageOptionRepositoryImpl) DeleteByProductID(
 	ctx context.Context,
@@ -22,6 +22,10 @@ type ProductAttributeRepository interface {
 		productID, attributeDefID uint,
 	) (*entity.ProductAttribute, error)
 	FindAllByProductID(ctx context.Context, productID uint) ([]entity.ProductAttribute, error)
+	FindAllByProductIDs(
+		ctx context.Context,
+		productIDs []uint,
+	) ([]entity.ProductAttribute, error)
 	ExistsByProductIDAndAttributeID(
 		ctx context.Context,
 		productID, attributeDefID uint,
@@ -127,6 +131,25 @@ func (r *ProductAttributeRepositoryImpl) FindAllByProductID(
 	return productAttributes, nil
 }
 
+// FindAllByProductIDs finds the attributes of several products with their definitions
+func (r *ProductAttributeRepositoryImpl) FindAllByProductIDs(
+	ctx context.Context,
+	productIDs []uint,
+) ([]entity.ProductAttribute, error) {
+	if len(productIDs) == 0 {
+		return nil, nil
+	}
+	var productAttributes []entity.ProductAttribute
+	err := db.DB(ctx).Preload("AttributeDefinition").
+		Where("product_id IN ?", productIDs).
+		Order("product_id ASC, sort_order ASC, id ASC").
+		Find(&productAttributes).Error
+	if err != nil {
+		return nil, err
+	}
+	return productAttributes, nil
+}
+
 // ExistsByProductIDAndAttributeID checks if a product attribute exists
 func (r *ProductAttributeRepositoryImpl) ExistsByProductIDAndAttributeID(
 	ctx context.Context,
@@ -7,6 +7,7 @@ import (
 	"ecommerce-be/common/db"
 	"ecommerce-be/product/entity"
 	productError "ecommerce-be/product/error"
+	"ecommerce-be/product/utils"
 
 	"gorm.io/gorm"
 )
@@ -33,7 +34,7 @@ type ProductMediaRepository interface {
 	// Used before promoting a new primary item.
 	UnsetPrimary(ctx context.Context, productID uint) error
 
-	// PromoteFallbackPrimary promotes the media row with the lowest display_order
+	// PromoteFallbackPrimary promotes the IMAGE media row with the lowest display_order
 	// (then lowest id) to primary, after the current primary has been removed.
 	// No-ops gracefully when no rows remain.
 	PromoteFallbackPrimary(ctx context.Context, productID uint) error
@@ -50,7 +51,7 @@ func NewProductMediaRepository() ProductMediaRepository {
 }
 
 func (r *productMediaRepository) Create(ctx context.Context, media *entity.ProductMedia) error {
-	result := db.GetDB().WithContext(ctx).Create(media)
+	result := db.DB(ctx).Create(media)
 	return result.Error
 }
 
@@ -60,7 +61,7 @@ func (r *productMediaRepository) FindByProductAndFile(
 	fileID string,
 ) (*entity.ProductMedia, error) {
 	var media entity.ProductMedia
-	result := db.GetDB().WithContext(ctx).
+	result := db.DB(ctx).
 		Where("product_id = ? AND file_id = ?", productID, fileID).
 		First(&media)
 	if result.Error != nil {
@@ -80,7 +81,7 @@ func (r *productMediaRepository) FindByProductIDs(
 		return nil, nil
 	}
 	var items []entity.ProductMedia
-	result := db.GetDB().WithContext(ctx).
+	result := db.DB(ctx).
 		Where("product_id IN ?", productIDs).
 		Order("product_id ASC, display_order ASC, id ASC").
 		Find(&items)
@@ -103,14 +104,14 @@ func (r *productMediaRepository) UpdateMetadata(
 	if len(updates) == 0 {
 		return nil
 	}
-	return db.GetDB().WithContext(ctx).
+	return db.DB(ctx).
 		Model(&entity.ProductMedia{}).
 		Where("id = ?", id).
 		Updates(updates).Error
 }
 
 func (r *productMediaRepository) UnsetPrimary(ctx context.Context, productID uint) error {
-	return db.GetDB().WithContext(ctx).
+	return db.DB(ctx).
 		Model(&entity.ProductMedia{}).
 		Where("product_id = ? AND is_primary = true", productID).
 		Update("is_primary", false).Error
@@ -118,8 +119,8 @@ func (r *productMediaRepository) UnsetPrimary(ctx context.Context, productID uin
 
 func (r *productMediaRepository) PromoteFallbackPrimary(ctx context.Context, productID uint) error {
 	var candidate entity.ProductMedia
-	result := db.GetDB().WithContext(ctx).
-		Where("product_id = ?", productID).
+	result := db.DB(ctx).
+		Where("product_id = ? AND media_type = ?", productID, utils.PRODUCT_MEDIA_TYPE_IMAGE).
 		Order("display_order ASC, id ASC").
 		First(&candidate)
 	if result.Error != nil {
@@ -128,13 +129,13 @@ func (r *productMediaRepository) PromoteFallbackPrimary(ctx context.Context, pro
 		}
 		return result.Error
 	}
-	return db.GetDB().WithContext(ctx).
+	return db.DB(ctx).
 		Model(&entity.ProductMedia{}).
 		Where("id = ?", candidate.ID).
 		Update("is_primary", true).Error
 }
 
 func (r *productMediaRepository) Delete(ctx context.Context, id uint) error {
-	return db.GetDB().WithContext(ctx).
+	return db.DB(ctx).
 		Delete(&entity.ProductMedia{}, id).Error
 }
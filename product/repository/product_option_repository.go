@@ -56,6 +56,10 @@ type ProductOptionRepository interface {
 		ctx context.Context,
 		productID uint,
 	) ([]entity.ProductOption, map[uint]int, error)
+	GetProductsOptionsWithVariantCounts(
+		ctx context.Context,
+		productIDs []uint,
+	) (map[uint][]entity.ProductOption, map[uint]int, error)
 
 	// Bulk deletion methods for product cleanup
 	DeleteOptionValuesByOptionID(ctx context.Context, optionID uint) error
@@ -342,34 +346,61 @@ func (r *ProductOptionRepositoryImpl) GetProductOptionsWithVariantCounts(
 	ctx context.Context,
 	productID uint,
 ) ([]entity.ProductOption, map[uint]int, error) {
-	// Get all options for the product with their values preloaded
-	var options []entity.ProductOption
-	if err := db.DB(ctx).
-		Where("product_id = ?", productID).
-		Order("position ASC").
-		Preload("Values", func(db *gorm.DB) *gorm.DB {
-			return db.Order("position ASC")
-		}).
-		Find(&options).Error; err != nil {
+	optionsByProduct, variantCounts, err := r.GetProductsOptionsWithVariantCounts(
+		ctx,
+		[]uint{productID},
+	)
+	if err != nil {
 		return nil, nil, err
 	}
+	return optionsByProduct[productID], variantCounts, nil
+}
 
-	// Count variants for each option value
-	variantCounts := make(map[uint]int)
+// GetProductsOptionsWithVariantCounts retrieves the options of several products keyed by
+// product ID, with the number of variants using each option value keyed by value ID
+func (r *ProductOptionRepositoryImpl) GetProductsOptionsWithVariantCounts(
+	ctx context.Context,
+	productIDs []uint,
+) (map[uint][]entity.ProductOption, map[uint]int, error) {
+	optionsByProduct, err := r.FindOptionsByProductIDs(ctx, productIDs)
+	if err != nil {
+		return nil, nil, err
+	}
 
-	for _, option := range options {
-		for _, value := range option.Values {
-			// Count how many variants use this option value
-			var count int64
-			db.DB(ctx).Model(&entity.VariantOptionValue{}).
-				Where("option_value_id = ?", value.ID).
-				Count(&count)
-
-			variantCounts[value.ID] = int(count)
+	valueIDs := make([]uint, 0)
+	for _, options := range optionsByProduct {
+		for _, option := range options {
+			for _, value := range option.Values {
+				valueIDs = append(valueIDs, value.ID)
+			}
 		}
 	}
 
-	return options, variantCounts, nil
+	// Count how many variants use each option value in one grouped query
+	variantCounts := make(map[uint]int, len(valueIDs))
+	if len(valueIDs) == 0 {
+		return optionsByProduct, variantCounts, nil
+	}
+
+	var counts []struct {
+		OptionValueID uint
+		Count         int
+	}
+	if err := db.DB(ctx).Model(&entity.VariantOptionValue{}).
+		Select("option_value_id, COUNT(*) AS count").
+		Where("option_value_id IN ?", valueIDs).
+		Group("option_value_id").
+		Scan(&counts).Error; err != nil {
+		return nil, nil, err
+	}
+	for _, valueID := range valueIDs {
+		variantCounts[valueID] = 0
+	}
+	for _, c := range counts {
+		variantCounts[c.OptionValueID] = c.Count
+	}
+
+	return optionsByProduct, variantCounts, nil
 }
 
 /***********************************************
@@ -397,7 +428,9 @@ func (r *ProductOptionRepositoryImpl) BulkUpdateOptions(
 		return nil
 	}
 
-	return db.DB(ctx).Transaction(func(tx *gorm.DB) error {
+	return db.WithTransaction(ctx, func(txCtx context.Context) error {
+		tx := db.DB(txCtx)
+
 		for _, option := range options {
 			if err := tx.Model(&entity.ProductOption{}).
 				Where("id = ?", option.ID).
@@ -421,7 +454,9 @@ func (r *ProductOptionRepositoryImpl) BulkUpdateOptionValues(
 		return nil
 	}
 
-	return db.DB(ctx).Transaction(func(tx *gorm.DB) error {
+	return db.WithTransaction(ctx, func(txCtx context.Context) error {
+		tx := db.DB(txCtx)
+
 		for _, value := range values {
 			updates := map[string]any{}
 
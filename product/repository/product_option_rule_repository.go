@@ -0,0 +1,102 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"ecommerce-be/common/db"
+	"ecommerce-be/product/entity"
+	prodErrors "ecommerce-be/product/error"
+	"ecommerce-be/product/utils"
+
+	"gorm.io/gorm"
+)
+
+// ProductOptionRuleRepository defines database operations for option dependency rules
+type ProductOptionRuleRepository interface {
+	Create(ctx context.Context, rule *entity.ProductOptionRule) error
+	FindByID(ctx context.Context, productID, ruleID uint) (*entity.ProductOptionRule, error)
+	FindByProductID(ctx context.Context, productID uint) ([]entity.ProductOptionRule, error)
+	Delete(ctx context.Context, ruleID uint) error
+	// FindVariantSelections returns the option selection of every variant of the product
+	FindVariantSelections(
+		ctx context.Context,
+		productID uint,
+	) (map[uint]utils.OptionSelection, error)
+}
+
+// ProductOptionRuleRepositoryImpl implements ProductOptionRuleRepository
+type ProductOptionRuleRepositoryImpl struct{}
+
+// NewProductOptionRuleRepository creates a new ProductOptionRuleRepository
+func NewProductOptionRuleRepository() ProductOptionRuleRepository {
+	return &ProductOptionRuleRepositoryImpl{}
+}
+
+// Create inserts a new rule
+func (r *ProductOptionRuleRepositoryImpl) Create(
+	ctx context.Context,
+	rule *entity.ProductOptionRule,
+) error {
+	return db.DB(ctx).Create(rule).Error
+}
+
+// FindByID returns a rule of the product
+func (r *ProductOptionRuleRepositoryImpl) FindByID(
+	ctx context.Context,
+	productID, ruleID uint,
+) (*entity.ProductOptionRule, error) {
+	var rule entity.ProductOptionRule
+	err := db.DB(ctx).Where("id = ? AND product_id = ?", ruleID, productID).First(&rule).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, prodErrors.ErrOptionRuleNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &rule, nil
+}
+
+// FindByProductID returns every rule of the product in creation order
+func (r *ProductOptionRuleRepositoryImpl) FindByProductID(
+	ctx context.Context,
+	productID uint,
+) ([]entity.ProductOptionRule, error) {
+	var rules []entity.ProductOptionRule
+	err := db.DB(ctx).
+		Where("product_id = ?", productID).
+		Order("id ASC").
+		Find(&rules).Error
+	return rules, err
+}
+
+// Delete removes a rule
+func (r *ProductOptionRuleRepositoryImpl) Delete(ctx context.Context, ruleID uint) error {
+	return db.DB(ctx).Delete(&entity.ProductOptionRule{}, ruleID).Error
+}
+
+// FindVariantSelections returns variant ID -> option ID -> option value ID for the product
+func (r *ProductOptionRuleRepositoryImpl) FindVariantSelections(
+	ctx context.Context,
+	productID uint,
+) (map[uint]utils.OptionSelection, error) {
+	var rows []entity.VariantOptionValue
+	err := db.DB(ctx).
+		Table("variant_option_value vov").
+		Select("vov.variant_id, vov.option_id, vov.option_value_id").
+		Joins("JOIN product_variant pv ON pv.id = vov.variant_id").
+		Where("pv.product_id = ?", productID).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	selections := make(map[uint]utils.OptionSelection)
+	for _, row := range rows {
+		if selections[row.VariantID] == nil {
+			selections[row.VariantID] = make(utils.OptionSelection)
+		}
+		selections[row.VariantID][row.OptionID] = row.OptionValueID
+	}
+	return selections, nil
+}
@@ -2,19 +2,23 @@ package repository
 
 import (
 	"context"
+	"database/sql"
 	"errors"
+	"strings"
 
-	commonError "ecommerce-be/common/error"
 	"ecommerce-be/common/db"
+	commonError "ecommerce-be/common/error"
 	"ecommerce-be/common/log"
 	"ecommerce-be/product/entity"
 	productError "ecommerce-be/product/error"
 	"ecommerce-be/product/mapper"
 	"ecommerce-be/product/model"
 	productQuery "ecommerce-be/product/query"
+	"ecommerce-be/product/utils"
 	"ecommerce-be/product/utils/helper"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // ProductRepository defines the interface for product-related database operations
@@ -23,18 +27,50 @@ type ProductRepository interface {
 	Update(ctx context.Context, product *entity.Product) error
 	FindByID(ctx context.Context, id uint) (*entity.Product, error)
 	FindByIDs(ctx context.Context, ids []uint) ([]entity.Product, error)
+	// FindBySlug returns the seller's product with the slug, or nil
+	FindBySlug(ctx context.Context, sellerID uint, slug string) (*entity.Product, error)
+	// SlugExists reports whether another product of the seller uses the slug
+	SlugExists(ctx context.Context, sellerID uint, slug string, excludeID uint) (bool, error)
 	// FindBySKU removed - BaseSKU validation no longer required
 	FindAll(
 		ctx context.Context,
 		filter model.GetProductsFilter,
 		page, limit int,
 	) ([]entity.Product, int64, error)
+	// Search returns products matching every term in some searchable field. Each term
+	// lists alternatives, any of which matches (see utils.ExpandSearchQuery).
 	Search(
 		ctx context.Context,
-		query string,
+		terms [][]string,
 		filters map[string]any,
 		page, limit int,
+		ranking model.SearchRanking,
 	) ([]entity.Product, int64, error)
+	// FindSearchMatches reports which searchable fields of the products match any term
+	FindSearchMatches(
+		ctx context.Context,
+		productIDs []uint,
+		terms [][]string,
+	) ([]mapper.SearchMatchRow, error)
+	// FindListingFacets returns the facet counts of the products a listing filter matches
+	FindListingFacets(
+		ctx context.Context,
+		filter model.GetProductsFilter,
+	) (*mapper.ProductFacetData, error)
+	// FindSearchFacets returns the facet counts of the products a search matches
+	FindSearchFacets(
+		ctx context.Context,
+		terms [][]string,
+		filters map[string]any,
+	) (*mapper.ProductFacetData, error)
+	// FindSuggestions returns up to limit product name, brand and category suggestions
+	// each for a normalized prefix, best matches first
+	FindSuggestions(
+		ctx context.Context,
+		prefix string,
+		sellerID *uint,
+		limit int,
+	) ([]mapper.SuggestionRow, error)
 	Delete(ctx context.Context, id uint) error
 	UpdateStock(ctx context.Context, id uint, inStock bool) error
 	FindRelated(
@@ -110,6 +146,41 @@ func (r *ProductRepositoryImpl) FindByIDs(ctx context.Context, ids []uint) ([]en
 	return products, nil
 }
 
+// FindBySlug finds a seller's product by slug; returns nil when there is none
+func (r *ProductRepositoryImpl) FindBySlug(
+	ctx context.Context,
+	sellerID uint,
+	slug string,
+) (*entity.Product, error) {
+	var product entity.Product
+	err := db.DB(ctx).Preload("Category").
+		Preload("Category.Parent").
+		Where("seller_id = ? AND slug = ?", sellerID, slug).
+		First(&product).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &product, nil
+}
+
+// SlugExists checks whether a product other than excludeID uses the seller's slug
+func (r *ProductRepositoryImpl) SlugExists(
+	ctx context.Context,
+	sellerID uint,
+	slug string,
+	excludeID uint,
+) (bool, error) {
+	var count int64
+	err := db.DB(ctx).
+		Model(&entity.Product{}).
+		Where("seller_id = ? AND slug = ? AND id <> ?", sellerID, slug, excludeID).
+		Count(&count).Error
+	return count > 0, err
+}
+
 // FindAll finds all products with filtering and pagination
 // Updated to work with variant-based pricing and stock
 func (r *ProductRepositoryImpl) FindAll(
@@ -120,8 +191,113 @@ func (r *ProductRepositoryImpl) FindAll(
 	var products []entity.Product
 	var total int64
 
-	query := db.DB(ctx).Model(&entity.Product{})
+	query := applyListingFilters(db.DB(ctx).Model(&entity.Product{}), filter)
+
+	// Count total
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// Apply pagination and sorting
+	offset := (page - 1) * limit
+	sortBy, ok := helper.NormalizeProductSortColumn(filter.SortBy)
+	if !ok {
+		return nil, 0, commonError.ErrValidation.WithMessagef("invalid sortBy field: %s", filter.SortBy)
+	}
+	sortOrder := filter.SortOrder
+	if sortOrder == "" {
+		sortOrder = "desc"
+	}
+
+	// Use eager loading to avoid N+1 queries; a sparse fieldset without the category
+	// skips its join
+	if utils.RequestedProductFields(ctx).Includes(utils.PRODUCT_CATEGORY_FIELD) {
+		query = query.Preload("Category").Preload("Category.Parent")
+	}
+	query = query.Offset(offset).
+		Limit(limit).
+		Order(productQuery.DEMOTED_SELLER_ORDER)
+	if filter.DemoteOutOfStock {
+		query = query.Order(productQuery.OUT_OF_STOCK_LAST_ORDER)
+	}
+	query = query.Order(sortBy + " " + sortOrder)
+
+	if err := query.Find(&products).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return products, total, nil
+}
+
+// Search searches products with query and filters
+// Updated to work with variant-based pricing and stock
+func (r *ProductRepositoryImpl) Search(
+	ctx context.Context,
+	terms [][]string,
+	filters map[string]any,
+	page, limit int,
+	ranking model.SearchRanking,
+) ([]entity.Product, int64, error) {
+	var products []entity.Product
+	var total int64
+
+	dbQuery := applySearchFilters(db.DB(ctx).Model(&entity.Product{}), terms, filters)
 
+	// Count total
+	if err := dbQuery.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// Apply ordering: demoted sellers last, out-of-stock products last when the seller
+	// demotes them, then weighted relevance or a regular product sort
+	dbQuery = dbQuery.Order(productQuery.DEMOTED_SELLER_ORDER)
+	if ranking.DemoteOutOfStock {
+		dbQuery = dbQuery.Order(productQuery.OUT_OF_STOCK_LAST_ORDER)
+	}
+	if ranking.SortBy == utils.RELEVANCE_SORT_KEY && len(terms) > 0 {
+		pattern := utils.AllSearchPatterns(terms)
+		w := ranking.Weights
+		dbQuery = dbQuery.Order(clause.OrderBy{Expression: clause.Expr{
+			SQL: productQuery.SEARCH_RELEVANCE_ORDER,
+			Vars: []any{
+				pattern, pattern, w.Name,
+				pattern, w.Tags,
+				pattern, pattern, w.Description,
+			},
+			WithoutParentheses: true,
+		}})
+	} else {
+		sortBy := ranking.SortBy
+		if sortBy == utils.RELEVANCE_SORT_KEY {
+			sortBy = ""
+		}
+		column, ok := helper.NormalizeProductSortColumn(sortBy)
+		if !ok {
+			return nil, 0, productError.ErrInvalidSearchSort
+		}
+		sortOrder := "desc"
+		if strings.EqualFold(ranking.SortOrder, "asc") {
+			sortOrder = "asc"
+		}
+		dbQuery = dbQuery.Order(column + " " + sortOrder)
+	}
+
+	// Apply pagination and eager loading
+	offset := (page - 1) * limit
+	if utils.RequestedProductFields(ctx).Includes(utils.PRODUCT_CATEGORY_FIELD) {
+		dbQuery = dbQuery.Preload("Category").Preload("Category.Parent")
+	}
+	dbQuery = dbQuery.Offset(offset).Limit(limit)
+
+	if err := dbQuery.Find(&products).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return products, total, nil
+}
+
+// applyListingFilters narrows a product query to the products a listing filter matches
+func applyListingFilters(query *gorm.DB, filter model.GetProductsFilter) *gorm.DB {
 	// Apply filters
 	// Multi-tenant filter: seller_id (CRITICAL for data isolation)
 	if filter.SellerID != nil {
@@ -164,57 +340,29 @@ func (r *ProductRepositoryImpl) FindAll(
 		query = query.Where(productQuery.FILTER_IS_POPULAR_SUBQUERY, *filter.IsPopular)
 	}
 
-	// Count total
-	if err := query.Count(&total).Error; err != nil {
-		return nil, 0, err
-	}
-
-	// Apply pagination and sorting
-	offset := (page - 1) * limit
-	sortBy, ok := helper.NormalizeProductSortColumn(filter.SortBy)
-	if !ok {
-		return nil, 0, commonError.ErrValidation.WithMessagef("invalid sortBy field: %s", filter.SortBy)
-	}
-	sortOrder := filter.SortOrder
-	if sortOrder == "" {
-		sortOrder = "desc"
-	}
-
-	// Use eager loading to avoid N+1 queries
-	query = query.Preload("Category").
-		Preload("Category.Parent").
-		Offset(offset).
-		Limit(limit).
-		Order(sortBy + " " + sortOrder)
-
-	if err := query.Find(&products).Error; err != nil {
-		return nil, 0, err
+	// Option value filter: one in-stock variant must match every option
+	if len(filter.OptionValues) > 0 {
+		variantQuery := productQuery.FILTER_OPTION_VALUES_VARIANT_SUBQUERY
+		args := make([]any, 0, 2*len(filter.OptionValues))
+		for _, option := range filter.OptionValues {
+			variantQuery += " AND " + productQuery.FILTER_OPTION_VALUE_CONDITION
+			args = append(args, option.Name, option.Values)
+		}
+		query = query.Where("EXISTS ("+variantQuery+")", args...)
 	}
-
-	return products, total, nil
+	return query
 }
 
-// Search searches products with query and filters
-// Updated to work with variant-based pricing and stock
-func (r *ProductRepositoryImpl) Search(
-	ctx context.Context,
-	query string,
-	filters map[string]any,
-	page, limit int,
-) ([]entity.Product, int64, error) {
-	var products []entity.Product
-	var total int64
-
-	dbQuery := db.DB(ctx).Model(&entity.Product{})
-
-	// Apply search query
-	if query != "" {
+// applySearchFilters narrows a product query to the products matching every search term
+// and the search filters
+func applySearchFilters(dbQuery *gorm.DB, terms [][]string, filters map[string]any) *gorm.DB {
+	// Apply each search term against name, tags and short description in every locale
+	for _, alternatives := range terms {
+		patterns := utils.SearchPatterns(alternatives...)
 		dbQuery = dbQuery.Where(
-			`name ILIKE ? OR short_description ILIKE ? OR EXISTS (
-				SELECT 1
-				FROM unnest(tags) AS tag
-				WHERE tag ILIKE ?
-			)`, "%"+query+"%", "%"+query+"%", "%"+query+"%")
+			productQuery.SEARCH_MATCH_FILTER,
+			patterns, patterns, patterns, patterns, patterns,
+		)
 	}
 
 	// Apply filters
@@ -250,25 +398,121 @@ func (r *ProductRepositoryImpl) Search(
 	if isPopular, exists := filters["isPopular"]; exists {
 		dbQuery = dbQuery.Where(productQuery.FILTER_IS_POPULAR_SUBQUERY, isPopular)
 	}
+	return dbQuery
+}
 
-	// Count total
-	if err := dbQuery.Count(&total).Error; err != nil {
-		return nil, 0, err
-	}
+// FindSearchMatches reports which searchable fields of the products match any term
+func (r *ProductRepositoryImpl) FindSearchMatches(
+	ctx context.Context,
+	productIDs []uint,
+	terms [][]string,
+) ([]mapper.SearchMatchRow, error) {
+	var rows []mapper.SearchMatchRow
+	if len(productIDs) == 0 || len(terms) == 0 {
+		return rows, nil
+	}
+	pattern := utils.AllSearchPatterns(terms)
+	err := db.DB(ctx).
+		Raw(
+			productQuery.SEARCH_MATCH_FLAGS_QUERY,
+			pattern, pattern, pattern, pattern, pattern, productIDs,
+		).
+		Scan(&rows).Error
+	return rows, err
+}
 
-	// Apply pagination and eager loading
-	offset := (page - 1) * limit
-	dbQuery = dbQuery.Preload("Category").
-		Preload("Category.Parent").
-		Offset(offset).
-		Limit(limit).
-		Order("created_at DESC")
+// FindListingFacets returns the facet counts of the products a listing filter matches
+func (r *ProductRepositoryImpl) FindListingFacets(
+	ctx context.Context,
+	filter model.GetProductsFilter,
+) (*mapper.ProductFacetData, error) {
+	return findFacets(ctx, func() *gorm.DB {
+		return applyListingFilters(db.DB(ctx).Model(&entity.Product{}).Select("id"), filter)
+	})
+}
 
-	if err := dbQuery.Find(&products).Error; err != nil {
-		return nil, 0, err
+// FindSearchFacets returns the facet counts of the products a search matches
+func (r *ProductRepositoryImpl) FindSearchFacets(
+	ctx context.Context,
+	terms [][]string,
+	filters map[string]any,
+) (*mapper.ProductFacetData, error) {
+	return findFacets(ctx, func() *gorm.DB {
+		return applySearchFilters(db.DB(ctx).Model(&entity.Product{}).Select("id"), terms, filters)
+	})
+}
+
+// findFacets runs the facet queries against the product IDs matchedIDs selects. Price
+// buckets are counted once the price range is known.
+func findFacets(
+	ctx context.Context,
+	matchedIDs func() *gorm.DB,
+) (*mapper.ProductFacetData, error) {
+	var facets mapper.ProductFacetData
+	conn := db.DB(ctx)
+
+	err := conn.Raw(productQuery.FACET_BRANDS_QUERY, matchedIDs(), utils.FACET_MAX_BRANDS).
+		Scan(&facets.Brands).Error
+	if err != nil {
+		return nil, err
+	}
+	err = conn.Raw(productQuery.FACET_CATEGORIES_QUERY, matchedIDs(), utils.FACET_MAX_CATEGORIES).
+		Scan(&facets.Categories).Error
+	if err != nil {
+		return nil, err
+	}
+	err = conn.Raw(productQuery.FACET_OPTION_VALUES_QUERY, matchedIDs()).
+		Scan(&facets.OptionValues).Error
+	if err != nil {
+		return nil, err
 	}
 
-	return products, total, nil
+	err = conn.Raw(productQuery.FACET_PRICE_RANGE_QUERY, matchedIDs()).
+		Scan(&facets.PriceRange).Error
+	if err != nil {
+		return nil, err
+	}
+	priceRange := facets.PriceRange
+	facets.PriceEdges = utils.PriceBucketEdges(priceRange.MinPrice, priceRange.MaxPrice)
+	if len(facets.PriceEdges) > 0 {
+		err = conn.Raw(
+			productQuery.FACET_PRICE_BUCKETS_QUERY,
+			db.Float64Array(facets.PriceEdges),
+			matchedIDs(),
+		).Scan(&facets.PriceBuckets).Error
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &facets, nil
+}
+
+// FindSuggestions runs the suggestion query in a read-only transaction so the trigram
+// cut-off for typo matches applies to this query alone
+func (r *ProductRepositoryImpl) FindSuggestions(
+	ctx context.Context,
+	prefix string,
+	sellerID *uint,
+	limit int,
+) ([]mapper.SuggestionRow, error) {
+	var rows []mapper.SuggestionRow
+	startPattern, wordPattern := utils.SuggestPatterns(prefix)
+	err := db.WithTransaction(ctx, func(txCtx context.Context) error {
+		tx := db.DB(txCtx)
+
+		err := tx.Exec(
+			productQuery.SET_SUGGEST_SIMILARITY_QUERY,
+			utils.SEARCH_SUGGEST_SIMILARITY_THRESHOLD,
+		).Error
+		if err != nil {
+			return err
+		}
+		return tx.Raw(
+			productQuery.SEARCH_SUGGEST_QUERY,
+			prefix, startPattern, wordPattern, sellerID, limit,
+		).Scan(&rows).Error
+	}, &sql.TxOptions{ReadOnly: true})
+	return rows, err
 }
 
 // SoftDelete soft deletes a product
@@ -366,7 +610,9 @@ func (r *ProductRepositoryImpl) GetProductFilters(ctx context.Context, sellerID
 	var variantOptions []mapper.VariantOptionData
 	var stockStatus mapper.StockStatusData
 
-	err := db.DB(ctx).Transaction(func(tx *gorm.DB) error {
+	err := db.WithTransaction(ctx, func(txCtx context.Context) error {
+		tx := db.DB(txCtx)
+
 		// Brands query with optional seller filter
 		if sellerID != nil {
 			// Multi-tenant: Filter by seller_id
@@ -473,4 +719,3 @@ func (r *ProductRepositoryImpl) GetProductFilters(ctx context.Context, sellerID
 
 	return brands, categories, attributes, &priceRange, variantOptions, &stockStatus, nil
 }
-
@@ -0,0 +1,143 @@
+package repository
+
+import (
+	"context"
+
+	"ecommerce-be/common/db"
+	"ecommerce-be/product/entity"
+	"ecommerce-be/product/mapper"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ProductTranslationRepository defines database operations for per-locale product content
+type ProductTranslationRepository interface {
+	// FindByProductIDs returns product translations; nil locales means every locale
+	FindByProductIDs(
+		ctx context.Context,
+		productIDs []uint,
+		locales []string,
+	) ([]entity.ProductTranslation, error)
+	// FindOptionTranslations returns the products' option translations; nil locales means all
+	FindOptionTranslations(
+		ctx context.Context,
+		productIDs []uint,
+		locales []string,
+	) ([]mapper.OptionTranslationRow, error)
+	Upsert(ctx context.Context, translation *entity.ProductTranslation) error
+	ReplaceOptionTranslations(
+		ctx context.Context,
+		productID uint,
+		locale string,
+		translations []entity.ProductOptionTranslation,
+	) error
+	DeleteByProductAndLocale(ctx context.Context, productID uint, locale string) (int64, error)
+}
+
+// ProductTranslationRepositoryImpl implements ProductTranslationRepository
+type ProductTranslationRepositoryImpl struct{}
+
+// NewProductTranslationRepository creates a new ProductTranslationRepository
+func NewProductTranslationRepository() ProductTranslationRepository {
+	return &ProductTranslationRepositoryImpl{}
+}
+
+// FindByProductIDs returns product translations; nil locales means every locale
+func (r *ProductTranslationRepositoryImpl) FindByProductIDs(
+	ctx context.Context,
+	productIDs []uint,
+	locales []string,
+) ([]entity.ProductTranslation, error) {
+	var translations []entity.ProductTranslation
+	if len(productIDs) == 0 {
+		return translations, nil
+	}
+	query := db.DB(ctx).Where("product_id IN ?", productIDs)
+	if locales != nil {
+		query = query.Where("locale IN ?", locales)
+	}
+	err := query.Order("product_id ASC, locale ASC").Find(&translations).Error
+	return translations, err
+}
+
+// FindOptionTranslations returns the products' option translations; nil locales means all
+func (r *ProductTranslationRepositoryImpl) FindOptionTranslations(
+	ctx context.Context,
+	productIDs []uint,
+	locales []string,
+) ([]mapper.OptionTranslationRow, error) {
+	var rows []mapper.OptionTranslationRow
+	if len(productIDs) == 0 {
+		return rows, nil
+	}
+	query := db.DB(ctx).
+		Table("product_option_translation pot").
+		Select("pot.option_id, po.product_id, po.name AS option_name, pot.locale, pot.display_name").
+		Joins("JOIN product_option po ON po.id = pot.option_id").
+		Where("po.product_id IN ?", productIDs)
+	if locales != nil {
+		query = query.Where("pot.locale IN ?", locales)
+	}
+	err := query.Order("po.product_id ASC, pot.locale ASC, po.position ASC").Scan(&rows).Error
+	return rows, err
+}
+
+// Upsert creates or replaces the product's content for the translation's locale
+func (r *ProductTranslationRepositoryImpl) Upsert(
+	ctx context.Context,
+	translation *entity.ProductTranslation,
+) error {
+	return db.DB(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "product_id"}, {Name: "locale"}},
+		DoUpdates: clause.AssignmentColumns(
+			[]string{"name", "short_description", "long_description", "updated_at"},
+		),
+	}).Create(translation).Error
+}
+
+// ReplaceOptionTranslations swaps the product's option display names for one locale
+func (r *ProductTranslationRepositoryImpl) ReplaceOptionTranslations(
+	ctx context.Context,
+	productID uint,
+	locale string,
+	translations []entity.ProductOptionTranslation,
+) error {
+	if err := r.deleteOptionTranslations(ctx, productID, locale).Error; err != nil {
+		return err
+	}
+	if len(translations) == 0 {
+		return nil
+	}
+	return db.DB(ctx).Create(&translations).Error
+}
+
+// DeleteByProductAndLocale removes the product's content and option names for one locale
+func (r *ProductTranslationRepositoryImpl) DeleteByProductAndLocale(
+	ctx context.Context,
+	productID uint,
+	locale string,
+) (int64, error) {
+	options := r.deleteOptionTranslations(ctx, productID, locale)
+	if options.Error != nil {
+		return 0, options.Error
+	}
+	content := db.DB(ctx).
+		Where("product_id = ? AND locale = ?", productID, locale).
+		Delete(&entity.ProductTranslation{})
+	return content.RowsAffected + options.RowsAffected, content.Error
+}
+
+func (r *ProductTranslationRepositoryImpl) deleteOptionTranslations(
+	ctx context.Context,
+	productID uint,
+	locale string,
+) *gorm.DB {
+	return db.DB(ctx).
+		Where(
+			"locale = ? AND option_id IN (SELECT id FROM product_option WHERE product_id = ?)",
+			locale,
+			productID,
+		).
+		Delete(&entity.ProductOptionTranslation{})
+}
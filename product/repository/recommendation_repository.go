@@ -0,0 +1,144 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"ecommerce-be/common/db"
+	"ecommerce-be/product/entity"
+	productQuery "ecommerce-be/product/query"
+)
+
+// RecommendationRepository defines database operations for precomputed recommendations
+type RecommendationRepository interface {
+	// RefreshCoPurchases upserts every product's co-purchases from orders placed since
+	// the given time, stamped with computedAt, and deletes the rows it did not write.
+	// Returns the number of rows written.
+	RefreshCoPurchases(
+		ctx context.Context,
+		since time.Time,
+		computedAt time.Time,
+		minCustomers int,
+		perProduct int,
+	) (int64, error)
+	// RefreshUserRecommendations does the same for every customer's recommendations,
+	// from the current co-purchases
+	RefreshUserRecommendations(
+		ctx context.Context,
+		since time.Time,
+		computedAt time.Time,
+		perUser int,
+	) (int64, error)
+	// FindAlsoBought returns the IDs of the products most often bought with the product
+	FindAlsoBought(ctx context.Context, productID uint, sellerID *uint, limit int) ([]uint, error)
+	// FindUserRecommendations returns the IDs of the products recommended to the customer
+	FindUserRecommendations(
+		ctx context.Context,
+		userID uint,
+		sellerID *uint,
+		limit int,
+	) ([]uint, error)
+}
+
+// RecommendationRepositoryImpl implements RecommendationRepository
+type RecommendationRepositoryImpl struct{}
+
+// NewRecommendationRepository creates a new RecommendationRepository
+func NewRecommendationRepository() RecommendationRepository {
+	return &RecommendationRepositoryImpl{}
+}
+
+// RefreshCoPurchases upserts every product's co-purchases and then deletes the pairs
+// older than computedAt, in one transaction so readers see either rebuild in full
+func (r *RecommendationRepositoryImpl) RefreshCoPurchases(
+	ctx context.Context,
+	since time.Time,
+	computedAt time.Time,
+	minCustomers int,
+	perProduct int,
+) (int64, error) {
+	return db.WithTransactionResult(ctx, func(txCtx context.Context) (int64, error) {
+		result := db.DB(txCtx).Exec(
+			productQuery.REBUILD_CO_PURCHASES_QUERY,
+			since,
+			minCustomers,
+			perProduct,
+			computedAt,
+		)
+		if result.Error != nil {
+			return 0, result.Error
+		}
+		err := db.DB(txCtx).Exec(productQuery.DELETE_STALE_CO_PURCHASES_QUERY, computedAt).Error
+		return result.RowsAffected, err
+	})
+}
+
+// RefreshUserRecommendations upserts every customer's recommendations and then deletes
+// the ones older than computedAt, in one transaction
+func (r *RecommendationRepositoryImpl) RefreshUserRecommendations(
+	ctx context.Context,
+	since time.Time,
+	computedAt time.Time,
+	perUser int,
+) (int64, error) {
+	return db.WithTransactionResult(ctx, func(txCtx context.Context) (int64, error) {
+		result := db.DB(txCtx).Exec(
+			productQuery.REBUILD_USER_RECOMMENDATIONS_QUERY,
+			since,
+			perUser,
+			computedAt,
+		)
+		if result.Error != nil {
+			return 0, result.Error
+		}
+		err := db.DB(txCtx).
+			Exec(productQuery.DELETE_STALE_USER_RECOMMENDATIONS_QUERY, computedAt).Error
+		return result.RowsAffected, err
+	})
+}
+
+// FindAlsoBought returns the IDs of the products most often bought with the product,
+// restricted to the seller when one is given
+func (r *RecommendationRepositoryImpl) FindAlsoBought(
+	ctx context.Context,
+	productID uint,
+	sellerID *uint,
+	limit int,
+) ([]uint, error) {
+	query := db.DB(ctx).Model(&entity.ProductCoPurchase{}).Where("product_id = ?", productID)
+	if sellerID != nil {
+		query = query.Where("seller_id = ?", *sellerID)
+	}
+	var productIDs []uint
+	err := query.
+		Order("customer_count DESC, related_product_id DESC").
+		Limit(limit).
+		Pluck("related_product_id", &productIDs).Error
+	if err != nil {
+		return nil, err
+	}
+	return productIDs, nil
+}
+
+// FindUserRecommendations returns the IDs of the products recommended to the customer,
+// restricted to the seller when one is given
+func (r *RecommendationRepositoryImpl) FindUserRecommendations(
+	ctx context.Context,
+	userID uint,
+	sellerID *uint,
+	limit int,
+) ([]uint, error) {
+	query := db.DB(ctx).Model(&entity.UserProductRecommendation{}).Where("user_id = ?", userID)
+	if sellerID != nil {
+		query = query.Where("seller_id = ?", *sellerID)
+	}
+	var productIDs []uint
+	err := query.
+		Order("score DESC, product_id DESC").
+		Limit(limit).
+		Pluck("product_id", &productIDs).Error
+	if err != nil {
+		return nil, err
+	}
+	return productIDs, nil
+}
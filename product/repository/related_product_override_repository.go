@@ -0,0 +1,72 @@
+package repository
+
+import (
+	"context"
+
+	"ecommerce-be/common/db"
+	"ecommerce-be/product/entity"
+)
+
+// RelatedProductOverrideRepository defines database operations for seller curation of
+// related products
+type RelatedProductOverrideRepository interface {
+	// FindByProductID returns the pins in position order followed by the exclusions
+	FindByProductID(ctx context.Context, productID uint) ([]entity.RelatedProductOverride, error)
+	// ReplaceForProduct swaps every override of the product for the given ones
+	ReplaceForProduct(
+		ctx context.Context,
+		productID uint,
+		overrides []entity.RelatedProductOverride,
+	) error
+	DeleteByProductID(ctx context.Context, productID uint) error
+}
+
+// RelatedProductOverrideRepositoryImpl implements RelatedProductOverrideRepository
+type RelatedProductOverrideRepositoryImpl struct{}
+
+// NewRelatedProductOverrideRepository creates a new RelatedProductOverrideRepository
+func NewRelatedProductOverrideRepository() RelatedProductOverrideRepository {
+	return &RelatedProductOverrideRepositoryImpl{}
+}
+
+// FindByProductID returns the pins in position order followed by the exclusions
+func (r *RelatedProductOverrideRepositoryImpl) FindByProductID(
+	ctx context.Context,
+	productID uint,
+) ([]entity.RelatedProductOverride, error) {
+	var overrides []entity.RelatedProductOverride
+	err := db.DB(ctx).
+		Where("product_id = ?", productID).
+		Order("CASE WHEN mode = 'pin' THEN 0 ELSE 1 END, position ASC, id ASC").
+		Find(&overrides).Error
+	if err != nil {
+		return nil, err
+	}
+	return overrides, nil
+}
+
+// ReplaceForProduct swaps every override of the product for the given ones. Callers run
+// it in a transaction so readers never see a half-written set.
+func (r *RelatedProductOverrideRepositoryImpl) ReplaceForProduct(
+	ctx context.Context,
+	productID uint,
+	overrides []entity.RelatedProductOverride,
+) error {
+	if err := r.DeleteByProductID(ctx, productID); err != nil {
+		return err
+	}
+	if len(overrides) == 0 {
+		return nil
+	}
+	return db.DB(ctx).Create(&overrides).Error
+}
+
+// DeleteByProductID removes every override of the product
+func (r *RelatedProductOverrideRepositoryImpl) DeleteByProductID(
+	ctx context.Context,
+	productID uint,
+) error {
+	return db.DB(ctx).
+		Where("product_id = ?", productID).
+		Delete(&entity.RelatedProductOverride{}).Error
+}
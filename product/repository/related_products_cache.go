@@ -0,0 +1,49 @@
+package repository
+
+import (
+	"context"
+
+	"ecommerce-be/common/cache"
+	"ecommerce-be/common/db"
+	"ecommerce-be/common/log"
+	"ecommerce-be/product/utils"
+)
+
+// RelatedProductsCacheKey is the key of one page of scored related products for
+// strategies normalized by utils.NormalizeRelatedStrategies. Pages live
+// under a namespace per seller scope, itself versioned under the related products
+// namespace: a seller's writes drop that seller's pages, category writes drop them all.
+func RelatedProductsCacheKey(
+	sellerID *uint,
+	productID uint,
+	strategies string,
+	limit, offset int,
+) string {
+	return cache.VersionedKey(
+		relatedProductsScopeNamespace(sellerScope(sellerID)),
+		productID,
+		strategies,
+		limit,
+		offset,
+	)
+}
+
+// InvalidateRelatedProductsCache drops the cached related products of a seller's
+// storefront and of the admin view once the current transaction commits. Each scope is
+// bumped on its own; failures are logged only and those pages expire with their TTL.
+func InvalidateRelatedProductsCache(ctx context.Context, sellerID uint) {
+	db.AfterCommit(ctx, func() {
+		for _, scope := range []string{sellerScope(&sellerID), sellerScope(nil)} {
+			if err := cache.BumpNamespaceVersion(relatedProductsScopeNamespace(scope)); err != nil {
+				log.WarnWithContext(ctx, "invalidateRelatedProductsCache: "+err.Error())
+				continue
+			}
+		}
+	})
+}
+
+// relatedProductsScopeNamespace is the namespace of one seller scope under the current
+// version of the related products namespace
+func relatedProductsScopeNamespace(scope string) string {
+	return cache.VersionedKey(utils.RELATED_PRODUCTS_CACHE_NAMESPACE, scope)
+}
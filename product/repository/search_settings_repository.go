@@ -0,0 +1,130 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"ecommerce-be/common/db"
+	"ecommerce-be/product/entity"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// SearchSettingsRepository defines database operations for seller search settings
+type SearchSettingsRepository interface {
+	// FindBySellerID returns nil, nil when the seller has not saved settings
+	FindBySellerID(ctx context.Context, sellerID uint) (*entity.SearchSettings, error)
+	Upsert(ctx context.Context, settings *entity.SearchSettings) error
+	// UpsertStopWords replaces the seller's stop words, creating default settings if needed
+	UpsertStopWords(ctx context.Context, settings *entity.SearchSettings) error
+
+	FindSynonymGroups(ctx context.Context, sellerID uint) ([]entity.SearchSynonymGroup, error)
+	// FindSynonymGroup returns nil, nil when the group does not exist for the seller
+	FindSynonymGroup(
+		ctx context.Context,
+		sellerID, groupID uint,
+	) (*entity.SearchSynonymGroup, error)
+	SaveSynonymGroup(ctx context.Context, group *entity.SearchSynonymGroup) error
+	// DeleteSynonymGroup reports whether the seller had the group
+	DeleteSynonymGroup(ctx context.Context, sellerID, groupID uint) (bool, error)
+}
+
+// SearchSettingsRepositoryImpl implements SearchSettingsRepository
+type SearchSettingsRepositoryImpl struct{}
+
+// NewSearchSettingsRepository creates a new SearchSettingsRepository
+func NewSearchSettingsRepository() SearchSettingsRepository {
+	return &SearchSettingsRepositoryImpl{}
+}
+
+// FindBySellerID returns nil, nil when the seller has not saved settings
+func (r *SearchSettingsRepositoryImpl) FindBySellerID(
+	ctx context.Context,
+	sellerID uint,
+) (*entity.SearchSettings, error) {
+	var settings entity.SearchSettings
+	err := db.DB(ctx).Where("seller_id = ?", sellerID).First(&settings).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &settings, nil
+}
+
+// Upsert creates or replaces the seller's settings
+func (r *SearchSettingsRepositoryImpl) Upsert(
+	ctx context.Context,
+	settings *entity.SearchSettings,
+) error {
+	return db.DB(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "seller_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{
+			"default_sort",
+			"default_sort_order",
+			"name_weight",
+			"tags_weight",
+			"description_weight",
+			"out_of_stock_display",
+			"updated_at",
+		}),
+	}).Create(settings).Error
+}
+
+// UpsertStopWords replaces the seller's stop words, creating default settings if needed
+func (r *SearchSettingsRepositoryImpl) UpsertStopWords(
+	ctx context.Context,
+	settings *entity.SearchSettings,
+) error {
+	return db.DB(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "seller_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"stop_words", "updated_at"}),
+	}).Create(settings).Error
+}
+
+// FindSynonymGroups returns the seller's synonym groups, oldest first
+func (r *SearchSettingsRepositoryImpl) FindSynonymGroups(
+	ctx context.Context,
+	sellerID uint,
+) ([]entity.SearchSynonymGroup, error) {
+	var groups []entity.SearchSynonymGroup
+	err := db.DB(ctx).Where("seller_id = ?", sellerID).Order("id ASC").Find(&groups).Error
+	return groups, err
+}
+
+// FindSynonymGroup returns nil, nil when the group does not exist for the seller
+func (r *SearchSettingsRepositoryImpl) FindSynonymGroup(
+	ctx context.Context,
+	sellerID, groupID uint,
+) (*entity.SearchSynonymGroup, error) {
+	var group entity.SearchSynonymGroup
+	err := db.DB(ctx).Where("id = ? AND seller_id = ?", groupID, sellerID).First(&group).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &group, nil
+}
+
+// SaveSynonymGroup creates or updates a synonym group
+func (r *SearchSettingsRepositoryImpl) SaveSynonymGroup(
+	ctx context.Context,
+	group *entity.SearchSynonymGroup,
+) error {
+	return db.DB(ctx).Save(group).Error
+}
+
+// DeleteSynonymGroup reports whether the seller had the group
+func (r *SearchSettingsRepositoryImpl) DeleteSynonymGroup(
+	ctx context.Context,
+	sellerID, groupID uint,
+) (bool, error) {
+	result := db.DB(ctx).
+		Where("id = ? AND seller_id = ?", groupID, sellerID).
+		Delete(&entity.SearchSynonymGroup{})
+	return result.RowsAffected > 0, result.Error
+}
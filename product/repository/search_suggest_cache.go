@@ -0,0 +1,38 @@
+package repository
+
+import (
+	"context"
+
+	"ecommerce-be/common/cache"
+	"ecommerce-be/common/db"
+	"ecommerce-be/common/log"
+	"ecommerce-be/product/utils"
+)
+
+// SearchSuggestCacheKey is the key of the suggestions for a normalized prefix. Like
+// related products, suggestions live under a namespace per seller scope, itself
+// versioned under the suggestions namespace: a seller's product writes drop that
+// seller's suggestions, category writes drop them all.
+func SearchSuggestCacheKey(sellerID *uint, prefix string, limit int) string {
+	return cache.VersionedKey(searchSuggestScopeNamespace(sellerScope(sellerID)), prefix, limit)
+}
+
+// InvalidateSearchSuggestCache drops the cached suggestions of a seller's storefront
+// and of the admin view once the current transaction commits. Failures are logged
+// only; the suggestions expire with their TTL.
+func InvalidateSearchSuggestCache(ctx context.Context, sellerID uint) {
+	db.AfterCommit(ctx, func() {
+		for _, scope := range []string{sellerScope(&sellerID), sellerScope(nil)} {
+			if err := cache.BumpNamespaceVersion(searchSuggestScopeNamespace(scope)); err != nil {
+				log.WarnWithContext(ctx, "invalidateSearchSuggestCache: "+err.Error())
+				return
+			}
+		}
+	})
+}
+
+// searchSuggestScopeNamespace is the namespace of one seller scope under the current
+// version of the suggestions namespace
+func searchSuggestScopeNamespace(scope string) string {
+	return cache.VersionedKey(utils.SEARCH_SUGGEST_CACHE_NAMESPACE, scope)
+}
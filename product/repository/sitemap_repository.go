@@ -0,0 +1,57 @@
+package repository
+
+import (
+	"context"
+
+	"ecommerce-be/common/db"
+	"ecommerce-be/product/mapper"
+	"ecommerce-be/product/query"
+)
+
+// SitemapRepository reads the published URLs that make up a seller's sitemap
+type SitemapRepository interface {
+	// FindProductEntries returns the seller's published products, oldest first
+	FindProductEntries(ctx context.Context, sellerID uint) ([]mapper.SitemapEntryRow, error)
+	// FindCategoryEntries returns the categories holding the seller's published products
+	FindCategoryEntries(ctx context.Context, sellerID uint) ([]mapper.SitemapEntryRow, error)
+	// FindSellerIDs returns the sellers with at least one published product
+	FindSellerIDs(ctx context.Context) ([]uint, error)
+}
+
+// SitemapRepositoryImpl implements SitemapRepository
+type SitemapRepositoryImpl struct{}
+
+// NewSitemapRepository creates a new SitemapRepository
+func NewSitemapRepository() SitemapRepository {
+	return &SitemapRepositoryImpl{}
+}
+
+// FindProductEntries returns the slug and last update of the seller's published products
+func (r *SitemapRepositoryImpl) FindProductEntries(
+	ctx context.Context,
+	sellerID uint,
+) ([]mapper.SitemapEntryRow, error) {
+	var rows []mapper.SitemapEntryRow
+	err := db.DB(ctx).Raw(query.FIND_SITEMAP_PRODUCTS_QUERY, sellerID).Scan(&rows).Error
+	return rows, err
+}
+
+// FindCategoryEntries returns the slug and last update of the global and seller
+// categories that hold at least one of the seller's published products
+func (r *SitemapRepositoryImpl) FindCategoryEntries(
+	ctx context.Context,
+	sellerID uint,
+) ([]mapper.SitemapEntryRow, error) {
+	var rows []mapper.SitemapEntryRow
+	err := db.DB(ctx).
+		Raw(query.FIND_SITEMAP_CATEGORIES_QUERY, sellerID, sellerID).
+		Scan(&rows).Error
+	return rows, err
+}
+
+// FindSellerIDs returns the sellers the refresh job builds sitemaps for
+func (r *SitemapRepositoryImpl) FindSellerIDs(ctx context.Context) ([]uint, error) {
+	var sellerIDs []uint
+	err := db.DB(ctx).Raw(query.FIND_SITEMAP_SELLER_IDS_QUERY).Scan(&sellerIDs).Error
+	return sellerIDs, err
+}
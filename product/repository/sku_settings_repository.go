@@ -0,0 +1,112 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"ecommerce-be/common/db"
+	"ecommerce-be/product/entity"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// SKUSettingsRepository defines database operations for seller SKU generation settings
+type SKUSettingsRepository interface {
+	// FindBySellerID returns nil, nil when the seller has no settings row
+	FindBySellerID(ctx context.Context, sellerID uint) (*entity.SKUSettings, error)
+	Upsert(ctx context.Context, settings *entity.SKUSettings) error
+	// ReserveSequence atomically takes the seller's next {SEQ} value, creating the row
+	// with the default patterns on first use
+	ReserveSequence(ctx context.Context, sellerID uint) (int64, error)
+	// FindExistingSKUs returns which of the given SKUs the seller's products or
+	// variants already use
+	FindExistingSKUs(ctx context.Context, sellerID uint, skus []string) ([]string, error)
+}
+
+// SKUSettingsRepositoryImpl implements SKUSettingsRepository
+type SKUSettingsRepositoryImpl struct{}
+
+// NewSKUSettingsRepository creates a new SKUSettingsRepository
+func NewSKUSettingsRepository() SKUSettingsRepository {
+	return &SKUSettingsRepositoryImpl{}
+}
+
+// FindBySellerID returns nil, nil when the seller has no settings row
+func (r *SKUSettingsRepositoryImpl) FindBySellerID(
+	ctx context.Context,
+	sellerID uint,
+) (*entity.SKUSettings, error) {
+	var settings entity.SKUSettings
+	err := db.DB(ctx).Where("seller_id = ?", sellerID).First(&settings).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &settings, nil
+}
+
+// Upsert creates or replaces the seller's patterns. The sequence counter is left alone
+// so saving settings never reissues numbers.
+func (r *SKUSettingsRepositoryImpl) Upsert(
+	ctx context.Context,
+	settings *entity.SKUSettings,
+) error {
+	return db.DB(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "seller_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{
+			"product_pattern",
+			"variant_pattern",
+			"updated_at",
+		}),
+	}).Create(settings).Error
+}
+
+// ReserveSequence atomically takes the seller's next {SEQ} value
+func (r *SKUSettingsRepositoryImpl) ReserveSequence(
+	ctx context.Context,
+	sellerID uint,
+) (int64, error) {
+	var sequence int64
+	now := time.Now()
+	err := db.DB(ctx).Raw(`
+		INSERT INTO product_sku_settings
+			(seller_id, product_pattern, variant_pattern, next_sequence, created_at, updated_at)
+		VALUES (?, ?, ?, 2, ?, ?)
+		ON CONFLICT (seller_id) DO UPDATE
+			SET next_sequence = product_sku_settings.next_sequence + 1
+		RETURNING next_sequence - 1`,
+		sellerID,
+		entity.DEFAULT_PRODUCT_SKU_PATTERN,
+		entity.DEFAULT_VARIANT_SKU_PATTERN,
+		now,
+		now,
+	).Scan(&sequence).Error
+	return sequence, err
+}
+
+// FindExistingSKUs returns which of the given SKUs the seller already uses as a base
+// SKU or variant SKU
+func (r *SKUSettingsRepositoryImpl) FindExistingSKUs(
+	ctx context.Context,
+	sellerID uint,
+	skus []string,
+) ([]string, error) {
+	existing := make([]string, 0)
+	if len(skus) == 0 {
+		return existing, nil
+	}
+	err := db.DB(ctx).Raw(`
+		SELECT p.base_sku FROM product p
+		WHERE p.seller_id = ? AND p.base_sku IN ?
+		UNION
+		SELECT pv.sku FROM product_variant pv
+		JOIN product p ON p.id = pv.product_id
+		WHERE p.seller_id = ? AND pv.sku IN ?`,
+		sellerID, skus, sellerID, skus,
+	).Scan(&existing).Error
+	return existing, err
+}
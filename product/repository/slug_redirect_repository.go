@@ -0,0 +1,103 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"ecommerce-be/common/db"
+	"ecommerce-be/product/entity"
+
+	"gorm.io/gorm"
+)
+
+// SlugRedirectRepository defines database operations for redirects of changed slugs.
+// A nil sellerID addresses the global scope (global categories).
+type SlugRedirectRepository interface {
+	// Upsert points oldSlug at the entity, replacing an older redirect of the same slug
+	Upsert(ctx context.Context, redirect *entity.SlugRedirect) error
+	// FindBySlug returns the redirect of a slug in exactly one scope, or nil
+	FindBySlug(
+		ctx context.Context,
+		entityType string,
+		sellerID *uint,
+		slug string,
+	) (*entity.SlugRedirect, error)
+	// DeleteBySlug drops the redirect of a slug that an entity now uses again
+	DeleteBySlug(ctx context.Context, entityType string, sellerID *uint, slug string) error
+	// DeleteByEntity drops all redirects to a deleted entity
+	DeleteByEntity(ctx context.Context, entityType string, entityID uint) error
+}
+
+// SlugRedirectRepositoryImpl implements SlugRedirectRepository
+type SlugRedirectRepositoryImpl struct{}
+
+// NewSlugRedirectRepository creates a new SlugRedirectRepository
+func NewSlugRedirectRepository() SlugRedirectRepository {
+	return &SlugRedirectRepositoryImpl{}
+}
+
+// Upsert inserts the redirect or re-points the existing one for the same slug
+func (r *SlugRedirectRepositoryImpl) Upsert(
+	ctx context.Context,
+	redirect *entity.SlugRedirect,
+) error {
+	return db.DB(ctx).Exec(
+		`INSERT INTO slug_redirect (entity_type, seller_id, old_slug, entity_id)
+		VALUES (?, ?, ?, ?)
+		ON CONFLICT (entity_type, COALESCE(seller_id, 0), old_slug)
+		DO UPDATE SET entity_id = EXCLUDED.entity_id, updated_at = NOW()`,
+		redirect.EntityType, redirect.SellerID, redirect.OldSlug, redirect.EntityID,
+	).Error
+}
+
+// FindBySlug returns the redirect of a slug, or nil if there is none
+func (r *SlugRedirectRepositoryImpl) FindBySlug(
+	ctx context.Context,
+	entityType string,
+	sellerID *uint,
+	slug string,
+) (*entity.SlugRedirect, error) {
+	var redirect entity.SlugRedirect
+	err := scopeSlugRedirects(db.DB(ctx), entityType, sellerID).
+		Where("old_slug = ?", slug).
+		First(&redirect).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &redirect, nil
+}
+
+// DeleteBySlug deletes the redirect of a slug, if any
+func (r *SlugRedirectRepositoryImpl) DeleteBySlug(
+	ctx context.Context,
+	entityType string,
+	sellerID *uint,
+	slug string,
+) error {
+	return scopeSlugRedirects(db.DB(ctx), entityType, sellerID).
+		Where("old_slug = ?", slug).
+		Delete(&entity.SlugRedirect{}).Error
+}
+
+// DeleteByEntity deletes every redirect to an entity
+func (r *SlugRedirectRepositoryImpl) DeleteByEntity(
+	ctx context.Context,
+	entityType string,
+	entityID uint,
+) error {
+	return db.DB(ctx).
+		Where("entity_type = ? AND entity_id = ?", entityType, entityID).
+		Delete(&entity.SlugRedirect{}).Error
+}
+
+// scopeSlugRedirects restricts a query to one entity type and seller scope
+func scopeSlugRedirects(query *gorm.DB, entityType string, sellerID *uint) *gorm.DB {
+	query = query.Where("entity_type = ?", entityType)
+	if sellerID == nil {
+		return query.Where("seller_id IS NULL")
+	}
+	return query.Where("seller_id = ?", *sellerID)
+}
@@ -0,0 +1,152 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"ecommerce-be/common/db"
+	"ecommerce-be/product/entity"
+	prodErrors "ecommerce-be/product/error"
+
+	"gorm.io/gorm"
+)
+
+// SponsoredPlacementRepository defines database operations for sponsored search placements
+type SponsoredPlacementRepository interface {
+	Create(ctx context.Context, placement *entity.SponsoredPlacement) error
+	Update(ctx context.Context, placement *entity.SponsoredPlacement) error
+	// FindByID returns any seller's placement; ownership is checked by the caller
+	FindByID(ctx context.Context, placementID uint) (*entity.SponsoredPlacement, error)
+	FindBySellerID(ctx context.Context, sellerID uint) ([]entity.SponsoredPlacement, error)
+	// FindLive returns switched-on placements inside their schedule, optionally for one seller
+	FindLive(ctx context.Context, sellerID *uint, now time.Time) ([]entity.SponsoredPlacement, error)
+	Delete(ctx context.Context, placementID uint) error
+	IncrementImpressions(ctx context.Context, placementID uint) error
+	IncrementClicks(ctx context.Context, placementID uint) error
+}
+
+// SponsoredPlacementRepositoryImpl implements SponsoredPlacementRepository
+type SponsoredPlacementRepositoryImpl struct{}
+
+// NewSponsoredPlacementRepository creates a new SponsoredPlacementRepository
+func NewSponsoredPlacementRepository() SponsoredPlacementRepository {
+	return &SponsoredPlacementRepositoryImpl{}
+}
+
+// Create inserts a new placement
+func (r *SponsoredPlacementRepositoryImpl) Create(
+	ctx context.Context,
+	placement *entity.SponsoredPlacement,
+) error {
+	return db.DB(ctx).Create(placement).Error
+}
+
+// Update saves a placement's settings; delivery counters are left untouched
+func (r *SponsoredPlacementRepositoryImpl) Update(
+	ctx context.Context,
+	placement *entity.SponsoredPlacement,
+) error {
+	return db.DB(ctx).
+		Model(placement).
+		Select(
+			"product_id",
+			"position",
+			"keywords",
+			"category_id",
+			"frequency_cap",
+			"is_active",
+			"starts_at",
+			"ends_at",
+			"updated_at",
+		).
+		Updates(placement).Error
+}
+
+// FindByID returns a placement by ID
+func (r *SponsoredPlacementRepositoryImpl) FindByID(
+	ctx context.Context,
+	placementID uint,
+) (*entity.SponsoredPlacement, error) {
+	var placement entity.SponsoredPlacement
+	err := db.DB(ctx).First(&placement, placementID).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, prodErrors.ErrSponsoredPlacementNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &placement, nil
+}
+
+// FindBySellerID returns every placement of the seller, newest first
+func (r *SponsoredPlacementRepositoryImpl) FindBySellerID(
+	ctx context.Context,
+	sellerID uint,
+) ([]entity.SponsoredPlacement, error) {
+	var placements []entity.SponsoredPlacement
+	err := db.DB(ctx).
+		Where("seller_id = ?", sellerID).
+		Order("id DESC").
+		Find(&placements).Error
+	return placements, err
+}
+
+// FindLive returns switched-on placements inside their schedule, oldest first so
+// earlier placements win a contested position
+func (r *SponsoredPlacementRepositoryImpl) FindLive(
+	ctx context.Context,
+	sellerID *uint,
+	now time.Time,
+) ([]entity.SponsoredPlacement, error) {
+	query := db.DB(ctx).
+		Where("is_active").
+		Where("starts_at IS NULL OR starts_at <= ?", now).
+		Where("ends_at IS NULL OR ends_at > ?", now)
+	if sellerID != nil {
+		query = query.Where("seller_id = ?", *sellerID)
+	}
+
+	var placements []entity.SponsoredPlacement
+	err := query.Order("id ASC").Find(&placements).Error
+	return placements, err
+}
+
+// Delete removes a placement
+func (r *SponsoredPlacementRepositoryImpl) Delete(ctx context.Context, placementID uint) error {
+	return db.DB(ctx).Delete(&entity.SponsoredPlacement{}, placementID).Error
+}
+
+// IncrementImpressions adds one impression to the placement
+func (r *SponsoredPlacementRepositoryImpl) IncrementImpressions(
+	ctx context.Context,
+	placementID uint,
+) error {
+	return r.increment(ctx, placementID, "impression_count")
+}
+
+// IncrementClicks adds one click to the placement
+func (r *SponsoredPlacementRepositoryImpl) IncrementClicks(
+	ctx context.Context,
+	placementID uint,
+) error {
+	return r.increment(ctx, placementID, "click_count")
+}
+
+func (r *SponsoredPlacementRepositoryImpl) increment(
+	ctx context.Context,
+	placementID uint,
+	column string,
+) error {
+	result := db.DB(ctx).
+		Model(&entity.SponsoredPlacement{}).
+		Where("id = ?", placementID).
+		UpdateColumn(column, gorm.Expr(column+" + 1"))
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return prodErrors.ErrSponsoredPlacementNotFound
+	}
+	return nil
+}
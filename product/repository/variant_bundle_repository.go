@@ -0,0 +1,114 @@
+package repository
+
+import (
+	"context"
+
+	"ecommerce-be/common/db"
+	"ecommerce-be/product/entity"
+)
+
+// VariantBundleRepository defines database operations for bundle components
+type VariantBundleRepository interface {
+	FindByBundleVariantIDs(
+		ctx context.Context,
+		bundleVariantIDs []uint,
+	) ([]entity.VariantBundleComponent, error)
+	FindComponentVariantIDs(ctx context.Context, variantIDs []uint) ([]uint, error)
+	ExistsOutsideBundleUsage(ctx context.Context, variantIDs []uint) (bool, error)
+	ReplaceComponents(
+		ctx context.Context,
+		bundleVariantID uint,
+		components []entity.VariantBundleComponent,
+	) error
+	DeleteByBundleVariantIDs(ctx context.Context, bundleVariantIDs []uint) (int64, error)
+}
+
+// VariantBundleRepositoryImpl implements VariantBundleRepository
+type VariantBundleRepositoryImpl struct{}
+
+// NewVariantBundleRepository creates a new VariantBundleRepository
+func NewVariantBundleRepository() VariantBundleRepository {
+	return &VariantBundleRepositoryImpl{}
+}
+
+// FindByBundleVariantIDs returns the components of the given bundles. Variants that are
+// not bundles have no rows.
+func (r *VariantBundleRepositoryImpl) FindByBundleVariantIDs(
+	ctx context.Context,
+	bundleVariantIDs []uint,
+) ([]entity.VariantBundleComponent, error) {
+	var components []entity.VariantBundleComponent
+	if len(bundleVariantIDs) == 0 {
+		return components, nil
+	}
+	err := db.DB(ctx).
+		Where("bundle_variant_id IN ?", bundleVariantIDs).
+		Order("bundle_variant_id ASC, id ASC").
+		Find(&components).Error
+	return components, err
+}
+
+// FindComponentVariantIDs returns which of the given variants are a component of any bundle
+func (r *VariantBundleRepositoryImpl) FindComponentVariantIDs(
+	ctx context.Context,
+	variantIDs []uint,
+) ([]uint, error) {
+	var ids []uint
+	if len(variantIDs) == 0 {
+		return ids, nil
+	}
+	err := db.DB(ctx).Model(&entity.VariantBundleComponent{}).
+		Where("component_variant_id IN ?", variantIDs).
+		Distinct().
+		Pluck("component_variant_id", &ids).Error
+	return ids, err
+}
+
+// ExistsOutsideBundleUsage reports whether any of the given variants is a component of
+// a bundle that is not itself among them, i.e. whether deleting them together would
+// break a remaining bundle
+func (r *VariantBundleRepositoryImpl) ExistsOutsideBundleUsage(
+	ctx context.Context,
+	variantIDs []uint,
+) (bool, error) {
+	if len(variantIDs) == 0 {
+		return false, nil
+	}
+	var count int64
+	err := db.DB(ctx).Model(&entity.VariantBundleComponent{}).
+		Where("component_variant_id IN ? AND bundle_variant_id NOT IN ?", variantIDs, variantIDs).
+		Count(&count).Error
+	return count > 0, err
+}
+
+// ReplaceComponents swaps the bundle's component list for the given one
+func (r *VariantBundleRepositoryImpl) ReplaceComponents(
+	ctx context.Context,
+	bundleVariantID uint,
+	components []entity.VariantBundleComponent,
+) error {
+	if err := db.DB(ctx).
+		Where("bundle_variant_id = ?", bundleVariantID).
+		Delete(&entity.VariantBundleComponent{}).Error; err != nil {
+		return err
+	}
+	if len(components) == 0 {
+		return nil
+	}
+	return db.DB(ctx).Create(&components).Error
+}
+
+// DeleteByBundleVariantIDs removes every component of the given bundles, turning them
+// back into regular variants
+func (r *VariantBundleRepositoryImpl) DeleteByBundleVariantIDs(
+	ctx context.Context,
+	bundleVariantIDs []uint,
+) (int64, error) {
+	if len(bundleVariantIDs) == 0 {
+		return 0, nil
+	}
+	result := db.DB(ctx).
+		Where("bundle_variant_id IN ?", bundleVariantIDs).
+		Delete(&entity.VariantBundleComponent{})
+	return result.RowsAffected, result.Error
+}
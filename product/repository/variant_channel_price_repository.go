@@ -0,0 +1,100 @@
+package repository
+
+import (
+	"context"
+
+	"ecommerce-be/common/db"
+	"ecommerce-be/product/entity"
+
+	"gorm.io/gorm/clause"
+)
+
+// VariantChannelPriceRepository defines database operations for per-channel variant prices
+type VariantChannelPriceRepository interface {
+	FindByVariantIDs(
+		ctx context.Context,
+		variantIDs []uint,
+	) ([]entity.VariantChannelPrice, error)
+	FindByVariantIDsAndChannel(
+		ctx context.Context,
+		variantIDs []uint,
+		channel entity.SalesChannel,
+	) ([]entity.VariantChannelPrice, error)
+	UpsertBatch(ctx context.Context, prices []entity.VariantChannelPrice) error
+	DeleteByVariantChannels(
+		ctx context.Context,
+		keys []entity.VariantChannelPrice,
+	) (int64, error)
+}
+
+// VariantChannelPriceRepositoryImpl implements VariantChannelPriceRepository
+type VariantChannelPriceRepositoryImpl struct{}
+
+// NewVariantChannelPriceRepository creates a new VariantChannelPriceRepository
+func NewVariantChannelPriceRepository() VariantChannelPriceRepository {
+	return &VariantChannelPriceRepositoryImpl{}
+}
+
+// FindByVariantIDs returns every channel override of the given variants
+func (r *VariantChannelPriceRepositoryImpl) FindByVariantIDs(
+	ctx context.Context,
+	variantIDs []uint,
+) ([]entity.VariantChannelPrice, error) {
+	var prices []entity.VariantChannelPrice
+	if len(variantIDs) == 0 {
+		return prices, nil
+	}
+	err := db.DB(ctx).
+		Where("variant_id IN ?", variantIDs).
+		Order("variant_id ASC, channel ASC").
+		Find(&prices).Error
+	return prices, err
+}
+
+// FindByVariantIDsAndChannel returns the overrides of the given variants for one channel
+func (r *VariantChannelPriceRepositoryImpl) FindByVariantIDsAndChannel(
+	ctx context.Context,
+	variantIDs []uint,
+	channel entity.SalesChannel,
+) ([]entity.VariantChannelPrice, error) {
+	var prices []entity.VariantChannelPrice
+	if len(variantIDs) == 0 {
+		return prices, nil
+	}
+	err := db.DB(ctx).
+		Where("variant_id IN ? AND channel = ?", variantIDs, channel).
+		Find(&prices).Error
+	return prices, err
+}
+
+// UpsertBatch creates or replaces overrides keyed by (variant_id, channel)
+func (r *VariantChannelPriceRepositoryImpl) UpsertBatch(
+	ctx context.Context,
+	prices []entity.VariantChannelPrice,
+) error {
+	if len(prices) == 0 {
+		return nil
+	}
+	return db.DB(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "variant_id"}, {Name: "channel"}},
+		DoUpdates: clause.AssignmentColumns([]string{"price", "updated_at"}),
+	}).Create(&prices).Error
+}
+
+// DeleteByVariantChannels removes the overrides matching each (VariantID, Channel) key
+func (r *VariantChannelPriceRepositoryImpl) DeleteByVariantChannels(
+	ctx context.Context,
+	keys []entity.VariantChannelPrice,
+) (int64, error) {
+	if len(keys) == 0 {
+		return 0, nil
+	}
+	pairs := make([][]any, len(keys))
+	for i, k := range keys {
+		pairs[i] = []any{k.VariantID, k.Channel}
+	}
+	result := db.DB(ctx).
+		Where("(variant_id, channel) IN ?", pairs).
+		Delete(&entity.VariantChannelPrice{})
+	return result.RowsAffected, result.Error
+}
@@ -25,8 +25,19 @@ type VariantMediaRepository interface {
 	// ordered by (variant_id, display_order ASC, id ASC) for stable presentation.
 	FindByVariantIDs(ctx context.Context, variantIDs []uint) ([]entity.VariantMedia, error)
 
-	// UpdateMetadata patches is_primary and/or display_order for the given row ID.
-	UpdateMetadata(ctx context.Context, id uint, isPrimary *bool, displayOrder *int) error
+	// UpdateMetadata patches is_primary, display_order and/or alt_text for the given
+	// row ID. An empty altText clears the column.
+	UpdateMetadata(
+		ctx context.Context,
+		id uint,
+		isPrimary *bool,
+		displayOrder *int,
+		altText *string,
+	) error
+
+	// UpdateDisplayOrders sets display_order of each media row of a variant to the
+	// index of its file ID in fileIDs.
+	UpdateDisplayOrders(ctx context.Context, variantID uint, fileIDs []string) error
 
 	// UnsetPrimary sets is_primary = false for every media row of a variant.
 	UnsetPrimary(ctx context.Context, variantID uint) error
@@ -47,7 +58,7 @@ func NewVariantMediaRepository() VariantMediaRepository {
 }
 
 func (r *variantMediaRepository) Create(ctx context.Context, media *entity.VariantMedia) error {
-	return db.GetDB().WithContext(ctx).Create(media).Error
+	return db.DB(ctx).Create(media).Error
 }
 
 func (r *variantMediaRepository) FindByVariantAndFile(
@@ -56,7 +67,7 @@ func (r *variantMediaRepository) FindByVariantAndFile(
 	fileID string,
 ) (*entity.VariantMedia, error) {
 	var media entity.VariantMedia
-	result := db.GetDB().WithContext(ctx).
+	result := db.DB(ctx).
 		Where("variant_id = ? AND file_id = ?", variantID, fileID).
 		First(&media)
 	if result.Error != nil {
@@ -76,7 +87,7 @@ func (r *variantMediaRepository) FindByVariantIDs(
 		return nil, nil
 	}
 	var items []entity.VariantMedia
-	result := db.GetDB().WithContext(ctx).
+	result := db.DB(ctx).
 		Where("variant_id IN ?", variantIDs).
 		Order("variant_id ASC, display_order ASC, id ASC").
 		Find(&items)
@@ -88,6 +99,7 @@ func (r *variantMediaRepository) UpdateMetadata(
 	id uint,
 	isPrimary *bool,
 	displayOrder *int,
+	altText *string,
 ) error {
 	updates := map[string]any{}
 	if isPrimary != nil {
@@ -96,17 +108,41 @@ func (r *variantMediaRepository) UpdateMetadata(
 	if displayOrder != nil {
 		updates["display_order"] = *displayOrder
 	}
+	if altText != nil {
+		if *altText == "" {
+			updates["alt_text"] = nil
+		} else {
+			updates["alt_text"] = *altText
+		}
+	}
 	if len(updates) == 0 {
 		return nil
 	}
-	return db.GetDB().WithContext(ctx).
+	return db.DB(ctx).
 		Model(&entity.VariantMedia{}).
 		Where("id = ?", id).
 		Updates(updates).Error
 }
 
+func (r *variantMediaRepository) UpdateDisplayOrders(
+	ctx context.Context,
+	variantID uint,
+	fileIDs []string,
+) error {
+	for position, fileID := range fileIDs {
+		err := db.DB(ctx).
+			Model(&entity.VariantMedia{}).
+			Where("variant_id = ? AND file_id = ?", variantID, fileID).
+			Update("display_order", position).Error
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (r *variantMediaRepository) UnsetPrimary(ctx context.Context, variantID uint) error {
-	return db.GetDB().WithContext(ctx).
+	return db.DB(ctx).
 		Model(&entity.VariantMedia{}).
 		Where("variant_id = ? AND is_primary = true", variantID).
 		Update("is_primary", false).Error
@@ -114,7 +150,7 @@ func (r *variantMediaRepository) UnsetPrimary(ctx context.Context, variantID uin
 
 func (r *variantMediaRepository) PromoteFallbackPrimary(ctx context.Context, variantID uint) error {
 	var candidate entity.VariantMedia
-	result := db.GetDB().WithContext(ctx).
+	result := db.DB(ctx).
 		Where("variant_id = ?", variantID).
 		Order("display_order ASC, id ASC").
 		First(&candidate)
@@ -124,13 +160,13 @@ func (r *variantMediaRepository) PromoteFallbackPrimary(ctx context.Context, var
 		}
 		return result.Error
 	}
-	return db.GetDB().WithContext(ctx).
+	return db.DB(ctx).
 		Model(&entity.VariantMedia{}).
 		Where("id = ?", candidate.ID).
 		Update("is_primary", true).Error
 }
 
 func (r *variantMediaRepository) Delete(ctx context.Context, id uint) error {
-	return db.GetDB().WithContext(ctx).
+	return db.DB(ctx).
 		Delete(&entity.VariantMedia{}, id).Error
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"strings"
+	"time"
 
 	"ecommerce-be/common/db"
 	"ecommerce-be/common/helper"
@@ -42,7 +43,11 @@ type VariantRepository interface {
 	DeleteVariantOptionValues(ctx context.Context, variantID uint) error
 	FindVariantsByIDs(ctx context.Context, variantIDs []uint) ([]entity.ProductVariant, error)
 	BulkUpdateVariants(ctx context.Context, variants []*entity.ProductVariant) error
-	UnsetAllDefaultVariantsForProduct(ctx context.Context, productID uint) error
+	UnsetAllDefaultVariantsForProduct(
+		ctx context.Context,
+		productID uint,
+		exceptVariantIDs ...uint,
+	) error
 	GetProductVariantAggregation(
 		ctx context.Context,
 		productID uint,
@@ -57,6 +62,10 @@ type VariantRepository interface {
 		ctx context.Context,
 		productID uint,
 	) ([]mapper.VariantWithOptions, error)
+	GetProductsVariantsWithOptions(
+		ctx context.Context,
+		productIDs []uint,
+	) (map[uint][]mapper.VariantWithOptions, error)
 	FindVariantsByProductID(ctx context.Context, productID uint) ([]entity.ProductVariant, error)
 	DeleteVariantsByProductID(ctx context.Context, productID uint) error
 	DeleteVariantOptionValuesByVariantIDs(ctx context.Context, variantIDs []uint) error
@@ -84,6 +93,25 @@ type VariantRepository interface {
 		variantIDs []uint,
 		sellerID *uint,
 	) ([]mapper.VariantBasicInfoRow, error)
+	GetVariantBasicInfoBySKUs(
+		ctx context.Context,
+		skus []string,
+		sellerID *uint,
+	) ([]mapper.VariantBasicInfoRow, error)
+	// FindVariantByBarcode finds the seller's variant with the GTIN-14 normalized barcode
+	FindVariantByBarcode(
+		ctx context.Context,
+		sellerID uint,
+		gtin string,
+	) (*entity.ProductVariant, error)
+	// FindUsedBarcodes returns which of the GTIN-14 normalized barcodes the seller's
+	// variants other than excludeVariantIDs already use
+	FindUsedBarcodes(
+		ctx context.Context,
+		sellerID uint,
+		gtins []string,
+		excludeVariantIDs []uint,
+	) ([]string, error)
 }
 
 // VariantRepositoryImpl implements the VariantRepository interface
@@ -256,12 +284,22 @@ func (r *VariantRepositoryImpl) CreateVariantOptionValues(
 	return db.DB(ctx).Create(&variantOptionValues).Error
 }
 
-// UpdateVariant updates an existing variant
+// UpdateVariant updates an existing variant if it is still at the version it was read
+// at, and returns ErrVariantVersionConflict otherwise
 func (r *VariantRepositoryImpl) UpdateVariant(
 	ctx context.Context,
 	variant *entity.ProductVariant,
 ) error {
-	return db.DB(ctx).Save(variant).Error
+	conflict, err := r.updateVariantVersioned(ctx, variant)
+	if err != nil {
+		return err
+	}
+	if conflict != nil {
+		return producterrors.ErrVariantVersionConflict.WithDetails(
+			[]db.VersionConflict{*conflict},
+		)
+	}
+	return nil
 }
 
 // DeleteVariant deletes a variant by ID
@@ -303,30 +341,78 @@ func (r *VariantRepositoryImpl) FindVariantsByIDs(
 	return variants, nil
 }
 
-// BulkUpdateVariants updates multiple variants in a transaction
+// BulkUpdateVariants updates multiple variants in a transaction. Nothing is written
+// when any of them changed since it was read; the error lists every such variant.
 func (r *VariantRepositoryImpl) BulkUpdateVariants(
 	ctx context.Context,
 	variants []*entity.ProductVariant,
 ) error {
-	return db.DB(ctx).Transaction(func(tx *gorm.DB) error {
+	return db.WithTransaction(ctx, func(txCtx context.Context) error {
+		var conflicts []db.VersionConflict
 		for _, variant := range variants {
-			if err := tx.Save(variant).Error; err != nil {
+			conflict, err := r.updateVariantVersioned(txCtx, variant)
+			if err != nil {
 				return err
 			}
+			if conflict != nil {
+				conflicts = append(conflicts, *conflict)
+			}
+		}
+		if len(conflicts) > 0 {
+			return producterrors.ErrVariantVersionConflict.WithDetails(conflicts)
 		}
 		return nil
 	})
 }
 
+// updateVariantVersioned compare-and-swaps the editable columns of a variant on its
+// version, and moves the entity to the new version on success
+func (r *VariantRepositoryImpl) updateVariantVersioned(
+	ctx context.Context,
+	variant *entity.ProductVariant,
+) (*db.VersionConflict, error) {
+	conflict, err := db.UpdateVersioned(
+		ctx,
+		&entity.ProductVariant{},
+		variant.ID,
+		variant.Version,
+		map[string]any{
+			"sku":            variant.SKU,
+			"barcode":        variant.Barcode,
+			"price":          variant.Price,
+			"allow_purchase": variant.AllowPurchase,
+			"is_popular":     variant.IsPopular,
+			"is_default":     variant.IsDefault,
+		},
+	)
+	if err != nil || conflict != nil {
+		return conflict, err
+	}
+	variant.Version++
+	variant.UpdatedAt = time.Now().UTC()
+	return nil, nil
+}
+
 // UnsetAllDefaultVariantsForProduct sets is_default=false for all variants of a product
-// This is used to enforce "only one default variant per product" constraint
+// This is used to enforce "only one default variant per product" constraint. The
+// changed variants move to a new version so stale edits of them conflict. Variants the
+// caller is about to update on the version it read are passed in exceptVariantIDs and
+// left alone; the caller writes their is_default itself.
 func (r *VariantRepositoryImpl) UnsetAllDefaultVariantsForProduct(
 	ctx context.Context,
 	productID uint,
+	exceptVariantIDs ...uint,
 ) error {
-	return db.DB(ctx).Model(&entity.ProductVariant{}).
-		Where("product_id = ? AND is_default = ?", productID, true).
-		Update("is_default", false).Error
+	query := db.DB(ctx).Model(&entity.ProductVariant{}).
+		Where("product_id = ? AND is_default = ?", productID, true)
+	if len(exceptVariantIDs) > 0 {
+		query = query.Where("id NOT IN ?", exceptVariantIDs)
+	}
+	return query.
+		Updates(map[string]any{
+			"is_default":      false,
+			db.VERSION_COLUMN: gorm.Expr(db.VERSION_COLUMN + " + 1"),
+		}).Error
 }
 
 // FindPlaceholderVariants returns variants with no linked option values (internal simple-product rows).
@@ -371,6 +457,7 @@ func (r *VariantRepositoryImpl) FindFirstOptionDerivedVariant(
 }
 
 // UpdateAllVariantsFlags bulk-updates allow_purchase and/or is_popular for every variant of a product.
+// Every variant moves to a new version so stale edits of them conflict.
 func (r *VariantRepositoryImpl) UpdateAllVariantsFlags(
 	ctx context.Context,
 	productID uint,
@@ -387,6 +474,7 @@ func (r *VariantRepositoryImpl) UpdateAllVariantsFlags(
 	if len(updates) == 0 {
 		return nil
 	}
+	updates[db.VERSION_COLUMN] = gorm.Expr(db.VERSION_COLUMN + " + 1")
 
 	return db.DB(ctx).Model(&entity.ProductVariant{}).
 		Where("product_id = ?", productID).
@@ -824,23 +912,43 @@ func (r *VariantRepositoryImpl) GetProductVariantsWithOptions(
 	ctx context.Context,
 	productID uint,
 ) ([]mapper.VariantWithOptions, error) {
-	// First, get all variants for the product
+	variantsByProduct, err := r.GetProductsVariantsWithOptions(ctx, []uint{productID})
+	if err != nil {
+		return nil, err
+	}
+	if variants := variantsByProduct[productID]; variants != nil {
+		return variants, nil
+	}
+	return []mapper.VariantWithOptions{}, nil
+}
+
+// GetProductsVariantsWithOptions retrieves the variants of several products with their
+// selected option values, keyed by product ID, in two queries whatever the batch size
+func (r *VariantRepositoryImpl) GetProductsVariantsWithOptions(
+	ctx context.Context,
+	productIDs []uint,
+) (map[uint][]mapper.VariantWithOptions, error) {
+	result := make(map[uint][]mapper.VariantWithOptions)
+	if len(productIDs) == 0 {
+		return result, nil
+	}
+
+	// First, get all variants for the products
 	var variants []entity.ProductVariant
-	if err := db.DB(ctx).Where("product_id = ?", productID).Find(&variants).Error; err != nil {
+	if err := db.DB(ctx).
+		Where("product_id IN ?", productIDs).
+		Find(&variants).Error; err != nil {
 		return nil, err
 	}
 
 	if len(variants) == 0 {
-		return []mapper.VariantWithOptions{}, nil
+		return result, nil
 	}
 
 	// Extract variant IDs for batch query
 	variantIDs := make([]uint, len(variants))
-	variantMap := make(map[uint]*entity.ProductVariant)
 	for i, v := range variants {
 		variantIDs[i] = v.ID
-		variantCopy := v
-		variantMap[v.ID] = &variantCopy
 	}
 
 	var optionData []mapper.OptionValueData
@@ -881,10 +989,9 @@ func (r *VariantRepositoryImpl) GetProductVariantsWithOptions(
 		)
 	}
 
-	// Build result
-	result := make([]mapper.VariantWithOptions, 0, len(variants))
+	// Group variants by product ID
 	for _, variant := range variants {
-		result = append(result, mapper.VariantWithOptions{
+		result[variant.ProductID] = append(result[variant.ProductID], mapper.VariantWithOptions{
 			Variant:         variant,
 			SelectedOptions: variantOptionsMap[variant.ID],
 		})
@@ -1257,6 +1364,7 @@ func (r *VariantRepositoryImpl) GetProductBasicInfoByVariantIDs(
 			"product.category_id as category_id",
 			"product.base_sku as base_sku",
 			"product.seller_id as seller_id",
+			"product_variant.sku as sku",
 			"product_variant.price as price",
 		).
 		Joins("INNER JOIN product ON product.id = product_variant.product_id").
@@ -1279,3 +1387,89 @@ func (r *VariantRepositoryImpl) GetProductBasicInfoByVariantIDs(
 
 	return results, nil
 }
+
+// GetVariantBasicInfoBySKUs retrieves basic product info for a list of variant SKUs
+// Used by marketplace order ingestion to match external order lines to catalogue variants
+func (r *VariantRepositoryImpl) GetVariantBasicInfoBySKUs(
+	ctx context.Context,
+	skus []string,
+	sellerID *uint,
+) ([]mapper.VariantBasicInfoRow, error) {
+	if len(skus) == 0 {
+		return []mapper.VariantBasicInfoRow{}, nil
+	}
+
+	var results []mapper.VariantBasicInfoRow
+
+	query := db.DB(ctx).Model(&entity.ProductVariant{}).
+		Select(
+			"product_variant.id as variant_id",
+			"product.id as product_id",
+			"product.name as product_name",
+			"product.category_id as category_id",
+			"product.base_sku as base_sku",
+			"product.seller_id as seller_id",
+			"product_variant.sku as sku",
+			"product_variant.price as price",
+		).
+		Joins("INNER JOIN product ON product.id = product_variant.product_id").
+		Where("product_variant.sku IN ?", skus)
+
+	if sellerID != nil {
+		query = query.Where("product.seller_id = ?", *sellerID)
+	}
+
+	if err := query.Scan(&results).Error; err != nil {
+		return nil, err
+	}
+
+	if results == nil {
+		results = []mapper.VariantBasicInfoRow{}
+	}
+
+	return results, nil
+}
+
+// FindVariantByBarcode finds the seller's variant with the GTIN-14 normalized barcode
+func (r *VariantRepositoryImpl) FindVariantByBarcode(
+	ctx context.Context,
+	sellerID uint,
+	gtin string,
+) (*entity.ProductVariant, error) {
+	var variant entity.ProductVariant
+	err := db.DB(ctx).
+		Joins("INNER JOIN product ON product.id = product_variant.product_id").
+		Where("product.seller_id = ? AND product_variant.gtin = ?", sellerID, gtin).
+		Order("product_variant.id ASC").
+		First(&variant).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, producterrors.ErrVariantNotFound
+		}
+		return nil, err
+	}
+	return &variant, nil
+}
+
+// FindUsedBarcodes returns which of the GTIN-14 normalized barcodes the seller's other
+// variants already use
+func (r *VariantRepositoryImpl) FindUsedBarcodes(
+	ctx context.Context,
+	sellerID uint,
+	gtins []string,
+	excludeVariantIDs []uint,
+) ([]string, error) {
+	used := make([]string, 0)
+	if len(gtins) == 0 {
+		return used, nil
+	}
+	query := db.DB(ctx).Model(&entity.ProductVariant{}).
+		Distinct().
+		Joins("INNER JOIN product ON product.id = product_variant.product_id").
+		Where("product.seller_id = ? AND product_variant.gtin IN ?", sellerID, gtins)
+	if len(excludeVariantIDs) > 0 {
+		query = query.Where("product_variant.id NOT IN ?", excludeVariantIDs)
+	}
+	err := query.Pluck("product_variant.gtin", &used).Error
+	return used, err
+}
@@ -3,8 +3,6 @@ package repository
 import (
 	"context"
 
-	"gorm.io/gorm"
-
 	"ecommerce-be/common/db"
 	"ecommerce-be/product/entity"
 )
@@ -73,14 +71,9 @@ func NewWishlistItemRepository() WishlistItemRepository {
 	return &wishlistItemRepositoryImpl{}
 }
 
-// getDB returns the database connection
-func (r *wishlistItemRepositoryImpl) getDB() *gorm.DB {
-	return db.GetDB()
-}
-
 // Create creates a new wishlist item
 func (r *wishlistItemRepositoryImpl) Create(ctx context.Context, item *entity.WishlistItem) error {
-	return r.getDB().WithContext(ctx).Create(item).Error
+	return db.DB(ctx).Create(item).Error
 }
 
 // FindByID finds a wishlist item by ID
@@ -89,7 +82,7 @@ func (r *wishlistItemRepositoryImpl) FindByID(
 	id uint,
 ) (*entity.WishlistItem, error) {
 	var item entity.WishlistItem
-	err := r.getDB().WithContext(ctx).First(&item, id).Error
+	err := db.DB(ctx).First(&item, id).Error
 	if err != nil {
 		return nil, err
 	}
@@ -102,7 +95,7 @@ func (r *wishlistItemRepositoryImpl) FindByWishlistIDAndVariantID(
 	wishlistID, variantID uint,
 ) (*entity.WishlistItem, error) {
 	var item entity.WishlistItem
-	err := r.getDB().WithContext(ctx).
+	err := db.DB(ctx).
 		Where("wishlist_id = ? AND variant_id = ?", wishlistID, variantID).
 		First(&item).Error
 	if err != nil {
@@ -113,12 +106,12 @@ func (r *wishlistItemRepositoryImpl) FindByWishlistIDAndVariantID(
 
 // Update updates a wishlist item
 func (r *wishlistItemRepositoryImpl) Update(ctx context.Context, item *entity.WishlistItem) error {
-	return r.getDB().WithContext(ctx).Save(item).Error
+	return db.DB(ctx).Save(item).Error
 }
 
 // Delete deletes a wishlist item (soft delete)
 func (r *wishlistItemRepositoryImpl) Delete(ctx context.Context, id uint) error {
-	return r.getDB().WithContext(ctx).Delete(&entity.WishlistItem{}, id).Error
+	return db.DB(ctx).Delete(&entity.WishlistItem{}, id).Error
 }
 
 // ExistsByWishlistIDAndVariantID checks if item exists in wishlist
@@ -127,7 +120,7 @@ func (r *wishlistItemRepositoryImpl) ExistsByWishlistIDAndVariantID(
 	wishlistID, variantID uint,
 ) (bool, error) {
 	var count int64
-	err := r.getDB().WithContext(ctx).
+	err := db.DB(ctx).
 		Model(&entity.WishlistItem{}).
 		Where("wishlist_id = ? AND variant_id = ?", wishlistID, variantID).
 		Count(&count).Error
@@ -143,7 +136,7 @@ func (r *wishlistItemRepositoryImpl) IsVariantInUserWishlist(
 	variantID, userID uint,
 ) (bool, error) {
 	var isWishlisted bool
-	err := r.getDB().WithContext(ctx).Raw(`
+	err := db.DB(ctx).Raw(`
 		SELECT EXISTS (
 			SELECT 1 FROM wishlist_item wi
 			INNER JOIN wishlist w ON w.id = wi.wishlist_id
@@ -177,7 +170,7 @@ func (r *wishlistItemRepositoryImpl) AreVariantsInUserWishlist(
 
 	// Get all wishlisted variant IDs in a single query
 	var wishlistedIDs []uint
-	err := r.getDB().WithContext(ctx).Raw(`
+	err := db.DB(ctx).Raw(`
 		SELECT DISTINCT wi.variant_id
 		FROM wishlist_item wi
 		INNER JOIN wishlist w ON w.id = wi.wishlist_id
@@ -202,7 +195,7 @@ func (r *wishlistItemRepositoryImpl) CountByWishlistID(
 	wishlistID uint,
 ) (int64, error) {
 	var count int64
-	err := r.getDB().WithContext(ctx).
+	err := db.DB(ctx).
 		Model(&entity.WishlistItem{}).
 		Where("wishlist_id = ?", wishlistID).
 		Count(&count).Error
@@ -216,7 +209,7 @@ func (r *wishlistItemRepositoryImpl) DeleteOldestByWishlistID(
 ) error {
 	// Find the oldest item (by created_at ASC)
 	var oldestItem entity.WishlistItem
-	err := r.getDB().WithContext(ctx).
+	err := db.DB(ctx).
 		Where("wishlist_id = ?", wishlistID).
 		Order("created_at ASC").
 		First(&oldestItem).Error
@@ -225,7 +218,7 @@ func (r *wishlistItemRepositoryImpl) DeleteOldestByWishlistID(
 	}
 
 	// Delete the oldest item
-	return r.getDB().WithContext(ctx).Delete(&entity.WishlistItem{}, oldestItem.ID).Error
+	return db.DB(ctx).Delete(&entity.WishlistItem{}, oldestItem.ID).Error
 }
 
 // FindVariantIDsByWishlistID finds all variant IDs for a wishlist with pagination
@@ -239,7 +232,7 @@ func (r *wishlistItemRepositoryImpl) FindVariantIDsByWishlistID(
 	var variantIDs []uint
 
 	// Count total items
-	err := r.getDB().WithContext(ctx).
+	err := db.DB(ctx).
 		Model(&entity.WishlistItem{}).
 		Where("wishlist_id = ?", wishlistID).
 		Count(&total).Error
@@ -249,7 +242,7 @@ func (r *wishlistItemRepositoryImpl) FindVariantIDsByWishlistID(
 
 	// Get variant IDs with pagination
 	offset := (page - 1) * limit
-	err = r.getDB().WithContext(ctx).
+	err = db.DB(ctx).
 		Model(&entity.WishlistItem{}).
 		Select("variant_id").
 		Where("wishlist_id = ?", wishlistID).
@@ -168,7 +168,9 @@ func (r *WishlistRepositoryImpl) Update(ctx context.Context, wishlist *entity.Wi
 
 // Delete soft deletes a wishlist and its items
 func (r *WishlistRepositoryImpl) Delete(ctx context.Context, id uint) error {
-	return db.DB(ctx).Transaction(func(tx *gorm.DB) error {
+	return db.WithTransaction(ctx, func(txCtx context.Context) error {
+		tx := db.DB(txCtx)
+
 		// Delete wishlist items first
 		if err := tx.Where("wishlist_id = ?", id).Delete(&entity.WishlistItem{}).Error; err != nil {
 			return err
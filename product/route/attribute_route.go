@@ -1,10 +1,15 @@
 package route
 
 import (
+	"net/http"
+
 	"ecommerce-be/common/constants"
 	"ecommerce-be/common/middleware"
+	"ecommerce-be/common/openapi"
 	"ecommerce-be/product/factory/singleton"
 	"ecommerce-be/product/handler"
+	"ecommerce-be/product/model"
+	"ecommerce-be/product/utils"
 
 	"github.com/gin-gonic/gin"
 )
@@ -29,19 +34,52 @@ func (m *AttributeModule) RegisterRoutes(router *gin.Engine) {
 	auth := middleware.SellerAuth()
 
 	// Attribute routes - /api/product/attribute/*
-	attributeRoutes := router.Group(constants.APIBaseProduct + "/attribute")
+	attributeRoutes := openapi.NewGroup(
+		router.Group(constants.APIBaseProduct+"/attribute"),
+		"Attributes",
+	)
 	{
 
-		attributeRoutes.GET("", publicRoutesAuth, m.attributeHandler.GetAllAttributes)
-		attributeRoutes.GET("/:attributeId", publicRoutesAuth, m.attributeHandler.GetAttributeByID)
+		attributeRoutes.GET("", publicRoutesAuth, m.attributeHandler.GetAllAttributes).
+			Summary("List attribute definitions").
+			Returns(http.StatusOK, model.AttributeDefinitionsResponse{})
+		attributeRoutes.GET("/:attributeId", publicRoutesAuth, m.attributeHandler.GetAttributeByID).
+			Summary("Get an attribute definition").
+			ReturnsField(
+				http.StatusOK,
+				utils.ATTRIBUTE_FIELD_NAME,
+				model.AttributeDefinitionResponse{},
+			)
 
-		attributeRoutes.POST("", auth, m.attributeHandler.CreateAttribute)
-		attributeRoutes.PUT("/:attributeId", auth, m.attributeHandler.UpdateAttribute)
-		attributeRoutes.DELETE("/:attributeId", auth, m.attributeHandler.DeleteAttribute)
+		attributeRoutes.POST("", auth, m.attributeHandler.CreateAttribute).
+			Summary("Create an attribute definition").
+			Body(model.AttributeDefinitionCreateRequest{}).
+			ReturnsField(
+				http.StatusCreated,
+				utils.ATTRIBUTE_FIELD_NAME,
+				model.AttributeDefinitionResponse{},
+			)
+		attributeRoutes.PUT("/:attributeId", auth, m.attributeHandler.UpdateAttribute).
+			Summary("Update an attribute definition").
+			Body(model.AttributeDefinitionUpdateRequest{}).
+			ReturnsField(
+				http.StatusOK,
+				utils.ATTRIBUTE_FIELD_NAME,
+				model.AttributeDefinitionResponse{},
+			)
+		attributeRoutes.DELETE("/:attributeId", auth, m.attributeHandler.DeleteAttribute).
+			Summary("Delete an attribute definition")
 		attributeRoutes.POST(
 			"/:categoryId",
 			auth,
 			m.attributeHandler.CreateCategoryAttributeDefinition,
-		)
+		).
+			Summary("Create an attribute definition linked to a category").
+			Body(model.AttributeDefinitionCreateRequest{}).
+			ReturnsField(
+				http.StatusCreated,
+				utils.ATTRIBUTE_FIELD_NAME,
+				model.AttributeDefinitionResponse{},
+			)
 	}
 }
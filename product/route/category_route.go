@@ -1,10 +1,16 @@
 package route
 
 import (
+	"net/http"
+
+	"ecommerce-be/common/config"
 	"ecommerce-be/common/constants"
 	"ecommerce-be/common/middleware"
+	"ecommerce-be/common/openapi"
 	"ecommerce-be/product/factory/singleton"
 	"ecommerce-be/product/handler"
+	"ecommerce-be/product/model"
+	"ecommerce-be/product/utils"
 
 	"github.com/gin-gonic/gin"
 )
@@ -27,35 +33,69 @@ func NewCategoryModule() *CategoryModule {
 func (m *CategoryModule) RegisterRoutes(router *gin.Engine) {
 	sellerAuth := middleware.SellerAuth()
 	publicRoutesAuth := middleware.PublicAPIAuth()
+	// Public category reads answer If-None-Match with 304 when unchanged
+	conditionalGET := middleware.ConditionalGET(config.Get().HTTPCache.CategoryMaxAgeSec)
 
 	// Category routes - /api/product/category/*
-	categoryRoutes := router.Group(constants.APIBaseProduct + "/category")
+	categoryRoutes := openapi.NewGroup(
+		router.Group(constants.APIBaseProduct+"/category"),
+		"Categories",
+	)
 	{
 		// Public routes
-		categoryRoutes.GET("", publicRoutesAuth, m.categoryHandler.GetAllCategories)
-		categoryRoutes.GET("/:categoryId", publicRoutesAuth, m.categoryHandler.GetCategoryByID)
-		categoryRoutes.GET("/by-parent", publicRoutesAuth, m.categoryHandler.GetCategoriesByParent)
+		categoryRoutes.GET("", publicRoutesAuth, conditionalGET, m.categoryHandler.GetAllCategories).
+			Summary("List categories").
+			Returns(http.StatusOK, model.CategoriesResponse{})
+		categoryRoutes.GET("/:categoryId", publicRoutesAuth, m.categoryHandler.GetCategoryByID).
+			Summary("Get a category").
+			ReturnsField(http.StatusOK, utils.CATEGORY_FIELD_NAME, model.CategoryResponse{})
+		categoryRoutes.GET(utils.SLUG_ROUTE, publicRoutesAuth, m.categoryHandler.GetCategoryBySlug).
+			Summary("Get a category by slug").
+			Description("A retired slug answers 301 with the current slug in Location.").
+			ReturnsField(http.StatusOK, utils.CATEGORY_FIELD_NAME, model.CategoryResponse{})
+		categoryRoutes.GET(
+			"/by-parent",
+			publicRoutesAuth,
+			conditionalGET,
+			m.categoryHandler.GetCategoriesByParent,
+		).
+			Summary("List child categories of a parent").
+			QueryParam("parentId", "Parent category ID; omit for root categories").
+			Returns(http.StatusOK, model.CategoriesResponse{})
 		categoryRoutes.GET(
 			"/:categoryId/attribute",
 			publicRoutesAuth,
 			m.categoryHandler.GetAttributesByCategoryIDWithInheritance,
-		)
+		).
+			Summary("List category attributes including inherited ones").
+			Returns(http.StatusOK, model.AttributeDefinitionsResponse{})
 
 		// Admin routes (protected)
-		categoryRoutes.POST("", sellerAuth, m.categoryHandler.CreateCategory)
-		categoryRoutes.PUT("/:categoryId", sellerAuth, m.categoryHandler.UpdateCategory)
-		categoryRoutes.DELETE("/:categoryId", sellerAuth, m.categoryHandler.DeleteCategory)
+		categoryRoutes.POST("", sellerAuth, m.categoryHandler.CreateCategory).
+			Summary("Create a category").
+			Body(model.CategoryCreateRequest{}).
+			ReturnsField(http.StatusCreated, utils.CATEGORY_FIELD_NAME, model.CategoryResponse{})
+		categoryRoutes.PUT("/:categoryId", sellerAuth, m.categoryHandler.UpdateCategory).
+			Summary("Update a category").
+			Body(model.CategoryUpdateRequest{}).
+			ReturnsField(http.StatusOK, utils.CATEGORY_FIELD_NAME, model.CategoryResponse{})
+		categoryRoutes.DELETE("/:categoryId", sellerAuth, m.categoryHandler.DeleteCategory).
+			Summary("Delete a category")
 
 		// Link/Unlink attribute routes (protected)
 		categoryRoutes.POST(
 			"/:categoryId/attribute",
 			sellerAuth,
 			m.categoryHandler.LinkAttributeToCategory,
-		)
+		).
+			Summary("Link an attribute to a category").
+			Body(model.LinkAttributeRequest{}).
+			Returns(http.StatusCreated, model.LinkAttributeResponse{})
 		categoryRoutes.DELETE(
 			"/:categoryId/attribute/:attributeId",
 			sellerAuth,
 			m.categoryHandler.UnlinkAttributeFromCategory,
-		)
+		).
+			Summary("Unlink an attribute from a category")
 	}
 }
@@ -1,10 +1,15 @@
 package route
 
 import (
+	"net/http"
+
 	"ecommerce-be/common/constants"
 	"ecommerce-be/common/middleware"
+	"ecommerce-be/common/openapi"
 	"ecommerce-be/product/factory/singleton"
 	"ecommerce-be/product/handler"
+	"ecommerce-be/product/model"
+	"ecommerce-be/product/utils"
 
 	"github.com/gin-gonic/gin"
 )
@@ -27,34 +32,65 @@ func (m *CollectionModule) RegisterRoutes(router *gin.Engine) {
 	sellerAuth := middleware.SellerAuth()
 	publicRoutesAuth := middleware.PublicAPIAuth()
 
-	collectionRoutes := router.Group(constants.APIBaseProduct + "/collection")
+	collectionRoutes := openapi.NewGroup(
+		router.Group(constants.APIBaseProduct+"/collection"),
+		"Collections",
+	)
 	{
-		collectionRoutes.GET("", publicRoutesAuth, m.collectionHandler.GetAllCollections)
-		collectionRoutes.GET("/:collectionId", publicRoutesAuth, m.collectionHandler.GetCollectionByID)
+		collectionRoutes.GET("", publicRoutesAuth, m.collectionHandler.GetAllCollections).
+			Summary("List collections").
+			Returns(http.StatusOK, model.CollectionsResponse{})
+		collectionRoutes.GET(
+			"/:collectionId",
+			publicRoutesAuth,
+			m.collectionHandler.GetCollectionByID,
+		).
+			Summary("Get a collection").
+			ReturnsField(http.StatusOK, utils.COLLECTION_FIELD_NAME, model.CollectionResponse{})
 		collectionRoutes.GET(
 			"/:collectionId/product",
 			publicRoutesAuth,
 			m.collectionHandler.GetProducts,
-		)
+		).
+			Summary("List products in a collection").
+			Query(model.GetCollectionProductsQueryParams{}).
+			Returns(http.StatusOK, model.GetCollectionProductsResponse{})
 
-		collectionRoutes.POST("", sellerAuth, m.collectionHandler.CreateCollection)
-		collectionRoutes.PUT("/:collectionId", sellerAuth, m.collectionHandler.UpdateCollection)
-		collectionRoutes.DELETE("/:collectionId", sellerAuth, m.collectionHandler.DeleteCollection)
+		collectionRoutes.POST("", sellerAuth, m.collectionHandler.CreateCollection).
+			Summary("Create a collection").
+			Body(model.CollectionCreateRequest{}).
+			ReturnsField(
+				http.StatusCreated,
+				utils.COLLECTION_FIELD_NAME,
+				model.CollectionResponse{},
+			)
+		collectionRoutes.PUT("/:collectionId", sellerAuth, m.collectionHandler.UpdateCollection).
+			Summary("Update a collection").
+			Body(model.CollectionUpdateRequest{}).
+			ReturnsField(http.StatusOK, utils.COLLECTION_FIELD_NAME, model.CollectionResponse{})
+		collectionRoutes.DELETE("/:collectionId", sellerAuth, m.collectionHandler.DeleteCollection).
+			Summary("Delete a collection")
 
 		collectionRoutes.POST(
 			"/:collectionId/product",
 			sellerAuth,
 			m.collectionHandler.AddProducts,
-		)
+		).
+			Summary("Add products to a collection").
+			Body(model.AddCollectionProductsRequest{})
 		collectionRoutes.DELETE(
 			"/:collectionId/product",
 			sellerAuth,
 			m.collectionHandler.RemoveProducts,
-		)
+		).
+			Summary("Remove products from a collection").
+			Body(model.RemoveCollectionProductsRequest{})
 		collectionRoutes.PUT(
 			"/:collectionId/product/reorder",
 			sellerAuth,
 			m.collectionHandler.ReorderProducts,
-		)
+		).
+			Summary("Reorder products in a collection").
+			Body(model.ReorderCollectionProductsRequest{})
 	}
 }
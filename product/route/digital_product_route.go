@@ -0,0 +1,82 @@
+package route
+
+import (
+	"net/http"
+
+	"ecommerce-be/common/constants"
+	"ecommerce-be/common/middleware"
+	"ecommerce-be/common/openapi"
+	"ecommerce-be/product/factory/singleton"
+	"ecommerce-be/product/handler"
+	"ecommerce-be/product/model"
+	"ecommerce-be/product/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// DigitalProductModule implements the Module interface for digital product routes
+type DigitalProductModule struct {
+	digitalHandler *handler.DigitalProductHandler
+}
+
+// NewDigitalProductModule creates a new instance of DigitalProductModule
+func NewDigitalProductModule() *DigitalProductModule {
+	f := singleton.GetInstance()
+
+	return &DigitalProductModule{
+		digitalHandler: f.GetDigitalProductHandler(),
+	}
+}
+
+// RegisterRoutes registers the seller routes managing download files and license keys,
+// and the public download link route
+func (m *DigitalProductModule) RegisterRoutes(router *gin.Engine) {
+	sellerAuth := middleware.SellerAuth()
+
+	digitalRoutes := openapi.NewGroup(router.Group(constants.APIBaseProduct), "Digital Products")
+	{
+		digitalRoutes.GET(utils.DIGITAL_ASSETS_ROUTE, sellerAuth, m.digitalHandler.ListAssets).
+			Summary("List the download files of a digital product").
+			ReturnsField(
+				http.StatusOK,
+				utils.DIGITAL_ASSETS_FIELD_NAME,
+				[]model.DigitalAssetResponse{},
+			)
+		digitalRoutes.POST(utils.DIGITAL_ASSETS_ROUTE, sellerAuth, m.digitalHandler.AddAsset).
+			Summary("Attach an uploaded file to a digital product").
+			Body(model.AddDigitalAssetRequest{}).
+			ReturnsField(
+				http.StatusCreated,
+				utils.DIGITAL_ASSET_FIELD_NAME,
+				model.DigitalAssetResponse{},
+			)
+		digitalRoutes.DELETE(utils.DIGITAL_ASSET_ROUTE, sellerAuth, m.digitalHandler.DeleteAsset).
+			Summary("Detach a download file from a digital product")
+
+		digitalRoutes.GET(utils.LICENSE_KEYS_ROUTE, sellerAuth, m.digitalHandler.GetLicenseKeys).
+			Summary("Get a digital product's license key pool").
+			ReturnsField(
+				http.StatusOK,
+				utils.LICENSE_KEY_POOL_FIELD_NAME,
+				model.LicenseKeyPoolResponse{},
+			)
+		digitalRoutes.POST(utils.LICENSE_KEYS_ROUTE, sellerAuth, m.digitalHandler.AddLicenseKeys).
+			Summary("Add license keys to a digital product's pool").
+			Description("Keys already in the pool are skipped. Paid orders still waiting "+
+				"for keys receive the new keys first.").
+			Body(model.AddLicenseKeysRequest{}).
+			ReturnsField(
+				http.StatusCreated,
+				utils.LICENSE_KEY_POOL_FIELD_NAME,
+				model.LicenseKeyPoolResponse{},
+			)
+		digitalRoutes.DELETE(utils.LICENSE_KEY_ROUTE, sellerAuth, m.digitalHandler.DeleteLicenseKey).
+			Summary("Remove a license key that has not been issued")
+
+		// Signed links are sent by email; the token is the only credential
+		digitalRoutes.GET(utils.DIGITAL_DOWNLOAD_ROUTE, m.digitalHandler.Download).
+			Summary("Download a purchased file with a signed link").
+			Description("Counts the download against the purchase's limit and redirects " +
+				"to a short-lived storage URL.")
+	}
+}
@@ -1,10 +1,15 @@
 package route
 
 import (
+	"net/http"
+
 	"ecommerce-be/common/constants"
 	"ecommerce-be/common/middleware"
+	"ecommerce-be/common/openapi"
 	"ecommerce-be/product/factory/singleton"
 	"ecommerce-be/product/handler"
+	"ecommerce-be/product/model"
+	"ecommerce-be/product/utils"
 
 	"github.com/gin-gonic/gin"
 )
@@ -28,21 +33,52 @@ func (m *PackageOptionModule) RegisterRoutes(router *gin.Engine) {
 	sellerAuth := middleware.SellerAuth()
 	publicRoutesAuth := middleware.PublicAPIAuth()
 
-	packageOptionRoutes := router.Group(constants.APIBaseProduct + "/:productId/package-option")
+	packageOptionRoutes := openapi.NewGroup(
+		router.Group(constants.APIBaseProduct+"/:productId/package-option"),
+		"Package Options",
+	)
 	{
-		packageOptionRoutes.GET("", publicRoutesAuth, m.packageOptionHandler.GetPackageOptions)
+		packageOptionRoutes.GET("", publicRoutesAuth, m.packageOptionHandler.GetPackageOptions).
+			Summary("List package options of a product").
+			ReturnsField(
+				http.StatusOK,
+				utils.PACKAGE_OPTIONS_FIELD_NAME,
+				model.PackageOptionsResponse{},
+			)
 
-		packageOptionRoutes.POST("", sellerAuth, m.packageOptionHandler.AddPackageOption)
-		packageOptionRoutes.PUT("/bulk", sellerAuth, m.packageOptionHandler.BulkUpdatePackageOptions)
+		packageOptionRoutes.POST("", sellerAuth, m.packageOptionHandler.AddPackageOption).
+			Summary("Add a package option").
+			Body(model.PackageOptionCreateRequest{}).
+			ReturnsField(
+				http.StatusCreated,
+				utils.PACKAGE_OPTION_FIELD_NAME,
+				model.PackageOptionResponse{},
+			)
+		packageOptionRoutes.PUT(
+			"/bulk",
+			sellerAuth,
+			m.packageOptionHandler.BulkUpdatePackageOptions,
+		).
+			Summary("Bulk update package options").
+			Body(model.BulkUpdatePackageOptionsRequest{}).
+			ReturnsField(http.StatusOK, "result", model.BulkUpdatePackageOptionsResponse{})
 		packageOptionRoutes.PUT(
 			"/:packageOptionId",
 			sellerAuth,
 			m.packageOptionHandler.UpdatePackageOption,
-		)
+		).
+			Summary("Update a package option").
+			Body(model.PackageOptionUpdateRequest{}).
+			ReturnsField(
+				http.StatusOK,
+				utils.PACKAGE_OPTION_FIELD_NAME,
+				model.PackageOptionResponse{},
+			)
 		packageOptionRoutes.DELETE(
 			"/:packageOptionId",
 			sellerAuth,
 			m.packageOptionHandler.DeletePackageOption,
-		)
+		).
+			Summary("Delete a package option")
 	}
 }
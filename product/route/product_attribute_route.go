@@ -1,10 +1,15 @@
 package route
 
 import (
+	"net/http"
+
 	"ecommerce-be/common/constants"
 	"ecommerce-be/common/middleware"
+	"ecommerce-be/common/openapi"
 	"ecommerce-be/product/factory/singleton"
 	"ecommerce-be/product/handler"
+	"ecommerce-be/product/model"
+	"ecommerce-be/product/utils"
 
 	"github.com/gin-gonic/gin"
 )
@@ -29,23 +34,50 @@ func (m *ProductAttributeModule) RegisterRoutes(router *gin.Engine) {
 	publicRoutesAuth := middleware.PublicAPIAuth()
 
 	// Product Attribute routes - nested under products - /api/product/:productId/attribute/*
-	productAttrRoutes := router.Group(constants.APIBaseProduct + "/:productId/attribute")
+	productAttrRoutes := openapi.NewGroup(
+		router.Group(constants.APIBaseProduct+"/:productId/attribute"),
+		"Product Attributes",
+	)
 	{
 		// Public route - get product attributes
-		productAttrRoutes.GET("", publicRoutesAuth, m.productAttrHandler.GetProductAttributes)
+		productAttrRoutes.GET("", publicRoutesAuth, m.productAttrHandler.GetProductAttributes).
+			Summary("List attribute values of a product").
+			ReturnsField(http.StatusOK, "productAttributes", model.ProductAttributesListResponse{})
 
 		// Protected routes - seller/admin only
-		productAttrRoutes.POST("", sellerAuth, m.productAttrHandler.AddProductAttribute)
-		productAttrRoutes.PUT("/bulk", sellerAuth, m.productAttrHandler.BulkUpdateProductAttributes)
+		productAttrRoutes.POST("", sellerAuth, m.productAttrHandler.AddProductAttribute).
+			Summary("Add an attribute value to a product").
+			Body(model.AddProductAttributeRequest{}).
+			ReturnsField(
+				http.StatusCreated,
+				utils.ATTRIBUTE_FIELD_NAME,
+				model.ProductAttributeDetailResponse{},
+			)
+		productAttrRoutes.PUT(
+			"/bulk",
+			sellerAuth,
+			m.productAttrHandler.BulkUpdateProductAttributes,
+		).
+			Summary("Bulk update product attribute values").
+			Body(model.BulkUpdateProductAttributesRequest{}).
+			ReturnsField(http.StatusOK, "result", model.BulkUpdateProductAttributesResponse{})
 		productAttrRoutes.PUT(
 			"/:attributeId",
 			sellerAuth,
 			m.productAttrHandler.UpdateProductAttribute,
-		)
+		).
+			Summary("Update a product attribute value").
+			Body(model.UpdateProductAttributeRequest{}).
+			ReturnsField(
+				http.StatusOK,
+				utils.ATTRIBUTE_FIELD_NAME,
+				model.ProductAttributeDetailResponse{},
+			)
 		productAttrRoutes.DELETE(
 			"/:attributeId",
 			sellerAuth,
 			m.productAttrHandler.DeleteProductAttribute,
-		)
+		).
+			Summary("Remove an attribute value from a product")
 	}
 }
@@ -1,10 +1,15 @@
 package route
 
 import (
+	"net/http"
+
 	"ecommerce-be/common/constants"
 	"ecommerce-be/common/middleware"
+	"ecommerce-be/common/openapi"
 	"ecommerce-be/product/factory/singleton"
 	"ecommerce-be/product/handler"
+	"ecommerce-be/product/model"
+	"ecommerce-be/product/utils"
 
 	"github.com/gin-gonic/gin"
 )
@@ -29,30 +34,109 @@ func NewProductOptionModule() *ProductOptionModule {
 func (m *ProductOptionModule) RegisterRoutes(router *gin.Engine) {
 	publicRoutesAuth := middleware.PublicAPIAuth()
 	// Public routes (reading options) - /api/product/:productId/option
-	publicOptionRoutes := router.Group(constants.APIBaseProduct + "/:productId/option")
+	publicOptionRoutes := openapi.NewGroup(
+		router.Group(constants.APIBaseProduct+"/:productId/option"),
+		"Product Options",
+	)
 	{
-		publicOptionRoutes.GET("", publicRoutesAuth, m.optionHandler.GetAvailableOptions)
+		publicOptionRoutes.GET("", publicRoutesAuth, m.optionHandler.GetAvailableOptions).
+			Summary("List options available on a product").
+			ReturnsField(http.StatusOK, "options", model.GetAvailableOptionsResponse{})
 	}
 
 	// Auth middleware for protected routes
 	sellerAuth := middleware.SellerAuth()
+	bulkBodyLimit := middleware.BulkBodySizeLimit()
 
-	protectedOptionRoutes := router.Group(constants.APIBaseProduct + "/:productId/option")
+	protectedOptionRoutes := openapi.NewGroup(
+		router.Group(constants.APIBaseProduct+"/:productId/option"),
+		"Product Options",
+	)
 	protectedOptionRoutes.Use(sellerAuth)
 	{
-		protectedOptionRoutes.POST("", m.optionHandler.CreateOption)
-		protectedOptionRoutes.PUT("/:optionId", m.optionHandler.UpdateOption)
-		protectedOptionRoutes.DELETE("/:optionId", m.optionHandler.DeleteOption)
-		protectedOptionRoutes.PUT("/bulk-update", m.optionHandler.BulkUpdateOptions)
+		protectedOptionRoutes.POST("", m.optionHandler.CreateOption).
+			Summary("Create a product option").
+			Body(model.ProductOptionCreateRequest{}).
+			ReturnsField(
+				http.StatusCreated,
+				utils.PRODUCT_OPTION_FIELD_NAME,
+				model.ProductOptionResponse{},
+			)
+		protectedOptionRoutes.PUT("/:optionId", m.optionHandler.UpdateOption).
+			Summary("Update a product option").
+			Body(model.ProductOptionUpdateRequest{}).
+			ReturnsField(
+				http.StatusOK,
+				utils.PRODUCT_OPTION_FIELD_NAME,
+				model.ProductOptionResponse{},
+			)
+		protectedOptionRoutes.DELETE("/:optionId", m.optionHandler.DeleteOption).
+			Summary("Delete a product option")
+		protectedOptionRoutes.PUT("/bulk-update", bulkBodyLimit, m.optionHandler.BulkUpdateOptions).
+			Summary("Bulk update product options").
+			Body(model.ProductOptionBulkUpdateRequest{})
 
 		// Option value routes
-		protectedOptionRoutes.POST("/:optionId/value", m.valueHandler.AddOptionValue)
-		protectedOptionRoutes.PUT("/:optionId/value/:valueId", m.valueHandler.UpdateOptionValue)
-		protectedOptionRoutes.DELETE("/:optionId/value/:valueId", m.valueHandler.DeleteOptionValue)
-		protectedOptionRoutes.POST("/:optionId/value/bulk", m.valueHandler.BulkAddOptionValues)
+		protectedOptionRoutes.POST("/:optionId/value", m.valueHandler.AddOptionValue).
+			Summary("Add a value to a product option").
+			Body(model.ProductOptionValueRequest{}).
+			ReturnsField(
+				http.StatusCreated,
+				utils.OPTION_VALUE_FIELD_NAME,
+				model.ProductOptionValueResponse{},
+			)
+		protectedOptionRoutes.PUT("/:optionId/value/:valueId", m.valueHandler.UpdateOptionValue).
+			Summary("Update a product option value").
+			Body(model.ProductOptionValueUpdateRequest{}).
+			ReturnsField(
+				http.StatusOK,
+				utils.OPTION_VALUE_FIELD_NAME,
+				model.ProductOptionValueResponse{},
+			)
+		protectedOptionRoutes.DELETE("/:optionId/value/:valueId", m.valueHandler.DeleteOptionValue).
+			Summary("Delete a product option value")
+		protectedOptionRoutes.POST(
+			"/:optionId/value/bulk",
+			bulkBodyLimit,
+			m.valueHandler.BulkAddOptionValues,
+		).
+			Summary("Bulk add values to a product option").
+			Body(model.ProductOptionValueBulkAddRequest{}).
+			ReturnsField(
+				http.StatusCreated,
+				utils.OPTION_VALUES_FIELD_NAME,
+				[]model.ProductOptionValueResponse{},
+			)
 		protectedOptionRoutes.PUT(
 			"/:optionId/value/bulk-update",
 			m.valueHandler.BulkUpdateOptionValues,
-		)
+		).
+			Summary("Bulk update product option values").
+			Body(model.ProductOptionValueBulkUpdateRequest{})
+	}
+
+	// Option dependency rules - /api/product/:productId/option-rule
+	optionRuleRoutes := openapi.NewGroup(
+		router.Group(constants.APIBaseProduct+"/:productId"+utils.OPTION_RULE_ROUTE),
+		"Product Options",
+	)
+	{
+		optionRuleRoutes.GET("", publicRoutesAuth, m.optionHandler.ListOptionRules).
+			Summary("List option dependency rules of a product").
+			ReturnsField(
+				http.StatusOK,
+				utils.OPTION_RULES_FIELD_NAME,
+				[]model.ProductOptionRuleResponse{},
+			)
+		optionRuleRoutes.POST("", sellerAuth, m.optionHandler.CreateOptionRule).
+			Summary("Create an option dependency rule").
+			Body(model.ProductOptionRuleCreateRequest{}).
+			ReturnsField(
+				http.StatusCreated,
+				utils.OPTION_RULE_FIELD_NAME,
+				model.ProductOptionRuleResponse{},
+			)
+		optionRuleRoutes.DELETE("/:ruleId", sellerAuth, m.optionHandler.DeleteOptionRule).
+			Summary("Delete an option dependency rule")
 	}
 }
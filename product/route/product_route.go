@@ -1,10 +1,16 @@
 package route
 
 import (
+	"net/http"
+
+	"ecommerce-be/common/cache"
+	"ecommerce-be/common/config"
 	"ecommerce-be/common/constants"
 	"ecommerce-be/common/middleware"
+	"ecommerce-be/common/openapi"
 	"ecommerce-be/product/factory/singleton"
 	"ecommerce-be/product/handler"
+	"ecommerce-be/product/model"
 	"ecommerce-be/product/utils"
 
 	"github.com/gin-gonic/gin"
@@ -12,7 +18,11 @@ import (
 
 // ProductModule implements the Module interface for product routes
 type ProductModule struct {
-	productHandler *handler.ProductHandler
+	productHandler         *handler.ProductHandler
+	translationHandler     *handler.ProductTranslationHandler
+	searchSettingsHandler  *handler.SearchSettingsHandler
+	skuSettingsHandler     *handler.SKUSettingsHandler
+	relatedCurationHandler *handler.RelatedCurationHandler
 }
 
 // NewProductModule creates a new instance of ProductModule
@@ -20,7 +30,11 @@ func NewProductModule() *ProductModule {
 	f := singleton.GetInstance()
 
 	return &ProductModule{
-		productHandler: f.GetProductHandler(),
+		productHandler:         f.GetProductHandler(),
+		translationHandler:     f.GetProductTranslationHandler(),
+		searchSettingsHandler:  f.GetSearchSettingsHandler(),
+		skuSettingsHandler:     f.GetSKUSettingsHandler(),
+		relatedCurationHandler: f.GetRelatedCurationHandler(),
 	}
 }
 
@@ -28,32 +42,326 @@ func NewProductModule() *ProductModule {
 func (m *ProductModule) RegisterRoutes(router *gin.Engine) {
 	sellerAuth := middleware.SellerAuth()
 	publicRoutesAuth := middleware.PublicAPIAuth()
+	// Listing, search and related products tolerate replica lag
+	readReplica := middleware.ReadReplica()
+	// Product detail answers If-None-Match with 304 when unchanged
+	conditionalGET := middleware.ConditionalGET(config.Get().HTTPCache.ProductMaxAgeSec)
 
 	// Product routes - /api/product/*
-	productRoutes := router.Group(constants.APIBaseProduct)
+	productRoutes := openapi.NewGroup(router.Group(constants.APIBaseProduct), "Products")
 	{
 		// Public routes
-		productRoutes.GET("", publicRoutesAuth, m.productHandler.GetAllProducts)
-		productRoutes.GET("/:productId", publicRoutesAuth, m.productHandler.GetProductByID)
-		productRoutes.GET("/search", publicRoutesAuth, m.productHandler.SearchProducts)
-		productRoutes.GET("/filters", publicRoutesAuth, m.productHandler.GetProductFilters)
+		productRoutes.GET("", readReplica, publicRoutesAuth, m.productHandler.GetAllProducts).
+			Summary("List products").
+			Query(model.GetProductsParams{}).
+			QueryParam(utils.LOCALE_QUERY_PARAM, "Content locale override").
+			QueryParam(utils.PRODUCT_FIELDS_QUERY_PARAM, "Product fields to return, e.g. id,name,price").
+			Returns(http.StatusOK, model.ProductsResponse{})
+		productRoutes.GET(
+			"/:productId",
+			publicRoutesAuth,
+			conditionalGET,
+			m.productHandler.GetProductByID,
+		).
+			Summary("Get product details").
+			QueryParam(utils.LOCALE_QUERY_PARAM, "Content locale override").
+			QueryParam(utils.PRODUCT_FIELDS_QUERY_PARAM, "Product fields to return, e.g. id,name,price").
+			QueryParam(
+				utils.PRODUCT_INCLUDE_QUERY_PARAM,
+				"Associations to load: category, attributes, packageOptions, options, variants, media",
+			).
+			ReturnsField(http.StatusOK, utils.PRODUCT_FIELD_NAME, model.ProductResponse{})
+		productRoutes.GET(
+			utils.SLUG_ROUTE,
+			publicRoutesAuth,
+			conditionalGET,
+			m.productHandler.GetProductBySlug,
+		).
+			Summary("Get product details by slug").
+			Description("A retired slug answers 301 with the current slug in Location.").
+			QueryParam(utils.LOCALE_QUERY_PARAM, "Content locale override").
+			QueryParam(utils.PRODUCT_FIELDS_QUERY_PARAM, "Product fields to return, e.g. id,name,price").
+			QueryParam(
+				utils.PRODUCT_INCLUDE_QUERY_PARAM,
+				"Associations to load: category, attributes, packageOptions, options, variants, media",
+			).
+			ReturnsField(http.StatusOK, utils.PRODUCT_FIELD_NAME, model.ProductResponse{})
+		productRoutes.POST(
+			utils.PRODUCT_BATCH_ROUTE,
+			publicRoutesAuth,
+			m.productHandler.GetProductsBatch,
+		).
+			Summary("Get product details for up to 100 product IDs").
+			Description("Results follow request order; hidden or unknown IDs have found=false.").
+			Body(model.BatchProductsRequest{}).
+			QueryParam(utils.LOCALE_QUERY_PARAM, "Content locale override").
+			Returns(http.StatusOK, model.BatchProductsResponse{})
+		productRoutes.GET(
+			utils.PRODUCT_COMPARE_ROUTE,
+			publicRoutesAuth,
+			m.productHandler.CompareProducts,
+		).
+			Summary("Compare products side by side").
+			Description("Products in request order with one row per attribute across them.").
+			QueryParam("ids", "Comma-separated product IDs, 2 to 5").
+			QueryParam(utils.LOCALE_QUERY_PARAM, "Content locale override").
+			Returns(http.StatusOK, model.CompareProductsResponse{})
+		productRoutes.GET("/search", readReplica, publicRoutesAuth, m.productHandler.SearchProducts).
+			Summary("Search products").
+			QueryParam("q", "Search text matched against name, tags and description").
+			QueryParam("page", "Page number, defaults to 1").
+			QueryParam("limit", "Page size, defaults to 10").
+			QueryParam("categoryId", "Restrict results to a category").
+			QueryParam("brand", "Restrict results to a brand").
+			QueryParam("minPrice", "Minimum variant price").
+			QueryParam("maxPrice", "Maximum variant price").
+			QueryParam("inStock", "true: in-stock products only, false: out-of-stock only").
+			QueryParam("sortBy", "relevance, newest, price, popularity, sales or discount").
+			QueryParam("sortOrder", "asc or desc").
+			QueryParam(utils.LOCALE_QUERY_PARAM, "Content locale override").
+			QueryParam(utils.PRODUCT_FIELDS_QUERY_PARAM, "Result fields to return, e.g. id,name,price").
+			Returns(http.StatusOK, model.SearchResponse{})
+		productRoutes.GET(
+			utils.SEARCH_SUGGEST_ROUTE,
+			readReplica,
+			publicRoutesAuth,
+			m.productHandler.SuggestProducts,
+		).
+			Summary("Suggest products, categories and brands for a search prefix").
+			Description("Prefix and typo-tolerant matches for search-as-you-type.").
+			QueryParam("q", "Search prefix, 2 to 50 characters").
+			QueryParam("limit", "Suggestions per kind, defaults to 5, at most 10").
+			ReturnsField(http.StatusOK, utils.SEARCH_SUGGESTIONS_FIELD_NAME, model.SuggestResponse{})
+		productRoutes.GET("/filters", publicRoutesAuth, m.productHandler.GetProductFilters).
+			Summary("Get available product filters").
+			ReturnsField(http.StatusOK, utils.FILTERS_FIELD_NAME, model.ProductFilters{})
 		productRoutes.GET(
 			"/:productId/related",
+			readReplica,
 			publicRoutesAuth,
+			middleware.PlanFeature(constants.PLAN_FEATURE_RELATED_PRODUCTS_API),
 			m.productHandler.GetRelatedProductsScored,
-		)
+		).
+			Summary("List related products").
+			QueryParam("page", "Page number, defaults to 1").
+			QueryParam("limit", "Page size, defaults to 10").
+			Description("Products the seller pinned come first with strategyUsed \"manual\"; "+
+				"products the seller excluded never appear.").
+			QueryParam("strategies", "Comma-separated scoring strategies, defaults to all").
+			QueryParam(utils.LOCALE_QUERY_PARAM, "Content locale override").
+			Returns(http.StatusOK, model.RelatedProductsScoredResponse{})
+
+		// Seller curation of related products (protected)
+		productRoutes.GET(
+			utils.RELATED_CURATION_ROUTE,
+			sellerAuth,
+			m.relatedCurationHandler.GetCuration,
+		).
+			Summary("Get pinned and excluded related products").
+			ReturnsField(
+				http.StatusOK,
+				utils.RELATED_CURATION_FIELD_NAME,
+				model.RelatedCurationResponse{},
+			)
+		productRoutes.PUT(
+			utils.RELATED_CURATION_ROUTE,
+			sellerAuth,
+			m.relatedCurationHandler.UpdateCuration,
+		).
+			Summary("Replace pinned and excluded related products").
+			Description("Pinned products lead the related products in the order given.").
+			Body(model.UpdateRelatedCurationRequest{}).
+			ReturnsField(
+				http.StatusOK,
+				utils.RELATED_CURATION_FIELD_NAME,
+				model.RelatedCurationResponse{},
+			)
+		productRoutes.DELETE(
+			utils.RELATED_CURATION_ROUTE,
+			sellerAuth,
+			m.relatedCurationHandler.ClearCuration,
+		).
+			Summary("Clear pinned and excluded related products")
+
+		// Seller storefront listing/search settings (protected)
+		productRoutes.GET(
+			utils.SEARCH_SETTINGS_ROUTE,
+			sellerAuth,
+			m.searchSettingsHandler.GetSearchSettings,
+		).
+			Summary("Get storefront listing and search settings").
+			ReturnsField(
+				http.StatusOK,
+				utils.SEARCH_SETTINGS_FIELD_NAME,
+				model.SearchSettingsResponse{},
+			)
+		productRoutes.PUT(
+			utils.SEARCH_SETTINGS_ROUTE,
+			sellerAuth,
+			m.searchSettingsHandler.UpdateSearchSettings,
+		).
+			Summary("Update storefront listing and search settings").
+			Body(model.UpdateSearchSettingsRequest{}).
+			ReturnsField(
+				http.StatusOK,
+				utils.SEARCH_SETTINGS_FIELD_NAME,
+				model.SearchSettingsResponse{},
+			)
+		productRoutes.GET(
+			utils.SEARCH_SYNONYMS_ROUTE,
+			sellerAuth,
+			m.searchSettingsHandler.ListSynonymGroups,
+		).
+			Summary("List search synonym groups").
+			ReturnsField(
+				http.StatusOK,
+				utils.SEARCH_SYNONYMS_FIELD_NAME,
+				[]model.SearchSynonymGroupResponse{},
+			)
+		productRoutes.POST(
+			utils.SEARCH_SYNONYMS_ROUTE,
+			sellerAuth,
+			m.searchSettingsHandler.CreateSynonymGroup,
+		).
+			Summary("Create a search synonym group").
+			Description("Searches for any term of the group also match the other terms.").
+			Body(model.SearchSynonymGroupRequest{}).
+			ReturnsField(
+				http.StatusCreated,
+				utils.SEARCH_SYNONYM_GROUP_FIELD_NAME,
+				model.SearchSynonymGroupResponse{},
+			)
+		productRoutes.PUT(
+			utils.SEARCH_SYNONYM_ROUTE,
+			sellerAuth,
+			m.searchSettingsHandler.UpdateSynonymGroup,
+		).
+			Summary("Replace the terms of a search synonym group").
+			Body(model.SearchSynonymGroupRequest{}).
+			ReturnsField(
+				http.StatusOK,
+				utils.SEARCH_SYNONYM_GROUP_FIELD_NAME,
+				model.SearchSynonymGroupResponse{},
+			)
+		productRoutes.DELETE(
+			utils.SEARCH_SYNONYM_ROUTE,
+			sellerAuth,
+			m.searchSettingsHandler.DeleteSynonymGroup,
+		).
+			Summary("Delete a search synonym group")
+		productRoutes.GET(
+			utils.SEARCH_STOP_WORDS_ROUTE,
+			sellerAuth,
+			m.searchSettingsHandler.GetStopWords,
+		).
+			Summary("Get search stop words").
+			ReturnsField(http.StatusOK, utils.SEARCH_STOP_WORDS_FIELD_NAME, []string{})
+		productRoutes.PUT(
+			utils.SEARCH_STOP_WORDS_ROUTE,
+			sellerAuth,
+			m.searchSettingsHandler.UpdateStopWords,
+		).
+			Summary("Replace search stop words").
+			Description("Stop words are dropped from search queries unless nothing else is left.").
+			Body(model.UpdateStopWordsRequest{}).
+			ReturnsField(http.StatusOK, utils.SEARCH_STOP_WORDS_FIELD_NAME, []string{})
+
+		// Seller SKU generation patterns (protected)
+		productRoutes.GET(utils.SKU_SETTINGS_ROUTE, sellerAuth, m.skuSettingsHandler.GetSKUSettings).
+			Summary("Get SKU generation settings").
+			ReturnsField(http.StatusOK, utils.SKU_SETTINGS_FIELD_NAME, model.SKUSettingsResponse{})
+		productRoutes.PUT(
+			utils.SKU_SETTINGS_ROUTE,
+			sellerAuth,
+			m.skuSettingsHandler.UpdateSKUSettings,
+		).
+			Summary("Update SKU generation settings").
+			Description("Patterns use {BRAND}, {CAT}, {NAME} and {SEQ}; variant patterns also "+
+				"{BASE} and {OPTIONS}. Add :width to abbreviate or zero-pad, e.g. {SEQ:5}.").
+			Body(model.UpdateSKUSettingsRequest{}).
+			ReturnsField(http.StatusOK, utils.SKU_SETTINGS_FIELD_NAME, model.SKUSettingsResponse{})
+		productRoutes.POST(
+			utils.SKU_SETTINGS_PREVIEW_ROUTE,
+			sellerAuth,
+			m.skuSettingsHandler.PreviewSKU,
+		).
+			Summary("Preview generated SKUs").
+			Body(model.SKUPreviewRequest{}).
+			ReturnsField(http.StatusOK, utils.SKU_PREVIEW_FIELD_NAME, model.SKUPreviewResponse{})
+
+		// Read-through cache hit/miss counters (admin)
+		productRoutes.GET(utils.CACHE_STATS_ROUTE, middleware.AdminAuth(), m.productHandler.GetCacheStats).
+			Summary("Get product cache hit/miss statistics").
+			ReturnsField(http.StatusOK, utils.CACHE_STATS_FIELD_NAME, []cache.NamespaceStats{})
 
 		// Admin/Seller routes (protected)
-		productRoutes.POST("", sellerAuth, m.productHandler.CreateProduct)
-		productRoutes.PUT("/:productId", sellerAuth, m.productHandler.UpdateProduct)
-		productRoutes.DELETE("/:productId", sellerAuth, m.productHandler.DeleteProduct)
+		// A new product brings at least one variant, so both plan limits apply
+		productRoutes.POST(
+			"",
+			sellerAuth,
+			middleware.PlanLimit(constants.PLAN_LIMIT_PRODUCTS),
+			middleware.PlanLimit(constants.PLAN_LIMIT_VARIANTS),
+			m.productHandler.CreateProduct,
+		).
+			Summary("Create a product").
+			Body(model.ProductCreateRequest{}).
+			ReturnsField(http.StatusCreated, utils.PRODUCT_FIELD_NAME, model.ProductResponse{})
+		productRoutes.PUT("/:productId", sellerAuth, m.productHandler.UpdateProduct).
+			Summary("Update a product").
+			Body(model.ProductUpdateRequest{}).
+			ReturnsField(http.StatusOK, utils.PRODUCT_FIELD_NAME, model.ProductResponse{})
+		productRoutes.DELETE("/:productId", sellerAuth, m.productHandler.DeleteProduct).
+			Summary("Delete a product")
 
 		// Product media management routes (seller-protected)
 		mediaRoutes := productRoutes.Group("/:productId" + utils.PRODUCT_MEDIA_ROUTE)
 		{
-			mediaRoutes.POST("", sellerAuth, m.productHandler.AttachMedia)
-			mediaRoutes.PATCH(utils.PRODUCT_MEDIA_FILE_ROUTE, sellerAuth, m.productHandler.UpdateMediaMetadata)
-			mediaRoutes.DELETE(utils.PRODUCT_MEDIA_FILE_ROUTE, sellerAuth, m.productHandler.RemoveMedia)
+			mediaRoutes.POST("", sellerAuth, m.productHandler.AttachMedia).
+				Summary("Attach an image, video or 3D model to a product").
+				Body(model.AttachMediaRequest{}).
+				ReturnsField(
+					http.StatusCreated,
+					utils.MEDIA_FIELD_NAME,
+					model.ProductMediaResponse{},
+				)
+			mediaRoutes.PATCH(
+				utils.PRODUCT_MEDIA_FILE_ROUTE,
+				sellerAuth,
+				m.productHandler.UpdateMediaMetadata,
+			).
+				Summary("Update product media metadata").
+				Body(model.UpdateMediaMetadataRequest{}).
+				ReturnsField(http.StatusOK, utils.MEDIA_FIELD_NAME, model.ProductMediaResponse{})
+			mediaRoutes.DELETE(
+				utils.PRODUCT_MEDIA_FILE_ROUTE,
+				sellerAuth,
+				m.productHandler.RemoveMedia,
+			).
+				Summary("Remove media from a product").
+				Returns(http.StatusNoContent, nil)
+		}
+
+		// Product translation management routes (seller-protected)
+		translationRoutes := productRoutes.Group("/:productId" + utils.PRODUCT_TRANSLATION_ROUTE)
+		{
+			localeRoute := "/:" + utils.LOCALE_PARAM
+			translationRoutes.GET("", sellerAuth, m.translationHandler.GetTranslations).
+				Summary("List product translations").
+				Returns(http.StatusOK, model.ProductTranslationsResponse{})
+			translationRoutes.PUT(localeRoute, sellerAuth, m.translationHandler.UpsertTranslation).
+				Summary("Create or replace a product translation").
+				Body(model.UpsertProductTranslationRequest{}).
+				ReturnsField(
+					http.StatusOK,
+					utils.TRANSLATION_FIELD_NAME,
+					model.ProductTranslationResponse{},
+				)
+			translationRoutes.DELETE(
+				localeRoute,
+				sellerAuth,
+				m.translationHandler.DeleteTranslation,
+			).
+				Summary("Delete a product translation")
 		}
 	}
 }
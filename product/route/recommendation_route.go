@@ -0,0 +1,62 @@
+package route
+
+import (
+	"net/http"
+
+	"ecommerce-be/common/constants"
+	"ecommerce-be/common/middleware"
+	"ecommerce-be/common/openapi"
+	"ecommerce-be/product/factory/singleton"
+	"ecommerce-be/product/handler"
+	"ecommerce-be/product/model"
+	"ecommerce-be/product/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RecommendationModule implements the Module interface for product recommendations
+type RecommendationModule struct {
+	recommendationHandler *handler.RecommendationHandler
+}
+
+// NewRecommendationModule creates a new instance of RecommendationModule
+func NewRecommendationModule() *RecommendationModule {
+	f := singleton.GetInstance()
+
+	return &RecommendationModule{
+		recommendationHandler: f.GetRecommendationHandler(),
+	}
+}
+
+// RegisterRoutes registers "customers also bought" under the product routes and the
+// personal recommendations under the signed-in customer's routes
+func (m *RecommendationModule) RegisterRoutes(router *gin.Engine) {
+	productRoutes := openapi.NewGroup(router.Group(constants.APIBaseProduct), "Products")
+	{
+		productRoutes.GET(
+			utils.ALSO_BOUGHT_ROUTE,
+			middleware.ReadReplica(),
+			middleware.PublicAPIAuth(),
+			m.recommendationHandler.GetAlsoBought,
+		).
+			Summary("Get products customers also bought").
+			Description("Products of the same seller bought by customers who bought this "+
+				"one, most shared customers first. Rebuilt nightly from order history.").
+			QueryParam("limit", "Number of products (default 10, max 30)").
+			ReturnsField(http.StatusOK, utils.ALSO_BOUGHT_FIELD_NAME, []model.ProductResponse{})
+	}
+
+	meRoutes := openapi.NewGroup(router.Group(constants.APIBaseMe), "Recommendations")
+	{
+		meRoutes.GET(
+			utils.RECOMMENDATIONS_ROUTE,
+			middleware.CustomerAuth(),
+			m.recommendationHandler.GetRecommendations,
+		).
+			Summary("Get products recommended to the customer").
+			Description("Products bought alongside the customer's past purchases that they "+
+				"do not own yet, scoped to the seller from X-Seller-ID when present.").
+			QueryParam("limit", "Number of products (default 10, max 30)").
+			ReturnsField(http.StatusOK, utils.RECOMMENDATIONS_FIELD_NAME, []model.ProductResponse{})
+	}
+}
@@ -0,0 +1,40 @@
+package route
+
+import (
+	"ecommerce-be/common/middleware"
+	"ecommerce-be/common/openapi"
+	"ecommerce-be/product/factory/singleton"
+	"ecommerce-be/product/handler"
+	"ecommerce-be/product/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SitemapModule implements the Module interface for the storefront sitemap
+type SitemapModule struct {
+	sitemapHandler *handler.SitemapHandler
+}
+
+// NewSitemapModule creates a new instance of SitemapModule
+func NewSitemapModule() *SitemapModule {
+	f := singleton.GetInstance()
+
+	return &SitemapModule{
+		sitemapHandler: f.GetSitemapHandler(),
+	}
+}
+
+// RegisterRoutes registers the sitemap at the site root, where crawlers look for it
+func (m *SitemapModule) RegisterRoutes(router *gin.Engine) {
+	publicRoutesAuth := middleware.PublicAPIAuth()
+
+	sitemapRoutes := openapi.NewGroup(router.Group(""), "Sitemap")
+	{
+		sitemapRoutes.GET(utils.SITEMAP_ROUTE, publicRoutesAuth, m.sitemapHandler.GetSitemap).
+			Summary("Get the seller's sitemap").
+			Description("Sellers are resolved from the storefront domain or X-Seller-ID. "+
+				"Large sitemaps return an index of pages fetched with ?page=N.").
+			QueryParam(utils.SITEMAP_PAGE_PARAM, "Sitemap page; omit for the index").
+			Produces("application/xml")
+	}
+}
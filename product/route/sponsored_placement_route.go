@@ -0,0 +1,82 @@
+package route
+
+import (
+	"net/http"
+
+	"ecommerce-be/common/constants"
+	"ecommerce-be/common/middleware"
+	"ecommerce-be/common/openapi"
+	"ecommerce-be/product/factory/singleton"
+	"ecommerce-be/product/handler"
+	"ecommerce-be/product/model"
+	"ecommerce-be/product/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SponsoredPlacementModule implements the Module interface for sponsored placement routes
+type SponsoredPlacementModule struct {
+	sponsoredHandler *handler.SponsoredPlacementHandler
+}
+
+// NewSponsoredPlacementModule creates a new instance of SponsoredPlacementModule
+func NewSponsoredPlacementModule() *SponsoredPlacementModule {
+	f := singleton.GetInstance()
+
+	return &SponsoredPlacementModule{
+		sponsoredHandler: f.GetSponsoredPlacementHandler(),
+	}
+}
+
+// RegisterRoutes registers sponsored placement management and tracking routes
+func (m *SponsoredPlacementModule) RegisterRoutes(router *gin.Engine) {
+	sellerAuth := middleware.SellerAuth()
+	publicRoutesAuth := middleware.PublicAPIAuth()
+
+	sponsoredRoutes := openapi.NewGroup(
+		router.Group(constants.APIBaseProduct+utils.SPONSORED_PLACEMENT_ROUTE),
+		"Sponsored Placements",
+	)
+	{
+		// Seller management routes (protected)
+		sponsoredRoutes.GET("", sellerAuth, m.sponsoredHandler.ListPlacements).
+			Summary("List sponsored placements with impressions and clicks").
+			ReturnsField(
+				http.StatusOK,
+				utils.SPONSORED_PLACEMENTS_FIELD_NAME,
+				[]model.SponsoredPlacementResponse{},
+			)
+		sponsoredRoutes.POST("", sellerAuth, m.sponsoredHandler.CreatePlacement).
+			Summary("Create a sponsored placement").
+			Body(model.SponsoredPlacementRequest{}).
+			ReturnsField(
+				http.StatusCreated,
+				utils.SPONSORED_PLACEMENT_FIELD_NAME,
+				model.SponsoredPlacementResponse{},
+			)
+		sponsoredRoutes.PUT("/:placementId", sellerAuth, m.sponsoredHandler.UpdatePlacement).
+			Summary("Update a sponsored placement").
+			Body(model.SponsoredPlacementRequest{}).
+			ReturnsField(
+				http.StatusOK,
+				utils.SPONSORED_PLACEMENT_FIELD_NAME,
+				model.SponsoredPlacementResponse{},
+			)
+		sponsoredRoutes.DELETE("/:placementId", sellerAuth, m.sponsoredHandler.DeletePlacement).
+			Summary("Delete a sponsored placement")
+
+		// Storefront tracking routes (public)
+		sponsoredRoutes.POST(
+			utils.SPONSORED_PLACEMENT_IMPRESSION_ROUTE,
+			publicRoutesAuth,
+			m.sponsoredHandler.RecordImpression,
+		).
+			Summary("Record a sponsored result impression")
+		sponsoredRoutes.POST(
+			utils.SPONSORED_PLACEMENT_CLICK_ROUTE,
+			publicRoutesAuth,
+			m.sponsoredHandler.RecordClick,
+		).
+			Summary("Record a sponsored result click")
+	}
+}
@@ -1,10 +1,14 @@
 package route
 
 import (
+	"net/http"
+
 	"ecommerce-be/common/constants"
 	"ecommerce-be/common/middleware"
+	"ecommerce-be/common/openapi"
 	"ecommerce-be/product/factory/singleton"
 	"ecommerce-be/product/handler"
+	"ecommerce-be/product/model"
 	"ecommerce-be/product/utils"
 
 	"github.com/gin-gonic/gin"
@@ -12,7 +16,9 @@ import (
 
 // VariantModule implements the Module interface for variant routes
 type VariantModule struct {
-	variantHandler *handler.VariantHandler
+	variantHandler      *handler.VariantHandler
+	channelPriceHandler *handler.VariantChannelPriceHandler
+	bundleHandler       *handler.VariantBundleHandler
 }
 
 // NewVariantModule creates a new instance of VariantModule
@@ -20,7 +26,9 @@ func NewVariantModule() *VariantModule {
 	f := singleton.GetInstance()
 
 	return &VariantModule{
-		variantHandler: f.GetVariantHandler(),
+		variantHandler:      f.GetVariantHandler(),
+		channelPriceHandler: f.GetVariantChannelPriceHandler(),
+		bundleHandler:       f.GetVariantBundleHandler(),
 	}
 }
 
@@ -28,27 +36,166 @@ func NewVariantModule() *VariantModule {
 func (m *VariantModule) RegisterRoutes(router *gin.Engine) {
 	publicRoutesAuth := middleware.PublicAPIAuth()
 	sellerAuth := middleware.SellerAuth()
+	bulkBodyLimit := middleware.BulkBodySizeLimit()
 
 	// List/filter variants (public - for home page, search, etc.) - /api/product/variant
-	router.GET(constants.APIBaseProduct+"/variant", publicRoutesAuth, m.variantHandler.ListVariants)
+	openapi.NewGroup(&router.RouterGroup, "Variants").
+		GET(constants.APIBaseProduct+"/variant", publicRoutesAuth, m.variantHandler.ListVariants).
+		Summary("List and filter variants").
+		Description(
+			"Option values can be passed as additional query parameters keyed by option name.",
+		).
+		Query(model.ListVariantsRequest{}).
+		Returns(http.StatusOK, model.ListVariantsResponse{})
+
+	// Barcode scan lookup (seller-protected) - /api/product/lookup
+	openapi.NewGroup(&router.RouterGroup, "Variants").
+		GET(
+			constants.APIBaseProduct+utils.BARCODE_LOOKUP_ROUTE,
+			sellerAuth,
+			m.variantHandler.LookupVariantByBarcode,
+		).
+		Summary("Find the seller's variant by barcode").
+		Description("Accepts EAN-8, UPC-A, EAN-13 and GTIN-14; a UPC-A also matches its "+
+			"EAN-13 form.").
+		QueryParam(utils.BARCODE_QUERY_PARAM, "Scanned EAN/UPC/GTIN barcode").
+		QueryParam(utils.SALES_CHANNEL_QUERY_PARAM, "Sales channel used for channel pricing").
+		ReturnsField(http.StatusOK, utils.VARIANT_FIELD_NAME, model.VariantDetailResponse{})
 
 	// Product-specific variant routes - /api/product/:productId/variant/*
-	variantRoutes := router.Group(constants.APIBaseProduct + "/:productId/variant")
+	variantRoutes := openapi.NewGroup(
+		router.Group(constants.APIBaseProduct+"/:productId/variant"),
+		"Variants",
+	)
 	{
-		variantRoutes.GET("/find", publicRoutesAuth, m.variantHandler.FindVariantByOptions)
-		variantRoutes.GET("/:variantId", publicRoutesAuth, m.variantHandler.GetVariantByID)
+		variantRoutes.GET("/find", publicRoutesAuth, m.variantHandler.FindVariantByOptions).
+			Summary("Find the variant matching selected option values").
+			Description("Option values are passed as query parameters keyed by option name.").
+			QueryParam(utils.SALES_CHANNEL_QUERY_PARAM, "Sales channel used for channel pricing").
+			ReturnsField(http.StatusOK, utils.VARIANT_FIELD_NAME, model.VariantResponse{})
+		variantRoutes.GET("/:variantId", publicRoutesAuth, m.variantHandler.GetVariantByID).
+			Summary("Get variant details").
+			QueryParam(utils.SALES_CHANNEL_QUERY_PARAM, "Sales channel used for channel pricing").
+			ReturnsField(http.StatusOK, utils.VARIANT_FIELD_NAME, model.VariantDetailResponse{})
+
+		variantRoutes.POST(
+			"",
+			sellerAuth,
+			middleware.PlanLimit(constants.PLAN_LIMIT_VARIANTS),
+			m.variantHandler.CreateVariant,
+		).
+			Summary("Create a variant").
+			Body(model.CreateVariantRequest{}).
+			ReturnsField(
+				http.StatusCreated,
+				utils.VARIANT_FIELD_NAME,
+				model.VariantDetailResponse{},
+			)
+		variantRoutes.PUT("/:variantId", sellerAuth, m.variantHandler.UpdateVariant).
+			Summary("Update a variant").
+			Body(model.UpdateVariantRequest{}).
+			ReturnsField(http.StatusOK, utils.VARIANT_FIELD_NAME, model.VariantDetailResponse{})
+		variantRoutes.PUT(
+			"/bulk",
+			bulkBodyLimit,
+			sellerAuth,
+			m.variantHandler.BulkUpdateVariants,
+		).
+			Summary("Bulk update variants").
+			Description("Each variant is updated only at the version it was read at. "+
+				"Stale variants fail the request with 409 listing their current versions.").
+			Body(model.BulkUpdateVariantsRequest{}).
+			Returns(http.StatusOK, model.BulkUpdateVariantsResponse{})
+		variantRoutes.POST(
+			utils.VARIANT_GENERATE_ROUTE,
+			sellerAuth,
+			middleware.PlanLimit(constants.PLAN_LIMIT_VARIANTS),
+			m.variantHandler.GenerateVariants,
+		).
+			Summary("Generate variants from the option matrix").
+			Description("Creates the variants of the listed combinations, or of every "+
+				"combination the option rules allow with all=true, that do not exist yet. "+
+				"SKUs are rendered from skuPattern, whose placeholders name options "+
+				"(e.g. TEE-{color}-{size}) or {baseSku}.").
+			Body(model.GenerateVariantsRequest{}).
+			Returns(http.StatusCreated, model.GenerateVariantsResponse{})
+		variantRoutes.DELETE("/:variantId", sellerAuth, m.variantHandler.DeleteVariant).
+			Summary("Delete a variant")
+
+		// Per-channel price overrides (seller-protected, bulk)
+		channelPriceRoutes := variantRoutes.Group(utils.CHANNEL_PRICE_ROUTE)
+		{
+			channelPriceRoutes.GET("", sellerAuth, m.channelPriceHandler.GetChannelPrices).
+				Summary("List per-channel variant prices").
+				ReturnsField(
+					http.StatusOK,
+					utils.CHANNEL_PRICES_FIELD_NAME,
+					[]model.VariantChannelPricesResponse{},
+				)
+			channelPriceRoutes.PUT("", sellerAuth, m.channelPriceHandler.UpsertChannelPrices).
+				Summary("Create or update per-channel variant prices").
+				Body(model.UpsertChannelPricesRequest{}).
+				ReturnsField(
+					http.StatusOK,
+					utils.CHANNEL_PRICES_FIELD_NAME,
+					[]model.VariantChannelPricesResponse{},
+				)
+			channelPriceRoutes.DELETE("", sellerAuth, m.channelPriceHandler.DeleteChannelPrices).
+				Summary("Delete per-channel variant prices").
+				Body(model.DeleteChannelPricesRequest{}).
+				ReturnsField(http.StatusOK, utils.DELETED_COUNT_FIELD_NAME, int64(0))
+		}
 
-		variantRoutes.POST("", sellerAuth, m.variantHandler.CreateVariant)
-		variantRoutes.PUT("/:variantId", sellerAuth, m.variantHandler.UpdateVariant)
-		variantRoutes.PUT("/bulk", sellerAuth, m.variantHandler.BulkUpdateVariants)
-		variantRoutes.DELETE("/:variantId", sellerAuth, m.variantHandler.DeleteVariant)
+		// Bundle (kit) components: public read, seller-protected writes
+		bundleRoutes := variantRoutes.Group(utils.BUNDLE_ROUTE)
+		{
+			bundleRoutes.GET("", publicRoutesAuth, m.bundleHandler.GetBundle).
+				Summary("Get a bundle's components and pricing").
+				ReturnsField(http.StatusOK, utils.BUNDLE_FIELD_NAME, model.VariantBundleResponse{})
+			bundleRoutes.PUT("", sellerAuth, m.bundleHandler.SetBundle).
+				Summary("Set the components of a bundle variant").
+				Description("Replaces the component list. The bundle sells at the variant's "+
+					"price; its stock is derived from and reserved on the components.").
+				Body(model.SetBundleComponentsRequest{}).
+				ReturnsField(http.StatusOK, utils.BUNDLE_FIELD_NAME, model.VariantBundleResponse{})
+			bundleRoutes.DELETE("", sellerAuth, m.bundleHandler.DeleteBundle).
+				Summary("Turn a bundle back into a regular variant")
+		}
 
 		// Variant media management routes (seller-protected)
 		variantMediaRoutes := variantRoutes.Group("/:variantId" + utils.VARIANT_MEDIA_ROUTE)
 		{
-			variantMediaRoutes.POST("", sellerAuth, m.variantHandler.AttachVariantMedia)
-			variantMediaRoutes.PATCH(utils.VARIANT_MEDIA_FILE_ROUTE, sellerAuth, m.variantHandler.UpdateVariantMediaMetadata)
-			variantMediaRoutes.DELETE(utils.VARIANT_MEDIA_FILE_ROUTE, sellerAuth, m.variantHandler.RemoveVariantMedia)
+			variantMediaRoutes.POST("", sellerAuth, m.variantHandler.AttachVariantMedia).
+				Summary("Attach an uploaded file to a variant").
+				Body(model.AttachVariantMediaRequest{}).
+				ReturnsField(
+					http.StatusCreated,
+					utils.MEDIA_FIELD_NAME,
+					model.VariantMediaResponse{},
+				)
+			variantMediaRoutes.PATCH(
+				utils.VARIANT_MEDIA_FILE_ROUTE,
+				sellerAuth,
+				m.variantHandler.UpdateVariantMediaMetadata,
+			).
+				Summary("Update variant media metadata").
+				Body(model.UpdateVariantMediaMetadataRequest{}).
+				ReturnsField(http.StatusOK, utils.MEDIA_FIELD_NAME, model.VariantMediaResponse{})
+			variantMediaRoutes.PUT(
+				utils.VARIANT_MEDIA_ORDER_ROUTE,
+				sellerAuth,
+				m.variantHandler.ReorderVariantMedia,
+			).
+				Summary("Reorder variant media").
+				Body(model.ReorderVariantMediaRequest{}).
+				ReturnsField(http.StatusOK, utils.MEDIA_FIELD_NAME, []model.VariantMediaResponse{})
+			variantMediaRoutes.DELETE(
+				utils.VARIANT_MEDIA_FILE_ROUTE,
+				sellerAuth,
+				m.variantHandler.RemoveVariantMedia,
+			).
+				Summary("Remove media from a variant").
+				Returns(http.StatusNoContent, nil)
 		}
 	}
 }
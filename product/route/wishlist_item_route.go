@@ -1,10 +1,15 @@
 package route
 
 import (
+	"net/http"
+
 	"ecommerce-be/common/constants"
 	"ecommerce-be/common/middleware"
+	"ecommerce-be/common/openapi"
 	"ecommerce-be/product/factory/singleton"
 	"ecommerce-be/product/handler"
+	"ecommerce-be/product/model"
+	"ecommerce-be/product/utils"
 
 	"github.com/gin-gonic/gin"
 )
@@ -28,11 +33,29 @@ func (m *WishlistItemModule) RegisterRoutes(router *gin.Engine) {
 	customerAuth := middleware.CustomerAuth()
 
 	// Wishlist Item routes - /api/wishlist/:id/item
-	wishlistItemRoutes := router.Group(constants.APIBaseProduct + "/wishlist/:id/item")
+	wishlistItemRoutes := openapi.NewGroup(
+		router.Group(constants.APIBaseProduct+"/wishlist/:id/item"),
+		"Wishlists",
+	)
 	{
 		// Customer routes (protected)
-		wishlistItemRoutes.POST("", customerAuth, m.wishlistItemHandler.AddItem)
-		wishlistItemRoutes.DELETE("/:itemId", customerAuth, m.wishlistItemHandler.RemoveItem)
-		wishlistItemRoutes.POST("/:itemId/move", customerAuth, m.wishlistItemHandler.MoveItem)
+		wishlistItemRoutes.POST("", customerAuth, m.wishlistItemHandler.AddItem).
+			Summary("Add a variant to a wishlist").
+			Body(model.WishlistItemCreateRequest{}).
+			ReturnsField(
+				http.StatusCreated,
+				utils.WISHLIST_ITEM_FIELD_NAME,
+				model.WishlistItemResponse{},
+			)
+		wishlistItemRoutes.DELETE("/:itemId", customerAuth, m.wishlistItemHandler.RemoveItem).
+			Summary("Remove an item from a wishlist")
+		wishlistItemRoutes.POST("/:itemId/move", customerAuth, m.wishlistItemHandler.MoveItem).
+			Summary("Move an item to another wishlist").
+			Body(model.WishlistItemMoveRequest{}).
+			ReturnsField(
+				http.StatusOK,
+				utils.WISHLIST_ITEM_FIELD_NAME,
+				model.WishlistItemResponse{},
+			)
 	}
 }
@@ -1,10 +1,16 @@
 package route
 
 import (
+	"net/http"
+
+	"ecommerce-be/common"
 	"ecommerce-be/common/constants"
 	"ecommerce-be/common/middleware"
+	"ecommerce-be/common/openapi"
 	"ecommerce-be/product/factory/singleton"
 	"ecommerce-be/product/handler"
+	"ecommerce-be/product/model"
+	"ecommerce-be/product/utils"
 
 	"github.com/gin-gonic/gin"
 )
@@ -28,13 +34,28 @@ func (m *WishlistModule) RegisterRoutes(router *gin.Engine) {
 	customerAuth := middleware.CustomerAuth()
 
 	// Wishlist routes - /api/wishlist
-	wishlistRoutes := router.Group(constants.APIBaseProduct + "/wishlist")
+	wishlistRoutes := openapi.NewGroup(
+		router.Group(constants.APIBaseProduct+"/wishlist"),
+		"Wishlists",
+	)
 	{
 		// Customer routes (protected)
-		wishlistRoutes.GET("", customerAuth, m.wishlistHandler.GetAllWishlists)
-		wishlistRoutes.POST("", customerAuth, m.wishlistHandler.CreateWishlist)
-		wishlistRoutes.GET("/:id", customerAuth, m.wishlistHandler.GetWishlistByID)
-		wishlistRoutes.PUT("/:id", customerAuth, m.wishlistHandler.UpdateWishlist)
-		wishlistRoutes.DELETE("/:id", customerAuth, m.wishlistHandler.DeleteWishlist)
+		wishlistRoutes.GET("", customerAuth, m.wishlistHandler.GetAllWishlists).
+			Summary("List the caller's wishlists").
+			ReturnsField(http.StatusOK, utils.WISHLISTS_FIELD_NAME, []model.WishlistResponse{})
+		wishlistRoutes.POST("", customerAuth, m.wishlistHandler.CreateWishlist).
+			Summary("Create a wishlist").
+			Body(model.WishlistCreateRequest{}).
+			ReturnsField(http.StatusCreated, utils.WISHLIST_FIELD_NAME, model.WishlistResponse{})
+		wishlistRoutes.GET("/:id", customerAuth, m.wishlistHandler.GetWishlistByID).
+			Summary("Get a wishlist with its items").
+			Query(common.BaseListParams{}).
+			ReturnsField(http.StatusOK, utils.WISHLIST_FIELD_NAME, model.WishlistDetailResponse{})
+		wishlistRoutes.PUT("/:id", customerAuth, m.wishlistHandler.UpdateWishlist).
+			Summary("Rename a wishlist").
+			Body(model.WishlistUpdateRequest{}).
+			ReturnsField(http.StatusOK, utils.WISHLIST_FIELD_NAME, model.WishlistResponse{})
+		wishlistRoutes.DELETE("/:id", customerAuth, m.wishlistHandler.DeleteWishlist).
+			Summary("Delete a wishlist")
 	}
 }
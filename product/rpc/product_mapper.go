@@ -0,0 +1,81 @@
+package rpc
+
+import (
+	"ecommerce-be/common"
+	"ecommerce-be/product/model"
+	productv1 "ecommerce-be/proto/product/v1"
+)
+
+func toProtoProduct(product *model.ProductResponse) *productv1.Product {
+	result := &productv1.Product{
+		Id:               uint64(product.ID),
+		Name:             product.Name,
+		CategoryId:       uint64(product.CategoryID),
+		Brand:            product.Brand,
+		Sku:              product.SKU,
+		ShortDescription: product.ShortDescription,
+		LongDescription:  product.LongDescription,
+		Tags:             product.Tags,
+		SellerId:         uint64(product.SellerID),
+		Locale:           product.Locale,
+		HasVariants:      product.HasVariants,
+		Price:            product.Price,
+		MinPrice:         product.Price,
+		MaxPrice:         product.Price,
+		AllowPurchase:    product.AllowPurchase,
+		Variants:         make([]*productv1.Variant, 0, len(product.Variants)),
+		ImageUrls:        make([]string, 0, len(product.Media)),
+	}
+	if product.PriceRange != nil {
+		result.MinPrice = product.PriceRange.Min
+		result.MaxPrice = product.PriceRange.Max
+	}
+
+	for _, variant := range product.Variants {
+		options := make([]*productv1.VariantOption, 0, len(variant.SelectedOptions))
+		for _, option := range variant.SelectedOptions {
+			options = append(options, &productv1.VariantOption{
+				OptionName: option.OptionName,
+				Value:      option.Value,
+				ColorCode:  option.ColorCode,
+			})
+		}
+		result.Variants = append(result.Variants, &productv1.Variant{
+			Id:              uint64(variant.ID),
+			Sku:             variant.SKU,
+			Price:           variant.Price,
+			AllowPurchase:   variant.AllowPurchase,
+			IsDefault:       variant.IsDefault,
+			SelectedOptions: options,
+		})
+	}
+
+	for _, media := range product.Media {
+		if media.URL != "" {
+			result.ImageUrls = append(result.ImageUrls, media.URL)
+		}
+	}
+	return result
+}
+
+func toProtoPagination(pagination common.PaginationResponse) *productv1.Pagination {
+	return &productv1.Pagination{
+		CurrentPage:  int32(pagination.CurrentPage),
+		TotalPages:   int32(pagination.TotalPages),
+		TotalItems:   int32(pagination.TotalItems),
+		ItemsPerPage: int32(pagination.ItemsPerPage),
+		HasNext:      pagination.HasNext,
+		HasPrev:      pagination.HasPrev,
+	}
+}
+
+func toUintSlice(values []uint64) []uint {
+	if len(values) == 0 {
+		return nil
+	}
+	result := make([]uint, 0, len(values))
+	for _, value := range values {
+		result = append(result, uint(value))
+	}
+	return result
+}
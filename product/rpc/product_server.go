@@ -0,0 +1,200 @@
+// Package rpc exposes product read APIs over gRPC using the same query service as the
+// REST handlers. Caller identity is resolved by the grpcserver interceptors.
+package rpc
+
+import (
+	"context"
+
+	"ecommerce-be/common/auth"
+	commonError "ecommerce-be/common/error"
+	"ecommerce-be/product/model"
+	"ecommerce-be/product/service"
+	"ecommerce-be/product/utils"
+	"ecommerce-be/product/validator"
+	productv1 "ecommerce-be/proto/product/v1"
+)
+
+// ProductServer implements productv1.ProductServiceServer
+type ProductServer struct {
+	productv1.UnimplementedProductServiceServer
+
+	productQueryService service.ProductQueryService
+}
+
+// NewProductServer creates a gRPC product server backed by the product query service
+func NewProductServer(productQueryService service.ProductQueryService) *ProductServer {
+	return &ProductServer{productQueryService: productQueryService}
+}
+
+// GetProduct returns a single product scoped to the caller's seller
+func (s *ProductServer) GetProduct(
+	ctx context.Context,
+	req *productv1.GetProductRequest,
+) (*productv1.GetProductResponse, error) {
+	if req.GetProductId() == 0 {
+		return nil, commonError.ErrInvalidID
+	}
+	ctx, err := withContentLocale(ctx, req.GetLocale())
+	if err != nil {
+		return nil, err
+	}
+
+	sellerIDPtr, userIDPtr := callerScope(ctx)
+	product, err := s.productQueryService.GetProductByID(
+		ctx,
+		uint(req.GetProductId()),
+		sellerIDPtr,
+		userIDPtr,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &productv1.GetProductResponse{Product: toProtoProduct(product)}, nil
+}
+
+// ListProducts returns a page of the caller's seller catalogue
+func (s *ProductServer) ListProducts(
+	ctx context.Context,
+	req *productv1.ListProductsRequest,
+) (*productv1.ListProductsResponse, error) {
+	ctx, err := withContentLocale(ctx, req.GetLocale())
+	if err != nil {
+		return nil, err
+	}
+
+	sellerIDPtr, userIDPtr := callerScope(ctx)
+	filter := model.GetProductsFilter{
+		CategoryIDs: toUintSlice(req.GetCategoryIds()),
+		Brands:      req.GetBrands(),
+		IDs:         toUintSlice(req.GetIds()),
+	}
+	filter.Page = int(req.GetPage())
+	filter.PageSize = int(req.GetPageSize())
+	filter.SellerID = sellerIDPtr
+	if req.GetMinPrice() > 0 {
+		minPrice := req.GetMinPrice()
+		filter.MinPrice = &minPrice
+	}
+	if req.GetMaxPrice() > 0 {
+		maxPrice := req.GetMaxPrice()
+		filter.MaxPrice = &maxPrice
+	}
+	filter.SetDefaults()
+
+	products, err := s.productQueryService.GetAllProducts(
+		ctx,
+		filter.Page,
+		filter.PageSize,
+		filter,
+		userIDPtr,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &productv1.ListProductsResponse{
+		Products:   make([]*productv1.Product, 0, len(products.Products)),
+		Pagination: toProtoPagination(products.Pagination),
+	}
+	for i := range products.Products {
+		response.Products = append(response.Products, toProtoProduct(&products.Products[i]))
+	}
+	return response, nil
+}
+
+// SearchProducts runs a full-text search over the caller's seller catalogue
+func (s *ProductServer) SearchProducts(
+	ctx context.Context,
+	req *productv1.SearchProductsRequest,
+) (*productv1.SearchProductsResponse, error) {
+	if req.GetQuery() == "" {
+		return nil, commonError.ErrRequiredQueryParam.WithMessage(
+			"Search query field 'query' is required",
+		)
+	}
+	ctx, err := withContentLocale(ctx, req.GetLocale())
+	if err != nil {
+		return nil, err
+	}
+
+	page := int(req.GetPage())
+	if page <= 0 {
+		page = 1
+	}
+	limit := int(req.GetLimit())
+	if limit <= 0 {
+		limit = 10
+	}
+
+	filters := make(map[string]any)
+	if req.GetCategoryId() > 0 {
+		filters["categoryId"] = uint(req.GetCategoryId())
+	}
+	if req.GetBrand() != "" {
+		filters["brand"] = req.GetBrand()
+	}
+	if req.GetMinPrice() > 0 {
+		filters["minPrice"] = req.GetMinPrice()
+	}
+	if req.GetMaxPrice() > 0 {
+		filters["maxPrice"] = req.GetMaxPrice()
+	}
+
+	sellerIDPtr, userIDPtr := callerScope(ctx)
+	if sellerIDPtr != nil {
+		filters["sellerId"] = *sellerIDPtr
+	}
+
+	searchResponse, err := s.productQueryService.SearchProducts(
+		ctx,
+		req.GetQuery(),
+		filters,
+		page,
+		limit,
+		req.GetSortBy(),
+		req.GetSortOrder(),
+		userIDPtr,
+		"", // internal callers get organic results only
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &productv1.SearchProductsResponse{
+		Results:    make([]*productv1.SearchResult, 0, len(searchResponse.Results)),
+		Pagination: toProtoPagination(searchResponse.Pagination),
+	}
+	for i := range searchResponse.Results {
+		result := &searchResponse.Results[i]
+		response.Results = append(response.Results, &productv1.SearchResult{
+			Product:        toProtoProduct(&result.ProductResponse),
+			RelevanceScore: result.RelevanceScore,
+			MatchedFields:  result.MatchedFields,
+		})
+	}
+	return response, nil
+}
+
+// callerScope returns the seller and user resolved by the auth interceptor.
+// Seller is absent only for admins operating across sellers.
+func callerScope(ctx context.Context) (sellerIDPtr *uint, userIDPtr *uint) {
+	if sellerID, exists := auth.GetSellerIDFromContext(ctx); exists {
+		sellerIDPtr = &sellerID
+	}
+	if userID, exists := auth.GetUserIDFromContext(ctx); exists {
+		userIDPtr = &userID
+	}
+	return sellerIDPtr, userIDPtr
+}
+
+// withContentLocale mirrors the REST ?locale override for product content
+func withContentLocale(ctx context.Context, raw string) (context.Context, error) {
+	locale, err := validator.ParseContentLocale(raw)
+	if err != nil {
+		return ctx, err
+	}
+	if locale != "" {
+		ctx = utils.ContentLocale.With(ctx, locale)
+	}
+	return ctx, nil
+}
@@ -4,11 +4,13 @@ import (
 	"context"
 
 	"ecommerce-be/common/constants"
+	"ecommerce-be/common/db"
 	"ecommerce-be/product/entity"
 	prodErrors "ecommerce-be/product/error"
 	"ecommerce-be/product/factory"
 	"ecommerce-be/product/model"
 	"ecommerce-be/product/repository"
+	"ecommerce-be/product/utils"
 	"ecommerce-be/product/validator"
 )
 
@@ -35,6 +37,15 @@ type CategoryService interface {
 	) error
 	GetAllCategories(ctx context.Context, sellerID *uint) (*model.CategoriesResponse, error)
 	GetCategoryByID(ctx context.Context, id uint, sellerID *uint) (*model.CategoryResponse, error)
+
+	// GetCategoryBySlug returns a global or seller category by slug. For a retired slug
+	// it returns no category but the category's current slug to redirect to.
+	GetCategoryBySlug(
+		ctx context.Context,
+		sellerID uint,
+		slug string,
+	) (*model.CategoryResponse, string, error)
+
 	GetCategoriesByParent(ctx context.Context, parentID *uint, sellerID *uint) (*model.CategoriesResponse, error)
 
 	// GetCategoryWithParent retrieves a category and its parent (if exists) in optimized way
@@ -67,6 +78,7 @@ type CategoryServiceImpl struct {
 	categoryRepo  repository.CategoryRepository
 	productRepo   repository.ProductRepository
 	attributeRepo repository.AttributeDefinitionRepository
+	redirectRepo  repository.SlugRedirectRepository
 }
 
 // NewCategoryService creates a new instance of CategoryService
@@ -74,11 +86,13 @@ func NewCategoryService(
 	categoryRepo repository.CategoryRepository,
 	productRepo repository.ProductRepository,
 	attributeRepo repository.AttributeDefinitionRepository,
+	redirectRepo repository.SlugRedirectRepository,
 ) CategoryService {
 	return &CategoryServiceImpl{
 		categoryRepo:  categoryRepo,
 		productRepo:   productRepo,
 		attributeRepo: attributeRepo,
+		redirectRepo:  redirectRepo,
 	}
 }
 
@@ -119,9 +133,22 @@ func (s *CategoryServiceImpl) CreateCategory(
 
 	// Create category entity using factory
 	category := factory.BuildCategoryEntityFromCreateRequest(req, global, &sellerId)
+	category.Slug, err = s.resolveCategorySlug(ctx, category.SellerID, req.Slug, req.Name, 0)
+	if err != nil {
+		return nil, err
+	}
 
-	// Save category to database
-	if err := s.categoryRepo.Create(ctx, category); err != nil {
+	// Save category to database; a retired slug it claims stops redirecting
+	err = db.WithTransaction(ctx, func(txCtx context.Context) error {
+		if err := s.categoryRepo.Create(txCtx, category); err != nil {
+			return err
+		}
+		invalidateCategorySitemaps(txCtx, category)
+		return s.redirectRepo.DeleteBySlug(
+			txCtx, utils.SLUG_ENTITY_CATEGORY, category.SellerID, category.Slug,
+		)
+	})
+	if err != nil {
 		return nil, err
 	}
 
@@ -210,10 +237,35 @@ func (s *CategoryServiceImpl) UpdateCategory(
 	}
 
 	// Update category using factory
+	oldSlug := category.Slug
 	category = factory.BuildCategoryEntityFromUpdateReq(category, req)
+	if req.Slug != nil && *req.Slug != oldSlug {
+		category.Slug, err = s.resolveCategorySlug(ctx, category.SellerID, req.Slug, req.Name, id)
+		if err != nil {
+			return nil, err
+		}
+	}
 
-	// Save updated category
-	if err := s.categoryRepo.Update(ctx, category); err != nil {
+	// Save updated category, keeping a changed slug's predecessor as a redirect
+	err = db.WithTransaction(ctx, func(txCtx context.Context) error {
+		if err := s.categoryRepo.Update(txCtx, category); err != nil {
+			return err
+		}
+		invalidateCategorySitemaps(txCtx, category)
+		if category.Slug == oldSlug {
+			return nil
+		}
+		return moveSlug(
+			txCtx,
+			s.redirectRepo,
+			utils.SLUG_ENTITY_CATEGORY,
+			category.SellerID,
+			category.ID,
+			oldSlug,
+			category.Slug,
+		)
+	})
+	if err != nil {
 		return nil, err
 	}
 
@@ -264,8 +316,54 @@ func (s *CategoryServiceImpl) DeleteCategory(
 		return err
 	}
 
-	// Soft delete category
-	return s.categoryRepo.Delete(ctx, id)
+	// Delete the category along with the redirects of its retired slugs
+	return db.WithTransaction(ctx, func(txCtx context.Context) error {
+		if err := s.redirectRepo.DeleteByEntity(
+			txCtx, utils.SLUG_ENTITY_CATEGORY, id,
+		); err != nil {
+			return err
+		}
+		invalidateCategorySitemaps(txCtx, category)
+		return s.categoryRepo.Delete(txCtx, id)
+	})
+}
+
+// invalidateCategorySitemaps evicts the sitemaps that may list the category: its
+// seller's, or every seller's for a global category
+func invalidateCategorySitemaps(ctx context.Context, category *entity.Category) {
+	if category.SellerID != nil {
+		InvalidateSitemapCache(ctx, *category.SellerID)
+		return
+	}
+	repository.InvalidateCacheNamespace(ctx, utils.SITEMAP_CACHE_NAMESPACE)
+}
+
+// resolveCategorySlug returns the requested slug if no other category in the scope
+// uses it, or generates a free slug from the name when none is requested
+func (s *CategoryServiceImpl) resolveCategorySlug(
+	ctx context.Context,
+	sellerID *uint,
+	requested *string,
+	name string,
+	excludeID uint,
+) (string, error) {
+	exists := func(ctx context.Context, slug string) (bool, error) {
+		return s.categoryRepo.SlugExists(ctx, sellerID, slug, excludeID)
+	}
+
+	if requested != nil {
+		taken, err := exists(ctx, *requested)
+		if err != nil {
+			return "", err
+		}
+		if taken {
+			return "", prodErrors.ErrCategorySlugTaken
+		}
+		return *requested, nil
+	}
+
+	base := utils.SlugBase(name, utils.CATEGORY_SLUG_FALLBACK)
+	return resolveUniqueSlug(ctx, base, exists)
 }
 
 // GetAllCategories gets all categories in hierarchical structure
@@ -347,6 +445,41 @@ func (s *CategoryServiceImpl) GetCategoryByID(
 	return categoryResponse, nil
 }
 
+// GetCategoryBySlug gets a category accessible to the seller by slug. Seller
+// redirects take precedence over global ones, like seller categories do.
+func (s *CategoryServiceImpl) GetCategoryBySlug(
+	ctx context.Context,
+	sellerID uint,
+	slug string,
+) (*model.CategoryResponse, string, error) {
+	category, err := s.categoryRepo.FindBySlug(ctx, sellerID, slug)
+	if err != nil {
+		return nil, "", err
+	}
+	if category != nil {
+		return factory.BuildCategoryResponse(category), "", nil
+	}
+
+	for _, scope := range []*uint{&sellerID, nil} {
+		redirect, err := s.redirectRepo.FindBySlug(ctx, utils.SLUG_ENTITY_CATEGORY, scope, slug)
+		if err != nil {
+			return nil, "", err
+		}
+		if redirect == nil {
+			continue
+		}
+		target, err := s.categoryRepo.FindByID(ctx, redirect.EntityID)
+		if err != nil {
+			return nil, "", prodErrors.ErrCategoryNotFound
+		}
+		if target.Slug == "" {
+			return nil, "", prodErrors.ErrCategoryNotFound
+		}
+		return nil, target.Slug, nil
+	}
+	return nil, "", prodErrors.ErrCategoryNotFound
+}
+
 // GetCategoriesByParent gets categories by parent ID
 func (s *CategoryServiceImpl) GetCategoriesByParent(
 	ctx context.Context,
@@ -0,0 +1,307 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"ecommerce-be/common/db"
+	"ecommerce-be/product/entity"
+	prodErrors "ecommerce-be/product/error"
+	"ecommerce-be/product/model"
+	"ecommerce-be/product/repository"
+	"ecommerce-be/product/utils"
+	"ecommerce-be/product/utils/helper"
+)
+
+// DigitalDeliveryService delivers digital products once they are paid for: it issues
+// one entitlement per order item with license keys and signed download links, and
+// serves the downloads within each purchase's download limit
+type DigitalDeliveryService interface {
+	// DeliverOrder issues entitlements and license keys for the digital lines of a
+	// paid order and returns what was delivered. Lines already delivered and lines of
+	// physical products are skipped, so it is safe to call again.
+	// Used by the order service inside the transaction that records the payment.
+	DeliverOrder(
+		ctx context.Context,
+		req model.DigitalDeliveryRequest,
+	) ([]model.DigitalPurchaseResponse, error)
+
+	// GetOrderDownloads returns the digital purchases of an order with fresh links
+	GetOrderDownloads(ctx context.Context, orderID uint) ([]model.DigitalPurchaseResponse, error)
+
+	// ResolveDownload verifies a download link, counts the download and returns the
+	// storage URL of the file
+	ResolveDownload(ctx context.Context, token string) (string, error)
+
+	// GetDigitalVariantIDs returns which of the given variants belong to digital
+	// products. Used by inventory, which holds no stock for them.
+	GetDigitalVariantIDs(ctx context.Context, variantIDs []uint) (map[uint]struct{}, error)
+}
+
+// DigitalDeliveryServiceImpl implements DigitalDeliveryService
+type DigitalDeliveryServiceImpl struct {
+	digitalRepo repository.DigitalProductRepository
+	productRepo repository.ProductRepository
+	fileGateway ProductFileGateway
+	signer      *utils.DownloadLinkSigner
+	baseURL     string
+}
+
+// NewDigitalDeliveryService creates a new DigitalDeliveryService. Download links point
+// at baseURL, the externally reachable API origin.
+func NewDigitalDeliveryService(
+	digitalRepo repository.DigitalProductRepository,
+	productRepo repository.ProductRepository,
+	fileGateway ProductFileGateway,
+	signer *utils.DownloadLinkSigner,
+	baseURL string,
+) DigitalDeliveryService {
+	return &DigitalDeliveryServiceImpl{
+		digitalRepo: digitalRepo,
+		productRepo: productRepo,
+		fileGateway: fileGateway,
+		signer:      signer,
+		baseURL:     baseURL,
+	}
+}
+
+// DeliverOrder issues an entitlement per digital order item, snapshotting the
+// product's download limit, and one license key per unit for products that sell keys.
+// Keys the pool cannot cover stay owed until the seller adds more. A concurrent
+// delivery of the same item fails on the unique order item of its entitlement.
+func (s *DigitalDeliveryServiceImpl) DeliverOrder(
+	ctx context.Context,
+	req model.DigitalDeliveryRequest,
+) ([]model.DigitalPurchaseResponse, error) {
+	productIDs := make([]uint, 0, len(req.Lines))
+	for _, line := range req.Lines {
+		productIDs = append(productIDs, line.ProductID)
+	}
+	products, err := s.digitalRepo.FindDigitalProductsByIDs(ctx, productIDs)
+	if err != nil || len(products) == 0 {
+		return nil, err
+	}
+	digital := make(map[uint]*entity.Product, len(products))
+	for i := range products {
+		digital[products[i].ID] = &products[i]
+	}
+
+	existing, err := s.digitalRepo.FindEntitlementsByOrderID(ctx, req.OrderID)
+	if err != nil {
+		return nil, err
+	}
+	delivered := make(map[uint]struct{}, len(existing))
+	for _, entitlement := range existing {
+		delivered[entitlement.OrderItemID] = struct{}{}
+	}
+
+	entitlements := make([]entity.DigitalEntitlement, 0, len(req.Lines))
+	for _, line := range req.Lines {
+		product, ok := digital[line.ProductID]
+		if !ok || line.Quantity <= 0 {
+			continue
+		}
+		if _, done := delivered[line.OrderItemID]; done {
+			continue
+		}
+		entitlements = append(entitlements, entity.DigitalEntitlement{
+			OrderID:       req.OrderID,
+			OrderItemID:   line.OrderItemID,
+			UserID:        req.UserID,
+			SellerID:      req.SellerID,
+			ProductID:     line.ProductID,
+			VariantID:     line.VariantID,
+			Quantity:      line.Quantity,
+			DownloadLimit: product.DownloadLimit,
+		})
+	}
+	if len(entitlements) == 0 {
+		return nil, nil
+	}
+
+	if err := s.digitalRepo.CreateEntitlements(ctx, entitlements); err != nil {
+		return nil, err
+	}
+	for _, entitlement := range entitlements {
+		hasKeys, err := s.digitalRepo.HasLicenseKeys(ctx, entitlement.ProductID)
+		if err != nil {
+			return nil, err
+		}
+		if hasKeys {
+			if _, err := s.digitalRepo.AssignLicenseKeys(
+				ctx, entitlement.ProductID, entitlement.ID, entitlement.Quantity,
+			); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return s.buildPurchases(ctx, entitlements)
+}
+
+// GetOrderDownloads returns the digital purchases of an order with fresh download links
+func (s *DigitalDeliveryServiceImpl) GetOrderDownloads(
+	ctx context.Context,
+	orderID uint,
+) ([]model.DigitalPurchaseResponse, error) {
+	entitlements, err := s.digitalRepo.FindEntitlementsByOrderID(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+	return s.buildPurchases(ctx, entitlements)
+}
+
+// ResolveDownload verifies the link and counts the download against the purchase's
+// limit under a row lock, so concurrent clicks cannot exceed it
+func (s *DigitalDeliveryServiceImpl) ResolveDownload(
+	ctx context.Context,
+	token string,
+) (string, error) {
+	entitlementID, assetID, expiresAt, ok := s.signer.Verify(token)
+	if !ok {
+		return "", prodErrors.ErrDownloadLinkInvalid
+	}
+	now := time.Now().UTC()
+	if now.After(expiresAt) {
+		return "", prodErrors.ErrDownloadLinkExpired
+	}
+
+	var url string
+	err := db.WithTransaction(ctx, func(txCtx context.Context) error {
+		entitlement, err := s.digitalRepo.FindEntitlementByIDForUpdate(txCtx, entitlementID)
+		if err != nil {
+			return err
+		}
+		if entitlement == nil {
+			return prodErrors.ErrDownloadLinkInvalid
+		}
+		if entitlement.DownloadLimit != nil &&
+			entitlement.DownloadCount >= *entitlement.DownloadLimit {
+			return prodErrors.ErrDownloadLimitReached
+		}
+		asset, err := s.digitalRepo.FindAssetByID(txCtx, entitlement.ProductID, assetID)
+		if err != nil {
+			return err
+		}
+		if asset == nil {
+			return prodErrors.ErrDigitalAssetNotFound
+		}
+
+		// Resolve the file before counting so a storage failure does not use up a download
+		info, err := s.fileGateway.GetFileInfo(txCtx, asset.FileID, &entitlement.SellerID)
+		if err != nil {
+			return err
+		}
+		url = info.URL
+		return s.digitalRepo.RecordDownload(txCtx, entitlement.ID, now)
+	})
+	return url, err
+}
+
+// GetDigitalVariantIDs returns which of the given variants belong to digital products
+func (s *DigitalDeliveryServiceImpl) GetDigitalVariantIDs(
+	ctx context.Context,
+	variantIDs []uint,
+) (map[uint]struct{}, error) {
+	ids, err := s.digitalRepo.FindDigitalVariantIDs(ctx, variantIDs)
+	if err != nil {
+		return nil, err
+	}
+	digital := make(map[uint]struct{}, len(ids))
+	for _, id := range ids {
+		digital[id] = struct{}{}
+	}
+	return digital, nil
+}
+
+// buildPurchases loads the files, keys and product names of the given purchases and
+// signs a link for every file
+func (s *DigitalDeliveryServiceImpl) buildPurchases(
+	ctx context.Context,
+	entitlements []entity.DigitalEntitlement,
+) ([]model.DigitalPurchaseResponse, error) {
+	if len(entitlements) == 0 {
+		return []model.DigitalPurchaseResponse{}, nil
+	}
+
+	productIDs := make([]uint, 0, len(entitlements))
+	entitlementIDs := make([]uint, 0, len(entitlements))
+	for _, entitlement := range entitlements {
+		productIDs = append(productIDs, entitlement.ProductID)
+		entitlementIDs = append(entitlementIDs, entitlement.ID)
+	}
+	products, err := s.productRepo.FindByIDs(ctx, productIDs)
+	if err != nil {
+		return nil, err
+	}
+	productNames := make(map[uint]string, len(products))
+	for _, product := range products {
+		productNames[product.ID] = product.Name
+	}
+	assets, err := s.digitalRepo.FindAssetsByProductIDs(ctx, productIDs)
+	if err != nil {
+		return nil, err
+	}
+	assetsByProduct := make(map[uint][]entity.DigitalAsset)
+	for _, asset := range assets {
+		assetsByProduct[asset.ProductID] = append(assetsByProduct[asset.ProductID], asset)
+	}
+	keys, err := s.digitalRepo.FindLicenseKeysByEntitlementIDs(ctx, entitlementIDs)
+	if err != nil {
+		return nil, err
+	}
+	keysByEntitlement := make(map[uint][]string)
+	for _, key := range keys {
+		keysByEntitlement[*key.EntitlementID] = append(
+			keysByEntitlement[*key.EntitlementID], key.KeyCode,
+		)
+	}
+
+	now := time.Now().UTC()
+	hasKeys := make(map[uint]bool)
+	purchases := make([]model.DigitalPurchaseResponse, 0, len(entitlements))
+	for _, entitlement := range entitlements {
+		if _, checked := hasKeys[entitlement.ProductID]; !checked {
+			if hasKeys[entitlement.ProductID], err = s.digitalRepo.HasLicenseKeys(
+				ctx, entitlement.ProductID,
+			); err != nil {
+				return nil, err
+			}
+		}
+
+		purchase := model.DigitalPurchaseResponse{
+			OrderItemID:   entitlement.OrderItemID,
+			ProductID:     entitlement.ProductID,
+			ProductName:   productNames[entitlement.ProductID],
+			VariantID:     entitlement.VariantID,
+			Quantity:      entitlement.Quantity,
+			DownloadLimit: entitlement.DownloadLimit,
+			DownloadCount: entitlement.DownloadCount,
+			LicenseKeys:   keysByEntitlement[entitlement.ID],
+			Downloads:     []model.DigitalDownloadLink{},
+		}
+		if purchase.LicenseKeys == nil {
+			purchase.LicenseKeys = []string{}
+		}
+		if hasKeys[entitlement.ProductID] {
+			purchase.PendingLicenseKeys = max(0, entitlement.Quantity-len(purchase.LicenseKeys))
+		}
+		if entitlement.DownloadLimit != nil {
+			remaining := max(0, *entitlement.DownloadLimit-entitlement.DownloadCount)
+			purchase.DownloadsRemaining = &remaining
+		}
+		for _, asset := range assetsByProduct[entitlement.ProductID] {
+			token, expiresAt, err := s.signer.Sign(entitlement.ID, asset.ID, now)
+			if err != nil {
+				return nil, err
+			}
+			purchase.Downloads = append(purchase.Downloads, model.DigitalDownloadLink{
+				AssetID:   asset.ID,
+				Name:      asset.Name,
+				URL:       s.baseURL + utils.DIGITAL_DOWNLOAD_PATH + token,
+				ExpiresAt: helper.FormatTimestamp(expiresAt),
+			})
+		}
+		purchases = append(purchases, purchase)
+	}
+	return purchases, nil
+}
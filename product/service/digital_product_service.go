@@ -0,0 +1,319 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"ecommerce-be/common/db"
+	"ecommerce-be/product/entity"
+	prodErrors "ecommerce-be/product/error"
+	"ecommerce-be/product/model"
+	"ecommerce-be/product/repository"
+	"ecommerce-be/product/utils"
+	"ecommerce-be/product/utils/helper"
+)
+
+// DigitalProductService manages what a digital product delivers: its download files
+// and its license key pool
+type DigitalProductService interface {
+	// ListAssets returns the download files of a digital product
+	ListAssets(
+		ctx context.Context,
+		productID, sellerID uint,
+	) ([]model.DigitalAssetResponse, error)
+
+	// AddAsset attaches an uploaded file of the seller to a digital product
+	AddAsset(
+		ctx context.Context,
+		productID, sellerID uint,
+		req model.AddDigitalAssetRequest,
+	) (*model.DigitalAssetResponse, error)
+
+	// DeleteAsset detaches a download file; the uploaded file itself is kept
+	DeleteAsset(ctx context.Context, productID, assetID, sellerID uint) error
+
+	// GetLicenseKeys returns a digital product's key pool
+	GetLicenseKeys(
+		ctx context.Context,
+		productID, sellerID uint,
+	) (*model.LicenseKeyPoolResponse, error)
+
+	// AddLicenseKeys adds keys to the pool and issues them to purchases still owed keys
+	AddLicenseKeys(
+		ctx context.Context,
+		productID, sellerID uint,
+		req model.AddLicenseKeysRequest,
+	) (*model.LicenseKeyPoolResponse, error)
+
+	// DeleteLicenseKey removes a key that has not been issued yet
+	DeleteLicenseKey(ctx context.Context, productID, keyID, sellerID uint) error
+}
+
+// DigitalProductServiceImpl implements DigitalProductService
+type DigitalProductServiceImpl struct {
+	digitalRepo      repository.DigitalProductRepository
+	validatorService ProductValidatorService
+	fileGateway      ProductFileGateway
+}
+
+// NewDigitalProductService creates a new DigitalProductService
+func NewDigitalProductService(
+	digitalRepo repository.DigitalProductRepository,
+	validatorService ProductValidatorService,
+	fileGateway ProductFileGateway,
+) DigitalProductService {
+	return &DigitalProductServiceImpl{
+		digitalRepo:      digitalRepo,
+		validatorService: validatorService,
+		fileGateway:      fileGateway,
+	}
+}
+
+// ListAssets returns the download files of a digital product
+func (s *DigitalProductServiceImpl) ListAssets(
+	ctx context.Context,
+	productID, sellerID uint,
+) ([]model.DigitalAssetResponse, error) {
+	if _, err := s.getDigitalProduct(ctx, productID, sellerID); err != nil {
+		return nil, err
+	}
+	assets, err := s.digitalRepo.FindAssetsByProductIDs(ctx, []uint{productID})
+	if err != nil {
+		return nil, err
+	}
+	responses := make([]model.DigitalAssetResponse, 0, len(assets))
+	for i := range assets {
+		responses = append(responses, buildDigitalAssetResponse(&assets[i]))
+	}
+	return responses, nil
+}
+
+// AddAsset attaches an uploaded file to a digital product. The file must belong to
+// the product's seller.
+func (s *DigitalProductServiceImpl) AddAsset(
+	ctx context.Context,
+	productID, sellerID uint,
+	req model.AddDigitalAssetRequest,
+) (*model.DigitalAssetResponse, error) {
+	product, err := s.getDigitalProduct(ctx, productID, sellerID)
+	if err != nil {
+		return nil, err
+	}
+
+	fileID := strings.TrimSpace(req.FileID)
+	if _, err := s.fileGateway.GetFileInfo(ctx, fileID, &product.SellerID); err != nil {
+		return nil, err
+	}
+
+	asset := &entity.DigitalAsset{
+		ProductID: productID,
+		FileID:    fileID,
+		Name:      strings.TrimSpace(req.Name),
+		SortOrder: req.SortOrder,
+	}
+	if err := db.WithTransaction(ctx, func(txCtx context.Context) error {
+		assets, err := s.digitalRepo.FindAssetsByProductIDs(txCtx, []uint{productID})
+		if err != nil {
+			return err
+		}
+		if len(assets) >= utils.DIGITAL_MAX_ASSETS {
+			return prodErrors.ErrDigitalAssetLimit
+		}
+		for _, existing := range assets {
+			if existing.FileID == fileID {
+				return prodErrors.ErrDigitalAssetDuplicate
+			}
+		}
+		return s.digitalRepo.CreateAsset(txCtx, asset)
+	}); err != nil {
+		return nil, err
+	}
+
+	response := buildDigitalAssetResponse(asset)
+	return &response, nil
+}
+
+// DeleteAsset detaches a download file from a digital product. Links already sent
+// for it stop working; the uploaded file is left to the file service.
+func (s *DigitalProductServiceImpl) DeleteAsset(
+	ctx context.Context,
+	productID, assetID, sellerID uint,
+) error {
+	if _, err := s.getDigitalProduct(ctx, productID, sellerID); err != nil {
+		return err
+	}
+	deleted, err := s.digitalRepo.DeleteAsset(ctx, productID, assetID)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return prodErrors.ErrDigitalAssetNotFound
+	}
+	return nil
+}
+
+// GetLicenseKeys returns a digital product's key pool
+func (s *DigitalProductServiceImpl) GetLicenseKeys(
+	ctx context.Context,
+	productID, sellerID uint,
+) (*model.LicenseKeyPoolResponse, error) {
+	if _, err := s.getDigitalProduct(ctx, productID, sellerID); err != nil {
+		return nil, err
+	}
+	return s.buildLicenseKeyPool(ctx, productID)
+}
+
+// AddLicenseKeys adds keys to the product's pool, skipping blanks and keys it already
+// holds. Purchases that were short of keys when the pool ran dry receive them first.
+func (s *DigitalProductServiceImpl) AddLicenseKeys(
+	ctx context.Context,
+	productID, sellerID uint,
+	req model.AddLicenseKeysRequest,
+) (*model.LicenseKeyPoolResponse, error) {
+	if _, err := s.getDigitalProduct(ctx, productID, sellerID); err != nil {
+		return nil, err
+	}
+
+	keys := make([]entity.LicenseKey, 0, len(req.Keys))
+	seen := make(map[string]struct{}, len(req.Keys))
+	for _, code := range req.Keys {
+		code = strings.TrimSpace(code)
+		if code == "" {
+			continue
+		}
+		if _, dup := seen[code]; dup {
+			continue
+		}
+		seen[code] = struct{}{}
+		keys = append(keys, entity.LicenseKey{ProductID: productID, KeyCode: code})
+	}
+
+	var added int64
+	if err := db.WithTransaction(ctx, func(txCtx context.Context) error {
+		var err error
+		if added, err = s.digitalRepo.InsertLicenseKeys(txCtx, keys); err != nil {
+			return err
+		}
+		return assignPendingLicenseKeys(txCtx, s.digitalRepo, productID)
+	}); err != nil {
+		return nil, err
+	}
+
+	pool, err := s.buildLicenseKeyPool(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+	addedCount := int(added)
+	pool.Added = &addedCount
+	return pool, nil
+}
+
+// DeleteLicenseKey removes a key from the pool. Issued keys belong to a customer and
+// cannot be removed.
+func (s *DigitalProductServiceImpl) DeleteLicenseKey(
+	ctx context.Context,
+	productID, keyID, sellerID uint,
+) error {
+	if _, err := s.getDigitalProduct(ctx, productID, sellerID); err != nil {
+		return err
+	}
+	key, err := s.digitalRepo.FindLicenseKeyByID(ctx, productID, keyID)
+	if err != nil {
+		return err
+	}
+	if key == nil {
+		return prodErrors.ErrLicenseKeyNotFound
+	}
+	if key.EntitlementID != nil {
+		return prodErrors.ErrLicenseKeyIssued
+	}
+	return s.digitalRepo.DeleteLicenseKey(ctx, keyID)
+}
+
+// getDigitalProduct validates seller ownership and that the product is digital
+func (s *DigitalProductServiceImpl) getDigitalProduct(
+	ctx context.Context,
+	productID, sellerID uint,
+) (*entity.Product, error) {
+	product, err := s.validatorService.GetAndValidateProductOwnershipNonPtr(
+		ctx, productID, sellerID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if product.ProductType != utils.PRODUCT_TYPE_DIGITAL {
+		return nil, prodErrors.ErrProductNotDigital
+	}
+	return product, nil
+}
+
+// buildLicenseKeyPool lists the product's keys with available, issued and owed counts
+func (s *DigitalProductServiceImpl) buildLicenseKeyPool(
+	ctx context.Context,
+	productID uint,
+) (*model.LicenseKeyPoolResponse, error) {
+	keys, err := s.digitalRepo.FindLicenseKeys(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+	pending, err := s.digitalRepo.FindPendingLicenses(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+
+	pool := &model.LicenseKeyPoolResponse{Keys: make([]model.LicenseKeyResponse, 0, len(keys))}
+	for _, key := range keys {
+		response := model.LicenseKeyResponse{ID: key.ID, KeyCode: key.KeyCode}
+		if key.EntitlementID != nil {
+			response.Issued = true
+			pool.Issued++
+		} else {
+			pool.Available++
+		}
+		if key.AssignedAt != nil {
+			assignedAt := helper.FormatTimestamp(*key.AssignedAt)
+			response.AssignedAt = &assignedAt
+		}
+		pool.Keys = append(pool.Keys, response)
+	}
+	for _, row := range pending {
+		pool.Pending += row.Missing
+	}
+	return pool, nil
+}
+
+// assignPendingLicenseKeys issues available keys to the product's purchases that are
+// still owed keys, oldest purchase first, until the pool runs dry
+func assignPendingLicenseKeys(
+	ctx context.Context,
+	digitalRepo repository.DigitalProductRepository,
+	productID uint,
+) error {
+	pending, err := digitalRepo.FindPendingLicenses(ctx, productID)
+	if err != nil {
+		return err
+	}
+	for _, row := range pending {
+		assigned, err := digitalRepo.AssignLicenseKeys(
+			ctx, productID, row.EntitlementID, row.Missing,
+		)
+		if err != nil {
+			return err
+		}
+		if int(assigned) < row.Missing {
+			return nil
+		}
+	}
+	return nil
+}
+
+// buildDigitalAssetResponse maps a download file to its response
+func buildDigitalAssetResponse(asset *entity.DigitalAsset) model.DigitalAssetResponse {
+	return model.DigitalAssetResponse{
+		ID:        asset.ID,
+		FileID:    asset.FileID,
+		Name:      asset.Name,
+		SortOrder: asset.SortOrder,
+		CreatedAt: helper.FormatTimestamp(asset.CreatedAt),
+	}
+}
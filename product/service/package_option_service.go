@@ -39,6 +39,11 @@ type PackageOptionService interface {
 		productID uint,
 	) (*model.PackageOptionsResponse, error)
 
+	GetProductsPackageOptions(
+		ctx context.Context,
+		productIDs []uint,
+	) (map[uint][]model.PackageOptionResponse, error)
+
 	BulkUpdatePackageOptions(
 		ctx context.Context,
 		productID uint,
@@ -178,6 +183,31 @@ func (s *PackageOptionServiceImpl) GetPackageOptions(
 	return factory.BuildPackageOptionsListResponse(packageOptions), nil
 }
 
+// GetProductsPackageOptions loads the package options of several products in one query,
+// keyed by product ID
+func (s *PackageOptionServiceImpl) GetProductsPackageOptions(
+	ctx context.Context,
+	productIDs []uint,
+) (map[uint][]model.PackageOptionResponse, error) {
+	packageOptions, err := s.packageOptionRepo.FindAllByProductIDs(ctx, productIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	byProduct := make(map[uint][]entity.PackageOption)
+	for _, packageOption := range packageOptions {
+		byProduct[packageOption.ProductID] = append(
+			byProduct[packageOption.ProductID],
+			packageOption,
+		)
+	}
+	result := make(map[uint][]model.PackageOptionResponse, len(byProduct))
+	for productID, options := range byProduct {
+		result[productID] = factory.BuildPackageOptionResponses(options)
+	}
+	return result, nil
+}
+
 // BulkUpdatePackageOptions updates multiple package options for a product
 func (s *PackageOptionServiceImpl) BulkUpdatePackageOptions(
 	ctx context.Context,
@@ -8,6 +8,7 @@ import (
 	"ecommerce-be/product/factory"
 	"ecommerce-be/product/model"
 	"ecommerce-be/product/repository"
+	productUtils "ecommerce-be/product/utils"
 	"ecommerce-be/product/validator"
 )
 
@@ -40,6 +41,11 @@ type ProductAttributeService interface {
 		productID uint,
 	) (*model.ProductAttributesListResponse, error)
 
+	GetProductsAttributes(
+		ctx context.Context,
+		productIDs []uint,
+	) (map[uint][]model.ProductAttributeResponse, error)
+
 	BulkUpdateProductAttributes(
 		ctx context.Context,
 		productID uint,
@@ -58,6 +64,13 @@ type ProductAttributeService interface {
 
 	// DeleteAttributesByProductID deletes all product attributes for a product
 	DeleteAttributesByProductID(ctx context.Context, productID uint) error
+
+	// GetCompareAttributeRows returns the union of the products' attributes as one row
+	// per attribute with a value for each product, in productIDs order
+	GetCompareAttributeRows(
+		ctx context.Context,
+		productIDs []uint,
+	) ([]model.CompareAttributeRow, error)
 }
 
 // ProductAttributeServiceImpl implements the ProductAttributeService interface
@@ -245,6 +258,29 @@ func (s *ProductAttributeServiceImpl) GetProductAttributes(
 	return factory.BuildProductAttributesListResponse(productID, productAttributes), nil
 }
 
+// GetProductsAttributes loads the attributes of several products in one query, keyed
+// by product ID
+func (s *ProductAttributeServiceImpl) GetProductsAttributes(
+	ctx context.Context,
+	productIDs []uint,
+) (map[uint][]model.ProductAttributeResponse, error) {
+	productAttributes, err := s.productAttrRepo.FindAllByProductIDs(ctx, productIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[uint][]model.ProductAttributeResponse)
+	for i := range productAttributes {
+		detail := factory.BuildProductAttributeDetailResponse(&productAttributes[i])
+		productID := productAttributes[i].ProductID
+		result[productID] = append(
+			result[productID],
+			factory.ConvertDetailToSimpleAttributeResponse(detail),
+		)
+	}
+	return result, nil
+}
+
 // BulkUpdateProductAttributes updates multiple attributes for a product
 func (s *ProductAttributeServiceImpl) BulkUpdateProductAttributes(
 	ctx context.Context,
@@ -474,3 +510,16 @@ func (s *ProductAttributeServiceImpl) DeleteAttributesByProductID(
 ) error {
 	return s.attributeRepo.DeleteProductAttributesByProductID(ctx, productID)
 }
+
+// GetCompareAttributeRows loads the attributes of all compared products in one query
+// and lays them out as a comparison matrix
+func (s *ProductAttributeServiceImpl) GetCompareAttributeRows(
+	ctx context.Context,
+	productIDs []uint,
+) ([]model.CompareAttributeRow, error) {
+	productAttributes, err := s.productAttrRepo.FindAllByProductIDs(ctx, productIDs)
+	if err != nil {
+		return nil, err
+	}
+	return productUtils.BuildCompareAttributeRows(productIDs, productAttributes), nil
+}
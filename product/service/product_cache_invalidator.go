@@ -0,0 +1,49 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"ecommerce-be/common/cache"
+	"ecommerce-be/common/log"
+	"ecommerce-be/common/messaging"
+	productMessaging "ecommerce-be/product/messaging"
+	"ecommerce-be/product/repository"
+	"ecommerce-be/product/utils"
+)
+
+// HandleProductChanged evicts the cached detail, variant preview, related products,
+// search suggestions and seller sitemap of the product named by a
+// product.product.changed event. Malformed
+// messages are rejected without retry; they would fail the same way again.
+func HandleProductChanged(ctx context.Context, msg messaging.Message) error {
+	var env messaging.Envelope
+	if err := json.Unmarshal(msg.Body, &env); err != nil {
+		return fmt.Errorf("product cache invalidation: decode envelope: %w", err)
+	}
+	var event productMessaging.ProductChanged
+	if err := env.DecodePayload(&event); err != nil {
+		return fmt.Errorf("product cache invalidation: decode payload: %w", err)
+	}
+	if event.ProductID == 0 {
+		return fmt.Errorf("product cache invalidation: event %s has no product ID", env.MessageID)
+	}
+
+	repository.InvalidateProductCache(ctx, event.ProductID)
+	if event.SellerID != 0 {
+		InvalidateSitemapCache(ctx, event.SellerID)
+		repository.InvalidateRelatedProductsCache(ctx, event.SellerID)
+		repository.InvalidateSearchSuggestCache(ctx, event.SellerID)
+	} else {
+		repository.InvalidateCacheNamespace(ctx, utils.RELATED_PRODUCTS_CACHE_NAMESPACE)
+		repository.InvalidateCacheNamespace(ctx, utils.SEARCH_SUGGEST_CACHE_NAMESPACE)
+	}
+	previewKey := cache.VersionedKey(utils.VARIANT_PREVIEW_CACHE_NAMESPACE, event.ProductID)
+	if err := cache.Del(previewKey); err != nil {
+		// Redis outages are transient; let the broker redeliver
+		log.WarnWithContext(ctx, "product cache invalidation: "+err.Error())
+		return messaging.RetryableError{Err: err}
+	}
+	return nil
+}
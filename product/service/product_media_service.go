@@ -8,6 +8,9 @@ import (
 	productError "ecommerce-be/product/error"
 	"ecommerce-be/product/model"
 	"ecommerce-be/product/repository"
+	"ecommerce-be/product/utils"
+
+	"github.com/google/uuid"
 )
 
 // ProductMediaService defines the business operations for managing product-media
@@ -23,9 +26,10 @@ type ProductMediaService interface {
 		sellerID *uint,
 	) (map[uint][]model.ProductMediaResponse, error)
 
-	// AttachMedia links an already-uploaded file to a product with optional
-	// primary and display-order attributes. Validates file existence through
-	// the File gateway; rejects duplicate links.
+	// AttachMedia links an already-uploaded file (image, video or 3D model), or an
+	// external video URL, to a product with optional primary and display-order
+	// attributes. Validates file existence and type through the File gateway;
+	// rejects duplicate links.
 	// (Implemented in Phase 4 / User Story 2)
 	AttachMedia(
 		ctx context.Context,
@@ -84,18 +88,31 @@ func NewProductMediaService(
 // MapToProductMediaResponse converts a ProductMedia entity and optional resolved
 // file info into a ProductMediaResponse DTO. When fileInfo is nil the media row
 // is still mapped using an empty URL so callers can decide how to handle it.
+// External videos take their URL from the row. A resolved thumbnail (poster) file
+// overrides the thumbnail generated for the asset itself.
 func MapToProductMediaResponse(
 	media entity.ProductMedia,
 	fileInfo *ProductFileInfo,
+	thumbnailInfo *ProductFileInfo,
 ) model.ProductMediaResponse {
 	resp := model.ProductMediaResponse{
-		FileID:       media.FileID,
-		IsPrimary:    media.IsPrimary,
-		DisplayOrder: media.DisplayOrder,
+		FileID:          media.FileID,
+		IsPrimary:       media.IsPrimary,
+		DisplayOrder:    media.DisplayOrder,
+		MediaType:       utils.NormalizeProductMediaType(media.MediaType),
+		DurationSeconds: media.DurationSeconds,
+	}
+	if media.ExternalURL != nil {
+		resp.URL = *media.ExternalURL
+		resp.External = true
 	}
 	if fileInfo != nil {
 		resp.URL = fileInfo.URL
 		resp.ThumbnailURL = fileInfo.ThumbnailURL
+		resp.MimeType = fileInfo.MimeType
+	}
+	if thumbnailInfo != nil {
+		resp.ThumbnailURL = &thumbnailInfo.URL
 	}
 	return resp
 }
@@ -135,10 +152,15 @@ func (s *productMediaService) GetMediaForProducts(
 		return map[uint][]model.ProductMediaResponse{}, nil
 	}
 
-	// Collect unique file IDs for a single batch gateway call.
+	// Collect unique file IDs (assets and posters) for a single batch gateway call.
 	fileIDSet := make(map[string]struct{}, len(rows))
 	for i := range rows {
-		fileIDSet[rows[i].FileID] = struct{}{}
+		if rows[i].ExternalURL == nil {
+			fileIDSet[rows[i].FileID] = struct{}{}
+		}
+		if rows[i].ThumbnailFileID != nil {
+			fileIDSet[*rows[i].ThumbnailFileID] = struct{}{}
+		}
 	}
 	fileIDs := make([]string, 0, len(fileIDSet))
 	for id := range fileIDSet {
@@ -153,11 +175,22 @@ func (s *productMediaService) GetMediaForProducts(
 	for productID, mediaRows := range grouped {
 		responses := make([]model.ProductMediaResponse, 0, len(mediaRows))
 		for _, row := range mediaRows {
+			var thumbnailInfo *ProductFileInfo
+			if row.ThumbnailFileID != nil {
+				thumbnailInfo = fileInfoMap[*row.ThumbnailFileID]
+			}
+			if row.ExternalURL != nil {
+				responses = append(responses, MapToProductMediaResponse(row, nil, thumbnailInfo))
+				continue
+			}
 			fileInfo, ok := fileInfoMap[row.FileID]
 			if !ok || fileInfo == nil {
 				continue
 			}
-			responses = append(responses, MapToProductMediaResponse(row, fileInfo))
+			responses = append(
+				responses,
+				MapToProductMediaResponse(row, fileInfo, thumbnailInfo),
+			)
 		}
 		result[productID] = responses
 	}
@@ -167,10 +200,12 @@ func (s *productMediaService) GetMediaForProducts(
 
 // ─── US2: Manage Product Media Links ─────────────────────────────────────────
 
-// AttachMedia links an already-uploaded file to a product. It enforces:
+// AttachMedia links an already-uploaded file, or an external video URL, to a
+// product. It enforces:
 //   - Product existence and seller ownership (product.SellerID == sellerID)
 //   - Duplicate prevention (same file attached to the same product)
 //   - File accessibility via the ProductFileGateway
+//   - Media type rules (see validateMediaType); the file MIME type must match
 //   - Primary-flag uniqueness: if isPrimary=true, existing primary is unset first
 func (s *productMediaService) AttachMedia(
 	ctx context.Context,
@@ -188,20 +223,48 @@ func (s *productMediaService) AttachMedia(
 		return nil, productError.ErrProductNotFound
 	}
 
-	// Check for duplicate before the expensive file gateway call.
-	_, err = s.mediaRepo.FindByProductAndFile(ctx, productID, req.FileID)
-	if err == nil {
-		return nil, productError.ErrProductMediaDuplicate
-	}
-	// Any error other than "not found" is unexpected.
-	if err != productError.ErrProductMediaNotFound {
+	mediaType := utils.NormalizeProductMediaType(req.MediaType)
+	if err := validateMediaType(mediaType, req); err != nil {
 		return nil, err
 	}
 
-	// Validate the file exists and is accessible via the file gateway.
-	fileInfo, err := s.fileGateway.GetFileInfo(ctx, req.FileID, &sellerID)
-	if err != nil {
-		return nil, err
+	// External videos have no file asset; give them a unique link ID instead.
+	fileID := req.FileID
+	var fileInfo *ProductFileInfo
+	if req.ExternalURL != nil {
+		fileID = utils.PRODUCT_MEDIA_EXTERNAL_ID_PREFIX + uuid.NewString()
+	} else {
+		// Check for duplicate before the expensive file gateway call.
+		_, err = s.mediaRepo.FindByProductAndFile(ctx, productID, fileID)
+		if err == nil {
+			return nil, productError.ErrProductMediaDuplicate
+		}
+		// Any error other than "not found" is unexpected.
+		if err != productError.ErrProductMediaNotFound {
+			return nil, err
+		}
+
+		// Validate the file exists and is accessible via the file gateway.
+		fileInfo, err = s.fileGateway.GetFileInfo(ctx, fileID, &sellerID)
+		if err != nil {
+			return nil, err
+		}
+		if !utils.ProductMediaMimeAllowed(mediaType, fileInfo.MimeType) {
+			return nil, productError.ErrProductMediaTypeMismatch
+		}
+	}
+
+	var thumbnailInfo *ProductFileInfo
+	if req.ThumbnailFileID != nil {
+		thumbnailInfo, err = s.fileGateway.GetFileInfo(ctx, *req.ThumbnailFileID, &sellerID)
+		if err != nil {
+			return nil, err
+		}
+		if !utils.ProductMediaMimeAllowed(utils.PRODUCT_MEDIA_TYPE_IMAGE, thumbnailInfo.MimeType) {
+			return nil, productError.ErrProductMediaTypeMismatch.WithMessage(
+				utils.PRODUCT_MEDIA_THUMBNAIL_IMAGE_MSG,
+			)
+		}
 	}
 
 	// Unset any existing primary before promoting this item.
@@ -212,16 +275,20 @@ func (s *productMediaService) AttachMedia(
 	}
 
 	media := &entity.ProductMedia{
-		ProductID:    productID,
-		FileID:       req.FileID,
-		IsPrimary:    req.IsPrimary,
-		DisplayOrder: req.DisplayOrder,
+		ProductID:       productID,
+		FileID:          fileID,
+		IsPrimary:       req.IsPrimary,
+		DisplayOrder:    req.DisplayOrder,
+		MediaType:       mediaType,
+		ExternalURL:     req.ExternalURL,
+		ThumbnailFileID: req.ThumbnailFileID,
+		DurationSeconds: req.DurationSeconds,
 	}
 	if err := s.mediaRepo.Create(ctx, media); err != nil {
 		return nil, err
 	}
 
-	resp := MapToProductMediaResponse(*media, fileInfo)
+	resp := MapToProductMediaResponse(*media, fileInfo, thumbnailInfo)
 	return &resp, nil
 }
 
@@ -251,6 +318,11 @@ func (s *productMediaService) UpdateMediaMetadata(
 
 	// Unset any existing primary before promoting this item.
 	if req.IsPrimary != nil && *req.IsPrimary {
+		if utils.NormalizeProductMediaType(existing.MediaType) != utils.PRODUCT_MEDIA_TYPE_IMAGE {
+			return nil, productError.ErrProductMediaTypeMismatch.WithMessage(
+				utils.PRODUCT_MEDIA_PRIMARY_NOT_IMAGE_MSG,
+			)
+		}
 		if err := s.mediaRepo.UnsetPrimary(ctx, productID); err != nil {
 			return nil, err
 		}
@@ -267,9 +339,15 @@ func (s *productMediaService) UpdateMediaMetadata(
 	}
 
 	// Best-effort file URL enrichment — degrade gracefully if unavailable.
-	fileInfo, _ := s.fileGateway.GetFileInfo(ctx, fileID, &sellerID)
+	var fileInfo, thumbnailInfo *ProductFileInfo
+	if updated.ExternalURL == nil {
+		fileInfo, _ = s.fileGateway.GetFileInfo(ctx, fileID, &sellerID)
+	}
+	if updated.ThumbnailFileID != nil {
+		thumbnailInfo, _ = s.fileGateway.GetFileInfo(ctx, *updated.ThumbnailFileID, &sellerID)
+	}
 
-	resp := MapToProductMediaResponse(*updated, fileInfo)
+	resp := MapToProductMediaResponse(*updated, fileInfo, thumbnailInfo)
 	return &resp, nil
 }
 
@@ -324,20 +402,57 @@ func (s *productMediaService) RemoveMedia(
 		}
 	}
 
-	// Attempt best-effort file asset cleanup. Any error is intentionally
-	// swallowed at this layer so product-media removal always returns success.
-	if cleanupErr := s.fileGateway.DeleteFile(ctx, fileID, &sellerID); cleanupErr != nil {
-		log.WarnWithContext(ctx,
-			"product media removed but file cleanup failed"+
-				" productId="+formatUint(productID)+
-				" fileId="+fileID+
-				" reason="+cleanupErr.Error(),
-		)
+	// Attempt best-effort cleanup of the file asset and its poster. Any error is
+	// intentionally swallowed at this layer so product-media removal always
+	// returns success. External videos have no asset to delete.
+	cleanupIDs := make([]string, 0, 2)
+	if existing.ExternalURL == nil {
+		cleanupIDs = append(cleanupIDs, fileID)
+	}
+	if existing.ThumbnailFileID != nil {
+		cleanupIDs = append(cleanupIDs, *existing.ThumbnailFileID)
+	}
+	for _, cleanupID := range cleanupIDs {
+		if cleanupErr := s.fileGateway.DeleteFile(ctx, cleanupID, &sellerID); cleanupErr != nil {
+			log.WarnWithContext(ctx,
+				"product media removed but file cleanup failed"+
+					" productId="+formatUint(productID)+
+					" fileId="+cleanupID+
+					" reason="+cleanupErr.Error(),
+			)
+		}
 	}
 
 	return nil
 }
 
+// validateMediaType checks the request options allowed for a media type:
+//   - externalUrl replaces fileId and is only supported for VIDEO
+//   - durationSeconds is only supported for VIDEO
+//   - thumbnailFileId (poster) is only supported for VIDEO and MODEL_3D
+//   - only IMAGE media can be primary, as listings use it as the product image
+func validateMediaType(mediaType string, req model.AttachMediaRequest) error {
+	switch {
+	case req.ExternalURL != nil && (req.FileID != "" || mediaType != utils.PRODUCT_MEDIA_TYPE_VIDEO):
+		return productError.ErrProductMediaTypeMismatch.WithMessage(
+			utils.PRODUCT_MEDIA_EXTERNAL_NOT_VIDEO_MSG,
+		)
+	case req.DurationSeconds != nil && mediaType != utils.PRODUCT_MEDIA_TYPE_VIDEO:
+		return productError.ErrProductMediaTypeMismatch.WithMessage(
+			utils.PRODUCT_MEDIA_DURATION_NOT_VIDEO_MSG,
+		)
+	case req.ThumbnailFileID != nil && mediaType == utils.PRODUCT_MEDIA_TYPE_IMAGE:
+		return productError.ErrProductMediaTypeMismatch.WithMessage(
+			utils.PRODUCT_MEDIA_THUMBNAIL_ON_IMAGE_MSG,
+		)
+	case req.IsPrimary && mediaType != utils.PRODUCT_MEDIA_TYPE_IMAGE:
+		return productError.ErrProductMediaTypeMismatch.WithMessage(
+			utils.PRODUCT_MEDIA_PRIMARY_NOT_IMAGE_MSG,
+		)
+	}
+	return nil
+}
+
 // formatUint converts uint to decimal string without importing strconv at call
 // sites within this file.
 func formatUint(n uint) string {
@@ -51,6 +51,13 @@ type ProductOptionService interface {
 		sellerID *uint,
 	) ([]model.ProductOptionDetailResponse, error)
 
+	// GetProductsOptionsWithVariantCounts is the batch form of
+	// GetProductOptionsWithVariantCounts for products already known to be visible
+	GetProductsOptionsWithVariantCounts(
+		ctx context.Context,
+		productIDs []uint,
+	) (map[uint][]model.ProductOptionDetailResponse, error)
+
 	// GetProductsOptionsWithValues retrieves all options with their values for multiple products
 	// Batch operation to prevent N+1 queries
 	GetProductsOptionsWithValues(
@@ -70,21 +77,45 @@ type ProductOptionService interface {
 		sellerID uint,
 		requests []model.ProductOptionCreateRequest,
 	) ([]model.ProductOptionDetailResponse, error)
+
+	// ListOptionRules returns the option dependency rules of a product
+	ListOptionRules(
+		ctx context.Context,
+		productID uint,
+		sellerID *uint,
+	) ([]model.ProductOptionRuleResponse, error)
+
+	// CreateOptionRule adds a dependency rule after checking it against existing variants
+	CreateOptionRule(
+		ctx context.Context,
+		productID uint,
+		sellerID uint,
+		req model.ProductOptionRuleCreateRequest,
+	) (*model.ProductOptionRuleResponse, error)
+
+	// DeleteOptionRule removes a dependency rule
+	DeleteOptionRule(ctx context.Context, productID, sellerID, ruleID uint) error
+
+	// GetOptionRules returns the raw rules used to validate variant option combinations
+	GetOptionRules(ctx context.Context, productID uint) ([]entity.ProductOptionRule, error)
 }
 
 // ProductOptionServiceImpl implements the ProductOptionService interface
 type ProductOptionServiceImpl struct {
 	optionRepo       repository.ProductOptionRepository
+	ruleRepo         repository.ProductOptionRuleRepository
 	validatorService ProductValidatorService
 }
 
 // NewProductOptionService creates a new instance of ProductOptionService
 func NewProductOptionService(
 	optionRepo repository.ProductOptionRepository,
+	ruleRepo repository.ProductOptionRuleRepository,
 	validatorService ProductValidatorService,
 ) ProductOptionService {
 	return &ProductOptionServiceImpl{
 		optionRepo:       optionRepo,
+		ruleRepo:         ruleRepo,
 		validatorService: validatorService,
 	}
 }
@@ -302,6 +333,11 @@ func (s *ProductOptionServiceImpl) GetAvailableOptions(
 		return nil, err
 	}
 
+	rules, err := s.ruleRepo.FindByProductID(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+
 	// Convert to response model
 	optionResponses := make([]model.ProductOptionDetailResponse, 0, len(options))
 
@@ -339,6 +375,7 @@ func (s *ProductOptionServiceImpl) GetAvailableOptions(
 	return &model.GetAvailableOptionsResponse{
 		ProductID: productID,
 		Options:   optionResponses,
+		Rules:     factory.BuildProductOptionRuleResponses(rules, optionNamesByID(options)),
 	}, nil
 }
 
@@ -372,6 +409,31 @@ func (s *ProductOptionServiceImpl) GetProductOptionsWithVariantCounts(
 	return options, nil
 }
 
+// GetProductsOptionsWithVariantCounts retrieves the options of several products with
+// their values and variant counts, keyed by product ID. Callers have already checked
+// that the products are visible.
+func (s *ProductOptionServiceImpl) GetProductsOptionsWithVariantCounts(
+	ctx context.Context,
+	productIDs []uint,
+) (map[uint][]model.ProductOptionDetailResponse, error) {
+	optionsByProduct, variantCounts, err := s.optionRepo.GetProductsOptionsWithVariantCounts(
+		ctx,
+		productIDs,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[uint][]model.ProductOptionDetailResponse, len(optionsByProduct))
+	for productID, productOptions := range optionsByProduct {
+		result[productID] = factory.BuildProductOptionsDetailResponse(
+			productOptions,
+			variantCounts,
+		)
+	}
+	return result, nil
+}
+
 /***********************************************
  *         BulkUpdateOptions                   *
  ***********************************************/
@@ -554,3 +616,122 @@ func (s *ProductOptionServiceImpl) CreateOptionsBulk(
 	emptyVariantCounts := make(map[uint]int)
 	return factory.BuildProductOptionsDetailResponse(createdOptions, emptyVariantCounts), nil
 }
+
+/***********************************************
+ *              Option Rules                    *
+ ***********************************************/
+
+// ListOptionRules returns the option dependency rules of a product
+func (s *ProductOptionServiceImpl) ListOptionRules(
+	ctx context.Context,
+	productID uint,
+	sellerID *uint,
+) ([]model.ProductOptionRuleResponse, error) {
+	_, err := s.validatorService.GetAndValidateProductOwnership(ctx, productID, sellerID)
+	if err != nil {
+		return nil, err
+	}
+
+	options, err := s.optionRepo.FindOptionsByProductID(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+	rules, err := s.ruleRepo.FindByProductID(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+	return factory.BuildProductOptionRuleResponses(rules, optionNamesByID(options)), nil
+}
+
+// CreateOptionRule adds a dependency rule. The rule must reference options and
+// values of the product, must not close a dependency loop and must not be broken
+// by any existing variant.
+func (s *ProductOptionServiceImpl) CreateOptionRule(
+	ctx context.Context,
+	productID uint,
+	sellerID uint,
+	req model.ProductOptionRuleCreateRequest,
+) (*model.ProductOptionRuleResponse, error) {
+	_, err := s.validatorService.GetAndValidateProductOwnership(ctx, productID, &sellerID)
+	if err != nil {
+		return nil, err
+	}
+
+	options, err := s.optionRepo.FindOptionsByProductID(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+	if err := validator.ValidateProductOptionRule(req, options); err != nil {
+		return nil, err
+	}
+
+	rule := factory.CreateOptionRuleFromRequest(productID, req)
+
+	existingRules, err := s.ruleRepo.FindByProductID(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+	if utils.OptionRulesHaveCycle(append(existingRules, *rule)) {
+		return nil, prodErrors.ErrOptionRuleCycle
+	}
+
+	selections, err := s.ruleRepo.FindVariantSelections(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+	conflicting := 0
+	for _, selection := range selections {
+		if utils.FindOptionRuleViolation([]entity.ProductOptionRule{*rule}, selection) != nil {
+			conflicting++
+		}
+	}
+	if conflicting > 0 {
+		return nil, prodErrors.ErrOptionRuleVariantConflict.WithMessagef(
+			"%d existing variant(s) use option combinations this rule forbids",
+			conflicting,
+		)
+	}
+
+	if err := s.ruleRepo.Create(ctx, rule); err != nil {
+		return nil, err
+	}
+
+	responses := factory.BuildProductOptionRuleResponses(
+		[]entity.ProductOptionRule{*rule},
+		optionNamesByID(options),
+	)
+	return &responses[0], nil
+}
+
+// DeleteOptionRule removes a dependency rule of the product
+func (s *ProductOptionServiceImpl) DeleteOptionRule(
+	ctx context.Context,
+	productID, sellerID, ruleID uint,
+) error {
+	_, err := s.validatorService.GetAndValidateProductOwnership(ctx, productID, &sellerID)
+	if err != nil {
+		return err
+	}
+
+	if _, err := s.ruleRepo.FindByID(ctx, productID, ruleID); err != nil {
+		return err
+	}
+	return s.ruleRepo.Delete(ctx, ruleID)
+}
+
+// GetOptionRules returns the raw rules used to validate variant option combinations
+func (s *ProductOptionServiceImpl) GetOptionRules(
+	ctx context.Context,
+	productID uint,
+) ([]entity.ProductOptionRule, error) {
+	return s.ruleRepo.FindByProductID(ctx, productID)
+}
+
+// optionNamesByID maps option ID to option name for rule responses
+func optionNamesByID(options []entity.ProductOption) map[uint]string {
+	names := make(map[uint]string, len(options))
+	for _, option := range options {
+		names[option.ID] = option.Name
+	}
+	return names
+}
@@ -2,8 +2,12 @@ package service
 
 import (
 	"context"
+	"maps"
 	"math"
+	"time"
 
+	"ecommerce-be/common/cache"
+	"ecommerce-be/common/log"
 	"ecommerce-be/product/entity"
 	prodErrors "ecommerce-be/product/error"
 	"ecommerce-be/product/factory"
@@ -28,13 +32,31 @@ type ProductQueryService interface {
 		sellerID *uint,
 		userID *uint, // Optional: if provided, checks if product is wishlisted by this user
 	) (*model.ProductResponse, error)
+	// GetProductBySlug returns the seller's product by slug. For a retired slug it
+	// returns no product but the product's current slug to redirect to.
+	GetProductBySlug(
+		ctx context.Context,
+		sellerID uint,
+		slug string,
+		userID *uint,
+	) (*model.ProductResponse, string, error)
 	SearchProducts(
 		ctx context.Context,
 		query string,
 		filters map[string]any,
 		page, limit int,
+		sortBy, sortOrder string, // Optional: empty sortBy applies the seller's default sort
 		userID *uint, // Optional: if provided, checks if products are wishlisted by this user
+		viewer string, // Optional: shopper key for sponsored frequency caps; empty skips sponsored slots
 	) (*model.SearchResponse, error)
+	// SuggestProducts returns product name, category and brand suggestions for a
+	// search-as-you-type prefix, served from Redis when cached
+	SuggestProducts(
+		ctx context.Context,
+		query string,
+		sellerID *uint,
+		limit int,
+	) (*model.SuggestResponse, error)
 	GetProductFilters(
 		ctx context.Context,
 		sellerID *uint,
@@ -48,6 +70,30 @@ type ProductQueryService interface {
 		sellerID *uint,
 		userID *uint, // Optional: if provided, checks if products are wishlisted by this user
 	) (*model.RelatedProductsScoredResponse, error)
+	// GetProductsInOrder returns the list view of the products in the order of productIDs,
+	// skipping products that no longer exist or belong to another seller
+	GetProductsInOrder(
+		ctx context.Context,
+		productIDs []uint,
+		sellerID *uint,
+		userID *uint,
+	) ([]model.ProductResponse, error)
+	// GetProductsBatch returns the full detail of each requested product in request
+	// order, marking IDs that do not exist or belong to another seller as not found
+	GetProductsBatch(
+		ctx context.Context,
+		productIDs []uint,
+		sellerID *uint,
+		userID *uint,
+	) (*model.BatchProductsResponse, error)
+	// CompareProducts returns the products side by side with a normalized attribute
+	// matrix, skipping products that do not exist or belong to another seller
+	CompareProducts(
+		ctx context.Context,
+		productIDs []uint,
+		sellerID *uint,
+		userID *uint,
+	) (*model.CompareProductsResponse, error)
 }
 
 // ProductQueryServiceImpl implements the ProductQueryService interface
@@ -59,6 +105,10 @@ type ProductQueryServiceImpl struct {
 	packageOptionService    PackageOptionService
 	productOptionService    ProductOptionService
 	productMediaService     ProductMediaService
+	translationService      ProductTranslationService
+	searchSettingsService   SearchSettingsService
+	sponsoredService        SponsoredPlacementService
+	redirectRepo            repository.SlugRedirectRepository
 }
 
 // NewProductQueryService creates a new instance of ProductQueryService
@@ -70,6 +120,10 @@ func NewProductQueryService(
 	packageOptionService PackageOptionService,
 	productOptionService ProductOptionService,
 	productMediaService ProductMediaService,
+	translationService ProductTranslationService,
+	searchSettingsService SearchSettingsService,
+	sponsoredService SponsoredPlacementService,
+	redirectRepo repository.SlugRedirectRepository,
 ) *ProductQueryServiceImpl {
 	return &ProductQueryServiceImpl{
 		productRepo:             productRepo,
@@ -79,12 +133,17 @@ func NewProductQueryService(
 		packageOptionService:    packageOptionService,
 		productOptionService:    productOptionService,
 		productMediaService:     productMediaService,
+		translationService:      translationService,
+		searchSettingsService:   searchSettingsService,
+		sponsoredService:        sponsoredService,
+		redirectRepo:            redirectRepo,
 	}
 }
 
 /*
  * GetAllProducts - Retrieve all products with pagination
  * Optimized with batch variant aggregation to prevent N+1 queries
+ * Facet counts of all matching products are added when filter.WithFacets is set
  */
 func (s *ProductQueryServiceImpl) GetAllProducts(
 	ctx context.Context,
@@ -96,6 +155,19 @@ func (s *ProductQueryServiceImpl) GetAllProducts(
 	// Validate and set default pagination values
 	page, limit = s.validatePaginationParams(page, limit)
 
+	// Without an explicit sortBy the seller's storefront default sort applies
+	settings := s.searchSettingsService.ResolveSettings(ctx, filter.SellerID)
+	filter.SortBy, filter.SortOrder = productUtils.ResolveListingSort(
+		filter.SortBy,
+		filter.SortOrder,
+		settings,
+	)
+	// Without an explicit inStock the seller's out-of-stock display applies
+	filter.InStock, filter.DemoteOutOfStock = productUtils.ResolveStockFilter(
+		filter.InStock,
+		settings,
+	)
+
 	// Fetch products from repository with filters
 	products, total, err := s.productRepo.FindAll(ctx, filter, page, limit)
 	if err != nil {
@@ -114,10 +186,18 @@ func (s *ProductQueryServiceImpl) GetAllProducts(
 		return nil, err
 	}
 
-	return &model.ProductsResponse{
+	response := &model.ProductsResponse{
 		Products:   productsResponse,
 		Pagination: s.buildPaginationResponse(page, limit, total),
-	}, nil
+	}
+	if filter.WithFacets {
+		facets, err := s.productRepo.FindListingFacets(ctx, filter)
+		if err != nil {
+			return nil, err
+		}
+		response.Facets = factory.BuildProductFacets(facets)
+	}
+	return response, nil
 }
 
 /*
@@ -144,25 +224,36 @@ func (s *ProductQueryServiceImpl) buildProductResponsesWithVariants(
 		productIDs[i] = product.ID
 	}
 
+	// A sparse fieldset skips the loads behind fields it leaves out
+	fields := productUtils.RequestedProductFields(ctx)
+	withCommerce := fields.Includes(productUtils.ProductCommerceFields...)
+
 	// Fetch variant aggregations for all products in ONE query via VariantService
 	// This is the key optimization to prevent N+1 queries
-	variantAggs, err := s.variantQueryService.GetProductsVariantAggregations(
-		ctx,
-		productIDs,
-		userID,
-	)
-	if err != nil {
-		return nil, err
+	var variantAggs map[uint]*mapper.VariantAggregation
+	if withCommerce {
+		var err error
+		variantAggs, err = s.variantQueryService.GetProductsVariantAggregations(
+			ctx,
+			productIDs,
+			userID,
+		)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	// Batch-load media for all products in a single call (no N+1 on file lookups).
-	mediaByProductID, _ := s.productMediaService.GetMediaForProducts(ctx, productIDs, sellerID)
+	var mediaByProductID map[uint][]model.ProductMediaResponse
+	if fields.Includes(productUtils.PRODUCT_MEDIA_FIELD) {
+		mediaByProductID, _ = s.productMediaService.GetMediaForProducts(ctx, productIDs, sellerID)
+	}
 
 	// Build response models with variant and media data using factory
 	productsResponse := make([]model.ProductResponse, 0, len(products))
 	for _, product := range products {
 		variantAgg := variantAggs[product.ID]
-		if variantAgg == nil {
+		if withCommerce && variantAgg == nil {
 			// Skip products without variants (shouldn't happen per business rules)
 			continue
 		}
@@ -179,6 +270,15 @@ func (s *ProductQueryServiceImpl) buildProductResponsesWithVariants(
 		productsResponse = append(productsResponse, productResp)
 	}
 
+	// Resolve the requested content locale for the whole page in one pass
+	localized := make([]*model.ProductResponse, len(productsResponse))
+	for i := range productsResponse {
+		localized[i] = &productsResponse[i]
+	}
+	if err := s.translationService.LocalizeProducts(ctx, localized); err != nil {
+		return nil, err
+	}
+
 	return productsResponse, nil
 }
 
@@ -207,8 +307,44 @@ func (s *ProductQueryServiceImpl) GetProductByID(
 	return s.buildDetailedProductResponse(ctx, product, sellerID, userID)
 }
 
+// GetProductBySlug returns the seller's product with the slug. A retired slug that
+// redirects yields a nil product and the product's current slug instead.
+func (s *ProductQueryServiceImpl) GetProductBySlug(
+	ctx context.Context,
+	sellerID uint,
+	slug string,
+	userID *uint,
+) (*model.ProductResponse, string, error) {
+	product, err := s.productRepo.FindBySlug(ctx, sellerID, slug)
+	if err != nil {
+		return nil, "", err
+	}
+	if product != nil {
+		response, err := s.buildDetailedProductResponse(ctx, product, &sellerID, userID)
+		return response, "", err
+	}
+
+	redirect, err := s.redirectRepo.FindBySlug(
+		ctx, productUtils.SLUG_ENTITY_PRODUCT, &sellerID, slug,
+	)
+	if err != nil {
+		return nil, "", err
+	}
+	if redirect == nil {
+		return nil, "", prodErrors.ErrProductNotFound
+	}
+
+	target, err := s.productRepo.FindByID(ctx, redirect.EntityID)
+	if err != nil {
+		return nil, "", err
+	}
+	if target.SellerID != sellerID || target.Slug == "" {
+		return nil, "", prodErrors.ErrProductNotFound
+	}
+	return nil, target.Slug, nil
+}
+
 // buildDetailedProductResponse builds a complete ProductResponse with all details
-// Uses service layer dependencies to fetch related data efficiently
 // If userID is provided, also checks if product is wishlisted by that user.
 // sellerID is used for scoped media file access.
 func (s *ProductQueryServiceImpl) buildDetailedProductResponse(
@@ -217,76 +353,182 @@ func (s *ProductQueryServiceImpl) buildDetailedProductResponse(
 	sellerID *uint,
 	userID *uint,
 ) (*model.ProductResponse, error) {
-	// Get variant aggregation for summary info using VariantService
-	variantAgg, err := s.variantQueryService.GetProductVariantAggregation(ctx, product.ID, userID)
+	responses, err := s.buildDetailedProductResponses(
+		ctx,
+		[]entity.Product{*product},
+		sellerID,
+		userID,
+	)
 	if err != nil {
 		return nil, err
 	}
+	return responses[0], nil
+}
 
-	// Use factory to build base product response with variant aggregation
-	response := factory.BuildProductResponse(product, variantAgg)
+// buildDetailedProductResponses builds the detailed view of several products, in the
+// order given. Each related load (variants, options, attributes, package options, media)
+// covers the whole set at once, so the query count does not grow with the products.
+func (s *ProductQueryServiceImpl) buildDetailedProductResponses(
+	ctx context.Context,
+	products []entity.Product,
+	sellerID *uint,
+	userID *uint,
+) ([]*model.ProductResponse, error) {
+	if len(products) == 0 {
+		return []*model.ProductResponse{}, nil
+	}
+
+	// A sparse fieldset skips the loads behind fields it leaves out
+	fields := productUtils.RequestedProductFields(ctx)
 
-	// Enhance with additional details for the detailed view
-	attrResponse, err := s.productAttributeService.GetProductAttributes(ctx, product.ID)
-	if err == nil && attrResponse != nil {
-		response.Attributes = factory.ConvertDetailListToSimpleAttributeResponses(
-			attrResponse.Attributes,
+	productIDs := make([]uint, len(products))
+	for i := range products {
+		productIDs[i] = products[i].ID
+	}
+
+	// Get variant aggregations for summary info using VariantService
+	var variantAggs map[uint]*mapper.VariantAggregation
+	if fields.Includes(productUtils.ProductCommerceFields...) {
+		var err error
+		variantAggs, err = s.variantQueryService.GetProductsVariantAggregations(
+			ctx,
+			productIDs,
+			userID,
 		)
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	// Get package options via PackageOptionService
-	if pkgResp, err := s.packageOptionService.GetPackageOptions(ctx, product.ID); err == nil && pkgResp != nil {
-		response.PackageOptions = pkgResp.PackageOptions
+	// Related data is optional: a failed load leaves its field empty
+	var attributes map[uint][]model.ProductAttributeResponse
+	if fields.Includes(productUtils.PRODUCT_ATTRIBUTES_FIELD) {
+		attributes, _ = s.productAttributeService.GetProductsAttributes(ctx, productIDs)
 	}
 
-	// Get all product options with their values using ProductOptionService
-	productOptions, err := s.productOptionService.GetProductOptionsWithVariantCounts(
-		ctx,
-		product.ID,
-		nil,
-	)
-	if err == nil && len(productOptions) > 0 {
-		response.Options = productOptions
+	var packageOptions map[uint][]model.PackageOptionResponse
+	if fields.Includes(productUtils.PRODUCT_PACKAGE_OPTIONS_FIELD) {
+		packageOptions, _ = s.packageOptionService.GetProductsPackageOptions(ctx, productIDs)
+	}
+
+	var productOptions map[uint][]model.ProductOptionDetailResponse
+	if fields.Includes(productUtils.PRODUCT_OPTIONS_FIELD) {
+		productOptions, _ = s.productOptionService.GetProductsOptionsWithVariantCounts(
+			ctx,
+			productIDs,
+		)
+	}
+
+	// Variant media resolves in the caller's seller scope, else the product's own
+	var variants map[uint][]model.VariantDetailResponse
+	withVariants := fields.Includes(productUtils.PRODUCT_VARIANTS_FIELD)
+	if withVariants {
+		mediaSellerIDs := make(map[uint]uint, len(products))
+		for i := range products {
+			mediaSellerIDs[products[i].ID] = products[i].SellerID
+			if sellerID != nil {
+				mediaSellerIDs[products[i].ID] = *sellerID
+			}
+		}
+		variants, _ = s.variantQueryService.GetProductsVariantsWithOptions(
+			ctx,
+			productIDs,
+			mediaSellerIDs,
+		)
 	}
 
-	// Get all variants with their selected option values; expose public variants only.
-	mediaSellerID := sellerID
-	if mediaSellerID == nil {
-		sid := product.SellerID
-		mediaSellerID = &sid
+	var media map[uint][]model.ProductMediaResponse
+	withMedia := fields.Includes(productUtils.PRODUCT_MEDIA_FIELD)
+	if withMedia {
+		media, _ = s.productMediaService.GetMediaForProducts(ctx, productIDs, sellerID)
 	}
-	allVariants, err := s.variantQueryService.GetProductVariantsWithOptions(ctx, product.ID, mediaSellerID)
-	if err == nil {
-		response.Variants = productUtils.FilterPublicVariants(allVariants)
+
+	responses := make([]*model.ProductResponse, len(products))
+	for i := range products {
+		productID := products[i].ID
+		response := factory.BuildProductResponse(&products[i], variantAggs[productID])
+
+		if attributes != nil {
+			response.Attributes = attributes[productID]
+		}
+		if packageOptions != nil {
+			response.PackageOptions = packageOptions[productID]
+		}
+		if options := productOptions[productID]; len(options) > 0 {
+			response.Options = options
+		}
+		// Expose public variants only
+		if withVariants && variants != nil {
+			response.Variants = productUtils.FilterPublicVariants(variants[productID])
+		}
+		// Always set a non-nil media slice
+		if withMedia {
+			response.Media = media[productID]
+			if response.Media == nil {
+				response.Media = []model.ProductMediaResponse{}
+			}
+		}
+
+		responses[i] = &response
 	}
 
-	// Batch-load media for this product; always set a non-nil slice.
-	mediaMap, _ := s.productMediaService.GetMediaForProducts(ctx, []uint{product.ID}, sellerID)
-	media := mediaMap[product.ID]
-	if media == nil {
-		media = []model.ProductMediaResponse{}
+	// Resolve the requested content locale (name, descriptions, option display names)
+	if err := s.translationService.LocalizeProducts(ctx, responses); err != nil {
+		return nil, err
 	}
-	response.Media = media
 
-	return &response, nil
+	return responses, nil
 }
 
 /*
  * SearchProducts - Search products with query and filters
- * Optimized with batch variant aggregation like GetAllProducts
+ * Optimized with batch variant aggregation like GetAllProducts; facet counts cover all matches
  */
 func (s *ProductQueryServiceImpl) SearchProducts(
 	ctx context.Context,
 	query string,
 	filters map[string]any,
 	page, limit int,
+	sortBy, sortOrder string,
 	userID *uint,
+	viewer string,
 ) (*model.SearchResponse, error) {
 	// Validate and set default pagination values
 	page, limit = s.validatePaginationParams(page, limit)
 
+	// Resolve ordering and relevance weights from the seller's search settings
+	var sellerID *uint
+	if id, ok := filters["sellerId"].(uint); ok {
+		sellerID = &id
+	}
+	settings := s.searchSettingsService.ResolveSettings(ctx, sellerID)
+	ranking := productUtils.ResolveSearchRanking(sortBy, sortOrder, settings)
+
+	// Without an explicit inStock the seller's out-of-stock display applies
+	var inStock *bool
+	if value, ok := filters["inStock"].(bool); ok {
+		inStock = &value
+	}
+	inStock, ranking.DemoteOutOfStock = productUtils.ResolveStockFilter(inStock, settings)
+	if inStock != nil {
+		filters = maps.Clone(filters)
+		filters["inStock"] = *inStock
+	}
+
+	// Expand the query with the seller's stop words and synonyms. A blank query
+	// matches nothing.
+	terms := s.searchSettingsService.ExpandSearchQuery(ctx, sellerID, query)
+	if len(terms) == 0 {
+		return &model.SearchResponse{
+			Query:      query,
+			Results:    []model.SearchResult{},
+			Pagination: s.buildPaginationResponse(page, limit, 0),
+			SearchTime: "0.05s", // Placeholder
+		}, nil
+	}
+
 	// Fetch products from repository with search query and filters
-	products, total, err := s.productRepo.Search(ctx, query, filters, page, limit)
+	products, total, err := s.productRepo.Search(ctx, terms, filters, page, limit, ranking)
 	if err != nil {
 		return nil, err
 	}
@@ -298,25 +540,148 @@ func (s *ProductQueryServiceImpl) SearchProducts(
 		return nil, err
 	}
 
+	// Report which fields matched and the weighted relevance score per product
+	productIDs := make([]uint, len(productsResponse))
+	for i, productResp := range productsResponse {
+		productIDs[i] = productResp.ID
+	}
+	matches, err := s.productRepo.FindSearchMatches(ctx, productIDs, terms)
+	if err != nil {
+		return nil, err
+	}
+	matchByProduct := make(map[uint]mapper.SearchMatchRow, len(matches))
+	for _, match := range matches {
+		matchByProduct[match.ProductID] = match
+	}
+
 	// Convert to search results with additional search metadata
 	searchResults := make([]model.SearchResult, 0, len(productsResponse))
 	for _, productResp := range productsResponse {
+		score, matchedFields := productUtils.ScoreSearchMatch(
+			matchByProduct[productResp.ID],
+			ranking.Weights,
+		)
 		searchResult := model.SearchResult{
 			ProductResponse: productResp,
-			RelevanceScore:  0.8,              // TODO: Implement actual relevance scoring
-			MatchedFields:   []string{"name"}, // TODO: Track which fields matched
+			RelevanceScore:  score,
+			MatchedFields:   matchedFields,
 		}
 		searchResults = append(searchResults, searchResult)
 	}
 
+	// Sponsored placements take their positions on top of the organic page
+	if viewer != "" {
+		searchResults = s.injectSponsoredResults(
+			ctx, searchResults, query, filters, sellerID, viewer, page, limit, userID,
+		)
+	}
+
+	// Facet counts cover every organic match, not just this page
+	facets, err := s.productRepo.FindSearchFacets(ctx, terms, filters)
+	if err != nil {
+		return nil, err
+	}
+
 	return &model.SearchResponse{
 		Query:      query,
 		Results:    searchResults,
 		Pagination: s.buildPaginationResponse(page, limit, total),
 		SearchTime: "0.05s", // Placeholder
+		Facets:     factory.BuildProductFacets(facets),
 	}, nil
 }
 
+/*
+ * SuggestProducts - Search-as-you-type suggestions for a prefix
+ * Prefix and typo matches come from the trigram indexes; responses are cached per
+ * seller scope and dropped on the seller's product writes
+ */
+func (s *ProductQueryServiceImpl) SuggestProducts(
+	ctx context.Context,
+	query string,
+	sellerID *uint,
+	limit int,
+) (*model.SuggestResponse, error) {
+	prefix, ok := productUtils.NormalizeSuggestPrefix(query)
+	if !ok {
+		return nil, prodErrors.ErrInvalidSuggestQuery
+	}
+	limit = productUtils.ClampSuggestLimit(limit)
+
+	return cache.GetOrLoad(
+		ctx,
+		productUtils.SEARCH_SUGGEST_CACHE_NAMESPACE,
+		repository.SearchSuggestCacheKey(sellerID, prefix, limit),
+		productUtils.SEARCH_SUGGEST_CACHE_TTL*time.Second,
+		func(ctx context.Context) (*model.SuggestResponse, error) {
+			rows, err := s.productRepo.FindSuggestions(ctx, prefix, sellerID, limit)
+			if err != nil {
+				return nil, err
+			}
+			return factory.BuildSuggestResponse(prefix, rows), nil
+		},
+	)
+}
+
+// injectSponsoredResults adds the sponsored placements matching the search to the page.
+// Failures are logged and leave the organic results unchanged.
+func (s *ProductQueryServiceImpl) injectSponsoredResults(
+	ctx context.Context,
+	organic []model.SearchResult,
+	query string,
+	filters map[string]any,
+	sellerID *uint,
+	viewer string,
+	page, limit int,
+	userID *uint,
+) []model.SearchResult {
+	var categoryID *uint
+	if id, ok := filters["categoryId"].(uint); ok {
+		categoryID = &id
+	}
+	placements := s.sponsoredService.SelectForSearch(
+		ctx, query, categoryID, sellerID, viewer, page, limit,
+	)
+	if len(placements) == 0 {
+		return organic
+	}
+
+	productIDs := make([]uint, 0, len(placements))
+	for _, placement := range placements {
+		productIDs = append(productIDs, placement.ProductID)
+	}
+	products, err := s.productRepo.FindByIDs(ctx, productIDs)
+	if err != nil {
+		log.ErrorWithContext(ctx, "searchProducts: skipping sponsored results", err)
+		return organic
+	}
+	productsResponse, err := s.buildProductResponsesWithVariants(ctx, products, userID, nil)
+	if err != nil {
+		log.ErrorWithContext(ctx, "searchProducts: skipping sponsored results", err)
+		return organic
+	}
+	responseByProduct := make(map[uint]model.ProductResponse, len(productsResponse))
+	for _, productResp := range productsResponse {
+		responseByProduct[productResp.ID] = productResp
+	}
+
+	sponsored := make(map[int]model.SearchResult, len(placements))
+	for index, placement := range placements {
+		productResp, ok := responseByProduct[placement.ProductID]
+		if !ok {
+			continue
+		}
+		placementID := placement.ID
+		sponsored[index] = model.SearchResult{
+			ProductResponse: productResp,
+			MatchedFields:   []string{},
+			Sponsored:       true,
+			PlacementID:     &placementID,
+		}
+	}
+	return productUtils.InjectSponsoredResults(organic, sponsored)
+}
+
 /*
  * GetProductFilters - Get available filters for product search
  * Multi-tenant: filters based on sellerID if provided
@@ -396,7 +761,8 @@ func (s *ProductQueryServiceImpl) buildCategoryFiltersHierarchy(
 
 /*
  * GetRelatedProductsScored - Get related products with scoring
- * Uses stored procedure for multi-strategy matching
+ * Uses stored procedure for multi-strategy matching; seller pins lead the
+ * results with strategy "manual" and seller exclusions are left out
  * Optimized to avoid N+1 queries
  */
 func (s *ProductQueryServiceImpl) GetRelatedProductsScored(
@@ -410,39 +776,58 @@ func (s *ProductQueryServiceImpl) GetRelatedProductsScored(
 ) (*model.RelatedProductsScoredResponse, error) {
 	// Validate and set defaults
 	page, limit = s.validatePaginationParams(page, limit)
-	if strategies == "" {
-		strategies = "all"
-	}
+	strategies = productUtils.NormalizeRelatedStrategies(strategies)
 
 	// Calculate offset for pagination
 	offset := (page - 1) * limit
 
-	// Call repository method that uses stored procedure for scoring
-	scoredResults, totalCount, err := s.productRepo.FindRelatedScored(
+	// The stored procedure's page is cached; prices and translations are applied fresh
+	var hit bool
+	cached, err := cache.GetOrLoad(
 		ctx,
-		productID,
-		sellerID,
-		limit,
-		offset,
-		strategies,
+		productUtils.RELATED_PRODUCTS_CACHE_NAMESPACE,
+		repository.RelatedProductsCacheKey(sellerID, productID, strategies, limit, offset),
+		productUtils.RELATED_PRODUCTS_CACHE_TTL*time.Second,
+		func(ctx context.Context) (*relatedProductsPage, error) {
+			results, total, err := s.productRepo.FindRelatedScored(
+				ctx,
+				productID,
+				sellerID,
+				limit,
+				offset,
+				strategies,
+			)
+			if err != nil {
+				return nil, err
+			}
+			return &relatedProductsPage{Results: results, Total: total}, nil
+		},
+		cache.ReportHit(&hit),
 	)
 	if err != nil {
 		return nil, err
 	}
+	cacheStatus := productUtils.RELATED_CACHE_STATUS_MISS
+	if hit {
+		cacheStatus = productUtils.RELATED_CACHE_STATUS_HIT
+	}
+	scoredResults, totalCount := cached.Results, cached.Total
 
 	// If no results, return empty response with metadata
 	if len(scoredResults) == 0 {
-		return factory.BuildRelatedProductsScoredResponse(
+		resp := factory.BuildRelatedProductsScoredResponse(
 			[]model.RelatedProductItemScored{},
 			[]string{},
 			0,
 			s.buildPaginationResponse(page, limit, 0),
 			8,
-		), nil
+		)
+		resp.Meta.CacheStatus = cacheStatus
+		return resp, nil
 	}
 
 	// Build related items using batch operation and factory methods
-	relatedItems, strategiesUsedMap, totalScore, err := s.buildRelatedProductItems(
+	relatedItems, strategiesUsedMap, avgScore, err := s.buildRelatedProductItems(
 		ctx,
 		scoredResults,
 	)
@@ -456,24 +841,30 @@ func (s *ProductQueryServiceImpl) GetRelatedProductsScored(
 		strategiesUsed = append(strategiesUsed, strategy)
 	}
 
-	// Calculate average score
-	avgScore := float64(totalScore) / float64(len(relatedItems))
-
-	return factory.BuildRelatedProductsScoredResponse(
+	resp := factory.BuildRelatedProductsScoredResponse(
 		relatedItems,
 		strategiesUsed,
 		avgScore,
 		s.buildPaginationResponse(page, limit, totalCount),
 		8,
-	), nil
+	)
+	resp.Meta.CacheStatus = cacheStatus
+	return resp, nil
+}
+
+// relatedProductsPage is the cached result of one related products procedure call
+type relatedProductsPage struct {
+	Results []mapper.RelatedProductScored
+	Total   int64
 }
 
 // buildRelatedProductItems builds related product items with batch optimization
-// Returns the items, strategies map, and total score for metadata calculation
+// Returns the items, strategies map, and average score for metadata calculation.
+// Seller pins carry no score and are left out of the average.
 func (s *ProductQueryServiceImpl) buildRelatedProductItems(
 	ctx context.Context,
 	scoredResults []mapper.RelatedProductScored,
-) ([]model.RelatedProductItemScored, map[string]bool, int, error) {
+) ([]model.RelatedProductItemScored, map[string]bool, float64, error) {
 	productIDs := make([]uint, len(scoredResults))
 	for i, result := range scoredResults {
 		productIDs[i] = result.ProductID
@@ -487,6 +878,7 @@ func (s *ProductQueryServiceImpl) buildRelatedProductItems(
 	relatedItems := make([]model.RelatedProductItemScored, 0, len(scoredResults))
 	strategiesUsedMap := make(map[string]bool)
 	totalScore := 0
+	scoredCount := 0
 
 	for _, result := range scoredResults {
 		scoredItem := factory.BuildRelatedProductItemScored(&result)
@@ -495,10 +887,115 @@ func (s *ProductQueryServiceImpl) buildRelatedProductItems(
 		}
 		relatedItems = append(relatedItems, scoredItem)
 		strategiesUsedMap[result.StrategyUsed] = true
-		totalScore += result.FinalScore
+		if result.StrategyUsed != productUtils.RELATED_STRATEGY_MANUAL {
+			totalScore += result.FinalScore
+			scoredCount++
+		}
+	}
+
+	localized := make([]*model.ProductResponse, len(relatedItems))
+	for i := range relatedItems {
+		localized[i] = &relatedItems[i].ProductResponse
+	}
+	if err := s.translationService.LocalizeProducts(ctx, localized); err != nil {
+		return nil, nil, 0, err
+	}
+
+	avgScore := 0.0
+	if scoredCount > 0 {
+		avgScore = float64(totalScore) / float64(scoredCount)
+	}
+	return relatedItems, strategiesUsedMap, avgScore, nil
+}
+
+// GetProductsInOrder returns the list view of the products in the order of productIDs
+func (s *ProductQueryServiceImpl) GetProductsInOrder(
+	ctx context.Context,
+	productIDs []uint,
+	sellerID *uint,
+	userID *uint,
+) ([]model.ProductResponse, error) {
+	if len(productIDs) == 0 {
+		return []model.ProductResponse{}, nil
+	}
+
+	filter := model.GetProductsFilter{IDs: productIDs}
+	filter.SellerID = sellerID
+	products, _, err := s.productRepo.FindAll(ctx, filter, 1, len(productIDs))
+	if err != nil {
+		return nil, err
+	}
+	productsResponse, err := s.buildProductResponsesWithVariants(ctx, products, userID, sellerID)
+	if err != nil {
+		return nil, err
+	}
+
+	responseByProduct := make(map[uint]model.ProductResponse, len(productsResponse))
+	for _, productResp := range productsResponse {
+		responseByProduct[productResp.ID] = productResp
+	}
+	ordered := make([]model.ProductResponse, 0, len(productsResponse))
+	for _, productID := range productIDs {
+		if productResp, ok := responseByProduct[productID]; ok {
+			ordered = append(ordered, productResp)
+		}
+	}
+	return ordered, nil
+}
+
+// GetProductsBatch returns the detailed view of each requested product in request order.
+// Products and their related data are loaded once for the whole set, so repeated IDs
+// share a response and the query count does not grow with the batch.
+func (s *ProductQueryServiceImpl) GetProductsBatch(
+	ctx context.Context,
+	productIDs []uint,
+	sellerID *uint,
+	userID *uint,
+) (*model.BatchProductsResponse, error) {
+	if len(productIDs) == 0 {
+		return &model.BatchProductsResponse{Products: []model.BatchProductResult{}}, nil
+	}
+
+	filter := model.GetProductsFilter{IDs: productIDs}
+	filter.SellerID = sellerID
+	products, _, err := s.productRepo.FindAll(ctx, filter, 1, len(productIDs))
+	if err != nil {
+		return nil, err
+	}
+
+	responses, err := s.buildDetailedProductResponses(ctx, products, sellerID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.BatchProductsResponse{
+		Products: productUtils.BuildBatchProductResults(productIDs, responses, sellerID),
+	}, nil
+}
+
+// CompareProducts returns the list view of the compared products in request order and
+// their attributes as one row per attribute across all of them
+func (s *ProductQueryServiceImpl) CompareProducts(
+	ctx context.Context,
+	productIDs []uint,
+	sellerID *uint,
+	userID *uint,
+) (*model.CompareProductsResponse, error) {
+	products, err := s.GetProductsInOrder(ctx, productIDs, sellerID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	foundIDs := make([]uint, len(products))
+	for i, product := range products {
+		foundIDs[i] = product.ID
+	}
+	rows, err := s.productAttributeService.GetCompareAttributeRows(ctx, foundIDs)
+	if err != nil {
+		return nil, err
 	}
 
-	return relatedItems, strategiesUsedMap, totalScore, nil
+	return &model.CompareProductsResponse{Products: products, Attributes: rows}, nil
 }
 
 // validatePaginationParams validates and normalizes pagination parameters
@@ -8,6 +8,7 @@ import (
 	commonError "ecommerce-be/common/error"
 	"ecommerce-be/common/db"
 	"ecommerce-be/product/entity"
+	prodErrors "ecommerce-be/product/error"
 	"ecommerce-be/product/factory"
 	"ecommerce-be/product/mapper"
 	"ecommerce-be/product/model"
@@ -41,6 +42,7 @@ type ProductServiceImpl struct {
 	productRepo             repository.ProductRepository
 	categoryRepo            repository.CategoryRepository
 	variantRepo             repository.VariantRepository
+	redirectRepo            repository.SlugRedirectRepository
 	productQueryService     ProductQueryService
 	validatorService        ProductValidatorService
 	variantService          VariantService
@@ -48,6 +50,7 @@ type ProductServiceImpl struct {
 	productOptionService    ProductOptionService
 	productAttributeService ProductAttributeService
 	packageOptionService    PackageOptionService
+	skuGenerator            SKUGeneratorService
 }
 
 // NewProductService creates a new instance of ProductService
@@ -55,6 +58,7 @@ func NewProductService(
 	productRepo repository.ProductRepository,
 	categoryRepo repository.CategoryRepository,
 	variantRepo repository.VariantRepository,
+	redirectRepo repository.SlugRedirectRepository,
 	productQueryService ProductQueryService,
 	validatorService ProductValidatorService,
 	variantService VariantService,
@@ -62,11 +66,13 @@ func NewProductService(
 	productOptionService ProductOptionService,
 	productAttributeService ProductAttributeService,
 	packageOptionService PackageOptionService,
+	skuGenerator SKUGeneratorService,
 ) ProductService {
 	return &ProductServiceImpl{
 		productRepo:             productRepo,
 		categoryRepo:            categoryRepo,
 		variantRepo:             variantRepo,
+		redirectRepo:            redirectRepo,
 		productQueryService:     productQueryService,
 		validatorService:        validatorService,
 		variantService:          variantService,
@@ -74,6 +80,7 @@ func NewProductService(
 		productOptionService:    productOptionService,
 		productAttributeService: productAttributeService,
 		packageOptionService:    packageOptionService,
+		skuGenerator:            skuGenerator,
 	}
 }
 
@@ -147,9 +154,26 @@ func (s *ProductServiceImpl) validateAndCreateProduct(
 	}
 
 	product := factory.CreateProductFromRequest(req, sellerID)
+	if product.BaseSKU == "" {
+		product.BaseSKU, err = s.skuGenerator.GenerateProductSKU(ctx, product)
+		if err != nil {
+			return err
+		}
+	}
+	product.Slug, err = s.resolveProductSlug(ctx, sellerID, req.Slug, req.Name, 0)
+	if err != nil {
+		return err
+	}
 	if err := s.productRepo.Create(ctx, product); err != nil {
 		return err
 	}
+	// A new product claiming a retired slug takes it over from the redirect
+	if err := s.redirectRepo.DeleteBySlug(
+		ctx, productUtils.SLUG_ENTITY_PRODUCT, &sellerID, product.Slug,
+	); err != nil {
+		return err
+	}
+	InvalidateSitemapCache(ctx, sellerID)
 
 	result.product = product
 	result.category = category
@@ -180,7 +204,7 @@ func (s *ProductServiceImpl) createProductAssociations(
 	}
 
 	// Create variants (explicit or synthesized placeholder for simple products)
-	variantRequests := resolveVariantCreateRequests(req)
+	variantRequests := resolveVariantCreateRequests(req, result.product.BaseSKU)
 	variants, err := s.variantBulkService.CreateVariantsBulk(ctx, productID, sellerID, variantRequests)
 	if err != nil {
 		return err
@@ -219,7 +243,11 @@ func (s *ProductServiceImpl) createProductAssociations(
 }
 
 // resolveVariantCreateRequests returns explicit variants or synthesizes a placeholder for simple products.
-func resolveVariantCreateRequests(req model.ProductCreateRequest) []model.CreateVariantRequest {
+// The placeholder shares the product's (possibly generated) base SKU.
+func resolveVariantCreateRequests(
+	req model.ProductCreateRequest,
+	baseSKU string,
+) []model.CreateVariantRequest {
 	if len(req.Variants) > 0 {
 		return req.Variants
 	}
@@ -236,7 +264,8 @@ func resolveVariantCreateRequests(req model.ProductCreateRequest) []model.Create
 
 	return []model.CreateVariantRequest{
 		{
-			SKU:           req.BaseSKU,
+			SKU:           baseSKU,
+			Barcode:       req.Barcode,
 			Price:         req.Price,
 			AllowPurchase: commonHelper.BoolPtr(allowPurchase),
 			IsPopular:     commonHelper.BoolPtr(isPopular),
@@ -355,24 +384,46 @@ func (s *ProductServiceImpl) UpdateProduct(
 	}
 
 	// Update product entity using factory
+	oldSlug := product.Slug
 	product = factory.CreateProductEntityFromUpdateRequest(product, req)
 
+	// Products created before slugs existed get one on their first update
+	if (req.Slug != nil && *req.Slug != oldSlug) || oldSlug == "" {
+		product.Slug, err = s.resolveProductSlug(ctx, product.SellerID, req.Slug, product.Name, id)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// Clear preloaded associations to avoid GORM sync issues
 	// When CategoryID is updated but Category is preloaded, GORM may not update correctly
 	product.Category = nil
 
 	hasCommerceUpdate := req.Price != nil || req.AllowPurchase != nil || req.IsPopular != nil
 
-	if hasCommerceUpdate {
-		err = db.WithTransaction(ctx, func(txCtx context.Context) error {
-			if err := s.productRepo.Update(txCtx, product); err != nil {
+	err = db.WithTransaction(ctx, func(txCtx context.Context) error {
+		if err := s.productRepo.Update(txCtx, product); err != nil {
+			return err
+		}
+		if product.Slug != oldSlug {
+			if err := moveSlug(
+				txCtx,
+				s.redirectRepo,
+				productUtils.SLUG_ENTITY_PRODUCT,
+				&product.SellerID,
+				product.ID,
+				oldSlug,
+				product.Slug,
+			); err != nil {
 				return err
 			}
+		}
+		InvalidateSitemapCache(txCtx, product.SellerID)
+		if hasCommerceUpdate {
 			return s.applyProductCommerceUpdates(txCtx, product.ID, req)
-		})
-	} else if err = s.productRepo.Update(ctx, product); err != nil {
-		return nil, err
-	}
+		}
+		return nil
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -384,6 +435,34 @@ func (s *ProductServiceImpl) UpdateProduct(
 	return s.productQueryService.GetProductByID(ctx, product.ID, sellerId, nil)
 }
 
+// resolveProductSlug returns the requested slug if no other product of the seller
+// uses it, or generates a free slug from the name when none is requested
+func (s *ProductServiceImpl) resolveProductSlug(
+	ctx context.Context,
+	sellerID uint,
+	requested *string,
+	name string,
+	excludeID uint,
+) (string, error) {
+	exists := func(ctx context.Context, slug string) (bool, error) {
+		return s.productRepo.SlugExists(ctx, sellerID, slug, excludeID)
+	}
+
+	if requested != nil {
+		taken, err := exists(ctx, *requested)
+		if err != nil {
+			return "", err
+		}
+		if taken {
+			return "", prodErrors.ErrProductSlugTaken
+		}
+		return *requested, nil
+	}
+
+	base := productUtils.SlugBase(name, productUtils.PRODUCT_SLUG_FALLBACK)
+	return resolveUniqueSlug(ctx, base, exists)
+}
+
 func (s *ProductServiceImpl) applyProductCommerceUpdates(
 	ctx context.Context,
 	productID uint,
@@ -450,7 +529,7 @@ func (s *ProductServiceImpl) DeleteProduct(
 	sellerId *uint,
 ) error {
 	// Verify product exists and validate ownership
-	_, err := s.validatorService.GetAndValidateProductOwnership(ctx, id, sellerId)
+	product, err := s.validatorService.GetAndValidateProductOwnership(ctx, id, sellerId)
 	if err != nil {
 		return err
 	}
@@ -477,6 +556,15 @@ func (s *ProductServiceImpl) DeleteProduct(
 			return err
 		}
 
+		// Retired slugs no longer redirect anywhere
+		if err := s.redirectRepo.DeleteByEntity(
+			txCtx, productUtils.SLUG_ENTITY_PRODUCT, id,
+		); err != nil {
+			return err
+		}
+
+		InvalidateSitemapCache(txCtx, product.SellerID)
+
 		// Finally, delete the product itself
 		return s.productRepo.Delete(txCtx, id)
 	})
@@ -0,0 +1,268 @@
+package service
+
+import (
+	"context"
+
+	"ecommerce-be/common/db"
+	"ecommerce-be/common/i18n"
+	"ecommerce-be/product/entity"
+	prodErrors "ecommerce-be/product/error"
+	"ecommerce-be/product/factory"
+	"ecommerce-be/product/model"
+	"ecommerce-be/product/repository"
+	"ecommerce-be/product/utils"
+	"ecommerce-be/product/validator"
+)
+
+// ProductTranslationService manages per-locale product content and applies it to public reads
+type ProductTranslationService interface {
+	// GetProductTranslations lists every translated locale of a product
+	GetProductTranslations(
+		ctx context.Context,
+		productID, sellerID uint,
+	) (*model.ProductTranslationsResponse, error)
+
+	// UpsertProductTranslation replaces the product's content and option names for one locale
+	UpsertProductTranslation(
+		ctx context.Context,
+		productID, sellerID uint,
+		locale string,
+		req model.UpsertProductTranslationRequest,
+	) (*model.ProductTranslationResponse, error)
+
+	// DeleteProductTranslation removes one locale, reverting it to default-locale content
+	DeleteProductTranslation(ctx context.Context, productID, sellerID uint, locale string) error
+
+	// LocalizeProducts rewrites content of already-built responses into the requested locale,
+	// falling back to the default-locale content per product and per field
+	LocalizeProducts(ctx context.Context, products []*model.ProductResponse) error
+}
+
+// ProductTranslationServiceImpl implements ProductTranslationService
+type ProductTranslationServiceImpl struct {
+	translationRepo  repository.ProductTranslationRepository
+	optionRepo       repository.ProductOptionRepository
+	validatorService ProductValidatorService
+}
+
+// NewProductTranslationService creates a new ProductTranslationService
+func NewProductTranslationService(
+	translationRepo repository.ProductTranslationRepository,
+	optionRepo repository.ProductOptionRepository,
+	validatorService ProductValidatorService,
+) ProductTranslationService {
+	return &ProductTranslationServiceImpl{
+		translationRepo:  translationRepo,
+		optionRepo:       optionRepo,
+		validatorService: validatorService,
+	}
+}
+
+// GetProductTranslations lists every translated locale of a product
+func (s *ProductTranslationServiceImpl) GetProductTranslations(
+	ctx context.Context,
+	productID, sellerID uint,
+) (*model.ProductTranslationsResponse, error) {
+	if _, err := s.validatorService.GetAndValidateProductOwnershipNonPtr(
+		ctx,
+		productID,
+		sellerID,
+	); err != nil {
+		return nil, err
+	}
+
+	translations, err := s.loadTranslations(ctx, productID, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &model.ProductTranslationsResponse{
+		ProductID:     productID,
+		DefaultLocale: i18n.Default().DefaultLanguage(),
+		Translations:  translations,
+	}, nil
+}
+
+// UpsertProductTranslation replaces the product's content and option names for one locale
+func (s *ProductTranslationServiceImpl) UpsertProductTranslation(
+	ctx context.Context,
+	productID, sellerID uint,
+	locale string,
+	req model.UpsertProductTranslationRequest,
+) (*model.ProductTranslationResponse, error) {
+	locale, err := validator.ValidateTranslationLocale(locale, i18n.Default().DefaultLanguage())
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.validatorService.GetAndValidateProductOwnershipNonPtr(
+		ctx,
+		productID,
+		sellerID,
+	); err != nil {
+		return nil, err
+	}
+	if err := s.validateOptionItems(ctx, productID, req.Options); err != nil {
+		return nil, err
+	}
+
+	content := factory.CreateProductTranslationFromRequest(productID, locale, req)
+	options := factory.CreateOptionTranslationsFromRequest(locale, req.Options)
+	if err := db.WithTransaction(ctx, func(txCtx context.Context) error {
+		if err := s.translationRepo.Upsert(txCtx, content); err != nil {
+			return err
+		}
+		return s.translationRepo.ReplaceOptionTranslations(txCtx, productID, locale, options)
+	}); err != nil {
+		return nil, err
+	}
+
+	translations, err := s.loadTranslations(ctx, productID, []string{locale})
+	if err != nil {
+		return nil, err
+	}
+	if len(translations) == 0 {
+		return nil, prodErrors.ErrProductTranslationNotFound
+	}
+	return &translations[0], nil
+}
+
+// DeleteProductTranslation removes one locale, reverting it to default-locale content
+func (s *ProductTranslationServiceImpl) DeleteProductTranslation(
+	ctx context.Context,
+	productID, sellerID uint,
+	locale string,
+) error {
+	locale, err := validator.ValidateTranslationLocale(locale, i18n.Default().DefaultLanguage())
+	if err != nil {
+		return err
+	}
+	if _, err := s.validatorService.GetAndValidateProductOwnershipNonPtr(
+		ctx,
+		productID,
+		sellerID,
+	); err != nil {
+		return err
+	}
+
+	deleted, err := s.translationRepo.DeleteByProductAndLocale(ctx, productID, locale)
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return prodErrors.ErrProductTranslationNotFound
+	}
+	return nil
+}
+
+// LocalizeProducts rewrites content of already-built responses into the requested locale
+func (s *ProductTranslationServiceImpl) LocalizeProducts(
+	ctx context.Context,
+	products []*model.ProductResponse,
+) error {
+	defaultLocale := i18n.Default().DefaultLanguage()
+	for _, p := range products {
+		p.Locale = defaultLocale
+	}
+
+	candidates := utils.LocaleCandidates(utils.RequestedContentLocales(ctx), defaultLocale)
+	if len(candidates) == 0 || len(products) == 0 {
+		return nil
+	}
+
+	productIDs := make([]uint, len(products))
+	for i, p := range products {
+		productIDs[i] = p.ID
+	}
+	contents, err := s.translationRepo.FindByProductIDs(ctx, productIDs, candidates)
+	if err != nil {
+		return err
+	}
+	options, err := s.translationRepo.FindOptionTranslations(ctx, productIDs, candidates)
+	if err != nil {
+		return err
+	}
+	if len(contents) == 0 && len(options) == 0 {
+		return nil
+	}
+
+	byProduct := make(map[uint]*utils.ProductLocalization)
+	get := func(productID uint) *utils.ProductLocalization {
+		l, ok := byProduct[productID]
+		if !ok {
+			l = utils.NewProductLocalization()
+			byProduct[productID] = l
+		}
+		return l
+	}
+	for _, c := range contents {
+		get(c.ProductID).Content[c.Locale] = c
+	}
+	for _, o := range options {
+		get(o.ProductID).AddOptionName(o.Locale, o.OptionName, o.DisplayName)
+	}
+
+	for _, p := range products {
+		localization, ok := byProduct[p.ID]
+		if !ok {
+			continue
+		}
+		locale, ok := localization.PickLocale(candidates)
+		if !ok {
+			continue
+		}
+		var content *entity.ProductTranslation
+		if c, ok := localization.Content[locale]; ok {
+			content = &c
+		}
+		utils.LocalizeProductResponse(p, content, localization.OptionNames[locale])
+		p.Locale = locale
+	}
+	return nil
+}
+
+// loadTranslations builds the per-locale view of a product; nil locales means every locale
+func (s *ProductTranslationServiceImpl) loadTranslations(
+	ctx context.Context,
+	productID uint,
+	locales []string,
+) ([]model.ProductTranslationResponse, error) {
+	contents, err := s.translationRepo.FindByProductIDs(ctx, []uint{productID}, locales)
+	if err != nil {
+		return nil, err
+	}
+	options, err := s.translationRepo.FindOptionTranslations(ctx, []uint{productID}, locales)
+	if err != nil {
+		return nil, err
+	}
+	return factory.BuildProductTranslationResponses(contents, options), nil
+}
+
+// validateOptionItems rejects options of other products and repeated options
+func (s *ProductTranslationServiceImpl) validateOptionItems(
+	ctx context.Context,
+	productID uint,
+	items []model.OptionTranslationItem,
+) error {
+	if len(items) == 0 {
+		return nil
+	}
+	options, err := s.optionRepo.FindOptionsByProductID(ctx, productID)
+	if err != nil {
+		return err
+	}
+	owned := make(map[uint]struct{}, len(options))
+	for _, o := range options {
+		owned[o.ID] = struct{}{}
+	}
+
+	seen := make(map[uint]struct{}, len(items))
+	for _, item := range items {
+		if _, ok := owned[item.OptionID]; !ok {
+			return prodErrors.ErrTranslationOptionInvalid
+		}
+		if _, dup := seen[item.OptionID]; dup {
+			return prodErrors.ErrTranslationOptionDuplicate
+		}
+		seen[item.OptionID] = struct{}{}
+	}
+	return nil
+}
@@ -0,0 +1,132 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"ecommerce-be/common/log"
+	prodErrors "ecommerce-be/product/error"
+	"ecommerce-be/product/model"
+	"ecommerce-be/product/repository"
+	"ecommerce-be/product/utils"
+)
+
+// RecommendationService serves "customers also bought" and "recommended for you"
+// products from co-purchase data precomputed by a nightly job
+type RecommendationService interface {
+	// GetAlsoBought returns the products customers of the product also bought
+	GetAlsoBought(
+		ctx context.Context,
+		productID uint,
+		sellerID *uint,
+		userID *uint,
+		limit int,
+	) ([]model.ProductResponse, error)
+
+	// GetRecommendations returns the products recommended to the customer
+	GetRecommendations(
+		ctx context.Context,
+		userID uint,
+		sellerID *uint,
+		limit int,
+	) ([]model.ProductResponse, error)
+
+	// Rebuild recomputes every co-purchase and recommendation from order history.
+	// Runs as a daily cron job.
+	Rebuild()
+}
+
+// RecommendationServiceImpl implements RecommendationService
+type RecommendationServiceImpl struct {
+	recommendationRepo  repository.RecommendationRepository
+	productRepo         repository.ProductRepository
+	productQueryService ProductQueryService
+}
+
+// NewRecommendationService creates a new RecommendationService
+func NewRecommendationService(
+	recommendationRepo repository.RecommendationRepository,
+	productRepo repository.ProductRepository,
+	productQueryService ProductQueryService,
+) RecommendationService {
+	return &RecommendationServiceImpl{
+		recommendationRepo:  recommendationRepo,
+		productRepo:         productRepo,
+		productQueryService: productQueryService,
+	}
+}
+
+// GetAlsoBought returns the products customers of the product also bought, most
+// customers first. Empty until the nightly rebuild finds enough shared customers.
+func (s *RecommendationServiceImpl) GetAlsoBought(
+	ctx context.Context,
+	productID uint,
+	sellerID *uint,
+	userID *uint,
+	limit int,
+) ([]model.ProductResponse, error) {
+	product, err := s.productRepo.FindByID(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+	if sellerID != nil && product.SellerID != *sellerID {
+		return nil, prodErrors.ErrProductNotFound
+	}
+
+	productIDs, err := s.recommendationRepo.FindAlsoBought(ctx, productID, sellerID, limit)
+	if err != nil {
+		return nil, err
+	}
+	return s.productQueryService.GetProductsInOrder(ctx, productIDs, sellerID, userID)
+}
+
+// GetRecommendations returns the products recommended to the customer, best first
+func (s *RecommendationServiceImpl) GetRecommendations(
+	ctx context.Context,
+	userID uint,
+	sellerID *uint,
+	limit int,
+) ([]model.ProductResponse, error) {
+	productIDs, err := s.recommendationRepo.FindUserRecommendations(ctx, userID, sellerID, limit)
+	if err != nil {
+		return nil, err
+	}
+	return s.productQueryService.GetProductsInOrder(ctx, productIDs, sellerID, &userID)
+}
+
+// Rebuild recomputes co-purchases and then recommendations from orders in the window.
+// Each table is refreshed in its own short transaction: rows are upserted in place and
+// only the ones this rebuild did not write are deleted.
+func (s *RecommendationServiceImpl) Rebuild() {
+	ctx := context.Background()
+	now := time.Now().UTC()
+	since := now.AddDate(0, 0, -utils.RECOMMENDATION_WINDOW_DAYS)
+	// Postgres keeps microseconds, so the stamp must compare equal once stored
+	computedAt := now.Truncate(time.Microsecond)
+
+	coPurchases, err := s.recommendationRepo.RefreshCoPurchases(
+		ctx,
+		since,
+		computedAt,
+		utils.CO_PURCHASE_MIN_CUSTOMERS,
+		utils.CO_PURCHASE_MAX_PER_PRODUCT,
+	)
+	var recommendations int64
+	if err == nil {
+		recommendations, err = s.recommendationRepo.RefreshUserRecommendations(
+			ctx,
+			since,
+			computedAt,
+			utils.USER_RECOMMENDATIONS_MAX_PER_SELLER,
+		)
+	}
+	if err != nil {
+		log.ErrorWithContext(ctx, "Cron: Failed to rebuild product recommendations", err)
+		return
+	}
+	log.InfoWithContext(ctx, fmt.Sprintf(
+		"Cron: Rebuilt product recommendations: coPurchases=%d recommendations=%d",
+		coPurchases, recommendations,
+	))
+}
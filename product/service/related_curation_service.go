@@ -0,0 +1,186 @@
+package service
+
+import (
+	"context"
+
+	"ecommerce-be/common/db"
+	"ecommerce-be/product/entity"
+	prodErrors "ecommerce-be/product/error"
+	"ecommerce-be/product/model"
+	"ecommerce-be/product/repository"
+	"ecommerce-be/product/utils"
+)
+
+// RelatedCurationService manages a seller's manual pins and exclusions for the related
+// products of their products. The related products procedure applies them.
+type RelatedCurationService interface {
+	GetCuration(
+		ctx context.Context,
+		productID uint,
+		sellerID uint,
+	) (*model.RelatedCurationResponse, error)
+
+	// UpdateCuration replaces the product's pins and exclusions
+	UpdateCuration(
+		ctx context.Context,
+		productID uint,
+		sellerID uint,
+		req model.UpdateRelatedCurationRequest,
+	) (*model.RelatedCurationResponse, error)
+
+	// ClearCuration removes every pin and exclusion, leaving the scored results alone
+	ClearCuration(ctx context.Context, productID uint, sellerID uint) error
+}
+
+// RelatedCurationServiceImpl implements RelatedCurationService
+type RelatedCurationServiceImpl struct {
+	overrideRepo     repository.RelatedProductOverrideRepository
+	productRepo      repository.ProductRepository
+	validatorService ProductValidatorService
+}
+
+// NewRelatedCurationService creates a new RelatedCurationService
+func NewRelatedCurationService(
+	overrideRepo repository.RelatedProductOverrideRepository,
+	productRepo repository.ProductRepository,
+	validatorService ProductValidatorService,
+) RelatedCurationService {
+	return &RelatedCurationServiceImpl{
+		overrideRepo:     overrideRepo,
+		productRepo:      productRepo,
+		validatorService: validatorService,
+	}
+}
+
+// GetCuration returns the product's pins in order and its exclusions
+func (s *RelatedCurationServiceImpl) GetCuration(
+	ctx context.Context,
+	productID uint,
+	sellerID uint,
+) (*model.RelatedCurationResponse, error) {
+	if _, err := s.validatorService.GetAndValidateProductOwnershipNonPtr(
+		ctx, productID, sellerID,
+	); err != nil {
+		return nil, err
+	}
+	return s.buildCurationResponse(ctx, productID)
+}
+
+// UpdateCuration replaces the product's pins and exclusions. Every listed product must
+// be another product of the same seller.
+func (s *RelatedCurationServiceImpl) UpdateCuration(
+	ctx context.Context,
+	productID uint,
+	sellerID uint,
+	req model.UpdateRelatedCurationRequest,
+) (*model.RelatedCurationResponse, error) {
+	product, err := s.validatorService.GetAndValidateProductOwnershipNonPtr(
+		ctx, productID, sellerID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	err = utils.ValidateRelatedCuration(productID, req.PinnedProductIDs, req.ExcludedProductIDs)
+	if err != nil {
+		return nil, prodErrors.ErrRelatedCurationInvalid.WithMessage(err.Error())
+	}
+
+	relatedIDs := append(append([]uint{}, req.PinnedProductIDs...), req.ExcludedProductIDs...)
+	if len(relatedIDs) > 0 {
+		related, err := s.productRepo.FindByIDs(ctx, relatedIDs)
+		if err != nil {
+			return nil, err
+		}
+		sellerProducts := make(map[uint]bool, len(related))
+		for _, relatedProduct := range related {
+			if relatedProduct.SellerID == product.SellerID {
+				sellerProducts[relatedProduct.ID] = true
+			}
+		}
+		for _, relatedID := range relatedIDs {
+			if !sellerProducts[relatedID] {
+				return nil, prodErrors.ErrRelatedCurationInvalid.WithMessagef(
+					"Product %d was not found", relatedID)
+			}
+		}
+	}
+
+	overrides := utils.BuildRelatedProductOverrides(
+		productID, req.PinnedProductIDs, req.ExcludedProductIDs,
+	)
+
+	err = db.WithTransaction(ctx, func(txCtx context.Context) error {
+		if err := s.overrideRepo.ReplaceForProduct(txCtx, productID, overrides); err != nil {
+			return err
+		}
+		repository.InvalidateRelatedProductsCache(txCtx, product.SellerID)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.buildCurationResponse(ctx, productID)
+}
+
+// ClearCuration removes every pin and exclusion of the product
+func (s *RelatedCurationServiceImpl) ClearCuration(
+	ctx context.Context,
+	productID uint,
+	sellerID uint,
+) error {
+	product, err := s.validatorService.GetAndValidateProductOwnershipNonPtr(
+		ctx, productID, sellerID,
+	)
+	if err != nil {
+		return err
+	}
+	if err := s.overrideRepo.DeleteByProductID(ctx, productID); err != nil {
+		return err
+	}
+	repository.InvalidateRelatedProductsCache(ctx, product.SellerID)
+	return nil
+}
+
+// buildCurationResponse loads the product's overrides with the related product names
+func (s *RelatedCurationServiceImpl) buildCurationResponse(
+	ctx context.Context,
+	productID uint,
+) (*model.RelatedCurationResponse, error) {
+	overrides, err := s.overrideRepo.FindByProductID(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make(map[uint]string, len(overrides))
+	if len(overrides) > 0 {
+		relatedIDs := make([]uint, len(overrides))
+		for i, override := range overrides {
+			relatedIDs[i] = override.RelatedProductID
+		}
+		related, err := s.productRepo.FindByIDs(ctx, relatedIDs)
+		if err != nil {
+			return nil, err
+		}
+		for _, relatedProduct := range related {
+			names[relatedProduct.ID] = relatedProduct.Name
+		}
+	}
+
+	resp := &model.RelatedCurationResponse{
+		ProductID: productID,
+		Pinned:    []model.RelatedCurationItem{},
+		Excluded:  []model.RelatedCurationItem{},
+	}
+	for _, override := range overrides {
+		item := model.RelatedCurationItem{
+			ProductID: override.RelatedProductID,
+			Name:      names[override.RelatedProductID],
+		}
+		if override.Mode == entity.RELATED_OVERRIDE_MODE_PIN {
+			resp.Pinned = append(resp.Pinned, item)
+		} else {
+			resp.Excluded = append(resp.Excluded, item)
+		}
+	}
+	return resp, nil
+}
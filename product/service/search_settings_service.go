@@ -0,0 +1,427 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"ecommerce-be/common/cache"
+	"ecommerce-be/common/log"
+	"ecommerce-be/product/entity"
+	prodErrors "ecommerce-be/product/error"
+	"ecommerce-be/product/model"
+	"ecommerce-be/product/repository"
+	"ecommerce-be/product/utils"
+	"ecommerce-be/product/utils/helper"
+)
+
+// SearchSettingsService manages seller storefront listing/search settings and serves them,
+// cached, to the listing and search services
+type SearchSettingsService interface {
+	// GetSettings returns the seller's effective settings (platform defaults until saved)
+	GetSettings(ctx context.Context, sellerID uint) (*model.SearchSettingsResponse, error)
+
+	// UpdateSettings saves the given fields on top of the seller's current settings
+	UpdateSettings(
+		ctx context.Context,
+		sellerID uint,
+		req model.UpdateSearchSettingsRequest,
+	) (*model.SearchSettingsResponse, error)
+
+	// ResolveSettings returns the settings listing and search apply for a seller.
+	// A nil seller (platform-wide reads) or a lookup failure yields the defaults.
+	ResolveSettings(ctx context.Context, sellerID *uint) entity.SearchSettings
+
+	ListSynonymGroups(
+		ctx context.Context,
+		sellerID uint,
+	) ([]model.SearchSynonymGroupResponse, error)
+	CreateSynonymGroup(
+		ctx context.Context,
+		sellerID uint,
+		req model.SearchSynonymGroupRequest,
+	) (*model.SearchSynonymGroupResponse, error)
+	UpdateSynonymGroup(
+		ctx context.Context,
+		sellerID, groupID uint,
+		req model.SearchSynonymGroupRequest,
+	) (*model.SearchSynonymGroupResponse, error)
+	DeleteSynonymGroup(ctx context.Context, sellerID, groupID uint) error
+
+	GetStopWords(ctx context.Context, sellerID uint) ([]string, error)
+	UpdateStopWords(
+		ctx context.Context,
+		sellerID uint,
+		req model.UpdateStopWordsRequest,
+	) ([]string, error)
+
+	// ExpandSearchQuery splits a search query into the terms products must match, with
+	// the seller's stop words dropped and synonyms added. A nil seller gets no expansion.
+	ExpandSearchQuery(ctx context.Context, sellerID *uint, query string) [][]string
+}
+
+// SearchSettingsServiceImpl implements SearchSettingsService
+type SearchSettingsServiceImpl struct {
+	settingsRepo repository.SearchSettingsRepository
+}
+
+// NewSearchSettingsService creates a new SearchSettingsService
+func NewSearchSettingsService(
+	settingsRepo repository.SearchSettingsRepository,
+) SearchSettingsService {
+	return &SearchSettingsServiceImpl{settingsRepo: settingsRepo}
+}
+
+// GetSettings returns the seller's effective settings (platform defaults until saved)
+func (s *SearchSettingsServiceImpl) GetSettings(
+	ctx context.Context,
+	sellerID uint,
+) (*model.SearchSettingsResponse, error) {
+	settings, err := s.settingsRepo.FindBySellerID(ctx, sellerID)
+	if err != nil {
+		return nil, err
+	}
+	if settings == nil {
+		defaults := entity.DefaultSearchSettings(sellerID)
+		return buildSearchSettingsResponse(&defaults, true), nil
+	}
+	return buildSearchSettingsResponse(settings, false), nil
+}
+
+// UpdateSettings saves the given fields on top of the seller's current settings
+func (s *SearchSettingsServiceImpl) UpdateSettings(
+	ctx context.Context,
+	sellerID uint,
+	req model.UpdateSearchSettingsRequest,
+) (*model.SearchSettingsResponse, error) {
+	settings, err := s.settingsRepo.FindBySellerID(ctx, sellerID)
+	if err != nil {
+		return nil, err
+	}
+	if settings == nil {
+		defaults := entity.DefaultSearchSettings(sellerID)
+		settings = &defaults
+	}
+
+	if req.DefaultSort != nil {
+		settings.DefaultSort = entity.ListingSort(*req.DefaultSort)
+	}
+	if req.DefaultSortOrder != nil {
+		settings.DefaultSortOrder = *req.DefaultSortOrder
+	}
+	if req.NameWeight != nil {
+		settings.NameWeight = *req.NameWeight
+	}
+	if req.TagsWeight != nil {
+		settings.TagsWeight = *req.TagsWeight
+	}
+	if req.DescriptionWeight != nil {
+		settings.DescriptionWeight = *req.DescriptionWeight
+	}
+	if req.OutOfStockDisplay != nil {
+		settings.OutOfStockDisplay = entity.OutOfStockDisplay(*req.OutOfStockDisplay)
+	}
+	if settings.NameWeight+settings.TagsWeight+settings.DescriptionWeight <= 0 {
+		return nil, prodErrors.ErrSearchWeightsAllZero
+	}
+
+	settings.UpdatedAt = time.Now()
+	if err := s.settingsRepo.Upsert(ctx, settings); err != nil {
+		return nil, err
+	}
+
+	// Listing and search pick up the change on their next read
+	if err := cache.Del(searchSettingsCacheKey(sellerID)); err != nil {
+		log.WarnWithContext(ctx, "updateSearchSettings: cache invalidation failed: "+err.Error())
+	}
+
+	return buildSearchSettingsResponse(settings, false), nil
+}
+
+// ResolveSettings returns the settings listing and search apply for a seller
+func (s *SearchSettingsServiceImpl) ResolveSettings(
+	ctx context.Context,
+	sellerID *uint,
+) entity.SearchSettings {
+	if sellerID == nil {
+		return entity.DefaultSearchSettings(0)
+	}
+
+	cacheKey := searchSettingsCacheKey(*sellerID)
+	if cached, err := cache.Get(cacheKey); err == nil && cached != "" {
+		var settings entity.SearchSettings
+		if err := json.Unmarshal([]byte(cached), &settings); err == nil {
+			return settings
+		}
+	}
+
+	settings, err := s.settingsRepo.FindBySellerID(ctx, *sellerID)
+	if err != nil {
+		log.ErrorWithContext(ctx, "resolveSearchSettings: using defaults", err)
+		return entity.DefaultSearchSettings(*sellerID)
+	}
+	if settings == nil {
+		defaults := entity.DefaultSearchSettings(*sellerID)
+		settings = &defaults
+	}
+
+	if bytes, err := json.Marshal(settings); err == nil {
+		_ = cache.Set(cacheKey, string(bytes), utils.SEARCH_SETTINGS_CACHE_TTL*time.Second)
+	}
+	return *settings
+}
+
+// ListSynonymGroups returns the seller's synonym groups, oldest first
+func (s *SearchSettingsServiceImpl) ListSynonymGroups(
+	ctx context.Context,
+	sellerID uint,
+) ([]model.SearchSynonymGroupResponse, error) {
+	groups, err := s.settingsRepo.FindSynonymGroups(ctx, sellerID)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]model.SearchSynonymGroupResponse, 0, len(groups))
+	for i := range groups {
+		resp = append(resp, *buildSynonymGroupResponse(&groups[i]))
+	}
+	return resp, nil
+}
+
+// CreateSynonymGroup adds a synonym group for the seller
+func (s *SearchSettingsServiceImpl) CreateSynonymGroup(
+	ctx context.Context,
+	sellerID uint,
+	req model.SearchSynonymGroupRequest,
+) (*model.SearchSynonymGroupResponse, error) {
+	existing, err := s.settingsRepo.FindSynonymGroups(ctx, sellerID)
+	if err != nil {
+		return nil, err
+	}
+	if len(existing) >= utils.SEARCH_MAX_SYNONYM_GROUPS {
+		return nil, prodErrors.ErrSearchSynonymLimitReached
+	}
+
+	group := &entity.SearchSynonymGroup{SellerID: sellerID}
+	return s.saveSynonymGroup(ctx, group, req, existing)
+}
+
+// UpdateSynonymGroup replaces the terms of one of the seller's synonym groups
+func (s *SearchSettingsServiceImpl) UpdateSynonymGroup(
+	ctx context.Context,
+	sellerID, groupID uint,
+	req model.SearchSynonymGroupRequest,
+) (*model.SearchSynonymGroupResponse, error) {
+	group, err := s.settingsRepo.FindSynonymGroup(ctx, sellerID, groupID)
+	if err != nil {
+		return nil, err
+	}
+	if group == nil {
+		return nil, prodErrors.ErrSearchSynonymGroupNotFound
+	}
+	existing, err := s.settingsRepo.FindSynonymGroups(ctx, sellerID)
+	if err != nil {
+		return nil, err
+	}
+	return s.saveSynonymGroup(ctx, group, req, existing)
+}
+
+// saveSynonymGroup normalizes the requested terms, rejects terms another group of the
+// seller already has and saves the group
+func (s *SearchSettingsServiceImpl) saveSynonymGroup(
+	ctx context.Context,
+	group *entity.SearchSynonymGroup,
+	req model.SearchSynonymGroupRequest,
+	existing []entity.SearchSynonymGroup,
+) (*model.SearchSynonymGroupResponse, error) {
+	terms := normalizeSearchTerms(req.Terms)
+	if len(terms) < 2 {
+		return nil, prodErrors.ErrInvalidSearchSynonyms
+	}
+	for _, other := range existing {
+		if other.ID == group.ID {
+			continue
+		}
+		for _, term := range terms {
+			if slices.Contains(other.Terms, term) {
+				return nil, prodErrors.ErrSearchSynonymConflict.WithMessagef(
+					"%q is already in synonym group %d", term, other.ID,
+				)
+			}
+		}
+	}
+
+	group.Terms = terms
+	if err := s.settingsRepo.SaveSynonymGroup(ctx, group); err != nil {
+		return nil, err
+	}
+	s.invalidateSynonyms(ctx, group.SellerID)
+	return buildSynonymGroupResponse(group), nil
+}
+
+// DeleteSynonymGroup removes one of the seller's synonym groups
+func (s *SearchSettingsServiceImpl) DeleteSynonymGroup(
+	ctx context.Context,
+	sellerID, groupID uint,
+) error {
+	deleted, err := s.settingsRepo.DeleteSynonymGroup(ctx, sellerID, groupID)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return prodErrors.ErrSearchSynonymGroupNotFound
+	}
+	s.invalidateSynonyms(ctx, sellerID)
+	return nil
+}
+
+// GetStopWords returns the seller's stop words
+func (s *SearchSettingsServiceImpl) GetStopWords(
+	ctx context.Context,
+	sellerID uint,
+) ([]string, error) {
+	settings, err := s.settingsRepo.FindBySellerID(ctx, sellerID)
+	if err != nil {
+		return nil, err
+	}
+	if settings == nil {
+		return []string{}, nil
+	}
+	return stopWordsOrEmpty(settings.StopWords), nil
+}
+
+// UpdateStopWords replaces the seller's stop words
+func (s *SearchSettingsServiceImpl) UpdateStopWords(
+	ctx context.Context,
+	sellerID uint,
+	req model.UpdateStopWordsRequest,
+) ([]string, error) {
+	var words []string
+	for _, entry := range req.StopWords {
+		for _, word := range strings.Fields(strings.ToLower(entry)) {
+			if !slices.Contains(words, word) {
+				words = append(words, word)
+			}
+		}
+	}
+	if len(words) > utils.SEARCH_MAX_STOP_WORDS {
+		words = words[:utils.SEARCH_MAX_STOP_WORDS]
+	}
+
+	settings := entity.DefaultSearchSettings(sellerID)
+	settings.StopWords = words
+	settings.UpdatedAt = time.Now()
+	if err := s.settingsRepo.UpsertStopWords(ctx, &settings); err != nil {
+		return nil, err
+	}
+
+	// Stop words travel with the cached settings
+	if err := cache.Del(searchSettingsCacheKey(sellerID)); err != nil {
+		log.WarnWithContext(ctx, "updateStopWords: cache invalidation failed: "+err.Error())
+	}
+	return stopWordsOrEmpty(settings.StopWords), nil
+}
+
+// ExpandSearchQuery splits a search query into the terms products must match
+func (s *SearchSettingsServiceImpl) ExpandSearchQuery(
+	ctx context.Context,
+	sellerID *uint,
+	query string,
+) [][]string {
+	if sellerID == nil {
+		return utils.ExpandSearchQuery(query, nil, nil)
+	}
+	settings := s.ResolveSettings(ctx, sellerID)
+	return utils.ExpandSearchQuery(query, settings.StopWords, s.resolveSynonyms(ctx, *sellerID))
+}
+
+// resolveSynonyms returns the seller's synonym groups as term lists, cached. A lookup
+// failure searches without synonyms.
+func (s *SearchSettingsServiceImpl) resolveSynonyms(ctx context.Context, sellerID uint) [][]string {
+	cacheKey := searchSynonymsCacheKey(sellerID)
+	if cached, err := cache.Get(cacheKey); err == nil && cached != "" {
+		var synonyms [][]string
+		if err := json.Unmarshal([]byte(cached), &synonyms); err == nil {
+			return synonyms
+		}
+	}
+
+	groups, err := s.settingsRepo.FindSynonymGroups(ctx, sellerID)
+	if err != nil {
+		log.ErrorWithContext(ctx, "resolveSearchSynonyms: searching without synonyms", err)
+		return nil
+	}
+	synonyms := make([][]string, 0, len(groups))
+	for _, group := range groups {
+		synonyms = append(synonyms, group.Terms)
+	}
+
+	if bytes, err := json.Marshal(synonyms); err == nil {
+		_ = cache.Set(cacheKey, string(bytes), utils.SEARCH_SYNONYMS_CACHE_TTL*time.Second)
+	}
+	return synonyms
+}
+
+// invalidateSynonyms makes searches pick up a synonym change on their next read
+func (s *SearchSettingsServiceImpl) invalidateSynonyms(ctx context.Context, sellerID uint) {
+	if err := cache.Del(searchSynonymsCacheKey(sellerID)); err != nil {
+		log.WarnWithContext(ctx, "searchSynonyms: cache invalidation failed: "+err.Error())
+	}
+}
+
+// normalizeSearchTerms normalizes terms and drops empty and repeated ones
+func normalizeSearchTerms(terms []string) []string {
+	normalized := make([]string, 0, len(terms))
+	for _, term := range terms {
+		term = utils.NormalizeSearchTerm(term)
+		if term != "" && !slices.Contains(normalized, term) {
+			normalized = append(normalized, term)
+		}
+	}
+	return normalized
+}
+
+func stopWordsOrEmpty(words []string) []string {
+	if words == nil {
+		return []string{}
+	}
+	return words
+}
+
+func searchSynonymsCacheKey(sellerID uint) string {
+	return fmt.Sprintf("%s%d", utils.SEARCH_SYNONYMS_CACHE_KEY_PREFIX, sellerID)
+}
+
+func buildSynonymGroupResponse(group *entity.SearchSynonymGroup) *model.SearchSynonymGroupResponse {
+	return &model.SearchSynonymGroupResponse{
+		ID:        group.ID,
+		Terms:     group.Terms,
+		CreatedAt: helper.FormatTimestamp(group.CreatedAt),
+		UpdatedAt: helper.FormatTimestamp(group.UpdatedAt),
+	}
+}
+
+func searchSettingsCacheKey(sellerID uint) string {
+	return fmt.Sprintf("%s%d", utils.SEARCH_SETTINGS_CACHE_KEY_PREFIX, sellerID)
+}
+
+func buildSearchSettingsResponse(
+	settings *entity.SearchSettings,
+	isDefault bool,
+) *model.SearchSettingsResponse {
+	resp := &model.SearchSettingsResponse{
+		SellerID:          settings.SellerID,
+		DefaultSort:       settings.DefaultSort.String(),
+		DefaultSortOrder:  settings.DefaultSortOrder,
+		RelevanceWeights:  utils.SettingsWeights(*settings),
+		StopWords:         stopWordsOrEmpty(settings.StopWords),
+		OutOfStockDisplay: utils.ResolveOutOfStockDisplay(*settings).String(),
+		IsDefault:         isDefault,
+	}
+	if !isDefault {
+		resp.UpdatedAt = helper.FormatTimestamp(settings.UpdatedAt)
+	}
+	return resp
+}
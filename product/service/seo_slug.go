@@ -0,0 +1,55 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"ecommerce-be/product/entity"
+	"ecommerce-be/product/repository"
+	productUtils "ecommerce-be/product/utils"
+
+	"github.com/google/uuid"
+)
+
+// slugExistsFunc reports whether a slug is already used in the caller's scope
+type slugExistsFunc func(ctx context.Context, slug string) (bool, error)
+
+// resolveUniqueSlug returns the first free slug among base, base-2, base-3, ...
+// After SLUG_MAX_SUFFIX_ATTEMPTS taken candidates a random suffix is used instead.
+func resolveUniqueSlug(ctx context.Context, base string, exists slugExistsFunc) (string, error) {
+	for attempt := 0; attempt < productUtils.SLUG_MAX_SUFFIX_ATTEMPTS; attempt++ {
+		candidate := productUtils.SlugCandidate(base, attempt)
+		taken, err := exists(ctx, candidate)
+		if err != nil {
+			return "", err
+		}
+		if !taken {
+			return candidate, nil
+		}
+	}
+	return base + "-" + strings.SplitN(uuid.NewString(), "-", 2)[0], nil
+}
+
+// moveSlug records a slug change: the old slug redirects to the entity, and a
+// redirect of the new slug (the entity's own or another's) is dropped so the live
+// slug always wins. Must run in the transaction that saves the new slug.
+func moveSlug(
+	ctx context.Context,
+	redirectRepo repository.SlugRedirectRepository,
+	entityType string,
+	sellerID *uint,
+	entityID uint,
+	oldSlug, newSlug string,
+) error {
+	if oldSlug != "" && oldSlug != newSlug {
+		if err := redirectRepo.Upsert(ctx, &entity.SlugRedirect{
+			EntityType: entityType,
+			SellerID:   sellerID,
+			OldSlug:    oldSlug,
+			EntityID:   entityID,
+		}); err != nil {
+			return err
+		}
+	}
+	return redirectRepo.DeleteBySlug(ctx, entityType, sellerID, newSlug)
+}
@@ -0,0 +1,209 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"time"
+
+	"ecommerce-be/common/cache"
+	"ecommerce-be/common/db"
+	"ecommerce-be/common/log"
+	prodErrors "ecommerce-be/product/error"
+	"ecommerce-be/product/mapper"
+	"ecommerce-be/product/model"
+	"ecommerce-be/product/repository"
+	"ecommerce-be/product/utils"
+)
+
+// SitemapService builds and serves per-seller sitemaps of published product and
+// category URLs
+type SitemapService interface {
+	// GetSitemap renders a sitemap page as XML with URLs on baseURL. Page 0 is the
+	// entry point: the only page, or an index of all pages when there are several.
+	GetSitemap(ctx context.Context, sellerID uint, baseURL string, page int) ([]byte, error)
+
+	// Regenerate rebuilds the seller's sitemap and replaces the cached copy
+	Regenerate(ctx context.Context, sellerID uint) error
+
+	// RegenerateAll rebuilds the sitemaps of all sellers with published products
+	RegenerateAll()
+}
+
+// SitemapServiceImpl implements SitemapService
+type SitemapServiceImpl struct {
+	sitemapRepo repository.SitemapRepository
+}
+
+// NewSitemapService creates a new instance of SitemapService
+func NewSitemapService(sitemapRepo repository.SitemapRepository) SitemapService {
+	return &SitemapServiceImpl{sitemapRepo: sitemapRepo}
+}
+
+// GetSitemap renders the requested page of the seller's cached sitemap
+func (s *SitemapServiceImpl) GetSitemap(
+	ctx context.Context,
+	sellerID uint,
+	baseURL string,
+	page int,
+) ([]byte, error) {
+	sitemap, err := cache.GetOrLoad(
+		ctx,
+		utils.SITEMAP_CACHE_NAMESPACE,
+		sitemapCacheKey(sellerID),
+		utils.SITEMAP_CACHE_TTL*time.Second,
+		func(ctx context.Context) (*model.SellerSitemap, error) { return s.build(ctx, sellerID) },
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	pages := utils.SitemapPageCount(len(sitemap.Entries))
+	if page == 0 && pages > 1 {
+		return marshalSitemap(buildSitemapIndex(sitemap.Entries, baseURL, pages))
+	}
+	if page == 0 {
+		page = 1
+	}
+	if page < 1 || page > pages {
+		return nil, prodErrors.ErrSitemapPageNotFound
+	}
+
+	start, end := utils.SitemapPageBounds(page, len(sitemap.Entries))
+	urlSet := model.SitemapURLSet{
+		Xmlns: utils.SITEMAP_XMLNS,
+		URLs:  make([]model.SitemapURL, 0, end-start),
+	}
+	for _, entry := range sitemap.Entries[start:end] {
+		urlSet.URLs = append(urlSet.URLs, model.SitemapURL{
+			Loc:     baseURL + entry.Path,
+			LastMod: entry.LastMod,
+		})
+	}
+	return marshalSitemap(urlSet)
+}
+
+// Regenerate rebuilds the seller's sitemap and overwrites the cached copy
+func (s *SitemapServiceImpl) Regenerate(ctx context.Context, sellerID uint) error {
+	sitemap, err := s.build(ctx, sellerID)
+	if err != nil {
+		return err
+	}
+	body, err := json.Marshal(sitemap)
+	if err != nil {
+		return err
+	}
+	return cache.Set(
+		sitemapCacheKey(sellerID),
+		string(body),
+		cache.JitterTTL(utils.SITEMAP_CACHE_TTL*time.Second),
+	)
+}
+
+// RegenerateAll is the scheduled refresh. It keeps the cache warm and picks up
+// publish changes that did not evict a sitemap, such as variants becoming purchasable.
+// Failures are logged per seller and do not stop the run.
+func (s *SitemapServiceImpl) RegenerateAll() {
+	ctx := context.Background()
+	sellerIDs, err := s.sitemapRepo.FindSellerIDs(ctx)
+	if err != nil {
+		log.ErrorWithContext(ctx, "sitemap refresh: failed to list sellers", err)
+		return
+	}
+	for _, sellerID := range sellerIDs {
+		if err := s.Regenerate(ctx, sellerID); err != nil {
+			log.WarnWithContext(ctx, fmt.Sprintf(
+				"sitemap refresh: sellerId=%d: %v", sellerID, err,
+			))
+		}
+	}
+}
+
+// build collects the seller's published category and product URLs
+func (s *SitemapServiceImpl) build(
+	ctx context.Context,
+	sellerID uint,
+) (*model.SellerSitemap, error) {
+	categories, err := s.sitemapRepo.FindCategoryEntries(ctx, sellerID)
+	if err != nil {
+		return nil, err
+	}
+	products, err := s.sitemapRepo.FindProductEntries(ctx, sellerID)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]model.SitemapEntry, 0, len(categories)+len(products))
+	entries = appendSitemapEntries(entries, utils.SITEMAP_CATEGORY_PATH_PREFIX, categories)
+	entries = appendSitemapEntries(entries, utils.SITEMAP_PRODUCT_PATH_PREFIX, products)
+	return &model.SellerSitemap{Entries: entries}, nil
+}
+
+// appendSitemapEntries maps slug rows to storefront paths under prefix
+func appendSitemapEntries(
+	entries []model.SitemapEntry,
+	prefix string,
+	rows []mapper.SitemapEntryRow,
+) []model.SitemapEntry {
+	for _, row := range rows {
+		entries = append(entries, model.SitemapEntry{
+			Path:    prefix + row.Slug,
+			LastMod: row.UpdatedAt.UTC().Format(utils.SITEMAP_DATE_LAYOUT),
+		})
+	}
+	return entries
+}
+
+// buildSitemapIndex links every page of a sitemap, dated by its newest entry
+func buildSitemapIndex(
+	entries []model.SitemapEntry,
+	baseURL string,
+	pages int,
+) model.SitemapIndex {
+	index := model.SitemapIndex{
+		Xmlns:    utils.SITEMAP_XMLNS,
+		Sitemaps: make([]model.SitemapIndexRef, 0, pages),
+	}
+	for page := 1; page <= pages; page++ {
+		start, end := utils.SitemapPageBounds(page, len(entries))
+		var lastMod string
+		for _, entry := range entries[start:end] {
+			// YYYY-MM-DD dates order lexically
+			lastMod = max(lastMod, entry.LastMod)
+		}
+		index.Sitemaps = append(index.Sitemaps, model.SitemapIndexRef{
+			Loc: fmt.Sprintf(
+				"%s%s?%s=%d",
+				baseURL, utils.SITEMAP_ROUTE, utils.SITEMAP_PAGE_PARAM, page,
+			),
+			LastMod: lastMod,
+		})
+	}
+	return index
+}
+
+// marshalSitemap encodes a sitemap document with the XML declaration
+func marshalSitemap(document any) ([]byte, error) {
+	body, err := xml.Marshal(document)
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), body...), nil
+}
+
+// sitemapCacheKey is the cache key of a seller's sitemap
+func sitemapCacheKey(sellerID uint) string {
+	return cache.VersionedKey(utils.SITEMAP_CACHE_NAMESPACE, sellerID)
+}
+
+// InvalidateSitemapCache evicts the seller's sitemap once the current transaction
+// commits; the next request rebuilds it. Failures are logged only; the entry is
+// replaced by the refresh job or expires with its TTL.
+func InvalidateSitemapCache(ctx context.Context, sellerID uint) {
+	db.AfterCommit(ctx, func() {
+		if err := cache.Del(sitemapCacheKey(sellerID)); err != nil {
+			log.WarnWithContext(ctx, "invalidateSitemapCache: "+err.Error())
+		}
+	})
+}
@@ -0,0 +1,364 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"ecommerce-be/product/entity"
+	prodErrors "ecommerce-be/product/error"
+	"ecommerce-be/product/model"
+	"ecommerce-be/product/repository"
+	"ecommerce-be/product/utils"
+	"ecommerce-be/product/utils/helper"
+)
+
+// SKUGeneratorService manages seller SKU patterns and generates SKUs for products and
+// variants created without one
+type SKUGeneratorService interface {
+	// GetSettings returns the seller's effective settings (platform defaults until saved)
+	GetSettings(ctx context.Context, sellerID uint) (*model.SKUSettingsResponse, error)
+
+	// UpdateSettings validates and saves the given patterns on top of the current ones
+	UpdateSettings(
+		ctx context.Context,
+		sellerID uint,
+		req model.UpdateSKUSettingsRequest,
+	) (*model.SKUSettingsResponse, error)
+
+	// Preview renders the SKUs the next product and variant would get, without
+	// reserving a sequence number or checking for collisions
+	Preview(
+		ctx context.Context,
+		sellerID uint,
+		req model.SKUPreviewRequest,
+	) (*model.SKUPreviewResponse, error)
+
+	// GenerateProductSKU returns an unused base SKU for a product being created
+	GenerateProductSKU(ctx context.Context, product *entity.Product) (string, error)
+
+	// GenerateVariantSKUs returns one unused SKU per entry of options, each the
+	// selected option values of a variant being created
+	GenerateVariantSKUs(
+		ctx context.Context,
+		product *entity.Product,
+		options [][]string,
+	) ([]string, error)
+}
+
+// SKUGeneratorServiceImpl implements SKUGeneratorService
+type SKUGeneratorServiceImpl struct {
+	settingsRepo repository.SKUSettingsRepository
+	categoryRepo repository.CategoryRepository
+}
+
+// NewSKUGeneratorService creates a new SKUGeneratorService
+func NewSKUGeneratorService(
+	settingsRepo repository.SKUSettingsRepository,
+	categoryRepo repository.CategoryRepository,
+) SKUGeneratorService {
+	return &SKUGeneratorServiceImpl{
+		settingsRepo: settingsRepo,
+		categoryRepo: categoryRepo,
+	}
+}
+
+// GetSettings returns the seller's effective settings (platform defaults until saved)
+func (s *SKUGeneratorServiceImpl) GetSettings(
+	ctx context.Context,
+	sellerID uint,
+) (*model.SKUSettingsResponse, error) {
+	settings, isDefault, err := s.resolveSettings(ctx, sellerID)
+	if err != nil {
+		return nil, err
+	}
+	return buildSKUSettingsResponse(settings, isDefault), nil
+}
+
+// UpdateSettings validates and saves the given patterns on top of the current ones
+func (s *SKUGeneratorServiceImpl) UpdateSettings(
+	ctx context.Context,
+	sellerID uint,
+	req model.UpdateSKUSettingsRequest,
+) (*model.SKUSettingsResponse, error) {
+	settings, _, err := s.resolveSettings(ctx, sellerID)
+	if err != nil {
+		return nil, err
+	}
+	if req.ProductPattern != nil {
+		settings.ProductPattern = *req.ProductPattern
+	}
+	if req.VariantPattern != nil {
+		settings.VariantPattern = *req.VariantPattern
+	}
+	if err := validateSKUPatterns(settings.ProductPattern, settings.VariantPattern); err != nil {
+		return nil, err
+	}
+
+	settings.UpdatedAt = time.Now()
+	if err := s.settingsRepo.Upsert(ctx, settings); err != nil {
+		return nil, err
+	}
+	return buildSKUSettingsResponse(settings, false), nil
+}
+
+// Preview renders the SKUs the next product and variant would get
+func (s *SKUGeneratorServiceImpl) Preview(
+	ctx context.Context,
+	sellerID uint,
+	req model.SKUPreviewRequest,
+) (*model.SKUPreviewResponse, error) {
+	settings, _, err := s.resolveSettings(ctx, sellerID)
+	if err != nil {
+		return nil, err
+	}
+	if req.ProductPattern != nil {
+		settings.ProductPattern = *req.ProductPattern
+	}
+	if req.VariantPattern != nil {
+		settings.VariantPattern = *req.VariantPattern
+	}
+	if err := validateSKUPatterns(settings.ProductPattern, settings.VariantPattern); err != nil {
+		return nil, err
+	}
+
+	values := utils.SKUPatternValues{Brand: req.Brand, Name: req.Name}
+	if req.CategoryID != 0 {
+		category, err := s.categoryRepo.FindByID(ctx, req.CategoryID)
+		if err != nil {
+			return nil, err
+		}
+		values.Category = category.Name
+	}
+
+	// Mirror generation: the product takes the next number, then the variant
+	values.Sequence = settings.NextSequence
+	productSKU, err := renderSKU(settings.ProductPattern, values)
+	if err != nil {
+		return nil, err
+	}
+	if utils.SKUPatternUses(settings.ProductPattern, utils.SKU_TOKEN_SEQUENCE) {
+		values.Sequence++
+	}
+	values.Base = productSKU
+	values.Options = req.Options
+	variantSKU, err := renderSKU(settings.VariantPattern, values)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.SKUPreviewResponse{
+		ProductPattern: settings.ProductPattern,
+		VariantPattern: settings.VariantPattern,
+		ProductSKU:     productSKU,
+		VariantSKU:     variantSKU,
+		Sequence:       settings.NextSequence,
+	}, nil
+}
+
+// GenerateProductSKU returns an unused base SKU for a product being created
+func (s *SKUGeneratorServiceImpl) GenerateProductSKU(
+	ctx context.Context,
+	product *entity.Product,
+) (string, error) {
+	settings, _, err := s.resolveSettings(ctx, product.SellerID)
+	if err != nil {
+		return "", err
+	}
+	values, err := s.productSKUValues(ctx, product)
+	if err != nil {
+		return "", err
+	}
+	return s.generateSKU(ctx, product.SellerID, settings.ProductPattern, values,
+		utils.SKU_BASE_MAX_LENGTH, make(map[string]bool))
+}
+
+// GenerateVariantSKUs returns one unused SKU per entry of options. SKUs generated in
+// the same call never repeat.
+func (s *SKUGeneratorServiceImpl) GenerateVariantSKUs(
+	ctx context.Context,
+	product *entity.Product,
+	options [][]string,
+) ([]string, error) {
+	settings, _, err := s.resolveSettings(ctx, product.SellerID)
+	if err != nil {
+		return nil, err
+	}
+	values, err := s.productSKUValues(ctx, product)
+	if err != nil {
+		return nil, err
+	}
+	values.Base = product.BaseSKU
+
+	skus := make([]string, len(options))
+	taken := make(map[string]bool, len(options))
+	for i, selected := range options {
+		values.Options = selected
+		skus[i], err = s.generateSKU(ctx, product.SellerID, settings.VariantPattern, values,
+			utils.VARIANT_SKU_MAX_LENGTH, taken)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return skus, nil
+}
+
+// generateSKU renders the pattern until it yields an SKU the seller does not use yet.
+// Patterns with {SEQ} take a fresh number per attempt; others get a "-2", "-3"... suffix.
+func (s *SKUGeneratorServiceImpl) generateSKU(
+	ctx context.Context,
+	sellerID uint,
+	pattern string,
+	values utils.SKUPatternValues,
+	maxLength int,
+	taken map[string]bool,
+) (string, error) {
+	usesSequence := utils.SKUPatternUses(pattern, utils.SKU_TOKEN_SEQUENCE)
+	var base string
+	for attempt := 1; attempt <= utils.SKU_GENERATE_MAX_ATTEMPTS; attempt++ {
+		candidate := base
+		if usesSequence || attempt == 1 {
+			if usesSequence {
+				sequence, err := s.settingsRepo.ReserveSequence(ctx, sellerID)
+				if err != nil {
+					return "", err
+				}
+				values.Sequence = sequence
+			}
+			rendered, err := renderSKU(pattern, values)
+			if err != nil {
+				return "", err
+			}
+			base, candidate = rendered, rendered
+		} else {
+			candidate = fmt.Sprintf("%s-%d", base, attempt)
+		}
+		if len(candidate) > maxLength {
+			return "", prodErrors.ErrSKUPatternInvalid.WithMessagef(
+				"generated SKU %q is longer than %d characters", candidate, maxLength)
+		}
+		if taken[candidate] {
+			continue
+		}
+
+		existing, err := s.settingsRepo.FindExistingSKUs(ctx, sellerID, []string{candidate})
+		if err != nil {
+			return "", err
+		}
+		if len(existing) == 0 {
+			taken[candidate] = true
+			return candidate, nil
+		}
+	}
+	return "", prodErrors.ErrSKUGenerationFailed
+}
+
+// productSKUValues returns the product fields patterns can use
+func (s *SKUGeneratorServiceImpl) productSKUValues(
+	ctx context.Context,
+	product *entity.Product,
+) (utils.SKUPatternValues, error) {
+	values := utils.SKUPatternValues{Brand: product.Brand, Name: product.Name}
+	if product.Category != nil {
+		values.Category = product.Category.Name
+	} else if product.CategoryID != 0 {
+		category, err := s.categoryRepo.FindByID(ctx, product.CategoryID)
+		if err != nil {
+			return values, err
+		}
+		values.Category = category.Name
+	}
+	return values, nil
+}
+
+// resolveSettings returns the seller's settings, or the defaults with isDefault set
+func (s *SKUGeneratorServiceImpl) resolveSettings(
+	ctx context.Context,
+	sellerID uint,
+) (*entity.SKUSettings, bool, error) {
+	settings, err := s.settingsRepo.FindBySellerID(ctx, sellerID)
+	if err != nil {
+		return nil, false, err
+	}
+	if settings == nil {
+		defaults := entity.DefaultSKUSettings(sellerID)
+		return &defaults, true, nil
+	}
+	return settings, false, nil
+}
+
+func validateSKUPatterns(productPattern string, variantPattern string) error {
+	if err := utils.ValidateSKUPattern(productPattern, utils.ProductSKUTokens); err != nil {
+		return prodErrors.ErrSKUPatternInvalid.WithMessagef("productPattern: %s", err)
+	}
+	if err := utils.ValidateSKUPattern(variantPattern, utils.VariantSKUTokens); err != nil {
+		return prodErrors.ErrSKUPatternInvalid.WithMessagef("variantPattern: %s", err)
+	}
+	return nil
+}
+
+func renderSKU(pattern string, values utils.SKUPatternValues) (string, error) {
+	sku, err := utils.RenderSKUPattern(pattern, values)
+	if err != nil {
+		return "", prodErrors.ErrSKUPatternInvalid.WithMessage(err.Error())
+	}
+	return sku, nil
+}
+
+func buildSKUSettingsResponse(
+	settings *entity.SKUSettings,
+	isDefault bool,
+) *model.SKUSettingsResponse {
+	resp := &model.SKUSettingsResponse{
+		SellerID:       settings.SellerID,
+		ProductPattern: settings.ProductPattern,
+		VariantPattern: settings.VariantPattern,
+		NextSequence:   settings.NextSequence,
+		IsDefault:      isDefault,
+	}
+	if !isDefault {
+		resp.UpdatedAt = helper.FormatTimestamp(settings.UpdatedAt)
+	}
+	return resp
+}
+
+// generateMissingVariantSKUs returns the requests with an SKU generated from the seller's
+// variant pattern for each one that omits it
+func generateMissingVariantSKUs(
+	ctx context.Context,
+	generator SKUGeneratorService,
+	product *entity.Product,
+	requests []model.CreateVariantRequest,
+) ([]model.CreateVariantRequest, error) {
+	var missing []int
+	var options [][]string
+	for i, request := range requests {
+		if request.SKU == "" {
+			missing = append(missing, i)
+			options = append(options, variantOptionValues(request.Options))
+		}
+	}
+	if len(missing) == 0 {
+		return requests, nil
+	}
+
+	skus, err := generator.GenerateVariantSKUs(ctx, product, options)
+	if err != nil {
+		return nil, err
+	}
+	filled := slices.Clone(requests)
+	for i, index := range missing {
+		filled[index].SKU = skus[i]
+	}
+	return filled, nil
+}
+
+// variantOptionValues lists a variant's selected option values in request order
+func variantOptionValues(options []model.VariantOptionInput) []string {
+	values := make([]string, len(options))
+	for i, option := range options {
+		values[i] = option.Value
+	}
+	return values
+}
@@ -0,0 +1,289 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"ecommerce-be/common/cache"
+	"ecommerce-be/common/log"
+	"ecommerce-be/product/entity"
+	prodErrors "ecommerce-be/product/error"
+	"ecommerce-be/product/model"
+	"ecommerce-be/product/repository"
+	"ecommerce-be/product/utils"
+)
+
+// SponsoredPlacementService manages seller sponsored search placements, picks the ones
+// a search shows and records their impressions and clicks
+type SponsoredPlacementService interface {
+	ListPlacements(ctx context.Context, sellerID uint) ([]model.SponsoredPlacementResponse, error)
+	CreatePlacement(
+		ctx context.Context,
+		sellerID uint,
+		req model.SponsoredPlacementRequest,
+	) (*model.SponsoredPlacementResponse, error)
+	UpdatePlacement(
+		ctx context.Context,
+		sellerID, placementID uint,
+		req model.SponsoredPlacementRequest,
+	) (*model.SponsoredPlacementResponse, error)
+	DeletePlacement(ctx context.Context, sellerID, placementID uint) error
+
+	// RecordImpression counts a shown placement toward its statistics and the
+	// viewer's frequency cap
+	RecordImpression(ctx context.Context, placementID uint, viewer string) error
+	RecordClick(ctx context.Context, placementID uint) error
+
+	// SelectForSearch returns the placements a search page shows, keyed by index on
+	// the page. Lookup failures yield no placements so search keeps working.
+	SelectForSearch(
+		ctx context.Context,
+		query string,
+		categoryID, sellerID *uint,
+		viewer string,
+		page, limit int,
+	) map[int]entity.SponsoredPlacement
+}
+
+// SponsoredPlacementServiceImpl implements SponsoredPlacementService
+type SponsoredPlacementServiceImpl struct {
+	placementRepo    repository.SponsoredPlacementRepository
+	validatorService ProductValidatorService
+}
+
+// NewSponsoredPlacementService creates a new SponsoredPlacementService
+func NewSponsoredPlacementService(
+	placementRepo repository.SponsoredPlacementRepository,
+	validatorService ProductValidatorService,
+) SponsoredPlacementService {
+	return &SponsoredPlacementServiceImpl{
+		placementRepo:    placementRepo,
+		validatorService: validatorService,
+	}
+}
+
+// ListPlacements returns the seller's placements with delivery statistics
+func (s *SponsoredPlacementServiceImpl) ListPlacements(
+	ctx context.Context,
+	sellerID uint,
+) ([]model.SponsoredPlacementResponse, error) {
+	placements, err := s.placementRepo.FindBySellerID(ctx, sellerID)
+	if err != nil {
+		return nil, err
+	}
+	responses := make([]model.SponsoredPlacementResponse, 0, len(placements))
+	for _, placement := range placements {
+		responses = append(responses, buildSponsoredPlacementResponse(placement))
+	}
+	return responses, nil
+}
+
+// CreatePlacement creates a placement for one of the seller's products
+func (s *SponsoredPlacementServiceImpl) CreatePlacement(
+	ctx context.Context,
+	sellerID uint,
+	req model.SponsoredPlacementRequest,
+) (*model.SponsoredPlacementResponse, error) {
+	placement := &entity.SponsoredPlacement{SellerID: sellerID, IsActive: true}
+	if err := s.applyRequest(ctx, placement, req); err != nil {
+		return nil, err
+	}
+	if err := s.placementRepo.Create(ctx, placement); err != nil {
+		return nil, err
+	}
+	response := buildSponsoredPlacementResponse(*placement)
+	return &response, nil
+}
+
+// UpdatePlacement replaces a placement's settings, keeping its statistics
+func (s *SponsoredPlacementServiceImpl) UpdatePlacement(
+	ctx context.Context,
+	sellerID, placementID uint,
+	req model.SponsoredPlacementRequest,
+) (*model.SponsoredPlacementResponse, error) {
+	placement, err := s.findSellerPlacement(ctx, sellerID, placementID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.applyRequest(ctx, placement, req); err != nil {
+		return nil, err
+	}
+	placement.UpdatedAt = time.Now()
+	if err := s.placementRepo.Update(ctx, placement); err != nil {
+		return nil, err
+	}
+	response := buildSponsoredPlacementResponse(*placement)
+	return &response, nil
+}
+
+// DeletePlacement removes one of the seller's placements
+func (s *SponsoredPlacementServiceImpl) DeletePlacement(
+	ctx context.Context,
+	sellerID, placementID uint,
+) error {
+	if _, err := s.findSellerPlacement(ctx, sellerID, placementID); err != nil {
+		return err
+	}
+	return s.placementRepo.Delete(ctx, placementID)
+}
+
+// RecordImpression counts an impression and charges it to the viewer's frequency cap
+func (s *SponsoredPlacementServiceImpl) RecordImpression(
+	ctx context.Context,
+	placementID uint,
+	viewer string,
+) error {
+	if err := s.placementRepo.IncrementImpressions(ctx, placementID); err != nil {
+		return err
+	}
+	if viewer == "" {
+		return nil
+	}
+	_, err := cache.IncrementCounter(
+		sponsoredFrequencyKey(placementID, viewer),
+		utils.SPONSORED_FREQUENCY_WINDOW,
+	)
+	if err != nil {
+		log.WarnWithContext(ctx, "recordSponsoredImpression: frequency counter failed: "+err.Error())
+	}
+	return nil
+}
+
+// RecordClick counts a click on a placement
+func (s *SponsoredPlacementServiceImpl) RecordClick(ctx context.Context, placementID uint) error {
+	return s.placementRepo.IncrementClicks(ctx, placementID)
+}
+
+// SelectForSearch picks the live placements matching the search, skips those the viewer
+// has hit the frequency cap for and keeps the oldest placement per position and product
+func (s *SponsoredPlacementServiceImpl) SelectForSearch(
+	ctx context.Context,
+	query string,
+	categoryID, sellerID *uint,
+	viewer string,
+	page, limit int,
+) map[int]entity.SponsoredPlacement {
+	placements, err := s.placementRepo.FindLive(ctx, sellerID, time.Now())
+	if err != nil {
+		log.ErrorWithContext(ctx, "selectSponsoredPlacements: skipping sponsored results", err)
+		return nil
+	}
+
+	selected := make(map[int]entity.SponsoredPlacement)
+	products := make(map[uint]bool)
+	for _, placement := range placements {
+		index, onPage := utils.SponsoredPageIndex(placement.Position, page, limit)
+		if !onPage || products[placement.ProductID] {
+			continue
+		}
+		if _, taken := selected[index]; taken {
+			continue
+		}
+		if !utils.SponsoredPlacementMatches(placement, query, categoryID) ||
+			frequencyCapReached(placement, viewer) {
+			continue
+		}
+		selected[index] = placement
+		products[placement.ProductID] = true
+	}
+	return selected
+}
+
+// applyRequest validates the request and copies it onto the placement
+func (s *SponsoredPlacementServiceImpl) applyRequest(
+	ctx context.Context,
+	placement *entity.SponsoredPlacement,
+	req model.SponsoredPlacementRequest,
+) error {
+	keywords := utils.NormalizeSponsoredKeywords(req.Keywords)
+	if len(keywords) == 0 && req.CategoryID == nil {
+		return prodErrors.ErrSponsoredPlacementNoTarget
+	}
+	if req.StartsAt != nil && req.EndsAt != nil && !req.EndsAt.After(*req.StartsAt) {
+		return prodErrors.ErrSponsoredPlacementInvalidWindow
+	}
+	_, err := s.validatorService.GetAndValidateProductOwnershipNonPtr(
+		ctx,
+		req.ProductID,
+		placement.SellerID,
+	)
+	if err != nil {
+		return err
+	}
+
+	placement.ProductID = req.ProductID
+	placement.Position = req.Position
+	placement.Keywords = keywords
+	placement.CategoryID = req.CategoryID
+	placement.FrequencyCap = req.FrequencyCap
+	if req.IsActive != nil {
+		placement.IsActive = *req.IsActive
+	}
+	placement.StartsAt = req.StartsAt
+	placement.EndsAt = req.EndsAt
+	return nil
+}
+
+// findSellerPlacement returns the placement if it belongs to the seller
+func (s *SponsoredPlacementServiceImpl) findSellerPlacement(
+	ctx context.Context,
+	sellerID, placementID uint,
+) (*entity.SponsoredPlacement, error) {
+	placement, err := s.placementRepo.FindByID(ctx, placementID)
+	if err != nil {
+		return nil, err
+	}
+	if placement.SellerID != sellerID {
+		return nil, prodErrors.ErrSponsoredPlacementNotFound
+	}
+	return placement, nil
+}
+
+// frequencyCapReached reports whether the viewer has seen the placement as often as
+// its cap allows. Anonymous lookups and counter failures never hide a placement.
+func frequencyCapReached(placement entity.SponsoredPlacement, viewer string) bool {
+	if placement.FrequencyCap <= 0 || viewer == "" {
+		return false
+	}
+	value, err := cache.Get(sponsoredFrequencyKey(placement.ID, viewer))
+	if err != nil {
+		return false
+	}
+	seen, err := strconv.Atoi(value)
+	return err == nil && seen >= placement.FrequencyCap
+}
+
+func sponsoredFrequencyKey(placementID uint, viewer string) string {
+	return fmt.Sprintf("%s%d:%s", utils.SPONSORED_FREQUENCY_KEY_PREFIX, placementID, viewer)
+}
+
+func buildSponsoredPlacementResponse(
+	placement entity.SponsoredPlacement,
+) model.SponsoredPlacementResponse {
+	clickThrough := 0.0
+	if placement.ImpressionCount > 0 {
+		clickThrough = float64(placement.ClickCount) / float64(placement.ImpressionCount) * 100
+		clickThrough = math.Round(clickThrough*100) / 100
+	}
+	keywords := []string(placement.Keywords)
+	if keywords == nil {
+		keywords = []string{}
+	}
+	return model.SponsoredPlacementResponse{
+		ID:              placement.ID,
+		ProductID:       placement.ProductID,
+		Position:        placement.Position,
+		Keywords:        keywords,
+		CategoryID:      placement.CategoryID,
+		FrequencyCap:    placement.FrequencyCap,
+		IsActive:        placement.IsActive,
+		StartsAt:        placement.StartsAt,
+		EndsAt:          placement.EndsAt,
+		Impressions:     placement.ImpressionCount,
+		Clicks:          placement.ClickCount,
+		ClickThroughPct: clickThrough,
+	}
+}
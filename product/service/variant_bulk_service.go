@@ -4,12 +4,14 @@ import (
 	"context"
 	"strings"
 
+	"ecommerce-be/common/db"
 	commonError "ecommerce-be/common/error"
 	"ecommerce-be/product/entity"
 	prodErrors "ecommerce-be/product/error"
 	"ecommerce-be/product/factory"
 	"ecommerce-be/product/model"
 	"ecommerce-be/product/repository"
+	"ecommerce-be/product/utils"
 	"ecommerce-be/product/validator"
 )
 
@@ -32,6 +34,15 @@ type VariantBulkService interface {
 		requests []model.CreateVariantRequest,
 	) ([]model.VariantDetailResponse, error)
 
+	// GenerateVariants creates the variants of an option matrix the product does not have
+	// yet, at one base price with SKUs rendered from a pattern
+	GenerateVariants(
+		ctx context.Context,
+		productID uint,
+		sellerID uint,
+		request *model.GenerateVariantsRequest,
+	) (*model.GenerateVariantsResponse, error)
+
 	// DeleteVariantsByProductID deletes all variants and their associated data for a product
 	// Handles cascade deletion of variant_option_values
 	DeleteVariantsByProductID(ctx context.Context, productID uint) error
@@ -42,6 +53,8 @@ type VariantBulkServiceImpl struct {
 	variantRepo      repository.VariantRepository
 	optionService    ProductOptionService
 	validatorService ProductValidatorService
+	bundleRepo       repository.VariantBundleRepository
+	skuGenerator     SKUGeneratorService
 }
 
 // NewVariantBulkService creates a new instance of VariantBulkService
@@ -49,11 +62,15 @@ func NewVariantBulkService(
 	variantRepo repository.VariantRepository,
 	optionService ProductOptionService,
 	validatorService ProductValidatorService,
+	bundleRepo repository.VariantBundleRepository,
+	skuGenerator SKUGeneratorService,
 ) VariantBulkService {
 	return &VariantBulkServiceImpl{
 		variantRepo:      variantRepo,
 		optionService:    optionService,
 		validatorService: validatorService,
+		bundleRepo:       bundleRepo,
+		skuGenerator:     skuGenerator,
 	}
 }
 
@@ -66,7 +83,8 @@ func (s *VariantBulkServiceImpl) BulkUpdateVariants(
 	request *model.BulkUpdateVariantsRequest,
 ) (*model.BulkUpdateVariantsResponse, error) {
 	// Get product and validate seller access using validator service
-	_, err := s.validatorService.GetAndValidateProductOwnershipNonPtr(ctx, productID, sellerID)
+	product, err := s.validatorService.GetAndValidateProductOwnershipNonPtr(
+		ctx, productID, sellerID)
 	if err != nil {
 		return nil, err
 	}
@@ -76,6 +94,20 @@ func (s *VariantBulkServiceImpl) BulkUpdateVariants(
 		return nil, err
 	}
 
+	// Variants getting a new barcode give up their old one
+	var barcodes []string
+	var rebarcodedIDs []uint
+	for _, item := range request.Variants {
+		if item.Barcode != nil {
+			barcodes = append(barcodes, *item.Barcode)
+			rebarcodedIDs = append(rebarcodedIDs, item.ID)
+		}
+	}
+	err = ensureBarcodesAvailable(ctx, s.variantRepo, product.SellerID, barcodes, rebarcodedIDs)
+	if err != nil {
+		return nil, err
+	}
+
 	// Extract variant IDs and track default
 	variantIDs, updateMap, lastDefaultVariantID := s.extractVariantIDsAndTrackDefault(request)
 
@@ -90,25 +122,42 @@ func (s *VariantBulkServiceImpl) BulkUpdateVariants(
 		return nil, err
 	}
 
+	// Reject the request if any variant moved past the version the client expects
+	if err := validator.ValidateBulkVariantVersions(existingVariants, updateMap); err != nil {
+		return nil, err
+	}
+
 	// Apply "last one wins" rule for defaults
 	s.applyLastOneWinsRule(updateMap, lastDefaultVariantID)
 
-	// Update variants using factory
+	// Update variants using factory. When the request picks a default, every variant
+	// in it is written with whether it is that default.
 	variantsToUpdate := make([]*entity.ProductVariant, 0, len(existingVariants))
+	variantIDsToUpdate := make([]uint, 0, len(existingVariants))
 	for i := range existingVariants {
 		variant := &existingVariants[i]
 		variant = factory.BulkUpdateVariantEntity(variant, updateMap[variant.ID])
+		if lastDefaultVariantID != nil {
+			variant.IsDefault = variant.ID == *lastDefaultVariantID
+		}
 		variantsToUpdate = append(variantsToUpdate, variant)
-	}
-
-	// Transaction: Handle default logic and bulk update atomically
-	if lastDefaultVariantID != nil {
-		if err := s.variantRepo.UnsetAllDefaultVariantsForProduct(ctx, productID); err != nil {
-			return nil, err
+		variantIDsToUpdate = append(variantIDsToUpdate, variant.ID)
+	}
+
+	// Transaction: Handle default logic and bulk update atomically. The update
+	// compare-and-swaps each variant on the version read above, so the unset leaves
+	// the variants being updated alone.
+	err = db.WithTransaction(ctx, func(txCtx context.Context) error {
+		if lastDefaultVariantID != nil {
+			err := s.variantRepo.UnsetAllDefaultVariantsForProduct(
+				txCtx, productID, variantIDsToUpdate...)
+			if err != nil {
+				return err
+			}
 		}
-	}
-
-	if err := s.variantRepo.BulkUpdateVariants(ctx, variantsToUpdate); err != nil {
+		return s.variantRepo.BulkUpdateVariants(txCtx, variantsToUpdate)
+	})
+	if err != nil {
 		return nil, err
 	}
 
@@ -162,8 +211,10 @@ func (s *VariantBulkServiceImpl) buildBulkUpdateResponse(
 		summaries = append(summaries, model.BulkUpdateVariantSummary{
 			ID:            variant.ID,
 			SKU:           variant.SKU,
+			Barcode:       variant.Barcode,
 			Price:         variant.Price,
 			AllowPurchase: variant.AllowPurchase,
+			Version:       variant.Version,
 		})
 	}
 
@@ -190,7 +241,8 @@ func (s *VariantBulkServiceImpl) CreateVariantsBulk(
 	}
 
 	// Validate product ownership
-	_, err := s.validatorService.GetAndValidateProductOwnershipNonPtr(ctx, productID, sellerID)
+	product, err := s.validatorService.GetAndValidateProductOwnershipNonPtr(
+		ctx, productID, sellerID)
 	if err != nil {
 		return nil, err
 	}
@@ -207,10 +259,17 @@ func (s *VariantBulkServiceImpl) CreateVariantsBulk(
 	// Find last default variant index
 	lastDefaultIndex := s.findLastDefaultVariantIndex(requests)
 
+	// Option rules decide which options each combination must specify
+	rules, err := s.optionService.GetOptionRules(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+
 	// Validate and map variant option combinations
 	variantOptionCombinations, err := s.validateAndMapVariantOptions(
 		requests,
 		productOptions,
+		rules,
 		optionMap,
 		optionValueMap,
 	)
@@ -218,6 +277,21 @@ func (s *VariantBulkServiceImpl) CreateVariantsBulk(
 		return nil, err
 	}
 
+	barcodes := make([]string, len(requests))
+	for i, request := range requests {
+		barcodes[i] = request.Barcode
+	}
+	err = ensureBarcodesAvailable(ctx, s.variantRepo, product.SellerID, barcodes, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Variants without an SKU get one from the seller's pattern once the batch is valid
+	requests, err = generateMissingVariantSKUs(ctx, s.skuGenerator, product, requests)
+	if err != nil {
+		return nil, err
+	}
+
 	// Transaction: Wrap all operations for data consistency
 	// This ensures default logic, variant creation, and option linking are atomic
 	var createdVariants []entity.ProductVariant
@@ -312,26 +386,23 @@ func (s *VariantBulkServiceImpl) findLastDefaultVariantIndex(
 func (s *VariantBulkServiceImpl) validateAndMapVariantOptions(
 	requests []model.CreateVariantRequest,
 	productOptions []entity.ProductOption,
+	rules []entity.ProductOptionRule,
 	optionMap map[string]*entity.ProductOption,
 	optionValueMap map[uint]map[string]uint,
 ) ([]map[uint]uint, error) {
 	combinationSet := make(map[string]bool)
 	variantOptionCombinations := make([]map[uint]uint, len(requests))
 
+	optionNames := make(map[uint]string, len(productOptions))
+	for _, option := range productOptions {
+		optionNames[option.ID] = option.Name
+	}
+
 	for i, req := range requests {
 		if len(req.Options) == 0 {
 			continue
 		}
 
-		// Validate all required options are provided
-		if len(productOptions) > 0 && len(req.Options) != len(productOptions) {
-			return nil, commonError.ErrValidation.WithMessagef(
-				"variant must specify all product options (%d required, %d provided)",
-				len(productOptions),
-				len(req.Options),
-			)
-		}
-
 		// Map options to IDs and build combination key
 		optionValueIDs, combinationKey, err := s.mapVariantOptionsToIDs(
 			req.Options,
@@ -342,6 +413,27 @@ func (s *VariantBulkServiceImpl) validateAndMapVariantOptions(
 			return nil, err
 		}
 
+		// Options whose dependency rules don't hold must be left out
+		err = validator.ValidateVariantOptionRules(rules, optionValueIDs, optionNames)
+		if err != nil {
+			return nil, err
+		}
+
+		// Validate all options that apply to this combination are provided
+		required := 0
+		for _, option := range productOptions {
+			if utils.OptionApplies(rules, option.ID, optionValueIDs) {
+				required++
+			}
+		}
+		if len(req.Options) != required {
+			return nil, commonError.ErrValidation.WithMessagef(
+				"variant must specify all product options (%d required, %d provided)",
+				required,
+				len(req.Options),
+			)
+		}
+
 		// Check for duplicate combinations
 		if combinationSet[combinationKey] {
 			return nil, prodErrors.ErrVariantCombinationExists
@@ -595,6 +687,241 @@ func (s *VariantBulkServiceImpl) convertOptionsResponseToEntities(
 	return productOptions
 }
 
+/***********************************************
+ *            GenerateVariants                 *
+ ***********************************************/
+// GenerateVariants creates the missing variants of the requested combinations, or of
+// every combination the option rules allow. Combinations that already have a variant
+// are skipped, so generating again after adding an option value fills in the gaps.
+// Placeholder variants of a product without option-derived variants are replaced.
+func (s *VariantBulkServiceImpl) GenerateVariants(
+	ctx context.Context,
+	productID uint,
+	sellerID uint,
+	request *model.GenerateVariantsRequest,
+) (*model.GenerateVariantsResponse, error) {
+	if request.All == (len(request.Combinations) > 0) {
+		return nil, prodErrors.ErrVariantGenerateSelection
+	}
+	if len(request.Combinations) > utils.VARIANT_GENERATE_MAX {
+		return nil, prodErrors.ErrVariantGenerateLimit.WithMessagef(
+			"at most %d variants can be generated per request", utils.VARIANT_GENERATE_MAX)
+	}
+
+	product, err := s.validatorService.GetAndValidateProductOwnershipNonPtr(
+		ctx, productID, sellerID)
+	if err != nil {
+		return nil, err
+	}
+	productOptions, err := s.fetchProductOptionsForValidation(ctx, productID, sellerID)
+	if err != nil {
+		return nil, err
+	}
+	if len(productOptions) == 0 {
+		return nil, prodErrors.ErrProductHasNoOptions
+	}
+	rules, err := s.optionService.GetOptionRules(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+
+	selections, err := s.resolveGenerateSelections(request, productOptions, rules)
+	if err != nil {
+		return nil, err
+	}
+	if len(selections) > utils.VARIANT_GENERATE_MAX {
+		return nil, prodErrors.ErrVariantGenerateLimit.WithMessagef(
+			"the options allow more than %d variants; generate them in batches of combinations",
+			utils.VARIANT_GENERATE_MAX,
+		)
+	}
+	skus, err := renderGeneratedSKUs(request.SKUPattern, product.BaseSKU, productOptions,
+		selections)
+	if err != nil {
+		return nil, err
+	}
+
+	var created []entity.ProductVariant
+	var createdSelections []map[uint]uint
+	err = db.WithTransaction(ctx, func(txCtx context.Context) error {
+		created, createdSelections, err = s.createMissingVariants(
+			txCtx, productID, request, selections, skus)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.GenerateVariantsResponse{
+		CreatedCount: len(created),
+		SkippedCount: len(selections) - len(created),
+		Variants: s.buildVariantDetailResponsesWithFactory(
+			created,
+			createdSelections,
+			productOptions,
+		),
+	}, nil
+}
+
+// resolveGenerateSelections expands or validates the requested combinations
+func (s *VariantBulkServiceImpl) resolveGenerateSelections(
+	request *model.GenerateVariantsRequest,
+	productOptions []entity.ProductOption,
+	rules []entity.ProductOptionRule,
+) ([]utils.OptionSelection, error) {
+	if request.All {
+		return utils.ExpandOptionCombinations(
+			productOptions, rules, utils.VARIANT_GENERATE_MAX), nil
+	}
+
+	requests := make([]model.CreateVariantRequest, len(request.Combinations))
+	for i, combination := range request.Combinations {
+		requests[i].Options = combination.Options
+	}
+	optionMap, optionValueMap := s.buildOptionLookupMaps(productOptions)
+	combinations, err := s.validateAndMapVariantOptions(
+		requests,
+		productOptions,
+		rules,
+		optionMap,
+		optionValueMap,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	selections := make([]utils.OptionSelection, len(combinations))
+	for i, combination := range combinations {
+		selections[i] = combination
+	}
+	return selections, nil
+}
+
+// renderGeneratedSKUs renders the SKU of each selection and rejects duplicates
+func renderGeneratedSKUs(
+	pattern string,
+	baseSKU string,
+	productOptions []entity.ProductOption,
+	selections []utils.OptionSelection,
+) ([]string, error) {
+	optionNames := make(map[uint]string, len(productOptions))
+	valueNames := make(map[uint]string)
+	for _, option := range productOptions {
+		optionNames[option.ID] = strings.ToLower(option.Name)
+		for _, value := range option.Values {
+			valueNames[value.ID] = value.Value
+		}
+	}
+
+	skus := make([]string, len(selections))
+	seen := make(map[string]bool, len(selections))
+	for i, selection := range selections {
+		values := make(map[string]string, len(optionNames))
+		for optionID, name := range optionNames {
+			values[name] = ""
+			if valueID, ok := selection[optionID]; ok {
+				values[name] = valueNames[valueID]
+			}
+		}
+		sku, err := utils.RenderVariantSKU(pattern, baseSKU, values)
+		if err != nil {
+			return nil, prodErrors.ErrVariantSKUPatternInvalid.WithMessage(err.Error())
+		}
+		if seen[sku] {
+			return nil, prodErrors.ErrVariantSKUPatternInvalid.WithMessagef(
+				"SKU pattern gives several variants the SKU %s; add a placeholder per option", sku)
+		}
+		seen[sku] = true
+		skus[i] = sku
+	}
+	return skus, nil
+}
+
+// createMissingVariants creates the selections the product has no variant for and links
+// their option values. The first one becomes the default when the product has no
+// option-derived default, replacing any placeholder variants.
+func (s *VariantBulkServiceImpl) createMissingVariants(
+	ctx context.Context,
+	productID uint,
+	request *model.GenerateVariantsRequest,
+	selections []utils.OptionSelection,
+	skus []string,
+) ([]entity.ProductVariant, []map[uint]uint, error) {
+	existing, err := s.variantRepo.GetProductVariantsWithOptions(ctx, productID)
+	if err != nil {
+		return nil, nil, err
+	}
+	existingKeys := make(map[string]bool, len(existing))
+	existingSKUs := make(map[string]bool, len(existing))
+	placeholders := make([]uint, 0)
+	hasDefault := false
+	for _, variant := range existing {
+		if len(variant.SelectedOptions) == 0 {
+			placeholders = append(placeholders, variant.Variant.ID)
+			continue
+		}
+		selection := make(utils.OptionSelection, len(variant.SelectedOptions))
+		for _, option := range variant.SelectedOptions {
+			selection[option.OptionID] = option.ValueID
+		}
+		existingKeys[utils.OptionSelectionKey(selection)] = true
+		existingSKUs[variant.Variant.SKU] = true
+		hasDefault = hasDefault || variant.Variant.IsDefault
+	}
+
+	variants := make([]*entity.ProductVariant, 0, len(selections))
+	combinations := make([]map[uint]uint, 0, len(selections))
+	for i, selection := range selections {
+		if existingKeys[utils.OptionSelectionKey(selection)] {
+			continue
+		}
+		if existingSKUs[skus[i]] {
+			return nil, nil, prodErrors.ErrVariantSKUExists.WithMessagef(
+				"SKU %s is already used by another variant of this product", skus[i])
+		}
+		variants = append(variants, factory.CreateVariantFromRequest(productID,
+			&model.CreateVariantRequest{
+				SKU:           skus[i],
+				Price:         request.Price,
+				AllowPurchase: request.AllowPurchase,
+			}))
+		combinations = append(combinations, selection)
+	}
+	if len(variants) == 0 {
+		return nil, nil, nil
+	}
+
+	for _, placeholderID := range placeholders {
+		if err := s.variantRepo.DeleteVariant(ctx, placeholderID); err != nil {
+			return nil, nil, err
+		}
+	}
+	if !hasDefault {
+		if err := s.variantRepo.UnsetAllDefaultVariantsForProduct(ctx, productID); err != nil {
+			return nil, nil, err
+		}
+		variants[0].IsDefault = true
+	}
+
+	if err := s.variantRepo.BulkCreateVariants(ctx, variants); err != nil {
+		return nil, nil, err
+	}
+	optionValues := make([]entity.VariantOptionValue, 0, len(variants))
+	for i, variant := range variants {
+		optionValues = append(optionValues,
+			factory.CreateVariantOptionValues(variant.ID, combinations[i])...)
+	}
+	if err := s.variantRepo.CreateVariantOptionValues(ctx, optionValues); err != nil {
+		return nil, nil, err
+	}
+
+	created := make([]entity.ProductVariant, len(variants))
+	for i, variant := range variants {
+		created[i] = *variant
+	}
+	return created, combinations, nil
+}
+
 /***********************************************
  *       DeleteVariantsByProductID             *
  ***********************************************/
@@ -618,6 +945,19 @@ func (s *VariantBulkServiceImpl) DeleteVariantsByProductID(ctx context.Context,
 		variantIDs[i] = v.ID
 	}
 
+	// Bundles of other products must drop these variants first. Bundles among them
+	// lose their components before the variants go, so the components can be deleted.
+	inBundle, err := s.bundleRepo.ExistsOutsideBundleUsage(ctx, variantIDs)
+	if err != nil {
+		return err
+	}
+	if inBundle {
+		return prodErrors.ErrVariantInBundle
+	}
+	if _, err := s.bundleRepo.DeleteByBundleVariantIDs(ctx, variantIDs); err != nil {
+		return err
+	}
+
 	// Transaction: Wrap both deletes to ensure atomicity
 	// If variant delete fails, option values won't be orphaned
 	if err := s.variantRepo.DeleteVariantOptionValuesByVariantIDs(ctx, variantIDs); err != nil {
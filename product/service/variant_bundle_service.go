@@ -0,0 +1,236 @@
+package service
+
+import (
+	"context"
+
+	"ecommerce-be/common/db"
+	"ecommerce-be/product/entity"
+	prodErrors "ecommerce-be/product/error"
+	"ecommerce-be/product/mapper"
+	"ecommerce-be/product/model"
+	"ecommerce-be/product/repository"
+	"ecommerce-be/product/utils"
+)
+
+// VariantBundleService manages bundle (kit) variants made up of other variants.
+// A bundle sells at its own price; stock is held and reserved on its components.
+type VariantBundleService interface {
+	// GetBundle returns a bundle variant's components and pricing
+	GetBundle(
+		ctx context.Context,
+		productID, variantID, sellerID uint,
+	) (*model.VariantBundleResponse, error)
+
+	// SetBundle replaces the components of a variant, making it a bundle
+	SetBundle(
+		ctx context.Context,
+		productID, variantID, sellerID uint,
+		req model.SetBundleComponentsRequest,
+	) (*model.VariantBundleResponse, error)
+
+	// DeleteBundle removes all components, turning the bundle back into a regular variant
+	DeleteBundle(ctx context.Context, productID, variantID, sellerID uint) error
+
+	// GetBundleComponents maps each bundle among variantIDs to its components. Variants
+	// that are not bundles are absent from the map.
+	// Used by inventory to derive bundle stock and reserve components.
+	GetBundleComponents(
+		ctx context.Context,
+		variantIDs []uint,
+	) (map[uint][]entity.VariantBundleComponent, error)
+}
+
+// VariantBundleServiceImpl implements VariantBundleService
+type VariantBundleServiceImpl struct {
+	bundleRepo       repository.VariantBundleRepository
+	variantRepo      repository.VariantRepository
+	validatorService ProductValidatorService
+}
+
+// NewVariantBundleService creates a new VariantBundleService
+func NewVariantBundleService(
+	bundleRepo repository.VariantBundleRepository,
+	variantRepo repository.VariantRepository,
+	validatorService ProductValidatorService,
+) VariantBundleService {
+	return &VariantBundleServiceImpl{
+		bundleRepo:       bundleRepo,
+		variantRepo:      variantRepo,
+		validatorService: validatorService,
+	}
+}
+
+// GetBundle returns a bundle variant's components and pricing
+func (s *VariantBundleServiceImpl) GetBundle(
+	ctx context.Context,
+	productID, variantID, sellerID uint,
+) (*model.VariantBundleResponse, error) {
+	productSellerID, err := s.validateBundleVariant(ctx, productID, variantID, sellerID)
+	if err != nil {
+		return nil, err
+	}
+
+	components, err := s.bundleRepo.FindByBundleVariantIDs(ctx, []uint{variantID})
+	if err != nil {
+		return nil, err
+	}
+	if len(components) == 0 {
+		return nil, prodErrors.ErrBundleNotFound
+	}
+	return s.buildBundleResponse(ctx, variantID, productSellerID, components)
+}
+
+// SetBundle replaces the components of a variant, making it a bundle. Components must
+// be other variants of the same seller and bundles do not nest.
+func (s *VariantBundleServiceImpl) SetBundle(
+	ctx context.Context,
+	productID, variantID, sellerID uint,
+	req model.SetBundleComponentsRequest,
+) (*model.VariantBundleResponse, error) {
+	productSellerID, err := s.validateBundleVariant(ctx, productID, variantID, sellerID)
+	if err != nil {
+		return nil, err
+	}
+
+	components := make([]entity.VariantBundleComponent, 0, len(req.Components))
+	componentIDs := make([]uint, 0, len(req.Components))
+	seen := make(map[uint]struct{}, len(req.Components))
+	for _, item := range req.Components {
+		if item.VariantID == variantID {
+			return nil, prodErrors.ErrBundleSelfReference
+		}
+		if _, dup := seen[item.VariantID]; dup {
+			return nil, prodErrors.ErrBundleComponentDuplicate
+		}
+		seen[item.VariantID] = struct{}{}
+		componentIDs = append(componentIDs, item.VariantID)
+		components = append(components, entity.VariantBundleComponent{
+			BundleVariantID:    variantID,
+			ComponentVariantID: item.VariantID,
+			Quantity:           item.Quantity,
+		})
+	}
+
+	// Components must belong to the bundle's seller
+	found, err := s.variantRepo.GetProductBasicInfoByVariantIDs(
+		ctx, componentIDs, &productSellerID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if len(found) != len(componentIDs) {
+		return nil, prodErrors.ErrBundleComponentInvalid
+	}
+
+	if err := db.WithTransaction(ctx, func(txCtx context.Context) error {
+		if err := s.validateNoNesting(txCtx, variantID, componentIDs); err != nil {
+			return err
+		}
+		return s.bundleRepo.ReplaceComponents(txCtx, variantID, components)
+	}); err != nil {
+		return nil, err
+	}
+
+	return s.buildBundleResponse(ctx, variantID, productSellerID, components)
+}
+
+// DeleteBundle removes all components, turning the bundle back into a regular variant
+func (s *VariantBundleServiceImpl) DeleteBundle(
+	ctx context.Context,
+	productID, variantID, sellerID uint,
+) error {
+	if _, err := s.validateBundleVariant(ctx, productID, variantID, sellerID); err != nil {
+		return err
+	}
+
+	deleted, err := s.bundleRepo.DeleteByBundleVariantIDs(ctx, []uint{variantID})
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return prodErrors.ErrBundleNotFound
+	}
+	return nil
+}
+
+// GetBundleComponents maps each bundle among variantIDs to its components
+func (s *VariantBundleServiceImpl) GetBundleComponents(
+	ctx context.Context,
+	variantIDs []uint,
+) (map[uint][]entity.VariantBundleComponent, error) {
+	components, err := s.bundleRepo.FindByBundleVariantIDs(ctx, variantIDs)
+	if err != nil {
+		return nil, err
+	}
+	return utils.GroupBundleComponents(components), nil
+}
+
+// validateBundleVariant checks the variant belongs to the product and the product to
+// the seller (any seller when sellerID is 0). Returns the product's seller ID.
+func (s *VariantBundleServiceImpl) validateBundleVariant(
+	ctx context.Context,
+	productID, variantID, sellerID uint,
+) (uint, error) {
+	product, err := s.validatorService.GetAndValidateProductOwnershipNonPtr(
+		ctx,
+		productID,
+		sellerID,
+	)
+	if err != nil {
+		return 0, err
+	}
+	if _, err := s.variantRepo.FindVariantByProductIDAndVariantID(
+		ctx, productID, variantID,
+	); err != nil {
+		return 0, err
+	}
+	return product.SellerID, nil
+}
+
+// validateNoNesting rejects components that are bundles themselves and bundles that
+// are a component of another bundle, keeping stock derivation one level deep
+func (s *VariantBundleServiceImpl) validateNoNesting(
+	ctx context.Context,
+	bundleVariantID uint,
+	componentIDs []uint,
+) error {
+	nested, err := s.bundleRepo.FindByBundleVariantIDs(ctx, componentIDs)
+	if err != nil {
+		return err
+	}
+	if len(nested) > 0 {
+		return prodErrors.ErrBundleNested
+	}
+
+	usedIn, err := s.bundleRepo.FindComponentVariantIDs(ctx, []uint{bundleVariantID})
+	if err != nil {
+		return err
+	}
+	if len(usedIn) > 0 {
+		return prodErrors.ErrBundleNested
+	}
+	return nil
+}
+
+func (s *VariantBundleServiceImpl) buildBundleResponse(
+	ctx context.Context,
+	bundleVariantID, sellerID uint,
+	components []entity.VariantBundleComponent,
+) (*model.VariantBundleResponse, error) {
+	variantIDs := make([]uint, 0, len(components)+1)
+	variantIDs = append(variantIDs, bundleVariantID)
+	for _, component := range components {
+		variantIDs = append(variantIDs, component.ComponentVariantID)
+	}
+	rows, err := s.variantRepo.GetProductBasicInfoByVariantIDs(ctx, variantIDs, &sellerID)
+	if err != nil {
+		return nil, err
+	}
+
+	variants := make(map[uint]mapper.VariantBasicInfoRow, len(rows))
+	for _, row := range rows {
+		variants[row.VariantID] = row
+	}
+	response := utils.BuildBundleResponse(variants[bundleVariantID], components, variants)
+	return &response, nil
+}
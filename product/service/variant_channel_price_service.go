@@ -0,0 +1,222 @@
+package service
+
+import (
+	"context"
+
+	"ecommerce-be/common/db"
+	"ecommerce-be/product/entity"
+	prodErrors "ecommerce-be/product/error"
+	"ecommerce-be/product/model"
+	"ecommerce-be/product/repository"
+	"ecommerce-be/product/utils"
+	"ecommerce-be/product/validator"
+)
+
+// VariantChannelPriceService manages per-sales-channel price overrides for variants
+type VariantChannelPriceService interface {
+	// GetProductChannelPrices lists base prices and channel overrides for every variant of a product
+	GetProductChannelPrices(
+		ctx context.Context,
+		productID, sellerID uint,
+	) ([]model.VariantChannelPricesResponse, error)
+
+	// UpsertChannelPrices creates or replaces overrides in bulk (all-or-nothing)
+	UpsertChannelPrices(
+		ctx context.Context,
+		productID, sellerID uint,
+		req model.UpsertChannelPricesRequest,
+	) ([]model.VariantChannelPricesResponse, error)
+
+	// DeleteChannelPrices removes overrides in bulk, reverting those variants to base price
+	DeleteChannelPrices(
+		ctx context.Context,
+		productID, sellerID uint,
+		req model.DeleteChannelPricesRequest,
+	) (int64, error)
+
+	// ResolveVariantPrices applies channel overrides to already-built variant responses.
+	// A blank channel leaves base prices untouched.
+	ResolveVariantPrices(
+		ctx context.Context,
+		channel entity.SalesChannel,
+		variants []model.VariantDetailResponse,
+	) error
+}
+
+// VariantChannelPriceServiceImpl implements VariantChannelPriceService
+type VariantChannelPriceServiceImpl struct {
+	channelPriceRepo repository.VariantChannelPriceRepository
+	variantRepo      repository.VariantRepository
+	validatorService ProductValidatorService
+}
+
+// NewVariantChannelPriceService creates a new VariantChannelPriceService
+func NewVariantChannelPriceService(
+	channelPriceRepo repository.VariantChannelPriceRepository,
+	variantRepo repository.VariantRepository,
+	validatorService ProductValidatorService,
+) VariantChannelPriceService {
+	return &VariantChannelPriceServiceImpl{
+		channelPriceRepo: channelPriceRepo,
+		variantRepo:      variantRepo,
+		validatorService: validatorService,
+	}
+}
+
+// GetProductChannelPrices lists base prices and channel overrides for every variant of a product
+func (s *VariantChannelPriceServiceImpl) GetProductChannelPrices(
+	ctx context.Context,
+	productID, sellerID uint,
+) ([]model.VariantChannelPricesResponse, error) {
+	variants, err := s.loadProductVariants(ctx, productID, sellerID)
+	if err != nil {
+		return nil, err
+	}
+	return s.buildChannelPricesResponse(ctx, variants)
+}
+
+// UpsertChannelPrices creates or replaces overrides in bulk (all-or-nothing)
+func (s *VariantChannelPriceServiceImpl) UpsertChannelPrices(
+	ctx context.Context,
+	productID, sellerID uint,
+	req model.UpsertChannelPricesRequest,
+) ([]model.VariantChannelPricesResponse, error) {
+	variants, err := s.loadProductVariants(ctx, productID, sellerID)
+	if err != nil {
+		return nil, err
+	}
+
+	prices := make([]entity.VariantChannelPrice, 0, len(req.Prices))
+	for _, item := range req.Prices {
+		prices = append(prices, entity.VariantChannelPrice{
+			VariantID: item.VariantID,
+			Channel:   entity.NormalizeSalesChannel(item.Channel),
+			Price:     item.Price,
+		})
+	}
+	if err := validateChannelPriceKeys(prices, variants); err != nil {
+		return nil, err
+	}
+
+	if err := db.WithTransaction(ctx, func(txCtx context.Context) error {
+		return s.channelPriceRepo.UpsertBatch(txCtx, prices)
+	}); err != nil {
+		return nil, err
+	}
+
+	return s.buildChannelPricesResponse(ctx, variants)
+}
+
+// DeleteChannelPrices removes overrides in bulk, reverting those variants to base price
+func (s *VariantChannelPriceServiceImpl) DeleteChannelPrices(
+	ctx context.Context,
+	productID, sellerID uint,
+	req model.DeleteChannelPricesRequest,
+) (int64, error) {
+	variants, err := s.loadProductVariants(ctx, productID, sellerID)
+	if err != nil {
+		return 0, err
+	}
+
+	keys := make([]entity.VariantChannelPrice, 0, len(req.Prices))
+	for _, item := range req.Prices {
+		keys = append(keys, entity.VariantChannelPrice{
+			VariantID: item.VariantID,
+			Channel:   entity.NormalizeSalesChannel(item.Channel),
+		})
+	}
+	if err := validateChannelPriceKeys(keys, variants); err != nil {
+		return 0, err
+	}
+
+	return s.channelPriceRepo.DeleteByVariantChannels(ctx, keys)
+}
+
+// ResolveVariantPrices applies channel overrides to already-built variant responses
+func (s *VariantChannelPriceServiceImpl) ResolveVariantPrices(
+	ctx context.Context,
+	channel entity.SalesChannel,
+	variants []model.VariantDetailResponse,
+) error {
+	if channel == "" || len(variants) == 0 {
+		return nil
+	}
+
+	variantIDs := make([]uint, len(variants))
+	for i, v := range variants {
+		variantIDs[i] = v.ID
+	}
+	prices, err := s.channelPriceRepo.FindByVariantIDsAndChannel(ctx, variantIDs, channel)
+	if err != nil {
+		return err
+	}
+
+	overrides := make(map[uint]float64, len(prices))
+	for _, p := range prices {
+		overrides[p.VariantID] = p.Price
+	}
+	utils.ApplyChannelPrices(variants, channel, overrides)
+	return nil
+}
+
+// loadProductVariants validates seller ownership and returns the product's variants
+func (s *VariantChannelPriceServiceImpl) loadProductVariants(
+	ctx context.Context,
+	productID, sellerID uint,
+) ([]entity.ProductVariant, error) {
+	if _, err := s.validatorService.GetAndValidateProductOwnershipNonPtr(
+		ctx,
+		productID,
+		sellerID,
+	); err != nil {
+		return nil, err
+	}
+	return s.variantRepo.FindVariantsByProductID(ctx, productID)
+}
+
+func (s *VariantChannelPriceServiceImpl) buildChannelPricesResponse(
+	ctx context.Context,
+	variants []entity.ProductVariant,
+) ([]model.VariantChannelPricesResponse, error) {
+	variantIDs := make([]uint, len(variants))
+	for i, v := range variants {
+		variantIDs[i] = v.ID
+	}
+	prices, err := s.channelPriceRepo.FindByVariantIDs(ctx, variantIDs)
+	if err != nil {
+		return nil, err
+	}
+	return utils.GroupChannelPrices(variants, prices), nil
+}
+
+// validateChannelPriceKeys rejects unsupported channels, repeated (variant, channel)
+// pairs and variants that do not belong to the product
+func validateChannelPriceKeys(
+	keys []entity.VariantChannelPrice,
+	variants []entity.ProductVariant,
+) error {
+	owned := make(map[uint]struct{}, len(variants))
+	for _, v := range variants {
+		owned[v.ID] = struct{}{}
+	}
+
+	type pairKey struct {
+		variantID uint
+		channel   entity.SalesChannel
+	}
+	seen := make(map[pairKey]struct{}, len(keys))
+	for _, k := range keys {
+		if _, err := validator.ValidateRequiredSalesChannel(k.Channel.String()); err != nil {
+			return err
+		}
+		if _, ok := owned[k.VariantID]; !ok {
+			return prodErrors.ErrChannelPriceVariantInvalid
+		}
+		key := pairKey{variantID: k.VariantID, channel: k.Channel}
+		if _, dup := seen[key]; dup {
+			return prodErrors.ErrChannelPriceDuplicate
+		}
+		seen[key] = struct{}{}
+	}
+	return nil
+}
@@ -2,7 +2,9 @@ package service
 
 import (
 	"context"
+	"strings"
 
+	"ecommerce-be/common/db"
 	"ecommerce-be/common/log"
 	"ecommerce-be/product/entity"
 	productError "ecommerce-be/product/error"
@@ -33,8 +35,8 @@ type VariantMediaService interface {
 		req model.AttachVariantMediaRequest,
 	) (*model.VariantMediaResponse, error)
 
-	// UpdateMediaMetadata patches the primary flag and/or display order of an
-	// existing variant-media link.
+	// UpdateMediaMetadata patches the primary flag, display order and/or alt text
+	// of an existing variant-media link.
 	UpdateMediaMetadata(
 		ctx context.Context,
 		variantID uint,
@@ -44,6 +46,16 @@ type VariantMediaService interface {
 		req model.UpdateVariantMediaMetadataRequest,
 	) (*model.VariantMediaResponse, error)
 
+	// ReorderMedia sets the display order of all media of a variant at once. The
+	// request must list every attached file exactly once.
+	ReorderMedia(
+		ctx context.Context,
+		variantID uint,
+		productID uint,
+		sellerID uint,
+		req model.ReorderVariantMediaRequest,
+	) ([]model.VariantMediaResponse, error)
+
 	// RemoveMedia unlinks a file from a variant, promotes a fallback primary
 	// when needed, and attempts best-effort deletion of the underlying file
 	// asset. Cleanup failures are logged but never propagated.
@@ -124,6 +136,7 @@ func (s *variantMediaService) GetMediaForVariants(
 			IsPrimary:    row.IsPrimary,
 			DisplayOrder: row.DisplayOrder,
 			ThumbnailURL: fi.ThumbnailURL,
+			AltText:      row.AltText,
 		}
 		result[row.VariantID] = append(result[row.VariantID], item)
 	}
@@ -183,6 +196,7 @@ func (s *variantMediaService) AttachMedia(
 		FileID:       req.FileID,
 		IsPrimary:    req.IsPrimary,
 		DisplayOrder: req.DisplayOrder,
+		AltText:      trimmedAltText(req.AltText),
 	}
 	if err := s.mediaRepo.Create(ctx, media); err != nil {
 		return nil, err
@@ -194,6 +208,7 @@ func (s *variantMediaService) AttachMedia(
 		IsPrimary:    media.IsPrimary,
 		DisplayOrder: media.DisplayOrder,
 		ThumbnailURL: fileInfo.ThumbnailURL,
+		AltText:      media.AltText,
 	}, nil
 }
 
@@ -235,7 +250,16 @@ func (s *variantMediaService) UpdateMediaMetadata(
 		}
 	}
 
-	if err := s.mediaRepo.UpdateMetadata(ctx, existing.ID, req.IsPrimary, req.DisplayOrder); err != nil {
+	// An empty alt text clears it, so only trim here.
+	var altText *string
+	if req.AltText != nil {
+		trimmed := strings.TrimSpace(*req.AltText)
+		altText = &trimmed
+	}
+
+	if err := s.mediaRepo.UpdateMetadata(
+		ctx, existing.ID, req.IsPrimary, req.DisplayOrder, altText,
+	); err != nil {
 		return nil, err
 	}
 
@@ -250,6 +274,7 @@ func (s *variantMediaService) UpdateMediaMetadata(
 		URL:          "",
 		IsPrimary:    updated.IsPrimary,
 		DisplayOrder: updated.DisplayOrder,
+		AltText:      updated.AltText,
 	}
 
 	// Best-effort URL enrichment.
@@ -261,6 +286,61 @@ func (s *variantMediaService) UpdateMediaMetadata(
 	return resp, nil
 }
 
+// ─── Reorder media ────────────────────────────────────────────────────────────
+
+func (s *variantMediaService) ReorderMedia(
+	ctx context.Context,
+	variantID uint,
+	productID uint,
+	sellerID uint,
+	req model.ReorderVariantMediaRequest,
+) ([]model.VariantMediaResponse, error) {
+	// Verify product ownership.
+	product, err := s.productRepo.FindByID(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+	if product.SellerID != sellerID {
+		return nil, productError.ErrProductNotFound
+	}
+
+	// Verify the variant belongs to this product.
+	_, err = s.variantRepo.FindVariantByProductIDAndVariantID(ctx, productID, variantID)
+	if err != nil {
+		return nil, err
+	}
+
+	// The new order must be a permutation of the attached files.
+	rows, err := s.mediaRepo.FindByVariantIDs(ctx, []uint{variantID})
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) != len(req.FileIDs) {
+		return nil, productError.ErrVariantMediaOrderMismatch
+	}
+	attached := make(map[string]struct{}, len(rows))
+	for _, row := range rows {
+		attached[row.FileID] = struct{}{}
+	}
+	for _, fileID := range req.FileIDs {
+		if _, ok := attached[fileID]; !ok {
+			return nil, productError.ErrVariantMediaOrderMismatch
+		}
+	}
+
+	if err := db.WithTransaction(ctx, func(txCtx context.Context) error {
+		return s.mediaRepo.UpdateDisplayOrders(txCtx, variantID, req.FileIDs)
+	}); err != nil {
+		return nil, err
+	}
+
+	media, err := s.GetMediaForVariants(ctx, []uint{variantID}, &sellerID)
+	if err != nil {
+		return nil, err
+	}
+	return media[variantID], nil
+}
+
 // ─── Remove media ─────────────────────────────────────────────────────────────
 
 func (s *variantMediaService) RemoveMedia(
@@ -323,3 +403,15 @@ func (s *variantMediaService) RemoveMedia(
 
 	return nil
 }
+
+// trimmedAltText trims alt text and maps an empty result to nil
+func trimmedAltText(altText *string) *string {
+	if altText == nil {
+		return nil
+	}
+	trimmed := strings.TrimSpace(*altText)
+	if trimmed == "" {
+		return nil
+	}
+	return &trimmed
+}
@@ -2,12 +2,17 @@ package service
 
 import (
 	"context"
+	"maps"
+	"strings"
 
+	commonValidator "ecommerce-be/common/validator"
 	"ecommerce-be/product/entity"
+	prodErrors "ecommerce-be/product/error"
 	"ecommerce-be/product/factory"
 	"ecommerce-be/product/mapper"
 	"ecommerce-be/product/model"
 	"ecommerce-be/product/repository"
+	"ecommerce-be/product/utils"
 	"ecommerce-be/product/validator"
 )
 
@@ -15,21 +20,35 @@ import (
 type VariantQueryService interface {
 	// GetVariantByID retrieves detailed information about a specific variant
 	// If userID is provided, also checks if the variant is wishlisted by that user
+	// If channel is provided, price is resolved as channel override -> base price
 	GetVariantByID(
 		ctx context.Context,
 		productID,
 		variantID uint,
 		sellerID uint,
 		userID *uint,
+		channel string,
+	) (*model.VariantDetailResponse, error)
+
+	// LookupVariantByBarcode finds the seller's variant with an EAN/UPC/GTIN barcode,
+	// e.g. for POS scanning. A UPC-A also finds the variant stored under its EAN-13.
+	// If channel is provided, price is resolved as channel override -> base price
+	LookupVariantByBarcode(
+		ctx context.Context,
+		sellerID uint,
+		barcode string,
+		channel string,
 	) (*model.VariantDetailResponse, error)
 
 	// FindVariantByOptions finds a variant based on selected options
+	// If channel is provided, price is resolved as channel override -> base price
 	FindVariantByOptions(
 		ctx context.Context,
 		productID uint,
 		optionValues map[string]string,
 		sellerID *uint,
 		userID *uint,
+		channel string,
 	) (*model.VariantResponse, error)
 
 	// GetProductVariantsWithOptions retrieves all variants with their selected option values
@@ -41,6 +60,15 @@ type VariantQueryService interface {
 		sellerID *uint,
 	) ([]model.VariantDetailResponse, error)
 
+	// GetProductsVariantsWithOptions is the batch form of GetProductVariantsWithOptions,
+	// keyed by product ID. mediaSellerIDs gives the seller whose scope resolves each
+	// product's variant media.
+	GetProductsVariantsWithOptions(
+		ctx context.Context,
+		productIDs []uint,
+		mediaSellerIDs map[uint]uint,
+	) (map[uint][]model.VariantDetailResponse, error)
+
 	// GetProductVariantAggregation retrieves aggregated variant data for a single product
 	// If userID is provided, also checks if any variant is wishlisted by that user
 	GetProductVariantAggregation(
@@ -61,6 +89,7 @@ type VariantQueryService interface {
 	// ListVariants lists variants with comprehensive filtering support
 	// Used for: home page recommendations, search results, filtered listings
 	// If userID is provided, also checks wishlist status for each variant
+	// If request.Channel is set, prices are resolved for that sales channel (cart/order pricing)
 	ListVariants(
 		ctx context.Context,
 		request *model.ListVariantsRequest,
@@ -86,6 +115,14 @@ type VariantQueryService interface {
 		variantIDs []uint,
 		sellerID *uint,
 	) ([]mapper.VariantBasicInfoRow, error)
+
+	// GetVariantBasicInfoBySKUs retrieves basic product info for variant SKUs
+	// Used by order module to match marketplace order lines to catalogue variants
+	GetVariantBasicInfoBySKUs(
+		ctx context.Context,
+		skus []string,
+		sellerID *uint,
+	) ([]mapper.VariantBasicInfoRow, error)
 }
 
 // VariantQueryServiceImpl implements the VariantQueryService interface
@@ -95,6 +132,7 @@ type VariantQueryServiceImpl struct {
 	optionService       ProductOptionService
 	validatorService    ProductValidatorService
 	variantMediaService VariantMediaService
+	channelPriceService VariantChannelPriceService
 }
 
 // NewVariantQueryService creates a new instance of VariantQueryService
@@ -104,6 +142,7 @@ func NewVariantQueryService(
 	optionService ProductOptionService,
 	validatorService ProductValidatorService,
 	variantMediaService VariantMediaService,
+	channelPriceService VariantChannelPriceService,
 ) VariantQueryService {
 	return &VariantQueryServiceImpl{
 		variantRepo:         variantRepo,
@@ -111,6 +150,7 @@ func NewVariantQueryService(
 		optionService:       optionService,
 		validatorService:    validatorService,
 		variantMediaService: variantMediaService,
+		channelPriceService: channelPriceService,
 	}
 }
 
@@ -121,7 +161,13 @@ func (s *VariantQueryServiceImpl) GetVariantByID(
 	productID, variantID uint,
 	sellerID uint,
 	userID *uint,
+	channel string,
 ) (*model.VariantDetailResponse, error) {
+	salesChannel, err := validator.ParseSalesChannel(channel)
+	if err != nil {
+		return nil, err
+	}
+
 	// Get product and validate seller access using validator service
 	product, err := s.validatorService.GetAndValidateProductOwnershipNonPtr(
 		ctx,
@@ -144,6 +190,13 @@ func (s *VariantQueryServiceImpl) GetVariantByID(
 		return nil, err
 	}
 
+	// Resolve channel price (channel override -> base price)
+	resolved := []model.VariantDetailResponse{*response}
+	if err := s.channelPriceService.ResolveVariantPrices(ctx, salesChannel, resolved); err != nil {
+		return nil, err
+	}
+	response = &resolved[0]
+
 	// Enrich with media (best-effort: never fails the variant response).
 	mediaSellerID := sellerID
 	if mediaSellerID == 0 {
@@ -153,7 +206,7 @@ func (s *VariantQueryServiceImpl) GetVariantByID(
 		ctx, []uint{variantID}, &mediaSellerID,
 	); mErr == nil {
 		if items, ok := mediaMap[variantID]; ok {
-			response.Media = items
+			response.SetMedia(items)
 		}
 	}
 
@@ -170,6 +223,25 @@ func (s *VariantQueryServiceImpl) GetVariantByID(
 	return response, nil
 }
 
+// LookupVariantByBarcode finds the seller's variant with an EAN/UPC/GTIN barcode
+func (s *VariantQueryServiceImpl) LookupVariantByBarcode(
+	ctx context.Context,
+	sellerID uint,
+	barcode string,
+	channel string,
+) (*model.VariantDetailResponse, error) {
+	barcode = strings.TrimSpace(barcode)
+	if !commonValidator.IsBarcode(barcode) {
+		return nil, prodErrors.ErrInvalidBarcode
+	}
+	variant, err := s.variantRepo.FindVariantByBarcode(
+		ctx, sellerID, utils.NormalizeBarcode(barcode))
+	if err != nil {
+		return nil, err
+	}
+	return s.GetVariantByID(ctx, variant.ProductID, variant.ID, sellerID, nil, channel)
+}
+
 // FindVariantByOptions finds a variant based on selected options
 func (s *VariantQueryServiceImpl) FindVariantByOptions(
 	ctx context.Context,
@@ -177,9 +249,15 @@ func (s *VariantQueryServiceImpl) FindVariantByOptions(
 	optionValues map[string]string,
 	sellerID *uint,
 	userID *uint,
+	channel string,
 ) (*model.VariantResponse, error) {
+	salesChannel, err := validator.ParseSalesChannel(channel)
+	if err != nil {
+		return nil, err
+	}
+
 	// Validate seller access FIRST (security priority)
-	_, err := s.validatorService.GetAndValidateProductOwnership(ctx, productID, sellerID)
+	_, err = s.validatorService.GetAndValidateProductOwnership(ctx, productID, sellerID)
 	if err != nil {
 		return nil, err
 	}
@@ -216,6 +294,15 @@ func (s *VariantQueryServiceImpl) FindVariantByOptions(
 	// Build response
 	response := factory.BuildVariantResponse(variant, selectedOptions)
 
+	// Resolve channel price (channel override -> base price)
+	resolved := []model.VariantDetailResponse{{ID: response.ID, Price: response.Price}}
+	if err := s.channelPriceService.ResolveVariantPrices(ctx, salesChannel, resolved); err != nil {
+		return nil, err
+	}
+	response.Price = resolved[0].Price
+	response.BasePrice = resolved[0].BasePrice
+	response.Channel = resolved[0].Channel
+
 	// Check wishlist status if user is logged in
 	if userID != nil {
 		isWishlisted, err := s.wishlistItemService.IsVariantInUserWishlist(ctx, variant.ID, *userID)
@@ -255,7 +342,7 @@ func (s *VariantQueryServiceImpl) GetProductVariantsWithOptions(
 	if mediaMap, mErr := s.variantMediaService.GetMediaForVariants(ctx, variantIDs, sellerID); mErr == nil {
 		for i := range responses {
 			if items, ok := mediaMap[responses[i].ID]; ok {
-				responses[i].Media = items
+				responses[i].SetMedia(items)
 			}
 		}
 	}
@@ -263,6 +350,54 @@ func (s *VariantQueryServiceImpl) GetProductVariantsWithOptions(
 	return responses, nil
 }
 
+// GetProductsVariantsWithOptions retrieves the variants of several products with their
+// selected option values and media. Variants are loaded in one pass, and media in one
+// batch per seller scope.
+func (s *VariantQueryServiceImpl) GetProductsVariantsWithOptions(
+	ctx context.Context,
+	productIDs []uint,
+	mediaSellerIDs map[uint]uint,
+) (map[uint][]model.VariantDetailResponse, error) {
+	variantsByProduct, err := s.variantRepo.GetProductsVariantsWithOptions(ctx, productIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[uint][]model.VariantDetailResponse, len(productIDs))
+	variantIDsBySeller := make(map[uint][]uint)
+	for _, productID := range productIDs {
+		if _, seen := result[productID]; seen {
+			continue
+		}
+		responses := factory.BuildVariantsDetailResponseFromMapper(variantsByProduct[productID])
+		result[productID] = responses
+
+		sellerID := mediaSellerIDs[productID]
+		for _, v := range responses {
+			variantIDsBySeller[sellerID] = append(variantIDsBySeller[sellerID], v.ID)
+		}
+	}
+
+	// Batch-enrich with media, one lookup per seller scope
+	mediaByVariant := make(map[uint][]model.VariantMediaResponse)
+	for sellerID, variantIDs := range variantIDsBySeller {
+		mediaMap, mErr := s.variantMediaService.GetMediaForVariants(ctx, variantIDs, &sellerID)
+		if mErr != nil {
+			continue
+		}
+		maps.Copy(mediaByVariant, mediaMap)
+	}
+	for _, responses := range result {
+		for i := range responses {
+			if items, ok := mediaByVariant[responses[i].ID]; ok {
+				responses[i].SetMedia(items)
+			}
+		}
+	}
+
+	return result, nil
+}
+
 // GetProductVariantAggregation retrieves aggregated variant data for a single product
 // Returns summary information about all variants for a product
 // If userID is provided, also checks if any variant is wishlisted by that user
@@ -328,6 +463,11 @@ func (s *VariantQueryServiceImpl) ListVariants(
 	optionFilters map[string]string,
 	userID *uint,
 ) (*model.ListVariantsResponse, error) {
+	salesChannel, err := validator.ParseSalesChannel(request.Channel)
+	if err != nil {
+		return nil, err
+	}
+
 	// Set default pagination if not provided
 	if request.Page == 0 {
 		request.Page = 1
@@ -358,6 +498,15 @@ func (s *VariantQueryServiceImpl) ListVariants(
 	// Build response using factory method (reduces code duplication)
 	variantResponses := factory.BuildVariantsDetailResponseFromMapper(variantsWithOptions)
 
+	// Resolve channel prices (channel override -> base price)
+	if err := s.channelPriceService.ResolveVariantPrices(
+		ctx,
+		salesChannel,
+		variantResponses,
+	); err != nil {
+		return nil, err
+	}
+
 	// Check wishlist status for each variant if user is logged in
 	if userID != nil && len(variantResponses) > 0 {
 		// Collect variant IDs for batch wishlist check
@@ -385,7 +534,7 @@ func (s *VariantQueryServiceImpl) ListVariants(
 		if mediaMap, mErr := s.variantMediaService.GetMediaForVariants(ctx, variantIDs, sellerID); mErr == nil {
 			for i := range variantResponses {
 				if items, ok := mediaMap[variantResponses[i].ID]; ok {
-					variantResponses[i].Media = items
+					variantResponses[i].SetMedia(items)
 				}
 			}
 		}
@@ -420,3 +569,12 @@ func (s *VariantQueryServiceImpl) GetProductBasicInfoByVariantIDs(
 ) ([]mapper.VariantBasicInfoRow, error) {
 	return s.variantRepo.GetProductBasicInfoByVariantIDs(ctx, variantIDs, sellerID)
 }
+
+// GetVariantBasicInfoBySKUs retrieves basic product info for variant SKUs
+func (s *VariantQueryServiceImpl) GetVariantBasicInfoBySKUs(
+	ctx context.Context,
+	skus []string,
+	sellerID *uint,
+) ([]mapper.VariantBasicInfoRow, error) {
+	return s.variantRepo.GetVariantBasicInfoBySKUs(ctx, skus, sellerID)
+}
@@ -10,6 +10,7 @@ import (
 	"ecommerce-be/product/factory"
 	"ecommerce-be/product/model"
 	"ecommerce-be/product/repository"
+	"ecommerce-be/product/utils"
 	"ecommerce-be/product/validator"
 )
 
@@ -41,6 +42,8 @@ type VariantServiceImpl struct {
 	optionService    ProductOptionService
 	validatorService ProductValidatorService
 	queryService     VariantQueryService
+	bundleRepo       repository.VariantBundleRepository
+	skuGenerator     SKUGeneratorService
 }
 
 // NewVariantService creates a new instance of VariantService
@@ -49,12 +52,16 @@ func NewVariantService(
 	optionService ProductOptionService,
 	validatorService ProductValidatorService,
 	queryService VariantQueryService,
+	bundleRepo repository.VariantBundleRepository,
+	skuGenerator SKUGeneratorService,
 ) VariantService {
 	return &VariantServiceImpl{
 		variantRepo:      variantRepo,
 		optionService:    optionService,
 		validatorService: validatorService,
 		queryService:     queryService,
+		bundleRepo:       bundleRepo,
+		skuGenerator:     skuGenerator,
 	}
 }
 
@@ -99,8 +106,36 @@ func (s *VariantServiceImpl) CreateVariant(
 		return nil, err
 	}
 
-	// Create variant entity using factory
+	// Reject combinations the product's option rules make impossible
+	rules, err := s.optionService.GetOptionRules(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+	optionNames := make(map[uint]string, len(optionsResponse.Options))
+	for _, opt := range optionsResponse.Options {
+		optionNames[opt.OptionID] = opt.OptionName
+	}
+	err = validator.ValidateVariantOptionRules(rules, optionValueIDs, optionNames)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ensureBarcodesAvailable(
+		ctx, s.variantRepo, product.SellerID, []string{request.Barcode}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create variant entity using factory; a missing SKU comes from the seller's pattern
 	variant := factory.CreateVariantFromRequest(productID, request)
+	if variant.SKU == "" {
+		skus, err := s.skuGenerator.GenerateVariantSKUs(
+			ctx, product, [][]string{variantOptionValues(request.Options)})
+		if err != nil {
+			return nil, err
+		}
+		variant.SKU = skus[0]
+	}
 
 	// Store variant option values for response mapping
 	var variantOptionValues []entity.VariantOptionValue
@@ -174,14 +209,26 @@ func (s *VariantServiceImpl) UpdateVariant(
 	if err != nil {
 		return nil, err
 	}
+	if err := validator.ValidateVariantVersion(variant, request.ExpectedVersion); err != nil {
+		return nil, err
+	}
+	if request.Barcode != nil {
+		err = ensureBarcodesAvailable(
+			ctx, s.variantRepo, product.SellerID, []string{*request.Barcode}, []uint{variantID})
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	// Transaction with race condition prevention:
 	// Wrap default variant logic and update in single transaction for atomicity
 	err = db.WithTransaction(ctx, func(txCtx context.Context) error {
 		// Handle default variant logic INSIDE transaction
 		// This prevents race condition where two concurrent updates both set isDefault=true
+		// The variant itself is left out so its update still matches the version read
 		if request.IsDefault != nil && *request.IsDefault {
-			if err := s.variantRepo.UnsetAllDefaultVariantsForProduct(txCtx, productID); err != nil {
+			err := s.variantRepo.UnsetAllDefaultVariantsForProduct(txCtx, productID, variantID)
+			if err != nil {
 				return err
 			}
 		}
@@ -239,6 +286,15 @@ func (s *VariantServiceImpl) DeleteVariant(
 			return err
 		}
 
+		// Bundles must drop the variant before it can go
+		inBundle, err := s.bundleRepo.ExistsOutsideBundleUsage(txCtx, []uint{variantID})
+		if err != nil {
+			return err
+		}
+		if inBundle {
+			return prodErrors.ErrVariantInBundle
+		}
+
 		// Delete variant option values first (foreign key constraint)
 		if err := s.variantRepo.DeleteVariantOptionValues(txCtx, variantID); err != nil {
 			return err
@@ -355,7 +411,8 @@ func (s *VariantServiceImpl) replacePlaceholderVariants(
 	requestIsDefault := request.IsDefault != nil && *request.IsDefault
 
 	if requestIsDefault {
-		if err := s.variantRepo.UnsetAllDefaultVariantsForProduct(ctx, productID); err != nil {
+		err := s.variantRepo.UnsetAllDefaultVariantsForProduct(ctx, productID, newVariant.ID)
+		if err != nil {
 			return err
 		}
 		newVariant.IsDefault = true
@@ -439,3 +496,39 @@ func (s *VariantServiceImpl) validateAndMapVariantOptions(
 
 	return optionValueIDs, optionsMap, nil
 }
+
+// ensureBarcodesAvailable rejects barcodes given twice in one request or already used
+// by another of the seller's variants. A UPC-A and its EAN-13 count as the same code.
+// The variants in excludeVariantIDs are being given new barcodes, so theirs are ignored.
+func ensureBarcodesAvailable(
+	ctx context.Context,
+	variantRepo repository.VariantRepository,
+	sellerID uint,
+	barcodes []string,
+	excludeVariantIDs []uint,
+) error {
+	gtins := make([]string, 0, len(barcodes))
+	seen := make(map[string]bool, len(barcodes))
+	for _, barcode := range barcodes {
+		if barcode == "" {
+			continue
+		}
+		gtin := utils.NormalizeBarcode(barcode)
+		if seen[gtin] {
+			return prodErrors.ErrVariantBarcodeExists.WithMessagef(
+				"barcode %s is given to more than one variant", barcode)
+		}
+		seen[gtin] = true
+		gtins = append(gtins, gtin)
+	}
+
+	used, err := variantRepo.FindUsedBarcodes(ctx, sellerID, gtins, excludeVariantIDs)
+	if err != nil {
+		return err
+	}
+	if len(used) > 0 {
+		return prodErrors.ErrVariantBarcodeExists.WithMessagef(
+			"barcode %s is already used by another variant", used[0])
+	}
+	return nil
+}
@@ -0,0 +1,13 @@
+package utils
+
+import "strings"
+
+// NormalizeBarcode zero-pads a barcode to GTIN-14 so a UPC-A and the EAN-13 of the
+// same item compare equal. It matches the product_variant.gtin column.
+func NormalizeBarcode(barcode string) string {
+	barcode = strings.TrimSpace(barcode)
+	if len(barcode) >= BARCODE_GTIN_LENGTH {
+		return barcode
+	}
+	return strings.Repeat("0", BARCODE_GTIN_LENGTH-len(barcode)) + barcode
+}
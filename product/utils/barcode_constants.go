@@ -0,0 +1,25 @@
+package utils
+
+// Barcode routes (relative to /api/product)
+const (
+	BARCODE_LOOKUP_ROUTE = "/lookup"
+	BARCODE_QUERY_PARAM  = "barcode"
+)
+
+// BARCODE_GTIN_LENGTH is the GTIN-14 length barcodes are normalized to for matching
+const BARCODE_GTIN_LENGTH = 14
+
+// Barcode error codes
+const (
+	INVALID_BARCODE_CODE        = "INVALID_BARCODE"
+	VARIANT_BARCODE_EXISTS_CODE = "VARIANT_BARCODE_EXISTS"
+)
+
+// Barcode messages
+const (
+	INVALID_BARCODE_MSG        = "Barcode must be an EAN, UPC or GTIN with a valid check digit"
+	VARIANT_BARCODE_EXISTS_MSG = "Another variant already uses this barcode"
+
+	VARIANT_FOUND_BY_BARCODE_MSG = "Variant found successfully"
+	FAILED_TO_LOOKUP_BARCODE_MSG = "Failed to look up barcode"
+)
@@ -0,0 +1,60 @@
+package utils
+
+import (
+	"math"
+
+	"ecommerce-be/product/entity"
+	"ecommerce-be/product/mapper"
+	"ecommerce-be/product/model"
+)
+
+// BuildBundleResponse prices a bundle against its components. variants maps variant ID
+// to the basic info of the bundle and every component.
+func BuildBundleResponse(
+	bundle mapper.VariantBasicInfoRow,
+	components []entity.VariantBundleComponent,
+	variants map[uint]mapper.VariantBasicInfoRow,
+) model.VariantBundleResponse {
+	response := model.VariantBundleResponse{
+		VariantID:  bundle.VariantID,
+		SKU:        bundle.SKU,
+		Price:      bundle.Price,
+		Components: make([]model.BundleComponentResponse, 0, len(components)),
+	}
+	for _, component := range components {
+		info := variants[component.ComponentVariantID]
+		lineTotal := roundPrice(info.Price * float64(component.Quantity))
+		response.ComponentsPrice += lineTotal
+		response.Components = append(response.Components, model.BundleComponentResponse{
+			VariantID:   component.ComponentVariantID,
+			ProductID:   info.ProductID,
+			ProductName: info.ProductName,
+			SKU:         info.SKU,
+			Quantity:    component.Quantity,
+			UnitPrice:   info.Price,
+			LineTotal:   lineTotal,
+		})
+	}
+	response.ComponentsPrice = roundPrice(response.ComponentsPrice)
+	response.Savings = max(roundPrice(response.ComponentsPrice-response.Price), 0)
+	return response
+}
+
+// GroupBundleComponents maps each bundle variant ID to its components
+func GroupBundleComponents(
+	components []entity.VariantBundleComponent,
+) map[uint][]entity.VariantBundleComponent {
+	grouped := make(map[uint][]entity.VariantBundleComponent)
+	for _, component := range components {
+		grouped[component.BundleVariantID] = append(
+			grouped[component.BundleVariantID],
+			component,
+		)
+	}
+	return grouped
+}
+
+// roundPrice rounds an amount to cents
+func roundPrice(amount float64) float64 {
+	return math.Round(amount*100) / 100
+}
@@ -0,0 +1,44 @@
+package utils
+
+// Bundle routes (under /api/product/:productId/variant)
+const (
+	BUNDLE_ROUTE = "/:variantId/bundle"
+)
+
+// Bundle settings
+const (
+	// BUNDLE_MAX_COMPONENTS caps the number of distinct variants in one bundle
+	BUNDLE_MAX_COMPONENTS = 50
+)
+
+// Bundle field names
+const (
+	BUNDLE_FIELD_NAME = "bundle"
+)
+
+// Bundle error codes
+const (
+	BUNDLE_NOT_FOUND_CODE           = "BUNDLE_NOT_FOUND"
+	BUNDLE_COMPONENT_INVALID_CODE   = "BUNDLE_COMPONENT_INVALID"
+	BUNDLE_COMPONENT_DUPLICATE_CODE = "BUNDLE_COMPONENT_DUPLICATE"
+	BUNDLE_SELF_REFERENCE_CODE      = "BUNDLE_SELF_REFERENCE"
+	BUNDLE_NESTED_CODE              = "BUNDLE_NESTED"
+	VARIANT_IN_BUNDLE_CODE          = "VARIANT_IN_BUNDLE"
+)
+
+// Bundle messages
+const (
+	BUNDLE_NOT_FOUND_MSG           = "Variant is not a bundle"
+	BUNDLE_COMPONENT_INVALID_MSG   = "Bundle components must be existing variants of your products"
+	BUNDLE_COMPONENT_DUPLICATE_MSG = "Each variant can appear only once in a bundle"
+	BUNDLE_SELF_REFERENCE_MSG      = "A bundle cannot contain itself"
+	BUNDLE_NESTED_MSG              = "Bundles cannot contain other bundles or be part of a bundle"
+	VARIANT_IN_BUNDLE_MSG          = "Variant is a component of a bundle and cannot be deleted"
+
+	BUNDLE_RETRIEVED_MSG        = "Bundle retrieved successfully"
+	BUNDLE_UPDATED_MSG          = "Bundle updated successfully"
+	BUNDLE_DELETED_MSG          = "Bundle removed successfully"
+	FAILED_TO_GET_BUNDLE_MSG    = "Failed to get bundle"
+	FAILED_TO_UPDATE_BUNDLE_MSG = "Failed to update bundle"
+	FAILED_TO_DELETE_BUNDLE_MSG = "Failed to remove bundle"
+)
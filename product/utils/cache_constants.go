@@ -19,8 +19,28 @@ const (
 	// Filter cache keys
 	FILTERS_CACHE_KEY = "product:filters:"
 
-	// Related products cache keys
-	RELATED_PRODUCTS_CACHE_KEY = "product:related:"
+	// Seller search settings cache keys
+	SEARCH_SETTINGS_CACHE_KEY_PREFIX = "product:search_settings:"
+	SEARCH_SYNONYMS_CACHE_KEY_PREFIX = "product:search_synonyms:"
+)
+
+// Read-through cache namespaces. Keys carry the namespace version, so bumping it
+// invalidates every key of the namespace at once.
+const (
+	CACHE_NAMESPACE_PREFIX          = "product:"
+	PRODUCT_DETAIL_CACHE_NAMESPACE  = "product:detail"
+	CATEGORY_TREE_CACHE_NAMESPACE   = "product:category_tree"
+	VARIANT_PREVIEW_CACHE_NAMESPACE = "product:variant_preview"
+	// RELATED_PRODUCTS_CACHE_NAMESPACE holds scored related product pages per seller scope
+	RELATED_PRODUCTS_CACHE_NAMESPACE = "product:related"
+	// SEARCH_SUGGEST_CACHE_NAMESPACE holds search-as-you-type suggestions per seller scope
+	SEARCH_SUGGEST_CACHE_NAMESPACE = "product:suggest"
+)
+
+// Related products cache status reported in the response meta
+const (
+	RELATED_CACHE_STATUS_HIT  = "hit"
+	RELATED_CACHE_STATUS_MISS = "miss"
 )
 
 // Cache TTL constants (in seconds)
@@ -42,4 +62,28 @@ const (
 
 	// Related Products: Cache for 20 minutes
 	RELATED_PRODUCTS_CACHE_TTL = 1200
+
+	// Search Suggestions: Cache for 5 minutes (invalidated on product and category writes)
+	SEARCH_SUGGEST_CACHE_TTL = 300
+
+	// Seller Search Settings: Cache for 30 minutes (invalidated on update)
+	SEARCH_SETTINGS_CACHE_TTL = 1800
+
+	// Seller Search Synonyms: Cache for 30 minutes (invalidated on change)
+	SEARCH_SYNONYMS_CACHE_TTL = 1800
+
+	// Variant Preview: Cache for 15 minutes (invalidated on variant and option writes)
+	VARIANT_PREVIEW_CACHE_TTL = 900
+
+	// Category Tree in-process copy: 30 seconds in front of Redis. Keys are versioned,
+	// so a category write is still seen as soon as the namespace version is bumped.
+	CATEGORY_TREE_LOCAL_CACHE_TTL = 30
+)
+
+// Cache statistics endpoint
+const (
+	// CACHE_STATS_ROUTE is relative to /api/product
+	CACHE_STATS_ROUTE         = "/cache/stats"
+	CACHE_STATS_FIELD_NAME    = "caches"
+	CACHE_STATS_RETRIEVED_MSG = "Cache statistics retrieved successfully"
 )
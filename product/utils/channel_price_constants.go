@@ -0,0 +1,38 @@
+package utils
+
+// Channel pricing route and query parameters
+const (
+	// CHANNEL_PRICE_ROUTE is relative to /api/product/:productId/variant
+	CHANNEL_PRICE_ROUTE = "/channel-price"
+
+	// SALES_CHANNEL_QUERY_PARAM scopes public variant prices to a sales channel (?channel=pos)
+	SALES_CHANNEL_QUERY_PARAM = "channel"
+)
+
+// Channel pricing error codes
+const (
+	INVALID_SALES_CHANNEL_CODE         = "INVALID_SALES_CHANNEL"
+	CHANNEL_PRICE_VARIANT_INVALID_CODE = "CHANNEL_PRICE_VARIANT_INVALID"
+	CHANNEL_PRICE_DUPLICATE_CODE       = "CHANNEL_PRICE_DUPLICATE"
+)
+
+// Channel pricing messages
+const (
+	INVALID_SALES_CHANNEL_MSG         = "Sales channel must be one of: web, pos, marketplace"
+	CHANNEL_PRICE_VARIANT_INVALID_MSG = "One or more variants do not belong to this product"
+	CHANNEL_PRICE_DUPLICATE_MSG       = "Each variant and channel pair may appear only once"
+
+	CHANNEL_PRICES_RETRIEVED_MSG = "Channel prices retrieved successfully"
+	CHANNEL_PRICES_UPDATED_MSG   = "Channel prices updated successfully"
+	CHANNEL_PRICES_DELETED_MSG   = "Channel prices deleted successfully"
+
+	FAILED_TO_GET_CHANNEL_PRICES_MSG    = "Failed to get channel prices"
+	FAILED_TO_UPDATE_CHANNEL_PRICES_MSG = "Failed to update channel prices"
+	FAILED_TO_DELETE_CHANNEL_PRICES_MSG = "Failed to delete channel prices"
+)
+
+// Channel pricing response field names
+const (
+	CHANNEL_PRICES_FIELD_NAME = "channelPrices"
+	DELETED_COUNT_FIELD_NAME  = "deletedCount"
+)
@@ -0,0 +1,65 @@
+package utils
+
+import (
+	"ecommerce-be/product/entity"
+	"ecommerce-be/product/model"
+)
+
+// ResolveChannelPrice applies the resolution order channel override -> base price
+func ResolveChannelPrice(basePrice float64, override *float64) float64 {
+	if override != nil && *override > 0 {
+		return *override
+	}
+	return basePrice
+}
+
+// ApplyChannelPrices rewrites Price on each variant for the given channel and records the
+// base price alongside it. overrides maps variant ID to that channel's override price.
+func ApplyChannelPrices(
+	variants []model.VariantDetailResponse,
+	channel entity.SalesChannel,
+	overrides map[uint]float64,
+) {
+	if channel == "" {
+		return
+	}
+	for i := range variants {
+		base := variants[i].Price
+		var override *float64
+		if price, ok := overrides[variants[i].ID]; ok {
+			override = &price
+		}
+		variants[i].BasePrice = &base
+		variants[i].Channel = channel.String()
+		variants[i].Price = ResolveChannelPrice(base, override)
+	}
+}
+
+// GroupChannelPrices builds the management view of base prices and overrides per variant
+func GroupChannelPrices(
+	variants []entity.ProductVariant,
+	prices []entity.VariantChannelPrice,
+) []model.VariantChannelPricesResponse {
+	byVariant := make(map[uint][]model.ChannelPriceResponse, len(variants))
+	for _, p := range prices {
+		byVariant[p.VariantID] = append(byVariant[p.VariantID], model.ChannelPriceResponse{
+			Channel: p.Channel.String(),
+			Price:   p.Price,
+		})
+	}
+
+	result := make([]model.VariantChannelPricesResponse, 0, len(variants))
+	for _, v := range variants {
+		channelPrices := byVariant[v.ID]
+		if channelPrices == nil {
+			channelPrices = []model.ChannelPriceResponse{}
+		}
+		result = append(result, model.VariantChannelPricesResponse{
+			VariantID:     v.ID,
+			SKU:           v.SKU,
+			BasePrice:     v.Price,
+			ChannelPrices: channelPrices,
+		})
+	}
+	return result
+}
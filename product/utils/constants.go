@@ -20,10 +20,6 @@ const (
 	INVALID_AUTH_FORMAT_CODE = constants.INVALID_AUTH_FORMAT_CODE
 	TOKEN_REQUIRED_CODE      = constants.TOKEN_REQUIRED_CODE
 
-	// Context keys
-	USER_ID_KEY = constants.USER_ID_KEY
-	EMAIL_KEY   = constants.EMAIL_KEY
-
 	// Token settings
 	TOKEN_EXPIRE_DURATION = constants.TOKEN_EXPIRE_DURATION
 
@@ -39,8 +35,9 @@ const (
 
 // Variant Media route path segments (relative to /:productId/variant/:variantId)
 const (
-	VARIANT_MEDIA_ROUTE      = "/media"
-	VARIANT_MEDIA_FILE_ROUTE = "/:fileId"
+	VARIANT_MEDIA_ROUTE       = "/media"
+	VARIANT_MEDIA_FILE_ROUTE  = "/:fileId"
+	VARIANT_MEDIA_ORDER_ROUTE = "/order"
 )
 
 // Backward-compatible aliases (to be removed after migration)
@@ -57,9 +54,6 @@ const (
 	InvalidAuthFormatCode = INVALID_AUTH_FORMAT_CODE
 	TokenRequiredCode     = TOKEN_REQUIRED_CODE
 
-	UserIDKey = USER_ID_KEY
-	EmailKey  = EMAIL_KEY
-
 	TokenExpireDuration = TOKEN_EXPIRE_DURATION
 
 	RedisNotInitializedMsg = REDIS_NOT_INITIALIZED_MSG
@@ -0,0 +1,80 @@
+package utils
+
+import "ecommerce-be/common/constants"
+
+// Product types
+const (
+	PRODUCT_TYPE_PHYSICAL = "physical"
+	PRODUCT_TYPE_DIGITAL  = "digital"
+)
+
+// Digital product routes (seller routes under /api/product/:productId)
+const (
+	DIGITAL_ASSETS_ROUTE         = "/:productId/digital/asset"
+	DIGITAL_ASSET_ROUTE          = "/:productId/digital/asset/:assetId"
+	LICENSE_KEYS_ROUTE           = "/:productId/digital/license-key"
+	LICENSE_KEY_ROUTE            = "/:productId/digital/license-key/:keyId"
+	DIGITAL_DOWNLOAD_ROUTE       = "/download/:token"
+	DIGITAL_DOWNLOAD_PATH        = constants.APIBaseProduct + "/download/"
+	DIGITAL_ASSET_ID_PARAM       = "assetId"
+	LICENSE_KEY_ID_PARAM         = "keyId"
+	DIGITAL_DOWNLOAD_TOKEN_PARAM = "token"
+)
+
+// Digital product settings
+const (
+	// DIGITAL_MAX_ASSETS caps the files attached to one product
+	DIGITAL_MAX_ASSETS = 20
+
+	// LICENSE_KEYS_MAX_PER_REQUEST caps the keys uploaded in one request
+	LICENSE_KEYS_MAX_PER_REQUEST = 1000
+)
+
+// Digital product field names
+const (
+	DIGITAL_ASSET_FIELD_NAME     = "asset"
+	DIGITAL_ASSETS_FIELD_NAME    = "assets"
+	LICENSE_KEY_POOL_FIELD_NAME  = "licenseKeys"
+	DIGITAL_PURCHASES_FIELD_NAME = "downloads"
+)
+
+// Digital product error codes
+const (
+	PRODUCT_NOT_DIGITAL_CODE     = "PRODUCT_NOT_DIGITAL"
+	DIGITAL_ASSET_NOT_FOUND_CODE = "DIGITAL_ASSET_NOT_FOUND"
+	DIGITAL_ASSET_DUPLICATE_CODE = "DIGITAL_ASSET_DUPLICATE"
+	DIGITAL_ASSET_LIMIT_CODE     = "DIGITAL_ASSET_LIMIT_REACHED"
+	LICENSE_KEY_NOT_FOUND_CODE   = "LICENSE_KEY_NOT_FOUND"
+	LICENSE_KEY_ISSUED_CODE      = "LICENSE_KEY_ISSUED"
+	DOWNLOAD_LINK_INVALID_CODE   = "DOWNLOAD_LINK_INVALID"
+	DOWNLOAD_LINK_EXPIRED_CODE   = "DOWNLOAD_LINK_EXPIRED"
+	DOWNLOAD_LIMIT_REACHED_CODE  = "DOWNLOAD_LIMIT_REACHED"
+)
+
+// Digital product messages
+const (
+	PRODUCT_NOT_DIGITAL_MSG     = "Product is not a digital product"
+	DIGITAL_ASSET_NOT_FOUND_MSG = "Download file not found"
+	DIGITAL_ASSET_DUPLICATE_MSG = "File is already attached to this product"
+	DIGITAL_ASSET_LIMIT_MSG     = "A product can have at most 20 download files"
+	LICENSE_KEY_NOT_FOUND_MSG   = "License key not found"
+	LICENSE_KEY_ISSUED_MSG      = "License key has already been issued to a customer"
+	DOWNLOAD_LINK_INVALID_MSG   = "Download link is invalid"
+	DOWNLOAD_LINK_EXPIRED_MSG   = "Download link has expired"
+	DOWNLOAD_LIMIT_REACHED_MSG  = "Download limit reached for this purchase"
+
+	DIGITAL_ASSETS_RETRIEVED_MSG       = "Download files retrieved successfully"
+	DIGITAL_ASSET_ADDED_MSG            = "Download file added successfully"
+	DIGITAL_ASSET_DELETED_MSG          = "Download file removed successfully"
+	LICENSE_KEYS_RETRIEVED_MSG         = "License keys retrieved successfully"
+	LICENSE_KEYS_ADDED_MSG             = "License keys added successfully"
+	LICENSE_KEY_DELETED_MSG            = "License key removed successfully"
+	DIGITAL_DOWNLOAD_STARTED_MSG       = "Redirecting to download"
+	FAILED_TO_GET_DIGITAL_ASSETS_MSG   = "Failed to get download files"
+	FAILED_TO_ADD_DIGITAL_ASSET_MSG    = "Failed to add download file"
+	FAILED_TO_DELETE_DIGITAL_ASSET_MSG = "Failed to remove download file"
+	FAILED_TO_GET_LICENSE_KEYS_MSG     = "Failed to get license keys"
+	FAILED_TO_ADD_LICENSE_KEYS_MSG     = "Failed to add license keys"
+	FAILED_TO_DELETE_LICENSE_KEY_MSG   = "Failed to remove license key"
+	FAILED_TO_DOWNLOAD_MSG             = "Failed to start download"
+)
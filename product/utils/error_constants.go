@@ -71,6 +71,7 @@ const (
 	VARIANT_MEDIA_DUPLICATE_CODE      = "VARIANT_MEDIA_DUPLICATE"
 	VARIANT_MEDIA_INVALID_FILE_CODE   = "VARIANT_MEDIA_INVALID_FILE"
 	VARIANT_MEDIA_CLEANUP_FAILED_CODE = "VARIANT_MEDIA_CLEANUP_FAILED"
+	VARIANT_MEDIA_ORDER_MISMATCH_CODE = "VARIANT_MEDIA_ORDER_MISMATCH"
 )
 
 // Variant error codes
@@ -87,4 +88,5 @@ const (
 	INSUFFICIENT_STOCK_FOR_OPERATION_CODE  = "INSUFFICIENT_STOCK_FOR_OPERATION"
 	BULK_UPDATE_EMPTY_LIST_CODE            = "BULK_UPDATE_EMPTY_LIST"
 	BULK_UPDATE_VARIANT_NOT_FOUND_CODE     = "BULK_UPDATE_VARIANT_NOT_FOUND"
+	VARIANT_VERSION_CONFLICT_CODE          = "VARIANT_VERSION_CONFLICT"
 )
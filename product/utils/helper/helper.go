@@ -7,15 +7,21 @@ import (
 	"time"
 
 	"ecommerce-be/common/db"
+	productQuery "ecommerce-be/product/query"
 )
 
-// productSortColumns maps API sortBy values to safe database column names.
+// productSortColumns maps API sortBy values to safe database columns or sort expressions.
 var productSortColumns = map[string]string{
 	"createdAt":  "created_at",
 	"created_at": "created_at",
+	"newest":     "created_at",
 	"updatedAt":  "updated_at",
 	"updated_at": "updated_at",
 	"name":       "name",
+	"price":      productQuery.SORT_BY_PRICE_EXPR,
+	"popularity": productQuery.SORT_BY_POPULARITY_EXPR,
+	"sales":      productQuery.SORT_BY_POPULARITY_EXPR,
+	"discount":   productQuery.SORT_BY_DISCOUNT_EXPR,
 }
 
 // NormalizeProductSortColumn maps a product list sortBy param to a DB column.
@@ -179,3 +185,15 @@ func MapToPrettyJSON(data map[string]any) (string, error) {
 	}
 	return string(b), nil
 }
+
+// TrimmedOrNil trims an optional string and maps an empty result to nil
+func TrimmedOrNil(value *string) *string {
+	if value == nil {
+		return nil
+	}
+	trimmed := strings.TrimSpace(*value)
+	if trimmed == "" {
+		return nil
+	}
+	return &trimmed
+}
@@ -117,6 +117,7 @@ const (
 	INSUFFICIENT_STOCK_FOR_OPERATION_MSG  = "Insufficient stock for subtract operation"
 	BULK_UPDATE_EMPTY_LIST_MSG            = "Variants list cannot be empty"
 	BULK_UPDATE_VARIANT_NOT_FOUND_MSG     = "One or more variants not found or do not belong to this product"
+	VARIANT_VERSION_CONFLICT_MSG          = "Variant was changed by another update; reload it and retry"
 )
 
 // Variant operation failure messages
@@ -204,13 +205,16 @@ const (
 	VARIANT_MEDIA_DUPLICATE_MSG      = "This file is already attached to the variant"
 	VARIANT_MEDIA_INVALID_FILE_MSG   = "The referenced file does not exist or is not accessible"
 	VARIANT_MEDIA_CLEANUP_FAILED_MSG = "Variant media removed but underlying file cleanup failed; scheduled for retry"
+	VARIANT_MEDIA_ORDER_MISMATCH_MSG = "fileIds must list every file attached to the variant exactly once"
 
-	VARIANT_MEDIA_ATTACHED_MSG         = "Media attached to variant"
-	VARIANT_MEDIA_UPDATED_MSG          = "Variant media metadata updated"
-	VARIANT_MEDIA_REMOVED_MSG          = "Variant media removed"
-	FAILED_TO_ATTACH_VARIANT_MEDIA_MSG = "Failed to attach media to variant"
-	FAILED_TO_UPDATE_VARIANT_MEDIA_MSG = "Failed to update variant media metadata"
-	FAILED_TO_REMOVE_VARIANT_MEDIA_MSG = "Failed to remove variant media"
+	VARIANT_MEDIA_ATTACHED_MSG          = "Media attached to variant"
+	VARIANT_MEDIA_UPDATED_MSG           = "Variant media metadata updated"
+	VARIANT_MEDIA_REMOVED_MSG           = "Variant media removed"
+	VARIANT_MEDIA_REORDERED_MSG         = "Variant media reordered"
+	FAILED_TO_ATTACH_VARIANT_MEDIA_MSG  = "Failed to attach media to variant"
+	FAILED_TO_UPDATE_VARIANT_MEDIA_MSG  = "Failed to update variant media metadata"
+	FAILED_TO_REMOVE_VARIANT_MEDIA_MSG  = "Failed to remove variant media"
+	FAILED_TO_REORDER_VARIANT_MEDIA_MSG = "Failed to reorder variant media"
 )
 
 // Business rule messages
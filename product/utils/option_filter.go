@@ -0,0 +1,46 @@
+package utils
+
+import (
+	"errors"
+	"slices"
+	"strings"
+
+	"ecommerce-be/product/model"
+)
+
+// ParseOptionValueFilters parses an options query parameter such as
+// "color:black,color:navy,size:m". Names and values are compared case-insensitively,
+// so both are lowercased. Values of the same option are alternatives; every option
+// must match. Options keep the order they first appear in.
+func ParseOptionValueFilters(raw string) ([]model.OptionValueFilter, error) {
+	var filters []model.OptionValueFilter
+	for pair := range strings.SplitSeq(raw, ",") {
+		if strings.TrimSpace(pair) == "" {
+			continue
+		}
+		name, value, ok := strings.Cut(pair, ":")
+		name, value = NormalizeSearchTerm(name), NormalizeSearchTerm(value)
+		if !ok || name == "" || value == "" {
+			return nil, errors.New(INVALID_OPTION_FILTER_MSG)
+		}
+
+		index := slices.IndexFunc(filters, func(f model.OptionValueFilter) bool {
+			return f.Name == name
+		})
+		if index < 0 {
+			if len(filters) == OPTION_FILTER_MAX_OPTIONS {
+				return nil, errors.New(OPTION_FILTER_TOO_MANY_OPTIONS_MSG)
+			}
+			filters = append(filters, model.OptionValueFilter{Name: name})
+			index = len(filters) - 1
+		}
+		if slices.Contains(filters[index].Values, value) {
+			continue
+		}
+		if len(filters[index].Values) == OPTION_FILTER_MAX_VALUES {
+			return nil, errors.New(OPTION_FILTER_TOO_MANY_VALUES_MSG)
+		}
+		filters[index].Values = append(filters[index].Values, value)
+	}
+	return filters, nil
+}
@@ -0,0 +1,19 @@
+package utils
+
+// Option value filter limits
+const (
+	// OPTION_FILTER_MAX_OPTIONS caps the distinct options in an options filter
+	OPTION_FILTER_MAX_OPTIONS = 5
+	// OPTION_FILTER_MAX_VALUES caps the values listed per option
+	OPTION_FILTER_MAX_VALUES = 20
+)
+
+// Option value filter error codes and messages
+const (
+	INVALID_OPTION_FILTER_CODE = "INVALID_OPTION_FILTER"
+
+	INVALID_OPTION_FILTER_MSG = "options must be a comma-separated list of name:value pairs, " +
+		"e.g. color:black,size:m"
+	OPTION_FILTER_TOO_MANY_OPTIONS_MSG = "options can filter on at most 5 options"
+	OPTION_FILTER_TOO_MANY_VALUES_MSG  = "options can list at most 20 values per option"
+)
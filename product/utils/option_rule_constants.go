@@ -0,0 +1,39 @@
+package utils
+
+// Option rule route
+const (
+	// OPTION_RULE_ROUTE is relative to /api/product/:productId
+	OPTION_RULE_ROUTE = "/option-rule"
+)
+
+// Option rule error codes
+const (
+	OPTION_RULE_NOT_FOUND_CODE          = "OPTION_RULE_NOT_FOUND"
+	OPTION_RULE_INVALID_CODE            = "OPTION_RULE_INVALID"
+	OPTION_RULE_CYCLE_CODE              = "OPTION_RULE_CYCLE"
+	OPTION_RULE_VARIANT_CONFLICT_CODE   = "OPTION_RULE_VARIANT_CONFLICT"
+	OPTION_COMBINATION_NOT_ALLOWED_CODE = "OPTION_COMBINATION_NOT_ALLOWED"
+)
+
+// Option rule messages
+const (
+	OPTION_RULE_NOT_FOUND_MSG          = "Option rule not found"
+	OPTION_RULE_INVALID_MSG            = "An option cannot depend on itself"
+	OPTION_RULE_CYCLE_MSG              = "Option rules cannot depend on each other in a loop"
+	OPTION_RULE_VARIANT_CONFLICT_MSG   = "Existing variants use option combinations this rule forbids"
+	OPTION_COMBINATION_NOT_ALLOWED_MSG = "This option combination is not allowed for the product"
+
+	OPTION_RULE_CREATED_MSG    = "Option rule created successfully"
+	OPTION_RULE_DELETED_MSG    = "Option rule deleted successfully"
+	OPTION_RULES_RETRIEVED_MSG = "Option rules retrieved successfully"
+
+	FAILED_TO_CREATE_OPTION_RULE_MSG = "Failed to create option rule"
+	FAILED_TO_DELETE_OPTION_RULE_MSG = "Failed to delete option rule"
+	FAILED_TO_GET_OPTION_RULES_MSG   = "Failed to get option rules"
+)
+
+// Option rule response field names
+const (
+	OPTION_RULE_FIELD_NAME  = "rule"
+	OPTION_RULES_FIELD_NAME = "rules"
+)
@@ -0,0 +1,95 @@
+package utils
+
+import (
+	"slices"
+
+	"ecommerce-be/product/entity"
+)
+
+// OptionSelection maps option ID to the selected option value ID of one variant
+type OptionSelection map[uint]uint
+
+// OptionRuleHolds reports whether the rule's condition option is set to one of its condition values
+func OptionRuleHolds(rule entity.ProductOptionRule, selection OptionSelection) bool {
+	valueID, ok := selection[rule.ConditionOptionID]
+	return ok && slices.Contains(rule.ConditionValueIDs, int64(valueID))
+}
+
+// OptionRuleTargets reports whether the selection uses the option or value the rule restricts
+func OptionRuleTargets(rule entity.ProductOptionRule, selection OptionSelection) bool {
+	valueID, ok := selection[rule.OptionID]
+	if !ok {
+		return false
+	}
+	return len(rule.OptionValueIDs) == 0 || slices.Contains(rule.OptionValueIDs, int64(valueID))
+}
+
+// FindOptionRuleViolation returns the first rule the selection breaks, or nil when
+// the combination is possible
+func FindOptionRuleViolation(
+	rules []entity.ProductOptionRule,
+	selection OptionSelection,
+) *entity.ProductOptionRule {
+	for i := range rules {
+		if OptionRuleTargets(rules[i], selection) && !OptionRuleHolds(rules[i], selection) {
+			return &rules[i]
+		}
+	}
+	return nil
+}
+
+// OptionApplies reports whether a variant with this selection must specify optionID.
+// An option stops applying when any whole-option rule on it does not hold.
+func OptionApplies(
+	rules []entity.ProductOptionRule,
+	optionID uint,
+	selection OptionSelection,
+) bool {
+	for _, rule := range rules {
+		if rule.OptionID == optionID && len(rule.OptionValueIDs) == 0 &&
+			!OptionRuleHolds(rule, selection) {
+			return false
+		}
+	}
+	return true
+}
+
+// OptionRulesHaveCycle reports whether options depend on each other in a loop,
+// which would make every combination involving them impossible
+func OptionRulesHaveCycle(rules []entity.ProductOptionRule) bool {
+	dependents := make(map[uint][]uint) // condition option -> options that depend on it
+	for _, rule := range rules {
+		dependents[rule.ConditionOptionID] = append(dependents[rule.ConditionOptionID], rule.OptionID)
+	}
+
+	const (
+		unvisited = iota
+		visiting
+		done
+	)
+	state := make(map[uint]int)
+	var visit func(optionID uint) bool
+	visit = func(optionID uint) bool {
+		switch state[optionID] {
+		case visiting:
+			return true
+		case done:
+			return false
+		}
+		state[optionID] = visiting
+		for _, next := range dependents[optionID] {
+			if visit(next) {
+				return true
+			}
+		}
+		state[optionID] = done
+		return false
+	}
+
+	for optionID := range dependents {
+		if visit(optionID) {
+			return true
+		}
+	}
+	return false
+}
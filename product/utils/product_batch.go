@@ -0,0 +1,32 @@
+package utils
+
+import "ecommerce-be/product/model"
+
+// BuildBatchProductResults lays the loaded products out as one result per requested
+// ID, in request order. An ID with no loaded product, or whose product belongs to
+// another seller when sellerID is set, is reported as not found. Repeated IDs share
+// the same response.
+func BuildBatchProductResults(
+	productIDs []uint,
+	products []*model.ProductResponse,
+	sellerID *uint,
+) []model.BatchProductResult {
+	byID := make(map[uint]*model.ProductResponse, len(products))
+	for _, product := range products {
+		if product == nil || (sellerID != nil && product.SellerID != *sellerID) {
+			continue
+		}
+		byID[product.ID] = product
+	}
+
+	results := make([]model.BatchProductResult, len(productIDs))
+	for i, productID := range productIDs {
+		product, found := byID[productID]
+		results[i] = model.BatchProductResult{
+			ProductID: productID,
+			Found:     found,
+			Product:   product,
+		}
+	}
+	return results
+}
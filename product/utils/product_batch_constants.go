@@ -0,0 +1,7 @@
+package utils
+
+// Batch product detail routes
+const (
+	// PRODUCT_BATCH_ROUTE is relative to /api/product
+	PRODUCT_BATCH_ROUTE = "/batch"
+)
@@ -0,0 +1,106 @@
+package utils
+
+import (
+	"errors"
+	"slices"
+	"strconv"
+	"strings"
+
+	"ecommerce-be/product/entity"
+	"ecommerce-be/product/model"
+)
+
+// ParseCompareProductIDs parses the ids query parameter of a comparison, such as
+// "12,7,31". Repeated IDs are dropped, keeping the first occurrence.
+func ParseCompareProductIDs(raw string) ([]uint, error) {
+	var ids []uint
+	for part := range strings.SplitSeq(raw, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		id, err := strconv.ParseUint(part, 10, 0)
+		if err != nil || id == 0 {
+			return nil, errors.New(INVALID_PRODUCT_COMPARE_MSG)
+		}
+		if slices.Contains(ids, uint(id)) {
+			continue
+		}
+		if len(ids) == PRODUCT_COMPARE_MAX_PRODUCTS {
+			return nil, errors.New(PRODUCT_COMPARE_TOO_MANY_MSG)
+		}
+		ids = append(ids, uint(id))
+	}
+	if len(ids) < PRODUCT_COMPARE_MIN_PRODUCTS {
+		return nil, errors.New(PRODUCT_COMPARE_TOO_FEW_MSG)
+	}
+	return ids, nil
+}
+
+// BuildCompareAttributeRows turns the attributes of the compared products into one row
+// per attribute definition, with a value slot for each product in productIDs order.
+// Rows follow the order attributes first appear when walking the products in order,
+// each by its own sort order. Values are trimmed and inner whitespace collapsed; a
+// row differs when any product lacks the attribute or values differ ignoring case.
+func BuildCompareAttributeRows(
+	productIDs []uint,
+	attributes []entity.ProductAttribute,
+) []model.CompareAttributeRow {
+	byProduct := make(map[uint][]entity.ProductAttribute, len(productIDs))
+	for _, attribute := range attributes {
+		byProduct[attribute.ProductID] = append(byProduct[attribute.ProductID], attribute)
+	}
+
+	rows := []model.CompareAttributeRow{}
+	rowIndex := make(map[uint]int)
+	for column, productID := range productIDs {
+		productAttributes := byProduct[productID]
+		slices.SortStableFunc(productAttributes, func(a, b entity.ProductAttribute) int {
+			return int(a.SortOrder) - int(b.SortOrder)
+		})
+		for _, attribute := range productAttributes {
+			index, ok := rowIndex[attribute.AttributeDefinitionID]
+			if !ok {
+				rows = append(rows, newCompareAttributeRow(attribute, productIDs))
+				index = len(rows) - 1
+				rowIndex[attribute.AttributeDefinitionID] = index
+			}
+			rows[index].Values[column] = model.CompareAttributeValue{
+				ProductID: productID,
+				Value:     strings.Join(strings.Fields(attribute.Value), " "),
+				Present:   true,
+			}
+		}
+	}
+
+	for i := range rows {
+		rows[i].Differs = compareValuesDiffer(rows[i].Values)
+	}
+	return rows
+}
+
+// newCompareAttributeRow starts a row for the attribute's definition with an empty
+// value slot per product
+func newCompareAttributeRow(
+	attribute entity.ProductAttribute,
+	productIDs []uint,
+) model.CompareAttributeRow {
+	row := model.CompareAttributeRow{Values: make([]model.CompareAttributeValue, len(productIDs))}
+	if definition := attribute.AttributeDefinition; definition != nil {
+		row.Key, row.Name, row.Unit = definition.Key, definition.Name, definition.Unit
+	}
+	for i, productID := range productIDs {
+		row.Values[i].ProductID = productID
+	}
+	return row
+}
+
+// compareValuesDiffer reports whether the products do not all share one value
+func compareValuesDiffer(values []model.CompareAttributeValue) bool {
+	for _, value := range values[1:] {
+		if value.Present != values[0].Present || !strings.EqualFold(value.Value, values[0].Value) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,27 @@
+package utils
+
+// Product comparison routes
+const (
+	// PRODUCT_COMPARE_ROUTE is relative to /api/product
+	PRODUCT_COMPARE_ROUTE = "/compare"
+)
+
+// Product comparison limits
+const (
+	// PRODUCT_COMPARE_MIN_PRODUCTS and PRODUCT_COMPARE_MAX_PRODUCTS bound the distinct
+	// product IDs in one comparison
+	PRODUCT_COMPARE_MIN_PRODUCTS = 2
+	PRODUCT_COMPARE_MAX_PRODUCTS = 5
+)
+
+// Product comparison error codes and messages
+const (
+	INVALID_PRODUCT_COMPARE_CODE = "INVALID_PRODUCT_COMPARE"
+
+	INVALID_PRODUCT_COMPARE_MSG  = "ids must be a comma-separated list of product IDs, e.g. 1,2,3"
+	PRODUCT_COMPARE_TOO_FEW_MSG  = "ids must list at least 2 distinct products"
+	PRODUCT_COMPARE_TOO_MANY_MSG = "ids can list at most 5 products"
+
+	PRODUCTS_COMPARED_MSG          = "Product comparison retrieved successfully"
+	FAILED_TO_COMPARE_PRODUCTS_MSG = "Failed to compare products"
+)
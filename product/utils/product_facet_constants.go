@@ -0,0 +1,14 @@
+package utils
+
+// Facet limits for listing and search responses
+const (
+	// FACET_MAX_BRANDS and FACET_MAX_CATEGORIES cap the brand and category facets,
+	// largest counts first
+	FACET_MAX_BRANDS     = 20
+	FACET_MAX_CATEGORIES = 20
+	// FACET_MAX_OPTION_VALUES caps the values listed per option facet
+	FACET_MAX_OPTION_VALUES = 20
+	// FACET_PRICE_BUCKETS is the number of price buckets aimed for; rounding the bucket
+	// width to a readable step can yield one more or a few less
+	FACET_PRICE_BUCKETS = 5
+)
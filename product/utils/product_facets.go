@@ -0,0 +1,37 @@
+package utils
+
+import "math"
+
+// PriceBucketEdges splits the price range into about FACET_PRICE_BUCKETS buckets with
+// readable edges: the width is rounded up to 1, 2, 2.5 or 5 times a power of ten, at
+// least one cent, and the first edge down to a multiple of it. Bucket i covers
+// [edges[i], edges[i+1]); the last edge lies above maxPrice. It returns nil when the
+// range is empty.
+func PriceBucketEdges(minPrice, maxPrice float64) []float64 {
+	if maxPrice <= minPrice {
+		return nil
+	}
+	step := max(readablePriceStep((maxPrice-minPrice)/FACET_PRICE_BUCKETS), 0.01)
+	first := math.Floor(minPrice/step) * step
+	edges := []float64{roundToCents(first)}
+	for edges[len(edges)-1] <= maxPrice {
+		edges = append(edges, roundToCents(first+float64(len(edges))*step))
+	}
+	return edges
+}
+
+// readablePriceStep rounds a bucket width up to 1, 2, 2.5 or 5 times a power of ten
+func readablePriceStep(width float64) float64 {
+	magnitude := math.Pow(10, math.Floor(math.Log10(width)))
+	for _, factor := range []float64{1, 2, 2.5, 5} {
+		if width <= factor*magnitude {
+			return factor * magnitude
+		}
+	}
+	return 10 * magnitude
+}
+
+// roundToCents drops float error from a price edge, e.g. 0.1 * 3
+func roundToCents(price float64) float64 {
+	return math.Round(price*100) / 100
+}
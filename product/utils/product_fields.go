@@ -0,0 +1,218 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"ecommerce-be/common/reqctx"
+	"ecommerce-be/product/model"
+)
+
+// ProductFields holds the ?fields selection of a product read in the request context
+var ProductFields = reqctx.NewKey[model.ProductFieldSet]("productFields")
+
+var (
+	productResponseFields = jsonFieldNames(reflect.TypeFor[model.ProductResponse]())
+	searchResultFields    = jsonFieldNames(reflect.TypeFor[model.SearchResult]())
+)
+
+// FieldSetParser parses a fields parameter into the selected fieldset
+type FieldSetParser func(raw string) (model.ProductFieldSet, error)
+
+// RequestedProductFields returns the request's sparse fieldset; nil selects every field
+func RequestedProductFields(ctx context.Context) model.ProductFieldSet {
+	fields, _ := ProductFields.Get(ctx)
+	return fields
+}
+
+// ParseProductFields parses a fields parameter of product list and detail reads, such
+// as "id,name,priceRange". A blank value selects every field; id is always selected.
+func ParseProductFields(raw string) (model.ProductFieldSet, error) {
+	return parseFieldSet(raw, productResponseFields)
+}
+
+// ParseSearchResultFields parses a fields parameter of product search, which also
+// accepts the search result fields such as relevanceScore
+func ParseSearchResultFields(raw string) (model.ProductFieldSet, error) {
+	return parseFieldSet(raw, searchResultFields)
+}
+
+// parseFieldSet parses a comma-separated list of names out of allowed
+func parseFieldSet(raw string, allowed []string) (model.ProductFieldSet, error) {
+	if strings.TrimSpace(raw) == "" {
+		return nil, nil
+	}
+	fields := model.ProductFieldSet{}
+	for name := range strings.SplitSeq(raw, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		if !slices.Contains(allowed, name) {
+			return nil, fmt.Errorf(UNKNOWN_PRODUCT_FIELD_MSG, name)
+		}
+		fields[name] = true
+	}
+	if len(fields) == 0 {
+		return nil, errors.New(INVALID_PRODUCT_FIELDS_MSG)
+	}
+	fields[PRODUCT_ID_FIELD] = true
+	return fields, nil
+}
+
+// ParseProductIncludes parses an include parameter of product detail, such as
+// "category,attributes". A blank value includes every association.
+func ParseProductIncludes(raw string) ([]string, error) {
+	if strings.TrimSpace(raw) == "" {
+		return nil, nil
+	}
+	includes := []string{}
+	for name := range strings.SplitSeq(raw, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" || slices.Contains(includes, name) {
+			continue
+		}
+		if !slices.Contains(ProductAssociations, name) {
+			return nil, fmt.Errorf(UNKNOWN_PRODUCT_INCLUDE_MSG, name)
+		}
+		includes = append(includes, name)
+	}
+	if len(includes) == 0 {
+		return nil, errors.New(INVALID_PRODUCT_INCLUDE_MSG)
+	}
+	return includes, nil
+}
+
+// ApplyProductIncludes narrows a fieldset to the included associations: the product's
+// own fields stay selected as before, associations only when included. Nil includes
+// leave the fieldset unchanged.
+func ApplyProductIncludes(
+	fields model.ProductFieldSet,
+	includes []string,
+) model.ProductFieldSet {
+	if includes == nil {
+		return fields
+	}
+	narrowed := model.ProductFieldSet{PRODUCT_ID_FIELD: true}
+	for _, name := range productResponseFields {
+		if slices.Contains(ProductAssociations, name) && !slices.Contains(includes, name) {
+			continue
+		}
+		if fields.Includes(name) {
+			narrowed[name] = true
+		}
+	}
+	return narrowed
+}
+
+// ProjectProduct returns the selected fields of a product response, named and
+// omitted as its JSON encoding would be. A nil fieldset keeps every field.
+func ProjectProduct(value any, fields model.ProductFieldSet) map[string]any {
+	projected := make(map[string]any)
+	projectStruct(reflect.Indirect(reflect.ValueOf(value)), fields, projected)
+	return projected
+}
+
+// ProjectProductsResponse limits every listed product to the selected fields
+func ProjectProductsResponse(
+	response *model.ProductsResponse,
+	fields model.ProductFieldSet,
+) *model.SparseProductsResponse {
+	products := make([]map[string]any, len(response.Products))
+	for i := range response.Products {
+		products[i] = ProjectProduct(&response.Products[i], fields)
+	}
+	return &model.SparseProductsResponse{
+		Products:   products,
+		Pagination: response.Pagination,
+		Facets:     response.Facets,
+	}
+}
+
+// ProjectSearchResponse limits every search result to the selected fields
+func ProjectSearchResponse(
+	response *model.SearchResponse,
+	fields model.ProductFieldSet,
+) *model.SparseSearchResponse {
+	results := make([]map[string]any, len(response.Results))
+	for i := range response.Results {
+		results[i] = ProjectProduct(&response.Results[i], fields)
+	}
+	return &model.SparseSearchResponse{
+		Query:      response.Query,
+		Results:    results,
+		Pagination: response.Pagination,
+		SearchTime: response.SearchTime,
+		Facets:     response.Facets,
+	}
+}
+
+// projectStruct copies the selected JSON fields of a struct into projected, flattening
+// embedded structs the way encoding/json does
+func projectStruct(value reflect.Value, fields model.ProductFieldSet, projected map[string]any) {
+	for i := range value.NumField() {
+		field := value.Type().Field(i)
+		if field.Anonymous && field.Type.Kind() == reflect.Struct {
+			projectStruct(value.Field(i), fields, projected)
+			continue
+		}
+		name, omitEmpty, ok := jsonFieldName(field)
+		if !ok || !fields.Includes(name) {
+			continue
+		}
+		if omitEmpty && isEmptyJSONValue(value.Field(i)) {
+			continue
+		}
+		projected[name] = value.Field(i).Interface()
+	}
+}
+
+// jsonFieldNames lists the JSON names of a struct's exported fields, embedded ones
+// included
+func jsonFieldNames(t reflect.Type) []string {
+	var names []string
+	for i := range t.NumField() {
+		field := t.Field(i)
+		if field.Anonymous && field.Type.Kind() == reflect.Struct {
+			names = append(names, jsonFieldNames(field.Type)...)
+			continue
+		}
+		if name, _, ok := jsonFieldName(field); ok {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// jsonFieldName returns a field's JSON name and whether it is omitempty; ok is false
+// for unexported or json:"-" fields
+func jsonFieldName(field reflect.StructField) (name string, omitEmpty bool, ok bool) {
+	if !field.IsExported() {
+		return "", false, false
+	}
+	tag := field.Tag.Get("json")
+	if tag == "-" {
+		return "", false, false
+	}
+	name, options, _ := strings.Cut(tag, ",")
+	if name == "" {
+		name = field.Name
+	}
+	return name, strings.Contains(options, "omitempty"), true
+}
+
+// isEmptyJSONValue mirrors encoding/json's omitempty test
+func isEmptyJSONValue(value reflect.Value) bool {
+	switch value.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return value.Len() == 0
+	case reflect.Interface, reflect.Pointer:
+		return value.IsNil()
+	default:
+		return value.IsZero()
+	}
+}
@@ -0,0 +1,58 @@
+package utils
+
+// Sparse fieldset query parameter
+const (
+	// PRODUCT_FIELDS_QUERY_PARAM selects the product fields of a response, e.g.
+	// ?fields=id,name,priceRange,variantPreview
+	PRODUCT_FIELDS_QUERY_PARAM = "fields"
+	// PRODUCT_ID_FIELD is always returned so clients can key sparse products
+	PRODUCT_ID_FIELD = "id"
+	// PRODUCT_INCLUDE_QUERY_PARAM selects the associations product detail loads, e.g.
+	// ?include=category,attributes,packageOptions
+	PRODUCT_INCLUDE_QUERY_PARAM = "include"
+)
+
+// Product response fields whose data needs its own load; the associations of include
+const (
+	PRODUCT_CATEGORY_FIELD        = "category"
+	PRODUCT_MEDIA_FIELD           = "media"
+	PRODUCT_ATTRIBUTES_FIELD      = "attributes"
+	PRODUCT_PACKAGE_OPTIONS_FIELD = "packageOptions"
+	PRODUCT_OPTIONS_FIELD         = "options"
+	PRODUCT_VARIANTS_FIELD        = "variants"
+)
+
+// Sparse fieldset error codes and messages
+const (
+	INVALID_PRODUCT_FIELDS_CODE = "INVALID_PRODUCT_FIELDS"
+
+	INVALID_PRODUCT_FIELDS_MSG = "fields must be a comma-separated list of response field names"
+	UNKNOWN_PRODUCT_FIELD_MSG  = "fields lists an unknown field: %s"
+
+	INVALID_PRODUCT_INCLUDE_CODE = "INVALID_PRODUCT_INCLUDE"
+
+	INVALID_PRODUCT_INCLUDE_MSG = "include must be a comma-separated list of category, " +
+		"attributes, packageOptions, options, variants or media"
+	UNKNOWN_PRODUCT_INCLUDE_MSG = "include lists an unknown association: %s"
+)
+
+// ProductCommerceFields are filled from the variant aggregation
+var ProductCommerceFields = []string{
+	"hasVariants",
+	"price",
+	"priceRange",
+	"allowPurchase",
+	"isPopular",
+	"variantPreview",
+	"isWishlisted",
+}
+
+// ProductAssociations can be loaded on demand with include
+var ProductAssociations = []string{
+	PRODUCT_CATEGORY_FIELD,
+	PRODUCT_ATTRIBUTES_FIELD,
+	PRODUCT_PACKAGE_OPTIONS_FIELD,
+	PRODUCT_OPTIONS_FIELD,
+	PRODUCT_VARIANTS_FIELD,
+	PRODUCT_MEDIA_FIELD,
+}
@@ -0,0 +1,140 @@
+package utils
+
+import (
+	"context"
+	"strings"
+
+	"ecommerce-be/common/i18n"
+	"ecommerce-be/common/reqctx"
+	"ecommerce-be/product/entity"
+	"ecommerce-be/product/model"
+)
+
+// ContentLocale holds the ?locale override in the request context
+var ContentLocale = reqctx.NewKey[string]("productContentLocale")
+
+// ProductLocalization is the translated content stored for one product, by locale
+type ProductLocalization struct {
+	Content     map[string]entity.ProductTranslation
+	OptionNames map[string]map[string]string // locale -> option name -> display name
+}
+
+// NewProductLocalization creates an empty ProductLocalization
+func NewProductLocalization() *ProductLocalization {
+	return &ProductLocalization{
+		Content:     make(map[string]entity.ProductTranslation),
+		OptionNames: make(map[string]map[string]string),
+	}
+}
+
+// AddOptionName records an option display name for a locale
+func (l *ProductLocalization) AddOptionName(locale, optionName, displayName string) {
+	if l.OptionNames[locale] == nil {
+		l.OptionNames[locale] = make(map[string]string)
+	}
+	l.OptionNames[locale][optionName] = displayName
+}
+
+// PickLocale returns the first candidate with any translated content for the product
+func (l *ProductLocalization) PickLocale(candidates []string) (string, bool) {
+	for _, locale := range candidates {
+		if _, ok := l.Content[locale]; ok {
+			return locale, true
+		}
+		if _, ok := l.OptionNames[locale]; ok {
+			return locale, true
+		}
+	}
+	return "", false
+}
+
+// RequestedContentLocales returns the locales product content was requested in, most
+// preferred first: the ?locale override, else the Accept-Language tags, else the
+// negotiated response language
+func RequestedContentLocales(ctx context.Context) []string {
+	if locale, ok := ContentLocale.Get(ctx); ok && locale != "" {
+		return []string{locale}
+	}
+	if tags := i18n.PreferredLanguagesFromContext(ctx); len(tags) > 0 {
+		return tags
+	}
+	return []string{i18n.Language(ctx)}
+}
+
+// LocaleCandidates expands requested locales into lookup order, each tag followed by its
+// primary language ("es-mx" -> "es-mx", "es"). The default locale or "*" ends the list
+// because default-locale content is stored on the product itself.
+func LocaleCandidates(requested []string, defaultLocale string) []string {
+	defaultLocale = i18n.NormalizeTag(defaultLocale)
+	seen := make(map[string]struct{}, len(requested)*2)
+	candidates := make([]string, 0, len(requested)*2)
+
+	add := func(locale string) bool {
+		if locale == defaultLocale {
+			return false
+		}
+		if _, dup := seen[locale]; !dup {
+			seen[locale] = struct{}{}
+			candidates = append(candidates, locale)
+		}
+		return true
+	}
+
+	for _, raw := range requested {
+		locale := i18n.NormalizeTag(raw)
+		if locale == "" {
+			continue
+		}
+		if locale == "*" || !add(locale) {
+			break
+		}
+		if primary, _, found := strings.Cut(locale, "-"); found && !add(primary) {
+			break
+		}
+	}
+	return candidates
+}
+
+// LocalizeProductResponse overwrites content fields with a locale's translation.
+// Blank translated fields and untranslated options keep the default-locale text.
+func LocalizeProductResponse(
+	resp *model.ProductResponse,
+	content *entity.ProductTranslation,
+	optionNames map[string]string,
+) {
+	if content != nil {
+		if content.Name != "" {
+			resp.Name = content.Name
+		}
+		if content.ShortDescription != "" {
+			resp.ShortDescription = content.ShortDescription
+		}
+		if content.LongDescription != "" {
+			resp.LongDescription = content.LongDescription
+		}
+	}
+	if len(optionNames) == 0 {
+		return
+	}
+
+	for i := range resp.Options {
+		if name, ok := optionNames[resp.Options[i].OptionName]; ok {
+			resp.Options[i].OptionDisplayName = name
+		}
+	}
+	if resp.VariantPreview != nil {
+		for i := range resp.VariantPreview.Options {
+			if name, ok := optionNames[resp.VariantPreview.Options[i].Name]; ok {
+				resp.VariantPreview.Options[i].DisplayName = name
+			}
+		}
+	}
+	for i := range resp.Variants {
+		selected := resp.Variants[i].SelectedOptions
+		for j := range selected {
+			if name, ok := optionNames[selected[j].OptionName]; ok {
+				selected[j].OptionDisplayName = name
+			}
+		}
+	}
+}
@@ -0,0 +1,38 @@
+package utils
+
+import (
+	"slices"
+	"strings"
+)
+
+// productModelMimeTypes are the 3D formats storefronts can render: glTF for web
+// viewers and USDZ for AR Quick Look
+var productModelMimeTypes = []string{
+	"model/gltf-binary",
+	"model/gltf+json",
+	"model/vnd.usdz+zip",
+}
+
+// NormalizeProductMediaType upper-cases a media type and defaults it to IMAGE
+func NormalizeProductMediaType(mediaType string) string {
+	mediaType = strings.ToUpper(strings.TrimSpace(mediaType))
+	if mediaType == "" {
+		return PRODUCT_MEDIA_TYPE_IMAGE
+	}
+	return mediaType
+}
+
+// ProductMediaMimeAllowed reports whether an uploaded file's MIME type can be
+// attached as the given media type
+func ProductMediaMimeAllowed(mediaType, mimeType string) bool {
+	mimeType = strings.ToLower(strings.TrimSpace(mimeType))
+	switch mediaType {
+	case PRODUCT_MEDIA_TYPE_IMAGE:
+		return strings.HasPrefix(mimeType, "image/")
+	case PRODUCT_MEDIA_TYPE_VIDEO:
+		return strings.HasPrefix(mimeType, "video/")
+	case PRODUCT_MEDIA_TYPE_MODEL_3D:
+		return slices.Contains(productModelMimeTypes, mimeType)
+	}
+	return false
+}
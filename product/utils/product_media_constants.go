@@ -0,0 +1,27 @@
+package utils
+
+// Product media types
+const (
+	PRODUCT_MEDIA_TYPE_IMAGE    = "IMAGE"
+	PRODUCT_MEDIA_TYPE_VIDEO    = "VIDEO"
+	PRODUCT_MEDIA_TYPE_MODEL_3D = "MODEL_3D"
+
+	// PRODUCT_MEDIA_EXTERNAL_ID_PREFIX prefixes the generated file ID of externally
+	// hosted videos, which have no file-module asset
+	PRODUCT_MEDIA_EXTERNAL_ID_PREFIX = "ext_"
+)
+
+// Product media type error codes
+const (
+	PRODUCT_MEDIA_TYPE_MISMATCH_CODE = "PRODUCT_MEDIA_TYPE_MISMATCH"
+)
+
+// Product media type messages
+const (
+	PRODUCT_MEDIA_TYPE_MISMATCH_MSG      = "The file does not match the media type"
+	PRODUCT_MEDIA_EXTERNAL_NOT_VIDEO_MSG = "externalUrl is only supported for VIDEO media"
+	PRODUCT_MEDIA_DURATION_NOT_VIDEO_MSG = "durationSeconds is only supported for VIDEO media"
+	PRODUCT_MEDIA_PRIMARY_NOT_IMAGE_MSG  = "Only IMAGE media can be the primary media"
+	PRODUCT_MEDIA_THUMBNAIL_IMAGE_MSG    = "thumbnailFileId must reference an image file"
+	PRODUCT_MEDIA_THUMBNAIL_ON_IMAGE_MSG = "thumbnailFileId is only supported for VIDEO and MODEL_3D media"
+)
@@ -0,0 +1,13 @@
+package utils
+
+import "strconv"
+
+// ParseRecommendationLimit parses the limit of a recommendation request, which must be
+// a number from 1 to RECOMMENDATION_MAX_LIMIT
+func ParseRecommendationLimit(raw string) (int, bool) {
+	limit, err := strconv.Atoi(raw)
+	if err != nil || limit < 1 || limit > RECOMMENDATION_MAX_LIMIT {
+		return 0, false
+	}
+	return limit, true
+}
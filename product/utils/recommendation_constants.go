@@ -0,0 +1,46 @@
+package utils
+
+// Recommendation routes. ALSO_BOUGHT_ROUTE is relative to /api/product and
+// RECOMMENDATIONS_ROUTE to /api/me.
+const (
+	ALSO_BOUGHT_ROUTE     = "/:productId/also-bought"
+	RECOMMENDATIONS_ROUTE = "/recommendations"
+)
+
+// Recommendation settings
+const (
+	// RECOMMENDATION_DEFAULT_LIMIT and RECOMMENDATION_MAX_LIMIT bound the products returned
+	RECOMMENDATION_DEFAULT_LIMIT = 10
+	RECOMMENDATION_MAX_LIMIT     = 30
+
+	// RECOMMENDATION_WINDOW_DAYS is how far back orders count towards recommendations
+	RECOMMENDATION_WINDOW_DAYS = 365
+
+	// CO_PURCHASE_MIN_CUSTOMERS is how many customers must have bought two products
+	// before one is shown as bought with the other
+	CO_PURCHASE_MIN_CUSTOMERS = 2
+
+	// CO_PURCHASE_MAX_PER_PRODUCT and USER_RECOMMENDATIONS_MAX_PER_SELLER cap the
+	// precomputed rows; reads never ask for more than RECOMMENDATION_MAX_LIMIT
+	CO_PURCHASE_MAX_PER_PRODUCT         = RECOMMENDATION_MAX_LIMIT
+	USER_RECOMMENDATIONS_MAX_PER_SELLER = RECOMMENDATION_MAX_LIMIT
+
+	// RECOMMENDATION_REBUILD_HOUR_UTC is when the nightly rebuild runs
+	RECOMMENDATION_REBUILD_HOUR_UTC = 2
+	RECOMMENDATION_REBUILD_JOB_NAME = "product_recommendation_rebuild"
+)
+
+// Recommendation field names
+const (
+	ALSO_BOUGHT_FIELD_NAME     = "alsoBought"
+	RECOMMENDATIONS_FIELD_NAME = "recommendations"
+)
+
+// Recommendation messages
+const (
+	ALSO_BOUGHT_RETRIEVED_MSG     = "Also bought products retrieved successfully"
+	RECOMMENDATIONS_RETRIEVED_MSG = "Recommendations retrieved successfully"
+
+	FAILED_TO_GET_ALSO_BOUGHT_MSG     = "Failed to get also bought products"
+	FAILED_TO_GET_RECOMMENDATIONS_MSG = "Failed to get recommendations"
+)
@@ -0,0 +1,48 @@
+package utils
+
+import (
+	"fmt"
+	"slices"
+
+	"ecommerce-be/product/entity"
+)
+
+// ValidateRelatedCuration checks that a product's pinned and excluded related products
+// do not include the product itself and that no product is both pinned and excluded
+func ValidateRelatedCuration(productID uint, pinnedIDs []uint, excludedIDs []uint) error {
+	if slices.Contains(pinnedIDs, productID) || slices.Contains(excludedIDs, productID) {
+		return fmt.Errorf("product %d cannot be related to itself", productID)
+	}
+	for _, pinnedID := range pinnedIDs {
+		if slices.Contains(excludedIDs, pinnedID) {
+			return fmt.Errorf("product %d cannot be both pinned and excluded", pinnedID)
+		}
+	}
+	return nil
+}
+
+// BuildRelatedProductOverrides turns a product's pinned and excluded related products
+// into override rows. Pins are positioned in the order given; exclusions have no position.
+func BuildRelatedProductOverrides(
+	productID uint,
+	pinnedIDs []uint,
+	excludedIDs []uint,
+) []entity.RelatedProductOverride {
+	overrides := make([]entity.RelatedProductOverride, 0, len(pinnedIDs)+len(excludedIDs))
+	for position, pinnedID := range pinnedIDs {
+		overrides = append(overrides, entity.RelatedProductOverride{
+			ProductID:        productID,
+			RelatedProductID: pinnedID,
+			Mode:             entity.RELATED_OVERRIDE_MODE_PIN,
+			Position:         position,
+		})
+	}
+	for _, excludedID := range excludedIDs {
+		overrides = append(overrides, entity.RelatedProductOverride{
+			ProductID:        productID,
+			RelatedProductID: excludedID,
+			Mode:             entity.RELATED_OVERRIDE_MODE_EXCLUDE,
+		})
+	}
+	return overrides
+}
@@ -0,0 +1,35 @@
+package utils
+
+// Related product curation routes (relative to /api/product)
+const (
+	RELATED_CURATION_ROUTE = "/:productId/related/curation"
+)
+
+// Related product curation settings
+const (
+	// RELATED_STRATEGY_MANUAL is the strategyUsed of related products the seller pinned
+	RELATED_STRATEGY_MANUAL = "manual"
+)
+
+// Related product curation field names
+const (
+	RELATED_CURATION_FIELD_NAME = "curation"
+)
+
+// Related product curation error codes
+const (
+	RELATED_CURATION_INVALID_CODE = "RELATED_CURATION_INVALID"
+)
+
+// Related product curation messages
+const (
+	RELATED_CURATION_INVALID_MSG = "Related product curation is invalid"
+
+	RELATED_CURATION_RETRIEVED_MSG = "Related product curation retrieved successfully"
+	RELATED_CURATION_UPDATED_MSG   = "Related product curation updated successfully"
+	RELATED_CURATION_CLEARED_MSG   = "Related product curation cleared successfully"
+
+	FAILED_TO_GET_RELATED_CURATION_MSG    = "Failed to get related product curation"
+	FAILED_TO_UPDATE_RELATED_CURATION_MSG = "Failed to update related product curation"
+	FAILED_TO_CLEAR_RELATED_CURATION_MSG  = "Failed to clear related product curation"
+)
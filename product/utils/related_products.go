@@ -0,0 +1,27 @@
+package utils
+
+import (
+	"slices"
+	"strings"
+)
+
+// NormalizeRelatedStrategies trims, de-duplicates and sorts a comma-separated strategy
+// list so equivalent requests share a cache entry. Empty means "all".
+func NormalizeRelatedStrategies(strategies string) string {
+	var normalized []string
+	for _, strategy := range strings.Split(strategies, ",") {
+		strategy = strings.TrimSpace(strategy)
+		if strategy == "" || slices.Contains(normalized, strategy) {
+			continue
+		}
+		if strategy == "all" {
+			return "all"
+		}
+		normalized = append(normalized, strategy)
+	}
+	if len(normalized) == 0 {
+		return "all"
+	}
+	slices.Sort(normalized)
+	return strings.Join(normalized, ",")
+}
@@ -0,0 +1,77 @@
+package utils
+
+import (
+	"slices"
+	"strings"
+
+	"ecommerce-be/common/db"
+)
+
+// NormalizeSearchTerm lowercases a term and collapses its whitespace, the form synonyms
+// and stop words are stored and compared in
+func NormalizeSearchTerm(term string) string {
+	return strings.Join(strings.Fields(strings.ToLower(term)), " ")
+}
+
+// ExpandSearchQuery splits a search query into terms a product must all match. Each
+// term lists its alternatives: the word itself and, when the word (or a run of words)
+// is in a synonym group, every term of the group. Stop words are dropped unless the
+// query has nothing else. At most SEARCH_MAX_QUERY_TERMS terms are kept.
+func ExpandSearchQuery(query string, stopWords []string, synonymGroups [][]string) [][]string {
+	words := strings.Fields(strings.ToLower(query))
+	if len(words) == 0 {
+		return nil
+	}
+
+	groupByTerm := make(map[string]int)
+	longestTerm := 1
+	for i, group := range synonymGroups {
+		for _, term := range group {
+			groupByTerm[term] = i
+			longestTerm = max(longestTerm, len(strings.Fields(term)))
+		}
+	}
+
+	expand := func(skipStopWords bool) [][]string {
+		var terms [][]string
+		for i := 0; i < len(words) && len(terms) < SEARCH_MAX_QUERY_TERMS; {
+			// The longest run of words that is a synonym wins, stop words included
+			matched := false
+			for n := min(longestTerm, len(words)-i); n > 0; n-- {
+				if group, ok := groupByTerm[strings.Join(words[i:i+n], " ")]; ok {
+					terms = append(terms, slices.Clone(synonymGroups[group]))
+					i += n
+					matched = true
+					break
+				}
+			}
+			if matched {
+				continue
+			}
+			if !skipStopWords || !slices.Contains(stopWords, words[i]) {
+				terms = append(terms, []string{words[i]})
+			}
+			i++
+		}
+		return terms
+	}
+
+	if terms := expand(true); len(terms) > 0 {
+		return terms
+	}
+	return expand(false)
+}
+
+// SearchPatterns returns the ILIKE patterns matching any of the alternatives
+func SearchPatterns(alternatives ...string) db.StringArray {
+	patterns := make(db.StringArray, 0, len(alternatives))
+	for _, alternative := range alternatives {
+		patterns = append(patterns, "%"+alternative+"%")
+	}
+	return patterns
+}
+
+// AllSearchPatterns returns the ILIKE patterns matching any alternative of any term
+func AllSearchPatterns(terms [][]string) db.StringArray {
+	return SearchPatterns(slices.Concat(terms...)...)
+}
@@ -0,0 +1,113 @@
+package utils
+
+import (
+	"math"
+
+	"ecommerce-be/product/entity"
+	"ecommerce-be/product/mapper"
+	"ecommerce-be/product/model"
+)
+
+// SettingsWeights returns the relevance weights configured in search settings
+func SettingsWeights(settings entity.SearchSettings) model.RelevanceWeights {
+	return model.RelevanceWeights{
+		Name:        settings.NameWeight,
+		Tags:        settings.TagsWeight,
+		Description: settings.DescriptionWeight,
+	}
+}
+
+// ResolveListingSort returns the sort for a product listing. An explicit sortBy wins;
+// otherwise the seller's default applies. Listings have no search term, so a relevance
+// default lists newest first.
+func ResolveListingSort(
+	sortBy, sortOrder string,
+	settings entity.SearchSettings,
+) (string, string) {
+	if sortBy != "" {
+		return sortBy, sortOrder
+	}
+	switch settings.DefaultSort {
+	case entity.LISTING_SORT_PRICE:
+		return "price", settings.DefaultSortOrder
+	case entity.LISTING_SORT_POPULARITY:
+		return "popularity", settings.DefaultSortOrder
+	case entity.LISTING_SORT_RELEVANCE:
+		return "createdAt", "desc"
+	default:
+		return "createdAt", settings.DefaultSortOrder
+	}
+}
+
+// ResolveSearchRanking returns the ordering for a search. An explicit sortBy wins;
+// otherwise the seller's default applies, with relevance using the seller's weights.
+func ResolveSearchRanking(
+	sortBy, sortOrder string,
+	settings entity.SearchSettings,
+) model.SearchRanking {
+	ranking := model.SearchRanking{Weights: SettingsWeights(settings)}
+	switch {
+	case sortBy != "":
+		ranking.SortBy, ranking.SortOrder = sortBy, sortOrder
+	case settings.DefaultSort == entity.LISTING_SORT_RELEVANCE:
+		ranking.SortBy = RELEVANCE_SORT_KEY
+	default:
+		ranking.SortBy, ranking.SortOrder = ResolveListingSort("", "", settings)
+	}
+	return ranking
+}
+
+// ResolveOutOfStockDisplay returns the seller's out-of-stock display, showing
+// out-of-stock products for settings saved before it existed
+func ResolveOutOfStockDisplay(settings entity.SearchSettings) entity.OutOfStockDisplay {
+	if !settings.OutOfStockDisplay.IsValid() {
+		return entity.OUT_OF_STOCK_SHOW
+	}
+	return settings.OutOfStockDisplay
+}
+
+// ResolveStockFilter returns the stock filter for a listing or search and whether
+// out-of-stock products are demoted. An explicit inStock wins; otherwise the seller's
+// out-of-stock display applies.
+func ResolveStockFilter(inStock *bool, settings entity.SearchSettings) (*bool, bool) {
+	if inStock != nil {
+		return inStock, false
+	}
+	switch ResolveOutOfStockDisplay(settings) {
+	case entity.OUT_OF_STOCK_HIDE:
+		onlyInStock := true
+		return &onlyInStock, false
+	case entity.OUT_OF_STOCK_DEMOTE:
+		return nil, true
+	default:
+		return nil, false
+	}
+}
+
+// ScoreSearchMatch converts matched fields into a 0-1 relevance score using the weights
+// (share of the total weight that matched) and lists the matched fields
+func ScoreSearchMatch(
+	match mapper.SearchMatchRow,
+	weights model.RelevanceWeights,
+) (float64, []string) {
+	matched := make([]string, 0, 3)
+	var score float64
+	if match.NameMatch {
+		matched = append(matched, SEARCH_MATCHED_FIELD_NAME)
+		score += weights.Name
+	}
+	if match.TagsMatch {
+		matched = append(matched, SEARCH_MATCHED_FIELD_TAGS)
+		score += weights.Tags
+	}
+	if match.DescriptionMatch {
+		matched = append(matched, SEARCH_MATCHED_FIELD_DESCRIPTION)
+		score += weights.Description
+	}
+
+	total := weights.Name + weights.Tags + weights.Description
+	if total <= 0 {
+		return 0, matched
+	}
+	return math.Round(score/total*100) / 100, matched
+}
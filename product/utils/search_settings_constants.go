@@ -0,0 +1,81 @@
+package utils
+
+// Search settings route
+const (
+	// SEARCH_SETTINGS_ROUTE is relative to /api/product
+	SEARCH_SETTINGS_ROUTE = "/search-settings"
+	// SEARCH_SYNONYMS_ROUTE is relative to /api/product
+	SEARCH_SYNONYMS_ROUTE = "/search-settings/synonyms"
+	// SEARCH_SYNONYM_ROUTE is relative to /api/product
+	SEARCH_SYNONYM_ROUTE = "/search-settings/synonyms/:synonymGroupId"
+	// SEARCH_STOP_WORDS_ROUTE is relative to /api/product
+	SEARCH_STOP_WORDS_ROUTE = "/search-settings/stop-words"
+)
+
+// Search query expansion limits
+const (
+	// SEARCH_MAX_QUERY_TERMS caps the terms a search query expands to
+	SEARCH_MAX_QUERY_TERMS = 8
+	// SEARCH_MAX_SYNONYM_GROUPS caps the synonym groups per seller
+	SEARCH_MAX_SYNONYM_GROUPS = 500
+	// SEARCH_MAX_STOP_WORDS caps the stop words per seller
+	SEARCH_MAX_STOP_WORDS = 200
+)
+
+// Search sort keys
+const (
+	// RELEVANCE_SORT_KEY ranks search results by weighted field matches
+	RELEVANCE_SORT_KEY = "relevance"
+
+	SEARCH_MATCHED_FIELD_NAME        = "name"
+	SEARCH_MATCHED_FIELD_TAGS        = "tags"
+	SEARCH_MATCHED_FIELD_DESCRIPTION = "description"
+)
+
+// Search settings error codes
+const (
+	SEARCH_WEIGHTS_ALL_ZERO_CODE = "SEARCH_WEIGHTS_ALL_ZERO"
+	INVALID_SEARCH_SORT_CODE     = "INVALID_SEARCH_SORT"
+
+	SEARCH_SYNONYM_GROUP_NOT_FOUND_CODE = "SEARCH_SYNONYM_GROUP_NOT_FOUND"
+	INVALID_SEARCH_SYNONYMS_CODE        = "INVALID_SEARCH_SYNONYMS"
+	SEARCH_SYNONYM_CONFLICT_CODE        = "SEARCH_SYNONYM_CONFLICT"
+	SEARCH_SYNONYM_LIMIT_CODE           = "SEARCH_SYNONYM_LIMIT_REACHED"
+)
+
+// Search settings messages
+const (
+	SEARCH_WEIGHTS_ALL_ZERO_MSG = "At least one relevance weight must be greater than zero"
+	INVALID_SEARCH_SORT_MSG     = "Unsupported sortBy; use newest, createdAt, updatedAt, name, " +
+		"price, popularity, sales, discount or relevance"
+
+	SEARCH_SETTINGS_RETRIEVED_MSG = "Search settings retrieved successfully"
+	SEARCH_SETTINGS_UPDATED_MSG   = "Search settings updated successfully"
+
+	FAILED_TO_GET_SEARCH_SETTINGS_MSG    = "Failed to get search settings"
+	FAILED_TO_UPDATE_SEARCH_SETTINGS_MSG = "Failed to update search settings"
+
+	SEARCH_SYNONYM_GROUP_NOT_FOUND_MSG = "Synonym group not found"
+	INVALID_SEARCH_SYNONYMS_MSG        = "A synonym group needs at least two different terms"
+	SEARCH_SYNONYM_CONFLICT_MSG        = "A term can only belong to one synonym group"
+	SEARCH_SYNONYM_LIMIT_MSG           = "Synonym group limit reached"
+
+	SEARCH_SYNONYMS_RETRIEVED_MSG      = "Synonym groups retrieved successfully"
+	SEARCH_SYNONYM_GROUP_CREATED_MSG   = "Synonym group created successfully"
+	SEARCH_SYNONYM_GROUP_UPDATED_MSG   = "Synonym group updated successfully"
+	SEARCH_SYNONYM_GROUP_DELETED_MSG   = "Synonym group deleted successfully"
+	SEARCH_STOP_WORDS_RETRIEVED_MSG    = "Stop words retrieved successfully"
+	SEARCH_STOP_WORDS_UPDATED_MSG      = "Stop words updated successfully"
+	FAILED_TO_GET_SEARCH_SYNONYMS_MSG  = "Failed to get synonym groups"
+	FAILED_TO_SAVE_SEARCH_SYNONYMS_MSG = "Failed to save synonym group"
+	FAILED_TO_GET_STOP_WORDS_MSG       = "Failed to get stop words"
+	FAILED_TO_UPDATE_STOP_WORDS_MSG    = "Failed to update stop words"
+)
+
+// Search settings response field names
+const (
+	SEARCH_SETTINGS_FIELD_NAME      = "searchSettings"
+	SEARCH_SYNONYM_GROUP_FIELD_NAME = "synonymGroup"
+	SEARCH_SYNONYMS_FIELD_NAME      = "synonymGroups"
+	SEARCH_STOP_WORDS_FIELD_NAME    = "stopWords"
+)
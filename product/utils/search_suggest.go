@@ -0,0 +1,34 @@
+package utils
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// NormalizeSuggestPrefix normalizes a search-as-you-type query the way search terms
+// are. It reports false when the result is shorter than SEARCH_SUGGEST_MIN_PREFIX_LENGTH
+// or longer than SEARCH_SUGGEST_MAX_PREFIX_LENGTH characters.
+func NormalizeSuggestPrefix(query string) (string, bool) {
+	prefix := NormalizeSearchTerm(query)
+	length := utf8.RuneCountInString(prefix)
+	if length < SEARCH_SUGGEST_MIN_PREFIX_LENGTH || length > SEARCH_SUGGEST_MAX_PREFIX_LENGTH {
+		return "", false
+	}
+	return prefix, true
+}
+
+// SuggestPatterns returns the LIKE patterns matching text that starts with the prefix
+// and text with a word starting with it. LIKE wildcards in the prefix are matched
+// literally.
+func SuggestPatterns(prefix string) (string, string) {
+	escaped := strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(prefix)
+	return escaped + "%", "% " + escaped + "%"
+}
+
+// ClampSuggestLimit returns the per-kind suggestion limit, defaulting when unset
+func ClampSuggestLimit(limit int) int {
+	if limit <= 0 {
+		return SEARCH_SUGGEST_DEFAULT_LIMIT
+	}
+	return min(limit, SEARCH_SUGGEST_MAX_LIMIT)
+}
@@ -0,0 +1,42 @@
+package utils
+
+// Search suggestion routes
+const (
+	// SEARCH_SUGGEST_ROUTE is relative to /api/product
+	SEARCH_SUGGEST_ROUTE = "/suggest"
+)
+
+// Search suggestion limits
+const (
+	// SEARCH_SUGGEST_MIN_PREFIX_LENGTH and SEARCH_SUGGEST_MAX_PREFIX_LENGTH bound the
+	// prefix in characters after normalization
+	SEARCH_SUGGEST_MIN_PREFIX_LENGTH = 2
+	SEARCH_SUGGEST_MAX_PREFIX_LENGTH = 50
+	// SEARCH_SUGGEST_DEFAULT_LIMIT and SEARCH_SUGGEST_MAX_LIMIT apply per suggestion kind
+	SEARCH_SUGGEST_DEFAULT_LIMIT = 5
+	SEARCH_SUGGEST_MAX_LIMIT     = 10
+	// SEARCH_SUGGEST_SIMILARITY_THRESHOLD is the pg_trgm word similarity a typo match needs
+	SEARCH_SUGGEST_SIMILARITY_THRESHOLD = "0.4"
+)
+
+// Search suggestion kinds, as returned by the suggestion query
+const (
+	SEARCH_SUGGEST_KIND_PRODUCT  = "product"
+	SEARCH_SUGGEST_KIND_BRAND    = "brand"
+	SEARCH_SUGGEST_KIND_CATEGORY = "category"
+)
+
+// Search suggestion error codes and messages
+const (
+	INVALID_SUGGEST_QUERY_CODE = "INVALID_SUGGEST_QUERY"
+
+	INVALID_SUGGEST_QUERY_MSG = "Query parameter 'q' must be between 2 and 50 characters"
+
+	SEARCH_SUGGESTIONS_FOUND_MSG         = "Suggestions retrieved successfully"
+	FAILED_TO_GET_SEARCH_SUGGESTIONS_MSG = "Failed to get suggestions"
+)
+
+// Search suggestion response field names
+const (
+	SEARCH_SUGGESTIONS_FIELD_NAME = "suggestions"
+)
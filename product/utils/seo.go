@@ -0,0 +1,30 @@
+package utils
+
+import (
+	"strconv"
+	"strings"
+
+	"ecommerce-be/common/helper"
+)
+
+// SlugBase slugifies a name for use as a generated slug, truncated to leave room for
+// a uniqueness suffix. Returns fallback when the name has no usable characters.
+func SlugBase(name, fallback string) string {
+	base := helper.GenerateSlug(name)
+	if len(base) > SLUG_BASE_MAX_LENGTH {
+		base = strings.TrimRight(base[:SLUG_BASE_MAX_LENGTH], "-")
+	}
+	if base == "" {
+		return fallback
+	}
+	return base
+}
+
+// SlugCandidate returns the slug to probe on the given attempt: the base itself
+// first, then "base-2", "base-3", ...
+func SlugCandidate(base string, attempt int) string {
+	if attempt == 0 {
+		return base
+	}
+	return base + "-" + strconv.Itoa(attempt+1)
+}
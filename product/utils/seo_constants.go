@@ -0,0 +1,44 @@
+package utils
+
+import "ecommerce-be/common/constants"
+
+// SEO slug routes (relative to /api/product and /api/product/category)
+const (
+	SLUG_ROUTE = "/by-slug/:slug"
+	SLUG_PARAM = "slug"
+
+	// *_SLUG_PATH prefix the current slug in the Location of a slug redirect
+	PRODUCT_SLUG_PATH  = constants.APIBaseProduct + "/by-slug/"
+	CATEGORY_SLUG_PATH = constants.APIBaseProduct + "/category/by-slug/"
+)
+
+// SEO slug settings
+const (
+	// SLUG_ENTITY_* are the slug_redirect.entity_type values
+	SLUG_ENTITY_PRODUCT  = "PRODUCT"
+	SLUG_ENTITY_CATEGORY = "CATEGORY"
+
+	// SLUG_MAX_LENGTH matches the slug columns; generated slugs leave room for a suffix
+	SLUG_MAX_LENGTH      = 200
+	SLUG_BASE_MAX_LENGTH = 180
+
+	// SLUG_MAX_SUFFIX_ATTEMPTS bounds the "-2", "-3", ... probes before a random suffix
+	SLUG_MAX_SUFFIX_ATTEMPTS = 20
+
+	// Fallback slug bases for names without any latin letters or digits
+	PRODUCT_SLUG_FALLBACK  = "product"
+	CATEGORY_SLUG_FALLBACK = "category"
+)
+
+// SEO slug error codes
+const (
+	PRODUCT_SLUG_TAKEN_CODE  = "PRODUCT_SLUG_TAKEN"
+	CATEGORY_SLUG_TAKEN_CODE = "CATEGORY_SLUG_TAKEN"
+)
+
+// SEO slug messages
+const (
+	PRODUCT_SLUG_TAKEN_MSG  = "Another product already uses this slug"
+	CATEGORY_SLUG_TAKEN_MSG = "Another category already uses this slug"
+	SLUG_MOVED_MSG          = "This slug has moved; follow the Location header"
+)
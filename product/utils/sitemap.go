@@ -0,0 +1,17 @@
+package utils
+
+// SitemapPageCount returns the number of sitemap files needed for total URLs. An
+// empty sitemap still has one (empty) page.
+func SitemapPageCount(total int) int {
+	if total <= SITEMAP_PAGE_SIZE {
+		return 1
+	}
+	return (total + SITEMAP_PAGE_SIZE - 1) / SITEMAP_PAGE_SIZE
+}
+
+// SitemapPageBounds returns the [start, end) entry range of a 1-based sitemap page
+func SitemapPageBounds(page, total int) (int, int) {
+	start := min((page-1)*SITEMAP_PAGE_SIZE, total)
+	end := min(start+SITEMAP_PAGE_SIZE, total)
+	return start, end
+}
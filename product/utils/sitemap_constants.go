@@ -0,0 +1,39 @@
+package utils
+
+// Sitemap route (registered at the site root, not under /api/product)
+const (
+	SITEMAP_ROUTE      = "/sitemap.xml"
+	SITEMAP_PAGE_PARAM = "page"
+)
+
+// Sitemap settings
+const (
+	// SITEMAP_PAGE_SIZE is the sitemap protocol's limit of URLs per file
+	SITEMAP_PAGE_SIZE = 50000
+
+	// Storefront paths the sitemap links to, followed by the slug
+	SITEMAP_PRODUCT_PATH_PREFIX  = "/products/"
+	SITEMAP_CATEGORY_PATH_PREFIX = "/categories/"
+
+	SITEMAP_XMLNS        = "http://www.sitemaps.org/schemas/sitemap/0.9"
+	SITEMAP_CONTENT_TYPE = "application/xml; charset=utf-8"
+	SITEMAP_DATE_LAYOUT  = "2006-01-02"
+
+	// Sitemaps are rebuilt by the refresh job every SITEMAP_REFRESH_INTERVAL_HOURS and
+	// evicted on product and category writes; the TTL outlives one job interval
+	SITEMAP_CACHE_NAMESPACE        = "product:sitemap"
+	SITEMAP_CACHE_TTL              = 12 * 3600
+	SITEMAP_REFRESH_INTERVAL_HOURS = 6
+	SITEMAP_REFRESH_JOB_NAME       = "product_sitemap_refresh"
+)
+
+// Sitemap error codes
+const (
+	SITEMAP_PAGE_NOT_FOUND_CODE = "SITEMAP_PAGE_NOT_FOUND"
+)
+
+// Sitemap messages
+const (
+	SITEMAP_PAGE_NOT_FOUND_MSG = "Sitemap page not found"
+	FAILED_TO_GET_SITEMAP_MSG  = "Failed to get sitemap"
+)
@@ -0,0 +1,164 @@
+package utils
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"ecommerce-be/common/validator"
+)
+
+// SKUPatternValues are the inputs an SKU pattern is rendered from
+type SKUPatternValues struct {
+	Brand    string
+	Category string
+	Name     string
+	// Base is the product's base SKU, for variant patterns
+	Base string
+	// Options are the variant's selected option values in option order
+	Options  []string
+	Sequence int64
+}
+
+// skuPatternPart is a literal run of a pattern or one {TOKEN:width} placeholder
+type skuPatternPart struct {
+	literal string
+	token   string
+	width   int
+}
+
+// ValidateSKUPattern checks that a pattern only uses the allowed tokens with sane
+// widths and that its literal text can appear in an SKU
+func ValidateSKUPattern(pattern string, allowedTokens []string) error {
+	parts, err := parseSKUPattern(pattern)
+	if err != nil {
+		return err
+	}
+	hasToken := false
+	for _, part := range parts {
+		if part.token == "" {
+			text := strings.Trim(part.literal, "-_.")
+			if text != "" && !validator.IsSKU(text) {
+				return fmt.Errorf("text %q cannot appear in an SKU", part.literal)
+			}
+			continue
+		}
+		if !slices.Contains(allowedTokens, part.token) {
+			return fmt.Errorf("{%s} cannot be used here; use %s",
+				part.token, strings.Join(allowedTokens, ", "))
+		}
+		hasToken = true
+	}
+	if !hasToken {
+		return fmt.Errorf("pattern needs at least one placeholder")
+	}
+	return nil
+}
+
+// SKUPatternUses reports whether a pattern contains the token
+func SKUPatternUses(pattern string, token string) bool {
+	parts, err := parseSKUPattern(pattern)
+	if err != nil {
+		return false
+	}
+	return slices.ContainsFunc(parts, func(part skuPatternPart) bool {
+		return part.token == token
+	})
+}
+
+// RenderSKUPattern renders a pattern such as "{BRAND:3}-{CAT:2}-{SEQ:5}". A width
+// abbreviates text tokens to their first letters and digits and zero-pads {SEQ};
+// without one, text keeps its words joined by '-'. Empty tokens drop their separator.
+func RenderSKUPattern(pattern string, values SKUPatternValues) (string, error) {
+	parts, err := parseSKUPattern(pattern)
+	if err != nil {
+		return "", err
+	}
+
+	var sku strings.Builder
+	for _, part := range parts {
+		switch part.token {
+		case "":
+			sku.WriteString(part.literal)
+		case SKU_TOKEN_BRAND:
+			sku.WriteString(abbreviateSKUPart(values.Brand, part.width))
+		case SKU_TOKEN_CATEGORY:
+			sku.WriteString(abbreviateSKUPart(values.Category, part.width))
+		case SKU_TOKEN_NAME:
+			sku.WriteString(abbreviateSKUPart(values.Name, part.width))
+		case SKU_TOKEN_BASE:
+			sku.WriteString(sanitizeSKUPart(values.Base))
+		case SKU_TOKEN_OPTIONS:
+			abbreviated := make([]string, 0, len(values.Options))
+			for _, option := range values.Options {
+				if value := abbreviateSKUPart(option, part.width); value != "" {
+					abbreviated = append(abbreviated, value)
+				}
+			}
+			sku.WriteString(strings.Join(abbreviated, "-"))
+		case SKU_TOKEN_SEQUENCE:
+			sku.WriteString(fmt.Sprintf("%0*d", part.width, values.Sequence))
+		default:
+			return "", fmt.Errorf("unknown placeholder {%s}", part.token)
+		}
+	}
+
+	rendered := collapseSKUSeparators(sku.String())
+	if !validator.IsSKU(rendered) {
+		return "", fmt.Errorf("pattern %q renders invalid SKU %q", pattern, rendered)
+	}
+	return rendered, nil
+}
+
+// abbreviateSKUPart sanitizes a value for an SKU and, with a width, keeps only its
+// first width letters and digits
+func abbreviateSKUPart(value string, width int) string {
+	part := sanitizeSKUPart(value)
+	if width == 0 {
+		return part
+	}
+	part = strings.ReplaceAll(part, "-", "")
+	if len(part) > width {
+		part = part[:width]
+	}
+	return part
+}
+
+// parseSKUPattern splits a pattern into literal runs and placeholders. Token names are
+// case-insensitive.
+func parseSKUPattern(pattern string) ([]skuPatternPart, error) {
+	var parts []skuPatternPart
+	rest := pattern
+	for rest != "" {
+		start := strings.IndexByte(rest, '{')
+		if start < 0 {
+			parts = append(parts, skuPatternPart{literal: rest})
+			break
+		}
+		if start > 0 {
+			parts = append(parts, skuPatternPart{literal: rest[:start]})
+		}
+		end := strings.IndexByte(rest[start:], '}')
+		if end < 0 {
+			return nil, fmt.Errorf("unclosed placeholder in %q", pattern)
+		}
+
+		name, widthText, hasWidth := strings.Cut(rest[start+1:start+end], ":")
+		part := skuPatternPart{token: strings.ToUpper(strings.TrimSpace(name))}
+		if part.token == "" {
+			return nil, fmt.Errorf("empty placeholder in %q", pattern)
+		}
+		if hasWidth {
+			width, err := strconv.Atoi(strings.TrimSpace(widthText))
+			if err != nil || width < 1 || width > SKU_PATTERN_MAX_WIDTH {
+				return nil, fmt.Errorf("width of {%s} must be 1 to %d",
+					part.token, SKU_PATTERN_MAX_WIDTH)
+			}
+			part.width = width
+		}
+		parts = append(parts, part)
+		rest = rest[start+end+1:]
+	}
+	return parts, nil
+}
@@ -0,0 +1,70 @@
+package utils
+
+// SKU settings routes (relative to /api/product)
+const (
+	SKU_SETTINGS_ROUTE         = "/sku-settings"
+	SKU_SETTINGS_PREVIEW_ROUTE = "/sku-settings/preview"
+)
+
+// SKU pattern placeholders
+const (
+	SKU_TOKEN_BRAND    = "BRAND"
+	SKU_TOKEN_CATEGORY = "CAT"
+	SKU_TOKEN_NAME     = "NAME"
+	SKU_TOKEN_SEQUENCE = "SEQ"
+	// SKU_TOKEN_BASE and SKU_TOKEN_OPTIONS are only available to variant patterns
+	SKU_TOKEN_BASE    = "BASE"
+	SKU_TOKEN_OPTIONS = "OPTIONS"
+)
+
+// SKU generation settings
+const (
+	// SKU_PATTERN_MAX_WIDTH caps the width of one placeholder
+	SKU_PATTERN_MAX_WIDTH = 20
+
+	// SKU_BASE_MAX_LENGTH is the longest base SKU a product accepts
+	SKU_BASE_MAX_LENGTH = 50
+
+	// VARIANT_SKU_MAX_LENGTH is the longest variant SKU a variant accepts
+	VARIANT_SKU_MAX_LENGTH = 255
+
+	// SKU_GENERATE_MAX_ATTEMPTS bounds the retries when a generated SKU is taken
+	SKU_GENERATE_MAX_ATTEMPTS = 20
+)
+
+// ProductSKUTokens are the placeholders a base SKU pattern may use
+var ProductSKUTokens = []string{
+	SKU_TOKEN_BRAND, SKU_TOKEN_CATEGORY, SKU_TOKEN_NAME, SKU_TOKEN_SEQUENCE,
+}
+
+// VariantSKUTokens are the placeholders a variant SKU pattern may use
+var VariantSKUTokens = []string{
+	SKU_TOKEN_BRAND, SKU_TOKEN_CATEGORY, SKU_TOKEN_NAME, SKU_TOKEN_SEQUENCE,
+	SKU_TOKEN_BASE, SKU_TOKEN_OPTIONS,
+}
+
+// SKU settings field names
+const (
+	SKU_SETTINGS_FIELD_NAME = "skuSettings"
+	SKU_PREVIEW_FIELD_NAME  = "preview"
+)
+
+// SKU settings error codes
+const (
+	SKU_PATTERN_INVALID_CODE   = "SKU_PATTERN_INVALID"
+	SKU_GENERATION_FAILED_CODE = "SKU_GENERATION_FAILED"
+)
+
+// SKU settings messages
+const (
+	SKU_PATTERN_INVALID_MSG   = "SKU pattern is invalid"
+	SKU_GENERATION_FAILED_MSG = "Could not generate an unused SKU; set the SKU explicitly"
+
+	SKU_SETTINGS_RETRIEVED_MSG = "SKU settings retrieved successfully"
+	SKU_SETTINGS_UPDATED_MSG   = "SKU settings updated successfully"
+	SKU_PREVIEW_GENERATED_MSG  = "SKU preview generated successfully"
+
+	FAILED_TO_GET_SKU_SETTINGS_MSG    = "Failed to get SKU settings"
+	FAILED_TO_UPDATE_SKU_SETTINGS_MSG = "Failed to update SKU settings"
+	FAILED_TO_PREVIEW_SKU_MSG         = "Failed to preview SKU"
+)
@@ -0,0 +1,78 @@
+package utils
+
+import (
+	"slices"
+	"strings"
+
+	"ecommerce-be/product/entity"
+	"ecommerce-be/product/model"
+)
+
+// NormalizeSponsoredKeywords lower-cases, trims and de-duplicates placement keywords
+func NormalizeSponsoredKeywords(keywords []string) []string {
+	normalized := make([]string, 0, len(keywords))
+	for _, keyword := range keywords {
+		keyword = strings.ToLower(strings.TrimSpace(keyword))
+		if keyword != "" && !slices.Contains(normalized, keyword) {
+			normalized = append(normalized, keyword)
+		}
+	}
+	return normalized
+}
+
+// SponsoredPlacementMatches reports whether a placement targets a search: the search
+// text contains one of its keywords, or the search is filtered to its category
+func SponsoredPlacementMatches(
+	placement entity.SponsoredPlacement,
+	query string,
+	categoryID *uint,
+) bool {
+	if placement.CategoryID != nil && categoryID != nil && *placement.CategoryID == *categoryID {
+		return true
+	}
+	query = strings.ToLower(query)
+	for _, keyword := range placement.Keywords {
+		if keyword != "" && strings.Contains(query, keyword) {
+			return true
+		}
+	}
+	return false
+}
+
+// SponsoredPageIndex returns where a placement's absolute result position falls on the
+// given page, or false when it belongs to another page
+func SponsoredPageIndex(position, page, limit int) (int, bool) {
+	index := position - 1 - (page-1)*limit
+	return index, index >= 0 && index < limit
+}
+
+// InjectSponsoredResults inserts sponsored results at their page indexes, ordered by
+// index, and drops organic results for the same products so nothing is listed twice.
+// Sponsored results come on top of the organic page size.
+func InjectSponsoredResults(
+	organic []model.SearchResult,
+	sponsored map[int]model.SearchResult,
+) []model.SearchResult {
+	if len(sponsored) == 0 {
+		return organic
+	}
+
+	sponsoredProducts := make(map[uint]bool, len(sponsored))
+	indexes := make([]int, 0, len(sponsored))
+	for index, result := range sponsored {
+		sponsoredProducts[result.ID] = true
+		indexes = append(indexes, index)
+	}
+	slices.Sort(indexes)
+
+	results := make([]model.SearchResult, 0, len(organic)+len(sponsored))
+	for _, result := range organic {
+		if !sponsoredProducts[result.ID] {
+			results = append(results, result)
+		}
+	}
+	for _, index := range indexes {
+		results = slices.Insert(results, min(index, len(results)), sponsored[index])
+	}
+	return results
+}
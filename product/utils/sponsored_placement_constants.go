@@ -0,0 +1,55 @@
+package utils
+
+import "time"
+
+// Sponsored placement routes
+const (
+	// SPONSORED_PLACEMENT_ROUTE is relative to /api/product
+	SPONSORED_PLACEMENT_ROUTE            = "/sponsored-placement"
+	SPONSORED_PLACEMENT_IMPRESSION_ROUTE = "/:placementId/impression"
+	SPONSORED_PLACEMENT_CLICK_ROUTE      = "/:placementId/click"
+)
+
+// Sponsored placement limits
+const (
+	// SPONSORED_MAX_POSITION is the deepest result position a placement can target
+	SPONSORED_MAX_POSITION = 50
+
+	// Frequency caps count impressions per shopper over this window
+	// Key format: sponsored:freq:{placementId}:{viewer}
+	SPONSORED_FREQUENCY_KEY_PREFIX = "sponsored:freq:"
+	SPONSORED_FREQUENCY_WINDOW     = 24 * time.Hour
+)
+
+// Sponsored placement error codes
+const (
+	SPONSORED_PLACEMENT_NOT_FOUND_CODE      = "SPONSORED_PLACEMENT_NOT_FOUND"
+	SPONSORED_PLACEMENT_NO_TARGET_CODE      = "SPONSORED_PLACEMENT_NO_TARGET"
+	SPONSORED_PLACEMENT_INVALID_WINDOW_CODE = "SPONSORED_PLACEMENT_INVALID_WINDOW"
+)
+
+// Sponsored placement messages
+const (
+	SPONSORED_PLACEMENT_NOT_FOUND_MSG      = "Sponsored placement not found"
+	SPONSORED_PLACEMENT_NO_TARGET_MSG      = "A sponsored placement needs keywords or a category to match"
+	SPONSORED_PLACEMENT_INVALID_WINDOW_MSG = "endsAt must be after startsAt"
+
+	SPONSORED_PLACEMENT_CREATED_MSG    = "Sponsored placement created successfully"
+	SPONSORED_PLACEMENT_UPDATED_MSG    = "Sponsored placement updated successfully"
+	SPONSORED_PLACEMENT_DELETED_MSG    = "Sponsored placement deleted successfully"
+	SPONSORED_PLACEMENTS_RETRIEVED_MSG = "Sponsored placements retrieved successfully"
+	SPONSORED_IMPRESSION_RECORDED_MSG  = "Impression recorded"
+	SPONSORED_CLICK_RECORDED_MSG       = "Click recorded"
+
+	FAILED_TO_CREATE_SPONSORED_PLACEMENT_MSG = "Failed to create sponsored placement"
+	FAILED_TO_UPDATE_SPONSORED_PLACEMENT_MSG = "Failed to update sponsored placement"
+	FAILED_TO_DELETE_SPONSORED_PLACEMENT_MSG = "Failed to delete sponsored placement"
+	FAILED_TO_GET_SPONSORED_PLACEMENTS_MSG   = "Failed to get sponsored placements"
+	FAILED_TO_RECORD_SPONSORED_EVENT_MSG     = "Failed to record sponsored placement event"
+)
+
+// Sponsored placement response field names
+const (
+	SPONSORED_PLACEMENT_FIELD_NAME  = "placement"
+	SPONSORED_PLACEMENTS_FIELD_NAME = "placements"
+)
@@ -0,0 +1,43 @@
+package utils
+
+// Product translation route and parameters
+const (
+	// PRODUCT_TRANSLATION_ROUTE is relative to /api/product/:productId
+	PRODUCT_TRANSLATION_ROUTE = "/translation"
+	LOCALE_PARAM              = "locale"
+
+	// LOCALE_QUERY_PARAM overrides Accept-Language for product content (?locale=es)
+	LOCALE_QUERY_PARAM = "locale"
+)
+
+// Product translation error codes
+const (
+	INVALID_LOCALE_CODE                = "INVALID_LOCALE"
+	TRANSLATION_DEFAULT_LOCALE_CODE    = "TRANSLATION_DEFAULT_LOCALE"
+	TRANSLATION_OPTION_INVALID_CODE    = "TRANSLATION_OPTION_INVALID"
+	TRANSLATION_OPTION_DUPLICATE_CODE  = "TRANSLATION_OPTION_DUPLICATE"
+	PRODUCT_TRANSLATION_NOT_FOUND_CODE = "PRODUCT_TRANSLATION_NOT_FOUND"
+)
+
+// Product translation messages
+const (
+	INVALID_LOCALE_MSG                = "Locale must be a language tag such as 'es' or 'pt-br'"
+	TRANSLATION_DEFAULT_LOCALE_MSG    = "Default-locale content is edited on the product itself"
+	TRANSLATION_OPTION_INVALID_MSG    = "One or more options do not belong to this product"
+	TRANSLATION_OPTION_DUPLICATE_MSG  = "Each option may appear only once per translation"
+	PRODUCT_TRANSLATION_NOT_FOUND_MSG = "Product translation not found"
+
+	PRODUCT_TRANSLATIONS_RETRIEVED_MSG = "Product translations retrieved successfully"
+	PRODUCT_TRANSLATION_SAVED_MSG      = "Product translation saved successfully"
+	PRODUCT_TRANSLATION_DELETED_MSG    = "Product translation deleted successfully"
+
+	FAILED_TO_GET_PRODUCT_TRANSLATIONS_MSG   = "Failed to get product translations"
+	FAILED_TO_SAVE_PRODUCT_TRANSLATION_MSG   = "Failed to save product translation"
+	FAILED_TO_DELETE_PRODUCT_TRANSLATION_MSG = "Failed to delete product translation"
+)
+
+// Product translation response field names
+const (
+	TRANSLATION_FIELD_NAME  = "translation"
+	TRANSLATIONS_FIELD_NAME = "translations"
+)
@@ -0,0 +1,175 @@
+package utils
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"ecommerce-be/common/validator"
+	"ecommerce-be/product/entity"
+)
+
+// ExpandOptionCombinations lists every variant the product's options allow, in option
+// position order. Options a rule switches off for a combination are left out of it and
+// combinations that break a rule are dropped, matching what variant creation accepts.
+// Expansion stops once more than limit combinations are found.
+func ExpandOptionCombinations(
+	options []entity.ProductOption,
+	rules []entity.ProductOptionRule,
+	limit int,
+) []OptionSelection {
+	// Only options behind a whole-option rule can be absent from a combination
+	optional := make(map[uint]bool)
+	for _, rule := range rules {
+		if len(rule.OptionValueIDs) == 0 {
+			optional[rule.OptionID] = true
+		}
+	}
+
+	var combinations []OptionSelection
+	selection := make(OptionSelection, len(options))
+	var expand func(index int)
+	expand = func(index int) {
+		if len(combinations) > limit {
+			return
+		}
+		if index == len(options) {
+			if isPossibleCombination(options, rules, selection) {
+				combinations = append(combinations, cloneSelection(selection))
+			}
+			return
+		}
+		option := options[index]
+		for _, value := range option.Values {
+			selection[option.ID] = value.ID
+			expand(index + 1)
+		}
+		delete(selection, option.ID)
+		if optional[option.ID] {
+			expand(index + 1)
+		}
+	}
+	if len(options) > 0 {
+		expand(0)
+	}
+	return combinations
+}
+
+// isPossibleCombination reports whether a full selection breaks no rule and specifies
+// exactly the options that apply to it
+func isPossibleCombination(
+	options []entity.ProductOption,
+	rules []entity.ProductOptionRule,
+	selection OptionSelection,
+) bool {
+	if len(selection) == 0 || FindOptionRuleViolation(rules, selection) != nil {
+		return false
+	}
+	for _, option := range options {
+		_, selected := selection[option.ID]
+		if selected != OptionApplies(rules, option.ID, selection) {
+			return false
+		}
+	}
+	return true
+}
+
+func cloneSelection(selection OptionSelection) OptionSelection {
+	clone := make(OptionSelection, len(selection))
+	for optionID, valueID := range selection {
+		clone[optionID] = valueID
+	}
+	return clone
+}
+
+// OptionSelectionKey identifies a combination regardless of option order
+func OptionSelectionKey(selection OptionSelection) string {
+	optionIDs := make([]uint, 0, len(selection))
+	for optionID := range selection {
+		optionIDs = append(optionIDs, optionID)
+	}
+	slices.Sort(optionIDs)
+
+	var key strings.Builder
+	for _, optionID := range optionIDs {
+		fmt.Fprintf(&key, "%d:%d;", optionID, selection[optionID])
+	}
+	return key.String()
+}
+
+// RenderVariantSKU fills an SKU pattern such as "TEE-{color}-{size}" for one variant.
+// Placeholders name an option (case-insensitive) or VARIANT_SKU_BASE_TOKEN for the
+// product's base SKU. values maps every lowercased option name of the product to the
+// selected value, empty for options the variant does not have; those render empty
+// with their separator dropped. Values are uppercased with other characters turned
+// into '-'. Returns an error for unknown placeholders and for patterns that do not
+// produce a valid SKU.
+func RenderVariantSKU(pattern string, baseSKU string, values map[string]string) (string, error) {
+	var sku strings.Builder
+	rest := pattern
+	for {
+		start := strings.IndexByte(rest, '{')
+		if start < 0 {
+			sku.WriteString(rest)
+			break
+		}
+		end := strings.IndexByte(rest[start:], '}')
+		if end < 0 {
+			return "", fmt.Errorf("unclosed placeholder in SKU pattern %q", pattern)
+		}
+		sku.WriteString(rest[:start])
+
+		token := strings.ToLower(strings.TrimSpace(rest[start+1 : start+end]))
+		value, isOption := values[token]
+		switch {
+		case token == VARIANT_SKU_BASE_TOKEN:
+			sku.WriteString(sanitizeSKUPart(baseSKU))
+		case isOption:
+			sku.WriteString(sanitizeSKUPart(value))
+		default:
+			return "", fmt.Errorf("unknown placeholder {%s} in SKU pattern", token)
+		}
+		rest = rest[start+end+1:]
+	}
+
+	rendered := collapseSKUSeparators(sku.String())
+	if !validator.IsSKU(rendered) {
+		return "", fmt.Errorf("SKU pattern produces invalid SKU %q", rendered)
+	}
+	return rendered, nil
+}
+
+// sanitizeSKUPart uppercases a value and replaces runs of characters an SKU cannot
+// contain with a single '-'
+func sanitizeSKUPart(value string) string {
+	var part strings.Builder
+	pendingDash := false
+	for _, r := range strings.ToUpper(strings.TrimSpace(value)) {
+		if (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') {
+			if pendingDash && part.Len() > 0 {
+				part.WriteByte('-')
+			}
+			part.WriteRune(r)
+			pendingDash = false
+			continue
+		}
+		pendingDash = true
+	}
+	return part.String()
+}
+
+// collapseSKUSeparators keeps the first of consecutive separators and trims them from
+// both ends, so empty placeholders leave no gaps
+func collapseSKUSeparators(sku string) string {
+	var collapsed strings.Builder
+	lastSeparator := true
+	for _, r := range sku {
+		separator := r == '-' || r == '_' || r == '.'
+		if separator && lastSeparator {
+			continue
+		}
+		collapsed.WriteRune(r)
+		lastSeparator = separator
+	}
+	return strings.TrimRight(collapsed.String(), "-_.")
+}
@@ -0,0 +1,39 @@
+package utils
+
+// Variant generation routes (under /api/product/:productId/variant)
+const (
+	VARIANT_GENERATE_ROUTE = "/generate"
+)
+
+// Variant generation settings
+const (
+	// VARIANT_GENERATE_MAX caps the combinations one generate request may cover,
+	// including those that already exist
+	VARIANT_GENERATE_MAX = 200
+
+	// VARIANT_SKU_BASE_TOKEN is the SKU pattern placeholder for the product's base SKU
+	VARIANT_SKU_BASE_TOKEN = "basesku"
+)
+
+// Variant generation field names
+const (
+	CREATED_COUNT_FIELD_NAME = "createdCount"
+	SKIPPED_COUNT_FIELD_NAME = "skippedCount"
+)
+
+// Variant generation error codes
+const (
+	VARIANT_GENERATE_LIMIT_CODE      = "VARIANT_GENERATE_LIMIT"
+	VARIANT_SKU_PATTERN_INVALID_CODE = "VARIANT_SKU_PATTERN_INVALID"
+	VARIANT_GENERATE_SELECTION_CODE  = "VARIANT_GENERATE_SELECTION"
+)
+
+// Variant generation messages
+const (
+	VARIANT_GENERATE_LIMIT_MSG      = "Too many variants requested in one generate call"
+	VARIANT_SKU_PATTERN_INVALID_MSG = "SKU pattern does not produce valid SKUs"
+	VARIANT_GENERATE_SELECTION_MSG  = "Provide either all=true or a list of combinations"
+
+	VARIANTS_GENERATED_MSG          = "Variants generated successfully"
+	FAILED_TO_GENERATE_VARIANTS_MSG = "Failed to generate variants"
+)
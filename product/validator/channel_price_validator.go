@@ -0,0 +1,28 @@
+package validator
+
+import (
+	"ecommerce-be/product/entity"
+	prodErrors "ecommerce-be/product/error"
+)
+
+// ParseSalesChannel normalizes an optional channel value.
+// An empty value means "no channel" and resolves to base prices.
+func ParseSalesChannel(raw string) (entity.SalesChannel, error) {
+	channel := entity.NormalizeSalesChannel(raw)
+	if channel == "" {
+		return "", nil
+	}
+	if !channel.IsValid() {
+		return "", prodErrors.ErrInvalidSalesChannel
+	}
+	return channel, nil
+}
+
+// ValidateRequiredSalesChannel normalizes a channel that must be present and supported
+func ValidateRequiredSalesChannel(raw string) (entity.SalesChannel, error) {
+	channel := entity.NormalizeSalesChannel(raw)
+	if !channel.IsValid() {
+		return "", prodErrors.ErrInvalidSalesChannel
+	}
+	return channel, nil
+}
@@ -1,8 +1,11 @@
 package validator
 
 import (
+	"slices"
+
 	"ecommerce-be/product/entity"
 	prodErrors "ecommerce-be/product/error"
+	"ecommerce-be/product/model"
 	"ecommerce-be/product/utils"
 	"ecommerce-be/product/utils/helper"
 )
@@ -78,3 +81,40 @@ func ValidateProductBelongsToSeller(
 
 	return nil
 }
+
+// ValidateProductOptionRule validates that a rule references distinct options of the
+// product and only values belonging to those options
+// options should be the product's options with their values preloaded
+func ValidateProductOptionRule(
+	req model.ProductOptionRuleCreateRequest,
+	options []entity.ProductOption,
+) error {
+	if req.OptionID == req.ConditionOptionID {
+		return prodErrors.ErrOptionRuleInvalid
+	}
+
+	optionsByID := make(map[uint]*entity.ProductOption, len(options))
+	for i := range options {
+		optionsByID[options[i].ID] = &options[i]
+	}
+
+	if err := validateRuleValues(optionsByID[req.OptionID], req.OptionValueIDs); err != nil {
+		return err
+	}
+	return validateRuleValues(optionsByID[req.ConditionOptionID], req.ConditionValueIDs)
+}
+
+// validateRuleValues checks that option exists on the product and owns every value ID
+func validateRuleValues(option *entity.ProductOption, valueIDs []uint) error {
+	if option == nil {
+		return prodErrors.ErrProductOptionMismatch
+	}
+	for _, valueID := range valueIDs {
+		if !slices.ContainsFunc(option.Values, func(v entity.ProductOptionValue) bool {
+			return v.ID == valueID
+		}) {
+			return prodErrors.ErrProductOptionValueMismatch
+		}
+	}
+	return nil
+}
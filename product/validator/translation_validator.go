@@ -0,0 +1,36 @@
+package validator
+
+import (
+	"regexp"
+
+	"ecommerce-be/common/i18n"
+	prodErrors "ecommerce-be/product/error"
+)
+
+// localePattern accepts BCP 47-style tags such as "es", "pt-br" or "zh-hant-tw"
+var localePattern = regexp.MustCompile(`^[a-z]{2,3}(-[a-z0-9]{2,8}){0,2}$`)
+
+// ValidateTranslationLocale normalizes a translation locale and rejects malformed tags
+// and the default locale, whose content lives on the product itself
+func ValidateTranslationLocale(raw, defaultLocale string) (string, error) {
+	locale := i18n.NormalizeTag(raw)
+	if !localePattern.MatchString(locale) {
+		return "", prodErrors.ErrInvalidLocale
+	}
+	if locale == i18n.NormalizeTag(defaultLocale) {
+		return "", prodErrors.ErrTranslationDefaultLocale
+	}
+	return locale, nil
+}
+
+// ParseContentLocale normalizes an optional ?locale override; empty means "not set"
+func ParseContentLocale(raw string) (string, error) {
+	locale := i18n.NormalizeTag(raw)
+	if locale == "" {
+		return "", nil
+	}
+	if !localePattern.MatchString(locale) {
+		return "", prodErrors.ErrInvalidLocale
+	}
+	return locale, nil
+}
@@ -1,9 +1,11 @@
 package validator
 
 import (
+	"ecommerce-be/common/db"
 	"ecommerce-be/product/entity"
 	prodErrors "ecommerce-be/product/error"
 	"ecommerce-be/product/model"
+	"ecommerce-be/product/utils"
 )
 
 // ValidateVariantProductAndSeller validates that a product exists and seller has access
@@ -99,6 +101,40 @@ func ValidateBulkVariantsExist(
 	return nil
 }
 
+// ValidateVariantVersion rejects an update whose expected version is not the variant's
+// current one. A nil expectedVersion always passes.
+func ValidateVariantVersion(variant *entity.ProductVariant, expectedVersion *int64) error {
+	if expectedVersion == nil || *expectedVersion == variant.Version {
+		return nil
+	}
+	return prodErrors.ErrVariantVersionConflict.WithDetails([]db.VersionConflict{
+		{ID: variant.ID, CurrentVersion: variant.Version},
+	})
+}
+
+// ValidateBulkVariantVersions checks the expected versions of a bulk update against the
+// fetched variants and reports every stale one
+func ValidateBulkVariantVersions(
+	existingVariants []entity.ProductVariant,
+	updateMap map[uint]*model.BulkUpdateVariantItem,
+) error {
+	var conflicts []db.VersionConflict
+	for _, variant := range existingVariants {
+		update, ok := updateMap[variant.ID]
+		if !ok || update.ExpectedVersion == nil || *update.ExpectedVersion == variant.Version {
+			continue
+		}
+		conflicts = append(conflicts, db.VersionConflict{
+			ID:             variant.ID,
+			CurrentVersion: variant.Version,
+		})
+	}
+	if len(conflicts) > 0 {
+		return prodErrors.ErrVariantVersionConflict.WithDetails(conflicts)
+	}
+	return nil
+}
+
 // ValidateProductOptionExists validates that a product option exists
 // option should be the fetched option entity, returns option ID if valid
 func ValidateProductOptionExists(option *entity.ProductOption) (*uint, error) {
@@ -116,3 +152,22 @@ func ValidateProductOptionValueExists(optionValue *entity.ProductOptionValue) (*
 	}
 	return &optionValue.ID, nil
 }
+
+// ValidateVariantOptionRules validates that a variant's option selection breaks no
+// option dependency rule of the product
+// optionNames maps option ID to option name for the error message
+func ValidateVariantOptionRules(
+	rules []entity.ProductOptionRule,
+	selection utils.OptionSelection,
+	optionNames map[uint]string,
+) error {
+	violated := utils.FindOptionRuleViolation(rules, selection)
+	if violated == nil {
+		return nil
+	}
+	return prodErrors.ErrOptionCombinationNotAllowed.WithMessagef(
+		"option %s is not available for the selected %s",
+		optionNames[violated.OptionID],
+		optionNames[violated.ConditionOptionID],
+	)
+}
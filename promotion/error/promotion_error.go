@@ -123,3 +123,20 @@ var (
 		StatusCode: http.StatusInternalServerError,
 	}
 )
+
+func init() {
+	commonError.Register(
+		ErrPromotionNotFound,
+		ErrPromotionSlugExists,
+		ErrInvalidDiscountConfig,
+		ErrInvalidDateRange,
+		ErrInvalidEligibility,
+		ErrUnauthorizedPromotionAccess,
+		ErrInvalidStatusTransition,
+		ErrCannotDeleteActivePromotion,
+		ErrCannotEditActivePromotion,
+		ErrCannotEditTerminalPromotion,
+		ErrPromotionUpdateFailed,
+		ErrPromotionDeleteFailed,
+	)
+}
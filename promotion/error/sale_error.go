@@ -61,3 +61,14 @@ var (
 		StatusCode: http.StatusUnprocessableEntity,
 	}
 )
+
+func init() {
+	commonError.Register(
+		ErrSaleNotFound,
+		ErrSaleSlugExists,
+		ErrUnauthorizedSaleAccess,
+		ErrInvalidSaleDateRange,
+		ErrInvalidSaleForPromotion,
+		ErrSaleInvalidFile,
+	)
+}
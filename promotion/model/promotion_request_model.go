@@ -10,7 +10,7 @@ type CreatePromotionRequest struct {
 	// Basic Info
 	Name        string  `json:"name"        binding:"required,min=3,max=255"`
 	DisplayName *string `json:"displayName" binding:"omitempty,max=255"`
-	Slug        *string `json:"slug"        binding:"omitempty,max=255"`
+	Slug        *string `json:"slug"        binding:"omitempty,slug,max=255"`
 	Description *string `json:"description" binding:"omitempty"`
 
 	// Promotion Mechanics
@@ -64,7 +64,7 @@ type CreatePromotionRequest struct {
 type UpdatePromotionRequest struct {
 	Name        *string `json:"name"        binding:"omitempty,min=3,max=255"`
 	DisplayName *string `json:"displayName" binding:"omitempty,max=255"`
-	Slug        *string `json:"slug"        binding:"omitempty,max=255"`
+	Slug        *string `json:"slug"        binding:"omitempty,slug,max=255"`
 	Description *string `json:"description" binding:"omitempty"`
 
 	PromotionType  *entity.PromotionType   `json:"promotionType"  binding:"omitempty,oneof=percentage_discount fixed_amount buy_x_get_y free_shipping bundle tiered flash_sale"`
@@ -9,7 +9,7 @@ import (
 type CreateSaleRequest struct {
 	Name          string                `json:"name"          binding:"required,min=3,max=255"`
 	Description   *string               `json:"description"   binding:"omitempty,max=2000"`
-	Slug          *string               `json:"slug"          binding:"omitempty,max=255"`
+	Slug          *string               `json:"slug"          binding:"omitempty,slug,max=255"`
 	BannerFileIDs []string              `json:"bannerFileIds" binding:"omitempty"`
 	Status        entity.CampaignStatus `json:"status"        binding:"omitempty,oneof=draft scheduled active paused ended cancelled"`
 	StartAt       string                `json:"startAt"       binding:"required"`
@@ -20,7 +20,7 @@ type CreateSaleRequest struct {
 type UpdateSaleRequest struct {
 	Name          string                `json:"name"          binding:"required,min=3,max=255"`
 	Description   *string               `json:"description"   binding:"omitempty,max=2000"`
-	Slug          *string               `json:"slug"          binding:"omitempty,max=255"`
+	Slug          *string               `json:"slug"          binding:"omitempty,slug,max=255"`
 	BannerFileIDs []string              `json:"bannerFileIds" binding:"omitempty"`
 	Status        entity.CampaignStatus `json:"status"        binding:"omitempty,oneof=draft scheduled active paused ended cancelled"`
 	StartAt       string                `json:"startAt"       binding:"required"`
@@ -1,10 +1,15 @@
 package route
 
 import (
+	"net/http"
+
 	"ecommerce-be/common/constants"
 	"ecommerce-be/common/middleware"
+	"ecommerce-be/common/openapi"
 	"ecommerce-be/promotion/factory/singleton"
 	"ecommerce-be/promotion/handler"
+	"ecommerce-be/promotion/model"
+	promotionConstants "ecommerce-be/promotion/utils/constant"
 
 	"github.com/gin-gonic/gin"
 )
@@ -28,13 +33,48 @@ func (m *PromotionModule) RegisterRoutes(router *gin.Engine) {
 	sellerAuth := middleware.SellerAuth()
 
 	// Promotion routes - all protected (seller only)
-	promotionRoutes := router.Group(constants.APIBasePromotion)
+	promotionRoutes := openapi.NewGroup(router.Group(constants.APIBasePromotion), "Promotions")
 	{
-		promotionRoutes.POST("", sellerAuth, m.promotionHandler.CreatePromotion)
-		promotionRoutes.GET("", sellerAuth, m.promotionHandler.ListPromotions)
-		promotionRoutes.GET("/:promotionId", sellerAuth, m.promotionHandler.GetPromotion)
-		promotionRoutes.PUT("/:promotionId", sellerAuth, m.promotionHandler.UpdatePromotion)
-		promotionRoutes.PATCH("/:promotionId/status", sellerAuth, m.promotionHandler.UpdateStatus)
-		promotionRoutes.DELETE("/:promotionId", sellerAuth, m.promotionHandler.DeletePromotion)
+		promotionRoutes.POST("", sellerAuth, m.promotionHandler.CreatePromotion).
+			Summary("Create a promotion").
+			Body(model.CreatePromotionRequest{}).
+			ReturnsField(
+				http.StatusCreated,
+				promotionConstants.PROMOTION_FIELD,
+				model.PromotionResponse{},
+			)
+		promotionRoutes.GET("", sellerAuth, m.promotionHandler.ListPromotions).
+			Summary("List promotions").
+			Query(model.ListPromotionsRequest{}).
+			ReturnsField(
+				http.StatusOK,
+				promotionConstants.PROMOTIONS_FIELD,
+				model.ListPromotionsResponse{},
+			)
+		promotionRoutes.GET("/:promotionId", sellerAuth, m.promotionHandler.GetPromotion).
+			Summary("Get a promotion").
+			ReturnsField(
+				http.StatusOK,
+				promotionConstants.PROMOTION_FIELD,
+				model.PromotionResponse{},
+			)
+		promotionRoutes.PUT("/:promotionId", sellerAuth, m.promotionHandler.UpdatePromotion).
+			Summary("Update a promotion").
+			Body(model.UpdatePromotionRequest{}).
+			ReturnsField(
+				http.StatusOK,
+				promotionConstants.PROMOTION_FIELD,
+				model.PromotionResponse{},
+			)
+		promotionRoutes.PATCH("/:promotionId/status", sellerAuth, m.promotionHandler.UpdateStatus).
+			Summary("Change a promotion's status").
+			Body(model.UpdateStatusRequest{}).
+			ReturnsField(
+				http.StatusOK,
+				promotionConstants.PROMOTION_FIELD,
+				model.PromotionResponse{},
+			)
+		promotionRoutes.DELETE("/:promotionId", sellerAuth, m.promotionHandler.DeletePromotion).
+			Summary("Delete a promotion")
 	}
 }
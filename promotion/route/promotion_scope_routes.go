@@ -1,10 +1,15 @@
 package route
 
 import (
+	"net/http"
+
 	"ecommerce-be/common/constants"
 	"ecommerce-be/common/middleware"
+	"ecommerce-be/common/openapi"
 	"ecommerce-be/promotion/factory/singleton"
 	"ecommerce-be/promotion/handler"
+	"ecommerce-be/promotion/model"
+	promotionConstants "ecommerce-be/promotion/utils/constant"
 
 	"github.com/gin-gonic/gin"
 )
@@ -34,74 +39,125 @@ func (m *PromotionScopeModule) RegisterRoutes(router *gin.Engine) {
 	sellerAuth := middleware.SellerAuth()
 
 	// Promotion routes - all protected (seller only)
-	promotionRoutes := router.Group(constants.APIBasePromotion + "/scope")
+	promotionRoutes := openapi.NewGroup(
+		router.Group(constants.APIBasePromotion+"/scope"),
+		"Promotion Scopes",
+	)
 	{
 		// Product Scope Routes
-		promotionRoutes.POST("/product", sellerAuth, m.promotionProductHandler.AddProducts)
-		promotionRoutes.DELETE("/product", sellerAuth, m.promotionProductHandler.RemoveProducts)
+		promotionRoutes.POST("/product", sellerAuth, m.promotionProductHandler.AddProducts).
+			Summary("Add products to a promotion scope").
+			Body(model.AddPromotionProductRequest{})
+		promotionRoutes.DELETE("/product", sellerAuth, m.promotionProductHandler.RemoveProducts).
+			Summary("Remove products from a promotion scope").
+			Body(model.RemovePromotionProductRequest{})
 		promotionRoutes.DELETE(
 			"/:promotionId/product",
 			sellerAuth,
 			m.promotionProductHandler.RemoveAllProducts,
-		)
+		).
+			Summary("Remove every product from a promotion scope")
 		promotionRoutes.GET(
 			"/:promotionId/product",
 			sellerAuth,
 			m.promotionProductHandler.GetProducts,
-		)
+		).
+			Summary("List products in a promotion scope").
+			Query(model.GetPromotionProductsQueryParams{}).
+			ReturnsField(
+				http.StatusOK,
+				promotionConstants.PROMOTION_PRODUCTS_FIELD,
+				model.GetPromotionProductsResponse{},
+			)
 
 		// Variant Scope Routes
-		promotionRoutes.POST("/variant", sellerAuth, m.promotionVariantHandler.AddVariants)
-		promotionRoutes.DELETE("/variant", sellerAuth, m.promotionVariantHandler.RemoveVariants)
+		promotionRoutes.POST("/variant", sellerAuth, m.promotionVariantHandler.AddVariants).
+			Summary("Add variants to a promotion scope").
+			Body(model.AddPromotionVariantRequest{})
+		promotionRoutes.DELETE("/variant", sellerAuth, m.promotionVariantHandler.RemoveVariants).
+			Summary("Remove variants from a promotion scope").
+			Body(model.RemovePromotionVariantRequest{})
 		promotionRoutes.DELETE(
 			"/:promotionId/variant",
 			sellerAuth,
 			m.promotionVariantHandler.RemoveAllVariants,
-		)
+		).
+			Summary("Remove every variant from a promotion scope")
 		promotionRoutes.GET(
 			"/:promotionId/variant",
 			sellerAuth,
 			m.promotionVariantHandler.GetVariants,
-		)
+		).
+			Summary("List variants in a promotion scope").
+			Query(model.GetPromotionVariantsQueryParams{}).
+			ReturnsField(
+				http.StatusOK,
+				promotionConstants.PROMOTION_VARIANTS_FIELD,
+				model.GetPromotionVariantsResponse{},
+			)
 
 		// Category Scope Routes
-		promotionRoutes.POST("/category", sellerAuth, m.promotionCategoryHandler.AddCategories)
+		promotionRoutes.POST("/category", sellerAuth, m.promotionCategoryHandler.AddCategories).
+			Summary("Add categories to a promotion scope").
+			Body(model.AddPromotionCategoryRequest{})
 		promotionRoutes.DELETE(
 			"/category",
 			sellerAuth,
 			m.promotionCategoryHandler.RemoveCategories,
-		)
+		).
+			Summary("Remove categories from a promotion scope").
+			Body(model.RemovePromotionCategoryRequest{})
 		promotionRoutes.DELETE(
 			"/:promotionId/category",
 			sellerAuth,
 			m.promotionCategoryHandler.RemoveAllCategories,
-		)
+		).
+			Summary("Remove every category from a promotion scope")
 		promotionRoutes.GET(
 			"/:promotionId/category",
 			sellerAuth,
 			m.promotionCategoryHandler.GetCategories,
-		)
+		).
+			Summary("List categories in a promotion scope").
+			Query(model.GetPromotionCategoriesQueryParams{}).
+			ReturnsField(
+				http.StatusOK,
+				promotionConstants.PROMOTION_CATEGORIES_FIELD,
+				model.GetPromotionCategoriesResponse{},
+			)
 
 		// Collection Scope Routes
 		promotionRoutes.POST(
 			"/collection",
 			sellerAuth,
 			m.promotionCollectionHandler.AddCollections,
-		)
+		).
+			Summary("Add collections to a promotion scope").
+			Body(model.AddPromotionCollectionRequest{})
 		promotionRoutes.DELETE(
 			"/collection",
 			sellerAuth,
 			m.promotionCollectionHandler.RemoveCollections,
-		)
+		).
+			Summary("Remove collections from a promotion scope").
+			Body(model.RemovePromotionCollectionRequest{})
 		promotionRoutes.DELETE(
 			"/:promotionId/collection",
 			sellerAuth,
 			m.promotionCollectionHandler.RemoveAllCollections,
-		)
+		).
+			Summary("Remove every collection from a promotion scope")
 		promotionRoutes.GET(
 			"/:promotionId/collection",
 			sellerAuth,
 			m.promotionCollectionHandler.GetCollections,
-		)
+		).
+			Summary("List collections in a promotion scope").
+			Query(model.GetPromotionCollectionsQueryParams{}).
+			ReturnsField(
+				http.StatusOK,
+				promotionConstants.PROMOTION_COLLECTIONS_FIELD,
+				model.GetPromotionCollectionsResponse{},
+			)
 	}
 }
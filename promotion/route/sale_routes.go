@@ -1,10 +1,15 @@
 package route
 
 import (
+	"net/http"
+
 	"ecommerce-be/common/constants"
 	"ecommerce-be/common/middleware"
+	"ecommerce-be/common/openapi"
 	"ecommerce-be/promotion/factory/singleton"
 	"ecommerce-be/promotion/handler"
+	"ecommerce-be/promotion/model"
+	promotionConstants "ecommerce-be/promotion/utils/constant"
 
 	"github.com/gin-gonic/gin"
 )
@@ -26,13 +31,27 @@ func NewSaleModule() *SaleModule {
 func (m *SaleModule) RegisterRoutes(router *gin.Engine) {
 	sellerAuth := middleware.SellerAuth()
 
-	saleRoutes := router.Group(constants.APIBasePromotion + "/sale")
+	saleRoutes := openapi.NewGroup(router.Group(constants.APIBasePromotion+"/sale"), "Sales")
 	{
-		saleRoutes.POST("", sellerAuth, m.saleHandler.CreateSale)
-		saleRoutes.GET("", sellerAuth, m.saleHandler.ListSales)
-		saleRoutes.GET("/:saleId", sellerAuth, m.saleHandler.GetSale)
-		saleRoutes.PUT("/:saleId", sellerAuth, m.saleHandler.UpdateSale)
-		saleRoutes.DELETE("/:saleId", sellerAuth, m.saleHandler.DeleteSale)
-		saleRoutes.PATCH("/:saleId/status", sellerAuth, m.saleHandler.UpdateStatus)
+		saleRoutes.POST("", sellerAuth, m.saleHandler.CreateSale).
+			Summary("Create a sale").
+			Body(model.CreateSaleRequest{}).
+			ReturnsField(http.StatusCreated, promotionConstants.SALE_FIELD, model.SaleResponse{})
+		saleRoutes.GET("", sellerAuth, m.saleHandler.ListSales).
+			Summary("List sales").
+			Returns(http.StatusOK, model.SalesResponse{})
+		saleRoutes.GET("/:saleId", sellerAuth, m.saleHandler.GetSale).
+			Summary("Get a sale").
+			ReturnsField(http.StatusOK, promotionConstants.SALE_FIELD, model.SaleResponse{})
+		saleRoutes.PUT("/:saleId", sellerAuth, m.saleHandler.UpdateSale).
+			Summary("Update a sale").
+			Body(model.UpdateSaleRequest{}).
+			ReturnsField(http.StatusOK, promotionConstants.SALE_FIELD, model.SaleResponse{})
+		saleRoutes.DELETE("/:saleId", sellerAuth, m.saleHandler.DeleteSale).
+			Summary("Delete a sale")
+		saleRoutes.PATCH("/:saleId/status", sellerAuth, m.saleHandler.UpdateStatus).
+			Summary("Change a sale's status").
+			Body(model.UpdateSaleStatusRequest{}).
+			ReturnsField(http.StatusOK, promotionConstants.SALE_FIELD, model.SaleResponse{})
 	}
 }
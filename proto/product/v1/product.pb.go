@@ -0,0 +1,1078 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: product/v1/product.proto
+
+package productv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Pagination mirrors the REST pagination block
+type Pagination struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CurrentPage   int32                  `protobuf:"varint,1,opt,name=current_page,json=currentPage,proto3" json:"current_page,omitempty"`
+	TotalPages    int32                  `protobuf:"varint,2,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	TotalItems    int32                  `protobuf:"varint,3,opt,name=total_items,json=totalItems,proto3" json:"total_items,omitempty"`
+	ItemsPerPage  int32                  `protobuf:"varint,4,opt,name=items_per_page,json=itemsPerPage,proto3" json:"items_per_page,omitempty"`
+	HasNext       bool                   `protobuf:"varint,5,opt,name=has_next,json=hasNext,proto3" json:"has_next,omitempty"`
+	HasPrev       bool                   `protobuf:"varint,6,opt,name=has_prev,json=hasPrev,proto3" json:"has_prev,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Pagination) Reset() {
+	*x = Pagination{}
+	mi := &file_product_v1_product_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Pagination) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pagination) ProtoMessage() {}
+
+func (x *Pagination) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pagination.ProtoReflect.Descriptor instead.
+func (*Pagination) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Pagination) GetCurrentPage() int32 {
+	if x != nil {
+		return x.CurrentPage
+	}
+	return 0
+}
+
+func (x *Pagination) GetTotalPages() int32 {
+	if x != nil {
+		return x.TotalPages
+	}
+	return 0
+}
+
+func (x *Pagination) GetTotalItems() int32 {
+	if x != nil {
+		return x.TotalItems
+	}
+	return 0
+}
+
+func (x *Pagination) GetItemsPerPage() int32 {
+	if x != nil {
+		return x.ItemsPerPage
+	}
+	return 0
+}
+
+func (x *Pagination) GetHasNext() bool {
+	if x != nil {
+		return x.HasNext
+	}
+	return false
+}
+
+func (x *Pagination) GetHasPrev() bool {
+	if x != nil {
+		return x.HasPrev
+	}
+	return false
+}
+
+// VariantOption is one option value selected by a variant (e.g. Color = Red)
+type VariantOption struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OptionName    string                 `protobuf:"bytes,1,opt,name=option_name,json=optionName,proto3" json:"option_name,omitempty"`
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	ColorCode     string                 `protobuf:"bytes,3,opt,name=color_code,json=colorCode,proto3" json:"color_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VariantOption) Reset() {
+	*x = VariantOption{}
+	mi := &file_product_v1_product_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VariantOption) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VariantOption) ProtoMessage() {}
+
+func (x *VariantOption) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VariantOption.ProtoReflect.Descriptor instead.
+func (*VariantOption) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *VariantOption) GetOptionName() string {
+	if x != nil {
+		return x.OptionName
+	}
+	return ""
+}
+
+func (x *VariantOption) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *VariantOption) GetColorCode() string {
+	if x != nil {
+		return x.ColorCode
+	}
+	return ""
+}
+
+// Variant is a purchasable variant of a product
+type Variant struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Sku             string                 `protobuf:"bytes,2,opt,name=sku,proto3" json:"sku,omitempty"`
+	Price           float64                `protobuf:"fixed64,3,opt,name=price,proto3" json:"price,omitempty"`
+	AllowPurchase   bool                   `protobuf:"varint,4,opt,name=allow_purchase,json=allowPurchase,proto3" json:"allow_purchase,omitempty"`
+	IsDefault       bool                   `protobuf:"varint,5,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
+	SelectedOptions []*VariantOption       `protobuf:"bytes,6,rep,name=selected_options,json=selectedOptions,proto3" json:"selected_options,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Variant) Reset() {
+	*x = Variant{}
+	mi := &file_product_v1_product_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Variant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Variant) ProtoMessage() {}
+
+func (x *Variant) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Variant.ProtoReflect.Descriptor instead.
+func (*Variant) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Variant) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Variant) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *Variant) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *Variant) GetAllowPurchase() bool {
+	if x != nil {
+		return x.AllowPurchase
+	}
+	return false
+}
+
+func (x *Variant) GetIsDefault() bool {
+	if x != nil {
+		return x.IsDefault
+	}
+	return false
+}
+
+func (x *Variant) GetSelectedOptions() []*VariantOption {
+	if x != nil {
+		return x.SelectedOptions
+	}
+	return nil
+}
+
+// Product is the storefront view of a product
+type Product struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name             string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	CategoryId       uint64                 `protobuf:"varint,3,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Brand            string                 `protobuf:"bytes,4,opt,name=brand,proto3" json:"brand,omitempty"`
+	Sku              string                 `protobuf:"bytes,5,opt,name=sku,proto3" json:"sku,omitempty"`
+	ShortDescription string                 `protobuf:"bytes,6,opt,name=short_description,json=shortDescription,proto3" json:"short_description,omitempty"`
+	LongDescription  string                 `protobuf:"bytes,7,opt,name=long_description,json=longDescription,proto3" json:"long_description,omitempty"`
+	Tags             []string               `protobuf:"bytes,8,rep,name=tags,proto3" json:"tags,omitempty"`
+	SellerId         uint64                 `protobuf:"varint,9,opt,name=seller_id,json=sellerId,proto3" json:"seller_id,omitempty"`
+	// Locale the text content was resolved in
+	Locale      string `protobuf:"bytes,10,opt,name=locale,proto3" json:"locale,omitempty"`
+	HasVariants bool   `protobuf:"varint,11,opt,name=has_variants,json=hasVariants,proto3" json:"has_variants,omitempty"`
+	// Default variant price; min_price and max_price span all variants
+	Price         float64    `protobuf:"fixed64,12,opt,name=price,proto3" json:"price,omitempty"`
+	MinPrice      float64    `protobuf:"fixed64,13,opt,name=min_price,json=minPrice,proto3" json:"min_price,omitempty"`
+	MaxPrice      float64    `protobuf:"fixed64,14,opt,name=max_price,json=maxPrice,proto3" json:"max_price,omitempty"`
+	AllowPurchase bool       `protobuf:"varint,15,opt,name=allow_purchase,json=allowPurchase,proto3" json:"allow_purchase,omitempty"`
+	Variants      []*Variant `protobuf:"bytes,16,rep,name=variants,proto3" json:"variants,omitempty"`
+	ImageUrls     []string   `protobuf:"bytes,17,rep,name=image_urls,json=imageUrls,proto3" json:"image_urls,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Product) Reset() {
+	*x = Product{}
+	mi := &file_product_v1_product_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Product) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Product) ProtoMessage() {}
+
+func (x *Product) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Product.ProtoReflect.Descriptor instead.
+func (*Product) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Product) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Product) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Product) GetCategoryId() uint64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *Product) GetBrand() string {
+	if x != nil {
+		return x.Brand
+	}
+	return ""
+}
+
+func (x *Product) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *Product) GetShortDescription() string {
+	if x != nil {
+		return x.ShortDescription
+	}
+	return ""
+}
+
+func (x *Product) GetLongDescription() string {
+	if x != nil {
+		return x.LongDescription
+	}
+	return ""
+}
+
+func (x *Product) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Product) GetSellerId() uint64 {
+	if x != nil {
+		return x.SellerId
+	}
+	return 0
+}
+
+func (x *Product) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *Product) GetHasVariants() bool {
+	if x != nil {
+		return x.HasVariants
+	}
+	return false
+}
+
+func (x *Product) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *Product) GetMinPrice() float64 {
+	if x != nil {
+		return x.MinPrice
+	}
+	return 0
+}
+
+func (x *Product) GetMaxPrice() float64 {
+	if x != nil {
+		return x.MaxPrice
+	}
+	return 0
+}
+
+func (x *Product) GetAllowPurchase() bool {
+	if x != nil {
+		return x.AllowPurchase
+	}
+	return false
+}
+
+func (x *Product) GetVariants() []*Variant {
+	if x != nil {
+		return x.Variants
+	}
+	return nil
+}
+
+func (x *Product) GetImageUrls() []string {
+	if x != nil {
+		return x.ImageUrls
+	}
+	return nil
+}
+
+type GetProductRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId uint64                 `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	// Optional content locale override (e.g. "fr-FR")
+	Locale        string `protobuf:"bytes,2,opt,name=locale,proto3" json:"locale,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductRequest) Reset() {
+	*x = GetProductRequest{}
+	mi := &file_product_v1_product_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductRequest) ProtoMessage() {}
+
+func (x *GetProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductRequest.ProtoReflect.Descriptor instead.
+func (*GetProductRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetProductRequest) GetProductId() uint64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *GetProductRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+type GetProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Product       *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductResponse) Reset() {
+	*x = GetProductResponse{}
+	mi := &file_product_v1_product_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductResponse) ProtoMessage() {}
+
+func (x *GetProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductResponse.ProtoReflect.Descriptor instead.
+func (*GetProductResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetProductResponse) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+type ListProductsRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Page        int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize    int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	CategoryIds []uint64               `protobuf:"varint,3,rep,packed,name=category_ids,json=categoryIds,proto3" json:"category_ids,omitempty"`
+	Brands      []string               `protobuf:"bytes,4,rep,name=brands,proto3" json:"brands,omitempty"`
+	Ids         []uint64               `protobuf:"varint,5,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	// Zero means no price bound
+	MinPrice      float64 `protobuf:"fixed64,6,opt,name=min_price,json=minPrice,proto3" json:"min_price,omitempty"`
+	MaxPrice      float64 `protobuf:"fixed64,7,opt,name=max_price,json=maxPrice,proto3" json:"max_price,omitempty"`
+	Locale        string  `protobuf:"bytes,8,opt,name=locale,proto3" json:"locale,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProductsRequest) Reset() {
+	*x = ListProductsRequest{}
+	mi := &file_product_v1_product_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductsRequest) ProtoMessage() {}
+
+func (x *ListProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductsRequest.ProtoReflect.Descriptor instead.
+func (*ListProductsRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListProductsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListProductsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListProductsRequest) GetCategoryIds() []uint64 {
+	if x != nil {
+		return x.CategoryIds
+	}
+	return nil
+}
+
+func (x *ListProductsRequest) GetBrands() []string {
+	if x != nil {
+		return x.Brands
+	}
+	return nil
+}
+
+func (x *ListProductsRequest) GetIds() []uint64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *ListProductsRequest) GetMinPrice() float64 {
+	if x != nil {
+		return x.MinPrice
+	}
+	return 0
+}
+
+func (x *ListProductsRequest) GetMaxPrice() float64 {
+	if x != nil {
+		return x.MaxPrice
+	}
+	return 0
+}
+
+func (x *ListProductsRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+type ListProductsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Products      []*Product             `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
+	Pagination    *Pagination            `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProductsResponse) Reset() {
+	*x = ListProductsResponse{}
+	mi := &file_product_v1_product_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductsResponse) ProtoMessage() {}
+
+func (x *ListProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductsResponse.ProtoReflect.Descriptor instead.
+func (*ListProductsResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListProductsResponse) GetProducts() []*Product {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+func (x *ListProductsResponse) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type SearchProductsRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Query      string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Page       int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Limit      int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	CategoryId uint64                 `protobuf:"varint,4,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Brand      string                 `protobuf:"bytes,5,opt,name=brand,proto3" json:"brand,omitempty"`
+	MinPrice   float64                `protobuf:"fixed64,6,opt,name=min_price,json=minPrice,proto3" json:"min_price,omitempty"`
+	MaxPrice   float64                `protobuf:"fixed64,7,opt,name=max_price,json=maxPrice,proto3" json:"max_price,omitempty"`
+	// Blank sort_by applies the seller's default sort
+	SortBy        string `protobuf:"bytes,8,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
+	SortOrder     string `protobuf:"bytes,9,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`
+	Locale        string `protobuf:"bytes,10,opt,name=locale,proto3" json:"locale,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchProductsRequest) Reset() {
+	*x = SearchProductsRequest{}
+	mi := &file_product_v1_product_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchProductsRequest) ProtoMessage() {}
+
+func (x *SearchProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchProductsRequest.ProtoReflect.Descriptor instead.
+func (*SearchProductsRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SearchProductsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchProductsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *SearchProductsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *SearchProductsRequest) GetCategoryId() uint64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *SearchProductsRequest) GetBrand() string {
+	if x != nil {
+		return x.Brand
+	}
+	return ""
+}
+
+func (x *SearchProductsRequest) GetMinPrice() float64 {
+	if x != nil {
+		return x.MinPrice
+	}
+	return 0
+}
+
+func (x *SearchProductsRequest) GetMaxPrice() float64 {
+	if x != nil {
+		return x.MaxPrice
+	}
+	return 0
+}
+
+func (x *SearchProductsRequest) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
+	}
+	return ""
+}
+
+func (x *SearchProductsRequest) GetSortOrder() string {
+	if x != nil {
+		return x.SortOrder
+	}
+	return ""
+}
+
+func (x *SearchProductsRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+type SearchResult struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Product        *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	RelevanceScore float64                `protobuf:"fixed64,2,opt,name=relevance_score,json=relevanceScore,proto3" json:"relevance_score,omitempty"`
+	MatchedFields  []string               `protobuf:"bytes,3,rep,name=matched_fields,json=matchedFields,proto3" json:"matched_fields,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SearchResult) Reset() {
+	*x = SearchResult{}
+	mi := &file_product_v1_product_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchResult) ProtoMessage() {}
+
+func (x *SearchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchResult.ProtoReflect.Descriptor instead.
+func (*SearchResult) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SearchResult) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+func (x *SearchResult) GetRelevanceScore() float64 {
+	if x != nil {
+		return x.RelevanceScore
+	}
+	return 0
+}
+
+func (x *SearchResult) GetMatchedFields() []string {
+	if x != nil {
+		return x.MatchedFields
+	}
+	return nil
+}
+
+type SearchProductsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*SearchResult        `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	Pagination    *Pagination            `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchProductsResponse) Reset() {
+	*x = SearchProductsResponse{}
+	mi := &file_product_v1_product_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchProductsResponse) ProtoMessage() {}
+
+func (x *SearchProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchProductsResponse.ProtoReflect.Descriptor instead.
+func (*SearchProductsResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SearchProductsResponse) GetResults() []*SearchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *SearchProductsResponse) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+var File_product_v1_product_proto protoreflect.FileDescriptor
+
+const file_product_v1_product_proto_rawDesc = "" +
+	"\n" +
+	"\x18product/v1/product.proto\x12\x14ecommerce.product.v1\"\xcd\x01\n" +
+	"\n" +
+	"Pagination\x12!\n" +
+	"\fcurrent_page\x18\x01 \x01(\x05R\vcurrentPage\x12\x1f\n" +
+	"\vtotal_pages\x18\x02 \x01(\x05R\n" +
+	"totalPages\x12\x1f\n" +
+	"\vtotal_items\x18\x03 \x01(\x05R\n" +
+	"totalItems\x12$\n" +
+	"\x0eitems_per_page\x18\x04 \x01(\x05R\fitemsPerPage\x12\x19\n" +
+	"\bhas_next\x18\x05 \x01(\bR\ahasNext\x12\x19\n" +
+	"\bhas_prev\x18\x06 \x01(\bR\ahasPrev\"e\n" +
+	"\rVariantOption\x12\x1f\n" +
+	"\voption_name\x18\x01 \x01(\tR\n" +
+	"optionName\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\x12\x1d\n" +
+	"\n" +
+	"color_code\x18\x03 \x01(\tR\tcolorCode\"\xd7\x01\n" +
+	"\aVariant\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x10\n" +
+	"\x03sku\x18\x02 \x01(\tR\x03sku\x12\x14\n" +
+	"\x05price\x18\x03 \x01(\x01R\x05price\x12%\n" +
+	"\x0eallow_purchase\x18\x04 \x01(\bR\rallowPurchase\x12\x1d\n" +
+	"\n" +
+	"is_default\x18\x05 \x01(\bR\tisDefault\x12N\n" +
+	"\x10selected_options\x18\x06 \x03(\v2#.ecommerce.product.v1.VariantOptionR\x0fselectedOptions\"\x8b\x04\n" +
+	"\aProduct\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1f\n" +
+	"\vcategory_id\x18\x03 \x01(\x04R\n" +
+	"categoryId\x12\x14\n" +
+	"\x05brand\x18\x04 \x01(\tR\x05brand\x12\x10\n" +
+	"\x03sku\x18\x05 \x01(\tR\x03sku\x12+\n" +
+	"\x11short_description\x18\x06 \x01(\tR\x10shortDescription\x12)\n" +
+	"\x10long_description\x18\a \x01(\tR\x0flongDescription\x12\x12\n" +
+	"\x04tags\x18\b \x03(\tR\x04tags\x12\x1b\n" +
+	"\tseller_id\x18\t \x01(\x04R\bsellerId\x12\x16\n" +
+	"\x06locale\x18\n" +
+	" \x01(\tR\x06locale\x12!\n" +
+	"\fhas_variants\x18\v \x01(\bR\vhasVariants\x12\x14\n" +
+	"\x05price\x18\f \x01(\x01R\x05price\x12\x1b\n" +
+	"\tmin_price\x18\r \x01(\x01R\bminPrice\x12\x1b\n" +
+	"\tmax_price\x18\x0e \x01(\x01R\bmaxPrice\x12%\n" +
+	"\x0eallow_purchase\x18\x0f \x01(\bR\rallowPurchase\x129\n" +
+	"\bvariants\x18\x10 \x03(\v2\x1d.ecommerce.product.v1.VariantR\bvariants\x12\x1d\n" +
+	"\n" +
+	"image_urls\x18\x11 \x03(\tR\timageUrls\"J\n" +
+	"\x11GetProductRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x04R\tproductId\x12\x16\n" +
+	"\x06locale\x18\x02 \x01(\tR\x06locale\"M\n" +
+	"\x12GetProductResponse\x127\n" +
+	"\aproduct\x18\x01 \x01(\v2\x1d.ecommerce.product.v1.ProductR\aproduct\"\xe5\x01\n" +
+	"\x13ListProductsRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12!\n" +
+	"\fcategory_ids\x18\x03 \x03(\x04R\vcategoryIds\x12\x16\n" +
+	"\x06brands\x18\x04 \x03(\tR\x06brands\x12\x10\n" +
+	"\x03ids\x18\x05 \x03(\x04R\x03ids\x12\x1b\n" +
+	"\tmin_price\x18\x06 \x01(\x01R\bminPrice\x12\x1b\n" +
+	"\tmax_price\x18\a \x01(\x01R\bmaxPrice\x12\x16\n" +
+	"\x06locale\x18\b \x01(\tR\x06locale\"\x93\x01\n" +
+	"\x14ListProductsResponse\x129\n" +
+	"\bproducts\x18\x01 \x03(\v2\x1d.ecommerce.product.v1.ProductR\bproducts\x12@\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2 .ecommerce.product.v1.PaginationR\n" +
+	"pagination\"\x98\x02\n" +
+	"\x15SearchProductsRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x1f\n" +
+	"\vcategory_id\x18\x04 \x01(\x04R\n" +
+	"categoryId\x12\x14\n" +
+	"\x05brand\x18\x05 \x01(\tR\x05brand\x12\x1b\n" +
+	"\tmin_price\x18\x06 \x01(\x01R\bminPrice\x12\x1b\n" +
+	"\tmax_price\x18\a \x01(\x01R\bmaxPrice\x12\x17\n" +
+	"\asort_by\x18\b \x01(\tR\x06sortBy\x12\x1d\n" +
+	"\n" +
+	"sort_order\x18\t \x01(\tR\tsortOrder\x12\x16\n" +
+	"\x06locale\x18\n" +
+	" \x01(\tR\x06locale\"\x97\x01\n" +
+	"\fSearchResult\x127\n" +
+	"\aproduct\x18\x01 \x01(\v2\x1d.ecommerce.product.v1.ProductR\aproduct\x12'\n" +
+	"\x0frelevance_score\x18\x02 \x01(\x01R\x0erelevanceScore\x12%\n" +
+	"\x0ematched_fields\x18\x03 \x03(\tR\rmatchedFields\"\x98\x01\n" +
+	"\x16SearchProductsResponse\x12<\n" +
+	"\aresults\x18\x01 \x03(\v2\".ecommerce.product.v1.SearchResultR\aresults\x12@\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2 .ecommerce.product.v1.PaginationR\n" +
+	"pagination2\xc5\x02\n" +
+	"\x0eProductService\x12_\n" +
+	"\n" +
+	"GetProduct\x12'.ecommerce.product.v1.GetProductRequest\x1a(.ecommerce.product.v1.GetProductResponse\x12e\n" +
+	"\fListProducts\x12).ecommerce.product.v1.ListProductsRequest\x1a*.ecommerce.product.v1.ListProductsResponse\x12k\n" +
+	"\x0eSearchProducts\x12+.ecommerce.product.v1.SearchProductsRequest\x1a,.ecommerce.product.v1.SearchProductsResponseB)Z'ecommerce-be/proto/product/v1;productv1b\x06proto3"
+
+var (
+	file_product_v1_product_proto_rawDescOnce sync.Once
+	file_product_v1_product_proto_rawDescData []byte
+)
+
+func file_product_v1_product_proto_rawDescGZIP() []byte {
+	file_product_v1_product_proto_rawDescOnce.Do(func() {
+		file_product_v1_product_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_product_v1_product_proto_rawDesc), len(file_product_v1_product_proto_rawDesc)))
+	})
+	return file_product_v1_product_proto_rawDescData
+}
+
+var file_product_v1_product_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_product_v1_product_proto_goTypes = []any{
+	(*Pagination)(nil),             // 0: ecommerce.product.v1.Pagination
+	(*VariantOption)(nil),          // 1: ecommerce.product.v1.VariantOption
+	(*Variant)(nil),                // 2: ecommerce.product.v1.Variant
+	(*Product)(nil),                // 3: ecommerce.product.v1.Product
+	(*GetProductRequest)(nil),      // 4: ecommerce.product.v1.GetProductRequest
+	(*GetProductResponse)(nil),     // 5: ecommerce.product.v1.GetProductResponse
+	(*ListProductsRequest)(nil),    // 6: ecommerce.product.v1.ListProductsRequest
+	(*ListProductsResponse)(nil),   // 7: ecommerce.product.v1.ListProductsResponse
+	(*SearchProductsRequest)(nil),  // 8: ecommerce.product.v1.SearchProductsRequest
+	(*SearchResult)(nil),           // 9: ecommerce.product.v1.SearchResult
+	(*SearchProductsResponse)(nil), // 10: ecommerce.product.v1.SearchProductsResponse
+}
+var file_product_v1_product_proto_depIdxs = []int32{
+	1,  // 0: ecommerce.product.v1.Variant.selected_options:type_name -> ecommerce.product.v1.VariantOption
+	2,  // 1: ecommerce.product.v1.Product.variants:type_name -> ecommerce.product.v1.Variant
+	3,  // 2: ecommerce.product.v1.GetProductResponse.product:type_name -> ecommerce.product.v1.Product
+	3,  // 3: ecommerce.product.v1.ListProductsResponse.products:type_name -> ecommerce.product.v1.Product
+	0,  // 4: ecommerce.product.v1.ListProductsResponse.pagination:type_name -> ecommerce.product.v1.Pagination
+	3,  // 5: ecommerce.product.v1.SearchResult.product:type_name -> ecommerce.product.v1.Product
+	9,  // 6: ecommerce.product.v1.SearchProductsResponse.results:type_name -> ecommerce.product.v1.SearchResult
+	0,  // 7: ecommerce.product.v1.SearchProductsResponse.pagination:type_name -> ecommerce.product.v1.Pagination
+	4,  // 8: ecommerce.product.v1.ProductService.GetProduct:input_type -> ecommerce.product.v1.GetProductRequest
+	6,  // 9: ecommerce.product.v1.ProductService.ListProducts:input_type -> ecommerce.product.v1.ListProductsRequest
+	8,  // 10: ecommerce.product.v1.ProductService.SearchProducts:input_type -> ecommerce.product.v1.SearchProductsRequest
+	5,  // 11: ecommerce.product.v1.ProductService.GetProduct:output_type -> ecommerce.product.v1.GetProductResponse
+	7,  // 12: ecommerce.product.v1.ProductService.ListProducts:output_type -> ecommerce.product.v1.ListProductsResponse
+	10, // 13: ecommerce.product.v1.ProductService.SearchProducts:output_type -> ecommerce.product.v1.SearchProductsResponse
+	11, // [11:14] is the sub-list for method output_type
+	8,  // [8:11] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_product_v1_product_proto_init() }
+func file_product_v1_product_proto_init() {
+	if File_product_v1_product_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_product_v1_product_proto_rawDesc), len(file_product_v1_product_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_product_v1_product_proto_goTypes,
+		DependencyIndexes: file_product_v1_product_proto_depIdxs,
+		MessageInfos:      file_product_v1_product_proto_msgTypes,
+	}.Build()
+	File_product_v1_product_proto = out.File
+	file_product_v1_product_proto_goTypes = nil
+	file_product_v1_product_proto_depIdxs = nil
+}
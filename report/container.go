@@ -2,7 +2,11 @@ package report
 
 import (
 	"ecommerce-be/common"
+	"ecommerce-be/common/cron"
+	"ecommerce-be/report/factory/singleton"
 	routes "ecommerce-be/report/route"
+	"ecommerce-be/report/service"
+	"ecommerce-be/report/util"
 
 	"github.com/gin-gonic/gin"
 )
@@ -15,6 +19,9 @@ func NewContainer(router *gin.Engine) *common.Container {
 	// Register all modules
 	addModules(c)
 
+	// Register schedulers
+	registerScheduler()
+
 	// Register routes for each module
 	for _, module := range c.Modules {
 		module.RegisterRoutes(router)
@@ -27,3 +34,45 @@ func NewContainer(router *gin.Engine) *common.Container {
 func addModules(c *common.Container) {
 	c.RegisterModule(routes.NewReportModule())
 }
+
+// registerScheduler registers recurring background jobs
+func registerScheduler() {
+	// Alert sellers and admins when payment declines spike on a gateway. The job runs
+	// once per measurement window so each window is checked exactly once.
+	cron.RegisterIntervalJob(
+		service.DefaultDeclineAlertPolicy().Window,
+		util.PAYMENT_DECLINE_ALERT_JOB_NAME,
+		singleton.GetInstance().GetPaymentAnalyticsService().CheckDeclineSpikes,
+	)
+
+	// Rescore sellers once a day and apply warnings and listing demotion
+	cron.RegisterDailyJob(
+		service.DefaultSellerHealthPolicy().RunHourUTC,
+		0,
+		"UTC",
+		util.SELLER_HEALTH_JOB_NAME,
+		singleton.GetInstance().GetSellerHealthService().RecalculateHealth,
+	)
+
+	// Roll storefront product and search events up into the dashboard's daily tables
+	cron.RegisterIntervalJob(
+		util.PRODUCT_ANALYTICS_ROLLUP_INTERVAL,
+		util.PRODUCT_ANALYTICS_ROLLUP_JOB_NAME,
+		singleton.GetInstance().GetProductAnalyticsService().RollupEvents,
+	)
+
+	// Keep the sales dashboard rollups current, and rewrite the last weeks nightly for
+	// orders cancelled, returned or refunded after the day they were placed
+	cron.RegisterIntervalJob(
+		util.SALES_ROLLUP_INTERVAL,
+		util.SALES_ROLLUP_JOB_NAME,
+		singleton.GetInstance().GetSalesDashboardService().RollupRecent,
+	)
+	cron.RegisterDailyJob(
+		util.SALES_ROLLUP_BACKFILL_HOUR_UTC,
+		0,
+		"UTC",
+		util.SALES_ROLLUP_BACKFILL_JOB_NAME,
+		singleton.GetInstance().GetSalesDashboardService().RollupBackfill,
+	)
+}
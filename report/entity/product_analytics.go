@@ -0,0 +1,42 @@
+package entity
+
+import "time"
+
+// AnalyticsEvent is one storefront event as reported, kept until rolled up.
+// ProductID is set for product events and search clicks; SearchQuery for search
+// events and search clicks.
+type AnalyticsEvent struct {
+	ID          uint      `json:"id"          gorm:"primaryKey"`
+	SellerID    uint      `json:"sellerId"    gorm:"not null"`
+	EventType   string    `json:"eventType"   gorm:"size:20;not null"`
+	ProductID   *uint     `json:"productId"`
+	UserID      *uint     `json:"userId"`
+	SessionID   *string   `json:"sessionId"   gorm:"size:64"`
+	SearchQuery *string   `json:"searchQuery" gorm:"size:200"`
+	ResultCount *int      `json:"resultCount"`
+	Position    *int      `json:"position"`
+	CreatedAt   time.Time `json:"createdAt"   gorm:"autoCreateTime"`
+}
+
+// ProductAnalyticsDaily is a product's engagement on one UTC day
+type ProductAnalyticsDaily struct {
+	ProductID    uint      `json:"productId"    gorm:"primaryKey"`
+	Day          time.Time `json:"day"          gorm:"primaryKey;type:date"`
+	SellerID     uint      `json:"sellerId"     gorm:"not null"`
+	Impressions  int64     `json:"impressions"  gorm:"not null"`
+	Views        int64     `json:"views"        gorm:"not null"`
+	AddToCarts   int64     `json:"addToCarts"   gorm:"not null"`
+	SearchClicks int64     `json:"searchClicks" gorm:"not null"`
+}
+
+// SearchQueryDaily is a search query's use on one UTC day of a seller's storefront
+type SearchQueryDaily struct {
+	SellerID           uint      `json:"sellerId"           gorm:"primaryKey"`
+	Day                time.Time `json:"day"                gorm:"primaryKey;type:date"`
+	SearchQuery        string    `json:"searchQuery"        gorm:"primaryKey;size:200"`
+	Searches           int64     `json:"searches"           gorm:"not null"`
+	ZeroResultSearches int64     `json:"zeroResultSearches" gorm:"not null"`
+	ResultCountSum     int64     `json:"resultCountSum"     gorm:"not null"`
+	Clicks             int64     `json:"clicks"             gorm:"not null"`
+	ClickPositionSum   int64     `json:"clickPositionSum"   gorm:"not null"`
+}
@@ -0,0 +1,42 @@
+package entity
+
+import (
+	"time"
+
+	"ecommerce-be/common/db"
+)
+
+// SellerHealth is a seller's current health score, rewritten by the daily
+// aggregation. ListingDemoted pushes the seller's products below all others in
+// product search and catalog listings.
+type SellerHealth struct {
+	SellerID       uint           `json:"sellerId"       gorm:"primaryKey"`
+	Score          int            `json:"score"          gorm:"not null"`
+	Status         string         `json:"status"         gorm:"size:20;not null"`
+	RiskFlags      db.StringArray `json:"riskFlags"      gorm:"type:text[];not null"`
+	ListingDemoted bool           `json:"listingDemoted" gorm:"not null;default:false"`
+	DemotedAt      *time.Time     `json:"demotedAt"`
+	CalculatedAt   time.Time      `json:"calculatedAt"   gorm:"not null"`
+	CreatedAt      time.Time      `json:"createdAt"      gorm:"autoCreateTime"`
+	UpdatedAt      time.Time      `json:"updatedAt"      gorm:"autoUpdateTime"`
+}
+
+// SellerHealthSnapshot records one day's metrics and score of a seller, for trends.
+type SellerHealthSnapshot struct {
+	ID               uint           `json:"id"               gorm:"primaryKey"`
+	SellerID         uint           `json:"sellerId"         gorm:"not null"`
+	SnapshotDate     time.Time      `json:"snapshotDate"     gorm:"type:date;not null"`
+	WindowDays       int            `json:"windowDays"       gorm:"not null"`
+	OrderCount       int64          `json:"orderCount"       gorm:"not null"`
+	CancelledCount   int64          `json:"cancelledCount"   gorm:"not null"`
+	SLATrackedCount  int64          `json:"slaTrackedCount"  gorm:"column:sla_tracked_count;not null"`
+	SLABreachCount   int64          `json:"slaBreachCount"   gorm:"column:sla_breach_count;not null"`
+	TransactionCount int64          `json:"transactionCount" gorm:"not null"`
+	DisputeCount     int64          `json:"disputeCount"     gorm:"not null"`
+	ReviewCount      int64          `json:"reviewCount"      gorm:"not null"`
+	ReviewScore      *float64       `json:"reviewScore"`
+	Score            int            `json:"score"            gorm:"not null"`
+	Status           string         `json:"status"           gorm:"size:20;not null"`
+	RiskFlags        db.StringArray `json:"riskFlags"        gorm:"type:text[];not null"`
+	CreatedAt        time.Time      `json:"createdAt"        gorm:"autoCreateTime"`
+}
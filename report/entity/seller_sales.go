@@ -0,0 +1,25 @@
+package entity
+
+import "time"
+
+// SellerSalesDaily is a seller's sales on one UTC day. Orders count on the day they
+// were placed and refunds on the day they completed.
+type SellerSalesDaily struct {
+	SellerID      uint      `json:"sellerId"      gorm:"primaryKey"`
+	Day           time.Time `json:"day"           gorm:"primaryKey;type:date"`
+	OrderCount    int64     `json:"orderCount"    gorm:"not null"`
+	RevenueCents  int64     `json:"revenueCents"  gorm:"not null"`
+	UnitsSold     int64     `json:"unitsSold"     gorm:"not null"`
+	RefundCount   int64     `json:"refundCount"   gorm:"not null"`
+	RefundedCents int64     `json:"refundedCents" gorm:"not null"`
+}
+
+// SellerProductSalesDaily is a product's sales on one UTC day
+type SellerProductSalesDaily struct {
+	ProductID    uint      `json:"productId"    gorm:"primaryKey"`
+	Day          time.Time `json:"day"          gorm:"primaryKey;type:date"`
+	SellerID     uint      `json:"sellerId"     gorm:"not null"`
+	OrderCount   int64     `json:"orderCount"   gorm:"not null"`
+	UnitsSold    int64     `json:"unitsSold"    gorm:"not null"`
+	RevenueCents int64     `json:"revenueCents" gorm:"not null"`
+}
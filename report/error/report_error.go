@@ -0,0 +1,47 @@
+package error
+
+import (
+	"net/http"
+
+	commonError "ecommerce-be/common/error"
+	"ecommerce-be/report/util"
+)
+
+var (
+	// ErrInvalidReportFilter is returned when report query parameters cannot be resolved
+	ErrInvalidReportFilter = &commonError.AppError{
+		Code:       util.INVALID_REPORT_FILTER_CODE,
+		Message:    util.INVALID_REPORT_FILTER_MSG,
+		StatusCode: http.StatusBadRequest,
+	}
+
+	// ErrReportSellerNotFound is returned when drilling down into an unknown seller
+	ErrReportSellerNotFound = &commonError.AppError{
+		Code:       util.REPORT_SELLER_NOT_FOUND_CODE,
+		Message:    util.REPORT_SELLER_NOT_FOUND_MSG,
+		StatusCode: http.StatusNotFound,
+	}
+
+	// ErrSellerHealthNotFound is returned for sellers the health aggregation has not scored yet
+	ErrSellerHealthNotFound = &commonError.AppError{
+		Code:       util.SELLER_HEALTH_NOT_FOUND_CODE,
+		Message:    util.SELLER_HEALTH_NOT_FOUND_MSG,
+		StatusCode: http.StatusNotFound,
+	}
+
+	// ErrInvalidAnalyticsEvent is returned when a storefront event lacks what its type needs
+	ErrInvalidAnalyticsEvent = &commonError.AppError{
+		Code:       util.INVALID_ANALYTICS_EVENT_CODE,
+		Message:    util.INVALID_ANALYTICS_EVENT_MSG,
+		StatusCode: http.StatusBadRequest,
+	}
+)
+
+func init() {
+	commonError.Register(
+		ErrInvalidReportFilter,
+		ErrReportSellerNotFound,
+		ErrSellerHealthNotFound,
+		ErrInvalidAnalyticsEvent,
+	)
+}
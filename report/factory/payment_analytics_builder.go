@@ -0,0 +1,111 @@
+package factory
+
+import (
+	"math"
+
+	"ecommerce-be/report/model"
+	"ecommerce-be/report/repository"
+	"ecommerce-be/report/util"
+)
+
+type PaymentAnalyticsBuilder struct{}
+
+func NewPaymentAnalyticsBuilder() *PaymentAnalyticsBuilder {
+	return &PaymentAnalyticsBuilder{}
+}
+
+// BuildStats converts a transaction aggregate into authorization stats. Average
+// capture latency only considers captured transactions.
+func (b *PaymentAnalyticsBuilder) BuildStats(
+	agg repository.PaymentAuthorizationAggregate,
+) model.PaymentAuthorizationStats {
+	stats := model.PaymentAuthorizationStats{
+		Attempts:              agg.Attempts,
+		Authorized:            agg.Authorized,
+		Declined:              agg.Declined,
+		SuccessRatePercentage: util.RatePercentage(agg.Authorized, agg.Attempts),
+		DeclineRatePercentage: util.RatePercentage(agg.Declined, agg.Attempts),
+		CapturedCount:         agg.CapturedCount,
+	}
+	if agg.CapturedCount > 0 {
+		avg := agg.CaptureLatencySecondsTotal / float64(agg.CapturedCount)
+		stats.AvgCaptureLatencySeconds = math.Round(avg*100) / 100
+	}
+	return stats
+}
+
+// BuildTotals sums aggregates across all groups
+func (b *PaymentAnalyticsBuilder) BuildTotals(
+	aggregates []repository.PaymentAuthorizationAggregate,
+) model.PaymentAuthorizationStats {
+	var total repository.PaymentAuthorizationAggregate
+	for _, agg := range aggregates {
+		addAuthorizationAggregate(&total, agg)
+	}
+	return b.BuildStats(total)
+}
+
+// BuildGateways groups per card type aggregates by gateway, keeping the order in
+// which gateways first appear.
+func (b *PaymentAnalyticsBuilder) BuildGateways(
+	aggregates []repository.PaymentAuthorizationAggregate,
+) []model.GatewayAuthorizationStats {
+	gateways := make([]model.GatewayAuthorizationStats, 0)
+	totals := make([]repository.PaymentAuthorizationAggregate, 0)
+	indexByGateway := make(map[uint]int)
+	for _, agg := range aggregates {
+		idx, ok := indexByGateway[agg.GatewayID]
+		if !ok {
+			idx = len(gateways)
+			indexByGateway[agg.GatewayID] = idx
+			gateways = append(gateways, model.GatewayAuthorizationStats{
+				GatewayID:   agg.GatewayID,
+				GatewayCode: agg.GatewayCode,
+				GatewayName: agg.GatewayName,
+				CardTypes:   make([]model.CardTypeAuthorizationStats, 0),
+			})
+			totals = append(totals, repository.PaymentAuthorizationAggregate{})
+		}
+
+		addAuthorizationAggregate(&totals[idx], agg)
+		gateways[idx].CardTypes = append(gateways[idx].CardTypes,
+			model.CardTypeAuthorizationStats{
+				PaymentMethodType:         agg.PaymentMethodType,
+				CardBrand:                 agg.CardBrand,
+				PaymentAuthorizationStats: b.BuildStats(agg),
+			})
+	}
+	for i := range gateways {
+		gateways[i].PaymentAuthorizationStats = b.BuildStats(totals[i])
+	}
+	return gateways
+}
+
+// BuildDeclineReasons reports each failure code's share of all declines
+func (b *PaymentAnalyticsBuilder) BuildDeclineReasons(
+	reasons []repository.DeclineReasonAggregate,
+	totalDeclined int64,
+) []model.DeclineReasonRow {
+	rows := make([]model.DeclineReasonRow, 0, len(reasons))
+	for _, reason := range reasons {
+		rows = append(rows, model.DeclineReasonRow{
+			GatewayID:   reason.GatewayID,
+			GatewayCode: reason.GatewayCode,
+			FailureCode: reason.FailureCode,
+			Count:       reason.Count,
+			Percentage:  util.RatePercentage(reason.Count, totalDeclined),
+		})
+	}
+	return rows
+}
+
+func addAuthorizationAggregate(
+	total *repository.PaymentAuthorizationAggregate,
+	agg repository.PaymentAuthorizationAggregate,
+) {
+	total.Attempts += agg.Attempts
+	total.Authorized += agg.Authorized
+	total.Declined += agg.Declined
+	total.CapturedCount += agg.CapturedCount
+	total.CaptureLatencySecondsTotal += agg.CaptureLatencySecondsTotal
+}
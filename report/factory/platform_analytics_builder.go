@@ -0,0 +1,186 @@
+package factory
+
+import (
+	"strconv"
+
+	"ecommerce-be/report/model"
+	"ecommerce-be/report/repository"
+	"ecommerce-be/report/util"
+)
+
+type PlatformAnalyticsBuilder struct{}
+
+func NewPlatformAnalyticsBuilder() *PlatformAnalyticsBuilder {
+	return &PlatformAnalyticsBuilder{}
+}
+
+func (b *PlatformAnalyticsBuilder) BuildMetrics(
+	agg repository.PlatformAggregate,
+) model.PlatformMetrics {
+	return model.PlatformMetrics{
+		GMVCents:           agg.GMVCents,
+		CommissionCents:    agg.CommissionCents,
+		TakeRatePercentage: util.RatePercentage(agg.CommissionCents, agg.GMVCents),
+		OrderCount:         agg.OrderCount,
+		ActiveSellers:      agg.ActiveSellers,
+	}
+}
+
+// BuildTotals returns one row per currency seen in either period. With compare on,
+// each row carries the previous period and the change against it.
+func (b *PlatformAnalyticsBuilder) BuildTotals(
+	current, previous []repository.PlatformAggregate,
+	compare bool,
+) []model.PlatformTotals {
+	previousByCurrency := make(map[string]repository.PlatformAggregate, len(previous))
+	for _, agg := range previous {
+		previousByCurrency[agg.Currency] = agg
+	}
+
+	totals := make([]model.PlatformTotals, 0, len(current))
+	seen := make(map[string]bool, len(current))
+	add := func(agg repository.PlatformAggregate) {
+		row := model.PlatformTotals{
+			Currency:        agg.Currency,
+			PlatformMetrics: b.BuildMetrics(agg),
+		}
+		if compare {
+			prev := previousByCurrency[agg.Currency]
+			prevMetrics := b.BuildMetrics(prev)
+			row.Previous = &prevMetrics
+			row.Changes = &model.PlatformMetricChanges{
+				GMVPercentage:           util.PercentageChange(prev.GMVCents, agg.GMVCents),
+				CommissionPercentage:    util.PercentageChange(prev.CommissionCents, agg.CommissionCents),
+				OrderCountPercentage:    util.PercentageChange(prev.OrderCount, agg.OrderCount),
+				ActiveSellersPercentage: util.PercentageChange(prev.ActiveSellers, agg.ActiveSellers),
+			}
+		}
+		seen[agg.Currency] = true
+		totals = append(totals, row)
+	}
+
+	for _, agg := range current {
+		add(agg)
+	}
+	if compare {
+		// A currency that only sold in the previous period still shows its drop
+		for _, agg := range previous {
+			if !seen[agg.Currency] {
+				add(repository.PlatformAggregate{Currency: agg.Currency})
+			}
+		}
+	}
+	return totals
+}
+
+func (b *PlatformAnalyticsBuilder) BuildPeriods(
+	aggregates []repository.PlatformAggregate,
+) []model.PlatformPeriod {
+	periods := make([]model.PlatformPeriod, 0, len(aggregates))
+	for _, agg := range aggregates {
+		periods = append(periods, model.PlatformPeriod{
+			Period:          agg.Bucket,
+			Currency:        agg.Currency,
+			PlatformMetrics: b.BuildMetrics(agg),
+		})
+	}
+	return periods
+}
+
+func (b *PlatformAnalyticsBuilder) BuildLeaderboard(
+	aggregates []repository.SellerRankAggregate,
+	compare bool,
+) []model.SellerLeaderboardRow {
+	rows := make([]model.SellerLeaderboardRow, 0, len(aggregates))
+	for _, agg := range aggregates {
+		row := model.SellerLeaderboardRow{
+			Rank:               agg.Rank,
+			SellerID:           agg.SellerID,
+			SellerName:         agg.SellerName,
+			Currency:           agg.Currency,
+			GMVCents:           agg.GMVCents,
+			CommissionCents:    agg.CommissionCents,
+			TakeRatePercentage: util.RatePercentage(agg.CommissionCents, agg.GMVCents),
+			OrderCount:         agg.OrderCount,
+			GMVSharePercentage: util.RatePercentage(agg.GMVCents, agg.PlatformGMVCents),
+		}
+		if compare {
+			prevGMV := agg.PrevGMVCents
+			change := util.PercentageChange(agg.PrevGMVCents, agg.GMVCents)
+			row.PreviousGMVCents = &prevGMV
+			row.GMVChangePercentage = &change
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+// BuildLeaderboardCSV renders leaderboard rows as CSV records including a header row.
+// Previous period columns are left empty when compare is off.
+func (b *PlatformAnalyticsBuilder) BuildLeaderboardCSV(
+	rows []model.SellerLeaderboardRow,
+) [][]string {
+	records := make([][]string, 0, len(rows)+1)
+	records = append(records, []string{
+		"rank",
+		"seller_id",
+		"seller_name",
+		"currency",
+		"gmv_cents",
+		"commission_cents",
+		"take_rate_percentage",
+		"order_count",
+		"gmv_share_percentage",
+		"previous_gmv_cents",
+		"gmv_change_percentage",
+	})
+	for _, row := range rows {
+		var prevGMV, change string
+		if row.PreviousGMVCents != nil {
+			prevGMV = strconv.FormatInt(*row.PreviousGMVCents, 10)
+		}
+		if row.GMVChangePercentage != nil {
+			change = strconv.FormatFloat(*row.GMVChangePercentage, 'f', 2, 64)
+		}
+		records = append(records, []string{
+			strconv.FormatInt(row.Rank, 10),
+			strconv.FormatUint(uint64(row.SellerID), 10),
+			row.SellerName,
+			row.Currency,
+			strconv.FormatInt(row.GMVCents, 10),
+			strconv.FormatInt(row.CommissionCents, 10),
+			strconv.FormatFloat(row.TakeRatePercentage, 'f', 2, 64),
+			strconv.FormatInt(row.OrderCount, 10),
+			strconv.FormatFloat(row.GMVSharePercentage, 'f', 2, 64),
+			prevGMV,
+			change,
+		})
+	}
+	return records
+}
+
+// BuildPeriodCSV renders per-period rows as CSV records including a header row
+func (b *PlatformAnalyticsBuilder) BuildPeriodCSV(periods []model.PlatformPeriod) [][]string {
+	records := make([][]string, 0, len(periods)+1)
+	records = append(records, []string{
+		"period",
+		"currency",
+		"gmv_cents",
+		"commission_cents",
+		"take_rate_percentage",
+		"order_count",
+		"active_sellers",
+	})
+	for _, period := range periods {
+		records = append(records, []string{
+			period.Period,
+			period.Currency,
+			strconv.FormatInt(period.GMVCents, 10),
+			strconv.FormatInt(period.CommissionCents, 10),
+			strconv.FormatFloat(period.TakeRatePercentage, 'f', 2, 64),
+			strconv.FormatInt(period.OrderCount, 10),
+			strconv.FormatInt(period.ActiveSellers, 10),
+		})
+	}
+	return records
+}
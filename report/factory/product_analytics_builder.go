@@ -0,0 +1,81 @@
+package factory
+
+import (
+	"math"
+
+	"ecommerce-be/report/model"
+	"ecommerce-be/report/repository"
+	"ecommerce-be/report/util"
+)
+
+type ProductAnalyticsBuilder struct{}
+
+func NewProductAnalyticsBuilder() *ProductAnalyticsBuilder {
+	return &ProductAnalyticsBuilder{}
+}
+
+// BuildEngagementStats converts summed engagement into counts with funnel rates
+func (b *ProductAnalyticsBuilder) BuildEngagementStats(
+	agg repository.ProductEngagementAggregate,
+) model.ProductEngagementStats {
+	return model.ProductEngagementStats{
+		Impressions:             agg.Impressions,
+		Views:                   agg.Views,
+		AddToCarts:              agg.AddToCarts,
+		SearchClicks:            agg.SearchClicks,
+		ViewRatePercentage:      util.RatePercentage(agg.Views, agg.Impressions),
+		AddToCartRatePercentage: util.RatePercentage(agg.AddToCarts, agg.Views),
+	}
+}
+
+func (b *ProductAnalyticsBuilder) BuildProductRows(
+	aggregates []repository.ProductEngagementAggregate,
+) []model.ProductEngagementRow {
+	rows := make([]model.ProductEngagementRow, 0, len(aggregates))
+	for _, agg := range aggregates {
+		rows = append(rows, model.ProductEngagementRow{
+			ProductID:              agg.ProductID,
+			ProductName:            agg.ProductName,
+			ProductEngagementStats: b.BuildEngagementStats(agg),
+		})
+	}
+	return rows
+}
+
+// BuildSearchStats converts summed search use into counts, rates and averages.
+// Click-through is clicks per search, so it can pass 100% when shoppers open
+// several results.
+func (b *ProductAnalyticsBuilder) BuildSearchStats(
+	agg repository.SearchQueryAggregate,
+) model.SearchQueryStats {
+	return model.SearchQueryStats{
+		Searches:                   agg.Searches,
+		ZeroResultSearches:         agg.ZeroResultSearches,
+		ZeroResultRatePercentage:   util.RatePercentage(agg.ZeroResultSearches, agg.Searches),
+		AvgResultCount:             average(agg.ResultCountSum, agg.Searches),
+		Clicks:                     agg.Clicks,
+		ClickThroughRatePercentage: util.RatePercentage(agg.Clicks, agg.Searches),
+		AvgClickPosition:           average(agg.ClickPositionSum, agg.Clicks),
+	}
+}
+
+func (b *ProductAnalyticsBuilder) BuildSearchRows(
+	aggregates []repository.SearchQueryAggregate,
+) []model.SearchQueryRow {
+	rows := make([]model.SearchQueryRow, 0, len(aggregates))
+	for _, agg := range aggregates {
+		rows = append(rows, model.SearchQueryRow{
+			Query:            agg.SearchQuery,
+			SearchQueryStats: b.BuildSearchStats(agg),
+		})
+	}
+	return rows
+}
+
+// average returns sum / count rounded to two decimals, or 0 when count is 0
+func average(sum, count int64) float64 {
+	if count == 0 {
+		return 0
+	}
+	return math.Round(float64(sum)/float64(count)*100) / 100
+}
@@ -0,0 +1,158 @@
+package factory
+
+import (
+	"math"
+	"strconv"
+	"time"
+
+	paymentEntity "ecommerce-be/payment/entity"
+	"ecommerce-be/report/model"
+	"ecommerce-be/report/repository"
+)
+
+type RevenueReportBuilder struct{}
+
+func NewRevenueReportBuilder() *RevenueReportBuilder {
+	return &RevenueReportBuilder{}
+}
+
+// BuildTotals converts a ledger aggregate into report totals. Net seller earnings
+// are what remains of GMV after commission, gateway fees, refunds and chargebacks;
+// payouts are reported separately because they only move money that was already earned.
+func (b *RevenueReportBuilder) BuildTotals(agg repository.RevenueAggregate) model.RevenueTotals {
+	return model.RevenueTotals{
+		Currency:        agg.Currency,
+		GMVCents:        agg.GMVCents,
+		CommissionCents: agg.CommissionCents,
+		GatewayFeeCents: agg.GatewayFeeCents,
+		RefundCents:     agg.RefundCents,
+		ChargebackCents: agg.ChargebackCents,
+		PayoutCents:     agg.PayoutCents,
+		NetSellerEarningsCents: agg.GMVCents - agg.CommissionCents - agg.GatewayFeeCents -
+			agg.RefundCents - agg.ChargebackCents,
+		OrderCount:         agg.OrderCount,
+		TakeRatePercentage: b.calculateTakeRate(agg.CommissionCents, agg.GMVCents),
+	}
+}
+
+func (b *RevenueReportBuilder) BuildTotalsList(
+	aggregates []repository.RevenueAggregate,
+) []model.RevenueTotals {
+	totals := make([]model.RevenueTotals, 0, len(aggregates))
+	for _, agg := range aggregates {
+		totals = append(totals, b.BuildTotals(agg))
+	}
+	return totals
+}
+
+func (b *RevenueReportBuilder) BuildSellerRows(
+	aggregates []repository.RevenueAggregate,
+) []model.SellerRevenueRow {
+	rows := make([]model.SellerRevenueRow, 0, len(aggregates))
+	for _, agg := range aggregates {
+		rows = append(rows, model.SellerRevenueRow{
+			SellerID:      agg.SellerID,
+			SellerName:    agg.SellerName,
+			RevenueTotals: b.BuildTotals(agg),
+		})
+	}
+	return rows
+}
+
+func (b *RevenueReportBuilder) BuildPeriods(
+	aggregates []repository.RevenueAggregate,
+) []model.RevenuePeriod {
+	periods := make([]model.RevenuePeriod, 0, len(aggregates))
+	for _, agg := range aggregates {
+		periods = append(periods, model.RevenuePeriod{
+			Period:        agg.Bucket,
+			RevenueTotals: b.BuildTotals(agg),
+		})
+	}
+	return periods
+}
+
+func (b *RevenueReportBuilder) BuildLedgerEntries(
+	entries []paymentEntity.SellerLedgerEntry,
+) []model.LedgerEntryItem {
+	items := make([]model.LedgerEntryItem, 0, len(entries))
+	for _, entry := range entries {
+		items = append(items, model.LedgerEntryItem{
+			ID:                   entry.ID,
+			EntryType:            string(entry.EntryType),
+			Currency:             entry.Currency,
+			AmountCents:          entry.AmountCents,
+			OrderID:              entry.OrderID,
+			PaymentTransactionID: entry.PaymentTransactionID,
+			RefundID:             entry.RefundID,
+			ChargebackID:         entry.ChargebackID,
+			Description:          entry.Description,
+			OccurredAt:           entry.OccurredAt.UTC().Format(time.RFC3339),
+		})
+	}
+	return items
+}
+
+// BuildSellerCSV renders per-seller rows as CSV records including a header row
+func (b *RevenueReportBuilder) BuildSellerCSV(rows []model.SellerRevenueRow) [][]string {
+	records := make([][]string, 0, len(rows)+1)
+	records = append(records, append([]string{"seller_id", "seller_name"}, totalsCSVHeader()...))
+	for _, row := range rows {
+		records = append(records, append(
+			[]string{strconv.FormatUint(uint64(row.SellerID), 10), row.SellerName},
+			totalsCSVValues(row.RevenueTotals)...,
+		))
+	}
+	return records
+}
+
+// BuildPeriodCSV renders per-period rows as CSV records including a header row
+func (b *RevenueReportBuilder) BuildPeriodCSV(periods []model.RevenuePeriod) [][]string {
+	records := make([][]string, 0, len(periods)+1)
+	records = append(records, append([]string{"period"}, totalsCSVHeader()...))
+	for _, period := range periods {
+		records = append(records, append(
+			[]string{period.Period},
+			totalsCSVValues(period.RevenueTotals)...,
+		))
+	}
+	return records
+}
+
+func (b *RevenueReportBuilder) calculateTakeRate(commissionCents, gmvCents int64) float64 {
+	if gmvCents == 0 {
+		return 0.0
+	}
+	rate := float64(commissionCents) / float64(gmvCents) * 100
+	return math.Round(rate*100) / 100
+}
+
+func totalsCSVHeader() []string {
+	return []string{
+		"currency",
+		"gmv_cents",
+		"commission_cents",
+		"gateway_fee_cents",
+		"refund_cents",
+		"chargeback_cents",
+		"payout_cents",
+		"net_seller_earnings_cents",
+		"order_count",
+		"take_rate_percentage",
+	}
+}
+
+func totalsCSVValues(t model.RevenueTotals) []string {
+	return []string{
+		t.Currency,
+		strconv.FormatInt(t.GMVCents, 10),
+		strconv.FormatInt(t.CommissionCents, 10),
+		strconv.FormatInt(t.GatewayFeeCents, 10),
+		strconv.FormatInt(t.RefundCents, 10),
+		strconv.FormatInt(t.ChargebackCents, 10),
+		strconv.FormatInt(t.PayoutCents, 10),
+		strconv.FormatInt(t.NetSellerEarningsCents, 10),
+		strconv.Itoa(t.OrderCount),
+		strconv.FormatFloat(t.TakeRatePercentage, 'f', 2, 64),
+	}
+}
@@ -0,0 +1,137 @@
+package factory
+
+import (
+	"math"
+	"time"
+
+	"ecommerce-be/report/model"
+	"ecommerce-be/report/repository"
+	"ecommerce-be/report/util"
+)
+
+type SalesDashboardBuilder struct{}
+
+func NewSalesDashboardBuilder() *SalesDashboardBuilder {
+	return &SalesDashboardBuilder{}
+}
+
+// BuildStats converts summed sales into amounts with average order value and
+// refund rate
+func (b *SalesDashboardBuilder) BuildStats(day repository.SellerSalesDay) model.SalesStats {
+	stats := model.SalesStats{
+		Orders:               day.OrderCount,
+		Revenue:              float64(day.RevenueCents) / 100,
+		UnitsSold:            day.UnitsSold,
+		Refunds:              day.RefundCount,
+		RefundedAmount:       float64(day.RefundedCents) / 100,
+		RefundRatePercentage: util.RatePercentage(day.RefundedCents, day.RevenueCents),
+	}
+	if day.OrderCount > 0 {
+		stats.AverageOrderValue = math.Round(float64(day.RevenueCents)/float64(day.OrderCount)) / 100
+	}
+	return stats
+}
+
+// BuildSeries buckets the daily rollups by granularity with one point per bucket
+// from start to end, empty buckets included, and returns the period totals
+func (b *SalesDashboardBuilder) BuildSeries(
+	days []repository.SellerSalesDay,
+	start, end time.Time,
+	granularity string,
+) (model.SalesStats, []model.SalesSeriesPoint) {
+	buckets := make(map[time.Time]*repository.SellerSalesDay)
+	var total repository.SellerSalesDay
+	for _, day := range days {
+		key := util.SalesBucketStart(day.Day, granularity)
+		bucket, ok := buckets[key]
+		if !ok {
+			bucket = &repository.SellerSalesDay{Day: key}
+			buckets[key] = bucket
+		}
+		addSalesDay(bucket, day)
+		addSalesDay(&total, day)
+	}
+
+	series := make([]model.SalesSeriesPoint, 0)
+	last := util.SalesBucketStart(end, granularity)
+	for current := util.SalesBucketStart(start, granularity); !current.After(last); {
+		point := model.SalesSeriesPoint{PeriodStart: current.Format(time.DateOnly)}
+		if bucket, ok := buckets[current]; ok {
+			point.SalesStats = b.BuildStats(*bucket)
+		}
+		series = append(series, point)
+		current = util.NextSalesBucket(current, granularity)
+	}
+	return b.BuildStats(total), series
+}
+
+func addSalesDay(total *repository.SellerSalesDay, day repository.SellerSalesDay) {
+	total.OrderCount += day.OrderCount
+	total.RevenueCents += day.RevenueCents
+	total.UnitsSold += day.UnitsSold
+	total.RefundCount += day.RefundCount
+	total.RefundedCents += day.RefundedCents
+}
+
+func (b *SalesDashboardBuilder) BuildTopProducts(
+	aggregates []repository.ProductSalesAggregate,
+) []model.TopProductRow {
+	rows := make([]model.TopProductRow, 0, len(aggregates))
+	for _, agg := range aggregates {
+		rows = append(rows, model.TopProductRow{
+			ProductID:   agg.ProductID,
+			ProductName: agg.ProductName,
+			Orders:      agg.OrderCount,
+			UnitsSold:   agg.UnitsSold,
+			Revenue:     float64(agg.RevenueCents) / 100,
+		})
+	}
+	return rows
+}
+
+func (b *SalesDashboardBuilder) BuildTopCategories(
+	aggregates []repository.CategorySalesAggregate,
+) []model.TopCategoryRow {
+	rows := make([]model.TopCategoryRow, 0, len(aggregates))
+	for _, agg := range aggregates {
+		rows = append(rows, model.TopCategoryRow{
+			CategoryID:   agg.CategoryID,
+			CategoryName: agg.CategoryName,
+			UnitsSold:    agg.UnitsSold,
+			Revenue:      float64(agg.RevenueCents) / 100,
+		})
+	}
+	return rows
+}
+
+// BuildFunnel lays storefront engagement and orders out as funnel stages
+func (b *SalesDashboardBuilder) BuildFunnel(
+	engagement repository.ProductEngagementAggregate,
+	orders int64,
+) []model.ConversionFunnelStep {
+	stages := []struct {
+		name  string
+		count int64
+	}{
+		{util.FUNNEL_STAGE_IMPRESSIONS, engagement.Impressions},
+		{util.FUNNEL_STAGE_VIEWS, engagement.Views},
+		{util.FUNNEL_STAGE_ADD_TO_CARTS, engagement.AddToCarts},
+		{util.FUNNEL_STAGE_ORDERS, orders},
+	}
+
+	steps := make([]model.ConversionFunnelStep, 0, len(stages))
+	for i, stage := range stages {
+		step := model.ConversionFunnelStep{
+			Stage:                 stage.name,
+			Count:                 stage.count,
+			StepRatePercentage:    100,
+			OverallRatePercentage: 100,
+		}
+		if i > 0 {
+			step.StepRatePercentage = util.RatePercentage(stage.count, stages[i-1].count)
+			step.OverallRatePercentage = util.RatePercentage(stage.count, stages[0].count)
+		}
+		steps = append(steps, step)
+	}
+	return steps
+}
@@ -0,0 +1,71 @@
+package factory
+
+import (
+	"time"
+
+	"ecommerce-be/report/entity"
+	"ecommerce-be/report/model"
+	"ecommerce-be/report/repository"
+	"ecommerce-be/report/util"
+)
+
+type SellerHealthBuilder struct{}
+
+func NewSellerHealthBuilder() *SellerHealthBuilder {
+	return &SellerHealthBuilder{}
+}
+
+func (b *SellerHealthBuilder) BuildRow(row repository.SellerHealthRow) model.SellerHealthRow {
+	var demotedAt *string
+	if row.DemotedAt != nil {
+		formatted := row.DemotedAt.UTC().Format(time.RFC3339)
+		demotedAt = &formatted
+	}
+	return model.SellerHealthRow{
+		SellerID:       row.SellerID,
+		SellerName:     row.SellerName,
+		Score:          row.Score,
+		Status:         row.Status,
+		RiskFlags:      nonNilFlags(row.RiskFlags),
+		ListingDemoted: row.ListingDemoted,
+		DemotedAt:      demotedAt,
+		CalculatedAt:   row.CalculatedAt.UTC().Format(time.RFC3339),
+	}
+}
+
+func (b *SellerHealthBuilder) BuildRows(rows []repository.SellerHealthRow) []model.SellerHealthRow {
+	result := make([]model.SellerHealthRow, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, b.BuildRow(row))
+	}
+	return result
+}
+
+// BuildTrend converts daily snapshots into trend points, oldest first
+func (b *SellerHealthBuilder) BuildTrend(
+	snapshots []entity.SellerHealthSnapshot,
+) []model.SellerHealthTrendPoint {
+	trend := make([]model.SellerHealthTrendPoint, 0, len(snapshots))
+	for _, s := range snapshots {
+		trend = append(trend, model.SellerHealthTrendPoint{
+			Date:             s.SnapshotDate.Format(time.DateOnly),
+			Score:            s.Score,
+			Status:           s.Status,
+			RiskFlags:        nonNilFlags(s.RiskFlags),
+			OrderCount:       s.OrderCount,
+			CancellationRate: util.RatePercentage(s.CancelledCount, s.OrderCount),
+			SLABreachRate:    util.RatePercentage(s.SLABreachCount, s.SLATrackedCount),
+			DisputeRate:      util.RatePercentage(s.DisputeCount, s.TransactionCount),
+			ReviewCount:      s.ReviewCount,
+			ReviewScore:      s.ReviewScore,
+		})
+	}
+	return trend
+}
+
+func nonNilFlags(flags []string) []string {
+	if flags == nil {
+		return []string{}
+	}
+	return flags
+}
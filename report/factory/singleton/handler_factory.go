@@ -5,7 +5,13 @@ import (
 )
 
 type HandlerFactory struct {
-	reportHandler *handler.ReportHandler
+	reportHandler            *handler.ReportHandler
+	revenueReportHandler     *handler.RevenueReportHandler
+	paymentAnalyticsHandler  *handler.PaymentAnalyticsHandler
+	sellerHealthHandler      *handler.SellerHealthHandler
+	productAnalyticsHandler  *handler.ProductAnalyticsHandler
+	salesDashboardHandler    *handler.SalesDashboardHandler
+	platformAnalyticsHandler *handler.PlatformAnalyticsHandler
 }
 
 func NewHandlerFactory(serviceFactory *ServiceFactory) *HandlerFactory {
@@ -13,9 +19,51 @@ func NewHandlerFactory(serviceFactory *ServiceFactory) *HandlerFactory {
 		reportHandler: handler.NewReportHandler(
 			serviceFactory.GetReportService(),
 		),
+		revenueReportHandler: handler.NewRevenueReportHandler(
+			serviceFactory.GetRevenueReportService(),
+		),
+		paymentAnalyticsHandler: handler.NewPaymentAnalyticsHandler(
+			serviceFactory.GetPaymentAnalyticsService(),
+		),
+		sellerHealthHandler: handler.NewSellerHealthHandler(
+			serviceFactory.GetSellerHealthService(),
+		),
+		productAnalyticsHandler: handler.NewProductAnalyticsHandler(
+			serviceFactory.GetProductAnalyticsService(),
+		),
+		salesDashboardHandler: handler.NewSalesDashboardHandler(
+			serviceFactory.GetSalesDashboardService(),
+		),
+		platformAnalyticsHandler: handler.NewPlatformAnalyticsHandler(
+			serviceFactory.GetPlatformAnalyticsService(),
+		),
 	}
 }
 
 func (f *HandlerFactory) GetReportHandler() *handler.ReportHandler {
 	return f.reportHandler
 }
+
+func (f *HandlerFactory) GetRevenueReportHandler() *handler.RevenueReportHandler {
+	return f.revenueReportHandler
+}
+
+func (f *HandlerFactory) GetPaymentAnalyticsHandler() *handler.PaymentAnalyticsHandler {
+	return f.paymentAnalyticsHandler
+}
+
+func (f *HandlerFactory) GetSellerHealthHandler() *handler.SellerHealthHandler {
+	return f.sellerHealthHandler
+}
+
+func (f *HandlerFactory) GetProductAnalyticsHandler() *handler.ProductAnalyticsHandler {
+	return f.productAnalyticsHandler
+}
+
+func (f *HandlerFactory) GetSalesDashboardHandler() *handler.SalesDashboardHandler {
+	return f.salesDashboardHandler
+}
+
+func (f *HandlerFactory) GetPlatformAnalyticsHandler() *handler.PlatformAnalyticsHandler {
+	return f.platformAnalyticsHandler
+}
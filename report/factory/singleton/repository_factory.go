@@ -6,15 +6,51 @@ import (
 )
 
 type RepositoryFactory struct {
-	reportRepository repository.ReportRepository
+	reportRepository            repository.ReportRepository
+	revenueReportRepository     repository.RevenueReportRepository
+	paymentAnalyticsRepository  repository.PaymentAnalyticsRepository
+	sellerHealthRepository      repository.SellerHealthRepository
+	productAnalyticsRepository  repository.ProductAnalyticsRepository
+	salesDashboardRepository    repository.SalesDashboardRepository
+	platformAnalyticsRepository repository.PlatformAnalyticsRepository
 }
 
 func NewRepositoryFactory() *RepositoryFactory {
 	return &RepositoryFactory{
-		reportRepository: repository.NewReportRepository(db.GetDB()),
+		reportRepository:            repository.NewReportRepository(db.GetDB()),
+		revenueReportRepository:     repository.NewRevenueReportRepository(db.GetDB()),
+		paymentAnalyticsRepository:  repository.NewPaymentAnalyticsRepository(db.GetDB()),
+		sellerHealthRepository:      repository.NewSellerHealthRepository(db.GetDB()),
+		productAnalyticsRepository:  repository.NewProductAnalyticsRepository(db.GetDB()),
+		salesDashboardRepository:    repository.NewSalesDashboardRepository(db.GetDB()),
+		platformAnalyticsRepository: repository.NewPlatformAnalyticsRepository(db.GetDB()),
 	}
 }
 
 func (f *RepositoryFactory) GetReportRepository() repository.ReportRepository {
 	return f.reportRepository
 }
+
+func (f *RepositoryFactory) GetRevenueReportRepository() repository.RevenueReportRepository {
+	return f.revenueReportRepository
+}
+
+func (f *RepositoryFactory) GetPaymentAnalyticsRepository() repository.PaymentAnalyticsRepository {
+	return f.paymentAnalyticsRepository
+}
+
+func (f *RepositoryFactory) GetSellerHealthRepository() repository.SellerHealthRepository {
+	return f.sellerHealthRepository
+}
+
+func (f *RepositoryFactory) GetProductAnalyticsRepository() repository.ProductAnalyticsRepository {
+	return f.productAnalyticsRepository
+}
+
+func (f *RepositoryFactory) GetSalesDashboardRepository() repository.SalesDashboardRepository {
+	return f.salesDashboardRepository
+}
+
+func (f *RepositoryFactory) GetPlatformAnalyticsRepository() repository.PlatformAnalyticsRepository {
+	return f.platformAnalyticsRepository
+}
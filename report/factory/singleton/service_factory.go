@@ -1,26 +1,90 @@
 package singleton
 
 import (
+	notificationFactory "ecommerce-be/notification/factory/singleton"
+	notificationGateway "ecommerce-be/notification/gateway"
 	"ecommerce-be/report/factory"
 	"ecommerce-be/report/service"
 )
 
 type ServiceFactory struct {
-	reportService service.ReportService
+	reportService            service.ReportService
+	revenueReportService     service.RevenueReportService
+	paymentAnalyticsService  service.PaymentAnalyticsService
+	sellerHealthService      service.SellerHealthService
+	productAnalyticsService  service.ProductAnalyticsService
+	salesDashboardService    service.SalesDashboardService
+	platformAnalyticsService service.PlatformAnalyticsService
 }
 
 func NewServiceFactory(repoFactory *RepositoryFactory) *ServiceFactory {
 	summaryBuilder := factory.NewSummaryResponseBuilder()
 	trendsBuilder := factory.NewSalesTrendsResponseBuilder()
+	notifier := notificationGateway.NewNotifier(
+		notificationFactory.GetInstance().GetNotificationDispatchService(),
+	)
 	return &ServiceFactory{
 		reportService: service.NewReportService(
 			repoFactory.GetReportRepository(),
 			summaryBuilder,
 			trendsBuilder,
 		),
+		revenueReportService: service.NewRevenueReportService(
+			repoFactory.GetRevenueReportRepository(),
+			factory.NewRevenueReportBuilder(),
+		),
+		paymentAnalyticsService: service.NewPaymentAnalyticsService(
+			repoFactory.GetPaymentAnalyticsRepository(),
+			factory.NewPaymentAnalyticsBuilder(),
+			notifier,
+			service.DefaultDeclineAlertPolicy(),
+		),
+		sellerHealthService: service.NewSellerHealthService(
+			repoFactory.GetSellerHealthRepository(),
+			factory.NewSellerHealthBuilder(),
+			notifier,
+			service.DefaultSellerHealthPolicy(),
+		),
+		productAnalyticsService: service.NewProductAnalyticsService(
+			repoFactory.GetProductAnalyticsRepository(),
+			factory.NewProductAnalyticsBuilder(),
+		),
+		salesDashboardService: service.NewSalesDashboardService(
+			repoFactory.GetSalesDashboardRepository(),
+			factory.NewSalesDashboardBuilder(),
+		),
+		platformAnalyticsService: service.NewPlatformAnalyticsService(
+			repoFactory.GetPlatformAnalyticsRepository(),
+			repoFactory.GetRevenueReportRepository(),
+			factory.NewPlatformAnalyticsBuilder(),
+		),
 	}
 }
 
 func (f *ServiceFactory) GetReportService() service.ReportService {
 	return f.reportService
 }
+
+func (f *ServiceFactory) GetRevenueReportService() service.RevenueReportService {
+	return f.revenueReportService
+}
+
+func (f *ServiceFactory) GetPaymentAnalyticsService() service.PaymentAnalyticsService {
+	return f.paymentAnalyticsService
+}
+
+func (f *ServiceFactory) GetSellerHealthService() service.SellerHealthService {
+	return f.sellerHealthService
+}
+
+func (f *ServiceFactory) GetProductAnalyticsService() service.ProductAnalyticsService {
+	return f.productAnalyticsService
+}
+
+func (f *ServiceFactory) GetSalesDashboardService() service.SalesDashboardService {
+	return f.salesDashboardService
+}
+
+func (f *ServiceFactory) GetPlatformAnalyticsService() service.PlatformAnalyticsService {
+	return f.platformAnalyticsService
+}
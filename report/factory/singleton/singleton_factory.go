@@ -51,3 +51,51 @@ func (f *SingletonFactory) GetReportService() service.ReportService {
 func (f *SingletonFactory) GetReportHandler() *handler.ReportHandler {
 	return f.handlerFactory.GetReportHandler()
 }
+
+func (f *SingletonFactory) GetRevenueReportRepository() repository.RevenueReportRepository {
+	return f.repoFactory.GetRevenueReportRepository()
+}
+
+func (f *SingletonFactory) GetRevenueReportService() service.RevenueReportService {
+	return f.serviceFactory.GetRevenueReportService()
+}
+
+func (f *SingletonFactory) GetRevenueReportHandler() *handler.RevenueReportHandler {
+	return f.handlerFactory.GetRevenueReportHandler()
+}
+
+func (f *SingletonFactory) GetPaymentAnalyticsService() service.PaymentAnalyticsService {
+	return f.serviceFactory.GetPaymentAnalyticsService()
+}
+
+func (f *SingletonFactory) GetPaymentAnalyticsHandler() *handler.PaymentAnalyticsHandler {
+	return f.handlerFactory.GetPaymentAnalyticsHandler()
+}
+
+func (f *SingletonFactory) GetSellerHealthService() service.SellerHealthService {
+	return f.serviceFactory.GetSellerHealthService()
+}
+
+func (f *SingletonFactory) GetSellerHealthHandler() *handler.SellerHealthHandler {
+	return f.handlerFactory.GetSellerHealthHandler()
+}
+
+func (f *SingletonFactory) GetProductAnalyticsService() service.ProductAnalyticsService {
+	return f.serviceFactory.GetProductAnalyticsService()
+}
+
+func (f *SingletonFactory) GetProductAnalyticsHandler() *handler.ProductAnalyticsHandler {
+	return f.handlerFactory.GetProductAnalyticsHandler()
+}
+
+func (f *SingletonFactory) GetSalesDashboardService() service.SalesDashboardService {
+	return f.serviceFactory.GetSalesDashboardService()
+}
+
+func (f *SingletonFactory) GetSalesDashboardHandler() *handler.SalesDashboardHandler {
+	return f.handlerFactory.GetSalesDashboardHandler()
+}
+
+func (f *SingletonFactory) GetPlatformAnalyticsHandler() *handler.PlatformAnalyticsHandler {
+	return f.handlerFactory.GetPlatformAnalyticsHandler()
+}
@@ -0,0 +1,23 @@
+package handler
+
+import (
+	"ecommerce-be/common/handler"
+	"ecommerce-be/common/validator"
+
+	"github.com/gin-gonic/gin"
+)
+
+// bindReportFilter binds the query of a report filter, which embeds
+// util.ReportQueryFilter, and checks its custom period. Writes the error response and
+// returns false when the query is invalid.
+func bindReportFilter(c *gin.Context, h *handler.BaseHandler, filter any) bool {
+	if err := c.ShouldBindQuery(filter); err != nil {
+		h.HandleValidationError(c, err)
+		return false
+	}
+	if err := validator.RequireLessOrEqual(filter, "start_date", "end_date"); err != nil {
+		h.HandleValidationError(c, err)
+		return false
+	}
+	return true
+}
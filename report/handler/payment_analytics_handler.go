@@ -0,0 +1,69 @@
+package handler
+
+import (
+	"net/http"
+
+	"ecommerce-be/common/auth"
+	"ecommerce-be/common/constants"
+	errs "ecommerce-be/common/error"
+	"ecommerce-be/common/handler"
+	"ecommerce-be/common/log"
+	"ecommerce-be/report/model"
+	"ecommerce-be/report/service"
+	"ecommerce-be/report/util"
+
+	"github.com/gin-gonic/gin"
+)
+
+type PaymentAnalyticsHandler struct {
+	*handler.BaseHandler
+	analyticsSvc service.PaymentAnalyticsService
+}
+
+func NewPaymentAnalyticsHandler(
+	analyticsSvc service.PaymentAnalyticsService,
+) *PaymentAnalyticsHandler {
+	return &PaymentAnalyticsHandler{
+		BaseHandler:  handler.NewBaseHandler(),
+		analyticsSvc: analyticsSvc,
+	}
+}
+
+// GetSellerPaymentAnalytics returns authorization analytics for the seller's payments
+func (h *PaymentAnalyticsHandler) GetSellerPaymentAnalytics(c *gin.Context) {
+	sellerID, exists := auth.GetSellerIDFromContext(c)
+	if !exists {
+		h.HandleError(c, errs.ErrSellerDataMissing, constants.SELLER_DATA_MISSING_MSG)
+		return
+	}
+
+	var filter model.PaymentAnalyticsFilter
+	if !bindReportFilter(c, h.BaseHandler, &filter) {
+		return
+	}
+
+	res, err := h.analyticsSvc.GetPaymentAnalytics(c, &sellerID, filter)
+	if err != nil {
+		log.ErrorWithContext(c, "getSellerPaymentAnalytics: failed", err)
+		h.HandleError(c, err, util.FAILED_TO_FETCH_PAYMENT_ANALYTICS_MSG)
+		return
+	}
+	h.Success(c, http.StatusOK, util.PAYMENT_ANALYTICS_FETCHED_MSG, res)
+}
+
+// GetPaymentAnalytics returns platform-wide authorization analytics, optionally for
+// one seller
+func (h *PaymentAnalyticsHandler) GetPaymentAnalytics(c *gin.Context) {
+	var filter model.PaymentAnalyticsFilter
+	if !bindReportFilter(c, h.BaseHandler, &filter) {
+		return
+	}
+
+	res, err := h.analyticsSvc.GetPaymentAnalytics(c, nil, filter)
+	if err != nil {
+		log.ErrorWithContext(c, "getPaymentAnalytics: failed", err)
+		h.HandleError(c, err, util.FAILED_TO_FETCH_PAYMENT_ANALYTICS_MSG)
+		return
+	}
+	h.Success(c, http.StatusOK, util.PAYMENT_ANALYTICS_FETCHED_MSG, res)
+}
@@ -0,0 +1,118 @@
+package handler
+
+import (
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"time"
+
+	"ecommerce-be/common/handler"
+	"ecommerce-be/common/log"
+	"ecommerce-be/report/model"
+	"ecommerce-be/report/service"
+	"ecommerce-be/report/util"
+
+	"github.com/gin-gonic/gin"
+)
+
+type PlatformAnalyticsHandler struct {
+	*handler.BaseHandler
+	platformSvc service.PlatformAnalyticsService
+}
+
+func NewPlatformAnalyticsHandler(
+	platformSvc service.PlatformAnalyticsService,
+) *PlatformAnalyticsHandler {
+	return &PlatformAnalyticsHandler{
+		BaseHandler: handler.NewBaseHandler(),
+		platformSvc: platformSvc,
+	}
+}
+
+// GetOverview returns platform GMV, take rate, active sellers and order volume
+func (h *PlatformAnalyticsHandler) GetOverview(c *gin.Context) {
+	var filter model.PlatformAnalyticsFilter
+	if !bindReportFilter(c, h.BaseHandler, &filter) {
+		return
+	}
+
+	res, err := h.platformSvc.GetOverview(c, filter)
+	if err != nil {
+		log.ErrorWithContext(c, "getPlatformOverview: failed", err)
+		h.HandleError(c, err, util.FAILED_TO_FETCH_PLATFORM_ANALYTICS_MSG)
+		return
+	}
+	h.Success(c, http.StatusOK, util.PLATFORM_ANALYTICS_FETCHED_MSG, res)
+}
+
+// GetLeaderboard ranks active sellers by GMV, orders or commission
+func (h *PlatformAnalyticsHandler) GetLeaderboard(c *gin.Context) {
+	var filter model.PlatformAnalyticsFilter
+	if !bindReportFilter(c, h.BaseHandler, &filter) {
+		return
+	}
+
+	res, err := h.platformSvc.GetLeaderboard(c, filter)
+	if err != nil {
+		log.ErrorWithContext(c, "getSellerLeaderboard: failed", err)
+		h.HandleError(c, err, util.FAILED_TO_FETCH_PLATFORM_ANALYTICS_MSG)
+		return
+	}
+	h.Success(c, http.StatusOK, util.PLATFORM_ANALYTICS_FETCHED_MSG, res)
+}
+
+// GetSellerDetail drills down into one seller's rank and activity over time
+func (h *PlatformAnalyticsHandler) GetSellerDetail(c *gin.Context) {
+	sellerID, err := h.ParseUintParam(c, "sellerId")
+	if err != nil {
+		h.HandleError(c, err, util.FAILED_TO_FETCH_PLATFORM_ANALYTICS_MSG)
+		return
+	}
+
+	var filter model.PlatformAnalyticsFilter
+	if !bindReportFilter(c, h.BaseHandler, &filter) {
+		return
+	}
+
+	res, err := h.platformSvc.GetSellerDetail(c, sellerID, filter)
+	if err != nil {
+		log.ErrorWithContext(c, "getSellerPlatformDetail: failed", err)
+		h.HandleError(c, err, util.FAILED_TO_FETCH_PLATFORM_ANALYTICS_MSG)
+		return
+	}
+	h.Success(c, http.StatusOK, util.PLATFORM_ANALYTICS_FETCHED_MSG, res)
+}
+
+// Export streams the seller leaderboard or the per-period series as CSV
+func (h *PlatformAnalyticsHandler) Export(c *gin.Context) {
+	var filter model.PlatformAnalyticsFilter
+	if !bindReportFilter(c, h.BaseHandler, &filter) {
+		return
+	}
+
+	records, err := h.platformSvc.Export(c, filter)
+	if err != nil {
+		log.ErrorWithContext(c, "exportPlatformAnalytics: failed", err)
+		h.HandleError(c, err, util.FAILED_TO_EXPORT_PLATFORM_ANALYTICS_MSG)
+		return
+	}
+
+	groupBy := filter.GroupBy
+	if groupBy == "" {
+		groupBy = util.REVENUE_GROUP_BY_SELLER
+	}
+	filename := fmt.Sprintf(
+		"platform_by_%s_%s.csv",
+		groupBy,
+		time.Now().UTC().Format("20060102T150405Z"),
+	)
+
+	c.Header("Content-Type", "text/csv; charset=utf-8")
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	c.Status(http.StatusOK)
+
+	writer := csv.NewWriter(c.Writer)
+	if err := writer.WriteAll(records); err != nil {
+		log.ErrorWithContext(c, "exportPlatformAnalytics: failed to write csv", err)
+	}
+}
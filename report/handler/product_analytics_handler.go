@@ -0,0 +1,101 @@
+package handler
+
+import (
+	"net/http"
+
+	"ecommerce-be/common/auth"
+	"ecommerce-be/common/constants"
+	errs "ecommerce-be/common/error"
+	"ecommerce-be/common/handler"
+	"ecommerce-be/common/log"
+	"ecommerce-be/report/model"
+	"ecommerce-be/report/service"
+	"ecommerce-be/report/util"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ProductAnalyticsHandler struct {
+	*handler.BaseHandler
+	analyticsSvc service.ProductAnalyticsService
+}
+
+func NewProductAnalyticsHandler(
+	analyticsSvc service.ProductAnalyticsService,
+) *ProductAnalyticsHandler {
+	return &ProductAnalyticsHandler{
+		BaseHandler:  handler.NewBaseHandler(),
+		analyticsSvc: analyticsSvc,
+	}
+}
+
+// RecordEvents stores a storefront's batch of product and search events
+func (h *ProductAnalyticsHandler) RecordEvents(c *gin.Context) {
+	sellerID, exists := auth.GetSellerIDFromContext(c)
+	if !exists {
+		h.HandleError(c, errs.ErrSellerDataMissing, constants.SELLER_DATA_MISSING_MSG)
+		return
+	}
+	var userID *uint
+	if id, ok := auth.GetUserIDFromContext(c); ok && id != 0 {
+		userID = &id
+	}
+
+	var req model.RecordAnalyticsEventsRequest
+	if err := h.BindJSON(c, &req); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	res, err := h.analyticsSvc.RecordEvents(c, sellerID, userID, req)
+	if err != nil {
+		log.ErrorWithContext(c, "recordAnalyticsEvents: failed", err)
+		h.HandleError(c, err, util.FAILED_TO_RECORD_ANALYTICS_MSG)
+		return
+	}
+	h.Success(c, http.StatusAccepted, util.ANALYTICS_EVENTS_RECORDED_MSG, res)
+}
+
+// GetProductAnalytics returns the seller's product impressions, views and add-to-carts
+func (h *ProductAnalyticsHandler) GetProductAnalytics(c *gin.Context) {
+	sellerID, exists := auth.GetSellerIDFromContext(c)
+	if !exists {
+		h.HandleError(c, errs.ErrSellerDataMissing, constants.SELLER_DATA_MISSING_MSG)
+		return
+	}
+
+	var filter model.ProductAnalyticsFilter
+	if !bindReportFilter(c, h.BaseHandler, &filter) {
+		return
+	}
+
+	res, err := h.analyticsSvc.GetProductAnalytics(c, sellerID, filter)
+	if err != nil {
+		log.ErrorWithContext(c, "getProductAnalytics: failed", err)
+		h.HandleError(c, err, util.FAILED_TO_FETCH_PRODUCT_ANALYTICS_MSG)
+		return
+	}
+	h.Success(c, http.StatusOK, util.PRODUCT_ANALYTICS_FETCHED_MSG, res)
+}
+
+// GetSearchAnalytics returns the seller's search queries with result and click stats
+func (h *ProductAnalyticsHandler) GetSearchAnalytics(c *gin.Context) {
+	sellerID, exists := auth.GetSellerIDFromContext(c)
+	if !exists {
+		h.HandleError(c, errs.ErrSellerDataMissing, constants.SELLER_DATA_MISSING_MSG)
+		return
+	}
+
+	var filter model.SearchAnalyticsFilter
+	if !bindReportFilter(c, h.BaseHandler, &filter) {
+		return
+	}
+
+	res, err := h.analyticsSvc.GetSearchAnalytics(c, sellerID, filter)
+	if err != nil {
+		log.ErrorWithContext(c, "getSearchAnalytics: failed", err)
+		h.HandleError(c, err, util.FAILED_TO_FETCH_SEARCH_ANALYTICS_MSG)
+		return
+	}
+	h.Success(c, http.StatusOK, util.SEARCH_ANALYTICS_FETCHED_MSG, res)
+}
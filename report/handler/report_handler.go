@@ -4,6 +4,7 @@ import (
 	"net/http"
 
 	"ecommerce-be/common/handler"
+	reportError "ecommerce-be/report/error"
 	"ecommerce-be/report/service"
 	"ecommerce-be/report/util"
 
@@ -26,8 +27,7 @@ func NewReportHandler(reportSvc service.ReportService) *ReportHandler {
 func (h *ReportHandler) GetSummary(c *gin.Context) {
 	var filter util.ReportQueryFilter
 
-	if err := c.ShouldBindQuery(&filter); err != nil {
-		h.HandleError(c, err, "Invalid query parameters")
+	if !bindReportFilter(c, h.BaseHandler, &filter) {
 		return
 	}
 
@@ -37,9 +37,9 @@ func (h *ReportHandler) GetSummary(c *gin.Context) {
 
 	res, err := h.reportSvc.GetSummary(c.Request.Context(), filter)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"error": "Invalid query filter: " + err.Error(),
-		})
+		h.HandleError(c, reportError.ErrInvalidReportFilter.WithMessage(
+			"Invalid query filter: "+err.Error(),
+		), "Invalid query filter")
 		return
 	}
 	h.Success(c, http.StatusOK, "Success", res)
@@ -49,16 +49,15 @@ func (h *ReportHandler) GetSummary(c *gin.Context) {
 func (h *ReportHandler) GetSalesTrends(c *gin.Context) {
 	var filter util.ReportQueryFilter
 
-	if err := c.ShouldBindQuery(&filter); err != nil {
-		h.HandleError(c, err, "Invalid query parameters")
+	if !bindReportFilter(c, h.BaseHandler, &filter) {
 		return
 	}
 
 	res, err := h.reportSvc.GetSalesTrends(c.Request.Context(), filter)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"error": "Failed to fetch sales trends: " + err.Error(),
-		})
+		h.HandleError(c, reportError.ErrInvalidReportFilter.WithMessage(
+			"Failed to fetch sales trends: "+err.Error(),
+		), "Failed to fetch sales trends")
 		return
 	}
 	h.Success(c, http.StatusOK, "Success", res)
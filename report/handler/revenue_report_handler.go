@@ -0,0 +1,154 @@
+package handler
+
+import (
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"time"
+
+	"ecommerce-be/common/handler"
+	"ecommerce-be/common/log"
+	"ecommerce-be/report/model"
+	"ecommerce-be/report/service"
+	"ecommerce-be/report/util"
+
+	"github.com/gin-gonic/gin"
+)
+
+type RevenueReportHandler struct {
+	*handler.BaseHandler
+	revenueSvc service.RevenueReportService
+}
+
+func NewRevenueReportHandler(revenueSvc service.RevenueReportService) *RevenueReportHandler {
+	return &RevenueReportHandler{
+		BaseHandler: handler.NewBaseHandler(),
+		revenueSvc:  revenueSvc,
+	}
+}
+
+// GetRevenueSummary returns platform-wide GMV, commission, refunds and chargebacks
+func (h *RevenueReportHandler) GetRevenueSummary(c *gin.Context) {
+	var filter model.RevenueReportFilter
+	if !bindReportFilter(c, h.BaseHandler, &filter) {
+		return
+	}
+
+	res, err := h.revenueSvc.GetRevenueSummary(c, filter)
+	if err != nil {
+		log.ErrorWithContext(c, "getRevenueSummary: failed", err)
+		h.HandleError(c, err, util.FAILED_TO_FETCH_REVENUE_REPORT_MSG)
+		return
+	}
+	h.Success(c, http.StatusOK, util.REVENUE_REPORT_FETCHED_MSG, res)
+}
+
+// GetRevenueBySeller returns revenue aggregates per seller and currency
+func (h *RevenueReportHandler) GetRevenueBySeller(c *gin.Context) {
+	var filter model.RevenueReportFilter
+	if !bindReportFilter(c, h.BaseHandler, &filter) {
+		return
+	}
+
+	res, err := h.revenueSvc.GetRevenueBySeller(c, filter)
+	if err != nil {
+		log.ErrorWithContext(c, "getRevenueBySeller: failed", err)
+		h.HandleError(c, err, util.FAILED_TO_FETCH_REVENUE_REPORT_MSG)
+		return
+	}
+	h.Success(c, http.StatusOK, util.REVENUE_REPORT_FETCHED_MSG, res)
+}
+
+// GetRevenueByPeriod returns revenue aggregates per time bucket and currency
+func (h *RevenueReportHandler) GetRevenueByPeriod(c *gin.Context) {
+	var filter model.RevenueReportFilter
+	if !bindReportFilter(c, h.BaseHandler, &filter) {
+		return
+	}
+
+	res, err := h.revenueSvc.GetRevenueByPeriod(c, filter)
+	if err != nil {
+		log.ErrorWithContext(c, "getRevenueByPeriod: failed", err)
+		h.HandleError(c, err, util.FAILED_TO_FETCH_REVENUE_REPORT_MSG)
+		return
+	}
+	h.Success(c, http.StatusOK, util.REVENUE_REPORT_FETCHED_MSG, res)
+}
+
+// GetSellerRevenueDetail drills down into one seller's totals and period breakdown
+func (h *RevenueReportHandler) GetSellerRevenueDetail(c *gin.Context) {
+	sellerID, err := h.ParseUintParam(c, "sellerId")
+	if err != nil {
+		h.HandleError(c, err, util.FAILED_TO_FETCH_REVENUE_REPORT_MSG)
+		return
+	}
+
+	var filter model.RevenueReportFilter
+	if !bindReportFilter(c, h.BaseHandler, &filter) {
+		return
+	}
+
+	res, err := h.revenueSvc.GetSellerRevenueDetail(c, sellerID, filter)
+	if err != nil {
+		log.ErrorWithContext(c, "getSellerRevenueDetail: failed", err)
+		h.HandleError(c, err, util.FAILED_TO_FETCH_REVENUE_REPORT_MSG)
+		return
+	}
+	h.Success(c, http.StatusOK, util.REVENUE_REPORT_FETCHED_MSG, res)
+}
+
+// GetSellerLedgerEntries drills down into the ledger entries behind a seller's totals
+func (h *RevenueReportHandler) GetSellerLedgerEntries(c *gin.Context) {
+	sellerID, err := h.ParseUintParam(c, "sellerId")
+	if err != nil {
+		h.HandleError(c, err, util.FAILED_TO_FETCH_REVENUE_REPORT_MSG)
+		return
+	}
+
+	var filter model.LedgerEntriesFilter
+	if !bindReportFilter(c, h.BaseHandler, &filter) {
+		return
+	}
+
+	res, err := h.revenueSvc.GetSellerLedgerEntries(c, sellerID, filter)
+	if err != nil {
+		log.ErrorWithContext(c, "getSellerLedgerEntries: failed", err)
+		h.HandleError(c, err, util.FAILED_TO_FETCH_REVENUE_REPORT_MSG)
+		return
+	}
+	h.Success(c, http.StatusOK, util.REVENUE_REPORT_FETCHED_MSG, res)
+}
+
+// ExportRevenue streams the per-seller or per-period revenue report as CSV
+func (h *RevenueReportHandler) ExportRevenue(c *gin.Context) {
+	var filter model.RevenueReportFilter
+	if !bindReportFilter(c, h.BaseHandler, &filter) {
+		return
+	}
+
+	records, err := h.revenueSvc.ExportRevenue(c, filter)
+	if err != nil {
+		log.ErrorWithContext(c, "exportRevenue: failed", err)
+		h.HandleError(c, err, util.FAILED_TO_EXPORT_REVENUE_REPORT_MSG)
+		return
+	}
+
+	groupBy := filter.GroupBy
+	if groupBy == "" {
+		groupBy = util.REVENUE_GROUP_BY_SELLER
+	}
+	filename := fmt.Sprintf(
+		"revenue_by_%s_%s.csv",
+		groupBy,
+		time.Now().UTC().Format("20060102T150405Z"),
+	)
+
+	c.Header("Content-Type", "text/csv; charset=utf-8")
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	c.Status(http.StatusOK)
+
+	writer := csv.NewWriter(c.Writer)
+	if err := writer.WriteAll(records); err != nil {
+		log.ErrorWithContext(c, "exportRevenue: failed to write csv", err)
+	}
+}
@@ -0,0 +1,116 @@
+package handler
+
+import (
+	"net/http"
+
+	"ecommerce-be/common/auth"
+	"ecommerce-be/common/constants"
+	errs "ecommerce-be/common/error"
+	"ecommerce-be/common/handler"
+	"ecommerce-be/common/log"
+	"ecommerce-be/report/model"
+	"ecommerce-be/report/service"
+	"ecommerce-be/report/util"
+
+	"github.com/gin-gonic/gin"
+)
+
+type SalesDashboardHandler struct {
+	*handler.BaseHandler
+	salesSvc service.SalesDashboardService
+}
+
+func NewSalesDashboardHandler(salesSvc service.SalesDashboardService) *SalesDashboardHandler {
+	return &SalesDashboardHandler{
+		BaseHandler: handler.NewBaseHandler(),
+		salesSvc:    salesSvc,
+	}
+}
+
+// GetSales returns the seller's revenue, orders, AOV and refund rate over time
+func (h *SalesDashboardHandler) GetSales(c *gin.Context) {
+	sellerID, exists := auth.GetSellerIDFromContext(c)
+	if !exists {
+		h.HandleError(c, errs.ErrSellerDataMissing, constants.SELLER_DATA_MISSING_MSG)
+		return
+	}
+
+	var filter model.SalesDashboardFilter
+	if !bindReportFilter(c, h.BaseHandler, &filter) {
+		return
+	}
+
+	res, err := h.salesSvc.GetSales(c, sellerID, filter)
+	if err != nil {
+		log.ErrorWithContext(c, "getSalesDashboard: failed", err)
+		h.HandleError(c, err, util.FAILED_TO_FETCH_SALES_DASHBOARD_MSG)
+		return
+	}
+	h.Success(c, http.StatusOK, util.SALES_DASHBOARD_FETCHED_MSG, res)
+}
+
+// GetTopProducts returns the seller's best selling products
+func (h *SalesDashboardHandler) GetTopProducts(c *gin.Context) {
+	sellerID, exists := auth.GetSellerIDFromContext(c)
+	if !exists {
+		h.HandleError(c, errs.ErrSellerDataMissing, constants.SELLER_DATA_MISSING_MSG)
+		return
+	}
+
+	var filter model.SalesRankingFilter
+	if !bindReportFilter(c, h.BaseHandler, &filter) {
+		return
+	}
+
+	res, err := h.salesSvc.GetTopProducts(c, sellerID, filter)
+	if err != nil {
+		log.ErrorWithContext(c, "getDashboardTopProducts: failed", err)
+		h.HandleError(c, err, util.FAILED_TO_FETCH_SALES_DASHBOARD_MSG)
+		return
+	}
+	h.Success(c, http.StatusOK, util.SALES_DASHBOARD_FETCHED_MSG, res)
+}
+
+// GetTopCategories returns the seller's best selling categories
+func (h *SalesDashboardHandler) GetTopCategories(c *gin.Context) {
+	sellerID, exists := auth.GetSellerIDFromContext(c)
+	if !exists {
+		h.HandleError(c, errs.ErrSellerDataMissing, constants.SELLER_DATA_MISSING_MSG)
+		return
+	}
+
+	var filter model.SalesRankingFilter
+	if !bindReportFilter(c, h.BaseHandler, &filter) {
+		return
+	}
+
+	res, err := h.salesSvc.GetTopCategories(c, sellerID, filter)
+	if err != nil {
+		log.ErrorWithContext(c, "getDashboardTopCategories: failed", err)
+		h.HandleError(c, err, util.FAILED_TO_FETCH_SALES_DASHBOARD_MSG)
+		return
+	}
+	h.Success(c, http.StatusOK, util.SALES_DASHBOARD_FETCHED_MSG, res)
+}
+
+// GetConversionFunnel returns impressions through orders with the rate between stages
+func (h *SalesDashboardHandler) GetConversionFunnel(c *gin.Context) {
+	sellerID, exists := auth.GetSellerIDFromContext(c)
+	if !exists {
+		h.HandleError(c, errs.ErrSellerDataMissing, constants.SELLER_DATA_MISSING_MSG)
+		return
+	}
+
+	var filter model.SalesDashboardFilter
+	if !bindReportFilter(c, h.BaseHandler, &filter) {
+		return
+	}
+
+	res, err := h.salesSvc.GetConversionFunnel(c, sellerID, filter)
+	if err != nil {
+		log.ErrorWithContext(c, "getConversionFunnel: failed", err)
+		h.HandleError(c, err, util.FAILED_TO_FETCH_SALES_DASHBOARD_MSG)
+		return
+	}
+	h.Success(c, http.StatusOK, util.SALES_DASHBOARD_FETCHED_MSG, res)
+}
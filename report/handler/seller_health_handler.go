@@ -0,0 +1,65 @@
+package handler
+
+import (
+	"net/http"
+
+	"ecommerce-be/common/handler"
+	"ecommerce-be/common/log"
+	"ecommerce-be/report/model"
+	"ecommerce-be/report/service"
+	"ecommerce-be/report/util"
+
+	"github.com/gin-gonic/gin"
+)
+
+type SellerHealthHandler struct {
+	*handler.BaseHandler
+	healthSvc service.SellerHealthService
+}
+
+func NewSellerHealthHandler(healthSvc service.SellerHealthService) *SellerHealthHandler {
+	return &SellerHealthHandler{
+		BaseHandler: handler.NewBaseHandler(),
+		healthSvc:   healthSvc,
+	}
+}
+
+// ListSellerHealth returns sellers' current health scores and risk flags
+func (h *SellerHealthHandler) ListSellerHealth(c *gin.Context) {
+	var filter model.SellerHealthFilter
+	if err := c.ShouldBindQuery(&filter); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	res, err := h.healthSvc.ListSellerHealth(c, filter)
+	if err != nil {
+		log.ErrorWithContext(c, "listSellerHealth: failed", err)
+		h.HandleError(c, err, util.FAILED_TO_FETCH_SELLER_HEALTH_MSG)
+		return
+	}
+	h.Success(c, http.StatusOK, util.SELLER_HEALTH_FETCHED_MSG, res)
+}
+
+// GetSellerHealthDetail returns a seller's current health with its daily trend
+func (h *SellerHealthHandler) GetSellerHealthDetail(c *gin.Context) {
+	sellerID, err := h.ParseUintParam(c, "sellerId")
+	if err != nil {
+		h.HandleError(c, err, util.FAILED_TO_FETCH_SELLER_HEALTH_MSG)
+		return
+	}
+
+	var filter model.SellerHealthTrendFilter
+	if err := c.ShouldBindQuery(&filter); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	res, err := h.healthSvc.GetSellerHealthDetail(c, sellerID, filter)
+	if err != nil {
+		log.ErrorWithContext(c, "getSellerHealthDetail: failed", err)
+		h.HandleError(c, err, util.FAILED_TO_FETCH_SELLER_HEALTH_MSG)
+		return
+	}
+	h.Success(c, http.StatusOK, util.SELLER_HEALTH_FETCHED_MSG, res)
+}
@@ -0,0 +1,58 @@
+package model
+
+import "ecommerce-be/report/util"
+
+// PaymentAnalyticsFilter extends the universal time filter with payment analytics
+// options. SellerID is only honoured for admins; sellers always see their own payments.
+type PaymentAnalyticsFilter struct {
+	util.ReportQueryFilter
+	SellerID  *uint `form:"seller_id"`
+	GatewayID *uint `form:"gateway_id"`
+}
+
+// PaymentAuthorizationStats holds authorization outcomes of a group of transactions.
+// Attempts exclude transactions still pending.
+type PaymentAuthorizationStats struct {
+	Attempts                 int64   `json:"attempts"`
+	Authorized               int64   `json:"authorized"`
+	Declined                 int64   `json:"declined"`
+	SuccessRatePercentage    float64 `json:"success_rate_percentage"`
+	DeclineRatePercentage    float64 `json:"decline_rate_percentage"`
+	CapturedCount            int64   `json:"captured_count"`
+	AvgCaptureLatencySeconds float64 `json:"avg_capture_latency_seconds"`
+}
+
+// CardTypeAuthorizationStats breaks a gateway down by payment method and card brand.
+// CardBrand is empty for non-card methods and cards whose brand is unknown.
+type CardTypeAuthorizationStats struct {
+	PaymentMethodType string `json:"payment_method_type"`
+	CardBrand         string `json:"card_brand"`
+	PaymentAuthorizationStats
+}
+
+// GatewayAuthorizationStats holds a gateway's totals and its card type breakdown.
+// Payments without a gateway (cash on delivery) are reported under gateway 0.
+type GatewayAuthorizationStats struct {
+	GatewayID   uint                         `json:"gateway_id"`
+	GatewayCode string                       `json:"gateway_code"`
+	GatewayName string                       `json:"gateway_name"`
+	CardTypes   []CardTypeAuthorizationStats `json:"card_types"`
+	PaymentAuthorizationStats
+}
+
+type DeclineReasonRow struct {
+	GatewayID   uint    `json:"gateway_id"`
+	GatewayCode string  `json:"gateway_code"`
+	FailureCode string  `json:"failure_code"`
+	Count       int64   `json:"count"`
+	Percentage  float64 `json:"percentage"`
+}
+
+type PaymentAnalyticsResponse struct {
+	StartDate      string                      `json:"start_date"`
+	EndDate        string                      `json:"end_date"`
+	SellerID       *uint                       `json:"seller_id,omitempty"`
+	Totals         PaymentAuthorizationStats   `json:"totals"`
+	Gateways       []GatewayAuthorizationStats `json:"gateways"`
+	DeclineReasons []DeclineReasonRow          `json:"decline_reasons"`
+}
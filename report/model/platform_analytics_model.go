@@ -0,0 +1,91 @@
+package model
+
+import "ecommerce-be/report/util"
+
+// PlatformAnalyticsFilter extends the universal time filter with admin platform options
+type PlatformAnalyticsFilter struct {
+	util.ReportQueryFilter
+	Currency string `form:"currency"`
+	SortBy   string `form:"sort_by"` // leaderboard only: gmv, orders, commission
+	Limit    int    `form:"limit"`
+	Offset   int    `form:"offset"`
+	GroupBy  string `form:"group_by"` // export only: seller (default) or period
+}
+
+// PlatformMetrics holds platform activity for one currency. Amounts are in cents.
+// Active sellers are sellers with at least one sale in the period.
+type PlatformMetrics struct {
+	GMVCents           int64   `json:"gmv_cents"`
+	CommissionCents    int64   `json:"commission_cents"`
+	TakeRatePercentage float64 `json:"take_rate_percentage"`
+	OrderCount         int64   `json:"order_count"`
+	ActiveSellers      int64   `json:"active_sellers"`
+}
+
+// PlatformMetricChanges compares a period with the previous one, in percent
+type PlatformMetricChanges struct {
+	GMVPercentage           float64 `json:"gmv_percentage"`
+	CommissionPercentage    float64 `json:"commission_percentage"`
+	OrderCountPercentage    float64 `json:"order_count_percentage"`
+	ActiveSellersPercentage float64 `json:"active_sellers_percentage"`
+}
+
+type PlatformTotals struct {
+	Currency string `json:"currency"`
+	PlatformMetrics
+	// Previous and Changes are only set when compare is on
+	Previous *PlatformMetrics       `json:"previous,omitempty"`
+	Changes  *PlatformMetricChanges `json:"changes,omitempty"`
+}
+
+type PlatformPeriod struct {
+	Period   string `json:"period"`
+	Currency string `json:"currency"`
+	PlatformMetrics
+}
+
+type PlatformOverviewResponse struct {
+	StartDate         string           `json:"start_date"`
+	EndDate           string           `json:"end_date"`
+	PreviousStartDate string           `json:"previous_start_date,omitempty"`
+	PreviousEndDate   string           `json:"previous_end_date,omitempty"`
+	Interval          string           `json:"interval"`
+	Totals            []PlatformTotals `json:"totals"`
+	Periods           []PlatformPeriod `json:"periods"`
+}
+
+// SellerLeaderboardRow ranks a seller among all active sellers of the same currency
+type SellerLeaderboardRow struct {
+	Rank               int64   `json:"rank"`
+	SellerID           uint    `json:"seller_id"`
+	SellerName         string  `json:"seller_name"`
+	Currency           string  `json:"currency"`
+	GMVCents           int64   `json:"gmv_cents"`
+	CommissionCents    int64   `json:"commission_cents"`
+	TakeRatePercentage float64 `json:"take_rate_percentage"`
+	OrderCount         int64   `json:"order_count"`
+	GMVSharePercentage float64 `json:"gmv_share_percentage"`
+	// PreviousGMVCents and GMVChangePercentage are only set when compare is on
+	PreviousGMVCents    *int64   `json:"previous_gmv_cents,omitempty"`
+	GMVChangePercentage *float64 `json:"gmv_change_percentage,omitempty"`
+}
+
+type SellerLeaderboardResponse struct {
+	StartDate string                 `json:"start_date"`
+	EndDate   string                 `json:"end_date"`
+	SortBy    string                 `json:"sort_by"`
+	Sellers   []SellerLeaderboardRow `json:"sellers"`
+	Total     int64                  `json:"total"`
+	Limit     int                    `json:"limit"`
+	Offset    int                    `json:"offset"`
+}
+
+type SellerPlatformDetailResponse struct {
+	SellerID   uint                   `json:"seller_id"`
+	SellerName string                 `json:"seller_name"`
+	StartDate  string                 `json:"start_date"`
+	EndDate    string                 `json:"end_date"`
+	Interval   string                 `json:"interval"`
+	Rankings   []SellerLeaderboardRow `json:"rankings"`
+	Periods    []PlatformPeriod       `json:"periods"`
+}
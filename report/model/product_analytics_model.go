@@ -0,0 +1,87 @@
+package model
+
+import "ecommerce-be/report/util"
+
+// RecordAnalyticsEventsRequest is a batch of storefront events. The seller comes from
+// the storefront context and the user from the token, never from the body.
+type RecordAnalyticsEventsRequest struct {
+	Events []AnalyticsEventInput `json:"events" binding:"required,min=1,max=50,dive"`
+}
+
+// AnalyticsEventInput is one storefront event. Which fields are required depends on
+// the type: product events need product_id, search needs query and result_count, and
+// search_click needs product_id, query and the clicked position.
+type AnalyticsEventInput struct {
+	Type        string `json:"type"         binding:"required,oneof=impression view add_to_cart search search_click"`
+	ProductID   *uint  `json:"product_id"`
+	SessionID   string `json:"session_id"   binding:"max=64"`
+	Query       string `json:"query"`
+	ResultCount *int   `json:"result_count"`
+	Position    *int   `json:"position"`
+}
+
+type RecordAnalyticsEventsResponse struct {
+	Accepted int `json:"accepted"`
+}
+
+// ProductAnalyticsFilter selects the period and ranking of the seller's products
+type ProductAnalyticsFilter struct {
+	util.ReportQueryFilter
+	SortBy string `form:"sort_by"` // views (default), impressions, add_to_carts, search_clicks
+	Limit  int    `form:"limit"`
+}
+
+// SearchAnalyticsFilter selects the period and ranking of the seller's search queries
+type SearchAnalyticsFilter struct {
+	util.ReportQueryFilter
+	SortBy string `form:"sort_by"` // searches (default), zero_result_searches, clicks
+	Limit  int    `form:"limit"`
+}
+
+// ProductEngagementStats are engagement counts with the funnel rates between them:
+// views per impression and add-to-carts per view
+type ProductEngagementStats struct {
+	Impressions             int64   `json:"impressions"`
+	Views                   int64   `json:"views"`
+	AddToCarts              int64   `json:"add_to_carts"`
+	SearchClicks            int64   `json:"search_clicks"`
+	ViewRatePercentage      float64 `json:"view_rate_percentage"`
+	AddToCartRatePercentage float64 `json:"add_to_cart_rate_percentage"`
+}
+
+type ProductEngagementRow struct {
+	ProductID   uint   `json:"product_id"`
+	ProductName string `json:"product_name"`
+	ProductEngagementStats
+}
+
+type ProductAnalyticsResponse struct {
+	StartDate string                 `json:"start_date"`
+	EndDate   string                 `json:"end_date"`
+	Totals    ProductEngagementStats `json:"totals"`
+	Products  []ProductEngagementRow `json:"products"`
+}
+
+// SearchQueryStats are a query's searches and the clicks on its results. Averages
+// are 0 when there is nothing to average.
+type SearchQueryStats struct {
+	Searches                   int64   `json:"searches"`
+	ZeroResultSearches         int64   `json:"zero_result_searches"`
+	ZeroResultRatePercentage   float64 `json:"zero_result_rate_percentage"`
+	AvgResultCount             float64 `json:"avg_result_count"`
+	Clicks                     int64   `json:"clicks"`
+	ClickThroughRatePercentage float64 `json:"click_through_rate_percentage"`
+	AvgClickPosition           float64 `json:"avg_click_position"`
+}
+
+type SearchQueryRow struct {
+	Query string `json:"query"`
+	SearchQueryStats
+}
+
+type SearchAnalyticsResponse struct {
+	StartDate string           `json:"start_date"`
+	EndDate   string           `json:"end_date"`
+	Totals    SearchQueryStats `json:"totals"`
+	Queries   []SearchQueryRow `json:"queries"`
+}
@@ -0,0 +1,102 @@
+package model
+
+import "ecommerce-be/report/util"
+
+// RevenueReportFilter extends the universal time filter with admin revenue options
+type RevenueReportFilter struct {
+	util.ReportQueryFilter
+	SellerID *uint  `form:"seller_id"`
+	Currency string `form:"currency"`
+	SortBy   string `form:"sort_by"` // gmv, commission, refunds, chargebacks
+	Limit    int    `form:"limit"`
+	Offset   int    `form:"offset"`
+	GroupBy  string `form:"group_by"` // export only: seller (default) or period
+}
+
+// LedgerEntriesFilter selects ledger entries for a seller drill-down
+type LedgerEntriesFilter struct {
+	util.ReportQueryFilter
+	EntryType string `form:"entry_type"`
+	Currency  string `form:"currency"`
+	Limit     int    `form:"limit"`
+	Offset    int    `form:"offset"`
+}
+
+// RevenueTotals holds ledger aggregates for one currency. Amounts are in cents.
+type RevenueTotals struct {
+	Currency               string  `json:"currency"`
+	GMVCents               int64   `json:"gmv_cents"`
+	CommissionCents        int64   `json:"commission_cents"`
+	GatewayFeeCents        int64   `json:"gateway_fee_cents"`
+	RefundCents            int64   `json:"refund_cents"`
+	ChargebackCents        int64   `json:"chargeback_cents"`
+	PayoutCents            int64   `json:"payout_cents"`
+	NetSellerEarningsCents int64   `json:"net_seller_earnings_cents"`
+	OrderCount             int     `json:"order_count"`
+	TakeRatePercentage     float64 `json:"take_rate_percentage"`
+}
+
+type RevenueSummaryResponse struct {
+	StartDate string          `json:"start_date"`
+	EndDate   string          `json:"end_date"`
+	Totals    []RevenueTotals `json:"totals"`
+}
+
+type SellerRevenueRow struct {
+	SellerID   uint   `json:"seller_id"`
+	SellerName string `json:"seller_name"`
+	RevenueTotals
+}
+
+type SellerRevenueResponse struct {
+	StartDate string             `json:"start_date"`
+	EndDate   string             `json:"end_date"`
+	Sellers   []SellerRevenueRow `json:"sellers"`
+	Total     int64              `json:"total"`
+	Limit     int                `json:"limit"`
+	Offset    int                `json:"offset"`
+}
+
+type RevenuePeriod struct {
+	Period string `json:"period"`
+	RevenueTotals
+}
+
+type RevenuePeriodsResponse struct {
+	StartDate string          `json:"start_date"`
+	EndDate   string          `json:"end_date"`
+	Interval  string          `json:"interval"`
+	SellerID  *uint           `json:"seller_id,omitempty"`
+	Periods   []RevenuePeriod `json:"periods"`
+}
+
+type SellerRevenueDetailResponse struct {
+	SellerID   uint            `json:"seller_id"`
+	SellerName string          `json:"seller_name"`
+	StartDate  string          `json:"start_date"`
+	EndDate    string          `json:"end_date"`
+	Interval   string          `json:"interval"`
+	Totals     []RevenueTotals `json:"totals"`
+	Periods    []RevenuePeriod `json:"periods"`
+}
+
+type LedgerEntryItem struct {
+	ID                   uint   `json:"id"`
+	EntryType            string `json:"entry_type"`
+	Currency             string `json:"currency"`
+	AmountCents          int64  `json:"amount_cents"`
+	OrderID              *uint  `json:"order_id"`
+	PaymentTransactionID *uint  `json:"payment_transaction_id"`
+	RefundID             *uint  `json:"refund_id"`
+	ChargebackID         *uint  `json:"chargeback_id"`
+	Description          string `json:"description"`
+	OccurredAt           string `json:"occurred_at"`
+}
+
+type LedgerEntriesResponse struct {
+	SellerID uint              `json:"seller_id"`
+	Entries  []LedgerEntryItem `json:"entries"`
+	Total    int64             `json:"total"`
+	Limit    int               `json:"limit"`
+	Offset   int               `json:"offset"`
+}
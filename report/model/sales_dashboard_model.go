@@ -0,0 +1,85 @@
+package model
+
+import "ecommerce-be/report/util"
+
+// SalesDashboardFilter selects the period of the seller sales dashboard. Interval
+// sets the series granularity: day, week, month, quarter or year.
+type SalesDashboardFilter struct {
+	util.ReportQueryFilter
+}
+
+// SalesRankingFilter selects the period and size of a top products or categories list
+type SalesRankingFilter struct {
+	util.ReportQueryFilter
+	SortBy string `form:"sort_by"` // revenue (default) or units
+	Limit  int    `form:"limit"`
+}
+
+// SalesStats are a period's sales. Revenue and refunds are in the order currency;
+// refund rate is refunded amount over revenue.
+type SalesStats struct {
+	Orders               int64   `json:"orders"`
+	Revenue              float64 `json:"revenue"`
+	AverageOrderValue    float64 `json:"average_order_value"`
+	UnitsSold            int64   `json:"units_sold"`
+	Refunds              int64   `json:"refunds"`
+	RefundedAmount       float64 `json:"refunded_amount"`
+	RefundRatePercentage float64 `json:"refund_rate_percentage"`
+}
+
+// SalesSeriesPoint is one bucket of the sales series, starting on PeriodStart
+type SalesSeriesPoint struct {
+	PeriodStart string `json:"period_start"`
+	SalesStats
+}
+
+type SalesDashboardResponse struct {
+	StartDate string             `json:"start_date"`
+	EndDate   string             `json:"end_date"`
+	Interval  string             `json:"interval"`
+	Totals    SalesStats         `json:"totals"`
+	Series    []SalesSeriesPoint `json:"series"`
+}
+
+type TopProductRow struct {
+	ProductID   uint    `json:"product_id"`
+	ProductName string  `json:"product_name"`
+	Orders      int64   `json:"orders"`
+	UnitsSold   int64   `json:"units_sold"`
+	Revenue     float64 `json:"revenue"`
+}
+
+type TopProductsResponse struct {
+	StartDate string          `json:"start_date"`
+	EndDate   string          `json:"end_date"`
+	Products  []TopProductRow `json:"products"`
+}
+
+// TopCategoryRow groups sales by the category products are in now
+type TopCategoryRow struct {
+	CategoryID   uint    `json:"category_id"`
+	CategoryName string  `json:"category_name"`
+	UnitsSold    int64   `json:"units_sold"`
+	Revenue      float64 `json:"revenue"`
+}
+
+type TopCategoriesResponse struct {
+	StartDate  string           `json:"start_date"`
+	EndDate    string           `json:"end_date"`
+	Categories []TopCategoryRow `json:"categories"`
+}
+
+// ConversionFunnelStep is one funnel stage with the share of the previous stage that
+// reached it; the first stage has no previous stage and reports 100.
+type ConversionFunnelStep struct {
+	Stage                 string  `json:"stage"`
+	Count                 int64   `json:"count"`
+	StepRatePercentage    float64 `json:"step_rate_percentage"`
+	OverallRatePercentage float64 `json:"overall_rate_percentage"`
+}
+
+type ConversionFunnelResponse struct {
+	StartDate string                 `json:"start_date"`
+	EndDate   string                 `json:"end_date"`
+	Steps     []ConversionFunnelStep `json:"steps"`
+}
@@ -0,0 +1,55 @@
+package model
+
+// SellerHealthFilter filters the admin seller health listing
+type SellerHealthFilter struct {
+	Status         string `form:"status"`    // healthy, at_risk, demoted, insufficient_data
+	RiskFlag       string `form:"risk_flag"` // e.g. sla_breaches
+	ListingDemoted *bool  `form:"listing_demoted"`
+	SortBy         string `form:"sort_by"` // score (default), calculated_at, demoted_at
+	Order          string `form:"order"`   // asc (default) or desc
+	Limit          int    `form:"limit"`
+	Offset         int    `form:"offset"`
+}
+
+// SellerHealthTrendFilter selects how many days of trend history to return
+type SellerHealthTrendFilter struct {
+	Days int `form:"days"`
+}
+
+type SellerHealthRow struct {
+	SellerID       uint     `json:"seller_id"`
+	SellerName     string   `json:"seller_name"`
+	Score          int      `json:"score"`
+	Status         string   `json:"status"`
+	RiskFlags      []string `json:"risk_flags"`
+	ListingDemoted bool     `json:"listing_demoted"`
+	DemotedAt      *string  `json:"demoted_at"`
+	CalculatedAt   string   `json:"calculated_at"`
+}
+
+type SellerHealthListResponse struct {
+	Sellers []SellerHealthRow `json:"sellers"`
+	Total   int64             `json:"total"`
+	Limit   int               `json:"limit"`
+	Offset  int               `json:"offset"`
+}
+
+// SellerHealthTrendPoint is one daily snapshot. Rates are percentages over the
+// snapshot's window; ReviewScore is nil when the seller had no reviews.
+type SellerHealthTrendPoint struct {
+	Date             string   `json:"date"`
+	Score            int      `json:"score"`
+	Status           string   `json:"status"`
+	RiskFlags        []string `json:"risk_flags"`
+	OrderCount       int64    `json:"order_count"`
+	CancellationRate float64  `json:"cancellation_rate_percentage"`
+	SLABreachRate    float64  `json:"sla_breach_rate_percentage"`
+	DisputeRate      float64  `json:"dispute_rate_percentage"`
+	ReviewCount      int64    `json:"review_count"`
+	ReviewScore      *float64 `json:"review_score"`
+}
+
+type SellerHealthDetailResponse struct {
+	SellerHealthRow
+	Trend []SellerHealthTrendPoint `json:"trend"`
+}
@@ -0,0 +1,174 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"ecommerce-be/common/constants"
+	paymentEntity "ecommerce-be/payment/entity"
+
+	"gorm.io/gorm"
+)
+
+// PaymentAuthorizationAggregate is one aggregated row of payment transactions.
+// Depending on the query, rows are grouped by gateway and card type or by seller
+// and gateway; the unused grouping columns are left zero.
+type PaymentAuthorizationAggregate struct {
+	SellerID                   uint    `gorm:"column:seller_id"`
+	GatewayID                  uint    `gorm:"column:gateway_id"`
+	GatewayCode                string  `gorm:"column:gateway_code"`
+	GatewayName                string  `gorm:"column:gateway_name"`
+	PaymentMethodType          string  `gorm:"column:payment_method_type"`
+	CardBrand                  string  `gorm:"column:card_brand"`
+	Attempts                   int64   `gorm:"column:attempts"`
+	Authorized                 int64   `gorm:"column:authorized"`
+	Declined                   int64   `gorm:"column:declined"`
+	CapturedCount              int64   `gorm:"column:captured_count"`
+	CaptureLatencySecondsTotal float64 `gorm:"column:capture_latency_seconds_total"`
+}
+
+// DeclineReasonAggregate counts declined transactions per gateway and failure code
+type DeclineReasonAggregate struct {
+	GatewayID   uint   `gorm:"column:gateway_id"`
+	GatewayCode string `gorm:"column:gateway_code"`
+	FailureCode string `gorm:"column:failure_code"`
+	Count       int64  `gorm:"column:count"`
+}
+
+// PaymentAnalyticsQuery scopes transaction aggregation to a time window and optional
+// seller/gateway. Transactions are bucketed by when they were created.
+type PaymentAnalyticsQuery struct {
+	StartDate time.Time
+	EndDate   time.Time
+	SellerID  *uint
+	GatewayID *uint
+}
+
+type PaymentAnalyticsRepository interface {
+	GetAuthorizationStats(
+		ctx context.Context,
+		q PaymentAnalyticsQuery,
+	) ([]PaymentAuthorizationAggregate, error)
+	GetDeclineReasons(
+		ctx context.Context,
+		q PaymentAnalyticsQuery,
+		limit int,
+	) ([]DeclineReasonAggregate, error)
+	GetAuthorizationStatsBySeller(
+		ctx context.Context,
+		q PaymentAnalyticsQuery,
+	) ([]PaymentAuthorizationAggregate, error)
+	FindAdminUserIDs(ctx context.Context) ([]uint, error)
+}
+
+type paymentAnalyticsRepository struct {
+	db *gorm.DB
+}
+
+func NewPaymentAnalyticsRepository(db *gorm.DB) PaymentAnalyticsRepository {
+	return &paymentAnalyticsRepository{
+		db: db,
+	}
+}
+
+// paymentAuthorizationColumns counts authorization outcomes. Pending transactions
+// have no outcome yet and are left out of attempts. Capture latency runs from
+// authorization (or initiation for gateways that authorize and capture in one
+// step) to completion.
+var paymentAuthorizationColumns = fmt.Sprintf(`
+	COUNT(*) FILTER (WHERE t.status <> '%[1]s') as attempts,
+	COUNT(*) FILTER (WHERE t.status NOT IN ('%[1]s', '%[2]s')) as authorized,
+	COUNT(*) FILTER (WHERE t.status = '%[2]s') as declined,
+	COUNT(*) FILTER (WHERE t.completed_at IS NOT NULL) as captured_count,
+	COALESCE(SUM(EXTRACT(EPOCH FROM t.completed_at -
+		COALESCE(t.authorized_at, t.initiated_at, t.created_at)))
+		FILTER (WHERE t.completed_at IS NOT NULL), 0) as capture_latency_seconds_total`,
+	paymentEntity.TransactionStatusPending,
+	paymentEntity.TransactionStatusFailed,
+)
+
+const paymentGatewayColumns = `COALESCE(t.gateway_id, 0) as gateway_id,
+	COALESCE(g.code, '') as gateway_code,
+	COALESCE(g.name, '') as gateway_name`
+
+func (r *paymentAnalyticsRepository) transactionQuery(
+	ctx context.Context,
+	q PaymentAnalyticsQuery,
+) *gorm.DB {
+	query := r.db.WithContext(ctx).
+		Table("payment_transaction t").
+		Joins("LEFT JOIN payment_gateway g ON g.id = t.gateway_id").
+		Where("t.created_at >= ? AND t.created_at <= ?", q.StartDate, q.EndDate)
+
+	if q.SellerID != nil {
+		query = query.Where("t.seller_id = ?", *q.SellerID)
+	}
+	if q.GatewayID != nil {
+		query = query.Where("t.gateway_id = ?", *q.GatewayID)
+	}
+	return query
+}
+
+// GetAuthorizationStats aggregates per gateway, payment method type and card brand
+func (r *paymentAnalyticsRepository) GetAuthorizationStats(
+	ctx context.Context,
+	q PaymentAnalyticsQuery,
+) ([]PaymentAuthorizationAggregate, error) {
+	var rows []PaymentAuthorizationAggregate
+	err := r.transactionQuery(ctx, q).
+		Select(paymentGatewayColumns + `,
+			COALESCE(t.payment_method_type, '') as payment_method_type,
+			COALESCE(t.card_brand, '') as card_brand,` + paymentAuthorizationColumns).
+		Group("t.gateway_id, g.code, g.name, t.payment_method_type, t.card_brand").
+		Order("gateway_code ASC, payment_method_type ASC, card_brand ASC").
+		Scan(&rows).Error
+	return rows, err
+}
+
+// GetDeclineReasons returns the most frequent failure codes per gateway
+func (r *paymentAnalyticsRepository) GetDeclineReasons(
+	ctx context.Context,
+	q PaymentAnalyticsQuery,
+	limit int,
+) ([]DeclineReasonAggregate, error) {
+	var rows []DeclineReasonAggregate
+	err := r.transactionQuery(ctx, q).
+		Select(`COALESCE(t.gateway_id, 0) as gateway_id,
+			COALESCE(g.code, '') as gateway_code,
+			COALESCE(NULLIF(t.failure_code, ''), 'unknown') as failure_code,
+			COUNT(*) as count`).
+		Where("t.status = ?", paymentEntity.TransactionStatusFailed).
+		Group("t.gateway_id, g.code, COALESCE(NULLIF(t.failure_code, ''), 'unknown')").
+		Order("count DESC, gateway_code ASC, failure_code ASC").
+		Limit(limit).
+		Scan(&rows).Error
+	return rows, err
+}
+
+// GetAuthorizationStatsBySeller aggregates per seller and gateway
+func (r *paymentAnalyticsRepository) GetAuthorizationStatsBySeller(
+	ctx context.Context,
+	q PaymentAnalyticsQuery,
+) ([]PaymentAuthorizationAggregate, error) {
+	var rows []PaymentAuthorizationAggregate
+	err := r.transactionQuery(ctx, q).
+		Select("t.seller_id as seller_id, " + paymentGatewayColumns + "," +
+			paymentAuthorizationColumns).
+		Group("t.seller_id, t.gateway_id, g.code, g.name").
+		Order("t.seller_id ASC, gateway_code ASC").
+		Scan(&rows).Error
+	return rows, err
+}
+
+// FindAdminUserIDs returns the active admins, who receive platform payment alerts
+func (r *paymentAnalyticsRepository) FindAdminUserIDs(ctx context.Context) ([]uint, error) {
+	var ids []uint
+	err := r.db.WithContext(ctx).
+		Table(`"user" u`).
+		Joins("JOIN role ro ON ro.id = u.role_id").
+		Where("ro.name = ? AND u.is_active = ?", constants.ADMIN_ROLE_NAME, true).
+		Order("u.id ASC").
+		Pluck("u.id", &ids).Error
+	return ids, err
+}
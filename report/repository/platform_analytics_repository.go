@@ -0,0 +1,205 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	paymentEntity "ecommerce-be/payment/entity"
+
+	"gorm.io/gorm"
+)
+
+// PlatformAggregate is platform activity from the seller ledger, grouped by currency
+// or by period and currency
+type PlatformAggregate struct {
+	Bucket          string `gorm:"column:bucket"`
+	Currency        string `gorm:"column:currency"`
+	GMVCents        int64  `gorm:"column:gmv_cents"`
+	CommissionCents int64  `gorm:"column:commission_cents"`
+	OrderCount      int64  `gorm:"column:order_count"`
+	ActiveSellers   int64  `gorm:"column:active_sellers"`
+}
+
+// SellerRankAggregate is a seller's activity in one currency with its rank among the
+// currency's active sellers
+type SellerRankAggregate struct {
+	Rank             int64  `gorm:"column:rank"`
+	SellerID         uint   `gorm:"column:seller_id"`
+	SellerName       string `gorm:"column:seller_name"`
+	Currency         string `gorm:"column:currency"`
+	GMVCents         int64  `gorm:"column:gmv_cents"`
+	CommissionCents  int64  `gorm:"column:commission_cents"`
+	OrderCount       int64  `gorm:"column:order_count"`
+	PlatformGMVCents int64  `gorm:"column:platform_gmv_cents"`
+	PrevGMVCents     int64  `gorm:"column:prev_gmv_cents"`
+}
+
+// PlatformQuery scopes ledger aggregation to a time window and optional seller/currency
+type PlatformQuery struct {
+	StartDate time.Time
+	EndDate   time.Time
+	SellerID  *uint
+	Currency  string
+}
+
+// LeaderboardQuery ranks sellers over the current window and compares them with the
+// previous one
+type LeaderboardQuery struct {
+	Current   PlatformQuery
+	PrevStart time.Time
+	PrevEnd   time.Time
+	// SellerID narrows the result to one seller after ranking against all of them
+	SellerID *uint
+	// SortColumn must be resolved from a whitelist by the caller
+	SortColumn string
+	Limit      int
+	Offset     int
+}
+
+type PlatformAnalyticsRepository interface {
+	GetPlatformTotals(ctx context.Context, q PlatformQuery) ([]PlatformAggregate, error)
+	GetPlatformByPeriod(
+		ctx context.Context,
+		q PlatformQuery,
+		interval string,
+		timezone string,
+	) ([]PlatformAggregate, error)
+	GetSellerLeaderboard(
+		ctx context.Context,
+		q LeaderboardQuery,
+	) ([]SellerRankAggregate, int64, error)
+}
+
+type platformAnalyticsRepository struct {
+	db *gorm.DB
+}
+
+func NewPlatformAnalyticsRepository(db *gorm.DB) PlatformAnalyticsRepository {
+	return &platformAnalyticsRepository{
+		db: db,
+	}
+}
+
+// platformAggregateColumns sums sales and commission. Only sale entries count towards
+// orders and active sellers, so sellers with nothing but payouts are not active.
+var platformAggregateColumns = fmt.Sprintf(`
+	l.currency as currency,
+	COALESCE(SUM(l.amount_cents) FILTER (WHERE l.entry_type = '%s'), 0) as gmv_cents,
+	COALESCE(SUM(l.amount_cents) FILTER (WHERE l.entry_type = '%s'), 0) as commission_cents,
+	COUNT(DISTINCT l.order_id) FILTER (WHERE l.entry_type = '%s') as order_count,
+	COUNT(DISTINCT l.seller_id) FILTER (WHERE l.entry_type = '%s') as active_sellers`,
+	paymentEntity.LedgerEntryTypeSale,
+	paymentEntity.LedgerEntryTypeCommission,
+	paymentEntity.LedgerEntryTypeSale,
+	paymentEntity.LedgerEntryTypeSale,
+)
+
+func (r *platformAnalyticsRepository) ledgerQuery(
+	ctx context.Context,
+	q PlatformQuery,
+) *gorm.DB {
+	query := r.db.WithContext(ctx).
+		Table("seller_ledger_entry l").
+		Where("l.occurred_at >= ? AND l.occurred_at <= ?", q.StartDate, q.EndDate)
+
+	if q.SellerID != nil {
+		query = query.Where("l.seller_id = ?", *q.SellerID)
+	}
+	if q.Currency != "" {
+		query = query.Where("l.currency = ?", q.Currency)
+	}
+	return query
+}
+
+func (r *platformAnalyticsRepository) GetPlatformTotals(
+	ctx context.Context,
+	q PlatformQuery,
+) ([]PlatformAggregate, error) {
+	var rows []PlatformAggregate
+	err := r.ledgerQuery(ctx, q).
+		Select(platformAggregateColumns).
+		Group("l.currency").
+		Order("l.currency ASC").
+		Scan(&rows).Error
+	return rows, err
+}
+
+func (r *platformAnalyticsRepository) GetPlatformByPeriod(
+	ctx context.Context,
+	q PlatformQuery,
+	interval string,
+	timezone string,
+) ([]PlatformAggregate, error) {
+	tz := sanitizeTimezone(timezone)
+
+	// interval is resolved from a whitelist and tz is sanitised, so embedding
+	// both is safe (see GetSalesTrendsMetrics).
+	bucketExpr := fmt.Sprintf("DATE_TRUNC('%s', l.occurred_at AT TIME ZONE '%s')", interval, tz)
+
+	var rows []PlatformAggregate
+	err := r.ledgerQuery(ctx, q).
+		Select(fmt.Sprintf("TO_CHAR(%s, 'YYYY-MM-DD HH24:MI:SS') as bucket, ", bucketExpr) +
+			platformAggregateColumns).
+		Group(bucketExpr + ", l.currency").
+		Order(bucketExpr + " ASC, l.currency ASC").
+		Scan(&rows).Error
+	return rows, err
+}
+
+// sellerActivity sums each active seller's sales per currency over the window
+func (r *platformAnalyticsRepository) sellerActivity(
+	ctx context.Context,
+	q PlatformQuery,
+) *gorm.DB {
+	return r.ledgerQuery(ctx, q).
+		Select("l.seller_id as seller_id, "+platformAggregateColumns).
+		Group("l.seller_id, l.currency").
+		Having("COUNT(*) FILTER (WHERE l.entry_type = ?) > 0", paymentEntity.LedgerEntryTypeSale)
+}
+
+// GetSellerLeaderboard ranks active sellers within each currency. Ties share a rank.
+func (r *platformAnalyticsRepository) GetSellerLeaderboard(
+	ctx context.Context,
+	q LeaderboardQuery,
+) ([]SellerRankAggregate, int64, error) {
+	previous := q.Current
+	previous.StartDate, previous.EndDate = q.PrevStart, q.PrevEnd
+
+	// SortColumn is whitelisted by the caller, so embedding it is safe
+	ranked := r.db.WithContext(ctx).
+		Table("(?) as c", r.sellerActivity(ctx, q.Current)).
+		Select(fmt.Sprintf(`c.seller_id, c.currency, c.gmv_cents, c.commission_cents,
+			c.order_count, COALESCE(p.gmv_cents, 0) as prev_gmv_cents,
+			RANK() OVER (PARTITION BY c.currency ORDER BY c.%s DESC) as rank,
+			SUM(c.gmv_cents) OVER (PARTITION BY c.currency) as platform_gmv_cents`,
+			q.SortColumn)).
+		Joins("LEFT JOIN (?) as p ON p.seller_id = c.seller_id AND p.currency = c.currency",
+			r.sellerActivity(ctx, previous))
+
+	filtered := func() *gorm.DB {
+		query := r.db.WithContext(ctx).Table("(?) as ranked", ranked)
+		if q.SellerID != nil {
+			query = query.Where("ranked.seller_id = ?", *q.SellerID)
+		}
+		return query
+	}
+
+	var total int64
+	if err := filtered().Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var rows []SellerRankAggregate
+	err := filtered().
+		Select("ranked.*, COALESCE(sp.business_name, '') as seller_name").
+		Joins("LEFT JOIN seller_profile sp ON sp.user_id = ranked.seller_id").
+		Order("ranked.rank ASC, ranked.currency ASC, ranked.seller_id ASC").
+		Limit(q.Limit).
+		Offset(q.Offset).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, 0, err
+	}
+	return rows, total, nil
+}
@@ -0,0 +1,248 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	reportEntity "ecommerce-be/report/entity"
+	"ecommerce-be/report/util"
+
+	"gorm.io/gorm"
+)
+
+// ProductEngagementAggregate sums a product's daily engagement over a period
+type ProductEngagementAggregate struct {
+	ProductID    uint   `gorm:"column:product_id"`
+	ProductName  string `gorm:"column:product_name"`
+	Impressions  int64  `gorm:"column:impressions"`
+	Views        int64  `gorm:"column:views"`
+	AddToCarts   int64  `gorm:"column:add_to_carts"`
+	SearchClicks int64  `gorm:"column:search_clicks"`
+}
+
+// SearchQueryAggregate sums a search query's daily use over a period
+type SearchQueryAggregate struct {
+	SearchQuery        string `gorm:"column:search_query"`
+	Searches           int64  `gorm:"column:searches"`
+	ZeroResultSearches int64  `gorm:"column:zero_result_searches"`
+	ResultCountSum     int64  `gorm:"column:result_count_sum"`
+	Clicks             int64  `gorm:"column:clicks"`
+	ClickPositionSum   int64  `gorm:"column:click_position_sum"`
+}
+
+// ProductAnalyticsQuery selects a seller's rollups between two UTC days, inclusive
+type ProductAnalyticsQuery struct {
+	SellerID uint
+	StartDay string
+	EndDay   string
+	// SortColumn must be resolved from a whitelist by the caller
+	SortColumn string
+	Limit      int
+}
+
+type ProductAnalyticsRepository interface {
+	InsertEvents(ctx context.Context, events []reportEntity.AnalyticsEvent) error
+	// RollupDay rewrites the daily rollups of the UTC day from the raw events
+	RollupDay(ctx context.Context, day time.Time) error
+	DeleteEventsBefore(ctx context.Context, before time.Time) (int64, error)
+	GetProductEngagement(
+		ctx context.Context,
+		q ProductAnalyticsQuery,
+	) ([]ProductEngagementAggregate, error)
+	GetProductEngagementTotals(
+		ctx context.Context,
+		q ProductAnalyticsQuery,
+	) (ProductEngagementAggregate, error)
+	GetSearchQueries(ctx context.Context, q ProductAnalyticsQuery) ([]SearchQueryAggregate, error)
+	GetSearchTotals(ctx context.Context, q ProductAnalyticsQuery) (SearchQueryAggregate, error)
+}
+
+type productAnalyticsRepository struct {
+	db *gorm.DB
+}
+
+func NewProductAnalyticsRepository(db *gorm.DB) ProductAnalyticsRepository {
+	return &productAnalyticsRepository{
+		db: db,
+	}
+}
+
+func (r *productAnalyticsRepository) InsertEvents(
+	ctx context.Context,
+	events []reportEntity.AnalyticsEvent,
+) error {
+	return r.db.WithContext(ctx).Create(&events).Error
+}
+
+// rollupProductDayQuery counts the product events of a day. Events for products of
+// another seller are spoofed or stale and dropped by the join.
+const rollupProductDayQuery = `
+	INSERT INTO product_analytics_daily
+		(product_id, day, seller_id, impressions, views, add_to_carts, search_clicks)
+	SELECT e.product_id, ?::date, e.seller_id,
+		COUNT(*) FILTER (WHERE e.event_type = ?),
+		COUNT(*) FILTER (WHERE e.event_type = ?),
+		COUNT(*) FILTER (WHERE e.event_type = ?),
+		COUNT(*) FILTER (WHERE e.event_type = ?)
+	FROM analytics_event e
+	JOIN product p ON p.id = e.product_id AND p.seller_id = e.seller_id
+	WHERE e.created_at >= ? AND e.created_at < ?
+	GROUP BY e.product_id, e.seller_id`
+
+// rollupSearchDayQuery counts the searches of a day and the clicks on their results
+const rollupSearchDayQuery = `
+	INSERT INTO search_query_daily
+		(seller_id, day, search_query, searches, zero_result_searches, result_count_sum,
+		clicks, click_position_sum)
+	SELECT e.seller_id, ?::date, e.search_query,
+		COUNT(*) FILTER (WHERE e.event_type = ?),
+		COUNT(*) FILTER (WHERE e.event_type = ? AND e.result_count = 0),
+		COALESCE(SUM(e.result_count) FILTER (WHERE e.event_type = ?), 0),
+		COUNT(*) FILTER (WHERE e.event_type = ?),
+		COALESCE(SUM(e.position) FILTER (WHERE e.event_type = ?), 0)
+	FROM analytics_event e
+	JOIN "user" u ON u.id = e.seller_id
+	WHERE e.search_query IS NOT NULL AND e.created_at >= ? AND e.created_at < ?
+	GROUP BY e.seller_id, e.search_query`
+
+// RollupDay replaces the day's rollups in one transaction, so it can rerun as late
+// events arrive and readers never see a half-written day
+func (r *productAnalyticsRepository) RollupDay(ctx context.Context, day time.Time) error {
+	start := day.UTC().Truncate(24 * time.Hour)
+	end := start.AddDate(0, 0, 1)
+	dayText := start.Format(time.DateOnly)
+
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Where("day = ?::date", dayText).
+			Delete(&reportEntity.ProductAnalyticsDaily{}).Error
+		if err != nil {
+			return err
+		}
+		err = tx.Exec(rollupProductDayQuery,
+			dayText,
+			util.ANALYTICS_EVENT_IMPRESSION,
+			util.ANALYTICS_EVENT_VIEW,
+			util.ANALYTICS_EVENT_ADD_TO_CART,
+			util.ANALYTICS_EVENT_SEARCH_CLICK,
+			start, end,
+		).Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Where("day = ?::date", dayText).
+			Delete(&reportEntity.SearchQueryDaily{}).Error
+		if err != nil {
+			return err
+		}
+		return tx.Exec(rollupSearchDayQuery,
+			dayText,
+			util.ANALYTICS_EVENT_SEARCH,
+			util.ANALYTICS_EVENT_SEARCH,
+			util.ANALYTICS_EVENT_SEARCH,
+			util.ANALYTICS_EVENT_SEARCH_CLICK,
+			util.ANALYTICS_EVENT_SEARCH_CLICK,
+			start, end,
+		).Error
+	})
+}
+
+// DeleteEventsBefore prunes raw events that were already rolled up
+func (r *productAnalyticsRepository) DeleteEventsBefore(
+	ctx context.Context,
+	before time.Time,
+) (int64, error) {
+	result := r.db.WithContext(ctx).
+		Where("created_at < ?", before).
+		Delete(&reportEntity.AnalyticsEvent{})
+	return result.RowsAffected, result.Error
+}
+
+const productEngagementColumns = `
+	COALESCE(SUM(d.impressions), 0) as impressions,
+	COALESCE(SUM(d.views), 0) as views,
+	COALESCE(SUM(d.add_to_carts), 0) as add_to_carts,
+	COALESCE(SUM(d.search_clicks), 0) as search_clicks`
+
+func (r *productAnalyticsRepository) productDailyQuery(
+	ctx context.Context,
+	q ProductAnalyticsQuery,
+) *gorm.DB {
+	return r.db.WithContext(ctx).
+		Table("product_analytics_daily d").
+		Where("d.seller_id = ? AND d.day >= ?::date AND d.day <= ?::date",
+			q.SellerID, q.StartDay, q.EndDay)
+}
+
+// GetProductEngagement returns the seller's top products over the period
+func (r *productAnalyticsRepository) GetProductEngagement(
+	ctx context.Context,
+	q ProductAnalyticsQuery,
+) ([]ProductEngagementAggregate, error) {
+	var rows []ProductEngagementAggregate
+	err := r.productDailyQuery(ctx, q).
+		Select("d.product_id as product_id, p.name as product_name, " +
+			productEngagementColumns).
+		Joins("JOIN product p ON p.id = d.product_id").
+		Group("d.product_id, p.name").
+		Order(q.SortColumn + " DESC, d.product_id ASC").
+		Limit(q.Limit).
+		Scan(&rows).Error
+	return rows, err
+}
+
+// GetProductEngagementTotals sums engagement across all the seller's products
+func (r *productAnalyticsRepository) GetProductEngagementTotals(
+	ctx context.Context,
+	q ProductAnalyticsQuery,
+) (ProductEngagementAggregate, error) {
+	var totals ProductEngagementAggregate
+	err := r.productDailyQuery(ctx, q).
+		Select(productEngagementColumns).
+		Scan(&totals).Error
+	return totals, err
+}
+
+const searchQueryColumns = `
+	COALESCE(SUM(d.searches), 0) as searches,
+	COALESCE(SUM(d.zero_result_searches), 0) as zero_result_searches,
+	COALESCE(SUM(d.result_count_sum), 0) as result_count_sum,
+	COALESCE(SUM(d.clicks), 0) as clicks,
+	COALESCE(SUM(d.click_position_sum), 0) as click_position_sum`
+
+func (r *productAnalyticsRepository) searchDailyQuery(
+	ctx context.Context,
+	q ProductAnalyticsQuery,
+) *gorm.DB {
+	return r.db.WithContext(ctx).
+		Table("search_query_daily d").
+		Where("d.seller_id = ? AND d.day >= ?::date AND d.day <= ?::date",
+			q.SellerID, q.StartDay, q.EndDay)
+}
+
+// GetSearchQueries returns the seller's top search queries over the period
+func (r *productAnalyticsRepository) GetSearchQueries(
+	ctx context.Context,
+	q ProductAnalyticsQuery,
+) ([]SearchQueryAggregate, error) {
+	var rows []SearchQueryAggregate
+	err := r.searchDailyQuery(ctx, q).
+		Select("d.search_query as search_query, " + searchQueryColumns).
+		Group("d.search_query").
+		Order(q.SortColumn + " DESC, d.search_query ASC").
+		Limit(q.Limit).
+		Scan(&rows).Error
+	return rows, err
+}
+
+// GetSearchTotals sums search use across all the seller's queries
+func (r *productAnalyticsRepository) GetSearchTotals(
+	ctx context.Context,
+	q ProductAnalyticsQuery,
+) (SearchQueryAggregate, error) {
+	var totals SearchQueryAggregate
+	err := r.searchDailyQuery(ctx, q).
+		Select(searchQueryColumns).
+		Scan(&totals).Error
+	return totals, err
+}
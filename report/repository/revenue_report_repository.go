@@ -0,0 +1,228 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	paymentEntity "ecommerce-be/payment/entity"
+
+	"gorm.io/gorm"
+)
+
+// RevenueAggregate is one aggregated row of the seller ledger. Depending on the
+// query, rows are grouped by currency, by seller and currency, or by period and
+// currency; the unused grouping columns are left zero.
+type RevenueAggregate struct {
+	SellerID        uint   `gorm:"column:seller_id"`
+	SellerName      string `gorm:"column:seller_name"`
+	Bucket          string `gorm:"column:bucket"`
+	Currency        string `gorm:"column:currency"`
+	GMVCents        int64  `gorm:"column:gmv_cents"`
+	CommissionCents int64  `gorm:"column:commission_cents"`
+	GatewayFeeCents int64  `gorm:"column:gateway_fee_cents"`
+	RefundCents     int64  `gorm:"column:refund_cents"`
+	ChargebackCents int64  `gorm:"column:chargeback_cents"`
+	PayoutCents     int64  `gorm:"column:payout_cents"`
+	OrderCount      int    `gorm:"column:order_count"`
+}
+
+// RevenueQuery scopes ledger aggregation to a time window and optional seller/currency
+type RevenueQuery struct {
+	StartDate time.Time
+	EndDate   time.Time
+	SellerID  *uint
+	Currency  string
+}
+
+type RevenueReportRepository interface {
+	GetRevenueTotals(ctx context.Context, q RevenueQuery) ([]RevenueAggregate, error)
+	GetRevenueBySeller(
+		ctx context.Context,
+		q RevenueQuery,
+		sortColumn string,
+		limit, offset int,
+	) ([]RevenueAggregate, int64, error)
+	GetRevenueByPeriod(
+		ctx context.Context,
+		q RevenueQuery,
+		interval string,
+		timezone string,
+	) ([]RevenueAggregate, error)
+	GetLedgerEntries(
+		ctx context.Context,
+		q RevenueQuery,
+		entryType string,
+		limit, offset int,
+	) ([]paymentEntity.SellerLedgerEntry, int64, error)
+	FindSellerName(ctx context.Context, sellerID uint) (string, bool, error)
+}
+
+type revenueReportRepository struct {
+	db *gorm.DB
+}
+
+func NewRevenueReportRepository(db *gorm.DB) RevenueReportRepository {
+	return &revenueReportRepository{
+		db: db,
+	}
+}
+
+// revenueAggregateColumns sums ledger amounts per entry type. Order count only
+// considers sale entries so refunds of the same order are not double counted.
+var revenueAggregateColumns = fmt.Sprintf(`
+	l.currency as currency,
+	COALESCE(SUM(l.amount_cents) FILTER (WHERE l.entry_type = '%s'), 0) as gmv_cents,
+	COALESCE(SUM(l.amount_cents) FILTER (WHERE l.entry_type = '%s'), 0) as commission_cents,
+	COALESCE(SUM(l.amount_cents) FILTER (WHERE l.entry_type = '%s'), 0) as gateway_fee_cents,
+	COALESCE(SUM(l.amount_cents) FILTER (WHERE l.entry_type = '%s'), 0) as refund_cents,
+	COALESCE(SUM(l.amount_cents) FILTER (WHERE l.entry_type = '%s'), 0) as chargeback_cents,
+	COALESCE(SUM(l.amount_cents) FILTER (WHERE l.entry_type = '%s'), 0) as payout_cents,
+	COUNT(DISTINCT l.order_id) FILTER (WHERE l.entry_type = '%s') as order_count`,
+	paymentEntity.LedgerEntryTypeSale,
+	paymentEntity.LedgerEntryTypeCommission,
+	paymentEntity.LedgerEntryTypeGatewayFee,
+	paymentEntity.LedgerEntryTypeRefund,
+	paymentEntity.LedgerEntryTypeChargeback,
+	paymentEntity.LedgerEntryTypePayout,
+	paymentEntity.LedgerEntryTypeSale,
+)
+
+func (r *revenueReportRepository) ledgerQuery(ctx context.Context, q RevenueQuery) *gorm.DB {
+	query := r.db.WithContext(ctx).
+		Table("seller_ledger_entry l").
+		Where("l.occurred_at >= ? AND l.occurred_at <= ?", q.StartDate, q.EndDate)
+
+	if q.SellerID != nil {
+		query = query.Where("l.seller_id = ?", *q.SellerID)
+	}
+	if q.Currency != "" {
+		query = query.Where("l.currency = ?", q.Currency)
+	}
+	return query
+}
+
+func (r *revenueReportRepository) GetRevenueTotals(
+	ctx context.Context,
+	q RevenueQuery,
+) ([]RevenueAggregate, error) {
+	var rows []RevenueAggregate
+	err := r.ledgerQuery(ctx, q).
+		Select(revenueAggregateColumns).
+		Group("l.currency").
+		Order("l.currency ASC").
+		Scan(&rows).Error
+	return rows, err
+}
+
+// GetRevenueBySeller aggregates per seller and currency. sortColumn must be one
+// of the aggregate column aliases; callers resolve it from a whitelist.
+func (r *revenueReportRepository) GetRevenueBySeller(
+	ctx context.Context,
+	q RevenueQuery,
+	sortColumn string,
+	limit, offset int,
+) ([]RevenueAggregate, int64, error) {
+	var total int64
+	grouped := r.ledgerQuery(ctx, q).
+		Select("l.seller_id, l.currency").
+		Group("l.seller_id, l.currency")
+	if err := r.db.WithContext(ctx).
+		Table("(?) as grouped", grouped).
+		Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var rows []RevenueAggregate
+	err := r.ledgerQuery(ctx, q).
+		Select("l.seller_id as seller_id, COALESCE(sp.business_name, '') as seller_name, " +
+			revenueAggregateColumns).
+		Joins("LEFT JOIN seller_profile sp ON sp.user_id = l.seller_id").
+		Group("l.seller_id, sp.business_name, l.currency").
+		Order(sortColumn + " DESC, l.seller_id ASC, l.currency ASC").
+		Limit(limit).
+		Offset(offset).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, 0, err
+	}
+	return rows, total, nil
+}
+
+func (r *revenueReportRepository) GetRevenueByPeriod(
+	ctx context.Context,
+	q RevenueQuery,
+	interval string,
+	timezone string,
+) ([]RevenueAggregate, error) {
+	tz := sanitizeTimezone(timezone)
+
+	// interval is resolved from a whitelist and tz is sanitised, so embedding
+	// both is safe (see GetSalesTrendsMetrics).
+	bucketExpr := fmt.Sprintf("DATE_TRUNC('%s', l.occurred_at AT TIME ZONE '%s')", interval, tz)
+
+	var rows []RevenueAggregate
+	err := r.ledgerQuery(ctx, q).
+		Select(fmt.Sprintf("TO_CHAR(%s, 'YYYY-MM-DD HH24:MI:SS') as bucket, ", bucketExpr) +
+			revenueAggregateColumns).
+		Group(bucketExpr + ", l.currency").
+		Order(bucketExpr + " ASC, l.currency ASC").
+		Scan(&rows).Error
+	return rows, err
+}
+
+func (r *revenueReportRepository) GetLedgerEntries(
+	ctx context.Context,
+	q RevenueQuery,
+	entryType string,
+	limit, offset int,
+) ([]paymentEntity.SellerLedgerEntry, int64, error) {
+	filtered := func() *gorm.DB {
+		query := r.ledgerQuery(ctx, q)
+		if entryType != "" {
+			query = query.Where("l.entry_type = ?", entryType)
+		}
+		return query
+	}
+
+	var total int64
+	if err := filtered().Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var entries []paymentEntity.SellerLedgerEntry
+	err := filtered().
+		Select("l.*").
+		Order("l.occurred_at DESC, l.id DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&entries).Error
+	if err != nil {
+		return nil, 0, err
+	}
+	return entries, total, nil
+}
+
+// FindSellerName returns the seller's business name and whether the seller exists
+func (r *revenueReportRepository) FindSellerName(
+	ctx context.Context,
+	sellerID uint,
+) (string, bool, error) {
+	var rows []struct {
+		Name string `gorm:"column:name"`
+	}
+	err := r.db.WithContext(ctx).
+		Table(`"user" u`).
+		Select("COALESCE(sp.business_name, '') as name").
+		Joins("LEFT JOIN seller_profile sp ON sp.user_id = u.id").
+		Where("u.id = ?", sellerID).
+		Limit(1).
+		Scan(&rows).Error
+	if err != nil {
+		return "", false, err
+	}
+	if len(rows) == 0 {
+		return "", false, nil
+	}
+	return rows[0].Name, true, nil
+}
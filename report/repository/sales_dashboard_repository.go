@@ -0,0 +1,230 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"ecommerce-be/order/entity"
+	paymentEntity "ecommerce-be/payment/entity"
+	reportEntity "ecommerce-be/report/entity"
+
+	"gorm.io/gorm"
+)
+
+// SellerSalesDay is a seller's rolled up sales on one UTC day
+type SellerSalesDay struct {
+	Day           time.Time `gorm:"column:day"`
+	OrderCount    int64     `gorm:"column:order_count"`
+	RevenueCents  int64     `gorm:"column:revenue_cents"`
+	UnitsSold     int64     `gorm:"column:units_sold"`
+	RefundCount   int64     `gorm:"column:refund_count"`
+	RefundedCents int64     `gorm:"column:refunded_cents"`
+}
+
+// ProductSalesAggregate sums a product's sales over a period
+type ProductSalesAggregate struct {
+	ProductID    uint   `gorm:"column:product_id"`
+	ProductName  string `gorm:"column:product_name"`
+	OrderCount   int64  `gorm:"column:order_count"`
+	UnitsSold    int64  `gorm:"column:units_sold"`
+	RevenueCents int64  `gorm:"column:revenue_cents"`
+}
+
+// CategorySalesAggregate sums the sales of a category's products over a period
+type CategorySalesAggregate struct {
+	CategoryID   uint   `gorm:"column:category_id"`
+	CategoryName string `gorm:"column:category_name"`
+	UnitsSold    int64  `gorm:"column:units_sold"`
+	RevenueCents int64  `gorm:"column:revenue_cents"`
+}
+
+// SalesRankingQuery selects a seller's top rows between two UTC days, inclusive
+type SalesRankingQuery struct {
+	SellerID uint
+	StartDay string
+	EndDay   string
+	// SortColumn must be resolved from a whitelist by the caller
+	SortColumn string
+	Limit      int
+}
+
+type SalesDashboardRepository interface {
+	// RollupDays rewrites the sales rollups of the UTC days in [start, end)
+	RollupDays(ctx context.Context, start, end time.Time) error
+	GetSalesDays(ctx context.Context, sellerID uint, startDay, endDay string) ([]SellerSalesDay, error)
+	GetTopProducts(ctx context.Context, q SalesRankingQuery) ([]ProductSalesAggregate, error)
+	GetTopCategories(ctx context.Context, q SalesRankingQuery) ([]CategorySalesAggregate, error)
+	// GetFunnelEngagement sums the seller's storefront impressions, views and
+	// add-to-carts from the product analytics rollups
+	GetFunnelEngagement(
+		ctx context.Context,
+		sellerID uint,
+		startDay, endDay string,
+	) (ProductEngagementAggregate, error)
+}
+
+type salesDashboardRepository struct {
+	db *gorm.DB
+}
+
+func NewSalesDashboardRepository(db *gorm.DB) SalesDashboardRepository {
+	return &salesDashboardRepository{
+		db: db,
+	}
+}
+
+// salesOrderStatuses are the orders that count as sales. Returned orders were sales
+// and show up again as refunds.
+var salesOrderStatuses = []string{
+	string(entity.ORDER_STATUS_CONFIRMED),
+	string(entity.ORDER_STATUS_COMPLETED),
+	string(entity.ORDER_STATUS_RETURNED),
+}
+
+// rollupSellerSalesQuery merges orders placed and refunds completed per seller and day
+const rollupSellerSalesQuery = `
+	INSERT INTO seller_sales_daily
+		(seller_id, day, order_count, revenue_cents, units_sold, refund_count, refunded_cents)
+	SELECT seller_id, day,
+		SUM(order_count), SUM(revenue_cents), SUM(units_sold),
+		SUM(refund_count), SUM(refunded_cents)
+	FROM (
+		SELECT o.seller_id, (o.placed_at AT TIME ZONE 'UTC')::date as day,
+			COUNT(*) as order_count, SUM(o.total_cents) as revenue_cents,
+			COALESCE(SUM(items.units), 0) as units_sold,
+			0 as refund_count, 0 as refunded_cents
+		FROM "order" o
+		LEFT JOIN LATERAL (
+			SELECT SUM(oi.quantity) as units FROM order_item oi WHERE oi.order_id = o.id
+		) items ON TRUE
+		WHERE o.seller_id IS NOT NULL AND o.status IN ?
+			AND o.placed_at >= ? AND o.placed_at < ?
+		GROUP BY o.seller_id, day
+		UNION ALL
+		SELECT t.seller_id, (r.completed_at AT TIME ZONE 'UTC')::date as day,
+			0, 0, 0, COUNT(*), SUM(r.amount_cents)
+		FROM payment_refund r
+		JOIN payment_transaction t ON t.id = r.transaction_id
+		WHERE r.status = ? AND r.completed_at >= ? AND r.completed_at < ?
+		GROUP BY t.seller_id, day
+	) sales
+	GROUP BY seller_id, day`
+
+// rollupProductSalesQuery sums order lines per product and day. Lines of deleted
+// products have no product and are left out.
+const rollupProductSalesQuery = `
+	INSERT INTO seller_product_sales_daily
+		(product_id, day, seller_id, order_count, units_sold, revenue_cents)
+	SELECT oi.product_id, (o.placed_at AT TIME ZONE 'UTC')::date as day, o.seller_id,
+		COUNT(DISTINCT o.id), SUM(oi.quantity), SUM(oi.line_total_cents)
+	FROM order_item oi
+	JOIN "order" o ON o.id = oi.order_id
+	WHERE oi.product_id IS NOT NULL AND o.seller_id IS NOT NULL AND o.status IN ?
+		AND o.placed_at >= ? AND o.placed_at < ?
+	GROUP BY oi.product_id, day, o.seller_id`
+
+// RollupDays replaces the days' rollups in one transaction, so readers never see a
+// half-written day
+func (r *salesDashboardRepository) RollupDays(ctx context.Context, start, end time.Time) error {
+	startDay := start.UTC().Format(time.DateOnly)
+	endDay := end.UTC().Format(time.DateOnly)
+
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Where("day >= ?::date AND day < ?::date", startDay, endDay).
+			Delete(&reportEntity.SellerSalesDaily{}).Error
+		if err != nil {
+			return err
+		}
+		err = tx.Exec(rollupSellerSalesQuery,
+			salesOrderStatuses, start, end,
+			string(paymentEntity.RefundStatusCompleted), start, end,
+		).Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Where("day >= ?::date AND day < ?::date", startDay, endDay).
+			Delete(&reportEntity.SellerProductSalesDaily{}).Error
+		if err != nil {
+			return err
+		}
+		return tx.Exec(rollupProductSalesQuery, salesOrderStatuses, start, end).Error
+	})
+}
+
+// GetSalesDays returns the seller's days with sales or refunds, oldest first
+func (r *salesDashboardRepository) GetSalesDays(
+	ctx context.Context,
+	sellerID uint,
+	startDay, endDay string,
+) ([]SellerSalesDay, error) {
+	var rows []SellerSalesDay
+	err := r.db.WithContext(ctx).
+		Model(&reportEntity.SellerSalesDaily{}).
+		Where("seller_id = ? AND day >= ?::date AND day <= ?::date", sellerID, startDay, endDay).
+		Order("day ASC").
+		Scan(&rows).Error
+	return rows, err
+}
+
+func (r *salesDashboardRepository) productSalesQuery(
+	ctx context.Context,
+	q SalesRankingQuery,
+) *gorm.DB {
+	return r.db.WithContext(ctx).
+		Table("seller_product_sales_daily d").
+		Joins("JOIN product p ON p.id = d.product_id").
+		Where("d.seller_id = ? AND d.day >= ?::date AND d.day <= ?::date",
+			q.SellerID, q.StartDay, q.EndDay)
+}
+
+// GetTopProducts returns the seller's best selling products over the period
+func (r *salesDashboardRepository) GetTopProducts(
+	ctx context.Context,
+	q SalesRankingQuery,
+) ([]ProductSalesAggregate, error) {
+	var rows []ProductSalesAggregate
+	err := r.productSalesQuery(ctx, q).
+		Select(`d.product_id as product_id, p.name as product_name,
+			SUM(d.order_count) as order_count,
+			SUM(d.units_sold) as units_sold,
+			SUM(d.revenue_cents) as revenue_cents`).
+		Group("d.product_id, p.name").
+		Order(q.SortColumn + " DESC, d.product_id ASC").
+		Limit(q.Limit).
+		Scan(&rows).Error
+	return rows, err
+}
+
+// GetTopCategories returns the seller's best selling categories over the period
+func (r *salesDashboardRepository) GetTopCategories(
+	ctx context.Context,
+	q SalesRankingQuery,
+) ([]CategorySalesAggregate, error) {
+	var rows []CategorySalesAggregate
+	err := r.productSalesQuery(ctx, q).
+		Select(`c.id as category_id, c.name as category_name,
+			SUM(d.units_sold) as units_sold,
+			SUM(d.revenue_cents) as revenue_cents`).
+		Joins("JOIN category c ON c.id = p.category_id").
+		Group("c.id, c.name").
+		Order(q.SortColumn + " DESC, c.id ASC").
+		Limit(q.Limit).
+		Scan(&rows).Error
+	return rows, err
+}
+
+func (r *salesDashboardRepository) GetFunnelEngagement(
+	ctx context.Context,
+	sellerID uint,
+	startDay, endDay string,
+) (ProductEngagementAggregate, error) {
+	var totals ProductEngagementAggregate
+	err := r.db.WithContext(ctx).
+		Table("product_analytics_daily d").
+		Select(productEngagementColumns).
+		Where("d.seller_id = ? AND d.day >= ?::date AND d.day <= ?::date",
+			sellerID, startDay, endDay).
+		Scan(&totals).Error
+	return totals, err
+}
@@ -0,0 +1,267 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"ecommerce-be/order/entity"
+	paymentEntity "ecommerce-be/payment/entity"
+	reportEntity "ecommerce-be/report/entity"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// SellerOrderHealthAggregate counts a seller's orders placed in the window.
+// SLA tracking only covers shipped fulfillment types that were not cancelled.
+type SellerOrderHealthAggregate struct {
+	SellerID        uint  `gorm:"column:seller_id"`
+	OrderCount      int64 `gorm:"column:order_count"`
+	CancelledCount  int64 `gorm:"column:cancelled_count"`
+	SLATrackedCount int64 `gorm:"column:sla_tracked_count"`
+	SLABreachCount  int64 `gorm:"column:sla_breach_count"`
+}
+
+// SellerDisputeAggregate counts a seller's settled payments and the chargebacks
+// opened in the window
+type SellerDisputeAggregate struct {
+	SellerID         uint  `gorm:"column:seller_id"`
+	TransactionCount int64 `gorm:"column:transaction_count"`
+	DisputeCount     int64 `gorm:"column:dispute_count"`
+}
+
+// SellerHealthRow is a current health record joined with the seller's name
+type SellerHealthRow struct {
+	reportEntity.SellerHealth
+	SellerName string `gorm:"column:seller_name"`
+}
+
+// SellerHealthListQuery filters the admin seller health listing
+type SellerHealthListQuery struct {
+	Status         string
+	RiskFlag       string
+	ListingDemoted *bool
+	// SortColumn must be resolved from a whitelist by the caller
+	SortColumn string
+	SortDesc   bool
+	Limit      int
+	Offset     int
+}
+
+type SellerHealthRepository interface {
+	GetOrderHealth(
+		ctx context.Context,
+		start, end time.Time,
+		slaHours int,
+	) ([]SellerOrderHealthAggregate, error)
+	GetDisputeHealth(ctx context.Context, start, end time.Time) ([]SellerDisputeAggregate, error)
+	FindAllHealth(ctx context.Context) ([]reportEntity.SellerHealth, error)
+	SaveHealth(ctx context.Context, health *reportEntity.SellerHealth) error
+	SaveSnapshot(ctx context.Context, snapshot *reportEntity.SellerHealthSnapshot) error
+	ListHealth(ctx context.Context, q SellerHealthListQuery) ([]SellerHealthRow, int64, error)
+	FindHealth(ctx context.Context, sellerID uint) (*SellerHealthRow, error)
+	FindSnapshots(
+		ctx context.Context,
+		sellerID uint,
+		since time.Time,
+	) ([]reportEntity.SellerHealthSnapshot, error)
+}
+
+type sellerHealthRepository struct {
+	db *gorm.DB
+}
+
+func NewSellerHealthRepository(db *gorm.DB) SellerHealthRepository {
+	return &sellerHealthRepository{
+		db: db,
+	}
+}
+
+// sellerOrderHealthColumns counts cancellations and shipping SLA breaches. An order
+// breaches when its first shipment left later than slaHours after placement, or
+// has not left although the deadline passed.
+var sellerOrderHealthColumns = fmt.Sprintf(`
+	o.seller_id as seller_id,
+	COUNT(*) as order_count,
+	COUNT(*) FILTER (WHERE o.status = '%[1]s') as cancelled_count,
+	COUNT(*) FILTER (WHERE o.status <> '%[1]s'
+		AND o.fulfillment_type IN ('%[2]s', '%[3]s')) as sla_tracked_count,
+	COUNT(*) FILTER (WHERE o.status <> '%[1]s'
+		AND o.fulfillment_type IN ('%[2]s', '%[3]s')
+		AND COALESCE(s.first_shipped_at, NOW()) > o.placed_at + make_interval(hours => ?)
+	) as sla_breach_count`,
+	entity.ORDER_STATUS_CANCELLED,
+	entity.DIRECTSHIP,
+	entity.DELIVERY,
+)
+
+// GetOrderHealth aggregates orders placed in [start, end) per seller. Pending and
+// failed orders never reached the seller and are left out.
+func (r *sellerHealthRepository) GetOrderHealth(
+	ctx context.Context,
+	start, end time.Time,
+	slaHours int,
+) ([]SellerOrderHealthAggregate, error) {
+	firstShipment := r.db.WithContext(ctx).
+		Table("order_shipment").
+		Select("order_id, MIN(shipped_at) as first_shipped_at").
+		Group("order_id")
+
+	var rows []SellerOrderHealthAggregate
+	err := r.db.WithContext(ctx).
+		Table(`"order" o`).
+		Select(sellerOrderHealthColumns, slaHours).
+		Joins("LEFT JOIN (?) s ON s.order_id = o.id", firstShipment).
+		Where("o.seller_id IS NOT NULL AND o.placed_at >= ? AND o.placed_at < ?", start, end).
+		Where("o.status NOT IN ?", []string{
+			string(entity.ORDER_STATUS_PENDING),
+			string(entity.ORDER_STATUS_FAILED),
+		}).
+		Group("o.seller_id").
+		Scan(&rows).Error
+	return rows, err
+}
+
+// GetDisputeHealth counts completed payments created in [start, end) and
+// chargebacks opened in the same window, per seller
+func (r *sellerHealthRepository) GetDisputeHealth(
+	ctx context.Context,
+	start, end time.Time,
+) ([]SellerDisputeAggregate, error) {
+	var rows []SellerDisputeAggregate
+	err := r.db.WithContext(ctx).
+		Raw(`SELECT seller_id,
+				SUM(transaction_count) as transaction_count,
+				SUM(dispute_count) as dispute_count
+			FROM (
+				SELECT t.seller_id, COUNT(*) as transaction_count, 0 as dispute_count
+				FROM payment_transaction t
+				WHERE t.status <> ? AND t.status <> ?
+					AND t.created_at >= ? AND t.created_at < ?
+				GROUP BY t.seller_id
+				UNION ALL
+				SELECT t.seller_id, 0, COUNT(*)
+				FROM payment_chargeback cb
+				JOIN payment_transaction t ON t.id = cb.transaction_id
+				WHERE cb.opened_at >= ? AND cb.opened_at < ?
+				GROUP BY t.seller_id
+			) counts
+			GROUP BY seller_id`,
+			paymentEntity.TransactionStatusPending,
+			paymentEntity.TransactionStatusFailed,
+			start, end,
+			start, end,
+		).
+		Scan(&rows).Error
+	return rows, err
+}
+
+// FindAllHealth returns every seller's current health, to carry demotions over
+func (r *sellerHealthRepository) FindAllHealth(
+	ctx context.Context,
+) ([]reportEntity.SellerHealth, error) {
+	var rows []reportEntity.SellerHealth
+	err := r.db.WithContext(ctx).Order("seller_id ASC").Find(&rows).Error
+	return rows, err
+}
+
+// SaveHealth inserts or replaces a seller's current health
+func (r *sellerHealthRepository) SaveHealth(
+	ctx context.Context,
+	health *reportEntity.SellerHealth,
+) error {
+	return r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "seller_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{
+			"score", "status", "risk_flags", "listing_demoted", "demoted_at",
+			"calculated_at", "updated_at",
+		}),
+	}).Create(health).Error
+}
+
+// SaveSnapshot records the day's snapshot, replacing one from an earlier run that day
+func (r *sellerHealthRepository) SaveSnapshot(
+	ctx context.Context,
+	snapshot *reportEntity.SellerHealthSnapshot,
+) error {
+	return r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "seller_id"}, {Name: "snapshot_date"}},
+		DoUpdates: clause.AssignmentColumns([]string{
+			"window_days", "order_count", "cancelled_count", "sla_tracked_count",
+			"sla_breach_count", "transaction_count", "dispute_count", "review_count",
+			"review_score", "score", "status", "risk_flags",
+		}),
+	}).Create(snapshot).Error
+}
+
+func (r *sellerHealthRepository) healthQuery(ctx context.Context) *gorm.DB {
+	return r.db.WithContext(ctx).
+		Table("seller_health h").
+		Select("h.*, COALESCE(sp.business_name, '') as seller_name").
+		Joins("LEFT JOIN seller_profile sp ON sp.user_id = h.seller_id")
+}
+
+// ListHealth pages through current seller health with optional filters
+func (r *sellerHealthRepository) ListHealth(
+	ctx context.Context,
+	q SellerHealthListQuery,
+) ([]SellerHealthRow, int64, error) {
+	query := r.healthQuery(ctx)
+	if q.Status != "" {
+		query = query.Where("h.status = ?", q.Status)
+	}
+	if q.RiskFlag != "" {
+		query = query.Where("? = ANY(h.risk_flags)", q.RiskFlag)
+	}
+	if q.ListingDemoted != nil {
+		query = query.Where("h.listing_demoted = ?", *q.ListingDemoted)
+	}
+
+	var total int64
+	if err := query.Session(&gorm.Session{}).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	direction := "ASC"
+	if q.SortDesc {
+		direction = "DESC"
+	}
+	var rows []SellerHealthRow
+	err := query.
+		Order(q.SortColumn + " " + direction + ", h.seller_id ASC").
+		Limit(q.Limit).
+		Offset(q.Offset).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, 0, err
+	}
+	return rows, total, nil
+}
+
+// FindHealth returns a seller's current health, nil if never scored
+func (r *sellerHealthRepository) FindHealth(
+	ctx context.Context,
+	sellerID uint,
+) (*SellerHealthRow, error) {
+	var rows []SellerHealthRow
+	err := r.healthQuery(ctx).Where("h.seller_id = ?", sellerID).Limit(1).Scan(&rows).Error
+	if err != nil || len(rows) == 0 {
+		return nil, err
+	}
+	return &rows[0], nil
+}
+
+// FindSnapshots returns a seller's daily snapshots since the given day, oldest first
+func (r *sellerHealthRepository) FindSnapshots(
+	ctx context.Context,
+	sellerID uint,
+	since time.Time,
+) ([]reportEntity.SellerHealthSnapshot, error) {
+	var rows []reportEntity.SellerHealthSnapshot
+	err := r.db.WithContext(ctx).
+		Where("seller_id = ? AND snapshot_date >= ?", sellerID, since).
+		Order("snapshot_date ASC").
+		Find(&rows).Error
+	return rows, err
+}
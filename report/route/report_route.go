@@ -1,38 +1,212 @@
 package route
 
 import (
+	"net/http"
+
 	"ecommerce-be/common/constants"
 	"ecommerce-be/common/middleware"
+	"ecommerce-be/common/openapi"
 	"ecommerce-be/report/factory/singleton"
 	"ecommerce-be/report/handler"
+	"ecommerce-be/report/model"
+	"ecommerce-be/report/util"
 
 	"github.com/gin-gonic/gin"
 )
 
 type ReportModule struct {
-	reportHandler *handler.ReportHandler
+	reportHandler            *handler.ReportHandler
+	revenueReportHandler     *handler.RevenueReportHandler
+	paymentAnalyticsHandler  *handler.PaymentAnalyticsHandler
+	sellerHealthHandler      *handler.SellerHealthHandler
+	productAnalyticsHandler  *handler.ProductAnalyticsHandler
+	salesDashboardHandler    *handler.SalesDashboardHandler
+	platformAnalyticsHandler *handler.PlatformAnalyticsHandler
 }
 
 func NewReportModule() *ReportModule {
 	factory := singleton.GetInstance()
 	h := factory.GetReportHandler()
 	return &ReportModule{
-		reportHandler: h,
+		reportHandler:            h,
+		revenueReportHandler:     factory.GetRevenueReportHandler(),
+		paymentAnalyticsHandler:  factory.GetPaymentAnalyticsHandler(),
+		sellerHealthHandler:      factory.GetSellerHealthHandler(),
+		productAnalyticsHandler:  factory.GetProductAnalyticsHandler(),
+		salesDashboardHandler:    factory.GetSalesDashboardHandler(),
+		platformAnalyticsHandler: factory.GetPlatformAnalyticsHandler(),
 	}
 }
 
 func (m *ReportModule) RegisterRoutes(router *gin.Engine) {
 	sellerAuth := middleware.SellerAuth() // Admin auth for reports
+	reportTimeout := middleware.ReportRequestTimeout()
+
+	reportRoutes := openapi.NewGroup(router.Group(constants.APIBaseReport), "Reports")
+	reportRoutes.Use(sellerAuth, reportTimeout) // Apply admin auth to all report routes
+
+	{
+		reportRoutes.GET("/summary", m.reportHandler.GetSummary).
+			Summary("Get the seller sales summary").
+			Query(util.ReportQueryFilter{}).
+			Returns(http.StatusOK, model.ReportSummaryResponse{})
+		reportRoutes.GET("/sales/trends", m.reportHandler.GetSalesTrends).
+			Summary("Get sales trends over time").
+			Query(util.ReportQueryFilter{}).
+			Returns(http.StatusOK, model.ReportTrendsResponse{})
+		reportRoutes.GET("/orders/distribution", m.reportHandler.GetOrderDistribution).
+			Summary("Get order status distribution")
+		reportRoutes.GET("/products/top-sellers", m.reportHandler.GetTopSellingProducts).
+			Summary("Get top-selling products")
+		reportRoutes.GET("/customers/retention", m.reportHandler.GetCustomerRetention).
+			Summary("Get customer retention")
+		reportRoutes.GET("/promotions/performance", m.reportHandler.GetPromotionPerformance).
+			Summary("Get promotion performance")
+		reportRoutes.GET("/payments", m.paymentAnalyticsHandler.GetSellerPaymentAnalytics).
+			Summary("Get authorization success, declines and capture latency").
+			Query(model.PaymentAnalyticsFilter{}).
+			Returns(http.StatusOK, model.PaymentAnalyticsResponse{})
+		reportRoutes.GET("/products/engagement", m.productAnalyticsHandler.GetProductAnalytics).
+			Summary("Get product impressions, views and add-to-carts").
+			Description("Served from daily rollups refreshed hourly; days are UTC.").
+			Query(model.ProductAnalyticsFilter{}).
+			Returns(http.StatusOK, model.ProductAnalyticsResponse{})
+		reportRoutes.GET("/search/queries", m.productAnalyticsHandler.GetSearchAnalytics).
+			Summary("Get storefront search queries with result counts and clicks").
+			Description("Served from daily rollups refreshed hourly; days are UTC.").
+			Query(model.SearchAnalyticsFilter{}).
+			Returns(http.StatusOK, model.SearchAnalyticsResponse{})
+
+		// Seller sales dashboard, served from daily rollups refreshed hourly
+		reportRoutes.GET("/dashboard/sales", m.salesDashboardHandler.GetSales).
+			Summary("Get revenue, orders, AOV and refund rate over time").
+			Description("interval sets the bucket size: day, week, month, quarter or year. "+
+				"Days are UTC.").
+			Query(model.SalesDashboardFilter{}).
+			Returns(http.StatusOK, model.SalesDashboardResponse{})
+		reportRoutes.GET("/dashboard/top-products", m.salesDashboardHandler.GetTopProducts).
+			Summary("Get the best selling products").
+			Query(model.SalesRankingFilter{}).
+			Returns(http.StatusOK, model.TopProductsResponse{})
+		reportRoutes.GET("/dashboard/top-categories", m.salesDashboardHandler.GetTopCategories).
+			Summary("Get the best selling categories").
+			Query(model.SalesRankingFilter{}).
+			Returns(http.StatusOK, model.TopCategoriesResponse{})
+		reportRoutes.GET("/dashboard/funnel", m.salesDashboardHandler.GetConversionFunnel).
+			Summary("Get the conversion funnel from impressions to orders").
+			Query(model.SalesDashboardFilter{}).
+			Returns(http.StatusOK, model.ConversionFunnelResponse{})
+	}
+
+	// Storefront product and search events, reported by the storefront per seller
+	eventRoutes := openapi.NewGroup(router.Group(constants.APIBaseReport), "Analytics Events")
+	{
+		eventRoutes.POST(
+			"/events",
+			middleware.PublicAPIAuth(),
+			m.productAnalyticsHandler.RecordEvents,
+		).
+			Summary("Record storefront product and search events").
+			Description("Accepts up to 50 impression, view, add_to_cart, search and "+
+				"search_click events per call.").
+			Body(model.RecordAnalyticsEventsRequest{}).
+			Returns(http.StatusAccepted, model.RecordAnalyticsEventsResponse{})
+	}
+
+	// Platform revenue and commission reports (admin only)
+	revenueRoutes := openapi.NewGroup(
+		router.Group(constants.APIBaseReport+"/admin/revenue"),
+		"Revenue Reports",
+	)
+	revenueRoutes.Use(middleware.AdminAuth(), reportTimeout)
+
+	{
+		revenueRoutes.GET("/summary", m.revenueReportHandler.GetRevenueSummary).
+			Summary("Get platform revenue and commission totals").
+			Query(model.RevenueReportFilter{}).
+			Returns(http.StatusOK, model.RevenueSummaryResponse{})
+		revenueRoutes.GET("/sellers", m.revenueReportHandler.GetRevenueBySeller).
+			Summary("Get revenue per seller").
+			Query(model.RevenueReportFilter{}).
+			Returns(http.StatusOK, model.SellerRevenueResponse{})
+		revenueRoutes.GET("/periods", m.revenueReportHandler.GetRevenueByPeriod).
+			Summary("Get revenue per period").
+			Query(model.RevenueReportFilter{}).
+			Returns(http.StatusOK, model.RevenuePeriodsResponse{})
+		revenueRoutes.GET("/sellers/:sellerId", m.revenueReportHandler.GetSellerRevenueDetail).
+			Summary("Get revenue detail for a seller").
+			Query(model.RevenueReportFilter{}).
+			Returns(http.StatusOK, model.SellerRevenueDetailResponse{})
+		revenueRoutes.GET(
+			"/sellers/:sellerId/entries",
+			m.revenueReportHandler.GetSellerLedgerEntries,
+		).
+			Summary("List a seller's ledger entries").
+			Query(model.LedgerEntriesFilter{}).
+			Returns(http.StatusOK, model.LedgerEntriesResponse{})
+		revenueRoutes.GET("/export", m.revenueReportHandler.ExportRevenue).
+			Summary("Export revenue per seller as CSV").
+			Query(model.RevenueReportFilter{}).
+			Produces("text/csv")
+	}
+
+	// Platform payment authorization analytics (admin only)
+	paymentRoutes := openapi.NewGroup(
+		router.Group(constants.APIBaseReport+"/admin/payments"),
+		"Payment Analytics",
+	)
+	paymentRoutes.Use(middleware.AdminAuth(), reportTimeout)
+
+	{
+		paymentRoutes.GET("", m.paymentAnalyticsHandler.GetPaymentAnalytics).
+			Summary("Get authorization success, declines and capture latency per gateway").
+			Query(model.PaymentAnalyticsFilter{}).
+			Returns(http.StatusOK, model.PaymentAnalyticsResponse{})
+	}
+
+	// Seller health scores and risk flags (admin only)
+	sellerHealthRoutes := openapi.NewGroup(
+		router.Group(constants.APIBaseReport+"/admin/sellers"),
+		"Seller Health",
+	)
+	sellerHealthRoutes.Use(middleware.AdminAuth(), reportTimeout)
+
+	{
+		sellerHealthRoutes.GET("/health", m.sellerHealthHandler.ListSellerHealth).
+			Summary("List seller health scores and risk flags").
+			Query(model.SellerHealthFilter{}).
+			Returns(http.StatusOK, model.SellerHealthListResponse{})
+		sellerHealthRoutes.GET("/:sellerId/health", m.sellerHealthHandler.GetSellerHealthDetail).
+			Summary("Get a seller's health score with trend history").
+			Query(model.SellerHealthTrendFilter{}).
+			Returns(http.StatusOK, model.SellerHealthDetailResponse{})
+	}
 
-	reportRoutes := router.Group(constants.APIBaseReport)
-	reportRoutes.Use(sellerAuth) // Apply admin auth to all report routes
+	// Platform-wide analytics and seller leaderboard (admin only)
+	platformRoutes := openapi.NewGroup(
+		router.Group(constants.APIBaseReport+"/admin/platform"),
+		"Platform Analytics",
+	)
+	platformRoutes.Use(middleware.AdminAuth(), reportTimeout)
 
 	{
-		reportRoutes.GET("/summary", m.reportHandler.GetSummary)
-		reportRoutes.GET("/sales/trends", m.reportHandler.GetSalesTrends)
-		reportRoutes.GET("/orders/distribution", m.reportHandler.GetOrderDistribution)
-		reportRoutes.GET("/products/top-sellers", m.reportHandler.GetTopSellingProducts)
-		reportRoutes.GET("/customers/retention", m.reportHandler.GetCustomerRetention)
-		reportRoutes.GET("/promotions/performance", m.reportHandler.GetPromotionPerformance)
+		platformRoutes.GET("/overview", m.platformAnalyticsHandler.GetOverview).
+			Summary("Get platform GMV, take rate, active sellers and order volume").
+			Description("Totals are per currency and compared with the previous period "+
+				"unless compare=false.").
+			Query(model.PlatformAnalyticsFilter{}).
+			Returns(http.StatusOK, model.PlatformOverviewResponse{})
+		platformRoutes.GET("/leaderboard", m.platformAnalyticsHandler.GetLeaderboard).
+			Summary("Rank active sellers by GMV, orders or commission").
+			Query(model.PlatformAnalyticsFilter{}).
+			Returns(http.StatusOK, model.SellerLeaderboardResponse{})
+		platformRoutes.GET("/sellers/:sellerId", m.platformAnalyticsHandler.GetSellerDetail).
+			Summary("Get a seller's leaderboard rank and activity over time").
+			Query(model.PlatformAnalyticsFilter{}).
+			Returns(http.StatusOK, model.SellerPlatformDetailResponse{})
+		platformRoutes.GET("/export", m.platformAnalyticsHandler.Export).
+			Summary("Export the seller leaderboard or the period series as CSV").
+			Query(model.PlatformAnalyticsFilter{}).
+			Produces("text/csv")
 	}
 }
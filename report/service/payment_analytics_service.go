@@ -0,0 +1,185 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"ecommerce-be/common/config"
+	"ecommerce-be/common/constants"
+	"ecommerce-be/common/log"
+	"ecommerce-be/common/notifier"
+	reportError "ecommerce-be/report/error"
+	"ecommerce-be/report/factory"
+	"ecommerce-be/report/model"
+	"ecommerce-be/report/repository"
+	"ecommerce-be/report/util"
+)
+
+// DeclineAlertPolicy configures payment decline spike alerts.
+type DeclineAlertPolicy struct {
+	// ThresholdPercent is the decline rate above which an alert is raised
+	ThresholdPercent int
+	// Window is the period the decline rate is measured over
+	Window time.Duration
+	// MinAttempts is the number of decided payments a window needs before it can alert
+	MinAttempts int
+}
+
+// DefaultDeclineAlertPolicy builds the decline alert settings from config, with the
+// config defaults when config is not loaded.
+func DefaultDeclineAlertPolicy() DeclineAlertPolicy {
+	paymentConfig := config.PaymentConfig{
+		DeclineAlertThresholdPercent: 30,
+		DeclineAlertWindowMinutes:    60,
+		DeclineAlertMinAttempts:      20,
+	}
+	if cfg := config.Get(); cfg != nil {
+		paymentConfig = cfg.Payment
+	}
+	return DeclineAlertPolicy{
+		ThresholdPercent: paymentConfig.DeclineAlertThresholdPercent,
+		Window:           time.Duration(paymentConfig.DeclineAlertWindowMinutes) * time.Minute,
+		MinAttempts:      paymentConfig.DeclineAlertMinAttempts,
+	}
+}
+
+// PaymentAnalyticsService serves authorization success, decline reason and capture
+// latency analytics per gateway and card type, and alerts on decline spikes.
+type PaymentAnalyticsService interface {
+	// GetPaymentAnalytics scopes the report to sellerID when set, overriding the
+	// filter's seller
+	GetPaymentAnalytics(
+		ctx context.Context,
+		sellerID *uint,
+		filter model.PaymentAnalyticsFilter,
+	) (*model.PaymentAnalyticsResponse, error)
+	// CheckDeclineSpikes alerts sellers and admins about gateways whose decline rate
+	// spiked during the last window. Runs as a recurring cron job.
+	CheckDeclineSpikes()
+}
+
+type paymentAnalyticsService struct {
+	analyticsRepo repository.PaymentAnalyticsRepository
+	builder       *factory.PaymentAnalyticsBuilder
+	notifier      notifier.Notifier
+	alertPolicy   DeclineAlertPolicy
+}
+
+func NewPaymentAnalyticsService(
+	analyticsRepo repository.PaymentAnalyticsRepository,
+	builder *factory.PaymentAnalyticsBuilder,
+	notifier notifier.Notifier,
+	alertPolicy DeclineAlertPolicy,
+) PaymentAnalyticsService {
+	return &paymentAnalyticsService{
+		analyticsRepo: analyticsRepo,
+		builder:       builder,
+		notifier:      notifier,
+		alertPolicy:   alertPolicy,
+	}
+}
+
+func (s *paymentAnalyticsService) GetPaymentAnalytics(
+	ctx context.Context,
+	sellerID *uint,
+	filter model.PaymentAnalyticsFilter,
+) (*model.PaymentAnalyticsResponse, error) {
+	if sellerID != nil {
+		filter.SellerID = sellerID
+	}
+	periods, err := util.CalculatePeriods(filter.ReportQueryFilter)
+	if err != nil {
+		return nil, reportError.ErrInvalidReportFilter.WithMessage(err.Error())
+	}
+	query := repository.PaymentAnalyticsQuery{
+		StartDate: periods.CurrStart,
+		EndDate:   periods.CurrEnd,
+		SellerID:  filter.SellerID,
+		GatewayID: filter.GatewayID,
+	}
+
+	aggregates, err := s.analyticsRepo.GetAuthorizationStats(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	reasons, err := s.analyticsRepo.GetDeclineReasons(
+		ctx,
+		query,
+		util.PAYMENT_DECLINE_REASONS_LIMIT,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	totals := s.builder.BuildTotals(aggregates)
+	return &model.PaymentAnalyticsResponse{
+		StartDate:      formatReportDate(query.StartDate),
+		EndDate:        formatReportDate(query.EndDate),
+		SellerID:       filter.SellerID,
+		Totals:         totals,
+		Gateways:       s.builder.BuildGateways(aggregates),
+		DeclineReasons: s.builder.BuildDeclineReasons(reasons, totals.Declined),
+	}, nil
+}
+
+func (s *paymentAnalyticsService) CheckDeclineSpikes() {
+	ctx := context.Background()
+	end := time.Now().UTC()
+
+	aggregates, err := s.analyticsRepo.GetAuthorizationStatsBySeller(
+		ctx,
+		repository.PaymentAnalyticsQuery{StartDate: end.Add(-s.alertPolicy.Window), EndDate: end},
+	)
+	if err != nil {
+		log.ErrorWithContext(ctx, "Cron: Failed to load payment decline rates", err)
+		return
+	}
+
+	var spikes []repository.PaymentAuthorizationAggregate
+	for _, agg := range aggregates {
+		if util.IsDeclineSpike(agg.Attempts, agg.Declined,
+			s.alertPolicy.ThresholdPercent, s.alertPolicy.MinAttempts) {
+			spikes = append(spikes, agg)
+		}
+	}
+	if len(spikes) == 0 || s.notifier == nil {
+		return
+	}
+
+	adminIDs, err := s.analyticsRepo.FindAdminUserIDs(ctx)
+	if err != nil {
+		log.ErrorWithContext(ctx, "Cron: Failed to load payment alert recipients", err)
+	}
+	for _, spike := range spikes {
+		payload := s.buildDeclineSpikePayload(spike)
+		for _, userID := range append([]uint{spike.SellerID}, adminIDs...) {
+			err := s.notifier.Notify(ctx, constants.NOTIFY_EVENT_PAYMENT_DECLINE_SPIKE,
+				userID, payload)
+			if err != nil {
+				log.ErrorWithContext(ctx, fmt.Sprintf(
+					"Cron: Failed to queue payment decline alert for user %d", userID,
+				), err)
+			}
+		}
+	}
+}
+
+func (s *paymentAnalyticsService) buildDeclineSpikePayload(
+	agg repository.PaymentAuthorizationAggregate,
+) map[string]any {
+	gatewayName := agg.GatewayName
+	if gatewayName == "" {
+		gatewayName = agg.GatewayCode
+	}
+	return map[string]any{
+		"SellerID":      agg.SellerID,
+		"GatewayID":     agg.GatewayID,
+		"GatewayName":   gatewayName,
+		"Attempts":      agg.Attempts,
+		"Declined":      agg.Declined,
+		"DeclineRate":   util.RatePercentage(agg.Declined, agg.Attempts),
+		"Threshold":     s.alertPolicy.ThresholdPercent,
+		"WindowMinutes": int(s.alertPolicy.Window.Minutes()),
+	}
+}
@@ -0,0 +1,278 @@
+package service
+
+import (
+	"context"
+
+	reportError "ecommerce-be/report/error"
+	"ecommerce-be/report/factory"
+	"ecommerce-be/report/model"
+	"ecommerce-be/report/repository"
+	"ecommerce-be/report/util"
+)
+
+// leaderboardSortColumns whitelists sort_by values for the seller leaderboard
+var leaderboardSortColumns = map[string]string{
+	"":           "gmv_cents",
+	"gmv":        "gmv_cents",
+	"orders":     "order_count",
+	"commission": "commission_cents",
+}
+
+// PlatformAnalyticsService serves platform-wide GMV, take rate, active seller and
+// order volume analytics and the seller leaderboard for admins, built on the seller
+// ledger.
+type PlatformAnalyticsService interface {
+	GetOverview(
+		ctx context.Context,
+		filter model.PlatformAnalyticsFilter,
+	) (*model.PlatformOverviewResponse, error)
+	GetLeaderboard(
+		ctx context.Context,
+		filter model.PlatformAnalyticsFilter,
+	) (*model.SellerLeaderboardResponse, error)
+	GetSellerDetail(
+		ctx context.Context,
+		sellerID uint,
+		filter model.PlatformAnalyticsFilter,
+	) (*model.SellerPlatformDetailResponse, error)
+	// Export returns CSV records (header first) of the leaderboard or the period series
+	Export(ctx context.Context, filter model.PlatformAnalyticsFilter) ([][]string, error)
+}
+
+type platformAnalyticsService struct {
+	platformRepo repository.PlatformAnalyticsRepository
+	revenueRepo  repository.RevenueReportRepository
+	builder      *factory.PlatformAnalyticsBuilder
+}
+
+func NewPlatformAnalyticsService(
+	platformRepo repository.PlatformAnalyticsRepository,
+	revenueRepo repository.RevenueReportRepository,
+	builder *factory.PlatformAnalyticsBuilder,
+) PlatformAnalyticsService {
+	return &platformAnalyticsService{
+		platformRepo: platformRepo,
+		revenueRepo:  revenueRepo,
+		builder:      builder,
+	}
+}
+
+func (s *platformAnalyticsService) GetOverview(
+	ctx context.Context,
+	filter model.PlatformAnalyticsFilter,
+) (*model.PlatformOverviewResponse, error) {
+	periods, query, err := buildPlatformQuery(filter, nil)
+	if err != nil {
+		return nil, err
+	}
+	interval, err := util.ResolveInterval(filter.Interval, query.StartDate, query.EndDate)
+	if err != nil {
+		return nil, reportError.ErrInvalidReportFilter.WithMessage(err.Error())
+	}
+
+	current, err := s.platformRepo.GetPlatformTotals(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	var previous []repository.PlatformAggregate
+	if filter.Compare {
+		prevQuery := query
+		prevQuery.StartDate, prevQuery.EndDate = periods.PrevStart, periods.PrevEnd
+		if previous, err = s.platformRepo.GetPlatformTotals(ctx, prevQuery); err != nil {
+			return nil, err
+		}
+	}
+	series, err := s.platformRepo.GetPlatformByPeriod(
+		ctx,
+		query,
+		interval,
+		util.LocationToPostgresTZ(query.StartDate),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &model.PlatformOverviewResponse{
+		StartDate: formatReportDate(query.StartDate),
+		EndDate:   formatReportDate(query.EndDate),
+		Interval:  interval,
+		Totals:    s.builder.BuildTotals(current, previous, filter.Compare),
+		Periods:   s.builder.BuildPeriods(series),
+	}
+	if filter.Compare {
+		res.PreviousStartDate = formatReportDate(periods.PrevStart)
+		res.PreviousEndDate = formatReportDate(periods.PrevEnd)
+	}
+	return res, nil
+}
+
+func (s *platformAnalyticsService) GetLeaderboard(
+	ctx context.Context,
+	filter model.PlatformAnalyticsFilter,
+) (*model.SellerLeaderboardResponse, error) {
+	limit, offset := normalizeLeaderboardPage(filter.Limit, filter.Offset)
+	return s.getLeaderboard(ctx, filter, limit, offset)
+}
+
+func (s *platformAnalyticsService) getLeaderboard(
+	ctx context.Context,
+	filter model.PlatformAnalyticsFilter,
+	limit, offset int,
+) (*model.SellerLeaderboardResponse, error) {
+	query, err := s.leaderboardQuery(filter, nil)
+	if err != nil {
+		return nil, err
+	}
+	query.Limit, query.Offset = limit, offset
+
+	aggregates, total, err := s.platformRepo.GetSellerLeaderboard(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	sortBy := filter.SortBy
+	if sortBy == "" {
+		sortBy = "gmv"
+	}
+	return &model.SellerLeaderboardResponse{
+		StartDate: formatReportDate(query.Current.StartDate),
+		EndDate:   formatReportDate(query.Current.EndDate),
+		SortBy:    sortBy,
+		Sellers:   s.builder.BuildLeaderboard(aggregates, filter.Compare),
+		Total:     total,
+		Limit:     limit,
+		Offset:    offset,
+	}, nil
+}
+
+func (s *platformAnalyticsService) GetSellerDetail(
+	ctx context.Context,
+	sellerID uint,
+	filter model.PlatformAnalyticsFilter,
+) (*model.SellerPlatformDetailResponse, error) {
+	name, exists, err := s.revenueRepo.FindSellerName(ctx, sellerID)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, reportError.ErrReportSellerNotFound
+	}
+
+	// Rank the seller against every active seller, then keep only their rows
+	rankQuery, err := s.leaderboardQuery(filter, &sellerID)
+	if err != nil {
+		return nil, err
+	}
+	rankQuery.Limit = util.PLATFORM_LEADERBOARD_MAX_LIMIT
+	rankings, _, err := s.platformRepo.GetSellerLeaderboard(ctx, rankQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	_, query, err := buildPlatformQuery(filter, &sellerID)
+	if err != nil {
+		return nil, err
+	}
+	interval, err := util.ResolveInterval(filter.Interval, query.StartDate, query.EndDate)
+	if err != nil {
+		return nil, reportError.ErrInvalidReportFilter.WithMessage(err.Error())
+	}
+	series, err := s.platformRepo.GetPlatformByPeriod(
+		ctx,
+		query,
+		interval,
+		util.LocationToPostgresTZ(query.StartDate),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.SellerPlatformDetailResponse{
+		SellerID:   sellerID,
+		SellerName: name,
+		StartDate:  formatReportDate(query.StartDate),
+		EndDate:    formatReportDate(query.EndDate),
+		Interval:   interval,
+		Rankings:   s.builder.BuildLeaderboard(rankings, filter.Compare),
+		Periods:    s.builder.BuildPeriods(series),
+	}, nil
+}
+
+func (s *platformAnalyticsService) Export(
+	ctx context.Context,
+	filter model.PlatformAnalyticsFilter,
+) ([][]string, error) {
+	switch filter.GroupBy {
+	case "", util.REVENUE_GROUP_BY_SELLER:
+		res, err := s.getLeaderboard(ctx, filter, util.REVENUE_MAX_EXPORT_ROWS, 0)
+		if err != nil {
+			return nil, err
+		}
+		return s.builder.BuildLeaderboardCSV(res.Sellers), nil
+	case util.REVENUE_GROUP_BY_PERIOD:
+		res, err := s.GetOverview(ctx, filter)
+		if err != nil {
+			return nil, err
+		}
+		return s.builder.BuildPeriodCSV(res.Periods), nil
+	default:
+		return nil, reportError.ErrInvalidReportFilter.WithMessagef(
+			"unsupported group_by: %s",
+			filter.GroupBy,
+		)
+	}
+}
+
+func (s *platformAnalyticsService) leaderboardQuery(
+	filter model.PlatformAnalyticsFilter,
+	sellerID *uint,
+) (repository.LeaderboardQuery, error) {
+	sortColumn, ok := leaderboardSortColumns[filter.SortBy]
+	if !ok {
+		return repository.LeaderboardQuery{}, reportError.ErrInvalidReportFilter.WithMessagef(
+			"unsupported sort_by: %s",
+			filter.SortBy,
+		)
+	}
+	periods, query, err := buildPlatformQuery(filter, nil)
+	if err != nil {
+		return repository.LeaderboardQuery{}, err
+	}
+	return repository.LeaderboardQuery{
+		Current:    query,
+		PrevStart:  periods.PrevStart,
+		PrevEnd:    periods.PrevEnd,
+		SellerID:   sellerID,
+		SortColumn: sortColumn,
+	}, nil
+}
+
+func buildPlatformQuery(
+	filter model.PlatformAnalyticsFilter,
+	sellerID *uint,
+) (util.ReportPeriods, repository.PlatformQuery, error) {
+	periods, err := util.CalculatePeriods(filter.ReportQueryFilter)
+	if err != nil {
+		return util.ReportPeriods{}, repository.PlatformQuery{},
+			reportError.ErrInvalidReportFilter.WithMessage(err.Error())
+	}
+	return periods, repository.PlatformQuery{
+		StartDate: periods.CurrStart,
+		EndDate:   periods.CurrEnd,
+		SellerID:  sellerID,
+		Currency:  filter.Currency,
+	}, nil
+}
+
+func normalizeLeaderboardPage(limit, offset int) (int, int) {
+	if limit <= 0 {
+		limit = util.PLATFORM_LEADERBOARD_DEFAULT_LIMIT
+	}
+	if limit > util.PLATFORM_LEADERBOARD_MAX_LIMIT {
+		limit = util.PLATFORM_LEADERBOARD_MAX_LIMIT
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	return limit, offset
+}
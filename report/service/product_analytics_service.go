@@ -0,0 +1,242 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"ecommerce-be/common/log"
+	reportEntity "ecommerce-be/report/entity"
+	reportError "ecommerce-be/report/error"
+	"ecommerce-be/report/factory"
+	"ecommerce-be/report/model"
+	"ecommerce-be/report/repository"
+	"ecommerce-be/report/util"
+)
+
+// productAnalyticsSortColumns whitelists sort_by values for the product ranking
+var productAnalyticsSortColumns = map[string]string{
+	"":              "views",
+	"views":         "views",
+	"impressions":   "impressions",
+	"add_to_carts":  "add_to_carts",
+	"search_clicks": "search_clicks",
+}
+
+// searchAnalyticsSortColumns whitelists sort_by values for the search query ranking
+var searchAnalyticsSortColumns = map[string]string{
+	"":                     "searches",
+	"searches":             "searches",
+	"zero_result_searches": "zero_result_searches",
+	"clicks":               "clicks",
+}
+
+// ProductAnalyticsService ingests storefront product and search events, rolls them
+// up per day and serves the rollups to the seller dashboard.
+type ProductAnalyticsService interface {
+	// RecordEvents validates and stores a storefront's batch of events. userID is
+	// nil for guests.
+	RecordEvents(
+		ctx context.Context,
+		sellerID uint,
+		userID *uint,
+		req model.RecordAnalyticsEventsRequest,
+	) (*model.RecordAnalyticsEventsResponse, error)
+	GetProductAnalytics(
+		ctx context.Context,
+		sellerID uint,
+		filter model.ProductAnalyticsFilter,
+	) (*model.ProductAnalyticsResponse, error)
+	GetSearchAnalytics(
+		ctx context.Context,
+		sellerID uint,
+		filter model.SearchAnalyticsFilter,
+	) (*model.SearchAnalyticsResponse, error)
+	// RollupEvents rewrites yesterday's and today's rollups, so late events are
+	// picked up, and prunes raw events past retention. Runs as an interval cron job.
+	RollupEvents()
+}
+
+type productAnalyticsService struct {
+	analyticsRepo repository.ProductAnalyticsRepository
+	builder       *factory.ProductAnalyticsBuilder
+}
+
+func NewProductAnalyticsService(
+	analyticsRepo repository.ProductAnalyticsRepository,
+	builder *factory.ProductAnalyticsBuilder,
+) ProductAnalyticsService {
+	return &productAnalyticsService{
+		analyticsRepo: analyticsRepo,
+		builder:       builder,
+	}
+}
+
+func (s *productAnalyticsService) RecordEvents(
+	ctx context.Context,
+	sellerID uint,
+	userID *uint,
+	req model.RecordAnalyticsEventsRequest,
+) (*model.RecordAnalyticsEventsResponse, error) {
+	events := make([]reportEntity.AnalyticsEvent, 0, len(req.Events))
+	for i, input := range req.Events {
+		query := util.NormalizeSearchQuery(input.Query)
+		err := util.ValidateAnalyticsEvent(
+			input.Type,
+			input.ProductID,
+			query,
+			input.ResultCount,
+			input.Position,
+		)
+		if err != nil {
+			return nil, reportError.ErrInvalidAnalyticsEvent.WithMessagef(
+				"events[%d]: %s", i, err.Error(),
+			)
+		}
+
+		event := reportEntity.AnalyticsEvent{
+			SellerID:  sellerID,
+			EventType: input.Type,
+			UserID:    userID,
+		}
+		if input.SessionID != "" {
+			event.SessionID = &input.SessionID
+		}
+		switch input.Type {
+		case util.ANALYTICS_EVENT_SEARCH:
+			event.SearchQuery = &query
+			event.ResultCount = input.ResultCount
+		case util.ANALYTICS_EVENT_SEARCH_CLICK:
+			event.ProductID = input.ProductID
+			event.SearchQuery = &query
+			event.Position = input.Position
+		default:
+			event.ProductID = input.ProductID
+		}
+		events = append(events, event)
+	}
+
+	if err := s.analyticsRepo.InsertEvents(ctx, events); err != nil {
+		return nil, err
+	}
+	return &model.RecordAnalyticsEventsResponse{Accepted: len(events)}, nil
+}
+
+func (s *productAnalyticsService) GetProductAnalytics(
+	ctx context.Context,
+	sellerID uint,
+	filter model.ProductAnalyticsFilter,
+) (*model.ProductAnalyticsResponse, error) {
+	sortColumn, ok := productAnalyticsSortColumns[filter.SortBy]
+	if !ok {
+		return nil, reportError.ErrInvalidReportFilter.WithMessagef(
+			"unsupported sort_by: %s",
+			filter.SortBy,
+		)
+	}
+	query, periods, err := analyticsQuery(sellerID, filter.ReportQueryFilter, filter.Limit)
+	if err != nil {
+		return nil, err
+	}
+	query.SortColumn = sortColumn
+
+	products, err := s.analyticsRepo.GetProductEngagement(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	totals, err := s.analyticsRepo.GetProductEngagementTotals(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.ProductAnalyticsResponse{
+		StartDate: formatReportDate(periods.CurrStart),
+		EndDate:   formatReportDate(periods.CurrEnd),
+		Totals:    s.builder.BuildEngagementStats(totals),
+		Products:  s.builder.BuildProductRows(products),
+	}, nil
+}
+
+func (s *productAnalyticsService) GetSearchAnalytics(
+	ctx context.Context,
+	sellerID uint,
+	filter model.SearchAnalyticsFilter,
+) (*model.SearchAnalyticsResponse, error) {
+	sortColumn, ok := searchAnalyticsSortColumns[filter.SortBy]
+	if !ok {
+		return nil, reportError.ErrInvalidReportFilter.WithMessagef(
+			"unsupported sort_by: %s",
+			filter.SortBy,
+		)
+	}
+	query, periods, err := analyticsQuery(sellerID, filter.ReportQueryFilter, filter.Limit)
+	if err != nil {
+		return nil, err
+	}
+	query.SortColumn = sortColumn
+
+	queries, err := s.analyticsRepo.GetSearchQueries(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	totals, err := s.analyticsRepo.GetSearchTotals(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.SearchAnalyticsResponse{
+		StartDate: formatReportDate(periods.CurrStart),
+		EndDate:   formatReportDate(periods.CurrEnd),
+		Totals:    s.builder.BuildSearchStats(totals),
+		Queries:   s.builder.BuildSearchRows(queries),
+	}, nil
+}
+
+// analyticsQuery resolves the report period to the UTC days the rollups cover
+func analyticsQuery(
+	sellerID uint,
+	filter util.ReportQueryFilter,
+	limit int,
+) (repository.ProductAnalyticsQuery, util.ReportPeriods, error) {
+	periods, err := util.CalculatePeriods(filter)
+	if err != nil {
+		return repository.ProductAnalyticsQuery{}, util.ReportPeriods{},
+			reportError.ErrInvalidReportFilter.WithMessage(err.Error())
+	}
+	if limit <= 0 {
+		limit = util.PRODUCT_ANALYTICS_DEFAULT_LIMIT
+	}
+	if limit > util.PRODUCT_ANALYTICS_MAX_LIMIT {
+		limit = util.PRODUCT_ANALYTICS_MAX_LIMIT
+	}
+	return repository.ProductAnalyticsQuery{
+		SellerID: sellerID,
+		StartDay: periods.CurrStart.UTC().Format(time.DateOnly),
+		EndDay:   periods.CurrEnd.UTC().Format(time.DateOnly),
+		Limit:    limit,
+	}, periods, nil
+}
+
+func (s *productAnalyticsService) RollupEvents() {
+	ctx := context.Background()
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+
+	for _, day := range []time.Time{today.AddDate(0, 0, -1), today} {
+		if err := s.analyticsRepo.RollupDay(ctx, day); err != nil {
+			log.ErrorWithContext(ctx, fmt.Sprintf(
+				"Cron: Failed to roll up product analytics for %s", day.Format(time.DateOnly),
+			), err)
+			return
+		}
+	}
+
+	cutoff := today.AddDate(0, 0, -util.ANALYTICS_EVENT_RETENTION_DAYS)
+	pruned, err := s.analyticsRepo.DeleteEventsBefore(ctx, cutoff)
+	if err != nil {
+		log.ErrorWithContext(ctx, "Cron: Failed to prune analytics events", err)
+		return
+	}
+	if pruned > 0 {
+		log.InfoWithContext(ctx, fmt.Sprintf("Cron: Pruned %d analytics events", pruned))
+	}
+}
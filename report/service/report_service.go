@@ -80,21 +80,7 @@ func (s *reportService) GetSalesTrends(
 		return nil, err
 	}
 
-	var interval string
-	duration := periods.CurrEnd.Sub(periods.CurrStart)
-	hours := duration.Hours()
-
-	if hours <= 24 {
-		interval = "hour"
-	} else if hours <= 31*24 {
-		interval = "day"
-	} else if hours <= 90*24 {
-		interval = "week"
-	} else if hours <= 365*24 {
-		interval = "month"
-	} else {
-		interval = "quarter"
-	}
+	interval := util.DefaultInterval(periods.CurrStart, periods.CurrEnd)
 
 	metrics, err := s.reportRepo.GetSalesTrendsMetrics(
 		ctx,
@@ -0,0 +1,337 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	paymentEntity "ecommerce-be/payment/entity"
+	reportError "ecommerce-be/report/error"
+	"ecommerce-be/report/factory"
+	"ecommerce-be/report/model"
+	"ecommerce-be/report/repository"
+	"ecommerce-be/report/util"
+)
+
+// revenueSortColumns whitelists sort_by values for the per-seller report
+var revenueSortColumns = map[string]string{
+	"":            "gmv_cents",
+	"gmv":         "gmv_cents",
+	"commission":  "commission_cents",
+	"refunds":     "refund_cents",
+	"chargebacks": "chargeback_cents",
+}
+
+// RevenueReportService serves platform-wide revenue and commission reports for
+// admins, built on the seller ledger.
+type RevenueReportService interface {
+	GetRevenueSummary(
+		ctx context.Context,
+		filter model.RevenueReportFilter,
+	) (*model.RevenueSummaryResponse, error)
+	GetRevenueBySeller(
+		ctx context.Context,
+		filter model.RevenueReportFilter,
+	) (*model.SellerRevenueResponse, error)
+	GetRevenueByPeriod(
+		ctx context.Context,
+		filter model.RevenueReportFilter,
+	) (*model.RevenuePeriodsResponse, error)
+	GetSellerRevenueDetail(
+		ctx context.Context,
+		sellerID uint,
+		filter model.RevenueReportFilter,
+	) (*model.SellerRevenueDetailResponse, error)
+	GetSellerLedgerEntries(
+		ctx context.Context,
+		sellerID uint,
+		filter model.LedgerEntriesFilter,
+	) (*model.LedgerEntriesResponse, error)
+	// ExportRevenue returns CSV records (header first) grouped by seller or period
+	ExportRevenue(ctx context.Context, filter model.RevenueReportFilter) ([][]string, error)
+}
+
+type revenueReportService struct {
+	revenueRepo repository.RevenueReportRepository
+	builder     *factory.RevenueReportBuilder
+}
+
+func NewRevenueReportService(
+	revenueRepo repository.RevenueReportRepository,
+	builder *factory.RevenueReportBuilder,
+) RevenueReportService {
+	return &revenueReportService{
+		revenueRepo: revenueRepo,
+		builder:     builder,
+	}
+}
+
+func (s *revenueReportService) GetRevenueSummary(
+	ctx context.Context,
+	filter model.RevenueReportFilter,
+) (*model.RevenueSummaryResponse, error) {
+	query, err := buildRevenueQuery(filter.ReportQueryFilter, filter.SellerID, filter.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	aggregates, err := s.revenueRepo.GetRevenueTotals(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.RevenueSummaryResponse{
+		StartDate: formatReportDate(query.StartDate),
+		EndDate:   formatReportDate(query.EndDate),
+		Totals:    s.builder.BuildTotalsList(aggregates),
+	}, nil
+}
+
+func (s *revenueReportService) GetRevenueBySeller(
+	ctx context.Context,
+	filter model.RevenueReportFilter,
+) (*model.SellerRevenueResponse, error) {
+	query, err := buildRevenueQuery(filter.ReportQueryFilter, filter.SellerID, filter.Currency)
+	if err != nil {
+		return nil, err
+	}
+	sortColumn, err := resolveRevenueSortColumn(filter.SortBy)
+	if err != nil {
+		return nil, err
+	}
+	limit, offset := normalizeRevenuePage(filter.Limit, filter.Offset)
+
+	aggregates, total, err := s.revenueRepo.GetRevenueBySeller(
+		ctx,
+		query,
+		sortColumn,
+		limit,
+		offset,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.SellerRevenueResponse{
+		StartDate: formatReportDate(query.StartDate),
+		EndDate:   formatReportDate(query.EndDate),
+		Sellers:   s.builder.BuildSellerRows(aggregates),
+		Total:     total,
+		Limit:     limit,
+		Offset:    offset,
+	}, nil
+}
+
+func (s *revenueReportService) GetRevenueByPeriod(
+	ctx context.Context,
+	filter model.RevenueReportFilter,
+) (*model.RevenuePeriodsResponse, error) {
+	query, err := buildRevenueQuery(filter.ReportQueryFilter, filter.SellerID, filter.Currency)
+	if err != nil {
+		return nil, err
+	}
+	interval, err := util.ResolveInterval(filter.Interval, query.StartDate, query.EndDate)
+	if err != nil {
+		return nil, reportError.ErrInvalidReportFilter.WithMessage(err.Error())
+	}
+
+	aggregates, err := s.revenueRepo.GetRevenueByPeriod(
+		ctx,
+		query,
+		interval,
+		util.LocationToPostgresTZ(query.StartDate),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.RevenuePeriodsResponse{
+		StartDate: formatReportDate(query.StartDate),
+		EndDate:   formatReportDate(query.EndDate),
+		Interval:  interval,
+		SellerID:  filter.SellerID,
+		Periods:   s.builder.BuildPeriods(aggregates),
+	}, nil
+}
+
+func (s *revenueReportService) GetSellerRevenueDetail(
+	ctx context.Context,
+	sellerID uint,
+	filter model.RevenueReportFilter,
+) (*model.SellerRevenueDetailResponse, error) {
+	sellerName, err := s.findSellerName(ctx, sellerID)
+	if err != nil {
+		return nil, err
+	}
+
+	filter.SellerID = &sellerID
+	totals, err := s.GetRevenueSummary(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	periods, err := s.GetRevenueByPeriod(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.SellerRevenueDetailResponse{
+		SellerID:   sellerID,
+		SellerName: sellerName,
+		StartDate:  totals.StartDate,
+		EndDate:    totals.EndDate,
+		Interval:   periods.Interval,
+		Totals:     totals.Totals,
+		Periods:    periods.Periods,
+	}, nil
+}
+
+func (s *revenueReportService) GetSellerLedgerEntries(
+	ctx context.Context,
+	sellerID uint,
+	filter model.LedgerEntriesFilter,
+) (*model.LedgerEntriesResponse, error) {
+	if _, err := s.findSellerName(ctx, sellerID); err != nil {
+		return nil, err
+	}
+	if filter.EntryType != "" && !paymentEntity.LedgerEntryType(filter.EntryType).IsValid() {
+		return nil, reportError.ErrInvalidReportFilter.WithMessagef(
+			"unsupported entry_type: %s",
+			filter.EntryType,
+		)
+	}
+
+	query, err := buildRevenueQuery(filter.ReportQueryFilter, &sellerID, filter.Currency)
+	if err != nil {
+		return nil, err
+	}
+	limit, offset := normalizeRevenuePage(filter.Limit, filter.Offset)
+
+	entries, total, err := s.revenueRepo.GetLedgerEntries(
+		ctx,
+		query,
+		filter.EntryType,
+		limit,
+		offset,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.LedgerEntriesResponse{
+		SellerID: sellerID,
+		Entries:  s.builder.BuildLedgerEntries(entries),
+		Total:    total,
+		Limit:    limit,
+		Offset:   offset,
+	}, nil
+}
+
+func (s *revenueReportService) ExportRevenue(
+	ctx context.Context,
+	filter model.RevenueReportFilter,
+) ([][]string, error) {
+	switch filter.GroupBy {
+	case "", util.REVENUE_GROUP_BY_SELLER:
+		res, err := s.getRevenueBySellerUnbounded(ctx, filter)
+		if err != nil {
+			return nil, err
+		}
+		return s.builder.BuildSellerCSV(res), nil
+	case util.REVENUE_GROUP_BY_PERIOD:
+		res, err := s.GetRevenueByPeriod(ctx, filter)
+		if err != nil {
+			return nil, err
+		}
+		return s.builder.BuildPeriodCSV(res.Periods), nil
+	default:
+		return nil, reportError.ErrInvalidReportFilter.WithMessagef(
+			"unsupported group_by: %s",
+			filter.GroupBy,
+		)
+	}
+}
+
+// getRevenueBySellerUnbounded fetches per-seller rows up to the export cap,
+// bypassing the interactive page size limit.
+func (s *revenueReportService) getRevenueBySellerUnbounded(
+	ctx context.Context,
+	filter model.RevenueReportFilter,
+) ([]model.SellerRevenueRow, error) {
+	query, err := buildRevenueQuery(filter.ReportQueryFilter, filter.SellerID, filter.Currency)
+	if err != nil {
+		return nil, err
+	}
+	sortColumn, err := resolveRevenueSortColumn(filter.SortBy)
+	if err != nil {
+		return nil, err
+	}
+
+	aggregates, _, err := s.revenueRepo.GetRevenueBySeller(
+		ctx,
+		query,
+		sortColumn,
+		util.REVENUE_MAX_EXPORT_ROWS,
+		0,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return s.builder.BuildSellerRows(aggregates), nil
+}
+
+func (s *revenueReportService) findSellerName(ctx context.Context, sellerID uint) (string, error) {
+	name, exists, err := s.revenueRepo.FindSellerName(ctx, sellerID)
+	if err != nil {
+		return "", err
+	}
+	if !exists {
+		return "", reportError.ErrReportSellerNotFound
+	}
+	return name, nil
+}
+
+func resolveRevenueSortColumn(sortBy string) (string, error) {
+	sortColumn, ok := revenueSortColumns[sortBy]
+	if !ok {
+		return "", reportError.ErrInvalidReportFilter.WithMessagef(
+			"unsupported sort_by: %s",
+			sortBy,
+		)
+	}
+	return sortColumn, nil
+}
+
+func buildRevenueQuery(
+	filter util.ReportQueryFilter,
+	sellerID *uint,
+	currency string,
+) (repository.RevenueQuery, error) {
+	periods, err := util.CalculatePeriods(filter)
+	if err != nil {
+		return repository.RevenueQuery{}, reportError.ErrInvalidReportFilter.WithMessage(
+			err.Error(),
+		)
+	}
+	return repository.RevenueQuery{
+		StartDate: periods.CurrStart,
+		EndDate:   periods.CurrEnd,
+		SellerID:  sellerID,
+		Currency:  currency,
+	}, nil
+}
+
+func normalizeRevenuePage(limit, offset int) (int, int) {
+	if limit <= 0 {
+		limit = util.REVENUE_DEFAULT_PAGE_SIZE
+	}
+	if limit > util.REVENUE_MAX_PAGE_SIZE {
+		limit = util.REVENUE_MAX_PAGE_SIZE
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	return limit, offset
+}
+
+func formatReportDate(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,251 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"ecommerce-be/common/log"
+	reportError "ecommerce-be/report/error"
+	"ecommerce-be/report/factory"
+	"ecommerce-be/report/model"
+	"ecommerce-be/report/repository"
+	"ecommerce-be/report/util"
+)
+
+// salesRankingSortColumns whitelists sort_by values for top products and categories
+var salesRankingSortColumns = map[string]string{
+	"":        "revenue_cents",
+	"revenue": "revenue_cents",
+	"units":   "units_sold",
+}
+
+// SalesDashboardService serves the seller sales dashboard from daily rollups, which
+// it keeps fresh with scheduled jobs.
+type SalesDashboardService interface {
+	GetSales(
+		ctx context.Context,
+		sellerID uint,
+		filter model.SalesDashboardFilter,
+	) (*model.SalesDashboardResponse, error)
+	GetTopProducts(
+		ctx context.Context,
+		sellerID uint,
+		filter model.SalesRankingFilter,
+	) (*model.TopProductsResponse, error)
+	GetTopCategories(
+		ctx context.Context,
+		sellerID uint,
+		filter model.SalesRankingFilter,
+	) (*model.TopCategoriesResponse, error)
+	GetConversionFunnel(
+		ctx context.Context,
+		sellerID uint,
+		filter model.SalesDashboardFilter,
+	) (*model.ConversionFunnelResponse, error)
+	// RollupRecent rewrites the most recent days' rollups. Runs as an interval cron job.
+	RollupRecent()
+	// RollupBackfill rewrites the last weeks' rollups, picking up later cancellations,
+	// returns and refunds. Runs as a daily cron job.
+	RollupBackfill()
+}
+
+type salesDashboardService struct {
+	salesRepo repository.SalesDashboardRepository
+	builder   *factory.SalesDashboardBuilder
+}
+
+func NewSalesDashboardService(
+	salesRepo repository.SalesDashboardRepository,
+	builder *factory.SalesDashboardBuilder,
+) SalesDashboardService {
+	return &salesDashboardService{
+		salesRepo: salesRepo,
+		builder:   builder,
+	}
+}
+
+// salesPeriod is a dashboard period resolved to the UTC days the rollups cover
+type salesPeriod struct {
+	periods  util.ReportPeriods
+	startDay time.Time
+	endDay   time.Time
+}
+
+func (p salesPeriod) startText() string { return p.startDay.Format(time.DateOnly) }
+func (p salesPeriod) endText() string   { return p.endDay.Format(time.DateOnly) }
+
+func resolveSalesPeriod(filter util.ReportQueryFilter) (salesPeriod, error) {
+	periods, err := util.CalculatePeriods(filter)
+	if err != nil {
+		return salesPeriod{}, reportError.ErrInvalidReportFilter.WithMessage(err.Error())
+	}
+	period := salesPeriod{
+		periods:  periods,
+		startDay: util.SalesBucketStart(periods.CurrStart.UTC(), "day"),
+		endDay:   util.SalesBucketStart(periods.CurrEnd.UTC(), "day"),
+	}
+	if period.endDay.Sub(period.startDay) >= util.SALES_DASHBOARD_MAX_DAYS*24*time.Hour {
+		return salesPeriod{}, reportError.ErrInvalidReportFilter.WithMessagef(
+			"period must not exceed %d days", util.SALES_DASHBOARD_MAX_DAYS,
+		)
+	}
+	return period, nil
+}
+
+func (s *salesDashboardService) GetSales(
+	ctx context.Context,
+	sellerID uint,
+	filter model.SalesDashboardFilter,
+) (*model.SalesDashboardResponse, error) {
+	period, err := resolveSalesPeriod(filter.ReportQueryFilter)
+	if err != nil {
+		return nil, err
+	}
+	granularity, err := util.ResolveSalesGranularity(
+		filter.Interval,
+		period.periods.CurrStart,
+		period.periods.CurrEnd,
+	)
+	if err != nil {
+		return nil, reportError.ErrInvalidReportFilter.WithMessage(err.Error())
+	}
+
+	days, err := s.salesRepo.GetSalesDays(ctx, sellerID, period.startText(), period.endText())
+	if err != nil {
+		return nil, err
+	}
+	totals, series := s.builder.BuildSeries(days, period.startDay, period.endDay, granularity)
+
+	return &model.SalesDashboardResponse{
+		StartDate: formatReportDate(period.periods.CurrStart),
+		EndDate:   formatReportDate(period.periods.CurrEnd),
+		Interval:  granularity,
+		Totals:    totals,
+		Series:    series,
+	}, nil
+}
+
+func (s *salesDashboardService) rankingQuery(
+	sellerID uint,
+	filter model.SalesRankingFilter,
+) (repository.SalesRankingQuery, salesPeriod, error) {
+	sortColumn, ok := salesRankingSortColumns[filter.SortBy]
+	if !ok {
+		return repository.SalesRankingQuery{}, salesPeriod{},
+			reportError.ErrInvalidReportFilter.WithMessagef(
+				"unsupported sort_by: %s",
+				filter.SortBy,
+			)
+	}
+	period, err := resolveSalesPeriod(filter.ReportQueryFilter)
+	if err != nil {
+		return repository.SalesRankingQuery{}, salesPeriod{}, err
+	}
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = util.SALES_DASHBOARD_DEFAULT_LIMIT
+	}
+	if limit > util.SALES_DASHBOARD_MAX_LIMIT {
+		limit = util.SALES_DASHBOARD_MAX_LIMIT
+	}
+	return repository.SalesRankingQuery{
+		SellerID:   sellerID,
+		StartDay:   period.startText(),
+		EndDay:     period.endText(),
+		SortColumn: sortColumn,
+		Limit:      limit,
+	}, period, nil
+}
+
+func (s *salesDashboardService) GetTopProducts(
+	ctx context.Context,
+	sellerID uint,
+	filter model.SalesRankingFilter,
+) (*model.TopProductsResponse, error) {
+	query, period, err := s.rankingQuery(sellerID, filter)
+	if err != nil {
+		return nil, err
+	}
+	products, err := s.salesRepo.GetTopProducts(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	return &model.TopProductsResponse{
+		StartDate: formatReportDate(period.periods.CurrStart),
+		EndDate:   formatReportDate(period.periods.CurrEnd),
+		Products:  s.builder.BuildTopProducts(products),
+	}, nil
+}
+
+func (s *salesDashboardService) GetTopCategories(
+	ctx context.Context,
+	sellerID uint,
+	filter model.SalesRankingFilter,
+) (*model.TopCategoriesResponse, error) {
+	query, period, err := s.rankingQuery(sellerID, filter)
+	if err != nil {
+		return nil, err
+	}
+	categories, err := s.salesRepo.GetTopCategories(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	return &model.TopCategoriesResponse{
+		StartDate:  formatReportDate(period.periods.CurrStart),
+		EndDate:    formatReportDate(period.periods.CurrEnd),
+		Categories: s.builder.BuildTopCategories(categories),
+	}, nil
+}
+
+func (s *salesDashboardService) GetConversionFunnel(
+	ctx context.Context,
+	sellerID uint,
+	filter model.SalesDashboardFilter,
+) (*model.ConversionFunnelResponse, error) {
+	period, err := resolveSalesPeriod(filter.ReportQueryFilter)
+	if err != nil {
+		return nil, err
+	}
+	engagement, err := s.salesRepo.GetFunnelEngagement(
+		ctx, sellerID, period.startText(), period.endText(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	days, err := s.salesRepo.GetSalesDays(ctx, sellerID, period.startText(), period.endText())
+	if err != nil {
+		return nil, err
+	}
+	var orders int64
+	for _, day := range days {
+		orders += day.OrderCount
+	}
+
+	return &model.ConversionFunnelResponse{
+		StartDate: formatReportDate(period.periods.CurrStart),
+		EndDate:   formatReportDate(period.periods.CurrEnd),
+		Steps:     s.builder.BuildFunnel(engagement, orders),
+	}, nil
+}
+
+func (s *salesDashboardService) RollupRecent() {
+	s.rollupLastDays(util.SALES_ROLLUP_RECENT_DAYS)
+}
+
+func (s *salesDashboardService) RollupBackfill() {
+	s.rollupLastDays(util.SALES_ROLLUP_BACKFILL_DAYS)
+}
+
+// rollupLastDays rewrites the rollups of the last days UTC days, today included
+func (s *salesDashboardService) rollupLastDays(days int) {
+	ctx := context.Background()
+	end := time.Now().UTC().Truncate(24*time.Hour).AddDate(0, 0, 1)
+	start := end.AddDate(0, 0, -days)
+
+	if err := s.salesRepo.RollupDays(ctx, start, end); err != nil {
+		log.ErrorWithContext(ctx, fmt.Sprintf(
+			"Cron: Failed to roll up seller sales from %s", start.Format(time.DateOnly),
+		), err)
+	}
+}
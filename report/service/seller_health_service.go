@@ -0,0 +1,344 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"ecommerce-be/common/config"
+	"ecommerce-be/common/constants"
+	"ecommerce-be/common/log"
+	"ecommerce-be/common/notifier"
+	reportEntity "ecommerce-be/report/entity"
+	reportError "ecommerce-be/report/error"
+	"ecommerce-be/report/factory"
+	"ecommerce-be/report/model"
+	"ecommerce-be/report/repository"
+	"ecommerce-be/report/util"
+)
+
+// sellerHealthSortColumns whitelists sort_by values for the seller health listing
+var sellerHealthSortColumns = map[string]string{
+	"":              "h.score",
+	"score":         "h.score",
+	"calculated_at": "h.calculated_at",
+	"demoted_at":    "h.demoted_at",
+}
+
+// SellerHealthPolicy configures the seller health aggregation.
+type SellerHealthPolicy struct {
+	// WindowDays is the trailing period the metrics are measured over
+	WindowDays int
+	// ShippingSLAHours is how long an order may stay unshipped after placement
+	ShippingSLAHours int
+	// Scoring holds the warning and demotion thresholds
+	Scoring util.SellerHealthPolicy
+	// RunHourUTC is the hour of day the daily aggregation runs
+	RunHourUTC int
+}
+
+// DefaultSellerHealthPolicy builds the seller health settings from config, with the
+// config defaults when config is not loaded.
+func DefaultSellerHealthPolicy() SellerHealthPolicy {
+	healthConfig := config.SellerHealthConfig{
+		WindowDays:       30,
+		ShippingSLAHours: 48,
+		WarningScore:     70,
+		DemotionScore:    50,
+		MinOrders:        10,
+		RunHourUTC:       2,
+	}
+	if cfg := config.Get(); cfg != nil {
+		healthConfig = cfg.SellerHealth
+	}
+	return SellerHealthPolicy{
+		WindowDays:       healthConfig.WindowDays,
+		ShippingSLAHours: healthConfig.ShippingSLAHours,
+		Scoring: util.SellerHealthPolicy{
+			WarningScore:  healthConfig.WarningScore,
+			DemotionScore: healthConfig.DemotionScore,
+			MinOrders:     healthConfig.MinOrders,
+		},
+		RunHourUTC: healthConfig.RunHourUTC,
+	}
+}
+
+// SellerHealthService scores sellers on cancellations, shipping SLA breaches,
+// disputes and reviews, and serves the scores to admins.
+type SellerHealthService interface {
+	ListSellerHealth(
+		ctx context.Context,
+		filter model.SellerHealthFilter,
+	) (*model.SellerHealthListResponse, error)
+	GetSellerHealthDetail(
+		ctx context.Context,
+		sellerID uint,
+		filter model.SellerHealthTrendFilter,
+	) (*model.SellerHealthDetailResponse, error)
+	// RecalculateHealth rescores every seller with activity in the window or an
+	// earlier score, records the day's snapshot, applies listing demotion and
+	// warns sellers whose health worsened. Runs as a daily cron job.
+	RecalculateHealth()
+}
+
+type sellerHealthService struct {
+	healthRepo repository.SellerHealthRepository
+	builder    *factory.SellerHealthBuilder
+	notifier   notifier.Notifier
+	policy     SellerHealthPolicy
+}
+
+func NewSellerHealthService(
+	healthRepo repository.SellerHealthRepository,
+	builder *factory.SellerHealthBuilder,
+	notifier notifier.Notifier,
+	policy SellerHealthPolicy,
+) SellerHealthService {
+	return &sellerHealthService{
+		healthRepo: healthRepo,
+		builder:    builder,
+		notifier:   notifier,
+		policy:     policy,
+	}
+}
+
+func (s *sellerHealthService) ListSellerHealth(
+	ctx context.Context,
+	filter model.SellerHealthFilter,
+) (*model.SellerHealthListResponse, error) {
+	sortColumn, ok := sellerHealthSortColumns[filter.SortBy]
+	if !ok {
+		return nil, reportError.ErrInvalidReportFilter.WithMessagef(
+			"unsupported sort_by: %s",
+			filter.SortBy,
+		)
+	}
+	if filter.Order != "" && filter.Order != "asc" && filter.Order != "desc" {
+		return nil, reportError.ErrInvalidReportFilter.WithMessagef(
+			"unsupported order: %s",
+			filter.Order,
+		)
+	}
+	limit, offset := normalizeRevenuePage(filter.Limit, filter.Offset)
+
+	rows, total, err := s.healthRepo.ListHealth(ctx, repository.SellerHealthListQuery{
+		Status:         filter.Status,
+		RiskFlag:       filter.RiskFlag,
+		ListingDemoted: filter.ListingDemoted,
+		SortColumn:     sortColumn,
+		SortDesc:       filter.Order == "desc",
+		Limit:          limit,
+		Offset:         offset,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.SellerHealthListResponse{
+		Sellers: s.builder.BuildRows(rows),
+		Total:   total,
+		Limit:   limit,
+		Offset:  offset,
+	}, nil
+}
+
+func (s *sellerHealthService) GetSellerHealthDetail(
+	ctx context.Context,
+	sellerID uint,
+	filter model.SellerHealthTrendFilter,
+) (*model.SellerHealthDetailResponse, error) {
+	days := filter.Days
+	if days <= 0 {
+		days = util.SELLER_HEALTH_DEFAULT_TREND_DAYS
+	}
+	if days > util.SELLER_HEALTH_MAX_TREND_DAYS {
+		days = util.SELLER_HEALTH_MAX_TREND_DAYS
+	}
+
+	health, err := s.healthRepo.FindHealth(ctx, sellerID)
+	if err != nil {
+		return nil, err
+	}
+	if health == nil {
+		return nil, reportError.ErrSellerHealthNotFound
+	}
+
+	since := time.Now().UTC().Truncate(24*time.Hour).AddDate(0, 0, -(days - 1))
+	snapshots, err := s.healthRepo.FindSnapshots(ctx, sellerID, since)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.SellerHealthDetailResponse{
+		SellerHealthRow: s.builder.BuildRow(*health),
+		Trend:           s.builder.BuildTrend(snapshots),
+	}, nil
+}
+
+func (s *sellerHealthService) RecalculateHealth() {
+	ctx := context.Background()
+	now := time.Now().UTC()
+	start := now.AddDate(0, 0, -s.policy.WindowDays)
+
+	metrics, err := s.loadMetrics(ctx, start, now)
+	if err != nil {
+		log.ErrorWithContext(ctx, "Cron: Failed to aggregate seller health metrics", err)
+		return
+	}
+	existing, err := s.healthRepo.FindAllHealth(ctx)
+	if err != nil {
+		log.ErrorWithContext(ctx, "Cron: Failed to load current seller health", err)
+		return
+	}
+	previous := make(map[uint]reportEntity.SellerHealth, len(existing))
+	for _, health := range existing {
+		previous[health.SellerID] = health
+		// Sellers that went quiet are rescored on zero activity
+		if _, ok := metrics[health.SellerID]; !ok {
+			metrics[health.SellerID] = &util.SellerHealthMetrics{}
+		}
+	}
+
+	sellerIDs := make([]uint, 0, len(metrics))
+	for sellerID := range metrics {
+		sellerIDs = append(sellerIDs, sellerID)
+	}
+	sort.Slice(sellerIDs, func(i, j int) bool { return sellerIDs[i] < sellerIDs[j] })
+
+	for _, sellerID := range sellerIDs {
+		prev, hadPrevious := previous[sellerID]
+		var prevHealth *reportEntity.SellerHealth
+		if hadPrevious {
+			prevHealth = &prev
+		}
+		if err := s.rescoreSeller(ctx, sellerID, *metrics[sellerID], prevHealth, now); err != nil {
+			log.ErrorWithContext(ctx, fmt.Sprintf(
+				"Cron: Failed to save health for seller %d", sellerID,
+			), err)
+		}
+	}
+}
+
+// loadMetrics merges the order and dispute aggregates per seller
+func (s *sellerHealthService) loadMetrics(
+	ctx context.Context,
+	start, end time.Time,
+) (map[uint]*util.SellerHealthMetrics, error) {
+	orders, err := s.healthRepo.GetOrderHealth(ctx, start, end, s.policy.ShippingSLAHours)
+	if err != nil {
+		return nil, err
+	}
+	disputes, err := s.healthRepo.GetDisputeHealth(ctx, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	metrics := make(map[uint]*util.SellerHealthMetrics)
+	get := func(sellerID uint) *util.SellerHealthMetrics {
+		m, ok := metrics[sellerID]
+		if !ok {
+			m = &util.SellerHealthMetrics{}
+			metrics[sellerID] = m
+		}
+		return m
+	}
+	for _, agg := range orders {
+		m := get(agg.SellerID)
+		m.OrderCount = agg.OrderCount
+		m.CancelledCount = agg.CancelledCount
+		m.SLATrackedCount = agg.SLATrackedCount
+		m.SLABreachCount = agg.SLABreachCount
+	}
+	for _, agg := range disputes {
+		m := get(agg.SellerID)
+		m.TransactionCount = agg.TransactionCount
+		m.DisputeCount = agg.DisputeCount
+	}
+	// Product reviews do not exist yet, so the review component is always skipped
+	return metrics, nil
+}
+
+func (s *sellerHealthService) rescoreSeller(
+	ctx context.Context,
+	sellerID uint,
+	metrics util.SellerHealthMetrics,
+	prev *reportEntity.SellerHealth,
+	now time.Time,
+) error {
+	result := util.ScoreSellerHealth(metrics, s.policy.Scoring)
+	wasDemoted := prev != nil && prev.ListingDemoted
+	demoted := util.ResolveListingDemotion(wasDemoted, result, s.policy.Scoring)
+
+	health := reportEntity.SellerHealth{
+		SellerID:       sellerID,
+		Score:          result.Score,
+		Status:         result.Status,
+		RiskFlags:      result.Flags,
+		ListingDemoted: demoted,
+		CalculatedAt:   now,
+	}
+	if demoted {
+		// A seller kept demoted until it recovers reports as demoted
+		health.Status = util.SELLER_HEALTH_STATUS_DEMOTED
+		health.DemotedAt = &now
+		if wasDemoted {
+			health.DemotedAt = prev.DemotedAt
+		}
+	}
+
+	if err := s.healthRepo.SaveHealth(ctx, &health); err != nil {
+		return err
+	}
+	err := s.healthRepo.SaveSnapshot(ctx, &reportEntity.SellerHealthSnapshot{
+		SellerID:         sellerID,
+		SnapshotDate:     now.Truncate(24 * time.Hour),
+		WindowDays:       s.policy.WindowDays,
+		OrderCount:       metrics.OrderCount,
+		CancelledCount:   metrics.CancelledCount,
+		SLATrackedCount:  metrics.SLATrackedCount,
+		SLABreachCount:   metrics.SLABreachCount,
+		TransactionCount: metrics.TransactionCount,
+		DisputeCount:     metrics.DisputeCount,
+		ReviewCount:      metrics.ReviewCount,
+		ReviewScore:      metrics.ReviewScore,
+		Score:            health.Score,
+		Status:           health.Status,
+		RiskFlags:        health.RiskFlags,
+	})
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case demoted && !wasDemoted:
+		s.notifySeller(ctx, constants.NOTIFY_EVENT_SELLER_LISTING_DEMOTED, health)
+	case health.Status == util.SELLER_HEALTH_STATUS_AT_RISK &&
+		(prev == nil || prev.Status != util.SELLER_HEALTH_STATUS_AT_RISK):
+		s.notifySeller(ctx, constants.NOTIFY_EVENT_SELLER_HEALTH_WARNING, health)
+	}
+	return nil
+}
+
+func (s *sellerHealthService) notifySeller(
+	ctx context.Context,
+	event string,
+	health reportEntity.SellerHealth,
+) {
+	if s.notifier == nil {
+		return
+	}
+	payload := map[string]any{
+		"SellerID":      health.SellerID,
+		"Score":         health.Score,
+		"RiskFlags":     util.JoinSellerRiskFlags(health.RiskFlags),
+		"WindowDays":    s.policy.WindowDays,
+		"WarningScore":  s.policy.Scoring.WarningScore,
+		"DemotionScore": s.policy.Scoring.DemotionScore,
+	}
+	if err := s.notifier.Notify(ctx, event, health.SellerID, payload); err != nil {
+		log.ErrorWithContext(ctx, fmt.Sprintf(
+			"Cron: Failed to queue seller health notice for seller %d", health.SellerID,
+		), err)
+	}
+}
@@ -0,0 +1,23 @@
+package util
+
+import "math"
+
+// RatePercentage returns part as a percentage of total rounded to two decimals, or 0
+// when total is 0.
+func RatePercentage(part, total int64) float64 {
+	if total == 0 {
+		return 0.0
+	}
+	rate := float64(part) / float64(total) * 100
+	return math.Round(rate*100) / 100
+}
+
+// IsDeclineSpike reports whether a decline rate should raise an alert. Windows with
+// fewer than minAttempts decided payments never alert, so a couple of declines on a
+// quiet gateway are not mistaken for a spike.
+func IsDeclineSpike(attempts, declined int64, thresholdPercent, minAttempts int) bool {
+	if attempts == 0 || attempts < int64(minAttempts) {
+		return false
+	}
+	return float64(declined)*100 > float64(thresholdPercent)*float64(attempts)
+}
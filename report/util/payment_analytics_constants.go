@@ -0,0 +1,13 @@
+package util
+
+const (
+	// PAYMENT_DECLINE_REASONS_LIMIT caps the failure codes listed in payment analytics
+	PAYMENT_DECLINE_REASONS_LIMIT = 20
+
+	PAYMENT_DECLINE_ALERT_JOB_NAME = "payment_decline_alert"
+)
+
+const (
+	FAILED_TO_FETCH_PAYMENT_ANALYTICS_MSG = "Failed to fetch payment analytics"
+	PAYMENT_ANALYTICS_FETCHED_MSG         = "Payment analytics fetched successfully"
+)
@@ -0,0 +1,16 @@
+package util
+
+import "math"
+
+// PercentageChange returns the change from prev to curr in percent, rounded to two
+// decimals. Growth from nothing counts as 100%.
+func PercentageChange(prev, curr int64) float64 {
+	if prev == 0 {
+		if curr == 0 {
+			return 0.0
+		}
+		return 100.0
+	}
+	change := float64(curr-prev) / float64(prev) * 100
+	return math.Round(change*100) / 100
+}
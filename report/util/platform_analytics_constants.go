@@ -0,0 +1,14 @@
+package util
+
+const (
+	// PLATFORM_LEADERBOARD_DEFAULT_LIMIT is the default number of sellers per leaderboard page
+	PLATFORM_LEADERBOARD_DEFAULT_LIMIT = 25
+	// PLATFORM_LEADERBOARD_MAX_LIMIT caps sellers per leaderboard page
+	PLATFORM_LEADERBOARD_MAX_LIMIT = 200
+)
+
+const (
+	PLATFORM_ANALYTICS_FETCHED_MSG          = "Platform analytics fetched successfully"
+	FAILED_TO_FETCH_PLATFORM_ANALYTICS_MSG  = "Failed to fetch platform analytics"
+	FAILED_TO_EXPORT_PLATFORM_ANALYTICS_MSG = "Failed to export platform analytics"
+)
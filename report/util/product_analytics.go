@@ -0,0 +1,55 @@
+package util
+
+import (
+	"fmt"
+	"strings"
+)
+
+// NormalizeSearchQuery lowercases a query and collapses its whitespace so the same
+// search typed differently is counted once, cut to SEARCH_QUERY_MAX_LENGTH characters
+func NormalizeSearchQuery(query string) string {
+	normalized := strings.Join(strings.Fields(strings.ToLower(query)), " ")
+	if runes := []rune(normalized); len(runes) > SEARCH_QUERY_MAX_LENGTH {
+		normalized = strings.TrimSpace(string(runes[:SEARCH_QUERY_MAX_LENGTH]))
+	}
+	return normalized
+}
+
+// ValidateAnalyticsEvent checks that an event carries what its type is counted by:
+// product events a product, searches a query and result count, and search clicks
+// a product, query and 1-based position. query must already be normalized.
+func ValidateAnalyticsEvent(
+	eventType string,
+	productID *uint,
+	query string,
+	resultCount *int,
+	position *int,
+) error {
+	needsProduct := false
+	needsQuery := false
+	switch eventType {
+	case ANALYTICS_EVENT_IMPRESSION, ANALYTICS_EVENT_VIEW, ANALYTICS_EVENT_ADD_TO_CART:
+		needsProduct = true
+	case ANALYTICS_EVENT_SEARCH:
+		needsQuery = true
+		if resultCount == nil || *resultCount < 0 {
+			return fmt.Errorf("search events need a resultCount of 0 or more")
+		}
+	case ANALYTICS_EVENT_SEARCH_CLICK:
+		needsProduct = true
+		needsQuery = true
+		if position == nil || *position < 1 {
+			return fmt.Errorf("search_click events need a position of 1 or more")
+		}
+	default:
+		return fmt.Errorf("unknown event type %q", eventType)
+	}
+
+	if needsProduct && (productID == nil || *productID == 0) {
+		return fmt.Errorf("%s events need a productId", eventType)
+	}
+	if needsQuery && query == "" {
+		return fmt.Errorf("%s events need a query", eventType)
+	}
+	return nil
+}
@@ -0,0 +1,38 @@
+package util
+
+import "time"
+
+// Storefront analytics event types
+const (
+	ANALYTICS_EVENT_IMPRESSION   = "impression"
+	ANALYTICS_EVENT_VIEW         = "view"
+	ANALYTICS_EVENT_ADD_TO_CART  = "add_to_cart"
+	ANALYTICS_EVENT_SEARCH       = "search"
+	ANALYTICS_EVENT_SEARCH_CLICK = "search_click"
+)
+
+const (
+	PRODUCT_ANALYTICS_ROLLUP_JOB_NAME = "product_analytics_rollup"
+	// PRODUCT_ANALYTICS_ROLLUP_INTERVAL is how often today and yesterday are rolled up
+	PRODUCT_ANALYTICS_ROLLUP_INTERVAL = time.Hour
+	// ANALYTICS_EVENT_RETENTION_DAYS keeps raw events this long after they are rolled up
+	ANALYTICS_EVENT_RETENTION_DAYS = 7
+
+	// SEARCH_QUERY_MAX_LENGTH is the length, in characters, queries are cut to
+	SEARCH_QUERY_MAX_LENGTH = 200
+
+	PRODUCT_ANALYTICS_DEFAULT_LIMIT = 20
+	PRODUCT_ANALYTICS_MAX_LIMIT     = 100
+)
+
+const (
+	ANALYTICS_EVENTS_RECORDED_MSG         = "Analytics events recorded successfully"
+	FAILED_TO_RECORD_ANALYTICS_MSG        = "Failed to record analytics events"
+	PRODUCT_ANALYTICS_FETCHED_MSG         = "Product analytics fetched successfully"
+	FAILED_TO_FETCH_PRODUCT_ANALYTICS_MSG = "Failed to fetch product analytics"
+	SEARCH_ANALYTICS_FETCHED_MSG          = "Search analytics fetched successfully"
+	FAILED_TO_FETCH_SEARCH_ANALYTICS_MSG  = "Failed to fetch search analytics"
+
+	INVALID_ANALYTICS_EVENT_CODE = "INVALID_ANALYTICS_EVENT"
+	INVALID_ANALYTICS_EVENT_MSG  = "Invalid analytics event"
+)
@@ -0,0 +1,25 @@
+package util
+
+const (
+	// REVENUE_DEFAULT_PAGE_SIZE is the default number of rows for paginated revenue reports
+	REVENUE_DEFAULT_PAGE_SIZE = 50
+	// REVENUE_MAX_PAGE_SIZE caps rows per page for paginated revenue reports
+	REVENUE_MAX_PAGE_SIZE = 500
+	// REVENUE_MAX_EXPORT_ROWS caps the rows written to a single CSV export
+	REVENUE_MAX_EXPORT_ROWS = 10000
+
+	REVENUE_GROUP_BY_SELLER = "seller"
+	REVENUE_GROUP_BY_PERIOD = "period"
+)
+
+const (
+	INVALID_REPORT_FILTER_CODE   = "INVALID_REPORT_FILTER"
+	REPORT_SELLER_NOT_FOUND_CODE = "REPORT_SELLER_NOT_FOUND"
+
+	INVALID_REPORT_FILTER_MSG   = "Invalid report filter"
+	REPORT_SELLER_NOT_FOUND_MSG = "Seller not found"
+
+	FAILED_TO_FETCH_REVENUE_REPORT_MSG  = "Failed to fetch revenue report"
+	FAILED_TO_EXPORT_REVENUE_REPORT_MSG = "Failed to export revenue report"
+	REVENUE_REPORT_FETCHED_MSG          = "Revenue report fetched successfully"
+)
@@ -0,0 +1,58 @@
+package util
+
+import (
+	"fmt"
+	"time"
+)
+
+// ResolveSalesGranularity validates the bucket size of a sales series. Rollups are
+// daily, so the smallest bucket is a day; without one, DefaultInterval picks it.
+func ResolveSalesGranularity(requested string, start, end time.Time) (string, error) {
+	granularity, err := ResolveInterval(requested, start, end)
+	if err != nil {
+		return "", err
+	}
+	if granularity == "hour" {
+		if requested != "" {
+			return "", fmt.Errorf("interval must be day or longer for sales rollups")
+		}
+		granularity = "day"
+	}
+	return granularity, nil
+}
+
+// SalesBucketStart returns the first day of the bucket containing day, matching
+// Postgres DATE_TRUNC: weeks start on Monday, quarters in January, April, July
+// and October.
+func SalesBucketStart(day time.Time, granularity string) time.Time {
+	day = time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, time.UTC)
+	switch granularity {
+	case "week":
+		daysSinceMonday := (int(day.Weekday()) + 6) % 7
+		return day.AddDate(0, 0, -daysSinceMonday)
+	case "month":
+		return time.Date(day.Year(), day.Month(), 1, 0, 0, 0, 0, time.UTC)
+	case "quarter":
+		return time.Date(day.Year(), getStartOfQuarter(day.Month()), 1, 0, 0, 0, 0, time.UTC)
+	case "year":
+		return time.Date(day.Year(), time.January, 1, 0, 0, 0, 0, time.UTC)
+	default:
+		return day
+	}
+}
+
+// NextSalesBucket returns the first day of the bucket after the one starting at start
+func NextSalesBucket(start time.Time, granularity string) time.Time {
+	switch granularity {
+	case "week":
+		return start.AddDate(0, 0, 7)
+	case "month":
+		return start.AddDate(0, 1, 0)
+	case "quarter":
+		return start.AddDate(0, 3, 0)
+	case "year":
+		return start.AddDate(1, 0, 0)
+	default:
+		return start.AddDate(0, 0, 1)
+	}
+}
@@ -0,0 +1,36 @@
+package util
+
+import "time"
+
+const (
+	SALES_ROLLUP_JOB_NAME          = "seller_sales_rollup"
+	SALES_ROLLUP_BACKFILL_JOB_NAME = "seller_sales_rollup_backfill"
+	// SALES_ROLLUP_INTERVAL is how often the most recent days are rolled up
+	SALES_ROLLUP_INTERVAL = time.Hour
+	// SALES_ROLLUP_RECENT_DAYS are rewritten by the hourly rollup, today included
+	SALES_ROLLUP_RECENT_DAYS = 2
+	// SALES_ROLLUP_BACKFILL_DAYS are rewritten nightly, picking up orders cancelled or
+	// returned after the day they were placed
+	SALES_ROLLUP_BACKFILL_DAYS = 35
+	// SALES_ROLLUP_BACKFILL_HOUR_UTC is the hour of day the nightly rollup runs
+	SALES_ROLLUP_BACKFILL_HOUR_UTC = 3
+
+	// SALES_DASHBOARD_MAX_DAYS caps the period one dashboard request may cover
+	SALES_DASHBOARD_MAX_DAYS = 731
+
+	SALES_DASHBOARD_DEFAULT_LIMIT = 10
+	SALES_DASHBOARD_MAX_LIMIT     = 50
+)
+
+const (
+	SALES_DASHBOARD_FETCHED_MSG         = "Sales dashboard fetched successfully"
+	FAILED_TO_FETCH_SALES_DASHBOARD_MSG = "Failed to fetch sales dashboard"
+)
+
+// Conversion funnel stages, in funnel order
+const (
+	FUNNEL_STAGE_IMPRESSIONS  = "impressions"
+	FUNNEL_STAGE_VIEWS        = "product_views"
+	FUNNEL_STAGE_ADD_TO_CARTS = "add_to_carts"
+	FUNNEL_STAGE_ORDERS       = "orders"
+)
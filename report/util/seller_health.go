@@ -0,0 +1,154 @@
+package util
+
+import (
+	"math"
+	"strings"
+)
+
+// SellerHealthMetrics are a seller's raw counts over the scoring window.
+// ReviewScore is the average rating (1-5), nil when the seller has no reviews.
+type SellerHealthMetrics struct {
+	OrderCount       int64
+	CancelledCount   int64
+	SLATrackedCount  int64
+	SLABreachCount   int64
+	TransactionCount int64
+	DisputeCount     int64
+	ReviewCount      int64
+	ReviewScore      *float64
+}
+
+// SellerHealthPolicy holds the score thresholds that trigger warnings and demotion.
+type SellerHealthPolicy struct {
+	WarningScore  int
+	DemotionScore int
+	MinOrders     int
+}
+
+// SellerHealthResult is a seller's score (0-100), derived status and risk flags.
+type SellerHealthResult struct {
+	Score  int
+	Status string
+	Flags  []string
+}
+
+// sellerHealthComponent scores one metric: a rate at or below good scores 100, at
+// or above bad scores 0, linear in between. Weights are relative.
+type sellerHealthComponent struct {
+	weight float64
+	good   float64
+	bad    float64
+	flagAt float64
+	flag   string
+}
+
+var (
+	cancellationComponent = sellerHealthComponent{
+		weight: 30, good: 0, bad: 20, flagAt: 5, flag: SELLER_RISK_FLAG_HIGH_CANCELLATION_RATE,
+	}
+	slaComponent = sellerHealthComponent{
+		weight: 30, good: 0, bad: 25, flagAt: 10, flag: SELLER_RISK_FLAG_SLA_BREACHES,
+	}
+	disputeComponent = sellerHealthComponent{
+		weight: 25, good: 0, bad: 2, flagAt: 1, flag: SELLER_RISK_FLAG_HIGH_DISPUTE_RATE,
+	}
+)
+
+const (
+	reviewWeight    = 15
+	reviewFlagBelow = 3.5
+)
+
+// ScoreSellerHealth combines cancellation rate, shipping SLA breach rate, dispute
+// rate and review score into a 0-100 score. Metrics without data (no shipped
+// orders, no payments, no reviews) are left out and the remaining weights are
+// rescaled. Sellers below the policy's minimum order count get a score but the
+// insufficient_data status, so no warning or demotion follows from it.
+func ScoreSellerHealth(m SellerHealthMetrics, p SellerHealthPolicy) SellerHealthResult {
+	var weighted, totalWeight float64
+	flags := []string{}
+
+	addRate := func(c sellerHealthComponent, part, total int64) {
+		if total == 0 {
+			return
+		}
+		rate := float64(part) / float64(total) * 100
+		weighted += c.weight * linearScore(rate, c.good, c.bad)
+		totalWeight += c.weight
+		if rate >= c.flagAt {
+			flags = append(flags, c.flag)
+		}
+	}
+	addRate(cancellationComponent, m.CancelledCount, m.OrderCount)
+	addRate(slaComponent, m.SLABreachCount, m.SLATrackedCount)
+	addRate(disputeComponent, m.DisputeCount, m.TransactionCount)
+
+	if m.ReviewScore != nil && m.ReviewCount > 0 {
+		// 1 star scores 0, 5 stars score 100
+		weighted += reviewWeight * linearScore(5-*m.ReviewScore, 0, 4)
+		totalWeight += reviewWeight
+		if *m.ReviewScore < reviewFlagBelow {
+			flags = append(flags, SELLER_RISK_FLAG_LOW_REVIEW_SCORE)
+		}
+	}
+
+	score := 100
+	if totalWeight > 0 {
+		score = int(math.Round(weighted / totalWeight))
+	}
+
+	status := SELLER_HEALTH_STATUS_HEALTHY
+	switch {
+	case m.OrderCount < int64(p.MinOrders):
+		status = SELLER_HEALTH_STATUS_INSUFFICIENT_DATA
+	case score < p.DemotionScore:
+		status = SELLER_HEALTH_STATUS_DEMOTED
+	case score < p.WarningScore:
+		status = SELLER_HEALTH_STATUS_AT_RISK
+	}
+
+	return SellerHealthResult{Score: score, Status: status, Flags: flags}
+}
+
+// ResolveListingDemotion decides whether a seller's listings stay demoted. A
+// demoted seller is only restored once the score climbs back to the warning
+// threshold, so a score hovering around the demotion line does not flap.
+// Without enough orders to judge, the demotion is lifted.
+func ResolveListingDemotion(
+	currentlyDemoted bool,
+	result SellerHealthResult,
+	p SellerHealthPolicy,
+) bool {
+	switch {
+	case result.Status == SELLER_HEALTH_STATUS_INSUFFICIENT_DATA:
+		return false
+	case result.Status == SELLER_HEALTH_STATUS_DEMOTED:
+		return true
+	default:
+		return currentlyDemoted && result.Score < p.WarningScore
+	}
+}
+
+// JoinSellerRiskFlags renders risk flags for notification text, e.g.
+// "sla breaches, high dispute rate"
+func JoinSellerRiskFlags(flags []string) string {
+	if len(flags) == 0 {
+		return "none"
+	}
+	readable := make([]string, len(flags))
+	for i, flag := range flags {
+		readable[i] = strings.ReplaceAll(flag, "_", " ")
+	}
+	return strings.Join(readable, ", ")
+}
+
+// linearScore maps value to 100 at good and 0 at bad, clamped to [0, 100]
+func linearScore(value, good, bad float64) float64 {
+	if value <= good {
+		return 100
+	}
+	if value >= bad {
+		return 0
+	}
+	return (bad - value) / (bad - good) * 100
+}
@@ -0,0 +1,34 @@
+package util
+
+const (
+	SELLER_HEALTH_JOB_NAME = "seller_health_aggregation"
+
+	// SELLER_HEALTH_DEFAULT_TREND_DAYS is the trend history returned by default
+	SELLER_HEALTH_DEFAULT_TREND_DAYS = 90
+	// SELLER_HEALTH_MAX_TREND_DAYS caps the trend history returned per request
+	SELLER_HEALTH_MAX_TREND_DAYS = 365
+)
+
+// Seller health statuses, from best to worst
+const (
+	SELLER_HEALTH_STATUS_HEALTHY           = "healthy"
+	SELLER_HEALTH_STATUS_AT_RISK           = "at_risk"
+	SELLER_HEALTH_STATUS_DEMOTED           = "demoted"
+	SELLER_HEALTH_STATUS_INSUFFICIENT_DATA = "insufficient_data"
+)
+
+// Seller health risk flags, raised per metric independently of the overall score
+const (
+	SELLER_RISK_FLAG_HIGH_CANCELLATION_RATE = "high_cancellation_rate"
+	SELLER_RISK_FLAG_SLA_BREACHES           = "sla_breaches"
+	SELLER_RISK_FLAG_HIGH_DISPUTE_RATE      = "high_dispute_rate"
+	SELLER_RISK_FLAG_LOW_REVIEW_SCORE       = "low_review_score"
+)
+
+const (
+	SELLER_HEALTH_NOT_FOUND_CODE = "SELLER_HEALTH_NOT_FOUND"
+	SELLER_HEALTH_NOT_FOUND_MSG  = "Seller health has not been calculated for this seller"
+
+	FAILED_TO_FETCH_SELLER_HEALTH_MSG = "Failed to fetch seller health"
+	SELLER_HEALTH_FETCHED_MSG         = "Seller health fetched successfully"
+)
@@ -129,6 +129,37 @@ func calculateCurrentPeriod(filter ReportQueryFilter, now time.Time) (time.Time,
 	return currStart, currEnd, nil
 }
 
+// DefaultInterval picks a chart granularity that keeps the number of buckets
+// readable for the given range.
+func DefaultInterval(start, end time.Time) string {
+	hours := end.Sub(start).Hours()
+	switch {
+	case hours <= 24:
+		return "hour"
+	case hours <= 31*24:
+		return "day"
+	case hours <= 90*24:
+		return "week"
+	case hours <= 365*24:
+		return "month"
+	default:
+		return "quarter"
+	}
+}
+
+// ResolveInterval validates a requested interval, falling back to DefaultInterval
+// when none is given. The result is safe to embed in DATE_TRUNC.
+func ResolveInterval(requested string, start, end time.Time) (string, error) {
+	switch requested {
+	case "":
+		return DefaultInterval(start, end), nil
+	case "hour", "day", "week", "month", "quarter", "year":
+		return requested, nil
+	default:
+		return "", fmt.Errorf("unsupported interval: %s", requested)
+	}
+}
+
 // LocationToPostgresTZ returns a Postgres-compatible timezone spec for the
 // location of the given time. IANA names (e.g. "Asia/Kolkata") are returned
 // as-is; anonymous/local zones fall back to a fixed offset like "+05:30"
@@ -0,0 +1,58 @@
+package auth_test
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"ecommerce-be/common/auth"
+	"ecommerce-be/common/constants"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDelegatedScopeAllows_ReadScopeGrantsOnlyReads(t *testing.T) {
+	scopes := []string{constants.DELEGATED_SCOPE_CATALOG_READ}
+
+	assert.True(t, auth.DelegatedScopeAllows(scopes, http.MethodGet, "/api/product/12"))
+	assert.True(t, auth.DelegatedScopeAllows(scopes, http.MethodGet, "/api/product"))
+	assert.False(t, auth.DelegatedScopeAllows(scopes, http.MethodPost, "/api/product"))
+	assert.False(t, auth.DelegatedScopeAllows(scopes, http.MethodDelete, "/api/product/12"))
+}
+
+func TestDelegatedScopeAllows_RejectsOtherModules(t *testing.T) {
+	scopes := []string{constants.DELEGATED_SCOPE_CATALOG_WRITE}
+
+	assert.False(t, auth.DelegatedScopeAllows(scopes, http.MethodGet, "/api/order"))
+	assert.False(t, auth.DelegatedScopeAllows(scopes, http.MethodGet, "/api/productivity"))
+	assert.False(
+		t,
+		auth.DelegatedScopeAllows(scopes, http.MethodPost, "/api/user/seller/delegated-tokens"),
+	)
+}
+
+func TestDelegatedScopeAllows_UnknownScopeGrantsNothing(t *testing.T) {
+	assert.False(t, auth.DelegatedScopeAllows([]string{"admin:*"}, http.MethodGet, "/api/product"))
+	assert.False(t, auth.DelegatedScopeAllows(nil, http.MethodGet, "/api/product"))
+}
+
+func TestIsValidDelegatedScope(t *testing.T) {
+	assert.True(t, auth.IsValidDelegatedScope(constants.DELEGATED_SCOPE_ORDERS_READ))
+	assert.False(t, auth.IsValidDelegatedScope("orders:write"))
+}
+
+func TestGenerateDelegatedToken_HashMatchesAndPrefixIsShort(t *testing.T) {
+	token, hash, prefix, err := auth.GenerateDelegatedToken()
+	require.NoError(t, err)
+
+	assert.True(t, auth.IsDelegatedToken(token))
+	assert.Equal(t, auth.HashDelegatedToken(token), hash)
+	assert.Len(t, hash, 64)
+	assert.True(t, strings.HasPrefix(token, prefix))
+	assert.Less(t, len(prefix), len(token))
+
+	other, _, _, err := auth.GenerateDelegatedToken()
+	require.NoError(t, err)
+	assert.NotEqual(t, token, other)
+}
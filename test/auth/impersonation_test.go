@@ -0,0 +1,56 @@
+package auth_test
+
+import (
+	"testing"
+	"time"
+
+	"ecommerce-be/common/auth"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImpersonationAllows_BlocksAccountSecurityEndpoints(t *testing.T) {
+	assert.False(t, auth.ImpersonationAllows("/api/user/auth/refresh"))
+	assert.False(t, auth.ImpersonationAllows("/api/user/auth/email-change"))
+	assert.False(t, auth.ImpersonationAllows("/api/user/password"))
+	assert.False(t, auth.ImpersonationAllows("/api/user/seller/delegated-tokens/4"))
+}
+
+func TestImpersonationAllows_PermitsSellerOperations(t *testing.T) {
+	assert.True(t, auth.ImpersonationAllows("/api/product/12"))
+	assert.True(t, auth.ImpersonationAllows("/api/user/seller/profile"))
+	assert.True(t, auth.ImpersonationAllows("/api/user/impersonation/end"))
+	assert.True(t, auth.ImpersonationAllows("/api/user/authors"))
+}
+
+func TestGenerateImpersonationToken_CarriesSessionAndExpiry(t *testing.T) {
+	sellerID := uint(2)
+	expiresAt := time.Now().Add(30 * time.Minute).Truncate(time.Second)
+
+	token, err := auth.GenerateImpersonationToken(
+		auth.TokenUserInfo{
+			UserID:    sellerID,
+			Email:     "seller@example.com",
+			RoleID:    2,
+			RoleName:  "SELLER",
+			RoleLevel: 2,
+			SellerID:  &sellerID,
+		},
+		7,
+		1,
+		expiresAt,
+		"test-secret",
+	)
+	require.NoError(t, err)
+
+	claims, err := auth.ParseToken(token, "test-secret")
+	require.NoError(t, err)
+	require.NotNil(t, claims.ImpersonationID)
+	require.NotNil(t, claims.ImpersonatorID)
+	assert.Equal(t, uint(7), *claims.ImpersonationID)
+	assert.Equal(t, uint(1), *claims.ImpersonatorID)
+	assert.Equal(t, sellerID, *claims.UserID)
+	assert.Equal(t, sellerID, *claims.SellerID)
+	assert.True(t, claims.ExpiresAt.Time.Equal(expiresAt))
+}
@@ -0,0 +1,22 @@
+package auth_test
+
+import (
+	"testing"
+
+	"ecommerce-be/common/auth"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeHost_MatchesStoredDomainForm(t *testing.T) {
+	assert.Equal(t, "shop.acme.com", auth.NormalizeHost("shop.acme.com"))
+	assert.Equal(t, "shop.acme.com", auth.NormalizeHost("Shop.ACME.com"))
+	assert.Equal(t, "shop.acme.com", auth.NormalizeHost("shop.acme.com:8443"))
+	assert.Equal(t, "shop.acme.com", auth.NormalizeHost("shop.acme.com."))
+	assert.Equal(t, "shop.acme.com", auth.NormalizeHost(" SHOP.acme.com.:443 "))
+}
+
+func TestNormalizeHost_EmptyHost(t *testing.T) {
+	assert.Equal(t, "", auth.NormalizeHost(""))
+	assert.Equal(t, "", auth.NormalizeHost("   "))
+}
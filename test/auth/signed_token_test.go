@@ -0,0 +1,71 @@
+package auth_test
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"ecommerce-be/common/auth"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenSigner_RoundTrip(t *testing.T) {
+	signer := auth.NewTokenSigner("secret", "order-access")
+
+	token, err := signer.Sign("v1:42:guest@example.com")
+	require.NoError(t, err)
+
+	payload, ok := signer.Verify(token)
+	assert.True(t, ok)
+	assert.Equal(t, "v1:42:guest@example.com", payload)
+}
+
+func TestTokenSigner_PurposeIsPartOfTheMAC(t *testing.T) {
+	token, err := auth.NewTokenSigner("secret", "unsubscribe").Sign("v1:42:marketing")
+	require.NoError(t, err)
+
+	_, ok := auth.NewTokenSigner("secret", "order-access").Verify(token)
+	assert.False(t, ok, "same secret, other purpose")
+}
+
+func TestTokenSigner_RejectsTamperedToken(t *testing.T) {
+	signer := auth.NewTokenSigner("secret", "order-access")
+	token, err := signer.Sign("v1:42")
+	require.NoError(t, err)
+	other, err := signer.Sign("v1:43")
+	require.NoError(t, err)
+
+	payload, mac, _ := strings.Cut(token, ".")
+	otherPayload, _, _ := strings.Cut(other, ".")
+	for _, tampered := range []string{
+		otherPayload + "." + mac,
+		payload + "." + mac[:len(mac)-2],
+		payload,
+		"",
+	} {
+		_, ok := signer.Verify(tampered)
+		assert.False(t, ok, tampered)
+	}
+
+	_, ok := auth.NewTokenSigner("other", "order-access").Verify(token)
+	assert.False(t, ok, "other secret")
+}
+
+func TestTokenSigner_EmptySecret(t *testing.T) {
+	empty := auth.NewTokenSigner("", "order-access")
+
+	_, err := empty.Sign("v1:42")
+	assert.ErrorIs(t, err, auth.ErrEmptySigningSecret)
+
+	// A token MACed with an empty key, as the signer would have, must not verify either
+	h := hmac.New(sha256.New, nil)
+	h.Write([]byte("order-access\x00v1:42"))
+	token := base64.RawURLEncoding.EncodeToString([]byte("v1:42")) + "." +
+		base64.RawURLEncoding.EncodeToString(h.Sum(nil))
+	_, ok := empty.Verify(token)
+	assert.False(t, ok)
+}
@@ -0,0 +1,233 @@
+package cache_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"ecommerce-be/common/cache"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type cachedProduct struct {
+	ID   uint   `json:"id"`
+	Name string `json:"name"`
+}
+
+func statsOf(t *testing.T, namespace string) cache.NamespaceStats {
+	t.Helper()
+	stats := cache.Stats(namespace)
+	require.Len(t, stats, 1)
+	return stats[0]
+}
+
+func TestGetOrLoad_WithoutRedisLoadsFromSourceAndCountsMiss(t *testing.T) {
+	calls := 0
+	load := func(context.Context) (*cachedProduct, error) {
+		calls++
+		return &cachedProduct{ID: 7, Name: "Mug"}, nil
+	}
+
+	for range 2 {
+		product, err := cache.GetOrLoad(
+			context.Background(), "test:no_redis", "test:no_redis:v0:7", time.Minute, load,
+		)
+		require.NoError(t, err)
+		assert.Equal(t, "Mug", product.Name)
+	}
+
+	assert.Equal(t, 2, calls)
+	stats := statsOf(t, "test:no_redis")
+	assert.Equal(t, int64(0), stats.Hits)
+	assert.Equal(t, int64(2), stats.Misses)
+	assert.Zero(t, stats.HitRatio)
+}
+
+func TestGetOrLoad_ReturnsLoadError(t *testing.T) {
+	loadErr := errors.New("not found")
+	load := func(context.Context) (*cachedProduct, error) { return nil, loadErr }
+	_, err := cache.GetOrLoad(context.Background(), "test:load_error", "k", time.Minute, load)
+	assert.ErrorIs(t, err, loadErr)
+}
+
+func TestGetOrLoad_CancelledCallerDoesNotCancelSharedLoad(t *testing.T) {
+	release := make(chan struct{})
+	loadErr := make(chan error, 1)
+	load := func(ctx context.Context) (*cachedProduct, error) {
+		<-release
+		loadErr <- ctx.Err()
+		return &cachedProduct{ID: 5, Name: "Chair"}, nil
+	}
+
+	first, cancel := context.WithCancel(context.Background())
+	firstErr := make(chan error, 1)
+	go func() {
+		_, err := cache.GetOrLoad(first, "test:cancel", "test:cancel:5", time.Minute, load)
+		firstErr <- err
+	}()
+	time.Sleep(20 * time.Millisecond)
+
+	secondResult := make(chan *cachedProduct, 1)
+	go func() {
+		product, err := cache.GetOrLoad(
+			context.Background(), "test:cancel", "test:cancel:5", time.Minute, load,
+		)
+		assert.NoError(t, err)
+		secondResult <- product
+	}()
+	time.Sleep(20 * time.Millisecond)
+
+	cancel()
+	assert.ErrorIs(t, <-firstErr, context.Canceled)
+
+	close(release)
+	assert.NoError(t, <-loadErr)
+	product := <-secondResult
+	require.NotNil(t, product)
+	assert.Equal(t, "Chair", product.Name)
+}
+
+func TestVersionedKey_DefaultsToVersionZero(t *testing.T) {
+	assert.Equal(t, "product:detail:v0:42", cache.VersionedKey("product:detail", 42))
+	assert.Equal(
+		t,
+		"product:category_tree:v0:parent:root:admin",
+		cache.VersionedKey("product:category_tree", "parent", "root", "admin"),
+	)
+	assert.Error(t, cache.BumpNamespaceVersion("product:detail"))
+}
+
+func TestStats_FiltersByPrefixAndSorts(t *testing.T) {
+	ctx := context.Background()
+	load := func(context.Context) (int, error) { return 1, nil }
+	_, _ = cache.GetOrLoad(ctx, "stats:b", "b", time.Minute, load)
+	_, _ = cache.GetOrLoad(ctx, "stats:a", "a", time.Minute, load)
+	_, _ = cache.GetOrLoad(ctx, "other:c", "c", time.Minute, load)
+
+	stats := cache.Stats("stats:")
+	require.Len(t, stats, 2)
+	assert.Equal(t, "stats:a", stats[0].Namespace)
+	assert.Equal(t, "stats:b", stats[1].Namespace)
+}
+
+func TestGetOrLoad_CollapsesConcurrentLoadsOfSameKey(t *testing.T) {
+	var calls atomic.Int32
+	release := make(chan struct{})
+	load := func(context.Context) (*cachedProduct, error) {
+		calls.Add(1)
+		<-release
+		return &cachedProduct{ID: 9, Name: "Lamp"}, nil
+	}
+
+	const callers = 10
+	var started, done sync.WaitGroup
+	results := make([]*cachedProduct, callers)
+	for i := range callers {
+		started.Add(1)
+		done.Add(1)
+		go func() {
+			defer done.Done()
+			started.Done()
+			product, err := cache.GetOrLoad(
+				context.Background(), "test:singleflight", "test:singleflight:9", time.Minute, load,
+			)
+			assert.NoError(t, err)
+			results[i] = product
+		}()
+	}
+	started.Wait()
+	time.Sleep(20 * time.Millisecond)
+	close(release)
+	done.Wait()
+
+	assert.Equal(t, int32(1), calls.Load())
+	for _, product := range results {
+		require.NotNil(t, product)
+		assert.Equal(t, "Lamp", product.Name)
+	}
+	// Each caller gets its own copy
+	results[0].Name = "changed"
+	assert.Equal(t, "Lamp", results[1].Name)
+}
+
+func TestGetOrLoad_WithLocalServesFromProcess(t *testing.T) {
+	cache.ClearLocal()
+	calls := 0
+	load := func(context.Context) (*cachedProduct, error) {
+		calls++
+		return &cachedProduct{ID: 3, Name: "Desk"}, nil
+	}
+
+	for range 3 {
+		product, err := cache.GetOrLoad(
+			context.Background(), "test:local", "test:local:3", time.Minute, load,
+			cache.WithLocal(time.Minute),
+		)
+		require.NoError(t, err)
+		assert.Equal(t, "Desk", product.Name)
+	}
+
+	assert.Equal(t, 1, calls)
+	stats := statsOf(t, "test:local")
+	assert.Equal(t, int64(2), stats.Hits)
+	assert.Equal(t, int64(2), stats.LocalHits)
+	assert.Equal(t, int64(1), stats.Misses)
+
+	cache.ClearLocal()
+	_, err := cache.GetOrLoad(
+		context.Background(), "test:local", "test:local:3", time.Minute, load,
+		cache.WithLocal(time.Minute),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, 2, calls)
+}
+
+func TestGetOrLoad_ReportHitOnlyForCachedValues(t *testing.T) {
+	cache.ClearLocal()
+	release := make(chan struct{})
+	load := func(context.Context) (*cachedProduct, error) {
+		<-release
+		return &cachedProduct{ID: 4, Name: "Shelf"}, nil
+	}
+	getOrLoad := func(hit *bool) {
+		_, err := cache.GetOrLoad(
+			context.Background(), "test:report_hit", "test:report_hit:4", time.Minute, load,
+			cache.WithLocal(time.Minute), cache.ReportHit(hit),
+		)
+		assert.NoError(t, err)
+	}
+
+	// The loading caller and one joining its load both find nothing cached
+	var loaderHit, joinerHit bool
+	var done sync.WaitGroup
+	for _, hit := range []*bool{&loaderHit, &joinerHit} {
+		done.Add(1)
+		go func() {
+			defer done.Done()
+			getOrLoad(hit)
+		}()
+		time.Sleep(20 * time.Millisecond)
+	}
+	close(release)
+	done.Wait()
+	assert.False(t, loaderHit)
+	assert.False(t, joinerHit)
+
+	cachedHit := false
+	getOrLoad(&cachedHit)
+	assert.True(t, cachedHit)
+}
+
+func TestJitterTTL_StaysWithinTenPercent(t *testing.T) {
+	for range 100 {
+		ttl := cache.JitterTTL(time.Hour)
+		assert.GreaterOrEqual(t, ttl, 54*time.Minute)
+		assert.LessOrEqual(t, ttl, 66*time.Minute)
+	}
+	assert.Equal(t, time.Nanosecond, cache.JitterTTL(time.Nanosecond))
+}
@@ -0,0 +1,83 @@
+package cache_test
+
+import (
+	"testing"
+	"time"
+
+	"ecommerce-be/common/cache"
+	"ecommerce-be/common/config"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRedisClient_PicksClientByMode(t *testing.T) {
+	base := config.RedisConfig{Addr: "localhost:6379", Mode: config.REDIS_MODE_STANDALONE}
+
+	client, err := cache.NewRedisClient(base)
+	require.NoError(t, err)
+	assert.IsType(t, &redis.Client{}, client)
+	client.Close()
+
+	sentinel := base
+	sentinel.Mode = config.REDIS_MODE_SENTINEL
+	sentinel.MasterName = "mymaster"
+	sentinel.Addrs = []string{"s1:26379", "s2:26379"}
+	client, err = cache.NewRedisClient(sentinel)
+	require.NoError(t, err)
+	assert.IsType(t, &redis.Client{}, client)
+	client.Close()
+
+	sentinel.ReadFromReplicas = true
+	client, err = cache.NewRedisClient(sentinel)
+	require.NoError(t, err)
+	assert.IsType(t, &redis.ClusterClient{}, client)
+	client.Close()
+
+	cluster := base
+	cluster.Mode = config.REDIS_MODE_CLUSTER
+	cluster.Addrs = []string{"n1:6379", "n2:6379"}
+	client, err = cache.NewRedisClient(cluster)
+	require.NoError(t, err)
+	assert.IsType(t, &redis.ClusterClient{}, client)
+	client.Close()
+}
+
+func TestNewRedisOptions_MapsPoolAndTopologySettings(t *testing.T) {
+	opts, err := cache.NewRedisOptions(config.RedisConfig{
+		Addr:         "localhost:6379",
+		Mode:         config.REDIS_MODE_SENTINEL,
+		MasterName:   "mymaster",
+		PoolSize:     50,
+		MinIdleConns: 5,
+		ReadTimeout:  2 * time.Second,
+		MaxRetries:   4,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"localhost:6379"}, opts.Addrs)
+	assert.Equal(t, "mymaster", opts.MasterName)
+	assert.Equal(t, 50, opts.PoolSize)
+	assert.Equal(t, 5, opts.MinIdleConns)
+	assert.Equal(t, 2*time.Second, opts.ReadTimeout)
+	assert.Equal(t, 4, opts.MaxRetries)
+	assert.Nil(t, opts.TLSConfig)
+}
+
+func TestNewRedisOptions_TLS(t *testing.T) {
+	opts, err := cache.NewRedisOptions(config.RedisConfig{
+		Addr:          "redis.internal:6380",
+		TLSEnabled:    true,
+		TLSServerName: "redis.internal",
+	})
+	require.NoError(t, err)
+	require.NotNil(t, opts.TLSConfig)
+	assert.Equal(t, "redis.internal", opts.TLSConfig.ServerName)
+
+	_, err = cache.NewRedisOptions(config.RedisConfig{
+		Addr:       "redis.internal:6380",
+		TLSEnabled: true,
+		TLSCAFile:  t.TempDir() + "/missing.pem",
+	})
+	assert.Error(t, err)
+}
@@ -0,0 +1,71 @@
+package calendar_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"ecommerce-be/common/calendar"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var stamp = time.Date(2026, 3, 1, 8, 0, 0, 0, time.UTC)
+
+func deliveryEvent() calendar.Event {
+	ist := time.FixedZone("IST", 5*3600+1800)
+	return calendar.Event{
+		UID:      "order-42-delivery-slot@ecommerce-be",
+		Sequence: 2,
+		Start:    time.Date(2026, 3, 2, 10, 0, 0, 0, ist),
+		End:      time.Date(2026, 3, 2, 12, 0, 0, 0, ist),
+		Summary:  "Delivery of order ORD-1",
+		Location: "1 Main St, Pune, MH 411001",
+	}
+}
+
+func unfold(ics string) string {
+	return strings.ReplaceAll(ics, "\r\n ", "")
+}
+
+func TestRender_WritesRequestInUTC(t *testing.T) {
+	ics := string(calendar.Render(deliveryEvent(), stamp))
+
+	assert.True(t, strings.HasPrefix(ics, "BEGIN:VCALENDAR\r\n"))
+	assert.True(t, strings.HasSuffix(ics, "END:VCALENDAR\r\n"))
+	assert.Contains(t, ics, "METHOD:REQUEST\r\n")
+	assert.Contains(t, ics, "UID:order-42-delivery-slot@ecommerce-be\r\n")
+	assert.Contains(t, ics, "SEQUENCE:2\r\n")
+	assert.Contains(t, ics, "DTSTAMP:20260301T080000Z\r\n")
+	assert.Contains(t, ics, "DTSTART:20260302T043000Z\r\n")
+	assert.Contains(t, ics, "DTEND:20260302T063000Z\r\n")
+	assert.Contains(t, ics, "STATUS:CONFIRMED\r\n")
+	// Commas in TEXT values are escaped
+	assert.Contains(t, ics, `LOCATION:1 Main St\, Pune\, MH 411001`)
+	assert.NotContains(t, ics, "DESCRIPTION:")
+}
+
+func TestRender_CancelledEvent(t *testing.T) {
+	event := deliveryEvent()
+	event.Cancelled = true
+
+	ics := string(calendar.Render(event, stamp))
+	assert.Contains(t, ics, "METHOD:CANCEL\r\n")
+	assert.Contains(t, ics, "STATUS:CANCELLED\r\n")
+	assert.Equal(t, "text/calendar; charset=UTF-8; method=CANCEL", event.ContentType())
+}
+
+func TestRender_FoldsLongLinesWithoutSplittingRunes(t *testing.T) {
+	event := deliveryEvent()
+	event.Description = strings.Repeat("Livraison prévue; ", 10) + "\nMerci"
+
+	ics := string(calendar.Render(event, stamp))
+	for _, line := range strings.Split(ics, "\r\n") {
+		assert.LessOrEqual(t, len(line), 75, line)
+	}
+
+	unfolded := unfold(ics)
+	require.Contains(t, unfolded, `DESCRIPTION:Livraison prévue\; Livraison`)
+	assert.Contains(t, unfolded, `\nMerci`+"\r\n")
+}
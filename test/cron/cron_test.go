@@ -0,0 +1,108 @@
+package cron_test
+
+import (
+	"context"
+	"io"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"ecommerce-be/common/cron"
+	"ecommerce-be/common/log"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestMain installs a silent logger; the default one needs loaded configuration
+func TestMain(m *testing.M) {
+	log.Log = logrus.New()
+	log.Log.SetOutput(io.Discard)
+	os.Exit(m.Run())
+}
+
+func TestRegisterJob_RejectsDuplicateNameAndInvalidSchedule(t *testing.T) {
+	s := cron.NewScheduler(cron.NewLocalCoordinator())
+
+	require.NoError(t, s.RegisterJob("0 0 * * * *", "hourly_job", func() {}))
+	assert.Error(t, s.RegisterJob("0 30 * * * *", "hourly_job", func() {}))
+	assert.Error(t, s.RegisterJob("not a schedule", "broken_job", func() {}))
+}
+
+func TestJobs_ListsScheduleAndNextRunBeforeStart(t *testing.T) {
+	s := cron.NewScheduler(cron.NewLocalCoordinator())
+	require.NoError(t, s.RegisterJob("0 0 3 * * *", "nightly_job", func() {}))
+	require.NoError(t, s.RegisterJob("@every 10m", "interval_job", func() {}))
+
+	jobs, err := s.Jobs(context.Background())
+	require.NoError(t, err)
+	require.Len(t, jobs, 2)
+
+	assert.Equal(t, "interval_job", jobs[0].Name)
+	assert.Equal(t, "nightly_job", jobs[1].Name)
+	assert.Equal(t, "0 0 3 * * *", jobs[1].Schedule)
+	assert.Equal(t, 3, jobs[1].NextRunAt.Local().Hour())
+	assert.True(t, jobs[1].NextRunAt.After(time.Now()))
+	assert.Nil(t, jobs[1].LastRun)
+}
+
+func TestJob_UnknownNameIsNotFound(t *testing.T) {
+	s := cron.NewScheduler(cron.NewLocalCoordinator())
+
+	_, err := s.Job(context.Background(), "missing_job")
+	assert.ErrorIs(t, err, cron.ErrJobNotFound)
+}
+
+func TestSharedCoordinator_RunsEachOccurrenceOnce(t *testing.T) {
+	coordinator := cron.NewLocalCoordinator()
+
+	var mu sync.Mutex
+	runs := map[int64]int{}
+	record := func() {
+		mu.Lock()
+		defer mu.Unlock()
+		runs[time.Now().Unix()]++
+	}
+
+	// Two instances scheduling the same job share its lock
+	first := cron.NewScheduler(coordinator)
+	second := cron.NewScheduler(coordinator)
+	require.NoError(t, first.RegisterJob("@every 1s", "shared_job", record))
+	require.NoError(t, second.RegisterJob("@every 1s", "shared_job", record))
+	first.Start()
+	second.Start()
+
+	time.Sleep(2500 * time.Millisecond)
+	first.Stop()
+	second.Stop()
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.NotEmpty(t, runs)
+	for at, count := range runs {
+		assert.Equal(t, 1, count, "occurrence at %d ran more than once", at)
+	}
+
+	status, err := first.Job(context.Background(), "shared_job")
+	require.NoError(t, err)
+	require.NotNil(t, status.LastRun)
+	assert.Equal(t, cron.RunResultSucceeded, status.LastRun.Result)
+}
+
+func TestPanickingJob_IsRecordedAsFailed(t *testing.T) {
+	s := cron.NewScheduler(cron.NewLocalCoordinator())
+	require.NoError(t, s.RegisterJob("@every 1s", "panicking_job", func() {
+		panic("boom")
+	}))
+	s.Start()
+	defer s.Stop()
+
+	assert.Eventually(t, func() bool {
+		status, err := s.Job(context.Background(), "panicking_job")
+		return err == nil && status.LastRun != nil &&
+			status.LastRun.Result == cron.RunResultFailed &&
+			status.LastRun.Error == "boom"
+	}, 3*time.Second, 50*time.Millisecond)
+}
@@ -0,0 +1,150 @@
+package datamigration_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"ecommerce-be/common/constants"
+	"ecommerce-be/common/datamigration"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIDBatchMigration_AdvancesCheckpointAndCompletesOnShortBatch(t *testing.T) {
+	var gotAfter []uint
+	m := datamigration.NewIDBatchMigration("backfill_test", "test",
+		func(_ context.Context, afterID uint, limit int) (uint, int, error) {
+			gotAfter = append(gotAfter, afterID)
+			if afterID == 0 {
+				return 40, limit, nil
+			}
+			return 45, 2, nil
+		})
+
+	first, err := m.RunChunk(context.Background(), "", 10)
+	require.NoError(t, err)
+	assert.Equal(t, datamigration.ChunkResult{Checkpoint: "40", Processed: 10}, first)
+
+	second, err := m.RunChunk(context.Background(), first.Checkpoint, 10)
+	require.NoError(t, err)
+	assert.Equal(t, "45", second.Checkpoint)
+	assert.True(t, second.Done)
+	assert.Equal(t, []uint{0, 40}, gotAfter)
+}
+
+func TestIDBatchMigration_EmptyBatchKeepsCheckpoint(t *testing.T) {
+	m := datamigration.NewIDBatchMigration("backfill_empty", "test",
+		func(_ context.Context, _ uint, _ int) (uint, int, error) {
+			return 0, 0, nil
+		})
+
+	result, err := m.RunChunk(context.Background(), "17", 10)
+	require.NoError(t, err)
+	assert.Equal(t, "17", result.Checkpoint)
+	assert.True(t, result.Done)
+}
+
+func TestIDBatchMigration_RejectsMalformedCheckpoint(t *testing.T) {
+	m := datamigration.NewIDBatchMigration("backfill_bad", "test",
+		func(_ context.Context, _ uint, _ int) (uint, int, error) {
+			return 0, 0, nil
+		})
+
+	_, err := m.RunChunk(context.Background(), "abc", 10)
+	assert.Error(t, err)
+}
+
+func TestResolveBatchSize(t *testing.T) {
+	assert.Equal(t, 50, datamigration.ResolveBatchSize(50, 500))
+	assert.Equal(t, 500, datamigration.ResolveBatchSize(0, 500))
+	assert.Equal(t, constants.DATA_MIGRATION_MAX_BATCH_SIZE, datamigration.ResolveBatchSize(0, 1e6))
+}
+
+func TestResolveChunkDelayMs(t *testing.T) {
+	zero := 0
+	tooLong := constants.DATA_MIGRATION_MAX_CHUNK_DELAY_MS + 1
+
+	assert.Equal(t, 1000, datamigration.ResolveChunkDelayMs(nil, 1000))
+	assert.Equal(t, 0, datamigration.ResolveChunkDelayMs(&zero, 1000))
+	assert.Equal(t,
+		constants.DATA_MIGRATION_MAX_CHUNK_DELAY_MS,
+		datamigration.ResolveChunkDelayMs(&tooLong, 1000),
+	)
+}
+
+func TestRetryDelay_BacksOffExponentiallyWithCap(t *testing.T) {
+	run := &datamigration.DataMigrationRun{ChunkDelayMs: 0, FailureCount: 1}
+	assert.Equal(t, time.Second, datamigration.RetryDelay(run))
+
+	run.FailureCount = 3
+	assert.Equal(t, 4*time.Second, datamigration.RetryDelay(run))
+
+	run.ChunkDelayMs = 5 * 60 * 1000
+	assert.Equal(t, 10*time.Minute, datamigration.RetryDelay(run))
+}
+
+func TestApplyChunkResult_CompletesRunOnDone(t *testing.T) {
+	now := time.Date(2026, 5, 1, 10, 0, 0, 0, time.UTC)
+	lastErr := "boom"
+	run := &datamigration.DataMigrationRun{
+		Status:         datamigration.RUN_STATUS_RUNNING,
+		ProcessedCount: 100,
+		ChunkCount:     1,
+		FailureCount:   2,
+		LastError:      &lastErr,
+		ChainToken:     "token",
+	}
+
+	datamigration.ApplyChunkResult(run, datamigration.ChunkResult{
+		Checkpoint: "150",
+		Processed:  50,
+		Done:       true,
+	}, now)
+
+	assert.Equal(t, "150", run.Checkpoint)
+	assert.Equal(t, int64(150), run.ProcessedCount)
+	assert.Equal(t, int64(2), run.ChunkCount)
+	assert.Zero(t, run.FailureCount)
+	assert.Nil(t, run.LastError)
+	assert.Equal(t, datamigration.RUN_STATUS_COMPLETED, run.Status)
+	assert.Equal(t, &now, run.CompletedAt)
+	assert.Empty(t, run.ChainToken)
+}
+
+func TestApplyChunkFailure_FailsRunAfterRetryBudget(t *testing.T) {
+	now := time.Now().UTC()
+	run := &datamigration.DataMigrationRun{
+		Status:     datamigration.RUN_STATUS_RUNNING,
+		ChainToken: "token",
+	}
+
+	for i := 0; i < constants.DATA_MIGRATION_MAX_CHUNK_RETRIES; i++ {
+		assert.True(t, datamigration.ApplyChunkFailure(run, errors.New("deadlock"), now))
+	}
+	assert.Equal(t, datamigration.RUN_STATUS_RUNNING, run.Status)
+
+	assert.False(t, datamigration.ApplyChunkFailure(run, errors.New("deadlock"), now))
+	assert.Equal(t, datamigration.RUN_STATUS_FAILED, run.Status)
+	assert.Equal(t, "deadlock", *run.LastError)
+	assert.Empty(t, run.ChainToken)
+}
+
+func TestProgressPercent(t *testing.T) {
+	total := int64(200)
+	run := &datamigration.DataMigrationRun{
+		Status:         datamigration.RUN_STATUS_RUNNING,
+		ProcessedCount: 50,
+		TotalEstimate:  &total,
+	}
+	require.NotNil(t, datamigration.ProgressPercent(run))
+	assert.Equal(t, 25.0, *datamigration.ProgressPercent(run))
+
+	run.TotalEstimate = nil
+	assert.Nil(t, datamigration.ProgressPercent(run))
+
+	run.Status = datamigration.RUN_STATUS_COMPLETED
+	assert.Equal(t, 100.0, *datamigration.ProgressPercent(run))
+}
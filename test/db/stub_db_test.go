@@ -0,0 +1,79 @@
+package db_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"sync/atomic"
+	"testing"
+
+	"ecommerce-be/common/db"
+
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// stubDriver is a database/sql driver that only begins, commits and rolls back
+// transactions, so db.WithTransaction can run without a database
+type stubDriver struct{}
+
+// stubTxCounts counts the transactions the stub driver committed and rolled back
+type stubTxCounts struct {
+	commits   atomic.Int32
+	rollbacks atomic.Int32
+}
+
+var stubCounts = &stubTxCounts{}
+
+func init() {
+	sql.Register("db_test_stub", stubDriver{})
+}
+
+func (stubDriver) Open(string) (driver.Conn, error) { return stubConn{}, nil }
+
+type stubConn struct{}
+
+func (stubConn) Prepare(string) (driver.Stmt, error) {
+	return nil, errors.New("stub driver runs no queries")
+}
+
+func (stubConn) Close() error { return nil }
+
+func (stubConn) Begin() (driver.Tx, error) { return stubTx{}, nil }
+
+type stubTx struct{}
+
+func (stubTx) Commit() error {
+	stubCounts.commits.Add(1)
+	return nil
+}
+
+func (stubTx) Rollback() error {
+	stubCounts.rollbacks.Add(1)
+	return nil
+}
+
+// useStubDB points db.GetDB at the stub driver for the test and resets its counts
+func useStubDB(t *testing.T) *stubTxCounts {
+	t.Helper()
+	sqlDB, err := sql.Open("db_test_stub", "")
+	require.NoError(t, err)
+	gormDB, err := gorm.Open(
+		postgres.New(postgres.Config{Conn: sqlDB}),
+		&gorm.Config{Logger: logger.Discard},
+	)
+	require.NoError(t, err)
+
+	previous := db.GetDB()
+	db.SetDB(gormDB)
+	t.Cleanup(func() {
+		db.SetDB(previous)
+		_ = sqlDB.Close()
+	})
+
+	stubCounts.commits.Store(0)
+	stubCounts.rollbacks.Store(0)
+	return stubCounts
+}
@@ -0,0 +1,66 @@
+package db_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"ecommerce-be/common/cache"
+	"ecommerce-be/common/db"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsInTransaction(t *testing.T) {
+	useStubDB(t)
+	assert.False(t, db.IsInTransaction(context.Background()))
+
+	err := db.WithTransaction(context.Background(), func(txCtx context.Context) error {
+		assert.True(t, db.IsInTransaction(txCtx))
+		return nil
+	})
+	require.NoError(t, err)
+}
+
+func TestWithoutTransaction_DetachesFromCallersTransaction(t *testing.T) {
+	counts := useStubDB(t)
+	type traceKey struct{}
+	ctx := context.WithValue(context.Background(), traceKey{}, "req-1")
+
+	var ranBeforeCommit bool
+	err := db.WithTransaction(ctx, func(txCtx context.Context) error {
+		detached := db.WithoutTransaction(txCtx)
+		assert.False(t, db.IsInTransaction(detached))
+		assert.Equal(t, "req-1", detached.Value(traceKey{}), "other values are kept")
+
+		// Hooks on the detached context do not wait for the caller's commit
+		db.AfterCommit(detached, func() { ranBeforeCommit = counts.commits.Load() == 0 })
+		return nil
+	})
+
+	require.NoError(t, err)
+	assert.True(t, ranBeforeCommit)
+}
+
+func TestWithoutTransaction_PlainContextUnchanged(t *testing.T) {
+	ctx := context.Background()
+	assert.Equal(t, ctx, db.WithoutTransaction(ctx))
+}
+
+func TestGetOrLoad_LoadsOutsideCallersTransaction(t *testing.T) {
+	useStubDB(t)
+
+	var loadInTransaction bool
+	err := db.WithTransaction(context.Background(), func(txCtx context.Context) error {
+		_, err := cache.GetOrLoad(txCtx, "test:tx", "test:tx:1", time.Minute,
+			func(loadCtx context.Context) (int, error) {
+				loadInTransaction = db.IsInTransaction(loadCtx)
+				return 1, nil
+			})
+		return err
+	})
+
+	require.NoError(t, err)
+	assert.False(t, loadInTransaction)
+}
@@ -0,0 +1,118 @@
+package db_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"ecommerce-be/common/db"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingTxManager runs units of work without a database and counts them
+type recordingTxManager struct {
+	calls int
+}
+
+func (m *recordingTxManager) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	m.calls++
+	return fn(ctx)
+}
+
+func TestAfterCommit_RunsImmediatelyOutsideTransaction(t *testing.T) {
+	ran := false
+	db.AfterCommit(context.Background(), func() { ran = true })
+	assert.True(t, ran)
+}
+
+func TestAfterCommit_RunsOnlyOnceCommitted(t *testing.T) {
+	counts := useStubDB(t)
+
+	var ran []string
+	err := db.WithTransaction(context.Background(), func(txCtx context.Context) error {
+		db.AfterCommit(txCtx, func() {
+			assert.Equal(t, int32(1), counts.commits.Load(), "hook ran before commit")
+			ran = append(ran, "first")
+		})
+		db.AfterCommit(txCtx, func() { ran = append(ran, "second") })
+		assert.Empty(t, ran, "hooks wait for the commit")
+		return nil
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"first", "second"}, ran)
+}
+
+func TestAfterCommit_SkippedOnRollback(t *testing.T) {
+	counts := useStubDB(t)
+	failure := errors.New("boom")
+
+	ran := false
+	err := db.WithTransaction(context.Background(), func(txCtx context.Context) error {
+		db.AfterCommit(txCtx, func() { ran = true })
+		return failure
+	})
+
+	assert.ErrorIs(t, err, failure)
+	assert.False(t, ran)
+	assert.Equal(t, int32(1), counts.rollbacks.Load())
+	assert.Zero(t, counts.commits.Load())
+}
+
+func TestAfterCommit_NestedHooksWaitForOuterCommit(t *testing.T) {
+	counts := useStubDB(t)
+
+	ran := false
+	err := db.WithTransaction(context.Background(), func(txCtx context.Context) error {
+		err := db.WithTransaction(txCtx, func(innerCtx context.Context) error {
+			db.AfterCommit(innerCtx, func() { ran = true })
+			return nil
+		})
+		assert.False(t, ran, "inner transaction joins the outer one")
+		return err
+	})
+
+	require.NoError(t, err)
+	assert.True(t, ran)
+	assert.Equal(t, int32(1), counts.commits.Load())
+}
+
+func TestTxManager_DoCommitsOrRollsBack(t *testing.T) {
+	counts := useStubDB(t)
+	tm := db.NewTxManager()
+
+	err := tm.Do(context.Background(), func(txCtx context.Context) error {
+		assert.True(t, db.IsInTransaction(txCtx))
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), counts.commits.Load())
+
+	failure := errors.New("boom")
+	err = tm.Do(context.Background(), func(context.Context) error { return failure })
+	assert.ErrorIs(t, err, failure)
+	assert.Equal(t, int32(1), counts.rollbacks.Load())
+}
+
+func TestDoWithResult_ReturnsResultThroughManager(t *testing.T) {
+	tm := &recordingTxManager{}
+
+	result, err := db.DoWithResult(context.Background(), tm, func(ctx context.Context) (int, error) {
+		return 42, nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 42, result)
+	assert.Equal(t, 1, tm.calls)
+}
+
+func TestDoWithResult_PropagatesError(t *testing.T) {
+	tm := &recordingTxManager{}
+	failure := errors.New("boom")
+
+	_, err := db.DoWithResult(context.Background(), tm, func(ctx context.Context) (string, error) {
+		return "", failure
+	})
+	assert.ErrorIs(t, err, failure)
+}
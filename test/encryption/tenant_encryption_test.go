@@ -0,0 +1,188 @@
+package encryption_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"ecommerce-be/common/encryption"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const masterKey = "0123456789abcdef0123456789abcdef"
+
+// fakeKeyRepository keeps keys in memory
+type fakeKeyRepository struct {
+	keys []*encryption.TenantDataKey
+}
+
+func (r *fakeKeyRepository) Create(_ context.Context, key *encryption.TenantDataKey) error {
+	key.ID = uint(len(r.keys) + 1)
+	r.keys = append(r.keys, key)
+	return nil
+}
+
+func (r *fakeKeyRepository) Save(context.Context, *encryption.TenantDataKey) error {
+	return nil
+}
+
+func (r *fakeKeyRepository) FindActive(
+	_ context.Context,
+	sellerID uint,
+) (*encryption.TenantDataKey, error) {
+	for _, key := range r.keys {
+		if key.SellerID == sellerID && key.Status == encryption.KEY_STATUS_ACTIVE {
+			return key, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *fakeKeyRepository) FindActiveForUpdate(
+	ctx context.Context,
+	sellerID uint,
+) (*encryption.TenantDataKey, error) {
+	return r.FindActive(ctx, sellerID)
+}
+
+func (r *fakeKeyRepository) FindByVersion(
+	_ context.Context,
+	sellerID uint,
+	version int,
+) (*encryption.TenantDataKey, error) {
+	for _, key := range r.keys {
+		if key.SellerID == sellerID && key.Version == version {
+			return key, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *fakeKeyRepository) FindAllBySellerID(
+	_ context.Context,
+	sellerID uint,
+) ([]encryption.TenantDataKey, error) {
+	var keys []encryption.TenantDataKey
+	for _, key := range r.keys {
+		if key.SellerID == sellerID {
+			keys = append(keys, *key)
+		}
+	}
+	return keys, nil
+}
+
+func (r *fakeKeyRepository) MaxVersion(_ context.Context, sellerID uint) (int, error) {
+	max := 0
+	for _, key := range r.keys {
+		if key.SellerID == sellerID && key.Version > max {
+			max = key.Version
+		}
+	}
+	return max, nil
+}
+
+// retire marks the seller's active key retired, as RotateKey does before provisioning
+func (r *fakeKeyRepository) retire(sellerID uint) {
+	for _, key := range r.keys {
+		if key.SellerID == sellerID {
+			key.Status = encryption.KEY_STATUS_RETIRED
+		}
+	}
+}
+
+func TestTenantEncryption_RoundTrip(t *testing.T) {
+	ctx := context.Background()
+	repo := &fakeKeyRepository{}
+	service := encryption.NewService(repo, masterKey)
+	require.NoError(t, service.ProvisionKey(ctx, 7))
+
+	ciphertext, err := service.Encrypt(ctx, 7, "GB29 NWBK 6016 1331 9268 19")
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(ciphertext, "tdk:v1:"))
+	assert.NotContains(t, ciphertext, "NWBK")
+
+	plaintext, err := service.Decrypt(ctx, 7, ciphertext)
+	require.NoError(t, err)
+	assert.Equal(t, "GB29 NWBK 6016 1331 9268 19", plaintext)
+}
+
+func TestTenantEncryption_ProvisionIsIdempotent(t *testing.T) {
+	ctx := context.Background()
+	repo := &fakeKeyRepository{}
+	service := encryption.NewService(repo, masterKey)
+
+	require.NoError(t, service.ProvisionKey(ctx, 7))
+	require.NoError(t, service.ProvisionKey(ctx, 7))
+
+	assert.Len(t, repo.keys, 1)
+}
+
+func TestTenantEncryption_OtherSellerCannotDecrypt(t *testing.T) {
+	ctx := context.Background()
+	repo := &fakeKeyRepository{}
+	service := encryption.NewService(repo, masterKey)
+	require.NoError(t, service.ProvisionKey(ctx, 7))
+	require.NoError(t, service.ProvisionKey(ctx, 8))
+
+	ciphertext, err := service.Encrypt(ctx, 7, "secret")
+	require.NoError(t, err)
+
+	_, err = service.Decrypt(ctx, 8, ciphertext)
+	assert.ErrorIs(t, err, encryption.ErrDecryptionFailed)
+}
+
+func TestTenantEncryption_RequiresProvisionedKey(t *testing.T) {
+	service := encryption.NewService(&fakeKeyRepository{}, masterKey)
+
+	_, err := service.Encrypt(context.Background(), 7, "secret")
+	assert.ErrorIs(t, err, encryption.ErrKeyNotFound)
+}
+
+func TestTenantEncryption_RejectsMalformedCiphertext(t *testing.T) {
+	ctx := context.Background()
+	repo := &fakeKeyRepository{}
+	service := encryption.NewService(repo, masterKey)
+	require.NoError(t, service.ProvisionKey(ctx, 7))
+
+	for _, value := range []string{"plain", "tdk:x1:AAAA", "tdk:v0:AAAA", "tdk:v1:%%%"} {
+		_, err := service.Decrypt(ctx, 7, value)
+		assert.ErrorIs(t, err, encryption.ErrInvalidCiphertext, value)
+	}
+}
+
+func TestTenantEncryption_RetiredKeyStillDecryptsAndReencryptMovesToActive(t *testing.T) {
+	ctx := context.Background()
+	repo := &fakeKeyRepository{}
+	service := encryption.NewService(repo, masterKey)
+	require.NoError(t, service.ProvisionKey(ctx, 7))
+
+	old, err := service.Encrypt(ctx, 7, "secret")
+	require.NoError(t, err)
+
+	repo.retire(7)
+	require.NoError(t, service.ProvisionKey(ctx, 7))
+
+	plaintext, err := service.Decrypt(ctx, 7, old)
+	require.NoError(t, err)
+	assert.Equal(t, "secret", plaintext)
+
+	moved, err := service.Reencrypt(ctx, 7, old)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(moved, "tdk:v2:"))
+
+	unchanged, err := service.Reencrypt(ctx, 7, moved)
+	require.NoError(t, err)
+	assert.Equal(t, moved, unchanged)
+}
+
+func TestTenantEncryption_WrongMasterKeyCannotUnwrap(t *testing.T) {
+	ctx := context.Background()
+	repo := &fakeKeyRepository{}
+	require.NoError(t, encryption.NewService(repo, masterKey).ProvisionKey(ctx, 7))
+
+	other := encryption.NewService(repo, "fedcba9876543210fedcba9876543210")
+	_, err := other.Encrypt(ctx, 7, "secret")
+	assert.ErrorIs(t, err, encryption.ErrKeyUnwrapFailed)
+}
@@ -0,0 +1,118 @@
+package featureflag_test
+
+import (
+	"testing"
+
+	"ecommerce-be/common/db"
+	"ecommerce-be/common/featureflag"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEvaluate_MissingFlagIsOff(t *testing.T) {
+	enabled, reason := featureflag.Evaluate(nil, featureflag.Subject{SellerID: 1})
+
+	assert.False(t, enabled)
+	assert.Equal(t, featureflag.REASON_NOT_FOUND, reason)
+}
+
+func TestEvaluate_DisabledFlagIsOffEvenForTargets(t *testing.T) {
+	flag := &featureflag.FeatureFlag{
+		Key:            "checkout.new_flow",
+		RolloutPercent: 100,
+		SellerIDs:      db.Int64Array{7},
+	}
+
+	enabled, reason := featureflag.Evaluate(flag, featureflag.Subject{SellerID: 7})
+
+	assert.False(t, enabled)
+	assert.Equal(t, featureflag.REASON_DISABLED, reason)
+}
+
+func TestEvaluate_TargetedSellerAndUserAreOn(t *testing.T) {
+	flag := &featureflag.FeatureFlag{
+		Key:       "checkout.new_flow",
+		Enabled:   true,
+		SellerIDs: db.Int64Array{7},
+		UserIDs:   db.Int64Array{42},
+	}
+
+	enabled, reason := featureflag.Evaluate(flag, featureflag.Subject{SellerID: 7, UserID: 1})
+	assert.True(t, enabled)
+	assert.Equal(t, featureflag.REASON_TARGETED, reason)
+
+	enabled, reason = featureflag.Evaluate(flag, featureflag.Subject{UserID: 42})
+	assert.True(t, enabled)
+	assert.Equal(t, featureflag.REASON_TARGETED, reason)
+
+	enabled, reason = featureflag.Evaluate(flag, featureflag.Subject{SellerID: 8, UserID: 43})
+	assert.False(t, enabled)
+	assert.Equal(t, featureflag.REASON_OUT_OF_ROLLOUT, reason)
+}
+
+func TestEvaluate_FullAndZeroRollout(t *testing.T) {
+	flag := &featureflag.FeatureFlag{Key: "search.new_backend", Enabled: true, RolloutPercent: 100}
+
+	enabled, reason := featureflag.Evaluate(flag, featureflag.Subject{})
+	assert.True(t, enabled)
+	assert.Equal(t, featureflag.REASON_ROLLOUT, reason)
+
+	flag.RolloutPercent = 0
+	enabled, reason = featureflag.Evaluate(flag, featureflag.Subject{SellerID: 3})
+	assert.False(t, enabled)
+	assert.Equal(t, featureflag.REASON_OUT_OF_ROLLOUT, reason)
+}
+
+func TestEvaluate_PartialRolloutExcludesAnonymousSubjects(t *testing.T) {
+	flag := &featureflag.FeatureFlag{Key: "search.new_backend", Enabled: true, RolloutPercent: 99}
+
+	enabled, _ := featureflag.Evaluate(flag, featureflag.Subject{})
+
+	assert.False(t, enabled)
+}
+
+func TestEvaluate_PartialRolloutBucketsBySeller(t *testing.T) {
+	flag := &featureflag.FeatureFlag{Key: "search.new_backend", Enabled: true}
+	bucket := featureflag.Bucket(flag.Key, "seller:12")
+
+	flag.RolloutPercent = bucket + 1
+	enabled, reason := featureflag.Evaluate(flag, featureflag.Subject{SellerID: 12, UserID: 5})
+	assert.True(t, enabled)
+	assert.Equal(t, featureflag.REASON_ROLLOUT, reason)
+
+	flag.RolloutPercent = bucket
+	enabled, _ = featureflag.Evaluate(flag, featureflag.Subject{SellerID: 12, UserID: 5})
+	assert.False(t, enabled)
+}
+
+func TestBucket_IsStableAndInRange(t *testing.T) {
+	first := featureflag.Bucket("search.new_backend", "seller:12")
+
+	assert.Equal(t, first, featureflag.Bucket("search.new_backend", "seller:12"))
+	assert.GreaterOrEqual(t, first, 0)
+	assert.Less(t, first, 100)
+}
+
+func TestEvaluate_RaisingRolloutOnlyAddsSellers(t *testing.T) {
+	flag := &featureflag.FeatureFlag{Key: "search.new_backend", Enabled: true}
+	enabledAt := func(percent int) map[uint]bool {
+		flag.RolloutPercent = percent
+		enabled := map[uint]bool{}
+		for sellerID := uint(1); sellerID <= 500; sellerID++ {
+			if on, _ := featureflag.Evaluate(flag, featureflag.Subject{SellerID: sellerID}); on {
+				enabled[sellerID] = true
+			}
+		}
+		return enabled
+	}
+
+	previous := enabledAt(10)
+	assert.InDelta(t, 50, len(previous), 25)
+	for _, percent := range []int{25, 50, 75} {
+		current := enabledAt(percent)
+		for sellerID := range previous {
+			assert.True(t, current[sellerID], "seller %d dropped at %d%%", sellerID, percent)
+		}
+		previous = current
+	}
+}
@@ -0,0 +1,34 @@
+package filegateway_test
+
+import (
+	"testing"
+
+	fileUtils "ecommerce-be/file/utils"
+)
+
+func TestBuildCDNURLJoinsAndEscapesSegments(t *testing.T) {
+	cases := []struct {
+		base, key, want string
+	}{
+		{
+			base: "https://cdn.example.com",
+			key:  "sellers/7/USER_AVATAR/abc.png",
+			want: "https://cdn.example.com/sellers/7/USER_AVATAR/abc.png",
+		},
+		{
+			base: "https://cdn.example.com/media/",
+			key:  "/platform/logo one.png",
+			want: "https://cdn.example.com/media/platform/logo%20one.png",
+		},
+		{
+			base: "https://cdn.example.com",
+			key:  "legacy/a?b#c.jpg",
+			want: "https://cdn.example.com/legacy/a%3Fb%23c.jpg",
+		},
+	}
+	for _, tc := range cases {
+		if got := fileUtils.BuildCDNURL(tc.base, tc.key); got != tc.want {
+			t.Fatalf("BuildCDNURL(%q, %q) = %q, want %q", tc.base, tc.key, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,89 @@
+package filegateway_test
+
+import (
+	"image"
+	"image/color"
+	"testing"
+
+	fileUtils "ecommerce-be/file/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseVariantCode(t *testing.T) {
+	spec, ok := fileUtils.ParseVariantCode("thumb_200")
+	require.True(t, ok)
+	assert.Equal(t, "thumb", spec.Kind)
+	assert.Equal(t, 200, spec.MaxDimension)
+
+	spec, ok = fileUtils.ParseVariantCode("webp_1600")
+	require.True(t, ok)
+	assert.Equal(t, "webp", spec.Kind)
+	assert.False(t, fileUtils.VariantKindSupported(spec.Kind))
+
+	for _, code := range []string{"poster", "thumb_", "thumb_abc", "thumb_0", "thumb_-5"} {
+		_, ok := fileUtils.ParseVariantCode(code)
+		assert.False(t, ok, code)
+	}
+}
+
+func TestFitWithin_KeepsAspectRatioAndNeverUpscales(t *testing.T) {
+	cases := []struct {
+		width, height, maxDimension int
+		wantWidth, wantHeight       int
+	}{
+		{4000, 3000, 200, 200, 150},
+		{3000, 4000, 600, 450, 600},
+		{1000, 1000, 600, 600, 600},
+		{150, 100, 200, 150, 100},
+		{5000, 10, 200, 200, 1},
+	}
+	for _, tc := range cases {
+		width, height := fileUtils.FitWithin(tc.width, tc.height, tc.maxDimension)
+		assert.Equal(t, tc.wantWidth, width)
+		assert.Equal(t, tc.wantHeight, height)
+	}
+}
+
+func TestResizeImage_AveragesSourcePixels(t *testing.T) {
+	// Left half black, right half white: halving the width yields one pixel of each
+	src := image.NewNRGBA(image.Rect(0, 0, 4, 2))
+	for y := 0; y < 2; y++ {
+		for x := 0; x < 4; x++ {
+			if x >= 2 {
+				src.Set(x, y, color.White)
+			} else {
+				src.Set(x, y, color.Black)
+			}
+		}
+	}
+
+	dst := fileUtils.ResizeImage(src, 2, 1)
+	assert.Equal(t, image.Rect(0, 0, 2, 1), dst.Bounds())
+	assert.Equal(t, color.NRGBA{A: 255}, dst.NRGBAAt(0, 0))
+	assert.Equal(t, color.NRGBA{R: 255, G: 255, B: 255, A: 255}, dst.NRGBAAt(1, 0))
+
+	// Averaging the two halves gives mid grey
+	grey := fileUtils.ResizeImage(src, 1, 1).NRGBAAt(0, 0)
+	assert.InDelta(t, 127, int(grey.R), 1)
+	assert.Equal(t, uint8(255), grey.A)
+}
+
+func TestResizeImage_TransparentPixelsDoNotDarkenEdges(t *testing.T) {
+	src := image.NewNRGBA(image.Rect(0, 0, 2, 1))
+	src.Set(0, 0, color.NRGBA{R: 255, A: 255})
+	src.Set(1, 0, color.NRGBA{})
+
+	pixel := fileUtils.ResizeImage(src, 1, 1).NRGBAAt(0, 0)
+	assert.Equal(t, uint8(255), pixel.R)
+	assert.InDelta(t, 127, int(pixel.A), 1)
+}
+
+func TestBuildVariantObjectKey(t *testing.T) {
+	assert.Equal(
+		t,
+		"seller/7/PRODUCT_IMAGE/2026/10/variants/thumb_200/0190-shoe.jpg",
+		fileUtils.BuildVariantObjectKey("seller/7/PRODUCT_IMAGE/2026/10/0190-shoe.png", "thumb_200", "jpg"),
+	)
+}
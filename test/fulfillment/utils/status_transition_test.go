@@ -0,0 +1,71 @@
+package utils_test
+
+import (
+	"testing"
+
+	"ecommerce-be/fulfillment/entity"
+	"ecommerce-be/fulfillment/utils"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsValidTransition(t *testing.T) {
+	tests := []struct {
+		from, to entity.ShipmentStatus
+		valid    bool
+	}{
+		{entity.SHIPMENT_STATUS_PENDING, entity.SHIPMENT_STATUS_IN_TRANSIT, true},
+		{entity.SHIPMENT_STATUS_FAILED, entity.SHIPMENT_STATUS_RETURNED, true},
+		{entity.SHIPMENT_STATUS_OUT_FOR_DELIVERY, entity.SHIPMENT_STATUS_IN_TRANSIT, true},
+		{entity.SHIPMENT_STATUS_IN_TRANSIT, entity.SHIPMENT_STATUS_PICKED, false},
+		{entity.SHIPMENT_STATUS_DELIVERED, entity.SHIPMENT_STATUS_IN_TRANSIT, false},
+		{entity.SHIPMENT_STATUS_RETURNED, entity.SHIPMENT_STATUS_DELIVERED, false},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(
+			t,
+			tt.valid,
+			utils.IsValidTransition(tt.from, tt.to),
+			"%s -> %s",
+			tt.from,
+			tt.to,
+		)
+	}
+}
+
+func TestResolveNextStatus(t *testing.T) {
+	t.Run("advances through chronological checkpoints", func(t *testing.T) {
+		status, idx := utils.ResolveNextStatus(
+			entity.SHIPMENT_STATUS_PENDING,
+			[]entity.ShipmentStatus{
+				entity.SHIPMENT_STATUS_PICKED,
+				entity.SHIPMENT_STATUS_IN_TRANSIT,
+				entity.SHIPMENT_STATUS_DELIVERED,
+			},
+		)
+		assert.Equal(t, entity.SHIPMENT_STATUS_DELIVERED, status)
+		assert.Equal(t, 2, idx)
+	})
+
+	t.Run("ignores stale checkpoints that would move backwards", func(t *testing.T) {
+		status, idx := utils.ResolveNextStatus(
+			entity.SHIPMENT_STATUS_IN_TRANSIT,
+			[]entity.ShipmentStatus{entity.SHIPMENT_STATUS_PICKED, entity.SHIPMENT_STATUS_PENDING},
+		)
+		assert.Equal(t, entity.SHIPMENT_STATUS_IN_TRANSIT, status)
+		assert.Equal(t, -1, idx)
+	})
+
+	t.Run("stops at terminal status", func(t *testing.T) {
+		status, idx := utils.ResolveNextStatus(
+			entity.SHIPMENT_STATUS_OUT_FOR_DELIVERY,
+			[]entity.ShipmentStatus{
+				entity.SHIPMENT_STATUS_DELIVERED,
+				entity.SHIPMENT_STATUS_IN_TRANSIT,
+			},
+		)
+		assert.Equal(t, entity.SHIPMENT_STATUS_DELIVERED, status)
+		assert.Equal(t, 0, idx)
+	})
+}
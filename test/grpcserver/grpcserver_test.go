@@ -0,0 +1,135 @@
+package grpcserver_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"testing"
+
+	"ecommerce-be/common/constants"
+	commonError "ecommerce-be/common/error"
+	"ecommerce-be/common/grpcserver"
+	"ecommerce-be/common/log"
+	"ecommerce-be/common/reqctx"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// TestMain installs a silent logger; the default one needs loaded configuration
+func TestMain(m *testing.M) {
+	log.Log = logrus.New()
+	log.Log.SetOutput(io.Discard)
+	os.Exit(m.Run())
+}
+
+var testInfo = &grpc.UnaryServerInfo{FullMethod: "/ecommerce.test.v1.TestService/Call"}
+
+func incoming(pairs ...string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(pairs...))
+}
+
+func TestToStatusError_MapsAppErrorStatus(t *testing.T) {
+	cases := []struct {
+		httpStatus int
+		want       codes.Code
+	}{
+		{http.StatusBadRequest, codes.InvalidArgument},
+		{http.StatusUnauthorized, codes.Unauthenticated},
+		{http.StatusForbidden, codes.PermissionDenied},
+		{http.StatusNotFound, codes.NotFound},
+		{http.StatusConflict, codes.AlreadyExists},
+		{http.StatusTooManyRequests, codes.ResourceExhausted},
+		{http.StatusInternalServerError, codes.Internal},
+	}
+	for _, tc := range cases {
+		err := grpcserver.ToStatusError(commonError.NewAppError("CODE", "message", tc.httpStatus))
+		assert.Equal(t, tc.want, status.Code(err), tc.httpStatus)
+		assert.Equal(t, "message", status.Convert(err).Message())
+	}
+}
+
+func TestToStatusError_HidesUnexpectedErrors(t *testing.T) {
+	err := grpcserver.ToStatusError(errors.New("pq: connection refused"))
+	assert.Equal(t, codes.Internal, status.Code(err))
+	assert.NotContains(t, status.Convert(err).Message(), "pq")
+
+	assert.Equal(t, codes.DeadlineExceeded, status.Code(
+		grpcserver.ToStatusError(context.DeadlineExceeded),
+	))
+	assert.Nil(t, grpcserver.ToStatusError(nil))
+}
+
+func TestErrorInterceptor_ConvertsHandlerErrors(t *testing.T) {
+	interceptor := grpcserver.ErrorInterceptor()
+	failing := func(context.Context, any) (any, error) { return nil, commonError.ErrInvalidID }
+	_, err := interceptor(context.Background(), nil, testInfo, failing)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	succeeding := func(context.Context, any) (any, error) { return "ok", nil }
+	resp, err := interceptor(context.Background(), nil, testInfo, succeeding)
+	require.NoError(t, err)
+	assert.Equal(t, "ok", resp)
+}
+
+func TestCorrelationIDInterceptor(t *testing.T) {
+	interceptor := grpcserver.CorrelationIDInterceptor()
+
+	rejected := func(context.Context, any) (any, error) {
+		t.Fatal("handler must not run without a correlation ID")
+		return nil, nil
+	}
+	_, err := interceptor(context.Background(), nil, testInfo, rejected)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Equal(t, constants.CORRELATION_ID_REQUIRED_MSG, status.Convert(err).Message())
+
+	var seen string
+	ctx := incoming(constants.GRPC_CORRELATION_ID_METADATA, "  req-42 ")
+	_, err = interceptor(ctx, nil, testInfo, func(ctx context.Context, _ any) (any, error) {
+		seen, _ = reqctx.CorrelationID.Get(ctx)
+		return nil, nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "req-42", seen)
+}
+
+func TestRecoveryInterceptor_ConvertsPanics(t *testing.T) {
+	_, err := grpcserver.RecoveryInterceptor()(
+		context.Background(),
+		nil,
+		testInfo,
+		func(context.Context, any) (any, error) { panic("boom") },
+	)
+	assert.Equal(t, codes.Internal, status.Code(err))
+}
+
+func TestAuthInterceptor_RejectsCallsWithoutIdentity(t *testing.T) {
+	interceptor := grpcserver.AuthInterceptor()
+	handler := func(context.Context, any) (any, error) {
+		t.Fatal("handler must not run for unauthenticated calls")
+		return nil, nil
+	}
+
+	_, err := interceptor(incoming(), nil, testInfo, handler)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Equal(t, constants.SELLER_ID_REQUIRED_MSG, status.Convert(err).Message())
+
+	_, err = interceptor(incoming(constants.GRPC_SELLER_ID_METADATA, "0"), nil, testInfo, handler)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Equal(t, constants.SELLER_ID_INVALID_MSG, status.Convert(err).Message())
+
+	_, err = interceptor(
+		incoming(constants.GRPC_AUTHORIZATION_METADATA, "Basic abc"),
+		nil,
+		testInfo,
+		handler,
+	)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
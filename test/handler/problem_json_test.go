@@ -0,0 +1,121 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"ecommerce-be/common"
+	commonError "ecommerce-be/common/error"
+	"ecommerce-be/common/handler"
+	"ecommerce-be/common/reqctx"
+	productError "ecommerce-be/product/error"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// serve registers a single GET route plus the error catalog and returns the response
+func serve(t *testing.T, path string, route func(c *gin.Context)) *httptest.ResponseRecorder {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.GET("/thing", route)
+	handler.RegisterErrorCatalogRoutes(router)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+	return w
+}
+
+func decodeProblem(t *testing.T, w *httptest.ResponseRecorder) common.ErrorResponse {
+	t.Helper()
+	assert.Equal(t, common.PROBLEM_JSON_CONTENT_TYPE, w.Header().Get("Content-Type"))
+	var problem common.ErrorResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &problem))
+	return problem
+}
+
+func TestHandleError_AppErrorRendersProblemJSON(t *testing.T) {
+	h := handler.NewBaseHandler()
+	w := serve(t, "/thing", func(c *gin.Context) {
+		h.HandleError(c, productError.ErrProductNotFound, "Failed to get product")
+	})
+
+	require.Equal(t, http.StatusNotFound, w.Code)
+	problem := decodeProblem(t, w)
+	assert.Equal(t, "PRODUCT_NOT_FOUND", problem.Code)
+	assert.Equal(t, http.StatusNotFound, problem.Status)
+	assert.Equal(t, commonError.ProblemType("PRODUCT_NOT_FOUND"), problem.Type)
+	assert.Equal(t, "/thing", problem.Instance)
+	assert.Equal(t, problem.Detail, problem.Message)
+	assert.False(t, problem.Success)
+}
+
+func TestErrorResp_WithoutCodeGetsStatusCode(t *testing.T) {
+	w := serve(t, "/thing", func(c *gin.Context) {
+		common.ErrorResp(c, http.StatusConflict, "Already exists")
+	})
+
+	require.Equal(t, http.StatusConflict, w.Code)
+	problem := decodeProblem(t, w)
+	assert.Equal(t, commonError.CONFLICT_CODE, problem.Code)
+	assert.Equal(t, "Conflict", problem.Title)
+	assert.Equal(t, "Already exists", problem.Detail)
+}
+
+func TestErrorCatalog_ListsAndResolvesCodes(t *testing.T) {
+	w := serve(t, "/api/errors/product_not_found", func(c *gin.Context) {})
+	require.Equal(t, http.StatusOK, w.Code)
+	var resp struct {
+		Data commonError.CatalogEntry `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Equal(t, "PRODUCT_NOT_FOUND", resp.Data.Code)
+	assert.Equal(t, http.StatusNotFound, resp.Data.Status)
+
+	w = serve(t, "/api/errors/NO_SUCH_CODE", func(c *gin.Context) {})
+	require.Equal(t, http.StatusNotFound, w.Code)
+	assert.Equal(t, commonError.NOT_FOUND_CODE, decodeProblem(t, w).Code)
+
+	entries := commonError.CatalogEntries()
+	assert.NotEmpty(t, entries)
+	for i := 1; i < len(entries); i++ {
+		assert.Less(t, entries[i-1].Code, entries[i].Code)
+	}
+}
+
+func TestResponses_CarryImpersonationMarker(t *testing.T) {
+	impersonate := func(c *gin.Context) {
+		reqctx.Impersonation.Set(c, reqctx.ImpersonationInfo{
+			SessionID:   7,
+			AdminUserID: 1,
+			AdminEmail:  "admin@example.com",
+		})
+	}
+
+	w := serve(t, "/thing", func(c *gin.Context) {
+		impersonate(c)
+		common.SuccessResponse(c, http.StatusOK, "ok", nil)
+	})
+	var response common.Response
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	require.NotNil(t, response.ImpersonatedBy)
+	assert.Equal(t, "admin@example.com", response.ImpersonatedBy.AdminEmail)
+	assert.Equal(t, uint(7), response.ImpersonatedBy.SessionID)
+
+	w = serve(t, "/thing", func(c *gin.Context) {
+		impersonate(c)
+		common.ErrorWithCode(c, http.StatusForbidden, "no", "FORBIDDEN")
+	})
+	problem := decodeProblem(t, w)
+	require.NotNil(t, problem.ImpersonatedBy)
+	assert.Equal(t, uint(1), problem.ImpersonatedBy.AdminUserID)
+
+	w = serve(t, "/thing", func(c *gin.Context) {
+		common.SuccessResponse(c, http.StatusOK, "ok", nil)
+	})
+	assert.NotContains(t, w.Body.String(), "impersonatedBy")
+}
@@ -0,0 +1,135 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"ecommerce-be/common"
+	"ecommerce-be/common/handler"
+	commonValidator "ecommerce-be/common/validator"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type itemRequest struct {
+	VariantID uint `json:"variantId" binding:"required"`
+	Quantity  int  `json:"quantity"  binding:"required,min=1"`
+}
+
+type orderRequest struct {
+	Email  string        `json:"email"  binding:"required,email"`
+	Status string        `json:"status" binding:"omitempty,oneof=pending paid"`
+	Items  []itemRequest `json:"items"  binding:"required,min=1,dive"`
+}
+
+// postOrder binds body into orderRequest and returns the validation errors
+func postOrder(t *testing.T, body string) []common.ValidationError {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+	h := handler.NewBaseHandler()
+	router := gin.New()
+	router.POST("/orders", func(c *gin.Context) {
+		var req orderRequest
+		if err := h.BindJSON(c, &req); err != nil {
+			h.HandleValidationError(c, err)
+			return
+		}
+		c.Status(http.StatusNoContent)
+	})
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/orders", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	var resp struct {
+		Code   string                   `json:"code"`
+		Errors []common.ValidationError `json:"errors"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Equal(t, "VALIDATION_ERROR", resp.Code)
+	return resp.Errors
+}
+
+func TestHandleValidationError_ReportsEveryFieldWithRuleAndParam(t *testing.T) {
+	errs := postOrder(t, `{"email":"nope","status":"shipped","items":[{"variantId":4,"quantity":0}]}`)
+
+	assert.Equal(t, []common.ValidationError{
+		{Field: "email", Rule: "email", Message: "email must be a valid email address"},
+		{
+			Field:   "status",
+			Rule:    "oneof",
+			Message: "status must be one of: pending, paid",
+			Param:   "pending paid",
+		},
+		{
+			Field:   "items[0].quantity",
+			Rule:    "required",
+			Message: "items[0].quantity is required",
+		},
+	}, errs)
+}
+
+func TestHandleValidationError_WrongTypeNamesTheField(t *testing.T) {
+	errs := postOrder(t, `{"email":"a@b.co","items":[{"variantId":4,"quantity":"two"}]}`)
+
+	require.Len(t, errs, 1)
+	assert.Equal(t, "items[0].quantity", errs[0].Field)
+	assert.Equal(t, "type", errs[0].Rule)
+	assert.Equal(t, "int", errs[0].Param)
+	assert.Equal(t, "items[0].quantity must be of type int", errs[0].Message)
+}
+
+func TestHandleValidationError_MalformedBody(t *testing.T) {
+	for _, body := range []string{`{"email":`, ``} {
+		errs := postOrder(t, body)
+
+		require.Len(t, errs, 1, body)
+		assert.Equal(t, "request", errs[0].Field)
+		assert.Equal(t, "json", errs[0].Rule)
+		assert.Equal(t, "Request body must be well-formed JSON", errs[0].Message)
+	}
+}
+
+func TestBuildValidationErrors_UnknownField(t *testing.T) {
+	var req orderRequest
+	decoder := json.NewDecoder(strings.NewReader(`{"email":"a@b.co","coupon":"X"}`))
+	decoder.DisallowUnknownFields()
+	err := decoder.Decode(&req)
+	require.Error(t, err)
+
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+	c.Request = httptest.NewRequest(http.MethodPost, "/orders", nil)
+	errs := handler.BuildValidationErrors(c, err)
+
+	assert.Equal(t, []common.ValidationError{{
+		Field:   "coupon",
+		Rule:    "unknown",
+		Message: "coupon is not a recognized field",
+	}}, errs)
+}
+
+func TestBuildValidationErrors_CrossFieldRule(t *testing.T) {
+	err := &commonValidator.CrossFieldError{
+		Rule:  "ltefield",
+		Field: "minPrice",
+		Param: "maxPrice",
+	}
+
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+	c.Request = httptest.NewRequest(http.MethodGet, "/products", nil)
+	errs := handler.BuildValidationErrors(c, err)
+
+	assert.Equal(t, []common.ValidationError{{
+		Field:   "minPrice",
+		Rule:    "ltefield",
+		Message: "minPrice must be less than or equal to maxPrice",
+		Param:   "maxPrice",
+	}}, errs)
+}
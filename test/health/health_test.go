@@ -0,0 +1,90 @@
+package health_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"ecommerce-be/common/constants"
+	"ecommerce-be/common/health"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func up(context.Context) error { return nil }
+
+func down(context.Context) error { return errors.New("connection refused") }
+
+func TestEvaluate_AllUp(t *testing.T) {
+	report := health.Evaluate(context.Background(), []health.Check{
+		{Name: "postgres", Required: true, Run: up},
+		{Name: "mail", Run: up},
+	}, time.Second)
+
+	assert.Equal(t, constants.HEALTH_STATUS_UP, report.Status)
+	require.Len(t, report.Dependencies, 2)
+	assert.Equal(t, "postgres", report.Dependencies[0].Name)
+	assert.Equal(t, constants.HEALTH_STATUS_UP, report.Dependencies[0].Status)
+	assert.Empty(t, report.Dependencies[0].Error)
+}
+
+func TestEvaluate_OptionalDownIsDegraded(t *testing.T) {
+	report := health.Evaluate(context.Background(), []health.Check{
+		{Name: "postgres", Required: true, Run: up},
+		{Name: "mail", Run: down},
+	}, time.Second)
+
+	assert.Equal(t, constants.HEALTH_STATUS_DEGRADED, report.Status)
+	assert.Equal(t, constants.HEALTH_STATUS_DOWN, report.Dependencies[1].Status)
+	assert.Equal(t, "connection refused", report.Dependencies[1].Error)
+}
+
+func TestEvaluate_RequiredDownIsDown(t *testing.T) {
+	report := health.Evaluate(context.Background(), []health.Check{
+		{Name: "mail", Run: down},
+		{Name: "redis", Required: true, Run: down},
+	}, time.Second)
+
+	assert.Equal(t, constants.HEALTH_STATUS_DOWN, report.Status)
+}
+
+func TestEvaluate_HungCheckTimesOut(t *testing.T) {
+	hung := func(context.Context) error {
+		time.Sleep(time.Second)
+		return nil
+	}
+
+	start := time.Now()
+	report := health.Evaluate(context.Background(), []health.Check{
+		{Name: "postgres", Required: true, Run: hung},
+	}, 20*time.Millisecond)
+
+	assert.Less(t, time.Since(start), 500*time.Millisecond)
+	assert.Equal(t, constants.HEALTH_STATUS_DOWN, report.Status)
+	assert.Equal(t, context.DeadlineExceeded.Error(), report.Dependencies[0].Error)
+}
+
+func TestPendingMigrations_KeepsFileOrder(t *testing.T) {
+	files := []string{"001_a.sql", "002_b.sql", "003_c.sql", "004_d.sql"}
+	applied := []string{"001_a.sql", "003_c.sql"}
+
+	assert.Equal(t, []string{"002_b.sql", "004_d.sql"}, health.PendingMigrations(files, applied))
+	assert.Empty(t, health.PendingMigrations(files, files))
+}
+
+func TestListMigrationFiles_OnlyNumberedSQLFiles(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"002_b.sql", "001_a.sql", "run_migrations.sh", "README.md"} {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), nil, 0o600))
+	}
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "seeds"), 0o700))
+
+	files, err := health.ListMigrationFiles(dir)
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"001_a.sql", "002_b.sql"}, files)
+}
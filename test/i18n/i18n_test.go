@@ -0,0 +1,156 @@
+package i18n_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"ecommerce-be/common"
+	"ecommerce-be/common/i18n"
+	"ecommerce-be/common/middleware"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestCatalog() *i18n.Catalog {
+	return i18n.NewCatalog("en", map[string]map[string]string{
+		"en": {
+			"ORDER_NOT_FOUND":     "Order not found",
+			"ORDER_CREATED_MSG":   "Order placed successfully",
+			"VALIDATION_REQUIRED": "{field} is required",
+		},
+		"es": {
+			"ORDER_NOT_FOUND":     "Pedido no encontrado",
+			"ORDER_CREATED_MSG":   "Pedido realizado correctamente",
+			"VALIDATION_REQUIRED": "{field} es obligatorio",
+		},
+		"pt-BR": {
+			"ORDER_NOT_FOUND": "Pedido não encontrado",
+		},
+	})
+}
+
+func TestNegotiate_PicksHighestQualitySupportedLanguage(t *testing.T) {
+	catalog := newTestCatalog()
+
+	cases := map[string]string{
+		"":                               "en",
+		"es":                             "es",
+		"fr, es;q=0.8, en;q=0.5":         "es",
+		"en;q=0.4, es;q=0.9":             "es",
+		"es-MX":                          "es",
+		"pt-br":                          "pt-br",
+		"PT_BR":                          "pt-br",
+		"de, fr":                         "en",
+		"*":                              "en",
+		"es;q=0, en":                     "en",
+		"es;q=abc, hi":                   "en",
+		"fr;q=0.9, es-AR;q=0.8, *;q=0.1": "es",
+	}
+	for header, want := range cases {
+		assert.Equal(t, want, catalog.Negotiate(header), "Accept-Language %q", header)
+	}
+}
+
+func TestPreferredLanguages_KeepsUnsupportedTagsInQualityOrder(t *testing.T) {
+	assert.Equal(
+		t,
+		[]string{"es-mx", "fr", "*"},
+		i18n.PreferredLanguages("fr;q=0.8, ES_mx, de;q=0, *;q=0.1"),
+	)
+	assert.Empty(t, i18n.PreferredLanguages(""))
+}
+
+func TestLocalize_TranslatesKnownEnglishTextOnly(t *testing.T) {
+	catalog := newTestCatalog()
+
+	assert.Equal(t, "Pedido realizado correctamente",
+		catalog.Localize("es", "Order placed successfully"))
+	assert.Equal(t, "Something ad-hoc", catalog.Localize("es", "Something ad-hoc"))
+	assert.Equal(t, "Order placed successfully", catalog.Localize("en", "Order placed successfully"))
+
+	// Missing translations fall back to the default language.
+	assert.Equal(t, "Order placed successfully",
+		catalog.Localize("pt-br", "Order placed successfully"))
+}
+
+func TestLocalizeError_KeepsCustomizedMessages(t *testing.T) {
+	catalog := newTestCatalog()
+
+	assert.Equal(t, "Pedido no encontrado",
+		catalog.LocalizeError("es", "ORDER_NOT_FOUND", "Order not found"))
+	assert.Equal(t, "Order 42 not found",
+		catalog.LocalizeError("es", "ORDER_NOT_FOUND", "Order 42 not found"))
+	assert.Equal(t, "Pedido realizado correctamente",
+		catalog.LocalizeError("es", "", "Order placed successfully"))
+}
+
+func TestLoadDir_RequiresDefaultLanguageFile(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "es.json"),
+		[]byte(`{"ORDER_NOT_FOUND":"Pedido no encontrado"}`), 0o600))
+
+	_, err := i18n.LoadDir(dir, "en")
+	assert.Error(t, err)
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "en.json"),
+		[]byte(`{"ORDER_NOT_FOUND":"Order not found"}`), 0o600))
+	catalog, err := i18n.LoadDir(dir, "en")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"en", "es"}, catalog.Languages())
+}
+
+func TestLocalesDir_EveryTranslationHasEnglishSource(t *testing.T) {
+	dir := filepath.Join("..", "..", "locales")
+	catalog, err := i18n.LoadDir(dir, "en")
+	require.NoError(t, err)
+
+	english := readLocale(t, filepath.Join(dir, "en.json"))
+	for _, lang := range catalog.Languages() {
+		for code := range readLocale(t, filepath.Join(dir, lang+".json")) {
+			assert.Contains(t, english, code, "%s.json has a code missing from en.json", lang)
+		}
+	}
+}
+
+func TestLanguageMiddleware_LocalizesResponses(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	i18n.SetCatalog(newTestCatalog())
+	t.Cleanup(func() { i18n.SetCatalog(nil) })
+
+	router := gin.New()
+	router.Use(middleware.Language())
+	router.GET("/ok", func(c *gin.Context) {
+		common.SuccessResponse(c, http.StatusOK, "Order placed successfully", nil)
+	})
+	router.GET("/missing", func(c *gin.Context) {
+		common.ErrorWithCode(c, http.StatusNotFound, "Order not found", "ORDER_NOT_FOUND")
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/ok", nil)
+	req.Header.Set("Accept-Language", "es-ES,es;q=0.9")
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	assert.Equal(t, "es", rec.Header().Get("Content-Language"))
+	assert.Contains(t, rec.Body.String(), "Pedido realizado correctamente")
+
+	req = httptest.NewRequest(http.MethodGet, "/missing", nil)
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	assert.Equal(t, "en", rec.Header().Get("Content-Language"))
+	assert.Contains(t, rec.Body.String(), "Order not found")
+}
+
+func readLocale(t *testing.T, path string) map[string]string {
+	t.Helper()
+	raw, err := os.ReadFile(path)
+	require.NoError(t, err)
+	entries := map[string]string{}
+	require.NoError(t, json.Unmarshal(raw, &entries))
+	return entries
+}
@@ -86,7 +86,7 @@ func (s *UploadSuite) SetupSuite() {
 
 	// Scheduler worker loop is global and should only be started once.
 	schedulerOnce.Do(func() {
-		go scheduler.StartRedisWorkerPool()
+		go scheduler.StartRedisWorkerPool(context.Background())
 	})
 
 	client := helpers.NewAPIClient(s.server)
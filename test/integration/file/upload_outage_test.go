@@ -133,7 +133,7 @@ func (s *UploadSuite) restartRedisAfterOutage(ctx context.Context) {
 
 	schedulerOnce = sync.Once{}
 	schedulerOnce.Do(func() {
-		go scheduler.StartRedisWorkerPool()
+		go scheduler.StartRedisWorkerPool(context.Background())
 	})
 }
 
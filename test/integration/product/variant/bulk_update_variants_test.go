@@ -391,6 +391,36 @@ func TestBulkUpdateVariants(t *testing.T) {
 		assert.Equal(t, float64(2), data["updatedCount"])
 	})
 
+	t.Run("Success - Update current default while moving default to another", func(t *testing.T) {
+		sellerToken := helpers.Login(t, client, helpers.SellerEmail, helpers.SellerPassword)
+		client.SetToken(sellerToken)
+
+		productID := uint(5)
+		current := getAndVerifyVariant(t, client, productID, 10) // default since the previous test
+		assert.True(t, current["isDefault"].(bool))
+
+		requestBody := map[string]any{
+			"variants": []map[string]any{
+				{"id": 10, "price": 31.99, "expectedVersion": current["version"]},
+				{"id": 11, "isDefault": true},
+			},
+		}
+
+		url := fmt.Sprintf("/api/product/%d/variant/bulk", productID)
+		w := client.Put(t, url, requestBody)
+
+		response := helpers.AssertSuccessResponse(t, w, http.StatusOK)
+		data, ok := response["data"].(map[string]any)
+		assert.True(t, ok)
+		assert.Equal(t, float64(2), data["updatedCount"])
+
+		variant10 := getAndVerifyVariant(t, client, productID, 10)
+		assert.False(t, variant10["isDefault"].(bool), "Variant 10 should NOT be default")
+		assert.Equal(t, 31.99, variant10["price"])
+		variant11 := getAndVerifyVariant(t, client, productID, 11)
+		assert.True(t, variant11["isDefault"].(bool), "Variant 11 SHOULD be default")
+	})
+
 	// ============================================================================
 	// SUCCESS SCENARIOS - SKU bulk updates
 	// ============================================================================
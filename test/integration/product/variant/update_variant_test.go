@@ -240,6 +240,40 @@ func TestUpdateVariant(t *testing.T) {
 		},
 	)
 
+	t.Run("Success - Re-save current default variant as default", func(t *testing.T) {
+		sellerToken := helpers.Login(t, client, helpers.SellerEmail, helpers.SellerPassword)
+		client.SetToken(sellerToken)
+
+		productID := 6  // Summer Dress
+		variantID := 13 // Default since the previous test
+
+		url := fmt.Sprintf("/api/product/%d/variant/%d", productID, variantID)
+
+		// Saving the default again must not conflict with its own version
+		w1 := client.Put(t, url, map[string]any{"isDefault": true})
+		response1 := helpers.AssertSuccessResponse(t, w1, http.StatusOK)
+		variant1 := helpers.GetResponseData(t, response1, "variant")
+		assert.True(t, variant1["isDefault"].(bool))
+
+		// Nor when the version it returned is sent back
+		w2 := client.Put(t, url, map[string]any{
+			"isDefault":       true,
+			"price":           54.99,
+			"expectedVersion": variant1["version"],
+		})
+		response2 := helpers.AssertSuccessResponse(t, w2, http.StatusOK)
+		variant2 := helpers.GetResponseData(t, response2, "variant")
+		assert.True(t, variant2["isDefault"].(bool))
+		assert.Equal(t, 54.99, variant2["price"])
+		assert.Equal(t, variant1["version"].(float64)+1, variant2["version"])
+
+		// The other variant stays non-default
+		w3 := client.Get(t, fmt.Sprintf("/api/product/%d/variant/12", productID))
+		response3 := helpers.AssertSuccessResponse(t, w3, http.StatusOK)
+		variant12 := helpers.GetResponseData(t, response3, "variant")
+		assert.False(t, variant12["isDefault"].(bool))
+	})
+
 	t.Run("Success - Unset default variant (set to false)", func(t *testing.T) {
 		sellerToken := helpers.Login(t, client, helpers.SellerEmail, helpers.SellerPassword)
 		client.SetToken(sellerToken)
@@ -74,7 +74,9 @@ func (s *ReportSuite) cleanupDomainData() {
 	s.Require().NoError(s.container.DB.Exec(`DELETE FROM order_item`).Error)
 	s.Require().NoError(s.container.DB.Exec(`DELETE FROM "order"`).Error)
 	s.Require().NoError(s.container.DB.Exec(`DELETE FROM inventory_reservation`).Error)
-	s.Require().NoError(s.container.DB.Exec(`DELETE FROM inventory_transaction`).Error)
+	// Movements reject row deletes; TRUNCATE is the only way to reset the history.
+	// CASCADE also clears stock-take lines that link to movements.
+	s.Require().NoError(s.container.DB.Exec(`TRUNCATE inventory_transaction CASCADE`).Error)
 
 	userIDs := []uint{
 		helpers.CustomerUserID,
@@ -11,6 +11,7 @@ import (
 	"ecommerce-be/common/log"
 	"ecommerce-be/common/middleware"
 	"ecommerce-be/file"
+	"ecommerce-be/fulfillment"
 	"ecommerce-be/inventory"
 	"ecommerce-be/notification"
 	"ecommerce-be/order"
@@ -83,12 +84,15 @@ func SetupTestServer(t *testing.T, database *gorm.DB, redisClient *redis.Client)
 	// 8. Initialize Gin Router
 	gin.SetMode(gin.TestMode)
 	router := gin.New()
+	router.ContextWithFallback = true
 	router.Use(gin.Recovery())
 
 	// 9. Apply middleware (same as main.go)
 	router.Use(middleware.CorrelationID())
 	router.Use(middleware.Logger())
 	router.Use(middleware.CORS())
+	router.Use(middleware.RequestTimeout(cfg.Server.RequestTimeout()))
+	router.Use(middleware.BodySizeLimit(cfg.RequestLimits.MaxBodyBytes))
 
 	// 10. Register modules
 	registerContainer(router)
@@ -101,6 +105,7 @@ func registerContainer(router *gin.Engine) {
 	_ = product.NewContainer(router)
 	_ = inventory.NewContainer(router)
 	_ = order.NewContainer(router)
+	_ = fulfillment.NewContainer(router)
 	_ = payment.NewContainer(router)
 	_ = notification.NewContainer(router)
 	_ = promotion.NewContainer(router)
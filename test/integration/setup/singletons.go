@@ -2,8 +2,11 @@ package setup
 
 import (
 	fileSingleton "ecommerce-be/file/factory/singleton"
+	fulfillmentSingleton "ecommerce-be/fulfillment/factory/singleton"
 	inventorySingleton "ecommerce-be/inventory/factory/singleton"
+	notificationSingleton "ecommerce-be/notification/factory/singleton"
 	orderSingleton "ecommerce-be/order/factory/singleton"
+	paymentSingleton "ecommerce-be/payment/factory/singleton"
 	productSingleton "ecommerce-be/product/factory/singleton"
 	promotionSingleton "ecommerce-be/promotion/factory/singleton"
 	reportSingleton "ecommerce-be/report/factory/singleton"
@@ -18,6 +21,9 @@ func ResetAllModuleSingletons() {
 	productSingleton.ResetInstance()
 	inventorySingleton.ResetInstance()
 	orderSingleton.ResetInstance()
+	fulfillmentSingleton.ResetInstance()
+	paymentSingleton.ResetInstance()
+	notificationSingleton.ResetInstance()
 	promotionSingleton.ResetInstance()
 	reportSingleton.ResetInstance()
 	fileSingleton.ResetInstance()
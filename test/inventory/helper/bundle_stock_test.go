@@ -0,0 +1,83 @@
+package helper_test
+
+import (
+	"testing"
+
+	"ecommerce-be/inventory/model"
+	"ecommerce-be/inventory/utils/helper"
+	productEntity "ecommerce-be/product/entity"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// kit 9 = 2 x variant 1 + 1 x variant 2
+var kitComponents = map[uint][]productEntity.VariantBundleComponent{
+	9: {
+		{BundleVariantID: 9, ComponentVariantID: 1, Quantity: 2},
+		{BundleVariantID: 9, ComponentVariantID: 2, Quantity: 1},
+	},
+}
+
+func TestExpandBundleDemand_AddsComponentsToDirectDemand(t *testing.T) {
+	stockIDs, demand := helper.ExpandBundleDemand(
+		[]uint{1, 9, 5},
+		map[uint]int{1: 1, 9: 3, 5: 2},
+		kitComponents,
+	)
+
+	assert.Equal(t, []uint{1, 2, 5}, stockIDs)
+	assert.Equal(t, map[uint]int{1: 7, 2: 3, 5: 2}, demand)
+}
+
+func TestExpandBundleReservationItems_ReservesComponents(t *testing.T) {
+	items := helper.ExpandBundleReservationItems([]model.ReservationItem{
+		{VariantID: 9, ReservedQuantity: 2},
+		{VariantID: 2, ReservedQuantity: 1},
+	}, kitComponents)
+
+	assert.Equal(t, []model.ReservationItem{
+		{VariantID: 1, ReservedQuantity: 4},
+		{VariantID: 2, ReservedQuantity: 3},
+	}, items)
+}
+
+func TestBuildBundleStockCheckResult_ScarcestComponentLimits(t *testing.T) {
+	stock := map[uint]model.StockCheckResult{
+		1: {
+			VariantID: 1, AvailableQuantity: 9, ReservableQuantity: 8, InStock: true,
+			Locations: []model.StockCheckLocation{
+				{LocationID: 10, LocationName: "Main", AvailableQuantity: 5},
+				{LocationID: 11, LocationName: "Store", AvailableQuantity: 4},
+			},
+		},
+		2: {
+			VariantID: 2, AvailableQuantity: 6, ReservableQuantity: 6, InStock: true,
+			Locations: []model.StockCheckLocation{
+				{LocationID: 10, LocationName: "Main", AvailableQuantity: 6},
+			},
+		},
+	}
+
+	result := helper.BuildBundleStockCheckResult(9, 3, kitComponents[9], stock)
+
+	assert.Equal(t, 4, result.AvailableQuantity)
+	assert.Equal(t, 4, result.ReservableQuantity)
+	assert.True(t, result.InStock)
+	// Only Main stocks both components
+	assert.Equal(t, []model.StockCheckLocation{
+		{LocationID: 10, LocationName: "Main", AvailableQuantity: 2},
+	}, result.Locations)
+}
+
+func TestBuildBundleStockCheckResult_ComponentShortForCartIsOutOfStock(t *testing.T) {
+	stock := map[uint]model.StockCheckResult{
+		1: {VariantID: 1, AvailableQuantity: 10, ReservableQuantity: 10, InStock: false},
+		2: {VariantID: 2, AvailableQuantity: 10, ReservableQuantity: 10, InStock: true},
+	}
+
+	result := helper.BuildBundleStockCheckResult(9, 1, kitComponents[9], stock)
+
+	assert.Equal(t, 5, result.ReservableQuantity)
+	assert.False(t, result.InStock)
+	assert.Empty(t, result.Locations)
+}
@@ -0,0 +1,77 @@
+package helper_test
+
+import (
+	"testing"
+
+	"ecommerce-be/common/db"
+	"ecommerce-be/inventory/entity"
+	"ecommerce-be/inventory/utils/helper"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func rule(
+	id uint,
+	variantID *uint,
+	channel string,
+	reserve, buffer int,
+) entity.ChannelAllocationRule {
+	return entity.ChannelAllocationRule{
+		BaseEntity:     db.BaseEntity{ID: id},
+		SellerID:       1,
+		VariantID:      variantID,
+		Channel:        channel,
+		ReservePercent: reserve,
+		BufferQuantity: buffer,
+	}
+}
+
+func TestResolveChannelAllocations_VariantRuleReplacesDefault(t *testing.T) {
+	variantID := uint(7)
+	other := uint(8)
+	rules := []entity.ChannelAllocationRule{
+		rule(1, &variantID, "web", 50, 0),
+		rule(2, nil, "web", 20, 0),
+		rule(3, nil, "marketplace", 0, 5),
+		rule(4, &other, "marketplace", 0, 50),
+	}
+
+	allocations := helper.ResolveChannelAllocations(rules, variantID)
+
+	assert.Equal(t, map[string]helper.ChannelAllocation{
+		"web":         {ReservePercent: 50},
+		"marketplace": {BufferQuantity: 5},
+	}, allocations)
+}
+
+func TestChannelAvailableQuantity(t *testing.T) {
+	// 30% reserved for web, marketplace keeps a 5 unit buffer
+	allocations := map[string]helper.ChannelAllocation{
+		"web":         {ReservePercent: 30},
+		"marketplace": {BufferQuantity: 5},
+	}
+
+	assert.Equal(t, 100, helper.ChannelAvailableQuantity(100, "web", allocations))
+	assert.Equal(t, 65, helper.ChannelAvailableQuantity(100, "marketplace", allocations))
+	assert.Equal(t, 70, helper.ChannelAvailableQuantity(100, "pos", allocations))
+	// Reserved shares round down
+	assert.Equal(t, 8, helper.ChannelAvailableQuantity(11, "pos", allocations))
+	// Buffers never make availability negative
+	assert.Equal(t, 0, helper.ChannelAvailableQuantity(6, "marketplace", allocations))
+}
+
+func TestChannelAvailableQuantity_PassesThroughWithoutRulesOrStock(t *testing.T) {
+	allocations := map[string]helper.ChannelAllocation{"web": {ReservePercent: 50}}
+
+	assert.Equal(t, 40, helper.ChannelAvailableQuantity(40, "pos", nil))
+	assert.Equal(t, 0, helper.ChannelAvailableQuantity(0, "pos", allocations))
+	assert.Equal(t, -3, helper.ChannelAvailableQuantity(-3, "pos", allocations))
+}
+
+func TestTotalReservePercent(t *testing.T) {
+	assert.Equal(t, 90, helper.TotalReservePercent(map[string]helper.ChannelAllocation{
+		"web":         {ReservePercent: 60},
+		"marketplace": {ReservePercent: 30, BufferQuantity: 10},
+	}))
+	assert.Zero(t, helper.TotalReservePercent(nil))
+}
@@ -0,0 +1,131 @@
+package helper_test
+
+import (
+	"testing"
+	"time"
+
+	"ecommerce-be/common/constants"
+	"ecommerce-be/inventory/entity"
+	"ecommerce-be/inventory/model"
+	"ecommerce-be/inventory/utils/helper"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	periodFrom = time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	periodTo   = time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)
+)
+
+func ledgerEntry(
+	variantID uint,
+	txnType entity.TransactionType,
+	quantity int,
+	unitCost *int64,
+	day int,
+) model.ValuationLedgerEntry {
+	return model.ValuationLedgerEntry{
+		VariantID:      variantID,
+		Type:           txnType,
+		QuantityChange: quantity,
+		UnitCostCents:  unitCost,
+		CreatedAt:      periodFrom.AddDate(0, 0, day),
+	}
+}
+
+func cents(v int64) *int64 {
+	return &v
+}
+
+// Two lots bought before the period, 15 units sold in it
+func twoLotLedger() []model.ValuationLedgerEntry {
+	return []model.ValuationLedgerEntry{
+		ledgerEntry(1, entity.TXN_PURCHASE, 10, cents(100), -10),
+		ledgerEntry(1, entity.TXN_PURCHASE, 10, cents(200), -5),
+		ledgerEntry(1, entity.TXN_TRANSFER_OUT, -4, nil, 1),
+		ledgerEntry(1, entity.TXN_TRANSFER_IN, 4, nil, 1),
+		ledgerEntry(1, entity.TXN_OUTBOUND, -15, nil, 2),
+	}
+}
+
+func TestValueInventoryLedger_FIFOSellsOldestLotsFirst(t *testing.T) {
+	valuations := helper.ValueInventoryLedger(
+		twoLotLedger(), constants.INVENTORY_COSTING_FIFO, periodFrom, periodTo,
+	)
+
+	require.Len(t, valuations, 1)
+	v := valuations[0]
+	assert.Equal(t, 20, v.OpeningQuantity)
+	assert.Equal(t, int64(3000), v.OpeningValueCents)
+	assert.Equal(t, 15, v.SoldQuantity)
+	assert.Equal(t, int64(10*100+5*200), v.COGSCents)
+	assert.Equal(t, 5, v.ClosingQuantity)
+	assert.Equal(t, int64(5*200), v.ClosingValueCents)
+}
+
+func TestValueInventoryLedger_AverageSellsAtMovingAverage(t *testing.T) {
+	valuations := helper.ValueInventoryLedger(
+		twoLotLedger(), constants.INVENTORY_COSTING_AVERAGE, periodFrom, periodTo,
+	)
+
+	require.Len(t, valuations, 1)
+	v := valuations[0]
+	assert.Equal(t, int64(3000), v.OpeningValueCents)
+	assert.Equal(t, int64(15*150), v.COGSCents)
+	assert.Equal(t, int64(5*150), v.ClosingValueCents)
+}
+
+func TestValueInventoryLedger_UncostedPurchaseUsesCostOnHand(t *testing.T) {
+	entries := []model.ValuationLedgerEntry{
+		ledgerEntry(1, entity.TXN_PURCHASE, 10, cents(100), -1),
+		ledgerEntry(1, entity.TXN_PURCHASE, 5, nil, 3),
+	}
+
+	valuations := helper.ValueInventoryLedger(
+		entries, constants.INVENTORY_COSTING_FIFO, periodFrom, periodTo,
+	)
+
+	require.Len(t, valuations, 1)
+	v := valuations[0]
+	assert.Equal(t, 5, v.PurchasedQuantity)
+	assert.Equal(t, 5, v.UncostedQuantity)
+	assert.Equal(t, int64(500), v.PurchasedValueCents)
+	assert.Equal(t, int64(1500), v.ClosingValueCents)
+}
+
+func TestValueInventoryLedger_ReceiptsFillBackorderFirst(t *testing.T) {
+	entries := []model.ValuationLedgerEntry{
+		ledgerEntry(1, entity.TXN_PURCHASE, 2, cents(100), 1),
+		ledgerEntry(1, entity.TXN_OUTBOUND, -5, nil, 2),
+		ledgerEntry(1, entity.TXN_PURCHASE, 10, cents(120), 3),
+	}
+
+	valuations := helper.ValueInventoryLedger(
+		entries, constants.INVENTORY_COSTING_FIFO, periodFrom, periodTo,
+	)
+
+	require.Len(t, valuations, 1)
+	v := valuations[0]
+	assert.Equal(t, int64(5*100), v.COGSCents)
+	assert.Equal(t, 7, v.ClosingQuantity)
+	assert.Equal(t, int64(7*120), v.ClosingValueCents)
+}
+
+func TestValueInventoryLedger_SkipsIdleVariantsAndLaterEntries(t *testing.T) {
+	entries := []model.ValuationLedgerEntry{
+		ledgerEntry(1, entity.TXN_PURCHASE, 3, cents(100), -2),
+		ledgerEntry(1, entity.TXN_OUTBOUND, -3, nil, -1),
+		ledgerEntry(2, entity.TXN_PURCHASE, 4, cents(50), 5),
+		ledgerEntry(2, entity.TXN_OUTBOUND, -4, nil, 40),
+	}
+
+	valuations := helper.ValueInventoryLedger(
+		entries, constants.INVENTORY_COSTING_FIFO, periodFrom, periodTo,
+	)
+
+	require.Len(t, valuations, 1)
+	assert.Equal(t, uint(2), valuations[0].VariantID)
+	assert.Equal(t, 4, valuations[0].ClosingQuantity)
+	assert.Equal(t, int64(200), helper.SumInventoryValuation(valuations).ClosingValueCents)
+}
@@ -0,0 +1,79 @@
+package helper_test
+
+import (
+	"testing"
+	"time"
+
+	"ecommerce-be/inventory/entity"
+	invErrors "ecommerce-be/inventory/error"
+	"ecommerce-be/inventory/model"
+	"ecommerce-be/inventory/utils/helper"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func adjustmentRequest(reasonCode *entity.MovementReason) model.ManageInventoryRequest {
+	direction := entity.ADJ_REMOVE
+	return model.ManageInventoryRequest{
+		VariantID:       1,
+		LocationID:      1,
+		Quantity:        2,
+		TransactionType: entity.TXN_ADJUSTMENT,
+		Direction:       &direction,
+		ReasonCode:      reasonCode,
+		Reason:          "Shelf count correction",
+	}
+}
+
+func TestMovementReason_DefaultsFollowTransactionType(t *testing.T) {
+	assert.Equal(t, entity.REASON_SALE, entity.TXN_OUTBOUND.DefaultReasonCode())
+	assert.Equal(t, entity.REASON_CUSTOMER_RETURN, entity.TXN_RETURN.DefaultReasonCode())
+	assert.Equal(t, entity.REASON_TRANSFER, entity.TXN_TRANSFER_IN.DefaultReasonCode())
+	assert.Equal(t, entity.REASON_CYCLE_COUNT, entity.TXN_REFRESH.DefaultReasonCode())
+	assert.Equal(t, entity.REASON_MANUAL_CORRECTION, entity.TXN_ADJUSTMENT.DefaultReasonCode())
+}
+
+func TestMovementReason_EveryTypeHasAValidDefault(t *testing.T) {
+	for _, txnType := range entity.ValidTransactionTypes() {
+		reason := txnType.DefaultReasonCode()
+		assert.True(t, reason.IsValid(), txnType)
+		assert.True(t, txnType.AllowsReasonCode(reason), txnType)
+	}
+}
+
+func TestValidateManageRequest_ReasonCode(t *testing.T) {
+	theft := entity.REASON_THEFT_LOSS
+	assert.NoError(t, helper.ValidateManageRequest(adjustmentRequest(nil)))
+	assert.NoError(t, helper.ValidateManageRequest(adjustmentRequest(&theft)))
+
+	sale := entity.REASON_SALE
+	assert.ErrorIs(
+		t,
+		helper.ValidateManageRequest(adjustmentRequest(&sale)),
+		invErrors.ErrReasonCodeNotAllowed,
+	)
+
+	unknown := entity.MovementReason("GIFT")
+	assert.ErrorIs(
+		t,
+		helper.ValidateManageRequest(adjustmentRequest(&unknown)),
+		invErrors.ErrInvalidReasonCode,
+	)
+}
+
+func TestVariantMovementsQueryParams_ToFilter(t *testing.T) {
+	params := model.VariantMovementsQueryParams{
+		LocationIDs: "3,4",
+		Types:       "damage,adjustment",
+		ReasonCodes: "expired,unknown",
+		CreatedFrom: "2026-01-01T00:00:00Z",
+	}
+
+	filter := params.ToFilter(42)
+
+	assert.Equal(t, []uint{42}, filter.VariantIDs)
+	assert.Equal(t, []uint{3, 4}, filter.LocationIDs)
+	assert.Equal(t, []entity.TransactionType{entity.TXN_DAMAGE, entity.TXN_ADJUSTMENT}, filter.Types)
+	assert.Equal(t, []entity.MovementReason{entity.REASON_EXPIRED}, filter.ReasonCodes)
+	assert.Equal(t, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), *filter.CreatedFrom)
+}
@@ -0,0 +1,122 @@
+package helper_test
+
+import (
+	"testing"
+
+	"ecommerce-be/common/db"
+	"ecommerce-be/inventory/entity"
+	invErrors "ecommerce-be/inventory/error"
+	"ecommerce-be/inventory/model"
+	"ecommerce-be/inventory/utils/helper"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func sentPurchaseOrder() *entity.PurchaseOrder {
+	return &entity.PurchaseOrder{
+		BaseEntity: db.BaseEntity{ID: 42},
+		LocationID: 5,
+		Status:     entity.PO_SENT,
+		Lines: []entity.PurchaseOrderLine{
+			{VariantID: 1, QuantityOrdered: 10, UnitCostCents: 250},
+			{VariantID: 2, QuantityOrdered: 4, QuantityReceived: 1, UnitCostCents: 900},
+		},
+	}
+}
+
+func int64Ptr(v int64) *int64 {
+	return &v
+}
+
+func TestBuildPurchaseOrderLines_RejectsDuplicateVariants(t *testing.T) {
+	_, err := helper.BuildPurchaseOrderLines([]model.PurchaseOrderLineRequest{
+		{VariantID: 1, QuantityOrdered: 2, UnitCostCents: int64Ptr(100)},
+		{VariantID: 1, QuantityOrdered: 3, UnitCostCents: int64Ptr(100)},
+	})
+
+	assert.ErrorIs(t, err, invErrors.ErrDuplicatePurchaseOrderLine)
+}
+
+func TestApplyPurchaseOrderReceipt_BuildsPurchaseMovements(t *testing.T) {
+	order := sentPurchaseOrder()
+
+	items, err := helper.ApplyPurchaseOrderReceipt(order, model.ReceivePurchaseOrderRequest{
+		Items: []model.ReceivePurchaseOrderItem{
+			{VariantID: 1, Quantity: 6},
+			{VariantID: 2, Quantity: 3, UnitCostCents: int64Ptr(875)},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, items, 2)
+
+	assert.Equal(t, entity.TXN_PURCHASE, items[0].TransactionType)
+	assert.Equal(t, uint(5), items[0].LocationID)
+	assert.Equal(t, 6, items[0].Quantity)
+	assert.Equal(t, int64(250), *items[0].UnitCostCents)
+	assert.Equal(t, "PO-000042", *items[0].Reference)
+	assert.Equal(t, int64(875), *items[1].UnitCostCents)
+	for _, item := range items {
+		assert.NoError(t, helper.ValidateManageRequest(item))
+	}
+
+	assert.Equal(t, 6, order.Lines[0].QuantityReceived)
+	assert.Equal(t, 4, order.Lines[1].QuantityReceived)
+	assert.Equal(t, entity.PO_PARTIALLY_RECEIVED, helper.ReceivedStatus(order.Lines))
+}
+
+func TestApplyPurchaseOrderReceipt_ClosesWhenFullyReceived(t *testing.T) {
+	order := sentPurchaseOrder()
+
+	_, err := helper.ApplyPurchaseOrderReceipt(order, model.ReceivePurchaseOrderRequest{
+		Items: []model.ReceivePurchaseOrderItem{
+			{VariantID: 1, Quantity: 10},
+			{VariantID: 2, Quantity: 3},
+		},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, entity.PO_CLOSED, helper.ReceivedStatus(order.Lines))
+}
+
+func TestApplyPurchaseOrderReceipt_RejectsInvalidItems(t *testing.T) {
+	tests := []struct {
+		name  string
+		items []model.ReceivePurchaseOrderItem
+		want  error
+	}{
+		{
+			name:  "variant not on the order",
+			items: []model.ReceivePurchaseOrderItem{{VariantID: 9, Quantity: 1}},
+			want:  invErrors.ErrPurchaseOrderLineNotFound,
+		},
+		{
+			name:  "more than outstanding",
+			items: []model.ReceivePurchaseOrderItem{{VariantID: 2, Quantity: 4}},
+			want:  invErrors.ErrReceiptExceedsOrdered,
+		},
+		{
+			name: "repeated item adds up past outstanding",
+			items: []model.ReceivePurchaseOrderItem{
+				{VariantID: 1, Quantity: 6},
+				{VariantID: 1, Quantity: 5},
+			},
+			want: invErrors.ErrReceiptExceedsOrdered,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			order := sentPurchaseOrder()
+
+			_, err := helper.ApplyPurchaseOrderReceipt(
+				order,
+				model.ReceivePurchaseOrderRequest{Items: tt.items},
+			)
+
+			assert.ErrorIs(t, err, tt.want)
+			assert.Equal(t, 0, order.Lines[0].QuantityReceived)
+			assert.Equal(t, 1, order.Lines[1].QuantityReceived)
+		})
+	}
+}
@@ -0,0 +1,82 @@
+package helper_test
+
+import (
+	"testing"
+
+	"ecommerce-be/inventory/entity"
+	invErrors "ecommerce-be/inventory/error"
+	"ecommerce-be/inventory/model"
+	"ecommerce-be/inventory/utils/helper"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func returnRequest(disposition *entity.ReturnDisposition) model.ManageInventoryRequest {
+	reference := "RMA-1"
+	return model.ManageInventoryRequest{
+		VariantID:       1,
+		LocationID:      1,
+		Quantity:        3,
+		TransactionType: entity.TXN_RETURN,
+		Disposition:     disposition,
+		Reference:       &reference,
+		Reason:          "Customer return",
+	}
+}
+
+func applyReturn(
+	t *testing.T,
+	inventory *entity.Inventory,
+	req model.ManageInventoryRequest,
+) int {
+	change, err := helper.CalculateQuantityChange(req, inventory.Quantity, false)
+	require.NoError(t, err)
+	require.NoError(t, helper.ApplyInventoryChanges(inventory, req, change))
+	return change
+}
+
+func TestReturnDisposition_RestockIsDefaultAndAvailable(t *testing.T) {
+	inventory := &entity.Inventory{Quantity: 10, ReservedQuantity: 2}
+
+	change := applyReturn(t, inventory, returnRequest(nil))
+
+	assert.Equal(t, 3, change)
+	assert.Equal(t, 13, inventory.Quantity)
+	resp := helper.BuildManageResponse(inventory, 10, change, 1)
+	assert.Equal(t, 11, resp.AvailableQuantity)
+	assert.Equal(t, entity.DISPOSITION_RESTOCK, helper.ReturnDispositionOf(returnRequest(nil)))
+}
+
+func TestReturnDisposition_HeldUnitsAreNotAvailable(t *testing.T) {
+	damaged := entity.DISPOSITION_DAMAGED
+	inventory := &entity.Inventory{Quantity: 10, ReservedQuantity: 2}
+	change := applyReturn(t, inventory, returnRequest(&damaged))
+
+	assert.Zero(t, change)
+	assert.Equal(t, 10, inventory.Quantity)
+	assert.Equal(t, 3, inventory.DamagedQuantity)
+	assert.Equal(t, 8, helper.BuildManageResponse(inventory, 10, change, 1).AvailableQuantity)
+
+	quarantine := entity.DISPOSITION_QUARANTINE
+	change = applyReturn(t, inventory, returnRequest(&quarantine))
+
+	assert.Zero(t, change)
+	assert.Equal(t, 10, inventory.Quantity)
+	assert.Equal(t, 3, inventory.QuarantinedQuantity)
+	assert.Equal(t, 3, inventory.DamagedQuantity)
+	assert.Equal(t, 8, helper.BuildManageResponse(inventory, 10, change, 1).AvailableQuantity)
+}
+
+func TestReturnDisposition_Validation(t *testing.T) {
+	invalid := entity.ReturnDisposition("LOST")
+	assert.ErrorIs(t, helper.ValidateManageRequest(returnRequest(&invalid)),
+		invErrors.ErrInvalidDisposition)
+
+	damaged := entity.DISPOSITION_DAMAGED
+	req := returnRequest(&damaged)
+	req.TransactionType = entity.TXN_PURCHASE
+	assert.ErrorIs(t, helper.ValidateManageRequest(req), invErrors.ErrDispositionNotAllowed)
+
+	assert.NoError(t, helper.ValidateManageRequest(returnRequest(&damaged)))
+}
@@ -0,0 +1,68 @@
+package helper_test
+
+import (
+	"testing"
+
+	"ecommerce-be/inventory/entity"
+	"ecommerce-be/inventory/model"
+	"ecommerce-be/inventory/utils/helper"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func stockAt(locationID uint, quantity, reserved, threshold int) *entity.Inventory {
+	return &entity.Inventory{
+		VariantID:        1,
+		LocationID:       locationID,
+		Quantity:         quantity,
+		ReservedQuantity: reserved,
+		Threshold:        threshold,
+	}
+}
+
+func TestMergeStockCheckItems_SumsRepeatedVariantsInRequestOrder(t *testing.T) {
+	variantIDs, requested := helper.MergeStockCheckItems([]model.StockCheckItem{
+		{VariantID: 5, Quantity: 1},
+		{VariantID: 2, Quantity: 3},
+		{VariantID: 5, Quantity: 2},
+	})
+
+	assert.Equal(t, []uint{5, 2}, variantIDs)
+	assert.Equal(t, map[uint]int{5: 3, 2: 3}, requested)
+}
+
+func TestBuildStockCheckResult_BreaksDownByLocation(t *testing.T) {
+	inventories := []*entity.Inventory{stockAt(10, 8, 2, 1), stockAt(11, 3, 4, 0)}
+	names := map[uint]string{10: "Main", 11: "Store"}
+
+	result := helper.BuildStockCheckResult(
+		1, 4, inventories, helper.PooledAvailableQuantity(inventories), names,
+	)
+
+	assert.Equal(t, 5, result.AvailableQuantity)
+	// The oversold store pulls the pool down to 4
+	assert.Equal(t, 4, result.ReservableQuantity)
+	assert.True(t, result.InStock)
+	assert.Equal(t, []model.StockCheckLocation{
+		{LocationID: 10, LocationName: "Main", AvailableQuantity: 5},
+		{LocationID: 11, LocationName: "Store", AvailableQuantity: 0},
+	}, result.Locations)
+}
+
+func TestBuildStockCheckResult_ChannelLimitCapsReservable(t *testing.T) {
+	inventories := []*entity.Inventory{stockAt(10, 20, 0, 0)}
+
+	result := helper.BuildStockCheckResult(1, 6, inventories, 5, nil)
+
+	assert.Equal(t, 20, result.AvailableQuantity)
+	assert.Equal(t, 5, result.ReservableQuantity)
+	assert.False(t, result.InStock)
+}
+
+func TestBuildStockCheckResult_NoInventory(t *testing.T) {
+	result := helper.BuildStockCheckResult(1, 1, nil, 0, nil)
+
+	assert.Empty(t, result.Locations)
+	assert.Zero(t, result.ReservableQuantity)
+	assert.False(t, result.InStock)
+}
@@ -0,0 +1,77 @@
+package helper_test
+
+import (
+	"testing"
+
+	"ecommerce-be/common/db"
+	"ecommerce-be/inventory/entity"
+	"ecommerce-be/inventory/utils/helper"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func stockTakeWithLines(lines ...entity.StockTakeLine) *entity.StockTake {
+	return &entity.StockTake{
+		BaseEntity: db.BaseEntity{ID: 7},
+		LocationID: 3,
+		Status:     entity.STOCK_TAKE_OPEN,
+		Lines:      lines,
+	}
+}
+
+func countedLine(variantID uint, system, counted int) entity.StockTakeLine {
+	return entity.StockTakeLine{
+		VariantID:       variantID,
+		SystemQuantity:  system,
+		CountedQuantity: counted,
+	}
+}
+
+func TestBuildStockTakeAdjustments_OneMovementPerDiscrepancy(t *testing.T) {
+	stockTake := stockTakeWithLines(
+		countedLine(1, 10, 12),
+		countedLine(2, 8, 8),
+		countedLine(3, 5, 0),
+	)
+
+	items := helper.BuildStockTakeAdjustments(stockTake)
+
+	require.Len(t, items, 2)
+
+	assert.Equal(t, uint(1), items[0].VariantID)
+	assert.Equal(t, uint(3), items[0].LocationID)
+	assert.Equal(t, 2, items[0].Quantity)
+	assert.Equal(t, entity.ADJ_ADD, *items[0].Direction)
+
+	assert.Equal(t, uint(3), items[1].VariantID)
+	assert.Equal(t, 5, items[1].Quantity)
+	assert.Equal(t, entity.ADJ_REMOVE, *items[1].Direction)
+
+	for _, item := range items {
+		assert.Equal(t, entity.TXN_ADJUSTMENT, item.TransactionType)
+		assert.Equal(t, entity.REASON_STOCK_TAKE, *item.ReasonCode)
+		assert.Equal(t, "STOCK_TAKE-7", *item.Reference)
+		assert.NoError(t, helper.ValidateManageRequest(item))
+	}
+}
+
+func TestBuildStockTakeAdjustments_NoDiscrepancies(t *testing.T) {
+	stockTake := stockTakeWithLines(countedLine(1, 4, 4))
+
+	assert.Empty(t, helper.BuildStockTakeAdjustments(stockTake))
+}
+
+func TestSummarizeStockTake(t *testing.T) {
+	summary := helper.SummarizeStockTake([]entity.StockTakeLine{
+		countedLine(1, 10, 12),
+		countedLine(2, 8, 8),
+		countedLine(3, 5, 0),
+		countedLine(4, 0, 1),
+	})
+
+	assert.Equal(t, 4, summary.CountedItems)
+	assert.Equal(t, 3, summary.DiscrepancyItems)
+	assert.Equal(t, 3, summary.UnitsOver)
+	assert.Equal(t, 5, summary.UnitsShort)
+}
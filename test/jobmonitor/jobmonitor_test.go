@@ -0,0 +1,67 @@
+package jobmonitor_test
+
+import (
+	"testing"
+	"time"
+
+	"ecommerce-be/common/jobmonitor"
+	"ecommerce-be/common/scheduler"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCommandStatsOf_SummarisesRunsByCommand(t *testing.T) {
+	now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	runs := []scheduler.JobRun{
+		{
+			Command:    "notification.dispatch",
+			Result:     scheduler.RunResultSucceeded,
+			DurationMs: 100,
+			FinishedAt: now,
+		},
+		{
+			Command:    "upload.expiry",
+			Result:     scheduler.RunResultSucceeded,
+			DurationMs: 20,
+			FinishedAt: now.Add(-time.Minute),
+		},
+		{
+			Command:    "notification.dispatch",
+			Result:     scheduler.RunResultRetrying,
+			Error:      "smtp timeout",
+			DurationMs: 400,
+			FinishedAt: now.Add(-2 * time.Minute),
+		},
+		{
+			Command:    "notification.dispatch",
+			Result:     scheduler.RunResultDeadLettered,
+			Error:      "invalid recipient",
+			DurationMs: 40,
+			FinishedAt: now.Add(-3 * time.Minute),
+		},
+	}
+
+	stats := jobmonitor.CommandStatsOf(runs)
+	require.Len(t, stats, 2)
+
+	dispatch := stats[0]
+	assert.Equal(t, "notification.dispatch", dispatch.Command)
+	assert.Equal(t, 3, dispatch.Runs)
+	assert.Equal(t, 2, dispatch.Failures)
+	assert.Equal(t, int64(180), dispatch.AvgDurationMs)
+	assert.Equal(t, int64(400), dispatch.MaxDurationMs)
+	assert.Equal(t, now, dispatch.LastRunAt)
+	require.NotNil(t, dispatch.LastFailureAt)
+	assert.Equal(t, now.Add(-2*time.Minute), *dispatch.LastFailureAt)
+	assert.Equal(t, "smtp timeout", dispatch.LastError)
+
+	expiry := stats[1]
+	assert.Equal(t, "upload.expiry", expiry.Command)
+	assert.Equal(t, 0, expiry.Failures)
+	assert.Nil(t, expiry.LastFailureAt)
+}
+
+func TestCommandStatsOf_NoRuns(t *testing.T) {
+	assert.Empty(t, jobmonitor.CommandStatsOf(nil))
+}
@@ -0,0 +1,57 @@
+package lifecycle_test
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"ecommerce-be/common/lifecycle"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestManager_ShutdownWaitsForWorkersToFinish(t *testing.T) {
+	manager := lifecycle.NewManager()
+	var finished atomic.Int32
+	for range 3 {
+		manager.Go("worker", func(ctx context.Context) {
+			<-ctx.Done()
+			// In-flight work still completes after cancellation
+			time.Sleep(20 * time.Millisecond)
+			finished.Add(1)
+		})
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	require.NoError(t, manager.Shutdown(ctx))
+	assert.Equal(t, int32(3), finished.Load())
+}
+
+func TestManager_ShutdownReportsWorkersPastDeadline(t *testing.T) {
+	manager := lifecycle.NewManager()
+	release := make(chan struct{})
+	defer close(release)
+	manager.Go("stuck consumer", func(ctx context.Context) { <-release })
+	manager.Go("polite worker", func(ctx context.Context) { <-ctx.Done() })
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	err := manager.Shutdown(ctx)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "stuck consumer")
+	assert.NotContains(t, err.Error(), "polite worker")
+}
+
+func TestManager_WorkerStartedAfterShutdownIsCancelled(t *testing.T) {
+	manager := lifecycle.NewManager()
+	require.NoError(t, manager.Shutdown(context.Background()))
+
+	stopped := make(chan error, 1)
+	manager.Go("late worker", func(ctx context.Context) { stopped <- ctx.Err() })
+
+	assert.ErrorIs(t, <-stopped, context.Canceled)
+}
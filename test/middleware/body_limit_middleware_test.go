@@ -0,0 +1,95 @@
+package middleware_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"ecommerce-be/common/constants"
+	"ecommerce-be/common/handler"
+	"ecommerce-be/common/middleware"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+type bulkInput struct {
+	Names []string `json:"names"`
+}
+
+// bodyLimitRouter caps bodies at 32 bytes, except on /bulk which allows 256
+func bodyLimitRouter() *gin.Engine {
+	base := handler.NewBaseHandler()
+	bind := func(c *gin.Context) {
+		var input bulkInput
+		if err := base.BindJSON(c, &input); err != nil {
+			base.HandleValidationError(c, err)
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"count": len(input.Names)})
+	}
+
+	router := gin.New()
+	router.Use(middleware.BodySizeLimit(32))
+	router.POST("/single", bind)
+	router.POST("/bulk", middleware.BodySizeLimit(256), bind)
+	return router
+}
+
+func postBody(
+	router *gin.Engine,
+	path string,
+	body io.Reader,
+	contentLength int64,
+) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, path, body)
+	req.Header.Set("Content-Type", "application/json")
+	req.ContentLength = contentLength
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, req)
+	return recorder
+}
+
+func namesBody(count int) string {
+	return `{"names":[` + strings.TrimSuffix(strings.Repeat(`"abcdefgh",`, count), ",") + `]}`
+}
+
+func TestBodySizeLimit_AcceptsBodyWithinLimit(t *testing.T) {
+	body := namesBody(1)
+	recorder := postBody(bodyLimitRouter(), "/single", strings.NewReader(body), int64(len(body)))
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.JSONEq(t, `{"count":1}`, recorder.Body.String())
+}
+
+func TestBodySizeLimit_RejectsDeclaredLengthOverLimit(t *testing.T) {
+	body := namesBody(5)
+	recorder := postBody(bodyLimitRouter(), "/single", strings.NewReader(body), int64(len(body)))
+
+	assert.Equal(t, http.StatusRequestEntityTooLarge, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), constants.REQUEST_BODY_TOO_LARGE_CODE)
+}
+
+func TestBodySizeLimit_RejectsUndeclaredBodyOverLimitWhileBinding(t *testing.T) {
+	// A chunked body has no Content-Length, so only reading can catch it
+	body := io.MultiReader(strings.NewReader(namesBody(5)))
+	recorder := postBody(bodyLimitRouter(), "/single", body, -1)
+
+	assert.Equal(t, http.StatusRequestEntityTooLarge, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), constants.REQUEST_BODY_TOO_LARGE_CODE)
+}
+
+func TestBodySizeLimit_RouteLimitReplacesDefault(t *testing.T) {
+	body := namesBody(5)
+	router := bodyLimitRouter()
+
+	recorder := postBody(router, "/bulk", io.MultiReader(strings.NewReader(body)), -1)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.JSONEq(t, `{"count":5}`, recorder.Body.String())
+
+	body = namesBody(30)
+	recorder = postBody(router, "/bulk", strings.NewReader(body), int64(len(body)))
+	assert.Equal(t, http.StatusRequestEntityTooLarge, recorder.Code)
+}
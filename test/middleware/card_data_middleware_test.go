@@ -0,0 +1,81 @@
+package middleware_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"ecommerce-be/common/constants"
+	"ecommerce-be/common/log"
+	"ecommerce-be/common/middleware"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestMain installs a silent logger; the default one needs loaded configuration
+func TestMain(m *testing.M) {
+	gin.SetMode(gin.TestMode)
+	log.Log = logrus.New()
+	log.Log.SetOutput(io.Discard)
+	os.Exit(m.Run())
+}
+
+func newCardDataRouter(handled *string) *gin.Engine {
+	router := gin.New()
+	router.POST("/pay", middleware.RejectCardData(), func(c *gin.Context) {
+		body, _ := io.ReadAll(c.Request.Body)
+		*handled = string(body)
+		c.Status(http.StatusOK)
+	})
+	return router
+}
+
+func TestRejectCardData_BlocksCardNumbers(t *testing.T) {
+	var handled string
+	router := newCardDataRouter(&handled)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(
+		http.MethodPost,
+		"/pay",
+		strings.NewReader(`{"gatewayCode":"cashfree","token":"4111 1111 1111 1111"}`),
+	)
+	router.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	var body map[string]any
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	assert.Equal(t, constants.CARD_DATA_NOT_ACCEPTED_CODE, body["code"])
+	assert.NotContains(t, w.Body.String(), "4111")
+	assert.Empty(t, handled)
+}
+
+func TestRejectCardData_BlocksQueryValues(t *testing.T) {
+	var handled string
+	router := newCardDataRouter(&handled)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/pay?cvv=123", nil))
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Empty(t, handled)
+}
+
+func TestRejectCardData_PassesTokensThrough(t *testing.T) {
+	var handled string
+	router := newCardDataRouter(&handled)
+
+	payload := `{"gatewayCode":"cashfree","token":"tok_1NirD82eZvKYlo2C","type":"card"}`
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/pay", strings.NewReader(payload)))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, payload, handled)
+}
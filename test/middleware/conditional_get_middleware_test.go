@@ -0,0 +1,86 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"ecommerce-be/common/constants"
+	"ecommerce-be/common/middleware"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// conditionalRouter serves /product through ConditionalGET with the given body
+func conditionalRouter(maxAge int, body *string) *gin.Engine {
+	router := gin.New()
+	router.GET("/product", middleware.ConditionalGET(maxAge), func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"name": *body})
+	})
+	router.GET("/missing", middleware.ConditionalGET(maxAge), func(c *gin.Context) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "not found"})
+	})
+	return router
+}
+
+func conditionalGet(router *gin.Engine, path, ifNoneMatch string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	if ifNoneMatch != "" {
+		req.Header.Set(constants.IF_NONE_MATCH_HEADER, ifNoneMatch)
+	}
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, req)
+	return recorder
+}
+
+func TestConditionalGET_SetsWeakETagAndCacheControl(t *testing.T) {
+	body := "phone"
+	recorder := conditionalGet(conditionalRouter(60, &body), "/product", "")
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.JSONEq(t, `{"name":"phone"}`, recorder.Body.String())
+	assert.Regexp(t, `^W/"[0-9a-f]{32}"$`, recorder.Header().Get(constants.ETAG_HEADER))
+	assert.Equal(t, "private, max-age=60", recorder.Header().Get(constants.CACHE_CONTROL_HEADER))
+	assert.Equal(t, constants.CONDITIONAL_GET_VARY, recorder.Header().Get(constants.VARY_HEADER))
+}
+
+func TestConditionalGET_NotModifiedWhenETagMatches(t *testing.T) {
+	body := "phone"
+	router := conditionalRouter(0, &body)
+	etag := conditionalGet(router, "/product", "").Header().Get(constants.ETAG_HEADER)
+	require.NotEmpty(t, etag)
+
+	for _, ifNoneMatch := range []string{etag, `"other", ` + etag[2:], "*"} {
+		recorder := conditionalGet(router, "/product", ifNoneMatch)
+
+		assert.Equal(t, http.StatusNotModified, recorder.Code, ifNoneMatch)
+		assert.Empty(t, recorder.Body.String(), ifNoneMatch)
+		assert.Equal(t, etag, recorder.Header().Get(constants.ETAG_HEADER))
+		assert.Equal(t, "private, no-cache", recorder.Header().Get(constants.CACHE_CONTROL_HEADER))
+	}
+}
+
+func TestConditionalGET_ChangedBodyGetsNewETag(t *testing.T) {
+	body := "phone"
+	router := conditionalRouter(0, &body)
+	etag := conditionalGet(router, "/product", "").Header().Get(constants.ETAG_HEADER)
+
+	body = "phone v2"
+	recorder := conditionalGet(router, "/product", etag)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.JSONEq(t, `{"name":"phone v2"}`, recorder.Body.String())
+	assert.NotEqual(t, etag, recorder.Header().Get(constants.ETAG_HEADER))
+}
+
+func TestConditionalGET_PassesErrorsThrough(t *testing.T) {
+	body := "phone"
+	recorder := conditionalGet(conditionalRouter(60, &body), "/missing", "*")
+
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+	assert.JSONEq(t, `{"error":"not found"}`, recorder.Body.String())
+	assert.Empty(t, recorder.Header().Get(constants.ETAG_HEADER))
+	assert.Empty(t, recorder.Header().Get(constants.CACHE_CONTROL_HEADER))
+}
@@ -0,0 +1,72 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"ecommerce-be/common/auth"
+	"ecommerce-be/common/constants"
+	"ecommerce-be/common/db"
+	"ecommerce-be/common/middleware"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+// servePlanRoute runs a request through the plan middleware for a seller with the given
+// validation data, or for a request not tied to a seller when sellerData is nil
+func servePlanRoute(
+	t *testing.T,
+	sellerData *auth.SellerValidationResult,
+	planMiddleware gin.HandlerFunc,
+) *httptest.ResponseRecorder {
+	t.Helper()
+	router := gin.New()
+	router.POST("/products", func(c *gin.Context) {
+		if sellerData != nil {
+			auth.SellerValidation.Set(c, sellerData)
+		}
+	}, planMiddleware, func(c *gin.Context) {
+		c.Status(http.StatusCreated)
+	})
+
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/products", nil))
+	return recorder
+}
+
+func TestPlanFeature_RejectsPlanWithoutFeature(t *testing.T) {
+	seller := &auth.SellerValidationResult{
+		SellerID:     7,
+		PlanFeatures: db.JSONMap{constants.PLAN_FEATURE_RELATED_PRODUCTS_API: false},
+	}
+	recorder := servePlanRoute(t, seller,
+		middleware.PlanFeature(constants.PLAN_FEATURE_RELATED_PRODUCTS_API))
+	assert.Equal(t, http.StatusForbidden, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), constants.PLAN_FEATURE_UNAVAILABLE_CODE)
+
+	seller.PlanFeatures[constants.PLAN_FEATURE_RELATED_PRODUCTS_API] = true
+	recorder = servePlanRoute(t, seller,
+		middleware.PlanFeature(constants.PLAN_FEATURE_RELATED_PRODUCTS_API))
+	assert.Equal(t, http.StatusCreated, recorder.Code)
+}
+
+func TestPlanFeature_PassesRequestsWithoutSeller(t *testing.T) {
+	recorder := servePlanRoute(t, nil,
+		middleware.PlanFeature(constants.PLAN_FEATURE_RELATED_PRODUCTS_API))
+	assert.Equal(t, http.StatusCreated, recorder.Code)
+}
+
+func TestPlanLimit_UnlimitedAndZeroLimits(t *testing.T) {
+	zero := 0
+	seller := &auth.SellerValidationResult{SellerID: 7, PlanMaxProducts: &zero}
+
+	recorder := servePlanRoute(t, seller, middleware.PlanLimit(constants.PLAN_LIMIT_PRODUCTS))
+	assert.Equal(t, http.StatusForbidden, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), constants.PLAN_LIMIT_REACHED_CODE)
+
+	// Variants are unlimited on this plan
+	recorder = servePlanRoute(t, seller, middleware.PlanLimit(constants.PLAN_LIMIT_VARIANTS))
+	assert.Equal(t, http.StatusCreated, recorder.Code)
+}
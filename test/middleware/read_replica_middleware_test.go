@@ -0,0 +1,56 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"ecommerce-be/common/constants"
+	"ecommerce-be/common/db"
+	"ecommerce-be/common/middleware"
+	"ecommerce-be/common/reqctx"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+// replicaEligible reports whether the request's reads may use a read replica
+func replicaEligible(t *testing.T, header string, override func(*gin.Context) bool) bool {
+	t.Helper()
+	router := gin.New()
+	var eligible bool
+	router.GET("/products", middleware.ReadReplica(), func(c *gin.Context) {
+		eligible = override(c)
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/products", nil)
+	if header != "" {
+		req.Header.Set(constants.READ_CONSISTENCY_HEADER, header)
+	}
+	router.ServeHTTP(httptest.NewRecorder(), req)
+	return eligible
+}
+
+func TestReadReplica_MarksRequestReplicaEligible(t *testing.T) {
+	eligible := replicaEligible(t, "", func(c *gin.Context) bool {
+		value, _ := reqctx.ReadReplica.Get(c)
+		return value
+	})
+	assert.True(t, eligible)
+}
+
+func TestReadReplica_StrongConsistencyHeaderKeepsPrimary(t *testing.T) {
+	eligible := replicaEligible(t, "Strong", func(c *gin.Context) bool {
+		value, _ := reqctx.ReadReplica.Get(c)
+		return value
+	})
+	assert.False(t, eligible)
+}
+
+func TestReadReplica_UsePrimaryOverridesRoute(t *testing.T) {
+	eligible := replicaEligible(t, "", func(c *gin.Context) bool {
+		value, _ := reqctx.ReadReplica.Get(db.UsePrimary(c))
+		return value
+	})
+	assert.False(t, eligible)
+}
@@ -0,0 +1,77 @@
+package middleware_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"ecommerce-be/common/constants"
+	"ecommerce-be/common/handler"
+	"ecommerce-be/common/middleware"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+// timeoutRouter applies a one-second default deadline; /report replaces it with an hour
+func timeoutRouter(route gin.HandlerFunc) *gin.Engine {
+	router := gin.New()
+	router.ContextWithFallback = true
+	router.Use(middleware.RequestTimeout(time.Second))
+	router.GET("/thing", route)
+	router.GET("/report", middleware.RequestTimeout(time.Hour), route)
+	return router
+}
+
+func getPath(router *gin.Engine, path string) *httptest.ResponseRecorder {
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, path, nil))
+	return recorder
+}
+
+func TestRequestTimeout_GinContextCarriesDeadline(t *testing.T) {
+	var remaining time.Duration
+	router := timeoutRouter(func(c *gin.Context) {
+		// Services receive the gin context itself as their context.Context
+		var ctx context.Context = c
+		deadline, ok := ctx.Deadline()
+		assert.True(t, ok)
+		remaining = time.Until(deadline)
+		c.Status(http.StatusNoContent)
+	})
+
+	getPath(router, "/thing")
+	assert.InDelta(t, time.Second, remaining, float64(100*time.Millisecond))
+
+	getPath(router, "/report")
+	assert.Greater(t, remaining, 59*time.Minute)
+}
+
+func TestRequestTimeout_CancelsWorkPastDeadline(t *testing.T) {
+	router := gin.New()
+	router.ContextWithFallback = true
+	router.GET("/slow", middleware.RequestTimeout(10*time.Millisecond), func(c *gin.Context) {
+		select {
+		case <-c.Done():
+			handler.NewBaseHandler().HandleError(c, c.Err(), "Failed to load")
+		case <-time.After(time.Second):
+			c.Status(http.StatusOK)
+		}
+	})
+
+	recorder := getPath(router, "/slow")
+
+	assert.Equal(t, http.StatusGatewayTimeout, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), constants.REQUEST_TIMEOUT_CODE)
+}
+
+func TestRequestTimeout_ErrorsBeforeDeadlineAreUnchanged(t *testing.T) {
+	router := timeoutRouter(func(c *gin.Context) {
+		handler.NewBaseHandler().HandleError(c, errors.New("boom"), "Failed to load")
+	})
+
+	assert.Equal(t, http.StatusInternalServerError, getPath(router, "/thing").Code)
+}
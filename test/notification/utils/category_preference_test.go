@@ -0,0 +1,88 @@
+package utils_test
+
+import (
+	"strings"
+	"testing"
+
+	"ecommerce-be/notification/entity"
+	"ecommerce-be/notification/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveCategoryPreferences_DefaultsMarketingOff(t *testing.T) {
+	resolved := utils.ResolveCategoryPreferences(nil)
+
+	assert.False(t, resolved[entity.NOTIFICATION_CATEGORY_MARKETING])
+	assert.True(t, resolved[entity.NOTIFICATION_CATEGORY_ORDER_UPDATES])
+	assert.True(t, resolved[entity.NOTIFICATION_CATEGORY_STOCK_ALERTS])
+}
+
+func TestIsCategoryEnabled_ExplicitPreferenceWins(t *testing.T) {
+	prefs := []entity.NotificationCategoryPreference{
+		{Category: entity.NOTIFICATION_CATEGORY_STOCK_ALERTS, IsEnabled: false},
+		{Category: entity.NOTIFICATION_CATEGORY_MARKETING, IsEnabled: true},
+	}
+
+	assert.False(t, utils.IsCategoryEnabled(prefs, entity.NOTIFICATION_CATEGORY_STOCK_ALERTS))
+	assert.True(t, utils.IsCategoryEnabled(prefs, entity.NOTIFICATION_CATEGORY_MARKETING))
+	assert.True(t, utils.IsCategoryEnabled(prefs, entity.NOTIFICATION_CATEGORY_ORDER_UPDATES))
+}
+
+func TestNotificationEventType_Category(t *testing.T) {
+	assert.Equal(t,
+		entity.NOTIFICATION_CATEGORY_STOCK_ALERTS,
+		entity.NOTIFICATION_EVENT_INVENTORY_LOW_STOCK.Category(),
+	)
+	assert.Equal(t,
+		entity.NOTIFICATION_CATEGORY_ORDER_UPDATES,
+		entity.NOTIFICATION_EVENT_SHIPMENT_DELIVERED.Category(),
+	)
+}
+
+func TestNotificationEventType_IsMandatory(t *testing.T) {
+	assert.True(t, entity.NOTIFICATION_EVENT_EMAIL_CHANGE_CONFIRM.IsMandatory())
+	assert.True(t, entity.NOTIFICATION_EVENT_EMAIL_CHANGED.IsMandatory())
+	assert.False(t, entity.NOTIFICATION_EVENT_ORDER_PLACED.IsMandatory())
+	assert.False(t, entity.NOTIFICATION_EVENT_INVENTORY_LOW_STOCK.IsMandatory())
+}
+
+func TestUnsubscribeSigner_RoundTrip(t *testing.T) {
+	signer := utils.NewUnsubscribeSigner("secret", "https://api.example.com/")
+
+	token, err := signer.Sign(42, entity.NOTIFICATION_CATEGORY_MARKETING)
+	require.NoError(t, err)
+	userID, category, ok := signer.Verify(token)
+
+	require.True(t, ok)
+	assert.Equal(t, uint(42), userID)
+	assert.Equal(t, entity.NOTIFICATION_CATEGORY_MARKETING, category)
+	link, err := signer.URL(42, entity.NOTIFICATION_CATEGORY_MARKETING)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(
+		link,
+		"https://api.example.com/api/notification/unsubscribe?token=",
+	))
+}
+
+func TestUnsubscribeSigner_RejectsForgedTokens(t *testing.T) {
+	signer := utils.NewUnsubscribeSigner("secret", "")
+	other := utils.NewUnsubscribeSigner("other-secret", "")
+	token, err := signer.Sign(42, entity.NOTIFICATION_CATEGORY_ORDER_UPDATES)
+	require.NoError(t, err)
+
+	_, _, ok := other.Verify(token)
+	assert.False(t, ok)
+
+	// Swap the payload for another user while keeping the original signature
+	forged, err := signer.Sign(7, entity.NOTIFICATION_CATEGORY_ORDER_UPDATES)
+	require.NoError(t, err)
+	payload, _, _ := strings.Cut(forged, ".")
+	_, signature, _ := strings.Cut(token, ".")
+	_, _, ok = signer.Verify(payload + "." + signature)
+	assert.False(t, ok)
+
+	_, _, ok = signer.Verify("not-a-token")
+	assert.False(t, ok)
+}
@@ -0,0 +1,52 @@
+package utils_test
+
+import (
+	"testing"
+
+	"ecommerce-be/notification/entity"
+	"ecommerce-be/notification/utils"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDefaultChannelEnabled_SMSIsOptIn(t *testing.T) {
+	assert.False(t, utils.DefaultChannelEnabled(entity.NOTIFICATION_CHANNEL_SMS))
+	assert.True(t, utils.DefaultChannelEnabled(entity.NOTIFICATION_CHANNEL_EMAIL))
+	assert.True(t, utils.DefaultChannelEnabled(entity.NOTIFICATION_CHANNEL_PUSH))
+	assert.True(t, utils.DefaultChannelEnabled(entity.NOTIFICATION_CHANNEL_IN_APP))
+}
+
+func TestResolveChannelPreferences_ExplicitOverridesDefaults(t *testing.T) {
+	resolved := utils.ResolveChannelPreferences([]entity.NotificationChannelPreference{
+		{Channel: entity.NOTIFICATION_CHANNEL_SMS, IsEnabled: true},
+		{Channel: entity.NOTIFICATION_CHANNEL_EMAIL, IsEnabled: false},
+	})
+
+	assert.Len(t, resolved, len(entity.AllNotificationChannels()))
+	assert.True(t, resolved[entity.NOTIFICATION_CHANNEL_SMS])
+	assert.False(t, resolved[entity.NOTIFICATION_CHANNEL_EMAIL])
+	assert.True(t, resolved[entity.NOTIFICATION_CHANNEL_PUSH])
+}
+
+func TestResolveChannelPreferences_IgnoresUnknownChannels(t *testing.T) {
+	resolved := utils.ResolveChannelPreferences([]entity.NotificationChannelPreference{
+		{Channel: entity.NotificationChannel("fax"), IsEnabled: true},
+	})
+
+	_, ok := resolved[entity.NotificationChannel("fax")]
+	assert.False(t, ok)
+}
+
+func TestEnabledChannels_PreservesStableOrder(t *testing.T) {
+	channels := utils.EnabledChannels([]entity.NotificationChannelPreference{
+		{Channel: entity.NOTIFICATION_CHANNEL_PUSH, IsEnabled: false},
+	})
+
+	expected := make([]entity.NotificationChannel, 0)
+	for _, channel := range entity.AllNotificationChannels() {
+		if channel != entity.NOTIFICATION_CHANNEL_PUSH && channel != entity.NOTIFICATION_CHANNEL_SMS {
+			expected = append(expected, channel)
+		}
+	}
+	assert.Equal(t, expected, channels)
+}
@@ -0,0 +1,31 @@
+package utils_test
+
+import (
+	"testing"
+
+	"ecommerce-be/notification/utils"
+	"ecommerce-be/notification/utils/constant"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeInboxPage_AppliesDefaults(t *testing.T) {
+	page, pageSize := utils.NormalizeInboxPage(0, 0)
+
+	assert.Equal(t, 1, page)
+	assert.Equal(t, constant.DEFAULT_INBOX_PAGE_SIZE, pageSize)
+}
+
+func TestNormalizeInboxPage_CapsPageSize(t *testing.T) {
+	page, pageSize := utils.NormalizeInboxPage(3, 1000)
+
+	assert.Equal(t, 3, page)
+	assert.Equal(t, constant.MAX_INBOX_PAGE_SIZE, pageSize)
+}
+
+func TestIsValidInboxSelection(t *testing.T) {
+	assert.True(t, utils.IsValidInboxSelection([]uint{1, 2}, false))
+	assert.True(t, utils.IsValidInboxSelection(nil, true))
+	assert.False(t, utils.IsValidInboxSelection(nil, false))
+	assert.False(t, utils.IsValidInboxSelection([]uint{1}, true))
+}
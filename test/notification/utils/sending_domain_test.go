@@ -0,0 +1,69 @@
+package utils_test
+
+import (
+	"testing"
+
+	"ecommerce-be/notification/utils"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeSendingDomain(t *testing.T) {
+	assert.Equal(t, "mail.example.com", utils.NormalizeSendingDomain(" Mail.Example.COM. "))
+}
+
+func TestIsValidSendingDomain(t *testing.T) {
+	assert.True(t, utils.IsValidSendingDomain("mail.example.com"))
+	assert.True(t, utils.IsValidSendingDomain("my-shop.co.uk"))
+
+	assert.False(t, utils.IsValidSendingDomain("localhost"))
+	assert.False(t, utils.IsValidSendingDomain("-shop.example.com"))
+	assert.False(t, utils.IsValidSendingDomain("shop..example.com"))
+	assert.False(t, utils.IsValidSendingDomain("shop_1.example.com"))
+}
+
+func TestIsValidDKIMSelector(t *testing.T) {
+	assert.True(t, utils.IsValidDKIMSelector("s1"))
+	assert.True(t, utils.IsValidDKIMSelector("abc123.2024"))
+	assert.False(t, utils.IsValidDKIMSelector(""))
+	assert.False(t, utils.IsValidDKIMSelector("s1._domainkey"))
+}
+
+func TestFromAddressOnDomain(t *testing.T) {
+	assert.True(t, utils.FromAddressOnDomain("orders@example.com", "example.com"))
+	assert.True(t, utils.FromAddressOnDomain("orders@mail.example.com", "example.com"))
+
+	assert.False(t, utils.FromAddressOnDomain("orders@example.org", "example.com"))
+	assert.False(t, utils.FromAddressOnDomain("orders@badexample.com", "example.com"))
+	assert.False(t, utils.FromAddressOnDomain("Shop <orders@example.com>", "example.com"))
+}
+
+func TestDKIMRecordName(t *testing.T) {
+	assert.Equal(t, "s1._domainkey.example.com", utils.DKIMRecordName("s1", "example.com"))
+}
+
+func TestHasSPFRecord(t *testing.T) {
+	records := []string{"google-site-verification=abc", "v=spf1 include:amazonses.com ~all"}
+
+	assert.True(t, utils.HasSPFRecord(records, ""))
+	assert.True(t, utils.HasSPFRecord(records, "amazonses.com"))
+	assert.False(t, utils.HasSPFRecord(records, "sendgrid.net"))
+	assert.False(t, utils.HasSPFRecord([]string{"v=spf10 include:amazonses.com"}, ""))
+	assert.False(t, utils.HasSPFRecord(nil, ""))
+}
+
+func TestHasDKIMRecord(t *testing.T) {
+	assert.True(t, utils.HasDKIMRecord([]string{"v=DKIM1; k=rsa; p=MIGfMA0GCSqGSIb3"}))
+	assert.True(t, utils.HasDKIMRecord([]string{"k=rsa; p=MIGf MA0G"}))
+
+	assert.False(t, utils.HasDKIMRecord([]string{"v=DKIM1; k=rsa; p="}))
+	assert.False(t, utils.HasDKIMRecord([]string{"v=DKIM2; p=MIGfMA0GCSqGSIb3"}))
+	assert.False(t, utils.HasDKIMRecord(nil))
+}
+
+func TestIsSendingDomainPlan(t *testing.T) {
+	assert.True(t, utils.IsSendingDomainPlan("Professional"))
+	assert.True(t, utils.IsSendingDomainPlan("enterprise"))
+	assert.False(t, utils.IsSendingDomainPlan("Starter"))
+	assert.False(t, utils.IsSendingDomainPlan(""))
+}
@@ -0,0 +1,68 @@
+package utils_test
+
+import (
+	"testing"
+	"time"
+
+	"ecommerce-be/common/db"
+	"ecommerce-be/notification/entity"
+	"ecommerce-be/notification/utils"
+	"ecommerce-be/notification/utils/constant"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResolveSyncCursor_ExplicitSinceWins(t *testing.T) {
+	since := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	storedAt := since.Add(time.Hour)
+	afterID := uint(7)
+
+	at, id := utils.ResolveSyncCursor(&since, &afterID, &entity.NotificationDeviceCursor{
+		LastSyncedAt: &storedAt,
+		LastSyncedID: 99,
+	})
+
+	assert.Equal(t, since, at)
+	assert.Equal(t, uint(7), id)
+}
+
+func TestResolveSyncCursor_FallsBackToStoredCursor(t *testing.T) {
+	storedAt := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	at, id := utils.ResolveSyncCursor(nil, nil, &entity.NotificationDeviceCursor{
+		LastSyncedAt: &storedAt,
+		LastSyncedID: 12,
+	})
+
+	assert.Equal(t, storedAt, at)
+	assert.Equal(t, uint(12), id)
+}
+
+func TestResolveSyncCursor_NewDeviceStartsFromBeginning(t *testing.T) {
+	at, id := utils.ResolveSyncCursor(nil, nil, nil)
+
+	assert.True(t, at.IsZero())
+	assert.Equal(t, uint(0), id)
+}
+
+func TestAdvanceSyncCursor(t *testing.T) {
+	since := time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)
+	last := since.Add(time.Minute)
+
+	at, id := utils.AdvanceSyncCursor(since, 3, nil)
+	assert.Equal(t, since, at)
+	assert.Equal(t, uint(3), id)
+
+	at, id = utils.AdvanceSyncCursor(since, 3, []entity.UserNotification{
+		{BaseEntity: db.BaseEntity{ID: 4, UpdatedAt: since.Add(time.Second)}},
+		{BaseEntity: db.BaseEntity{ID: 2, UpdatedAt: last}},
+	})
+	assert.Equal(t, last, at)
+	assert.Equal(t, uint(2), id)
+}
+
+func TestNormalizeSyncLimit(t *testing.T) {
+	assert.Equal(t, constant.DEFAULT_SYNC_PAGE_SIZE, utils.NormalizeSyncLimit(0))
+	assert.Equal(t, 10, utils.NormalizeSyncLimit(10))
+	assert.Equal(t, constant.MAX_SYNC_PAGE_SIZE, utils.NormalizeSyncLimit(1000))
+}
@@ -0,0 +1,52 @@
+package utils_test
+
+import (
+	"testing"
+
+	"ecommerce-be/notification/entity"
+	"ecommerce-be/notification/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderTemplate_EscapesEmailBody(t *testing.T) {
+	subject, body, err := utils.RenderTemplate(
+		entity.NOTIFICATION_CHANNEL_EMAIL,
+		"Hello {{.Name}}",
+		"<p>{{.Name}}</p>",
+		map[string]any{"Name": "<b>Ann</b>"},
+	)
+
+	require.NoError(t, err)
+	assert.Equal(t, "Hello <b>Ann</b>", subject)
+	assert.Equal(t, "<p>&lt;b&gt;Ann&lt;/b&gt;</p>", body)
+}
+
+func TestRenderTemplate_PlainTextChannels(t *testing.T) {
+	_, body, err := utils.RenderTemplate(
+		entity.NOTIFICATION_CHANNEL_SMS,
+		"",
+		"Order {{.OrderNumber}} & more",
+		map[string]any{"OrderNumber": "ORD-1"},
+	)
+
+	require.NoError(t, err)
+	assert.Equal(t, "Order ORD-1 & more", body)
+}
+
+func TestParseTemplate_RejectsInvalidSyntax(t *testing.T) {
+	assert.Error(t, utils.ParseTemplate(entity.NOTIFICATION_CHANNEL_PUSH, "{{.Title", "body"))
+	assert.Error(t, utils.ParseTemplate(entity.NOTIFICATION_CHANNEL_EMAIL, "", "{{if .X}}"))
+	assert.NoError(t, utils.ParseTemplate(entity.NOTIFICATION_CHANNEL_EMAIL, "{{.A}}", "{{.B}}"))
+}
+
+func TestDefaultTemplates_CoverAllEventsAndChannels(t *testing.T) {
+	for _, eventType := range entity.AllNotificationEventTypes() {
+		for _, channel := range entity.AllNotificationChannels() {
+			tmpl, ok := utils.GetDefaultTemplate(eventType, channel)
+			require.True(t, ok, "missing default for %s/%s", eventType, channel)
+			assert.NoError(t, utils.ParseTemplate(channel, tmpl.Subject, tmpl.Body))
+		}
+	}
+}
@@ -0,0 +1,193 @@
+package openapi_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"ecommerce-be/common/constants"
+	"ecommerce-be/common/openapi"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type widgetRequest struct {
+	Name     string  `json:"name"               binding:"required"`
+	Kind     string  `json:"kind"               binding:"omitempty,oneof=small large"`
+	Price    float64 `json:"price"`
+	Internal string  `json:"-"`
+}
+
+type widgetResponse struct {
+	ID   uint   `json:"id"`
+	Name string `json:"name"`
+}
+
+type widgetQuery struct {
+	Page   int    `form:"page"`
+	Search string `form:"search" binding:"required"`
+}
+
+func noop(c *gin.Context) {}
+
+func testAuth() gin.HandlerFunc {
+	return func(c *gin.Context) { c.Next() }
+}
+
+func newTestEngine() *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	openapi.RegisterAuthMiddleware(testAuth, openapi.Auth{
+		Security: []openapi.SecurityRequirement{{openapi.BearerAuthScheme: {}}},
+		Role:     constants.SELLER_ROLE_NAME,
+	})
+
+	router := gin.New()
+	widgets := openapi.NewGroup(router.Group("/api/widget"), "Widgets")
+	widgets.POST("", testAuth(), noop).
+		Summary("Create a widget").
+		Body(widgetRequest{}).
+		ReturnsField(http.StatusCreated, "widget", widgetResponse{})
+	widgets.GET("", noop).
+		Summary("List widgets").
+		Query(widgetQuery{}).
+		Returns(http.StatusOK, []widgetResponse{})
+	widgets.GET("/:widgetId", noop).Summary("Get a widget")
+	widgets.GET("/export", noop).Produces("text/csv")
+
+	router.GET("/api/gadget/:gadgetId", noop)
+	return router
+}
+
+func buildSpec(t *testing.T) map[string]any {
+	router := newTestEngine()
+	raw, err := json.Marshal(openapi.Build(openapi.DefaultInfo, router.Routes()))
+	require.NoError(t, err)
+
+	var doc map[string]any
+	require.NoError(t, json.Unmarshal(raw, &doc))
+	return doc
+}
+
+func dig(t *testing.T, v any, keys ...string) any {
+	t.Helper()
+	for _, key := range keys {
+		m, ok := v.(map[string]any)
+		require.Truef(t, ok, "expected object at %q", key)
+		v, ok = m[key]
+		require.Truef(t, ok, "missing key %q", key)
+	}
+	return v
+}
+
+func TestBuild_ConvertsPathParametersToTemplates(t *testing.T) {
+	doc := buildSpec(t)
+
+	op := dig(t, doc, "paths", "/api/widget/{widgetId}", "get")
+	params := dig(t, op, "parameters").([]any)
+	require.Len(t, params, 1)
+	assert.Equal(t, "widgetId", dig(t, params[0], "name"))
+	assert.Equal(t, "path", dig(t, params[0], "in"))
+	assert.Equal(t, true, dig(t, params[0], "required"))
+	assert.Contains(t, dig(t, op, "responses"), "404")
+}
+
+func TestBuild_DocumentsRequestBodyAndWrappedResponse(t *testing.T) {
+	doc := buildSpec(t)
+	op := dig(t, doc, "paths", "/api/widget", "post")
+
+	body := dig(t, op, "requestBody", "content", "application/json", "schema", "$ref")
+	assert.Equal(t, "#/components/schemas/test.widgetRequest", body)
+
+	request := dig(t, doc, "components", "schemas", "test.widgetRequest")
+	assert.Equal(t, []any{"name"}, dig(t, request, "required"))
+	assert.Equal(t, []any{"small", "large"}, dig(t, request, "properties", "kind", "enum"))
+	assert.NotContains(t, dig(t, request, "properties"), "Internal")
+
+	allOf := dig(t, op, "responses", "201", "content", "application/json", "schema", "allOf")
+	parts := allOf.([]any)
+	require.Len(t, parts, 2)
+	assert.Equal(t, "#/components/schemas/common.Response", dig(t, parts[0], "$ref"))
+	assert.Equal(t,
+		"#/components/schemas/test.widgetResponse",
+		dig(t, parts[1], "properties", "data", "properties", "widget", "$ref"),
+	)
+}
+
+func TestBuild_ExpandsQueryStructIntoParameters(t *testing.T) {
+	doc := buildSpec(t)
+	op := dig(t, doc, "paths", "/api/widget", "get")
+
+	params := map[string]any{}
+	for _, p := range dig(t, op, "parameters").([]any) {
+		params[dig(t, p, "name").(string)] = p
+	}
+	require.Contains(t, params, "page")
+	require.Contains(t, params, "search")
+	assert.Equal(t, "query", dig(t, params["page"], "in"))
+	assert.Equal(t, "integer", dig(t, params["page"], "schema", "type"))
+	assert.Equal(t, true, dig(t, params["search"], "required"))
+
+	data := dig(t, op, "responses", "200", "content", "application/json", "schema", "allOf")
+	assert.Equal(t, "array", dig(t, data.([]any)[1], "properties", "data", "type"))
+}
+
+func TestBuild_DerivesSecurityFromAuthMiddleware(t *testing.T) {
+	doc := buildSpec(t)
+
+	secured := dig(t, doc, "paths", "/api/widget", "post")
+	assert.Equal(t,
+		[]any{map[string]any{openapi.BearerAuthScheme: []any{}}},
+		dig(t, secured, "security"),
+	)
+	assert.Contains(t, dig(t, secured, "description"), constants.SELLER_ROLE_NAME)
+	assert.Contains(t, dig(t, secured, "responses"), "401")
+	assert.Contains(t, dig(t, secured, "responses"), "403")
+
+	public := dig(t, doc, "paths", "/api/widget", "get").(map[string]any)
+	assert.NotContains(t, public, "security")
+	assert.NotContains(t, dig(t, public, "responses"), "401")
+
+	schemes := dig(t, doc, "components", "securitySchemes")
+	assert.Contains(t, schemes, openapi.BearerAuthScheme)
+	assert.Contains(t, schemes, openapi.SellerIDScheme)
+}
+
+func TestBuild_DocumentsNonJSONResponses(t *testing.T) {
+	doc := buildSpec(t)
+
+	content := dig(t, doc, "paths", "/api/widget/export", "get", "responses", "200", "content")
+	assert.Contains(t, content, "text/csv")
+	assert.NotContains(t, content, "application/json")
+}
+
+func TestBuild_ListsUnannotatedRoutes(t *testing.T) {
+	doc := buildSpec(t)
+
+	op := dig(t, doc, "paths", "/api/gadget/{gadgetId}", "get")
+	assert.Equal(t, []any{"gadget"}, dig(t, op, "tags"))
+	assert.Equal(t, "noop", dig(t, op, "operationId"))
+
+	var tags []string
+	for _, tag := range dig(t, doc, "tags").([]any) {
+		tags = append(tags, dig(t, tag, "name").(string))
+	}
+	assert.Equal(t, []string{"Widgets", "gadget"}, tags)
+}
+
+func TestRegisterRoutes_ServesSpec(t *testing.T) {
+	router := newTestEngine()
+	openapi.RegisterRoutes(router)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, constants.APIOpenAPISpec, nil)
+	router.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	var doc map[string]any
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &doc))
+	assert.Equal(t, "3.0.3", doc["openapi"])
+	assert.Contains(t, dig(t, doc, "paths"), constants.APIOpenAPISpec)
+}
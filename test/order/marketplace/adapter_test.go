@@ -0,0 +1,203 @@
+package marketplace_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"ecommerce-be/order/entity"
+	"ecommerce-be/order/service/marketplace"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const amazonPayload = `{
+  "payload": {
+    "Orders": [{
+      "AmazonOrderId": "902-3159896-1390916",
+      "OrderStatus": "Unshipped",
+      "PurchaseDate": "2026-03-01T10:15:00Z",
+      "OrderTotal": {"CurrencyCode": "USD", "Amount": "45.97"},
+      "BuyerInfo": {"BuyerEmail": "buyer@marketplace.amazon.com", "BuyerName": "Ann Lee"},
+      "ShippingAddress": {
+        "Name": "Ann Lee", "AddressLine1": "1 Main St", "AddressLine2": "Apt 2",
+        "City": "Seattle", "StateOrRegion": "WA", "PostalCode": "98101", "CountryCode": "US"
+      },
+      "OrderItems": [{
+        "OrderItemId": "68828574383266", "SellerSKU": "TSHIRT-RED-M", "Title": "T-Shirt",
+        "QuantityOrdered": 2,
+        "ItemPrice": {"CurrencyCode": "USD", "Amount": "39.98"},
+        "ItemTax": {"CurrencyCode": "USD", "Amount": "3.00"},
+        "ShippingPrice": {"CurrencyCode": "USD", "Amount": "4.99"},
+        "PromotionDiscount": {"CurrencyCode": "USD", "Amount": "2.00"}
+      }]
+    }]
+  }
+}`
+
+const ebayPayload = `{
+  "orderId": "12-03456-78901",
+  "creationDate": "2026-03-02T08:00:00.000Z",
+  "cancelStatus": {"cancelState": "NONE_REQUESTED"},
+  "buyer": {"username": "bob_b", "buyerRegistrationAddress": {"fullName": "Bob Brown"}},
+  "pricingSummary": {
+    "priceSubtotal": {"value": "30.00", "currency": "GBP"},
+    "deliveryCost": {"value": "3.50", "currency": "GBP"},
+    "priceDiscount": {"value": "-5.00", "currency": "GBP"},
+    "tax": {"value": "0.00", "currency": "GBP"},
+    "total": {"value": "28.50", "currency": "GBP"}
+  },
+  "fulfillmentStartInstructions": [{"shippingStep": {"shipTo": {
+    "fullName": "Bob Brown",
+    "contactAddress": {"addressLine1": "2 High St", "city": "Leeds",
+      "stateOrProvince": "West Yorkshire", "postalCode": "LS1 1AA", "countryCode": "GB"},
+    "email": "bob@members.ebay.com"
+  }}}],
+  "lineItems": [
+    {"lineItemId": "10001", "sku": "MUG-BLUE", "title": "Mug", "quantity": 3,
+     "lineItemCost": {"value": "30.00", "currency": "GBP"}}
+  ]
+}`
+
+func TestAmazonAdapter_ParsesOrderNotification(t *testing.T) {
+	orders, err := marketplace.NewAmazonAdapter().ParseOrders([]byte(amazonPayload))
+	require.NoError(t, err)
+	require.Len(t, orders, 1)
+
+	order := orders[0]
+	assert.Equal(t, "902-3159896-1390916", order.ExternalOrderID)
+	assert.False(t, order.Cancelled)
+	assert.Equal(t, "USD", order.Currency)
+	assert.Equal(t, "buyer@marketplace.amazon.com", order.Buyer.Email)
+	assert.Equal(t, "US", order.ShippingAddress.CountryCode)
+	assert.Equal(t, int64(3998), order.SubtotalCents)
+	assert.Equal(t, int64(300), order.TaxCents)
+	assert.Equal(t, int64(499), order.ShippingCents)
+	assert.Equal(t, int64(200), order.DiscountCents)
+	assert.Equal(t, int64(4597), order.TotalCents)
+	assert.Equal(t, time.Date(2026, 3, 1, 10, 15, 0, 0, time.UTC), order.PlacedAt)
+
+	require.Len(t, order.Items, 1)
+	assert.Equal(t, marketplace.ExternalOrderItem{
+		ExternalLineID: "68828574383266",
+		SKU:            "TSHIRT-RED-M",
+		Title:          "T-Shirt",
+		Quantity:       2,
+		UnitPriceCents: 1999,
+		LineTotalCents: 3998,
+	}, order.Items[0])
+}
+
+func TestAmazonAdapter_FlagsCancelledOrders(t *testing.T) {
+	orders, err := marketplace.NewAmazonAdapter().ParseOrders(
+		[]byte(`{"AmazonOrderId": "111-1", "OrderStatus": "Canceled"}`),
+	)
+	require.NoError(t, err)
+	require.Len(t, orders, 1)
+	assert.True(t, orders[0].Cancelled)
+	assert.Empty(t, orders[0].Items)
+}
+
+func TestEbayAdapter_ParsesFulfillmentOrder(t *testing.T) {
+	orders, err := marketplace.NewEbayAdapter().ParseOrders([]byte(ebayPayload))
+	require.NoError(t, err)
+	require.Len(t, orders, 1)
+
+	order := orders[0]
+	assert.Equal(t, "12-03456-78901", order.ExternalOrderID)
+	assert.Equal(t, "bob_b", order.Buyer.ExternalID)
+	// Registration email is absent, so the ship-to email is used
+	assert.Equal(t, "bob@members.ebay.com", order.Buyer.Email)
+	assert.Equal(t, "GB", order.ShippingAddress.CountryCode)
+	assert.Equal(t, "GBP", order.Currency)
+	assert.Equal(t, int64(3000), order.SubtotalCents)
+	assert.Equal(t, int64(350), order.ShippingCents)
+	assert.Equal(t, int64(500), order.DiscountCents)
+	assert.Equal(t, int64(2850), order.TotalCents)
+
+	require.Len(t, order.Items, 1)
+	assert.Equal(t, "MUG-BLUE", order.Items[0].SKU)
+	assert.Equal(t, int64(1000), order.Items[0].UnitPriceCents)
+}
+
+func TestAdapters_RejectMalformedPayloads(t *testing.T) {
+	for _, adapter := range []marketplace.Adapter{
+		marketplace.NewAmazonAdapter(),
+		marketplace.NewEbayAdapter(),
+	} {
+		_, err := adapter.ParseOrders([]byte(`{"unexpected": true}`))
+		assert.ErrorIs(t, err, marketplace.ErrMalformedPayload, adapter.Code())
+
+		_, err = adapter.ParseOrders([]byte(`not json`))
+		assert.ErrorIs(t, err, marketplace.ErrMalformedPayload, adapter.Code())
+	}
+}
+
+func TestEbayAdapter_ConfirmShipmentPostsShippingFulfillment(t *testing.T) {
+	var (
+		path    string
+		auth    string
+		payload map[string]any
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		auth = r.Header.Get("Authorization")
+		_ = json.NewDecoder(r.Body).Decode(&payload)
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	err := marketplace.NewEbayAdapter().ConfirmShipment(
+		context.Background(),
+		marketplace.Credentials{AccessToken: "token-1", BaseURL: server.URL},
+		marketplace.ShipmentConfirmation{
+			ExternalOrderID: "12-03456-78901",
+			Carrier:         "ROYAL_MAIL",
+			TrackingNo:      "RM123",
+			ShippedAt:       time.Date(2026, 3, 3, 9, 0, 0, 0, time.UTC),
+			Items: []marketplace.ShipmentConfirmationItem{
+				{ExternalLineID: "10001", Quantity: 3},
+			},
+		},
+	)
+	require.NoError(t, err)
+
+	assert.Equal(t, "/sell/fulfillment/v1/order/12-03456-78901/shipping_fulfillment", path)
+	assert.Equal(t, "Bearer token-1", auth)
+	assert.Equal(t, "RM123", payload["trackingNumber"])
+	assert.Equal(t, "2026-03-03T09:00:00.000Z", payload["shippedDate"])
+	assert.Len(t, payload["lineItems"], 1)
+}
+
+func TestAmazonAdapter_ConfirmShipmentReportsAPIErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "token-2", r.Header.Get("x-amz-access-token"))
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"errors":[{"code":"InvalidInput"}]}`))
+	}))
+	defer server.Close()
+
+	err := marketplace.NewAmazonAdapter().ConfirmShipment(
+		context.Background(),
+		marketplace.Credentials{AccessToken: "token-2", BaseURL: server.URL},
+		marketplace.ShipmentConfirmation{ExternalOrderID: "111-1", ShippedAt: time.Now()},
+	)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "InvalidInput")
+}
+
+func TestRegistry_NormalizesMarketplaceCodes(t *testing.T) {
+	registry := marketplace.NewRegistry()
+	registry.Register(marketplace.NewAmazonAdapter())
+
+	adapter, ok := registry.Get(" Amazon ")
+	require.True(t, ok)
+	assert.Equal(t, entity.MARKETPLACE_AMAZON, adapter.Code())
+
+	_, ok = registry.Get(entity.MARKETPLACE_EBAY)
+	assert.False(t, ok)
+}
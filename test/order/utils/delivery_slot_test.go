@@ -0,0 +1,73 @@
+package utils_test
+
+import (
+	"testing"
+	"time"
+
+	"ecommerce-be/order/entity"
+	orderError "ecommerce-be/order/error"
+	"ecommerce-be/order/model"
+	"ecommerce-be/order/utils"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var now = time.Date(2026, 3, 1, 8, 0, 0, 0, time.UTC)
+
+func slot(startHour, endHour int) model.DeliverySlotRequest {
+	return model.DeliverySlotRequest{
+		Start: time.Date(2026, 3, 2, startHour, 0, 0, 0, time.UTC),
+		End:   time.Date(2026, 3, 2, endHour, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestValidateDeliverySlot(t *testing.T) {
+	assert.NoError(t, utils.ValidateDeliverySlot(entity.DELIVERY, slot(10, 12), now))
+	assert.NoError(t, utils.ValidateDeliverySlot(entity.BOPIS, slot(10, 12), now))
+
+	assert.ErrorIs(t, utils.ValidateDeliverySlot(entity.DIRECTSHIP, slot(10, 12), now),
+		orderError.ErrDeliverySlotNotAllowed)
+	assert.ErrorIs(t, utils.ValidateDeliverySlot(entity.DELIVERY, slot(12, 10), now),
+		orderError.ErrInvalidDeliverySlot)
+	assert.ErrorIs(t, utils.ValidateDeliverySlot(entity.DELIVERY, slot(10, 12), now.AddDate(0, 0, 2)),
+		orderError.ErrInvalidDeliverySlot)
+}
+
+func TestFormatDeliverySlot(t *testing.T) {
+	start := time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, "Mon, 02 Mar 2026 10:00-12:00 UTC",
+		utils.FormatDeliverySlot(start, start.Add(2*time.Hour)))
+	assert.Equal(t, "Mon, 02 Mar 2026 22:00 - Tue, 03 Mar 2026 01:00 UTC",
+		utils.FormatDeliverySlot(start.Add(12*time.Hour), start.Add(15*time.Hour)))
+}
+
+func TestBuildDeliverySlotEvent(t *testing.T) {
+	order := &model.OrderResponse{
+		ID:              42,
+		OrderNumber:     "ORD-1",
+		FulfillmentType: entity.BOPIS,
+		DeliverySlot: &model.DeliverySlotResponse{
+			Start:    time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC),
+			End:      time.Date(2026, 3, 2, 12, 0, 0, 0, time.UTC),
+			Sequence: 1,
+		},
+		Addresses: []model.OrderAddressResponse{
+			{Type: entity.ORDER_ADDR_BILLING, Address: "9 Other Rd"},
+			{Type: entity.ORDER_ADDR_SHIPPING, Address: "1 Main St", City: "Pune", ZipCode: "411001"},
+		},
+	}
+
+	event := utils.BuildDeliverySlotEvent(order, false)
+	assert.Equal(t, "order-42-delivery-slot@ecommerce-be", event.UID)
+	assert.Equal(t, 1, event.Sequence)
+	assert.Equal(t, "Pickup of order ORD-1", event.Summary)
+	assert.Equal(t, "1 Main St, Pune, 411001", event.Location)
+	assert.False(t, event.Cancelled)
+
+	// Cancelling supersedes the last invite with the same UID
+	cancelled := utils.BuildDeliverySlotEvent(order, true)
+	assert.Equal(t, event.UID, cancelled.UID)
+	assert.Equal(t, 2, cancelled.Sequence)
+	assert.True(t, cancelled.Cancelled)
+}
@@ -0,0 +1,57 @@
+package utils_test
+
+import (
+	"strings"
+	"testing"
+
+	"ecommerce-be/order/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrderAccessSigner_RoundTrip(t *testing.T) {
+	signer := utils.NewOrderAccessSigner("secret")
+
+	token, err := signer.Sign(42, "  Guest@Example.com ")
+	require.NoError(t, err)
+	orderID, email, ok := signer.Verify(token)
+	assert.True(t, ok)
+	assert.Equal(t, uint(42), orderID)
+	// Emails are bound lower-cased so lookups match however the guest typed it
+	assert.Equal(t, "guest@example.com", email)
+}
+
+func TestOrderAccessSigner_RejectsTamperedToken(t *testing.T) {
+	signer := utils.NewOrderAccessSigner("secret")
+	token, err := signer.Sign(42, "guest@example.com")
+	require.NoError(t, err)
+	otherToken, err := signer.Sign(43, "guest@example.com")
+	require.NoError(t, err)
+
+	payload, mac, _ := strings.Cut(token, ".")
+	other, _, _ := strings.Cut(otherToken, ".")
+
+	for _, tampered := range []string{
+		other + "." + mac,
+		payload + "." + mac[:len(mac)-2],
+		payload,
+		"",
+	} {
+		_, _, ok := signer.Verify(tampered)
+		assert.False(t, ok, tampered)
+	}
+}
+
+func TestOrderAccessSigner_RejectsOtherSecret(t *testing.T) {
+	token, err := utils.NewOrderAccessSigner("secret").Sign(42, "guest@example.com")
+	require.NoError(t, err)
+
+	_, _, ok := utils.NewOrderAccessSigner("other").Verify(token)
+	assert.False(t, ok)
+}
+
+func TestOrderAccessSigner_RefusesEmptySecret(t *testing.T) {
+	_, err := utils.NewOrderAccessSigner("").Sign(42, "guest@example.com")
+	assert.Error(t, err)
+}
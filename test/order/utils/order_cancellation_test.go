@@ -0,0 +1,95 @@
+package utils_test
+
+import (
+	"testing"
+	"time"
+
+	"ecommerce-be/order/entity"
+	"ecommerce-be/order/utils"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCancellationWindowOpen(t *testing.T) {
+	placedAt := now.Add(-30 * time.Minute)
+
+	// No window: customers may cancel until the order is fulfilled
+	assert.True(t, utils.CancellationWindowOpen(placedAt.Add(-72*time.Hour), 0, now))
+
+	assert.True(t, utils.CancellationWindowOpen(placedAt, 60, now))
+	assert.True(t, utils.CancellationWindowOpen(placedAt, 30, now))
+	assert.False(t, utils.CancellationWindowOpen(placedAt, 29, now))
+}
+
+func TestOrderPlacedAt(t *testing.T) {
+	order := &entity.Order{}
+	order.CreatedAt = now
+	assert.Equal(t, now, utils.OrderPlacedAt(order))
+
+	placedAt := now.Add(time.Hour)
+	order.PlacedAt = &placedAt
+	assert.Equal(t, placedAt, utils.OrderPlacedAt(order))
+}
+
+func TestBuildCancellationRefunds_FullPayment(t *testing.T) {
+	order := &entity.Order{
+		PaymentPlan:   entity.PAYMENT_PLAN_FULL,
+		TotalCents:    5000,
+		TransactionID: "txn_1",
+	}
+	// Not paid yet
+	assert.Empty(t, utils.BuildCancellationRefunds(order))
+
+	order.PaidAt = &now
+	assert.Equal(t,
+		[]utils.CancellationRefund{{PaymentReference: "txn_1", AmountCents: 5000}},
+		utils.BuildCancellationRefunds(order),
+	)
+
+	// Paid offline without a transaction ID
+	order.TransactionID = " "
+	assert.Empty(t, utils.BuildCancellationRefunds(order))
+}
+
+func TestBuildCancellationRefunds_Deposit(t *testing.T) {
+	balanceTxn := "txn_balance"
+	order := &entity.Order{
+		PaymentPlan:   entity.PAYMENT_PLAN_DEPOSIT,
+		TotalCents:    10000,
+		TransactionID: "txn_deposit",
+		PaymentInstallments: []entity.OrderPaymentInstallment{
+			{
+				Kind:        entity.INSTALLMENT_DEPOSIT,
+				AmountCents: 2000,
+				Status:      entity.INSTALLMENT_STATUS_PAID,
+			},
+			{
+				Kind:        entity.INSTALLMENT_BALANCE,
+				AmountCents: 8000,
+				Status:      entity.INSTALLMENT_STATUS_PENDING,
+			},
+		},
+	}
+	// Only the paid deposit is refunded, under the order's transaction ID
+	assert.Equal(t,
+		[]utils.CancellationRefund{{PaymentReference: "txn_deposit", AmountCents: 2000}},
+		utils.BuildCancellationRefunds(order),
+	)
+
+	order.PaymentInstallments[1].Status = entity.INSTALLMENT_STATUS_PAID
+	order.PaymentInstallments[1].TransactionID = &balanceTxn
+	assert.Equal(t,
+		[]utils.CancellationRefund{
+			{PaymentReference: "txn_deposit", AmountCents: 2000},
+			{PaymentReference: "txn_balance", AmountCents: 8000},
+		},
+		utils.BuildCancellationRefunds(order),
+	)
+
+	// Both installments paid in one transaction are refunded together
+	order.PaymentInstallments[1].TransactionID = &order.TransactionID
+	assert.Equal(t,
+		[]utils.CancellationRefund{{PaymentReference: "txn_deposit", AmountCents: 10000}},
+		utils.BuildCancellationRefunds(order),
+	)
+}
@@ -0,0 +1,32 @@
+package utils_test
+
+import (
+	"testing"
+
+	"ecommerce-be/order/entity"
+	"ecommerce-be/order/utils"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInstallmentCommissionCents(t *testing.T) {
+	order := &entity.Order{
+		TotalCents: 10000,
+		Items: []entity.OrderItem{
+			{CommissionCents: 700},
+			{CommissionCents: 301},
+		},
+		PaymentInstallments: []entity.OrderPaymentInstallment{
+			{Kind: entity.INSTALLMENT_DEPOSIT, AmountCents: 3000},
+			{Kind: entity.INSTALLMENT_BALANCE, AmountCents: 7000},
+		},
+	}
+
+	assert.Equal(t, int64(1001), utils.OrderCommissionCents(order))
+
+	deposit := utils.InstallmentCommissionCents(order, &order.PaymentInstallments[0])
+	balance := utils.InstallmentCommissionCents(order, &order.PaymentInstallments[1])
+	assert.Equal(t, int64(300), deposit)
+	assert.Equal(t, int64(701), balance)
+	assert.Equal(t, utils.OrderCommissionCents(order), deposit+balance)
+}
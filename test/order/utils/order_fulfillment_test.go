@@ -0,0 +1,86 @@
+package utils_test
+
+import (
+	"testing"
+
+	commonError "ecommerce-be/common/error"
+	"ecommerce-be/order/entity"
+	orderError "ecommerce-be/order/error"
+	"ecommerce-be/order/model"
+	"ecommerce-be/order/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func orderItem(id uint, quantity, fulfilled int) entity.OrderItem {
+	item := entity.OrderItem{Quantity: quantity, FulfilledQuantity: fulfilled}
+	item.ID = id
+	return item
+}
+
+func TestResolveFulfillmentLines_MergesAndChecksRemaining(t *testing.T) {
+	items := []entity.OrderItem{orderItem(1, 3, 1), orderItem(2, 2, 0)}
+
+	lines, err := utils.ResolveFulfillmentLines(items, []model.FulfillmentLine{
+		{OrderItemID: 2, Quantity: 1},
+		{OrderItemID: 1, Quantity: 1},
+		{OrderItemID: 1, Quantity: 1},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []model.FulfillmentLine{
+		{OrderItemID: 2, Quantity: 1},
+		{OrderItemID: 1, Quantity: 2},
+	}, lines)
+
+	// Only two units of item 1 are left once one has shipped
+	_, err = utils.ResolveFulfillmentLines(items, []model.FulfillmentLine{
+		{OrderItemID: 1, Quantity: 2},
+		{OrderItemID: 1, Quantity: 1},
+	})
+	require.Error(t, err)
+	assert.Equal(t,
+		orderError.ORDER_FULFILLMENT_EXCEEDS_ORDERED_CODE,
+		err.(*commonError.AppError).Code,
+	)
+
+	_, err = utils.ResolveFulfillmentLines(items, []model.FulfillmentLine{
+		{OrderItemID: 9, Quantity: 1},
+	})
+	require.Error(t, err)
+	assert.Equal(t,
+		orderError.ORDER_INVALID_FULFILLMENT_ITEM_CODE,
+		err.(*commonError.AppError).Code,
+	)
+}
+
+func TestResolveFulfillmentLines_DefaultsToEverythingLeft(t *testing.T) {
+	items := []entity.OrderItem{orderItem(2, 2, 2), orderItem(1, 3, 1)}
+
+	lines, err := utils.ResolveFulfillmentLines(items, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []model.FulfillmentLine{{OrderItemID: 1, Quantity: 2}}, lines)
+
+	items[1].FulfilledQuantity = 3
+	_, err = utils.ResolveFulfillmentLines(items, nil)
+	assert.ErrorIs(t, err, orderError.ErrOrderAlreadyFulfilled)
+}
+
+func TestApplyFulfillmentLinesAndDeriveStatus(t *testing.T) {
+	items := []entity.OrderItem{orderItem(1, 3, 0), orderItem(2, 2, 0)}
+	assert.Equal(t, entity.FULFILLMENT_STATUS_UNFULFILLED, utils.DeriveFulfillmentStatus(items))
+
+	changed := utils.ApplyFulfillmentLines(items, []model.FulfillmentLine{
+		{OrderItemID: 1, Quantity: 2},
+	})
+	assert.Equal(t, []int{0}, changed)
+	assert.Equal(t, 2, items[0].FulfilledQuantity)
+	assert.Equal(t, 1, utils.RemainingQuantity(items[0]))
+	assert.Equal(t, entity.FULFILLMENT_STATUS_PARTIAL, utils.DeriveFulfillmentStatus(items))
+
+	utils.ApplyFulfillmentLines(items, []model.FulfillmentLine{
+		{OrderItemID: 1, Quantity: 1},
+		{OrderItemID: 2, Quantity: 2},
+	})
+	assert.Equal(t, entity.FULFILLMENT_STATUS_FULFILLED, utils.DeriveFulfillmentStatus(items))
+}
@@ -0,0 +1,73 @@
+package utils_test
+
+import (
+	"testing"
+	"time"
+
+	"ecommerce-be/order/entity"
+	orderError "ecommerce-be/order/error"
+	"ecommerce-be/order/model"
+	"ecommerce-be/order/utils"
+	"ecommerce-be/order/utils/constant"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateOrderMessage(t *testing.T) {
+	assert.NoError(t, utils.ValidateOrderMessage("Where is my parcel?", nil))
+	assert.NoError(t, utils.ValidateOrderMessage("", []string{"file-1"}))
+
+	assert.ErrorIs(t, utils.ValidateOrderMessage("  \n ", nil), orderError.ErrOrderMessageEmpty)
+	assert.ErrorIs(t,
+		utils.ValidateOrderMessage("hi", []string{"1", "2", "3", "4", "5", "6"}),
+		orderError.ErrOrderMessageTooManyAttachments)
+}
+
+func TestValidateOrderMessageAttachment(t *testing.T) {
+	assert.NoError(t, utils.ValidateOrderMessageAttachment("application/pdf", 1024))
+	assert.NoError(t, utils.ValidateOrderMessageAttachment("IMAGE/PNG", 1024))
+
+	assert.ErrorIs(t, utils.ValidateOrderMessageAttachment("image/gif", 1024),
+		orderError.ErrInvalidOrderMessageAttachment)
+	assert.ErrorIs(t,
+		utils.ValidateOrderMessageAttachment(
+			"application/pdf",
+			constant.ORDER_MESSAGE_ATTACHMENT_MAX_SIZE_BYTES+1,
+		),
+		orderError.ErrInvalidOrderMessageAttachment)
+}
+
+func TestIsCustomerSide(t *testing.T) {
+	assert.True(t, utils.IsCustomerSide("customer"))
+	assert.False(t, utils.IsCustomerSide("SELLER"))
+	assert.False(t, utils.IsCustomerSide("ADMIN"))
+}
+
+func TestMessagePreview(t *testing.T) {
+	assert.Equal(t, "Hello there", utils.MessagePreview("  Hello \n there ", 20))
+	assert.Equal(t, "Héllo…", utils.MessagePreview("Héllo wörld", 6))
+	assert.Equal(t, "", utils.MessagePreview("", 10))
+}
+
+func TestMergeOrderTimeline(t *testing.T) {
+	base := time.Date(2026, 3, 1, 8, 0, 0, 0, time.UTC)
+	history := []entity.OrderHistory{
+		{ToStatus: "pending", CreatedAt: base},
+		{ToStatus: "confirmed", CreatedAt: base.Add(2 * time.Hour)},
+	}
+	messages := []model.OrderMessageResponse{
+		{ID: 1, CreatedAt: base.Add(time.Hour)},
+		{ID: 2, CreatedAt: base.Add(2 * time.Hour)},
+	}
+
+	entries := utils.MergeOrderTimeline(history, messages)
+
+	require.Len(t, entries, 4)
+	assert.Equal(t, "pending", entries[0].Status.ToStatus)
+	assert.Equal(t, uint(1), entries[1].Message.ID)
+	assert.Equal(t, constant.ORDER_TIMELINE_STATUS, entries[2].Type)
+	assert.Equal(t, "confirmed", entries[2].Status.ToStatus)
+	assert.Equal(t, constant.ORDER_TIMELINE_MESSAGE, entries[3].Type)
+	assert.Equal(t, uint(2), entries[3].Message.ID)
+}
@@ -0,0 +1,66 @@
+package utils_test
+
+import (
+	"testing"
+	"time"
+
+	"ecommerce-be/order/entity"
+	orderError "ecommerce-be/order/error"
+	"ecommerce-be/order/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDepositCents(t *testing.T) {
+	assert.Equal(t, int64(2000), utils.DepositCents(10000, 20))
+	// Rounded up to the next cent
+	assert.Equal(t, int64(2), utils.DepositCents(150, 1))
+	assert.Equal(t, int64(200), utils.DepositCents(999, 20))
+	// Always leaves a balance
+	assert.Equal(t, int64(1), utils.DepositCents(2, 99))
+}
+
+func TestBuildPaymentSchedule_BeforeFulfillment(t *testing.T) {
+	schedule, err := utils.BuildPaymentSchedule(
+		42, 10000, 20, entity.BALANCE_BEFORE_FULFILLMENT, now, 7*24*time.Hour)
+	require.NoError(t, err)
+	require.Len(t, schedule, 2)
+
+	deposit := utils.FindInstallment(schedule, entity.INSTALLMENT_DEPOSIT)
+	require.NotNil(t, deposit)
+	assert.Equal(t, uint(42), deposit.OrderID)
+	assert.Equal(t, int64(2000), deposit.AmountCents)
+	assert.Equal(t, now, *deposit.DueAt)
+
+	balance := utils.FindInstallment(schedule, entity.INSTALLMENT_BALANCE)
+	require.NotNil(t, balance)
+	assert.Equal(t, int64(8000), balance.AmountCents)
+	assert.Equal(t, now.AddDate(0, 0, 7), *balance.DueAt)
+	assert.Equal(t, entity.INSTALLMENT_STATUS_PENDING, balance.Status)
+
+	assert.Equal(t, int64(10000), utils.OutstandingBalanceCents(schedule))
+	deposit.Status = entity.INSTALLMENT_STATUS_PAID
+	assert.Equal(t, int64(8000), utils.OutstandingBalanceCents(schedule))
+}
+
+func TestBuildPaymentSchedule_OnDeliveryHasNoDueDate(t *testing.T) {
+	schedule, err := utils.BuildPaymentSchedule(
+		42, 10000, 20, entity.BALANCE_ON_DELIVERY, now, 7*24*time.Hour)
+	require.NoError(t, err)
+
+	assert.Nil(t, utils.FindInstallment(schedule, entity.INSTALLMENT_BALANCE).DueAt)
+}
+
+func TestBuildPaymentSchedule_Rejects(t *testing.T) {
+	_, err := utils.BuildPaymentSchedule(42, 1, 20, entity.BALANCE_ON_DELIVERY, now, time.Hour)
+	assert.ErrorIs(t, err, orderError.ErrDepositNotAvailable)
+
+	_, err = utils.BuildPaymentSchedule(42, 10000, 20, "later", now, time.Hour)
+	assert.ErrorIs(t, err, orderError.ErrInvalidPaymentPlan)
+}
+
+func TestFindInstallment_FullPaymentOrder(t *testing.T) {
+	assert.Nil(t, utils.FindInstallment(nil, entity.INSTALLMENT_BALANCE))
+	assert.Zero(t, utils.OutstandingBalanceCents(nil))
+}
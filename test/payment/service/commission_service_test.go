@@ -0,0 +1,144 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"ecommerce-be/common/db"
+	"ecommerce-be/payment/entity"
+	"ecommerce-be/payment/model"
+	"ecommerce-be/payment/repository"
+	"ecommerce-be/payment/service"
+	"ecommerce-be/product/mapper"
+	productService "ecommerce-be/product/service"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeCommissionRuleRepository struct {
+	repository.CommissionRuleRepository
+	rules []entity.CommissionRule
+}
+
+func (r *fakeCommissionRuleRepository) FindApplicable(
+	_ context.Context,
+	_ uint,
+	_ []uint,
+) ([]entity.CommissionRule, error) {
+	return r.rules, nil
+}
+
+type fakeVariantQueryService struct {
+	productService.VariantQueryService
+	categories map[uint]uint
+}
+
+func (s *fakeVariantQueryService) GetProductBasicInfoByVariantIDs(
+	_ context.Context,
+	variantIDs []uint,
+	_ *uint,
+) ([]mapper.VariantBasicInfoRow, error) {
+	rows := make([]mapper.VariantBasicInfoRow, 0, len(variantIDs))
+	for _, id := range variantIDs {
+		rows = append(rows, mapper.VariantBasicInfoRow{VariantID: id, CategoryID: s.categories[id]})
+	}
+	return rows, nil
+}
+
+func newCommissionService(rules []entity.CommissionRule) service.CommissionService {
+	return service.NewCommissionService(
+		&fakeCommissionRuleRepository{rules: rules},
+		&fakeVariantQueryService{categories: map[uint]uint{1: 10, 2: 20}},
+		1000,
+	)
+}
+
+func TestCalculateCommissions_ChargesDefaultOnUndiscountedLines(t *testing.T) {
+	svc := newCommissionService(nil)
+
+	commissions, err := svc.CalculateCommissions(context.Background(), 7, []model.CommissionLine{
+		{VariantID: 1, Quantity: 2, LineTotalCents: 6000},
+		{VariantID: 2, Quantity: 1, LineTotalCents: 4000},
+	}, 0)
+
+	require.NoError(t, err)
+	require.Len(t, commissions, 2)
+	assert.Equal(t, int64(600), commissions[0].CommissionCents)
+	assert.Equal(t, int64(400), commissions[1].CommissionCents)
+	assert.Nil(t, commissions[0].RuleID)
+}
+
+func TestCalculateCommissions_ChargesOnLinesAfterOrderDiscount(t *testing.T) {
+	categoryID := uint(20)
+	svc := newCommissionService([]entity.CommissionRule{{
+		BaseEntity:      db.BaseEntity{ID: 3},
+		CategoryID:      &categoryID,
+		RateType:        entity.CommissionRatePercentage,
+		RateBasisPoints: 2000,
+		IsActive:        true,
+	}})
+
+	// A 2500 cent coupon on a 10000 cent order takes 1500 off the first line and 1000
+	// off the second
+	commissions, err := svc.CalculateCommissions(context.Background(), 7, []model.CommissionLine{
+		{VariantID: 1, Quantity: 2, LineTotalCents: 6000},
+		{VariantID: 2, Quantity: 1, LineTotalCents: 4000},
+	}, 2500)
+
+	require.NoError(t, err)
+	require.Len(t, commissions, 2)
+	assert.Equal(t, int64(450), commissions[0].CommissionCents)
+	assert.Equal(t, int64(600), commissions[1].CommissionCents)
+	require.NotNil(t, commissions[1].RuleID)
+	assert.Equal(t, uint(3), *commissions[1].RuleID)
+}
+
+func TestCalculateCommissions_LinePromotionStaysOnItsLine(t *testing.T) {
+	svc := newCommissionService(nil)
+
+	// A 2000 cent promotion on the first line is the order's whole discount; the second
+	// line keeps its full total
+	commissions, err := svc.CalculateCommissions(context.Background(), 7, []model.CommissionLine{
+		{VariantID: 1, Quantity: 2, LineTotalCents: 6000, DiscountCents: 2000},
+		{VariantID: 2, Quantity: 1, LineTotalCents: 4000},
+	}, 2000)
+
+	require.NoError(t, err)
+	require.Len(t, commissions, 2)
+	assert.Equal(t, int64(400), commissions[0].CommissionCents)
+	assert.Equal(t, int64(400), commissions[1].CommissionCents)
+}
+
+func TestCalculateCommissions_SpreadsOnlyOrderLevelRemainder(t *testing.T) {
+	svc := newCommissionService(nil)
+
+	// Of a 3000 cent discount, 2000 is the first line's promotion; the 1000 cent coupon
+	// left is spread over the discounted lines, 500 on each
+	commissions, err := svc.CalculateCommissions(context.Background(), 7, []model.CommissionLine{
+		{VariantID: 1, Quantity: 2, LineTotalCents: 6000, DiscountCents: 2000},
+		{VariantID: 2, Quantity: 1, LineTotalCents: 4000},
+	}, 3000)
+
+	require.NoError(t, err)
+	require.Len(t, commissions, 2)
+	assert.Equal(t, int64(350), commissions[0].CommissionCents)
+	assert.Equal(t, int64(350), commissions[1].CommissionCents)
+}
+
+func TestCalculateCommissions_FlatRuleCappedAtDiscountedLine(t *testing.T) {
+	svc := newCommissionService([]entity.CommissionRule{{
+		BaseEntity: db.BaseEntity{ID: 4},
+		RateType:   entity.CommissionRateFlat,
+		FlatCents:  500,
+		IsActive:   true,
+	}})
+
+	commissions, err := svc.CalculateCommissions(context.Background(), 7, []model.CommissionLine{
+		{VariantID: 1, Quantity: 2, LineTotalCents: 1000},
+	}, 800)
+
+	require.NoError(t, err)
+	require.Len(t, commissions, 1)
+	assert.Equal(t, int64(200), commissions[0].CommissionCents)
+}
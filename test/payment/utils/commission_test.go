@@ -0,0 +1,105 @@
+package utils_test
+
+import (
+	"testing"
+
+	"ecommerce-be/payment/entity"
+	"ecommerce-be/payment/model"
+	"ecommerce-be/payment/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func uintPtr(v uint) *uint {
+	return &v
+}
+
+func TestMatchCommissionRule_MostSpecificWins(t *testing.T) {
+	rules := []entity.CommissionRule{
+		{RateType: entity.CommissionRatePercentage, RateBasisPoints: 1000, IsActive: true},
+		{CategoryID: uintPtr(5), RateType: entity.CommissionRatePercentage,
+			RateBasisPoints: 800, IsActive: true},
+		{SellerID: uintPtr(7), RateType: entity.CommissionRatePercentage,
+			RateBasisPoints: 500, IsActive: true},
+		{SellerID: uintPtr(7), CategoryID: uintPtr(5), RateType: entity.CommissionRateFlat,
+			FlatCents: 50, IsActive: true},
+		{SellerID: uintPtr(8), RateType: entity.CommissionRatePercentage,
+			RateBasisPoints: 200, IsActive: false},
+	}
+
+	match := utils.MatchCommissionRule(rules, 7, 5)
+	require.NotNil(t, match)
+	assert.Equal(t, entity.CommissionRateFlat, match.RateType)
+
+	match = utils.MatchCommissionRule(rules, 7, 9)
+	require.NotNil(t, match)
+	assert.Equal(t, 500, match.RateBasisPoints)
+
+	match = utils.MatchCommissionRule(rules, 3, 5)
+	require.NotNil(t, match)
+	assert.Equal(t, 800, match.RateBasisPoints)
+
+	// The inactive seller override falls back to the global default
+	match = utils.MatchCommissionRule(rules, 8, 9)
+	require.NotNil(t, match)
+	assert.Equal(t, 1000, match.RateBasisPoints)
+
+	assert.Nil(t, utils.MatchCommissionRule(rules[1:3], 3, 9))
+}
+
+func TestLineCommissionCents(t *testing.T) {
+	percentage := entity.CommissionRule{
+		RateType:        entity.CommissionRatePercentage,
+		RateBasisPoints: 1250,
+	}
+	assert.Equal(t, int64(500), utils.LineCommissionCents(percentage, 2, 4000))
+
+	flat := entity.CommissionRule{RateType: entity.CommissionRateFlat, FlatCents: 150}
+	assert.Equal(t, int64(450), utils.LineCommissionCents(flat, 3, 4000))
+	// Never more than the line itself
+	assert.Equal(t, int64(200), utils.LineCommissionCents(flat, 3, 200))
+	assert.Equal(t, int64(0), utils.LineCommissionCents(flat, 3, 0))
+}
+
+func TestSpreadDiscountCents(t *testing.T) {
+	tests := []struct {
+		name       string
+		lineTotals []int64
+		discount   int64
+		want       []int64
+	}{
+		{"proportional", []int64{6000, 4000}, 1000, []int64{600, 400}},
+		{"rounding goes to largest remainder", []int64{100, 100, 100}, 100, []int64{34, 33, 33}},
+		{"no discount", []int64{500, 500}, 0, []int64{0, 0}},
+		{"capped at the lines", []int64{300, 200}, 900, []int64{300, 200}},
+		{"empty lines skipped", []int64{0, 1000}, 250, []int64{0, 250}},
+		{"no lines", nil, 100, []int64{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := utils.SpreadDiscountCents(tt.lineTotals, tt.discount)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestValidateCommissionRule(t *testing.T) {
+	assert.NoError(t, utils.ValidateCommissionRule(model.CommissionRuleRequest{
+		RateType:        "percentage",
+		RateBasisPoints: 1000,
+	}))
+	assert.NoError(t, utils.ValidateCommissionRule(model.CommissionRuleRequest{
+		RateType:  "flat",
+		FlatCents: 99,
+	}))
+	assert.Error(t, utils.ValidateCommissionRule(model.CommissionRuleRequest{
+		RateType:        "flat",
+		FlatCents:       99,
+		RateBasisPoints: 1000,
+	}))
+	assert.Error(t, utils.ValidateCommissionRule(model.CommissionRuleRequest{
+		RateType:  "percentage",
+		FlatCents: 99,
+	}))
+}
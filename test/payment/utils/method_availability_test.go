@@ -0,0 +1,143 @@
+package utils_test
+
+import (
+	"testing"
+
+	"ecommerce-be/common/db"
+	"ecommerce-be/payment/entity"
+	"ecommerce-be/payment/utils"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func gateway(code string, countries, currencies, methods []string) entity.PaymentGateway {
+	return entity.PaymentGateway{
+		Code:                    code,
+		SupportedCountries:      db.StringArray(countries),
+		SupportedCurrencies:     db.StringArray(currencies),
+		SupportedPaymentMethods: db.StringArray(methods),
+	}
+}
+
+func int64Ptr(v int64) *int64 {
+	return &v
+}
+
+func methodsOf(available []utils.AvailableMethod) []entity.PaymentMethodType {
+	out := make([]entity.PaymentMethodType, 0, len(available))
+	for _, m := range available {
+		out = append(out, m.Method)
+	}
+	return out
+}
+
+func TestResolveAvailableMethods_FiltersByCurrencyAndCountry(t *testing.T) {
+	in := utils.MethodAvailabilityInput{
+		Currency:        "inr",
+		AmountCents:     50000,
+		CustomerCountry: "IN",
+		SellerCountry:   "IN",
+		Gateways: []entity.PaymentGateway{
+			gateway("razorpay", []string{"IN"}, []string{"INR"}, []string{"card", "upi"}),
+			gateway("stripe", nil, []string{"USD", "INR"}, []string{"card", "wallet"}),
+			gateway("paypal", []string{"US"}, []string{"INR"}, []string{"wallet"}),
+			gateway("adyen", nil, []string{"EUR"}, []string{"bank_transfer"}),
+		},
+	}
+
+	available := utils.ResolveAvailableMethods(in)
+
+	assert.Equal(t, []entity.PaymentMethodType{
+		entity.PaymentMethodTypeCard,
+		entity.PaymentMethodTypeUPI,
+		entity.PaymentMethodTypeWallet,
+	}, methodsOf(available))
+	assert.Equal(t, []string{"razorpay", "stripe"}, available[0].GatewayCodes)
+	assert.Equal(t, []string{"stripe"}, available[2].GatewayCodes)
+}
+
+func TestResolveAvailableMethods_CODIsDomesticOnly(t *testing.T) {
+	rules := []entity.PaymentMethodRule{
+		{Method: entity.PaymentMethodTypeCOD, IsEnabled: true},
+	}
+
+	domestic := utils.ResolveAvailableMethods(utils.MethodAvailabilityInput{
+		Currency:        "INR",
+		AmountCents:     1000,
+		CustomerCountry: "IN",
+		SellerCountry:   "IN",
+		Rules:           rules,
+	})
+	assert.Equal(t, []entity.PaymentMethodType{entity.PaymentMethodTypeCOD}, methodsOf(domestic))
+	assert.Empty(t, domestic[0].GatewayCodes)
+
+	crossBorder := utils.ResolveAvailableMethods(utils.MethodAvailabilityInput{
+		Currency:        "INR",
+		AmountCents:     1000,
+		CustomerCountry: "US",
+		SellerCountry:   "IN",
+		Rules:           rules,
+	})
+	assert.Empty(t, crossBorder)
+
+	notEnabled := utils.ResolveAvailableMethods(utils.MethodAvailabilityInput{
+		Currency:        "INR",
+		AmountCents:     1000,
+		CustomerCountry: "IN",
+		SellerCountry:   "IN",
+	})
+	assert.Empty(t, notEnabled)
+}
+
+func TestResolveAvailableMethods_BNPLLimits(t *testing.T) {
+	gateways := []entity.PaymentGateway{
+		gateway("klarna", nil, []string{"USD"}, []string{"card", "bnpl"}),
+	}
+
+	belowDefault := utils.ResolveAvailableMethods(utils.MethodAvailabilityInput{
+		Currency:    "USD",
+		AmountCents: 500,
+		Gateways:    gateways,
+	})
+	assert.Equal(
+		t,
+		[]entity.PaymentMethodType{entity.PaymentMethodTypeCard},
+		methodsOf(belowDefault),
+	)
+
+	withinDefault := utils.ResolveAvailableMethods(utils.MethodAvailabilityInput{
+		Currency:    "USD",
+		AmountCents: 50000,
+		Gateways:    gateways,
+	})
+	assert.Contains(t, methodsOf(withinDefault), entity.PaymentMethodTypeBNPL)
+
+	sellerCapped := utils.ResolveAvailableMethods(utils.MethodAvailabilityInput{
+		Currency:    "USD",
+		AmountCents: 50000,
+		Gateways:    gateways,
+		Rules: []entity.PaymentMethodRule{
+			{
+				Method:         entity.PaymentMethodTypeBNPL,
+				IsEnabled:      true,
+				MaxAmountCents: int64Ptr(20000),
+			},
+		},
+	})
+	assert.NotContains(t, methodsOf(sellerCapped), entity.PaymentMethodTypeBNPL)
+}
+
+func TestResolveAvailableMethods_DisabledRule(t *testing.T) {
+	available := utils.ResolveAvailableMethods(utils.MethodAvailabilityInput{
+		Currency:    "USD",
+		AmountCents: 1000,
+		Gateways: []entity.PaymentGateway{
+			gateway("stripe", nil, []string{"USD"}, []string{"card", "wallet"}),
+		},
+		Rules: []entity.PaymentMethodRule{
+			{Method: entity.PaymentMethodTypeWallet, IsEnabled: false},
+		},
+	})
+
+	assert.Equal(t, []entity.PaymentMethodType{entity.PaymentMethodTypeCard}, methodsOf(available))
+}
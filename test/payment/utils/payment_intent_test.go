@@ -0,0 +1,18 @@
+package utils_test
+
+import (
+	"strings"
+	"testing"
+
+	"ecommerce-be/payment/utils"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerateTransactionID(t *testing.T) {
+	first := utils.GenerateTransactionID()
+	assert.True(t, strings.HasPrefix(first, "TXN-"))
+	// payment_transaction.transaction_id is VARCHAR(50)
+	assert.LessOrEqual(t, len(first), 50)
+	assert.NotEqual(t, first, utils.GenerateTransactionID())
+}
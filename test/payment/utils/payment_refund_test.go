@@ -0,0 +1,68 @@
+package utils_test
+
+import (
+	"strings"
+	"testing"
+
+	"ecommerce-be/payment/entity"
+	"ecommerce-be/payment/utils"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerateRefundID(t *testing.T) {
+	first := utils.GenerateRefundID()
+	assert.True(t, strings.HasPrefix(first, "RFD-"))
+	assert.LessOrEqual(t, len(first), 50)
+	assert.NotEqual(t, first, utils.GenerateRefundID())
+}
+
+func TestIsRefundable(t *testing.T) {
+	gatewayID := uint(1)
+	transaction := entity.PaymentTransaction{
+		GatewayID:            &gatewayID,
+		GatewayTransactionID: "cf_123",
+		Status:               entity.TransactionStatusCompleted,
+	}
+	assert.True(t, utils.IsRefundable(transaction))
+
+	transaction.Status = entity.TransactionStatusPartiallyRefunded
+	assert.True(t, utils.IsRefundable(transaction))
+
+	transaction.Status = entity.TransactionStatusRefunded
+	assert.False(t, utils.IsRefundable(transaction))
+
+	transaction.Status = entity.TransactionStatusPending
+	assert.False(t, utils.IsRefundable(transaction))
+
+	// Cash on delivery has no gateway
+	transaction.Status = entity.TransactionStatusCompleted
+	transaction.GatewayID = nil
+	assert.False(t, utils.IsRefundable(transaction))
+}
+
+func TestRefundableCents(t *testing.T) {
+	assert.Equal(t, int64(5000), utils.RefundableCents(5000, nil))
+
+	refunds := []entity.PaymentRefund{
+		{AmountCents: 1000, Status: entity.RefundStatusCompleted},
+		{AmountCents: 1500, Status: entity.RefundStatusPending},
+		{AmountCents: 2500, Status: entity.RefundStatusFailed},
+	}
+	// Failed refunds give the money back to refund; pending ones hold it
+	assert.Equal(t, int64(2500), utils.RefundableCents(5000, refunds))
+
+	refunds = append(refunds, entity.PaymentRefund{
+		AmountCents: 4000,
+		Status:      entity.RefundStatusCompleted,
+	})
+	assert.Equal(t, int64(0), utils.RefundableCents(5000, refunds))
+}
+
+func TestRefundedTransactionStatus(t *testing.T) {
+	assert.Equal(t, entity.TransactionStatusRefunded, utils.RefundedTransactionStatus(0))
+	assert.Equal(t,
+		entity.TransactionStatusPartiallyRefunded,
+		utils.RefundedTransactionStatus(100),
+	)
+}
@@ -0,0 +1,48 @@
+package utils_test
+
+import (
+	"strings"
+	"testing"
+
+	"ecommerce-be/payment/entity"
+	paymenterrors "ecommerce-be/payment/error"
+	"ecommerce-be/payment/utils"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidatePaymentToken(t *testing.T) {
+	assert.NoError(t, utils.ValidatePaymentToken("tok_1NirD82eZvKYlo2CIvbtLWuY"))
+	assert.NoError(t, utils.ValidatePaymentToken("pm.2:abc-DEF"))
+
+	assert.ErrorIs(t,
+		utils.ValidatePaymentToken("4111111111111111"),
+		paymenterrors.ErrorCardDataNotAccepted,
+	)
+	assert.ErrorIs(t,
+		utils.ValidatePaymentToken("tok with spaces"),
+		paymenterrors.ErrorPaymentTokenInvalid,
+	)
+	assert.ErrorIs(t,
+		utils.ValidatePaymentToken("tok_"+strings.Repeat("a", 260)),
+		paymenterrors.ErrorPaymentTokenInvalid,
+	)
+}
+
+func TestGatewaySupportsMethod(t *testing.T) {
+	gw := gateway("cashfree", nil, []string{"INR"}, []string{"Card", "upi"})
+	assert.True(t, utils.GatewaySupportsMethod(gw, entity.PaymentMethodTypeCard))
+	assert.True(t, utils.GatewaySupportsMethod(gw, entity.PaymentMethodTypeUPI))
+	assert.False(t, utils.GatewaySupportsMethod(gw, entity.PaymentMethodTypeWallet))
+}
+
+func TestBuildPaymentMethodDisplayName(t *testing.T) {
+	assert.Equal(t, "Visa ending in 4242",
+		utils.BuildPaymentMethodDisplayName(entity.PaymentMethodTypeCard, "visa", "4242"))
+	assert.Equal(t, "Card ending in 0005",
+		utils.BuildPaymentMethodDisplayName(entity.PaymentMethodTypeCard, "", "0005"))
+	assert.Equal(t, "UPI",
+		utils.BuildPaymentMethodDisplayName(entity.PaymentMethodTypeUPI, "", ""))
+	assert.Equal(t, "PhonePe",
+		utils.BuildPaymentMethodDisplayName(entity.PaymentMethodTypeWallet, "PhonePe", ""))
+}
@@ -0,0 +1,120 @@
+package utils_test
+
+import (
+	"testing"
+	"time"
+
+	"ecommerce-be/payment/entity"
+	"ecommerce-be/payment/model"
+	"ecommerce-be/payment/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCommissionCents(t *testing.T) {
+	assert.Equal(t, int64(1000), utils.CommissionCents(10000, 1000))
+	// 2.5% of 1.99 is 4.975 cents, rounded half up
+	assert.Equal(t, int64(5), utils.CommissionCents(199, 250))
+	assert.Equal(t, int64(0), utils.CommissionCents(10000, 0))
+	assert.Equal(t, int64(0), utils.CommissionCents(0, 1000))
+}
+
+func TestGatewayFeeShare(t *testing.T) {
+	assert.Equal(t, int64(300), utils.GatewayFeeShare(300, 10000, 10000))
+	assert.Equal(t, int64(90), utils.GatewayFeeShare(300, 10000, 3000))
+	assert.Equal(t, int64(300), utils.GatewayFeeShare(300, 10000, 12000))
+	assert.Equal(t, int64(0), utils.GatewayFeeShare(0, 10000, 3000))
+}
+
+func TestNewLedgerEntry_Postings(t *testing.T) {
+	now := time.Now().UTC()
+
+	sale := utils.NewLedgerEntry(7, entity.LedgerEntryTypeSale, "USD", 100, now)
+	assert.Equal(t, entity.LedgerAccountGatewayClearing, sale.DebitAccount)
+	assert.Equal(t, entity.LedgerAccountSellerPayable, sale.CreditAccount)
+
+	fee := utils.NewLedgerEntry(7, entity.LedgerEntryTypeGatewayFee, "USD", 3, now)
+	assert.Equal(t, entity.LedgerAccountSellerPayable, fee.DebitAccount)
+	assert.Equal(t, entity.LedgerAccountGatewayFees, fee.CreditAccount)
+
+	payout := utils.NewLedgerEntry(7, entity.LedgerEntryTypePayout, "USD", 50, now)
+	assert.Equal(t, entity.LedgerAccountSellerBank, payout.CreditAccount)
+	assert.Equal(t, int64(-50),
+		utils.SellerPayableDelta(payout.DebitAccount, payout.CreditAccount, payout.AmountCents))
+}
+
+func TestBuildSellerBalances(t *testing.T) {
+	total := func(currency string, entryType entity.LedgerEntryType, amount int64) model.LedgerTotal {
+		debit, credit := utils.LedgerPosting(entryType)
+		return model.LedgerTotal{
+			Currency:      currency,
+			EntryType:     entryType,
+			DebitAccount:  debit,
+			CreditAccount: credit,
+			AmountCents:   amount,
+		}
+	}
+
+	balances := utils.BuildSellerBalances([]model.LedgerTotal{
+		total("USD", entity.LedgerEntryTypeSale, 10000),
+		total("USD", entity.LedgerEntryTypeCommission, 1000),
+		total("USD", entity.LedgerEntryTypeGatewayFee, 300),
+		total("USD", entity.LedgerEntryTypeRefund, 2000),
+		total("USD", entity.LedgerEntryTypePayout, 4000),
+		total("EUR", entity.LedgerEntryTypeSale, 500),
+	})
+
+	require.Len(t, balances, 2)
+	assert.Equal(t, "EUR", balances[0].Currency)
+	assert.Equal(t, int64(500), balances[0].AvailableCents)
+
+	usd := balances[1]
+	assert.Equal(t, int64(10000), usd.GrossSalesCents)
+	assert.Equal(t, int64(300), usd.GatewayFeeCents)
+	assert.Equal(t, int64(4000), usd.PayoutCents)
+	assert.Equal(t, int64(2700), usd.AvailableCents)
+}
+
+func TestBuildPayoutReportCSV(t *testing.T) {
+	from := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	orderID := uint(42)
+	sale := utils.NewLedgerEntry(7, entity.LedgerEntryTypeSale, "USD", 1000, from.Add(time.Hour))
+	sale.OrderID = &orderID
+	sale.Reference = "TXN-1"
+	commission := utils.NewLedgerEntry(7, entity.LedgerEntryTypeCommission, "USD", 100,
+		from.Add(time.Hour))
+
+	records := utils.BuildPayoutReportCSV("USD", from, 250,
+		[]entity.SellerLedgerEntry{sale, commission})
+
+	require.Len(t, records, 4)
+	for _, record := range records {
+		assert.Len(t, record, len(records[0]))
+	}
+	last := len(records[0]) - 1
+	assert.Equal(t, "opening_balance", records[1][1])
+	assert.Equal(t, "250", records[1][last])
+
+	assert.Equal(t, "42", records[2][4])
+	assert.Equal(t, "", records[2][last-2])
+	assert.Equal(t, "1000", records[2][last-1])
+	assert.Equal(t, "1250", records[2][last])
+
+	assert.Equal(t, "100", records[3][last-2])
+	assert.Equal(t, "1150", records[3][last])
+}
+
+func TestResolvePayoutReportPeriod(t *testing.T) {
+	now := time.Date(2026, 3, 15, 10, 0, 0, 0, time.UTC)
+
+	start, end, err := utils.ResolvePayoutReportPeriod(nil, nil, now)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC), start)
+	assert.Equal(t, time.Date(2026, 3, 16, 0, 0, 0, 0, time.UTC), end)
+
+	from := time.Date(2026, 2, 10, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	_, _, err = utils.ResolvePayoutReportPeriod(&from, &to, now)
+	assert.Error(t, err)
+}
@@ -0,0 +1,39 @@
+package model_test
+
+import (
+	"testing"
+
+	"ecommerce-be/product/model"
+
+	"github.com/gin-gonic/gin/binding"
+	"github.com/stretchr/testify/assert"
+)
+
+func batchIDs(n int) []uint {
+	ids := make([]uint, n)
+	for i := range ids {
+		ids[i] = uint(i + 1)
+	}
+	return ids
+}
+
+func TestBatchProductsRequest_SizeCap(t *testing.T) {
+	cases := []struct {
+		name  string
+		ids   []uint
+		valid bool
+	}{
+		{"one id", batchIDs(1), true},
+		{"at the cap", batchIDs(100), true},
+		{"over the cap", batchIDs(101), false},
+		{"empty", []uint{}, false},
+		{"missing", nil, false},
+		{"zero id", []uint{1, 0}, false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := binding.Validator.ValidateStruct(&model.BatchProductsRequest{ProductIDs: tc.ids})
+			assert.Equal(t, tc.valid, err == nil, err)
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package model_test
+
+import (
+	"testing"
+
+	"ecommerce-be/product/model"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVariantMediaImageURLs_PrimaryFirst(t *testing.T) {
+	media := []model.VariantMediaResponse{
+		{FileID: "a", URL: "https://cdn/a.jpg", DisplayOrder: 0},
+		{FileID: "b", URL: "https://cdn/b.jpg", DisplayOrder: 1, IsPrimary: true},
+		{FileID: "c", URL: "", DisplayOrder: 2},
+		{FileID: "d", URL: "https://cdn/d.jpg", DisplayOrder: 3},
+	}
+
+	assert.Equal(t,
+		[]string{"https://cdn/b.jpg", "https://cdn/a.jpg", "https://cdn/d.jpg"},
+		model.VariantMediaImageURLs(media),
+	)
+}
+
+func TestVariantMediaImageURLs_EmptyIsNotNil(t *testing.T) {
+	urls := model.VariantMediaImageURLs(nil)
+
+	assert.NotNil(t, urls)
+	assert.Empty(t, urls)
+}
+
+func TestVariantResponse_SetMediaKeepsImagesInSync(t *testing.T) {
+	var resp model.VariantResponse
+	resp.SetMedia([]model.VariantMediaResponse{{FileID: "a", URL: "https://cdn/a.jpg"}})
+
+	assert.Len(t, resp.Media, 1)
+	assert.Equal(t, []string{"https://cdn/a.jpg"}, resp.Images)
+}
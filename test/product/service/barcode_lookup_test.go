@@ -0,0 +1,178 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"ecommerce-be/common/db"
+	"ecommerce-be/product/entity"
+	prodErrors "ecommerce-be/product/error"
+	"ecommerce-be/product/model"
+	"ecommerce-be/product/repository"
+	"ecommerce-be/product/service"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeBarcodeVariantRepository holds variants by seller and GTIN-14, like the
+// seller-joined query of the real repository
+type fakeBarcodeVariantRepository struct {
+	repository.VariantRepository
+	bySellerGTIN map[uint]map[string]*entity.ProductVariant
+	lookups      []string
+}
+
+func (r *fakeBarcodeVariantRepository) FindVariantByBarcode(
+	_ context.Context,
+	sellerID uint,
+	gtin string,
+) (*entity.ProductVariant, error) {
+	r.lookups = append(r.lookups, gtin)
+	if variant, ok := r.bySellerGTIN[sellerID][gtin]; ok {
+		return variant, nil
+	}
+	return nil, prodErrors.ErrVariantNotFound
+}
+
+func (r *fakeBarcodeVariantRepository) FindVariantByProductIDAndVariantID(
+	_ context.Context,
+	productID, variantID uint,
+) (*entity.ProductVariant, error) {
+	for _, variants := range r.bySellerGTIN {
+		for _, variant := range variants {
+			if variant.ProductID == productID && variant.ID == variantID {
+				return variant, nil
+			}
+		}
+	}
+	return nil, prodErrors.ErrVariantNotFound
+}
+
+func (r *fakeBarcodeVariantRepository) GetVariantOptionValues(
+	_ context.Context,
+	_ uint,
+) ([]entity.VariantOptionValue, error) {
+	return nil, nil
+}
+
+type fakeProductValidatorService struct {
+	service.ProductValidatorService
+	products map[uint]*entity.Product
+}
+
+func (s *fakeProductValidatorService) GetAndValidateProductOwnershipNonPtr(
+	_ context.Context,
+	productID uint,
+	sellerID uint,
+) (*entity.Product, error) {
+	product, ok := s.products[productID]
+	if !ok {
+		return nil, prodErrors.ErrProductNotFound
+	}
+	if product.SellerID != sellerID {
+		return nil, prodErrors.ErrUnauthorizedProductAccess
+	}
+	return product, nil
+}
+
+type fakeProductOptionService struct {
+	service.ProductOptionService
+}
+
+func (s *fakeProductOptionService) GetAvailableOptions(
+	_ context.Context,
+	productID uint,
+	_ *uint,
+) (*model.GetAvailableOptionsResponse, error) {
+	return &model.GetAvailableOptionsResponse{ProductID: productID}, nil
+}
+
+type fakeChannelPriceService struct {
+	service.VariantChannelPriceService
+}
+
+func (s *fakeChannelPriceService) ResolveVariantPrices(
+	_ context.Context,
+	_ entity.SalesChannel,
+	_ []model.VariantDetailResponse,
+) error {
+	return nil
+}
+
+type fakeVariantMediaService struct {
+	service.VariantMediaService
+}
+
+func (s *fakeVariantMediaService) GetMediaForVariants(
+	_ context.Context,
+	_ []uint,
+	_ *uint,
+) (map[uint][]model.VariantMediaResponse, error) {
+	return map[uint][]model.VariantMediaResponse{}, nil
+}
+
+// newBarcodeLookupService has seller 7's variant 11 of product 3 under UPC-A
+// 036000291452, stored as its GTIN-14
+func newBarcodeLookupService() (service.VariantQueryService, *fakeBarcodeVariantRepository) {
+	barcode := "036000291452"
+	variant := &entity.ProductVariant{
+		BaseEntity: db.BaseEntity{ID: 11},
+		ProductID:  3,
+		SKU:        "MUG-RED",
+		Barcode:    &barcode,
+	}
+	repo := &fakeBarcodeVariantRepository{
+		bySellerGTIN: map[uint]map[string]*entity.ProductVariant{
+			7: {"00036000291452": variant},
+		},
+	}
+	validatorSvc := &fakeProductValidatorService{products: map[uint]*entity.Product{
+		3: {BaseEntity: db.BaseEntity{ID: 3}, SellerID: 7, Name: "Mug"},
+	}}
+	svc := service.NewVariantQueryService(
+		repo,
+		nil,
+		&fakeProductOptionService{},
+		validatorSvc,
+		&fakeVariantMediaService{},
+		&fakeChannelPriceService{},
+	)
+	return svc, repo
+}
+
+func TestLookupVariantByBarcode_FindsSellersVariantAcrossFormats(t *testing.T) {
+	svc, repo := newBarcodeLookupService()
+
+	for _, barcode := range []string{"036000291452", "0036000291452", " 036000291452 "} {
+		variant, err := svc.LookupVariantByBarcode(context.Background(), 7, barcode, "")
+		require.NoError(t, err, barcode)
+		assert.Equal(t, uint(11), variant.ID)
+		assert.Equal(t, uint(3), variant.ProductID)
+	}
+	assert.Equal(t, []string{"00036000291452", "00036000291452", "00036000291452"}, repo.lookups)
+}
+
+func TestLookupVariantByBarcode_UnknownBarcodeNotFound(t *testing.T) {
+	svc, _ := newBarcodeLookupService()
+
+	_, err := svc.LookupVariantByBarcode(context.Background(), 7, "4006381333931", "")
+	assert.ErrorIs(t, err, prodErrors.ErrVariantNotFound)
+}
+
+func TestLookupVariantByBarcode_ScopedToSeller(t *testing.T) {
+	svc, _ := newBarcodeLookupService()
+
+	_, err := svc.LookupVariantByBarcode(context.Background(), 8, "036000291452", "")
+	assert.ErrorIs(t, err, prodErrors.ErrVariantNotFound)
+}
+
+func TestLookupVariantByBarcode_InvalidBarcodeSkipsLookup(t *testing.T) {
+	svc, repo := newBarcodeLookupService()
+
+	for _, barcode := range []string{"036000291453", "03600029145A", ""} {
+		_, err := svc.LookupVariantByBarcode(context.Background(), 7, barcode, "")
+		assert.ErrorIs(t, err, prodErrors.ErrInvalidBarcode, barcode)
+	}
+	assert.Empty(t, repo.lookups)
+}
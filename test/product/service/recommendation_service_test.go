@@ -0,0 +1,207 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"testing"
+	"time"
+
+	"ecommerce-be/common/db"
+	"ecommerce-be/common/log"
+	"ecommerce-be/product/entity"
+	prodErrors "ecommerce-be/product/error"
+	"ecommerce-be/product/model"
+	"ecommerce-be/product/repository"
+	"ecommerce-be/product/service"
+	"ecommerce-be/product/utils"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMain(m *testing.M) {
+	log.Log = logrus.New()
+	log.Log.SetOutput(io.Discard)
+	os.Exit(m.Run())
+}
+
+// fakeRecommendationRepository records the calls the service makes
+type fakeRecommendationRepository struct {
+	alsoBought     []uint
+	refreshErr     error
+	calls          []string
+	lastSellerID   *uint
+	lastLimit      int
+	coPurchaseAt   time.Time
+	recommendedAt  time.Time
+	coPurchaseRows int64
+}
+
+func (r *fakeRecommendationRepository) RefreshCoPurchases(
+	_ context.Context,
+	_ time.Time,
+	computedAt time.Time,
+	_ int,
+	_ int,
+) (int64, error) {
+	r.calls = append(r.calls, "coPurchases")
+	r.coPurchaseAt = computedAt
+	return r.coPurchaseRows, r.refreshErr
+}
+
+func (r *fakeRecommendationRepository) RefreshUserRecommendations(
+	_ context.Context,
+	_ time.Time,
+	computedAt time.Time,
+	_ int,
+) (int64, error) {
+	r.calls = append(r.calls, "userRecommendations")
+	r.recommendedAt = computedAt
+	return 0, nil
+}
+
+func (r *fakeRecommendationRepository) FindAlsoBought(
+	_ context.Context,
+	_ uint,
+	sellerID *uint,
+	limit int,
+) ([]uint, error) {
+	r.calls = append(r.calls, "alsoBought")
+	r.lastSellerID = sellerID
+	r.lastLimit = limit
+	return r.alsoBought[:min(limit, len(r.alsoBought))], nil
+}
+
+func (r *fakeRecommendationRepository) FindUserRecommendations(
+	_ context.Context,
+	_ uint,
+	sellerID *uint,
+	limit int,
+) ([]uint, error) {
+	r.lastSellerID = sellerID
+	r.lastLimit = limit
+	return nil, nil
+}
+
+// fakeProductRepository serves FindByID from memory; other methods are not used
+type fakeProductRepository struct {
+	repository.ProductRepository
+	products map[uint]*entity.Product
+}
+
+func (r *fakeProductRepository) FindByID(_ context.Context, id uint) (*entity.Product, error) {
+	if product, ok := r.products[id]; ok {
+		return product, nil
+	}
+	return nil, prodErrors.ErrProductNotFound
+}
+
+// fakeProductQueryService returns one bare response per requested ID
+type fakeProductQueryService struct {
+	service.ProductQueryService
+}
+
+func (fakeProductQueryService) GetProductsInOrder(
+	_ context.Context,
+	productIDs []uint,
+	_ *uint,
+	_ *uint,
+) ([]model.ProductResponse, error) {
+	responses := make([]model.ProductResponse, len(productIDs))
+	for i, id := range productIDs {
+		responses[i] = model.ProductResponse{ID: id}
+	}
+	return responses, nil
+}
+
+func newRecommendationService(
+	repo *fakeRecommendationRepository,
+) service.RecommendationService {
+	products := &fakeProductRepository{products: map[uint]*entity.Product{
+		1: {BaseEntity: db.BaseEntity{ID: 1}, SellerID: 9},
+	}}
+	return service.NewRecommendationService(repo, products, fakeProductQueryService{})
+}
+
+func TestGetAlsoBought_OtherSellersProductIsNotFound(t *testing.T) {
+	repo := &fakeRecommendationRepository{alsoBought: []uint{2, 3}}
+	otherSeller := uint(4)
+
+	_, err := newRecommendationService(repo).GetAlsoBought(
+		context.Background(), 1, &otherSeller, nil, 10,
+	)
+
+	assert.ErrorIs(t, err, prodErrors.ErrProductNotFound)
+	assert.Empty(t, repo.calls, "co-purchases are not read for another seller's product")
+}
+
+func TestGetAlsoBought_ScopesToSeller(t *testing.T) {
+	repo := &fakeRecommendationRepository{alsoBought: []uint{2, 3}}
+	sellerID := uint(9)
+
+	products, err := newRecommendationService(repo).GetAlsoBought(
+		context.Background(), 1, &sellerID, nil, 10,
+	)
+
+	require.NoError(t, err)
+	require.NotNil(t, repo.lastSellerID)
+	assert.Equal(t, sellerID, *repo.lastSellerID)
+	assert.Len(t, products, 2)
+}
+
+func TestGetAlsoBought_MissingProduct(t *testing.T) {
+	repo := &fakeRecommendationRepository{}
+
+	_, err := newRecommendationService(repo).GetAlsoBought(
+		context.Background(), 99, nil, nil, 10,
+	)
+
+	assert.ErrorIs(t, err, prodErrors.ErrProductNotFound)
+	assert.Empty(t, repo.calls)
+}
+
+func TestGetAlsoBought_PassesLimitThrough(t *testing.T) {
+	repo := &fakeRecommendationRepository{alsoBought: []uint{2, 3, 4, 5}}
+
+	products, err := newRecommendationService(repo).GetAlsoBought(
+		context.Background(), 1, nil, nil, 3,
+	)
+
+	require.NoError(t, err)
+	assert.Equal(t, 3, repo.lastLimit)
+	assert.Len(t, products, 3)
+}
+
+func TestGetRecommendations_PassesLimitAndSellerThrough(t *testing.T) {
+	repo := &fakeRecommendationRepository{}
+	sellerID := uint(9)
+
+	_, err := newRecommendationService(repo).GetRecommendations(
+		context.Background(), 5, &sellerID, utils.RECOMMENDATION_MAX_LIMIT,
+	)
+
+	require.NoError(t, err)
+	assert.Equal(t, utils.RECOMMENDATION_MAX_LIMIT, repo.lastLimit)
+	assert.Equal(t, &sellerID, repo.lastSellerID)
+}
+
+func TestRebuild_StampsBothTablesWithOneMicrosecondTime(t *testing.T) {
+	repo := &fakeRecommendationRepository{}
+
+	newRecommendationService(repo).Rebuild()
+
+	assert.Equal(t, []string{"coPurchases", "userRecommendations"}, repo.calls)
+	assert.Equal(t, repo.coPurchaseAt, repo.recommendedAt)
+	assert.Equal(t, repo.coPurchaseAt, repo.coPurchaseAt.Truncate(time.Microsecond))
+}
+
+func TestRebuild_StopsWhenCoPurchasesFail(t *testing.T) {
+	repo := &fakeRecommendationRepository{refreshErr: errors.New("db down")}
+
+	newRecommendationService(repo).Rebuild()
+
+	assert.Equal(t, []string{"coPurchases"}, repo.calls)
+}
@@ -0,0 +1,195 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"ecommerce-be/common/db"
+	commonError "ecommerce-be/common/error"
+	"ecommerce-be/product/entity"
+	prodErrors "ecommerce-be/product/error"
+	"ecommerce-be/product/mapper"
+	"ecommerce-be/product/model"
+	"ecommerce-be/product/repository"
+	"ecommerce-be/product/service"
+	"ecommerce-be/product/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeRelatedOverrideRepository returns the stored overrides as the real repository
+// orders them: pins by position, then exclusions
+type fakeRelatedOverrideRepository struct {
+	repository.RelatedProductOverrideRepository
+	overrides []entity.RelatedProductOverride
+	replaced  bool
+}
+
+func (r *fakeRelatedOverrideRepository) FindByProductID(
+	_ context.Context,
+	_ uint,
+) ([]entity.RelatedProductOverride, error) {
+	return r.overrides, nil
+}
+
+func (r *fakeRelatedOverrideRepository) ReplaceForProduct(
+	_ context.Context,
+	_ uint,
+	_ []entity.RelatedProductOverride,
+) error {
+	r.replaced = true
+	return nil
+}
+
+// fakeCatalogRepository serves FindByIDs from memory; deleted products are absent
+type fakeCatalogRepository struct {
+	repository.ProductRepository
+	products map[uint]entity.Product
+}
+
+func (r *fakeCatalogRepository) FindByIDs(_ context.Context, ids []uint) ([]entity.Product, error) {
+	products := make([]entity.Product, 0, len(ids))
+	for _, id := range ids {
+		if product, ok := r.products[id]; ok {
+			products = append(products, product)
+		}
+	}
+	return products, nil
+}
+
+func catalogProduct(id, sellerID uint, name string) entity.Product {
+	return entity.Product{BaseEntity: db.BaseEntity{ID: id}, SellerID: sellerID, Name: name}
+}
+
+// newRelatedCurationService has product 1 of seller 7, its other products 2 to 4 and
+// product 5 of seller 8
+func newRelatedCurationService(
+	overrideRepo *fakeRelatedOverrideRepository,
+) service.RelatedCurationService {
+	catalog := &fakeCatalogRepository{products: map[uint]entity.Product{
+		1: catalogProduct(1, 7, "Mug"),
+		2: catalogProduct(2, 7, "Saucer"),
+		3: catalogProduct(3, 7, "Teapot"),
+		4: catalogProduct(4, 7, "Spoon"),
+		5: catalogProduct(5, 8, "Other seller's cup"),
+	}}
+	owned := make(map[uint]*entity.Product, len(catalog.products))
+	for id, product := range catalog.products {
+		owned[id] = &product
+	}
+	return service.NewRelatedCurationService(
+		overrideRepo,
+		catalog,
+		&fakeProductValidatorService{products: owned},
+	)
+}
+
+func assertCurationInvalid(t *testing.T, err error) {
+	t.Helper()
+	var appErr *commonError.AppError
+	require.ErrorAs(t, err, &appErr)
+	assert.Equal(t, utils.RELATED_CURATION_INVALID_CODE, appErr.Code)
+}
+
+func TestUpdateCuration_RejectsUnusableProducts(t *testing.T) {
+	tests := []struct {
+		name string
+		req  model.UpdateRelatedCurationRequest
+	}{
+		{"pins another seller's product",
+			model.UpdateRelatedCurationRequest{PinnedProductIDs: []uint{2, 5}}},
+		{"excludes another seller's product",
+			model.UpdateRelatedCurationRequest{ExcludedProductIDs: []uint{5}}},
+		{"pins a removed product",
+			model.UpdateRelatedCurationRequest{PinnedProductIDs: []uint{2, 99}}},
+		{"excludes a removed product",
+			model.UpdateRelatedCurationRequest{ExcludedProductIDs: []uint{99}}},
+		{"pins the product itself",
+			model.UpdateRelatedCurationRequest{PinnedProductIDs: []uint{1}}},
+		{"pins and excludes the same product", model.UpdateRelatedCurationRequest{
+			PinnedProductIDs:   []uint{2},
+			ExcludedProductIDs: []uint{2},
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			overrideRepo := &fakeRelatedOverrideRepository{}
+			_, err := newRelatedCurationService(overrideRepo).UpdateCuration(
+				context.Background(), 1, 7, tt.req,
+			)
+			assertCurationInvalid(t, err)
+			assert.False(t, overrideRepo.replaced, "nothing is written")
+		})
+	}
+}
+
+func TestUpdateCuration_OtherSellersProductIsNotTheirs(t *testing.T) {
+	overrideRepo := &fakeRelatedOverrideRepository{}
+
+	_, err := newRelatedCurationService(overrideRepo).UpdateCuration(
+		context.Background(), 5, 7,
+		model.UpdateRelatedCurationRequest{PinnedProductIDs: []uint{2}},
+	)
+
+	assert.ErrorIs(t, err, prodErrors.ErrUnauthorizedProductAccess)
+	assert.False(t, overrideRepo.replaced)
+}
+
+func TestGetCuration_PinsInPositionOrderThenExclusions(t *testing.T) {
+	overrideRepo := &fakeRelatedOverrideRepository{
+		overrides: utils.BuildRelatedProductOverrides(1, []uint{4, 2}, []uint{3, 99}),
+	}
+
+	resp, err := newRelatedCurationService(overrideRepo).GetCuration(context.Background(), 1, 7)
+
+	require.NoError(t, err)
+	assert.Equal(t, uint(1), resp.ProductID)
+	assert.Equal(t, []model.RelatedCurationItem{
+		{ProductID: 4, Name: "Spoon"},
+		{ProductID: 2, Name: "Saucer"},
+	}, resp.Pinned)
+	// A product removed since it was excluded is still listed, without a name
+	assert.Equal(t, []model.RelatedCurationItem{
+		{ProductID: 3, Name: "Teapot"},
+		{ProductID: 99},
+	}, resp.Excluded)
+}
+
+func TestGetCuration_NothingCurated(t *testing.T) {
+	resp, err := newRelatedCurationService(&fakeRelatedOverrideRepository{}).GetCuration(
+		context.Background(), 1, 7,
+	)
+
+	require.NoError(t, err)
+	assert.NotNil(t, resp.Pinned)
+	assert.NotNil(t, resp.Excluded)
+	assert.Empty(t, resp.Pinned)
+	assert.Empty(t, resp.Excluded)
+}
+
+func TestGetRelatedProductsScored_PinsLeadScoredResults(t *testing.T) {
+	repo := &fakeRelatedProductRepository{
+		results: []mapper.RelatedProductScored{
+			{ProductID: 4, StrategyUsed: utils.RELATED_STRATEGY_MANUAL},
+			{ProductID: 2, StrategyUsed: utils.RELATED_STRATEGY_MANUAL},
+			{ProductID: 3, FinalScore: 120, StrategyUsed: "same_category"},
+			{ProductID: 6, FinalScore: 80, StrategyUsed: "same_brand"},
+		},
+		total: 4,
+	}
+
+	resp, err := newRelatedProductsService(repo).GetRelatedProductsScored(
+		context.Background(), 1, 10, 1, "", nil, nil,
+	)
+
+	require.NoError(t, err)
+	require.Len(t, resp.RelatedProducts, 4)
+	assert.Equal(t, uint(4), resp.RelatedProducts[0].ID)
+	assert.Equal(t, utils.RELATED_STRATEGY_MANUAL, resp.RelatedProducts[0].StrategyUsed)
+	assert.Equal(t, uint(2), resp.RelatedProducts[1].ID)
+	assert.Equal(t, uint(3), resp.RelatedProducts[2].ID)
+	// Pins carry no score and are left out of the average
+	assert.Equal(t, 100.0, resp.Meta.AvgScore)
+	assert.Contains(t, resp.Meta.StrategiesUsed, utils.RELATED_STRATEGY_MANUAL)
+}
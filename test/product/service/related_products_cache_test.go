@@ -0,0 +1,178 @@
+package service_test
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"ecommerce-be/common/cache"
+	"ecommerce-be/product/entity"
+	"ecommerce-be/product/mapper"
+	"ecommerce-be/product/repository"
+	"ecommerce-be/product/utils"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeRedis keeps the strings and counters the read-through cache uses in memory
+type fakeRedis struct {
+	redis.UniversalClient
+	mu     sync.Mutex
+	values map[string]string
+}
+
+func (r *fakeRedis) Get(ctx context.Context, key string) *redis.StringCmd {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	value, ok := r.values[key]
+	if !ok {
+		return redis.NewStringResult("", redis.Nil)
+	}
+	return redis.NewStringResult(value, nil)
+}
+
+func (r *fakeRedis) Set(
+	ctx context.Context,
+	key string,
+	value any,
+	_ time.Duration,
+) *redis.StatusCmd {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	switch v := value.(type) {
+	case string:
+		r.values[key] = v
+	case []byte:
+		r.values[key] = string(v)
+	}
+	return redis.NewStatusResult("OK", nil)
+}
+
+func (r *fakeRedis) Del(ctx context.Context, keys ...string) *redis.IntCmd {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, key := range keys {
+		delete(r.values, key)
+	}
+	return redis.NewIntResult(int64(len(keys)), nil)
+}
+
+func (r *fakeRedis) Incr(ctx context.Context, key string) *redis.IntCmd {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	version, _ := strconv.ParseInt(r.values[key], 10, 64)
+	version++
+	r.values[key] = strconv.FormatInt(version, 10)
+	return redis.NewIntResult(version, nil)
+}
+
+// useFakeRedis serves the cache from an empty fakeRedis for the rest of the test
+func useFakeRedis(t *testing.T) {
+	t.Helper()
+	cache.ClearLocal()
+	cache.SetRedisClient(&fakeRedis{values: map[string]string{}})
+	t.Cleanup(func() {
+		cache.SetRedisClient(nil)
+		cache.ClearLocal()
+	})
+}
+
+// fakeWriteProductRepository accepts product writes without storing them
+type fakeWriteProductRepository struct {
+	repository.ProductRepository
+}
+
+func (fakeWriteProductRepository) Create(context.Context, *entity.Product) error { return nil }
+
+func (fakeWriteProductRepository) Update(context.Context, *entity.Product) error { return nil }
+
+func relatedCacheStatus(
+	t *testing.T,
+	repo *fakeRelatedProductRepository,
+	sellerID *uint,
+) string {
+	t.Helper()
+	resp, err := newRelatedProductsService(repo).GetRelatedProductsScored(
+		context.Background(), 1, 10, 1, "", sellerID, nil,
+	)
+	require.NoError(t, err)
+	return resp.Meta.CacheStatus
+}
+
+func TestGetRelatedProductsScored_MissThenHit(t *testing.T) {
+	useFakeRedis(t)
+	repo := &fakeRelatedProductRepository{
+		results: []mapper.RelatedProductScored{
+			{ProductID: 5, FinalScore: 150, StrategyUsed: "same_category"},
+		},
+		total: 1,
+	}
+
+	assert.Equal(t, utils.RELATED_CACHE_STATUS_MISS, relatedCacheStatus(t, repo, nil))
+	assert.Equal(t, utils.RELATED_CACHE_STATUS_HIT, relatedCacheStatus(t, repo, nil))
+	assert.Len(t, repo.calls, 1)
+}
+
+func TestGetRelatedProductsScored_WithoutRedisAlwaysMisses(t *testing.T) {
+	cache.ClearLocal()
+	repo := &fakeRelatedProductRepository{}
+
+	assert.Equal(t, utils.RELATED_CACHE_STATUS_MISS, relatedCacheStatus(t, repo, nil))
+	assert.Equal(t, utils.RELATED_CACHE_STATUS_MISS, relatedCacheStatus(t, repo, nil))
+	assert.Len(t, repo.calls, 2)
+}
+
+func TestGetRelatedProductsScored_CachesEachSellerScopeApart(t *testing.T) {
+	useFakeRedis(t)
+	repo := &fakeRelatedProductRepository{}
+	sellerA, sellerB := uint(1), uint(2)
+
+	keys := map[string]bool{}
+	for _, sellerID := range []*uint{&sellerA, &sellerB, nil} {
+		keys[repository.RelatedProductsCacheKey(sellerID, 1, "all", 10, 0)] = true
+		assert.Equal(t, utils.RELATED_CACHE_STATUS_MISS, relatedCacheStatus(t, repo, sellerID))
+	}
+	assert.Len(t, keys, 3)
+	assert.Len(t, repo.calls, 3)
+
+	assert.Equal(t, utils.RELATED_CACHE_STATUS_HIT, relatedCacheStatus(t, repo, &sellerA))
+	assert.Len(t, repo.calls, 3)
+}
+
+func TestCachedProductRepository_WritesInvalidateSellerAndAdminRelatedProducts(t *testing.T) {
+	writes := map[string]func(repository.ProductRepository, *entity.Product) error{
+		"create": func(repo repository.ProductRepository, product *entity.Product) error {
+			return repo.Create(context.Background(), product)
+		},
+		"update": func(repo repository.ProductRepository, product *entity.Product) error {
+			return repo.Update(context.Background(), product)
+		},
+	}
+	for name, write := range writes {
+		t.Run(name, func(t *testing.T) {
+			useFakeRedis(t)
+			related := &fakeRelatedProductRepository{}
+			sellerA, sellerB := uint(1), uint(2)
+			for _, sellerID := range []*uint{&sellerA, &sellerB, nil} {
+				relatedCacheStatus(t, related, sellerID)
+			}
+
+			products := repository.NewCachedProductRepository(fakeWriteProductRepository{})
+			product := &entity.Product{SellerID: sellerA}
+			product.ID = 1
+			require.NoError(t, write(products, product))
+
+			assert.Equal(
+				t, utils.RELATED_CACHE_STATUS_MISS, relatedCacheStatus(t, related, &sellerA),
+			)
+			assert.Equal(t, utils.RELATED_CACHE_STATUS_MISS, relatedCacheStatus(t, related, nil))
+			assert.Equal(
+				t, utils.RELATED_CACHE_STATUS_HIT, relatedCacheStatus(t, related, &sellerB),
+			)
+		})
+	}
+}
@@ -0,0 +1,170 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"ecommerce-be/product/mapper"
+	"ecommerce-be/product/model"
+	"ecommerce-be/product/repository"
+	"ecommerce-be/product/service"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// relatedCall is one call of the related products procedure
+type relatedCall struct {
+	productID  uint
+	limit      int
+	offset     int
+	strategies string
+}
+
+// fakeRelatedProductRepository returns a fixed page of the related products procedure
+// and records how it was called
+type fakeRelatedProductRepository struct {
+	repository.ProductRepository
+	results []mapper.RelatedProductScored
+	total   int64
+	calls   []relatedCall
+}
+
+func (r *fakeRelatedProductRepository) FindRelatedScored(
+	_ context.Context,
+	productID uint,
+	_ *uint,
+	limit int,
+	offset int,
+	strategies string,
+) ([]mapper.RelatedProductScored, int64, error) {
+	r.calls = append(r.calls, relatedCall{productID, limit, offset, strategies})
+	return r.results, r.total, nil
+}
+
+type fakeRelatedVariantQueryService struct {
+	service.VariantQueryService
+}
+
+func (fakeRelatedVariantQueryService) GetProductsVariantAggregations(
+	_ context.Context,
+	_ []uint,
+	_ *uint,
+) (map[uint]*mapper.VariantAggregation, error) {
+	return map[uint]*mapper.VariantAggregation{}, nil
+}
+
+type fakeTranslationService struct {
+	service.ProductTranslationService
+}
+
+func (fakeTranslationService) LocalizeProducts(
+	_ context.Context,
+	_ []*model.ProductResponse,
+) error {
+	return nil
+}
+
+func newRelatedProductsService(repo *fakeRelatedProductRepository) service.ProductQueryService {
+	return service.NewProductQueryService(
+		repo,
+		fakeRelatedVariantQueryService{},
+		nil, nil, nil, nil, nil,
+		fakeTranslationService{},
+		nil, nil, nil,
+	)
+}
+
+func TestGetRelatedProductsScored_EnforcesLimit(t *testing.T) {
+	tests := []struct {
+		name       string
+		limit      int
+		page       int
+		wantLimit  int
+		wantOffset int
+	}{
+		{"default when missing", 0, 1, 20, 0},
+		{"default when negative", -5, 1, 20, 0},
+		{"kept within bounds", 25, 1, 25, 0},
+		{"capped at maximum", 500, 1, 100, 0},
+		{"offset from page", 10, 3, 10, 20},
+		{"first page when page missing", 10, 0, 10, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &fakeRelatedProductRepository{}
+			resp, err := newRelatedProductsService(repo).GetRelatedProductsScored(
+				context.Background(), 1, tt.limit, tt.page, "", nil, nil,
+			)
+			require.NoError(t, err)
+			require.Len(t, repo.calls, 1)
+			assert.Equal(t, tt.wantLimit, repo.calls[0].limit)
+			assert.Equal(t, tt.wantOffset, repo.calls[0].offset)
+			assert.Equal(t, tt.wantLimit, resp.Pagination.ItemsPerPage)
+		})
+	}
+}
+
+func TestGetRelatedProductsScored_NormalizesStrategies(t *testing.T) {
+	tests := []struct {
+		name       string
+		strategies string
+		want       string
+	}{
+		{"none requested falls back to all", "", "all"},
+		{"duplicates collapsed", "same_brand,same_brand", "same_brand"},
+		{"sorted", "tag_matching,same_brand", "same_brand,tag_matching"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &fakeRelatedProductRepository{}
+			_, err := newRelatedProductsService(repo).GetRelatedProductsScored(
+				context.Background(), 1, 10, 1, tt.strategies, nil, nil,
+			)
+			require.NoError(t, err)
+			require.Len(t, repo.calls, 1)
+			assert.Equal(t, tt.want, repo.calls[0].strategies)
+		})
+	}
+}
+
+func TestGetRelatedProductsScored_NoResults(t *testing.T) {
+	repo := &fakeRelatedProductRepository{}
+
+	resp, err := newRelatedProductsService(repo).GetRelatedProductsScored(
+		context.Background(), 1, 10, 1, "", nil, nil,
+	)
+
+	require.NoError(t, err)
+	assert.Empty(t, resp.RelatedProducts)
+	assert.NotNil(t, resp.RelatedProducts)
+	assert.Empty(t, resp.Meta.StrategiesUsed)
+	assert.Zero(t, resp.Pagination.TotalItems)
+}
+
+func TestGetRelatedProductsScored_KeepsProcedureOrderAndAveragesScores(t *testing.T) {
+	repo := &fakeRelatedProductRepository{
+		results: []mapper.RelatedProductScored{
+			{ProductID: 5, FinalScore: 150, StrategyUsed: "same_category"},
+			{ProductID: 3, FinalScore: 90, StrategyUsed: "same_brand"},
+			{ProductID: 8, FinalScore: 60, StrategyUsed: "same_category"},
+		},
+		total: 3,
+	}
+
+	resp, err := newRelatedProductsService(repo).GetRelatedProductsScored(
+		context.Background(), 1, 10, 1, "", nil, nil,
+	)
+
+	require.NoError(t, err)
+	require.Len(t, resp.RelatedProducts, 3)
+	ids := []uint{
+		resp.RelatedProducts[0].ID,
+		resp.RelatedProducts[1].ID,
+		resp.RelatedProducts[2].ID,
+	}
+	assert.Equal(t, []uint{5, 3, 8}, ids)
+	assert.Equal(t, 100.0, resp.Meta.AvgScore)
+	assert.ElementsMatch(t, []string{"same_category", "same_brand"}, resp.Meta.StrategiesUsed)
+	assert.Equal(t, 3, resp.Pagination.TotalItems)
+}
@@ -0,0 +1,73 @@
+package utils_test
+
+import (
+	"testing"
+
+	commonValidator "ecommerce-be/common/validator"
+	"ecommerce-be/product/utils"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeBarcode_MatchesAcrossFormats(t *testing.T) {
+	upc := utils.NormalizeBarcode("036000291452")
+	assert.Equal(t, "00036000291452", upc)
+	assert.Equal(t, upc, utils.NormalizeBarcode("0036000291452"), "EAN-13 of the same UPC-A")
+	assert.Equal(t, "00000096385074", utils.NormalizeBarcode(" 96385074 "))
+	assert.Equal(t, "10012345678902", utils.NormalizeBarcode("10012345678902"))
+}
+
+func TestBarcode_ValidatesCheckDigitAndPadsToGTIN14(t *testing.T) {
+	tests := []struct {
+		name    string
+		barcode string
+		valid   bool
+		gtin    string
+	}{
+		{"gtin-8", "96385074", true, "00000096385074"},
+		{"gtin-8 other", "40170725", true, "00000040170725"},
+		{"gtin-12", "036000291452", true, "00036000291452"},
+		{"gtin-12 other", "012345678905", true, "00012345678905"},
+		{"gtin-13", "4006381333931", true, "04006381333931"},
+		{"gtin-13 other", "5901234123457", true, "05901234123457"},
+		{"gtin-14", "10012345678902", true, "10012345678902"},
+		{"gtin-14 zero padded", "00012345600012", true, "00012345600012"},
+		{"gtin-8 wrong check digit", "96385075", false, ""},
+		{"gtin-12 wrong check digit", "036000291453", false, ""},
+		{"gtin-13 wrong check digit", "4006381333930", false, ""},
+		{"gtin-14 wrong check digit", "10012345678903", false, ""},
+		{"letters", "40063813339A1", false, ""},
+		{"hyphenated", "4006-381333931", false, ""},
+		{"inner space", "4006381 333931", false, ""},
+		{"empty", "", false, ""},
+		{"unsupported length", "1234567890", false, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.valid, commonValidator.IsBarcode(tt.barcode))
+			if tt.valid {
+				assert.Equal(t, tt.gtin, utils.NormalizeBarcode(tt.barcode))
+			}
+		})
+	}
+}
+
+func TestNormalizeBarcode_Padding(t *testing.T) {
+	tests := []struct {
+		name    string
+		barcode string
+		want    string
+	}{
+		{"pads gtin-8", "96385074", "00000096385074"},
+		{"pads gtin-12", "036000291452", "00036000291452"},
+		{"pads gtin-13", "4006381333931", "04006381333931"},
+		{"leaves gtin-14", "10012345678902", "10012345678902"},
+		{"trims surrounding space", "\t036000291452\n", "00036000291452"},
+		{"same item across lengths", "0036000291452", "00036000291452"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, utils.NormalizeBarcode(tt.barcode))
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package utils_test
+
+import (
+	"testing"
+
+	"ecommerce-be/product/entity"
+	"ecommerce-be/product/mapper"
+	"ecommerce-be/product/utils"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func bundleLine(bundleID, componentID uint, quantity int) entity.VariantBundleComponent {
+	return entity.VariantBundleComponent{
+		BundleVariantID:    bundleID,
+		ComponentVariantID: componentID,
+		Quantity:           quantity,
+	}
+}
+
+func TestBuildBundleResponse_PricesComponentsAndSavings(t *testing.T) {
+	variants := map[uint]mapper.VariantBasicInfoRow{
+		1: {VariantID: 1, SKU: "KIT", Price: 25},
+		2: {VariantID: 2, ProductID: 7, ProductName: "Mug", SKU: "MUG", Price: 9.99},
+		3: {VariantID: 3, ProductID: 8, ProductName: "Coffee", SKU: "BEAN", Price: 6.5},
+	}
+
+	response := utils.BuildBundleResponse(
+		variants[1],
+		[]entity.VariantBundleComponent{bundleLine(1, 2, 2), bundleLine(1, 3, 1)},
+		variants,
+	)
+
+	assert.Equal(t, 25.0, response.Price)
+	assert.Equal(t, 26.48, response.ComponentsPrice)
+	assert.Equal(t, 1.48, response.Savings)
+	assert.Len(t, response.Components, 2)
+	assert.Equal(t, 19.98, response.Components[0].LineTotal)
+	assert.Equal(t, "Coffee", response.Components[1].ProductName)
+}
+
+func TestBuildBundleResponse_NoNegativeSavings(t *testing.T) {
+	variants := map[uint]mapper.VariantBasicInfoRow{
+		1: {VariantID: 1, Price: 30},
+		2: {VariantID: 2, Price: 10},
+	}
+
+	response := utils.BuildBundleResponse(
+		variants[1],
+		[]entity.VariantBundleComponent{bundleLine(1, 2, 2)},
+		variants,
+	)
+
+	assert.Equal(t, 20.0, response.ComponentsPrice)
+	assert.Zero(t, response.Savings)
+}
+
+func TestGroupBundleComponents_ByBundle(t *testing.T) {
+	grouped := utils.GroupBundleComponents([]entity.VariantBundleComponent{
+		bundleLine(1, 2, 1), bundleLine(4, 2, 3), bundleLine(1, 3, 2),
+	})
+
+	assert.Len(t, grouped, 2)
+	assert.Len(t, grouped[1], 2)
+	assert.Equal(t, 3, grouped[4][0].Quantity)
+}
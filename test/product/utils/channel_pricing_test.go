@@ -0,0 +1,73 @@
+package utils_test
+
+import (
+	"testing"
+
+	"ecommerce-be/common/db"
+	"ecommerce-be/product/entity"
+	"ecommerce-be/product/model"
+	"ecommerce-be/product/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveChannelPrice(t *testing.T) {
+	override := 79.0
+	zero := 0.0
+
+	assert.Equal(t, 79.0, utils.ResolveChannelPrice(99, &override))
+	assert.Equal(t, 99.0, utils.ResolveChannelPrice(99, nil))
+	assert.Equal(t, 99.0, utils.ResolveChannelPrice(99, &zero))
+}
+
+func TestApplyChannelPrices_NoChannelLeavesBasePrices(t *testing.T) {
+	variants := []model.VariantDetailResponse{variant(1, 100, true, true, false)}
+
+	utils.ApplyChannelPrices(variants, "", map[uint]float64{1: 80})
+
+	assert.Equal(t, 100.0, variants[0].Price)
+	assert.Nil(t, variants[0].BasePrice)
+	assert.Empty(t, variants[0].Channel)
+}
+
+func TestApplyChannelPrices_OverrideThenBase(t *testing.T) {
+	variants := []model.VariantDetailResponse{
+		variant(1, 100, true, true, false),
+		variant(2, 120, false, true, false),
+	}
+
+	utils.ApplyChannelPrices(variants, entity.SALES_CHANNEL_POS, map[uint]float64{1: 80})
+
+	assert.Equal(t, 80.0, variants[0].Price)
+	require.NotNil(t, variants[0].BasePrice)
+	assert.Equal(t, 100.0, *variants[0].BasePrice)
+	assert.Equal(t, "pos", variants[0].Channel)
+
+	assert.Equal(t, 120.0, variants[1].Price)
+	require.NotNil(t, variants[1].BasePrice)
+	assert.Equal(t, 120.0, *variants[1].BasePrice)
+	assert.Equal(t, "pos", variants[1].Channel)
+}
+
+func TestGroupChannelPrices(t *testing.T) {
+	variants := []entity.ProductVariant{
+		{BaseEntity: db.BaseEntity{ID: 1}, SKU: "SKU-1", Price: 100},
+		{BaseEntity: db.BaseEntity{ID: 2}, SKU: "SKU-2", Price: 120},
+	}
+	prices := []entity.VariantChannelPrice{
+		{VariantID: 1, Channel: entity.SALES_CHANNEL_POS, Price: 90},
+		{VariantID: 1, Channel: entity.SALES_CHANNEL_MARKETPLACE, Price: 110},
+	}
+
+	result := utils.GroupChannelPrices(variants, prices)
+
+	require.Len(t, result, 2)
+	assert.Equal(t, "SKU-1", result[0].SKU)
+	assert.Equal(t, 100.0, result[0].BasePrice)
+	assert.Len(t, result[0].ChannelPrices, 2)
+	assert.Equal(t, "pos", result[0].ChannelPrices[0].Channel)
+	assert.Equal(t, 90.0, result[0].ChannelPrices[0].Price)
+	assert.NotNil(t, result[1].ChannelPrices)
+	assert.Empty(t, result[1].ChannelPrices)
+}
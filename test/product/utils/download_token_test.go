@@ -0,0 +1,64 @@
+package utils_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"ecommerce-be/product/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDownloadLinkSigner_RoundTrip(t *testing.T) {
+	signer := utils.NewDownloadLinkSigner("secret", time.Hour)
+	now := time.Date(2026, 3, 1, 12, 0, 0, 500, time.UTC)
+
+	token, expiresAt, err := signer.Sign(7, 42, now)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2026, 3, 1, 13, 0, 0, 0, time.UTC), expiresAt)
+
+	entitlementID, assetID, parsedExpiry, ok := signer.Verify(token)
+	assert.True(t, ok)
+	assert.Equal(t, uint(7), entitlementID)
+	assert.Equal(t, uint(42), assetID)
+	assert.Equal(t, expiresAt, parsedExpiry)
+}
+
+func TestDownloadLinkSigner_RejectsTamperedTokens(t *testing.T) {
+	signer := utils.NewDownloadLinkSigner("secret", time.Hour)
+	token, _, err := signer.Sign(7, 42, time.Now())
+	require.NoError(t, err)
+
+	_, _, _, ok := utils.NewDownloadLinkSigner("other", time.Hour).Verify(token)
+	assert.False(t, ok, "token signed with another secret")
+
+	forged, _, err := utils.NewDownloadLinkSigner("other", time.Hour).Sign(8, 42, time.Now())
+	require.NoError(t, err)
+	forgedPayload, _, _ := strings.Cut(forged, ".")
+	_, mac, _ := strings.Cut(token, ".")
+	_, _, _, ok = signer.Verify(forgedPayload + "." + mac)
+	assert.False(t, ok, "payload swapped under a valid signature")
+
+	for _, bad := range []string{"", "no-dot", "a.b", token + "x"} {
+		_, _, _, ok = signer.Verify(bad)
+		assert.False(t, ok, bad)
+	}
+}
+
+func TestDownloadLinkSigner_ExpiredTokenStillParses(t *testing.T) {
+	signer := utils.NewDownloadLinkSigner("secret", time.Minute)
+	token, expiresAt, err := signer.Sign(1, 2, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+
+	_, _, parsedExpiry, ok := signer.Verify(token)
+	assert.True(t, ok)
+	assert.True(t, time.Now().After(parsedExpiry))
+	assert.Equal(t, expiresAt, parsedExpiry)
+}
+
+func TestDownloadLinkSigner_RefusesEmptySecret(t *testing.T) {
+	_, _, err := utils.NewDownloadLinkSigner("", time.Hour).Sign(7, 42, time.Now())
+	assert.Error(t, err)
+}
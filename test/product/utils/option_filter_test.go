@@ -0,0 +1,52 @@
+package utils_test
+
+import (
+	"strings"
+	"testing"
+
+	"ecommerce-be/product/model"
+	"ecommerce-be/product/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseOptionValueFilters(t *testing.T) {
+	filters, err := utils.ParseOptionValueFilters("Color:Black, size:M ,color:navy blue,color:black")
+
+	require.NoError(t, err)
+	assert.Equal(t, []model.OptionValueFilter{
+		{Name: "color", Values: []string{"black", "navy blue"}},
+		{Name: "size", Values: []string{"m"}},
+	}, filters)
+}
+
+func TestParseOptionValueFilters_SkipsEmptyPairs(t *testing.T) {
+	filters, err := utils.ParseOptionValueFilters(",size:l,,")
+
+	require.NoError(t, err)
+	assert.Equal(t, []model.OptionValueFilter{{Name: "size", Values: []string{"l"}}}, filters)
+
+	filters, err = utils.ParseOptionValueFilters("")
+	require.NoError(t, err)
+	assert.Empty(t, filters)
+}
+
+func TestParseOptionValueFilters_RejectsMalformedPairs(t *testing.T) {
+	for _, raw := range []string{"black", "color:", ":black", "color: "} {
+		_, err := utils.ParseOptionValueFilters(raw)
+		assert.EqualError(t, err, utils.INVALID_OPTION_FILTER_MSG, raw)
+	}
+}
+
+func TestParseOptionValueFilters_Limits(t *testing.T) {
+	_, err := utils.ParseOptionValueFilters("a:1,b:1,c:1,d:1,e:1,f:1")
+	assert.EqualError(t, err, utils.OPTION_FILTER_TOO_MANY_OPTIONS_MSG)
+
+	pairs := make([]string, utils.OPTION_FILTER_MAX_VALUES+1)
+	for i := range pairs {
+		pairs[i] = "size:" + strings.Repeat("x", i+1)
+	}
+	_, err = utils.ParseOptionValueFilters(strings.Join(pairs, ","))
+	assert.EqualError(t, err, utils.OPTION_FILTER_TOO_MANY_VALUES_MSG)
+}
@@ -0,0 +1,93 @@
+package utils_test
+
+import (
+	"testing"
+
+	"ecommerce-be/common/db"
+	"ecommerce-be/product/entity"
+	"ecommerce-be/product/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	colorOption   = uint(1)
+	storageOption = uint(2)
+	sizeOption    = uint(3)
+
+	colorRed     = uint(10)
+	colorSpecial = uint(11)
+	storage512   = uint(20)
+	storage1TB   = uint(21)
+)
+
+// storageNeedsSpecialEdition: Storage only applies when Color=Special Edition
+func storageNeedsSpecialEdition() entity.ProductOptionRule {
+	return entity.ProductOptionRule{
+		OptionID:          storageOption,
+		ConditionOptionID: colorOption,
+		ConditionValueIDs: db.Int64Array{int64(colorSpecial)},
+	}
+}
+
+func TestFindOptionRuleViolation_WholeOptionRule(t *testing.T) {
+	rules := []entity.ProductOptionRule{storageNeedsSpecialEdition()}
+
+	assert.Nil(t, utils.FindOptionRuleViolation(rules, utils.OptionSelection{
+		colorOption: colorSpecial, storageOption: storage512,
+	}))
+	assert.Nil(t, utils.FindOptionRuleViolation(rules, utils.OptionSelection{
+		colorOption: colorRed,
+	}))
+
+	violated := utils.FindOptionRuleViolation(rules, utils.OptionSelection{
+		colorOption: colorRed, storageOption: storage512,
+	})
+	require.NotNil(t, violated)
+	assert.Equal(t, storageOption, violated.OptionID)
+}
+
+func TestFindOptionRuleViolation_ValueRuleOnlyRestrictsListedValues(t *testing.T) {
+	rule := storageNeedsSpecialEdition()
+	rule.OptionValueIDs = db.Int64Array{int64(storage1TB)}
+	rules := []entity.ProductOptionRule{rule}
+
+	assert.Nil(t, utils.FindOptionRuleViolation(rules, utils.OptionSelection{
+		colorOption: colorRed, storageOption: storage512,
+	}))
+	assert.NotNil(t, utils.FindOptionRuleViolation(rules, utils.OptionSelection{
+		colorOption: colorRed, storageOption: storage1TB,
+	}))
+	assert.True(t, utils.OptionApplies(rules, storageOption, utils.OptionSelection{
+		colorOption: colorRed,
+	}))
+}
+
+func TestOptionApplies(t *testing.T) {
+	rules := []entity.ProductOptionRule{storageNeedsSpecialEdition()}
+
+	assert.False(t, utils.OptionApplies(rules, storageOption, utils.OptionSelection{
+		colorOption: colorRed,
+	}))
+	assert.True(t, utils.OptionApplies(rules, storageOption, utils.OptionSelection{
+		colorOption: colorSpecial,
+	}))
+	assert.True(t, utils.OptionApplies(rules, sizeOption, utils.OptionSelection{
+		colorOption: colorRed,
+	}))
+}
+
+func TestOptionRulesHaveCycle(t *testing.T) {
+	chain := []entity.ProductOptionRule{
+		storageNeedsSpecialEdition(),
+		{OptionID: sizeOption, ConditionOptionID: storageOption},
+	}
+	assert.False(t, utils.OptionRulesHaveCycle(chain))
+
+	loop := append(chain, entity.ProductOptionRule{
+		OptionID:          colorOption,
+		ConditionOptionID: sizeOption,
+	})
+	assert.True(t, utils.OptionRulesHaveCycle(loop))
+}
@@ -0,0 +1,76 @@
+package utils_test
+
+import (
+	"testing"
+
+	"ecommerce-be/product/model"
+	"ecommerce-be/product/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func batchProduct(id, sellerID uint) *model.ProductResponse {
+	return &model.ProductResponse{ID: id, SellerID: sellerID}
+}
+
+func TestBuildBatchProductResults_RequestOrder(t *testing.T) {
+	// Products come back from the store in its own order
+	products := []*model.ProductResponse{batchProduct(1, 9), batchProduct(2, 9), batchProduct(3, 9)}
+
+	results := utils.BuildBatchProductResults([]uint{3, 1, 2}, products, nil)
+
+	require.Len(t, results, 3)
+	for i, id := range []uint{3, 1, 2} {
+		assert.Equal(t, id, results[i].ProductID)
+		assert.True(t, results[i].Found)
+		require.NotNil(t, results[i].Product)
+		assert.Equal(t, id, results[i].Product.ID)
+	}
+}
+
+func TestBuildBatchProductResults_MissingIDs(t *testing.T) {
+	products := []*model.ProductResponse{batchProduct(5, 9)}
+
+	results := utils.BuildBatchProductResults([]uint{4, 5, 6}, products, nil)
+
+	assert.Equal(t, []model.BatchProductResult{
+		{ProductID: 4, Found: false},
+		{ProductID: 5, Found: true, Product: products[0]},
+		{ProductID: 6, Found: false},
+	}, results)
+}
+
+func TestBuildBatchProductResults_RepeatedIDsShareResponse(t *testing.T) {
+	products := []*model.ProductResponse{batchProduct(7, 9)}
+
+	results := utils.BuildBatchProductResults([]uint{7, 8, 7}, products, nil)
+
+	require.Len(t, results, 3)
+	assert.True(t, results[0].Found)
+	assert.False(t, results[1].Found)
+	assert.True(t, results[2].Found)
+	assert.Same(t, results[0].Product, results[2].Product)
+}
+
+func TestBuildBatchProductResults_SellerScoping(t *testing.T) {
+	sellerID := uint(9)
+	products := []*model.ProductResponse{batchProduct(1, 9), batchProduct(2, 4)}
+
+	scoped := utils.BuildBatchProductResults([]uint{1, 2}, products, &sellerID)
+	assert.True(t, scoped[0].Found)
+	assert.False(t, scoped[1].Found, "another seller's product is not found")
+	assert.Nil(t, scoped[1].Product)
+
+	// Without a seller scope every loaded product is visible
+	public := utils.BuildBatchProductResults([]uint{1, 2}, products, nil)
+	assert.True(t, public[0].Found)
+	assert.True(t, public[1].Found)
+}
+
+func TestBuildBatchProductResults_EmptyRequest(t *testing.T) {
+	results := utils.BuildBatchProductResults(nil, nil, nil)
+
+	assert.NotNil(t, results)
+	assert.Empty(t, results)
+}
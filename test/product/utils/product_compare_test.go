@@ -0,0 +1,93 @@
+package utils_test
+
+import (
+	"testing"
+
+	"ecommerce-be/product/entity"
+	"ecommerce-be/product/model"
+	"ecommerce-be/product/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCompareProductIDs(t *testing.T) {
+	ids, err := utils.ParseCompareProductIDs(" 12,7, ,12,31")
+
+	require.NoError(t, err)
+	assert.Equal(t, []uint{12, 7, 31}, ids)
+}
+
+func TestParseCompareProductIDs_Invalid(t *testing.T) {
+	cases := map[string]string{
+		"1,abc":       utils.INVALID_PRODUCT_COMPARE_MSG,
+		"1,0":         utils.INVALID_PRODUCT_COMPARE_MSG,
+		"1,-2":        utils.INVALID_PRODUCT_COMPARE_MSG,
+		"":            utils.PRODUCT_COMPARE_TOO_FEW_MSG,
+		"4,4":         utils.PRODUCT_COMPARE_TOO_FEW_MSG,
+		"1,2,3,4,5,6": utils.PRODUCT_COMPARE_TOO_MANY_MSG,
+	}
+	for raw, msg := range cases {
+		_, err := utils.ParseCompareProductIDs(raw)
+		require.Error(t, err, raw)
+		assert.Equal(t, msg, err.Error(), raw)
+	}
+}
+
+func compareAttribute(
+	productID uint,
+	definition *entity.AttributeDefinition,
+	value string,
+	sortOrder uint,
+) entity.ProductAttribute {
+	return entity.ProductAttribute{
+		ProductID:             productID,
+		AttributeDefinitionID: definition.ID,
+		AttributeDefinition:   definition,
+		Value:                 value,
+		SortOrder:             sortOrder,
+	}
+}
+
+func TestBuildCompareAttributeRows(t *testing.T) {
+	weight := &entity.AttributeDefinition{Key: "weight", Name: "Weight", Unit: "g"}
+	weight.ID = 1
+	color := &entity.AttributeDefinition{Key: "color", Name: "Color"}
+	color.ID = 2
+	battery := &entity.AttributeDefinition{Key: "battery", Name: "Battery", Unit: "mAh"}
+	battery.ID = 3
+
+	rows := utils.BuildCompareAttributeRows([]uint{20, 10}, []entity.ProductAttribute{
+		compareAttribute(10, weight, "180", 0),
+		compareAttribute(10, battery, "4000", 1),
+		compareAttribute(20, color, " Space  Gray ", 1),
+		compareAttribute(20, weight, "180", 0),
+		compareAttribute(10, color, "space gray", 2),
+	})
+
+	require.Len(t, rows, 3)
+	assert.Equal(t, model.CompareAttributeRow{
+		Key:  "weight",
+		Name: "Weight",
+		Unit: "g",
+		Values: []model.CompareAttributeValue{
+			{ProductID: 20, Value: "180", Present: true},
+			{ProductID: 10, Value: "180", Present: true},
+		},
+	}, rows[0])
+
+	assert.Equal(t, "color", rows[1].Key)
+	assert.Equal(t, "Space Gray", rows[1].Values[0].Value)
+	assert.False(t, rows[1].Differs)
+
+	assert.Equal(t, "battery", rows[2].Key)
+	assert.Equal(t, model.CompareAttributeValue{ProductID: 20}, rows[2].Values[0])
+	assert.True(t, rows[2].Differs)
+}
+
+func TestBuildCompareAttributeRows_NoAttributes(t *testing.T) {
+	rows := utils.BuildCompareAttributeRows([]uint{1, 2}, nil)
+
+	assert.NotNil(t, rows)
+	assert.Empty(t, rows)
+}
@@ -0,0 +1,95 @@
+package utils_test
+
+import (
+	"testing"
+
+	"ecommerce-be/product/factory"
+	"ecommerce-be/product/mapper"
+	"ecommerce-be/product/model"
+	"ecommerce-be/product/utils"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPriceBucketEdges_ReadableSteps(t *testing.T) {
+	assert.Equal(t, []float64{0, 20, 40, 60, 80, 100}, utils.PriceBucketEdges(10, 95))
+	assert.Equal(t, []float64{1000, 1250, 1500, 1750, 2000, 2250}, utils.PriceBucketEdges(1020, 2199))
+	assert.Equal(t, []float64{0.5, 0.6, 0.7, 0.8, 0.9, 1, 1.1}, utils.PriceBucketEdges(0.5, 1.0))
+}
+
+func TestPriceBucketEdges_LastEdgeAboveMax(t *testing.T) {
+	edges := utils.PriceBucketEdges(0, 100)
+
+	assert.Greater(t, edges[len(edges)-1], 100.0)
+	assert.LessOrEqual(t, len(edges)-1, utils.FACET_PRICE_BUCKETS+1)
+}
+
+func TestPriceBucketEdges_EmptyRange(t *testing.T) {
+	assert.Nil(t, utils.PriceBucketEdges(25, 25))
+	assert.Nil(t, utils.PriceBucketEdges(0, 0))
+}
+
+func TestBuildProductFacets_PriceBucketsTileRange(t *testing.T) {
+	facets := factory.BuildProductFacets(&mapper.ProductFacetData{
+		PriceRange:   mapper.PriceRangeData{MinPrice: 10, MaxPrice: 55, ProductCount: 4},
+		PriceEdges:   []float64{0, 20, 40, 60},
+		PriceBuckets: []mapper.PriceBucketRow{{Bucket: 1, ProductCount: 3}, {Bucket: 3, ProductCount: 1}},
+	})
+
+	assert.Equal(t, []model.PriceRangeFilter{
+		{Min: 0, Max: 20, ProductCount: 3},
+		{Min: 20, Max: 40, ProductCount: 0},
+		{Min: 40, Max: 60, ProductCount: 1},
+	}, facets.PriceBuckets)
+}
+
+func TestBuildProductFacets_SinglePrice(t *testing.T) {
+	facets := factory.BuildProductFacets(&mapper.ProductFacetData{
+		PriceRange: mapper.PriceRangeData{MinPrice: 25, MaxPrice: 25, ProductCount: 2},
+	})
+
+	assert.Equal(t, []model.PriceRangeFilter{{Min: 25, Max: 25, ProductCount: 2}}, facets.PriceBuckets)
+}
+
+func TestBuildProductFacets_GroupsOptionValues(t *testing.T) {
+	facets := factory.BuildProductFacets(&mapper.ProductFacetData{
+		OptionValues: []mapper.OptionValueFacetRow{
+			{OptionName: "color", OptionDisplayName: "Color", OptionValue: "Red",
+				ValueDisplayName: "Red", ColorCode: "#FF0000", ProductCount: 5},
+			{OptionName: "color", OptionDisplayName: "Color", OptionValue: "Blue",
+				ValueDisplayName: "Blue", ProductCount: 2},
+			{OptionName: "size", OptionDisplayName: "Size", OptionValue: "M",
+				ValueDisplayName: "Medium", ProductCount: 4},
+		},
+	})
+
+	assert.Len(t, facets.Options, 2)
+	assert.Equal(t, "Color", facets.Options[0].DisplayName)
+	assert.Equal(t, []model.VariantOptionFilter{
+		{Value: "Red", DisplayName: "Red", ColorCode: "#FF0000", ProductCount: 5},
+		{Value: "Blue", DisplayName: "Blue", ProductCount: 2},
+	}, facets.Options[0].Values)
+	assert.Equal(t, "size", facets.Options[1].Name)
+	assert.Equal(t, "Medium", facets.Options[1].Values[0].DisplayName)
+}
+
+func TestBuildProductFacets_CapsOptionValues(t *testing.T) {
+	rows := make([]mapper.OptionValueFacetRow, utils.FACET_MAX_OPTION_VALUES+5)
+	for i := range rows {
+		rows[i] = mapper.OptionValueFacetRow{OptionName: "size", ProductCount: 1}
+	}
+
+	facets := factory.BuildProductFacets(&mapper.ProductFacetData{OptionValues: rows})
+
+	assert.Len(t, facets.Options, 1)
+	assert.Len(t, facets.Options[0].Values, utils.FACET_MAX_OPTION_VALUES)
+}
+
+func TestBuildProductFacets_EmptyResultSet(t *testing.T) {
+	facets := factory.BuildProductFacets(&mapper.ProductFacetData{})
+
+	assert.Empty(t, facets.Brands)
+	assert.NotNil(t, facets.Categories)
+	assert.NotNil(t, facets.PriceBuckets)
+	assert.NotNil(t, facets.Options)
+}
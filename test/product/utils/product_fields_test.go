@@ -0,0 +1,128 @@
+package utils_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"ecommerce-be/product/model"
+	"ecommerce-be/product/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseProductFields(t *testing.T) {
+	fields, err := utils.ParseProductFields(" name, priceRange ,,variantPreview")
+
+	require.NoError(t, err)
+	assert.Equal(t, model.ProductFieldSet{
+		"id":             true,
+		"name":           true,
+		"priceRange":     true,
+		"variantPreview": true,
+	}, fields)
+}
+
+func TestParseProductFields_BlankSelectsEverything(t *testing.T) {
+	fields, err := utils.ParseProductFields("  ")
+
+	require.NoError(t, err)
+	assert.Nil(t, fields)
+	assert.True(t, fields.Includes("media"))
+}
+
+func TestParseProductFields_Invalid(t *testing.T) {
+	_, err := utils.ParseProductFields("name,relevanceScore")
+	require.Error(t, err)
+	assert.Equal(t, "fields lists an unknown field: relevanceScore", err.Error())
+
+	_, err = utils.ParseProductFields(",,")
+	require.Error(t, err)
+	assert.Equal(t, utils.INVALID_PRODUCT_FIELDS_MSG, err.Error())
+}
+
+func TestParseSearchResultFields_AcceptsResultFields(t *testing.T) {
+	fields, err := utils.ParseSearchResultFields("name,relevanceScore")
+
+	require.NoError(t, err)
+	assert.True(t, fields.Includes("relevanceScore"))
+	assert.False(t, fields.Includes("media"))
+}
+
+func TestProjectProduct(t *testing.T) {
+	product := model.ProductResponse{
+		ID:         7,
+		Name:       "Trail Runner",
+		Price:      89.5,
+		PriceRange: &model.PriceRange{Min: 79, Max: 99},
+		Variants:   []model.VariantDetailResponse{},
+	}
+	fields := model.ProductFieldSet{
+		"id":             true,
+		"name":           true,
+		"priceRange":     true,
+		"variantPreview": true,
+	}
+
+	projected := utils.ProjectProduct(&product, fields)
+
+	// variantPreview is omitempty and unset, so it is left out like in the full JSON
+	body, err := json.Marshal(projected)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"id":7,"name":"Trail Runner","priceRange":{"min":79,"max":99}}`,
+		string(body))
+}
+
+func TestProjectSearchResponse_FlattensEmbeddedProduct(t *testing.T) {
+	response := &model.SearchResponse{
+		Query: "runner",
+		Results: []model.SearchResult{{
+			ProductResponse: model.ProductResponse{ID: 7, Name: "Trail Runner"},
+			RelevanceScore:  4.5,
+		}},
+	}
+	fields := model.ProductFieldSet{"id": true, "relevanceScore": true}
+
+	sparse := utils.ProjectSearchResponse(response, fields)
+
+	assert.Equal(t, "runner", sparse.Query)
+	assert.Equal(t, []map[string]any{{"id": uint(7), "relevanceScore": 4.5}}, sparse.Results)
+}
+
+func TestParseProductIncludes(t *testing.T) {
+	includes, err := utils.ParseProductIncludes("category, attributes,category,packageOptions")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"category", "attributes", "packageOptions"}, includes)
+
+	includes, err = utils.ParseProductIncludes("")
+	require.NoError(t, err)
+	assert.Nil(t, includes)
+
+	_, err = utils.ParseProductIncludes("category,reviews")
+	require.Error(t, err)
+	assert.Equal(t, "include lists an unknown association: reviews", err.Error())
+
+	_, err = utils.ParseProductIncludes(" , ")
+	require.Error(t, err)
+	assert.Equal(t, utils.INVALID_PRODUCT_INCLUDE_MSG, err.Error())
+}
+
+func TestApplyProductIncludes(t *testing.T) {
+	fields := utils.ApplyProductIncludes(nil, []string{"attributes"})
+
+	assert.True(t, fields.Includes("name"))
+	assert.True(t, fields.Includes("priceRange"))
+	assert.True(t, fields.Includes("attributes"))
+	for _, association := range []string{"category", "packageOptions", "options", "variants", "media"} {
+		assert.False(t, fields.Includes(association), association)
+	}
+}
+
+func TestApplyProductIncludes_NarrowsSelectedFields(t *testing.T) {
+	selected := model.ProductFieldSet{"id": true, "name": true, "variants": true, "media": true}
+
+	fields := utils.ApplyProductIncludes(selected, []string{"media"})
+
+	assert.Equal(t, model.ProductFieldSet{"id": true, "name": true, "media": true}, fields)
+	assert.Equal(t, selected, utils.ApplyProductIncludes(selected, nil))
+}
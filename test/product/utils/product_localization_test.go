@@ -0,0 +1,90 @@
+package utils_test
+
+import (
+	"context"
+	"testing"
+
+	"ecommerce-be/common/reqctx"
+	"ecommerce-be/product/entity"
+	"ecommerce-be/product/model"
+	"ecommerce-be/product/utils"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocaleCandidates_ExpandsPrimaryAndStopsAtDefault(t *testing.T) {
+	cases := []struct {
+		requested []string
+		want      []string
+	}{
+		{[]string{"es-mx", "fr"}, []string{"es-mx", "es", "fr"}},
+		{[]string{"PT_BR"}, []string{"pt-br", "pt"}},
+		{[]string{"de", "en", "fr"}, []string{"de"}},
+		{[]string{"en-gb", "es"}, []string{"en-gb"}},
+		{[]string{"es", "es-ar", "*", "fr"}, []string{"es", "es-ar"}},
+		{[]string{"en"}, []string{}},
+		{nil, []string{}},
+	}
+	for _, tc := range cases {
+		assert.Equal(t, tc.want, utils.LocaleCandidates(tc.requested, "en"), "%v", tc.requested)
+	}
+}
+
+func TestRequestedContentLocales_PrefersOverrideThenAcceptLanguage(t *testing.T) {
+	preferred := []string{"fr", "es"}
+	ctx := reqctx.PreferredLanguages.With(context.Background(), preferred)
+	assert.Equal(t, []string{"fr", "es"}, utils.RequestedContentLocales(ctx))
+
+	ctx = utils.ContentLocale.With(ctx, "hi")
+	assert.Equal(t, []string{"hi"}, utils.RequestedContentLocales(ctx))
+
+	assert.Equal(t, []string{"en"}, utils.RequestedContentLocales(context.Background()))
+}
+
+func TestProductLocalization_PickLocale(t *testing.T) {
+	l := utils.NewProductLocalization()
+	l.AddOptionName("fr", "color", "Couleur")
+	l.Content["es"] = entity.ProductTranslation{Locale: "es", Name: "Camiseta"}
+
+	locale, ok := l.PickLocale([]string{"de", "fr", "es"})
+	assert.True(t, ok)
+	assert.Equal(t, "fr", locale)
+
+	_, ok = l.PickLocale([]string{"de"})
+	assert.False(t, ok)
+}
+
+func TestLocalizeProductResponse_FallsBackPerField(t *testing.T) {
+	resp := model.ProductResponse{
+		Name:             "T-Shirt",
+		ShortDescription: "Cotton tee",
+		LongDescription:  "A soft cotton tee",
+		Options: []model.ProductOptionDetailResponse{
+			{OptionName: "color", OptionDisplayName: "Color"},
+			{OptionName: "size", OptionDisplayName: "Size"},
+		},
+		VariantPreview: &model.VariantPreview{
+			Options: []model.OptionPreview{{Name: "color", DisplayName: "Color"}},
+		},
+		Variants: []model.VariantDetailResponse{
+			variant(1, 10, true, true, false, model.VariantOptionResponse{
+				OptionName:        "color",
+				OptionDisplayName: "Color",
+			}),
+		},
+	}
+
+	utils.LocalizeProductResponse(
+		&resp,
+		&entity.ProductTranslation{Name: "Camiseta", LongDescription: "Una camiseta suave"},
+		map[string]string{"color": "Color (es)"},
+	)
+
+	assert.Equal(t, "Camiseta", resp.Name)
+	assert.Equal(t, "Cotton tee", resp.ShortDescription)
+	assert.Equal(t, "Una camiseta suave", resp.LongDescription)
+	assert.Equal(t, "Color (es)", resp.Options[0].OptionDisplayName)
+	assert.Equal(t, "Size", resp.Options[1].OptionDisplayName)
+	assert.Equal(t, "Color (es)", resp.VariantPreview.Options[0].DisplayName)
+	assert.Equal(t, "Color (es)", resp.Variants[0].SelectedOptions[0].OptionDisplayName)
+}
@@ -0,0 +1,36 @@
+package utils_test
+
+import (
+	"testing"
+
+	"ecommerce-be/product/utils"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeProductMediaType(t *testing.T) {
+	assert.Equal(t, utils.PRODUCT_MEDIA_TYPE_IMAGE, utils.NormalizeProductMediaType(""))
+	assert.Equal(t, utils.PRODUCT_MEDIA_TYPE_VIDEO, utils.NormalizeProductMediaType(" video "))
+	assert.Equal(t, utils.PRODUCT_MEDIA_TYPE_MODEL_3D, utils.NormalizeProductMediaType("MODEL_3D"))
+}
+
+func TestProductMediaMimeAllowed(t *testing.T) {
+	cases := []struct {
+		mediaType string
+		mimeType  string
+		allowed   bool
+	}{
+		{utils.PRODUCT_MEDIA_TYPE_IMAGE, "image/webp", true},
+		{utils.PRODUCT_MEDIA_TYPE_IMAGE, "video/mp4", false},
+		{utils.PRODUCT_MEDIA_TYPE_VIDEO, "Video/MP4", true},
+		{utils.PRODUCT_MEDIA_TYPE_VIDEO, "image/png", false},
+		{utils.PRODUCT_MEDIA_TYPE_MODEL_3D, "model/gltf-binary", true},
+		{utils.PRODUCT_MEDIA_TYPE_MODEL_3D, "model/vnd.usdz+zip", true},
+		{utils.PRODUCT_MEDIA_TYPE_MODEL_3D, "application/octet-stream", false},
+		{"AUDIO", "audio/mpeg", false},
+	}
+	for _, tc := range cases {
+		assert.Equal(t, tc.allowed, utils.ProductMediaMimeAllowed(tc.mediaType, tc.mimeType),
+			"%s %s", tc.mediaType, tc.mimeType)
+	}
+}
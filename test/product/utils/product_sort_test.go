@@ -0,0 +1,31 @@
+package utils_test
+
+import (
+	"testing"
+
+	productQuery "ecommerce-be/product/query"
+	"ecommerce-be/product/utils/helper"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeProductSortColumn(t *testing.T) {
+	cases := map[string]string{
+		"":           "created_at",
+		"newest":     "created_at",
+		"price":      productQuery.SORT_BY_PRICE_EXPR,
+		"popularity": productQuery.SORT_BY_POPULARITY_EXPR,
+		"sales":      productQuery.SORT_BY_POPULARITY_EXPR,
+		"discount":   productQuery.SORT_BY_DISCOUNT_EXPR,
+	}
+	for sortBy, want := range cases {
+		column, ok := helper.NormalizeProductSortColumn(sortBy)
+		assert.True(t, ok, sortBy)
+		assert.Equal(t, want, column, sortBy)
+	}
+}
+
+func TestNormalizeProductSortColumn_RejectsUnknown(t *testing.T) {
+	_, ok := helper.NormalizeProductSortColumn("rating; DROP TABLE product")
+	assert.False(t, ok)
+}
@@ -0,0 +1,35 @@
+package utils_test
+
+import (
+	"strconv"
+	"testing"
+
+	"ecommerce-be/product/utils"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseRecommendationLimit(t *testing.T) {
+	maxLimit := strconv.Itoa(utils.RECOMMENDATION_MAX_LIMIT)
+	overMax := strconv.Itoa(utils.RECOMMENDATION_MAX_LIMIT + 1)
+
+	cases := []struct {
+		raw   string
+		limit int
+		ok    bool
+	}{
+		{"1", 1, true},
+		{"10", 10, true},
+		{maxLimit, utils.RECOMMENDATION_MAX_LIMIT, true},
+		{overMax, 0, false},
+		{"0", 0, false},
+		{"-3", 0, false},
+		{"ten", 0, false},
+		{"", 0, false},
+	}
+	for _, tc := range cases {
+		limit, ok := utils.ParseRecommendationLimit(tc.raw)
+		assert.Equal(t, tc.ok, ok, tc.raw)
+		assert.Equal(t, tc.limit, limit, tc.raw)
+	}
+}
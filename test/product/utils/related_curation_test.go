@@ -0,0 +1,66 @@
+package utils_test
+
+import (
+	"testing"
+
+	"ecommerce-be/product/entity"
+	"ecommerce-be/product/utils"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateRelatedCuration(t *testing.T) {
+	tests := []struct {
+		name     string
+		pinned   []uint
+		excluded []uint
+		wantErr  bool
+	}{
+		{"pins and exclusions", []uint{3, 2}, []uint{4}, false},
+		{"nothing curated", nil, nil, false},
+		{"only pins", []uint{2, 3, 4}, nil, false},
+		{"only exclusions", nil, []uint{5, 6}, false},
+		{"pinned to itself", []uint{2, 1}, nil, true},
+		{"excluded from itself", nil, []uint{1}, true},
+		{"pinned and excluded", []uint{2, 3}, []uint{3}, true},
+		{"first pin also excluded", []uint{2, 3}, []uint{4, 2}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := utils.ValidateRelatedCuration(1, tt.pinned, tt.excluded)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestBuildRelatedProductOverrides_PinsKeepRequestOrder(t *testing.T) {
+	overrides := utils.BuildRelatedProductOverrides(1, []uint{7, 3, 9}, []uint{4, 2})
+
+	type row struct {
+		relatedID uint
+		mode      string
+		position  int
+	}
+	rows := make([]row, len(overrides))
+	for i, override := range overrides {
+		assert.Equal(t, uint(1), override.ProductID)
+		rows[i] = row{override.RelatedProductID, override.Mode, override.Position}
+	}
+	assert.Equal(t, []row{
+		{7, entity.RELATED_OVERRIDE_MODE_PIN, 0},
+		{3, entity.RELATED_OVERRIDE_MODE_PIN, 1},
+		{9, entity.RELATED_OVERRIDE_MODE_PIN, 2},
+		{4, entity.RELATED_OVERRIDE_MODE_EXCLUDE, 0},
+		{2, entity.RELATED_OVERRIDE_MODE_EXCLUDE, 0},
+	}, rows)
+}
+
+func TestBuildRelatedProductOverrides_Empty(t *testing.T) {
+	overrides := utils.BuildRelatedProductOverrides(1, nil, nil)
+	assert.NotNil(t, overrides)
+	assert.Empty(t, overrides)
+}
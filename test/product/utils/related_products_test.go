@@ -0,0 +1,41 @@
+package utils_test
+
+import (
+	"testing"
+
+	"ecommerce-be/product/utils"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeRelatedStrategies(t *testing.T) {
+	tests := []struct {
+		name       string
+		strategies string
+		want       string
+	}{
+		{"empty falls back to all", "", "all"},
+		{"only separators fall back to all", " , ,", "all"},
+		{"all", "all", "all"},
+		{"all wins over named strategies", "same_brand,all", "all"},
+		{"all wins after duplicates", "same_brand,same_brand,all", "all"},
+		{"single strategy", "same_brand", "same_brand"},
+		{"trimmed", "  tag_matching ", "tag_matching"},
+		{"duplicates collapsed", "same_brand,same_brand", "same_brand"},
+		{
+			"sorted and de-duplicated",
+			" same_category, same_brand,same_category ",
+			"same_brand,same_category",
+		},
+		{
+			"order does not matter",
+			"price_range,child_category,same_brand",
+			"child_category,price_range,same_brand",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, utils.NormalizeRelatedStrategies(tt.strategies))
+		})
+	}
+}
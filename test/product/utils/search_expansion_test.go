@@ -0,0 +1,65 @@
+package utils_test
+
+import (
+	"testing"
+
+	"ecommerce-be/common/db"
+	"ecommerce-be/product/utils"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var phoneSynonyms = [][]string{
+	{"cellphone", "smartphone", "mobile"},
+	{"cell phone", "handset"},
+}
+
+func TestNormalizeSearchTerm(t *testing.T) {
+	assert.Equal(t, "cell phone", utils.NormalizeSearchTerm("  Cell \t PHONE "))
+	assert.Equal(t, "", utils.NormalizeSearchTerm("   "))
+}
+
+func TestExpandSearchQuery_WithoutSettings(t *testing.T) {
+	assert.Equal(t, [][]string{{"red"}, {"shoes"}}, utils.ExpandSearchQuery(" Red  SHOES", nil, nil))
+	assert.Nil(t, utils.ExpandSearchQuery("   ", nil, nil))
+}
+
+func TestExpandSearchQuery_DropsStopWords(t *testing.T) {
+	stopWords := []string{"for", "the"}
+
+	assert.Equal(t, [][]string{{"shoes"}, {"men"}},
+		utils.ExpandSearchQuery("shoes for the men", stopWords, nil))
+	assert.Equal(t, [][]string{{"the"}},
+		utils.ExpandSearchQuery("The", stopWords, nil), "a query of only stop words is kept")
+}
+
+func TestExpandSearchQuery_AddsSynonyms(t *testing.T) {
+	assert.Equal(t,
+		[][]string{{"red"}, {"cellphone", "smartphone", "mobile"}, {"case"}},
+		utils.ExpandSearchQuery("red Mobile case", nil, phoneSynonyms),
+	)
+	assert.Equal(t,
+		[][]string{{"cell phone", "handset"}, {"case"}},
+		utils.ExpandSearchQuery("cell phone case", nil, phoneSynonyms),
+		"the longest run of words that is a synonym wins",
+	)
+}
+
+func TestExpandSearchQuery_KeepsStopWordsInsideSynonyms(t *testing.T) {
+	synonyms := [][]string{{"t shirt", "tee"}}
+
+	assert.Equal(t, [][]string{{"t shirt", "tee"}},
+		utils.ExpandSearchQuery("a t shirt", []string{"a", "t"}, synonyms))
+}
+
+func TestExpandSearchQuery_CapsTerms(t *testing.T) {
+	terms := utils.ExpandSearchQuery("a b c d e f g h i j", nil, nil)
+	assert.Len(t, terms, utils.SEARCH_MAX_QUERY_TERMS)
+}
+
+func TestSearchPatterns(t *testing.T) {
+	assert.Equal(t, db.StringArray{"%mobile%", "%handset%"},
+		utils.SearchPatterns("mobile", "handset"))
+	assert.Equal(t, db.StringArray{"%red%", "%cellphone%", "%mobile%"},
+		utils.AllSearchPatterns([][]string{{"red"}, {"cellphone", "mobile"}}))
+}
@@ -0,0 +1,127 @@
+package utils_test
+
+import (
+	"testing"
+
+	"ecommerce-be/product/entity"
+	"ecommerce-be/product/mapper"
+	"ecommerce-be/product/model"
+	"ecommerce-be/product/utils"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func settingsWithSort(sort entity.ListingSort, order string) entity.SearchSettings {
+	settings := entity.DefaultSearchSettings(7)
+	settings.DefaultSort = sort
+	settings.DefaultSortOrder = order
+	return settings
+}
+
+func TestResolveListingSort_ExplicitSortWins(t *testing.T) {
+	sortBy, sortOrder := utils.ResolveListingSort(
+		"name",
+		"asc",
+		settingsWithSort(entity.LISTING_SORT_PRICE, "desc"),
+	)
+	assert.Equal(t, "name", sortBy)
+	assert.Equal(t, "asc", sortOrder)
+}
+
+func TestResolveListingSort_SellerDefaults(t *testing.T) {
+	cases := []struct {
+		sort      entity.ListingSort
+		order     string
+		wantSort  string
+		wantOrder string
+	}{
+		{entity.LISTING_SORT_NEWEST, "desc", "createdAt", "desc"},
+		{entity.LISTING_SORT_PRICE, "asc", "price", "asc"},
+		{entity.LISTING_SORT_POPULARITY, "desc", "popularity", "desc"},
+		{entity.LISTING_SORT_RELEVANCE, "asc", "createdAt", "desc"},
+	}
+	for _, tc := range cases {
+		sortBy, sortOrder := utils.ResolveListingSort("", "", settingsWithSort(tc.sort, tc.order))
+		assert.Equal(t, tc.wantSort, sortBy, tc.sort)
+		assert.Equal(t, tc.wantOrder, sortOrder, tc.sort)
+	}
+}
+
+func TestResolveSearchRanking(t *testing.T) {
+	relevance := settingsWithSort(entity.LISTING_SORT_RELEVANCE, "desc")
+	relevance.NameWeight = 5
+
+	ranking := utils.ResolveSearchRanking("", "", relevance)
+	assert.Equal(t, utils.RELEVANCE_SORT_KEY, ranking.SortBy)
+	assert.Equal(t, model.RelevanceWeights{Name: 5, Tags: 2, Description: 1}, ranking.Weights)
+
+	ranking = utils.ResolveSearchRanking("price", "asc", relevance)
+	assert.Equal(t, "price", ranking.SortBy)
+	assert.Equal(t, "asc", ranking.SortOrder)
+
+	ranking = utils.ResolveSearchRanking("", "", settingsWithSort(entity.LISTING_SORT_PRICE, "asc"))
+	assert.Equal(t, "price", ranking.SortBy)
+	assert.Equal(t, "asc", ranking.SortOrder)
+}
+
+func TestScoreSearchMatch(t *testing.T) {
+	weights := model.RelevanceWeights{Name: 3, Tags: 2, Description: 1}
+
+	score, fields := utils.ScoreSearchMatch(
+		mapper.SearchMatchRow{NameMatch: true, DescriptionMatch: true},
+		weights,
+	)
+	assert.Equal(t, 0.67, score)
+	assert.Equal(t, []string{"name", "description"}, fields)
+
+	score, fields = utils.ScoreSearchMatch(mapper.SearchMatchRow{}, weights)
+	assert.Equal(t, 0.0, score)
+	assert.Empty(t, fields)
+
+	score, _ = utils.ScoreSearchMatch(
+		mapper.SearchMatchRow{TagsMatch: true},
+		model.RelevanceWeights{},
+	)
+	assert.Equal(t, 0.0, score)
+}
+
+func settingsWithStockDisplay(display entity.OutOfStockDisplay) entity.SearchSettings {
+	settings := entity.DefaultSearchSettings(7)
+	settings.OutOfStockDisplay = display
+	return settings
+}
+
+func TestResolveStockFilter_ExplicitInStockWins(t *testing.T) {
+	outOfStock := false
+
+	inStock, demote := utils.ResolveStockFilter(
+		&outOfStock,
+		settingsWithStockDisplay(entity.OUT_OF_STOCK_HIDE),
+	)
+	assert.Same(t, &outOfStock, inStock)
+	assert.False(t, demote)
+}
+
+func TestResolveStockFilter_SellerDisplay(t *testing.T) {
+	inStock, demote := utils.ResolveStockFilter(nil, settingsWithStockDisplay(entity.OUT_OF_STOCK_HIDE))
+	if assert.NotNil(t, inStock) {
+		assert.True(t, *inStock)
+	}
+	assert.False(t, demote)
+
+	inStock, demote = utils.ResolveStockFilter(nil, settingsWithStockDisplay(entity.OUT_OF_STOCK_DEMOTE))
+	assert.Nil(t, inStock)
+	assert.True(t, demote)
+
+	inStock, demote = utils.ResolveStockFilter(nil, settingsWithStockDisplay(entity.OUT_OF_STOCK_SHOW))
+	assert.Nil(t, inStock)
+	assert.False(t, demote)
+}
+
+func TestResolveOutOfStockDisplay_DefaultsToShow(t *testing.T) {
+	assert.Equal(t, entity.OUT_OF_STOCK_SHOW, utils.ResolveOutOfStockDisplay(settingsWithStockDisplay("")))
+	assert.Equal(t,
+		entity.OUT_OF_STOCK_DEMOTE,
+		utils.ResolveOutOfStockDisplay(settingsWithStockDisplay(entity.OUT_OF_STOCK_DEMOTE)),
+	)
+}
@@ -0,0 +1,74 @@
+package utils_test
+
+import (
+	"strings"
+	"testing"
+
+	"ecommerce-be/product/factory"
+	"ecommerce-be/product/mapper"
+	"ecommerce-be/product/model"
+	"ecommerce-be/product/utils"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeSuggestPrefix(t *testing.T) {
+	prefix, ok := utils.NormalizeSuggestPrefix("  Red \t SHO ")
+	assert.True(t, ok)
+	assert.Equal(t, "red sho", prefix)
+
+	_, ok = utils.NormalizeSuggestPrefix(" a ")
+	assert.False(t, ok, "a single character is too short")
+
+	prefix, ok = utils.NormalizeSuggestPrefix("éé")
+	assert.True(t, ok, "length counts characters, not bytes")
+	assert.Equal(t, "éé", prefix)
+
+	_, ok = utils.NormalizeSuggestPrefix(strings.Repeat("x", utils.SEARCH_SUGGEST_MAX_PREFIX_LENGTH+1))
+	assert.False(t, ok)
+}
+
+func TestSuggestPatterns(t *testing.T) {
+	start, word := utils.SuggestPatterns("iph")
+	assert.Equal(t, "iph%", start)
+	assert.Equal(t, "% iph%", word)
+
+	start, word = utils.SuggestPatterns(`50%_off\`)
+	assert.Equal(t, `50\%\_off\\%`, start, "wildcards in the prefix are escaped")
+	assert.Equal(t, `% 50\%\_off\\%`, word)
+}
+
+func TestClampSuggestLimit(t *testing.T) {
+	assert.Equal(t, utils.SEARCH_SUGGEST_DEFAULT_LIMIT, utils.ClampSuggestLimit(0))
+	assert.Equal(t, utils.SEARCH_SUGGEST_DEFAULT_LIMIT, utils.ClampSuggestLimit(-3))
+	assert.Equal(t, 3, utils.ClampSuggestLimit(3))
+	assert.Equal(t, utils.SEARCH_SUGGEST_MAX_LIMIT, utils.ClampSuggestLimit(100))
+}
+
+func TestBuildSuggestResponse_GroupsByKind(t *testing.T) {
+	rows := []mapper.SuggestionRow{
+		{Kind: utils.SEARCH_SUGGEST_KIND_BRAND, Text: "Apple", Score: 1},
+		{Kind: utils.SEARCH_SUGGEST_KIND_PRODUCT, RefID: 7, Text: "Apple iPhone", Slug: "apple-iphone"},
+		{Kind: utils.SEARCH_SUGGEST_KIND_CATEGORY, RefID: 3, Text: "Apparel", Slug: "apparel"},
+		{Kind: utils.SEARCH_SUGGEST_KIND_PRODUCT, RefID: 9, Text: "Appliance", Slug: "appliance"},
+	}
+
+	resp := factory.BuildSuggestResponse("app", rows)
+
+	assert.Equal(t, "app", resp.Query)
+	assert.Equal(t, []model.ProductSuggestion{
+		{ID: 7, Name: "Apple iPhone", Slug: "apple-iphone"},
+		{ID: 9, Name: "Appliance", Slug: "appliance"},
+	}, resp.Products)
+	assert.Equal(t, []model.CategorySuggestion{{ID: 3, Name: "Apparel", Slug: "apparel"}},
+		resp.Categories)
+	assert.Equal(t, []string{"Apple"}, resp.Brands)
+}
+
+func TestBuildSuggestResponse_EmptyListsWhenNoMatches(t *testing.T) {
+	resp := factory.BuildSuggestResponse("zz", nil)
+
+	assert.NotNil(t, resp.Products)
+	assert.NotNil(t, resp.Categories)
+	assert.NotNil(t, resp.Brands)
+}
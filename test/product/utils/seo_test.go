@@ -0,0 +1,25 @@
+package utils_test
+
+import (
+	"strings"
+	"testing"
+
+	"ecommerce-be/product/utils"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSlugBase(t *testing.T) {
+	assert.Equal(t, "summer-linen-shirt", utils.SlugBase("  Summer Linen Shirt! ", "product"))
+	assert.Equal(t, "product", utils.SlugBase("!!!", "product"))
+
+	long := utils.SlugBase(strings.Repeat("word ", 60), "product")
+	assert.LessOrEqual(t, len(long), utils.SLUG_BASE_MAX_LENGTH)
+	assert.False(t, strings.HasSuffix(long, "-"))
+}
+
+func TestSlugCandidate(t *testing.T) {
+	assert.Equal(t, "shirt", utils.SlugCandidate("shirt", 0))
+	assert.Equal(t, "shirt-2", utils.SlugCandidate("shirt", 1))
+	assert.Equal(t, "shirt-3", utils.SlugCandidate("shirt", 2))
+}
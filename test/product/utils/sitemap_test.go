@@ -0,0 +1,32 @@
+package utils_test
+
+import (
+	"testing"
+
+	"ecommerce-be/product/utils"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSitemapPageCount(t *testing.T) {
+	assert.Equal(t, 1, utils.SitemapPageCount(0))
+	assert.Equal(t, 1, utils.SitemapPageCount(utils.SITEMAP_PAGE_SIZE))
+	assert.Equal(t, 2, utils.SitemapPageCount(utils.SITEMAP_PAGE_SIZE+1))
+	assert.Equal(t, 3, utils.SitemapPageCount(3*utils.SITEMAP_PAGE_SIZE))
+}
+
+func TestSitemapPageBounds(t *testing.T) {
+	total := utils.SITEMAP_PAGE_SIZE + 10
+
+	start, end := utils.SitemapPageBounds(1, total)
+	assert.Equal(t, 0, start)
+	assert.Equal(t, utils.SITEMAP_PAGE_SIZE, end)
+
+	start, end = utils.SitemapPageBounds(2, total)
+	assert.Equal(t, utils.SITEMAP_PAGE_SIZE, start)
+	assert.Equal(t, total, end)
+
+	start, end = utils.SitemapPageBounds(1, 0)
+	assert.Equal(t, 0, start)
+	assert.Equal(t, 0, end)
+}
@@ -0,0 +1,80 @@
+package utils_test
+
+import (
+	"testing"
+
+	"ecommerce-be/product/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderSKUPattern(t *testing.T) {
+	values := utils.SKUPatternValues{
+		Brand:    "Acme Co.",
+		Category: "t-shirts",
+		Name:     "Classic Tee",
+		Sequence: 42,
+	}
+
+	sku, err := utils.RenderSKUPattern("{BRAND:3}-{CAT:2}-{SEQ:5}", values)
+	require.NoError(t, err)
+	assert.Equal(t, "ACM-TS-00042", sku)
+
+	sku, err = utils.RenderSKUPattern("{name}_{seq}", values)
+	require.NoError(t, err)
+	assert.Equal(t, "CLASSIC-TEE_42", sku, "no width keeps words; tokens are case-insensitive")
+
+	values.Brand = ""
+	sku, err = utils.RenderSKUPattern("{BRAND:3}-{CAT:3}-{SEQ:4}", values)
+	require.NoError(t, err)
+	assert.Equal(t, "TSH-0042", sku, "empty token drops its separator")
+}
+
+func TestRenderSKUPattern_Variant(t *testing.T) {
+	values := utils.SKUPatternValues{
+		Base:    "acm tsh 00042",
+		Options: []string{"Navy Blue", "", "XL"},
+	}
+
+	sku, err := utils.RenderSKUPattern("{BASE}-{OPTIONS:3}", values)
+	require.NoError(t, err)
+	assert.Equal(t, "ACM-TSH-00042-NAV-XL", sku)
+
+	values.Options = nil
+	sku, err = utils.RenderSKUPattern("{BASE}-{OPTIONS}", values)
+	require.NoError(t, err)
+	assert.Equal(t, "ACM-TSH-00042", sku)
+}
+
+func TestRenderSKUPattern_RejectsEmptyResult(t *testing.T) {
+	_, err := utils.RenderSKUPattern("{BRAND}", utils.SKUPatternValues{})
+	assert.Error(t, err)
+}
+
+func TestValidateSKUPattern(t *testing.T) {
+	assert.NoError(t, utils.ValidateSKUPattern("{BRAND:3}-{CAT:2}-{SEQ:5}", utils.ProductSKUTokens))
+	assert.NoError(t, utils.ValidateSKUPattern("SHOP-{SEQ:6}", utils.ProductSKUTokens))
+	assert.NoError(t, utils.ValidateSKUPattern("{BASE}-{OPTIONS:2}", utils.VariantSKUTokens))
+
+	invalid := map[string]string{
+		"SHOP-001":        "no placeholder",
+		"{SEQ:5":          "unclosed placeholder",
+		"{}-{SEQ}":        "empty placeholder",
+		"{SEQ:0}":         "width below 1",
+		"{SEQ:21}":        "width above the maximum",
+		"{SEQ:x}":         "non-numeric width",
+		"{COLOR}-{SEQ}":   "unknown token",
+		"{BASE}-{SEQ}":    "variant-only token in a product pattern",
+		"SHOP #{SEQ}":     "literal that cannot appear in an SKU",
+		"{BRAND} {SEQ:3}": "space between placeholders",
+	}
+	for pattern, reason := range invalid {
+		assert.Error(t, utils.ValidateSKUPattern(pattern, utils.ProductSKUTokens), reason)
+	}
+}
+
+func TestSKUPatternUses(t *testing.T) {
+	assert.True(t, utils.SKUPatternUses("{BRAND:3}-{seq:5}", utils.SKU_TOKEN_SEQUENCE))
+	assert.False(t, utils.SKUPatternUses("{BASE}-{OPTIONS}", utils.SKU_TOKEN_SEQUENCE))
+}
@@ -0,0 +1,79 @@
+package utils_test
+
+import (
+	"testing"
+
+	"ecommerce-be/common/db"
+	"ecommerce-be/product/entity"
+	"ecommerce-be/product/model"
+	"ecommerce-be/product/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func searchResult(productID uint) model.SearchResult {
+	result := model.SearchResult{}
+	result.ID = productID
+	return result
+}
+
+func resultIDs(results []model.SearchResult) []uint {
+	ids := make([]uint, len(results))
+	for i, result := range results {
+		ids[i] = result.ID
+	}
+	return ids
+}
+
+func TestNormalizeSponsoredKeywords(t *testing.T) {
+	keywords := utils.NormalizeSponsoredKeywords([]string{" Phone ", "phone", "", "Case"})
+	assert.Equal(t, []string{"phone", "case"}, keywords)
+}
+
+func TestSponsoredPlacementMatches(t *testing.T) {
+	electronics := uint(4)
+	books := uint(5)
+	placement := entity.SponsoredPlacement{
+		Keywords:   db.StringArray{"phone"},
+		CategoryID: &electronics,
+	}
+
+	assert.True(t, utils.SponsoredPlacementMatches(placement, "Cheap PHONE case", nil))
+	assert.True(t, utils.SponsoredPlacementMatches(placement, "laptop", &electronics))
+	assert.False(t, utils.SponsoredPlacementMatches(placement, "laptop", &books))
+	assert.False(t, utils.SponsoredPlacementMatches(placement, "laptop", nil))
+}
+
+func TestSponsoredPageIndex(t *testing.T) {
+	index, onPage := utils.SponsoredPageIndex(1, 1, 10)
+	assert.True(t, onPage)
+	assert.Equal(t, 0, index)
+
+	index, onPage = utils.SponsoredPageIndex(12, 2, 10)
+	assert.True(t, onPage)
+	assert.Equal(t, 1, index)
+
+	_, onPage = utils.SponsoredPageIndex(12, 1, 10)
+	assert.False(t, onPage)
+}
+
+func TestInjectSponsoredResults_InsertsAtPositionsAndDropsDuplicates(t *testing.T) {
+	organic := []model.SearchResult{searchResult(1), searchResult(2), searchResult(3)}
+	sponsored := map[int]model.SearchResult{
+		0: searchResult(9),
+		2: searchResult(3),
+	}
+
+	results := utils.InjectSponsoredResults(organic, sponsored)
+	require.Len(t, results, 4)
+	assert.Equal(t, []uint{9, 1, 3, 2}, resultIDs(results))
+}
+
+func TestInjectSponsoredResults_AppendsBeyondShortPage(t *testing.T) {
+	organic := []model.SearchResult{searchResult(1)}
+	sponsored := map[int]model.SearchResult{5: searchResult(9)}
+
+	results := utils.InjectSponsoredResults(organic, sponsored)
+	assert.Equal(t, []uint{1, 9}, resultIDs(results))
+}
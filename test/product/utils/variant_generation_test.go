@@ -0,0 +1,108 @@
+package utils_test
+
+import (
+	"testing"
+
+	"ecommerce-be/common/db"
+	"ecommerce-be/product/entity"
+	"ecommerce-be/product/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func generationOption(id uint, name string, values map[uint]string) entity.ProductOption {
+	option := entity.ProductOption{Name: name}
+	option.ID = id
+	for valueID := uint(id * 10); valueID < id*10+10; valueID++ {
+		value, ok := values[valueID]
+		if !ok {
+			continue
+		}
+		optionValue := entity.ProductOptionValue{OptionID: id, Value: value}
+		optionValue.ID = valueID
+		option.Values = append(option.Values, optionValue)
+	}
+	return option
+}
+
+func TestExpandOptionCombinations_FullMatrix(t *testing.T) {
+	options := []entity.ProductOption{
+		generationOption(1, "Color", map[uint]string{10: "Red", 11: "Blue"}),
+		generationOption(3, "Size", map[uint]string{30: "S", 31: "M", 32: "L"}),
+	}
+
+	combinations := utils.ExpandOptionCombinations(options, nil, 100)
+	require.Len(t, combinations, 6)
+	assert.Equal(t, utils.OptionSelection{1: 10, 3: 30}, combinations[0])
+	assert.Equal(t, utils.OptionSelection{1: 11, 3: 32}, combinations[5])
+}
+
+func TestExpandOptionCombinations_FollowsRules(t *testing.T) {
+	options := []entity.ProductOption{
+		generationOption(colorOption, "Color", map[uint]string{
+			colorRed: "Red", colorSpecial: "Special Edition",
+		}),
+		generationOption(storageOption, "Storage", map[uint]string{
+			storage512: "512GB", storage1TB: "1TB",
+		}),
+	}
+	rules := []entity.ProductOptionRule{storageNeedsSpecialEdition()}
+
+	combinations := utils.ExpandOptionCombinations(options, rules, 100)
+	assert.ElementsMatch(t, []utils.OptionSelection{
+		{colorOption: colorRed},
+		{colorOption: colorSpecial, storageOption: storage512},
+		{colorOption: colorSpecial, storageOption: storage1TB},
+	}, combinations)
+
+	valueRule := storageNeedsSpecialEdition()
+	valueRule.OptionValueIDs = db.Int64Array{int64(storage1TB)}
+	combinations = utils.ExpandOptionCombinations(
+		options, []entity.ProductOptionRule{valueRule}, 100)
+	assert.ElementsMatch(t, []utils.OptionSelection{
+		{colorOption: colorRed, storageOption: storage512},
+		{colorOption: colorSpecial, storageOption: storage512},
+		{colorOption: colorSpecial, storageOption: storage1TB},
+	}, combinations)
+}
+
+func TestExpandOptionCombinations_StopsPastLimit(t *testing.T) {
+	options := []entity.ProductOption{
+		generationOption(1, "A", map[uint]string{10: "1", 11: "2", 12: "3"}),
+		generationOption(2, "B", map[uint]string{20: "1", 21: "2", 22: "3"}),
+	}
+	assert.Len(t, utils.ExpandOptionCombinations(options, nil, 4), 5)
+}
+
+func TestOptionSelectionKey_IgnoresOrder(t *testing.T) {
+	assert.Equal(t,
+		utils.OptionSelectionKey(utils.OptionSelection{1: 10, 2: 20}),
+		utils.OptionSelectionKey(utils.OptionSelection{2: 20, 1: 10}),
+	)
+	assert.NotEqual(t,
+		utils.OptionSelectionKey(utils.OptionSelection{1: 10}),
+		utils.OptionSelectionKey(utils.OptionSelection{1: 11}),
+	)
+}
+
+func TestRenderVariantSKU(t *testing.T) {
+	values := map[string]string{"color": "Navy Blue", "size": "xl", "storage": ""}
+
+	sku, err := utils.RenderVariantSKU("{baseSku}-{Color}-{size}", "tee 01", values)
+	require.NoError(t, err)
+	assert.Equal(t, "TEE-01-NAVY-BLUE-XL", sku)
+
+	sku, err = utils.RenderVariantSKU("TEE-{color}-{storage}-{size}", "", values)
+	require.NoError(t, err)
+	assert.Equal(t, "TEE-NAVY-BLUE-XL", sku, "empty placeholder leaves no gap")
+
+	_, err = utils.RenderVariantSKU("TEE-{colour}", "", values)
+	assert.Error(t, err, "unknown placeholder")
+
+	_, err = utils.RenderVariantSKU("TEE-{color", "", values)
+	assert.Error(t, err, "unclosed placeholder")
+
+	_, err = utils.RenderVariantSKU("TEE {color}", "", values)
+	assert.Error(t, err, "literal text that is not a valid SKU")
+}
@@ -0,0 +1,58 @@
+package validator_test
+
+import (
+	"testing"
+
+	"ecommerce-be/common/db"
+	commonError "ecommerce-be/common/error"
+	"ecommerce-be/product/entity"
+	"ecommerce-be/product/model"
+	"ecommerce-be/product/utils"
+	"ecommerce-be/product/validator"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func version(v int64) *int64 {
+	return &v
+}
+
+func variantAt(id uint, v int64) entity.ProductVariant {
+	return entity.ProductVariant{BaseEntity: db.BaseEntity{ID: id}, Version: v}
+}
+
+func TestValidateVariantVersion(t *testing.T) {
+	variant := variantAt(3, 4)
+
+	assert.NoError(t, validator.ValidateVariantVersion(&variant, nil))
+	assert.NoError(t, validator.ValidateVariantVersion(&variant, version(4)))
+
+	err := validator.ValidateVariantVersion(&variant, version(3))
+	appErr, ok := commonError.AsAppError(err)
+	require.True(t, ok)
+	assert.Equal(t, utils.VARIANT_VERSION_CONFLICT_CODE, appErr.Code)
+	assert.Equal(t, []db.VersionConflict{{ID: 3, CurrentVersion: 4}}, appErr.Details)
+}
+
+func TestValidateBulkVariantVersions_ReportsEveryStaleVariant(t *testing.T) {
+	existing := []entity.ProductVariant{variantAt(1, 2), variantAt(2, 5), variantAt(3, 1)}
+	updates := map[uint]*model.BulkUpdateVariantItem{
+		1: {ID: 1, ExpectedVersion: version(1)},
+		2: {ID: 2, ExpectedVersion: version(5)},
+		3: {ID: 3},
+	}
+
+	err := validator.ValidateBulkVariantVersions(existing, updates)
+
+	appErr, ok := commonError.AsAppError(err)
+	require.True(t, ok)
+	assert.Equal(t, []db.VersionConflict{{ID: 1, CurrentVersion: 2}}, appErr.Details)
+}
+
+func TestValidateBulkVariantVersions_PassesWithoutExpectations(t *testing.T) {
+	existing := []entity.ProductVariant{variantAt(1, 2)}
+	updates := map[uint]*model.BulkUpdateVariantItem{1: {ID: 1}}
+
+	assert.NoError(t, validator.ValidateBulkVariantVersions(existing, updates))
+}
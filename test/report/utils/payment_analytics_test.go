@@ -0,0 +1,78 @@
+package utils_test
+
+import (
+	"testing"
+
+	"ecommerce-be/report/factory"
+	"ecommerce-be/report/repository"
+	"ecommerce-be/report/util"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPaymentAnalyticsBuilder_BuildStats(t *testing.T) {
+	b := factory.NewPaymentAnalyticsBuilder()
+
+	stats := b.BuildStats(repository.PaymentAuthorizationAggregate{
+		Attempts:                   8,
+		Authorized:                 6,
+		Declined:                   2,
+		CapturedCount:              3,
+		CaptureLatencySecondsTotal: 100,
+	})
+
+	assert.Equal(t, 75.0, stats.SuccessRatePercentage)
+	assert.Equal(t, 25.0, stats.DeclineRatePercentage)
+	assert.Equal(t, 33.33, stats.AvgCaptureLatencySeconds)
+
+	empty := b.BuildStats(repository.PaymentAuthorizationAggregate{})
+	assert.Zero(t, empty.SuccessRatePercentage)
+	assert.Zero(t, empty.AvgCaptureLatencySeconds)
+}
+
+func TestPaymentAnalyticsBuilder_BuildGateways(t *testing.T) {
+	b := factory.NewPaymentAnalyticsBuilder()
+	aggregates := []repository.PaymentAuthorizationAggregate{
+		{GatewayID: 1, GatewayCode: "stripe", PaymentMethodType: "card", CardBrand: "visa",
+			Attempts: 10, Authorized: 9, Declined: 1, CapturedCount: 9,
+			CaptureLatencySecondsTotal: 90},
+		{GatewayID: 1, GatewayCode: "stripe", PaymentMethodType: "card", CardBrand: "amex",
+			Attempts: 10, Authorized: 5, Declined: 5, CapturedCount: 1,
+			CaptureLatencySecondsTotal: 30},
+		{GatewayID: 2, GatewayCode: "razorpay", PaymentMethodType: "upi",
+			Attempts: 4, Authorized: 4},
+	}
+
+	gateways := b.BuildGateways(aggregates)
+
+	require.Len(t, gateways, 2)
+	assert.Equal(t, "stripe", gateways[0].GatewayCode)
+	require.Len(t, gateways[0].CardTypes, 2)
+	assert.Equal(t, "amex", gateways[0].CardTypes[1].CardBrand)
+	assert.Equal(t, 50.0, gateways[0].CardTypes[1].DeclineRatePercentage)
+	assert.Equal(t, int64(20), gateways[0].Attempts)
+	assert.Equal(t, 70.0, gateways[0].SuccessRatePercentage)
+	assert.Equal(t, 12.0, gateways[0].AvgCaptureLatencySeconds)
+	assert.Equal(t, 100.0, gateways[1].SuccessRatePercentage)
+
+	totals := b.BuildTotals(aggregates)
+	assert.Equal(t, int64(24), totals.Attempts)
+	assert.Equal(t, int64(6), totals.Declined)
+	assert.Equal(t, 25.0, totals.DeclineRatePercentage)
+
+	reasons := b.BuildDeclineReasons([]repository.DeclineReasonAggregate{
+		{GatewayID: 1, FailureCode: "insufficient_funds", Count: 3},
+	}, totals.Declined)
+	assert.Equal(t, 50.0, reasons[0].Percentage)
+}
+
+func TestIsDeclineSpike(t *testing.T) {
+	// Above the threshold with enough attempts
+	assert.True(t, util.IsDeclineSpike(20, 7, 30, 20))
+	// Exactly at the threshold is not beyond it
+	assert.False(t, util.IsDeclineSpike(20, 6, 30, 20))
+	// Too few attempts to judge
+	assert.False(t, util.IsDeclineSpike(5, 5, 30, 20))
+	assert.False(t, util.IsDeclineSpike(0, 0, 30, 0))
+}
@@ -0,0 +1,96 @@
+package utils_test
+
+import (
+	"testing"
+
+	"ecommerce-be/report/factory"
+	"ecommerce-be/report/model"
+	"ecommerce-be/report/repository"
+	"ecommerce-be/report/util"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPercentageChange(t *testing.T) {
+	assert.Equal(t, 50.0, util.PercentageChange(200, 300))
+	assert.Equal(t, -25.0, util.PercentageChange(400, 300))
+	assert.Equal(t, 100.0, util.PercentageChange(0, 5), "growth from nothing")
+	assert.Equal(t, 0.0, util.PercentageChange(0, 0))
+}
+
+func TestPlatformAnalyticsBuilder_BuildTotals(t *testing.T) {
+	b := factory.NewPlatformAnalyticsBuilder()
+	current := []repository.PlatformAggregate{
+		{Currency: "USD", GMVCents: 150000, CommissionCents: 15000, OrderCount: 30, ActiveSellers: 6},
+	}
+	previous := []repository.PlatformAggregate{
+		{Currency: "EUR", GMVCents: 5000, CommissionCents: 500, OrderCount: 2, ActiveSellers: 1},
+		{Currency: "USD", GMVCents: 100000, CommissionCents: 12000, OrderCount: 20, ActiveSellers: 4},
+	}
+
+	totals := b.BuildTotals(current, previous, true)
+
+	require.Len(t, totals, 2)
+	usd := totals[0]
+	assert.Equal(t, 10.0, usd.TakeRatePercentage)
+	require.NotNil(t, usd.Previous)
+	assert.Equal(t, 12.0, usd.Previous.TakeRatePercentage)
+	assert.Equal(t, 50.0, usd.Changes.GMVPercentage)
+	assert.Equal(t, 50.0, usd.Changes.ActiveSellersPercentage)
+
+	eur := totals[1]
+	assert.Equal(t, "EUR", eur.Currency, "currency that stopped selling is still reported")
+	assert.Zero(t, eur.GMVCents)
+	assert.Equal(t, -100.0, eur.Changes.GMVPercentage)
+
+	withoutCompare := b.BuildTotals(current, nil, false)
+	require.Len(t, withoutCompare, 1)
+	assert.Nil(t, withoutCompare[0].Previous)
+	assert.Nil(t, withoutCompare[0].Changes)
+}
+
+func TestPlatformAnalyticsBuilder_BuildLeaderboard(t *testing.T) {
+	b := factory.NewPlatformAnalyticsBuilder()
+	aggregates := []repository.SellerRankAggregate{
+		{
+			Rank: 1, SellerID: 42, SellerName: "Acme", Currency: "USD",
+			GMVCents: 60000, CommissionCents: 6000, OrderCount: 12,
+			PlatformGMVCents: 240000, PrevGMVCents: 40000,
+		},
+	}
+
+	rows := b.BuildLeaderboard(aggregates, true)
+	require.Len(t, rows, 1)
+	assert.Equal(t, 25.0, rows[0].GMVSharePercentage)
+	assert.Equal(t, 10.0, rows[0].TakeRatePercentage)
+	require.NotNil(t, rows[0].GMVChangePercentage)
+	assert.Equal(t, 50.0, *rows[0].GMVChangePercentage)
+
+	records := b.BuildLeaderboardCSV(b.BuildLeaderboard(aggregates, false))
+	require.Len(t, records, 2)
+	assert.Equal(t, "rank", records[0][0])
+	assert.Equal(t, []string{
+		"1", "42", "Acme", "USD", "60000", "6000", "10.00", "12", "25.00", "", "",
+	}, records[1])
+}
+
+func TestPlatformAnalyticsBuilder_BuildPeriodCSV(t *testing.T) {
+	b := factory.NewPlatformAnalyticsBuilder()
+
+	records := b.BuildPeriodCSV([]model.PlatformPeriod{
+		{
+			Period:   "2026-10-01 00:00:00",
+			Currency: "USD",
+			PlatformMetrics: model.PlatformMetrics{
+				GMVCents: 1000, CommissionCents: 125, TakeRatePercentage: 12.5,
+				OrderCount: 3, ActiveSellers: 2,
+			},
+		},
+	})
+
+	require.Len(t, records, 2)
+	assert.Equal(t, []string{
+		"2026-10-01 00:00:00", "USD", "1000", "125", "12.50", "3", "2",
+	}, records[1])
+}
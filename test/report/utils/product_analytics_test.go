@@ -0,0 +1,91 @@
+package utils_test
+
+import (
+	"strings"
+	"testing"
+
+	"ecommerce-be/report/factory"
+	"ecommerce-be/report/repository"
+	"ecommerce-be/report/util"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeSearchQuery(t *testing.T) {
+	assert.Equal(t, "red running shoes", util.NormalizeSearchQuery("  Red \t Running  SHOES "))
+	assert.Equal(t, "", util.NormalizeSearchQuery("   "))
+
+	long := util.NormalizeSearchQuery(strings.Repeat("é", util.SEARCH_QUERY_MAX_LENGTH+5))
+	assert.Len(t, []rune(long), util.SEARCH_QUERY_MAX_LENGTH, "cut by characters, not bytes")
+}
+
+func TestValidateAnalyticsEvent(t *testing.T) {
+	productID := uint(7)
+	zero, one := 0, 1
+	tests := []struct {
+		name        string
+		eventType   string
+		productID   *uint
+		query       string
+		resultCount *int
+		position    *int
+		valid       bool
+	}{
+		{name: "view", eventType: util.ANALYTICS_EVENT_VIEW, productID: &productID, valid: true},
+		{name: "view without product", eventType: util.ANALYTICS_EVENT_VIEW},
+		{
+			name: "search with no results", eventType: util.ANALYTICS_EVENT_SEARCH,
+			query: "shoes", resultCount: &zero, valid: true,
+		},
+		{name: "search without result count", eventType: util.ANALYTICS_EVENT_SEARCH, query: "shoes"},
+		{
+			name: "search without query", eventType: util.ANALYTICS_EVENT_SEARCH,
+			resultCount: &zero,
+		},
+		{
+			name: "search click", eventType: util.ANALYTICS_EVENT_SEARCH_CLICK,
+			productID: &productID, query: "shoes", position: &one, valid: true,
+		},
+		{
+			name: "search click at position 0", eventType: util.ANALYTICS_EVENT_SEARCH_CLICK,
+			productID: &productID, query: "shoes", position: &zero,
+		},
+		{name: "unknown type", eventType: "purchase", productID: &productID},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := util.ValidateAnalyticsEvent(
+				tt.eventType, tt.productID, tt.query, tt.resultCount, tt.position,
+			)
+			if tt.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestProductAnalyticsBuilder_BuildStats(t *testing.T) {
+	b := factory.NewProductAnalyticsBuilder()
+
+	engagement := b.BuildEngagementStats(repository.ProductEngagementAggregate{
+		Impressions: 200, Views: 50, AddToCarts: 5,
+	})
+	assert.Equal(t, 25.0, engagement.ViewRatePercentage)
+	assert.Equal(t, 10.0, engagement.AddToCartRatePercentage)
+
+	search := b.BuildSearchStats(repository.SearchQueryAggregate{
+		Searches: 3, ZeroResultSearches: 1, ResultCountSum: 20,
+		Clicks: 2, ClickPositionSum: 5,
+	})
+	assert.Equal(t, 33.33, search.ZeroResultRatePercentage)
+	assert.Equal(t, 6.67, search.AvgResultCount)
+	assert.Equal(t, 66.67, search.ClickThroughRatePercentage)
+	assert.Equal(t, 2.5, search.AvgClickPosition)
+
+	empty := b.BuildSearchStats(repository.SearchQueryAggregate{})
+	assert.Zero(t, empty.AvgResultCount)
+	assert.Zero(t, empty.AvgClickPosition)
+}
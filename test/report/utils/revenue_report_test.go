@@ -0,0 +1,86 @@
+package utils_test
+
+import (
+	"testing"
+	"time"
+
+	"ecommerce-be/report/factory"
+	"ecommerce-be/report/model"
+	"ecommerce-be/report/repository"
+	"ecommerce-be/report/util"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRevenueReportBuilder_BuildTotals(t *testing.T) {
+	b := factory.NewRevenueReportBuilder()
+
+	totals := b.BuildTotals(repository.RevenueAggregate{
+		Currency:        "USD",
+		GMVCents:        100000,
+		CommissionCents: 12500,
+		GatewayFeeCents: 1500,
+		RefundCents:     5000,
+		ChargebackCents: 2000,
+		PayoutCents:     40000,
+		OrderCount:      7,
+	})
+
+	assert.Equal(t, "USD", totals.Currency)
+	assert.Equal(t, int64(79000), totals.NetSellerEarningsCents)
+	assert.Equal(t, int64(40000), totals.PayoutCents)
+	assert.Equal(t, 7, totals.OrderCount)
+	assert.Equal(t, 12.5, totals.TakeRatePercentage)
+}
+
+func TestRevenueReportBuilder_TakeRateWithoutGMV(t *testing.T) {
+	b := factory.NewRevenueReportBuilder()
+
+	totals := b.BuildTotals(repository.RevenueAggregate{CommissionCents: 100})
+
+	assert.Equal(t, 0.0, totals.TakeRatePercentage)
+}
+
+func TestRevenueReportBuilder_BuildSellerCSV(t *testing.T) {
+	b := factory.NewRevenueReportBuilder()
+
+	records := b.BuildSellerCSV([]model.SellerRevenueRow{
+		{
+			SellerID:   42,
+			SellerName: "Acme, Inc.",
+			RevenueTotals: model.RevenueTotals{
+				Currency:           "EUR",
+				GMVCents:           2000,
+				CommissionCents:    200,
+				TakeRatePercentage: 10,
+			},
+		},
+	})
+
+	require.Len(t, records, 2)
+	assert.Equal(t, []string{"seller_id", "seller_name", "currency"}, records[0][:3])
+	assert.Equal(t, len(records[0]), len(records[1]))
+	assert.Equal(t, "42", records[1][0])
+	assert.Equal(t, "Acme, Inc.", records[1][1])
+	assert.Equal(t, "10.00", records[1][len(records[1])-1])
+}
+
+func TestResolveInterval(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	interval, err := util.ResolveInterval("", start, start.Add(12*time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, "hour", interval)
+
+	interval, err = util.ResolveInterval("", start, start.AddDate(0, 6, 0))
+	require.NoError(t, err)
+	assert.Equal(t, "month", interval)
+
+	interval, err = util.ResolveInterval("week", start, start.AddDate(1, 0, 0))
+	require.NoError(t, err)
+	assert.Equal(t, "week", interval)
+
+	_, err = util.ResolveInterval("decade", start, start.AddDate(1, 0, 0))
+	assert.Error(t, err)
+}
@@ -0,0 +1,97 @@
+package utils_test
+
+import (
+	"testing"
+	"time"
+
+	"ecommerce-be/report/factory"
+	"ecommerce-be/report/repository"
+	"ecommerce-be/report/util"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func salesDay(date string) time.Time {
+	day, _ := time.Parse(time.DateOnly, date)
+	return day
+}
+
+func TestSalesBucketStart(t *testing.T) {
+	// 2026-10-15 is a Thursday
+	day := salesDay("2026-10-15")
+	assert.Equal(t, salesDay("2026-10-15"), util.SalesBucketStart(day, "day"))
+	assert.Equal(t, salesDay("2026-10-12"), util.SalesBucketStart(day, "week"))
+	assert.Equal(t, salesDay("2026-10-01"), util.SalesBucketStart(day, "month"))
+	assert.Equal(t, salesDay("2026-10-01"), util.SalesBucketStart(day, "quarter"))
+	assert.Equal(t, salesDay("2026-01-01"), util.SalesBucketStart(day, "year"))
+	assert.Equal(t, salesDay("2026-10-12"),
+		util.SalesBucketStart(salesDay("2026-10-18"), "week"), "Sunday closes the week")
+}
+
+func TestResolveSalesGranularity(t *testing.T) {
+	start := salesDay("2026-10-15")
+
+	granularity, err := util.ResolveSalesGranularity("", start, start.Add(12*time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, "day", granularity, "a single day defaults to a daily bucket")
+
+	granularity, err = util.ResolveSalesGranularity("month", start, start.AddDate(0, 0, 7))
+	require.NoError(t, err)
+	assert.Equal(t, "month", granularity)
+
+	_, err = util.ResolveSalesGranularity("hour", start, start.Add(12*time.Hour))
+	assert.Error(t, err, "rollups are daily")
+}
+
+func TestBuildSalesSeries_FillsEmptyBuckets(t *testing.T) {
+	days := []repository.SellerSalesDay{
+		{Day: salesDay("2026-10-12"), OrderCount: 2, RevenueCents: 3000, UnitsSold: 3},
+		{Day: salesDay("2026-10-14"), OrderCount: 1, RevenueCents: 1001, UnitsSold: 1,
+			RefundCount: 1, RefundedCents: 500},
+	}
+
+	totals, series := factory.NewSalesDashboardBuilder().BuildSeries(
+		days, salesDay("2026-10-12"), salesDay("2026-10-14"), "day")
+
+	require.Len(t, series, 3)
+	assert.Equal(t, "2026-10-13", series[1].PeriodStart)
+	assert.Zero(t, series[1].Orders)
+	assert.Equal(t, 15.0, series[0].AverageOrderValue)
+
+	assert.Equal(t, int64(3), totals.Orders)
+	assert.Equal(t, 40.01, totals.Revenue)
+	assert.Equal(t, 13.34, totals.AverageOrderValue)
+	assert.Equal(t, 12.5, totals.RefundRatePercentage)
+}
+
+func TestBuildSalesSeries_GroupsWeeks(t *testing.T) {
+	days := []repository.SellerSalesDay{
+		{Day: salesDay("2026-10-05"), OrderCount: 1, RevenueCents: 100},
+		{Day: salesDay("2026-10-11"), OrderCount: 1, RevenueCents: 100},
+		{Day: salesDay("2026-10-12"), OrderCount: 1, RevenueCents: 100},
+	}
+
+	_, series := factory.NewSalesDashboardBuilder().BuildSeries(
+		days, salesDay("2026-10-07"), salesDay("2026-10-14"), "week")
+
+	require.Len(t, series, 2)
+	assert.Equal(t, "2026-10-05", series[0].PeriodStart)
+	assert.Equal(t, int64(2), series[0].Orders)
+	assert.Equal(t, int64(1), series[1].Orders)
+}
+
+func TestBuildFunnel(t *testing.T) {
+	steps := factory.NewSalesDashboardBuilder().BuildFunnel(
+		repository.ProductEngagementAggregate{Impressions: 1000, Views: 200, AddToCarts: 50},
+		10,
+	)
+
+	require.Len(t, steps, 4)
+	assert.Equal(t, util.FUNNEL_STAGE_IMPRESSIONS, steps[0].Stage)
+	assert.Equal(t, 100.0, steps[0].StepRatePercentage)
+	assert.Equal(t, 20.0, steps[1].StepRatePercentage)
+	assert.Equal(t, util.FUNNEL_STAGE_ORDERS, steps[3].Stage)
+	assert.Equal(t, 20.0, steps[3].StepRatePercentage)
+	assert.Equal(t, 1.0, steps[3].OverallRatePercentage)
+}
@@ -0,0 +1,118 @@
+package utils_test
+
+import (
+	"testing"
+
+	"ecommerce-be/report/util"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var healthPolicy = util.SellerHealthPolicy{WarningScore: 70, DemotionScore: 50, MinOrders: 10}
+
+func TestScoreSellerHealth(t *testing.T) {
+	reviewScore := 3.0
+	tests := []struct {
+		name    string
+		metrics util.SellerHealthMetrics
+		score   int
+		status  string
+		flags   []string
+	}{
+		{
+			name: "clean seller",
+			metrics: util.SellerHealthMetrics{
+				OrderCount: 20, SLATrackedCount: 10, TransactionCount: 20,
+			},
+			score:  100,
+			status: util.SELLER_HEALTH_STATUS_HEALTHY,
+			flags:  []string{},
+		},
+		{
+			name: "metrics without data are reweighted away",
+			metrics: util.SellerHealthMetrics{
+				OrderCount: 20, CancelledCount: 2, SLATrackedCount: 18,
+			},
+			score:  75,
+			status: util.SELLER_HEALTH_STATUS_HEALTHY,
+			flags:  []string{util.SELLER_RISK_FLAG_HIGH_CANCELLATION_RATE},
+		},
+		{
+			name: "at risk",
+			metrics: util.SellerHealthMetrics{
+				OrderCount: 20, CancelledCount: 2, SLATrackedCount: 20, SLABreachCount: 2,
+			},
+			score:  55,
+			status: util.SELLER_HEALTH_STATUS_AT_RISK,
+			flags: []string{
+				util.SELLER_RISK_FLAG_HIGH_CANCELLATION_RATE,
+				util.SELLER_RISK_FLAG_SLA_BREACHES,
+			},
+		},
+		{
+			name: "demoted",
+			metrics: util.SellerHealthMetrics{
+				OrderCount: 20, CancelledCount: 4, SLATrackedCount: 16, SLABreachCount: 4,
+				TransactionCount: 20,
+			},
+			score:  29,
+			status: util.SELLER_HEALTH_STATUS_DEMOTED,
+			flags: []string{
+				util.SELLER_RISK_FLAG_HIGH_CANCELLATION_RATE,
+				util.SELLER_RISK_FLAG_SLA_BREACHES,
+			},
+		},
+		{
+			name: "low review score",
+			metrics: util.SellerHealthMetrics{
+				OrderCount: 20, ReviewCount: 5, ReviewScore: &reviewScore,
+			},
+			score:  83,
+			status: util.SELLER_HEALTH_STATUS_HEALTHY,
+			flags:  []string{util.SELLER_RISK_FLAG_LOW_REVIEW_SCORE},
+		},
+		{
+			name:    "too few orders to judge",
+			metrics: util.SellerHealthMetrics{OrderCount: 5, CancelledCount: 5},
+			score:   0,
+			status:  util.SELLER_HEALTH_STATUS_INSUFFICIENT_DATA,
+			flags:   []string{util.SELLER_RISK_FLAG_HIGH_CANCELLATION_RATE},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := util.ScoreSellerHealth(tt.metrics, healthPolicy)
+			assert.Equal(t, tt.score, result.Score)
+			assert.Equal(t, tt.status, result.Status)
+			assert.Equal(t, tt.flags, result.Flags)
+		})
+	}
+}
+
+func TestResolveListingDemotion(t *testing.T) {
+	result := func(score int, status string) util.SellerHealthResult {
+		return util.SellerHealthResult{Score: score, Status: status}
+	}
+
+	assert.True(t, util.ResolveListingDemotion(false,
+		result(40, util.SELLER_HEALTH_STATUS_DEMOTED), healthPolicy))
+	assert.False(t, util.ResolveListingDemotion(false,
+		result(60, util.SELLER_HEALTH_STATUS_AT_RISK), healthPolicy))
+
+	// A demoted seller stays demoted until the score reaches the warning threshold
+	assert.True(t, util.ResolveListingDemotion(true,
+		result(60, util.SELLER_HEALTH_STATUS_AT_RISK), healthPolicy))
+	assert.False(t, util.ResolveListingDemotion(true,
+		result(70, util.SELLER_HEALTH_STATUS_HEALTHY), healthPolicy))
+	assert.False(t, util.ResolveListingDemotion(true,
+		result(0, util.SELLER_HEALTH_STATUS_INSUFFICIENT_DATA), healthPolicy))
+}
+
+func TestJoinSellerRiskFlags(t *testing.T) {
+	assert.Equal(t, "none", util.JoinSellerRiskFlags(nil))
+	assert.Equal(t, "sla breaches, high dispute rate", util.JoinSellerRiskFlags([]string{
+		util.SELLER_RISK_FLAG_SLA_BREACHES,
+		util.SELLER_RISK_FLAG_HIGH_DISPUTE_RATE,
+	}))
+}
@@ -0,0 +1,64 @@
+package reqctx_test
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	"ecommerce-be/common/reqctx"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+type txKey struct{}
+
+func TestKey_GinRequestValues(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+
+	_, exists := reqctx.UserID.Get(c)
+	assert.False(t, exists)
+
+	sellerID := uint(7)
+	reqctx.SetIdentity(c, reqctx.Identity{
+		UserID: 3, Email: "a@b.c", RoleLevel: 2, RoleName: "SELLER", SellerID: &sellerID,
+	})
+	userID, exists := reqctx.UserID.Get(c)
+	assert.True(t, exists)
+	assert.Equal(t, uint(3), userID)
+
+	// Values set on the request stay visible through contexts derived from it
+	derived := context.WithValue(c, txKey{}, "tx")
+	gotSeller, exists := reqctx.SellerID.Get(derived)
+	assert.True(t, exists)
+	assert.Equal(t, uint(7), gotSeller)
+}
+
+func TestKey_RejectsMistypedValue(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+	c.Set(reqctx.UserID.Name(), "3")
+
+	userID, exists := reqctx.UserID.Get(c)
+	assert.False(t, exists)
+	assert.Zero(t, userID)
+}
+
+func TestKey_PlainContextValues(t *testing.T) {
+	ctx := reqctx.CorrelationID.With(context.Background(), "req-1")
+	correlationID, exists := reqctx.CorrelationID.Get(ctx)
+	assert.True(t, exists)
+	assert.Equal(t, "req-1", correlationID)
+
+	// A plain string key of the same name is not a request metadata value
+	ctx = context.WithValue(context.Background(), "user_id", uint(3))
+	_, exists = reqctx.UserID.Get(ctx)
+	assert.False(t, exists)
+
+	ctx = reqctx.WithIdentity(context.Background(), reqctx.Identity{UserID: 5, RoleLevel: 3})
+	_, exists = reqctx.SellerID.Get(ctx)
+	assert.False(t, exists)
+	roleLevel, _ := reqctx.RoleLevel.Get(ctx)
+	assert.Equal(t, uint(3), roleLevel)
+}
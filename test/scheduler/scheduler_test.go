@@ -0,0 +1,117 @@
+package scheduler_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"testing"
+	"time"
+
+	"ecommerce-be/common/log"
+	"ecommerce-be/common/scheduler"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestMain installs a silent logger; the default one needs loaded configuration
+func TestMain(m *testing.M) {
+	log.Log = logrus.New()
+	log.Log.SetOutput(io.Discard)
+	os.Exit(m.Run())
+}
+
+type reservationPayload struct {
+	ReservationID uint `json:"reservationId"`
+}
+
+func TestRetryDelay_DoublesPerRetryUpToCap(t *testing.T) {
+	base, maxDelay := 30*time.Second, 5*time.Minute
+
+	assert.Equal(t, 30*time.Second, scheduler.RetryDelay(1, base, maxDelay))
+	assert.Equal(t, time.Minute, scheduler.RetryDelay(2, base, maxDelay))
+	assert.Equal(t, 2*time.Minute, scheduler.RetryDelay(3, base, maxDelay))
+	assert.Equal(t, 4*time.Minute, scheduler.RetryDelay(4, base, maxDelay))
+	assert.Equal(t, maxDelay, scheduler.RetryDelay(5, base, maxDelay))
+	assert.Equal(t, maxDelay, scheduler.RetryDelay(60, base, maxDelay))
+}
+
+func TestShouldRetry_StopsAtMaxAttempts(t *testing.T) {
+	job := scheduler.NewJob("test_command", json.RawMessage(`{}`)).WithMaxAttempts(2)
+	failure := errors.New("downstream unavailable")
+
+	first := scheduler.ScheduledJob{Job: &job, Attempt: 1}
+	assert.True(t, scheduler.ShouldRetry(first, failure))
+
+	second := scheduler.ScheduledJob{Job: &job, Attempt: 2}
+	assert.False(t, scheduler.ShouldRetry(second, failure))
+}
+
+func TestShouldRetry_DefaultMaxAttempts(t *testing.T) {
+	job := scheduler.NewJob("test_command", json.RawMessage(`{}`))
+	failure := errors.New("downstream unavailable")
+
+	assert.True(t, scheduler.ShouldRetry(scheduler.ScheduledJob{Job: &job, Attempt: 2}, failure))
+	assert.False(t, scheduler.ShouldRetry(scheduler.ScheduledJob{Job: &job, Attempt: 3}, failure))
+}
+
+func TestShouldRetry_PermanentErrorIsNotRetried(t *testing.T) {
+	job := scheduler.NewJob("test_command", json.RawMessage(`{}`)).WithMaxAttempts(5)
+	err := scheduler.Permanent(errors.New("order no longer exists"))
+
+	assert.False(t, scheduler.ShouldRetry(scheduler.ScheduledJob{Job: &job, Attempt: 1}, err))
+	assert.True(t, scheduler.IsPermanent(err))
+	assert.EqualError(t, err, "order no longer exists")
+}
+
+func TestDispatch_UnknownCommandFailsPermanently(t *testing.T) {
+	job := scheduler.NewJob("test_unregistered_command", json.RawMessage(`{}`))
+
+	err := scheduler.Dispatch(scheduler.ScheduledJob{Job: &job}, context.Background())
+
+	require.Error(t, err)
+	assert.True(t, scheduler.IsPermanent(err))
+}
+
+func TestNewTypedJob_EncodesPayloadAndPriority(t *testing.T) {
+	job, err := scheduler.NewTypedJob("test_typed_job", reservationPayload{ReservationID: 42})
+	require.NoError(t, err)
+	job = job.WithPriority(scheduler.PriorityHigh)
+
+	assert.NotEmpty(t, job.JobID)
+	assert.JSONEq(t, `{"reservationId":42}`, string(job.Payload))
+	assert.Equal(t, scheduler.PriorityHigh, job.Priority)
+}
+
+func TestRegisterTyped_DecodesPayload(t *testing.T) {
+	var got reservationPayload
+	scheduler.RegisterTyped("test_typed_decode",
+		func(_ context.Context, payload reservationPayload) error {
+			got = payload
+			return nil
+		})
+
+	job, err := scheduler.NewTypedJob("test_typed_decode", reservationPayload{ReservationID: 7})
+	require.NoError(t, err)
+	require.NoError(t, scheduler.Dispatch(scheduler.ScheduledJob{Job: &job}, context.Background()))
+	assert.Equal(t, uint(7), got.ReservationID)
+}
+
+func TestRegisterTyped_MalformedPayloadFailsPermanently(t *testing.T) {
+	called := false
+	scheduler.RegisterTyped("test_typed_malformed",
+		func(_ context.Context, _ reservationPayload) error {
+			called = true
+			return nil
+		})
+
+	job := scheduler.NewJob("test_typed_malformed", json.RawMessage(`{"reservationId":"x"}`))
+	err := scheduler.Dispatch(scheduler.ScheduledJob{Job: &job}, context.Background())
+
+	require.Error(t, err)
+	assert.True(t, scheduler.IsPermanent(err))
+	assert.False(t, called)
+}
@@ -0,0 +1,61 @@
+package schemamigration_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"ecommerce-be/common/schemamigration"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+}
+
+func TestLoad_OrdersByVersionAndPicksUpRollbacks(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "010_add_index.sql"), "CREATE INDEX i ON t(id);")
+	writeFile(t, filepath.Join(dir, "002_create_table.sql"), "CREATE TABLE t (id INT);")
+	writeFile(t, filepath.Join(dir, "down", "002_create_table.sql"), "DROP TABLE t;")
+	writeFile(t, filepath.Join(dir, "README.md"), "not a migration")
+	writeFile(t, filepath.Join(dir, "run_migrations.sh"), "#!/bin/bash")
+
+	migrations, err := schemamigration.Load(dir)
+	require.NoError(t, err)
+	require.Len(t, migrations, 2)
+
+	assert.Equal(t, int64(2), migrations[0].Version)
+	assert.Equal(t, "create_table", migrations[0].Name)
+	assert.True(t, migrations[0].HasDown())
+	assert.Equal(t, "DROP TABLE t;", migrations[0].DownSQL)
+
+	assert.Equal(t, int64(10), migrations[1].Version)
+	assert.False(t, migrations[1].HasDown())
+	assert.Equal(t, schemamigration.Checksum("CREATE INDEX i ON t(id);"), migrations[1].Checksum)
+}
+
+func TestLoad_RejectsDuplicateVersions(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "003_first.sql"), "SELECT 1;")
+	writeFile(t, filepath.Join(dir, "3_second.sql"), "SELECT 2;")
+
+	_, err := schemamigration.Load(dir)
+	assert.Error(t, err)
+}
+
+func TestChecksum_ChangesWithContent(t *testing.T) {
+	assert.Equal(t, schemamigration.Checksum("SELECT 1;"), schemamigration.Checksum("SELECT 1;"))
+	assert.NotEqual(t, schemamigration.Checksum("SELECT 1;"), schemamigration.Checksum("SELECT 2;"))
+}
+
+func TestLoad_RepositoryMigrations(t *testing.T) {
+	migrations, err := schemamigration.Load(filepath.Join("..", "..", "migrations"))
+	require.NoError(t, err)
+	require.NotEmpty(t, migrations)
+	assert.Equal(t, int64(1), migrations[0].Version)
+}
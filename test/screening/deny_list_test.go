@@ -0,0 +1,83 @@
+package screening_test
+
+import (
+	"testing"
+
+	"ecommerce-be/common/screening"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalize_StripsAccentsCaseAndPunctuation(t *testing.T) {
+	assert.Equal(t, "jose muller", screening.Normalize("  José  MÜLLER "))
+	assert.Equal(t, "acme trading co ltd", screening.Normalize("ACME-Trading Co., Ltd."))
+	assert.Equal(t, "", screening.Normalize(" .,- "))
+}
+
+func TestMatchDenyList_NameMatchesWordsInAnyOrder(t *testing.T) {
+	entries := []screening.DenyListEntry{
+		denyListEntry(screening.ENTRY_TYPE_NAME, "Petrov, Ivan"),
+	}
+	subject := screening.Subject{Names: []string{"Acme Store", "IVAN PETROV Trading LLC"}}
+
+	matches := screening.MatchDenyList(subject, entries)
+
+	require.Len(t, matches, 1)
+	assert.Equal(t, "IVAN PETROV Trading LLC", matches[0].Matched)
+	assert.Equal(t, "Petrov, Ivan", matches[0].Listed)
+	assert.Equal(t, "internal", matches[0].ListName)
+}
+
+func TestMatchDenyList_NameRequiresEveryWord(t *testing.T) {
+	entries := []screening.DenyListEntry{
+		denyListEntry(screening.ENTRY_TYPE_NAME, "Ivan Petrov"),
+	}
+	subject := screening.Subject{Names: []string{"Ivan Petrovich"}}
+
+	assert.Empty(t, screening.MatchDenyList(subject, entries))
+}
+
+func TestMatchDenyList_NameIgnoresAccents(t *testing.T) {
+	entries := []screening.DenyListEntry{
+		denyListEntry(screening.ENTRY_TYPE_NAME, "Jose Muller"),
+	}
+	subject := screening.Subject{Names: []string{"José Müller"}}
+
+	assert.Len(t, screening.MatchDenyList(subject, entries), 1)
+}
+
+func TestMatchDenyList_AddressMatchesWholeWordPhrase(t *testing.T) {
+	entries := []screening.DenyListEntry{
+		denyListEntry(screening.ENTRY_TYPE_ADDRESS, "12 Harbour Road"),
+	}
+
+	hit := screening.Subject{Address: "Unit 4, 12 Harbour Road, Port Town"}
+	miss := screening.Subject{Address: "112 Harbour Roadway"}
+
+	assert.Len(t, screening.MatchDenyList(hit, entries), 1)
+	assert.Empty(t, screening.MatchDenyList(miss, entries))
+}
+
+func TestMatchDenyList_CountryMatchesCode(t *testing.T) {
+	entries := []screening.DenyListEntry{
+		denyListEntry(screening.ENTRY_TYPE_COUNTRY, "KP"),
+	}
+
+	matches := screening.MatchDenyList(screening.Subject{CountryCode: "kp"}, entries)
+	require.Len(t, matches, 1)
+	assert.Equal(t, "KP", matches[0].Matched)
+
+	assert.Empty(t, screening.MatchDenyList(screening.Subject{CountryCode: "US"}, entries))
+	assert.Empty(t, screening.MatchDenyList(screening.Subject{}, entries))
+}
+
+func denyListEntry(entryType screening.EntryType, value string) screening.DenyListEntry {
+	return screening.DenyListEntry{
+		ListName:        "internal",
+		EntryType:       entryType,
+		Value:           value,
+		NormalizedValue: screening.Normalize(value),
+		IsActive:        true,
+	}
+}
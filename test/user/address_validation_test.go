@@ -0,0 +1,70 @@
+package user_test
+
+import (
+	"testing"
+
+	commonError "ecommerce-be/common/error"
+	"ecommerce-be/user/entity"
+	"ecommerce-be/user/utils"
+	"ecommerce-be/user/utils/constant"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizePostalCode(t *testing.T) {
+	assert.Equal(t, "SW1A 1AA", utils.NormalizePostalCode("GB", " sw1a1aa "))
+	assert.Equal(t, "K1A 0B1", utils.NormalizePostalCode("ca", "k1a  0b1"))
+	assert.Equal(t, "1011 AB", utils.NormalizePostalCode("NL", "1011ab"))
+	assert.Equal(t, "94103-1234", utils.NormalizePostalCode("US", " 94103-1234"))
+}
+
+func TestValidateAddressFields(t *testing.T) {
+	us := &entity.Country{Code: "US", Name: "United States", IsActive: true}
+
+	zip, err := utils.ValidateAddressFields(us, "CA", "94103")
+	require.NoError(t, err)
+	assert.Equal(t, "94103", zip)
+
+	_, err = utils.ValidateAddressFields(us, "CA", "9410")
+	assertAddressErrorCode(t, err, constant.INVALID_ADDRESS_FIELD_CODE)
+
+	_, err = utils.ValidateAddressFields(us, "", "94103")
+	assertAddressErrorCode(t, err, constant.INVALID_ADDRESS_FIELD_CODE)
+
+	_, err = utils.ValidateAddressFields(us, "CA", "")
+	assertAddressErrorCode(t, err, constant.INVALID_ADDRESS_FIELD_CODE)
+}
+
+func TestValidateAddressFields_CountryConventions(t *testing.T) {
+	// No postal codes in the UAE
+	ae := &entity.Country{Code: "AE", Name: "United Arab Emirates", IsActive: true}
+	zip, err := utils.ValidateAddressFields(ae, "Dubai", "")
+	require.NoError(t, err)
+	assert.Empty(t, zip)
+
+	// No states in Singapore
+	sg := &entity.Country{Code: "SG", Name: "Singapore", IsActive: true}
+	zip, err = utils.ValidateAddressFields(sg, "", "018956")
+	require.NoError(t, err)
+	assert.Equal(t, "018956", zip)
+
+	// Countries without a known format accept any code
+	ke := &entity.Country{Code: "KE", Name: "Kenya", IsActive: true}
+	zip, err = utils.ValidateAddressFields(ke, "Nairobi", "00100")
+	require.NoError(t, err)
+	assert.Equal(t, "00100", zip)
+}
+
+func TestValidateAddressFields_InactiveCountry(t *testing.T) {
+	country := &entity.Country{Code: "US", Name: "United States"}
+	_, err := utils.ValidateAddressFields(country, "CA", "94103")
+	assertAddressErrorCode(t, err, constant.COUNTRY_NOT_SUPPORTED_CODE)
+}
+
+func assertAddressErrorCode(t *testing.T, err error, code string) {
+	t.Helper()
+	appErr, ok := commonError.AsAppError(err)
+	require.True(t, ok, "expected an AppError, got %v", err)
+	assert.Equal(t, code, appErr.Code)
+}
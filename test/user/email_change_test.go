@@ -0,0 +1,65 @@
+package user_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"ecommerce-be/common/notifier"
+	"ecommerce-be/user/entity"
+	"ecommerce-be/user/factory"
+	"ecommerce-be/user/utils/constant"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEmailChangeStatus_Lifecycle(t *testing.T) {
+	now := time.Now().UTC()
+	request := &entity.EmailChangeRequest{ExpiresAt: now.Add(time.Hour)}
+
+	assert.Equal(t, constant.EMAIL_CHANGE_STATUS_PENDING, factory.EmailChangeStatus(request, now))
+	assert.True(t, request.IsOpen(now))
+	assert.Equal(
+		t,
+		constant.EMAIL_CHANGE_STATUS_EXPIRED,
+		factory.EmailChangeStatus(request, now.Add(2*time.Hour)),
+	)
+
+	request.CancelledAt = &now
+	assert.Equal(t, constant.EMAIL_CHANGE_STATUS_CANCELLED, factory.EmailChangeStatus(request, now))
+	assert.False(t, request.IsOpen(now))
+}
+
+func TestEmailChangeRequest_NeedsBothConfirmations(t *testing.T) {
+	now := time.Now().UTC()
+	request := &entity.EmailChangeRequest{ExpiresAt: now.Add(time.Hour)}
+
+	request.NewConfirmedAt = &now
+	assert.False(t, request.IsFullyConfirmed())
+
+	request.OldConfirmedAt = &now
+	assert.True(t, request.IsFullyConfirmed())
+
+	request.CompletedAt = &now
+	assert.Equal(t, constant.EMAIL_CHANGE_STATUS_COMPLETED, factory.EmailChangeStatus(request, now))
+	assert.False(t, request.IsOpen(now))
+}
+
+type recordingNotifier struct {
+	events []string
+}
+
+func (n *recordingNotifier) Notify(_ context.Context, event string, _ uint, _ map[string]any) error {
+	n.events = append(n.events, event)
+	return nil
+}
+
+func TestDefaultNotifier_ForwardsOnceInstalled(t *testing.T) {
+	deferred := notifier.Default()
+	recorder := &recordingNotifier{}
+	notifier.SetDefault(recorder)
+
+	require.NoError(t, deferred.Notify(context.Background(), "account.email_changed", 1, nil))
+	assert.Equal(t, []string{"account.email_changed"}, recorder.events)
+}
@@ -0,0 +1,100 @@
+package user_test
+
+import (
+	"testing"
+
+	"ecommerce-be/common/validator"
+	"ecommerce-be/user/entity"
+	"ecommerce-be/user/factory"
+	"ecommerce-be/user/model"
+
+	"github.com/gin-gonic/gin/binding"
+	playground "github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func failedStorefrontFields(t *testing.T, req any) map[string]string {
+	t.Helper()
+	validator.RegisterBindingValidators()
+
+	var errs playground.ValidationErrors
+	require.ErrorAs(t, binding.Validator.ValidateStruct(req), &errs)
+	failed := map[string]string{}
+	for _, fieldErr := range errs {
+		failed[fieldErr.Field()] = fieldErr.Tag()
+	}
+	return failed
+}
+
+func TestStorefrontCreateRequest_AcceptsCompleteStorefront(t *testing.T) {
+	validator.RegisterBindingValidators()
+	color := "#1A2B3C"
+	email := "hello@acme.example"
+	phone := "+14155550100"
+	currency := "usd"
+	timezone := "UTC"
+
+	err := binding.Validator.ValidateStruct(&model.StorefrontCreateRequest{
+		StoreName:    "Acme",
+		PrimaryColor: &color,
+		ContactEmail: &email,
+		ContactPhone: &phone,
+		SocialLinks:  map[string]string{"instagram": "https://instagram.com/acme"},
+		CurrencyCode: &currency,
+		Timezone:     &timezone,
+	})
+	assert.NoError(t, err)
+}
+
+func TestStorefrontCreateRequest_RejectsInvalidFields(t *testing.T) {
+	color := "blue"
+	timezone := "Mars/Olympus_Mons"
+
+	failed := failedStorefrontFields(t, &model.StorefrontCreateRequest{
+		StoreName:    "Acme",
+		PrimaryColor: &color,
+		Timezone:     &timezone,
+	})
+	assert.Equal(t, "hexcolor7", failed["primaryColor"])
+	assert.Equal(t, "timezone", failed["timezone"])
+}
+
+func TestStorefrontCreateRequest_RejectsUnknownSocialNetworksAndBadLinks(t *testing.T) {
+	failed := failedStorefrontFields(t, &model.StorefrontCreateRequest{
+		StoreName:   "Acme",
+		SocialLinks: map[string]string{"myspace": "https://myspace.com/acme"},
+	})
+	assert.Contains(t, failed, "socialLinks[myspace]")
+
+	failed = failedStorefrontFields(t, &model.StorefrontCreateRequest{
+		StoreName:   "Acme",
+		SocialLinks: map[string]string{"facebook": "not a url"},
+	})
+	assert.Equal(t, "url", failed["socialLinks[facebook]"])
+}
+
+func TestBuildPublicStorefrontResponse_GroupsThemeAndContact(t *testing.T) {
+	color := "#000000"
+	email := "hello@acme.example"
+	settings := &entity.StorefrontSettings{
+		SellerID:     7,
+		StoreName:    "Acme",
+		PrimaryColor: &color,
+		ContactEmail: &email,
+		SocialLinks:  entity.SocialLinks{"x": "https://x.com/acme"},
+		Timezone:     "UTC",
+	}
+
+	response := factory.BuildPublicStorefrontResponse(settings)
+
+	assert.Equal(t, uint(7), response.SellerID)
+	assert.Equal(t, &color, response.Theme.PrimaryColor)
+	assert.Nil(t, response.Theme.AccentColor)
+	assert.Equal(t, &email, response.Contact.Email)
+	assert.Equal(t, map[string]string{"x": "https://x.com/acme"}, response.Contact.SocialLinks)
+
+	// The response owns its links; later changes to the entity do not leak into it
+	settings.SocialLinks["x"] = "https://x.com/other"
+	assert.Equal(t, "https://x.com/acme", response.Contact.SocialLinks["x"])
+}
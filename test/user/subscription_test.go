@@ -0,0 +1,83 @@
+package user_test
+
+import (
+	"testing"
+	"time"
+
+	"ecommerce-be/user/entity"
+	"ecommerce-be/user/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAssignSubscriptionPlan_TrialThenPlanChange(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	starter := &entity.Plan{TrialDays: 14, BillingCycle: "monthly"}
+	starter.ID = 2
+
+	subscription := &entity.Subscription{SellerID: 7}
+	utils.AssignSubscriptionPlan(subscription, starter, now)
+	assert.Equal(t, entity.SUBSCRIPTION_STATUS_TRIALING, subscription.Status)
+	assert.Equal(t, now.AddDate(0, 0, 14), subscription.EndDate)
+
+	// Moving plan mid-trial keeps the trial
+	subscription.ID = 1
+	pro := &entity.Plan{TrialDays: 14, BillingCycle: "yearly"}
+	pro.ID = 3
+	utils.AssignSubscriptionPlan(subscription, pro, now.AddDate(0, 0, 3))
+	assert.Equal(t, uint(3), subscription.PlanID)
+	assert.Equal(t, entity.SUBSCRIPTION_STATUS_TRIALING, subscription.Status)
+	assert.Equal(t, now.AddDate(0, 0, 14), subscription.EndDate)
+
+	// A lapsed subscription gets a paid period, not a second trial
+	later := now.AddDate(0, 2, 0)
+	utils.AssignSubscriptionPlan(subscription, pro, later)
+	assert.Equal(t, entity.SUBSCRIPTION_STATUS_ACTIVE, subscription.Status)
+	assert.Equal(t, later.AddDate(1, 0, 0), subscription.EndDate)
+}
+
+func TestApplyRenewalPayment_ContinuesPaidPeriod(t *testing.T) {
+	periodEnd := time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)
+	plan := &entity.Plan{BillingCycle: "monthly"}
+	subscription := &entity.Subscription{
+		Status:  entity.SUBSCRIPTION_STATUS_TRIALING,
+		EndDate: periodEnd,
+	}
+
+	// Paid before the trial ends: the period starts when the trial does
+	utils.ApplyRenewalPayment(subscription, plan, periodEnd.AddDate(0, 0, -2), "TXN-1")
+	assert.Equal(t, entity.SUBSCRIPTION_STATUS_ACTIVE, subscription.Status)
+	assert.Equal(t, periodEnd, subscription.StartDate)
+	assert.Equal(t, periodEnd.AddDate(0, 1, 0), subscription.EndDate)
+	assert.Equal(t, "TXN-1", subscription.PaymentTransactionID)
+}
+
+func TestApplyRenewalFailure_GracePeriod(t *testing.T) {
+	periodEnd := time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)
+	subscription := &entity.Subscription{
+		Status:  entity.SUBSCRIPTION_STATUS_ACTIVE,
+		EndDate: periodEnd,
+	}
+
+	failedAt := periodEnd.Add(time.Hour)
+	require.True(t, utils.ApplyRenewalFailure(subscription, failedAt, 3))
+	assert.Equal(t, entity.SUBSCRIPTION_STATUS_PAST_DUE, subscription.Status)
+	assert.Equal(t, periodEnd.AddDate(0, 0, 3), *subscription.GraceEndsAt)
+	assert.True(t, utils.IsSubscriptionInForce(subscription, periodEnd.AddDate(0, 0, 2)))
+	assert.Equal(
+		t,
+		entity.SUBSCRIPTION_STATUS_EXPIRED,
+		utils.EffectiveSubscriptionStatus(subscription, periodEnd.AddDate(0, 0, 3)),
+	)
+
+	// A retry failing again does not extend the grace period
+	assert.False(t, utils.ApplyRenewalFailure(subscription, periodEnd.AddDate(0, 0, 2), 3))
+
+	// Paying late in the grace period starts the new period at the payment
+	paidAt := periodEnd.AddDate(0, 0, 2)
+	utils.ApplyRenewalPayment(subscription, &entity.Plan{BillingCycle: "monthly"}, paidAt, "")
+	assert.Equal(t, entity.SUBSCRIPTION_STATUS_ACTIVE, subscription.Status)
+	assert.Equal(t, paidAt.AddDate(0, 1, 0), subscription.EndDate)
+	assert.Nil(t, subscription.GraceEndsAt)
+}
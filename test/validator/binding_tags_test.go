@@ -0,0 +1,93 @@
+package validator_test
+
+import (
+	"testing"
+
+	"ecommerce-be/common/validator"
+
+	"github.com/gin-gonic/gin/binding"
+	playground "github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBindingTagChecks(t *testing.T) {
+	cases := []struct {
+		name  string
+		check func(string) bool
+		value string
+		want  bool
+	}{
+		{"sku", validator.IsSKU, "NIKE-TSHIRT-BLK-M", true},
+		{"sku with dot and underscore", validator.IsSKU, "MBP_16.M3", true},
+		{"sku with space", validator.IsSKU, "NIKE TSHIRT", false},
+		{"sku with doubled separator", validator.IsSKU, "NIKE--M", false},
+		{"slug", validator.IsSlug, "summer-sale-2025", true},
+		{"slug upper case", validator.IsSlug, "Summer-Sale", false},
+		{"slug trailing hyphen", validator.IsSlug, "summer-", false},
+		{"hex color", validator.IsHexColor7, "#ff5733", true},
+		{"hex color short form", validator.IsHexColor7, "#FFF", false},
+		{"hex color without hash", validator.IsHexColor7, "FF5733A", false},
+		{"e164 phone", validator.IsE164Phone, "+919876543210", true},
+		{"phone without plus", validator.IsE164Phone, "919876543210", false},
+		{"phone with leading zero", validator.IsE164Phone, "+0919876543", false},
+		{"phone too long", validator.IsE164Phone, "+1234567890123456", false},
+		{"currency", validator.IsCurrencyCode, "INR", true},
+		{"currency lower case", validator.IsCurrencyCode, "usd", true},
+		{"currency unknown", validator.IsCurrencyCode, "ABC", false},
+		{"currency too long", validator.IsCurrencyCode, "USDT", false},
+		{"ean-13", validator.IsBarcode, "4006381333931", true},
+		{"upc-a", validator.IsBarcode, "036000291452", true},
+		{"ean-8", validator.IsBarcode, "96385074", true},
+		{"gtin-14", validator.IsBarcode, "10012345678902", true},
+		{"barcode bad check digit", validator.IsBarcode, "4006381333932", false},
+		{"barcode bad length", validator.IsBarcode, "400638133393", false},
+		{"barcode with letters", validator.IsBarcode, "40063813339A1", false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, tc.check(tc.value))
+		})
+	}
+}
+
+type taggedRequest struct {
+	SKU       string  `json:"sku"       binding:"omitempty,sku"`
+	Slug      *string `json:"slug"      binding:"omitempty,slug"`
+	ColorCode string  `json:"colorCode" binding:"omitempty,hexcolor7"`
+	Phone     string  `json:"phone"     binding:"omitempty,e164phone"`
+	Currency  string  `json:"currency"  binding:"required,currencycode"`
+	Barcode   *string `json:"barcode"   binding:"omitempty,barcode"`
+}
+
+func TestRegisterBindingValidators_TagsUsableInBindingStructs(t *testing.T) {
+	validator.RegisterBindingValidators()
+
+	slug := "Not A Slug"
+	barcode := "4006381333932"
+	err := binding.Validator.ValidateStruct(&taggedRequest{
+		SKU:       "bad sku",
+		Slug:      &slug,
+		ColorCode: "#12345",
+		Phone:     "12345",
+		Currency:  "XXY",
+		Barcode:   &barcode,
+	})
+	var errs playground.ValidationErrors
+	require.ErrorAs(t, err, &errs)
+
+	failed := map[string]string{}
+	for _, fieldErr := range errs {
+		failed[fieldErr.Field()] = fieldErr.Tag()
+	}
+	assert.Equal(t, map[string]string{
+		"sku":       "sku",
+		"slug":      "slug",
+		"colorCode": "hexcolor7",
+		"phone":     "e164phone",
+		"currency":  "currencycode",
+		"barcode":   "barcode",
+	}, failed)
+
+	assert.NoError(t, binding.Validator.ValidateStruct(&taggedRequest{Currency: "EUR"}))
+}
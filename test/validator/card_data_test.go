@@ -0,0 +1,69 @@
+package validator_test
+
+import (
+	"testing"
+
+	"ecommerce-be/common/validator"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContainsCardNumber(t *testing.T) {
+	cases := []struct {
+		name string
+		text string
+		want bool
+	}{
+		{"visa", "4111111111111111", true},
+		{"grouped with spaces", "card 4111 1111 1111 1111 exp 12/30", true},
+		{"grouped with hyphens", "5555-5555-5555-4444", true},
+		{"amex", "378282246310005", true},
+		{"fails luhn", "4111111111111112", false},
+		{"too short", "411111111111", false},
+		{"millisecond timestamp", "1700000000000", false},
+		{"longer identifier", "41111111111111110000000", false},
+		{"phone number", "+1 555-123-4567", false},
+		{"gateway token", "tok_1NirD82eZvKYlo2CIvbtLWuY", false},
+		{"empty", "", false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, validator.ContainsCardNumber(tc.text))
+		})
+	}
+}
+
+func TestIsSensitiveCardField(t *testing.T) {
+	for _, name := range []string{"cardNumber", "card_number", "PAN", "cvv", "CVC2", "security-code"} {
+		assert.True(t, validator.IsSensitiveCardField(name), name)
+	}
+	for _, name := range []string{"token", "last4", "gatewayCode", "cardholderName"} {
+		assert.False(t, validator.IsSensitiveCardField(name), name)
+	}
+}
+
+func TestFindCardData(t *testing.T) {
+	field, found := validator.FindCardData(
+		[]byte(`{"gatewayCode":"cashfree","billing":{"notes":["ok","4242 4242 4242 4242"]}}`),
+	)
+	assert.True(t, found)
+	assert.Equal(t, "billing.notes[1]", field)
+
+	// Numbers are compared by their exact digits, not as floats
+	field, found = validator.FindCardData([]byte(`{"amount":4111111111111111}`))
+	assert.True(t, found)
+	assert.Equal(t, "amount", field)
+
+	field, found = validator.FindCardData([]byte(`{"card":{"cvv":"123"}}`))
+	assert.True(t, found)
+	assert.Equal(t, "card.cvv", field)
+
+	_, found = validator.FindCardData([]byte(`{"cvv":""}`))
+	assert.False(t, found)
+
+	_, found = validator.FindCardData([]byte(`token=tok_abc&pan=4111111111111111`))
+	assert.True(t, found)
+
+	_, found = validator.FindCardData([]byte(`{"token":"tok_abc","type":"card","amount":1999}`))
+	assert.False(t, found)
+}
@@ -0,0 +1,158 @@
+package validator_test
+
+import (
+	"testing"
+	"time"
+
+	commonError "ecommerce-be/common/error"
+	"ecommerce-be/common/validator"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type pageParams struct {
+	Page int `form:"page"`
+}
+
+type productFilter struct {
+	pageParams
+	MinPrice   *float64 `form:"minPrice"`
+	MaxPrice   *float64 `form:"maxPrice"`
+	ProductID  *uint    `json:"productId,omitempty"`
+	CategoryID *uint    `json:"categoryId"`
+	Sort       string   `json:"sort"`
+	Direction  string   `json:"direction"`
+	MinStock   uint     `json:"minStock"`
+	From       string   `form:"from"`
+	To         string   `form:"to"`
+	PlacedFrom *time.Time
+	PlacedTo   *time.Time
+	Tags       []string `json:"tags"`
+}
+
+// crossFieldError asserts err is a CrossFieldError and returns it
+func crossFieldError(t *testing.T, err error) *validator.CrossFieldError {
+	t.Helper()
+	var crossFieldErr *validator.CrossFieldError
+	require.ErrorAs(t, err, &crossFieldErr)
+	return crossFieldErr
+}
+
+func TestRequireTogether(t *testing.T) {
+	assert.NoError(t, validator.RequireTogether(&productFilter{}, "minPrice", "maxPrice"))
+	assert.NoError(t, validator.RequireTogether(&productFilter{
+		MinPrice: ptr(1.0),
+		MaxPrice: ptr(2.0),
+	}, "minPrice", "maxPrice"))
+
+	err := crossFieldError(t, validator.RequireTogether(&productFilter{
+		MaxPrice: ptr(2.0),
+	}, "minPrice", "maxPrice"))
+	assert.Equal(t, &validator.CrossFieldError{
+		Rule:  "required_with",
+		Field: "minPrice",
+		Param: "maxPrice",
+	}, err)
+}
+
+func TestRequireIfPresent(t *testing.T) {
+	assert.NoError(t, validator.RequireIfPresent(
+		&productFilter{Direction: "asc"}, "sort", "direction"))
+	assert.NoError(t, validator.RequireIfPresent(&productFilter{
+		Sort:      "price",
+		Direction: "asc",
+	}, "sort", "direction"))
+
+	err := crossFieldError(t, validator.RequireIfPresent(
+		&productFilter{Sort: "price"}, "sort", "direction"))
+	assert.Equal(t, "direction", err.Field)
+	assert.Equal(t, "sort", err.Param)
+	assert.Equal(t, "required_with", err.Rule)
+}
+
+func TestMutuallyExclusive(t *testing.T) {
+	assert.NoError(t, validator.MutuallyExclusive(&productFilter{}, "productId", "categoryId"))
+	assert.NoError(t, validator.MutuallyExclusive(&productFilter{
+		ProductID: ptr(uint(1)),
+	}, "productId", "categoryId"))
+
+	err := crossFieldError(t, validator.MutuallyExclusive(&productFilter{
+		ProductID:  ptr(uint(1)),
+		CategoryID: ptr(uint(2)),
+	}, "productId", "categoryId"))
+	assert.Equal(t, "categoryId", err.Field)
+	assert.Equal(t, "productId", err.Param)
+	assert.Equal(t, "excluded_with", err.Rule)
+}
+
+func TestRequireLessOrEqual(t *testing.T) {
+	// A missing bound leaves nothing to compare
+	assert.NoError(t, validator.RequireLessOrEqual(&productFilter{
+		MinPrice: ptr(5.0),
+	}, "minPrice", "maxPrice"))
+	assert.NoError(t, validator.RequireLessOrEqual(&productFilter{
+		MinPrice: ptr(5.0),
+		MaxPrice: ptr(5.0),
+	}, "minPrice", "maxPrice"))
+
+	err := crossFieldError(t, validator.RequireLessOrEqual(&productFilter{
+		MinPrice: ptr(5.0),
+		MaxPrice: ptr(4.99),
+	}, "minPrice", "maxPrice"))
+	assert.Equal(t, "minPrice", err.Field)
+	assert.Equal(t, "maxPrice", err.Param)
+	assert.Equal(t, "ltefield", err.Rule)
+
+	// Integer fields, including those of embedded structs, compare too
+	err = crossFieldError(t, validator.RequireLessOrEqual(&productFilter{
+		pageParams: pageParams{Page: 3},
+		MinStock:   2,
+	}, "page", "minStock"))
+	assert.Equal(t, "page", err.Field)
+}
+
+func TestRequireLessOrEqual_Times(t *testing.T) {
+	assert.NoError(t, validator.RequireLessOrEqual(&productFilter{
+		From: "2026-10-01T00:00:00Z",
+		To:   "2026-10-01T00:00:00Z",
+	}, "from", "to"))
+	// Unparseable times are left to the code that parses them
+	assert.NoError(t, validator.RequireLessOrEqual(&productFilter{
+		From: "yesterday",
+		To:   "2026-10-01T00:00:00Z",
+	}, "from", "to"))
+
+	err := crossFieldError(t, validator.RequireLessOrEqual(&productFilter{
+		From: "2026-10-02T00:00:00+05:30",
+		To:   "2026-10-01T00:00:00Z",
+	}, "from", "to"))
+	assert.Equal(t, "from", err.Field)
+	assert.Equal(t, "to", err.Param)
+
+	day := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	err = crossFieldError(t, validator.RequireLessOrEqual(&productFilter{
+		PlacedFrom: ptr(day),
+		PlacedTo:   ptr(day.Add(-time.Second)),
+	}, "PlacedFrom", "PlacedTo"))
+	assert.Equal(t, "PlacedFrom", err.Field)
+}
+
+func TestCrossFieldRules_RejectUnknownAndNonNumericFields(t *testing.T) {
+	for _, err := range []error{
+		validator.RequireTogether(&productFilter{}, "minPrice", "maxPrize"),
+		validator.RequireLessOrEqual(&productFilter{}, "tags", "maxPrice"),
+		validator.RequireLessOrEqual(&productFilter{
+			MinPrice: ptr(1.0),
+			To:       "2026-10-01T00:00:00Z",
+		}, "minPrice", "to"),
+	} {
+		var appErr *commonError.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, commonError.ErrInvalidRequestStruct.Code, appErr.Code)
+	}
+
+	assert.ErrorIs(t,
+		validator.MutuallyExclusive("productId", "productId", "categoryId"),
+		commonError.ErrInvalidRequestStruct)
+}
@@ -0,0 +1,73 @@
+package validator_test
+
+import (
+	"strings"
+	"testing"
+
+	"ecommerce-be/common/constants"
+	"ecommerce-be/common/validator"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type variantsInput struct {
+	Variants []struct {
+		SKU string `json:"sku"`
+	} `json:"variants"`
+}
+
+func withJSONLimits(t *testing.T, maxDepth, maxArrayLength int) {
+	validator.SetJSONLimits(maxDepth, maxArrayLength)
+	t.Cleanup(func() {
+		validator.SetJSONLimits(
+			validator.DEFAULT_MAX_JSON_DEPTH,
+			validator.DEFAULT_MAX_JSON_ARRAY_LENGTH,
+		)
+	})
+}
+
+func TestCheckJSONLimits_AcceptsBodiesWithinLimits(t *testing.T) {
+	withJSONLimits(t, 3, 2)
+
+	assert.NoError(t, validator.CheckJSONLimits([]byte(`{"variants":[{"sku":"A"},{"sku":"B"}]}`)))
+	assert.NoError(t, validator.CheckJSONLimits([]byte(`"plain"`)))
+	// Malformed JSON is left for decoding to report
+	assert.NoError(t, validator.CheckJSONLimits([]byte(`{"variants":[`)))
+}
+
+func TestCheckJSONLimits_RejectsDeepNesting(t *testing.T) {
+	withJSONLimits(t, 3, 100)
+
+	err := validator.CheckJSONLimits([]byte(`{"a":{"b":[{"c":1}]}}`))
+
+	var limitErr *validator.JSONLimitError
+	require.ErrorAs(t, err, &limitErr)
+	assert.Equal(t, constants.VALIDATION_RULE_MAX_DEPTH, limitErr.Rule)
+	assert.Equal(t, "a.b[0]", limitErr.Field)
+	assert.Equal(t, 3, limitErr.Limit)
+}
+
+func TestCheckJSONLimits_RejectsLongArrays(t *testing.T) {
+	withJSONLimits(t, 10, 2)
+
+	err := validator.CheckJSONLimits([]byte(`{"variants":[{"sku":"A"},{"sku":"B"},{"sku":"C"}]}`))
+
+	var limitErr *validator.JSONLimitError
+	require.ErrorAs(t, err, &limitErr)
+	assert.Equal(t, constants.VALIDATION_RULE_MAX_ARRAY_LENGTH, limitErr.Rule)
+	assert.Equal(t, "variants", limitErr.Field)
+	assert.Equal(t, 2, limitErr.Limit)
+}
+
+func TestSanitizedJSON_RejectsBodyOverLimitsBeforeDecoding(t *testing.T) {
+	withJSONLimits(t, 10, 2)
+	body := `{"variants":[` + strings.Repeat(`{"sku":"A"},`, 2) + `{"sku":"A"}]}`
+
+	var input variantsInput
+	err := validator.SanitizedJSON.BindBody([]byte(body), &input)
+
+	var limitErr *validator.JSONLimitError
+	require.ErrorAs(t, err, &limitErr)
+	assert.Empty(t, input.Variants)
+}
@@ -0,0 +1,95 @@
+package validator_test
+
+import (
+	"testing"
+
+	commonError "ecommerce-be/common/error"
+	"ecommerce-be/common/validator"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type imagesUpdate struct {
+	Position *int
+	AltText  *string
+}
+
+type optionUpdate struct {
+	Value *string
+}
+
+type variantUpdate struct {
+	ID      *uint
+	Price   *float64
+	Images  *imagesUpdate
+	Limits  imagesUpdate
+	Options []*optionUpdate
+	Tags    []*string
+}
+
+func ptr[T any](v T) *T { return &v }
+
+func TestRequireAtLeastOneNonNilPointer_TopLevelOnly(t *testing.T) {
+	assert.NoError(t, validator.RequireAtLeastOneNonNilPointer(&variantUpdate{Price: ptr(1.5)}))
+	// Without traversal a non-nil pointer counts even when it points to an empty struct
+	assert.NoError(t, validator.RequireAtLeastOneNonNilPointer(&variantUpdate{
+		Images: &imagesUpdate{},
+	}))
+	assert.ErrorIs(t, validator.RequireAtLeastOneNonNilPointer(&variantUpdate{
+		Limits: imagesUpdate{Position: ptr(1)},
+		Tags:   []*string{ptr("sale")},
+	}), commonError.ErrNoFieldsProvided)
+}
+
+func TestRequireAtLeastOneNonNilPointerWithOptions_NestedStructs(t *testing.T) {
+	opts := validator.TraversalOptions{MaxDepth: 1}
+
+	assert.ErrorIs(t, validator.RequireAtLeastOneNonNilPointerWithOptions(&variantUpdate{
+		Images: &imagesUpdate{},
+	}, opts), commonError.ErrNoFieldsProvided)
+	assert.NoError(t, validator.RequireAtLeastOneNonNilPointerWithOptions(&variantUpdate{
+		Images: &imagesUpdate{AltText: ptr("front")},
+	}, opts))
+	assert.NoError(t, validator.RequireAtLeastOneNonNilPointerWithOptions(&variantUpdate{
+		Limits: imagesUpdate{Position: ptr(2)},
+	}, opts))
+}
+
+func TestRequireAtLeastOneNonNilPointerWithOptions_Slices(t *testing.T) {
+	req := &variantUpdate{Options: []*optionUpdate{nil, {}}}
+
+	assert.ErrorIs(t, validator.RequireAtLeastOneNonNilPointerWithOptions(req,
+		validator.TraversalOptions{MaxDepth: 1, IncludeSlices: true}),
+		commonError.ErrNoFieldsProvided)
+
+	req.Options[1].Value = ptr("red")
+	assert.NoError(t, validator.RequireAtLeastOneNonNilPointerWithOptions(req,
+		validator.TraversalOptions{MaxDepth: 1, IncludeSlices: true}))
+	assert.ErrorIs(t, validator.RequireAtLeastOneNonNilPointerWithOptions(req,
+		validator.TraversalOptions{MaxDepth: 1}), commonError.ErrNoFieldsProvided)
+
+	assert.NoError(t, validator.RequireAtLeastOneNonNilPointerWithOptions(&variantUpdate{
+		Tags: []*string{nil, ptr("sale")},
+	}, validator.TraversalOptions{IncludeSlices: true}))
+}
+
+func TestRequireAtLeastOneNonNilPointerWithOptions_ExcludeFields(t *testing.T) {
+	opts := validator.TraversalOptions{MaxDepth: 1, ExcludeFields: []string{"ID", "Position"}}
+
+	assert.ErrorIs(t, validator.RequireAtLeastOneNonNilPointerWithOptions(&variantUpdate{
+		ID:     ptr(uint(7)),
+		Images: &imagesUpdate{Position: ptr(1)},
+	}, opts), commonError.ErrNoFieldsProvided)
+	assert.NoError(t, validator.RequireAtLeastOneNonNilPointerWithOptions(&variantUpdate{
+		ID:    ptr(uint(7)),
+		Price: ptr(9.99),
+	}, opts))
+}
+
+func TestRequireAtLeastOneNonNilPointerWithOptions_RejectsNonStruct(t *testing.T) {
+	var req *variantUpdate
+	err := validator.RequireAtLeastOneNonNilPointerWithOptions(req, validator.TraversalOptions{})
+	assert.Equal(t, commonError.ErrInvalidRequestStruct.Code, err.(*commonError.AppError).Code)
+	assert.ErrorIs(t, validator.RequireAtLeastOneNonNilPointerWithOptions("price",
+		validator.TraversalOptions{}), commonError.ErrInvalidRequestStruct)
+}
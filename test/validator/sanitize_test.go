@@ -0,0 +1,93 @@
+package validator_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	commonError "ecommerce-be/common/error"
+	"ecommerce-be/common/validator"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type attributeInput struct {
+	Name string `json:"name" sanitize:"collapse"`
+}
+
+type descriptionInput struct {
+	Name        string           `json:"name"        binding:"required" sanitize:"nfc,striphtml,collapse"`
+	Description *string          `json:"description" sanitize:"richtext,trim"`
+	Tags        []string         `json:"tags"        sanitize:"trim"`
+	Attributes  []attributeInput `json:"attributes"`
+	Password    string           `json:"password"`
+}
+
+func TestSanitizeString_Rules(t *testing.T) {
+	assert.Equal(t, "Blue Cotton Shirt",
+		validator.SanitizeString("  Blue \t Cotton\n\nShirt ", validator.SANITIZE_COLLAPSE))
+	// "e" followed by a combining acute accent becomes the precomposed "é"
+	assert.Equal(t, "caf\u00e9", validator.SanitizeString("cafe\u0301", validator.SANITIZE_NFC))
+	assert.Equal(t, "Hello world", validator.SanitizeString(
+		"<b>Hello</b> <script>alert(1)</script>world", validator.SANITIZE_STRIP_HTML))
+	// Escaped markup is text, not markup, and stays escaped
+	assert.Equal(t, "Use &lt;b&gt; tags", validator.StripHTML("Use &lt;b&gt; tags"))
+	assert.Equal(t, "5 < 6", validator.StripHTML("5 < 6"))
+
+	assert.Panics(t, func() { validator.SanitizeString("x", "uppercase") })
+}
+
+func TestSanitizeRichText_AllowList(t *testing.T) {
+	input := `<p onclick="steal()">Soft <strong>cotton</strong><br/>` +
+		`<img src=x onerror=alert(1)><script>alert(1)</script></p>` +
+		`<a href="javascript:alert(1)">bad</a> <a href="https://shop.example/care" ` +
+		`target="_blank">care</a> Tom &amp; Jerry`
+
+	assert.Equal(t, `<p>Soft <strong>cotton</strong><br></p>`+
+		`<a>bad</a> <a href="https://shop.example/care">care</a> Tom &amp; Jerry`,
+		validator.SanitizeRichText(input))
+}
+
+func TestSanitize_WalksTaggedFields(t *testing.T) {
+	description := "  <p>Great <em>fit</em></p><style>p{}</style>  "
+	req := &descriptionInput{
+		Name:        " <i>Linen</i>   Shirt ",
+		Description: &description,
+		Tags:        []string{" summer ", "linen"},
+		Attributes:  []attributeInput{{Name: "Fabric   weight"}},
+		Password:    " secret ",
+	}
+
+	require.NoError(t, validator.Sanitize(req))
+
+	assert.Equal(t, "Linen Shirt", req.Name)
+	assert.Equal(t, "<p>Great <em>fit</em></p>", *req.Description)
+	assert.Equal(t, []string{"summer", "linen"}, req.Tags)
+	assert.Equal(t, "Fabric weight", req.Attributes[0].Name)
+	// Untagged fields are left alone
+	assert.Equal(t, " secret ", req.Password)
+
+	err := validator.Sanitize(*req)
+	assert.Equal(t, commonError.ErrInvalidRequestStruct.Code, err.(*commonError.AppError).Code)
+}
+
+func TestSanitizedJSON_SanitizesBeforeValidation(t *testing.T) {
+	bind := func(body string) (descriptionInput, error) {
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Request = httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+		var req descriptionInput
+		err := ctx.ShouldBindWith(&req, validator.SanitizedJSON)
+		return req, err
+	}
+
+	req, err := bind(`{"name": "  <b>Wool</b>  Scarf "}`)
+	require.NoError(t, err)
+	assert.Equal(t, "Wool Scarf", req.Name)
+
+	// Markup-only names are empty once cleaned and fail "required"
+	_, err = bind(`{"name": "<script>x</script>  "}`)
+	assert.Error(t, err)
+}
@@ -32,4 +32,11 @@ func addModules(c *common.Container) {
 	c.RegisterModule(routes.NewCurrencyModule())
 	c.RegisterModule(routes.NewSellerModule())
 	c.RegisterModule(routes.NewSellerSettingsModule())
+	c.RegisterModule(routes.NewDelegatedTokenModule())
+	c.RegisterModule(routes.NewProfileMediaModule())
+	c.RegisterModule(routes.NewEmailChangeModule())
+	c.RegisterModule(routes.NewSubscriptionModule())
+	c.RegisterModule(routes.NewImpersonationModule())
+	c.RegisterModule(routes.NewStorefrontModule())
+	c.RegisterModule(routes.NewSellerDomainModule())
 }
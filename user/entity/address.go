@@ -70,6 +70,10 @@ type Address struct {
 	Longitude *float64    `json:"longitude" gorm:"column:longitude"`
 	IsDefault bool        `json:"isDefault" gorm:"column:is_default;default:false"`
 
+	// IsDefault marks the default shipping address; IsDefaultBilling the default billing
+	// address. A user has at most one of each, often the same address.
+	IsDefaultBilling bool `json:"isDefaultBilling" gorm:"column:is_default_billing;default:false"`
+
 	// Relationships
 	Country Country `json:"country,omitempty" gorm:"foreignKey:CountryID"`
 }
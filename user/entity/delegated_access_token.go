@@ -0,0 +1,47 @@
+package entity
+
+import (
+	"time"
+
+	"ecommerce-be/common/db"
+)
+
+// DelegatedAccessToken is a short-lived, narrowly scoped grant a seller issues to a
+// third party (e.g. an agency). Only the SHA-256 hash of the token is stored.
+type DelegatedAccessToken struct {
+	db.BaseEntity
+	SellerID        uint           `json:"sellerId"        gorm:"not null;index"`
+	CreatedByUserID uint           `json:"createdByUserId" gorm:"not null"`
+	Name            string         `json:"name"            gorm:"size:100;not null"`
+	TokenHash       string         `json:"-"               gorm:"size:64;uniqueIndex;not null"`
+	TokenPrefix     string         `json:"tokenPrefix"     gorm:"size:16;not null"` // Leading characters, for display only
+	Scopes          db.StringArray `json:"scopes"          gorm:"type:text[];not null"`
+	ExpiresAt       time.Time      `json:"expiresAt"       gorm:"not null"`
+	LastUsedAt      *time.Time     `json:"lastUsedAt"`
+	RevokedAt       *time.Time     `json:"revokedAt"`
+	RevokedByUserID *uint          `json:"revokedByUserId"`
+}
+
+// IsRevoked reports whether the token was revoked early
+func (t *DelegatedAccessToken) IsRevoked() bool {
+	return t.RevokedAt != nil
+}
+
+// IsExpired reports whether the token is past its expiry at the given time
+func (t *DelegatedAccessToken) IsExpired(now time.Time) bool {
+	return !now.Before(t.ExpiresAt)
+}
+
+// DelegatedAccessTokenAudit is an immutable record of a delegated token's lifecycle
+// (creation, revocation) and of every request made with it.
+type DelegatedAccessTokenAudit struct {
+	ID          uint      `json:"id"          gorm:"primaryKey"`
+	TokenID     uint      `json:"tokenId"     gorm:"not null;index"`
+	SellerID    uint      `json:"sellerId"    gorm:"not null"`
+	Action      string    `json:"action"      gorm:"size:20;not null"`
+	ActorUserID *uint     `json:"actorUserId"`
+	Method      *string   `json:"method"      gorm:"size:10"`
+	Path        *string   `json:"path"        gorm:"size:255"`
+	IPAddress   *string   `json:"ipAddress"   gorm:"size:64"`
+	CreatedAt   time.Time `json:"createdAt"   gorm:"autoCreateTime"`
+}
@@ -0,0 +1,49 @@
+package entity
+
+import (
+	"time"
+
+	"ecommerce-be/common/db"
+)
+
+// EmailChangeRequest is a pending change of a user's login email. It completes only
+// once both the current and the new address have confirmed through their own link.
+// Only the SHA-256 hashes of the two tokens are stored.
+type EmailChangeRequest struct {
+	db.BaseEntity
+	UserID         uint       `json:"userId"         gorm:"not null;index"`
+	OldEmail       string     `json:"oldEmail"       gorm:"size:255;not null"`
+	NewEmail       string     `json:"newEmail"       gorm:"size:255;not null"`
+	OldTokenHash   string     `json:"-"              gorm:"size:64;uniqueIndex;not null"`
+	NewTokenHash   string     `json:"-"              gorm:"size:64;uniqueIndex;not null"`
+	OldConfirmedAt *time.Time `json:"oldConfirmedAt"`
+	NewConfirmedAt *time.Time `json:"newConfirmedAt"`
+	ExpiresAt      time.Time  `json:"expiresAt"      gorm:"not null"`
+	CompletedAt    *time.Time `json:"completedAt"`
+	CancelledAt    *time.Time `json:"cancelledAt"`
+}
+
+// IsExpired reports whether the confirmation links are past their expiry
+func (r *EmailChangeRequest) IsExpired(now time.Time) bool {
+	return !now.Before(r.ExpiresAt)
+}
+
+// IsOpen reports whether the request can still be confirmed or cancelled
+func (r *EmailChangeRequest) IsOpen(now time.Time) bool {
+	return r.CompletedAt == nil && r.CancelledAt == nil && !r.IsExpired(now)
+}
+
+// IsFullyConfirmed reports whether both addresses have confirmed
+func (r *EmailChangeRequest) IsFullyConfirmed() bool {
+	return r.OldConfirmedAt != nil && r.NewConfirmedAt != nil
+}
+
+// EmailChangeAudit is an immutable record of an email change request's lifecycle.
+type EmailChangeAudit struct {
+	ID        uint      `json:"id"        gorm:"primaryKey"`
+	RequestID uint      `json:"requestId" gorm:"not null;index"`
+	UserID    uint      `json:"userId"    gorm:"not null"`
+	Action    string    `json:"action"    gorm:"size:20;not null"`
+	IPAddress *string   `json:"ipAddress" gorm:"size:64"`
+	CreatedAt time.Time `json:"createdAt" gorm:"autoCreateTime"`
+}
@@ -0,0 +1,34 @@
+package entity
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+)
+
+// ImageCrop is the crop rectangle (source image pixels) stored with an avatar or logo
+type ImageCrop struct {
+	X      int `json:"x"`
+	Y      int `json:"y"`
+	Width  int `json:"width"`
+	Height int `json:"height"`
+}
+
+// Scan implements sql.Scanner.
+func (c *ImageCrop) Scan(value any) error {
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case []byte:
+		return json.Unmarshal(v, c)
+	case string:
+		return json.Unmarshal([]byte(v), c)
+	default:
+		return fmt.Errorf("entity.ImageCrop: unsupported Scan type %T", value)
+	}
+}
+
+// Value implements driver.Valuer.
+func (c ImageCrop) Value() (driver.Value, error) {
+	return json.Marshal(c)
+}
@@ -0,0 +1,45 @@
+package entity
+
+import (
+	"time"
+
+	"ecommerce-be/common/db"
+)
+
+// ImpersonationSession is a time-boxed "act as seller" session opened by an admin.
+// The impersonation token is a JWT bound to the session, so ending the session
+// revokes the token.
+type ImpersonationSession struct {
+	db.BaseEntity
+	AdminUserID   uint       `json:"adminUserId"   gorm:"not null;index"`
+	SellerID      uint       `json:"sellerId"      gorm:"not null;index"`
+	Reason        string     `json:"reason"        gorm:"size:255;not null"`
+	ExpiresAt     time.Time  `json:"expiresAt"     gorm:"not null"`
+	EndedAt       *time.Time `json:"endedAt"`
+	EndedByUserID *uint      `json:"endedByUserId"`
+}
+
+// IsEnded reports whether the session was ended explicitly
+func (s *ImpersonationSession) IsEnded() bool {
+	return s.EndedAt != nil
+}
+
+// IsExpired reports whether the session is past its expiry at the given time
+func (s *ImpersonationSession) IsExpired(now time.Time) bool {
+	return !now.Before(s.ExpiresAt)
+}
+
+// ImpersonationAudit is an immutable record of an impersonation session's lifecycle
+// (start, end) and of every request made during it.
+type ImpersonationAudit struct {
+	ID          uint      `json:"id"          gorm:"primaryKey"`
+	SessionID   uint      `json:"sessionId"   gorm:"not null;index"`
+	AdminUserID uint      `json:"adminUserId" gorm:"not null"`
+	SellerID    uint      `json:"sellerId"    gorm:"not null"`
+	Action      string    `json:"action"      gorm:"size:20;not null"`
+	ActorUserID *uint     `json:"actorUserId"`
+	Method      *string   `json:"method"      gorm:"size:10"`
+	Path        *string   `json:"path"        gorm:"size:255"`
+	IPAddress   *string   `json:"ipAddress"   gorm:"size:64"`
+	CreatedAt   time.Time `json:"createdAt"   gorm:"autoCreateTime"`
+}
@@ -15,4 +15,19 @@ type Plan struct {
 	IsPopular    bool    `json:"isPopular"    gorm:"default:false"`            // Featured/popular plan flag
 	SortOrder    int     `json:"sortOrder"    gorm:"default:0"`                // Display order
 	TrialDays    int     `json:"trialDays"    gorm:"default:0"`                // Free trial days (0 = no trial)
+
+	// Limits enforced on the seller's catalog; nil means unlimited
+	MaxProducts *int         `json:"maxProducts" gorm:"column:max_products"`
+	MaxVariants *int         `json:"maxVariants" gorm:"column:max_variants"`
+	Features    PlanFeatures `json:"features"    gorm:"column:features;type:jsonb;not null"`
+}
+
+// PlanFeatures maps a feature key, such as related_products_api, to whether the plan
+// includes it. Keys the map does not list are not included.
+type PlanFeatures = db.JSONMap
+
+// HasFeature reports whether the plan includes the feature
+func (p *Plan) HasFeature(key string) bool {
+	included, _ := p.Features[key].(bool)
+	return included
 }
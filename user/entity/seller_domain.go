@@ -0,0 +1,12 @@
+package entity
+
+import "ecommerce-be/common/db"
+
+// SellerDomain maps a custom storefront domain to its seller. Public APIs called on
+// the domain are scoped to the seller without an X-Seller-ID header. Domains are
+// stored lowercased, without port or trailing dot.
+type SellerDomain struct {
+	db.BaseEntity
+	SellerID uint   `json:"sellerId" gorm:"not null;index"`
+	Domain   string `json:"domain"   gorm:"size:253;uniqueIndex;not null"`
+}
@@ -9,8 +9,9 @@ type SellerProfile struct {
 	db.BaseEntityWithoutID
 	UserID uint `json:"userId" gorm:"primaryKey"`
 
-	BusinessName       string  `json:"businessName" binding:"required"`
-	BusinessLogoFileID *string `json:"businessLogoFileId" gorm:"column:business_logo_file_id;size:80"`
-	TaxID              string  `json:"taxId" gorm:"unique"`
-	IsVerified         bool    `json:"isVerified" gorm:"default:false"`
+	BusinessName       string     `json:"businessName" binding:"required"`
+	BusinessLogoFileID *string    `json:"businessLogoFileId" gorm:"column:business_logo_file_id;size:80"`
+	BusinessLogoCrop   *ImageCrop `json:"businessLogoCrop" gorm:"column:business_logo_crop;type:jsonb"`
+	TaxID              string     `json:"taxId" gorm:"unique"`
+	IsVerified         bool       `json:"isVerified" gorm:"default:false"`
 }
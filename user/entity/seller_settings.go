@@ -16,4 +16,10 @@ type SellerSettings struct {
 
 	// Display preferences
 	DisplayPricesInBuyerCurrency bool `json:"displayPricesInBuyerCurrency" gorm:"default:false"` // Convert prices for buyers
+
+	// Inventory valuation: FIFO lots or moving average cost
+	InventoryCostingMethod string `json:"inventoryCostingMethod" gorm:"column:inventory_costing_method;size:16;not null;default:FIFO"`
+
+	// Minutes after placement a customer may still cancel an order; 0 means no limit
+	CancellationWindowMinutes int `json:"cancellationWindowMinutes" gorm:"column:cancellation_window_minutes;not null;default:0"`
 }
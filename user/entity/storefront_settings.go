@@ -0,0 +1,60 @@
+package entity
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+
+	"ecommerce-be/common/db"
+)
+
+// StorefrontSettings is the seller's public storefront configuration: branding,
+// theme, contact details and the display currency and timezone of the store.
+type StorefrontSettings struct {
+	db.BaseEntity
+	SellerID uint `json:"sellerId" gorm:"uniqueIndex;not null"` // References user.id (seller)
+
+	// Branding
+	StoreName string  `json:"storeName" gorm:"size:100;not null"`
+	LogoURL   *string `json:"logoUrl"   gorm:"size:500"`
+
+	// Theme colors (#RRGGBB)
+	PrimaryColor   *string `json:"primaryColor"   gorm:"size:7"`
+	SecondaryColor *string `json:"secondaryColor" gorm:"size:7"`
+	AccentColor    *string `json:"accentColor"    gorm:"size:7"`
+
+	// Contact info
+	ContactEmail *string     `json:"contactEmail" gorm:"size:255"`
+	ContactPhone *string     `json:"contactPhone" gorm:"size:20"`
+	SocialLinks  SocialLinks `json:"socialLinks"  gorm:"type:jsonb;not null;default:'{}'"`
+
+	// Localization
+	CurrencyCode *string `json:"currencyCode" gorm:"size:3"`
+	Timezone     string  `json:"timezone"     gorm:"size:64;not null;default:UTC"`
+}
+
+// SocialLinks maps a social network to the store's profile URL
+type SocialLinks map[string]string
+
+// Scan implements sql.Scanner.
+func (l *SocialLinks) Scan(value any) error {
+	switch v := value.(type) {
+	case nil:
+		*l = SocialLinks{}
+		return nil
+	case []byte:
+		return json.Unmarshal(v, l)
+	case string:
+		return json.Unmarshal([]byte(v), l)
+	default:
+		return fmt.Errorf("entity.SocialLinks: unsupported Scan type %T", value)
+	}
+}
+
+// Value implements driver.Valuer.
+func (l SocialLinks) Value() (driver.Value, error) {
+	if l == nil {
+		return json.Marshal(map[string]string{})
+	}
+	return json.Marshal(map[string]string(l))
+}
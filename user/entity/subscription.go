@@ -14,6 +14,11 @@ type Subscription struct {
 	StartDate            time.Time          `json:"startDate"                      gorm:"not null"`                       // When this subscription period started.
 	EndDate              time.Time          `json:"endDate"                        gorm:"not null"`                       // When this subscription period ends.
 	PaymentTransactionID string             `json:"paymentTransactionId,omitempty"`                                       // The transaction ID from your payment provider.
+
+	// PastDueSince and GraceEndsAt are set while a failed renewal is retried; the seller
+	// keeps access until GraceEndsAt, while EndDate stays the date the seller paid up to
+	PastDueSince *time.Time `json:"pastDueSince,omitempty" gorm:"column:past_due_since"`
+	GraceEndsAt  *time.Time `json:"graceEndsAt,omitempty"  gorm:"column:grace_ends_at"`
 }
 
 type SubscriptionStatus string
@@ -22,6 +27,28 @@ const (
 	SUBSCRIPTION_STATUS_PENDING   SubscriptionStatus = "pending"
 	SUBSCRIPTION_STATUS_ACTIVE    SubscriptionStatus = "active"
 	SUBSCRIPTION_STATUS_TRIALING  SubscriptionStatus = "trialing"
+	SUBSCRIPTION_STATUS_PAST_DUE  SubscriptionStatus = "past_due"
 	SUBSCRIPTION_STATUS_EXPIRED   SubscriptionStatus = "expired"
 	SUBSCRIPTION_STATUS_CANCELLED SubscriptionStatus = "cancelled"
 )
+
+// SubscriptionPaymentEventType is the outcome of a renewal payment reported by the
+// billing provider
+type SubscriptionPaymentEventType string
+
+const (
+	SUBSCRIPTION_PAYMENT_SUCCEEDED SubscriptionPaymentEventType = "payment.succeeded"
+	SUBSCRIPTION_PAYMENT_FAILED    SubscriptionPaymentEventType = "payment.failed"
+)
+
+// SubscriptionPaymentEvent records a renewal payment webhook once it has been applied, so
+// a redelivery of the same event is ignored
+type SubscriptionPaymentEvent struct {
+	ID             uint                         `json:"id"             gorm:"primaryKey"`
+	EventID        string                       `json:"eventId"        gorm:"column:event_id;size:255;not null;uniqueIndex"`
+	SubscriptionID uint                         `json:"subscriptionId" gorm:"column:subscription_id;not null;index"`
+	EventType      SubscriptionPaymentEventType `json:"eventType"      gorm:"column:event_type;size:50;not null"`
+	TransactionID  string                       `json:"transactionId"  gorm:"column:transaction_id;size:255"`
+	OccurredAt     time.Time                    `json:"occurredAt"     gorm:"column:occurred_at;not null"`
+	CreatedAt      time.Time                    `json:"createdAt"      gorm:"column:created_at;autoCreateTime"`
+}
@@ -28,4 +28,14 @@ type User struct {
 	// Note: User's country is derived from their default address
 	CurrencyID *uint  `json:"currencyId"`                                // User's preferred currency for display (optional)
 	Locale     string `json:"locale"     gorm:"size:10;default:'en-US'"` // Locale for formatting (e.g., 'en-US', 'hi-IN')
+
+	// --- Guest Checkout ---
+	// Guest users are created by guest checkout and cannot sign in. Their email may
+	// repeat; the registered account with the same email can claim their orders.
+	IsGuest bool `json:"isGuest" gorm:"default:false"`
+
+	// --- Avatar ---
+	// File reference (USER_AVATAR) and the crop the user framed it with
+	AvatarFileID *string    `json:"avatarFileId" gorm:"column:avatar_file_id;size:80"`
+	AvatarCrop   *ImageCrop `json:"avatarCrop"   gorm:"column:avatar_crop;type:jsonb"`
 }
@@ -0,0 +1,59 @@
+package error
+
+import (
+	"fmt"
+	"net/http"
+
+	commonerrors "ecommerce-be/common/error"
+	"ecommerce-be/user/utils/constant"
+)
+
+var (
+	// ErrAddressLimitReached is returned when the address book already holds the
+	// maximum number of addresses
+	ErrAddressLimitReached = &commonerrors.AppError{
+		Code:       constant.ADDRESS_LIMIT_REACHED_CODE,
+		Message:    constant.ADDRESS_LIMIT_REACHED_MSG,
+		StatusCode: http.StatusConflict,
+	}
+)
+
+// ErrPostalCodeRequired is returned when a country that uses postal codes gets none
+func ErrPostalCodeRequired(countryName string) *commonerrors.AppError {
+	return &commonerrors.AppError{
+		Code:       constant.INVALID_ADDRESS_FIELD_CODE,
+		Message:    fmt.Sprintf(constant.POSTAL_CODE_REQUIRED_MSG, countryName),
+		StatusCode: http.StatusBadRequest,
+	}
+}
+
+// ErrInvalidPostalCode is returned when a postal code does not match the country's format
+func ErrInvalidPostalCode(postalCode, countryName string) *commonerrors.AppError {
+	return &commonerrors.AppError{
+		Code:       constant.INVALID_ADDRESS_FIELD_CODE,
+		Message:    fmt.Sprintf(constant.INVALID_POSTAL_CODE_MSG, postalCode, countryName),
+		StatusCode: http.StatusBadRequest,
+	}
+}
+
+// ErrStateRequired is returned when a country with states or provinces gets none
+func ErrStateRequired(countryName string) *commonerrors.AppError {
+	return &commonerrors.AppError{
+		Code:       constant.INVALID_ADDRESS_FIELD_CODE,
+		Message:    fmt.Sprintf(constant.STATE_REQUIRED_MSG, countryName),
+		StatusCode: http.StatusBadRequest,
+	}
+}
+
+// ErrCountryNotSupported is returned for addresses in countries the platform disabled
+func ErrCountryNotSupported(countryName string) *commonerrors.AppError {
+	return &commonerrors.AppError{
+		Code:       constant.COUNTRY_NOT_SUPPORTED_CODE,
+		Message:    fmt.Sprintf(constant.COUNTRY_NOT_SUPPORTED_MSG, countryName),
+		StatusCode: http.StatusBadRequest,
+	}
+}
+
+func init() {
+	commonerrors.Register(ErrAddressLimitReached)
+}
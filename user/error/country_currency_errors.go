@@ -36,3 +36,12 @@ var (
 		StatusCode: http.StatusConflict,
 	}
 )
+
+func init() {
+	commonerrors.Register(
+		ErrCountryCurrencyNotFound,
+		ErrCountryCurrencyExists,
+		ErrPrimaryCurrencyRequired,
+		ErrMultiplePrimaryCurrencies,
+	)
+}
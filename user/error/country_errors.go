@@ -36,3 +36,12 @@ var (
 		StatusCode: http.StatusConflict,
 	}
 )
+
+func init() {
+	commonerrors.Register(
+		ErrCountryNotFound,
+		ErrDuplicateCountryCode,
+		ErrCountryInactive,
+		ErrCountryHasReferences,
+	)
+}
@@ -36,3 +36,12 @@ var (
 		StatusCode: http.StatusConflict,
 	}
 )
+
+func init() {
+	commonerrors.Register(
+		ErrCurrencyNotFound,
+		ErrDuplicateCurrencyCode,
+		ErrCurrencyInactive,
+		ErrCurrencyHasReferences,
+	)
+}
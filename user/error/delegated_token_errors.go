@@ -0,0 +1,56 @@
+package error
+
+import (
+	"net/http"
+
+	commonerrors "ecommerce-be/common/error"
+	"ecommerce-be/user/utils/constant"
+)
+
+var (
+	// ErrDelegatedTokenNotFound is returned when the token does not exist for the seller
+	ErrDelegatedTokenNotFound = &commonerrors.AppError{
+		Code:       constant.DELEGATED_TOKEN_NOT_FOUND_CODE,
+		Message:    constant.DELEGATED_TOKEN_NOT_FOUND_MSG,
+		StatusCode: http.StatusNotFound,
+	}
+
+	// ErrDelegatedTokenInvalidScope is returned when a requested scope is unsupported
+	ErrDelegatedTokenInvalidScope = &commonerrors.AppError{
+		Code:       constant.DELEGATED_TOKEN_INVALID_SCOPE_CODE,
+		Message:    constant.DELEGATED_TOKEN_INVALID_SCOPE_MSG,
+		StatusCode: http.StatusBadRequest,
+	}
+
+	// ErrDelegatedTokenLimitReached is returned when the seller has too many active tokens
+	ErrDelegatedTokenLimitReached = &commonerrors.AppError{
+		Code:       constant.DELEGATED_TOKEN_LIMIT_REACHED_CODE,
+		Message:    constant.DELEGATED_TOKEN_LIMIT_REACHED_MSG,
+		StatusCode: http.StatusConflict,
+	}
+
+	// ErrDelegatedTokenAlreadyRevoked is returned when revoking an inactive token
+	ErrDelegatedTokenAlreadyRevoked = &commonerrors.AppError{
+		Code:       constant.DELEGATED_TOKEN_ALREADY_REVOKED_CODE,
+		Message:    constant.DELEGATED_TOKEN_ALREADY_REVOKED_MSG,
+		StatusCode: http.StatusConflict,
+	}
+
+	// ErrDelegatedTokenNotAllowed is returned when a delegated session tries to mint
+	// or revoke tokens
+	ErrDelegatedTokenNotAllowed = &commonerrors.AppError{
+		Code:       constant.DELEGATED_TOKEN_NOT_ALLOWED_CODE,
+		Message:    constant.DELEGATED_TOKEN_NOT_ALLOWED_MSG,
+		StatusCode: http.StatusForbidden,
+	}
+)
+
+func init() {
+	commonerrors.Register(
+		ErrDelegatedTokenNotFound,
+		ErrDelegatedTokenInvalidScope,
+		ErrDelegatedTokenLimitReached,
+		ErrDelegatedTokenAlreadyRevoked,
+		ErrDelegatedTokenNotAllowed,
+	)
+}
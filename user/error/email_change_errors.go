@@ -0,0 +1,48 @@
+package error
+
+import (
+	"net/http"
+
+	commonerrors "ecommerce-be/common/error"
+	"ecommerce-be/user/utils/constant"
+)
+
+var (
+	// ErrEmailChangeNotFound is returned when the user has no open email change
+	ErrEmailChangeNotFound = &commonerrors.AppError{
+		Code:       constant.EMAIL_CHANGE_NOT_FOUND_CODE,
+		Message:    constant.EMAIL_CHANGE_NOT_FOUND_MSG,
+		StatusCode: http.StatusNotFound,
+	}
+
+	// ErrEmailChangeInvalidToken is returned for unknown, expired, cancelled or
+	// already completed confirmation links
+	ErrEmailChangeInvalidToken = &commonerrors.AppError{
+		Code:       constant.EMAIL_CHANGE_INVALID_TOKEN_CODE,
+		Message:    constant.EMAIL_CHANGE_INVALID_TOKEN_MSG,
+		StatusCode: http.StatusBadRequest,
+	}
+
+	// ErrEmailChangeSameEmail is returned when the new email equals the current one
+	ErrEmailChangeSameEmail = &commonerrors.AppError{
+		Code:       constant.EMAIL_CHANGE_SAME_EMAIL_CODE,
+		Message:    constant.EMAIL_CHANGE_SAME_EMAIL_MSG,
+		StatusCode: http.StatusBadRequest,
+	}
+
+	// ErrEmailChangeEmailTaken is returned when another account already uses the email
+	ErrEmailChangeEmailTaken = &commonerrors.AppError{
+		Code:       constant.EMAIL_CHANGE_EMAIL_TAKEN_CODE,
+		Message:    constant.EMAIL_CHANGE_EMAIL_TAKEN_MSG,
+		StatusCode: http.StatusConflict,
+	}
+)
+
+func init() {
+	commonerrors.Register(
+		ErrEmailChangeNotFound,
+		ErrEmailChangeInvalidToken,
+		ErrEmailChangeSameEmail,
+		ErrEmailChangeEmailTaken,
+	)
+}
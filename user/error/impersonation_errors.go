@@ -0,0 +1,48 @@
+package error
+
+import (
+	"net/http"
+
+	commonerrors "ecommerce-be/common/error"
+	"ecommerce-be/user/utils/constant"
+)
+
+var (
+	// ErrImpersonationNotFound is returned when the session does not exist
+	ErrImpersonationNotFound = &commonerrors.AppError{
+		Code:       constant.IMPERSONATION_NOT_FOUND_CODE,
+		Message:    constant.IMPERSONATION_NOT_FOUND_MSG,
+		StatusCode: http.StatusNotFound,
+	}
+
+	// ErrImpersonationSellerNotFound is returned when the target is not an active seller
+	ErrImpersonationSellerNotFound = &commonerrors.AppError{
+		Code:       constant.IMPERSONATION_SELLER_NOT_FOUND_CODE,
+		Message:    constant.IMPERSONATION_SELLER_NOT_FOUND_MSG,
+		StatusCode: http.StatusNotFound,
+	}
+
+	// ErrImpersonationAlreadyEnded is returned when ending an inactive session
+	ErrImpersonationAlreadyEnded = &commonerrors.AppError{
+		Code:       constant.IMPERSONATION_ALREADY_ENDED_CODE,
+		Message:    constant.IMPERSONATION_ALREADY_ENDED_MSG,
+		StatusCode: http.StatusConflict,
+	}
+
+	// ErrImpersonationNotActive is returned when a regular session calls the
+	// end-impersonation endpoint
+	ErrImpersonationNotActive = &commonerrors.AppError{
+		Code:       constant.IMPERSONATION_NOT_ACTIVE_CODE,
+		Message:    constant.IMPERSONATION_NOT_ACTIVE_MSG,
+		StatusCode: http.StatusBadRequest,
+	}
+)
+
+func init() {
+	commonerrors.Register(
+		ErrImpersonationNotFound,
+		ErrImpersonationSellerNotFound,
+		ErrImpersonationAlreadyEnded,
+		ErrImpersonationNotActive,
+	)
+}
@@ -0,0 +1,59 @@
+package error
+
+import (
+	"net/http"
+
+	commonerrors "ecommerce-be/common/error"
+	"ecommerce-be/user/utils/constant"
+)
+
+// ========================================
+// PROFILE MEDIA ERRORS (avatars, store logos)
+// ========================================
+var (
+	// ErrInvalidMediaFormat is returned when the image MIME type is not allowed
+	ErrInvalidMediaFormat = &commonerrors.AppError{
+		Code:       constant.INVALID_MEDIA_FORMAT_CODE,
+		Message:    constant.INVALID_MEDIA_FORMAT_MSG,
+		StatusCode: http.StatusUnprocessableEntity,
+	}
+
+	// ErrMediaTooLarge is returned when the image exceeds the size limit for its purpose
+	ErrMediaTooLarge = &commonerrors.AppError{
+		Code:       constant.MEDIA_TOO_LARGE_CODE,
+		Message:    constant.MEDIA_TOO_LARGE_MSG,
+		StatusCode: http.StatusRequestEntityTooLarge,
+	}
+
+	// ErrInvalidMediaCrop is returned when the crop rectangle is not usable
+	ErrInvalidMediaCrop = &commonerrors.AppError{
+		Code:       constant.INVALID_MEDIA_CROP_CODE,
+		Message:    constant.INVALID_MEDIA_CROP_MSG,
+		StatusCode: http.StatusBadRequest,
+	}
+
+	// ErrInvalidAvatarFile is returned when the avatar file is missing, inaccessible or
+	// was uploaded for another purpose
+	ErrInvalidAvatarFile = &commonerrors.AppError{
+		Code:       constant.INVALID_AVATAR_FILE_CODE,
+		Message:    constant.INVALID_AVATAR_FILE_MSG,
+		StatusCode: http.StatusUnprocessableEntity,
+	}
+
+	// ErrAvatarNotSet is returned when removing an avatar that does not exist
+	ErrAvatarNotSet = &commonerrors.AppError{
+		Code:       constant.AVATAR_NOT_SET_CODE,
+		Message:    constant.AVATAR_NOT_SET_MSG,
+		StatusCode: http.StatusNotFound,
+	}
+)
+
+func init() {
+	commonerrors.Register(
+		ErrInvalidMediaFormat,
+		ErrMediaTooLarge,
+		ErrInvalidMediaCrop,
+		ErrInvalidAvatarFile,
+		ErrAvatarNotSet,
+	)
+}
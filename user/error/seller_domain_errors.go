@@ -0,0 +1,39 @@
+package error
+
+import (
+	"net/http"
+
+	commonerrors "ecommerce-be/common/error"
+	"ecommerce-be/user/utils/constant"
+)
+
+var (
+	// ErrSellerDomainNotFound is returned when the seller has no such domain mapping
+	ErrSellerDomainNotFound = &commonerrors.AppError{
+		Code:       constant.SELLER_DOMAIN_NOT_FOUND_CODE,
+		Message:    constant.SELLER_DOMAIN_NOT_FOUND_MSG,
+		StatusCode: http.StatusNotFound,
+	}
+
+	// ErrSellerDomainTaken is returned when the domain is mapped to any seller already
+	ErrSellerDomainTaken = &commonerrors.AppError{
+		Code:       constant.SELLER_DOMAIN_TAKEN_CODE,
+		Message:    constant.SELLER_DOMAIN_TAKEN_MSG,
+		StatusCode: http.StatusConflict,
+	}
+
+	// ErrSellerDomainSellerNotFound is returned when the target user is not a seller
+	ErrSellerDomainSellerNotFound = &commonerrors.AppError{
+		Code:       constant.SELLER_DOMAIN_SELLER_NOT_FOUND_CODE,
+		Message:    constant.SELLER_DOMAIN_SELLER_NOT_FOUND_MSG,
+		StatusCode: http.StatusNotFound,
+	}
+)
+
+func init() {
+	commonerrors.Register(
+		ErrSellerDomainNotFound,
+		ErrSellerDomainTaken,
+		ErrSellerDomainSellerNotFound,
+	)
+}
@@ -66,6 +66,13 @@ var (
 		Message:    constant.TOKEN_GENERATION_FAILED_MSG,
 		StatusCode: http.StatusInternalServerError,
 	}
+
+	// ErrDataKeyProvisionFailed is returned when the seller's encryption key cannot be created
+	ErrDataKeyProvisionFailed = &commonerrors.AppError{
+		Code:       constant.DATA_KEY_PROVISION_FAILED_CODE,
+		Message:    constant.DATA_KEY_PROVISION_FAILED_MSG,
+		StatusCode: http.StatusInternalServerError,
+	}
 )
 
 // ========================================
@@ -99,3 +106,21 @@ var (
 		StatusCode: http.StatusUnprocessableEntity,
 	}
 )
+
+func init() {
+	commonerrors.Register(
+		ErrEmailAlreadyExists,
+		ErrTaxIDAlreadyExists,
+		ErrTaxIDCheckFailed,
+		ErrUserCreateFailed,
+		ErrSellerIDUpdateFailed,
+		ErrProfileCreateFailed,
+		ErrSettingsCreateFailed,
+		ErrTokenGenerationFailed,
+		ErrDataKeyProvisionFailed,
+		ErrSellerProfileNotFound,
+		ErrSellerProfileExists,
+		ErrProfileUpdateFailed,
+		ErrInvalidBusinessLogoFile,
+	)
+}
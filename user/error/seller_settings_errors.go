@@ -22,3 +22,10 @@ var (
 		StatusCode: http.StatusConflict,
 	}
 )
+
+func init() {
+	commonerrors.Register(
+		ErrSellerSettingsNotFound,
+		ErrSellerSettingsExists,
+	)
+}
@@ -0,0 +1,31 @@
+package error
+
+import (
+	"net/http"
+
+	commonerrors "ecommerce-be/common/error"
+	"ecommerce-be/user/utils/constant"
+)
+
+var (
+	// ErrStorefrontNotFound is returned when the seller has no storefront settings
+	ErrStorefrontNotFound = &commonerrors.AppError{
+		Code:       constant.STOREFRONT_NOT_FOUND_CODE,
+		Message:    constant.STOREFRONT_NOT_FOUND_MSG,
+		StatusCode: http.StatusNotFound,
+	}
+
+	// ErrStorefrontAlreadyExists is returned when creating settings a second time
+	ErrStorefrontAlreadyExists = &commonerrors.AppError{
+		Code:       constant.STOREFRONT_ALREADY_EXISTS_CODE,
+		Message:    constant.STOREFRONT_ALREADY_EXISTS_MSG,
+		StatusCode: http.StatusConflict,
+	}
+)
+
+func init() {
+	commonerrors.Register(
+		ErrStorefrontNotFound,
+		ErrStorefrontAlreadyExists,
+	)
+}
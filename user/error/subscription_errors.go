@@ -0,0 +1,57 @@
+package error
+
+import (
+	"net/http"
+
+	commonerrors "ecommerce-be/common/error"
+	"ecommerce-be/user/utils/constant"
+)
+
+var (
+	// ErrPlanNotFound is returned when the requested plan does not exist
+	ErrPlanNotFound = &commonerrors.AppError{
+		Code:       constant.PLAN_NOT_FOUND_CODE,
+		Message:    constant.PLAN_NOT_FOUND_MSG,
+		StatusCode: http.StatusNotFound,
+	}
+
+	// ErrSubscriptionNotFound is returned when the seller has never been subscribed
+	ErrSubscriptionNotFound = &commonerrors.AppError{
+		Code:       constant.SUBSCRIPTION_NOT_FOUND_CODE,
+		Message:    constant.SUBSCRIPTION_NOT_FOUND_MSG,
+		StatusCode: http.StatusNotFound,
+	}
+
+	// ErrSubscriptionSellerNotFound is returned when a plan is assigned to an account
+	// that is not a seller
+	ErrSubscriptionSellerNotFound = &commonerrors.AppError{
+		Code:       constant.SUBSCRIPTION_SELLER_NOT_FOUND_CODE,
+		Message:    constant.SUBSCRIPTION_SELLER_NOT_FOUND_MSG,
+		StatusCode: http.StatusNotFound,
+	}
+
+	// ErrSubscriptionWebhookUnauthorized is returned when a payment webhook's signature
+	// does not match its body
+	ErrSubscriptionWebhookUnauthorized = &commonerrors.AppError{
+		Code:       constant.SUBSCRIPTION_WEBHOOK_UNAUTHORIZED_CODE,
+		Message:    constant.SUBSCRIPTION_WEBHOOK_UNAUTHORIZED_MSG,
+		StatusCode: http.StatusUnauthorized,
+	}
+
+	// ErrSubscriptionWebhookNotConfigured is returned when no webhook secret is set
+	ErrSubscriptionWebhookNotConfigured = &commonerrors.AppError{
+		Code:       constant.SUBSCRIPTION_WEBHOOK_NOT_CONFIGURED_CODE,
+		Message:    constant.SUBSCRIPTION_WEBHOOK_NOT_CONFIGURED_MSG,
+		StatusCode: http.StatusServiceUnavailable,
+	}
+)
+
+func init() {
+	commonerrors.Register(
+		ErrPlanNotFound,
+		ErrSubscriptionNotFound,
+		ErrSubscriptionSellerNotFound,
+		ErrSubscriptionWebhookUnauthorized,
+		ErrSubscriptionWebhookNotConfigured,
+	)
+}
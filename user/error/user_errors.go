@@ -53,3 +53,14 @@ var (
 		StatusCode: http.StatusBadRequest,
 	}
 )
+
+func init() {
+	commonerrors.Register(
+		ErrPasswordMismatch,
+		ErrUserExists,
+		ErrUserNotFound,
+		ErrInvalidCredentials,
+		ErrAccountDeactivated,
+		ErrInvalidCurrentPassword,
+	)
+}
@@ -29,6 +29,8 @@ func BuildAddressEntity(userID uint, req model.AddressRequest) *entity.Address {
 		Latitude:  req.Latitude,
 		Longitude: req.Longitude,
 		IsDefault: req.IsDefault,
+
+		IsDefaultBilling: req.IsDefaultBilling,
 	}
 }
 
@@ -53,8 +55,10 @@ func UpdateAddressEntity(address *entity.Address, req model.AddressUpdateRequest
 	if req.ZipCode != nil {
 		address.ZipCode = *req.ZipCode
 	}
-	if req.CountryID != nil {
+	if req.CountryID != nil && *req.CountryID != address.CountryID {
 		address.CountryID = *req.CountryID
+		// Drop the loaded country so saving does not write its ID back
+		address.Country = entity.Country{}
 	}
 	if req.Latitude != nil {
 		address.Latitude = req.Latitude
@@ -65,6 +69,9 @@ func UpdateAddressEntity(address *entity.Address, req model.AddressUpdateRequest
 	if req.IsDefault != nil {
 		address.IsDefault = *req.IsDefault
 	}
+	if req.IsDefaultBilling != nil {
+		address.IsDefaultBilling = *req.IsDefaultBilling
+	}
 }
 
 // BuildAddressResponse converts an address entity to response model
@@ -81,6 +88,8 @@ func BuildAddressResponse(address *entity.Address) model.AddressResponse {
 		Latitude:  address.Latitude,
 		Longitude: address.Longitude,
 		IsDefault: address.IsDefault,
+
+		IsDefaultBilling: address.IsDefaultBilling,
 	}
 
 	// Include expanded country info if relationship is loaded
@@ -0,0 +1,82 @@
+package factory
+
+import (
+	"time"
+
+	"ecommerce-be/user/entity"
+	"ecommerce-be/user/model"
+	"ecommerce-be/user/utils/constant"
+)
+
+/***********************************************
+ *      Delegated Token Response Builders      *
+ ***********************************************/
+
+// BuildDelegatedTokenResponse converts a delegated token entity to its response model
+func BuildDelegatedTokenResponse(
+	token *entity.DelegatedAccessToken,
+	now time.Time,
+) model.DelegatedTokenResponse {
+	return model.DelegatedTokenResponse{
+		ID:          token.ID,
+		Name:        token.Name,
+		TokenPrefix: token.TokenPrefix,
+		Scopes:      append([]string{}, token.Scopes...),
+		Status:      DelegatedTokenStatus(token, now),
+		ExpiresAt:   token.ExpiresAt.UTC().Format(time.RFC3339),
+		LastUsedAt:  formatOptionalTime(token.LastUsedAt),
+		RevokedAt:   formatOptionalTime(token.RevokedAt),
+		CreatedAt:   token.CreatedAt.UTC().Format(time.RFC3339),
+	}
+}
+
+// BuildDelegatedTokenResponses converts a list of delegated token entities
+func BuildDelegatedTokenResponses(
+	tokens []entity.DelegatedAccessToken,
+	now time.Time,
+) []model.DelegatedTokenResponse {
+	responses := make([]model.DelegatedTokenResponse, 0, len(tokens))
+	for i := range tokens {
+		responses = append(responses, BuildDelegatedTokenResponse(&tokens[i], now))
+	}
+	return responses
+}
+
+// BuildDelegatedTokenAuditResponses converts audit entries to response models
+func BuildDelegatedTokenAuditResponses(
+	entries []entity.DelegatedAccessTokenAudit,
+) []model.DelegatedTokenAuditResponse {
+	responses := make([]model.DelegatedTokenAuditResponse, 0, len(entries))
+	for _, entry := range entries {
+		responses = append(responses, model.DelegatedTokenAuditResponse{
+			ID:          entry.ID,
+			Action:      entry.Action,
+			ActorUserID: entry.ActorUserID,
+			Method:      entry.Method,
+			Path:        entry.Path,
+			IPAddress:   entry.IPAddress,
+			CreatedAt:   entry.CreatedAt.UTC().Format(time.RFC3339),
+		})
+	}
+	return responses
+}
+
+// DelegatedTokenStatus derives the lifecycle status; revocation wins over expiry
+func DelegatedTokenStatus(token *entity.DelegatedAccessToken, now time.Time) string {
+	switch {
+	case token.IsRevoked():
+		return constant.DELEGATED_TOKEN_STATUS_REVOKED
+	case token.IsExpired(now):
+		return constant.DELEGATED_TOKEN_STATUS_EXPIRED
+	default:
+		return constant.DELEGATED_TOKEN_STATUS_ACTIVE
+	}
+}
+
+func formatOptionalTime(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	formatted := t.UTC().Format(time.RFC3339)
+	return &formatted
+}
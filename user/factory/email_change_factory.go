@@ -0,0 +1,46 @@
+package factory
+
+import (
+	"time"
+
+	"ecommerce-be/user/entity"
+	"ecommerce-be/user/model"
+	"ecommerce-be/user/utils/constant"
+)
+
+/***********************************************
+ *       Email Change Response Builders        *
+ ***********************************************/
+
+// BuildEmailChangeResponse converts an email change request to its response model
+func BuildEmailChangeResponse(
+	request *entity.EmailChangeRequest,
+	now time.Time,
+) model.EmailChangeResponse {
+	return model.EmailChangeResponse{
+		ID:             request.ID,
+		OldEmail:       request.OldEmail,
+		NewEmail:       request.NewEmail,
+		Status:         EmailChangeStatus(request, now),
+		OldConfirmedAt: formatOptionalTime(request.OldConfirmedAt),
+		NewConfirmedAt: formatOptionalTime(request.NewConfirmedAt),
+		ExpiresAt:      request.ExpiresAt.UTC().Format(time.RFC3339),
+		CompletedAt:    formatOptionalTime(request.CompletedAt),
+		CreatedAt:      request.CreatedAt.UTC().Format(time.RFC3339),
+	}
+}
+
+// EmailChangeStatus derives the lifecycle status; completion and cancellation win
+// over expiry
+func EmailChangeStatus(request *entity.EmailChangeRequest, now time.Time) string {
+	switch {
+	case request.CompletedAt != nil:
+		return constant.EMAIL_CHANGE_STATUS_COMPLETED
+	case request.CancelledAt != nil:
+		return constant.EMAIL_CHANGE_STATUS_CANCELLED
+	case request.IsExpired(now):
+		return constant.EMAIL_CHANGE_STATUS_EXPIRED
+	default:
+		return constant.EMAIL_CHANGE_STATUS_PENDING
+	}
+}
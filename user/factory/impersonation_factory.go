@@ -0,0 +1,61 @@
+package factory
+
+import (
+	"time"
+
+	"ecommerce-be/user/entity"
+	"ecommerce-be/user/model"
+	"ecommerce-be/user/utils/constant"
+)
+
+/***********************************************
+ *      Impersonation Response Builders        *
+ ***********************************************/
+
+// BuildImpersonationSessionResponse converts an impersonation session to its response model
+func BuildImpersonationSessionResponse(
+	session *entity.ImpersonationSession,
+	now time.Time,
+) model.ImpersonationSessionResponse {
+	return model.ImpersonationSessionResponse{
+		ID:          session.ID,
+		AdminUserID: session.AdminUserID,
+		SellerID:    session.SellerID,
+		Reason:      session.Reason,
+		Status:      ImpersonationStatus(session, now),
+		ExpiresAt:   session.ExpiresAt.UTC().Format(time.RFC3339),
+		EndedAt:     formatOptionalTime(session.EndedAt),
+		CreatedAt:   session.CreatedAt.UTC().Format(time.RFC3339),
+	}
+}
+
+// BuildImpersonationAuditResponses converts audit entries to response models
+func BuildImpersonationAuditResponses(
+	entries []entity.ImpersonationAudit,
+) []model.ImpersonationAuditResponse {
+	responses := make([]model.ImpersonationAuditResponse, 0, len(entries))
+	for _, entry := range entries {
+		responses = append(responses, model.ImpersonationAuditResponse{
+			ID:          entry.ID,
+			Action:      entry.Action,
+			ActorUserID: entry.ActorUserID,
+			Method:      entry.Method,
+			Path:        entry.Path,
+			IPAddress:   entry.IPAddress,
+			CreatedAt:   entry.CreatedAt.UTC().Format(time.RFC3339),
+		})
+	}
+	return responses
+}
+
+// ImpersonationStatus derives the lifecycle status; ending wins over expiry
+func ImpersonationStatus(session *entity.ImpersonationSession, now time.Time) string {
+	switch {
+	case session.IsEnded():
+		return constant.IMPERSONATION_STATUS_ENDED
+	case session.IsExpired(now):
+		return constant.IMPERSONATION_STATUS_EXPIRED
+	default:
+		return constant.IMPERSONATION_STATUS_ACTIVE
+	}
+}
@@ -0,0 +1,35 @@
+package factory
+
+import (
+	"ecommerce-be/common/filegateway"
+	"ecommerce-be/user/entity"
+)
+
+/***********************************************
+ *      Profile Media Builders                 *
+ ***********************************************/
+
+// BuildImageCropEntity converts a request crop into its stored form
+func BuildImageCropEntity(crop *filegateway.ImageCrop) *entity.ImageCrop {
+	if crop == nil {
+		return nil
+	}
+	return &entity.ImageCrop{X: crop.X, Y: crop.Y, Width: crop.Width, Height: crop.Height}
+}
+
+// BuildMediaAssetResponse attaches the stored crop to a resolved file asset
+func BuildMediaAssetResponse(
+	asset *filegateway.FileAssetResponse,
+	crop *entity.ImageCrop,
+) *filegateway.FileAssetResponse {
+	if asset == nil || crop == nil {
+		return asset
+	}
+	asset.Crop = &filegateway.ImageCrop{
+		X:      crop.X,
+		Y:      crop.Y,
+		Width:  crop.Width,
+		Height: crop.Height,
+	}
+	return asset
+}
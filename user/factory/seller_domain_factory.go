@@ -0,0 +1,31 @@
+package factory
+
+import (
+	"time"
+
+	"ecommerce-be/user/entity"
+	"ecommerce-be/user/model"
+)
+
+/***********************************************
+ *      Seller Domain Response Builders        *
+ ***********************************************/
+
+// BuildSellerDomainResponse converts a domain mapping to its response model
+func BuildSellerDomainResponse(domain *entity.SellerDomain) model.SellerDomainResponse {
+	return model.SellerDomainResponse{
+		ID:        domain.ID,
+		SellerID:  domain.SellerID,
+		Domain:    domain.Domain,
+		CreatedAt: domain.CreatedAt.UTC().Format(time.RFC3339),
+	}
+}
+
+// BuildSellerDomainResponses converts domain mappings to response models
+func BuildSellerDomainResponses(domains []entity.SellerDomain) []model.SellerDomainResponse {
+	responses := make([]model.SellerDomainResponse, 0, len(domains))
+	for i := range domains {
+		responses = append(responses, BuildSellerDomainResponse(&domains[i]))
+	}
+	return responses
+}
@@ -3,6 +3,7 @@ package factory
 import (
 	"time"
 
+	"ecommerce-be/common/constants"
 	"ecommerce-be/common/filegateway"
 	"ecommerce-be/user/entity"
 	"ecommerce-be/user/model"
@@ -101,6 +102,8 @@ func BuildSellerSettingsResponse(settings *entity.SellerSettings) *model.SellerS
 		BaseCurrencyID:               settings.BaseCurrencyID,
 		SettlementCurrencyID:         settings.SettlementCurrencyID,
 		DisplayPricesInBuyerCurrency: settings.DisplayPricesInBuyerCurrency,
+		InventoryCostingMethod:       settings.InventoryCostingMethod,
+		CancellationWindowMinutes:    settings.CancellationWindowMinutes,
 		CreatedAt:                    settings.CreatedAt.Format(time.RFC3339),
 		UpdatedAt:                    settings.UpdatedAt.Format(time.RFC3339),
 	}
@@ -141,6 +144,7 @@ func BuildSellerSettingsEntity(
 		BusinessCountryID:            req.BusinessCountryID,
 		BaseCurrencyID:               req.BaseCurrencyID,
 		DisplayPricesInBuyerCurrency: false,
+		InventoryCostingMethod:       constants.INVENTORY_COSTING_FIFO,
 	}
 	settings.CreatedAt = now
 	settings.UpdatedAt = now
@@ -155,5 +159,13 @@ func BuildSellerSettingsEntity(
 		settings.DisplayPricesInBuyerCurrency = *req.DisplayPricesInBuyerCurrency
 	}
 
+	if req.InventoryCostingMethod != nil {
+		settings.InventoryCostingMethod = *req.InventoryCostingMethod
+	}
+
+	if req.CancellationWindowMinutes != nil {
+		settings.CancellationWindowMinutes = *req.CancellationWindowMinutes
+	}
+
 	return settings
 }
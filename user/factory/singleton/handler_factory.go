@@ -18,6 +18,13 @@ type HandlerFactory struct {
 	countryCurrencyHandler *handler.CountryCurrencyHandler
 	sellerHandler          *handler.SellerHandler
 	sellerSettingsHandler  *handler.SellerSettingsHandler
+	delegatedTokenHandler  *handler.DelegatedTokenHandler
+	profileMediaHandler    *handler.ProfileMediaHandler
+	emailChangeHandler     *handler.EmailChangeHandler
+	subscriptionHandler    *handler.SubscriptionHandler
+	impersonationHandler   *handler.ImpersonationHandler
+	storefrontHandler      *handler.StorefrontHandler
+	sellerDomainHandler    *handler.SellerDomainHandler
 
 	once sync.Once
 }
@@ -53,6 +60,27 @@ func (f *HandlerFactory) initialize() {
 		f.sellerSettingsHandler = handler.NewSellerSettingsHandler(
 			f.serviceFactory.GetSellerSettingsService(),
 		)
+		f.delegatedTokenHandler = handler.NewDelegatedTokenHandler(
+			f.serviceFactory.GetDelegatedTokenService(),
+		)
+		f.profileMediaHandler = handler.NewProfileMediaHandler(
+			f.serviceFactory.GetProfileMediaService(),
+		)
+		f.emailChangeHandler = handler.NewEmailChangeHandler(
+			f.serviceFactory.GetEmailChangeService(),
+		)
+		f.subscriptionHandler = handler.NewSubscriptionHandler(
+			f.serviceFactory.GetSubscriptionService(),
+		)
+		f.impersonationHandler = handler.NewImpersonationHandler(
+			f.serviceFactory.GetImpersonationService(),
+		)
+		f.storefrontHandler = handler.NewStorefrontHandler(
+			f.serviceFactory.GetStorefrontService(),
+		)
+		f.sellerDomainHandler = handler.NewSellerDomainHandler(
+			f.serviceFactory.GetSellerDomainService(),
+		)
 	})
 }
 
@@ -103,3 +131,45 @@ func (f *HandlerFactory) GetSellerSettingsHandler() *handler.SellerSettingsHandl
 	f.initialize()
 	return f.sellerSettingsHandler
 }
+
+// GetDelegatedTokenHandler returns the singleton delegated token handler
+func (f *HandlerFactory) GetDelegatedTokenHandler() *handler.DelegatedTokenHandler {
+	f.initialize()
+	return f.delegatedTokenHandler
+}
+
+// GetProfileMediaHandler returns the singleton profile media handler
+func (f *HandlerFactory) GetProfileMediaHandler() *handler.ProfileMediaHandler {
+	f.initialize()
+	return f.profileMediaHandler
+}
+
+// GetEmailChangeHandler returns the singleton email change handler
+func (f *HandlerFactory) GetEmailChangeHandler() *handler.EmailChangeHandler {
+	f.initialize()
+	return f.emailChangeHandler
+}
+
+// GetSubscriptionHandler returns the singleton subscription handler
+func (f *HandlerFactory) GetSubscriptionHandler() *handler.SubscriptionHandler {
+	f.initialize()
+	return f.subscriptionHandler
+}
+
+// GetImpersonationHandler returns the singleton impersonation handler
+func (f *HandlerFactory) GetImpersonationHandler() *handler.ImpersonationHandler {
+	f.initialize()
+	return f.impersonationHandler
+}
+
+// GetStorefrontHandler returns the singleton storefront handler
+func (f *HandlerFactory) GetStorefrontHandler() *handler.StorefrontHandler {
+	f.initialize()
+	return f.storefrontHandler
+}
+
+// GetSellerDomainHandler returns the singleton seller domain handler
+func (f *HandlerFactory) GetSellerDomainHandler() *handler.SellerDomainHandler {
+	f.initialize()
+	return f.sellerDomainHandler
+}
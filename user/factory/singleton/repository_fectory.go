@@ -18,6 +18,13 @@ type RepositoryFactory struct {
 	countryCurrencyRepo repository.CountryCurrencyRepository
 	sellerProfileRepo   repository.SellerProfileRepository
 	sellerSettingsRepo  repository.SellerSettingsRepository
+	delegatedTokenRepo  repository.DelegatedAccessTokenRepository
+	emailChangeRepo     repository.EmailChangeRequestRepository
+	planRepo            repository.PlanRepository
+	subscriptionRepo    repository.SubscriptionRepository
+	impersonationRepo   repository.ImpersonationSessionRepository
+	storefrontRepo      repository.StorefrontSettingsRepository
+	sellerDomainRepo    repository.SellerDomainRepository
 	once                sync.Once
 }
 
@@ -38,6 +45,13 @@ func (f *RepositoryFactory) initialize() {
 		f.countryCurrencyRepo = repository.NewCountryCurrencyRepository()
 		f.sellerProfileRepo = repository.NewSellerProfileRepository()
 		f.sellerSettingsRepo = repository.NewSellerSettingsRepository()
+		f.delegatedTokenRepo = repository.NewDelegatedAccessTokenRepository()
+		f.emailChangeRepo = repository.NewEmailChangeRequestRepository()
+		f.planRepo = repository.NewPlanRepository()
+		f.subscriptionRepo = repository.NewSubscriptionRepository()
+		f.impersonationRepo = repository.NewImpersonationSessionRepository()
+		f.storefrontRepo = repository.NewStorefrontSettingsRepository()
+		f.sellerDomainRepo = repository.NewSellerDomainRepository()
 	})
 }
 
@@ -82,3 +96,45 @@ func (f *RepositoryFactory) GetSellerSettingsRepository() repository.SellerSetti
 	f.initialize()
 	return f.sellerSettingsRepo
 }
+
+// GetDelegatedAccessTokenRepository returns the singleton delegated access token repository
+func (f *RepositoryFactory) GetDelegatedAccessTokenRepository() repository.DelegatedAccessTokenRepository {
+	f.initialize()
+	return f.delegatedTokenRepo
+}
+
+// GetEmailChangeRequestRepository returns the singleton email change request repository
+func (f *RepositoryFactory) GetEmailChangeRequestRepository() repository.EmailChangeRequestRepository {
+	f.initialize()
+	return f.emailChangeRepo
+}
+
+// GetPlanRepository returns the singleton plan repository
+func (f *RepositoryFactory) GetPlanRepository() repository.PlanRepository {
+	f.initialize()
+	return f.planRepo
+}
+
+// GetSubscriptionRepository returns the singleton subscription repository
+func (f *RepositoryFactory) GetSubscriptionRepository() repository.SubscriptionRepository {
+	f.initialize()
+	return f.subscriptionRepo
+}
+
+// GetImpersonationSessionRepository returns the singleton impersonation session repository
+func (f *RepositoryFactory) GetImpersonationSessionRepository() repository.ImpersonationSessionRepository {
+	f.initialize()
+	return f.impersonationRepo
+}
+
+// GetStorefrontSettingsRepository returns the singleton storefront settings repository
+func (f *RepositoryFactory) GetStorefrontSettingsRepository() repository.StorefrontSettingsRepository {
+	f.initialize()
+	return f.storefrontRepo
+}
+
+// GetSellerDomainRepository returns the singleton seller domain repository
+func (f *RepositoryFactory) GetSellerDomainRepository() repository.SellerDomainRepository {
+	f.initialize()
+	return f.sellerDomainRepo
+}
@@ -3,6 +3,10 @@ package singleton
 import (
 	"sync"
 
+	"ecommerce-be/common/config"
+	"ecommerce-be/common/encryption"
+	"ecommerce-be/common/notifier"
+	"ecommerce-be/common/screening"
 	fileSingleton "ecommerce-be/file/factory/singleton"
 	filegw "ecommerce-be/file/gateway"
 	"ecommerce-be/user/service"
@@ -21,6 +25,13 @@ type ServiceFactory struct {
 	sellerSettingsService  service.SellerSettingsService
 	sellerService          service.SellerService
 	sellerProfileService   service.SellerProfileService
+	delegatedTokenService  service.DelegatedTokenService
+	profileMediaService    service.ProfileMediaService
+	emailChangeService     service.EmailChangeService
+	subscriptionService    service.SubscriptionService
+	impersonationService   service.ImpersonationService
+	storefrontService      service.StorefrontService
+	sellerDomainService    service.SellerDomainService
 
 	once sync.Once
 }
@@ -40,12 +51,23 @@ func (f *ServiceFactory) initialize() {
 		countryCurrencyRepo := f.repoFactory.GetCountryCurrencyRepository()
 		sellerProfileRepo := f.repoFactory.GetSellerProfileRepository()
 		sellerSettingsRepo := f.repoFactory.GetSellerSettingsRepository()
-
-		displayFileGateway := filegw.NewDisplayGateway(
-			fileSingleton.GetInstance().GetFileReadService(),
+		delegatedTokenRepo := f.repoFactory.GetDelegatedAccessTokenRepository()
+		emailChangeRepo := f.repoFactory.GetEmailChangeRequestRepository()
+		planRepo := f.repoFactory.GetPlanRepository()
+		subscriptionRepo := f.repoFactory.GetSubscriptionRepository()
+		impersonationRepo := f.repoFactory.GetImpersonationSessionRepository()
+		storefrontRepo := f.repoFactory.GetStorefrontSettingsRepository()
+		sellerDomainRepo := f.repoFactory.GetSellerDomainRepository()
+
+		fileFactory := fileSingleton.GetInstance()
+		displayFileGateway := filegw.NewDisplayGateway(fileFactory.GetFileReadService())
+		uploadFileGateway := filegw.NewUploadGateway(
+			fileFactory.GetFileUploadService(),
+			fileFactory.GetFileReadService(),
+			fileFactory.GetFileDeleteService(),
 		)
 
-		f.addressService = service.NewAddressService(addressRepo)
+		f.addressService = service.NewAddressService(addressRepo, countryRepo)
 		f.userQueryService = service.NewUserQueryService(userRepo)
 		f.countryService = service.NewCountryService(countryRepo)
 		f.currencyService = service.NewCurrencyService(currencyRepo)
@@ -56,8 +78,10 @@ func (f *ServiceFactory) initialize() {
 		)
 		f.sellerSettingsService = service.NewSellerSettingsService(
 			sellerSettingsRepo,
+			sellerProfileRepo,
 			f.countryService,
 			f.currencyService,
+			displayFileGateway,
 		)
 
 		f.userService = service.NewUserService(
@@ -73,7 +97,10 @@ func (f *ServiceFactory) initialize() {
 			f.sellerSettingsService,
 			userRepo,
 			sellerProfileRepo,
+			countryRepo,
 			displayFileGateway,
+			screening.GetService(),
+			encryption.GetService(),
 		)
 		f.sellerProfileService = service.NewSellerProfileService(
 			userRepo,
@@ -81,9 +108,38 @@ func (f *ServiceFactory) initialize() {
 			f.sellerSettingsService,
 			displayFileGateway,
 		)
+		f.delegatedTokenService = service.NewDelegatedTokenService(delegatedTokenRepo)
+		f.profileMediaService = service.NewProfileMediaService(
+			userRepo,
+			sellerProfileRepo,
+			uploadFileGateway,
+		)
+		// The notification module depends on this one, so its notifier is bound late
+		f.emailChangeService = service.NewEmailChangeService(
+			emailChangeRepo,
+			userRepo,
+			notifier.Default(),
+		)
+		f.subscriptionService = service.NewSubscriptionService(
+			subscriptionRepo,
+			planRepo,
+			userRepo,
+			subscriptionGraceDays(),
+		)
+		f.impersonationService = service.NewImpersonationService(impersonationRepo, userRepo)
+		f.storefrontService = service.NewStorefrontService(storefrontRepo, currencyRepo)
+		f.sellerDomainService = service.NewSellerDomainService(sellerDomainRepo, userRepo)
 	})
 }
 
+// subscriptionGraceDays is how long past-due sellers keep access, from configuration
+func subscriptionGraceDays() int {
+	if cfg := config.Get(); cfg != nil {
+		return cfg.Subscription.GraceDays
+	}
+	return 3
+}
+
 func (f *ServiceFactory) GetUserService() service.UserService {
 	f.initialize()
 	return f.userService
@@ -128,3 +184,38 @@ func (f *ServiceFactory) GetSellerProfileService() service.SellerProfileService
 	f.initialize()
 	return f.sellerProfileService
 }
+
+func (f *ServiceFactory) GetDelegatedTokenService() service.DelegatedTokenService {
+	f.initialize()
+	return f.delegatedTokenService
+}
+
+func (f *ServiceFactory) GetProfileMediaService() service.ProfileMediaService {
+	f.initialize()
+	return f.profileMediaService
+}
+
+func (f *ServiceFactory) GetEmailChangeService() service.EmailChangeService {
+	f.initialize()
+	return f.emailChangeService
+}
+
+func (f *ServiceFactory) GetSubscriptionService() service.SubscriptionService {
+	f.initialize()
+	return f.subscriptionService
+}
+
+func (f *ServiceFactory) GetImpersonationService() service.ImpersonationService {
+	f.initialize()
+	return f.impersonationService
+}
+
+func (f *ServiceFactory) GetStorefrontService() service.StorefrontService {
+	f.initialize()
+	return f.storefrontService
+}
+
+func (f *ServiceFactory) GetSellerDomainService() service.SellerDomainService {
+	f.initialize()
+	return f.sellerDomainService
+}
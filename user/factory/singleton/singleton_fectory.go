@@ -81,6 +81,34 @@ func (f *SingletonFactory) GetSellerSettingsHandler() *handler.SellerSettingsHan
 	return f.handlerFactory.GetSellerSettingsHandler()
 }
 
+func (f *SingletonFactory) GetDelegatedTokenHandler() *handler.DelegatedTokenHandler {
+	return f.handlerFactory.GetDelegatedTokenHandler()
+}
+
+func (f *SingletonFactory) GetProfileMediaHandler() *handler.ProfileMediaHandler {
+	return f.handlerFactory.GetProfileMediaHandler()
+}
+
+func (f *SingletonFactory) GetEmailChangeHandler() *handler.EmailChangeHandler {
+	return f.handlerFactory.GetEmailChangeHandler()
+}
+
+func (f *SingletonFactory) GetSubscriptionHandler() *handler.SubscriptionHandler {
+	return f.handlerFactory.GetSubscriptionHandler()
+}
+
+func (f *SingletonFactory) GetImpersonationHandler() *handler.ImpersonationHandler {
+	return f.handlerFactory.GetImpersonationHandler()
+}
+
+func (f *SingletonFactory) GetStorefrontHandler() *handler.StorefrontHandler {
+	return f.handlerFactory.GetStorefrontHandler()
+}
+
+func (f *SingletonFactory) GetSellerDomainHandler() *handler.SellerDomainHandler {
+	return f.handlerFactory.GetSellerDomainHandler()
+}
+
 // ===============================
 // Service Getters (Delegates)
 // ===============================
@@ -109,6 +137,14 @@ func (f *SingletonFactory) GetCountryCurrencyService() service.CountryCurrencySe
 	return f.serviceFactory.GetCountryCurrencyService()
 }
 
+func (f *SingletonFactory) GetSellerSettingsService() service.SellerSettingsService {
+	return f.serviceFactory.GetSellerSettingsService()
+}
+
+func (f *SingletonFactory) GetDelegatedTokenService() service.DelegatedTokenService {
+	return f.serviceFactory.GetDelegatedTokenService()
+}
+
 // ===============================
 // Repository Getters (Delegates)
 // ===============================
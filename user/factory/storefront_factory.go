@@ -0,0 +1,49 @@
+package factory
+
+import (
+	"time"
+
+	"ecommerce-be/user/entity"
+	"ecommerce-be/user/model"
+)
+
+/***********************************************
+ *        Storefront Response Builders         *
+ ***********************************************/
+
+// BuildPublicStorefrontResponse converts storefront settings to the shopper-facing model
+func BuildPublicStorefrontResponse(
+	settings *entity.StorefrontSettings,
+) model.PublicStorefrontResponse {
+	socialLinks := make(map[string]string, len(settings.SocialLinks))
+	for network, url := range settings.SocialLinks {
+		socialLinks[network] = url
+	}
+	return model.PublicStorefrontResponse{
+		SellerID:  settings.SellerID,
+		StoreName: settings.StoreName,
+		LogoURL:   settings.LogoURL,
+		Theme: model.StorefrontThemeResponse{
+			PrimaryColor:   settings.PrimaryColor,
+			SecondaryColor: settings.SecondaryColor,
+			AccentColor:    settings.AccentColor,
+		},
+		Contact: model.StorefrontContactResponse{
+			Email:       settings.ContactEmail,
+			Phone:       settings.ContactPhone,
+			SocialLinks: socialLinks,
+		},
+		CurrencyCode: settings.CurrencyCode,
+		Timezone:     settings.Timezone,
+	}
+}
+
+// BuildStorefrontResponse converts storefront settings to the seller-facing model
+func BuildStorefrontResponse(settings *entity.StorefrontSettings) *model.StorefrontResponse {
+	return &model.StorefrontResponse{
+		PublicStorefrontResponse: BuildPublicStorefrontResponse(settings),
+		ID:                       settings.ID,
+		CreatedAt:                settings.CreatedAt.UTC().Format(time.RFC3339),
+		UpdatedAt:                settings.UpdatedAt.UTC().Format(time.RFC3339),
+	}
+}
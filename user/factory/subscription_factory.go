@@ -0,0 +1,79 @@
+package factory
+
+import (
+	"time"
+
+	"ecommerce-be/user/entity"
+	"ecommerce-be/user/model"
+	"ecommerce-be/user/utils"
+)
+
+/***********************************************
+ *       Subscription Response Builders        *
+ ***********************************************/
+
+// BuildPlanResponse converts a plan to its response model, listing every feature the
+// plan sets, included or not
+func BuildPlanResponse(plan *entity.Plan) model.PlanResponse {
+	features := make(map[string]bool, len(plan.Features))
+	for key := range plan.Features {
+		features[key] = plan.HasFeature(key)
+	}
+	return model.PlanResponse{
+		ID:           plan.ID,
+		Name:         plan.Name,
+		Description:  plan.Description,
+		Price:        plan.Price,
+		Currency:     plan.Currency,
+		BillingCycle: plan.BillingCycle,
+		TrialDays:    plan.TrialDays,
+		IsPopular:    plan.IsPopular,
+		MaxProducts:  plan.MaxProducts,
+		MaxVariants:  plan.MaxVariants,
+		Features:     features,
+	}
+}
+
+// BuildPlanResponses converts plans to their response models
+func BuildPlanResponses(plans []entity.Plan) []model.PlanResponse {
+	responses := make([]model.PlanResponse, 0, len(plans))
+	for i := range plans {
+		responses = append(responses, BuildPlanResponse(&plans[i]))
+	}
+	return responses
+}
+
+// BuildSellerSubscriptionResponse converts a subscription to its response model, with
+// the status it has at now
+func BuildSellerSubscriptionResponse(
+	subscription *entity.Subscription,
+	plan *entity.Plan,
+	usage model.PlanUsageResponse,
+	now time.Time,
+) *model.SellerSubscriptionResponse {
+	return &model.SellerSubscriptionResponse{
+		ID:           subscription.ID,
+		SellerID:     subscription.SellerID,
+		Status:       string(utils.EffectiveSubscriptionStatus(subscription, now)),
+		StartDate:    subscription.StartDate.UTC().Format(time.RFC3339),
+		EndDate:      subscription.EndDate.UTC().Format(time.RFC3339),
+		PastDueSince: formatOptionalTime(subscription.PastDueSince),
+		GraceEndsAt:  formatOptionalTime(subscription.GraceEndsAt),
+		Plan:         BuildPlanResponse(plan),
+		Usage:        usage,
+	}
+}
+
+// BuildSubscriptionPaymentWebhookResponse reports the subscription after a payment event
+func BuildSubscriptionPaymentWebhookResponse(
+	subscription *entity.Subscription,
+	duplicate bool,
+	now time.Time,
+) *model.SubscriptionPaymentWebhookResponse {
+	return &model.SubscriptionPaymentWebhookResponse{
+		SubscriptionID: subscription.ID,
+		Status:         string(utils.EffectiveSubscriptionStatus(subscription, now)),
+		EndDate:        subscription.EndDate.UTC().Format(time.RFC3339),
+		Duplicate:      duplicate,
+	}
+}
@@ -5,6 +5,9 @@ import (
 	"strconv"
 
 	"ecommerce-be/common"
+	"ecommerce-be/common/auth"
+	commonError "ecommerce-be/common/error"
+	"ecommerce-be/common/handler"
 	"ecommerce-be/user/model"
 	"ecommerce-be/user/service"
 	"ecommerce-be/user/utils/constant"
@@ -14,12 +17,14 @@ import (
 
 // AddressHandler handles HTTP requests related to addresses
 type AddressHandler struct {
+	*handler.BaseHandler
 	addressService service.AddressService
 }
 
 // NewAddressHandler creates a new instance of AddressHandler
 func NewAddressHandler(addressService service.AddressService) *AddressHandler {
 	return &AddressHandler{
+		BaseHandler:    handler.NewBaseHandler(),
 		addressService: addressService,
 	}
 }
@@ -27,7 +32,7 @@ func NewAddressHandler(addressService service.AddressService) *AddressHandler {
 // GetAddresses handles retrieving all addresses for a user
 func (h *AddressHandler) GetAddresses(c *gin.Context) {
 	// Get user ID from context (set by auth middleware)
-	userID, exists := c.Get(constant.USER_ID_KEY)
+	userID, exists := auth.GetUserIDFromContext(c)
 	if !exists {
 		common.ErrorWithCode(
 			c,
@@ -39,7 +44,7 @@ func (h *AddressHandler) GetAddresses(c *gin.Context) {
 	}
 
 	// Get addresses
-	addresses, err := h.addressService.GetAddresses(c, userID.(uint))
+	addresses, err := h.addressService.GetAddresses(c, userID)
 	if err != nil {
 		common.ErrorResp(
 			c,
@@ -62,7 +67,7 @@ func (h *AddressHandler) GetAddresses(c *gin.Context) {
 // GetAddressByID handles retrieving a specific address by ID
 func (h *AddressHandler) GetAddressByID(c *gin.Context) {
 	// Get user ID from context (set by auth middleware)
-	userID, exists := c.Get(constant.USER_ID_KEY)
+	userID, exists := auth.GetUserIDFromContext(c)
 	if !exists {
 		common.ErrorWithCode(
 			c,
@@ -86,7 +91,7 @@ func (h *AddressHandler) GetAddressByID(c *gin.Context) {
 	}
 
 	// Get address
-	address, err := h.addressService.GetAddressByID(c, addressID, userID.(uint))
+	address, err := h.addressService.GetAddressByID(c, addressID, userID)
 	if err != nil {
 		if err.Error() == constant.ADDRESS_NOT_FOUND_MSG {
 			common.ErrorWithCode(
@@ -113,7 +118,7 @@ func (h *AddressHandler) GetAddressByID(c *gin.Context) {
 // AddAddress handles adding a new address
 func (h *AddressHandler) AddAddress(c *gin.Context) {
 	// Get user ID from context (set by auth middleware)
-	userID, exists := c.Get(constant.USER_ID_KEY)
+	userID, exists := auth.GetUserIDFromContext(c)
 	if !exists {
 		common.ErrorWithCode(
 			c,
@@ -125,30 +130,15 @@ func (h *AddressHandler) AddAddress(c *gin.Context) {
 	}
 
 	var req model.AddressRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		var validationErrors []common.ValidationError
-		validationErrors = append(validationErrors, common.ValidationError{
-			Field:   constant.REQUEST_FIELD_NAME,
-			Message: err.Error(),
-		})
-		common.ErrorWithValidation(
-			c,
-			http.StatusBadRequest,
-			constant.VALIDATION_FAILED_MSG,
-			validationErrors,
-			constant.VALIDATION_ERROR_CODE,
-		)
+	if err := h.BindJSON(c, &req); err != nil {
+		h.HandleValidationError(c, err)
 		return
 	}
 
 	// Add address
-	address, err := h.addressService.AddAddress(c, userID.(uint), req)
+	address, err := h.addressService.AddAddress(c, userID, req)
 	if err != nil {
-		common.ErrorResp(
-			c,
-			http.StatusInternalServerError,
-			constant.FAILED_TO_ADD_ADDRESS_MSG+": "+err.Error(),
-		)
+		h.HandleError(c, err, constant.FAILED_TO_ADD_ADDRESS_MSG)
 		return
 	}
 
@@ -165,7 +155,7 @@ func (h *AddressHandler) AddAddress(c *gin.Context) {
 // UpdateAddress handles updating an existing address
 func (h *AddressHandler) UpdateAddress(c *gin.Context) {
 	// Get user ID from context (set by auth middleware)
-	userID, exists := c.Get(constant.USER_ID_KEY)
+	userID, exists := auth.GetUserIDFromContext(c)
 	if !exists {
 		common.ErrorWithCode(
 			c,
@@ -189,24 +179,13 @@ func (h *AddressHandler) UpdateAddress(c *gin.Context) {
 	}
 
 	var req model.AddressUpdateRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		var validationErrors []common.ValidationError
-		validationErrors = append(validationErrors, common.ValidationError{
-			Field:   constant.REQUEST_FIELD_NAME,
-			Message: err.Error(),
-		})
-		common.ErrorWithValidation(
-			c,
-			http.StatusBadRequest,
-			constant.VALIDATION_FAILED_MSG,
-			validationErrors,
-			constant.VALIDATION_ERROR_CODE,
-		)
+	if err := h.BindJSON(c, &req); err != nil {
+		h.HandleValidationError(c, err)
 		return
 	}
 
 	// Update address
-	address, err := h.addressService.UpdateAddress(c, addressID, userID.(uint), req)
+	address, err := h.addressService.UpdateAddress(c, addressID, userID, req)
 	if err != nil {
 		if err.Error() == constant.ADDRESS_NOT_FOUND_MSG {
 			common.ErrorWithCode(
@@ -217,6 +196,10 @@ func (h *AddressHandler) UpdateAddress(c *gin.Context) {
 			)
 			return
 		}
+		if _, ok := commonError.AsAppError(err); ok {
+			h.HandleError(c, err, constant.FAILED_TO_UPDATE_ADDRESS_MSG)
+			return
+		}
 		common.ErrorWithCode(
 			c,
 			http.StatusForbidden,
@@ -234,7 +217,7 @@ func (h *AddressHandler) UpdateAddress(c *gin.Context) {
 // DeleteAddress handles deleting an address
 func (h *AddressHandler) DeleteAddress(c *gin.Context) {
 	// Get user ID from context (set by auth middleware)
-	userID, exists := c.Get(constant.USER_ID_KEY)
+	userID, exists := auth.GetUserIDFromContext(c)
 	if !exists {
 		common.ErrorWithCode(
 			c,
@@ -258,7 +241,7 @@ func (h *AddressHandler) DeleteAddress(c *gin.Context) {
 	}
 
 	// Delete address
-	err = h.addressService.DeleteAddress(c, addressID, userID.(uint))
+	err = h.addressService.DeleteAddress(c, addressID, userID)
 	if err != nil {
 		if err.Error() == constant.ADDRESS_NOT_FOUND_MSG {
 			common.ErrorWithCode(
@@ -292,7 +275,7 @@ func (h *AddressHandler) DeleteAddress(c *gin.Context) {
 // SetDefaultAddress handles setting an address as the default address
 func (h *AddressHandler) SetDefaultAddress(c *gin.Context) {
 	// Get user ID from context (set by auth middleware)
-	userID, exists := c.Get(constant.USER_ID_KEY)
+	userID, exists := auth.GetUserIDFromContext(c)
 	if !exists {
 		common.ErrorWithCode(
 			c,
@@ -316,7 +299,7 @@ func (h *AddressHandler) SetDefaultAddress(c *gin.Context) {
 	}
 
 	// Set default address
-	address, err := h.addressService.SetDefaultAddress(c, addressID, userID.(uint))
+	address, err := h.addressService.SetDefaultAddress(c, addressID, userID)
 	if err != nil {
 		if err.Error() == constant.ADDRESS_NOT_FOUND_MSG {
 			common.ErrorWithCode(
@@ -345,6 +328,62 @@ func (h *AddressHandler) SetDefaultAddress(c *gin.Context) {
 	)
 }
 
+// SetDefaultBillingAddress handles setting an address as the default billing address
+func (h *AddressHandler) SetDefaultBillingAddress(c *gin.Context) {
+	// Get user ID from context (set by auth middleware)
+	userID, exists := auth.GetUserIDFromContext(c)
+	if !exists {
+		common.ErrorWithCode(
+			c,
+			http.StatusUnauthorized,
+			constant.AUTHENTICATION_REQUIRED_MSG,
+			constant.AUTH_REQUIRED_CODE,
+		)
+		return
+	}
+
+	// Get address ID from path parameter
+	addressID, err := getAddressIDParam(c)
+	if err != nil {
+		common.ErrorWithCode(
+			c,
+			http.StatusBadRequest,
+			constant.INVALID_ADDRESS_ID_MSG,
+			constant.INVALID_ID_CODE,
+		)
+		return
+	}
+
+	// Set default billing address
+	address, err := h.addressService.SetDefaultBillingAddress(c, addressID, userID)
+	if err != nil {
+		if err.Error() == constant.ADDRESS_NOT_FOUND_MSG {
+			common.ErrorWithCode(
+				c,
+				http.StatusNotFound,
+				err.Error(),
+				constant.ADDRESS_NOT_FOUND_CODE,
+			)
+			return
+		}
+		common.ErrorResp(
+			c,
+			http.StatusInternalServerError,
+			constant.FAILED_TO_SET_DEFAULT_ADDRESS_MSG+": "+err.Error(),
+		)
+		return
+	}
+
+	common.SuccessResponse(
+		c,
+		http.StatusOK,
+		constant.DEFAULT_BILLING_ADDRESS_UPDATED_MSG,
+		map[string]any{
+			constant.ADDRESS_FIELD_NAME: address,
+		},
+	)
+}
+
 // getAddressIDParam gets an address ID from a path parameter
 func getAddressIDParam(c *gin.Context) (uint, error) {
 	idParam := c.Param("id")
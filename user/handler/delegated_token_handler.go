@@ -0,0 +1,176 @@
+package handler
+
+import (
+	"net/http"
+
+	"ecommerce-be/common/auth"
+	commonError "ecommerce-be/common/error"
+	"ecommerce-be/common/handler"
+	userErrors "ecommerce-be/user/error"
+	"ecommerce-be/user/model"
+	"ecommerce-be/user/service"
+	"ecommerce-be/user/utils/constant"
+
+	"github.com/gin-gonic/gin"
+)
+
+// DelegatedTokenHandler handles HTTP requests for seller delegated access tokens.
+type DelegatedTokenHandler struct {
+	*handler.BaseHandler
+	delegatedTokenService service.DelegatedTokenService
+}
+
+// NewDelegatedTokenHandler creates a new DelegatedTokenHandler.
+func NewDelegatedTokenHandler(
+	delegatedTokenService service.DelegatedTokenService,
+) *DelegatedTokenHandler {
+	return &DelegatedTokenHandler{
+		BaseHandler:           handler.NewBaseHandler(),
+		delegatedTokenService: delegatedTokenService,
+	}
+}
+
+// CreateDelegatedToken handles POST /api/user/seller/delegated-tokens
+func (h *DelegatedTokenHandler) CreateDelegatedToken(c *gin.Context) {
+	sellerID, userID, ok := h.actorFromContext(c, constant.FAILED_TO_CREATE_DELEGATED_TOKEN_MSG)
+	if !ok {
+		return
+	}
+
+	var req model.CreateDelegatedTokenRequest
+	if err := h.BindJSON(c, &req); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	response, err := h.delegatedTokenService.Create(c, sellerID, userID, req)
+	if err != nil {
+		h.HandleError(c, err, constant.FAILED_TO_CREATE_DELEGATED_TOKEN_MSG)
+		return
+	}
+
+	h.SuccessWithData(
+		c,
+		http.StatusCreated,
+		constant.DELEGATED_TOKEN_CREATED_MSG,
+		constant.DELEGATED_TOKEN_FIELD_NAME,
+		response,
+	)
+}
+
+// ListDelegatedTokens handles GET /api/user/seller/delegated-tokens
+func (h *DelegatedTokenHandler) ListDelegatedTokens(c *gin.Context) {
+	sellerID, _, ok := h.actorFromContext(c, constant.FAILED_TO_LIST_DELEGATED_TOKENS_MSG)
+	if !ok {
+		return
+	}
+
+	var req model.ListDelegatedTokensRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	response, err := h.delegatedTokenService.List(c, sellerID, req)
+	if err != nil {
+		h.HandleError(c, err, constant.FAILED_TO_LIST_DELEGATED_TOKENS_MSG)
+		return
+	}
+
+	h.SuccessWithData(
+		c,
+		http.StatusOK,
+		constant.DELEGATED_TOKENS_RETRIEVED_MSG,
+		constant.DELEGATED_TOKENS_FIELD_NAME,
+		response,
+	)
+}
+
+// RevokeDelegatedToken handles DELETE /api/user/seller/delegated-tokens/:id
+func (h *DelegatedTokenHandler) RevokeDelegatedToken(c *gin.Context) {
+	sellerID, userID, ok := h.actorFromContext(c, constant.FAILED_TO_REVOKE_DELEGATED_TOKEN_MSG)
+	if !ok {
+		return
+	}
+
+	tokenID, err := h.ParseUintParam(c, "id")
+	if err != nil {
+		h.HandleError(c, err, constant.FAILED_TO_REVOKE_DELEGATED_TOKEN_MSG)
+		return
+	}
+
+	response, err := h.delegatedTokenService.Revoke(c, sellerID, userID, tokenID)
+	if err != nil {
+		h.HandleError(c, err, constant.FAILED_TO_REVOKE_DELEGATED_TOKEN_MSG)
+		return
+	}
+
+	h.SuccessWithData(
+		c,
+		http.StatusOK,
+		constant.DELEGATED_TOKEN_REVOKED_MSG,
+		constant.DELEGATED_TOKEN_FIELD_NAME,
+		response,
+	)
+}
+
+// GetDelegatedTokenAudit handles GET /api/user/seller/delegated-tokens/:id/audit
+func (h *DelegatedTokenHandler) GetDelegatedTokenAudit(c *gin.Context) {
+	sellerID, _, ok := h.actorFromContext(c, constant.FAILED_TO_GET_DELEGATED_TOKEN_AUDIT_MSG)
+	if !ok {
+		return
+	}
+
+	tokenID, err := h.ParseUintParam(c, "id")
+	if err != nil {
+		h.HandleError(c, err, constant.FAILED_TO_GET_DELEGATED_TOKEN_AUDIT_MSG)
+		return
+	}
+
+	var req model.DelegatedTokenAuditRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	response, err := h.delegatedTokenService.GetAuditLog(c, sellerID, tokenID, req)
+	if err != nil {
+		h.HandleError(c, err, constant.FAILED_TO_GET_DELEGATED_TOKEN_AUDIT_MSG)
+		return
+	}
+
+	h.SuccessWithData(
+		c,
+		http.StatusOK,
+		constant.DELEGATED_TOKEN_AUDIT_RETRIEVED_MSG,
+		constant.DELEGATED_TOKEN_AUDIT_FIELD_NAME,
+		response,
+	)
+}
+
+// actorFromContext returns the seller and acting user for token management.
+// Requests authenticated with a delegated token are rejected so a grant can
+// never be used to mint or revoke other grants.
+func (h *DelegatedTokenHandler) actorFromContext(
+	c *gin.Context,
+	failureMsg string,
+) (sellerID uint, userID uint, ok bool) {
+	if _, delegated := auth.GetDelegatedTokenIDFromContext(c); delegated {
+		h.HandleError(c, userErrors.ErrDelegatedTokenNotAllowed, failureMsg)
+		return 0, 0, false
+	}
+
+	sellerID, exists := auth.GetSellerIDFromContext(c)
+	if !exists || sellerID == 0 {
+		h.HandleError(c, commonError.UnauthorizedError, failureMsg)
+		return 0, 0, false
+	}
+
+	userID, exists = auth.GetUserIDFromContext(c)
+	if !exists {
+		h.HandleError(c, commonError.UnauthorizedError, failureMsg)
+		return 0, 0, false
+	}
+
+	return sellerID, userID, true
+}
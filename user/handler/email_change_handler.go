@@ -0,0 +1,120 @@
+package handler
+
+import (
+	"net/http"
+
+	"ecommerce-be/common/auth"
+	commonError "ecommerce-be/common/error"
+	"ecommerce-be/common/handler"
+	"ecommerce-be/user/model"
+	"ecommerce-be/user/service"
+	"ecommerce-be/user/utils/constant"
+
+	"github.com/gin-gonic/gin"
+)
+
+// EmailChangeHandler handles HTTP requests for the dual-confirmation email change.
+type EmailChangeHandler struct {
+	*handler.BaseHandler
+	emailChangeService service.EmailChangeService
+}
+
+// NewEmailChangeHandler creates a new EmailChangeHandler.
+func NewEmailChangeHandler(emailChangeService service.EmailChangeService) *EmailChangeHandler {
+	return &EmailChangeHandler{
+		BaseHandler:        handler.NewBaseHandler(),
+		emailChangeService: emailChangeService,
+	}
+}
+
+// RequestEmailChange handles POST /api/user/auth/email-change
+func (h *EmailChangeHandler) RequestEmailChange(c *gin.Context) {
+	userID, exists := auth.GetUserIDFromContext(c)
+	if !exists {
+		h.HandleError(c, commonError.UnauthorizedError, constant.FAILED_TO_REQUEST_EMAIL_CHANGE_MSG)
+		return
+	}
+
+	var req model.RequestEmailChangeRequest
+	if err := h.BindJSON(c, &req); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	response, err := h.emailChangeService.Request(c, userID, req, c.ClientIP())
+	if err != nil {
+		h.HandleError(c, err, constant.FAILED_TO_REQUEST_EMAIL_CHANGE_MSG)
+		return
+	}
+
+	h.SuccessWithData(
+		c,
+		http.StatusCreated,
+		constant.EMAIL_CHANGE_REQUESTED_MSG,
+		constant.EMAIL_CHANGE_FIELD_NAME,
+		response,
+	)
+}
+
+// GetEmailChange handles GET /api/user/auth/email-change
+func (h *EmailChangeHandler) GetEmailChange(c *gin.Context) {
+	userID, exists := auth.GetUserIDFromContext(c)
+	if !exists {
+		h.HandleError(c, commonError.UnauthorizedError, constant.FAILED_TO_GET_EMAIL_CHANGE_MSG)
+		return
+	}
+
+	response, err := h.emailChangeService.GetPending(c, userID)
+	if err != nil {
+		h.HandleError(c, err, constant.FAILED_TO_GET_EMAIL_CHANGE_MSG)
+		return
+	}
+
+	h.SuccessWithData(
+		c,
+		http.StatusOK,
+		constant.EMAIL_CHANGE_RETRIEVED_MSG,
+		constant.EMAIL_CHANGE_FIELD_NAME,
+		response,
+	)
+}
+
+// CancelEmailChange handles DELETE /api/user/auth/email-change
+func (h *EmailChangeHandler) CancelEmailChange(c *gin.Context) {
+	userID, exists := auth.GetUserIDFromContext(c)
+	if !exists {
+		h.HandleError(c, commonError.UnauthorizedError, constant.FAILED_TO_CANCEL_EMAIL_CHANGE_MSG)
+		return
+	}
+
+	if err := h.emailChangeService.Cancel(c, userID, c.ClientIP()); err != nil {
+		h.HandleError(c, err, constant.FAILED_TO_CANCEL_EMAIL_CHANGE_MSG)
+		return
+	}
+
+	h.Success(c, http.StatusOK, constant.EMAIL_CHANGE_CANCELLED_MSG, nil)
+}
+
+// ConfirmEmailChange handles POST /api/user/auth/email-change/confirm. It is public:
+// the token from either emailed link is the credential.
+func (h *EmailChangeHandler) ConfirmEmailChange(c *gin.Context) {
+	var req model.ConfirmEmailChangeRequest
+	if err := h.BindJSON(c, &req); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	response, err := h.emailChangeService.Confirm(c, req, c.ClientIP())
+	if err != nil {
+		h.HandleError(c, err, constant.FAILED_TO_CONFIRM_EMAIL_CHANGE_MSG)
+		return
+	}
+
+	h.SuccessWithData(
+		c,
+		http.StatusOK,
+		constant.EMAIL_CHANGE_CONFIRMED_MSG,
+		constant.EMAIL_CHANGE_FIELD_NAME,
+		response,
+	)
+}
@@ -0,0 +1,150 @@
+package handler
+
+import (
+	"net/http"
+
+	"ecommerce-be/common/auth"
+	commonError "ecommerce-be/common/error"
+	"ecommerce-be/common/handler"
+	userErrors "ecommerce-be/user/error"
+	"ecommerce-be/user/model"
+	"ecommerce-be/user/service"
+	"ecommerce-be/user/utils/constant"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ImpersonationHandler handles HTTP requests for admin impersonation of sellers
+type ImpersonationHandler struct {
+	*handler.BaseHandler
+	impersonationService service.ImpersonationService
+}
+
+// NewImpersonationHandler creates a new ImpersonationHandler
+func NewImpersonationHandler(
+	impersonationService service.ImpersonationService,
+) *ImpersonationHandler {
+	return &ImpersonationHandler{
+		BaseHandler:          handler.NewBaseHandler(),
+		impersonationService: impersonationService,
+	}
+}
+
+// StartImpersonation handles POST /api/user/admin/impersonations
+func (h *ImpersonationHandler) StartImpersonation(c *gin.Context) {
+	adminUserID, exists := auth.GetUserIDFromContext(c)
+	if !exists {
+		h.HandleError(c, commonError.UnauthorizedError, constant.FAILED_TO_START_IMPERSONATION_MSG)
+		return
+	}
+
+	var req model.StartImpersonationRequest
+	if err := h.BindJSON(c, &req); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	response, err := h.impersonationService.Start(c, adminUserID, req)
+	if err != nil {
+		h.HandleError(c, err, constant.FAILED_TO_START_IMPERSONATION_MSG)
+		return
+	}
+
+	h.SuccessWithData(
+		c,
+		http.StatusCreated,
+		constant.IMPERSONATION_STARTED_MSG,
+		constant.IMPERSONATION_FIELD_NAME,
+		response,
+	)
+}
+
+// EndCurrentImpersonation handles POST /api/user/impersonation/end. It is called
+// with the impersonation token itself and ends the session behind it.
+func (h *ImpersonationHandler) EndCurrentImpersonation(c *gin.Context) {
+	impersonation, exists := auth.GetImpersonationFromContext(c)
+	if !exists {
+		h.HandleError(
+			c,
+			userErrors.ErrImpersonationNotActive,
+			constant.FAILED_TO_END_IMPERSONATION_MSG,
+		)
+		return
+	}
+
+	response, err := h.impersonationService.End(
+		c,
+		impersonation.SessionID,
+		impersonation.AdminUserID,
+	)
+	if err != nil {
+		h.HandleError(c, err, constant.FAILED_TO_END_IMPERSONATION_MSG)
+		return
+	}
+
+	h.SuccessWithData(
+		c,
+		http.StatusOK,
+		constant.IMPERSONATION_ENDED_MSG,
+		constant.IMPERSONATION_FIELD_NAME,
+		response,
+	)
+}
+
+// EndImpersonation handles DELETE /api/user/admin/impersonations/:id
+func (h *ImpersonationHandler) EndImpersonation(c *gin.Context) {
+	adminUserID, exists := auth.GetUserIDFromContext(c)
+	if !exists {
+		h.HandleError(c, commonError.UnauthorizedError, constant.FAILED_TO_END_IMPERSONATION_MSG)
+		return
+	}
+
+	sessionID, err := h.ParseUintParam(c, "id")
+	if err != nil {
+		h.HandleError(c, err, constant.FAILED_TO_END_IMPERSONATION_MSG)
+		return
+	}
+
+	response, err := h.impersonationService.End(c, sessionID, adminUserID)
+	if err != nil {
+		h.HandleError(c, err, constant.FAILED_TO_END_IMPERSONATION_MSG)
+		return
+	}
+
+	h.SuccessWithData(
+		c,
+		http.StatusOK,
+		constant.IMPERSONATION_ENDED_MSG,
+		constant.IMPERSONATION_FIELD_NAME,
+		response,
+	)
+}
+
+// GetImpersonationAudit handles GET /api/user/admin/impersonations/:id/audit
+func (h *ImpersonationHandler) GetImpersonationAudit(c *gin.Context) {
+	sessionID, err := h.ParseUintParam(c, "id")
+	if err != nil {
+		h.HandleError(c, err, constant.FAILED_TO_GET_IMPERSONATION_AUDIT_MSG)
+		return
+	}
+
+	var req model.ImpersonationAuditRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	response, err := h.impersonationService.GetAuditLog(c, sessionID, req)
+	if err != nil {
+		h.HandleError(c, err, constant.FAILED_TO_GET_IMPERSONATION_AUDIT_MSG)
+		return
+	}
+
+	h.SuccessWithData(
+		c,
+		http.StatusOK,
+		constant.IMPERSONATION_AUDIT_RETRIEVED_MSG,
+		constant.IMPERSONATION_AUDIT_FIELD_NAME,
+		response,
+	)
+}
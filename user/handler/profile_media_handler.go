@@ -0,0 +1,177 @@
+package handler
+
+import (
+	"net/http"
+
+	"ecommerce-be/common/auth"
+	commonError "ecommerce-be/common/error"
+	"ecommerce-be/common/handler"
+	"ecommerce-be/user/model"
+	"ecommerce-be/user/service"
+	"ecommerce-be/user/utils/constant"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ProfileMediaHandler handles avatar and store logo uploads.
+type ProfileMediaHandler struct {
+	*handler.BaseHandler
+	profileMediaService service.ProfileMediaService
+}
+
+// NewProfileMediaHandler creates a new ProfileMediaHandler.
+func NewProfileMediaHandler(profileMediaService service.ProfileMediaService) *ProfileMediaHandler {
+	return &ProfileMediaHandler{
+		BaseHandler:         handler.NewBaseHandler(),
+		profileMediaService: profileMediaService,
+	}
+}
+
+// InitAvatarUpload handles POST /api/user/avatar/upload
+func (h *ProfileMediaHandler) InitAvatarUpload(c *gin.Context) {
+	userID, ok := h.userIDFromContext(c, constant.FAILED_TO_INIT_MEDIA_UPLOAD_MSG)
+	if !ok {
+		return
+	}
+
+	var req model.MediaUploadRequest
+	if err := h.BindJSON(c, &req); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	ticket, err := h.profileMediaService.InitAvatarUpload(c, userID, req)
+	if err != nil {
+		h.HandleError(c, err, constant.FAILED_TO_INIT_MEDIA_UPLOAD_MSG)
+		return
+	}
+
+	h.SuccessWithData(
+		c,
+		http.StatusCreated,
+		constant.MEDIA_UPLOAD_INITIATED_MSG,
+		constant.UPLOAD_FIELD_NAME,
+		ticket,
+	)
+}
+
+// SetAvatar handles PUT /api/user/avatar
+func (h *ProfileMediaHandler) SetAvatar(c *gin.Context) {
+	userID, ok := h.userIDFromContext(c, constant.FAILED_TO_UPDATE_AVATAR_MSG)
+	if !ok {
+		return
+	}
+
+	var req model.MediaSetRequest
+	if err := h.BindJSON(c, &req); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	avatar, err := h.profileMediaService.SetAvatar(c, userID, req)
+	if err != nil {
+		h.HandleError(c, err, constant.FAILED_TO_UPDATE_AVATAR_MSG)
+		return
+	}
+
+	h.SuccessWithData(
+		c,
+		http.StatusOK,
+		constant.AVATAR_UPDATED_MSG,
+		constant.AVATAR_FIELD_NAME,
+		avatar,
+	)
+}
+
+// RemoveAvatar handles DELETE /api/user/avatar
+func (h *ProfileMediaHandler) RemoveAvatar(c *gin.Context) {
+	userID, ok := h.userIDFromContext(c, constant.FAILED_TO_REMOVE_AVATAR_MSG)
+	if !ok {
+		return
+	}
+
+	if err := h.profileMediaService.RemoveAvatar(c, userID); err != nil {
+		h.HandleError(c, err, constant.FAILED_TO_REMOVE_AVATAR_MSG)
+		return
+	}
+
+	h.Success(c, http.StatusOK, constant.AVATAR_REMOVED_MSG, nil)
+}
+
+// InitStoreLogoUpload handles POST /api/user/seller/logo/upload
+func (h *ProfileMediaHandler) InitStoreLogoUpload(c *gin.Context) {
+	userID, ok := h.userIDFromContext(c, constant.FAILED_TO_INIT_MEDIA_UPLOAD_MSG)
+	if !ok {
+		return
+	}
+	sellerID, ok := h.sellerIDFromContext(c, constant.FAILED_TO_INIT_MEDIA_UPLOAD_MSG)
+	if !ok {
+		return
+	}
+
+	var req model.MediaUploadRequest
+	if err := h.BindJSON(c, &req); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	ticket, err := h.profileMediaService.InitStoreLogoUpload(c, sellerID, userID, req)
+	if err != nil {
+		h.HandleError(c, err, constant.FAILED_TO_INIT_MEDIA_UPLOAD_MSG)
+		return
+	}
+
+	h.SuccessWithData(
+		c,
+		http.StatusCreated,
+		constant.MEDIA_UPLOAD_INITIATED_MSG,
+		constant.UPLOAD_FIELD_NAME,
+		ticket,
+	)
+}
+
+// SetStoreLogo handles PUT /api/user/seller/logo
+func (h *ProfileMediaHandler) SetStoreLogo(c *gin.Context) {
+	sellerID, ok := h.sellerIDFromContext(c, constant.FAILED_TO_UPDATE_STORE_LOGO_MSG)
+	if !ok {
+		return
+	}
+
+	var req model.MediaSetRequest
+	if err := h.BindJSON(c, &req); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	logo, err := h.profileMediaService.SetStoreLogo(c, sellerID, req)
+	if err != nil {
+		h.HandleError(c, err, constant.FAILED_TO_UPDATE_STORE_LOGO_MSG)
+		return
+	}
+
+	h.SuccessWithData(
+		c,
+		http.StatusOK,
+		constant.STORE_LOGO_UPDATED_MSG,
+		constant.STORE_LOGO_FIELD_NAME,
+		logo,
+	)
+}
+
+func (h *ProfileMediaHandler) userIDFromContext(c *gin.Context, failureMsg string) (uint, bool) {
+	userID, exists := auth.GetUserIDFromContext(c)
+	if !exists || userID == 0 {
+		h.HandleError(c, commonError.UnauthorizedError, failureMsg)
+		return 0, false
+	}
+	return userID, true
+}
+
+func (h *ProfileMediaHandler) sellerIDFromContext(c *gin.Context, failureMsg string) (uint, bool) {
+	sellerID, exists := auth.GetSellerIDFromContext(c)
+	if !exists || sellerID == 0 {
+		h.HandleError(c, commonError.UnauthorizedError, failureMsg)
+		return 0, false
+	}
+	return sellerID, true
+}
@@ -0,0 +1,99 @@
+package handler
+
+import (
+	"net/http"
+
+	"ecommerce-be/common/handler"
+	"ecommerce-be/user/model"
+	"ecommerce-be/user/service"
+	"ecommerce-be/user/utils/constant"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SellerDomainHandler handles HTTP requests for custom storefront domains
+type SellerDomainHandler struct {
+	*handler.BaseHandler
+	sellerDomainService service.SellerDomainService
+}
+
+// NewSellerDomainHandler creates a new SellerDomainHandler
+func NewSellerDomainHandler(sellerDomainService service.SellerDomainService) *SellerDomainHandler {
+	return &SellerDomainHandler{
+		BaseHandler:         handler.NewBaseHandler(),
+		sellerDomainService: sellerDomainService,
+	}
+}
+
+// ListSellerDomains handles GET /api/user/admin/sellers/:sellerId/domains
+func (h *SellerDomainHandler) ListSellerDomains(c *gin.Context) {
+	sellerID, err := h.ParseUintParam(c, "sellerId")
+	if err != nil {
+		h.HandleError(c, err, constant.FAILED_TO_LIST_SELLER_DOMAINS_MSG)
+		return
+	}
+
+	response, err := h.sellerDomainService.List(c, sellerID)
+	if err != nil {
+		h.HandleError(c, err, constant.FAILED_TO_LIST_SELLER_DOMAINS_MSG)
+		return
+	}
+
+	h.SuccessWithData(
+		c,
+		http.StatusOK,
+		constant.SELLER_DOMAINS_RETRIEVED_MSG,
+		constant.SELLER_DOMAINS_FIELD_NAME,
+		response,
+	)
+}
+
+// CreateSellerDomain handles POST /api/user/admin/sellers/:sellerId/domains
+func (h *SellerDomainHandler) CreateSellerDomain(c *gin.Context) {
+	sellerID, err := h.ParseUintParam(c, "sellerId")
+	if err != nil {
+		h.HandleError(c, err, constant.FAILED_TO_CREATE_SELLER_DOMAIN_MSG)
+		return
+	}
+
+	var req model.SellerDomainRequest
+	if err := h.BindJSON(c, &req); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	response, err := h.sellerDomainService.Create(c, sellerID, req)
+	if err != nil {
+		h.HandleError(c, err, constant.FAILED_TO_CREATE_SELLER_DOMAIN_MSG)
+		return
+	}
+
+	h.SuccessWithData(
+		c,
+		http.StatusCreated,
+		constant.SELLER_DOMAIN_CREATED_MSG,
+		constant.SELLER_DOMAIN_FIELD_NAME,
+		response,
+	)
+}
+
+// DeleteSellerDomain handles DELETE /api/user/admin/sellers/:sellerId/domains/:id
+func (h *SellerDomainHandler) DeleteSellerDomain(c *gin.Context) {
+	sellerID, err := h.ParseUintParam(c, "sellerId")
+	if err != nil {
+		h.HandleError(c, err, constant.FAILED_TO_DELETE_SELLER_DOMAIN_MSG)
+		return
+	}
+	domainID, err := h.ParseUintParam(c, "id")
+	if err != nil {
+		h.HandleError(c, err, constant.FAILED_TO_DELETE_SELLER_DOMAIN_MSG)
+		return
+	}
+
+	if err := h.sellerDomainService.Delete(c, sellerID, domainID); err != nil {
+		h.HandleError(c, err, constant.FAILED_TO_DELETE_SELLER_DOMAIN_MSG)
+		return
+	}
+
+	h.Success(c, http.StatusOK, constant.SELLER_DOMAIN_DELETED_MSG, nil)
+}
@@ -0,0 +1,156 @@
+package handler
+
+import (
+	"net/http"
+
+	"ecommerce-be/common/auth"
+	"ecommerce-be/common/constants"
+	commonError "ecommerce-be/common/error"
+	"ecommerce-be/common/handler"
+	"ecommerce-be/user/model"
+	"ecommerce-be/user/service"
+	"ecommerce-be/user/utils/constant"
+
+	"github.com/gin-gonic/gin"
+)
+
+// StorefrontHandler handles HTTP requests for seller storefront settings and the
+// public storefront API
+type StorefrontHandler struct {
+	*handler.BaseHandler
+	storefrontService service.StorefrontService
+}
+
+// NewStorefrontHandler creates a new StorefrontHandler
+func NewStorefrontHandler(storefrontService service.StorefrontService) *StorefrontHandler {
+	return &StorefrontHandler{
+		BaseHandler:       handler.NewBaseHandler(),
+		storefrontService: storefrontService,
+	}
+}
+
+// GetStorefront handles GET /api/user/seller/storefront
+func (h *StorefrontHandler) GetStorefront(c *gin.Context) {
+	sellerID, ok := h.sellerIDFromContext(c, constant.FAILED_TO_GET_STOREFRONT_MSG)
+	if !ok {
+		return
+	}
+
+	response, err := h.storefrontService.Get(c, sellerID)
+	if err != nil {
+		h.HandleError(c, err, constant.FAILED_TO_GET_STOREFRONT_MSG)
+		return
+	}
+
+	h.SuccessWithData(
+		c,
+		http.StatusOK,
+		constant.STOREFRONT_RETRIEVED_MSG,
+		constant.STOREFRONT_FIELD_NAME,
+		response,
+	)
+}
+
+// CreateStorefront handles POST /api/user/seller/storefront
+func (h *StorefrontHandler) CreateStorefront(c *gin.Context) {
+	sellerID, ok := h.sellerIDFromContext(c, constant.FAILED_TO_CREATE_STOREFRONT_MSG)
+	if !ok {
+		return
+	}
+
+	var req model.StorefrontCreateRequest
+	if err := h.BindJSON(c, &req); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	response, err := h.storefrontService.Create(c, sellerID, req)
+	if err != nil {
+		h.HandleError(c, err, constant.FAILED_TO_CREATE_STOREFRONT_MSG)
+		return
+	}
+
+	h.SuccessWithData(
+		c,
+		http.StatusCreated,
+		constant.STOREFRONT_CREATED_MSG,
+		constant.STOREFRONT_FIELD_NAME,
+		response,
+	)
+}
+
+// UpdateStorefront handles PUT /api/user/seller/storefront
+func (h *StorefrontHandler) UpdateStorefront(c *gin.Context) {
+	sellerID, ok := h.sellerIDFromContext(c, constant.FAILED_TO_UPDATE_STOREFRONT_MSG)
+	if !ok {
+		return
+	}
+
+	var req model.StorefrontUpdateRequest
+	if err := h.BindJSON(c, &req); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	response, err := h.storefrontService.Update(c, sellerID, req)
+	if err != nil {
+		h.HandleError(c, err, constant.FAILED_TO_UPDATE_STOREFRONT_MSG)
+		return
+	}
+
+	h.SuccessWithData(
+		c,
+		http.StatusOK,
+		constant.STOREFRONT_UPDATED_MSG,
+		constant.STOREFRONT_FIELD_NAME,
+		response,
+	)
+}
+
+// DeleteStorefront handles DELETE /api/user/seller/storefront
+func (h *StorefrontHandler) DeleteStorefront(c *gin.Context) {
+	sellerID, ok := h.sellerIDFromContext(c, constant.FAILED_TO_DELETE_STOREFRONT_MSG)
+	if !ok {
+		return
+	}
+
+	if err := h.storefrontService.Delete(c, sellerID); err != nil {
+		h.HandleError(c, err, constant.FAILED_TO_DELETE_STOREFRONT_MSG)
+		return
+	}
+
+	h.Success(c, http.StatusOK, constant.STOREFRONT_DELETED_MSG, nil)
+}
+
+// GetPublicStorefront handles GET /api/storefront for the seller named by the
+// X-Seller-ID header (or the caller's token)
+func (h *StorefrontHandler) GetPublicStorefront(c *gin.Context) {
+	sellerID, exists := auth.GetSellerIDFromContext(c)
+	if !exists || sellerID == 0 {
+		h.HandleError(c, commonError.ErrSellerDataMissing, constants.SELLER_DATA_MISSING_MSG)
+		return
+	}
+
+	response, err := h.storefrontService.GetPublic(c, sellerID)
+	if err != nil {
+		h.HandleError(c, err, constant.FAILED_TO_GET_STOREFRONT_MSG)
+		return
+	}
+
+	h.SuccessWithData(
+		c,
+		http.StatusOK,
+		constant.STOREFRONT_RETRIEVED_MSG,
+		constant.STOREFRONT_FIELD_NAME,
+		response,
+	)
+}
+
+func (h *StorefrontHandler) sellerIDFromContext(c *gin.Context, failureMsg string) (uint, bool) {
+	sellerID, exists := auth.GetSellerIDFromContext(c)
+	if !exists || sellerID == 0 {
+		h.HandleError(c, commonError.UnauthorizedError, failureMsg)
+		return 0, false
+	}
+	return sellerID, true
+}
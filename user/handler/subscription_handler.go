@@ -0,0 +1,168 @@
+package handler
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"strings"
+
+	"ecommerce-be/common/auth"
+	"ecommerce-be/common/config"
+	"ecommerce-be/common/constants"
+	commonError "ecommerce-be/common/error"
+	"ecommerce-be/common/handler"
+	"ecommerce-be/common/log"
+	userErrors "ecommerce-be/user/error"
+	"ecommerce-be/user/model"
+	"ecommerce-be/user/service"
+	"ecommerce-be/user/utils/constant"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SubscriptionHandler handles HTTP requests for plans, seller subscriptions and the
+// billing provider's renewal payment webhooks
+type SubscriptionHandler struct {
+	*handler.BaseHandler
+	subscriptionService service.SubscriptionService
+}
+
+// NewSubscriptionHandler creates a new SubscriptionHandler
+func NewSubscriptionHandler(subscriptionService service.SubscriptionService) *SubscriptionHandler {
+	return &SubscriptionHandler{
+		BaseHandler:         handler.NewBaseHandler(),
+		subscriptionService: subscriptionService,
+	}
+}
+
+// ListPlans handles GET /api/user/plans
+func (h *SubscriptionHandler) ListPlans(c *gin.Context) {
+	plans, err := h.subscriptionService.ListPlans(c)
+	if err != nil {
+		h.HandleError(c, err, constant.FAILED_TO_LIST_PLANS_MSG)
+		return
+	}
+
+	h.SuccessWithData(
+		c,
+		http.StatusOK,
+		constant.PLANS_RETRIEVED_MSG,
+		constant.PLANS_FIELD_NAME,
+		plans,
+	)
+}
+
+// GetSellerSubscription handles GET /api/user/seller/subscription
+func (h *SubscriptionHandler) GetSellerSubscription(c *gin.Context) {
+	sellerID, exists := auth.GetSellerIDFromContext(c)
+	if !exists {
+		h.HandleError(c, commonError.ErrSellerDataMissing, constants.SELLER_DATA_MISSING_MSG)
+		return
+	}
+
+	response, err := h.subscriptionService.GetSellerSubscription(c, sellerID)
+	if err != nil {
+		h.HandleError(c, err, constant.FAILED_TO_GET_SUBSCRIPTION_MSG)
+		return
+	}
+
+	h.SuccessWithData(
+		c,
+		http.StatusOK,
+		constant.SUBSCRIPTION_RETRIEVED_MSG,
+		constant.SUBSCRIPTION_FIELD_NAME,
+		response,
+	)
+}
+
+// AssignSellerPlan handles PUT /api/user/admin/sellers/:sellerId/subscription
+func (h *SubscriptionHandler) AssignSellerPlan(c *gin.Context) {
+	sellerID, err := h.ParseUintParam(c, "sellerId")
+	if err != nil {
+		h.HandleError(c, err, constant.FAILED_TO_ASSIGN_PLAN_MSG)
+		return
+	}
+
+	var req model.AssignPlanRequest
+	if err := h.BindJSON(c, &req); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	response, err := h.subscriptionService.AssignPlan(c, sellerID, req)
+	if err != nil {
+		log.ErrorWithContext(c, "assignSellerPlan: failed", err)
+		h.HandleError(c, err, constant.FAILED_TO_ASSIGN_PLAN_MSG)
+		return
+	}
+
+	h.SuccessWithData(
+		c,
+		http.StatusOK,
+		constant.PLAN_ASSIGNED_MSG,
+		constant.SUBSCRIPTION_FIELD_NAME,
+		response,
+	)
+}
+
+// ReceivePaymentWebhook handles POST /api/user/subscription/webhooks/payment. The raw
+// body is verified before binding because the signature covers the exact bytes sent.
+func (h *SubscriptionHandler) ReceivePaymentWebhook(c *gin.Context) {
+	c.Request.Body = http.MaxBytesReader(
+		c.Writer,
+		c.Request.Body,
+		constant.SUBSCRIPTION_WEBHOOK_MAX_BODY_BYTES,
+	)
+	body, err := c.GetRawData()
+	if err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	signature := c.GetHeader(constant.SUBSCRIPTION_SIGNATURE_HEADER)
+	if err := verifySubscriptionSignature(body, signature); err != nil {
+		h.HandleError(c, err, constant.FAILED_TO_PROCESS_SUBSCRIPTION_WEBHOOK_MSG)
+		return
+	}
+
+	// Restore the body consumed by signature verification so it can be bound
+	c.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+	var req model.SubscriptionPaymentWebhookRequest
+	if err := h.BindJSON(c, &req); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
+
+	response, err := h.subscriptionService.ApplyPaymentWebhook(c, req)
+	if err != nil {
+		log.ErrorWithContext(c, "receiveSubscriptionPaymentWebhook: failed", err)
+		h.HandleError(c, err, constant.FAILED_TO_PROCESS_SUBSCRIPTION_WEBHOOK_MSG)
+		return
+	}
+
+	h.Success(c, http.StatusOK, constant.SUBSCRIPTION_WEBHOOK_PROCESSED_MSG, response)
+}
+
+// verifySubscriptionSignature fails closed when no shared secret is configured
+func verifySubscriptionSignature(body []byte, signature string) error {
+	cfg := config.Get()
+	if cfg == nil || cfg.Subscription.PaymentWebhookSecret == "" {
+		return userErrors.ErrSubscriptionWebhookNotConfigured
+	}
+
+	provided, err := hex.DecodeString(strings.TrimSpace(signature))
+	if err != nil || len(provided) == 0 {
+		return userErrors.ErrSubscriptionWebhookUnauthorized
+	}
+
+	mac := hmac.New(sha256.New, []byte(cfg.Subscription.PaymentWebhookSecret))
+	mac.Write(body)
+	if !hmac.Equal(provided, mac.Sum(nil)) {
+		return userErrors.ErrSubscriptionWebhookUnauthorized
+	}
+	return nil
+}
@@ -6,7 +6,10 @@ import (
 	"strings"
 
 	"ecommerce-be/common"
+	"ecommerce-be/common/auth"
 	"ecommerce-be/common/cache"
+	"ecommerce-be/common/handler"
+	"ecommerce-be/common/reqctx"
 	"ecommerce-be/user/model"
 	"ecommerce-be/user/service"
 	"ecommerce-be/user/utils/constant"
@@ -16,12 +19,14 @@ import (
 
 // UserHandler handles HTTP requests related to users
 type UserHandler struct {
+	*handler.BaseHandler
 	userService service.UserService
 }
 
 // NewUserHandler creates a new instance of UserHandler
 func NewUserHandler(userService service.UserService) *UserHandler {
 	return &UserHandler{
+		BaseHandler: handler.NewBaseHandler(),
 		userService: userService,
 	}
 }
@@ -29,19 +34,8 @@ func NewUserHandler(userService service.UserService) *UserHandler {
 // Register handles user registration
 func (h *UserHandler) Register(c *gin.Context) {
 	var req model.UserRegisterRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		var validationErrors []common.ValidationError
-		validationErrors = append(validationErrors, common.ValidationError{
-			Field:   constant.REQUEST_FIELD_NAME,
-			Message: err.Error(),
-		})
-		common.ErrorWithValidation(
-			c,
-			http.StatusBadRequest,
-			constant.VALIDATION_FAILED_MSG,
-			validationErrors,
-			constant.VALIDATION_ERROR_CODE,
-		)
+	if err := h.BindJSON(c, &req); err != nil {
+		h.HandleValidationError(c, err)
 		return
 	}
 
@@ -74,13 +68,8 @@ func (h *UserHandler) Register(c *gin.Context) {
 // Login handles user authentication
 func (h *UserHandler) Login(c *gin.Context) {
 	var req model.UserLoginRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		common.ErrorWithCode(
-			c,
-			http.StatusBadRequest,
-			constant.INVALID_REQUEST_FORMAT_MSG,
-			constant.VALIDATION_ERROR_CODE,
-		)
+	if err := h.BindJSON(c, &req); err != nil {
+		h.HandleValidationError(c, err)
 		return
 	}
 
@@ -110,7 +99,7 @@ func (h *UserHandler) Login(c *gin.Context) {
 // RefreshToken handles token refresh
 func (h *UserHandler) RefreshToken(c *gin.Context) {
 	// Get user ID from context (set by auth middleware)
-	userID, exists := c.Get(constant.USER_ID_KEY)
+	userID, exists := auth.GetUserIDFromContext(c)
 	if !exists {
 		common.ErrorWithCode(
 			c,
@@ -121,7 +110,7 @@ func (h *UserHandler) RefreshToken(c *gin.Context) {
 		return
 	}
 
-	email, exists := c.Get(constant.EMAIL_KEY)
+	email, exists := reqctx.Email.Get(c)
 	if !exists {
 		common.ErrorWithCode(
 			c,
@@ -135,8 +124,8 @@ func (h *UserHandler) RefreshToken(c *gin.Context) {
 	// Generate new token
 	tokenResponse, err := h.userService.RefreshToken(
 		c,
-		userID.(uint),
-		email.(string),
+		userID,
+		email,
 	)
 	if err != nil {
 		common.ErrorResp(
@@ -153,7 +142,7 @@ func (h *UserHandler) RefreshToken(c *gin.Context) {
 // GetProfile handles retrieving user profile
 func (h *UserHandler) GetProfile(c *gin.Context) {
 	// Get user ID from context (set by auth middleware)
-	userID, exists := c.Get(constant.USER_ID_KEY)
+	userID, exists := auth.GetUserIDFromContext(c)
 	if !exists {
 		common.ErrorWithCode(
 			c,
@@ -165,7 +154,7 @@ func (h *UserHandler) GetProfile(c *gin.Context) {
 	}
 
 	// Get user profile
-	profileResponse, err := h.userService.GetProfile(c, userID.(uint))
+	profileResponse, err := h.userService.GetProfile(c, userID)
 	if err != nil {
 		if err.Error() == constant.USER_NOT_FOUND_MSG {
 			common.ErrorWithCode(c, http.StatusNotFound, err.Error(), constant.USER_NOT_FOUND_CODE)
@@ -188,7 +177,7 @@ func (h *UserHandler) GetProfile(c *gin.Context) {
 // UpdateProfile handles updating user profile
 func (h *UserHandler) UpdateProfile(c *gin.Context) {
 	// Get user ID from context (set by auth middleware)
-	userID, exists := c.Get(constant.USER_ID_KEY)
+	userID, exists := auth.GetUserIDFromContext(c)
 	if !exists {
 		common.ErrorWithCode(
 			c,
@@ -200,24 +189,13 @@ func (h *UserHandler) UpdateProfile(c *gin.Context) {
 	}
 
 	var req model.UserUpdateRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		var validationErrors []common.ValidationError
-		validationErrors = append(validationErrors, common.ValidationError{
-			Field:   constant.REQUEST_FIELD_NAME,
-			Message: err.Error(),
-		})
-		common.ErrorWithValidation(
-			c,
-			http.StatusBadRequest,
-			constant.VALIDATION_FAILED_MSG,
-			validationErrors,
-			constant.VALIDATION_ERROR_CODE,
-		)
+	if err := h.BindJSON(c, &req); err != nil {
+		h.HandleValidationError(c, err)
 		return
 	}
 
 	// Update profile
-	userResponse, err := h.userService.UpdateProfile(c, userID.(uint), req)
+	userResponse, err := h.userService.UpdateProfile(c, userID, req)
 	if err != nil {
 		common.ErrorResp(
 			c,
@@ -236,7 +214,7 @@ func (h *UserHandler) UpdateProfile(c *gin.Context) {
 // ChangePassword handles changing user password
 func (h *UserHandler) ChangePassword(c *gin.Context) {
 	// Get user ID from context (set by auth middleware)
-	userID, exists := c.Get(constant.USER_ID_KEY)
+	userID, exists := auth.GetUserIDFromContext(c)
 	if !exists {
 		common.ErrorWithCode(
 			c,
@@ -248,13 +226,8 @@ func (h *UserHandler) ChangePassword(c *gin.Context) {
 	}
 
 	var req model.UserPasswordChangeRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		common.ErrorWithCode(
-			c,
-			http.StatusBadRequest,
-			constant.INVALID_REQUEST_FORMAT_MSG,
-			constant.VALIDATION_ERROR_CODE,
-		)
+	if err := h.BindJSON(c, &req); err != nil {
+		h.HandleValidationError(c, err)
 		return
 	}
 
@@ -270,7 +243,7 @@ func (h *UserHandler) ChangePassword(c *gin.Context) {
 	}
 
 	// Change password
-	if err := h.userService.ChangePassword(c, userID.(uint), req); err != nil {
+	if err := h.userService.ChangePassword(c, userID, req); err != nil {
 		if err.Error() == constant.INVALID_CURRENT_PASSWORD_MSG {
 			common.ErrorWithCode(
 				c,
@@ -5,6 +5,7 @@ import (
 
 	"ecommerce-be/common/auth"
 	"ecommerce-be/common/handler"
+	"ecommerce-be/common/validator"
 	"ecommerce-be/user/model"
 	"ecommerce-be/user/service"
 	"ecommerce-be/user/utils/constant"
@@ -55,6 +56,10 @@ func (h *UserQueryHandler) ListUsers(c *gin.Context) {
 		h.HandleValidationError(c, err)
 		return
 	}
+	if err := validator.RequireLessOrEqual(&params, "createdFrom", "createdTo"); err != nil {
+		h.HandleValidationError(c, err)
+		return
+	}
 
 	// Convert to filter (parses comma-separated values)
 	filter := params.ToFilter()
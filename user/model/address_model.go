@@ -2,18 +2,22 @@ package model
 
 import "ecommerce-be/user/entity"
 
-// AddressRequest represents the request body for adding a new address
+// AddressRequest represents the request body for adding a new address. State and
+// ZipCode are required unless the country does without them; ZipCode is checked against
+// the country's postal code format.
 type AddressRequest struct {
 	Type      entity.AddressType `json:"type"      binding:"omitempty"`
 	Address   string             `json:"address"   binding:"required,min=5,max=500"`
 	Landmark  string             `json:"landmark"  binding:"omitempty,max=255"`
 	City      string             `json:"city"      binding:"required,min=2,max=100"`
-	State     string             `json:"state"     binding:"required,min=2,max=100"`
-	ZipCode   string             `json:"zipCode"   binding:"required,max=20"`
+	State     string             `json:"state"     binding:"omitempty,min=2,max=100"`
+	ZipCode   string             `json:"zipCode"   binding:"omitempty,max=20"`
 	CountryID uint               `json:"countryId" binding:"required"`
 	Latitude  *float64           `json:"latitude"  binding:"omitempty"`
 	Longitude *float64           `json:"longitude" binding:"omitempty"`
 	IsDefault bool               `json:"isDefault"`
+
+	IsDefaultBilling bool `json:"isDefaultBilling"`
 }
 
 // AddressUpdateRequest represents the request body for updating an existing address
@@ -29,6 +33,8 @@ type AddressUpdateRequest struct {
 	Latitude  *float64            `json:"latitude"`
 	Longitude *float64            `json:"longitude"`
 	IsDefault *bool               `json:"isDefault"`
+
+	IsDefaultBilling *bool `json:"isDefaultBilling"`
 }
 
 // AddressResponse represents the address data returned in API responses
@@ -45,4 +51,6 @@ type AddressResponse struct {
 	Latitude  *float64           `json:"latitude,omitempty"`
 	Longitude *float64           `json:"longitude,omitempty"`
 	IsDefault bool               `json:"isDefault,omitempty"`
+
+	IsDefaultBilling bool `json:"isDefaultBilling,omitempty"`
 }
@@ -7,7 +7,7 @@ package model
 // CurrencyBase contains common fields used in create/update/response
 // Changes here will reflect in all currency-related models
 type CurrencyBase struct {
-	Code          string `json:"code"                   binding:"required,len=3"`         // ISO 4217 code
+	Code          string `json:"code"                   binding:"required,currencycode"`  // ISO 4217 code
 	Name          string `json:"name"                   binding:"required,min=2,max=100"` // Currency name
 	Symbol        string `json:"symbol"                 binding:"required,max=10"`        // Symbol ($, €, ₹)
 	SymbolNative  string `json:"symbolNative,omitempty" binding:"omitempty,max=10"`       // Native symbol
@@ -26,7 +26,7 @@ type CurrencyCreateRequest struct {
 
 // CurrencyUpdateRequest - Admin updates a currency (all fields optional)
 type CurrencyUpdateRequest struct {
-	Code          *string `json:"code"          binding:"omitempty,len=3"`
+	Code          *string `json:"code"          binding:"omitempty,currencycode"`
 	Name          *string `json:"name"          binding:"omitempty,min=2,max=100"`
 	Symbol        *string `json:"symbol"        binding:"omitempty,max=10"`
 	SymbolNative  *string `json:"symbolNative"  binding:"omitempty,max=10"`
@@ -0,0 +1,56 @@
+package model
+
+// ========================================
+// REQUEST MODELS
+// ========================================
+
+// CreateDelegatedTokenRequest - Seller mints a scoped, expiring delegated token
+type CreateDelegatedTokenRequest struct {
+	Name           string   `json:"name"           binding:"required,min=1,max=100"`
+	Scopes         []string `json:"scopes"         binding:"required,min=1,dive,required"`
+	ExpiresInHours int      `json:"expiresInHours" binding:"required,min=1,max=720"`
+}
+
+// ListDelegatedTokensRequest - Query params for listing delegated tokens
+type ListDelegatedTokensRequest struct {
+	IncludeInactive bool `form:"includeInactive"`
+}
+
+// DelegatedTokenAuditRequest - Query params for a token's audit log
+type DelegatedTokenAuditRequest struct {
+	Limit int `form:"limit" binding:"omitempty,min=1,max=200"`
+}
+
+// ========================================
+// RESPONSE MODELS
+// ========================================
+
+// DelegatedTokenResponse - Delegated token metadata (never includes the secret)
+type DelegatedTokenResponse struct {
+	ID          uint     `json:"id"`
+	Name        string   `json:"name"`
+	TokenPrefix string   `json:"tokenPrefix"`
+	Scopes      []string `json:"scopes"`
+	Status      string   `json:"status"`
+	ExpiresAt   string   `json:"expiresAt"`
+	LastUsedAt  *string  `json:"lastUsedAt"`
+	RevokedAt   *string  `json:"revokedAt"`
+	CreatedAt   string   `json:"createdAt"`
+}
+
+// CreateDelegatedTokenResponse - Returned once at creation with the raw token
+type CreateDelegatedTokenResponse struct {
+	DelegatedTokenResponse
+	Token string `json:"token"`
+}
+
+// DelegatedTokenAuditResponse - One audit trail entry
+type DelegatedTokenAuditResponse struct {
+	ID          uint    `json:"id"`
+	Action      string  `json:"action"`
+	ActorUserID *uint   `json:"actorUserId"`
+	Method      *string `json:"method"`
+	Path        *string `json:"path"`
+	IPAddress   *string `json:"ipAddress"`
+	CreatedAt   string  `json:"createdAt"`
+}
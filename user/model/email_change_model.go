@@ -0,0 +1,33 @@
+package model
+
+// ========================================
+// REQUEST MODELS
+// ========================================
+
+// RequestEmailChangeRequest - Start an email change; the current password is re-checked
+type RequestEmailChangeRequest struct {
+	NewEmail        string `json:"newEmail"        binding:"required,email,max=255" sanitize:"trim"`
+	CurrentPassword string `json:"currentPassword" binding:"required"`
+}
+
+// ConfirmEmailChangeRequest - Token from either confirmation link
+type ConfirmEmailChangeRequest struct {
+	Token string `json:"token" binding:"required" sanitize:"trim"`
+}
+
+// ========================================
+// RESPONSE MODELS
+// ========================================
+
+// EmailChangeResponse - Progress of an email change (never includes the tokens)
+type EmailChangeResponse struct {
+	ID             uint    `json:"id"`
+	OldEmail       string  `json:"oldEmail"`
+	NewEmail       string  `json:"newEmail"`
+	Status         string  `json:"status"`
+	OldConfirmedAt *string `json:"oldConfirmedAt"`
+	NewConfirmedAt *string `json:"newConfirmedAt"`
+	ExpiresAt      string  `json:"expiresAt"`
+	CompletedAt    *string `json:"completedAt"`
+	CreatedAt      string  `json:"createdAt"`
+}
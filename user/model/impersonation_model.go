@@ -0,0 +1,50 @@
+package model
+
+// ========================================
+// REQUEST MODELS
+// ========================================
+
+// StartImpersonationRequest - Admin opens an "act as seller" session
+type StartImpersonationRequest struct {
+	SellerID        uint   `json:"sellerId"        binding:"required"`
+	Reason          string `json:"reason"          binding:"required,min=3,max=255"`
+	DurationMinutes int    `json:"durationMinutes" binding:"omitempty,min=1,max=240"`
+}
+
+// ImpersonationAuditRequest - Query params for a session's audit log
+type ImpersonationAuditRequest struct {
+	Limit int `form:"limit" binding:"omitempty,min=1,max=200"`
+}
+
+// ========================================
+// RESPONSE MODELS
+// ========================================
+
+// ImpersonationSessionResponse - Impersonation session metadata
+type ImpersonationSessionResponse struct {
+	ID          uint    `json:"id"`
+	AdminUserID uint    `json:"adminUserId"`
+	SellerID    uint    `json:"sellerId"`
+	Reason      string  `json:"reason"`
+	Status      string  `json:"status"`
+	ExpiresAt   string  `json:"expiresAt"`
+	EndedAt     *string `json:"endedAt"`
+	CreatedAt   string  `json:"createdAt"`
+}
+
+// StartImpersonationResponse - Returned once at start with the impersonation token
+type StartImpersonationResponse struct {
+	ImpersonationSessionResponse
+	Token string `json:"token"`
+}
+
+// ImpersonationAuditResponse - One audit trail entry
+type ImpersonationAuditResponse struct {
+	ID          uint    `json:"id"`
+	Action      string  `json:"action"`
+	ActorUserID *uint   `json:"actorUserId"`
+	Method      *string `json:"method"`
+	Path        *string `json:"path"`
+	IPAddress   *string `json:"ipAddress"`
+	CreatedAt   string  `json:"createdAt"`
+}
@@ -0,0 +1,22 @@
+package model
+
+import "ecommerce-be/common/filegateway"
+
+// ========================================
+// PROFILE MEDIA REQUEST MODELS
+// ========================================
+
+// MediaUploadRequest starts an avatar or store logo upload. The returned ticket carries
+// a presigned URL the client PUTs the bytes to before calling the set endpoint.
+type MediaUploadRequest struct {
+	Filename  string `json:"filename"  binding:"required,max=255"`
+	MimeType  string `json:"mimeType"  binding:"required"`
+	SizeBytes int64  `json:"sizeBytes" binding:"required,min=1"`
+}
+
+// MediaSetRequest points the avatar or store logo at an uploaded file. Re-sending the
+// current fileId with a new crop only updates the crop.
+type MediaSetRequest struct {
+	FileID string                 `json:"fileId" binding:"required,max=80"`
+	Crop   *filegateway.ImageCrop `json:"crop"`
+}
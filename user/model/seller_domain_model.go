@@ -0,0 +1,22 @@
+package model
+
+// ========================================
+// REQUEST MODELS
+// ========================================
+
+// SellerDomainRequest - Admin maps a custom storefront domain to a seller
+type SellerDomainRequest struct {
+	Domain string `json:"domain" binding:"required,max=253,fqdn"`
+}
+
+// ========================================
+// RESPONSE MODELS
+// ========================================
+
+// SellerDomainResponse - A seller's custom storefront domain
+type SellerDomainResponse struct {
+	ID        uint   `json:"id"`
+	SellerID  uint   `json:"sellerId"`
+	Domain    string `json:"domain"`
+	CreatedAt string `json:"createdAt"`
+}
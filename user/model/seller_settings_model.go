@@ -1,5 +1,7 @@
 package model
 
+import "ecommerce-be/common/filegateway"
+
 // ========================================
 // BASE MODELS (for inheritance)
 // ========================================
@@ -23,14 +25,20 @@ type SellerSettingsCreateRequest struct {
 	BaseCurrencyID               uint  `json:"baseCurrencyId"               binding:"required"`
 	SettlementCurrencyID         *uint `json:"settlementCurrencyId"`         // Optional, defaults to BaseCurrencyID
 	DisplayPricesInBuyerCurrency *bool `json:"displayPricesInBuyerCurrency"` // Optional, defaults to false
+	// Optional, defaults to FIFO
+	InventoryCostingMethod *string `json:"inventoryCostingMethod" binding:"omitempty,oneof=FIFO AVERAGE"`
+	// Optional, defaults to 0 (customers may cancel until the order is fulfilled)
+	CancellationWindowMinutes *int `json:"cancellationWindowMinutes" binding:"omitempty,min=0,max=43200"`
 }
 
 // SellerSettingsUpdateRequest - Seller updates their settings (all fields optional)
 type SellerSettingsUpdateRequest struct {
-	BusinessCountryID            *uint `json:"businessCountryId"`
-	BaseCurrencyID               *uint `json:"baseCurrencyId"`
-	SettlementCurrencyID         *uint `json:"settlementCurrencyId"`
-	DisplayPricesInBuyerCurrency *bool `json:"displayPricesInBuyerCurrency"`
+	BusinessCountryID            *uint   `json:"businessCountryId"`
+	BaseCurrencyID               *uint   `json:"baseCurrencyId"`
+	SettlementCurrencyID         *uint   `json:"settlementCurrencyId"`
+	DisplayPricesInBuyerCurrency *bool   `json:"displayPricesInBuyerCurrency"`
+	InventoryCostingMethod       *string `json:"inventoryCostingMethod" binding:"omitempty,oneof=FIFO AVERAGE"`
+	CancellationWindowMinutes    *int    `json:"cancellationWindowMinutes" binding:"omitempty,min=0,max=43200"`
 }
 
 // ========================================
@@ -45,8 +53,13 @@ type SellerSettingsResponse struct {
 	BaseCurrencyID               uint   `json:"baseCurrencyId"`
 	SettlementCurrencyID         uint   `json:"settlementCurrencyId"`
 	DisplayPricesInBuyerCurrency bool   `json:"displayPricesInBuyerCurrency"`
+	InventoryCostingMethod       string `json:"inventoryCostingMethod"`
+	CancellationWindowMinutes    int    `json:"cancellationWindowMinutes"`
 	CreatedAt                    string `json:"createdAt"`
 	UpdatedAt                    string `json:"updatedAt"`
+
+	// StoreLogo is the storefront logo with its CDN URL and crop
+	StoreLogo *filegateway.FileAssetResponse `json:"storeLogo,omitempty"`
 }
 
 // SellerSettingsDetailResponse - Seller settings with expanded country/currency info
@@ -0,0 +1,71 @@
+package model
+
+// ========================================
+// REQUEST MODELS
+// ========================================
+
+// StorefrontCreateRequest - Seller sets up their storefront
+type StorefrontCreateRequest struct {
+	StoreName      string            `json:"storeName"      binding:"required,min=1,max=100"`
+	LogoURL        *string           `json:"logoUrl"        binding:"omitempty,url,max=500"`
+	PrimaryColor   *string           `json:"primaryColor"   binding:"omitempty,hexcolor7"`
+	SecondaryColor *string           `json:"secondaryColor" binding:"omitempty,hexcolor7"`
+	AccentColor    *string           `json:"accentColor"    binding:"omitempty,hexcolor7"`
+	ContactEmail   *string           `json:"contactEmail"   binding:"omitempty,email,max=255"`
+	ContactPhone   *string           `json:"contactPhone"   binding:"omitempty,e164phone"`
+	SocialLinks    map[string]string `json:"socialLinks"    binding:"omitempty,max=10,dive,keys,oneof=facebook instagram x youtube tiktok linkedin pinterest,endkeys,required,url,max=500"`
+	CurrencyCode   *string           `json:"currencyCode"   binding:"omitempty,currencycode"`
+	Timezone       *string           `json:"timezone"       binding:"omitempty,timezone"` // Defaults to UTC
+}
+
+// StorefrontUpdateRequest - Seller updates their storefront (all fields optional).
+// An empty string clears an optional field; socialLinks replaces all links.
+type StorefrontUpdateRequest struct {
+	StoreName      *string           `json:"storeName"      binding:"omitempty,min=1,max=100"`
+	LogoURL        *string           `json:"logoUrl"        binding:"omitempty,url,max=500"`
+	PrimaryColor   *string           `json:"primaryColor"   binding:"omitempty,hexcolor7"`
+	SecondaryColor *string           `json:"secondaryColor" binding:"omitempty,hexcolor7"`
+	AccentColor    *string           `json:"accentColor"    binding:"omitempty,hexcolor7"`
+	ContactEmail   *string           `json:"contactEmail"   binding:"omitempty,email,max=255"`
+	ContactPhone   *string           `json:"contactPhone"   binding:"omitempty,e164phone"`
+	SocialLinks    map[string]string `json:"socialLinks"    binding:"omitempty,max=10,dive,keys,oneof=facebook instagram x youtube tiktok linkedin pinterest,endkeys,required,url,max=500"`
+	CurrencyCode   *string           `json:"currencyCode"   binding:"omitempty,currencycode"`
+	Timezone       *string           `json:"timezone"       binding:"omitempty,timezone"`
+}
+
+// ========================================
+// RESPONSE MODELS
+// ========================================
+
+// StorefrontThemeResponse - Storefront theme colors
+type StorefrontThemeResponse struct {
+	PrimaryColor   *string `json:"primaryColor"`
+	SecondaryColor *string `json:"secondaryColor"`
+	AccentColor    *string `json:"accentColor"`
+}
+
+// StorefrontContactResponse - Storefront contact details
+type StorefrontContactResponse struct {
+	Email       *string           `json:"email"`
+	Phone       *string           `json:"phone"`
+	SocialLinks map[string]string `json:"socialLinks"`
+}
+
+// PublicStorefrontResponse - Storefront configuration served to shoppers
+type PublicStorefrontResponse struct {
+	SellerID     uint                      `json:"sellerId"`
+	StoreName    string                    `json:"storeName"`
+	LogoURL      *string                   `json:"logoUrl"`
+	Theme        StorefrontThemeResponse   `json:"theme"`
+	Contact      StorefrontContactResponse `json:"contact"`
+	CurrencyCode *string                   `json:"currencyCode"`
+	Timezone     string                    `json:"timezone"`
+}
+
+// StorefrontResponse - Storefront settings as seen by the seller
+type StorefrontResponse struct {
+	PublicStorefrontResponse
+	ID        uint   `json:"id"`
+	CreatedAt string `json:"createdAt"`
+	UpdatedAt string `json:"updatedAt"`
+}
@@ -0,0 +1,70 @@
+package model
+
+import "time"
+
+// ========================================
+// REQUEST MODELS
+// ========================================
+
+// AssignPlanRequest - Plan an admin puts a seller on
+type AssignPlanRequest struct {
+	PlanID uint `json:"planId" binding:"required"`
+}
+
+// SubscriptionPaymentWebhookRequest - Renewal payment outcome reported by the billing
+// provider. PlanID is set when the payment was for a different plan than the current one.
+type SubscriptionPaymentWebhookRequest struct {
+	EventID       string     `json:"eventId"       binding:"required,max=255"                              sanitize:"trim"`
+	EventType     string     `json:"eventType"     binding:"required,oneof=payment.succeeded payment.failed"`
+	SellerID      uint       `json:"sellerId"      binding:"required"`
+	PlanID        *uint      `json:"planId"`
+	TransactionID string     `json:"transactionId" binding:"max=255"                                       sanitize:"trim"`
+	OccurredAt    *time.Time `json:"occurredAt"`
+}
+
+// ========================================
+// RESPONSE MODELS
+// ========================================
+
+// PlanResponse - A plan with the limits and features it carries; nil limits are unlimited
+type PlanResponse struct {
+	ID           uint            `json:"id"`
+	Name         string          `json:"name"`
+	Description  string          `json:"description"`
+	Price        float64         `json:"price"`
+	Currency     string          `json:"currency"`
+	BillingCycle string          `json:"billingCycle"`
+	TrialDays    int             `json:"trialDays"`
+	IsPopular    bool            `json:"isPopular"`
+	MaxProducts  *int            `json:"maxProducts"`
+	MaxVariants  *int            `json:"maxVariants"`
+	Features     map[string]bool `json:"features"`
+}
+
+// PlanUsageResponse - How much of the plan's catalog limits the seller uses
+type PlanUsageResponse struct {
+	Products int64 `json:"products"`
+	Variants int64 `json:"variants"`
+}
+
+// SellerSubscriptionResponse - The seller's subscription, its plan and usage
+type SellerSubscriptionResponse struct {
+	ID           uint              `json:"id"`
+	SellerID     uint              `json:"sellerId"`
+	Status       string            `json:"status"`
+	StartDate    string            `json:"startDate"`
+	EndDate      string            `json:"endDate"`
+	PastDueSince *string           `json:"pastDueSince"`
+	GraceEndsAt  *string           `json:"graceEndsAt"`
+	Plan         PlanResponse      `json:"plan"`
+	Usage        PlanUsageResponse `json:"usage"`
+}
+
+// SubscriptionPaymentWebhookResponse - Subscription state after a payment webhook;
+// Duplicate is set when the event had already been applied
+type SubscriptionPaymentWebhookResponse struct {
+	SubscriptionID uint   `json:"subscriptionId"`
+	Status         string `json:"status"`
+	EndDate        string `json:"endDate"`
+	Duplicate      bool   `json:"duplicate"`
+}
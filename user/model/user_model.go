@@ -1,5 +1,7 @@
 package model
 
+import "ecommerce-be/common/filegateway"
+
 // UserRegisterRequest represents the request body for user registration
 type UserRegisterRequest struct {
 	CreateUserRequest
@@ -13,10 +15,12 @@ type CreateUserRequest struct {
 	LastName    string `json:"lastName"    binding:"required"`
 	Email       string `json:"email"       binding:"required,email"`
 	Password    string `json:"password"    binding:"required,min=6"`
-	Phone       string `json:"phone"`
+	Phone       string `json:"phone"       binding:"omitempty,e164phone"`
 	DateOfBirth string `json:"dateOfBirth"`
 	Gender      string `json:"gender"`
 	SellerID    uint   `json:"sellerId"`
+	// IsGuest creates a guest checkout user; set internally, never from request bodies
+	IsGuest bool `json:"-"`
 }
 
 // UserLoginRequest represents the request body for user login
@@ -30,7 +34,7 @@ type UserLoginRequest struct {
 type UserUpdateRequest struct {
 	FirstName   *string `json:"firstName"   binding:"omitempty,min=1"`
 	LastName    *string `json:"lastName"    binding:"omitempty,min=1"`
-	Phone       *string `json:"phone"`
+	Phone       *string `json:"phone"       binding:"omitempty,e164phone"`
 	DateOfBirth *string `json:"dateOfBirth"`
 	Gender      *string `json:"gender"`
 
@@ -62,6 +66,9 @@ type UserResponse struct {
 	// Preferences (Note: User's country is derived from default address)
 	CurrencyID *uint  `json:"currencyId,omitempty"`
 	Locale     string `json:"locale,omitempty"`
+
+	// Avatar is resolved to its CDN URL together with the stored crop
+	Avatar *filegateway.FileAssetResponse `json:"avatar,omitempty"`
 }
 
 // UserDetailResponse represents user with expanded currency info
@@ -20,6 +20,10 @@ type AddressRepository interface {
 	Update(ctx context.Context, address *entity.Address) error
 	Delete(ctx context.Context, id uint, userID uint) error
 	SetDefault(ctx context.Context, id uint, userID uint) error
+	SetDefaultBilling(ctx context.Context, id uint, userID uint) error
+	// CountByUserID counts the user's address book entries; seller location
+	// addresses are not part of the address book
+	CountByUserID(ctx context.Context, userID uint) (int64, error)
 }
 
 // AddressRepositoryImpl implements the AddressRepository interface
@@ -30,35 +34,28 @@ func NewAddressRepository(db *gorm.DB) AddressRepository {
 	return &AddressRepositoryImpl{}
 }
 
-// Create creates a new address in the database
+// Create creates a new address in the database. The user's first address becomes the
+// default for both shipping and billing.
 func (r *AddressRepositoryImpl) Create(ctx context.Context, address *entity.Address) error {
-	// If this is the first address or marked as default, ensure it's set as default
-	var count int64
-	db.DB(ctx).Model(&entity.Address{}).Where("user_id = ?", address.UserID).Count(&count)
-
-	if count == 0 || address.IsDefault {
-		// If this is the first address or marked as default
-		tx := db.DB(ctx).Begin()
-		// Reset all existing addresses to non-default if this one is default
-		if address.IsDefault {
-			if err := tx.Model(&entity.Address{}).Where("user_id = ?", address.UserID).Update("is_default", false).Error; err != nil {
-				tx.Rollback()
-				return err
-			}
-		} else if count == 0 {
-			// If this is the first address, make it default
+	return db.WithTransaction(ctx, func(txCtx context.Context) error {
+		tx := db.DB(txCtx)
+
+		var count int64
+		if err := tx.Model(&entity.Address{}).
+			Where("user_id = ?", address.UserID).
+			Count(&count).Error; err != nil {
+			return err
+		}
+		if count == 0 {
 			address.IsDefault = true
+			address.IsDefaultBilling = true
 		}
 
-		if err := tx.Create(address).Error; err != nil {
-			tx.Rollback()
+		if err := clearOtherDefaults(tx, address); err != nil {
 			return err
 		}
-
-		return tx.Commit().Error
-	}
-
-	return db.DB(ctx).Create(address).Error
+		return tx.Create(address).Error
+	})
 }
 
 // FindByID finds an address by ID and user ID
@@ -96,24 +93,16 @@ func (r *AddressRepositoryImpl) FindByUserID(
 
 // Update updates an existing address
 func (r *AddressRepositoryImpl) Update(ctx context.Context, address *entity.Address) error {
-	tx := db.DB(ctx).Begin()
+	return db.WithTransaction(ctx, func(txCtx context.Context) error {
+		tx := db.DB(txCtx)
 
-	// If setting as default, reset other addresses
-	if address.IsDefault {
-		if err := tx.Model(&entity.Address{}).
-			Where("user_id = ? AND id != ?", address.UserID, address.ID).
-			Update("is_default", false).Error; err != nil {
-			tx.Rollback()
+		// If setting as default, reset other addresses
+		if err := clearOtherDefaults(tx, address); err != nil {
 			return err
 		}
-	}
 
-	if err := tx.Save(address).Error; err != nil {
-		tx.Rollback()
-		return err
-	}
-
-	return tx.Commit().Error
+		return tx.Save(address).Error
+	})
 }
 
 // Delete deletes an address by ID and user ID
@@ -135,53 +124,97 @@ func (r *AddressRepositoryImpl) Delete(ctx context.Context, id uint, userID uint
 		return errors.New(constant.CANNOT_DELETE_ONLY_DEFAULT_ADDRESS_MSG)
 	}
 
-	tx := db.DB(ctx).Begin()
+	return db.WithTransaction(ctx, func(txCtx context.Context) error {
+		tx := db.DB(txCtx)
 
-	if err := tx.Where("id = ? AND user_id = ?", id, userID).Delete(&entity.Address{}).Error; err != nil {
-		tx.Rollback()
-		return err
-	}
+		if err := tx.Where("id = ? AND user_id = ?", id, userID).Delete(&entity.Address{}).Error; err != nil {
+			return err
+		}
 
-	// If we deleted the default address and there are other addresses, set the first one as default
-	if address.IsDefault && count > 1 {
-		var newDefaultAddress entity.Address
-		if err := tx.Where("user_id = ?", userID).First(&newDefaultAddress).Error; err == nil {
-			newDefaultAddress.IsDefault = true
-			if err := tx.Save(&newDefaultAddress).Error; err != nil {
-				tx.Rollback()
-				return err
+		// If we deleted a default address and there are other addresses, the first one
+		// takes over its default roles
+		if (address.IsDefault || address.IsDefaultBilling) && count > 1 {
+			var newDefaultAddress entity.Address
+			if err := tx.Where("user_id = ?", userID).First(&newDefaultAddress).Error; err == nil {
+				newDefaultAddress.IsDefault = newDefaultAddress.IsDefault || address.IsDefault
+				newDefaultAddress.IsDefaultBilling = newDefaultAddress.IsDefaultBilling ||
+					address.IsDefaultBilling
+				if err := tx.Save(&newDefaultAddress).Error; err != nil {
+					return err
+				}
 			}
 		}
-	}
 
-	return tx.Commit().Error
+		return nil
+	})
 }
 
-// SetDefault sets an address as the default address
+// SetDefault sets an address as the default (shipping) address
 func (r *AddressRepositoryImpl) SetDefault(ctx context.Context, id uint, userID uint) error {
-	tx := db.DB(ctx).Begin()
+	return setDefaultFlag(ctx, "is_default", id, userID)
+}
 
-	// Reset all addresses to non-default
-	if err := tx.Model(&entity.Address{}).Where("user_id = ?", userID).Update("is_default", false).Error; err != nil {
-		tx.Rollback()
-		return err
-	}
+// SetDefaultBilling sets an address as the default billing address
+func (r *AddressRepositoryImpl) SetDefaultBilling(ctx context.Context, id uint, userID uint) error {
+	return setDefaultFlag(ctx, "is_default_billing", id, userID)
+}
 
-	// Set the specified address as default
-	address := entity.Address{}
-	if err := tx.Where("id = ? AND user_id = ?", id, userID).First(&address).Error; err != nil {
-		tx.Rollback()
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return errors.New(constant.ADDRESS_NOT_FOUND_MSG)
+// CountByUserID counts the user's addresses other than seller locations
+func (r *AddressRepositoryImpl) CountByUserID(ctx context.Context, userID uint) (int64, error) {
+	var count int64
+	err := db.DB(ctx).Model(&entity.Address{}).
+		Where("user_id = ? AND type NOT IN ?", userID, []entity.AddressType{
+			entity.ADDR_WAREHOUSE,
+			entity.ADDR_STORE,
+			entity.ADDR_RETURN_CENTER,
+		}).
+		Count(&count).Error
+	return count, err
+}
+
+// setDefaultFlag moves one of the user's default flags (a boolean column) to an address
+func setDefaultFlag(ctx context.Context, column string, id uint, userID uint) error {
+	return db.WithTransaction(ctx, func(txCtx context.Context) error {
+		tx := db.DB(txCtx)
+
+		// Make sure the address exists before taking the flag from the others
+		address := entity.Address{}
+		if err := tx.Where("id = ? AND user_id = ?", id, userID).First(&address).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return errors.New(constant.ADDRESS_NOT_FOUND_MSG)
+			}
+			return err
 		}
-		return err
-	}
 
-	address.IsDefault = true
-	if err := tx.Save(&address).Error; err != nil {
-		tx.Rollback()
-		return err
-	}
+		// Reset all addresses, then flag the specified one
+		if err := tx.Model(&entity.Address{}).
+			Where("user_id = ?", userID).
+			UpdateColumn(column, false).Error; err != nil {
+			return err
+		}
+		return tx.Model(&entity.Address{}).
+			Where("id = ? AND user_id = ?", id, userID).
+			UpdateColumn(column, true).Error
+	})
+}
 
-	return tx.Commit().Error
+// clearOtherDefaults takes the default flags the address claims from the user's other
+// addresses, so each user keeps one default of each kind. Flags are written with
+// UpdateColumn: the Address save hooks validate a full row, which these updates lack.
+func clearOtherDefaults(tx *gorm.DB, address *entity.Address) error {
+	if address.IsDefault {
+		if err := tx.Model(&entity.Address{}).
+			Where("user_id = ? AND id != ?", address.UserID, address.ID).
+			UpdateColumn("is_default", false).Error; err != nil {
+			return err
+		}
+	}
+	if address.IsDefaultBilling {
+		if err := tx.Model(&entity.Address{}).
+			Where("user_id = ? AND id != ?", address.UserID, address.ID).
+			UpdateColumn("is_default_billing", false).Error; err != nil {
+			return err
+		}
+	}
+	return nil
 }
@@ -0,0 +1,147 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"ecommerce-be/common/db"
+	"ecommerce-be/user/entity"
+
+	"gorm.io/gorm"
+)
+
+// DelegatedAccessTokenRepository defines data operations for delegated tokens and
+// their audit trail
+type DelegatedAccessTokenRepository interface {
+	Create(ctx context.Context, token *entity.DelegatedAccessToken) error
+	FindBySellerID(
+		ctx context.Context,
+		sellerID uint,
+		includeInactive bool,
+	) ([]entity.DelegatedAccessToken, error)
+	FindByIDAndSellerID(
+		ctx context.Context,
+		id uint,
+		sellerID uint,
+	) (*entity.DelegatedAccessToken, error)
+	CountActiveBySellerID(ctx context.Context, sellerID uint) (int64, error)
+	Revoke(ctx context.Context, id uint, sellerID uint, revokedBy uint) (bool, error)
+
+	CreateAudit(ctx context.Context, audit *entity.DelegatedAccessTokenAudit) error
+	FindAuditByTokenID(
+		ctx context.Context,
+		tokenID uint,
+		limit int,
+	) ([]entity.DelegatedAccessTokenAudit, error)
+}
+
+// DelegatedAccessTokenRepositoryImpl implements DelegatedAccessTokenRepository
+type DelegatedAccessTokenRepositoryImpl struct{}
+
+// NewDelegatedAccessTokenRepository creates a new instance of DelegatedAccessTokenRepository
+func NewDelegatedAccessTokenRepository() DelegatedAccessTokenRepository {
+	return &DelegatedAccessTokenRepositoryImpl{}
+}
+
+// Create inserts a new delegated token
+func (r *DelegatedAccessTokenRepositoryImpl) Create(
+	ctx context.Context,
+	token *entity.DelegatedAccessToken,
+) error {
+	return db.DB(ctx).Create(token).Error
+}
+
+// FindBySellerID lists a seller's tokens, newest first. Unless includeInactive is
+// set, revoked and expired tokens are left out.
+func (r *DelegatedAccessTokenRepositoryImpl) FindBySellerID(
+	ctx context.Context,
+	sellerID uint,
+	includeInactive bool,
+) ([]entity.DelegatedAccessToken, error) {
+	var tokens []entity.DelegatedAccessToken
+	query := db.DB(ctx).Where("seller_id = ?", sellerID)
+	if !includeInactive {
+		query = query.Where("revoked_at IS NULL AND expires_at > ?", time.Now().UTC())
+	}
+	err := query.Order("created_at DESC, id DESC").Find(&tokens).Error
+	return tokens, err
+}
+
+// FindByIDAndSellerID returns the token if it belongs to the seller, nil otherwise
+func (r *DelegatedAccessTokenRepositoryImpl) FindByIDAndSellerID(
+	ctx context.Context,
+	id uint,
+	sellerID uint,
+) (*entity.DelegatedAccessToken, error) {
+	var token entity.DelegatedAccessToken
+	err := db.DB(ctx).Where("id = ? AND seller_id = ?", id, sellerID).First(&token).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &token, nil
+}
+
+// CountActiveBySellerID counts tokens that are neither revoked nor expired
+func (r *DelegatedAccessTokenRepositoryImpl) CountActiveBySellerID(
+	ctx context.Context,
+	sellerID uint,
+) (int64, error) {
+	var count int64
+	err := db.DB(ctx).
+		Model(&entity.DelegatedAccessToken{}).
+		Where("seller_id = ? AND revoked_at IS NULL AND expires_at > ?", sellerID, time.Now().UTC()).
+		Count(&count).
+		Error
+	return count, err
+}
+
+// Revoke marks an active token as revoked. Returns false if the token was
+// already revoked or expired.
+func (r *DelegatedAccessTokenRepositoryImpl) Revoke(
+	ctx context.Context,
+	id uint,
+	sellerID uint,
+	revokedBy uint,
+) (bool, error) {
+	now := time.Now().UTC()
+	result := db.DB(ctx).
+		Model(&entity.DelegatedAccessToken{}).
+		Where("id = ? AND seller_id = ? AND revoked_at IS NULL AND expires_at > ?", id, sellerID, now).
+		UpdateColumns(map[string]any{
+			"revoked_at":         now,
+			"revoked_by_user_id": revokedBy,
+			"updated_at":         now,
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// CreateAudit appends an entry to a token's audit trail
+func (r *DelegatedAccessTokenRepositoryImpl) CreateAudit(
+	ctx context.Context,
+	audit *entity.DelegatedAccessTokenAudit,
+) error {
+	return db.DB(ctx).Create(audit).Error
+}
+
+// FindAuditByTokenID returns the most recent audit entries for a token
+func (r *DelegatedAccessTokenRepositoryImpl) FindAuditByTokenID(
+	ctx context.Context,
+	tokenID uint,
+	limit int,
+) ([]entity.DelegatedAccessTokenAudit, error) {
+	var entries []entity.DelegatedAccessTokenAudit
+	err := db.DB(ctx).
+		Where("token_id = ?", tokenID).
+		Order("created_at DESC, id DESC").
+		Limit(limit).
+		Find(&entries).
+		Error
+	return entries, err
+}
@@ -0,0 +1,144 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"ecommerce-be/common/db"
+	"ecommerce-be/user/entity"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// EmailChangeRequestRepository defines data operations for email change requests
+// and their audit trail
+type EmailChangeRequestRepository interface {
+	Create(ctx context.Context, request *entity.EmailChangeRequest) error
+	Update(ctx context.Context, request *entity.EmailChangeRequest) error
+	// FindOpenByUserID returns the user's request that is neither completed,
+	// cancelled nor expired, or nil
+	FindOpenByUserID(ctx context.Context, userID uint) (*entity.EmailChangeRequest, error)
+	// FindByTokenHashForUpdate locks and returns the request either token belongs
+	// to, or nil
+	FindByTokenHashForUpdate(
+		ctx context.Context,
+		tokenHash string,
+	) (*entity.EmailChangeRequest, error)
+	// CancelOpenByUserID cancels every open request of the user and returns their IDs
+	CancelOpenByUserID(ctx context.Context, userID uint) ([]uint, error)
+
+	CreateAudit(ctx context.Context, audit *entity.EmailChangeAudit) error
+}
+
+// EmailChangeRequestRepositoryImpl implements EmailChangeRequestRepository
+type EmailChangeRequestRepositoryImpl struct{}
+
+// NewEmailChangeRequestRepository creates a new instance of EmailChangeRequestRepository
+func NewEmailChangeRequestRepository() EmailChangeRequestRepository {
+	return &EmailChangeRequestRepositoryImpl{}
+}
+
+// Create inserts a new email change request
+func (r *EmailChangeRequestRepositoryImpl) Create(
+	ctx context.Context,
+	request *entity.EmailChangeRequest,
+) error {
+	return db.DB(ctx).Create(request).Error
+}
+
+// Update saves confirmation, completion and cancellation timestamps
+func (r *EmailChangeRequestRepositoryImpl) Update(
+	ctx context.Context,
+	request *entity.EmailChangeRequest,
+) error {
+	return db.DB(ctx).Save(request).Error
+}
+
+// FindOpenByUserID returns the user's newest open request, nil if there is none
+func (r *EmailChangeRequestRepositoryImpl) FindOpenByUserID(
+	ctx context.Context,
+	userID uint,
+) (*entity.EmailChangeRequest, error) {
+	var request entity.EmailChangeRequest
+	err := db.DB(ctx).
+		Where(
+			"user_id = ? AND completed_at IS NULL AND cancelled_at IS NULL AND expires_at > ?",
+			userID,
+			time.Now().UTC(),
+		).
+		Order("created_at DESC, id DESC").
+		First(&request).
+		Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &request, nil
+}
+
+// FindByTokenHashForUpdate matches the hash against both confirmation tokens and
+// locks the row so concurrent confirmations of the two links serialize
+func (r *EmailChangeRequestRepositoryImpl) FindByTokenHashForUpdate(
+	ctx context.Context,
+	tokenHash string,
+) (*entity.EmailChangeRequest, error) {
+	var request entity.EmailChangeRequest
+	err := db.DB(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("old_token_hash = ? OR new_token_hash = ?", tokenHash, tokenHash).
+		First(&request).
+		Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &request, nil
+}
+
+// CancelOpenByUserID marks the user's open requests as cancelled
+func (r *EmailChangeRequestRepositoryImpl) CancelOpenByUserID(
+	ctx context.Context,
+	userID uint,
+) ([]uint, error) {
+	now := time.Now().UTC()
+	var ids []uint
+	err := db.DB(ctx).
+		Model(&entity.EmailChangeRequest{}).
+		Where(
+			"user_id = ? AND completed_at IS NULL AND cancelled_at IS NULL AND expires_at > ?",
+			userID,
+			now,
+		).
+		Pluck("id", &ids).
+		Error
+	if err != nil || len(ids) == 0 {
+		return nil, err
+	}
+
+	err = db.DB(ctx).
+		Model(&entity.EmailChangeRequest{}).
+		Where("id IN ?", ids).
+		UpdateColumns(map[string]any{
+			"cancelled_at": now,
+			"updated_at":   now,
+		}).
+		Error
+	if err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// CreateAudit appends an entry to a request's audit trail
+func (r *EmailChangeRequestRepositoryImpl) CreateAudit(
+	ctx context.Context,
+	audit *entity.EmailChangeAudit,
+) error {
+	return db.DB(ctx).Create(audit).Error
+}
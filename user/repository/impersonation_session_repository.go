@@ -0,0 +1,105 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"ecommerce-be/common/db"
+	"ecommerce-be/user/entity"
+
+	"gorm.io/gorm"
+)
+
+// ImpersonationSessionRepository defines data operations for impersonation sessions
+// and their audit trail
+type ImpersonationSessionRepository interface {
+	Create(ctx context.Context, session *entity.ImpersonationSession) error
+	FindByID(ctx context.Context, id uint) (*entity.ImpersonationSession, error)
+	End(ctx context.Context, id uint, endedBy uint) (bool, error)
+
+	CreateAudit(ctx context.Context, audit *entity.ImpersonationAudit) error
+	FindAuditBySessionID(
+		ctx context.Context,
+		sessionID uint,
+		limit int,
+	) ([]entity.ImpersonationAudit, error)
+}
+
+// ImpersonationSessionRepositoryImpl implements ImpersonationSessionRepository
+type ImpersonationSessionRepositoryImpl struct{}
+
+// NewImpersonationSessionRepository creates a new instance of ImpersonationSessionRepository
+func NewImpersonationSessionRepository() ImpersonationSessionRepository {
+	return &ImpersonationSessionRepositoryImpl{}
+}
+
+// Create inserts a new impersonation session
+func (r *ImpersonationSessionRepositoryImpl) Create(
+	ctx context.Context,
+	session *entity.ImpersonationSession,
+) error {
+	return db.DB(ctx).Create(session).Error
+}
+
+// FindByID returns the session, nil if it does not exist
+func (r *ImpersonationSessionRepositoryImpl) FindByID(
+	ctx context.Context,
+	id uint,
+) (*entity.ImpersonationSession, error) {
+	var session entity.ImpersonationSession
+	err := db.DB(ctx).Where("id = ?", id).First(&session).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &session, nil
+}
+
+// End marks an active session as ended. Returns false if the session was already
+// ended or expired.
+func (r *ImpersonationSessionRepositoryImpl) End(
+	ctx context.Context,
+	id uint,
+	endedBy uint,
+) (bool, error) {
+	now := time.Now().UTC()
+	result := db.DB(ctx).
+		Model(&entity.ImpersonationSession{}).
+		Where("id = ? AND ended_at IS NULL AND expires_at > ?", id, now).
+		UpdateColumns(map[string]any{
+			"ended_at":         now,
+			"ended_by_user_id": endedBy,
+			"updated_at":       now,
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// CreateAudit appends an entry to a session's audit trail
+func (r *ImpersonationSessionRepositoryImpl) CreateAudit(
+	ctx context.Context,
+	audit *entity.ImpersonationAudit,
+) error {
+	return db.DB(ctx).Create(audit).Error
+}
+
+// FindAuditBySessionID returns the most recent audit entries for a session
+func (r *ImpersonationSessionRepositoryImpl) FindAuditBySessionID(
+	ctx context.Context,
+	sessionID uint,
+	limit int,
+) ([]entity.ImpersonationAudit, error) {
+	var entries []entity.ImpersonationAudit
+	err := db.DB(ctx).
+		Where("session_id = ?", sessionID).
+		Order("created_at DESC, id DESC").
+		Limit(limit).
+		Find(&entries).
+		Error
+	return entries, err
+}
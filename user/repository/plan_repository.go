@@ -0,0 +1,46 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"ecommerce-be/common/db"
+	"ecommerce-be/user/entity"
+
+	"gorm.io/gorm"
+)
+
+// PlanRepository defines data operations for subscription plans
+type PlanRepository interface {
+	// FindAll returns every plan in display order
+	FindAll(ctx context.Context) ([]entity.Plan, error)
+	// FindByID returns the plan, or nil when it does not exist
+	FindByID(ctx context.Context, id uint) (*entity.Plan, error)
+}
+
+// PlanRepositoryImpl implements PlanRepository
+type PlanRepositoryImpl struct{}
+
+// NewPlanRepository creates a new instance of PlanRepository
+func NewPlanRepository() PlanRepository {
+	return &PlanRepositoryImpl{}
+}
+
+// FindAll returns every plan ordered by sort order
+func (r *PlanRepositoryImpl) FindAll(ctx context.Context) ([]entity.Plan, error) {
+	var plans []entity.Plan
+	err := db.DB(ctx).Order("sort_order ASC, id ASC").Find(&plans).Error
+	return plans, err
+}
+
+// FindByID returns the plan with the ID, nil if there is none
+func (r *PlanRepositoryImpl) FindByID(ctx context.Context, id uint) (*entity.Plan, error) {
+	var plan entity.Plan
+	if err := db.DB(ctx).First(&plan, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &plan, nil
+}
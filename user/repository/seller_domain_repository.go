@@ -0,0 +1,88 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"ecommerce-be/common/db"
+	"ecommerce-be/user/entity"
+
+	"gorm.io/gorm"
+)
+
+// SellerDomainRepository defines data operations for custom storefront domains
+type SellerDomainRepository interface {
+	Create(ctx context.Context, domain *entity.SellerDomain) error
+	FindByDomain(ctx context.Context, domain string) (*entity.SellerDomain, error)
+	FindByIDAndSellerID(ctx context.Context, id, sellerID uint) (*entity.SellerDomain, error)
+	FindBySellerID(ctx context.Context, sellerID uint) ([]entity.SellerDomain, error)
+	Delete(ctx context.Context, domain *entity.SellerDomain) error
+}
+
+// SellerDomainRepositoryImpl implements SellerDomainRepository
+type SellerDomainRepositoryImpl struct{}
+
+// NewSellerDomainRepository creates a new instance of SellerDomainRepository
+func NewSellerDomainRepository() SellerDomainRepository {
+	return &SellerDomainRepositoryImpl{}
+}
+
+// Create inserts a domain mapping
+func (r *SellerDomainRepositoryImpl) Create(
+	ctx context.Context,
+	domain *entity.SellerDomain,
+) error {
+	return db.DB(ctx).Create(domain).Error
+}
+
+// FindByDomain returns the mapping for a normalized domain, nil if it is not mapped
+func (r *SellerDomainRepositoryImpl) FindByDomain(
+	ctx context.Context,
+	domain string,
+) (*entity.SellerDomain, error) {
+	var sellerDomain entity.SellerDomain
+	err := db.DB(ctx).Where("domain = ?", domain).First(&sellerDomain).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &sellerDomain, nil
+}
+
+// FindByIDAndSellerID returns one of the seller's domains, nil if not found
+func (r *SellerDomainRepositoryImpl) FindByIDAndSellerID(
+	ctx context.Context,
+	id, sellerID uint,
+) (*entity.SellerDomain, error) {
+	var sellerDomain entity.SellerDomain
+	err := db.DB(ctx).
+		Where("id = ? AND seller_id = ?", id, sellerID).
+		First(&sellerDomain).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &sellerDomain, nil
+}
+
+// FindBySellerID returns the seller's domains ordered by creation
+func (r *SellerDomainRepositoryImpl) FindBySellerID(
+	ctx context.Context,
+	sellerID uint,
+) ([]entity.SellerDomain, error) {
+	var domains []entity.SellerDomain
+	err := db.DB(ctx).Where("seller_id = ?", sellerID).Order("id ASC").Find(&domains).Error
+	return domains, err
+}
+
+// Delete removes a domain mapping
+func (r *SellerDomainRepositoryImpl) Delete(
+	ctx context.Context,
+	domain *entity.SellerDomain,
+) error {
+	return db.DB(ctx).Delete(domain).Error
+}
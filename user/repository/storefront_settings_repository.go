@@ -0,0 +1,72 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"ecommerce-be/common/db"
+	"ecommerce-be/user/entity"
+
+	"gorm.io/gorm"
+)
+
+// StorefrontSettingsRepository defines data operations for seller storefront settings
+type StorefrontSettingsRepository interface {
+	Create(ctx context.Context, settings *entity.StorefrontSettings) error
+	FindBySellerID(ctx context.Context, sellerID uint) (*entity.StorefrontSettings, error)
+	Update(ctx context.Context, settings *entity.StorefrontSettings) error
+	DeleteBySellerID(ctx context.Context, sellerID uint) (bool, error)
+}
+
+// StorefrontSettingsRepositoryImpl implements StorefrontSettingsRepository
+type StorefrontSettingsRepositoryImpl struct{}
+
+// NewStorefrontSettingsRepository creates a new instance of StorefrontSettingsRepository
+func NewStorefrontSettingsRepository() StorefrontSettingsRepository {
+	return &StorefrontSettingsRepositoryImpl{}
+}
+
+// Create inserts the seller's storefront settings
+func (r *StorefrontSettingsRepositoryImpl) Create(
+	ctx context.Context,
+	settings *entity.StorefrontSettings,
+) error {
+	return db.DB(ctx).Create(settings).Error
+}
+
+// FindBySellerID returns the seller's storefront settings, nil if none exist
+func (r *StorefrontSettingsRepositoryImpl) FindBySellerID(
+	ctx context.Context,
+	sellerID uint,
+) (*entity.StorefrontSettings, error) {
+	var settings entity.StorefrontSettings
+	err := db.DB(ctx).Where("seller_id = ?", sellerID).First(&settings).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &settings, nil
+}
+
+// Update saves changed storefront settings
+func (r *StorefrontSettingsRepositoryImpl) Update(
+	ctx context.Context,
+	settings *entity.StorefrontSettings,
+) error {
+	return db.DB(ctx).Save(settings).Error
+}
+
+// DeleteBySellerID removes the seller's storefront settings. Returns false if there
+// were none.
+func (r *StorefrontSettingsRepositoryImpl) DeleteBySellerID(
+	ctx context.Context,
+	sellerID uint,
+) (bool, error) {
+	result := db.DB(ctx).Where("seller_id = ?", sellerID).Delete(&entity.StorefrontSettings{})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
@@ -0,0 +1,114 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"ecommerce-be/common/auth"
+	"ecommerce-be/common/constants"
+	"ecommerce-be/common/db"
+	"ecommerce-be/user/entity"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// SubscriptionRepository defines data operations for seller subscriptions and the
+// renewal payments applied to them
+type SubscriptionRepository interface {
+	// FindLatestBySeller returns the seller's newest subscription, or nil
+	FindLatestBySeller(ctx context.Context, sellerID uint) (*entity.Subscription, error)
+	// FindLatestBySellerForUpdate locks and returns the seller's newest subscription, or nil
+	FindLatestBySellerForUpdate(ctx context.Context, sellerID uint) (*entity.Subscription, error)
+	Save(ctx context.Context, subscription *entity.Subscription) error
+	// CreatePaymentEvent records a payment event and reports false, recording nothing,
+	// when an event with the same ID was already recorded
+	CreatePaymentEvent(ctx context.Context, event *entity.SubscriptionPaymentEvent) (bool, error)
+	// CountCatalog returns how many products and variants the seller has
+	CountCatalog(ctx context.Context, sellerID uint) (products int64, variants int64, err error)
+}
+
+// SubscriptionRepositoryImpl implements SubscriptionRepository
+type SubscriptionRepositoryImpl struct{}
+
+// NewSubscriptionRepository creates a new instance of SubscriptionRepository
+func NewSubscriptionRepository() SubscriptionRepository {
+	return &SubscriptionRepositoryImpl{}
+}
+
+// FindLatestBySeller returns the seller's newest subscription, nil if there is none
+func (r *SubscriptionRepositoryImpl) FindLatestBySeller(
+	ctx context.Context,
+	sellerID uint,
+) (*entity.Subscription, error) {
+	return r.findLatestBySeller(db.DB(ctx), sellerID)
+}
+
+// FindLatestBySellerForUpdate locks the row so concurrent webhooks and plan changes for
+// the seller serialize
+func (r *SubscriptionRepositoryImpl) FindLatestBySellerForUpdate(
+	ctx context.Context,
+	sellerID uint,
+) (*entity.Subscription, error) {
+	return r.findLatestBySeller(
+		db.DB(ctx).Clauses(clause.Locking{Strength: "UPDATE"}),
+		sellerID,
+	)
+}
+
+func (r *SubscriptionRepositoryImpl) findLatestBySeller(
+	query *gorm.DB,
+	sellerID uint,
+) (*entity.Subscription, error) {
+	var subscription entity.Subscription
+	err := query.
+		Where("seller_id = ?", sellerID).
+		Order("id DESC").
+		First(&subscription).
+		Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &subscription, nil
+}
+
+// Save inserts a new subscription or updates an existing one
+func (r *SubscriptionRepositoryImpl) Save(
+	ctx context.Context,
+	subscription *entity.Subscription,
+) error {
+	return db.DB(ctx).Save(subscription).Error
+}
+
+// CreatePaymentEvent inserts the event unless its event ID is already recorded
+func (r *SubscriptionRepositoryImpl) CreatePaymentEvent(
+	ctx context.Context,
+	event *entity.SubscriptionPaymentEvent,
+) (bool, error) {
+	result := db.DB(ctx).
+		Clauses(clause.OnConflict{Columns: []clause.Column{{Name: "event_id"}}, DoNothing: true}).
+		Create(event)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// CountCatalog counts the seller's catalog the way plan limits are enforced
+func (r *SubscriptionRepositoryImpl) CountCatalog(
+	ctx context.Context,
+	sellerID uint,
+) (int64, int64, error) {
+	products, err := auth.CountPlanUsage(db.DB(ctx), sellerID, constants.PLAN_LIMIT_PRODUCTS)
+	if err != nil {
+		return 0, 0, err
+	}
+	variants, err := auth.CountPlanUsage(db.DB(ctx), sellerID, constants.PLAN_LIMIT_VARIANTS)
+	if err != nil {
+		return 0, 0, err
+	}
+	return products, variants, nil
+}
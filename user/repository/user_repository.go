@@ -17,8 +17,11 @@ type UserRepository interface {
 	// User CRUD operations
 	Create(ctx context.Context, user *entity.User) error
 	FindByID(ctx context.Context, id uint) (*entity.User, error)
+	// FindByEmail and FindByEmailWithRole find registered users; guests are skipped
 	FindByEmail(ctx context.Context, email string) (*entity.User, error)
 	FindByEmailWithRole(ctx context.Context, email string) (*entity.User, *entity.Role, error)
+	// FindGuestIDsByEmail returns the seller's guest users checked out with email
+	FindGuestIDsByEmail(ctx context.Context, sellerID uint, email string) ([]uint, error)
 	FindByIDWithRole(ctx context.Context, id uint) (*entity.User, *entity.Role, error)
 	Update(ctx context.Context, user *entity.User) error
 	Delete(ctx context.Context, id uint) error
@@ -65,7 +68,7 @@ func (r *UserRepositoryImpl) FindByID(ctx context.Context, id uint) (*entity.Use
 // FindByEmail finds a user by email
 func (r *UserRepositoryImpl) FindByEmail(ctx context.Context, email string) (*entity.User, error) {
 	var user entity.User
-	result := db.DB(ctx).Where("email = ?", email).First(&user)
+	result := db.DB(ctx).Where("email = ? AND is_guest = ?", email, false).First(&user)
 	if result.Error != nil {
 		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
 			return nil, errors.New(constant.USER_NOT_FOUND_MSG)
@@ -75,6 +78,20 @@ func (r *UserRepositoryImpl) FindByEmail(ctx context.Context, email string) (*en
 	return &user, nil
 }
 
+// FindGuestIDsByEmail finds the seller's guest users by email, ignoring case
+func (r *UserRepositoryImpl) FindGuestIDsByEmail(
+	ctx context.Context,
+	sellerID uint,
+	email string,
+) ([]uint, error) {
+	var ids []uint
+	err := db.DB(ctx).
+		Model(&entity.User{}).
+		Where("seller_id = ? AND is_guest = ? AND LOWER(email) = LOWER(?)", sellerID, true, email).
+		Pluck("id", &ids).Error
+	return ids, err
+}
+
 // Update updates an existing user
 func (r *UserRepositoryImpl) Update(ctx context.Context, user *entity.User) error {
 	return db.DB(ctx).Save(user).Error
@@ -94,7 +111,7 @@ func (r *UserRepositoryImpl) FindByEmailWithRole(
 	var role entity.Role
 
 	// First find the user
-	result := db.DB(ctx).Where("email = ?", email).First(&user)
+	result := db.DB(ctx).Where("email = ? AND is_guest = ?", email, false).First(&user)
 	if result.Error != nil {
 		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
 			return nil, nil, errors.New(constant.USER_NOT_FOUND_MSG)
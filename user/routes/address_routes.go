@@ -1,10 +1,15 @@
 package routes
 
 import (
+	"net/http"
+
 	"ecommerce-be/common/constants"
 	"ecommerce-be/common/middleware"
+	"ecommerce-be/common/openapi"
 	"ecommerce-be/user/factory/singleton"
 	"ecommerce-be/user/handler"
+	"ecommerce-be/user/model"
+	"ecommerce-be/user/utils/constant"
 
 	"github.com/gin-gonic/gin"
 )
@@ -27,13 +32,31 @@ func (m *AddressModule) RegisterRoutes(router *gin.Engine) {
 	auth := middleware.CustomerAuth()
 
 	// Address routes (protected) - /api/user/address/*
-	addressRoutes := router.Group(constants.APIBaseUser + "/address")
+	addressRoutes := openapi.NewGroup(router.Group(constants.APIBaseUser+"/address"), "Addresses")
 	{
-		addressRoutes.GET("", auth, m.addressHandler.GetAddresses)
-		addressRoutes.GET("/:id", auth, m.addressHandler.GetAddressByID)
-		addressRoutes.POST("", auth, m.addressHandler.AddAddress)
-		addressRoutes.PUT("/:id", auth, m.addressHandler.UpdateAddress)
-		addressRoutes.DELETE("/:id", auth, m.addressHandler.DeleteAddress)
-		addressRoutes.PATCH("/:id/default", auth, m.addressHandler.SetDefaultAddress)
+		addressRoutes.GET("", auth, m.addressHandler.GetAddresses).
+			Summary("List the caller's addresses").
+			ReturnsField(http.StatusOK, constant.ADDRESSES_FIELD_NAME, []model.AddressResponse{})
+		addressRoutes.GET("/:id", auth, m.addressHandler.GetAddressByID).
+			Summary("Get an address").
+			ReturnsField(http.StatusOK, constant.ADDRESS_FIELD_NAME, model.AddressResponse{})
+		addressRoutes.POST("", auth, m.addressHandler.AddAddress).
+			Summary("Add an address").
+			Description("The state and postal code are checked against the country's "+
+				"conventions. The first address becomes the default for shipping and billing.").
+			Body(model.AddressRequest{}).
+			ReturnsField(http.StatusCreated, constant.ADDRESS_FIELD_NAME, model.AddressResponse{})
+		addressRoutes.PUT("/:id", auth, m.addressHandler.UpdateAddress).
+			Summary("Update an address").
+			Body(model.AddressUpdateRequest{}).
+			ReturnsField(http.StatusOK, constant.ADDRESS_FIELD_NAME, model.AddressResponse{})
+		addressRoutes.DELETE("/:id", auth, m.addressHandler.DeleteAddress).
+			Summary("Delete an address")
+		addressRoutes.PATCH("/:id/default", auth, m.addressHandler.SetDefaultAddress).
+			Summary("Make an address the default shipping address").
+			ReturnsField(http.StatusOK, constant.ADDRESS_FIELD_NAME, model.AddressResponse{})
+		addressRoutes.PATCH("/:id/default-billing", auth, m.addressHandler.SetDefaultBillingAddress).
+			Summary("Make an address the default billing address").
+			ReturnsField(http.StatusOK, constant.ADDRESS_FIELD_NAME, model.AddressResponse{})
 	}
 }
@@ -1,10 +1,15 @@
 package routes
 
 import (
+	"net/http"
+
 	"ecommerce-be/common/constants"
 	"ecommerce-be/common/middleware"
+	"ecommerce-be/common/openapi"
 	"ecommerce-be/user/factory/singleton"
 	"ecommerce-be/user/handler"
+	"ecommerce-be/user/model"
+	"ecommerce-be/user/utils/constant"
 
 	"github.com/gin-gonic/gin"
 )
@@ -33,13 +38,18 @@ func (m *CountryModule) RegisterRoutes(router *gin.Engine) {
 	// GET /api/user/country - List active countries (with currencies)
 	// Query params: ?region=Asia&page=1&limit=20
 	// Response: CountryListWithCurrenciesResponse
-	publicRoutes := router.Group(constants.APIBaseUser + "/country")
+	publicRoutes := openapi.NewGroup(router.Group(constants.APIBaseUser+"/country"), "Countries")
 	{
-		publicRoutes.GET("", m.countryHandler.ListActiveCountries)
+		publicRoutes.GET("", m.countryHandler.ListActiveCountries).
+			Summary("List active countries").
+			Query(model.CountryQueryParams{}).
+			ReturnsField(http.StatusOK, constant.COUNTRIES_FIELD_NAME, []model.CountryResponse{})
 
 		// GET /api/user/country/:id - Get country by ID (with currencies)
 		// Response: CountryDetailResponse
-		publicRoutes.GET("/:id", m.countryHandler.GetCountryByID)
+		publicRoutes.GET("/:id", m.countryHandler.GetCountryByID).
+			Summary("Get a country").
+			ReturnsField(http.StatusOK, constant.COUNTRY_FIELD_NAME, model.CountryDetailResponse{})
 	}
 
 	// ========================================
@@ -47,31 +57,46 @@ func (m *CountryModule) RegisterRoutes(router *gin.Engine) {
 	// ========================================
 
 	adminAuth := middleware.AdminAuth()
-	adminRoutes := router.Group(constants.APIBaseUser + "/admin/country")
+	adminRoutes := openapi.NewGroup(
+		router.Group(constants.APIBaseUser+"/admin/country"),
+		"Countries",
+	)
 	adminRoutes.Use(adminAuth)
 	{
 		// GET /api/user/admin/country - List all countries (including inactive)
 		// Query params: ?region=Asia&isActive=false&page=1&limit=20
 		// Response: CountryListResponse
-		adminRoutes.GET("", m.countryHandler.ListAllCountries)
+		adminRoutes.GET("", m.countryHandler.ListAllCountries).
+			Summary("List all countries including inactive").
+			Query(model.CountryQueryParams{}).
+			Returns(http.StatusOK, model.CountryListResponse{})
 
 		// GET /api/user/admin/country/:id - Get country by ID (admin view)
 		// Response: CountryDetailResponse
-		adminRoutes.GET("/:id", m.countryHandler.GetCountryByIDAdmin)
+		adminRoutes.GET("/:id", m.countryHandler.GetCountryByIDAdmin).
+			Summary("Get a country (admin view)").
+			ReturnsField(http.StatusOK, constant.COUNTRY_FIELD_NAME, model.CountryDetailResponse{})
 
 		// POST /api/user/admin/country - Create new country
 		// Request: CountryCreateRequest
 		// Response: CountryResponse
-		adminRoutes.POST("", m.countryHandler.CreateCountry)
+		adminRoutes.POST("", m.countryHandler.CreateCountry).
+			Summary("Create a country").
+			Body(model.CountryCreateRequest{}).
+			ReturnsField(http.StatusCreated, constant.COUNTRY_FIELD_NAME, model.CountryResponse{})
 
 		// PUT /api/user/admin/country/:id - Update country (including deactivation)
 		// Request: CountryUpdateRequest
 		// Response: CountryResponse
-		adminRoutes.PUT("/:id", m.countryHandler.UpdateCountry)
+		adminRoutes.PUT("/:id", m.countryHandler.UpdateCountry).
+			Summary("Update a country").
+			Body(model.CountryUpdateRequest{}).
+			ReturnsField(http.StatusOK, constant.COUNTRY_FIELD_NAME, model.CountryResponse{})
 
 		// DELETE /api/user/admin/country/:id - Hard delete country
 		// Response: { "message": "Country deleted successfully" }
-		adminRoutes.DELETE("/:id", m.countryHandler.DeleteCountry)
+		adminRoutes.DELETE("/:id", m.countryHandler.DeleteCountry).
+			Summary("Delete a country")
 
 		// ========================================
 		// COUNTRY-CURRENCY MAPPING ROUTES
@@ -79,25 +104,43 @@ func (m *CountryModule) RegisterRoutes(router *gin.Engine) {
 
 		// GET /api/user/admin/country/:id/currency - List currencies for a country
 		// Response: CountryCurrencyListResponse
-		adminRoutes.GET("/:id/currency", m.countryCurrencyHandler.ListCountryCurrencies)
+		adminRoutes.GET("/:id/currency", m.countryCurrencyHandler.ListCountryCurrencies).
+			Summary("List currencies of a country").
+			Returns(http.StatusOK, model.CountryCurrencyListResponse{})
 
 		// POST /api/user/admin/country/:id/currency - Add currency to country
 		// Request: CountryCurrencyCreateRequest
 		// Response: CountryCurrencySimpleResponse
-		adminRoutes.POST("/:id/currency", m.countryCurrencyHandler.AddCurrencyToCountry)
+		adminRoutes.POST("/:id/currency", m.countryCurrencyHandler.AddCurrencyToCountry).
+			Summary("Add a currency to a country").
+			Body(model.CountryCurrencyCreateRequest{}).
+			Returns(http.StatusCreated, model.CountryCurrencySimpleResponse{})
 
 		// POST /api/user/admin/country/:id/currency/bulk - Add multiple currencies to country
 		// Request: CountryCurrencyBulkRequest
 		// Response: []CountryCurrencySimpleResponse
-		adminRoutes.POST("/:id/currency/bulk", m.countryCurrencyHandler.BulkAddCurrenciesToCountry)
+		adminRoutes.POST("/:id/currency/bulk", m.countryCurrencyHandler.BulkAddCurrenciesToCountry).
+			Summary("Add currencies to a country in bulk").
+			Body(model.CountryCurrencyBulkRequest{}).
+			Returns(http.StatusCreated, []model.CountryCurrencySimpleResponse{})
 
 		// PUT /api/user/admin/country/:id/currency/:currencyId - Update mapping (set primary)
 		// Request: CountryCurrencyUpdateRequest
 		// Response: CountryCurrencySimpleResponse
-		adminRoutes.PUT("/:id/currency/:currencyId", m.countryCurrencyHandler.UpdateCountryCurrency)
+		adminRoutes.PUT(
+			"/:id/currency/:currencyId",
+			m.countryCurrencyHandler.UpdateCountryCurrency,
+		).
+			Summary("Update a country currency mapping").
+			Body(model.CountryCurrencyUpdateRequest{}).
+			Returns(http.StatusOK, model.CountryCurrencySimpleResponse{})
 
 		// DELETE /api/user/admin/country/:id/currency/:currencyId - Remove currency from country
 		// Response: { "message": "Currency removed from country" }
-		adminRoutes.DELETE("/:id/currency/:currencyId", m.countryCurrencyHandler.RemoveCurrencyFromCountry)
+		adminRoutes.DELETE(
+			"/:id/currency/:currencyId",
+			m.countryCurrencyHandler.RemoveCurrencyFromCountry,
+		).
+			Summary("Remove a currency from a country")
 	}
 }
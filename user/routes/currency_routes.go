@@ -1,10 +1,15 @@
 package routes
 
 import (
+	"net/http"
+
 	"ecommerce-be/common/constants"
 	"ecommerce-be/common/middleware"
+	"ecommerce-be/common/openapi"
 	"ecommerce-be/user/factory/singleton"
 	"ecommerce-be/user/handler"
+	"ecommerce-be/user/model"
+	"ecommerce-be/user/utils/constant"
 
 	"github.com/gin-gonic/gin"
 )
@@ -31,10 +36,19 @@ func (m *CurrencyModule) RegisterRoutes(router *gin.Engine) {
 	// GET /api/user/currency - List active currencies
 	// Query params: ?page=1&limit=20
 	// Response: CurrencyListResponse
-	publicRoutes := router.Group(constants.APIBaseUser + "/currency")
+	publicRoutes := openapi.NewGroup(router.Group(constants.APIBaseUser+"/currency"), "Currencies")
 	{
-		publicRoutes.GET("", m.currencyHandler.ListActiveCurrencies)
-		publicRoutes.GET("/:id", m.currencyHandler.GetCurrencyByID)
+		publicRoutes.GET("", m.currencyHandler.ListActiveCurrencies).
+			Summary("List active currencies").
+			Query(model.CurrencyQueryParams{}).
+			Returns(http.StatusOK, model.CurrencyListResponse{})
+		publicRoutes.GET("/:id", m.currencyHandler.GetCurrencyByID).
+			Summary("Get a currency").
+			ReturnsField(
+				http.StatusOK,
+				constant.CURRENCY_FIELD_NAME,
+				model.CurrencyDetailResponse{},
+			)
 	}
 
 	// ========================================
@@ -42,30 +56,49 @@ func (m *CurrencyModule) RegisterRoutes(router *gin.Engine) {
 	// ========================================
 
 	adminAuth := middleware.AdminAuth()
-	adminRoutes := router.Group(constants.APIBaseUser + "/admin/currency")
+	adminRoutes := openapi.NewGroup(
+		router.Group(constants.APIBaseUser+"/admin/currency"),
+		"Currencies",
+	)
 	adminRoutes.Use(adminAuth)
 	{
 		// GET /api/user/admin/currency - List all currencies (including inactive)
 		// Query params: ?isActive=false&page=1&limit=20
 		// Response: CurrencyListResponse
-		adminRoutes.GET("", m.currencyHandler.ListAllCurrencies)
+		adminRoutes.GET("", m.currencyHandler.ListAllCurrencies).
+			Summary("List all currencies including inactive").
+			Query(model.CurrencyQueryParams{}).
+			Returns(http.StatusOK, model.CurrencyListResponse{})
 
 		// GET /api/user/admin/currency/:id - Get currency by ID (admin view)
 		// Response: CurrencyDetailResponse
-		adminRoutes.GET("/:id", m.currencyHandler.GetCurrencyByIDAdmin)
+		adminRoutes.GET("/:id", m.currencyHandler.GetCurrencyByIDAdmin).
+			Summary("Get a currency (admin view)").
+			ReturnsField(
+				http.StatusOK,
+				constant.CURRENCY_FIELD_NAME,
+				model.CurrencyDetailResponse{},
+			)
 
 		// POST /api/user/admin/currency - Create new currency
 		// Request: CurrencyCreateRequest
 		// Response: CurrencyResponse
-		adminRoutes.POST("", m.currencyHandler.CreateCurrency)
+		adminRoutes.POST("", m.currencyHandler.CreateCurrency).
+			Summary("Create a currency").
+			Body(model.CurrencyCreateRequest{}).
+			ReturnsField(http.StatusCreated, constant.CURRENCY_FIELD_NAME, model.CurrencyResponse{})
 
 		// PUT /api/user/admin/currency/:id - Update currency (including deactivation)
 		// Request: CurrencyUpdateRequest
 		// Response: CurrencyResponse
-		adminRoutes.PUT("/:id", m.currencyHandler.UpdateCurrency)
+		adminRoutes.PUT("/:id", m.currencyHandler.UpdateCurrency).
+			Summary("Update a currency").
+			Body(model.CurrencyUpdateRequest{}).
+			ReturnsField(http.StatusOK, constant.CURRENCY_FIELD_NAME, model.CurrencyResponse{})
 
 		// DELETE /api/user/admin/currency/:id - Hard delete currency
 		// Response: { "message": "Currency deleted successfully" }
-		adminRoutes.DELETE("/:id", m.currencyHandler.DeleteCurrency)
+		adminRoutes.DELETE("/:id", m.currencyHandler.DeleteCurrency).
+			Summary("Delete a currency")
 	}
 }
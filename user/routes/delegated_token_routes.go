@@ -0,0 +1,71 @@
+package routes
+
+import (
+	"net/http"
+
+	"ecommerce-be/common/constants"
+	"ecommerce-be/common/middleware"
+	"ecommerce-be/common/openapi"
+	"ecommerce-be/user/factory/singleton"
+	"ecommerce-be/user/handler"
+	"ecommerce-be/user/model"
+	"ecommerce-be/user/utils/constant"
+
+	"github.com/gin-gonic/gin"
+)
+
+// DelegatedTokenModule handles seller delegated access token routes
+type DelegatedTokenModule struct {
+	delegatedTokenHandler *handler.DelegatedTokenHandler
+}
+
+// NewDelegatedTokenModule creates a new instance of DelegatedTokenModule
+func NewDelegatedTokenModule() *DelegatedTokenModule {
+	f := singleton.GetInstance()
+	return &DelegatedTokenModule{
+		delegatedTokenHandler: f.GetDelegatedTokenHandler(),
+	}
+}
+
+// RegisterRoutes registers seller-scoped delegated token routes
+func (m *DelegatedTokenModule) RegisterRoutes(router *gin.Engine) {
+	sellerAuth := middleware.SellerAuth()
+	sellerRoutes := openapi.NewGroup(
+		router.Group(constants.APIBaseUser+"/seller/delegated-tokens"),
+		"Delegated Tokens",
+	)
+	sellerRoutes.Use(sellerAuth)
+	{
+		sellerRoutes.POST("", m.delegatedTokenHandler.CreateDelegatedToken).
+			Summary("Issue a delegated access token").
+			Body(model.CreateDelegatedTokenRequest{}).
+			ReturnsField(
+				http.StatusCreated,
+				constant.DELEGATED_TOKEN_FIELD_NAME,
+				model.CreateDelegatedTokenResponse{},
+			)
+		sellerRoutes.GET("", m.delegatedTokenHandler.ListDelegatedTokens).
+			Summary("List delegated access tokens").
+			Query(model.ListDelegatedTokensRequest{}).
+			ReturnsField(
+				http.StatusOK,
+				constant.DELEGATED_TOKENS_FIELD_NAME,
+				[]model.DelegatedTokenResponse{},
+			)
+		sellerRoutes.DELETE("/:id", m.delegatedTokenHandler.RevokeDelegatedToken).
+			Summary("Revoke a delegated access token").
+			ReturnsField(
+				http.StatusOK,
+				constant.DELEGATED_TOKEN_FIELD_NAME,
+				model.DelegatedTokenResponse{},
+			)
+		sellerRoutes.GET("/:id/audit", m.delegatedTokenHandler.GetDelegatedTokenAudit).
+			Summary("List the audit log of a delegated token").
+			Query(model.DelegatedTokenAuditRequest{}).
+			ReturnsField(
+				http.StatusOK,
+				constant.DELEGATED_TOKEN_AUDIT_FIELD_NAME,
+				[]model.DelegatedTokenAuditResponse{},
+			)
+	}
+}
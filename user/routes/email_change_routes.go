@@ -0,0 +1,64 @@
+package routes
+
+import (
+	"net/http"
+
+	"ecommerce-be/common/constants"
+	"ecommerce-be/common/middleware"
+	"ecommerce-be/common/openapi"
+	"ecommerce-be/user/factory/singleton"
+	"ecommerce-be/user/handler"
+	"ecommerce-be/user/model"
+	"ecommerce-be/user/utils/constant"
+
+	"github.com/gin-gonic/gin"
+)
+
+// EmailChangeModule handles the dual-confirmation email change routes
+type EmailChangeModule struct {
+	emailChangeHandler *handler.EmailChangeHandler
+}
+
+// NewEmailChangeModule creates a new instance of EmailChangeModule
+func NewEmailChangeModule() *EmailChangeModule {
+	f := singleton.GetInstance()
+	return &EmailChangeModule{
+		emailChangeHandler: f.GetEmailChangeHandler(),
+	}
+}
+
+// RegisterRoutes registers email change routes under /api/user/auth/email-change
+func (m *EmailChangeModule) RegisterRoutes(router *gin.Engine) {
+	auth := middleware.CustomerAuth()
+	emailChangeRoutes := openapi.NewGroup(
+		router.Group(constants.APIBaseUser+"/auth/email-change"),
+		"Auth",
+	)
+	{
+		emailChangeRoutes.POST("", auth, m.emailChangeHandler.RequestEmailChange).
+			Summary("Request an email change confirmed by both addresses").
+			Body(model.RequestEmailChangeRequest{}).
+			ReturnsField(
+				http.StatusCreated,
+				constant.EMAIL_CHANGE_FIELD_NAME,
+				model.EmailChangeResponse{},
+			)
+		emailChangeRoutes.GET("", auth, m.emailChangeHandler.GetEmailChange).
+			Summary("Get the pending email change").
+			ReturnsField(
+				http.StatusOK,
+				constant.EMAIL_CHANGE_FIELD_NAME,
+				model.EmailChangeResponse{},
+			)
+		emailChangeRoutes.DELETE("", auth, m.emailChangeHandler.CancelEmailChange).
+			Summary("Cancel the pending email change")
+		emailChangeRoutes.POST("/confirm", m.emailChangeHandler.ConfirmEmailChange).
+			Summary("Confirm an email change with a link token").
+			Body(model.ConfirmEmailChangeRequest{}).
+			ReturnsField(
+				http.StatusOK,
+				constant.EMAIL_CHANGE_FIELD_NAME,
+				model.EmailChangeResponse{},
+			)
+	}
+}
@@ -0,0 +1,81 @@
+package routes
+
+import (
+	"net/http"
+
+	"ecommerce-be/common/constants"
+	"ecommerce-be/common/middleware"
+	"ecommerce-be/common/openapi"
+	"ecommerce-be/user/factory/singleton"
+	"ecommerce-be/user/handler"
+	"ecommerce-be/user/model"
+	"ecommerce-be/user/utils/constant"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ImpersonationModule handles admin "act as seller" routes
+type ImpersonationModule struct {
+	impersonationHandler *handler.ImpersonationHandler
+}
+
+// NewImpersonationModule creates a new instance of ImpersonationModule
+func NewImpersonationModule() *ImpersonationModule {
+	f := singleton.GetInstance()
+	return &ImpersonationModule{
+		impersonationHandler: f.GetImpersonationHandler(),
+	}
+}
+
+// RegisterRoutes registers admin impersonation routes and the end-impersonation
+// endpoint used with the impersonation token
+func (m *ImpersonationModule) RegisterRoutes(router *gin.Engine) {
+	adminRoutes := openapi.NewGroup(
+		router.Group(constants.APIBaseUser+"/admin/impersonations"),
+		"Impersonation",
+	)
+	adminRoutes.Use(middleware.AdminAuth())
+	{
+		adminRoutes.POST("", m.impersonationHandler.StartImpersonation).
+			Summary("Start impersonating a seller").
+			Body(model.StartImpersonationRequest{}).
+			ReturnsField(
+				http.StatusCreated,
+				constant.IMPERSONATION_FIELD_NAME,
+				model.StartImpersonationResponse{},
+			)
+		adminRoutes.DELETE("/:id", m.impersonationHandler.EndImpersonation).
+			Summary("End an impersonation session").
+			ReturnsField(
+				http.StatusOK,
+				constant.IMPERSONATION_FIELD_NAME,
+				model.ImpersonationSessionResponse{},
+			)
+		adminRoutes.GET("/:id/audit", m.impersonationHandler.GetImpersonationAudit).
+			Summary("List the audit log of an impersonation session").
+			Query(model.ImpersonationAuditRequest{}).
+			ReturnsField(
+				http.StatusOK,
+				constant.IMPERSONATION_AUDIT_FIELD_NAME,
+				[]model.ImpersonationAuditResponse{},
+			)
+	}
+
+	impersonationRoutes := openapi.NewGroup(
+		router.Group(constants.APIBaseUser+"/impersonation"),
+		"Impersonation",
+	)
+	{
+		impersonationRoutes.POST(
+			"/end",
+			middleware.CustomerAuth(),
+			m.impersonationHandler.EndCurrentImpersonation,
+		).
+			Summary("End the current impersonation session").
+			ReturnsField(
+				http.StatusOK,
+				constant.IMPERSONATION_FIELD_NAME,
+				model.ImpersonationSessionResponse{},
+			)
+	}
+}
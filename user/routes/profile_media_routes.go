@@ -0,0 +1,75 @@
+package routes
+
+import (
+	"net/http"
+
+	"ecommerce-be/common/constants"
+	"ecommerce-be/common/filegateway"
+	"ecommerce-be/common/middleware"
+	"ecommerce-be/common/openapi"
+	"ecommerce-be/user/factory/singleton"
+	"ecommerce-be/user/handler"
+	"ecommerce-be/user/model"
+	"ecommerce-be/user/utils/constant"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ProfileMediaModule handles avatar and store logo routes
+type ProfileMediaModule struct {
+	profileMediaHandler *handler.ProfileMediaHandler
+}
+
+// NewProfileMediaModule creates a new instance of ProfileMediaModule
+func NewProfileMediaModule() *ProfileMediaModule {
+	f := singleton.GetInstance()
+	return &ProfileMediaModule{
+		profileMediaHandler: f.GetProfileMediaHandler(),
+	}
+}
+
+// RegisterRoutes registers avatar and store logo routes. Uploading is two-step: the
+// upload route returns a presigned URL, and the set route completes it and applies the crop.
+func (m *ProfileMediaModule) RegisterRoutes(router *gin.Engine) {
+	avatarRoutes := openapi.NewGroup(router.Group(constants.APIBaseUser+"/avatar"), "Users")
+	avatarRoutes.Use(middleware.CustomerAuth())
+	{
+		avatarRoutes.POST("/upload", m.profileMediaHandler.InitAvatarUpload).
+			Summary("Start an avatar upload").
+			Description("Formats: JPEG, PNG, WebP. Maximum size 2 MB.").
+			Body(model.MediaUploadRequest{}).
+			ReturnsField(http.StatusCreated, constant.UPLOAD_FIELD_NAME, filegateway.UploadTicket{})
+		avatarRoutes.PUT("", m.profileMediaHandler.SetAvatar).
+			Summary("Set the caller's avatar").
+			Description("Completes the upload. The optional crop must be square.").
+			Body(model.MediaSetRequest{}).
+			ReturnsField(
+				http.StatusOK,
+				constant.AVATAR_FIELD_NAME,
+				filegateway.FileAssetResponse{},
+			)
+		avatarRoutes.DELETE("", m.profileMediaHandler.RemoveAvatar).
+			Summary("Remove the caller's avatar")
+	}
+
+	logoRoutes := openapi.NewGroup(
+		router.Group(constants.APIBaseUser+"/seller/logo"),
+		"Sellers",
+	)
+	logoRoutes.Use(middleware.SellerAuth())
+	{
+		logoRoutes.POST("/upload", m.profileMediaHandler.InitStoreLogoUpload).
+			Summary("Start a store logo upload").
+			Description("Formats: JPEG, PNG, WebP, SVG. Maximum size 3 MB.").
+			Body(model.MediaUploadRequest{}).
+			ReturnsField(http.StatusCreated, constant.UPLOAD_FIELD_NAME, filegateway.UploadTicket{})
+		logoRoutes.PUT("", m.profileMediaHandler.SetStoreLogo).
+			Summary("Set the store logo").
+			Body(model.MediaSetRequest{}).
+			ReturnsField(
+				http.StatusOK,
+				constant.STORE_LOGO_FIELD_NAME,
+				filegateway.FileAssetResponse{},
+			)
+	}
+}
@@ -0,0 +1,56 @@
+package routes
+
+import (
+	"net/http"
+
+	"ecommerce-be/common/constants"
+	"ecommerce-be/common/middleware"
+	"ecommerce-be/common/openapi"
+	"ecommerce-be/user/factory/singleton"
+	"ecommerce-be/user/handler"
+	"ecommerce-be/user/model"
+	"ecommerce-be/user/utils/constant"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SellerDomainModule handles custom storefront domain routes
+type SellerDomainModule struct {
+	sellerDomainHandler *handler.SellerDomainHandler
+}
+
+// NewSellerDomainModule creates a new instance of SellerDomainModule
+func NewSellerDomainModule() *SellerDomainModule {
+	f := singleton.GetInstance()
+	return &SellerDomainModule{
+		sellerDomainHandler: f.GetSellerDomainHandler(),
+	}
+}
+
+// RegisterRoutes registers admin routes mapping custom domains to seller storefronts
+func (m *SellerDomainModule) RegisterRoutes(router *gin.Engine) {
+	adminRoutes := openapi.NewGroup(
+		router.Group(constants.APIBaseUser+"/admin/sellers/:sellerId/domains"),
+		"Storefront",
+	)
+	adminRoutes.Use(middleware.AdminAuth())
+	{
+		adminRoutes.GET("", m.sellerDomainHandler.ListSellerDomains).
+			Summary("List a seller's storefront domains").
+			ReturnsField(
+				http.StatusOK,
+				constant.SELLER_DOMAINS_FIELD_NAME,
+				[]model.SellerDomainResponse{},
+			)
+		adminRoutes.POST("", m.sellerDomainHandler.CreateSellerDomain).
+			Summary("Map a custom domain to a seller's storefront").
+			Body(model.SellerDomainRequest{}).
+			ReturnsField(
+				http.StatusCreated,
+				constant.SELLER_DOMAIN_FIELD_NAME,
+				model.SellerDomainResponse{},
+			)
+		adminRoutes.DELETE("/:id", m.sellerDomainHandler.DeleteSellerDomain).
+			Summary("Remove a storefront domain")
+	}
+}
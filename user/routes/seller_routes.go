@@ -1,10 +1,15 @@
 package routes
 
 import (
+	"net/http"
+
 	"ecommerce-be/common/constants"
 	"ecommerce-be/common/middleware"
+	"ecommerce-be/common/openapi"
 	"ecommerce-be/user/factory/singleton"
 	"ecommerce-be/user/handler"
+	"ecommerce-be/user/model"
+	"ecommerce-be/user/utils/constant"
 
 	"github.com/gin-gonic/gin"
 )
@@ -25,17 +30,37 @@ func NewSellerModule() *SellerModule {
 // RegisterRoutes registers all seller related routes
 func (m *SellerModule) RegisterRoutes(router *gin.Engine) {
 	// Seller routes - /api/user/seller/*
-	sellerRoutes := router.Group(constants.APIBaseUser + "/seller")
+	sellerRoutes := openapi.NewGroup(router.Group(constants.APIBaseUser+"/seller"), "Sellers")
 	{
 		// Public route - no auth required
-		sellerRoutes.POST("/register", m.sellerHandler.RegisterSeller)
+		sellerRoutes.POST("/register", m.sellerHandler.RegisterSeller).
+			Summary("Register a seller account").
+			Body(model.SellerRegisterRequest{}).
+			ReturnsField(
+				http.StatusCreated,
+				constant.SELLER_FIELD_NAME,
+				model.SellerRegisterResponse{},
+			)
 
 		// Protected routes - require seller auth
 		protected := sellerRoutes.Group("")
 		protected.Use(middleware.SellerAuth())
 		{
-			protected.GET("/profile", m.sellerHandler.GetProfile)
-			protected.PUT("/profile", m.sellerHandler.UpdateProfile)
+			protected.GET("/profile", m.sellerHandler.GetProfile).
+				Summary("Get the seller profile").
+				ReturnsField(
+					http.StatusOK,
+					constant.SELLER_FIELD_NAME,
+					model.SellerFullProfileResponse{},
+				)
+			protected.PUT("/profile", m.sellerHandler.UpdateProfile).
+				Summary("Update the seller business profile").
+				Body(model.SellerProfileUpdateRequest{}).
+				ReturnsField(
+					http.StatusOK,
+					constant.PROFILE_FIELD_NAME,
+					model.SellerProfileResponse{},
+				)
 		}
 	}
 }
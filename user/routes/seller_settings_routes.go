@@ -1,10 +1,15 @@
 package routes
 
 import (
+	"net/http"
+
 	"ecommerce-be/common/constants"
 	"ecommerce-be/common/middleware"
+	"ecommerce-be/common/openapi"
 	"ecommerce-be/user/factory/singleton"
 	"ecommerce-be/user/handler"
+	"ecommerce-be/user/model"
+	"ecommerce-be/user/utils/constant"
 
 	"github.com/gin-gonic/gin"
 )
@@ -26,11 +31,34 @@ func NewSellerSettingsModule() *SellerSettingsModule {
 // Admin list/get-by-seller routes are deferred until repo/service support exists.
 func (m *SellerSettingsModule) RegisterRoutes(router *gin.Engine) {
 	sellerAuth := middleware.SellerAuth()
-	sellerRoutes := router.Group(constants.APIBaseUser + "/seller/settings")
+	sellerRoutes := openapi.NewGroup(
+		router.Group(constants.APIBaseUser+"/seller/settings"),
+		"Seller Settings",
+	)
 	sellerRoutes.Use(sellerAuth)
 	{
-		sellerRoutes.GET("", m.sellerSettingsHandler.GetSellerSettings)
-		sellerRoutes.POST("", m.sellerSettingsHandler.CreateSellerSettings)
-		sellerRoutes.PUT("", m.sellerSettingsHandler.UpdateSellerSettings)
+		sellerRoutes.GET("", m.sellerSettingsHandler.GetSellerSettings).
+			Summary("Get seller settings").
+			ReturnsField(
+				http.StatusOK,
+				constant.SELLER_SETTINGS_FIELD_NAME,
+				model.SellerSettingsResponse{},
+			)
+		sellerRoutes.POST("", m.sellerSettingsHandler.CreateSellerSettings).
+			Summary("Create seller settings").
+			Body(model.SellerSettingsCreateRequest{}).
+			ReturnsField(
+				http.StatusCreated,
+				constant.SELLER_SETTINGS_FIELD_NAME,
+				model.SellerSettingsResponse{},
+			)
+		sellerRoutes.PUT("", m.sellerSettingsHandler.UpdateSellerSettings).
+			Summary("Update seller settings").
+			Body(model.SellerSettingsUpdateRequest{}).
+			ReturnsField(
+				http.StatusOK,
+				constant.SELLER_SETTINGS_FIELD_NAME,
+				model.SellerSettingsResponse{},
+			)
 	}
 }
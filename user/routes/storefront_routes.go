@@ -0,0 +1,82 @@
+package routes
+
+import (
+	"net/http"
+
+	"ecommerce-be/common/config"
+	"ecommerce-be/common/constants"
+	"ecommerce-be/common/middleware"
+	"ecommerce-be/common/openapi"
+	"ecommerce-be/user/factory/singleton"
+	"ecommerce-be/user/handler"
+	"ecommerce-be/user/model"
+	"ecommerce-be/user/utils/constant"
+
+	"github.com/gin-gonic/gin"
+)
+
+// StorefrontModule handles seller storefront settings and the public storefront
+type StorefrontModule struct {
+	storefrontHandler *handler.StorefrontHandler
+}
+
+// NewStorefrontModule creates a new instance of StorefrontModule
+func NewStorefrontModule() *StorefrontModule {
+	f := singleton.GetInstance()
+	return &StorefrontModule{
+		storefrontHandler: f.GetStorefrontHandler(),
+	}
+}
+
+// RegisterRoutes registers the seller storefront CRUD routes and the public,
+// cached storefront route
+func (m *StorefrontModule) RegisterRoutes(router *gin.Engine) {
+	sellerRoutes := openapi.NewGroup(
+		router.Group(constants.APIBaseUser+"/seller/storefront"),
+		"Storefront",
+	)
+	sellerRoutes.Use(middleware.SellerAuth())
+	{
+		sellerRoutes.GET("", m.storefrontHandler.GetStorefront).
+			Summary("Get storefront settings").
+			ReturnsField(
+				http.StatusOK,
+				constant.STOREFRONT_FIELD_NAME,
+				model.StorefrontResponse{},
+			)
+		sellerRoutes.POST("", m.storefrontHandler.CreateStorefront).
+			Summary("Create storefront settings").
+			Body(model.StorefrontCreateRequest{}).
+			ReturnsField(
+				http.StatusCreated,
+				constant.STOREFRONT_FIELD_NAME,
+				model.StorefrontResponse{},
+			)
+		sellerRoutes.PUT("", m.storefrontHandler.UpdateStorefront).
+			Summary("Update storefront settings").
+			Body(model.StorefrontUpdateRequest{}).
+			ReturnsField(
+				http.StatusOK,
+				constant.STOREFRONT_FIELD_NAME,
+				model.StorefrontResponse{},
+			)
+		sellerRoutes.DELETE("", m.storefrontHandler.DeleteStorefront).
+			Summary("Delete storefront settings")
+	}
+
+	publicRoutes := openapi.NewGroup(router.Group(constants.APIBaseStorefront), "Storefront")
+	publicRoutes.Use(middleware.PublicAPIAuth())
+	{
+		publicRoutes.GET(
+			"",
+			middleware.ConditionalGET(config.Get().HTTPCache.StorefrontMaxAgeSec),
+			m.storefrontHandler.GetPublicStorefront,
+		).
+			Summary("Get the storefront of the seller in X-Seller-ID").
+			ReturnsField(
+				http.StatusOK,
+				constant.STOREFRONT_FIELD_NAME,
+				model.PublicStorefrontResponse{},
+			)
+	}
+}
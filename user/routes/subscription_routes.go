@@ -0,0 +1,78 @@
+package routes
+
+import (
+	"net/http"
+
+	"ecommerce-be/common/constants"
+	"ecommerce-be/common/middleware"
+	"ecommerce-be/common/openapi"
+	"ecommerce-be/user/factory/singleton"
+	"ecommerce-be/user/handler"
+	"ecommerce-be/user/model"
+	"ecommerce-be/user/utils/constant"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SubscriptionModule handles plan and seller subscription routes
+type SubscriptionModule struct {
+	subscriptionHandler *handler.SubscriptionHandler
+}
+
+// NewSubscriptionModule creates a new instance of SubscriptionModule
+func NewSubscriptionModule() *SubscriptionModule {
+	f := singleton.GetInstance()
+	return &SubscriptionModule{
+		subscriptionHandler: f.GetSubscriptionHandler(),
+	}
+}
+
+// RegisterRoutes registers plan, seller subscription and renewal webhook routes
+func (m *SubscriptionModule) RegisterRoutes(router *gin.Engine) {
+	// GET /api/user/plans - Public plan catalog
+	openapi.NewGroup(router.Group(constants.APIBaseUser+"/plans"), "Subscriptions").
+		GET("", m.subscriptionHandler.ListPlans).
+		Summary("List subscription plans with their limits and features").
+		ReturnsField(http.StatusOK, constant.PLANS_FIELD_NAME, []model.PlanResponse{})
+
+	// GET /api/user/seller/subscription - The signed-in seller's subscription
+	openapi.NewGroup(router.Group(constants.APIBaseUser+"/seller"), "Subscriptions").
+		GET(
+			"/subscription",
+			middleware.SellerAuth(),
+			m.subscriptionHandler.GetSellerSubscription,
+		).
+		Summary("Get the seller's subscription, plan limits and usage").
+		ReturnsField(
+			http.StatusOK,
+			constant.SUBSCRIPTION_FIELD_NAME,
+			model.SellerSubscriptionResponse{},
+		)
+
+	// PUT /api/user/admin/sellers/:sellerId/subscription - Admin plan assignment
+	openapi.NewGroup(router.Group(constants.APIBaseUser+"/admin/sellers"), "Subscriptions").
+		PUT(
+			"/:sellerId/subscription",
+			middleware.AdminAuth(),
+			m.subscriptionHandler.AssignSellerPlan,
+		).
+		Summary("Assign a seller's plan").
+		Description("A seller's first subscription starts the plan's trial when it has one. "+
+			"A subscription in force changes plan; a lapsed one starts a new paid period.").
+		Body(model.AssignPlanRequest{}).
+		ReturnsField(
+			http.StatusOK,
+			constant.SUBSCRIPTION_FIELD_NAME,
+			model.SellerSubscriptionResponse{},
+		)
+
+	// Renewal payment webhooks are authenticated by their HMAC signature
+	openapi.NewGroup(router.Group(constants.APIBaseUser+"/subscription/webhooks"), "Subscriptions").
+		POST("/payment", m.subscriptionHandler.ReceivePaymentWebhook).
+		Summary("Receive a subscription renewal payment webhook").
+		Description("Successful payments start the next billing period; failed payments "+
+			"mark the subscription past due until the grace period ends.").
+		Header(constant.SUBSCRIPTION_SIGNATURE_HEADER, "Hex HMAC-SHA256 of the request body").
+		Body(model.SubscriptionPaymentWebhookRequest{}).
+		Returns(http.StatusOK, model.SubscriptionPaymentWebhookResponse{})
+}
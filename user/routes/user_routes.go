@@ -1,10 +1,15 @@
 package routes
 
 import (
+	"net/http"
+
 	"ecommerce-be/common/constants"
 	"ecommerce-be/common/middleware"
+	"ecommerce-be/common/openapi"
 	"ecommerce-be/user/factory/singleton"
 	"ecommerce-be/user/handler"
+	"ecommerce-be/user/model"
+	"ecommerce-be/user/utils/constant"
 
 	"github.com/gin-gonic/gin"
 )
@@ -34,27 +39,46 @@ func (m *UserModule) RegisterRoutes(router *gin.Engine) {
 	sellerAuth := middleware.SellerAuth()
 
 	// Authentication routes - /api/user/auth/*
-	authRoutes := router.Group(constants.APIBaseUser + "/auth")
+	authRoutes := openapi.NewGroup(router.Group(constants.APIBaseUser+"/auth"), "Auth")
 	{
-		authRoutes.POST("/register", m.userHandler.Register)
+		authRoutes.POST("/register", m.userHandler.Register).
+			Summary("Register a customer account").
+			Body(model.UserRegisterRequest{}).
+			Returns(http.StatusCreated, model.AuthResponse{})
 
 		// TODO: Looks like in login response we not return the user role related information
-		authRoutes.POST("/login", m.userHandler.Login)
-		authRoutes.POST("/refresh", auth, m.userHandler.RefreshToken)
-		authRoutes.POST("/logout", auth, m.userHandler.Logout)
+		authRoutes.POST("/login", m.userHandler.Login).
+			Summary("Log in").
+			Body(model.UserLoginRequest{}).
+			Returns(http.StatusOK, model.AuthResponse{})
+		authRoutes.POST("/refresh", auth, m.userHandler.RefreshToken).
+			Summary("Refresh the access token").
+			Returns(http.StatusOK, model.TokenResponse{})
+		authRoutes.POST("/logout", auth, m.userHandler.Logout).
+			Summary("Log out")
 	}
 
 	// User routes - /api/user/*
-	userRoutes := router.Group(constants.APIBaseUser)
+	userRoutes := openapi.NewGroup(router.Group(constants.APIBaseUser), "Users")
 	{
 		// User profile routes (protected)
-		userRoutes.GET("/profile", auth, m.userHandler.GetProfile)
-		userRoutes.PUT("/profile", auth, m.userHandler.UpdateProfile)
-		userRoutes.PATCH("/password", auth, m.userHandler.ChangePassword)
+		userRoutes.GET("/profile", auth, m.userHandler.GetProfile).
+			Summary("Get the caller's profile").
+			ReturnsField(http.StatusOK, constant.USER_FIELD_NAME, model.ProfileResponse{})
+		userRoutes.PUT("/profile", auth, m.userHandler.UpdateProfile).
+			Summary("Update the caller's profile").
+			Body(model.UserUpdateRequest{}).
+			ReturnsField(http.StatusOK, constant.USER_FIELD_NAME, model.UserResponse{})
+		userRoutes.PATCH("/password", auth, m.userHandler.ChangePassword).
+			Summary("Change the caller's password").
+			Body(model.UserPasswordChangeRequest{})
 
 		// User query routes (seller or admin only)
 		// Sellers can only see users in their seller scope
 		// Admins can see all users
-		userRoutes.GET("", sellerAuth, m.userQueryHandler.ListUsers)
+		userRoutes.GET("", sellerAuth, m.userQueryHandler.ListUsers).
+			Summary("List users in the caller's scope").
+			Query(model.ListUsersQueryParams{}).
+			Returns(http.StatusOK, model.ListUsersResponse{})
 	}
 }
@@ -2,10 +2,15 @@ package service
 
 import (
 	"context"
+	"strings"
 
+	"ecommerce-be/user/entity"
+	userErrors "ecommerce-be/user/error"
 	"ecommerce-be/user/factory"
 	"ecommerce-be/user/model"
 	"ecommerce-be/user/repository"
+	"ecommerce-be/user/utils"
+	"ecommerce-be/user/utils/constant"
 )
 
 // AddressService defines the interface for address-related business logic
@@ -29,17 +34,27 @@ type AddressService interface {
 		addressID uint,
 		userID uint,
 	) (*model.AddressResponse, error)
+	SetDefaultBillingAddress(
+		ctx context.Context,
+		addressID uint,
+		userID uint,
+	) (*model.AddressResponse, error)
 }
 
 // AddressServiceImpl implements the AddressService interface
 type AddressServiceImpl struct {
 	addressRepo repository.AddressRepository
+	countryRepo repository.CountryRepository
 }
 
 // NewAddressService creates a new instance of AddressService
-func NewAddressService(addressRepo repository.AddressRepository) AddressService {
+func NewAddressService(
+	addressRepo repository.AddressRepository,
+	countryRepo repository.CountryRepository,
+) AddressService {
 	return &AddressServiceImpl{
 		addressRepo: addressRepo,
+		countryRepo: countryRepo,
 	}
 }
 
@@ -79,7 +94,7 @@ func (s *AddressServiceImpl) GetAddressByID(
 	return &addressResponse, nil
 }
 
-// AddAddress adds a new address for a user
+// AddAddress adds a new address for a user, up to MAX_ADDRESSES_PER_USER
 func (s *AddressServiceImpl) AddAddress(
 	ctx context.Context,
 	userID uint,
@@ -88,6 +103,19 @@ func (s *AddressServiceImpl) AddAddress(
 	// Build address entity using factory
 	address := factory.BuildAddressEntity(userID, req)
 
+	if !address.Type.IsLocationAddress() {
+		count, err := s.addressRepo.CountByUserID(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+		if count >= constant.MAX_ADDRESSES_PER_USER {
+			return nil, userErrors.ErrAddressLimitReached
+		}
+	}
+	if err := s.validateFields(ctx, address); err != nil {
+		return nil, err
+	}
+
 	if err := s.addressRepo.Create(ctx, address); err != nil {
 		return nil, err
 	}
@@ -113,6 +141,9 @@ func (s *AddressServiceImpl) UpdateAddress(
 
 	// Update address fields using factory (only non-nil fields)
 	factory.UpdateAddressEntity(address, req)
+	if err := s.validateFields(ctx, address); err != nil {
+		return nil, err
+	}
 
 	// Save changes to database
 	if err := s.addressRepo.Update(ctx, address); err != nil {
@@ -130,7 +161,7 @@ func (s *AddressServiceImpl) DeleteAddress(ctx context.Context, addressID uint,
 	return s.addressRepo.Delete(ctx, addressID, userID)
 }
 
-// SetDefaultAddress sets an address as the default address
+// SetDefaultAddress sets an address as the default (shipping) address
 func (s *AddressServiceImpl) SetDefaultAddress(
 	ctx context.Context,
 	addressID uint,
@@ -139,14 +170,33 @@ func (s *AddressServiceImpl) SetDefaultAddress(
 	if err := s.addressRepo.SetDefault(ctx, addressID, userID); err != nil {
 		return nil, err
 	}
+	return s.GetAddressByID(ctx, addressID, userID)
+}
 
-	address, err := s.addressRepo.FindByID(ctx, addressID, userID)
-	if err != nil {
+// SetDefaultBillingAddress sets an address as the default billing address
+func (s *AddressServiceImpl) SetDefaultBillingAddress(
+	ctx context.Context,
+	addressID uint,
+	userID uint,
+) (*model.AddressResponse, error) {
+	if err := s.addressRepo.SetDefaultBilling(ctx, addressID, userID); err != nil {
 		return nil, err
 	}
+	return s.GetAddressByID(ctx, addressID, userID)
+}
 
-	// Build response using factory
-	addressResponse := factory.BuildAddressResponse(address)
-
-	return &addressResponse, nil
+// validateFields checks the address against its country's conventions and stores the
+// normalized postal code
+func (s *AddressServiceImpl) validateFields(ctx context.Context, address *entity.Address) error {
+	country, err := s.countryRepo.FindByID(ctx, address.CountryID)
+	if err != nil {
+		return err
+	}
+	zipCode, err := utils.ValidateAddressFields(country, address.State, address.ZipCode)
+	if err != nil {
+		return err
+	}
+	address.State = strings.TrimSpace(address.State)
+	address.ZipCode = zipCode
+	return nil
 }
@@ -0,0 +1,224 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"ecommerce-be/common/auth"
+	"ecommerce-be/common/constants"
+	"ecommerce-be/common/db"
+	"ecommerce-be/user/entity"
+	userErrors "ecommerce-be/user/error"
+	"ecommerce-be/user/factory"
+	"ecommerce-be/user/model"
+	"ecommerce-be/user/repository"
+	"ecommerce-be/user/utils/constant"
+)
+
+// DelegatedTokenService defines business logic for seller-issued delegated tokens
+type DelegatedTokenService interface {
+	// Create mints a new token. The raw token is only ever returned here.
+	Create(
+		ctx context.Context,
+		sellerID uint,
+		actorUserID uint,
+		req model.CreateDelegatedTokenRequest,
+	) (*model.CreateDelegatedTokenResponse, error)
+
+	// List returns the seller's tokens without their secrets
+	List(
+		ctx context.Context,
+		sellerID uint,
+		req model.ListDelegatedTokensRequest,
+	) ([]model.DelegatedTokenResponse, error)
+
+	// Revoke disables an active token before its expiry
+	Revoke(
+		ctx context.Context,
+		sellerID uint,
+		actorUserID uint,
+		tokenID uint,
+	) (*model.DelegatedTokenResponse, error)
+
+	// GetAuditLog returns the most recent audit entries for a token
+	GetAuditLog(
+		ctx context.Context,
+		sellerID uint,
+		tokenID uint,
+		req model.DelegatedTokenAuditRequest,
+	) ([]model.DelegatedTokenAuditResponse, error)
+}
+
+// DelegatedTokenServiceImpl implements DelegatedTokenService
+type DelegatedTokenServiceImpl struct {
+	tokenRepo repository.DelegatedAccessTokenRepository
+}
+
+// NewDelegatedTokenService creates a new instance of DelegatedTokenService
+func NewDelegatedTokenService(
+	tokenRepo repository.DelegatedAccessTokenRepository,
+) DelegatedTokenService {
+	return &DelegatedTokenServiceImpl{tokenRepo: tokenRepo}
+}
+
+// Create mints a new delegated token and records the creation in its audit trail
+func (s *DelegatedTokenServiceImpl) Create(
+	ctx context.Context,
+	sellerID uint,
+	actorUserID uint,
+	req model.CreateDelegatedTokenRequest,
+) (*model.CreateDelegatedTokenResponse, error) {
+	scopes, err := normalizeDelegatedScopes(req.Scopes)
+	if err != nil {
+		return nil, err
+	}
+
+	activeCount, err := s.tokenRepo.CountActiveBySellerID(ctx, sellerID)
+	if err != nil {
+		return nil, err
+	}
+	if activeCount >= constant.DELEGATED_TOKEN_MAX_ACTIVE {
+		return nil, userErrors.ErrDelegatedTokenLimitReached
+	}
+
+	rawToken, tokenHash, tokenPrefix, err := auth.GenerateDelegatedToken()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	token := &entity.DelegatedAccessToken{
+		SellerID:        sellerID,
+		CreatedByUserID: actorUserID,
+		Name:            req.Name,
+		TokenHash:       tokenHash,
+		TokenPrefix:     tokenPrefix,
+		Scopes:          db.StringArray(scopes),
+		ExpiresAt:       now.Add(time.Duration(req.ExpiresInHours) * time.Hour),
+	}
+
+	err = db.WithTransaction(ctx, func(txCtx context.Context) error {
+		if err := s.tokenRepo.Create(txCtx, token); err != nil {
+			return err
+		}
+		return s.tokenRepo.CreateAudit(txCtx, &entity.DelegatedAccessTokenAudit{
+			TokenID:     token.ID,
+			SellerID:    sellerID,
+			Action:      constants.DELEGATED_AUDIT_ACTION_CREATED,
+			ActorUserID: &actorUserID,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.CreateDelegatedTokenResponse{
+		DelegatedTokenResponse: factory.BuildDelegatedTokenResponse(token, now),
+		Token:                  rawToken,
+	}, nil
+}
+
+// List returns the seller's delegated tokens
+func (s *DelegatedTokenServiceImpl) List(
+	ctx context.Context,
+	sellerID uint,
+	req model.ListDelegatedTokensRequest,
+) ([]model.DelegatedTokenResponse, error) {
+	tokens, err := s.tokenRepo.FindBySellerID(ctx, sellerID, req.IncludeInactive)
+	if err != nil {
+		return nil, err
+	}
+	return factory.BuildDelegatedTokenResponses(tokens, time.Now().UTC()), nil
+}
+
+// Revoke disables an active token and records the revocation in its audit trail
+func (s *DelegatedTokenServiceImpl) Revoke(
+	ctx context.Context,
+	sellerID uint,
+	actorUserID uint,
+	tokenID uint,
+) (*model.DelegatedTokenResponse, error) {
+	return db.WithTransactionResult(
+		ctx,
+		func(txCtx context.Context) (*model.DelegatedTokenResponse, error) {
+			token, err := s.tokenRepo.FindByIDAndSellerID(txCtx, tokenID, sellerID)
+			if err != nil {
+				return nil, err
+			}
+			if token == nil {
+				return nil, userErrors.ErrDelegatedTokenNotFound
+			}
+
+			revoked, err := s.tokenRepo.Revoke(txCtx, tokenID, sellerID, actorUserID)
+			if err != nil {
+				return nil, err
+			}
+			if !revoked {
+				return nil, userErrors.ErrDelegatedTokenAlreadyRevoked
+			}
+
+			if err := s.tokenRepo.CreateAudit(txCtx, &entity.DelegatedAccessTokenAudit{
+				TokenID:     tokenID,
+				SellerID:    sellerID,
+				Action:      constants.DELEGATED_AUDIT_ACTION_REVOKED,
+				ActorUserID: &actorUserID,
+			}); err != nil {
+				return nil, err
+			}
+
+			now := time.Now().UTC()
+			token.RevokedAt = &now
+			token.RevokedByUserID = &actorUserID
+			response := factory.BuildDelegatedTokenResponse(token, now)
+			return &response, nil
+		},
+	)
+}
+
+// GetAuditLog returns the most recent audit entries for one of the seller's tokens
+func (s *DelegatedTokenServiceImpl) GetAuditLog(
+	ctx context.Context,
+	sellerID uint,
+	tokenID uint,
+	req model.DelegatedTokenAuditRequest,
+) ([]model.DelegatedTokenAuditResponse, error) {
+	token, err := s.tokenRepo.FindByIDAndSellerID(ctx, tokenID, sellerID)
+	if err != nil {
+		return nil, err
+	}
+	if token == nil {
+		return nil, userErrors.ErrDelegatedTokenNotFound
+	}
+
+	limit := req.Limit
+	if limit <= 0 {
+		limit = constant.DELEGATED_TOKEN_AUDIT_PAGE_SIZE
+	}
+	if limit > constant.DELEGATED_TOKEN_AUDIT_MAX_PAGE_SIZE {
+		limit = constant.DELEGATED_TOKEN_AUDIT_MAX_PAGE_SIZE
+	}
+
+	entries, err := s.tokenRepo.FindAuditByTokenID(ctx, tokenID, limit)
+	if err != nil {
+		return nil, err
+	}
+	return factory.BuildDelegatedTokenAuditResponses(entries), nil
+}
+
+// normalizeDelegatedScopes validates and de-duplicates requested scopes,
+// preserving the caller's order
+func normalizeDelegatedScopes(requested []string) ([]string, error) {
+	seen := make(map[string]bool, len(requested))
+	scopes := make([]string, 0, len(requested))
+	for _, scope := range requested {
+		if !auth.IsValidDelegatedScope(scope) {
+			return nil, userErrors.ErrDelegatedTokenInvalidScope
+		}
+		if seen[scope] {
+			continue
+		}
+		seen[scope] = true
+		scopes = append(scopes, scope)
+	}
+	return scopes, nil
+}
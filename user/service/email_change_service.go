@@ -0,0 +1,403 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"ecommerce-be/common/cache"
+	"ecommerce-be/common/config"
+	"ecommerce-be/common/constants"
+	"ecommerce-be/common/db"
+	"ecommerce-be/common/log"
+	"ecommerce-be/common/notifier"
+	"ecommerce-be/user/entity"
+	userErrors "ecommerce-be/user/error"
+	"ecommerce-be/user/factory"
+	"ecommerce-be/user/model"
+	"ecommerce-be/user/repository"
+	"ecommerce-be/user/utils/constant"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// EmailChangeService defines the email change flow. A change takes effect only once
+// the current and the new address have both confirmed through their own link;
+// completing it signs the user out everywhere.
+type EmailChangeService interface {
+	// Request starts a change (replacing any open one) and emails a confirmation
+	// link to each address
+	Request(
+		ctx context.Context,
+		userID uint,
+		req model.RequestEmailChangeRequest,
+		ipAddress string,
+	) (*model.EmailChangeResponse, error)
+
+	// GetPending returns the user's open email change
+	GetPending(ctx context.Context, userID uint) (*model.EmailChangeResponse, error)
+
+	// Cancel withdraws the user's open email change
+	Cancel(ctx context.Context, userID uint, ipAddress string) error
+
+	// Confirm records the confirmation of the address the token was sent to and
+	// completes the change once both addresses have confirmed
+	Confirm(
+		ctx context.Context,
+		req model.ConfirmEmailChangeRequest,
+		ipAddress string,
+	) (*model.EmailChangeResponse, error)
+}
+
+// EmailChangeServiceImpl implements EmailChangeService
+type EmailChangeServiceImpl struct {
+	changeRepo repository.EmailChangeRequestRepository
+	userRepo   repository.UserRepository
+	notifier   notifier.Notifier
+}
+
+// NewEmailChangeService creates a new instance of EmailChangeService
+func NewEmailChangeService(
+	changeRepo repository.EmailChangeRequestRepository,
+	userRepo repository.UserRepository,
+	notifier notifier.Notifier,
+) EmailChangeService {
+	return &EmailChangeServiceImpl{
+		changeRepo: changeRepo,
+		userRepo:   userRepo,
+		notifier:   notifier,
+	}
+}
+
+// Request verifies the password, stores a new request with one token per address
+// and sends both confirmation links
+func (s *EmailChangeServiceImpl) Request(
+	ctx context.Context,
+	userID uint,
+	req model.RequestEmailChangeRequest,
+	ipAddress string,
+) (*model.EmailChangeResponse, error) {
+	user, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(req.CurrentPassword)); err != nil {
+		return nil, userErrors.ErrInvalidCurrentPassword
+	}
+	if strings.EqualFold(user.Email, req.NewEmail) {
+		return nil, userErrors.ErrEmailChangeSameEmail
+	}
+	if s.isEmailTaken(ctx, req.NewEmail, userID) {
+		return nil, userErrors.ErrEmailChangeEmailTaken
+	}
+
+	oldToken, oldTokenHash, err := generateEmailChangeToken()
+	if err != nil {
+		return nil, err
+	}
+	newToken, newTokenHash, err := generateEmailChangeToken()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	request := &entity.EmailChangeRequest{
+		UserID:       userID,
+		OldEmail:     user.Email,
+		NewEmail:     req.NewEmail,
+		OldTokenHash: oldTokenHash,
+		NewTokenHash: newTokenHash,
+		ExpiresAt:    now.Add(emailChangeTokenExpiry()),
+	}
+
+	err = db.WithTransaction(ctx, func(txCtx context.Context) error {
+		// Only the newest request can complete; older links stop working
+		if err := s.cancelOpen(txCtx, userID, ipAddress); err != nil {
+			return err
+		}
+		if err := s.changeRepo.Create(txCtx, request); err != nil {
+			return err
+		}
+		return s.audit(txCtx, request, constant.EMAIL_CHANGE_AUDIT_ACTION_REQUESTED, ipAddress)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.sendConfirmation(ctx, request, request.OldEmail, oldToken)
+	s.sendConfirmation(ctx, request, request.NewEmail, newToken)
+
+	response := factory.BuildEmailChangeResponse(request, now)
+	return &response, nil
+}
+
+// GetPending returns the user's open email change
+func (s *EmailChangeServiceImpl) GetPending(
+	ctx context.Context,
+	userID uint,
+) (*model.EmailChangeResponse, error) {
+	request, err := s.changeRepo.FindOpenByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if request == nil {
+		return nil, userErrors.ErrEmailChangeNotFound
+	}
+
+	response := factory.BuildEmailChangeResponse(request, time.Now().UTC())
+	return &response, nil
+}
+
+// Cancel withdraws the user's open email change
+func (s *EmailChangeServiceImpl) Cancel(ctx context.Context, userID uint, ipAddress string) error {
+	return db.WithTransaction(ctx, func(txCtx context.Context) error {
+		ids, err := s.changeRepo.CancelOpenByUserID(txCtx, userID)
+		if err != nil {
+			return err
+		}
+		if len(ids) == 0 {
+			return userErrors.ErrEmailChangeNotFound
+		}
+		return s.auditCancelled(txCtx, userID, ids, ipAddress)
+	})
+}
+
+// Confirm marks the token's address as confirmed. When both are confirmed the
+// user's email is switched, all sessions are revoked and both addresses are told.
+func (s *EmailChangeServiceImpl) Confirm(
+	ctx context.Context,
+	req model.ConfirmEmailChangeRequest,
+	ipAddress string,
+) (*model.EmailChangeResponse, error) {
+	tokenHash := hashEmailChangeToken(req.Token)
+
+	request, err := db.WithTransactionResult(
+		ctx,
+		func(txCtx context.Context) (*entity.EmailChangeRequest, error) {
+			request, err := s.changeRepo.FindByTokenHashForUpdate(txCtx, tokenHash)
+			if err != nil {
+				return nil, err
+			}
+			now := time.Now().UTC()
+			if request == nil || !request.IsOpen(now) {
+				return nil, userErrors.ErrEmailChangeInvalidToken
+			}
+
+			// Opening the same link twice confirms once
+			confirmedAt := &request.NewConfirmedAt
+			action := constant.EMAIL_CHANGE_AUDIT_ACTION_CONFIRMED_NEW
+			if tokenHash == request.OldTokenHash {
+				confirmedAt = &request.OldConfirmedAt
+				action = constant.EMAIL_CHANGE_AUDIT_ACTION_CONFIRMED_OLD
+			}
+			if *confirmedAt == nil {
+				*confirmedAt = &now
+				if err := s.audit(txCtx, request, action, ipAddress); err != nil {
+					return nil, err
+				}
+			}
+
+			if request.IsFullyConfirmed() {
+				if err := s.complete(txCtx, request, now, ipAddress); err != nil {
+					return nil, err
+				}
+			}
+
+			if err := s.changeRepo.Update(txCtx, request); err != nil {
+				return nil, err
+			}
+			return request, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if request.CompletedAt != nil {
+		if err := cache.RevokeUserSessions(request.UserID, sessionRevocationTTL()); err != nil {
+			log.ErrorWithContext(ctx, fmt.Sprintf(
+				"email change: failed to revoke sessions of user %d", request.UserID,
+			), err)
+		}
+		s.sendChanged(ctx, request, request.OldEmail)
+		s.sendChanged(ctx, request, request.NewEmail)
+	}
+
+	response := factory.BuildEmailChangeResponse(request, time.Now().UTC())
+	return &response, nil
+}
+
+// complete switches the user's email. It re-checks availability because another
+// account may have claimed the address while the links were outstanding.
+func (s *EmailChangeServiceImpl) complete(
+	ctx context.Context,
+	request *entity.EmailChangeRequest,
+	now time.Time,
+	ipAddress string,
+) error {
+	user, err := s.userRepo.FindByID(ctx, request.UserID)
+	if err != nil {
+		return err
+	}
+	if user.Email != request.OldEmail {
+		return userErrors.ErrEmailChangeInvalidToken
+	}
+	if s.isEmailTaken(ctx, request.NewEmail, request.UserID) {
+		return userErrors.ErrEmailChangeEmailTaken
+	}
+
+	user.Email = request.NewEmail
+	user.UpdatedAt = now
+	if err := s.userRepo.Update(ctx, user); err != nil {
+		return err
+	}
+
+	request.CompletedAt = &now
+	return s.audit(ctx, request, constant.EMAIL_CHANGE_AUDIT_ACTION_COMPLETED, ipAddress)
+}
+
+func (s *EmailChangeServiceImpl) cancelOpen(ctx context.Context, userID uint, ipAddress string) error {
+	ids, err := s.changeRepo.CancelOpenByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	return s.auditCancelled(ctx, userID, ids, ipAddress)
+}
+
+func (s *EmailChangeServiceImpl) auditCancelled(
+	ctx context.Context,
+	userID uint,
+	requestIDs []uint,
+	ipAddress string,
+) error {
+	for _, id := range requestIDs {
+		err := s.changeRepo.CreateAudit(ctx, &entity.EmailChangeAudit{
+			RequestID: id,
+			UserID:    userID,
+			Action:    constant.EMAIL_CHANGE_AUDIT_ACTION_CANCELLED,
+			IPAddress: optionalString(ipAddress),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *EmailChangeServiceImpl) audit(
+	ctx context.Context,
+	request *entity.EmailChangeRequest,
+	action string,
+	ipAddress string,
+) error {
+	return s.changeRepo.CreateAudit(ctx, &entity.EmailChangeAudit{
+		RequestID: request.ID,
+		UserID:    request.UserID,
+		Action:    action,
+		IPAddress: optionalString(ipAddress),
+	})
+}
+
+func (s *EmailChangeServiceImpl) isEmailTaken(ctx context.Context, email string, userID uint) bool {
+	existing, _ := s.userRepo.FindByEmail(ctx, email)
+	return existing != nil && existing.ID != userID
+}
+
+// sendConfirmation emails one address its confirmation link. Failures are logged;
+// the user can request the change again.
+func (s *EmailChangeServiceImpl) sendConfirmation(
+	ctx context.Context,
+	request *entity.EmailChangeRequest,
+	emailTo string,
+	token string,
+) {
+	s.notify(ctx, constants.NOTIFY_EVENT_EMAIL_CHANGE_CONFIRM, request, map[string]any{
+		constants.NOTIFY_EMAIL_TO_DATA_KEY: emailTo,
+		"OldEmail":                         request.OldEmail,
+		"NewEmail":                         request.NewEmail,
+		"ConfirmURL":                       emailChangeConfirmURL(token),
+		"ExpiresAt":                        request.ExpiresAt.UTC().Format(time.RFC1123),
+	})
+}
+
+// sendChanged tells one address that the change went through
+func (s *EmailChangeServiceImpl) sendChanged(
+	ctx context.Context,
+	request *entity.EmailChangeRequest,
+	emailTo string,
+) {
+	s.notify(ctx, constants.NOTIFY_EVENT_EMAIL_CHANGED, request, map[string]any{
+		constants.NOTIFY_EMAIL_TO_DATA_KEY: emailTo,
+		"OldEmail":                         request.OldEmail,
+		"NewEmail":                         request.NewEmail,
+	})
+}
+
+func (s *EmailChangeServiceImpl) notify(
+	ctx context.Context,
+	event string,
+	request *entity.EmailChangeRequest,
+	payload map[string]any,
+) {
+	if err := s.notifier.Notify(ctx, event, request.UserID, payload); err != nil {
+		log.ErrorWithContext(ctx, fmt.Sprintf(
+			"email change: %s notification for request %d failed", event, request.ID,
+		), err)
+	}
+}
+
+// generateEmailChangeToken creates a raw confirmation token and its storage hash
+func generateEmailChangeToken() (token, hash string, err error) {
+	buf := make([]byte, constant.EMAIL_CHANGE_TOKEN_RANDOM_BYTES)
+	if _, err = rand.Read(buf); err != nil {
+		return "", "", err
+	}
+	token = constant.EMAIL_CHANGE_TOKEN_PREFIX + base64.RawURLEncoding.EncodeToString(buf)
+	return token, hashEmailChangeToken(token), nil
+}
+
+func hashEmailChangeToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func emailChangeConfirmURL(token string) string {
+	base := ""
+	if cfg := config.Get(); cfg != nil {
+		base = cfg.Auth.EmailChangeConfirmURL
+	}
+	separator := "?"
+	if strings.Contains(base, "?") {
+		separator = "&"
+	}
+	return base + separator + constant.EMAIL_CHANGE_TOKEN_QUERY_PARAM + "=" + url.QueryEscape(token)
+}
+
+func emailChangeTokenExpiry() time.Duration {
+	if cfg := config.Get(); cfg != nil && cfg.Auth.EmailChangeTokenTTLHours > 0 {
+		return cfg.Auth.EmailChangeTokenExpiry()
+	}
+	return 24 * time.Hour
+}
+
+// sessionRevocationTTL keeps the revocation marker until every token issued before
+// it has expired on its own
+func sessionRevocationTTL() time.Duration {
+	if cfg := config.Get(); cfg != nil && cfg.Auth.JWTExpiryHours > 0 {
+		return cfg.Auth.TokenExpiry()
+	}
+	return 24 * time.Hour
+}
+
+func optionalString(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
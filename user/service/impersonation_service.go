@@ -0,0 +1,200 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"ecommerce-be/common/auth"
+	"ecommerce-be/common/config"
+	"ecommerce-be/common/constants"
+	"ecommerce-be/common/db"
+	"ecommerce-be/user/entity"
+	userErrors "ecommerce-be/user/error"
+	"ecommerce-be/user/factory"
+	"ecommerce-be/user/model"
+	"ecommerce-be/user/repository"
+	"ecommerce-be/user/utils/constant"
+)
+
+// ImpersonationService defines business logic for admin impersonation of sellers
+type ImpersonationService interface {
+	// Start opens a session and issues its token. The token is only ever returned here.
+	Start(
+		ctx context.Context,
+		adminUserID uint,
+		req model.StartImpersonationRequest,
+	) (*model.StartImpersonationResponse, error)
+
+	// End closes an active session, revoking its token
+	End(
+		ctx context.Context,
+		sessionID uint,
+		actorUserID uint,
+	) (*model.ImpersonationSessionResponse, error)
+
+	// GetAuditLog returns the most recent audit entries for a session
+	GetAuditLog(
+		ctx context.Context,
+		sessionID uint,
+		req model.ImpersonationAuditRequest,
+	) ([]model.ImpersonationAuditResponse, error)
+}
+
+// ImpersonationServiceImpl implements ImpersonationService
+type ImpersonationServiceImpl struct {
+	sessionRepo repository.ImpersonationSessionRepository
+	userRepo    repository.UserRepository
+}
+
+// NewImpersonationService creates a new instance of ImpersonationService
+func NewImpersonationService(
+	sessionRepo repository.ImpersonationSessionRepository,
+	userRepo repository.UserRepository,
+) ImpersonationService {
+	return &ImpersonationServiceImpl{
+		sessionRepo: sessionRepo,
+		userRepo:    userRepo,
+	}
+}
+
+// Start opens an impersonation session for an active seller account, records the
+// start in its audit trail and issues a token that expires with the session
+func (s *ImpersonationServiceImpl) Start(
+	ctx context.Context,
+	adminUserID uint,
+	req model.StartImpersonationRequest,
+) (*model.StartImpersonationResponse, error) {
+	seller, role, err := s.userRepo.FindByIDWithRole(ctx, req.SellerID)
+	if err != nil || role == nil || role.Name != entity.SELLER_ROLE ||
+		!seller.IsActive || seller.SellerID != seller.ID {
+		return nil, userErrors.ErrImpersonationSellerNotFound
+	}
+
+	duration := req.DurationMinutes
+	if duration <= 0 {
+		duration = constant.IMPERSONATION_DEFAULT_DURATION_MINUTES
+	}
+	if duration > constant.IMPERSONATION_MAX_DURATION_MINUTES {
+		duration = constant.IMPERSONATION_MAX_DURATION_MINUTES
+	}
+
+	now := time.Now().UTC()
+	session := &entity.ImpersonationSession{
+		AdminUserID: adminUserID,
+		SellerID:    seller.ID,
+		Reason:      req.Reason,
+		ExpiresAt:   now.Add(time.Duration(duration) * time.Minute),
+	}
+
+	err = db.WithTransaction(ctx, func(txCtx context.Context) error {
+		if err := s.sessionRepo.Create(txCtx, session); err != nil {
+			return err
+		}
+		return s.sessionRepo.CreateAudit(txCtx, &entity.ImpersonationAudit{
+			SessionID:   session.ID,
+			AdminUserID: adminUserID,
+			SellerID:    seller.ID,
+			Action:      constants.IMPERSONATION_AUDIT_ACTION_STARTED,
+			ActorUserID: &adminUserID,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := auth.GenerateImpersonationToken(
+		auth.TokenUserInfo{
+			UserID:    seller.ID,
+			Email:     seller.Email,
+			RoleID:    seller.RoleID,
+			RoleName:  role.Name.ToString(),
+			RoleLevel: role.Level.ToUint(),
+			SellerID:  &seller.ID,
+		},
+		session.ID,
+		adminUserID,
+		session.ExpiresAt,
+		config.Get().Auth.JWTSecret,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.StartImpersonationResponse{
+		ImpersonationSessionResponse: factory.BuildImpersonationSessionResponse(session, now),
+		Token:                        token,
+	}, nil
+}
+
+// End closes an active session and records the end in its audit trail
+func (s *ImpersonationServiceImpl) End(
+	ctx context.Context,
+	sessionID uint,
+	actorUserID uint,
+) (*model.ImpersonationSessionResponse, error) {
+	return db.WithTransactionResult(
+		ctx,
+		func(txCtx context.Context) (*model.ImpersonationSessionResponse, error) {
+			session, err := s.sessionRepo.FindByID(txCtx, sessionID)
+			if err != nil {
+				return nil, err
+			}
+			if session == nil {
+				return nil, userErrors.ErrImpersonationNotFound
+			}
+
+			ended, err := s.sessionRepo.End(txCtx, sessionID, actorUserID)
+			if err != nil {
+				return nil, err
+			}
+			if !ended {
+				return nil, userErrors.ErrImpersonationAlreadyEnded
+			}
+
+			if err := s.sessionRepo.CreateAudit(txCtx, &entity.ImpersonationAudit{
+				SessionID:   sessionID,
+				AdminUserID: session.AdminUserID,
+				SellerID:    session.SellerID,
+				Action:      constants.IMPERSONATION_AUDIT_ACTION_ENDED,
+				ActorUserID: &actorUserID,
+			}); err != nil {
+				return nil, err
+			}
+
+			now := time.Now().UTC()
+			session.EndedAt = &now
+			session.EndedByUserID = &actorUserID
+			response := factory.BuildImpersonationSessionResponse(session, now)
+			return &response, nil
+		},
+	)
+}
+
+// GetAuditLog returns the most recent audit entries for a session
+func (s *ImpersonationServiceImpl) GetAuditLog(
+	ctx context.Context,
+	sessionID uint,
+	req model.ImpersonationAuditRequest,
+) ([]model.ImpersonationAuditResponse, error) {
+	session, err := s.sessionRepo.FindByID(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	if session == nil {
+		return nil, userErrors.ErrImpersonationNotFound
+	}
+
+	limit := req.Limit
+	if limit <= 0 {
+		limit = constant.IMPERSONATION_AUDIT_PAGE_SIZE
+	}
+	if limit > constant.IMPERSONATION_AUDIT_MAX_PAGE_SIZE {
+		limit = constant.IMPERSONATION_AUDIT_MAX_PAGE_SIZE
+	}
+
+	entries, err := s.sessionRepo.FindAuditBySessionID(ctx, sessionID, limit)
+	if err != nil {
+		return nil, err
+	}
+	return factory.BuildImpersonationAuditResponses(entries), nil
+}
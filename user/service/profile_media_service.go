@@ -0,0 +1,300 @@
+package service
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	commonError "ecommerce-be/common/error"
+	"ecommerce-be/common/filegateway"
+	"ecommerce-be/common/log"
+	fileGateway "ecommerce-be/file/gateway"
+	"ecommerce-be/user/entity"
+	userErrors "ecommerce-be/user/error"
+	"ecommerce-be/user/factory"
+	"ecommerce-be/user/model"
+	"ecommerce-be/user/repository"
+	"ecommerce-be/user/utils/constant"
+)
+
+// ProfileMediaService manages user avatars and seller store logos. Bytes go straight to
+// storage through a presigned URL; this service validates the result and stores the
+// file reference with its crop.
+type ProfileMediaService interface {
+	// InitAvatarUpload validates the image metadata and returns a presigned upload ticket
+	InitAvatarUpload(
+		ctx context.Context,
+		userID uint,
+		req model.MediaUploadRequest,
+	) (*filegateway.UploadTicket, error)
+
+	// SetAvatar completes the upload and makes it the user's avatar
+	SetAvatar(
+		ctx context.Context,
+		userID uint,
+		req model.MediaSetRequest,
+	) (*filegateway.FileAssetResponse, error)
+
+	// RemoveAvatar clears the user's avatar and deletes the file
+	RemoveAvatar(ctx context.Context, userID uint) error
+
+	// InitStoreLogoUpload validates the image metadata and returns a presigned upload ticket
+	InitStoreLogoUpload(
+		ctx context.Context,
+		sellerID uint,
+		userID uint,
+		req model.MediaUploadRequest,
+	) (*filegateway.UploadTicket, error)
+
+	// SetStoreLogo completes the upload and makes it the seller's store logo
+	SetStoreLogo(
+		ctx context.Context,
+		sellerID uint,
+		req model.MediaSetRequest,
+	) (*filegateway.FileAssetResponse, error)
+}
+
+// mediaPolicy is the size/format contract for one kind of profile image
+type mediaPolicy struct {
+	purpose      string
+	maxSizeBytes int64
+	mimeTypes    []string
+	squareCrop   bool
+	invalidFile  error
+}
+
+var (
+	avatarPolicy = mediaPolicy{
+		purpose:      constant.AVATAR_FILE_PURPOSE,
+		maxSizeBytes: constant.AVATAR_MAX_SIZE_BYTES,
+		mimeTypes:    constant.AVATAR_ALLOWED_MIME_TYPES,
+		squareCrop:   true,
+		invalidFile:  userErrors.ErrInvalidAvatarFile,
+	}
+	storeLogoPolicy = mediaPolicy{
+		purpose:      constant.STORE_LOGO_FILE_PURPOSE,
+		maxSizeBytes: constant.STORE_LOGO_MAX_SIZE_BYTES,
+		mimeTypes:    constant.STORE_LOGO_ALLOWED_MIME_TYPES,
+		invalidFile:  userErrors.ErrInvalidBusinessLogoFile,
+	}
+)
+
+// ProfileMediaServiceImpl implements the ProfileMediaService interface
+type ProfileMediaServiceImpl struct {
+	userRepo          repository.UserRepository
+	sellerProfileRepo repository.SellerProfileRepository
+	fileGateway       filegateway.FileUploadGateway
+}
+
+// NewProfileMediaService creates a new instance of ProfileMediaService
+func NewProfileMediaService(
+	userRepo repository.UserRepository,
+	sellerProfileRepo repository.SellerProfileRepository,
+	fileGateway filegateway.FileUploadGateway,
+) ProfileMediaService {
+	return &ProfileMediaServiceImpl{
+		userRepo:          userRepo,
+		sellerProfileRepo: sellerProfileRepo,
+		fileGateway:       fileGateway,
+	}
+}
+
+// InitAvatarUpload starts a public USER_AVATAR upload. Avatars are platform-scoped so they
+// resolve the same way for customers and sellers.
+func (s *ProfileMediaServiceImpl) InitAvatarUpload(
+	ctx context.Context,
+	userID uint,
+	req model.MediaUploadRequest,
+) (*filegateway.UploadTicket, error) {
+	if err := avatarPolicy.validate(req.MimeType, req.SizeBytes); err != nil {
+		return nil, err
+	}
+	return s.fileGateway.InitUpload(ctx, filegateway.UploadRequest{
+		Purpose:        avatarPolicy.purpose,
+		Filename:       req.Filename,
+		MimeType:       req.MimeType,
+		SizeBytes:      req.SizeBytes,
+		UploaderUserID: userID,
+		Public:         true,
+	})
+}
+
+func (s *ProfileMediaServiceImpl) SetAvatar(
+	ctx context.Context,
+	userID uint,
+	req model.MediaSetRequest,
+) (*filegateway.FileAssetResponse, error) {
+	user, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, userErrors.ErrUserNotFound
+	}
+
+	info, err := s.completeMedia(ctx, avatarPolicy, req, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	previous := user.AvatarFileID
+	user.AvatarFileID = &req.FileID
+	user.AvatarCrop = factory.BuildImageCropEntity(req.Crop)
+	if err := s.userRepo.Update(ctx, user); err != nil {
+		return nil, err
+	}
+
+	s.deleteReplaced(ctx, previous, req.FileID, nil)
+	return factory.BuildMediaAssetResponse(filegateway.ToFileAssetResponse(info), user.AvatarCrop), nil
+}
+
+func (s *ProfileMediaServiceImpl) RemoveAvatar(ctx context.Context, userID uint) error {
+	user, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return userErrors.ErrUserNotFound
+	}
+	if user.AvatarFileID == nil || *user.AvatarFileID == "" {
+		return userErrors.ErrAvatarNotSet
+	}
+
+	previous := user.AvatarFileID
+	user.AvatarFileID = nil
+	user.AvatarCrop = nil
+	if err := s.userRepo.Update(ctx, user); err != nil {
+		return err
+	}
+
+	s.deleteReplaced(ctx, previous, "", nil)
+	return nil
+}
+
+// InitStoreLogoUpload starts a public SELLER_LOGO upload owned by the seller
+func (s *ProfileMediaServiceImpl) InitStoreLogoUpload(
+	ctx context.Context,
+	sellerID uint,
+	userID uint,
+	req model.MediaUploadRequest,
+) (*filegateway.UploadTicket, error) {
+	if err := storeLogoPolicy.validate(req.MimeType, req.SizeBytes); err != nil {
+		return nil, err
+	}
+	return s.fileGateway.InitUpload(ctx, filegateway.UploadRequest{
+		Purpose:        storeLogoPolicy.purpose,
+		Filename:       req.Filename,
+		MimeType:       req.MimeType,
+		SizeBytes:      req.SizeBytes,
+		UploaderUserID: userID,
+		SellerID:       &sellerID,
+		Public:         true,
+	})
+}
+
+func (s *ProfileMediaServiceImpl) SetStoreLogo(
+	ctx context.Context,
+	sellerID uint,
+	req model.MediaSetRequest,
+) (*filegateway.FileAssetResponse, error) {
+	profile, err := s.sellerProfileRepo.FindByUserID(ctx, sellerID)
+	if err != nil {
+		return nil, userErrors.ErrSellerProfileNotFound
+	}
+
+	info, err := s.completeMedia(ctx, storeLogoPolicy, req, &sellerID)
+	if err != nil {
+		return nil, err
+	}
+
+	previous := profile.BusinessLogoFileID
+	profile.BusinessLogoFileID = &req.FileID
+	profile.BusinessLogoCrop = factory.BuildImageCropEntity(req.Crop)
+	if err := s.sellerProfileRepo.Update(ctx, profile); err != nil {
+		return nil, userErrors.ErrProfileUpdateFailed
+	}
+
+	s.deleteReplaced(ctx, previous, req.FileID, &sellerID)
+	return factory.BuildMediaAssetResponse(
+		filegateway.ToFileAssetResponse(info),
+		profile.BusinessLogoCrop,
+	), nil
+}
+
+// completeMedia activates the uploaded file and checks it against the policy. The stored
+// metadata is re-checked because the upload URL only bounds what the client declared.
+func (s *ProfileMediaServiceImpl) completeMedia(
+	ctx context.Context,
+	policy mediaPolicy,
+	req model.MediaSetRequest,
+	sellerID *uint,
+) (*filegateway.FileDisplayInfo, error) {
+	if err := policy.validateCrop(req.Crop); err != nil {
+		return nil, err
+	}
+
+	info, err := s.fileGateway.CompleteUpload(ctx, req.FileID, sellerID)
+	if err != nil {
+		if fileGateway.IsFileNotFound(err) || err == commonError.ErrFileNotAccessible {
+			return nil, policy.invalidFile
+		}
+		return nil, err
+	}
+	if info.Purpose != policy.purpose {
+		return nil, policy.invalidFile
+	}
+	if err := policy.validate(info.MimeType, info.SizeBytes); err != nil {
+		return nil, err
+	}
+	return info, nil
+}
+
+// deleteReplaced removes the file a profile no longer references. Failures only leave an
+// orphaned file behind, so they are logged rather than returned.
+func (s *ProfileMediaServiceImpl) deleteReplaced(
+	ctx context.Context,
+	previous *string,
+	current string,
+	sellerID *uint,
+) {
+	if previous == nil || *previous == "" || *previous == current {
+		return
+	}
+	if err := s.fileGateway.DeleteFile(ctx, *previous, sellerID); err != nil {
+		log.WarnWithContext(
+			ctx,
+			"profile media cleanup failed fileId="+*previous+" reason="+err.Error(),
+		)
+	}
+}
+
+func (p mediaPolicy) validate(mimeType string, sizeBytes int64) error {
+	if !slices.Contains(p.mimeTypes, strings.ToLower(strings.TrimSpace(mimeType))) {
+		return userErrors.ErrInvalidMediaFormat
+	}
+	if sizeBytes > p.maxSizeBytes {
+		return userErrors.ErrMediaTooLarge
+	}
+	return nil
+}
+
+func (p mediaPolicy) validateCrop(crop *filegateway.ImageCrop) error {
+	if crop == nil {
+		return nil
+	}
+	if crop.Width <= 0 || crop.Height <= 0 || crop.X < 0 || crop.Y < 0 {
+		return userErrors.ErrInvalidMediaCrop
+	}
+	if p.squareCrop && crop.Width != crop.Height {
+		return userErrors.ErrInvalidMediaCrop
+	}
+	return nil
+}
+
+// resolveProfileMedia resolves a stored file reference with its crop for read paths
+func resolveProfileMedia(
+	ctx context.Context,
+	gw filegateway.FileDisplayGateway,
+	fileID *string,
+	crop *entity.ImageCrop,
+	sellerID *uint,
+) *filegateway.FileAssetResponse {
+	return factory.BuildMediaAssetResponse(
+		filegateway.ResolveOptional(ctx, gw, fileID, sellerID),
+		crop,
+	)
+}
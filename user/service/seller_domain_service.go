@@ -0,0 +1,141 @@
+package service
+
+import (
+	"context"
+
+	"ecommerce-be/common/auth"
+	"ecommerce-be/common/cache"
+	"ecommerce-be/common/db"
+	"ecommerce-be/common/log"
+	"ecommerce-be/user/entity"
+	userErrors "ecommerce-be/user/error"
+	"ecommerce-be/user/factory"
+	"ecommerce-be/user/model"
+	"ecommerce-be/user/repository"
+)
+
+// SellerDomainService defines business logic for custom storefront domains
+type SellerDomainService interface {
+	// List returns the seller's storefront domains
+	List(ctx context.Context, sellerID uint) ([]model.SellerDomainResponse, error)
+
+	// Create maps a domain to the seller
+	Create(
+		ctx context.Context,
+		sellerID uint,
+		req model.SellerDomainRequest,
+	) (*model.SellerDomainResponse, error)
+
+	// Delete removes one of the seller's domains
+	Delete(ctx context.Context, sellerID uint, domainID uint) error
+}
+
+// SellerDomainServiceImpl implements SellerDomainService
+type SellerDomainServiceImpl struct {
+	domainRepo repository.SellerDomainRepository
+	userRepo   repository.UserRepository
+}
+
+// NewSellerDomainService creates a new instance of SellerDomainService
+func NewSellerDomainService(
+	domainRepo repository.SellerDomainRepository,
+	userRepo repository.UserRepository,
+) SellerDomainService {
+	return &SellerDomainServiceImpl{
+		domainRepo: domainRepo,
+		userRepo:   userRepo,
+	}
+}
+
+// List returns the seller's storefront domains
+func (s *SellerDomainServiceImpl) List(
+	ctx context.Context,
+	sellerID uint,
+) ([]model.SellerDomainResponse, error) {
+	if err := s.ensureSeller(ctx, sellerID); err != nil {
+		return nil, err
+	}
+
+	domains, err := s.domainRepo.FindBySellerID(ctx, sellerID)
+	if err != nil {
+		return nil, err
+	}
+	return factory.BuildSellerDomainResponses(domains), nil
+}
+
+// Create maps a domain to the seller. The domain is normalized the same way the
+// public API middleware normalizes the request Host, and may belong to one seller only.
+func (s *SellerDomainServiceImpl) Create(
+	ctx context.Context,
+	sellerID uint,
+	req model.SellerDomainRequest,
+) (*model.SellerDomainResponse, error) {
+	if err := s.ensureSeller(ctx, sellerID); err != nil {
+		return nil, err
+	}
+
+	domain := auth.NormalizeHost(req.Domain)
+	existing, err := s.domainRepo.FindByDomain(ctx, domain)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, userErrors.ErrSellerDomainTaken
+	}
+
+	sellerDomain := &entity.SellerDomain{
+		SellerID: sellerID,
+		Domain:   domain,
+	}
+	if err := s.domainRepo.Create(ctx, sellerDomain); err != nil {
+		return nil, err
+	}
+
+	// The host may be cached as unmapped from an earlier public request
+	invalidateSellerDomainCache(ctx, domain)
+	response := factory.BuildSellerDomainResponse(sellerDomain)
+	return &response, nil
+}
+
+// Delete removes one of the seller's domains; public requests on it stop resolving
+// to the seller immediately
+func (s *SellerDomainServiceImpl) Delete(
+	ctx context.Context,
+	sellerID uint,
+	domainID uint,
+) error {
+	sellerDomain, err := s.domainRepo.FindByIDAndSellerID(ctx, domainID, sellerID)
+	if err != nil {
+		return err
+	}
+	if sellerDomain == nil {
+		return userErrors.ErrSellerDomainNotFound
+	}
+
+	if err := s.domainRepo.Delete(ctx, sellerDomain); err != nil {
+		return err
+	}
+
+	invalidateSellerDomainCache(ctx, sellerDomain.Domain)
+	return nil
+}
+
+// ensureSeller checks that the user is a seller account
+func (s *SellerDomainServiceImpl) ensureSeller(ctx context.Context, sellerID uint) error {
+	seller, role, err := s.userRepo.FindByIDWithRole(ctx, sellerID)
+	if err != nil || role == nil || role.Name != entity.SELLER_ROLE ||
+		seller.SellerID != seller.ID {
+		return userErrors.ErrSellerDomainSellerNotFound
+	}
+	return nil
+}
+
+// invalidateSellerDomainCache evicts the cached seller of a domain once the current
+// transaction commits. Failures are logged only; the entry expires with its TTL.
+func invalidateSellerDomainCache(ctx context.Context, domain string) {
+	db.AfterCommit(ctx, func() {
+		if err := cache.InvalidateSellerDomainCache(domain); err != nil {
+			log.WarnWithContext(ctx, "invalidateSellerDomainCache: "+err.Error())
+		}
+	})
+}
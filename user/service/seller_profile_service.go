@@ -76,6 +76,10 @@ func (s *SellerProfileServiceImpl) UpdateProfile(
 				return nil, err
 			}
 		}
+		if profile.BusinessLogoFileID == nil || *profile.BusinessLogoFileID != *req.BusinessLogoFileID {
+			// A crop only applies to the image it was framed on
+			profile.BusinessLogoCrop = nil
+		}
 		profile.BusinessLogoFileID = req.BusinessLogoFileID
 	}
 	if req.TaxID != nil {
@@ -86,6 +90,12 @@ func (s *SellerProfileServiceImpl) UpdateProfile(
 		return nil, userErrors.ErrProfileUpdateFailed
 	}
 
-	logo := filegateway.ResolveOptional(ctx, s.fileGateway, profile.BusinessLogoFileID, &userID)
+	logo := resolveProfileMedia(
+		ctx,
+		s.fileGateway,
+		profile.BusinessLogoFileID,
+		profile.BusinessLogoCrop,
+		&userID,
+	)
 	return factory.BuildSellerProfileResponsePtr(profile, logo), nil
 }
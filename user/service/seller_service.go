@@ -6,9 +6,12 @@ import (
 
 	"ecommerce-be/common/constants"
 	commonEntity "ecommerce-be/common/db"
+	"ecommerce-be/common/encryption"
 	commonError "ecommerce-be/common/error"
 	"ecommerce-be/common/filegateway"
 	db "ecommerce-be/common/db"
+	"ecommerce-be/common/log"
+	"ecommerce-be/common/screening"
 	fileGateway "ecommerce-be/file/gateway"
 	"ecommerce-be/user/entity"
 	userErrors "ecommerce-be/user/error"
@@ -36,7 +39,10 @@ type SellerServiceImpl struct {
 	sellerSettingsService SellerSettingsService
 	sellerProfileRepo     repository.SellerProfileRepository
 	userRepo              repository.UserRepository
+	countryRepo           repository.CountryRepository
 	fileGateway           filegateway.FileDisplayGateway
+	screener              screening.Screener
+	dataKeys              encryption.Service
 }
 
 // NewSellerService creates a new instance of SellerService
@@ -45,14 +51,20 @@ func NewSellerService(
 	sellerSettingsService SellerSettingsService,
 	userRepo repository.UserRepository,
 	sellerProfileRepo repository.SellerProfileRepository,
+	countryRepo repository.CountryRepository,
 	fileGateway filegateway.FileDisplayGateway,
+	screener screening.Screener,
+	dataKeys encryption.Service,
 ) SellerService {
 	return &SellerServiceImpl{
 		userService:           userService,
 		sellerSettingsService: sellerSettingsService,
 		userRepo:              userRepo,
 		sellerProfileRepo:     sellerProfileRepo,
+		countryRepo:           countryRepo,
 		fileGateway:           fileGateway,
+		screener:              screener,
+		dataKeys:              dataKeys,
 	}
 }
 
@@ -64,12 +76,45 @@ func (s *SellerServiceImpl) RegisterSeller(
 		return nil, err
 	}
 
-	return db.WithTransactionResult(
+	resp, err := db.WithTransactionResult(
 		ctx,
 		func(txCtx context.Context) (*model.SellerRegisterResponse, error) {
 			return s.executeRegistration(txCtx, req)
 		},
 	)
+	if err != nil {
+		return nil, err
+	}
+
+	s.screenSeller(ctx, req, resp.User.ID)
+	return resp, nil
+}
+
+// screenSeller checks the new seller against the blocked-party lists. A hit only
+// queues the seller for compliance review, so registration itself never fails here.
+func (s *SellerServiceImpl) screenSeller(
+	ctx context.Context,
+	req model.SellerRegisterRequest,
+	sellerID uint,
+) {
+	subject := screening.Subject{
+		Type:        screening.SUBJECT_SELLER_ONBOARDING,
+		ReferenceID: sellerID,
+		SellerID:    sellerID,
+		Names: []string{
+			req.Profile.BusinessName,
+			req.User.FirstName + " " + req.User.LastName,
+		},
+	}
+	if req.Settings != nil {
+		if country, err := s.countryRepo.FindByID(ctx, req.Settings.BusinessCountryID); err == nil {
+			subject.CountryCode = country.Code
+		}
+	}
+
+	if _, err := s.screener.Screen(ctx, subject); err != nil {
+		log.ErrorWithContext(ctx, "registerSeller: screening failed", err)
+	}
 }
 
 func (s *SellerServiceImpl) validateSellerData(
@@ -147,6 +192,13 @@ func (s *SellerServiceImpl) executeRegistration(
 		return nil, userErrors.ErrSellerIDUpdateFailed
 	}
 
+	// The seller's own key encrypts its PII; created in the same transaction so a
+	// registered seller always has one
+	if err := s.dataKeys.ProvisionKey(ctx, user.ID); err != nil {
+		log.ErrorWithContext(ctx, "registerSeller: data key provisioning failed", err)
+		return nil, userErrors.ErrDataKeyProvisionFailed
+	}
+
 	var settings *model.SellerSettingsResponse
 	requiresOnboarding := true
 
@@ -163,7 +215,13 @@ func (s *SellerServiceImpl) executeRegistration(
 		return nil, userErrors.ErrTokenGenerationFailed
 	}
 
-	logo := filegateway.ResolveOptional(ctx, s.fileGateway, profile.BusinessLogoFileID, &user.ID)
+	logo := resolveProfileMedia(
+		ctx,
+		s.fileGateway,
+		profile.BusinessLogoFileID,
+		profile.BusinessLogoCrop,
+		&user.ID,
+	)
 	return factory.BuildSellerRegisterResponse(
 		user,
 		profile,
@@ -191,7 +249,13 @@ func (s *SellerServiceImpl) GetProfile(
 
 	settings, _ := s.sellerSettingsService.GetBySellerID(ctx, userID)
 
-	logo := filegateway.ResolveOptional(ctx, s.fileGateway, profile.BusinessLogoFileID, &userID)
+	logo := resolveProfileMedia(
+		ctx,
+		s.fileGateway,
+		profile.BusinessLogoFileID,
+		profile.BusinessLogoCrop,
+		&userID,
+	)
 	return factory.BuildSellerFullProfileResponse(
 		user.UserResponse,
 		profile,
@@ -4,7 +4,9 @@ import (
 	"context"
 	"time"
 
+	"ecommerce-be/common/constants"
 	commonEntity "ecommerce-be/common/db"
+	"ecommerce-be/common/filegateway"
 	"ecommerce-be/user/entity"
 	userErrors "ecommerce-be/user/error"
 	"ecommerce-be/user/factory"
@@ -49,21 +51,27 @@ type SellerSettingsService interface {
 
 // SellerSettingsServiceImpl implements the SellerSettingsService interface
 type SellerSettingsServiceImpl struct {
-	settingsRepo    repository.SellerSettingsRepository
-	countryService  CountryService
-	currencyService CurrencyService
+	settingsRepo      repository.SellerSettingsRepository
+	sellerProfileRepo repository.SellerProfileRepository
+	countryService    CountryService
+	currencyService   CurrencyService
+	fileGateway       filegateway.FileDisplayGateway
 }
 
 // NewSellerSettingsService creates a new instance of SellerSettingsService
 func NewSellerSettingsService(
 	settingsRepo repository.SellerSettingsRepository,
+	sellerProfileRepo repository.SellerProfileRepository,
 	countryService CountryService,
 	currencyService CurrencyService,
+	fileGateway filegateway.FileDisplayGateway,
 ) SellerSettingsService {
 	return &SellerSettingsServiceImpl{
-		settingsRepo:    settingsRepo,
-		countryService:  countryService,
-		currencyService: currencyService,
+		settingsRepo:      settingsRepo,
+		sellerProfileRepo: sellerProfileRepo,
+		countryService:    countryService,
+		currencyService:   currencyService,
+		fileGateway:       fileGateway,
 	}
 }
 
@@ -98,6 +106,7 @@ func (s *SellerSettingsServiceImpl) Create(
 		BusinessCountryID:            req.BusinessCountryID,
 		BaseCurrencyID:               req.BaseCurrencyID,
 		DisplayPricesInBuyerCurrency: false,
+		InventoryCostingMethod:       constants.INVENTORY_COSTING_FIFO,
 		BaseEntity: commonEntity.BaseEntity{
 			CreatedAt: now,
 			UpdatedAt: now,
@@ -116,6 +125,14 @@ func (s *SellerSettingsServiceImpl) Create(
 		settings.DisplayPricesInBuyerCurrency = *req.DisplayPricesInBuyerCurrency
 	}
 
+	if req.InventoryCostingMethod != nil {
+		settings.InventoryCostingMethod = *req.InventoryCostingMethod
+	}
+
+	if req.CancellationWindowMinutes != nil {
+		settings.CancellationWindowMinutes = *req.CancellationWindowMinutes
+	}
+
 	// Save to database
 	if err := s.settingsRepo.Create(ctx, settings); err != nil {
 		return nil, userErrors.ErrSettingsCreateFailed
@@ -134,7 +151,7 @@ func (s *SellerSettingsServiceImpl) GetBySellerID(
 		return nil, userErrors.ErrSellerSettingsNotFound
 	}
 
-	return factory.BuildSellerSettingsResponse(settings), nil
+	return s.withStoreLogo(ctx, factory.BuildSellerSettingsResponse(settings)), nil
 }
 
 // Update updates existing seller settings
@@ -178,6 +195,14 @@ func (s *SellerSettingsServiceImpl) Update(
 		settings.DisplayPricesInBuyerCurrency = *req.DisplayPricesInBuyerCurrency
 	}
 
+	if req.InventoryCostingMethod != nil {
+		settings.InventoryCostingMethod = *req.InventoryCostingMethod
+	}
+
+	if req.CancellationWindowMinutes != nil {
+		settings.CancellationWindowMinutes = *req.CancellationWindowMinutes
+	}
+
 	settings.UpdatedAt = time.Now()
 
 	// Save changes
@@ -185,7 +210,7 @@ func (s *SellerSettingsServiceImpl) Update(
 		return nil, userErrors.ErrSellerSettingsExists // Generic update error
 	}
 
-	return factory.BuildSellerSettingsResponse(settings), nil
+	return s.withStoreLogo(ctx, factory.BuildSellerSettingsResponse(settings)), nil
 }
 
 // ValidateSettingsData validates country and currency IDs using their respective services
@@ -222,3 +247,23 @@ func (s *SellerSettingsServiceImpl) ExistsBySellerID(
 ) (bool, error) {
 	return s.settingsRepo.ExistsBySellerID(ctx, sellerID)
 }
+
+// withStoreLogo attaches the storefront logo so clients render it from the CDN URL.
+// A missing profile or logo leaves the field empty rather than failing the settings read.
+func (s *SellerSettingsServiceImpl) withStoreLogo(
+	ctx context.Context,
+	resp *model.SellerSettingsResponse,
+) *model.SellerSettingsResponse {
+	profile, err := s.sellerProfileRepo.FindByUserID(ctx, resp.SellerID)
+	if err != nil {
+		return resp
+	}
+	resp.StoreLogo = resolveProfileMedia(
+		ctx,
+		s.fileGateway,
+		profile.BusinessLogoFileID,
+		profile.BusinessLogoCrop,
+		&resp.SellerID,
+	)
+	return resp
+}
@@ -0,0 +1,263 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"ecommerce-be/common/cache"
+	"ecommerce-be/common/db"
+	"ecommerce-be/common/log"
+	"ecommerce-be/user/entity"
+	userErrors "ecommerce-be/user/error"
+	"ecommerce-be/user/factory"
+	"ecommerce-be/user/model"
+	"ecommerce-be/user/repository"
+	"ecommerce-be/user/utils/constant"
+)
+
+// StorefrontService defines business logic for seller storefront settings
+type StorefrontService interface {
+	// Get returns the seller's storefront settings
+	Get(ctx context.Context, sellerID uint) (*model.StorefrontResponse, error)
+
+	// Create sets up the seller's storefront
+	Create(
+		ctx context.Context,
+		sellerID uint,
+		req model.StorefrontCreateRequest,
+	) (*model.StorefrontResponse, error)
+
+	// Update changes the seller's storefront
+	Update(
+		ctx context.Context,
+		sellerID uint,
+		req model.StorefrontUpdateRequest,
+	) (*model.StorefrontResponse, error)
+
+	// Delete removes the seller's storefront settings
+	Delete(ctx context.Context, sellerID uint) error
+
+	// GetPublic returns the shopper-facing storefront, served from the cache
+	GetPublic(ctx context.Context, sellerID uint) (*model.PublicStorefrontResponse, error)
+}
+
+// StorefrontServiceImpl implements StorefrontService
+type StorefrontServiceImpl struct {
+	storefrontRepo repository.StorefrontSettingsRepository
+	currencyRepo   repository.CurrencyRepository
+}
+
+// NewStorefrontService creates a new instance of StorefrontService
+func NewStorefrontService(
+	storefrontRepo repository.StorefrontSettingsRepository,
+	currencyRepo repository.CurrencyRepository,
+) StorefrontService {
+	return &StorefrontServiceImpl{
+		storefrontRepo: storefrontRepo,
+		currencyRepo:   currencyRepo,
+	}
+}
+
+// Get returns the seller's storefront settings
+func (s *StorefrontServiceImpl) Get(
+	ctx context.Context,
+	sellerID uint,
+) (*model.StorefrontResponse, error) {
+	settings, err := s.storefrontRepo.FindBySellerID(ctx, sellerID)
+	if err != nil {
+		return nil, err
+	}
+	if settings == nil {
+		return nil, userErrors.ErrStorefrontNotFound
+	}
+	return factory.BuildStorefrontResponse(settings), nil
+}
+
+// Create sets up the seller's storefront. Timezone defaults to UTC.
+func (s *StorefrontServiceImpl) Create(
+	ctx context.Context,
+	sellerID uint,
+	req model.StorefrontCreateRequest,
+) (*model.StorefrontResponse, error) {
+	existing, err := s.storefrontRepo.FindBySellerID(ctx, sellerID)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, userErrors.ErrStorefrontAlreadyExists
+	}
+
+	currencyCode, err := s.validateCurrencyCode(ctx, req.CurrencyCode)
+	if err != nil {
+		return nil, err
+	}
+
+	settings := &entity.StorefrontSettings{
+		SellerID:       sellerID,
+		StoreName:      strings.TrimSpace(req.StoreName),
+		LogoURL:        trimmedOrNil(req.LogoURL),
+		PrimaryColor:   trimmedOrNil(req.PrimaryColor),
+		SecondaryColor: trimmedOrNil(req.SecondaryColor),
+		AccentColor:    trimmedOrNil(req.AccentColor),
+		ContactEmail:   trimmedOrNil(req.ContactEmail),
+		ContactPhone:   trimmedOrNil(req.ContactPhone),
+		SocialLinks:    entity.SocialLinks(req.SocialLinks),
+		CurrencyCode:   currencyCode,
+		Timezone:       constant.STOREFRONT_DEFAULT_TIMEZONE,
+	}
+	if timezone := trimmedOrNil(req.Timezone); timezone != nil {
+		settings.Timezone = *timezone
+	}
+
+	if err := s.storefrontRepo.Create(ctx, settings); err != nil {
+		return nil, err
+	}
+
+	invalidateStorefrontCache(ctx, sellerID)
+	return factory.BuildStorefrontResponse(settings), nil
+}
+
+// Update changes the provided fields of the seller's storefront
+func (s *StorefrontServiceImpl) Update(
+	ctx context.Context,
+	sellerID uint,
+	req model.StorefrontUpdateRequest,
+) (*model.StorefrontResponse, error) {
+	settings, err := s.storefrontRepo.FindBySellerID(ctx, sellerID)
+	if err != nil {
+		return nil, err
+	}
+	if settings == nil {
+		return nil, userErrors.ErrStorefrontNotFound
+	}
+
+	if req.StoreName != nil {
+		settings.StoreName = strings.TrimSpace(*req.StoreName)
+	}
+	if req.LogoURL != nil {
+		settings.LogoURL = trimmedOrNil(req.LogoURL)
+	}
+	if req.PrimaryColor != nil {
+		settings.PrimaryColor = trimmedOrNil(req.PrimaryColor)
+	}
+	if req.SecondaryColor != nil {
+		settings.SecondaryColor = trimmedOrNil(req.SecondaryColor)
+	}
+	if req.AccentColor != nil {
+		settings.AccentColor = trimmedOrNil(req.AccentColor)
+	}
+	if req.ContactEmail != nil {
+		settings.ContactEmail = trimmedOrNil(req.ContactEmail)
+	}
+	if req.ContactPhone != nil {
+		settings.ContactPhone = trimmedOrNil(req.ContactPhone)
+	}
+	if req.SocialLinks != nil {
+		settings.SocialLinks = entity.SocialLinks(req.SocialLinks)
+	}
+	if req.CurrencyCode != nil {
+		currencyCode, err := s.validateCurrencyCode(ctx, req.CurrencyCode)
+		if err != nil {
+			return nil, err
+		}
+		settings.CurrencyCode = currencyCode
+	}
+	if timezone := trimmedOrNil(req.Timezone); timezone != nil {
+		settings.Timezone = *timezone
+	}
+
+	settings.UpdatedAt = time.Now().UTC()
+	if err := s.storefrontRepo.Update(ctx, settings); err != nil {
+		return nil, err
+	}
+
+	invalidateStorefrontCache(ctx, sellerID)
+	return factory.BuildStorefrontResponse(settings), nil
+}
+
+// Delete removes the seller's storefront settings
+func (s *StorefrontServiceImpl) Delete(ctx context.Context, sellerID uint) error {
+	deleted, err := s.storefrontRepo.DeleteBySellerID(ctx, sellerID)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return userErrors.ErrStorefrontNotFound
+	}
+
+	invalidateStorefrontCache(ctx, sellerID)
+	return nil
+}
+
+// GetPublic returns the shopper-facing storefront. It is read through the cache;
+// every write path evicts the seller's entry.
+func (s *StorefrontServiceImpl) GetPublic(
+	ctx context.Context,
+	sellerID uint,
+) (*model.PublicStorefrontResponse, error) {
+	return cache.GetOrLoad(
+		ctx,
+		constant.STOREFRONT_CACHE_NAMESPACE,
+		cache.VersionedKey(constant.STOREFRONT_CACHE_NAMESPACE, sellerID),
+		constant.STOREFRONT_CACHE_TTL*time.Second,
+		func(ctx context.Context) (*model.PublicStorefrontResponse, error) {
+			settings, err := s.storefrontRepo.FindBySellerID(ctx, sellerID)
+			if err != nil {
+				return nil, err
+			}
+			if settings == nil {
+				return nil, userErrors.ErrStorefrontNotFound
+			}
+			response := factory.BuildPublicStorefrontResponse(settings)
+			return &response, nil
+		},
+	)
+}
+
+// validateCurrencyCode checks that a display currency is configured and active on
+// the platform. An empty code clears the display currency.
+func (s *StorefrontServiceImpl) validateCurrencyCode(
+	ctx context.Context,
+	code *string,
+) (*string, error) {
+	code = trimmedOrNil(code)
+	if code == nil {
+		return nil, nil
+	}
+	normalized := strings.ToUpper(*code)
+	currency, err := s.currencyRepo.FindByCode(ctx, normalized)
+	if err != nil {
+		return nil, err
+	}
+	if currency == nil {
+		return nil, userErrors.ErrCurrencyNotFound
+	}
+	if !currency.IsActive {
+		return nil, userErrors.ErrCurrencyInactive
+	}
+	return &normalized, nil
+}
+
+// invalidateStorefrontCache evicts the seller's public storefront once the current
+// transaction commits. Failures are logged only; the entry expires with its TTL.
+func invalidateStorefrontCache(ctx context.Context, sellerID uint) {
+	db.AfterCommit(ctx, func() {
+		key := cache.VersionedKey(constant.STOREFRONT_CACHE_NAMESPACE, sellerID)
+		if err := cache.Del(key); err != nil {
+			log.WarnWithContext(ctx, "invalidateStorefrontCache: "+err.Error())
+		}
+	})
+}
+
+// trimmedOrNil trims value and maps an empty result to nil
+func trimmedOrNil(value *string) *string {
+	if value == nil {
+		return nil
+	}
+	trimmed := strings.TrimSpace(*value)
+	if trimmed == "" {
+		return nil
+	}
+	return &trimmed
+}
@@ -0,0 +1,239 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"ecommerce-be/common/cache"
+	"ecommerce-be/common/db"
+	"ecommerce-be/common/log"
+	"ecommerce-be/user/entity"
+	userErrors "ecommerce-be/user/error"
+	"ecommerce-be/user/factory"
+	"ecommerce-be/user/model"
+	"ecommerce-be/user/repository"
+	"ecommerce-be/user/utils"
+)
+
+// SubscriptionService manages the plans sellers subscribe to and each seller's
+// subscription: trials, admin-assigned plans and renewals reported by the billing
+// provider's payment webhooks. The limits and features a plan carries are enforced by
+// the plan middleware.
+type SubscriptionService interface {
+	ListPlans(ctx context.Context) ([]model.PlanResponse, error)
+	// GetSellerSubscription returns the seller's subscription with its plan and how much
+	// of the plan's limits the seller uses
+	GetSellerSubscription(
+		ctx context.Context,
+		sellerID uint,
+	) (*model.SellerSubscriptionResponse, error)
+	// AssignPlan puts the seller on a plan. A subscription in force changes plan; a first
+	// subscription starts the plan's trial; a lapsed one starts a new paid period.
+	AssignPlan(
+		ctx context.Context,
+		sellerID uint,
+		req model.AssignPlanRequest,
+	) (*model.SellerSubscriptionResponse, error)
+	// ApplyPaymentWebhook renews the seller's subscription on a successful payment and
+	// marks it past due on a failed one. Each event is applied once.
+	ApplyPaymentWebhook(
+		ctx context.Context,
+		req model.SubscriptionPaymentWebhookRequest,
+	) (*model.SubscriptionPaymentWebhookResponse, error)
+}
+
+// SubscriptionServiceImpl implements the SubscriptionService interface
+type SubscriptionServiceImpl struct {
+	subscriptionRepo repository.SubscriptionRepository
+	planRepo         repository.PlanRepository
+	userRepo         repository.UserRepository
+	// graceDays is how long a past-due seller keeps access after the period ends
+	graceDays int
+}
+
+// NewSubscriptionService creates a new instance of SubscriptionService
+func NewSubscriptionService(
+	subscriptionRepo repository.SubscriptionRepository,
+	planRepo repository.PlanRepository,
+	userRepo repository.UserRepository,
+	graceDays int,
+) SubscriptionService {
+	return &SubscriptionServiceImpl{
+		subscriptionRepo: subscriptionRepo,
+		planRepo:         planRepo,
+		userRepo:         userRepo,
+		graceDays:        graceDays,
+	}
+}
+
+func (s *SubscriptionServiceImpl) ListPlans(ctx context.Context) ([]model.PlanResponse, error) {
+	plans, err := s.planRepo.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return factory.BuildPlanResponses(plans), nil
+}
+
+func (s *SubscriptionServiceImpl) GetSellerSubscription(
+	ctx context.Context,
+	sellerID uint,
+) (*model.SellerSubscriptionResponse, error) {
+	subscription, err := s.subscriptionRepo.FindLatestBySeller(ctx, sellerID)
+	if err != nil {
+		return nil, err
+	}
+	if subscription == nil {
+		return nil, userErrors.ErrSubscriptionNotFound
+	}
+	return s.buildSellerSubscription(ctx, subscription)
+}
+
+func (s *SubscriptionServiceImpl) AssignPlan(
+	ctx context.Context,
+	sellerID uint,
+	req model.AssignPlanRequest,
+) (*model.SellerSubscriptionResponse, error) {
+	user, err := s.userRepo.FindByID(ctx, sellerID)
+	if err != nil || user.SellerID != user.ID {
+		return nil, userErrors.ErrSubscriptionSellerNotFound
+	}
+	plan, err := s.planRepo.FindByID(ctx, req.PlanID)
+	if err != nil {
+		return nil, err
+	}
+	if plan == nil {
+		return nil, userErrors.ErrPlanNotFound
+	}
+
+	subscription, err := db.WithTransactionResult(
+		ctx,
+		func(txCtx context.Context) (*entity.Subscription, error) {
+			subscription, err := s.subscriptionRepo.FindLatestBySellerForUpdate(txCtx, sellerID)
+			if err != nil {
+				return nil, err
+			}
+			if subscription == nil {
+				subscription = &entity.Subscription{SellerID: sellerID}
+			}
+			utils.AssignSubscriptionPlan(subscription, plan, time.Now().UTC())
+			if err := s.subscriptionRepo.Save(txCtx, subscription); err != nil {
+				return nil, err
+			}
+			return subscription, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	s.invalidateSellerCache(ctx, sellerID)
+	return s.buildSellerSubscription(ctx, subscription)
+}
+
+func (s *SubscriptionServiceImpl) ApplyPaymentWebhook(
+	ctx context.Context,
+	req model.SubscriptionPaymentWebhookRequest,
+) (*model.SubscriptionPaymentWebhookResponse, error) {
+	now := time.Now().UTC()
+	occurredAt := now
+	if req.OccurredAt != nil {
+		occurredAt = req.OccurredAt.UTC()
+	}
+
+	var duplicate bool
+	subscription, err := db.WithTransactionResult(
+		ctx,
+		func(txCtx context.Context) (*entity.Subscription, error) {
+			subscription, err := s.subscriptionRepo.FindLatestBySellerForUpdate(
+				txCtx, req.SellerID)
+			if err != nil {
+				return nil, err
+			}
+			if subscription == nil {
+				return nil, userErrors.ErrSubscriptionNotFound
+			}
+
+			recorded, err := s.subscriptionRepo.CreatePaymentEvent(txCtx,
+				&entity.SubscriptionPaymentEvent{
+					EventID:        req.EventID,
+					SubscriptionID: subscription.ID,
+					EventType:      entity.SubscriptionPaymentEventType(req.EventType),
+					TransactionID:  req.TransactionID,
+					OccurredAt:     occurredAt,
+				})
+			if err != nil {
+				return nil, err
+			}
+			if !recorded {
+				duplicate = true
+				return subscription, nil
+			}
+
+			switch entity.SubscriptionPaymentEventType(req.EventType) {
+			case entity.SUBSCRIPTION_PAYMENT_SUCCEEDED:
+				planID := subscription.PlanID
+				if req.PlanID != nil {
+					planID = *req.PlanID
+				}
+				plan, err := s.planRepo.FindByID(txCtx, planID)
+				if err != nil {
+					return nil, err
+				}
+				if plan == nil {
+					return nil, userErrors.ErrPlanNotFound
+				}
+				utils.ApplyRenewalPayment(subscription, plan, occurredAt, req.TransactionID)
+			case entity.SUBSCRIPTION_PAYMENT_FAILED:
+				if !utils.ApplyRenewalFailure(subscription, occurredAt, s.graceDays) {
+					return subscription, nil
+				}
+			}
+			if err := s.subscriptionRepo.Save(txCtx, subscription); err != nil {
+				return nil, err
+			}
+			return subscription, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if !duplicate {
+		s.invalidateSellerCache(ctx, req.SellerID)
+	}
+	return factory.BuildSubscriptionPaymentWebhookResponse(subscription, duplicate, now), nil
+}
+
+func (s *SubscriptionServiceImpl) buildSellerSubscription(
+	ctx context.Context,
+	subscription *entity.Subscription,
+) (*model.SellerSubscriptionResponse, error) {
+	plan, err := s.planRepo.FindByID(ctx, subscription.PlanID)
+	if err != nil {
+		return nil, err
+	}
+	if plan == nil {
+		return nil, userErrors.ErrPlanNotFound
+	}
+	products, variants, err := s.subscriptionRepo.CountCatalog(ctx, subscription.SellerID)
+	if err != nil {
+		return nil, err
+	}
+	return factory.BuildSellerSubscriptionResponse(
+		subscription,
+		plan,
+		model.PlanUsageResponse{Products: products, Variants: variants},
+		time.Now().UTC(),
+	), nil
+}
+
+// invalidateSellerCache drops the seller's cached validation data so the subscription
+// and plan limits apply on their next request
+func (s *SubscriptionServiceImpl) invalidateSellerCache(ctx context.Context, sellerID uint) {
+	if err := cache.InvalidateSellerCompleteCache(sellerID); err != nil {
+		log.ErrorWithContext(ctx, fmt.Sprintf(
+			"subscription: failed to invalidate cache of seller %d", sellerID,
+		), err)
+	}
+}
@@ -166,7 +166,13 @@ func (s *UserServiceImpl) buildSellerLoginProfile(
 		settings = nil
 	}
 
-	logo := filegateway.ResolveOptional(ctx, s.fileGateway, profile.BusinessLogoFileID, &userID)
+	logo := resolveProfileMedia(
+		ctx,
+		s.fileGateway,
+		profile.BusinessLogoFileID,
+		profile.BusinessLogoCrop,
+		&userID,
+	)
 	return factory.BuildSellerLoginProfileResponse(profile, settings, addresses, logo)
 }
 
@@ -195,6 +201,13 @@ func (s *UserServiceImpl) GetProfile(
 		// Preferences (Note: User's country is derived from default address)
 		CurrencyID: user.CurrencyID,
 		Locale:     user.Locale,
+		Avatar: resolveProfileMedia(
+			ctx,
+			s.fileGateway,
+			user.AvatarFileID,
+			user.AvatarCrop,
+			nil,
+		),
 	}
 
 	addresses, err := s.addressService.GetAddresses(ctx, userID)
@@ -271,6 +284,13 @@ func (s *UserServiceImpl) UpdateProfile(
 
 	// Build user response using factory (eliminates duplication)
 	userResponse := factory.BuildUserResponse(user)
+	userResponse.Avatar = resolveProfileMedia(
+		ctx,
+		s.fileGateway,
+		user.AvatarFileID,
+		user.AvatarCrop,
+		nil,
+	)
 
 	return &userResponse, nil
 }
@@ -339,10 +359,12 @@ func (s *UserServiceImpl) CreateUserWithRole(
 	req model.CreateUserRequest,
 	roleName string,
 ) (*entity.User, *entity.Role, error) {
-	// 1. Check if user already exists
-	existingUser, _ := s.userRepo.FindByEmail(ctx, req.Email)
-	if existingUser != nil {
-		return nil, nil, errors.New(constant.USER_EXISTS_MSG)
+	// 1. Check if user already exists; guest emails do not have to be unique
+	if !req.IsGuest {
+		existingUser, _ := s.userRepo.FindByEmail(ctx, req.Email)
+		if existingUser != nil {
+			return nil, nil, errors.New(constant.USER_EXISTS_MSG)
+		}
 	}
 
 	// 2. Hash password
@@ -368,6 +390,7 @@ func (s *UserServiceImpl) CreateUserWithRole(
 		DateOfBirth: req.DateOfBirth,
 		Gender:      req.Gender,
 		IsActive:    true,
+		IsGuest:     req.IsGuest,
 		RoleID:      role.ID,
 		SellerID:    req.SellerID,
 		BaseEntity: commonEntity.BaseEntity{
@@ -0,0 +1,89 @@
+package utils
+
+import (
+	"regexp"
+	"strings"
+
+	"ecommerce-be/user/entity"
+	userErrors "ecommerce-be/user/error"
+)
+
+// postalCodePatterns are the postal code formats checked per country (ISO alpha-2).
+// Codes are matched after NormalizePostalCode. Countries not listed accept any code.
+var postalCodePatterns = map[string]*regexp.Regexp{
+	"AU": regexp.MustCompile(`^\d{4}$`),
+	"BR": regexp.MustCompile(`^\d{5}-?\d{3}$`),
+	"CA": regexp.MustCompile(`^[A-Z]\d[A-Z] \d[A-Z]\d$`),
+	"CN": regexp.MustCompile(`^\d{6}$`),
+	"DE": regexp.MustCompile(`^\d{5}$`),
+	"ES": regexp.MustCompile(`^\d{5}$`),
+	"FR": regexp.MustCompile(`^\d{5}$`),
+	"GB": regexp.MustCompile(`^[A-Z]{1,2}\d[A-Z\d]? \d[A-Z]{2}$`),
+	"IN": regexp.MustCompile(`^[1-9]\d{5}$`),
+	"IT": regexp.MustCompile(`^\d{5}$`),
+	"JP": regexp.MustCompile(`^\d{3}-\d{4}$`),
+	"MX": regexp.MustCompile(`^\d{5}$`),
+	"NL": regexp.MustCompile(`^\d{4} [A-Z]{2}$`),
+	"SG": regexp.MustCompile(`^\d{6}$`),
+	"US": regexp.MustCompile(`^\d{5}(-\d{4})?$`),
+}
+
+// countriesWithoutPostalCodes do not use postal codes, so the field is optional there
+var countriesWithoutPostalCodes = map[string]bool{
+	"AE": true,
+	"HK": true,
+	"QA": true,
+}
+
+// countriesWithoutStates have no state or province level in postal addresses
+var countriesWithoutStates = map[string]bool{
+	"HK": true,
+	"MC": true,
+	"SG": true,
+}
+
+// spacedPostalCodes are written with a space before the last three (CA, GB) or two (NL)
+// characters; NormalizePostalCode inserts it when it was left out
+var spacedPostalCodes = map[string]int{
+	"CA": 3,
+	"GB": 3,
+	"NL": 2,
+}
+
+// NormalizePostalCode upper-cases a postal code and collapses its whitespace, so the
+// same code is stored the same way however it was typed
+func NormalizePostalCode(countryCode, postalCode string) string {
+	normalized := strings.ToUpper(strings.Join(strings.Fields(postalCode), " "))
+	if tail, ok := spacedPostalCodes[strings.ToUpper(countryCode)]; ok {
+		compact := strings.ReplaceAll(normalized, " ", "")
+		if len(compact) > tail {
+			normalized = compact[:len(compact)-tail] + " " + compact[len(compact)-tail:]
+		}
+	}
+	return normalized
+}
+
+// ValidateAddressFields checks an address's state and postal code against the
+// conventions of its country and returns the normalized postal code
+func ValidateAddressFields(country *entity.Country, state, postalCode string) (string, error) {
+	if !country.IsActive {
+		return "", userErrors.ErrCountryNotSupported(country.Name)
+	}
+
+	code := strings.ToUpper(country.Code)
+	if strings.TrimSpace(state) == "" && !countriesWithoutStates[code] {
+		return "", userErrors.ErrStateRequired(country.Name)
+	}
+
+	normalized := NormalizePostalCode(code, postalCode)
+	if normalized == "" {
+		if countriesWithoutPostalCodes[code] {
+			return "", nil
+		}
+		return "", userErrors.ErrPostalCodeRequired(country.Name)
+	}
+	if pattern, ok := postalCodePatterns[code]; ok && !pattern.MatchString(normalized) {
+		return "", userErrors.ErrInvalidPostalCode(postalCode, country.Name)
+	}
+	return normalized, nil
+}
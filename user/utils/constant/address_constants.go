@@ -7,6 +7,9 @@ const (
 	ADDRESS_NOT_FOUND_CODE      = "ADDRESS_NOT_FOUND"
 	DEFAULT_ADDRESS_EXISTS_CODE = "DEFAULT_ADDRESS_EXISTS"
 	CANNOT_DELETE_DEFAULT_CODE  = "CANNOT_DELETE_DEFAULT"
+	ADDRESS_LIMIT_REACHED_CODE  = "ADDRESS_LIMIT_REACHED"
+	INVALID_ADDRESS_FIELD_CODE  = "INVALID_ADDRESS_FIELD"
+	COUNTRY_NOT_SUPPORTED_CODE  = "COUNTRY_NOT_SUPPORTED"
 )
 
 // ========================================
@@ -18,6 +21,11 @@ const (
 	CANNOT_DELETE_ONLY_DEFAULT_ADDRESS_MSG = "cannot delete the only default address"
 	CANNOT_DELETE_DEFAULT_MSG              = "Cannot delete default address. Please set another address as default first."
 	INVALID_ADDRESS_ID_MSG                 = "Invalid address ID"
+	ADDRESS_LIMIT_REACHED_MSG              = "Address book is full; remove an address to add another"
+	POSTAL_CODE_REQUIRED_MSG               = "Postal code is required for %s"
+	INVALID_POSTAL_CODE_MSG                = "Postal code %q is not valid for %s"
+	STATE_REQUIRED_MSG                     = "State or province is required for %s"
+	COUNTRY_NOT_SUPPORTED_MSG              = "Addresses in %s are not supported"
 )
 
 // ========================================
@@ -31,6 +39,15 @@ const (
 	FAILED_TO_SET_DEFAULT_ADDRESS_MSG = "Failed to set default address"
 )
 
+// ========================================
+// ADDRESS BOOK LIMITS
+// ========================================
+const (
+	// MAX_ADDRESSES_PER_USER caps a user's address book. Seller location addresses
+	// (warehouses, stores, return centers) are managed by inventory and not counted.
+	MAX_ADDRESSES_PER_USER = 20
+)
+
 // ========================================
 // ADDRESS SUCCESS MESSAGES
 // ========================================
@@ -41,6 +58,8 @@ const (
 	ADDRESS_DELETED_MSG         = "Address deleted successfully"
 	ADDRESSES_RETRIEVED_MSG     = "Addresses retrieved successfully"
 	DEFAULT_ADDRESS_UPDATED_MSG = "Default address updated successfully"
+
+	DEFAULT_BILLING_ADDRESS_UPDATED_MSG = "Default billing address updated successfully"
 )
 
 // ========================================
@@ -26,12 +26,6 @@ const (
 	TOKEN_REQUIRED_CODE      = constants.TOKEN_REQUIRED_CODE
 )
 
-// Context keys
-const (
-	USER_ID_KEY = constants.USER_ID_KEY
-	EMAIL_KEY   = constants.EMAIL_KEY
-)
-
 // Token settings
 const (
 	TOKEN_EXPIRE_DURATION = constants.TOKEN_EXPIRE_DURATION
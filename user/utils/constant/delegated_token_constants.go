@@ -0,0 +1,75 @@
+package constant
+
+// ========================================
+// DELEGATED TOKEN LIMITS
+// ========================================
+const (
+	// DELEGATED_TOKEN_MAX_LIFETIME_HOURS caps a grant at 30 days
+	DELEGATED_TOKEN_MAX_LIFETIME_HOURS = 24 * 30
+	// DELEGATED_TOKEN_MAX_ACTIVE caps concurrently active grants per seller
+	DELEGATED_TOKEN_MAX_ACTIVE = 20
+	// DELEGATED_TOKEN_AUDIT_PAGE_SIZE is the default number of audit entries returned
+	DELEGATED_TOKEN_AUDIT_PAGE_SIZE = 50
+	// DELEGATED_TOKEN_AUDIT_MAX_PAGE_SIZE caps the audit entries returned per request
+	DELEGATED_TOKEN_AUDIT_MAX_PAGE_SIZE = 200
+)
+
+// ========================================
+// DELEGATED TOKEN STATUSES
+// ========================================
+const (
+	DELEGATED_TOKEN_STATUS_ACTIVE  = "active"
+	DELEGATED_TOKEN_STATUS_EXPIRED = "expired"
+	DELEGATED_TOKEN_STATUS_REVOKED = "revoked"
+)
+
+// ========================================
+// DELEGATED TOKEN ERROR CODES
+// ========================================
+const (
+	DELEGATED_TOKEN_NOT_FOUND_CODE       = "DELEGATED_TOKEN_NOT_FOUND"
+	DELEGATED_TOKEN_INVALID_SCOPE_CODE   = "DELEGATED_TOKEN_INVALID_SCOPE"
+	DELEGATED_TOKEN_LIMIT_REACHED_CODE   = "DELEGATED_TOKEN_LIMIT_REACHED"
+	DELEGATED_TOKEN_ALREADY_REVOKED_CODE = "DELEGATED_TOKEN_ALREADY_REVOKED"
+	DELEGATED_TOKEN_NOT_ALLOWED_CODE     = "DELEGATED_TOKEN_NOT_ALLOWED"
+)
+
+// ========================================
+// DELEGATED TOKEN ERROR MESSAGES
+// ========================================
+const (
+	DELEGATED_TOKEN_NOT_FOUND_MSG       = "Delegated token not found"
+	DELEGATED_TOKEN_INVALID_SCOPE_MSG   = "One or more requested scopes are not supported"
+	DELEGATED_TOKEN_LIMIT_REACHED_MSG   = "Maximum number of active delegated tokens reached"
+	DELEGATED_TOKEN_ALREADY_REVOKED_MSG = "Delegated token is already revoked or expired"
+	DELEGATED_TOKEN_NOT_ALLOWED_MSG     = "Delegated tokens cannot manage delegated tokens"
+)
+
+// ========================================
+// DELEGATED TOKEN OPERATION FAILURE MESSAGES
+// ========================================
+const (
+	FAILED_TO_CREATE_DELEGATED_TOKEN_MSG    = "Failed to create delegated token"
+	FAILED_TO_LIST_DELEGATED_TOKENS_MSG     = "Failed to list delegated tokens"
+	FAILED_TO_REVOKE_DELEGATED_TOKEN_MSG    = "Failed to revoke delegated token"
+	FAILED_TO_GET_DELEGATED_TOKEN_AUDIT_MSG = "Failed to get delegated token audit log"
+)
+
+// ========================================
+// DELEGATED TOKEN SUCCESS MESSAGES
+// ========================================
+const (
+	DELEGATED_TOKEN_CREATED_MSG         = "Delegated token created successfully"
+	DELEGATED_TOKENS_RETRIEVED_MSG      = "Delegated tokens retrieved successfully"
+	DELEGATED_TOKEN_REVOKED_MSG         = "Delegated token revoked successfully"
+	DELEGATED_TOKEN_AUDIT_RETRIEVED_MSG = "Delegated token audit log retrieved successfully"
+)
+
+// ========================================
+// DELEGATED TOKEN FIELD NAMES
+// ========================================
+const (
+	DELEGATED_TOKEN_FIELD_NAME       = "token"
+	DELEGATED_TOKENS_FIELD_NAME      = "tokens"
+	DELEGATED_TOKEN_AUDIT_FIELD_NAME = "audit"
+)
@@ -0,0 +1,81 @@
+package constant
+
+// ========================================
+// EMAIL CHANGE TOKENS
+// ========================================
+const (
+	// EMAIL_CHANGE_TOKEN_PREFIX marks email-change confirmation tokens
+	EMAIL_CHANGE_TOKEN_PREFIX = "ecc_"
+	// EMAIL_CHANGE_TOKEN_RANDOM_BYTES is the entropy of a confirmation token
+	EMAIL_CHANGE_TOKEN_RANDOM_BYTES = 32
+	// EMAIL_CHANGE_TOKEN_QUERY_PARAM carries the token in the confirmation link
+	EMAIL_CHANGE_TOKEN_QUERY_PARAM = "token"
+)
+
+// ========================================
+// EMAIL CHANGE STATUSES
+// ========================================
+const (
+	EMAIL_CHANGE_STATUS_PENDING   = "pending"
+	EMAIL_CHANGE_STATUS_COMPLETED = "completed"
+	EMAIL_CHANGE_STATUS_CANCELLED = "cancelled"
+	EMAIL_CHANGE_STATUS_EXPIRED   = "expired"
+)
+
+// ========================================
+// EMAIL CHANGE AUDIT ACTIONS
+// ========================================
+const (
+	EMAIL_CHANGE_AUDIT_ACTION_REQUESTED     = "requested"
+	EMAIL_CHANGE_AUDIT_ACTION_CONFIRMED_OLD = "confirmed_old"
+	EMAIL_CHANGE_AUDIT_ACTION_CONFIRMED_NEW = "confirmed_new"
+	EMAIL_CHANGE_AUDIT_ACTION_COMPLETED     = "completed"
+	EMAIL_CHANGE_AUDIT_ACTION_CANCELLED     = "cancelled"
+)
+
+// ========================================
+// EMAIL CHANGE ERROR CODES
+// ========================================
+const (
+	EMAIL_CHANGE_NOT_FOUND_CODE     = "EMAIL_CHANGE_NOT_FOUND"
+	EMAIL_CHANGE_INVALID_TOKEN_CODE = "EMAIL_CHANGE_INVALID_TOKEN"
+	EMAIL_CHANGE_SAME_EMAIL_CODE    = "EMAIL_CHANGE_SAME_EMAIL"
+	EMAIL_CHANGE_EMAIL_TAKEN_CODE   = "EMAIL_CHANGE_EMAIL_TAKEN"
+)
+
+// ========================================
+// EMAIL CHANGE ERROR MESSAGES
+// ========================================
+const (
+	EMAIL_CHANGE_NOT_FOUND_MSG     = "No pending email change"
+	EMAIL_CHANGE_INVALID_TOKEN_MSG = "Email change link is invalid or has expired"
+	EMAIL_CHANGE_SAME_EMAIL_MSG    = "New email must differ from the current email"
+	EMAIL_CHANGE_EMAIL_TAKEN_MSG   = "Email is already in use by another account"
+)
+
+// ========================================
+// EMAIL CHANGE OPERATION FAILURE MESSAGES
+// ========================================
+const (
+	FAILED_TO_REQUEST_EMAIL_CHANGE_MSG = "Failed to request email change"
+	FAILED_TO_GET_EMAIL_CHANGE_MSG     = "Failed to get email change"
+	FAILED_TO_CANCEL_EMAIL_CHANGE_MSG  = "Failed to cancel email change"
+	FAILED_TO_CONFIRM_EMAIL_CHANGE_MSG = "Failed to confirm email change"
+)
+
+// ========================================
+// EMAIL CHANGE SUCCESS MESSAGES
+// ========================================
+const (
+	EMAIL_CHANGE_REQUESTED_MSG = "Confirmation links sent to the current and the new email"
+	EMAIL_CHANGE_RETRIEVED_MSG = "Email change retrieved successfully"
+	EMAIL_CHANGE_CANCELLED_MSG = "Email change cancelled successfully"
+	EMAIL_CHANGE_CONFIRMED_MSG = "Email change confirmed successfully"
+)
+
+// ========================================
+// EMAIL CHANGE FIELD NAMES
+// ========================================
+const (
+	EMAIL_CHANGE_FIELD_NAME = "emailChange"
+)
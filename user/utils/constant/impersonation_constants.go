@@ -0,0 +1,70 @@
+package constant
+
+// ========================================
+// IMPERSONATION LIMITS
+// ========================================
+const (
+	// IMPERSONATION_DEFAULT_DURATION_MINUTES is the session length when none is requested
+	IMPERSONATION_DEFAULT_DURATION_MINUTES = 30
+	// IMPERSONATION_MAX_DURATION_MINUTES caps a session at four hours
+	IMPERSONATION_MAX_DURATION_MINUTES = 240
+	// IMPERSONATION_AUDIT_PAGE_SIZE is the default number of audit entries returned
+	IMPERSONATION_AUDIT_PAGE_SIZE = 50
+	// IMPERSONATION_AUDIT_MAX_PAGE_SIZE caps the audit entries returned per request
+	IMPERSONATION_AUDIT_MAX_PAGE_SIZE = 200
+)
+
+// ========================================
+// IMPERSONATION STATUSES
+// ========================================
+const (
+	IMPERSONATION_STATUS_ACTIVE  = "active"
+	IMPERSONATION_STATUS_EXPIRED = "expired"
+	IMPERSONATION_STATUS_ENDED   = "ended"
+)
+
+// ========================================
+// IMPERSONATION ERROR CODES
+// ========================================
+const (
+	IMPERSONATION_NOT_FOUND_CODE        = "IMPERSONATION_NOT_FOUND"
+	IMPERSONATION_SELLER_NOT_FOUND_CODE = "IMPERSONATION_SELLER_NOT_FOUND"
+	IMPERSONATION_ALREADY_ENDED_CODE    = "IMPERSONATION_ALREADY_ENDED"
+	IMPERSONATION_NOT_ACTIVE_CODE       = "IMPERSONATION_NOT_ACTIVE"
+)
+
+// ========================================
+// IMPERSONATION ERROR MESSAGES
+// ========================================
+const (
+	IMPERSONATION_NOT_FOUND_MSG        = "Impersonation session not found"
+	IMPERSONATION_SELLER_NOT_FOUND_MSG = "Seller not found or inactive"
+	IMPERSONATION_ALREADY_ENDED_MSG    = "Impersonation session is already ended or expired"
+	IMPERSONATION_NOT_ACTIVE_MSG       = "Request is not part of an impersonation session"
+)
+
+// ========================================
+// IMPERSONATION OPERATION FAILURE MESSAGES
+// ========================================
+const (
+	FAILED_TO_START_IMPERSONATION_MSG     = "Failed to start impersonation"
+	FAILED_TO_END_IMPERSONATION_MSG       = "Failed to end impersonation"
+	FAILED_TO_GET_IMPERSONATION_AUDIT_MSG = "Failed to get impersonation audit log"
+)
+
+// ========================================
+// IMPERSONATION SUCCESS MESSAGES
+// ========================================
+const (
+	IMPERSONATION_STARTED_MSG         = "Impersonation started successfully"
+	IMPERSONATION_ENDED_MSG           = "Impersonation ended successfully"
+	IMPERSONATION_AUDIT_RETRIEVED_MSG = "Impersonation audit log retrieved successfully"
+)
+
+// ========================================
+// IMPERSONATION FIELD NAMES
+// ========================================
+const (
+	IMPERSONATION_FIELD_NAME       = "impersonation"
+	IMPERSONATION_AUDIT_FIELD_NAME = "audit"
+)
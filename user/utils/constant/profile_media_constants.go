@@ -0,0 +1,70 @@
+package constant
+
+// ========================================
+// PROFILE MEDIA ERROR CODES
+// ========================================
+const (
+	INVALID_MEDIA_FORMAT_CODE = "INVALID_MEDIA_FORMAT"
+	MEDIA_TOO_LARGE_CODE      = "MEDIA_TOO_LARGE"
+	INVALID_MEDIA_CROP_CODE   = "INVALID_MEDIA_CROP"
+	INVALID_AVATAR_FILE_CODE  = "INVALID_AVATAR_FILE"
+	AVATAR_NOT_SET_CODE       = "AVATAR_NOT_SET"
+)
+
+// ========================================
+// PROFILE MEDIA ERROR MESSAGES
+// ========================================
+const (
+	INVALID_MEDIA_FORMAT_MSG = "Image format is not supported"
+	MEDIA_TOO_LARGE_MSG      = "Image exceeds the maximum allowed size"
+	INVALID_MEDIA_CROP_MSG   = "Crop is invalid; avatars require a square crop"
+	INVALID_AVATAR_FILE_MSG  = "Avatar file is invalid or not accessible"
+	AVATAR_NOT_SET_MSG       = "No avatar is set"
+)
+
+// ========================================
+// PROFILE MEDIA OPERATION FAILURE MESSAGES
+// ========================================
+const (
+	FAILED_TO_INIT_MEDIA_UPLOAD_MSG = "Failed to start image upload"
+	FAILED_TO_UPDATE_AVATAR_MSG     = "Failed to update avatar"
+	FAILED_TO_REMOVE_AVATAR_MSG     = "Failed to remove avatar"
+	FAILED_TO_UPDATE_STORE_LOGO_MSG = "Failed to update store logo"
+)
+
+// ========================================
+// PROFILE MEDIA SUCCESS MESSAGES
+// ========================================
+const (
+	MEDIA_UPLOAD_INITIATED_MSG = "Image upload initiated successfully"
+	AVATAR_UPDATED_MSG         = "Avatar updated successfully"
+	AVATAR_REMOVED_MSG         = "Avatar removed successfully"
+	STORE_LOGO_UPDATED_MSG     = "Store logo updated successfully"
+)
+
+// ========================================
+// PROFILE MEDIA FIELD NAMES
+// ========================================
+const (
+	UPLOAD_FIELD_NAME     = "upload"
+	AVATAR_FIELD_NAME     = "avatar"
+	STORE_LOGO_FIELD_NAME = "storeLogo"
+)
+
+// ========================================
+// PROFILE MEDIA POLICY
+// ========================================
+// Mirrors the file module's upload policy so callers get a user-facing error before
+// a presigned URL is issued.
+const (
+	AVATAR_FILE_PURPOSE     = "USER_AVATAR"
+	STORE_LOGO_FILE_PURPOSE = "SELLER_LOGO"
+
+	AVATAR_MAX_SIZE_BYTES     int64 = 2 * 1024 * 1024
+	STORE_LOGO_MAX_SIZE_BYTES int64 = 3 * 1024 * 1024
+)
+
+var (
+	AVATAR_ALLOWED_MIME_TYPES     = []string{"image/jpeg", "image/png", "image/webp"}
+	STORE_LOGO_ALLOWED_MIME_TYPES = []string{"image/jpeg", "image/png", "image/webp", "image/svg+xml"}
+)
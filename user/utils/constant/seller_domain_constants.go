@@ -0,0 +1,45 @@
+package constant
+
+// ========================================
+// SELLER DOMAIN ERROR CODES
+// ========================================
+const (
+	SELLER_DOMAIN_NOT_FOUND_CODE        = "SELLER_DOMAIN_NOT_FOUND"
+	SELLER_DOMAIN_TAKEN_CODE            = "SELLER_DOMAIN_TAKEN"
+	SELLER_DOMAIN_SELLER_NOT_FOUND_CODE = "SELLER_DOMAIN_SELLER_NOT_FOUND"
+)
+
+// ========================================
+// SELLER DOMAIN ERROR MESSAGES
+// ========================================
+const (
+	SELLER_DOMAIN_NOT_FOUND_MSG        = "Storefront domain not found"
+	SELLER_DOMAIN_TAKEN_MSG            = "Domain is already mapped to a storefront"
+	SELLER_DOMAIN_SELLER_NOT_FOUND_MSG = "Seller not found"
+)
+
+// ========================================
+// SELLER DOMAIN OPERATION FAILURE MESSAGES
+// ========================================
+const (
+	FAILED_TO_LIST_SELLER_DOMAINS_MSG  = "Failed to list storefront domains"
+	FAILED_TO_CREATE_SELLER_DOMAIN_MSG = "Failed to add storefront domain"
+	FAILED_TO_DELETE_SELLER_DOMAIN_MSG = "Failed to remove storefront domain"
+)
+
+// ========================================
+// SELLER DOMAIN SUCCESS MESSAGES
+// ========================================
+const (
+	SELLER_DOMAINS_RETRIEVED_MSG = "Storefront domains retrieved successfully"
+	SELLER_DOMAIN_CREATED_MSG    = "Storefront domain added successfully"
+	SELLER_DOMAIN_DELETED_MSG    = "Storefront domain removed successfully"
+)
+
+// ========================================
+// SELLER DOMAIN FIELD NAMES
+// ========================================
+const (
+	SELLER_DOMAIN_FIELD_NAME  = "domain"
+	SELLER_DOMAINS_FIELD_NAME = "domains"
+)
@@ -30,6 +30,11 @@ const (
 	SELLER_REGISTRATION_FAILED_MSG = "Seller registration failed"
 )
 
+const (
+	DATA_KEY_PROVISION_FAILED_CODE = "DATA_KEY_PROVISION_FAILED"
+	DATA_KEY_PROVISION_FAILED_MSG  = "Failed to provision seller data encryption key"
+)
+
 // ========================================
 // SELLER REGISTRATION SUCCESS MESSAGES
 // ========================================
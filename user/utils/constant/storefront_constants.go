@@ -0,0 +1,61 @@
+package constant
+
+// ========================================
+// STOREFRONT DEFAULTS
+// ========================================
+const (
+	STOREFRONT_DEFAULT_TIMEZONE = "UTC"
+)
+
+// ========================================
+// STOREFRONT CACHE
+// ========================================
+const (
+	// STOREFRONT_CACHE_NAMESPACE holds the public storefront of each seller
+	STOREFRONT_CACHE_NAMESPACE = "user:storefront"
+	// STOREFRONT_CACHE_TTL is how long a public storefront is cached (seconds)
+	STOREFRONT_CACHE_TTL = 1800
+)
+
+// ========================================
+// STOREFRONT ERROR CODES
+// ========================================
+const (
+	STOREFRONT_NOT_FOUND_CODE      = "STOREFRONT_NOT_FOUND"
+	STOREFRONT_ALREADY_EXISTS_CODE = "STOREFRONT_ALREADY_EXISTS"
+)
+
+// ========================================
+// STOREFRONT ERROR MESSAGES
+// ========================================
+const (
+	STOREFRONT_NOT_FOUND_MSG      = "Storefront settings not found"
+	STOREFRONT_ALREADY_EXISTS_MSG = "Storefront settings already exist for this seller"
+)
+
+// ========================================
+// STOREFRONT OPERATION FAILURE MESSAGES
+// ========================================
+const (
+	FAILED_TO_GET_STOREFRONT_MSG    = "Failed to get storefront settings"
+	FAILED_TO_CREATE_STOREFRONT_MSG = "Failed to create storefront settings"
+	FAILED_TO_UPDATE_STOREFRONT_MSG = "Failed to update storefront settings"
+	FAILED_TO_DELETE_STOREFRONT_MSG = "Failed to delete storefront settings"
+)
+
+// ========================================
+// STOREFRONT SUCCESS MESSAGES
+// ========================================
+const (
+	STOREFRONT_RETRIEVED_MSG = "Storefront settings retrieved successfully"
+	STOREFRONT_CREATED_MSG   = "Storefront settings created successfully"
+	STOREFRONT_UPDATED_MSG   = "Storefront settings updated successfully"
+	STOREFRONT_DELETED_MSG   = "Storefront settings deleted successfully"
+)
+
+// ========================================
+// STOREFRONT FIELD NAMES
+// ========================================
+const (
+	STOREFRONT_FIELD_NAME = "storefront"
+)
@@ -0,0 +1,72 @@
+package constant
+
+// ========================================
+// PLAN BILLING CYCLES
+// ========================================
+const (
+	PLAN_BILLING_CYCLE_MONTHLY  = "monthly"
+	PLAN_BILLING_CYCLE_YEARLY   = "yearly"
+	PLAN_BILLING_CYCLE_LIFETIME = "lifetime"
+	// SUBSCRIPTION_LIFETIME_YEARS is how far a lifetime plan's period runs
+	SUBSCRIPTION_LIFETIME_YEARS = 100
+)
+
+// ========================================
+// SUBSCRIPTION WEBHOOK
+// ========================================
+const (
+	// SUBSCRIPTION_SIGNATURE_HEADER carries the hex HMAC-SHA256 of the webhook body
+	SUBSCRIPTION_SIGNATURE_HEADER = "X-Subscription-Signature"
+	// SUBSCRIPTION_WEBHOOK_MAX_BODY_BYTES bounds the payment webhook body
+	SUBSCRIPTION_WEBHOOK_MAX_BODY_BYTES = 64 << 10
+)
+
+// ========================================
+// SUBSCRIPTION ERROR CODES
+// ========================================
+const (
+	PLAN_NOT_FOUND_CODE                      = "PLAN_NOT_FOUND"
+	SUBSCRIPTION_NOT_FOUND_CODE              = "SUBSCRIPTION_NOT_FOUND"
+	SUBSCRIPTION_SELLER_NOT_FOUND_CODE       = "SUBSCRIPTION_SELLER_NOT_FOUND"
+	SUBSCRIPTION_WEBHOOK_UNAUTHORIZED_CODE   = "SUBSCRIPTION_WEBHOOK_UNAUTHORIZED"
+	SUBSCRIPTION_WEBHOOK_NOT_CONFIGURED_CODE = "SUBSCRIPTION_WEBHOOK_NOT_CONFIGURED"
+)
+
+// ========================================
+// SUBSCRIPTION ERROR MESSAGES
+// ========================================
+const (
+	PLAN_NOT_FOUND_MSG                      = "Plan not found"
+	SUBSCRIPTION_NOT_FOUND_MSG              = "Seller has no subscription"
+	SUBSCRIPTION_SELLER_NOT_FOUND_MSG       = "Seller not found"
+	SUBSCRIPTION_WEBHOOK_UNAUTHORIZED_MSG   = "Invalid subscription webhook signature"
+	SUBSCRIPTION_WEBHOOK_NOT_CONFIGURED_MSG = "Subscription payment webhooks are not configured"
+)
+
+// ========================================
+// SUBSCRIPTION OPERATION FAILURE MESSAGES
+// ========================================
+const (
+	FAILED_TO_LIST_PLANS_MSG                   = "Failed to list plans"
+	FAILED_TO_GET_SUBSCRIPTION_MSG             = "Failed to get subscription"
+	FAILED_TO_ASSIGN_PLAN_MSG                  = "Failed to assign plan"
+	FAILED_TO_PROCESS_SUBSCRIPTION_WEBHOOK_MSG = "Failed to process subscription payment webhook"
+)
+
+// ========================================
+// SUBSCRIPTION SUCCESS MESSAGES
+// ========================================
+const (
+	PLANS_RETRIEVED_MSG                = "Plans retrieved successfully"
+	SUBSCRIPTION_RETRIEVED_MSG         = "Subscription retrieved successfully"
+	PLAN_ASSIGNED_MSG                  = "Plan assigned successfully"
+	SUBSCRIPTION_WEBHOOK_PROCESSED_MSG = "Subscription payment webhook processed"
+)
+
+// ========================================
+// SUBSCRIPTION FIELD NAMES
+// ========================================
+const (
+	PLANS_FIELD_NAME        = "plans"
+	SUBSCRIPTION_FIELD_NAME = "subscription"
+)
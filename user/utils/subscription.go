@@ -0,0 +1,124 @@
+package utils
+
+import (
+	"time"
+
+	"ecommerce-be/user/entity"
+	"ecommerce-be/user/utils/constant"
+)
+
+// SubscriptionPeriodEnd returns when a billing period of the cycle starting at start ends.
+// Unknown cycles bill monthly.
+func SubscriptionPeriodEnd(start time.Time, billingCycle string) time.Time {
+	switch billingCycle {
+	case constant.PLAN_BILLING_CYCLE_YEARLY:
+		return start.AddDate(1, 0, 0)
+	case constant.PLAN_BILLING_CYCLE_LIFETIME:
+		return start.AddDate(constant.SUBSCRIPTION_LIFETIME_YEARS, 0, 0)
+	default:
+		return start.AddDate(0, 1, 0)
+	}
+}
+
+// EffectiveSubscriptionStatus is the subscription's status at now: a trial or paid period
+// that has ended, or a past-due subscription whose grace period has, is expired
+func EffectiveSubscriptionStatus(
+	sub *entity.Subscription,
+	now time.Time,
+) entity.SubscriptionStatus {
+	switch sub.Status {
+	case entity.SUBSCRIPTION_STATUS_ACTIVE, entity.SUBSCRIPTION_STATUS_TRIALING:
+		if !now.Before(sub.EndDate) {
+			return entity.SUBSCRIPTION_STATUS_EXPIRED
+		}
+	case entity.SUBSCRIPTION_STATUS_PAST_DUE:
+		if !now.Before(sub.EndDate) && (sub.GraceEndsAt == nil || !now.Before(*sub.GraceEndsAt)) {
+			return entity.SUBSCRIPTION_STATUS_EXPIRED
+		}
+	}
+	return sub.Status
+}
+
+// IsSubscriptionInForce reports whether the seller can use the platform under the
+// subscription at now
+func IsSubscriptionInForce(sub *entity.Subscription, now time.Time) bool {
+	switch EffectiveSubscriptionStatus(sub, now) {
+	case entity.SUBSCRIPTION_STATUS_ACTIVE,
+		entity.SUBSCRIPTION_STATUS_TRIALING,
+		entity.SUBSCRIPTION_STATUS_PAST_DUE:
+		return true
+	default:
+		return false
+	}
+}
+
+// AssignSubscriptionPlan puts the subscription on the plan. A subscription still in force
+// only changes plan. A seller's first subscription starts the plan's trial when it has
+// one; anything else starts a paid period now.
+func AssignSubscriptionPlan(sub *entity.Subscription, plan *entity.Plan, now time.Time) {
+	firstSubscription := sub.ID == 0
+	inForce := !firstSubscription && IsSubscriptionInForce(sub, now)
+	sub.PlanID = plan.ID
+	if inForce {
+		return
+	}
+
+	sub.StartDate = now
+	sub.PastDueSince = nil
+	sub.GraceEndsAt = nil
+	if firstSubscription && plan.TrialDays > 0 {
+		sub.Status = entity.SUBSCRIPTION_STATUS_TRIALING
+		sub.EndDate = now.AddDate(0, 0, plan.TrialDays)
+		return
+	}
+	sub.Status = entity.SUBSCRIPTION_STATUS_ACTIVE
+	sub.EndDate = SubscriptionPeriodEnd(now, plan.BillingCycle)
+}
+
+// ApplyRenewalPayment starts the plan's next billing period. It follows on from the
+// period or trial already paid for, or starts at paidAt when that has lapsed, so paying
+// early loses no days and paying late during the grace period gains none.
+func ApplyRenewalPayment(
+	sub *entity.Subscription,
+	plan *entity.Plan,
+	paidAt time.Time,
+	transactionID string,
+) {
+	start := sub.EndDate
+	if paidAt.After(start) {
+		start = paidAt
+	}
+	sub.PlanID = plan.ID
+	sub.Status = entity.SUBSCRIPTION_STATUS_ACTIVE
+	sub.StartDate = start
+	sub.EndDate = SubscriptionPeriodEnd(start, plan.BillingCycle)
+	sub.PastDueSince = nil
+	sub.GraceEndsAt = nil
+	if transactionID != "" {
+		sub.PaymentTransactionID = transactionID
+	}
+}
+
+// ApplyRenewalFailure marks a subscription in a trial or paid period past due. The seller
+// keeps access for graceDays after the period ends while the payment is retried. It
+// returns false, changing nothing, for a subscription already past due, whose grace keeps
+// running, or one whose grace would already be over at failedAt.
+func ApplyRenewalFailure(
+	sub *entity.Subscription,
+	failedAt time.Time,
+	graceDays int,
+) bool {
+	if sub.Status != entity.SUBSCRIPTION_STATUS_ACTIVE &&
+		sub.Status != entity.SUBSCRIPTION_STATUS_TRIALING {
+		return false
+	}
+	graceEndsAt := sub.EndDate.AddDate(0, 0, graceDays)
+	if !failedAt.Before(graceEndsAt) {
+		return false
+	}
+
+	sub.Status = entity.SUBSCRIPTION_STATUS_PAST_DUE
+	sub.PastDueSince = &failedAt
+	sub.GraceEndsAt = &graceEndsAt
+	return true
+}